@@ -17,6 +17,8 @@ var (
 		"smsKey",
 		"smtpKey",
 		"userKey",
+		"targetKey",
+		"captchaKey",
 		"csrfCookieKey",
 		"userAgentCookieKey",
 	}
@@ -31,8 +33,11 @@ type EncryptionKeyConfig struct {
 	SMS                  *crypto.KeyConfig
 	SMTP                 *crypto.KeyConfig
 	User                 *crypto.KeyConfig
+	Target               *crypto.KeyConfig
+	Captcha              *crypto.KeyConfig
 	CSRFCookieKeyID      string
 	UserAgentCookieKeyID string
+	SigningKeys          *crypto.SigningKeyProviderConfig
 }
 
 type EncryptionKeys struct {
@@ -44,9 +49,13 @@ type EncryptionKeys struct {
 	SMS                crypto.EncryptionAlgorithm
 	SMTP               crypto.EncryptionAlgorithm
 	User               crypto.EncryptionAlgorithm
+	Target             crypto.EncryptionAlgorithm
+	Captcha            crypto.EncryptionAlgorithm
 	CSRFCookieKey      []byte
 	UserAgentCookieKey []byte
 	OIDCKey            []byte
+	KMS                crypto.KMSProviders
+	SigningKeys        crypto.SigningKeyProvider
 }
 
 func EnsureEncryptionKeys(ctx context.Context, keyConfig *EncryptionKeyConfig, keyStorage crypto.KeyStorage) (keys *EncryptionKeys, err error) {
@@ -75,6 +84,10 @@ func EnsureEncryptionKeys(ctx context.Context, keyConfig *EncryptionKeyConfig, k
 		return nil, err
 	}
 	keys.OIDCKey = []byte(key)
+	keys.SigningKeys, err = keyConfig.SigningKeys.NewProvider(ctx, keys.OIDC)
+	if err != nil {
+		return nil, err
+	}
 	keys.OTP, err = crypto.NewAESCrypto(keyConfig.OTP, keyStorage)
 	if err != nil {
 		return nil, err
@@ -91,6 +104,14 @@ func EnsureEncryptionKeys(ctx context.Context, keyConfig *EncryptionKeyConfig, k
 	if err != nil {
 		return nil, err
 	}
+	keys.Target, err = crypto.NewAESCrypto(keyConfig.Target, keyStorage)
+	if err != nil {
+		return nil, err
+	}
+	keys.Captcha, err = crypto.NewAESCrypto(keyConfig.Captcha, keyStorage)
+	if err != nil {
+		return nil, err
+	}
 	key, err = crypto.LoadKey(keyConfig.CSRFCookieKeyID, keyStorage)
 	if err != nil {
 		return nil, err
@@ -101,9 +122,19 @@ func EnsureEncryptionKeys(ctx context.Context, keyConfig *EncryptionKeyConfig, k
 		return nil, err
 	}
 	keys.UserAgentCookieKey = []byte(key)
+	keys.KMS = crypto.KMSProviders{
+		crypto.KMSProviderLocal: crypto.NewLocalKMS(keyStorage),
+	}
 	return keys, nil
 }
 
+// WithInstanceKeys enables customer managed keys of the instances
+// for OTP secrets and the secrets and tokens of identity providers.
+func (k *EncryptionKeys) WithInstanceKeys(instanceKeys crypto.InstanceKeyProvider) {
+	k.IDPConfig = crypto.NewInstanceEncryption(k.IDPConfig, instanceKeys)
+	k.OTP = crypto.NewInstanceEncryption(k.OTP, instanceKeys)
+}
+
 func VerifyDefaultKeys(ctx context.Context, keyStorage crypto.KeyStorage) (err error) {
 	keys := make([]*crypto.Key, 0, len(defaultKeyIDs))
 	for _, keyID := range defaultKeyIDs {
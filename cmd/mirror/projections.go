@@ -78,17 +78,18 @@ type ProjectionsConfig struct {
 	Log     *logging.Config
 	Machine *id.Config
 
-	ExternalPort    uint16
-	ExternalDomain  string
-	ExternalSecure  bool
-	InternalAuthZ   internal_authz.Config
-	SystemDefaults  systemdefaults.SystemDefaults
-	Telemetry       *handlers.TelemetryPusherConfig
-	Login           login.Config
-	OIDC            oidc.Config
-	WebAuthNName    string
-	DefaultInstance command.InstanceSetup
-	AssetStorage    static_config.AssetStorageConfig
+	ExternalPort      uint16
+	ExternalDomain    string
+	ExternalSecure    bool
+	InternalAuthZ     internal_authz.Config
+	SystemDefaults    systemdefaults.SystemDefaults
+	Telemetry         *handlers.TelemetryPusherConfig
+	NotificationQueue *handlers.NotificationQueueConfig
+	Login             login.Config
+	OIDC              oidc.Config
+	WebAuthNName      string
+	DefaultInstance   command.InstanceSetup
+	AssetStorage      static_config.AssetStorageConfig
 }
 
 func migrateProjectionsFlags(cmd *cobra.Command) {
@@ -175,6 +176,10 @@ func projections(
 		keys.DomainVerification,
 		keys.OIDC,
 		keys.SAML,
+		keys.Target,
+		keys.Captcha,
+		keys.KMS,
+		keys.SigningKeys,
 		&http.Client{},
 		func(ctx context.Context, permission, orgID, resourceID string) (err error) {
 			return internal_authz.CheckPermission(ctx, authZRepo, config.InternalAuthZ.RolePermissionMappings, permission, orgID, resourceID)
@@ -197,7 +202,11 @@ func projections(
 		config.Projections.Customizations["notifications"],
 		config.Projections.Customizations["notificationsquotas"],
 		config.Projections.Customizations["telemetry"],
+		config.Projections.Customizations["notificationsqueue"],
+		config.Projections.Customizations["notificationsalerts"],
+		config.Projections.Customizations["notificationsalertsprojectionfailures"],
 		*config.Telemetry,
+		*config.NotificationQueue,
 		config.ExternalDomain,
 		config.ExternalPort,
 		config.ExternalSecure,
@@ -206,6 +215,7 @@ func projections(
 		es,
 		config.Login.DefaultOTPEmailURLV2,
 		config.SystemDefaults.Notifications.FileSystemPath,
+		staticStorage,
 		keys.User,
 		keys.SMTP,
 		keys.SMS,
@@ -0,0 +1,27 @@
+package setup
+
+import (
+	"context"
+	_ "embed"
+
+	"github.com/zitadel/zitadel/internal/database"
+	"github.com/zitadel/zitadel/internal/eventstore"
+)
+
+var (
+	//go:embed 32.sql
+	addPATRestrictionsToAuthTokens string
+)
+
+type AddPATRestrictionsToAuthTokens struct {
+	dbClient *database.DB
+}
+
+func (mig *AddPATRestrictionsToAuthTokens) Execute(ctx context.Context, _ eventstore.Event) error {
+	_, err := mig.dbClient.ExecContext(ctx, addPATRestrictionsToAuthTokens)
+	return err
+}
+
+func (mig *AddPATRestrictionsToAuthTokens) String() string {
+	return "32_add_pat_restrictions_to_auth_tokens"
+}
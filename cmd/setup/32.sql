@@ -0,0 +1,2 @@
+ALTER TABLE auth.tokens ADD COLUMN IF NOT EXISTS permissions TEXT[];
+ALTER TABLE auth.tokens ADD COLUMN IF NOT EXISTS allowed_ips TEXT[];
\ No newline at end of file
@@ -0,0 +1,27 @@
+package setup
+
+import (
+	"context"
+	_ "embed"
+
+	"github.com/zitadel/zitadel/internal/database"
+	"github.com/zitadel/zitadel/internal/eventstore"
+)
+
+var (
+	//go:embed 33.sql
+	createSubjectKeysTable string
+)
+
+type CreateSubjectKeysTable struct {
+	dbClient *database.DB
+}
+
+func (mig *CreateSubjectKeysTable) Execute(ctx context.Context, _ eventstore.Event) error {
+	_, err := mig.dbClient.ExecContext(ctx, createSubjectKeysTable)
+	return err
+}
+
+func (mig *CreateSubjectKeysTable) String() string {
+	return "33_create_subject_keys_table"
+}
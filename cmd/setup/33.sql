@@ -0,0 +1,8 @@
+CREATE TABLE IF NOT EXISTS eventstore.subject_keys (
+    instance_id TEXT NOT NULL
+    , subject_id TEXT NOT NULL
+    , key JSONB NOT NULL
+    , creation_date TIMESTAMPTZ NOT NULL DEFAULT NOW()
+
+    , PRIMARY KEY (instance_id, subject_id)
+);
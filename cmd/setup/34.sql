@@ -0,0 +1,12 @@
+CREATE TABLE IF NOT EXISTS eventstore.snapshots (
+    instance_id TEXT NOT NULL
+    , aggregate_id TEXT NOT NULL
+    , snapshot_type TEXT NOT NULL
+    , resource_owner TEXT NOT NULL
+    , sequence BIGINT NOT NULL
+    , change_date TIMESTAMPTZ NOT NULL
+    , payload JSONB NOT NULL
+    , created_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+
+    , PRIMARY KEY (instance_id, aggregate_id, snapshot_type)
+);
@@ -0,0 +1,56 @@
+package setup
+
+import (
+	"context"
+	"embed"
+
+	"github.com/zitadel/logging"
+
+	"github.com/zitadel/zitadel/internal/database"
+	"github.com/zitadel/zitadel/internal/eventstore"
+)
+
+var (
+	//go:embed 35/*.sql
+	shardActiveInstancesIndex embed.FS
+)
+
+// ShardActiveInstancesIndex replaces the es_active_instances index, which is ordered by the creation time only,
+// by a hash sharded index.
+// On CockroachDB all inserts into an index on an increasing column hit the same range,
+// which limits the push throughput. Hash sharded indexes spread the inserts across multiple ranges
+// and CockroachDB merges the shards when they are read.
+// Indexes leading with the instance_id (e.g. es_projection) are not sharded,
+// as their inserts are already spread across the instances.
+// Postgres doesn't split its indexes into ranges, so the step is skipped.
+// The events themselves are still written to the single eventstore.events2 table.
+type ShardActiveInstancesIndex struct {
+	dbClient *database.DB
+}
+
+func (mig *ShardActiveInstancesIndex) Execute(ctx context.Context, _ eventstore.Event) error {
+	if mig.dbClient.Type() != "cockroach" {
+		return nil
+	}
+	migrations, err := shardActiveInstancesIndex.ReadDir("35")
+	if err != nil {
+		return err
+	}
+	for _, migration := range migrations {
+		stmt, err := shardActiveInstancesIndex.ReadFile("35/" + migration.Name())
+		if err != nil {
+			return err
+		}
+
+		logging.WithFields("file", migration.Name(), "migration", mig.String()).Info("execute statement")
+
+		if _, err = mig.dbClient.ExecContext(ctx, string(stmt)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (mig *ShardActiveInstancesIndex) String() string {
+	return "35_shard_active_instances_index"
+}
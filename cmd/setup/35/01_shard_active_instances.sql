@@ -0,0 +1 @@
+CREATE INDEX IF NOT EXISTS es_active_instances_sharded ON eventstore.events2 (created_at DESC) USING HASH STORING ("position");
\ No newline at end of file
@@ -0,0 +1 @@
+DROP INDEX IF EXISTS eventstore.events2@es_active_instances;
\ No newline at end of file
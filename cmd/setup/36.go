@@ -0,0 +1,27 @@
+package setup
+
+import (
+	"context"
+	_ "embed"
+
+	"github.com/zitadel/zitadel/internal/database"
+	"github.com/zitadel/zitadel/internal/eventstore"
+)
+
+var (
+	//go:embed 36.sql
+	createIdempotencyKeysTable string
+)
+
+type CreateIdempotencyKeysTable struct {
+	dbClient *database.DB
+}
+
+func (mig *CreateIdempotencyKeysTable) Execute(ctx context.Context, _ eventstore.Event) error {
+	_, err := mig.dbClient.ExecContext(ctx, createIdempotencyKeysTable)
+	return err
+}
+
+func (mig *CreateIdempotencyKeysTable) String() string {
+	return "36_create_idempotency_keys_table"
+}
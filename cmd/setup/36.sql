@@ -0,0 +1,11 @@
+CREATE TABLE IF NOT EXISTS eventstore.idempotency_keys (
+    instance_id TEXT NOT NULL
+    , user_id TEXT NOT NULL
+    , idempotency_key TEXT NOT NULL
+    , method TEXT NOT NULL
+    , request_hash BYTEA NOT NULL
+    , response JSONB
+    , created_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+
+    , PRIMARY KEY (instance_id, user_id, idempotency_key)
+);
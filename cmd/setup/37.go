@@ -0,0 +1,27 @@
+package setup
+
+import (
+	"context"
+	_ "embed"
+
+	"github.com/zitadel/zitadel/internal/database"
+	"github.com/zitadel/zitadel/internal/eventstore"
+)
+
+var (
+	//go:embed 37.sql
+	addCustomCSSToStyling string
+)
+
+type AddCustomCSSToStyling struct {
+	dbClient *database.DB
+}
+
+func (mig *AddCustomCSSToStyling) Execute(ctx context.Context, _ eventstore.Event) error {
+	_, err := mig.dbClient.ExecContext(ctx, addCustomCSSToStyling)
+	return err
+}
+
+func (mig *AddCustomCSSToStyling) String() string {
+	return "37_add_custom_css_to_styling"
+}
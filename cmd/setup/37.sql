@@ -0,0 +1 @@
+ALTER TABLE adminapi.styling2 ADD COLUMN IF NOT EXISTS custom_css TEXT;
@@ -0,0 +1,27 @@
+package setup
+
+import (
+	"context"
+	_ "embed"
+
+	"github.com/zitadel/zitadel/internal/database"
+	"github.com/zitadel/zitadel/internal/eventstore"
+)
+
+var (
+	//go:embed 38.sql
+	addPasskeyPromptToAuthUsers string
+)
+
+type AddPasskeyPromptToAuthUsers struct {
+	dbClient *database.DB
+}
+
+func (mig *AddPasskeyPromptToAuthUsers) Execute(ctx context.Context, _ eventstore.Event) error {
+	_, err := mig.dbClient.ExecContext(ctx, addPasskeyPromptToAuthUsers)
+	return err
+}
+
+func (mig *AddPasskeyPromptToAuthUsers) String() string {
+	return "38_add_passkey_prompt_to_auth_users"
+}
@@ -0,0 +1,2 @@
+ALTER TABLE auth.users3 ADD COLUMN IF NOT EXISTS passkey_prompt_snoozed TIMESTAMPTZ NULL;
+ALTER TABLE auth.users3 ADD COLUMN IF NOT EXISTS passkey_prompt_opted_out BOOL NULL;
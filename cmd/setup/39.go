@@ -0,0 +1,27 @@
+package setup
+
+import (
+	"context"
+	_ "embed"
+
+	"github.com/zitadel/zitadel/internal/database"
+	"github.com/zitadel/zitadel/internal/eventstore"
+)
+
+var (
+	//go:embed 39.sql
+	addOAuthAttributeMapping string
+)
+
+type IDPTemplate6OAuthAttributeMapping struct {
+	dbClient *database.DB
+}
+
+func (mig *IDPTemplate6OAuthAttributeMapping) Execute(ctx context.Context, _ eventstore.Event) error {
+	_, err := mig.dbClient.ExecContext(ctx, addOAuthAttributeMapping)
+	return err
+}
+
+func (mig *IDPTemplate6OAuthAttributeMapping) String() string {
+	return "39_idp_templates6_add_oauth_attribute_mapping"
+}
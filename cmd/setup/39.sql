@@ -0,0 +1 @@
+ALTER TABLE IF EXISTS projections.idp_templates6_oauth2 ADD COLUMN IF NOT EXISTS attribute_mapping JSONB;
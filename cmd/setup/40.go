@@ -0,0 +1,27 @@
+package setup
+
+import (
+	"context"
+	_ "embed"
+
+	"github.com/zitadel/zitadel/internal/database"
+	"github.com/zitadel/zitadel/internal/eventstore"
+)
+
+var (
+	//go:embed 40.sql
+	addAzureADAllowedTenantsAndGroups string
+)
+
+type IDPTemplate6AzureADTenantsAndGroups struct {
+	dbClient *database.DB
+}
+
+func (mig *IDPTemplate6AzureADTenantsAndGroups) Execute(ctx context.Context, _ eventstore.Event) error {
+	_, err := mig.dbClient.ExecContext(ctx, addAzureADAllowedTenantsAndGroups)
+	return err
+}
+
+func (mig *IDPTemplate6AzureADTenantsAndGroups) String() string {
+	return "40_idp_templates6_add_azure_allowed_tenants_and_groups"
+}
@@ -0,0 +1,2 @@
+ALTER TABLE IF EXISTS projections.idp_templates6_azure ADD COLUMN IF NOT EXISTS allowed_tenants TEXT[];
+ALTER TABLE IF EXISTS projections.idp_templates6_azure ADD COLUMN IF NOT EXISTS fetch_groups BOOLEAN DEFAULT FALSE;
@@ -0,0 +1,27 @@
+package setup
+
+import (
+	"context"
+	_ "embed"
+
+	"github.com/zitadel/zitadel/internal/database"
+	"github.com/zitadel/zitadel/internal/eventstore"
+)
+
+var (
+	//go:embed 41.sql
+	addLDAPGroupsAndMetadata string
+)
+
+type IDPTemplate6LDAPGroupsAndMetadata struct {
+	dbClient *database.DB
+}
+
+func (mig *IDPTemplate6LDAPGroupsAndMetadata) Execute(ctx context.Context, _ eventstore.Event) error {
+	_, err := mig.dbClient.ExecContext(ctx, addLDAPGroupsAndMetadata)
+	return err
+}
+
+func (mig *IDPTemplate6LDAPGroupsAndMetadata) String() string {
+	return "41_idp_templates6_add_ldap_groups_and_metadata"
+}
@@ -0,0 +1,34 @@
+package setup
+
+import (
+	"context"
+	"embed"
+
+	"github.com/zitadel/zitadel/internal/database"
+	"github.com/zitadel/zitadel/internal/eventstore"
+)
+
+var (
+	//go:embed 42/cockroach/authz_decisions.sql
+	//go:embed 42/postgres/authz_decisions.sql
+	createAuthZDecisionsTable embed.FS
+)
+
+// CreateAuthZDecisionsTable creates the table of the recorded authorization decisions in the logstore schema.
+// The decisions are written by the log store emitter and not reduced from events.
+type CreateAuthZDecisionsTable struct {
+	dbClient *database.DB
+}
+
+func (mig *CreateAuthZDecisionsTable) Execute(ctx context.Context, _ eventstore.Event) error {
+	stmt, err := readStmt(createAuthZDecisionsTable, "42", mig.dbClient.Type(), "authz_decisions.sql")
+	if err != nil {
+		return err
+	}
+	_, err = mig.dbClient.ExecContext(ctx, stmt)
+	return err
+}
+
+func (mig *CreateAuthZDecisionsTable) String() string {
+	return "42_create_authz_decisions_table"
+}
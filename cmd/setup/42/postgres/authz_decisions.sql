@@ -0,0 +1,19 @@
+CREATE TABLE IF NOT EXISTS logstore.authz_decisions (
+    instance_id TEXT NOT NULL
+    , id TEXT NOT NULL
+    , checked_at TIMESTAMPTZ NOT NULL
+    , caller_id TEXT NOT NULL
+    , caller_org_id TEXT NOT NULL DEFAULT ''
+    , org_id TEXT NOT NULL DEFAULT ''
+    , method TEXT NOT NULL DEFAULT ''
+    , permission TEXT NOT NULL
+    , resource_id TEXT NOT NULL DEFAULT ''
+    , allowed BOOLEAN NOT NULL
+    , matched_permission TEXT NOT NULL DEFAULT ''
+    , error_id TEXT NOT NULL DEFAULT ''
+
+    , PRIMARY KEY (instance_id, id)
+);
+
+CREATE INDEX IF NOT EXISTS authz_decisions_checked_at ON logstore.authz_decisions (instance_id, checked_at DESC);
+CREATE INDEX IF NOT EXISTS authz_decisions_org_checked_at ON logstore.authz_decisions (instance_id, org_id, checked_at DESC);
@@ -115,6 +115,17 @@ type Steps struct {
 	s29FillFieldsForProjectGrant           *FillFieldsForProjectGrant
 	s30FillFieldsForOrgDomainVerified      *FillFieldsForOrgDomainVerified
 	s31AddAggregateIndexToFields           *AddAggregateIndexToFields
+	s32AddPATRestrictionsToAuthTokens      *AddPATRestrictionsToAuthTokens
+	s33CreateSubjectKeysTable              *CreateSubjectKeysTable
+	s34CreateSnapshotsTable                *CreateSnapshotsTable
+	s35ShardActiveInstancesIndex           *ShardActiveInstancesIndex
+	s36CreateIdempotencyKeysTable          *CreateIdempotencyKeysTable
+	s37AddCustomCSSToStyling               *AddCustomCSSToStyling
+	s38AddPasskeyPromptToAuthUsers         *AddPasskeyPromptToAuthUsers
+	s39IDPTemplate6OAuthAttributeMapping   *IDPTemplate6OAuthAttributeMapping
+	s40IDPTemplate6AzureADTenantsAndGroups *IDPTemplate6AzureADTenantsAndGroups
+	s41IDPTemplate6LDAPGroupsAndMetadata   *IDPTemplate6LDAPGroupsAndMetadata
+	s42CreateAuthZDecisionsTable           *CreateAuthZDecisionsTable
 }
 
 func MustNewSteps(v *viper.Viper) *Steps {
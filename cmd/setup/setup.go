@@ -27,11 +27,14 @@ import (
 	"github.com/zitadel/zitadel/internal/database/dialect"
 	"github.com/zitadel/zitadel/internal/domain"
 	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/eventstore/payload"
 	old_es "github.com/zitadel/zitadel/internal/eventstore/repository/sql"
+	"github.com/zitadel/zitadel/internal/eventstore/shredding"
 	new_es "github.com/zitadel/zitadel/internal/eventstore/v3"
 	"github.com/zitadel/zitadel/internal/i18n"
 	"github.com/zitadel/zitadel/internal/migration"
 	notify_handler "github.com/zitadel/zitadel/internal/notification"
+	"github.com/zitadel/zitadel/internal/notification/handlers"
 	"github.com/zitadel/zitadel/internal/query"
 	"github.com/zitadel/zitadel/internal/query/projection"
 	es_v4 "github.com/zitadel/zitadel/internal/v2/eventstore"
@@ -109,6 +112,9 @@ func Setup(ctx context.Context, config *Config, steps *Steps, masterKey string)
 
 	config.Eventstore.Querier = old_es.NewCRDB(queryDBClient)
 	esV3 := new_es.NewEventstore(esPusherDBClient)
+	if config.Eventstore.ChangeDataCapture.Enabled {
+		esV3.EnableChangeNotifications()
+	}
 	config.Eventstore.Pusher = esV3
 	config.Eventstore.Searcher = esV3
 	eventstoreClient := eventstore.NewEventstore(config.Eventstore)
@@ -160,6 +166,17 @@ func Setup(ctx context.Context, config *Config, steps *Steps, masterKey string)
 	steps.s29FillFieldsForProjectGrant = &FillFieldsForProjectGrant{eventstore: eventstoreClient}
 	steps.s30FillFieldsForOrgDomainVerified = &FillFieldsForOrgDomainVerified{eventstore: eventstoreClient}
 	steps.s31AddAggregateIndexToFields = &AddAggregateIndexToFields{dbClient: esPusherDBClient}
+	steps.s32AddPATRestrictionsToAuthTokens = &AddPATRestrictionsToAuthTokens{dbClient: queryDBClient}
+	steps.s33CreateSubjectKeysTable = &CreateSubjectKeysTable{dbClient: esPusherDBClient}
+	steps.s34CreateSnapshotsTable = &CreateSnapshotsTable{dbClient: esPusherDBClient}
+	steps.s35ShardActiveInstancesIndex = &ShardActiveInstancesIndex{dbClient: esPusherDBClient}
+	steps.s36CreateIdempotencyKeysTable = &CreateIdempotencyKeysTable{dbClient: esPusherDBClient}
+	steps.s37AddCustomCSSToStyling = &AddCustomCSSToStyling{dbClient: queryDBClient}
+	steps.s38AddPasskeyPromptToAuthUsers = &AddPasskeyPromptToAuthUsers{dbClient: queryDBClient}
+	steps.s39IDPTemplate6OAuthAttributeMapping = &IDPTemplate6OAuthAttributeMapping{dbClient: esPusherDBClient}
+	steps.s40IDPTemplate6AzureADTenantsAndGroups = &IDPTemplate6AzureADTenantsAndGroups{dbClient: esPusherDBClient}
+	steps.s41IDPTemplate6LDAPGroupsAndMetadata = &IDPTemplate6LDAPGroupsAndMetadata{dbClient: esPusherDBClient}
+	steps.s42CreateAuthZDecisionsTable = &CreateAuthZDecisionsTable{dbClient: queryDBClient}
 
 	err = projection.Create(ctx, projectionDBClient, eventstoreClient, config.Projections, nil, nil, nil)
 	logging.OnError(err).Fatal("unable to start projections")
@@ -202,6 +219,14 @@ func Setup(ctx context.Context, config *Config, steps *Steps, masterKey string)
 		steps.s26AuthUsers3,
 		steps.s29FillFieldsForProjectGrant,
 		steps.s30FillFieldsForOrgDomainVerified,
+		steps.s32AddPATRestrictionsToAuthTokens,
+		steps.s33CreateSubjectKeysTable,
+		steps.s34CreateSnapshotsTable,
+		steps.s35ShardActiveInstancesIndex,
+		steps.s36CreateIdempotencyKeysTable,
+		steps.s37AddCustomCSSToStyling,
+		steps.s38AddPasskeyPromptToAuthUsers,
+		steps.s42CreateAuthZDecisionsTable,
 	} {
 		mustExecuteMigration(ctx, eventstoreClient, step, "migration failed")
 	}
@@ -216,6 +241,9 @@ func Setup(ctx context.Context, config *Config, steps *Steps, masterKey string)
 		steps.s21AddBlockFieldToLimits,
 		steps.s25User11AddLowerFieldsToVerifiedEmail,
 		steps.s27IDPTemplate6SAMLNameIDFormat,
+		steps.s39IDPTemplate6OAuthAttributeMapping,
+		steps.s40IDPTemplate6AzureADTenantsAndGroups,
+		steps.s41IDPTemplate6LDAPGroupsAndMetadata,
 	} {
 		mustExecuteMigration(ctx, eventstoreClient, step, "migration failed")
 	}
@@ -261,6 +289,21 @@ func initProjections(
 	keys, err := encryption.EnsureEncryptionKeys(ctx, config.EncryptionKeys, keyStorage)
 	logging.OnError(err).Fatal("unable to ensure encryption keys")
 
+	staticStorage, err := config.AssetStorage.NewStorage(queryDBClient.DB)
+	logging.OnError(err).Fatal("unable to start asset storage")
+
+	// the projections must read the decoded payloads
+	if config.Eventstore.Payloads.OffloadThreshold > 0 {
+		payload.WrapOffloading(config.Eventstore, staticStorage, config.Eventstore.Payloads.OffloadThreshold)
+	}
+	if config.Eventstore.CryptoShredding.Enabled {
+		shredding.Wrap(config.Eventstore, shredding.NewKeyStorage(queryDBClient, keys.User))
+	}
+	if config.Eventstore.Payloads.CompressionThreshold > 0 {
+		payload.WrapCompression(config.Eventstore, config.Eventstore.Payloads.CompressionThreshold)
+	}
+	eventstoreClient = eventstore.NewEventstore(config.Eventstore)
+
 	err = projection.Create(
 		ctx,
 		queryDBClient,
@@ -280,9 +323,6 @@ func initProjections(
 		logging.WithFields("name", p.String()).OnError(err).Fatal("migration failed")
 	}
 
-	staticStorage, err := config.AssetStorage.NewStorage(queryDBClient.DB)
-	logging.OnError(err).Fatal("unable to start asset storage")
-
 	adminView, err := admin_view.StartView(queryDBClient)
 	logging.OnError(err).Fatal("unable to start admin view")
 	admin_handler.Register(ctx,
@@ -368,6 +408,10 @@ func initProjections(
 		keys.DomainVerification,
 		keys.OIDC,
 		keys.SAML,
+		keys.Target,
+		keys.Captcha,
+		keys.KMS,
+		keys.SigningKeys,
 		&http.Client{},
 		permissionCheck,
 		sessionTokenVerifier,
@@ -382,7 +426,12 @@ func initProjections(
 		config.Projections.Customizations["notifications"],
 		config.Projections.Customizations["notificationsquotas"],
 		config.Projections.Customizations["telemetry"],
+		config.Projections.Customizations["notificationsqueue"],
+		config.Projections.Customizations["notificationsalerts"],
+		config.Projections.Customizations["notificationsalertsprojectionfailures"],
 		*config.Telemetry,
+		// the queue worker only delivers notifications, it is not needed to initialize the projections
+		handlers.NotificationQueueConfig{},
 		config.ExternalDomain,
 		config.ExternalPort,
 		config.ExternalSecure,
@@ -391,6 +440,7 @@ func initProjections(
 		eventstoreClient,
 		config.Login.DefaultOTPEmailURLV2,
 		config.SystemDefaults.Notifications.FileSystemPath,
+		staticStorage,
 		keys.User,
 		keys.SMTP,
 		keys.SMS,
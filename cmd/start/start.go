@@ -29,6 +29,7 @@ import (
 	"github.com/zitadel/zitadel/cmd/encryption"
 	"github.com/zitadel/zitadel/cmd/key"
 	cmd_tls "github.com/zitadel/zitadel/cmd/tls"
+	"github.com/zitadel/zitadel/internal/accessreview"
 	"github.com/zitadel/zitadel/internal/actions"
 	admin_es "github.com/zitadel/zitadel/internal/admin/repository/eventsourcing"
 	"github.com/zitadel/zitadel/internal/api"
@@ -48,12 +49,15 @@ import (
 	user_v2 "github.com/zitadel/zitadel/internal/api/grpc/user/v2"
 	http_util "github.com/zitadel/zitadel/internal/api/http"
 	"github.com/zitadel/zitadel/internal/api/http/middleware"
+	"github.com/zitadel/zitadel/internal/api/idempotency"
 	"github.com/zitadel/zitadel/internal/api/idp"
+	notification_receipt "github.com/zitadel/zitadel/internal/api/notification"
 	"github.com/zitadel/zitadel/internal/api/oidc"
 	"github.com/zitadel/zitadel/internal/api/robots_txt"
 	"github.com/zitadel/zitadel/internal/api/saml"
 	"github.com/zitadel/zitadel/internal/api/ui/console"
 	"github.com/zitadel/zitadel/internal/api/ui/login"
+	"github.com/zitadel/zitadel/internal/auditstream"
 	auth_es "github.com/zitadel/zitadel/internal/auth/repository/eventsourcing"
 	"github.com/zitadel/zitadel/internal/authz"
 	authz_repo "github.com/zitadel/zitadel/internal/authz/repository"
@@ -63,21 +67,33 @@ import (
 	cryptoDB "github.com/zitadel/zitadel/internal/crypto/database"
 	"github.com/zitadel/zitadel/internal/database"
 	"github.com/zitadel/zitadel/internal/database/dialect"
+	"github.com/zitadel/zitadel/internal/dataexport"
 	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/domainverification"
 	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/eventstore/payload"
 	old_es "github.com/zitadel/zitadel/internal/eventstore/repository/sql"
+	"github.com/zitadel/zitadel/internal/eventstore/shredding"
 	new_es "github.com/zitadel/zitadel/internal/eventstore/v3"
+	exec_handler "github.com/zitadel/zitadel/internal/execution"
 	"github.com/zitadel/zitadel/internal/i18n"
 	"github.com/zitadel/zitadel/internal/id"
+	"github.com/zitadel/zitadel/internal/idphealth"
 	"github.com/zitadel/zitadel/internal/logstore"
 	"github.com/zitadel/zitadel/internal/logstore/emitters/access"
+	"github.com/zitadel/zitadel/internal/logstore/emitters/decision"
 	"github.com/zitadel/zitadel/internal/logstore/emitters/execution"
 	"github.com/zitadel/zitadel/internal/logstore/emitters/stdout"
 	"github.com/zitadel/zitadel/internal/logstore/record"
+	"github.com/zitadel/zitadel/internal/machinecredentialexpiry"
 	"github.com/zitadel/zitadel/internal/net"
 	"github.com/zitadel/zitadel/internal/notification"
+	"github.com/zitadel/zitadel/internal/provisioning"
 	"github.com/zitadel/zitadel/internal/query"
+	"github.com/zitadel/zitadel/internal/retention"
+	"github.com/zitadel/zitadel/internal/samlmetadata"
 	"github.com/zitadel/zitadel/internal/static"
+	"github.com/zitadel/zitadel/internal/userlifecycle"
 	es_v4 "github.com/zitadel/zitadel/internal/v2/eventstore"
 	es_v4_pg "github.com/zitadel/zitadel/internal/v2/eventstore/postgres"
 	"github.com/zitadel/zitadel/internal/webauthn"
@@ -152,14 +168,40 @@ func startZitadel(ctx context.Context, config *Config, masterKey string, server
 		return err
 	}
 
-	config.Eventstore.Pusher = new_es.NewEventstore(esPusherDBClient)
+	storage, err := config.AssetStorage.NewStorage(queryDBClient.DB)
+	if err != nil {
+		return fmt.Errorf("cannot start asset storage client: %w", err)
+	}
+
+	pusher := new_es.NewEventstore(esPusherDBClient)
+	if config.Eventstore.ChangeDataCapture.Enabled {
+		pusher.EnableChangeNotifications()
+	}
+	config.Eventstore.Pusher = pusher
 	config.Eventstore.Searcher = new_es.NewEventstore(queryDBClient)
+	config.Eventstore.SnapshotStorage = new_es.NewEventstore(queryDBClient)
 	config.Eventstore.Querier = old_es.NewCRDB(queryDBClient)
+	// offloading must be applied before the encryption, so offloaded payloads are encrypted as well,
+	// compression must be applied after the encryption, as encrypted payloads can't be compressed
+	if config.Eventstore.Payloads.OffloadThreshold > 0 {
+		payload.WrapOffloading(config.Eventstore, storage, config.Eventstore.Payloads.OffloadThreshold)
+	}
+	if config.Eventstore.CryptoShredding.Enabled {
+		shredding.Wrap(config.Eventstore, shredding.NewKeyStorage(queryDBClient, keys.User))
+	}
+	if config.Eventstore.Payloads.CompressionThreshold > 0 {
+		payload.WrapCompression(config.Eventstore, config.Eventstore.Payloads.CompressionThreshold)
+	}
 	eventstoreClient := eventstore.NewEventstore(config.Eventstore)
+	if config.Eventstore.ChangeDataCapture.Enabled && queryDBClient.Type() == "postgres" {
+		go new_es.Listen(ctx, queryDBClient, eventstoreClient.NotifyChanges)
+	}
 	eventstoreV4 := es_v4.NewEventstoreFromOne(es_v4_pg.New(queryDBClient, &es_v4_pg.Config{
 		MaxRetries: config.Eventstore.MaxRetries,
 	}))
 
+	keys.WithInstanceKeys(command.NewInstanceKeys(eventstoreClient, keys.KMS))
+
 	sessionTokenVerifier := internal_authz.SessionTokenVerifier(keys.OIDC)
 
 	queries, err := query.StartQueries(
@@ -197,10 +239,6 @@ func startZitadel(ctx context.Context, config *Config, masterKey string, server
 		return internal_authz.CheckPermission(ctx, authZRepo, config.InternalAuthZ.RolePermissionMappings, permission, orgID, resourceID)
 	}
 
-	storage, err := config.AssetStorage.NewStorage(queryDBClient.DB)
-	if err != nil {
-		return fmt.Errorf("cannot start asset storage client: %w", err)
-	}
 	webAuthNConfig := &webauthn.Config{
 		DisplayName:    config.WebAuthNName,
 		ExternalSecure: config.ExternalSecure,
@@ -222,6 +260,10 @@ func startZitadel(ctx context.Context, config *Config, masterKey string, server
 		keys.DomainVerification,
 		keys.OIDC,
 		keys.SAML,
+		keys.Target,
+		keys.Captcha,
+		keys.KMS,
+		keys.SigningKeys,
 		&http.Client{},
 		permissionCheck,
 		sessionTokenVerifier,
@@ -248,12 +290,34 @@ func startZitadel(ctx context.Context, config *Config, masterKey string, server
 	actionsLogstoreSvc := logstore.New(queries, actionsExecutionDBEmitter, actionsExecutionStdoutEmitter)
 	actions.SetLogstoreService(actionsLogstoreSvc)
 
+	authzStdoutEmitter, err := logstore.NewEmitter[*record.AuthZDecision](ctx, clock, &logstore.EmitterConfig{Enabled: config.LogStore.AuthZ.Stdout.Enabled}, stdout.NewStdoutEmitter[*record.AuthZDecision]())
+	if err != nil {
+		return err
+	}
+	authzDBStorage := decision.NewDatabaseLogStorage(queryDBClient, config.LogStore.AuthZ.Database.Keep)
+	authzDBEmitter, err := logstore.NewEmitter[*record.AuthZDecision](ctx, clock, &config.LogStore.AuthZ.Database.EmitterConfig, authzDBStorage)
+	if err != nil {
+		return err
+	}
+	if config.LogStore.AuthZ.Database.Enabled {
+		authzDBStorage.StartCleanup(ctx, queries)
+	}
+	if authzLogstoreSvc := logstore.New[*record.AuthZDecision](queries, nil, authzDBEmitter, authzStdoutEmitter); authzLogstoreSvc.Enabled() {
+		internal_authz.SetDecisionRecorder(internal_authz.DecisionRecorderFunc(func(ctx context.Context, d *internal_authz.Decision) {
+			authzLogstoreSvc.Handle(ctx, record.NewAuthZDecision(d))
+		}))
+	}
+
 	notification.Register(
 		ctx,
 		config.Projections.Customizations["notifications"],
 		config.Projections.Customizations["notificationsquotas"],
 		config.Projections.Customizations["telemetry"],
+		config.Projections.Customizations["notificationsqueue"],
+		config.Projections.Customizations["notificationsalerts"],
+		config.Projections.Customizations["notificationsalertsprojectionfailures"],
 		*config.Telemetry,
+		*config.NotificationQueue,
 		config.ExternalDomain,
 		config.ExternalPort,
 		config.ExternalSecure,
@@ -262,11 +326,47 @@ func startZitadel(ctx context.Context, config *Config, masterKey string, server
 		eventstoreClient,
 		config.Login.DefaultOTPEmailURLV2,
 		config.SystemDefaults.Notifications.FileSystemPath,
+		storage,
 		keys.User,
 		keys.SMTP,
 		keys.SMS,
 	)
 	notification.Start(ctx)
+	exec_handler.Register(
+		ctx,
+		config.Projections.Customizations["executions_handler"],
+		config.Executions,
+		queries,
+		commands,
+		eventstoreClient,
+		keys.Target,
+	)
+	exec_handler.Start(ctx)
+	err = auditstream.Register(ctx, config.Projections.Customizations["audit_stream"], config.AuditStream, eventstoreClient)
+	if err != nil {
+		return err
+	}
+	auditstream.Start(ctx)
+	dataexport.Register(ctx, config.Projections.Customizations["user_data_exports"], commands, queries, config.SystemDefaults.UserDataExport.LinkLifetime)
+	dataexport.Start(ctx)
+	provisioning.Register(
+		ctx,
+		config.Projections.Customizations["scim_provisioning"],
+		config.Projections.Customizations["scim_sync_worker"],
+		config.SCIMProvisioning,
+		commands,
+		queries,
+		eventstoreClient,
+		keys.Target,
+	)
+	provisioning.Start(ctx)
+	domainverification.Start(ctx, config.SystemDefaults.DomainVerification.RecheckInterval, commands, queries, queryDBClient)
+	userlifecycle.Start(ctx, config.SystemDefaults.UserLifecycle.Interval, commands, queries, queryDBClient)
+	machinecredentialexpiry.Start(ctx, config.SystemDefaults.MachineCredentialExpiry.Interval, commands, queries, queryDBClient)
+	idphealth.Start(ctx, config.SystemDefaults.IDPHealthCheck.Interval, commands, queries, queryDBClient)
+	samlmetadata.Start(ctx, config.SystemDefaults.SAMLMetadataRefresh.Interval, commands, queries, queryDBClient)
+	accessreview.Start(ctx, config.SystemDefaults.AccessReview.Interval, commands, queries, queryDBClient)
+	retention.Start(ctx, config.SystemDefaults.Retention, queries, queryDBClient)
 
 	router := mux.NewRouter()
 	tlsConfig, err := config.TLS.Config()
@@ -292,6 +392,7 @@ func startZitadel(ctx context.Context, config *Config, masterKey string, server
 	}
 	commands.GrpcMethodExisting = checkExisting(api.ListGrpcMethods())
 	commands.GrpcServiceExisting = checkExisting(api.ListGrpcServices())
+	commands.ExecutionTargets = exec_handler.TargetsByExecutionID(queries)
 
 	shutdown := make(chan os.Signal, 1)
 	signal.Notify(shutdown, os.Interrupt, syscall.SIGTERM)
@@ -368,7 +469,13 @@ func startAPIs(
 		http_util.WithMaxAge(int(math.Floor(config.Quotas.Access.ExhaustedCookieMaxAge.Seconds()))),
 	)
 	limitingAccessInterceptor := middleware.NewAccessInterceptor(accessSvc, exhaustedCookieHandler, &config.Quotas.Access.AccessConfig)
-	apis, err := api.New(ctx, config.Port, router, queries, verifier, config.InternalAuthZ, tlsConfig, config.HTTP2HostHeader, config.HTTP1HostHeader, config.ExternalDomain, limitingAccessInterceptor)
+	if err = http_util.SetClientIPConfig(config.ClientIP); err != nil {
+		return nil, err
+	}
+	if len(config.ClientIP.TrustedProxies) == 0 {
+		logging.WithFields("header", config.ClientIP.Header).Warn("no trusted proxies configured, the client IP is read from the last hop of the header, which can be set by clients reaching ZITADEL directly")
+	}
+	apis, err := api.New(ctx, config.Port, router, queries, verifier, config.InternalAuthZ, tlsConfig, config.HTTP2HostHeader, config.HTTP1HostHeader, config.ExternalDomain, limitingAccessInterceptor, idempotency.NewStorage(dbClient, keys.User, config.Idempotency))
 	if err != nil {
 		return nil, fmt.Errorf("error creating api %w", err)
 	}
@@ -423,9 +530,10 @@ func startAPIs(
 	}
 	instanceInterceptor := middleware.InstanceInterceptor(queries, config.HTTP1HostHeader, config.ExternalDomain, login.IgnoreInstanceEndpoints...)
 	assetsCache := middleware.AssetsCacheInterceptor(config.AssetStorage.Cache.MaxAge, config.AssetStorage.Cache.SharedMaxAge)
-	apis.RegisterHandlerOnPrefix(assets.HandlerPrefix, assets.NewHandler(commands, verifier, config.InternalAuthZ, id.SonyFlakeGenerator(), store, queries, middleware.CallDurationHandler, instanceInterceptor.Handler, assetsCache.Handler, limitingAccessInterceptor.Handle))
+	apis.RegisterHandlerOnPrefix(assets.HandlerPrefix, assets.NewHandler(commands, verifier, config.InternalAuthZ, id.SonyFlakeGenerator(), store, queries, keys.User, middleware.CallDurationHandler, instanceInterceptor.Handler, assetsCache.Handler, limitingAccessInterceptor.Handle))
 
 	apis.RegisterHandlerOnPrefix(idp.HandlerPrefix, idp.NewHandler(commands, queries, keys.IDPConfig, config.ExternalSecure, instanceInterceptor.Handler))
+	apis.RegisterHandlerOnPrefix(notification_receipt.HandlerPrefix, notification_receipt.NewHandler(commands, queries, keys.SMS, instanceInterceptor.Handler))
 
 	userAgentInterceptor, err := middleware.NewUserAgentHandler(config.UserAgentCookie, keys.UserAgentCookieKey, id.SonyFlakeGenerator(), config.ExternalSecure, login.EndpointResources, login.EndpointExternalLoginCallbackFormPost, login.EndpointSAMLACS)
 	if err != nil {
@@ -446,7 +554,7 @@ func startAPIs(
 	}
 	apis.RegisterHandlerOnPrefix(openapi.HandlerPrefix, openAPIHandler)
 
-	oidcServer, err := oidc.NewServer(ctx, config.OIDC, login.DefaultLoggedOutPath, config.ExternalSecure, commands, queries, authRepo, keys.OIDC, keys.OIDCKey, eventstore, dbClient, userAgentInterceptor, instanceInterceptor.Handler, limitingAccessInterceptor, config.Log.Slog(), config.SystemDefaults.SecretHasher)
+	oidcServer, err := oidc.NewServer(ctx, config.OIDC, login.DefaultLoggedOutPath, config.ExternalSecure, commands, queries, authRepo, keys.OIDC, keys.SigningKeys, keys.OIDCKey, eventstore, dbClient, userAgentInterceptor, instanceInterceptor.Handler, limitingAccessInterceptor, config.Log.Slog(), config.SystemDefaults.SecretHasher)
 	if err != nil {
 		return nil, fmt.Errorf("unable to start oidc provider: %w", err)
 	}
@@ -472,6 +580,7 @@ func startAPIs(
 		store,
 		consolePath,
 		oidcServer.AuthCallbackURL(),
+		oidcServer.EndSessionURL,
 		provider.AuthCallbackURL(samlProvider),
 		config.ExternalSecure,
 		userAgentInterceptor,
@@ -482,6 +591,7 @@ func startAPIs(
 		limitingAccessInterceptor.WithRedirect(consolePath).Handle,
 		keys.User,
 		keys.IDPConfig,
+		keys.Captcha,
 		keys.CSRFCookieKey,
 	)
 	if err != nil {
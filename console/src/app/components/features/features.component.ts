@@ -38,6 +38,7 @@ type ToggleStates = {
   userSchema?: FeatureState;
   oidcTokenExchange?: FeatureState;
   actions?: FeatureState;
+  loginV2?: FeatureState;
 };
 
 @Component({
@@ -135,6 +136,10 @@ export class FeaturesComponent implements OnDestroy {
         req.setActions(this.toggleStates?.actions?.state === ToggleState.ENABLED);
         changed = true;
       }
+      if (this.toggleStates?.loginV2?.state !== ToggleState.INHERITED) {
+        req.setLoginV2(this.toggleStates?.loginV2?.state === ToggleState.ENABLED);
+        changed = true;
+      }
 
       if (changed) {
         this.featureService
@@ -215,6 +220,16 @@ export class FeaturesComponent implements OnDestroy {
                 ? ToggleState.ENABLED
                 : ToggleState.DISABLED,
         },
+        loginV2: {
+          source: Source.SOURCE_SYSTEM,
+          state:
+            this.featureData.loginV2?.source === Source.SOURCE_SYSTEM ||
+            this.featureData.loginV2?.source === Source.SOURCE_UNSPECIFIED
+              ? ToggleState.INHERITED
+              : !!this.featureData.loginV2?.enabled
+                ? ToggleState.ENABLED
+                : ToggleState.DISABLED,
+        },
       };
     });
   }
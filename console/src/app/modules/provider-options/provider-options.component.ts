@@ -25,6 +25,7 @@ export class ProviderOptionsComponent implements OnChanges, OnDestroy {
     AutoLinkingOption.AUTO_LINKING_OPTION_UNSPECIFIED,
     AutoLinkingOption.AUTO_LINKING_OPTION_USERNAME,
     AutoLinkingOption.AUTO_LINKING_OPTION_EMAIL,
+    AutoLinkingOption.AUTO_LINKING_OPTION_VERIFIED_EMAIL,
   ];
 
   constructor() {
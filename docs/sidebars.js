@@ -167,6 +167,7 @@ module.exports = {
             "guides/manage/user/reg-create-user",
             "guides/manage/customize/user-metadata",
             "guides/manage/customize/user-schema",
+            "guides/manage/user/data-export",
           ],
         },
         "guides/manage/terraform-provider",
@@ -230,6 +231,7 @@ module.exports = {
                 "guides/integrate/login/oidc/oauth-recommended-flows",
                 "guides/integrate/login/oidc/device-authorization",
                 "guides/integrate/login/oidc/logout",
+                "guides/integrate/login/oidc/embedded-login",
               ],
             },
               "guides/integrate/login/saml",
@@ -304,6 +306,7 @@ module.exports = {
             "guides/integrate/login-ui/mfa",
             "guides/integrate/login-ui/select-account",
             "guides/integrate/login-ui/password-reset",
+            "guides/integrate/login-ui/captcha",
             "guides/integrate/login-ui/logout",
             "guides/integrate/login-ui/oidc-standard",
             "guides/integrate/login-ui/typescript-repo",
@@ -333,6 +336,11 @@ module.exports = {
             "guides/integrate/identity-providers/mocksaml",
             "guides/integrate/identity-providers/jwt_idp",
             "guides/integrate/identity-providers/migrate",
+            "guides/integrate/identity-providers/role-mapping",
+            "guides/integrate/identity-providers/oauth-attribute-mapping",
+            "guides/integrate/identity-providers/health-checks",
+            "guides/integrate/identity-providers/saml-metadata-refresh",
+            "guides/integrate/identity-providers/login-buttons",
             "guides/integrate/identity-providers/additional-information",
           ],
         },
@@ -427,6 +435,7 @@ module.exports = {
           ],
         },
         "guides/integrate/external-audit-log",
+        "guides/integrate/scim-provisioning",
       ],
     },
     {
@@ -865,6 +874,9 @@ module.exports = {
         "self-hosting/manage/database/database",
         "self-hosting/manage/updating_scaling",
         "self-hosting/manage/usage_control",
+        "self-hosting/manage/audit_stream",
+        "self-hosting/manage/retention",
+        "self-hosting/manage/crypto_shredding",
         {
           type: "category",
           label: "Command Line Interface",
@@ -0,0 +1,69 @@
+package accessreview
+
+import (
+	"context"
+	"time"
+
+	"github.com/zitadel/zitadel/internal/command"
+	"github.com/zitadel/zitadel/internal/database"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/query"
+	"github.com/zitadel/zitadel/internal/scheduledjob"
+)
+
+const lockName = "access_review"
+
+type job struct {
+	commands *command.Commands
+	queries  *query.Queries
+}
+
+// Start starts and closes the campaigns of the access reviews of all instances on every interval until the context is done.
+// The interval defines how precisely the campaigns are started and closed, 0 disables the access reviews.
+func Start(ctx context.Context, interval time.Duration, commands *command.Commands, queries *query.Queries, client *database.DB) {
+	j := &job{
+		commands: commands,
+		queries:  queries,
+	}
+	scheduledjob.Start(ctx, lockName, interval, queries, client, j.apply)
+}
+
+// apply starts and closes the campaigns of the access reviews of the instance.
+func (j *job) apply(ctx context.Context) error {
+	return j.commands.ApplyAccessReviews(ctx, j.grantsInScope)
+}
+
+// grantsInScope returns the active user grants of the organization on the projects, or on all projects if none are passed
+func (j *job) grantsInScope(ctx context.Context, resourceOwner string, projectIDs []string) ([]*command.AccessReviewItem, error) {
+	resourceOwnerQuery, err := query.NewUserGrantResourceOwnerSearchQuery(resourceOwner)
+	if err != nil {
+		return nil, err
+	}
+	queries := []query.SearchQuery{resourceOwnerQuery}
+	if len(projectIDs) > 0 {
+		projectIDsQuery, err := query.NewUserGrantProjectIDsSearchQuery(projectIDs)
+		if err != nil {
+			return nil, err
+		}
+		queries = append(queries, projectIDsQuery)
+	}
+	grants, err := j.queries.UserGrants(ctx, &query.UserGrantsQueries{Queries: queries}, true)
+	if err != nil {
+		return nil, err
+	}
+	items := make([]*command.AccessReviewItem, 0, len(grants.UserGrants))
+	for _, grant := range grants.UserGrants {
+		if grant.State != domain.UserGrantStateActive {
+			continue
+		}
+		items = append(items, &command.AccessReviewItem{
+			GrantID:        grant.ID,
+			ResourceOwner:  grant.ResourceOwner,
+			UserID:         grant.UserID,
+			ProjectID:      grant.ProjectID,
+			ProjectGrantID: grant.GrantID,
+			RoleKeys:       grant.Roles,
+		})
+	}
+	return items, nil
+}
@@ -13,13 +13,15 @@ import (
 	"github.com/dop251/goja"
 	"github.com/zitadel/logging"
 
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/telemetry/tracing"
 	"github.com/zitadel/zitadel/internal/zerrors"
 )
 
 func WithHTTP(ctx context.Context) Option {
 	return func(c *runConfig) {
 		c.modules["zitadel/http"] = func(runtime *goja.Runtime, module *goja.Object) {
-			requireHTTP(ctx, &http.Client{Transport: new(transport)}, runtime, module)
+			requireHTTP(ctx, &http.Client{Transport: tracing.HTTPTransport(new(transport))}, runtime, module)
 		}
 	}
 }
@@ -58,8 +60,13 @@ func (c *HTTP) fetchConfigFromArg(arg *goja.Object, config *fetchConfig) (err er
 		case "headers":
 			config.Headers = parseHeaders(arg.Get(key).ToObject(c.runtime))
 		case "method":
-			config.Method = arg.Get(key).String()
+			config.Method = strings.ToUpper(arg.Get(key).String())
 		case "body":
+			// strings are sent as is, all other values are sent as json
+			if body, ok := arg.Get(key).Export().(string); ok {
+				config.Body = strings.NewReader(body)
+				continue
+			}
 			body, err := arg.Get(key).ToObject(c.runtime).MarshalJSON()
 			if err != nil {
 				return err
@@ -96,9 +103,7 @@ func (r *response) Text() goja.Value {
 func (c *HTTP) fetch(ctx context.Context) func(call goja.FunctionCall) goja.Value {
 	return func(call goja.FunctionCall) goja.Value {
 		req := c.buildHTTPRequest(ctx, call.Arguments)
-		if deadline, ok := ctx.Deadline(); ok {
-			c.client.Timeout = time.Until(deadline)
-		}
+		c.client.Timeout = callTimeout(ctx)
 
 		res, err := c.client.Do(req)
 		if err != nil {
@@ -107,13 +112,42 @@ func (c *HTTP) fetch(ctx context.Context) func(call goja.FunctionCall) goja.Valu
 		}
 		defer res.Body.Close()
 
-		body, err := io.ReadAll(res.Body)
+		body, err := readBody(res.Body)
 		if err != nil {
 			logging.WithError(err).Warn("unable to parse body")
-			panic("unable to read response body")
+			panic(err)
 		}
-		return c.runtime.ToValue(&response{Status: res.StatusCode, Body: string(body), runtime: c.runtime})
+		return c.runtime.ToValue(&response{Status: res.StatusCode, Body: string(body), Headers: res.Header, runtime: c.runtime})
+	}
+}
+
+// callTimeout returns the timeout of a single call,
+// which is the configured timeout limited by the remaining duration of the action.
+func callTimeout(ctx context.Context) (timeout time.Duration) {
+	if httpConfig != nil {
+		timeout = httpConfig.Timeout
+	}
+	if deadline, ok := ctx.Deadline(); ok {
+		if remaining := time.Until(deadline); timeout == 0 || remaining < timeout {
+			timeout = remaining
+		}
+	}
+	return timeout
+}
+
+// readBody reads the response body and fails if it exceeds the configured maximum response size.
+func readBody(body io.Reader) ([]byte, error) {
+	if httpConfig == nil || httpConfig.MaxResponseSize <= 0 {
+		return io.ReadAll(body)
 	}
+	content, err := io.ReadAll(io.LimitReader(body, httpConfig.MaxResponseSize+1))
+	if err != nil {
+		return nil, err
+	}
+	if int64(len(content)) > httpConfig.MaxResponseSize {
+		return nil, zerrors.ThrowResourceExhausted(nil, "ACTIO-3kx9tpwqjz", "response body too large")
+	}
+	return content, nil
 }
 
 // the first argument has to be a string and is required
@@ -173,6 +207,9 @@ func parseHeaders(headers *goja.Object) http.Header {
 type transport struct{}
 
 func (*transport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if !isHostAllowed(ParseAllowList(authz.GetInstance(req.Context()).ActionsHTTPAllowList()), req.URL) {
+		return nil, zerrors.ThrowInvalidArgument(nil, "ACTIO-p2d8wnr6ke", "host is not allowed")
+	}
 	if httpConfig == nil {
 		return http.DefaultTransport.RoundTrip(req)
 	}
@@ -182,6 +219,20 @@ func (*transport) RoundTrip(req *http.Request) (*http.Response, error) {
 	return http.DefaultTransport.RoundTrip(req)
 }
 
+// isHostAllowed checks the address against the allow list of the instance,
+// an empty allow list allows all addresses.
+func isHostAllowed(allowList []AddressChecker, address *url.URL) bool {
+	if len(allowList) == 0 {
+		return true
+	}
+	for _, allowed := range allowList {
+		if allowed.Matches(address.Hostname()) {
+			return true
+		}
+	}
+	return false
+}
+
 func isHostBlocked(denyList []AddressChecker, address *url.URL) bool {
 	for _, blocked := range denyList {
 		if blocked.Matches(address.Hostname()) {
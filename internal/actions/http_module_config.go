@@ -4,6 +4,7 @@ import (
 	"net"
 	"reflect"
 	"strings"
+	"time"
 
 	"github.com/mitchellh/mapstructure"
 
@@ -18,6 +19,10 @@ var httpConfig *HTTPConfig
 
 type HTTPConfig struct {
 	DenyList []AddressChecker
+	// MaxResponseSize limits the size of the response body in bytes, 0 means unlimited
+	MaxResponseSize int64
+	// Timeout is the maximum duration of a single call, it is further limited by the remaining duration of the action
+	Timeout time.Duration
 }
 
 func HTTPConfigDecodeHook(from, to reflect.Value) (interface{}, error) {
@@ -26,7 +31,9 @@ func HTTPConfigDecodeHook(from, to reflect.Value) (interface{}, error) {
 	}
 
 	config := struct {
-		DenyList []string
+		DenyList        []string
+		MaxResponseSize int64
+		Timeout         time.Duration
 	}{}
 
 	decoder, err := mapstructure.NewDecoder(&mapstructure.DecoderConfig{
@@ -43,7 +50,9 @@ func HTTPConfigDecodeHook(from, to reflect.Value) (interface{}, error) {
 	}
 
 	c := HTTPConfig{
-		DenyList: make([]AddressChecker, 0),
+		DenyList:        make([]AddressChecker, 0),
+		MaxResponseSize: config.MaxResponseSize,
+		Timeout:         config.Timeout,
 	}
 
 	for _, unsplit := range config.DenyList {
@@ -61,6 +70,21 @@ func HTTPConfigDecodeHook(from, to reflect.Value) (interface{}, error) {
 	return c, nil
 }
 
+// ParseAllowList parses the entries of an allow list, which are either IPs, CIDRs or domains.
+// Domains prefixed with `*.` match all sub domains.
+func ParseAllowList(entries []string) []AddressChecker {
+	checkers := make([]AddressChecker, 0, len(entries))
+	for _, entry := range entries {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+		checker, _ := parseDenyListEntry(entry)
+		checkers = append(checkers, checker)
+	}
+	return checkers
+}
+
 func parseDenyListEntry(entry string) (AddressChecker, error) {
 	if checker, err := NewIPChecker(entry); err == nil {
 		return checker, nil
@@ -100,7 +124,11 @@ type DomainChecker struct {
 	Domain string
 }
 
+// Matches checks if the domain equals the configured domain.
+// If the configured domain starts with `*.` all sub domains match as well.
 func (c *DomainChecker) Matches(domain string) bool {
-	//TODO: allow wild cards
+	if parent, ok := strings.CutPrefix(c.Domain, "*."); ok {
+		return strings.HasSuffix(domain, "."+parent)
+	}
 	return c.Domain == domain
 }
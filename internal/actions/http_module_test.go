@@ -435,3 +435,131 @@ func TestHTTP_buildHTTPRequest(t *testing.T) {
 		})
 	}
 }
+
+func Test_isHostAllowed(t *testing.T) {
+	allowList := ParseAllowList([]string{
+		"192.168.5.0/24",
+		"api.test.com",
+		"*.example.com",
+		" ",
+	})
+	type args struct {
+		allowList []AddressChecker
+		address   *url.URL
+	}
+	tests := []struct {
+		name string
+		args args
+		want bool
+	}{
+		{
+			name: "empty allow list",
+			args: args{
+				address: mustNewURL(t, "https://test.com/hodor"),
+			},
+			want: true,
+		},
+		{
+			name: "in range",
+			args: args{
+				allowList: allowList,
+				address:   mustNewURL(t, "https://192.168.5.4/hodor"),
+			},
+			want: true,
+		},
+		{
+			name: "address match",
+			args: args{
+				allowList: allowList,
+				address:   mustNewURL(t, "https://api.test.com:42/hodor"),
+			},
+			want: true,
+		},
+		{
+			name: "sub domain match",
+			args: args{
+				allowList: allowList,
+				address:   mustNewURL(t, "https://api.example.com/hodor"),
+			},
+			want: true,
+		},
+		{
+			name: "wildcard parent domain not match",
+			args: args{
+				allowList: allowList,
+				address:   mustNewURL(t, "https://example.com/hodor"),
+			},
+			want: false,
+		},
+		{
+			name: "address not match",
+			args: args{
+				allowList: allowList,
+				address:   mustNewURL(t, "https://test.com/hodor"),
+			},
+			want: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := isHostAllowed(tt.args.allowList, tt.args.address); got != tt.want {
+				t.Errorf("isHostAllowed() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func Test_readBody(t *testing.T) {
+	tests := []struct {
+		name    string
+		config  *HTTPConfig
+		body    string
+		want    string
+		wantErr func(error) bool
+	}{
+		{
+			name: "no config",
+			body: "hodor",
+			want: "hodor",
+		},
+		{
+			name:   "unlimited",
+			config: &HTTPConfig{},
+			body:   "hodor",
+			want:   "hodor",
+		},
+		{
+			name:   "within limit",
+			config: &HTTPConfig{MaxResponseSize: 5},
+			body:   "hodor",
+			want:   "hodor",
+		},
+		{
+			name:    "exceeds limit",
+			config:  &HTTPConfig{MaxResponseSize: 4},
+			body:    "hodor",
+			wantErr: zerrors.IsResourceExhausted,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			SetHTTPConfig(tt.config)
+			t.Cleanup(func() { SetHTTPConfig(nil) })
+
+			got, err := readBody(bytes.NewBufferString(tt.body))
+			if tt.wantErr != nil {
+				if !tt.wantErr(err) {
+					t.Errorf("readBody() unexpected error = %v", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Errorf("readBody() unexpected error = %v", err)
+				return
+			}
+			if string(got) != tt.want {
+				t.Errorf("readBody() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
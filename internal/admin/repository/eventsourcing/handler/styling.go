@@ -326,6 +326,9 @@ func (m *Styling) writeFile(policy *iam_model.LabelPolicyView) (io.Reader, int64
 		}
 	}
 	cssContent += "}"
+	if customCSS := domain.SanitizeLabelPolicyCSS(policy.CustomCSS); customCSS != "" {
+		cssContent += "\n" + customCSS
+	}
 
 	data := []byte(cssContent)
 	buffer := bytes.NewBuffer(data)
@@ -6,6 +6,7 @@ import (
 	"net/http"
 	"sort"
 	"strings"
+	"time"
 
 	"github.com/gorilla/mux"
 	"github.com/improbable-eng/grpc-web/go/grpcweb"
@@ -19,6 +20,7 @@ import (
 	"github.com/zitadel/zitadel/internal/api/grpc/server"
 	http_util "github.com/zitadel/zitadel/internal/api/http"
 	http_mw "github.com/zitadel/zitadel/internal/api/http/middleware"
+	"github.com/zitadel/zitadel/internal/api/idempotency"
 	"github.com/zitadel/zitadel/internal/api/ui/login"
 	"github.com/zitadel/zitadel/internal/query"
 	"github.com/zitadel/zitadel/internal/telemetry/metrics"
@@ -65,6 +67,7 @@ func (a *API) ListGrpcMethods() []string {
 
 type healthCheck interface {
 	Health(ctx context.Context) error
+	ReplicationLag(ctx context.Context) (time.Duration, error)
 }
 
 func New(
@@ -77,6 +80,7 @@ func New(
 	tlsConfig *tls.Config,
 	http2HostName, http1HostName, externalDomain string,
 	accessInterceptor *http_mw.AccessInterceptor,
+	idempotencyStorage idempotency.Storage,
 ) (_ *API, err error) {
 	api := &API{
 		port:              port,
@@ -88,7 +92,7 @@ func New(
 		accessInterceptor: accessInterceptor,
 	}
 
-	api.grpcServer = server.CreateServer(api.verifier, authZ, queries, http2HostName, externalDomain, tlsConfig, accessInterceptor.AccessService())
+	api.grpcServer = server.CreateServer(api.verifier, authZ, queries, http2HostName, externalDomain, tlsConfig, accessInterceptor.AccessService(), idempotencyStorage)
 	api.grpcGateway, err = server.CreateGateway(ctx, port, http1HostName, accessInterceptor, tlsConfig)
 	if err != nil {
 		return nil, err
@@ -231,6 +235,7 @@ func (a *API) healthHandler() http.Handler {
 	handler.HandleFunc("/healthz", handleHealth)
 	handler.HandleFunc("/ready", handleReadiness(checks))
 	handler.HandleFunc("/validate", handleValidate(checks))
+	handler.HandleFunc("/replication", a.handleReplication)
 	handler.Handle("/metrics", metricsExporter())
 
 	return handler
@@ -241,6 +246,19 @@ func handleHealth(w http.ResponseWriter, r *http.Request) {
 	logging.WithFields("traceID", tracing.TraceIDFromCtx(r.Context())).OnError(err).Error("error writing ok for health")
 }
 
+type replicationStatus struct {
+	LagSeconds float64 `json:"lagSeconds"`
+}
+
+func (a *API) handleReplication(w http.ResponseWriter, r *http.Request) {
+	lag, err := a.health.ReplicationLag(r.Context())
+	if err != nil {
+		http_util.MarshalJSON(w, nil, err, http.StatusInternalServerError)
+		return
+	}
+	http_util.MarshalJSON(w, &replicationStatus{LagSeconds: lag.Seconds()}, nil, http.StatusOK)
+}
+
 func handleReadiness(checks []ValidationFunction) func(w http.ResponseWriter, r *http.Request) {
 	return func(w http.ResponseWriter, r *http.Request) {
 		errs := validate(r.Context(), checks)
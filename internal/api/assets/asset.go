@@ -19,6 +19,8 @@ import (
 	http_util "github.com/zitadel/zitadel/internal/api/http"
 	http_mw "github.com/zitadel/zitadel/internal/api/http/middleware"
 	"github.com/zitadel/zitadel/internal/command"
+	"github.com/zitadel/zitadel/internal/crypto"
+	"github.com/zitadel/zitadel/internal/domain"
 	"github.com/zitadel/zitadel/internal/i18n"
 	"github.com/zitadel/zitadel/internal/id"
 	"github.com/zitadel/zitadel/internal/query"
@@ -37,6 +39,7 @@ type Handler struct {
 	authInterceptor *http_mw.AuthInterceptor
 	idGenerator     id.Generator
 	query           *query.Queries
+	dataExportAlg   crypto.EncryptionAlgorithm
 }
 
 func (h *Handler) AuthInterceptor() *http_mw.AuthInterceptor {
@@ -70,6 +73,12 @@ type Uploader interface {
 	ObjectType() static.ObjectType
 }
 
+// ObjectIDUploader is implemented by uploaders of assets which belong to an object (e.g. an identity provider),
+// the id of the object is passed as form value
+type ObjectIDUploader interface {
+	WithObjectID(id string) Uploader
+}
+
 type Downloader interface {
 	ObjectName(ctx context.Context, path string) (string, error)
 	ResourceOwner(ctx context.Context, ownerPath string) string
@@ -94,7 +103,7 @@ func DefaultErrorHandler(translator *i18n.Translator) func(w http.ResponseWriter
 	}
 }
 
-func NewHandler(commands *command.Commands, verifier authz.APITokenVerifier, authConfig authz.Config, idGenerator id.Generator, storage static.Storage, queries *query.Queries, callDurationInterceptor, instanceInterceptor, assetCacheInterceptor, accessInterceptor func(handler http.Handler) http.Handler) http.Handler {
+func NewHandler(commands *command.Commands, verifier authz.APITokenVerifier, authConfig authz.Config, idGenerator id.Generator, storage static.Storage, queries *query.Queries, dataExportAlg crypto.EncryptionAlgorithm, callDurationInterceptor, instanceInterceptor, assetCacheInterceptor, accessInterceptor func(handler http.Handler) http.Handler) http.Handler {
 	translator, err := i18n.NewZitadelTranslator(language.English)
 	logging.OnError(err).Panic("unable to get translator")
 	h := &Handler{
@@ -104,6 +113,7 @@ func NewHandler(commands *command.Commands, verifier authz.APITokenVerifier, aut
 		idGenerator:     idGenerator,
 		storage:         storage,
 		query:           queries,
+		dataExportAlg:   dataExportAlg,
 	}
 
 	verifier.RegisterServer("Assets-API", "assets", AssetsService_AuthMethods)
@@ -111,6 +121,7 @@ func NewHandler(commands *command.Commands, verifier authz.APITokenVerifier, aut
 	csp := http_mw.SecurityHeaders(&http_mw.DefaultSCP, nil)
 	router.Use(callDurationInterceptor, instanceInterceptor, assetCacheInterceptor, accessInterceptor, csp)
 	RegisterRoutes(router, h)
+	router.Path(dataExportPath).Methods("GET").HandlerFunc(h.DownloadDataExport)
 	router.PathPrefix("/{owner}").Methods("GET").HandlerFunc(DownloadHandleFunc(h, h.GetFile()))
 	return http_util.CopyHeadersToContext(http_mw.CORSInterceptor(router))
 }
@@ -122,6 +133,10 @@ func (h *Handler) GetFile() Downloader {
 type publicFileDownloader struct{}
 
 func (l *publicFileDownloader) ObjectName(_ context.Context, path string) (string, error) {
+	// data exports are only served through their signed links, offloaded event payloads are never served
+	if domain.IsHumanDataExportAssetPath(path) || domain.IsEventPayloadAssetPath(path) {
+		return "", nil
+	}
 	return path, nil
 }
 
@@ -131,6 +146,7 @@ func (l *publicFileDownloader) ResourceOwner(_ context.Context, ownerPath string
 
 const maxMemory = 2 << 20
 const paramFile = "file"
+const paramObjectID = "id"
 
 func UploadHandleFunc(s AssetsService, uploader Uploader) func(http.ResponseWriter, *http.Request) {
 	return func(w http.ResponseWriter, r *http.Request) {
@@ -141,6 +157,10 @@ func UploadHandleFunc(s AssetsService, uploader Uploader) func(http.ResponseWrit
 			http.Error(w, err.Error(), http.StatusBadRequest)
 			return
 		}
+		uploader := uploader
+		if objectUploader, ok := uploader.(ObjectIDUploader); ok {
+			uploader = objectUploader.WithObjectID(r.FormValue(paramObjectID))
+		}
 		file, handler, err := r.FormFile(paramFile)
 		if err != nil {
 			http.Error(w, err.Error(), http.StatusBadRequest)
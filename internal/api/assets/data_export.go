@@ -0,0 +1,58 @@
+package assets
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/gorilla/mux"
+
+	http_util "github.com/zitadel/zitadel/internal/api/http"
+	"github.com/zitadel/zitadel/internal/crypto"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+const (
+	dataExportPath     = "/users/{userID}/data_exports/{exportID}"
+	paramDataExportSig = "signature"
+)
+
+// DataExportURL returns the signed link to download the archive of a data export
+func DataExportURL(assetAPI, userID, exportID, signature string) string {
+	return assetAPI + "/users/" + url.PathEscape(userID) + "/data_exports/" + url.PathEscape(exportID) + "?" + paramDataExportSig + "=" + url.QueryEscape(signature)
+}
+
+// DownloadDataExport serves the archive of a data export, if the link is signed with the key of the export and not expired.
+// The archive is not served through the public download of the assets.
+func (h *Handler) DownloadDataExport(w http.ResponseWriter, r *http.Request) {
+	if h.storage == nil {
+		return
+	}
+	ctx := r.Context()
+	userID, exportID := mux.Vars(r)["userID"], mux.Vars(r)["exportID"]
+	export, err := h.query.HumanDataExportByID(ctx, userID, "", exportID)
+	if err != nil {
+		h.errorHandler(w, r, err, http.StatusNotFound)
+		return
+	}
+	if export.State != domain.DataExportStateGenerated || export.Expired(time.Now()) {
+		h.errorHandler(w, r, zerrors.ThrowNotFound(nil, "ASSETS-Dx7kq2mv9n", "Errors.User.DataExport.NotFound"), http.StatusNotFound)
+		return
+	}
+	signingKey, err := crypto.Decrypt(export.SigningKey, h.dataExportAlg)
+	if err != nil {
+		h.errorHandler(w, r, err, http.StatusInternalServerError)
+		return
+	}
+	if !domain.VerifyDataExportSignature(signingKey, userID, exportID, export.Expiration, r.URL.Query().Get(paramDataExportSig)) {
+		h.errorHandler(w, r, zerrors.ThrowPermissionDenied(nil, "ASSETS-Dx3nw8kq4v", "Errors.User.DataExport.SignatureInvalid"), http.StatusForbidden)
+		return
+	}
+	w.Header().Set(http_util.CacheControl, "no-store")
+	w.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=%q", "data-export-"+exportID+".zip"))
+	if err = GetAsset(w, r, export.ResourceOwner, export.ObjectName, h.storage); err != nil {
+		h.errorHandler(w, r, err, http.StatusInternalServerError)
+	}
+}
@@ -366,6 +366,67 @@ func (l *labelPolicyFontDownloader) ResourceOwner(ctx context.Context, _ string)
 	return getLabelPolicyResourceOwner(ctx, l.defaultPolicy, l.preview, l.query)
 }
 
+func (h *Handler) UploadDefaultLoginPolicyIDPIcon() Uploader {
+	return &loginPolicyIDPIconUploader{h.idGenerator, true, "", []string{"image/"}, 1 << 19}
+}
+
+func (h *Handler) UploadOrgLoginPolicyIDPIcon() Uploader {
+	return &loginPolicyIDPIconUploader{h.idGenerator, false, "", []string{"image/"}, 1 << 19}
+}
+
+type loginPolicyIDPIconUploader struct {
+	idGenerator   id.Generator
+	defaultPolicy bool
+	idpID         string
+	contentTypes  []string
+	maxSize       int64
+}
+
+func (l *loginPolicyIDPIconUploader) WithObjectID(idpID string) Uploader {
+	return &loginPolicyIDPIconUploader{l.idGenerator, l.defaultPolicy, idpID, l.contentTypes, l.maxSize}
+}
+
+func (l *loginPolicyIDPIconUploader) ContentTypeAllowed(contentType string) bool {
+	for _, ct := range l.contentTypes {
+		if strings.HasPrefix(contentType, ct) {
+			return true
+		}
+	}
+	return false
+}
+
+func (l *loginPolicyIDPIconUploader) ObjectType() static.ObjectType {
+	return static.ObjectTypeStyling
+}
+
+func (l *loginPolicyIDPIconUploader) MaxFileSize() int64 {
+	return l.maxSize
+}
+
+func (l *loginPolicyIDPIconUploader) ObjectName(_ authz.CtxData) (string, error) {
+	suffixID, err := l.idGenerator.Next()
+	if err != nil {
+		return "", err
+	}
+	return domain.LoginPolicyIDPIconPath + "-" + l.idpID + "-" + suffixID, nil
+}
+
+func (l *loginPolicyIDPIconUploader) ResourceOwner(instance authz.Instance, ctxData authz.CtxData) string {
+	if l.defaultPolicy {
+		return instance.InstanceID()
+	}
+	return ctxData.OrgID
+}
+
+func (l *loginPolicyIDPIconUploader) UploadAsset(ctx context.Context, orgID string, upload *command.AssetUpload, commands *command.Commands) error {
+	if l.defaultPolicy {
+		_, err := commands.AddDefaultLoginPolicyIDPIcon(ctx, l.idpID, upload)
+		return err
+	}
+	_, err := commands.AddOrgLoginPolicyIDPIcon(ctx, orgID, l.idpID, upload)
+	return err
+}
+
 func getLabelPolicy(ctx context.Context, defaultPolicy, preview bool, queries *query.Queries) (*query.LabelPolicy, error) {
 	if defaultPolicy {
 		if preview {
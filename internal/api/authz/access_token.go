@@ -16,7 +16,7 @@ type MembershipsResolver interface {
 
 type authZRepo interface {
 	MembershipsResolver
-	VerifyAccessToken(ctx context.Context, token, verifierClientID, projectID string) (userID, agentID, clientID, prefLang, resourceOwner string, err error)
+	VerifyAccessToken(ctx context.Context, token, verifierClientID, projectID string) (userID, agentID, clientID, prefLang, resourceOwner, tokenID string, permissions []string, err error)
 	VerifierClientID(ctx context.Context, name string) (clientID, projectID string, err error)
 	ProjectIDAndOriginsByClientID(ctx context.Context, clientID string) (projectID string, origins []string, err error)
 	ExistsOrg(ctx context.Context, id, domain string) (string, error)
@@ -32,11 +32,11 @@ func StartAccessTokenVerifierFromRepo(authZRepo authZRepo) *AccessTokenVerifierF
 	return &AccessTokenVerifierFromRepo{authZRepo: authZRepo}
 }
 
-func (a *AccessTokenVerifierFromRepo) VerifyAccessToken(ctx context.Context, token string) (userID, clientID, agentID, prefLang, resourceOwner string, err error) {
+func (a *AccessTokenVerifierFromRepo) VerifyAccessToken(ctx context.Context, token string) (userID, clientID, agentID, prefLang, resourceOwner, tokenID string, permissions []string, err error) {
 	ctx, span := tracing.NewSpan(ctx)
 	defer func() { span.EndWithError(err) }()
-	userID, agentID, clientID, prefLang, resourceOwner, err = a.authZRepo.VerifyAccessToken(ctx, token, "", GetInstance(ctx).ProjectID())
-	return userID, clientID, agentID, prefLang, resourceOwner, err
+	userID, agentID, clientID, prefLang, resourceOwner, tokenID, permissions, err = a.authZRepo.VerifyAccessToken(ctx, token, "", GetInstance(ctx).ProjectID())
+	return userID, clientID, agentID, prefLang, resourceOwner, tokenID, permissions, err
 }
 
 type client struct {
@@ -40,8 +40,8 @@ func Test_extractBearerToken(t *testing.T) {
 			args: args{
 				ctx:   context.Background(),
 				token: "Bearer AUTH",
-				verifier: AccessTokenVerifierFunc(func(context.Context, string) (string, string, string, string, string, error) {
-					return "", "", "", "", "", nil
+				verifier: AccessTokenVerifierFunc(func(context.Context, string) (string, string, string, string, string, string, []string, error) {
+					return "", "", "", "", "", "", nil, nil
 				}),
 			},
 			wantErr: false,
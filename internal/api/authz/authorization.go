@@ -35,6 +35,9 @@ func CheckUserAuthorization(ctx context.Context, req interface{}, token, orgID,
 	}
 
 	requestedPermissions, allPermissions, err := getUserPermissions(ctx, verifier, requiredAuthOption.Permission, authConfig.RolePermissionMappings, ctxData, ctxData.OrgID)
+	defer func() {
+		recordDecision(ctx, ctxData, method, requiredAuthOption.Permission, ctxData.OrgID, requestResourceID(req, requiredAuthOption.CheckParam), requestedPermissions, err)
+	}()
 	if err != nil {
 		return nil, err
 	}
@@ -32,6 +32,13 @@ type CtxData struct {
 	PreferredLanguage string
 	ResourceOwner     string
 	SystemMemberships Memberships
+	// ClientID is the client id of the application the token was issued to, empty for personal access tokens.
+	ClientID string
+	// TokenID is the id of the verified access token, empty for system tokens.
+	TokenID string
+	// TokenPermissions restrict the permissions of the user to the permission scopes of the token, e.g. of a personal access token.
+	// The user keeps all permissions if empty.
+	TokenPermissions []string
 }
 
 func (ctxData CtxData) IsZero() bool {
@@ -54,6 +61,9 @@ type Membership struct {
 	ObjectID string
 
 	Roles []string
+	// Permissions are granted by the custom roles of an organization,
+	// which are not part of the role mappings.
+	Permissions []string
 }
 
 type MemberType int32
@@ -75,13 +85,13 @@ type TokenVerifier interface {
 }
 
 type AccessTokenVerifier interface {
-	VerifyAccessToken(ctx context.Context, token string) (userID, clientID, agentID, prefLan, resourceOwner string, err error)
+	VerifyAccessToken(ctx context.Context, token string) (userID, clientID, agentID, prefLan, resourceOwner, tokenID string, permissions []string, err error)
 }
 
 // AccessTokenVerifierFunc implements the SystemTokenVerifier interface so that a function can be used as a AccessTokenVerifier.
-type AccessTokenVerifierFunc func(context.Context, string) (string, string, string, string, string, error)
+type AccessTokenVerifierFunc func(context.Context, string) (string, string, string, string, string, string, []string, error)
 
-func (a AccessTokenVerifierFunc) VerifyAccessToken(ctx context.Context, token string) (string, string, string, string, string, error) {
+func (a AccessTokenVerifierFunc) VerifyAccessToken(ctx context.Context, token string) (string, string, string, string, string, string, []string, error) {
 	return a(ctx, token)
 }
 
@@ -103,7 +113,7 @@ func VerifyTokenAndCreateCtxData(ctx context.Context, token, orgID, orgDomain st
 	if err != nil {
 		return CtxData{}, err
 	}
-	userID, clientID, agentID, prefLang, resourceOwner, err := t.VerifyAccessToken(ctx, tokenWOBearer)
+	userID, clientID, agentID, prefLang, resourceOwner, tokenID, tokenPermissions, err := t.VerifyAccessToken(ctx, tokenWOBearer)
 	var sysMemberships Memberships
 	if err != nil && !zerrors.IsUnauthenticated(err) {
 		return CtxData{}, err
@@ -144,10 +154,13 @@ func VerifyTokenAndCreateCtxData(ctx context.Context, token, orgID, orgDomain st
 		UserID:            userID,
 		OrgID:             orgID,
 		ProjectID:         projectID,
+		ClientID:          clientID,
+		TokenID:           tokenID,
 		AgentID:           agentID,
 		PreferredLanguage: prefLang,
 		ResourceOwner:     resourceOwner,
 		SystemMemberships: sysMemberships,
+		TokenPermissions:  tokenPermissions,
 	}, nil
 }
 
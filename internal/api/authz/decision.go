@@ -0,0 +1,108 @@
+package authz
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"time"
+
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+// Decision is the outcome of a permission check
+type Decision struct {
+	CheckedAt  time.Time
+	InstanceID string
+	// CallerID is the id of the user whose permissions were checked
+	CallerID string
+	// CallerOrgID is the organization of the caller
+	CallerOrgID string
+	// OrgID is the organization the permission was checked on
+	OrgID string
+	// Method is the called API method
+	Method     string
+	Permission string
+	// ResourceID is the resource the permission was checked on, empty if no specific resource was checked
+	ResourceID string
+	Allowed    bool
+	// MatchedPermission is the permission of the caller which allowed the request,
+	// e.g. project.write:123 if the caller is a member of the project 123
+	MatchedPermission string
+	// ErrorID is the id of the error which denied the request
+	ErrorID string
+}
+
+// DecisionRecorder records the outcome of permission checks
+type DecisionRecorder interface {
+	RecordDecision(ctx context.Context, decision *Decision)
+}
+
+type DecisionRecorderFunc func(ctx context.Context, decision *Decision)
+
+func (f DecisionRecorderFunc) RecordDecision(ctx context.Context, decision *Decision) {
+	f(ctx, decision)
+}
+
+var decisionRecorder DecisionRecorder
+
+// SetDecisionRecorder sets the recorder of all permission checks,
+// decisions are not recorded if no recorder is set
+func SetDecisionRecorder(recorder DecisionRecorder) {
+	decisionRecorder = recorder
+}
+
+func recordDecision(ctx context.Context, ctxData CtxData, method, permission, orgID, resourceID string, userPerms []string, err error) {
+	if decisionRecorder == nil {
+		return
+	}
+	decision := &Decision{
+		CheckedAt:   time.Now(),
+		InstanceID:  GetInstance(ctx).InstanceID(),
+		CallerID:    ctxData.UserID,
+		CallerOrgID: ctxData.OrgID,
+		OrgID:       orgID,
+		Method:      method,
+		Permission:  permission,
+		ResourceID:  resourceID,
+		Allowed:     err == nil,
+	}
+	if err == nil {
+		decision.MatchedPermission = matchedPermission(userPerms, resourceID)
+	}
+	zErr := new(zerrors.ZitadelError)
+	if errors.As(err, &zErr) {
+		decision.ErrorID = zErr.GetID()
+	}
+	decisionRecorder.RecordDecision(ctx, decision)
+}
+
+// requestResourceID returns the value of the field of the request the permission is checked on
+func requestResourceID(req interface{}, checkParam string) string {
+	if checkParam == "" || decisionRecorder == nil {
+		return ""
+	}
+	if v := reflect.Indirect(reflect.ValueOf(req)); v.Kind() != reflect.Struct {
+		return ""
+	}
+	return getFieldFromReq(req, checkParam)
+}
+
+// matchedPermission returns the permission which allowed the access to the resource
+func matchedPermission(userPerms []string, resourceID string) string {
+	if len(userPerms) == 0 {
+		return ""
+	}
+	for _, perm := range userPerms {
+		_, ctxID := SplitPermission(perm)
+		if ctxID == "" {
+			return perm
+		}
+	}
+	for _, perm := range userPerms {
+		_, ctxID := SplitPermission(perm)
+		if ctxID == resourceID {
+			return perm
+		}
+	}
+	return userPerms[0]
+}
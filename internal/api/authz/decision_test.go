@@ -0,0 +1,117 @@
+package authz
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+func Test_matchedPermission(t *testing.T) {
+	type args struct {
+		userPerms  []string
+		resourceID string
+	}
+	tests := []struct {
+		name string
+		args args
+		want string
+	}{
+		{
+			name: "no permissions",
+			args: args{},
+			want: "",
+		},
+		{
+			name: "global permission",
+			args: args{
+				userPerms:  []string{"project.read:123", "project.read"},
+				resourceID: "123",
+			},
+			want: "project.read",
+		},
+		{
+			name: "context permission",
+			args: args{
+				userPerms:  []string{"project.read:456", "project.read:123"},
+				resourceID: "123",
+			},
+			want: "project.read:123",
+		},
+		{
+			name: "no matching context",
+			args: args{
+				userPerms: []string{"project.read:456"},
+			},
+			want: "project.read:456",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, matchedPermission(tt.args.userPerms, tt.args.resourceID))
+		})
+	}
+}
+
+func Test_recordDecision(t *testing.T) {
+	type args struct {
+		userPerms []string
+		err       error
+	}
+	tests := []struct {
+		name string
+		args args
+		want *Decision
+	}{
+		{
+			name: "allowed",
+			args: args{
+				userPerms: []string{"user.read"},
+			},
+			want: &Decision{
+				CallerID:          "user-id",
+				CallerOrgID:       "caller-org-id",
+				OrgID:             "org-id",
+				Method:            "/method",
+				Permission:        "user.read",
+				ResourceID:        "resource-id",
+				Allowed:           true,
+				MatchedPermission: "user.read",
+			},
+		},
+		{
+			name: "denied",
+			args: args{
+				err: zerrors.ThrowPermissionDenied(nil, "AUTH-5mWD2", "No matching permissions found"),
+			},
+			want: &Decision{
+				CallerID:    "user-id",
+				CallerOrgID: "caller-org-id",
+				OrgID:       "org-id",
+				Method:      "/method",
+				Permission:  "user.read",
+				ResourceID:  "resource-id",
+				Allowed:     false,
+				ErrorID:     "AUTH-5mWD2",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got *Decision
+			SetDecisionRecorder(DecisionRecorderFunc(func(_ context.Context, decision *Decision) {
+				got = decision
+			}))
+			defer SetDecisionRecorder(nil)
+
+			recordDecision(context.Background(), CtxData{UserID: "user-id", OrgID: "caller-org-id"}, "/method", "user.read", "org-id", "resource-id", tt.args.userPerms, tt.args.err)
+			if assert.NotNil(t, got) {
+				assert.NotZero(t, got.CheckedAt)
+				got.CheckedAt = tt.want.CheckedAt
+				assert.Equal(t, tt.want, got)
+			}
+		})
+	}
+}
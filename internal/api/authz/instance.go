@@ -22,8 +22,11 @@ type Instance interface {
 	RequestedHost() string
 	DefaultLanguage() language.Tag
 	DefaultOrganisationID() string
+	// BrandingOrganisationID returns the organization whose branding is used on the requested domain
+	BrandingOrganisationID() string
 	SecurityPolicyAllowedOrigins() []string
 	EnableImpersonation() bool
+	ActionsHTTPAllowList() []string
 	Block() *bool
 	AuditLogRetention() *time.Duration
 	Features() feature.Features
@@ -84,6 +87,10 @@ func (i *instance) DefaultOrganisationID() string {
 	return i.orgID
 }
 
+func (i *instance) BrandingOrganisationID() string {
+	return ""
+}
+
 func (i *instance) SecurityPolicyAllowedOrigins() []string {
 	return nil
 }
@@ -92,6 +99,10 @@ func (i *instance) EnableImpersonation() bool {
 	return false
 }
 
+func (i *instance) ActionsHTTPAllowList() []string {
+	return nil
+}
+
 func (i *instance) Features() feature.Features {
 	return i.features
 }
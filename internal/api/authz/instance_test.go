@@ -118,6 +118,10 @@ func (m *mockInstance) DefaultOrganisationID() string {
 	return "orgID"
 }
 
+func (m *mockInstance) BrandingOrganisationID() string {
+	return ""
+}
+
 func (m *mockInstance) RequestedDomain() string {
 	return "zitadel.cloud"
 }
@@ -134,6 +138,10 @@ func (m *mockInstance) EnableImpersonation() bool {
 	return false
 }
 
+func (m *mockInstance) ActionsHTTPAllowList() []string {
+	return nil
+}
+
 func (m *mockInstance) Features() feature.Features {
 	return feature.Features{}
 }
@@ -2,6 +2,7 @@ package authz
 
 import (
 	"context"
+	"slices"
 
 	"github.com/zitadel/zitadel/internal/telemetry/tracing"
 	"github.com/zitadel/zitadel/internal/zerrors"
@@ -9,6 +10,9 @@ import (
 
 func CheckPermission(ctx context.Context, resolver MembershipsResolver, roleMappings []RoleMapping, permission, orgID, resourceID string) (err error) {
 	requestedPermissions, _, err := getUserPermissions(ctx, resolver, permission, roleMappings, GetCtxData(ctx), orgID)
+	defer func() {
+		recordDecision(ctx, GetCtxData(ctx), "", permission, orgID, resourceID, requestedPermissions, err)
+	}()
 	if err != nil {
 		return err
 	}
@@ -50,7 +54,23 @@ func getUserPermissions(ctx context.Context, resolver MembershipsResolver, requi
 		}
 	}
 	requestedPermissions, allPermissions = mapMembershipsToPermissions(requiredPerm, memberships, roleMappings)
-	return requestedPermissions, allPermissions, nil
+	return restrictToTokenPermissions(requestedPermissions, ctxData.TokenPermissions), restrictToTokenPermissions(allPermissions, ctxData.TokenPermissions), nil
+}
+
+// restrictToTokenPermissions removes the permissions which are not part of the permission scopes of the token.
+// Permissions of a specific resource (e.g. project.write:123) are kept if the permission itself is part of the scopes.
+func restrictToTokenPermissions(permissions, tokenPermissions []string) []string {
+	if len(tokenPermissions) == 0 {
+		return permissions
+	}
+	restricted := make([]string, 0, len(permissions))
+	for _, permission := range permissions {
+		perm, _ := SplitPermission(permission)
+		if slices.Contains(tokenPermissions, perm) {
+			restricted = append(restricted, permission)
+		}
+	}
+	return restricted
 }
 
 // checkUserResourcePermissions checks that if a user i granted either the requested permission globally (project.write)
@@ -99,18 +119,22 @@ func mapMembershipToPerm(requiredPerm string, membership *Membership, roleMappin
 	roleNames, roleContextID := roleWithContext(membership)
 	for _, roleName := range roleNames {
 		perms := getPermissionsFromRole(roleMappings, roleName)
+		requestPermissions, allPermissions = mapPermissions(requiredPerm, perms, roleContextID, requestPermissions, allPermissions)
+	}
+	return mapPermissions(requiredPerm, membership.Permissions, roleContextID, requestPermissions, allPermissions)
+}
 
-		for _, p := range perms {
-			permWithCtx := addRoleContextIDToPerm(p, roleContextID)
-			if !ExistsPerm(allPermissions, permWithCtx) {
-				allPermissions = append(allPermissions, permWithCtx)
-			}
+func mapPermissions(requiredPerm string, perms []string, roleContextID string, requestPermissions, allPermissions []string) ([]string, []string) {
+	for _, p := range perms {
+		permWithCtx := addRoleContextIDToPerm(p, roleContextID)
+		if !ExistsPerm(allPermissions, permWithCtx) {
+			allPermissions = append(allPermissions, permWithCtx)
+		}
 
-			p, _ = SplitPermission(p)
-			if p == requiredPerm {
-				if !ExistsPerm(requestPermissions, permWithCtx) {
-					requestPermissions = append(requestPermissions, permWithCtx)
-				}
+		p, _ = SplitPermission(p)
+		if p == requiredPerm {
+			if !ExistsPerm(requestPermissions, permWithCtx) {
+				requestPermissions = append(requestPermissions, permWithCtx)
 			}
 		}
 	}
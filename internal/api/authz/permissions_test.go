@@ -117,6 +117,32 @@ func Test_GetUserPermissions(t *testing.T) {
 			},
 			result: []string{"project.read"},
 		},
+		{
+			name: "Get Permissions restricted by token",
+			args: args{
+				ctxData: CtxData{UserID: "userID", OrgID: "orgID", TokenPermissions: []string{"org.read"}},
+				membershipsResolver: membershipsResolverFunc(func(ctx context.Context, orgID string, shouldTriggerBulk bool) ([]*Membership, error) {
+					return []*Membership{
+						{
+							AggregateID: "orgID",
+							ObjectID:    "orgID",
+							MemberType:  MemberTypeOrganization,
+							Roles:       []string{"ORG_OWNER"},
+						},
+					}, nil
+				}),
+				requiredPerm: "project.read",
+				authConfig: Config{
+					RolePermissionMappings: []RoleMapping{
+						{
+							Role:        "ORG_OWNER",
+							Permissions: []string{"org.read", "project.read"},
+						},
+					},
+				},
+			},
+			result: []string{"org.read"},
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -273,6 +299,31 @@ func Test_MapMembershipToPermissions(t *testing.T) {
 			requestPerms: []string{"project.read", "project.read:1"},
 			allPerms:     []string{"org.read", "project.read", "project.read:1"},
 		},
+		{
+			name: "custom role permissions",
+			args: args{
+				requiredPerm: "user.write",
+				membership: []*Membership{
+					{
+						AggregateID: "1",
+						ObjectID:    "1",
+						MemberType:  MemberTypeOrganization,
+						Roles:       []string{"ORG_OWNER_VIEWER", "ORG_CUSTOM_HELPDESK"},
+						Permissions: []string{"user.read", "user.write"},
+					},
+				},
+				authConfig: Config{
+					RolePermissionMappings: []RoleMapping{
+						{
+							Role:        "ORG_OWNER_VIEWER",
+							Permissions: []string{"org.read", "user.read"},
+						},
+					},
+				},
+			},
+			requestPerms: []string{"user.write"},
+			allPerms:     []string{"org.read", "user.read", "user.write"},
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
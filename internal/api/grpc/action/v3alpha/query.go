@@ -5,6 +5,7 @@ import (
 	"strings"
 
 	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/structpb"
 
 	"github.com/zitadel/zitadel/internal/api/grpc/object/v2"
 	"github.com/zitadel/zitadel/internal/command"
@@ -149,6 +150,78 @@ func targetToPb(t *query.Target) *action.Target {
 	return target
 }
 
+func (s *Server) ListTargetDeliveries(ctx context.Context, req *action.ListTargetDeliveriesRequest) (*action.ListTargetDeliveriesResponse, error) {
+	if err := checkExecutionEnabled(ctx); err != nil {
+		return nil, err
+	}
+
+	deliveries, err := s.query.TargetDeliveries(ctx, req.GetTargetId(), req.GetIncludeSucceeded())
+	if err != nil {
+		return nil, err
+	}
+	state := new(query.State)
+	for _, delivery := range deliveries {
+		if delivery.Sequence > state.Sequence {
+			state.Sequence = delivery.Sequence
+			state.EventCreatedAt = delivery.ChangeDate
+		}
+	}
+	return &action.ListTargetDeliveriesResponse{
+		Result: targetDeliveriesToPb(deliveries),
+		Details: object.ToListDetails(query.SearchResponse{
+			Count: uint64(len(deliveries)),
+			State: state,
+		}),
+	}, nil
+}
+
+func targetDeliveriesToPb(deliveries []*query.TargetDelivery) []*action.TargetDelivery {
+	d := make([]*action.TargetDelivery, len(deliveries))
+	for i, delivery := range deliveries {
+		d[i] = targetDeliveryToPb(delivery)
+	}
+	return d
+}
+
+func targetDeliveryToPb(d *query.TargetDelivery) *action.TargetDelivery {
+	delivery := &action.TargetDelivery{
+		DeliveryId: d.ID,
+		Details: object.DomainToDetailsPb(&domain.ObjectDetails{
+			Sequence:      d.Sequence,
+			EventDate:     d.ChangeDate,
+			ResourceOwner: d.TargetID,
+		}),
+		ExecutionId:   d.ExecutionID,
+		AggregateType: string(d.AggregateType),
+		AggregateId:   d.AggregateID,
+		EventType:     string(d.EventType),
+		EventSequence: d.EventSequence,
+		Attempts:      uint32(d.Attempts),
+		Reason:        d.Reason,
+		State:         targetDeliveryStateToPb(d.State),
+	}
+	payload := new(structpb.Struct)
+	if err := payload.UnmarshalJSON(d.Body); err == nil {
+		delivery.Payload = payload
+	}
+	return delivery
+}
+
+func targetDeliveryStateToPb(state domain.TargetDeliveryState) action.TargetDeliveryState {
+	switch state {
+	case domain.TargetDeliveryStateFailed:
+		return action.TargetDeliveryState_TARGET_DELIVERY_STATE_FAILED
+	case domain.TargetDeliveryStateReplaying:
+		return action.TargetDeliveryState_TARGET_DELIVERY_STATE_REPLAYING
+	case domain.TargetDeliveryStateSucceeded:
+		return action.TargetDeliveryState_TARGET_DELIVERY_STATE_SUCCEEDED
+	case domain.TargetDeliveryStateUnspecified:
+		return action.TargetDeliveryState_TARGET_DELIVERY_STATE_UNSPECIFIED
+	default:
+		return action.TargetDeliveryState_TARGET_DELIVERY_STATE_UNSPECIFIED
+	}
+}
+
 func (s *Server) ListExecutions(ctx context.Context, req *action.ListExecutionsRequest) (*action.ListExecutionsResponse, error) {
 	if err := checkExecutionEnabled(ctx); err != nil {
 		return nil, err
@@ -24,8 +24,9 @@ func (s *Server) CreateTarget(ctx context.Context, req *action.CreateTargetReque
 		return nil, err
 	}
 	return &action.CreateTargetResponse{
-		Id:      add.AggregateID,
-		Details: object.DomainToDetailsPb(details),
+		Id:         add.AggregateID,
+		Details:    object.DomainToDetailsPb(details),
+		SigningKey: add.SigningKey,
 	}, nil
 }
 
@@ -34,12 +35,14 @@ func (s *Server) UpdateTarget(ctx context.Context, req *action.UpdateTargetReque
 		return nil, err
 	}
 
-	details, err := s.command.ChangeTarget(ctx, updateTargetToCommand(req), authz.GetInstance(ctx).InstanceID())
+	change := updateTargetToCommand(req)
+	details, err := s.command.ChangeTarget(ctx, change, authz.GetInstance(ctx).InstanceID())
 	if err != nil {
 		return nil, err
 	}
 	return &action.UpdateTargetResponse{
-		Details: object.DomainToDetailsPb(details),
+		Details:    object.DomainToDetailsPb(details),
+		SigningKey: change.SigningKey,
 	}, nil
 }
 
@@ -57,6 +60,20 @@ func (s *Server) DeleteTarget(ctx context.Context, req *action.DeleteTargetReque
 	}, nil
 }
 
+func (s *Server) ReplayTargetDelivery(ctx context.Context, req *action.ReplayTargetDeliveryRequest) (*action.ReplayTargetDeliveryResponse, error) {
+	if err := checkExecutionEnabled(ctx); err != nil {
+		return nil, err
+	}
+
+	details, err := s.command.ReplayTargetDelivery(ctx, req.GetTargetId(), req.GetDeliveryId(), authz.GetInstance(ctx).InstanceID())
+	if err != nil {
+		return nil, err
+	}
+	return &action.ReplayTargetDeliveryResponse{
+		Details: object.DomainToDetailsPb(details),
+	}, nil
+}
+
 func createTargetToCommand(req *action.CreateTargetRequest) *command.AddTarget {
 	var (
 		targetType       domain.TargetType
@@ -89,8 +106,9 @@ func updateTargetToCommand(req *action.UpdateTargetRequest) *command.ChangeTarge
 		ObjectRoot: models.ObjectRoot{
 			AggregateID: req.GetTargetId(),
 		},
-		Name:     req.Name,
-		Endpoint: req.Endpoint,
+		Name:                 req.Name,
+		Endpoint:             req.Endpoint,
+		ExpirationSigningKey: req.GetExpirationSigningKey(),
 	}
 	if req.TargetType != nil {
 		switch t := req.GetTargetType().(type) {
@@ -0,0 +1,72 @@
+package admin
+
+import (
+	"context"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/api/grpc/object"
+	"github.com/zitadel/zitadel/internal/command"
+	"github.com/zitadel/zitadel/internal/domain"
+	admin_pb "github.com/zitadel/zitadel/pkg/grpc/admin"
+)
+
+func (s *Server) ListAlertTargets(ctx context.Context, _ *admin_pb.ListAlertTargetsRequest) (*admin_pb.ListAlertTargetsResponse, error) {
+	targets, err := s.query.AlertTargets(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &admin_pb.ListAlertTargetsResponse{
+		Result: AlertTargetsToPb(targets, authz.GetInstance(ctx).InstanceID()),
+	}, nil
+}
+
+func (s *Server) GetAlertTarget(ctx context.Context, req *admin_pb.GetAlertTargetRequest) (*admin_pb.GetAlertTargetResponse, error) {
+	target, err := s.query.AlertTargetByID(ctx, req.GetId())
+	if err != nil {
+		return nil, err
+	}
+	return &admin_pb.GetAlertTargetResponse{
+		Target: AlertTargetToPb(target, authz.GetInstance(ctx).InstanceID()),
+	}, nil
+}
+
+func (s *Server) AddAlertTarget(ctx context.Context, req *admin_pb.AddAlertTargetRequest) (*admin_pb.AddAlertTargetResponse, error) {
+	id, details, err := s.command.AddAlertTarget(ctx, &command.AlertTarget{
+		Name:       req.GetName(),
+		TargetType: domain.AlertTargetType(req.GetType()),
+		WebhookURL: req.GetWebhookUrl(),
+		EventTypes: req.GetEventTypes(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &admin_pb.AddAlertTargetResponse{
+		Id:      id,
+		Details: object.DomainToAddDetailsPb(details),
+	}, nil
+}
+
+func (s *Server) UpdateAlertTarget(ctx context.Context, req *admin_pb.UpdateAlertTargetRequest) (*admin_pb.UpdateAlertTargetResponse, error) {
+	details, err := s.command.ChangeAlertTarget(ctx, req.GetId(), &command.AlertTarget{
+		Name:       req.GetName(),
+		TargetType: domain.AlertTargetType(req.GetType()),
+		WebhookURL: req.GetWebhookUrl(),
+		EventTypes: req.GetEventTypes(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &admin_pb.UpdateAlertTargetResponse{
+		Details: object.DomainToChangeDetailsPb(details),
+	}, nil
+}
+
+func (s *Server) RemoveAlertTarget(ctx context.Context, req *admin_pb.RemoveAlertTargetRequest) (*admin_pb.RemoveAlertTargetResponse, error) {
+	details, err := s.command.RemoveAlertTarget(ctx, req.GetId())
+	if err != nil {
+		return nil, err
+	}
+	return &admin_pb.RemoveAlertTargetResponse{
+		Details: object.DomainToChangeDetailsPb(details),
+	}, nil
+}
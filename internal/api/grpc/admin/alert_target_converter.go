@@ -0,0 +1,25 @@
+package admin
+
+import (
+	"github.com/zitadel/zitadel/internal/api/grpc/object"
+	"github.com/zitadel/zitadel/internal/query"
+	settings_pb "github.com/zitadel/zitadel/pkg/grpc/settings"
+)
+
+func AlertTargetsToPb(targets []*query.AlertTarget, instanceID string) []*settings_pb.AlertTarget {
+	pb := make([]*settings_pb.AlertTarget, len(targets))
+	for i, target := range targets {
+		pb[i] = AlertTargetToPb(target, instanceID)
+	}
+	return pb
+}
+
+func AlertTargetToPb(target *query.AlertTarget, instanceID string) *settings_pb.AlertTarget {
+	return &settings_pb.AlertTarget{
+		Details:    object.ChangeToDetailsPb(target.Sequence, target.ChangeDate, instanceID),
+		Id:         target.ID,
+		Name:       target.Name,
+		Type:       settings_pb.AlertTargetType(target.TargetType),
+		EventTypes: target.EventTypes,
+	}
+}
@@ -0,0 +1,149 @@
+package admin
+
+import (
+	"context"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/api/grpc/gerrors"
+	"github.com/zitadel/zitadel/internal/command"
+	"github.com/zitadel/zitadel/internal/query"
+	"github.com/zitadel/zitadel/internal/telemetry/tracing"
+	admin_pb "github.com/zitadel/zitadel/pkg/grpc/admin"
+	"github.com/zitadel/zitadel/pkg/grpc/message"
+)
+
+func (s *Server) ApplyConfiguration(ctx context.Context, req *admin_pb.ApplyConfigurationRequest) (_ *admin_pb.ApplyConfigurationResponse, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	declared, err := command.ParseDeclaration([]byte(req.Definition))
+	if err != nil {
+		return nil, err
+	}
+	current, err := s.currentDeclaration(ctx, declared)
+	if err != nil {
+		return nil, err
+	}
+	changes := command.PlanDeclaration(declared, current)
+	if req.DryRun {
+		return &admin_pb.ApplyConfigurationResponse{
+			Changes: declarationChangesToPb(changes, 0),
+		}, nil
+	}
+	ctxData := authz.GetCtxData(ctx)
+	applied, err := s.command.ApplyDeclaration(ctx, changes, ctxData.UserID, ctxData.ResourceOwner)
+	resp := &admin_pb.ApplyConfigurationResponse{
+		Changes: declarationChangesToPb(changes, applied),
+	}
+	if err != nil {
+		_, key, id, _ := gerrors.ExtractZITADELError(err)
+		resp.Error = &message.ErrorDetail{
+			Id:      id,
+			Message: key,
+		}
+	}
+	return resp, nil
+}
+
+// currentDeclaration queries the current state of the declared orgs, their projects and roles
+func (s *Server) currentDeclaration(ctx context.Context, declared *command.Declaration) (*command.Declaration, error) {
+	current := &command.Declaration{
+		Orgs: make([]*command.DeclaredOrg, 0, len(declared.Orgs)),
+	}
+	for _, declaredOrg := range declared.Orgs {
+		nameQuery, err := query.NewOrgNameSearchQuery(query.TextEquals, declaredOrg.Name)
+		if err != nil {
+			return nil, err
+		}
+		orgs, err := s.query.SearchOrgs(ctx, &query.OrgSearchQueries{Queries: []query.SearchQuery{nameQuery}})
+		if err != nil {
+			return nil, err
+		}
+		if len(orgs.Orgs) == 0 {
+			continue
+		}
+		org := &command.DeclaredOrg{
+			ID:   orgs.Orgs[0].ID,
+			Name: orgs.Orgs[0].Name,
+		}
+		if org.Projects, err = s.currentDeclaredProjects(ctx, org.ID); err != nil {
+			return nil, err
+		}
+		current.Orgs = append(current.Orgs, org)
+	}
+	return current, nil
+}
+
+func (s *Server) currentDeclaredProjects(ctx context.Context, orgID string) ([]*command.DeclaredProject, error) {
+	ownerQuery, err := query.NewProjectResourceOwnerSearchQuery(orgID)
+	if err != nil {
+		return nil, err
+	}
+	projects, err := s.query.SearchProjects(ctx, &query.ProjectSearchQueries{Queries: []query.SearchQuery{ownerQuery}})
+	if err != nil {
+		return nil, err
+	}
+	declaredProjects := make([]*command.DeclaredProject, len(projects.Projects))
+	for i, project := range projects.Projects {
+		declaredProjects[i] = &command.DeclaredProject{
+			ID:                     project.ID,
+			Name:                   project.Name,
+			ProjectRoleAssertion:   project.ProjectRoleAssertion,
+			ProjectRoleCheck:       project.ProjectRoleCheck,
+			HasProjectCheck:        project.HasProjectCheck,
+			PrivateLabelingSetting: project.PrivateLabelingSetting,
+		}
+		if declaredProjects[i].Roles, err = s.currentDeclaredRoles(ctx, orgID, project.ID); err != nil {
+			return nil, err
+		}
+	}
+	return declaredProjects, nil
+}
+
+func (s *Server) currentDeclaredRoles(ctx context.Context, orgID, projectID string) ([]*command.DeclaredRole, error) {
+	projectQuery, err := query.NewProjectRoleProjectIDSearchQuery(projectID)
+	if err != nil {
+		return nil, err
+	}
+	ownerQuery, err := query.NewProjectRoleResourceOwnerSearchQuery(orgID)
+	if err != nil {
+		return nil, err
+	}
+	roles, err := s.query.SearchProjectRoles(ctx, false, &query.ProjectRoleSearchQueries{Queries: []query.SearchQuery{projectQuery, ownerQuery}})
+	if err != nil {
+		return nil, err
+	}
+	declaredRoles := make([]*command.DeclaredRole, len(roles.ProjectRoles))
+	for i, role := range roles.ProjectRoles {
+		declaredRoles[i] = &command.DeclaredRole{
+			Key:         role.Key,
+			DisplayName: role.DisplayName,
+			Group:       role.Group,
+		}
+	}
+	return declaredRoles, nil
+}
+
+func declarationChangesToPb(changes []*command.DeclarationChange, applied int) []*admin_pb.ApplyConfigurationResponse_Change {
+	pbChanges := make([]*admin_pb.ApplyConfigurationResponse_Change, len(changes))
+	for i, change := range changes {
+		pbChanges[i] = &admin_pb.ApplyConfigurationResponse_Change{
+			ResourceType: string(change.ResourceType),
+			Resource:     change.Resource,
+			Action:       declarationChangeActionToPb(change.Action),
+			Applied:      i < applied,
+		}
+	}
+	return pbChanges
+}
+
+func declarationChangeActionToPb(action command.DeclarationChangeAction) admin_pb.ApplyConfigurationResponse_Action {
+	switch action {
+	case command.DeclarationChangeActionUpdate:
+		return admin_pb.ApplyConfigurationResponse_ACTION_UPDATE
+	case command.DeclarationChangeActionCreate:
+		return admin_pb.ApplyConfigurationResponse_ACTION_CREATE
+	default:
+		return admin_pb.ApplyConfigurationResponse_ACTION_CREATE
+	}
+}
@@ -0,0 +1,50 @@
+package admin
+
+import (
+	"context"
+	"time"
+
+	"github.com/zitadel/zitadel/internal/api/grpc/decision"
+	object_pb "github.com/zitadel/zitadel/internal/api/grpc/object"
+	"github.com/zitadel/zitadel/internal/query"
+	admin_pb "github.com/zitadel/zitadel/pkg/grpc/admin"
+)
+
+func (s *Server) ListAuthorizationDecisions(ctx context.Context, req *admin_pb.ListAuthorizationDecisionsRequest) (*admin_pb.ListAuthorizationDecisionsResponse, error) {
+	queries, err := listAuthorizationDecisionsToModel(req)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := s.query.SearchAuthZDecisions(ctx, queries)
+	if err != nil {
+		return nil, err
+	}
+	return &admin_pb.ListAuthorizationDecisionsResponse{
+		Result:  decision.DecisionsToPb(resp.Decisions),
+		Details: object_pb.ToListDetails(resp.Count, 0, time.Time{}),
+	}, nil
+}
+
+func listAuthorizationDecisionsToModel(req *admin_pb.ListAuthorizationDecisionsRequest) (*query.AuthZDecisionSearchQueries, error) {
+	offset, limit, asc := object_pb.ListQueryToModel(req.Query)
+	queries, err := decision.DecisionQueriesToQuery(req.GetQueries())
+	if err != nil {
+		return nil, err
+	}
+	if req.GetOrgId() != "" {
+		orgQuery, err := query.NewAuthZDecisionOrgIDSearchQuery(req.GetOrgId())
+		if err != nil {
+			return nil, err
+		}
+		queries = append(queries, orgQuery)
+	}
+	return &query.AuthZDecisionSearchQueries{
+		SearchRequest: query.SearchRequest{
+			Offset:        offset,
+			Limit:         limit,
+			Asc:           asc,
+			SortingColumn: decision.DecisionFieldNameToSortingColumn(req.GetSortingColumn()),
+		},
+		Queries: queries,
+	}, nil
+}
@@ -11,11 +11,12 @@ import (
 )
 
 const (
-	maxLimit = 1000
+	maxLimit       = 1000
+	maxExportLimit = 10000
 )
 
 func (s *Server) ListEvents(ctx context.Context, in *admin_pb.ListEventsRequest) (*admin_pb.ListEventsResponse, error) {
-	filter, err := eventRequestToFilter(ctx, in)
+	filter, err := eventRequestToFilter(ctx, in, maxLimit)
 	if err != nil {
 		return nil, err
 	}
@@ -37,7 +38,7 @@ func (s *Server) ListAggregateTypes(ctx context.Context, in *admin_pb.ListAggreg
 	return admin_pb.AggregateTypesToPb(aggregateTypes), nil
 }
 
-func eventRequestToFilter(ctx context.Context, req *admin_pb.ListEventsRequest) (*eventstore.SearchQueryBuilder, error) {
+func eventRequestToFilter(ctx context.Context, req *admin_pb.ListEventsRequest, maxLimit uint64) (*eventstore.SearchQueryBuilder, error) {
 	var fromTime, sinceTime, untilTime time.Time
 	// We ignore the deprecation warning here because we still need to support the deprecated field.
 	//nolint:staticcheck
@@ -85,6 +86,7 @@ func eventRequestToFilter(ctx context.Context, req *admin_pb.ListEventsRequest)
 		OrderDesc().
 		InstanceID(authz.GetInstance(ctx).InstanceID()).
 		Limit(limit).
+		Offset(req.GetOffset()).
 		AwaitOpenTransactions().
 		ResourceOwner(req.ResourceOwner).
 		EditorUser(req.EditorUserId).
@@ -0,0 +1,135 @@
+package admin
+
+import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"strconv"
+	"time"
+
+	"github.com/zitadel/zitadel/internal/query"
+	"github.com/zitadel/zitadel/internal/zerrors"
+	admin_pb "github.com/zitadel/zitadel/pkg/grpc/admin"
+)
+
+func (s *Server) ExportEvents(ctx context.Context, in *admin_pb.ExportEventsRequest) (*admin_pb.ExportEventsResponse, error) {
+	req := in.GetQuery()
+	if req == nil {
+		req = new(admin_pb.ListEventsRequest)
+	}
+	filter, err := eventRequestToFilter(ctx, req, maxExportLimit)
+	if err != nil {
+		return nil, err
+	}
+	events, err := s.query.SearchEvents(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+	switch in.GetFormat() {
+	case admin_pb.EventExportFormat_EVENT_EXPORT_FORMAT_CSV:
+		content, err := eventsToCSV(events)
+		if err != nil {
+			return nil, err
+		}
+		return &admin_pb.ExportEventsResponse{
+			Content:     content,
+			ContentType: "text/csv",
+			FileName:    "events.csv",
+		}, nil
+	case admin_pb.EventExportFormat_EVENT_EXPORT_FORMAT_JSON:
+		content, err := eventsToJSON(events)
+		if err != nil {
+			return nil, err
+		}
+		return &admin_pb.ExportEventsResponse{
+			Content:     content,
+			ContentType: "application/json",
+			FileName:    "events.json",
+		}, nil
+	}
+	return nil, zerrors.ThrowInvalidArgument(nil, "ADMIN-q9ek3mv7xd", "Errors.Query.InvalidRequest")
+}
+
+// exportedEvent is the representation of an event in the exports, the columns of the CSV export have the same names
+type exportedEvent struct {
+	CreationDate      time.Time       `json:"creation_date"`
+	EventType         string          `json:"event_type"`
+	AggregateType     string          `json:"aggregate_type"`
+	AggregateID       string          `json:"aggregate_id"`
+	ResourceOwner     string          `json:"resource_owner"`
+	Sequence          uint64          `json:"sequence"`
+	EditorUserID      string          `json:"editor_user_id"`
+	EditorDisplayName string          `json:"editor_display_name"`
+	EditorService     string          `json:"editor_service"`
+	Payload           json.RawMessage `json:"payload,omitempty"`
+}
+
+var exportedEventColumns = []string{
+	"creation_date",
+	"event_type",
+	"aggregate_type",
+	"aggregate_id",
+	"resource_owner",
+	"sequence",
+	"editor_user_id",
+	"editor_display_name",
+	"editor_service",
+	"payload",
+}
+
+func exportedEventFromQuery(event *query.Event) *exportedEvent {
+	exported := &exportedEvent{
+		CreationDate:  event.CreationDate,
+		EventType:     event.Type,
+		AggregateType: string(event.Aggregate.Type),
+		AggregateID:   event.Aggregate.ID,
+		ResourceOwner: event.Aggregate.ResourceOwner,
+		Sequence:      event.Sequence,
+	}
+	if event.Editor != nil {
+		exported.EditorUserID = event.Editor.ID
+		exported.EditorDisplayName = event.Editor.DisplayName
+		exported.EditorService = event.Editor.Service
+	}
+	if len(event.Payload) > 0 && json.Valid(event.Payload) {
+		exported.Payload = event.Payload
+	}
+	return exported
+}
+
+func eventsToJSON(events []*query.Event) ([]byte, error) {
+	exported := make([]*exportedEvent, len(events))
+	for i, event := range events {
+		exported[i] = exportedEventFromQuery(event)
+	}
+	return json.Marshal(exported)
+}
+
+func eventsToCSV(events []*query.Event) ([]byte, error) {
+	var buf bytes.Buffer
+	w := csv.NewWriter(&buf)
+	if err := w.Write(exportedEventColumns); err != nil {
+		return nil, err
+	}
+	for _, event := range events {
+		exported := exportedEventFromQuery(event)
+		err := w.Write([]string{
+			exported.CreationDate.Format(time.RFC3339Nano),
+			exported.EventType,
+			exported.AggregateType,
+			exported.AggregateID,
+			exported.ResourceOwner,
+			strconv.FormatUint(exported.Sequence, 10),
+			exported.EditorUserID,
+			exported.EditorDisplayName,
+			exported.EditorService,
+			string(exported.Payload),
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+	w.Flush()
+	return buf.Bytes(), w.Error()
+}
@@ -0,0 +1,61 @@
+package admin
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/query"
+)
+
+func testExportEvents() []*query.Event {
+	return []*query.Event{
+		{
+			Editor: &query.EventEditor{
+				ID:          "user1",
+				DisplayName: "Jane, Doe",
+				Service:     "zitadel.admin.v1.AdminService",
+			},
+			Aggregate: &eventstore.Aggregate{
+				ID:            "org1",
+				Type:          "org",
+				ResourceOwner: "org1",
+			},
+			Sequence:     2,
+			CreationDate: time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+			Type:         "org.member.added",
+			Payload:      []byte(`{"userId":"user2","roles":["ORG_OWNER"]}`),
+		},
+		{
+			Aggregate: &eventstore.Aggregate{
+				ID:            "user2",
+				Type:          "user",
+				ResourceOwner: "org1",
+			},
+			Sequence:     1,
+			CreationDate: time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC),
+			Type:         "user.human.added",
+		},
+	}
+}
+
+func Test_eventsToCSV(t *testing.T) {
+	got, err := eventsToCSV(testExportEvents())
+	require.NoError(t, err)
+	assert.Equal(t, `creation_date,event_type,aggregate_type,aggregate_id,resource_owner,sequence,editor_user_id,editor_display_name,editor_service,payload
+2024-01-01T00:00:00Z,org.member.added,org,org1,org1,2,user1,"Jane, Doe",zitadel.admin.v1.AdminService,"{""userId"":""user2"",""roles"":[""ORG_OWNER""]}"
+2024-01-02T00:00:00Z,user.human.added,user,user2,org1,1,,,,
+`, string(got))
+}
+
+func Test_eventsToJSON(t *testing.T) {
+	got, err := eventsToJSON(testExportEvents())
+	require.NoError(t, err)
+	assert.JSONEq(t, `[
+		{"creation_date":"2024-01-01T00:00:00Z","event_type":"org.member.added","aggregate_type":"org","aggregate_id":"org1","resource_owner":"org1","sequence":2,"editor_user_id":"user1","editor_display_name":"Jane, Doe","editor_service":"zitadel.admin.v1.AdminService","payload":{"userId":"user2","roles":["ORG_OWNER"]}},
+		{"creation_date":"2024-01-02T00:00:00Z","event_type":"user.human.added","aggregate_type":"user","aggregate_id":"user2","resource_owner":"org1","sequence":1,"editor_user_id":"","editor_display_name":"","editor_service":""}
+	]`, string(got))
+}
@@ -0,0 +1,66 @@
+package admin
+
+import (
+	"slices"
+	"time"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	event_grpc "github.com/zitadel/zitadel/internal/api/grpc/event"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/query"
+	admin_pb "github.com/zitadel/zitadel/pkg/grpc/admin"
+)
+
+// eventSubscriptionInterval defines how often new events are polled
+const eventSubscriptionInterval = time.Second
+
+func (s *Server) SubscribeEvents(req *admin_pb.SubscribeEventsRequest, stream admin_pb.AdminService_SubscribeEventsServer) error {
+	ctx := stream.Context()
+	cursor, err := query.ParseEventCursor(req.GetCursor())
+	if err != nil {
+		return err
+	}
+	return s.query.SubscribeEvents(ctx, func() *eventstore.SearchQueryBuilder {
+		return subscribeEventsRequestToFilter(authz.GetInstance(ctx).InstanceID(), req)
+	}, cursor, eventSubscriptionInterval, func(event *query.Event, cursor query.EventCursor) error {
+		pb, err := event_grpc.EventToPb(event)
+		if err != nil {
+			return err
+		}
+		return stream.Send(&admin_pb.SubscribeEventsResponse{
+			Event:  pb,
+			Cursor: cursor.String(),
+		})
+	})
+}
+
+func subscribeEventsRequestToFilter(instanceID string, req *admin_pb.SubscribeEventsRequest) *eventstore.SearchQueryBuilder {
+	eventTypes := make([]eventstore.EventType, len(req.GetEventTypes()))
+	for i, eventType := range req.GetEventTypes() {
+		eventTypes[i] = eventstore.EventType(eventType)
+	}
+	aggregateTypes := make([]eventstore.AggregateType, len(req.GetAggregateTypes()))
+	for i, aggregateType := range req.GetAggregateTypes() {
+		aggregateTypes[i] = eventstore.AggregateType(aggregateType)
+	}
+	if len(aggregateTypes) == 0 {
+		aggregateTypes = aggregateTypesFromEventTypes(eventTypes)
+	}
+	aggregateTypes = slices.Compact(aggregateTypes)
+	var aggregateIDs []string
+	if req.GetAggregateId() != "" {
+		aggregateIDs = append(aggregateIDs, req.GetAggregateId())
+	}
+
+	builder := eventstore.NewSearchQueryBuilder(eventstore.ColumnsEvent).
+		InstanceID(instanceID).
+		ResourceOwner(req.GetResourceOwner())
+	if len(aggregateIDs) > 0 || len(aggregateTypes) > 0 || len(eventTypes) > 0 {
+		builder.AddQuery().
+			AggregateIDs(aggregateIDs...).
+			AggregateTypes(aggregateTypes...).
+			EventTypes(eventTypes...).
+			Builder()
+	}
+	return builder
+}
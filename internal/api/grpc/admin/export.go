@@ -400,6 +400,10 @@ func (s *Server) getLoginPolicy(ctx context.Context, orgID string, orgIDPs []str
 		mfaInitSkip := durationpb.New(time.Duration(queriedLogin.MFAInitSkipLifetime))
 		secondFactor := durationpb.New(time.Duration(queriedLogin.SecondFactorCheckLifetime))
 		multiFactor := durationpb.New(time.Duration(queriedLogin.MultiFactorCheckLifetime))
+		trustedDevice := durationpb.New(time.Duration(queriedLogin.TrustedDeviceLifetime))
+		sharedDevice := durationpb.New(time.Duration(queriedLogin.SharedDeviceLifetime))
+		sharedDeviceIdle := durationpb.New(time.Duration(queriedLogin.SharedDeviceIdleTimeout))
+		passkeyPromptSnooze := durationpb.New(time.Duration(queriedLogin.PasskeyPromptSnoozeLifetime))
 
 		secondFactors := []policy_pb.SecondFactorType{}
 		for _, factor := range queriedLogin.SecondFactors {
@@ -437,23 +441,29 @@ func (s *Server) getLoginPolicy(ctx context.Context, orgID string, orgIDPs []str
 		}
 
 		return &management_pb.AddCustomLoginPolicyRequest{
-			AllowUsernamePassword:      queriedLogin.AllowUsernamePassword,
-			AllowRegister:              queriedLogin.AllowRegister,
-			AllowExternalIdp:           queriedLogin.AllowExternalIDPs,
-			ForceMfa:                   queriedLogin.ForceMFA,
-			ForceMfaLocalOnly:          queriedLogin.ForceMFALocalOnly,
-			PasswordlessType:           policy_pb.PasswordlessType(queriedLogin.PasswordlessType),
-			HidePasswordReset:          queriedLogin.HidePasswordReset,
-			IgnoreUnknownUsernames:     queriedLogin.IgnoreUnknownUsernames,
-			DefaultRedirectUri:         queriedLogin.DefaultRedirectURI,
-			PasswordCheckLifetime:      pwCheck,
-			ExternalLoginCheckLifetime: externalLogin,
-			MfaInitSkipLifetime:        mfaInitSkip,
-			SecondFactorCheckLifetime:  secondFactor,
-			MultiFactorCheckLifetime:   multiFactor,
-			SecondFactors:              secondFactors,
-			MultiFactors:               multiFactors,
-			Idps:                       idpLinks,
+			AllowUsernamePassword:       queriedLogin.AllowUsernamePassword,
+			AllowRegister:               queriedLogin.AllowRegister,
+			AllowExternalIdp:            queriedLogin.AllowExternalIDPs,
+			ForceMfa:                    queriedLogin.ForceMFA,
+			ForceMfaLocalOnly:           queriedLogin.ForceMFALocalOnly,
+			PasswordlessType:            policy_pb.PasswordlessType(queriedLogin.PasswordlessType),
+			HidePasswordReset:           queriedLogin.HidePasswordReset,
+			IgnoreUnknownUsernames:      queriedLogin.IgnoreUnknownUsernames,
+			DefaultRedirectUri:          queriedLogin.DefaultRedirectURI,
+			PasswordCheckLifetime:       pwCheck,
+			ExternalLoginCheckLifetime:  externalLogin,
+			MfaInitSkipLifetime:         mfaInitSkip,
+			SecondFactorCheckLifetime:   secondFactor,
+			MultiFactorCheckLifetime:    multiFactor,
+			TrustedDeviceLifetime:       trustedDevice,
+			SharedDevice:                queriedLogin.SharedDevice,
+			SharedDeviceLifetime:        sharedDevice,
+			SharedDeviceIdleTimeout:     sharedDeviceIdle,
+			PasskeyPrompt:               queriedLogin.PasskeyPrompt,
+			PasskeyPromptSnoozeLifetime: passkeyPromptSnooze,
+			SecondFactors:               secondFactors,
+			MultiFactors:                multiFactors,
+			Idps:                        idpLinks,
 		}, nil
 	}
 
@@ -514,11 +524,12 @@ func (s *Server) getPasswordComplexityPolicy(ctx context.Context, orgID string)
 	}
 	if !queriedPasswordComplexity.IsDefault {
 		return &management_pb.AddCustomPasswordComplexityPolicyRequest{
-			MinLength:    queriedPasswordComplexity.MinLength,
-			HasUppercase: queriedPasswordComplexity.HasUppercase,
-			HasLowercase: queriedPasswordComplexity.HasLowercase,
-			HasNumber:    queriedPasswordComplexity.HasNumber,
-			HasSymbol:    queriedPasswordComplexity.HasSymbol,
+			MinLength:     queriedPasswordComplexity.MinLength,
+			HasUppercase:  queriedPasswordComplexity.HasUppercase,
+			HasLowercase:  queriedPasswordComplexity.HasLowercase,
+			HasNumber:     queriedPasswordComplexity.HasNumber,
+			HasSymbol:     queriedPasswordComplexity.HasSymbol,
+			CheckBreached: queriedPasswordComplexity.CheckBreached,
 		}, nil
 	}
 	return nil, nil
@@ -816,6 +827,8 @@ func (s *Server) getProjectsAndApps(ctx context.Context, org string) ([]*v1_pb.D
 						ClockSkew:                durationpb.New(app.OIDCConfig.ClockSkew),
 						AdditionalOrigins:        app.OIDCConfig.AdditionalOrigins,
 						SkipNativeAppSuccessPage: app.OIDCConfig.SkipNativeAppSuccessPage,
+						TlsClientAuthSubjectDn:   app.OIDCConfig.TLSClientAuthSubjectDN,
+						TlsClientAuthThumbprint:  app.OIDCConfig.TLSClientAuthThumbprint,
 					},
 				})
 			}
@@ -1064,10 +1077,15 @@ func (s *Server) getCustomLoginTexts(ctx context.Context, org string, languages
 				ExternalRegistrationUserOverviewText: text_grpc.ExternalRegistrationUserOverviewScreenTextToPb(text.ExternalRegistrationUserOverview),
 				RegistrationOrgText:                  text_grpc.RegistrationOrgScreenTextToPb(text.RegistrationOrg),
 				LinkingUserPromptText:                text_grpc.LinkingUserPromptScreenTextToPb(text.LinkingUserPrompt),
+				UsernameRecoveryText:                 text_grpc.UsernameRecoveryScreenTextToPb(text.UsernameRecovery),
+				UsernameRecoveryDoneText:             text_grpc.UsernameRecoveryDoneScreenTextToPb(text.UsernameRecoveryDone),
+				PasskeyPromptText:                    text_grpc.PasskeyPromptScreenTextToPb(text.PasskeyPrompt),
+				LinkedIdpsText:                       text_grpc.LinkedIDPsScreenTextToPb(text.LinkedIDPs),
 				LinkingUserDoneText:                  text_grpc.LinkingUserDoneScreenTextToPb(text.LinkingUsersDone),
 				ExternalUserNotFoundText:             text_grpc.ExternalUserNotFoundScreenTextToPb(text.ExternalNotFound),
 				SuccessLoginText:                     text_grpc.SuccessLoginScreenTextToPb(text.LoginSuccess),
 				LogoutText:                           text_grpc.LogoutDoneScreenTextToPb(text.LogoutDone),
+				LogoutConfirmationText:               text_grpc.LogoutConfirmationScreenTextToPb(text.LogoutConfirmation),
 				FooterText:                           text_grpc.FooterTextToPb(text.Footer),
 			})
 		}
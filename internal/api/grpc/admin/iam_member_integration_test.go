@@ -24,6 +24,7 @@ var iamRoles = []string{
 	"IAM_USER_MANAGER",
 	"IAM_ADMIN_IMPERSONATOR",
 	"IAM_END_USER_IMPERSONATOR",
+	"IAM_SUPPORT_IMPERSONATOR",
 }
 
 func TestServer_ListIAMMemberRoles(t *testing.T) {
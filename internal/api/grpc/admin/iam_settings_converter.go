@@ -183,6 +183,7 @@ func SecurityPolicyToPb(policy *query.SecurityPolicy) *settings_pb.SecurityPolic
 		EnableIframeEmbedding: policy.EnableIframeEmbedding,
 		AllowedOrigins:        policy.AllowedOrigins,
 		EnableImpersonation:   policy.EnableImpersonation,
+		ActionsHttpAllowList:  policy.ActionsHTTPAllowList,
 	}
 }
 
@@ -191,5 +192,6 @@ func securityPolicyToCommand(req *admin_pb.SetSecurityPolicyRequest) *command.Se
 		EnableIframeEmbedding: req.GetEnableIframeEmbedding(),
 		AllowedOrigins:        req.GetAllowedOrigins(),
 		EnableImpersonation:   req.GetEnableImpersonation(),
+		ActionsHTTPAllowList:  req.GetActionsHttpAllowList(),
 	}
 }
@@ -2,6 +2,9 @@ package admin
 
 import (
 	"context"
+	"time"
+
+	"google.golang.org/protobuf/types/known/structpb"
 
 	"github.com/zitadel/zitadel/internal/api/authz"
 	idp_grpc "github.com/zitadel/zitadel/internal/api/grpc/idp"
@@ -200,6 +203,21 @@ func (s *Server) UpdateGenericOAuthProvider(ctx context.Context, req *admin_pb.U
 	}, nil
 }
 
+func (s *Server) TestGenericOAuthProviderAttributeMapping(ctx context.Context, req *admin_pb.TestGenericOAuthProviderAttributeMappingRequest) (*admin_pb.TestGenericOAuthProviderAttributeMappingResponse, error) {
+	result, err := s.command.TestInstanceGenericOAuthProviderAttributeMapping(ctx, req.Id, req.AccessToken, idp_grpc.OAuthAttributeMappingToDomain(req.AttributeMapping))
+	if err != nil {
+		return nil, err
+	}
+	rawInfo, err := structpb.NewStruct(result.RawInfo)
+	if err != nil {
+		return nil, err
+	}
+	return &admin_pb.TestGenericOAuthProviderAttributeMappingResponse{
+		RawInfo: rawInfo,
+		User:    idp_grpc.OAuthMappedUserToPb(result.User),
+	}, nil
+}
+
 func (s *Server) AddGenericOIDCProvider(ctx context.Context, req *admin_pb.AddGenericOIDCProviderRequest) (*admin_pb.AddGenericOIDCProviderResponse, error) {
 	id, details, err := s.command.AddInstanceGenericOIDCProvider(ctx, addGenericOIDCProviderToCommand(req))
 	if err != nil {
@@ -466,3 +484,56 @@ func (s *Server) DeleteProvider(ctx context.Context, req *admin_pb.DeleteProvide
 		Details: object_pb.DomainToChangeDetailsPb(details),
 	}, nil
 }
+
+func (s *Server) GetProviderRoleMapping(ctx context.Context, req *admin_pb.GetProviderRoleMappingRequest) (*admin_pb.GetProviderRoleMappingResponse, error) {
+	mapping, err := s.query.IDPRoleMappingByID(ctx, req.Id, authz.GetInstance(ctx).InstanceID())
+	if err != nil {
+		return nil, err
+	}
+	return &admin_pb.GetProviderRoleMappingResponse{
+		Details:  object_pb.ToViewDetailsPb(mapping.Sequence, time.Time{}, mapping.ChangeDate, mapping.ResourceOwner),
+		Mappings: idp_grpc.RoleMappingsToPb(mapping.Mappings),
+	}, nil
+}
+
+func (s *Server) SetProviderRoleMapping(ctx context.Context, req *admin_pb.SetProviderRoleMappingRequest) (*admin_pb.SetProviderRoleMappingResponse, error) {
+	details, err := s.command.SetInstanceIDPRoleMapping(ctx, req.Id, idp_grpc.RoleMappingsToDomain(req.Mappings))
+	if err != nil {
+		return nil, err
+	}
+	return &admin_pb.SetProviderRoleMappingResponse{
+		Details: object_pb.DomainToChangeDetailsPb(details),
+	}, nil
+}
+
+func (s *Server) GetProviderAutoGrants(ctx context.Context, req *admin_pb.GetProviderAutoGrantsRequest) (*admin_pb.GetProviderAutoGrantsResponse, error) {
+	grants, err := s.query.IDPAutoGrantsByID(ctx, req.Id, authz.GetInstance(ctx).InstanceID())
+	if err != nil {
+		return nil, err
+	}
+	return &admin_pb.GetProviderAutoGrantsResponse{
+		Details: object_pb.ToViewDetailsPb(grants.Sequence, time.Time{}, grants.ChangeDate, grants.ResourceOwner),
+		Grants:  idp_grpc.AutoGrantsToPb(grants.Grants),
+	}, nil
+}
+
+func (s *Server) SetProviderAutoGrants(ctx context.Context, req *admin_pb.SetProviderAutoGrantsRequest) (*admin_pb.SetProviderAutoGrantsResponse, error) {
+	details, err := s.command.SetInstanceIDPAutoGrants(ctx, req.Id, idp_grpc.AutoGrantsToDomain(req.Grants))
+	if err != nil {
+		return nil, err
+	}
+	return &admin_pb.SetProviderAutoGrantsResponse{
+		Details: object_pb.DomainToChangeDetailsPb(details),
+	}, nil
+}
+
+func (s *Server) TestProvider(ctx context.Context, req *admin_pb.TestProviderRequest) (*admin_pb.TestProviderResponse, error) {
+	health, err := s.command.TestInstanceIDP(ctx, req.Id)
+	if err != nil {
+		return nil, err
+	}
+	return &admin_pb.TestProviderResponse{
+		Healthy: health.Healthy(),
+		Checks:  idp_grpc.HealthChecksToPb(health.Checks, health.Failed),
+	}, nil
+}
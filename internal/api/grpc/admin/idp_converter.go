@@ -215,6 +215,7 @@ func addGenericOAuthProviderToCommand(req *admin_pb.AddGenericOAuthProviderReque
 		UserEndpoint:          req.UserEndpoint,
 		Scopes:                req.Scopes,
 		IDAttribute:           req.IdAttribute,
+		AttributeMapping:      idp_grpc.OAuthAttributeMappingToDomain(req.AttributeMapping),
 		IDPOptions:            idp_grpc.OptionsToCommand(req.ProviderOptions),
 	}
 }
@@ -229,6 +230,7 @@ func updateGenericOAuthProviderToCommand(req *admin_pb.UpdateGenericOAuthProvide
 		UserEndpoint:          req.UserEndpoint,
 		Scopes:                req.Scopes,
 		IDAttribute:           req.IdAttribute,
+		AttributeMapping:      idp_grpc.OAuthAttributeMappingToDomain(req.AttributeMapping),
 		IDPOptions:            idp_grpc.OptionsToCommand(req.ProviderOptions),
 	}
 }
@@ -281,25 +283,29 @@ func updateJWTProviderToCommand(req *admin_pb.UpdateJWTProviderRequest) command.
 
 func addAzureADProviderToCommand(req *admin_pb.AddAzureADProviderRequest) command.AzureADProvider {
 	return command.AzureADProvider{
-		Name:          req.Name,
-		ClientID:      req.ClientId,
-		ClientSecret:  req.ClientSecret,
-		Scopes:        req.Scopes,
-		Tenant:        idp_grpc.AzureADTenantToCommand(req.Tenant),
-		EmailVerified: req.EmailVerified,
-		IDPOptions:    idp_grpc.OptionsToCommand(req.ProviderOptions),
+		Name:           req.Name,
+		ClientID:       req.ClientId,
+		ClientSecret:   req.ClientSecret,
+		Scopes:         req.Scopes,
+		Tenant:         idp_grpc.AzureADTenantToCommand(req.Tenant),
+		EmailVerified:  req.EmailVerified,
+		AllowedTenants: req.AllowedTenants,
+		FetchGroups:    req.FetchGroups,
+		IDPOptions:     idp_grpc.OptionsToCommand(req.ProviderOptions),
 	}
 }
 
 func updateAzureADProviderToCommand(req *admin_pb.UpdateAzureADProviderRequest) command.AzureADProvider {
 	return command.AzureADProvider{
-		Name:          req.Name,
-		ClientID:      req.ClientId,
-		ClientSecret:  req.ClientSecret,
-		Scopes:        req.Scopes,
-		Tenant:        idp_grpc.AzureADTenantToCommand(req.Tenant),
-		EmailVerified: req.EmailVerified,
-		IDPOptions:    idp_grpc.OptionsToCommand(req.ProviderOptions),
+		Name:           req.Name,
+		ClientID:       req.ClientId,
+		ClientSecret:   req.ClientSecret,
+		Scopes:         req.Scopes,
+		Tenant:         idp_grpc.AzureADTenantToCommand(req.Tenant),
+		EmailVerified:  req.EmailVerified,
+		AllowedTenants: req.AllowedTenants,
+		FetchGroups:    req.FetchGroups,
+		IDPOptions:     idp_grpc.OptionsToCommand(req.ProviderOptions),
 	}
 }
 
@@ -413,35 +419,43 @@ func updateGoogleProviderToCommand(req *admin_pb.UpdateGoogleProviderRequest) co
 
 func addLDAPProviderToCommand(req *admin_pb.AddLDAPProviderRequest) command.LDAPProvider {
 	return command.LDAPProvider{
-		Name:              req.Name,
-		Servers:           req.Servers,
-		StartTLS:          req.StartTls,
-		BaseDN:            req.BaseDn,
-		BindDN:            req.BindDn,
-		BindPassword:      req.BindPassword,
-		UserBase:          req.UserBase,
-		UserObjectClasses: req.UserObjectClasses,
-		UserFilters:       req.UserFilters,
-		Timeout:           req.Timeout.AsDuration(),
-		LDAPAttributes:    idp_grpc.LDAPAttributesToCommand(req.Attributes),
-		IDPOptions:        idp_grpc.OptionsToCommand(req.ProviderOptions),
+		Name:               req.Name,
+		Servers:            req.Servers,
+		StartTLS:           req.StartTls,
+		BaseDN:             req.BaseDn,
+		BindDN:             req.BindDn,
+		BindPassword:       req.BindPassword,
+		UserBase:           req.UserBase,
+		UserObjectClasses:  req.UserObjectClasses,
+		UserFilters:        req.UserFilters,
+		Timeout:            req.Timeout.AsDuration(),
+		LDAPAttributes:     idp_grpc.LDAPAttributesToCommand(req.Attributes),
+		IDPOptions:         idp_grpc.OptionsToCommand(req.ProviderOptions),
+		RootCA:             req.RootCa,
+		GroupResolution:    idp_grpc.LDAPGroupResolutionToDomain(req.GroupResolution),
+		GroupBase:          req.GroupBase,
+		GroupNameAttribute: req.GroupNameAttribute,
 	}
 }
 
 func updateLDAPProviderToCommand(req *admin_pb.UpdateLDAPProviderRequest) command.LDAPProvider {
 	return command.LDAPProvider{
-		Name:              req.Name,
-		Servers:           req.Servers,
-		StartTLS:          req.StartTls,
-		BaseDN:            req.BaseDn,
-		BindDN:            req.BindDn,
-		BindPassword:      req.BindPassword,
-		UserBase:          req.UserBase,
-		UserObjectClasses: req.UserObjectClasses,
-		UserFilters:       req.UserFilters,
-		Timeout:           req.Timeout.AsDuration(),
-		LDAPAttributes:    idp_grpc.LDAPAttributesToCommand(req.Attributes),
-		IDPOptions:        idp_grpc.OptionsToCommand(req.ProviderOptions),
+		Name:               req.Name,
+		Servers:            req.Servers,
+		StartTLS:           req.StartTls,
+		BaseDN:             req.BaseDn,
+		BindDN:             req.BindDn,
+		BindPassword:       req.BindPassword,
+		UserBase:           req.UserBase,
+		UserObjectClasses:  req.UserObjectClasses,
+		UserFilters:        req.UserFilters,
+		Timeout:            req.Timeout.AsDuration(),
+		LDAPAttributes:     idp_grpc.LDAPAttributesToCommand(req.Attributes),
+		IDPOptions:         idp_grpc.OptionsToCommand(req.ProviderOptions),
+		RootCA:             req.RootCa,
+		GroupResolution:    idp_grpc.LDAPGroupResolutionToDomain(req.GroupResolution),
+		GroupBase:          req.GroupBase,
+		GroupNameAttribute: req.GroupNameAttribute,
 	}
 }
 
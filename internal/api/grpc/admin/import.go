@@ -1265,6 +1265,10 @@ func (s *Server) dataOrgsV1ToDataOrgs(ctx context.Context, dataOrgs *v1_pb.Impor
 			org.LoginPolicy.SecondFactorCheckLifetime = durationpb.New(time.Duration(defaultLoginPolicy.SecondFactorCheckLifetime))
 			org.LoginPolicy.PasswordCheckLifetime = durationpb.New(time.Duration(defaultLoginPolicy.PasswordCheckLifetime))
 			org.LoginPolicy.MfaInitSkipLifetime = durationpb.New(time.Duration(defaultLoginPolicy.MFAInitSkipLifetime))
+			org.LoginPolicy.TrustedDeviceLifetime = durationpb.New(time.Duration(defaultLoginPolicy.TrustedDeviceLifetime))
+			org.LoginPolicy.SharedDeviceLifetime = durationpb.New(time.Duration(defaultLoginPolicy.SharedDeviceLifetime))
+			org.LoginPolicy.SharedDeviceIdleTimeout = durationpb.New(time.Duration(defaultLoginPolicy.SharedDeviceIdleTimeout))
+			org.LoginPolicy.PasskeyPromptSnoozeLifetime = durationpb.New(time.Duration(defaultLoginPolicy.PasskeyPromptSnoozeLifetime))
 
 			if orgV1.SecondFactors != nil {
 				org.LoginPolicy.SecondFactors = make([]policy.SecondFactorType, len(orgV1.SecondFactors))
@@ -19,6 +19,7 @@ func updateLabelPolicyToDomain(policy *admin_pb.UpdateLabelPolicyRequest) *domai
 		HideLoginNameSuffix: policy.HideLoginNameSuffix,
 		DisableWatermark:    policy.DisableWatermark,
 		ThemeMode:           themeModeToDomain(policy.ThemeMode),
+		CustomCSS:           policy.CustomCss,
 	}
 }
 
@@ -2,6 +2,7 @@ package admin
 
 import (
 	"context"
+	"time"
 
 	"github.com/zitadel/zitadel/internal/api/authz"
 	"github.com/zitadel/zitadel/internal/api/grpc/idp"
@@ -68,6 +69,37 @@ func (s *Server) RemoveIDPFromLoginPolicy(ctx context.Context, req *admin_pb.Rem
 	}, nil
 }
 
+func (s *Server) GetLoginPolicyIDPButtons(ctx context.Context, req *admin_pb.GetLoginPolicyIDPButtonsRequest) (*admin_pb.GetLoginPolicyIDPButtonsResponse, error) {
+	buttons, err := s.query.LoginPolicyIDPButtonsByOwner(ctx, authz.GetInstance(ctx).InstanceID())
+	if err != nil {
+		return nil, err
+	}
+	return &admin_pb.GetLoginPolicyIDPButtonsResponse{
+		Details: object.ToViewDetailsPb(buttons.Sequence, time.Time{}, buttons.ChangeDate, buttons.ResourceOwner),
+		Buttons: idp.IDPButtonsToPb(buttons.Buttons, buttons.Icons),
+	}, nil
+}
+
+func (s *Server) SetLoginPolicyIDPButtons(ctx context.Context, req *admin_pb.SetLoginPolicyIDPButtonsRequest) (*admin_pb.SetLoginPolicyIDPButtonsResponse, error) {
+	objectDetails, err := s.command.SetDefaultLoginPolicyIDPButtons(ctx, idp.IDPButtonsToDomain(req.Buttons))
+	if err != nil {
+		return nil, err
+	}
+	return &admin_pb.SetLoginPolicyIDPButtonsResponse{
+		Details: object.DomainToChangeDetailsPb(objectDetails),
+	}, nil
+}
+
+func (s *Server) RemoveLoginPolicyIDPIcon(ctx context.Context, req *admin_pb.RemoveLoginPolicyIDPIconRequest) (*admin_pb.RemoveLoginPolicyIDPIconResponse, error) {
+	objectDetails, err := s.command.RemoveDefaultLoginPolicyIDPIcon(ctx, req.IdpId)
+	if err != nil {
+		return nil, err
+	}
+	return &admin_pb.RemoveLoginPolicyIDPIconResponse{
+		Details: object.DomainToChangeDetailsPb(objectDetails),
+	}, nil
+}
+
 func (s *Server) ListLoginPolicySecondFactors(ctx context.Context, req *admin_pb.ListLoginPolicySecondFactorsRequest) (*admin_pb.ListLoginPolicySecondFactorsResponse, error) {
 	result, err := s.query.DefaultSecondFactors(ctx)
 	if err != nil {
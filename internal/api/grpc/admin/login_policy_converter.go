@@ -10,23 +10,29 @@ import (
 
 func updateLoginPolicyToCommand(p *admin_pb.UpdateLoginPolicyRequest) *command.ChangeLoginPolicy {
 	return &command.ChangeLoginPolicy{
-		AllowUsernamePassword:      p.AllowUsernamePassword,
-		AllowRegister:              p.AllowRegister,
-		AllowExternalIDP:           p.AllowExternalIdp,
-		ForceMFA:                   p.ForceMfa,
-		ForceMFALocalOnly:          p.ForceMfaLocalOnly,
-		PasswordlessType:           policy_grpc.PasswordlessTypeToDomain(p.PasswordlessType),
-		HidePasswordReset:          p.HidePasswordReset,
-		IgnoreUnknownUsernames:     p.IgnoreUnknownUsernames,
-		AllowDomainDiscovery:       p.AllowDomainDiscovery,
-		DisableLoginWithEmail:      p.DisableLoginWithEmail,
-		DisableLoginWithPhone:      p.DisableLoginWithPhone,
-		DefaultRedirectURI:         p.DefaultRedirectUri,
-		PasswordCheckLifetime:      p.PasswordCheckLifetime.AsDuration(),
-		ExternalLoginCheckLifetime: p.ExternalLoginCheckLifetime.AsDuration(),
-		MFAInitSkipLifetime:        p.MfaInitSkipLifetime.AsDuration(),
-		SecondFactorCheckLifetime:  p.SecondFactorCheckLifetime.AsDuration(),
-		MultiFactorCheckLifetime:   p.MultiFactorCheckLifetime.AsDuration(),
+		AllowUsernamePassword:       p.AllowUsernamePassword,
+		AllowRegister:               p.AllowRegister,
+		AllowExternalIDP:            p.AllowExternalIdp,
+		ForceMFA:                    p.ForceMfa,
+		ForceMFALocalOnly:           p.ForceMfaLocalOnly,
+		PasswordlessType:            policy_grpc.PasswordlessTypeToDomain(p.PasswordlessType),
+		HidePasswordReset:           p.HidePasswordReset,
+		IgnoreUnknownUsernames:      p.IgnoreUnknownUsernames,
+		AllowDomainDiscovery:        p.AllowDomainDiscovery,
+		DisableLoginWithEmail:       p.DisableLoginWithEmail,
+		DisableLoginWithPhone:       p.DisableLoginWithPhone,
+		DefaultRedirectURI:          p.DefaultRedirectUri,
+		PasswordCheckLifetime:       p.PasswordCheckLifetime.AsDuration(),
+		ExternalLoginCheckLifetime:  p.ExternalLoginCheckLifetime.AsDuration(),
+		MFAInitSkipLifetime:         p.MfaInitSkipLifetime.AsDuration(),
+		SecondFactorCheckLifetime:   p.SecondFactorCheckLifetime.AsDuration(),
+		MultiFactorCheckLifetime:    p.MultiFactorCheckLifetime.AsDuration(),
+		TrustedDeviceLifetime:       p.TrustedDeviceLifetime.AsDuration(),
+		SharedDevice:                p.SharedDevice,
+		SharedDeviceLifetime:        p.SharedDeviceLifetime.AsDuration(),
+		SharedDeviceIdleTimeout:     p.SharedDeviceIdleTimeout.AsDuration(),
+		PasskeyPrompt:               p.PasskeyPrompt,
+		PasskeyPromptSnoozeLifetime: p.PasskeyPromptSnoozeLifetime.AsDuration(),
 	}
 }
 
@@ -0,0 +1,18 @@
+package admin
+
+import (
+	"context"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	admin_pb "github.com/zitadel/zitadel/pkg/grpc/admin"
+)
+
+func (s *Server) GetLogout(ctx context.Context, req *admin_pb.GetLogoutRequest) (*admin_pb.GetLogoutResponse, error) {
+	logout, err := s.query.LogoutByID(ctx, req.LogoutId, authz.GetInstance(ctx).InstanceID())
+	if err != nil {
+		return nil, err
+	}
+	return &admin_pb.GetLogoutResponse{
+		Logout: logoutToPb(logout),
+	}, nil
+}
@@ -0,0 +1,68 @@
+package admin
+
+import (
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/zitadel/zitadel/internal/api/grpc/object"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/query"
+	admin_pb "github.com/zitadel/zitadel/pkg/grpc/admin"
+)
+
+func logoutToPb(logout *query.Logout) *admin_pb.Logout {
+	return &admin_pb.Logout{
+		Id:                    logout.ID,
+		Details:               object.ToViewDetailsPb(logout.Sequence, logout.CreationDate, logout.ChangeDate, logout.ResourceOwner),
+		UserId:                logout.UserID,
+		SessionId:             logout.SessionID,
+		PostLogoutRedirectUri: logout.PostLogoutRedirectURI,
+		Targets:               logoutTargetsToPb(logout.Targets),
+	}
+}
+
+func logoutTargetsToPb(targets []*query.LogoutTarget) []*admin_pb.LogoutTarget {
+	t := make([]*admin_pb.LogoutTarget, len(targets))
+	for i, target := range targets {
+		t[i] = &admin_pb.LogoutTarget{
+			Id:         target.ID,
+			Type:       logoutTargetTypeToPb(target.Type),
+			Uri:        target.URI,
+			State:      logoutTargetStateToPb(target.State),
+			Reason:     target.Reason,
+			ChangeDate: timestamppb.New(target.ChangeDate),
+		}
+	}
+	return t
+}
+
+func logoutTargetTypeToPb(targetType domain.LogoutTargetType) admin_pb.LogoutTargetType {
+	switch targetType {
+	case domain.LogoutTargetTypeOIDCBackChannel:
+		return admin_pb.LogoutTargetType_LOGOUT_TARGET_TYPE_OIDC_BACK_CHANNEL
+	case domain.LogoutTargetTypeOIDCFrontChannel:
+		return admin_pb.LogoutTargetType_LOGOUT_TARGET_TYPE_OIDC_FRONT_CHANNEL
+	case domain.LogoutTargetTypeSAMLIDP:
+		return admin_pb.LogoutTargetType_LOGOUT_TARGET_TYPE_SAML_IDP
+	case domain.LogoutTargetTypeUnspecified:
+		return admin_pb.LogoutTargetType_LOGOUT_TARGET_TYPE_UNSPECIFIED
+	default:
+		return admin_pb.LogoutTargetType_LOGOUT_TARGET_TYPE_UNSPECIFIED
+	}
+}
+
+func logoutTargetStateToPb(state domain.LogoutTargetState) admin_pb.LogoutTargetState {
+	switch state {
+	case domain.LogoutTargetStatePending:
+		return admin_pb.LogoutTargetState_LOGOUT_TARGET_STATE_PENDING
+	case domain.LogoutTargetStateSucceeded:
+		return admin_pb.LogoutTargetState_LOGOUT_TARGET_STATE_SUCCEEDED
+	case domain.LogoutTargetStateFailed:
+		return admin_pb.LogoutTargetState_LOGOUT_TARGET_STATE_FAILED
+	case domain.LogoutTargetStateDelegated:
+		return admin_pb.LogoutTargetState_LOGOUT_TARGET_STATE_DELEGATED
+	case domain.LogoutTargetStateUnspecified:
+		return admin_pb.LogoutTargetState_LOGOUT_TARGET_STATE_UNSPECIFIED
+	default:
+		return admin_pb.LogoutTargetState_LOGOUT_TARGET_STATE_UNSPECIFIED
+	}
+}
@@ -0,0 +1,33 @@
+package admin
+
+import (
+	"context"
+
+	"github.com/zitadel/zitadel/internal/api/grpc/object"
+	admin_pb "github.com/zitadel/zitadel/pkg/grpc/admin"
+)
+
+func (s *Server) ListFailedNotifications(ctx context.Context, req *admin_pb.ListFailedNotificationsRequest) (*admin_pb.ListFailedNotificationsResponse, error) {
+	queries, err := listFailedNotificationsToModel(req)
+	if err != nil {
+		return nil, err
+	}
+	result, err := s.query.SearchQueuedNotifications(ctx, nil, queries)
+	if err != nil {
+		return nil, err
+	}
+	return &admin_pb.ListFailedNotificationsResponse{
+		Details: object.ToListDetails(result.Count, result.Sequence, result.LastRun),
+		Result:  QueuedNotificationsToPb(result.QueuedNotifications),
+	}, nil
+}
+
+func (s *Server) RequeueNotification(ctx context.Context, req *admin_pb.RequeueNotificationRequest) (*admin_pb.RequeueNotificationResponse, error) {
+	details, err := s.command.RequeueNotification(ctx, req.Id)
+	if err != nil {
+		return nil, err
+	}
+	return &admin_pb.RequeueNotificationResponse{
+		Details: object.DomainToChangeDetailsPb(details),
+	}, nil
+}
@@ -0,0 +1,76 @@
+package admin
+
+import (
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/zitadel/zitadel/internal/api/grpc/object"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/query"
+	admin_pb "github.com/zitadel/zitadel/pkg/grpc/admin"
+	settings_pb "github.com/zitadel/zitadel/pkg/grpc/settings"
+)
+
+func listFailedNotificationsToModel(req *admin_pb.ListFailedNotificationsRequest) (*query.QueuedNotificationSearchQueries, error) {
+	offset, limit, asc := object.ListQueryToModel(req.Query)
+	queries := &query.QueuedNotificationSearchQueries{
+		SearchRequest: query.SearchRequest{
+			Offset:        offset,
+			Limit:         limit,
+			Asc:           asc,
+			SortingColumn: query.QueuedNotificationColumnCreationDate,
+		},
+	}
+	if state := queuedNotificationStateToDomain(req.State); state != domain.NotificationQueueStateUnspecified {
+		stateQuery, err := query.NewQueuedNotificationStateSearchQuery(state)
+		if err != nil {
+			return nil, err
+		}
+		queries.Queries = append(queries.Queries, stateQuery)
+	}
+	return queries, nil
+}
+
+func QueuedNotificationsToPb(notifications []*query.QueuedNotification) []*settings_pb.QueuedNotification {
+	n := make([]*settings_pb.QueuedNotification, len(notifications))
+	for i, notification := range notifications {
+		n[i] = QueuedNotificationToPb(notification)
+	}
+	return n
+}
+
+func QueuedNotificationToPb(notification *query.QueuedNotification) *settings_pb.QueuedNotification {
+	return &settings_pb.QueuedNotification{
+		Details:               object.ToViewDetailsPb(notification.Sequence, notification.CreationDate, notification.ChangeDate, notification.InstanceID),
+		Id:                    notification.ID,
+		Channel:               notification.Channel,
+		Recipient:             notification.Recipient,
+		TriggeringAggregateId: notification.TriggeringAggregateID,
+		TriggeringEventType:   string(notification.TriggeringEventType),
+		State:                 queuedNotificationStateToPb(notification.State),
+		Attempts:              uint32(notification.Attempts),
+		NextAttempt:           timestamppb.New(notification.NextAttempt),
+		LastError:             notification.LastError,
+	}
+}
+
+func queuedNotificationStateToPb(state domain.NotificationQueueState) settings_pb.QueuedNotificationState {
+	switch state {
+	case domain.NotificationQueueStatePending:
+		return settings_pb.QueuedNotificationState_QUEUED_NOTIFICATION_STATE_PENDING
+	case domain.NotificationQueueStateDeadLettered:
+		return settings_pb.QueuedNotificationState_QUEUED_NOTIFICATION_STATE_DEAD_LETTERED
+	default:
+		return settings_pb.QueuedNotificationState_QUEUED_NOTIFICATION_STATE_UNSPECIFIED
+	}
+}
+
+func queuedNotificationStateToDomain(state settings_pb.QueuedNotificationState) domain.NotificationQueueState {
+	switch state {
+	case settings_pb.QueuedNotificationState_QUEUED_NOTIFICATION_STATE_PENDING:
+		return domain.NotificationQueueStatePending
+	case settings_pb.QueuedNotificationState_QUEUED_NOTIFICATION_STATE_DEAD_LETTERED:
+		return domain.NotificationQueueStateDeadLettered
+	default:
+		return domain.NotificationQueueStateUnspecified
+	}
+}
@@ -0,0 +1,142 @@
+package admin
+
+import (
+	"context"
+
+	"golang.org/x/text/language"
+
+	"github.com/zitadel/zitadel/internal/api/grpc/object"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/i18n"
+	"github.com/zitadel/zitadel/internal/notification/types"
+	"github.com/zitadel/zitadel/internal/zerrors"
+	admin_pb "github.com/zitadel/zitadel/pkg/grpc/admin"
+)
+
+const (
+	previewURL         = "https://example.com/verify"
+	previewDisplayName = "Jane Doe"
+)
+
+func (s *Server) ListNotificationTemplates(ctx context.Context, _ *admin_pb.ListNotificationTemplatesRequest) (*admin_pb.ListNotificationTemplatesResponse, error) {
+	templates, err := s.query.NotificationTemplates(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &admin_pb.ListNotificationTemplatesResponse{
+		Layouts:  NotificationLayoutsToPb(templates.Layouts),
+		Partials: NotificationTemplatePartialsToPb(templates.Partials),
+	}, nil
+}
+
+func (s *Server) GetNotificationLayout(ctx context.Context, req *admin_pb.GetNotificationLayoutRequest) (*admin_pb.GetNotificationLayoutResponse, error) {
+	templates, err := s.query.NotificationTemplates(ctx)
+	if err != nil {
+		return nil, err
+	}
+	layout, err := templates.Layout(req.Language)
+	if err != nil {
+		return nil, err
+	}
+	return &admin_pb.GetNotificationLayoutResponse{
+		Layout: NotificationLayoutToPb(layout),
+	}, nil
+}
+
+func (s *Server) GetNotificationTemplatePartial(ctx context.Context, req *admin_pb.GetNotificationTemplatePartialRequest) (*admin_pb.GetNotificationTemplatePartialResponse, error) {
+	templates, err := s.query.NotificationTemplates(ctx)
+	if err != nil {
+		return nil, err
+	}
+	partial, err := templates.Partial(req.Name)
+	if err != nil {
+		return nil, err
+	}
+	return &admin_pb.GetNotificationTemplatePartialResponse{
+		Partial: NotificationTemplatePartialToPb(partial),
+	}, nil
+}
+
+func (s *Server) SetNotificationLayout(ctx context.Context, req *admin_pb.SetNotificationLayoutRequest) (*admin_pb.SetNotificationLayoutResponse, error) {
+	details, err := s.command.SetNotificationLayout(ctx, setNotificationLayoutToCommand(req))
+	if err != nil {
+		return nil, err
+	}
+	return &admin_pb.SetNotificationLayoutResponse{
+		Details: object.DomainToChangeDetailsPb(details),
+	}, nil
+}
+
+func (s *Server) RemoveNotificationLayout(ctx context.Context, req *admin_pb.RemoveNotificationLayoutRequest) (*admin_pb.RemoveNotificationLayoutResponse, error) {
+	details, err := s.command.RemoveNotificationLayout(ctx, req.Language)
+	if err != nil {
+		return nil, err
+	}
+	return &admin_pb.RemoveNotificationLayoutResponse{
+		Details: object.DomainToChangeDetailsPb(details),
+	}, nil
+}
+
+func (s *Server) SetNotificationTemplatePartial(ctx context.Context, req *admin_pb.SetNotificationTemplatePartialRequest) (*admin_pb.SetNotificationTemplatePartialResponse, error) {
+	details, err := s.command.SetNotificationTemplatePartial(ctx, req.Name, req.Content)
+	if err != nil {
+		return nil, err
+	}
+	return &admin_pb.SetNotificationTemplatePartialResponse{
+		Details: object.DomainToChangeDetailsPb(details),
+	}, nil
+}
+
+func (s *Server) RemoveNotificationTemplatePartial(ctx context.Context, req *admin_pb.RemoveNotificationTemplatePartialRequest) (*admin_pb.RemoveNotificationTemplatePartialResponse, error) {
+	details, err := s.command.RemoveNotificationTemplatePartial(ctx, req.Name)
+	if err != nil {
+		return nil, err
+	}
+	return &admin_pb.RemoveNotificationTemplatePartialResponse{
+		Details: object.DomainToChangeDetailsPb(details),
+	}, nil
+}
+
+// PreviewNotificationTemplate renders the layout of the requested language with the texts of the verify email message
+func (s *Server) PreviewNotificationTemplate(ctx context.Context, req *admin_pb.PreviewNotificationTemplateRequest) (*admin_pb.PreviewNotificationTemplateResponse, error) {
+	lang := s.query.GetDefaultLanguage(ctx)
+	if req.Language != "" {
+		tag, err := language.Parse(req.Language)
+		if err != nil {
+			return nil, zerrors.ThrowInvalidArgument(err, "ADMIN-t4gz7mqc2e", "Errors.NotificationTemplate.InvalidLanguage")
+		}
+		lang = tag
+	}
+	mailTemplate, err := s.query.DefaultMailTemplate(ctx)
+	if err != nil {
+		return nil, err
+	}
+	notificationTemplates, err := s.query.NotificationTemplates(ctx)
+	if err != nil {
+		return nil, err
+	}
+	policy, err := s.query.DefaultActiveLabelPolicy(ctx)
+	if err != nil {
+		return nil, err
+	}
+	translator, err := i18n.NewNotificationTranslator(lang, nil)
+	if err != nil {
+		return nil, err
+	}
+	set := notificationTemplates.TemplateSet(string(mailTemplate.Template))
+	if req.Layout != nil {
+		set.Layouts[lang.String()] = notificationLayoutPbToTemplate(req.Layout)
+	}
+	data := types.GetTemplateData(ctx, translator, map[string]interface{}{
+		"DisplayName": previewDisplayName,
+		"Code":        "123456",
+	}, previewURL, domain.VerifyEmailMessageType, lang.String(), policy)
+	message, err := set.Layout(lang.String()).Render(set.Partials, &data)
+	if err != nil {
+		return nil, zerrors.ThrowInvalidArgument(err, "ADMIN-y8kw1rnb3v", "Errors.NotificationTemplate.Invalid")
+	}
+	return &admin_pb.PreviewNotificationTemplateResponse{
+		Html: message.HTML,
+		Text: message.Text,
+	}, nil
+}
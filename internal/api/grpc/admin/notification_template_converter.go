@@ -0,0 +1,61 @@
+package admin
+
+import (
+	"github.com/zitadel/zitadel/internal/api/grpc/object"
+	"github.com/zitadel/zitadel/internal/command"
+	"github.com/zitadel/zitadel/internal/notification/templates"
+	"github.com/zitadel/zitadel/internal/query"
+	admin_pb "github.com/zitadel/zitadel/pkg/grpc/admin"
+	settings_pb "github.com/zitadel/zitadel/pkg/grpc/settings"
+)
+
+func setNotificationLayoutToCommand(req *admin_pb.SetNotificationLayoutRequest) *command.NotificationLayout {
+	return &command.NotificationLayout{
+		Language:   req.Language,
+		HTML:       req.Html,
+		Text:       req.Text,
+		InlineLogo: req.InlineLogo,
+	}
+}
+
+func NotificationLayoutsToPb(layouts []*query.NotificationLayout) []*settings_pb.NotificationLayout {
+	result := make([]*settings_pb.NotificationLayout, len(layouts))
+	for i, layout := range layouts {
+		result[i] = NotificationLayoutToPb(layout)
+	}
+	return result
+}
+
+func NotificationLayoutToPb(layout *query.NotificationLayout) *settings_pb.NotificationLayout {
+	return &settings_pb.NotificationLayout{
+		Details:    object.ToViewDetailsPb(layout.Sequence, layout.CreationDate, layout.ChangeDate, ""),
+		Language:   layout.Language,
+		Html:       layout.HTML,
+		Text:       layout.Text,
+		InlineLogo: layout.InlineLogo,
+	}
+}
+
+func NotificationTemplatePartialsToPb(partials []*query.NotificationTemplatePartial) []*settings_pb.NotificationTemplatePartial {
+	result := make([]*settings_pb.NotificationTemplatePartial, len(partials))
+	for i, partial := range partials {
+		result[i] = NotificationTemplatePartialToPb(partial)
+	}
+	return result
+}
+
+func NotificationTemplatePartialToPb(partial *query.NotificationTemplatePartial) *settings_pb.NotificationTemplatePartial {
+	return &settings_pb.NotificationTemplatePartial{
+		Details: object.ToViewDetailsPb(partial.Sequence, partial.CreationDate, partial.ChangeDate, ""),
+		Name:    partial.Name,
+		Content: partial.Content,
+	}
+}
+
+func notificationLayoutPbToTemplate(layout *settings_pb.NotificationLayout) *templates.Layout {
+	return &templates.Layout{
+		HTML:       layout.Html,
+		Text:       layout.Text,
+		InlineLogo: layout.InlineLogo,
+	}
+}
@@ -2,6 +2,9 @@ package admin
 
 import (
 	"context"
+	"time"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
 
 	"github.com/zitadel/zitadel/internal/api/authz"
 	"github.com/zitadel/zitadel/internal/api/grpc/object"
@@ -37,6 +40,90 @@ func (s *Server) RemoveOrg(ctx context.Context, req *admin_pb.RemoveOrgRequest)
 	}, nil
 }
 
+func (s *Server) GetOrgHierarchy(ctx context.Context, req *admin_pb.GetOrgHierarchyRequest) (*admin_pb.GetOrgHierarchyResponse, error) {
+	hierarchy, err := s.query.OrgHierarchy(ctx, req.OrgId)
+	if err != nil {
+		return nil, err
+	}
+	return &admin_pb.GetOrgHierarchyResponse{
+		ParentOrgId:    hierarchy.ParentOrgID,
+		AncestorOrgIds: hierarchy.AncestorOrgIDs,
+		ChildOrgIds:    hierarchy.ChildOrgIDs,
+	}, nil
+}
+
+func (s *Server) SetOrgParent(ctx context.Context, req *admin_pb.SetOrgParentRequest) (*admin_pb.SetOrgParentResponse, error) {
+	details, err := s.command.SetOrgParent(ctx, req.OrgId, req.ParentOrgId)
+	if err != nil {
+		return nil, err
+	}
+	return &admin_pb.SetOrgParentResponse{
+		Details: object.DomainToChangeDetailsPb(details),
+	}, nil
+}
+
+func (s *Server) RemoveOrgParent(ctx context.Context, req *admin_pb.RemoveOrgParentRequest) (*admin_pb.RemoveOrgParentResponse, error) {
+	details, err := s.command.RemoveOrgParent(ctx, req.OrgId)
+	if err != nil {
+		return nil, err
+	}
+	return &admin_pb.RemoveOrgParentResponse{
+		Details: object.DomainToChangeDetailsPb(details),
+	}, nil
+}
+
+func (s *Server) ArchiveOrg(ctx context.Context, req *admin_pb.ArchiveOrgRequest) (*admin_pb.ArchiveOrgResponse, error) {
+	details, err := s.command.ArchiveOrg(ctx, req.OrgId)
+	if err != nil {
+		return nil, err
+	}
+	return &admin_pb.ArchiveOrgResponse{
+		Details: object.DomainToChangeDetailsPb(details),
+	}, nil
+}
+
+func (s *Server) UnarchiveOrg(ctx context.Context, req *admin_pb.UnarchiveOrgRequest) (*admin_pb.UnarchiveOrgResponse, error) {
+	details, err := s.command.UnarchiveOrg(ctx, req.OrgId)
+	if err != nil {
+		return nil, err
+	}
+	return &admin_pb.UnarchiveOrgResponse{
+		Details: object.DomainToChangeDetailsPb(details),
+	}, nil
+}
+
+func (s *Server) ScheduleOrgDeletion(ctx context.Context, req *admin_pb.ScheduleOrgDeletionRequest) (*admin_pb.ScheduleOrgDeletionResponse, error) {
+	deletionDate := time.Now().Add(req.GetRetention().AsDuration())
+	details, err := s.command.ScheduleOrgDeletion(ctx, req.OrgId, deletionDate)
+	if err != nil {
+		return nil, err
+	}
+	return &admin_pb.ScheduleOrgDeletionResponse{
+		Details:      object.DomainToChangeDetailsPb(details),
+		DeletionDate: timestamppb.New(deletionDate),
+	}, nil
+}
+
+func (s *Server) CancelOrgDeletion(ctx context.Context, req *admin_pb.CancelOrgDeletionRequest) (*admin_pb.CancelOrgDeletionResponse, error) {
+	details, err := s.command.CancelOrgDeletion(ctx, req.OrgId)
+	if err != nil {
+		return nil, err
+	}
+	return &admin_pb.CancelOrgDeletionResponse{
+		Details: object.DomainToChangeDetailsPb(details),
+	}, nil
+}
+
+func (s *Server) PurgeOrg(ctx context.Context, req *admin_pb.PurgeOrgRequest) (*admin_pb.PurgeOrgResponse, error) {
+	details, err := s.command.PurgeOrg(ctx, req.OrgId)
+	if err != nil {
+		return nil, err
+	}
+	return &admin_pb.PurgeOrgResponse{
+		Details: object.DomainToChangeDetailsPb(details),
+	}, nil
+}
+
 func (s *Server) GetDefaultOrg(ctx context.Context, _ *admin_pb.GetDefaultOrgRequest) (*admin_pb.GetDefaultOrgResponse, error) {
 	org, err := s.query.OrgByID(ctx, true, authz.GetInstance(ctx).DefaultOrganisationID())
 	if err != nil {
@@ -102,6 +189,24 @@ func (s *Server) SetUpOrg(ctx context.Context, req *admin_pb.SetUpOrgRequest) (*
 	}, nil
 }
 
+func (s *Server) ProvisionOrgs(ctx context.Context, req *admin_pb.ProvisionOrgsRequest) (*admin_pb.ProvisionOrgsResponse, error) {
+	orgs := make([]*command.OrgProvisioning, len(req.Orgs))
+	for i, org := range req.Orgs {
+		orgDomain, err := domain.NewIAMDomainName(org.Name, authz.GetInstance(ctx).RequestedDomain())
+		if err != nil {
+			return nil, err
+		}
+		userIDs, err := s.getClaimedUserIDsOfOrgDomain(ctx, orgDomain)
+		if err != nil {
+			return nil, err
+		}
+		orgs[i] = provisionOrgToCommand(org, userIDs)
+	}
+	return &admin_pb.ProvisionOrgsResponse{
+		Results: orgProvisioningResultsToPb(req.Orgs, s.command.ProvisionOrgs(ctx, orgs, true)),
+	}, nil
+}
+
 func (s *Server) getClaimedUserIDsOfOrgDomain(ctx context.Context, orgDomain string) ([]string, error) {
 	loginName, err := query.NewUserPreferredLoginNameSearchQuery("@"+orgDomain, query.TextEndsWithIgnoreCase)
 	if err != nil {
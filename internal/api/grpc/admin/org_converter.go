@@ -1,9 +1,14 @@
 package admin
 
 import (
+	"errors"
+
+	"github.com/zitadel/zitadel/internal/api/grpc/management"
 	"github.com/zitadel/zitadel/internal/api/grpc/object"
 	org_grpc "github.com/zitadel/zitadel/internal/api/grpc/org"
+	"github.com/zitadel/zitadel/internal/command"
 	"github.com/zitadel/zitadel/internal/query"
+	"github.com/zitadel/zitadel/internal/zerrors"
 	"github.com/zitadel/zitadel/pkg/grpc/admin"
 )
 
@@ -23,3 +28,64 @@ func listOrgRequestToModel(req *admin.ListOrgsRequest) (*query.OrgSearchQueries,
 		Queries: queries,
 	}, nil
 }
+
+func provisionOrgToCommand(org *admin.ProvisionOrgsRequest_Org, claimedUserIDs []string) *command.OrgProvisioning {
+	provisioning := &command.OrgProvisioning{
+		OrgSetup: command.OrgSetup{
+			Name:         org.Name,
+			CustomDomain: org.Domain,
+		},
+		ID:             org.OrgId,
+		ClaimedUserIDs: claimedUserIDs,
+	}
+	if org.Admin != nil {
+		provisioning.Admins = []*command.OrgSetupAdmin{
+			{
+				Human: setUpOrgHumanToCommand(org.Admin),
+				Roles: org.AdminRoles,
+			},
+		}
+	}
+	if org.LoginPolicy != nil {
+		provisioning.LoginPolicy = management.AddLoginPolicyToCommand(org.LoginPolicy)
+	}
+	if org.PasswordComplexityPolicy != nil {
+		provisioning.PasswordComplexityPolicy = management.AddPasswordComplexityPolicyToDomain(org.PasswordComplexityPolicy)
+	}
+	if org.Branding != nil {
+		provisioning.LabelPolicy = management.AddLabelPolicyToDomain(org.Branding)
+	}
+	return provisioning
+}
+
+func orgProvisioningResultsToPb(orgs []*admin.ProvisionOrgsRequest_Org, results []*command.OrgProvisioningResult) []*admin.ProvisionOrgsResponse_Result {
+	pb := make([]*admin.ProvisionOrgsResponse_Result, len(results))
+	for i, result := range results {
+		pb[i] = &admin.ProvisionOrgsResponse_Result{
+			OrgId: orgs[i].OrgId,
+		}
+		if result.Err != nil {
+			pb[i].Error = orgProvisioningErrorToPb(result.Err)
+			continue
+		}
+		pb[i].Details = object.DomainToAddDetailsPb(result.Org.ObjectDetails)
+		pb[i].AlreadyExisted = result.Org.AlreadyExisted
+		if len(result.Org.CreatedAdmins) == 1 {
+			pb[i].AdminUserId = result.Org.CreatedAdmins[0].ID
+		}
+	}
+	return pb
+}
+
+func orgProvisioningErrorToPb(err error) *admin.ProvisionOrgsResponse_Error {
+	zErr := new(zerrors.ZitadelError)
+	if errors.As(err, &zErr) {
+		return &admin.ProvisionOrgsResponse_Error{
+			Id:      zErr.GetID(),
+			Message: zErr.GetMessage(),
+		}
+	}
+	return &admin.ProvisionOrgsResponse_Error{
+		Message: err.Error(),
+	}
+}
@@ -29,3 +29,21 @@ func (s *Server) UpdatePrivacyPolicy(ctx context.Context, req *admin_pb.UpdatePr
 		),
 	}, nil
 }
+
+func (s *Server) GetDefaultTermsVersion(ctx context.Context, _ *admin_pb.GetDefaultTermsVersionRequest) (*admin_pb.GetDefaultTermsVersionResponse, error) {
+	version, err := s.query.DefaultTermsVersion(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &admin_pb.GetDefaultTermsVersionResponse{Version: policy_grpc.TermsVersionToPb(version)}, nil
+}
+
+func (s *Server) PublishDefaultTermsVersion(ctx context.Context, req *admin_pb.PublishDefaultTermsVersionRequest) (*admin_pb.PublishDefaultTermsVersionResponse, error) {
+	details, err := s.command.PublishDefaultTermsVersion(ctx, req.GetVersion())
+	if err != nil {
+		return nil, err
+	}
+	return &admin_pb.PublishDefaultTermsVersionResponse{
+		Details: object.DomainToChangeDetailsPb(details),
+	}, nil
+}
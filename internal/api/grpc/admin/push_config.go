@@ -0,0 +1,70 @@
+package admin
+
+import (
+	"context"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/api/grpc/object"
+	"github.com/zitadel/zitadel/internal/command"
+	"github.com/zitadel/zitadel/internal/domain"
+	admin_pb "github.com/zitadel/zitadel/pkg/grpc/admin"
+)
+
+func (s *Server) GetPushConfig(ctx context.Context, _ *admin_pb.GetPushConfigRequest) (*admin_pb.GetPushConfigResponse, error) {
+	config, err := s.query.PushConfig(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &admin_pb.GetPushConfigResponse{
+		Config: PushConfigToPb(config, authz.GetInstance(ctx).InstanceID()),
+	}, nil
+}
+
+func (s *Server) SetFCMPushConfig(ctx context.Context, req *admin_pb.SetFCMPushConfigRequest) (*admin_pb.SetFCMPushConfigResponse, error) {
+	details, err := s.command.SetFCMPushConfig(ctx, &command.FCMPushConfig{
+		ProjectID:         req.GetProjectId(),
+		ServiceAccountKey: req.GetServiceAccountKey(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &admin_pb.SetFCMPushConfigResponse{
+		Details: object.DomainToChangeDetailsPb(details),
+	}, nil
+}
+
+func (s *Server) RemoveFCMPushConfig(ctx context.Context, _ *admin_pb.RemoveFCMPushConfigRequest) (*admin_pb.RemoveFCMPushConfigResponse, error) {
+	details, err := s.command.RemovePushConfig(ctx, domain.PushPlatformFCM)
+	if err != nil {
+		return nil, err
+	}
+	return &admin_pb.RemoveFCMPushConfigResponse{
+		Details: object.DomainToChangeDetailsPb(details),
+	}, nil
+}
+
+func (s *Server) SetAPNsPushConfig(ctx context.Context, req *admin_pb.SetAPNsPushConfigRequest) (*admin_pb.SetAPNsPushConfigResponse, error) {
+	details, err := s.command.SetAPNsPushConfig(ctx, &command.APNsPushConfig{
+		KeyID:      req.GetKeyId(),
+		TeamID:     req.GetTeamId(),
+		BundleID:   req.GetBundleId(),
+		PrivateKey: req.GetPrivateKey(),
+		Production: req.GetProduction(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &admin_pb.SetAPNsPushConfigResponse{
+		Details: object.DomainToChangeDetailsPb(details),
+	}, nil
+}
+
+func (s *Server) RemoveAPNsPushConfig(ctx context.Context, _ *admin_pb.RemoveAPNsPushConfigRequest) (*admin_pb.RemoveAPNsPushConfigResponse, error) {
+	details, err := s.command.RemovePushConfig(ctx, domain.PushPlatformAPNs)
+	if err != nil {
+		return nil, err
+	}
+	return &admin_pb.RemoveAPNsPushConfigResponse{
+		Details: object.DomainToChangeDetailsPb(details),
+	}, nil
+}
@@ -0,0 +1,27 @@
+package admin
+
+import (
+	"github.com/zitadel/zitadel/internal/api/grpc/object"
+	"github.com/zitadel/zitadel/internal/query"
+	settings_pb "github.com/zitadel/zitadel/pkg/grpc/settings"
+)
+
+func PushConfigToPb(config *query.PushConfig, instanceID string) *settings_pb.PushConfig {
+	pb := &settings_pb.PushConfig{
+		Details: object.ChangeToDetailsPb(config.Sequence, config.ChangeDate, instanceID),
+	}
+	if config.FCM != nil {
+		pb.Fcm = &settings_pb.FCMPushConfig{
+			ProjectId: config.FCM.ProjectID,
+		}
+	}
+	if config.APNs != nil {
+		pb.Apns = &settings_pb.APNsPushConfig{
+			KeyId:      config.APNs.KeyID,
+			TeamId:     config.APNs.TeamID,
+			BundleId:   config.APNs.BundleID,
+			Production: config.APNs.Production,
+		}
+	}
+	return pb
+}
@@ -65,6 +65,51 @@ func (s *Server) UpdateSMSProviderTwilioToken(ctx context.Context, req *admin_pb
 	}, nil
 }
 
+func (s *Server) AddSMSProviderHTTP(ctx context.Context, req *admin_pb.AddSMSProviderHTTPRequest) (*admin_pb.AddSMSProviderHTTPResponse, error) {
+	config := AddSMSConfigHTTPToConfig(req)
+	result, err := s.command.AddSMSConfigHTTP(ctx, authz.GetInstance(ctx).InstanceID(), config)
+	if err != nil {
+		return nil, err
+	}
+	return &admin_pb.AddSMSProviderHTTPResponse{
+		Details:    object.DomainToAddDetailsPb(result),
+		Id:         config.ID,
+		SigningKey: config.SigningKey,
+	}, nil
+}
+
+func (s *Server) UpdateSMSProviderHTTP(ctx context.Context, req *admin_pb.UpdateSMSProviderHTTPRequest) (*admin_pb.UpdateSMSProviderHTTPResponse, error) {
+	config := UpdateSMSConfigHTTPToConfig(req)
+	result, err := s.command.ChangeSMSConfigHTTP(ctx, authz.GetInstance(ctx).InstanceID(), req.Id, config)
+	if err != nil {
+		return nil, err
+	}
+	return &admin_pb.UpdateSMSProviderHTTPResponse{
+		Details:    object.DomainToChangeDetailsPb(result),
+		SigningKey: config.SigningKey,
+	}, nil
+}
+
+func (s *Server) SetSMSProviderRouting(ctx context.Context, req *admin_pb.SetSMSProviderRoutingRequest) (*admin_pb.SetSMSProviderRoutingResponse, error) {
+	result, err := s.command.SetSMSConfigRouting(ctx, authz.GetInstance(ctx).InstanceID(), req.Id, req.Priority, req.CountryCodes)
+	if err != nil {
+		return nil, err
+	}
+	return &admin_pb.SetSMSProviderRoutingResponse{
+		Details: object.DomainToChangeDetailsPb(result),
+	}, nil
+}
+
+func (s *Server) GetNotificationStatus(ctx context.Context, req *admin_pb.GetNotificationStatusRequest) (*admin_pb.GetNotificationStatusResponse, error) {
+	status, err := s.query.NotificationStatusByID(ctx, req.Id)
+	if err != nil {
+		return nil, err
+	}
+	return &admin_pb.GetNotificationStatusResponse{
+		Status: NotificationStatusToPb(status),
+	}, nil
+}
+
 func (s *Server) ActivateSMSProvider(ctx context.Context, req *admin_pb.ActivateSMSProviderRequest) (*admin_pb.ActivateSMSProviderResponse, error) {
 	result, err := s.command.ActivateSMSConfig(ctx, authz.GetInstance(ctx).InstanceID(), req.Id)
 	if err != nil {
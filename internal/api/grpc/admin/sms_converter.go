@@ -2,6 +2,7 @@ package admin
 
 import (
 	"github.com/zitadel/zitadel/internal/api/grpc/object"
+	"github.com/zitadel/zitadel/internal/command"
 	"github.com/zitadel/zitadel/internal/domain"
 	"github.com/zitadel/zitadel/internal/notification/channels/twilio"
 	"github.com/zitadel/zitadel/internal/query"
@@ -30,10 +31,13 @@ func SMSConfigsToPb(configs []*query.SMSConfig) []*settings_pb.SMSProvider {
 
 func SMSConfigToProviderPb(config *query.SMSConfig) *settings_pb.SMSProvider {
 	return &settings_pb.SMSProvider{
-		Details: object.ToViewDetailsPb(config.Sequence, config.CreationDate, config.ChangeDate, config.ResourceOwner),
-		Id:      config.ID,
-		State:   smsStateToPb(config.State),
-		Config:  SMSConfigToPb(config),
+		Details:      object.ToViewDetailsPb(config.Sequence, config.CreationDate, config.ChangeDate, config.ResourceOwner),
+		Id:           config.ID,
+		State:        smsStateToPb(config.State),
+		Config:       SMSConfigToPb(config),
+		Description:  config.Description,
+		Priority:     config.Priority,
+		CountryCodes: config.CountryCodes,
 	}
 }
 
@@ -41,9 +45,20 @@ func SMSConfigToPb(config *query.SMSConfig) settings_pb.SMSConfig {
 	if config.TwilioConfig != nil {
 		return TwilioConfigToPb(config.TwilioConfig)
 	}
+	if config.HTTPConfig != nil {
+		return HTTPConfigToPb(config.HTTPConfig)
+	}
 	return nil
 }
 
+func HTTPConfigToPb(http *query.HTTP) *settings_pb.SMSProvider_Http {
+	return &settings_pb.SMSProvider_Http{
+		Http: &settings_pb.HTTPConfig{
+			Endpoint: http.Endpoint,
+		},
+	}
+}
+
 func TwilioConfigToPb(twilio *query.Twilio) *settings_pb.SMSProvider_Twilio {
 	return &settings_pb.SMSProvider_Twilio{
 		Twilio: &settings_pb.TwilioConfig{
@@ -78,3 +93,44 @@ func UpdateSMSConfigTwilioToConfig(req *admin_pb.UpdateSMSProviderTwilioRequest)
 		SenderNumber: req.SenderNumber,
 	}
 }
+
+func AddSMSConfigHTTPToConfig(req *admin_pb.AddSMSProviderHTTPRequest) *command.AddSMSHTTP {
+	return &command.AddSMSHTTP{
+		Description: req.Description,
+		Endpoint:    req.Endpoint,
+	}
+}
+
+func UpdateSMSConfigHTTPToConfig(req *admin_pb.UpdateSMSProviderHTTPRequest) *command.ChangeSMSHTTP {
+	return &command.ChangeSMSHTTP{
+		Description:          req.Description,
+		Endpoint:             req.Endpoint,
+		ExpirationSigningKey: req.ExpirationSigningKey,
+	}
+}
+
+func NotificationStatusToPb(status *query.NotificationStatus) *settings_pb.NotificationStatus {
+	return &settings_pb.NotificationStatus{
+		Details:    object.ToViewDetailsPb(status.Sequence, status.CreationDate, status.ChangeDate, ""),
+		Id:         status.ID,
+		Channel:    status.Channel,
+		ProviderId: status.ProviderID,
+		Status:     notificationDeliveryStatusToPb(status.Status),
+		Reason:     status.Reason,
+	}
+}
+
+func notificationDeliveryStatusToPb(status domain.NotificationDeliveryStatus) settings_pb.NotificationDeliveryStatus {
+	switch status {
+	case domain.NotificationDeliveryStatusSent:
+		return settings_pb.NotificationDeliveryStatus_NOTIFICATION_DELIVERY_STATUS_SENT
+	case domain.NotificationDeliveryStatusFailed:
+		return settings_pb.NotificationDeliveryStatus_NOTIFICATION_DELIVERY_STATUS_FAILED
+	case domain.NotificationDeliveryStatusDelivered:
+		return settings_pb.NotificationDeliveryStatus_NOTIFICATION_DELIVERY_STATUS_DELIVERED
+	case domain.NotificationDeliveryStatusUndelivered:
+		return settings_pb.NotificationDeliveryStatus_NOTIFICATION_DELIVERY_STATUS_UNDELIVERED
+	default:
+		return settings_pb.NotificationDeliveryStatus_NOTIFICATION_DELIVERY_STATUS_UNSPECIFIED
+	}
+}
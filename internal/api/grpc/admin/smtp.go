@@ -100,6 +100,31 @@ func (s *Server) ListSMTPConfigs(ctx context.Context, req *admin_pb.ListSMTPConf
 	}, nil
 }
 
+func (s *Server) AddEmailProviderHTTP(ctx context.Context, req *admin_pb.AddEmailProviderHTTPRequest) (*admin_pb.AddEmailProviderHTTPResponse, error) {
+	config := AddEmailProviderHTTPToConfig(req)
+	details, err := s.command.AddSMTPConfigHTTP(ctx, authz.GetInstance(ctx).InstanceID(), config)
+	if err != nil {
+		return nil, err
+	}
+	return &admin_pb.AddEmailProviderHTTPResponse{
+		Details:    object.DomainToAddDetailsPb(details),
+		Id:         config.ID,
+		SigningKey: config.SigningKey,
+	}, nil
+}
+
+func (s *Server) UpdateEmailProviderHTTP(ctx context.Context, req *admin_pb.UpdateEmailProviderHTTPRequest) (*admin_pb.UpdateEmailProviderHTTPResponse, error) {
+	config := UpdateEmailProviderHTTPToConfig(req)
+	details, err := s.command.ChangeSMTPConfigHTTP(ctx, authz.GetInstance(ctx).InstanceID(), req.Id, config)
+	if err != nil {
+		return nil, err
+	}
+	return &admin_pb.UpdateEmailProviderHTTPResponse{
+		Details:    object.DomainToChangeDetailsPb(details),
+		SigningKey: config.SigningKey,
+	}, nil
+}
+
 func (s *Server) ActivateSMTPConfig(ctx context.Context, req *admin_pb.ActivateSMTPConfigRequest) (*admin_pb.ActivateSMTPConfigResponse, error) {
 	// Get the ID of current SMTP active provider if any
 	currentActiveProviderID := ""
@@ -2,6 +2,7 @@ package admin
 
 import (
 	"github.com/zitadel/zitadel/internal/api/grpc/object"
+	"github.com/zitadel/zitadel/internal/command"
 	"github.com/zitadel/zitadel/internal/query"
 	admin_pb "github.com/zitadel/zitadel/pkg/grpc/admin"
 	settings_pb "github.com/zitadel/zitadel/pkg/grpc/settings"
@@ -29,6 +30,31 @@ func SMTPConfigToProviderPb(config *query.SMTPConfig) *settings_pb.SMTPConfig {
 		State:         settings_pb.SMTPConfigState(config.State),
 		SenderAddress: config.SenderAddress,
 		SenderName:    config.SenderName,
+		Http:          smtpHTTPConfigToPb(config.HTTPConfig),
+	}
+}
+
+func smtpHTTPConfigToPb(http *query.HTTP) *settings_pb.HTTPConfig {
+	if http == nil {
+		return nil
+	}
+	return &settings_pb.HTTPConfig{
+		Endpoint: http.Endpoint,
+	}
+}
+
+func AddEmailProviderHTTPToConfig(req *admin_pb.AddEmailProviderHTTPRequest) *command.AddSMTPHTTP {
+	return &command.AddSMTPHTTP{
+		Description: req.Description,
+		Endpoint:    req.Endpoint,
+	}
+}
+
+func UpdateEmailProviderHTTPToConfig(req *admin_pb.UpdateEmailProviderHTTPRequest) *command.ChangeSMTPHTTP {
+	return &command.ChangeSMTPHTTP{
+		Description:          req.Description,
+		Endpoint:             req.Endpoint,
+		ExpirationSigningKey: req.ExpirationSigningKey,
 	}
 }
 
@@ -4,9 +4,11 @@ import (
 	"github.com/zitadel/logging"
 	"golang.org/x/text/language"
 
+	"github.com/zitadel/zitadel/internal/api/grpc/object"
 	user_grpc "github.com/zitadel/zitadel/internal/api/grpc/user"
 	"github.com/zitadel/zitadel/internal/command"
 	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/query"
 	admin_grpc "github.com/zitadel/zitadel/pkg/grpc/admin"
 )
 
@@ -44,3 +46,25 @@ func setUpOrgHumanPhoneToDomain(phone *admin_grpc.SetUpOrgRequest_Human_Phone) c
 		Verified: phone.IsPhoneVerified,
 	}
 }
+
+func exportUsersRequestToModel(req *admin_grpc.ExportUsersRequest) (*query.UserSearchQueries, error) {
+	offset, limit, asc := object.ListQueryToModel(req.Query)
+	resourceOwnerQuery, err := query.NewUserResourceOwnerSearchQuery(req.OrgId, query.TextEquals)
+	if err != nil {
+		return nil, err
+	}
+	typeQuery, err := query.NewUserTypeSearchQuery(int32(domain.UserTypeHuman))
+	if err != nil {
+		return nil, err
+	}
+	return &query.UserSearchQueries{
+		SearchRequest: query.SearchRequest{
+			Offset: offset,
+			Limit:  limit,
+			Asc:    asc,
+			// sorted by id for stable pages
+			SortingColumn: query.UserIDCol,
+		},
+		Queries: []query.SearchQuery{resourceOwnerQuery, typeQuery},
+	}, nil
+}
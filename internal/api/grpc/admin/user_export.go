@@ -0,0 +1,139 @@
+package admin
+
+import (
+	"context"
+	"crypto/rsa"
+
+	"github.com/zitadel/zitadel/internal/api/grpc/object"
+	user_grpc "github.com/zitadel/zitadel/internal/api/grpc/user"
+	"github.com/zitadel/zitadel/internal/crypto"
+	"github.com/zitadel/zitadel/internal/query"
+	"github.com/zitadel/zitadel/internal/telemetry/tracing"
+	"github.com/zitadel/zitadel/internal/zerrors"
+	admin_pb "github.com/zitadel/zitadel/pkg/grpc/admin"
+	idp_pb "github.com/zitadel/zitadel/pkg/grpc/idp"
+)
+
+func (s *Server) ExportUsers(ctx context.Context, req *admin_pb.ExportUsersRequest) (_ *admin_pb.ExportUsersResponse, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	var otpKey *rsa.PublicKey
+	if len(req.OtpEncryptionKey) > 0 {
+		otpKey, err = crypto.BytesToPublicKey(req.OtpEncryptionKey)
+		if err != nil || otpKey == nil {
+			return nil, zerrors.ThrowInvalidArgument(err, "ADMIN-Ex7kq", "Errors.User.Machine.Key.Invalid")
+		}
+	}
+	queries, err := exportUsersRequestToModel(req)
+	if err != nil {
+		return nil, err
+	}
+	users, err := s.query.SearchUsers(ctx, queries)
+	if err != nil {
+		return nil, err
+	}
+	exported := make([]*admin_pb.ExportUsersResponse_User, len(users.Users))
+	for i, user := range users.Users {
+		exported[i], err = s.exportUser(ctx, user, req.WithPasswords, otpKey)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return &admin_pb.ExportUsersResponse{
+		Details: object.ToListDetails(users.Count, users.Sequence, users.LastRun),
+		Users:   exported,
+	}, nil
+}
+
+func (s *Server) exportUser(ctx context.Context, user *query.User, withPassword bool, otpKey *rsa.PublicKey) (_ *admin_pb.ExportUsersResponse_User, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	exported := &admin_pb.ExportUsersResponse_User{
+		UserId:   user.ID,
+		Details:  object.ToViewDetailsPb(user.Sequence, user.CreationDate, user.ChangeDate, user.ResourceOwner),
+		State:    user_grpc.UserStateToPb(user.State),
+		UserName: user.Username,
+		Human:    user_grpc.HumanToPb(user.Human, s.assetsAPIDomain(ctx), user.ResourceOwner),
+	}
+	if withPassword {
+		encodedHash, err := s.query.GetHumanPassword(ctx, user.ResourceOwner, user.ID)
+		if err != nil && !zerrors.IsNotFound(err) {
+			return nil, err
+		}
+		if encodedHash != "" {
+			algorithm, _ := crypto.EncodedHashName(encodedHash)
+			exported.HashedPassword = &admin_pb.ExportUsersResponse_HashedPassword{
+				Value:     encodedHash,
+				Algorithm: string(algorithm),
+			}
+		}
+	}
+	if otpKey != nil {
+		secret, err := s.query.GetHumanOTPSecret(ctx, user.ID, user.ResourceOwner)
+		if err != nil && !zerrors.IsNotFound(err) {
+			return nil, err
+		}
+		if secret != "" {
+			exported.EncryptedTotpSecret, err = crypto.EncryptWithPublicKey(otpKey, []byte(secret))
+			if err != nil {
+				return nil, zerrors.ThrowInternal(err, "ADMIN-Ex8nf", "Errors.Internal")
+			}
+		}
+	}
+	exported.IdpLinks, err = s.exportUserIDPLinks(ctx, user)
+	if err != nil {
+		return nil, err
+	}
+	exported.Metadata, err = s.exportUserMetadata(ctx, user)
+	if err != nil {
+		return nil, err
+	}
+	return exported, nil
+}
+
+func (s *Server) exportUserIDPLinks(ctx context.Context, user *query.User) ([]*admin_pb.ExportUsersResponse_IDPLink, error) {
+	userIDQuery, err := query.NewIDPUserLinksUserIDSearchQuery(user.ID)
+	if err != nil {
+		return nil, err
+	}
+	resourceOwnerQuery, err := query.NewIDPUserLinksResourceOwnerSearchQuery(user.ResourceOwner)
+	if err != nil {
+		return nil, err
+	}
+	links, err := s.query.IDPUserLinks(ctx, &query.IDPUserLinksSearchQuery{Queries: []query.SearchQuery{userIDQuery, resourceOwnerQuery}}, false)
+	if err != nil {
+		return nil, err
+	}
+	exported := make([]*admin_pb.ExportUsersResponse_IDPLink, len(links.Links))
+	for i, link := range links.Links {
+		exported[i] = &admin_pb.ExportUsersResponse_IDPLink{
+			IdpId:            link.IDPID,
+			IdpName:          link.IDPName,
+			IdpType:          idp_pb.IDPType(link.IDPType),
+			ProvidedUserId:   link.ProvidedUserID,
+			ProvidedUserName: link.ProvidedUsername,
+		}
+	}
+	return exported, nil
+}
+
+func (s *Server) exportUserMetadata(ctx context.Context, user *query.User) ([]*admin_pb.ExportUsersResponse_Metadata, error) {
+	resourceOwnerQuery, err := query.NewUserMetadataResourceOwnerSearchQuery(user.ResourceOwner)
+	if err != nil {
+		return nil, err
+	}
+	metadata, err := s.query.SearchUserMetadata(ctx, false, user.ID, &query.UserMetadataSearchQueries{Queries: []query.SearchQuery{resourceOwnerQuery}}, false)
+	if err != nil {
+		return nil, err
+	}
+	exported := make([]*admin_pb.ExportUsersResponse_Metadata, len(metadata.Metadata))
+	for i, entry := range metadata.Metadata {
+		exported[i] = &admin_pb.ExportUsersResponse_Metadata{
+			Key:   entry.Key,
+			Value: entry.Value,
+		}
+	}
+	return exported, nil
+}
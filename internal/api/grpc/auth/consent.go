@@ -0,0 +1,34 @@
+package auth
+
+import (
+	"context"
+	"time"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/api/grpc/object"
+	user_grpc "github.com/zitadel/zitadel/internal/api/grpc/user"
+	"github.com/zitadel/zitadel/pkg/grpc/auth"
+)
+
+func (s *Server) ListMyConsents(ctx context.Context, _ *auth.ListMyConsentsRequest) (*auth.ListMyConsentsResponse, error) {
+	ctxData := authz.GetCtxData(ctx)
+	consents, err := s.query.UserConsents(ctx, ctxData.UserID, ctxData.ResourceOwner)
+	if err != nil {
+		return nil, err
+	}
+	return &auth.ListMyConsentsResponse{
+		Result:  user_grpc.UserConsentsToPb(consents),
+		Details: object.ToListDetails(uint64(len(consents)), 0, time.Time{}),
+	}, nil
+}
+
+func (s *Server) RevokeMyConsent(ctx context.Context, req *auth.RevokeMyConsentRequest) (*auth.RevokeMyConsentResponse, error) {
+	ctxData := authz.GetCtxData(ctx)
+	details, err := s.command.RevokeHumanConsent(ctx, ctxData.UserID, req.AppId, ctxData.ResourceOwner)
+	if err != nil {
+		return nil, err
+	}
+	return &auth.RevokeMyConsentResponse{
+		Details: object.DomainToChangeDetailsPb(details),
+	}, nil
+}
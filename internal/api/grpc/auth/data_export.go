@@ -0,0 +1,61 @@
+package auth
+
+import (
+	"context"
+	"time"
+
+	"github.com/zitadel/zitadel/internal/api/assets"
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/api/grpc/object"
+	user_grpc "github.com/zitadel/zitadel/internal/api/grpc/user"
+	"github.com/zitadel/zitadel/internal/crypto"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/query"
+	"github.com/zitadel/zitadel/pkg/grpc/auth"
+	"github.com/zitadel/zitadel/pkg/grpc/user"
+)
+
+func (s *Server) RequestMyDataExport(ctx context.Context, _ *auth.RequestMyDataExportRequest) (*auth.RequestMyDataExportResponse, error) {
+	ctxData := authz.GetCtxData(ctx)
+	exportID, details, err := s.command.RequestHumanDataExport(ctx, ctxData.UserID, ctxData.ResourceOwner)
+	if err != nil {
+		return nil, err
+	}
+	return &auth.RequestMyDataExportResponse{
+		Id:      exportID,
+		Details: object.DomainToAddDetailsPb(details),
+	}, nil
+}
+
+func (s *Server) ListMyDataExports(ctx context.Context, _ *auth.ListMyDataExportsRequest) (*auth.ListMyDataExportsResponse, error) {
+	ctxData := authz.GetCtxData(ctx)
+	exports, err := s.query.HumanDataExports(ctx, ctxData.UserID, ctxData.ResourceOwner)
+	if err != nil {
+		return nil, err
+	}
+	now := time.Now()
+	result := make([]*user.DataExport, len(exports))
+	for i, export := range exports {
+		downloadURL, err := s.dataExportURL(ctx, export, now)
+		if err != nil {
+			return nil, err
+		}
+		result[i] = user_grpc.DataExportToPb(export, downloadURL, now)
+	}
+	return &auth.ListMyDataExportsResponse{
+		Result:  result,
+		Details: object.ToListDetails(uint64(len(exports)), 0, time.Time{}),
+	}, nil
+}
+
+func (s *Server) dataExportURL(ctx context.Context, export *query.DataExport, now time.Time) (string, error) {
+	if export.State != domain.DataExportStateGenerated || export.Expired(now) {
+		return "", nil
+	}
+	signingKey, err := crypto.Decrypt(export.SigningKey, s.userCodeAlg)
+	if err != nil {
+		return "", err
+	}
+	signature := domain.DataExportSignature(signingKey, export.UserID, export.ID, export.Expiration)
+	return assets.DataExportURL(s.assetsAPIDomain(ctx), export.UserID, export.ID, signature), nil
+}
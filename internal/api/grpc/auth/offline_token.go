@@ -0,0 +1,61 @@
+package auth
+
+import (
+	"context"
+	"time"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/api/grpc/object"
+	user_grpc "github.com/zitadel/zitadel/internal/api/grpc/user"
+	"github.com/zitadel/zitadel/internal/command"
+	"github.com/zitadel/zitadel/internal/eventstore/v1/models"
+	"github.com/zitadel/zitadel/pkg/grpc/auth"
+)
+
+func (s *Server) AddMyOfflineToken(ctx context.Context, req *auth.AddMyOfflineTokenRequest) (*auth.AddMyOfflineTokenResponse, error) {
+	ctxData := authz.GetCtxData(ctx)
+	var expirationDate time.Time
+	if req.ExpirationDate != nil {
+		expirationDate = req.ExpirationDate.AsTime()
+	}
+	token := &command.OfflineToken{
+		ObjectRoot: models.ObjectRoot{
+			AggregateID:   ctxData.UserID,
+			ResourceOwner: ctxData.ResourceOwner,
+		},
+		ClientID:       ctxData.ClientID,
+		ExpirationDate: expirationDate,
+		Description:    req.Description,
+	}
+	details, err := s.command.AddOfflineToken(ctx, token)
+	if err != nil {
+		return nil, err
+	}
+	return &auth.AddMyOfflineTokenResponse{
+		Details: object.DomainToAddDetailsPb(details),
+		TokenId: token.TokenID,
+		Token:   token.Token,
+	}, nil
+}
+
+func (s *Server) ListMyOfflineTokens(ctx context.Context, _ *auth.ListMyOfflineTokensRequest) (*auth.ListMyOfflineTokensResponse, error) {
+	tokens, err := s.query.OfflineTokensByUserID(ctx, authz.GetCtxData(ctx).UserID)
+	if err != nil {
+		return nil, err
+	}
+	return &auth.ListMyOfflineTokensResponse{
+		Result:  user_grpc.OfflineTokensToPb(tokens),
+		Details: object.ToListDetails(uint64(len(tokens)), 0, time.Time{}),
+	}, nil
+}
+
+func (s *Server) RevokeMyOfflineToken(ctx context.Context, req *auth.RevokeMyOfflineTokenRequest) (*auth.RevokeMyOfflineTokenResponse, error) {
+	ctxData := authz.GetCtxData(ctx)
+	details, err := s.command.RevokeOfflineToken(ctx, ctxData.UserID, req.Id, ctxData.ResourceOwner)
+	if err != nil {
+		return nil, err
+	}
+	return &auth.RevokeMyOfflineTokenResponse{
+		Details: object.DomainToChangeDetailsPb(details),
+	}, nil
+}
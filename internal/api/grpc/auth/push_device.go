@@ -0,0 +1,51 @@
+package auth
+
+import (
+	"context"
+	"time"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/api/grpc/object"
+	user_grpc "github.com/zitadel/zitadel/internal/api/grpc/user"
+	"github.com/zitadel/zitadel/internal/command"
+	"github.com/zitadel/zitadel/pkg/grpc/auth"
+)
+
+func (s *Server) ListMyPushDevices(ctx context.Context, _ *auth.ListMyPushDevicesRequest) (*auth.ListMyPushDevicesResponse, error) {
+	ctxData := authz.GetCtxData(ctx)
+	devices, err := s.query.PushDevices(ctx, ctxData.UserID, ctxData.ResourceOwner)
+	if err != nil {
+		return nil, err
+	}
+	return &auth.ListMyPushDevicesResponse{
+		Result:  user_grpc.PushDevicesToPb(devices),
+		Details: object.ToListDetails(uint64(len(devices)), 0, time.Time{}),
+	}, nil
+}
+
+func (s *Server) AddMyPushDevice(ctx context.Context, req *auth.AddMyPushDeviceRequest) (*auth.AddMyPushDeviceResponse, error) {
+	ctxData := authz.GetCtxData(ctx)
+	id, details, err := s.command.AddHumanPushDevice(ctx, ctxData.UserID, ctxData.ResourceOwner, &command.PushDevice{
+		Platform: user_grpc.PushPlatformToDomain(req.GetPlatform()),
+		Token:    req.GetToken(),
+		Name:     req.GetName(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &auth.AddMyPushDeviceResponse{
+		Id:      id,
+		Details: object.DomainToAddDetailsPb(details),
+	}, nil
+}
+
+func (s *Server) RemoveMyPushDevice(ctx context.Context, req *auth.RemoveMyPushDeviceRequest) (*auth.RemoveMyPushDeviceResponse, error) {
+	ctxData := authz.GetCtxData(ctx)
+	details, err := s.command.RemoveHumanPushDevice(ctx, ctxData.UserID, req.Id, ctxData.ResourceOwner)
+	if err != nil {
+		return nil, err
+	}
+	return &auth.RemoveMyPushDeviceResponse{
+		Details: object.DomainToChangeDetailsPb(details),
+	}, nil
+}
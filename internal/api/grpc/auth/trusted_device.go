@@ -0,0 +1,34 @@
+package auth
+
+import (
+	"context"
+	"time"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/api/grpc/object"
+	user_grpc "github.com/zitadel/zitadel/internal/api/grpc/user"
+	"github.com/zitadel/zitadel/pkg/grpc/auth"
+)
+
+func (s *Server) ListMyTrustedDevices(ctx context.Context, _ *auth.ListMyTrustedDevicesRequest) (*auth.ListMyTrustedDevicesResponse, error) {
+	ctxData := authz.GetCtxData(ctx)
+	devices, err := s.query.TrustedDevices(ctx, ctxData.UserID, ctxData.ResourceOwner)
+	if err != nil {
+		return nil, err
+	}
+	return &auth.ListMyTrustedDevicesResponse{
+		Result:  user_grpc.TrustedDevicesToPb(devices),
+		Details: object.ToListDetails(uint64(len(devices)), 0, time.Time{}),
+	}, nil
+}
+
+func (s *Server) RemoveMyTrustedDevice(ctx context.Context, req *auth.RemoveMyTrustedDeviceRequest) (*auth.RemoveMyTrustedDeviceResponse, error) {
+	ctxData := authz.GetCtxData(ctx)
+	details, err := s.command.RemoveHumanTrustedDevice(ctx, ctxData.UserID, req.Id, ctxData.ResourceOwner)
+	if err != nil {
+		return nil, err
+	}
+	return &auth.RemoveMyTrustedDeviceResponse{
+		Details: object.DomainToChangeDetailsPb(details),
+	}, nil
+}
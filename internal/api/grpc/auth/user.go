@@ -2,6 +2,7 @@ package auth
 
 import (
 	"context"
+	"time"
 
 	"github.com/zitadel/zitadel/internal/api/authz"
 	"github.com/zitadel/zitadel/internal/api/grpc/change"
@@ -128,6 +129,25 @@ func (s *Server) ListMyUserSessions(ctx context.Context, req *auth_pb.ListMyUser
 	}, nil
 }
 
+func (s *Server) ListMyDeviceSessions(ctx context.Context, _ *auth_pb.ListMyDeviceSessionsRequest) (*auth_pb.ListMyDeviceSessionsResponse, error) {
+	userSessions, err := s.repo.GetMyUserSessionsOfAllAgents(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &auth_pb.ListMyDeviceSessionsResponse{
+		Result:  user_grpc.UserSessionsToPb(userSessions, s.assetsAPIDomain(ctx)),
+		Details: obj_grpc.ToListDetails(uint64(len(userSessions)), 0, time.Time{}),
+	}, nil
+}
+
+func (s *Server) TerminateMyDeviceSession(ctx context.Context, req *auth_pb.TerminateMyDeviceSessionRequest) (*auth_pb.TerminateMyDeviceSessionResponse, error) {
+	err := s.command.HumansSignOut(ctx, req.AgentId, []string{authz.GetCtxData(ctx).UserID})
+	if err != nil {
+		return nil, err
+	}
+	return &auth_pb.TerminateMyDeviceSessionResponse{}, nil
+}
+
 func (s *Server) UpdateMyUserName(ctx context.Context, req *auth_pb.UpdateMyUserNameRequest) (*auth_pb.UpdateMyUserNameResponse, error) {
 	ctxData := authz.GetCtxData(ctx)
 	objectDetails, err := s.command.ChangeUsername(ctx, ctxData.ResourceOwner, ctxData.UserID, req.UserName)
@@ -0,0 +1,18 @@
+package middleware
+
+import (
+	"context"
+
+	"google.golang.org/grpc"
+
+	grpc_utils "github.com/zitadel/zitadel/internal/api/grpc"
+	http_util "github.com/zitadel/zitadel/internal/api/http"
+)
+
+// UnaryClientIPClientInterceptor passes the client IP of the HTTP request to the gRPC server.
+// It's used by the gateway, for which the client IP is resolved by the ClientIPHandler of the HTTP middleware.
+func UnaryClientIPClientInterceptor() grpc.UnaryClientInterceptor {
+	return func(ctx context.Context, method string, req, reply any, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		return invoker(grpc_utils.SetGatewayClientIP(ctx, http_util.RemoteIPFromCtx(ctx)), method, req, reply, cc, opts...)
+	}
+}
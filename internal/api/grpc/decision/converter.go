@@ -0,0 +1,84 @@
+package decision
+
+import (
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/zitadel/zitadel/internal/api/grpc/object"
+	"github.com/zitadel/zitadel/internal/query"
+	"github.com/zitadel/zitadel/internal/zerrors"
+	decision_pb "github.com/zitadel/zitadel/pkg/grpc/decision"
+	object_pb "github.com/zitadel/zitadel/pkg/grpc/object"
+)
+
+var timestampComparisons = map[object_pb.TimestampQueryMethod]query.TimestampComparison{
+	object_pb.TimestampQueryMethod_TIMESTAMP_QUERY_METHOD_EQUALS:            query.TimestampEquals,
+	object_pb.TimestampQueryMethod_TIMESTAMP_QUERY_METHOD_GREATER:           query.TimestampGreater,
+	object_pb.TimestampQueryMethod_TIMESTAMP_QUERY_METHOD_GREATER_OR_EQUALS: query.TimestampGreaterOrEquals,
+	object_pb.TimestampQueryMethod_TIMESTAMP_QUERY_METHOD_LESS:              query.TimestampLess,
+	object_pb.TimestampQueryMethod_TIMESTAMP_QUERY_METHOD_LESS_OR_EQUALS:    query.TimestampLessOrEquals,
+}
+
+func DecisionsToPb(decisions []*query.AuthZDecision) []*decision_pb.Decision {
+	resp := make([]*decision_pb.Decision, len(decisions))
+	for i, d := range decisions {
+		resp[i] = DecisionToPb(d)
+	}
+	return resp
+}
+
+func DecisionToPb(d *query.AuthZDecision) *decision_pb.Decision {
+	return &decision_pb.Decision{
+		Id:                d.ID,
+		CheckedAt:         timestamppb.New(d.CheckedAt),
+		CallerId:          d.CallerID,
+		CallerOrgId:       d.CallerOrgID,
+		OrgId:             d.OrgID,
+		Method:            d.Method,
+		Permission:        d.Permission,
+		ResourceId:        d.ResourceID,
+		Allowed:           d.Allowed,
+		MatchedPermission: d.MatchedPermission,
+		ErrorId:           d.ErrorID,
+	}
+}
+
+func DecisionQueriesToQuery(queries []*decision_pb.DecisionQuery) (_ []query.SearchQuery, err error) {
+	q := make([]query.SearchQuery, len(queries))
+	for i, query := range queries {
+		q[i], err = DecisionQueryToQuery(query)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return q, nil
+}
+
+func DecisionQueryToQuery(decisionQuery *decision_pb.DecisionQuery) (query.SearchQuery, error) {
+	switch q := decisionQuery.Query.(type) {
+	case *decision_pb.DecisionQuery_CallerIdQuery:
+		return query.NewAuthZDecisionCallerIDSearchQuery(q.CallerIdQuery.GetCallerId())
+	case *decision_pb.DecisionQuery_PermissionQuery:
+		return query.NewAuthZDecisionPermissionSearchQuery(object.TextMethodToQuery(q.PermissionQuery.GetMethod()), q.PermissionQuery.GetPermission())
+	case *decision_pb.DecisionQuery_ResourceIdQuery:
+		return query.NewAuthZDecisionResourceIDSearchQuery(q.ResourceIdQuery.GetResourceId())
+	case *decision_pb.DecisionQuery_AllowedQuery:
+		return query.NewAuthZDecisionAllowedSearchQuery(q.AllowedQuery.GetAllowed())
+	case *decision_pb.DecisionQuery_CheckedAtQuery:
+		return query.NewAuthZDecisionCheckedAtSearchQuery(q.CheckedAtQuery.GetCheckedAt().AsTime(), timestampComparisons[q.CheckedAtQuery.GetMethod()])
+	default:
+		return nil, zerrors.ThrowInvalidArgument(nil, "DECIS-Rk4wNf7qXs", "List.Query.Invalid")
+	}
+}
+
+func DecisionFieldNameToSortingColumn(field decision_pb.DecisionFieldName) query.Column {
+	switch field {
+	case decision_pb.DecisionFieldName_DECISION_FIELD_NAME_CHECKED_AT:
+		return query.AuthZDecisionColumnCheckedAt
+	case decision_pb.DecisionFieldName_DECISION_FIELD_NAME_CALLER_ID:
+		return query.AuthZDecisionColumnCallerID
+	case decision_pb.DecisionFieldName_DECISION_FIELD_NAME_PERMISSION:
+		return query.AuthZDecisionColumnPermission
+	default:
+		return query.Column{}
+	}
+}
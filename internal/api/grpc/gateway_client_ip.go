@@ -0,0 +1,59 @@
+package grpc
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/subtle"
+	"encoding/base64"
+
+	"google.golang.org/grpc/metadata"
+)
+
+// The gateway calls the gRPC server over the loopback interface,
+// so the peer address of these calls is not the address of the client.
+// Instead, the gateway passes the client IP it resolved from the HTTP request as metadata.
+// The metadata is only trusted if it contains the secret of the running process,
+// which isn't known to any caller.
+const (
+	gatewayClientIPKey     = "x-zitadel-internal-gateway-client-ip"
+	gatewayClientIPAuthKey = "x-zitadel-internal-gateway-auth"
+)
+
+var gatewaySecret = newGatewaySecret()
+
+func newGatewaySecret() string {
+	secret := make([]byte, 32)
+	if _, err := rand.Read(secret); err != nil {
+		panic(err)
+	}
+	return base64.RawURLEncoding.EncodeToString(secret)
+}
+
+// SetGatewayClientIP sets the client IP resolved by the gateway on the outgoing context of the call to the gRPC server.
+// Values of the same keys sent by the client are replaced.
+func SetGatewayClientIP(ctx context.Context, ip string) context.Context {
+	md, ok := metadata.FromOutgoingContext(ctx)
+	if ok {
+		md = md.Copy()
+	} else {
+		md = metadata.MD{}
+	}
+	md.Set(gatewayClientIPKey, ip)
+	md.Set(gatewayClientIPAuthKey, gatewaySecret)
+	return metadata.NewOutgoingContext(ctx, md)
+}
+
+// GatewayClientIP returns the client IP set by the gateway of this process.
+// It returns false if the call wasn't sent by the gateway.
+func GatewayClientIP(ctx context.Context) (string, bool) {
+	md, _ := metadata.FromIncomingContext(ctx)
+	auth := md.Get(gatewayClientIPAuthKey)
+	ip := md.Get(gatewayClientIPKey)
+	if len(auth) != 1 || len(ip) != 1 {
+		return "", false
+	}
+	if subtle.ConstantTimeCompare([]byte(auth[0]), []byte(gatewaySecret)) != 1 {
+		return "", false
+	}
+	return ip[0], true
+}
@@ -0,0 +1,87 @@
+package group
+
+import (
+	"github.com/zitadel/zitadel/internal/api/grpc/object"
+	"github.com/zitadel/zitadel/internal/query"
+	"github.com/zitadel/zitadel/internal/zerrors"
+	group_pb "github.com/zitadel/zitadel/pkg/grpc/group"
+)
+
+func GroupsToPb(groups []*query.Group) []*group_pb.Group {
+	g := make([]*group_pb.Group, len(groups))
+	for i, group := range groups {
+		g[i] = GroupToPb(group)
+	}
+	return g
+}
+
+func GroupToPb(group *query.Group) *group_pb.Group {
+	return &group_pb.Group{
+		Id:          group.ID,
+		Name:        group.Name,
+		Description: group.Description,
+		ParentId:    group.ParentID,
+		Details: object.ToViewDetailsPb(
+			group.Sequence,
+			group.CreationDate,
+			group.ChangeDate,
+			group.ResourceOwner,
+		),
+	}
+}
+
+func GroupMembersToPb(members []*query.GroupMember) []*group_pb.GroupMember {
+	m := make([]*group_pb.GroupMember, len(members))
+	for i, member := range members {
+		m[i] = &group_pb.GroupMember{
+			UserId: member.UserID,
+			Details: object.ToViewDetailsPb(
+				member.Sequence,
+				member.CreationDate,
+				member.CreationDate,
+				member.ResourceOwner,
+			),
+		}
+	}
+	return m
+}
+
+func GroupGrantsToPb(grants []*query.GroupGrant) []*group_pb.GroupGrant {
+	g := make([]*group_pb.GroupGrant, len(grants))
+	for i, grant := range grants {
+		g[i] = &group_pb.GroupGrant{
+			ProjectId:      grant.ProjectID,
+			ProjectGrantId: grant.ProjectGrantID,
+			RoleKeys:       grant.RoleKeys,
+			Details: object.ToViewDetailsPb(
+				grant.Sequence,
+				grant.CreationDate,
+				grant.ChangeDate,
+				grant.ResourceOwner,
+			),
+		}
+	}
+	return g
+}
+
+func GroupQueriesToModel(queries []*group_pb.GroupQuery) (_ []query.SearchQuery, err error) {
+	q := make([]query.SearchQuery, len(queries))
+	for i, query := range queries {
+		q[i], err = GroupQueryToModel(query)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return q, nil
+}
+
+func GroupQueryToModel(apiQuery *group_pb.GroupQuery) (query.SearchQuery, error) {
+	switch q := apiQuery.Query.(type) {
+	case *group_pb.GroupQuery_NameQuery:
+		return query.NewGroupNameSearchQuery(object.TextMethodToQuery(q.NameQuery.Method), q.NameQuery.Name)
+	case *group_pb.GroupQuery_ParentIdQuery:
+		return query.NewGroupParentIDSearchQuery(q.ParentIdQuery.ParentId)
+	default:
+		return nil, zerrors.ThrowInvalidArgument(nil, "GROUP-Gp1qi", "List.Query.Invalid")
+	}
+}
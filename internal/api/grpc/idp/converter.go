@@ -1,9 +1,12 @@
 package idp
 
 import (
+	"slices"
+
 	"github.com/crewjam/saml"
 	"github.com/muhlemmer/gu"
 	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
 
 	obj_grpc "github.com/zitadel/zitadel/internal/api/grpc/object"
 	"github.com/zitadel/zitadel/internal/domain"
@@ -285,6 +288,8 @@ func autoLinkingOptionToCommand(linking idp_pb.AutoLinkingOption) domain.AutoLin
 		return domain.AutoLinkingOptionUsername
 	case idp_pb.AutoLinkingOption_AUTO_LINKING_OPTION_EMAIL:
 		return domain.AutoLinkingOptionEmail
+	case idp_pb.AutoLinkingOption_AUTO_LINKING_OPTION_VERIFIED_EMAIL:
+		return domain.AutoLinkingOptionVerifiedEmail
 	case idp_pb.AutoLinkingOption_AUTO_LINKING_OPTION_UNSPECIFIED:
 		return domain.AutoLinkingOptionUnspecified
 	default:
@@ -310,6 +315,20 @@ func LDAPAttributesToCommand(attributes *idp_pb.LDAPAttributes) idp.LDAPAttribut
 		PreferredLanguageAttribute: attributes.PreferredLanguageAttribute,
 		AvatarURLAttribute:         attributes.AvatarUrlAttribute,
 		ProfileAttribute:           attributes.ProfileAttribute,
+		MetadataAttributes:         attributes.MetadataAttributes,
+	}
+}
+
+func LDAPGroupResolutionToDomain(resolution idp_pb.LDAPGroupResolution) domain.LDAPGroupResolution {
+	switch resolution {
+	case idp_pb.LDAPGroupResolution_LDAP_GROUP_RESOLUTION_MEMBER_OF:
+		return domain.LDAPGroupResolutionMemberOf
+	case idp_pb.LDAPGroupResolution_LDAP_GROUP_RESOLUTION_MATCHING_RULE_IN_CHAIN:
+		return domain.LDAPGroupResolutionMatchingRuleInChain
+	case idp_pb.LDAPGroupResolution_LDAP_GROUP_RESOLUTION_UNSPECIFIED:
+		return domain.LDAPGroupResolutionUnspecified
+	default:
+		return domain.LDAPGroupResolutionUnspecified
 	}
 }
 
@@ -490,6 +509,8 @@ func autoLinkingOptionToPb(linking domain.AutoLinkingOption) idp_pb.AutoLinkingO
 		return idp_pb.AutoLinkingOption_AUTO_LINKING_OPTION_USERNAME
 	case domain.AutoLinkingOptionEmail:
 		return idp_pb.AutoLinkingOption_AUTO_LINKING_OPTION_EMAIL
+	case domain.AutoLinkingOptionVerifiedEmail:
+		return idp_pb.AutoLinkingOption_AUTO_LINKING_OPTION_VERIFIED_EMAIL
 	default:
 		return idp_pb.AutoLinkingOption_AUTO_LINKING_OPTION_UNSPECIFIED
 	}
@@ -504,10 +525,73 @@ func oauthConfigToPb(providerConfig *idp_pb.ProviderConfig, template *query.OAut
 			UserEndpoint:          template.UserEndpoint,
 			Scopes:                template.Scopes,
 			IdAttribute:           template.IDAttribute,
+			AttributeMapping:      OAuthAttributeMappingToPb(template.AttributeMapping),
 		},
 	}
 }
 
+func OAuthAttributeMappingToPb(mapping *domain.OAuthAttributeMapping) *idp_pb.OAuthAttributeMapping {
+	if mapping == nil {
+		return nil
+	}
+	return &idp_pb.OAuthAttributeMapping{
+		FirstName:         mapping.FirstName,
+		LastName:          mapping.LastName,
+		DisplayName:       mapping.DisplayName,
+		NickName:          mapping.Nickname,
+		PreferredUsername: mapping.PreferredUsername,
+		Email:             mapping.Email,
+		EmailVerified:     mapping.EmailVerified,
+		Phone:             mapping.Phone,
+		PreferredLanguage: mapping.PreferredLanguage,
+		AvatarUrl:         mapping.AvatarURL,
+		Metadata:          mapping.Metadata,
+	}
+}
+
+func OAuthAttributeMappingToDomain(mapping *idp_pb.OAuthAttributeMapping) *domain.OAuthAttributeMapping {
+	if mapping == nil {
+		return nil
+	}
+	return &domain.OAuthAttributeMapping{
+		FirstName:         mapping.GetFirstName(),
+		LastName:          mapping.GetLastName(),
+		DisplayName:       mapping.GetDisplayName(),
+		Nickname:          mapping.GetNickName(),
+		PreferredUsername: mapping.GetPreferredUsername(),
+		Email:             mapping.GetEmail(),
+		EmailVerified:     mapping.GetEmailVerified(),
+		Phone:             mapping.GetPhone(),
+		PreferredLanguage: mapping.GetPreferredLanguage(),
+		AvatarURL:         mapping.GetAvatarUrl(),
+		Metadata:          mapping.GetMetadata(),
+	}
+}
+
+func OAuthMappedUserToPb(user *domain.ExternalUser) *idp_pb.OAuthMappedUser {
+	metadata := make(map[string]string, len(user.Metadatas))
+	for _, entry := range user.Metadatas {
+		metadata[entry.Key] = string(entry.Value)
+	}
+	var preferredLanguage string
+	if !user.PreferredLanguage.IsRoot() {
+		preferredLanguage = user.PreferredLanguage.String()
+	}
+	return &idp_pb.OAuthMappedUser{
+		Id:                user.ExternalUserID,
+		FirstName:         user.FirstName,
+		LastName:          user.LastName,
+		DisplayName:       user.DisplayName,
+		NickName:          user.NickName,
+		PreferredUsername: user.PreferredUsername,
+		Email:             string(user.Email),
+		IsEmailVerified:   user.IsEmailVerified,
+		Phone:             string(user.Phone),
+		PreferredLanguage: preferredLanguage,
+		Metadata:          metadata,
+	}
+}
+
 func oidcConfigToPb(providerConfig *idp_pb.ProviderConfig, template *query.OIDCIDPTemplate) {
 	providerConfig.Config = &idp_pb.ProviderConfig_Oidc{
 		Oidc: &idp_pb.GenericOIDCConfig{
@@ -533,10 +617,12 @@ func jwtConfigToPb(providerConfig *idp_pb.ProviderConfig, template *query.JWTIDP
 func azureConfigToPb(providerConfig *idp_pb.ProviderConfig, template *query.AzureADIDPTemplate) {
 	providerConfig.Config = &idp_pb.ProviderConfig_AzureAd{
 		AzureAd: &idp_pb.AzureADConfig{
-			ClientId:      template.ClientID,
-			Tenant:        azureTenantToPb(template.Tenant),
-			EmailVerified: template.IsEmailVerified,
-			Scopes:        template.Scopes,
+			ClientId:       template.ClientID,
+			Tenant:         azureTenantToPb(template.Tenant),
+			EmailVerified:  template.IsEmailVerified,
+			Scopes:         template.Scopes,
+			AllowedTenants: template.AllowedTenants,
+			FetchGroups:    template.FetchGroups,
 		},
 	}
 }
@@ -612,15 +698,19 @@ func ldapConfigToPb(providerConfig *idp_pb.ProviderConfig, template *query.LDAPI
 	}
 	providerConfig.Config = &idp_pb.ProviderConfig_Ldap{
 		Ldap: &idp_pb.LDAPConfig{
-			Servers:           template.Servers,
-			StartTls:          template.StartTLS,
-			BaseDn:            template.BaseDN,
-			BindDn:            template.BindDN,
-			UserBase:          template.UserBase,
-			UserObjectClasses: template.UserObjectClasses,
-			UserFilters:       template.UserFilters,
-			Timeout:           timeout,
-			Attributes:        ldapAttributesToPb(template.LDAPAttributes),
+			Servers:            template.Servers,
+			StartTls:           template.StartTLS,
+			BaseDn:             template.BaseDN,
+			BindDn:             template.BindDN,
+			UserBase:           template.UserBase,
+			UserObjectClasses:  template.UserObjectClasses,
+			UserFilters:        template.UserFilters,
+			Timeout:            timeout,
+			Attributes:         ldapAttributesToPb(template.LDAPAttributes),
+			RootCa:             template.RootCA,
+			GroupResolution:    ldapGroupResolutionToPb(template.GroupResolution),
+			GroupBase:          template.GroupBase,
+			GroupNameAttribute: template.GroupNameAttribute,
 		},
 	}
 }
@@ -640,6 +730,20 @@ func ldapAttributesToPb(attributes idp.LDAPAttributes) *idp_pb.LDAPAttributes {
 		PreferredLanguageAttribute: attributes.PreferredLanguageAttribute,
 		AvatarUrlAttribute:         attributes.AvatarURLAttribute,
 		ProfileAttribute:           attributes.ProfileAttribute,
+		MetadataAttributes:         attributes.MetadataAttributes,
+	}
+}
+
+func ldapGroupResolutionToPb(resolution domain.LDAPGroupResolution) idp_pb.LDAPGroupResolution {
+	switch resolution {
+	case domain.LDAPGroupResolutionMemberOf:
+		return idp_pb.LDAPGroupResolution_LDAP_GROUP_RESOLUTION_MEMBER_OF
+	case domain.LDAPGroupResolutionMatchingRuleInChain:
+		return idp_pb.LDAPGroupResolution_LDAP_GROUP_RESOLUTION_MATCHING_RULE_IN_CHAIN
+	case domain.LDAPGroupResolutionUnspecified:
+		return idp_pb.LDAPGroupResolution_LDAP_GROUP_RESOLUTION_UNSPECIFIED
+	default:
+		return idp_pb.LDAPGroupResolution_LDAP_GROUP_RESOLUTION_UNSPECIFIED
 	}
 }
 
@@ -699,3 +803,115 @@ func nameIDToPb(format domain.SAMLNameIDFormat) idp_pb.SAMLNameIDFormat {
 		return idp_pb.SAMLNameIDFormat_SAML_NAME_ID_FORMAT_UNSPECIFIED
 	}
 }
+
+func RoleMappingsToPb(mappings []*domain.IDPRoleMapping) []*idp_pb.IDPRoleMapping {
+	resp := make([]*idp_pb.IDPRoleMapping, len(mappings))
+	for i, mapping := range mappings {
+		resp[i] = &idp_pb.IDPRoleMapping{
+			Claim:           mapping.Claim,
+			Value:           mapping.Value,
+			OrgRoles:        mapping.OrgRoles,
+			ProjectId:       mapping.ProjectID,
+			ProjectGrantId:  mapping.ProjectGrantID,
+			ProjectRoleKeys: mapping.ProjectRoleKeys,
+		}
+	}
+	return resp
+}
+
+func RoleMappingsToDomain(mappings []*idp_pb.IDPRoleMapping) []*domain.IDPRoleMapping {
+	resp := make([]*domain.IDPRoleMapping, len(mappings))
+	for i, mapping := range mappings {
+		resp[i] = &domain.IDPRoleMapping{
+			Claim:           mapping.GetClaim(),
+			Value:           mapping.GetValue(),
+			OrgRoles:        mapping.GetOrgRoles(),
+			ProjectID:       mapping.GetProjectId(),
+			ProjectGrantID:  mapping.GetProjectGrantId(),
+			ProjectRoleKeys: mapping.GetProjectRoleKeys(),
+		}
+	}
+	return resp
+}
+
+func AutoGrantsToPb(grants []*domain.IDPAutoGrant) []*idp_pb.IDPAutoGrant {
+	resp := make([]*idp_pb.IDPAutoGrant, len(grants))
+	for i, grant := range grants {
+		resp[i] = &idp_pb.IDPAutoGrant{
+			ProjectId:      grant.ProjectID,
+			ProjectGrantId: grant.ProjectGrantID,
+			RoleKeys:       grant.RoleKeys,
+		}
+	}
+	return resp
+}
+
+func AutoGrantsToDomain(grants []*idp_pb.IDPAutoGrant) []*domain.IDPAutoGrant {
+	resp := make([]*domain.IDPAutoGrant, len(grants))
+	for i, grant := range grants {
+		resp[i] = &domain.IDPAutoGrant{
+			ProjectID:      grant.GetProjectId(),
+			ProjectGrantID: grant.GetProjectGrantId(),
+			RoleKeys:       grant.GetRoleKeys(),
+		}
+	}
+	return resp
+}
+
+func IDPButtonsToPb(buttons []*domain.IDPButton, icons map[string]string) []*idp_pb.IDPButton {
+	resp := make([]*idp_pb.IDPButton, len(buttons))
+	for i, button := range buttons {
+		resp[i] = &idp_pb.IDPButton{
+			IdpId:   button.IDPID,
+			Domains: button.Domains,
+			HasIcon: icons[button.IDPID] != "",
+		}
+	}
+	return resp
+}
+
+func IDPButtonsToDomain(buttons []*idp_pb.IDPButton) []*domain.IDPButton {
+	resp := make([]*domain.IDPButton, len(buttons))
+	for i, button := range buttons {
+		resp[i] = &domain.IDPButton{
+			IDPID:   button.GetIdpId(),
+			Domains: button.GetDomains(),
+		}
+	}
+	return resp
+}
+
+func HealthChecksToPb(checks, failed []*domain.IDPHealthCheck) []*idp_pb.IDPHealthCheck {
+	resp := make([]*idp_pb.IDPHealthCheck, len(checks))
+	for i, check := range checks {
+		resp[i] = &idp_pb.IDPHealthCheck{
+			Type:   healthCheckTypeToPb(check.Type),
+			Target: check.Target,
+			Error:  check.Error,
+			Failed: slices.Contains(failed, check),
+		}
+		if !check.ExpirationDate.IsZero() {
+			resp[i].ExpirationDate = timestamppb.New(check.ExpirationDate)
+		}
+	}
+	return resp
+}
+
+func healthCheckTypeToPb(checkType domain.IDPHealthCheckType) idp_pb.IDPHealthCheckType {
+	switch checkType {
+	case domain.IDPHealthCheckTypeConfiguration:
+		return idp_pb.IDPHealthCheckType_IDP_HEALTH_CHECK_TYPE_CONFIGURATION
+	case domain.IDPHealthCheckTypeMetadata:
+		return idp_pb.IDPHealthCheckType_IDP_HEALTH_CHECK_TYPE_METADATA
+	case domain.IDPHealthCheckTypeTokenEndpoint:
+		return idp_pb.IDPHealthCheckType_IDP_HEALTH_CHECK_TYPE_TOKEN_ENDPOINT
+	case domain.IDPHealthCheckTypeCertificate:
+		return idp_pb.IDPHealthCheckType_IDP_HEALTH_CHECK_TYPE_CERTIFICATE
+	case domain.IDPHealthCheckTypeConnection:
+		return idp_pb.IDPHealthCheckType_IDP_HEALTH_CHECK_TYPE_CONNECTION
+	case domain.IDPHealthCheckTypeUnspecified:
+		return idp_pb.IDPHealthCheckType_IDP_HEALTH_CHECK_TYPE_UNSPECIFIED
+	default:
+		return idp_pb.IDPHealthCheckType_IDP_HEALTH_CHECK_TYPE_UNSPECIFIED
+	}
+}
@@ -0,0 +1,109 @@
+package management
+
+import (
+	"context"
+	"slices"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	object_grpc "github.com/zitadel/zitadel/internal/api/grpc/object"
+	"github.com/zitadel/zitadel/internal/domain"
+	access_review_pb "github.com/zitadel/zitadel/pkg/grpc/access_review"
+	mgmt_pb "github.com/zitadel/zitadel/pkg/grpc/management"
+)
+
+func (s *Server) ListAccessReviews(ctx context.Context, _ *mgmt_pb.ListAccessReviewsRequest) (*mgmt_pb.ListAccessReviewsResponse, error) {
+	reviews, err := s.query.AccessReviews(ctx, authz.GetCtxData(ctx).OrgID)
+	if err != nil {
+		return nil, err
+	}
+	return &mgmt_pb.ListAccessReviewsResponse{
+		Result: AccessReviewsToPb(reviews),
+	}, nil
+}
+
+func (s *Server) GetAccessReviewByID(ctx context.Context, req *mgmt_pb.GetAccessReviewByIDRequest) (*mgmt_pb.GetAccessReviewByIDResponse, error) {
+	review, err := s.query.AccessReviewByID(ctx, authz.GetCtxData(ctx).OrgID, req.GetId())
+	if err != nil {
+		return nil, err
+	}
+	return &mgmt_pb.GetAccessReviewByIDResponse{
+		AccessReview: AccessReviewToPb(review),
+	}, nil
+}
+
+func (s *Server) AddAccessReview(ctx context.Context, req *mgmt_pb.AddAccessReviewRequest) (*mgmt_pb.AddAccessReviewResponse, error) {
+	id, details, err := s.command.AddAccessReview(ctx, authz.GetCtxData(ctx).OrgID, addAccessReviewRequestToCommand(req))
+	if err != nil {
+		return nil, err
+	}
+	return &mgmt_pb.AddAccessReviewResponse{
+		Id:      id,
+		Details: object_grpc.DomainToAddDetailsPb(details),
+	}, nil
+}
+
+func (s *Server) UpdateAccessReview(ctx context.Context, req *mgmt_pb.UpdateAccessReviewRequest) (*mgmt_pb.UpdateAccessReviewResponse, error) {
+	details, err := s.command.ChangeAccessReview(ctx, authz.GetCtxData(ctx).OrgID, req.GetId(), updateAccessReviewRequestToCommand(req))
+	if err != nil {
+		return nil, err
+	}
+	return &mgmt_pb.UpdateAccessReviewResponse{
+		Details: object_grpc.DomainToChangeDetailsPb(details),
+	}, nil
+}
+
+func (s *Server) RemoveAccessReview(ctx context.Context, req *mgmt_pb.RemoveAccessReviewRequest) (*mgmt_pb.RemoveAccessReviewResponse, error) {
+	details, err := s.command.RemoveAccessReview(ctx, authz.GetCtxData(ctx).OrgID, req.GetId())
+	if err != nil {
+		return nil, err
+	}
+	return &mgmt_pb.RemoveAccessReviewResponse{
+		Details: object_grpc.DomainToChangeDetailsPb(details),
+	}, nil
+}
+
+func (s *Server) CloseAccessReviewCampaign(ctx context.Context, req *mgmt_pb.CloseAccessReviewCampaignRequest) (*mgmt_pb.CloseAccessReviewCampaignResponse, error) {
+	details, err := s.command.CloseAccessReviewCampaign(ctx, authz.GetCtxData(ctx).OrgID, req.GetId())
+	if err != nil {
+		return nil, err
+	}
+	return &mgmt_pb.CloseAccessReviewCampaignResponse{
+		Details: object_grpc.DomainToChangeDetailsPb(details),
+	}, nil
+}
+
+func (s *Server) ListMyAccessReviewCampaigns(ctx context.Context, _ *mgmt_pb.ListMyAccessReviewCampaignsRequest) (*mgmt_pb.ListMyAccessReviewCampaignsResponse, error) {
+	ctxData := authz.GetCtxData(ctx)
+	reviews, err := s.query.AccessReviews(ctx, ctxData.OrgID)
+	if err != nil {
+		return nil, err
+	}
+	campaigns := make([]*access_review_pb.Campaign, 0)
+	for _, review := range reviews {
+		for _, campaign := range review.Campaigns {
+			if campaign.State != domain.AccessReviewCampaignStateOpen || !slices.Contains(campaign.Reviewers, ctxData.UserID) {
+				continue
+			}
+			campaigns = append(campaigns, AccessReviewCampaignToPb(review.ID, campaign))
+		}
+	}
+	return &mgmt_pb.ListMyAccessReviewCampaignsResponse{
+		Result: campaigns,
+	}, nil
+}
+
+func (s *Server) DecideAccessReviewItem(ctx context.Context, req *mgmt_pb.DecideAccessReviewItemRequest) (*mgmt_pb.DecideAccessReviewItemResponse, error) {
+	details, err := s.command.DecideAccessReviewItem(ctx,
+		authz.GetCtxData(ctx).OrgID,
+		req.GetId(),
+		req.GetGrantId(),
+		domain.AccessReviewDecision(req.GetDecision()),
+		req.GetComment(),
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &mgmt_pb.DecideAccessReviewItemResponse{
+		Details: object_grpc.DomainToChangeDetailsPb(details),
+	}, nil
+}
@@ -0,0 +1,101 @@
+package management
+
+import (
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	object_grpc "github.com/zitadel/zitadel/internal/api/grpc/object"
+	"github.com/zitadel/zitadel/internal/command"
+	"github.com/zitadel/zitadel/internal/query"
+	access_review_pb "github.com/zitadel/zitadel/pkg/grpc/access_review"
+	mgmt_pb "github.com/zitadel/zitadel/pkg/grpc/management"
+)
+
+func addAccessReviewRequestToCommand(req *mgmt_pb.AddAccessReviewRequest) *command.AccessReview {
+	review := &command.AccessReview{
+		Name:            req.GetName(),
+		ProjectIDs:      req.GetProjectIds(),
+		Reviewers:       req.GetReviewerIds(),
+		Duration:        req.GetDuration().AsDuration(),
+		Interval:        req.GetInterval().AsDuration(),
+		RevokeUndecided: req.GetRevokeUndecided(),
+	}
+	if req.GetStartDate() != nil {
+		review.StartDate = req.GetStartDate().AsTime()
+	}
+	return review
+}
+
+func updateAccessReviewRequestToCommand(req *mgmt_pb.UpdateAccessReviewRequest) *command.AccessReview {
+	return &command.AccessReview{
+		Name:            req.GetName(),
+		ProjectIDs:      req.GetProjectIds(),
+		Reviewers:       req.GetReviewerIds(),
+		Duration:        req.GetDuration().AsDuration(),
+		Interval:        req.GetInterval().AsDuration(),
+		RevokeUndecided: req.GetRevokeUndecided(),
+	}
+}
+
+func AccessReviewsToPb(reviews []*query.AccessReview) []*access_review_pb.AccessReview {
+	pb := make([]*access_review_pb.AccessReview, len(reviews))
+	for i, review := range reviews {
+		pb[i] = AccessReviewToPb(review)
+	}
+	return pb
+}
+
+func AccessReviewToPb(review *query.AccessReview) *access_review_pb.AccessReview {
+	campaigns := make([]*access_review_pb.Campaign, len(review.Campaigns))
+	for i, campaign := range review.Campaigns {
+		campaigns[i] = AccessReviewCampaignToPb(review.ID, campaign)
+	}
+	pb := &access_review_pb.AccessReview{
+		Id:              review.ID,
+		Details:         object_grpc.ChangeToDetailsPb(review.Sequence, review.ChangeDate, review.ResourceOwner),
+		Name:            review.Name,
+		ProjectIds:      review.ProjectIDs,
+		ReviewerIds:     review.Reviewers,
+		StartDate:       timestamppb.New(review.StartDate),
+		Duration:        durationpb.New(review.Duration),
+		RevokeUndecided: review.RevokeUndecided,
+		Campaigns:       campaigns,
+	}
+	if review.Interval > 0 {
+		pb.Interval = durationpb.New(review.Interval)
+	}
+	return pb
+}
+
+func AccessReviewCampaignToPb(reviewID string, campaign *query.AccessReviewCampaign) *access_review_pb.Campaign {
+	items := make([]*access_review_pb.Item, len(campaign.Items))
+	for i, item := range campaign.Items {
+		items[i] = &access_review_pb.Item{
+			GrantId:        item.GrantID,
+			UserId:         item.UserID,
+			ProjectId:      item.ProjectID,
+			ProjectGrantId: item.ProjectGrantID,
+			RoleKeys:       item.RoleKeys,
+			Decision:       access_review_pb.Decision(item.Decision),
+			DecidedBy:      item.DecidedBy,
+			Comment:        item.Comment,
+			Revoked:        item.Revoked,
+		}
+		if !item.DecisionDate.IsZero() {
+			items[i].DecisionDate = timestamppb.New(item.DecisionDate)
+		}
+	}
+	pb := &access_review_pb.Campaign{
+		Id:             campaign.ID,
+		AccessReviewId: reviewID,
+		State:          access_review_pb.CampaignState(campaign.State),
+		StartDate:      timestamppb.New(campaign.StartDate),
+		DueDate:        timestamppb.New(campaign.DueDate),
+		ReviewerIds:    campaign.Reviewers,
+		Items:          items,
+	}
+	if !campaign.CloseDate.IsZero() {
+		pb.CloseDate = timestamppb.New(campaign.CloseDate)
+	}
+	return pb
+}
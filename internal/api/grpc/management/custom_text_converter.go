@@ -172,10 +172,15 @@ func SetLoginCustomTextToDomain(req *mgmt_pb.SetCustomLoginTextsRequest) *domain
 	result.ExternalRegistrationUserOverview = text.ExternalRegistrationUserOverviewScreenTextPbToDomain(req.ExternalRegistrationUserOverviewText)
 	result.RegistrationOrg = text.RegistrationOrgScreenTextPbToDomain(req.RegistrationOrgText)
 	result.LinkingUserPrompt = text.LinkingUserPromptScreenTextPbToDomain(req.LinkingUserPromptText)
+	result.UsernameRecovery = text.UsernameRecoveryScreenTextPbToDomain(req.UsernameRecoveryText)
+	result.UsernameRecoveryDone = text.UsernameRecoveryDoneScreenTextPbToDomain(req.UsernameRecoveryDoneText)
+	result.PasskeyPrompt = text.PasskeyPromptScreenTextPbToDomain(req.PasskeyPromptText)
+	result.LinkedIDPs = text.LinkedIDPsScreenTextPbToDomain(req.LinkedIdpsText)
 	result.LinkingUsersDone = text.LinkingUserDoneScreenTextPbToDomain(req.LinkingUserDoneText)
 	result.ExternalNotFound = text.ExternalUserNotFoundScreenTextPbToDomain(req.ExternalUserNotFoundText)
 	result.LoginSuccess = text.SuccessLoginScreenTextPbToDomain(req.SuccessLoginText)
 	result.LogoutDone = text.LogoutDoneScreenTextPbToDomain(req.LogoutText)
+	result.LogoutConfirmation = text.LogoutConfirmationScreenTextPbToDomain(req.LogoutConfirmationText)
 	result.Footer = text.FooterTextPbToDomain(req.FooterText)
 
 	return result
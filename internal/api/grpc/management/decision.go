@@ -0,0 +1,48 @@
+package management
+
+import (
+	"context"
+	"time"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/api/grpc/decision"
+	object_pb "github.com/zitadel/zitadel/internal/api/grpc/object"
+	"github.com/zitadel/zitadel/internal/query"
+	mgmt_pb "github.com/zitadel/zitadel/pkg/grpc/management"
+)
+
+func (s *Server) ListAuthorizationDecisions(ctx context.Context, req *mgmt_pb.ListAuthorizationDecisionsRequest) (*mgmt_pb.ListAuthorizationDecisionsResponse, error) {
+	queries, err := listAuthorizationDecisionsToModel(authz.GetCtxData(ctx).OrgID, req)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := s.query.SearchAuthZDecisions(ctx, queries)
+	if err != nil {
+		return nil, err
+	}
+	return &mgmt_pb.ListAuthorizationDecisionsResponse{
+		Result:  decision.DecisionsToPb(resp.Decisions),
+		Details: object_pb.ToListDetails(resp.Count, 0, time.Time{}),
+	}, nil
+}
+
+func listAuthorizationDecisionsToModel(orgID string, req *mgmt_pb.ListAuthorizationDecisionsRequest) (*query.AuthZDecisionSearchQueries, error) {
+	offset, limit, asc := object_pb.ListQueryToModel(req.Query)
+	queries, err := decision.DecisionQueriesToQuery(req.GetQueries())
+	if err != nil {
+		return nil, err
+	}
+	orgQuery, err := query.NewAuthZDecisionOrgIDSearchQuery(orgID)
+	if err != nil {
+		return nil, err
+	}
+	return &query.AuthZDecisionSearchQueries{
+		SearchRequest: query.SearchRequest{
+			Offset:        offset,
+			Limit:         limit,
+			Asc:           asc,
+			SortingColumn: decision.DecisionFieldNameToSortingColumn(req.GetSortingColumn()),
+		},
+		Queries: append(queries, orgQuery),
+	}, nil
+}
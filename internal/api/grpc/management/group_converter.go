@@ -0,0 +1,108 @@
+package management
+
+import (
+	"context"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	group_grpc "github.com/zitadel/zitadel/internal/api/grpc/group"
+	"github.com/zitadel/zitadel/internal/api/grpc/object"
+	"github.com/zitadel/zitadel/internal/command"
+	"github.com/zitadel/zitadel/internal/eventstore/v1/models"
+	"github.com/zitadel/zitadel/internal/query"
+	mgmt_pb "github.com/zitadel/zitadel/pkg/grpc/management"
+)
+
+func listGroupsRequestToModel(ctx context.Context, req *mgmt_pb.ListGroupsRequest) (*query.GroupSearchQueries, error) {
+	offset, limit, asc := object.ListQueryToModel(req.Query)
+	queries, err := group_grpc.GroupQueriesToModel(req.Queries)
+	if err != nil {
+		return nil, err
+	}
+	ownerQuery, err := query.NewGroupResourceOwnerSearchQuery(authz.GetCtxData(ctx).OrgID)
+	if err != nil {
+		return nil, err
+	}
+	return &query.GroupSearchQueries{
+		SearchRequest: query.SearchRequest{
+			Offset: offset,
+			Limit:  limit,
+			Asc:    asc,
+		},
+		Queries: append(queries, ownerQuery),
+	}, nil
+}
+
+func listGroupMembersRequestToModel(ctx context.Context, req *mgmt_pb.ListGroupMembersRequest) (*query.GroupMemberSearchQueries, error) {
+	offset, limit, asc := object.ListQueryToModel(req.Query)
+	groupQuery, err := query.NewGroupMemberGroupIDSearchQuery(req.GroupId)
+	if err != nil {
+		return nil, err
+	}
+	ownerQuery, err := query.NewGroupMemberResourceOwnerSearchQuery(authz.GetCtxData(ctx).OrgID)
+	if err != nil {
+		return nil, err
+	}
+	return &query.GroupMemberSearchQueries{
+		SearchRequest: query.SearchRequest{
+			Offset: offset,
+			Limit:  limit,
+			Asc:    asc,
+		},
+		Queries: []query.SearchQuery{groupQuery, ownerQuery},
+	}, nil
+}
+
+func listGroupGrantsRequestToModel(ctx context.Context, req *mgmt_pb.ListGroupGrantsRequest) (*query.GroupGrantSearchQueries, error) {
+	offset, limit, asc := object.ListQueryToModel(req.Query)
+	groupQuery, err := query.NewGroupGrantGroupIDSearchQuery(req.GroupId)
+	if err != nil {
+		return nil, err
+	}
+	ownerQuery, err := query.NewGroupGrantResourceOwnerSearchQuery(authz.GetCtxData(ctx).OrgID)
+	if err != nil {
+		return nil, err
+	}
+	return &query.GroupGrantSearchQueries{
+		SearchRequest: query.SearchRequest{
+			Offset: offset,
+			Limit:  limit,
+			Asc:    asc,
+		},
+		Queries: []query.SearchQuery{groupQuery, ownerQuery},
+	}, nil
+}
+
+func addGroupRequestToCommand(req *mgmt_pb.AddGroupRequest) *command.AddGroup {
+	return &command.AddGroup{
+		Name:        req.Name,
+		Description: req.Description,
+		ParentID:    req.ParentId,
+	}
+}
+
+func updateGroupRequestToCommand(req *mgmt_pb.UpdateGroupRequest) *command.ChangeGroup {
+	return &command.ChangeGroup{
+		ObjectRoot: models.ObjectRoot{
+			AggregateID: req.Id,
+		},
+		Name:        &req.Name,
+		Description: &req.Description,
+	}
+}
+
+func addGroupGrantRequestToCommand(req *mgmt_pb.AddGroupGrantRequest) *command.GroupGrant {
+	return &command.GroupGrant{
+		GroupID:        req.GroupId,
+		ProjectID:      req.ProjectId,
+		ProjectGrantID: req.ProjectGrantId,
+		RoleKeys:       req.RoleKeys,
+	}
+}
+
+func updateGroupGrantRequestToCommand(req *mgmt_pb.UpdateGroupGrantRequest) *command.GroupGrant {
+	return &command.GroupGrant{
+		GroupID:   req.GroupId,
+		ProjectID: req.ProjectId,
+		RoleKeys:  req.RoleKeys,
+	}
+}
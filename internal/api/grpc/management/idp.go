@@ -2,6 +2,9 @@ package management
 
 import (
 	"context"
+	"time"
+
+	"google.golang.org/protobuf/types/known/structpb"
 
 	"github.com/zitadel/zitadel/internal/api/authz"
 	idp_grpc "github.com/zitadel/zitadel/internal/api/grpc/idp"
@@ -192,6 +195,21 @@ func (s *Server) UpdateGenericOAuthProvider(ctx context.Context, req *mgmt_pb.Up
 	}, nil
 }
 
+func (s *Server) TestGenericOAuthProviderAttributeMapping(ctx context.Context, req *mgmt_pb.TestGenericOAuthProviderAttributeMappingRequest) (*mgmt_pb.TestGenericOAuthProviderAttributeMappingResponse, error) {
+	result, err := s.command.TestOrgGenericOAuthProviderAttributeMapping(ctx, authz.GetCtxData(ctx).OrgID, req.Id, req.AccessToken, idp_grpc.OAuthAttributeMappingToDomain(req.AttributeMapping))
+	if err != nil {
+		return nil, err
+	}
+	rawInfo, err := structpb.NewStruct(result.RawInfo)
+	if err != nil {
+		return nil, err
+	}
+	return &mgmt_pb.TestGenericOAuthProviderAttributeMappingResponse{
+		RawInfo: rawInfo,
+		User:    idp_grpc.OAuthMappedUserToPb(result.User),
+	}, nil
+}
+
 func (s *Server) AddGenericOIDCProvider(ctx context.Context, req *mgmt_pb.AddGenericOIDCProviderRequest) (*mgmt_pb.AddGenericOIDCProviderResponse, error) {
 	id, details, err := s.command.AddOrgGenericOIDCProvider(ctx, authz.GetCtxData(ctx).OrgID, addGenericOIDCProviderToCommand(req))
 	if err != nil {
@@ -458,3 +476,45 @@ func (s *Server) DeleteProvider(ctx context.Context, req *mgmt_pb.DeleteProvider
 		Details: object_pb.DomainToChangeDetailsPb(details),
 	}, nil
 }
+
+func (s *Server) GetProviderRoleMapping(ctx context.Context, req *mgmt_pb.GetProviderRoleMappingRequest) (*mgmt_pb.GetProviderRoleMappingResponse, error) {
+	mapping, err := s.query.IDPRoleMappingByID(ctx, req.Id, authz.GetCtxData(ctx).OrgID)
+	if err != nil {
+		return nil, err
+	}
+	return &mgmt_pb.GetProviderRoleMappingResponse{
+		Details:  object_pb.ToViewDetailsPb(mapping.Sequence, time.Time{}, mapping.ChangeDate, mapping.ResourceOwner),
+		Mappings: idp_grpc.RoleMappingsToPb(mapping.Mappings),
+	}, nil
+}
+
+func (s *Server) SetProviderRoleMapping(ctx context.Context, req *mgmt_pb.SetProviderRoleMappingRequest) (*mgmt_pb.SetProviderRoleMappingResponse, error) {
+	details, err := s.command.SetOrgIDPRoleMapping(ctx, authz.GetCtxData(ctx).OrgID, req.Id, idp_grpc.RoleMappingsToDomain(req.Mappings))
+	if err != nil {
+		return nil, err
+	}
+	return &mgmt_pb.SetProviderRoleMappingResponse{
+		Details: object_pb.DomainToChangeDetailsPb(details),
+	}, nil
+}
+
+func (s *Server) GetProviderAutoGrants(ctx context.Context, req *mgmt_pb.GetProviderAutoGrantsRequest) (*mgmt_pb.GetProviderAutoGrantsResponse, error) {
+	grants, err := s.query.IDPAutoGrantsByID(ctx, req.Id, authz.GetCtxData(ctx).OrgID)
+	if err != nil {
+		return nil, err
+	}
+	return &mgmt_pb.GetProviderAutoGrantsResponse{
+		Details: object_pb.ToViewDetailsPb(grants.Sequence, time.Time{}, grants.ChangeDate, grants.ResourceOwner),
+		Grants:  idp_grpc.AutoGrantsToPb(grants.Grants),
+	}, nil
+}
+
+func (s *Server) SetProviderAutoGrants(ctx context.Context, req *mgmt_pb.SetProviderAutoGrantsRequest) (*mgmt_pb.SetProviderAutoGrantsResponse, error) {
+	details, err := s.command.SetOrgIDPAutoGrants(ctx, authz.GetCtxData(ctx).OrgID, req.Id, idp_grpc.AutoGrantsToDomain(req.Grants))
+	if err != nil {
+		return nil, err
+	}
+	return &mgmt_pb.SetProviderAutoGrantsResponse{
+		Details: object_pb.DomainToChangeDetailsPb(details),
+	}, nil
+}
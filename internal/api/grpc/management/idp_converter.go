@@ -208,6 +208,7 @@ func addGenericOAuthProviderToCommand(req *mgmt_pb.AddGenericOAuthProviderReques
 		UserEndpoint:          req.UserEndpoint,
 		Scopes:                req.Scopes,
 		IDAttribute:           req.IdAttribute,
+		AttributeMapping:      idp_grpc.OAuthAttributeMappingToDomain(req.AttributeMapping),
 		IDPOptions:            idp_grpc.OptionsToCommand(req.ProviderOptions),
 	}
 }
@@ -222,6 +223,7 @@ func updateGenericOAuthProviderToCommand(req *mgmt_pb.UpdateGenericOAuthProvider
 		UserEndpoint:          req.UserEndpoint,
 		Scopes:                req.Scopes,
 		IDAttribute:           req.IdAttribute,
+		AttributeMapping:      idp_grpc.OAuthAttributeMappingToDomain(req.AttributeMapping),
 		IDPOptions:            idp_grpc.OptionsToCommand(req.ProviderOptions),
 	}
 }
@@ -274,25 +276,29 @@ func updateJWTProviderToCommand(req *mgmt_pb.UpdateJWTProviderRequest) command.J
 
 func addAzureADProviderToCommand(req *mgmt_pb.AddAzureADProviderRequest) command.AzureADProvider {
 	return command.AzureADProvider{
-		Name:          req.Name,
-		ClientID:      req.ClientId,
-		ClientSecret:  req.ClientSecret,
-		Tenant:        idp_grpc.AzureADTenantToCommand(req.Tenant),
-		EmailVerified: req.EmailVerified,
-		IDPOptions:    idp_grpc.OptionsToCommand(req.ProviderOptions),
-		Scopes:        req.Scopes,
+		Name:           req.Name,
+		ClientID:       req.ClientId,
+		ClientSecret:   req.ClientSecret,
+		Tenant:         idp_grpc.AzureADTenantToCommand(req.Tenant),
+		EmailVerified:  req.EmailVerified,
+		AllowedTenants: req.AllowedTenants,
+		FetchGroups:    req.FetchGroups,
+		IDPOptions:     idp_grpc.OptionsToCommand(req.ProviderOptions),
+		Scopes:         req.Scopes,
 	}
 }
 
 func updateAzureADProviderToCommand(req *mgmt_pb.UpdateAzureADProviderRequest) command.AzureADProvider {
 	return command.AzureADProvider{
-		Name:          req.Name,
-		ClientID:      req.ClientId,
-		ClientSecret:  req.ClientSecret,
-		Tenant:        idp_grpc.AzureADTenantToCommand(req.Tenant),
-		EmailVerified: req.EmailVerified,
-		IDPOptions:    idp_grpc.OptionsToCommand(req.ProviderOptions),
-		Scopes:        req.Scopes,
+		Name:           req.Name,
+		ClientID:       req.ClientId,
+		ClientSecret:   req.ClientSecret,
+		Tenant:         idp_grpc.AzureADTenantToCommand(req.Tenant),
+		EmailVerified:  req.EmailVerified,
+		AllowedTenants: req.AllowedTenants,
+		FetchGroups:    req.FetchGroups,
+		IDPOptions:     idp_grpc.OptionsToCommand(req.ProviderOptions),
+		Scopes:         req.Scopes,
 	}
 }
 
@@ -406,35 +412,43 @@ func updateGoogleProviderToCommand(req *mgmt_pb.UpdateGoogleProviderRequest) com
 
 func addLDAPProviderToCommand(req *mgmt_pb.AddLDAPProviderRequest) command.LDAPProvider {
 	return command.LDAPProvider{
-		Name:              req.Name,
-		Servers:           req.Servers,
-		StartTLS:          req.StartTls,
-		BaseDN:            req.BaseDn,
-		BindDN:            req.BindDn,
-		BindPassword:      req.BindPassword,
-		UserBase:          req.UserBase,
-		UserObjectClasses: req.UserObjectClasses,
-		UserFilters:       req.UserFilters,
-		Timeout:           req.Timeout.AsDuration(),
-		LDAPAttributes:    idp_grpc.LDAPAttributesToCommand(req.Attributes),
-		IDPOptions:        idp_grpc.OptionsToCommand(req.ProviderOptions),
+		Name:               req.Name,
+		Servers:            req.Servers,
+		StartTLS:           req.StartTls,
+		BaseDN:             req.BaseDn,
+		BindDN:             req.BindDn,
+		BindPassword:       req.BindPassword,
+		UserBase:           req.UserBase,
+		UserObjectClasses:  req.UserObjectClasses,
+		UserFilters:        req.UserFilters,
+		Timeout:            req.Timeout.AsDuration(),
+		LDAPAttributes:     idp_grpc.LDAPAttributesToCommand(req.Attributes),
+		IDPOptions:         idp_grpc.OptionsToCommand(req.ProviderOptions),
+		RootCA:             req.RootCa,
+		GroupResolution:    idp_grpc.LDAPGroupResolutionToDomain(req.GroupResolution),
+		GroupBase:          req.GroupBase,
+		GroupNameAttribute: req.GroupNameAttribute,
 	}
 }
 
 func updateLDAPProviderToCommand(req *mgmt_pb.UpdateLDAPProviderRequest) command.LDAPProvider {
 	return command.LDAPProvider{
-		Name:              req.Name,
-		Servers:           req.Servers,
-		StartTLS:          req.StartTls,
-		BaseDN:            req.BaseDn,
-		BindDN:            req.BindDn,
-		BindPassword:      req.BindPassword,
-		UserBase:          req.UserBase,
-		UserObjectClasses: req.UserObjectClasses,
-		UserFilters:       req.UserFilters,
-		Timeout:           req.Timeout.AsDuration(),
-		LDAPAttributes:    idp_grpc.LDAPAttributesToCommand(req.Attributes),
-		IDPOptions:        idp_grpc.OptionsToCommand(req.ProviderOptions),
+		Name:               req.Name,
+		Servers:            req.Servers,
+		StartTLS:           req.StartTls,
+		BaseDN:             req.BaseDn,
+		BindDN:             req.BindDn,
+		BindPassword:       req.BindPassword,
+		UserBase:           req.UserBase,
+		UserObjectClasses:  req.UserObjectClasses,
+		UserFilters:        req.UserFilters,
+		Timeout:            req.Timeout.AsDuration(),
+		LDAPAttributes:     idp_grpc.LDAPAttributesToCommand(req.Attributes),
+		IDPOptions:         idp_grpc.OptionsToCommand(req.ProviderOptions),
+		RootCA:             req.RootCa,
+		GroupResolution:    idp_grpc.LDAPGroupResolutionToDomain(req.GroupResolution),
+		GroupBase:          req.GroupBase,
+		GroupNameAttribute: req.GroupNameAttribute,
 	}
 }
 
@@ -11,6 +11,7 @@ import (
 	obj_grpc "github.com/zitadel/zitadel/internal/api/grpc/object"
 	org_grpc "github.com/zitadel/zitadel/internal/api/grpc/org"
 	policy_grpc "github.com/zitadel/zitadel/internal/api/grpc/policy"
+	"github.com/zitadel/zitadel/internal/command"
 	"github.com/zitadel/zitadel/internal/domain"
 	"github.com/zitadel/zitadel/internal/eventstore"
 	"github.com/zitadel/zitadel/internal/eventstore/v1/models"
@@ -257,11 +258,76 @@ func (s *Server) ListOrgMemberRoles(ctx context.Context, _ *mgmt_pb.ListOrgMembe
 		return nil, err
 	}
 	roles := s.query.GetOrgMemberRoles(authz.GetCtxData(ctx).OrgID == instance.DefaultOrgID)
+	customRoles, err := s.query.OrgCustomRoles(ctx, authz.GetCtxData(ctx).OrgID)
+	if err != nil {
+		return nil, err
+	}
+	for _, role := range customRoles.Roles {
+		roles = append(roles, role.Key)
+	}
 	return &mgmt_pb.ListOrgMemberRolesResponse{
 		Result: roles,
 	}, nil
 }
 
+func (s *Server) ListOrgCustomRoles(ctx context.Context, _ *mgmt_pb.ListOrgCustomRolesRequest) (*mgmt_pb.ListOrgCustomRolesResponse, error) {
+	roles, err := s.query.OrgCustomRoles(ctx, authz.GetCtxData(ctx).OrgID)
+	if err != nil {
+		return nil, err
+	}
+	return &mgmt_pb.ListOrgCustomRolesResponse{
+		Result: org_grpc.CustomRolesToPb(roles.Roles),
+	}, nil
+}
+
+func (s *Server) GetOrgCustomRole(ctx context.Context, req *mgmt_pb.GetOrgCustomRoleRequest) (*mgmt_pb.GetOrgCustomRoleResponse, error) {
+	role, err := s.query.OrgCustomRoleByKey(ctx, authz.GetCtxData(ctx).OrgID, req.RoleKey)
+	if err != nil {
+		return nil, err
+	}
+	return &mgmt_pb.GetOrgCustomRoleResponse{
+		Role: org_grpc.CustomRoleToPb(role),
+	}, nil
+}
+
+func (s *Server) AddOrgCustomRole(ctx context.Context, req *mgmt_pb.AddOrgCustomRoleRequest) (*mgmt_pb.AddOrgCustomRoleResponse, error) {
+	details, err := s.command.AddOrgCustomRole(ctx, authz.GetCtxData(ctx).OrgID, &command.CustomRole{
+		Key:         req.RoleKey,
+		DisplayName: req.DisplayName,
+		Permissions: req.Permissions,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &mgmt_pb.AddOrgCustomRoleResponse{
+		Details: object.DomainToAddDetailsPb(details),
+	}, nil
+}
+
+func (s *Server) UpdateOrgCustomRole(ctx context.Context, req *mgmt_pb.UpdateOrgCustomRoleRequest) (*mgmt_pb.UpdateOrgCustomRoleResponse, error) {
+	details, err := s.command.ChangeOrgCustomRole(ctx, authz.GetCtxData(ctx).OrgID, &command.CustomRole{
+		Key:         req.RoleKey,
+		DisplayName: req.DisplayName,
+		Permissions: req.Permissions,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &mgmt_pb.UpdateOrgCustomRoleResponse{
+		Details: object.DomainToChangeDetailsPb(details),
+	}, nil
+}
+
+func (s *Server) RemoveOrgCustomRole(ctx context.Context, req *mgmt_pb.RemoveOrgCustomRoleRequest) (*mgmt_pb.RemoveOrgCustomRoleResponse, error) {
+	details, err := s.command.RemoveOrgCustomRole(ctx, authz.GetCtxData(ctx).OrgID, req.RoleKey)
+	if err != nil {
+		return nil, err
+	}
+	return &mgmt_pb.RemoveOrgCustomRoleResponse{
+		Details: object.DomainToChangeDetailsPb(details),
+	}, nil
+}
+
 func (s *Server) ListOrgMembers(ctx context.Context, req *mgmt_pb.ListOrgMembersRequest) (*mgmt_pb.ListOrgMembersResponse, error) {
 	queries, err := ListOrgMembersRequestToModel(ctx, req)
 	if err != nil {
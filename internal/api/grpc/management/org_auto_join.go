@@ -0,0 +1,38 @@
+package management
+
+import (
+	"context"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/api/grpc/object"
+	org_grpc "github.com/zitadel/zitadel/internal/api/grpc/org"
+	mgmt_pb "github.com/zitadel/zitadel/pkg/grpc/management"
+)
+
+func (s *Server) GetOrgAutoJoin(ctx context.Context, _ *mgmt_pb.GetOrgAutoJoinRequest) (*mgmt_pb.GetOrgAutoJoinResponse, error) {
+	autoJoin, err := s.query.OrgAutoJoinByOrg(ctx, authz.GetCtxData(ctx).OrgID)
+	if err != nil {
+		return nil, err
+	}
+	return &mgmt_pb.GetOrgAutoJoinResponse{AutoJoin: org_grpc.OrgAutoJoinToPb(autoJoin)}, nil
+}
+
+func (s *Server) SetOrgAutoJoin(ctx context.Context, req *mgmt_pb.SetOrgAutoJoinRequest) (*mgmt_pb.SetOrgAutoJoinResponse, error) {
+	details, err := s.command.SetOrgAutoJoin(ctx, authz.GetCtxData(ctx).OrgID, req.GetRoles())
+	if err != nil {
+		return nil, err
+	}
+	return &mgmt_pb.SetOrgAutoJoinResponse{
+		Details: object.DomainToChangeDetailsPb(details),
+	}, nil
+}
+
+func (s *Server) RemoveOrgAutoJoin(ctx context.Context, _ *mgmt_pb.RemoveOrgAutoJoinRequest) (*mgmt_pb.RemoveOrgAutoJoinResponse, error) {
+	details, err := s.command.RemoveOrgAutoJoin(ctx, authz.GetCtxData(ctx).OrgID)
+	if err != nil {
+		return nil, err
+	}
+	return &mgmt_pb.RemoveOrgAutoJoinResponse{
+		Details: object.DomainToChangeDetailsPb(details),
+	}, nil
+}
@@ -29,6 +29,7 @@ var iamRoles = []string{
 	"ORG_USER_SELF_MANAGER",
 	"ORG_ADMIN_IMPERSONATOR",
 	"ORG_END_USER_IMPERSONATOR",
+	"ORG_SUPPORT_IMPERSONATOR",
 }
 
 func TestServer_ListOrgMemberRoles(t *testing.T) {
@@ -0,0 +1,51 @@
+package management
+
+import (
+	"context"
+	"time"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/api/grpc/object"
+	org_grpc "github.com/zitadel/zitadel/internal/api/grpc/org"
+	"github.com/zitadel/zitadel/internal/command"
+	"github.com/zitadel/zitadel/internal/domain"
+	mgmt_pb "github.com/zitadel/zitadel/pkg/grpc/management"
+)
+
+func (s *Server) AddOrgInvite(ctx context.Context, req *mgmt_pb.AddOrgInviteRequest) (*mgmt_pb.AddOrgInviteResponse, error) {
+	invite := &command.AddOrgInvite{
+		Email:      domain.EmailAddress(req.GetEmail()),
+		Grants:     org_grpc.OrgInviteGrantsToDomain(req.GetGrants()),
+		Metadata:   org_grpc.OrgInviteMetadataToDomain(req.GetMetadata()),
+		ReturnCode: req.GetReturnCode(),
+	}
+	if err := s.command.AddOrgInvite(ctx, authz.GetCtxData(ctx).OrgID, invite); err != nil {
+		return nil, err
+	}
+	return &mgmt_pb.AddOrgInviteResponse{
+		InviteId: invite.ID,
+		Details:  object.DomainToAddDetailsPb(invite.Details),
+		Code:     invite.Code,
+	}, nil
+}
+
+func (s *Server) ListOrgInvites(ctx context.Context, _ *mgmt_pb.ListOrgInvitesRequest) (*mgmt_pb.ListOrgInvitesResponse, error) {
+	invites, err := s.query.OrgInvites(ctx, authz.GetCtxData(ctx).OrgID)
+	if err != nil {
+		return nil, err
+	}
+	return &mgmt_pb.ListOrgInvitesResponse{
+		Result:  org_grpc.OrgInvitesToPb(invites),
+		Details: object.ToListDetails(uint64(len(invites)), 0, time.Time{}),
+	}, nil
+}
+
+func (s *Server) RevokeOrgInvite(ctx context.Context, req *mgmt_pb.RevokeOrgInviteRequest) (*mgmt_pb.RevokeOrgInviteResponse, error) {
+	details, err := s.command.RevokeOrgInvite(ctx, authz.GetCtxData(ctx).OrgID, req.GetInviteId())
+	if err != nil {
+		return nil, err
+	}
+	return &mgmt_pb.RevokeOrgInviteResponse{
+		Details: object.DomainToChangeDetailsPb(details),
+	}, nil
+}
@@ -19,6 +19,7 @@ func AddLabelPolicyToDomain(p *mgmt_pb.AddCustomLabelPolicyRequest) *domain.Labe
 		HideLoginNameSuffix: p.HideLoginNameSuffix,
 		DisableWatermark:    p.DisableWatermark,
 		ThemeMode:           themeModeToDomain(p.ThemeMode),
+		CustomCSS:           p.CustomCss,
 	}
 }
 
@@ -50,5 +51,6 @@ func updateLabelPolicyToDomain(p *mgmt_pb.UpdateCustomLabelPolicyRequest) *domai
 		HideLoginNameSuffix: p.HideLoginNameSuffix,
 		DisableWatermark:    p.DisableWatermark,
 		ThemeMode:           themeModeToDomain(p.ThemeMode),
+		CustomCSS:           p.CustomCss,
 	}
 }
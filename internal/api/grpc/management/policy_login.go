@@ -2,6 +2,7 @@ package management
 
 import (
 	"context"
+	"time"
 
 	"github.com/zitadel/zitadel/internal/api/authz"
 	"github.com/zitadel/zitadel/internal/api/grpc/idp"
@@ -101,6 +102,37 @@ func (s *Server) RemoveIDPFromLoginPolicy(ctx context.Context, req *mgmt_pb.Remo
 	}, nil
 }
 
+func (s *Server) GetLoginPolicyIDPButtons(ctx context.Context, req *mgmt_pb.GetLoginPolicyIDPButtonsRequest) (*mgmt_pb.GetLoginPolicyIDPButtonsResponse, error) {
+	buttons, err := s.query.LoginPolicyIDPButtonsByOwner(ctx, authz.GetCtxData(ctx).OrgID)
+	if err != nil {
+		return nil, err
+	}
+	return &mgmt_pb.GetLoginPolicyIDPButtonsResponse{
+		Details: object.ToViewDetailsPb(buttons.Sequence, time.Time{}, buttons.ChangeDate, buttons.ResourceOwner),
+		Buttons: idp.IDPButtonsToPb(buttons.Buttons, buttons.Icons),
+	}, nil
+}
+
+func (s *Server) SetLoginPolicyIDPButtons(ctx context.Context, req *mgmt_pb.SetLoginPolicyIDPButtonsRequest) (*mgmt_pb.SetLoginPolicyIDPButtonsResponse, error) {
+	objectDetails, err := s.command.SetOrgLoginPolicyIDPButtons(ctx, authz.GetCtxData(ctx).OrgID, idp.IDPButtonsToDomain(req.Buttons))
+	if err != nil {
+		return nil, err
+	}
+	return &mgmt_pb.SetLoginPolicyIDPButtonsResponse{
+		Details: object.DomainToChangeDetailsPb(objectDetails),
+	}, nil
+}
+
+func (s *Server) RemoveLoginPolicyIDPIcon(ctx context.Context, req *mgmt_pb.RemoveLoginPolicyIDPIconRequest) (*mgmt_pb.RemoveLoginPolicyIDPIconResponse, error) {
+	objectDetails, err := s.command.RemoveOrgLoginPolicyIDPIcon(ctx, authz.GetCtxData(ctx).OrgID, req.IdpId)
+	if err != nil {
+		return nil, err
+	}
+	return &mgmt_pb.RemoveLoginPolicyIDPIconResponse{
+		Details: object.DomainToChangeDetailsPb(objectDetails),
+	}, nil
+}
+
 func (s *Server) ListLoginPolicySecondFactors(ctx context.Context, req *mgmt_pb.ListLoginPolicySecondFactorsRequest) (*mgmt_pb.ListLoginPolicySecondFactorsResponse, error) {
 	result, err := s.query.SecondFactorsByOrg(ctx, authz.GetCtxData(ctx).OrgID)
 	if err != nil {
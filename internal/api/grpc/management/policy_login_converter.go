@@ -11,26 +11,32 @@ import (
 
 func AddLoginPolicyToCommand(p *mgmt_pb.AddCustomLoginPolicyRequest) *command.AddLoginPolicy {
 	return &command.AddLoginPolicy{
-		AllowUsernamePassword:      p.AllowUsernamePassword,
-		AllowRegister:              p.AllowRegister,
-		AllowExternalIDP:           p.AllowExternalIdp,
-		ForceMFA:                   p.ForceMfa,
-		ForceMFALocalOnly:          p.ForceMfaLocalOnly,
-		PasswordlessType:           policy_grpc.PasswordlessTypeToDomain(p.PasswordlessType),
-		HidePasswordReset:          p.HidePasswordReset,
-		IgnoreUnknownUsernames:     p.IgnoreUnknownUsernames,
-		AllowDomainDiscovery:       p.AllowDomainDiscovery,
-		DefaultRedirectURI:         p.DefaultRedirectUri,
-		PasswordCheckLifetime:      p.PasswordCheckLifetime.AsDuration(),
-		ExternalLoginCheckLifetime: p.ExternalLoginCheckLifetime.AsDuration(),
-		MFAInitSkipLifetime:        p.MfaInitSkipLifetime.AsDuration(),
-		SecondFactorCheckLifetime:  p.SecondFactorCheckLifetime.AsDuration(),
-		MultiFactorCheckLifetime:   p.MultiFactorCheckLifetime.AsDuration(),
-		SecondFactors:              policy_grpc.SecondFactorsTypesToDomain(p.SecondFactors),
-		MultiFactors:               policy_grpc.MultiFactorsTypesToDomain(p.MultiFactors),
-		IDPProviders:               addLoginPolicyIDPsToCommand(p.Idps),
-		DisableLoginWithEmail:      p.DisableLoginWithEmail,
-		DisableLoginWithPhone:      p.DisableLoginWithPhone,
+		AllowUsernamePassword:       p.AllowUsernamePassword,
+		AllowRegister:               p.AllowRegister,
+		AllowExternalIDP:            p.AllowExternalIdp,
+		ForceMFA:                    p.ForceMfa,
+		ForceMFALocalOnly:           p.ForceMfaLocalOnly,
+		PasswordlessType:            policy_grpc.PasswordlessTypeToDomain(p.PasswordlessType),
+		HidePasswordReset:           p.HidePasswordReset,
+		IgnoreUnknownUsernames:      p.IgnoreUnknownUsernames,
+		AllowDomainDiscovery:        p.AllowDomainDiscovery,
+		DefaultRedirectURI:          p.DefaultRedirectUri,
+		PasswordCheckLifetime:       p.PasswordCheckLifetime.AsDuration(),
+		ExternalLoginCheckLifetime:  p.ExternalLoginCheckLifetime.AsDuration(),
+		MFAInitSkipLifetime:         p.MfaInitSkipLifetime.AsDuration(),
+		SecondFactorCheckLifetime:   p.SecondFactorCheckLifetime.AsDuration(),
+		MultiFactorCheckLifetime:    p.MultiFactorCheckLifetime.AsDuration(),
+		TrustedDeviceLifetime:       p.TrustedDeviceLifetime.AsDuration(),
+		SharedDevice:                p.SharedDevice,
+		SharedDeviceLifetime:        p.SharedDeviceLifetime.AsDuration(),
+		SharedDeviceIdleTimeout:     p.SharedDeviceIdleTimeout.AsDuration(),
+		PasskeyPrompt:               p.PasskeyPrompt,
+		PasskeyPromptSnoozeLifetime: p.PasskeyPromptSnoozeLifetime.AsDuration(),
+		SecondFactors:               policy_grpc.SecondFactorsTypesToDomain(p.SecondFactors),
+		MultiFactors:                policy_grpc.MultiFactorsTypesToDomain(p.MultiFactors),
+		IDPProviders:                addLoginPolicyIDPsToCommand(p.Idps),
+		DisableLoginWithEmail:       p.DisableLoginWithEmail,
+		DisableLoginWithPhone:       p.DisableLoginWithPhone,
 	}
 }
 func addLoginPolicyIDPsToCommand(idps []*mgmt_pb.AddCustomLoginPolicyRequest_IDP) []*command.AddLoginPolicyIDP {
@@ -46,23 +52,29 @@ func addLoginPolicyIDPsToCommand(idps []*mgmt_pb.AddCustomLoginPolicyRequest_IDP
 
 func updateLoginPolicyToCommand(p *mgmt_pb.UpdateCustomLoginPolicyRequest) *command.ChangeLoginPolicy {
 	return &command.ChangeLoginPolicy{
-		AllowUsernamePassword:      p.AllowUsernamePassword,
-		AllowRegister:              p.AllowRegister,
-		AllowExternalIDP:           p.AllowExternalIdp,
-		ForceMFA:                   p.ForceMfa,
-		ForceMFALocalOnly:          p.ForceMfaLocalOnly,
-		PasswordlessType:           policy_grpc.PasswordlessTypeToDomain(p.PasswordlessType),
-		HidePasswordReset:          p.HidePasswordReset,
-		IgnoreUnknownUsernames:     p.IgnoreUnknownUsernames,
-		AllowDomainDiscovery:       p.AllowDomainDiscovery,
-		DisableLoginWithEmail:      p.DisableLoginWithEmail,
-		DisableLoginWithPhone:      p.DisableLoginWithPhone,
-		DefaultRedirectURI:         p.DefaultRedirectUri,
-		PasswordCheckLifetime:      p.PasswordCheckLifetime.AsDuration(),
-		ExternalLoginCheckLifetime: p.ExternalLoginCheckLifetime.AsDuration(),
-		MFAInitSkipLifetime:        p.MfaInitSkipLifetime.AsDuration(),
-		SecondFactorCheckLifetime:  p.SecondFactorCheckLifetime.AsDuration(),
-		MultiFactorCheckLifetime:   p.MultiFactorCheckLifetime.AsDuration(),
+		AllowUsernamePassword:       p.AllowUsernamePassword,
+		AllowRegister:               p.AllowRegister,
+		AllowExternalIDP:            p.AllowExternalIdp,
+		ForceMFA:                    p.ForceMfa,
+		ForceMFALocalOnly:           p.ForceMfaLocalOnly,
+		PasswordlessType:            policy_grpc.PasswordlessTypeToDomain(p.PasswordlessType),
+		HidePasswordReset:           p.HidePasswordReset,
+		IgnoreUnknownUsernames:      p.IgnoreUnknownUsernames,
+		AllowDomainDiscovery:        p.AllowDomainDiscovery,
+		DisableLoginWithEmail:       p.DisableLoginWithEmail,
+		DisableLoginWithPhone:       p.DisableLoginWithPhone,
+		DefaultRedirectURI:          p.DefaultRedirectUri,
+		PasswordCheckLifetime:       p.PasswordCheckLifetime.AsDuration(),
+		ExternalLoginCheckLifetime:  p.ExternalLoginCheckLifetime.AsDuration(),
+		MFAInitSkipLifetime:         p.MfaInitSkipLifetime.AsDuration(),
+		SecondFactorCheckLifetime:   p.SecondFactorCheckLifetime.AsDuration(),
+		MultiFactorCheckLifetime:    p.MultiFactorCheckLifetime.AsDuration(),
+		TrustedDeviceLifetime:       p.TrustedDeviceLifetime.AsDuration(),
+		SharedDevice:                p.SharedDevice,
+		SharedDeviceLifetime:        p.SharedDeviceLifetime.AsDuration(),
+		SharedDeviceIdleTimeout:     p.SharedDeviceIdleTimeout.AsDuration(),
+		PasskeyPrompt:               p.PasskeyPrompt,
+		PasskeyPromptSnoozeLifetime: p.PasskeyPromptSnoozeLifetime.AsDuration(),
 	}
 }
 
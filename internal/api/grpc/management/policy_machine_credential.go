@@ -0,0 +1,42 @@
+package management
+
+import (
+	"context"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/api/grpc/object"
+	policy_grpc "github.com/zitadel/zitadel/internal/api/grpc/policy"
+	"github.com/zitadel/zitadel/internal/command"
+	mgmt_pb "github.com/zitadel/zitadel/pkg/grpc/management"
+)
+
+func (s *Server) GetMachineCredentialPolicy(ctx context.Context, _ *mgmt_pb.GetMachineCredentialPolicyRequest) (*mgmt_pb.GetMachineCredentialPolicyResponse, error) {
+	policy, err := s.query.MachineCredentialPolicyByOrg(ctx, authz.GetCtxData(ctx).OrgID)
+	if err != nil {
+		return nil, err
+	}
+	return &mgmt_pb.GetMachineCredentialPolicyResponse{Policy: policy_grpc.ModelMachineCredentialPolicyToPb(policy)}, nil
+}
+
+func (s *Server) SetMachineCredentialPolicy(ctx context.Context, req *mgmt_pb.SetMachineCredentialPolicyRequest) (*mgmt_pb.SetMachineCredentialPolicyResponse, error) {
+	details, err := s.command.SetOrgMachineCredentialPolicy(ctx, authz.GetCtxData(ctx).OrgID, &command.MachineCredentialPolicy{
+		MaxLifetime:  req.GetMaxLifetime().AsDuration(),
+		NotifyBefore: req.GetNotifyBefore().AsDuration(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &mgmt_pb.SetMachineCredentialPolicyResponse{
+		Details: object.DomainToChangeDetailsPb(details),
+	}, nil
+}
+
+func (s *Server) RemoveMachineCredentialPolicy(ctx context.Context, _ *mgmt_pb.RemoveMachineCredentialPolicyRequest) (*mgmt_pb.RemoveMachineCredentialPolicyResponse, error) {
+	details, err := s.command.RemoveOrgMachineCredentialPolicy(ctx, authz.GetCtxData(ctx).OrgID)
+	if err != nil {
+		return nil, err
+	}
+	return &mgmt_pb.RemoveMachineCredentialPolicyResponse{
+		Details: object.DomainToChangeDetailsPb(details),
+	}, nil
+}
@@ -0,0 +1,89 @@
+package management
+
+import (
+	"context"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/api/grpc/object"
+	policy_grpc "github.com/zitadel/zitadel/internal/api/grpc/policy"
+	"github.com/zitadel/zitadel/internal/domain"
+	mgmt_pb "github.com/zitadel/zitadel/pkg/grpc/management"
+)
+
+func (s *Server) GetNetworkAccessPolicy(ctx context.Context, _ *mgmt_pb.GetNetworkAccessPolicyRequest) (*mgmt_pb.GetNetworkAccessPolicyResponse, error) {
+	policy, err := s.query.NetworkAccessPolicyByOrg(ctx, authz.GetCtxData(ctx).OrgID)
+	if err != nil {
+		return nil, err
+	}
+	return &mgmt_pb.GetNetworkAccessPolicyResponse{Policy: policy_grpc.ModelNetworkAccessPolicyToPb(policy)}, nil
+}
+
+func (s *Server) SetNetworkAccessPolicy(ctx context.Context, req *mgmt_pb.SetNetworkAccessPolicyRequest) (*mgmt_pb.SetNetworkAccessPolicyResponse, error) {
+	details, err := s.command.SetOrgNetworkAccessPolicy(ctx, authz.GetCtxData(ctx).OrgID, networkAccessRulesToDomain(req))
+	if err != nil {
+		return nil, err
+	}
+	return &mgmt_pb.SetNetworkAccessPolicyResponse{
+		Details: object.DomainToChangeDetailsPb(details),
+	}, nil
+}
+
+func (s *Server) RemoveNetworkAccessPolicy(ctx context.Context, _ *mgmt_pb.RemoveNetworkAccessPolicyRequest) (*mgmt_pb.RemoveNetworkAccessPolicyResponse, error) {
+	details, err := s.command.RemoveOrgNetworkAccessPolicy(ctx, authz.GetCtxData(ctx).OrgID)
+	if err != nil {
+		return nil, err
+	}
+	return &mgmt_pb.RemoveNetworkAccessPolicyResponse{
+		Details: object.DomainToChangeDetailsPb(details),
+	}, nil
+}
+
+func (s *Server) GetAppNetworkAccessPolicy(ctx context.Context, req *mgmt_pb.GetAppNetworkAccessPolicyRequest) (*mgmt_pb.GetAppNetworkAccessPolicyResponse, error) {
+	policy, err := s.query.NetworkAccessPolicyByApp(ctx, req.GetProjectId(), req.GetAppId(), authz.GetCtxData(ctx).OrgID)
+	if err != nil {
+		return nil, err
+	}
+	return &mgmt_pb.GetAppNetworkAccessPolicyResponse{Policy: policy_grpc.ModelNetworkAccessPolicyToPb(policy)}, nil
+}
+
+func (s *Server) SetAppNetworkAccessPolicy(ctx context.Context, req *mgmt_pb.SetAppNetworkAccessPolicyRequest) (*mgmt_pb.SetAppNetworkAccessPolicyResponse, error) {
+	details, err := s.command.SetAppNetworkAccessPolicy(ctx, req.GetProjectId(), req.GetAppId(), authz.GetCtxData(ctx).OrgID, networkAccessRulesToDomain(req))
+	if err != nil {
+		return nil, err
+	}
+	return &mgmt_pb.SetAppNetworkAccessPolicyResponse{
+		Details: object.DomainToChangeDetailsPb(details),
+	}, nil
+}
+
+func (s *Server) RemoveAppNetworkAccessPolicy(ctx context.Context, req *mgmt_pb.RemoveAppNetworkAccessPolicyRequest) (*mgmt_pb.RemoveAppNetworkAccessPolicyResponse, error) {
+	details, err := s.command.RemoveAppNetworkAccessPolicy(ctx, req.GetProjectId(), req.GetAppId(), authz.GetCtxData(ctx).OrgID)
+	if err != nil {
+		return nil, err
+	}
+	return &mgmt_pb.RemoveAppNetworkAccessPolicyResponse{
+		Details: object.DomainToChangeDetailsPb(details),
+	}, nil
+}
+
+type networkAccessRulesRequest interface {
+	GetAllowedCidrs() []string
+	GetDeniedCidrs() []string
+	GetAllowedCountries() []string
+	GetDeniedCountries() []string
+	GetAllowedAsns() []uint32
+	GetDeniedAsns() []uint32
+	GetMonitorOnly() bool
+}
+
+func networkAccessRulesToDomain(req networkAccessRulesRequest) *domain.NetworkAccessRules {
+	return &domain.NetworkAccessRules{
+		AllowedCIDRs:     req.GetAllowedCidrs(),
+		DeniedCIDRs:      req.GetDeniedCidrs(),
+		AllowedCountries: req.GetAllowedCountries(),
+		DeniedCountries:  req.GetDeniedCountries(),
+		AllowedASNs:      req.GetAllowedAsns(),
+		DeniedASNs:       req.GetDeniedAsns(),
+		MonitorOnly:      req.GetMonitorOnly(),
+	}
+}
@@ -62,3 +62,29 @@ func (s *Server) ResetPrivacyPolicyToDefault(ctx context.Context, _ *mgmt_pb.Res
 		Details: object.DomainToChangeDetailsPb(objectDetails),
 	}, nil
 }
+
+func (s *Server) GetTermsVersion(ctx context.Context, _ *mgmt_pb.GetTermsVersionRequest) (*mgmt_pb.GetTermsVersionResponse, error) {
+	version, err := s.query.ActiveTermsVersion(ctx, authz.GetCtxData(ctx).OrgID)
+	if err != nil {
+		return nil, err
+	}
+	return &mgmt_pb.GetTermsVersionResponse{Version: policy_grpc.TermsVersionToPb(version)}, nil
+}
+
+func (s *Server) PublishTermsVersion(ctx context.Context, req *mgmt_pb.PublishTermsVersionRequest) (*mgmt_pb.PublishTermsVersionResponse, error) {
+	details, err := s.command.PublishTermsVersion(ctx, authz.GetCtxData(ctx).OrgID, req.GetVersion())
+	if err != nil {
+		return nil, err
+	}
+	return &mgmt_pb.PublishTermsVersionResponse{
+		Details: object.DomainToChangeDetailsPb(details),
+	}, nil
+}
+
+func (s *Server) ListTermsAcceptances(ctx context.Context, req *mgmt_pb.ListTermsAcceptancesRequest) (*mgmt_pb.ListTermsAcceptancesResponse, error) {
+	acceptances, err := s.query.SearchTermsAcceptances(ctx, authz.GetCtxData(ctx).OrgID, req.GetVersion())
+	if err != nil {
+		return nil, err
+	}
+	return &mgmt_pb.ListTermsAcceptancesResponse{Result: policy_grpc.TermsAcceptancesToPb(acceptances)}, nil
+}
@@ -0,0 +1,38 @@
+package management
+
+import (
+	"context"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/api/grpc/object"
+	policy_grpc "github.com/zitadel/zitadel/internal/api/grpc/policy"
+	mgmt_pb "github.com/zitadel/zitadel/pkg/grpc/management"
+)
+
+func (s *Server) GetProfileRequirements(ctx context.Context, _ *mgmt_pb.GetProfileRequirementsRequest) (*mgmt_pb.GetProfileRequirementsResponse, error) {
+	requirements, err := s.query.ProfileRequirementsByOrg(ctx, authz.GetCtxData(ctx).OrgID)
+	if err != nil {
+		return nil, err
+	}
+	return &mgmt_pb.GetProfileRequirementsResponse{Requirements: policy_grpc.ModelProfileRequirementsToPb(requirements)}, nil
+}
+
+func (s *Server) SetProfileRequirements(ctx context.Context, req *mgmt_pb.SetProfileRequirementsRequest) (*mgmt_pb.SetProfileRequirementsResponse, error) {
+	details, err := s.command.SetOrgProfileRequirements(ctx, authz.GetCtxData(ctx).OrgID, policy_grpc.ProfileFieldsToDomain(req.GetFields()))
+	if err != nil {
+		return nil, err
+	}
+	return &mgmt_pb.SetProfileRequirementsResponse{
+		Details: object.DomainToChangeDetailsPb(details),
+	}, nil
+}
+
+func (s *Server) RemoveProfileRequirements(ctx context.Context, _ *mgmt_pb.RemoveProfileRequirementsRequest) (*mgmt_pb.RemoveProfileRequirementsResponse, error) {
+	details, err := s.command.RemoveOrgProfileRequirements(ctx, authz.GetCtxData(ctx).OrgID)
+	if err != nil {
+		return nil, err
+	}
+	return &mgmt_pb.RemoveProfileRequirementsResponse{
+		Details: object.DomainToChangeDetailsPb(details),
+	}, nil
+}
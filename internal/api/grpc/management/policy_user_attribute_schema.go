@@ -0,0 +1,38 @@
+package management
+
+import (
+	"context"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/api/grpc/object"
+	policy_grpc "github.com/zitadel/zitadel/internal/api/grpc/policy"
+	mgmt_pb "github.com/zitadel/zitadel/pkg/grpc/management"
+)
+
+func (s *Server) GetUserAttributeSchema(ctx context.Context, _ *mgmt_pb.GetUserAttributeSchemaRequest) (*mgmt_pb.GetUserAttributeSchemaResponse, error) {
+	schema, err := s.query.UserAttributeSchemaByOrg(ctx, authz.GetCtxData(ctx).OrgID)
+	if err != nil {
+		return nil, err
+	}
+	return &mgmt_pb.GetUserAttributeSchemaResponse{Schema: policy_grpc.ModelUserAttributeSchemaToPb(schema)}, nil
+}
+
+func (s *Server) SetUserAttributeSchema(ctx context.Context, req *mgmt_pb.SetUserAttributeSchemaRequest) (*mgmt_pb.SetUserAttributeSchemaResponse, error) {
+	details, err := s.command.SetOrgUserAttributeSchema(ctx, authz.GetCtxData(ctx).OrgID, policy_grpc.UserAttributesToDomain(req.GetAttributes()))
+	if err != nil {
+		return nil, err
+	}
+	return &mgmt_pb.SetUserAttributeSchemaResponse{
+		Details: object.DomainToChangeDetailsPb(details),
+	}, nil
+}
+
+func (s *Server) RemoveUserAttributeSchema(ctx context.Context, _ *mgmt_pb.RemoveUserAttributeSchemaRequest) (*mgmt_pb.RemoveUserAttributeSchemaResponse, error) {
+	details, err := s.command.RemoveOrgUserAttributeSchema(ctx, authz.GetCtxData(ctx).OrgID)
+	if err != nil {
+		return nil, err
+	}
+	return &mgmt_pb.RemoveUserAttributeSchemaResponse{
+		Details: object.DomainToChangeDetailsPb(details),
+	}, nil
+}
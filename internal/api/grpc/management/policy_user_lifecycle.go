@@ -0,0 +1,43 @@
+package management
+
+import (
+	"context"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/api/grpc/object"
+	policy_grpc "github.com/zitadel/zitadel/internal/api/grpc/policy"
+	"github.com/zitadel/zitadel/internal/command"
+	mgmt_pb "github.com/zitadel/zitadel/pkg/grpc/management"
+)
+
+func (s *Server) GetUserLifecyclePolicy(ctx context.Context, _ *mgmt_pb.GetUserLifecyclePolicyRequest) (*mgmt_pb.GetUserLifecyclePolicyResponse, error) {
+	policy, err := s.query.UserLifecyclePolicyByOrg(ctx, authz.GetCtxData(ctx).OrgID)
+	if err != nil {
+		return nil, err
+	}
+	return &mgmt_pb.GetUserLifecyclePolicyResponse{Policy: policy_grpc.ModelUserLifecyclePolicyToPb(policy)}, nil
+}
+
+func (s *Server) SetUserLifecyclePolicy(ctx context.Context, req *mgmt_pb.SetUserLifecyclePolicyRequest) (*mgmt_pb.SetUserLifecyclePolicyResponse, error) {
+	details, err := s.command.SetOrgUserLifecyclePolicy(ctx, authz.GetCtxData(ctx).OrgID, &command.UserLifecyclePolicy{
+		DeactivateAfter: req.GetDeactivateAfter().AsDuration(),
+		DeleteAfter:     req.GetDeleteAfter().AsDuration(),
+		NotifyBefore:    req.GetNotifyBefore().AsDuration(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &mgmt_pb.SetUserLifecyclePolicyResponse{
+		Details: object.DomainToChangeDetailsPb(details),
+	}, nil
+}
+
+func (s *Server) RemoveUserLifecyclePolicy(ctx context.Context, _ *mgmt_pb.RemoveUserLifecyclePolicyRequest) (*mgmt_pb.RemoveUserLifecyclePolicyResponse, error) {
+	details, err := s.command.RemoveOrgUserLifecyclePolicy(ctx, authz.GetCtxData(ctx).OrgID)
+	if err != nil {
+		return nil, err
+	}
+	return &mgmt_pb.RemoveUserLifecyclePolicyResponse{
+		Details: object.DomainToChangeDetailsPb(details),
+	}, nil
+}
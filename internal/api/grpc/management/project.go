@@ -202,6 +202,16 @@ func (s *Server) UpdateProject(ctx context.Context, req *mgmt_pb.UpdateProjectRe
 	}, nil
 }
 
+func (s *Server) SetProjectAccessTokenType(ctx context.Context, req *mgmt_pb.SetProjectAccessTokenTypeRequest) (*mgmt_pb.SetProjectAccessTokenTypeResponse, error) {
+	details, err := s.command.SetProjectAccessTokenType(ctx, req.Id, project_grpc.OIDCTokenTypeToDomain(req.AccessTokenType), authz.GetCtxData(ctx).OrgID)
+	if err != nil {
+		return nil, err
+	}
+	return &mgmt_pb.SetProjectAccessTokenTypeResponse{
+		Details: object_grpc.DomainToChangeDetailsPb(details),
+	}, nil
+}
+
 func (s *Server) DeactivateProject(ctx context.Context, req *mgmt_pb.DeactivateProjectRequest) (*mgmt_pb.DeactivateProjectResponse, error) {
 	details, err := s.command.DeactivateProject(ctx, req.Id, authz.GetCtxData(ctx).OrgID)
 	if err != nil {
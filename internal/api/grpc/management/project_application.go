@@ -3,6 +3,8 @@ package management
 import (
 	"context"
 
+	"google.golang.org/protobuf/types/known/durationpb"
+
 	"github.com/zitadel/zitadel/internal/api/authz"
 	authn_grpc "github.com/zitadel/zitadel/internal/api/grpc/authn"
 	change_grpc "github.com/zitadel/zitadel/internal/api/grpc/change"
@@ -169,6 +171,138 @@ func (s *Server) UpdateAPIAppConfig(ctx context.Context, req *mgmt_pb.UpdateAPIA
 	}, nil
 }
 
+func (s *Server) SetAppClaimsMapping(ctx context.Context, req *mgmt_pb.SetAppClaimsMappingRequest) (*mgmt_pb.SetAppClaimsMappingResponse, error) {
+	details, err := s.command.SetApplicationClaimsMapping(ctx, req.ProjectId, req.AppId, project_grpc.ClaimsMappingToDomain(req.ClaimsMapping), authz.GetCtxData(ctx).OrgID)
+	if err != nil {
+		return nil, err
+	}
+	return &mgmt_pb.SetAppClaimsMappingResponse{
+		Details: object_grpc.DomainToChangeDetailsPb(details),
+	}, nil
+}
+
+func (s *Server) SetAppSAMLAttributes(ctx context.Context, req *mgmt_pb.SetAppSAMLAttributesRequest) (*mgmt_pb.SetAppSAMLAttributesResponse, error) {
+	details, err := s.command.SetSAMLApplicationAttributes(ctx, req.ProjectId, req.AppId, project_grpc.SAMLAttributesToDomain(req.Attributes), authz.GetCtxData(ctx).OrgID)
+	if err != nil {
+		return nil, err
+	}
+	return &mgmt_pb.SetAppSAMLAttributesResponse{
+		Details: object_grpc.DomainToChangeDetailsPb(details),
+	}, nil
+}
+
+func (s *Server) SetAppLogoutConfig(ctx context.Context, req *mgmt_pb.SetAppLogoutConfigRequest) (*mgmt_pb.SetAppLogoutConfigResponse, error) {
+	details, err := s.command.SetApplicationLogoutConfig(ctx, req.ProjectId, req.AppId, req.BackChannelLogoutUri, req.FrontChannelLogoutUri, authz.GetCtxData(ctx).OrgID)
+	if err != nil {
+		return nil, err
+	}
+	return &mgmt_pb.SetAppLogoutConfigResponse{
+		Details: object_grpc.DomainToChangeDetailsPb(details),
+	}, nil
+}
+
+func (s *Server) GetAppAuthRequirements(ctx context.Context, req *mgmt_pb.GetAppAuthRequirementsRequest) (*mgmt_pb.GetAppAuthRequirementsResponse, error) {
+	app, err := s.query.AppByProjectAndAppID(ctx, true, req.ProjectId, req.AppId)
+	if err != nil {
+		return nil, err
+	}
+	requirements, err := s.query.AppAuthRequirementsByID(ctx, app.ProjectID, app.ID)
+	if err != nil {
+		return nil, err
+	}
+	return &mgmt_pb.GetAppAuthRequirementsResponse{
+		AuthRequirements: project_grpc.AuthRequirementsToPb(requirements),
+	}, nil
+}
+
+func (s *Server) SetAppAuthRequirements(ctx context.Context, req *mgmt_pb.SetAppAuthRequirementsRequest) (*mgmt_pb.SetAppAuthRequirementsResponse, error) {
+	details, err := s.command.SetApplicationAuthRequirements(ctx, req.ProjectId, req.AppId, project_grpc.AuthRequirementsToDomain(req.AuthRequirements), authz.GetCtxData(ctx).OrgID)
+	if err != nil {
+		return nil, err
+	}
+	return &mgmt_pb.SetAppAuthRequirementsResponse{
+		Details: object_grpc.DomainToChangeDetailsPb(details),
+	}, nil
+}
+
+func (s *Server) GetAppTokenLifetimes(ctx context.Context, req *mgmt_pb.GetAppTokenLifetimesRequest) (*mgmt_pb.GetAppTokenLifetimesResponse, error) {
+	app, err := s.query.AppByProjectAndAppID(ctx, true, req.ProjectId, req.AppId)
+	if err != nil {
+		return nil, err
+	}
+	lifetimes, err := s.query.AppTokenLifetimesByID(ctx, app.ProjectID, app.ID)
+	if err != nil {
+		return nil, err
+	}
+	return &mgmt_pb.GetAppTokenLifetimesResponse{
+		TokenLifetimes: project_grpc.TokenLifetimesToPb(lifetimes),
+	}, nil
+}
+
+func (s *Server) SetAppTokenLifetimes(ctx context.Context, req *mgmt_pb.SetAppTokenLifetimesRequest) (*mgmt_pb.SetAppTokenLifetimesResponse, error) {
+	details, err := s.command.SetOIDCApplicationTokenLifetimes(ctx, req.ProjectId, req.AppId, project_grpc.TokenLifetimesToDomain(req.TokenLifetimes), authz.GetCtxData(ctx).OrgID)
+	if err != nil {
+		return nil, err
+	}
+	return &mgmt_pb.SetAppTokenLifetimesResponse{
+		Details: object_grpc.DomainToChangeDetailsPb(details),
+	}, nil
+}
+
+func (s *Server) GetAppOfflineTokens(ctx context.Context, req *mgmt_pb.GetAppOfflineTokensRequest) (*mgmt_pb.GetAppOfflineTokensResponse, error) {
+	app, err := s.query.AppByProjectAndAppID(ctx, true, req.ProjectId, req.AppId)
+	if err != nil {
+		return nil, err
+	}
+	maxLifetime, err := s.query.AppOfflineTokenMaxLifetimeByID(ctx, app.ProjectID, app.ID)
+	if err != nil {
+		return nil, err
+	}
+	return &mgmt_pb.GetAppOfflineTokensResponse{
+		MaxLifetime: durationpb.New(maxLifetime),
+	}, nil
+}
+
+func (s *Server) SetAppOfflineTokens(ctx context.Context, req *mgmt_pb.SetAppOfflineTokensRequest) (*mgmt_pb.SetAppOfflineTokensResponse, error) {
+	details, err := s.command.SetOIDCApplicationOfflineTokens(ctx, req.ProjectId, req.AppId, req.GetMaxLifetime().AsDuration(), authz.GetCtxData(ctx).OrgID)
+	if err != nil {
+		return nil, err
+	}
+	return &mgmt_pb.SetAppOfflineTokensResponse{
+		Details: object_grpc.DomainToChangeDetailsPb(details),
+	}, nil
+}
+
+func (s *Server) AddAppPostLogoutRedirectURI(ctx context.Context, req *mgmt_pb.AddAppPostLogoutRedirectURIRequest) (*mgmt_pb.AddAppPostLogoutRedirectURIResponse, error) {
+	details, err := s.command.AddOIDCApplicationPostLogoutRedirectURI(ctx, req.ProjectId, req.AppId, req.Uri, authz.GetCtxData(ctx).OrgID)
+	if err != nil {
+		return nil, err
+	}
+	return &mgmt_pb.AddAppPostLogoutRedirectURIResponse{
+		Details: object_grpc.DomainToChangeDetailsPb(details),
+	}, nil
+}
+
+func (s *Server) RemoveAppPostLogoutRedirectURI(ctx context.Context, req *mgmt_pb.RemoveAppPostLogoutRedirectURIRequest) (*mgmt_pb.RemoveAppPostLogoutRedirectURIResponse, error) {
+	details, err := s.command.RemoveOIDCApplicationPostLogoutRedirectURI(ctx, req.ProjectId, req.AppId, req.Uri, authz.GetCtxData(ctx).OrgID)
+	if err != nil {
+		return nil, err
+	}
+	return &mgmt_pb.RemoveAppPostLogoutRedirectURIResponse{
+		Details: object_grpc.DomainToChangeDetailsPb(details),
+	}, nil
+}
+
+func (s *Server) SetAppFrameAncestors(ctx context.Context, req *mgmt_pb.SetAppFrameAncestorsRequest) (*mgmt_pb.SetAppFrameAncestorsResponse, error) {
+	details, err := s.command.SetApplicationFrameAncestors(ctx, req.ProjectId, req.AppId, req.FrameAncestors, authz.GetCtxData(ctx).OrgID)
+	if err != nil {
+		return nil, err
+	}
+	return &mgmt_pb.SetAppFrameAncestorsResponse{
+		Details: object_grpc.DomainToChangeDetailsPb(details),
+	}, nil
+}
+
 func (s *Server) DeactivateApp(ctx context.Context, req *mgmt_pb.DeactivateAppRequest) (*mgmt_pb.DeactivateAppResponse, error) {
 	details, err := s.command.DeactivateApplication(ctx, req.ProjectId, req.AppId, authz.GetCtxData(ctx).OrgID)
 	if err != nil {
@@ -271,9 +405,14 @@ func (s *Server) AddAppKey(ctx context.Context, req *mgmt_pb.AddAppKeyRequest) (
 	if err != nil {
 		return nil, err
 	}
-	keyDetails, err := key.Detail()
-	if err != nil {
-		return nil, err
+	// Return key details only if the public key wasn't supplied, otherwise the client already has
+	// the private key locally
+	var keyDetails []byte
+	if len(req.PublicKey) == 0 {
+		keyDetails, err = key.Detail()
+		if err != nil {
+			return nil, err
+		}
 	}
 	return &mgmt_pb.AddAppKeyResponse{
 		Id:         key.KeyID,
@@ -282,6 +421,26 @@ func (s *Server) AddAppKey(ctx context.Context, req *mgmt_pb.AddAppKeyRequest) (
 	}, nil
 }
 
+func (s *Server) UpdateAppKeyExpiration(ctx context.Context, req *mgmt_pb.UpdateAppKeyExpirationRequest) (*mgmt_pb.UpdateAppKeyExpirationResponse, error) {
+	details, err := s.command.ChangeApplicationKeyExpiration(ctx, req.ProjectId, req.AppId, req.KeyId, req.GetExpirationDate().AsTime(), authz.GetCtxData(ctx).OrgID)
+	if err != nil {
+		return nil, err
+	}
+	return &mgmt_pb.UpdateAppKeyExpirationResponse{
+		Details: object_grpc.DomainToChangeDetailsPb(details),
+	}, nil
+}
+
+func (s *Server) RevokeAppKey(ctx context.Context, req *mgmt_pb.RevokeAppKeyRequest) (*mgmt_pb.RevokeAppKeyResponse, error) {
+	details, err := s.command.RevokeApplicationKey(ctx, req.ProjectId, req.AppId, req.KeyId, authz.GetCtxData(ctx).OrgID)
+	if err != nil {
+		return nil, err
+	}
+	return &mgmt_pb.RevokeAppKeyResponse{
+		Details: object_grpc.DomainToChangeDetailsPb(details),
+	}, nil
+}
+
 func (s *Server) RemoveAppKey(ctx context.Context, req *mgmt_pb.RemoveAppKeyRequest) (*mgmt_pb.RemoveAppKeyResponse, error) {
 	details, err := s.command.RemoveApplicationKey(ctx, req.ProjectId, req.AppId, req.KeyId, authz.GetCtxData(ctx).OrgID)
 	if err != nil {
@@ -57,6 +57,8 @@ func AddOIDCAppRequestToDomain(req *mgmt_pb.AddOIDCAppRequest) *domain.OIDCApp {
 		ClockSkew:                req.ClockSkew.AsDuration(),
 		AdditionalOrigins:        req.AdditionalOrigins,
 		SkipNativeAppSuccessPage: req.SkipNativeAppSuccessPage,
+		TLSClientAuthSubjectDN:   req.TlsClientAuthSubjectDn,
+		TLSClientAuthThumbprint:  req.TlsClientAuthThumbprint,
 	}
 }
 
@@ -108,6 +110,8 @@ func UpdateOIDCAppConfigRequestToDomain(app *mgmt_pb.UpdateOIDCAppConfigRequest)
 		ClockSkew:                app.ClockSkew.AsDuration(),
 		AdditionalOrigins:        app.AdditionalOrigins,
 		SkipNativeAppSuccessPage: app.SkipNativeAppSuccessPage,
+		TLSClientAuthSubjectDN:   app.TlsClientAuthSubjectDn,
+		TLSClientAuthThumbprint:  app.TlsClientAuthThumbprint,
 	}
 }
 
@@ -145,6 +149,7 @@ func AddAPIClientKeyRequestToDomain(key *mgmt_pb.AddAppKeyRequest) *domain.Appli
 		ExpirationDate: expirationDate,
 		Type:           authn_grpc.KeyTypeToDomain(key.Type),
 		ApplicationID:  key.AppId,
+		PublicKey:      key.PublicKey,
 	}
 }
 
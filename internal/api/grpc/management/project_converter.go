@@ -21,6 +21,7 @@ func ProjectCreateToDomain(req *mgmt_pb.AddProjectRequest) *domain.Project {
 		ProjectRoleCheck:       req.ProjectRoleCheck,
 		HasProjectCheck:        req.HasProjectCheck,
 		PrivateLabelingSetting: privateLabelingSettingToDomain(req.PrivateLabelingSetting),
+		ExpectedSequence:       req.ExpectedSequence,
 	}
 }
 
@@ -79,9 +80,10 @@ func UpdateProjectRoleRequestToDomain(req *mgmt_pb.UpdateProjectRoleRequest) *do
 		ObjectRoot: models.ObjectRoot{
 			AggregateID: req.ProjectId,
 		},
-		Key:         req.RoleKey,
-		DisplayName: req.DisplayName,
-		Group:       req.Group,
+		Key:              req.RoleKey,
+		DisplayName:      req.DisplayName,
+		Group:            req.Group,
+		ExpectedSequence: req.ExpectedSequence,
 	}
 }
 
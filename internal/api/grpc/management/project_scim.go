@@ -0,0 +1,142 @@
+package management
+
+import (
+	"context"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	object_grpc "github.com/zitadel/zitadel/internal/api/grpc/object"
+	"github.com/zitadel/zitadel/internal/command"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/query"
+	mgmt_pb "github.com/zitadel/zitadel/pkg/grpc/management"
+	project_pb "github.com/zitadel/zitadel/pkg/grpc/project"
+)
+
+func (s *Server) ListProjectSCIMTargets(ctx context.Context, req *mgmt_pb.ListProjectSCIMTargetsRequest) (*mgmt_pb.ListProjectSCIMTargetsResponse, error) {
+	targets, err := s.query.ProjectSCIMTargets(ctx, req.GetProjectId(), authz.GetCtxData(ctx).OrgID)
+	if err != nil {
+		return nil, err
+	}
+	return &mgmt_pb.ListProjectSCIMTargetsResponse{
+		Result: SCIMTargetsToPb(targets),
+	}, nil
+}
+
+func (s *Server) GetProjectSCIMTarget(ctx context.Context, req *mgmt_pb.GetProjectSCIMTargetRequest) (*mgmt_pb.GetProjectSCIMTargetResponse, error) {
+	target, err := s.query.ProjectSCIMTargetByID(ctx, req.GetProjectId(), authz.GetCtxData(ctx).OrgID, req.GetTargetId())
+	if err != nil {
+		return nil, err
+	}
+	return &mgmt_pb.GetProjectSCIMTargetResponse{
+		Target: SCIMTargetToPb(target),
+	}, nil
+}
+
+func (s *Server) AddProjectSCIMTarget(ctx context.Context, req *mgmt_pb.AddProjectSCIMTargetRequest) (*mgmt_pb.AddProjectSCIMTargetResponse, error) {
+	id, details, err := s.command.AddProjectSCIMTarget(ctx, req.GetProjectId(), authz.GetCtxData(ctx).OrgID, &command.SCIMTarget{
+		Name:     req.GetName(),
+		Endpoint: req.GetEndpoint(),
+		Token:    req.GetToken(),
+		Mapping:  SCIMProvisioningMappingToDomain(req.GetMapping()),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &mgmt_pb.AddProjectSCIMTargetResponse{
+		Id:      id,
+		Details: object_grpc.DomainToAddDetailsPb(details),
+	}, nil
+}
+
+func (s *Server) UpdateProjectSCIMTarget(ctx context.Context, req *mgmt_pb.UpdateProjectSCIMTargetRequest) (*mgmt_pb.UpdateProjectSCIMTargetResponse, error) {
+	details, err := s.command.ChangeProjectSCIMTarget(ctx, req.GetProjectId(), authz.GetCtxData(ctx).OrgID, req.GetTargetId(), &command.SCIMTarget{
+		Name:     req.GetName(),
+		Endpoint: req.GetEndpoint(),
+		Token:    req.GetToken(),
+		Mapping:  SCIMProvisioningMappingToDomain(req.GetMapping()),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &mgmt_pb.UpdateProjectSCIMTargetResponse{
+		Details: object_grpc.DomainToChangeDetailsPb(details),
+	}, nil
+}
+
+func (s *Server) RemoveProjectSCIMTarget(ctx context.Context, req *mgmt_pb.RemoveProjectSCIMTargetRequest) (*mgmt_pb.RemoveProjectSCIMTargetResponse, error) {
+	details, err := s.command.RemoveProjectSCIMTarget(ctx, req.GetProjectId(), authz.GetCtxData(ctx).OrgID, req.GetTargetId())
+	if err != nil {
+		return nil, err
+	}
+	return &mgmt_pb.RemoveProjectSCIMTargetResponse{
+		Details: object_grpc.DomainToChangeDetailsPb(details),
+	}, nil
+}
+
+func (s *Server) ListProjectSCIMSyncs(ctx context.Context, req *mgmt_pb.ListProjectSCIMSyncsRequest) (*mgmt_pb.ListProjectSCIMSyncsResponse, error) {
+	queries, err := listProjectSCIMSyncsRequestToModel(req, authz.GetCtxData(ctx).OrgID)
+	if err != nil {
+		return nil, err
+	}
+	syncs, err := s.query.SearchSCIMSyncs(ctx, nil, queries)
+	if err != nil {
+		return nil, err
+	}
+	return &mgmt_pb.ListProjectSCIMSyncsResponse{
+		Details: object_grpc.ToListDetails(syncs.Count, syncs.Sequence, syncs.LastRun),
+		Result:  SCIMSyncsToPb(syncs.SCIMSyncs),
+	}, nil
+}
+
+func (s *Server) RetryProjectSCIMSync(ctx context.Context, req *mgmt_pb.RetryProjectSCIMSyncRequest) (*mgmt_pb.RetryProjectSCIMSyncResponse, error) {
+	details, err := s.command.RetrySCIMSync(ctx, req.GetProjectId(), authz.GetCtxData(ctx).OrgID, req.GetTargetId(), req.GetUserId())
+	if err != nil {
+		return nil, err
+	}
+	return &mgmt_pb.RetryProjectSCIMSyncResponse{
+		Details: object_grpc.DomainToChangeDetailsPb(details),
+	}, nil
+}
+
+func listProjectSCIMSyncsRequestToModel(req *mgmt_pb.ListProjectSCIMSyncsRequest, resourceOwner string) (*query.SCIMSyncSearchQueries, error) {
+	offset, limit, asc := object_grpc.ListQueryToModel(req.GetQuery())
+	projectIDQuery, err := query.NewSCIMSyncProjectIDSearchQuery(req.GetProjectId())
+	if err != nil {
+		return nil, err
+	}
+	resourceOwnerQuery, err := query.NewSCIMSyncResourceOwnerSearchQuery(resourceOwner)
+	if err != nil {
+		return nil, err
+	}
+	queries := []query.SearchQuery{projectIDQuery, resourceOwnerQuery}
+	if req.GetTargetId() != "" {
+		targetIDQuery, err := query.NewSCIMSyncTargetIDSearchQuery(req.GetTargetId())
+		if err != nil {
+			return nil, err
+		}
+		queries = append(queries, targetIDQuery)
+	}
+	if req.GetUserId() != "" {
+		userIDQuery, err := query.NewSCIMSyncUserIDSearchQuery(req.GetUserId())
+		if err != nil {
+			return nil, err
+		}
+		queries = append(queries, userIDQuery)
+	}
+	if req.GetState() != project_pb.SCIMSyncState_SCIM_SYNC_STATE_UNSPECIFIED {
+		stateQuery, err := query.NewSCIMSyncStateSearchQuery(domain.SCIMSyncState(req.GetState()))
+		if err != nil {
+			return nil, err
+		}
+		queries = append(queries, stateQuery)
+	}
+	return &query.SCIMSyncSearchQueries{
+		SearchRequest: query.SearchRequest{
+			Offset:        offset,
+			Limit:         limit,
+			Asc:           asc,
+			SortingColumn: query.SCIMSyncColumnChangeDate,
+		},
+		Queries: queries,
+	}, nil
+}
@@ -0,0 +1,84 @@
+package management
+
+import (
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	object_grpc "github.com/zitadel/zitadel/internal/api/grpc/object"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/query"
+	project_pb "github.com/zitadel/zitadel/pkg/grpc/project"
+)
+
+func SCIMTargetsToPb(targets []*query.SCIMTarget) []*project_pb.SCIMTarget {
+	pb := make([]*project_pb.SCIMTarget, len(targets))
+	for i, target := range targets {
+		pb[i] = SCIMTargetToPb(target)
+	}
+	return pb
+}
+
+func SCIMTargetToPb(target *query.SCIMTarget) *project_pb.SCIMTarget {
+	return &project_pb.SCIMTarget{
+		Id:       target.ID,
+		Details:  object_grpc.ChangeToDetailsPb(target.Sequence, target.ChangeDate, target.ResourceOwner),
+		Name:     target.Name,
+		Endpoint: target.Endpoint,
+		Mapping:  SCIMProvisioningMappingToPb(&target.Mapping),
+	}
+}
+
+func SCIMProvisioningMappingToPb(mapping *domain.SCIMProvisioningMapping) *project_pb.SCIMProvisioningMapping {
+	attributes := make([]*project_pb.SCIMAttributeMapping, len(mapping.Attributes))
+	for i, attribute := range mapping.Attributes {
+		attributes[i] = &project_pb.SCIMAttributeMapping{
+			MetadataKey: attribute.MetadataKey,
+			Attribute:   attribute.Attribute,
+		}
+	}
+	return &project_pb.SCIMProvisioningMapping{
+		UserName:   project_pb.SCIMUserNameSource(mapping.UserName),
+		Groups:     mapping.Groups,
+		Attributes: attributes,
+	}
+}
+
+func SCIMProvisioningMappingToDomain(mapping *project_pb.SCIMProvisioningMapping) domain.SCIMProvisioningMapping {
+	if mapping == nil {
+		return domain.SCIMProvisioningMapping{}
+	}
+	var attributes []domain.SCIMAttributeMapping
+	for _, attribute := range mapping.GetAttributes() {
+		attributes = append(attributes, domain.SCIMAttributeMapping{
+			MetadataKey: attribute.GetMetadataKey(),
+			Attribute:   attribute.GetAttribute(),
+		})
+	}
+	return domain.SCIMProvisioningMapping{
+		UserName:   domain.SCIMUserNameSource(mapping.GetUserName()),
+		Groups:     mapping.GetGroups(),
+		Attributes: attributes,
+	}
+}
+
+func SCIMSyncsToPb(syncs []*query.SCIMSync) []*project_pb.SCIMSync {
+	pb := make([]*project_pb.SCIMSync, len(syncs))
+	for i, sync := range syncs {
+		pb[i] = &project_pb.SCIMSync{
+			TargetId:  sync.TargetID,
+			UserId:    sync.UserID,
+			Details:   object_grpc.ChangeToDetailsPb(sync.Sequence, sync.ChangeDate, sync.ResourceOwner),
+			State:     project_pb.SCIMSyncState(sync.State),
+			Reason:    sync.Reason,
+			Attempts:  uint32(sync.Attempts),
+			RemoteId:  sync.RemoteID,
+			LastError: sync.LastError,
+		}
+		if sync.State == domain.SCIMSyncStatePending {
+			pb[i].NextAttempt = timestamppb.New(sync.NextAttempt)
+		}
+		if !sync.LastSyncDate.IsZero() {
+			pb[i].LastSyncDate = timestamppb.New(sync.LastSyncDate)
+		}
+	}
+	return pb
+}
@@ -2,11 +2,13 @@ package management
 
 import (
 	"context"
+	"time"
 
 	"github.com/zitadel/logging"
 	"github.com/zitadel/oidc/v3/pkg/oidc"
 	"golang.org/x/text/language"
 	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
 
 	"github.com/zitadel/zitadel/internal/api/authz"
 	"github.com/zitadel/zitadel/internal/api/grpc/authn"
@@ -296,6 +298,13 @@ func (s *Server) ImportHumanUser(ctx context.Context, req *mgmt_pb.ImportHumanUs
 	return resp, nil
 }
 
+func (s *Server) ImportHumanUsers(ctx context.Context, req *mgmt_pb.ImportHumanUsersRequest) (*mgmt_pb.ImportHumanUsersResponse, error) {
+	results := s.command.ImportHumans(ctx, authz.GetCtxData(ctx).OrgID, ImportHumanUsersRequestToCommand(req), req.DryRun, true, s.userCodeAlg)
+	return &mgmt_pb.ImportHumanUsersResponse{
+		Results: importHumanResultsToPb(req.Users, results),
+	}, nil
+}
+
 func (s *Server) AddMachineUser(ctx context.Context, req *mgmt_pb.AddMachineUserRequest) (*mgmt_pb.AddMachineUserResponse, error) {
 	machine := AddMachineUserRequestToCommand(req, authz.GetCtxData(ctx).OrgID)
 	objectDetails, err := s.command.AddMachine(ctx, machine)
@@ -728,6 +737,59 @@ func (s *Server) RemoveHumanPasswordless(ctx context.Context, req *mgmt_pb.Remov
 	}, nil
 }
 
+func (s *Server) ListHumanTrustedDevices(ctx context.Context, req *mgmt_pb.ListHumanTrustedDevicesRequest) (*mgmt_pb.ListHumanTrustedDevicesResponse, error) {
+	devices, err := s.query.TrustedDevices(ctx, req.UserId, authz.GetCtxData(ctx).OrgID)
+	if err != nil {
+		return nil, err
+	}
+	return &mgmt_pb.ListHumanTrustedDevicesResponse{
+		Result:  user_grpc.TrustedDevicesToPb(devices),
+		Details: obj_grpc.ToListDetails(uint64(len(devices)), 0, time.Time{}),
+	}, nil
+}
+
+func (s *Server) RemoveHumanTrustedDevice(ctx context.Context, req *mgmt_pb.RemoveHumanTrustedDeviceRequest) (*mgmt_pb.RemoveHumanTrustedDeviceResponse, error) {
+	objectDetails, err := s.command.RemoveHumanTrustedDevice(ctx, req.UserId, req.DeviceId, authz.GetCtxData(ctx).OrgID)
+	if err != nil {
+		return nil, err
+	}
+	return &mgmt_pb.RemoveHumanTrustedDeviceResponse{
+		Details: obj_grpc.DomainToChangeDetailsPb(objectDetails),
+	}, nil
+}
+
+func (s *Server) GetUserBreakGlass(ctx context.Context, req *mgmt_pb.GetUserBreakGlassRequest) (*mgmt_pb.GetUserBreakGlassResponse, error) {
+	breakGlass, err := s.query.UserBreakGlass(ctx, req.UserId, authz.GetCtxData(ctx).OrgID)
+	if err != nil {
+		return nil, err
+	}
+	return &mgmt_pb.GetUserBreakGlassResponse{
+		BreakGlass:  breakGlass.BreakGlass,
+		Description: breakGlass.Description,
+		Usages:      user_grpc.BreakGlassUsagesToPb(breakGlass.Usages, breakGlass.ResourceOwner),
+	}, nil
+}
+
+func (s *Server) SetUserBreakGlass(ctx context.Context, req *mgmt_pb.SetUserBreakGlassRequest) (*mgmt_pb.SetUserBreakGlassResponse, error) {
+	objectDetails, err := s.command.SetUserBreakGlass(ctx, req.UserId, authz.GetCtxData(ctx).OrgID, req.Description)
+	if err != nil {
+		return nil, err
+	}
+	return &mgmt_pb.SetUserBreakGlassResponse{
+		Details: obj_grpc.DomainToChangeDetailsPb(objectDetails),
+	}, nil
+}
+
+func (s *Server) RemoveUserBreakGlass(ctx context.Context, req *mgmt_pb.RemoveUserBreakGlassRequest) (*mgmt_pb.RemoveUserBreakGlassResponse, error) {
+	objectDetails, err := s.command.RemoveUserBreakGlass(ctx, req.UserId, authz.GetCtxData(ctx).OrgID)
+	if err != nil {
+		return nil, err
+	}
+	return &mgmt_pb.RemoveUserBreakGlassResponse{
+		Details: obj_grpc.DomainToChangeDetailsPb(objectDetails),
+	}, nil
+}
+
 func (s *Server) UpdateMachine(ctx context.Context, req *mgmt_pb.UpdateMachineRequest) (*mgmt_pb.UpdateMachineResponse, error) {
 	machine := UpdateMachineRequestToCommand(req, authz.GetCtxData(ctx).OrgID)
 	objectDetails, err := s.command.ChangeMachine(ctx, machine)
@@ -798,6 +860,34 @@ func (s *Server) AddMachineKey(ctx context.Context, req *mgmt_pb.AddMachineKeyRe
 	}, nil
 }
 
+func (s *Server) RotateMachineKey(ctx context.Context, req *mgmt_pb.RotateMachineKeyRequest) (*mgmt_pb.RotateMachineKeyResponse, error) {
+	machineKey := RotateMachineKeyRequestToCommand(req, authz.GetCtxData(ctx).OrgID)
+	// If there is no pubkey supplied, then RotateUserMachineKey will generate a new one
+	pubkeySupplied := len(machineKey.PublicKey) > 0
+	revocationDate := time.Time{}
+	if req.RevocationDate != nil {
+		revocationDate = req.RevocationDate.AsTime()
+	}
+	details, err := s.command.RotateUserMachineKey(ctx, req.GetKeyId(), machineKey, revocationDate)
+	if err != nil {
+		return nil, err
+	}
+
+	var keyDetails []byte
+	if !pubkeySupplied {
+		var err error
+		keyDetails, err = machineKey.Detail()
+		if err != nil {
+			return nil, err
+		}
+	}
+	return &mgmt_pb.RotateMachineKeyResponse{
+		KeyId:      machineKey.KeyID,
+		KeyDetails: keyDetails,
+		Details:    obj_grpc.DomainToAddDetailsPb(details),
+	}, nil
+}
+
 func (s *Server) RemoveMachineKey(ctx context.Context, req *mgmt_pb.RemoveMachineKeyRequest) (*mgmt_pb.RemoveMachineKeyResponse, error) {
 	objectDetails, err := s.command.RemoveUserMachineKey(ctx, RemoveMachineKeyRequestToCommand(req, authz.GetCtxData(ctx).OrgID))
 	if err != nil {
@@ -893,6 +983,32 @@ func (s *Server) RemovePersonalAccessToken(ctx context.Context, req *mgmt_pb.Rem
 	}, nil
 }
 
+func (s *Server) ImpersonateUser(ctx context.Context, req *mgmt_pb.ImpersonateUserRequest) (*mgmt_pb.ImpersonateUserResponse, error) {
+	client, err := s.query.GetOIDCClientByID(ctx, req.ClientId, false)
+	if err != nil {
+		return nil, err
+	}
+	impersonation, err := s.command.ImpersonateUser(ctx, req.UserId, authz.GetCtxData(ctx).OrgID, client.ProjectID, client.ClientID, req.Reason, req.GetLifetime().AsDuration())
+	if err != nil {
+		return nil, err
+	}
+	return &mgmt_pb.ImpersonateUserResponse{
+		ImpersonationId: impersonation.ID,
+		Token:           impersonation.AccessToken,
+		ExpirationDate:  timestamppb.New(impersonation.Expiration),
+	}, nil
+}
+
+func (s *Server) ListImpersonations(ctx context.Context, req *mgmt_pb.ListImpersonationsRequest) (*mgmt_pb.ListImpersonationsResponse, error) {
+	res, err := s.query.SearchImpersonations(ctx, authz.GetCtxData(ctx).OrgID, ListImpersonationsRequestToQuery(req))
+	if err != nil {
+		return nil, err
+	}
+	return &mgmt_pb.ListImpersonationsResponse{
+		Result: user_grpc.ImpersonationsToPb(res.Impersonations),
+	}, nil
+}
+
 func (s *Server) ListHumanLinkedIDPs(ctx context.Context, req *mgmt_pb.ListHumanLinkedIDPsRequest) (*mgmt_pb.ListHumanLinkedIDPsResponse, error) {
 	queries, err := ListHumanLinkedIDPsRequestToQuery(ctx, req)
 	if err != nil {
@@ -2,6 +2,7 @@ package management
 
 import (
 	"context"
+	"errors"
 	"time"
 
 	"github.com/zitadel/logging"
@@ -16,6 +17,7 @@ import (
 	"github.com/zitadel/zitadel/internal/domain"
 	"github.com/zitadel/zitadel/internal/eventstore/v1/models"
 	"github.com/zitadel/zitadel/internal/query"
+	"github.com/zitadel/zitadel/internal/zerrors"
 	mgmt_pb "github.com/zitadel/zitadel/pkg/grpc/management"
 	"github.com/zitadel/zitadel/pkg/grpc/user"
 )
@@ -132,6 +134,89 @@ func ImportHumanUserRequestToDomain(req *mgmt_pb.ImportHumanUserRequest) (human
 	return human, req.RequestPasswordlessRegistration, links
 }
 
+func ImportHumanUsersRequestToCommand(req *mgmt_pb.ImportHumanUsersRequest) []*command.AddHuman {
+	humans := make([]*command.AddHuman, len(req.Users))
+	for i, importUser := range req.Users {
+		humans[i] = importHumanUserToCommand(importUser)
+	}
+	return humans
+}
+
+func importHumanUserToCommand(importUser *mgmt_pb.ImportHumanUsersRequest_User) *command.AddHuman {
+	req := importUser.Human
+	preferredLanguage, err := language.Parse(req.GetProfile().GetPreferredLanguage())
+	logging.OnError(err).Debug("language malformed")
+	human := &command.AddHuman{
+		ID:                importUser.UserId,
+		Username:          req.GetUserName(),
+		FirstName:         req.GetProfile().GetFirstName(),
+		LastName:          req.GetProfile().GetLastName(),
+		NickName:          req.GetProfile().GetNickName(),
+		DisplayName:       req.GetProfile().GetDisplayName(),
+		PreferredLanguage: preferredLanguage,
+		Gender:            user_grpc.GenderToDomain(req.GetProfile().GetGender()),
+		Email: command.Email{
+			Address:  domain.EmailAddress(req.GetEmail().GetEmail()),
+			Verified: req.GetEmail().GetIsEmailVerified(),
+		},
+		Phone: command.Phone{
+			Number:   domain.PhoneNumber(req.GetPhone().GetPhone()),
+			Verified: req.GetPhone().GetIsPhoneVerified(),
+		},
+		Password:               req.GetPassword(),
+		EncodedPasswordHash:    req.GetHashedPassword().GetValue(),
+		PasswordChangeRequired: req.GetPasswordChangeRequired(),
+		TOTPSecret:             importUser.TotpSecret,
+		Metadata:               make([]*command.AddMetadataEntry, len(importUser.Metadata)),
+		Links:                  make([]*command.AddLink, len(req.GetIdps())),
+	}
+	for i, metadata := range importUser.Metadata {
+		human.Metadata[i] = &command.AddMetadataEntry{
+			Key:   metadata.Key,
+			Value: metadata.Value,
+		}
+	}
+	for i, idp := range req.GetIdps() {
+		human.Links[i] = &command.AddLink{
+			IDPID:         idp.ConfigId,
+			DisplayName:   idp.DisplayName,
+			IDPExternalID: idp.ExternalUserId,
+		}
+	}
+	return human
+}
+
+func importHumanResultsToPb(users []*mgmt_pb.ImportHumanUsersRequest_User, results []*command.ImportHumanResult) []*mgmt_pb.ImportHumanUsersResponse_Result {
+	pb := make([]*mgmt_pb.ImportHumanUsersResponse_Result, len(results))
+	for i, result := range results {
+		pb[i] = &mgmt_pb.ImportHumanUsersResponse_Result{
+			UserName: users[i].GetHuman().GetUserName(),
+			UserId:   result.ID,
+		}
+		if result.Err != nil {
+			pb[i].Error = importHumanErrorToPb(result.Err)
+			continue
+		}
+		if result.Details != nil {
+			pb[i].Details = object.DomainToAddDetailsPb(result.Details)
+		}
+	}
+	return pb
+}
+
+func importHumanErrorToPb(err error) *mgmt_pb.ImportHumanUsersResponse_Error {
+	zErr := new(zerrors.ZitadelError)
+	if errors.As(err, &zErr) {
+		return &mgmt_pb.ImportHumanUsersResponse_Error{
+			Id:      zErr.GetID(),
+			Message: zErr.GetMessage(),
+		}
+	}
+	return &mgmt_pb.ImportHumanUsersResponse_Error{
+		Message: err.Error(),
+	}
+}
+
 func AddMachineUserRequestToCommand(req *mgmt_pb.AddMachineUserRequest, resourceowner string) *command.Machine {
 	return &command.Machine{
 		ObjectRoot: models.ObjectRoot{
@@ -241,6 +326,23 @@ func AddMachineKeyRequestToCommand(req *mgmt_pb.AddMachineKeyRequest, resourceOw
 	}
 }
 
+func RotateMachineKeyRequestToCommand(req *mgmt_pb.RotateMachineKeyRequest, resourceOwner string) *command.MachineKey {
+	expDate := time.Time{}
+	if req.ExpirationDate != nil {
+		expDate = req.ExpirationDate.AsTime()
+	}
+
+	return &command.MachineKey{
+		ObjectRoot: models.ObjectRoot{
+			AggregateID:   req.UserId,
+			ResourceOwner: resourceOwner,
+		},
+		ExpirationDate: expDate,
+		Type:           authn.KeyTypeToDomain(req.Type),
+		PublicKey:      req.PublicKey,
+	}
+}
+
 func RemoveMachineKeyRequestToCommand(req *mgmt_pb.RemoveMachineKeyRequest, resourceOwner string) *command.MachineKey {
 	return &command.MachineKey{
 		ObjectRoot: models.ObjectRoot{
@@ -264,6 +366,9 @@ func AddPersonalAccessTokenRequestToCommand(req *mgmt_pb.AddPersonalAccessTokenR
 		},
 		ExpirationDate:  expDate,
 		Scopes:          scopes,
+		Audience:        req.GetAudience(),
+		Permissions:     req.GetPermissions(),
+		AllowedIPs:      req.GetAllowedIps(),
 		AllowedUserType: allowedUserType,
 	}
 }
@@ -358,3 +463,15 @@ func ListUserMembershipsRequestToModel(ctx context.Context, req *mgmt_pb.ListUse
 		Queries: queries,
 	}, nil
 }
+
+func ListImpersonationsRequestToQuery(req *mgmt_pb.ListImpersonationsRequest) *query.ImpersonationSearchQueries {
+	queries := &query.ImpersonationSearchQueries{
+		UserID:         req.UserId,
+		ImpersonatorID: req.ImpersonatorId,
+		Limit:          uint64(req.Limit),
+	}
+	if req.CreationDate != nil {
+		queries.CreationDate = req.CreationDate.AsTime()
+	}
+	return queries
+}
@@ -6,7 +6,10 @@ import (
 	"github.com/zitadel/zitadel/internal/api/authz"
 	obj_grpc "github.com/zitadel/zitadel/internal/api/grpc/object"
 	"github.com/zitadel/zitadel/internal/api/grpc/user"
+	"github.com/zitadel/zitadel/internal/command"
+	"github.com/zitadel/zitadel/internal/eventstore"
 	"github.com/zitadel/zitadel/internal/query"
+	"github.com/zitadel/zitadel/internal/zerrors"
 	mgmt_pb "github.com/zitadel/zitadel/pkg/grpc/management"
 )
 
@@ -76,6 +79,43 @@ func (s *Server) UpdateUserGrant(ctx context.Context, req *mgmt_pb.UpdateUserGra
 	}, nil
 }
 
+func (s *Server) ExecuteUserGrantBatch(ctx context.Context, req *mgmt_pb.ExecuteUserGrantBatchRequest) (*mgmt_pb.ExecuteUserGrantBatchResponse, error) {
+	results, err := s.command.ExecuteBatch(ctx, UserGrantBatchModeToCommand(req.Mode), s.userGrantBatchItems(ctx, req.Commands))
+	if err != nil {
+		return nil, err
+	}
+	return &mgmt_pb.ExecuteUserGrantBatchResponse{
+		Results: BatchResultsToPb(results),
+	}, nil
+}
+
+func (s *Server) userGrantBatchItems(ctx context.Context, commands []*mgmt_pb.UserGrantBatchCommand) []command.BatchItem {
+	orgID := authz.GetCtxData(ctx).OrgID
+	items := make([]command.BatchItem, len(commands))
+	for i, cmd := range commands {
+		switch c := cmd.GetCommand().(type) {
+		case *mgmt_pb.UserGrantBatchCommand_AddUserGrant:
+			grant := AddUserGrantRequestToDomain(c.AddUserGrant)
+			if err := checkExplicitProjectPermission(ctx, grant.ProjectGrantID, grant.ProjectID); err != nil {
+				items[i] = failedBatchItem(err)
+				continue
+			}
+			items[i] = s.command.AddUserGrantBatchItem(grant, orgID)
+		case *mgmt_pb.UserGrantBatchCommand_UpdateUserGrant:
+			items[i] = s.command.ChangeUserGrantBatchItem(UpdateUserGrantRequestToDomain(c.UpdateUserGrant), orgID)
+		default:
+			items[i] = failedBatchItem(zerrors.ThrowInvalidArgument(nil, "MANAG-Wq8vn3kx7p", "Errors.Batch.CommandMissing"))
+		}
+	}
+	return items
+}
+
+func failedBatchItem(err error) command.BatchItem {
+	return func(context.Context) ([]eventstore.Command, error) {
+		return nil, err
+	}
+}
+
 func (s *Server) DeactivateUserGrant(ctx context.Context, req *mgmt_pb.DeactivateUserGrantRequest) (*mgmt_pb.DeactivateUserGrantResponse, error) {
 	objectDetails, err := s.command.DeactivateUserGrant(ctx, req.GrantId, authz.GetCtxData(ctx).OrgID)
 	if err != nil {
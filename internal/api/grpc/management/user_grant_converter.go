@@ -4,12 +4,15 @@ import (
 	"context"
 
 	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/api/grpc/gerrors"
 	"github.com/zitadel/zitadel/internal/api/grpc/object"
 	user_grpc "github.com/zitadel/zitadel/internal/api/grpc/user"
+	"github.com/zitadel/zitadel/internal/command"
 	"github.com/zitadel/zitadel/internal/domain"
 	"github.com/zitadel/zitadel/internal/eventstore/v1/models"
 	"github.com/zitadel/zitadel/internal/query"
 	mgmt_pb "github.com/zitadel/zitadel/pkg/grpc/management"
+	"github.com/zitadel/zitadel/pkg/grpc/message"
 	"github.com/zitadel/zitadel/pkg/grpc/user"
 )
 
@@ -63,8 +66,44 @@ func UpdateUserGrantRequestToDomain(req *mgmt_pb.UpdateUserGrantRequest) *domain
 		ObjectRoot: models.ObjectRoot{
 			AggregateID: req.GrantId,
 		},
-		UserID:   req.UserId,
-		RoleKeys: req.RoleKeys,
+		UserID:           req.UserId,
+		RoleKeys:         req.RoleKeys,
+		ExpectedSequence: req.ExpectedSequence,
 	}
 
 }
+
+func UserGrantBatchModeToCommand(mode mgmt_pb.BatchMode) command.BatchMode {
+	switch mode {
+	case mgmt_pb.BatchMode_BATCH_MODE_BEST_EFFORT:
+		return command.BatchModeBestEffort
+	case mgmt_pb.BatchMode_BATCH_MODE_ATOMIC:
+		return command.BatchModeAtomic
+	default:
+		return command.BatchModeAtomic
+	}
+}
+
+func BatchResultsToPb(results []*command.BatchResult) []*mgmt_pb.BatchResult {
+	pbResults := make([]*mgmt_pb.BatchResult, len(results))
+	for i, result := range results {
+		pbResults[i] = BatchResultToPb(result)
+	}
+	return pbResults
+}
+
+func BatchResultToPb(result *command.BatchResult) *mgmt_pb.BatchResult {
+	if result.Err != nil {
+		_, key, id, _ := gerrors.ExtractZITADELError(result.Err)
+		return &mgmt_pb.BatchResult{
+			Error: &message.ErrorDetail{
+				Id:      id,
+				Message: key,
+			},
+		}
+	}
+	return &mgmt_pb.BatchResult{
+		Id:      result.ID,
+		Details: object.DomainToChangeDetailsPb(result.Details),
+	}
+}
@@ -2,10 +2,12 @@ package oidc
 
 import (
 	"context"
+	"encoding/json"
 
 	"github.com/zitadel/logging"
 	"github.com/zitadel/oidc/v3/pkg/op"
 	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/structpb"
 	"google.golang.org/protobuf/types/known/timestamppb"
 
 	"github.com/zitadel/zitadel/internal/api/authz"
@@ -202,3 +204,34 @@ func errorReasonToOIDC(reason oidc_pb.ErrorReason) string {
 		return "server_error"
 	}
 }
+
+func (s *Server) PreviewTokenClaims(ctx context.Context, req *oidc_pb.PreviewTokenClaimsRequest) (*oidc_pb.PreviewTokenClaimsResponse, error) {
+	idToken, accessToken, err := s.op.PreviewTokenClaims(ctx, req.GetUserId(), req.GetClientId(), req.GetScope())
+	if err != nil {
+		return nil, err
+	}
+	idTokenClaims, err := claimsToStructPb(idToken)
+	if err != nil {
+		return nil, err
+	}
+	accessTokenClaims, err := claimsToStructPb(accessToken)
+	if err != nil {
+		return nil, err
+	}
+	return &oidc_pb.PreviewTokenClaimsResponse{
+		IdTokenClaims:     idTokenClaims,
+		AccessTokenClaims: accessTokenClaims,
+	}, nil
+}
+
+func claimsToStructPb(claims any) (*structpb.Struct, error) {
+	marshalled, err := json.Marshal(claims)
+	if err != nil {
+		return nil, zerrors.ThrowInternal(err, "OIDCv2-r8xk2m0dqa", "Errors.Internal")
+	}
+	pb := new(structpb.Struct)
+	if err = pb.UnmarshalJSON(marshalled); err != nil {
+		return nil, zerrors.ThrowInternal(err, "OIDCv2-6uyw3fz1ho", "Errors.Internal")
+	}
+	return pb, nil
+}
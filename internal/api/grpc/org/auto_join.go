@@ -0,0 +1,14 @@
+package org
+
+import (
+	"github.com/zitadel/zitadel/internal/api/grpc/object"
+	"github.com/zitadel/zitadel/internal/query"
+	org_pb "github.com/zitadel/zitadel/pkg/grpc/org"
+)
+
+func OrgAutoJoinToPb(autoJoin *query.OrgAutoJoin) *org_pb.OrgAutoJoin {
+	return &org_pb.OrgAutoJoin{
+		Details: object.ToViewDetailsPb(autoJoin.Sequence, autoJoin.ChangeDate, autoJoin.ChangeDate, autoJoin.OrgID),
+		Roles:   autoJoin.Roles,
+	}
+}
@@ -27,6 +27,10 @@ func OrgQueryToModel(apiQuery *org_pb.OrgQuery) (query.SearchQuery, error) {
 		return query.NewOrgNameSearchQuery(object.TextMethodToQuery(q.NameQuery.Method), q.NameQuery.Name)
 	case *org_pb.OrgQuery_StateQuery:
 		return query.NewOrgStateSearchQuery(OrgStateToDomain(q.StateQuery.State))
+	case *org_pb.OrgQuery_SubtreeQuery:
+		return query.NewOrgSubtreeSearchQuery(q.SubtreeQuery.OrgId)
+	case *org_pb.OrgQuery_MetadataQuery:
+		return query.NewOrgMetadataSearchQuery(q.MetadataQuery.Key, q.MetadataQuery.Value)
 	default:
 		return nil, zerrors.ThrowInvalidArgument(nil, "ORG-vR9nC", "List.Query.Invalid")
 	}
@@ -51,6 +55,10 @@ func OrgQueryToQuery(search *org_pb.OrgQuery) (query.SearchQuery, error) {
 		return query.NewOrgNameSearchQuery(object.TextMethodToQuery(q.NameQuery.Method), q.NameQuery.Name)
 	case *org_pb.OrgQuery_StateQuery:
 		return query.NewOrgStateSearchQuery(OrgStateToDomain(q.StateQuery.State))
+	case *org_pb.OrgQuery_SubtreeQuery:
+		return query.NewOrgSubtreeSearchQuery(q.SubtreeQuery.OrgId)
+	case *org_pb.OrgQuery_MetadataQuery:
+		return query.NewOrgMetadataSearchQuery(q.MetadataQuery.Key, q.MetadataQuery.Value)
 	default:
 		return nil, zerrors.ThrowInvalidArgument(nil, "ADMIN-ADvsd", "List.Query.Invalid")
 	}
@@ -103,6 +111,8 @@ func OrgStateToPb(state domain.OrgState) org_pb.OrgState {
 		return org_pb.OrgState_ORG_STATE_ACTIVE
 	case domain.OrgStateInactive:
 		return org_pb.OrgState_ORG_STATE_INACTIVE
+	case domain.OrgStateArchived:
+		return org_pb.OrgState_ORG_STATE_ARCHIVED
 	default:
 		return org_pb.OrgState_ORG_STATE_UNSPECIFIED
 	}
@@ -114,6 +124,8 @@ func OrgStateToDomain(state org_pb.OrgState) domain.OrgState {
 		return domain.OrgStateActive
 	case org_pb.OrgState_ORG_STATE_INACTIVE:
 		return domain.OrgStateInactive
+	case org_pb.OrgState_ORG_STATE_ARCHIVED:
+		return domain.OrgStateArchived
 	case org_pb.OrgState_ORG_STATE_UNSPECIFIED:
 		fallthrough
 	default:
@@ -197,3 +209,20 @@ func FieldNameToOrgColumn(fieldName org_pb.OrgFieldName) query.Column {
 		return query.Column{}
 	}
 }
+
+func CustomRolesToPb(roles []*query.CustomRole) []*org_pb.CustomRole {
+	result := make([]*org_pb.CustomRole, len(roles))
+	for i, role := range roles {
+		result[i] = CustomRoleToPb(role)
+	}
+	return result
+}
+
+func CustomRoleToPb(role *query.CustomRole) *org_pb.CustomRole {
+	return &org_pb.CustomRole{
+		Key:         role.Key,
+		Details:     object.ToViewDetailsPb(role.Sequence, role.CreationDate, role.ChangeDate, role.ResourceOwner),
+		DisplayName: role.DisplayName,
+		Permissions: role.Permissions,
+	}
+}
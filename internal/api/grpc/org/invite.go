@@ -0,0 +1,82 @@
+package org
+
+import (
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/zitadel/zitadel/internal/api/grpc/object"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/query"
+	org_pb "github.com/zitadel/zitadel/pkg/grpc/org"
+)
+
+func OrgInvitesToPb(invites []*query.OrgInvite) []*org_pb.OrgInvite {
+	list := make([]*org_pb.OrgInvite, len(invites))
+	for i, invite := range invites {
+		list[i] = OrgInviteToPb(invite)
+	}
+	return list
+}
+
+func OrgInviteToPb(invite *query.OrgInvite) *org_pb.OrgInvite {
+	grants := make([]*org_pb.OrgInviteGrant, len(invite.Grants))
+	for i, grant := range invite.Grants {
+		grants[i] = &org_pb.OrgInviteGrant{
+			ProjectId:      grant.ProjectID,
+			ProjectGrantId: grant.ProjectGrantID,
+			RoleKeys:       grant.RoleKeys,
+		}
+	}
+	metadata := make([]*org_pb.OrgInviteMetadata, len(invite.Metadata))
+	for i, m := range invite.Metadata {
+		metadata[i] = &org_pb.OrgInviteMetadata{
+			Key:   m.Key,
+			Value: m.Value,
+		}
+	}
+	return &org_pb.OrgInvite{
+		Id:             invite.ID,
+		Details:        object.ToViewDetailsPb(invite.Sequence, invite.CreationDate, invite.ChangeDate, invite.OrgID),
+		State:          OrgInviteStateToPb(invite.State),
+		Email:          string(invite.Email),
+		Grants:         grants,
+		Metadata:       metadata,
+		ExpirationDate: timestamppb.New(invite.ExpirationDate),
+		UserId:         invite.UserID,
+	}
+}
+
+func OrgInviteStateToPb(state domain.OrgInviteState) org_pb.OrgInviteState {
+	switch state {
+	case domain.OrgInviteStateActive:
+		return org_pb.OrgInviteState_ORG_INVITE_STATE_ACTIVE
+	case domain.OrgInviteStateUsed:
+		return org_pb.OrgInviteState_ORG_INVITE_STATE_USED
+	case domain.OrgInviteStateRevoked:
+		return org_pb.OrgInviteState_ORG_INVITE_STATE_REVOKED
+	default:
+		return org_pb.OrgInviteState_ORG_INVITE_STATE_UNSPECIFIED
+	}
+}
+
+func OrgInviteGrantsToDomain(grants []*org_pb.OrgInviteGrant) []*domain.OrgInviteGrant {
+	list := make([]*domain.OrgInviteGrant, len(grants))
+	for i, grant := range grants {
+		list[i] = &domain.OrgInviteGrant{
+			ProjectID:      grant.GetProjectId(),
+			ProjectGrantID: grant.GetProjectGrantId(),
+			RoleKeys:       grant.GetRoleKeys(),
+		}
+	}
+	return list
+}
+
+func OrgInviteMetadataToDomain(metadata []*org_pb.OrgInviteMetadata) []*domain.OrgInviteMetadata {
+	list := make([]*domain.OrgInviteMetadata, len(metadata))
+	for i, m := range metadata {
+		list[i] = &domain.OrgInviteMetadata{
+			Key:   m.GetKey(),
+			Value: m.GetValue(),
+		}
+	}
+	return list
+}
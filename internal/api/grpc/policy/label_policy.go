@@ -27,6 +27,7 @@ func ModelLabelPolicyToPb(policy *query.LabelPolicy, assetPrefix string) *policy
 		DisableWatermark:    policy.WatermarkDisabled,
 		HideLoginNameSuffix: policy.HideLoginNameSuffix,
 		ThemeMode:           themeModeToPb(policy.ThemeMode),
+		CustomCss:           policy.CustomCSS,
 		Details: object.ToViewDetailsPb(
 			policy.Sequence,
 			policy.CreationDate,
@@ -15,27 +15,33 @@ import (
 
 func ModelLoginPolicyToPb(policy *query.LoginPolicy) *policy_pb.LoginPolicy {
 	return &policy_pb.LoginPolicy{
-		IsDefault:                  policy.IsDefault,
-		AllowUsernamePassword:      policy.AllowUsernamePassword,
-		AllowRegister:              policy.AllowRegister,
-		AllowExternalIdp:           policy.AllowExternalIDPs,
-		ForceMfa:                   policy.ForceMFA,
-		ForceMfaLocalOnly:          policy.ForceMFALocalOnly,
-		PasswordlessType:           ModelPasswordlessTypeToPb(policy.PasswordlessType),
-		HidePasswordReset:          policy.HidePasswordReset,
-		IgnoreUnknownUsernames:     policy.IgnoreUnknownUsernames,
-		AllowDomainDiscovery:       policy.AllowDomainDiscovery,
-		DisableLoginWithEmail:      policy.DisableLoginWithEmail,
-		DisableLoginWithPhone:      policy.DisableLoginWithPhone,
-		DefaultRedirectUri:         policy.DefaultRedirectURI,
-		PasswordCheckLifetime:      durationpb.New(time.Duration(policy.PasswordCheckLifetime)),
-		ExternalLoginCheckLifetime: durationpb.New(time.Duration(policy.ExternalLoginCheckLifetime)),
-		MfaInitSkipLifetime:        durationpb.New(time.Duration(policy.MFAInitSkipLifetime)),
-		SecondFactorCheckLifetime:  durationpb.New(time.Duration(policy.SecondFactorCheckLifetime)),
-		MultiFactorCheckLifetime:   durationpb.New(time.Duration(policy.MultiFactorCheckLifetime)),
-		SecondFactors:              ModelSecondFactorTypesToPb(policy.SecondFactors),
-		MultiFactors:               ModelMultiFactorTypesToPb(policy.MultiFactors),
-		Idps:                       idp_grpc.IDPLoginPolicyLinksToPb(policy.IDPLinks),
+		IsDefault:                   policy.IsDefault,
+		AllowUsernamePassword:       policy.AllowUsernamePassword,
+		AllowRegister:               policy.AllowRegister,
+		AllowExternalIdp:            policy.AllowExternalIDPs,
+		ForceMfa:                    policy.ForceMFA,
+		ForceMfaLocalOnly:           policy.ForceMFALocalOnly,
+		PasswordlessType:            ModelPasswordlessTypeToPb(policy.PasswordlessType),
+		HidePasswordReset:           policy.HidePasswordReset,
+		IgnoreUnknownUsernames:      policy.IgnoreUnknownUsernames,
+		AllowDomainDiscovery:        policy.AllowDomainDiscovery,
+		DisableLoginWithEmail:       policy.DisableLoginWithEmail,
+		DisableLoginWithPhone:       policy.DisableLoginWithPhone,
+		DefaultRedirectUri:          policy.DefaultRedirectURI,
+		PasswordCheckLifetime:       durationpb.New(time.Duration(policy.PasswordCheckLifetime)),
+		ExternalLoginCheckLifetime:  durationpb.New(time.Duration(policy.ExternalLoginCheckLifetime)),
+		MfaInitSkipLifetime:         durationpb.New(time.Duration(policy.MFAInitSkipLifetime)),
+		SecondFactorCheckLifetime:   durationpb.New(time.Duration(policy.SecondFactorCheckLifetime)),
+		MultiFactorCheckLifetime:    durationpb.New(time.Duration(policy.MultiFactorCheckLifetime)),
+		TrustedDeviceLifetime:       durationpb.New(time.Duration(policy.TrustedDeviceLifetime)),
+		SharedDevice:                policy.SharedDevice,
+		SharedDeviceLifetime:        durationpb.New(time.Duration(policy.SharedDeviceLifetime)),
+		SharedDeviceIdleTimeout:     durationpb.New(time.Duration(policy.SharedDeviceIdleTimeout)),
+		PasskeyPrompt:               policy.PasskeyPrompt,
+		PasskeyPromptSnoozeLifetime: durationpb.New(time.Duration(policy.PasskeyPromptSnoozeLifetime)),
+		SecondFactors:               ModelSecondFactorTypesToPb(policy.SecondFactors),
+		MultiFactors:                ModelMultiFactorTypesToPb(policy.MultiFactors),
+		Idps:                        idp_grpc.IDPLoginPolicyLinksToPb(policy.IDPLinks),
 		Details: &object.ObjectDetails{
 			Sequence:      policy.Sequence,
 			CreationDate:  timestamppb.New(policy.CreationDate),
@@ -0,0 +1,22 @@
+package policy
+
+import (
+	"google.golang.org/protobuf/types/known/durationpb"
+
+	"github.com/zitadel/zitadel/internal/api/grpc/object"
+	"github.com/zitadel/zitadel/internal/query"
+	policy_pb "github.com/zitadel/zitadel/pkg/grpc/policy"
+)
+
+func ModelMachineCredentialPolicyToPb(policy *query.MachineCredentialPolicy) *policy_pb.MachineCredentialPolicy {
+	return &policy_pb.MachineCredentialPolicy{
+		MaxLifetime:  durationpb.New(policy.MaxLifetime),
+		NotifyBefore: durationpb.New(policy.NotifyBefore),
+		Details: object.ToViewDetailsPb(
+			policy.Sequence,
+			policy.ChangeDate,
+			policy.ChangeDate,
+			policy.OrgID,
+		),
+	}
+}
@@ -0,0 +1,25 @@
+package policy
+
+import (
+	"github.com/zitadel/zitadel/internal/api/grpc/object"
+	"github.com/zitadel/zitadel/internal/query"
+	policy_pb "github.com/zitadel/zitadel/pkg/grpc/policy"
+)
+
+func ModelNetworkAccessPolicyToPb(policy *query.NetworkAccessPolicy) *policy_pb.NetworkAccessPolicy {
+	return &policy_pb.NetworkAccessPolicy{
+		AllowedCidrs:     policy.AllowedCIDRs,
+		DeniedCidrs:      policy.DeniedCIDRs,
+		AllowedCountries: policy.AllowedCountries,
+		DeniedCountries:  policy.DeniedCountries,
+		AllowedAsns:      policy.AllowedASNs,
+		DeniedAsns:       policy.DeniedASNs,
+		MonitorOnly:      policy.MonitorOnly,
+		Details: object.ToViewDetailsPb(
+			policy.Sequence,
+			policy.ChangeDate,
+			policy.ChangeDate,
+			policy.ResourceOwner,
+		),
+	}
+}
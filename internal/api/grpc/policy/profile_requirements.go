@@ -0,0 +1,62 @@
+package policy
+
+import (
+	"github.com/zitadel/zitadel/internal/api/grpc/object"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/query"
+	policy_pb "github.com/zitadel/zitadel/pkg/grpc/policy"
+)
+
+func ModelProfileRequirementsToPb(requirements *query.ProfileRequirements) *policy_pb.ProfileRequirements {
+	fields := make([]policy_pb.ProfileField, len(requirements.Fields))
+	for i, field := range requirements.Fields {
+		fields[i] = ProfileFieldToPb(field)
+	}
+	return &policy_pb.ProfileRequirements{
+		Fields: fields,
+		Details: object.ToViewDetailsPb(
+			requirements.Sequence,
+			requirements.ChangeDate,
+			requirements.ChangeDate,
+			requirements.OrgID,
+		),
+	}
+}
+
+func ProfileFieldsToDomain(fields []policy_pb.ProfileField) []domain.ProfileField {
+	list := make([]domain.ProfileField, len(fields))
+	for i, field := range fields {
+		list[i] = ProfileFieldToDomain(field)
+	}
+	return list
+}
+
+func ProfileFieldToPb(field domain.ProfileField) policy_pb.ProfileField {
+	switch field {
+	case domain.ProfileFieldPhone:
+		return policy_pb.ProfileField_PROFILE_FIELD_PHONE
+	case domain.ProfileFieldNickName:
+		return policy_pb.ProfileField_PROFILE_FIELD_NICK_NAME
+	case domain.ProfileFieldGender:
+		return policy_pb.ProfileField_PROFILE_FIELD_GENDER
+	case domain.ProfileFieldPreferredLanguage:
+		return policy_pb.ProfileField_PROFILE_FIELD_PREFERRED_LANGUAGE
+	default:
+		return policy_pb.ProfileField_PROFILE_FIELD_UNSPECIFIED
+	}
+}
+
+func ProfileFieldToDomain(field policy_pb.ProfileField) domain.ProfileField {
+	switch field {
+	case policy_pb.ProfileField_PROFILE_FIELD_PHONE:
+		return domain.ProfileFieldPhone
+	case policy_pb.ProfileField_PROFILE_FIELD_NICK_NAME:
+		return domain.ProfileFieldNickName
+	case policy_pb.ProfileField_PROFILE_FIELD_GENDER:
+		return domain.ProfileFieldGender
+	case policy_pb.ProfileField_PROFILE_FIELD_PREFERRED_LANGUAGE:
+		return domain.ProfileFieldPreferredLanguage
+	default:
+		return domain.ProfileFieldUnspecified
+	}
+}
@@ -0,0 +1,33 @@
+package policy
+
+import (
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/zitadel/zitadel/internal/query"
+	policy_pb "github.com/zitadel/zitadel/pkg/grpc/policy"
+)
+
+func TermsVersionToPb(version *query.TermsVersion) *policy_pb.TermsVersion {
+	if version == nil {
+		return nil
+	}
+	return &policy_pb.TermsVersion{
+		Version:     version.Version,
+		TosLink:     version.TOSLink,
+		PrivacyLink: version.PrivacyLink,
+		PublishedAt: timestamppb.New(version.PublishedAt),
+		IsDefault:   version.IsDefault,
+	}
+}
+
+func TermsAcceptancesToPb(acceptances []*query.TermsAcceptance) []*policy_pb.TermsAcceptance {
+	result := make([]*policy_pb.TermsAcceptance, len(acceptances))
+	for i, acceptance := range acceptances {
+		result[i] = &policy_pb.TermsAcceptance{
+			UserId:     acceptance.UserID,
+			Version:    acceptance.Version,
+			AcceptedAt: timestamppb.New(acceptance.AcceptedAt),
+		}
+	}
+	return result
+}
@@ -0,0 +1,74 @@
+package policy
+
+import (
+	"github.com/zitadel/zitadel/internal/api/grpc/object"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/query"
+	policy_pb "github.com/zitadel/zitadel/pkg/grpc/policy"
+)
+
+func ModelUserAttributeSchemaToPb(schema *query.UserAttributeSchema) *policy_pb.UserAttributeSchema {
+	attributes := make([]*policy_pb.UserAttribute, len(schema.Attributes))
+	for i, attribute := range schema.Attributes {
+		attributes[i] = &policy_pb.UserAttribute{
+			Key:         attribute.Key,
+			DisplayName: attribute.DisplayName,
+			Type:        UserAttributeTypeToPb(attribute.Type),
+			Required:    attribute.Required,
+			Pattern:     attribute.Pattern,
+		}
+	}
+	return &policy_pb.UserAttributeSchema{
+		Attributes: attributes,
+		Details: object.ToViewDetailsPb(
+			schema.Sequence,
+			schema.ChangeDate,
+			schema.ChangeDate,
+			schema.OrgID,
+		),
+	}
+}
+
+func UserAttributesToDomain(attributes []*policy_pb.UserAttribute) []*domain.UserAttribute {
+	list := make([]*domain.UserAttribute, len(attributes))
+	for i, attribute := range attributes {
+		list[i] = &domain.UserAttribute{
+			Key:         attribute.GetKey(),
+			DisplayName: attribute.GetDisplayName(),
+			Type:        UserAttributeTypeToDomain(attribute.GetType()),
+			Required:    attribute.GetRequired(),
+			Pattern:     attribute.GetPattern(),
+		}
+	}
+	return list
+}
+
+func UserAttributeTypeToPb(attributeType domain.UserAttributeType) policy_pb.UserAttributeType {
+	switch attributeType {
+	case domain.UserAttributeTypeString:
+		return policy_pb.UserAttributeType_USER_ATTRIBUTE_TYPE_STRING
+	case domain.UserAttributeTypeNumber:
+		return policy_pb.UserAttributeType_USER_ATTRIBUTE_TYPE_NUMBER
+	case domain.UserAttributeTypeBoolean:
+		return policy_pb.UserAttributeType_USER_ATTRIBUTE_TYPE_BOOLEAN
+	case domain.UserAttributeTypeDate:
+		return policy_pb.UserAttributeType_USER_ATTRIBUTE_TYPE_DATE
+	default:
+		return policy_pb.UserAttributeType_USER_ATTRIBUTE_TYPE_UNSPECIFIED
+	}
+}
+
+func UserAttributeTypeToDomain(attributeType policy_pb.UserAttributeType) domain.UserAttributeType {
+	switch attributeType {
+	case policy_pb.UserAttributeType_USER_ATTRIBUTE_TYPE_STRING:
+		return domain.UserAttributeTypeString
+	case policy_pb.UserAttributeType_USER_ATTRIBUTE_TYPE_NUMBER:
+		return domain.UserAttributeTypeNumber
+	case policy_pb.UserAttributeType_USER_ATTRIBUTE_TYPE_BOOLEAN:
+		return domain.UserAttributeTypeBoolean
+	case policy_pb.UserAttributeType_USER_ATTRIBUTE_TYPE_DATE:
+		return domain.UserAttributeTypeDate
+	default:
+		return domain.UserAttributeTypeUnspecified
+	}
+}
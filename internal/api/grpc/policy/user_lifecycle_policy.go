@@ -0,0 +1,23 @@
+package policy
+
+import (
+	"google.golang.org/protobuf/types/known/durationpb"
+
+	"github.com/zitadel/zitadel/internal/api/grpc/object"
+	"github.com/zitadel/zitadel/internal/query"
+	policy_pb "github.com/zitadel/zitadel/pkg/grpc/policy"
+)
+
+func ModelUserLifecyclePolicyToPb(policy *query.UserLifecyclePolicy) *policy_pb.UserLifecyclePolicy {
+	return &policy_pb.UserLifecyclePolicy{
+		DeactivateAfter: durationpb.New(policy.DeactivateAfter),
+		DeleteAfter:     durationpb.New(policy.DeleteAfter),
+		NotifyBefore:    durationpb.New(policy.NotifyBefore),
+		Details: object.ToViewDetailsPb(
+			policy.Sequence,
+			policy.ChangeDate,
+			policy.ChangeDate,
+			policy.OrgID,
+		),
+	}
+}
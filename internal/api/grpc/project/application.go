@@ -21,11 +21,108 @@ func AppsToPb(apps []*query.App) []*app_pb.App {
 
 func AppToPb(app *query.App) *app_pb.App {
 	return &app_pb.App{
-		Id:      app.ID,
-		Details: object_grpc.ToViewDetailsPb(app.Sequence, app.CreationDate, app.ChangeDate, app.ResourceOwner),
-		State:   AppStateToPb(app.State),
-		Name:    app.Name,
-		Config:  AppConfigToPb(app),
+		Id:            app.ID,
+		Details:       object_grpc.ToViewDetailsPb(app.Sequence, app.CreationDate, app.ChangeDate, app.ResourceOwner),
+		State:         AppStateToPb(app.State),
+		Name:          app.Name,
+		Config:        AppConfigToPb(app),
+		ClaimsMapping: ClaimsMappingToPb(app.ClaimsMapping),
+	}
+}
+
+func ClaimsMappingToPb(mapping *domain.ClaimsMapping) *app_pb.ClaimsMapping {
+	if mapping.IsZero() {
+		return nil
+	}
+	return &app_pb.ClaimsMapping{
+		IncludeRoles:       mapping.IncludeRoles,
+		MetadataKeys:       mapping.MetadataKeys,
+		FlattenAudience:    mapping.FlattenAudience,
+		RenamedClaims:      mapping.RenamedClaims,
+		MetadataNamespaces: mapping.MetadataNamespaces,
+		RolesFormat:        claimsRolesFormatToPb(mapping.RolesFormat),
+	}
+}
+
+func claimsRolesFormatToPb(format domain.ClaimsRolesFormat) app_pb.ClaimsRolesFormat {
+	switch format {
+	case domain.ClaimsRolesFormatList:
+		return app_pb.ClaimsRolesFormat_CLAIMS_ROLES_FORMAT_LIST
+	case domain.ClaimsRolesFormatMap:
+		return app_pb.ClaimsRolesFormat_CLAIMS_ROLES_FORMAT_MAP
+	default:
+		return app_pb.ClaimsRolesFormat_CLAIMS_ROLES_FORMAT_MAP
+	}
+}
+
+func ClaimsMappingToDomain(mapping *app_pb.ClaimsMapping) *domain.ClaimsMapping {
+	if mapping == nil {
+		return nil
+	}
+	return &domain.ClaimsMapping{
+		IncludeRoles:       mapping.GetIncludeRoles(),
+		MetadataKeys:       mapping.GetMetadataKeys(),
+		FlattenAudience:    mapping.GetFlattenAudience(),
+		RenamedClaims:      mapping.GetRenamedClaims(),
+		MetadataNamespaces: mapping.GetMetadataNamespaces(),
+		RolesFormat:        claimsRolesFormatToDomain(mapping.GetRolesFormat()),
+	}
+}
+
+func claimsRolesFormatToDomain(format app_pb.ClaimsRolesFormat) domain.ClaimsRolesFormat {
+	switch format {
+	case app_pb.ClaimsRolesFormat_CLAIMS_ROLES_FORMAT_LIST:
+		return domain.ClaimsRolesFormatList
+	case app_pb.ClaimsRolesFormat_CLAIMS_ROLES_FORMAT_MAP:
+		return domain.ClaimsRolesFormatMap
+	default:
+		return domain.ClaimsRolesFormatMap
+	}
+}
+
+func AuthRequirementsToPb(requirements *domain.AppAuthRequirements) *app_pb.AppAuthRequirements {
+	if requirements.IsZero() {
+		return nil
+	}
+	return &app_pb.AppAuthRequirements{
+		ForceMfa:        requirements.ForceMFA,
+		MaxAuthAge:      durationpb.New(requirements.MaxAuthAge),
+		ConsentRequired: requirements.ConsentRequired,
+	}
+}
+
+func AuthRequirementsToDomain(requirements *app_pb.AppAuthRequirements) *domain.AppAuthRequirements {
+	if requirements == nil {
+		return nil
+	}
+	return &domain.AppAuthRequirements{
+		ForceMFA:        requirements.GetForceMfa(),
+		MaxAuthAge:      requirements.GetMaxAuthAge().AsDuration(),
+		ConsentRequired: requirements.GetConsentRequired(),
+	}
+}
+
+func TokenLifetimesToPb(lifetimes *domain.AppTokenLifetimes) *app_pb.AppTokenLifetimes {
+	if lifetimes.IsZero() {
+		return nil
+	}
+	return &app_pb.AppTokenLifetimes{
+		AccessTokenLifetime:        durationpb.New(lifetimes.AccessTokenLifetime),
+		IdTokenLifetime:            durationpb.New(lifetimes.IDTokenLifetime),
+		RefreshTokenIdleExpiration: durationpb.New(lifetimes.RefreshTokenIdleExpiration),
+		RefreshTokenExpiration:     durationpb.New(lifetimes.RefreshTokenExpiration),
+	}
+}
+
+func TokenLifetimesToDomain(lifetimes *app_pb.AppTokenLifetimes) *domain.AppTokenLifetimes {
+	if lifetimes == nil {
+		return nil
+	}
+	return &domain.AppTokenLifetimes{
+		AccessTokenLifetime:        lifetimes.GetAccessTokenLifetime().AsDuration(),
+		IDTokenLifetime:            lifetimes.GetIdTokenLifetime().AsDuration(),
+		RefreshTokenIdleExpiration: lifetimes.GetRefreshTokenIdleExpiration().AsDuration(),
+		RefreshTokenExpiration:     lifetimes.GetRefreshTokenExpiration().AsDuration(),
 	}
 }
 
@@ -61,6 +158,11 @@ func AppOIDCConfigToPb(app *query.OIDCApp) *app_pb.App_OidcConfig {
 			AdditionalOrigins:        app.AdditionalOrigins,
 			AllowedOrigins:           app.AllowedOrigins,
 			SkipNativeAppSuccessPage: app.SkipNativeAppSuccessPage,
+			TlsClientAuthSubjectDn:   app.TLSClientAuthSubjectDN,
+			TlsClientAuthThumbprint:  app.TLSClientAuthThumbprint,
+			BackChannelLogoutUri:     app.BackChannelLogoutURI,
+			FrontChannelLogoutUri:    app.FrontChannelLogoutURI,
+			FrameAncestors:           app.FrameAncestors,
 		},
 	}
 }
@@ -68,11 +170,86 @@ func AppOIDCConfigToPb(app *query.OIDCApp) *app_pb.App_OidcConfig {
 func AppSAMLConfigToPb(app *query.SAMLApp) app_pb.AppConfig {
 	return &app_pb.App_SamlConfig{
 		SamlConfig: &app_pb.SAMLConfig{
-			Metadata: &app_pb.SAMLConfig_MetadataXml{MetadataXml: app.Metadata},
+			Metadata:   &app_pb.SAMLConfig_MetadataXml{MetadataXml: app.Metadata},
+			Attributes: SAMLAttributesToPb(app.Attributes),
 		},
 	}
 }
 
+func SAMLAttributesToPb(attributes []*domain.SAMLAttribute) []*app_pb.SAMLAttribute {
+	a := make([]*app_pb.SAMLAttribute, len(attributes))
+	for i, attribute := range attributes {
+		a[i] = &app_pb.SAMLAttribute{
+			Name:         attribute.Name,
+			NameFormat:   attribute.NameFormat,
+			FriendlyName: attribute.FriendlyName,
+			Value:        samlAttributeValueToPb(attribute.Value),
+			MetadataKey:  attribute.MetadataKey,
+		}
+	}
+	return a
+}
+
+func SAMLAttributesToDomain(attributes []*app_pb.SAMLAttribute) []*domain.SAMLAttribute {
+	a := make([]*domain.SAMLAttribute, len(attributes))
+	for i, attribute := range attributes {
+		a[i] = &domain.SAMLAttribute{
+			Name:         attribute.GetName(),
+			NameFormat:   attribute.GetNameFormat(),
+			FriendlyName: attribute.GetFriendlyName(),
+			Value:        samlAttributeValueToDomain(attribute.GetValue()),
+			MetadataKey:  attribute.GetMetadataKey(),
+		}
+	}
+	return a
+}
+
+func samlAttributeValueToPb(value domain.SAMLAttributeValue) app_pb.SAMLAttributeValue {
+	switch value {
+	case domain.SAMLAttributeValueEmail:
+		return app_pb.SAMLAttributeValue_SAML_ATTRIBUTE_VALUE_EMAIL
+	case domain.SAMLAttributeValueGivenName:
+		return app_pb.SAMLAttributeValue_SAML_ATTRIBUTE_VALUE_GIVEN_NAME
+	case domain.SAMLAttributeValueSurname:
+		return app_pb.SAMLAttributeValue_SAML_ATTRIBUTE_VALUE_SURNAME
+	case domain.SAMLAttributeValueFullName:
+		return app_pb.SAMLAttributeValue_SAML_ATTRIBUTE_VALUE_FULL_NAME
+	case domain.SAMLAttributeValueUsername:
+		return app_pb.SAMLAttributeValue_SAML_ATTRIBUTE_VALUE_USERNAME
+	case domain.SAMLAttributeValueUserID:
+		return app_pb.SAMLAttributeValue_SAML_ATTRIBUTE_VALUE_USER_ID
+	case domain.SAMLAttributeValueRoles:
+		return app_pb.SAMLAttributeValue_SAML_ATTRIBUTE_VALUE_ROLES
+	case domain.SAMLAttributeValueMetadata:
+		return app_pb.SAMLAttributeValue_SAML_ATTRIBUTE_VALUE_METADATA
+	default:
+		return app_pb.SAMLAttributeValue_SAML_ATTRIBUTE_VALUE_UNSPECIFIED
+	}
+}
+
+func samlAttributeValueToDomain(value app_pb.SAMLAttributeValue) domain.SAMLAttributeValue {
+	switch value {
+	case app_pb.SAMLAttributeValue_SAML_ATTRIBUTE_VALUE_EMAIL:
+		return domain.SAMLAttributeValueEmail
+	case app_pb.SAMLAttributeValue_SAML_ATTRIBUTE_VALUE_GIVEN_NAME:
+		return domain.SAMLAttributeValueGivenName
+	case app_pb.SAMLAttributeValue_SAML_ATTRIBUTE_VALUE_SURNAME:
+		return domain.SAMLAttributeValueSurname
+	case app_pb.SAMLAttributeValue_SAML_ATTRIBUTE_VALUE_FULL_NAME:
+		return domain.SAMLAttributeValueFullName
+	case app_pb.SAMLAttributeValue_SAML_ATTRIBUTE_VALUE_USERNAME:
+		return domain.SAMLAttributeValueUsername
+	case app_pb.SAMLAttributeValue_SAML_ATTRIBUTE_VALUE_USER_ID:
+		return domain.SAMLAttributeValueUserID
+	case app_pb.SAMLAttributeValue_SAML_ATTRIBUTE_VALUE_ROLES:
+		return domain.SAMLAttributeValueRoles
+	case app_pb.SAMLAttributeValue_SAML_ATTRIBUTE_VALUE_METADATA:
+		return domain.SAMLAttributeValueMetadata
+	default:
+		return domain.SAMLAttributeValueUnspecified
+	}
+}
+
 func AppAPIConfigToPb(app *query.APIApp) app_pb.AppConfig {
 	return &app_pb.App_ApiConfig{
 		ApiConfig: &app_pb.APIConfig{
@@ -202,6 +379,10 @@ func OIDCAuthMethodTypeToPb(authType domain.OIDCAuthMethodType) app_pb.OIDCAuthM
 		return app_pb.OIDCAuthMethodType_OIDC_AUTH_METHOD_TYPE_NONE
 	case domain.OIDCAuthMethodTypePrivateKeyJWT:
 		return app_pb.OIDCAuthMethodType_OIDC_AUTH_METHOD_TYPE_PRIVATE_KEY_JWT
+	case domain.OIDCAuthMethodTypeTLSClientAuth:
+		return app_pb.OIDCAuthMethodType_OIDC_AUTH_METHOD_TYPE_TLS_CLIENT_AUTH
+	case domain.OIDCAuthMethodTypeSelfSignedTLSClientAuth:
+		return app_pb.OIDCAuthMethodType_OIDC_AUTH_METHOD_TYPE_SELF_SIGNED_TLS_CLIENT_AUTH
 	default:
 		return app_pb.OIDCAuthMethodType_OIDC_AUTH_METHOD_TYPE_BASIC
 	}
@@ -217,6 +398,10 @@ func OIDCAuthMethodTypeToDomain(authType app_pb.OIDCAuthMethodType) domain.OIDCA
 		return domain.OIDCAuthMethodTypeNone
 	case app_pb.OIDCAuthMethodType_OIDC_AUTH_METHOD_TYPE_PRIVATE_KEY_JWT:
 		return domain.OIDCAuthMethodTypePrivateKeyJWT
+	case app_pb.OIDCAuthMethodType_OIDC_AUTH_METHOD_TYPE_TLS_CLIENT_AUTH:
+		return domain.OIDCAuthMethodTypeTLSClientAuth
+	case app_pb.OIDCAuthMethodType_OIDC_AUTH_METHOD_TYPE_SELF_SIGNED_TLS_CLIENT_AUTH:
+		return domain.OIDCAuthMethodTypeSelfSignedTLSClientAuth
 	default:
 		return domain.OIDCAuthMethodTypeBasic
 	}
@@ -25,6 +25,7 @@ func ProjectViewToPb(project *query.Project) *proj_pb.Project {
 		HasProjectCheck:        project.HasProjectCheck,
 		ProjectRoleAssertion:   project.ProjectRoleAssertion,
 		ProjectRoleCheck:       project.ProjectRoleCheck,
+		AccessTokenType:        oidcTokenTypeToPb(project.AccessTokenType),
 		Details: object.ToViewDetailsPb(
 			project.Sequence,
 			project.CreationDate,
@@ -33,6 +33,7 @@ const (
 var (
 	customHeaders = []string{
 		"x-zitadel-",
+		middleware.IdempotencyKeyHeader,
 	}
 	jsonMarshaler = &runtime.JSONPb{
 		UnmarshalOptions: protojson.UnmarshalOptions{
@@ -121,6 +122,7 @@ func CreateGatewayWithPrefix(
 		grpc.WithChainUnaryInterceptor(
 			client_middleware.DefaultTracingClient(),
 			client_middleware.UnaryActivityClientInterceptor(),
+			client_middleware.UnaryClientIPClientInterceptor(),
 		),
 	}
 	connection, err := dial(ctx, port, opts)
@@ -148,6 +150,7 @@ func CreateGateway(
 			grpc.WithChainUnaryInterceptor(
 				client_middleware.DefaultTracingClient(),
 				client_middleware.UnaryActivityClientInterceptor(),
+				client_middleware.UnaryClientIPClientInterceptor(),
 			),
 		})
 	if err != nil {
@@ -205,6 +208,7 @@ func addInterceptors(
 	handler = http_mw.RobotsTagHandler(handler)
 	handler = http_mw.DefaultTelemetryHandler(handler)
 	handler = http_mw.ActivityHandler(handler)
+	handler = http_mw.ClientIPHandler(handler)
 	// For some non-obvious reason, the exhaustedCookieInterceptor sends the SetCookie header
 	// only if it follows the http_mw.DefaultTelemetryHandler
 	handler = exhaustedCookieInterceptor(handler, accessInterceptor)
@@ -39,7 +39,9 @@ func authorize(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo,
 		return nil, err
 	}
 	span.End()
-	return handler(ctxSetter(ctx), req)
+	ctx = ctxSetter(ctx)
+	tracing.SetOrgAttribute(ctx, authz.GetCtxData(ctx).OrgID)
+	return handler(ctx, req)
 }
 
 func orgIDAndDomainFromRequest(ctx context.Context, req interface{}) (id, domain string) {
@@ -17,8 +17,8 @@ const anAPIRole = "AN_API_ROLE"
 
 type authzRepoMock struct{}
 
-func (v *authzRepoMock) VerifyAccessToken(ctx context.Context, token, clientID, projectID string) (string, string, string, string, string, error) {
-	return "", "", "", "", "", nil
+func (v *authzRepoMock) VerifyAccessToken(ctx context.Context, token, clientID, projectID string) (string, string, string, string, string, string, []string, error) {
+	return "", "", "", "", "", "", nil, nil
 }
 func (v *authzRepoMock) SearchMyMemberships(ctx context.Context, orgID string, _ bool) ([]*authz.Membership, error) {
 	return authz.Memberships{{
@@ -39,11 +39,11 @@ func (v *authzRepoMock) VerifierClientID(ctx context.Context, appName string) (s
 }
 
 var (
-	accessTokenOK = authz.AccessTokenVerifierFunc(func(ctx context.Context, token string) (userID string, clientID string, agentID string, prefLan string, resourceOwner string, err error) {
-		return "user1", "", "", "", "org1", nil
+	accessTokenOK = authz.AccessTokenVerifierFunc(func(ctx context.Context, token string) (userID string, clientID string, agentID string, prefLan string, resourceOwner string, tokenID string, permissions []string, err error) {
+		return "user1", "", "", "", "org1", "", nil, nil
 	})
-	accessTokenNOK = authz.AccessTokenVerifierFunc(func(ctx context.Context, token string) (userID string, clientID string, agentID string, prefLan string, resourceOwner string, err error) {
-		return "", "", "", "", "", zerrors.ThrowUnauthenticated(nil, "TEST-fQHDI", "unauthenticaded")
+	accessTokenNOK = authz.AccessTokenVerifierFunc(func(ctx context.Context, token string) (userID string, clientID string, agentID string, prefLan string, resourceOwner string, tokenID string, permissions []string, err error) {
+		return "", "", "", "", "", "", nil, zerrors.ThrowUnauthenticated(nil, "TEST-fQHDI", "unauthenticaded")
 	})
 	systemTokenNOK = authz.SystemTokenVerifierFunc(func(ctx context.Context, token string, orgID string) (memberships authz.Memberships, userID string, err error) {
 		return nil, "", errors.New("system token error")
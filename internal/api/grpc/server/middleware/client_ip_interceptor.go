@@ -0,0 +1,34 @@
+package middleware
+
+import (
+	"context"
+	"net/http"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+
+	grpc_utils "github.com/zitadel/zitadel/internal/api/grpc"
+	http_util "github.com/zitadel/zitadel/internal/api/http"
+)
+
+// ClientIPInterceptor sets the IP address of the client, so it can be read by [http_util.RemoteIPFromCtx].
+// Calls of the gateway use the client IP it resolved from the HTTP request,
+// for all other calls the forwarded header is read as configured (see [http_util.ClientIPConfig]).
+func ClientIPInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		return handler(http_util.WithClientIP(ctx, clientIPFromCall(ctx, http_util.ClientIPHeader())), req)
+	}
+}
+
+func clientIPFromCall(ctx context.Context, header string) string {
+	if ip, ok := grpc_utils.GatewayClientIP(ctx); ok {
+		return ip
+	}
+	var remoteAddr string
+	if p, ok := peer.FromContext(ctx); ok && p.Addr != nil {
+		remoteAddr = p.Addr.String()
+	}
+	md, _ := metadata.FromIncomingContext(ctx)
+	return http_util.ClientIP(http.Header{header: md.Get(header)}, remoteAddr)
+}
@@ -0,0 +1,123 @@
+package middleware
+
+import (
+	"context"
+	"net"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+
+	grpc_utils "github.com/zitadel/zitadel/internal/api/grpc"
+	http_util "github.com/zitadel/zitadel/internal/api/http"
+)
+
+func Test_clientIPFromCall(t *testing.T) {
+	type args struct {
+		trustedProxies []string
+		peer           string
+		forwarded      []string
+		metadata       metadata.MD
+		gateway        bool
+	}
+	tests := []struct {
+		name string
+		args args
+		want string
+	}{
+		{
+			name: "no peer",
+			want: "",
+		},
+		{
+			name: "peer",
+			args: args{
+				peer: "1.2.3.4:1234",
+			},
+			want: "1.2.3.4",
+		},
+		{
+			name: "header without trusted proxies, last hop",
+			args: args{
+				peer:      "1.2.3.4:1234",
+				forwarded: []string{"10.0.0.1"},
+			},
+			want: "10.0.0.1",
+		},
+		{
+			name: "forged header of untrusted peer",
+			args: args{
+				trustedProxies: []string{"10.0.0.0/8"},
+				peer:           "1.2.3.4:1234",
+				forwarded:      []string{"5.6.7.8"},
+			},
+			want: "1.2.3.4",
+		},
+		{
+			name: "trusted proxy",
+			args: args{
+				trustedProxies: []string{"10.0.0.0/8"},
+				peer:           "10.0.0.2:1234",
+				forwarded:      []string{"5.6.7.8, 1.2.3.4"},
+			},
+			want: "1.2.3.4",
+		},
+		{
+			name: "loopback peer, header of untrusted peer",
+			args: args{
+				trustedProxies: []string{"10.0.0.0/8"},
+				peer:           "127.0.0.1:8080",
+				forwarded:      []string{"5.6.7.8, 1.2.3.4"},
+			},
+			want: "127.0.0.1",
+		},
+		{
+			name: "gateway",
+			args: args{
+				trustedProxies: []string{"10.0.0.0/8"},
+				peer:           "127.0.0.1:8080",
+				forwarded:      []string{"5.6.7.8"},
+				gateway:        true,
+			},
+			want: "1.2.3.4",
+		},
+		{
+			name: "forged gateway metadata",
+			args: args{
+				trustedProxies: []string{"10.0.0.0/8"},
+				peer:           "1.2.3.4:1234",
+				metadata: metadata.Pairs(
+					"x-zitadel-internal-gateway-client-ip", "5.6.7.8",
+					"x-zitadel-internal-gateway-auth", "secret",
+				),
+			},
+			want: "1.2.3.4",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.NoError(t, http_util.SetClientIPConfig(http_util.ClientIPConfig{TrustedProxies: tt.args.trustedProxies}))
+			t.Cleanup(func() {
+				require.NoError(t, http_util.SetClientIPConfig(http_util.ClientIPConfig{}))
+			})
+			ctx := context.Background()
+			if tt.args.peer != "" {
+				addr, err := net.ResolveTCPAddr("tcp", tt.args.peer)
+				require.NoError(t, err)
+				ctx = peer.NewContext(ctx, &peer.Peer{Addr: addr})
+			}
+			md := tt.args.metadata.Copy()
+			if len(tt.args.forwarded) > 0 {
+				md = metadata.Join(md, metadata.MD{http_util.ForwardedFor: tt.args.forwarded})
+			}
+			if tt.args.gateway {
+				outgoing, _ := metadata.FromOutgoingContext(grpc_utils.SetGatewayClientIP(ctx, "1.2.3.4"))
+				md = metadata.Join(md, outgoing)
+			}
+			ctx = metadata.NewIncomingContext(ctx, md)
+			assert.Equal(t, tt.want, clientIPFromCall(ctx, http_util.ForwardedFor))
+		})
+	}
+}
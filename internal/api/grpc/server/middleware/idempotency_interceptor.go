@@ -0,0 +1,146 @@
+package middleware
+
+import (
+	"context"
+	"crypto/sha256"
+	"strings"
+
+	"github.com/zitadel/logging"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/api/idempotency"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+// IdempotencyKeyHeader is the header or metadata key of the idempotency key
+const IdempotencyKeyHeader = "idempotency-key"
+
+// queryMethodPrefixes are the prefixes of the methods which don't change any resource
+var queryMethodPrefixes = []string{"Get", "List", "Is", "Healthz"}
+
+// IdempotencyInterceptor executes the mutating calls of the services only once per idempotency key.
+// Retries with the same key return the response of the first call.
+// Calls without an idempotency key are not affected.
+// Only the Management API is passed as service for now: the responses are stored in the database,
+// so services with large responses like the exports of the Admin API
+// and services with other method naming like the v2 APIs must be checked before they are added.
+func IdempotencyInterceptor(storage idempotency.Storage, services ...string) grpc.UnaryServerInterceptor {
+	for idx, service := range services {
+		if !strings.HasPrefix(service, "/") {
+			services[idx] = "/" + service
+		}
+	}
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (_ interface{}, err error) {
+		key := idempotencyKey(ctx)
+		if key == "" || !isMutatingMethod(info.FullMethod, services) {
+			return handler(ctx, req)
+		}
+		request, ok := req.(proto.Message)
+		if !ok {
+			return handler(ctx, req)
+		}
+		requestHash, err := hashRequest(request)
+		if err != nil {
+			return nil, err
+		}
+		idempotencyKey := &idempotency.Key{
+			InstanceID: authz.GetInstance(ctx).InstanceID(),
+			UserID:     authz.GetCtxData(ctx).UserID,
+			Key:        key,
+		}
+		stored, err := storage.Reserve(ctx, idempotencyKey, &idempotency.Call{
+			Method:      info.FullMethod,
+			RequestHash: requestHash,
+		})
+		if err != nil {
+			return nil, err
+		}
+		if stored != nil {
+			return unmarshalResponse(stored)
+		}
+
+		resp, err := handler(ctx, req)
+		if err != nil {
+			releaseErr := storage.Release(ctx, idempotencyKey)
+			logging.OnError(releaseErr).Warn("unable to release idempotency key")
+			return nil, err
+		}
+		if err = completeCall(ctx, storage, idempotencyKey, resp); err != nil {
+			// the call succeeded, so the response is returned anyway,
+			// retries with the same key will be executed again
+			logging.WithError(err).Warn("unable to store response of idempotency key")
+			releaseErr := storage.Release(ctx, idempotencyKey)
+			logging.OnError(releaseErr).Warn("unable to release idempotency key")
+		}
+		return resp, nil
+	}
+}
+
+func idempotencyKey(ctx context.Context) string {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return ""
+	}
+	keys := md.Get(IdempotencyKeyHeader)
+	if len(keys) != 1 {
+		return ""
+	}
+	return keys[0]
+}
+
+func isMutatingMethod(fullMethod string, services []string) bool {
+	for _, service := range services {
+		if !strings.HasPrefix(fullMethod, service) {
+			continue
+		}
+		method := fullMethod[strings.LastIndex(fullMethod, "/")+1:]
+		for _, prefix := range queryMethodPrefixes {
+			if strings.HasPrefix(method, prefix) {
+				return false
+			}
+		}
+		return true
+	}
+	return false
+}
+
+func hashRequest(request proto.Message) ([]byte, error) {
+	data, err := proto.MarshalOptions{Deterministic: true}.Marshal(request)
+	if err != nil {
+		return nil, zerrors.ThrowInternal(err, "IDEMP-Rk3vq", "Errors.Internal")
+	}
+	hash := sha256.Sum256(data)
+	return hash[:], nil
+}
+
+func completeCall(ctx context.Context, storage idempotency.Storage, key *idempotency.Key, resp interface{}) error {
+	response, ok := resp.(proto.Message)
+	if !ok {
+		return zerrors.ThrowInternal(nil, "IDEMP-Rv9nx", "Errors.Internal")
+	}
+	wrapped, err := anypb.New(response)
+	if err != nil {
+		return zerrors.ThrowInternal(err, "IDEMP-Rq4mw", "Errors.Internal")
+	}
+	data, err := proto.Marshal(wrapped)
+	if err != nil {
+		return zerrors.ThrowInternal(err, "IDEMP-Rn8xk", "Errors.Internal")
+	}
+	return storage.Complete(ctx, key, data)
+}
+
+func unmarshalResponse(data []byte) (interface{}, error) {
+	wrapped := new(anypb.Any)
+	if err := proto.Unmarshal(data, wrapped); err != nil {
+		return nil, zerrors.ThrowInternal(err, "IDEMP-Rx7qv", "Errors.Internal")
+	}
+	response, err := wrapped.UnmarshalNew()
+	if err != nil {
+		return nil, zerrors.ThrowInternal(err, "IDEMP-Rm3kq", "Errors.Internal")
+	}
+	return response, nil
+}
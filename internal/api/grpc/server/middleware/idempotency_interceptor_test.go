@@ -0,0 +1,96 @@
+package middleware
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+
+	"github.com/zitadel/zitadel/internal/api/idempotency"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+type mockIdempotencyStorage struct {
+	calls     map[string]*idempotency.Call
+	responses map[string][]byte
+}
+
+func (s *mockIdempotencyStorage) Reserve(_ context.Context, key *idempotency.Key, call *idempotency.Call) ([]byte, error) {
+	reserved, ok := s.calls[key.Key]
+	if !ok {
+		s.calls[key.Key] = call
+		return nil, nil
+	}
+	if reserved.Method != call.Method || string(reserved.RequestHash) != string(call.RequestHash) {
+		return nil, zerrors.ThrowInvalidArgument(nil, "test", "Errors.Idempotency.RequestMismatch")
+	}
+	if s.responses[key.Key] == nil {
+		return nil, zerrors.ThrowPreconditionFailed(nil, "test", "Errors.Idempotency.InProgress")
+	}
+	return s.responses[key.Key], nil
+}
+
+func (s *mockIdempotencyStorage) Complete(_ context.Context, key *idempotency.Key, response []byte) error {
+	s.responses[key.Key] = response
+	return nil
+}
+
+func (s *mockIdempotencyStorage) Release(_ context.Context, key *idempotency.Key) error {
+	delete(s.calls, key.Key)
+	return nil
+}
+
+func TestIdempotencyInterceptor(t *testing.T) {
+	storage := &mockIdempotencyStorage{calls: map[string]*idempotency.Call{}, responses: map[string][]byte{}}
+	interceptor := IdempotencyInterceptor(storage, "zitadel.management.v1.ManagementService")
+
+	var executed int
+	handler := func(_ context.Context, req interface{}) (interface{}, error) {
+		executed++
+		return wrapperspb.Int64(int64(executed)), nil
+	}
+	failingHandler := func(_ context.Context, req interface{}) (interface{}, error) {
+		return nil, errors.New("failed")
+	}
+	withKey := func(key string) context.Context {
+		return metadata.NewIncomingContext(context.Background(), metadata.Pairs(IdempotencyKeyHeader, key))
+	}
+	const addUser = "/zitadel.management.v1.ManagementService/AddHumanUser"
+
+	resp, err := interceptor(withKey("key"), wrapperspb.String("user"), mockInfo(addUser), handler)
+	require.NoError(t, err)
+	assert.True(t, proto.Equal(wrapperspb.Int64(1), resp.(proto.Message)))
+
+	// the retry returns the response of the first call
+	resp, err = interceptor(withKey("key"), wrapperspb.String("user"), mockInfo(addUser), handler)
+	require.NoError(t, err)
+	assert.True(t, proto.Equal(wrapperspb.Int64(1), resp.(proto.Message)))
+	assert.Equal(t, 1, executed)
+
+	// the key must not be reused for another request
+	_, err = interceptor(withKey("key"), wrapperspb.String("other"), mockInfo(addUser), handler)
+	assert.True(t, zerrors.IsErrorInvalidArgument(err))
+
+	// failed calls can be retried
+	_, err = interceptor(withKey("failing"), wrapperspb.String("user"), mockInfo(addUser), failingHandler)
+	require.Error(t, err)
+	_, err = interceptor(withKey("failing"), wrapperspb.String("user"), mockInfo(addUser), handler)
+	require.NoError(t, err)
+	assert.Equal(t, 2, executed)
+
+	// calls without key, queries and other services are executed every time
+	_, err = interceptor(context.Background(), wrapperspb.String("user"), mockInfo(addUser), handler)
+	require.NoError(t, err)
+	_, err = interceptor(withKey("query"), wrapperspb.String("user"), mockInfo("/zitadel.management.v1.ManagementService/GetUserByID"), handler)
+	require.NoError(t, err)
+	_, err = interceptor(withKey("query"), wrapperspb.String("user"), mockInfo("/zitadel.management.v1.ManagementService/GetUserByID"), handler)
+	require.NoError(t, err)
+	_, err = interceptor(withKey("auth"), wrapperspb.String("user"), mockInfo("/zitadel.auth.v1.AuthService/UpdateMyProfile"), handler)
+	require.NoError(t, err)
+	assert.Equal(t, 6, executed)
+}
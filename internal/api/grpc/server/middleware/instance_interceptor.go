@@ -53,6 +53,7 @@ func setInstance(ctx context.Context, req interface{}, info *grpc.UnaryServerInf
 				}
 				return nil, status.Error(codes.NotFound, err.Error())
 			}
+			tracing.SetInstanceAttribute(ctx, instance.InstanceID())
 			return handler(authz.WithInstance(ctx, instance), req)
 		}
 	}
@@ -73,6 +74,7 @@ func setInstance(ctx context.Context, req interface{}, info *grpc.UnaryServerInf
 		return nil, status.Error(codes.NotFound, fmt.Sprintf("unable to set instance using origin %s (ExternalDomain is %s)", origin, externalDomain))
 	}
 	span.End()
+	tracing.SetInstanceAttribute(ctx, instance.InstanceID())
 	return handler(authz.WithInstance(ctx, instance), req)
 }
 
@@ -198,6 +198,10 @@ func (m *mockInstance) DefaultOrganisationID() string {
 	return "orgID"
 }
 
+func (m *mockInstance) BrandingOrganisationID() string {
+	return ""
+}
+
 func (m *mockInstance) RequestedDomain() string {
 	return "localhost"
 }
@@ -214,6 +218,10 @@ func (m *mockInstance) EnableImpersonation() bool {
 	return false
 }
 
+func (m *mockInstance) ActionsHTTPAllowList() []string {
+	return nil
+}
+
 func (m *mockInstance) Features() feature.Features {
 	return feature.Features{}
 }
@@ -3,6 +3,7 @@ package middleware
 import (
 	"context"
 	"strings"
+	"time"
 
 	"github.com/grpc-ecosystem/grpc-gateway/runtime"
 	"go.opentelemetry.io/otel/attribute"
@@ -22,6 +23,8 @@ const (
 	TotalGrpcRequestCounterDescription = "Total grpc request counter"
 	GrpcStatusCodeCounter              = "grpc.server.grpc_status_code"
 	GrpcStatusCodeCounterDescription   = "Grpc status code counter"
+	GrpcRequestDuration                = "grpc.server.request_duration"
+	GrpcRequestDurationDescription     = "Grpc request duration in seconds"
 )
 
 func MetricsHandler(metricTypes []metrics.MetricType, ignoredMethodSuffixes ...string) grpc.UnaryServerInterceptor {
@@ -41,7 +44,11 @@ func RegisterMetrics(ctx context.Context, req interface{}, info *grpc.UnaryServe
 		}
 	}
 
+	start := time.Now()
 	resp, err := handler(ctx, req)
+	if containsMetricsMethod(metrics.MetricTypeRequestDuration, metricTypes) {
+		RegisterGrpcRequestDuration(ctx, info, time.Since(start))
+	}
 	if containsMetricsMethod(metrics.MetricTypeRequestCount, metricTypes) {
 		RegisterGrpcRequestCounter(ctx, info)
 	}
@@ -77,6 +84,14 @@ func RegisterGrpcRequestCodeCounter(ctx context.Context, info *grpc.UnaryServerI
 	metrics.AddCount(ctx, GrpcStatusCodeCounter, 1, labels)
 }
 
+func RegisterGrpcRequestDuration(ctx context.Context, info *grpc.UnaryServerInfo, duration time.Duration) {
+	var labels = map[string]attribute.Value{
+		GrpcMethod: attribute.StringValue(info.FullMethod),
+	}
+	metrics.RegisterHistogram(GrpcRequestDuration, GrpcRequestDurationDescription, "s", metrics.DurationBuckets)
+	metrics.AddHistogramMeasurement(ctx, GrpcRequestDuration, duration.Seconds(), labels)
+}
+
 func containsMetricsMethod(metricType metrics.MetricType, metricTypes []metrics.MetricType) bool {
 	for _, m := range metricTypes {
 		if m == metricType {
@@ -0,0 +1,59 @@
+package middleware
+
+import (
+	"context"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/zitadel/zitadel/internal/api/grpc/gerrors"
+)
+
+// ServerStreamInterceptor applies the unary interceptor to server streaming calls.
+// The interceptor is called with the request of the call before the stream is handled,
+// so instance, authorization and validation checks work like for unary calls.
+// Client streaming calls are rejected, as their requests can't be checked upfront.
+func ServerStreamInterceptor(interceptor grpc.UnaryServerInterceptor) grpc.StreamServerInterceptor {
+	return func(srv interface{}, stream grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		if info.IsClientStream {
+			return status.Error(codes.Unimplemented, "client streaming is not supported")
+		}
+		err := handler(srv, &interceptedStream{
+			ServerStream: stream,
+			ctx:          stream.Context(),
+			interceptor:  interceptor,
+			info:         &grpc.UnaryServerInfo{Server: srv, FullMethod: info.FullMethod},
+		})
+		return gerrors.ZITADELToGRPCError(err)
+	}
+}
+
+// interceptedStream calls the interceptor when the request is received
+// and provides the context set by the interceptor to the handler
+type interceptedStream struct {
+	grpc.ServerStream
+	ctx         context.Context
+	interceptor grpc.UnaryServerInterceptor
+	info        *grpc.UnaryServerInfo
+	received    bool
+}
+
+func (s *interceptedStream) Context() context.Context {
+	return s.ctx
+}
+
+func (s *interceptedStream) RecvMsg(m interface{}) error {
+	if err := s.ServerStream.RecvMsg(m); err != nil {
+		return err
+	}
+	if s.received {
+		return status.Error(codes.InvalidArgument, "only one request is allowed")
+	}
+	s.received = true
+	_, err := s.interceptor(s.ctx, m, s.info, func(ctx context.Context, _ interface{}) (interface{}, error) {
+		s.ctx = ctx
+		return nil, nil
+	})
+	return err
+}
@@ -0,0 +1,78 @@
+package middleware
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type ctxKey struct{}
+
+type mockServerStream struct {
+	grpc.ServerStream
+	req string
+}
+
+func (s *mockServerStream) Context() context.Context {
+	return context.Background()
+}
+
+func (s *mockServerStream) RecvMsg(m interface{}) error {
+	*m.(*string) = s.req
+	return nil
+}
+
+func TestServerStreamInterceptor(t *testing.T) {
+	interceptor := func(ctx context.Context, req interface{}, _ *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		if *req.(*string) != "allowed" {
+			return nil, status.Error(codes.PermissionDenied, "denied")
+		}
+		return handler(context.WithValue(ctx, ctxKey{}, "checked"), req)
+	}
+	handler := func(_ interface{}, stream grpc.ServerStream) error {
+		req := new(string)
+		if err := stream.RecvMsg(req); err != nil {
+			return err
+		}
+		if stream.Context().Value(ctxKey{}) != "checked" {
+			return errors.New("context not set")
+		}
+		return nil
+	}
+	tests := []struct {
+		name     string
+		req      string
+		info     *grpc.StreamServerInfo
+		wantCode codes.Code
+	}{
+		{
+			name:     "allowed",
+			req:      "allowed",
+			info:     &grpc.StreamServerInfo{IsServerStream: true},
+			wantCode: codes.OK,
+		},
+		{
+			name:     "denied",
+			req:      "denied",
+			info:     &grpc.StreamServerInfo{IsServerStream: true},
+			wantCode: codes.PermissionDenied,
+		},
+		{
+			name:     "client stream",
+			req:      "allowed",
+			info:     &grpc.StreamServerInfo{IsClientStream: true},
+			wantCode: codes.Unimplemented,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ServerStreamInterceptor(interceptor)(nil, &mockServerStream{req: tt.req}, tt.info, handler)
+			assert.Equal(t, tt.wantCode, status.Code(err))
+		})
+	}
+}
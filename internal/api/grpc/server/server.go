@@ -11,10 +11,12 @@ import (
 	"github.com/zitadel/zitadel/internal/api/authz"
 	grpc_api "github.com/zitadel/zitadel/internal/api/grpc"
 	"github.com/zitadel/zitadel/internal/api/grpc/server/middleware"
+	"github.com/zitadel/zitadel/internal/api/idempotency"
 	"github.com/zitadel/zitadel/internal/logstore"
 	"github.com/zitadel/zitadel/internal/logstore/record"
 	"github.com/zitadel/zitadel/internal/query"
 	"github.com/zitadel/zitadel/internal/telemetry/metrics"
+	mgmt_pb "github.com/zitadel/zitadel/pkg/grpc/management"
 	system_pb "github.com/zitadel/zitadel/pkg/grpc/system"
 )
 
@@ -42,8 +44,9 @@ func CreateServer(
 	externalDomain string,
 	tlsConfig *tls.Config,
 	accessSvc *logstore.Service[*record.AccessLog],
+	idempotencyStorage idempotency.Storage,
 ) *grpc.Server {
-	metricTypes := []metrics.MetricType{metrics.MetricTypeTotalCount, metrics.MetricTypeRequestCount, metrics.MetricTypeStatusCode}
+	metricTypes := []metrics.MetricType{metrics.MetricTypeTotalCount, metrics.MetricTypeRequestCount, metrics.MetricTypeStatusCode, metrics.MetricTypeRequestDuration}
 	serverOptions := []grpc.ServerOption{
 		grpc.UnaryInterceptor(
 			grpc_middleware.ChainUnaryServer(
@@ -51,6 +54,7 @@ func CreateServer(
 				middleware.DefaultTracingServer(),
 				middleware.MetricsHandler(metricTypes, grpc_api.Probes...),
 				middleware.NoCacheInterceptor(),
+				middleware.ClientIPInterceptor(),
 				middleware.InstanceInterceptor(queries, hostHeaderName, externalDomain, system_pb.SystemService_ServiceDesc.ServiceName, healthpb.Health_ServiceDesc.ServiceName),
 				middleware.AccessStorageInterceptor(accessSvc),
 				middleware.ErrorHandler(),
@@ -60,10 +64,26 @@ func CreateServer(
 				middleware.QuotaExhaustedInterceptor(accessSvc, system_pb.SystemService_ServiceDesc.ServiceName),
 				middleware.ExecutionHandler(queries),
 				middleware.ValidationHandler(),
+				middleware.IdempotencyInterceptor(idempotencyStorage, mgmt_pb.ManagementService_ServiceDesc.ServiceName),
 				middleware.ServiceHandler(),
 				middleware.ActivityInterceptor(),
 			),
 		),
+		// server streams are checked like unary calls before the stream is handled
+		grpc.StreamInterceptor(
+			middleware.ServerStreamInterceptor(
+				grpc_middleware.ChainUnaryServer(
+					middleware.ClientIPInterceptor(),
+					middleware.InstanceInterceptor(queries, hostHeaderName, externalDomain, system_pb.SystemService_ServiceDesc.ServiceName, healthpb.Health_ServiceDesc.ServiceName),
+					middleware.ErrorHandler(),
+					middleware.LimitsInterceptor(system_pb.SystemService_ServiceDesc.ServiceName),
+					middleware.AuthorizationInterceptor(verifier, authConfig),
+					middleware.QuotaExhaustedInterceptor(accessSvc, system_pb.SystemService_ServiceDesc.ServiceName),
+					middleware.ValidationHandler(),
+					middleware.ServiceHandler(),
+				),
+			),
+		),
 	}
 	if tlsConfig != nil {
 		serverOptions = append(serverOptions, grpc.Creds(credentials.NewTLS(tlsConfig)))
@@ -110,6 +110,31 @@ func (s *Server) DeleteSession(ctx context.Context, req *session.DeleteSessionRe
 	}, nil
 }
 
+func (s *Server) ListActiveSessions(ctx context.Context, req *session.ListActiveSessionsRequest) (*session.ListActiveSessionsResponse, error) {
+	userID := req.GetUserId()
+	if userID == "" {
+		userID = authz.GetCtxData(ctx).UserID
+	}
+	sessions, err := s.query.SearchActiveUserSessions(ctx, userID, listActiveSessionsRequestToQuery(req))
+	if err != nil {
+		return nil, err
+	}
+	return &session.ListActiveSessionsResponse{
+		Details:  object.ToListDetails(sessions.SearchResponse),
+		Sessions: sessionsToPb(sessions.Sessions),
+	}, nil
+}
+
+func (s *Server) TerminateAllOtherSessions(ctx context.Context, req *session.TerminateAllOtherSessionsRequest) (*session.TerminateAllOtherSessionsResponse, error) {
+	details, err := s.command.TerminateAllOtherSessions(ctx, req.GetSessionId(), req.GetSessionToken())
+	if err != nil {
+		return nil, err
+	}
+	return &session.TerminateAllOtherSessionsResponse{
+		Details: object.DomainToDetailsPb(details),
+	}, nil
+}
+
 func sessionsToPb(sessions []*query.Session) []*session.Session {
 	s := make([]*session.Session, len(sessions))
 	for i, session := range sessions {
@@ -128,6 +153,17 @@ func sessionToPb(s *query.Session) *session.Session {
 		Metadata:       s.Metadata,
 		UserAgent:      userAgentToPb(s.UserAgent),
 		ExpirationDate: expirationToPb(s.Expiration),
+		GeoLocation:    geoLocationToPb(s.UserAgent.GeoLocation()),
+	}
+}
+
+func geoLocationToPb(location *domain.GeoLocation) *session.GeoLocation {
+	if location == nil {
+		return nil
+	}
+	return &session.GeoLocation{
+		CountryCode: location.CountryCode,
+		City:        location.City,
 	}
 }
 
@@ -254,6 +290,18 @@ func listSessionsRequestToQuery(ctx context.Context, req *session.ListSessionsRe
 	}, nil
 }
 
+func listActiveSessionsRequestToQuery(req *session.ListActiveSessionsRequest) *query.SessionsSearchQueries {
+	offset, limit, asc := object.ListQueryToQuery(req.Query)
+	return &query.SessionsSearchQueries{
+		SearchRequest: query.SearchRequest{
+			Offset:        offset,
+			Limit:         limit,
+			Asc:           asc,
+			SortingColumn: fieldNameToSessionColumn(req.GetSortingColumn()),
+		},
+	}
+}
+
 func sessionQueriesToQuery(ctx context.Context, queries []*session.SearchQuery) (_ []query.SearchQuery, err error) {
 	q := make([]query.SearchQuery, len(queries)+1)
 	for i, v := range queries {
@@ -340,7 +388,7 @@ func (s *Server) checksToCommand(ctx context.Context, checks *session.Checks) ([
 	if err != nil {
 		return nil, err
 	}
-	sessionChecks := make([]command.SessionCommand, 0, 7)
+	sessionChecks := make([]command.SessionCommand, 0, 8)
 	if checkUser != nil {
 		user, err := checkUser.search(ctx, s.query)
 		if err != nil {
@@ -356,6 +404,10 @@ func (s *Server) checksToCommand(ctx context.Context, checks *session.Checks) ([
 		}
 		sessionChecks = append(sessionChecks, command.CheckUser(user.ID, user.ResourceOwner, preferredLanguage))
 	}
+	// the captcha response must be set before the checks requiring it are executed
+	if captcha := checks.GetCaptcha(); captcha != nil {
+		sessionChecks = append(sessionChecks, command.CheckCaptcha(captcha.GetResponse(), captcha.GetRemoteIp()))
+	}
 	if password := checks.GetPassword(); password != nil {
 		sessionChecks = append(sessionChecks, command.CheckPassword(password.GetPassword()))
 	}
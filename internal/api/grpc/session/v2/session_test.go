@@ -446,6 +446,69 @@ func Test_listSessionsRequestToQuery(t *testing.T) {
 	}
 }
 
+func Test_listActiveSessionsRequestToQuery(t *testing.T) {
+	tests := []struct {
+		name string
+		req  *session.ListActiveSessionsRequest
+		want *query.SessionsSearchQueries
+	}{
+		{
+			name: "default request",
+			req:  &session.ListActiveSessionsRequest{},
+			want: &query.SessionsSearchQueries{},
+		},
+		{
+			name: "with list query and sorting column",
+			req: &session.ListActiveSessionsRequest{
+				UserId: gu.Ptr("userID"),
+				Query: &object.ListQuery{
+					Offset: 10,
+					Limit:  20,
+					Asc:    true,
+				},
+				SortingColumn: session.SessionFieldName_SESSION_FIELD_NAME_CREATION_DATE,
+			},
+			want: &query.SessionsSearchQueries{
+				SearchRequest: query.SearchRequest{
+					Offset:        10,
+					Limit:         20,
+					SortingColumn: query.SessionColumnCreationDate,
+					Asc:           true,
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := listActiveSessionsRequestToQuery(tt.req)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func Test_geoLocationToPb(t *testing.T) {
+	tests := []struct {
+		name     string
+		location *domain.GeoLocation
+		want     *session.GeoLocation
+	}{
+		{
+			name: "nil",
+		},
+		{
+			name:     "location",
+			location: &domain.GeoLocation{CountryCode: "CH", City: "Zurich"},
+			want:     &session.GeoLocation{CountryCode: "CH", City: "Zurich"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := geoLocationToPb(tt.location)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
 func Test_sessionQueriesToQuery(t *testing.T) {
 	type args struct {
 		ctx     context.Context
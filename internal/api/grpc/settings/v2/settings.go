@@ -119,6 +119,26 @@ func (s *Server) GetLockoutSettings(ctx context.Context, req *settings.GetLockou
 	}, nil
 }
 
+func (s *Server) GetCaptchaSettings(ctx context.Context, req *settings.GetCaptchaSettingsRequest) (*settings.GetCaptchaSettingsResponse, error) {
+	current, err := s.query.CaptchaPolicyByOrg(ctx, object.ResourceOwnerFromReq(ctx, req.GetCtx()))
+	if err != nil {
+		return nil, err
+	}
+	if current == nil {
+		return &settings.GetCaptchaSettingsResponse{
+			Settings: &settings.CaptchaSettings{},
+		}, nil
+	}
+	return &settings.GetCaptchaSettingsResponse{
+		Settings: captchaSettingsToPb(current),
+		Details: &object_pb.Details{
+			Sequence:      current.Sequence,
+			ChangeDate:    timestamppb.New(current.ChangeDate),
+			ResourceOwner: current.ResourceOwner,
+		},
+	}, nil
+}
+
 func (s *Server) GetActiveIdentityProviders(ctx context.Context, req *settings.GetActiveIdentityProvidersRequest) (*settings.GetActiveIdentityProvidersResponse, error) {
 	links, err := s.query.IDPLoginPolicyLinks(ctx, object.ResourceOwnerFromReq(ctx, req.GetCtx()), &query.IDPLoginPolicyLinksSearchQuery{}, false)
 	if err != nil {
@@ -22,26 +22,32 @@ func loginSettingsToPb(current *query.LoginPolicy) *settings.LoginSettings {
 	}
 
 	return &settings.LoginSettings{
-		AllowUsernamePassword:      current.AllowUsernamePassword,
-		AllowRegister:              current.AllowRegister,
-		AllowExternalIdp:           current.AllowExternalIDPs,
-		ForceMfa:                   current.ForceMFA,
-		ForceMfaLocalOnly:          current.ForceMFALocalOnly,
-		PasskeysType:               passkeysTypeToPb(current.PasswordlessType),
-		HidePasswordReset:          current.HidePasswordReset,
-		IgnoreUnknownUsernames:     current.IgnoreUnknownUsernames,
-		AllowDomainDiscovery:       current.AllowDomainDiscovery,
-		DisableLoginWithEmail:      current.DisableLoginWithEmail,
-		DisableLoginWithPhone:      current.DisableLoginWithPhone,
-		DefaultRedirectUri:         current.DefaultRedirectURI,
-		PasswordCheckLifetime:      durationpb.New(time.Duration(current.PasswordCheckLifetime)),
-		ExternalLoginCheckLifetime: durationpb.New(time.Duration(current.ExternalLoginCheckLifetime)),
-		MfaInitSkipLifetime:        durationpb.New(time.Duration(current.MFAInitSkipLifetime)),
-		SecondFactorCheckLifetime:  durationpb.New(time.Duration(current.SecondFactorCheckLifetime)),
-		MultiFactorCheckLifetime:   durationpb.New(time.Duration(current.MultiFactorCheckLifetime)),
-		SecondFactors:              second,
-		MultiFactors:               multi,
-		ResourceOwnerType:          isDefaultToResourceOwnerTypePb(current.IsDefault),
+		AllowUsernamePassword:       current.AllowUsernamePassword,
+		AllowRegister:               current.AllowRegister,
+		AllowExternalIdp:            current.AllowExternalIDPs,
+		ForceMfa:                    current.ForceMFA,
+		ForceMfaLocalOnly:           current.ForceMFALocalOnly,
+		PasskeysType:                passkeysTypeToPb(current.PasswordlessType),
+		HidePasswordReset:           current.HidePasswordReset,
+		IgnoreUnknownUsernames:      current.IgnoreUnknownUsernames,
+		AllowDomainDiscovery:        current.AllowDomainDiscovery,
+		DisableLoginWithEmail:       current.DisableLoginWithEmail,
+		DisableLoginWithPhone:       current.DisableLoginWithPhone,
+		DefaultRedirectUri:          current.DefaultRedirectURI,
+		PasswordCheckLifetime:       durationpb.New(time.Duration(current.PasswordCheckLifetime)),
+		ExternalLoginCheckLifetime:  durationpb.New(time.Duration(current.ExternalLoginCheckLifetime)),
+		MfaInitSkipLifetime:         durationpb.New(time.Duration(current.MFAInitSkipLifetime)),
+		SecondFactorCheckLifetime:   durationpb.New(time.Duration(current.SecondFactorCheckLifetime)),
+		MultiFactorCheckLifetime:    durationpb.New(time.Duration(current.MultiFactorCheckLifetime)),
+		TrustedDeviceLifetime:       durationpb.New(time.Duration(current.TrustedDeviceLifetime)),
+		SharedDevice:                current.SharedDevice,
+		SharedDeviceLifetime:        durationpb.New(time.Duration(current.SharedDeviceLifetime)),
+		SharedDeviceIdleTimeout:     durationpb.New(time.Duration(current.SharedDeviceIdleTimeout)),
+		PasskeyPrompt:               current.PasskeyPrompt,
+		PasskeyPromptSnoozeLifetime: durationpb.New(time.Duration(current.PasskeyPromptSnoozeLifetime)),
+		SecondFactors:               second,
+		MultiFactors:                multi,
+		ResourceOwnerType:           isDefaultToResourceOwnerTypePb(current.IsDefault),
 	}
 }
 
@@ -176,6 +182,33 @@ func lockoutSettingsToPb(current *query.LockoutPolicy) *settings.LockoutSettings
 	}
 }
 
+func captchaSettingsToPb(current *domain.CaptchaPolicy) *settings.CaptchaSettings {
+	return &settings.CaptchaSettings{
+		Type:                   captchaTypeToPb(current.Type),
+		SiteKey:                current.SiteKey,
+		EnabledOnRegister:      current.EnabledOnRegister,
+		EnabledOnPasswordReset: current.EnabledOnPasswordReset,
+		EnabledOnPasswordless:  current.EnabledOnPasswordless,
+		EnabledOnSession:       current.EnabledOnSession,
+		ResourceOwnerType:      isDefaultToResourceOwnerTypePb(current.Default),
+	}
+}
+
+func captchaTypeToPb(captchaType domain.CaptchaType) settings.CaptchaType {
+	switch captchaType {
+	case domain.CaptchaTypeHCaptcha:
+		return settings.CaptchaType_CAPTCHA_TYPE_HCAPTCHA
+	case domain.CaptchaTypeReCaptcha:
+		return settings.CaptchaType_CAPTCHA_TYPE_RECAPTCHA
+	case domain.CaptchaTypeTurnstile:
+		return settings.CaptchaType_CAPTCHA_TYPE_TURNSTILE
+	case domain.CaptchaTypeUnspecified:
+		return settings.CaptchaType_CAPTCHA_TYPE_UNSPECIFIED
+	default:
+		return settings.CaptchaType_CAPTCHA_TYPE_UNSPECIFIED
+	}
+}
+
 func identityProvidersToPb(idps []*query.IDPLoginPolicyLink) []*settings.IdentityProvider {
 	providers := make([]*settings.IdentityProvider, len(idps))
 	for i, idp := range idps {
@@ -229,7 +262,8 @@ func securityPolicyToSettingsPb(policy *query.SecurityPolicy) *settings.Security
 			Enabled:        policy.EnableIframeEmbedding,
 			AllowedOrigins: policy.AllowedOrigins,
 		},
-		EnableImpersonation: policy.EnableImpersonation,
+		EnableImpersonation:  policy.EnableImpersonation,
+		ActionsHttpAllowList: policy.ActionsHTTPAllowList,
 	}
 }
 
@@ -238,5 +272,6 @@ func securitySettingsToCommand(req *settings.SetSecuritySettingsRequest) *comman
 		EnableIframeEmbedding: req.GetEmbeddedIframe().GetEnabled(),
 		AllowedOrigins:        req.GetEmbeddedIframe().GetAllowedOrigins(),
 		EnableImpersonation:   req.GetEnableImpersonation(),
+		ActionsHTTPAllowList:  req.GetActionsHttpAllowList(),
 	}
 }
@@ -23,23 +23,29 @@ var ignoreTypes = []protoreflect.FullName{"google.protobuf.Duration"}
 
 func Test_loginSettingsToPb(t *testing.T) {
 	arg := &query.LoginPolicy{
-		AllowUsernamePassword:      true,
-		AllowRegister:              true,
-		AllowExternalIDPs:          true,
-		ForceMFA:                   true,
-		ForceMFALocalOnly:          true,
-		PasswordlessType:           domain.PasswordlessTypeAllowed,
-		HidePasswordReset:          true,
-		IgnoreUnknownUsernames:     true,
-		AllowDomainDiscovery:       true,
-		DisableLoginWithEmail:      true,
-		DisableLoginWithPhone:      true,
-		DefaultRedirectURI:         "example.com",
-		PasswordCheckLifetime:      database.Duration(time.Hour),
-		ExternalLoginCheckLifetime: database.Duration(time.Minute),
-		MFAInitSkipLifetime:        database.Duration(time.Millisecond),
-		SecondFactorCheckLifetime:  database.Duration(time.Microsecond),
-		MultiFactorCheckLifetime:   database.Duration(time.Nanosecond),
+		AllowUsernamePassword:       true,
+		AllowRegister:               true,
+		AllowExternalIDPs:           true,
+		ForceMFA:                    true,
+		ForceMFALocalOnly:           true,
+		PasswordlessType:            domain.PasswordlessTypeAllowed,
+		HidePasswordReset:           true,
+		IgnoreUnknownUsernames:      true,
+		AllowDomainDiscovery:        true,
+		DisableLoginWithEmail:       true,
+		DisableLoginWithPhone:       true,
+		DefaultRedirectURI:          "example.com",
+		PasswordCheckLifetime:       database.Duration(time.Hour),
+		ExternalLoginCheckLifetime:  database.Duration(time.Minute),
+		MFAInitSkipLifetime:         database.Duration(time.Millisecond),
+		SecondFactorCheckLifetime:   database.Duration(time.Microsecond),
+		MultiFactorCheckLifetime:    database.Duration(time.Nanosecond),
+		TrustedDeviceLifetime:       database.Duration(time.Nanosecond),
+		SharedDevice:                true,
+		SharedDeviceLifetime:        database.Duration(time.Hour),
+		SharedDeviceIdleTimeout:     database.Duration(time.Minute),
+		PasskeyPrompt:               true,
+		PasskeyPromptSnoozeLifetime: database.Duration(time.Hour),
 		SecondFactors: []domain.SecondFactorType{
 			domain.SecondFactorTypeTOTP,
 			domain.SecondFactorTypeU2F,
@@ -53,23 +59,29 @@ func Test_loginSettingsToPb(t *testing.T) {
 	}
 
 	want := &settings.LoginSettings{
-		AllowUsernamePassword:      true,
-		AllowRegister:              true,
-		AllowExternalIdp:           true,
-		ForceMfa:                   true,
-		ForceMfaLocalOnly:          true,
-		PasskeysType:               settings.PasskeysType_PASSKEYS_TYPE_ALLOWED,
-		HidePasswordReset:          true,
-		IgnoreUnknownUsernames:     true,
-		AllowDomainDiscovery:       true,
-		DisableLoginWithEmail:      true,
-		DisableLoginWithPhone:      true,
-		DefaultRedirectUri:         "example.com",
-		PasswordCheckLifetime:      durationpb.New(time.Hour),
-		ExternalLoginCheckLifetime: durationpb.New(time.Minute),
-		MfaInitSkipLifetime:        durationpb.New(time.Millisecond),
-		SecondFactorCheckLifetime:  durationpb.New(time.Microsecond),
-		MultiFactorCheckLifetime:   durationpb.New(time.Nanosecond),
+		AllowUsernamePassword:       true,
+		AllowRegister:               true,
+		AllowExternalIdp:            true,
+		ForceMfa:                    true,
+		ForceMfaLocalOnly:           true,
+		PasskeysType:                settings.PasskeysType_PASSKEYS_TYPE_ALLOWED,
+		HidePasswordReset:           true,
+		IgnoreUnknownUsernames:      true,
+		AllowDomainDiscovery:        true,
+		DisableLoginWithEmail:       true,
+		DisableLoginWithPhone:       true,
+		DefaultRedirectUri:          "example.com",
+		PasswordCheckLifetime:       durationpb.New(time.Hour),
+		ExternalLoginCheckLifetime:  durationpb.New(time.Minute),
+		MfaInitSkipLifetime:         durationpb.New(time.Millisecond),
+		SecondFactorCheckLifetime:   durationpb.New(time.Microsecond),
+		MultiFactorCheckLifetime:    durationpb.New(time.Nanosecond),
+		TrustedDeviceLifetime:       durationpb.New(time.Nanosecond),
+		SharedDevice:                true,
+		SharedDeviceLifetime:        durationpb.New(time.Hour),
+		SharedDeviceIdleTimeout:     durationpb.New(time.Minute),
+		PasskeyPrompt:               true,
+		PasskeyPromptSnoozeLifetime: durationpb.New(time.Hour),
 		SecondFactors: []settings.SecondFactorType{
 			settings.SecondFactorType_SECOND_FACTOR_TYPE_OTP,
 			settings.SecondFactorType_SECOND_FACTOR_TYPE_U2F,
@@ -379,6 +391,32 @@ func Test_lockoutSettingsToPb(t *testing.T) {
 	}
 }
 
+func Test_captchaSettingsToPb(t *testing.T) {
+	arg := &domain.CaptchaPolicy{
+		Type:                   domain.CaptchaTypeTurnstile,
+		SiteKey:                "siteKey",
+		EnabledOnRegister:      true,
+		EnabledOnPasswordReset: true,
+		EnabledOnPasswordless:  true,
+		EnabledOnSession:       true,
+		Default:                true,
+	}
+	want := &settings.CaptchaSettings{
+		Type:                   settings.CaptchaType_CAPTCHA_TYPE_TURNSTILE,
+		SiteKey:                "siteKey",
+		EnabledOnRegister:      true,
+		EnabledOnPasswordReset: true,
+		EnabledOnPasswordless:  true,
+		EnabledOnSession:       true,
+		ResourceOwnerType:      settings.ResourceOwnerType_RESOURCE_OWNER_TYPE_INSTANCE,
+	}
+	got := captchaSettingsToPb(arg)
+	grpc.AllFieldsSet(t, got.ProtoReflect(), ignoreTypes...)
+	if !proto.Equal(got, want) {
+		t.Errorf("captchaSettingsToPb() =\n%v\nwant\n%v", got, want)
+	}
+}
+
 func Test_identityProvidersToPb(t *testing.T) {
 	arg := []*query.IDPLoginPolicyLink{
 		{
@@ -490,12 +528,14 @@ func Test_securityPolicyToSettingsPb(t *testing.T) {
 			Enabled:        true,
 			AllowedOrigins: []string{"foo", "bar"},
 		},
-		EnableImpersonation: true,
+		EnableImpersonation:  true,
+		ActionsHttpAllowList: []string{"*.example.com"},
 	}
 	got := securityPolicyToSettingsPb(&query.SecurityPolicy{
 		EnableIframeEmbedding: true,
 		AllowedOrigins:        []string{"foo", "bar"},
 		EnableImpersonation:   true,
+		ActionsHTTPAllowList:  []string{"*.example.com"},
 	})
 	assert.Equal(t, want, got)
 }
@@ -505,13 +545,15 @@ func Test_securitySettingsToCommand(t *testing.T) {
 		EnableIframeEmbedding: true,
 		AllowedOrigins:        []string{"foo", "bar"},
 		EnableImpersonation:   true,
+		ActionsHTTPAllowList:  []string{"*.example.com"},
 	}
 	got := securitySettingsToCommand(&settings.SetSecuritySettingsRequest{
 		EmbeddedIframe: &settings.EmbeddedIframeSettings{
 			Enabled:        true,
 			AllowedOrigins: []string{"foo", "bar"},
 		},
-		EnableImpersonation: true,
+		EnableImpersonation:  true,
+		ActionsHttpAllowList: []string{"*.example.com"},
 	})
 	assert.Equal(t, want, got)
 }
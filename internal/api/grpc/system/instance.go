@@ -185,3 +185,35 @@ func (s *Server) SetPrimaryDomain(ctx context.Context, req *system_pb.SetPrimary
 		Details: object.ChangeToDetailsPb(details.Sequence, details.EventDate, details.ResourceOwner),
 	}, nil
 }
+
+func (s *Server) GetDomainSettings(ctx context.Context, req *system_pb.GetDomainSettingsRequest) (*system_pb.GetDomainSettingsResponse, error) {
+	settings, err := s.query.InstanceDomainSettingsByDomain(ctx, req.Domain)
+	if err != nil {
+		return nil, err
+	}
+	return InstanceDomainSettingsToPb(settings), nil
+}
+
+func (s *Server) SetDomainSettings(ctx context.Context, req *system_pb.SetDomainSettingsRequest) (*system_pb.SetDomainSettingsResponse, error) {
+	settings, err := SetDomainSettingsRequestToCommand(req)
+	if err != nil {
+		return nil, err
+	}
+	details, err := s.command.SetInstanceDomainSettings(ctx, req.Domain, settings)
+	if err != nil {
+		return nil, err
+	}
+	return &system_pb.SetDomainSettingsResponse{
+		Details: object.ChangeToDetailsPb(details.Sequence, details.EventDate, details.ResourceOwner),
+	}, nil
+}
+
+func (s *Server) RemoveDomainSettings(ctx context.Context, req *system_pb.RemoveDomainSettingsRequest) (*system_pb.RemoveDomainSettingsResponse, error) {
+	details, err := s.command.RemoveInstanceDomainSettings(ctx, req.Domain)
+	if err != nil {
+		return nil, err
+	}
+	return &system_pb.RemoveDomainSettingsResponse{
+		Details: object.ChangeToDetailsPb(details.Sequence, details.EventDate, details.ResourceOwner),
+	}, nil
+}
@@ -262,6 +262,31 @@ func fieldNameToInstanceDomainColumn(fieldName instance_pb.DomainFieldName) quer
 	}
 }
 
+func SetDomainSettingsRequestToCommand(req *system_pb.SetDomainSettingsRequest) (*command.InstanceDomainSettings, error) {
+	settings := &command.InstanceDomainSettings{
+		BrandingOrgID: req.BrandingOrgId,
+	}
+	if req.DefaultLanguage != "" {
+		lang, err := domain.ParseLanguage(req.DefaultLanguage)
+		if err != nil {
+			return nil, err
+		}
+		settings.DefaultLanguage = lang[0]
+	}
+	return settings, nil
+}
+
+func InstanceDomainSettingsToPb(settings *query.InstanceDomainSettings) *system_pb.GetDomainSettingsResponse {
+	resp := &system_pb.GetDomainSettingsResponse{
+		Details:       object.ToViewDetailsPb(settings.Sequence, settings.CreationDate, settings.ChangeDate, settings.InstanceID),
+		BrandingOrgId: settings.BrandingOrgID,
+	}
+	if !settings.DefaultLanguage.IsRoot() {
+		resp.DefaultLanguage = settings.DefaultLanguage.String()
+	}
+	return resp
+}
+
 func ListIAMMembersRequestToQuery(req *system_pb.ListIAMMembersRequest) (*query.IAMMembersQuery, error) {
 	offset, limit, asc := object.ListQueryToModel(req.Query)
 	queries, err := member_grpc.MemberQueriesToQuery(req.Queries)
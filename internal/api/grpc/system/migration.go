@@ -0,0 +1,22 @@
+package system
+
+import (
+	"context"
+
+	system_pb "github.com/zitadel/zitadel/pkg/grpc/system"
+)
+
+func (s *Server) ListMigrationSteps(ctx context.Context, _ *system_pb.ListMigrationStepsRequest) (*system_pb.ListMigrationStepsResponse, error) {
+	steps, err := s.query.MigrationSteps(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &system_pb.ListMigrationStepsResponse{Result: MigrationStepsToPb(steps)}, nil
+}
+
+func (s *Server) RetryMigrationStep(ctx context.Context, req *system_pb.RetryMigrationStepRequest) (*system_pb.RetryMigrationStepResponse, error) {
+	if err := s.command.RetryMigrationStep(ctx, req.Name); err != nil {
+		return nil, err
+	}
+	return &system_pb.RetryMigrationStepResponse{}, nil
+}
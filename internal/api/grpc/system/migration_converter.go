@@ -0,0 +1,42 @@
+package system
+
+import (
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/zitadel/zitadel/internal/migration"
+	system_pb "github.com/zitadel/zitadel/pkg/grpc/system"
+)
+
+func MigrationStepsToPb(steps []*migration.Step) []*system_pb.MigrationStep {
+	pbSteps := make([]*system_pb.MigrationStep, len(steps))
+	for i, step := range steps {
+		pbSteps[i] = MigrationStepToPb(step)
+	}
+	return pbSteps
+}
+
+func MigrationStepToPb(step *migration.Step) *system_pb.MigrationStep {
+	pbStep := &system_pb.MigrationStep{
+		Name:        step.Name,
+		State:       migrationStepStateToPb(step.State()),
+		ChangeDate:  timestamppb.New(step.CreatedAt()),
+		HasProgress: len(step.Progress) > 0,
+	}
+	if stepErr, ok := step.Error.(string); ok && step.State() == migration.StepFailed {
+		pbStep.Error = stepErr
+	}
+	return pbStep
+}
+
+func migrationStepStateToPb(state migration.StepState) system_pb.MigrationStep_State {
+	switch state {
+	case migration.StepStarted:
+		return system_pb.MigrationStep_STATE_STARTED
+	case migration.StepDone:
+		return system_pb.MigrationStep_STATE_DONE
+	case migration.StepFailed:
+		return system_pb.MigrationStep_STATE_FAILED
+	default:
+		return system_pb.MigrationStep_STATE_UNSPECIFIED
+	}
+}
@@ -2,6 +2,7 @@ package system
 
 import (
 	"context"
+	"time"
 
 	"github.com/zitadel/zitadel/internal/api/grpc/object"
 	"github.com/zitadel/zitadel/pkg/grpc/system"
@@ -42,3 +43,15 @@ func (s *Server) RemoveQuota(ctx context.Context, req *system.RemoveQuotaRequest
 		Details: object.ChangeToDetailsPb(details.Sequence, details.EventDate, details.ResourceOwner),
 	}, nil
 }
+
+func (s *Server) GetInstanceUsage(ctx context.Context, req *system.GetInstanceUsageRequest) (*system.GetInstanceUsageResponse, error) {
+	period := time.Now()
+	if req.GetPeriod() != nil {
+		period = req.GetPeriod().AsTime()
+	}
+	usage, err := s.query.InstanceUsage(ctx, req.GetInstanceId(), period)
+	if err != nil {
+		return nil, err
+	}
+	return instanceUsageToPb(usage), nil
+}
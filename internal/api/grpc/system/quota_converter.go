@@ -5,7 +5,9 @@ import (
 	"google.golang.org/protobuf/types/known/timestamppb"
 
 	"github.com/zitadel/zitadel/internal/command"
+	"github.com/zitadel/zitadel/internal/query"
 	"github.com/zitadel/zitadel/pkg/grpc/quota"
+	"github.com/zitadel/zitadel/pkg/grpc/system"
 )
 
 type setQuotaRequest interface {
@@ -52,3 +54,13 @@ func instanceQuotaNotificationsPbToCommand(req []*quota.Notification) command.Qu
 	}
 	return notifications
 }
+
+func instanceUsageToPb(usage *query.InstanceUsage) *system.GetInstanceUsageResponse {
+	return &system.GetInstanceUsageResponse{
+		PeriodStart:      timestamppb.New(usage.PeriodStart),
+		Authentications:  usage.Authentications,
+		ActiveUsers:      usage.ActiveUsers,
+		Requests:         usage.Requests,
+		ActionRunSeconds: usage.ActionRunSeconds,
+	}
+}
@@ -60,6 +60,10 @@ func CustomLoginTextToPb(text *domain.CustomLoginText) *text_pb.LoginCustomText
 		PasswordChangeText:                   PasswordChangeScreenTextToPb(text.PasswordChange),
 		PasswordChangeDoneText:               PasswordChangeDoneScreenTextToPb(text.PasswordChangeDone),
 		PasswordResetDoneText:                PasswordResetDoneScreenTextToPb(text.PasswordResetDone),
+		UsernameRecoveryText:                 UsernameRecoveryScreenTextToPb(text.UsernameRecovery),
+		UsernameRecoveryDoneText:             UsernameRecoveryDoneScreenTextToPb(text.UsernameRecoveryDone),
+		PasskeyPromptText:                    PasskeyPromptScreenTextToPb(text.PasskeyPrompt),
+		LinkedIdpsText:                       LinkedIDPsScreenTextToPb(text.LinkedIDPs),
 		RegistrationOptionText:               RegistrationOptionScreenTextToPb(text.RegisterOption),
 		RegistrationUserText:                 RegistrationUserScreenTextToPb(text.RegistrationUser),
 		ExternalRegistrationUserOverviewText: ExternalRegistrationUserOverviewScreenTextToPb(text.ExternalRegistrationUserOverview),
@@ -69,6 +73,7 @@ func CustomLoginTextToPb(text *domain.CustomLoginText) *text_pb.LoginCustomText
 		ExternalUserNotFoundText:             ExternalUserNotFoundScreenTextToPb(text.ExternalNotFound),
 		SuccessLoginText:                     SuccessLoginScreenTextToPb(text.LoginSuccess),
 		LogoutText:                           LogoutDoneScreenTextToPb(text.LogoutDone),
+		LogoutConfirmationText:               LogoutConfirmationScreenTextToPb(text.LogoutConfirmation),
 		FooterText:                           FooterTextToPb(text.Footer),
 	}
 }
@@ -83,6 +88,7 @@ func SelectAccountScreenToPb(text domain.SelectAccountScreenText) *text_pb.Selec
 		SessionStateActive:        text.SessionState0,
 		SessionStateInactive:      text.SessionState1,
 		UserMustBeMemberOfOrg:     text.MustBeMemberOfOrg,
+		ForgotUsernameLinkText:    text.ForgotUsernameLinkText,
 	}
 }
 
@@ -108,6 +114,7 @@ func PasswordScreenTextToPb(text domain.PasswordScreenText) *text_pb.PasswordScr
 		Description:    text.Description,
 		PasswordLabel:  text.PasswordLabel,
 		ResetLinkText:  text.ResetLinkText,
+		CaptchaLabel:   text.CaptchaLabel,
 		BackButtonText: text.BackButtonText,
 		NextButtonText: text.NextButtonText,
 		MinLength:      text.MinLength,
@@ -277,6 +284,7 @@ func PasswordlessScreenTextToPb(text domain.PasswordlessScreenText) *text_pb.Pas
 		ValidateTokenButtonText: text.ValidateTokenButtonText,
 		NotSupported:            text.NotSupported,
 		ErrorRetry:              text.ErrorRetry,
+		CaptchaLabel:            text.CaptchaLabel,
 	}
 }
 
@@ -341,6 +349,35 @@ func PasswordResetDoneScreenTextToPb(text domain.PasswordResetDoneScreenText) *t
 	}
 }
 
+func UsernameRecoveryScreenTextToPb(text domain.UsernameRecoveryScreenText) *text_pb.UsernameRecoveryScreenText {
+	return &text_pb.UsernameRecoveryScreenText{
+		Title:             text.Title,
+		Description:       text.Description,
+		EmailOrPhoneLabel: text.EmailOrPhoneLabel,
+		CaptchaLabel:      text.CaptchaLabel,
+		BackButtonText:    text.BackButtonText,
+		NextButtonText:    text.NextButtonText,
+	}
+}
+
+func UsernameRecoveryDoneScreenTextToPb(text domain.UsernameRecoveryDoneScreenText) *text_pb.UsernameRecoveryDoneScreenText {
+	return &text_pb.UsernameRecoveryDoneScreenText{
+		Title:          text.Title,
+		Description:    text.Description,
+		NextButtonText: text.NextButtonText,
+	}
+}
+
+func PasskeyPromptScreenTextToPb(text domain.PasskeyPromptScreenText) *text_pb.PasskeyPromptScreenText {
+	return &text_pb.PasskeyPromptScreenText{
+		Title:              text.Title,
+		Description:        text.Description,
+		RegisterButtonText: text.RegisterButtonText,
+		SnoozeButtonText:   text.SnoozeButtonText,
+		OptOutButtonText:   text.OptOutButtonText,
+	}
+}
+
 func RegistrationOptionScreenTextToPb(text domain.RegistrationOptionScreenText) *text_pb.RegistrationOptionScreenText {
 	return &text_pb.RegistrationOptionScreenText{
 		Title:                    text.Title,
@@ -371,6 +408,7 @@ func RegistrationUserScreenTextToPb(text domain.RegistrationUserScreenText) *tex
 		PrivacyLinkText:        text.PrivacyLinkText,
 		NextButtonText:         text.NextButtonText,
 		BackButtonText:         text.BackButtonText,
+		CaptchaLabel:           text.CaptchaLabel,
 	}
 }
 
@@ -464,6 +502,15 @@ func LogoutDoneScreenTextToPb(text domain.LogoutDoneScreenText) *text_pb.LogoutD
 	}
 }
 
+func LogoutConfirmationScreenTextToPb(text domain.LogoutConfirmationScreenText) *text_pb.LogoutConfirmationScreenText {
+	return &text_pb.LogoutConfirmationScreenText{
+		Title:             text.Title,
+		Description:       text.Description,
+		ConfirmButtonText: text.ConfirmButtonText,
+		CancelButtonText:  text.CancelButtonText,
+	}
+}
+
 func FooterTextToPb(text domain.FooterText) *text_pb.FooterText {
 	return &text_pb.FooterText{
 		Tos:           text.TOS,
@@ -505,6 +552,7 @@ func LoginScreenTextPbToDomain(text *text_pb.LoginScreenText) domain.LoginScreen
 		NextButtonText:          text.NextButtonText,
 		ExternalUserDescription: text.ExternalUserDescription,
 		MustBeMemberOfOrg:       text.UserMustBeMemberOfOrg,
+		ForgotUsernameLinkText:  text.ForgotUsernameLinkText,
 	}
 }
 
@@ -517,6 +565,7 @@ func PasswordScreenTextPbToDomain(text *text_pb.PasswordScreenText) domain.Passw
 		Description:    text.Description,
 		PasswordLabel:  text.PasswordLabel,
 		ResetLinkText:  text.ResetLinkText,
+		CaptchaLabel:   text.CaptchaLabel,
 		BackButtonText: text.BackButtonText,
 		NextButtonText: text.NextButtonText,
 		MinLength:      text.MinLength,
@@ -734,6 +783,7 @@ func PasswordlessScreenTextPbToDomain(text *text_pb.PasswordlessScreenText) doma
 		ValidateTokenButtonText: text.ValidateTokenButtonText,
 		NotSupported:            text.NotSupported,
 		ErrorRetry:              text.ErrorRetry,
+		CaptchaLabel:            text.CaptchaLabel,
 	}
 }
 
@@ -816,6 +866,67 @@ func PasswordResetDoneScreenTextPbToDomain(text *text_pb.PasswordResetDoneScreen
 	}
 }
 
+func UsernameRecoveryScreenTextPbToDomain(text *text_pb.UsernameRecoveryScreenText) domain.UsernameRecoveryScreenText {
+	if text == nil {
+		return domain.UsernameRecoveryScreenText{}
+	}
+	return domain.UsernameRecoveryScreenText{
+		Title:             text.Title,
+		Description:       text.Description,
+		EmailOrPhoneLabel: text.EmailOrPhoneLabel,
+		CaptchaLabel:      text.CaptchaLabel,
+		BackButtonText:    text.BackButtonText,
+		NextButtonText:    text.NextButtonText,
+	}
+}
+
+func UsernameRecoveryDoneScreenTextPbToDomain(text *text_pb.UsernameRecoveryDoneScreenText) domain.UsernameRecoveryDoneScreenText {
+	if text == nil {
+		return domain.UsernameRecoveryDoneScreenText{}
+	}
+	return domain.UsernameRecoveryDoneScreenText{
+		Title:          text.Title,
+		Description:    text.Description,
+		NextButtonText: text.NextButtonText,
+	}
+}
+
+func PasskeyPromptScreenTextPbToDomain(text *text_pb.PasskeyPromptScreenText) domain.PasskeyPromptScreenText {
+	if text == nil {
+		return domain.PasskeyPromptScreenText{}
+	}
+	return domain.PasskeyPromptScreenText{
+		Title:              text.Title,
+		Description:        text.Description,
+		RegisterButtonText: text.RegisterButtonText,
+		SnoozeButtonText:   text.SnoozeButtonText,
+		OptOutButtonText:   text.OptOutButtonText,
+	}
+}
+
+func LinkedIDPsScreenTextToPb(text domain.LinkedIDPsScreenText) *text_pb.LinkedIDPsScreenText {
+	return &text_pb.LinkedIDPsScreenText{
+		Title:            text.Title,
+		Description:      text.Description,
+		NoLinksText:      text.NoLinksText,
+		UnlinkButtonText: text.UnlinkButtonText,
+		NextButtonText:   text.NextButtonText,
+	}
+}
+
+func LinkedIDPsScreenTextPbToDomain(text *text_pb.LinkedIDPsScreenText) domain.LinkedIDPsScreenText {
+	if text == nil {
+		return domain.LinkedIDPsScreenText{}
+	}
+	return domain.LinkedIDPsScreenText{
+		Title:            text.Title,
+		Description:      text.Description,
+		NoLinksText:      text.NoLinksText,
+		UnlinkButtonText: text.UnlinkButtonText,
+		NextButtonText:   text.NextButtonText,
+	}
+}
+
 func RegistrationOptionScreenTextPbToDomain(text *text_pb.RegistrationOptionScreenText) domain.RegistrationOptionScreenText {
 	if text == nil {
 		return domain.RegistrationOptionScreenText{}
@@ -852,6 +963,7 @@ func RegistrationUserScreenTextPbToDomain(text *text_pb.RegistrationUserScreenTe
 		PrivacyLinkText:        text.PrivacyLinkText,
 		NextButtonText:         text.NextButtonText,
 		BackButtonText:         text.BackButtonText,
+		CaptchaLabel:           text.CaptchaLabel,
 	}
 }
 
@@ -963,6 +1075,18 @@ func LogoutDoneScreenTextPbToDomain(text *text_pb.LogoutDoneScreenText) domain.L
 	}
 }
 
+func LogoutConfirmationScreenTextPbToDomain(text *text_pb.LogoutConfirmationScreenText) domain.LogoutConfirmationScreenText {
+	if text == nil {
+		return domain.LogoutConfirmationScreenText{}
+	}
+	return domain.LogoutConfirmationScreenText{
+		Title:             text.Title,
+		Description:       text.Description,
+		ConfirmButtonText: text.ConfirmButtonText,
+		CancelButtonText:  text.CancelButtonText,
+	}
+}
+
 func FooterTextPbToDomain(text *text_pb.FooterText) domain.FooterText {
 	if text == nil {
 		return domain.FooterText{}
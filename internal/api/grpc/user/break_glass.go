@@ -0,0 +1,44 @@
+package user
+
+import (
+	"github.com/zitadel/zitadel/internal/api/grpc/object"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/query"
+	"github.com/zitadel/zitadel/pkg/grpc/user"
+)
+
+func BreakGlassUsagesToPb(usages []*query.BreakGlassUsage, resourceOwner string) []*user.BreakGlassUsage {
+	result := make([]*user.BreakGlassUsage, len(usages))
+	for i, usage := range usages {
+		result[i] = BreakGlassUsageToPb(usage, resourceOwner)
+	}
+	return result
+}
+
+func BreakGlassUsageToPb(usage *query.BreakGlassUsage, resourceOwner string) *user.BreakGlassUsage {
+	var remoteIP string
+	if usage.RemoteIP != nil {
+		remoteIP = usage.RemoteIP.String()
+	}
+	return &user.BreakGlassUsage{
+		Details:       object.ToViewDetailsPb(usage.Sequence, usage.CreationDate, usage.CreationDate, resourceOwner),
+		Method:        BreakGlassAuthMethodToPb(usage.Method),
+		AuthRequestId: usage.AuthRequestID,
+		UserAgentId:   usage.UserAgentID,
+		UserAgent:     usage.UserAgent,
+		RemoteIp:      remoteIP,
+	}
+}
+
+func BreakGlassAuthMethodToPb(method domain.BreakGlassAuthMethod) user.BreakGlassAuthMethod {
+	switch method {
+	case domain.BreakGlassAuthMethodSecurityKey:
+		return user.BreakGlassAuthMethod_BREAK_GLASS_AUTH_METHOD_SECURITY_KEY
+	case domain.BreakGlassAuthMethodClientSecret:
+		return user.BreakGlassAuthMethod_BREAK_GLASS_AUTH_METHOD_CLIENT_SECRET
+	case domain.BreakGlassAuthMethodUnspecified:
+		return user.BreakGlassAuthMethod_BREAK_GLASS_AUTH_METHOD_UNSPECIFIED
+	default:
+		return user.BreakGlassAuthMethod_BREAK_GLASS_AUTH_METHOD_UNSPECIFIED
+	}
+}
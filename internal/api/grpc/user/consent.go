@@ -0,0 +1,23 @@
+package user
+
+import (
+	"github.com/zitadel/zitadel/internal/api/grpc/object"
+	"github.com/zitadel/zitadel/internal/query"
+	"github.com/zitadel/zitadel/pkg/grpc/user"
+)
+
+func UserConsentsToPb(consents []*query.UserConsent) []*user.UserConsent {
+	result := make([]*user.UserConsent, len(consents))
+	for i, consent := range consents {
+		result[i] = UserConsentToPb(consent)
+	}
+	return result
+}
+
+func UserConsentToPb(consent *query.UserConsent) *user.UserConsent {
+	return &user.UserConsent{
+		AppId:   consent.AppID,
+		Details: object.ToViewDetailsPb(consent.Sequence, consent.CreationDate, consent.ChangeDate, consent.ResourceOwner),
+		Scopes:  consent.Scopes,
+	}
+}
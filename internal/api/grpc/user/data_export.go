@@ -0,0 +1,47 @@
+package user
+
+import (
+	"time"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/zitadel/zitadel/internal/api/grpc/object"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/query"
+	"github.com/zitadel/zitadel/pkg/grpc/user"
+)
+
+// DataExportToPb converts the export, the download url is only set for exports which can be downloaded
+func DataExportToPb(export *query.DataExport, downloadURL string, now time.Time) *user.DataExport {
+	pb := &user.DataExport{
+		Id:      export.ID,
+		Details: object.ToViewDetailsPb(export.Sequence, export.CreationDate, export.ChangeDate, export.ResourceOwner),
+		State:   DataExportStateToPb(export, now),
+		Size:    uint64(export.Size),
+	}
+	if export.State == domain.DataExportStateGenerated {
+		pb.Expiration = timestamppb.New(export.Expiration)
+		if !export.Expired(now) {
+			pb.DownloadUrl = downloadURL
+		}
+	}
+	return pb
+}
+
+func DataExportStateToPb(export *query.DataExport, now time.Time) user.DataExportState {
+	if export.Expired(now) {
+		return user.DataExportState_DATA_EXPORT_STATE_EXPIRED
+	}
+	switch export.State {
+	case domain.DataExportStateRequested:
+		return user.DataExportState_DATA_EXPORT_STATE_REQUESTED
+	case domain.DataExportStateGenerated:
+		return user.DataExportState_DATA_EXPORT_STATE_GENERATED
+	case domain.DataExportStateFailed:
+		return user.DataExportState_DATA_EXPORT_STATE_FAILED
+	case domain.DataExportStateUnspecified:
+		return user.DataExportState_DATA_EXPORT_STATE_UNSPECIFIED
+	default:
+		return user.DataExportState_DATA_EXPORT_STATE_UNSPECIFIED
+	}
+}
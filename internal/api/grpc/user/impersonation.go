@@ -0,0 +1,25 @@
+package user
+
+import (
+	"github.com/zitadel/zitadel/internal/api/grpc/object"
+	"github.com/zitadel/zitadel/internal/query"
+	"github.com/zitadel/zitadel/pkg/grpc/user"
+)
+
+func ImpersonationsToPb(impersonations []*query.Impersonation) []*user.Impersonation {
+	result := make([]*user.Impersonation, len(impersonations))
+	for i, impersonation := range impersonations {
+		result[i] = ImpersonationToPb(impersonation)
+	}
+	return result
+}
+
+func ImpersonationToPb(impersonation *query.Impersonation) *user.Impersonation {
+	return &user.Impersonation{
+		Details:        object.ToViewDetailsPb(impersonation.Sequence, impersonation.CreationDate, impersonation.CreationDate, impersonation.ResourceOwner),
+		UserId:         impersonation.UserID,
+		ImpersonatorId: impersonation.ImpersonatorID,
+		ClientId:       impersonation.ClientID,
+		Reason:         impersonation.Reason,
+	}
+}
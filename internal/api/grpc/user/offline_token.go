@@ -0,0 +1,27 @@
+package user
+
+import (
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/zitadel/zitadel/internal/api/grpc/object"
+	"github.com/zitadel/zitadel/internal/query"
+	"github.com/zitadel/zitadel/pkg/grpc/user"
+)
+
+func OfflineTokensToPb(tokens []*query.OfflineToken) []*user.OfflineToken {
+	result := make([]*user.OfflineToken, len(tokens))
+	for i, token := range tokens {
+		result[i] = OfflineTokenToPb(token)
+	}
+	return result
+}
+
+func OfflineTokenToPb(token *query.OfflineToken) *user.OfflineToken {
+	return &user.OfflineToken{
+		Id:          token.ID,
+		Details:     object.ToViewDetailsPb(token.Sequence, token.CreationDate, token.CreationDate, token.ResourceOwner),
+		ClientId:    token.ClientID,
+		Description: token.Description,
+		Expiration:  timestamppb.New(token.Expiration),
+	}
+}
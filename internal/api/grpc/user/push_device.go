@@ -0,0 +1,49 @@
+package user
+
+import (
+	"github.com/zitadel/zitadel/internal/api/grpc/object"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/query"
+	"github.com/zitadel/zitadel/pkg/grpc/user"
+)
+
+func PushDevicesToPb(devices []*query.PushDevice) []*user.PushDevice {
+	result := make([]*user.PushDevice, len(devices))
+	for i, device := range devices {
+		result[i] = PushDeviceToPb(device)
+	}
+	return result
+}
+
+func PushDeviceToPb(device *query.PushDevice) *user.PushDevice {
+	return &user.PushDevice{
+		Id:       device.ID,
+		Details:  object.ToViewDetailsPb(device.Sequence, device.CreationDate, device.CreationDate, device.ResourceOwner),
+		Platform: PushPlatformToPb(device.Platform),
+		Name:     device.Name,
+	}
+}
+
+func PushPlatformToPb(platform domain.PushPlatform) user.PushPlatform {
+	switch platform {
+	case domain.PushPlatformFCM:
+		return user.PushPlatform_PUSH_PLATFORM_FCM
+	case domain.PushPlatformAPNs:
+		return user.PushPlatform_PUSH_PLATFORM_APNS
+	case domain.PushPlatformUnspecified:
+		return user.PushPlatform_PUSH_PLATFORM_UNSPECIFIED
+	}
+	return user.PushPlatform_PUSH_PLATFORM_UNSPECIFIED
+}
+
+func PushPlatformToDomain(platform user.PushPlatform) domain.PushPlatform {
+	switch platform {
+	case user.PushPlatform_PUSH_PLATFORM_FCM:
+		return domain.PushPlatformFCM
+	case user.PushPlatform_PUSH_PLATFORM_APNS:
+		return domain.PushPlatformAPNs
+	case user.PushPlatform_PUSH_PLATFORM_UNSPECIFIED:
+		return domain.PushPlatformUnspecified
+	}
+	return domain.PushPlatformUnspecified
+}
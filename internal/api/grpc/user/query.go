@@ -48,6 +48,10 @@ func UserQueryToQuery(query *user_pb.SearchQuery, level uint8) (query.SearchQuer
 		return InUserIdsQueryToQuery(q.InUserIdsQuery)
 	case *user_pb.SearchQuery_InUserEmailsQuery:
 		return InUserEmailsQueryToQuery(q.InUserEmailsQuery)
+	case *user_pb.SearchQuery_MetadataQuery:
+		return MetadataQueryToQuery(q.MetadataQuery)
+	case *user_pb.SearchQuery_MetadataJsonQuery:
+		return MetadataJSONQueryToQuery(q.MetadataJsonQuery)
 	case *user_pb.SearchQuery_OrQuery:
 		return OrQueryToQuery(q.OrQuery, level)
 	case *user_pb.SearchQuery_AndQuery:
@@ -107,6 +111,14 @@ func InUserEmailsQueryToQuery(q *user_pb.InUserEmailsQuery) (query.SearchQuery,
 	return query.NewUserInUserEmailsSearchQuery(q.UserEmails)
 }
 
+func MetadataQueryToQuery(q *user_pb.MetadataQuery) (query.SearchQuery, error) {
+	return query.NewUserMetadataSearchQuery(q.Key, q.Value)
+}
+
+func MetadataJSONQueryToQuery(q *user_pb.MetadataJSONQuery) (query.SearchQuery, error) {
+	return query.NewUserMetadataJSONSearchQuery(q.Key, q.Path, []byte(q.Contains))
+}
+
 func OrQueryToQuery(q *user_pb.OrQuery, level uint8) (query.SearchQuery, error) {
 	mappedQueries, err := UserQueriesToQuery(q.Queries, level+1)
 	if err != nil {
@@ -0,0 +1,27 @@
+package user
+
+import (
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/zitadel/zitadel/internal/api/grpc/object"
+	"github.com/zitadel/zitadel/internal/query"
+	"github.com/zitadel/zitadel/pkg/grpc/user"
+)
+
+func TrustedDevicesToPb(devices []*query.TrustedDevice) []*user.TrustedDevice {
+	result := make([]*user.TrustedDevice, len(devices))
+	for i, device := range devices {
+		result[i] = TrustedDeviceToPb(device)
+	}
+	return result
+}
+
+func TrustedDeviceToPb(device *query.TrustedDevice) *user.TrustedDevice {
+	return &user.TrustedDevice{
+		Id:          device.ID,
+		Details:     object.ToViewDetailsPb(device.Sequence, device.CreationDate, device.CreationDate, device.ResourceOwner),
+		UserAgentId: device.UserAgentID,
+		Description: device.Description,
+		Expiration:  timestamppb.New(device.Expiration),
+	}
+}
@@ -258,6 +258,10 @@ func userQueryToQuery(query *user.SearchQuery, level uint8) (query.SearchQuery,
 		return notQueryToQuery(q.NotQuery, level)
 	case *user.SearchQuery_InUserEmailsQuery:
 		return inUserEmailsQueryToQuery(q.InUserEmailsQuery)
+	case *user.SearchQuery_MetadataQuery:
+		return metadataQueryToQuery(q.MetadataQuery)
+	case *user.SearchQuery_MetadataJsonQuery:
+		return metadataJSONQueryToQuery(q.MetadataJsonQuery)
 	default:
 		return nil, zerrors.ThrowInvalidArgument(nil, "GRPC-vR9nC", "List.Query.Invalid")
 	}
@@ -331,3 +335,11 @@ func notQueryToQuery(q *user.NotQuery, level uint8) (query.SearchQuery, error) {
 func inUserEmailsQueryToQuery(q *user.InUserEmailsQuery) (query.SearchQuery, error) {
 	return query.NewUserInUserEmailsSearchQuery(q.UserEmails)
 }
+
+func metadataQueryToQuery(q *user.MetadataQuery) (query.SearchQuery, error) {
+	return query.NewUserMetadataSearchQuery(q.Key, q.Value)
+}
+
+func metadataJSONQueryToQuery(q *user.MetadataJSONQuery) (query.SearchQuery, error) {
+	return query.NewUserMetadataJSONSearchQuery(q.Key, q.Path, []byte(q.Contains))
+}
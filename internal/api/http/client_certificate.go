@@ -0,0 +1,56 @@
+package http
+
+import (
+	"context"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"errors"
+	"net/http"
+	"net/url"
+)
+
+// WithClientCertificate stores the certificate the client presented during the TLS handshake.
+func WithClientCertificate(ctx context.Context, cert *x509.Certificate) context.Context {
+	return context.WithValue(ctx, clientCertificate, cert)
+}
+
+// ClientCertificateFromCtx returns the certificate the client presented during the TLS handshake, if any.
+func ClientCertificateFromCtx(ctx context.Context) *x509.Certificate {
+	cert, _ := ctx.Value(clientCertificate).(*x509.Certificate)
+	return cert
+}
+
+// CertificateThumbprint returns the base64url encoded SHA-256 hash of the DER encoded certificate
+// as used in the x5t#S256 confirmation method (RFC 8705).
+func CertificateThumbprint(cert *x509.Certificate) string {
+	hash := sha256.Sum256(cert.Raw)
+	return base64.RawURLEncoding.EncodeToString(hash[:])
+}
+
+// ClientCertificateFromRequest returns the leaf certificate of the client.
+// If the TLS connection is terminated by ZITADEL the certificate of the handshake is used.
+// Otherwise the URL encoded PEM of the header set by the terminating proxy is parsed.
+// The header must only be configured if the proxy overwrites it for every request.
+func ClientCertificateFromRequest(r *http.Request, header string) (*x509.Certificate, error) {
+	if r.TLS != nil && len(r.TLS.PeerCertificates) > 0 {
+		return r.TLS.PeerCertificates[0], nil
+	}
+	if header == "" {
+		return nil, nil
+	}
+	value := r.Header.Get(header)
+	if value == "" {
+		return nil, nil
+	}
+	decoded, err := url.QueryUnescape(value)
+	if err != nil {
+		return nil, err
+	}
+	block, _ := pem.Decode([]byte(decoded))
+	if block == nil || block.Type != "CERTIFICATE" {
+		return nil, errors.New("invalid client certificate")
+	}
+	return x509.ParseCertificate(block.Bytes)
+}
@@ -0,0 +1,98 @@
+package http
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func testCertificate(t *testing.T) *x509.Certificate {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "client", Organization: []string{"ZITADEL"}},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	require.NoError(t, err)
+	cert, err := x509.ParseCertificate(der)
+	require.NoError(t, err)
+	return cert
+}
+
+func TestClientCertificateFromRequest(t *testing.T) {
+	cert := testCertificate(t)
+	encoded := url.QueryEscape(string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: cert.Raw})))
+
+	tests := []struct {
+		name    string
+		tls     *tls.ConnectionState
+		headers http.Header
+		header  string
+		want    *x509.Certificate
+		wantErr bool
+	}{
+		{
+			name: "no certificate",
+		},
+		{
+			name: "tls connection",
+			tls:  &tls.ConnectionState{PeerCertificates: []*x509.Certificate{cert}},
+			want: cert,
+		},
+		{
+			name:    "header not configured",
+			headers: http.Header{"X-Client-Cert": []string{encoded}},
+		},
+		{
+			name:    "header",
+			headers: http.Header{"X-Client-Cert": []string{encoded}},
+			header:  "X-Client-Cert",
+			want:    cert,
+		},
+		{
+			name:    "invalid header",
+			headers: http.Header{"X-Client-Cert": []string{"invalid"}},
+			header:  "X-Client-Cert",
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := httptest.NewRequest(http.MethodPost, "/oauth/v2/token", nil)
+			r.TLS = tt.tls
+			for key, values := range tt.headers {
+				r.Header[key] = values
+			}
+			got, err := ClientCertificateFromRequest(r, tt.header)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestCertificateThumbprint(t *testing.T) {
+	cert := testCertificate(t)
+	got := CertificateThumbprint(cert)
+	assert.Len(t, got, 43)
+	assert.Equal(t, got, CertificateThumbprint(cert))
+}
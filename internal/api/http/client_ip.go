@@ -0,0 +1,89 @@
+package http
+
+import (
+	"net"
+	"net/http"
+	"strings"
+
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+// ClientIPConfig defines how the IP address of the client is determined if ZITADEL runs behind proxies
+type ClientIPConfig struct {
+	// Header which contains the client address and the addresses of the proxies (x-forwarded-for format)
+	Header string
+	// TrustedProxies are the addresses or networks (CIDR) of the proxies in front of ZITADEL.
+	// If set, the header is only used for requests of a trusted proxy
+	// and the client IP is the rightmost entry of the header which isn't a trusted proxy.
+	// If empty, the last (rightmost) entry of the header is used if present, otherwise the remote address.
+	// As the header can be set by the client, this is only safe if ZITADEL is exclusively reachable through a single proxy.
+	TrustedProxies []string
+}
+
+var clientIPConfig = ClientIPConfig{Header: ForwardedFor}
+
+// SetClientIPConfig configures how the client IP is read from the requests
+func SetClientIPConfig(config ClientIPConfig) error {
+	if !IsIPAllowList(config.TrustedProxies) {
+		return zerrors.ThrowInvalidArgument(nil, "HTTP-Ci3pr", "invalid trusted proxies")
+	}
+	if config.Header == "" {
+		config.Header = ForwardedFor
+	}
+	config.Header = strings.ToLower(config.Header)
+	clientIPConfig = config
+	return nil
+}
+
+// ClientIPHeader returns the (lower case) header, which contains the client and the proxy addresses.
+func ClientIPHeader() string {
+	return clientIPConfig.Header
+}
+
+// ClientIP returns the IP address of the client based on the headers of a call received from the remote address.
+func ClientIP(headers http.Header, remoteAddr string) string {
+	return clientIP(clientIPConfig, headers, remoteAddr)
+}
+
+func clientIP(config ClientIPConfig, headers http.Header, remoteAddr string) string {
+	if host, _, err := net.SplitHostPort(remoteAddr); err == nil {
+		remoteAddr = host
+	}
+	if len(config.TrustedProxies) == 0 {
+		// without trusted proxies, the last hop of the header is used as before,
+		// so deployments behind a (single) proxy keep working
+		if forwarded := forwardedAddresses(headers, config.Header); len(forwarded) > 0 {
+			return forwarded[len(forwarded)-1]
+		}
+		return remoteAddr
+	}
+	if !IsIPAllowed(config.TrustedProxies, remoteAddr) {
+		return remoteAddr
+	}
+	forwarded := forwardedAddresses(headers, config.Header)
+	if len(forwarded) == 0 {
+		return remoteAddr
+	}
+	for i := len(forwarded) - 1; i >= 0; i-- {
+		if !IsIPAllowed(config.TrustedProxies, forwarded[i]) {
+			return forwarded[i]
+		}
+	}
+	return forwarded[0]
+}
+
+func forwardedAddresses(headers http.Header, header string) []string {
+	values, ok := headers[header]
+	if !ok {
+		values = headers.Values(header)
+	}
+	addresses := make([]string, 0, len(values))
+	for _, value := range values {
+		for _, address := range strings.Split(value, ",") {
+			if address = strings.TrimSpace(address); address != "" {
+				addresses = append(addresses, address)
+			}
+		}
+	}
+	return addresses
+}
@@ -0,0 +1,105 @@
+package http
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_clientIP(t *testing.T) {
+	type args struct {
+		config     ClientIPConfig
+		headers    http.Header
+		remoteAddr string
+	}
+	tests := []struct {
+		name string
+		args args
+		want string
+	}{
+		{
+			name: "no header, remote address",
+			args: args{
+				config:     ClientIPConfig{Header: ForwardedFor},
+				remoteAddr: "192.168.0.1:1234",
+			},
+			want: "192.168.0.1",
+		},
+		{
+			name: "no trusted proxies, last hop of header",
+			args: args{
+				config:     ClientIPConfig{Header: ForwardedFor},
+				headers:    http.Header{ForwardedFor: []string{"1.2.3.4, 10.0.0.1"}},
+				remoteAddr: "10.0.0.2:1234",
+			},
+			want: "10.0.0.1",
+		},
+		{
+			name: "no trusted proxies, no header",
+			args: args{
+				config:     ClientIPConfig{Header: ForwardedFor},
+				remoteAddr: "10.0.0.2:1234",
+			},
+			want: "10.0.0.2",
+		},
+		{
+			name: "canonical header name",
+			args: args{
+				config:     ClientIPConfig{Header: "x-real-ip", TrustedProxies: []string{"10.0.0.0/8"}},
+				headers:    http.Header{"X-Real-Ip": []string{"1.2.3.4"}},
+				remoteAddr: "10.0.0.2:1234",
+			},
+			want: "1.2.3.4",
+		},
+		{
+			name: "untrusted remote address, header ignored",
+			args: args{
+				config:     ClientIPConfig{Header: ForwardedFor, TrustedProxies: []string{"10.0.0.0/8"}},
+				headers:    http.Header{ForwardedFor: []string{"1.2.3.4"}},
+				remoteAddr: "5.6.7.8:1234",
+			},
+			want: "5.6.7.8",
+		},
+		{
+			name: "trusted proxies, rightmost untrusted entry",
+			args: args{
+				config:     ClientIPConfig{Header: ForwardedFor, TrustedProxies: []string{"10.0.0.0/8"}},
+				headers:    http.Header{ForwardedFor: []string{"9.9.9.9, 1.2.3.4", "10.0.0.1"}},
+				remoteAddr: "10.0.0.2:1234",
+			},
+			want: "1.2.3.4",
+		},
+		{
+			name: "only trusted proxies, first entry",
+			args: args{
+				config:     ClientIPConfig{Header: ForwardedFor, TrustedProxies: []string{"10.0.0.0/8"}},
+				headers:    http.Header{ForwardedFor: []string{"10.0.0.3, 10.0.0.1"}},
+				remoteAddr: "10.0.0.2:1234",
+			},
+			want: "10.0.0.3",
+		},
+		{
+			name: "forged entries left of the trusted proxy",
+			args: args{
+				config:     ClientIPConfig{Header: ForwardedFor, TrustedProxies: []string{"10.0.0.1"}},
+				headers:    http.Header{ForwardedFor: []string{"6.6.6.6, 1.2.3.4"}},
+				remoteAddr: "10.0.0.1:1234",
+			},
+			want: "1.2.3.4",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, clientIP(tt.args.config, tt.args.headers, tt.args.remoteAddr))
+		})
+	}
+}
+
+func TestSetClientIPConfig(t *testing.T) {
+	defer func() { clientIPConfig = ClientIPConfig{Header: ForwardedFor} }()
+
+	assert.Error(t, SetClientIPConfig(ClientIPConfig{TrustedProxies: []string{"proxy.local"}}))
+	assert.NoError(t, SetClientIPConfig(ClientIPConfig{TrustedProxies: []string{"10.0.0.0/8"}}))
+	assert.Equal(t, ForwardedFor, clientIPConfig.Header)
+}
@@ -45,7 +45,9 @@ type key int
 const (
 	httpHeaders key = iota
 	remoteAddr
+	clientIPAddr
 	origin
+	clientCertificate
 )
 
 func CopyHeadersToContext(h http.Handler) http.Handler {
@@ -82,16 +84,18 @@ func WithComposedOrigin(ctx context.Context, composed string) context.Context {
 	return context.WithValue(ctx, origin, composed)
 }
 
+// WithClientIP sets the client IP of calls, which are not passed through [CopyHeadersToContext] (e.g. gRPC).
+// It is returned by [RemoteIPFromCtx].
+func WithClientIP(ctx context.Context, ip string) context.Context {
+	return context.WithValue(ctx, clientIPAddr, ip)
+}
+
 func RemoteIPFromCtx(ctx context.Context) string {
-	ctxHeaders, ok := HeadersFromCtx(ctx)
-	if !ok {
-		return RemoteAddrFromCtx(ctx)
-	}
-	forwarded, ok := GetForwardedFor(ctxHeaders)
-	if ok {
-		return forwarded
+	if ip, ok := ctx.Value(clientIPAddr).(string); ok {
+		return ip
 	}
-	return RemoteAddrFromCtx(ctx)
+	ctxHeaders, _ := HeadersFromCtx(ctx)
+	return clientIP(clientIPConfig, ctxHeaders, RemoteAddrFromCtx(ctx))
 }
 
 func RemoteIPFromRequest(r *http.Request) net.IP {
@@ -99,12 +103,7 @@ func RemoteIPFromRequest(r *http.Request) net.IP {
 }
 
 func RemoteIPStringFromRequest(r *http.Request) string {
-	ip, ok := GetForwardedFor(r.Header)
-	if ok {
-		return ip
-	}
-	host, _, _ := net.SplitHostPort(r.RemoteAddr)
-	return host
+	return clientIP(clientIPConfig, r.Header, r.RemoteAddr)
 }
 
 func GetAuthorization(r *http.Request) string {
@@ -0,0 +1,48 @@
+package http
+
+import (
+	"net"
+)
+
+// IsIPAllowList checks if every entry of the list is an IP address or a network in CIDR notation
+func IsIPAllowList(allowList []string) bool {
+	for _, entry := range allowList {
+		if _, _, err := net.ParseCIDR(entry); err == nil {
+			continue
+		}
+		if net.ParseIP(entry) == nil {
+			return false
+		}
+	}
+	return true
+}
+
+// IsIPAllowed checks if the IP address (optionally with a port) is part of the allow list.
+// An empty allow list allows every address.
+func IsIPAllowed(allowList []string, address string) bool {
+	return len(allowList) == 0 || IsIPListed(allowList, address)
+}
+
+// IsIPListed checks if the IP address (optionally with a port) is one of the addresses or part of the networks of the list.
+// An invalid address is never listed.
+func IsIPListed(list []string, address string) bool {
+	if host, _, err := net.SplitHostPort(address); err == nil {
+		address = host
+	}
+	ip := net.ParseIP(address)
+	if ip == nil {
+		return false
+	}
+	for _, entry := range list {
+		if _, network, err := net.ParseCIDR(entry); err == nil {
+			if network.Contains(ip) {
+				return true
+			}
+			continue
+		}
+		if allowed := net.ParseIP(entry); allowed != nil && allowed.Equal(ip) {
+			return true
+		}
+	}
+	return false
+}
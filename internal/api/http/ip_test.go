@@ -0,0 +1,79 @@
+package http
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIsIPAllowList(t *testing.T) {
+	tests := []struct {
+		name      string
+		allowList []string
+		want      bool
+	}{
+		{
+			name: "empty",
+			want: true,
+		},
+		{
+			name:      "addresses and networks",
+			allowList: []string{"192.168.0.1", "10.0.0.0/8", "2001:db8::/32"},
+			want:      true,
+		},
+		{
+			name:      "hostname",
+			allowList: []string{"10.0.0.0/8", "zitadel.com"},
+			want:      false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, IsIPAllowList(tt.allowList))
+		})
+	}
+}
+
+func TestIsIPAllowed(t *testing.T) {
+	tests := []struct {
+		name      string
+		allowList []string
+		address   string
+		want      bool
+	}{
+		{
+			name:    "empty allow list",
+			address: "192.168.0.1",
+			want:    true,
+		},
+		{
+			name:      "address matches",
+			allowList: []string{"192.168.0.1"},
+			address:   "192.168.0.1",
+			want:      true,
+		},
+		{
+			name:      "network matches with port",
+			allowList: []string{"192.168.0.1", "10.0.0.0/8"},
+			address:   "10.1.2.3:54321",
+			want:      true,
+		},
+		{
+			name:      "no match",
+			allowList: []string{"192.168.0.1", "10.0.0.0/8"},
+			address:   "172.16.0.1",
+			want:      false,
+		},
+		{
+			name:      "missing address",
+			allowList: []string{"10.0.0.0/8"},
+			address:   "",
+			want:      false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, IsIPAllowed(tt.allowList, tt.address))
+		})
+	}
+}
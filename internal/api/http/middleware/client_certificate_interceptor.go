@@ -0,0 +1,27 @@
+package middleware
+
+import (
+	"net/http"
+
+	"github.com/zitadel/logging"
+
+	http_util "github.com/zitadel/zitadel/internal/api/http"
+)
+
+// ClientCertificateHandler adds the certificate presented by the client to the context.
+// See [http_util.ClientCertificateFromRequest] for the header.
+func ClientCertificateHandler(header string) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			cert, err := http_util.ClientCertificateFromRequest(r, header)
+			if err != nil {
+				logging.WithError(err).Debug("unable to parse client certificate")
+			}
+			if cert == nil {
+				next.ServeHTTP(w, r)
+				return
+			}
+			next.ServeHTTP(w, r.WithContext(http_util.WithClientCertificate(r.Context(), cert)))
+		})
+	}
+}
@@ -0,0 +1,16 @@
+package middleware
+
+import (
+	"net/http"
+
+	http_util "github.com/zitadel/zitadel/internal/api/http"
+)
+
+// ClientIPHandler resolves the IP address of the client from the request,
+// so it can be read by [http_util.RemoteIPFromCtx] and passed on by the gateway.
+func ClientIPHandler(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := http_util.WithClientIP(r.Context(), http_util.RemoteIPStringFromRequest(r))
+		next.ServeHTTP(w, r.WithContext(ctx))
+	})
+}
@@ -87,6 +87,7 @@ func setInstance(r *http.Request, verifier authz.InstanceVerifier, headerName st
 		return nil, err
 	}
 	span.End()
+	tracing.SetInstanceAttribute(ctx, instance.InstanceID())
 	return authz.WithInstance(ctx, instance), nil
 }
 
@@ -0,0 +1,131 @@
+package idempotency
+
+import (
+	"bytes"
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"time"
+
+	"github.com/zitadel/zitadel/internal/crypto"
+	"github.com/zitadel/zitadel/internal/database"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+type Config struct {
+	// MaxAge is the duration a key is remembered after its first use
+	MaxAge time.Duration
+}
+
+// Key identifies the calls of a user which must only be executed once
+type Key struct {
+	InstanceID string
+	UserID     string
+	Key        string
+}
+
+// Call is the request the key was used for
+type Call struct {
+	Method      string
+	RequestHash []byte
+}
+
+// Storage remembers the responses of calls by their idempotency key
+type Storage interface {
+	// Reserve reserves the key for the call.
+	// If the key was already used by a completed call, its response is returned.
+	// If the key is used by a running call or was used for another call, an error is returned.
+	Reserve(ctx context.Context, key *Key, call *Call) (response []byte, err error)
+	// Complete stores the response of the reserved call
+	Complete(ctx context.Context, key *Key, response []byte) error
+	// Release removes the reservation of a failed call, so it can be retried
+	Release(ctx context.Context, key *Key) error
+}
+
+const (
+	// purgeStmt removes the expired keys of the user, so they don't pile up
+	purgeStmt    = "DELETE FROM eventstore.idempotency_keys WHERE instance_id = $1 AND user_id = $2 AND created_at < $3"
+	reserveStmt  = "INSERT INTO eventstore.idempotency_keys (instance_id, user_id, idempotency_key, method, request_hash) VALUES ($1, $2, $3, $4, $5) ON CONFLICT DO NOTHING"
+	selectStmt   = "SELECT method, request_hash, response FROM eventstore.idempotency_keys WHERE instance_id = $1 AND user_id = $2 AND idempotency_key = $3"
+	completeStmt = "UPDATE eventstore.idempotency_keys SET response = $4 WHERE instance_id = $1 AND user_id = $2 AND idempotency_key = $3"
+	releaseStmt  = "DELETE FROM eventstore.idempotency_keys WHERE instance_id = $1 AND user_id = $2 AND idempotency_key = $3"
+)
+
+type dbStorage struct {
+	client *database.DB
+	alg    crypto.EncryptionAlgorithm
+	maxAge time.Duration
+}
+
+// NewStorage stores the keys in the database.
+// The responses are encrypted with alg, as they might contain secrets like client secrets or keys.
+func NewStorage(client *database.DB, alg crypto.EncryptionAlgorithm, config *Config) Storage {
+	return &dbStorage{
+		client: client,
+		alg:    alg,
+		maxAge: config.MaxAge,
+	}
+}
+
+func (s *dbStorage) Reserve(ctx context.Context, key *Key, call *Call) ([]byte, error) {
+	if _, err := s.client.ExecContext(ctx, purgeStmt, key.InstanceID, key.UserID, time.Now().Add(-s.maxAge)); err != nil {
+		return nil, zerrors.ThrowInternal(err, "IDEMP-Wx5nk", "Errors.Internal")
+	}
+	res, err := s.client.ExecContext(ctx, reserveStmt, key.InstanceID, key.UserID, key.Key, call.Method, call.RequestHash)
+	if err != nil {
+		return nil, zerrors.ThrowInternal(err, "IDEMP-Wq3nv", "Errors.Internal")
+	}
+	if reserved, err := res.RowsAffected(); err != nil || reserved > 0 {
+		return nil, err
+	}
+
+	var (
+		method      string
+		requestHash []byte
+		response    []byte
+	)
+	err = s.client.QueryRowContext(ctx, func(row *sql.Row) error {
+		return row.Scan(&method, &requestHash, &response)
+	}, selectStmt, key.InstanceID, key.UserID, key.Key)
+	if errors.Is(err, sql.ErrNoRows) {
+		// the key was released in the meantime
+		return s.Reserve(ctx, key, call)
+	}
+	if err != nil {
+		return nil, zerrors.ThrowInternal(err, "IDEMP-Wv7mq", "Errors.Internal")
+	}
+	if method != call.Method || !bytes.Equal(requestHash, call.RequestHash) {
+		return nil, zerrors.ThrowInvalidArgument(nil, "IDEMP-Wn4xk", "Errors.Idempotency.RequestMismatch")
+	}
+	if response == nil {
+		return nil, zerrors.ThrowPreconditionFailed(nil, "IDEMP-Wk8vn", "Errors.Idempotency.InProgress")
+	}
+	encrypted := new(crypto.CryptoValue)
+	if err = json.Unmarshal(response, encrypted); err != nil {
+		return nil, zerrors.ThrowInternal(err, "IDEMP-Wx2qm", "Errors.Internal")
+	}
+	return crypto.Decrypt(encrypted, s.alg)
+}
+
+func (s *dbStorage) Complete(ctx context.Context, key *Key, response []byte) error {
+	encrypted, err := crypto.Encrypt(response, s.alg)
+	if err != nil {
+		return err
+	}
+	value, err := json.Marshal(encrypted)
+	if err != nil {
+		return zerrors.ThrowInternal(err, "IDEMP-Wm9kx", "Errors.Internal")
+	}
+	if _, err = s.client.ExecContext(ctx, completeStmt, key.InstanceID, key.UserID, key.Key, value); err != nil {
+		return zerrors.ThrowInternal(err, "IDEMP-Wq6vx", "Errors.Internal")
+	}
+	return nil
+}
+
+func (s *dbStorage) Release(ctx context.Context, key *Key) error {
+	if _, err := s.client.ExecContext(ctx, releaseStmt, key.InstanceID, key.UserID, key.Key); err != nil {
+		return zerrors.ThrowInternal(err, "IDEMP-Wn2mk", "Errors.Internal")
+	}
+	return nil
+}
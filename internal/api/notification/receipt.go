@@ -0,0 +1,128 @@
+package notification
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/gorilla/mux"
+	"github.com/zitadel/logging"
+
+	http_utils "github.com/zitadel/zitadel/internal/api/http"
+	"github.com/zitadel/zitadel/internal/crypto"
+	"github.com/zitadel/zitadel/internal/execution"
+	"github.com/zitadel/zitadel/internal/query"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+const (
+	HandlerPrefix = "/notifications"
+
+	varProviderID  = "providerid"
+	smsReceiptPath = "/sms/{" + varProviderID + ":[0-9]+}/receipts"
+
+	// receiptSignatureTolerance is the maximum age of a receipt signature to prevent replay attacks
+	receiptSignatureTolerance = 5 * time.Minute
+	maxReceiptSize            = 64 << 10
+)
+
+type Commands interface {
+	NotificationReceiptReceived(ctx context.Context, messageID, providerID, status, reason string) error
+}
+
+type Queries interface {
+	SMSProviderConfigByID(ctx context.Context, id string) (*query.SMSConfig, error)
+}
+
+type Handler struct {
+	commands            Commands
+	queries             Queries
+	encryptionAlgorithm crypto.EncryptionAlgorithm
+}
+
+// Receipt is the delivery receipt a provider of type HTTP reports for a message it accepted.
+// The request must be signed with the signing key of the provider in the same way ZITADEL signs the messages.
+type Receipt struct {
+	MessageID string `json:"messageId"`
+	Status    string `json:"status"`
+	Reason    string `json:"reason,omitempty"`
+}
+
+// NewHandler returns the handler for delivery receipts of notification providers
+func NewHandler(
+	commands Commands,
+	queries Queries,
+	encryptionAlgorithm crypto.EncryptionAlgorithm,
+	instanceInterceptor func(next http.Handler) http.Handler,
+) http.Handler {
+	h := &Handler{
+		commands:            commands,
+		queries:             queries,
+		encryptionAlgorithm: encryptionAlgorithm,
+	}
+
+	router := mux.NewRouter()
+	router.Use(instanceInterceptor)
+	router.HandleFunc(smsReceiptPath, h.handleSMSReceipt).Methods(http.MethodPost)
+	return router
+}
+
+func (h *Handler) handleSMSReceipt(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	providerID := mux.Vars(r)[varProviderID]
+	payload, err := io.ReadAll(io.LimitReader(r.Body, maxReceiptSize))
+	if err != nil {
+		h.handleError(w, r, zerrors.ThrowInvalidArgument(err, "NOTIF-3ovb9xq1rk", "Errors.Notification.InvalidReceipt"))
+		return
+	}
+	if err = h.checkSMSSignature(ctx, providerID, payload, r.Header.Get(execution.SigningHeader)); err != nil {
+		h.handleError(w, r, err)
+		return
+	}
+	receipt := new(Receipt)
+	if err = json.Unmarshal(payload, receipt); err != nil {
+		h.handleError(w, r, zerrors.ThrowInvalidArgument(err, "NOTIF-0tmy4kcz8s", "Errors.Notification.InvalidReceipt"))
+		return
+	}
+	if err = h.commands.NotificationReceiptReceived(ctx, receipt.MessageID, providerID, receipt.Status, receipt.Reason); err != nil {
+		h.handleError(w, r, err)
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// checkSMSSignature verifies the receipt was signed with the signing key of the SMS provider of type HTTP
+func (h *Handler) checkSMSSignature(ctx context.Context, providerID string, payload []byte, signature string) error {
+	config, err := h.queries.SMSProviderConfigByID(ctx, providerID)
+	if err != nil {
+		return err
+	}
+	if config.HTTPConfig == nil || config.HTTPConfig.SigningKey == nil {
+		return zerrors.ThrowNotFound(nil, "NOTIF-w2d6hfy0ab", "Errors.SMSConfig.NotFound")
+	}
+	signingKey, err := crypto.DecryptString(config.HTTPConfig.SigningKey, h.encryptionAlgorithm)
+	if err != nil {
+		return err
+	}
+	if err = execution.ValidatePayload(payload, signature, signingKey, receiptSignatureTolerance); err != nil {
+		return zerrors.ThrowUnauthenticated(err, "NOTIF-9gqe1vn5lz", "Errors.Notification.InvalidReceiptSignature")
+	}
+	return nil
+}
+
+func (h *Handler) handleError(w http.ResponseWriter, r *http.Request, err error) {
+	logging.WithFields("uri", r.RequestURI).WithError(err).Info("notification receipt rejected")
+	code, ok := http_utils.ZitadelErrorToHTTPStatusCode(err)
+	if !ok {
+		code = http.StatusInternalServerError
+	}
+	zErr := new(zerrors.ZitadelError)
+	if errors.As(err, &zErr) {
+		zErr.Parent = nil // ensuring we don't leak any unwanted information
+		err = zErr
+	}
+	http.Error(w, err.Error(), code)
+}
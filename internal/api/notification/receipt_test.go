@@ -0,0 +1,136 @@
+package notification
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	"github.com/zitadel/zitadel/internal/crypto"
+	"github.com/zitadel/zitadel/internal/execution"
+	"github.com/zitadel/zitadel/internal/query"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+type receiptCommands struct {
+	messageID, providerID, status, reason string
+}
+
+func (c *receiptCommands) NotificationReceiptReceived(_ context.Context, messageID, providerID, status, reason string) error {
+	if messageID != "message" {
+		return zerrors.ThrowNotFound(nil, "TEST", "Errors.Notification.NotFound")
+	}
+	c.messageID, c.providerID, c.status, c.reason = messageID, providerID, status, reason
+	return nil
+}
+
+type receiptQueries struct{}
+
+func (receiptQueries) SMSProviderConfigByID(_ context.Context, id string) (*query.SMSConfig, error) {
+	switch id {
+	case "1":
+		return &query.SMSConfig{ID: id, HTTPConfig: &query.HTTP{
+			Endpoint: "https://sms.example.com",
+			SigningKey: &crypto.CryptoValue{
+				CryptoType: crypto.TypeEncryption,
+				Algorithm:  "enc",
+				KeyID:      "id",
+				Crypted:    []byte("signingkey"),
+			},
+		}}, nil
+	case "2":
+		return &query.SMSConfig{ID: id, TwilioConfig: &query.Twilio{}}, nil
+	default:
+		return nil, zerrors.ThrowNotFound(nil, "TEST", "Errors.SMSConfig.NotExisting")
+	}
+}
+
+func TestHandler_handleSMSReceipt(t *testing.T) {
+	body := `{"messageId":"message","status":"failed","reason":"unreachable"}`
+	tests := []struct {
+		name         string
+		path         string
+		body         string
+		signature    string
+		wantCode     int
+		wantCommands *receiptCommands
+	}{
+		{
+			name:         "unknown provider, not found",
+			path:         "/sms/3/receipts",
+			body:         body,
+			signature:    execution.ComputeSignatureHeader(time.Now(), []byte(body), "signingkey"),
+			wantCode:     http.StatusNotFound,
+			wantCommands: &receiptCommands{},
+		},
+		{
+			name:         "provider without signing key, not found",
+			path:         "/sms/2/receipts",
+			body:         body,
+			signature:    execution.ComputeSignatureHeader(time.Now(), []byte(body), "signingkey"),
+			wantCode:     http.StatusNotFound,
+			wantCommands: &receiptCommands{},
+		},
+		{
+			name:         "invalid signature, unauthorized",
+			path:         "/sms/1/receipts",
+			body:         body,
+			signature:    execution.ComputeSignatureHeader(time.Now(), []byte(body), "otherkey"),
+			wantCode:     http.StatusUnauthorized,
+			wantCommands: &receiptCommands{},
+		},
+		{
+			name:         "expired signature, unauthorized",
+			path:         "/sms/1/receipts",
+			body:         body,
+			signature:    execution.ComputeSignatureHeader(time.Now().Add(-time.Hour), []byte(body), "signingkey"),
+			wantCode:     http.StatusUnauthorized,
+			wantCommands: &receiptCommands{},
+		},
+		{
+			name:         "invalid body, bad request",
+			path:         "/sms/1/receipts",
+			body:         "receipt",
+			signature:    execution.ComputeSignatureHeader(time.Now(), []byte("receipt"), "signingkey"),
+			wantCode:     http.StatusBadRequest,
+			wantCommands: &receiptCommands{},
+		},
+		{
+			name:      "receipt, ok",
+			path:      "/sms/1/receipts",
+			body:      body,
+			signature: execution.ComputeSignatureHeader(time.Now(), []byte(body), "signingkey"),
+			wantCode:  http.StatusNoContent,
+			wantCommands: &receiptCommands{
+				messageID:  "message",
+				providerID: "1",
+				status:     "failed",
+				reason:     "unreachable",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			commands := &receiptCommands{}
+			handler := NewHandler(
+				commands,
+				receiptQueries{},
+				crypto.CreateMockEncryptionAlg(gomock.NewController(t)),
+				func(next http.Handler) http.Handler { return next },
+			)
+			req := httptest.NewRequest(http.MethodPost, tt.path, strings.NewReader(tt.body))
+			req.Header.Set(execution.SigningHeader, tt.signature)
+			recorder := httptest.NewRecorder()
+
+			handler.ServeHTTP(recorder, req)
+
+			assert.Equal(t, tt.wantCode, recorder.Code)
+			assert.Equal(t, tt.wantCommands, commands)
+		})
+	}
+}
@@ -21,20 +21,22 @@ import (
 )
 
 type accessToken struct {
-	tokenID           string
-	userID            string
-	resourceOwner     string
-	subject           string
-	preferredLanguage *language.Tag
-	clientID          string
-	audience          []string
-	scope             []string
-	authMethods       []domain.UserAuthMethodType
-	authTime          time.Time
-	tokenCreation     time.Time
-	tokenExpiration   time.Time
-	isPAT             bool
-	actor             *domain.TokenActor
+	tokenID               string
+	userID                string
+	resourceOwner         string
+	subject               string
+	preferredLanguage     *language.Tag
+	clientID              string
+	audience              []string
+	scope                 []string
+	authMethods           []domain.UserAuthMethodType
+	authTime              time.Time
+	tokenCreation         time.Time
+	tokenExpiration       time.Time
+	isPAT                 bool
+	actor                 *domain.TokenActor
+	certificateThumbprint string
+	permissions           []string
 }
 
 var ErrInvalidTokenFormat = errors.New("invalid token format")
@@ -68,6 +70,9 @@ func (s *Server) verifyAccessToken(ctx context.Context, tkn string) (_ *accessTo
 		return accessTokenV2(tokenID, subject, token), nil
 	}
 
+	if s.revokedTokens.isRevoked(authz.GetInstance(ctx).InstanceID(), tokenID) {
+		return nil, zerrors.ThrowPermissionDenied(nil, "OIDC-Rv8ks", "token is not valid or has expired")
+	}
 	token, err := s.repo.TokenByIDs(ctx, subject, tokenID)
 	if err != nil {
 		return nil, zerrors.ThrowPermissionDenied(err, "OIDC-Dsfb2", "token is not valid or has expired")
@@ -93,29 +98,34 @@ func accessTokenV1(tokenID, subject string, token *model.TokenView) *accessToken
 		tokenExpiration:   token.Expiration,
 		isPAT:             token.IsPAT,
 		actor:             token.Actor,
+		permissions:       token.Permissions,
 	}
 }
 
 func accessTokenV2(tokenID, subject string, token *query.OIDCSessionAccessTokenReadModel) *accessToken {
 	return &accessToken{
-		tokenID:           tokenID,
-		userID:            token.UserID,
-		resourceOwner:     token.ResourceOwner,
-		subject:           subject,
-		preferredLanguage: token.PreferredLanguage,
-		clientID:          token.ClientID,
-		audience:          token.Audience,
-		scope:             token.Scope,
-		authMethods:       token.AuthMethods,
-		authTime:          token.AuthTime,
-		tokenCreation:     token.AccessTokenCreation,
-		tokenExpiration:   token.AccessTokenExpiration,
-		actor:             token.Actor,
+		tokenID:               tokenID,
+		userID:                token.UserID,
+		resourceOwner:         token.ResourceOwner,
+		subject:               subject,
+		preferredLanguage:     token.PreferredLanguage,
+		clientID:              token.ClientID,
+		audience:              token.Audience,
+		scope:                 token.Scope,
+		authMethods:           token.AuthMethods,
+		authTime:              token.AuthTime,
+		tokenCreation:         token.AccessTokenCreation,
+		tokenExpiration:       token.AccessTokenExpiration,
+		actor:                 token.Actor,
+		certificateThumbprint: token.CertificateThumbprint,
 	}
 }
 
 func (s *Server) assertClientScopesForPAT(ctx context.Context, token *accessToken, clientID, projectID string) error {
-	token.audience = append(token.audience, clientID, projectID)
+	// a PAT with a restricted audience is only valid for the projects and apps it was created for
+	if len(token.audience) == 0 {
+		token.audience = append(token.audience, clientID, projectID)
+	}
 	projectIDQuery, err := query.NewProjectRoleProjectIDSearchQuery(projectID)
 	if err != nil {
 		return zerrors.ThrowInternal(err, "OIDC-Cyc78", "Errors.Internal")
@@ -0,0 +1,38 @@
+package oidc
+
+import (
+	"slices"
+
+	"github.com/zitadel/zitadel/internal/domain"
+)
+
+const (
+	// ACRSingleFactor states that the user authenticated with a single factor (e.g. password or external identity provider)
+	ACRSingleFactor = "urn:zitadel:acr:sfa"
+	// ACRMultiFactor states that the user authenticated with multiple factors (e.g. password and otp or passkey)
+	ACRMultiFactor = "urn:zitadel:acr:mfa"
+)
+
+// ACRValuesSupported returns the Authentication Context Class References
+// which can be requested through the acr_values parameter and are returned in the acr claim.
+func ACRValuesSupported() []string {
+	return []string{ACRSingleFactor, ACRMultiFactor}
+}
+
+// AuthMethodTypesToACR maps zitadel auth method types to the Authentication Context Class Reference
+// as defined in [OpenID Connect Core 1.0, section 2].
+// An empty string is returned if no method was verified, so the acr claim is omitted.
+//
+// [OpenID Connect Core 1.0, section 2]: https://openid.net/specs/openid-connect-core-1_0.html#IDToken
+func AuthMethodTypesToACR(methodTypes []domain.UserAuthMethodType) string {
+	amr := AuthMethodTypesToAMR(methodTypes)
+	if slices.Contains(amr, MFA) {
+		return ACRMultiFactor
+	}
+	for _, methodType := range methodTypes {
+		if methodType != domain.UserAuthMethodTypeUnspecified {
+			return ACRSingleFactor
+		}
+	}
+	return ""
+}
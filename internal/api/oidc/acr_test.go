@@ -0,0 +1,82 @@
+package oidc
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/zitadel/zitadel/internal/domain"
+)
+
+func TestAuthMethodTypesToACR(t *testing.T) {
+	tests := []struct {
+		name        string
+		methodTypes []domain.UserAuthMethodType
+		want        string
+	}{
+		{
+			"no checks, empty",
+			nil,
+			"",
+		},
+		{
+			"unspecified, empty",
+			[]domain.UserAuthMethodType{domain.UserAuthMethodTypeUnspecified},
+			"",
+		},
+		{
+			"pw checked, single factor",
+			[]domain.UserAuthMethodType{domain.UserAuthMethodTypePassword},
+			ACRSingleFactor,
+		},
+		{
+			"idp checked, single factor",
+			[]domain.UserAuthMethodType{domain.UserAuthMethodTypeIDP},
+			ACRSingleFactor,
+		},
+		{
+			"pw and totp checked, multi factor",
+			[]domain.UserAuthMethodType{domain.UserAuthMethodTypePassword, domain.UserAuthMethodTypeTOTP},
+			ACRMultiFactor,
+		},
+		{
+			"passkey checked, multi factor",
+			[]domain.UserAuthMethodType{domain.UserAuthMethodTypePasswordless},
+			ACRMultiFactor,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, AuthMethodTypesToACR(tt.methodTypes))
+		})
+	}
+}
+
+func TestACRValuesToBusiness(t *testing.T) {
+	tests := []struct {
+		name   string
+		values []string
+		want   []domain.LevelOfAssurance
+	}{
+		{
+			"no values, nil",
+			nil,
+			nil,
+		},
+		{
+			"unknown values, ignored",
+			[]string{"urn:mace:incommon:iap:silver"},
+			[]domain.LevelOfAssurance{},
+		},
+		{
+			"known values",
+			[]string{ACRSingleFactor, "unknown", ACRMultiFactor},
+			[]domain.LevelOfAssurance{domain.LevelOfAssuranceSingleFactor, domain.LevelOfAssuranceMultiFactor},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, ACRValuesToBusiness(tt.values))
+		})
+	}
+}
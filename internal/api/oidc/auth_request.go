@@ -209,25 +209,53 @@ func (o *OPStorage) TerminateSession(ctx context.Context, userID, clientID strin
 		err = oidcError(err)
 		span.EndWithError(err)
 	}()
+	_, err = o.terminateV1Session(ctx, userID, "")
+	return err
+}
+
+// terminateV1Session signs out all users of the user agent and starts their logout from the relying parties.
+// It returns the URI the user agent has to be redirected to.
+func (o *OPStorage) terminateV1Session(ctx context.Context, userID, redirectURI string) (string, error) {
 	userAgentID, ok := middleware.UserAgentIDFromCtx(ctx)
 	if !ok {
 		logging.Error("no user agent id")
-		return zerrors.ThrowPreconditionFailed(nil, "OIDC-fso7F", "no user agent id")
+		return "", zerrors.ThrowPreconditionFailed(nil, "OIDC-fso7F", "no user agent id")
 	}
 	userIDs, err := o.repo.UserSessionUserIDsByAgentID(ctx, userAgentID)
 	if err != nil {
 		logging.WithError(err).Error("error retrieving user sessions")
-		return err
+		return "", err
 	}
 	if len(userIDs) == 0 {
-		return nil
+		return redirectURI, nil
+	}
+	clientIDs := make(map[string][]string, len(userIDs))
+	for _, id := range userIDs {
+		clientIDs[id], err = o.query.UserAgentClientIDs(ctx, userAgentID, []string{id})
+		if err != nil {
+			return "", err
+		}
 	}
 	data := authz.CtxData{
 		UserID: userID,
 	}
 	err = o.command.HumansSignOut(authz.SetCtxData(ctx, data), userAgentID, userIDs)
-	logging.OnError(err).Error("error signing out")
-	return err
+	if err != nil {
+		logging.WithError(err).Error("error signing out")
+		return "", err
+	}
+	logoutIDs := make([]string, 0, len(userIDs))
+	for _, id := range userIDs {
+		logoutID, err := o.startLogout(ctx, id, "", userAgentID, redirectURI, clientIDs[id])
+		if err != nil {
+			logging.WithError(err).WithField("user_id", id).Error("error starting logout")
+			continue
+		}
+		if logoutID != "" {
+			logoutIDs = append(logoutIDs, logoutID)
+		}
+	}
+	return logoutRedirectURI(redirectURI, logoutIDs), nil
 }
 
 func (o *OPStorage) TerminateSessionFromRequest(ctx context.Context, endSessionRequest *op.EndSessionRequest) (redirectURI string, err error) {
@@ -244,18 +272,45 @@ func (o *OPStorage) TerminateSessionFromRequest(ctx context.Context, endSessionR
 		return o.defaultLogoutURLV2 + endSessionRequest.RedirectURI, nil
 	}
 
-	// If there is no login client header and no id_token_hint or the id_token_hint does not have a session ID,
+	hintValid, err := o.isValidIDTokenHint(ctx, endSessionRequest.IDTokenHintClaims)
+	if err != nil {
+		return "", err
+	}
+	// Without a valid id_token_hint the request might not be initiated by the user,
+	// who has to confirm the logout of all sessions of the user agent first.
+	if !hintValid && !endSessionRequestFromCtx(ctx).confirmed {
+		return o.logoutConfirmationURI(ctx, endSessionRequest)
+	}
+	// If there is no valid id_token_hint or the id_token_hint does not have a session ID,
 	// do a v1 Terminate session.
-	if endSessionRequest.IDTokenHintClaims == nil || endSessionRequest.IDTokenHintClaims.SessionID == "" {
-		return endSessionRequest.RedirectURI, o.TerminateSession(ctx, endSessionRequest.UserID, endSessionRequest.ClientID)
+	if !hintValid || endSessionRequest.IDTokenHintClaims.SessionID == "" {
+		return o.terminateV1Session(ctx, endSessionRequest.UserID, endSessionRequest.RedirectURI)
 	}
 
 	// terminate the v2 session of the id_token_hint
-	_, err = o.command.TerminateSessionWithoutTokenCheck(ctx, endSessionRequest.IDTokenHintClaims.SessionID)
+	sessionID := endSessionRequest.IDTokenHintClaims.SessionID
+	clientIDs, err := o.query.SessionClientIDs(ctx, sessionID)
+	if err != nil {
+		return "", err
+	}
+	_, err = o.command.TerminateSessionWithoutTokenCheck(ctx, sessionID)
 	if err != nil {
 		return "", err
 	}
-	return endSessionRequest.RedirectURI, nil
+	// sessions of the same browser are terminated as well, so the user is logged out globally
+	_, err = o.command.TerminateLinkedSessions(ctx, sessionID)
+	logging.OnError(err).WithField("session_id", sessionID).Warn("unable to terminate linked sessions")
+
+	userAgentID, _ := middleware.UserAgentIDFromCtx(ctx)
+	logoutID, err := o.startLogout(ctx, endSessionRequest.IDTokenHintClaims.Subject, sessionID, userAgentID, endSessionRequest.RedirectURI, clientIDs)
+	if err != nil {
+		logging.WithError(err).WithField("session_id", sessionID).Error("error starting logout")
+		return endSessionRequest.RedirectURI, nil
+	}
+	if logoutID == "" {
+		return endSessionRequest.RedirectURI, nil
+	}
+	return logoutRedirectURI(endSessionRequest.RedirectURI, []string{logoutID}), nil
 }
 
 func (o *OPStorage) RevokeToken(ctx context.Context, token, userID, clientID string) (err *oidc.Error) {
@@ -392,7 +447,10 @@ func (o *OPStorage) assertProjectRoleScopesByProject(ctx context.Context, projec
 }
 
 func (o *OPStorage) assertClientScopesForPAT(ctx context.Context, token *model.TokenView, clientID, projectID string) error {
-	token.Audience = append(token.Audience, clientID)
+	// a PAT with a restricted audience is only valid for the projects and apps it was created for
+	if len(token.Audience) == 0 {
+		token.Audience = append(token.Audience, clientID)
+	}
 	projectIDQuery, err := query.NewProjectRoleProjectIDSearchQuery(projectID)
 	if err != nil {
 		return zerrors.ThrowInternal(err, "OIDC-Cyc78", "Errors.Internal")
@@ -507,6 +565,10 @@ func (s *Server) authorizeCallbackHandler(w http.ResponseWriter, r *http.Request
 		if err != nil {
 			return nil, err
 		}
+		// without prompt=none the consent step was shown to the user, who denied it
+		if authReq.ConsentPending() && !domain.IsPrompt(authReq.Prompt, domain.PromptNone) {
+			return authReq, oidc.ErrAccessDenied().WithDescription("The user did not consent to the requested scopes.")
+		}
 		if !authReq.Done() {
 			return authReq, oidc.ErrInteractionRequired().WithDescription("Unfortunately, the user may be not logged in and/or additional interaction is required.")
 		}
@@ -518,6 +580,10 @@ func (s *Server) authorizeCallbackHandler(w http.ResponseWriter, r *http.Request
 			op.AuthRequestError(w, r, nil, err, authorizer)
 			return
 		}
+		if authReq.GetResponseMode() == ResponseModeWebMessage {
+			webMessageError(w, authReq, err, authorizer)
+			return
+		}
 		op.AuthRequestError(w, r, authReq, err, authorizer)
 	}
 }
@@ -533,12 +599,35 @@ func (s *Server) authResponse(authReq *AuthRequest, authorizer op.Authorizer, w
 		return err
 	}
 	if authReq.GetResponseType() == oidc.ResponseTypeCode {
+		if authReq.GetResponseMode() == ResponseModeWebMessage {
+			return authResponseCodeWebMessage(authReq, authorizer, w, r)
+		}
 		op.AuthResponseCode(w, r, authReq, authorizer)
 		return nil
 	}
 	return s.authResponseToken(authReq, authorizer, client, w, r)
 }
 
+func authResponseCodeWebMessage(authReq *AuthRequest, authorizer op.Authorizer, w http.ResponseWriter, r *http.Request) (err error) {
+	code, err := op.CreateAuthRequestCode(r.Context(), authReq, authorizer.Storage(), authorizer.Crypto())
+	if err != nil {
+		webMessageError(w, authReq, err, authorizer)
+		return err
+	}
+	codeResponse := struct {
+		Code  string `schema:"code"`
+		State string `schema:"state,omitempty"`
+	}{
+		Code:  code,
+		State: authReq.GetState(),
+	}
+	if err = webMessageResponse(w, authReq, &codeResponse, authorizer.Encoder()); err != nil {
+		webMessageError(w, authReq, err, authorizer)
+		return err
+	}
+	return nil
+}
+
 func (s *Server) authResponseToken(authReq *AuthRequest, authorizer op.Authorizer, opClient op.Client, w http.ResponseWriter, r *http.Request) (err error) {
 	ctx, span := tracing.NewSpan(r.Context())
 	r = r.WithContext(ctx)
@@ -582,6 +671,13 @@ func (s *Server) authResponseToken(authReq *AuthRequest, authorizer op.Authorize
 		}
 		return nil
 	}
+	if authReq.GetResponseMode() == ResponseModeWebMessage {
+		if err = webMessageResponse(w, authReq, resp, authorizer.Encoder()); err != nil {
+			webMessageError(w, authReq, err, authorizer)
+			return err
+		}
+		return nil
+	}
 
 	callback, err := op.AuthResponseURL(authReq.GetRedirectURI(), authReq.GetResponseType(), authReq.GetResponseMode(), resp, authorizer.Encoder())
 	if err != nil {
@@ -27,8 +27,7 @@ func (a *AuthRequest) GetID() string {
 }
 
 func (a *AuthRequest) GetACR() string {
-	// return a.
-	return "" //PLANNED: impl
+	return AuthMethodTypesToACR(a.AuthMethods())
 }
 
 func (a *AuthRequest) GetAMR() []string {
@@ -176,8 +175,22 @@ func PromptToBusiness(oidcPrompt []string) []domain.Prompt {
 	return prompts
 }
 
+// ACRValuesToBusiness maps the requested acr_values to levels of assurance.
+// Unknown values are ignored.
 func ACRValuesToBusiness(values []string) []domain.LevelOfAssurance {
-	return nil
+	if len(values) == 0 {
+		return nil
+	}
+	loas := make([]domain.LevelOfAssurance, 0, len(values))
+	for _, value := range values {
+		switch value {
+		case ACRSingleFactor:
+			loas = append(loas, domain.LevelOfAssuranceSingleFactor)
+		case ACRMultiFactor:
+			loas = append(loas, domain.LevelOfAssuranceMultiFactor)
+		}
+	}
+	return loas
 }
 
 func UILocalesToBusiness(tags []language.Tag) []string {
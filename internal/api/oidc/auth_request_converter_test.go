@@ -43,6 +43,11 @@ func TestResponseModeToBusiness(t *testing.T) {
 			args: args{oidc.ResponseModeFormPost},
 			want: domain.OIDCResponseModeFormPost,
 		},
+		{
+			name: "web_message",
+			args: args{ResponseModeWebMessage},
+			want: domain.OIDCResponseModeWebMessage,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -86,6 +91,11 @@ func TestResponseModeToOIDC(t *testing.T) {
 			args: args{domain.OIDCResponseModeFormPost},
 			want: oidc.ResponseModeFormPost,
 		},
+		{
+			name: "web_message",
+			args: args{domain.OIDCResponseModeWebMessage},
+			want: ResponseModeWebMessage,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
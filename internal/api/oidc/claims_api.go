@@ -0,0 +1,228 @@
+package oidc
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+
+	"github.com/dop251/goja"
+
+	"github.com/zitadel/zitadel/internal/actions"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+const (
+	// defaultActionClaimsMaxSize is used if no maximum size of the claims set by actions is configured
+	defaultActionClaimsMaxSize = 16 * 1024
+	claimMaxKeyLength          = 200
+	claimMaxDepth              = 10
+	claimNamespaceSeparator    = ":"
+)
+
+// reservedClaims can't be set or removed by actions,
+// additionally to all claims prefixed with [ClaimPrefix].
+var reservedClaims = map[string]struct{}{
+	"iss": {}, "sub": {}, "aud": {}, "exp": {}, "iat": {}, "nbf": {}, "jti": {},
+	"azp": {}, "auth_time": {}, "nonce": {}, "acr": {}, "amr": {}, "at_hash": {}, "c_hash": {},
+	"sid": {}, "client_id": {}, "scope": {}, "act": {}, "may_act": {},
+	"name": {}, "given_name": {}, "family_name": {}, "middle_name": {}, "nickname": {},
+	"preferred_username": {}, "profile": {}, "picture": {}, "website": {}, "gender": {},
+	"birthdate": {}, "zoneinfo": {}, "locale": {}, "updated_at": {},
+	"email": {}, "email_verified": {}, "phone_number": {}, "phone_number_verified": {}, "address": {},
+}
+
+// ClaimType is the type a claim value set by an action can be checked against.
+type ClaimType string
+
+const (
+	ClaimTypeString  ClaimType = "string"
+	ClaimTypeNumber  ClaimType = "number"
+	ClaimTypeBoolean ClaimType = "boolean"
+	ClaimTypeArray   ClaimType = "array"
+	ClaimTypeObject  ClaimType = "object"
+)
+
+// actionClaims implements the `api.v2.claims` of the complement token flow.
+// In contrast to v1, claims can be overwritten and removed, values are type checked
+// and the total size of the claims set by all actions of a flow is limited.
+type actionClaims struct {
+	claims  map[string]any
+	sizes   map[string]int
+	maxSize int
+}
+
+func newActionClaims(claims map[string]any, maxSize int) *actionClaims {
+	if maxSize <= 0 {
+		maxSize = defaultActionClaimsMaxSize
+	}
+	return &actionClaims{
+		claims:  claims,
+		sizes:   make(map[string]int),
+		maxSize: maxSize,
+	}
+}
+
+// set validates and sets the claim, if expected is not empty, the value must be of this type.
+func (a *actionClaims) set(key string, value any, expected ClaimType) error {
+	if err := validateClaimKey(key); err != nil {
+		return err
+	}
+	if err := validateClaimValue(value, expected); err != nil {
+		return err
+	}
+	marshalled, err := json.Marshal(value)
+	if err != nil {
+		return zerrors.ThrowInvalidArgument(err, "OIDC-v8zq2rjx4n", "Errors.Action.Claims.InvalidValue")
+	}
+	size := len(key) + len(marshalled)
+	if a.size()-a.sizes[key]+size > a.maxSize {
+		return zerrors.ThrowResourceExhausted(nil, "OIDC-5hdk0ycm3s", "Errors.Action.Claims.TooLarge")
+	}
+	a.claims[key] = value
+	a.sizes[key] = size
+	return nil
+}
+
+func (a *actionClaims) remove(key string) error {
+	if err := validateClaimKey(key); err != nil {
+		return err
+	}
+	delete(a.claims, key)
+	delete(a.sizes, key)
+	return nil
+}
+
+func (a *actionClaims) size() (size int) {
+	for _, s := range a.sizes {
+		size += s
+	}
+	return size
+}
+
+// fields returns the `v2.claims` api, the functions throw an error in the action if the claim is invalid.
+func (a *actionClaims) fields() actions.FieldOption {
+	return actions.SetFields("v2",
+		actions.SetFields("claims",
+			actions.SetFields("set", a.setFunc("")),
+			actions.SetFields("remove", a.removeFunc("")),
+			actions.SetFields("namespace", func(c *actions.FieldConfig) interface{} {
+				return func(namespace string) map[string]interface{} {
+					if strings.TrimSpace(namespace) == "" {
+						panic(zerrors.ThrowInvalidArgument(nil, "OIDC-w4lr7ak2pe", "Errors.Action.Claims.InvalidKey"))
+					}
+					return map[string]interface{}{
+						"set":    a.setFunc(namespace),
+						"remove": a.removeFunc(namespace),
+					}
+				}
+			}),
+		),
+	)
+}
+
+// setFunc expects the key, the value and optionally the [ClaimType] of the value as arguments
+func (a *actionClaims) setFunc(namespace string) func(call goja.FunctionCall) goja.Value {
+	return func(call goja.FunctionCall) goja.Value {
+		if len(call.Arguments) < 2 || len(call.Arguments) > 3 {
+			panic("2 (key, value) or 3 (key, value, type) arguments expected")
+		}
+		var expected ClaimType
+		if len(call.Arguments) == 3 {
+			expected = ClaimType(call.Argument(2).String())
+		}
+		if err := a.set(namespacedClaim(namespace, call.Argument(0).String()), call.Argument(1).Export(), expected); err != nil {
+			panic(err)
+		}
+		return nil
+	}
+}
+
+func (a *actionClaims) removeFunc(namespace string) func(key string) {
+	return func(key string) {
+		if err := a.remove(namespacedClaim(namespace, key)); err != nil {
+			panic(err)
+		}
+	}
+}
+
+func namespacedClaim(namespace, key string) string {
+	if namespace == "" {
+		return key
+	}
+	return strings.TrimSuffix(namespace, claimNamespaceSeparator) + claimNamespaceSeparator + key
+}
+
+func validateClaimKey(key string) error {
+	if key == "" || len(key) > claimMaxKeyLength {
+		return zerrors.ThrowInvalidArgument(nil, "OIDC-0tqj3pmz6b", "Errors.Action.Claims.InvalidKey")
+	}
+	if _, ok := reservedClaims[key]; ok || strings.HasPrefix(key, ClaimPrefix) {
+		return zerrors.ThrowPermissionDenied(nil, "OIDC-2s9wfe1kxo", "Errors.Action.Claims.Reserved")
+	}
+	return nil
+}
+
+// validateClaimValue checks if the value is a valid JSON value (null excluded)
+// and optionally of the expected type.
+func validateClaimValue(value any, expected ClaimType) error {
+	typ, ok := claimValueType(value, 0)
+	if !ok {
+		return zerrors.ThrowInvalidArgument(nil, "OIDC-kd83nbx0qa", "Errors.Action.Claims.InvalidValue")
+	}
+	switch expected {
+	case "":
+		return nil
+	case ClaimTypeString, ClaimTypeNumber, ClaimTypeBoolean, ClaimTypeArray, ClaimTypeObject:
+		if typ != expected {
+			return zerrors.ThrowInvalidArgument(nil, "OIDC-9bq1ylc5rt", "Errors.Action.Claims.TypeMismatch")
+		}
+		return nil
+	default:
+		return zerrors.ThrowInvalidArgument(nil, "OIDC-m1e7dhz4uv", "Errors.Action.Claims.InvalidType")
+	}
+}
+
+func claimValueType(value any, depth int) (ClaimType, bool) {
+	if depth > claimMaxDepth {
+		return "", false
+	}
+	switch v := value.(type) {
+	case string:
+		return ClaimTypeString, true
+	case bool:
+		return ClaimTypeBoolean, true
+	case int, int32, int64, uint, uint32, uint64, float32, float64:
+		return ClaimTypeNumber, true
+	case []any:
+		for _, item := range v {
+			if _, ok := claimValueType(item, depth+1); !ok {
+				return "", false
+			}
+		}
+		return ClaimTypeArray, true
+	case []string:
+		return ClaimTypeArray, true
+	case map[string]any:
+		for _, item := range v {
+			if _, ok := claimValueType(item, depth+1); !ok {
+				return "", false
+			}
+		}
+		return ClaimTypeObject, true
+	default:
+		return "", false
+	}
+}
+
+type dryRunKey struct{}
+
+// withActionsDryRun marks the context, so actions don't persist any changes (e.g. metadata),
+// which is used to preview the resulting claims.
+func withActionsDryRun(ctx context.Context) context.Context {
+	return context.WithValue(ctx, dryRunKey{}, true)
+}
+
+func isActionsDryRun(ctx context.Context) bool {
+	dryRun, _ := ctx.Value(dryRunKey{}).(bool)
+	return dryRun
+}
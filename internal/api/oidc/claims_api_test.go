@@ -0,0 +1,135 @@
+package oidc
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+func Test_actionClaims_set(t *testing.T) {
+	type args struct {
+		key      string
+		value    any
+		expected ClaimType
+	}
+	tests := []struct {
+		name       string
+		claims     map[string]any
+		maxSize    int
+		args       args
+		wantClaims map[string]any
+		wantErr    func(error) bool
+	}{
+		{
+			name:    "empty key, error",
+			claims:  map[string]any{},
+			args:    args{key: "", value: "value"},
+			wantErr: zerrors.IsErrorInvalidArgument,
+		},
+		{
+			name:    "standard claim, error",
+			claims:  map[string]any{},
+			args:    args{key: "email", value: "test@example.com"},
+			wantErr: zerrors.IsPermissionDenied,
+		},
+		{
+			name:    "zitadel claim, error",
+			claims:  map[string]any{},
+			args:    args{key: ClaimPrefix + ":user:metadata", value: "value"},
+			wantErr: zerrors.IsPermissionDenied,
+		},
+		{
+			name:    "null value, error",
+			claims:  map[string]any{},
+			args:    args{key: "key", value: nil},
+			wantErr: zerrors.IsErrorInvalidArgument,
+		},
+		{
+			name:    "function value, error",
+			claims:  map[string]any{},
+			args:    args{key: "key", value: func() {}},
+			wantErr: zerrors.IsErrorInvalidArgument,
+		},
+		{
+			name:    "type mismatch, error",
+			claims:  map[string]any{},
+			args:    args{key: "key", value: "value", expected: ClaimTypeNumber},
+			wantErr: zerrors.IsErrorInvalidArgument,
+		},
+		{
+			name:    "unknown type, error",
+			claims:  map[string]any{},
+			args:    args{key: "key", value: "value", expected: "date"},
+			wantErr: zerrors.IsErrorInvalidArgument,
+		},
+		{
+			name:    "too large, error",
+			claims:  map[string]any{},
+			maxSize: 10,
+			args:    args{key: "key", value: "some long value"},
+			wantErr: zerrors.IsResourceExhausted,
+		},
+		{
+			name:       "string, ok",
+			claims:     map[string]any{},
+			args:       args{key: "key", value: "value", expected: ClaimTypeString},
+			wantClaims: map[string]any{"key": "value"},
+		},
+		{
+			name:   "object, ok",
+			claims: map[string]any{},
+			args: args{
+				key:      "key",
+				value:    map[string]any{"roles": []any{"admin", int64(1), true}},
+				expected: ClaimTypeObject,
+			},
+			wantClaims: map[string]any{"key": map[string]any{"roles": []any{"admin", int64(1), true}}},
+		},
+		{
+			name:       "overwrite, ok",
+			claims:     map[string]any{"key": "old"},
+			args:       args{key: "key", value: float64(1.5)},
+			wantClaims: map[string]any{"key": float64(1.5)},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			a := newActionClaims(tt.claims, tt.maxSize)
+			err := a.set(tt.args.key, tt.args.value, tt.args.expected)
+			if tt.wantErr != nil {
+				assert.True(t, tt.wantErr(err), "unexpected error: %v", err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantClaims, tt.claims)
+		})
+	}
+}
+
+func Test_actionClaims_size(t *testing.T) {
+	a := newActionClaims(map[string]any{}, 20)
+	require.NoError(t, a.set("key", "0123456789", ""))
+	// overwriting the same claim only counts once
+	require.NoError(t, a.set("key", "9876543210", ""))
+	assert.True(t, zerrors.IsResourceExhausted(a.set("other", "0123456789", "")))
+	require.NoError(t, a.remove("key"))
+	require.NoError(t, a.set("other", "0123456789", ""))
+	assert.Equal(t, map[string]any{"other": "0123456789"}, a.claims)
+}
+
+func Test_actionClaims_remove(t *testing.T) {
+	claims := map[string]any{"key": "value", "other": "value"}
+	a := newActionClaims(claims, 0)
+	assert.True(t, zerrors.IsPermissionDenied(a.remove("sub")))
+	require.NoError(t, a.remove("key"))
+	assert.Equal(t, map[string]any{"other": "value"}, claims)
+}
+
+func Test_namespacedClaim(t *testing.T) {
+	assert.Equal(t, "key", namespacedClaim("", "key"))
+	assert.Equal(t, "https://example.com:key", namespacedClaim("https://example.com", "key"))
+	assert.Equal(t, "urn:example:key", namespacedClaim("urn:example:", "key"))
+}
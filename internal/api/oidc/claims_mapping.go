@@ -0,0 +1,119 @@
+package oidc
+
+import (
+	"slices"
+	"strings"
+
+	"github.com/zitadel/oidc/v3/pkg/oidc"
+	"github.com/zitadel/oidc/v3/pkg/op"
+
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/query"
+)
+
+// claimsMappingFromClient returns the claims mapping of the application,
+// or nil if the client is not an application (e.g. a user using client credentials).
+func claimsMappingFromClient(client op.Client) *domain.ClaimsMapping {
+	c, ok := client.(*Client)
+	if !ok || c.client == nil {
+		return nil
+	}
+	return c.client.ClaimsMapping
+}
+
+// applyClaimsMapping adds the mapped metadata to the userinfo, formats the role claims and renames the mapped claims.
+// Metadata and roles are handled first, so they can be renamed as well.
+func applyClaimsMapping(mapping *domain.ClaimsMapping, user *query.OIDCUserInfo, userInfo *oidc.UserInfo) {
+	if mapping.IsZero() {
+		return
+	}
+	if user != nil {
+		for _, key := range mapping.MetadataKeys {
+			for _, md := range user.Metadata {
+				if md.Key == key {
+					userInfo.AppendClaims(key, string(md.Value))
+					break
+				}
+			}
+		}
+		setMetadataNamespaceClaims(mapping.MetadataNamespaces, user.Metadata, userInfo)
+	}
+	if mapping.RolesFormat == domain.ClaimsRolesFormatList {
+		formatRoleClaimsAsList(userInfo.Claims)
+	}
+	for claim, name := range mapping.RenamedClaims {
+		value, ok := userInfo.Claims[claim]
+		if !ok {
+			continue
+		}
+		delete(userInfo.Claims, claim)
+		userInfo.AppendClaims(name, value)
+	}
+}
+
+// setMetadataNamespaceClaims adds a claim per namespace, containing the metadata
+// with the key "namespace.name" as "name": "value".
+// Namespaces without any metadata of the user are omitted.
+func setMetadataNamespaceClaims(namespaces []string, metadata []query.UserMetadata, userInfo *oidc.UserInfo) {
+	for _, namespace := range namespaces {
+		var values map[string]string
+		for _, md := range metadata {
+			name, ok := strings.CutPrefix(md.Key, namespace+".")
+			if !ok || name == "" {
+				continue
+			}
+			if values == nil {
+				values = make(map[string]string)
+			}
+			values[name] = string(md.Value)
+		}
+		if values != nil {
+			userInfo.AppendClaims(namespace, values)
+		}
+	}
+}
+
+// formatRoleClaimsAsList replaces the asserted role claims by a sorted list of the role keys.
+func formatRoleClaimsAsList(claims map[string]any) {
+	for claim, value := range claims {
+		if !isRoleClaim(claim) {
+			continue
+		}
+		roles, ok := value.(projectRoles)
+		if !ok {
+			continue
+		}
+		keys := make([]string, 0, len(roles))
+		for key := range roles {
+			keys = append(keys, key)
+		}
+		slices.Sort(keys)
+		claims[claim] = keys
+	}
+}
+
+func isRoleClaim(claim string) bool {
+	if claim == ClaimProjectRoles {
+		return true
+	}
+	prefix, suffix, _ := strings.Cut(ClaimProjectRolesFormat, "%s")
+	projectID, ok := strings.CutPrefix(claim, prefix)
+	if !ok {
+		return false
+	}
+	projectID, ok = strings.CutSuffix(projectID, suffix)
+	return ok && projectID != "" && !strings.Contains(projectID, ":")
+}
+
+// flattenAudience moves a single audience into the claims as string, if requested by the mapping.
+// The returned audience and claims must replace the passed ones.
+func flattenAudience(mapping *domain.ClaimsMapping, audience oidc.Audience, claims map[string]any) (oidc.Audience, map[string]any) {
+	if mapping == nil || !mapping.FlattenAudience || len(audience) != 1 {
+		return audience, claims
+	}
+	if claims == nil {
+		claims = make(map[string]any, 1)
+	}
+	claims["aud"] = audience[0]
+	return nil, claims
+}
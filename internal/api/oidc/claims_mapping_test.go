@@ -0,0 +1,143 @@
+package oidc
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/zitadel/oidc/v3/pkg/oidc"
+
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/query"
+)
+
+func Test_applyClaimsMapping(t *testing.T) {
+	user := &query.OIDCUserInfo{
+		Metadata: []query.UserMetadata{
+			{Key: "department", Value: []byte("sales")},
+			{Key: "location", Value: []byte("zurich")},
+			{Key: "billing.plan", Value: []byte("pro")},
+			{Key: "billing.currency", Value: []byte("CHF")},
+			{Key: "billingcycle", Value: []byte("monthly")},
+		},
+	}
+	tests := []struct {
+		name    string
+		mapping *domain.ClaimsMapping
+		claims  map[string]any
+		want    map[string]any
+	}{
+		{
+			name:   "no mapping",
+			claims: map[string]any{"foo": "bar"},
+			want:   map[string]any{"foo": "bar"},
+		},
+		{
+			name: "metadata keys",
+			mapping: &domain.ClaimsMapping{
+				MetadataKeys: []string{"department", "unknown"},
+			},
+			claims: map[string]any{"foo": "bar"},
+			want:   map[string]any{"foo": "bar", "department": "sales"},
+		},
+		{
+			name: "renamed claims",
+			mapping: &domain.ClaimsMapping{
+				RenamedClaims: map[string]string{"foo": "baz", "unknown": "other"},
+			},
+			claims: map[string]any{"foo": "bar"},
+			want:   map[string]any{"baz": "bar"},
+		},
+		{
+			name: "renamed metadata",
+			mapping: &domain.ClaimsMapping{
+				MetadataKeys:  []string{"location"},
+				RenamedClaims: map[string]string{"location": "office"},
+			},
+			want: map[string]any{"office": "zurich"},
+		},
+		{
+			name: "metadata namespaces",
+			mapping: &domain.ClaimsMapping{
+				MetadataNamespaces: []string{"billing", "unknown"},
+			},
+			want: map[string]any{"billing": map[string]string{"plan": "pro", "currency": "CHF"}},
+		},
+		{
+			name: "roles format list",
+			mapping: &domain.ClaimsMapping{
+				RolesFormat: domain.ClaimsRolesFormatList,
+			},
+			claims: map[string]any{
+				ClaimProjectRoles: projectRoles{"user": {"orgID": "org.com"}, "admin": {"orgID": "org.com"}},
+				"urn:zitadel:iam:org:project:projectID:roles": projectRoles{"admin": {"orgID": "org.com"}},
+				"custom": projectRoles{"admin": {"orgID": "org.com"}},
+			},
+			want: map[string]any{
+				ClaimProjectRoles: []string{"admin", "user"},
+				"urn:zitadel:iam:org:project:projectID:roles": []string{"admin"},
+				"custom": projectRoles{"admin": {"orgID": "org.com"}},
+			},
+		},
+		{
+			name: "roles format list, renamed",
+			mapping: &domain.ClaimsMapping{
+				RolesFormat:   domain.ClaimsRolesFormatList,
+				RenamedClaims: map[string]string{ClaimProjectRoles: "roles"},
+			},
+			claims: map[string]any{
+				ClaimProjectRoles: projectRoles{"user": {"orgID": "org.com"}},
+			},
+			want: map[string]any{"roles": []string{"user"}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			userInfo := &oidc.UserInfo{Claims: tt.claims}
+			applyClaimsMapping(tt.mapping, user, userInfo)
+			assert.Equal(t, tt.want, userInfo.Claims)
+		})
+	}
+}
+
+func Test_flattenAudience(t *testing.T) {
+	tests := []struct {
+		name         string
+		mapping      *domain.ClaimsMapping
+		audience     oidc.Audience
+		claims       map[string]any
+		wantAudience oidc.Audience
+		wantClaims   map[string]any
+	}{
+		{
+			name:         "no mapping",
+			audience:     oidc.Audience{"client"},
+			wantAudience: oidc.Audience{"client"},
+		},
+		{
+			name:         "multiple audiences",
+			mapping:      &domain.ClaimsMapping{FlattenAudience: true},
+			audience:     oidc.Audience{"client", "project"},
+			wantAudience: oidc.Audience{"client", "project"},
+		},
+		{
+			name:       "single audience",
+			mapping:    &domain.ClaimsMapping{FlattenAudience: true},
+			audience:   oidc.Audience{"client"},
+			claims:     map[string]any{"foo": "bar"},
+			wantClaims: map[string]any{"foo": "bar", "aud": "client"},
+		},
+		{
+			name:       "single audience, no claims",
+			mapping:    &domain.ClaimsMapping{FlattenAudience: true},
+			audience:   oidc.Audience{"client"},
+			wantClaims: map[string]any{"aud": "client"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gotAudience, gotClaims := flattenAudience(tt.mapping, tt.audience, tt.claims)
+			assert.Equal(t, tt.wantAudience, gotAudience)
+			assert.Equal(t, tt.wantClaims, gotClaims)
+		})
+	}
+}
@@ -40,6 +40,8 @@ const (
 	ClaimResourceOwnerName          = ScopeResourceOwner + ":name"
 	ClaimResourceOwnerPrimaryDomain = ScopeResourceOwner + ":primary_domain"
 	ClaimActionLogFormat            = "urn:zitadel:iam:action:%s:log"
+	ClaimImpersonationReason        = "urn:zitadel:iam:impersonation:reason"
+	ClaimTokenPermissions           = "urn:zitadel:iam:token:permissions"
 
 	oidcCtx = "oidc"
 )
@@ -57,6 +59,10 @@ func (o *OPStorage) GetClientByClientID(ctx context.Context, id string) (_ op.Cl
 	if client.State != domain.AppStateActive {
 		return nil, zerrors.ThrowPreconditionFailed(nil, "OIDC-sdaGg", "client is not active")
 	}
+	client.TokenLifetimes, err = o.query.AppTokenLifetimesByClientID(ctx, client.ClientID)
+	if err != nil {
+		return nil, err
+	}
 	return ClientFromBusiness(client, o.defaultLoginURL, o.defaultLoginURLV2), nil
 }
 
@@ -507,6 +513,11 @@ func (o *OPStorage) userinfoFlows(ctx context.Context, user *query.User, userGra
 		),
 	)
 
+	if userInfo.Claims == nil {
+		userInfo.Claims = make(map[string]any)
+	}
+	v2Claims := newActionClaims(userInfo.Claims, o.actionClaimsMaxSize)
+
 	for _, action := range queriedActions {
 		actionCtx, cancel := context.WithTimeout(ctx, action.Timeout())
 		claimLogs := []string{}
@@ -557,6 +568,9 @@ func (o *OPStorage) userinfoFlows(ctx context.Context, user *query.User, userGra
 							panic(err)
 						}
 
+						if isActionsDryRun(ctx) {
+							return nil
+						}
 						metadata := &domain.Metadata{
 							Key:   key,
 							Value: value,
@@ -569,6 +583,7 @@ func (o *OPStorage) userinfoFlows(ctx context.Context, user *query.User, userGra
 					}),
 				),
 			),
+			v2Claims.fields(),
 		)
 
 		err = actions.Run(
@@ -719,6 +734,11 @@ func (o *OPStorage) privateClaimsFlows(ctx context.Context, userID string, userG
 		),
 	)
 
+	if claims == nil && len(queriedActions) > 0 {
+		claims = make(map[string]any)
+	}
+	v2Claims := newActionClaims(claims, o.actionClaimsMaxSize)
+
 	for _, action := range queriedActions {
 		claimLogs := []string{}
 		actionCtx, cancel := context.WithTimeout(ctx, action.Timeout())
@@ -754,6 +774,9 @@ func (o *OPStorage) privateClaimsFlows(ctx context.Context, userID string, userG
 							panic(err)
 						}
 
+						if isActionsDryRun(ctx) {
+							return nil
+						}
 						metadata := &domain.Metadata{
 							Key:   key,
 							Value: value,
@@ -766,6 +789,7 @@ func (o *OPStorage) privateClaimsFlows(ctx context.Context, userID string, userG
 					}),
 				),
 			),
+			v2Claims.fields(),
 		)
 
 		err = actions.Run(
@@ -995,12 +1019,18 @@ func (s *Server) VerifyClient(ctx context.Context, r *op.Request[op.ClientCreden
 			IdTokenLifetime:     s.defaultIdTokenLifetime,
 		}
 	}
+	client.TokenLifetimes, err = s.query.AppTokenLifetimesByClientID(ctx, client.ClientID)
+	if err != nil {
+		return nil, err
+	}
 
 	switch client.AuthMethodType {
 	case domain.OIDCAuthMethodTypeBasic, domain.OIDCAuthMethodTypePost:
 		err = s.verifyClientSecret(ctx, client, r.Data.ClientSecret)
 	case domain.OIDCAuthMethodTypePrivateKeyJWT:
 		err = s.verifyClientAssertion(ctx, client, r.Data.ClientAssertion)
+	case domain.OIDCAuthMethodTypeTLSClientAuth, domain.OIDCAuthMethodTypeSelfSignedTLSClientAuth:
+		err = s.verifyClientCertificate(ctx, client)
 	case domain.OIDCAuthMethodTypeNone:
 	}
 	if err != nil {
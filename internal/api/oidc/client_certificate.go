@@ -0,0 +1,95 @@
+package oidc
+
+import (
+	"context"
+	"crypto/x509"
+	"errors"
+	"os"
+	"time"
+
+	"github.com/zitadel/oidc/v3/pkg/oidc"
+
+	http_util "github.com/zitadel/zitadel/internal/api/http"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/query"
+)
+
+// Client authentication methods using mutual TLS (RFC 8705)
+const (
+	AuthMethodTLSClientAuth           oidc.AuthMethod = "tls_client_auth"
+	AuthMethodSelfSignedTLSClientAuth oidc.AuthMethod = "self_signed_tls_client_auth"
+
+	// confirmationClaim binds an access token to the certificate of the client
+	confirmationClaim = "cnf"
+	// thumbprintConfirmationMethod is the base64url encoded SHA-256 hash of the DER encoded certificate
+	thumbprintConfirmationMethod = "x5t#S256"
+)
+
+func loadClientCertificateCAs(path string) (*x509.CertPool, error) {
+	if path == "" {
+		return nil, nil
+	}
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	pool := x509.NewCertPool()
+	if !pool.AppendCertsFromPEM(data) {
+		return nil, errors.New("no certificates found")
+	}
+	return pool, nil
+}
+
+// verifyClientCertificate checks the certificate the client presented during the TLS handshake.
+// The chain of a certificate for tls_client_auth is verified against the configured CAs.
+// If none are configured, it must already be verified by the TLS terminating proxy.
+func (s *Server) verifyClientCertificate(ctx context.Context, client *query.OIDCClient) error {
+	cert := http_util.ClientCertificateFromCtx(ctx)
+	if cert == nil {
+		return oidc.ErrInvalidClient().WithDescription("client certificate missing")
+	}
+	now := time.Now()
+	if now.Before(cert.NotBefore) || now.After(cert.NotAfter) {
+		return oidc.ErrInvalidClient().WithDescription("client certificate expired")
+	}
+	switch client.AuthMethodType {
+	case domain.OIDCAuthMethodTypeTLSClientAuth:
+		if client.TLSClientAuthSubjectDN == "" || cert.Subject.String() != client.TLSClientAuthSubjectDN {
+			return oidc.ErrInvalidClient().WithDescription("invalid client certificate")
+		}
+		if s.clientCAs == nil {
+			return nil
+		}
+		_, err := cert.Verify(x509.VerifyOptions{
+			Roots:     s.clientCAs,
+			KeyUsages: []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+		})
+		if err != nil {
+			return oidc.ErrInvalidClient().WithParent(err).WithDescription("invalid client certificate")
+		}
+	case domain.OIDCAuthMethodTypeSelfSignedTLSClientAuth:
+		if client.TLSClientAuthThumbprint == "" || http_util.CertificateThumbprint(cert) != client.TLSClientAuthThumbprint {
+			return oidc.ErrInvalidClient().WithDescription("invalid client certificate")
+		}
+	default:
+		return oidc.ErrInvalidClient().WithDescription("client does not use tls client authentication")
+	}
+	return nil
+}
+
+// certificateConfirmation returns the confirmation claim binding an access token
+// to the client certificate (RFC 8705, section 3.1)
+func certificateConfirmation(thumbprint string) map[string]any {
+	return map[string]any{
+		thumbprintConfirmationMethod: thumbprint,
+	}
+}
+
+// requestCertificateThumbprint returns the thumbprint of the client certificate of the request, if any.
+func requestCertificateThumbprint(ctx context.Context) string {
+	cert := http_util.ClientCertificateFromCtx(ctx)
+	if cert == nil {
+		return ""
+	}
+	return http_util.CertificateThumbprint(cert)
+}
@@ -0,0 +1,146 @@
+package oidc
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	http_util "github.com/zitadel/zitadel/internal/api/http"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/query"
+)
+
+func testClientCertificate(t *testing.T, notAfter time.Time) *x509.Certificate {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "client", Organization: []string{"ZITADEL"}},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     notAfter,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	require.NoError(t, err)
+	cert, err := x509.ParseCertificate(der)
+	require.NoError(t, err)
+	return cert
+}
+
+func TestServer_verifyClientCertificate(t *testing.T) {
+	cert := testClientCertificate(t, time.Now().Add(time.Hour))
+	expired := testClientCertificate(t, time.Now().Add(-time.Minute))
+	trusted := x509.NewCertPool()
+	trusted.AddCert(cert)
+
+	tests := []struct {
+		name      string
+		clientCAs *x509.CertPool
+		cert      *x509.Certificate
+		client    *query.OIDCClient
+		wantErr   bool
+	}{
+		{
+			name: "certificate missing",
+			client: &query.OIDCClient{
+				AuthMethodType:         domain.OIDCAuthMethodTypeTLSClientAuth,
+				TLSClientAuthSubjectDN: "CN=client,O=ZITADEL",
+			},
+			wantErr: true,
+		},
+		{
+			name: "certificate expired",
+			cert: expired,
+			client: &query.OIDCClient{
+				AuthMethodType:          domain.OIDCAuthMethodTypeSelfSignedTLSClientAuth,
+				TLSClientAuthThumbprint: http_util.CertificateThumbprint(expired),
+			},
+			wantErr: true,
+		},
+		{
+			name: "tls_client_auth, subject mismatch",
+			cert: cert,
+			client: &query.OIDCClient{
+				AuthMethodType:         domain.OIDCAuthMethodTypeTLSClientAuth,
+				TLSClientAuthSubjectDN: "CN=other,O=ZITADEL",
+			},
+			wantErr: true,
+		},
+		{
+			name: "tls_client_auth, ok",
+			cert: cert,
+			client: &query.OIDCClient{
+				AuthMethodType:         domain.OIDCAuthMethodTypeTLSClientAuth,
+				TLSClientAuthSubjectDN: "CN=client,O=ZITADEL",
+			},
+		},
+		{
+			name:      "tls_client_auth, untrusted chain",
+			clientCAs: x509.NewCertPool(),
+			cert:      cert,
+			client: &query.OIDCClient{
+				AuthMethodType:         domain.OIDCAuthMethodTypeTLSClientAuth,
+				TLSClientAuthSubjectDN: "CN=client,O=ZITADEL",
+			},
+			wantErr: true,
+		},
+		{
+			name:      "tls_client_auth, trusted chain",
+			clientCAs: trusted,
+			cert:      cert,
+			client: &query.OIDCClient{
+				AuthMethodType:         domain.OIDCAuthMethodTypeTLSClientAuth,
+				TLSClientAuthSubjectDN: "CN=client,O=ZITADEL",
+			},
+		},
+		{
+			name: "self_signed_tls_client_auth, thumbprint mismatch",
+			cert: cert,
+			client: &query.OIDCClient{
+				AuthMethodType:          domain.OIDCAuthMethodTypeSelfSignedTLSClientAuth,
+				TLSClientAuthThumbprint: http_util.CertificateThumbprint(expired),
+			},
+			wantErr: true,
+		},
+		{
+			name: "self_signed_tls_client_auth, ok",
+			cert: cert,
+			client: &query.OIDCClient{
+				AuthMethodType:          domain.OIDCAuthMethodTypeSelfSignedTLSClientAuth,
+				TLSClientAuthThumbprint: http_util.CertificateThumbprint(cert),
+			},
+		},
+		{
+			name: "other auth method",
+			cert: cert,
+			client: &query.OIDCClient{
+				AuthMethodType: domain.OIDCAuthMethodTypeBasic,
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := &Server{clientCAs: tt.clientCAs}
+			ctx := context.Background()
+			if tt.cert != nil {
+				ctx = http_util.WithClientCertificate(ctx, tt.cert)
+			}
+			err := s.verifyClientCertificate(ctx, tt.client)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
@@ -92,11 +92,11 @@ func (c *Client) RestrictAdditionalAccessTokenScopes() func(scopes []string) []s
 }
 
 func (c *Client) AccessTokenLifetime() time.Duration {
-	return c.client.Settings.AccessTokenLifetime
+	return c.client.TokenLifetimes.AccessToken(c.client.Settings.AccessTokenLifetime)
 }
 
 func (c *Client) IDTokenLifetime() time.Duration {
-	return c.client.Settings.IdTokenLifetime
+	return c.client.TokenLifetimes.IDToken(c.client.Settings.IdTokenLifetime)
 }
 
 func (c *Client) AccessTokenType() op.AccessTokenType {
@@ -150,6 +150,10 @@ func authMethodToOIDC(authType domain.OIDCAuthMethodType) oidc.AuthMethod {
 		return oidc.AuthMethodNone
 	case domain.OIDCAuthMethodTypePrivateKeyJWT:
 		return oidc.AuthMethodPrivateKeyJWT
+	case domain.OIDCAuthMethodTypeTLSClientAuth:
+		return AuthMethodTLSClientAuth
+	case domain.OIDCAuthMethodTypeSelfSignedTLSClientAuth:
+		return AuthMethodSelfSignedTLSClientAuth
 	default:
 		return oidc.AuthMethodBasic
 	}
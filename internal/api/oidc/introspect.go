@@ -112,6 +112,7 @@ func (s *Server) Introspect(ctx context.Context, r *op.Request[op.IntrospectionR
 		client.projectRoleAssertion,
 		true,
 		true,
+		client.claimsMapping,
 	)(ctx, true, domain.TriggerTypePreUserinfoCreation)
 	if err != nil {
 		return nil, err
@@ -131,7 +132,19 @@ func (s *Server) Introspect(ctx context.Context, r *op.Request[op.IntrospectionR
 		JWTID:                           token.tokenID,
 		Actor:                           actorDomainToClaims(token.actor),
 	}
+	if token.certificateThumbprint != "" {
+		introspectionResp.Claims = map[string]any{
+			confirmationClaim: certificateConfirmation(token.certificateThumbprint),
+		}
+	}
+	if len(token.permissions) > 0 {
+		if introspectionResp.Claims == nil {
+			introspectionResp.Claims = make(map[string]any, 1)
+		}
+		introspectionResp.Claims[ClaimTokenPermissions] = token.permissions
+	}
 	introspectionResp.SetUserInfo(userInfo)
+	introspectionResp.Audience, introspectionResp.Claims = flattenAudience(client.claimsMapping, introspectionResp.Audience, introspectionResp.Claims)
 	return op.NewResponse(introspectionResp), nil
 }
 
@@ -139,6 +152,7 @@ type introspectionClientResult struct {
 	clientID             string
 	projectID            string
 	projectRoleAssertion bool
+	claimsMapping        *domain.ClaimsMapping
 	err                  error
 }
 
@@ -147,11 +161,13 @@ var errNoClientSecret = errors.New("client has no configured secret")
 func (s *Server) introspectionClientAuth(ctx context.Context, cc *op.ClientCredentials, rc chan<- *introspectionClientResult) {
 	ctx, span := tracing.NewSpan(ctx)
 
+	var claimsMapping *domain.ClaimsMapping
 	clientID, projectID, projectRoleAssertion, err := func() (string, string, bool, error) {
 		client, err := s.clientFromCredentials(ctx, cc)
 		if err != nil {
 			return "", "", false, err
 		}
+		claimsMapping = client.ClaimsMapping
 
 		if cc.ClientAssertion != "" {
 			verifier := op.NewJWTProfileVerifierKeySet(keySetMap(client.PublicKeys), op.IssuerFromContext(ctx), time.Hour, time.Second)
@@ -176,6 +192,7 @@ func (s *Server) introspectionClientAuth(ctx context.Context, cc *op.ClientCrede
 		clientID:             clientID,
 		projectID:            projectID,
 		projectRoleAssertion: projectRoleAssertion,
+		claimsMapping:        claimsMapping,
 		err:                  err,
 	}
 }
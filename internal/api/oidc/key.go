@@ -2,12 +2,14 @@ package oidc
 
 import (
 	"context"
+	"crypto/rsa"
 	"fmt"
 	"sync"
 	"sync/atomic"
 	"time"
 
 	"github.com/go-jose/go-jose/v4"
+	"github.com/go-jose/go-jose/v4/cryptosigner"
 	"github.com/jonboulle/clockwork"
 	"github.com/zitadel/logging"
 	"github.com/zitadel/oidc/v3/pkg/op"
@@ -324,7 +326,7 @@ func (o *OPStorage) getSigningKey(ctx context.Context) (op.SigningKey, error) {
 		return nil, err
 	}
 	if len(keys.Keys) > 0 {
-		return o.privateKeyToSigningKey(selectSigningKey(keys.Keys))
+		return o.privateKeyToSigningKey(ctx, selectSigningKey(keys.Keys))
 	}
 	var position float64
 	if keys.State != nil {
@@ -353,14 +355,15 @@ func (o *OPStorage) ensureIsLatestKey(ctx context.Context, position float64) (bo
 	return position >= maxSequence, nil
 }
 
-func (o *OPStorage) privateKeyToSigningKey(key query.PrivateKey) (_ op.SigningKey, err error) {
-	keyData, err := crypto.Decrypt(key.Key(), o.encAlg)
+func (o *OPStorage) privateKeyToSigningKey(ctx context.Context, key query.PrivateKey) (_ op.SigningKey, err error) {
+	signer, err := o.signingKeyProvider.Signer(ctx, key.Key())
 	if err != nil {
 		return nil, err
 	}
-	privateKey, err := crypto.BytesToPrivateKey(keyData)
-	if err != nil {
-		return nil, err
+	var privateKey interface{} = signer
+	// keys held by a key manager can only be used through the signer
+	if _, ok := signer.(*rsa.PrivateKey); !ok {
+		privateKey = cryptosigner.Opaque(signer)
 	}
 	return &SigningKey{
 		algorithm: jose.SignatureAlgorithm(key.Algorithm()),
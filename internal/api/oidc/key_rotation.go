@@ -0,0 +1,72 @@
+package oidc
+
+import (
+	"context"
+	"time"
+
+	"github.com/zitadel/logging"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/query"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+type KeyRotationConfig struct {
+	// Interval after which a new signing key is generated, 0 disables the scheduled rotation.
+	Interval time.Duration
+	// GracePeriod during which the public keys of replaced signing keys are still published.
+	GracePeriod time.Duration
+	// CheckInterval defines how often the signing keys of all instances are checked.
+	CheckInterval time.Duration
+}
+
+func (c *KeyRotationConfig) enabled() bool {
+	return c != nil && c.Interval > 0 && c.CheckInterval > 0
+}
+
+// rotateSigningKeysOnInterval rotates the signing keys of all instances until the context is done.
+func (o *OPStorage) rotateSigningKeysOnInterval(background context.Context, config *KeyRotationConfig) {
+	ticker := time.NewTicker(config.CheckInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-background.Done():
+			return
+		case <-ticker.C:
+			o.rotateSigningKeys(background, config)
+		}
+	}
+}
+
+func (o *OPStorage) rotateSigningKeys(ctx context.Context, config *KeyRotationConfig) {
+	instances, err := o.query.SearchInstances(ctx, &query.InstanceSearchQueries{})
+	if err != nil {
+		logging.WithError(err).Warn("unable to query instances for signing key rotation")
+		return
+	}
+	for _, instance := range instances.Instances {
+		err = o.lockAndRotateSigningKeyPair(authz.WithInstanceID(ctx, instance.ID), config)
+		logging.OnError(err).WithField("instance", instance.ID).Warn("signing key rotation failed")
+	}
+}
+
+func (o *OPStorage) lockAndRotateSigningKeyPair(ctx context.Context, config *KeyRotationConfig) error {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	instanceID := authz.GetInstance(ctx).InstanceID()
+	errs := o.locker.Lock(ctx, lockDuration, instanceID)
+	err, ok := <-errs
+	if err != nil || !ok {
+		if zerrors.IsErrorAlreadyExists(err) {
+			return nil
+		}
+		return err
+	}
+
+	rotated, err := o.command.RotateSigningKeyPair(setOIDCCtx(ctx), o.signingKeyAlgorithm, config.Interval, config.GracePeriod)
+	if rotated {
+		logging.WithFields("instance", instanceID).Info("signing key rotated")
+	}
+	return err
+}
@@ -0,0 +1,313 @@
+package oidc
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/zitadel/logging"
+	"github.com/zitadel/oidc/v3/pkg/crypto"
+	"github.com/zitadel/oidc/v3/pkg/oidc"
+	"github.com/zitadel/oidc/v3/pkg/op"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/api/http/middleware"
+	"github.com/zitadel/zitadel/internal/api/ui/login"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/query"
+	"github.com/zitadel/zitadel/internal/telemetry/tracing"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+const (
+	backChannelLogoutEvent   = "http://schemas.openid.net/event/backchannel-logout"
+	backChannelLogoutTimeout = 10 * time.Second
+	logoutTokenLifetime      = 2 * time.Minute
+)
+
+var backChannelLogoutClient = &http.Client{Timeout: backChannelLogoutTimeout}
+
+// logoutToken is the logout token sent to the back-channel logout URI of a relying party,
+// see https://openid.net/specs/openid-connect-backchannel-1_0.html#LogoutToken
+type logoutToken struct {
+	Issuer     string              `json:"iss"`
+	Subject    string              `json:"sub,omitempty"`
+	Audience   []string            `json:"aud"`
+	IssuedAt   int64               `json:"iat"`
+	Expiration int64               `json:"exp"`
+	JWTID      string              `json:"jti"`
+	SessionID  string              `json:"sid,omitempty"`
+	Events     map[string]struct{} `json:"events"`
+}
+
+// logoutDiscoveryConfiguration adds the metadata of the front- and back-channel logout to the discovery,
+// see https://openid.net/specs/openid-connect-frontchannel-1_0.html#OPLogout
+// and https://openid.net/specs/openid-connect-backchannel-1_0.html#BCSupport
+type logoutDiscoveryConfiguration struct {
+	*oidc.DiscoveryConfiguration
+	FrontChannelLogoutSupported        bool `json:"frontchannel_logout_supported"`
+	FrontChannelLogoutSessionSupported bool `json:"frontchannel_logout_session_supported"`
+	BackChannelLogoutSupported         bool `json:"backchannel_logout_supported"`
+	BackChannelLogoutSessionSupported  bool `json:"backchannel_logout_session_supported"`
+}
+
+func withLogoutDiscovery(config *oidc.DiscoveryConfiguration) *logoutDiscoveryConfiguration {
+	return &logoutDiscoveryConfiguration{
+		DiscoveryConfiguration:             config,
+		FrontChannelLogoutSupported:        true,
+		FrontChannelLogoutSessionSupported: true,
+		BackChannelLogoutSupported:         true,
+		BackChannelLogoutSessionSupported:  true,
+	}
+}
+
+type endSessionKey struct{}
+
+// endSessionRequest holds the parameters of the end_session request as they were sent by the relying party
+// and whether the user confirmed the logout on the confirmation page.
+type endSessionRequest struct {
+	form      url.Values
+	confirmed bool
+}
+
+func withEndSessionRequest(ctx context.Context, form url.Values, confirmed bool) context.Context {
+	return context.WithValue(ctx, endSessionKey{}, &endSessionRequest{form: form, confirmed: confirmed})
+}
+
+func endSessionRequestFromCtx(ctx context.Context) *endSessionRequest {
+	request, ok := ctx.Value(endSessionKey{}).(*endSessionRequest)
+	if !ok {
+		return &endSessionRequest{}
+	}
+	return request
+}
+
+// isValidIDTokenHint checks the id_token_hint was issued for a login of its subject,
+// which is still (or was) logged in: the session (v2) of the hint must belong to the subject
+// and for hints without session (v1) the subject must be logged in on the user agent.
+// The signature and the client of the hint are already verified by the OP.
+func (o *OPStorage) isValidIDTokenHint(ctx context.Context, claims *oidc.IDTokenClaims) (bool, error) {
+	if claims == nil {
+		return false, nil
+	}
+	if claims.SessionID != "" {
+		session, err := o.query.SessionByID(ctx, true, claims.SessionID, "")
+		if zerrors.IsNotFound(err) {
+			// the session is already terminated
+			return true, nil
+		}
+		if err != nil {
+			return false, err
+		}
+		return session.UserFactor.UserID == claims.Subject, nil
+	}
+	userIDs, err := o.userAgentUserIDs(ctx)
+	if err != nil {
+		return false, err
+	}
+	return slices.Contains(userIDs, claims.Subject), nil
+}
+
+// logoutConfirmationURI returns the page of the login UI, where the user has to confirm the logout,
+// which then posts the parameters of the end_session request back to the endpoint.
+// If no user is logged in on the user agent, there is nothing to confirm and the redirect URI is returned.
+func (o *OPStorage) logoutConfirmationURI(ctx context.Context, request *op.EndSessionRequest) (string, error) {
+	userIDs, err := o.userAgentUserIDs(ctx)
+	if err != nil {
+		return "", err
+	}
+	if len(userIDs) == 0 {
+		return request.RedirectURI, nil
+	}
+	form := endSessionRequestFromCtx(ctx).form
+	params := make(url.Values, len(login.LogoutConfirmationParams))
+	for _, param := range login.LogoutConfirmationParams {
+		if value := form.Get(param); value != "" {
+			params.Set(param, value)
+		}
+	}
+	// the client might only be known from the id_token_hint, which is not passed to the confirmation
+	if request.ClientID != "" {
+		params.Set("client_id", request.ClientID)
+	}
+	return login.LogoutConfirmationPath + "?" + params.Encode(), nil
+}
+
+func (o *OPStorage) userAgentUserIDs(ctx context.Context) ([]string, error) {
+	userAgentID, ok := middleware.UserAgentIDFromCtx(ctx)
+	if !ok {
+		return nil, nil
+	}
+	return o.repo.UserSessionUserIDsByAgentID(ctx, userAgentID)
+}
+
+// startLogout starts the global logout of the user from all the passed relying parties
+// and the SAML identity providers the user is linked to.
+// Back-channel logouts are sent asynchronously.
+// The returned logoutID is only set if targets have to be logged out through the browser of the user.
+func (o *OPStorage) startLogout(ctx context.Context, userID, sessionID, userAgentID, redirectURI string, clientIDs []string) (_ string, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	targets, err := o.logoutTargets(ctx, userID, sessionID, clientIDs)
+	if err != nil {
+		return "", err
+	}
+	postLogoutRedirectURI := redirectURI
+	if strings.HasPrefix(postLogoutRedirectURI, login.DefaultLoggedOutPath) {
+		postLogoutRedirectURI = ""
+	}
+	logoutID, err := o.command.StartLogout(ctx, userID, authz.GetInstance(ctx).InstanceID(), sessionID, userAgentID, postLogoutRedirectURI, targets)
+	if err != nil || logoutID == "" {
+		return "", err
+	}
+	go o.sendBackChannelLogouts(context.WithoutCancel(ctx), logoutID, userID, sessionID, targets)
+
+	for _, target := range targets {
+		if target.Type.IsBrowserDelegated() {
+			return logoutID, nil
+		}
+	}
+	return "", nil
+}
+
+// logoutRedirectURI returns the page of the login UI, which logs out the front-channel targets of the logouts,
+// or the passed redirect URI if there are none.
+func logoutRedirectURI(redirectURI string, logoutIDs []string) string {
+	if len(logoutIDs) == 0 {
+		return redirectURI
+	}
+	return login.DefaultLoggedOutPath + "?" + url.Values{login.QueryLogoutID: logoutIDs}.Encode()
+}
+
+// logoutTargets returns the back- and front-channel logout URIs of the clients
+// and the SAML identity providers of the user.
+func (o *OPStorage) logoutTargets(ctx context.Context, userID, sessionID string, clientIDs []string) ([]*domain.LogoutTarget, error) {
+	targets := make([]*domain.LogoutTarget, 0, len(clientIDs))
+	for _, clientID := range clientIDs {
+		client, err := o.query.GetOIDCClientByID(ctx, clientID, false)
+		if err != nil {
+			logging.WithError(err).WithField("client_id", clientID).Warn("unable to get client for logout")
+			continue
+		}
+		if client.BackChannelLogoutURI != "" {
+			targets = append(targets, &domain.LogoutTarget{
+				ID:      clientID,
+				Type:    domain.LogoutTargetTypeOIDCBackChannel,
+				URI:     client.BackChannelLogoutURI,
+				Subject: userID,
+			})
+		}
+		if client.FrontChannelLogoutURI != "" {
+			targets = append(targets, &domain.LogoutTarget{
+				ID:      clientID,
+				Type:    domain.LogoutTargetTypeOIDCFrontChannel,
+				URI:     frontChannelLogoutURI(ctx, client.FrontChannelLogoutURI, sessionID),
+				Subject: userID,
+			})
+		}
+	}
+	userIDQuery, err := query.NewIDPUserLinksUserIDSearchQuery(userID)
+	if err != nil {
+		return nil, err
+	}
+	links, err := o.query.IDPUserLinks(ctx, &query.IDPUserLinksSearchQuery{Queries: []query.SearchQuery{userIDQuery}}, false)
+	if err != nil {
+		return nil, err
+	}
+	for _, link := range links.Links {
+		if link.IDPType != domain.IDPTypeSAML {
+			continue
+		}
+		targets = append(targets, &domain.LogoutTarget{
+			ID:      link.IDPID,
+			Type:    domain.LogoutTargetTypeSAMLIDP,
+			Subject: link.ProvidedUserID,
+		})
+	}
+	return targets, nil
+}
+
+// frontChannelLogoutURI adds the issuer and session ID to the front-channel logout URI of the client,
+// see https://openid.net/specs/openid-connect-frontchannel-1_0.html#RPLogout
+func frontChannelLogoutURI(ctx context.Context, uri, sessionID string) string {
+	if sessionID == "" {
+		return uri
+	}
+	logoutURI, err := url.Parse(uri)
+	if err != nil {
+		return uri
+	}
+	values := logoutURI.Query()
+	values.Set("iss", op.IssuerFromContext(ctx))
+	values.Set("sid", sessionID)
+	logoutURI.RawQuery = values.Encode()
+	return logoutURI.String()
+}
+
+func (o *OPStorage) sendBackChannelLogouts(ctx context.Context, logoutID, userID, sessionID string, targets []*domain.LogoutTarget) {
+	for _, target := range targets {
+		if target.Type != domain.LogoutTargetTypeOIDCBackChannel {
+			continue
+		}
+		state, reason := domain.LogoutTargetStateSucceeded, ""
+		if err := o.sendBackChannelLogout(ctx, logoutID, target, userID, sessionID); err != nil {
+			logging.WithError(err).WithField("client_id", target.ID).Info("back-channel logout failed")
+			state, reason = domain.LogoutTargetStateFailed, err.Error()
+		}
+		_, err := o.command.ReportLogoutTarget(ctx, logoutID, target.ID, target.Type, state, reason)
+		logging.OnError(err).WithField("logout_id", logoutID).Error("unable to report back-channel logout")
+	}
+}
+
+func (o *OPStorage) sendBackChannelLogout(ctx context.Context, logoutID string, target *domain.LogoutTarget, userID, sessionID string) (err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	token, err := o.signLogoutToken(ctx, logoutID, target.ID, userID, sessionID)
+	if err != nil {
+		return err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, target.URI, strings.NewReader(url.Values{"logout_token": {token}}.Encode()))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	resp, err := backChannelLogoutClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusNoContent {
+		return fmt.Errorf("unexpected status %d", resp.StatusCode)
+	}
+	return nil
+}
+
+// signLogoutToken creates the logout token for the client.
+// Every client receives a single token per logout, so the ID of the logout and the client are used as unique token ID.
+func (o *OPStorage) signLogoutToken(ctx context.Context, logoutID, clientID, userID, sessionID string) (string, error) {
+	key, err := o.SigningKey(ctx)
+	if err != nil {
+		return "", err
+	}
+	signer, err := op.SignerFromKey(key)
+	if err != nil {
+		return "", err
+	}
+	now := time.Now()
+	return crypto.Sign(&logoutToken{
+		Issuer:     op.IssuerFromContext(ctx),
+		Subject:    userID,
+		Audience:   []string{clientID},
+		IssuedAt:   now.Unix(),
+		Expiration: now.Add(logoutTokenLifetime).Unix(),
+		JWTID:      logoutID + ":" + clientID,
+		SessionID:  sessionID,
+		Events:     map[string]struct{}{backChannelLogoutEvent: {}},
+	}, signer)
+}
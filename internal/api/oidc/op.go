@@ -29,6 +29,9 @@ type Config struct {
 	CodeMethodS256                    bool
 	AuthMethodPost                    bool
 	AuthMethodPrivateKeyJWT           bool
+	AuthMethodTLSClientAuth           bool
+	ClientCertificateHeader           string
+	ClientCertificateCAPath           string
 	GrantTypeRefreshToken             bool
 	RequestObjectSupported            bool
 	SigningKeyAlgorithm               string
@@ -43,6 +46,10 @@ type Config struct {
 	DefaultLoginURLV2                 string
 	DefaultLogoutURLV2                string
 	PublicKeyCacheMaxAge              time.Duration
+	SigningKeyRotation                *KeyRotationConfig
+	RevokedTokenCache                 *RevokedTokenCacheConfig
+	// ActionClaimsMaxSize limits the size in bytes of the claims set by the actions of a flow using the v2 api
+	ActionClaimsMaxSize int
 }
 
 type EndpointConfig struct {
@@ -75,8 +82,10 @@ type OPStorage struct {
 	defaultRefreshTokenIdleExpiration time.Duration
 	defaultRefreshTokenExpiration     time.Duration
 	encAlg                            crypto.EncryptionAlgorithm
+	signingKeyProvider                crypto.SigningKeyProvider
 	locker                            crdb.Locker
 	assetAPIPrefix                    func(ctx context.Context) string
+	actionClaimsMaxSize               int
 }
 
 func NewServer(
@@ -88,6 +97,7 @@ func NewServer(
 	query *query.Queries,
 	repo repository.Repository,
 	encryptionAlg crypto.EncryptionAlgorithm,
+	signingKeyProvider crypto.SigningKeyProvider,
 	cryptoKey []byte,
 	es *eventstore.Eventstore,
 	projections *database.DB,
@@ -100,10 +110,17 @@ func NewServer(
 	if err != nil {
 		return nil, zerrors.ThrowInternal(err, "OIDC-EGrqd", "cannot create op config: %w")
 	}
-	storage := newStorage(config, command, query, repo, encryptionAlg, es, projections, externalSecure)
+	storage := newStorage(config, command, query, repo, encryptionAlg, signingKeyProvider, es, projections, externalSecure)
+	if config.SigningKeyRotation.enabled() {
+		go storage.rotateSigningKeysOnInterval(ctx, config.SigningKeyRotation)
+	}
 	keyCache := newPublicKeyCache(ctx, config.PublicKeyCacheMaxAge, query.GetPublicKeyByID)
 	accessTokenKeySet := newOidcKeySet(keyCache, withKeyExpiryCheck(true))
 	idTokenHintKeySet := newOidcKeySet(keyCache)
+	var revokedTokens *revokedTokenCache
+	if config.RevokedTokenCache.enabled() {
+		revokedTokens = newRevokedTokenCache(ctx, config.RevokedTokenCache, query.RevokedTokensSince, query.RevokedTokensAfterPosition)
+	}
 
 	options := []op.Option{
 		op.WithAccessTokenKeySet(accessTokenKeySet),
@@ -125,6 +142,10 @@ func NewServer(
 	if err != nil {
 		return nil, zerrors.ThrowInternal(err, "OIDC-Aij4e", "cannot create secret hasher")
 	}
+	clientCAs, err := loadClientCertificateCAs(config.ClientCertificateCAPath)
+	if err != nil {
+		return nil, zerrors.ThrowInternal(err, "OIDC-Pq3vs", "cannot load client certificate CAs")
+	}
 	server := &Server{
 		LegacyServer:               op.NewLegacyServer(provider, endpoints(config.CustomEndpoints)),
 		repo:                       repo,
@@ -132,6 +153,7 @@ func NewServer(
 		command:                    command,
 		accessTokenKeySet:          accessTokenKeySet,
 		idTokenHintKeySet:          idTokenHintKeySet,
+		revokedTokens:              revokedTokens,
 		defaultLoginURL:            fmt.Sprintf("%s%s?%s=", login.HandlerPrefix, login.EndpointLogin, login.QueryAuthRequestID),
 		defaultLoginURLV2:          config.DefaultLoginURLV2,
 		defaultLogoutURLV2:         config.DefaultLogoutURLV2,
@@ -142,6 +164,9 @@ func NewServer(
 		signingKeyAlgorithm:        config.SigningKeyAlgorithm,
 		encAlg:                     encryptionAlg,
 		opCrypto:                   op.NewAESCrypto(opConfig.CryptoKey),
+		authMethodTLSClientAuth:    config.AuthMethodTLSClientAuth,
+		clientCAs:                  clientCAs,
+		actionClaimsMaxSize:        config.ActionClaimsMaxSize,
 		assetAPIPrefix:             assets.AssetAPI(externalSecure),
 	}
 	metricTypes := []metrics.MetricType{metrics.MetricTypeRequestCount, metrics.MetricTypeStatusCode, metrics.MetricTypeTotalCount}
@@ -155,6 +180,7 @@ func NewServer(
 			instanceHandler,
 			userAgentCookie,
 			http_utils.CopyHeadersToContext,
+			middleware.ClientCertificateHandler(config.ClientCertificateHeader),
 			accessHandler.HandleWithPublicAuthPathPrefixes(publicAuthPathPrefixes(config.CustomEndpoints)),
 			middleware.ActivityHandler,
 		))
@@ -194,7 +220,7 @@ func createOPConfig(config Config, defaultLogoutRedirectURI string, cryptoKey []
 	return opConfig, nil
 }
 
-func newStorage(config Config, command *command.Commands, query *query.Queries, repo repository.Repository, encAlg crypto.EncryptionAlgorithm, es *eventstore.Eventstore, db *database.DB, externalSecure bool) *OPStorage {
+func newStorage(config Config, command *command.Commands, query *query.Queries, repo repository.Repository, encAlg crypto.EncryptionAlgorithm, signingKeyProvider crypto.SigningKeyProvider, es *eventstore.Eventstore, db *database.DB, externalSecure bool) *OPStorage {
 	return &OPStorage{
 		repo:                              repo,
 		command:                           command,
@@ -209,8 +235,10 @@ func newStorage(config Config, command *command.Commands, query *query.Queries,
 		defaultRefreshTokenIdleExpiration: config.DefaultRefreshTokenIdleExpiration,
 		defaultRefreshTokenExpiration:     config.DefaultRefreshTokenExpiration,
 		encAlg:                            encAlg,
+		signingKeyProvider:                signingKeyProvider,
 		locker:                            crdb.NewLocker(db.DB, locksTable, signingKey),
 		assetAPIPrefix:                    assets.AssetAPI(externalSecure),
+		actionClaimsMaxSize:               config.ActionClaimsMaxSize,
 	}
 }
 
@@ -0,0 +1,162 @@
+package oidc
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/jonboulle/clockwork"
+	"github.com/zitadel/logging"
+
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/query"
+	"github.com/zitadel/zitadel/internal/repository/user"
+)
+
+type RevokedTokenCacheConfig struct {
+	// MaxAge defines how long a revoked token is kept in the cache, 0 disables the cache.
+	// It should exceed the time the token views need to catch up.
+	MaxAge time.Duration
+	// PollInterval defines how often revocations of other running instances are read from the eventstore.
+	PollInterval time.Duration
+}
+
+func (c *RevokedTokenCacheConfig) enabled() bool {
+	return c != nil && c.MaxAge > 0 && c.PollInterval > 0
+}
+
+type (
+	revokedTokensSince         func(ctx context.Context, since time.Time) ([]*query.RevokedToken, error)
+	revokedTokensAfterPosition func(ctx context.Context, position float64, since time.Time) ([]*query.RevokedToken, float64, error)
+)
+
+// revokedTokenCache keeps the IDs of recently revoked tokens of all instances in a 2-dimensional map of Instance ID and Token ID,
+// so introspection rejects them before the token views are updated.
+// Revocations pushed by this process are added immediately, revocations of other processes are polled from the eventstore.
+type revokedTokenCache struct {
+	mtx            sync.RWMutex
+	instanceTokens map[string]map[string]time.Time
+	clock          clockwork.Clock
+}
+
+// newRevokedTokenCache initializes a revokedTokenCache from the revocation list projection
+// and starts the Go routines which add new revocations and purge the ones older than maxAge.
+// When the passed context is done, the Go routines will terminate.
+func newRevokedTokenCache(background context.Context, config *RevokedTokenCacheConfig, since revokedTokensSince, afterPosition revokedTokensAfterPosition) *revokedTokenCache {
+	c := &revokedTokenCache{
+		instanceTokens: make(map[string]map[string]time.Time),
+		clock:          clockwork.FromContext(background), // defaults to real clock
+	}
+	started := c.clock.Now()
+	tokens, err := since(background, started.Add(-config.MaxAge))
+	logging.OnError(err).Warn("unable to load revoked tokens")
+	c.add(tokens...)
+
+	go c.subscribe(background)
+	go c.pollOnInterval(background, c.clock.NewTicker(config.PollInterval), afterPosition, started.Add(-config.PollInterval))
+	go c.purgeOnInterval(background, c.clock.NewTicker(config.MaxAge/5), config.MaxAge)
+	return c
+}
+
+// subscribe adds the revocations pushed by this process.
+func (c *revokedTokenCache) subscribe(background context.Context) {
+	queue := make(chan eventstore.Event, 100)
+	subscription := eventstore.SubscribeEventTypes(queue, map[eventstore.AggregateType][]eventstore.EventType{
+		user.AggregateType: {
+			user.UserTokenRemovedType,
+			user.PersonalAccessTokenRemovedType,
+			user.OfflineTokenRevokedType,
+		},
+	})
+	for {
+		select {
+		case <-background.Done():
+			subscription.Unsubscribe()
+			return
+		case event := <-queue:
+			switch e := event.(type) {
+			case *user.UserTokenRemovedEvent:
+				c.setToken(e.Aggregate().InstanceID, e.TokenID, e.CreatedAt())
+			case *user.PersonalAccessTokenRemovedEvent:
+				c.setToken(e.Aggregate().InstanceID, e.TokenID, e.CreatedAt())
+			case *user.OfflineTokenRevokedEvent:
+				c.setToken(e.Aggregate().InstanceID, e.TokenID, e.CreatedAt())
+			}
+		}
+	}
+}
+
+// pollOnInterval adds the revocations of all processes, starting with the ones created after since.
+func (c *revokedTokenCache) pollOnInterval(background context.Context, ticker clockwork.Ticker, afterPosition revokedTokensAfterPosition, since time.Time) {
+	defer ticker.Stop()
+	var position float64
+	for {
+		select {
+		case <-background.Done():
+			return
+		case <-ticker.Chan():
+		}
+
+		tokens, lastPosition, err := afterPosition(background, position, since)
+		if err != nil {
+			logging.WithError(err).Warn("unable to poll revoked tokens")
+			continue
+		}
+		position = lastPosition
+		c.add(tokens...)
+	}
+}
+
+func (c *revokedTokenCache) purgeOnInterval(background context.Context, ticker clockwork.Ticker, maxAge time.Duration) {
+	defer ticker.Stop()
+	for {
+		select {
+		case <-background.Done():
+			return
+		case <-ticker.Chan():
+		}
+
+		// do the actual purging
+		c.mtx.Lock()
+		for instanceID, tokens := range c.instanceTokens {
+			for tokenID, revocationDate := range tokens {
+				if revocationDate.Add(maxAge).Before(c.clock.Now()) {
+					delete(tokens, tokenID)
+				}
+			}
+			if len(tokens) == 0 {
+				delete(c.instanceTokens, instanceID)
+			}
+		}
+		c.mtx.Unlock()
+	}
+}
+
+func (c *revokedTokenCache) add(tokens ...*query.RevokedToken) {
+	for _, token := range tokens {
+		c.setToken(token.InstanceID, token.TokenID, token.RevocationDate)
+	}
+}
+
+func (c *revokedTokenCache) setToken(instanceID, tokenID string, revocationDate time.Time) {
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+
+	if tokens, ok := c.instanceTokens[instanceID]; ok {
+		tokens[tokenID] = revocationDate
+		return
+	}
+	c.instanceTokens[instanceID] = map[string]time.Time{tokenID: revocationDate}
+}
+
+// isRevoked returns true if the token was revoked recently.
+// It is safe to call on a nil cache, which never reports a revocation.
+func (c *revokedTokenCache) isRevoked(instanceID, tokenID string) bool {
+	if c == nil {
+		return false
+	}
+	c.mtx.RLock()
+	defer c.mtx.RUnlock()
+	_, ok := c.instanceTokens[instanceID][tokenID]
+	return ok
+}
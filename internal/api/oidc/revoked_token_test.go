@@ -0,0 +1,84 @@
+package oidc
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/jonboulle/clockwork"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/zitadel/zitadel/internal/query"
+)
+
+func Test_revokedTokenCache(t *testing.T) {
+	clock := clockwork.NewFakeClock()
+	background, cancel := context.WithCancel(
+		clockwork.AddToContext(context.Background(), clock),
+	)
+	defer cancel()
+
+	since := func(context.Context, time.Time) ([]*query.RevokedToken, error) {
+		return []*query.RevokedToken{
+			{InstanceID: "instance1", TokenID: "token1", RevocationDate: clock.Now().Add(-time.Minute)},
+		}, nil
+	}
+	var (
+		mtx       sync.Mutex
+		positions []float64
+	)
+	afterPosition := func(_ context.Context, position float64, _ time.Time) ([]*query.RevokedToken, float64, error) {
+		mtx.Lock()
+		defer mtx.Unlock()
+		positions = append(positions, position)
+		return []*query.RevokedToken{
+			{InstanceID: "instance2", TokenID: "token2", RevocationDate: clock.Now()},
+		}, 42, nil
+	}
+
+	// the purge routine runs every minute, tokens are cached for 5 minutes after revocation.
+	cache := newRevokedTokenCache(background, &RevokedTokenCacheConfig{
+		MaxAge:       5 * time.Minute,
+		PollInterval: time.Second,
+	}, since, afterPosition)
+
+	// loaded from the projection
+	assert.True(t, cache.isRevoked("instance1", "token1"))
+	assert.False(t, cache.isRevoked("instance2", "token1"))
+	assert.False(t, cache.isRevoked("instance2", "token2"))
+
+	// polled from the eventstore
+	clock.BlockUntil(2)
+	clock.Advance(time.Second)
+	assert.Eventually(t, func() bool {
+		return cache.isRevoked("instance2", "token2")
+	}, time.Second, time.Millisecond)
+
+	// the next poll continues at the last position
+	clock.BlockUntil(2)
+	clock.Advance(time.Second)
+	assert.Eventually(t, func() bool {
+		mtx.Lock()
+		defer mtx.Unlock()
+		return len(positions) == 2
+	}, time.Second, time.Millisecond)
+	mtx.Lock()
+	assert.Equal(t, []float64{0, 42}, positions)
+	mtx.Unlock()
+
+	// purge token1, token2 was revoked again by the polls
+	clock.BlockUntil(2)
+	clock.Advance(58 * time.Second)
+	clock.BlockUntil(2)
+	clock.Advance(3*time.Minute + time.Second)
+	assert.Eventually(t, func() bool {
+		return !cache.isRevoked("instance1", "token1")
+	}, time.Second, time.Millisecond)
+	assert.True(t, cache.isRevoked("instance2", "token2"))
+}
+
+func Test_revokedTokenCache_nil(t *testing.T) {
+	var cache *revokedTokenCache
+	assert.False(t, cache.isRevoked("instance1", "token1"))
+}
@@ -2,6 +2,7 @@ package oidc
 
 import (
 	"context"
+	"crypto/x509"
 	"log/slog"
 	"net/http"
 	"time"
@@ -10,6 +11,7 @@ import (
 	"github.com/zitadel/oidc/v3/pkg/oidc"
 	"github.com/zitadel/oidc/v3/pkg/op"
 
+	"github.com/zitadel/zitadel/internal/api/ui/login"
 	"github.com/zitadel/zitadel/internal/auth/repository"
 	"github.com/zitadel/zitadel/internal/command"
 	"github.com/zitadel/zitadel/internal/crypto"
@@ -27,6 +29,7 @@ type Server struct {
 	command           *command.Commands
 	accessTokenKeySet *oidcKeySet
 	idTokenHintKeySet *oidcKeySet
+	revokedTokens     *revokedTokenCache
 
 	defaultLoginURL            string
 	defaultLoginURLV2          string
@@ -40,7 +43,12 @@ type Server struct {
 	encAlg              crypto.EncryptionAlgorithm
 	opCrypto            op.Crypto
 
+	authMethodTLSClientAuth bool
+	clientCAs               *x509.CertPool
+
 	assetAPIPrefix func(ctx context.Context) string
+
+	actionClaimsMaxSize int
 }
 
 func endpoints(endpointConfig *EndpointConfig) op.Endpoints {
@@ -125,7 +133,7 @@ func (s *Server) Discovery(ctx context.Context, r *op.Request[struct{}]) (_ *op.
 	if len(allowedLanguages) == 0 {
 		allowedLanguages = i18n.SupportedLanguages()
 	}
-	return op.NewResponse(s.createDiscoveryConfig(ctx, allowedLanguages)), nil
+	return op.NewResponse(withLogoutDiscovery(s.createDiscoveryConfig(ctx, allowedLanguages))), nil
 }
 
 func (s *Server) Keys(ctx context.Context, r *op.Request[struct{}]) (_ *op.Response, err error) {
@@ -167,7 +175,15 @@ func (s *Server) EndSession(ctx context.Context, r *op.Request[oidc.EndSessionRe
 	ctx, span := tracing.NewSpan(ctx)
 	defer func() { span.EndWithError(err) }()
 
-	return s.LegacyServer.EndSession(ctx, r)
+	// the confirmation is only accepted from the form of the confirmation page,
+	// so a (cross-site) link to the endpoint cannot log out the user without asking
+	confirmed := r.Method == http.MethodPost && r.PostForm.Get(login.QueryLogoutConfirmed) == "true"
+	return s.LegacyServer.EndSession(withEndSessionRequest(ctx, r.Form, confirmed), r)
+}
+
+// EndSessionURL returns the absolute URL of the end_session endpoint of the issuer of the request.
+func (s *Server) EndSessionURL(ctx context.Context) string {
+	return s.Endpoints().EndSession.Absolute(op.IssuerFromContext(ctx))
 }
 
 func (s *Server) createDiscoveryConfig(ctx context.Context, supportedUILocales oidc.Locales) *oidc.DiscoveryConfiguration {
@@ -188,12 +204,14 @@ func (s *Server) createDiscoveryConfig(ctx context.Context, supportedUILocales o
 			string(oidc.ResponseModeQuery),
 			string(oidc.ResponseModeFragment),
 			string(oidc.ResponseModeFormPost),
+			string(ResponseModeWebMessage),
 		},
 		GrantTypesSupported:                                op.GrantTypes(s.Provider()),
+		ACRValuesSupported:                                 ACRValuesSupported(),
 		SubjectTypesSupported:                              op.SubjectTypes(s.Provider()),
 		IDTokenSigningAlgValuesSupported:                   []string{s.signingKeyAlgorithm},
 		RequestObjectSigningAlgValuesSupported:             op.RequestObjectSigAlgorithms(s.Provider()),
-		TokenEndpointAuthMethodsSupported:                  op.AuthMethodsTokenEndpoint(s.Provider()),
+		TokenEndpointAuthMethodsSupported:                  s.tokenEndpointAuthMethods(),
 		TokenEndpointAuthSigningAlgValuesSupported:         op.TokenSigAlgorithms(s.Provider()),
 		IntrospectionEndpointAuthSigningAlgValuesSupported: op.IntrospectionSigAlgorithms(s.Provider()),
 		IntrospectionEndpointAuthMethodsSupported:          op.AuthMethodsIntrospectionEndpoint(s.Provider()),
@@ -206,6 +224,14 @@ func (s *Server) createDiscoveryConfig(ctx context.Context, supportedUILocales o
 	}
 }
 
+func (s *Server) tokenEndpointAuthMethods() []oidc.AuthMethod {
+	methods := op.AuthMethodsTokenEndpoint(s.Provider())
+	if s.authMethodTLSClientAuth {
+		methods = append(methods, AuthMethodTLSClientAuth, AuthMethodSelfSignedTLSClientAuth)
+	}
+	return methods
+}
+
 func response(resp any, err error) (*op.Response, error) {
 	if err != nil {
 		return nil, err
@@ -2,9 +2,11 @@ package oidc
 
 import (
 	"context"
+	"encoding/json"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	"github.com/zitadel/oidc/v3/pkg/oidc"
 	"github.com/zitadel/oidc/v3/pkg/op"
 	"golang.org/x/text/language"
@@ -73,9 +75,9 @@ func TestServer_createDiscoveryConfig(t *testing.T) {
 				RegistrationEndpoint:                               "",
 				ScopesSupported:                                    []string{oidc.ScopeOpenID, oidc.ScopeProfile, oidc.ScopeEmail, oidc.ScopePhone, oidc.ScopeAddress, oidc.ScopeOfflineAccess},
 				ResponseTypesSupported:                             []string{string(oidc.ResponseTypeCode), string(oidc.ResponseTypeIDTokenOnly), string(oidc.ResponseTypeIDToken)},
-				ResponseModesSupported:                             []string{string(oidc.ResponseModeQuery), string(oidc.ResponseModeFragment), string(oidc.ResponseModeFormPost)},
+				ResponseModesSupported:                             []string{string(oidc.ResponseModeQuery), string(oidc.ResponseModeFragment), string(oidc.ResponseModeFormPost), string(ResponseModeWebMessage)},
 				GrantTypesSupported:                                []oidc.GrantType{oidc.GrantTypeCode, oidc.GrantTypeImplicit, oidc.GrantTypeRefreshToken, oidc.GrantTypeBearer},
-				ACRValuesSupported:                                 nil,
+				ACRValuesSupported:                                 []string{"urn:zitadel:acr:sfa", "urn:zitadel:acr:mfa"},
 				SubjectTypesSupported:                              []string{"public"},
 				IDTokenSigningAlgValuesSupported:                   []string{"RS256"},
 				IDTokenEncryptionAlgValuesSupported:                nil,
@@ -118,3 +120,18 @@ func TestServer_createDiscoveryConfig(t *testing.T) {
 		})
 	}
 }
+
+func Test_withLogoutDiscovery(t *testing.T) {
+	config, err := json.Marshal(withLogoutDiscovery(&oidc.DiscoveryConfiguration{
+		Issuer: "https://issuer.com",
+	}))
+	require.NoError(t, err)
+	assert.JSONEq(t, `{
+		"issuer": "https://issuer.com",
+		"request_uri_parameter_supported": false,
+		"frontchannel_logout_supported": true,
+		"frontchannel_logout_session_supported": true,
+		"backchannel_logout_supported": true,
+		"backchannel_logout_session_supported": true
+	}`, string(config))
+}
@@ -3,6 +3,7 @@ package oidc
 import (
 	"context"
 	"encoding/base64"
+	"maps"
 	"slices"
 	"sync"
 	"time"
@@ -30,7 +31,7 @@ for example the v2 code exchange and refresh token.
 */
 
 func (s *Server) accessTokenResponseFromSession(ctx context.Context, client op.Client, session *command.OIDCSession, state, projectID string, projectRoleAssertion, accessTokenRoleAssertion, idTokenRoleAssertion, userInfoAssertion bool) (_ *oidc.AccessTokenResponse, err error) {
-	getUserInfo := s.getUserInfo(session.UserID, projectID, projectRoleAssertion, userInfoAssertion, session.Scope)
+	getUserInfo := s.getUserInfo(session.UserID, projectID, projectRoleAssertion, userInfoAssertion, session.Scope, claimsMappingFromClient(client))
 	getSigner := s.getSignerOnce()
 
 	resp := &oidc.AccessTokenResponse{
@@ -96,8 +97,8 @@ type userInfoFunc func(ctx context.Context, roleAssertion bool, triggerType doma
 
 // getUserInfo returns a function which retrieves userinfo from the database once.
 // However, each time, role claims are asserted and also action flows will trigger.
-func (s *Server) getUserInfo(userID, projectID string, projectRoleAssertion, userInfoAssertion bool, scope []string) userInfoFunc {
-	userInfo := s.userInfo(userID, scope, projectID, projectRoleAssertion, userInfoAssertion, false)
+func (s *Server) getUserInfo(userID, projectID string, projectRoleAssertion, userInfoAssertion bool, scope []string, claimsMapping *domain.ClaimsMapping) userInfoFunc {
+	userInfo := s.userInfo(userID, scope, projectID, projectRoleAssertion, userInfoAssertion, false, claimsMapping)
 	return func(ctx context.Context, roleAssertion bool, triggerType domain.TriggerType) (*oidc.UserInfo, error) {
 		return userInfo(ctx, roleAssertion, triggerType)
 	}
@@ -125,7 +126,7 @@ func (*Server) createIDToken(ctx context.Context, client op.Client, getUserInfo
 		expTime,
 		authTime,
 		nonce,
-		"",
+		AuthMethodTypesToACR(authMethods),
 		AuthMethodTypesToAMR(authMethods),
 		client.GetID(),
 		client.ClockSkew(),
@@ -133,6 +134,7 @@ func (*Server) createIDToken(ctx context.Context, client op.Client, getUserInfo
 	claims.SessionID = sessionID
 	claims.Actor = actorDomainToClaims(actor)
 	claims.SetUserInfo(userInfo)
+	claims.Audience, claims.Claims = flattenAudience(claimsMappingFromClient(client), claims.Audience, claims.Claims)
 	if accessToken != "" {
 		claims.AccessTokenHash, err = oidc.ClaimHash(accessToken, signAlg)
 		if err != nil {
@@ -172,6 +174,13 @@ func (s *Server) createJWT(ctx context.Context, client op.Client, session *comma
 	)
 	claims.Actor = actorDomainToClaims(session.Actor)
 	claims.Claims = userInfo.Claims
+	if thumbprint := requestCertificateThumbprint(ctx); thumbprint != "" {
+		claims.Claims = maps.Clone(userInfo.Claims)
+		if claims.Claims == nil {
+			claims.Claims = make(map[string]any, 1)
+		}
+		claims.Claims[confirmationClaim] = certificateConfirmation(thumbprint)
+	}
 
 	return crypto.Sign(claims, signer)
 }
@@ -67,10 +67,12 @@ func actorClaimsToDomain(actor *oidc.ActorClaims) *domain.TokenActor {
 	if actor == nil {
 		return nil
 	}
+	reason, _ := actor.Claims[ClaimImpersonationReason].(string)
 	return &domain.TokenActor{
 		Actor:  actorClaimsToDomain(actor.Actor),
 		UserID: actor.Subject,
 		Issuer: actor.Issuer,
+		Reason: reason,
 	}
 }
 
@@ -78,11 +80,16 @@ func actorDomainToClaims(actor *domain.TokenActor) *oidc.ActorClaims {
 	if actor == nil {
 		return nil
 	}
-	return &oidc.ActorClaims{
+	claims := &oidc.ActorClaims{
 		Actor:   actorDomainToClaims(actor.Actor),
 		Subject: actor.UserID,
 		Issuer:  actor.Issuer,
 	}
+	// the reason of a support impersonation is passed to the relying party in the actor claim
+	if actor.Reason != "" {
+		claims.Claims = map[string]any{ClaimImpersonationReason: actor.Reason}
+	}
+	return claims
 }
 
 func jwtToExchangeToken(jwt *oidc.JWTTokenRequest, resourceOwner string, preferredLanguage *language.Tag) *exchangeToken {
@@ -0,0 +1,34 @@
+package oidc
+
+import (
+	"context"
+
+	"github.com/zitadel/oidc/v3/pkg/oidc"
+
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/telemetry/tracing"
+)
+
+// PreviewTokenClaims returns the user claims of the ID token and the JWT access token
+// the client would receive for the user and scope, including the claims set by the actions of the complement token flow.
+// The actions are run as dry run, so they don't persist any changes (e.g. metadata).
+func (s *Server) PreviewTokenClaims(ctx context.Context, userID, clientID string, scope []string) (idToken, accessToken *oidc.UserInfo, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	client, err := s.query.GetOIDCClientByID(ctx, clientID, false)
+	if err != nil {
+		return nil, nil, err
+	}
+	ctx = withActionsDryRun(ctx)
+	getUserInfo := s.getUserInfo(userID, client.ProjectID, client.ProjectRoleAssertion, client.IDTokenUserinfoAssertion, scope, client.ClaimsMapping)
+	idToken, err = getUserInfo(ctx, client.IDTokenRoleAssertion, domain.TriggerTypePreUserinfoCreation)
+	if err != nil {
+		return nil, nil, err
+	}
+	accessToken, err = getUserInfo(ctx, client.AccessTokenRoleAssertion, domain.TriggerTypePreAccessTokenCreation)
+	if err != nil {
+		return nil, nil, err
+	}
+	return idToken, accessToken, nil
+}
@@ -45,25 +45,28 @@ func (s *Server) UserInfo(ctx context.Context, r *op.Request[oidc.UserInfoReques
 		return nil, op.NewStatusError(oidc.ErrAccessDenied().WithDescription("access token invalid").WithParent(err), http.StatusUnauthorized)
 	}
 
-	var (
-		projectID string
-		assertion bool
-	)
+	client := new(query.OIDCUserinfoClient)
 	if token.clientID != "" {
-		projectID, assertion, err = s.query.GetOIDCUserinfoClientByID(ctx, token.clientID)
+		c, err := s.query.GetOIDCUserinfoClientByID(ctx, token.clientID)
 		// token.clientID might contain a username (e.g. client credentials) -> ignore the not found
 		if err != nil && !zerrors.IsNotFound(err) {
 			return nil, err
 		}
+		if c != nil {
+			client = c
+		}
 	}
 
+	// the claims mapping of the client is applied the same way as for introspection,
+	// so both endpoints return the same user claims for an access token
 	userInfo, err := s.userInfo(
 		token.userID,
 		token.scope,
-		projectID,
-		assertion,
+		client.ProjectID,
+		client.ProjectRoleAssertion,
 		true,
 		false,
+		client.ClaimsMapping,
 	)(ctx, true, domain.TriggerTypePreUserinfoCreation)
 	if err != nil {
 		return nil, err
@@ -86,11 +89,18 @@ func (s *Server) UserInfo(ctx context.Context, r *op.Request[oidc.UserInfoReques
 // currentProjectOnly can be set to use the current project ID only and ignore the audience from the scope.
 // It should be set in cases where the client doesn't need to know roles outside its own project,
 // for example an introspection client.
+//
+// claimsMapping is an optional mapping of the application, which is applied to ID tokens, userinfo and introspection responses
+// ([domain.TriggerTypePreUserinfoCreation]), but not to access tokens.
+//
+// Standard claims of the profile, email, phone and address scopes are always removed if the scope was not granted,
+// even if they were set by an action.
 func (s *Server) userInfo(
 	userID string,
 	scope []string,
 	projectID string,
 	projectRoleAssertion, userInfoAssertion, currentProjectOnly bool,
+	claimsMapping *domain.ClaimsMapping,
 ) func(ctx context.Context, roleAssertion bool, triggerType domain.TriggerType) (_ *oidc.UserInfo, err error) {
 	var (
 		once                         sync.Once
@@ -98,7 +108,14 @@ func (s *Server) userInfo(
 		qu                           *query.OIDCUserInfo
 		roleAudience, requestedRoles []string
 	)
+	if claimsMapping != nil && claimsMapping.IncludeRoles {
+		projectRoleAssertion = true
+	}
 	return func(ctx context.Context, roleAssertion bool, triggerType domain.TriggerType) (_ *oidc.UserInfo, err error) {
+		applyMapping := claimsMapping != nil && triggerType == domain.TriggerTypePreUserinfoCreation
+		if applyMapping && claimsMapping.IncludeRoles {
+			roleAssertion = true
+		}
 		once.Do(func() {
 			ctx, span := tracing.NewSpan(ctx)
 			defer func() { span.EndWithError(err) }()
@@ -124,7 +141,14 @@ func (s *Server) userInfo(
 			Claims:          maps.Clone(rawUserInfo.Claims),
 		}
 		assertRoles(projectID, qu, roleAudience, requestedRoles, roleAssertion, userInfo)
-		return userInfo, s.userinfoFlows(ctx, qu, userInfo, triggerType)
+		if err = s.userinfoFlows(ctx, qu, userInfo, triggerType); err != nil {
+			return nil, err
+		}
+		if applyMapping {
+			applyClaimsMapping(claimsMapping, qu, userInfo)
+		}
+		restrictClaimsToScope(scope, userInfo)
+		return userInfo, nil
 	}
 }
 
@@ -205,6 +229,34 @@ func userInfoToOIDC(user *query.OIDCUserInfo, userInfoAssertion bool, scope []st
 	return out
 }
 
+// scopeClaims are the standard claims, which may only be returned if the corresponding scope is granted.
+var scopeClaims = map[string][]string{
+	oidc.ScopeProfile: {
+		"name", "given_name", "family_name", "middle_name", "nickname", "preferred_username", "profile",
+		"picture", "website", "gender", "birthdate", "zoneinfo", "locale", "updated_at",
+	},
+	oidc.ScopeEmail:   {"email", "email_verified"},
+	oidc.ScopePhone:   {"phone_number", "phone_number_verified"},
+	oidc.ScopeAddress: {"address"},
+}
+
+// restrictClaimsToScope removes the standard claims of scopes, which were not granted.
+// The typed fields are only set by [userInfoToOIDC] for granted scopes,
+// so only the custom claims (e.g. set by actions) need to be checked.
+func restrictClaimsToScope(scope []string, userInfo *oidc.UserInfo) {
+	if len(userInfo.Claims) == 0 {
+		return
+	}
+	for s, claims := range scopeClaims {
+		if slices.Contains(scope, s) {
+			continue
+		}
+		for _, claim := range claims {
+			delete(userInfo.Claims, claim)
+		}
+	}
+}
+
 func assertRoles(projectID string, user *query.OIDCUserInfo, roleAudience, requestedRoles []string, assertion bool, info *oidc.UserInfo) {
 	if !assertion {
 		return
@@ -326,6 +378,11 @@ func (s *Server) userinfoFlows(ctx context.Context, qu *query.OIDCUserInfo, user
 		),
 	)
 
+	if userInfo.Claims == nil {
+		userInfo.Claims = make(map[string]any)
+	}
+	v2Claims := newActionClaims(userInfo.Claims, s.actionClaimsMaxSize)
+
 	for _, action := range queriedActions {
 		actionCtx, cancel := context.WithTimeout(ctx, action.Timeout())
 		claimLogs := []string{}
@@ -376,6 +433,9 @@ func (s *Server) userinfoFlows(ctx context.Context, qu *query.OIDCUserInfo, user
 							panic(err)
 						}
 
+						if isActionsDryRun(ctx) {
+							return nil
+						}
 						metadata := &domain.Metadata{
 							Key:   key,
 							Value: value,
@@ -388,6 +448,7 @@ func (s *Server) userinfoFlows(ctx context.Context, qu *query.OIDCUserInfo, user
 					}),
 				),
 			),
+			v2Claims.fields(),
 		)
 
 		err = actions.Run(
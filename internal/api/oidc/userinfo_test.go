@@ -3,10 +3,12 @@ package oidc
 import (
 	"context"
 	"encoding/base64"
+	"encoding/json"
 	"testing"
 	"time"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	"github.com/zitadel/oidc/v3/pkg/oidc"
 	"golang.org/x/text/language"
 
@@ -490,3 +492,160 @@ func Test_userInfoToOIDC(t *testing.T) {
 		})
 	}
 }
+
+func Test_restrictClaimsToScope(t *testing.T) {
+	tests := []struct {
+		name   string
+		scope  []string
+		claims map[string]any
+		want   map[string]any
+	}{
+		{
+			name: "no claims",
+		},
+		{
+			name:   "custom claims",
+			claims: map[string]any{"foo": "bar"},
+			want:   map[string]any{"foo": "bar"},
+		},
+		{
+			name:  "scope claims not granted",
+			scope: []string{oidc.ScopeOpenID, oidc.ScopeProfile},
+			claims: map[string]any{
+				"foo":          "bar",
+				"nickname":     "foobar",
+				"email":        "foo@bar.ch",
+				"phone_number": "+41791234567",
+				"address":      "street",
+			},
+			want: map[string]any{
+				"foo":      "bar",
+				"nickname": "foobar",
+			},
+		},
+		{
+			name:  "scope claims granted",
+			scope: []string{oidc.ScopeOpenID, oidc.ScopeEmail, oidc.ScopePhone, oidc.ScopeAddress},
+			claims: map[string]any{
+				"email":        "foo@bar.ch",
+				"phone_number": "+41791234567",
+				"address":      "street",
+			},
+			want: map[string]any{
+				"email":        "foo@bar.ch",
+				"phone_number": "+41791234567",
+				"address":      "street",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			userInfo := &oidc.UserInfo{Claims: tt.claims}
+			restrictClaimsToScope(tt.scope, userInfo)
+			assert.Equal(t, tt.want, userInfo.Claims)
+		})
+	}
+}
+
+// Test_userInfo_introspectionParity makes sure the userinfo endpoint
+// and the introspection endpoint return the same user claims for the same token.
+func Test_userInfo_introspectionParity(t *testing.T) {
+	user := &query.OIDCUserInfo{
+		User: &query.User{
+			ID:                 "human1",
+			ChangeDate:         time.Unix(567, 890),
+			ResourceOwner:      "orgID",
+			Type:               domain.UserTypeHuman,
+			PreferredLoginName: "foo",
+			Human: &query.Human{
+				FirstName:       "user",
+				LastName:        "name",
+				DisplayName:     "xxx",
+				Email:           "foo@bar.ch",
+				IsEmailVerified: true,
+			},
+		},
+		Metadata: []query.UserMetadata{
+			{Key: "department", Value: []byte("sales")},
+			{Key: "billing.plan", Value: []byte("pro")},
+		},
+		UserGrants: []query.UserGrant{
+			{
+				ProjectID:        "projectID",
+				Roles:            []string{"user", "admin"},
+				ResourceOwner:    "orgID",
+				OrgPrimaryDomain: "org.com",
+			},
+		},
+	}
+	tests := []struct {
+		name        string
+		scope       []string
+		mapping     *domain.ClaimsMapping
+		claims      map[string]any
+		wantClaims  []string
+		wantRemoved []string
+	}{
+		{
+			name:       "no mapping",
+			scope:      []string{oidc.ScopeOpenID, oidc.ScopeProfile, ScopeProjectsRoles},
+			wantClaims: []string{"sub", "name", ClaimProjectRoles},
+		},
+		{
+			name:  "mapping",
+			scope: []string{oidc.ScopeOpenID, oidc.ScopeEmail, ScopeProjectsRoles},
+			mapping: &domain.ClaimsMapping{
+				MetadataKeys:       []string{"department"},
+				MetadataNamespaces: []string{"billing"},
+				RolesFormat:        domain.ClaimsRolesFormatList,
+				RenamedClaims:      map[string]string{ClaimProjectRoles: "roles"},
+			},
+			wantClaims:  []string{"sub", "email", "department", "billing", "roles"},
+			wantRemoved: []string{"name", ClaimProjectRoles},
+		},
+		{
+			name:        "action claims of scope not granted",
+			scope:       []string{oidc.ScopeOpenID, oidc.ScopeProfile},
+			claims:      map[string]any{"email": "other@bar.ch", "custom": "value"},
+			wantClaims:  []string{"sub", "custom"},
+			wantRemoved: []string{"email"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// the same steps as in [Server.userInfo], without querying and actions
+			userInfo := userInfoToOIDC(user, true, tt.scope, "https://foo.com/assets")
+			for claim, value := range tt.claims {
+				userInfo.AppendClaims(claim, value)
+			}
+			roleAudience, requestedRoles := prepareRoles(context.Background(), tt.scope, "projectID", true, false)
+			assertRoles("projectID", user, roleAudience, requestedRoles, true, userInfo)
+			applyClaimsMapping(tt.mapping, user, userInfo)
+			restrictClaimsToScope(tt.scope, userInfo)
+
+			introspection := &oidc.IntrospectionResponse{Active: true}
+			introspection.SetUserInfo(userInfo)
+
+			userInfoClaims := claimsFromJSON(t, userInfo)
+			introspectionClaims := claimsFromJSON(t, introspection)
+			for claim, value := range userInfoClaims {
+				assert.Equal(t, value, introspectionClaims[claim], "claim %q", claim)
+			}
+			for _, claim := range tt.wantClaims {
+				assert.Contains(t, introspectionClaims, claim)
+			}
+			for _, claim := range tt.wantRemoved {
+				assert.NotContains(t, userInfoClaims, claim)
+				assert.NotContains(t, introspectionClaims, claim)
+			}
+		})
+	}
+}
+
+func claimsFromJSON(t *testing.T, v any) map[string]any {
+	data, err := json.Marshal(v)
+	require.NoError(t, err)
+	claims := make(map[string]any)
+	require.NoError(t, json.Unmarshal(data, &claims))
+	return claims
+}
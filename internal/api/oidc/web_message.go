@@ -0,0 +1,113 @@
+package oidc
+
+import (
+	"crypto/rand"
+	"encoding/base64"
+	"html/template"
+	"net/http"
+	"net/url"
+
+	"github.com/zitadel/logging"
+	httphelper "github.com/zitadel/oidc/v3/pkg/http"
+	"github.com/zitadel/oidc/v3/pkg/oidc"
+	"github.com/zitadel/oidc/v3/pkg/op"
+
+	http_utils "github.com/zitadel/zitadel/internal/api/http"
+)
+
+// ResponseModeWebMessage returns the authorization response to the window,
+// which opened the authorization request as popup or embeds it in an (hidden) iframe,
+// by calling window.postMessage with the origin of the redirect_uri as target origin.
+const ResponseModeWebMessage oidc.ResponseMode = "web_message"
+
+// WebMessageType is the type of the message posted to the opener or parent window.
+const WebMessageType = "authorization_response"
+
+var webMessageTemplate = template.Must(template.New("web_message").Parse(`<!DOCTYPE html>
+<html>
+<head><title>Authorization Response</title></head>
+<body>
+<script nonce="{{.Nonce}}">
+(function () {
+	var target = window.opener || (window.parent !== window ? window.parent : null);
+	if (!target) {
+		return;
+	}
+	target.postMessage({type: {{.Type}}, response: {{.Response}}}, {{.Origin}});
+	if (window.opener) {
+		window.close();
+	}
+})();
+</script>
+</body>
+</html>`))
+
+type webMessageData struct {
+	Nonce    string
+	Type     string
+	Origin   string
+	Response map[string]string
+}
+
+// webMessageResponse renders a page, which posts the (successful or error) response
+// to the origin of the redirect_uri of the auth request.
+// Only the origin of the redirect_uri is allowed to embed the page.
+func webMessageResponse(w http.ResponseWriter, authReq op.AuthRequest, response any, encoder httphelper.Encoder) error {
+	origin, err := webMessageOrigin(authReq.GetRedirectURI())
+	if err != nil {
+		return err
+	}
+	params, err := httphelper.URLEncodeParams(response, encoder)
+	if err != nil {
+		return oidc.ErrServerError().WithParent(err)
+	}
+	data := webMessageData{
+		Type:     WebMessageType,
+		Origin:   origin,
+		Response: make(map[string]string, len(params)),
+	}
+	for key := range params {
+		data.Response[key] = params.Get(key)
+	}
+	data.Nonce, err = webMessageNonce()
+	if err != nil {
+		return oidc.ErrServerError().WithParent(err)
+	}
+	w.Header().Set(http_utils.ContentType, "text/html; charset=utf-8")
+	w.Header().Set(http_utils.CacheControl, "no-store")
+	w.Header().Set(http_utils.ReferrerPolicy, "no-referrer")
+	w.Header().Set(http_utils.ContentSecurityPolicy, "default-src 'none'; script-src 'nonce-"+data.Nonce+"'; frame-ancestors "+origin)
+	return webMessageTemplate.Execute(w, data)
+}
+
+// webMessageError returns the error to the application by postMessage.
+// If that's not possible, e.g. because the redirect_uri is not a web origin,
+// the error is rendered directly.
+func webMessageError(w http.ResponseWriter, authReq op.AuthRequest, err error, authorizer op.Authorizer) {
+	e := oidc.DefaultToServerError(err, err.Error())
+	e.State = authReq.GetState()
+	if err = webMessageResponse(w, authReq, e, authorizer.Encoder()); err != nil {
+		logging.WithError(err).Info("unable to return web message error")
+		http.Error(w, e.Description, http.StatusBadRequest)
+	}
+}
+
+// webMessageOrigin returns the origin of the redirect_uri, which the response is posted to.
+func webMessageOrigin(redirectURI string) (string, error) {
+	uri, err := url.Parse(redirectURI)
+	if err != nil {
+		return "", oidc.ErrInvalidRequestRedirectURI().WithParent(err)
+	}
+	if (uri.Scheme != "https" && uri.Scheme != "http") || uri.Host == "" {
+		return "", oidc.ErrInvalidRequestRedirectURI().WithDescription("response_mode web_message requires a http(s) redirect_uri")
+	}
+	return http_utils.BuildOrigin(uri.Host, uri.Scheme == "https"), nil
+}
+
+func webMessageNonce() (string, error) {
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return base64.StdEncoding.EncodeToString(b), nil
+}
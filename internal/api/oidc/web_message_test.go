@@ -0,0 +1,82 @@
+package oidc
+
+import (
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/zitadel/schema"
+
+	"github.com/zitadel/zitadel/internal/domain"
+)
+
+func Test_webMessageOrigin(t *testing.T) {
+	tests := []struct {
+		name        string
+		redirectURI string
+		want        string
+		wantErr     bool
+	}{
+		{
+			name:        "https",
+			redirectURI: "https://app.example.com/callback?foo=bar",
+			want:        "https://app.example.com",
+		},
+		{
+			name:        "http with port",
+			redirectURI: "http://localhost:3000/callback",
+			want:        "http://localhost:3000",
+		},
+		{
+			name:        "custom scheme",
+			redirectURI: "com.example.app:/callback",
+			wantErr:     true,
+		},
+		{
+			name:        "relative",
+			redirectURI: "/callback",
+			wantErr:     true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := webMessageOrigin(tt.redirectURI)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func Test_webMessageResponse(t *testing.T) {
+	authReq := &AuthRequest{
+		AuthRequest: &domain.AuthRequest{
+			CallbackURI:   "https://app.example.com/callback",
+			TransferState: "state</script>",
+			Request: &domain.AuthRequestOIDC{
+				ResponseMode: domain.OIDCResponseModeWebMessage,
+			},
+		},
+	}
+	response := struct {
+		Code  string `schema:"code"`
+		State string `schema:"state,omitempty"`
+	}{
+		Code:  "code",
+		State: authReq.GetState(),
+	}
+	w := httptest.NewRecorder()
+	err := webMessageResponse(w, authReq, &response, schema.NewEncoder())
+	require.NoError(t, err)
+
+	csp := w.Header().Get("Content-Security-Policy")
+	assert.Contains(t, csp, "frame-ancestors https://app.example.com")
+	assert.Contains(t, csp, "default-src 'none'")
+	body := w.Body.String()
+	assert.Contains(t, body, `target.postMessage({type: "authorization_response", response: {"code":"code","state":"state\u003c/script\u003e"}}, "https://app.example.com");`)
+	assert.NotContains(t, body, "state</script>")
+}
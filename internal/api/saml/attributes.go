@@ -0,0 +1,97 @@
+package saml
+
+import (
+	"context"
+	"slices"
+
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/query"
+)
+
+// getConfiguredAttributes returns the attribute statements configured on the application with the values of the user.
+// Attributes without value (e.g. missing metadata) are omitted.
+func (p *Storage) getConfiguredAttributes(ctx context.Context, applicationID string, user *query.User, userGrants *query.UserGrants) (map[string]*customAttribute, error) {
+	app, err := p.query.AppByID(ctx, applicationID)
+	if err != nil {
+		return nil, err
+	}
+	if app.SAMLConfig == nil || len(app.SAMLConfig.Attributes) == 0 {
+		return nil, nil
+	}
+	var metadata *query.UserMetadataList
+	if slices.ContainsFunc(app.SAMLConfig.Attributes, func(attribute *domain.SAMLAttribute) bool {
+		return attribute.Value == domain.SAMLAttributeValueMetadata
+	}) {
+		resourceOwnerQuery, err := query.NewUserMetadataResourceOwnerSearchQuery(user.ResourceOwner)
+		if err != nil {
+			return nil, err
+		}
+		metadata, err = p.query.SearchUserMetadata(ctx, true, user.ID, &query.UserMetadataSearchQueries{Queries: []query.SearchQuery{resourceOwnerQuery}}, false)
+		if err != nil {
+			return nil, err
+		}
+	}
+	attributes := make(map[string]*customAttribute, len(app.SAMLConfig.Attributes))
+	for _, attribute := range app.SAMLConfig.Attributes {
+		values := configuredAttributeValues(attribute, user, userGrants, metadata)
+		if len(values) == 0 {
+			continue
+		}
+		attributes[attribute.Name] = &customAttribute{
+			friendlyName:   attribute.FriendlyName,
+			nameFormat:     attribute.NameFormat,
+			attributeValue: values,
+		}
+	}
+	return attributes, nil
+}
+
+func configuredAttributeValues(attribute *domain.SAMLAttribute, user *query.User, userGrants *query.UserGrants, metadata *query.UserMetadataList) []string {
+	switch attribute.Value {
+	case domain.SAMLAttributeValueUsername:
+		return []string{user.PreferredLoginName}
+	case domain.SAMLAttributeValueUserID:
+		return []string{user.ID}
+	case domain.SAMLAttributeValueRoles:
+		if userGrants == nil {
+			return nil
+		}
+		roles := make([]string, 0)
+		for _, grant := range userGrants.UserGrants {
+			for _, role := range grant.Roles {
+				if !slices.Contains(roles, role) {
+					roles = append(roles, role)
+				}
+			}
+		}
+		return roles
+	case domain.SAMLAttributeValueMetadata:
+		if metadata == nil {
+			return nil
+		}
+		for _, md := range metadata.Metadata {
+			if md.Key == attribute.MetadataKey {
+				return []string{string(md.Value)}
+			}
+		}
+		return nil
+	}
+	if user.Human == nil {
+		return nil
+	}
+	var value string
+	switch attribute.Value {
+	case domain.SAMLAttributeValueEmail:
+		value = string(user.Human.Email)
+	case domain.SAMLAttributeValueGivenName:
+		value = user.Human.FirstName
+	case domain.SAMLAttributeValueSurname:
+		value = user.Human.LastName
+	case domain.SAMLAttributeValueFullName:
+		value = user.Human.DisplayName
+	}
+	if value == "" {
+		return nil
+	}
+	return []string{value}
+}
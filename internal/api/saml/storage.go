@@ -147,6 +147,16 @@ func (p *Storage) SetUserinfoWithUserID(ctx context.Context, applicationID strin
 	if err != nil {
 		return err
 	}
+	configuredAttributes, err := p.getConfiguredAttributes(ctx, applicationID, user, userGrants)
+	if err != nil {
+		return err
+	}
+	// attributes set by actions take precedence over the ones configured on the application
+	for name, attribute := range configuredAttributes {
+		if _, ok := customAttributes[name]; !ok {
+			customAttributes[name] = attribute
+		}
+	}
 
 	setUserinfo(user, userinfo, attributes, customAttributes)
 
@@ -170,7 +180,7 @@ func (p *Storage) SetUserinfoWithLoginName(ctx context.Context, userinfo models.
 
 func setUserinfo(user *query.User, userinfo models.AttributeSetter, attributes []int, customAttributes map[string]*customAttribute) {
 	for name, attr := range customAttributes {
-		userinfo.SetCustomAttribute(name, "", attr.nameFormat, attr.attributeValue)
+		userinfo.SetCustomAttribute(name, attr.friendlyName, attr.nameFormat, attr.attributeValue)
 	}
 	if len(attributes) == 0 {
 		userinfo.SetUsername(user.PreferredLoginName)
@@ -339,6 +349,7 @@ func (p *Storage) getGrants(ctx context.Context, userID, applicationID string) (
 }
 
 type customAttribute struct {
+	friendlyName   string
 	nameFormat     string
 	attributeValue []string
 }
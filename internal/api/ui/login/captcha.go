@@ -0,0 +1,103 @@
+package login
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/zitadel/logging"
+
+	http_utils "github.com/zitadel/zitadel/internal/api/http"
+	"github.com/zitadel/zitadel/internal/captcha"
+	"github.com/zitadel/zitadel/internal/crypto"
+	"github.com/zitadel/zitadel/internal/domain"
+)
+
+var captchaScriptHosts = []string{
+	"https://js.hcaptcha.com",
+	"https://*.hcaptcha.com",
+	"https://www.google.com/recaptcha/",
+	"https://www.gstatic.com/recaptcha/",
+	"https://challenges.cloudflare.com",
+}
+
+// captchaData is used to render the widget of the configured CAPTCHA provider
+type captchaData struct {
+	LabelKey    string
+	WidgetClass string
+	ScriptURL   string
+	SiteKey     string
+}
+
+func newCaptchaData(policy *domain.CaptchaPolicy, labelKey string) *captchaData {
+	if policy == nil {
+		return nil
+	}
+	data := &captchaData{
+		LabelKey: labelKey,
+		SiteKey:  policy.SiteKey,
+	}
+	switch policy.Type {
+	case domain.CaptchaTypeHCaptcha:
+		data.WidgetClass = "h-captcha"
+		data.ScriptURL = "https://js.hcaptcha.com/1/api.js"
+	case domain.CaptchaTypeReCaptcha:
+		data.WidgetClass = "g-recaptcha"
+		data.ScriptURL = "https://www.google.com/recaptcha/api.js"
+	case domain.CaptchaTypeTurnstile:
+		data.WidgetClass = "cf-turnstile"
+		data.ScriptURL = "https://challenges.cloudflare.com/turnstile/v0/api.js"
+	case domain.CaptchaTypeUnspecified:
+		return nil
+	}
+	return data
+}
+
+// getCaptchaData returns the data to render the CAPTCHA widget, if a challenge is required for the endpoint.
+// In case the policy cannot be read, no widget is rendered and the check will fail on submit.
+func (l *Login) getCaptchaData(ctx context.Context, orgID string, endpoint domain.CaptchaEndpoint, labelKey string) *captchaData {
+	policy, err := l.captchaPolicy(ctx, orgID, endpoint)
+	if err != nil {
+		logging.WithFields("orgID", orgID).WithError(err).Warn("unable to get captcha policy")
+		return nil
+	}
+	return newCaptchaData(policy, labelKey)
+}
+
+// captchaPolicy returns the captcha policy of the organisation if a challenge is required for the endpoint
+func (l *Login) captchaPolicy(ctx context.Context, orgID string, endpoint domain.CaptchaEndpoint) (*domain.CaptchaPolicy, error) {
+	policy, err := l.query.CaptchaPolicyByOrg(ctx, orgID)
+	if err != nil {
+		return nil, err
+	}
+	if !policy.IsEnabledOn(endpoint) {
+		return nil, nil
+	}
+	return policy, nil
+}
+
+// checkCaptcha verifies the response of the CAPTCHA widget, if a challenge is required for the endpoint
+func (l *Login) checkCaptcha(r *http.Request, orgID string, endpoint domain.CaptchaEndpoint) error {
+	policy, err := l.captchaPolicy(r.Context(), orgID, endpoint)
+	if err != nil || policy == nil {
+		return err
+	}
+	secret, err := crypto.DecryptString(policy.Secret, l.captchaAlg)
+	if err != nil {
+		return err
+	}
+	verifier, err := captcha.New(policy.Type, secret)
+	if err != nil {
+		return err
+	}
+	return verifier.Verify(r.Context(), captchaResponse(r), http_utils.RemoteIPStringFromRequest(r))
+}
+
+// captchaResponse returns the token the widget of any of the supported providers added to the form
+func captchaResponse(r *http.Request) string {
+	for _, field := range []string{"h-captcha-response", "g-recaptcha-response", "cf-turnstile-response"} {
+		if response := r.FormValue(field); response != "" {
+			return response
+		}
+	}
+	return ""
+}
@@ -0,0 +1,152 @@
+package login
+
+import (
+	"net/http"
+	"slices"
+	"strconv"
+
+	"golang.org/x/text/language"
+
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore/v1/models"
+	"github.com/zitadel/zitadel/internal/query"
+)
+
+const (
+	tmplCompleteProfile = "completeprofile"
+)
+
+type completeProfileFormData struct {
+	Phone    string        `schema:"phone"`
+	NickName string        `schema:"nickname"`
+	Gender   domain.Gender `schema:"gender"`
+	Language string        `schema:"language"`
+}
+
+type completeProfileData struct {
+	userData
+	Phone      bool
+	NickName   bool
+	Gender     bool
+	Language   bool
+	Attributes []*registerAttribute
+}
+
+func (l *Login) renderCompleteProfile(w http.ResponseWriter, r *http.Request, authReq *domain.AuthRequest, step *domain.CompleteProfileStep, err error) {
+	var errID, errMessage string
+	if err != nil {
+		errID, errMessage = l.getErrorMessage(r, err)
+	}
+	translator := l.getTranslator(r.Context(), authReq)
+	data := completeProfileData{
+		userData:   l.getUserData(r, authReq, translator, "CompleteProfile.Title", "CompleteProfile.Description", errID, errMessage),
+		Phone:      slices.Contains(step.Fields, domain.ProfileFieldPhone),
+		NickName:   slices.Contains(step.Fields, domain.ProfileFieldNickName),
+		Gender:     slices.Contains(step.Fields, domain.ProfileFieldGender),
+		Language:   slices.Contains(step.Fields, domain.ProfileFieldPreferredLanguage),
+		Attributes: registerAttributes(r, step.Attributes),
+	}
+	formLanguage := r.FormValue("language")
+	if formLanguage == "" {
+		formLanguage = l.renderer.ReqLang(translator, r).String()
+	}
+	funcs := map[string]interface{}{
+		"selectedLanguage": func(l string) bool {
+			return formLanguage == l
+		},
+		"selectedGender": func(g int32) bool {
+			return r.FormValue("gender") == strconv.Itoa(int(g))
+		},
+	}
+	l.renderer.RenderTemplate(w, r, translator, l.renderer.Templates[tmplCompleteProfile], data, funcs)
+}
+
+// handleCompleteProfile writes the missing profile fields and custom attributes of the user
+// through the same commands used to change them in the console and the APIs.
+func (l *Login) handleCompleteProfile(w http.ResponseWriter, r *http.Request) {
+	data := new(completeProfileFormData)
+	authReq, err := l.ensureAuthRequestAndParseData(r, data)
+	if err != nil {
+		l.renderError(w, r, authReq, err)
+		return
+	}
+	step, ok := completeProfileStep(authReq)
+	if !ok {
+		l.renderNextStep(w, r, authReq)
+		return
+	}
+	ctx := setContext(r.Context(), authReq.UserOrgID)
+	user, err := l.query.GetUserByID(ctx, false, authReq.UserID)
+	if err != nil {
+		l.renderCompleteProfile(w, r, authReq, step, err)
+		return
+	}
+	if slices.Contains(step.Fields, domain.ProfileFieldPhone) {
+		phoneCodeGenerator, err := l.query.InitEncryptionGenerator(ctx, domain.SecretGeneratorTypeVerifyPhoneCode, l.userCodeAlg)
+		if err != nil {
+			l.renderCompleteProfile(w, r, authReq, step, err)
+			return
+		}
+		_, err = l.command.ChangeHumanPhone(ctx, &domain.Phone{
+			ObjectRoot:  models.ObjectRoot{AggregateID: user.ID},
+			PhoneNumber: domain.PhoneNumber(data.Phone),
+		}, user.ResourceOwner, phoneCodeGenerator)
+		if err != nil {
+			l.renderCompleteProfile(w, r, authReq, step, err)
+			return
+		}
+	}
+	if profile := completedProfile(user, step.Fields, data); profile != nil {
+		if _, err = l.command.ChangeHumanProfile(ctx, profile); err != nil {
+			l.renderCompleteProfile(w, r, authReq, step, err)
+			return
+		}
+	}
+	if metadata := attributeMetadata(r, step.Attributes); len(metadata) > 0 {
+		if _, err = l.command.BulkSetUserMetadata(ctx, user.ID, user.ResourceOwner, metadata...); err != nil {
+			l.renderCompleteProfile(w, r, authReq, step, err)
+			return
+		}
+	}
+	l.renderNextStep(w, r, authReq)
+}
+
+// completedProfile returns the current profile of the user with the entered values of the required fields,
+// or nil if none of the required fields is part of the profile.
+func completedProfile(user *query.User, fields []domain.ProfileField, data *completeProfileFormData) *domain.Profile {
+	profile := &domain.Profile{
+		ObjectRoot:        models.ObjectRoot{AggregateID: user.ID},
+		FirstName:         user.Human.FirstName,
+		LastName:          user.Human.LastName,
+		NickName:          user.Human.NickName,
+		DisplayName:       user.Human.DisplayName,
+		PreferredLanguage: user.Human.PreferredLanguage,
+		Gender:            user.Human.Gender,
+	}
+	var changed bool
+	for _, field := range fields {
+		switch field {
+		case domain.ProfileFieldNickName:
+			profile.NickName, changed = data.NickName, true
+		case domain.ProfileFieldGender:
+			profile.Gender, changed = data.Gender, true
+		case domain.ProfileFieldPreferredLanguage:
+			profile.PreferredLanguage, changed = language.Make(data.Language), true
+		case domain.ProfileFieldPhone,
+			domain.ProfileFieldUnspecified:
+		}
+	}
+	if !changed {
+		return nil
+	}
+	return profile
+}
+
+func completeProfileStep(authReq *domain.AuthRequest) (*domain.CompleteProfileStep, bool) {
+	for _, step := range authReq.PossibleSteps {
+		if profile, ok := step.(*domain.CompleteProfileStep); ok {
+			return profile, true
+		}
+	}
+	return nil, false
+}
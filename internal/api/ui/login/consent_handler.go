@@ -0,0 +1,107 @@
+package login
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/zitadel/oidc/v3/pkg/oidc"
+
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/i18n"
+)
+
+const (
+	tmplConsent = "consent"
+
+	scopeProjectRolePrefix = "urn:zitadel:iam:org:project:role:"
+)
+
+// consentScopeTexts maps the standard scopes to their description in the consent screen
+var consentScopeTexts = map[string]string{
+	oidc.ScopeOpenID:        "Consent.Scopes.OpenID",
+	oidc.ScopeProfile:       "Consent.Scopes.Profile",
+	oidc.ScopeEmail:         "Consent.Scopes.Email",
+	oidc.ScopePhone:         "Consent.Scopes.Phone",
+	oidc.ScopeAddress:       "Consent.Scopes.Address",
+	oidc.ScopeOfflineAccess: "Consent.Scopes.OfflineAccess",
+}
+
+type consentFormData struct {
+	Deny bool `schema:"deny"`
+}
+
+type consentData struct {
+	userData
+	AppName string
+	Scopes  []string
+	Roles   []string
+}
+
+func (l *Login) renderConsent(w http.ResponseWriter, r *http.Request, authReq *domain.AuthRequest, step *domain.ConsentStep, err error) {
+	var errID, errMessage string
+	if err != nil {
+		errID, errMessage = l.getErrorMessage(r, err)
+	}
+	translator := l.getTranslator(r.Context(), authReq)
+	data := consentData{
+		userData: l.getUserData(r, authReq, translator, "Consent.Title", "Consent.Description", errID, errMessage),
+		AppName:  authReq.ApplicationID,
+	}
+	if app, err := l.query.AppByID(r.Context(), step.AppID); err == nil {
+		data.AppName = app.Name
+	}
+	data.Scopes, data.Roles = consentScopeDescriptions(translator, step.Scopes)
+	l.renderer.RenderTemplate(w, r, translator, l.renderer.Templates[tmplConsent], data, nil)
+}
+
+// consentScopeDescriptions returns the translated descriptions of the standard scopes
+// and the keys of the requested project roles.
+// Other scopes are returned as they were requested.
+func consentScopeDescriptions(translator *i18n.Translator, scopes []string) (descriptions, roles []string) {
+	for _, scope := range scopes {
+		if role, ok := strings.CutPrefix(scope, scopeProjectRolePrefix); ok {
+			roles = append(roles, role)
+			continue
+		}
+		if key, ok := consentScopeTexts[scope]; ok {
+			descriptions = append(descriptions, translator.LocalizeWithoutArgs(key))
+			continue
+		}
+		descriptions = append(descriptions, scope)
+	}
+	return descriptions, roles
+}
+
+func (l *Login) handleConsent(w http.ResponseWriter, r *http.Request) {
+	data := new(consentFormData)
+	authReq, err := l.ensureAuthRequestAndParseData(r, data)
+	if err != nil {
+		l.renderError(w, r, authReq, err)
+		return
+	}
+	step, ok := consentStep(authReq)
+	if !ok {
+		l.renderNextStep(w, r, authReq)
+		return
+	}
+	// the callback returns access_denied to the application as long as the consent is pending
+	if data.Deny {
+		l.redirectToCallback(w, r, authReq)
+		return
+	}
+	_, err = l.command.GrantHumanConsent(setContext(r.Context(), authReq.UserOrgID), authReq.UserID, authReq.UserOrgID, step.AppID, step.Scopes)
+	if err != nil {
+		l.renderConsent(w, r, authReq, step, err)
+		return
+	}
+	l.renderNextStep(w, r, authReq)
+}
+
+func consentStep(authReq *domain.AuthRequest) (*domain.ConsentStep, bool) {
+	for _, step := range authReq.PossibleSteps {
+		if consent, ok := step.(*domain.ConsentStep); ok {
+			return consent, true
+		}
+	}
+	return nil, false
+}
@@ -396,6 +396,13 @@ func (l *Login) handleExternalUserAuthenticated(
 	callback func(w http.ResponseWriter, r *http.Request, authReq *domain.AuthRequest),
 ) {
 	externalUser := mapIDPUserToExternalUser(user, provider.ID)
+	roleMapping, err := l.query.IDPRoleMappingByID(r.Context(), provider.ID, provider.ResourceOwner)
+	if err != nil {
+		l.renderError(w, r, authReq, err)
+		return
+	}
+	claims := idp.Claims(user, domain.IDPRoleMappingClaims(roleMapping.Mappings))
+	externalUser.Claims = claims
 	// ensure the linked IDP is added to the login policy
 	if err := l.authRepo.SelectExternalIDP(r.Context(), authReq.ID, provider.ID, authReq.AgentID); err != nil {
 		l.renderError(w, r, authReq, err)
@@ -418,7 +425,6 @@ func (l *Login) handleExternalUserAuthenticated(
 			externalErr = nil
 		}
 	}
-	var err error
 	// read current auth request state (incl. authorized user)
 	authReq, err = l.authRepo.AuthRequestByID(r.Context(), authReq.ID, authReq.AgentID)
 	if err != nil {
@@ -430,6 +436,22 @@ func (l *Login) handleExternalUserAuthenticated(
 		l.renderError(w, r, authReq, err)
 		return
 	}
+	// link the external user directly to the user with the same verified email if enabled
+	if zerrors.IsNotFound(externalErr) && provider.AutoLinking == domain.AutoLinkingOptionVerifiedEmail {
+		linked, err := l.autoLinkByVerifiedEmail(r, authReq, provider, externalUser)
+		if err != nil {
+			l.renderError(w, r, authReq, err)
+			return
+		}
+		if linked {
+			externalErr = nil
+			authReq, err = l.authRepo.AuthRequestByID(r.Context(), authReq.ID, authReq.AgentID)
+			if err != nil {
+				l.renderError(w, r, authReq, err)
+				return
+			}
+		}
+	}
 	// if action is done and no user linked then link or register
 	if zerrors.IsNotFound(externalErr) {
 		l.externalUserNotExisting(w, r, authReq, provider, externalUser, externalUserChange)
@@ -449,6 +471,10 @@ func (l *Login) handleExternalUserAuthenticated(
 			return
 		}
 	}
+	if err = l.applyIDPRoleMapping(r.Context(), roleMapping.Mappings, claims, authReq.UserID, authReq.UserOrgID); err != nil {
+		l.renderError(w, r, authReq, err)
+		return
+	}
 	callback(w, r, authReq)
 }
 
@@ -481,8 +507,10 @@ func (l *Login) checkAutoLinking(w http.ResponseWriter, r *http.Request, authReq
 			return false
 		}
 		queries = append(queries, usernameQuery)
-	case domain.AutoLinkingOptionEmail:
+	case domain.AutoLinkingOptionEmail, domain.AutoLinkingOptionVerifiedEmail:
 		// Email will always be checked against verified email addresses.
+		// In case of the verified email option, we only get here if the email could not be linked automatically,
+		// e.g. because the IdP did not verify the email, so we'll let the user confirm the linking by authenticating.
 		emailQuery, err := query.NewUserVerifiedEmailSearchQuery(string(externalUser.Email))
 		if err != nil {
 			return false
@@ -505,6 +533,53 @@ func (l *Login) checkAutoLinking(w http.ResponseWriter, r *http.Request, authReq
 	return true
 }
 
+// autoLinkByVerifiedEmail links the external user to an existing user without user interaction,
+// if the IdP returned the email as verified and exactly one user with the same verified email exists
+// in the requested organization or in the organization of the IdP.
+// Without such an organization the user has to confirm the linking (see checkAutoLinking).
+// The function returns a boolean whether the user was linked or not.
+func (l *Login) autoLinkByVerifiedEmail(r *http.Request, authReq *domain.AuthRequest, provider *query.IDPTemplate, externalUser *domain.ExternalUser) (bool, error) {
+	if externalUser.Email == "" || !externalUser.IsEmailVerified {
+		return false, nil
+	}
+	orgID := authReq.RequestedOrgID
+	if orgID == "" && provider.ResourceOwner != authz.GetInstance(r.Context()).InstanceID() {
+		orgID = provider.ResourceOwner
+	}
+	if orgID == "" {
+		return false, nil
+	}
+	emailQuery, err := query.NewUserEmailSearchQuery(string(externalUser.Email), query.TextEqualsIgnoreCase)
+	if err != nil {
+		return false, err
+	}
+	verifiedQuery, err := query.NewUserIsEmailVerifiedSearchQuery(true)
+	if err != nil {
+		return false, err
+	}
+	resourceOwnerQuery, err := query.NewUserResourceOwnerSearchQuery(orgID, query.TextEquals)
+	if err != nil {
+		return false, err
+	}
+	// the second user is enough to know that the email is ambiguous
+	users, err := l.query.SearchUsers(r.Context(), &query.UserSearchQueries{
+		SearchRequest: query.SearchRequest{Limit: 2},
+		Queries:       []query.SearchQuery{emailQuery, verifiedQuery, resourceOwnerQuery},
+	})
+	if err != nil {
+		return false, err
+	}
+	if len(users.Users) != 1 {
+		return false, nil
+	}
+	user := users.Users[0]
+	err = l.authRepo.AutoLinkExternalUser(setContext(r.Context(), user.ResourceOwner), authReq.ID, authReq.AgentID, user.ID, externalUser, domain.BrowserInfoFromRequest(r))
+	if err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
 // externalUserNotExisting is called if an externalAuthentication couldn't find a corresponding externalID
 // possible solutions are:
 //
@@ -735,11 +810,34 @@ func (l *Login) registerExternalUser(w http.ResponseWriter, r *http.Request, aut
 		l.renderError(w, r, authReq, err)
 		return
 	}
+	provider, err := l.getIDPByID(r, externalUser.IDPConfigID)
+	if err != nil {
+		l.renderError(w, r, authReq, err)
+		return
+	}
+	// grant the configured project roles, so the user is able to use the projects right after the first login
+	autoGrants, err := l.query.IDPAutoGrantsByID(r.Context(), provider.ID, provider.ResourceOwner)
+	if err != nil {
+		l.renderError(w, r, authReq, err)
+		return
+	}
+	userGrants = domain.MergeIDPAutoGrants(userGrants, autoGrants.Grants, authReq.UserID)
 	err = l.appendUserGrants(r.Context(), userGrants, resourceOwner)
 	if err != nil {
 		l.renderError(w, r, authReq, err)
 		return
 	}
+	roleMapping, err := l.query.IDPRoleMappingByID(r.Context(), provider.ID, provider.ResourceOwner)
+	if err != nil {
+		l.renderError(w, r, authReq, err)
+		return
+	}
+	err = l.applyIDPRoleMapping(r.Context(), roleMapping.Mappings, externalUser.Claims, authReq.UserID, resourceOwner)
+	if err != nil {
+		l.renderError(w, r, authReq, err)
+		return
+	}
+	l.autoJoinOrg(r.Context(), authReq.UserID, resourceOwner)
 	l.renderNextStep(w, r, authReq)
 }
 
@@ -894,6 +992,19 @@ func (l *Login) ldapProvider(ctx context.Context, identityProvider *query.IDPTem
 	if !identityProvider.LDAPIDPTemplate.StartTLS {
 		opts = append(opts, ldap.WithoutStartTLS())
 	}
+	if len(identityProvider.LDAPIDPTemplate.RootCA) > 0 {
+		opts = append(opts, ldap.WithRootCA(identityProvider.LDAPIDPTemplate.RootCA))
+	}
+	if identityProvider.LDAPIDPTemplate.GroupResolution != domain.LDAPGroupResolutionUnspecified {
+		opts = append(opts, ldap.WithGroups(
+			identityProvider.LDAPIDPTemplate.GroupResolution,
+			identityProvider.LDAPIDPTemplate.GroupBase,
+			identityProvider.LDAPIDPTemplate.GroupNameAttribute,
+		))
+	}
+	if len(identityProvider.LDAPIDPTemplate.LDAPAttributes.MetadataAttributes) > 0 {
+		opts = append(opts, ldap.WithMetadataAttributes(identityProvider.LDAPIDPTemplate.LDAPAttributes.MetadataAttributes))
+	}
 	if identityProvider.LDAPIDPTemplate.LDAPAttributes.IDAttribute != "" {
 		opts = append(opts, ldap.WithCustomIDAttribute(identityProvider.LDAPIDPTemplate.LDAPAttributes.IDAttribute))
 	}
@@ -1013,12 +1124,16 @@ func (l *Login) oauthProvider(ctx context.Context, identityProvider *query.IDPTe
 		RedirectURL: l.baseURL(ctx) + EndpointExternalLoginCallback,
 		Scopes:      identityProvider.OAuthIDPTemplate.Scopes,
 	}
+	attributeMapping, err := oauth.NewAttributeMapping(identityProvider.OAuthIDPTemplate.AttributeMapping)
+	if err != nil {
+		return nil, err
+	}
 	return oauth.New(
 		config,
 		identityProvider.Name,
 		identityProvider.OAuthIDPTemplate.UserEndpoint,
 		func() idp.User {
-			return oauth.NewUserMapper(identityProvider.OAuthIDPTemplate.IDAttribute)
+			return oauth.NewUserMapperWithAttributeMapping(identityProvider.OAuthIDPTemplate.IDAttribute, attributeMapping)
 		},
 	)
 }
@@ -1077,13 +1192,19 @@ func (l *Login) azureProvider(ctx context.Context, identityProvider *query.IDPTe
 	if err != nil {
 		return nil, err
 	}
-	opts := make([]azuread.ProviderOptions, 0, 2)
+	opts := make([]azuread.ProviderOptions, 0, 4)
 	if identityProvider.AzureADIDPTemplate.IsEmailVerified {
 		opts = append(opts, azuread.WithEmailVerified())
 	}
 	if identityProvider.AzureADIDPTemplate.Tenant != "" {
 		opts = append(opts, azuread.WithTenant(azuread.TenantType(identityProvider.AzureADIDPTemplate.Tenant)))
 	}
+	if len(identityProvider.AzureADIDPTemplate.AllowedTenants) > 0 {
+		opts = append(opts, azuread.WithAllowedTenants(identityProvider.AzureADIDPTemplate.AllowedTenants...))
+	}
+	if identityProvider.AzureADIDPTemplate.FetchGroups {
+		opts = append(opts, azuread.WithGroups())
+	}
 	return azuread.New(
 		identityProvider.Name,
 		identityProvider.AzureADIDPTemplate.ClientID,
@@ -1180,6 +1301,37 @@ func (l *Login) appendUserGrants(ctx context.Context, userGrants []*domain.UserG
 	return nil
 }
 
+// applyIDPRoleMapping updates the org membership and the user grants of the user according to the claims provided by the IDP,
+// so roles removed from the user in the IDP are removed in ZITADEL as well
+func (l *Login) applyIDPRoleMapping(ctx context.Context, mappings []*domain.IDPRoleMapping, claims map[string][]string, userID, resourceOwner string) error {
+	if len(mappings) == 0 {
+		return nil
+	}
+	userIDQuery, err := query.NewUserGrantUserIDSearchQuery(userID)
+	if err != nil {
+		return err
+	}
+	resourceOwnerQuery, err := query.NewUserGrantResourceOwnerSearchQuery(resourceOwner)
+	if err != nil {
+		return err
+	}
+	grants, err := l.query.UserGrants(ctx, &query.UserGrantsQueries{Queries: []query.SearchQuery{userIDQuery, resourceOwnerQuery}}, true)
+	if err != nil {
+		return err
+	}
+	userGrants := make([]*domain.UserGrant, len(grants.UserGrants))
+	for i, grant := range grants.UserGrants {
+		userGrants[i] = &domain.UserGrant{
+			ObjectRoot:     models.ObjectRoot{AggregateID: grant.ID},
+			UserID:         grant.UserID,
+			ProjectID:      grant.ProjectID,
+			ProjectGrantID: grant.GrantID,
+			RoleKeys:       grant.Roles,
+		}
+	}
+	return l.command.ApplyIDPRoleMapping(setContext(ctx, resourceOwner), userID, resourceOwner, mappings, claims, userGrants)
+}
+
 func (l *Login) externalAuthFailed(w http.ResponseWriter, r *http.Request, authReq *domain.AuthRequest, tokens *oidc.Tokens[*oidc.IDTokenClaims], user idp.User, err error) {
 	if authReq == nil {
 		l.renderLogin(w, r, authReq, err)
@@ -1222,6 +1374,7 @@ func mapIDPUserToExternalUser(user idp.User, id string) *domain.ExternalUser {
 		PreferredLanguage: user.GetPreferredLanguage(),
 		Phone:             user.GetPhone(),
 		IsPhoneVerified:   user.IsPhoneVerified(),
+		Metadatas:         idp.Metadata(user),
 	}
 }
 
@@ -0,0 +1,109 @@
+package login
+
+import (
+	"net/http"
+
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore/v1/models"
+	"github.com/zitadel/zitadel/internal/query"
+)
+
+const (
+	tmplLinkedIDPs = "linkedidps"
+)
+
+type linkedIDPsData struct {
+	userData
+	Links []*query.IDPUserLink
+}
+
+type linkedIDPsFormData struct {
+	IDPID          string `schema:"idpID"`
+	ExternalUserID string `schema:"externalUserID"`
+}
+
+// handleLinkedIDPs lists the identity providers linked to the user of the auth request
+func (l *Login) handleLinkedIDPs(w http.ResponseWriter, r *http.Request) {
+	authReq, err := l.ensureAuthRequest(r)
+	if err != nil {
+		l.renderError(w, r, authReq, err)
+		return
+	}
+	if !userAuthenticated(authReq) {
+		l.renderNextStep(w, r, authReq)
+		return
+	}
+	l.renderLinkedIDPs(w, r, authReq, nil)
+}
+
+// handleUnlinkIDP removes the link to the selected identity provider,
+// as long as the user is still able to log in afterwards
+func (l *Login) handleUnlinkIDP(w http.ResponseWriter, r *http.Request) {
+	data := new(linkedIDPsFormData)
+	authReq, err := l.ensureAuthRequestAndParseData(r, data)
+	if err != nil {
+		l.renderError(w, r, authReq, err)
+		return
+	}
+	if !userAuthenticated(authReq) {
+		l.renderNextStep(w, r, authReq)
+		return
+	}
+	_, err = l.command.RemoveMyUserIDPLink(setUserContext(r.Context(), authReq.UserID, authReq.UserOrgID), &domain.UserIDPLink{
+		ObjectRoot: models.ObjectRoot{
+			AggregateID:   authReq.UserID,
+			ResourceOwner: authReq.UserOrgID,
+		},
+		IDPConfigID:    data.IDPID,
+		ExternalUserID: data.ExternalUserID,
+	})
+	l.renderLinkedIDPs(w, r, authReq, err)
+}
+
+func (l *Login) renderLinkedIDPs(w http.ResponseWriter, r *http.Request, authReq *domain.AuthRequest, err error) {
+	var errID, errMessage string
+	if err != nil {
+		errID, errMessage = l.getErrorMessage(r, err)
+	}
+	translator := l.getTranslator(r.Context(), authReq)
+	data := &linkedIDPsData{
+		userData: l.getUserData(r, authReq, translator, "LinkedIDPs.Title", "LinkedIDPs.Description", errID, errMessage),
+	}
+	userIDQuery, err := query.NewIDPUserLinksUserIDSearchQuery(authReq.UserID)
+	if err != nil {
+		l.renderError(w, r, authReq, err)
+		return
+	}
+	links, err := l.query.IDPUserLinks(r.Context(), &query.IDPUserLinksSearchQuery{Queries: []query.SearchQuery{userIDQuery}}, false)
+	if err != nil {
+		l.renderError(w, r, authReq, err)
+		return
+	}
+	data.Links = links.Links
+	l.renderer.RenderTemplate(w, r, translator, l.renderer.Templates[tmplLinkedIDPs], data, nil)
+}
+
+// userAuthenticated checks that the user of the auth request completed the authentication,
+// so only steps after the login (e.g. terms, consent or the redirect) remain
+func userAuthenticated(authReq *domain.AuthRequest) bool {
+	if authReq == nil || authReq.UserID == "" || len(authReq.PossibleSteps) == 0 {
+		return false
+	}
+	for _, step := range authReq.PossibleSteps {
+		switch step.Type() {
+		case domain.NextStepRedirectToCallback,
+			domain.NextStepLoginSucceeded,
+			domain.NextStepAcceptTerms,
+			domain.NextStepConsent,
+			domain.NextStepCompleteProfile,
+			domain.NextStepPasskeyPrompt,
+			domain.NextStepPasswordlessRegistrationPrompt,
+			domain.NextStepGrantRequired,
+			domain.NextStepProjectRequired:
+			continue
+		default:
+			return false
+		}
+	}
+	return true
+}
@@ -3,6 +3,7 @@ package login
 import (
 	"context"
 	"net/http"
+	"slices"
 	"strings"
 	"time"
 
@@ -35,8 +36,10 @@ type Login struct {
 	externalSecure      bool
 	consolePath         string
 	oidcAuthCallbackURL func(context.Context, string) string
+	oidcEndSessionURL   func(context.Context) string
 	samlAuthCallbackURL func(context.Context, string) string
 	idpConfigAlg        crypto.EncryptionAlgorithm
+	captchaAlg          crypto.EncryptionAlgorithm
 	userCodeAlg         crypto.EncryptionAlgorithm
 }
 
@@ -51,9 +54,10 @@ type Config struct {
 }
 
 const (
-	login                = "LOGIN"
-	HandlerPrefix        = "/ui/login"
-	DefaultLoggedOutPath = HandlerPrefix + EndpointLogoutDone
+	login                  = "LOGIN"
+	HandlerPrefix          = "/ui/login"
+	DefaultLoggedOutPath   = HandlerPrefix + EndpointLogoutDone
+	LogoutConfirmationPath = HandlerPrefix + EndpointLogoutConfirmation
 )
 
 func CreateLogin(config Config,
@@ -63,6 +67,7 @@ func CreateLogin(config Config,
 	staticStorage static.Storage,
 	consolePath string,
 	oidcAuthCallbackURL func(context.Context, string) string,
+	oidcEndSessionURL func(context.Context) string,
 	samlAuthCallbackURL func(context.Context, string) string,
 	externalSecure bool,
 	userAgentCookie,
@@ -73,10 +78,12 @@ func CreateLogin(config Config,
 	accessHandler mux.MiddlewareFunc,
 	userCodeAlg crypto.EncryptionAlgorithm,
 	idpConfigAlg crypto.EncryptionAlgorithm,
+	captchaAlg crypto.EncryptionAlgorithm,
 	csrfCookieKey []byte,
 ) (*Login, error) {
 	login := &Login{
 		oidcAuthCallbackURL: oidcAuthCallbackURL,
+		oidcEndSessionURL:   oidcEndSessionURL,
 		samlAuthCallbackURL: samlAuthCallbackURL,
 		externalSecure:      externalSecure,
 		consolePath:         consolePath,
@@ -85,6 +92,7 @@ func CreateLogin(config Config,
 		staticStorage:       staticStorage,
 		authRepo:            authRepo,
 		idpConfigAlg:        idpConfigAlg,
+		captchaAlg:          captchaAlg,
 		userCodeAlg:         userCodeAlg,
 	}
 	csrfInterceptor := createCSRFInterceptor(config.CSRFCookieName, csrfCookieKey, externalSecure, login.csrfErrorHandler())
@@ -101,10 +109,23 @@ func csp() *middleware.CSP {
 	csp := middleware.DefaultSCP
 	csp.ObjectSrc = middleware.CSPSourceOptsSelf()
 	csp.StyleSrc = csp.StyleSrc.AddNonce()
-	csp.ScriptSrc = csp.ScriptSrc.AddNonce().AddHash("sha256", "AjPdJSbZmeWHnEc5ykvJFay8FTWeTeRbs9dutfZ0HqE=")
+	csp.ScriptSrc = csp.ScriptSrc.AddNonce().AddHash("sha256", "AjPdJSbZmeWHnEc5ykvJFay8FTWeTeRbs9dutfZ0HqE=").AddHost(captchaScriptHosts...)
+	csp.FrameSrc = middleware.CSPSourceOpts().AddHost(captchaScriptHosts...)
+	csp.ConnectSrc = csp.ConnectSrc.AddHost(captchaScriptHosts...)
 	return &csp
 }
 
+// setFrameAncestors allows the origins of the application to embed the page,
+// in addition to the ones allowed by the security policy of the instance.
+func setFrameAncestors(w http.ResponseWriter, r *http.Request, frameAncestors []string) {
+	if len(frameAncestors) == 0 {
+		return
+	}
+	allowed := slices.Concat(authz.GetInstance(r.Context()).SecurityPolicyAllowedOrigins(), frameAncestors)
+	w.Header().Set(http_utils.ContentSecurityPolicy, csp().Value(middleware.GetNonce(r), r.Host, allowed))
+	w.Header().Del(http_utils.XFrameOptions)
+}
+
 func createCSRFInterceptor(cookieName string, csrfCookieKey []byte, externalSecure bool, errorHandler http.Handler) func(http.Handler) http.Handler {
 	path := "/"
 	return func(handler http.Handler) http.Handler {
@@ -16,8 +16,9 @@ const (
 )
 
 type loginData struct {
-	LoginName string `schema:"loginName"`
-	Register  bool   `schema:"register"`
+	LoginName  string `schema:"loginName"`
+	Register   bool   `schema:"register"`
+	BreakGlass bool   `schema:"breakGlass"`
 }
 
 func LoginLink(origin, orgID string) string {
@@ -61,6 +62,17 @@ func (l *Login) handleLoginName(w http.ResponseWriter, r *http.Request) {
 	l.renderLogin(w, r, authReq, nil)
 }
 
+// handleBreakGlassLogin renders the login without identity providers,
+// so break-glass users can log in locally even if the external identity providers are unavailable
+func (l *Login) handleBreakGlassLogin(w http.ResponseWriter, r *http.Request) {
+	authReq, err := l.getAuthRequest(r)
+	if err != nil {
+		l.renderError(w, r, authReq, err)
+		return
+	}
+	l.renderBreakGlassLogin(w, r, authReq, nil)
+}
+
 func (l *Login) handleLoginNameCheck(w http.ResponseWriter, r *http.Request) {
 	data := new(loginData)
 	authReq, err := l.getAuthRequestAndParseData(r, data)
@@ -82,7 +94,14 @@ func (l *Login) handleLoginNameCheck(w http.ResponseWriter, r *http.Request) {
 	}
 	userAgentID, _ := http_mw.UserAgentIDFromCtx(r.Context())
 	loginName := data.LoginName
+	if !data.BreakGlass && l.discoverIDP(w, r, authReq, loginName) {
+		return
+	}
 	err = l.authRepo.CheckLoginName(r.Context(), authReq.ID, loginName, userAgentID)
+	if err != nil && data.BreakGlass {
+		l.renderBreakGlassLogin(w, r, authReq, err)
+		return
+	}
 	if err != nil {
 		l.renderLogin(w, r, authReq, err)
 		return
@@ -91,30 +110,63 @@ func (l *Login) handleLoginNameCheck(w http.ResponseWriter, r *http.Request) {
 }
 
 func (l *Login) renderLogin(w http.ResponseWriter, r *http.Request, authReq *domain.AuthRequest, err error) {
-	var errID, errMessage string
-	if err != nil {
-		errID, errMessage = l.getErrorMessage(r, err)
-	}
 	if err == nil && singleIDPAllowed(authReq) {
 		l.handleIDP(w, r, authReq, authReq.AllowedExternalIDPs[0].IDPConfigID)
 		return
 	}
+	l.renderLoginPage(w, r, authReq, err, false)
+}
+
+// renderBreakGlassLogin always renders the login name field and omits identity providers and the registration
+func (l *Login) renderBreakGlassLogin(w http.ResponseWriter, r *http.Request, authReq *domain.AuthRequest, err error) {
+	l.renderLoginPage(w, r, authReq, err, true)
+}
+
+func (l *Login) renderLoginPage(w http.ResponseWriter, r *http.Request, authReq *domain.AuthRequest, err error, breakGlass bool) {
+	var errID, errMessage string
+	if err != nil {
+		errID, errMessage = l.getErrorMessage(r, err)
+	}
 	translator := l.getTranslator(r.Context(), authReq)
 	data := l.getUserData(r, authReq, translator, "Login.Title", "Login.Description", errID, errMessage)
 	funcs := map[string]interface{}{
 		"hasUsernamePasswordLogin": func() bool {
-			return authReq != nil && authReq.LoginPolicy != nil && authReq.LoginPolicy.AllowUsernamePassword
+			return breakGlass || authReq != nil && authReq.LoginPolicy != nil && authReq.LoginPolicy.AllowUsernamePassword
 		},
 		"hasExternalLogin": func() bool {
-			return authReq != nil && authReq.LoginPolicy != nil && authReq.LoginPolicy.AllowExternalIDP && authReq.AllowedExternalIDPs != nil && len(authReq.AllowedExternalIDPs) > 0
+			return !breakGlass && authReq != nil && authReq.LoginPolicy != nil && authReq.LoginPolicy.AllowExternalIDP && len(domain.VisibleIDPProviders(authReq.AllowedExternalIDPs, authReq.LoginName)) > 0
 		},
 		"hasRegistration": func() bool {
-			return authReq != nil && authReq.LoginPolicy != nil && authReq.LoginPolicy.AllowRegister
+			return !breakGlass && authReq != nil && authReq.LoginPolicy != nil && authReq.LoginPolicy.AllowRegister
+		},
+		"isBreakGlassLogin": func() bool {
+			return breakGlass
 		},
 	}
 	l.renderer.RenderTemplate(w, r, translator, l.renderer.Templates[tmplLogin], data, funcs)
 }
 
+// discoverIDP handles the home realm discovery:
+// if the domain of the login name matches exactly one identity provider, the user is directly redirected to it,
+// if it matches multiple, the login is rendered again showing their buttons
+func (l *Login) discoverIDP(w http.ResponseWriter, r *http.Request, authReq *domain.AuthRequest, loginName string) bool {
+	if authReq.LoginPolicy == nil || !authReq.LoginPolicy.AllowExternalIDP {
+		return false
+	}
+	discovered := domain.DiscoveredIDPProviders(authReq.AllowedExternalIDPs, loginName)
+	switch len(discovered) {
+	case 0:
+		return false
+	case 1:
+		l.handleIDP(w, r, authReq, discovered[0].IDPConfigID)
+		return true
+	default:
+		authReq.LoginName = loginName
+		l.renderLogin(w, r, authReq, nil)
+		return true
+	}
+}
+
 func singleIDPAllowed(authReq *domain.AuthRequest) bool {
 	return authReq != nil && authReq.LoginPolicy != nil && !authReq.LoginPolicy.AllowUsernamePassword && authReq.LoginPolicy.AllowExternalIDP && len(authReq.AllowedExternalIDPs) == 1
 }
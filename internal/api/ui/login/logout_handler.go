@@ -2,18 +2,211 @@ package login
 
 import (
 	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/zitadel/logging"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	http_utils "github.com/zitadel/zitadel/internal/api/http"
+	http_mw "github.com/zitadel/zitadel/internal/api/http/middleware"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/query"
 )
 
 const (
-	tmplLogoutDone = "logoutdone"
+	tmplLogoutDone         = "logoutdone"
+	tmplLogoutConfirmation = "logoutconfirmation"
+
+	QueryLogoutID = "logoutID"
+
+	// logoutFrameTimeout is the time the browser waits for a logout frame to load,
+	// before the logout of the target is reported as failed
+	logoutFrameTimeout = 10 * time.Second
+	// QueryLogoutConfirmed is posted to the end_session endpoint, after the user confirmed the logout.
+	QueryLogoutConfirmed = "logout_confirmed"
 )
 
+// LogoutConfirmationParams are the parameters of the end_session request,
+// which are passed through the confirmation page back to the end_session endpoint.
+var LogoutConfirmationParams = []string{"client_id", "post_logout_redirect_uri", "state", "ui_locales"}
+
+type logoutDoneData struct {
+	userData
+	LogoutFrames          []*logoutFrame
+	FrameTimeout          int64
+	PostLogoutRedirectURI string
+}
+
+// logoutFrame is a front-channel or SAML logout, which is loaded in a hidden iframe.
+// The browser reports the result of the frame using the IDs.
+type logoutFrame struct {
+	URI        string
+	LogoutID   string
+	TargetID   string
+	TargetType domain.LogoutTargetType
+}
+
+type logoutReportFormData struct {
+	LogoutID   string                  `schema:"logoutID"`
+	TargetID   string                  `schema:"targetID"`
+	TargetType domain.LogoutTargetType `schema:"targetType"`
+	Succeeded  bool                    `schema:"succeeded"`
+	Reason     string                  `schema:"reason"`
+}
+
+type logoutConfirmationData struct {
+	userData
+	EndSessionURL string
+	Params        map[string]string
+	CancelURL     string
+}
+
 func (l *Login) handleLogoutDone(w http.ResponseWriter, r *http.Request) {
-	l.renderLogoutDone(w, r)
+	l.renderLogoutDone(w, r, l.delegatedLogouts(r))
+}
+
+// delegatedLogouts returns the logouts passed by the OP, which have to be finished by the browser of the user.
+// Only logouts of the current user agent are considered.
+func (l *Login) delegatedLogouts(r *http.Request) []*query.Logout {
+	logoutIDs := r.URL.Query()[QueryLogoutID]
+	if len(logoutIDs) == 0 {
+		return nil
+	}
+	userAgentID, _ := http_mw.UserAgentIDFromCtx(r.Context())
+	logouts := make([]*query.Logout, 0, len(logoutIDs))
+	for _, logoutID := range logoutIDs {
+		logout, err := l.query.LogoutByID(r.Context(), logoutID, "")
+		if err != nil {
+			logging.WithError(err).WithField("logout_id", logoutID).Warn("unable to get logout")
+			continue
+		}
+		if userAgentID == "" || logout.UserAgentID != userAgentID {
+			continue
+		}
+		logouts = append(logouts, logout)
+	}
+	return logouts
+}
+
+// logoutFrames returns the pending front-channel and SAML logouts,
+// which are called from the browser of the user, and reports them as delegated.
+// The browser reports their result once the frames are loaded, see [Login.handleLogoutReport].
+func (l *Login) logoutFrames(r *http.Request, logout *query.Logout) []*logoutFrame {
+	targets := logout.PendingTargets(domain.LogoutTargetTypeOIDCFrontChannel, domain.LogoutTargetTypeSAMLIDP)
+	frames := make([]*logoutFrame, 0, len(targets))
+	for _, target := range targets {
+		uri, err := l.logoutTargetURI(r, target)
+		state, reason := domain.LogoutTargetStateDelegated, ""
+		if err != nil {
+			state, reason = domain.LogoutTargetStateFailed, err.Error()
+		} else {
+			frames = append(frames, &logoutFrame{
+				URI:        uri,
+				LogoutID:   logout.ID,
+				TargetID:   target.ID,
+				TargetType: target.Type,
+			})
+		}
+		_, err = l.command.ReportLogoutTarget(r.Context(), logout.ID, target.ID, target.Type, state, reason)
+		logging.OnError(err).WithField("logout_id", logout.ID).Warn("unable to report logout target")
+	}
+	return frames
+}
+
+// handleLogoutReport is called by the browser of the user with the result of a logout frame.
+// A loaded frame is reported as success, as the relying party does not return any further result.
+// Only logouts of the current user agent can be reported.
+func (l *Login) handleLogoutReport(w http.ResponseWriter, r *http.Request) {
+	data := new(logoutReportFormData)
+	if err := l.getParseData(r, data); err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+	if !data.TargetType.IsBrowserDelegated() {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+	logout, err := l.query.LogoutByID(r.Context(), data.LogoutID, "")
+	userAgentID, _ := http_mw.UserAgentIDFromCtx(r.Context())
+	if err != nil || userAgentID == "" || logout.UserAgentID != userAgentID {
+		w.WriteHeader(http.StatusNotFound)
+		return
+	}
+	state, reason := domain.LogoutTargetStateSucceeded, ""
+	if !data.Succeeded {
+		state, reason = domain.LogoutTargetStateFailed, data.Reason
+	}
+	_, err = l.command.ReportLogoutTarget(r.Context(), logout.ID, data.TargetID, data.TargetType, state, reason)
+	if err != nil {
+		logging.WithError(err).WithField("logout_id", logout.ID).Warn("unable to report logout target")
+		w.WriteHeader(http.StatusConflict)
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (l *Login) logoutTargetURI(r *http.Request, target *query.LogoutTarget) (string, error) {
+	if target.Type != domain.LogoutTargetTypeSAMLIDP {
+		return target.URI, nil
+	}
+	identityProvider, err := l.getIDPByID(r, target.ID)
+	if err != nil {
+		return "", err
+	}
+	provider, err := l.samlProvider(r.Context(), identityProvider)
+	if err != nil {
+		return "", err
+	}
+	logoutURL, err := provider.LogoutURL(target.Subject)
+	if err != nil {
+		return "", err
+	}
+	return logoutURL.String(), nil
 }
 
-func (l *Login) renderLogoutDone(w http.ResponseWriter, r *http.Request) {
+func (l *Login) renderLogoutDone(w http.ResponseWriter, r *http.Request, logouts []*query.Logout) {
 	translator := l.getTranslator(r.Context(), nil)
-	data := l.getUserData(r, nil, translator, "LogoutDone.Title", "LogoutDone.Description", "", "")
+	data := logoutDoneData{
+		userData:     l.getUserData(r, nil, translator, "LogoutDone.Title", "LogoutDone.Description", "", ""),
+		FrameTimeout: logoutFrameTimeout.Milliseconds(),
+	}
+	frameHosts := make([]string, 0)
+	for _, logout := range logouts {
+		frames := l.logoutFrames(r, logout)
+		data.LogoutFrames = append(data.LogoutFrames, frames...)
+		for _, frame := range frames {
+			if u, err := url.Parse(frame.URI); err == nil {
+				frameHosts = append(frameHosts, u.Scheme+"://"+u.Host)
+			}
+		}
+		if data.PostLogoutRedirectURI == "" {
+			data.PostLogoutRedirectURI = logout.PostLogoutRedirectURI
+		}
+	}
+	if len(frameHosts) > 0 {
+		// the logout URIs are loaded in hidden iframes, so their origins must be allowed as frame source
+		csp := csp()
+		csp.FrameSrc = csp.FrameSrc.AddHost(frameHosts...)
+		w.Header().Set(http_utils.ContentSecurityPolicy, csp.Value(http_mw.GetNonce(r), r.Host, authz.GetInstance(r.Context()).SecurityPolicyAllowedOrigins()))
+	}
 	l.renderer.RenderTemplate(w, r, translator, l.renderer.Templates[tmplLogoutDone], data, nil)
 }
+
+// handleLogoutConfirmation asks the user to confirm the logout of an end_session request without a valid id_token_hint.
+// The confirmation is posted back to the end_session endpoint, which validates the parameters again.
+func (l *Login) handleLogoutConfirmation(w http.ResponseWriter, r *http.Request) {
+	translator := l.getTranslator(r.Context(), nil)
+	data := logoutConfirmationData{
+		userData:      l.getUserData(r, nil, translator, "LogoutConfirmation.Title", "LogoutConfirmation.Description", "", ""),
+		EndSessionURL: l.oidcEndSessionURL(r.Context()),
+		Params:        make(map[string]string, len(LogoutConfirmationParams)),
+		CancelURL:     l.consolePath,
+	}
+	for _, param := range LogoutConfirmationParams {
+		if value := r.URL.Query().Get(param); value != "" {
+			data.Params[param] = value
+		}
+	}
+	l.renderer.RenderTemplate(w, r, translator, l.renderer.Templates[tmplLogoutConfirmation], data, nil)
+}
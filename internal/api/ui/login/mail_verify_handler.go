@@ -88,6 +88,7 @@ func (l *Login) checkMailCode(w http.ResponseWriter, r *http.Request, authReq *d
 		l.renderMailVerification(w, r, authReq, userID, err)
 		return
 	}
+	l.autoJoinOrg(r.Context(), userID, userOrg)
 	l.renderMailVerified(w, r, authReq, userOrg)
 }
 
@@ -2,6 +2,7 @@ package login
 
 import (
 	"net/http"
+	"time"
 
 	http_mw "github.com/zitadel/zitadel/internal/api/http/middleware"
 	"github.com/zitadel/zitadel/internal/domain"
@@ -15,6 +16,7 @@ type mfaVerifyFormData struct {
 	MFAType          domain.MFAType `schema:"mfaType"`
 	Code             string         `schema:"code"`
 	SelectedProvider domain.MFAType `schema:"provider"`
+	TrustDevice      bool           `schema:"trustDevice"`
 }
 
 func (l *Login) handleMFAVerify(w http.ResponseWriter, r *http.Request) {
@@ -43,6 +45,9 @@ func (l *Login) handleMFAVerify(w http.ResponseWriter, r *http.Request) {
 		} else if actionErr != nil && err == nil {
 			err = actionErr
 		}
+		if err == nil && data.TrustDevice {
+			err = l.trustDevice(r, authReq)
+		}
 
 		if err != nil {
 			l.renderMFAVerifySelected(w, r, authReq, step, domain.MFATypeTOTP, err)
@@ -97,6 +102,24 @@ func (l *Login) renderMFAVerifySelected(w http.ResponseWriter, r *http.Request,
 	l.renderer.RenderTemplate(w, r, translator, l.renderer.Templates[tmplMFAVerify], data, nil)
 }
 
+// trustDevice marks the user agent of the request as trusted by the user,
+// so the multi-factor check is skipped on it for the lifetime defined in the login policy.
+func (l *Login) trustDevice(r *http.Request, authReq *domain.AuthRequest) error {
+	if authReq.LoginPolicy == nil || authReq.LoginPolicy.TrustedDeviceLifetime <= 0 {
+		return nil
+	}
+	userAgentID, _ := http_mw.UserAgentIDFromCtx(r.Context())
+	_, _, err := l.command.AddHumanTrustedDevice(
+		setContext(r.Context(), authReq.UserOrgID),
+		authReq.UserID,
+		authReq.UserOrgID,
+		userAgentID,
+		r.UserAgent(),
+		time.Now().Add(authReq.LoginPolicy.TrustedDeviceLifetime),
+	)
+	return err
+}
+
 func removeSelectedProviderFromList(providers []domain.MFAType, selected domain.MFAType) []domain.MFAType {
 	for i := len(providers) - 1; i >= 0; i-- {
 		if providers[i] == selected {
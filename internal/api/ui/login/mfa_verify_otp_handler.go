@@ -25,6 +25,7 @@ type mfaOTPFormData struct {
 	Code             string         `schema:"code"`
 	SelectedProvider domain.MFAType `schema:"selectedProvider"`
 	Provider         domain.MFAType `schema:"provider"`
+	TrustDevice      bool           `schema:"trustDevice"`
 }
 
 func OTPLink(origin, authRequestID, code string, provider domain.MFAType) string {
@@ -118,6 +119,9 @@ func (l *Login) handleOTPVerificationCheck(w http.ResponseWriter, r *http.Reques
 	} else if actionErr != nil && err == nil {
 		err = actionErr
 	}
+	if err == nil && formData.TrustDevice {
+		err = l.trustDevice(r, authReq)
+	}
 
 	if err != nil {
 		l.renderOTPVerification(w, r, authReq, step.MFAProviders, formData.SelectedProvider, err)
@@ -21,6 +21,7 @@ type mfaU2FData struct {
 type mfaU2FFormData struct {
 	webAuthNFormData
 	SelectedProvider domain.MFAType `schema:"provider"`
+	TrustDevice      bool           `schema:"trustDevice"`
 }
 
 func (l *Login) renderU2FVerification(w http.ResponseWriter, r *http.Request, authReq *domain.AuthRequest, providers []domain.MFAType, err error) {
@@ -78,6 +79,9 @@ func (l *Login) handleU2FVerification(w http.ResponseWriter, r *http.Request) {
 	} else if actionErr != nil && err == nil {
 		err = actionErr
 	}
+	if err == nil && formData.TrustDevice {
+		err = l.trustDevice(r, authReq)
+	}
 
 	if err != nil {
 		l.renderU2FVerification(w, r, authReq, step.MFAProviders, err)
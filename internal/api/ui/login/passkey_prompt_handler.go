@@ -0,0 +1,62 @@
+package login
+
+import (
+	"net/http"
+
+	"github.com/zitadel/logging"
+
+	"github.com/zitadel/zitadel/internal/domain"
+)
+
+const (
+	tmplPasskeyPrompt = "passkeyprompt"
+
+	passkeyPromptActionRegister = "register"
+	passkeyPromptActionSnooze   = "snooze"
+	passkeyPromptActionOptOut   = "optout"
+)
+
+type passkeyPromptFormData struct {
+	Action string `schema:"action"`
+}
+
+// handlePasskeyPrompt either starts the passkey registration or records that the user
+// wants to be reminded later or never again and continues with the login.
+func (l *Login) handlePasskeyPrompt(w http.ResponseWriter, r *http.Request) {
+	data := new(passkeyPromptFormData)
+	authReq, err := l.ensureAuthRequestAndParseData(r, data)
+	if err != nil {
+		l.renderError(w, r, authReq, err)
+		return
+	}
+	ctx := setContext(r.Context(), authReq.UserOrgID)
+	switch data.Action {
+	case passkeyPromptActionRegister:
+		err = l.command.HumanPasskeyPromptAccepted(ctx, authReq.UserID, authReq.UserOrgID)
+		logging.WithFields("authRequestID", authReq.ID).OnError(err).Warn("passkey prompt acceptance not recorded")
+		l.renderPasswordlessRegistration(w, r, authReq, "", "", "", "", 0, nil)
+		return
+	case passkeyPromptActionOptOut:
+		err = l.command.HumanOptOutPasskeyPrompt(ctx, authReq.UserID, authReq.UserOrgID)
+	default:
+		err = l.command.HumanSnoozePasskeyPrompt(ctx, authReq.UserID, authReq.UserOrgID)
+	}
+	if err != nil {
+		l.renderPasskeyPrompt(w, r, authReq, err)
+		return
+	}
+	l.renderNextStep(w, r, authReq)
+}
+
+func (l *Login) renderPasskeyPrompt(w http.ResponseWriter, r *http.Request, authReq *domain.AuthRequest, err error) {
+	var errID, errMessage string
+	if err != nil {
+		errID, errMessage = l.getErrorMessage(r, err)
+	} else {
+		shownErr := l.command.HumanPasskeyPromptShown(setContext(r.Context(), authReq.UserOrgID), authReq.UserID, authReq.UserOrgID)
+		logging.WithFields("authRequestID", authReq.ID).OnError(shownErr).Warn("passkey prompt not recorded")
+	}
+	translator := l.getTranslator(r.Context(), authReq)
+	data := l.getUserData(r, authReq, translator, "PasskeyPrompt.Title", "PasskeyPrompt.Description", errID, errMessage)
+	l.renderer.RenderTemplate(w, r, translator, l.renderer.Templates[tmplPasskeyPrompt], data, nil)
+}
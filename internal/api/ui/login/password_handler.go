@@ -28,6 +28,9 @@ func (l *Login) renderPassword(w http.ResponseWriter, r *http.Request, authReq *
 			}
 			return true
 		},
+		"passwordResetCaptcha": func() *captchaData {
+			return l.getCaptchaData(r.Context(), authReq.UserOrgID, domain.CaptchaEndpointPasswordReset, "Password.CaptchaLabel")
+		},
 	}
 	l.renderer.RenderTemplate(w, r, translator, l.renderer.Templates[tmplPassword], data, funcs)
 }
@@ -17,6 +17,10 @@ func (l *Login) handlePasswordReset(w http.ResponseWriter, r *http.Request) {
 		l.renderError(w, r, authReq, err)
 		return
 	}
+	if err = l.checkCaptcha(r, authReq.UserOrgID, domain.CaptchaEndpointPasswordReset); err != nil {
+		l.renderPassword(w, r, authReq, err)
+		return
+	}
 	user, err := l.query.GetUserByLoginName(setContext(r.Context(), authReq.UserOrgID), true, authReq.LoginName)
 	if err != nil {
 		if authReq.LoginPolicy.IgnoreUnknownUsernames && zerrors.IsNotFound(err) {
@@ -14,17 +14,29 @@ const (
 type passwordlessData struct {
 	webAuthNData
 	PasswordLogin bool
+	Captcha       *captchaData
 }
 
 type passwordlessFormData struct {
 	webAuthNFormData
 	PasswordLogin bool `schema:"passwordlogin"`
+	Captcha       bool `schema:"captcha"`
 }
 
 func (l *Login) renderPasswordlessVerification(w http.ResponseWriter, r *http.Request, authReq *domain.AuthRequest, passwordSet bool, err error) {
+	l.renderPasswordlessVerificationWithCaptcha(w, r, authReq, passwordSet, false, err)
+}
+
+// renderPasswordlessVerificationWithCaptcha only begins the passwordless login
+// if no CAPTCHA challenge is required or it has already been solved
+func (l *Login) renderPasswordlessVerificationWithCaptcha(w http.ResponseWriter, r *http.Request, authReq *domain.AuthRequest, passwordSet, captchaVerified bool, err error) {
 	var errID, errMessage, credentialData string
 	var webAuthNLogin *domain.WebAuthNLogin
-	if err == nil {
+	var captcha *captchaData
+	if !captchaVerified {
+		captcha = l.getCaptchaData(r.Context(), authReq.UserOrgID, domain.CaptchaEndpointPasswordlessBegin, "Passwordless.CaptchaLabel")
+	}
+	if err == nil && captcha == nil {
 		webAuthNLogin, err = l.authRepo.BeginPasswordlessLogin(setContext(r.Context(), authReq.UserOrgID), authReq.UserID, authReq.UserOrgID, authReq.ID, authReq.AgentID)
 	}
 	if err != nil {
@@ -43,6 +55,7 @@ func (l *Login) renderPasswordlessVerification(w http.ResponseWriter, r *http.Re
 			CredentialCreationData: credentialData,
 		},
 		passwordSet,
+		captcha,
 	}
 	l.renderer.RenderTemplate(w, r, translator, l.renderer.Templates[tmplPasswordlessVerification], data, nil)
 }
@@ -58,6 +71,11 @@ func (l *Login) handlePasswordlessVerification(w http.ResponseWriter, r *http.Re
 		l.renderPassword(w, r, authReq, nil)
 		return
 	}
+	if formData.Captcha {
+		err = l.checkCaptcha(r, authReq.UserOrgID, domain.CaptchaEndpointPasswordlessBegin)
+		l.renderPasswordlessVerificationWithCaptcha(w, r, authReq, formData.PasswordLogin, err == nil, err)
+		return
+	}
 	credData, err := base64.URLEncoding.DecodeString(formData.CredentialData)
 	if err != nil {
 		l.renderPasswordlessVerification(w, r, authReq, formData.PasswordLogin, err)
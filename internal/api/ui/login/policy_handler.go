@@ -3,7 +3,9 @@ package login
 import (
 	"net/http"
 
+	"github.com/zitadel/zitadel/internal/domain"
 	"github.com/zitadel/zitadel/internal/query"
+	"github.com/zitadel/zitadel/internal/zerrors"
 )
 
 func (l *Login) getDefaultDomainPolicy(r *http.Request) (*query.DomainPolicy, error) {
@@ -34,3 +36,15 @@ func (l *Login) getLabelPolicy(r *http.Request, orgID string) (*query.LabelPolic
 	}
 	return l.query.ActiveLabelPolicyByOrg(r.Context(), orgID, false)
 }
+
+// getUserAttributes returns the custom user attributes of the organization, if it defined any
+func (l *Login) getUserAttributes(r *http.Request, orgID string) ([]*domain.UserAttribute, error) {
+	schema, err := l.query.UserAttributeSchemaByOrg(r.Context(), orgID)
+	if zerrors.IsNotFound(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return schema.Attributes, nil
+}
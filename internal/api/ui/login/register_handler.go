@@ -1,8 +1,10 @@
 package login
 
 import (
+	"context"
 	"net/http"
 
+	"github.com/zitadel/logging"
 	"golang.org/x/text/language"
 
 	"github.com/zitadel/zitadel/internal/api/authz"
@@ -25,6 +27,9 @@ type registerFormData struct {
 	Password     string              `schema:"register-password"`
 	Password2    string              `schema:"register-password-confirmation"`
 	TermsConfirm bool                `schema:"terms-confirm"`
+	InviteOrgID  string              `schema:"inviteOrgID"`
+	InviteID     string              `schema:"inviteID"`
+	InviteCode   string              `schema:"inviteCode"`
 }
 
 type registerData struct {
@@ -38,6 +43,18 @@ type registerData struct {
 	ShowUsername       bool
 	ShowUsernameSuffix bool
 	OrgRegister        bool
+	Captcha            *captchaData
+	Attributes         []*registerAttribute
+	InviteEmail        bool
+}
+
+type registerAttribute struct {
+	Name      string
+	Label     string
+	InputType string
+	Required  bool
+	Pattern   string
+	Value     string
 }
 
 func (l *Login) handleRegister(w http.ResponseWriter, r *http.Request) {
@@ -68,6 +85,14 @@ func (l *Login) handleRegisterCheck(w http.ResponseWriter, r *http.Request) {
 	if authRequest != nil && authRequest.RequestedOrgID != "" && authRequest.RequestedOrgID != resourceOwner {
 		resourceOwner = authRequest.RequestedOrgID
 	}
+	// users registered with an invite always belong to the organization of the invite
+	if data.InviteID != "" {
+		resourceOwner = data.InviteOrgID
+	}
+	if err = l.checkCaptcha(r, resourceOwner, domain.CaptchaEndpointRegister); err != nil {
+		l.renderRegister(w, r, authRequest, data, err)
+		return
+	}
 	// For consistency with the external authentication flow,
 	// the setMetadata() function is provided on the pre creation hook, for now,
 	// like for the ExternalAuthentication flow.
@@ -76,18 +101,33 @@ func (l *Login) handleRegisterCheck(w http.ResponseWriter, r *http.Request) {
 	// without breaking existing actions.
 	// Also, if that field is needed, we probably also should provide it
 	// for ExternalAuthentication.
-	user, metadatas, err := l.runPreCreationActions(authRequest, r, data.toHumanDomain(), make([]*domain.Metadata, 0), resourceOwner, domain.FlowTypeInternalAuthentication)
+	attributes, err := l.getUserAttributes(r, resourceOwner)
+	if err != nil {
+		l.renderRegister(w, r, authRequest, data, err)
+		return
+	}
+	user, metadatas, err := l.runPreCreationActions(authRequest, r, data.toHumanDomain(), attributeMetadata(r, attributes), resourceOwner, domain.FlowTypeInternalAuthentication)
 	if err != nil {
 		l.renderRegister(w, r, authRequest, data, err)
 		return
 	}
 
 	human := command.AddHumanFromDomain(user, metadatas, authRequest, nil)
-	err = l.command.AddUserHuman(setContext(r.Context(), resourceOwner), resourceOwner, human, true, l.userCodeAlg)
+	if data.InviteID != "" {
+		err = l.command.RegisterHumanWithInvite(setContext(r.Context(), resourceOwner), resourceOwner, data.InviteID, data.InviteCode, human, l.userCodeAlg)
+	} else {
+		err = l.command.AddUserHuman(setContext(r.Context(), resourceOwner), resourceOwner, human, true, l.userCodeAlg)
+	}
 	if err != nil {
 		l.renderRegister(w, r, authRequest, data, err)
 		return
 	}
+	// the terms were confirmed as part of the registration form
+	_, err = l.command.AcceptActiveTerms(setContext(r.Context(), resourceOwner), human.ID, resourceOwner)
+	if err != nil {
+		l.renderError(w, r, authRequest, err)
+		return
+	}
 	userGrants, err := l.runPostCreationActions(human.ID, authRequest, r, resourceOwner, domain.FlowTypeInternalAuthentication)
 	if err != nil {
 		l.renderError(w, r, authRequest, err)
@@ -99,6 +139,7 @@ func (l *Login) handleRegisterCheck(w http.ResponseWriter, r *http.Request) {
 		l.renderError(w, r, authRequest, err)
 		return
 	}
+	l.autoJoinOrg(r.Context(), human.ID, resourceOwner)
 
 	if authRequest == nil {
 		l.defaultRedirect(w, r)
@@ -131,6 +172,10 @@ func (l *Login) renderRegister(w http.ResponseWriter, r *http.Request, authReque
 		resourceOwner = authRequest.RequestedOrgID
 	}
 
+	if formData.InviteID != "" {
+		resourceOwner = formData.InviteOrgID
+	}
+
 	if resourceOwner == "" {
 		resourceOwner = authz.GetInstance(r.Context()).DefaultOrganisationID()
 	}
@@ -172,6 +217,27 @@ func (l *Login) renderRegister(w http.ResponseWriter, r *http.Request, authReque
 	}
 	data.ShowUsernameSuffix = !labelPolicy.HideLoginNameSuffix
 
+	data.Captcha = l.getCaptchaData(r.Context(), resourceOwner, domain.CaptchaEndpointRegister, "RegistrationUser.CaptchaLabel")
+
+	attributes, err := l.getUserAttributes(r, resourceOwner)
+	if err != nil {
+		l.renderRegister(w, r, authRequest, formData, err)
+		return
+	}
+	data.Attributes = registerAttributes(r, attributes)
+
+	if formData.InviteID != "" {
+		invite, err := l.query.OrgInviteByID(r.Context(), formData.InviteOrgID, formData.InviteID)
+		if err != nil {
+			l.renderError(w, r, authRequest, err)
+			return
+		}
+		if invite.Email != "" {
+			data.Email = invite.Email
+			data.InviteEmail = true
+		}
+	}
+
 	funcs := map[string]interface{}{
 		"selectedLanguage": func(l string) bool {
 			if formData == nil {
@@ -202,3 +268,62 @@ func (d registerFormData) toHumanDomain() *domain.Human {
 		},
 	}
 }
+
+// registerAttributes maps the custom user attributes of the organization to the inputs of the registration form.
+// Already entered values are kept if the form is rendered again.
+func registerAttributes(r *http.Request, attributes []*domain.UserAttribute) []*registerAttribute {
+	inputs := make([]*registerAttribute, len(attributes))
+	for i, attribute := range attributes {
+		input := &registerAttribute{
+			Name:     attributeFormKey(attribute.Key),
+			Label:    attribute.DisplayName,
+			Required: attribute.Required,
+			Value:    r.FormValue(attributeFormKey(attribute.Key)),
+		}
+		if input.Label == "" {
+			input.Label = attribute.Key
+		}
+		switch attribute.Type {
+		case domain.UserAttributeTypeNumber:
+			input.InputType = "number"
+		case domain.UserAttributeTypeBoolean:
+			input.InputType = "checkbox"
+		case domain.UserAttributeTypeDate:
+			input.InputType = "date"
+		case domain.UserAttributeTypeString,
+			domain.UserAttributeTypeUnspecified:
+			input.InputType = "text"
+			input.Pattern = attribute.Pattern
+		}
+		inputs[i] = input
+	}
+	return inputs
+}
+
+// attributeMetadata returns the entered values of the custom user attributes as metadata of the new user.
+// The values are validated against the schema when the user is created.
+func attributeMetadata(r *http.Request, attributes []*domain.UserAttribute) []*domain.Metadata {
+	metadata := make([]*domain.Metadata, 0, len(attributes))
+	for _, attribute := range attributes {
+		value := r.FormValue(attributeFormKey(attribute.Key))
+		if value == "" {
+			continue
+		}
+		metadata = append(metadata, &domain.Metadata{
+			Key:   attribute.Key,
+			Value: []byte(value),
+		})
+	}
+	return metadata
+}
+
+func attributeFormKey(key string) string {
+	return "attribute-" + key
+}
+
+// autoJoinOrg adds the user as member to the organization of its verified email domain, if the organization enabled it.
+// A failure must not prevent the user from using the login, so it's only logged.
+func (l *Login) autoJoinOrg(ctx context.Context, userID, resourceOwner string) {
+	_, err := l.command.AutoJoinOrg(setContext(ctx, resourceOwner), userID, resourceOwner)
+	logging.WithFields("userID", userID).OnError(err).Warn("unable to auto join organization")
+}
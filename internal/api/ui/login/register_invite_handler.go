@@ -0,0 +1,42 @@
+package login
+
+import (
+	"net/http"
+	"net/url"
+
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+const (
+	queryInviteID   = "inviteID"
+	queryInviteCode = "code"
+)
+
+func InviteUserLink(origin, orgID, inviteID, code string) string {
+	v := url.Values{}
+	v.Set(queryOrgID, orgID)
+	v.Set(queryInviteID, inviteID)
+	v.Set(queryInviteCode, code)
+	return externalLink(origin) + EndpointRegisterInvite + "?" + v.Encode()
+}
+
+// handleRegisterInvite renders the registration form for the organization of the invite.
+// The invite is only used when the form is submitted.
+func (l *Login) handleRegisterInvite(w http.ResponseWriter, r *http.Request) {
+	authReq := l.checkOptionalAuthRequestOfEmailLinks(r)
+	data := &registerFormData{
+		InviteOrgID: r.FormValue(queryOrgID),
+		InviteID:    r.FormValue(queryInviteID),
+		InviteCode:  r.FormValue(queryInviteCode),
+	}
+	invite, err := l.query.OrgInviteByID(r.Context(), data.InviteOrgID, data.InviteID)
+	if err != nil {
+		l.renderError(w, r, authReq, err)
+		return
+	}
+	if !invite.IsUsable() {
+		l.renderError(w, r, authReq, zerrors.ThrowPreconditionFailed(nil, "LOGIN-Inv1p", "Errors.Org.Invite.NotUsable"))
+		return
+	}
+	l.renderRegister(w, r, authReq, data, nil)
+}
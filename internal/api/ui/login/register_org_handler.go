@@ -35,6 +35,7 @@ type registerOrgData struct {
 	HasSymbol                 string
 	UserLoginMustBeDomain     bool
 	IamDomain                 string
+	Captcha                   *captchaData
 }
 
 func (l *Login) handleRegisterOrg(w http.ResponseWriter, r *http.Request) {
@@ -77,6 +78,11 @@ func (l *Login) handleRegisterOrgCheck(w http.ResponseWriter, r *http.Request) {
 		l.renderRegisterOrg(w, r, authRequest, data, err)
 		return
 	}
+	// the organization does not exist yet, so the captcha policy of the instance applies
+	if err = l.checkCaptcha(r, authz.GetInstance(r.Context()).InstanceID(), domain.CaptchaEndpointRegister); err != nil {
+		l.renderRegisterOrg(w, r, authRequest, data, err)
+		return
+	}
 
 	ctx := setContext(r.Context(), "")
 	userIDs, err := l.getClaimedUserIDsOfOrgDomain(ctx, data.RegisterOrgName)
@@ -84,11 +90,19 @@ func (l *Login) handleRegisterOrgCheck(w http.ResponseWriter, r *http.Request) {
 		l.renderRegisterOrg(w, r, authRequest, data, err)
 		return
 	}
-	_, err = l.command.SetUpOrg(ctx, data.toCommandOrg(), true, userIDs...)
+	createdOrg, err := l.command.SetUpOrg(ctx, data.toCommandOrg(), true, userIDs...)
 	if err != nil {
 		l.renderRegisterOrg(w, r, authRequest, data, err)
 		return
 	}
+	// the terms were confirmed as part of the registration form
+	for _, admin := range createdOrg.CreatedAdmins {
+		_, err = l.command.AcceptActiveTerms(setContext(ctx, createdOrg.ObjectDetails.ResourceOwner), admin.ID, createdOrg.ObjectDetails.ResourceOwner)
+		if err != nil {
+			l.renderError(w, r, authRequest, err)
+			return
+		}
+	}
 	if authRequest == nil {
 		l.defaultRedirect(w, r)
 		return
@@ -130,6 +144,7 @@ func (l *Login) renderRegisterOrg(w http.ResponseWriter, r *http.Request, authRe
 		data.UserLoginMustBeDomain = orgPolicy.UserLoginMustBeDomain
 		data.IamDomain = authz.GetInstance(r.Context()).RequestedDomain()
 	}
+	data.Captcha = l.getCaptchaData(r.Context(), authz.GetInstance(r.Context()).InstanceID(), domain.CaptchaEndpointRegister, "RegistrationUser.CaptchaLabel")
 
 	if authRequest == nil {
 		l.customTexts(r.Context(), translator, "")
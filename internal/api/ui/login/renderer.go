@@ -54,6 +54,8 @@ func CreateRenderer(pathPrefix string, staticStorage static.Storage, cookieName
 		tmplPasswordlessRegistration:     "passwordless_registration.html",
 		tmplPasswordlessRegistrationDone: "passwordless_registration_done.html",
 		tmplPasswordlessPrompt:           "passwordless_prompt.html",
+		tmplPasskeyPrompt:                "passkey_prompt.html",
+		tmplLinkedIDPs:                   "linked_idps.html",
 		tmplMFAVerify:                    "mfa_verify_totp.html",
 		tmplMFAPrompt:                    "mfa_prompt.html",
 		tmplMFAInitVerify:                "mfa_init_otp.html",
@@ -69,14 +71,20 @@ func CreateRenderer(pathPrefix string, staticStorage static.Storage, cookieName
 		tmplInitUser:                     "init_user.html",
 		tmplInitUserDone:                 "init_user_done.html",
 		tmplPasswordResetDone:            "password_reset_done.html",
+		tmplUsernameRecovery:             "username_recovery.html",
+		tmplUsernameRecoveryDone:         "username_recovery_done.html",
 		tmplChangePassword:               "change_password.html",
 		tmplChangePasswordDone:           "change_password_done.html",
 		tmplRegisterOption:               "register_option.html",
 		tmplRegister:                     "register.html",
 		tmplLogoutDone:                   "logout_done.html",
+		tmplLogoutConfirmation:           "logout_confirmation.html",
 		tmplRegisterOrg:                  "register_org.html",
 		tmplChangeUsername:               "change_username.html",
 		tmplChangeUsernameDone:           "change_username_done.html",
+		tmplAcceptTerms:                  "accept_terms.html",
+		tmplConsent:                      "consent.html",
+		tmplCompleteProfile:              "complete_profile.html",
 		tmplLinkUsersDone:                "link_users_done.html",
 		tmplExternalNotFoundOption:       "external_not_found_option.html",
 		tmplLoginSuccess:                 "login_success.html",
@@ -99,7 +107,7 @@ func CreateRenderer(pathPrefix string, staticStorage static.Storage, cookieName
 			return policy == nil || !policy.DisableWatermark
 		},
 		"variablesCssFileUrl": func(orgID string, policy *domain.LabelPolicy) string {
-			cssFile := domain.CssPath + "/" + domain.CssVariablesFileName + "?v=" + policy.ChangeDate.Format(time.RFC3339)
+			cssFile := versionedFileName(domain.CssPath+"/"+domain.CssVariablesFileName, policy)
 			return path.Join(r.pathPrefix, fmt.Sprintf("%s?%s=%s&%s=%v&%s=%s", EndpointDynamicResources, "orgId", orgID, "default-policy", policy.Default, "filename", cssFile))
 		},
 		"customLogoResource": func(orgID string, policy *domain.LabelPolicy, darkMode bool) string {
@@ -110,7 +118,7 @@ func CreateRenderer(pathPrefix string, staticStorage static.Storage, cookieName
 			if fileName == "" {
 				return ""
 			}
-			return path.Join(r.pathPrefix, fmt.Sprintf("%s?%s=%s&%s=%v&%s=%s", EndpointDynamicResources, "orgId", orgID, "default-policy", policy.Default, "filename", fileName))
+			return path.Join(r.pathPrefix, fmt.Sprintf("%s?%s=%s&%s=%v&%s=%s", EndpointDynamicResources, "orgId", orgID, "default-policy", policy.Default, "filename", versionedFileName(fileName, policy)))
 		},
 		"customIconResource": func(orgID string, policy *domain.LabelPolicy, darkMode bool) string {
 			fileName := policy.IconURL
@@ -120,7 +128,13 @@ func CreateRenderer(pathPrefix string, staticStorage static.Storage, cookieName
 			if fileName == "" {
 				return ""
 			}
-			return path.Join(r.pathPrefix, fmt.Sprintf("%s?%s=%s&%s=%v&%s=%s", EndpointDynamicResources, "orgId", orgID, "default-policy", policy.Default, "filename", fileName))
+			return path.Join(r.pathPrefix, fmt.Sprintf("%s?%s=%s&%s=%v&%s=%s", EndpointDynamicResources, "orgId", orgID, "default-policy", policy.Default, "filename", versionedFileName(fileName, policy)))
+		},
+		"idpIconResource": func(policy *domain.LoginPolicy, iconKey string) string {
+			if policy == nil || iconKey == "" {
+				return ""
+			}
+			return path.Join(r.pathPrefix, fmt.Sprintf("%s?%s=%s&%s=%v&%s=%s", EndpointDynamicResources, "orgId", policy.ResourceOwner, "default-policy", policy.Default, "filename", iconKey))
 		},
 		"avatarResource": func(orgID, avatar string) string {
 			return path.Join(r.pathPrefix, fmt.Sprintf("%s?%s=%s&%s=%v&%s=%s", EndpointDynamicResources, "orgId", orgID, "default-policy", false, "filename", avatar))
@@ -128,6 +142,9 @@ func CreateRenderer(pathPrefix string, staticStorage static.Storage, cookieName
 		"loginUrl": func() string {
 			return path.Join(r.pathPrefix, EndpointLogin)
 		},
+		"logoutReportUrl": func() string {
+			return path.Join(r.pathPrefix, EndpointLogoutReport)
+		},
 		"externalIDPAuthURL": func(authReqID, idpConfigID string) string {
 			return path.Join(r.pathPrefix, fmt.Sprintf("%s?%s=%s&%s=%s", EndpointExternalLogin, QueryAuthRequestID, authReqID, queryIDPConfigID, idpConfigID))
 		},
@@ -143,6 +160,9 @@ func CreateRenderer(pathPrefix string, staticStorage static.Storage, cookieName
 		"loginNameChangeUrl": func(id string) string {
 			return path.Join(r.pathPrefix, fmt.Sprintf("%s?%s=%s", EndpointLoginName, QueryAuthRequestID, id))
 		},
+		"usernameRecoveryUrl": func(id string) string {
+			return path.Join(r.pathPrefix, fmt.Sprintf("%s?%s=%s", EndpointUsernameRecovery, QueryAuthRequestID, id))
+		},
 		"userSelectionUrl": func() string {
 			return path.Join(r.pathPrefix, EndpointUserSelection)
 		},
@@ -155,6 +175,15 @@ func CreateRenderer(pathPrefix string, staticStorage static.Storage, cookieName
 		"passwordlessPromptUrl": func() string {
 			return path.Join(r.pathPrefix, EndpointPasswordlessPrompt)
 		},
+		"passkeyPromptUrl": func() string {
+			return path.Join(r.pathPrefix, EndpointPasskeyPrompt)
+		},
+		"linkedIDPsUrl": func(id string) string {
+			return path.Join(r.pathPrefix, fmt.Sprintf("%s?%s=%s", EndpointLinkedIDPs, QueryAuthRequestID, id))
+		},
+		"unlinkIDPUrl": func() string {
+			return path.Join(r.pathPrefix, EndpointUnlinkIDP)
+		},
 		"passwordResetUrl": func(id string) string {
 			return path.Join(r.pathPrefix, fmt.Sprintf("%s?%s=%s", EndpointPasswordReset, QueryAuthRequestID, id))
 		},
@@ -209,6 +238,15 @@ func CreateRenderer(pathPrefix string, staticStorage static.Storage, cookieName
 		"changeUsernameUrl": func() string {
 			return path.Join(r.pathPrefix, EndpointChangeUsername)
 		},
+		"acceptTermsUrl": func() string {
+			return path.Join(r.pathPrefix, EndpointAcceptTerms)
+		},
+		"consentUrl": func() string {
+			return path.Join(r.pathPrefix, EndpointConsent)
+		},
+		"completeProfileUrl": func() string {
+			return path.Join(r.pathPrefix, EndpointCompleteProfile)
+		},
 		"externalNotFoundOptionUrl": func(action string) string {
 			return path.Join(r.pathPrefix, EndpointExternalNotFoundOption+"?"+action+"=true")
 		},
@@ -224,12 +262,21 @@ func CreateRenderer(pathPrefix string, staticStorage static.Storage, cookieName
 		"showPasswordReset": func() bool {
 			return true
 		},
+		"passwordResetCaptcha": func() *captchaData {
+			return nil
+		},
+		"usernameRecoveryCaptcha": func() *captchaData {
+			return nil
+		},
 		"hasExternalLogin": func() bool {
 			return false
 		},
 		"hasRegistration": func() bool {
 			return true
 		},
+		"isBreakGlassLogin": func() bool {
+			return false
+		},
 		"idpProviderClass": func(idpType domain.IDPType) string {
 			return idpType.GetCSSClass()
 		},
@@ -249,6 +296,12 @@ func CreateRenderer(pathPrefix string, staticStorage static.Storage, cookieName
 	return r
 }
 
+// versionedFileName adds the change date of the policy to the file name of its asset,
+// so browsers and proxies load a changed asset without waiting for the cache to expire
+func versionedFileName(fileName string, policy *domain.LabelPolicy) string {
+	return fileName + "?v=" + policy.ChangeDate.Format(time.RFC3339)
+}
+
 func (l *Login) renderNextStep(w http.ResponseWriter, r *http.Request, authReq *domain.AuthRequest) {
 	if authReq == nil {
 		l.renderInternalError(w, r, nil, zerrors.ThrowInvalidArgument(nil, "LOGIN-Df3f2", "Errors.AuthRequest.NotFound"))
@@ -300,6 +353,8 @@ func (l *Login) chooseNextStep(w http.ResponseWriter, r *http.Request, authReq *
 		l.renderPasswordlessVerification(w, r, authReq, step.PasswordSet, nil)
 	case *domain.PasswordlessRegistrationPromptStep:
 		l.renderPasswordlessPrompt(w, r, authReq, nil)
+	case *domain.PasskeyPromptStep:
+		l.renderPasskeyPrompt(w, r, authReq, err)
 	case *domain.MFAVerificationStep:
 		l.renderMFAVerify(w, r, authReq, step, err)
 	case *domain.RedirectToCallbackStep:
@@ -320,6 +375,12 @@ func (l *Login) chooseNextStep(w http.ResponseWriter, r *http.Request, authReq *
 		l.renderInitUser(w, r, authReq, "", "", "", step.PasswordSet, nil)
 	case *domain.ChangeUsernameStep:
 		l.renderChangeUsername(w, r, authReq, nil)
+	case *domain.AcceptTermsStep:
+		l.renderAcceptTerms(w, r, authReq, step, nil)
+	case *domain.ConsentStep:
+		l.renderConsent(w, r, authReq, step, nil)
+	case *domain.CompleteProfileStep:
+		l.renderCompleteProfile(w, r, authReq, step, nil)
 	case *domain.LinkUsersStep:
 		l.linkUsers(w, r, authReq, err)
 	case *domain.ExternalNotFoundOptionStep:
@@ -363,9 +424,20 @@ func (l *Login) getUserData(r *http.Request, authReq *domain.AuthRequest, transl
 	if authReq != nil && authReq.LinkingUsers != nil {
 		userData.Linking = len(authReq.LinkingUsers) > 0
 	}
+	if authReq != nil && authReq.LoginPolicy != nil {
+		userData.TrustDeviceAllowed = authReq.LoginPolicy.TrustedDeviceLifetime > 0
+	}
 	return userData
 }
 
+// RenderTemplate renders the page and allows the application of the auth request to embed it.
+func (l *Renderer) RenderTemplate(w http.ResponseWriter, req *http.Request, translator *i18n.Translator, tmpl *template.Template, data interface{}, reqFuncs map[string]interface{}) {
+	if embeddable, ok := data.(interface{ frameAncestors() []string }); ok {
+		setFrameAncestors(w, req, embeddable.frameAncestors())
+	}
+	l.Renderer.RenderTemplate(w, req, translator, tmpl, data, reqFuncs)
+}
+
 func (l *Login) getBaseData(r *http.Request, authReq *domain.AuthRequest, translator *i18n.Translator, titleI18nKey string, descriptionI18nKey string, errType, errMessage string) baseData {
 	title := ""
 	if titleI18nKey != "" {
@@ -398,9 +470,10 @@ func (l *Login) getBaseData(r *http.Request, authReq *domain.AuthRequest, transl
 	}
 	var privacyPolicy *domain.PrivacyPolicy
 	if authReq != nil {
+		baseData.appFrameAncestors = authReq.FrameAncestors
 		baseData.LoginPolicy = authReq.LoginPolicy
 		baseData.LabelPolicy = authReq.LabelPolicy
-		baseData.IDPProviders = authReq.AllowedExternalIDPs
+		baseData.IDPProviders = domain.VisibleIDPProviders(authReq.AllowedExternalIDPs, authReq.LoginName)
 		if authReq.PrivacyPolicy == nil {
 			return baseData
 		}
@@ -537,6 +610,10 @@ func (l *Login) getPrivateLabelingID(r *http.Request, authReq *domain.AuthReques
 	if !instance.Features().LoginDefaultOrg {
 		defaultID = instance.InstanceID()
 	}
+	// the branding of the requested domain has precedence over the default
+	if brandingID := instance.BrandingOrganisationID(); brandingID != "" {
+		defaultID = brandingID
+	}
 
 	if authReq != nil {
 		return authReq.PrivateLabelingOrgID(defaultID)
@@ -652,6 +729,12 @@ type baseData struct {
 	IDPProviders           []*domain.IDPProvider
 	LabelPolicy            *domain.LabelPolicy
 	LoginTexts             []*domain.CustomLoginText
+	// appFrameAncestors are the origins the application of the auth request allows to embed the login
+	appFrameAncestors []string
+}
+
+func (d baseData) frameAncestors() []string {
+	return d.appFrameAncestors
 }
 
 type errorData struct {
@@ -666,6 +749,7 @@ type userData struct {
 	MFAProviders        []domain.MFAType
 	SelectedMFAProvider domain.MFAType
 	Linking             bool
+	TrustDeviceAllowed  bool
 }
 
 type profileData struct {
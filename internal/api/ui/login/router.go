@@ -22,9 +22,17 @@ const (
 	EndpointPasswordlessLogin             = "/login/passwordless"
 	EndpointPasswordlessRegistration      = "/login/passwordless/init"
 	EndpointPasswordlessPrompt            = "/login/passwordless/prompt"
+	EndpointPasskeyPrompt                 = "/login/passkey/prompt"
+	EndpointLinkedIDPs                    = "/login/idps"
+	EndpointBreakGlassLogin               = "/login/breakglass"
+	EndpointUnlinkIDP                     = "/login/idps/unlink"
 	EndpointLoginName                     = "/loginname"
+	EndpointUsernameRecovery              = "/loginname/recovery"
 	EndpointUserSelection                 = "/userselection"
 	EndpointChangeUsername                = "/username/change"
+	EndpointAcceptTerms                   = "/terms/accept"
+	EndpointConsent                       = "/consent"
+	EndpointCompleteProfile               = "/profile/complete"
 	EndpointPassword                      = "/password"
 	EndpointInitPassword                  = "/password/init"
 	EndpointChangePassword                = "/password/change"
@@ -44,7 +52,10 @@ const (
 	EndpointExternalRegister              = "/register/externalidp"
 	EndpointExternalRegisterCallback      = "/register/externalidp/callback"
 	EndpointRegisterOrg                   = "/register/org"
+	EndpointRegisterInvite                = "/register/invite"
 	EndpointLogoutDone                    = "/logout/done"
+	EndpointLogoutConfirmation            = "/logout/confirm"
+	EndpointLogoutReport                  = "/logout/report"
 	EndpointLoginSuccess                  = "/login/success"
 	EndpointExternalNotFoundOption        = "/externaluser/option"
 
@@ -84,14 +95,24 @@ func CreateRouter(login *Login, interceptors ...mux.MiddlewareFunc) *mux.Router
 	router.HandleFunc(EndpointPasswordlessRegistration, login.handlePasswordlessRegistration).Methods(http.MethodGet)
 	router.HandleFunc(EndpointPasswordlessRegistration, login.handlePasswordlessRegistrationCheck).Methods(http.MethodPost)
 	router.HandleFunc(EndpointPasswordlessPrompt, login.handlePasswordlessPrompt).Methods(http.MethodPost)
+	router.HandleFunc(EndpointPasskeyPrompt, login.handlePasskeyPrompt).Methods(http.MethodPost)
+	router.HandleFunc(EndpointLinkedIDPs, login.handleLinkedIDPs).Methods(http.MethodGet)
+	router.HandleFunc(EndpointUnlinkIDP, login.handleUnlinkIDP).Methods(http.MethodPost)
+	router.HandleFunc(EndpointBreakGlassLogin, login.handleBreakGlassLogin).Methods(http.MethodGet)
 	router.HandleFunc(EndpointLoginName, login.handleLoginName).Methods(http.MethodGet)
 	router.HandleFunc(EndpointLoginName, login.handleLoginNameCheck).Methods(http.MethodPost)
+	router.HandleFunc(EndpointUsernameRecovery, login.handleUsernameRecovery).Methods(http.MethodGet)
+	router.HandleFunc(EndpointUsernameRecovery, login.handleUsernameRecoveryCheck).Methods(http.MethodPost)
 	router.HandleFunc(EndpointUserSelection, login.handleSelectUser).Methods(http.MethodPost)
 	router.HandleFunc(EndpointChangeUsername, login.handleChangeUsername).Methods(http.MethodPost)
+	router.HandleFunc(EndpointAcceptTerms, login.handleAcceptTerms).Methods(http.MethodPost)
+	router.HandleFunc(EndpointConsent, login.handleConsent).Methods(http.MethodPost)
+	router.HandleFunc(EndpointCompleteProfile, login.handleCompleteProfile).Methods(http.MethodPost)
 	router.HandleFunc(EndpointPassword, login.handlePasswordCheck).Methods(http.MethodPost)
 	router.HandleFunc(EndpointInitPassword, login.handleInitPassword).Methods(http.MethodGet)
 	router.HandleFunc(EndpointInitPassword, login.handleInitPasswordCheck).Methods(http.MethodPost)
 	router.HandleFunc(EndpointPasswordReset, login.handlePasswordReset).Methods(http.MethodGet)
+	router.HandleFunc(EndpointPasswordReset, login.handlePasswordReset).Methods(http.MethodPost)
 	router.HandleFunc(EndpointInitUser, login.handleInitUser).Methods(http.MethodGet)
 	router.HandleFunc(EndpointInitUser, login.handleInitUserCheck).Methods(http.MethodPost)
 	router.HandleFunc(EndpointMFAVerify, login.handleMFAVerify).Methods(http.MethodPost)
@@ -114,10 +135,13 @@ func CreateRouter(login *Login, interceptors ...mux.MiddlewareFunc) *mux.Router
 	router.HandleFunc(EndpointExternalRegister, login.handleExternalRegister).Methods(http.MethodGet)
 	router.HandleFunc(EndpointExternalRegisterCallback, login.handleExternalLoginCallback).Methods(http.MethodGet)
 	router.HandleFunc(EndpointLogoutDone, login.handleLogoutDone).Methods(http.MethodGet)
+	router.HandleFunc(EndpointLogoutConfirmation, login.handleLogoutConfirmation).Methods(http.MethodGet)
+	router.HandleFunc(EndpointLogoutReport, login.handleLogoutReport).Methods(http.MethodPost)
 	router.HandleFunc(EndpointDynamicResources, login.handleDynamicResources).Methods(http.MethodGet)
 	router.PathPrefix(EndpointResources).Handler(login.handleResources()).Methods(http.MethodGet)
 	router.HandleFunc(EndpointRegisterOrg, login.handleRegisterOrg).Methods(http.MethodGet)
 	router.HandleFunc(EndpointRegisterOrg, login.handleRegisterOrgCheck).Methods(http.MethodPost)
+	router.HandleFunc(EndpointRegisterInvite, login.handleRegisterInvite).Methods(http.MethodGet)
 	router.HandleFunc(EndpointLoginSuccess, login.handleLoginSuccess).Methods(http.MethodGet)
 	router.HandleFunc(EndpointLDAPLogin, login.handleLDAP).Methods(http.MethodGet)
 	router.HandleFunc(EndpointLDAPCallback, login.handleLDAPCallback).Methods(http.MethodPost)
@@ -0,0 +1,58 @@
+document.addEventListener("DOMContentLoaded", function () {
+  const form = document.getElementById("logout-done-form");
+  const timeout = parseInt(form.dataset.frameTimeout, 10);
+  const frames = Array.from(document.getElementsByClassName("logout-frame"));
+
+  const reports = frames.map(function (frame) {
+    return loadLogoutFrame(frame, timeout).then(function (reason) {
+      return reportLogoutFrame(form, frame, reason);
+    });
+  });
+  Promise.all(reports).then(continueLogout);
+});
+
+// loadLogoutFrame resolves with an empty reason once the logout page of the relying party is loaded
+// or with the reason of the failure if it did not load in time.
+// The source is only set after the listener is registered, so no load event is missed.
+function loadLogoutFrame(frame, timeout) {
+  return new Promise(function (resolve) {
+    const timer = setTimeout(function () {
+      resolve("timeout");
+    }, timeout);
+    frame.addEventListener(
+      "load",
+      function () {
+        clearTimeout(timer);
+        resolve("");
+      },
+      { once: true }
+    );
+    frame.src = frame.dataset.src;
+  });
+}
+
+function reportLogoutFrame(form, frame, reason) {
+  const body = new URLSearchParams();
+  body.append("gorilla.csrf.Token", form.elements["gorilla.csrf.Token"].value);
+  body.append("logoutID", frame.dataset.logoutId);
+  body.append("targetID", frame.dataset.targetId);
+  body.append("targetType", frame.dataset.targetType);
+  body.append("succeeded", reason === "" ? "true" : "false");
+  body.append("reason", reason);
+  return fetch(form.dataset.reportUrl, {
+    method: "POST",
+    body: body,
+    credentials: "same-origin",
+  }).catch(function () {
+    // the result is not known to ZITADEL, the logout is still reported as delegated
+  });
+}
+
+// continueLogout redirects to the post_logout_redirect_uri of the relying party,
+// after all relying parties were logged out.
+function continueLogout() {
+  const link = document.getElementById("logout-continue");
+  if (link) {
+    window.location.href = link.href;
+  }
+}
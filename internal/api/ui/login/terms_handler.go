@@ -0,0 +1,57 @@
+package login
+
+import (
+	"net/http"
+
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/notification/templates"
+)
+
+const (
+	tmplAcceptTerms = "acceptterms"
+)
+
+type acceptTermsFormData struct {
+	Version string `schema:"version"`
+}
+
+type acceptTermsData struct {
+	userData
+	Version string
+}
+
+func (l *Login) renderAcceptTerms(w http.ResponseWriter, r *http.Request, authReq *domain.AuthRequest, step *domain.AcceptTermsStep, err error) {
+	var errID, errMessage string
+	if err != nil {
+		errID, errMessage = l.getErrorMessage(r, err)
+	}
+	translator := l.getTranslator(r.Context(), authReq)
+	data := acceptTermsData{
+		userData: l.getUserData(r, authReq, translator, "AcceptTerms.Title", "AcceptTerms.Description", errID, errMessage),
+		Version:  step.Version,
+	}
+	// the links recorded with the published version take precedence over the ones of the current privacy policy
+	lang := LanguageData{Lang: data.Lang}
+	if link, err := templates.ParseTemplateText(step.TOSLink, lang); err == nil && link != "" {
+		data.TOSLink = link
+	}
+	if link, err := templates.ParseTemplateText(step.PrivacyLink, lang); err == nil && link != "" {
+		data.PrivacyLink = link
+	}
+	l.renderer.RenderTemplate(w, r, translator, l.renderer.Templates[tmplAcceptTerms], data, nil)
+}
+
+func (l *Login) handleAcceptTerms(w http.ResponseWriter, r *http.Request) {
+	data := new(acceptTermsFormData)
+	authReq, err := l.ensureAuthRequestAndParseData(r, data)
+	if err != nil {
+		l.renderError(w, r, authReq, err)
+		return
+	}
+	_, err = l.command.AcceptTerms(setContext(r.Context(), authReq.UserOrgID), authReq.UserID, authReq.UserOrgID, data.Version)
+	if err != nil {
+		l.renderAcceptTerms(w, r, authReq, &domain.AcceptTermsStep{Version: data.Version}, err)
+		return
+	}
+	l.renderNextStep(w, r, authReq)
+}
@@ -0,0 +1,124 @@
+package login
+
+import (
+	"context"
+	"net/http"
+	"strings"
+
+	"github.com/zitadel/logging"
+
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/query"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+const (
+	tmplUsernameRecovery     = "usernamerecovery"
+	tmplUsernameRecoveryDone = "usernamerecoverydone"
+)
+
+type usernameRecoveryFormData struct {
+	EmailOrPhone string `schema:"emailOrPhone"`
+}
+
+func (l *Login) handleUsernameRecovery(w http.ResponseWriter, r *http.Request) {
+	authReq, err := l.getAuthRequest(r)
+	if err != nil {
+		l.renderError(w, r, authReq, err)
+		return
+	}
+	l.renderUsernameRecovery(w, r, authReq, nil)
+}
+
+// handleUsernameRecoveryCheck requests a notification with the login names of the user
+// owning the verified email or phone.
+// The same response is rendered whether a user was found or not, so the flow cannot be used
+// to check if an email address or phone number is known.
+func (l *Login) handleUsernameRecoveryCheck(w http.ResponseWriter, r *http.Request) {
+	data := new(usernameRecoveryFormData)
+	authReq, err := l.getAuthRequestAndParseData(r, data)
+	if err != nil {
+		l.renderUsernameRecovery(w, r, authReq, err)
+		return
+	}
+	if authReq == nil {
+		l.renderUsernameRecovery(w, r, nil, zerrors.ThrowInvalidArgument(nil, "LOGIN-Urc1o", "Errors.AuthRequest.NotFound"))
+		return
+	}
+	if err = l.checkCaptcha(r, authReq.RequestedOrgID, domain.CaptchaEndpointPasswordReset); err != nil {
+		l.renderUsernameRecovery(w, r, authReq, err)
+		return
+	}
+	notificationType, contactQuery, err := usernameRecoveryContactQuery(data.EmailOrPhone)
+	if err != nil {
+		l.renderUsernameRecovery(w, r, authReq, err)
+		return
+	}
+	err = l.requestUsernameRecovery(r.Context(), authReq, notificationType, contactQuery)
+	logging.WithFields("authRequestID", authReq.ID).OnError(err).Info("username recovery not requested")
+	l.renderUsernameRecoveryDone(w, r, authReq, nil)
+}
+
+func (l *Login) requestUsernameRecovery(ctx context.Context, authReq *domain.AuthRequest, notificationType domain.NotificationType, contactQuery query.SearchQuery) error {
+	queries := []query.SearchQuery{contactQuery}
+	if authReq.RequestedOrgID != "" {
+		resourceOwnerQuery, err := query.NewUserResourceOwnerSearchQuery(authReq.RequestedOrgID, query.TextEquals)
+		if err != nil {
+			return err
+		}
+		queries = append(queries, resourceOwnerQuery)
+	}
+	user, err := l.query.GetNotifyUser(ctx, false, queries...)
+	if err != nil {
+		return err
+	}
+	_, err = l.command.RequestUsernameRecovery(setContext(ctx, user.ResourceOwner), user.ID, user.ResourceOwner, notificationType, authReq.ID)
+	return err
+}
+
+// usernameRecoveryContactQuery returns the search query for a verified email, if the input contains an @,
+// or for a verified phone number otherwise.
+func usernameRecoveryContactQuery(emailOrPhone string) (domain.NotificationType, query.SearchQuery, error) {
+	emailOrPhone = strings.TrimSpace(emailOrPhone)
+	if emailOrPhone == "" {
+		return 0, nil, zerrors.ThrowInvalidArgument(nil, "LOGIN-Urc2o", "Errors.User.EmailOrPhoneMissing")
+	}
+	if strings.Contains(emailOrPhone, "@") {
+		emailQuery, err := query.NewUserVerifiedEmailSearchQuery(emailOrPhone)
+		return domain.NotificationTypeEmail, emailQuery, err
+	}
+	phone, err := domain.PhoneNumber(emailOrPhone).Normalize()
+	if err != nil {
+		return 0, nil, err
+	}
+	phoneQuery, err := query.NewUserVerifiedPhoneSearchQuery(string(phone), query.TextEquals)
+	return domain.NotificationTypeSms, phoneQuery, err
+}
+
+func (l *Login) renderUsernameRecovery(w http.ResponseWriter, r *http.Request, authReq *domain.AuthRequest, err error) {
+	var errID, errMessage string
+	if err != nil {
+		errID, errMessage = l.getErrorMessage(r, err)
+	}
+	translator := l.getTranslator(r.Context(), authReq)
+	data := l.getUserData(r, authReq, translator, "UsernameRecovery.Title", "UsernameRecovery.Description", errID, errMessage)
+	funcs := map[string]interface{}{
+		"usernameRecoveryCaptcha": func() *captchaData {
+			if authReq == nil {
+				return nil
+			}
+			return l.getCaptchaData(r.Context(), authReq.RequestedOrgID, domain.CaptchaEndpointPasswordReset, "UsernameRecovery.CaptchaLabel")
+		},
+	}
+	l.renderer.RenderTemplate(w, r, translator, l.renderer.Templates[tmplUsernameRecovery], data, funcs)
+}
+
+func (l *Login) renderUsernameRecoveryDone(w http.ResponseWriter, r *http.Request, authReq *domain.AuthRequest, err error) {
+	var errID, errMessage string
+	if err != nil {
+		errID, errMessage = l.getErrorMessage(r, err)
+	}
+	translator := l.getTranslator(r.Context(), authReq)
+	data := l.getUserData(r, authReq, translator, "UsernameRecoveryDone.Title", "UsernameRecoveryDone.Description", errID, errMessage)
+	l.renderer.RenderTemplate(w, r, translator, l.renderer.Templates[tmplUsernameRecoveryDone], data, nil)
+}
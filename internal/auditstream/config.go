@@ -0,0 +1,102 @@
+package auditstream
+
+import (
+	"strings"
+	"time"
+
+	"github.com/zitadel/zitadel/internal/backoff"
+)
+
+// Config defines which events are streamed to which security information and event management (SIEM) system
+type Config struct {
+	// Enabled streams the events of all instances to the configured sink
+	Enabled bool
+	// EventTypes restricts the streamed events, a filter ending with * matches by prefix.
+	// All events are streamed if empty.
+	EventTypes []string
+	// Format of the streamed records, json or cef
+	Format Format
+	Sink   SinkConfig
+	Retry  RetryConfig
+}
+
+type Format string
+
+const (
+	FormatJSON Format = "json"
+	FormatCEF  Format = "cef"
+)
+
+type SinkType string
+
+const (
+	SinkTypeKafka  SinkType = "kafka"
+	SinkTypeSplunk SinkType = "splunk"
+	SinkTypeSyslog SinkType = "syslog"
+)
+
+// SinkConfig defines the system the records are streamed to, only the configuration of the chosen type is used
+type SinkConfig struct {
+	Type   SinkType
+	Kafka  KafkaConfig
+	Splunk SplunkConfig
+	Syslog SyslogConfig
+}
+
+// KafkaConfig produces the records to a topic through a Kafka REST proxy
+type KafkaConfig struct {
+	// Endpoint is the base URL of the REST proxy, e.g. https://kafka-rest.example.com
+	Endpoint string
+	Topic    string
+	Username string
+	Password string
+	Timeout  time.Duration
+}
+
+// SplunkConfig sends the records to a Splunk HTTP Event Collector (HEC)
+type SplunkConfig struct {
+	// Endpoint is the base URL of the collector, e.g. https://splunk.example.com:8088
+	Endpoint string
+	Token    string
+	// Index is optional, the default index of the token is used if empty
+	Index   string
+	Timeout time.Duration
+}
+
+// SyslogConfig sends the records as RFC 5424 messages
+type SyslogConfig struct {
+	// Network is udp, tcp or tls, messages sent over tcp and tls are octet-counted (RFC 6587)
+	Network string
+	// Address of the syslog server, e.g. syslog.example.com:6514
+	Address string
+	// Facility is the syslog facility code, 13 (log audit) by default
+	Facility uint8
+	Timeout  time.Duration
+}
+
+// RetryConfig defines the exponential backoff used if the sink is unavailable
+type RetryConfig struct {
+	// MaxAttempts is the maximum number of attempts to send a record, including the first one.
+	// If all attempts failed, the handler stops and continues with the same record on its next run.
+	MaxAttempts    uint16
+	backoff.Config `mapstructure:",squash"`
+}
+
+// matches checks if the event type is streamed
+func (c *Config) matches(eventType string) bool {
+	if len(c.EventTypes) == 0 {
+		return true
+	}
+	for _, filter := range c.EventTypes {
+		if prefix, ok := strings.CutSuffix(filter, "*"); ok {
+			if strings.HasPrefix(eventType, prefix) {
+				return true
+			}
+			continue
+		}
+		if filter == eventType {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,125 @@
+package auditstream
+
+import (
+	"context"
+
+	"github.com/zitadel/logging"
+
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/eventstore/handler/v2"
+	"github.com/zitadel/zitadel/internal/query/projection"
+)
+
+const (
+	HandlerTable = "projections.audit_stream"
+)
+
+type eventHandler struct {
+	config     *Config
+	eventTypes map[eventstore.AggregateType][]eventstore.EventType
+	sink       Sink
+}
+
+// NewEventHandler returns the handler which streams the events to the sink.
+// The position of the handler is only updated after the sink accepted the records, so every event is delivered at least once.
+// If the sink is unavailable, the handler retries the same record on its next run and doesn't read further events in the meantime.
+func NewEventHandler(
+	ctx context.Context,
+	handlerConfig handler.Config,
+	config *Config,
+	eventTypes []string,
+	aggregateTypeFromEventType func(typ eventstore.EventType) eventstore.AggregateType,
+	sink Sink,
+) *handler.Handler {
+	return handler.NewHandler(ctx, &handlerConfig, &eventHandler{
+		config:     config,
+		eventTypes: groupEventTypes(config, eventTypes, aggregateTypeFromEventType),
+		sink:       sink,
+	})
+}
+
+// groupEventTypes groups the streamed event types by their aggregate types
+func groupEventTypes(config *Config, eventTypes []string, aggregateTypeFromEventType func(typ eventstore.EventType) eventstore.AggregateType) map[eventstore.AggregateType][]eventstore.EventType {
+	grouped := make(map[eventstore.AggregateType][]eventstore.EventType)
+	for _, eventType := range eventTypes {
+		if !config.matches(eventType) {
+			continue
+		}
+		aggregateType := aggregateTypeFromEventType(eventstore.EventType(eventType))
+		if aggregateType == "" {
+			continue
+		}
+		grouped[aggregateType] = append(grouped[aggregateType], eventstore.EventType(eventType))
+	}
+	return grouped
+}
+
+func (*eventHandler) Name() string {
+	return HandlerTable
+}
+
+func (h *eventHandler) Reducers() []handler.AggregateReducer {
+	reducers := make([]handler.AggregateReducer, 0, len(h.eventTypes))
+	for aggregateType, eventTypes := range h.eventTypes {
+		eventReducers := make([]handler.EventReducer, len(eventTypes))
+		for i, eventType := range eventTypes {
+			eventReducers[i] = handler.EventReducer{
+				Event:  eventType,
+				Reduce: h.reduce,
+			}
+		}
+		reducers = append(reducers, handler.AggregateReducer{
+			Aggregate:     aggregateType,
+			EventReducers: eventReducers,
+		})
+	}
+	return reducers
+}
+
+func (h *eventHandler) reduce(event eventstore.Event) (*handler.Statement, error) {
+	record := RecordFromEvent(event)
+	message, err := record.Format(h.config.Format)
+	if err != nil {
+		return nil, err
+	}
+	return handler.NewStatement(event, func(ex handler.Executer, projectionName string) error {
+		_, err := h.config.Retry.Retry(context.Background(), h.config.Retry.MaxAttempts, func(ctx context.Context) error {
+			return h.sink.Send(ctx, record, message)
+		})
+		logging.WithFields("instance", record.InstanceID, "event", record.EventType, "sequence", record.Sequence).OnError(err).Warn("streaming event failed")
+		return err
+	}), nil
+}
+
+var projections []*handler.Handler
+
+// Register creates the handler which streams the events, if enabled. It's started with [Start].
+func Register(
+	ctx context.Context,
+	customConfig projection.CustomConfig,
+	config *Config,
+	es *eventstore.Eventstore,
+) error {
+	if config == nil || !config.Enabled {
+		return nil
+	}
+	sink, err := NewSink(config)
+	if err != nil {
+		return err
+	}
+	projections = append(projections, NewEventHandler(
+		ctx,
+		projection.ApplyCustomConfig(customConfig),
+		config,
+		es.EventTypes(),
+		eventstore.AggregateTypeFromEventType,
+		sink,
+	))
+	return nil
+}
+
+func Start(ctx context.Context) {
+	for _, projection := range projections {
+		projection.Start(ctx)
+	}
+}
@@ -0,0 +1,74 @@
+package auditstream
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+const kafkaJSONContentType = "application/vnd.kafka.json.v2+json"
+
+type kafkaSink struct {
+	config *KafkaConfig
+	format Format
+	url    string
+	client *http.Client
+}
+
+func newKafkaSink(config *KafkaConfig, format Format) (*kafkaSink, error) {
+	if config.Endpoint == "" || config.Topic == "" {
+		return nil, zerrors.ThrowInvalidArgument(nil, "AUDIT-b7rx1lw5ne", "kafka endpoint and topic are required")
+	}
+	topicURL, err := url.JoinPath(strings.TrimSuffix(config.Endpoint, "/"), "topics", config.Topic)
+	if err != nil {
+		return nil, zerrors.ThrowInvalidArgument(err, "AUDIT-h3qk9p0vzs", "kafka endpoint is invalid")
+	}
+	return &kafkaSink{
+		config: config,
+		format: format,
+		url:    topicURL,
+		client: &http.Client{Timeout: config.Timeout},
+	}, nil
+}
+
+type kafkaRecords struct {
+	Records []kafkaRecord `json:"records"`
+}
+
+type kafkaRecord struct {
+	Key   string          `json:"key"`
+	Value json.RawMessage `json:"value"`
+}
+
+// Send produces the record with the key of its aggregate, so the records of an aggregate stay ordered within a partition
+func (s *kafkaSink) Send(ctx context.Context, record *Record, message []byte) error {
+	value := json.RawMessage(message)
+	if s.format == FormatCEF {
+		var err error
+		if value, err = json.Marshal(string(message)); err != nil {
+			return err
+		}
+	}
+	body, err := json.Marshal(&kafkaRecords{Records: []kafkaRecord{{Key: record.Key(), Value: value}}})
+	if err != nil {
+		return err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, s.url, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", kafkaJSONContentType)
+	if s.config.Username != "" {
+		req.SetBasicAuth(s.config.Username, s.config.Password)
+	}
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return err
+	}
+	return checkResponse(resp)
+}
@@ -0,0 +1,120 @@
+package auditstream
+
+import (
+	"encoding/json"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/zitadel/zitadel/cmd/build"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+// Record is the structured representation of an event streamed to the sink
+type Record struct {
+	InstanceID    string          `json:"instanceID"`
+	ResourceOwner string          `json:"resourceOwner"`
+	AggregateType string          `json:"aggregateType"`
+	AggregateID   string          `json:"aggregateID"`
+	Sequence      uint64          `json:"sequence"`
+	EventType     string          `json:"eventType"`
+	CreatedAt     time.Time       `json:"createdAt"`
+	Editor        string          `json:"editor,omitempty"`
+	Payload       json.RawMessage `json:"payload,omitempty"`
+}
+
+func RecordFromEvent(event eventstore.Event) *Record {
+	record := &Record{
+		InstanceID:    event.Aggregate().InstanceID,
+		ResourceOwner: event.Aggregate().ResourceOwner,
+		AggregateType: string(event.Aggregate().Type),
+		AggregateID:   event.Aggregate().ID,
+		Sequence:      event.Sequence(),
+		EventType:     string(event.Type()),
+		CreatedAt:     event.CreatedAt(),
+		Editor:        event.Creator(),
+	}
+	if payload := event.DataAsBytes(); len(payload) > 0 && json.Valid(payload) {
+		record.Payload = payload
+	}
+	return record
+}
+
+// Key identifies the aggregate of the record, so sinks which partition by key keep the order per aggregate
+func (r *Record) Key() string {
+	return r.InstanceID + ":" + r.AggregateType + ":" + r.AggregateID
+}
+
+// Format returns the record as JSON object or as Common Event Format (CEF) line
+func (r *Record) Format(format Format) ([]byte, error) {
+	switch format {
+	case FormatJSON, "":
+		return json.Marshal(r)
+	case FormatCEF:
+		return []byte(r.cef()), nil
+	}
+	return nil, zerrors.ThrowInvalidArgumentf(nil, "AUDIT-x2lq8vnd3c", "unsupported format %s", format)
+}
+
+// cef formats the record as CEF:Version|Device Vendor|Device Product|Device Version|Signature ID|Name|Severity|Extension
+func (r *Record) cef() string {
+	var b strings.Builder
+	b.WriteString("CEF:0|ZITADEL|ZITADEL|")
+	b.WriteString(cefHeaderEscaper.Replace(build.Version()))
+	b.WriteString("|")
+	b.WriteString(cefHeaderEscaper.Replace(r.EventType))
+	b.WriteString("|")
+	b.WriteString(cefHeaderEscaper.Replace(r.EventType))
+	b.WriteString("|")
+	b.WriteString(strconv.Itoa(cefSeverity(r.EventType)))
+	b.WriteString("|")
+	extensions := []struct{ key, value string }{
+		{"rt", strconv.FormatInt(r.CreatedAt.UnixMilli(), 10)},
+		{"suser", r.Editor},
+		{"duid", r.AggregateID},
+		{"cs1Label", "instanceID"},
+		{"cs1", r.InstanceID},
+		{"cs2Label", "resourceOwner"},
+		{"cs2", r.ResourceOwner},
+		{"cs3Label", "aggregateType"},
+		{"cs3", r.AggregateType},
+		{"cn1Label", "sequence"},
+		{"cn1", strconv.FormatUint(r.Sequence, 10)},
+	}
+	if len(r.Payload) > 0 {
+		extensions = append(extensions, struct{ key, value string }{"msg", string(r.Payload)})
+	}
+	for i, extension := range extensions {
+		if extension.value == "" {
+			continue
+		}
+		if i > 0 {
+			b.WriteString(" ")
+		}
+		b.WriteString(extension.key)
+		b.WriteString("=")
+		b.WriteString(cefExtensionEscaper.Replace(extension.value))
+	}
+	return b.String()
+}
+
+var (
+	cefHeaderEscaper    = strings.NewReplacer(`\`, `\\`, `|`, `\|`, "\n", " ", "\r", " ")
+	cefExtensionEscaper = strings.NewReplacer(`\`, `\\`, `=`, `\=`, "\n", `\n`, "\r", `\r`)
+)
+
+// cefSeverity rates failed authentications and removals higher than other events
+func cefSeverity(eventType string) int {
+	switch {
+	case strings.Contains(eventType, ".check.failed"),
+		strings.Contains(eventType, ".locked"),
+		strings.HasSuffix(eventType, ".removed"):
+		return 7
+	case strings.Contains(eventType, ".member."),
+		strings.Contains(eventType, ".idp."),
+		strings.Contains(eventType, ".password.changed"):
+		return 5
+	}
+	return 3
+}
@@ -0,0 +1,70 @@
+package auditstream
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/zitadel/zitadel/cmd/build"
+)
+
+func testRecord() *Record {
+	return &Record{
+		InstanceID:    "instance1",
+		ResourceOwner: "org1",
+		AggregateType: "user",
+		AggregateID:   "user1",
+		Sequence:      3,
+		EventType:     "user.human.password.check.failed",
+		CreatedAt:     time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+		Editor:        "editor1",
+		Payload:       json.RawMessage(`{"userAgentID":"a=b|c"}`),
+	}
+}
+
+func TestRecord_Format(t *testing.T) {
+	tests := []struct {
+		name    string
+		format  Format
+		want    string
+		wantErr bool
+	}{
+		{
+			name:   "json",
+			format: FormatJSON,
+			want:   `{"instanceID":"instance1","resourceOwner":"org1","aggregateType":"user","aggregateID":"user1","sequence":3,"eventType":"user.human.password.check.failed","createdAt":"2024-01-01T00:00:00Z","editor":"editor1","payload":{"userAgentID":"a=b|c"}}`,
+		},
+		{
+			name:   "cef",
+			format: FormatCEF,
+			want:   `CEF:0|ZITADEL|ZITADEL|` + build.Version() + `|user.human.password.check.failed|user.human.password.check.failed|7|rt=1704067200000 suser=editor1 duid=user1 cs1Label=instanceID cs1=instance1 cs2Label=resourceOwner cs2=org1 cs3Label=aggregateType cs3=user cn1Label=sequence cn1=3 msg={"userAgentID":"a\=b|c"}`,
+		},
+		{
+			name:    "unsupported",
+			format:  "xml",
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := testRecord().Format(tt.format)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, string(got))
+		})
+	}
+}
+
+func TestConfig_matches(t *testing.T) {
+	config := &Config{EventTypes: []string{"user.human.password.check.failed", "org.member.*"}}
+	assert.True(t, config.matches("user.human.password.check.failed"))
+	assert.True(t, config.matches("org.member.added"))
+	assert.False(t, config.matches("user.human.added"))
+	assert.True(t, (&Config{}).matches("user.human.added"))
+}
@@ -0,0 +1,36 @@
+package auditstream
+
+import (
+	"context"
+	"io"
+	"net/http"
+
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+// Sink delivers formatted records to a SIEM system
+type Sink interface {
+	Send(ctx context.Context, record *Record, message []byte) error
+}
+
+func NewSink(config *Config) (Sink, error) {
+	switch config.Sink.Type {
+	case SinkTypeKafka:
+		return newKafkaSink(&config.Sink.Kafka, config.Format)
+	case SinkTypeSplunk:
+		return newSplunkSink(&config.Sink.Splunk, config.Format)
+	case SinkTypeSyslog:
+		return newSyslogSink(&config.Sink.Syslog)
+	}
+	return nil, zerrors.ThrowInvalidArgumentf(nil, "AUDIT-k4nd8s1q0w", "unsupported sink type %s", config.Sink.Type)
+}
+
+// checkResponse returns an error if the sink didn't accept the request
+func checkResponse(resp *http.Response) error {
+	defer resp.Body.Close()
+	_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, 1<<16))
+	if resp.StatusCode >= 200 && resp.StatusCode < 300 {
+		return nil
+	}
+	return zerrors.ThrowUnavailablef(nil, "AUDIT-p0v6l3dm8x", "sink responded with status %d", resp.StatusCode)
+}
@@ -0,0 +1,141 @@
+package auditstream
+
+import (
+	"bufio"
+	"context"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_kafkaSink_Send(t *testing.T) {
+	var gotPath, gotContentType, gotBody, gotUser string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotPath = r.URL.Path
+		gotContentType = r.Header.Get("Content-Type")
+		gotUser, _, _ = r.BasicAuth()
+		body, _ := io.ReadAll(r.Body)
+		gotBody = string(body)
+	}))
+	defer server.Close()
+
+	sink, err := NewSink(&Config{
+		Format: FormatCEF,
+		Sink: SinkConfig{
+			Type:  SinkTypeKafka,
+			Kafka: KafkaConfig{Endpoint: server.URL + "/", Topic: "audit", Username: "user"},
+		},
+	})
+	require.NoError(t, err)
+	err = sink.Send(context.Background(), testRecord(), []byte("CEF:0|ZITADEL"))
+	require.NoError(t, err)
+	assert.Equal(t, "/topics/audit", gotPath)
+	assert.Equal(t, kafkaJSONContentType, gotContentType)
+	assert.Equal(t, "user", gotUser)
+	assert.JSONEq(t, `{"records":[{"key":"instance1:user:user1","value":"CEF:0|ZITADEL"}]}`, gotBody)
+}
+
+func Test_splunkSink_Send(t *testing.T) {
+	tests := []struct {
+		name    string
+		status  int
+		wantErr bool
+	}{
+		{
+			name:   "accepted",
+			status: http.StatusOK,
+		},
+		{
+			name:    "unavailable, error",
+			status:  http.StatusServiceUnavailable,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var gotPath, gotAuthorization, gotBody string
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				gotPath = r.URL.Path
+				gotAuthorization = r.Header.Get("Authorization")
+				body, _ := io.ReadAll(r.Body)
+				gotBody = string(body)
+				w.WriteHeader(tt.status)
+			}))
+			defer server.Close()
+
+			sink, err := NewSink(&Config{
+				Format: FormatJSON,
+				Sink: SinkConfig{
+					Type:   SinkTypeSplunk,
+					Splunk: SplunkConfig{Endpoint: server.URL, Token: "token", Index: "security"},
+				},
+			})
+			require.NoError(t, err)
+			err = sink.Send(context.Background(), testRecord(), []byte(`{"eventType":"user.human.password.check.failed"}`))
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, "/services/collector/event", gotPath)
+			assert.Equal(t, "Splunk token", gotAuthorization)
+			assert.JSONEq(t, `{"time":1704067200,"source":"zitadel","sourcetype":"_json","index":"security","event":{"eventType":"user.human.password.check.failed"}}`, gotBody)
+		})
+	}
+}
+
+func Test_syslogSink_Send(t *testing.T) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	defer listener.Close()
+	received := make(chan string, 1)
+	go func() {
+		conn, err := listener.Accept()
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+		reader := bufio.NewReader(conn)
+		length, err := reader.ReadString(' ')
+		if err != nil {
+			return
+		}
+		n, _ := strconv.Atoi(strings.TrimSpace(length))
+		msg := make([]byte, n)
+		_, _ = io.ReadFull(reader, msg)
+		received <- string(msg)
+	}()
+
+	sink, err := NewSink(&Config{
+		Sink: SinkConfig{
+			Type:   SinkTypeSyslog,
+			Syslog: SyslogConfig{Network: "tcp", Address: listener.Addr().String(), Timeout: time.Second},
+		},
+	})
+	require.NoError(t, err)
+	err = sink.Send(context.Background(), testRecord(), []byte("{}"))
+	require.NoError(t, err)
+
+	select {
+	case msg := <-received:
+		hostname := sink.(*syslogSink).hostname
+		assert.Equal(t, "<109>1 2024-01-01T00:00:00Z "+hostname+" zitadel - user.human.password.check.failed - {}", msg)
+	case <-time.After(5 * time.Second):
+		t.Fatal("no message received")
+	}
+}
+
+func TestNewSink(t *testing.T) {
+	_, err := NewSink(&Config{Sink: SinkConfig{Type: "ftp"}})
+	assert.Error(t, err)
+	_, err = NewSink(&Config{Sink: SinkConfig{Type: SinkTypeSyslog, Syslog: SyslogConfig{Network: "unix"}}})
+	assert.Error(t, err)
+}
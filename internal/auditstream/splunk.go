@@ -0,0 +1,75 @@
+package auditstream
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+type splunkSink struct {
+	config *SplunkConfig
+	format Format
+	url    string
+	client *http.Client
+}
+
+func newSplunkSink(config *SplunkConfig, format Format) (*splunkSink, error) {
+	if config.Endpoint == "" || config.Token == "" {
+		return nil, zerrors.ThrowInvalidArgument(nil, "AUDIT-s9fw2mc7jt", "splunk endpoint and token are required")
+	}
+	collectorURL, err := url.JoinPath(strings.TrimSuffix(config.Endpoint, "/"), "services", "collector", "event")
+	if err != nil {
+		return nil, zerrors.ThrowInvalidArgument(err, "AUDIT-y1eg5tq8ro", "splunk endpoint is invalid")
+	}
+	return &splunkSink{
+		config: config,
+		format: format,
+		url:    collectorURL,
+		client: &http.Client{Timeout: config.Timeout},
+	}, nil
+}
+
+type splunkEvent struct {
+	Time       float64         `json:"time"`
+	Source     string          `json:"source"`
+	SourceType string          `json:"sourcetype"`
+	Index      string          `json:"index,omitempty"`
+	Event      json.RawMessage `json:"event"`
+}
+
+func (s *splunkSink) Send(ctx context.Context, record *Record, message []byte) error {
+	event := &splunkEvent{
+		Time:       float64(record.CreatedAt.UnixMilli()) / 1000,
+		Source:     "zitadel",
+		SourceType: "_json",
+		Index:      s.config.Index,
+		Event:      message,
+	}
+	if s.format == FormatCEF {
+		event.SourceType = "cef"
+		var err error
+		if event.Event, err = json.Marshal(string(message)); err != nil {
+			return err
+		}
+	}
+	body, err := json.Marshal(event)
+	if err != nil {
+		return err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, s.url, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Authorization", "Splunk "+s.config.Token)
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return err
+	}
+	return checkResponse(resp)
+}
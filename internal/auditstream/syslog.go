@@ -0,0 +1,104 @@
+package auditstream
+
+import (
+	"context"
+	"crypto/tls"
+	"net"
+	"os"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+const (
+	syslogFacilityLogAudit = 13
+	syslogSeverityNotice   = 5
+	syslogAppName          = "zitadel"
+)
+
+type syslogSink struct {
+	config   *SyslogConfig
+	hostname string
+
+	mu   sync.Mutex
+	conn net.Conn
+}
+
+func newSyslogSink(config *SyslogConfig) (*syslogSink, error) {
+	switch config.Network {
+	case "udp", "tcp", "tls":
+	default:
+		return nil, zerrors.ThrowInvalidArgumentf(nil, "AUDIT-u6mz0rk3pa", "unsupported syslog network %s", config.Network)
+	}
+	if config.Address == "" {
+		return nil, zerrors.ThrowInvalidArgument(nil, "AUDIT-c8ty4nw2lf", "syslog address is required")
+	}
+	hostname, err := os.Hostname()
+	if err != nil {
+		hostname = "-"
+	}
+	return &syslogSink{
+		config:   config,
+		hostname: hostname,
+	}, nil
+}
+
+// Send writes the message to the connection, which is established again after a failed write
+func (s *syslogSink) Send(ctx context.Context, record *Record, message []byte) (err error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.conn == nil {
+		if s.conn, err = s.dial(ctx); err != nil {
+			return err
+		}
+	}
+	if s.config.Timeout > 0 {
+		if err = s.conn.SetWriteDeadline(time.Now().Add(s.config.Timeout)); err != nil {
+			return s.reset(err)
+		}
+	}
+	if _, err = s.conn.Write(s.frame(record, message)); err != nil {
+		return s.reset(err)
+	}
+	return nil
+}
+
+func (s *syslogSink) dial(ctx context.Context) (net.Conn, error) {
+	dialer := &net.Dialer{Timeout: s.config.Timeout}
+	if s.config.Network == "tls" {
+		return (&tls.Dialer{NetDialer: dialer}).DialContext(ctx, "tcp", s.config.Address)
+	}
+	return dialer.DialContext(ctx, s.config.Network, s.config.Address)
+}
+
+func (s *syslogSink) reset(err error) error {
+	_ = s.conn.Close()
+	s.conn = nil
+	return err
+}
+
+// frame formats the RFC 5424 message, which is prefixed by its length on stream connections (RFC 6587)
+func (s *syslogSink) frame(record *Record, message []byte) []byte {
+	facility := int(s.config.Facility)
+	if facility == 0 {
+		facility = syslogFacilityLogAudit
+	}
+	// the message id is limited to 32 characters
+	msgID := record.EventType
+	if len(msgID) > 32 {
+		msgID = msgID[:32]
+	}
+	msg := "<" + strconv.Itoa(facility*8+syslogSeverityNotice) + ">1 " +
+		record.CreatedAt.UTC().Format(time.RFC3339Nano) + " " +
+		s.hostname + " " +
+		syslogAppName + " - " +
+		msgID + " - " +
+		string(message)
+	if s.config.Network == "udp" {
+		return []byte(msg)
+	}
+	return []byte(strconv.Itoa(len(msg)) + " " + msg)
+}
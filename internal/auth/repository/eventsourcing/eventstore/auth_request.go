@@ -2,6 +2,7 @@ package eventstore
 
 import (
 	"context"
+	"net"
 	"slices"
 	"strings"
 	"time"
@@ -52,6 +53,13 @@ type AuthRequestRepo struct {
 	ProjectProvider           projectProvider
 	ApplicationProvider       applicationProvider
 	CustomTextProvider        customTextProvider
+	TrustedDeviceProvider     trustedDeviceProvider
+	BreakGlassProvider        breakGlassProvider
+	NetworkAccessProvider     networkAccessProvider
+	NetworkPolicyProvider     networkPolicyProvider
+	TermsProvider             termsProvider
+	ConsentProvider           consentProvider
+	ProfileProvider           profileProvider
 
 	IdGenerator id.Generator
 }
@@ -88,6 +96,7 @@ type passwordAgePolicyProvider interface {
 
 type idpProviderViewProvider interface {
 	IDPLoginPolicyLinks(context.Context, string, *query.IDPLoginPolicyLinksSearchQuery, bool) (*query.IDPLoginPolicyLinks, error)
+	LoginPolicyIDPButtonsByOwner(context.Context, string) (*query.LoginPolicyIDPButtons, error)
 }
 
 type idpUserLinksProvider interface {
@@ -98,8 +107,40 @@ type userEventProvider interface {
 	UserEventsByID(ctx context.Context, id string, changeDate time.Time, eventTypes []eventstore.EventType) ([]eventstore.Event, error)
 }
 
+type trustedDeviceProvider interface {
+	TrustedDeviceByUserAgent(ctx context.Context, userID, userAgentID string) (*query.TrustedDevice, error)
+}
+
+type breakGlassProvider interface {
+	IsBreakGlassUser(ctx context.Context, userID string) (bool, error)
+}
+
+type networkAccessProvider interface {
+	CheckNetworkAccessPolicies(ctx context.Context, phase domain.NetworkAccessPhase, userID, userResourceOwner, clientID string, remoteIP net.IP, policies *domain.NetworkAccessPolicies, recordMonitored bool) (bool, error)
+}
+
+type networkPolicyProvider interface {
+	NetworkAccessPolicies(ctx context.Context, orgID, clientID string) (*domain.NetworkAccessPolicies, error)
+}
+
+type termsProvider interface {
+	ActiveTermsVersion(ctx context.Context, orgID string) (*query.TermsVersion, error)
+	UserTermsAcceptance(ctx context.Context, userID string) (*query.TermsAcceptance, error)
+}
+
+type consentProvider interface {
+	UserConsentByApp(ctx context.Context, userID, appID string) (*query.UserConsent, error)
+}
+
+type profileProvider interface {
+	ProfileRequirementsByOrg(ctx context.Context, orgID string) (*query.ProfileRequirements, error)
+	UserAttributeSchemaByOrg(ctx context.Context, orgID string) (*query.UserAttributeSchema, error)
+	SearchUserMetadata(ctx context.Context, shouldTriggerBulk bool, userID string, queries *query.UserMetadataSearchQueries, withOwnerRemoved bool) (*query.UserMetadataList, error)
+}
+
 type userCommandProvider interface {
 	BulkAddedUserIDPLinks(ctx context.Context, userID, resourceOwner string, externalIDPs []*domain.UserIDPLink) error
+	HumansSignOut(ctx context.Context, agentID string, userIDs []string) error
 }
 
 type orgViewProvider interface {
@@ -119,6 +160,7 @@ type projectProvider interface {
 
 type applicationProvider interface {
 	AppByOIDCClientID(context.Context, string) (*query.App, error)
+	AppAuthRequirementsByID(ctx context.Context, projectID, appID string) (*domain.AppAuthRequirements, error)
 }
 
 type customTextProvider interface {
@@ -144,6 +186,9 @@ func (repo *AuthRequestRepo) CreateAuthRequest(ctx context.Context, request *dom
 	request.AppendAudIfNotExisting(project.ID)
 	request.ApplicationResourceOwner = project.ResourceOwner
 	request.PrivateLabelingSetting = project.PrivateLabelingSetting
+	if err := repo.applyAppAuthRequirements(ctx, request, project.ID); err != nil {
+		return nil, err
+	}
 	if err := setOrgID(ctx, repo.OrgViewProvider, request); err != nil {
 		return nil, err
 	}
@@ -274,6 +319,9 @@ func (repo *AuthRequestRepo) CheckExternalUserLogin(ctx context.Context, authReq
 	if err != nil {
 		return err
 	}
+	if err = repo.checkNetworkAccess(ctx, request, request.UserID, request.UserOrgID, info); err != nil {
+		return err
+	}
 
 	request.IDPLoginChecked = true
 	err = repo.Command.UserIDPLoginChecked(ctx, request.UserOrgID, request.UserID, request.WithCurrentInfo(info))
@@ -345,6 +393,9 @@ func (repo *AuthRequestRepo) VerifyPassword(ctx context.Context, authReqID, user
 		}
 		return err
 	}
+	if err = repo.checkNetworkAccess(ctx, request, userID, resourceOwner, info); err != nil {
+		return err
+	}
 	err = repo.Command.HumanCheckPassword(ctx, resourceOwner, userID, password, request.WithCurrentInfo(info))
 	if isIgnoreUserInvalidPasswordError(err, request) {
 		return zerrors.ThrowInvalidArgument(nil, "EVENT-Jsf32", "Errors.User.UsernameOrPassword.Invalid")
@@ -352,6 +403,35 @@ func (repo *AuthRequestRepo) VerifyPassword(ctx context.Context, authReqID, user
 	return err
 }
 
+// checkNetworkAccess evaluates the network access policies of the organization of the user and of the application
+// when the user is authenticated by password, passkey or identity provider.
+// An attempt blocked by a monitor only policy is only recorded once per auth request.
+func (repo *AuthRequestRepo) checkNetworkAccess(ctx context.Context, request *domain.AuthRequest, userID, resourceOwner string, info *domain.BrowserInfo) (err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	if userID == "" || resourceOwner == "" {
+		return nil
+	}
+	if info == nil {
+		info = request.BrowserInfo
+	}
+	var remoteIP net.IP
+	if info != nil {
+		remoteIP = info.RemoteIP
+	}
+	policies, err := repo.NetworkPolicyProvider.NetworkAccessPolicies(ctx, resourceOwner, request.ApplicationID)
+	if err != nil {
+		return err
+	}
+	monitored, err := repo.NetworkAccessProvider.CheckNetworkAccessPolicies(ctx, domain.NetworkAccessPhaseLogin, userID, resourceOwner, request.ApplicationID, remoteIP, policies, !request.NetworkAccessMonitored)
+	if err != nil || !monitored || request.NetworkAccessMonitored {
+		return err
+	}
+	request.NetworkAccessMonitored = true
+	return repo.AuthRequests.UpdateAuthRequest(ctx, request)
+}
+
 func isIgnoreUserNotFoundError(err error, request *domain.AuthRequest) bool {
 	return request != nil && request.LoginPolicy != nil && request.LoginPolicy.IgnoreUnknownUsernames && zerrors.IsNotFound(err) && zerrors.Contains(err, "Errors.User.NotFound")
 }
@@ -500,6 +580,9 @@ func (repo *AuthRequestRepo) VerifyPasswordless(ctx context.Context, userID, res
 	if err != nil {
 		return err
 	}
+	if err = repo.checkNetworkAccess(ctx, request, userID, resourceOwner, info); err != nil {
+		return err
+	}
 	return repo.Command.HumanFinishPasswordlessLogin(ctx, userID, resourceOwner, credentialData, request)
 }
 
@@ -510,6 +593,9 @@ func (repo *AuthRequestRepo) LinkExternalUsers(ctx context.Context, authReqID, u
 	if err != nil {
 		return err
 	}
+	if err = repo.checkNetworkAccess(ctx, request, request.UserID, request.UserOrgID, info); err != nil {
+		return err
+	}
 	err = linkExternalIDPs(ctx, repo.UserCommandProvider, request)
 	if err != nil {
 		return err
@@ -523,6 +609,48 @@ func (repo *AuthRequestRepo) LinkExternalUsers(ctx context.Context, authReqID, u
 	return repo.AuthRequests.UpdateAuthRequest(ctx, request)
 }
 
+// AutoLinkExternalUser links the external user to the existing user with the same verified email
+// and continues the login with this user, as if the link had already existed.
+func (repo *AuthRequestRepo) AutoLinkExternalUser(ctx context.Context, authReqID, userAgentID, userID string, externalUser *domain.ExternalUser, info *domain.BrowserInfo) (err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+	request, err := repo.getAuthRequest(ctx, authReqID, userAgentID)
+	if err != nil {
+		return err
+	}
+	user, err := activeUserByID(ctx, repo.UserViewProvider, repo.UserEventProvider, repo.OrgViewProvider, repo.LockoutPolicyViewProvider, userID, false)
+	if err != nil {
+		return err
+	}
+	link := &domain.UserIDPLink{
+		ObjectRoot:     es_models.ObjectRoot{AggregateID: user.ID},
+		IDPConfigID:    externalUser.IDPConfigID,
+		ExternalUserID: externalUser.ExternalUserID,
+		DisplayName:    externalUser.PreferredUsername,
+	}
+	data := authz.CtxData{
+		UserID: "LOGIN",
+		OrgID:  user.ResourceOwner,
+	}
+	err = repo.Command.AutoLinkUserIDP(authz.SetCtxData(ctx, data), user.ID, user.ResourceOwner, link, externalUser.Email)
+	if err != nil {
+		return err
+	}
+	username := user.UserName
+	if request.RequestedOrgID == "" {
+		username = user.PreferredLoginName
+	}
+	request.SetUserInfo(user.ID, username, user.PreferredLoginName, user.DisplayName, user.AvatarKey, user.ResourceOwner)
+	request.SelectedIDPConfigID = externalUser.IDPConfigID
+	request.LinkingUsers = nil
+	request.IDPLoginChecked = true
+	err = repo.Command.UserIDPLoginChecked(ctx, request.UserOrgID, request.UserID, request.WithCurrentInfo(info))
+	if err != nil {
+		return err
+	}
+	return repo.AuthRequests.UpdateAuthRequest(ctx, request)
+}
+
 func (repo *AuthRequestRepo) ResetLinkingUsers(ctx context.Context, authReqID, userAgentID string) error {
 	request, err := repo.getAuthRequest(ctx, authReqID, userAgentID)
 	if err != nil {
@@ -920,7 +1048,7 @@ func (repo *AuthRequestRepo) checkLoginPolicyWithResourceOwner(ctx context.Conte
 }
 
 func queryLoginPolicyToDomain(policy *query.LoginPolicy) *domain.LoginPolicy {
-	return &domain.LoginPolicy{
+	loginPolicy := &domain.LoginPolicy{
 		ObjectRoot: es_models.ObjectRoot{
 			AggregateID:   policy.OrgID,
 			Sequence:      policy.Sequence,
@@ -928,27 +1056,35 @@ func queryLoginPolicyToDomain(policy *query.LoginPolicy) *domain.LoginPolicy {
 			CreationDate:  policy.CreationDate,
 			ChangeDate:    policy.ChangeDate,
 		},
-		Default:                    policy.IsDefault,
-		AllowUsernamePassword:      policy.AllowUsernamePassword,
-		AllowRegister:              policy.AllowRegister,
-		AllowExternalIDP:           policy.AllowExternalIDPs,
-		ForceMFA:                   policy.ForceMFA,
-		ForceMFALocalOnly:          policy.ForceMFALocalOnly,
-		SecondFactors:              policy.SecondFactors,
-		MultiFactors:               policy.MultiFactors,
-		PasswordlessType:           policy.PasswordlessType,
-		HidePasswordReset:          policy.HidePasswordReset,
-		IgnoreUnknownUsernames:     policy.IgnoreUnknownUsernames,
-		AllowDomainDiscovery:       policy.AllowDomainDiscovery,
-		DefaultRedirectURI:         policy.DefaultRedirectURI,
-		PasswordCheckLifetime:      time.Duration(policy.PasswordCheckLifetime),
-		ExternalLoginCheckLifetime: time.Duration(policy.ExternalLoginCheckLifetime),
-		MFAInitSkipLifetime:        time.Duration(policy.MFAInitSkipLifetime),
-		SecondFactorCheckLifetime:  time.Duration(policy.SecondFactorCheckLifetime),
-		MultiFactorCheckLifetime:   time.Duration(policy.MultiFactorCheckLifetime),
-		DisableLoginWithEmail:      policy.DisableLoginWithEmail,
-		DisableLoginWithPhone:      policy.DisableLoginWithPhone,
-	}
+		Default:                     policy.IsDefault,
+		AllowUsernamePassword:       policy.AllowUsernamePassword,
+		AllowRegister:               policy.AllowRegister,
+		AllowExternalIDP:            policy.AllowExternalIDPs,
+		ForceMFA:                    policy.ForceMFA,
+		ForceMFALocalOnly:           policy.ForceMFALocalOnly,
+		SecondFactors:               policy.SecondFactors,
+		MultiFactors:                policy.MultiFactors,
+		PasswordlessType:            policy.PasswordlessType,
+		HidePasswordReset:           policy.HidePasswordReset,
+		IgnoreUnknownUsernames:      policy.IgnoreUnknownUsernames,
+		AllowDomainDiscovery:        policy.AllowDomainDiscovery,
+		DefaultRedirectURI:          policy.DefaultRedirectURI,
+		PasswordCheckLifetime:       time.Duration(policy.PasswordCheckLifetime),
+		ExternalLoginCheckLifetime:  time.Duration(policy.ExternalLoginCheckLifetime),
+		MFAInitSkipLifetime:         time.Duration(policy.MFAInitSkipLifetime),
+		SecondFactorCheckLifetime:   time.Duration(policy.SecondFactorCheckLifetime),
+		MultiFactorCheckLifetime:    time.Duration(policy.MultiFactorCheckLifetime),
+		TrustedDeviceLifetime:       time.Duration(policy.TrustedDeviceLifetime),
+		SharedDevice:                policy.SharedDevice,
+		SharedDeviceLifetime:        time.Duration(policy.SharedDeviceLifetime),
+		SharedDeviceIdleTimeout:     time.Duration(policy.SharedDeviceIdleTimeout),
+		PasskeyPrompt:               policy.PasskeyPrompt,
+		PasskeyPromptSnoozeLifetime: time.Duration(policy.PasskeyPromptSnoozeLifetime),
+		DisableLoginWithEmail:       policy.DisableLoginWithEmail,
+		DisableLoginWithPhone:       policy.DisableLoginWithPhone,
+	}
+	loginPolicy.ApplySharedDevice()
+	return loginPolicy
 }
 
 func (repo *AuthRequestRepo) checkSelectedExternalIDP(request *domain.AuthRequest, idpConfigID string) error {
@@ -1028,6 +1164,10 @@ func (repo *AuthRequestRepo) nextSteps(ctx context.Context, request *domain.Auth
 	if err != nil {
 		return nil, err
 	}
+	userSession, err = repo.terminateIdleSharedDeviceSession(ctx, request, userSession)
+	if err != nil {
+		return nil, err
+	}
 	request.DisplayName = userSession.DisplayName
 	request.AvatarKey = userSession.AvatarKey
 	if user.HumanView != nil && user.HumanView.PreferredLanguage != "" {
@@ -1035,31 +1175,31 @@ func (repo *AuthRequestRepo) nextSteps(ctx context.Context, request *domain.Auth
 	}
 
 	isInternalLogin := request.SelectedIDPConfigID == "" && userSession.SelectedIDPConfigID == ""
-	idps, err := checkExternalIDPsOfUser(ctx, repo.IDPUserLinksProvider, user.ID)
-	if err != nil {
-		return nil, err
+	isBreakGlass := false
+	if isInternalLogin && len(request.LinkingUsers) == 0 {
+		isBreakGlass, err = repo.BreakGlassProvider.IsBreakGlassUser(ctx, user.ID)
+		if err != nil {
+			return nil, err
+		}
 	}
-	if (!isInternalLogin || len(idps.Links) > 0) && len(request.LinkingUsers) == 0 {
-		step := repo.idpChecked(request, idps.Links, userSession)
+	if isBreakGlass {
+		step, err := repo.breakGlassChecked(request, user, userSession)
+		if err != nil {
+			return nil, err
+		}
 		if step != nil {
 			return append(steps, step), nil
 		}
-	}
-	if isInternalLogin || (!isInternalLogin && len(request.LinkingUsers) > 0) {
-		step := repo.firstFactorChecked(request, user, userSession)
+	} else {
+		step, err := repo.authenticationChecked(ctx, request, user, userSession, isInternalLogin)
+		if err != nil {
+			return nil, err
+		}
 		if step != nil {
 			return append(steps, step), nil
 		}
 	}
 
-	step, ok, err := repo.mfaChecked(userSession, request, user, isInternalLogin && len(request.LinkingUsers) == 0)
-	if err != nil {
-		return nil, err
-	}
-	if !ok {
-		return append(steps, step), nil
-	}
-
 	expired := passwordAgeChangeRequired(request.PasswordAgePolicy, user.PasswordChanged)
 	if expired || user.PasswordChangeRequired {
 		steps = append(steps, &domain.ChangePasswordStep{Expired: expired})
@@ -1078,7 +1218,26 @@ func (repo *AuthRequestRepo) nextSteps(ctx context.Context, request *domain.Auth
 	if request.LinkingUsers != nil && len(request.LinkingUsers) != 0 {
 		return append(steps, &domain.LinkUsersStep{}), nil
 	}
-	//PLANNED: consent step
+
+	termsStep, err := repo.termsAccepted(ctx, user)
+	if err != nil {
+		return nil, err
+	}
+	if termsStep != nil {
+		return append(steps, termsStep), nil
+	}
+
+	profileStep, err := repo.profileCompleted(ctx, user)
+	if err != nil {
+		return nil, err
+	}
+	if profileStep != nil {
+		return append(steps, profileStep), nil
+	}
+
+	if passkeyPromptRequired(request, user, isInternalLogin) {
+		return append(steps, &domain.PasskeyPromptStep{}), nil
+	}
 
 	missing, err := projectRequired(ctx, request, repo.ProjectProvider)
 	if err != nil {
@@ -1096,7 +1255,15 @@ func (repo *AuthRequestRepo) nextSteps(ctx context.Context, request *domain.Auth
 		return append(steps, &domain.GrantRequiredStep{}), nil
 	}
 
-	ok, err = repo.hasSucceededPage(ctx, request, repo.ApplicationProvider)
+	consentStep, err := repo.consentGiven(ctx, request, user)
+	if err != nil {
+		return nil, err
+	}
+	if consentStep != nil {
+		return append(steps, consentStep), nil
+	}
+
+	ok, err := repo.hasSucceededPage(ctx, request, repo.ApplicationProvider)
 	if err != nil {
 		return nil, err
 	}
@@ -1106,6 +1273,139 @@ func (repo *AuthRequestRepo) nextSteps(ctx context.Context, request *domain.Auth
 	return append(steps, &domain.RedirectToCallbackStep{}), nil
 }
 
+// termsAccepted returns an AcceptTermsStep if the human user didn't accept
+// the version of the terms of service and privacy policy active for the organization of the user.
+func (repo *AuthRequestRepo) termsAccepted(ctx context.Context, user *user_model.UserView) (_ *domain.AcceptTermsStep, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	if user.HumanView == nil {
+		return nil, nil
+	}
+	version, err := repo.TermsProvider.ActiveTermsVersion(ctx, user.ResourceOwner)
+	if err != nil || version == nil {
+		return nil, err
+	}
+	acceptance, err := repo.TermsProvider.UserTermsAcceptance(ctx, user.ID)
+	if err != nil {
+		return nil, err
+	}
+	if acceptance.IsAcceptanceOf(version) {
+		return nil, nil
+	}
+	return &domain.AcceptTermsStep{
+		Version:     version.Version,
+		TOSLink:     version.TOSLink,
+		PrivacyLink: version.PrivacyLink,
+	}, nil
+}
+
+// profileCompleted returns a CompleteProfileStep if the human user is missing any profile field
+// or custom attribute required by the organization of the user.
+func (repo *AuthRequestRepo) profileCompleted(ctx context.Context, user *user_model.UserView) (_ *domain.CompleteProfileStep, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	if user.HumanView == nil {
+		return nil, nil
+	}
+	step := new(domain.CompleteProfileStep)
+	requirements, err := repo.ProfileProvider.ProfileRequirementsByOrg(ctx, user.ResourceOwner)
+	if err != nil && !zerrors.IsNotFound(err) {
+		return nil, err
+	}
+	if requirements != nil {
+		step.Fields = missingProfileFields(requirements.Fields, user.HumanView)
+	}
+	schema, err := repo.ProfileProvider.UserAttributeSchemaByOrg(ctx, user.ResourceOwner)
+	if err != nil && !zerrors.IsNotFound(err) {
+		return nil, err
+	}
+	if schema != nil && slices.ContainsFunc(schema.Attributes, func(attribute *domain.UserAttribute) bool { return attribute.Required }) {
+		metadata, err := repo.ProfileProvider.SearchUserMetadata(ctx, false, user.ID, &query.UserMetadataSearchQueries{}, false)
+		if err != nil {
+			return nil, err
+		}
+		step.Attributes = missingUserAttributes(schema.Attributes, metadata.Metadata)
+	}
+	if len(step.Fields) == 0 && len(step.Attributes) == 0 {
+		return nil, nil
+	}
+	return step, nil
+}
+
+func missingProfileFields(fields []domain.ProfileField, human *user_model.HumanView) []domain.ProfileField {
+	missing := make([]domain.ProfileField, 0, len(fields))
+	for _, field := range fields {
+		switch field {
+		case domain.ProfileFieldPhone:
+			if human.Phone != "" {
+				continue
+			}
+		case domain.ProfileFieldNickName:
+			if human.NickName != "" {
+				continue
+			}
+		case domain.ProfileFieldGender:
+			if human.Gender != user_model.GenderUnspecified {
+				continue
+			}
+		case domain.ProfileFieldPreferredLanguage:
+			if human.PreferredLanguage != "" && human.PreferredLanguage != language.Und.String() {
+				continue
+			}
+		case domain.ProfileFieldUnspecified:
+			continue
+		}
+		missing = append(missing, field)
+	}
+	return missing
+}
+
+func missingUserAttributes(attributes []*domain.UserAttribute, metadata []*query.UserMetadata) []*domain.UserAttribute {
+	missing := make([]*domain.UserAttribute, 0, len(attributes))
+	for _, attribute := range attributes {
+		if !attribute.Required {
+			continue
+		}
+		if slices.ContainsFunc(metadata, func(m *query.UserMetadata) bool { return m.Key == attribute.Key && len(m.Value) > 0 }) {
+			continue
+		}
+		missing = append(missing, attribute)
+	}
+	return missing
+}
+
+// consentGiven returns a ConsentStep if the application requires a consent
+// and the user didn't consent to all requested scopes yet.
+// If the consent prompt was requested, the user has to consent again unless it was given during the auth request.
+func (repo *AuthRequestRepo) consentGiven(ctx context.Context, request *domain.AuthRequest, user *user_model.UserView) (_ *domain.ConsentStep, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	if request.ConsentAppID == "" || user.HumanView == nil {
+		return nil, nil
+	}
+	step := &domain.ConsentStep{
+		AppID:  request.ConsentAppID,
+		Scopes: request.ConsentScopes(),
+	}
+	consent, err := repo.ConsentProvider.UserConsentByApp(ctx, user.ID, request.ConsentAppID)
+	if zerrors.IsNotFound(err) {
+		return step, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	if !consent.Covers(step.Scopes) {
+		return step, nil
+	}
+	if domain.IsPrompt(request.Prompt, domain.PromptConsent) && consent.ChangeDate.Before(request.CreationDate) {
+		return step, nil
+	}
+	return nil, nil
+}
+
 func passwordAgeChangeRequired(policy *domain.PasswordAgePolicy, changed time.Time) bool {
 	if policy == nil || policy.MaxAgeDays == 0 {
 		return false
@@ -1136,6 +1436,10 @@ func (repo *AuthRequestRepo) nextStepsUser(ctx context.Context, request *domain.
 		if err != nil {
 			return nil, err
 		}
+		// on shared devices the user selection is always shown, so the next person is able to switch the user
+		if request.LoginPolicy != nil && request.LoginPolicy.SharedDevice && len(users) > 0 {
+			return append(steps, &domain.SelectUserStep{Users: users}), nil
+		}
 		// in case select_account was specified ignore it if there aren't any user sessions
 		if domain.IsPrompt(request.Prompt, domain.PromptSelectAccount) && len(users) > 0 {
 			steps = append(steps, &domain.SelectUserStep{Users: users})
@@ -1204,6 +1508,63 @@ func (repo *AuthRequestRepo) usersForUserSelection(ctx context.Context, request
 	return users, nil
 }
 
+// authenticationChecked returns the next step of the external, first and second factor authentication
+// or nil if the user is authenticated.
+func (repo *AuthRequestRepo) authenticationChecked(ctx context.Context, request *domain.AuthRequest, user *user_model.UserView, userSession *user_model.UserSessionView, isInternalLogin bool) (domain.NextStep, error) {
+	idps, err := checkExternalIDPsOfUser(ctx, repo.IDPUserLinksProvider, user.ID)
+	if err != nil {
+		return nil, err
+	}
+	if (!isInternalLogin || len(idps.Links) > 0) && len(request.LinkingUsers) == 0 {
+		step := repo.idpChecked(request, idps.Links, userSession)
+		if step != nil {
+			return step, nil
+		}
+	}
+	if isInternalLogin || (!isInternalLogin && len(request.LinkingUsers) > 0) {
+		step := repo.firstFactorChecked(request, user, userSession)
+		if step != nil {
+			return step, nil
+		}
+	}
+
+	step, ok, err := repo.mfaChecked(ctx, userSession, request, user, isInternalLogin && len(request.LinkingUsers) == 0)
+	if err != nil || ok {
+		return nil, err
+	}
+	return step, nil
+}
+
+// breakGlassChecked replaces the authentication steps for break-glass users.
+// They always log in locally with their password and a security key (U2F),
+// so the login does not depend on any external identity provider.
+// Passwordless logins and trusted devices are not considered.
+func (repo *AuthRequestRepo) breakGlassChecked(request *domain.AuthRequest, user *user_model.UserView, userSession *user_model.UserSessionView) (domain.NextStep, error) {
+	if user.InitRequired {
+		return &domain.InitUserStep{PasswordSet: user.PasswordSet}, nil
+	}
+	if user.PasswordInitRequired {
+		return &domain.InitPasswordStep{}, nil
+	}
+	if !checkVerificationTimeMaxAge(userSession.PasswordVerification, request.LoginPolicy.PasswordCheckLifetime, request) {
+		return &domain.PasswordStep{}, nil
+	}
+	request.PasswordVerified = true
+	request.AuthTime = userSession.PasswordVerification
+	if !user.IsU2FReady() {
+		return nil, zerrors.ThrowPreconditionFailed(nil, "LOGIN-Bg2sk", "Errors.User.BreakGlass.SecurityKeyRequired")
+	}
+	if userSession.SecondFactorVerificationType == domain.MFATypeU2F &&
+		checkVerificationTimeMaxAge(userSession.SecondFactorVerification, request.LoginPolicy.SecondFactorCheckLifetime, request) {
+		request.MFAsVerified = append(request.MFAsVerified, domain.MFATypeU2F)
+		request.AuthTime = userSession.SecondFactorVerification
+		return nil, nil
+	}
+	return &domain.MFAVerificationStep{
+		MFAProviders: []domain.MFAType{domain.MFATypeU2F},
+	}, nil
+}
+
 func (repo *AuthRequestRepo) firstFactorChecked(request *domain.AuthRequest, user *user_model.UserView, userSession *user_model.UserSessionView) domain.NextStep {
 	if user.InitRequired {
 		return &domain.InitUserStep{PasswordSet: user.PasswordSet}
@@ -1256,7 +1617,7 @@ func (repo *AuthRequestRepo) idpChecked(request *domain.AuthRequest, idps []*que
 	return &domain.ExternalLoginStep{SelectedIDPConfigID: selectedIDPConfigID}
 }
 
-func (repo *AuthRequestRepo) mfaChecked(userSession *user_model.UserSessionView, request *domain.AuthRequest, user *user_model.UserView, isInternalAuthentication bool) (domain.NextStep, bool, error) {
+func (repo *AuthRequestRepo) mfaChecked(ctx context.Context, userSession *user_model.UserSessionView, request *domain.AuthRequest, user *user_model.UserView, isInternalAuthentication bool) (domain.NextStep, bool, error) {
 	mfaLevel := request.MFALevel()
 	if slices.Contains(request.MFAsVerified, domain.MFATypeU2FUserVerification) {
 		return nil, true, nil
@@ -1298,11 +1659,88 @@ func (repo *AuthRequestRepo) mfaChecked(userSession *user_model.UserSessionView,
 			return nil, true, nil
 		}
 	}
+	// a multi-factor authentication requested by the client or required by the application
+	// has to be verified and can't be skipped on trusted devices
+	if mfaLevel < domain.MFALevelSecondFactor {
+		trusted, err := repo.isTrustedDevice(ctx, request, user.ID)
+		if err != nil {
+			return nil, false, err
+		}
+		if trusted {
+			return nil, true, nil
+		}
+	}
 	return &domain.MFAVerificationStep{
 		MFAProviders: allowedProviders,
 	}, false, nil
 }
 
+// isTrustedDevice checks if the user marked the user agent as trusted after a previous multi-factor check.
+// Trusted devices are only considered if the login policy allows them.
+func (repo *AuthRequestRepo) isTrustedDevice(ctx context.Context, request *domain.AuthRequest, userID string) (bool, error) {
+	if request.LoginPolicy.TrustedDeviceLifetime == 0 || request.AgentID == "" {
+		return false, nil
+	}
+	device, err := repo.TrustedDeviceProvider.TrustedDeviceByUserAgent(ctx, userID, request.AgentID)
+	if zerrors.IsNotFound(err) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	return checkVerificationTime(device.CreationDate, request.LoginPolicy.TrustedDeviceLifetime), nil
+}
+
+// terminateIdleSharedDeviceSession signs the user out of the session on a shared device,
+// if there wasn't any activity on it for longer than the idle timeout of the login policy.
+// The returned session is terminated, so the user has to authenticate again.
+func (repo *AuthRequestRepo) terminateIdleSharedDeviceSession(ctx context.Context, request *domain.AuthRequest, userSession *user_model.UserSessionView) (*user_model.UserSessionView, error) {
+	if !sharedDeviceSessionIdle(request, userSession) {
+		return userSession, nil
+	}
+	if err := repo.UserCommandProvider.HumansSignOut(ctx, request.AgentID, []string{userSession.UserID}); err != nil {
+		return nil, err
+	}
+	return &user_model.UserSessionView{
+		State:         domain.UserSessionStateTerminated,
+		ResourceOwner: userSession.ResourceOwner,
+		UserAgentID:   userSession.UserAgentID,
+		UserID:        userSession.UserID,
+		UserName:      userSession.UserName,
+		LoginName:     userSession.LoginName,
+		DisplayName:   userSession.DisplayName,
+		AvatarKey:     userSession.AvatarKey,
+	}, nil
+}
+
+func sharedDeviceSessionIdle(request *domain.AuthRequest, userSession *user_model.UserSessionView) bool {
+	if !request.LoginPolicy.SharedDevice || request.LoginPolicy.SharedDeviceIdleTimeout <= 0 || request.AgentID == "" {
+		return false
+	}
+	if userSession.State != domain.UserSessionStateActive ||
+		userSession.PasswordVerification.IsZero() && userSession.PasswordlessVerification.IsZero() && userSession.ExternalLoginVerification.IsZero() {
+		return false
+	}
+	return !checkVerificationTime(userSession.ChangeDate, request.LoginPolicy.SharedDeviceIdleTimeout)
+}
+
+// passkeyPromptRequired checks if the user, who logged in with the password, should be asked to register a passkey.
+// The prompt is not shown on shared devices, to users who already have a passkey or opted out
+// and for the snooze lifetime of the login policy after the user chose to be reminded later.
+func passkeyPromptRequired(request *domain.AuthRequest, user *user_model.UserView, isInternalLogin bool) bool {
+	policy := request.LoginPolicy
+	if !policy.PasskeyPrompt || policy.PasswordlessType == domain.PasswordlessTypeNotAllowed || policy.SharedDevice {
+		return false
+	}
+	if !isInternalLogin || !request.PasswordVerified {
+		return false
+	}
+	if user.HumanView == nil || user.IsPasswordlessReady() || user.PasswordlessInitRequired || user.PasskeyPromptOptedOut {
+		return false
+	}
+	return !checkVerificationTime(user.PasskeyPromptSnoozed, policy.PasskeyPromptSnoozeLifetime)
+}
+
 func (repo *AuthRequestRepo) mfaSkippedOrSetUp(user *user_model.UserView, request *domain.AuthRequest) bool {
 	if user.MFAMaxSetUp > domain.MFALevelNotSetUp {
 		return true
@@ -1389,6 +1827,7 @@ func labelPolicyToDomain(p *query.LabelPolicy) *domain.LabelPolicy {
 		ErrorMsgPopup:       p.ShouldErrorPopup,
 		DisableWatermark:    p.WatermarkDisabled,
 		ThemeMode:           p.ThemeMode,
+		CustomCSS:           p.CustomCSS,
 	}
 }
 
@@ -1433,6 +1872,31 @@ func (repo *AuthRequestRepo) hasSucceededPage(ctx context.Context, request *doma
 	return app.OIDCConfig.AppType == domain.OIDCApplicationTypeNative && !app.OIDCConfig.SkipNativeAppSuccessPage, nil
 }
 
+// applyAppAuthRequirements adds the authentication requirements of the application to the request,
+// so they are evaluated in the next steps like the ones requested by the client (acr_values and max_age).
+// OIDC requests reference the application by its client id, SAML requests by its id.
+// For OIDC requests the origins allowed to embed the login of the application are taken over as well.
+func (repo *AuthRequestRepo) applyAppAuthRequirements(ctx context.Context, request *domain.AuthRequest, projectID string) (err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	appID := request.ApplicationID
+	if _, ok := request.Request.(*domain.AuthRequestSAML); !ok {
+		app, err := repo.ApplicationProvider.AppByOIDCClientID(ctx, request.ApplicationID)
+		if err != nil {
+			return err
+		}
+		appID = app.ID
+		request.FrameAncestors = app.OIDCConfig.FrameAncestors
+	}
+	requirements, err := repo.ApplicationProvider.AppAuthRequirementsByID(ctx, projectID, appID)
+	if err != nil {
+		return err
+	}
+	requirements.ApplyTo(request, appID)
+	return nil
+}
+
 func (repo *AuthRequestRepo) getDomainPolicy(ctx context.Context, orgID string) (*query.DomainPolicy, error) {
 	return repo.Query.DomainPolicyByOrg(ctx, false, orgID, false)
 }
@@ -1479,6 +1943,11 @@ func getLoginPolicyIDPProviders(ctx context.Context, provider idpProviderViewPro
 			IDPType:     link.IDPType,
 		}
 	}
+	buttons, err := provider.LoginPolicyIDPButtonsByOwner(ctx, resourceOwner)
+	if err != nil {
+		return nil, err
+	}
+	domain.ApplyIDPButtons(providers, buttons.Buttons, buttons.Icons)
 	return providers, nil
 }
 
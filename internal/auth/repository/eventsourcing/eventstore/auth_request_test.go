@@ -4,9 +4,11 @@ import (
 	"context"
 	"database/sql"
 	"encoding/json"
+	"net"
 	"testing"
 	"time"
 
+	"github.com/muhlemmer/gu"
 	"github.com/stretchr/testify/assert"
 	"go.uber.org/mock/gomock"
 
@@ -59,12 +61,14 @@ func (m *mockViewErrUserSession) GetLatestUserSessionSequence(ctx context.Contex
 }
 
 type mockViewUserSession struct {
-	ExternalLoginVerification time.Time
-	PasswordlessVerification  time.Time
-	PasswordVerification      time.Time
-	SecondFactorVerification  time.Time
-	MultiFactorVerification   time.Time
-	Users                     []mockUser
+	ChangeDate                   time.Time
+	ExternalLoginVerification    time.Time
+	PasswordlessVerification     time.Time
+	PasswordVerification         time.Time
+	SecondFactorVerification     time.Time
+	SecondFactorVerificationType domain.MFAType
+	MultiFactorVerification      time.Time
+	Users                        []mockUser
 }
 
 type mockUser struct {
@@ -76,11 +80,13 @@ type mockUser struct {
 
 func (m *mockViewUserSession) UserSessionByIDs(string, string, string) (*user_view_model.UserSessionView, error) {
 	return &user_view_model.UserSessionView{
-		ExternalLoginVerification: sql.NullTime{Time: m.ExternalLoginVerification},
-		PasswordlessVerification:  sql.NullTime{Time: m.PasswordlessVerification},
-		PasswordVerification:      sql.NullTime{Time: m.PasswordVerification},
-		SecondFactorVerification:  sql.NullTime{Time: m.SecondFactorVerification},
-		MultiFactorVerification:   sql.NullTime{Time: m.MultiFactorVerification},
+		ChangeDate:                   m.ChangeDate,
+		ExternalLoginVerification:    sql.NullTime{Time: m.ExternalLoginVerification},
+		PasswordlessVerification:     sql.NullTime{Time: m.PasswordlessVerification},
+		PasswordVerification:         sql.NullTime{Time: m.PasswordVerification},
+		SecondFactorVerification:     sql.NullTime{Time: m.SecondFactorVerification},
+		SecondFactorVerificationType: sql.NullInt32{Int32: int32(m.SecondFactorVerificationType)},
+		MultiFactorVerification:      sql.NullTime{Time: m.MultiFactorVerification},
 	}, nil
 }
 
@@ -101,6 +107,19 @@ func (m *mockViewUserSession) GetLatestUserSessionSequence(ctx context.Context,
 	return &query.CurrentState{State: query.State{Sequence: 0}}, nil
 }
 
+type mockUserCommand struct {
+	signedOut []string
+}
+
+func (m *mockUserCommand) BulkAddedUserIDPLinks(context.Context, string, string, []*domain.UserIDPLink) error {
+	return nil
+}
+
+func (m *mockUserCommand) HumansSignOut(_ context.Context, _ string, userIDs []string) error {
+	m.signedOut = append(m.signedOut, userIDs...)
+	return nil
+}
+
 type mockViewNoUser struct{}
 
 func (m *mockViewNoUser) UserByID(string, string) (*user_view_model.UserView, error) {
@@ -148,6 +167,8 @@ type mockViewUser struct {
 	MFAInitSkipped           time.Time
 	PasswordlessInitRequired bool
 	PasswordlessTokens       user_view_model.WebAuthNTokens
+	U2FTokens                user_view_model.WebAuthNTokens
+	Phone                    string
 }
 
 type mockLoginPolicy struct {
@@ -182,6 +203,96 @@ func (m *mockCustomText) CustomTextListByTemplate(ctx context.Context, aggregate
 	return m.texts, nil
 }
 
+type mockTrustedDevice struct {
+	device *query.TrustedDevice
+}
+
+func (m *mockTrustedDevice) TrustedDeviceByUserAgent(_ context.Context, _, userAgentID string) (*query.TrustedDevice, error) {
+	if m.device == nil || m.device.UserAgentID != userAgentID {
+		return nil, zerrors.ThrowNotFound(nil, "ID", "trusted device not found")
+	}
+	return m.device, nil
+}
+
+type mockBreakGlass struct {
+	breakGlass bool
+}
+
+func (m *mockBreakGlass) IsBreakGlassUser(context.Context, string) (bool, error) {
+	return m.breakGlass, nil
+}
+
+type mockNetworkAccess struct {
+	blocked   bool
+	monitored bool
+	recorded  int
+}
+
+func (m *mockNetworkAccess) CheckNetworkAccessPolicies(_ context.Context, _ domain.NetworkAccessPhase, _, _, _ string, _ net.IP, _ *domain.NetworkAccessPolicies, recordMonitored bool) (bool, error) {
+	if m.blocked {
+		m.recorded++
+		return false, zerrors.ThrowPermissionDenied(nil, "id", "blocked")
+	}
+	if m.monitored && recordMonitored {
+		m.recorded++
+	}
+	return m.monitored, nil
+}
+
+type mockNetworkPolicies struct{}
+
+func (m *mockNetworkPolicies) NetworkAccessPolicies(context.Context, string, string) (*domain.NetworkAccessPolicies, error) {
+	return &domain.NetworkAccessPolicies{}, nil
+}
+
+type mockTerms struct {
+	version    *query.TermsVersion
+	acceptance *query.TermsAcceptance
+}
+
+func (m *mockTerms) ActiveTermsVersion(context.Context, string) (*query.TermsVersion, error) {
+	return m.version, nil
+}
+
+func (m *mockTerms) UserTermsAcceptance(context.Context, string) (*query.TermsAcceptance, error) {
+	return m.acceptance, nil
+}
+
+type mockConsent struct {
+	consent *query.UserConsent
+}
+
+func (m *mockConsent) UserConsentByApp(_ context.Context, _, appID string) (*query.UserConsent, error) {
+	if m.consent == nil || m.consent.AppID != appID {
+		return nil, zerrors.ThrowNotFound(nil, "ID", "consent not found")
+	}
+	return m.consent, nil
+}
+
+type mockProfile struct {
+	requirements *query.ProfileRequirements
+	schema       *query.UserAttributeSchema
+	metadata     []*query.UserMetadata
+}
+
+func (m *mockProfile) ProfileRequirementsByOrg(context.Context, string) (*query.ProfileRequirements, error) {
+	if m.requirements == nil {
+		return nil, zerrors.ThrowNotFound(nil, "ID", "requirements not found")
+	}
+	return m.requirements, nil
+}
+
+func (m *mockProfile) UserAttributeSchemaByOrg(context.Context, string) (*query.UserAttributeSchema, error) {
+	if m.schema == nil {
+		return nil, zerrors.ThrowNotFound(nil, "ID", "schema not found")
+	}
+	return m.schema, nil
+}
+
+func (m *mockProfile) SearchUserMetadata(context.Context, bool, string, *query.UserMetadataSearchQueries, bool) (*query.UserMetadataList, error) {
+	return &query.UserMetadataList{Metadata: m.metadata}, nil
+}
+
 type mockLockoutPolicy struct {
 	policy *query.LockoutPolicy
 }
@@ -214,6 +325,8 @@ func (m *mockViewUser) UserByID(string, string) (*user_view_model.UserView, erro
 			MFAInitSkipped:           m.MFAInitSkipped,
 			PasswordlessInitRequired: m.PasswordlessInitRequired,
 			PasswordlessTokens:       m.PasswordlessTokens,
+			U2FTokens:                m.U2FTokens,
+			Phone:                    m.Phone,
 		},
 	}, nil
 }
@@ -290,6 +403,10 @@ func (m *mockApp) AppByOIDCClientID(ctx context.Context, id string) (*query.App,
 	return nil, zerrors.ThrowNotFound(nil, "ERROR", "error")
 }
 
+func (m *mockApp) AppAuthRequirementsByID(ctx context.Context, projectID, appID string) (*domain.AppAuthRequirements, error) {
+	return nil, nil
+}
+
 type mockIDPUserLinks struct {
 	idps []*query.IDPUserLink
 }
@@ -316,6 +433,11 @@ func TestAuthRequestRepo_nextSteps(t *testing.T) {
 		labelPolicyProvider       labelPolicyProvider
 		passwordAgePolicyProvider passwordAgePolicyProvider
 		customTextProvider        customTextProvider
+		termsProvider             termsProvider
+		breakGlassProvider        breakGlassProvider
+		consentProvider           consentProvider
+		profileProvider           profileProvider
+		userCommandProvider       userCommandProvider
 	}
 	type args struct {
 		request       *domain.AuthRequest
@@ -441,6 +563,35 @@ func TestAuthRequestRepo_nextSteps(t *testing.T) {
 				}},
 			nil,
 		},
+		{
+			"user not set single active session on shared device, select account step",
+			fields{
+				userSessionViewProvider: &mockViewUserSession{
+					Users: []mockUser{
+						{
+							"id1",
+							"loginname1",
+							"orgID1",
+							domain.UserSessionStateActive,
+						},
+					},
+				},
+				userEventProvider: &mockEventUser{},
+			},
+			args{&domain.AuthRequest{LoginPolicy: &domain.LoginPolicy{SharedDevice: true}}, false},
+			[]domain.NextStep{
+				&domain.SelectUserStep{
+					Users: []domain.UserSelection{
+						{
+							UserID:            "id1",
+							LoginName:         "loginname1",
+							SelectionPossible: true,
+							ResourceOwner:     "orgID1",
+						},
+					},
+				}},
+			nil,
+		},
 		{
 			"user not set, primary domain set, prompt select account, select account step",
 			fields{
@@ -1077,6 +1228,39 @@ func TestAuthRequestRepo_nextSteps(t *testing.T) {
 			[]domain.NextStep{&domain.PasswordStep{}},
 			nil,
 		},
+		{
+			"session on shared device idle, signed out, password check step",
+			fields{
+				userSessionViewProvider: &mockViewUserSession{
+					ChangeDate:           testNow.Add(-10 * time.Minute),
+					PasswordVerification: testNow.Add(-10 * time.Minute),
+				},
+				userViewProvider: &mockViewUser{
+					PasswordSet: true,
+				},
+				userEventProvider: &mockEventUser{},
+				orgViewProvider:   &mockViewOrg{State: domain.OrgStateActive},
+				lockoutPolicyProvider: &mockLockoutPolicy{
+					policy: &query.LockoutPolicy{
+						ShowFailures: true,
+					},
+				},
+				idpUserLinksProvider: &mockIDPUserLinks{},
+				userCommandProvider:  &mockUserCommand{},
+			},
+			args{&domain.AuthRequest{
+				UserID:  "UserID",
+				AgentID: "AgentID",
+				LoginPolicy: &domain.LoginPolicy{
+					PasswordCheckLifetime:   8 * time.Hour,
+					SharedDevice:            true,
+					SharedDeviceLifetime:    8 * time.Hour,
+					SharedDeviceIdleTimeout: 5 * time.Minute,
+				},
+			}, false},
+			[]domain.NextStep{&domain.PasswordStep{}},
+			nil,
+		},
 		{
 			"external user (no password check needed), callback",
 			fields{
@@ -1220,6 +1404,107 @@ func TestAuthRequestRepo_nextSteps(t *testing.T) {
 			}},
 			nil,
 		},
+		{
+			"break-glass user with idp link, password check step",
+			fields{
+				userSessionViewProvider: &mockViewUserSession{},
+				userViewProvider: &mockViewUser{
+					PasswordSet: true,
+					MFAMaxSetUp: int32(domain.MFALevelSecondFactor),
+				},
+				userEventProvider: &mockEventUser{},
+				orgViewProvider:   &mockViewOrg{State: domain.OrgStateActive},
+				lockoutPolicyProvider: &mockLockoutPolicy{
+					policy: &query.LockoutPolicy{
+						ShowFailures: true,
+					},
+				},
+				idpUserLinksProvider: &mockIDPUserLinks{
+					idps: []*query.IDPUserLink{{IDPID: "IDPConfigID"}},
+				},
+				breakGlassProvider: &mockBreakGlass{breakGlass: true},
+			},
+			args{
+				&domain.AuthRequest{
+					UserID: "UserID",
+					LoginPolicy: &domain.LoginPolicy{
+						PasswordCheckLifetime: 10 * 24 * time.Hour,
+					},
+				}, false},
+			[]domain.NextStep{&domain.PasswordStep{}},
+			nil,
+		},
+		{
+			"break-glass user without security key, precondition failed error",
+			fields{
+				userSessionViewProvider: &mockViewUserSession{
+					PasswordVerification: testNow.Add(-5 * time.Minute),
+				},
+				userViewProvider: &mockViewUser{
+					PasswordSet: true,
+					OTPState:    int32(user_model.MFAStateReady),
+					MFAMaxSetUp: int32(domain.MFALevelSecondFactor),
+				},
+				userEventProvider: &mockEventUser{},
+				orgViewProvider:   &mockViewOrg{State: domain.OrgStateActive},
+				lockoutPolicyProvider: &mockLockoutPolicy{
+					policy: &query.LockoutPolicy{
+						ShowFailures: true,
+					},
+				},
+				idpUserLinksProvider: &mockIDPUserLinks{},
+				breakGlassProvider:   &mockBreakGlass{breakGlass: true},
+			},
+			args{
+				&domain.AuthRequest{
+					UserID: "UserID",
+					LoginPolicy: &domain.LoginPolicy{
+						SecondFactors:             []domain.SecondFactorType{domain.SecondFactorTypeTOTP},
+						PasswordCheckLifetime:     10 * 24 * time.Hour,
+						SecondFactorCheckLifetime: 18 * time.Hour,
+					},
+				}, false},
+			nil,
+			zerrors.IsPreconditionFailed,
+		},
+		{
+			"break-glass user, otp verified, security key check step",
+			fields{
+				userSessionViewProvider: &mockViewUserSession{
+					PasswordVerification:         testNow.Add(-5 * time.Minute),
+					SecondFactorVerification:     testNow.Add(-5 * time.Minute),
+					SecondFactorVerificationType: domain.MFATypeTOTP,
+				},
+				userViewProvider: &mockViewUser{
+					PasswordSet: true,
+					OTPState:    int32(user_model.MFAStateReady),
+					U2FTokens:   user_view_model.WebAuthNTokens{{ID: "id", State: int32(user_model.MFAStateReady)}},
+					MFAMaxSetUp: int32(domain.MFALevelSecondFactor),
+				},
+				userEventProvider: &mockEventUser{},
+				orgViewProvider:   &mockViewOrg{State: domain.OrgStateActive},
+				lockoutPolicyProvider: &mockLockoutPolicy{
+					policy: &query.LockoutPolicy{
+						ShowFailures: true,
+					},
+				},
+				idpUserLinksProvider: &mockIDPUserLinks{},
+				breakGlassProvider:   &mockBreakGlass{breakGlass: true},
+			},
+			args{
+				&domain.AuthRequest{
+					UserID: "UserID",
+					LoginPolicy: &domain.LoginPolicy{
+						SecondFactors:             []domain.SecondFactorType{domain.SecondFactorTypeTOTP, domain.SecondFactorTypeU2F},
+						PasswordCheckLifetime:     10 * 24 * time.Hour,
+						SecondFactorCheckLifetime: 18 * time.Hour,
+					},
+				}, false},
+			[]domain.NextStep{&domain.MFAVerificationStep{
+				MFAProviders: []domain.MFAType{domain.MFATypeU2F},
+			}},
+			nil,
+		},
 		{
 			"password change required and email verified, password change step",
 			fields{
@@ -1396,7 +1681,7 @@ func TestAuthRequestRepo_nextSteps(t *testing.T) {
 			nil,
 		},
 		{
-			"prompt none, checkLoggedIn true and authenticated, redirect to callback step",
+			"terms version not accepted, accept terms step",
 			fields{
 				userSessionViewProvider: &mockViewUserSession{
 					PasswordVerification:     testNow.Add(-5 * time.Minute),
@@ -1418,22 +1703,25 @@ func TestAuthRequestRepo_nextSteps(t *testing.T) {
 					},
 				},
 				idpUserLinksProvider: &mockIDPUserLinks{},
+				termsProvider: &mockTerms{
+					version:    &query.TermsVersion{ResourceOwner: "org1", Version: "v2", TOSLink: "TOSLink", PrivacyLink: "PrivacyLink"},
+					acceptance: &query.TermsAcceptance{Version: "v1", TermsOwner: "org1"},
+				},
 			},
 			args{&domain.AuthRequest{
 				UserID:  "UserID",
-				Prompt:  []domain.Prompt{domain.PromptNone},
 				Request: &domain.AuthRequestOIDC{},
 				LoginPolicy: &domain.LoginPolicy{
 					SecondFactors:             []domain.SecondFactorType{domain.SecondFactorTypeTOTP},
 					PasswordCheckLifetime:     10 * 24 * time.Hour,
 					SecondFactorCheckLifetime: 18 * time.Hour,
 				},
-			}, true},
-			[]domain.NextStep{&domain.RedirectToCallbackStep{}},
+			}, false},
+			[]domain.NextStep{&domain.AcceptTermsStep{Version: "v2", TOSLink: "TOSLink", PrivacyLink: "PrivacyLink"}},
 			nil,
 		},
 		{
-			"prompt none, checkLoggedIn true, authenticated and native, login succeeded step",
+			"terms version accepted, redirect to callback step",
 			fields{
 				userSessionViewProvider: &mockViewUserSession{
 					PasswordVerification:     testNow.Add(-5 * time.Minute),
@@ -1448,29 +1736,32 @@ func TestAuthRequestRepo_nextSteps(t *testing.T) {
 				orgViewProvider:     &mockViewOrg{State: domain.OrgStateActive},
 				userGrantProvider:   &mockUserGrants{},
 				projectProvider:     &mockProject{},
-				applicationProvider: &mockApp{app: &query.App{OIDCConfig: &query.OIDCApp{AppType: domain.OIDCApplicationTypeNative}}},
+				applicationProvider: &mockApp{app: &query.App{OIDCConfig: &query.OIDCApp{AppType: domain.OIDCApplicationTypeWeb}}},
 				lockoutPolicyProvider: &mockLockoutPolicy{
 					policy: &query.LockoutPolicy{
 						ShowFailures: true,
 					},
 				},
 				idpUserLinksProvider: &mockIDPUserLinks{},
+				termsProvider: &mockTerms{
+					version:    &query.TermsVersion{ResourceOwner: "org1", Version: "v2"},
+					acceptance: &query.TermsAcceptance{Version: "v2", TermsOwner: "org1"},
+				},
 			},
 			args{&domain.AuthRequest{
 				UserID:  "UserID",
-				Prompt:  []domain.Prompt{domain.PromptNone},
 				Request: &domain.AuthRequestOIDC{},
 				LoginPolicy: &domain.LoginPolicy{
 					SecondFactors:             []domain.SecondFactorType{domain.SecondFactorTypeTOTP},
 					PasswordCheckLifetime:     10 * 24 * time.Hour,
 					SecondFactorCheckLifetime: 18 * time.Hour,
 				},
-			}, true},
-			[]domain.NextStep{&domain.LoginSucceededStep{}, &domain.RedirectToCallbackStep{}},
+			}, false},
+			[]domain.NextStep{&domain.RedirectToCallbackStep{}},
 			nil,
 		},
 		{
-			"prompt none, checkLoggedIn true, authenticated and required user grants missing, grant required step",
+			"required profile field missing, complete profile step",
 			fields{
 				userSessionViewProvider: &mockViewUserSession{
 					PasswordVerification:     testNow.Add(-5 * time.Minute),
@@ -1481,35 +1772,37 @@ func TestAuthRequestRepo_nextSteps(t *testing.T) {
 					IsEmailVerified: true,
 					MFAMaxSetUp:     int32(domain.MFALevelSecondFactor),
 				},
-				userEventProvider: &mockEventUser{},
-				orgViewProvider:   &mockViewOrg{State: domain.OrgStateActive},
-				userGrantProvider: &mockUserGrants{
-					roleCheck:  true,
-					userGrants: 0,
-				},
-				projectProvider: &mockProject{},
+				userEventProvider:   &mockEventUser{},
+				orgViewProvider:     &mockViewOrg{State: domain.OrgStateActive},
+				userGrantProvider:   &mockUserGrants{},
+				projectProvider:     &mockProject{},
+				applicationProvider: &mockApp{app: &query.App{OIDCConfig: &query.OIDCApp{AppType: domain.OIDCApplicationTypeWeb}}},
 				lockoutPolicyProvider: &mockLockoutPolicy{
 					policy: &query.LockoutPolicy{
 						ShowFailures: true,
 					},
 				},
 				idpUserLinksProvider: &mockIDPUserLinks{},
+				profileProvider: &mockProfile{
+					requirements: &query.ProfileRequirements{
+						Fields: []domain.ProfileField{domain.ProfileFieldPhone, domain.ProfileFieldNickName},
+					},
+				},
 			},
 			args{&domain.AuthRequest{
 				UserID:  "UserID",
-				Prompt:  []domain.Prompt{domain.PromptNone},
 				Request: &domain.AuthRequestOIDC{},
 				LoginPolicy: &domain.LoginPolicy{
 					SecondFactors:             []domain.SecondFactorType{domain.SecondFactorTypeTOTP},
 					PasswordCheckLifetime:     10 * 24 * time.Hour,
 					SecondFactorCheckLifetime: 18 * time.Hour,
 				},
-			}, true},
-			[]domain.NextStep{&domain.GrantRequiredStep{}},
+			}, false},
+			[]domain.NextStep{&domain.CompleteProfileStep{Fields: []domain.ProfileField{domain.ProfileFieldPhone, domain.ProfileFieldNickName}}},
 			nil,
 		},
 		{
-			"prompt none, checkLoggedIn true, authenticated and required user grants exist, redirect to callback step",
+			"required attribute missing, complete profile step",
 			fields{
 				userSessionViewProvider: &mockViewUserSession{
 					PasswordVerification:     testNow.Add(-5 * time.Minute),
@@ -1519,13 +1812,11 @@ func TestAuthRequestRepo_nextSteps(t *testing.T) {
 					PasswordSet:     true,
 					IsEmailVerified: true,
 					MFAMaxSetUp:     int32(domain.MFALevelSecondFactor),
+					Phone:           "+41791234567",
 				},
-				userEventProvider: &mockEventUser{},
-				orgViewProvider:   &mockViewOrg{State: domain.OrgStateActive},
-				userGrantProvider: &mockUserGrants{
-					roleCheck:  true,
-					userGrants: 2,
-				},
+				userEventProvider:   &mockEventUser{},
+				orgViewProvider:     &mockViewOrg{State: domain.OrgStateActive},
+				userGrantProvider:   &mockUserGrants{},
 				projectProvider:     &mockProject{},
 				applicationProvider: &mockApp{app: &query.App{OIDCConfig: &query.OIDCApp{AppType: domain.OIDCApplicationTypeWeb}}},
 				lockoutPolicyProvider: &mockLockoutPolicy{
@@ -1534,22 +1825,32 @@ func TestAuthRequestRepo_nextSteps(t *testing.T) {
 					},
 				},
 				idpUserLinksProvider: &mockIDPUserLinks{},
+				profileProvider: &mockProfile{
+					requirements: &query.ProfileRequirements{
+						Fields: []domain.ProfileField{domain.ProfileFieldPhone},
+					},
+					schema: &query.UserAttributeSchema{
+						Attributes: []*domain.UserAttribute{
+							{Key: "department", Type: domain.UserAttributeTypeString, Required: true},
+							{Key: "birthday", Type: domain.UserAttributeTypeDate},
+						},
+					},
+				},
 			},
 			args{&domain.AuthRequest{
 				UserID:  "UserID",
-				Prompt:  []domain.Prompt{domain.PromptNone},
 				Request: &domain.AuthRequestOIDC{},
 				LoginPolicy: &domain.LoginPolicy{
 					SecondFactors:             []domain.SecondFactorType{domain.SecondFactorTypeTOTP},
 					PasswordCheckLifetime:     10 * 24 * time.Hour,
 					SecondFactorCheckLifetime: 18 * time.Hour,
 				},
-			}, true},
-			[]domain.NextStep{&domain.RedirectToCallbackStep{}},
+			}, false},
+			[]domain.NextStep{&domain.CompleteProfileStep{Fields: []domain.ProfileField{}, Attributes: []*domain.UserAttribute{{Key: "department", Type: domain.UserAttributeTypeString, Required: true}}}},
 			nil,
 		},
 		{
-			"prompt none, checkLoggedIn true, authenticated and required project missing, project required step",
+			"profile complete, redirect to callback step",
 			fields{
 				userSessionViewProvider: &mockViewUserSession{
 					PasswordVerification:     testNow.Add(-5 * time.Minute),
@@ -1559,23 +1860,427 @@ func TestAuthRequestRepo_nextSteps(t *testing.T) {
 					PasswordSet:     true,
 					IsEmailVerified: true,
 					MFAMaxSetUp:     int32(domain.MFALevelSecondFactor),
+					Phone:           "+41791234567",
 				},
-				userEventProvider: &mockEventUser{},
-				orgViewProvider:   &mockViewOrg{State: domain.OrgStateActive},
-				userGrantProvider: &mockUserGrants{},
-				projectProvider: &mockProject{
-					projectCheck:  true,
-					hasProject:    false,
-					resourceOwner: "other-org",
-				},
+				userEventProvider:   &mockEventUser{},
+				orgViewProvider:     &mockViewOrg{State: domain.OrgStateActive},
+				userGrantProvider:   &mockUserGrants{},
+				projectProvider:     &mockProject{},
+				applicationProvider: &mockApp{app: &query.App{OIDCConfig: &query.OIDCApp{AppType: domain.OIDCApplicationTypeWeb}}},
 				lockoutPolicyProvider: &mockLockoutPolicy{
 					policy: &query.LockoutPolicy{
 						ShowFailures: true,
 					},
 				},
 				idpUserLinksProvider: &mockIDPUserLinks{},
-			},
-			args{&domain.AuthRequest{
+				profileProvider: &mockProfile{
+					requirements: &query.ProfileRequirements{
+						Fields: []domain.ProfileField{domain.ProfileFieldPhone},
+					},
+					schema: &query.UserAttributeSchema{
+						Attributes: []*domain.UserAttribute{
+							{Key: "department", Type: domain.UserAttributeTypeString, Required: true},
+							{Key: "birthday", Type: domain.UserAttributeTypeDate},
+						},
+					},
+					metadata: []*query.UserMetadata{
+						{Key: "department", Value: []byte("ENG")},
+					},
+				},
+			},
+			args{&domain.AuthRequest{
+				UserID:  "UserID",
+				Request: &domain.AuthRequestOIDC{},
+				LoginPolicy: &domain.LoginPolicy{
+					SecondFactors:             []domain.SecondFactorType{domain.SecondFactorTypeTOTP},
+					PasswordCheckLifetime:     10 * 24 * time.Hour,
+					SecondFactorCheckLifetime: 18 * time.Hour,
+				},
+			}, false},
+			[]domain.NextStep{&domain.RedirectToCallbackStep{}},
+			nil,
+		},
+		{
+			"consent required and not given, consent step",
+			fields{
+				userSessionViewProvider: &mockViewUserSession{
+					PasswordVerification:     testNow.Add(-5 * time.Minute),
+					SecondFactorVerification: testNow.Add(-5 * time.Minute),
+				},
+				userViewProvider: &mockViewUser{
+					PasswordSet:     true,
+					IsEmailVerified: true,
+					MFAMaxSetUp:     int32(domain.MFALevelSecondFactor),
+				},
+				userEventProvider:   &mockEventUser{},
+				orgViewProvider:     &mockViewOrg{State: domain.OrgStateActive},
+				userGrantProvider:   &mockUserGrants{},
+				projectProvider:     &mockProject{},
+				applicationProvider: &mockApp{app: &query.App{OIDCConfig: &query.OIDCApp{AppType: domain.OIDCApplicationTypeWeb}}},
+				lockoutPolicyProvider: &mockLockoutPolicy{
+					policy: &query.LockoutPolicy{
+						ShowFailures: true,
+					},
+				},
+				idpUserLinksProvider: &mockIDPUserLinks{},
+				consentProvider:      &mockConsent{},
+			},
+			args{&domain.AuthRequest{
+				UserID:       "UserID",
+				CreationDate: testNow,
+				Prompt:       nil,
+				Request:      &domain.AuthRequestOIDC{Scopes: []string{"openid", "email"}},
+				ConsentAppID: "app1",
+				LoginPolicy: &domain.LoginPolicy{
+					SecondFactors:             []domain.SecondFactorType{domain.SecondFactorTypeTOTP},
+					PasswordCheckLifetime:     10 * 24 * time.Hour,
+					SecondFactorCheckLifetime: 18 * time.Hour,
+				},
+			}, false},
+			[]domain.NextStep{&domain.ConsentStep{AppID: "app1", Scopes: []string{"openid", "email"}}},
+			nil,
+		},
+		{
+			"consent required and scopes missing, consent step",
+			fields{
+				userSessionViewProvider: &mockViewUserSession{
+					PasswordVerification:     testNow.Add(-5 * time.Minute),
+					SecondFactorVerification: testNow.Add(-5 * time.Minute),
+				},
+				userViewProvider: &mockViewUser{
+					PasswordSet:     true,
+					IsEmailVerified: true,
+					MFAMaxSetUp:     int32(domain.MFALevelSecondFactor),
+				},
+				userEventProvider:   &mockEventUser{},
+				orgViewProvider:     &mockViewOrg{State: domain.OrgStateActive},
+				userGrantProvider:   &mockUserGrants{},
+				projectProvider:     &mockProject{},
+				applicationProvider: &mockApp{app: &query.App{OIDCConfig: &query.OIDCApp{AppType: domain.OIDCApplicationTypeWeb}}},
+				lockoutPolicyProvider: &mockLockoutPolicy{
+					policy: &query.LockoutPolicy{
+						ShowFailures: true,
+					},
+				},
+				idpUserLinksProvider: &mockIDPUserLinks{},
+				consentProvider:      &mockConsent{consent: &query.UserConsent{AppID: "app1", Scopes: []string{"openid"}}},
+			},
+			args{&domain.AuthRequest{
+				UserID:       "UserID",
+				CreationDate: testNow,
+				Prompt:       nil,
+				Request:      &domain.AuthRequestOIDC{Scopes: []string{"openid", "email"}},
+				ConsentAppID: "app1",
+				LoginPolicy: &domain.LoginPolicy{
+					SecondFactors:             []domain.SecondFactorType{domain.SecondFactorTypeTOTP},
+					PasswordCheckLifetime:     10 * 24 * time.Hour,
+					SecondFactorCheckLifetime: 18 * time.Hour,
+				},
+			}, false},
+			[]domain.NextStep{&domain.ConsentStep{AppID: "app1", Scopes: []string{"openid", "email"}}},
+			nil,
+		},
+		{
+			"consent required and given, redirect to callback step",
+			fields{
+				userSessionViewProvider: &mockViewUserSession{
+					PasswordVerification:     testNow.Add(-5 * time.Minute),
+					SecondFactorVerification: testNow.Add(-5 * time.Minute),
+				},
+				userViewProvider: &mockViewUser{
+					PasswordSet:     true,
+					IsEmailVerified: true,
+					MFAMaxSetUp:     int32(domain.MFALevelSecondFactor),
+				},
+				userEventProvider:   &mockEventUser{},
+				orgViewProvider:     &mockViewOrg{State: domain.OrgStateActive},
+				userGrantProvider:   &mockUserGrants{},
+				projectProvider:     &mockProject{},
+				applicationProvider: &mockApp{app: &query.App{OIDCConfig: &query.OIDCApp{AppType: domain.OIDCApplicationTypeWeb}}},
+				lockoutPolicyProvider: &mockLockoutPolicy{
+					policy: &query.LockoutPolicy{
+						ShowFailures: true,
+					},
+				},
+				idpUserLinksProvider: &mockIDPUserLinks{},
+				consentProvider:      &mockConsent{consent: &query.UserConsent{AppID: "app1", Scopes: []string{"openid", "email"}, ChangeDate: testNow.Add(-time.Hour)}},
+			},
+			args{&domain.AuthRequest{
+				UserID:       "UserID",
+				CreationDate: testNow,
+				Prompt:       nil,
+				Request:      &domain.AuthRequestOIDC{Scopes: []string{"openid", "email"}},
+				ConsentAppID: "app1",
+				LoginPolicy: &domain.LoginPolicy{
+					SecondFactors:             []domain.SecondFactorType{domain.SecondFactorTypeTOTP},
+					PasswordCheckLifetime:     10 * 24 * time.Hour,
+					SecondFactorCheckLifetime: 18 * time.Hour,
+				},
+			}, false},
+			[]domain.NextStep{&domain.RedirectToCallbackStep{}},
+			nil,
+		},
+		{
+			"consent given before prompt consent, consent step",
+			fields{
+				userSessionViewProvider: &mockViewUserSession{
+					PasswordVerification:     testNow.Add(-5 * time.Minute),
+					SecondFactorVerification: testNow.Add(-5 * time.Minute),
+				},
+				userViewProvider: &mockViewUser{
+					PasswordSet:     true,
+					IsEmailVerified: true,
+					MFAMaxSetUp:     int32(domain.MFALevelSecondFactor),
+				},
+				userEventProvider:   &mockEventUser{},
+				orgViewProvider:     &mockViewOrg{State: domain.OrgStateActive},
+				userGrantProvider:   &mockUserGrants{},
+				projectProvider:     &mockProject{},
+				applicationProvider: &mockApp{app: &query.App{OIDCConfig: &query.OIDCApp{AppType: domain.OIDCApplicationTypeWeb}}},
+				lockoutPolicyProvider: &mockLockoutPolicy{
+					policy: &query.LockoutPolicy{
+						ShowFailures: true,
+					},
+				},
+				idpUserLinksProvider: &mockIDPUserLinks{},
+				consentProvider:      &mockConsent{consent: &query.UserConsent{AppID: "app1", Scopes: []string{"openid", "email"}, ChangeDate: testNow.Add(-time.Hour)}},
+			},
+			args{&domain.AuthRequest{
+				UserID:       "UserID",
+				CreationDate: testNow,
+				Prompt:       []domain.Prompt{domain.PromptConsent},
+				Request:      &domain.AuthRequestOIDC{Scopes: []string{"openid", "email"}},
+				ConsentAppID: "app1",
+				LoginPolicy: &domain.LoginPolicy{
+					SecondFactors:             []domain.SecondFactorType{domain.SecondFactorTypeTOTP},
+					PasswordCheckLifetime:     10 * 24 * time.Hour,
+					SecondFactorCheckLifetime: 18 * time.Hour,
+				},
+			}, false},
+			[]domain.NextStep{&domain.ConsentStep{AppID: "app1", Scopes: []string{"openid", "email"}}},
+			nil,
+		},
+		{
+			"consent given after prompt consent, redirect to callback step",
+			fields{
+				userSessionViewProvider: &mockViewUserSession{
+					PasswordVerification:     testNow.Add(-5 * time.Minute),
+					SecondFactorVerification: testNow.Add(-5 * time.Minute),
+				},
+				userViewProvider: &mockViewUser{
+					PasswordSet:     true,
+					IsEmailVerified: true,
+					MFAMaxSetUp:     int32(domain.MFALevelSecondFactor),
+				},
+				userEventProvider:   &mockEventUser{},
+				orgViewProvider:     &mockViewOrg{State: domain.OrgStateActive},
+				userGrantProvider:   &mockUserGrants{},
+				projectProvider:     &mockProject{},
+				applicationProvider: &mockApp{app: &query.App{OIDCConfig: &query.OIDCApp{AppType: domain.OIDCApplicationTypeWeb}}},
+				lockoutPolicyProvider: &mockLockoutPolicy{
+					policy: &query.LockoutPolicy{
+						ShowFailures: true,
+					},
+				},
+				idpUserLinksProvider: &mockIDPUserLinks{},
+				consentProvider:      &mockConsent{consent: &query.UserConsent{AppID: "app1", Scopes: []string{"openid", "email"}, ChangeDate: testNow.Add(time.Minute)}},
+			},
+			args{&domain.AuthRequest{
+				UserID:       "UserID",
+				CreationDate: testNow,
+				Prompt:       []domain.Prompt{domain.PromptConsent},
+				Request:      &domain.AuthRequestOIDC{Scopes: []string{"openid", "email"}},
+				ConsentAppID: "app1",
+				LoginPolicy: &domain.LoginPolicy{
+					SecondFactors:             []domain.SecondFactorType{domain.SecondFactorTypeTOTP},
+					PasswordCheckLifetime:     10 * 24 * time.Hour,
+					SecondFactorCheckLifetime: 18 * time.Hour,
+				},
+			}, false},
+			[]domain.NextStep{&domain.RedirectToCallbackStep{}},
+			nil,
+		},
+		{
+			"prompt none, checkLoggedIn true and authenticated, redirect to callback step",
+			fields{
+				userSessionViewProvider: &mockViewUserSession{
+					PasswordVerification:     testNow.Add(-5 * time.Minute),
+					SecondFactorVerification: testNow.Add(-5 * time.Minute),
+				},
+				userViewProvider: &mockViewUser{
+					PasswordSet:     true,
+					IsEmailVerified: true,
+					MFAMaxSetUp:     int32(domain.MFALevelSecondFactor),
+				},
+				userEventProvider:   &mockEventUser{},
+				orgViewProvider:     &mockViewOrg{State: domain.OrgStateActive},
+				userGrantProvider:   &mockUserGrants{},
+				projectProvider:     &mockProject{},
+				applicationProvider: &mockApp{app: &query.App{OIDCConfig: &query.OIDCApp{AppType: domain.OIDCApplicationTypeWeb}}},
+				lockoutPolicyProvider: &mockLockoutPolicy{
+					policy: &query.LockoutPolicy{
+						ShowFailures: true,
+					},
+				},
+				idpUserLinksProvider: &mockIDPUserLinks{},
+			},
+			args{&domain.AuthRequest{
+				UserID:  "UserID",
+				Prompt:  []domain.Prompt{domain.PromptNone},
+				Request: &domain.AuthRequestOIDC{},
+				LoginPolicy: &domain.LoginPolicy{
+					SecondFactors:             []domain.SecondFactorType{domain.SecondFactorTypeTOTP},
+					PasswordCheckLifetime:     10 * 24 * time.Hour,
+					SecondFactorCheckLifetime: 18 * time.Hour,
+				},
+			}, true},
+			[]domain.NextStep{&domain.RedirectToCallbackStep{}},
+			nil,
+		},
+		{
+			"prompt none, checkLoggedIn true, authenticated and native, login succeeded step",
+			fields{
+				userSessionViewProvider: &mockViewUserSession{
+					PasswordVerification:     testNow.Add(-5 * time.Minute),
+					SecondFactorVerification: testNow.Add(-5 * time.Minute),
+				},
+				userViewProvider: &mockViewUser{
+					PasswordSet:     true,
+					IsEmailVerified: true,
+					MFAMaxSetUp:     int32(domain.MFALevelSecondFactor),
+				},
+				userEventProvider:   &mockEventUser{},
+				orgViewProvider:     &mockViewOrg{State: domain.OrgStateActive},
+				userGrantProvider:   &mockUserGrants{},
+				projectProvider:     &mockProject{},
+				applicationProvider: &mockApp{app: &query.App{OIDCConfig: &query.OIDCApp{AppType: domain.OIDCApplicationTypeNative}}},
+				lockoutPolicyProvider: &mockLockoutPolicy{
+					policy: &query.LockoutPolicy{
+						ShowFailures: true,
+					},
+				},
+				idpUserLinksProvider: &mockIDPUserLinks{},
+			},
+			args{&domain.AuthRequest{
+				UserID:  "UserID",
+				Prompt:  []domain.Prompt{domain.PromptNone},
+				Request: &domain.AuthRequestOIDC{},
+				LoginPolicy: &domain.LoginPolicy{
+					SecondFactors:             []domain.SecondFactorType{domain.SecondFactorTypeTOTP},
+					PasswordCheckLifetime:     10 * 24 * time.Hour,
+					SecondFactorCheckLifetime: 18 * time.Hour,
+				},
+			}, true},
+			[]domain.NextStep{&domain.LoginSucceededStep{}, &domain.RedirectToCallbackStep{}},
+			nil,
+		},
+		{
+			"prompt none, checkLoggedIn true, authenticated and required user grants missing, grant required step",
+			fields{
+				userSessionViewProvider: &mockViewUserSession{
+					PasswordVerification:     testNow.Add(-5 * time.Minute),
+					SecondFactorVerification: testNow.Add(-5 * time.Minute),
+				},
+				userViewProvider: &mockViewUser{
+					PasswordSet:     true,
+					IsEmailVerified: true,
+					MFAMaxSetUp:     int32(domain.MFALevelSecondFactor),
+				},
+				userEventProvider: &mockEventUser{},
+				orgViewProvider:   &mockViewOrg{State: domain.OrgStateActive},
+				userGrantProvider: &mockUserGrants{
+					roleCheck:  true,
+					userGrants: 0,
+				},
+				projectProvider: &mockProject{},
+				lockoutPolicyProvider: &mockLockoutPolicy{
+					policy: &query.LockoutPolicy{
+						ShowFailures: true,
+					},
+				},
+				idpUserLinksProvider: &mockIDPUserLinks{},
+			},
+			args{&domain.AuthRequest{
+				UserID:  "UserID",
+				Prompt:  []domain.Prompt{domain.PromptNone},
+				Request: &domain.AuthRequestOIDC{},
+				LoginPolicy: &domain.LoginPolicy{
+					SecondFactors:             []domain.SecondFactorType{domain.SecondFactorTypeTOTP},
+					PasswordCheckLifetime:     10 * 24 * time.Hour,
+					SecondFactorCheckLifetime: 18 * time.Hour,
+				},
+			}, true},
+			[]domain.NextStep{&domain.GrantRequiredStep{}},
+			nil,
+		},
+		{
+			"prompt none, checkLoggedIn true, authenticated and required user grants exist, redirect to callback step",
+			fields{
+				userSessionViewProvider: &mockViewUserSession{
+					PasswordVerification:     testNow.Add(-5 * time.Minute),
+					SecondFactorVerification: testNow.Add(-5 * time.Minute),
+				},
+				userViewProvider: &mockViewUser{
+					PasswordSet:     true,
+					IsEmailVerified: true,
+					MFAMaxSetUp:     int32(domain.MFALevelSecondFactor),
+				},
+				userEventProvider: &mockEventUser{},
+				orgViewProvider:   &mockViewOrg{State: domain.OrgStateActive},
+				userGrantProvider: &mockUserGrants{
+					roleCheck:  true,
+					userGrants: 2,
+				},
+				projectProvider:     &mockProject{},
+				applicationProvider: &mockApp{app: &query.App{OIDCConfig: &query.OIDCApp{AppType: domain.OIDCApplicationTypeWeb}}},
+				lockoutPolicyProvider: &mockLockoutPolicy{
+					policy: &query.LockoutPolicy{
+						ShowFailures: true,
+					},
+				},
+				idpUserLinksProvider: &mockIDPUserLinks{},
+			},
+			args{&domain.AuthRequest{
+				UserID:  "UserID",
+				Prompt:  []domain.Prompt{domain.PromptNone},
+				Request: &domain.AuthRequestOIDC{},
+				LoginPolicy: &domain.LoginPolicy{
+					SecondFactors:             []domain.SecondFactorType{domain.SecondFactorTypeTOTP},
+					PasswordCheckLifetime:     10 * 24 * time.Hour,
+					SecondFactorCheckLifetime: 18 * time.Hour,
+				},
+			}, true},
+			[]domain.NextStep{&domain.RedirectToCallbackStep{}},
+			nil,
+		},
+		{
+			"prompt none, checkLoggedIn true, authenticated and required project missing, project required step",
+			fields{
+				userSessionViewProvider: &mockViewUserSession{
+					PasswordVerification:     testNow.Add(-5 * time.Minute),
+					SecondFactorVerification: testNow.Add(-5 * time.Minute),
+				},
+				userViewProvider: &mockViewUser{
+					PasswordSet:     true,
+					IsEmailVerified: true,
+					MFAMaxSetUp:     int32(domain.MFALevelSecondFactor),
+				},
+				userEventProvider: &mockEventUser{},
+				orgViewProvider:   &mockViewOrg{State: domain.OrgStateActive},
+				userGrantProvider: &mockUserGrants{},
+				projectProvider: &mockProject{
+					projectCheck:  true,
+					hasProject:    false,
+					resourceOwner: "other-org",
+				},
+				lockoutPolicyProvider: &mockLockoutPolicy{
+					policy: &query.LockoutPolicy{
+						ShowFailures: true,
+					},
+				},
+				idpUserLinksProvider: &mockIDPUserLinks{},
+			},
+			args{&domain.AuthRequest{
 				UserID:  "UserID",
 				Prompt:  []domain.Prompt{domain.PromptNone},
 				Request: &domain.AuthRequestOIDC{},
@@ -1699,63 +2404,185 @@ func TestAuthRequestRepo_nextSteps(t *testing.T) {
 			[]domain.NextStep{&domain.LinkUsersStep{}},
 			nil,
 		},
-	}
-	for _, tt := range tests {
-		t.Run(tt.name, func(t *testing.T) {
-			repo := &AuthRequestRepo{
-				AuthRequests:              tt.fields.AuthRequests,
-				View:                      tt.fields.View,
-				UserSessionViewProvider:   tt.fields.userSessionViewProvider,
-				UserViewProvider:          tt.fields.userViewProvider,
-				UserEventProvider:         tt.fields.userEventProvider,
-				OrgViewProvider:           tt.fields.orgViewProvider,
-				UserGrantProvider:         tt.fields.userGrantProvider,
-				ProjectProvider:           tt.fields.projectProvider,
-				ApplicationProvider:       tt.fields.applicationProvider,
-				LoginPolicyViewProvider:   tt.fields.loginPolicyProvider,
-				LockoutPolicyViewProvider: tt.fields.lockoutPolicyProvider,
-				IDPUserLinksProvider:      tt.fields.idpUserLinksProvider,
-				PrivacyPolicyProvider:     tt.fields.privacyPolicyProvider,
-				LabelPolicyProvider:       tt.fields.labelPolicyProvider,
-				PasswordAgePolicyProvider: tt.fields.passwordAgePolicyProvider,
-				CustomTextProvider:        tt.fields.customTextProvider,
-			}
-			got, err := repo.nextSteps(context.Background(), tt.args.request, tt.args.checkLoggedIn)
-			if (err != nil && tt.wantErr == nil) || (tt.wantErr != nil && !tt.wantErr(err)) {
-				t.Errorf("nextSteps() wrong error = %v", err)
-				return
-			}
-			assert.ElementsMatch(t, got, tt.want)
-		})
-	}
-}
-
-func TestAuthRequestRepo_mfaChecked(t *testing.T) {
-	type args struct {
-		userSession *user_model.UserSessionView
-		request     *domain.AuthRequest
-		user        *user_model.UserView
-		isInternal  bool
-	}
-	tests := []struct {
-		name            string
-		args            args
-		want            domain.NextStep
-		wantChecked     bool
-		errFunc         func(err error) bool
-		wantMFAVerified []domain.MFAType
-	}{
-		//{
-		//	"required, prompt and false", //TODO: enable when LevelsOfAssurance is checked
-		//	fields{},
-		//	args{
-		//		request: &domain.AuthRequest{PossibleLOAs: []model.LevelOfAssurance{}},
-		//		user: &user_model.UserView{
-		//			OTPState: user_model.MFAStateReady,
-		//		},
-		//	},
-		//	false,
-		//},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := &AuthRequestRepo{
+				AuthRequests:              tt.fields.AuthRequests,
+				View:                      tt.fields.View,
+				UserSessionViewProvider:   tt.fields.userSessionViewProvider,
+				UserViewProvider:          tt.fields.userViewProvider,
+				UserEventProvider:         tt.fields.userEventProvider,
+				OrgViewProvider:           tt.fields.orgViewProvider,
+				UserGrantProvider:         tt.fields.userGrantProvider,
+				ProjectProvider:           tt.fields.projectProvider,
+				ApplicationProvider:       tt.fields.applicationProvider,
+				LoginPolicyViewProvider:   tt.fields.loginPolicyProvider,
+				LockoutPolicyViewProvider: tt.fields.lockoutPolicyProvider,
+				IDPUserLinksProvider:      tt.fields.idpUserLinksProvider,
+				PrivacyPolicyProvider:     tt.fields.privacyPolicyProvider,
+				LabelPolicyProvider:       tt.fields.labelPolicyProvider,
+				PasswordAgePolicyProvider: tt.fields.passwordAgePolicyProvider,
+				CustomTextProvider:        tt.fields.customTextProvider,
+				TermsProvider:             tt.fields.termsProvider,
+				BreakGlassProvider:        tt.fields.breakGlassProvider,
+				ConsentProvider:           tt.fields.consentProvider,
+				ProfileProvider:           tt.fields.profileProvider,
+				UserCommandProvider:       tt.fields.userCommandProvider,
+			}
+			if repo.TermsProvider == nil {
+				repo.TermsProvider = &mockTerms{}
+			}
+			if repo.BreakGlassProvider == nil {
+				repo.BreakGlassProvider = &mockBreakGlass{}
+			}
+			if repo.ConsentProvider == nil {
+				repo.ConsentProvider = &mockConsent{}
+			}
+			if repo.ProfileProvider == nil {
+				repo.ProfileProvider = &mockProfile{}
+			}
+			got, err := repo.nextSteps(context.Background(), tt.args.request, tt.args.checkLoggedIn)
+			if (err != nil && tt.wantErr == nil) || (tt.wantErr != nil && !tt.wantErr(err)) {
+				t.Errorf("nextSteps() wrong error = %v", err)
+				return
+			}
+			assert.ElementsMatch(t, got, tt.want)
+		})
+	}
+}
+
+func TestAuthRequestRepo_mfaChecked(t *testing.T) {
+	type args struct {
+		userSession    *user_model.UserSessionView
+		request        *domain.AuthRequest
+		user           *user_model.UserView
+		isInternal     bool
+		trustedDevices trustedDeviceProvider
+	}
+	tests := []struct {
+		name            string
+		args            args
+		want            domain.NextStep
+		wantChecked     bool
+		errFunc         func(err error) bool
+		wantMFAVerified []domain.MFAType
+	}{
+		{
+			"not set up, required by request, prompt and false",
+			args{
+				request: &domain.AuthRequest{
+					PossibleLOAs: []domain.LevelOfAssurance{domain.LevelOfAssuranceMultiFactor},
+					LoginPolicy: &domain.LoginPolicy{
+						SecondFactors:       []domain.SecondFactorType{domain.SecondFactorTypeTOTP},
+						MFAInitSkipLifetime: 30 * 24 * time.Hour,
+					},
+				},
+				user: &user_model.UserView{
+					HumanView: &user_model.HumanView{
+						MFAMaxSetUp:    domain.MFALevelNotSetUp,
+						MFAInitSkipped: testNow,
+					},
+				},
+				isInternal: true,
+			},
+			&domain.MFAPromptStep{
+				Required:     true,
+				MFAProviders: []domain.MFAType{domain.MFATypeTOTP},
+			},
+			false,
+			nil,
+			nil,
+		},
+		{
+			"not checked, required by request, trusted device, check and false",
+			args{
+				request: &domain.AuthRequest{
+					AgentID:      "agentID",
+					PossibleLOAs: []domain.LevelOfAssurance{domain.LevelOfAssuranceMultiFactor},
+					LoginPolicy: &domain.LoginPolicy{
+						SecondFactors:             []domain.SecondFactorType{domain.SecondFactorTypeTOTP},
+						SecondFactorCheckLifetime: 18 * time.Hour,
+						TrustedDeviceLifetime:     30 * 24 * time.Hour,
+					},
+				},
+				user: &user_model.UserView{
+					HumanView: &user_model.HumanView{
+						MFAMaxSetUp: domain.MFALevelSecondFactor,
+						OTPState:    user_model.MFAStateReady,
+					},
+				},
+				userSession: &user_model.UserSessionView{},
+				isInternal:  false,
+				trustedDevices: &mockTrustedDevice{
+					device: &query.TrustedDevice{
+						ID:           "deviceID",
+						UserAgentID:  "agentID",
+						CreationDate: testNow.Add(-24 * time.Hour),
+					},
+				},
+			},
+			&domain.MFAVerificationStep{
+				MFAProviders: []domain.MFAType{domain.MFATypeTOTP},
+			},
+			false,
+			nil,
+			nil,
+		},
+		{
+			"checked within max auth age of request, required by request, true",
+			args{
+				request: &domain.AuthRequest{
+					CreationDate: testNow,
+					MaxAuthAge:   gu.Ptr(15 * time.Minute),
+					PossibleLOAs: []domain.LevelOfAssurance{domain.LevelOfAssuranceMultiFactor},
+					LoginPolicy: &domain.LoginPolicy{
+						SecondFactors:             []domain.SecondFactorType{domain.SecondFactorTypeTOTP},
+						SecondFactorCheckLifetime: 18 * time.Hour,
+					},
+				},
+				user: &user_model.UserView{
+					HumanView: &user_model.HumanView{
+						MFAMaxSetUp: domain.MFALevelSecondFactor,
+						OTPState:    user_model.MFAStateReady,
+					},
+				},
+				userSession: &user_model.UserSessionView{SecondFactorVerification: testNow.Add(-5 * time.Minute)},
+				isInternal:  true,
+			},
+			nil,
+			true,
+			nil,
+			[]domain.MFAType{domain.MFATypeTOTP},
+		},
+		{
+			"checked before max auth age of request, required by request, check and false",
+			args{
+				request: &domain.AuthRequest{
+					CreationDate: testNow,
+					MaxAuthAge:   gu.Ptr(15 * time.Minute),
+					PossibleLOAs: []domain.LevelOfAssurance{domain.LevelOfAssuranceMultiFactor},
+					LoginPolicy: &domain.LoginPolicy{
+						SecondFactors:             []domain.SecondFactorType{domain.SecondFactorTypeTOTP},
+						SecondFactorCheckLifetime: 18 * time.Hour,
+					},
+				},
+				user: &user_model.UserView{
+					HumanView: &user_model.HumanView{
+						MFAMaxSetUp: domain.MFALevelSecondFactor,
+						OTPState:    user_model.MFAStateReady,
+					},
+				},
+				userSession: &user_model.UserSessionView{SecondFactorVerification: testNow.Add(-time.Hour)},
+				isInternal:  true,
+			},
+			&domain.MFAVerificationStep{
+				MFAProviders: []domain.MFAType{domain.MFATypeTOTP},
+			},
+			false,
+			nil,
+			nil,
+		},
 		{
 			"not set up, forced by policy, no mfas configured, error",
 			args{
@@ -1950,6 +2777,100 @@ func TestAuthRequestRepo_mfaChecked(t *testing.T) {
 			nil,
 			nil,
 		},
+		{
+			"not checked, trusted device, true",
+			args{
+				request: &domain.AuthRequest{
+					AgentID: "agentID",
+					LoginPolicy: &domain.LoginPolicy{
+						SecondFactors:             []domain.SecondFactorType{domain.SecondFactorTypeTOTP},
+						SecondFactorCheckLifetime: 18 * time.Hour,
+						TrustedDeviceLifetime:     30 * 24 * time.Hour,
+					},
+				},
+				user: &user_model.UserView{
+					HumanView: &user_model.HumanView{
+						MFAMaxSetUp: domain.MFALevelSecondFactor,
+						OTPState:    user_model.MFAStateReady,
+					},
+				},
+				userSession: &user_model.UserSessionView{},
+				isInternal:  true,
+				trustedDevices: &mockTrustedDevice{
+					device: &query.TrustedDevice{
+						ID:           "deviceID",
+						UserAgentID:  "agentID",
+						CreationDate: testNow.Add(-24 * time.Hour),
+					},
+				},
+			},
+			nil,
+			true,
+			nil,
+			nil,
+		},
+		{
+			"not checked, trusted device outside policy lifetime, check and false",
+			args{
+				request: &domain.AuthRequest{
+					AgentID: "agentID",
+					LoginPolicy: &domain.LoginPolicy{
+						SecondFactors:             []domain.SecondFactorType{domain.SecondFactorTypeTOTP},
+						SecondFactorCheckLifetime: 18 * time.Hour,
+						TrustedDeviceLifetime:     12 * time.Hour,
+					},
+				},
+				user: &user_model.UserView{
+					HumanView: &user_model.HumanView{
+						MFAMaxSetUp: domain.MFALevelSecondFactor,
+						OTPState:    user_model.MFAStateReady,
+					},
+				},
+				userSession: &user_model.UserSessionView{},
+				isInternal:  true,
+				trustedDevices: &mockTrustedDevice{
+					device: &query.TrustedDevice{
+						ID:           "deviceID",
+						UserAgentID:  "agentID",
+						CreationDate: testNow.Add(-24 * time.Hour),
+					},
+				},
+			},
+			&domain.MFAVerificationStep{
+				MFAProviders: []domain.MFAType{domain.MFATypeTOTP},
+			},
+			false,
+			nil,
+			nil,
+		},
+		{
+			"not checked, device not trusted, check and false",
+			args{
+				request: &domain.AuthRequest{
+					AgentID: "agentID",
+					LoginPolicy: &domain.LoginPolicy{
+						SecondFactors:             []domain.SecondFactorType{domain.SecondFactorTypeTOTP},
+						SecondFactorCheckLifetime: 18 * time.Hour,
+						TrustedDeviceLifetime:     30 * 24 * time.Hour,
+					},
+				},
+				user: &user_model.UserView{
+					HumanView: &user_model.HumanView{
+						MFAMaxSetUp: domain.MFALevelSecondFactor,
+						OTPState:    user_model.MFAStateReady,
+					},
+				},
+				userSession:    &user_model.UserSessionView{},
+				isInternal:     true,
+				trustedDevices: &mockTrustedDevice{},
+			},
+			&domain.MFAVerificationStep{
+				MFAProviders: []domain.MFAType{domain.MFATypeTOTP},
+			},
+			false,
+			nil,
+			nil,
+		},
 		{
 			"external not checked or forced but set up, want step",
 			args{
@@ -2053,8 +2974,10 @@ func TestAuthRequestRepo_mfaChecked(t *testing.T) {
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			repo := &AuthRequestRepo{}
-			got, ok, err := repo.mfaChecked(tt.args.userSession, tt.args.request, tt.args.user, tt.args.isInternal)
+			repo := &AuthRequestRepo{
+				TrustedDeviceProvider: tt.args.trustedDevices,
+			}
+			got, ok, err := repo.mfaChecked(context.Background(), tt.args.userSession, tt.args.request, tt.args.user, tt.args.isInternal)
 			if (tt.errFunc != nil && !tt.errFunc(err)) || (err != nil && tt.errFunc == nil) {
 				t.Errorf("got wrong err: %v ", err)
 				return
@@ -2144,6 +3067,264 @@ func TestAuthRequestRepo_mfaSkippedOrSetUp(t *testing.T) {
 	}
 }
 
+func TestAuthRequestRepo_checkNetworkAccess(t *testing.T) {
+	type args struct {
+		request *domain.AuthRequest
+		userID  string
+	}
+	tests := []struct {
+		name          string
+		networkAccess *mockNetworkAccess
+		authRequests  func(t *testing.T) cache.AuthRequestCache
+		args          args
+		wantRecorded  int
+		wantMonitored bool
+		wantErr       func(error) bool
+	}{
+		{
+			name:          "user unknown, not checked",
+			networkAccess: &mockNetworkAccess{blocked: true},
+			args: args{
+				request: &domain.AuthRequest{},
+			},
+		},
+		{
+			name:          "allowed",
+			networkAccess: &mockNetworkAccess{},
+			args: args{
+				request: &domain.AuthRequest{},
+				userID:  "userID",
+			},
+		},
+		{
+			name:          "blocked, permission denied error",
+			networkAccess: &mockNetworkAccess{blocked: true},
+			args: args{
+				request: &domain.AuthRequest{},
+				userID:  "userID",
+			},
+			wantRecorded: 1,
+			wantErr:      zerrors.IsPermissionDenied,
+		},
+		{
+			name:          "monitored, recorded",
+			networkAccess: &mockNetworkAccess{monitored: true},
+			authRequests: func(t *testing.T) cache.AuthRequestCache {
+				m := mock.NewMockAuthRequestCache(gomock.NewController(t))
+				m.EXPECT().UpdateAuthRequest(gomock.Any(), gomock.Any())
+				return m
+			},
+			args: args{
+				request: &domain.AuthRequest{},
+				userID:  "userID",
+			},
+			wantRecorded:  1,
+			wantMonitored: true,
+		},
+		{
+			name:          "monitored, already recorded",
+			networkAccess: &mockNetworkAccess{monitored: true},
+			args: args{
+				request: &domain.AuthRequest{NetworkAccessMonitored: true},
+				userID:  "userID",
+			},
+			wantMonitored: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := &AuthRequestRepo{
+				NetworkAccessProvider: tt.networkAccess,
+				NetworkPolicyProvider: &mockNetworkPolicies{},
+			}
+			if tt.authRequests != nil {
+				repo.AuthRequests = tt.authRequests(t)
+			}
+			err := repo.checkNetworkAccess(context.Background(), tt.args.request, tt.args.userID, "orgID", &domain.BrowserInfo{RemoteIP: net.ParseIP("1.2.3.4")})
+			if tt.wantErr == nil && err != nil {
+				t.Errorf("unexpected error: %v", err)
+			}
+			if tt.wantErr != nil && !tt.wantErr(err) {
+				t.Errorf("unexpected error: %v", err)
+			}
+			assert.Equal(t, tt.wantRecorded, tt.networkAccess.recorded)
+			assert.Equal(t, tt.wantMonitored, tt.args.request.NetworkAccessMonitored)
+		})
+	}
+}
+
+func Test_passkeyPromptRequired(t *testing.T) {
+	promptPolicy := func() *domain.LoginPolicy {
+		return &domain.LoginPolicy{
+			PasswordlessType:            domain.PasswordlessTypeAllowed,
+			PasskeyPrompt:               true,
+			PasskeyPromptSnoozeLifetime: 30 * 24 * time.Hour,
+		}
+	}
+	type args struct {
+		request         *domain.AuthRequest
+		user            *user_model.UserView
+		isInternalLogin bool
+	}
+	tests := []struct {
+		name string
+		args args
+		want bool
+	}{
+		{
+			"prompt disabled, false",
+			args{
+				request: &domain.AuthRequest{
+					PasswordVerified: true,
+					LoginPolicy: &domain.LoginPolicy{
+						PasswordlessType: domain.PasswordlessTypeAllowed,
+					},
+				},
+				user:            &user_model.UserView{HumanView: &user_model.HumanView{}},
+				isInternalLogin: true,
+			},
+			false,
+		},
+		{
+			"passwordless not allowed, false",
+			args{
+				request: &domain.AuthRequest{
+					PasswordVerified: true,
+					LoginPolicy: &domain.LoginPolicy{
+						PasswordlessType: domain.PasswordlessTypeNotAllowed,
+						PasskeyPrompt:    true,
+					},
+				},
+				user:            &user_model.UserView{HumanView: &user_model.HumanView{}},
+				isInternalLogin: true,
+			},
+			false,
+		},
+		{
+			"shared device, false",
+			args{
+				request: &domain.AuthRequest{
+					PasswordVerified: true,
+					LoginPolicy: &domain.LoginPolicy{
+						PasswordlessType: domain.PasswordlessTypeAllowed,
+						PasskeyPrompt:    true,
+						SharedDevice:     true,
+					},
+				},
+				user:            &user_model.UserView{HumanView: &user_model.HumanView{}},
+				isInternalLogin: true,
+			},
+			false,
+		},
+		{
+			"external login, false",
+			args{
+				request: &domain.AuthRequest{
+					LoginPolicy: promptPolicy(),
+				},
+				user:            &user_model.UserView{HumanView: &user_model.HumanView{}},
+				isInternalLogin: false,
+			},
+			false,
+		},
+		{
+			"machine user, false",
+			args{
+				request: &domain.AuthRequest{
+					PasswordVerified: true,
+					LoginPolicy:      promptPolicy(),
+				},
+				user:            &user_model.UserView{MachineView: &user_model.MachineView{}},
+				isInternalLogin: true,
+			},
+			false,
+		},
+		{
+			"passkey registered, false",
+			args{
+				request: &domain.AuthRequest{
+					PasswordVerified: true,
+					LoginPolicy:      promptPolicy(),
+				},
+				user: &user_model.UserView{
+					HumanView: &user_model.HumanView{
+						PasswordlessTokens: []*user_model.WebAuthNView{{TokenID: "id", State: user_model.MFAStateReady}},
+					},
+				},
+				isInternalLogin: true,
+			},
+			false,
+		},
+		{
+			"opted out, false",
+			args{
+				request: &domain.AuthRequest{
+					PasswordVerified: true,
+					LoginPolicy:      promptPolicy(),
+				},
+				user: &user_model.UserView{
+					HumanView: &user_model.HumanView{
+						PasskeyPromptOptedOut: true,
+					},
+				},
+				isInternalLogin: true,
+			},
+			false,
+		},
+		{
+			"snoozed, false",
+			args{
+				request: &domain.AuthRequest{
+					PasswordVerified: true,
+					LoginPolicy:      promptPolicy(),
+				},
+				user: &user_model.UserView{
+					HumanView: &user_model.HumanView{
+						PasskeyPromptSnoozed: testNow.Add(-10 * time.Hour),
+					},
+				},
+				isInternalLogin: true,
+			},
+			false,
+		},
+		{
+			"snooze expired, true",
+			args{
+				request: &domain.AuthRequest{
+					PasswordVerified: true,
+					LoginPolicy:      promptPolicy(),
+				},
+				user: &user_model.UserView{
+					HumanView: &user_model.HumanView{
+						PasskeyPromptSnoozed: testNow.Add(-40 * 24 * time.Hour),
+					},
+				},
+				isInternalLogin: true,
+			},
+			true,
+		},
+		{
+			"password login without passkey, true",
+			args{
+				request: &domain.AuthRequest{
+					PasswordVerified: true,
+					LoginPolicy:      promptPolicy(),
+				},
+				user:            &user_model.UserView{HumanView: &user_model.HumanView{}},
+				isInternalLogin: true,
+			},
+			true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := passkeyPromptRequired(tt.args.request, tt.args.user, tt.args.isInternalLogin); got != tt.want {
+				t.Errorf("passkeyPromptRequired() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
 func Test_userSessionByIDs(t *testing.T) {
 	type args struct {
 		userProvider  userSessionViewProvider
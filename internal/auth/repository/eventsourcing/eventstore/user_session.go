@@ -20,3 +20,12 @@ func (repo *UserSessionRepo) GetMyUserSessions(ctx context.Context) ([]*usr_mode
 	}
 	return model.UserSessionsToModel(userSessions), nil
 }
+
+// GetMyUserSessionsOfAllAgents returns the sessions of the authenticated user on all user agents (browsers).
+func (repo *UserSessionRepo) GetMyUserSessionsOfAllAgents(ctx context.Context) ([]*usr_model.UserSessionView, error) {
+	userSessions, err := repo.View.UserSessionsByUserID(authz.GetCtxData(ctx).UserID, authz.GetInstance(ctx).InstanceID())
+	if err != nil {
+		return nil, err
+	}
+	return model.UserSessionsToModel(userSessions), nil
+}
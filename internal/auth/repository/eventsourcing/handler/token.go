@@ -106,6 +106,14 @@ func (t *Token) Reducers() []handler.AggregateReducer {
 					Event:  user.HumanRefreshTokenRemovedType,
 					Reduce: t.Reduce,
 				},
+				{
+					Event:  user.OfflineTokenAddedType,
+					Reduce: t.Reduce,
+				},
+				{
+					Event:  user.OfflineTokenRevokedType,
+					Reduce: t.Reduce,
+				},
 			},
 		},
 		{
@@ -193,10 +201,33 @@ func (t *Token) Reduce(event eventstore.Event) (_ *handler.Statement, err error)
 				handler.NewCol(view_model.TokenKeyChangeDate, event.CreatedAt()),
 				handler.NewCol(view_model.TokenKeySequence, event.Sequence()),
 				handler.NewCol(view_model.TokenKeyScopes, e.Scopes),
+				handler.NewCol(view_model.TokenKeyAudience, e.Audience),
+				handler.NewCol(view_model.TokenKeyPermissions, e.Permissions),
+				handler.NewCol(view_model.TokenKeyAllowedIPs, e.AllowedIPs),
 				handler.NewCol(view_model.TokenKeyExpiration, e.Expiration),
 				handler.NewCol(view_model.TokenKeyIsPat, true),
 			},
 		), nil
+	case user.OfflineTokenAddedType:
+		e, ok := event.(*user.OfflineTokenAddedEvent)
+		if !ok {
+			return nil, zerrors.ThrowInvalidArgumentf(nil, "MODEL-Ot4ra", "reduce.wrong.event.type %s", user.OfflineTokenAddedType)
+		}
+		return handler.NewCreateStatement(event,
+			[]handler.Column{
+				handler.NewCol(view_model.TokenKeyInstanceID, event.Aggregate().InstanceID),
+				handler.NewCol(view_model.TokenKeyUserID, event.Aggregate().ID),
+				handler.NewCol(view_model.TokenKeyResourceOwner, event.Aggregate().ResourceOwner),
+				handler.NewCol(view_model.TokenKeyID, e.TokenID),
+				handler.NewCol(view_model.TokenKeyCreationDate, event.CreatedAt()),
+				handler.NewCol(view_model.TokenKeyChangeDate, event.CreatedAt()),
+				handler.NewCol(view_model.TokenKeySequence, event.Sequence()),
+				handler.NewCol(view_model.TokenKeyApplicationID, e.ApplicationID),
+				handler.NewCol(view_model.TokenKeyAudience, e.Audience),
+				handler.NewCol(view_model.TokenKeyExpiration, e.Expiration),
+				handler.NewCol(view_model.TokenKeyIsPat, false),
+			},
+		), nil
 	case user.UserV1ProfileChangedType,
 		user.HumanProfileChangedType:
 		e, ok := event.(*user.HumanProfileChangedEvent)
@@ -240,13 +271,16 @@ func (t *Token) Reduce(event eventstore.Event) (_ *handler.Statement, err error)
 			},
 		), nil
 	case user.UserTokenRemovedType,
-		user.PersonalAccessTokenRemovedType:
+		user.PersonalAccessTokenRemovedType,
+		user.OfflineTokenRevokedType:
 		var tokenID string
 		switch e := event.(type) {
 		case *user.UserTokenRemovedEvent:
 			tokenID = e.TokenID
 		case *user.PersonalAccessTokenRemovedEvent:
 			tokenID = e.TokenID
+		case *user.OfflineTokenRevokedEvent:
+			tokenID = e.TokenID
 		default:
 			return nil, zerrors.ThrowInvalidArgumentf(nil, "MODEL-SF3ga", "reduce.wrong.event.type %s", user.UserTokenRemovedType)
 		}
@@ -109,6 +109,14 @@ func (u *User) Reducers() []handler.AggregateReducer {
 					Event:  user_repo.HumanMFAInitSkippedType,
 					Reduce: u.ProcessUser,
 				},
+				{
+					Event:  user_repo.HumanPasskeyPromptSnoozedType,
+					Reduce: u.ProcessUser,
+				},
+				{
+					Event:  user_repo.HumanPasskeyPromptOptedOutType,
+					Reduce: u.ProcessUser,
+				},
 				{
 					Event:  user_repo.HumanPasswordChangedType,
 					Reduce: u.ProcessUser,
@@ -216,6 +224,26 @@ func (u *User) ProcessUser(event eventstore.Event) (_ *handler.Statement, err er
 				handler.NewCond(view_model.UserKeyInstanceID, event.Aggregate().InstanceID),
 				handler.NewCond(view_model.UserKeyUserID, event.Aggregate().ID),
 			}), nil
+	case user_repo.HumanPasskeyPromptSnoozedType:
+		return handler.NewUpdateStatement(event,
+			[]handler.Column{
+				handler.NewCol(view_model.UserKeyPasskeyPromptSnoozed, event.CreatedAt()),
+				handler.NewCol(view_model.UserKeyChangeDate, event.CreatedAt()),
+			},
+			[]handler.Condition{
+				handler.NewCond(view_model.UserKeyInstanceID, event.Aggregate().InstanceID),
+				handler.NewCond(view_model.UserKeyUserID, event.Aggregate().ID),
+			}), nil
+	case user_repo.HumanPasskeyPromptOptedOutType:
+		return handler.NewUpdateStatement(event,
+			[]handler.Column{
+				handler.NewCol(view_model.UserKeyPasskeyPromptOptedOut, true),
+				handler.NewCol(view_model.UserKeyChangeDate, event.CreatedAt()),
+			},
+			[]handler.Condition{
+				handler.NewCond(view_model.UserKeyInstanceID, event.Aggregate().InstanceID),
+				handler.NewCond(view_model.UserKeyUserID, event.Aggregate().ID),
+			}), nil
 	case user_repo.UserV1InitialCodeAddedType,
 		user_repo.HumanInitialCodeAddedType:
 		return handler.NewUpdateStatement(event,
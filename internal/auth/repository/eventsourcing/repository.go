@@ -78,6 +78,13 @@ func Start(ctx context.Context, conf Config, systemDefaults sd.SystemDefaults, c
 			ProjectProvider:           queryView,
 			ApplicationProvider:       queries,
 			CustomTextProvider:        queries,
+			TrustedDeviceProvider:     queries,
+			BreakGlassProvider:        queries,
+			NetworkAccessProvider:     command,
+			NetworkPolicyProvider:     queries,
+			TermsProvider:             queries,
+			ConsentProvider:           queries,
+			ProfileProvider:           queries,
 			IdGenerator:               id.SonyFlakeGenerator(),
 		},
 		eventstore.TokenRepo{
@@ -122,7 +129,11 @@ func (q queryViewWrapper) UserGrantsByProjectAndUserID(ctx context.Context, proj
 	if err != nil {
 		return nil, err
 	}
-	return grants.UserGrants, nil
+	groupGrants, err := q.Queries.GroupGrantsByUserAndProjectID(ctx, userID, projectID)
+	if err != nil {
+		return nil, err
+	}
+	return append(grants.UserGrants, groupGrants...), nil
 }
 func (repo *EsRepository) Health(ctx context.Context) error {
 	if err := repo.UserRepo.Health(ctx); err != nil {
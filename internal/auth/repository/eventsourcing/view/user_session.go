@@ -20,6 +20,10 @@ func (v *View) UserSessionsByAgentID(agentID, instanceID string) ([]*model.UserS
 	return view.UserSessionsByAgentID(v.client, agentID, instanceID)
 }
 
+func (v *View) UserSessionsByUserID(userID, instanceID string) ([]*model.UserSessionView, error) {
+	return view.UserSessionsByUserID(v.client, userID, instanceID)
+}
+
 func (v *View) GetLatestUserSessionSequence(ctx context.Context, instanceID string) (_ *query.CurrentState, err error) {
 	q := &query.CurrentStateSearchQueries{
 		Queries: make([]query.SearchQuery, 2),
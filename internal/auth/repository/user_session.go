@@ -8,4 +8,5 @@ import (
 
 type UserSessionRepository interface {
 	GetMyUserSessions(ctx context.Context) ([]*model.UserSessionView, error)
+	GetMyUserSessionsOfAllAgents(ctx context.Context) ([]*model.UserSessionView, error)
 }
@@ -88,25 +88,25 @@ func (repo *TokenVerifierRepo) tokenByID(ctx context.Context, tokenID, userID st
 	return model.TokenViewToModel(token), nil
 }
 
-func (repo *TokenVerifierRepo) VerifyAccessToken(ctx context.Context, tokenString, verifierClientID, projectID string) (userID string, agentID string, clientID, prefLang, resourceOwner string, err error) {
+func (repo *TokenVerifierRepo) VerifyAccessToken(ctx context.Context, tokenString, verifierClientID, projectID string) (userID string, agentID string, clientID, prefLang, resourceOwner, accessTokenID string, permissions []string, err error) {
 	ctx, span := tracing.NewSpan(ctx)
 	defer func() { span.EndWithError(err) }()
 
 	tokenID, subject, ok := repo.getTokenIDAndSubject(ctx, tokenString)
 	if !ok {
-		return "", "", "", "", "", zerrors.ThrowUnauthenticated(nil, "APP-Reb32", "invalid token")
+		return "", "", "", "", "", "", nil, zerrors.ThrowUnauthenticated(nil, "APP-Reb32", "invalid token")
 	}
 	if strings.HasPrefix(tokenID, command.IDPrefixV2) {
 		return repo.verifyAccessTokenV2(ctx, tokenID, verifierClientID, projectID)
 	}
 	if sessionID, ok := strings.CutPrefix(tokenID, authz.SessionTokenPrefix); ok {
 		userID, clientID, resourceOwner, err = repo.verifySessionToken(ctx, sessionID, tokenString)
-		return
+		return userID, "", clientID, "", resourceOwner, tokenID, nil, err
 	}
 	return repo.verifyAccessTokenV1(ctx, tokenID, subject, verifierClientID, projectID)
 }
 
-func (repo *TokenVerifierRepo) verifyAccessTokenV1(ctx context.Context, tokenID, subject, verifierClientID, projectID string) (userID, agentID, clientID, prefLang, resourceOwner string, err error) {
+func (repo *TokenVerifierRepo) verifyAccessTokenV1(ctx context.Context, tokenID, subject, verifierClientID, projectID string) (userID, agentID, clientID, prefLang, resourceOwner, accessTokenID string, permissions []string, err error) {
 	ctx, span := tracing.NewSpan(ctx)
 	defer func() { span.EndWithError(err) }()
 
@@ -114,44 +114,47 @@ func (repo *TokenVerifierRepo) verifyAccessTokenV1(ctx context.Context, tokenID,
 	token, err := repo.tokenByID(ctx, tokenID, subject)
 	tokenSpan.EndWithError(err)
 	if err != nil {
-		return "", "", "", "", "", zerrors.ThrowUnauthenticated(err, "APP-BxUSiL", "invalid token")
+		return "", "", "", "", "", "", nil, zerrors.ThrowUnauthenticated(err, "APP-BxUSiL", "invalid token")
 	}
 	if token.Actor != nil {
-		return "", "", "", "", "", zerrors.ThrowPermissionDenied(nil, "APP-wai8O", "Errors.TokenExchange.Token.NotForAPI")
+		return "", "", "", "", "", "", nil, zerrors.ThrowPermissionDenied(nil, "APP-wai8O", "Errors.TokenExchange.Token.NotForAPI")
 	}
 	if !token.Expiration.After(time.Now().UTC()) {
-		return "", "", "", "", "", zerrors.ThrowUnauthenticated(err, "APP-k9KS0", "invalid token")
+		return "", "", "", "", "", "", nil, zerrors.ThrowUnauthenticated(err, "APP-k9KS0", "invalid token")
 	}
 	if token.IsPAT {
-		return token.UserID, "", "", "", token.ResourceOwner, nil
+		if err = verifyPATRestrictions(ctx, token, verifierClientID, projectID); err != nil {
+			return "", "", "", "", "", "", nil, err
+		}
+		return token.UserID, "", "", "", token.ResourceOwner, token.ID, token.Permissions, nil
 	}
 	if err = verifyAudience(token.Audience, verifierClientID, projectID); err != nil {
-		return "", "", "", "", "", err
+		return "", "", "", "", "", "", nil, err
 	}
-	return token.UserID, token.UserAgentID, token.ApplicationID, token.PreferredLanguage, token.ResourceOwner, nil
+	return token.UserID, token.UserAgentID, token.ApplicationID, token.PreferredLanguage, token.ResourceOwner, token.ID, nil, nil
 }
 
-func (repo *TokenVerifierRepo) verifyAccessTokenV2(ctx context.Context, token, verifierClientID, projectID string) (userID, agentID, clientID, prefLang, resourceOwner string, err error) {
+func (repo *TokenVerifierRepo) verifyAccessTokenV2(ctx context.Context, token, verifierClientID, projectID string) (userID, agentID, clientID, prefLang, resourceOwner, accessTokenID string, permissions []string, err error) {
 	ctx, span := tracing.NewSpan(ctx)
 	defer func() { span.EndWithError(err) }()
 
 	activeToken, err := repo.Query.ActiveAccessTokenByToken(ctx, token)
 	if err != nil {
-		return "", "", "", "", "", err
+		return "", "", "", "", "", "", nil, err
 	}
 	if activeToken.Actor != nil {
-		return "", "", "", "", "", zerrors.ThrowPermissionDenied(nil, "APP-Shi0J", "Errors.TokenExchange.Token.NotForAPI")
+		return "", "", "", "", "", "", nil, zerrors.ThrowPermissionDenied(nil, "APP-Shi0J", "Errors.TokenExchange.Token.NotForAPI")
 	}
 	if err = verifyAudience(activeToken.Audience, verifierClientID, projectID); err != nil {
-		return "", "", "", "", "", err
+		return "", "", "", "", "", "", nil, err
 	}
 	if err = repo.checkAuthentication(ctx, activeToken.AuthMethods, activeToken.UserID); err != nil {
-		return "", "", "", "", "", err
+		return "", "", "", "", "", "", nil, err
 	}
 	prefLang = gu.Value(activeToken.PreferredLanguage).String()
 	agentID = gu.Value(gu.Value(activeToken.UserAgent).FingerprintID)
 
-	return activeToken.UserID, agentID, activeToken.ClientID, prefLang, activeToken.ResourceOwner, nil
+	return activeToken.UserID, agentID, activeToken.ClientID, prefLang, activeToken.ResourceOwner, activeToken.AccessTokenID, nil, nil
 }
 
 func (repo *TokenVerifierRepo) verifySessionToken(ctx context.Context, sessionID, token string) (userID, clientID, resourceOwner string, err error) {
@@ -313,6 +316,20 @@ func (repo *TokenVerifierRepo) decryptAccessToken(token string) (string, error)
 	return tokenIDSubject, nil
 }
 
+// verifyPATRestrictions checks the optional audience and IP restrictions of a personal access token.
+// The IP restriction is checked against the client IP, which is only read from the forwarded header of trusted proxies.
+func verifyPATRestrictions(ctx context.Context, token *usr_model.TokenView, verifierClientID, projectID string) error {
+	if len(token.Audience) > 0 {
+		if err := verifyAudience(token.Audience, verifierClientID, projectID); err != nil {
+			return err
+		}
+	}
+	if !http_util.IsIPAllowed(token.AllowedIPs, http_util.RemoteIPFromCtx(ctx)) {
+		return zerrors.ThrowPermissionDenied(nil, "APP-Pat3i", "Errors.Token.IPNotAllowed")
+	}
+	return nil
+}
+
 func verifyAudience(audience []string, verifierClientID, projectID string) error {
 	for _, aud := range audience {
 		if verifierClientID == aud || projectID == aud {
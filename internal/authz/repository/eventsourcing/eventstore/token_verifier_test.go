@@ -0,0 +1,110 @@
+package eventstore
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	http_util "github.com/zitadel/zitadel/internal/api/http"
+	usr_model "github.com/zitadel/zitadel/internal/user/model"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+func Test_verifyPATRestrictions(t *testing.T) {
+	type args struct {
+		token          *usr_model.TokenView
+		trustedProxies []string
+		remoteAddr     string
+		forwardedFor   string
+	}
+	tests := []struct {
+		name    string
+		args    args
+		wantErr error
+	}{
+		{
+			name: "no restrictions",
+			args: args{
+				token:      &usr_model.TokenView{},
+				remoteAddr: "1.2.3.4:1234",
+			},
+		},
+		{
+			name: "invalid audience",
+			args: args{
+				token:      &usr_model.TokenView{Audience: []string{"otherProjectID"}},
+				remoteAddr: "1.2.3.4:1234",
+			},
+			wantErr: zerrors.ThrowUnauthenticated(nil, "APP-Zxfako", "invalid audience"),
+		},
+		{
+			name: "allowed ip",
+			args: args{
+				token:      &usr_model.TokenView{AllowedIPs: []string{"1.2.3.0/24"}},
+				remoteAddr: "1.2.3.4:1234",
+			},
+		},
+		{
+			name: "ip not allowed",
+			args: args{
+				token:      &usr_model.TokenView{AllowedIPs: []string{"1.2.3.0/24"}},
+				remoteAddr: "5.6.7.8:1234",
+			},
+			wantErr: zerrors.ThrowPermissionDenied(nil, "APP-Pat3i", "Errors.Token.IPNotAllowed"),
+		},
+		{
+			name: "forwarded header without trusted proxies, allowed",
+			args: args{
+				token:        &usr_model.TokenView{AllowedIPs: []string{"1.2.3.0/24"}},
+				remoteAddr:   "5.6.7.8:1234",
+				forwardedFor: "1.2.3.4",
+			},
+		},
+		{
+			name: "forged forwarded header, not allowed",
+			args: args{
+				token:          &usr_model.TokenView{AllowedIPs: []string{"1.2.3.0/24"}},
+				trustedProxies: []string{"10.0.0.0/8"},
+				remoteAddr:     "5.6.7.8:1234",
+				forwardedFor:   "1.2.3.4",
+			},
+			wantErr: zerrors.ThrowPermissionDenied(nil, "APP-Pat3i", "Errors.Token.IPNotAllowed"),
+		},
+		{
+			name: "forwarded header of trusted proxy, allowed",
+			args: args{
+				token:          &usr_model.TokenView{AllowedIPs: []string{"1.2.3.0/24"}},
+				trustedProxies: []string{"10.0.0.0/8"},
+				remoteAddr:     "10.0.0.1:1234",
+				forwardedFor:   "1.2.3.4",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.NoError(t, http_util.SetClientIPConfig(http_util.ClientIPConfig{TrustedProxies: tt.args.trustedProxies}))
+			t.Cleanup(func() {
+				require.NoError(t, http_util.SetClientIPConfig(http_util.ClientIPConfig{}))
+			})
+			err := verifyPATRestrictions(requestContext(tt.args.remoteAddr, tt.args.forwardedFor), tt.args.token, "clientID", "projectID")
+			assert.ErrorIs(t, err, tt.wantErr)
+		})
+	}
+}
+
+// requestContext returns the context of a request, as it is passed to the handlers
+func requestContext(remoteAddr, forwardedFor string) (ctx context.Context) {
+	r := httptest.NewRequest(http.MethodGet, "/", nil)
+	r.RemoteAddr = remoteAddr
+	if forwardedFor != "" {
+		r.Header.Set(http_util.ForwardedFor, forwardedFor)
+	}
+	http_util.CopyHeadersToContext(http.HandlerFunc(func(_ http.ResponseWriter, r *http.Request) {
+		ctx = r.Context()
+	})).ServeHTTP(httptest.NewRecorder(), r)
+	return ctx
+}
@@ -2,8 +2,10 @@ package eventstore
 
 import (
 	"context"
+	"slices"
 
 	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/domain"
 	"github.com/zitadel/zitadel/internal/query"
 	"github.com/zitadel/zitadel/internal/telemetry/tracing"
 )
@@ -20,7 +22,33 @@ func (repo *UserMembershipRepo) SearchMyMemberships(ctx context.Context, orgID s
 	if err != nil {
 		return nil, err
 	}
-	return userMembershipsToMemberships(memberships), nil
+	return repo.addCustomRolePermissions(ctx, userMembershipsToMemberships(memberships))
+}
+
+// addCustomRolePermissions resolves the custom roles of the organization memberships to their permissions.
+func (repo *UserMembershipRepo) addCustomRolePermissions(ctx context.Context, memberships []*authz.Membership) (_ []*authz.Membership, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	for _, membership := range memberships {
+		if membership.MemberType != authz.MemberTypeOrganization {
+			continue
+		}
+		_, customRoles := domain.SplitOrgCustomRoles(membership.Roles)
+		if len(customRoles) == 0 {
+			continue
+		}
+		roles, err := repo.Queries.OrgCustomRoles(ctx, membership.AggregateID)
+		if err != nil {
+			return nil, err
+		}
+		for _, role := range roles.Roles {
+			if slices.Contains(customRoles, role.Key) {
+				membership.Permissions = append(membership.Permissions, role.Permissions...)
+			}
+		}
+	}
+	return memberships, nil
 }
 
 func (repo *UserMembershipRepo) searchUserMemberships(ctx context.Context, orgID string, shouldTriggerBulk bool) (_ []*query.Membership, err error) {
@@ -0,0 +1,48 @@
+// Package backoff provides the exponential backoff used for the retries of failed deliveries and syncs.
+package backoff
+
+import (
+	"context"
+	"time"
+)
+
+// Config defines the exponential backoff.
+// Embed it with `mapstructure:",squash"` to keep its keys on the level of the embedding configuration.
+type Config struct {
+	// InitialInterval is the wait time before the first retry
+	InitialInterval time.Duration
+	// MaxInterval limits the wait time between two retries
+	MaxInterval time.Duration
+	// Multiplier is applied to the wait time after every retry
+	Multiplier float64
+}
+
+// Delay returns the wait time before the retry after the given number of failed attempts
+func (c *Config) Delay(attempts uint16) time.Duration {
+	interval := float64(c.InitialInterval)
+	for i := uint16(1); i < attempts; i++ {
+		interval *= c.Multiplier
+		if c.MaxInterval > 0 && interval >= float64(c.MaxInterval) {
+			return c.MaxInterval
+		}
+	}
+	return time.Duration(interval)
+}
+
+// Retry calls fn until it succeeds, the maximum attempts are reached or the context is done.
+// It returns the number of attempts and the last error.
+func (c *Config) Retry(ctx context.Context, maxAttempts uint16, fn func(ctx context.Context) error) (attempts uint16, err error) {
+	maxAttempts = max(maxAttempts, 1)
+	for attempts = 1; ; attempts++ {
+		if err = fn(ctx); err == nil || attempts >= maxAttempts {
+			return attempts, err
+		}
+		timer := time.NewTimer(c.Delay(attempts))
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return attempts, ctx.Err()
+		case <-timer.C:
+		}
+	}
+}
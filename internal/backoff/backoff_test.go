@@ -0,0 +1,48 @@
+package backoff
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestConfig_Delay(t *testing.T) {
+	config := &Config{
+		InitialInterval: time.Second,
+		MaxInterval:     5 * time.Second,
+		Multiplier:      2,
+	}
+	assert.Equal(t, time.Second, config.Delay(1))
+	assert.Equal(t, 2*time.Second, config.Delay(2))
+	assert.Equal(t, 4*time.Second, config.Delay(3))
+	assert.Equal(t, 5*time.Second, config.Delay(4))
+}
+
+func TestConfig_Retry(t *testing.T) {
+	config := &Config{
+		InitialInterval: time.Millisecond,
+		Multiplier:      2,
+	}
+	t.Run("success after retry", func(t *testing.T) {
+		calls := 0
+		attempts, err := config.Retry(context.Background(), 3, func(context.Context) error {
+			calls++
+			if calls < 2 {
+				return errors.New("failed")
+			}
+			return nil
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, uint16(2), attempts)
+	})
+	t.Run("max attempts reached", func(t *testing.T) {
+		attempts, err := config.Retry(context.Background(), 3, func(context.Context) error {
+			return errors.New("failed")
+		})
+		assert.Error(t, err)
+		assert.Equal(t, uint16(3), attempts)
+	})
+}
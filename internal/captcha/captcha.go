@@ -0,0 +1,120 @@
+package captcha
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+const (
+	HCaptchaVerifyURL  = "https://api.hcaptcha.com/siteverify"
+	ReCaptchaVerifyURL = "https://www.google.com/recaptcha/api/siteverify"
+	TurnstileVerifyURL = "https://challenges.cloudflare.com/turnstile/v0/siteverify"
+
+	// DefaultTimeout bounds the verification, so an unavailable CAPTCHA service doesn't block the login.
+	DefaultTimeout = 10 * time.Second
+)
+
+var defaultHTTPClient = &http.Client{Timeout: DefaultTimeout}
+
+// Verifier checks the response token a client received after solving a CAPTCHA challenge.
+type Verifier interface {
+	Verify(ctx context.Context, response, remoteIP string) error
+}
+
+// Provider verifies responses against the siteverify endpoint of a CAPTCHA service.
+// hCaptcha, reCAPTCHA and Turnstile share the same request and response format.
+type Provider struct {
+	verifyURL  string
+	secret     string
+	httpClient *http.Client
+}
+
+type Option func(*Provider)
+
+// WithHTTPClient sets the client used to call the siteverify endpoint.
+// The verification is bounded by [DefaultTimeout] regardless of the client.
+func WithHTTPClient(client *http.Client) Option {
+	return func(p *Provider) {
+		p.httpClient = client
+	}
+}
+
+// WithVerifyURL overwrites the siteverify endpoint of the provider.
+func WithVerifyURL(verifyURL string) Option {
+	return func(p *Provider) {
+		p.verifyURL = verifyURL
+	}
+}
+
+// New returns the Provider for the requested CAPTCHA type.
+func New(captchaType domain.CaptchaType, secret string, options ...Option) (*Provider, error) {
+	p := &Provider{
+		secret:     secret,
+		httpClient: defaultHTTPClient,
+	}
+	switch captchaType {
+	case domain.CaptchaTypeHCaptcha:
+		p.verifyURL = HCaptchaVerifyURL
+	case domain.CaptchaTypeReCaptcha:
+		p.verifyURL = ReCaptchaVerifyURL
+	case domain.CaptchaTypeTurnstile:
+		p.verifyURL = TurnstileVerifyURL
+	case domain.CaptchaTypeUnspecified:
+		fallthrough
+	default:
+		return nil, zerrors.ThrowInvalidArgument(nil, "CAPTC-3n9fs", "Errors.Captcha.TypeInvalid")
+	}
+	for _, option := range options {
+		option(p)
+	}
+	return p, nil
+}
+
+type verifyResponse struct {
+	Success    bool     `json:"success"`
+	ErrorCodes []string `json:"error-codes"`
+}
+
+func (p *Provider) Verify(ctx context.Context, response, remoteIP string) error {
+	if response == "" {
+		return zerrors.ThrowInvalidArgument(nil, "CAPTC-Ew2ka", "Errors.Captcha.Missing")
+	}
+	values := url.Values{
+		"secret":   {p.secret},
+		"response": {response},
+	}
+	if remoteIP != "" {
+		values.Set("remoteip", remoteIP)
+	}
+	ctx, cancel := context.WithTimeout(ctx, DefaultTimeout)
+	defer cancel()
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, p.verifyURL, strings.NewReader(values.Encode()))
+	if err != nil {
+		return zerrors.ThrowInternal(err, "CAPTC-Iu2ds", "Errors.Captcha.VerificationFailed")
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	resp, err := p.httpClient.Do(req)
+	if err != nil {
+		return zerrors.ThrowUnavailable(err, "CAPTC-a9Vbn", "Errors.Captcha.VerificationFailed")
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return zerrors.ThrowUnavailable(nil, "CAPTC-Pq3xz", "Errors.Captcha.VerificationFailed")
+	}
+	result := new(verifyResponse)
+	if err = json.NewDecoder(resp.Body).Decode(result); err != nil {
+		return zerrors.ThrowInternal(err, "CAPTC-Zo2mc", "Errors.Captcha.VerificationFailed")
+	}
+	if !result.Success {
+		return zerrors.ThrowPreconditionFailed(fmt.Errorf("error codes: %v", result.ErrorCodes), "CAPTC-Ke4rt", "Errors.Captcha.Invalid")
+	}
+	return nil
+}
@@ -0,0 +1,113 @@
+package captcha
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+func TestNew(t *testing.T) {
+	tests := []struct {
+		name        string
+		captchaType domain.CaptchaType
+		wantURL     string
+		wantErr     bool
+	}{
+		{
+			name:        "unspecified",
+			captchaType: domain.CaptchaTypeUnspecified,
+			wantErr:     true,
+		},
+		{
+			name:        "hCaptcha",
+			captchaType: domain.CaptchaTypeHCaptcha,
+			wantURL:     HCaptchaVerifyURL,
+		},
+		{
+			name:        "reCAPTCHA",
+			captchaType: domain.CaptchaTypeReCaptcha,
+			wantURL:     ReCaptchaVerifyURL,
+		},
+		{
+			name:        "Turnstile",
+			captchaType: domain.CaptchaTypeTurnstile,
+			wantURL:     TurnstileVerifyURL,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := New(tt.captchaType, "secret")
+			if tt.wantErr {
+				assert.True(t, zerrors.IsErrorInvalidArgument(err))
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantURL, got.verifyURL)
+			assert.Equal(t, DefaultTimeout, got.httpClient.Timeout)
+		})
+	}
+}
+
+func TestProvider_Verify(t *testing.T) {
+	tests := []struct {
+		name     string
+		response string
+		status   int
+		body     string
+		wantErr  func(error) bool
+	}{
+		{
+			name:     "missing response",
+			response: "",
+			wantErr:  zerrors.IsErrorInvalidArgument,
+		},
+		{
+			name:     "unavailable",
+			response: "token",
+			status:   http.StatusInternalServerError,
+			wantErr:  zerrors.IsUnavailable,
+		},
+		{
+			name:     "invalid response",
+			response: "token",
+			status:   http.StatusOK,
+			body:     `{"success":false,"error-codes":["invalid-input-response"]}`,
+			wantErr:  zerrors.IsPreconditionFailed,
+		},
+		{
+			name:     "ok",
+			response: "token",
+			status:   http.StatusOK,
+			body:     `{"success":true}`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				assert.NoError(t, r.ParseForm())
+				assert.Equal(t, "secret", r.PostForm.Get("secret"))
+				assert.Equal(t, tt.response, r.PostForm.Get("response"))
+				assert.Equal(t, "127.0.0.1", r.PostForm.Get("remoteip"))
+				w.WriteHeader(tt.status)
+				_, _ = w.Write([]byte(tt.body))
+			}))
+			defer server.Close()
+
+			p, err := New(domain.CaptchaTypeHCaptcha, "secret", WithVerifyURL(server.URL), WithHTTPClient(server.Client()))
+			require.NoError(t, err)
+			err = p.Verify(context.Background(), tt.response, "127.0.0.1")
+			if tt.wantErr != nil {
+				assert.True(t, tt.wantErr(err), "unexpected error: %v", err)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
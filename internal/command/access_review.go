@@ -0,0 +1,337 @@
+package command
+
+import (
+	"context"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/zitadel/logging"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/repository/accessreview"
+	"github.com/zitadel/zitadel/internal/telemetry/tracing"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+// AccessReview periodically reviews the user grants of an organization
+type AccessReview struct {
+	Name string
+	// ProjectIDs restrict the reviewed grants to the projects, all grants of the organization are reviewed if empty
+	ProjectIDs []string
+	// Reviewers are the IDs of the users deciding about the grants
+	Reviewers []string
+	// StartDate of the first campaign, it's ignored on changes. The first campaign starts immediately if it's empty.
+	StartDate time.Time
+	// Duration is the time the reviewers have to decide, before the campaign is closed
+	Duration time.Duration
+	// Interval between the start of two campaigns, the review is only run once if it's 0
+	Interval time.Duration
+	// RevokeUndecided revokes the grants, no reviewer decided about, when the campaign is closed
+	RevokeUndecided bool
+}
+
+func (r *AccessReview) IsValid() error {
+	if r.Name = strings.TrimSpace(r.Name); r.Name == "" {
+		return zerrors.ThrowInvalidArgument(nil, "COMMAND-Ar1nm", "Errors.AccessReview.NameMissing")
+	}
+	if len(r.Reviewers) == 0 {
+		return zerrors.ThrowInvalidArgument(nil, "COMMAND-Ar2rv", "Errors.AccessReview.ReviewersMissing")
+	}
+	if r.Duration <= 0 {
+		return zerrors.ThrowInvalidArgument(nil, "COMMAND-Ar3du", "Errors.AccessReview.DurationInvalid")
+	}
+	// only one campaign of a review can be open at once
+	if r.Interval != 0 && r.Interval < r.Duration {
+		return zerrors.ThrowInvalidArgument(nil, "COMMAND-Ar4in", "Errors.AccessReview.IntervalInvalid")
+	}
+	r.ProjectIDs = compactIDs(r.ProjectIDs)
+	r.Reviewers = compactIDs(r.Reviewers)
+	return nil
+}
+
+func compactIDs(ids []string) []string {
+	if len(ids) == 0 {
+		return nil
+	}
+	compacted := make([]string, 0, len(ids))
+	for _, id := range ids {
+		if id = strings.TrimSpace(id); id != "" && !slices.Contains(compacted, id) {
+			compacted = append(compacted, id)
+		}
+	}
+	return compacted
+}
+
+// AccessReviewItem is a user grant in scope of an access review
+type AccessReviewItem struct {
+	GrantID        string
+	ResourceOwner  string
+	UserID         string
+	ProjectID      string
+	ProjectGrantID string
+	RoleKeys       []string
+}
+
+func (c *Commands) AddAccessReview(ctx context.Context, resourceOwner string, review *AccessReview) (_ string, _ *domain.ObjectDetails, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	if resourceOwner == "" {
+		return "", nil, zerrors.ThrowInvalidArgument(nil, "COMMAND-Ar5ro", "Errors.ResourceOwnerMissing")
+	}
+	if err = review.IsValid(); err != nil {
+		return "", nil, err
+	}
+	if err = c.checkOrgExists(ctx, resourceOwner); err != nil {
+		return "", nil, err
+	}
+	if err = c.checkReviewersExist(ctx, review.Reviewers); err != nil {
+		return "", nil, err
+	}
+	id, err := c.idGenerator.Next()
+	if err != nil {
+		return "", nil, err
+	}
+	startDate := review.StartDate
+	if startDate.IsZero() {
+		startDate = time.Now()
+	}
+	wm := NewAccessReviewWriteModel(id, resourceOwner)
+	if err = c.pushAppendAndReduce(ctx, wm,
+		accessreview.NewAddedEvent(ctx,
+			AccessReviewAggregateFromWriteModel(&wm.WriteModel),
+			review.Name,
+			review.ProjectIDs,
+			review.Reviewers,
+			startDate,
+			review.Duration,
+			review.Interval,
+			review.RevokeUndecided,
+		),
+	); err != nil {
+		return "", nil, err
+	}
+	return id, writeModelToObjectDetails(&wm.WriteModel), nil
+}
+
+// ChangeAccessReview changes the review, the changes apply from the next campaign on
+func (c *Commands) ChangeAccessReview(ctx context.Context, resourceOwner, id string, review *AccessReview) (_ *domain.ObjectDetails, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	if err = review.IsValid(); err != nil {
+		return nil, err
+	}
+	wm, err := c.getActiveAccessReviewWriteModel(ctx, resourceOwner, id)
+	if err != nil {
+		return nil, err
+	}
+	changes := make([]accessreview.Changes, 0, 6)
+	if wm.Name != review.Name {
+		changes = append(changes, accessreview.ChangeName(review.Name))
+	}
+	if !slices.Equal(wm.ProjectIDs, review.ProjectIDs) {
+		changes = append(changes, accessreview.ChangeProjectIDs(review.ProjectIDs))
+	}
+	if !slices.Equal(wm.Reviewers, review.Reviewers) {
+		if err = c.checkReviewersExist(ctx, review.Reviewers); err != nil {
+			return nil, err
+		}
+		changes = append(changes, accessreview.ChangeReviewers(review.Reviewers))
+	}
+	if wm.Duration != review.Duration {
+		changes = append(changes, accessreview.ChangeDuration(review.Duration))
+	}
+	if wm.Interval != review.Interval {
+		changes = append(changes, accessreview.ChangeInterval(review.Interval))
+	}
+	if wm.RevokeUndecided != review.RevokeUndecided {
+		changes = append(changes, accessreview.ChangeRevokeUndecided(review.RevokeUndecided))
+	}
+	if len(changes) == 0 {
+		return nil, zerrors.ThrowPreconditionFailed(nil, "COMMAND-Ar6nc", "Errors.NoChangesFound")
+	}
+	if err = c.pushAppendAndReduce(ctx, wm, accessreview.NewChangedEvent(ctx, AccessReviewAggregateFromWriteModel(&wm.WriteModel), changes)); err != nil {
+		return nil, err
+	}
+	return writeModelToObjectDetails(&wm.WriteModel), nil
+}
+
+// RemoveAccessReview removes the review, an open campaign is discarded without revoking any grant
+func (c *Commands) RemoveAccessReview(ctx context.Context, resourceOwner, id string) (_ *domain.ObjectDetails, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	wm, err := c.getActiveAccessReviewWriteModel(ctx, resourceOwner, id)
+	if err != nil {
+		return nil, err
+	}
+	if err = c.pushAppendAndReduce(ctx, wm, accessreview.NewRemovedEvent(ctx, AccessReviewAggregateFromWriteModel(&wm.WriteModel))); err != nil {
+		return nil, err
+	}
+	return writeModelToObjectDetails(&wm.WriteModel), nil
+}
+
+// DecideAccessReviewItem records the decision of the calling user about a grant of the open campaign.
+// Only the reviewers of the campaign can decide, the decision can be changed until the campaign is closed.
+func (c *Commands) DecideAccessReviewItem(ctx context.Context, resourceOwner, id, grantID string, decision domain.AccessReviewDecision, comment string) (_ *domain.ObjectDetails, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	if grantID == "" {
+		return nil, zerrors.ThrowInvalidArgument(nil, "COMMAND-Ar7gi", "Errors.IDMissing")
+	}
+	if !decision.Valid() {
+		return nil, zerrors.ThrowInvalidArgument(nil, "COMMAND-Ar8dc", "Errors.AccessReview.DecisionInvalid")
+	}
+	wm, err := c.getActiveAccessReviewWriteModel(ctx, resourceOwner, id)
+	if err != nil {
+		return nil, err
+	}
+	campaign := wm.OpenCampaign()
+	if campaign == nil {
+		return nil, zerrors.ThrowPreconditionFailed(nil, "COMMAND-Ar9oc", "Errors.AccessReview.NoOpenCampaign")
+	}
+	if !slices.Contains(campaign.Reviewers, authz.GetCtxData(ctx).UserID) {
+		return nil, zerrors.ThrowPermissionDenied(nil, "COMMAND-Ar0rv", "Errors.AccessReview.NotReviewer")
+	}
+	if campaign.item(grantID) == nil {
+		return nil, zerrors.ThrowNotFound(nil, "COMMAND-Ar1it", "Errors.AccessReview.ItemNotFound")
+	}
+	if err = c.pushAppendAndReduce(ctx, wm, accessreview.NewItemDecidedEvent(ctx, AccessReviewAggregateFromWriteModel(&wm.WriteModel), campaign.ID, grantID, decision, comment)); err != nil {
+		return nil, err
+	}
+	return writeModelToObjectDetails(&wm.WriteModel), nil
+}
+
+// CloseAccessReviewCampaign closes the open campaign of the review before its due date and revokes the grants.
+func (c *Commands) CloseAccessReviewCampaign(ctx context.Context, resourceOwner, id string) (_ *domain.ObjectDetails, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	wm, err := c.getActiveAccessReviewWriteModel(ctx, resourceOwner, id)
+	if err != nil {
+		return nil, err
+	}
+	if wm.OpenCampaign() == nil {
+		return nil, zerrors.ThrowPreconditionFailed(nil, "COMMAND-Ar2oc", "Errors.AccessReview.NoOpenCampaign")
+	}
+	if err = c.closeAccessReviewCampaign(ctx, wm); err != nil {
+		return nil, err
+	}
+	return writeModelToObjectDetails(&wm.WriteModel), nil
+}
+
+// ApplyAccessReviews closes the campaigns of all access reviews of the instance, which are due,
+// and starts the campaigns, which are scheduled.
+// grantsInScope returns the user grants of the organization, which are reviewed in a new campaign.
+func (c *Commands) ApplyAccessReviews(ctx context.Context, grantsInScope func(ctx context.Context, resourceOwner string, projectIDs []string) ([]*AccessReviewItem, error)) (err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	writeModel := newAccessReviewsWriteModel(authz.GetInstance(ctx).InstanceID())
+	if err = c.eventstore.FilterToQueryReducer(ctx, writeModel); err != nil {
+		return err
+	}
+	now := time.Now()
+	for _, review := range writeModel.reviews {
+		if !review.State.Exists() {
+			continue
+		}
+		err := c.applyAccessReview(ctx, review, now, grantsInScope)
+		logging.WithFields("review", review.AggregateID, "org", review.ResourceOwner).OnError(err).Warn("access review failed")
+	}
+	return nil
+}
+
+func (c *Commands) applyAccessReview(ctx context.Context, review *AccessReviewWriteModel, now time.Time, grantsInScope func(ctx context.Context, resourceOwner string, projectIDs []string) ([]*AccessReviewItem, error)) error {
+	if campaign := review.OpenCampaign(); campaign != nil {
+		if campaign.DueDate.After(now) {
+			return nil
+		}
+		if err := c.closeAccessReviewCampaign(ctx, review); err != nil {
+			return err
+		}
+	}
+	next, ok := review.NextCampaignDate()
+	if !ok || next.After(now) {
+		return nil
+	}
+	grants, err := grantsInScope(ctx, review.ResourceOwner, review.ProjectIDs)
+	if err != nil {
+		return err
+	}
+	campaignID, err := c.idGenerator.Next()
+	if err != nil {
+		return err
+	}
+	items := make([]*accessreview.Item, len(grants))
+	for i, grant := range grants {
+		items[i] = &accessreview.Item{
+			GrantID:        grant.GrantID,
+			ResourceOwner:  grant.ResourceOwner,
+			UserID:         grant.UserID,
+			ProjectID:      grant.ProjectID,
+			ProjectGrantID: grant.ProjectGrantID,
+			RoleKeys:       grant.RoleKeys,
+		}
+	}
+	return c.pushAppendAndReduce(ctx, review, accessreview.NewCampaignStartedEvent(ctx,
+		AccessReviewAggregateFromWriteModel(&review.WriteModel),
+		campaignID,
+		now.Add(review.Duration),
+		review.Reviewers,
+		items,
+	))
+}
+
+// closeAccessReviewCampaign removes the revoked grants of the open campaign, which still exist, and closes it.
+func (c *Commands) closeAccessReviewCampaign(ctx context.Context, review *AccessReviewWriteModel) error {
+	campaign := review.OpenCampaign()
+	cmds := make([]eventstore.Command, 0, len(campaign.Items)+1)
+	revoked := make([]string, 0)
+	for _, item := range campaign.Items {
+		decision := campaign.Decisions[item.GrantID]
+		if decision != domain.AccessReviewDecisionRevoke && (decision != domain.AccessReviewDecisionUnspecified || !review.RevokeUndecided) {
+			continue
+		}
+		// the revocation is executed on behalf of the review, so no permission on the project is checked
+		removeGrant, _, err := c.removeUserGrant(ctx, item.GrantID, item.ResourceOwner, true)
+		if zerrors.IsNotFound(err) {
+			continue
+		}
+		if err != nil {
+			return err
+		}
+		cmds = append(cmds, removeGrant)
+		revoked = append(revoked, item.GrantID)
+	}
+	cmds = append(cmds, accessreview.NewCampaignClosedEvent(ctx, AccessReviewAggregateFromWriteModel(&review.WriteModel), campaign.ID, revoked))
+	return c.pushAppendAndReduce(ctx, review, cmds...)
+}
+
+func (c *Commands) checkReviewersExist(ctx context.Context, reviewers []string) error {
+	for _, reviewer := range reviewers {
+		if err := c.checkUserExists(ctx, reviewer, ""); err != nil {
+			return zerrors.ThrowPreconditionFailed(err, "COMMAND-Ar3rv", "Errors.AccessReview.ReviewerNotFound")
+		}
+	}
+	return nil
+}
+
+func (c *Commands) getActiveAccessReviewWriteModel(ctx context.Context, resourceOwner, id string) (*AccessReviewWriteModel, error) {
+	if resourceOwner == "" || id == "" {
+		return nil, zerrors.ThrowInvalidArgument(nil, "COMMAND-Ar4id", "Errors.IDMissing")
+	}
+	wm := NewAccessReviewWriteModel(id, resourceOwner)
+	if err := c.eventstore.FilterToQueryReducer(ctx, wm); err != nil {
+		return nil, err
+	}
+	if !wm.State.Exists() {
+		return nil, zerrors.ThrowNotFound(nil, "COMMAND-Ar5nf", "Errors.AccessReview.NotFound")
+	}
+	return wm, nil
+}
@@ -0,0 +1,220 @@
+package command
+
+import (
+	"time"
+
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/repository/accessreview"
+	"github.com/zitadel/zitadel/internal/repository/org"
+)
+
+type AccessReviewWriteModel struct {
+	eventstore.WriteModel
+
+	Name            string
+	ProjectIDs      []string
+	Reviewers       []string
+	StartDate       time.Time
+	Duration        time.Duration
+	Interval        time.Duration
+	RevokeUndecided bool
+
+	// Campaign is the last campaign of the review, nil if none was started yet
+	Campaign *accessReviewCampaign
+
+	State domain.AccessReviewState
+}
+
+type accessReviewCampaign struct {
+	ID        string
+	StartDate time.Time
+	DueDate   time.Time
+	Reviewers []string
+	Items     []*accessreview.Item
+	// Decisions are the last decisions of the reviewers, by grant ID
+	Decisions map[string]domain.AccessReviewDecision
+	State     domain.AccessReviewCampaignState
+}
+
+func NewAccessReviewWriteModel(id, resourceOwner string) *AccessReviewWriteModel {
+	return &AccessReviewWriteModel{
+		WriteModel: eventstore.WriteModel{
+			AggregateID:   id,
+			ResourceOwner: resourceOwner,
+		},
+	}
+}
+
+func (wm *AccessReviewWriteModel) Reduce() error {
+	for _, event := range wm.Events {
+		wm.reduceEvent(event)
+	}
+	return wm.WriteModel.Reduce()
+}
+
+func (wm *AccessReviewWriteModel) reduceEvent(event eventstore.Event) {
+	switch e := event.(type) {
+	case *accessreview.AddedEvent:
+		wm.Name = e.Name
+		wm.ProjectIDs = e.ProjectIDs
+		wm.Reviewers = e.Reviewers
+		wm.StartDate = e.StartDate
+		wm.Duration = e.Duration
+		wm.Interval = e.Interval
+		wm.RevokeUndecided = e.RevokeUndecided
+		wm.State = domain.AccessReviewStateActive
+	case *accessreview.ChangedEvent:
+		if e.Name != nil {
+			wm.Name = *e.Name
+		}
+		if e.ProjectIDs != nil {
+			wm.ProjectIDs = *e.ProjectIDs
+		}
+		if e.Reviewers != nil {
+			wm.Reviewers = *e.Reviewers
+		}
+		if e.Duration != nil {
+			wm.Duration = *e.Duration
+		}
+		if e.Interval != nil {
+			wm.Interval = *e.Interval
+		}
+		if e.RevokeUndecided != nil {
+			wm.RevokeUndecided = *e.RevokeUndecided
+		}
+	case *accessreview.RemovedEvent, *org.OrgRemovedEvent:
+		wm.State = domain.AccessReviewStateRemoved
+		wm.Campaign = nil
+	case *accessreview.CampaignStartedEvent:
+		wm.Campaign = &accessReviewCampaign{
+			ID:        e.CampaignID,
+			StartDate: e.CreationDate(),
+			DueDate:   e.DueDate,
+			Reviewers: e.Reviewers,
+			Items:     e.Items,
+			Decisions: make(map[string]domain.AccessReviewDecision, len(e.Items)),
+			State:     domain.AccessReviewCampaignStateOpen,
+		}
+	case *accessreview.ItemDecidedEvent:
+		if wm.Campaign != nil && wm.Campaign.ID == e.CampaignID {
+			wm.Campaign.Decisions[e.GrantID] = e.Decision
+		}
+	case *accessreview.CampaignClosedEvent:
+		if wm.Campaign != nil && wm.Campaign.ID == e.CampaignID {
+			wm.Campaign.State = domain.AccessReviewCampaignStateClosed
+		}
+	}
+}
+
+func (wm *AccessReviewWriteModel) Query() *eventstore.SearchQueryBuilder {
+	return eventstore.NewSearchQueryBuilder(eventstore.ColumnsEvent).
+		ResourceOwner(wm.ResourceOwner).
+		AddQuery().
+		AggregateTypes(accessreview.AggregateType).
+		AggregateIDs(wm.AggregateID).
+		EventTypes(accessReviewEventTypes...).
+		Builder()
+}
+
+var accessReviewEventTypes = []eventstore.EventType{
+	accessreview.AddedEventType,
+	accessreview.ChangedEventType,
+	accessreview.RemovedEventType,
+	accessreview.CampaignStartedEventType,
+	accessreview.ItemDecidedEventType,
+	accessreview.CampaignClosedEventType,
+}
+
+// OpenCampaign returns the campaign the reviewers currently decide about, nil if there is none
+func (wm *AccessReviewWriteModel) OpenCampaign() *accessReviewCampaign {
+	if wm.Campaign == nil || wm.Campaign.State != domain.AccessReviewCampaignStateOpen {
+		return nil
+	}
+	return wm.Campaign
+}
+
+// NextCampaignDate returns when the next campaign starts.
+// The first campaign starts at the start date, the following ones an interval after the start of the last one.
+// False is returned if the review is only run once and its campaign was already started.
+func (wm *AccessReviewWriteModel) NextCampaignDate() (time.Time, bool) {
+	if wm.Campaign == nil {
+		return wm.StartDate, true
+	}
+	if wm.Interval == 0 {
+		return time.Time{}, false
+	}
+	return wm.Campaign.StartDate.Add(wm.Interval), true
+}
+
+func (c *accessReviewCampaign) item(grantID string) *accessreview.Item {
+	for _, item := range c.Items {
+		if item.GrantID == grantID {
+			return item
+		}
+	}
+	return nil
+}
+
+func AccessReviewAggregateFromWriteModel(wm *eventstore.WriteModel) *eventstore.Aggregate {
+	return &eventstore.Aggregate{
+		ID:            wm.AggregateID,
+		Type:          accessreview.AggregateType,
+		ResourceOwner: wm.ResourceOwner,
+		InstanceID:    wm.InstanceID,
+		Version:       accessreview.AggregateVersion,
+	}
+}
+
+// accessReviewsWriteModel contains the access reviews of all organizations of an instance
+type accessReviewsWriteModel struct {
+	eventstore.WriteModel
+
+	reviews []*AccessReviewWriteModel
+	byID    map[string]*AccessReviewWriteModel
+}
+
+func newAccessReviewsWriteModel(instanceID string) *accessReviewsWriteModel {
+	return &accessReviewsWriteModel{
+		WriteModel: eventstore.WriteModel{
+			InstanceID: instanceID,
+		},
+		byID: make(map[string]*AccessReviewWriteModel),
+	}
+}
+
+func (wm *accessReviewsWriteModel) Reduce() error {
+	for _, event := range wm.Events {
+		if e, ok := event.(*org.OrgRemovedEvent); ok {
+			for _, review := range wm.reviews {
+				if review.ResourceOwner == e.Aggregate().ID {
+					review.reduceEvent(e)
+				}
+			}
+			continue
+		}
+		review, ok := wm.byID[event.Aggregate().ID]
+		if !ok {
+			review = NewAccessReviewWriteModel(event.Aggregate().ID, event.Aggregate().ResourceOwner)
+			review.InstanceID = event.Aggregate().InstanceID
+			wm.reviews = append(wm.reviews, review)
+			wm.byID[review.AggregateID] = review
+		}
+		review.reduceEvent(event)
+		review.ProcessedSequence = event.Sequence()
+		review.ChangeDate = event.CreatedAt()
+	}
+	return wm.WriteModel.Reduce()
+}
+
+func (wm *accessReviewsWriteModel) Query() *eventstore.SearchQueryBuilder {
+	return eventstore.NewSearchQueryBuilder(eventstore.ColumnsEvent).
+		InstanceID(wm.InstanceID).
+		AddQuery().
+		AggregateTypes(accessreview.AggregateType).
+		EventTypes(accessReviewEventTypes...).
+		Or().
+		AggregateTypes(org.AggregateType).
+		EventTypes(org.OrgRemovedEventType).
+		Builder()
+}
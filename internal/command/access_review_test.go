@@ -0,0 +1,459 @@
+package command
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/text/language"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/id"
+	"github.com/zitadel/zitadel/internal/id/mock"
+	"github.com/zitadel/zitadel/internal/repository/accessreview"
+	"github.com/zitadel/zitadel/internal/repository/org"
+	"github.com/zitadel/zitadel/internal/repository/user"
+	"github.com/zitadel/zitadel/internal/repository/usergrant"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+var accessReviewStartDate = time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+func accessReviewAddedEvent(interval time.Duration, revokeUndecided bool) *accessreview.AddedEvent {
+	return accessreview.NewAddedEvent(context.Background(),
+		&accessreview.NewAggregate("review1", "org1").Aggregate,
+		"review",
+		[]string{"project1"},
+		[]string{"reviewer1"},
+		accessReviewStartDate,
+		24*time.Hour,
+		interval,
+		revokeUndecided,
+	)
+}
+
+func accessReviewCampaignStartedEvent(dueDate time.Time) *accessreview.CampaignStartedEvent {
+	return accessreview.NewCampaignStartedEvent(context.Background(),
+		&accessreview.NewAggregate("review1", "org1").Aggregate,
+		"campaign1",
+		dueDate,
+		[]string{"reviewer1"},
+		[]*accessreview.Item{
+			{GrantID: "grant1", ResourceOwner: "org1", UserID: "user1", ProjectID: "project1", RoleKeys: []string{"admin"}},
+			{GrantID: "grant2", ResourceOwner: "org1", UserID: "user2", ProjectID: "project1", RoleKeys: []string{"admin"}},
+		},
+	)
+}
+
+func accessReviewUserGrantAddedEvent(grantID, userID string) *usergrant.UserGrantAddedEvent {
+	return usergrant.NewUserGrantAddedEvent(context.Background(),
+		&usergrant.NewAggregate(grantID, "org1").Aggregate,
+		userID,
+		"project1",
+		"",
+		[]string{"admin"},
+	)
+}
+
+func TestCommandSide_AddAccessReview(t *testing.T) {
+	type fields struct {
+		eventstore  func(t *testing.T) *eventstore.Eventstore
+		idGenerator id.Generator
+	}
+	type args struct {
+		resourceOwner string
+		review        *AccessReview
+	}
+	type res struct {
+		id   string
+		want *domain.ObjectDetails
+		err  error
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		args   args
+		res    res
+	}{
+		{
+			name: "no reviewers, invalid argument error",
+			fields: fields{
+				eventstore: expectEventstore(),
+			},
+			args: args{
+				resourceOwner: "org1",
+				review:        &AccessReview{Name: "review", Duration: time.Hour},
+			},
+			res: res{
+				err: zerrors.ThrowInvalidArgument(nil, "COMMAND-Ar2rv", "Errors.AccessReview.ReviewersMissing"),
+			},
+		},
+		{
+			name: "interval shorter than duration, invalid argument error",
+			fields: fields{
+				eventstore: expectEventstore(),
+			},
+			args: args{
+				resourceOwner: "org1",
+				review:        &AccessReview{Name: "review", Reviewers: []string{"reviewer1"}, Duration: 2 * time.Hour, Interval: time.Hour},
+			},
+			res: res{
+				err: zerrors.ThrowInvalidArgument(nil, "COMMAND-Ar4in", "Errors.AccessReview.IntervalInvalid"),
+			},
+		},
+		{
+			name: "reviewer not found, precondition error",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(
+							org.NewOrgAddedEvent(context.Background(), &org.NewAggregate("org1").Aggregate, "org"),
+						),
+					),
+					expectFilter(),
+				),
+			},
+			args: args{
+				resourceOwner: "org1",
+				review:        &AccessReview{Name: "review", Reviewers: []string{"reviewer1"}, Duration: time.Hour},
+			},
+			res: res{
+				err: zerrors.ThrowPreconditionFailed(nil, "COMMAND-Ar3rv", "Errors.AccessReview.ReviewerNotFound"),
+			},
+		},
+		{
+			name: "add access review, ok",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(
+							org.NewOrgAddedEvent(context.Background(), &org.NewAggregate("org1").Aggregate, "org"),
+						),
+					),
+					expectFilter(
+						eventFromEventPusher(
+							user.NewHumanAddedEvent(context.Background(),
+								&user.NewAggregate("reviewer1", "org1").Aggregate,
+								"username",
+								"firstname",
+								"lastname",
+								"",
+								"firstname lastname",
+								language.Und,
+								domain.GenderUnspecified,
+								"email@test.ch",
+								true,
+							),
+						),
+					),
+					expectPush(
+						accessReviewAddedEvent(7*24*time.Hour, true),
+					),
+				),
+				idGenerator: mock.ExpectID(t, "review1"),
+			},
+			args: args{
+				resourceOwner: "org1",
+				review: &AccessReview{
+					Name:            " review ",
+					ProjectIDs:      []string{"project1", "project1"},
+					Reviewers:       []string{"reviewer1"},
+					StartDate:       accessReviewStartDate,
+					Duration:        24 * time.Hour,
+					Interval:        7 * 24 * time.Hour,
+					RevokeUndecided: true,
+				},
+			},
+			res: res{
+				id: "review1",
+				want: &domain.ObjectDetails{
+					ResourceOwner: "org1",
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &Commands{
+				eventstore:  tt.fields.eventstore(t),
+				idGenerator: tt.fields.idGenerator,
+			}
+			id, got, err := c.AddAccessReview(context.Background(), tt.args.resourceOwner, tt.args.review)
+			assert.ErrorIs(t, err, tt.res.err)
+			assert.Equal(t, tt.res.id, id)
+			assert.Equal(t, tt.res.want, got)
+		})
+	}
+}
+
+func TestCommandSide_DecideAccessReviewItem(t *testing.T) {
+	type args struct {
+		ctx      context.Context
+		grantID  string
+		decision domain.AccessReviewDecision
+	}
+	type res struct {
+		want *domain.ObjectDetails
+		err  error
+	}
+	tests := []struct {
+		name       string
+		eventstore func(t *testing.T) *eventstore.Eventstore
+		args       args
+		res        res
+	}{
+		{
+			name:       "decision missing, invalid argument error",
+			eventstore: expectEventstore(),
+			args: args{
+				ctx:     authz.NewMockContext("instance1", "org1", "reviewer1"),
+				grantID: "grant1",
+			},
+			res: res{
+				err: zerrors.ThrowInvalidArgument(nil, "COMMAND-Ar8dc", "Errors.AccessReview.DecisionInvalid"),
+			},
+		},
+		{
+			name: "no open campaign, precondition error",
+			eventstore: expectEventstore(
+				expectFilter(
+					eventFromEventPusher(accessReviewAddedEvent(0, false)),
+				),
+			),
+			args: args{
+				ctx:      authz.NewMockContext("instance1", "org1", "reviewer1"),
+				grantID:  "grant1",
+				decision: domain.AccessReviewDecisionRevoke,
+			},
+			res: res{
+				err: zerrors.ThrowPreconditionFailed(nil, "COMMAND-Ar9oc", "Errors.AccessReview.NoOpenCampaign"),
+			},
+		},
+		{
+			name: "not a reviewer, permission denied error",
+			eventstore: expectEventstore(
+				expectFilter(
+					eventFromEventPusher(accessReviewAddedEvent(0, false)),
+					eventFromEventPusher(accessReviewCampaignStartedEvent(accessReviewStartDate.Add(24*time.Hour))),
+				),
+			),
+			args: args{
+				ctx:      authz.NewMockContext("instance1", "org1", "user1"),
+				grantID:  "grant1",
+				decision: domain.AccessReviewDecisionApprove,
+			},
+			res: res{
+				err: zerrors.ThrowPermissionDenied(nil, "COMMAND-Ar0rv", "Errors.AccessReview.NotReviewer"),
+			},
+		},
+		{
+			name: "grant not in campaign, not found error",
+			eventstore: expectEventstore(
+				expectFilter(
+					eventFromEventPusher(accessReviewAddedEvent(0, false)),
+					eventFromEventPusher(accessReviewCampaignStartedEvent(accessReviewStartDate.Add(24*time.Hour))),
+				),
+			),
+			args: args{
+				ctx:      authz.NewMockContext("instance1", "org1", "reviewer1"),
+				grantID:  "grant3",
+				decision: domain.AccessReviewDecisionApprove,
+			},
+			res: res{
+				err: zerrors.ThrowNotFound(nil, "COMMAND-Ar1it", "Errors.AccessReview.ItemNotFound"),
+			},
+		},
+		{
+			name: "decide, ok",
+			eventstore: expectEventstore(
+				expectFilter(
+					eventFromEventPusher(accessReviewAddedEvent(0, false)),
+					eventFromEventPusher(accessReviewCampaignStartedEvent(accessReviewStartDate.Add(24*time.Hour))),
+				),
+				expectPush(
+					accessreview.NewItemDecidedEvent(authz.NewMockContext("instance1", "org1", "reviewer1"),
+						&accessreview.NewAggregate("review1", "org1").Aggregate,
+						"campaign1",
+						"grant1",
+						domain.AccessReviewDecisionRevoke,
+						"left the team",
+					),
+				),
+			),
+			args: args{
+				ctx:      authz.NewMockContext("instance1", "org1", "reviewer1"),
+				grantID:  "grant1",
+				decision: domain.AccessReviewDecisionRevoke,
+			},
+			res: res{
+				want: &domain.ObjectDetails{
+					ResourceOwner: "org1",
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &Commands{
+				eventstore: tt.eventstore(t),
+			}
+			got, err := c.DecideAccessReviewItem(tt.args.ctx, "org1", "review1", tt.args.grantID, tt.args.decision, "left the team")
+			assert.ErrorIs(t, err, tt.res.err)
+			assert.Equal(t, tt.res.want, got)
+		})
+	}
+}
+
+func TestCommandSide_ApplyAccessReviews(t *testing.T) {
+	tests := []struct {
+		name       string
+		eventstore func(t *testing.T) *eventstore.Eventstore
+	}{
+		{
+			name: "campaign not due, nothing pushed",
+			eventstore: expectEventstore(
+				expectFilter(
+					eventFromEventPusher(accessReviewAddedEvent(0, false)),
+					eventFromEventPusherWithCreationDateNow(accessReviewCampaignStartedEvent(time.Now().Add(time.Hour))),
+				),
+			),
+		},
+		{
+			name: "removed review, nothing pushed",
+			eventstore: expectEventstore(
+				expectFilter(
+					eventFromEventPusher(accessReviewAddedEvent(0, false)),
+					eventFromEventPusher(
+						org.NewOrgRemovedEvent(context.Background(), &org.NewAggregate("org1").Aggregate, "org", nil, false, nil, nil, nil),
+					),
+				),
+			),
+		},
+		{
+			name: "campaign due, revoked grants removed",
+			eventstore: expectEventstore(
+				expectFilter(
+					eventFromEventPusher(accessReviewAddedEvent(0, false)),
+					eventFromEventPusher(accessReviewCampaignStartedEvent(accessReviewStartDate.Add(24*time.Hour))),
+					eventFromEventPusher(
+						accessreview.NewItemDecidedEvent(context.Background(),
+							&accessreview.NewAggregate("review1", "org1").Aggregate,
+							"campaign1",
+							"grant1",
+							domain.AccessReviewDecisionRevoke,
+							"",
+						),
+					),
+				),
+				expectFilter(
+					eventFromEventPusher(accessReviewUserGrantAddedEvent("grant1", "user1")),
+				),
+				expectPush(
+					usergrant.NewUserGrantCascadeRemovedEvent(context.Background(),
+						&usergrant.NewAggregate("grant1", "org1").Aggregate,
+						"user1",
+						"project1",
+						"",
+					),
+					accessreview.NewCampaignClosedEvent(context.Background(),
+						&accessreview.NewAggregate("review1", "org1").Aggregate,
+						"campaign1",
+						[]string{"grant1"},
+					),
+				),
+			),
+		},
+		{
+			name: "campaign due, undecided grants revoked, removed grants skipped",
+			eventstore: expectEventstore(
+				expectFilter(
+					eventFromEventPusher(accessReviewAddedEvent(0, true)),
+					eventFromEventPusher(accessReviewCampaignStartedEvent(accessReviewStartDate.Add(24*time.Hour))),
+				),
+				expectFilter(
+					eventFromEventPusher(accessReviewUserGrantAddedEvent("grant1", "user1")),
+					eventFromEventPusher(
+						usergrant.NewUserGrantRemovedEvent(context.Background(),
+							&usergrant.NewAggregate("grant1", "org1").Aggregate,
+							"user1",
+							"project1",
+							"",
+						),
+					),
+				),
+				expectFilter(
+					eventFromEventPusher(accessReviewUserGrantAddedEvent("grant2", "user2")),
+				),
+				expectPush(
+					usergrant.NewUserGrantCascadeRemovedEvent(context.Background(),
+						&usergrant.NewAggregate("grant2", "org1").Aggregate,
+						"user2",
+						"project1",
+						"",
+					),
+					accessreview.NewCampaignClosedEvent(context.Background(),
+						&accessreview.NewAggregate("review1", "org1").Aggregate,
+						"campaign1",
+						[]string{"grant2"},
+					),
+				),
+			),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &Commands{
+				eventstore: tt.eventstore(t),
+			}
+			err := c.ApplyAccessReviews(context.Background(), func(context.Context, string, []string) ([]*AccessReviewItem, error) {
+				t.Fatal("no campaign must be started")
+				return nil, nil
+			})
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestAccessReviewWriteModel_NextCampaignDate(t *testing.T) {
+	campaignStart := accessReviewStartDate.Add(time.Hour)
+	tests := []struct {
+		name     string
+		interval time.Duration
+		campaign *accessReviewCampaign
+		want     time.Time
+		wantOK   bool
+	}{
+		{
+			name:   "first campaign at start date",
+			want:   accessReviewStartDate,
+			wantOK: true,
+		},
+		{
+			name:     "one-time review already started",
+			campaign: &accessReviewCampaign{StartDate: campaignStart},
+		},
+		{
+			name:     "recurring review, interval after last campaign",
+			interval: 7 * 24 * time.Hour,
+			campaign: &accessReviewCampaign{StartDate: campaignStart},
+			want:     campaignStart.Add(7 * 24 * time.Hour),
+			wantOK:   true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			wm := &AccessReviewWriteModel{
+				StartDate: accessReviewStartDate,
+				Interval:  tt.interval,
+				Campaign:  tt.campaign,
+			}
+			got, ok := wm.NextCampaignDate()
+			assert.Equal(t, tt.wantOK, ok)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
@@ -191,6 +191,7 @@ func TestCommands_SetExecutionRequest(t *testing.T) {
 								"https://example.com",
 								time.Second,
 								true,
+								nil,
 							),
 						),
 					),
@@ -238,6 +239,7 @@ func TestCommands_SetExecutionRequest(t *testing.T) {
 								"https://example.com",
 								time.Second,
 								true,
+								nil,
 							),
 						),
 					),
@@ -285,6 +287,7 @@ func TestCommands_SetExecutionRequest(t *testing.T) {
 								"https://example.com",
 								time.Second,
 								true,
+								nil,
 							),
 						),
 					),
@@ -704,6 +707,7 @@ func TestCommands_SetExecutionResponse(t *testing.T) {
 							"https://example.com",
 							time.Second,
 							true,
+							nil,
 						),
 					),
 					expectPushFailed(
@@ -797,6 +801,7 @@ func TestCommands_SetExecutionResponse(t *testing.T) {
 								"https://example.com",
 								time.Second,
 								true,
+								nil,
 							),
 						),
 					),
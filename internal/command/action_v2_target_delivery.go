@@ -0,0 +1,100 @@
+package command
+
+import (
+	"context"
+
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/repository/target"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+// TargetDeliveryFailed dead-letters a delivery to a target after all retries failed.
+// If no deliveryID is passed, a new dead letter is created, otherwise the existing one is marked as failed again.
+func (c *Commands) TargetDeliveryFailed(ctx context.Context, targetID, deliveryID, resourceOwner string, delivery target.Delivery, attempts uint16, reason string) (_ string, err error) {
+	if targetID == "" || resourceOwner == "" {
+		return "", zerrors.ThrowInvalidArgument(nil, "COMMAND-0c9lbkw6yq", "Errors.IDMissing")
+	}
+	if deliveryID == "" {
+		deliveryID, err = c.idGenerator.Next()
+		if err != nil {
+			return "", err
+		}
+	}
+	existing, err := c.getTargetWriteModelByID(ctx, targetID, resourceOwner)
+	if err != nil {
+		return "", err
+	}
+	if !existing.State.Exists() {
+		return "", zerrors.ThrowNotFound(nil, "COMMAND-3s4dgi5hzt", "Errors.Target.NotFound")
+	}
+	_, err = c.eventstore.Push(ctx, target.NewDeliveryFailedEvent(
+		ctx,
+		TargetAggregateFromWriteModel(&existing.WriteModel),
+		deliveryID,
+		delivery,
+		attempts,
+		reason,
+	))
+	if err != nil {
+		return "", err
+	}
+	return deliveryID, nil
+}
+
+// ReplayTargetDelivery requests a new delivery of a dead-lettered event to the target.
+func (c *Commands) ReplayTargetDelivery(ctx context.Context, targetID, deliveryID, resourceOwner string) (*domain.ObjectDetails, error) {
+	if targetID == "" || deliveryID == "" || resourceOwner == "" {
+		return nil, zerrors.ThrowInvalidArgument(nil, "COMMAND-w6xq1m5kpa", "Errors.IDMissing")
+	}
+	existing, err := c.getTargetDeliveryWriteModelByID(ctx, targetID, deliveryID, resourceOwner)
+	if err != nil {
+		return nil, err
+	}
+	if existing.State != domain.TargetDeliveryStateFailed {
+		return nil, zerrors.ThrowPreconditionFailed(nil, "COMMAND-7uqp2r0hde", "Errors.Target.Delivery.NotFailed")
+	}
+	if err := c.pushAppendAndReduce(ctx,
+		existing,
+		target.NewDeliveryReplayedEvent(ctx,
+			TargetAggregateFromWriteModel(&existing.WriteModel),
+			deliveryID,
+			existing.Delivery,
+		),
+	); err != nil {
+		return nil, err
+	}
+	return writeModelToObjectDetails(&existing.WriteModel), nil
+}
+
+// TargetDeliverySucceeded marks a replayed delivery as accepted by the target.
+func (c *Commands) TargetDeliverySucceeded(ctx context.Context, targetID, deliveryID, resourceOwner string) error {
+	if targetID == "" || deliveryID == "" || resourceOwner == "" {
+		return zerrors.ThrowInvalidArgument(nil, "COMMAND-k2v5cxhq8n", "Errors.IDMissing")
+	}
+	existing, err := c.getTargetDeliveryWriteModelByID(ctx, targetID, deliveryID, resourceOwner)
+	if err != nil {
+		return err
+	}
+	if existing.State != domain.TargetDeliveryStateReplaying {
+		return nil
+	}
+	return c.pushAppendAndReduce(ctx,
+		existing,
+		target.NewDeliverySucceededEvent(ctx,
+			TargetAggregateFromWriteModel(&existing.WriteModel),
+			deliveryID,
+		),
+	)
+}
+
+func (c *Commands) getTargetDeliveryWriteModelByID(ctx context.Context, targetID, deliveryID, resourceOwner string) (*TargetDeliveryWriteModel, error) {
+	wm := NewTargetDeliveryWriteModel(targetID, deliveryID, resourceOwner)
+	err := c.eventstore.FilterToQueryReducer(ctx, wm)
+	if err != nil {
+		return nil, err
+	}
+	if wm.State == domain.TargetDeliveryStateUnspecified {
+		return nil, zerrors.ThrowNotFound(nil, "COMMAND-f8o1zjw3se", "Errors.Target.Delivery.NotFound")
+	}
+	return wm, nil
+}
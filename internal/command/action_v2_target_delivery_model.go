@@ -0,0 +1,81 @@
+package command
+
+import (
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/repository/target"
+)
+
+type TargetDeliveryWriteModel struct {
+	eventstore.WriteModel
+
+	DeliveryID string
+	Delivery   target.Delivery
+	Attempts   uint16
+
+	State domain.TargetDeliveryState
+}
+
+func NewTargetDeliveryWriteModel(targetID, deliveryID, resourceOwner string) *TargetDeliveryWriteModel {
+	return &TargetDeliveryWriteModel{
+		WriteModel: eventstore.WriteModel{
+			AggregateID:   targetID,
+			ResourceOwner: resourceOwner,
+			InstanceID:    resourceOwner,
+		},
+		DeliveryID: deliveryID,
+	}
+}
+
+func (wm *TargetDeliveryWriteModel) AppendEvents(events ...eventstore.Event) {
+	for _, event := range events {
+		switch e := event.(type) {
+		case *target.DeliveryFailedEvent:
+			if e.DeliveryID != wm.DeliveryID {
+				continue
+			}
+		case *target.DeliveryReplayedEvent:
+			if e.DeliveryID != wm.DeliveryID {
+				continue
+			}
+		case *target.DeliverySucceededEvent:
+			if e.DeliveryID != wm.DeliveryID {
+				continue
+			}
+		}
+		wm.WriteModel.AppendEvents(event)
+	}
+}
+
+func (wm *TargetDeliveryWriteModel) Reduce() error {
+	for _, event := range wm.Events {
+		switch e := event.(type) {
+		case *target.DeliveryFailedEvent:
+			wm.Delivery = e.Delivery
+			wm.Attempts += e.Attempts
+			wm.State = domain.TargetDeliveryStateFailed
+		case *target.DeliveryReplayedEvent:
+			wm.State = domain.TargetDeliveryStateReplaying
+		case *target.DeliverySucceededEvent:
+			wm.State = domain.TargetDeliveryStateSucceeded
+		case *target.RemovedEvent:
+			wm.State = domain.TargetDeliveryStateUnspecified
+		}
+	}
+	return wm.WriteModel.Reduce()
+}
+
+func (wm *TargetDeliveryWriteModel) Query() *eventstore.SearchQueryBuilder {
+	return eventstore.NewSearchQueryBuilder(eventstore.ColumnsEvent).
+		ResourceOwner(wm.ResourceOwner).
+		AddQuery().
+		AggregateTypes(target.AggregateType).
+		AggregateIDs(wm.AggregateID).
+		EventTypes(
+			target.DeliveryFailedEventType,
+			target.DeliveryReplayedEventType,
+			target.DeliverySucceededEventType,
+			target.RemovedEventType,
+		).
+		Builder()
+}
@@ -0,0 +1,329 @@
+package command
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/id"
+	"github.com/zitadel/zitadel/internal/id/mock"
+	"github.com/zitadel/zitadel/internal/repository/target"
+	"github.com/zitadel/zitadel/internal/repository/user"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+func targetDelivery() target.Delivery {
+	return target.Delivery{
+		ExecutionID:   "event/user.human.added",
+		AggregateType: user.AggregateType,
+		AggregateID:   "user1",
+		EventType:     user.HumanAddedType,
+		EventSequence: 1,
+		Body:          []byte(`{"userName":"username"}`),
+	}
+}
+
+func targetDeliveryFailedEvent(aggID, resourceOwner, deliveryID string) *target.DeliveryFailedEvent {
+	return target.NewDeliveryFailedEvent(context.Background(),
+		target.NewAggregate(aggID, resourceOwner),
+		deliveryID,
+		targetDelivery(),
+		5,
+		"Errors.Execution.Failed",
+	)
+}
+
+func TestCommands_TargetDeliveryFailed(t *testing.T) {
+	type fields struct {
+		eventstore  func(t *testing.T) *eventstore.Eventstore
+		idGenerator id.Generator
+	}
+	type args struct {
+		ctx           context.Context
+		targetID      string
+		deliveryID    string
+		resourceOwner string
+	}
+	type res struct {
+		deliveryID string
+		err        func(error) bool
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		args   args
+		res    res
+	}{
+		{
+			"no resourceowner, error",
+			fields{
+				eventstore: expectEventstore(),
+			},
+			args{
+				ctx:      context.Background(),
+				targetID: "id1",
+			},
+			res{
+				err: zerrors.IsErrorInvalidArgument,
+			},
+		},
+		{
+			"target not found, error",
+			fields{
+				eventstore:  expectEventstore(expectFilter()),
+				idGenerator: mock.ExpectID(t, "delivery1"),
+			},
+			args{
+				ctx:           context.Background(),
+				targetID:      "id1",
+				resourceOwner: "instance",
+			},
+			res{
+				err: zerrors.IsNotFound,
+			},
+		},
+		{
+			"new dead letter, ok",
+			fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(
+							targetAddEvent("id1", "instance"),
+						),
+					),
+					expectPush(
+						targetDeliveryFailedEvent("id1", "instance", "delivery1"),
+					),
+				),
+				idGenerator: mock.ExpectID(t, "delivery1"),
+			},
+			args{
+				ctx:           context.Background(),
+				targetID:      "id1",
+				resourceOwner: "instance",
+			},
+			res{
+				deliveryID: "delivery1",
+			},
+		},
+		{
+			"existing dead letter, ok",
+			fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(
+							targetAddEvent("id1", "instance"),
+						),
+					),
+					expectPush(
+						targetDeliveryFailedEvent("id1", "instance", "delivery1"),
+					),
+				),
+			},
+			args{
+				ctx:           context.Background(),
+				targetID:      "id1",
+				deliveryID:    "delivery1",
+				resourceOwner: "instance",
+			},
+			res{
+				deliveryID: "delivery1",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &Commands{
+				eventstore:  tt.fields.eventstore(t),
+				idGenerator: tt.fields.idGenerator,
+			}
+			deliveryID, err := c.TargetDeliveryFailed(tt.args.ctx, tt.args.targetID, tt.args.deliveryID, tt.args.resourceOwner, targetDelivery(), 5, "Errors.Execution.Failed")
+			if tt.res.err == nil {
+				assert.NoError(t, err)
+			}
+			if tt.res.err != nil && !tt.res.err(err) {
+				t.Errorf("got wrong err: %v ", err)
+			}
+			if tt.res.err == nil {
+				assert.Equal(t, tt.res.deliveryID, deliveryID)
+			}
+		})
+	}
+}
+
+func TestCommands_ReplayTargetDelivery(t *testing.T) {
+	type fields struct {
+		eventstore func(t *testing.T) *eventstore.Eventstore
+	}
+	type args struct {
+		ctx           context.Context
+		targetID      string
+		deliveryID    string
+		resourceOwner string
+	}
+	type res struct {
+		details *domain.ObjectDetails
+		err     func(error) bool
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		args   args
+		res    res
+	}{
+		{
+			"delivery id missing, error",
+			fields{
+				eventstore: expectEventstore(),
+			},
+			args{
+				ctx:           context.Background(),
+				targetID:      "id1",
+				resourceOwner: "instance",
+			},
+			res{
+				err: zerrors.IsErrorInvalidArgument,
+			},
+		},
+		{
+			"not found, error",
+			fields{
+				eventstore: expectEventstore(
+					expectFilter(),
+				),
+			},
+			args{
+				ctx:           context.Background(),
+				targetID:      "id1",
+				deliveryID:    "delivery1",
+				resourceOwner: "instance",
+			},
+			res{
+				err: zerrors.IsNotFound,
+			},
+		},
+		{
+			"other delivery, not found",
+			fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(
+							targetDeliveryFailedEvent("id1", "instance", "delivery2"),
+						),
+					),
+				),
+			},
+			args{
+				ctx:           context.Background(),
+				targetID:      "id1",
+				deliveryID:    "delivery1",
+				resourceOwner: "instance",
+			},
+			res{
+				err: zerrors.IsNotFound,
+			},
+		},
+		{
+			"already replaying, precondition error",
+			fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(
+							targetDeliveryFailedEvent("id1", "instance", "delivery1"),
+						),
+						eventFromEventPusher(
+							target.NewDeliveryReplayedEvent(context.Background(),
+								target.NewAggregate("id1", "instance"),
+								"delivery1",
+								targetDelivery(),
+							),
+						),
+					),
+				),
+			},
+			args{
+				ctx:           context.Background(),
+				targetID:      "id1",
+				deliveryID:    "delivery1",
+				resourceOwner: "instance",
+			},
+			res{
+				err: zerrors.IsPreconditionFailed,
+			},
+		},
+		{
+			"target removed, not found",
+			fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(
+							targetDeliveryFailedEvent("id1", "instance", "delivery1"),
+						),
+						eventFromEventPusher(
+							targetRemoveEvent("id1", "instance"),
+						),
+					),
+				),
+			},
+			args{
+				ctx:           context.Background(),
+				targetID:      "id1",
+				deliveryID:    "delivery1",
+				resourceOwner: "instance",
+			},
+			res{
+				err: zerrors.IsNotFound,
+			},
+		},
+		{
+			"replay, ok",
+			fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(
+							targetDeliveryFailedEvent("id1", "instance", "delivery1"),
+						),
+					),
+					expectPush(
+						target.NewDeliveryReplayedEvent(context.Background(),
+							target.NewAggregate("id1", "instance"),
+							"delivery1",
+							targetDelivery(),
+						),
+					),
+				),
+			},
+			args{
+				ctx:           context.Background(),
+				targetID:      "id1",
+				deliveryID:    "delivery1",
+				resourceOwner: "instance",
+			},
+			res{
+				details: &domain.ObjectDetails{
+					ResourceOwner: "instance",
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &Commands{
+				eventstore: tt.fields.eventstore(t),
+			}
+			details, err := c.ReplayTargetDelivery(tt.args.ctx, tt.args.targetID, tt.args.deliveryID, tt.args.resourceOwner)
+			if tt.res.err == nil {
+				assert.NoError(t, err)
+			}
+			if tt.res.err != nil && !tt.res.err(err) {
+				t.Errorf("got wrong err: %v ", err)
+			}
+			if tt.res.err == nil {
+				assert.Equal(t, tt.res.details, details)
+			}
+		})
+	}
+}
@@ -8,6 +8,7 @@ import (
 	"github.com/muhlemmer/gu"
 	"github.com/stretchr/testify/assert"
 
+	"github.com/zitadel/zitadel/internal/crypto"
 	"github.com/zitadel/zitadel/internal/domain"
 	"github.com/zitadel/zitadel/internal/eventstore"
 	"github.com/zitadel/zitadel/internal/eventstore/v1/models"
@@ -28,9 +29,10 @@ func TestCommands_AddTarget(t *testing.T) {
 		resourceOwner string
 	}
 	type res struct {
-		id      string
-		details *domain.ObjectDetails
-		err     func(error) bool
+		id         string
+		details    *domain.ObjectDetails
+		signingKey string
+		err        func(error) bool
 	}
 	tests := []struct {
 		name   string
@@ -132,6 +134,12 @@ func TestCommands_AddTarget(t *testing.T) {
 							"https://example.com",
 							time.Second,
 							false,
+							&crypto.CryptoValue{
+								CryptoType: crypto.TypeEncryption,
+								Algorithm:  "enc",
+								KeyID:      "id",
+								Crypted:    []byte("12345678"),
+							},
 						),
 					),
 				),
@@ -203,6 +211,7 @@ func TestCommands_AddTarget(t *testing.T) {
 				details: &domain.ObjectDetails{
 					ResourceOwner: "instance",
 				},
+				signingKey: "12345678",
 			},
 		},
 		{
@@ -236,14 +245,17 @@ func TestCommands_AddTarget(t *testing.T) {
 				details: &domain.ObjectDetails{
 					ResourceOwner: "instance",
 				},
+				signingKey: "12345678",
 			},
 		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			c := &Commands{
-				eventstore:  tt.fields.eventstore(t),
-				idGenerator: tt.fields.idGenerator,
+				eventstore:                  tt.fields.eventstore(t),
+				idGenerator:                 tt.fields.idGenerator,
+				newEncryptedCodeWithDefault: mockEncryptedCodeWithDefault("12345678", time.Hour),
+				defaultSecretGenerators:     &SecretGenerators{},
 			}
 			details, err := c.AddTarget(tt.args.ctx, tt.args.add, tt.args.resourceOwner)
 			if tt.res.err == nil {
@@ -255,6 +267,7 @@ func TestCommands_AddTarget(t *testing.T) {
 			if tt.res.err == nil {
 				assert.Equal(t, tt.res.id, tt.args.add.AggregateID)
 				assert.Equal(t, tt.res.details, details)
+				assert.Equal(t, tt.res.signingKey, tt.args.add.SigningKey)
 			}
 		})
 	}
@@ -270,8 +283,9 @@ func TestCommands_ChangeTarget(t *testing.T) {
 		resourceOwner string
 	}
 	type res struct {
-		details *domain.ObjectDetails
-		err     func(error) bool
+		details    *domain.ObjectDetails
+		signingKey *string
+		err        func(error) bool
 	}
 	tests := []struct {
 		name   string
@@ -531,11 +545,54 @@ func TestCommands_ChangeTarget(t *testing.T) {
 				},
 			},
 		},
+		{
+			"push signing key ok",
+			fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(
+							targetAddEvent("id1", "instance"),
+						),
+					),
+					expectPush(
+						target.NewChangedEvent(context.Background(),
+							target.NewAggregate("id1", "instance"),
+							[]target.Changes{
+								target.ChangeSigningKey(&crypto.CryptoValue{
+									CryptoType: crypto.TypeEncryption,
+									Algorithm:  "enc",
+									KeyID:      "id",
+									Crypted:    []byte("87654321"),
+								}),
+							},
+						),
+					),
+				),
+			},
+			args{
+				ctx: context.Background(),
+				change: &ChangeTarget{
+					ObjectRoot: models.ObjectRoot{
+						AggregateID: "id1",
+					},
+					ExpirationSigningKey: true,
+				},
+				resourceOwner: "instance",
+			},
+			res{
+				details: &domain.ObjectDetails{
+					ResourceOwner: "instance",
+				},
+				signingKey: gu.Ptr("87654321"),
+			},
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			c := &Commands{
-				eventstore: tt.fields.eventstore(t),
+				eventstore:                  tt.fields.eventstore(t),
+				newEncryptedCodeWithDefault: mockEncryptedCodeWithDefault("87654321", time.Hour),
+				defaultSecretGenerators:     &SecretGenerators{},
 			}
 			details, err := c.ChangeTarget(tt.args.ctx, tt.args.change, tt.args.resourceOwner)
 			if tt.res.err == nil {
@@ -546,6 +603,7 @@ func TestCommands_ChangeTarget(t *testing.T) {
 			}
 			if tt.res.err == nil {
 				assert.Equal(t, tt.res.details, details)
+				assert.Equal(t, tt.res.signingKey, tt.args.change.SigningKey)
 			}
 		})
 	}
@@ -0,0 +1,131 @@
+package command
+
+import (
+	"context"
+
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/telemetry/tracing"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+type BatchMode int32
+
+const (
+	// BatchModeAtomic pushes the commands of all items in one transaction,
+	// if one item fails no item is executed
+	BatchModeAtomic BatchMode = iota
+	// BatchModeBestEffort executes all items which don't fail
+	BatchModeBestEffort
+)
+
+// BatchItem prepares the commands of an item of a batch
+type BatchItem func(ctx context.Context) ([]eventstore.Command, error)
+
+// BatchResult is the result of an item of a batch, either Details or Err is set
+type BatchResult struct {
+	// ID is the id of the aggregate of the last pushed event of the item
+	ID      string
+	Details *domain.ObjectDetails
+	Err     error
+}
+
+// AddUserGrantBatchItem prepares the creation of a user grant as part of a batch
+func (c *Commands) AddUserGrantBatchItem(userGrant *domain.UserGrant, resourceOwner string) BatchItem {
+	return func(ctx context.Context) ([]eventstore.Command, error) {
+		cmd, _, err := c.addUserGrant(ctx, userGrant, resourceOwner)
+		if err != nil {
+			return nil, err
+		}
+		return []eventstore.Command{cmd}, nil
+	}
+}
+
+// ChangeUserGrantBatchItem prepares the change of a user grant as part of a batch
+func (c *Commands) ChangeUserGrantBatchItem(userGrant *domain.UserGrant, resourceOwner string) BatchItem {
+	return func(ctx context.Context) ([]eventstore.Command, error) {
+		cmd, _, err := c.changeUserGrant(ctx, userGrant, resourceOwner, false)
+		if err != nil {
+			return nil, err
+		}
+		return []eventstore.Command{cmd}, nil
+	}
+}
+
+// ExecuteBatch executes the items in a single push if possible.
+// A result is returned for each item in the order of the items.
+// In [BatchModeAtomic] the items which didn't fail get an aborted error if another item failed.
+// In [BatchModeBestEffort] the items are pushed one by one if the single push failed,
+// so the failing items are identified.
+func (c *Commands) ExecuteBatch(ctx context.Context, mode BatchMode, items []BatchItem) (_ []*BatchResult, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	if len(items) == 0 {
+		return nil, zerrors.ThrowInvalidArgument(nil, "COMMAND-Bq4nx8vk2m", "Errors.Batch.Empty")
+	}
+
+	results := make([]*BatchResult, len(items))
+	prepared := make([][]eventstore.Command, len(items))
+	var failed bool
+	for i, item := range items {
+		results[i] = new(BatchResult)
+		prepared[i], results[i].Err = item(ctx)
+		failed = failed || results[i].Err != nil
+	}
+	if failed && mode == BatchModeAtomic {
+		for _, result := range results {
+			if result.Err == nil {
+				result.Err = zerrors.ThrowPreconditionFailed(nil, "COMMAND-Bv7mq3nx9k", "Errors.Batch.Aborted")
+			}
+		}
+		return results, nil
+	}
+
+	cmds := make([]eventstore.Command, 0, len(items))
+	for i, itemCmds := range prepared {
+		if results[i].Err == nil {
+			cmds = append(cmds, itemCmds...)
+		}
+	}
+	if len(cmds) == 0 {
+		return results, nil
+	}
+	pushedEvents, err := c.eventstore.Push(ctx, cmds...)
+	if err == nil {
+		setBatchResults(results, prepared, pushedEvents)
+		return results, nil
+	}
+	if mode == BatchModeAtomic {
+		return nil, err
+	}
+
+	// the failing items can't be identified from a single push
+	for i, itemCmds := range prepared {
+		if results[i].Err != nil || len(itemCmds) == 0 {
+			continue
+		}
+		pushedEvents, results[i].Err = c.eventstore.Push(ctx, itemCmds...)
+		if results[i].Err == nil {
+			setBatchResult(results[i], pushedEvents)
+		}
+	}
+	return results, nil
+}
+
+// setBatchResults assigns the events pushed in a single push to the items they were prepared by
+func setBatchResults(results []*BatchResult, prepared [][]eventstore.Command, pushedEvents []eventstore.Event) {
+	var offset int
+	for i, itemCmds := range prepared {
+		if results[i].Err != nil || len(itemCmds) == 0 {
+			continue
+		}
+		setBatchResult(results[i], pushedEvents[offset:offset+len(itemCmds)])
+		offset += len(itemCmds)
+	}
+}
+
+func setBatchResult(result *BatchResult, pushedEvents []eventstore.Event) {
+	result.ID = pushedEvents[len(pushedEvents)-1].Aggregate().ID
+	result.Details = pushedEventsToObjectDetails(pushedEvents)
+}
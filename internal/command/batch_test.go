@@ -0,0 +1,144 @@
+package command
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/repository/usergrant"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+func TestCommands_ExecuteBatch(t *testing.T) {
+	grantAdded := func(id string) eventstore.Command {
+		return usergrant.NewUserGrantAddedEvent(context.Background(),
+			&usergrant.NewAggregate(id, "org1").Aggregate,
+			"user1",
+			"project1",
+			"", []string{"rolekey1"},
+		)
+	}
+	item := func(cmds ...eventstore.Command) BatchItem {
+		return func(context.Context) ([]eventstore.Command, error) {
+			return cmds, nil
+		}
+	}
+	failingItem := func(context.Context) ([]eventstore.Command, error) {
+		return nil, zerrors.ThrowNotFound(nil, "test", "not found")
+	}
+	type args struct {
+		mode  BatchMode
+		items []BatchItem
+	}
+	type res struct {
+		ids  []string
+		errs []func(error) bool
+		err  func(error) bool
+	}
+	tests := []struct {
+		name       string
+		eventstore func(*testing.T) *eventstore.Eventstore
+		args       args
+		res        res
+	}{
+		{
+			name:       "no items, invalid argument",
+			eventstore: expectEventstore(),
+			args:       args{mode: BatchModeAtomic},
+			res:        res{err: zerrors.IsErrorInvalidArgument},
+		},
+		{
+			name: "atomic, single push",
+			eventstore: expectEventstore(
+				expectPush(grantAdded("grant1"), grantAdded("grant2")),
+			),
+			args: args{
+				mode:  BatchModeAtomic,
+				items: []BatchItem{item(grantAdded("grant1")), item(grantAdded("grant2"))},
+			},
+			res: res{
+				ids:  []string{"grant1", "grant2"},
+				errs: []func(error) bool{nil, nil},
+			},
+		},
+		{
+			name:       "atomic, item fails, nothing pushed",
+			eventstore: expectEventstore(),
+			args: args{
+				mode:  BatchModeAtomic,
+				items: []BatchItem{item(grantAdded("grant1")), failingItem},
+			},
+			res: res{
+				ids:  []string{"", ""},
+				errs: []func(error) bool{zerrors.IsPreconditionFailed, zerrors.IsNotFound},
+			},
+		},
+		{
+			name: "atomic, push fails, error",
+			eventstore: expectEventstore(
+				expectPushFailed(zerrors.ThrowAlreadyExists(nil, "test", "exists"), grantAdded("grant1"), grantAdded("grant2")),
+			),
+			args: args{
+				mode:  BatchModeAtomic,
+				items: []BatchItem{item(grantAdded("grant1")), item(grantAdded("grant2"))},
+			},
+			res: res{err: zerrors.IsErrorAlreadyExists},
+		},
+		{
+			name: "best effort, failed item skipped",
+			eventstore: expectEventstore(
+				expectPush(grantAdded("grant1"), grantAdded("grant3")),
+			),
+			args: args{
+				mode:  BatchModeBestEffort,
+				items: []BatchItem{item(grantAdded("grant1")), failingItem, item(grantAdded("grant3"))},
+			},
+			res: res{
+				ids:  []string{"grant1", "", "grant3"},
+				errs: []func(error) bool{nil, zerrors.IsNotFound, nil},
+			},
+		},
+		{
+			name: "best effort, push fails, items pushed one by one",
+			eventstore: expectEventstore(
+				expectPushFailed(zerrors.ThrowAlreadyExists(nil, "test", "exists"), grantAdded("grant1"), grantAdded("grant2")),
+				expectPush(grantAdded("grant1")),
+				expectPushFailed(zerrors.ThrowAlreadyExists(nil, "test", "exists"), grantAdded("grant2")),
+			),
+			args: args{
+				mode:  BatchModeBestEffort,
+				items: []BatchItem{item(grantAdded("grant1")), item(grantAdded("grant2"))},
+			},
+			res: res{
+				ids:  []string{"grant1", ""},
+				errs: []func(error) bool{nil, zerrors.IsErrorAlreadyExists},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &Commands{
+				eventstore: tt.eventstore(t),
+			}
+			results, err := c.ExecuteBatch(context.Background(), tt.args.mode, tt.args.items)
+			if tt.res.err != nil {
+				assert.True(t, tt.res.err(err), "unexpected error: %v", err)
+				return
+			}
+			require.NoError(t, err)
+			require.Len(t, results, len(tt.res.ids))
+			for i, result := range results {
+				assert.Equal(t, tt.res.ids[i], result.ID)
+				if tt.res.errs[i] == nil {
+					assert.NoError(t, result.Err)
+					assert.NotNil(t, result.Details)
+					continue
+				}
+				assert.True(t, tt.res.errs[i](result.Err), "unexpected error: %v", result.Err)
+			}
+		})
+	}
+}
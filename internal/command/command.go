@@ -22,7 +22,10 @@ import (
 	"github.com/zitadel/zitadel/internal/crypto"
 	"github.com/zitadel/zitadel/internal/domain"
 	"github.com/zitadel/zitadel/internal/eventstore"
+	exec "github.com/zitadel/zitadel/internal/execution"
+	"github.com/zitadel/zitadel/internal/geoip"
 	"github.com/zitadel/zitadel/internal/id"
+	"github.com/zitadel/zitadel/internal/passwordbreach"
 	"github.com/zitadel/zitadel/internal/static"
 	"github.com/zitadel/zitadel/internal/telemetry/tracing"
 	webauthn_helper "github.com/zitadel/zitadel/internal/webauthn"
@@ -51,13 +54,20 @@ type Commands struct {
 	smtpEncryption                  crypto.EncryptionAlgorithm
 	smsEncryption                   crypto.EncryptionAlgorithm
 	userEncryption                  crypto.EncryptionAlgorithm
+	targetEncryption                crypto.EncryptionAlgorithm
+	captchaEncryption               crypto.EncryptionAlgorithm
+	instanceKMS                     crypto.KMSProviders
 	userPasswordHasher              *crypto.Hasher
+	passwordBreachChecker           passwordbreach.Checker
+	geoIP                           geoip.Provider
 	secretHasher                    *crypto.Hasher
 	machineKeySize                  int
 	applicationKeySize              int
 	domainVerificationAlg           crypto.EncryptionAlgorithm
 	domainVerificationGenerator     crypto.Generator
 	domainVerificationValidator     func(domain, token, verifier string, checkType api_http.CheckType) error
+	domainVerificationExpiry        time.Duration
+	idpCertificateExpiryWarning     time.Duration
 	sessionTokenCreator             func(sessionID string) (id string, token string, err error)
 	sessionTokenVerifier            func(ctx context.Context, sessionToken, sessionID, tokenID string) (err error)
 	defaultAccessTokenLifetime      time.Duration
@@ -68,6 +78,7 @@ type Commands struct {
 	webauthnConfig          *webauthn_helper.Config
 	keySize                 int
 	keyAlgorithm            crypto.EncryptionAlgorithm
+	signingKeyProvider      crypto.SigningKeyProvider
 	certificateAlgorithm    crypto.EncryptionAlgorithm
 	certKeySize             int
 	privateKeyLifetime      time.Duration
@@ -82,6 +93,8 @@ type Commands struct {
 	ActionFunctionExisting func(function string) bool
 	EventExisting          func(event string) bool
 	EventGroupExisting     func(group string) bool
+	// ExecutionTargets returns the targets of the executions, used to call the targets of function executions
+	ExecutionTargets func(ctx context.Context, ids []string) ([]exec.Target, error)
 
 	GenerateDomain func(instanceName, domain string) (string, error)
 }
@@ -95,7 +108,9 @@ func StartCommands(
 	externalDomain string,
 	externalSecure bool,
 	externalPort uint16,
-	idpConfigEncryption, otpEncryption, smtpEncryption, smsEncryption, userEncryption, domainVerificationEncryption, oidcEncryption, samlEncryption crypto.EncryptionAlgorithm,
+	idpConfigEncryption, otpEncryption, smtpEncryption, smsEncryption, userEncryption, domainVerificationEncryption, oidcEncryption, samlEncryption, targetEncryption, captchaEncryption crypto.EncryptionAlgorithm,
+	instanceKMS crypto.KMSProviders,
+	signingKeyProvider crypto.SigningKeyProvider,
 	httpClient *http.Client,
 	permissionCheck domain.PermissionCheck,
 	sessionTokenVerifier func(ctx context.Context, sessionToken string, sessionID string, tokenID string) (err error),
@@ -119,6 +134,17 @@ func StartCommands(
 	if err != nil {
 		return nil, fmt.Errorf("password hasher: %w", err)
 	}
+	passwordBreachChecker, err := newPasswordBreachChecker(defaults.PasswordBreachCheck, httpClient)
+	if err != nil {
+		return nil, fmt.Errorf("password breach checker: %w", err)
+	}
+	geoIP, err := newGeoIPProvider(defaults.GeoIP, httpClient)
+	if err != nil {
+		return nil, fmt.Errorf("geoip provider: %w", err)
+	}
+	if signingKeyProvider == nil {
+		signingKeyProvider = crypto.NewDatabaseSigningKeyProvider(oidcEncryption)
+	}
 	repo = &Commands{
 		eventstore:                      es,
 		static:                          staticStore,
@@ -136,14 +162,22 @@ func StartCommands(
 		smtpEncryption:                  smtpEncryption,
 		smsEncryption:                   smsEncryption,
 		userEncryption:                  userEncryption,
+		targetEncryption:                targetEncryption,
+		captchaEncryption:               captchaEncryption,
+		instanceKMS:                     instanceKMS,
 		userPasswordHasher:              userPasswordHasher,
+		passwordBreachChecker:           passwordBreachChecker,
+		geoIP:                           geoIP,
 		secretHasher:                    secretHasher,
 		machineKeySize:                  int(defaults.SecretGenerators.MachineKeySize),
 		applicationKeySize:              int(defaults.SecretGenerators.ApplicationKeySize),
 		domainVerificationAlg:           domainVerificationEncryption,
 		domainVerificationGenerator:     crypto.NewEncryptionGenerator(defaults.DomainVerification.VerificationGenerator, domainVerificationEncryption),
 		domainVerificationValidator:     api_http.ValidateDomain,
+		domainVerificationExpiry:        defaults.DomainVerification.VerificationExpiry,
+		idpCertificateExpiryWarning:     defaults.IDPHealthCheck.CertificateExpiryWarning,
 		keyAlgorithm:                    oidcEncryption,
+		signingKeyProvider:              signingKeyProvider,
 		certificateAlgorithm:            samlEncryption,
 		webauthnConfig:                  webAuthN,
 		httpClient:                      httpClient,
@@ -185,6 +219,15 @@ type AppendReducer interface {
 	Reduce() error
 }
 
+// checkExpectedSequence fails if the object was changed after the sequence the caller expects it to be at.
+// The check is skipped if no sequence is expected.
+func checkExpectedSequence(wm *eventstore.WriteModel, expectedSequence uint64) error {
+	if expectedSequence == 0 || wm.ProcessedSequence == expectedSequence {
+		return nil
+	}
+	return zerrors.ThrowPreconditionFailed(nil, "COMMAND-Qx4vn8Tz2k", "Errors.Concurrency.SequenceMismatch")
+}
+
 func (c *Commands) pushAppendAndReduce(ctx context.Context, object AppendReducer, cmds ...eventstore.Command) error {
 	events, err := c.eventstore.Push(ctx, cmds...)
 	if err != nil {
@@ -259,6 +302,26 @@ func samlCertificateAndKeyGenerator(keySize int, lifetime time.Duration) func(id
 	}
 }
 
+// newPasswordBreachChecker uses the bloom filter if configured and the range API otherwise
+func newPasswordBreachChecker(config sd.PasswordBreachCheck, httpClient *http.Client) (passwordbreach.Checker, error) {
+	if config.BloomFilterPath != "" {
+		return passwordbreach.LoadBloomFilter(config.BloomFilterPath)
+	}
+	return passwordbreach.NewRangeChecker(httpClient, config.RangeURL), nil
+}
+
+// newGeoIPProvider uses the file if configured and the lookup service otherwise,
+// without both the network access policies can't restrict countries and autonomous systems
+func newGeoIPProvider(config sd.GeoIP, httpClient *http.Client) (geoip.Provider, error) {
+	if config.FilePath != "" {
+		return geoip.LoadFile(config.FilePath)
+	}
+	if config.LookupURL != "" {
+		return geoip.NewHTTPProvider(httpClient, config.LookupURL), nil
+	}
+	return nil, nil
+}
+
 // Close blocks until all async jobs are finished,
 // the context expires or after eventstore.PushTimeout.
 func (c *Commands) Close(ctx context.Context) error {
@@ -38,6 +38,10 @@ func (c *Commands) createAllLoginTextEvents(ctx context.Context, agg *eventstore
 	events = append(events, c.createPasswordChangeEvents(ctx, agg, existingText, text, defaultText)...)
 	events = append(events, c.createPasswordChangeDoneEvents(ctx, agg, existingText, text, defaultText)...)
 	events = append(events, c.createPasswordResetDoneEvents(ctx, agg, existingText, text, defaultText)...)
+	events = append(events, c.createUsernameRecoveryEvents(ctx, agg, existingText, text, defaultText)...)
+	events = append(events, c.createUsernameRecoveryDoneEvents(ctx, agg, existingText, text, defaultText)...)
+	events = append(events, c.createPasskeyPromptEvents(ctx, agg, existingText, text, defaultText)...)
+	events = append(events, c.createLinkedIDPsEvents(ctx, agg, existingText, text, defaultText)...)
 	events = append(events, c.createRegistrationOptionEvents(ctx, agg, existingText, text, defaultText)...)
 	events = append(events, c.createRegistrationUserEvents(ctx, agg, existingText, text, defaultText)...)
 	events = append(events, c.createExternalRegistrationUserOverviewEvents(ctx, agg, existingText, text, defaultText)...)
@@ -47,6 +51,7 @@ func (c *Commands) createAllLoginTextEvents(ctx context.Context, agg *eventstore
 	events = append(events, c.createExternalUserNotFoundEvents(ctx, agg, existingText, text, defaultText)...)
 	events = append(events, c.createSuccessLoginEvents(ctx, agg, existingText, text, defaultText)...)
 	events = append(events, c.createLogoutDoneEvents(ctx, agg, existingText, text, defaultText)...)
+	events = append(events, c.createLogoutConfirmationEvents(ctx, agg, existingText, text, defaultText)...)
 	events = append(events, c.createFooterTextEvents(ctx, agg, existingText, text, defaultText)...)
 	return events
 }
@@ -134,6 +139,10 @@ func (c *Commands) createLoginTextEvents(ctx context.Context, agg *eventstore.Ag
 	if event != nil {
 		events = append(events, event)
 	}
+	event = c.createCustomLoginTextEvent(ctx, agg, domain.LoginKeyLoginForgotUsernameLinkText, existingText.LoginForgotUsernameLinkText, text.Login.ForgotUsernameLinkText, text.Language, defaultText)
+	if event != nil {
+		events = append(events, event)
+	}
 	return events
 }
 
@@ -155,6 +164,10 @@ func (c *Commands) createPasswordTextEvents(ctx context.Context, agg *eventstore
 	if event != nil {
 		events = append(events, event)
 	}
+	event = c.createCustomLoginTextEvent(ctx, agg, domain.LoginKeyPasswordCaptchaLabel, existingText.PasswordCaptchaLabel, text.Password.CaptchaLabel, text.Language, defaultText)
+	if event != nil {
+		events = append(events, event)
+	}
 	event = c.createCustomLoginTextEvent(ctx, agg, domain.LoginKeyPasswordBackButtonText, existingText.PasswordBackButtonText, text.Password.BackButtonText, text.Language, defaultText)
 	if event != nil {
 		events = append(events, event)
@@ -591,6 +604,10 @@ func (c *Commands) createPasswordlessEvents(ctx context.Context, agg *eventstore
 	if event != nil {
 		events = append(events, event)
 	}
+	event = c.createCustomLoginTextEvent(ctx, agg, domain.LoginKeyPasswordlessCaptchaLabel, existingText.PasswordlessCaptchaLabel, text.Passwordless.CaptchaLabel, text.Language, defaultText)
+	if event != nil {
+		events = append(events, event)
+	}
 	return events
 }
 
@@ -748,6 +765,102 @@ func (c *Commands) createPasswordResetDoneEvents(ctx context.Context, agg *event
 	return events
 }
 
+func (c *Commands) createUsernameRecoveryEvents(ctx context.Context, agg *eventstore.Aggregate, existingText *CustomLoginTextReadModel, text *domain.CustomLoginText, defaultText bool) []eventstore.Command {
+	events := make([]eventstore.Command, 0)
+	event := c.createCustomLoginTextEvent(ctx, agg, domain.LoginKeyUsernameRecoveryTitle, existingText.UsernameRecoveryTitle, text.UsernameRecovery.Title, text.Language, defaultText)
+	if event != nil {
+		events = append(events, event)
+	}
+	event = c.createCustomLoginTextEvent(ctx, agg, domain.LoginKeyUsernameRecoveryDescription, existingText.UsernameRecoveryDescription, text.UsernameRecovery.Description, text.Language, defaultText)
+	if event != nil {
+		events = append(events, event)
+	}
+	event = c.createCustomLoginTextEvent(ctx, agg, domain.LoginKeyUsernameRecoveryEmailOrPhoneLabel, existingText.UsernameRecoveryEmailOrPhoneLabel, text.UsernameRecovery.EmailOrPhoneLabel, text.Language, defaultText)
+	if event != nil {
+		events = append(events, event)
+	}
+	event = c.createCustomLoginTextEvent(ctx, agg, domain.LoginKeyUsernameRecoveryCaptchaLabel, existingText.UsernameRecoveryCaptchaLabel, text.UsernameRecovery.CaptchaLabel, text.Language, defaultText)
+	if event != nil {
+		events = append(events, event)
+	}
+	event = c.createCustomLoginTextEvent(ctx, agg, domain.LoginKeyUsernameRecoveryBackButtonText, existingText.UsernameRecoveryBackButtonText, text.UsernameRecovery.BackButtonText, text.Language, defaultText)
+	if event != nil {
+		events = append(events, event)
+	}
+	event = c.createCustomLoginTextEvent(ctx, agg, domain.LoginKeyUsernameRecoveryNextButtonText, existingText.UsernameRecoveryNextButtonText, text.UsernameRecovery.NextButtonText, text.Language, defaultText)
+	if event != nil {
+		events = append(events, event)
+	}
+	return events
+}
+
+func (c *Commands) createUsernameRecoveryDoneEvents(ctx context.Context, agg *eventstore.Aggregate, existingText *CustomLoginTextReadModel, text *domain.CustomLoginText, defaultText bool) []eventstore.Command {
+	events := make([]eventstore.Command, 0)
+	event := c.createCustomLoginTextEvent(ctx, agg, domain.LoginKeyUsernameRecoveryDoneTitle, existingText.UsernameRecoveryDoneTitle, text.UsernameRecoveryDone.Title, text.Language, defaultText)
+	if event != nil {
+		events = append(events, event)
+	}
+	event = c.createCustomLoginTextEvent(ctx, agg, domain.LoginKeyUsernameRecoveryDoneDescription, existingText.UsernameRecoveryDoneDescription, text.UsernameRecoveryDone.Description, text.Language, defaultText)
+	if event != nil {
+		events = append(events, event)
+	}
+	event = c.createCustomLoginTextEvent(ctx, agg, domain.LoginKeyUsernameRecoveryDoneNextButtonText, existingText.UsernameRecoveryDoneNextButtonText, text.UsernameRecoveryDone.NextButtonText, text.Language, defaultText)
+	if event != nil {
+		events = append(events, event)
+	}
+	return events
+}
+
+func (c *Commands) createPasskeyPromptEvents(ctx context.Context, agg *eventstore.Aggregate, existingText *CustomLoginTextReadModel, text *domain.CustomLoginText, defaultText bool) []eventstore.Command {
+	events := make([]eventstore.Command, 0)
+	event := c.createCustomLoginTextEvent(ctx, agg, domain.LoginKeyPasskeyPromptTitle, existingText.PasskeyPromptTitle, text.PasskeyPrompt.Title, text.Language, defaultText)
+	if event != nil {
+		events = append(events, event)
+	}
+	event = c.createCustomLoginTextEvent(ctx, agg, domain.LoginKeyPasskeyPromptDescription, existingText.PasskeyPromptDescription, text.PasskeyPrompt.Description, text.Language, defaultText)
+	if event != nil {
+		events = append(events, event)
+	}
+	event = c.createCustomLoginTextEvent(ctx, agg, domain.LoginKeyPasskeyPromptRegisterButtonText, existingText.PasskeyPromptRegisterButtonText, text.PasskeyPrompt.RegisterButtonText, text.Language, defaultText)
+	if event != nil {
+		events = append(events, event)
+	}
+	event = c.createCustomLoginTextEvent(ctx, agg, domain.LoginKeyPasskeyPromptSnoozeButtonText, existingText.PasskeyPromptSnoozeButtonText, text.PasskeyPrompt.SnoozeButtonText, text.Language, defaultText)
+	if event != nil {
+		events = append(events, event)
+	}
+	event = c.createCustomLoginTextEvent(ctx, agg, domain.LoginKeyPasskeyPromptOptOutButtonText, existingText.PasskeyPromptOptOutButtonText, text.PasskeyPrompt.OptOutButtonText, text.Language, defaultText)
+	if event != nil {
+		events = append(events, event)
+	}
+	return events
+}
+
+func (c *Commands) createLinkedIDPsEvents(ctx context.Context, agg *eventstore.Aggregate, existingText *CustomLoginTextReadModel, text *domain.CustomLoginText, defaultText bool) []eventstore.Command {
+	events := make([]eventstore.Command, 0)
+	event := c.createCustomLoginTextEvent(ctx, agg, domain.LoginKeyLinkedIDPsTitle, existingText.LinkedIDPsTitle, text.LinkedIDPs.Title, text.Language, defaultText)
+	if event != nil {
+		events = append(events, event)
+	}
+	event = c.createCustomLoginTextEvent(ctx, agg, domain.LoginKeyLinkedIDPsDescription, existingText.LinkedIDPsDescription, text.LinkedIDPs.Description, text.Language, defaultText)
+	if event != nil {
+		events = append(events, event)
+	}
+	event = c.createCustomLoginTextEvent(ctx, agg, domain.LoginKeyLinkedIDPsNoLinksText, existingText.LinkedIDPsNoLinksText, text.LinkedIDPs.NoLinksText, text.Language, defaultText)
+	if event != nil {
+		events = append(events, event)
+	}
+	event = c.createCustomLoginTextEvent(ctx, agg, domain.LoginKeyLinkedIDPsUnlinkButtonText, existingText.LinkedIDPsUnlinkButtonText, text.LinkedIDPs.UnlinkButtonText, text.Language, defaultText)
+	if event != nil {
+		events = append(events, event)
+	}
+	event = c.createCustomLoginTextEvent(ctx, agg, domain.LoginKeyLinkedIDPsNextButtonText, existingText.LinkedIDPsNextButtonText, text.LinkedIDPs.NextButtonText, text.Language, defaultText)
+	if event != nil {
+		events = append(events, event)
+	}
+	return events
+}
+
 func (c *Commands) createRegistrationOptionEvents(ctx context.Context, agg *eventstore.Aggregate, existingText *CustomLoginTextReadModel, text *domain.CustomLoginText, defaultText bool) []eventstore.Command {
 	events := make([]eventstore.Command, 0)
 	event := c.createCustomLoginTextEvent(ctx, agg, domain.LoginKeyRegistrationOptionTitle, existingText.RegistrationOptionTitle, text.RegisterOption.Title, text.Language, defaultText)
@@ -847,6 +960,10 @@ func (c *Commands) createRegistrationUserEvents(ctx context.Context, agg *events
 	if event != nil {
 		events = append(events, event)
 	}
+	event = c.createCustomLoginTextEvent(ctx, agg, domain.LoginKeyRegistrationUserCaptchaLabel, existingText.RegistrationUserCaptchaLabel, text.RegistrationUser.CaptchaLabel, text.Language, defaultText)
+	if event != nil {
+		events = append(events, event)
+	}
 	return events
 }
 
@@ -1105,6 +1222,27 @@ func (c *Commands) createLogoutDoneEvents(ctx context.Context, agg *eventstore.A
 	return events
 }
 
+func (c *Commands) createLogoutConfirmationEvents(ctx context.Context, agg *eventstore.Aggregate, existingText *CustomLoginTextReadModel, text *domain.CustomLoginText, defaultText bool) []eventstore.Command {
+	events := make([]eventstore.Command, 0)
+	event := c.createCustomLoginTextEvent(ctx, agg, domain.LoginKeyLogoutConfirmationTitle, existingText.LogoutConfirmationTitle, text.LogoutConfirmation.Title, text.Language, defaultText)
+	if event != nil {
+		events = append(events, event)
+	}
+	event = c.createCustomLoginTextEvent(ctx, agg, domain.LoginKeyLogoutConfirmationDescription, existingText.LogoutConfirmationDescription, text.LogoutConfirmation.Description, text.Language, defaultText)
+	if event != nil {
+		events = append(events, event)
+	}
+	event = c.createCustomLoginTextEvent(ctx, agg, domain.LoginKeyLogoutConfirmationConfirmButtonText, existingText.LogoutConfirmationConfirmButtonText, text.LogoutConfirmation.ConfirmButtonText, text.Language, defaultText)
+	if event != nil {
+		events = append(events, event)
+	}
+	event = c.createCustomLoginTextEvent(ctx, agg, domain.LoginKeyLogoutConfirmationCancelButtonText, existingText.LogoutConfirmationCancelButtonText, text.LogoutConfirmation.CancelButtonText, text.Language, defaultText)
+	if event != nil {
+		events = append(events, event)
+	}
+	return events
+}
+
 func (c *Commands) createFooterTextEvents(ctx context.Context, agg *eventstore.Aggregate, existingText *CustomLoginTextReadModel, text *domain.CustomLoginText, defaultText bool) []eventstore.Command {
 	events := make([]eventstore.Command, 0)
 	event := c.createCustomLoginTextEvent(ctx, agg, domain.LoginKeyFooterTOS, existingText.FooterTOS, text.Footer.TOS, text.Language, defaultText)
@@ -53,11 +53,13 @@ type CustomLoginTextReadModel struct {
 	LoginNextButtonText            string
 	LoginExternalUserDescription   string
 	LoginUserMustBeMemberOfOrg     string
+	LoginForgotUsernameLinkText    string
 
 	PasswordTitle          string
 	PasswordDescription    string
 	PasswordLabel          string
 	PasswordResetLinkText  string
+	PasswordCaptchaLabel   string
 	PasswordBackButtonText string
 	PasswordNextButtonText string
 	PasswordMinLength      string
@@ -163,6 +165,7 @@ type CustomLoginTextReadModel struct {
 	PasswordlessValidateTokenButtonText string
 	PasswordlessNotSupported            string
 	PasswordlessErrorRetry              string
+	PasswordlessCaptchaLabel            string
 
 	PasswordlessPromptTitle                  string
 	PasswordlessPromptDescription            string
@@ -201,6 +204,29 @@ type CustomLoginTextReadModel struct {
 	PasswordResetDoneDescription    string
 	PasswordResetDoneNextButtonText string
 
+	UsernameRecoveryTitle             string
+	UsernameRecoveryDescription       string
+	UsernameRecoveryEmailOrPhoneLabel string
+	UsernameRecoveryCaptchaLabel      string
+	UsernameRecoveryBackButtonText    string
+	UsernameRecoveryNextButtonText    string
+
+	UsernameRecoveryDoneTitle          string
+	UsernameRecoveryDoneDescription    string
+	UsernameRecoveryDoneNextButtonText string
+
+	PasskeyPromptTitle              string
+	PasskeyPromptDescription        string
+	PasskeyPromptRegisterButtonText string
+	PasskeyPromptSnoozeButtonText   string
+	PasskeyPromptOptOutButtonText   string
+
+	LinkedIDPsTitle            string
+	LinkedIDPsDescription      string
+	LinkedIDPsNoLinksText      string
+	LinkedIDPsUnlinkButtonText string
+	LinkedIDPsNextButtonText   string
+
 	RegistrationOptionTitle                    string
 	RegistrationOptionDescription              string
 	RegistrationOptionUserNameButtonText       string
@@ -227,6 +253,7 @@ type CustomLoginTextReadModel struct {
 	RegistrationUserPrivacyLinkText        string
 	RegistrationUserNextButtonText         string
 	RegistrationUserBackButtonText         string
+	RegistrationUserCaptchaLabel           string
 
 	ExternalRegistrationUserOverviewTitle              string
 	ExternalRegistrationUserOverviewDescription        string
@@ -292,6 +319,11 @@ type CustomLoginTextReadModel struct {
 	LogoutDoneDescription     string
 	LogoutDoneLoginButtonText string
 
+	LogoutConfirmationTitle             string
+	LogoutConfirmationDescription       string
+	LogoutConfirmationConfirmButtonText string
+	LogoutConfirmationCancelButtonText  string
+
 	FooterTOS           string
 	FooterPrivacyPolicy string
 	FooterHelp          string
@@ -406,6 +438,22 @@ func (wm *CustomLoginTextReadModel) Reduce() error {
 				wm.handlePasswordResetDoneScreenSetEvent(e)
 				continue
 			}
+			if strings.HasPrefix(e.Key, domain.LoginKeyUsernameRecovery) {
+				wm.handleUsernameRecoveryScreenSetEvent(e)
+				continue
+			}
+			if strings.HasPrefix(e.Key, domain.LoginKeyUsernameRecoveryDone) {
+				wm.handleUsernameRecoveryDoneScreenSetEvent(e)
+				continue
+			}
+			if strings.HasPrefix(e.Key, domain.LoginKeyPasskeyPrompt) {
+				wm.handlePasskeyPromptScreenSetEvent(e)
+				continue
+			}
+			if strings.HasPrefix(e.Key, domain.LoginKeyLinkedIDPs) {
+				wm.handleLinkedIDPsScreenSetEvent(e)
+				continue
+			}
 			if strings.HasPrefix(e.Key, domain.LoginKeyRegistrationOption) {
 				wm.handleRegistrationOptionScreenSetEvent(e)
 				continue
@@ -442,6 +490,10 @@ func (wm *CustomLoginTextReadModel) Reduce() error {
 				wm.handleLogoutDoneScreenSetEvent(e)
 				continue
 			}
+			if strings.HasPrefix(e.Key, domain.LoginKeyLogoutConfirmation) {
+				wm.handleLogoutConfirmationScreenSetEvent(e)
+				continue
+			}
 			if strings.HasPrefix(e.Key, domain.LoginKeyFooter) {
 				wm.handleFooterTextSetEvent(e)
 				continue
@@ -550,6 +602,22 @@ func (wm *CustomLoginTextReadModel) Reduce() error {
 				wm.handlePasswordResetDoneScreenRemoveEvent(e)
 				continue
 			}
+			if strings.HasPrefix(e.Key, domain.LoginKeyUsernameRecovery) {
+				wm.handleUsernameRecoveryScreenRemoveEvent(e)
+				continue
+			}
+			if strings.HasPrefix(e.Key, domain.LoginKeyUsernameRecoveryDone) {
+				wm.handleUsernameRecoveryDoneScreenRemoveEvent(e)
+				continue
+			}
+			if strings.HasPrefix(e.Key, domain.LoginKeyPasskeyPrompt) {
+				wm.handlePasskeyPromptScreenRemoveEvent(e)
+				continue
+			}
+			if strings.HasPrefix(e.Key, domain.LoginKeyLinkedIDPs) {
+				wm.handleLinkedIDPsScreenRemoveEvent(e)
+				continue
+			}
 			if strings.HasPrefix(e.Key, domain.LoginKeyRegistrationOption) {
 				wm.handleRegistrationOptionScreenRemoveEvent(e)
 				continue
@@ -586,6 +654,10 @@ func (wm *CustomLoginTextReadModel) Reduce() error {
 				wm.handleLogoutDoneScreenRemoveEvent(e)
 				continue
 			}
+			if strings.HasPrefix(e.Key, domain.LoginKeyLogoutConfirmation) {
+				wm.handleLogoutConfirmationScreenRemoveEvent(e)
+				continue
+			}
 			if strings.HasPrefix(e.Key, domain.LoginKeyFooter) {
 				wm.handleFooterTextRemoveEvent(e)
 				continue
@@ -720,6 +792,10 @@ func (wm *CustomLoginTextReadModel) handleLoginScreenSetEvent(e *policy.CustomTe
 		wm.LoginUserMustBeMemberOfOrg = e.Text
 		return
 	}
+	if e.Key == domain.LoginKeyLoginForgotUsernameLinkText {
+		wm.LoginForgotUsernameLinkText = e.Text
+		return
+	}
 }
 
 func (wm *CustomLoginTextReadModel) handleLoginScreenRemoveEvent(e *policy.CustomTextRemovedEvent) {
@@ -767,6 +843,10 @@ func (wm *CustomLoginTextReadModel) handleLoginScreenRemoveEvent(e *policy.Custo
 		wm.LoginUserMustBeMemberOfOrg = ""
 		return
 	}
+	if e.Key == domain.LoginKeyLoginForgotUsernameLinkText {
+		wm.LoginForgotUsernameLinkText = ""
+		return
+	}
 }
 
 func (wm *CustomLoginTextReadModel) handlePasswordScreenSetEvent(e *policy.CustomTextSetEvent) {
@@ -786,6 +866,10 @@ func (wm *CustomLoginTextReadModel) handlePasswordScreenSetEvent(e *policy.Custo
 		wm.PasswordResetLinkText = e.Text
 		return
 	}
+	if e.Key == domain.LoginKeyPasswordCaptchaLabel {
+		wm.PasswordCaptchaLabel = e.Text
+		return
+	}
 	if e.Key == domain.LoginKeyPasswordBackButtonText {
 		wm.PasswordBackButtonText = e.Text
 		return
@@ -837,6 +921,10 @@ func (wm *CustomLoginTextReadModel) handlePasswordScreenRemoveEvent(e *policy.Cu
 		wm.PasswordResetLinkText = ""
 		return
 	}
+	if e.Key == domain.LoginKeyPasswordCaptchaLabel {
+		wm.PasswordCaptchaLabel = ""
+		return
+	}
 	if e.Key == domain.LoginKeyPasswordBackButtonText {
 		wm.PasswordBackButtonText = ""
 		return
@@ -1576,6 +1664,10 @@ func (wm *CustomLoginTextReadModel) handlePasswordlessScreenSetEvent(e *policy.C
 		wm.PasswordlessErrorRetry = e.Text
 		return
 	}
+	if e.Key == domain.LoginKeyPasswordlessCaptchaLabel {
+		wm.PasswordlessCaptchaLabel = e.Text
+		return
+	}
 }
 
 func (wm *CustomLoginTextReadModel) handlePasswordlessScreenRemoveEvent(e *policy.CustomTextRemovedEvent) {
@@ -1603,6 +1695,10 @@ func (wm *CustomLoginTextReadModel) handlePasswordlessScreenRemoveEvent(e *polic
 		wm.PasswordlessErrorRetry = ""
 		return
 	}
+	if e.Key == domain.LoginKeyPasswordlessCaptchaLabel {
+		wm.PasswordlessCaptchaLabel = ""
+		return
+	}
 }
 
 func (wm *CustomLoginTextReadModel) handlePasswordlessPromptScreenSetEvent(e *policy.CustomTextSetEvent) {
@@ -1889,6 +1985,182 @@ func (wm *CustomLoginTextReadModel) handlePasswordResetDoneScreenRemoveEvent(e *
 	}
 }
 
+func (wm *CustomLoginTextReadModel) handleUsernameRecoveryScreenSetEvent(e *policy.CustomTextSetEvent) {
+	if e.Key == domain.LoginKeyUsernameRecoveryTitle {
+		wm.UsernameRecoveryTitle = e.Text
+		return
+	}
+	if e.Key == domain.LoginKeyUsernameRecoveryDescription {
+		wm.UsernameRecoveryDescription = e.Text
+		return
+	}
+	if e.Key == domain.LoginKeyUsernameRecoveryEmailOrPhoneLabel {
+		wm.UsernameRecoveryEmailOrPhoneLabel = e.Text
+		return
+	}
+	if e.Key == domain.LoginKeyUsernameRecoveryCaptchaLabel {
+		wm.UsernameRecoveryCaptchaLabel = e.Text
+		return
+	}
+	if e.Key == domain.LoginKeyUsernameRecoveryBackButtonText {
+		wm.UsernameRecoveryBackButtonText = e.Text
+		return
+	}
+	if e.Key == domain.LoginKeyUsernameRecoveryNextButtonText {
+		wm.UsernameRecoveryNextButtonText = e.Text
+		return
+	}
+}
+
+func (wm *CustomLoginTextReadModel) handleUsernameRecoveryScreenRemoveEvent(e *policy.CustomTextRemovedEvent) {
+	if e.Key == domain.LoginKeyUsernameRecoveryTitle {
+		wm.UsernameRecoveryTitle = ""
+		return
+	}
+	if e.Key == domain.LoginKeyUsernameRecoveryDescription {
+		wm.UsernameRecoveryDescription = ""
+		return
+	}
+	if e.Key == domain.LoginKeyUsernameRecoveryEmailOrPhoneLabel {
+		wm.UsernameRecoveryEmailOrPhoneLabel = ""
+		return
+	}
+	if e.Key == domain.LoginKeyUsernameRecoveryCaptchaLabel {
+		wm.UsernameRecoveryCaptchaLabel = ""
+		return
+	}
+	if e.Key == domain.LoginKeyUsernameRecoveryBackButtonText {
+		wm.UsernameRecoveryBackButtonText = ""
+		return
+	}
+	if e.Key == domain.LoginKeyUsernameRecoveryNextButtonText {
+		wm.UsernameRecoveryNextButtonText = ""
+		return
+	}
+}
+
+func (wm *CustomLoginTextReadModel) handleUsernameRecoveryDoneScreenSetEvent(e *policy.CustomTextSetEvent) {
+	if e.Key == domain.LoginKeyUsernameRecoveryDoneTitle {
+		wm.UsernameRecoveryDoneTitle = e.Text
+		return
+	}
+	if e.Key == domain.LoginKeyUsernameRecoveryDoneDescription {
+		wm.UsernameRecoveryDoneDescription = e.Text
+		return
+	}
+	if e.Key == domain.LoginKeyUsernameRecoveryDoneNextButtonText {
+		wm.UsernameRecoveryDoneNextButtonText = e.Text
+		return
+	}
+}
+
+func (wm *CustomLoginTextReadModel) handleUsernameRecoveryDoneScreenRemoveEvent(e *policy.CustomTextRemovedEvent) {
+	if e.Key == domain.LoginKeyUsernameRecoveryDoneTitle {
+		wm.UsernameRecoveryDoneTitle = ""
+		return
+	}
+	if e.Key == domain.LoginKeyUsernameRecoveryDoneDescription {
+		wm.UsernameRecoveryDoneDescription = ""
+		return
+	}
+	if e.Key == domain.LoginKeyUsernameRecoveryDoneNextButtonText {
+		wm.UsernameRecoveryDoneNextButtonText = ""
+		return
+	}
+}
+
+func (wm *CustomLoginTextReadModel) handlePasskeyPromptScreenSetEvent(e *policy.CustomTextSetEvent) {
+	if e.Key == domain.LoginKeyPasskeyPromptTitle {
+		wm.PasskeyPromptTitle = e.Text
+		return
+	}
+	if e.Key == domain.LoginKeyPasskeyPromptDescription {
+		wm.PasskeyPromptDescription = e.Text
+		return
+	}
+	if e.Key == domain.LoginKeyPasskeyPromptRegisterButtonText {
+		wm.PasskeyPromptRegisterButtonText = e.Text
+		return
+	}
+	if e.Key == domain.LoginKeyPasskeyPromptSnoozeButtonText {
+		wm.PasskeyPromptSnoozeButtonText = e.Text
+		return
+	}
+	if e.Key == domain.LoginKeyPasskeyPromptOptOutButtonText {
+		wm.PasskeyPromptOptOutButtonText = e.Text
+		return
+	}
+}
+
+func (wm *CustomLoginTextReadModel) handlePasskeyPromptScreenRemoveEvent(e *policy.CustomTextRemovedEvent) {
+	if e.Key == domain.LoginKeyPasskeyPromptTitle {
+		wm.PasskeyPromptTitle = ""
+		return
+	}
+	if e.Key == domain.LoginKeyPasskeyPromptDescription {
+		wm.PasskeyPromptDescription = ""
+		return
+	}
+	if e.Key == domain.LoginKeyPasskeyPromptRegisterButtonText {
+		wm.PasskeyPromptRegisterButtonText = ""
+		return
+	}
+	if e.Key == domain.LoginKeyPasskeyPromptSnoozeButtonText {
+		wm.PasskeyPromptSnoozeButtonText = ""
+		return
+	}
+	if e.Key == domain.LoginKeyPasskeyPromptOptOutButtonText {
+		wm.PasskeyPromptOptOutButtonText = ""
+		return
+	}
+}
+
+func (wm *CustomLoginTextReadModel) handleLinkedIDPsScreenSetEvent(e *policy.CustomTextSetEvent) {
+	if e.Key == domain.LoginKeyLinkedIDPsTitle {
+		wm.LinkedIDPsTitle = e.Text
+		return
+	}
+	if e.Key == domain.LoginKeyLinkedIDPsDescription {
+		wm.LinkedIDPsDescription = e.Text
+		return
+	}
+	if e.Key == domain.LoginKeyLinkedIDPsNoLinksText {
+		wm.LinkedIDPsNoLinksText = e.Text
+		return
+	}
+	if e.Key == domain.LoginKeyLinkedIDPsUnlinkButtonText {
+		wm.LinkedIDPsUnlinkButtonText = e.Text
+		return
+	}
+	if e.Key == domain.LoginKeyLinkedIDPsNextButtonText {
+		wm.LinkedIDPsNextButtonText = e.Text
+		return
+	}
+}
+
+func (wm *CustomLoginTextReadModel) handleLinkedIDPsScreenRemoveEvent(e *policy.CustomTextRemovedEvent) {
+	if e.Key == domain.LoginKeyLinkedIDPsTitle {
+		wm.LinkedIDPsTitle = ""
+		return
+	}
+	if e.Key == domain.LoginKeyLinkedIDPsDescription {
+		wm.LinkedIDPsDescription = ""
+		return
+	}
+	if e.Key == domain.LoginKeyLinkedIDPsNoLinksText {
+		wm.LinkedIDPsNoLinksText = ""
+		return
+	}
+	if e.Key == domain.LoginKeyLinkedIDPsUnlinkButtonText {
+		wm.LinkedIDPsUnlinkButtonText = ""
+		return
+	}
+	if e.Key == domain.LoginKeyLinkedIDPsNextButtonText {
+		wm.LinkedIDPsNextButtonText = ""
+		return
+	}
+}
+
 func (wm *CustomLoginTextReadModel) handleRegistrationOptionScreenSetEvent(e *policy.CustomTextSetEvent) {
 	if e.Key == domain.LoginKeyRegistrationOptionTitle {
 		wm.RegistrationOptionTitle = e.Text
@@ -2008,6 +2280,10 @@ func (wm *CustomLoginTextReadModel) handleRegistrationUserScreenSetEvent(e *poli
 		wm.RegistrationUserBackButtonText = e.Text
 		return
 	}
+	if e.Key == domain.LoginKeyRegistrationUserCaptchaLabel {
+		wm.RegistrationUserCaptchaLabel = e.Text
+		return
+	}
 }
 
 func (wm *CustomLoginTextReadModel) handleExternalRegistrationUserOverviewScreenSetEvent(e *policy.CustomTextSetEvent) {
@@ -2150,6 +2426,10 @@ func (wm *CustomLoginTextReadModel) handleRegistrationUserScreenRemoveEvent(e *p
 		wm.RegistrationUserBackButtonText = ""
 		return
 	}
+	if e.Key == domain.LoginKeyRegistrationUserCaptchaLabel {
+		wm.RegistrationUserCaptchaLabel = ""
+		return
+	}
 }
 
 func (wm *CustomLoginTextReadModel) handleExternalRegistrationUserOverviewScreenRemoveEvent(e *policy.CustomTextRemovedEvent) {
@@ -2567,6 +2847,44 @@ func (wm *CustomLoginTextReadModel) handleLogoutDoneScreenRemoveEvent(e *policy.
 	}
 }
 
+func (wm *CustomLoginTextReadModel) handleLogoutConfirmationScreenSetEvent(e *policy.CustomTextSetEvent) {
+	if e.Key == domain.LoginKeyLogoutConfirmationTitle {
+		wm.LogoutConfirmationTitle = e.Text
+		return
+	}
+	if e.Key == domain.LoginKeyLogoutConfirmationDescription {
+		wm.LogoutConfirmationDescription = e.Text
+		return
+	}
+	if e.Key == domain.LoginKeyLogoutConfirmationConfirmButtonText {
+		wm.LogoutConfirmationConfirmButtonText = e.Text
+		return
+	}
+	if e.Key == domain.LoginKeyLogoutConfirmationCancelButtonText {
+		wm.LogoutConfirmationCancelButtonText = e.Text
+		return
+	}
+}
+
+func (wm *CustomLoginTextReadModel) handleLogoutConfirmationScreenRemoveEvent(e *policy.CustomTextRemovedEvent) {
+	if e.Key == domain.LoginKeyLogoutConfirmationTitle {
+		wm.LogoutConfirmationTitle = ""
+		return
+	}
+	if e.Key == domain.LoginKeyLogoutConfirmationDescription {
+		wm.LogoutConfirmationDescription = ""
+		return
+	}
+	if e.Key == domain.LoginKeyLogoutConfirmationConfirmButtonText {
+		wm.LogoutConfirmationConfirmButtonText = ""
+		return
+	}
+	if e.Key == domain.LoginKeyLogoutConfirmationCancelButtonText {
+		wm.LogoutConfirmationCancelButtonText = ""
+		return
+	}
+}
+
 func (wm *CustomLoginTextReadModel) handleFooterTextSetEvent(e *policy.CustomTextSetEvent) {
 	if e.Key == domain.LoginKeyFooterTOS {
 		wm.FooterTOS = e.Text
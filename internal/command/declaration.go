@@ -0,0 +1,253 @@
+package command
+
+import (
+	"context"
+	"strings"
+
+	"sigs.k8s.io/yaml"
+
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore/v1/models"
+	"github.com/zitadel/zitadel/internal/telemetry/tracing"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+// Declaration is the desired state of the resources of an instance.
+// Resources are identified by their name (orgs, projects) or key (roles).
+// Resources which exist but are not declared are left untouched.
+type Declaration struct {
+	Orgs []*DeclaredOrg `json:"orgs,omitempty"`
+}
+
+type DeclaredOrg struct {
+	ID       string             `json:"-"`
+	Name     string             `json:"name"`
+	Projects []*DeclaredProject `json:"projects,omitempty"`
+}
+
+type DeclaredProject struct {
+	ID                     string                        `json:"-"`
+	Name                   string                        `json:"name"`
+	ProjectRoleAssertion   bool                          `json:"projectRoleAssertion,omitempty"`
+	ProjectRoleCheck       bool                          `json:"projectRoleCheck,omitempty"`
+	HasProjectCheck        bool                          `json:"hasProjectCheck,omitempty"`
+	PrivateLabelingSetting domain.PrivateLabelingSetting `json:"privateLabelingSetting,omitempty"`
+	Roles                  []*DeclaredRole               `json:"roles,omitempty"`
+}
+
+type DeclaredRole struct {
+	Key         string `json:"key"`
+	DisplayName string `json:"displayName,omitempty"`
+	Group       string `json:"group,omitempty"`
+}
+
+type DeclarationResourceType string
+
+const (
+	DeclarationResourceTypeOrg         DeclarationResourceType = "org"
+	DeclarationResourceTypeProject     DeclarationResourceType = "project"
+	DeclarationResourceTypeProjectRole DeclarationResourceType = "project_role"
+)
+
+type DeclarationChangeAction int32
+
+const (
+	DeclarationChangeActionCreate DeclarationChangeAction = iota
+	DeclarationChangeActionUpdate
+)
+
+// DeclarationChange is a single command needed to reconcile the current with the declared state
+type DeclarationChange struct {
+	ResourceType DeclarationResourceType
+	Action       DeclarationChangeAction
+	// Resource is the path of the resource, e.g. org/project/role
+	Resource string
+
+	org     *DeclaredOrg
+	project *DeclaredProject
+	role    *DeclaredRole
+}
+
+// ParseDeclaration parses a declaration in YAML or JSON format, unknown fields are rejected
+func ParseDeclaration(data []byte) (*Declaration, error) {
+	declaration := new(Declaration)
+	if err := yaml.UnmarshalStrict(data, declaration); err != nil {
+		return nil, zerrors.ThrowInvalidArgument(err, "COMMAND-Vd3kq8Xn2w", "Errors.Declaration.Invalid")
+	}
+	if err := declaration.validate(); err != nil {
+		return nil, err
+	}
+	return declaration, nil
+}
+
+func (d *Declaration) validate() error {
+	orgs := make(map[string]bool, len(d.Orgs))
+	for _, org := range d.Orgs {
+		org.Name = strings.TrimSpace(org.Name)
+		if org.Name == "" || orgs[org.Name] {
+			return zerrors.ThrowInvalidArgument(nil, "COMMAND-Lp6wz2Rk9c", "Errors.Declaration.Invalid")
+		}
+		orgs[org.Name] = true
+		projects := make(map[string]bool, len(org.Projects))
+		for _, project := range org.Projects {
+			project.Name = strings.TrimSpace(project.Name)
+			if project.Name == "" || projects[project.Name] {
+				return zerrors.ThrowInvalidArgument(nil, "COMMAND-Hy2nc7Ws4d", "Errors.Declaration.Invalid")
+			}
+			projects[project.Name] = true
+			roles := make(map[string]bool, len(project.Roles))
+			for _, role := range project.Roles {
+				if role.Key == "" || roles[role.Key] {
+					return zerrors.ThrowInvalidArgument(nil, "COMMAND-Zt9vb3Qm5e", "Errors.Declaration.Invalid")
+				}
+				roles[role.Key] = true
+			}
+		}
+	}
+	return nil
+}
+
+// PlanDeclaration computes the minimal set of changes to reconcile the current state with the declared state.
+// The ids of existing resources are set on the declared resources.
+func PlanDeclaration(declared, current *Declaration) []*DeclarationChange {
+	changes := make([]*DeclarationChange, 0)
+	for _, org := range declared.Orgs {
+		currentOrg := current.org(org.Name)
+		if currentOrg == nil {
+			changes = append(changes, &DeclarationChange{ResourceType: DeclarationResourceTypeOrg, Action: DeclarationChangeActionCreate, Resource: org.Name, org: org})
+		} else {
+			org.ID = currentOrg.ID
+		}
+		for _, project := range org.Projects {
+			changes = append(changes, planDeclaredProject(org, project, currentOrg)...)
+		}
+	}
+	return changes
+}
+
+func planDeclaredProject(org *DeclaredOrg, project *DeclaredProject, currentOrg *DeclaredOrg) []*DeclarationChange {
+	changes := make([]*DeclarationChange, 0, len(project.Roles)+1)
+	path := org.Name + "/" + project.Name
+	var currentProject *DeclaredProject
+	if currentOrg != nil {
+		currentProject = currentOrg.project(project.Name)
+	}
+	switch {
+	case currentProject == nil:
+		changes = append(changes, &DeclarationChange{ResourceType: DeclarationResourceTypeProject, Action: DeclarationChangeActionCreate, Resource: path, org: org, project: project})
+	case !currentProject.settingsEqual(project):
+		project.ID = currentProject.ID
+		changes = append(changes, &DeclarationChange{ResourceType: DeclarationResourceTypeProject, Action: DeclarationChangeActionUpdate, Resource: path, org: org, project: project})
+	default:
+		project.ID = currentProject.ID
+	}
+	for _, role := range project.Roles {
+		var currentRole *DeclaredRole
+		if currentProject != nil {
+			currentRole = currentProject.role(role.Key)
+		}
+		if currentRole == nil {
+			changes = append(changes, &DeclarationChange{ResourceType: DeclarationResourceTypeProjectRole, Action: DeclarationChangeActionCreate, Resource: path + "/" + role.Key, org: org, project: project, role: role})
+			continue
+		}
+		if *currentRole != *role {
+			changes = append(changes, &DeclarationChange{ResourceType: DeclarationResourceTypeProjectRole, Action: DeclarationChangeActionUpdate, Resource: path + "/" + role.Key, org: org, project: project, role: role})
+		}
+	}
+	return changes
+}
+
+func (d *Declaration) org(name string) *DeclaredOrg {
+	for _, org := range d.Orgs {
+		if org.Name == name {
+			return org
+		}
+	}
+	return nil
+}
+
+func (o *DeclaredOrg) project(name string) *DeclaredProject {
+	for _, project := range o.Projects {
+		if project.Name == name {
+			return project
+		}
+	}
+	return nil
+}
+
+func (p *DeclaredProject) role(key string) *DeclaredRole {
+	for _, role := range p.Roles {
+		if role.Key == key {
+			return role
+		}
+	}
+	return nil
+}
+
+func (p *DeclaredProject) settingsEqual(other *DeclaredProject) bool {
+	return p.ProjectRoleAssertion == other.ProjectRoleAssertion &&
+		p.ProjectRoleCheck == other.ProjectRoleCheck &&
+		p.HasProjectCheck == other.HasProjectCheck &&
+		p.PrivateLabelingSetting == other.PrivateLabelingSetting
+}
+
+// ApplyDeclaration executes the planned changes in order.
+// Created orgs and projects are owned by the given user.
+// It stops at the first failing change and returns the number of applied changes.
+func (c *Commands) ApplyDeclaration(ctx context.Context, changes []*DeclarationChange, userID, userResourceOwner string) (applied int, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	for _, change := range changes {
+		if err = c.applyDeclarationChange(ctx, change, userID, userResourceOwner); err != nil {
+			return applied, err
+		}
+		applied++
+	}
+	return applied, nil
+}
+
+func (c *Commands) applyDeclarationChange(ctx context.Context, change *DeclarationChange, userID, userResourceOwner string) error {
+	switch change.ResourceType {
+	case DeclarationResourceTypeOrg:
+		org, err := c.AddOrg(ctx, change.org.Name, userID, userResourceOwner, nil)
+		if err != nil {
+			return err
+		}
+		change.org.ID = org.AggregateID
+		return nil
+	case DeclarationResourceTypeProject:
+		project := &domain.Project{
+			ObjectRoot:             models.ObjectRoot{AggregateID: change.project.ID},
+			Name:                   change.project.Name,
+			ProjectRoleAssertion:   change.project.ProjectRoleAssertion,
+			ProjectRoleCheck:       change.project.ProjectRoleCheck,
+			HasProjectCheck:        change.project.HasProjectCheck,
+			PrivateLabelingSetting: change.project.PrivateLabelingSetting,
+		}
+		if change.Action == DeclarationChangeActionUpdate {
+			_, err := c.ChangeProject(ctx, project, change.org.ID)
+			return err
+		}
+		project, err := c.AddProject(ctx, project, change.org.ID, userID)
+		if err != nil {
+			return err
+		}
+		change.project.ID = project.AggregateID
+		return nil
+	case DeclarationResourceTypeProjectRole:
+		role := &domain.ProjectRole{
+			ObjectRoot:  models.ObjectRoot{AggregateID: change.project.ID},
+			Key:         change.role.Key,
+			DisplayName: change.role.DisplayName,
+			Group:       change.role.Group,
+		}
+		if change.Action == DeclarationChangeActionUpdate {
+			_, err := c.ChangeProjectRole(ctx, role, change.org.ID)
+			return err
+		}
+		_, err := c.AddProjectRole(ctx, role, change.org.ID)
+		return err
+	}
+	return zerrors.ThrowInvalidArgument(nil, "COMMAND-Jm4xs8Fb6t", "Errors.Declaration.Invalid")
+}
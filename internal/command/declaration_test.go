@@ -0,0 +1,212 @@
+package command
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/repository/project"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+func TestParseDeclaration(t *testing.T) {
+	tests := []struct {
+		name    string
+		data    string
+		want    *Declaration
+		wantErr func(error) bool
+	}{
+		{
+			name: "yaml",
+			data: `
+orgs:
+  - name: " org "
+    projects:
+      - name: project
+        projectRoleCheck: true
+        roles:
+          - key: admin
+            displayName: Administrator
+`,
+			want: &Declaration{
+				Orgs: []*DeclaredOrg{{
+					Name: "org",
+					Projects: []*DeclaredProject{{
+						Name:             "project",
+						ProjectRoleCheck: true,
+						Roles:            []*DeclaredRole{{Key: "admin", DisplayName: "Administrator"}},
+					}},
+				}},
+			},
+		},
+		{
+			name: "json",
+			data: `{"orgs": [{"name": "org"}]}`,
+			want: &Declaration{
+				Orgs: []*DeclaredOrg{{Name: "org"}},
+			},
+		},
+		{
+			name:    "unknown field, error",
+			data:    `{"orgs": [{"name": "org", "users": []}]}`,
+			wantErr: zerrors.IsErrorInvalidArgument,
+		},
+		{
+			name:    "duplicate org, error",
+			data:    `{"orgs": [{"name": "org"}, {"name": "org"}]}`,
+			wantErr: zerrors.IsErrorInvalidArgument,
+		},
+		{
+			name:    "role without key, error",
+			data:    `{"orgs": [{"name": "org", "projects": [{"name": "project", "roles": [{"displayName": "role"}]}]}]}`,
+			wantErr: zerrors.IsErrorInvalidArgument,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseDeclaration([]byte(tt.data))
+			if tt.wantErr != nil {
+				assert.True(t, tt.wantErr(err), "got wrong err: %v", err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestPlanDeclaration(t *testing.T) {
+	type change struct {
+		resourceType DeclarationResourceType
+		action       DeclarationChangeAction
+		resource     string
+	}
+	current := &Declaration{
+		Orgs: []*DeclaredOrg{{
+			ID:   "org1",
+			Name: "org",
+			Projects: []*DeclaredProject{{
+				ID:   "project1",
+				Name: "project",
+				Roles: []*DeclaredRole{
+					{Key: "admin", DisplayName: "Administrator"},
+					{Key: "viewer", DisplayName: "Viewer"},
+				},
+			}},
+		}},
+	}
+	tests := []struct {
+		name     string
+		declared *Declaration
+		want     []change
+	}{
+		{
+			name: "unchanged",
+			declared: &Declaration{
+				Orgs: []*DeclaredOrg{{
+					Name: "org",
+					Projects: []*DeclaredProject{{
+						Name:  "project",
+						Roles: []*DeclaredRole{{Key: "admin", DisplayName: "Administrator"}},
+					}},
+				}},
+			},
+			want: []change{},
+		},
+		{
+			name: "changed",
+			declared: &Declaration{
+				Orgs: []*DeclaredOrg{{
+					Name: "org",
+					Projects: []*DeclaredProject{{
+						Name:             "project",
+						ProjectRoleCheck: true,
+						Roles: []*DeclaredRole{
+							{Key: "admin", DisplayName: "Admin"},
+							{Key: "editor", DisplayName: "Editor"},
+						},
+					}},
+				}},
+			},
+			want: []change{
+				{DeclarationResourceTypeProject, DeclarationChangeActionUpdate, "org/project"},
+				{DeclarationResourceTypeProjectRole, DeclarationChangeActionUpdate, "org/project/admin"},
+				{DeclarationResourceTypeProjectRole, DeclarationChangeActionCreate, "org/project/editor"},
+			},
+		},
+		{
+			name: "new org",
+			declared: &Declaration{
+				Orgs: []*DeclaredOrg{{
+					Name: "new",
+					Projects: []*DeclaredProject{{
+						Name:  "project",
+						Roles: []*DeclaredRole{{Key: "admin"}},
+					}},
+				}},
+			},
+			want: []change{
+				{DeclarationResourceTypeOrg, DeclarationChangeActionCreate, "new"},
+				{DeclarationResourceTypeProject, DeclarationChangeActionCreate, "new/project"},
+				{DeclarationResourceTypeProjectRole, DeclarationChangeActionCreate, "new/project/admin"},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := PlanDeclaration(tt.declared, current)
+			changes := make([]change, len(got))
+			for i, c := range got {
+				changes[i] = change{c.ResourceType, c.Action, c.Resource}
+			}
+			assert.Equal(t, tt.want, changes)
+		})
+	}
+}
+
+func TestCommands_ApplyDeclaration(t *testing.T) {
+	declared := &Declaration{
+		Orgs: []*DeclaredOrg{{
+			Name: "org",
+			Projects: []*DeclaredProject{{
+				Name:  "project",
+				Roles: []*DeclaredRole{{Key: "key1", DisplayName: "key", Group: "group"}},
+			}},
+		}},
+	}
+	current := &Declaration{
+		Orgs: []*DeclaredOrg{{
+			ID:       "org1",
+			Name:     "org",
+			Projects: []*DeclaredProject{{ID: "project1", Name: "project"}},
+		}},
+	}
+	c := &Commands{
+		eventstore: expectEventstore(
+			expectFilter(
+				eventFromEventPusher(
+					project.NewProjectAddedEvent(context.Background(),
+						&project.NewAggregate("project1", "org1").Aggregate,
+						"project", false, false, false,
+						domain.PrivateLabelingSettingUnspecified,
+					),
+				),
+			),
+			expectPush(
+				project.NewRoleAddedEvent(
+					context.Background(),
+					&project.NewAggregate("project1", "org1").Aggregate,
+					"key1",
+					"key",
+					"group",
+				),
+			),
+		)(t),
+	}
+	applied, err := c.ApplyDeclaration(context.Background(), PlanDeclaration(declared, current), "user1", "org1")
+	require.NoError(t, err)
+	assert.Equal(t, 1, applied)
+}
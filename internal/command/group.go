@@ -0,0 +1,210 @@
+package command
+
+import (
+	"context"
+	"strings"
+
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore/v1/models"
+	"github.com/zitadel/zitadel/internal/repository/group"
+	"github.com/zitadel/zitadel/internal/telemetry/tracing"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+type AddGroup struct {
+	models.ObjectRoot
+
+	Name        string
+	Description string
+	// ParentID optionally nests the group into an existing group of the same organization
+	ParentID string
+}
+
+func (a *AddGroup) IsValid() error {
+	if a.Name = strings.TrimSpace(a.Name); a.Name == "" {
+		return zerrors.ThrowInvalidArgument(nil, "COMMAND-Gr1nv", "Errors.Group.Invalid")
+	}
+	return nil
+}
+
+func (c *Commands) AddGroup(ctx context.Context, add *AddGroup, resourceOwner string) (_ *domain.ObjectDetails, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	if resourceOwner == "" {
+		return nil, zerrors.ThrowInvalidArgument(nil, "COMMAND-Gr1ro", "Errors.ResourceOwnerMissing")
+	}
+	if err := add.IsValid(); err != nil {
+		return nil, err
+	}
+	if err = c.checkOrgExists(ctx, resourceOwner); err != nil {
+		return nil, err
+	}
+	if add.ParentID != "" {
+		groups, err := c.orgGroupsReadModel(ctx, resourceOwner)
+		if err != nil {
+			return nil, err
+		}
+		if !groups.Exists(add.ParentID) {
+			return nil, zerrors.ThrowPreconditionFailed(nil, "COMMAND-Gr1pn", "Errors.Group.ParentNotFound")
+		}
+	}
+	if add.AggregateID == "" {
+		add.AggregateID, err = c.idGenerator.Next()
+		if err != nil {
+			return nil, err
+		}
+	}
+	wm, err := c.getGroupWriteModelByID(ctx, add.AggregateID, resourceOwner)
+	if err != nil {
+		return nil, err
+	}
+	if wm.State.Exists() {
+		return nil, zerrors.ThrowAlreadyExists(nil, "COMMAND-Gr1ae", "Errors.Group.AlreadyExists")
+	}
+	if err = c.pushAppendAndReduce(ctx, wm,
+		group.NewAddedEvent(ctx,
+			GroupAggregateFromWriteModel(&wm.WriteModel),
+			add.Name,
+			add.Description,
+			add.ParentID,
+		),
+	); err != nil {
+		return nil, err
+	}
+	return writeModelToObjectDetails(&wm.WriteModel), nil
+}
+
+type ChangeGroup struct {
+	models.ObjectRoot
+
+	Name        *string
+	Description *string
+}
+
+func (a *ChangeGroup) IsValid() error {
+	if a.AggregateID == "" {
+		return zerrors.ThrowInvalidArgument(nil, "COMMAND-Gr2id", "Errors.IDMissing")
+	}
+	if a.Name != nil {
+		if *a.Name = strings.TrimSpace(*a.Name); *a.Name == "" {
+			return zerrors.ThrowInvalidArgument(nil, "COMMAND-Gr2nv", "Errors.Group.Invalid")
+		}
+	}
+	return nil
+}
+
+// ChangeGroup renames the group or changes its description.
+func (c *Commands) ChangeGroup(ctx context.Context, change *ChangeGroup, resourceOwner string) (_ *domain.ObjectDetails, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	if resourceOwner == "" {
+		return nil, zerrors.ThrowInvalidArgument(nil, "COMMAND-Gr2ro", "Errors.ResourceOwnerMissing")
+	}
+	if err := change.IsValid(); err != nil {
+		return nil, err
+	}
+	wm, err := c.getGroupWriteModelByID(ctx, change.AggregateID, resourceOwner)
+	if err != nil {
+		return nil, err
+	}
+	if !wm.State.Exists() {
+		return nil, zerrors.ThrowNotFound(nil, "COMMAND-Gr2nf", "Errors.Group.NotFound")
+	}
+	changedEvent := wm.NewChangedEvent(ctx, GroupAggregateFromWriteModel(&wm.WriteModel), change.Name, change.Description)
+	if changedEvent == nil {
+		return writeModelToObjectDetails(&wm.WriteModel), nil
+	}
+	if err = c.pushAppendAndReduce(ctx, wm, changedEvent); err != nil {
+		return nil, err
+	}
+	return writeModelToObjectDetails(&wm.WriteModel), nil
+}
+
+// SetGroupParent nests the group into the parent group.
+// The members of the group and its nested groups inherit the grants of all parent groups.
+// An empty parentID moves the group back to the top level of the organization.
+func (c *Commands) SetGroupParent(ctx context.Context, groupID, parentID, resourceOwner string) (_ *domain.ObjectDetails, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	if groupID == "" || resourceOwner == "" {
+		return nil, zerrors.ThrowInvalidArgument(nil, "COMMAND-Gr3id", "Errors.IDMissing")
+	}
+	wm, err := c.getGroupWriteModelByID(ctx, groupID, resourceOwner)
+	if err != nil {
+		return nil, err
+	}
+	if !wm.State.Exists() {
+		return nil, zerrors.ThrowNotFound(nil, "COMMAND-Gr3nf", "Errors.Group.NotFound")
+	}
+	if wm.ParentID == parentID {
+		return writeModelToObjectDetails(&wm.WriteModel), nil
+	}
+	if parentID != "" {
+		groups, err := c.orgGroupsReadModel(ctx, resourceOwner)
+		if err != nil {
+			return nil, err
+		}
+		if !groups.Exists(parentID) {
+			return nil, zerrors.ThrowPreconditionFailed(nil, "COMMAND-Gr3pn", "Errors.Group.ParentNotFound")
+		}
+		if groups.IsAncestor(groupID, parentID) {
+			return nil, zerrors.ThrowPreconditionFailed(nil, "COMMAND-Gr3cy", "Errors.Group.NestingCycle")
+		}
+	}
+	if err = c.pushAppendAndReduce(ctx, wm,
+		group.NewParentSetEvent(ctx, GroupAggregateFromWriteModel(&wm.WriteModel), parentID),
+	); err != nil {
+		return nil, err
+	}
+	return writeModelToObjectDetails(&wm.WriteModel), nil
+}
+
+// RemoveGroup removes the group including its memberships and grants.
+// Groups which still contain nested groups can't be removed.
+func (c *Commands) RemoveGroup(ctx context.Context, groupID, resourceOwner string) (_ *domain.ObjectDetails, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	if groupID == "" || resourceOwner == "" {
+		return nil, zerrors.ThrowInvalidArgument(nil, "COMMAND-Gr4id", "Errors.IDMissing")
+	}
+	wm, err := c.getGroupWriteModelByID(ctx, groupID, resourceOwner)
+	if err != nil {
+		return nil, err
+	}
+	if !wm.State.Exists() {
+		return nil, zerrors.ThrowNotFound(nil, "COMMAND-Gr4nf", "Errors.Group.NotFound")
+	}
+	groups, err := c.orgGroupsReadModel(ctx, resourceOwner)
+	if err != nil {
+		return nil, err
+	}
+	if groups.HasNestedGroups(groupID) {
+		return nil, zerrors.ThrowPreconditionFailed(nil, "COMMAND-Gr4ng", "Errors.Group.HasNestedGroups")
+	}
+	if err = c.pushAppendAndReduce(ctx, wm,
+		group.NewRemovedEvent(ctx, GroupAggregateFromWriteModel(&wm.WriteModel), wm.Name),
+	); err != nil {
+		return nil, err
+	}
+	return writeModelToObjectDetails(&wm.WriteModel), nil
+}
+
+func (c *Commands) getGroupWriteModelByID(ctx context.Context, id, resourceOwner string) (*GroupWriteModel, error) {
+	wm := NewGroupWriteModel(id, resourceOwner)
+	if err := c.eventstore.FilterToQueryReducer(ctx, wm); err != nil {
+		return nil, err
+	}
+	return wm, nil
+}
+
+func (c *Commands) orgGroupsReadModel(ctx context.Context, resourceOwner string) (*OrgGroupsReadModel, error) {
+	rm := NewOrgGroupsReadModel(resourceOwner)
+	if err := c.eventstore.FilterToQueryReducer(ctx, rm); err != nil {
+		return nil, err
+	}
+	return rm, nil
+}
@@ -0,0 +1,155 @@
+package command
+
+import (
+	"context"
+	"slices"
+
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/repository/group"
+	"github.com/zitadel/zitadel/internal/telemetry/tracing"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+type GroupGrant struct {
+	GroupID   string
+	ProjectID string
+	// ProjectGrantID is required if the project is granted to the organization of the group
+	ProjectGrantID string
+	RoleKeys       []string
+}
+
+func (g *GroupGrant) IsValid() error {
+	if g.GroupID == "" || g.ProjectID == "" {
+		return zerrors.ThrowInvalidArgument(nil, "COMMAND-Grg1i", "Errors.IDMissing")
+	}
+	if len(g.RoleKeys) == 0 || slices.Contains(g.RoleKeys, "") {
+		return zerrors.ThrowInvalidArgument(nil, "COMMAND-Grg1r", "Errors.Group.Grant.Invalid")
+	}
+	return nil
+}
+
+// AddGroupGrant grants roles of a project to the group.
+// All members of the group and its nested groups receive the roles.
+func (c *Commands) AddGroupGrant(ctx context.Context, grant *GroupGrant, resourceOwner string) (_ *domain.ObjectDetails, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	if resourceOwner == "" {
+		return nil, zerrors.ThrowInvalidArgument(nil, "COMMAND-Grg2o", "Errors.ResourceOwnerMissing")
+	}
+	if err := grant.IsValid(); err != nil {
+		return nil, err
+	}
+	wm, err := c.getGroupWriteModelByID(ctx, grant.GroupID, resourceOwner)
+	if err != nil {
+		return nil, err
+	}
+	if !wm.State.Exists() {
+		return nil, zerrors.ThrowNotFound(nil, "COMMAND-Grg2n", "Errors.Group.NotFound")
+	}
+	if _, ok := wm.Grants[grant.ProjectID]; ok {
+		return nil, zerrors.ThrowAlreadyExists(nil, "COMMAND-Grg2e", "Errors.Group.Grant.AlreadyExists")
+	}
+	if err = c.checkGroupGrantPreCondition(ctx, grant.ProjectID, grant.ProjectGrantID, grant.RoleKeys, resourceOwner); err != nil {
+		return nil, err
+	}
+	if err = c.pushAppendAndReduce(ctx, wm,
+		group.NewGrantAddedEvent(ctx,
+			GroupAggregateFromWriteModel(&wm.WriteModel),
+			grant.ProjectID,
+			grant.ProjectGrantID,
+			grant.RoleKeys,
+		),
+	); err != nil {
+		return nil, err
+	}
+	return writeModelToObjectDetails(&wm.WriteModel), nil
+}
+
+// ChangeGroupGrant replaces the roles of the project granted to the group.
+func (c *Commands) ChangeGroupGrant(ctx context.Context, grant *GroupGrant, resourceOwner string) (_ *domain.ObjectDetails, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	if resourceOwner == "" {
+		return nil, zerrors.ThrowInvalidArgument(nil, "COMMAND-Grg3o", "Errors.ResourceOwnerMissing")
+	}
+	if err := grant.IsValid(); err != nil {
+		return nil, err
+	}
+	wm, err := c.getGroupWriteModelByID(ctx, grant.GroupID, resourceOwner)
+	if err != nil {
+		return nil, err
+	}
+	if !wm.State.Exists() {
+		return nil, zerrors.ThrowNotFound(nil, "COMMAND-Grg3n", "Errors.Group.NotFound")
+	}
+	existing, ok := wm.Grants[grant.ProjectID]
+	if !ok {
+		return nil, zerrors.ThrowNotFound(nil, "COMMAND-Grg3g", "Errors.Group.Grant.NotFound")
+	}
+	if slices.Equal(existing.RoleKeys, grant.RoleKeys) {
+		return writeModelToObjectDetails(&wm.WriteModel), nil
+	}
+	if err = c.checkGroupGrantPreCondition(ctx, grant.ProjectID, existing.ProjectGrantID, grant.RoleKeys, resourceOwner); err != nil {
+		return nil, err
+	}
+	if err = c.pushAppendAndReduce(ctx, wm,
+		group.NewGrantChangedEvent(ctx,
+			GroupAggregateFromWriteModel(&wm.WriteModel),
+			grant.ProjectID,
+			grant.RoleKeys,
+		),
+	); err != nil {
+		return nil, err
+	}
+	return writeModelToObjectDetails(&wm.WriteModel), nil
+}
+
+// RemoveGroupGrant revokes the roles of the project from the group and therefore from all its members.
+func (c *Commands) RemoveGroupGrant(ctx context.Context, groupID, projectID, resourceOwner string) (_ *domain.ObjectDetails, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	if groupID == "" || projectID == "" || resourceOwner == "" {
+		return nil, zerrors.ThrowInvalidArgument(nil, "COMMAND-Grg4i", "Errors.IDMissing")
+	}
+	wm, err := c.getGroupWriteModelByID(ctx, groupID, resourceOwner)
+	if err != nil {
+		return nil, err
+	}
+	if !wm.State.Exists() {
+		return nil, zerrors.ThrowNotFound(nil, "COMMAND-Grg4n", "Errors.Group.NotFound")
+	}
+	if _, ok := wm.Grants[projectID]; !ok {
+		return nil, zerrors.ThrowNotFound(nil, "COMMAND-Grg4g", "Errors.Group.Grant.NotFound")
+	}
+	if err = c.pushAppendAndReduce(ctx, wm,
+		group.NewGrantRemovedEvent(ctx, GroupAggregateFromWriteModel(&wm.WriteModel), projectID),
+	); err != nil {
+		return nil, err
+	}
+	return writeModelToObjectDetails(&wm.WriteModel), nil
+}
+
+func (c *Commands) checkGroupGrantPreCondition(ctx context.Context, projectID, projectGrantID string, roleKeys []string, resourceOwner string) (err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	preConditions := NewGroupGrantPreConditionReadModel(projectID, projectGrantID, resourceOwner)
+	if err = c.eventstore.FilterToQueryReducer(ctx, preConditions); err != nil {
+		return err
+	}
+	if projectGrantID == "" && !preConditions.ProjectExists {
+		return zerrors.ThrowPreconditionFailed(nil, "COMMAND-Grg5p", "Errors.Project.NotFound")
+	}
+	if projectGrantID != "" && !preConditions.ProjectGrantExists {
+		return zerrors.ThrowPreconditionFailed(nil, "COMMAND-Grg5g", "Errors.Project.Grant.NotFound")
+	}
+	for _, roleKey := range roleKeys {
+		if !slices.Contains(preConditions.ExistingRoleKeys, roleKey) {
+			return zerrors.ThrowPreconditionFailed(nil, "COMMAND-Grg5r", "Errors.Project.Role.NotFound")
+		}
+	}
+	return nil
+}
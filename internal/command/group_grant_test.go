@@ -0,0 +1,386 @@
+package command
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/repository/group"
+	"github.com/zitadel/zitadel/internal/repository/project"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+func TestCommandSide_AddGroupGrant(t *testing.T) {
+	type fields struct {
+		eventstore func(t *testing.T) *eventstore.Eventstore
+	}
+	type args struct {
+		ctx   context.Context
+		grant *GroupGrant
+	}
+	type res struct {
+		want *domain.ObjectDetails
+		err  error
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		args   args
+		res    res
+	}{
+		{
+			name: "no roles, invalid argument error",
+			fields: fields{
+				eventstore: expectEventstore(),
+			},
+			args: args{
+				ctx:   context.Background(),
+				grant: &GroupGrant{GroupID: "group1", ProjectID: "project1"},
+			},
+			res: res{
+				err: zerrors.ThrowInvalidArgument(nil, "COMMAND-Grg1r", "Errors.Group.Grant.Invalid"),
+			},
+		},
+		{
+			name: "grant already exists, already exists error",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(
+							groupAddedEvent("group1", ""),
+						),
+						eventFromEventPusher(
+							group.NewGrantAddedEvent(context.Background(), &group.NewAggregate("group1", "org1").Aggregate, "project1", "", []string{"role1"}),
+						),
+					),
+				),
+			},
+			args: args{
+				ctx:   context.Background(),
+				grant: &GroupGrant{GroupID: "group1", ProjectID: "project1", RoleKeys: []string{"role1"}},
+			},
+			res: res{
+				err: zerrors.ThrowAlreadyExists(nil, "COMMAND-Grg2e", "Errors.Group.Grant.AlreadyExists"),
+			},
+		},
+		{
+			name: "project not found, precondition error",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(
+							groupAddedEvent("group1", ""),
+						),
+					),
+					expectFilter(),
+				),
+			},
+			args: args{
+				ctx:   context.Background(),
+				grant: &GroupGrant{GroupID: "group1", ProjectID: "project1", RoleKeys: []string{"role1"}},
+			},
+			res: res{
+				err: zerrors.ThrowPreconditionFailed(nil, "COMMAND-Grg5p", "Errors.Project.NotFound"),
+			},
+		},
+		{
+			name: "role not found, precondition error",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(
+							groupAddedEvent("group1", ""),
+						),
+					),
+					expectFilter(
+						eventFromEventPusher(
+							project.NewProjectAddedEvent(context.Background(), &project.NewAggregate("project1", "org1").Aggregate, "project", false, false, false, domain.PrivateLabelingSettingUnspecified),
+						),
+						eventFromEventPusher(
+							project.NewRoleAddedEvent(context.Background(), &project.NewAggregate("project1", "org1").Aggregate, "role1", "Role 1", ""),
+						),
+					),
+				),
+			},
+			args: args{
+				ctx:   context.Background(),
+				grant: &GroupGrant{GroupID: "group1", ProjectID: "project1", RoleKeys: []string{"role1", "role2"}},
+			},
+			res: res{
+				err: zerrors.ThrowPreconditionFailed(nil, "COMMAND-Grg5r", "Errors.Project.Role.NotFound"),
+			},
+		},
+		{
+			name: "project grant not found, precondition error",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(
+							groupAddedEvent("group1", ""),
+						),
+					),
+					expectFilter(
+						eventFromEventPusher(
+							project.NewProjectAddedEvent(context.Background(), &project.NewAggregate("project1", "org2").Aggregate, "project", false, false, false, domain.PrivateLabelingSettingUnspecified),
+						),
+						eventFromEventPusher(
+							project.NewGrantAddedEvent(context.Background(), &project.NewAggregate("project1", "org2").Aggregate, "projectgrant1", "org3", []string{"role1"}),
+						),
+					),
+				),
+			},
+			args: args{
+				ctx:   context.Background(),
+				grant: &GroupGrant{GroupID: "group1", ProjectID: "project1", ProjectGrantID: "projectgrant1", RoleKeys: []string{"role1"}},
+			},
+			res: res{
+				err: zerrors.ThrowPreconditionFailed(nil, "COMMAND-Grg5g", "Errors.Project.Grant.NotFound"),
+			},
+		},
+		{
+			name: "add grant, ok",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(
+							groupAddedEvent("group1", ""),
+						),
+					),
+					expectFilter(
+						eventFromEventPusher(
+							project.NewProjectAddedEvent(context.Background(), &project.NewAggregate("project1", "org1").Aggregate, "project", false, false, false, domain.PrivateLabelingSettingUnspecified),
+						),
+						eventFromEventPusher(
+							project.NewRoleAddedEvent(context.Background(), &project.NewAggregate("project1", "org1").Aggregate, "role1", "Role 1", ""),
+						),
+					),
+					expectPush(
+						group.NewGrantAddedEvent(context.Background(), &group.NewAggregate("group1", "org1").Aggregate, "project1", "", []string{"role1"}),
+					),
+				),
+			},
+			args: args{
+				ctx:   context.Background(),
+				grant: &GroupGrant{GroupID: "group1", ProjectID: "project1", RoleKeys: []string{"role1"}},
+			},
+			res: res{
+				want: &domain.ObjectDetails{
+					ResourceOwner: "org1",
+				},
+			},
+		},
+		{
+			name: "add grant of granted project, ok",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(
+							groupAddedEvent("group1", ""),
+						),
+					),
+					expectFilter(
+						eventFromEventPusher(
+							project.NewProjectAddedEvent(context.Background(), &project.NewAggregate("project1", "org2").Aggregate, "project", false, false, false, domain.PrivateLabelingSettingUnspecified),
+						),
+						eventFromEventPusher(
+							project.NewGrantAddedEvent(context.Background(), &project.NewAggregate("project1", "org2").Aggregate, "projectgrant1", "org1", []string{"role1"}),
+						),
+					),
+					expectPush(
+						group.NewGrantAddedEvent(context.Background(), &group.NewAggregate("group1", "org1").Aggregate, "project1", "projectgrant1", []string{"role1"}),
+					),
+				),
+			},
+			args: args{
+				ctx:   context.Background(),
+				grant: &GroupGrant{GroupID: "group1", ProjectID: "project1", ProjectGrantID: "projectgrant1", RoleKeys: []string{"role1"}},
+			},
+			res: res{
+				want: &domain.ObjectDetails{
+					ResourceOwner: "org1",
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := &Commands{
+				eventstore: tt.fields.eventstore(t),
+			}
+			got, err := r.AddGroupGrant(tt.args.ctx, tt.args.grant, "org1")
+			assert.ErrorIs(t, err, tt.res.err)
+			assert.Equal(t, tt.res.want, got)
+		})
+	}
+}
+
+func TestCommandSide_ChangeGroupGrant(t *testing.T) {
+	type fields struct {
+		eventstore func(t *testing.T) *eventstore.Eventstore
+	}
+	type args struct {
+		ctx   context.Context
+		grant *GroupGrant
+	}
+	type res struct {
+		want *domain.ObjectDetails
+		err  error
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		args   args
+		res    res
+	}{
+		{
+			name: "grant not found, not found error",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(
+							groupAddedEvent("group1", ""),
+						),
+					),
+				),
+			},
+			args: args{
+				ctx:   context.Background(),
+				grant: &GroupGrant{GroupID: "group1", ProjectID: "project1", RoleKeys: []string{"role1"}},
+			},
+			res: res{
+				err: zerrors.ThrowNotFound(nil, "COMMAND-Grg3g", "Errors.Group.Grant.NotFound"),
+			},
+		},
+		{
+			name: "change roles, ok",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(
+							groupAddedEvent("group1", ""),
+						),
+						eventFromEventPusher(
+							group.NewGrantAddedEvent(context.Background(), &group.NewAggregate("group1", "org1").Aggregate, "project1", "", []string{"role1"}),
+						),
+					),
+					expectFilter(
+						eventFromEventPusher(
+							project.NewProjectAddedEvent(context.Background(), &project.NewAggregate("project1", "org1").Aggregate, "project", false, false, false, domain.PrivateLabelingSettingUnspecified),
+						),
+						eventFromEventPusher(
+							project.NewRoleAddedEvent(context.Background(), &project.NewAggregate("project1", "org1").Aggregate, "role1", "Role 1", ""),
+						),
+						eventFromEventPusher(
+							project.NewRoleAddedEvent(context.Background(), &project.NewAggregate("project1", "org1").Aggregate, "role2", "Role 2", ""),
+						),
+					),
+					expectPush(
+						group.NewGrantChangedEvent(context.Background(), &group.NewAggregate("group1", "org1").Aggregate, "project1", []string{"role1", "role2"}),
+					),
+				),
+			},
+			args: args{
+				ctx:   context.Background(),
+				grant: &GroupGrant{GroupID: "group1", ProjectID: "project1", RoleKeys: []string{"role1", "role2"}},
+			},
+			res: res{
+				want: &domain.ObjectDetails{
+					ResourceOwner: "org1",
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := &Commands{
+				eventstore: tt.fields.eventstore(t),
+			}
+			got, err := r.ChangeGroupGrant(tt.args.ctx, tt.args.grant, "org1")
+			assert.ErrorIs(t, err, tt.res.err)
+			assert.Equal(t, tt.res.want, got)
+		})
+	}
+}
+
+func TestCommandSide_RemoveGroupGrant(t *testing.T) {
+	type fields struct {
+		eventstore func(t *testing.T) *eventstore.Eventstore
+	}
+	type args struct {
+		ctx       context.Context
+		groupID   string
+		projectID string
+	}
+	type res struct {
+		want *domain.ObjectDetails
+		err  error
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		args   args
+		res    res
+	}{
+		{
+			name: "grant not found, not found error",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(
+							groupAddedEvent("group1", ""),
+						),
+					),
+				),
+			},
+			args: args{
+				ctx:       context.Background(),
+				groupID:   "group1",
+				projectID: "project1",
+			},
+			res: res{
+				err: zerrors.ThrowNotFound(nil, "COMMAND-Grg4g", "Errors.Group.Grant.NotFound"),
+			},
+		},
+		{
+			name: "remove grant, ok",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(
+							groupAddedEvent("group1", ""),
+						),
+						eventFromEventPusher(
+							group.NewGrantAddedEvent(context.Background(), &group.NewAggregate("group1", "org1").Aggregate, "project1", "", []string{"role1"}),
+						),
+					),
+					expectPush(
+						group.NewGrantRemovedEvent(context.Background(), &group.NewAggregate("group1", "org1").Aggregate, "project1"),
+					),
+				),
+			},
+			args: args{
+				ctx:       context.Background(),
+				groupID:   "group1",
+				projectID: "project1",
+			},
+			res: res{
+				want: &domain.ObjectDetails{
+					ResourceOwner: "org1",
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := &Commands{
+				eventstore: tt.fields.eventstore(t),
+			}
+			got, err := r.RemoveGroupGrant(tt.args.ctx, tt.args.groupID, tt.args.projectID, "org1")
+			assert.ErrorIs(t, err, tt.res.err)
+			assert.Equal(t, tt.res.want, got)
+		})
+	}
+}
@@ -0,0 +1,68 @@
+package command
+
+import (
+	"context"
+	"slices"
+
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/repository/group"
+	"github.com/zitadel/zitadel/internal/telemetry/tracing"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+// AddGroupMember adds a user of the organization to the group.
+// The user immediately receives the grants of the group and all its parent groups.
+func (c *Commands) AddGroupMember(ctx context.Context, groupID, userID, resourceOwner string) (_ *domain.ObjectDetails, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	if groupID == "" || userID == "" || resourceOwner == "" {
+		return nil, zerrors.ThrowInvalidArgument(nil, "COMMAND-Grm1i", "Errors.IDMissing")
+	}
+	wm, err := c.getGroupWriteModelByID(ctx, groupID, resourceOwner)
+	if err != nil {
+		return nil, err
+	}
+	if !wm.State.Exists() {
+		return nil, zerrors.ThrowNotFound(nil, "COMMAND-Grm1n", "Errors.Group.NotFound")
+	}
+	if slices.Contains(wm.Members, userID) {
+		return nil, zerrors.ThrowAlreadyExists(nil, "COMMAND-Grm1e", "Errors.Group.Member.AlreadyExists")
+	}
+	if err = c.checkUserExists(ctx, userID, resourceOwner); err != nil {
+		return nil, err
+	}
+	if err = c.pushAppendAndReduce(ctx, wm,
+		group.NewMemberAddedEvent(ctx, GroupAggregateFromWriteModel(&wm.WriteModel), userID),
+	); err != nil {
+		return nil, err
+	}
+	return writeModelToObjectDetails(&wm.WriteModel), nil
+}
+
+// RemoveGroupMember removes the user from the group.
+// The grants of the group are immediately revoked from the user.
+func (c *Commands) RemoveGroupMember(ctx context.Context, groupID, userID, resourceOwner string) (_ *domain.ObjectDetails, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	if groupID == "" || userID == "" || resourceOwner == "" {
+		return nil, zerrors.ThrowInvalidArgument(nil, "COMMAND-Grm2i", "Errors.IDMissing")
+	}
+	wm, err := c.getGroupWriteModelByID(ctx, groupID, resourceOwner)
+	if err != nil {
+		return nil, err
+	}
+	if !wm.State.Exists() {
+		return nil, zerrors.ThrowNotFound(nil, "COMMAND-Grm2n", "Errors.Group.NotFound")
+	}
+	if !slices.Contains(wm.Members, userID) {
+		return nil, zerrors.ThrowNotFound(nil, "COMMAND-Grm2m", "Errors.Group.Member.NotFound")
+	}
+	if err = c.pushAppendAndReduce(ctx, wm,
+		group.NewMemberRemovedEvent(ctx, GroupAggregateFromWriteModel(&wm.WriteModel), userID),
+	); err != nil {
+		return nil, err
+	}
+	return writeModelToObjectDetails(&wm.WriteModel), nil
+}
@@ -0,0 +1,247 @@
+package command
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/text/language"
+
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/repository/group"
+	"github.com/zitadel/zitadel/internal/repository/user"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+func TestCommandSide_AddGroupMember(t *testing.T) {
+	type fields struct {
+		eventstore func(t *testing.T) *eventstore.Eventstore
+	}
+	type args struct {
+		ctx     context.Context
+		groupID string
+		userID  string
+	}
+	type res struct {
+		want *domain.ObjectDetails
+		err  error
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		args   args
+		res    res
+	}{
+		{
+			name: "missing user, invalid argument error",
+			fields: fields{
+				eventstore: expectEventstore(),
+			},
+			args: args{
+				ctx:     context.Background(),
+				groupID: "group1",
+			},
+			res: res{
+				err: zerrors.ThrowInvalidArgument(nil, "COMMAND-Grm1i", "Errors.IDMissing"),
+			},
+		},
+		{
+			name: "group not found, not found error",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(),
+				),
+			},
+			args: args{
+				ctx:     context.Background(),
+				groupID: "group1",
+				userID:  "user1",
+			},
+			res: res{
+				err: zerrors.ThrowNotFound(nil, "COMMAND-Grm1n", "Errors.Group.NotFound"),
+			},
+		},
+		{
+			name: "already member, already exists error",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(
+							groupAddedEvent("group1", ""),
+						),
+						eventFromEventPusher(
+							group.NewMemberAddedEvent(context.Background(), &group.NewAggregate("group1", "org1").Aggregate, "user1"),
+						),
+					),
+				),
+			},
+			args: args{
+				ctx:     context.Background(),
+				groupID: "group1",
+				userID:  "user1",
+			},
+			res: res{
+				err: zerrors.ThrowAlreadyExists(nil, "COMMAND-Grm1e", "Errors.Group.Member.AlreadyExists"),
+			},
+		},
+		{
+			name: "user not found, precondition error",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(
+							groupAddedEvent("group1", ""),
+						),
+					),
+					expectFilter(),
+				),
+			},
+			args: args{
+				ctx:     context.Background(),
+				groupID: "group1",
+				userID:  "user1",
+			},
+			res: res{
+				err: zerrors.ThrowPreconditionFailed(nil, "COMMAND-uXHNj", "Errors.User.NotFound"),
+			},
+		},
+		{
+			name: "add member, ok",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(
+							groupAddedEvent("group1", ""),
+						),
+					),
+					expectFilter(
+						eventFromEventPusher(
+							user.NewHumanAddedEvent(context.Background(),
+								&user.NewAggregate("user1", "org1").Aggregate,
+								"username",
+								"firstname",
+								"lastname",
+								"",
+								"firstname lastname",
+								language.Und,
+								domain.GenderUnspecified,
+								"email@test.ch",
+								true,
+							),
+						),
+					),
+					expectPush(
+						group.NewMemberAddedEvent(context.Background(), &group.NewAggregate("group1", "org1").Aggregate, "user1"),
+					),
+				),
+			},
+			args: args{
+				ctx:     context.Background(),
+				groupID: "group1",
+				userID:  "user1",
+			},
+			res: res{
+				want: &domain.ObjectDetails{
+					ResourceOwner: "org1",
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := &Commands{
+				eventstore: tt.fields.eventstore(t),
+			}
+			got, err := r.AddGroupMember(tt.args.ctx, tt.args.groupID, tt.args.userID, "org1")
+			assert.ErrorIs(t, err, tt.res.err)
+			assert.Equal(t, tt.res.want, got)
+		})
+	}
+}
+
+func TestCommandSide_RemoveGroupMember(t *testing.T) {
+	type fields struct {
+		eventstore func(t *testing.T) *eventstore.Eventstore
+	}
+	type args struct {
+		ctx     context.Context
+		groupID string
+		userID  string
+	}
+	type res struct {
+		want *domain.ObjectDetails
+		err  error
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		args   args
+		res    res
+	}{
+		{
+			name: "member not found, not found error",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(
+							groupAddedEvent("group1", ""),
+						),
+						eventFromEventPusher(
+							group.NewMemberAddedEvent(context.Background(), &group.NewAggregate("group1", "org1").Aggregate, "user1"),
+						),
+						eventFromEventPusher(
+							group.NewMemberRemovedEvent(context.Background(), &group.NewAggregate("group1", "org1").Aggregate, "user1"),
+						),
+					),
+				),
+			},
+			args: args{
+				ctx:     context.Background(),
+				groupID: "group1",
+				userID:  "user1",
+			},
+			res: res{
+				err: zerrors.ThrowNotFound(nil, "COMMAND-Grm2m", "Errors.Group.Member.NotFound"),
+			},
+		},
+		{
+			name: "remove member, ok",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(
+							groupAddedEvent("group1", ""),
+						),
+						eventFromEventPusher(
+							group.NewMemberAddedEvent(context.Background(), &group.NewAggregate("group1", "org1").Aggregate, "user1"),
+						),
+					),
+					expectPush(
+						group.NewMemberRemovedEvent(context.Background(), &group.NewAggregate("group1", "org1").Aggregate, "user1"),
+					),
+				),
+			},
+			args: args{
+				ctx:     context.Background(),
+				groupID: "group1",
+				userID:  "user1",
+			},
+			res: res{
+				want: &domain.ObjectDetails{
+					ResourceOwner: "org1",
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := &Commands{
+				eventstore: tt.fields.eventstore(t),
+			}
+			got, err := r.RemoveGroupMember(tt.args.ctx, tt.args.groupID, tt.args.userID, "org1")
+			assert.ErrorIs(t, err, tt.res.err)
+			assert.Equal(t, tt.res.want, got)
+		})
+	}
+}
@@ -0,0 +1,288 @@
+package command
+
+import (
+	"context"
+	"slices"
+
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/repository/group"
+	"github.com/zitadel/zitadel/internal/repository/project"
+)
+
+type GroupWriteModel struct {
+	eventstore.WriteModel
+
+	Name        string
+	Description string
+	ParentID    string
+	// Members are the IDs of the users which are direct members of the group
+	Members []string
+	// Grants are the project roles granted to the group, by project ID
+	Grants map[string]*groupGrant
+
+	State domain.GroupState
+}
+
+type groupGrant struct {
+	ProjectGrantID string
+	RoleKeys       []string
+}
+
+func NewGroupWriteModel(id, resourceOwner string) *GroupWriteModel {
+	return &GroupWriteModel{
+		WriteModel: eventstore.WriteModel{
+			AggregateID:   id,
+			ResourceOwner: resourceOwner,
+		},
+		Grants: make(map[string]*groupGrant),
+	}
+}
+
+func (wm *GroupWriteModel) Reduce() error {
+	for _, event := range wm.Events {
+		switch e := event.(type) {
+		case *group.AddedEvent:
+			wm.Name = e.Name
+			wm.Description = e.Description
+			wm.ParentID = e.ParentID
+			wm.State = domain.GroupStateActive
+		case *group.ChangedEvent:
+			if e.Name != nil {
+				wm.Name = *e.Name
+			}
+			if e.Description != nil {
+				wm.Description = *e.Description
+			}
+		case *group.ParentSetEvent:
+			wm.ParentID = e.ParentID
+		case *group.RemovedEvent:
+			wm.State = domain.GroupStateRemoved
+			wm.Members = nil
+			wm.Grants = make(map[string]*groupGrant)
+		case *group.MemberAddedEvent:
+			wm.Members = append(wm.Members, e.UserID)
+		case *group.MemberRemovedEvent:
+			wm.Members = slices.DeleteFunc(wm.Members, func(userID string) bool {
+				return userID == e.UserID
+			})
+		case *group.GrantAddedEvent:
+			wm.Grants[e.ProjectID] = &groupGrant{
+				ProjectGrantID: e.ProjectGrantID,
+				RoleKeys:       e.RoleKeys,
+			}
+		case *group.GrantChangedEvent:
+			if grant, ok := wm.Grants[e.ProjectID]; ok {
+				grant.RoleKeys = e.RoleKeys
+			}
+		case *group.GrantRemovedEvent:
+			delete(wm.Grants, e.ProjectID)
+		}
+	}
+	return wm.WriteModel.Reduce()
+}
+
+func (wm *GroupWriteModel) Query() *eventstore.SearchQueryBuilder {
+	return eventstore.NewSearchQueryBuilder(eventstore.ColumnsEvent).
+		ResourceOwner(wm.ResourceOwner).
+		AddQuery().
+		AggregateTypes(group.AggregateType).
+		AggregateIDs(wm.AggregateID).
+		EventTypes(
+			group.AddedEventType,
+			group.ChangedEventType,
+			group.ParentSetEventType,
+			group.RemovedEventType,
+			group.MemberAddedEventType,
+			group.MemberRemovedEventType,
+			group.GrantAddedEventType,
+			group.GrantChangedEventType,
+			group.GrantRemovedEventType,
+		).
+		Builder()
+}
+
+func (wm *GroupWriteModel) NewChangedEvent(
+	ctx context.Context,
+	agg *eventstore.Aggregate,
+	name *string,
+	description *string,
+) *group.ChangedEvent {
+	changes := make([]group.Changes, 0)
+	if name != nil && wm.Name != *name {
+		changes = append(changes, group.ChangeName(wm.Name, *name))
+	}
+	if description != nil && wm.Description != *description {
+		changes = append(changes, group.ChangeDescription(*description))
+	}
+	if len(changes) == 0 {
+		return nil
+	}
+	return group.NewChangedEvent(ctx, agg, changes)
+}
+
+func GroupAggregateFromWriteModel(wm *eventstore.WriteModel) *eventstore.Aggregate {
+	return &eventstore.Aggregate{
+		ID:            wm.AggregateID,
+		Type:          group.AggregateType,
+		ResourceOwner: wm.ResourceOwner,
+		InstanceID:    wm.InstanceID,
+		Version:       group.AggregateVersion,
+	}
+}
+
+// OrgGroupsReadModel holds the hierarchy of all groups of an organization,
+// which is needed to validate the nesting of groups.
+type OrgGroupsReadModel struct {
+	eventstore.WriteModel
+
+	// Parents maps the ID of every existing group to the ID of its parent group
+	Parents map[string]string
+}
+
+func NewOrgGroupsReadModel(resourceOwner string) *OrgGroupsReadModel {
+	return &OrgGroupsReadModel{
+		WriteModel: eventstore.WriteModel{
+			ResourceOwner: resourceOwner,
+		},
+		Parents: make(map[string]string),
+	}
+}
+
+func (rm *OrgGroupsReadModel) Reduce() error {
+	for _, event := range rm.Events {
+		switch e := event.(type) {
+		case *group.AddedEvent:
+			rm.Parents[e.Aggregate().ID] = e.ParentID
+		case *group.ParentSetEvent:
+			rm.Parents[e.Aggregate().ID] = e.ParentID
+		case *group.RemovedEvent:
+			delete(rm.Parents, e.Aggregate().ID)
+		}
+	}
+	return rm.WriteModel.Reduce()
+}
+
+func (rm *OrgGroupsReadModel) Query() *eventstore.SearchQueryBuilder {
+	return eventstore.NewSearchQueryBuilder(eventstore.ColumnsEvent).
+		ResourceOwner(rm.ResourceOwner).
+		AddQuery().
+		AggregateTypes(group.AggregateType).
+		EventTypes(
+			group.AddedEventType,
+			group.ParentSetEventType,
+			group.RemovedEventType,
+		).
+		Builder()
+}
+
+func (rm *OrgGroupsReadModel) Exists(groupID string) bool {
+	_, ok := rm.Parents[groupID]
+	return ok
+}
+
+// IsAncestor checks if the ancestorID is the group itself or one of the groups it is nested in.
+func (rm *OrgGroupsReadModel) IsAncestor(ancestorID, groupID string) bool {
+	// the visited groups protect against loops in corrupted hierarchies
+	visited := make(map[string]struct{}, len(rm.Parents))
+	for groupID != "" {
+		if groupID == ancestorID {
+			return true
+		}
+		if _, ok := visited[groupID]; ok {
+			return false
+		}
+		visited[groupID] = struct{}{}
+		groupID = rm.Parents[groupID]
+	}
+	return false
+}
+
+func (rm *OrgGroupsReadModel) HasNestedGroups(groupID string) bool {
+	for _, parentID := range rm.Parents {
+		if parentID == groupID {
+			return true
+		}
+	}
+	return false
+}
+
+// GroupGrantPreConditionReadModel checks the existence of the project (grant) and its roles
+// before they are granted to a group.
+type GroupGrantPreConditionReadModel struct {
+	eventstore.WriteModel
+
+	ProjectID          string
+	ProjectGrantID     string
+	ProjectExists      bool
+	ProjectGrantExists bool
+	ExistingRoleKeys   []string
+}
+
+func NewGroupGrantPreConditionReadModel(projectID, projectGrantID, resourceOwner string) *GroupGrantPreConditionReadModel {
+	return &GroupGrantPreConditionReadModel{
+		WriteModel: eventstore.WriteModel{
+			ResourceOwner: resourceOwner,
+		},
+		ProjectID:      projectID,
+		ProjectGrantID: projectGrantID,
+	}
+}
+
+func (rm *GroupGrantPreConditionReadModel) Reduce() error {
+	for _, event := range rm.Events {
+		switch e := event.(type) {
+		case *project.ProjectAddedEvent:
+			if rm.ProjectGrantID == "" && rm.ResourceOwner == e.Aggregate().ResourceOwner {
+				rm.ProjectExists = true
+			}
+		case *project.ProjectRemovedEvent:
+			rm.ProjectExists = false
+			rm.ProjectGrantExists = false
+		case *project.GrantAddedEvent:
+			if rm.ProjectGrantID == e.GrantID && rm.ResourceOwner == e.GrantedOrgID {
+				rm.ProjectGrantExists = true
+				rm.ExistingRoleKeys = e.RoleKeys
+			}
+		case *project.GrantChangedEvent:
+			if rm.ProjectGrantID == e.GrantID {
+				rm.ExistingRoleKeys = e.RoleKeys
+			}
+		case *project.GrantRemovedEvent:
+			if rm.ProjectGrantID == e.GrantID {
+				rm.ProjectGrantExists = false
+				rm.ExistingRoleKeys = nil
+			}
+		case *project.RoleAddedEvent:
+			if rm.ProjectGrantID != "" {
+				continue
+			}
+			rm.ExistingRoleKeys = append(rm.ExistingRoleKeys, e.Key)
+		case *project.RoleRemovedEvent:
+			if rm.ProjectGrantID != "" {
+				continue
+			}
+			rm.ExistingRoleKeys = slices.DeleteFunc(rm.ExistingRoleKeys, func(key string) bool {
+				return key == e.Key
+			})
+		}
+	}
+	return rm.WriteModel.Reduce()
+}
+
+func (rm *GroupGrantPreConditionReadModel) Query() *eventstore.SearchQueryBuilder {
+	return eventstore.NewSearchQueryBuilder(eventstore.ColumnsEvent).
+		AddQuery().
+		AggregateTypes(project.AggregateType).
+		AggregateIDs(rm.ProjectID).
+		EventTypes(
+			project.ProjectAddedType,
+			project.ProjectRemovedType,
+			project.GrantAddedType,
+			project.GrantChangedType,
+			project.GrantRemovedType,
+			project.RoleAddedType,
+			project.RoleRemovedType,
+		).
+		Builder()
+}
@@ -0,0 +1,569 @@
+package command
+
+import (
+	"context"
+	"testing"
+
+	"github.com/muhlemmer/gu"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/eventstore/v1/models"
+	"github.com/zitadel/zitadel/internal/id"
+	"github.com/zitadel/zitadel/internal/id/mock"
+	"github.com/zitadel/zitadel/internal/repository/group"
+	"github.com/zitadel/zitadel/internal/repository/org"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+func groupAddedEvent(groupID, parentID string) *group.AddedEvent {
+	return group.NewAddedEvent(context.Background(),
+		&group.NewAggregate(groupID, "org1").Aggregate,
+		groupID+"-name",
+		"",
+		parentID,
+	)
+}
+
+func TestCommandSide_AddGroup(t *testing.T) {
+	type fields struct {
+		eventstore  func(t *testing.T) *eventstore.Eventstore
+		idGenerator id.Generator
+	}
+	type args struct {
+		ctx           context.Context
+		add           *AddGroup
+		resourceOwner string
+	}
+	type res struct {
+		id   string
+		want *domain.ObjectDetails
+		err  error
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		args   args
+		res    res
+	}{
+		{
+			name: "no name, invalid argument error",
+			fields: fields{
+				eventstore: expectEventstore(),
+			},
+			args: args{
+				ctx:           context.Background(),
+				add:           &AddGroup{Name: " "},
+				resourceOwner: "org1",
+			},
+			res: res{
+				err: zerrors.ThrowInvalidArgument(nil, "COMMAND-Gr1nv", "Errors.Group.Invalid"),
+			},
+		},
+		{
+			name: "org not found, precondition error",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(),
+				),
+			},
+			args: args{
+				ctx:           context.Background(),
+				add:           &AddGroup{Name: "group1-name"},
+				resourceOwner: "org1",
+			},
+			res: res{
+				err: zerrors.ThrowPreconditionFailed(nil, "COMMAND-QXPGs", "Errors.Org.NotFound"),
+			},
+		},
+		{
+			name: "parent not found, precondition error",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(
+							org.NewOrgAddedEvent(context.Background(), &org.NewAggregate("org1").Aggregate, "org"),
+						),
+					),
+					expectFilter(),
+				),
+			},
+			args: args{
+				ctx:           context.Background(),
+				add:           &AddGroup{Name: "group1-name", ParentID: "parent"},
+				resourceOwner: "org1",
+			},
+			res: res{
+				err: zerrors.ThrowPreconditionFailed(nil, "COMMAND-Gr1pn", "Errors.Group.ParentNotFound"),
+			},
+		},
+		{
+			name: "add group, ok",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(
+							org.NewOrgAddedEvent(context.Background(), &org.NewAggregate("org1").Aggregate, "org"),
+						),
+					),
+					expectFilter(),
+					expectPush(
+						groupAddedEvent("group1", ""),
+					),
+				),
+				idGenerator: mock.ExpectID(t, "group1"),
+			},
+			args: args{
+				ctx:           context.Background(),
+				add:           &AddGroup{Name: "group1-name"},
+				resourceOwner: "org1",
+			},
+			res: res{
+				id: "group1",
+				want: &domain.ObjectDetails{
+					ResourceOwner: "org1",
+				},
+			},
+		},
+		{
+			name: "add nested group, ok",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(
+							org.NewOrgAddedEvent(context.Background(), &org.NewAggregate("org1").Aggregate, "org"),
+						),
+					),
+					expectFilter(
+						eventFromEventPusher(
+							groupAddedEvent("parent", ""),
+						),
+					),
+					expectFilter(),
+					expectPush(
+						groupAddedEvent("group1", "parent"),
+					),
+				),
+				idGenerator: mock.ExpectID(t, "group1"),
+			},
+			args: args{
+				ctx:           context.Background(),
+				add:           &AddGroup{Name: "group1-name", ParentID: "parent"},
+				resourceOwner: "org1",
+			},
+			res: res{
+				id: "group1",
+				want: &domain.ObjectDetails{
+					ResourceOwner: "org1",
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := &Commands{
+				eventstore:  tt.fields.eventstore(t),
+				idGenerator: tt.fields.idGenerator,
+			}
+			got, err := r.AddGroup(tt.args.ctx, tt.args.add, tt.args.resourceOwner)
+			assert.ErrorIs(t, err, tt.res.err)
+			assert.Equal(t, tt.res.want, got)
+			if tt.res.err == nil {
+				assert.Equal(t, tt.res.id, tt.args.add.AggregateID)
+			}
+		})
+	}
+}
+
+func TestCommandSide_ChangeGroup(t *testing.T) {
+	type fields struct {
+		eventstore func(t *testing.T) *eventstore.Eventstore
+	}
+	type args struct {
+		ctx    context.Context
+		change *ChangeGroup
+	}
+	type res struct {
+		want *domain.ObjectDetails
+		err  error
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		args   args
+		res    res
+	}{
+		{
+			name: "empty name, invalid argument error",
+			fields: fields{
+				eventstore: expectEventstore(),
+			},
+			args: args{
+				ctx: context.Background(),
+				change: &ChangeGroup{
+					ObjectRoot: models.ObjectRoot{AggregateID: "group1"},
+					Name:       gu.Ptr(""),
+				},
+			},
+			res: res{
+				err: zerrors.ThrowInvalidArgument(nil, "COMMAND-Gr2nv", "Errors.Group.Invalid"),
+			},
+		},
+		{
+			name: "group not found, not found error",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(),
+				),
+			},
+			args: args{
+				ctx: context.Background(),
+				change: &ChangeGroup{
+					ObjectRoot: models.ObjectRoot{AggregateID: "group1"},
+					Name:       gu.Ptr("new"),
+				},
+			},
+			res: res{
+				err: zerrors.ThrowNotFound(nil, "COMMAND-Gr2nf", "Errors.Group.NotFound"),
+			},
+		},
+		{
+			name: "no changes, ok",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(
+							groupAddedEvent("group1", ""),
+						),
+					),
+				),
+			},
+			args: args{
+				ctx: context.Background(),
+				change: &ChangeGroup{
+					ObjectRoot: models.ObjectRoot{AggregateID: "group1"},
+					Name:       gu.Ptr("group1-name"),
+				},
+			},
+			res: res{
+				want: &domain.ObjectDetails{
+					ResourceOwner: "org1",
+				},
+			},
+		},
+		{
+			name: "rename group, ok",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(
+							groupAddedEvent("group1", ""),
+						),
+					),
+					expectPush(
+						group.NewChangedEvent(context.Background(),
+							&group.NewAggregate("group1", "org1").Aggregate,
+							[]group.Changes{
+								group.ChangeName("group1-name", "new"),
+								group.ChangeDescription("description"),
+							},
+						),
+					),
+				),
+			},
+			args: args{
+				ctx: context.Background(),
+				change: &ChangeGroup{
+					ObjectRoot:  models.ObjectRoot{AggregateID: "group1"},
+					Name:        gu.Ptr("new"),
+					Description: gu.Ptr("description"),
+				},
+			},
+			res: res{
+				want: &domain.ObjectDetails{
+					ResourceOwner: "org1",
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := &Commands{
+				eventstore: tt.fields.eventstore(t),
+			}
+			got, err := r.ChangeGroup(tt.args.ctx, tt.args.change, "org1")
+			assert.ErrorIs(t, err, tt.res.err)
+			assert.Equal(t, tt.res.want, got)
+		})
+	}
+}
+
+func TestCommandSide_SetGroupParent(t *testing.T) {
+	type fields struct {
+		eventstore func(t *testing.T) *eventstore.Eventstore
+	}
+	type args struct {
+		ctx      context.Context
+		groupID  string
+		parentID string
+	}
+	type res struct {
+		want *domain.ObjectDetails
+		err  error
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		args   args
+		res    res
+	}{
+		{
+			name: "group not found, not found error",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(),
+				),
+			},
+			args: args{
+				ctx:      context.Background(),
+				groupID:  "group1",
+				parentID: "parent",
+			},
+			res: res{
+				err: zerrors.ThrowNotFound(nil, "COMMAND-Gr3nf", "Errors.Group.NotFound"),
+			},
+		},
+		{
+			name: "parent not found, precondition error",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(
+							groupAddedEvent("group1", ""),
+						),
+					),
+					expectFilter(
+						eventFromEventPusher(
+							groupAddedEvent("group1", ""),
+						),
+					),
+				),
+			},
+			args: args{
+				ctx:      context.Background(),
+				groupID:  "group1",
+				parentID: "parent",
+			},
+			res: res{
+				err: zerrors.ThrowPreconditionFailed(nil, "COMMAND-Gr3pn", "Errors.Group.ParentNotFound"),
+			},
+		},
+		{
+			name: "parent nested in group, precondition error",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(
+							groupAddedEvent("group1", ""),
+						),
+					),
+					expectFilter(
+						eventFromEventPusher(
+							groupAddedEvent("group1", ""),
+						),
+						eventFromEventPusher(
+							groupAddedEvent("child", "group1"),
+						),
+						eventFromEventPusher(
+							groupAddedEvent("grandchild", "child"),
+						),
+					),
+				),
+			},
+			args: args{
+				ctx:      context.Background(),
+				groupID:  "group1",
+				parentID: "grandchild",
+			},
+			res: res{
+				err: zerrors.ThrowPreconditionFailed(nil, "COMMAND-Gr3cy", "Errors.Group.NestingCycle"),
+			},
+		},
+		{
+			name: "nest group, ok",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(
+							groupAddedEvent("group1", ""),
+						),
+					),
+					expectFilter(
+						eventFromEventPusher(
+							groupAddedEvent("group1", ""),
+						),
+						eventFromEventPusher(
+							groupAddedEvent("parent", ""),
+						),
+					),
+					expectPush(
+						group.NewParentSetEvent(context.Background(), &group.NewAggregate("group1", "org1").Aggregate, "parent"),
+					),
+				),
+			},
+			args: args{
+				ctx:      context.Background(),
+				groupID:  "group1",
+				parentID: "parent",
+			},
+			res: res{
+				want: &domain.ObjectDetails{
+					ResourceOwner: "org1",
+				},
+			},
+		},
+		{
+			name: "move to top level, ok",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(
+							groupAddedEvent("group1", "parent"),
+						),
+					),
+					expectPush(
+						group.NewParentSetEvent(context.Background(), &group.NewAggregate("group1", "org1").Aggregate, ""),
+					),
+				),
+			},
+			args: args{
+				ctx:     context.Background(),
+				groupID: "group1",
+			},
+			res: res{
+				want: &domain.ObjectDetails{
+					ResourceOwner: "org1",
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := &Commands{
+				eventstore: tt.fields.eventstore(t),
+			}
+			got, err := r.SetGroupParent(tt.args.ctx, tt.args.groupID, tt.args.parentID, "org1")
+			assert.ErrorIs(t, err, tt.res.err)
+			assert.Equal(t, tt.res.want, got)
+		})
+	}
+}
+
+func TestCommandSide_RemoveGroup(t *testing.T) {
+	type fields struct {
+		eventstore func(t *testing.T) *eventstore.Eventstore
+	}
+	type args struct {
+		ctx     context.Context
+		groupID string
+	}
+	type res struct {
+		want *domain.ObjectDetails
+		err  error
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		args   args
+		res    res
+	}{
+		{
+			name: "group not found, not found error",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(),
+				),
+			},
+			args: args{
+				ctx:     context.Background(),
+				groupID: "group1",
+			},
+			res: res{
+				err: zerrors.ThrowNotFound(nil, "COMMAND-Gr4nf", "Errors.Group.NotFound"),
+			},
+		},
+		{
+			name: "nested groups, precondition error",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(
+							groupAddedEvent("group1", ""),
+						),
+					),
+					expectFilter(
+						eventFromEventPusher(
+							groupAddedEvent("group1", ""),
+						),
+						eventFromEventPusher(
+							groupAddedEvent("child", "group1"),
+						),
+					),
+				),
+			},
+			args: args{
+				ctx:     context.Background(),
+				groupID: "group1",
+			},
+			res: res{
+				err: zerrors.ThrowPreconditionFailed(nil, "COMMAND-Gr4ng", "Errors.Group.HasNestedGroups"),
+			},
+		},
+		{
+			name: "remove group, ok",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(
+							groupAddedEvent("group1", ""),
+						),
+					),
+					expectFilter(
+						eventFromEventPusher(
+							groupAddedEvent("group1", ""),
+						),
+						eventFromEventPusher(
+							groupAddedEvent("child", "group1"),
+						),
+						eventFromEventPusher(
+							group.NewRemovedEvent(context.Background(), &group.NewAggregate("child", "org1").Aggregate, "child-name"),
+						),
+					),
+					expectPush(
+						group.NewRemovedEvent(context.Background(), &group.NewAggregate("group1", "org1").Aggregate, "group1-name"),
+					),
+				),
+			},
+			args: args{
+				ctx:     context.Background(),
+				groupID: "group1",
+			},
+			res: res{
+				want: &domain.ObjectDetails{
+					ResourceOwner: "org1",
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := &Commands{
+				eventstore: tt.fields.eventstore(t),
+			}
+			got, err := r.RemoveGroup(tt.args.ctx, tt.args.groupID, "org1")
+			assert.ErrorIs(t, err, tt.res.err)
+			assert.Equal(t, tt.res.want, got)
+		})
+	}
+}
@@ -2,6 +2,7 @@ package command
 
 import (
 	"context"
+	"strings"
 	"time"
 
 	"github.com/zitadel/zitadel/internal/command/preparation"
@@ -20,6 +21,7 @@ type GenericOAuthProvider struct {
 	UserEndpoint          string
 	Scopes                []string
 	IDAttribute           string
+	AttributeMapping      *domain.OAuthAttributeMapping
 	IDPOptions            idp.Options
 }
 
@@ -49,7 +51,11 @@ type AzureADProvider struct {
 	Scopes        []string
 	Tenant        string
 	EmailVerified bool
-	IDPOptions    idp.Options
+	// AllowedTenants restricts the sign-in to users of the listed tenant IDs, all tenants are allowed if empty
+	AllowedTenants []string
+	// FetchGroups retrieves the groups of the user from Microsoft Graph, e.g. to use them in the role mapping
+	FetchGroups bool
+	IDPOptions  idp.Options
 }
 
 type GitHubProvider struct {
@@ -109,6 +115,11 @@ type LDAPProvider struct {
 	Timeout           time.Duration
 	LDAPAttributes    idp.LDAPAttributes
 	IDPOptions        idp.Options
+	// RootCA is the PEM encoded certificate (bundle) to verify the LDAP server, the system roots are used if empty
+	RootCA             []byte
+	GroupResolution    domain.LDAPGroupResolution
+	GroupBase          string
+	GroupNameAttribute string
 }
 
 type SAMLProvider struct {
@@ -217,3 +228,13 @@ func IDPProviderWriteModel(ctx context.Context, filter preparation.FilterToQuery
 
 	return allWriteModel, err
 }
+
+// validLDAPMetadataAttributes checks that each metadata key is mapped to an LDAP attribute
+func validLDAPMetadataAttributes(attributes map[string]string) bool {
+	for key, attribute := range attributes {
+		if strings.TrimSpace(key) == "" || strings.TrimSpace(attribute) == "" {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,113 @@
+package command
+
+import (
+	"context"
+	"slices"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/repository/instance"
+	"github.com/zitadel/zitadel/internal/repository/org"
+	"github.com/zitadel/zitadel/internal/telemetry/tracing"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+// SetOrgIDPAutoGrants replaces the project roles granted to users registered with the identity provider of the organization.
+// An empty list of grants removes the auto grants.
+func (c *Commands) SetOrgIDPAutoGrants(ctx context.Context, resourceOwner, idpID string, grants []*domain.IDPAutoGrant) (_ *domain.ObjectDetails, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	if resourceOwner == "" {
+		return nil, zerrors.ThrowInvalidArgument(nil, "COMMAND-Iag1o", "Errors.ResourceOwnerMissing")
+	}
+	if idpID == "" {
+		return nil, zerrors.ThrowInvalidArgument(nil, "COMMAND-Iag1i", "Errors.IDMissing")
+	}
+	if err = checkIDPAutoGrants(grants); err != nil {
+		return nil, err
+	}
+	exists, err := ExistsOrgIDP(ctx, c.eventstore.Filter, idpID, resourceOwner)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, zerrors.ThrowNotFound(nil, "COMMAND-Iag1n", "Errors.IDPConfig.NotExisting")
+	}
+	for _, grant := range grants {
+		if err = c.checkGroupGrantPreCondition(ctx, grant.ProjectID, grant.ProjectGrantID, grant.RoleKeys, resourceOwner); err != nil {
+			return nil, err
+		}
+	}
+	writeModel := NewOrgIDPAutoGrantsWriteModel(resourceOwner, idpID)
+	if err = c.eventstore.FilterToQueryReducer(ctx, writeModel); err != nil {
+		return nil, err
+	}
+	if slices.EqualFunc(writeModel.Grants, grants, idpAutoGrantEqual) {
+		return writeModelToObjectDetails(&writeModel.WriteModel), nil
+	}
+	if err = c.pushAppendAndReduce(ctx, writeModel,
+		org.NewIDPAutoGrantsSetEvent(ctx, &org.NewAggregate(resourceOwner).Aggregate, idpID, grants),
+	); err != nil {
+		return nil, err
+	}
+	return writeModelToObjectDetails(&writeModel.WriteModel), nil
+}
+
+// SetInstanceIDPAutoGrants replaces the project roles granted to users registered with the identity provider of the instance.
+// The project or project grant must be available to the organization the users are registered in.
+// An empty list of grants removes the auto grants.
+func (c *Commands) SetInstanceIDPAutoGrants(ctx context.Context, idpID string, grants []*domain.IDPAutoGrant) (_ *domain.ObjectDetails, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	if idpID == "" {
+		return nil, zerrors.ThrowInvalidArgument(nil, "COMMAND-Iag2i", "Errors.IDMissing")
+	}
+	if err = checkIDPAutoGrants(grants); err != nil {
+		return nil, err
+	}
+	exists, err := ExistsInstanceIDP(ctx, c.eventstore.Filter, idpID)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, zerrors.ThrowNotFound(nil, "COMMAND-Iag2n", "Errors.IDPConfig.NotExisting")
+	}
+	instanceID := authz.GetInstance(ctx).InstanceID()
+	writeModel := NewInstanceIDPAutoGrantsWriteModel(instanceID, idpID)
+	if err = c.eventstore.FilterToQueryReducer(ctx, writeModel); err != nil {
+		return nil, err
+	}
+	if slices.EqualFunc(writeModel.Grants, grants, idpAutoGrantEqual) {
+		return writeModelToObjectDetails(&writeModel.WriteModel), nil
+	}
+	if err = c.pushAppendAndReduce(ctx, writeModel,
+		instance.NewIDPAutoGrantsSetEvent(ctx, &instance.NewAggregate(instanceID).Aggregate, idpID, grants),
+	); err != nil {
+		return nil, err
+	}
+	return writeModelToObjectDetails(&writeModel.WriteModel), nil
+}
+
+func checkIDPAutoGrants(grants []*domain.IDPAutoGrant) error {
+	projects := make([]domain.IDPRoleMappingProject, 0, len(grants))
+	for _, grant := range grants {
+		if grant == nil || !grant.IsValid() {
+			return zerrors.ThrowInvalidArgument(nil, "COMMAND-Iag3i", "Errors.IDPConfig.AutoGrantInvalid")
+		}
+		project := domain.IDPRoleMappingProject{ProjectID: grant.ProjectID, ProjectGrantID: grant.ProjectGrantID}
+		// a user can only have one grant per project
+		if slices.Contains(projects, project) {
+			return zerrors.ThrowInvalidArgument(nil, "COMMAND-Iag3d", "Errors.IDPConfig.AutoGrantInvalid")
+		}
+		projects = append(projects, project)
+	}
+	return nil
+}
+
+func idpAutoGrantEqual(a, b *domain.IDPAutoGrant) bool {
+	return a.ProjectID == b.ProjectID &&
+		a.ProjectGrantID == b.ProjectGrantID &&
+		slices.Equal(a.RoleKeys, b.RoleKeys)
+}
@@ -0,0 +1,114 @@
+package command
+
+import (
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/repository/idp"
+	"github.com/zitadel/zitadel/internal/repository/instance"
+	"github.com/zitadel/zitadel/internal/repository/org"
+)
+
+type IDPAutoGrantsWriteModel struct {
+	eventstore.WriteModel
+
+	ID     string
+	Grants []*domain.IDPAutoGrant
+}
+
+func (wm *IDPAutoGrantsWriteModel) Reduce() error {
+	for _, event := range wm.Events {
+		switch e := event.(type) {
+		case *idp.AutoGrantsSetEvent:
+			wm.Grants = e.Grants
+		case *idp.RemovedEvent:
+			wm.Grants = nil
+		}
+	}
+	return wm.WriteModel.Reduce()
+}
+
+type OrgIDPAutoGrantsWriteModel struct {
+	IDPAutoGrantsWriteModel
+}
+
+func NewOrgIDPAutoGrantsWriteModel(orgID, id string) *OrgIDPAutoGrantsWriteModel {
+	return &OrgIDPAutoGrantsWriteModel{
+		IDPAutoGrantsWriteModel{
+			WriteModel: eventstore.WriteModel{
+				AggregateID:   orgID,
+				ResourceOwner: orgID,
+			},
+			ID: id,
+		},
+	}
+}
+
+func (wm *OrgIDPAutoGrantsWriteModel) AppendEvents(events ...eventstore.Event) {
+	for _, event := range events {
+		switch e := event.(type) {
+		case *org.IDPAutoGrantsSetEvent:
+			wm.IDPAutoGrantsWriteModel.AppendEvents(&e.AutoGrantsSetEvent)
+		case *org.IDPRemovedEvent:
+			wm.IDPAutoGrantsWriteModel.AppendEvents(&e.RemovedEvent)
+		default:
+			wm.IDPAutoGrantsWriteModel.AppendEvents(e)
+		}
+	}
+}
+
+func (wm *OrgIDPAutoGrantsWriteModel) Query() *eventstore.SearchQueryBuilder {
+	return eventstore.NewSearchQueryBuilder(eventstore.ColumnsEvent).
+		ResourceOwner(wm.ResourceOwner).
+		AddQuery().
+		AggregateTypes(org.AggregateType).
+		AggregateIDs(wm.AggregateID).
+		EventTypes(
+			org.IDPAutoGrantsSetEventType,
+			org.IDPRemovedEventType,
+		).
+		EventData(map[string]interface{}{"id": wm.ID}).
+		Builder()
+}
+
+type InstanceIDPAutoGrantsWriteModel struct {
+	IDPAutoGrantsWriteModel
+}
+
+func NewInstanceIDPAutoGrantsWriteModel(instanceID, id string) *InstanceIDPAutoGrantsWriteModel {
+	return &InstanceIDPAutoGrantsWriteModel{
+		IDPAutoGrantsWriteModel{
+			WriteModel: eventstore.WriteModel{
+				AggregateID:   instanceID,
+				ResourceOwner: instanceID,
+			},
+			ID: id,
+		},
+	}
+}
+
+func (wm *InstanceIDPAutoGrantsWriteModel) AppendEvents(events ...eventstore.Event) {
+	for _, event := range events {
+		switch e := event.(type) {
+		case *instance.IDPAutoGrantsSetEvent:
+			wm.IDPAutoGrantsWriteModel.AppendEvents(&e.AutoGrantsSetEvent)
+		case *instance.IDPRemovedEvent:
+			wm.IDPAutoGrantsWriteModel.AppendEvents(&e.RemovedEvent)
+		default:
+			wm.IDPAutoGrantsWriteModel.AppendEvents(e)
+		}
+	}
+}
+
+func (wm *InstanceIDPAutoGrantsWriteModel) Query() *eventstore.SearchQueryBuilder {
+	return eventstore.NewSearchQueryBuilder(eventstore.ColumnsEvent).
+		ResourceOwner(wm.ResourceOwner).
+		AddQuery().
+		AggregateTypes(instance.AggregateType).
+		AggregateIDs(wm.AggregateID).
+		EventTypes(
+			instance.IDPAutoGrantsSetEventType,
+			instance.IDPRemovedEventType,
+		).
+		EventData(map[string]interface{}{"id": wm.ID}).
+		Builder()
+}
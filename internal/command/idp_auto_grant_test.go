@@ -0,0 +1,304 @@
+package command
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/repository/instance"
+	"github.com/zitadel/zitadel/internal/repository/org"
+	"github.com/zitadel/zitadel/internal/repository/project"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+func TestCommandSide_SetOrgIDPAutoGrants(t *testing.T) {
+	grants := []*domain.IDPAutoGrant{
+		{ProjectID: "project1", RoleKeys: []string{"role1"}},
+	}
+	type fields struct {
+		eventstore func(t *testing.T) *eventstore.Eventstore
+	}
+	type args struct {
+		ctx           context.Context
+		resourceOwner string
+		idpID         string
+		grants        []*domain.IDPAutoGrant
+	}
+	type res struct {
+		want *domain.ObjectDetails
+		err  error
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		args   args
+		res    res
+	}{
+		{
+			name: "missing id, invalid argument error",
+			fields: fields{
+				eventstore: expectEventstore(),
+			},
+			args: args{
+				ctx:           context.Background(),
+				resourceOwner: "org1",
+				grants:        grants,
+			},
+			res: res{
+				err: zerrors.ThrowInvalidArgument(nil, "COMMAND-Iag1i", "Errors.IDMissing"),
+			},
+		},
+		{
+			name: "grant without roles, invalid argument error",
+			fields: fields{
+				eventstore: expectEventstore(),
+			},
+			args: args{
+				ctx:           context.Background(),
+				resourceOwner: "org1",
+				idpID:         "idp1",
+				grants:        []*domain.IDPAutoGrant{{ProjectID: "project1"}},
+			},
+			res: res{
+				err: zerrors.ThrowInvalidArgument(nil, "COMMAND-Iag3i", "Errors.IDPConfig.AutoGrantInvalid"),
+			},
+		},
+		{
+			name: "project granted twice, invalid argument error",
+			fields: fields{
+				eventstore: expectEventstore(),
+			},
+			args: args{
+				ctx:           context.Background(),
+				resourceOwner: "org1",
+				idpID:         "idp1",
+				grants: []*domain.IDPAutoGrant{
+					{ProjectID: "project1", RoleKeys: []string{"role1"}},
+					{ProjectID: "project1", RoleKeys: []string{"role2"}},
+				},
+			},
+			res: res{
+				err: zerrors.ThrowInvalidArgument(nil, "COMMAND-Iag3d", "Errors.IDPConfig.AutoGrantInvalid"),
+			},
+		},
+		{
+			name: "idp not found, not found error",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(),
+				),
+			},
+			args: args{
+				ctx:           context.Background(),
+				resourceOwner: "org1",
+				idpID:         "idp1",
+				grants:        grants,
+			},
+			res: res{
+				err: zerrors.ThrowNotFound(nil, "COMMAND-Iag1n", "Errors.IDPConfig.NotExisting"),
+			},
+		},
+		{
+			name: "role not found, precondition error",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(orgOAuthIDPAddedEvent("org1", "idp1")),
+					),
+					expectFilter(
+						eventFromEventPusher(
+							project.NewProjectAddedEvent(context.Background(), &project.NewAggregate("project1", "org1").Aggregate, "project", false, false, false, domain.PrivateLabelingSettingUnspecified),
+						),
+					),
+				),
+			},
+			args: args{
+				ctx:           context.Background(),
+				resourceOwner: "org1",
+				idpID:         "idp1",
+				grants:        grants,
+			},
+			res: res{
+				err: zerrors.ThrowPreconditionFailed(nil, "COMMAND-Grg5r", "Errors.Project.Role.NotFound"),
+			},
+		},
+		{
+			name: "set grants, ok",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(orgOAuthIDPAddedEvent("org1", "idp1")),
+					),
+					expectFilter(
+						eventFromEventPusher(
+							project.NewProjectAddedEvent(context.Background(), &project.NewAggregate("project1", "org1").Aggregate, "project", false, false, false, domain.PrivateLabelingSettingUnspecified),
+						),
+						eventFromEventPusher(
+							project.NewRoleAddedEvent(context.Background(), &project.NewAggregate("project1", "org1").Aggregate, "role1", "Role 1", ""),
+						),
+					),
+					expectFilter(),
+					expectPush(
+						org.NewIDPAutoGrantsSetEvent(context.Background(), &org.NewAggregate("org1").Aggregate, "idp1", grants),
+					),
+				),
+			},
+			args: args{
+				ctx:           context.Background(),
+				resourceOwner: "org1",
+				idpID:         "idp1",
+				grants:        grants,
+			},
+			res: res{
+				want: &domain.ObjectDetails{
+					ResourceOwner: "org1",
+				},
+			},
+		},
+		{
+			name: "remove grants, ok",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(orgOAuthIDPAddedEvent("org1", "idp1")),
+					),
+					expectFilter(
+						eventFromEventPusher(
+							org.NewIDPAutoGrantsSetEvent(context.Background(), &org.NewAggregate("org1").Aggregate, "idp1", grants),
+						),
+					),
+					expectPush(
+						org.NewIDPAutoGrantsSetEvent(context.Background(), &org.NewAggregate("org1").Aggregate, "idp1", nil),
+					),
+				),
+			},
+			args: args{
+				ctx:           context.Background(),
+				resourceOwner: "org1",
+				idpID:         "idp1",
+				grants:        nil,
+			},
+			res: res{
+				want: &domain.ObjectDetails{
+					ResourceOwner: "org1",
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := &Commands{
+				eventstore: tt.fields.eventstore(t),
+			}
+			got, err := r.SetOrgIDPAutoGrants(tt.args.ctx, tt.args.resourceOwner, tt.args.idpID, tt.args.grants)
+			assert.ErrorIs(t, err, tt.res.err)
+			assert.Equal(t, tt.res.want, got)
+		})
+	}
+}
+
+func TestCommandSide_SetInstanceIDPAutoGrants(t *testing.T) {
+	grants := []*domain.IDPAutoGrant{
+		{ProjectID: "project1", ProjectGrantID: "grant1", RoleKeys: []string{"role1"}},
+	}
+	type fields struct {
+		eventstore func(t *testing.T) *eventstore.Eventstore
+	}
+	type args struct {
+		ctx    context.Context
+		idpID  string
+		grants []*domain.IDPAutoGrant
+	}
+	type res struct {
+		want *domain.ObjectDetails
+		err  error
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		args   args
+		res    res
+	}{
+		{
+			name: "idp not found, not found error",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(),
+				),
+			},
+			args: args{
+				ctx:    authz.WithInstanceID(context.Background(), "instance1"),
+				idpID:  "idp1",
+				grants: grants,
+			},
+			res: res{
+				err: zerrors.ThrowNotFound(nil, "COMMAND-Iag2n", "Errors.IDPConfig.NotExisting"),
+			},
+		},
+		{
+			name: "grants not changed, ok",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(instanceOAuthIDPAddedEvent("instance1", "idp1")),
+					),
+					expectFilter(
+						eventFromEventPusher(
+							instance.NewIDPAutoGrantsSetEvent(context.Background(), &instance.NewAggregate("instance1").Aggregate, "idp1", grants),
+						),
+					),
+				),
+			},
+			args: args{
+				ctx:   authz.WithInstanceID(context.Background(), "instance1"),
+				idpID: "idp1",
+				grants: []*domain.IDPAutoGrant{
+					{ProjectID: "project1", ProjectGrantID: "grant1", RoleKeys: []string{"role1"}},
+				},
+			},
+			res: res{
+				want: &domain.ObjectDetails{
+					ResourceOwner: "instance1",
+				},
+			},
+		},
+		{
+			name: "set grants, ok",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(instanceOAuthIDPAddedEvent("instance1", "idp1")),
+					),
+					expectFilter(),
+					expectPush(
+						instance.NewIDPAutoGrantsSetEvent(context.Background(), &instance.NewAggregate("instance1").Aggregate, "idp1", grants),
+					),
+				),
+			},
+			args: args{
+				ctx:    authz.WithInstanceID(context.Background(), "instance1"),
+				idpID:  "idp1",
+				grants: grants,
+			},
+			res: res{
+				want: &domain.ObjectDetails{
+					ResourceOwner: "instance1",
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := &Commands{
+				eventstore: tt.fields.eventstore(t),
+			}
+			got, err := r.SetInstanceIDPAutoGrants(tt.args.ctx, tt.args.idpID, tt.args.grants)
+			assert.ErrorIs(t, err, tt.res.err)
+			assert.Equal(t, tt.res.want, got)
+		})
+	}
+}
@@ -0,0 +1,140 @@
+package command
+
+import (
+	"context"
+
+	"github.com/zitadel/logging"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/idp"
+	"github.com/zitadel/zitadel/internal/repository/instance"
+	"github.com/zitadel/zitadel/internal/repository/org"
+	"github.com/zitadel/zitadel/internal/telemetry/tracing"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+// IDPHealth is the result of the health check of an identity provider
+type IDPHealth struct {
+	Checks []*domain.IDPHealthCheck
+	// Failed contains the failed checks, it's empty if the identity provider is healthy
+	Failed []*domain.IDPHealthCheck
+}
+
+func (h *IDPHealth) Healthy() bool {
+	return len(h.Failed) == 0
+}
+
+// TestInstanceIDP checks the reachability (e.g. metadata fetch, token endpoint)
+// and the expiration of the certificates of the instance provider.
+// The result is only returned and the provider isn't flagged if it fails.
+func (c *Commands) TestInstanceIDP(ctx context.Context, id string) (_ *IDPHealth, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	writeModel, err := IDPProviderWriteModel(ctx, c.eventstore.Filter, id)
+	if err != nil {
+		return nil, err
+	}
+	if !writeModel.Instance {
+		return nil, zerrors.ThrowNotFound(nil, "INST-Ih2nf", "Errors.IDPConfig.NotExisting")
+	}
+	_, health := c.idpHealth(ctx, writeModel)
+	return health, nil
+}
+
+// CheckIDPsHealth checks the health of the identity providers of the instance.
+// A failing provider is flagged once and its owners are notified about it,
+// the flag is removed as soon as the provider is healthy again.
+// A failing check of a provider doesn't prevent the others.
+func (c *Commands) CheckIDPsHealth(ctx context.Context, ids []string) (err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	for _, id := range ids {
+		err := c.checkIDPHealth(ctx, id)
+		logging.WithFields("idp", id).OnError(err).Warn("idp health check failed")
+	}
+	return nil
+}
+
+func (c *Commands) checkIDPHealth(ctx context.Context, id string) error {
+	providerWriteModel, err := IDPProviderWriteModel(ctx, c.eventstore.Filter, id)
+	if err != nil {
+		return err
+	}
+	writeModel := newIDPHealthWriteModel(providerWriteModel.ResourceOwner, id, providerWriteModel.Instance)
+	if err = c.eventstore.FilterToQueryReducer(ctx, writeModel); err != nil {
+		return err
+	}
+	name, health := c.idpHealth(ctx, providerWriteModel)
+	var event eventstore.Command
+	switch {
+	case !health.Healthy() && !writeModel.failed:
+		event = idpHealthCheckFailedEvent(ctx, writeModel, name, health.Failed)
+	case health.Healthy() && writeModel.failed:
+		event = idpHealthCheckRecoveredEvent(ctx, writeModel)
+	default:
+		return nil
+	}
+	_, err = c.eventstore.Push(ctx, event)
+	return err
+}
+
+// IDPHealthCheckFailedNotificationSent marks the owners as notified about the failed health check of the identity provider.
+func (c *Commands) IDPHealthCheckFailedNotificationSent(ctx context.Context, resourceOwner, idpID string) error {
+	if resourceOwner == "" || idpID == "" {
+		return zerrors.ThrowInvalidArgument(nil, "COMMAND-Ih3ns", "Errors.IDMissing")
+	}
+	if resourceOwner == authz.GetInstance(ctx).InstanceID() {
+		_, err := c.eventstore.Push(ctx, instance.NewIDPHealthCheckFailedNotificationSentEvent(ctx, &instance.NewAggregate(resourceOwner).Aggregate, idpID))
+		return err
+	}
+	_, err := c.eventstore.Push(ctx, org.NewIDPHealthCheckFailedNotificationSentEvent(ctx, &org.NewAggregate(resourceOwner).Aggregate, idpID))
+	return err
+}
+
+// idpHealth runs the checks of the provider, a provider which can't be created from its configuration
+// (e.g. because the OIDC discovery failed) results in a failed configuration check.
+func (c *Commands) idpHealth(ctx context.Context, writeModel *AllIDPWriteModel) (name string, health *IDPHealth) {
+	var checks []*domain.IDPHealthCheck
+	provider, err := c.idpProviderForHealthCheck(writeModel)
+	if err != nil {
+		checks = []*domain.IDPHealthCheck{{
+			Type:  domain.IDPHealthCheckTypeConfiguration,
+			Error: err.Error(),
+		}}
+	} else {
+		name = provider.Name()
+		if checker, ok := provider.(idp.HealthChecker); ok {
+			checks = checker.CheckHealth(ctx)
+		}
+	}
+	return name, &IDPHealth{
+		Checks: checks,
+		Failed: domain.FailedIDPHealthChecks(checks, c.idpCertificateExpiryWarning),
+	}
+}
+
+func (c *Commands) idpProviderForHealthCheck(writeModel *AllIDPWriteModel) (idp.Provider, error) {
+	if writeModel.IDPType != domain.IDPTypeSAML {
+		return writeModel.ToProvider("", c.idpConfigEncryption)
+	}
+	// the request tracker is not used, since no authentication is started
+	return writeModel.ToSAMLProvider("", c.idpConfigEncryption, nil, nil)
+}
+
+func idpHealthCheckFailedEvent(ctx context.Context, writeModel *idpHealthWriteModel, name string, checks []*domain.IDPHealthCheck) eventstore.Command {
+	if writeModel.instanceIDP {
+		return instance.NewIDPHealthCheckFailedEvent(ctx, &instance.NewAggregate(writeModel.AggregateID).Aggregate, writeModel.ID, name, checks)
+	}
+	return org.NewIDPHealthCheckFailedEvent(ctx, &org.NewAggregate(writeModel.AggregateID).Aggregate, writeModel.ID, name, checks)
+}
+
+func idpHealthCheckRecoveredEvent(ctx context.Context, writeModel *idpHealthWriteModel) eventstore.Command {
+	if writeModel.instanceIDP {
+		return instance.NewIDPHealthCheckRecoveredEvent(ctx, &instance.NewAggregate(writeModel.AggregateID).Aggregate, writeModel.ID)
+	}
+	return org.NewIDPHealthCheckRecoveredEvent(ctx, &org.NewAggregate(writeModel.AggregateID).Aggregate, writeModel.ID)
+}
@@ -0,0 +1,66 @@
+package command
+
+import (
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/repository/instance"
+	"github.com/zitadel/zitadel/internal/repository/org"
+)
+
+// idpHealthWriteModel contains whether the identity provider is flagged because of a failed health check
+type idpHealthWriteModel struct {
+	eventstore.WriteModel
+
+	ID          string
+	instanceIDP bool
+	failed      bool
+}
+
+func newIDPHealthWriteModel(resourceOwner, id string, instanceIDP bool) *idpHealthWriteModel {
+	return &idpHealthWriteModel{
+		WriteModel: eventstore.WriteModel{
+			AggregateID:   resourceOwner,
+			ResourceOwner: resourceOwner,
+		},
+		ID:          id,
+		instanceIDP: instanceIDP,
+	}
+}
+
+func (wm *idpHealthWriteModel) Reduce() error {
+	for _, event := range wm.Events {
+		switch event.(type) {
+		case *org.IDPHealthCheckFailedEvent, *instance.IDPHealthCheckFailedEvent:
+			wm.failed = true
+		case *org.IDPHealthCheckRecoveredEvent, *instance.IDPHealthCheckRecoveredEvent:
+			wm.failed = false
+		}
+	}
+	return wm.WriteModel.Reduce()
+}
+
+func (wm *idpHealthWriteModel) Query() *eventstore.SearchQueryBuilder {
+	if wm.instanceIDP {
+		return eventstore.NewSearchQueryBuilder(eventstore.ColumnsEvent).
+			ResourceOwner(wm.ResourceOwner).
+			AddQuery().
+			AggregateTypes(instance.AggregateType).
+			AggregateIDs(wm.AggregateID).
+			EventTypes(
+				instance.IDPHealthCheckFailedEventType,
+				instance.IDPHealthCheckRecoveredEventType,
+			).
+			EventData(map[string]interface{}{"id": wm.ID}).
+			Builder()
+	}
+	return eventstore.NewSearchQueryBuilder(eventstore.ColumnsEvent).
+		ResourceOwner(wm.ResourceOwner).
+		AddQuery().
+		AggregateTypes(org.AggregateType).
+		AggregateIDs(wm.AggregateID).
+		EventTypes(
+			org.IDPHealthCheckFailedEventType,
+			org.IDPHealthCheckRecoveredEventType,
+		).
+		EventData(map[string]interface{}{"id": wm.ID}).
+		Builder()
+}
@@ -0,0 +1,328 @@
+package command
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/eventstore/repository"
+	"github.com/zitadel/zitadel/internal/repository/idp"
+	"github.com/zitadel/zitadel/internal/repository/instance"
+	"github.com/zitadel/zitadel/internal/repository/org"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+func TestCommandSide_CheckIDPsHealth(t *testing.T) {
+	healthy := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer healthy.Close()
+	unhealthy := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer unhealthy.Close()
+
+	jwtAdded := func(keysEndpoint string) *repository.Event {
+		return eventFromEventPusher(
+			org.NewJWTIDPAddedEvent(context.Background(), &org.NewAggregate("org1").Aggregate,
+				"id1",
+				"name",
+				"issuer",
+				"https://jwt.endpoint",
+				keysEndpoint,
+				"header",
+				idp.Options{},
+			),
+		)
+	}
+	failedChecks := []*domain.IDPHealthCheck{{
+		Type:   domain.IDPHealthCheckTypeMetadata,
+		Target: unhealthy.URL,
+		Error:  "unexpected status 500 Internal Server Error",
+	}}
+	type fields struct {
+		eventstore func(t *testing.T) *eventstore.Eventstore
+	}
+	tests := []struct {
+		name   string
+		fields fields
+	}{
+		{
+			name: "idp not existing, nothing done",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(),
+				),
+			},
+		},
+		{
+			name: "healthy, nothing done",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(jwtAdded(healthy.URL)),
+					expectFilter(jwtAdded(healthy.URL)),
+					expectFilter(),
+				),
+			},
+		},
+		{
+			name: "failed, flagged",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(jwtAdded(unhealthy.URL)),
+					expectFilter(jwtAdded(unhealthy.URL)),
+					expectFilter(),
+					expectPush(
+						org.NewIDPHealthCheckFailedEvent(context.Background(), &org.NewAggregate("org1").Aggregate,
+							"id1",
+							"name",
+							failedChecks,
+						),
+					),
+				),
+			},
+		},
+		{
+			name: "failed, already flagged",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(jwtAdded(unhealthy.URL)),
+					expectFilter(jwtAdded(unhealthy.URL)),
+					expectFilter(
+						eventFromEventPusher(
+							org.NewIDPHealthCheckFailedEvent(context.Background(), &org.NewAggregate("org1").Aggregate,
+								"id1",
+								"name",
+								failedChecks,
+							),
+						),
+					),
+				),
+			},
+		},
+		{
+			name: "healthy again, recovered",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(jwtAdded(healthy.URL)),
+					expectFilter(jwtAdded(healthy.URL)),
+					expectFilter(
+						eventFromEventPusher(
+							org.NewIDPHealthCheckFailedEvent(context.Background(), &org.NewAggregate("org1").Aggregate,
+								"id1",
+								"name",
+								failedChecks,
+							),
+						),
+					),
+					expectPush(
+						org.NewIDPHealthCheckRecoveredEvent(context.Background(), &org.NewAggregate("org1").Aggregate,
+							"id1",
+						),
+					),
+				),
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &Commands{
+				eventstore: tt.fields.eventstore(t),
+			}
+			err := c.CheckIDPsHealth(context.Background(), []string{"id1"})
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func TestCommandSide_TestInstanceIDP(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+	}))
+	defer server.Close()
+
+	type fields struct {
+		eventstore func(t *testing.T) *eventstore.Eventstore
+	}
+	type res struct {
+		want *IDPHealth
+		err  func(error) bool
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		res    res
+	}{
+		{
+			name: "not existing, precondition error",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(),
+				),
+			},
+			res: res{
+				err: zerrors.IsPreconditionFailed,
+			},
+		},
+		{
+			name: "org idp, not found error",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(
+							org.NewJWTIDPAddedEvent(context.Background(), &org.NewAggregate("org1").Aggregate,
+								"id1",
+								"name",
+								"issuer",
+								"https://jwt.endpoint",
+								server.URL,
+								"header",
+								idp.Options{},
+							),
+						),
+					),
+					expectFilter(),
+				),
+			},
+			res: res{
+				err: zerrors.IsNotFound,
+			},
+		},
+		{
+			name: "instance idp, failed check",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(
+							instance.NewJWTIDPAddedEvent(context.Background(), &instance.NewAggregate("instance1").Aggregate,
+								"id1",
+								"name",
+								"issuer",
+								"https://jwt.endpoint",
+								server.URL,
+								"header",
+								idp.Options{},
+							),
+						),
+					),
+					expectFilter(
+						eventFromEventPusher(
+							instance.NewJWTIDPAddedEvent(context.Background(), &instance.NewAggregate("instance1").Aggregate,
+								"id1",
+								"name",
+								"issuer",
+								"https://jwt.endpoint",
+								server.URL,
+								"header",
+								idp.Options{},
+							),
+						),
+					),
+				),
+			},
+			res: res{
+				want: &IDPHealth{
+					Checks: []*domain.IDPHealthCheck{{
+						Type:   domain.IDPHealthCheckTypeMetadata,
+						Target: server.URL,
+						Error:  "unexpected status 404 Not Found",
+					}},
+					Failed: []*domain.IDPHealthCheck{{
+						Type:   domain.IDPHealthCheckTypeMetadata,
+						Target: server.URL,
+						Error:  "unexpected status 404 Not Found",
+					}},
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &Commands{
+				eventstore: tt.fields.eventstore(t),
+			}
+			got, err := c.TestInstanceIDP(authz.WithInstanceID(context.Background(), "instance1"), "id1")
+			if tt.res.err != nil {
+				require.True(t, tt.res.err(err), err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.res.want, got)
+		})
+	}
+}
+
+func TestCommandSide_IDPHealthCheckFailedNotificationSent(t *testing.T) {
+	type fields struct {
+		eventstore func(t *testing.T) *eventstore.Eventstore
+	}
+	type args struct {
+		resourceOwner string
+		idpID         string
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		args   args
+		err    func(error) bool
+	}{
+		{
+			name: "missing id, invalid argument error",
+			fields: fields{
+				eventstore: expectEventstore(),
+			},
+			args: args{
+				resourceOwner: "org1",
+			},
+			err: zerrors.IsErrorInvalidArgument,
+		},
+		{
+			name: "org idp, ok",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectPush(
+						org.NewIDPHealthCheckFailedNotificationSentEvent(context.Background(), &org.NewAggregate("org1").Aggregate, "id1"),
+					),
+				),
+			},
+			args: args{
+				resourceOwner: "org1",
+				idpID:         "id1",
+			},
+		},
+		{
+			name: "instance idp, ok",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectPush(
+						instance.NewIDPHealthCheckFailedNotificationSentEvent(context.Background(), &instance.NewAggregate("instance1").Aggregate, "id1"),
+					),
+				),
+			},
+			args: args{
+				resourceOwner: "instance1",
+				idpID:         "id1",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &Commands{
+				eventstore: tt.fields.eventstore(t),
+			}
+			err := c.IDPHealthCheckFailedNotificationSent(authz.WithInstanceID(context.Background(), "instance1"), tt.args.resourceOwner, tt.args.idpID)
+			if tt.err != nil {
+				assert.True(t, tt.err(err), err)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
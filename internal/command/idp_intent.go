@@ -163,7 +163,7 @@ func (c *Commands) SucceedIDPIntent(ctx context.Context, writeModel *IDPIntentWr
 	if err != nil {
 		return "", err
 	}
-	accessToken, idToken, err := tokensForSucceededIDPIntent(idpSession, c.idpConfigEncryption)
+	accessToken, idToken, err := tokensForSucceededIDPIntent(idpSession, crypto.ForContext(ctx, c.idpConfigEncryption))
 	if err != nil {
 		return "", err
 	}
@@ -201,7 +201,7 @@ func (c *Commands) SucceedSAMLIDPIntent(ctx context.Context, writeModel *IDPInte
 	if err != nil {
 		return "", err
 	}
-	assertionEnc, err := crypto.Encrypt(assertionData, c.idpConfigEncryption)
+	assertionEnc, err := crypto.Encrypt(assertionData, crypto.ForContext(ctx, c.idpConfigEncryption))
 	if err != nil {
 		return "", err
 	}
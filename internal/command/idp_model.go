@@ -1,6 +1,7 @@
 package command
 
 import (
+	"bytes"
 	"net/http"
 	"reflect"
 	"slices"
@@ -44,6 +45,7 @@ type OAuthIDPWriteModel struct {
 	UserEndpoint          string
 	Scopes                []string
 	IDAttribute           string
+	AttributeMapping      *domain.OAuthAttributeMapping
 	idp.Options
 
 	State domain.IDPState
@@ -72,6 +74,7 @@ func (wm *OAuthIDPWriteModel) reduceAddedEvent(e *idp.OAuthIDPAddedEvent) {
 	wm.UserEndpoint = e.UserEndpoint
 	wm.Scopes = e.Scopes
 	wm.IDAttribute = e.IDAttribute
+	wm.AttributeMapping = e.AttributeMapping
 	wm.Options = e.Options
 	wm.State = domain.IDPStateActive
 }
@@ -101,6 +104,12 @@ func (wm *OAuthIDPWriteModel) reduceChangedEvent(e *idp.OAuthIDPChangedEvent) {
 	if e.IDAttribute != nil {
 		wm.IDAttribute = *e.IDAttribute
 	}
+	if e.AttributeMapping != nil {
+		wm.AttributeMapping = e.AttributeMapping
+		if e.AttributeMapping.IsZero() {
+			wm.AttributeMapping = nil
+		}
+	}
 	wm.Options.ReduceChanges(e.OptionChanges)
 }
 
@@ -114,6 +123,7 @@ func (wm *OAuthIDPWriteModel) NewChanges(
 	userEndpoint,
 	idAttribute string,
 	scopes []string,
+	attributeMapping *domain.OAuthAttributeMapping,
 	options idp.Options,
 ) ([]idp.OAuthIDPChanges, error) {
 	changes := make([]idp.OAuthIDPChanges, 0)
@@ -147,6 +157,13 @@ func (wm *OAuthIDPWriteModel) NewChanges(
 	if wm.IDAttribute != idAttribute {
 		changes = append(changes, idp.ChangeOAuthIDAttribute(idAttribute))
 	}
+	if !wm.AttributeMapping.Equal(attributeMapping) {
+		// an empty mapping is set explicitly to remove the existing one
+		if attributeMapping == nil {
+			attributeMapping = new(domain.OAuthAttributeMapping)
+		}
+		changes = append(changes, idp.ChangeOAuthAttributeMapping(attributeMapping))
+	}
 	opts := wm.Options.Changes(options)
 	if !opts.IsZero() {
 		changes = append(changes, idp.ChangeOAuthOptions(opts))
@@ -169,6 +186,10 @@ func (wm *OAuthIDPWriteModel) ToProvider(callbackURL string, idpAlg crypto.Encry
 		RedirectURL: callbackURL,
 		Scopes:      wm.Scopes,
 	}
+	attributeMapping, err := oauth.NewAttributeMapping(wm.AttributeMapping)
+	if err != nil {
+		return nil, err
+	}
 	opts := make([]oauth.ProviderOpts, 0, 4)
 	if wm.IsCreationAllowed {
 		opts = append(opts, oauth.WithCreationAllowed())
@@ -187,7 +208,7 @@ func (wm *OAuthIDPWriteModel) ToProvider(callbackURL string, idpAlg crypto.Encry
 		wm.Name,
 		wm.UserEndpoint,
 		func() providers.User {
-			return oauth.NewUserMapper(wm.IDAttribute)
+			return oauth.NewUserMapperWithAttributeMapping(wm.IDAttribute, attributeMapping)
 		},
 		opts...,
 	)
@@ -578,6 +599,8 @@ type AzureADIDPWriteModel struct {
 	Scopes          []string
 	Tenant          string
 	IsEmailVerified bool
+	AllowedTenants  []string
+	FetchGroups     bool
 	idp.Options
 
 	State domain.IDPState
@@ -606,6 +629,8 @@ func (wm *AzureADIDPWriteModel) reduceAddedEvent(e *idp.AzureADIDPAddedEvent) {
 	wm.Scopes = e.Scopes
 	wm.Tenant = e.Tenant
 	wm.IsEmailVerified = e.IsEmailVerified
+	wm.AllowedTenants = e.AllowedTenants
+	wm.FetchGroups = e.FetchGroups
 	wm.Options = e.Options
 	wm.State = domain.IDPStateActive
 }
@@ -629,6 +654,12 @@ func (wm *AzureADIDPWriteModel) reduceChangedEvent(e *idp.AzureADIDPChangedEvent
 	if e.IsEmailVerified != nil {
 		wm.IsEmailVerified = *e.IsEmailVerified
 	}
+	if e.AllowedTenants != nil {
+		wm.AllowedTenants = *e.AllowedTenants
+	}
+	if e.FetchGroups != nil {
+		wm.FetchGroups = *e.FetchGroups
+	}
 	wm.Options.ReduceChanges(e.OptionChanges)
 }
 
@@ -640,6 +671,8 @@ func (wm *AzureADIDPWriteModel) NewChanges(
 	scopes []string,
 	tenant string,
 	isEmailVerified bool,
+	allowedTenants []string,
+	fetchGroups bool,
 	options idp.Options,
 ) ([]idp.AzureADIDPChanges, error) {
 	changes := make([]idp.AzureADIDPChanges, 0)
@@ -667,6 +700,12 @@ func (wm *AzureADIDPWriteModel) NewChanges(
 	if !reflect.DeepEqual(wm.Scopes, scopes) {
 		changes = append(changes, idp.ChangeAzureADScopes(scopes))
 	}
+	if !slices.Equal(wm.AllowedTenants, allowedTenants) {
+		changes = append(changes, idp.ChangeAzureADAllowedTenants(allowedTenants))
+	}
+	if wm.FetchGroups != fetchGroups {
+		changes = append(changes, idp.ChangeAzureADFetchGroups(fetchGroups))
+	}
 
 	opts := wm.Options.Changes(options)
 	if !opts.IsZero() {
@@ -679,13 +718,19 @@ func (wm *AzureADIDPWriteModel) ToProvider(callbackURL string, idpAlg crypto.Enc
 	if err != nil {
 		return nil, err
 	}
-	opts := make([]azuread.ProviderOptions, 0, 3)
+	opts := make([]azuread.ProviderOptions, 0, 5)
 	if wm.IsEmailVerified {
 		opts = append(opts, azuread.WithEmailVerified())
 	}
 	if wm.Tenant != "" {
 		opts = append(opts, azuread.WithTenant(azuread.TenantType(wm.Tenant)))
 	}
+	if len(wm.AllowedTenants) > 0 {
+		opts = append(opts, azuread.WithAllowedTenants(wm.AllowedTenants...))
+	}
+	if wm.FetchGroups {
+		opts = append(opts, azuread.WithGroups())
+	}
 	oauthOpts := make([]oauth.ProviderOpts, 0, 4)
 	if wm.IsCreationAllowed {
 		oauthOpts = append(oauthOpts, oauth.WithCreationAllowed())
@@ -1366,6 +1411,11 @@ type LDAPIDPWriteModel struct {
 	UserObjectClasses []string
 	UserFilters       []string
 	Timeout           time.Duration
+	RootCA            []byte
+
+	GroupResolution    domain.LDAPGroupResolution
+	GroupBase          string
+	GroupNameAttribute string
 	idp.LDAPAttributes
 	idp.Options
 
@@ -1406,6 +1456,10 @@ func (wm *LDAPIDPWriteModel) reduceAddedEvent(e *idp.LDAPIDPAddedEvent) {
 	wm.UserObjectClasses = e.UserObjectClasses
 	wm.UserFilters = e.UserFilters
 	wm.Timeout = e.Timeout
+	wm.RootCA = e.RootCA
+	wm.GroupResolution = e.GroupResolution
+	wm.GroupBase = e.GroupBase
+	wm.GroupNameAttribute = e.GroupNameAttribute
 	wm.LDAPAttributes = e.LDAPAttributes
 	wm.Options = e.Options
 	wm.State = domain.IDPStateActive
@@ -1445,6 +1499,18 @@ func (wm *LDAPIDPWriteModel) reduceChangedEvent(e *idp.LDAPIDPChangedEvent) {
 	if e.Timeout != nil {
 		wm.Timeout = *e.Timeout
 	}
+	if e.RootCA != nil {
+		wm.RootCA = *e.RootCA
+	}
+	if e.GroupResolution != nil {
+		wm.GroupResolution = *e.GroupResolution
+	}
+	if e.GroupBase != nil {
+		wm.GroupBase = *e.GroupBase
+	}
+	if e.GroupNameAttribute != nil {
+		wm.GroupNameAttribute = *e.GroupNameAttribute
+	}
 	wm.LDAPAttributes.ReduceChanges(e.LDAPAttributeChanges)
 	wm.Options.ReduceChanges(e.OptionChanges)
 }
@@ -1460,6 +1526,10 @@ func (wm *LDAPIDPWriteModel) NewChanges(
 	userObjectClasses []string,
 	userFilters []string,
 	timeout time.Duration,
+	rootCA []byte,
+	groupResolution domain.LDAPGroupResolution,
+	groupBase string,
+	groupNameAttribute string,
 	secretCrypto crypto.EncryptionAlgorithm,
 	attributes idp.LDAPAttributes,
 	options idp.Options,
@@ -1501,6 +1571,18 @@ func (wm *LDAPIDPWriteModel) NewChanges(
 	if wm.Timeout != timeout {
 		changes = append(changes, idp.ChangeLDAPTimeout(timeout))
 	}
+	if !bytes.Equal(wm.RootCA, rootCA) {
+		changes = append(changes, idp.ChangeLDAPRootCA(rootCA))
+	}
+	if wm.GroupResolution != groupResolution {
+		changes = append(changes, idp.ChangeLDAPGroupResolution(groupResolution))
+	}
+	if wm.GroupBase != groupBase {
+		changes = append(changes, idp.ChangeLDAPGroupBase(groupBase))
+	}
+	if wm.GroupNameAttribute != groupNameAttribute {
+		changes = append(changes, idp.ChangeLDAPGroupNameAttribute(groupNameAttribute))
+	}
 	attrs := wm.LDAPAttributes.Changes(attributes)
 	if !attrs.IsZero() {
 		changes = append(changes, idp.ChangeLDAPAttributes(attrs))
@@ -1521,6 +1603,15 @@ func (wm *LDAPIDPWriteModel) ToProvider(callbackURL string, idpAlg crypto.Encryp
 	if !wm.StartTLS {
 		opts = append(opts, ldap.WithoutStartTLS())
 	}
+	if len(wm.RootCA) > 0 {
+		opts = append(opts, ldap.WithRootCA(wm.RootCA))
+	}
+	if wm.GroupResolution != domain.LDAPGroupResolutionUnspecified {
+		opts = append(opts, ldap.WithGroups(wm.GroupResolution, wm.GroupBase, wm.GroupNameAttribute))
+	}
+	if len(wm.LDAPAttributes.MetadataAttributes) > 0 {
+		opts = append(opts, ldap.WithMetadataAttributes(wm.LDAPAttributes.MetadataAttributes))
+	}
 	if wm.LDAPAttributes.IDAttribute != "" {
 		opts = append(opts, ldap.WithCustomIDAttribute(wm.LDAPAttributes.IDAttribute))
 	}
@@ -1729,6 +1820,7 @@ type SAMLIDPWriteModel struct {
 	Name                          string
 	ID                            string
 	Metadata                      []byte
+	MetadataURL                   string
 	Key                           *crypto.CryptoValue
 	Certificate                   []byte
 	Binding                       string
@@ -1757,6 +1849,7 @@ func (wm *SAMLIDPWriteModel) Reduce() error {
 func (wm *SAMLIDPWriteModel) reduceAddedEvent(e *idp.SAMLIDPAddedEvent) {
 	wm.Name = e.Name
 	wm.Metadata = e.Metadata
+	wm.MetadataURL = e.MetadataURL
 	wm.Key = e.Key
 	wm.Certificate = e.Certificate
 	wm.Binding = e.Binding
@@ -1780,6 +1873,9 @@ func (wm *SAMLIDPWriteModel) reduceChangedEvent(e *idp.SAMLIDPChangedEvent) {
 	if e.Metadata != nil {
 		wm.Metadata = e.Metadata
 	}
+	if e.MetadataURL != nil {
+		wm.MetadataURL = *e.MetadataURL
+	}
 	if e.Binding != nil {
 		wm.Binding = *e.Binding
 	}
@@ -1797,7 +1893,8 @@ func (wm *SAMLIDPWriteModel) reduceChangedEvent(e *idp.SAMLIDPChangedEvent) {
 
 func (wm *SAMLIDPWriteModel) NewChanges(
 	name string,
-	metadata,
+	metadata []byte,
+	metadataURL string,
 	key,
 	certificate []byte,
 	secretCrypto crypto.EncryptionAlgorithm,
@@ -1824,6 +1921,9 @@ func (wm *SAMLIDPWriteModel) NewChanges(
 	if !reflect.DeepEqual(wm.Metadata, metadata) {
 		changes = append(changes, idp.ChangeSAMLMetadata(metadata))
 	}
+	if wm.MetadataURL != metadataURL {
+		changes = append(changes, idp.ChangeSAMLMetadataURL(metadataURL))
+	}
 	if wm.Binding != binding {
 		changes = append(changes, idp.ChangeSAMLBinding(binding))
 	}
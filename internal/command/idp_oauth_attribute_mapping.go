@@ -0,0 +1,95 @@
+package command
+
+import (
+	"context"
+	"strings"
+
+	"github.com/zitadel/oidc/v3/pkg/oidc"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/idp"
+	"github.com/zitadel/zitadel/internal/idp/providers/oauth"
+	"github.com/zitadel/zitadel/internal/telemetry/tracing"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+// OAuthAttributeMappingTest is the result of fetching the user information of a generic OAuth provider
+// and mapping it into the attributes of the user.
+type OAuthAttributeMappingTest struct {
+	// RawInfo is the information as returned by the user endpoint
+	RawInfo map[string]interface{}
+	// User contains the attributes (incl. metadata) mapped from the RawInfo
+	User *domain.ExternalUser
+}
+
+// TestInstanceGenericOAuthProviderAttributeMapping fetches the user information of the instance provider
+// using the access token and maps it with the provided attribute mapping,
+// which allows to check a mapping before it is saved.
+// If no mapping is provided, the current mapping of the provider is used.
+func (c *Commands) TestInstanceGenericOAuthProviderAttributeMapping(ctx context.Context, id, accessToken string, attributeMapping *domain.OAuthAttributeMapping) (_ *OAuthAttributeMappingTest, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	writeModel := NewOAuthInstanceIDPWriteModel(authz.GetInstance(ctx).InstanceID(), id)
+	if err = c.eventstore.FilterToQueryReducer(ctx, writeModel); err != nil {
+		return nil, err
+	}
+	if !writeModel.State.Exists() {
+		return nil, zerrors.ThrowNotFound(nil, "INST-Oat1f", "Errors.IDPConfig.NotExisting")
+	}
+	return c.testOAuthAttributeMapping(ctx, &writeModel.OAuthIDPWriteModel, accessToken, attributeMapping)
+}
+
+// TestOrgGenericOAuthProviderAttributeMapping fetches the user information of the organization provider
+// using the access token and maps it with the provided attribute mapping,
+// which allows to check a mapping before it is saved.
+// If no mapping is provided, the current mapping of the provider is used.
+func (c *Commands) TestOrgGenericOAuthProviderAttributeMapping(ctx context.Context, resourceOwner, id, accessToken string, attributeMapping *domain.OAuthAttributeMapping) (_ *OAuthAttributeMappingTest, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	writeModel := NewOAuthOrgIDPWriteModel(resourceOwner, id)
+	if err = c.eventstore.FilterToQueryReducer(ctx, writeModel); err != nil {
+		return nil, err
+	}
+	if !writeModel.State.Exists() {
+		return nil, zerrors.ThrowNotFound(nil, "ORG-Oat1f", "Errors.IDPConfig.NotExisting")
+	}
+	return c.testOAuthAttributeMapping(ctx, &writeModel.OAuthIDPWriteModel, accessToken, attributeMapping)
+}
+
+func (c *Commands) testOAuthAttributeMapping(ctx context.Context, writeModel *OAuthIDPWriteModel, accessToken string, attributeMapping *domain.OAuthAttributeMapping) (*OAuthAttributeMappingTest, error) {
+	if accessToken = strings.TrimSpace(accessToken); accessToken == "" {
+		return nil, zerrors.ThrowInvalidArgument(nil, "COMMAND-Oat2f", "Errors.Invalid.Argument")
+	}
+	if attributeMapping == nil {
+		attributeMapping = writeModel.AttributeMapping
+	}
+	mapping, err := oauth.NewAttributeMapping(attributeMapping)
+	if err != nil {
+		return nil, zerrors.ThrowInvalidArgument(err, "COMMAND-Oat3f", "Errors.IDPConfig.AttributeMappingInvalid")
+	}
+	mapper := oauth.NewUserMapperWithAttributeMapping(writeModel.IDAttribute, mapping)
+	if err = oauth.FetchUserInfo(ctx, c.httpClient, writeModel.UserEndpoint, oidc.BearerToken, accessToken, mapper); err != nil {
+		return nil, zerrors.ThrowPreconditionFailed(err, "COMMAND-Oat4f", "Errors.IDPConfig.UserInfoNotFetched")
+	}
+	return &OAuthAttributeMappingTest{
+		RawInfo: mapper.RawInfo,
+		User: &domain.ExternalUser{
+			IDPConfigID:       writeModel.ID,
+			ExternalUserID:    mapper.GetID(),
+			PreferredUsername: mapper.GetPreferredUsername(),
+			DisplayName:       mapper.GetDisplayName(),
+			FirstName:         mapper.GetFirstName(),
+			LastName:          mapper.GetLastName(),
+			NickName:          mapper.GetNickname(),
+			Email:             mapper.GetEmail(),
+			IsEmailVerified:   mapper.IsEmailVerified(),
+			PreferredLanguage: mapper.GetPreferredLanguage(),
+			Phone:             mapper.GetPhone(),
+			IsPhoneVerified:   mapper.IsPhoneVerified(),
+			Metadatas:         idp.Metadata(mapper),
+		},
+	}, nil
+}
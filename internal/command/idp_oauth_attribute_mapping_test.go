@@ -0,0 +1,234 @@
+package command
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/text/language"
+
+	"github.com/zitadel/zitadel/internal/crypto"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/repository/idp"
+	"github.com/zitadel/zitadel/internal/repository/org"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+func TestCommandSide_TestOrgGenericOAuthProviderAttributeMapping(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("authorization") != "Bearer token" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"id":"user1","data":{"email":"user@example.com","verified":true,"first":"Jane","last":"Doe","tenant":"acme"}}`))
+	}))
+	t.Cleanup(server.Close)
+
+	providerAdded := func(attributeMapping *domain.OAuthAttributeMapping) eventstore.Command {
+		return org.NewOAuthIDPAddedEvent(context.Background(), &org.NewAggregate("org1").Aggregate,
+			"id1",
+			"name",
+			"clientID",
+			&crypto.CryptoValue{
+				CryptoType: crypto.TypeEncryption,
+				Algorithm:  "enc",
+				KeyID:      "id",
+				Crypted:    []byte("clientSecret"),
+			},
+			"auth",
+			"token",
+			server.URL,
+			"id",
+			nil,
+			attributeMapping,
+			idp.Options{},
+		)
+	}
+
+	type fields struct {
+		eventstore func(*testing.T) *eventstore.Eventstore
+	}
+	type args struct {
+		accessToken      string
+		attributeMapping *domain.OAuthAttributeMapping
+	}
+	type res struct {
+		rawInfo map[string]interface{}
+		user    *domain.ExternalUser
+		err     func(error) bool
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		args   args
+		res    res
+	}{
+		{
+			name: "not existing",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(),
+				),
+			},
+			args: args{
+				accessToken: "token",
+			},
+			res: res{
+				err: zerrors.IsNotFound,
+			},
+		},
+		{
+			name: "missing access token",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(providerAdded(nil)),
+					),
+				),
+			},
+			args: args{},
+			res: res{
+				err: zerrors.IsErrorInvalidArgument,
+			},
+		},
+		{
+			name: "invalid mapping",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(providerAdded(nil)),
+					),
+				),
+			},
+			args: args{
+				accessToken:      "token",
+				attributeMapping: &domain.OAuthAttributeMapping{Email: ".data.email +"},
+			},
+			res: res{
+				err: zerrors.IsErrorInvalidArgument,
+			},
+		},
+		{
+			name: "fetch failed",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(providerAdded(nil)),
+					),
+				),
+			},
+			args: args{
+				accessToken: "invalid",
+			},
+			res: res{
+				err: zerrors.IsPreconditionFailed,
+			},
+		},
+		{
+			name: "existing mapping, ok",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(providerAdded(&domain.OAuthAttributeMapping{
+							Email: ".data.email",
+						})),
+					),
+				),
+			},
+			args: args{
+				accessToken: "token",
+			},
+			res: res{
+				rawInfo: map[string]interface{}{
+					"id": "user1",
+					"data": map[string]interface{}{
+						"email":    "user@example.com",
+						"verified": true,
+						"first":    "Jane",
+						"last":     "Doe",
+						"tenant":   "acme",
+					},
+				},
+				user: &domain.ExternalUser{
+					IDPConfigID:       "id1",
+					ExternalUserID:    "user1",
+					Email:             "user@example.com",
+					PreferredLanguage: language.Und,
+				},
+			},
+		},
+		{
+			name: "provided mapping, ok",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(providerAdded(&domain.OAuthAttributeMapping{
+							Email: ".data.email",
+						})),
+					),
+				),
+			},
+			args: args{
+				accessToken: "token",
+				attributeMapping: &domain.OAuthAttributeMapping{
+					FirstName:         ".data.first",
+					LastName:          ".data.last",
+					DisplayName:       `.data.first + " " + .data.last`,
+					PreferredUsername: ".data.username // .data.email",
+					Email:             ".data.email",
+					EmailVerified:     ".data.verified",
+					Metadata: map[string]string{
+						"tenant": ".data.tenant",
+					},
+				},
+			},
+			res: res{
+				rawInfo: map[string]interface{}{
+					"id": "user1",
+					"data": map[string]interface{}{
+						"email":    "user@example.com",
+						"verified": true,
+						"first":    "Jane",
+						"last":     "Doe",
+						"tenant":   "acme",
+					},
+				},
+				user: &domain.ExternalUser{
+					IDPConfigID:       "id1",
+					ExternalUserID:    "user1",
+					PreferredUsername: "user@example.com",
+					DisplayName:       "Jane Doe",
+					FirstName:         "Jane",
+					LastName:          "Doe",
+					Email:             "user@example.com",
+					IsEmailVerified:   true,
+					PreferredLanguage: language.Und,
+					Metadatas: []*domain.Metadata{
+						{Key: "tenant", Value: []byte("acme")},
+					},
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &Commands{
+				eventstore: tt.fields.eventstore(t),
+				httpClient: http.DefaultClient,
+			}
+			got, err := c.TestOrgGenericOAuthProviderAttributeMapping(context.Background(), "org1", "id1", tt.args.accessToken, tt.args.attributeMapping)
+			if tt.res.err != nil {
+				assert.True(t, tt.res.err(err), "unexpected error: %v", err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.res.rawInfo, got.RawInfo)
+			assert.Equal(t, tt.res.user, got.User)
+		})
+	}
+}
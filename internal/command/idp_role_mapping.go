@@ -0,0 +1,233 @@
+package command
+
+import (
+	"context"
+	"slices"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/repository/instance"
+	"github.com/zitadel/zitadel/internal/repository/org"
+	"github.com/zitadel/zitadel/internal/repository/usergrant"
+	"github.com/zitadel/zitadel/internal/telemetry/tracing"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+// SetOrgIDPRoleMapping replaces the mappings of the claims provided by the identity provider of the organization
+// to memberships and user grants of the organization.
+// An empty list of mappings removes the role mapping.
+func (c *Commands) SetOrgIDPRoleMapping(ctx context.Context, resourceOwner, idpID string, mappings []*domain.IDPRoleMapping) (_ *domain.ObjectDetails, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	if resourceOwner == "" {
+		return nil, zerrors.ThrowInvalidArgument(nil, "COMMAND-Irm1o", "Errors.ResourceOwnerMissing")
+	}
+	if idpID == "" {
+		return nil, zerrors.ThrowInvalidArgument(nil, "COMMAND-Irm1i", "Errors.IDMissing")
+	}
+	if err = c.checkIDPRoleMappings(mappings); err != nil {
+		return nil, err
+	}
+	exists, err := ExistsOrgIDP(ctx, c.eventstore.Filter, idpID, resourceOwner)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, zerrors.ThrowNotFound(nil, "COMMAND-Irm1n", "Errors.IDPConfig.NotExisting")
+	}
+	for _, project := range domain.IDPRoleMappingProjects(mappings) {
+		if err = c.checkGroupGrantPreCondition(ctx, project.ProjectID, project.ProjectGrantID, idpRoleMappingProjectRoleKeys(mappings, project), resourceOwner); err != nil {
+			return nil, err
+		}
+	}
+	writeModel := NewOrgIDPRoleMappingWriteModel(resourceOwner, idpID)
+	if err = c.eventstore.FilterToQueryReducer(ctx, writeModel); err != nil {
+		return nil, err
+	}
+	if slices.EqualFunc(writeModel.Mappings, mappings, idpRoleMappingEqual) {
+		return writeModelToObjectDetails(&writeModel.WriteModel), nil
+	}
+	if err = c.pushAppendAndReduce(ctx, writeModel,
+		org.NewIDPRoleMappingSetEvent(ctx, &org.NewAggregate(resourceOwner).Aggregate, idpID, mappings),
+	); err != nil {
+		return nil, err
+	}
+	return writeModelToObjectDetails(&writeModel.WriteModel), nil
+}
+
+// SetInstanceIDPRoleMapping replaces the mappings of the claims provided by the identity provider of the instance
+// to memberships and user grants of the organization the federated user belongs to.
+// An empty list of mappings removes the role mapping.
+func (c *Commands) SetInstanceIDPRoleMapping(ctx context.Context, idpID string, mappings []*domain.IDPRoleMapping) (_ *domain.ObjectDetails, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	if idpID == "" {
+		return nil, zerrors.ThrowInvalidArgument(nil, "COMMAND-Irm2i", "Errors.IDMissing")
+	}
+	if err = c.checkIDPRoleMappings(mappings); err != nil {
+		return nil, err
+	}
+	exists, err := ExistsInstanceIDP(ctx, c.eventstore.Filter, idpID)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, zerrors.ThrowNotFound(nil, "COMMAND-Irm2n", "Errors.IDPConfig.NotExisting")
+	}
+	instanceID := authz.GetInstance(ctx).InstanceID()
+	writeModel := NewInstanceIDPRoleMappingWriteModel(instanceID, idpID)
+	if err = c.eventstore.FilterToQueryReducer(ctx, writeModel); err != nil {
+		return nil, err
+	}
+	if slices.EqualFunc(writeModel.Mappings, mappings, idpRoleMappingEqual) {
+		return writeModelToObjectDetails(&writeModel.WriteModel), nil
+	}
+	if err = c.pushAppendAndReduce(ctx, writeModel,
+		instance.NewIDPRoleMappingSetEvent(ctx, &instance.NewAggregate(instanceID).Aggregate, idpID, mappings),
+	); err != nil {
+		return nil, err
+	}
+	return writeModelToObjectDetails(&writeModel.WriteModel), nil
+}
+
+func (c *Commands) checkIDPRoleMappings(mappings []*domain.IDPRoleMapping) error {
+	for _, mapping := range mappings {
+		if mapping == nil || !mapping.IsValid() {
+			return zerrors.ThrowInvalidArgument(nil, "COMMAND-Irm3i", "Errors.IDPConfig.RoleMappingInvalid")
+		}
+		if len(mapping.OrgRoles) > 0 && len(domain.CheckForInvalidRoles(mapping.OrgRoles, domain.OrgRolePrefix, c.zitadelRoles)) > 0 {
+			return zerrors.ThrowInvalidArgument(nil, "COMMAND-Irm3r", "Errors.IDPConfig.RoleMappingInvalid")
+		}
+	}
+	return nil
+}
+
+// ApplyIDPRoleMapping updates the org membership and the user grants of the federated user according to the claims
+// provided by the identity provider on login.
+// Roles managed by the mappings are added if a claim matches and removed otherwise,
+// all other roles of the user are kept.
+// userGrants are the current grants of the user in the organization.
+func (c *Commands) ApplyIDPRoleMapping(ctx context.Context, userID, resourceOwner string, mappings []*domain.IDPRoleMapping, claims map[string][]string, userGrants []*domain.UserGrant) (err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	if len(mappings) == 0 {
+		return nil
+	}
+	if userID == "" || resourceOwner == "" {
+		return zerrors.ThrowInvalidArgument(nil, "COMMAND-Irm4i", "Errors.IDMissing")
+	}
+	cmds := make([]eventstore.Command, 0, len(mappings)+1)
+	memberCmd, err := c.applyIDPRoleMappingToOrgMember(ctx, userID, resourceOwner, mappings, claims)
+	if err != nil {
+		return err
+	}
+	if memberCmd != nil {
+		cmds = append(cmds, memberCmd)
+	}
+	for _, project := range domain.IDPRoleMappingProjects(mappings) {
+		grantCmd, err := c.applyIDPRoleMappingToUserGrant(ctx, userID, resourceOwner, mappings, claims, project, userGrants)
+		if err != nil {
+			return err
+		}
+		if grantCmd != nil {
+			cmds = append(cmds, grantCmd)
+		}
+	}
+	if len(cmds) == 0 {
+		return nil
+	}
+	_, err = c.eventstore.Push(ctx, cmds...)
+	return err
+}
+
+func (c *Commands) applyIDPRoleMappingToOrgMember(ctx context.Context, userID, resourceOwner string, mappings []*domain.IDPRoleMapping, claims map[string][]string) (eventstore.Command, error) {
+	if !slices.ContainsFunc(mappings, func(mapping *domain.IDPRoleMapping) bool { return len(mapping.OrgRoles) > 0 }) {
+		return nil, nil
+	}
+	member := NewOrgMemberWriteModel(resourceOwner, userID)
+	if err := c.eventstore.FilterToQueryReducer(ctx, member); err != nil {
+		return nil, err
+	}
+	orgAgg := &org.NewAggregate(resourceOwner).Aggregate
+	if member.State != domain.MemberStateActive {
+		roles := domain.IDPRoleMappingOrgRoles(mappings, claims, nil)
+		if len(roles) == 0 {
+			return nil, nil
+		}
+		return org.NewMemberAddedEvent(ctx, orgAgg, userID, roles...), nil
+	}
+	roles := domain.IDPRoleMappingOrgRoles(mappings, claims, member.Roles)
+	if len(roles) == 0 {
+		return c.removeOrgMember(ctx, orgAgg, userID, false), nil
+	}
+	if slices.Equal(member.Roles, roles) {
+		return nil, nil
+	}
+	return org.NewMemberChangedEvent(ctx, orgAgg, userID, roles...), nil
+}
+
+func (c *Commands) applyIDPRoleMappingToUserGrant(ctx context.Context, userID, resourceOwner string, mappings []*domain.IDPRoleMapping, claims map[string][]string, project domain.IDPRoleMappingProject, userGrants []*domain.UserGrant) (eventstore.Command, error) {
+	grantIndex := slices.IndexFunc(userGrants, func(grant *domain.UserGrant) bool {
+		return grant.ProjectID == project.ProjectID && grant.ProjectGrantID == project.ProjectGrantID
+	})
+	if grantIndex < 0 {
+		roles := domain.IDPRoleMappingProjectRoles(mappings, claims, project, nil)
+		if len(roles) == 0 {
+			return nil, nil
+		}
+		cmd, _, err := c.addUserGrant(ctx, &domain.UserGrant{
+			UserID:         userID,
+			ProjectID:      project.ProjectID,
+			ProjectGrantID: project.ProjectGrantID,
+			RoleKeys:       roles,
+		}, resourceOwner)
+		return cmd, err
+	}
+	existing, err := c.userGrantWriteModelByID(ctx, userGrants[grantIndex].AggregateID, resourceOwner)
+	if err != nil {
+		return nil, err
+	}
+	if existing.State == domain.UserGrantStateUnspecified || existing.State == domain.UserGrantStateRemoved {
+		return nil, nil
+	}
+	userGrantAgg := UserGrantAggregateFromWriteModel(&existing.WriteModel)
+	roles := domain.IDPRoleMappingProjectRoles(mappings, claims, project, existing.RoleKeys)
+	if len(roles) == 0 {
+		return usergrant.NewUserGrantRemovedEvent(ctx, userGrantAgg, userID, existing.ProjectID, existing.ProjectGrantID), nil
+	}
+	if slices.Equal(existing.RoleKeys, roles) {
+		return nil, nil
+	}
+	if err = c.checkUserGrantPreCondition(ctx, &domain.UserGrant{
+		UserID:         userID,
+		ProjectID:      existing.ProjectID,
+		ProjectGrantID: existing.ProjectGrantID,
+		RoleKeys:       roles,
+	}, resourceOwner); err != nil {
+		return nil, err
+	}
+	return usergrant.NewUserGrantChangedEvent(ctx, userGrantAgg, roles), nil
+}
+
+func idpRoleMappingProjectRoleKeys(mappings []*domain.IDPRoleMapping, project domain.IDPRoleMappingProject) []string {
+	roleKeys := make([]string, 0, len(mappings))
+	for _, mapping := range mappings {
+		if mapping.ProjectID == project.ProjectID && mapping.ProjectGrantID == project.ProjectGrantID {
+			roleKeys = append(roleKeys, mapping.ProjectRoleKeys...)
+		}
+	}
+	return roleKeys
+}
+
+func idpRoleMappingEqual(a, b *domain.IDPRoleMapping) bool {
+	return a.Claim == b.Claim &&
+		a.Value == b.Value &&
+		slices.Equal(a.OrgRoles, b.OrgRoles) &&
+		a.ProjectID == b.ProjectID &&
+		a.ProjectGrantID == b.ProjectGrantID &&
+		slices.Equal(a.ProjectRoleKeys, b.ProjectRoleKeys)
+}
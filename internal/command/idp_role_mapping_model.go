@@ -0,0 +1,114 @@
+package command
+
+import (
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/repository/idp"
+	"github.com/zitadel/zitadel/internal/repository/instance"
+	"github.com/zitadel/zitadel/internal/repository/org"
+)
+
+type IDPRoleMappingWriteModel struct {
+	eventstore.WriteModel
+
+	ID       string
+	Mappings []*domain.IDPRoleMapping
+}
+
+func (wm *IDPRoleMappingWriteModel) Reduce() error {
+	for _, event := range wm.Events {
+		switch e := event.(type) {
+		case *idp.RoleMappingSetEvent:
+			wm.Mappings = e.Mappings
+		case *idp.RemovedEvent:
+			wm.Mappings = nil
+		}
+	}
+	return wm.WriteModel.Reduce()
+}
+
+type OrgIDPRoleMappingWriteModel struct {
+	IDPRoleMappingWriteModel
+}
+
+func NewOrgIDPRoleMappingWriteModel(orgID, id string) *OrgIDPRoleMappingWriteModel {
+	return &OrgIDPRoleMappingWriteModel{
+		IDPRoleMappingWriteModel{
+			WriteModel: eventstore.WriteModel{
+				AggregateID:   orgID,
+				ResourceOwner: orgID,
+			},
+			ID: id,
+		},
+	}
+}
+
+func (wm *OrgIDPRoleMappingWriteModel) AppendEvents(events ...eventstore.Event) {
+	for _, event := range events {
+		switch e := event.(type) {
+		case *org.IDPRoleMappingSetEvent:
+			wm.IDPRoleMappingWriteModel.AppendEvents(&e.RoleMappingSetEvent)
+		case *org.IDPRemovedEvent:
+			wm.IDPRoleMappingWriteModel.AppendEvents(&e.RemovedEvent)
+		default:
+			wm.IDPRoleMappingWriteModel.AppendEvents(e)
+		}
+	}
+}
+
+func (wm *OrgIDPRoleMappingWriteModel) Query() *eventstore.SearchQueryBuilder {
+	return eventstore.NewSearchQueryBuilder(eventstore.ColumnsEvent).
+		ResourceOwner(wm.ResourceOwner).
+		AddQuery().
+		AggregateTypes(org.AggregateType).
+		AggregateIDs(wm.AggregateID).
+		EventTypes(
+			org.IDPRoleMappingSetEventType,
+			org.IDPRemovedEventType,
+		).
+		EventData(map[string]interface{}{"id": wm.ID}).
+		Builder()
+}
+
+type InstanceIDPRoleMappingWriteModel struct {
+	IDPRoleMappingWriteModel
+}
+
+func NewInstanceIDPRoleMappingWriteModel(instanceID, id string) *InstanceIDPRoleMappingWriteModel {
+	return &InstanceIDPRoleMappingWriteModel{
+		IDPRoleMappingWriteModel{
+			WriteModel: eventstore.WriteModel{
+				AggregateID:   instanceID,
+				ResourceOwner: instanceID,
+			},
+			ID: id,
+		},
+	}
+}
+
+func (wm *InstanceIDPRoleMappingWriteModel) AppendEvents(events ...eventstore.Event) {
+	for _, event := range events {
+		switch e := event.(type) {
+		case *instance.IDPRoleMappingSetEvent:
+			wm.IDPRoleMappingWriteModel.AppendEvents(&e.RoleMappingSetEvent)
+		case *instance.IDPRemovedEvent:
+			wm.IDPRoleMappingWriteModel.AppendEvents(&e.RemovedEvent)
+		default:
+			wm.IDPRoleMappingWriteModel.AppendEvents(e)
+		}
+	}
+}
+
+func (wm *InstanceIDPRoleMappingWriteModel) Query() *eventstore.SearchQueryBuilder {
+	return eventstore.NewSearchQueryBuilder(eventstore.ColumnsEvent).
+		ResourceOwner(wm.ResourceOwner).
+		AddQuery().
+		AggregateTypes(instance.AggregateType).
+		AggregateIDs(wm.AggregateID).
+		EventTypes(
+			instance.IDPRoleMappingSetEventType,
+			instance.IDPRemovedEventType,
+		).
+		EventData(map[string]interface{}{"id": wm.ID}).
+		Builder()
+}
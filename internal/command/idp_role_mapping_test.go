@@ -0,0 +1,526 @@
+package command
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/text/language"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/crypto"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/eventstore/v1/models"
+	"github.com/zitadel/zitadel/internal/id"
+	id_mock "github.com/zitadel/zitadel/internal/id/mock"
+	"github.com/zitadel/zitadel/internal/repository/idp"
+	"github.com/zitadel/zitadel/internal/repository/instance"
+	"github.com/zitadel/zitadel/internal/repository/org"
+	"github.com/zitadel/zitadel/internal/repository/project"
+	"github.com/zitadel/zitadel/internal/repository/user"
+	"github.com/zitadel/zitadel/internal/repository/usergrant"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+func orgOAuthIDPAddedEvent(orgID, id string) *org.OAuthIDPAddedEvent {
+	return org.NewOAuthIDPAddedEvent(context.Background(), &org.NewAggregate(orgID).Aggregate,
+		id,
+		"name",
+		"clientID",
+		&crypto.CryptoValue{
+			CryptoType: crypto.TypeEncryption,
+			Algorithm:  "enc",
+			KeyID:      "id",
+			Crypted:    []byte("clientSecret"),
+		},
+		"auth",
+		"token",
+		"user",
+		"idAttribute",
+		nil,
+		nil,
+		idp.Options{},
+	)
+}
+
+func instanceOAuthIDPAddedEvent(instanceID, id string) *instance.OAuthIDPAddedEvent {
+	return instance.NewOAuthIDPAddedEvent(context.Background(), &instance.NewAggregate(instanceID).Aggregate,
+		id,
+		"name",
+		"clientID",
+		&crypto.CryptoValue{
+			CryptoType: crypto.TypeEncryption,
+			Algorithm:  "enc",
+			KeyID:      "id",
+			Crypted:    []byte("clientSecret"),
+		},
+		"auth",
+		"token",
+		"user",
+		"idAttribute",
+		nil,
+		nil,
+		idp.Options{},
+	)
+}
+
+func TestCommandSide_SetOrgIDPRoleMapping(t *testing.T) {
+	mappings := []*domain.IDPRoleMapping{
+		{Claim: "groups", Value: "admins", OrgRoles: []string{"ORG_OWNER"}},
+	}
+	type fields struct {
+		eventstore func(t *testing.T) *eventstore.Eventstore
+	}
+	type args struct {
+		ctx           context.Context
+		resourceOwner string
+		idpID         string
+		mappings      []*domain.IDPRoleMapping
+	}
+	type res struct {
+		want *domain.ObjectDetails
+		err  error
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		args   args
+		res    res
+	}{
+		{
+			name: "missing id, invalid argument error",
+			fields: fields{
+				eventstore: expectEventstore(),
+			},
+			args: args{
+				ctx:           context.Background(),
+				resourceOwner: "org1",
+				mappings:      mappings,
+			},
+			res: res{
+				err: zerrors.ThrowInvalidArgument(nil, "COMMAND-Irm1i", "Errors.IDMissing"),
+			},
+		},
+		{
+			name: "invalid mapping, invalid argument error",
+			fields: fields{
+				eventstore: expectEventstore(),
+			},
+			args: args{
+				ctx:           context.Background(),
+				resourceOwner: "org1",
+				idpID:         "idp1",
+				mappings:      []*domain.IDPRoleMapping{{Claim: "groups", OrgRoles: []string{"ORG_OWNER"}}},
+			},
+			res: res{
+				err: zerrors.ThrowInvalidArgument(nil, "COMMAND-Irm3i", "Errors.IDPConfig.RoleMappingInvalid"),
+			},
+		},
+		{
+			name: "unknown org role, invalid argument error",
+			fields: fields{
+				eventstore: expectEventstore(),
+			},
+			args: args{
+				ctx:           context.Background(),
+				resourceOwner: "org1",
+				idpID:         "idp1",
+				mappings:      []*domain.IDPRoleMapping{{Claim: "groups", Value: "admins", OrgRoles: []string{"IAM_OWNER"}}},
+			},
+			res: res{
+				err: zerrors.ThrowInvalidArgument(nil, "COMMAND-Irm3r", "Errors.IDPConfig.RoleMappingInvalid"),
+			},
+		},
+		{
+			name: "idp not found, not found error",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(),
+				),
+			},
+			args: args{
+				ctx:           context.Background(),
+				resourceOwner: "org1",
+				idpID:         "idp1",
+				mappings:      mappings,
+			},
+			res: res{
+				err: zerrors.ThrowNotFound(nil, "COMMAND-Irm1n", "Errors.IDPConfig.NotExisting"),
+			},
+		},
+		{
+			name: "mapping not changed, ok",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(orgOAuthIDPAddedEvent("org1", "idp1")),
+					),
+					expectFilter(
+						eventFromEventPusher(
+							org.NewIDPRoleMappingSetEvent(context.Background(), &org.NewAggregate("org1").Aggregate, "idp1", mappings),
+						),
+					),
+				),
+			},
+			args: args{
+				ctx:           context.Background(),
+				resourceOwner: "org1",
+				idpID:         "idp1",
+				mappings: []*domain.IDPRoleMapping{
+					{Claim: "groups", Value: "admins", OrgRoles: []string{"ORG_OWNER"}},
+				},
+			},
+			res: res{
+				want: &domain.ObjectDetails{
+					ResourceOwner: "org1",
+				},
+			},
+		},
+		{
+			name: "set mapping, ok",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(orgOAuthIDPAddedEvent("org1", "idp1")),
+					),
+					expectFilter(),
+					expectPush(
+						org.NewIDPRoleMappingSetEvent(context.Background(), &org.NewAggregate("org1").Aggregate, "idp1", mappings),
+					),
+				),
+			},
+			args: args{
+				ctx:           context.Background(),
+				resourceOwner: "org1",
+				idpID:         "idp1",
+				mappings:      mappings,
+			},
+			res: res{
+				want: &domain.ObjectDetails{
+					ResourceOwner: "org1",
+				},
+			},
+		},
+		{
+			name: "remove mapping, ok",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(orgOAuthIDPAddedEvent("org1", "idp1")),
+					),
+					expectFilter(
+						eventFromEventPusher(
+							org.NewIDPRoleMappingSetEvent(context.Background(), &org.NewAggregate("org1").Aggregate, "idp1", mappings),
+						),
+					),
+					expectPush(
+						org.NewIDPRoleMappingSetEvent(context.Background(), &org.NewAggregate("org1").Aggregate, "idp1", nil),
+					),
+				),
+			},
+			args: args{
+				ctx:           context.Background(),
+				resourceOwner: "org1",
+				idpID:         "idp1",
+				mappings:      nil,
+			},
+			res: res{
+				want: &domain.ObjectDetails{
+					ResourceOwner: "org1",
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := &Commands{
+				eventstore:   tt.fields.eventstore(t),
+				zitadelRoles: []authz.RoleMapping{{Role: "ORG_OWNER"}, {Role: "IAM_OWNER"}},
+			}
+			got, err := r.SetOrgIDPRoleMapping(tt.args.ctx, tt.args.resourceOwner, tt.args.idpID, tt.args.mappings)
+			assert.ErrorIs(t, err, tt.res.err)
+			assert.Equal(t, tt.res.want, got)
+		})
+	}
+}
+
+func TestCommandSide_SetInstanceIDPRoleMapping(t *testing.T) {
+	mappings := []*domain.IDPRoleMapping{
+		{Claim: "groups", Value: "developers", ProjectID: "project1", ProjectRoleKeys: []string{"developer"}},
+	}
+	type fields struct {
+		eventstore func(t *testing.T) *eventstore.Eventstore
+	}
+	type args struct {
+		ctx      context.Context
+		idpID    string
+		mappings []*domain.IDPRoleMapping
+	}
+	type res struct {
+		want *domain.ObjectDetails
+		err  error
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		args   args
+		res    res
+	}{
+		{
+			name: "idp not found, not found error",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(),
+				),
+			},
+			args: args{
+				ctx:      authz.WithInstanceID(context.Background(), "instance1"),
+				idpID:    "idp1",
+				mappings: mappings,
+			},
+			res: res{
+				err: zerrors.ThrowNotFound(nil, "COMMAND-Irm2n", "Errors.IDPConfig.NotExisting"),
+			},
+		},
+		{
+			name: "set mapping, ok",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(instanceOAuthIDPAddedEvent("instance1", "idp1")),
+					),
+					expectFilter(),
+					expectPush(
+						instance.NewIDPRoleMappingSetEvent(context.Background(), &instance.NewAggregate("instance1").Aggregate, "idp1", mappings),
+					),
+				),
+			},
+			args: args{
+				ctx:      authz.WithInstanceID(context.Background(), "instance1"),
+				idpID:    "idp1",
+				mappings: mappings,
+			},
+			res: res{
+				want: &domain.ObjectDetails{
+					ResourceOwner: "instance1",
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := &Commands{
+				eventstore: tt.fields.eventstore(t),
+			}
+			got, err := r.SetInstanceIDPRoleMapping(tt.args.ctx, tt.args.idpID, tt.args.mappings)
+			assert.ErrorIs(t, err, tt.res.err)
+			assert.Equal(t, tt.res.want, got)
+		})
+	}
+}
+
+func TestCommandSide_ApplyIDPRoleMapping(t *testing.T) {
+	mappings := []*domain.IDPRoleMapping{
+		{Claim: "groups", Value: "admins", OrgRoles: []string{"ORG_OWNER"}},
+		{Claim: "groups", Value: "developers", ProjectID: "project1", ProjectRoleKeys: []string{"developer"}},
+	}
+	type fields struct {
+		eventstore  func(t *testing.T) *eventstore.Eventstore
+		idGenerator id.Generator
+	}
+	type args struct {
+		ctx        context.Context
+		userID     string
+		orgID      string
+		mappings   []*domain.IDPRoleMapping
+		claims     map[string][]string
+		userGrants []*domain.UserGrant
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		args   args
+		err    error
+	}{
+		{
+			name: "no mappings, ok",
+			fields: fields{
+				eventstore: expectEventstore(),
+			},
+			args: args{
+				ctx:    context.Background(),
+				userID: "user1",
+				orgID:  "org1",
+				claims: map[string][]string{"groups": {"admins"}},
+			},
+		},
+		{
+			name: "roles granted, member and user grant added",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(),
+					expectFilter(
+						eventFromEventPusher(
+							user.NewHumanAddedEvent(context.Background(),
+								&user.NewAggregate("user1", "org1").Aggregate,
+								"username1",
+								"firstname1",
+								"lastname1",
+								"nickname1",
+								"displayname1",
+								language.German,
+								domain.GenderMale,
+								"email1",
+								true,
+							),
+						),
+						eventFromEventPusher(
+							project.NewProjectAddedEvent(context.Background(),
+								&project.NewAggregate("project1", "org1").Aggregate,
+								"projectname1", true, true, true,
+								domain.PrivateLabelingSettingUnspecified,
+							),
+						),
+						eventFromEventPusher(
+							project.NewRoleAddedEvent(context.Background(),
+								&project.NewAggregate("project1", "org1").Aggregate,
+								"developer",
+								"developer",
+								"",
+							),
+						),
+					),
+					expectPush(
+						org.NewMemberAddedEvent(context.Background(), &org.NewAggregate("org1").Aggregate, "user1", "ORG_OWNER"),
+						usergrant.NewUserGrantAddedEvent(context.Background(),
+							&usergrant.NewAggregate("usergrant1", "org1").Aggregate,
+							"user1",
+							"project1",
+							"",
+							[]string{"developer"},
+						),
+					),
+				),
+				idGenerator: id_mock.NewIDGeneratorExpectIDs(t, "usergrant1"),
+			},
+			args: args{
+				ctx:      context.Background(),
+				userID:   "user1",
+				orgID:    "org1",
+				mappings: mappings,
+				claims:   map[string][]string{"groups": {"admins", "developers"}},
+			},
+		},
+		{
+			name: "roles revoked upstream, member and user grant removed",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(
+							org.NewMemberAddedEvent(context.Background(), &org.NewAggregate("org1").Aggregate, "user1", "ORG_OWNER"),
+						),
+					),
+					expectFilter(
+						eventFromEventPusher(
+							usergrant.NewUserGrantAddedEvent(context.Background(),
+								&usergrant.NewAggregate("usergrant1", "org1").Aggregate,
+								"user1",
+								"project1",
+								"",
+								[]string{"developer"},
+							),
+						),
+					),
+					expectPush(
+						org.NewMemberRemovedEvent(context.Background(), &org.NewAggregate("org1").Aggregate, "user1"),
+						usergrant.NewUserGrantRemovedEvent(context.Background(),
+							&usergrant.NewAggregate("usergrant1", "org1").Aggregate,
+							"user1",
+							"project1",
+							"",
+						),
+					),
+				),
+			},
+			args: args{
+				ctx:      context.Background(),
+				userID:   "user1",
+				orgID:    "org1",
+				mappings: mappings,
+				claims:   nil,
+				userGrants: []*domain.UserGrant{
+					{UserID: "user1", ProjectID: "project1", RoleKeys: []string{"developer"}, ObjectRoot: models.ObjectRoot{AggregateID: "usergrant1"}},
+				},
+			},
+		},
+		{
+			name: "unmanaged roles kept, member changed",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(
+							org.NewMemberAddedEvent(context.Background(), &org.NewAggregate("org1").Aggregate, "user1", "ORG_OWNER", "ORG_USER_MANAGER"),
+						),
+					),
+					expectPush(
+						org.NewMemberChangedEvent(context.Background(), &org.NewAggregate("org1").Aggregate, "user1", "ORG_USER_MANAGER"),
+					),
+				),
+			},
+			args: args{
+				ctx:    context.Background(),
+				userID: "user1",
+				orgID:  "org1",
+				mappings: []*domain.IDPRoleMapping{
+					{Claim: "groups", Value: "admins", OrgRoles: []string{"ORG_OWNER"}},
+				},
+				claims: map[string][]string{"groups": {"developers"}},
+			},
+		},
+		{
+			name: "roles unchanged, no events",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(
+							org.NewMemberAddedEvent(context.Background(), &org.NewAggregate("org1").Aggregate, "user1", "ORG_OWNER"),
+						),
+					),
+					expectFilter(
+						eventFromEventPusher(
+							usergrant.NewUserGrantAddedEvent(context.Background(),
+								&usergrant.NewAggregate("usergrant1", "org1").Aggregate,
+								"user1",
+								"project1",
+								"",
+								[]string{"developer"},
+							),
+						),
+					),
+				),
+			},
+			args: args{
+				ctx:      context.Background(),
+				userID:   "user1",
+				orgID:    "org1",
+				mappings: mappings,
+				claims:   map[string][]string{"groups": {"admins", "developers"}},
+				userGrants: []*domain.UserGrant{
+					{UserID: "user1", ProjectID: "project1", RoleKeys: []string{"developer"}, ObjectRoot: models.ObjectRoot{AggregateID: "usergrant1"}},
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := &Commands{
+				eventstore:   tt.fields.eventstore(t),
+				idGenerator:  tt.fields.idGenerator,
+				zitadelRoles: []authz.RoleMapping{{Role: "ORG_OWNER"}},
+			}
+			err := r.ApplyIDPRoleMapping(tt.args.ctx, tt.args.userID, tt.args.orgID, tt.args.mappings, tt.args.claims, tt.args.userGrants)
+			assert.ErrorIs(t, err, tt.err)
+		})
+	}
+}
@@ -0,0 +1,109 @@
+package command
+
+import (
+	"bytes"
+	"context"
+
+	"github.com/zitadel/logging"
+	"github.com/zitadel/saml/pkg/provider/xml"
+
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	saml2 "github.com/zitadel/zitadel/internal/idp/providers/saml"
+	"github.com/zitadel/zitadel/internal/repository/idp"
+	"github.com/zitadel/zitadel/internal/repository/instance"
+	"github.com/zitadel/zitadel/internal/repository/org"
+	"github.com/zitadel/zitadel/internal/telemetry/tracing"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+// RefreshSAMLIDPsMetadata fetches the metadata of the SAML identity providers of the instance
+// from their metadata URL and stores it if it changed, so rotated certificates are used automatically.
+// Changed signing certificates and signing certificates expiring within the warning period are reported by events.
+// Providers without metadata URL are skipped, a failing refresh of a provider doesn't prevent the others.
+func (c *Commands) RefreshSAMLIDPsMetadata(ctx context.Context, ids []string) (err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	for _, id := range ids {
+		err := c.refreshSAMLIDPMetadata(ctx, id)
+		logging.WithFields("idp", id).OnError(err).Warn("saml metadata refresh failed")
+	}
+	return nil
+}
+
+func (c *Commands) refreshSAMLIDPMetadata(ctx context.Context, id string) error {
+	providerWriteModel, err := IDPProviderWriteModel(ctx, c.eventstore.Filter, id)
+	if err != nil {
+		return err
+	}
+	if providerWriteModel.IDPType != domain.IDPTypeSAML {
+		return nil
+	}
+	writeModel := newSAMLMetadataWriteModel(providerWriteModel.ResourceOwner, id, providerWriteModel.Instance)
+	if err = c.eventstore.FilterToQueryReducer(ctx, writeModel); err != nil {
+		return err
+	}
+	if writeModel.State != domain.IDPStateActive || writeModel.MetadataURL == "" {
+		return nil
+	}
+	metadata, err := xml.ReadMetadataFromURL(c.httpClient, writeModel.MetadataURL)
+	if err != nil {
+		return zerrors.ThrowPreconditionFailed(err, "COMMAND-Sm1rf", "Errors.Project.App.SAMLMetadataMissing")
+	}
+	current, err := saml2.SigningCertificates(metadata)
+	if err != nil {
+		return zerrors.ThrowPreconditionFailed(err, "COMMAND-Sm2pc", "Errors.Project.App.SAMLMetadataFormat")
+	}
+	events, err := c.samlMetadataRefreshEvents(ctx, writeModel, metadata, current)
+	if err != nil || len(events) == 0 {
+		return err
+	}
+	_, err = c.eventstore.Push(ctx, events...)
+	return err
+}
+
+func (c *Commands) samlMetadataRefreshEvents(ctx context.Context, writeModel *samlMetadataWriteModel, metadata []byte, current []*domain.SAMLSigningCertificate) ([]eventstore.Command, error) {
+	events := make([]eventstore.Command, 0, len(current)+2)
+	if !bytes.Equal(writeModel.Metadata, metadata) {
+		event, err := samlMetadataChangedEvent(ctx, writeModel, metadata)
+		if err != nil {
+			return nil, err
+		}
+		events = append(events, event)
+		// metadata which can't be parsed (anymore) is treated as without certificates
+		previous, _ := saml2.SigningCertificates(writeModel.Metadata)
+		if domain.SAMLSigningCertificatesChanged(previous, current) {
+			events = append(events, samlCertificateChangedEvent(ctx, writeModel, previous, current))
+		}
+	}
+	for _, certificate := range current {
+		if !certificate.ExpiresWithin(c.idpCertificateExpiryWarning) || writeModel.expirationReported(certificate) {
+			continue
+		}
+		events = append(events, samlCertificateExpiringEvent(ctx, writeModel, certificate))
+	}
+	return events, nil
+}
+
+func samlMetadataChangedEvent(ctx context.Context, writeModel *samlMetadataWriteModel, metadata []byte) (eventstore.Command, error) {
+	changes := []idp.SAMLIDPChanges{idp.ChangeSAMLMetadata(metadata)}
+	if writeModel.instanceIDP {
+		return instance.NewSAMLIDPChangedEvent(ctx, &instance.NewAggregate(writeModel.AggregateID).Aggregate, writeModel.ID, changes)
+	}
+	return org.NewSAMLIDPChangedEvent(ctx, &org.NewAggregate(writeModel.AggregateID).Aggregate, writeModel.ID, changes)
+}
+
+func samlCertificateChangedEvent(ctx context.Context, writeModel *samlMetadataWriteModel, previous, current []*domain.SAMLSigningCertificate) eventstore.Command {
+	if writeModel.instanceIDP {
+		return instance.NewSAMLIDPCertificateChangedEvent(ctx, &instance.NewAggregate(writeModel.AggregateID).Aggregate, writeModel.ID, previous, current)
+	}
+	return org.NewSAMLIDPCertificateChangedEvent(ctx, &org.NewAggregate(writeModel.AggregateID).Aggregate, writeModel.ID, previous, current)
+}
+
+func samlCertificateExpiringEvent(ctx context.Context, writeModel *samlMetadataWriteModel, certificate *domain.SAMLSigningCertificate) eventstore.Command {
+	if writeModel.instanceIDP {
+		return instance.NewSAMLIDPCertificateExpiringEvent(ctx, &instance.NewAggregate(writeModel.AggregateID).Aggregate, writeModel.ID, certificate)
+	}
+	return org.NewSAMLIDPCertificateExpiringEvent(ctx, &org.NewAggregate(writeModel.AggregateID).Aggregate, writeModel.ID, certificate)
+}
@@ -0,0 +1,108 @@
+package command
+
+import (
+	"slices"
+
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/repository/idp"
+	"github.com/zitadel/zitadel/internal/repository/instance"
+	"github.com/zitadel/zitadel/internal/repository/org"
+)
+
+// samlMetadataWriteModel contains the metadata of a SAML identity provider
+// and the signing certificates whose expiration was already reported
+type samlMetadataWriteModel struct {
+	eventstore.WriteModel
+
+	ID          string
+	instanceIDP bool
+	Metadata    []byte
+	MetadataURL string
+	State       domain.IDPState
+
+	reportedExpirations []string
+}
+
+func newSAMLMetadataWriteModel(resourceOwner, id string, instanceIDP bool) *samlMetadataWriteModel {
+	return &samlMetadataWriteModel{
+		WriteModel: eventstore.WriteModel{
+			AggregateID:   resourceOwner,
+			ResourceOwner: resourceOwner,
+		},
+		ID:          id,
+		instanceIDP: instanceIDP,
+	}
+}
+
+func (wm *samlMetadataWriteModel) Reduce() error {
+	for _, event := range wm.Events {
+		switch e := event.(type) {
+		case *org.SAMLIDPAddedEvent:
+			wm.reduceAddedEvent(&e.SAMLIDPAddedEvent)
+		case *instance.SAMLIDPAddedEvent:
+			wm.reduceAddedEvent(&e.SAMLIDPAddedEvent)
+		case *org.SAMLIDPChangedEvent:
+			wm.reduceChangedEvent(&e.SAMLIDPChangedEvent)
+		case *instance.SAMLIDPChangedEvent:
+			wm.reduceChangedEvent(&e.SAMLIDPChangedEvent)
+		case *org.IDPRemovedEvent, *instance.IDPRemovedEvent:
+			wm.State = domain.IDPStateRemoved
+		case *org.SAMLIDPCertificateExpiringEvent:
+			wm.reportedExpirations = append(wm.reportedExpirations, e.Certificate.Fingerprint)
+		case *instance.SAMLIDPCertificateExpiringEvent:
+			wm.reportedExpirations = append(wm.reportedExpirations, e.Certificate.Fingerprint)
+		}
+	}
+	return wm.WriteModel.Reduce()
+}
+
+func (wm *samlMetadataWriteModel) reduceAddedEvent(e *idp.SAMLIDPAddedEvent) {
+	wm.Metadata = e.Metadata
+	wm.MetadataURL = e.MetadataURL
+	wm.State = domain.IDPStateActive
+}
+
+func (wm *samlMetadataWriteModel) reduceChangedEvent(e *idp.SAMLIDPChangedEvent) {
+	if e.Metadata != nil {
+		wm.Metadata = e.Metadata
+	}
+	if e.MetadataURL != nil {
+		wm.MetadataURL = *e.MetadataURL
+	}
+}
+
+func (wm *samlMetadataWriteModel) expirationReported(certificate *domain.SAMLSigningCertificate) bool {
+	return slices.Contains(wm.reportedExpirations, certificate.Fingerprint)
+}
+
+func (wm *samlMetadataWriteModel) Query() *eventstore.SearchQueryBuilder {
+	if wm.instanceIDP {
+		return eventstore.NewSearchQueryBuilder(eventstore.ColumnsEvent).
+			ResourceOwner(wm.ResourceOwner).
+			AddQuery().
+			AggregateTypes(instance.AggregateType).
+			AggregateIDs(wm.AggregateID).
+			EventTypes(
+				instance.SAMLIDPAddedEventType,
+				instance.SAMLIDPChangedEventType,
+				instance.IDPRemovedEventType,
+				instance.SAMLIDPCertificateExpiringEventType,
+			).
+			EventData(map[string]interface{}{"id": wm.ID}).
+			Builder()
+	}
+	return eventstore.NewSearchQueryBuilder(eventstore.ColumnsEvent).
+		ResourceOwner(wm.ResourceOwner).
+		AddQuery().
+		AggregateTypes(org.AggregateType).
+		AggregateIDs(wm.AggregateID).
+		EventTypes(
+			org.SAMLIDPAddedEventType,
+			org.SAMLIDPChangedEventType,
+			org.IDPRemovedEventType,
+			org.SAMLIDPCertificateExpiringEventType,
+		).
+		EventData(map[string]interface{}{"id": wm.ID}).
+		Builder()
+}
@@ -0,0 +1,195 @@
+package command
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/base64"
+	"fmt"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/zitadel/zitadel/internal/crypto"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/eventstore/repository"
+	saml2 "github.com/zitadel/zitadel/internal/idp/providers/saml"
+	"github.com/zitadel/zitadel/internal/repository/idp"
+	"github.com/zitadel/zitadel/internal/repository/org"
+)
+
+func TestCommandSide_RefreshSAMLIDPsMetadata(t *testing.T) {
+	metadata := testSAMLMetadata(t, time.Now().Add(24*time.Hour))
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write(metadata)
+	}))
+	defer server.Close()
+	certificates, err := saml2.SigningCertificates(metadata)
+	require.NoError(t, err)
+	require.Len(t, certificates, 1)
+
+	samlAdded := func(metadata []byte, metadataURL string) *repository.Event {
+		return eventFromEventPusher(
+			org.NewSAMLIDPAddedEvent(context.Background(), &org.NewAggregate("org1").Aggregate,
+				"id1",
+				"name",
+				metadata,
+				metadataURL,
+				&crypto.CryptoValue{
+					CryptoType: crypto.TypeEncryption,
+					Algorithm:  "enc",
+					KeyID:      "id",
+					Crypted:    []byte("key"),
+				},
+				[]byte("certificate"),
+				"",
+				false,
+				nil,
+				"",
+				idp.Options{},
+			),
+		)
+	}
+	metadataChanged := func() eventstore.Command {
+		event, err := org.NewSAMLIDPChangedEvent(context.Background(), &org.NewAggregate("org1").Aggregate,
+			"id1",
+			[]idp.SAMLIDPChanges{idp.ChangeSAMLMetadata(metadata)},
+		)
+		require.NoError(t, err)
+		return event
+	}
+	type fields struct {
+		eventstore func(t *testing.T) *eventstore.Eventstore
+		warning    time.Duration
+	}
+	tests := []struct {
+		name   string
+		fields fields
+	}{
+		{
+			name: "idp not existing, nothing done",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(),
+				),
+			},
+		},
+		{
+			name: "without metadata url, nothing done",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(samlAdded([]byte("metadata"), "")),
+					expectFilter(samlAdded([]byte("metadata"), "")),
+					expectFilter(samlAdded([]byte("metadata"), "")),
+				),
+			},
+		},
+		{
+			name: "metadata unchanged, nothing done",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(samlAdded(metadata, server.URL)),
+					expectFilter(samlAdded(metadata, server.URL)),
+					expectFilter(samlAdded(metadata, server.URL)),
+				),
+			},
+		},
+		{
+			name: "metadata and certificate changed",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(samlAdded([]byte("metadata"), server.URL)),
+					expectFilter(samlAdded([]byte("metadata"), server.URL)),
+					expectFilter(samlAdded([]byte("metadata"), server.URL)),
+					expectPush(
+						metadataChanged(),
+						org.NewSAMLIDPCertificateChangedEvent(context.Background(), &org.NewAggregate("org1").Aggregate,
+							"id1",
+							nil,
+							certificates,
+						),
+					),
+				),
+			},
+		},
+		{
+			name: "certificate expiring, reported",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(samlAdded(metadata, server.URL)),
+					expectFilter(samlAdded(metadata, server.URL)),
+					expectFilter(samlAdded(metadata, server.URL)),
+					expectPush(
+						org.NewSAMLIDPCertificateExpiringEvent(context.Background(), &org.NewAggregate("org1").Aggregate,
+							"id1",
+							certificates[0],
+						),
+					),
+				),
+				warning: 48 * time.Hour,
+			},
+		},
+		{
+			name: "certificate expiring, already reported",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(samlAdded(metadata, server.URL)),
+					expectFilter(samlAdded(metadata, server.URL)),
+					expectFilter(
+						samlAdded(metadata, server.URL),
+						eventFromEventPusher(
+							org.NewSAMLIDPCertificateExpiringEvent(context.Background(), &org.NewAggregate("org1").Aggregate,
+								"id1",
+								certificates[0],
+							),
+						),
+					),
+				),
+				warning: 48 * time.Hour,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &Commands{
+				eventstore:                  tt.fields.eventstore(t),
+				httpClient:                  http.DefaultClient,
+				idpCertificateExpiryWarning: tt.fields.warning,
+			}
+			err := c.RefreshSAMLIDPsMetadata(context.Background(), []string{"id1"})
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func testSAMLMetadata(t *testing.T, notAfter time.Time) []byte {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "idp"},
+		NotBefore:    time.Now(),
+		NotAfter:     notAfter,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	require.NoError(t, err)
+	return []byte(fmt.Sprintf(`<EntityDescriptor xmlns="urn:oasis:names:tc:SAML:2.0:metadata" entityID="https://idp.example.com">
+  <IDPSSODescriptor protocolSupportEnumeration="urn:oasis:names:tc:SAML:2.0:protocol">
+    <KeyDescriptor use="signing">
+      <KeyInfo xmlns="http://www.w3.org/2000/09/xmldsig#">
+        <X509Data>
+          <X509Certificate>%s</X509Certificate>
+        </X509Data>
+      </KeyInfo>
+    </KeyDescriptor>
+  </IDPSSODescriptor>
+</EntityDescriptor>`, base64.StdEncoding.EncodeToString(der)))
+}
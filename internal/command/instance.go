@@ -43,11 +43,12 @@ type InstanceSetup struct {
 	Org                      InstanceOrgSetup
 	SecretGenerators         *SecretGenerators
 	PasswordComplexityPolicy struct {
-		MinLength    uint64
-		HasLowercase bool
-		HasUppercase bool
-		HasNumber    bool
-		HasSymbol    bool
+		MinLength     uint64
+		HasLowercase  bool
+		HasUppercase  bool
+		HasNumber     bool
+		HasSymbol     bool
+		CheckBreached bool
 	}
 	PasswordAgePolicy struct {
 		ExpireWarnDays uint64
@@ -59,23 +60,29 @@ type InstanceSetup struct {
 		SMTPSenderAddressMatchesInstanceDomain bool
 	}
 	LoginPolicy struct {
-		AllowUsernamePassword      bool
-		AllowRegister              bool
-		AllowExternalIDP           bool
-		ForceMFA                   bool
-		ForceMFALocalOnly          bool
-		HidePasswordReset          bool
-		IgnoreUnknownUsername      bool
-		AllowDomainDiscovery       bool
-		DisableLoginWithEmail      bool
-		DisableLoginWithPhone      bool
-		PasswordlessType           domain.PasswordlessType
-		DefaultRedirectURI         string
-		PasswordCheckLifetime      time.Duration
-		ExternalLoginCheckLifetime time.Duration
-		MfaInitSkipLifetime        time.Duration
-		SecondFactorCheckLifetime  time.Duration
-		MultiFactorCheckLifetime   time.Duration
+		AllowUsernamePassword       bool
+		AllowRegister               bool
+		AllowExternalIDP            bool
+		ForceMFA                    bool
+		ForceMFALocalOnly           bool
+		HidePasswordReset           bool
+		IgnoreUnknownUsername       bool
+		AllowDomainDiscovery        bool
+		DisableLoginWithEmail       bool
+		DisableLoginWithPhone       bool
+		PasswordlessType            domain.PasswordlessType
+		DefaultRedirectURI          string
+		PasswordCheckLifetime       time.Duration
+		ExternalLoginCheckLifetime  time.Duration
+		MfaInitSkipLifetime         time.Duration
+		SecondFactorCheckLifetime   time.Duration
+		MultiFactorCheckLifetime    time.Duration
+		TrustedDeviceLifetime       time.Duration
+		SharedDevice                bool
+		SharedDeviceLifetime        time.Duration
+		SharedDeviceIdleTimeout     time.Duration
+		PasskeyPrompt               bool
+		PasskeyPromptSnoozeLifetime time.Duration
 	}
 	NotificationPolicy struct {
 		PasswordChange bool
@@ -102,6 +109,7 @@ type InstanceSetup struct {
 		ErrorMsgPopup       bool
 		DisableWatermark    bool
 		ThemeMode           domain.LabelPolicyThemeMode
+		CustomCSS           string
 	}
 	LockoutPolicy struct {
 		MaxPasswordAttempts      uint64
@@ -139,6 +147,7 @@ type SecretGenerators struct {
 	DomainVerification       *crypto.GeneratorConfig
 	OTPSMS                   *crypto.GeneratorConfig
 	OTPEmail                 *crypto.GeneratorConfig
+	SigningKey               *crypto.GeneratorConfig
 }
 
 type ZitadelConfig struct {
@@ -297,6 +306,7 @@ func setupInstanceElements(instanceAgg *instance.Aggregate, setup *InstanceSetup
 			setup.PasswordComplexityPolicy.HasUppercase,
 			setup.PasswordComplexityPolicy.HasNumber,
 			setup.PasswordComplexityPolicy.HasSymbol,
+			setup.PasswordComplexityPolicy.CheckBreached,
 		),
 		prepareAddDefaultPasswordAgePolicy(
 			instanceAgg,
@@ -328,6 +338,12 @@ func setupInstanceElements(instanceAgg *instance.Aggregate, setup *InstanceSetup
 			setup.LoginPolicy.MfaInitSkipLifetime,
 			setup.LoginPolicy.SecondFactorCheckLifetime,
 			setup.LoginPolicy.MultiFactorCheckLifetime,
+			setup.LoginPolicy.TrustedDeviceLifetime,
+			setup.LoginPolicy.SharedDevice,
+			setup.LoginPolicy.SharedDeviceLifetime,
+			setup.LoginPolicy.SharedDeviceIdleTimeout,
+			setup.LoginPolicy.PasskeyPrompt,
+			setup.LoginPolicy.PasskeyPromptSnoozeLifetime,
 		),
 		prepareAddSecondFactorToDefaultLoginPolicy(instanceAgg, domain.SecondFactorTypeTOTP),
 		prepareAddSecondFactorToDefaultLoginPolicy(instanceAgg, domain.SecondFactorTypeU2F),
@@ -355,6 +371,7 @@ func setupInstanceElements(instanceAgg *instance.Aggregate, setup *InstanceSetup
 			setup.LabelPolicy.ErrorMsgPopup,
 			setup.LabelPolicy.DisableWatermark,
 			setup.LabelPolicy.ThemeMode,
+			setup.LabelPolicy.CustomCSS,
 		),
 		prepareAddDefaultEmailTemplate(instanceAgg, setup.EmailTemplate),
 	}
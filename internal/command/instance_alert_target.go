@@ -0,0 +1,171 @@
+package command
+
+import (
+	"context"
+	"net/url"
+	"slices"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/crypto"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/repository/instance"
+	"github.com/zitadel/zitadel/internal/telemetry/tracing"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+// AlertTarget is a Slack or Teams channel security-relevant events of the instance are posted to
+type AlertTarget struct {
+	Name       string
+	TargetType domain.AlertTargetType
+	// WebhookURL is the URL of the incoming webhook of the channel.
+	// On changes, an empty URL keeps the existing one.
+	WebhookURL string
+	// EventTypes restricts the alerts posted to the target, see [domain.AlertEventTypeMatches]
+	EventTypes []string
+}
+
+func (t *AlertTarget) IsValid(requireURL bool) error {
+	if t.Name == "" {
+		return zerrors.ThrowInvalidArgument(nil, "COMMAND-Al3nd", "Errors.Alert.Target.NameMissing")
+	}
+	if !t.TargetType.Valid() {
+		return zerrors.ThrowInvalidArgument(nil, "COMMAND-Al6qw", "Errors.Alert.Target.TypeInvalid")
+	}
+	if t.WebhookURL != "" || requireURL {
+		if err := validateAlertWebhookURL(t.WebhookURL); err != nil {
+			return err
+		}
+	}
+	for _, eventType := range t.EventTypes {
+		if eventType == "" || eventType == "*" {
+			return zerrors.ThrowInvalidArgument(nil, "COMMAND-Al8vk", "Errors.Alert.Target.EventTypeInvalid")
+		}
+	}
+	return nil
+}
+
+func validateAlertWebhookURL(webhookURL string) error {
+	parsed, err := url.Parse(webhookURL)
+	if err != nil || parsed.Scheme != "https" || parsed.Host == "" {
+		return zerrors.ThrowInvalidArgument(err, "COMMAND-Al2mr", "Errors.Alert.Target.URLInvalid")
+	}
+	return nil
+}
+
+// AddAlertTarget adds a chat channel, which receives the alerts of the instance
+func (c *Commands) AddAlertTarget(ctx context.Context, target *AlertTarget) (id string, _ *domain.ObjectDetails, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	if err = target.IsValid(true); err != nil {
+		return "", nil, err
+	}
+	webhookURL, err := crypto.Encrypt([]byte(target.WebhookURL), c.smsEncryption)
+	if err != nil {
+		return "", nil, err
+	}
+	id, err = c.idGenerator.Next()
+	if err != nil {
+		return "", nil, err
+	}
+	writeModel, err := c.getInstanceAlertTargetsWriteModel(ctx)
+	if err != nil {
+		return "", nil, err
+	}
+	err = c.pushAppendAndReduce(ctx, writeModel, instance.NewAlertTargetAddedEvent(
+		ctx,
+		InstanceAggregateFromWriteModel(&writeModel.WriteModel),
+		id,
+		target.Name,
+		target.TargetType,
+		webhookURL,
+		target.EventTypes,
+	))
+	if err != nil {
+		return "", nil, err
+	}
+	return id, writeModelToObjectDetails(&writeModel.WriteModel), nil
+}
+
+// ChangeAlertTarget changes the alert target, the webhook URL is only replaced if a new one is passed
+func (c *Commands) ChangeAlertTarget(ctx context.Context, id string, target *AlertTarget) (_ *domain.ObjectDetails, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	if id == "" {
+		return nil, zerrors.ThrowInvalidArgument(nil, "COMMAND-Al5xp", "Errors.IDMissing")
+	}
+	if err = target.IsValid(false); err != nil {
+		return nil, err
+	}
+	writeModel, err := c.getInstanceAlertTargetsWriteModel(ctx)
+	if err != nil {
+		return nil, err
+	}
+	existing := writeModel.ActiveTarget(id)
+	if existing == nil {
+		return nil, zerrors.ThrowNotFound(nil, "COMMAND-Al9wd", "Errors.Alert.Target.NotFound")
+	}
+	changes := make([]instance.AlertTargetChanges, 0, 4)
+	if existing.Name != target.Name {
+		changes = append(changes, instance.ChangeAlertTargetName(target.Name))
+	}
+	if existing.TargetType != target.TargetType {
+		changes = append(changes, instance.ChangeAlertTargetType(target.TargetType))
+	}
+	if target.WebhookURL != "" {
+		webhookURL, err := crypto.Encrypt([]byte(target.WebhookURL), c.smsEncryption)
+		if err != nil {
+			return nil, err
+		}
+		changes = append(changes, instance.ChangeAlertTargetWebhookURL(webhookURL))
+	}
+	if !slices.Equal(existing.EventTypes, target.EventTypes) {
+		changes = append(changes, instance.ChangeAlertTargetEventTypes(target.EventTypes))
+	}
+	if len(changes) == 0 {
+		return nil, zerrors.ThrowPreconditionFailed(nil, "COMMAND-Al1qs", "Errors.NoChangesFound")
+	}
+	changedEvent, err := instance.NewAlertTargetChangedEvent(ctx, InstanceAggregateFromWriteModel(&writeModel.WriteModel), id, changes)
+	if err != nil {
+		return nil, err
+	}
+	if err = c.pushAppendAndReduce(ctx, writeModel, changedEvent); err != nil {
+		return nil, err
+	}
+	return writeModelToObjectDetails(&writeModel.WriteModel), nil
+}
+
+// RemoveAlertTarget removes the alert target, it no longer receives alerts
+func (c *Commands) RemoveAlertTarget(ctx context.Context, id string) (_ *domain.ObjectDetails, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	if id == "" {
+		return nil, zerrors.ThrowInvalidArgument(nil, "COMMAND-Al4kc", "Errors.IDMissing")
+	}
+	writeModel, err := c.getInstanceAlertTargetsWriteModel(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if writeModel.ActiveTarget(id) == nil {
+		return nil, zerrors.ThrowNotFound(nil, "COMMAND-Al7bn", "Errors.Alert.Target.NotFound")
+	}
+	err = c.pushAppendAndReduce(ctx, writeModel, instance.NewAlertTargetRemovedEvent(
+		ctx,
+		InstanceAggregateFromWriteModel(&writeModel.WriteModel),
+		id,
+	))
+	if err != nil {
+		return nil, err
+	}
+	return writeModelToObjectDetails(&writeModel.WriteModel), nil
+}
+
+func (c *Commands) getInstanceAlertTargetsWriteModel(ctx context.Context) (*InstanceAlertTargetsWriteModel, error) {
+	writeModel := NewInstanceAlertTargetsWriteModel(authz.GetInstance(ctx).InstanceID())
+	if err := c.eventstore.FilterToQueryReducer(ctx, writeModel); err != nil {
+		return nil, err
+	}
+	return writeModel, nil
+}
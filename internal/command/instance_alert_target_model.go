@@ -0,0 +1,96 @@
+package command
+
+import (
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/repository/instance"
+)
+
+type InstanceAlertTargetsWriteModel struct {
+	eventstore.WriteModel
+
+	Targets []*AlertTargetWriteModel
+}
+
+type AlertTargetWriteModel struct {
+	ID         string
+	Name       string
+	TargetType domain.AlertTargetType
+	EventTypes []string
+	State      domain.AlertTargetState
+}
+
+func NewInstanceAlertTargetsWriteModel(instanceID string) *InstanceAlertTargetsWriteModel {
+	return &InstanceAlertTargetsWriteModel{
+		WriteModel: eventstore.WriteModel{
+			AggregateID:   instanceID,
+			ResourceOwner: instanceID,
+			InstanceID:    instanceID,
+		},
+	}
+}
+
+func (wm *InstanceAlertTargetsWriteModel) Reduce() error {
+	for _, event := range wm.Events {
+		switch e := event.(type) {
+		case *instance.AlertTargetAddedEvent:
+			wm.Targets = append(wm.Targets, &AlertTargetWriteModel{
+				ID:         e.ID,
+				Name:       e.Name,
+				TargetType: e.TargetType,
+				EventTypes: e.EventTypes,
+				State:      domain.AlertTargetStateActive,
+			})
+		case *instance.AlertTargetChangedEvent:
+			target := wm.target(e.ID)
+			if target == nil {
+				continue
+			}
+			if e.Name != nil {
+				target.Name = *e.Name
+			}
+			if e.TargetType != nil {
+				target.TargetType = *e.TargetType
+			}
+			if e.EventTypes != nil {
+				target.EventTypes = *e.EventTypes
+			}
+		case *instance.AlertTargetRemovedEvent:
+			if target := wm.target(e.ID); target != nil {
+				target.State = domain.AlertTargetStateRemoved
+			}
+		}
+	}
+	return wm.WriteModel.Reduce()
+}
+
+func (wm *InstanceAlertTargetsWriteModel) Query() *eventstore.SearchQueryBuilder {
+	return eventstore.NewSearchQueryBuilder(eventstore.ColumnsEvent).
+		ResourceOwner(wm.ResourceOwner).
+		AddQuery().
+		AggregateTypes(instance.AggregateType).
+		AggregateIDs(wm.AggregateID).
+		EventTypes(
+			instance.AlertTargetAddedEventType,
+			instance.AlertTargetChangedEventType,
+			instance.AlertTargetRemovedEventType).
+		Builder()
+}
+
+func (wm *InstanceAlertTargetsWriteModel) target(id string) *AlertTargetWriteModel {
+	for _, target := range wm.Targets {
+		if target.ID == id {
+			return target
+		}
+	}
+	return nil
+}
+
+// ActiveTarget returns the active alert target with the passed id, nil if there is none
+func (wm *InstanceAlertTargetsWriteModel) ActiveTarget(id string) *AlertTargetWriteModel {
+	target := wm.target(id)
+	if target == nil || target.State != domain.AlertTargetStateActive {
+		return nil
+	}
+	return target
+}
@@ -0,0 +1,432 @@
+package command
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/crypto"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/id"
+	id_mock "github.com/zitadel/zitadel/internal/id/mock"
+	"github.com/zitadel/zitadel/internal/repository/instance"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+const testAlertWebhookURL = "https://hooks.slack.com/services/T000/B000/XXXX"
+
+func testAlertTargetAddedEvent() *instance.AlertTargetAddedEvent {
+	return instance.NewAlertTargetAddedEvent(context.Background(),
+		&instance.NewAggregate("INSTANCE").Aggregate,
+		"target1",
+		"security",
+		domain.AlertTargetTypeSlack,
+		&crypto.CryptoValue{
+			CryptoType: crypto.TypeEncryption,
+			Algorithm:  "enc",
+			KeyID:      "id",
+			Crypted:    []byte(testAlertWebhookURL),
+		},
+		[]string{"org.member.added"},
+	)
+}
+
+func testAlertTargetChangedEvent(t *testing.T, changes ...instance.AlertTargetChanges) *instance.AlertTargetChangedEvent {
+	event, err := instance.NewAlertTargetChangedEvent(context.Background(),
+		&instance.NewAggregate("INSTANCE").Aggregate,
+		"target1",
+		changes,
+	)
+	require.NoError(t, err)
+	return event
+}
+
+func TestCommands_AddAlertTarget(t *testing.T) {
+	type fields struct {
+		eventstore  *eventstore.Eventstore
+		idGenerator id.Generator
+	}
+	type args struct {
+		ctx    context.Context
+		target *AlertTarget
+	}
+	type res struct {
+		id   string
+		want *domain.ObjectDetails
+		err  func(error) bool
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		args   args
+		res    res
+	}{
+		{
+			name: "missing name, error",
+			fields: fields{
+				eventstore: eventstoreExpect(t),
+			},
+			args: args{
+				ctx: authz.WithInstanceID(context.Background(), "INSTANCE"),
+				target: &AlertTarget{
+					TargetType: domain.AlertTargetTypeSlack,
+					WebhookURL: testAlertWebhookURL,
+				},
+			},
+			res: res{
+				err: zerrors.IsErrorInvalidArgument,
+			},
+		},
+		{
+			name: "invalid type, error",
+			fields: fields{
+				eventstore: eventstoreExpect(t),
+			},
+			args: args{
+				ctx: authz.WithInstanceID(context.Background(), "INSTANCE"),
+				target: &AlertTarget{
+					Name:       "security",
+					WebhookURL: testAlertWebhookURL,
+				},
+			},
+			res: res{
+				err: zerrors.IsErrorInvalidArgument,
+			},
+		},
+		{
+			name: "missing url, error",
+			fields: fields{
+				eventstore: eventstoreExpect(t),
+			},
+			args: args{
+				ctx: authz.WithInstanceID(context.Background(), "INSTANCE"),
+				target: &AlertTarget{
+					Name:       "security",
+					TargetType: domain.AlertTargetTypeTeams,
+				},
+			},
+			res: res{
+				err: zerrors.IsErrorInvalidArgument,
+			},
+		},
+		{
+			name: "insecure url, error",
+			fields: fields{
+				eventstore: eventstoreExpect(t),
+			},
+			args: args{
+				ctx: authz.WithInstanceID(context.Background(), "INSTANCE"),
+				target: &AlertTarget{
+					Name:       "security",
+					TargetType: domain.AlertTargetTypeSlack,
+					WebhookURL: "http://hooks.slack.com/services/T000/B000/XXXX",
+				},
+			},
+			res: res{
+				err: zerrors.IsErrorInvalidArgument,
+			},
+		},
+		{
+			name: "empty event type filter, error",
+			fields: fields{
+				eventstore: eventstoreExpect(t),
+			},
+			args: args{
+				ctx: authz.WithInstanceID(context.Background(), "INSTANCE"),
+				target: &AlertTarget{
+					Name:       "security",
+					TargetType: domain.AlertTargetTypeSlack,
+					WebhookURL: testAlertWebhookURL,
+					EventTypes: []string{""},
+				},
+			},
+			res: res{
+				err: zerrors.IsErrorInvalidArgument,
+			},
+		},
+		{
+			name: "add alert target, ok",
+			fields: fields{
+				eventstore: eventstoreExpect(t,
+					expectFilter(),
+					expectPush(
+						testAlertTargetAddedEvent(),
+					),
+				),
+				idGenerator: id_mock.NewIDGeneratorExpectIDs(t, "target1"),
+			},
+			args: args{
+				ctx: authz.WithInstanceID(context.Background(), "INSTANCE"),
+				target: &AlertTarget{
+					Name:       "security",
+					TargetType: domain.AlertTargetTypeSlack,
+					WebhookURL: testAlertWebhookURL,
+					EventTypes: []string{"org.member.added"},
+				},
+			},
+			res: res{
+				id: "target1",
+				want: &domain.ObjectDetails{
+					ResourceOwner: "INSTANCE",
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &Commands{
+				eventstore:    tt.fields.eventstore,
+				idGenerator:   tt.fields.idGenerator,
+				smsEncryption: crypto.CreateMockEncryptionAlg(gomock.NewController(t)),
+			}
+			id, got, err := c.AddAlertTarget(tt.args.ctx, tt.args.target)
+			if tt.res.err == nil {
+				assert.NoError(t, err)
+			}
+			if tt.res.err != nil && !tt.res.err(err) {
+				t.Errorf("got wrong err: %v ", err)
+			}
+			if tt.res.err == nil {
+				assert.Equal(t, tt.res.id, id)
+				assert.Equal(t, tt.res.want, got)
+			}
+		})
+	}
+}
+
+func TestCommands_ChangeAlertTarget(t *testing.T) {
+	type fields struct {
+		eventstore *eventstore.Eventstore
+	}
+	type args struct {
+		ctx    context.Context
+		id     string
+		target *AlertTarget
+	}
+	type res struct {
+		want *domain.ObjectDetails
+		err  func(error) bool
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		args   args
+		res    res
+	}{
+		{
+			name: "missing id, error",
+			fields: fields{
+				eventstore: eventstoreExpect(t),
+			},
+			args: args{
+				ctx: authz.WithInstanceID(context.Background(), "INSTANCE"),
+				target: &AlertTarget{
+					Name:       "security",
+					TargetType: domain.AlertTargetTypeSlack,
+				},
+			},
+			res: res{
+				err: zerrors.IsErrorInvalidArgument,
+			},
+		},
+		{
+			name: "not found, error",
+			fields: fields{
+				eventstore: eventstoreExpect(t,
+					expectFilter(),
+				),
+			},
+			args: args{
+				ctx: authz.WithInstanceID(context.Background(), "INSTANCE"),
+				id:  "target1",
+				target: &AlertTarget{
+					Name:       "security",
+					TargetType: domain.AlertTargetTypeSlack,
+				},
+			},
+			res: res{
+				err: zerrors.IsNotFound,
+			},
+		},
+		{
+			name: "no changes, precondition error",
+			fields: fields{
+				eventstore: eventstoreExpect(t,
+					expectFilter(
+						eventFromEventPusher(testAlertTargetAddedEvent()),
+					),
+				),
+			},
+			args: args{
+				ctx: authz.WithInstanceID(context.Background(), "INSTANCE"),
+				id:  "target1",
+				target: &AlertTarget{
+					Name:       "security",
+					TargetType: domain.AlertTargetTypeSlack,
+					EventTypes: []string{"org.member.added"},
+				},
+			},
+			res: res{
+				err: zerrors.IsPreconditionFailed,
+			},
+		},
+		{
+			name: "change alert target, ok",
+			fields: fields{
+				eventstore: eventstoreExpect(t,
+					expectFilter(
+						eventFromEventPusher(testAlertTargetAddedEvent()),
+					),
+					expectPush(
+						testAlertTargetChangedEvent(t,
+							instance.ChangeAlertTargetType(domain.AlertTargetTypeTeams),
+							instance.ChangeAlertTargetWebhookURL(&crypto.CryptoValue{
+								CryptoType: crypto.TypeEncryption,
+								Algorithm:  "enc",
+								KeyID:      "id",
+								Crypted:    []byte("https://example.webhook.office.com/webhookb2/xxx"),
+							}),
+							instance.ChangeAlertTargetEventTypes([]string{"org.member.added", "instance.idp.*"}),
+						),
+					),
+				),
+			},
+			args: args{
+				ctx: authz.WithInstanceID(context.Background(), "INSTANCE"),
+				id:  "target1",
+				target: &AlertTarget{
+					Name:       "security",
+					TargetType: domain.AlertTargetTypeTeams,
+					WebhookURL: "https://example.webhook.office.com/webhookb2/xxx",
+					EventTypes: []string{"org.member.added", "instance.idp.*"},
+				},
+			},
+			res: res{
+				want: &domain.ObjectDetails{
+					ResourceOwner: "INSTANCE",
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &Commands{
+				eventstore:    tt.fields.eventstore,
+				smsEncryption: crypto.CreateMockEncryptionAlg(gomock.NewController(t)),
+			}
+			got, err := c.ChangeAlertTarget(tt.args.ctx, tt.args.id, tt.args.target)
+			if tt.res.err == nil {
+				assert.NoError(t, err)
+			}
+			if tt.res.err != nil && !tt.res.err(err) {
+				t.Errorf("got wrong err: %v ", err)
+			}
+			if tt.res.err == nil {
+				assert.Equal(t, tt.res.want, got)
+			}
+		})
+	}
+}
+
+func TestCommands_RemoveAlertTarget(t *testing.T) {
+	type fields struct {
+		eventstore *eventstore.Eventstore
+	}
+	type args struct {
+		ctx context.Context
+		id  string
+	}
+	type res struct {
+		want *domain.ObjectDetails
+		err  func(error) bool
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		args   args
+		res    res
+	}{
+		{
+			name: "missing id, error",
+			fields: fields{
+				eventstore: eventstoreExpect(t),
+			},
+			args: args{
+				ctx: authz.WithInstanceID(context.Background(), "INSTANCE"),
+			},
+			res: res{
+				err: zerrors.IsErrorInvalidArgument,
+			},
+		},
+		{
+			name: "already removed, not found error",
+			fields: fields{
+				eventstore: eventstoreExpect(t,
+					expectFilter(
+						eventFromEventPusher(testAlertTargetAddedEvent()),
+						eventFromEventPusher(
+							instance.NewAlertTargetRemovedEvent(context.Background(),
+								&instance.NewAggregate("INSTANCE").Aggregate,
+								"target1",
+							),
+						),
+					),
+				),
+			},
+			args: args{
+				ctx: authz.WithInstanceID(context.Background(), "INSTANCE"),
+				id:  "target1",
+			},
+			res: res{
+				err: zerrors.IsNotFound,
+			},
+		},
+		{
+			name: "remove alert target, ok",
+			fields: fields{
+				eventstore: eventstoreExpect(t,
+					expectFilter(
+						eventFromEventPusher(testAlertTargetAddedEvent()),
+					),
+					expectPush(
+						instance.NewAlertTargetRemovedEvent(context.Background(),
+							&instance.NewAggregate("INSTANCE").Aggregate,
+							"target1",
+						),
+					),
+				),
+			},
+			args: args{
+				ctx: authz.WithInstanceID(context.Background(), "INSTANCE"),
+				id:  "target1",
+			},
+			res: res{
+				want: &domain.ObjectDetails{
+					ResourceOwner: "INSTANCE",
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &Commands{
+				eventstore: tt.fields.eventstore,
+			}
+			got, err := c.RemoveAlertTarget(tt.args.ctx, tt.args.id)
+			if tt.res.err == nil {
+				assert.NoError(t, err)
+			}
+			if tt.res.err != nil && !tt.res.err(err) {
+				t.Errorf("got wrong err: %v ", err)
+			}
+			if tt.res.err == nil {
+				assert.Equal(t, tt.res.want, got)
+			}
+		})
+	}
+}
@@ -55,3 +55,7 @@ func (wm *InstanceCustomLoginTextReadModel) Query() *eventstore.SearchQueryBuild
 			instance.CustomTextTemplateRemovedEventType).
 		Builder()
 }
+
+func (wm *InstanceCustomLoginTextReadModel) SnapshotType() string {
+	return "instance_custom_login_text.v1." + wm.Language.String()
+}
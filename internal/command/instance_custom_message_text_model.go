@@ -53,3 +53,7 @@ func (wm *InstanceCustomMessageTextWriteModel) Query() *eventstore.SearchQueryBu
 		EventTypes(instance.CustomTextSetEventType, instance.CustomTextRemovedEventType, instance.CustomTextTemplateRemovedEventType).
 		Builder()
 }
+
+func (wm *InstanceCustomMessageTextWriteModel) SnapshotType() string {
+	return "instance_custom_message_text.v1." + wm.MessageTextType + "." + wm.Language.String()
+}
@@ -89,7 +89,7 @@ func (c *Commands) addInstanceDomain(a *instance.Aggregate, instanceDomain strin
 		if instanceDomain = strings.TrimSpace(instanceDomain); instanceDomain == "" {
 			return nil, zerrors.ThrowInvalidArgument(nil, "INST-28nlD", "Errors.Invalid.Argument")
 		}
-		if !allowDomainRunes.MatchString(instanceDomain) {
+		if !isValidInstanceDomain(instanceDomain) {
 			return nil, zerrors.ThrowInvalidArgument(nil, "INST-S3v3w", "Errors.Instance.Domain.InvalidCharacter")
 		}
 		return func(ctx context.Context, filter preparation.FilterToQueryReducer) ([]eventstore.Command, error) {
@@ -103,6 +103,10 @@ func (c *Commands) addInstanceDomain(a *instance.Aggregate, instanceDomain strin
 			events := []eventstore.Command{
 				instance.NewDomainAddedEvent(ctx, &a.Aggregate, instanceDomain, generated),
 			}
+			// a wildcard domain can't be used as redirect of console
+			if domain.IsWildcardInstanceDomain(instanceDomain) {
+				return events, nil
+			}
 			consoleChangeEvent, err := c.updateConsoleRedirectURIs(ctx, filter, instanceDomain)
 			if err != nil {
 				return nil, err
@@ -176,6 +180,9 @@ func setPrimaryInstanceDomain(a *instance.Aggregate, instanceDomain string) prep
 			if !domainWriteModel.State.Exists() {
 				return nil, zerrors.ThrowNotFound(nil, "INSTANCE-9nkWf", "Errors.Instance.Domain.NotFound")
 			}
+			if domain.IsWildcardInstanceDomain(instanceDomain) {
+				return nil, zerrors.ThrowPreconditionFailed(nil, "INSTANCE-Wc2pr", "Errors.Instance.Domain.WildcardNotPrimary")
+			}
 			return []eventstore.Command{instance.NewDomainPrimarySetEvent(ctx, &a.Aggregate, instanceDomain)}, nil
 		}, nil
 	}
@@ -202,6 +209,16 @@ func removeInstanceDomain(a *instance.Aggregate, instanceDomain string) preparat
 	}
 }
 
+// isValidInstanceDomain checks the characters of the domain,
+// a wildcard domain like *.example.com must consist of at least two labels after the wildcard.
+func isValidInstanceDomain(instanceDomain string) bool {
+	if domain.IsWildcardInstanceDomain(instanceDomain) {
+		parent := strings.TrimPrefix(instanceDomain, "*.")
+		return strings.Contains(parent, ".") && allowDomainRunes.MatchString(parent)
+	}
+	return allowDomainRunes.MatchString(instanceDomain)
+}
+
 func getInstanceDomainWriteModel(ctx context.Context, filter preparation.FilterToQueryReducer, domain string) (*InstanceDomainWriteModel, error) {
 	domainWriteModel := NewInstanceDomainWriteModel(ctx, domain)
 	events, err := filter(ctx, domainWriteModel.Query())
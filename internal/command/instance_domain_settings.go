@@ -0,0 +1,123 @@
+package command
+
+import (
+	"context"
+	"strings"
+
+	"golang.org/x/text/language"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/command/preparation"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/i18n"
+	"github.com/zitadel/zitadel/internal/repository/instance"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+// InstanceDomainSettings overwrite the settings of the instance for requests on a specific instance domain.
+// Empty values fall back to the settings of the instance.
+type InstanceDomainSettings struct {
+	// BrandingOrgID is the organization whose branding is used on the domain
+	BrandingOrgID   string
+	DefaultLanguage language.Tag
+}
+
+func (c *Commands) SetInstanceDomainSettings(ctx context.Context, instanceDomain string, settings *InstanceDomainSettings) (*domain.ObjectDetails, error) {
+	instanceAgg := instance.NewAggregate(authz.GetInstance(ctx).InstanceID())
+	validation := setInstanceDomainSettings(instanceAgg, instanceDomain, settings)
+	cmds, err := preparation.PrepareCommands(ctx, c.eventstore.Filter, validation)
+	if err != nil {
+		return nil, err
+	}
+	events, err := c.eventstore.Push(ctx, cmds...)
+	if err != nil {
+		return nil, err
+	}
+	return pushedEventsToObjectDetails(events), nil
+}
+
+func (c *Commands) RemoveInstanceDomainSettings(ctx context.Context, instanceDomain string) (*domain.ObjectDetails, error) {
+	instanceAgg := instance.NewAggregate(authz.GetInstance(ctx).InstanceID())
+	validation := removeInstanceDomainSettings(instanceAgg, instanceDomain)
+	cmds, err := preparation.PrepareCommands(ctx, c.eventstore.Filter, validation)
+	if err != nil {
+		return nil, err
+	}
+	events, err := c.eventstore.Push(ctx, cmds...)
+	if err != nil {
+		return nil, err
+	}
+	return pushedEventsToObjectDetails(events), nil
+}
+
+func setInstanceDomainSettings(a *instance.Aggregate, instanceDomain string, settings *InstanceDomainSettings) preparation.Validation {
+	return func() (preparation.CreateCommands, error) {
+		if instanceDomain = strings.TrimSpace(instanceDomain); instanceDomain == "" || settings == nil {
+			return nil, zerrors.ThrowInvalidArgument(nil, "INST-Ds9ek", "Errors.Invalid.Argument")
+		}
+		if !settings.DefaultLanguage.IsRoot() {
+			if err := domain.LanguagesAreSupported(i18n.SupportedLanguages(), settings.DefaultLanguage); err != nil {
+				return nil, err
+			}
+		}
+		return func(ctx context.Context, filter preparation.FilterToQueryReducer) ([]eventstore.Command, error) {
+			writeModel, err := getInstanceDomainSettingsWriteModel(ctx, filter, instanceDomain)
+			if err != nil {
+				return nil, err
+			}
+			if writeModel.State != domain.InstanceDomainStateActive {
+				return nil, zerrors.ThrowNotFound(nil, "INSTANCE-Ds2nf", "Errors.Instance.Domain.NotFound")
+			}
+			if writeModel.HasSettings &&
+				writeModel.BrandingOrgID == settings.BrandingOrgID &&
+				writeModel.DefaultLanguage == settings.DefaultLanguage {
+				return nil, zerrors.ThrowPreconditionFailed(nil, "INSTANCE-Ds4nc", "Errors.Instance.Domain.Settings.NotChanged")
+			}
+			if settings.BrandingOrgID != "" {
+				exists, err := ExistsOrg(ctx, filter, settings.BrandingOrgID)
+				if err != nil {
+					return nil, err
+				}
+				if !exists {
+					return nil, zerrors.ThrowPreconditionFailed(nil, "INSTANCE-Ds5bo", "Errors.Org.NotFound")
+				}
+			}
+			return []eventstore.Command{
+				instance.NewDomainSettingsSetEvent(ctx, &a.Aggregate, instanceDomain, settings.BrandingOrgID, settings.DefaultLanguage),
+			}, nil
+		}, nil
+	}
+}
+
+func removeInstanceDomainSettings(a *instance.Aggregate, instanceDomain string) preparation.Validation {
+	return func() (preparation.CreateCommands, error) {
+		if instanceDomain = strings.TrimSpace(instanceDomain); instanceDomain == "" {
+			return nil, zerrors.ThrowInvalidArgument(nil, "INST-Dr3mk", "Errors.Invalid.Argument")
+		}
+		return func(ctx context.Context, filter preparation.FilterToQueryReducer) ([]eventstore.Command, error) {
+			writeModel, err := getInstanceDomainSettingsWriteModel(ctx, filter, instanceDomain)
+			if err != nil {
+				return nil, err
+			}
+			if writeModel.State != domain.InstanceDomainStateActive || !writeModel.HasSettings {
+				return nil, zerrors.ThrowNotFound(nil, "INSTANCE-Dr4nf", "Errors.Instance.Domain.Settings.NotFound")
+			}
+			return []eventstore.Command{instance.NewDomainSettingsRemovedEvent(ctx, &a.Aggregate, instanceDomain)}, nil
+		}, nil
+	}
+}
+
+func getInstanceDomainSettingsWriteModel(ctx context.Context, filter preparation.FilterToQueryReducer, instanceDomain string) (*InstanceDomainSettingsWriteModel, error) {
+	writeModel := NewInstanceDomainSettingsWriteModel(ctx, instanceDomain)
+	events, err := filter(ctx, writeModel.Query())
+	if err != nil {
+		return nil, err
+	}
+	if len(events) == 0 {
+		return writeModel, nil
+	}
+	writeModel.AppendEvents(events...)
+	err = writeModel.Reduce()
+	return writeModel, err
+}
@@ -0,0 +1,98 @@
+package command
+
+import (
+	"context"
+
+	"golang.org/x/text/language"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/repository/instance"
+)
+
+type InstanceDomainSettingsWriteModel struct {
+	eventstore.WriteModel
+
+	Domain          string
+	State           domain.InstanceDomainState
+	HasSettings     bool
+	BrandingOrgID   string
+	DefaultLanguage language.Tag
+}
+
+func NewInstanceDomainSettingsWriteModel(ctx context.Context, instanceDomain string) *InstanceDomainSettingsWriteModel {
+	return &InstanceDomainSettingsWriteModel{
+		WriteModel: eventstore.WriteModel{
+			AggregateID:   authz.GetInstance(ctx).InstanceID(),
+			ResourceOwner: authz.GetInstance(ctx).InstanceID(),
+		},
+		Domain: instanceDomain,
+	}
+}
+
+func (wm *InstanceDomainSettingsWriteModel) AppendEvents(events ...eventstore.Event) {
+	for _, event := range events {
+		switch e := event.(type) {
+		case *instance.DomainAddedEvent:
+			if e.Domain != wm.Domain {
+				continue
+			}
+			wm.WriteModel.AppendEvents(e)
+		case *instance.DomainRemovedEvent:
+			if e.Domain != wm.Domain {
+				continue
+			}
+			wm.WriteModel.AppendEvents(e)
+		case *instance.DomainSettingsSetEvent:
+			if e.Domain != wm.Domain {
+				continue
+			}
+			wm.WriteModel.AppendEvents(e)
+		case *instance.DomainSettingsRemovedEvent:
+			if e.Domain != wm.Domain {
+				continue
+			}
+			wm.WriteModel.AppendEvents(e)
+		}
+	}
+}
+
+func (wm *InstanceDomainSettingsWriteModel) Reduce() error {
+	for _, event := range wm.Events {
+		switch e := event.(type) {
+		case *instance.DomainAddedEvent:
+			wm.State = domain.InstanceDomainStateActive
+		case *instance.DomainRemovedEvent:
+			wm.State = domain.InstanceDomainStateRemoved
+			wm.reset()
+		case *instance.DomainSettingsSetEvent:
+			wm.HasSettings = true
+			wm.BrandingOrgID = e.BrandingOrgID
+			wm.DefaultLanguage = e.DefaultLanguage
+		case *instance.DomainSettingsRemovedEvent:
+			wm.reset()
+		}
+	}
+	return wm.WriteModel.Reduce()
+}
+
+func (wm *InstanceDomainSettingsWriteModel) Query() *eventstore.SearchQueryBuilder {
+	return eventstore.NewSearchQueryBuilder(eventstore.ColumnsEvent).
+		ResourceOwner(wm.ResourceOwner).
+		AddQuery().
+		AggregateTypes(instance.AggregateType).
+		AggregateIDs(wm.AggregateID).
+		EventTypes(
+			instance.InstanceDomainAddedEventType,
+			instance.InstanceDomainRemovedEventType,
+			instance.InstanceDomainSettingsSetEventType,
+			instance.InstanceDomainSettingsRemovedEventType).
+		Builder()
+}
+
+func (wm *InstanceDomainSettingsWriteModel) reset() {
+	wm.HasSettings = false
+	wm.BrandingOrgID = ""
+	wm.DefaultLanguage = language.Und
+}
@@ -94,6 +94,36 @@ func TestCommandSide_AddInstanceDomain(t *testing.T) {
 				err: zerrors.IsErrorInvalidArgument,
 			},
 		},
+		{
+			name: "invalid wildcard domain, error",
+			fields: fields{
+				eventstore: eventstoreExpect(
+					t,
+				),
+			},
+			args: args{
+				ctx:    context.Background(),
+				domain: "*.ch",
+			},
+			res: res{
+				err: zerrors.IsErrorInvalidArgument,
+			},
+		},
+		{
+			name: "invalid nested wildcard domain, error",
+			fields: fields{
+				eventstore: eventstoreExpect(
+					t,
+				),
+			},
+			args: args{
+				ctx:    context.Background(),
+				domain: "*.*.domain.ch",
+			},
+			res: res{
+				err: zerrors.IsErrorInvalidArgument,
+			},
+		},
 		{
 			name: "domain already exists, precondition error",
 			fields: fields{
@@ -155,6 +185,8 @@ func TestCommandSide_AddInstanceDomain(t *testing.T) {
 								time.Second*1,
 								[]string{"https://sub.test.ch"},
 								false,
+								"",
+								"",
 							),
 						),
 					),
@@ -184,6 +216,32 @@ func TestCommandSide_AddInstanceDomain(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "wildcard domain add, console not changed, ok",
+			fields: fields{
+				eventstore: eventstoreExpect(
+					t,
+					expectFilter(),
+					expectPush(
+						instance.NewDomainAddedEvent(context.Background(),
+							&instance.NewAggregate("INSTANCE").Aggregate,
+							"*.domain.ch",
+							false,
+						),
+					),
+				),
+				externalSecure: true,
+			},
+			args: args{
+				ctx:    authz.WithInstance(context.Background(), new(mockInstance)),
+				domain: "*.domain.ch",
+			},
+			res: res{
+				want: &domain.ObjectDetails{
+					ResourceOwner: "INSTANCE",
+				},
+			},
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -252,6 +310,31 @@ func TestCommandSide_SetPrimaryInstanceDomain(t *testing.T) {
 				err: zerrors.IsNotFound,
 			},
 		},
+		{
+			name: "wildcard domain, precondition error",
+			fields: fields{
+				eventstore: eventstoreExpect(
+					t,
+					expectFilter(
+						eventFromEventPusherWithInstanceID(
+							"INSTANCE",
+							instance.NewDomainAddedEvent(context.Background(),
+								&instance.NewAggregate("INSTANCE").Aggregate,
+								"*.domain.ch",
+								false,
+							),
+						),
+					),
+				),
+			},
+			args: args{
+				ctx:    authz.WithInstanceID(context.Background(), "INSTANCE"),
+				domain: "*.domain.ch",
+			},
+			res: res{
+				err: zerrors.IsPreconditionFailed,
+			},
+		},
 		{
 			name: "set primary domain, ok",
 			fields: fields{
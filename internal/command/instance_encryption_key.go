@@ -0,0 +1,252 @@
+package command
+
+import (
+	"context"
+	"sort"
+	"sync"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/crypto"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/repository/instance"
+	"github.com/zitadel/zitadel/internal/telemetry/tracing"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+const defaultReencryptionBatchSize = 100
+
+// SetInstanceEncryptionKey generates a new customer managed key for the instance,
+// wraps it with the key identified by keyURI of the key management service and activates it.
+// Secrets encrypted afterwards use the new key.
+func (c *Commands) SetInstanceEncryptionKey(ctx context.Context, provider, keyURI string) (*domain.ObjectDetails, error) {
+	if provider == "" || keyURI == "" {
+		return nil, zerrors.ThrowInvalidArgument(nil, "COMMAND-Ha2mx", "Errors.Instance.EncryptionKey.Invalid")
+	}
+	writeModel, err := c.getInstanceEncryptionKeyWriteModel(ctx, authz.GetInstance(ctx).InstanceID())
+	if err != nil {
+		return nil, err
+	}
+	return c.addInstanceEncryptionKey(ctx, writeModel, provider, keyURI)
+}
+
+// RotateInstanceEncryptionKey replaces the active customer managed key of the instance
+// by a new one wrapped with the same key of the key management service.
+// Existing secrets can be re-encrypted using [Commands.ReencryptInstanceSecrets].
+func (c *Commands) RotateInstanceEncryptionKey(ctx context.Context) (*domain.ObjectDetails, error) {
+	writeModel, err := c.getInstanceEncryptionKeyWriteModel(ctx, authz.GetInstance(ctx).InstanceID())
+	if err != nil {
+		return nil, err
+	}
+	active := writeModel.ActiveKey()
+	if active == nil {
+		return nil, zerrors.ThrowNotFound(nil, "COMMAND-Ke9sa", "Errors.Instance.EncryptionKey.NotFound")
+	}
+	return c.addInstanceEncryptionKey(ctx, writeModel, active.Provider, active.KeyURI)
+}
+
+// RemoveInstanceEncryptionKey switches the instance back to the system keys.
+// The customer managed keys are kept to be able to decrypt existing secrets.
+func (c *Commands) RemoveInstanceEncryptionKey(ctx context.Context) (*domain.ObjectDetails, error) {
+	writeModel, err := c.getInstanceEncryptionKeyWriteModel(ctx, authz.GetInstance(ctx).InstanceID())
+	if err != nil {
+		return nil, err
+	}
+	if writeModel.ActiveKeyID == "" {
+		return nil, zerrors.ThrowNotFound(nil, "COMMAND-Gq3xv", "Errors.Instance.EncryptionKey.NotFound")
+	}
+	err = c.pushAppendAndReduce(ctx, writeModel, instance.NewEncryptionKeyRemovedEvent(ctx, InstanceAggregateFromWriteModel(&writeModel.WriteModel)))
+	if err != nil {
+		return nil, err
+	}
+	return writeModelToObjectDetails(&writeModel.WriteModel), nil
+}
+
+// ReencryptInstanceSecrets re-encrypts the client secrets and private keys of all identity providers
+// of the instance and its organizations which are not yet encrypted with the active key.
+// The events are pushed in batches of batchSize.
+// It returns the number of re-encrypted secrets.
+func (c *Commands) ReencryptInstanceSecrets(ctx context.Context, batchSize int) (_ int, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	if batchSize <= 0 {
+		batchSize = defaultReencryptionBatchSize
+	}
+	writeModel := newIDPSecretsWriteModel(authz.GetInstance(ctx).InstanceID())
+	if err = c.eventstore.FilterToQueryReducer(ctx, writeModel); err != nil {
+		return 0, err
+	}
+	alg := crypto.ForContext(ctx, c.idpConfigEncryption)
+	cmds := make([]eventstore.Command, 0, batchSize)
+	ids := make([]string, 0, len(writeModel.secrets))
+	for id := range writeModel.secrets {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+	var count int
+	for _, id := range ids {
+		secret := writeModel.secrets[id]
+		if secret.secret == nil || secret.secret.KeyID == alg.EncryptionKeyID() {
+			continue
+		}
+		decrypted, err := crypto.Decrypt(secret.secret, c.idpConfigEncryption)
+		if err != nil {
+			return count, err
+		}
+		crypted, err := crypto.Encrypt(decrypted, alg)
+		if err != nil {
+			return count, err
+		}
+		cmd, err := secret.secretChangedEvent(ctx, crypted)
+		if err != nil {
+			return count, err
+		}
+		cmds = append(cmds, cmd)
+		if len(cmds) < batchSize {
+			continue
+		}
+		if _, err = c.eventstore.Push(ctx, cmds...); err != nil {
+			return count, err
+		}
+		count += len(cmds)
+		cmds = cmds[:0]
+	}
+	if len(cmds) == 0 {
+		return count, nil
+	}
+	if _, err = c.eventstore.Push(ctx, cmds...); err != nil {
+		return count, err
+	}
+	return count + len(cmds), nil
+}
+
+func (c *Commands) addInstanceEncryptionKey(ctx context.Context, writeModel *InstanceEncryptionKeyWriteModel, provider, keyURI string) (*domain.ObjectDetails, error) {
+	kms, err := c.instanceKMS.Get(provider)
+	if err != nil {
+		return nil, err
+	}
+	id, err := c.idGenerator.Next()
+	if err != nil {
+		return nil, err
+	}
+	key, err := crypto.NewKey(crypto.InstanceKeyID(writeModel.AggregateID, id))
+	if err != nil {
+		return nil, err
+	}
+	wrappedKey, err := kms.Encrypt(ctx, keyURI, []byte(key.Value))
+	if err != nil {
+		return nil, zerrors.ThrowPreconditionFailed(err, "COMMAND-Vb4sk", "Errors.Instance.EncryptionKey.Unavailable")
+	}
+	err = c.pushAppendAndReduce(ctx, writeModel, instance.NewEncryptionKeyAddedEvent(
+		ctx,
+		InstanceAggregateFromWriteModel(&writeModel.WriteModel),
+		key.ID,
+		provider,
+		keyURI,
+		wrappedKey,
+	))
+	if err != nil {
+		return nil, err
+	}
+	return writeModelToObjectDetails(&writeModel.WriteModel), nil
+}
+
+func (c *Commands) getInstanceEncryptionKeyWriteModel(ctx context.Context, instanceID string) (*InstanceEncryptionKeyWriteModel, error) {
+	writeModel := NewInstanceEncryptionKeyWriteModel(instanceID)
+	if err := c.eventstore.FilterToQueryReducer(ctx, writeModel); err != nil {
+		return nil, err
+	}
+	return writeModel, nil
+}
+
+var _ crypto.InstanceKeyProvider = (*InstanceKeys)(nil)
+
+// InstanceKeys resolves the customer managed keys of the instances from the eventstore
+// and unwraps them using the key management services.
+// Unwrapped keys are cached in memory.
+type InstanceKeys struct {
+	eventstore *eventstore.Eventstore
+	kms        crypto.KMSProviders
+
+	mu   sync.RWMutex
+	keys map[string]string
+}
+
+func NewInstanceKeys(es *eventstore.Eventstore, kms crypto.KMSProviders) *InstanceKeys {
+	return &InstanceKeys{
+		eventstore: es,
+		kms:        kms,
+		keys:       make(map[string]string),
+	}
+}
+
+func (k *InstanceKeys) ActiveKey(ctx context.Context) (string, string, error) {
+	instanceID := authz.GetInstance(ctx).InstanceID()
+	if instanceID == "" {
+		return "", "", nil
+	}
+	writeModel, err := k.writeModel(ctx, instanceID)
+	if err != nil {
+		return "", "", err
+	}
+	if writeModel.ActiveKeyID == "" {
+		return "", "", nil
+	}
+	key, err := k.unwrap(ctx, writeModel, writeModel.ActiveKeyID)
+	if err != nil {
+		return "", "", err
+	}
+	return writeModel.ActiveKeyID, key, nil
+}
+
+func (k *InstanceKeys) Key(ctx context.Context, id string) (string, error) {
+	k.mu.RLock()
+	key, ok := k.keys[id]
+	k.mu.RUnlock()
+	if ok {
+		return key, nil
+	}
+	instanceID, ok := crypto.InstanceIDFromKeyID(id)
+	if !ok {
+		return "", zerrors.ThrowNotFound(nil, "COMMAND-Xo3kd", "Errors.Instance.EncryptionKey.NotFound")
+	}
+	writeModel, err := k.writeModel(ctx, instanceID)
+	if err != nil {
+		return "", err
+	}
+	return k.unwrap(ctx, writeModel, id)
+}
+
+func (k *InstanceKeys) writeModel(ctx context.Context, instanceID string) (*InstanceEncryptionKeyWriteModel, error) {
+	writeModel := NewInstanceEncryptionKeyWriteModel(instanceID)
+	if err := k.eventstore.FilterToQueryReducer(ctx, writeModel); err != nil {
+		return nil, err
+	}
+	return writeModel, nil
+}
+
+func (k *InstanceKeys) unwrap(ctx context.Context, writeModel *InstanceEncryptionKeyWriteModel, id string) (string, error) {
+	k.mu.RLock()
+	key, ok := k.keys[id]
+	k.mu.RUnlock()
+	if ok {
+		return key, nil
+	}
+	wrapped, ok := writeModel.Keys[id]
+	if !ok {
+		return "", zerrors.ThrowNotFound(nil, "COMMAND-Lm2wq", "Errors.Instance.EncryptionKey.NotFound")
+	}
+	kms, err := k.kms.Get(wrapped.Provider)
+	if err != nil {
+		return "", err
+	}
+	unwrapped, err := kms.Decrypt(ctx, wrapped.KeyURI, wrapped.WrappedKey)
+	if err != nil {
+		return "", zerrors.ThrowInternal(err, "COMMAND-Tn5aw", "Errors.Instance.EncryptionKey.Unavailable")
+	}
+	k.mu.Lock()
+	k.keys[id] = string(unwrapped)
+	k.mu.Unlock()
+	return string(unwrapped), nil
+}
@@ -0,0 +1,316 @@
+package command
+
+import (
+	"context"
+
+	"github.com/zitadel/zitadel/internal/crypto"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/repository/idp"
+	"github.com/zitadel/zitadel/internal/repository/instance"
+	"github.com/zitadel/zitadel/internal/repository/org"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+type InstanceEncryptionKeyWriteModel struct {
+	eventstore.WriteModel
+
+	ActiveKeyID string
+	Keys        map[string]*instanceEncryptionKey
+}
+
+type instanceEncryptionKey struct {
+	Provider   string
+	KeyURI     string
+	WrappedKey []byte
+}
+
+func NewInstanceEncryptionKeyWriteModel(instanceID string) *InstanceEncryptionKeyWriteModel {
+	return &InstanceEncryptionKeyWriteModel{
+		WriteModel: eventstore.WriteModel{
+			AggregateID:   instanceID,
+			ResourceOwner: instanceID,
+			InstanceID:    instanceID,
+		},
+		Keys: make(map[string]*instanceEncryptionKey),
+	}
+}
+
+func (wm *InstanceEncryptionKeyWriteModel) Reduce() error {
+	for _, event := range wm.Events {
+		switch e := event.(type) {
+		case *instance.EncryptionKeyAddedEvent:
+			wm.Keys[e.KeyID] = &instanceEncryptionKey{
+				Provider:   e.Provider,
+				KeyURI:     e.KeyURI,
+				WrappedKey: e.WrappedKey,
+			}
+			wm.ActiveKeyID = e.KeyID
+		case *instance.EncryptionKeyRemovedEvent:
+			wm.ActiveKeyID = ""
+		}
+	}
+	return wm.WriteModel.Reduce()
+}
+
+func (wm *InstanceEncryptionKeyWriteModel) Query() *eventstore.SearchQueryBuilder {
+	return eventstore.NewSearchQueryBuilder(eventstore.ColumnsEvent).
+		InstanceID(wm.InstanceID).
+		ResourceOwner(wm.ResourceOwner).
+		AddQuery().
+		AggregateTypes(instance.AggregateType).
+		AggregateIDs(wm.AggregateID).
+		EventTypes(
+			instance.EncryptionKeyAddedEventType,
+			instance.EncryptionKeyRemovedEventType).
+		Builder()
+}
+
+func (wm *InstanceEncryptionKeyWriteModel) ActiveKey() *instanceEncryptionKey {
+	return wm.Keys[wm.ActiveKeyID]
+}
+
+// idpSecretsWriteModel collects the encrypted client secrets and private keys
+// of all identity providers of the instance and its organizations.
+type idpSecretsWriteModel struct {
+	eventstore.WriteModel
+
+	secrets map[string]*idpSecret
+}
+
+type idpSecret struct {
+	aggregate *eventstore.Aggregate
+	id        string
+	idpType   domain.IDPType
+	secret    *crypto.CryptoValue
+}
+
+func newIDPSecretsWriteModel(instanceID string) *idpSecretsWriteModel {
+	return &idpSecretsWriteModel{
+		WriteModel: eventstore.WriteModel{
+			AggregateID:   instanceID,
+			ResourceOwner: instanceID,
+			InstanceID:    instanceID,
+		},
+		secrets: make(map[string]*idpSecret),
+	}
+}
+
+func (wm *idpSecretsWriteModel) Reduce() error {
+	for _, event := range wm.Events {
+		switch e := event.(type) {
+		case *instance.OAuthIDPAddedEvent:
+			wm.setSecret(e.Aggregate(), e.ID, domain.IDPTypeOAuth, e.ClientSecret)
+		case *instance.OAuthIDPChangedEvent:
+			wm.setSecret(e.Aggregate(), e.ID, domain.IDPTypeOAuth, e.ClientSecret)
+		case *instance.OIDCIDPAddedEvent:
+			wm.setSecret(e.Aggregate(), e.ID, domain.IDPTypeOIDC, e.ClientSecret)
+		case *instance.OIDCIDPChangedEvent:
+			wm.setSecret(e.Aggregate(), e.ID, domain.IDPTypeOIDC, e.ClientSecret)
+		case *instance.AzureADIDPAddedEvent:
+			wm.setSecret(e.Aggregate(), e.ID, domain.IDPTypeAzureAD, e.ClientSecret)
+		case *instance.AzureADIDPChangedEvent:
+			wm.setSecret(e.Aggregate(), e.ID, domain.IDPTypeAzureAD, e.ClientSecret)
+		case *instance.GitHubIDPAddedEvent:
+			wm.setSecret(e.Aggregate(), e.ID, domain.IDPTypeGitHub, e.ClientSecret)
+		case *instance.GitHubIDPChangedEvent:
+			wm.setSecret(e.Aggregate(), e.ID, domain.IDPTypeGitHub, e.ClientSecret)
+		case *instance.GitHubEnterpriseIDPAddedEvent:
+			wm.setSecret(e.Aggregate(), e.ID, domain.IDPTypeGitHubEnterprise, e.ClientSecret)
+		case *instance.GitHubEnterpriseIDPChangedEvent:
+			wm.setSecret(e.Aggregate(), e.ID, domain.IDPTypeGitHubEnterprise, e.ClientSecret)
+		case *instance.GitLabIDPAddedEvent:
+			wm.setSecret(e.Aggregate(), e.ID, domain.IDPTypeGitLab, e.ClientSecret)
+		case *instance.GitLabIDPChangedEvent:
+			wm.setSecret(e.Aggregate(), e.ID, domain.IDPTypeGitLab, e.ClientSecret)
+		case *instance.GitLabSelfHostedIDPAddedEvent:
+			wm.setSecret(e.Aggregate(), e.ID, domain.IDPTypeGitLabSelfHosted, e.ClientSecret)
+		case *instance.GitLabSelfHostedIDPChangedEvent:
+			wm.setSecret(e.Aggregate(), e.ID, domain.IDPTypeGitLabSelfHosted, e.ClientSecret)
+		case *instance.GoogleIDPAddedEvent:
+			wm.setSecret(e.Aggregate(), e.ID, domain.IDPTypeGoogle, e.ClientSecret)
+		case *instance.GoogleIDPChangedEvent:
+			wm.setSecret(e.Aggregate(), e.ID, domain.IDPTypeGoogle, e.ClientSecret)
+		case *instance.AppleIDPAddedEvent:
+			wm.setSecret(e.Aggregate(), e.ID, domain.IDPTypeApple, e.PrivateKey)
+		case *instance.AppleIDPChangedEvent:
+			wm.setSecret(e.Aggregate(), e.ID, domain.IDPTypeApple, e.PrivateKey)
+		case *instance.OIDCIDPMigratedAzureADEvent:
+			wm.setSecret(e.Aggregate(), e.ID, domain.IDPTypeAzureAD, e.ClientSecret)
+		case *instance.OIDCIDPMigratedGoogleEvent:
+			wm.setSecret(e.Aggregate(), e.ID, domain.IDPTypeGoogle, e.ClientSecret)
+		case *instance.IDPRemovedEvent:
+			delete(wm.secrets, e.ID)
+		case *org.OAuthIDPAddedEvent:
+			wm.setSecret(e.Aggregate(), e.ID, domain.IDPTypeOAuth, e.ClientSecret)
+		case *org.OAuthIDPChangedEvent:
+			wm.setSecret(e.Aggregate(), e.ID, domain.IDPTypeOAuth, e.ClientSecret)
+		case *org.OIDCIDPAddedEvent:
+			wm.setSecret(e.Aggregate(), e.ID, domain.IDPTypeOIDC, e.ClientSecret)
+		case *org.OIDCIDPChangedEvent:
+			wm.setSecret(e.Aggregate(), e.ID, domain.IDPTypeOIDC, e.ClientSecret)
+		case *org.AzureADIDPAddedEvent:
+			wm.setSecret(e.Aggregate(), e.ID, domain.IDPTypeAzureAD, e.ClientSecret)
+		case *org.AzureADIDPChangedEvent:
+			wm.setSecret(e.Aggregate(), e.ID, domain.IDPTypeAzureAD, e.ClientSecret)
+		case *org.GitHubIDPAddedEvent:
+			wm.setSecret(e.Aggregate(), e.ID, domain.IDPTypeGitHub, e.ClientSecret)
+		case *org.GitHubIDPChangedEvent:
+			wm.setSecret(e.Aggregate(), e.ID, domain.IDPTypeGitHub, e.ClientSecret)
+		case *org.GitHubEnterpriseIDPAddedEvent:
+			wm.setSecret(e.Aggregate(), e.ID, domain.IDPTypeGitHubEnterprise, e.ClientSecret)
+		case *org.GitHubEnterpriseIDPChangedEvent:
+			wm.setSecret(e.Aggregate(), e.ID, domain.IDPTypeGitHubEnterprise, e.ClientSecret)
+		case *org.GitLabIDPAddedEvent:
+			wm.setSecret(e.Aggregate(), e.ID, domain.IDPTypeGitLab, e.ClientSecret)
+		case *org.GitLabIDPChangedEvent:
+			wm.setSecret(e.Aggregate(), e.ID, domain.IDPTypeGitLab, e.ClientSecret)
+		case *org.GitLabSelfHostedIDPAddedEvent:
+			wm.setSecret(e.Aggregate(), e.ID, domain.IDPTypeGitLabSelfHosted, e.ClientSecret)
+		case *org.GitLabSelfHostedIDPChangedEvent:
+			wm.setSecret(e.Aggregate(), e.ID, domain.IDPTypeGitLabSelfHosted, e.ClientSecret)
+		case *org.GoogleIDPAddedEvent:
+			wm.setSecret(e.Aggregate(), e.ID, domain.IDPTypeGoogle, e.ClientSecret)
+		case *org.GoogleIDPChangedEvent:
+			wm.setSecret(e.Aggregate(), e.ID, domain.IDPTypeGoogle, e.ClientSecret)
+		case *org.AppleIDPAddedEvent:
+			wm.setSecret(e.Aggregate(), e.ID, domain.IDPTypeApple, e.PrivateKey)
+		case *org.AppleIDPChangedEvent:
+			wm.setSecret(e.Aggregate(), e.ID, domain.IDPTypeApple, e.PrivateKey)
+		case *org.OIDCIDPMigratedAzureADEvent:
+			wm.setSecret(e.Aggregate(), e.ID, domain.IDPTypeAzureAD, e.ClientSecret)
+		case *org.OIDCIDPMigratedGoogleEvent:
+			wm.setSecret(e.Aggregate(), e.ID, domain.IDPTypeGoogle, e.ClientSecret)
+		case *org.IDPRemovedEvent:
+			delete(wm.secrets, e.ID)
+		case *org.OrgRemovedEvent:
+			for id, secret := range wm.secrets {
+				if secret.aggregate.ID == e.Aggregate().ID {
+					delete(wm.secrets, id)
+				}
+			}
+		}
+	}
+	return wm.WriteModel.Reduce()
+}
+
+func (wm *idpSecretsWriteModel) setSecret(aggregate *eventstore.Aggregate, id string, idpType domain.IDPType, secret *crypto.CryptoValue) {
+	existing, ok := wm.secrets[id]
+	if !ok {
+		existing = &idpSecret{id: id}
+		wm.secrets[id] = existing
+	}
+	existing.aggregate = aggregate
+	existing.idpType = idpType
+	if secret != nil {
+		existing.secret = secret
+	}
+}
+
+func (wm *idpSecretsWriteModel) Query() *eventstore.SearchQueryBuilder {
+	return eventstore.NewSearchQueryBuilder(eventstore.ColumnsEvent).
+		InstanceID(wm.InstanceID).
+		AddQuery().
+		AggregateTypes(instance.AggregateType).
+		AggregateIDs(wm.AggregateID).
+		EventTypes(
+			instance.OAuthIDPAddedEventType,
+			instance.OAuthIDPChangedEventType,
+			instance.OIDCIDPAddedEventType,
+			instance.OIDCIDPChangedEventType,
+			instance.AzureADIDPAddedEventType,
+			instance.AzureADIDPChangedEventType,
+			instance.GitHubIDPAddedEventType,
+			instance.GitHubIDPChangedEventType,
+			instance.GitHubEnterpriseIDPAddedEventType,
+			instance.GitHubEnterpriseIDPChangedEventType,
+			instance.GitLabIDPAddedEventType,
+			instance.GitLabIDPChangedEventType,
+			instance.GitLabSelfHostedIDPAddedEventType,
+			instance.GitLabSelfHostedIDPChangedEventType,
+			instance.GoogleIDPAddedEventType,
+			instance.GoogleIDPChangedEventType,
+			instance.AppleIDPAddedEventType,
+			instance.AppleIDPChangedEventType,
+			instance.OIDCIDPMigratedAzureADEventType,
+			instance.OIDCIDPMigratedGoogleEventType,
+			instance.IDPRemovedEventType,
+		).
+		Or().
+		AggregateTypes(org.AggregateType).
+		EventTypes(
+			org.OAuthIDPAddedEventType,
+			org.OAuthIDPChangedEventType,
+			org.OIDCIDPAddedEventType,
+			org.OIDCIDPChangedEventType,
+			org.AzureADIDPAddedEventType,
+			org.AzureADIDPChangedEventType,
+			org.GitHubIDPAddedEventType,
+			org.GitHubIDPChangedEventType,
+			org.GitHubEnterpriseIDPAddedEventType,
+			org.GitHubEnterpriseIDPChangedEventType,
+			org.GitLabIDPAddedEventType,
+			org.GitLabIDPChangedEventType,
+			org.GitLabSelfHostedIDPAddedEventType,
+			org.GitLabSelfHostedIDPChangedEventType,
+			org.GoogleIDPAddedEventType,
+			org.GoogleIDPChangedEventType,
+			org.AppleIDPAddedEventType,
+			org.AppleIDPChangedEventType,
+			org.OIDCIDPMigratedAzureADEventType,
+			org.OIDCIDPMigratedGoogleEventType,
+			org.IDPRemovedEventType,
+			org.OrgRemovedEventType,
+		).
+		Builder()
+}
+
+// secretChangedEvent returns the event which replaces the secret of the identity provider.
+func (s *idpSecret) secretChangedEvent(ctx context.Context, crypted *crypto.CryptoValue) (eventstore.Command, error) {
+	aggregate := s.aggregate
+	switch aggregate.Type {
+	case instance.AggregateType:
+		switch s.idpType {
+		case domain.IDPTypeOAuth:
+			return instance.NewOAuthIDPChangedEvent(ctx, aggregate, s.id, []idp.OAuthIDPChanges{idp.ChangeOAuthClientSecret(crypted)})
+		case domain.IDPTypeOIDC:
+			return instance.NewOIDCIDPChangedEvent(ctx, aggregate, s.id, []idp.OIDCIDPChanges{idp.ChangeOIDCClientSecret(crypted)})
+		case domain.IDPTypeAzureAD:
+			return instance.NewAzureADIDPChangedEvent(ctx, aggregate, s.id, []idp.AzureADIDPChanges{idp.ChangeAzureADClientSecret(crypted)})
+		case domain.IDPTypeGitHub:
+			return instance.NewGitHubIDPChangedEvent(ctx, aggregate, s.id, []idp.GitHubIDPChanges{idp.ChangeGitHubClientSecret(crypted)})
+		case domain.IDPTypeGitHubEnterprise:
+			return instance.NewGitHubEnterpriseIDPChangedEvent(ctx, aggregate, s.id, []idp.GitHubEnterpriseIDPChanges{idp.ChangeGitHubEnterpriseClientSecret(crypted)})
+		case domain.IDPTypeGitLab:
+			return instance.NewGitLabIDPChangedEvent(ctx, aggregate, s.id, []idp.GitLabIDPChanges{idp.ChangeGitLabClientSecret(crypted)})
+		case domain.IDPTypeGitLabSelfHosted:
+			return instance.NewGitLabSelfHostedIDPChangedEvent(ctx, aggregate, s.id, []idp.GitLabSelfHostedIDPChanges{idp.ChangeGitLabSelfHostedClientSecret(crypted)})
+		case domain.IDPTypeGoogle:
+			return instance.NewGoogleIDPChangedEvent(ctx, aggregate, s.id, []idp.GoogleIDPChanges{idp.ChangeGoogleClientSecret(crypted)})
+		case domain.IDPTypeApple:
+			return instance.NewAppleIDPChangedEvent(ctx, aggregate, s.id, []idp.AppleIDPChanges{idp.ChangeApplePrivateKey(crypted)})
+		}
+	case org.AggregateType:
+		switch s.idpType {
+		case domain.IDPTypeOAuth:
+			return org.NewOAuthIDPChangedEvent(ctx, aggregate, s.id, []idp.OAuthIDPChanges{idp.ChangeOAuthClientSecret(crypted)})
+		case domain.IDPTypeOIDC:
+			return org.NewOIDCIDPChangedEvent(ctx, aggregate, s.id, []idp.OIDCIDPChanges{idp.ChangeOIDCClientSecret(crypted)})
+		case domain.IDPTypeAzureAD:
+			return org.NewAzureADIDPChangedEvent(ctx, aggregate, s.id, []idp.AzureADIDPChanges{idp.ChangeAzureADClientSecret(crypted)})
+		case domain.IDPTypeGitHub:
+			return org.NewGitHubIDPChangedEvent(ctx, aggregate, s.id, []idp.GitHubIDPChanges{idp.ChangeGitHubClientSecret(crypted)})
+		case domain.IDPTypeGitHubEnterprise:
+			return org.NewGitHubEnterpriseIDPChangedEvent(ctx, aggregate, s.id, []idp.GitHubEnterpriseIDPChanges{idp.ChangeGitHubEnterpriseClientSecret(crypted)})
+		case domain.IDPTypeGitLab:
+			return org.NewGitLabIDPChangedEvent(ctx, aggregate, s.id, []idp.GitLabIDPChanges{idp.ChangeGitLabClientSecret(crypted)})
+		case domain.IDPTypeGitLabSelfHosted:
+			return org.NewGitLabSelfHostedIDPChangedEvent(ctx, aggregate, s.id, []idp.GitLabSelfHostedIDPChanges{idp.ChangeGitLabSelfHostedClientSecret(crypted)})
+		case domain.IDPTypeGoogle:
+			return org.NewGoogleIDPChangedEvent(ctx, aggregate, s.id, []idp.GoogleIDPChanges{idp.ChangeGoogleClientSecret(crypted)})
+		case domain.IDPTypeApple:
+			return org.NewAppleIDPChangedEvent(ctx, aggregate, s.id, []idp.AppleIDPChanges{idp.ChangeApplePrivateKey(crypted)})
+		}
+	}
+	return nil, zerrors.ThrowInternal(nil, "COMMAND-Pz2nd", "Errors.IDPConfig.NotExisting")
+}
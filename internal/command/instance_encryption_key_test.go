@@ -0,0 +1,501 @@
+package command
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/crypto"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/id"
+	id_mock "github.com/zitadel/zitadel/internal/id/mock"
+	"github.com/zitadel/zitadel/internal/repository/idp"
+	"github.com/zitadel/zitadel/internal/repository/instance"
+	"github.com/zitadel/zitadel/internal/repository/org"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+type testKMS struct {
+	wrapped []byte
+	err     error
+}
+
+func (k *testKMS) Encrypt(context.Context, string, []byte) ([]byte, error) {
+	return k.wrapped, k.err
+}
+
+func (k *testKMS) Decrypt(context.Context, string, []byte) ([]byte, error) {
+	return nil, k.err
+}
+
+// testInstanceEncryption encrypts with the active algorithm and decrypts with the embedded one.
+type testInstanceEncryption struct {
+	crypto.EncryptionAlgorithm
+	active crypto.EncryptionAlgorithm
+}
+
+func (e *testInstanceEncryption) WithContext(context.Context) crypto.EncryptionAlgorithm {
+	return e.active
+}
+
+func testActiveEncryptionAlg(ctrl *gomock.Controller, keyID string) crypto.EncryptionAlgorithm {
+	alg := crypto.NewMockEncryptionAlgorithm(ctrl)
+	alg.EXPECT().Algorithm().AnyTimes().Return("enc")
+	alg.EXPECT().EncryptionKeyID().AnyTimes().Return(keyID)
+	alg.EXPECT().Encrypt(gomock.Any()).AnyTimes().DoAndReturn(
+		func(value []byte) ([]byte, error) {
+			return value, nil
+		},
+	)
+	return alg
+}
+
+func TestCommandSide_SetInstanceEncryptionKey(t *testing.T) {
+	type fields struct {
+		eventstore  func(*testing.T) *eventstore.Eventstore
+		idGenerator id.Generator
+		instanceKMS crypto.KMSProviders
+	}
+	type args struct {
+		ctx      context.Context
+		provider string
+		keyURI   string
+	}
+	type res struct {
+		want *domain.ObjectDetails
+		err  func(error) bool
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		args   args
+		res    res
+	}{
+		{
+			name: "missing key uri, invalid argument error",
+			fields: fields{
+				eventstore: expectEventstore(),
+			},
+			args: args{
+				ctx:      authz.WithInstanceID(context.Background(), "INSTANCE"),
+				provider: "kms",
+			},
+			res: res{
+				err: zerrors.IsErrorInvalidArgument,
+			},
+		},
+		{
+			name: "unknown provider, invalid argument error",
+			fields: fields{
+				eventstore:  expectEventstore(expectFilter()),
+				instanceKMS: crypto.KMSProviders{},
+			},
+			args: args{
+				ctx:      authz.WithInstanceID(context.Background(), "INSTANCE"),
+				provider: "kms",
+				keyURI:   "kek",
+			},
+			res: res{
+				err: zerrors.IsErrorInvalidArgument,
+			},
+		},
+		{
+			name: "kms unavailable, precondition error",
+			fields: fields{
+				eventstore:  expectEventstore(expectFilter()),
+				idGenerator: id_mock.NewIDGeneratorExpectIDs(t, "key1"),
+				instanceKMS: crypto.KMSProviders{"kms": &testKMS{err: errors.New("unavailable")}},
+			},
+			args: args{
+				ctx:      authz.WithInstanceID(context.Background(), "INSTANCE"),
+				provider: "kms",
+				keyURI:   "kek",
+			},
+			res: res{
+				err: zerrors.IsPreconditionFailed,
+			},
+		},
+		{
+			name: "set key, ok",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(),
+					expectPush(
+						instance.NewEncryptionKeyAddedEvent(context.Background(),
+							&instance.NewAggregate("INSTANCE").Aggregate,
+							"instance:INSTANCE:key1",
+							"kms",
+							"kek",
+							[]byte("wrapped"),
+						),
+					),
+				),
+				idGenerator: id_mock.NewIDGeneratorExpectIDs(t, "key1"),
+				instanceKMS: crypto.KMSProviders{"kms": &testKMS{wrapped: []byte("wrapped")}},
+			},
+			args: args{
+				ctx:      authz.WithInstanceID(context.Background(), "INSTANCE"),
+				provider: "kms",
+				keyURI:   "kek",
+			},
+			res: res{
+				want: &domain.ObjectDetails{
+					ResourceOwner: "INSTANCE",
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := &Commands{
+				eventstore:  tt.fields.eventstore(t),
+				idGenerator: tt.fields.idGenerator,
+				instanceKMS: tt.fields.instanceKMS,
+			}
+			got, err := r.SetInstanceEncryptionKey(tt.args.ctx, tt.args.provider, tt.args.keyURI)
+			if tt.res.err == nil {
+				assert.NoError(t, err)
+			}
+			if tt.res.err != nil && !tt.res.err(err) {
+				t.Errorf("got wrong err: %v ", err)
+			}
+			if tt.res.err == nil {
+				assert.Equal(t, tt.res.want, got)
+			}
+		})
+	}
+}
+
+func TestCommandSide_RotateInstanceEncryptionKey(t *testing.T) {
+	type fields struct {
+		eventstore  func(*testing.T) *eventstore.Eventstore
+		idGenerator id.Generator
+		instanceKMS crypto.KMSProviders
+	}
+	type res struct {
+		want *domain.ObjectDetails
+		err  func(error) bool
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		res    res
+	}{
+		{
+			name: "no active key, not found error",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(
+							instance.NewEncryptionKeyAddedEvent(context.Background(),
+								&instance.NewAggregate("INSTANCE").Aggregate,
+								"instance:INSTANCE:key1",
+								"kms",
+								"kek",
+								[]byte("wrapped"),
+							),
+						),
+						eventFromEventPusher(
+							instance.NewEncryptionKeyRemovedEvent(context.Background(),
+								&instance.NewAggregate("INSTANCE").Aggregate,
+							),
+						),
+					),
+				),
+			},
+			res: res{
+				err: zerrors.IsNotFound,
+			},
+		},
+		{
+			name: "rotate key, ok",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(
+							instance.NewEncryptionKeyAddedEvent(context.Background(),
+								&instance.NewAggregate("INSTANCE").Aggregate,
+								"instance:INSTANCE:key1",
+								"kms",
+								"kek",
+								[]byte("wrapped"),
+							),
+						),
+					),
+					expectPush(
+						instance.NewEncryptionKeyAddedEvent(context.Background(),
+							&instance.NewAggregate("INSTANCE").Aggregate,
+							"instance:INSTANCE:key2",
+							"kms",
+							"kek",
+							[]byte("wrapped2"),
+						),
+					),
+				),
+				idGenerator: id_mock.NewIDGeneratorExpectIDs(t, "key2"),
+				instanceKMS: crypto.KMSProviders{"kms": &testKMS{wrapped: []byte("wrapped2")}},
+			},
+			res: res{
+				want: &domain.ObjectDetails{
+					ResourceOwner: "INSTANCE",
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := &Commands{
+				eventstore:  tt.fields.eventstore(t),
+				idGenerator: tt.fields.idGenerator,
+				instanceKMS: tt.fields.instanceKMS,
+			}
+			got, err := r.RotateInstanceEncryptionKey(authz.WithInstanceID(context.Background(), "INSTANCE"))
+			if tt.res.err == nil {
+				assert.NoError(t, err)
+			}
+			if tt.res.err != nil && !tt.res.err(err) {
+				t.Errorf("got wrong err: %v ", err)
+			}
+			if tt.res.err == nil {
+				assert.Equal(t, tt.res.want, got)
+			}
+		})
+	}
+}
+
+func TestCommandSide_RemoveInstanceEncryptionKey(t *testing.T) {
+	type fields struct {
+		eventstore func(*testing.T) *eventstore.Eventstore
+	}
+	type res struct {
+		want *domain.ObjectDetails
+		err  func(error) bool
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		res    res
+	}{
+		{
+			name: "no key, not found error",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(),
+				),
+			},
+			res: res{
+				err: zerrors.IsNotFound,
+			},
+		},
+		{
+			name: "remove key, ok",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(
+							instance.NewEncryptionKeyAddedEvent(context.Background(),
+								&instance.NewAggregate("INSTANCE").Aggregate,
+								"instance:INSTANCE:key1",
+								"kms",
+								"kek",
+								[]byte("wrapped"),
+							),
+						),
+					),
+					expectPush(
+						instance.NewEncryptionKeyRemovedEvent(context.Background(),
+							&instance.NewAggregate("INSTANCE").Aggregate,
+						),
+					),
+				),
+			},
+			res: res{
+				want: &domain.ObjectDetails{
+					ResourceOwner: "INSTANCE",
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := &Commands{
+				eventstore: tt.fields.eventstore(t),
+			}
+			got, err := r.RemoveInstanceEncryptionKey(authz.WithInstanceID(context.Background(), "INSTANCE"))
+			if tt.res.err == nil {
+				assert.NoError(t, err)
+			}
+			if tt.res.err != nil && !tt.res.err(err) {
+				t.Errorf("got wrong err: %v ", err)
+			}
+			if tt.res.err == nil {
+				assert.Equal(t, tt.res.want, got)
+			}
+		})
+	}
+}
+
+func TestCommandSide_ReencryptInstanceSecrets(t *testing.T) {
+	secret := func(keyID string) *crypto.CryptoValue {
+		return &crypto.CryptoValue{
+			CryptoType: crypto.TypeEncryption,
+			Algorithm:  "enc",
+			KeyID:      keyID,
+			Crypted:    []byte("clientSecret"),
+		}
+	}
+	type fields struct {
+		eventstore func(*testing.T) *eventstore.Eventstore
+	}
+	type args struct {
+		batchSize int
+	}
+	type res struct {
+		want int
+		err  func(error) bool
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		args   args
+		res    res
+	}{
+		{
+			name: "already encrypted with active key, ok",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(
+							instance.NewOAuthIDPAddedEvent(context.Background(), &instance.NewAggregate("INSTANCE").Aggregate,
+								"idp1",
+								"name",
+								"clientID",
+								secret("instance:INSTANCE:key1"),
+								"auth",
+								"token",
+								"user",
+								"idAttribute",
+								nil,
+								nil,
+								idp.Options{},
+							),
+						),
+					),
+				),
+			},
+			res: res{
+				want: 0,
+			},
+		},
+		{
+			name: "removed idp, ok",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(
+							org.NewGoogleIDPAddedEvent(context.Background(), &org.NewAggregate("org1").Aggregate,
+								"idp2",
+								"name",
+								"clientID",
+								secret("id"),
+								nil,
+								idp.Options{},
+							),
+						),
+						eventFromEventPusher(
+							org.NewIDPRemovedEvent(context.Background(), &org.NewAggregate("org1").Aggregate,
+								"idp2",
+							),
+						),
+					),
+				),
+			},
+			res: res{
+				want: 0,
+			},
+		},
+		{
+			name: "re-encrypt in batches, ok",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(
+							instance.NewOAuthIDPAddedEvent(context.Background(), &instance.NewAggregate("INSTANCE").Aggregate,
+								"idp1",
+								"name",
+								"clientID",
+								secret("id"),
+								"auth",
+								"token",
+								"user",
+								"idAttribute",
+								nil,
+								nil,
+								idp.Options{},
+							),
+						),
+						eventFromEventPusher(
+							org.NewGoogleIDPAddedEvent(context.Background(), &org.NewAggregate("org1").Aggregate,
+								"idp2",
+								"name",
+								"clientID",
+								secret("id"),
+								nil,
+								idp.Options{},
+							),
+						),
+					),
+					expectPush(
+						func() eventstore.Command {
+							event, _ := instance.NewOAuthIDPChangedEvent(context.Background(), &instance.NewAggregate("INSTANCE").Aggregate,
+								"idp1",
+								[]idp.OAuthIDPChanges{idp.ChangeOAuthClientSecret(secret("instance:INSTANCE:key1"))},
+							)
+							return event
+						}(),
+					),
+					expectPush(
+						func() eventstore.Command {
+							event, _ := org.NewGoogleIDPChangedEvent(context.Background(), &org.NewAggregate("org1").Aggregate,
+								"idp2",
+								[]idp.GoogleIDPChanges{idp.ChangeGoogleClientSecret(secret("instance:INSTANCE:key1"))},
+							)
+							return event
+						}(),
+					),
+				),
+			},
+			args: args{
+				batchSize: 1,
+			},
+			res: res{
+				want: 2,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			r := &Commands{
+				eventstore: tt.fields.eventstore(t),
+				idpConfigEncryption: &testInstanceEncryption{
+					EncryptionAlgorithm: crypto.CreateMockEncryptionAlg(ctrl),
+					active:              testActiveEncryptionAlg(ctrl, "instance:INSTANCE:key1"),
+				},
+			}
+			got, err := r.ReencryptInstanceSecrets(authz.WithInstanceID(context.Background(), "INSTANCE"), tt.args.batchSize)
+			if tt.res.err == nil {
+				assert.NoError(t, err)
+			}
+			if tt.res.err != nil && !tt.res.err(err) {
+				t.Errorf("got wrong err: %v ", err)
+			}
+			assert.Equal(t, tt.res.want, got)
+		})
+	}
+}
@@ -167,6 +167,24 @@ func TestCommands_SetInstanceFeatures(t *testing.T) {
 				ResourceOwner: "instance1",
 			},
 		},
+		{
+			name: "set LoginV2",
+			eventstore: expectEventstore(
+				expectFilter(),
+				expectPush(
+					feature_v2.NewSetEvent[bool](
+						ctx, aggregate,
+						feature_v2.InstanceLoginV2EventType, true,
+					),
+				),
+			),
+			args: args{ctx, &InstanceFeatures{
+				LoginV2: gu.Ptr(true),
+			}},
+			want: &domain.ObjectDetails{
+				ResourceOwner: "instance1",
+			},
+		},
 		{
 			name: "push error",
 			eventstore: expectEventstore(
@@ -2,6 +2,7 @@ package command
 
 import (
 	"context"
+	"slices"
 	"strings"
 
 	"github.com/zitadel/saml/pkg/provider/xml"
@@ -11,6 +12,9 @@ import (
 	"github.com/zitadel/zitadel/internal/crypto"
 	"github.com/zitadel/zitadel/internal/domain"
 	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/idp/providers/apple"
+	"github.com/zitadel/zitadel/internal/idp/providers/ldap"
+	"github.com/zitadel/zitadel/internal/idp/providers/oauth"
 	"github.com/zitadel/zitadel/internal/repository/instance"
 	"github.com/zitadel/zitadel/internal/zerrors"
 )
@@ -629,6 +633,9 @@ func (c *Commands) prepareAddInstanceOAuthProvider(a *instance.Aggregate, writeM
 		if provider.IDAttribute = strings.TrimSpace(provider.IDAttribute); provider.IDAttribute == "" {
 			return nil, zerrors.ThrowInvalidArgument(nil, "INST-sdf3f", "Errors.Invalid.Argument")
 		}
+		if _, err := oauth.NewAttributeMapping(provider.AttributeMapping); err != nil {
+			return nil, zerrors.ThrowInvalidArgument(err, "INST-Oam1f", "Errors.IDPConfig.AttributeMappingInvalid")
+		}
 		return func(ctx context.Context, filter preparation.FilterToQueryReducer) ([]eventstore.Command, error) {
 			events, err := filter(ctx, writeModel.Query())
 			if err != nil {
@@ -638,7 +645,7 @@ func (c *Commands) prepareAddInstanceOAuthProvider(a *instance.Aggregate, writeM
 			if err = writeModel.Reduce(); err != nil {
 				return nil, err
 			}
-			secret, err := crypto.Encrypt([]byte(provider.ClientSecret), c.idpConfigEncryption)
+			secret, err := crypto.Encrypt([]byte(provider.ClientSecret), crypto.ForContext(ctx, c.idpConfigEncryption))
 			if err != nil {
 				return nil, err
 			}
@@ -655,6 +662,7 @@ func (c *Commands) prepareAddInstanceOAuthProvider(a *instance.Aggregate, writeM
 					provider.UserEndpoint,
 					provider.IDAttribute,
 					provider.Scopes,
+					provider.AttributeMapping,
 					provider.IDPOptions,
 				),
 			}, nil
@@ -685,6 +693,9 @@ func (c *Commands) prepareUpdateInstanceOAuthProvider(a *instance.Aggregate, wri
 		if provider.IDAttribute = strings.TrimSpace(provider.IDAttribute); provider.IDAttribute == "" {
 			return nil, zerrors.ThrowInvalidArgument(nil, "INST-JKD3h", "Errors.Invalid.Argument")
 		}
+		if _, err := oauth.NewAttributeMapping(provider.AttributeMapping); err != nil {
+			return nil, zerrors.ThrowInvalidArgument(err, "INST-Oam2f", "Errors.IDPConfig.AttributeMappingInvalid")
+		}
 		return func(ctx context.Context, filter preparation.FilterToQueryReducer) ([]eventstore.Command, error) {
 			events, err := filter(ctx, writeModel.Query())
 			if err != nil {
@@ -704,12 +715,13 @@ func (c *Commands) prepareUpdateInstanceOAuthProvider(a *instance.Aggregate, wri
 				provider.Name,
 				provider.ClientID,
 				provider.ClientSecret,
-				c.idpConfigEncryption,
+				crypto.ForContext(ctx, c.idpConfigEncryption),
 				provider.AuthorizationEndpoint,
 				provider.TokenEndpoint,
 				provider.UserEndpoint,
 				provider.IDAttribute,
 				provider.Scopes,
+				provider.AttributeMapping,
 				provider.IDPOptions,
 			)
 			if err != nil || event == nil {
@@ -743,7 +755,7 @@ func (c *Commands) prepareAddInstanceOIDCProvider(a *instance.Aggregate, writeMo
 			if err = writeModel.Reduce(); err != nil {
 				return nil, err
 			}
-			secret, err := crypto.Encrypt([]byte(provider.ClientSecret), c.idpConfigEncryption)
+			secret, err := crypto.Encrypt([]byte(provider.ClientSecret), crypto.ForContext(ctx, c.idpConfigEncryption))
 			if err != nil {
 				return nil, err
 			}
@@ -799,7 +811,7 @@ func (c *Commands) prepareUpdateInstanceOIDCProvider(a *instance.Aggregate, writ
 				provider.Issuer,
 				provider.ClientID,
 				provider.ClientSecret,
-				c.idpConfigEncryption,
+				crypto.ForContext(ctx, c.idpConfigEncryption),
 				provider.Scopes,
 				provider.IsIDTokenMapping,
 				provider.IDPOptions,
@@ -835,7 +847,7 @@ func (c *Commands) prepareMigrateInstanceOIDCToAzureADProvider(a *instance.Aggre
 			if !writeModel.State.Exists() {
 				return nil, zerrors.ThrowNotFound(nil, "INST-Dg29201", "Errors.IDPConfig.NotExisting")
 			}
-			secret, err := crypto.Encrypt([]byte(provider.ClientSecret), c.idpConfigEncryption)
+			secret, err := crypto.Encrypt([]byte(provider.ClientSecret), crypto.ForContext(ctx, c.idpConfigEncryption))
 			if err != nil {
 				return nil, err
 			}
@@ -850,6 +862,8 @@ func (c *Commands) prepareMigrateInstanceOIDCToAzureADProvider(a *instance.Aggre
 					provider.Scopes,
 					provider.Tenant,
 					provider.EmailVerified,
+					provider.AllowedTenants,
+					provider.FetchGroups,
 					provider.IDPOptions,
 				),
 			}, nil
@@ -877,7 +891,7 @@ func (c *Commands) prepareMigrateInstanceOIDCToGoogleProvider(a *instance.Aggreg
 			if !writeModel.State.Exists() {
 				return nil, zerrors.ThrowNotFound(nil, "INST-Dg29202", "Errors.IDPConfig.NotExisting")
 			}
-			secret, err := crypto.Encrypt([]byte(provider.ClientSecret), c.idpConfigEncryption)
+			secret, err := crypto.Encrypt([]byte(provider.ClientSecret), crypto.ForContext(ctx, c.idpConfigEncryption))
 			if err != nil {
 				return nil, err
 			}
@@ -1002,6 +1016,9 @@ func (c *Commands) prepareAddInstanceAzureADProvider(a *instance.Aggregate, writ
 		if provider.ClientSecret = strings.TrimSpace(provider.ClientSecret); provider.ClientSecret == "" {
 			return nil, zerrors.ThrowInvalidArgument(nil, "INST-Dzh3g", "Errors.Invalid.Argument")
 		}
+		if slices.Contains(trimStringSliceWhiteSpaces(provider.AllowedTenants), "") {
+			return nil, zerrors.ThrowInvalidArgument(nil, "INST-Azt1q", "Errors.Invalid.Argument")
+		}
 		return func(ctx context.Context, filter preparation.FilterToQueryReducer) ([]eventstore.Command, error) {
 			events, err := filter(ctx, writeModel.Query())
 			if err != nil {
@@ -1011,7 +1028,7 @@ func (c *Commands) prepareAddInstanceAzureADProvider(a *instance.Aggregate, writ
 			if err = writeModel.Reduce(); err != nil {
 				return nil, err
 			}
-			secret, err := crypto.Encrypt([]byte(provider.ClientSecret), c.idpConfigEncryption)
+			secret, err := crypto.Encrypt([]byte(provider.ClientSecret), crypto.ForContext(ctx, c.idpConfigEncryption))
 			if err != nil {
 				return nil, err
 			}
@@ -1026,6 +1043,8 @@ func (c *Commands) prepareAddInstanceAzureADProvider(a *instance.Aggregate, writ
 					provider.Scopes,
 					provider.Tenant,
 					provider.EmailVerified,
+					provider.AllowedTenants,
+					provider.FetchGroups,
 					provider.IDPOptions,
 				),
 			}, nil
@@ -1044,6 +1063,9 @@ func (c *Commands) prepareUpdateInstanceAzureADProvider(a *instance.Aggregate, w
 		if provider.ClientID = strings.TrimSpace(provider.ClientID); provider.ClientID == "" {
 			return nil, zerrors.ThrowInvalidArgument(nil, "INST-dmitg", "Errors.Invalid.Argument")
 		}
+		if slices.Contains(trimStringSliceWhiteSpaces(provider.AllowedTenants), "") {
+			return nil, zerrors.ThrowInvalidArgument(nil, "INST-Azt2q", "Errors.Invalid.Argument")
+		}
 		return func(ctx context.Context, filter preparation.FilterToQueryReducer) ([]eventstore.Command, error) {
 			events, err := filter(ctx, writeModel.Query())
 			if err != nil {
@@ -1063,10 +1085,12 @@ func (c *Commands) prepareUpdateInstanceAzureADProvider(a *instance.Aggregate, w
 				provider.Name,
 				provider.ClientID,
 				provider.ClientSecret,
-				c.idpConfigEncryption,
+				crypto.ForContext(ctx, c.idpConfigEncryption),
 				provider.Scopes,
 				provider.Tenant,
 				provider.EmailVerified,
+				provider.AllowedTenants,
+				provider.FetchGroups,
 				provider.IDPOptions,
 			)
 			if err != nil || event == nil {
@@ -1094,7 +1118,7 @@ func (c *Commands) prepareAddInstanceGitHubProvider(a *instance.Aggregate, write
 			if err = writeModel.Reduce(); err != nil {
 				return nil, err
 			}
-			secret, err := crypto.Encrypt([]byte(provider.ClientSecret), c.idpConfigEncryption)
+			secret, err := crypto.Encrypt([]byte(provider.ClientSecret), crypto.ForContext(ctx, c.idpConfigEncryption))
 			if err != nil {
 				return nil, err
 			}
@@ -1141,7 +1165,7 @@ func (c *Commands) prepareUpdateInstanceGitHubProvider(a *instance.Aggregate, wr
 				provider.Name,
 				provider.ClientID,
 				provider.ClientSecret,
-				c.idpConfigEncryption,
+				crypto.ForContext(ctx, c.idpConfigEncryption),
 				provider.Scopes,
 				provider.IDPOptions,
 			)
@@ -1182,7 +1206,7 @@ func (c *Commands) prepareAddInstanceGitHubEnterpriseProvider(a *instance.Aggreg
 			if err = writeModel.Reduce(); err != nil {
 				return nil, err
 			}
-			secret, err := crypto.Encrypt([]byte(provider.ClientSecret), c.idpConfigEncryption)
+			secret, err := crypto.Encrypt([]byte(provider.ClientSecret), crypto.ForContext(ctx, c.idpConfigEncryption))
 			if err != nil {
 				return nil, err
 			}
@@ -1244,7 +1268,7 @@ func (c *Commands) prepareUpdateInstanceGitHubEnterpriseProvider(a *instance.Agg
 				provider.Name,
 				provider.ClientID,
 				provider.ClientSecret,
-				c.idpConfigEncryption,
+				crypto.ForContext(ctx, c.idpConfigEncryption),
 				provider.AuthorizationEndpoint,
 				provider.TokenEndpoint,
 				provider.UserEndpoint,
@@ -1276,7 +1300,7 @@ func (c *Commands) prepareAddInstanceGitLabProvider(a *instance.Aggregate, write
 			if err = writeModel.Reduce(); err != nil {
 				return nil, err
 			}
-			secret, err := crypto.Encrypt([]byte(provider.ClientSecret), c.idpConfigEncryption)
+			secret, err := crypto.Encrypt([]byte(provider.ClientSecret), crypto.ForContext(ctx, c.idpConfigEncryption))
 			if err != nil {
 				return nil, err
 			}
@@ -1323,7 +1347,7 @@ func (c *Commands) prepareUpdateInstanceGitLabProvider(a *instance.Aggregate, wr
 				provider.Name,
 				provider.ClientID,
 				provider.ClientSecret,
-				c.idpConfigEncryption,
+				crypto.ForContext(ctx, c.idpConfigEncryption),
 				provider.Scopes,
 				provider.IDPOptions,
 			)
@@ -1358,7 +1382,7 @@ func (c *Commands) prepareAddInstanceGitLabSelfHostedProvider(a *instance.Aggreg
 			if err = writeModel.Reduce(); err != nil {
 				return nil, err
 			}
-			secret, err := crypto.Encrypt([]byte(provider.ClientSecret), c.idpConfigEncryption)
+			secret, err := crypto.Encrypt([]byte(provider.ClientSecret), crypto.ForContext(ctx, c.idpConfigEncryption))
 			if err != nil {
 				return nil, err
 			}
@@ -1413,7 +1437,7 @@ func (c *Commands) prepareUpdateInstanceGitLabSelfHostedProvider(a *instance.Agg
 				provider.Issuer,
 				provider.ClientID,
 				provider.ClientSecret,
-				c.idpConfigEncryption,
+				crypto.ForContext(ctx, c.idpConfigEncryption),
 				provider.Scopes,
 				provider.IDPOptions,
 			)
@@ -1442,7 +1466,7 @@ func (c *Commands) prepareAddInstanceGoogleProvider(a *instance.Aggregate, write
 			if err = writeModel.Reduce(); err != nil {
 				return nil, err
 			}
-			secret, err := crypto.Encrypt([]byte(provider.ClientSecret), c.idpConfigEncryption)
+			secret, err := crypto.Encrypt([]byte(provider.ClientSecret), crypto.ForContext(ctx, c.idpConfigEncryption))
 			if err != nil {
 				return nil, err
 			}
@@ -1489,7 +1513,7 @@ func (c *Commands) prepareUpdateInstanceGoogleProvider(a *instance.Aggregate, wr
 				provider.Name,
 				provider.ClientID,
 				provider.ClientSecret,
-				c.idpConfigEncryption,
+				crypto.ForContext(ctx, c.idpConfigEncryption),
 				provider.Scopes,
 				provider.IDPOptions,
 			)
@@ -1527,6 +1551,17 @@ func (c *Commands) prepareAddInstanceLDAPProvider(a *instance.Aggregate, writeMo
 		if len(provider.UserFilters) == 0 {
 			return nil, zerrors.ThrowInvalidArgument(nil, "INST-aAx905n", "Errors.Invalid.Argument")
 		}
+		if err := ldap.ValidateRootCA(provider.RootCA); err != nil {
+			return nil, zerrors.ThrowInvalidArgument(err, "INST-Rc7x1", "Errors.IDP.RootCAInvalid")
+		}
+		if !provider.GroupResolution.Valid() {
+			return nil, zerrors.ThrowInvalidArgument(nil, "INST-Gr7x1", "Errors.Invalid.Argument")
+		}
+		if !validLDAPMetadataAttributes(provider.LDAPAttributes.MetadataAttributes) {
+			return nil, zerrors.ThrowInvalidArgument(nil, "INST-Md7x1", "Errors.Invalid.Argument")
+		}
+		provider.GroupBase = strings.TrimSpace(provider.GroupBase)
+		provider.GroupNameAttribute = strings.TrimSpace(provider.GroupNameAttribute)
 		return func(ctx context.Context, filter preparation.FilterToQueryReducer) ([]eventstore.Command, error) {
 			events, err := filter(ctx, writeModel.Query())
 			if err != nil {
@@ -1536,7 +1571,7 @@ func (c *Commands) prepareAddInstanceLDAPProvider(a *instance.Aggregate, writeMo
 			if err = writeModel.Reduce(); err != nil {
 				return nil, err
 			}
-			secret, err := crypto.Encrypt([]byte(provider.BindPassword), c.idpConfigEncryption)
+			secret, err := crypto.Encrypt([]byte(provider.BindPassword), crypto.ForContext(ctx, c.idpConfigEncryption))
 			if err != nil {
 				return nil, err
 			}
@@ -1555,6 +1590,10 @@ func (c *Commands) prepareAddInstanceLDAPProvider(a *instance.Aggregate, writeMo
 					provider.UserObjectClasses,
 					provider.UserFilters,
 					provider.Timeout,
+					provider.RootCA,
+					provider.GroupResolution,
+					provider.GroupBase,
+					provider.GroupNameAttribute,
 					provider.LDAPAttributes,
 					provider.IDPOptions,
 				),
@@ -1589,6 +1628,17 @@ func (c *Commands) prepareUpdateInstanceLDAPProvider(a *instance.Aggregate, writ
 		if len(provider.UserFilters) == 0 {
 			return nil, zerrors.ThrowInvalidArgument(nil, "INST-aAx901n", "Errors.Invalid.Argument")
 		}
+		if err := ldap.ValidateRootCA(provider.RootCA); err != nil {
+			return nil, zerrors.ThrowInvalidArgument(err, "INST-Rc7x2", "Errors.IDP.RootCAInvalid")
+		}
+		if !provider.GroupResolution.Valid() {
+			return nil, zerrors.ThrowInvalidArgument(nil, "INST-Gr7x2", "Errors.Invalid.Argument")
+		}
+		if !validLDAPMetadataAttributes(provider.LDAPAttributes.MetadataAttributes) {
+			return nil, zerrors.ThrowInvalidArgument(nil, "INST-Md7x2", "Errors.Invalid.Argument")
+		}
+		provider.GroupBase = strings.TrimSpace(provider.GroupBase)
+		provider.GroupNameAttribute = strings.TrimSpace(provider.GroupNameAttribute)
 		return func(ctx context.Context, filter preparation.FilterToQueryReducer) ([]eventstore.Command, error) {
 			events, err := filter(ctx, writeModel.Query())
 			if err != nil {
@@ -1615,7 +1665,11 @@ func (c *Commands) prepareUpdateInstanceLDAPProvider(a *instance.Aggregate, writ
 				provider.UserObjectClasses,
 				provider.UserFilters,
 				provider.Timeout,
-				c.idpConfigEncryption,
+				provider.RootCA,
+				provider.GroupResolution,
+				provider.GroupBase,
+				provider.GroupNameAttribute,
+				crypto.ForContext(ctx, c.idpConfigEncryption),
 				provider.LDAPAttributes,
 				provider.IDPOptions,
 			)
@@ -1641,6 +1695,9 @@ func (c *Commands) prepareAddInstanceAppleProvider(a *instance.Aggregate, writeM
 		if len(provider.PrivateKey) == 0 {
 			return nil, zerrors.ThrowInvalidArgument(nil, "INST-GVD4n", "Errors.IDP.PrivateKeyMissing")
 		}
+		if err := apple.ValidatePrivateKey(provider.PrivateKey); err != nil {
+			return nil, zerrors.ThrowInvalidArgument(err, "INST-Vk3hx", "Errors.IDP.PrivateKeyInvalid")
+		}
 		return func(ctx context.Context, filter preparation.FilterToQueryReducer) ([]eventstore.Command, error) {
 			events, err := filter(ctx, writeModel.Query())
 			if err != nil {
@@ -1650,7 +1707,7 @@ func (c *Commands) prepareAddInstanceAppleProvider(a *instance.Aggregate, writeM
 			if err = writeModel.Reduce(); err != nil {
 				return nil, err
 			}
-			privateKey, err := crypto.Encrypt(provider.PrivateKey, c.idpConfigEncryption)
+			privateKey, err := crypto.Encrypt(provider.PrivateKey, crypto.ForContext(ctx, c.idpConfigEncryption))
 			if err != nil {
 				return nil, err
 			}
@@ -1686,6 +1743,11 @@ func (c *Commands) prepareUpdateInstanceAppleProvider(a *instance.Aggregate, wri
 		if provider.KeyID = strings.TrimSpace(provider.KeyID); provider.KeyID == "" {
 			return nil, zerrors.ThrowInvalidArgument(nil, "INST-Gh4z2", "Errors.IDP.KeyIDMissing")
 		}
+		if len(provider.PrivateKey) > 0 {
+			if err := apple.ValidatePrivateKey(provider.PrivateKey); err != nil {
+				return nil, zerrors.ThrowInvalidArgument(err, "INST-Vk4hx", "Errors.IDP.PrivateKeyInvalid")
+			}
+		}
 		return func(ctx context.Context, filter preparation.FilterToQueryReducer) ([]eventstore.Command, error) {
 			events, err := filter(ctx, writeModel.Query())
 			if err != nil {
@@ -1707,7 +1769,7 @@ func (c *Commands) prepareUpdateInstanceAppleProvider(a *instance.Aggregate, wri
 				provider.TeamID,
 				provider.KeyID,
 				provider.PrivateKey,
-				c.idpConfigEncryption,
+				crypto.ForContext(ctx, c.idpConfigEncryption),
 				provider.Scopes,
 				provider.IDPOptions,
 			)
@@ -1748,7 +1810,7 @@ func (c *Commands) prepareAddInstanceSAMLProvider(a *instance.Aggregate, writeMo
 			if err != nil {
 				return nil, err
 			}
-			keyEnc, err := crypto.Encrypt(key, c.idpConfigEncryption)
+			keyEnc, err := crypto.Encrypt(key, crypto.ForContext(ctx, c.idpConfigEncryption))
 			if err != nil {
 				return nil, err
 			}
@@ -1759,6 +1821,7 @@ func (c *Commands) prepareAddInstanceSAMLProvider(a *instance.Aggregate, writeMo
 					writeModel.ID,
 					provider.Name,
 					provider.Metadata,
+					provider.MetadataURL,
 					keyEnc,
 					cert,
 					provider.Binding,
@@ -1808,9 +1871,10 @@ func (c *Commands) prepareUpdateInstanceSAMLProvider(a *instance.Aggregate, writ
 				writeModel.ID,
 				provider.Name,
 				provider.Metadata,
+				provider.MetadataURL,
 				nil,
 				nil,
-				c.idpConfigEncryption,
+				crypto.ForContext(ctx, c.idpConfigEncryption),
 				provider.Binding,
 				provider.WithSignedRequest,
 				provider.NameIDFormat,
@@ -1853,9 +1917,10 @@ func (c *Commands) prepareRegenerateInstanceSAMLProviderCertificate(a *instance.
 				writeModel.ID,
 				writeModel.Name,
 				writeModel.Metadata,
+				writeModel.MetadataURL,
 				key,
 				cert,
-				c.idpConfigEncryption,
+				crypto.ForContext(ctx, c.idpConfigEncryption),
 				writeModel.Binding,
 				writeModel.WithSignedRequest,
 				writeModel.NameIDFormat,
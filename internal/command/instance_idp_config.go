@@ -35,7 +35,7 @@ func (c *Commands) AddDefaultIDPConfig(ctx context.Context, config *domain.IDPCo
 		),
 	}
 	if config.OIDCConfig != nil {
-		clientSecret, err := crypto.Encrypt([]byte(config.OIDCConfig.ClientSecretString), c.idpConfigEncryption)
+		clientSecret, err := crypto.Encrypt([]byte(config.OIDCConfig.ClientSecretString), crypto.ForContext(ctx, c.idpConfigEncryption))
 		if err != nil {
 			return nil, err
 		}
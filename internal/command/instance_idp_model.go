@@ -68,6 +68,7 @@ func (wm *InstanceOAuthIDPWriteModel) NewChangedEvent(
 	userEndpoint,
 	idAttribute string,
 	scopes []string,
+	attributeMapping *domain.OAuthAttributeMapping,
 	options idp.Options,
 ) (*instance.OAuthIDPChangedEvent, error) {
 
@@ -81,6 +82,7 @@ func (wm *InstanceOAuthIDPWriteModel) NewChangedEvent(
 		userEndpoint,
 		idAttribute,
 		scopes,
+		attributeMapping,
 		options,
 	)
 	if err != nil || len(changes) == 0 {
@@ -349,6 +351,8 @@ func (wm *InstanceAzureADIDPWriteModel) NewChangedEvent(
 	scopes []string,
 	tenant string,
 	isEmailVerified bool,
+	allowedTenants []string,
+	fetchGroups bool,
 	options idp.Options,
 ) (*instance.AzureADIDPChangedEvent, error) {
 
@@ -360,6 +364,8 @@ func (wm *InstanceAzureADIDPWriteModel) NewChangedEvent(
 		scopes,
 		tenant,
 		isEmailVerified,
+		allowedTenants,
+		fetchGroups,
 		options,
 	)
 	if err != nil || len(changes) == 0 {
@@ -768,6 +774,10 @@ func (wm *InstanceLDAPIDPWriteModel) NewChangedEvent(
 	userObjectClasses []string,
 	userFilters []string,
 	timeout time.Duration,
+	rootCA []byte,
+	groupResolution domain.LDAPGroupResolution,
+	groupBase string,
+	groupNameAttribute string,
 	secretCrypto crypto.EncryptionAlgorithm,
 	attributes idp.LDAPAttributes,
 	options idp.Options,
@@ -784,6 +794,10 @@ func (wm *InstanceLDAPIDPWriteModel) NewChangedEvent(
 		userObjectClasses,
 		userFilters,
 		timeout,
+		rootCA,
+		groupResolution,
+		groupBase,
+		groupNameAttribute,
 		secretCrypto,
 		attributes,
 		options,
@@ -910,7 +924,8 @@ func (wm *InstanceSAMLIDPWriteModel) NewChangedEvent(
 	aggregate *eventstore.Aggregate,
 	id,
 	name string,
-	metadata,
+	metadata []byte,
+	metadataURL string,
 	key,
 	certificate []byte,
 	secretCrypto crypto.EncryptionAlgorithm,
@@ -923,6 +938,7 @@ func (wm *InstanceSAMLIDPWriteModel) NewChangedEvent(
 	changes, err := wm.SAMLIDPWriteModel.NewChanges(
 		name,
 		metadata,
+		metadataURL,
 		key,
 		certificate,
 		secretCrypto,
@@ -3,6 +3,7 @@ package command
 import (
 	"context"
 
+	"github.com/zitadel/zitadel/internal/crypto"
 	"github.com/zitadel/zitadel/internal/domain"
 	"github.com/zitadel/zitadel/internal/zerrors"
 )
@@ -31,7 +32,7 @@ func (c *Commands) ChangeDefaultIDPOIDCConfig(ctx context.Context, config *domai
 		config.AuthorizationEndpoint,
 		config.TokenEndpoint,
 		config.ClientSecretString,
-		c.idpConfigEncryption,
+		crypto.ForContext(ctx, c.idpConfigEncryption),
 		config.IDPDisplayNameMapping,
 		config.UsernameMapping,
 		config.Scopes...)
@@ -203,6 +203,7 @@ func TestCommandSide_AddInstanceGenericOAuthIDP(t *testing.T) {
 							"user",
 							"idAttribute",
 							nil,
+							nil,
 							idp.Options{},
 						),
 					),
@@ -248,6 +249,7 @@ func TestCommandSide_AddInstanceGenericOAuthIDP(t *testing.T) {
 							"user",
 							"idAttribute",
 							[]string{"user"},
+							nil,
 							idp.Options{
 								IsCreationAllowed: true,
 								IsLinkingAllowed:  true,
@@ -502,6 +504,7 @@ func TestCommandSide_UpdateInstanceGenericOAuthIDP(t *testing.T) {
 								"user",
 								"idAttribute",
 								nil,
+								nil,
 								idp.Options{},
 							)),
 					),
@@ -544,6 +547,7 @@ func TestCommandSide_UpdateInstanceGenericOAuthIDP(t *testing.T) {
 								"user",
 								"idAttribute",
 								nil,
+								nil,
 								idp.Options{},
 							)),
 					),
@@ -1204,6 +1208,8 @@ func TestCommandSide_MigrateInstanceGenericOIDCToAzureADProvider(t *testing.T) {
 								nil,
 								"",
 								false,
+								nil,
+								false,
 								idp.Options{},
 							)
 							return event
@@ -1262,6 +1268,8 @@ func TestCommandSide_MigrateInstanceGenericOIDCToAzureADProvider(t *testing.T) {
 								[]string{"openid"},
 								"tenant",
 								true,
+								nil,
+								false,
 								idp.Options{
 									IsCreationAllowed: true,
 									IsLinkingAllowed:  true,
@@ -1600,6 +1608,27 @@ func TestCommandSide_AddInstanceAzureADIDP(t *testing.T) {
 				},
 			},
 		},
+		{
+			"invalid allowed tenant",
+			fields{
+				eventstore:  expectEventstore(),
+				idGenerator: id_mock.NewIDGeneratorExpectIDs(t, "id1"),
+			},
+			args{
+				ctx: authz.WithInstanceID(context.Background(), "instance1"),
+				provider: AzureADProvider{
+					Name:           "name",
+					ClientID:       "clientID",
+					ClientSecret:   "clientSecret",
+					AllowedTenants: []string{"tenant1", " "},
+				},
+			},
+			res{
+				err: func(err error) bool {
+					return errors.Is(err, zerrors.ThrowInvalidArgument(nil, "INST-Azt1q", ""))
+				},
+			},
+		},
 		{
 			name: "ok",
 			fields: fields{
@@ -1619,6 +1648,8 @@ func TestCommandSide_AddInstanceAzureADIDP(t *testing.T) {
 							nil,
 							"",
 							false,
+							nil,
+							false,
 							idp.Options{},
 						),
 					),
@@ -1658,6 +1689,8 @@ func TestCommandSide_AddInstanceAzureADIDP(t *testing.T) {
 							[]string{"openid"},
 							"tenant",
 							true,
+							[]string{"tenant1", "tenant2"},
+							true,
 							idp.Options{
 								IsCreationAllowed: true,
 								IsLinkingAllowed:  true,
@@ -1673,12 +1706,14 @@ func TestCommandSide_AddInstanceAzureADIDP(t *testing.T) {
 			args: args{
 				ctx: authz.WithInstanceID(context.Background(), "instance1"),
 				provider: AzureADProvider{
-					Name:          "name",
-					ClientID:      "clientID",
-					ClientSecret:  "clientSecret",
-					Scopes:        []string{"openid"},
-					Tenant:        "tenant",
-					EmailVerified: true,
+					Name:           "name",
+					ClientID:       "clientID",
+					ClientSecret:   "clientSecret",
+					Scopes:         []string{"openid"},
+					Tenant:         "tenant",
+					EmailVerified:  true,
+					AllowedTenants: []string{" tenant1", "tenant2 "},
+					FetchGroups:    true,
 					IDPOptions: idp.Options{
 						IsCreationAllowed: true,
 						IsLinkingAllowed:  true,
@@ -1822,6 +1857,8 @@ func TestCommandSide_UpdateInstanceAzureADIDP(t *testing.T) {
 								nil,
 								"",
 								false,
+								nil,
+								false,
 								idp.Options{},
 							)),
 					),
@@ -1858,6 +1895,8 @@ func TestCommandSide_UpdateInstanceAzureADIDP(t *testing.T) {
 								nil,
 								"",
 								false,
+								nil,
+								false,
 								idp.Options{},
 							)),
 					),
@@ -1878,6 +1917,8 @@ func TestCommandSide_UpdateInstanceAzureADIDP(t *testing.T) {
 									idp.ChangeAzureADScopes([]string{"openid", "profile"}),
 									idp.ChangeAzureADTenant("new tenant"),
 									idp.ChangeAzureADIsEmailVerified(true),
+									idp.ChangeAzureADAllowedTenants([]string{"tenant1"}),
+									idp.ChangeAzureADFetchGroups(true),
 									idp.ChangeAzureADOptions(idp.OptionChanges{
 										IsCreationAllowed: &t,
 										IsLinkingAllowed:  &t,
@@ -1896,12 +1937,14 @@ func TestCommandSide_UpdateInstanceAzureADIDP(t *testing.T) {
 				ctx: authz.WithInstanceID(context.Background(), "instance1"),
 				id:  "id1",
 				provider: AzureADProvider{
-					Name:          "new name",
-					ClientID:      "new clientID",
-					ClientSecret:  "new clientSecret",
-					Scopes:        []string{"openid", "profile"},
-					Tenant:        "new tenant",
-					EmailVerified: true,
+					Name:           "new name",
+					ClientID:       "new clientID",
+					ClientSecret:   "new clientSecret",
+					Scopes:         []string{"openid", "profile"},
+					Tenant:         "new tenant",
+					EmailVerified:  true,
+					AllowedTenants: []string{"tenant1"},
+					FetchGroups:    true,
 					IDPOptions: idp.Options{
 						IsCreationAllowed: true,
 						IsLinkingAllowed:  true,
@@ -4170,6 +4213,32 @@ func TestCommandSide_AddInstanceLDAPIDP(t *testing.T) {
 				},
 			},
 		},
+		{
+			"invalid rootCA",
+			fields{
+				eventstore:  expectEventstore(),
+				idGenerator: id_mock.NewIDGeneratorExpectIDs(t, "id1"),
+			},
+			args{
+				ctx: authz.WithInstanceID(context.Background(), "instance1"),
+				provider: LDAPProvider{
+					Name:              "name",
+					Servers:           []string{"server"},
+					BindDN:            "binddn",
+					BaseDN:            "baseDN",
+					BindPassword:      "password",
+					UserBase:          "user",
+					UserObjectClasses: []string{"object"},
+					UserFilters:       []string{"filter"},
+					RootCA:            []byte("rootCA"),
+				},
+			},
+			res{
+				err: func(err error) bool {
+					return errors.Is(err, zerrors.ThrowInvalidArgument(nil, "INST-Rc7x1", ""))
+				},
+			},
+		},
 		{
 			name: "ok",
 			fields: fields{
@@ -4193,6 +4262,10 @@ func TestCommandSide_AddInstanceLDAPIDP(t *testing.T) {
 							[]string{"object"},
 							[]string{"filter"},
 							time.Second*30,
+							nil,
+							domain.LDAPGroupResolutionUnspecified,
+							"",
+							"",
 							idp.LDAPAttributes{},
 							idp.Options{},
 						),
@@ -4244,6 +4317,10 @@ func TestCommandSide_AddInstanceLDAPIDP(t *testing.T) {
 							[]string{"object"},
 							[]string{"filter"},
 							time.Second*30,
+							nil,
+							domain.LDAPGroupResolutionUnspecified,
+							"",
+							"",
 							idp.LDAPAttributes{
 								IDAttribute:                "id",
 								FirstNameAttribute:         "firstName",
@@ -4559,6 +4636,10 @@ func TestCommandSide_UpdateInstanceLDAPIDP(t *testing.T) {
 								[]string{"object"},
 								[]string{"filter"},
 								time.Second*30,
+								nil,
+								domain.LDAPGroupResolutionUnspecified,
+								"",
+								"",
 								idp.LDAPAttributes{},
 								idp.Options{},
 							)),
@@ -4607,6 +4688,10 @@ func TestCommandSide_UpdateInstanceLDAPIDP(t *testing.T) {
 								[]string{"object"},
 								[]string{"filter"},
 								time.Second*30,
+								nil,
+								domain.LDAPGroupResolutionUnspecified,
+								"",
+								"",
 								idp.LDAPAttributes{},
 								idp.Options{},
 							)),
@@ -4632,6 +4717,10 @@ func TestCommandSide_UpdateInstanceLDAPIDP(t *testing.T) {
 									idp.ChangeLDAPUserObjectClasses([]string{"new object"}),
 									idp.ChangeLDAPUserFilters([]string{"new filter"}),
 									idp.ChangeLDAPTimeout(time.Second * 20),
+									idp.ChangeLDAPRootCA([]byte(ldapRootCA)),
+									idp.ChangeLDAPGroupResolution(domain.LDAPGroupResolutionMatchingRuleInChain),
+									idp.ChangeLDAPGroupBase("new groups"),
+									idp.ChangeLDAPGroupNameAttribute("new cn"),
 									idp.ChangeLDAPAttributes(idp.LDAPAttributeChanges{
 										IDAttribute:                stringPointer("new id"),
 										FirstNameAttribute:         stringPointer("new firstName"),
@@ -4646,6 +4735,7 @@ func TestCommandSide_UpdateInstanceLDAPIDP(t *testing.T) {
 										PreferredLanguageAttribute: stringPointer("new preferredLanguage"),
 										AvatarURLAttribute:         stringPointer("new avatarURL"),
 										ProfileAttribute:           stringPointer("new profile"),
+										MetadataAttributes:         &map[string]string{"department": "new departmentNumber"},
 									}),
 									idp.ChangeLDAPOptions(idp.OptionChanges{
 										IsCreationAllowed: &t,
@@ -4665,16 +4755,20 @@ func TestCommandSide_UpdateInstanceLDAPIDP(t *testing.T) {
 				ctx: authz.WithInstanceID(context.Background(), "instance1"),
 				id:  "id1",
 				provider: LDAPProvider{
-					Name:              "new name",
-					Servers:           []string{"new server"},
-					StartTLS:          true,
-					BaseDN:            "new basedn",
-					BindDN:            "new binddn",
-					BindPassword:      "new password",
-					UserBase:          "new user",
-					UserObjectClasses: []string{"new object"},
-					UserFilters:       []string{"new filter"},
-					Timeout:           time.Second * 20,
+					Name:               "new name",
+					Servers:            []string{"new server"},
+					StartTLS:           true,
+					BaseDN:             "new basedn",
+					BindDN:             "new binddn",
+					BindPassword:       "new password",
+					UserBase:           "new user",
+					UserObjectClasses:  []string{"new object"},
+					UserFilters:        []string{"new filter"},
+					Timeout:            time.Second * 20,
+					RootCA:             []byte(ldapRootCA),
+					GroupResolution:    domain.LDAPGroupResolutionMatchingRuleInChain,
+					GroupBase:          "new groups",
+					GroupNameAttribute: "new cn",
 					LDAPAttributes: idp.LDAPAttributes{
 						IDAttribute:                "new id",
 						FirstNameAttribute:         "new firstName",
@@ -4689,6 +4783,7 @@ func TestCommandSide_UpdateInstanceLDAPIDP(t *testing.T) {
 						PreferredLanguageAttribute: "new preferredLanguage",
 						AvatarURLAttribute:         "new avatarURL",
 						ProfileAttribute:           "new profile",
+						MetadataAttributes:         map[string]string{"department": "new departmentNumber"},
 					},
 					IDPOptions: idp.Options{
 						IsCreationAllowed: true,
@@ -4817,6 +4912,27 @@ func TestCommandSide_AddInstanceAppleIDP(t *testing.T) {
 				},
 			},
 		},
+		{
+			"invalid privateKey format",
+			fields{
+				eventstore:  expectEventstore(),
+				idGenerator: id_mock.NewIDGeneratorExpectIDs(t, "id1"),
+			},
+			args{
+				ctx: authz.WithInstanceID(context.Background(), "instance1"),
+				provider: AppleProvider{
+					ClientID:   "clientID",
+					TeamID:     "teamID",
+					KeyID:      "keyID",
+					PrivateKey: []byte("privateKey"),
+				},
+			},
+			res{
+				err: func(err error) bool {
+					return errors.Is(err, zerrors.ThrowInvalidArgument(nil, "INST-Vk3hx", "Errors.IDP.PrivateKeyInvalid"))
+				},
+			},
+		},
 		{
 			name: "ok",
 			fields: fields{
@@ -4833,7 +4949,7 @@ func TestCommandSide_AddInstanceAppleIDP(t *testing.T) {
 								CryptoType: crypto.TypeEncryption,
 								Algorithm:  "enc",
 								KeyID:      "id",
-								Crypted:    []byte("privateKey"),
+								Crypted:    []byte(applePrivateKey),
 							},
 							nil,
 							idp.Options{},
@@ -4849,7 +4965,7 @@ func TestCommandSide_AddInstanceAppleIDP(t *testing.T) {
 					ClientID:   "clientID",
 					TeamID:     "teamID",
 					KeyID:      "keyID",
-					PrivateKey: []byte("privateKey"),
+					PrivateKey: []byte(applePrivateKey),
 				},
 			},
 			res: res{
@@ -4873,7 +4989,7 @@ func TestCommandSide_AddInstanceAppleIDP(t *testing.T) {
 								CryptoType: crypto.TypeEncryption,
 								Algorithm:  "enc",
 								KeyID:      "id",
-								Crypted:    []byte("privateKey"),
+								Crypted:    []byte(applePrivateKey),
 							},
 							[]string{"name", "email"},
 							idp.Options{
@@ -4894,7 +5010,7 @@ func TestCommandSide_AddInstanceAppleIDP(t *testing.T) {
 					ClientID:   "clientID",
 					TeamID:     "teamID",
 					KeyID:      "keyID",
-					PrivateKey: []byte("privateKey"),
+					PrivateKey: []byte(applePrivateKey),
 					Scopes:     []string{"name", "email"},
 					IDPOptions: idp.Options{
 						IsCreationAllowed: true,
@@ -5020,6 +5136,27 @@ func TestCommandSide_UpdateInstanceAppleIDP(t *testing.T) {
 				},
 			},
 		},
+		{
+			"invalid privateKey",
+			fields{
+				eventstore: expectEventstore(),
+			},
+			args{
+				ctx: authz.WithInstanceID(context.Background(), "instance1"),
+				id:  "id1",
+				provider: AppleProvider{
+					ClientID:   "clientID",
+					TeamID:     "teamID",
+					KeyID:      "keyID",
+					PrivateKey: []byte("privateKey"),
+				},
+			},
+			res{
+				err: func(err error) bool {
+					return errors.Is(err, zerrors.ThrowInvalidArgument(nil, "INST-Vk4hx", "Errors.IDP.PrivateKeyInvalid"))
+				},
+			},
+		},
 		{
 			name: "not found",
 			fields: fields{
@@ -5112,7 +5249,7 @@ func TestCommandSide_UpdateInstanceAppleIDP(t *testing.T) {
 										CryptoType: crypto.TypeEncryption,
 										Algorithm:  "enc",
 										KeyID:      "id",
-										Crypted:    []byte("newPrivateKey"),
+										Crypted:    []byte(appleNewPrivateKey),
 									}),
 									idp.ChangeAppleScopes([]string{"name", "email"}),
 									idp.ChangeAppleOptions(idp.OptionChanges{
@@ -5136,7 +5273,7 @@ func TestCommandSide_UpdateInstanceAppleIDP(t *testing.T) {
 					ClientID:   "clientID2",
 					TeamID:     "teamID2",
 					KeyID:      "keyID2",
-					PrivateKey: []byte("newPrivateKey"),
+					PrivateKey: []byte(appleNewPrivateKey),
 					Scopes:     []string{"name", "email"},
 					IDPOptions: idp.Options{
 						IsCreationAllowed: true,
@@ -5237,6 +5374,7 @@ func TestCommandSide_AddInstanceSAMLIDP(t *testing.T) {
 							"id1",
 							"name",
 							[]byte("metadata"),
+							"",
 							&crypto.CryptoValue{
 								CryptoType: crypto.TypeEncryption,
 								Algorithm:  "enc",
@@ -5278,6 +5416,7 @@ func TestCommandSide_AddInstanceSAMLIDP(t *testing.T) {
 							"id1",
 							"name",
 							[]byte("metadata"),
+							"",
 							&crypto.CryptoValue{
 								CryptoType: crypto.TypeEncryption,
 								Algorithm:  "enc",
@@ -5446,6 +5585,7 @@ func TestCommandSide_UpdateInstanceGenericSAMLIDP(t *testing.T) {
 								"id1",
 								"name",
 								[]byte("metadata"),
+								"",
 								&crypto.CryptoValue{
 									CryptoType: crypto.TypeEncryption,
 									Algorithm:  "enc",
@@ -5484,6 +5624,7 @@ func TestCommandSide_UpdateInstanceGenericSAMLIDP(t *testing.T) {
 								"id1",
 								"name",
 								[]byte("metadata"),
+								"",
 								&crypto.CryptoValue{
 									CryptoType: crypto.TypeEncryption,
 									Algorithm:  "enc",
@@ -5626,6 +5767,7 @@ func TestCommandSide_RegenerateInstanceSAMLProviderCertificate(t *testing.T) {
 								"id1",
 								"name",
 								[]byte("metadata"),
+								"",
 								&crypto.CryptoValue{
 									CryptoType: crypto.TypeEncryption,
 									Algorithm:  "enc",
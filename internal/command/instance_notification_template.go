@@ -0,0 +1,154 @@
+package command
+
+import (
+	"context"
+	"regexp"
+
+	"golang.org/x/text/language"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/notification/templates"
+	"github.com/zitadel/zitadel/internal/repository/instance"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+// partialNameRegex restricts partial names to valid template identifiers
+var partialNameRegex = regexp.MustCompile(`^[a-zA-Z][a-zA-Z0-9_]{0,99}$`)
+
+type NotificationLayout struct {
+	// Language of the layout, empty for the default layout
+	Language   string
+	HTML       string
+	Text       string
+	InlineLogo bool
+}
+
+func (l *NotificationLayout) validate(partials map[string]string) error {
+	if l.Language != "" {
+		tag, err := language.Parse(l.Language)
+		if err != nil || tag.IsRoot() {
+			return zerrors.ThrowInvalidArgument(err, "COMMAND-q2w8fxo3kn", "Errors.NotificationTemplate.InvalidLanguage")
+		}
+		l.Language = tag.String()
+	}
+	if l.HTML == "" {
+		return zerrors.ThrowInvalidArgument(nil, "COMMAND-v5jd1snc7e", "Errors.NotificationTemplate.HTMLMissing")
+	}
+	if err := templates.ValidateHTML(l.HTML, partials); err != nil {
+		return zerrors.ThrowInvalidArgument(err, "COMMAND-b8ro4yvu2m", "Errors.NotificationTemplate.Invalid")
+	}
+	if err := templates.ValidateText(l.Text, partials); err != nil {
+		return zerrors.ThrowInvalidArgument(err, "COMMAND-z0gh6pe3xw", "Errors.NotificationTemplate.Invalid")
+	}
+	return nil
+}
+
+// SetNotificationLayout sets the email layout (html and optional plain text part) of the instance for a language.
+func (c *Commands) SetNotificationLayout(ctx context.Context, layout *NotificationLayout) (*domain.ObjectDetails, error) {
+	writeModel, err := c.getInstanceNotificationTemplateWriteModel(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err = layout.validate(writeModel.Partials); err != nil {
+		return nil, err
+	}
+	existing, ok := writeModel.Layouts[layout.Language]
+	if ok && existing.HTML == layout.HTML && existing.Text == layout.Text && existing.InlineLogo == layout.InlineLogo {
+		return nil, zerrors.ThrowPreconditionFailed(nil, "COMMAND-c4mt9aqj1h", "Errors.NoChangesFound")
+	}
+	err = c.pushAppendAndReduce(ctx, writeModel, instance.NewNotificationLayoutSetEvent(
+		ctx,
+		InstanceAggregateFromWriteModel(&writeModel.WriteModel),
+		layout.Language,
+		layout.HTML,
+		layout.Text,
+		layout.InlineLogo,
+	))
+	if err != nil {
+		return nil, err
+	}
+	return writeModelToObjectDetails(&writeModel.WriteModel), nil
+}
+
+// RemoveNotificationLayout removes the email layout of the instance for a language.
+func (c *Commands) RemoveNotificationLayout(ctx context.Context, lang string) (*domain.ObjectDetails, error) {
+	if lang != "" {
+		tag, err := language.Parse(lang)
+		if err != nil {
+			return nil, zerrors.ThrowInvalidArgument(err, "COMMAND-w7sk2ebn4u", "Errors.NotificationTemplate.InvalidLanguage")
+		}
+		lang = tag.String()
+	}
+	writeModel, err := c.getInstanceNotificationTemplateWriteModel(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if _, ok := writeModel.Layouts[lang]; !ok {
+		return nil, zerrors.ThrowNotFound(nil, "COMMAND-f3yl8dux0t", "Errors.NotificationTemplate.NotFound")
+	}
+	err = c.pushAppendAndReduce(ctx, writeModel, instance.NewNotificationLayoutRemovedEvent(
+		ctx,
+		InstanceAggregateFromWriteModel(&writeModel.WriteModel),
+		lang,
+	))
+	if err != nil {
+		return nil, err
+	}
+	return writeModelToObjectDetails(&writeModel.WriteModel), nil
+}
+
+// SetNotificationTemplatePartial sets a named partial (e.g. header or footer) shared by all layouts of the instance.
+func (c *Commands) SetNotificationTemplatePartial(ctx context.Context, name, content string) (*domain.ObjectDetails, error) {
+	if !partialNameRegex.MatchString(name) || name == "layout" {
+		return nil, zerrors.ThrowInvalidArgument(nil, "COMMAND-j6pe0vzr5q", "Errors.NotificationTemplate.InvalidPartialName")
+	}
+	if err := templates.ValidateHTML(content, nil); err != nil {
+		return nil, zerrors.ThrowInvalidArgument(err, "COMMAND-m1ua7gtk3d", "Errors.NotificationTemplate.Invalid")
+	}
+	writeModel, err := c.getInstanceNotificationTemplateWriteModel(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if existing, ok := writeModel.Partials[name]; ok && existing == content {
+		return nil, zerrors.ThrowPreconditionFailed(nil, "COMMAND-e9xc5hwo2l", "Errors.NoChangesFound")
+	}
+	err = c.pushAppendAndReduce(ctx, writeModel, instance.NewNotificationTemplatePartialSetEvent(
+		ctx,
+		InstanceAggregateFromWriteModel(&writeModel.WriteModel),
+		name,
+		content,
+	))
+	if err != nil {
+		return nil, err
+	}
+	return writeModelToObjectDetails(&writeModel.WriteModel), nil
+}
+
+// RemoveNotificationTemplatePartial removes a named partial of the instance.
+func (c *Commands) RemoveNotificationTemplatePartial(ctx context.Context, name string) (*domain.ObjectDetails, error) {
+	writeModel, err := c.getInstanceNotificationTemplateWriteModel(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if _, ok := writeModel.Partials[name]; !ok {
+		return nil, zerrors.ThrowNotFound(nil, "COMMAND-s8bn3kdq6y", "Errors.NotificationTemplate.PartialNotFound")
+	}
+	err = c.pushAppendAndReduce(ctx, writeModel, instance.NewNotificationTemplatePartialRemovedEvent(
+		ctx,
+		InstanceAggregateFromWriteModel(&writeModel.WriteModel),
+		name,
+	))
+	if err != nil {
+		return nil, err
+	}
+	return writeModelToObjectDetails(&writeModel.WriteModel), nil
+}
+
+func (c *Commands) getInstanceNotificationTemplateWriteModel(ctx context.Context) (*InstanceNotificationTemplateWriteModel, error) {
+	writeModel := NewInstanceNotificationTemplateWriteModel(authz.GetInstance(ctx).InstanceID())
+	if err := c.eventstore.FilterToQueryReducer(ctx, writeModel); err != nil {
+		return nil, err
+	}
+	return writeModel, nil
+}
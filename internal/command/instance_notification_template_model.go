@@ -0,0 +1,60 @@
+package command
+
+import (
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/notification/templates"
+	"github.com/zitadel/zitadel/internal/repository/instance"
+)
+
+type InstanceNotificationTemplateWriteModel struct {
+	eventstore.WriteModel
+
+	Layouts  map[string]*templates.Layout
+	Partials map[string]string
+}
+
+func NewInstanceNotificationTemplateWriteModel(instanceID string) *InstanceNotificationTemplateWriteModel {
+	return &InstanceNotificationTemplateWriteModel{
+		WriteModel: eventstore.WriteModel{
+			AggregateID:   instanceID,
+			ResourceOwner: instanceID,
+			InstanceID:    instanceID,
+		},
+		Layouts:  make(map[string]*templates.Layout),
+		Partials: make(map[string]string),
+	}
+}
+
+func (wm *InstanceNotificationTemplateWriteModel) Reduce() error {
+	for _, event := range wm.Events {
+		switch e := event.(type) {
+		case *instance.NotificationLayoutSetEvent:
+			wm.Layouts[e.Language] = &templates.Layout{
+				HTML:       e.HTML,
+				Text:       e.Text,
+				InlineLogo: e.InlineLogo,
+			}
+		case *instance.NotificationLayoutRemovedEvent:
+			delete(wm.Layouts, e.Language)
+		case *instance.NotificationTemplatePartialSetEvent:
+			wm.Partials[e.Name] = e.Content
+		case *instance.NotificationTemplatePartialRemovedEvent:
+			delete(wm.Partials, e.Name)
+		}
+	}
+	return wm.WriteModel.Reduce()
+}
+
+func (wm *InstanceNotificationTemplateWriteModel) Query() *eventstore.SearchQueryBuilder {
+	return eventstore.NewSearchQueryBuilder(eventstore.ColumnsEvent).
+		ResourceOwner(wm.ResourceOwner).
+		AddQuery().
+		AggregateTypes(instance.AggregateType).
+		AggregateIDs(wm.AggregateID).
+		EventTypes(
+			instance.NotificationLayoutSetEventType,
+			instance.NotificationLayoutRemovedEventType,
+			instance.NotificationTemplatePartialSetEventType,
+			instance.NotificationTemplatePartialRemovedEventType).
+		Builder()
+}
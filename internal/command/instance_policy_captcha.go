@@ -0,0 +1,129 @@
+package command
+
+import (
+	"context"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/command/preparation"
+	"github.com/zitadel/zitadel/internal/crypto"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/repository/instance"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+type CaptchaPolicy struct {
+	Type    domain.CaptchaType
+	SiteKey string
+	// Secret is only required when the policy is added.
+	// An empty Secret on change keeps the existing one.
+	Secret                 string
+	EnabledOnRegister      bool
+	EnabledOnPasswordReset bool
+	EnabledOnPasswordless  bool
+	EnabledOnSession       bool
+}
+
+func (p *CaptchaPolicy) IsValid(secretRequired bool) error {
+	if !p.Type.Valid() {
+		return zerrors.ThrowInvalidArgument(nil, "COMMAND-Vb2qe", "Errors.Captcha.TypeInvalid")
+	}
+	if p.SiteKey == "" {
+		return zerrors.ThrowInvalidArgument(nil, "COMMAND-Lw9ma", "Errors.Captcha.SiteKeyMissing")
+	}
+	if secretRequired && p.Secret == "" {
+		return zerrors.ThrowInvalidArgument(nil, "COMMAND-Qy3ns", "Errors.Captcha.SecretMissing")
+	}
+	return nil
+}
+
+func (c *Commands) encryptCaptchaSecret(secret string) (*crypto.CryptoValue, error) {
+	if secret == "" {
+		return nil, nil
+	}
+	return crypto.Encrypt([]byte(secret), c.captchaEncryption)
+}
+
+// SetDefaultCaptchaPolicy adds the captcha policy of the instance or changes it if it already exists.
+func (c *Commands) SetDefaultCaptchaPolicy(ctx context.Context, policy *CaptchaPolicy) (*domain.ObjectDetails, error) {
+	instanceAgg := instance.NewAggregate(authz.GetInstance(ctx).InstanceID())
+	cmds, err := preparation.PrepareCommands(ctx, c.eventstore.Filter, c.prepareSetDefaultCaptchaPolicy(instanceAgg, policy))
+	if err != nil {
+		return nil, err
+	}
+	pushedEvents, err := c.eventstore.Push(ctx, cmds...)
+	if err != nil {
+		return nil, err
+	}
+	return pushedEventsToObjectDetails(pushedEvents), nil
+}
+
+func (c *Commands) prepareSetDefaultCaptchaPolicy(
+	a *instance.Aggregate,
+	policy *CaptchaPolicy,
+) preparation.Validation {
+	return func() (preparation.CreateCommands, error) {
+		if err := policy.IsValid(false); err != nil {
+			return nil, err
+		}
+		return func(ctx context.Context, filter preparation.FilterToQueryReducer) ([]eventstore.Command, error) {
+			writeModel := NewInstanceCaptchaPolicyWriteModel(ctx)
+			events, err := filter(ctx, writeModel.Query())
+			if err != nil {
+				return nil, err
+			}
+			writeModel.AppendEvents(events...)
+			if err = writeModel.Reduce(); err != nil {
+				return nil, err
+			}
+			if writeModel.State != domain.PolicyStateActive && policy.Secret == "" {
+				return nil, zerrors.ThrowInvalidArgument(nil, "INSTANCE-Hs9ew", "Errors.Captcha.SecretMissing")
+			}
+			secret, err := c.encryptCaptchaSecret(policy.Secret)
+			if err != nil {
+				return nil, err
+			}
+			if writeModel.State != domain.PolicyStateActive {
+				return []eventstore.Command{
+					instance.NewCaptchaPolicyAddedEvent(ctx, &a.Aggregate,
+						policy.Type,
+						policy.SiteKey,
+						secret,
+						policy.EnabledOnRegister,
+						policy.EnabledOnPasswordReset,
+						policy.EnabledOnPasswordless,
+						policy.EnabledOnSession,
+					),
+				}, nil
+			}
+			change, hasChanged := writeModel.NewChangedEvent(ctx, &a.Aggregate, policy, secret)
+			if !hasChanged {
+				return nil, zerrors.ThrowPreconditionFailed(nil, "INSTANCE-Kx8ce", "Errors.IAM.CaptchaPolicy.NotChanged")
+			}
+			return []eventstore.Command{
+				change,
+			}, nil
+		}, nil
+	}
+}
+
+func (c *Commands) RemoveDefaultCaptchaPolicy(ctx context.Context) (*domain.ObjectDetails, error) {
+	instanceAgg := instance.NewAggregate(authz.GetInstance(ctx).InstanceID())
+	writeModel := NewInstanceCaptchaPolicyWriteModel(ctx)
+	err := c.eventstore.FilterToQueryReducer(ctx, writeModel)
+	if err != nil {
+		return nil, err
+	}
+	if writeModel.State != domain.PolicyStateActive {
+		return nil, zerrors.ThrowNotFound(nil, "INSTANCE-Ra2cv", "Errors.IAM.CaptchaPolicy.NotFound")
+	}
+	pushedEvents, err := c.eventstore.Push(ctx, instance.NewCaptchaPolicyRemovedEvent(ctx, &instanceAgg.Aggregate))
+	if err != nil {
+		return nil, err
+	}
+	err = AppendAndReduce(writeModel, pushedEvents...)
+	if err != nil {
+		return nil, err
+	}
+	return writeModelToObjectDetails(&writeModel.WriteModel), nil
+}
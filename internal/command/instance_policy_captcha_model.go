@@ -0,0 +1,72 @@
+package command
+
+import (
+	"context"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/crypto"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/repository/instance"
+)
+
+type InstanceCaptchaPolicyWriteModel struct {
+	CaptchaPolicyWriteModel
+}
+
+func NewInstanceCaptchaPolicyWriteModel(ctx context.Context) *InstanceCaptchaPolicyWriteModel {
+	return &InstanceCaptchaPolicyWriteModel{
+		CaptchaPolicyWriteModel{
+			WriteModel: eventstore.WriteModel{
+				AggregateID:   authz.GetInstance(ctx).InstanceID(),
+				ResourceOwner: authz.GetInstance(ctx).InstanceID(),
+			},
+		},
+	}
+}
+
+func (wm *InstanceCaptchaPolicyWriteModel) AppendEvents(events ...eventstore.Event) {
+	for _, event := range events {
+		switch e := event.(type) {
+		case *instance.CaptchaPolicyAddedEvent:
+			wm.CaptchaPolicyWriteModel.AppendEvents(&e.CaptchaPolicyAddedEvent)
+		case *instance.CaptchaPolicyChangedEvent:
+			wm.CaptchaPolicyWriteModel.AppendEvents(&e.CaptchaPolicyChangedEvent)
+		case *instance.CaptchaPolicyRemovedEvent:
+			wm.CaptchaPolicyWriteModel.AppendEvents(&e.CaptchaPolicyRemovedEvent)
+		}
+	}
+}
+
+func (wm *InstanceCaptchaPolicyWriteModel) Reduce() error {
+	return wm.CaptchaPolicyWriteModel.Reduce()
+}
+
+func (wm *InstanceCaptchaPolicyWriteModel) Query() *eventstore.SearchQueryBuilder {
+	return eventstore.NewSearchQueryBuilder(eventstore.ColumnsEvent).
+		ResourceOwner(wm.ResourceOwner).
+		AddQuery().
+		AggregateTypes(instance.AggregateType).
+		AggregateIDs(wm.CaptchaPolicyWriteModel.AggregateID).
+		EventTypes(
+			instance.CaptchaPolicyAddedEventType,
+			instance.CaptchaPolicyChangedEventType,
+			instance.CaptchaPolicyRemovedEventType).
+		Builder()
+}
+
+func (wm *InstanceCaptchaPolicyWriteModel) NewChangedEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	policy *CaptchaPolicy,
+	secret *crypto.CryptoValue,
+) (*instance.CaptchaPolicyChangedEvent, bool) {
+	changes := wm.changes(policy, secret)
+	if len(changes) == 0 {
+		return nil, false
+	}
+	changedEvent, err := instance.NewCaptchaPolicyChangedEvent(ctx, aggregate, changes)
+	if err != nil {
+		return nil, false
+	}
+	return changedEvent, true
+}
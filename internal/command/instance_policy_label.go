@@ -39,7 +39,8 @@ func (c *Commands) ChangeDefaultLabelPolicy(ctx context.Context, policy *domain.
 		policy.HideLoginNameSuffix,
 		policy.ErrorMsgPopup,
 		policy.DisableWatermark,
-		policy.ThemeMode)
+		policy.ThemeMode,
+		domain.SanitizeLabelPolicyCSS(policy.CustomCSS))
 	if !hasChanged {
 		return nil, zerrors.ThrowPreconditionFailed(nil, "INSTANCE-28fHe", "Errors.IAM.LabelPolicy.NotChanged")
 	}
@@ -357,6 +358,7 @@ func prepareAddDefaultLabelPolicy(
 	errorMsgPopup,
 	disableWatermark bool,
 	themeMode domain.LabelPolicyThemeMode,
+	customCSS string,
 ) preparation.Validation {
 	return func() (preparation.CreateCommands, error) {
 		return func(ctx context.Context, filter preparation.FilterToQueryReducer) ([]eventstore.Command, error) {
@@ -386,6 +388,7 @@ func prepareAddDefaultLabelPolicy(
 					errorMsgPopup,
 					disableWatermark,
 					themeMode,
+					domain.SanitizeLabelPolicyCSS(customCSS),
 				),
 				instance.NewLabelPolicyActivatedEvent(ctx, &a.Aggregate),
 			}, nil
@@ -99,6 +99,7 @@ func (wm *InstanceLabelPolicyWriteModel) NewChangedEvent(
 	errorMsgPopup,
 	disableWatermark bool,
 	themeMode domain.LabelPolicyThemeMode,
+	customCSS string,
 ) (*instance.LabelPolicyChangedEvent, bool) {
 	changes := make([]policy.LabelPolicyChanges, 0)
 	if wm.PrimaryColor != primaryColor {
@@ -137,6 +138,9 @@ func (wm *InstanceLabelPolicyWriteModel) NewChangedEvent(
 	if wm.ThemeMode != themeMode {
 		changes = append(changes, policy.ChangeThemeMode(themeMode))
 	}
+	if wm.CustomCSS != customCSS {
+		changes = append(changes, policy.ChangeCustomCSS(customCSS))
+	}
 	if len(changes) == 0 {
 		return nil, false
 	}
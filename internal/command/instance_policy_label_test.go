@@ -78,6 +78,7 @@ func TestCommandSide_ChangeDefaultLabelPolicy(t *testing.T) {
 								true,
 								true,
 								domain.LabelPolicyThemeAuto,
+								"",
 							),
 						),
 					),
@@ -125,6 +126,7 @@ func TestCommandSide_ChangeDefaultLabelPolicy(t *testing.T) {
 								true,
 								true,
 								domain.LabelPolicyThemeAuto,
+								"",
 							),
 						),
 					),
@@ -258,6 +260,7 @@ func TestCommandSide_ActivateDefaultLabelPolicy(t *testing.T) {
 								true,
 								true,
 								domain.LabelPolicyThemeAuto,
+								"",
 							),
 						),
 					),
@@ -360,6 +363,7 @@ func TestCommandSide_AddLogoDefaultLabelPolicy(t *testing.T) {
 								true,
 								true,
 								domain.LabelPolicyThemeAuto,
+								"",
 							),
 						),
 					),
@@ -402,6 +406,7 @@ func TestCommandSide_AddLogoDefaultLabelPolicy(t *testing.T) {
 								true,
 								true,
 								domain.LabelPolicyThemeAuto,
+								"",
 							),
 						),
 					),
@@ -507,6 +512,7 @@ func TestCommandSide_RemoveLogoDefaultLabelPolicy(t *testing.T) {
 								true,
 								true,
 								domain.LabelPolicyThemeAuto,
+								"",
 							),
 						),
 						eventFromEventPusher(
@@ -547,6 +553,7 @@ func TestCommandSide_RemoveLogoDefaultLabelPolicy(t *testing.T) {
 								true,
 								true,
 								domain.LabelPolicyThemeAuto,
+								"",
 							),
 						),
 						eventFromEventPusher(
@@ -657,6 +664,7 @@ func TestCommandSide_AddIconDefaultLabelPolicy(t *testing.T) {
 								true,
 								true,
 								domain.LabelPolicyThemeAuto,
+								"",
 							),
 						),
 					),
@@ -699,6 +707,7 @@ func TestCommandSide_AddIconDefaultLabelPolicy(t *testing.T) {
 								true,
 								true,
 								domain.LabelPolicyThemeAuto,
+								"",
 							),
 						),
 					),
@@ -804,6 +813,7 @@ func TestCommandSide_RemoveIconDefaultLabelPolicy(t *testing.T) {
 								true,
 								true,
 								domain.LabelPolicyThemeAuto,
+								"",
 							),
 						),
 						eventFromEventPusher(
@@ -916,6 +926,7 @@ func TestCommandSide_AddLogoDarkDefaultLabelPolicy(t *testing.T) {
 								true,
 								true,
 								domain.LabelPolicyThemeAuto,
+								"",
 							),
 						),
 					),
@@ -959,6 +970,7 @@ func TestCommandSide_AddLogoDarkDefaultLabelPolicy(t *testing.T) {
 								true,
 								true,
 								domain.LabelPolicyThemeAuto,
+								"",
 							),
 						),
 					),
@@ -1064,6 +1076,7 @@ func TestCommandSide_RemoveLogoDarkDefaultLabelPolicy(t *testing.T) {
 								true,
 								true,
 								domain.LabelPolicyThemeAuto,
+								"",
 							),
 						),
 						eventFromEventPusher(
@@ -1104,6 +1117,7 @@ func TestCommandSide_RemoveLogoDarkDefaultLabelPolicy(t *testing.T) {
 								true,
 								true,
 								domain.LabelPolicyThemeAuto,
+								"",
 							),
 						),
 						eventFromEventPusher(
@@ -1214,6 +1228,7 @@ func TestCommandSide_AddIconDarkDefaultLabelPolicy(t *testing.T) {
 								true,
 								true,
 								domain.LabelPolicyThemeAuto,
+								"",
 							),
 						),
 					),
@@ -1256,6 +1271,7 @@ func TestCommandSide_AddIconDarkDefaultLabelPolicy(t *testing.T) {
 								true,
 								true,
 								domain.LabelPolicyThemeAuto,
+								"",
 							),
 						),
 					),
@@ -1361,6 +1377,7 @@ func TestCommandSide_RemoveIconDarkDefaultLabelPolicy(t *testing.T) {
 								true,
 								true,
 								domain.LabelPolicyThemeAuto,
+								"",
 							),
 						),
 						eventFromEventPusher(
@@ -1401,6 +1418,7 @@ func TestCommandSide_RemoveIconDarkDefaultLabelPolicy(t *testing.T) {
 								true,
 								true,
 								domain.LabelPolicyThemeAuto,
+								"",
 							),
 						),
 						eventFromEventPusher(
@@ -1511,6 +1529,7 @@ func TestCommandSide_AddFontDefaultLabelPolicy(t *testing.T) {
 								true,
 								true,
 								domain.LabelPolicyThemeAuto,
+								"",
 							),
 						),
 					),
@@ -1553,6 +1572,7 @@ func TestCommandSide_AddFontDefaultLabelPolicy(t *testing.T) {
 								true,
 								true,
 								domain.LabelPolicyThemeAuto,
+								"",
 							),
 						),
 					),
@@ -1658,6 +1678,7 @@ func TestCommandSide_RemoveFontDefaultLabelPolicy(t *testing.T) {
 								true,
 								true,
 								domain.LabelPolicyThemeAuto,
+								"",
 							),
 						),
 						eventFromEventPusher(
@@ -1698,6 +1719,7 @@ func TestCommandSide_RemoveFontDefaultLabelPolicy(t *testing.T) {
 								true,
 								true,
 								domain.LabelPolicyThemeAuto,
+								"",
 							),
 						),
 						eventFromEventPusher(
@@ -242,7 +242,13 @@ func prepareChangeDefaultLoginPolicy(a *instance.Aggregate, policy *ChangeLoginP
 				policy.ExternalLoginCheckLifetime,
 				policy.MFAInitSkipLifetime,
 				policy.SecondFactorCheckLifetime,
-				policy.MultiFactorCheckLifetime)
+				policy.MultiFactorCheckLifetime,
+				policy.TrustedDeviceLifetime,
+				policy.SharedDevice,
+				policy.SharedDeviceLifetime,
+				policy.SharedDeviceIdleTimeout,
+				policy.PasskeyPrompt,
+				policy.PasskeyPromptSnoozeLifetime)
 			if !hasChanged {
 				return nil, zerrors.ThrowPreconditionFailed(nil, "INSTANCE-5M9vdd", "Errors.IAM.LoginPolicy.NotChanged")
 			}
@@ -270,6 +276,12 @@ func prepareAddDefaultLoginPolicy(
 	mfaInitSkipLifetime time.Duration,
 	secondFactorCheckLifetime time.Duration,
 	multiFactorCheckLifetime time.Duration,
+	trustedDeviceLifetime time.Duration,
+	sharedDevice bool,
+	sharedDeviceLifetime time.Duration,
+	sharedDeviceIdleTimeout time.Duration,
+	passkeyPrompt bool,
+	passkeyPromptSnoozeLifetime time.Duration,
 ) preparation.Validation {
 	return func() (preparation.CreateCommands, error) {
 		return func(ctx context.Context, filter preparation.FilterToQueryReducer) ([]eventstore.Command, error) {
@@ -304,6 +316,12 @@ func prepareAddDefaultLoginPolicy(
 					mfaInitSkipLifetime,
 					secondFactorCheckLifetime,
 					multiFactorCheckLifetime,
+					trustedDeviceLifetime,
+					sharedDevice,
+					sharedDeviceLifetime,
+					sharedDeviceIdleTimeout,
+					passkeyPrompt,
+					passkeyPromptSnoozeLifetime,
 				),
 			}, nil
 		}, nil
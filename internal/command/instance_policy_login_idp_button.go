@@ -0,0 +1,96 @@
+package command
+
+import (
+	"context"
+
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/repository/instance"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+// SetDefaultLoginPolicyIDPButtons sets the order and the visibility rules of the identity provider buttons of the login policy of the instance
+func (c *Commands) SetDefaultLoginPolicyIDPButtons(ctx context.Context, buttons []*domain.IDPButton) (*domain.ObjectDetails, error) {
+	existingPolicy, err := c.defaultLoginPolicyIDPButtonsWriteModel(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err = existingPolicy.validateButtons(buttons); err != nil {
+		return nil, err
+	}
+	instanceAgg := InstanceAggregateFromWriteModel(&existingPolicy.WriteModel)
+	pushedEvents, err := c.eventstore.Push(ctx, instance.NewIdentityProviderButtonsSetEvent(ctx, instanceAgg, buttons))
+	if err != nil {
+		return nil, err
+	}
+	err = AppendAndReduce(existingPolicy, pushedEvents...)
+	if err != nil {
+		return nil, err
+	}
+	return writeModelToObjectDetails(&existingPolicy.WriteModel), nil
+}
+
+// AddDefaultLoginPolicyIDPIcon uploads a custom icon for the button of the identity provider on the login screen
+func (c *Commands) AddDefaultLoginPolicyIDPIcon(ctx context.Context, idpID string, upload *AssetUpload) (*domain.ObjectDetails, error) {
+	if idpID == "" {
+		return nil, zerrors.ThrowInvalidArgument(nil, "INSTANCE-Ic5id", "Errors.IDMissing")
+	}
+	existingPolicy, err := c.defaultLoginPolicyIDPButtonsWriteModel(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if !existingPolicy.isLinked(idpID) {
+		return nil, zerrors.ThrowPreconditionFailed(nil, "INSTANCE-Ic6nl", "Errors.Policy.Login.IDPButton.IDPNotLinked")
+	}
+	asset, err := c.uploadAsset(ctx, upload)
+	if err != nil {
+		return nil, zerrors.ThrowInternal(err, "INSTANCE-Ic7pf", "Errors.Assets.Object.PutFailed")
+	}
+	instanceAgg := InstanceAggregateFromWriteModel(&existingPolicy.WriteModel)
+	pushedEvents, err := c.eventstore.Push(ctx, instance.NewIdentityProviderIconAddedEvent(ctx, instanceAgg, idpID, asset.Name))
+	if err != nil {
+		return nil, err
+	}
+	err = AppendAndReduce(existingPolicy, pushedEvents...)
+	if err != nil {
+		return nil, err
+	}
+	return writeModelToObjectDetails(&existingPolicy.WriteModel), nil
+}
+
+// RemoveDefaultLoginPolicyIDPIcon removes the custom icon of the button of the identity provider, the default icon will be shown again
+func (c *Commands) RemoveDefaultLoginPolicyIDPIcon(ctx context.Context, idpID string) (*domain.ObjectDetails, error) {
+	existingPolicy, err := c.defaultLoginPolicyIDPButtonsWriteModel(ctx)
+	if err != nil {
+		return nil, err
+	}
+	storeKey := existingPolicy.Icons[idpID]
+	if storeKey == "" {
+		return nil, zerrors.ThrowNotFound(nil, "INSTANCE-Ic8nf", "Errors.Policy.Login.IDPButton.IconNotExisting")
+	}
+	err = c.removeAsset(ctx, existingPolicy.ResourceOwner, storeKey)
+	if err != nil {
+		return nil, err
+	}
+	instanceAgg := InstanceAggregateFromWriteModel(&existingPolicy.WriteModel)
+	pushedEvents, err := c.eventstore.Push(ctx, instance.NewIdentityProviderIconRemovedEvent(ctx, instanceAgg, idpID, storeKey))
+	if err != nil {
+		return nil, err
+	}
+	err = AppendAndReduce(existingPolicy, pushedEvents...)
+	if err != nil {
+		return nil, err
+	}
+	return writeModelToObjectDetails(&existingPolicy.WriteModel), nil
+}
+
+func (c *Commands) defaultLoginPolicyIDPButtonsWriteModel(ctx context.Context) (*InstanceLoginPolicyIDPButtonsWriteModel, error) {
+	writeModel := NewInstanceLoginPolicyIDPButtonsWriteModel(ctx)
+	err := c.eventstore.FilterToQueryReducer(ctx, writeModel)
+	if err != nil {
+		return nil, err
+	}
+	if writeModel.State != domain.PolicyStateActive {
+		return nil, zerrors.ThrowNotFound(nil, "INSTANCE-Ic9nf", "Errors.IAM.LoginPolicy.NotFound")
+	}
+	return writeModel, nil
+}
@@ -0,0 +1,67 @@
+package command
+
+import (
+	"context"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/repository/instance"
+)
+
+type InstanceLoginPolicyIDPButtonsWriteModel struct {
+	LoginPolicyIDPButtonsWriteModel
+}
+
+func NewInstanceLoginPolicyIDPButtonsWriteModel(ctx context.Context) *InstanceLoginPolicyIDPButtonsWriteModel {
+	return &InstanceLoginPolicyIDPButtonsWriteModel{
+		LoginPolicyIDPButtonsWriteModel: LoginPolicyIDPButtonsWriteModel{
+			WriteModel: eventstore.WriteModel{
+				AggregateID:   authz.GetInstance(ctx).InstanceID(),
+				ResourceOwner: authz.GetInstance(ctx).InstanceID(),
+				InstanceID:    authz.GetInstance(ctx).InstanceID(),
+			},
+		},
+	}
+}
+
+func (wm *InstanceLoginPolicyIDPButtonsWriteModel) AppendEvents(events ...eventstore.Event) {
+	for _, event := range events {
+		switch e := event.(type) {
+		case *instance.LoginPolicyAddedEvent:
+			wm.LoginPolicyIDPButtonsWriteModel.AppendEvents(&e.LoginPolicyAddedEvent)
+		case *instance.IdentityProviderAddedEvent:
+			wm.LoginPolicyIDPButtonsWriteModel.AppendEvents(&e.IdentityProviderAddedEvent)
+		case *instance.IdentityProviderRemovedEvent:
+			wm.LoginPolicyIDPButtonsWriteModel.AppendEvents(&e.IdentityProviderRemovedEvent)
+		case *instance.IdentityProviderCascadeRemovedEvent:
+			wm.LoginPolicyIDPButtonsWriteModel.AppendEvents(&e.IdentityProviderCascadeRemovedEvent)
+		case *instance.IdentityProviderButtonsSetEvent:
+			wm.LoginPolicyIDPButtonsWriteModel.AppendEvents(&e.IdentityProviderButtonsSetEvent)
+		case *instance.IdentityProviderIconAddedEvent:
+			wm.LoginPolicyIDPButtonsWriteModel.AppendEvents(&e.IdentityProviderIconAddedEvent)
+		case *instance.IdentityProviderIconRemovedEvent:
+			wm.LoginPolicyIDPButtonsWriteModel.AppendEvents(&e.IdentityProviderIconRemovedEvent)
+		}
+	}
+}
+
+func (wm *InstanceLoginPolicyIDPButtonsWriteModel) Reduce() error {
+	return wm.LoginPolicyIDPButtonsWriteModel.Reduce()
+}
+
+func (wm *InstanceLoginPolicyIDPButtonsWriteModel) Query() *eventstore.SearchQueryBuilder {
+	return eventstore.NewSearchQueryBuilder(eventstore.ColumnsEvent).
+		ResourceOwner(wm.ResourceOwner).
+		AddQuery().
+		AggregateTypes(instance.AggregateType).
+		AggregateIDs(wm.AggregateID).
+		EventTypes(
+			instance.LoginPolicyAddedEventType,
+			instance.LoginPolicyIDPProviderAddedEventType,
+			instance.LoginPolicyIDPProviderRemovedEventType,
+			instance.LoginPolicyIDPProviderCascadeRemovedEventType,
+			instance.LoginPolicyIDPProviderButtonsSetEventType,
+			instance.LoginPolicyIDPProviderIconAddedEventType,
+			instance.LoginPolicyIDPProviderIconRemovedEventType).
+		Builder()
+}
@@ -76,7 +76,13 @@ func (wm *InstanceLoginPolicyWriteModel) NewChangedEvent(
 	externalLoginCheckLifetime,
 	mfaInitSkipLifetime,
 	secondFactorCheckLifetime,
-	multiFactorCheckLifetime time.Duration,
+	multiFactorCheckLifetime,
+	trustedDeviceLifetime time.Duration,
+	sharedDevice bool,
+	sharedDeviceLifetime,
+	sharedDeviceIdleTimeout time.Duration,
+	passkeyPrompt bool,
+	passkeyPromptSnoozeLifetime time.Duration,
 ) (*instance.LoginPolicyChangedEvent, bool) {
 
 	changes := make([]policy.LoginPolicyChanges, 0)
@@ -125,6 +131,24 @@ func (wm *InstanceLoginPolicyWriteModel) NewChangedEvent(
 	if wm.MultiFactorCheckLifetime != multiFactorCheckLifetime {
 		changes = append(changes, policy.ChangeMultiFactorCheckLifetime(multiFactorCheckLifetime))
 	}
+	if wm.TrustedDeviceLifetime != trustedDeviceLifetime {
+		changes = append(changes, policy.ChangeTrustedDeviceLifetime(trustedDeviceLifetime))
+	}
+	if wm.SharedDevice != sharedDevice {
+		changes = append(changes, policy.ChangeSharedDevice(sharedDevice))
+	}
+	if wm.SharedDeviceLifetime != sharedDeviceLifetime {
+		changes = append(changes, policy.ChangeSharedDeviceLifetime(sharedDeviceLifetime))
+	}
+	if wm.SharedDeviceIdleTimeout != sharedDeviceIdleTimeout {
+		changes = append(changes, policy.ChangeSharedDeviceIdleTimeout(sharedDeviceIdleTimeout))
+	}
+	if wm.PasskeyPrompt != passkeyPrompt {
+		changes = append(changes, policy.ChangePasskeyPrompt(passkeyPrompt))
+	}
+	if wm.PasskeyPromptSnoozeLifetime != passkeyPromptSnoozeLifetime {
+		changes = append(changes, policy.ChangePasskeyPromptSnoozeLifetime(passkeyPromptSnoozeLifetime))
+	}
 	if wm.DisableLoginWithEmail != disableLoginWithEmail {
 		changes = append(changes, policy.ChangeDisableLoginWithEmail(disableLoginWithEmail))
 	}
@@ -79,6 +79,12 @@ func TestCommandSide_ChangeDefaultLoginPolicy(t *testing.T) {
 								time.Hour*3,
 								time.Hour*4,
 								time.Hour*5,
+								time.Hour*6,
+								false,
+								0,
+								0,
+								false,
+								0,
 							),
 						),
 					),
@@ -104,6 +110,7 @@ func TestCommandSide_ChangeDefaultLoginPolicy(t *testing.T) {
 					MFAInitSkipLifetime:        time.Hour * 3,
 					SecondFactorCheckLifetime:  time.Hour * 4,
 					MultiFactorCheckLifetime:   time.Hour * 5,
+					TrustedDeviceLifetime:      time.Hour * 6,
 				},
 			},
 			res: res{
@@ -137,6 +144,12 @@ func TestCommandSide_ChangeDefaultLoginPolicy(t *testing.T) {
 								time.Hour*3,
 								time.Hour*4,
 								time.Hour*5,
+								time.Hour*6,
+								false,
+								0,
+								0,
+								false,
+								0,
 							),
 						),
 					),
@@ -158,7 +171,11 @@ func TestCommandSide_ChangeDefaultLoginPolicy(t *testing.T) {
 							time.Hour*20,
 							time.Hour*30,
 							time.Hour*40,
-							time.Hour*50),
+							time.Hour*50,
+							time.Hour*60,
+							true,
+							time.Hour*8,
+							time.Minute*5),
 					),
 				),
 			},
@@ -182,6 +199,10 @@ func TestCommandSide_ChangeDefaultLoginPolicy(t *testing.T) {
 					MFAInitSkipLifetime:        time.Hour * 30,
 					SecondFactorCheckLifetime:  time.Hour * 40,
 					MultiFactorCheckLifetime:   time.Hour * 50,
+					TrustedDeviceLifetime:      time.Hour * 60,
+					SharedDevice:               true,
+					SharedDeviceLifetime:       time.Hour * 8,
+					SharedDeviceIdleTimeout:    time.Minute * 5,
 				},
 			},
 			res: res{
@@ -287,6 +308,12 @@ func TestCommandSide_AddIDPProviderDefaultLoginPolicy(t *testing.T) {
 								time.Hour*3,
 								time.Hour*4,
 								time.Hour*5,
+								time.Hour*6,
+								false,
+								0,
+								0,
+								false,
+								0,
 							),
 						),
 					),
@@ -329,6 +356,12 @@ func TestCommandSide_AddIDPProviderDefaultLoginPolicy(t *testing.T) {
 								time.Hour*3,
 								time.Hour*4,
 								time.Hour*5,
+								time.Hour*6,
+								false,
+								0,
+								0,
+								false,
+								0,
 							),
 						),
 					),
@@ -391,6 +424,12 @@ func TestCommandSide_AddIDPProviderDefaultLoginPolicy(t *testing.T) {
 								time.Hour*3,
 								time.Hour*4,
 								time.Hour*5,
+								time.Hour*6,
+								false,
+								0,
+								0,
+								false,
+								0,
 							),
 						),
 					),
@@ -529,6 +568,12 @@ func TestCommandSide_RemoveIDPProviderDefaultLoginPolicy(t *testing.T) {
 								time.Hour*3,
 								time.Hour*4,
 								time.Hour*5,
+								time.Hour*6,
+								false,
+								0,
+								0,
+								false,
+								0,
 							),
 						),
 					),
@@ -571,6 +616,12 @@ func TestCommandSide_RemoveIDPProviderDefaultLoginPolicy(t *testing.T) {
 								time.Hour*3,
 								time.Hour*4,
 								time.Hour*5,
+								time.Hour*6,
+								false,
+								0,
+								0,
+								false,
+								0,
 							),
 						),
 					),
@@ -626,6 +677,12 @@ func TestCommandSide_RemoveIDPProviderDefaultLoginPolicy(t *testing.T) {
 								time.Hour*3,
 								time.Hour*4,
 								time.Hour*5,
+								time.Hour*6,
+								false,
+								0,
+								0,
+								false,
+								0,
 							),
 						),
 					),
@@ -682,6 +739,12 @@ func TestCommandSide_RemoveIDPProviderDefaultLoginPolicy(t *testing.T) {
 								time.Hour*3,
 								time.Hour*4,
 								time.Hour*5,
+								time.Hour*6,
+								false,
+								0,
+								0,
+								false,
+								0,
 							),
 						),
 					),
@@ -1407,7 +1470,9 @@ func newDefaultLoginPolicyChangedEvent(ctx context.Context, allowRegister, allow
 	hidePasswordReset, ignoreUnknownUsernames, allowDomainDiscovery, disableLoginWithEmail, disableLoginWithPhone bool,
 	passwordlessType domain.PasswordlessType,
 	redirectURI string,
-	passwordLifetime, externalLoginLifetime, mfaInitSkipLifetime, secondFactorLifetime, multiFactorLifetime time.Duration) *instance.LoginPolicyChangedEvent {
+	passwordLifetime, externalLoginLifetime, mfaInitSkipLifetime, secondFactorLifetime, multiFactorLifetime, trustedDeviceLifetime time.Duration,
+	sharedDevice bool,
+	sharedDeviceLifetime, sharedDeviceIdleTimeout time.Duration) *instance.LoginPolicyChangedEvent {
 	event, _ := instance.NewLoginPolicyChangedEvent(ctx,
 		&instance.NewAggregate("INSTANCE").Aggregate,
 		[]policy.LoginPolicyChanges{
@@ -1428,6 +1493,10 @@ func newDefaultLoginPolicyChangedEvent(ctx context.Context, allowRegister, allow
 			policy.ChangeMFAInitSkipLifetime(mfaInitSkipLifetime),
 			policy.ChangeSecondFactorCheckLifetime(secondFactorLifetime),
 			policy.ChangeMultiFactorCheckLifetime(multiFactorLifetime),
+			policy.ChangeTrustedDeviceLifetime(trustedDeviceLifetime),
+			policy.ChangeSharedDevice(sharedDevice),
+			policy.ChangeSharedDeviceLifetime(sharedDeviceLifetime),
+			policy.ChangeSharedDeviceIdleTimeout(sharedDeviceIdleTimeout),
 		},
 	)
 	return event
@@ -14,6 +14,9 @@ type SecurityPolicy struct {
 	EnableIframeEmbedding bool
 	AllowedOrigins        []string
 	EnableImpersonation   bool
+	// ActionsHTTPAllowList restricts the hosts actions are allowed to call using the http module.
+	// An empty list allows all hosts, which are not denied by the runtime configuration.
+	ActionsHTTPAllowList []string
 }
 
 func (c *Commands) SetSecurityPolicy(ctx context.Context, policy *SecurityPolicy) (*domain.ObjectDetails, error) {
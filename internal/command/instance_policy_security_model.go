@@ -38,6 +38,9 @@ func (wm *InstanceSecurityPolicyWriteModel) Reduce() error {
 			if e.EnableImpersonation != nil {
 				wm.EnableImpersonation = *e.EnableImpersonation
 			}
+			if e.ActionsHTTPAllowList != nil {
+				wm.ActionsHTTPAllowList = *e.ActionsHTTPAllowList
+			}
 		}
 	}
 	return wm.WriteModel.Reduce()
@@ -71,6 +74,9 @@ func (wm *InstanceSecurityPolicyWriteModel) NewSetEvent(
 	if wm.EnableImpersonation != policy.EnableImpersonation {
 		changes = append(changes, instance.ChangeSecurityPolicyEnableImpersonation(policy.EnableImpersonation))
 	}
+	if !slices.Equal(wm.ActionsHTTPAllowList, policy.ActionsHTTPAllowList) {
+		changes = append(changes, instance.ChangeSecurityPolicyActionsHTTPAllowList(policy.ActionsHTTPAllowList))
+	}
 	changeEvent, err := instance.NewSecurityPolicySetEvent(ctx, aggregate, changes)
 	if err != nil {
 		return nil, err
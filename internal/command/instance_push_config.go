@@ -0,0 +1,162 @@
+package command
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/x509"
+	"encoding/json"
+	"encoding/pem"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/crypto"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/repository/instance"
+	"github.com/zitadel/zitadel/internal/telemetry/tracing"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+// FCMPushConfig is the configuration of Firebase Cloud Messaging used to deliver push notifications to Android devices
+type FCMPushConfig struct {
+	ProjectID string
+	// ServiceAccountKey is the JSON key of a Google service account allowed to send messages
+	ServiceAccountKey []byte
+}
+
+func (c *FCMPushConfig) IsValid() error {
+	if c.ProjectID == "" {
+		return zerrors.ThrowInvalidArgument(nil, "COMMAND-Pf2mw", "Errors.Push.FCM.ProjectIDMissing")
+	}
+	key := new(struct {
+		Type        string `json:"type"`
+		ClientEmail string `json:"client_email"`
+		PrivateKey  string `json:"private_key"`
+	})
+	if err := json.Unmarshal(c.ServiceAccountKey, key); err != nil ||
+		key.Type != "service_account" || key.ClientEmail == "" || key.PrivateKey == "" {
+		return zerrors.ThrowInvalidArgument(err, "COMMAND-Pf8ks", "Errors.Push.FCM.ServiceAccountKeyInvalid")
+	}
+	return nil
+}
+
+// APNsPushConfig is the configuration of the Apple Push Notification service used to deliver push notifications to iOS devices
+type APNsPushConfig struct {
+	KeyID    string
+	TeamID   string
+	BundleID string
+	// PrivateKey is the PEM encoded (.p8) token signing key
+	PrivateKey []byte
+	// Production sends the notifications through the production environment instead of the sandbox
+	Production bool
+}
+
+func (c *APNsPushConfig) IsValid() error {
+	if c.KeyID == "" || c.TeamID == "" || c.BundleID == "" {
+		return zerrors.ThrowInvalidArgument(nil, "COMMAND-Pa3nd", "Errors.Push.APNs.Invalid")
+	}
+	block, _ := pem.Decode(c.PrivateKey)
+	if block == nil {
+		return zerrors.ThrowInvalidArgument(nil, "COMMAND-Pa6vq", "Errors.Push.APNs.PrivateKeyInvalid")
+	}
+	key, err := x509.ParsePKCS8PrivateKey(block.Bytes)
+	if err != nil {
+		return zerrors.ThrowInvalidArgument(err, "COMMAND-Pa9wr", "Errors.Push.APNs.PrivateKeyInvalid")
+	}
+	if _, ok := key.(*ecdsa.PrivateKey); !ok {
+		return zerrors.ThrowInvalidArgument(nil, "COMMAND-Pa1ke", "Errors.Push.APNs.PrivateKeyInvalid")
+	}
+	return nil
+}
+
+// SetFCMPushConfig sets the FCM configuration of the instance, an existing one is replaced.
+func (c *Commands) SetFCMPushConfig(ctx context.Context, config *FCMPushConfig) (_ *domain.ObjectDetails, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	if err = config.IsValid(); err != nil {
+		return nil, err
+	}
+	serviceAccountKey, err := crypto.Encrypt(config.ServiceAccountKey, c.smsEncryption)
+	if err != nil {
+		return nil, err
+	}
+	writeModel, err := c.getInstancePushConfigWriteModel(ctx)
+	if err != nil {
+		return nil, err
+	}
+	err = c.pushAppendAndReduce(ctx, writeModel, instance.NewPushConfigFCMSetEvent(
+		ctx,
+		InstanceAggregateFromWriteModel(&writeModel.WriteModel),
+		config.ProjectID,
+		serviceAccountKey,
+	))
+	if err != nil {
+		return nil, err
+	}
+	return writeModelToObjectDetails(&writeModel.WriteModel), nil
+}
+
+// SetAPNsPushConfig sets the APNs configuration of the instance, an existing one is replaced.
+func (c *Commands) SetAPNsPushConfig(ctx context.Context, config *APNsPushConfig) (_ *domain.ObjectDetails, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	if err = config.IsValid(); err != nil {
+		return nil, err
+	}
+	privateKey, err := crypto.Encrypt(config.PrivateKey, c.smsEncryption)
+	if err != nil {
+		return nil, err
+	}
+	writeModel, err := c.getInstancePushConfigWriteModel(ctx)
+	if err != nil {
+		return nil, err
+	}
+	err = c.pushAppendAndReduce(ctx, writeModel, instance.NewPushConfigAPNsSetEvent(
+		ctx,
+		InstanceAggregateFromWriteModel(&writeModel.WriteModel),
+		config.KeyID,
+		config.TeamID,
+		config.BundleID,
+		privateKey,
+		config.Production,
+	))
+	if err != nil {
+		return nil, err
+	}
+	return writeModelToObjectDetails(&writeModel.WriteModel), nil
+}
+
+// RemovePushConfig removes the configuration of the platform,
+// devices registered with it no longer receive push notifications.
+func (c *Commands) RemovePushConfig(ctx context.Context, platform domain.PushPlatform) (_ *domain.ObjectDetails, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	if !platform.Valid() {
+		return nil, zerrors.ThrowInvalidArgument(nil, "COMMAND-Pr4mv", "Errors.Push.PlatformInvalid")
+	}
+	writeModel, err := c.getInstancePushConfigWriteModel(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if !writeModel.Exists(platform) {
+		return nil, zerrors.ThrowNotFound(nil, "COMMAND-Pr7cx", "Errors.Push.NotFound")
+	}
+	err = c.pushAppendAndReduce(ctx, writeModel, instance.NewPushConfigRemovedEvent(
+		ctx,
+		InstanceAggregateFromWriteModel(&writeModel.WriteModel),
+		platform,
+	))
+	if err != nil {
+		return nil, err
+	}
+	return writeModelToObjectDetails(&writeModel.WriteModel), nil
+}
+
+func (c *Commands) getInstancePushConfigWriteModel(ctx context.Context) (*InstancePushConfigWriteModel, error) {
+	writeModel := NewInstancePushConfigWriteModel(authz.GetInstance(ctx).InstanceID())
+	if err := c.eventstore.FilterToQueryReducer(ctx, writeModel); err != nil {
+		return nil, err
+	}
+	return writeModel, nil
+}
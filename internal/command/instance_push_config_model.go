@@ -0,0 +1,91 @@
+package command
+
+import (
+	"github.com/zitadel/zitadel/internal/crypto"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/repository/instance"
+)
+
+type InstancePushConfigWriteModel struct {
+	eventstore.WriteModel
+
+	FCM  *FCMPushConfigWriteModel
+	APNs *APNsPushConfigWriteModel
+}
+
+type FCMPushConfigWriteModel struct {
+	ProjectID         string
+	ServiceAccountKey *crypto.CryptoValue
+}
+
+type APNsPushConfigWriteModel struct {
+	KeyID      string
+	TeamID     string
+	BundleID   string
+	PrivateKey *crypto.CryptoValue
+	Production bool
+}
+
+func NewInstancePushConfigWriteModel(instanceID string) *InstancePushConfigWriteModel {
+	return &InstancePushConfigWriteModel{
+		WriteModel: eventstore.WriteModel{
+			AggregateID:   instanceID,
+			ResourceOwner: instanceID,
+			InstanceID:    instanceID,
+		},
+	}
+}
+
+func (wm *InstancePushConfigWriteModel) Reduce() error {
+	for _, event := range wm.Events {
+		switch e := event.(type) {
+		case *instance.PushConfigFCMSetEvent:
+			wm.FCM = &FCMPushConfigWriteModel{
+				ProjectID:         e.ProjectID,
+				ServiceAccountKey: e.ServiceAccountKey,
+			}
+		case *instance.PushConfigAPNsSetEvent:
+			wm.APNs = &APNsPushConfigWriteModel{
+				KeyID:      e.KeyID,
+				TeamID:     e.TeamID,
+				BundleID:   e.BundleID,
+				PrivateKey: e.PrivateKey,
+				Production: e.Production,
+			}
+		case *instance.PushConfigRemovedEvent:
+			switch e.Platform {
+			case domain.PushPlatformFCM:
+				wm.FCM = nil
+			case domain.PushPlatformAPNs:
+				wm.APNs = nil
+			case domain.PushPlatformUnspecified:
+			}
+		}
+	}
+	return wm.WriteModel.Reduce()
+}
+
+func (wm *InstancePushConfigWriteModel) Query() *eventstore.SearchQueryBuilder {
+	return eventstore.NewSearchQueryBuilder(eventstore.ColumnsEvent).
+		ResourceOwner(wm.ResourceOwner).
+		AddQuery().
+		AggregateTypes(instance.AggregateType).
+		AggregateIDs(wm.AggregateID).
+		EventTypes(
+			instance.PushConfigFCMSetEventType,
+			instance.PushConfigAPNsSetEventType,
+			instance.PushConfigRemovedEventType).
+		Builder()
+}
+
+func (wm *InstancePushConfigWriteModel) Exists(platform domain.PushPlatform) bool {
+	switch platform {
+	case domain.PushPlatformFCM:
+		return wm.FCM != nil
+	case domain.PushPlatformAPNs:
+		return wm.APNs != nil
+	case domain.PushPlatformUnspecified:
+	}
+	return false
+}
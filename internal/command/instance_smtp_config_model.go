@@ -21,6 +21,7 @@ type IAMSMTPConfigWriteModel struct {
 	SenderAddress  string
 	SenderName     string
 	ReplyToAddress string
+	HTTP           *HTTPConfig
 	State          domain.SMTPConfigState
 
 	domain                                 string
@@ -73,6 +74,22 @@ func (wm *IAMSMTPConfigWriteModel) Reduce() error {
 				continue
 			}
 			wm.reduceSMTPConfigChangedEvent(e)
+		case *instance.SMTPConfigHTTPAddedEvent:
+			if wm.ID != e.ID {
+				continue
+			}
+			wm.Description = e.Description
+			wm.HTTP = &HTTPConfig{
+				Description: e.Description,
+				Endpoint:    e.Endpoint,
+				SigningKey:  e.SigningKey,
+			}
+			wm.State = domain.SMTPConfigStateInactive
+		case *instance.SMTPConfigHTTPChangedEvent:
+			if wm.ID != e.ID {
+				continue
+			}
+			wm.reduceSMTPConfigHTTPChangedEvent(e)
 		case *instance.SMTPConfigRemovedEvent:
 			if wm.ID != e.ID {
 				continue
@@ -123,6 +140,8 @@ func (wm *IAMSMTPConfigWriteModel) Query() *eventstore.SearchQueryBuilder {
 			instance.SMTPConfigActivatedEventType,
 			instance.SMTPConfigDeactivatedEventType,
 			instance.SMTPConfigRemovedEventType,
+			instance.SMTPConfigHTTPAddedEventType,
+			instance.SMTPConfigHTTPChangedEventType,
 			instance.InstanceDomainAddedEventType,
 			instance.InstanceDomainRemovedEventType,
 			instance.DomainPolicyAddedEventType,
@@ -225,6 +244,41 @@ func (wm *IAMSMTPConfigWriteModel) reduceSMTPConfigChangedEvent(e *instance.SMTP
 	}
 }
 
+func (wm *IAMSMTPConfigWriteModel) reduceSMTPConfigHTTPChangedEvent(e *instance.SMTPConfigHTTPChangedEvent) {
+	if e.Description != nil {
+		wm.Description = *e.Description
+		wm.HTTP.Description = *e.Description
+	}
+	if e.Endpoint != nil {
+		wm.HTTP.Endpoint = *e.Endpoint
+	}
+	if e.SigningKey != nil {
+		wm.HTTP.SigningKey = e.SigningKey
+	}
+}
+
+func (wm *IAMSMTPConfigWriteModel) NewHTTPChangedEvent(ctx context.Context, aggregate *eventstore.Aggregate, id string, description, endpoint *string, signingKey *crypto.CryptoValue) (*instance.SMTPConfigHTTPChangedEvent, bool, error) {
+	changes := make([]instance.SMTPConfigHTTPChanges, 0)
+
+	if description != nil && wm.HTTP.Description != *description {
+		changes = append(changes, instance.ChangeSMTPConfigHTTPDescription(*description))
+	}
+	if endpoint != nil && wm.HTTP.Endpoint != *endpoint {
+		changes = append(changes, instance.ChangeSMTPConfigHTTPEndpoint(*endpoint))
+	}
+	if signingKey != nil {
+		changes = append(changes, instance.ChangeSMTPConfigHTTPSigningKey(signingKey))
+	}
+	if len(changes) == 0 {
+		return nil, false, nil
+	}
+	changeEvent, err := instance.NewSMTPConfigHTTPChangedEvent(ctx, aggregate, id, changes)
+	if err != nil {
+		return nil, false, err
+	}
+	return changeEvent, true, nil
+}
+
 func (wm *IAMSMTPConfigWriteModel) reduceSMTPConfigRemovedEvent(e *instance.SMTPConfigRemovedEvent) {
 	wm.Description = ""
 	wm.TLS = false
@@ -234,6 +288,7 @@ func (wm *IAMSMTPConfigWriteModel) reduceSMTPConfigRemovedEvent(e *instance.SMTP
 	wm.Host = ""
 	wm.User = ""
 	wm.Password = nil
+	wm.HTTP = nil
 	wm.State = domain.SMTPConfigStateRemoved
 
 	// If ID has empty value we're dealing with the old and unique smtp settings
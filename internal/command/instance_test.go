@@ -125,6 +125,8 @@ func oidcAppEvents(ctx context.Context, orgID, projectID, id, name, clientID str
 			0,
 			nil,
 			false,
+			"",
+			"",
 		),
 	}
 }
@@ -201,17 +203,17 @@ func instancePoliciesFilters(instanceID string) []expect {
 func instancePoliciesEvents(ctx context.Context, instanceID string) []eventstore.Command {
 	instanceAgg := instance.NewAggregate(instanceID)
 	return []eventstore.Command{
-		instance.NewPasswordComplexityPolicyAddedEvent(ctx, &instanceAgg.Aggregate, 8, true, true, true, true),
+		instance.NewPasswordComplexityPolicyAddedEvent(ctx, &instanceAgg.Aggregate, 8, true, true, true, true, false),
 		instance.NewPasswordAgePolicyAddedEvent(ctx, &instanceAgg.Aggregate, 0, 0),
 		instance.NewDomainPolicyAddedEvent(ctx, &instanceAgg.Aggregate, false, false, false),
-		instance.NewLoginPolicyAddedEvent(ctx, &instanceAgg.Aggregate, true, true, true, false, false, false, false, true, false, false, domain.PasswordlessTypeAllowed, "", 240*time.Hour, 240*time.Hour, 720*time.Hour, 18*time.Hour, 12*time.Hour),
+		instance.NewLoginPolicyAddedEvent(ctx, &instanceAgg.Aggregate, true, true, true, false, false, false, false, true, false, false, domain.PasswordlessTypeAllowed, "", 240*time.Hour, 240*time.Hour, 720*time.Hour, 18*time.Hour, 12*time.Hour, 0, false, 0, 0, false, 0),
 		instance.NewLoginPolicySecondFactorAddedEvent(ctx, &instanceAgg.Aggregate, domain.SecondFactorTypeTOTP),
 		instance.NewLoginPolicySecondFactorAddedEvent(ctx, &instanceAgg.Aggregate, domain.SecondFactorTypeU2F),
 		instance.NewLoginPolicyMultiFactorAddedEvent(ctx, &instanceAgg.Aggregate, domain.MultiFactorTypeU2FWithPIN),
 		instance.NewPrivacyPolicyAddedEvent(ctx, &instanceAgg.Aggregate, "", "", "", "", "", "", ""),
 		instance.NewNotificationPolicyAddedEvent(ctx, &instanceAgg.Aggregate, true),
 		instance.NewLockoutPolicyAddedEvent(ctx, &instanceAgg.Aggregate, 0, 0, true),
-		instance.NewLabelPolicyAddedEvent(ctx, &instanceAgg.Aggregate, "#5469d4", "#fafafa", "#cd3d56", "#000000", "#2073c4", "#111827", "#ff3b5b", "#ffffff", false, false, false, domain.LabelPolicyThemeAuto),
+		instance.NewLabelPolicyAddedEvent(ctx, &instanceAgg.Aggregate, "#5469d4", "#fafafa", "#cd3d56", "#000000", "#2073c4", "#111827", "#ff3b5b", "#ffffff", false, false, false, domain.LabelPolicyThemeAuto, ""),
 		instance.NewLabelPolicyActivatedEvent(ctx, &instanceAgg.Aggregate),
 	}
 }
@@ -219,12 +221,13 @@ func instancePoliciesEvents(ctx context.Context, instanceID string) []eventstore
 func instanceSetupPoliciesConfig() *InstanceSetup {
 	return &InstanceSetup{
 		PasswordComplexityPolicy: struct {
-			MinLength    uint64
-			HasLowercase bool
-			HasUppercase bool
-			HasNumber    bool
-			HasSymbol    bool
-		}{8, true, true, true, true},
+			MinLength     uint64
+			HasLowercase  bool
+			HasUppercase  bool
+			HasNumber     bool
+			HasSymbol     bool
+			CheckBreached bool
+		}{8, true, true, true, true, false},
 		PasswordAgePolicy: struct {
 			ExpireWarnDays uint64
 			MaxAgeDays     uint64
@@ -235,24 +238,30 @@ func instanceSetupPoliciesConfig() *InstanceSetup {
 			SMTPSenderAddressMatchesInstanceDomain bool
 		}{false, false, false},
 		LoginPolicy: struct {
-			AllowUsernamePassword      bool
-			AllowRegister              bool
-			AllowExternalIDP           bool
-			ForceMFA                   bool
-			ForceMFALocalOnly          bool
-			HidePasswordReset          bool
-			IgnoreUnknownUsername      bool
-			AllowDomainDiscovery       bool
-			DisableLoginWithEmail      bool
-			DisableLoginWithPhone      bool
-			PasswordlessType           domain.PasswordlessType
-			DefaultRedirectURI         string
-			PasswordCheckLifetime      time.Duration
-			ExternalLoginCheckLifetime time.Duration
-			MfaInitSkipLifetime        time.Duration
-			SecondFactorCheckLifetime  time.Duration
-			MultiFactorCheckLifetime   time.Duration
-		}{true, true, true, false, false, false, false, true, false, false, domain.PasswordlessTypeAllowed, "", 240 * time.Hour, 240 * time.Hour, 720 * time.Hour, 18 * time.Hour, 12 * time.Hour},
+			AllowUsernamePassword       bool
+			AllowRegister               bool
+			AllowExternalIDP            bool
+			ForceMFA                    bool
+			ForceMFALocalOnly           bool
+			HidePasswordReset           bool
+			IgnoreUnknownUsername       bool
+			AllowDomainDiscovery        bool
+			DisableLoginWithEmail       bool
+			DisableLoginWithPhone       bool
+			PasswordlessType            domain.PasswordlessType
+			DefaultRedirectURI          string
+			PasswordCheckLifetime       time.Duration
+			ExternalLoginCheckLifetime  time.Duration
+			MfaInitSkipLifetime         time.Duration
+			SecondFactorCheckLifetime   time.Duration
+			MultiFactorCheckLifetime    time.Duration
+			TrustedDeviceLifetime       time.Duration
+			SharedDevice                bool
+			SharedDeviceLifetime        time.Duration
+			SharedDeviceIdleTimeout     time.Duration
+			PasskeyPrompt               bool
+			PasskeyPromptSnoozeLifetime time.Duration
+		}{true, true, true, false, false, false, false, true, false, false, domain.PasswordlessTypeAllowed, "", 240 * time.Hour, 240 * time.Hour, 720 * time.Hour, 18 * time.Hour, 12 * time.Hour, 0, false, 0, 0, false, 0},
 		NotificationPolicy: struct {
 			PasswordChange bool
 		}{true},
@@ -278,7 +287,8 @@ func instanceSetupPoliciesConfig() *InstanceSetup {
 			ErrorMsgPopup       bool
 			DisableWatermark    bool
 			ThemeMode           domain.LabelPolicyThemeMode
-		}{"#5469d4", "#fafafa", "#cd3d56", "#000000", "#2073c4", "#111827", "#ff3b5b", "#ffffff", false, false, false, domain.LabelPolicyThemeAuto},
+			CustomCSS           string
+		}{"#5469d4", "#fafafa", "#cd3d56", "#000000", "#2073c4", "#111827", "#ff3b5b", "#ffffff", false, false, false, domain.LabelPolicyThemeAuto, ""},
 		LockoutPolicy: struct {
 			MaxPasswordAttempts      uint64
 			MaxOTPAttempts           uint64
@@ -430,6 +440,8 @@ func generatedDomainFilters(instanceID, orgID, projectID, appID, generatedDomain
 				0,
 				nil,
 				false,
+				"",
+				"",
 			),
 		),
 		expectFilter(
@@ -467,8 +479,10 @@ func humanFilters(orgID string) []expect {
 				false,
 				false,
 				false,
+				false,
 			),
 		),
+		expectFilter(),
 	}
 }
 
@@ -504,6 +518,7 @@ func machineFilters(orgID string, pat bool) []expect {
 		filters = append(filters,
 			expectFilter(),
 			expectFilter(),
+			expectFilter(),
 		)
 	}
 	return filters
@@ -599,6 +614,9 @@ func machineEvents(ctx context.Context, instanceID, orgID, userID, patID string)
 				patID,
 				time.Date(9999, time.December, 31, 23, 59, 59, 0, time.UTC),
 				nil,
+				nil,
+				nil,
+				nil,
 			),
 		)
 	}
@@ -6,22 +6,74 @@ import (
 	"crypto/rsa"
 	"crypto/x509"
 	"math/big"
+	"sort"
 	"time"
 
 	"github.com/zitadel/zitadel/internal/api/authz"
 	"github.com/zitadel/zitadel/internal/crypto"
 	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
 	"github.com/zitadel/zitadel/internal/repository/keypair"
 )
 
 func (c *Commands) GenerateSigningKeyPair(ctx context.Context, algorithm string) error {
-	privateCrypto, publicCrypto, err := crypto.GenerateEncryptedKeyPair(c.keySize, c.keyAlgorithm)
+	cmd, err := c.newSigningKeyPair(ctx, algorithm)
 	if err != nil {
 		return err
 	}
+	_, err = c.eventstore.Push(ctx, cmd)
+	return err
+}
+
+// RotateSigningKeyPair generates a new signing key pair if the newest active key of the instance is older than the interval.
+// The private keys of the replaced key pairs are no longer used for signing,
+// their public keys stay published for the grace period, so tokens signed by them can still be verified.
+// It returns whether the keys have been rotated.
+func (c *Commands) RotateSigningKeyPair(ctx context.Context, algorithm string, interval, gracePeriod time.Duration) (bool, error) {
+	writeModel := newSigningKeysWriteModel(authz.GetInstance(ctx).InstanceID())
+	if err := c.eventstore.FilterToQueryReducer(ctx, writeModel); err != nil {
+		return false, err
+	}
+	now := time.Now().UTC()
+	activeKeys := writeModel.activeKeys(now)
+	if len(activeKeys) == 0 || latestKeyCreation(activeKeys).Add(interval).After(now) {
+		return false, nil
+	}
+	sort.Slice(activeKeys, func(i, j int) bool {
+		return activeKeys[i].aggregate.ID < activeKeys[j].aggregate.ID
+	})
+	added, err := c.newSigningKeyPair(ctx, algorithm)
+	if err != nil {
+		return false, err
+	}
+	cmds := make([]eventstore.Command, 0, len(activeKeys)+1)
+	cmds = append(cmds, added)
+	publicKeyExp := now.Add(gracePeriod)
+	for _, key := range activeKeys {
+		cmds = append(cmds, keypair.NewRotatedEvent(ctx, key.aggregate, added.Aggregate().ID, now, publicKeyExp))
+	}
+	if _, err = c.eventstore.Push(ctx, cmds...); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+func (c *Commands) newSigningKeyPair(ctx context.Context, algorithm string) (*keypair.AddedEvent, error) {
+	privateCrypto, publicKey, err := c.signingKeyProvider.GenerateKeyPair(ctx, c.keySize)
+	if err != nil {
+		return nil, err
+	}
+	publicKeyBytes, err := crypto.PublicKeyToBytes(publicKey)
+	if err != nil {
+		return nil, err
+	}
+	publicCrypto, err := crypto.Encrypt(publicKeyBytes, c.keyAlgorithm)
+	if err != nil {
+		return nil, err
+	}
 	keyID, err := c.idGenerator.Next()
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	privateKeyExp := time.Now().UTC().Add(c.privateKeyLifetime)
@@ -29,14 +81,13 @@ func (c *Commands) GenerateSigningKeyPair(ctx context.Context, algorithm string)
 
 	keyPairWriteModel := NewKeyPairWriteModel(keyID, authz.GetInstance(ctx).InstanceID())
 	keyAgg := KeyPairAggregateFromWriteModel(&keyPairWriteModel.WriteModel)
-	_, err = c.eventstore.Push(ctx, keypair.NewAddedEvent(
+	return keypair.NewAddedEvent(
 		ctx,
 		keyAgg,
 		domain.KeyUsageSigning,
 		algorithm,
 		privateCrypto, publicCrypto,
-		privateKeyExp, publicKeyExp))
-	return err
+		privateKeyExp, publicKeyExp), nil
 }
 
 func (c *Commands) GenerateSAMLCACertificate(ctx context.Context, algorithm string) error {
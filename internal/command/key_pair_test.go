@@ -0,0 +1,130 @@
+package command
+
+import (
+	"context"
+	stdcrypto "crypto"
+	"crypto/rsa"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/crypto"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/repository/keypair"
+)
+
+type testSigningKeyProvider struct {
+	err error
+}
+
+func (p *testSigningKeyProvider) GenerateKeyPair(context.Context, int) (*crypto.CryptoValue, *rsa.PublicKey, error) {
+	return nil, nil, p.err
+}
+
+func (p *testSigningKeyProvider) Signer(context.Context, *crypto.CryptoValue) (stdcrypto.Signer, error) {
+	return nil, p.err
+}
+
+func TestCommandSide_RotateSigningKeyPair(t *testing.T) {
+	keyAgg := &eventstore.Aggregate{
+		ID:            "key1",
+		Type:          keypair.AggregateType,
+		ResourceOwner: "instance1",
+		InstanceID:    "instance1",
+		Version:       keypair.AggregateVersion,
+	}
+	keyAdded := func(privateKeyExpiry time.Time) eventstore.Command {
+		return keypair.NewAddedEvent(context.Background(), keyAgg, domain.KeyUsageSigning, "RS256", nil, nil, privateKeyExpiry, privateKeyExpiry.Add(time.Hour))
+	}
+	type fields struct {
+		eventstore         func(*testing.T) *eventstore.Eventstore
+		signingKeyProvider crypto.SigningKeyProvider
+	}
+	type res struct {
+		rotated bool
+		err     func(error) bool
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		res    res
+	}{
+		{
+			name: "filter error",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilterError(errors.New("filter failed")),
+				),
+			},
+			res: res{
+				err: func(err error) bool { return err != nil },
+			},
+		},
+		{
+			name: "no active key, not rotated",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(keyAdded(time.Now().Add(-time.Hour))),
+					),
+				),
+			},
+		},
+		{
+			name: "key newer than interval, not rotated",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusherWithCreationDateNow(keyAdded(time.Now().Add(time.Hour))),
+					),
+				),
+			},
+		},
+		{
+			name: "already rotated key, not rotated",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(keyAdded(time.Now().Add(time.Hour))),
+						eventFromEventPusher(keypair.NewRotatedEvent(context.Background(), keyAgg, "key2", time.Now().Add(-time.Minute), time.Now().Add(time.Hour))),
+					),
+				),
+			},
+		},
+		{
+			name: "key older than interval, key generation failed",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(keyAdded(time.Now().Add(time.Hour))),
+					),
+				),
+				signingKeyProvider: &testSigningKeyProvider{err: errors.New("unavailable")},
+			},
+			res: res{
+				err: func(err error) bool { return err != nil },
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &Commands{
+				eventstore:         tt.fields.eventstore(t),
+				signingKeyProvider: tt.fields.signingKeyProvider,
+			}
+			ctx := authz.WithInstanceID(context.Background(), "instance1")
+			rotated, err := c.RotateSigningKeyPair(ctx, "RS256", time.Hour, 24*time.Hour)
+			if tt.res.err == nil {
+				assert.NoError(t, err)
+			}
+			if tt.res.err != nil && !tt.res.err(err) {
+				t.Errorf("got wrong err: %v ", err)
+			}
+			assert.Equal(t, tt.res.rotated, rotated)
+		})
+	}
+}
@@ -0,0 +1,89 @@
+package command
+
+import (
+	"time"
+
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/repository/keypair"
+)
+
+type signingKey struct {
+	aggregate        *eventstore.Aggregate
+	creationDate     time.Time
+	privateKeyExpiry time.Time
+	publicKeyExpiry  time.Time
+}
+
+// signingKeysWriteModel contains all signing key pairs of an instance
+type signingKeysWriteModel struct {
+	eventstore.WriteModel
+
+	keys map[string]*signingKey
+}
+
+func newSigningKeysWriteModel(instanceID string) *signingKeysWriteModel {
+	return &signingKeysWriteModel{
+		WriteModel: eventstore.WriteModel{
+			ResourceOwner: instanceID,
+			InstanceID:    instanceID,
+		},
+		keys: make(map[string]*signingKey),
+	}
+}
+
+func (wm *signingKeysWriteModel) Reduce() error {
+	for _, event := range wm.Events {
+		switch e := event.(type) {
+		case *keypair.AddedEvent:
+			if e.Usage != domain.KeyUsageSigning {
+				continue
+			}
+			wm.keys[e.Aggregate().ID] = &signingKey{
+				aggregate:        e.Aggregate(),
+				creationDate:     e.CreationDate(),
+				privateKeyExpiry: e.PrivateKey.Expiry,
+				publicKeyExpiry:  e.PublicKey.Expiry,
+			}
+		case *keypair.RotatedEvent:
+			key, ok := wm.keys[e.Aggregate().ID]
+			if !ok {
+				continue
+			}
+			key.privateKeyExpiry = e.PrivateKeyExpiry
+			key.publicKeyExpiry = e.PublicKeyExpiry
+		}
+	}
+	return wm.WriteModel.Reduce()
+}
+
+func (wm *signingKeysWriteModel) Query() *eventstore.SearchQueryBuilder {
+	return eventstore.NewSearchQueryBuilder(eventstore.ColumnsEvent).
+		ResourceOwner(wm.ResourceOwner).
+		InstanceID(wm.InstanceID).
+		AddQuery().
+		AggregateTypes(keypair.AggregateType).
+		EventTypes(keypair.AddedEventType, keypair.RotatedEventType).
+		Builder()
+}
+
+// activeKeys returns the keys which can still be used for signing at the given time.
+func (wm *signingKeysWriteModel) activeKeys(t time.Time) []*signingKey {
+	keys := make([]*signingKey, 0, len(wm.keys))
+	for _, key := range wm.keys {
+		if key.privateKeyExpiry.After(t) {
+			keys = append(keys, key)
+		}
+	}
+	return keys
+}
+
+// latestKeyCreation returns the creation date of the newest active key.
+func latestKeyCreation(keys []*signingKey) (latest time.Time) {
+	for _, key := range keys {
+		if key.creationDate.After(latest) {
+			latest = key.creationDate
+		}
+	}
+	return latest
+}
@@ -0,0 +1,86 @@
+package command
+
+import (
+	"context"
+
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/repository/logout"
+	"github.com/zitadel/zitadel/internal/telemetry/tracing"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+// StartLogout records the global logout of a terminated session.
+// The passed targets are the relying parties and identity providers the user has to be logged out from,
+// their results are reported using [Commands.ReportLogoutTarget].
+// No logout is started if there are no targets, the returned ID is empty in this case.
+func (c *Commands) StartLogout(ctx context.Context, userID, resourceOwner, sessionID, userAgentID, postLogoutRedirectURI string, targets []*domain.LogoutTarget) (logoutID string, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	if userID == "" {
+		return "", zerrors.ThrowInvalidArgument(nil, "COMMAND-Lg2qa", "Errors.User.UserIDMissing")
+	}
+	if len(targets) == 0 {
+		return "", nil
+	}
+	for _, target := range targets {
+		if target.ID == "" || !target.Type.Valid() {
+			return "", zerrors.ThrowInvalidArgument(nil, "COMMAND-Lg8wn", "Errors.Logout.TargetInvalid")
+		}
+	}
+	logoutID, err = c.idGenerator.Next()
+	if err != nil {
+		return "", err
+	}
+	_, err = c.eventstore.Push(ctx, logout.NewStartedEvent(ctx,
+		&logout.NewAggregate(logoutID, resourceOwner).Aggregate,
+		userID,
+		sessionID,
+		userAgentID,
+		postLogoutRedirectURI,
+		targets,
+	))
+	if err != nil {
+		return "", err
+	}
+	return logoutID, nil
+}
+
+// ReportLogoutTarget reports the result of the logout of a target of the logout.
+// Every target can only be reported once, except for targets delegated to the browser of the user,
+// which can report their final result afterwards.
+func (c *Commands) ReportLogoutTarget(ctx context.Context, logoutID, targetID string, targetType domain.LogoutTargetType, state domain.LogoutTargetState, reason string) (_ *domain.ObjectDetails, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	if logoutID == "" || targetID == "" {
+		return nil, zerrors.ThrowInvalidArgument(nil, "COMMAND-Lr3sd", "Errors.IDMissing")
+	}
+	if !state.IsReported() {
+		return nil, zerrors.ThrowInvalidArgument(nil, "COMMAND-Lr7ha", "Errors.Logout.StateInvalid")
+	}
+	writeModel := NewLogoutWriteModel(logoutID)
+	if err = c.eventstore.FilterToQueryReducer(ctx, writeModel); err != nil {
+		return nil, err
+	}
+	if !writeModel.Exists() {
+		return nil, zerrors.ThrowNotFound(nil, "COMMAND-Lr9vk", "Errors.Logout.NotFound")
+	}
+	target := writeModel.target(targetID, targetType)
+	if target == nil {
+		return nil, zerrors.ThrowNotFound(nil, "COMMAND-Lr2mx", "Errors.Logout.TargetNotFound")
+	}
+	if target.state.IsFinal() || (target.state.IsReported() && !state.IsFinal()) {
+		return nil, zerrors.ThrowPreconditionFailed(nil, "COMMAND-Lr5pe", "Errors.Logout.TargetAlreadyReported")
+	}
+	if err = c.pushAppendAndReduce(ctx, writeModel, logout.NewTargetReportedEvent(ctx,
+		&logout.NewAggregate(writeModel.AggregateID, writeModel.ResourceOwner).Aggregate,
+		targetID,
+		targetType,
+		state,
+		reason,
+	)); err != nil {
+		return nil, err
+	}
+	return writeModelToObjectDetails(&writeModel.WriteModel), nil
+}
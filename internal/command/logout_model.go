@@ -0,0 +1,78 @@
+package command
+
+import (
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/repository/logout"
+)
+
+type logoutTarget struct {
+	domain.LogoutTarget
+	state domain.LogoutTargetState
+}
+
+type LogoutWriteModel struct {
+	eventstore.WriteModel
+
+	UserID      string
+	SessionID   string
+	UserAgentID string
+
+	targets []*logoutTarget
+}
+
+func NewLogoutWriteModel(logoutID string) *LogoutWriteModel {
+	return &LogoutWriteModel{
+		WriteModel: eventstore.WriteModel{
+			AggregateID: logoutID,
+		},
+	}
+}
+
+func (wm *LogoutWriteModel) Reduce() error {
+	for _, event := range wm.Events {
+		switch e := event.(type) {
+		case *logout.StartedEvent:
+			wm.UserID = e.UserID
+			wm.SessionID = e.SessionID
+			wm.UserAgentID = e.UserAgentID
+			wm.targets = make([]*logoutTarget, len(e.Targets))
+			for i, target := range e.Targets {
+				wm.targets[i] = &logoutTarget{
+					LogoutTarget: *target,
+					state:        domain.LogoutTargetStatePending,
+				}
+			}
+		case *logout.TargetReportedEvent:
+			if target := wm.target(e.TargetID, e.TargetType); target != nil {
+				target.state = e.State
+			}
+		}
+	}
+	return wm.WriteModel.Reduce()
+}
+
+func (wm *LogoutWriteModel) Query() *eventstore.SearchQueryBuilder {
+	return eventstore.NewSearchQueryBuilder(eventstore.ColumnsEvent).
+		AddQuery().
+		AggregateTypes(logout.AggregateType).
+		AggregateIDs(wm.AggregateID).
+		EventTypes(
+			logout.StartedType,
+			logout.TargetReportedType,
+		).
+		Builder()
+}
+
+func (wm *LogoutWriteModel) Exists() bool {
+	return wm.UserID != ""
+}
+
+func (wm *LogoutWriteModel) target(id string, targetType domain.LogoutTargetType) *logoutTarget {
+	for _, target := range wm.targets {
+		if target.ID == id && target.Type == targetType {
+			return target
+		}
+	}
+	return nil
+}
@@ -0,0 +1,345 @@
+package command
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/id"
+	id_mock "github.com/zitadel/zitadel/internal/id/mock"
+	"github.com/zitadel/zitadel/internal/repository/logout"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+func TestCommands_StartLogout(t *testing.T) {
+	targets := []*domain.LogoutTarget{
+		{ID: "client1", Type: domain.LogoutTargetTypeOIDCBackChannel, URI: "https://rp1.com/logout"},
+		{ID: "client2", Type: domain.LogoutTargetTypeOIDCFrontChannel, URI: "https://rp2.com/logout"},
+		{ID: "idp1", Type: domain.LogoutTargetTypeSAMLIDP, Subject: "externalUser1"},
+	}
+	type fields struct {
+		eventstore  func(t *testing.T) *eventstore.Eventstore
+		idGenerator id.Generator
+	}
+	type args struct {
+		userID  string
+		targets []*domain.LogoutTarget
+	}
+	type res struct {
+		logoutID string
+		err      error
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		args   args
+		res    res
+	}{
+		{
+			name: "missing user id, error",
+			fields: fields{
+				eventstore: expectEventstore(),
+			},
+			args: args{
+				targets: targets,
+			},
+			res: res{
+				err: zerrors.ThrowInvalidArgument(nil, "COMMAND-Lg2qa", "Errors.User.UserIDMissing"),
+			},
+		},
+		{
+			name: "no targets, no logout",
+			fields: fields{
+				eventstore: expectEventstore(),
+			},
+			args: args{
+				userID: "user1",
+			},
+			res: res{},
+		},
+		{
+			name: "invalid target, error",
+			fields: fields{
+				eventstore: expectEventstore(),
+			},
+			args: args{
+				userID:  "user1",
+				targets: []*domain.LogoutTarget{{ID: "client1"}},
+			},
+			res: res{
+				err: zerrors.ThrowInvalidArgument(nil, "COMMAND-Lg8wn", "Errors.Logout.TargetInvalid"),
+			},
+		},
+		{
+			name: "start logout, ok",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectPush(
+						logout.NewStartedEvent(context.Background(),
+							&logout.NewAggregate("logout1", "org1").Aggregate,
+							"user1",
+							"session1",
+							"agent1",
+							"https://rp1.com/logged-out",
+							targets,
+						),
+					),
+				),
+				idGenerator: id_mock.NewIDGeneratorExpectIDs(t, "logout1"),
+			},
+			args: args{
+				userID:  "user1",
+				targets: targets,
+			},
+			res: res{
+				logoutID: "logout1",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &Commands{
+				eventstore:  tt.fields.eventstore(t),
+				idGenerator: tt.fields.idGenerator,
+			}
+			got, err := c.StartLogout(context.Background(), tt.args.userID, "org1", "session1", "agent1", "https://rp1.com/logged-out", tt.args.targets)
+			require.ErrorIs(t, err, tt.res.err)
+			assert.Equal(t, tt.res.logoutID, got)
+		})
+	}
+}
+
+func TestCommands_ReportLogoutTarget(t *testing.T) {
+	startedEvent := func() eventstore.Event {
+		return eventFromEventPusher(
+			logout.NewStartedEvent(context.Background(),
+				&logout.NewAggregate("logout1", "org1").Aggregate,
+				"user1",
+				"session1",
+				"agent1",
+				"",
+				[]*domain.LogoutTarget{
+					{ID: "client1", Type: domain.LogoutTargetTypeOIDCBackChannel, URI: "https://rp1.com/logout"},
+					{ID: "client2", Type: domain.LogoutTargetTypeOIDCFrontChannel, URI: "https://rp2.com/logout"},
+				},
+			),
+		)
+	}
+	delegatedEvent := func() eventstore.Event {
+		return eventFromEventPusher(
+			logout.NewTargetReportedEvent(context.Background(),
+				&logout.NewAggregate("logout1", "org1").Aggregate,
+				"client2",
+				domain.LogoutTargetTypeOIDCFrontChannel,
+				domain.LogoutTargetStateDelegated,
+				"",
+			),
+		)
+	}
+	type fields struct {
+		eventstore func(t *testing.T) *eventstore.Eventstore
+	}
+	type args struct {
+		logoutID   string
+		targetID   string
+		targetType domain.LogoutTargetType
+		state      domain.LogoutTargetState
+	}
+	type res struct {
+		want *domain.ObjectDetails
+		err  error
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		args   args
+		res    res
+	}{
+		{
+			name: "missing id, error",
+			fields: fields{
+				eventstore: expectEventstore(),
+			},
+			args: args{
+				targetID: "client1",
+				state:    domain.LogoutTargetStateSucceeded,
+			},
+			res: res{
+				err: zerrors.ThrowInvalidArgument(nil, "COMMAND-Lr3sd", "Errors.IDMissing"),
+			},
+		},
+		{
+			name: "pending state, error",
+			fields: fields{
+				eventstore: expectEventstore(),
+			},
+			args: args{
+				logoutID: "logout1",
+				targetID: "client1",
+				state:    domain.LogoutTargetStatePending,
+			},
+			res: res{
+				err: zerrors.ThrowInvalidArgument(nil, "COMMAND-Lr7ha", "Errors.Logout.StateInvalid"),
+			},
+		},
+		{
+			name: "logout not existing, error",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(),
+				),
+			},
+			args: args{
+				logoutID:   "logout1",
+				targetID:   "client1",
+				targetType: domain.LogoutTargetTypeOIDCBackChannel,
+				state:      domain.LogoutTargetStateSucceeded,
+			},
+			res: res{
+				err: zerrors.ThrowNotFound(nil, "COMMAND-Lr9vk", "Errors.Logout.NotFound"),
+			},
+		},
+		{
+			name: "target not existing, error",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						startedEvent(),
+					),
+				),
+			},
+			args: args{
+				logoutID:   "logout1",
+				targetID:   "client1",
+				targetType: domain.LogoutTargetTypeOIDCFrontChannel,
+				state:      domain.LogoutTargetStateDelegated,
+			},
+			res: res{
+				err: zerrors.ThrowNotFound(nil, "COMMAND-Lr2mx", "Errors.Logout.TargetNotFound"),
+			},
+		},
+		{
+			name: "target already reported, error",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						startedEvent(),
+						eventFromEventPusher(
+							logout.NewTargetReportedEvent(context.Background(),
+								&logout.NewAggregate("logout1", "org1").Aggregate,
+								"client1",
+								domain.LogoutTargetTypeOIDCBackChannel,
+								domain.LogoutTargetStateFailed,
+								"timeout",
+							),
+						),
+					),
+				),
+			},
+			args: args{
+				logoutID:   "logout1",
+				targetID:   "client1",
+				targetType: domain.LogoutTargetTypeOIDCBackChannel,
+				state:      domain.LogoutTargetStateSucceeded,
+			},
+			res: res{
+				err: zerrors.ThrowPreconditionFailed(nil, "COMMAND-Lr5pe", "Errors.Logout.TargetAlreadyReported"),
+			},
+		},
+		{
+			name: "delegated target reported as delegated again, error",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						startedEvent(),
+						delegatedEvent(),
+					),
+				),
+			},
+			args: args{
+				logoutID:   "logout1",
+				targetID:   "client2",
+				targetType: domain.LogoutTargetTypeOIDCFrontChannel,
+				state:      domain.LogoutTargetStateDelegated,
+			},
+			res: res{
+				err: zerrors.ThrowPreconditionFailed(nil, "COMMAND-Lr5pe", "Errors.Logout.TargetAlreadyReported"),
+			},
+		},
+		{
+			name: "delegated target reported with result, ok",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						startedEvent(),
+						delegatedEvent(),
+					),
+					expectPush(
+						logout.NewTargetReportedEvent(context.Background(),
+							&logout.NewAggregate("logout1", "org1").Aggregate,
+							"client2",
+							domain.LogoutTargetTypeOIDCFrontChannel,
+							domain.LogoutTargetStateSucceeded,
+							"",
+						),
+					),
+				),
+			},
+			args: args{
+				logoutID:   "logout1",
+				targetID:   "client2",
+				targetType: domain.LogoutTargetTypeOIDCFrontChannel,
+				state:      domain.LogoutTargetStateSucceeded,
+			},
+			res: res{
+				want: &domain.ObjectDetails{
+					ResourceOwner: "org1",
+				},
+			},
+		},
+		{
+			name: "report target, ok",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						startedEvent(),
+					),
+					expectPush(
+						logout.NewTargetReportedEvent(context.Background(),
+							&logout.NewAggregate("logout1", "org1").Aggregate,
+							"client1",
+							domain.LogoutTargetTypeOIDCBackChannel,
+							domain.LogoutTargetStateSucceeded,
+							"",
+						),
+					),
+				),
+			},
+			args: args{
+				logoutID:   "logout1",
+				targetID:   "client1",
+				targetType: domain.LogoutTargetTypeOIDCBackChannel,
+				state:      domain.LogoutTargetStateSucceeded,
+			},
+			res: res{
+				want: &domain.ObjectDetails{
+					ResourceOwner: "org1",
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &Commands{
+				eventstore: tt.fields.eventstore(t),
+			}
+			got, err := c.ReportLogoutTarget(context.Background(), tt.args.logoutID, tt.args.targetID, tt.args.targetType, tt.args.state, "")
+			require.ErrorIs(t, err, tt.res.err)
+			assert.Equal(t, tt.res.want, got)
+		})
+	}
+}
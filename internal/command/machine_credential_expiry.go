@@ -0,0 +1,47 @@
+package command
+
+import (
+	"context"
+	"time"
+
+	"github.com/zitadel/logging"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/repository/user"
+	"github.com/zitadel/zitadel/internal/telemetry/tracing"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+// FlagExpiringMachineCredentials flags the keys and personal access tokens of the machine users of all organizations of the instance,
+// which expire within the notification duration of the machine credential policy of their organization.
+// Every credential is flagged once and the org owners are notified about it.
+// A failing credential doesn't prevent the others.
+func (c *Commands) FlagExpiringMachineCredentials(ctx context.Context) (err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	writeModel := newMachineCredentialExpiriesWriteModel(authz.GetInstance(ctx).InstanceID())
+	if err = c.eventstore.FilterToQueryReducer(ctx, writeModel); err != nil {
+		return err
+	}
+	now := time.Now()
+	for _, credential := range writeModel.credentials {
+		notifyBefore := writeModel.notifyBefore[credential.aggregate.ResourceOwner]
+		if notifyBefore <= 0 || credential.flagged || credential.rotated ||
+			!credential.expirationDate.After(now) || credential.expirationDate.Add(-notifyBefore).After(now) {
+			continue
+		}
+		_, err := c.eventstore.Push(ctx, user.NewMachineCredentialExpiringEvent(ctx, credential.aggregate, credential.credentialType, credential.credentialID, credential.expirationDate))
+		logging.WithFields("user", credential.aggregate.ID, "credential", credential.credentialID).OnError(err).Warn("flagging expiring machine credential failed")
+	}
+	return nil
+}
+
+// MachineCredentialExpiringNotificationSent marks the org owners as notified about the expiring credential of the machine user.
+func (c *Commands) MachineCredentialExpiringNotificationSent(ctx context.Context, orgID, userID, credentialID string) error {
+	if orgID == "" || userID == "" || credentialID == "" {
+		return zerrors.ThrowInvalidArgument(nil, "COMMAND-Mc2ns", "Errors.IDMissing")
+	}
+	_, err := c.eventstore.Push(ctx, user.NewMachineCredentialExpiringNotificationSentEvent(ctx, &user.NewAggregate(userID, orgID).Aggregate, credentialID))
+	return err
+}
@@ -0,0 +1,126 @@
+package command
+
+import (
+	"time"
+
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/repository/org"
+	"github.com/zitadel/zitadel/internal/repository/user"
+)
+
+type machineCredentialExpiry struct {
+	aggregate      *eventstore.Aggregate
+	credentialType domain.MachineCredentialType
+	credentialID   string
+	expirationDate time.Time
+	// rotated keys are replaced by a new key and don't need a notification
+	rotated bool
+	flagged bool
+}
+
+// machineCredentialExpiriesWriteModel contains the machine credential policies of all organizations of an instance
+// and the expiration of the keys and personal access tokens of their machine users
+type machineCredentialExpiriesWriteModel struct {
+	eventstore.WriteModel
+
+	notifyBefore map[string]time.Duration
+	credentials  []*machineCredentialExpiry
+}
+
+func newMachineCredentialExpiriesWriteModel(instanceID string) *machineCredentialExpiriesWriteModel {
+	return &machineCredentialExpiriesWriteModel{
+		WriteModel: eventstore.WriteModel{
+			InstanceID: instanceID,
+		},
+		notifyBefore: make(map[string]time.Duration),
+	}
+}
+
+func (wm *machineCredentialExpiriesWriteModel) Reduce() error {
+	for _, event := range wm.Events {
+		switch e := event.(type) {
+		case *org.MachineCredentialPolicySetEvent:
+			wm.notifyBefore[e.Aggregate().ID] = e.NotifyBefore
+		case *org.MachineCredentialPolicyRemovedEvent, *org.OrgRemovedEvent:
+			delete(wm.notifyBefore, e.Aggregate().ID)
+		case *user.MachineKeyAddedEvent:
+			wm.add(e.Aggregate(), domain.MachineCredentialTypeKey, e.KeyID, e.ExpirationDate)
+		case *user.PersonalAccessTokenAddedEvent:
+			wm.add(e.Aggregate(), domain.MachineCredentialTypePersonalAccessToken, e.TokenID, e.Expiration)
+		case *user.MachineKeyRevocationScheduledEvent:
+			if credential := wm.find(e.Aggregate().ID, e.KeyID); credential != nil {
+				credential.rotated = true
+			}
+		case *user.MachineCredentialExpiringEvent:
+			if credential := wm.find(e.Aggregate().ID, e.CredentialID); credential != nil {
+				credential.flagged = true
+			}
+		case *user.MachineKeyRemovedEvent:
+			wm.remove(func(c *machineCredentialExpiry) bool {
+				return c.aggregate.ID == e.Aggregate().ID && c.credentialID == e.KeyID
+			})
+		case *user.PersonalAccessTokenRemovedEvent:
+			wm.remove(func(c *machineCredentialExpiry) bool {
+				return c.aggregate.ID == e.Aggregate().ID && c.credentialID == e.TokenID
+			})
+		case *user.UserRemovedEvent:
+			wm.remove(func(c *machineCredentialExpiry) bool {
+				return c.aggregate.ID == e.Aggregate().ID
+			})
+		}
+	}
+	return wm.WriteModel.Reduce()
+}
+
+func (wm *machineCredentialExpiriesWriteModel) Query() *eventstore.SearchQueryBuilder {
+	return eventstore.NewSearchQueryBuilder(eventstore.ColumnsEvent).
+		InstanceID(wm.InstanceID).
+		AddQuery().
+		AggregateTypes(org.AggregateType).
+		EventTypes(
+			org.MachineCredentialPolicySetEventType,
+			org.MachineCredentialPolicyRemovedEventType,
+			org.OrgRemovedEventType,
+		).
+		Or().
+		AggregateTypes(user.AggregateType).
+		EventTypes(
+			user.MachineKeyAddedEventType,
+			user.MachineKeyRemovedEventType,
+			user.MachineKeyRevocationScheduledEventType,
+			user.PersonalAccessTokenAddedType,
+			user.PersonalAccessTokenRemovedType,
+			user.MachineCredentialExpiringEventType,
+			user.UserRemovedType,
+		).
+		Builder()
+}
+
+func (wm *machineCredentialExpiriesWriteModel) add(aggregate *eventstore.Aggregate, credentialType domain.MachineCredentialType, credentialID string, expirationDate time.Time) {
+	wm.credentials = append(wm.credentials, &machineCredentialExpiry{
+		aggregate:      aggregate,
+		credentialType: credentialType,
+		credentialID:   credentialID,
+		expirationDate: expirationDate,
+	})
+}
+
+func (wm *machineCredentialExpiriesWriteModel) find(userID, credentialID string) *machineCredentialExpiry {
+	for _, credential := range wm.credentials {
+		if credential.aggregate.ID == userID && credential.credentialID == credentialID {
+			return credential
+		}
+	}
+	return nil
+}
+
+func (wm *machineCredentialExpiriesWriteModel) remove(matches func(c *machineCredentialExpiry) bool) {
+	credentials := wm.credentials[:0]
+	for _, credential := range wm.credentials {
+		if !matches(credential) {
+			credentials = append(credentials, credential)
+		}
+	}
+	wm.credentials = credentials
+}
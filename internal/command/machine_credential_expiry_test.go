@@ -0,0 +1,211 @@
+package command
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/eventstore/repository"
+	"github.com/zitadel/zitadel/internal/repository/org"
+	"github.com/zitadel/zitadel/internal/repository/user"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+func TestCommandSide_FlagExpiringMachineCredentials(t *testing.T) {
+	type fields struct {
+		eventstore func(t *testing.T) *eventstore.Eventstore
+	}
+	type res struct {
+		err func(error) bool
+	}
+	policySet := func(notifyBefore time.Duration) *repository.Event {
+		return eventFromEventPusher(
+			org.NewMachineCredentialPolicySetEvent(context.Background(),
+				&org.NewAggregate("org1").Aggregate,
+				0,
+				notifyBefore,
+			),
+		)
+	}
+	keyAdded := func(expirationDate time.Time) *repository.Event {
+		return eventFromEventPusher(
+			user.NewMachineKeyAddedEvent(context.Background(),
+				&user.NewAggregate("user1", "org1").Aggregate,
+				"key1",
+				domain.AuthNKeyTypeJSON,
+				expirationDate,
+				[]byte("publicKey"),
+			),
+		)
+	}
+	inTenMinutes := time.Now().Add(10 * time.Minute)
+	tests := []struct {
+		name   string
+		fields fields
+		res    res
+	}{
+		{
+			name: "filter error, error",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilterError(zerrors.ThrowInternal(nil, "id", "filter failed")),
+				),
+			},
+			res: res{
+				err: zerrors.IsInternal,
+			},
+		},
+		{
+			name: "org without policy, nothing done",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						keyAdded(inTenMinutes),
+					),
+				),
+			},
+		},
+		{
+			name: "key expires after notification period, nothing done",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						policySet(time.Minute),
+						keyAdded(inTenMinutes),
+					),
+				),
+			},
+		},
+		{
+			name: "key expires within notification period, flagged",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						policySet(time.Hour),
+						keyAdded(inTenMinutes),
+					),
+					expectPush(
+						user.NewMachineCredentialExpiringEvent(context.Background(),
+							&user.NewAggregate("user1", "org1").Aggregate,
+							domain.MachineCredentialTypeKey,
+							"key1",
+							inTenMinutes,
+						),
+					),
+				),
+			},
+		},
+		{
+			name: "token expires within notification period, flagged",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						policySet(time.Hour),
+						eventFromEventPusher(
+							user.NewPersonalAccessTokenAddedEvent(context.Background(),
+								&user.NewAggregate("user1", "org1").Aggregate,
+								"token1",
+								inTenMinutes,
+								nil,
+								nil,
+								nil,
+								nil,
+							),
+						),
+					),
+					expectPush(
+						user.NewMachineCredentialExpiringEvent(context.Background(),
+							&user.NewAggregate("user1", "org1").Aggregate,
+							domain.MachineCredentialTypePersonalAccessToken,
+							"token1",
+							inTenMinutes,
+						),
+					),
+				),
+			},
+		},
+		{
+			name: "key already flagged, nothing done",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						policySet(time.Hour),
+						keyAdded(inTenMinutes),
+						eventFromEventPusher(
+							user.NewMachineCredentialExpiringEvent(context.Background(),
+								&user.NewAggregate("user1", "org1").Aggregate,
+								domain.MachineCredentialTypeKey,
+								"key1",
+								inTenMinutes,
+							),
+						),
+					),
+				),
+			},
+		},
+		{
+			name: "rotated key, nothing done",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						policySet(time.Hour),
+						keyAdded(inTenMinutes),
+						eventFromEventPusher(
+							user.NewMachineKeyRevocationScheduledEvent(context.Background(),
+								&user.NewAggregate("user1", "org1").Aggregate,
+								"key1",
+								time.Now().Add(5*time.Minute),
+							),
+						),
+					),
+				),
+			},
+		},
+		{
+			name: "removed key, nothing done",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						policySet(time.Hour),
+						keyAdded(inTenMinutes),
+						eventFromEventPusher(
+							user.NewMachineKeyRemovedEvent(context.Background(),
+								&user.NewAggregate("user1", "org1").Aggregate,
+								"key1",
+							),
+						),
+					),
+				),
+			},
+		},
+		{
+			name: "expired key, nothing done",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						policySet(time.Hour),
+						keyAdded(time.Now().Add(-time.Minute)),
+					),
+				),
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := &Commands{
+				eventstore: tt.fields.eventstore(t),
+			}
+			err := r.FlagExpiringMachineCredentials(context.Background())
+			if tt.res.err == nil {
+				assert.NoError(t, err)
+			}
+			if tt.res.err != nil && !tt.res.err(err) {
+				t.Errorf("got wrong err: %v ", err)
+			}
+		})
+	}
+}
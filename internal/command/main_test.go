@@ -208,6 +208,10 @@ func (m *mockInstance) DefaultOrganisationID() string {
 	return "defaultOrgID"
 }
 
+func (m *mockInstance) BrandingOrganisationID() string {
+	return ""
+}
+
 func (m *mockInstance) RequestedDomain() string {
 	return "zitadel.cloud"
 }
@@ -224,6 +228,10 @@ func (m *mockInstance) EnableImpersonation() bool {
 	return false
 }
 
+func (m *mockInstance) ActionsHTTPAllowList() []string {
+	return nil
+}
+
 func (m *mockInstance) Features() feature.Features {
 	return feature.Features{}
 }
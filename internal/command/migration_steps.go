@@ -0,0 +1,16 @@
+package command
+
+import (
+	"context"
+
+	"github.com/zitadel/zitadel/internal/migration"
+	"github.com/zitadel/zitadel/internal/telemetry/tracing"
+)
+
+// RetryMigrationStep marks a stuck setup step as failed, so the next setup run resumes it
+func (c *Commands) RetryMigrationStep(ctx context.Context, name string) (err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	return migration.RetryStep(ctx, c.eventstore, name)
+}
@@ -0,0 +1,234 @@
+package command
+
+import (
+	"context"
+	"net"
+	"slices"
+
+	"github.com/zitadel/logging"
+
+	http_util "github.com/zitadel/zitadel/internal/api/http"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/geoip"
+	"github.com/zitadel/zitadel/internal/repository/org"
+	"github.com/zitadel/zitadel/internal/repository/project"
+	"github.com/zitadel/zitadel/internal/repository/user"
+	"github.com/zitadel/zitadel/internal/telemetry/tracing"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+// SetOrgNetworkAccessPolicy restricts the client networks from which the users of the organization
+// can log in and receive tokens.
+func (c *Commands) SetOrgNetworkAccessPolicy(ctx context.Context, orgID string, rules *domain.NetworkAccessRules) (_ *domain.ObjectDetails, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	if orgID == "" {
+		return nil, zerrors.ThrowInvalidArgument(nil, "ORG-Nap1i", "Errors.ResourceOwnerMissing")
+	}
+	if !rules.IsValid() {
+		return nil, zerrors.ThrowInvalidArgument(nil, "ORG-Nap2i", "Errors.NetworkAccessPolicy.Invalid")
+	}
+	if rules.UsesLocation() && c.geoIP == nil {
+		return nil, zerrors.ThrowPreconditionFailed(nil, "ORG-Nap6g", "Errors.NetworkAccessPolicy.GeoIPNotConfigured")
+	}
+	if err = c.checkOrgExists(ctx, orgID); err != nil {
+		return nil, err
+	}
+	writeModel, err := c.orgNetworkAccessPolicyWriteModel(ctx, orgID)
+	if err != nil {
+		return nil, err
+	}
+	if writeModel.State.Exists() && networkAccessRulesEqual(&writeModel.Rules, rules) {
+		return nil, zerrors.ThrowPreconditionFailed(nil, "ORG-Nap3c", "Errors.NetworkAccessPolicy.NotChanged")
+	}
+	if err = c.pushAppendAndReduce(ctx, writeModel,
+		org.NewNetworkAccessPolicySetEvent(ctx, &org.NewAggregate(orgID).Aggregate, *rules),
+	); err != nil {
+		return nil, err
+	}
+	return writeModelToObjectDetails(&writeModel.WriteModel), nil
+}
+
+// RemoveOrgNetworkAccessPolicy removes the network restrictions of the organization.
+func (c *Commands) RemoveOrgNetworkAccessPolicy(ctx context.Context, orgID string) (_ *domain.ObjectDetails, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	if orgID == "" {
+		return nil, zerrors.ThrowInvalidArgument(nil, "ORG-Nap4i", "Errors.ResourceOwnerMissing")
+	}
+	writeModel, err := c.orgNetworkAccessPolicyWriteModel(ctx, orgID)
+	if err != nil {
+		return nil, err
+	}
+	if !writeModel.State.Exists() {
+		return nil, zerrors.ThrowNotFound(nil, "ORG-Nap5n", "Errors.NetworkAccessPolicy.NotFound")
+	}
+	if err = c.pushAppendAndReduce(ctx, writeModel,
+		org.NewNetworkAccessPolicyRemovedEvent(ctx, &org.NewAggregate(orgID).Aggregate),
+	); err != nil {
+		return nil, err
+	}
+	return writeModelToObjectDetails(&writeModel.WriteModel), nil
+}
+
+// SetAppNetworkAccessPolicy restricts the client networks which can log in to the OIDC application
+// and receive tokens for it.
+// The policy applies in addition to the policy of the organization of the user.
+func (c *Commands) SetAppNetworkAccessPolicy(ctx context.Context, projectID, appID, resourceOwner string, rules *domain.NetworkAccessRules) (_ *domain.ObjectDetails, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	if projectID == "" || appID == "" {
+		return nil, zerrors.ThrowInvalidArgument(nil, "COMMAND-Nap1m", "Errors.IDMissing")
+	}
+	if !rules.IsValid() {
+		return nil, zerrors.ThrowInvalidArgument(nil, "COMMAND-Nap2i", "Errors.NetworkAccessPolicy.Invalid")
+	}
+	if rules.UsesLocation() && c.geoIP == nil {
+		return nil, zerrors.ThrowPreconditionFailed(nil, "COMMAND-Nap9g", "Errors.NetworkAccessPolicy.GeoIPNotConfigured")
+	}
+	app, err := c.getOIDCAppWriteModel(ctx, projectID, appID, resourceOwner)
+	if err != nil {
+		return nil, err
+	}
+	if !app.State.Exists() {
+		return nil, zerrors.ThrowNotFound(nil, "COMMAND-Nap3n", "Errors.Project.App.NotExisting")
+	}
+	if !app.IsOIDC() {
+		return nil, zerrors.ThrowInvalidArgument(nil, "COMMAND-Nap4o", "Errors.Project.App.IsNotOIDC")
+	}
+	writeModel, err := c.appNetworkAccessPolicyWriteModel(ctx, projectID, appID, app.ResourceOwner)
+	if err != nil {
+		return nil, err
+	}
+	if writeModel.State.Exists() && networkAccessRulesEqual(&writeModel.Rules, rules) {
+		return nil, zerrors.ThrowPreconditionFailed(nil, "COMMAND-Nap5c", "Errors.NetworkAccessPolicy.NotChanged")
+	}
+	if err = c.pushAppendAndReduce(ctx, writeModel,
+		project.NewNetworkAccessPolicySetEvent(ctx, ProjectAggregateFromWriteModel(&writeModel.WriteModel), appID, app.ClientID, *rules),
+	); err != nil {
+		return nil, err
+	}
+	return writeModelToObjectDetails(&writeModel.WriteModel), nil
+}
+
+// RemoveAppNetworkAccessPolicy removes the network restrictions of the application.
+func (c *Commands) RemoveAppNetworkAccessPolicy(ctx context.Context, projectID, appID, resourceOwner string) (_ *domain.ObjectDetails, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	if projectID == "" || appID == "" {
+		return nil, zerrors.ThrowInvalidArgument(nil, "COMMAND-Nap6m", "Errors.IDMissing")
+	}
+	writeModel, err := c.appNetworkAccessPolicyWriteModel(ctx, projectID, appID, resourceOwner)
+	if err != nil {
+		return nil, err
+	}
+	if !writeModel.State.Exists() {
+		return nil, zerrors.ThrowNotFound(nil, "COMMAND-Nap7n", "Errors.NetworkAccessPolicy.NotFound")
+	}
+	if err = c.pushAppendAndReduce(ctx, writeModel,
+		project.NewNetworkAccessPolicyRemovedEvent(ctx, ProjectAggregateFromWriteModel(&writeModel.WriteModel), appID, writeModel.ClientID),
+	); err != nil {
+		return nil, err
+	}
+	return writeModelToObjectDetails(&writeModel.WriteModel), nil
+}
+
+// CheckNetworkAccess evaluates the network access policies of the organization of the user
+// and of the application (identified by its client id) against the client IP and its location.
+// A blocked attempt is recorded on the user and returns a permission denied error,
+// unless the blocking policy is in monitor only mode.
+// A missing or invalid client IP (nil) is blocked by policies with allowed networks.
+func (c *Commands) CheckNetworkAccess(ctx context.Context, phase domain.NetworkAccessPhase, userID, userResourceOwner, clientID string, remoteIP net.IP) (err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	if userID == "" || userResourceOwner == "" {
+		return nil
+	}
+	policies := newNetworkAccessPoliciesReadModel(userResourceOwner, clientID)
+	if err = c.eventstore.FilterToQueryReducer(ctx, policies); err != nil {
+		return err
+	}
+	_, err = c.CheckNetworkAccessPolicies(ctx, phase, userID, userResourceOwner, clientID, remoteIP, &policies.policies, true)
+	return err
+}
+
+// CheckNetworkAccessPolicies evaluates the passed network access policies like [Commands.CheckNetworkAccess].
+// Attempts blocked by a monitor only policy are only recorded if recordMonitored is set,
+// monitored reports if the attempt was blocked by such a policy.
+func (c *Commands) CheckNetworkAccessPolicies(ctx context.Context, phase domain.NetworkAccessPhase, userID, userResourceOwner, clientID string, remoteIP net.IP, policies *domain.NetworkAccessPolicies, recordMonitored bool) (monitored bool, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	if userID == "" || userResourceOwner == "" || policies == nil {
+		return false, nil
+	}
+	var location *geoip.Location
+	if c.geoIP != nil && remoteIP != nil && policies.UsesLocation() {
+		location, err = c.geoIP.Lookup(ctx, remoteIP)
+		if err != nil {
+			// the location stays unknown, so only policies without allowed countries and ASNs let the client pass
+			logging.WithError(err).Warn("geoip lookup of client failed")
+			location = nil
+		}
+	}
+	level, monitorOnly := policies.BlockedBy(remoteIP, location)
+	if level == domain.NetworkAccessPolicyLevelUnspecified {
+		return false, nil
+	}
+	if monitorOnly && !recordMonitored {
+		return true, nil
+	}
+	var address string
+	if remoteIP != nil {
+		address = remoteIP.String()
+	}
+	_, err = c.eventstore.Push(ctx, user.NewUserNetworkAccessBlockedEvent(ctx,
+		&user.NewAggregate(userID, userResourceOwner).Aggregate,
+		phase,
+		level,
+		address,
+		clientID,
+		location,
+		monitorOnly,
+	))
+	if err != nil || monitorOnly {
+		return monitorOnly, err
+	}
+	return false, zerrors.ThrowPermissionDenied(nil, "COMMAND-Nap8b", "Errors.NetworkAccessPolicy.Blocked")
+}
+
+// checkNetworkAccessFromCtx evaluates the network access policies with the client IP of the request
+func (c *Commands) checkNetworkAccessFromCtx(ctx context.Context, phase domain.NetworkAccessPhase, userID, userResourceOwner, clientID string) error {
+	return c.CheckNetworkAccess(ctx, phase, userID, userResourceOwner, clientID, net.ParseIP(http_util.RemoteIPFromCtx(ctx)))
+}
+
+func (c *Commands) orgNetworkAccessPolicyWriteModel(ctx context.Context, orgID string) (*OrgNetworkAccessPolicyWriteModel, error) {
+	writeModel := NewOrgNetworkAccessPolicyWriteModel(orgID)
+	if err := c.eventstore.FilterToQueryReducer(ctx, writeModel); err != nil {
+		return nil, err
+	}
+	return writeModel, nil
+}
+
+func (c *Commands) appNetworkAccessPolicyWriteModel(ctx context.Context, projectID, appID, resourceOwner string) (*AppNetworkAccessPolicyWriteModel, error) {
+	writeModel := NewAppNetworkAccessPolicyWriteModel(projectID, appID, resourceOwner)
+	if err := c.eventstore.FilterToQueryReducer(ctx, writeModel); err != nil {
+		return nil, err
+	}
+	return writeModel, nil
+}
+
+func networkAccessRulesEqual(a, b *domain.NetworkAccessRules) bool {
+	return slices.Equal(a.AllowedCIDRs, b.AllowedCIDRs) &&
+		slices.Equal(a.DeniedCIDRs, b.DeniedCIDRs) &&
+		slices.Equal(a.AllowedCountries, b.AllowedCountries) &&
+		slices.Equal(a.DeniedCountries, b.DeniedCountries) &&
+		slices.Equal(a.AllowedASNs, b.AllowedASNs) &&
+		slices.Equal(a.DeniedASNs, b.DeniedASNs) &&
+		a.MonitorOnly == b.MonitorOnly
+}
@@ -0,0 +1,187 @@
+package command
+
+import (
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/repository/org"
+	"github.com/zitadel/zitadel/internal/repository/project"
+)
+
+type OrgNetworkAccessPolicyWriteModel struct {
+	eventstore.WriteModel
+
+	State domain.PolicyState
+	Rules domain.NetworkAccessRules
+}
+
+func NewOrgNetworkAccessPolicyWriteModel(orgID string) *OrgNetworkAccessPolicyWriteModel {
+	return &OrgNetworkAccessPolicyWriteModel{
+		WriteModel: eventstore.WriteModel{
+			AggregateID:   orgID,
+			ResourceOwner: orgID,
+		},
+	}
+}
+
+func (wm *OrgNetworkAccessPolicyWriteModel) Reduce() error {
+	for _, event := range wm.Events {
+		switch e := event.(type) {
+		case *org.NetworkAccessPolicySetEvent:
+			wm.State = domain.PolicyStateActive
+			wm.Rules = e.NetworkAccessRules
+		case *org.NetworkAccessPolicyRemovedEvent, *org.OrgRemovedEvent:
+			wm.State = domain.PolicyStateRemoved
+			wm.Rules = domain.NetworkAccessRules{}
+		}
+	}
+	return wm.WriteModel.Reduce()
+}
+
+func (wm *OrgNetworkAccessPolicyWriteModel) Query() *eventstore.SearchQueryBuilder {
+	return eventstore.NewSearchQueryBuilder(eventstore.ColumnsEvent).
+		ResourceOwner(wm.ResourceOwner).
+		AddQuery().
+		AggregateTypes(org.AggregateType).
+		AggregateIDs(wm.AggregateID).
+		EventTypes(
+			org.NetworkAccessPolicySetEventType,
+			org.NetworkAccessPolicyRemovedEventType,
+			org.OrgRemovedEventType,
+		).
+		Builder()
+}
+
+type AppNetworkAccessPolicyWriteModel struct {
+	eventstore.WriteModel
+
+	AppID    string
+	ClientID string
+	State    domain.PolicyState
+	Rules    domain.NetworkAccessRules
+}
+
+func NewAppNetworkAccessPolicyWriteModel(projectID, appID, resourceOwner string) *AppNetworkAccessPolicyWriteModel {
+	return &AppNetworkAccessPolicyWriteModel{
+		WriteModel: eventstore.WriteModel{
+			AggregateID:   projectID,
+			ResourceOwner: resourceOwner,
+		},
+		AppID: appID,
+	}
+}
+
+func (wm *AppNetworkAccessPolicyWriteModel) AppendEvents(events ...eventstore.Event) {
+	for _, event := range events {
+		switch e := event.(type) {
+		case *project.NetworkAccessPolicySetEvent:
+			if e.AppID != wm.AppID {
+				continue
+			}
+		case *project.NetworkAccessPolicyRemovedEvent:
+			if e.AppID != wm.AppID {
+				continue
+			}
+		case *project.ApplicationRemovedEvent:
+			if e.AppID != wm.AppID {
+				continue
+			}
+		}
+		wm.WriteModel.AppendEvents(event)
+	}
+}
+
+func (wm *AppNetworkAccessPolicyWriteModel) Reduce() error {
+	for _, event := range wm.Events {
+		switch e := event.(type) {
+		case *project.NetworkAccessPolicySetEvent:
+			wm.State = domain.PolicyStateActive
+			wm.ClientID = e.ClientID
+			wm.Rules = e.NetworkAccessRules
+		case *project.NetworkAccessPolicyRemovedEvent, *project.ApplicationRemovedEvent, *project.ProjectRemovedEvent:
+			wm.State = domain.PolicyStateRemoved
+			wm.Rules = domain.NetworkAccessRules{}
+		}
+	}
+	return wm.WriteModel.Reduce()
+}
+
+func (wm *AppNetworkAccessPolicyWriteModel) Query() *eventstore.SearchQueryBuilder {
+	return eventstore.NewSearchQueryBuilder(eventstore.ColumnsEvent).
+		ResourceOwner(wm.ResourceOwner).
+		AddQuery().
+		AggregateTypes(project.AggregateType).
+		AggregateIDs(wm.AggregateID).
+		EventTypes(
+			project.NetworkAccessPolicySetType,
+			project.NetworkAccessPolicyRemovedType,
+			project.ApplicationRemovedType,
+			project.ProjectRemovedType,
+		).
+		Builder()
+}
+
+// networkAccessPoliciesReadModel contains the network access policies which apply to a user
+// of the organization authenticating for the client
+type networkAccessPoliciesReadModel struct {
+	eventstore.WriteModel
+
+	clientID string
+	policies domain.NetworkAccessPolicies
+}
+
+func newNetworkAccessPoliciesReadModel(orgID, clientID string) *networkAccessPoliciesReadModel {
+	return &networkAccessPoliciesReadModel{
+		WriteModel: eventstore.WriteModel{
+			AggregateID: orgID,
+		},
+		clientID: clientID,
+	}
+}
+
+func (rm *networkAccessPoliciesReadModel) Reduce() error {
+	for _, event := range rm.Events {
+		switch e := event.(type) {
+		case *org.NetworkAccessPolicySetEvent:
+			rules := e.NetworkAccessRules
+			rm.policies.Org = &rules
+		case *org.NetworkAccessPolicyRemovedEvent, *org.OrgRemovedEvent:
+			rm.policies.Org = nil
+		case *project.NetworkAccessPolicySetEvent:
+			if e.ClientID != rm.clientID {
+				continue
+			}
+			rules := e.NetworkAccessRules
+			rm.policies.App = &rules
+		case *project.NetworkAccessPolicyRemovedEvent:
+			if e.ClientID != rm.clientID {
+				continue
+			}
+			rm.policies.App = nil
+		}
+	}
+	return rm.WriteModel.Reduce()
+}
+
+func (rm *networkAccessPoliciesReadModel) Query() *eventstore.SearchQueryBuilder {
+	query := eventstore.NewSearchQueryBuilder(eventstore.ColumnsEvent).
+		AddQuery().
+		AggregateTypes(org.AggregateType).
+		AggregateIDs(rm.AggregateID).
+		EventTypes(
+			org.NetworkAccessPolicySetEventType,
+			org.NetworkAccessPolicyRemovedEventType,
+			org.OrgRemovedEventType,
+		).
+		Builder()
+	if rm.clientID == "" {
+		return query
+	}
+	return query.
+		AddQuery().
+		AggregateTypes(project.AggregateType).
+		EventTypes(
+			project.NetworkAccessPolicySetType,
+			project.NetworkAccessPolicyRemovedType,
+		).
+		Builder()
+}
@@ -0,0 +1,944 @@
+package command
+
+import (
+	"context"
+	"net"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/geoip"
+	"github.com/zitadel/zitadel/internal/repository/org"
+	"github.com/zitadel/zitadel/internal/repository/project"
+	"github.com/zitadel/zitadel/internal/repository/user"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+func TestCommandSide_SetOrgNetworkAccessPolicy(t *testing.T) {
+	type fields struct {
+		eventstore func(t *testing.T) *eventstore.Eventstore
+	}
+	type args struct {
+		ctx   context.Context
+		orgID string
+		rules *domain.NetworkAccessRules
+	}
+	type res struct {
+		want *domain.ObjectDetails
+		err  error
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		args   args
+		res    res
+	}{
+		{
+			name: "no rules, invalid argument error",
+			fields: fields{
+				eventstore: expectEventstore(),
+			},
+			args: args{
+				ctx:   context.Background(),
+				orgID: "org1",
+				rules: &domain.NetworkAccessRules{},
+			},
+			res: res{
+				err: zerrors.ThrowInvalidArgument(nil, "ORG-Nap2i", "Errors.NetworkAccessPolicy.Invalid"),
+			},
+		},
+		{
+			name: "invalid network, invalid argument error",
+			fields: fields{
+				eventstore: expectEventstore(),
+			},
+			args: args{
+				ctx:   context.Background(),
+				orgID: "org1",
+				rules: &domain.NetworkAccessRules{AllowedCIDRs: []string{"intranet"}},
+			},
+			res: res{
+				err: zerrors.ThrowInvalidArgument(nil, "ORG-Nap2i", "Errors.NetworkAccessPolicy.Invalid"),
+			},
+		},
+		{
+			name: "countries without geoip provider, precondition error",
+			fields: fields{
+				eventstore: expectEventstore(),
+			},
+			args: args{
+				ctx:   context.Background(),
+				orgID: "org1",
+				rules: &domain.NetworkAccessRules{AllowedCountries: []string{"CH"}},
+			},
+			res: res{
+				err: zerrors.ThrowPreconditionFailed(nil, "ORG-Nap6g", "Errors.NetworkAccessPolicy.GeoIPNotConfigured"),
+			},
+		},
+		{
+			name: "org not found, precondition error",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(),
+				),
+			},
+			args: args{
+				ctx:   context.Background(),
+				orgID: "org1",
+				rules: &domain.NetworkAccessRules{AllowedCIDRs: []string{"10.0.0.0/8"}},
+			},
+			res: res{
+				err: zerrors.ThrowPreconditionFailed(nil, "COMMAND-QXPGs", "Errors.Org.NotFound"),
+			},
+		},
+		{
+			name: "policy not changed, precondition error",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(
+							org.NewOrgAddedEvent(context.Background(), &org.NewAggregate("org1").Aggregate, "org"),
+						),
+					),
+					expectFilter(
+						eventFromEventPusher(
+							org.NewNetworkAccessPolicySetEvent(context.Background(), &org.NewAggregate("org1").Aggregate,
+								domain.NetworkAccessRules{AllowedCIDRs: []string{"10.0.0.0/8"}},
+							),
+						),
+					),
+				),
+			},
+			args: args{
+				ctx:   context.Background(),
+				orgID: "org1",
+				rules: &domain.NetworkAccessRules{AllowedCIDRs: []string{"10.0.0.0/8"}},
+			},
+			res: res{
+				err: zerrors.ThrowPreconditionFailed(nil, "ORG-Nap3c", "Errors.NetworkAccessPolicy.NotChanged"),
+			},
+		},
+		{
+			name: "set policy, ok",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(
+							org.NewOrgAddedEvent(context.Background(), &org.NewAggregate("org1").Aggregate, "org"),
+						),
+					),
+					expectFilter(),
+					expectPush(
+						org.NewNetworkAccessPolicySetEvent(context.Background(), &org.NewAggregate("org1").Aggregate,
+							domain.NetworkAccessRules{
+								AllowedCIDRs: []string{"10.0.0.0/8"},
+								DeniedCIDRs:  []string{"10.0.0.1"},
+							},
+						),
+					),
+				),
+			},
+			args: args{
+				ctx:   context.Background(),
+				orgID: "org1",
+				rules: &domain.NetworkAccessRules{
+					AllowedCIDRs: []string{"10.0.0.0/8"},
+					DeniedCIDRs:  []string{"10.0.0.1"},
+				},
+			},
+			res: res{
+				want: &domain.ObjectDetails{
+					ResourceOwner: "org1",
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := &Commands{
+				eventstore: tt.fields.eventstore(t),
+			}
+			got, err := r.SetOrgNetworkAccessPolicy(tt.args.ctx, tt.args.orgID, tt.args.rules)
+			assert.ErrorIs(t, err, tt.res.err)
+			assert.Equal(t, tt.res.want, got)
+		})
+	}
+}
+
+func TestCommandSide_RemoveOrgNetworkAccessPolicy(t *testing.T) {
+	type fields struct {
+		eventstore func(t *testing.T) *eventstore.Eventstore
+	}
+	type args struct {
+		ctx   context.Context
+		orgID string
+	}
+	type res struct {
+		want *domain.ObjectDetails
+		err  error
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		args   args
+		res    res
+	}{
+		{
+			name: "policy not found, not found error",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(),
+				),
+			},
+			args: args{
+				ctx:   context.Background(),
+				orgID: "org1",
+			},
+			res: res{
+				err: zerrors.ThrowNotFound(nil, "ORG-Nap5n", "Errors.NetworkAccessPolicy.NotFound"),
+			},
+		},
+		{
+			name: "remove policy, ok",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(
+							org.NewNetworkAccessPolicySetEvent(context.Background(), &org.NewAggregate("org1").Aggregate,
+								domain.NetworkAccessRules{DeniedCIDRs: []string{"1.2.3.4"}},
+							),
+						),
+					),
+					expectPush(
+						org.NewNetworkAccessPolicyRemovedEvent(context.Background(), &org.NewAggregate("org1").Aggregate),
+					),
+				),
+			},
+			args: args{
+				ctx:   context.Background(),
+				orgID: "org1",
+			},
+			res: res{
+				want: &domain.ObjectDetails{
+					ResourceOwner: "org1",
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := &Commands{
+				eventstore: tt.fields.eventstore(t),
+			}
+			got, err := r.RemoveOrgNetworkAccessPolicy(tt.args.ctx, tt.args.orgID)
+			assert.ErrorIs(t, err, tt.res.err)
+			assert.Equal(t, tt.res.want, got)
+		})
+	}
+}
+
+func TestCommandSide_SetAppNetworkAccessPolicy(t *testing.T) {
+	type fields struct {
+		eventstore func(t *testing.T) *eventstore.Eventstore
+	}
+	type args struct {
+		ctx           context.Context
+		projectID     string
+		appID         string
+		resourceOwner string
+		rules         *domain.NetworkAccessRules
+	}
+	type res struct {
+		want *domain.ObjectDetails
+		err  error
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		args   args
+		res    res
+	}{
+		{
+			name: "missing app id, invalid argument error",
+			fields: fields{
+				eventstore: expectEventstore(),
+			},
+			args: args{
+				ctx:           context.Background(),
+				projectID:     "project1",
+				resourceOwner: "org1",
+				rules:         &domain.NetworkAccessRules{AllowedCIDRs: []string{"10.0.0.0/8"}},
+			},
+			res: res{
+				err: zerrors.ThrowInvalidArgument(nil, "COMMAND-Nap1m", "Errors.IDMissing"),
+			},
+		},
+		{
+			name: "app not found, not found error",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(),
+				),
+			},
+			args: args{
+				ctx:           context.Background(),
+				projectID:     "project1",
+				appID:         "app1",
+				resourceOwner: "org1",
+				rules:         &domain.NetworkAccessRules{AllowedCIDRs: []string{"10.0.0.0/8"}},
+			},
+			res: res{
+				err: zerrors.ThrowNotFound(nil, "COMMAND-Nap3n", "Errors.Project.App.NotExisting"),
+			},
+		},
+		{
+			name: "set policy, ok",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(networkAccessTestOIDCAppEvents()...),
+					expectFilter(),
+					expectPush(
+						project.NewNetworkAccessPolicySetEvent(context.Background(),
+							&project.NewAggregate("project1", "org1").Aggregate,
+							"app1",
+							"client1",
+							domain.NetworkAccessRules{AllowedCIDRs: []string{"10.0.0.0/8"}},
+						),
+					),
+				),
+			},
+			args: args{
+				ctx:           context.Background(),
+				projectID:     "project1",
+				appID:         "app1",
+				resourceOwner: "org1",
+				rules:         &domain.NetworkAccessRules{AllowedCIDRs: []string{"10.0.0.0/8"}},
+			},
+			res: res{
+				want: &domain.ObjectDetails{
+					ResourceOwner: "org1",
+				},
+			},
+		},
+		{
+			name: "policy not changed, precondition error",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(networkAccessTestOIDCAppEvents()...),
+					expectFilter(
+						eventFromEventPusher(
+							project.NewNetworkAccessPolicySetEvent(context.Background(),
+								&project.NewAggregate("project1", "org1").Aggregate,
+								"app1",
+								"client1",
+								domain.NetworkAccessRules{AllowedCIDRs: []string{"10.0.0.0/8"}},
+							),
+						),
+					),
+				),
+			},
+			args: args{
+				ctx:           context.Background(),
+				projectID:     "project1",
+				appID:         "app1",
+				resourceOwner: "org1",
+				rules:         &domain.NetworkAccessRules{AllowedCIDRs: []string{"10.0.0.0/8"}},
+			},
+			res: res{
+				err: zerrors.ThrowPreconditionFailed(nil, "COMMAND-Nap5c", "Errors.NetworkAccessPolicy.NotChanged"),
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := &Commands{
+				eventstore: tt.fields.eventstore(t),
+			}
+			got, err := r.SetAppNetworkAccessPolicy(tt.args.ctx, tt.args.projectID, tt.args.appID, tt.args.resourceOwner, tt.args.rules)
+			assert.ErrorIs(t, err, tt.res.err)
+			assert.Equal(t, tt.res.want, got)
+		})
+	}
+}
+
+func TestCommandSide_RemoveAppNetworkAccessPolicy(t *testing.T) {
+	type fields struct {
+		eventstore func(t *testing.T) *eventstore.Eventstore
+	}
+	type args struct {
+		ctx           context.Context
+		projectID     string
+		appID         string
+		resourceOwner string
+	}
+	type res struct {
+		want *domain.ObjectDetails
+		err  error
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		args   args
+		res    res
+	}{
+		{
+			name: "policy of other app, not found error",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(
+							project.NewNetworkAccessPolicySetEvent(context.Background(),
+								&project.NewAggregate("project1", "org1").Aggregate,
+								"app2",
+								"client2",
+								domain.NetworkAccessRules{AllowedCIDRs: []string{"10.0.0.0/8"}},
+							),
+						),
+					),
+				),
+			},
+			args: args{
+				ctx:           context.Background(),
+				projectID:     "project1",
+				appID:         "app1",
+				resourceOwner: "org1",
+			},
+			res: res{
+				err: zerrors.ThrowNotFound(nil, "COMMAND-Nap7n", "Errors.NetworkAccessPolicy.NotFound"),
+			},
+		},
+		{
+			name: "remove policy, ok",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(
+							project.NewNetworkAccessPolicySetEvent(context.Background(),
+								&project.NewAggregate("project1", "org1").Aggregate,
+								"app1",
+								"client1",
+								domain.NetworkAccessRules{AllowedCIDRs: []string{"10.0.0.0/8"}},
+							),
+						),
+					),
+					expectPush(
+						project.NewNetworkAccessPolicyRemovedEvent(context.Background(),
+							&project.NewAggregate("project1", "org1").Aggregate,
+							"app1",
+							"client1",
+						),
+					),
+				),
+			},
+			args: args{
+				ctx:           context.Background(),
+				projectID:     "project1",
+				appID:         "app1",
+				resourceOwner: "org1",
+			},
+			res: res{
+				want: &domain.ObjectDetails{
+					ResourceOwner: "org1",
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := &Commands{
+				eventstore: tt.fields.eventstore(t),
+			}
+			got, err := r.RemoveAppNetworkAccessPolicy(tt.args.ctx, tt.args.projectID, tt.args.appID, tt.args.resourceOwner)
+			assert.ErrorIs(t, err, tt.res.err)
+			assert.Equal(t, tt.res.want, got)
+		})
+	}
+}
+
+func TestCommands_CheckNetworkAccess(t *testing.T) {
+	type fields struct {
+		eventstore func(t *testing.T) *eventstore.Eventstore
+		geoIP      geoip.Provider
+	}
+	type args struct {
+		ctx      context.Context
+		phase    domain.NetworkAccessPhase
+		clientID string
+		remoteIP net.IP
+	}
+	tests := []struct {
+		name    string
+		fields  fields
+		args    args
+		wantErr error
+	}{
+		{
+			name: "no client ip, deny list only, ok",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(
+							org.NewNetworkAccessPolicySetEvent(context.Background(), &org.NewAggregate("org1").Aggregate,
+								domain.NetworkAccessRules{DeniedCIDRs: []string{"10.0.0.0/8"}},
+							),
+						),
+					),
+				),
+			},
+			args: args{
+				ctx:      context.Background(),
+				phase:    domain.NetworkAccessPhaseToken,
+				clientID: "client1",
+			},
+		},
+		{
+			name: "no client ip, allow list, permission denied error",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(
+							org.NewNetworkAccessPolicySetEvent(context.Background(), &org.NewAggregate("org1").Aggregate,
+								domain.NetworkAccessRules{AllowedCIDRs: []string{"10.0.0.0/8"}},
+							),
+						),
+					),
+					expectPush(
+						user.NewUserNetworkAccessBlockedEvent(context.Background(),
+							&user.NewAggregate("user1", "org1").Aggregate,
+							domain.NetworkAccessPhaseToken,
+							domain.NetworkAccessPolicyLevelOrg,
+							"",
+							"client1",
+							nil,
+							false,
+						),
+					),
+				),
+			},
+			args: args{
+				ctx:      context.Background(),
+				phase:    domain.NetworkAccessPhaseToken,
+				clientID: "client1",
+			},
+			wantErr: zerrors.ThrowPermissionDenied(nil, "COMMAND-Nap8b", "Errors.NetworkAccessPolicy.Blocked"),
+		},
+		{
+			name: "no policies, ok",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(),
+				),
+			},
+			args: args{
+				ctx:      context.Background(),
+				phase:    domain.NetworkAccessPhaseLogin,
+				clientID: "client1",
+				remoteIP: net.ParseIP("1.2.3.4"),
+			},
+		},
+		{
+			name: "allowed by org and app, ok",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(
+							org.NewNetworkAccessPolicySetEvent(context.Background(), &org.NewAggregate("org1").Aggregate,
+								domain.NetworkAccessRules{AllowedCIDRs: []string{"10.0.0.0/8"}},
+							),
+						),
+						eventFromEventPusher(
+							project.NewNetworkAccessPolicySetEvent(context.Background(),
+								&project.NewAggregate("project1", "org1").Aggregate,
+								"app1",
+								"client1",
+								domain.NetworkAccessRules{DeniedCIDRs: []string{"10.0.0.1"}},
+							),
+						),
+					),
+				),
+			},
+			args: args{
+				ctx:      context.Background(),
+				phase:    domain.NetworkAccessPhaseLogin,
+				clientID: "client1",
+				remoteIP: net.ParseIP("10.1.2.3"),
+			},
+		},
+		{
+			name: "blocked by org, permission denied error",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(
+							org.NewNetworkAccessPolicySetEvent(context.Background(), &org.NewAggregate("org1").Aggregate,
+								domain.NetworkAccessRules{AllowedCIDRs: []string{"10.0.0.0/8"}},
+							),
+						),
+					),
+					expectPush(
+						user.NewUserNetworkAccessBlockedEvent(context.Background(),
+							&user.NewAggregate("user1", "org1").Aggregate,
+							domain.NetworkAccessPhaseLogin,
+							domain.NetworkAccessPolicyLevelOrg,
+							"1.2.3.4",
+							"client1",
+							nil,
+							false,
+						),
+					),
+				),
+			},
+			args: args{
+				ctx:      context.Background(),
+				phase:    domain.NetworkAccessPhaseLogin,
+				clientID: "client1",
+				remoteIP: net.ParseIP("1.2.3.4"),
+			},
+			wantErr: zerrors.ThrowPermissionDenied(nil, "COMMAND-Nap8b", "Errors.NetworkAccessPolicy.Blocked"),
+		},
+		{
+			name: "blocked by app, permission denied error",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(
+							project.NewNetworkAccessPolicySetEvent(context.Background(),
+								&project.NewAggregate("project1", "org2").Aggregate,
+								"app1",
+								"client1",
+								domain.NetworkAccessRules{DeniedCIDRs: []string{"10.0.0.1"}},
+							),
+						),
+						eventFromEventPusher(
+							project.NewNetworkAccessPolicySetEvent(context.Background(),
+								&project.NewAggregate("project1", "org2").Aggregate,
+								"app2",
+								"client2",
+								domain.NetworkAccessRules{AllowedCIDRs: []string{"10.0.0.1"}},
+							),
+						),
+					),
+					expectPush(
+						user.NewUserNetworkAccessBlockedEvent(context.Background(),
+							&user.NewAggregate("user1", "org1").Aggregate,
+							domain.NetworkAccessPhaseToken,
+							domain.NetworkAccessPolicyLevelApp,
+							"10.0.0.1",
+							"client1",
+							nil,
+							false,
+						),
+					),
+				),
+			},
+			args: args{
+				ctx:      context.Background(),
+				phase:    domain.NetworkAccessPhaseToken,
+				clientID: "client1",
+				remoteIP: net.ParseIP("10.0.0.1"),
+			},
+			wantErr: zerrors.ThrowPermissionDenied(nil, "COMMAND-Nap8b", "Errors.NetworkAccessPolicy.Blocked"),
+		},
+		{
+			name: "blocked by monitoring org policy, recorded, ok",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(
+							org.NewNetworkAccessPolicySetEvent(context.Background(), &org.NewAggregate("org1").Aggregate,
+								domain.NetworkAccessRules{AllowedCIDRs: []string{"10.0.0.0/8"}, MonitorOnly: true},
+							),
+						),
+					),
+					expectPush(
+						user.NewUserNetworkAccessBlockedEvent(context.Background(),
+							&user.NewAggregate("user1", "org1").Aggregate,
+							domain.NetworkAccessPhaseLogin,
+							domain.NetworkAccessPolicyLevelOrg,
+							"1.2.3.4",
+							"client1",
+							nil,
+							true,
+						),
+					),
+				),
+			},
+			args: args{
+				ctx:      context.Background(),
+				phase:    domain.NetworkAccessPhaseLogin,
+				clientID: "client1",
+				remoteIP: net.ParseIP("1.2.3.4"),
+			},
+		},
+		{
+			name: "monitoring org policy, enforced app policy, permission denied error",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(
+							org.NewNetworkAccessPolicySetEvent(context.Background(), &org.NewAggregate("org1").Aggregate,
+								domain.NetworkAccessRules{DeniedCIDRs: []string{"1.2.3.4"}, MonitorOnly: true},
+							),
+						),
+						eventFromEventPusher(
+							project.NewNetworkAccessPolicySetEvent(context.Background(),
+								&project.NewAggregate("project1", "org2").Aggregate,
+								"app1",
+								"client1",
+								domain.NetworkAccessRules{DeniedCIDRs: []string{"1.2.3.0/24"}},
+							),
+						),
+					),
+					expectPush(
+						user.NewUserNetworkAccessBlockedEvent(context.Background(),
+							&user.NewAggregate("user1", "org1").Aggregate,
+							domain.NetworkAccessPhaseToken,
+							domain.NetworkAccessPolicyLevelApp,
+							"1.2.3.4",
+							"client1",
+							nil,
+							false,
+						),
+					),
+				),
+			},
+			args: args{
+				ctx:      context.Background(),
+				phase:    domain.NetworkAccessPhaseToken,
+				clientID: "client1",
+				remoteIP: net.ParseIP("1.2.3.4"),
+			},
+			wantErr: zerrors.ThrowPermissionDenied(nil, "COMMAND-Nap8b", "Errors.NetworkAccessPolicy.Blocked"),
+		},
+		{
+			name: "denied country, permission denied error",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(
+							org.NewNetworkAccessPolicySetEvent(context.Background(), &org.NewAggregate("org1").Aggregate,
+								domain.NetworkAccessRules{DeniedCountries: []string{"XX"}},
+							),
+						),
+					),
+					expectPush(
+						user.NewUserNetworkAccessBlockedEvent(context.Background(),
+							&user.NewAggregate("user1", "org1").Aggregate,
+							domain.NetworkAccessPhaseLogin,
+							domain.NetworkAccessPolicyLevelOrg,
+							"1.2.3.4",
+							"client1",
+							&geoip.Location{Country: "XX", ASN: 64496},
+							false,
+						),
+					),
+				),
+				geoIP: testGeoIP{"1.2.3.4": {Country: "XX", ASN: 64496}},
+			},
+			args: args{
+				ctx:      context.Background(),
+				phase:    domain.NetworkAccessPhaseLogin,
+				clientID: "client1",
+				remoteIP: net.ParseIP("1.2.3.4"),
+			},
+			wantErr: zerrors.ThrowPermissionDenied(nil, "COMMAND-Nap8b", "Errors.NetworkAccessPolicy.Blocked"),
+		},
+		{
+			name: "allowed asn, ok",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(
+							org.NewNetworkAccessPolicySetEvent(context.Background(), &org.NewAggregate("org1").Aggregate,
+								domain.NetworkAccessRules{AllowedASNs: []uint32{64496}},
+							),
+						),
+					),
+				),
+				geoIP: testGeoIP{"1.2.3.4": {Country: "XX", ASN: 64496}},
+			},
+			args: args{
+				ctx:      context.Background(),
+				phase:    domain.NetworkAccessPhaseLogin,
+				clientID: "client1",
+				remoteIP: net.ParseIP("1.2.3.4"),
+			},
+		},
+		{
+			name: "app policy removed, ok",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(
+							project.NewNetworkAccessPolicySetEvent(context.Background(),
+								&project.NewAggregate("project1", "org2").Aggregate,
+								"app1",
+								"client1",
+								domain.NetworkAccessRules{DeniedCIDRs: []string{"10.0.0.1"}},
+							),
+						),
+						eventFromEventPusher(
+							project.NewNetworkAccessPolicyRemovedEvent(context.Background(),
+								&project.NewAggregate("project1", "org2").Aggregate,
+								"app1",
+								"client1",
+							),
+						),
+					),
+				),
+			},
+			args: args{
+				ctx:      context.Background(),
+				phase:    domain.NetworkAccessPhaseToken,
+				clientID: "client1",
+				remoteIP: net.ParseIP("10.0.0.1"),
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &Commands{
+				eventstore: tt.fields.eventstore(t),
+				geoIP:      tt.fields.geoIP,
+			}
+			err := c.CheckNetworkAccess(tt.args.ctx, tt.args.phase, "user1", "org1", tt.args.clientID, tt.args.remoteIP)
+			assert.ErrorIs(t, err, tt.wantErr)
+		})
+	}
+}
+
+func TestCommands_CheckNetworkAccessPolicies(t *testing.T) {
+	monitoring := &domain.NetworkAccessPolicies{
+		Org: &domain.NetworkAccessRules{DeniedCIDRs: []string{"10.0.0.0/8"}, MonitorOnly: true},
+	}
+	type args struct {
+		policies        *domain.NetworkAccessPolicies
+		recordMonitored bool
+	}
+	tests := []struct {
+		name          string
+		eventstore    func(t *testing.T) *eventstore.Eventstore
+		args          args
+		wantMonitored bool
+		wantErr       error
+	}{
+		{
+			name:       "allowed, ok",
+			eventstore: expectEventstore(),
+			args: args{
+				policies: &domain.NetworkAccessPolicies{
+					Org: &domain.NetworkAccessRules{AllowedCIDRs: []string{"10.0.0.0/8"}},
+				},
+			},
+		},
+		{
+			name:       "monitored, not recorded",
+			eventstore: expectEventstore(),
+			args: args{
+				policies: monitoring,
+			},
+			wantMonitored: true,
+		},
+		{
+			name: "monitored, recorded",
+			eventstore: expectEventstore(
+				expectPush(
+					user.NewUserNetworkAccessBlockedEvent(context.Background(),
+						&user.NewAggregate("user1", "org1").Aggregate,
+						domain.NetworkAccessPhaseLogin,
+						domain.NetworkAccessPolicyLevelOrg,
+						"10.0.0.1",
+						"client1",
+						nil,
+						true,
+					),
+				),
+			),
+			args: args{
+				policies:        monitoring,
+				recordMonitored: true,
+			},
+			wantMonitored: true,
+		},
+		{
+			name: "blocked, permission denied error",
+			eventstore: expectEventstore(
+				expectPush(
+					user.NewUserNetworkAccessBlockedEvent(context.Background(),
+						&user.NewAggregate("user1", "org1").Aggregate,
+						domain.NetworkAccessPhaseLogin,
+						domain.NetworkAccessPolicyLevelApp,
+						"10.0.0.1",
+						"client1",
+						nil,
+						false,
+					),
+				),
+			),
+			args: args{
+				policies: &domain.NetworkAccessPolicies{
+					App: &domain.NetworkAccessRules{AllowedCIDRs: []string{"192.168.0.0/16"}},
+				},
+			},
+			wantErr: zerrors.ThrowPermissionDenied(nil, "COMMAND-Nap8b", "Errors.NetworkAccessPolicy.Blocked"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &Commands{
+				eventstore: tt.eventstore(t),
+			}
+			monitored, err := c.CheckNetworkAccessPolicies(context.Background(), domain.NetworkAccessPhaseLogin, "user1", "org1", "client1", net.ParseIP("10.0.0.1"), tt.args.policies, tt.args.recordMonitored)
+			assert.ErrorIs(t, err, tt.wantErr)
+			assert.Equal(t, tt.wantMonitored, monitored)
+		})
+	}
+}
+
+func networkAccessTestOIDCAppEvents() []eventstore.Event {
+	return []eventstore.Event{
+		eventFromEventPusher(
+			project.NewApplicationAddedEvent(context.Background(),
+				&project.NewAggregate("project1", "org1").Aggregate,
+				"app1",
+				"app",
+			),
+		),
+		eventFromEventPusher(
+			project.NewOIDCConfigAddedEvent(context.Background(),
+				&project.NewAggregate("project1", "org1").Aggregate,
+				domain.OIDCVersionV1,
+				"app1",
+				"client1",
+				"",
+				[]string{"https://test.ch"},
+				[]domain.OIDCResponseType{domain.OIDCResponseTypeCode},
+				[]domain.OIDCGrantType{domain.OIDCGrantTypeAuthorizationCode},
+				domain.OIDCApplicationTypeWeb,
+				domain.OIDCAuthMethodTypeNone,
+				nil,
+				false,
+				domain.OIDCTokenTypeBearer,
+				false,
+				false,
+				false,
+				0,
+				nil,
+				false,
+				"",
+				"",
+			),
+		),
+	}
+}
+
+type testGeoIP map[string]*geoip.Location
+
+func (p testGeoIP) Lookup(_ context.Context, ip net.IP) (*geoip.Location, error) {
+	if location, ok := p[ip.String()]; ok {
+		return location, nil
+	}
+	return &geoip.Location{}, nil
+}
@@ -0,0 +1,45 @@
+package command
+
+import (
+	"context"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/repository/notification"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+// NotificationDelivered writes a new notification.DeliveredEvent for the message sent to the provider
+func (c *Commands) NotificationDelivered(ctx context.Context, messageID string, delivery notification.Delivery) error {
+	agg := notification.NewAggregate(messageID, authz.GetInstance(ctx).InstanceID())
+	_, err := c.eventstore.Push(ctx, notification.NewDeliveredEvent(ctx, &agg.Aggregate, delivery))
+	return err
+}
+
+// NotificationDeliveryFailed writes a new notification.DeliveryFailedEvent for the message which could not be sent to the provider
+func (c *Commands) NotificationDeliveryFailed(ctx context.Context, messageID string, delivery notification.Delivery, reason string) error {
+	agg := notification.NewAggregate(messageID, authz.GetInstance(ctx).InstanceID())
+	_, err := c.eventstore.Push(ctx, notification.NewDeliveryFailedEvent(ctx, &agg.Aggregate, delivery, reason))
+	return err
+}
+
+// NotificationReceiptReceived writes a new notification.ReceiptReceivedEvent for a delivery receipt reported by the provider,
+// which previously accepted the message
+func (c *Commands) NotificationReceiptReceived(ctx context.Context, messageID, providerID, status, reason string) error {
+	if messageID == "" || providerID == "" {
+		return zerrors.ThrowInvalidArgument(nil, "COMMAND-u6bq3mxk0f", "Errors.IDMissing")
+	}
+	if status != notification.ReceiptStatusDelivered && status != notification.ReceiptStatusFailed {
+		return zerrors.ThrowInvalidArgument(nil, "COMMAND-7rnw2yjc4v", "Errors.Notification.InvalidReceiptStatus")
+	}
+	instanceID := authz.GetInstance(ctx).InstanceID()
+	writeModel := NewNotificationDeliveryWriteModel(messageID, instanceID)
+	if err := c.eventstore.FilterToQueryReducer(ctx, writeModel); err != nil {
+		return err
+	}
+	if !writeModel.DeliveredBy(providerID) {
+		return zerrors.ThrowNotFound(nil, "COMMAND-h1zq8dpw5e", "Errors.Notification.NotFound")
+	}
+	agg := notification.NewAggregate(messageID, instanceID)
+	_, err := c.eventstore.Push(ctx, notification.NewReceiptReceivedEvent(ctx, &agg.Aggregate, providerID, status, reason))
+	return err
+}
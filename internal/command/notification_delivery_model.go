@@ -0,0 +1,48 @@
+package command
+
+import (
+	"slices"
+
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/repository/notification"
+)
+
+type NotificationDeliveryWriteModel struct {
+	eventstore.WriteModel
+
+	// ProviderIDs are the providers which accepted the message
+	ProviderIDs []string
+}
+
+func NewNotificationDeliveryWriteModel(messageID, instanceID string) *NotificationDeliveryWriteModel {
+	return &NotificationDeliveryWriteModel{
+		WriteModel: eventstore.WriteModel{
+			AggregateID:   messageID,
+			ResourceOwner: instanceID,
+			InstanceID:    instanceID,
+		},
+	}
+}
+
+func (wm *NotificationDeliveryWriteModel) Reduce() error {
+	for _, event := range wm.Events {
+		if e, ok := event.(*notification.DeliveredEvent); ok && !slices.Contains(wm.ProviderIDs, e.ProviderID) {
+			wm.ProviderIDs = append(wm.ProviderIDs, e.ProviderID)
+		}
+	}
+	return wm.WriteModel.Reduce()
+}
+
+func (wm *NotificationDeliveryWriteModel) Query() *eventstore.SearchQueryBuilder {
+	return eventstore.NewSearchQueryBuilder(eventstore.ColumnsEvent).
+		ResourceOwner(wm.ResourceOwner).
+		AddQuery().
+		AggregateTypes(notification.AggregateType).
+		AggregateIDs(wm.AggregateID).
+		EventTypes(notification.DeliveredEventType).
+		Builder()
+}
+
+func (wm *NotificationDeliveryWriteModel) DeliveredBy(providerID string) bool {
+	return slices.Contains(wm.ProviderIDs, providerID)
+}
@@ -0,0 +1,111 @@
+package command
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/repository/notification"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+func TestCommands_NotificationReceiptReceived(t *testing.T) {
+	ctx := authz.WithInstanceID(context.Background(), "instance")
+	delivery := notification.Delivery{
+		Channel:    notification.DeliveryChannelSMS,
+		ProviderID: "provider",
+	}
+	type fields struct {
+		eventstore func(t *testing.T) *eventstore.Eventstore
+	}
+	type args struct {
+		messageID  string
+		providerID string
+		status     string
+		reason     string
+	}
+	tests := []struct {
+		name    string
+		fields  fields
+		args    args
+		wantErr error
+	}{
+		{
+			name: "missing message id, invalid argument error",
+			fields: fields{
+				eventstore: expectEventstore(),
+			},
+			args: args{
+				providerID: "provider",
+				status:     notification.ReceiptStatusDelivered,
+			},
+			wantErr: zerrors.ThrowInvalidArgument(nil, "COMMAND-u6bq3mxk0f", "Errors.IDMissing"),
+		},
+		{
+			name: "invalid status, invalid argument error",
+			fields: fields{
+				eventstore: expectEventstore(),
+			},
+			args: args{
+				messageID:  "message",
+				providerID: "provider",
+				status:     "read",
+			},
+			wantErr: zerrors.ThrowInvalidArgument(nil, "COMMAND-7rnw2yjc4v", "Errors.Notification.InvalidReceiptStatus"),
+		},
+		{
+			name: "message not delivered by provider, not found error",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(
+							notification.NewDeliveredEvent(ctx, &notification.NewAggregate("message", "instance").Aggregate, notification.Delivery{
+								Channel:    notification.DeliveryChannelSMS,
+								ProviderID: "other",
+							}),
+						),
+					),
+				),
+			},
+			args: args{
+				messageID:  "message",
+				providerID: "provider",
+				status:     notification.ReceiptStatusDelivered,
+			},
+			wantErr: zerrors.ThrowNotFound(nil, "COMMAND-h1zq8dpw5e", "Errors.Notification.NotFound"),
+		},
+		{
+			name: "receipt received, ok",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(
+							notification.NewDeliveredEvent(ctx, &notification.NewAggregate("message", "instance").Aggregate, delivery),
+						),
+					),
+					expectPush(
+						notification.NewReceiptReceivedEvent(ctx, &notification.NewAggregate("message", "instance").Aggregate, "provider", notification.ReceiptStatusFailed, "unreachable"),
+					),
+				),
+			},
+			args: args{
+				messageID:  "message",
+				providerID: "provider",
+				status:     notification.ReceiptStatusFailed,
+				reason:     "unreachable",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &Commands{
+				eventstore: tt.fields.eventstore(t),
+			}
+			err := c.NotificationReceiptReceived(ctx, tt.args.messageID, tt.args.providerID, tt.args.status, tt.args.reason)
+			assert.ErrorIs(t, err, tt.wantErr)
+		})
+	}
+}
@@ -0,0 +1,101 @@
+package command
+
+import (
+	"context"
+	"time"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/crypto"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/repository/notification"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+// QueueNotification queues a notification, whose first delivery failed, for asynchronous retries.
+// The rendered message is encrypted, as it might contain codes and links.
+func (c *Commands) QueueNotification(ctx context.Context, queued notification.Queued, message []byte, reason string, nextAttempt time.Time) (string, error) {
+	if queued.Channel == "" || queued.Recipient == "" {
+		return "", zerrors.ThrowInvalidArgument(nil, "COMMAND-x4nb8qye2r", "Errors.Notification.Queue.Invalid")
+	}
+	id, err := c.idGenerator.Next()
+	if err != nil {
+		return "", err
+	}
+	encrypted, err := crypto.Encrypt(message, c.userEncryption)
+	if err != nil {
+		return "", err
+	}
+	agg := notification.NewAggregate(id, authz.GetInstance(ctx).InstanceID())
+	_, err = c.eventstore.Push(ctx, notification.NewQueuedEvent(ctx, &agg.Aggregate, queued, encrypted, reason, nextAttempt))
+	if err != nil {
+		return "", err
+	}
+	return id, nil
+}
+
+// NotificationRetryScheduled schedules the next attempt of a queued notification after a failed retry.
+func (c *Commands) NotificationRetryScheduled(ctx context.Context, id, reason string, nextAttempt time.Time) error {
+	existing, err := c.getPendingNotificationQueueWriteModel(ctx, id)
+	if err != nil {
+		return err
+	}
+	return c.pushAppendAndReduce(ctx, existing, notification.NewRetryScheduledEvent(ctx, NotificationAggregateFromWriteModel(&existing.WriteModel), reason, nextAttempt))
+}
+
+// NotificationDeadLettered stops the retries of a queued notification after the last attempt failed.
+func (c *Commands) NotificationDeadLettered(ctx context.Context, id, reason string) error {
+	existing, err := c.getPendingNotificationQueueWriteModel(ctx, id)
+	if err != nil {
+		return err
+	}
+	return c.pushAppendAndReduce(ctx, existing, notification.NewDeadLetteredEvent(ctx, NotificationAggregateFromWriteModel(&existing.WriteModel), reason))
+}
+
+// NotificationResent marks a queued notification as sent.
+func (c *Commands) NotificationResent(ctx context.Context, id string) error {
+	existing, err := c.getPendingNotificationQueueWriteModel(ctx, id)
+	if err != nil {
+		return err
+	}
+	return c.pushAppendAndReduce(ctx, existing, notification.NewResentEvent(ctx, NotificationAggregateFromWriteModel(&existing.WriteModel)))
+}
+
+// RequeueNotification requeues a dead-lettered notification, which is then retried with the full number of attempts.
+func (c *Commands) RequeueNotification(ctx context.Context, id string) (*domain.ObjectDetails, error) {
+	existing, err := c.getNotificationQueueWriteModel(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if existing.State != domain.NotificationQueueStateDeadLettered {
+		return nil, zerrors.ThrowPreconditionFailed(nil, "COMMAND-r7ck2wdu5m", "Errors.Notification.Queue.NotDeadLettered")
+	}
+	if err = c.pushAppendAndReduce(ctx, existing, notification.NewRequeuedEvent(ctx, NotificationAggregateFromWriteModel(&existing.WriteModel))); err != nil {
+		return nil, err
+	}
+	return writeModelToObjectDetails(&existing.WriteModel), nil
+}
+
+func (c *Commands) getPendingNotificationQueueWriteModel(ctx context.Context, id string) (*NotificationQueueWriteModel, error) {
+	existing, err := c.getNotificationQueueWriteModel(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if existing.State != domain.NotificationQueueStatePending {
+		return nil, zerrors.ThrowPreconditionFailed(nil, "COMMAND-e3hv9zpk1q", "Errors.Notification.Queue.NotPending")
+	}
+	return existing, nil
+}
+
+func (c *Commands) getNotificationQueueWriteModel(ctx context.Context, id string) (*NotificationQueueWriteModel, error) {
+	if id == "" {
+		return nil, zerrors.ThrowInvalidArgument(nil, "COMMAND-5gmf0tjw8a", "Errors.IDMissing")
+	}
+	wm := NewNotificationQueueWriteModel(id, authz.GetInstance(ctx).InstanceID())
+	if err := c.eventstore.FilterToQueryReducer(ctx, wm); err != nil {
+		return nil, err
+	}
+	if wm.State == domain.NotificationQueueStateUnspecified {
+		return nil, zerrors.ThrowNotFound(nil, "COMMAND-q8yd3nls6b", "Errors.Notification.Queue.NotFound")
+	}
+	return wm, nil
+}
@@ -0,0 +1,65 @@
+package command
+
+import (
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/repository/notification"
+)
+
+type NotificationQueueWriteModel struct {
+	eventstore.WriteModel
+
+	State domain.NotificationQueueState
+}
+
+func NewNotificationQueueWriteModel(id, instanceID string) *NotificationQueueWriteModel {
+	return &NotificationQueueWriteModel{
+		WriteModel: eventstore.WriteModel{
+			AggregateID:   id,
+			ResourceOwner: instanceID,
+			InstanceID:    instanceID,
+		},
+	}
+}
+
+func (wm *NotificationQueueWriteModel) Reduce() error {
+	for _, event := range wm.Events {
+		switch event.(type) {
+		case *notification.QueuedEvent,
+			*notification.RetryScheduledEvent,
+			*notification.RequeuedEvent:
+			wm.State = domain.NotificationQueueStatePending
+		case *notification.DeadLetteredEvent:
+			wm.State = domain.NotificationQueueStateDeadLettered
+		case *notification.ResentEvent:
+			wm.State = domain.NotificationQueueStateSent
+		}
+	}
+	return wm.WriteModel.Reduce()
+}
+
+func (wm *NotificationQueueWriteModel) Query() *eventstore.SearchQueryBuilder {
+	return eventstore.NewSearchQueryBuilder(eventstore.ColumnsEvent).
+		ResourceOwner(wm.ResourceOwner).
+		AddQuery().
+		AggregateTypes(notification.AggregateType).
+		AggregateIDs(wm.AggregateID).
+		EventTypes(
+			notification.QueuedEventType,
+			notification.RetryScheduledEventType,
+			notification.DeadLetteredEventType,
+			notification.RequeuedEventType,
+			notification.ResentEventType,
+		).
+		Builder()
+}
+
+func NotificationAggregateFromWriteModel(wm *eventstore.WriteModel) *eventstore.Aggregate {
+	return &eventstore.Aggregate{
+		ID:            wm.AggregateID,
+		Type:          notification.AggregateType,
+		ResourceOwner: wm.ResourceOwner,
+		InstanceID:    wm.InstanceID,
+		Version:       notification.AggregateVersion,
+	}
+}
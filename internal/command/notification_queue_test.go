@@ -0,0 +1,194 @@
+package command
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/crypto"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/id"
+	"github.com/zitadel/zitadel/internal/id/mock"
+	"github.com/zitadel/zitadel/internal/repository/notification"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+func TestCommands_QueueNotification(t *testing.T) {
+	ctx := authz.WithInstanceID(context.Background(), "instance")
+	nextAttempt := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	queued := notification.Queued{
+		Channel:               notification.DeliveryChannelEmail,
+		Recipient:             "user@example.com",
+		TriggeringAggregateID: "user1",
+		TriggeringEventType:   "user.human.initialization.code.added",
+	}
+	type fields struct {
+		eventstore  func(t *testing.T) *eventstore.Eventstore
+		idGenerator id.Generator
+	}
+	type args struct {
+		queued notification.Queued
+	}
+	tests := []struct {
+		name    string
+		fields  fields
+		args    args
+		wantID  string
+		wantErr error
+	}{
+		{
+			name: "missing recipient, invalid argument error",
+			fields: fields{
+				eventstore: expectEventstore(),
+			},
+			args: args{
+				queued: notification.Queued{Channel: notification.DeliveryChannelEmail},
+			},
+			wantErr: zerrors.ThrowInvalidArgument(nil, "COMMAND-x4nb8qye2r", "Errors.Notification.Queue.Invalid"),
+		},
+		{
+			name: "queued, ok",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectPush(
+						notification.NewQueuedEvent(ctx, &notification.NewAggregate("queued1", "instance").Aggregate,
+							queued,
+							&crypto.CryptoValue{
+								CryptoType: crypto.TypeEncryption,
+								Algorithm:  "enc",
+								KeyID:      "id",
+								Crypted:    []byte("message"),
+							},
+							"smtp unavailable",
+							nextAttempt,
+						),
+					),
+				),
+				idGenerator: mock.ExpectID(t, "queued1"),
+			},
+			args: args{
+				queued: queued,
+			},
+			wantID: "queued1",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &Commands{
+				eventstore:     tt.fields.eventstore(t),
+				idGenerator:    tt.fields.idGenerator,
+				userEncryption: crypto.CreateMockEncryptionAlg(gomock.NewController(t)),
+			}
+			got, err := c.QueueNotification(ctx, tt.args.queued, []byte("message"), "smtp unavailable", nextAttempt)
+			assert.ErrorIs(t, err, tt.wantErr)
+			assert.Equal(t, tt.wantID, got)
+		})
+	}
+}
+
+func TestCommands_NotificationRetryScheduled(t *testing.T) {
+	ctx := authz.WithInstanceID(context.Background(), "instance")
+	nextAttempt := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	agg := &notification.NewAggregate("queued1", "instance").Aggregate
+	tests := []struct {
+		name       string
+		eventstore func(t *testing.T) *eventstore.Eventstore
+		wantErr    error
+	}{
+		{
+			name:       "not queued, not found error",
+			eventstore: expectEventstore(expectFilter()),
+			wantErr:    zerrors.ThrowNotFound(nil, "COMMAND-q8yd3nls6b", "Errors.Notification.Queue.NotFound"),
+		},
+		{
+			name: "dead lettered, precondition error",
+			eventstore: expectEventstore(
+				expectFilter(
+					eventFromEventPusher(notification.NewQueuedEvent(ctx, agg, notification.Queued{}, nil, "", nextAttempt)),
+					eventFromEventPusher(notification.NewDeadLetteredEvent(ctx, agg, "smtp unavailable")),
+				),
+			),
+			wantErr: zerrors.ThrowPreconditionFailed(nil, "COMMAND-e3hv9zpk1q", "Errors.Notification.Queue.NotPending"),
+		},
+		{
+			name: "retry scheduled, ok",
+			eventstore: expectEventstore(
+				expectFilter(
+					eventFromEventPusher(notification.NewQueuedEvent(ctx, agg, notification.Queued{}, nil, "", nextAttempt)),
+				),
+				expectPush(
+					notification.NewRetryScheduledEvent(ctx, agg, "smtp unavailable", nextAttempt),
+				),
+			),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &Commands{
+				eventstore: tt.eventstore(t),
+			}
+			err := c.NotificationRetryScheduled(ctx, "queued1", "smtp unavailable", nextAttempt)
+			assert.ErrorIs(t, err, tt.wantErr)
+		})
+	}
+}
+
+func TestCommands_RequeueNotification(t *testing.T) {
+	ctx := authz.WithInstanceID(context.Background(), "instance")
+	nextAttempt := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	agg := &notification.NewAggregate("queued1", "instance").Aggregate
+	tests := []struct {
+		name       string
+		id         string
+		eventstore func(t *testing.T) *eventstore.Eventstore
+		want       *domain.ObjectDetails
+		wantErr    error
+	}{
+		{
+			name:       "missing id, invalid argument error",
+			eventstore: expectEventstore(),
+			wantErr:    zerrors.ThrowInvalidArgument(nil, "COMMAND-5gmf0tjw8a", "Errors.IDMissing"),
+		},
+		{
+			name: "pending, precondition error",
+			id:   "queued1",
+			eventstore: expectEventstore(
+				expectFilter(
+					eventFromEventPusher(notification.NewQueuedEvent(ctx, agg, notification.Queued{}, nil, "", nextAttempt)),
+				),
+			),
+			wantErr: zerrors.ThrowPreconditionFailed(nil, "COMMAND-r7ck2wdu5m", "Errors.Notification.Queue.NotDeadLettered"),
+		},
+		{
+			name: "requeued, ok",
+			id:   "queued1",
+			eventstore: expectEventstore(
+				expectFilter(
+					eventFromEventPusher(notification.NewQueuedEvent(ctx, agg, notification.Queued{}, nil, "", nextAttempt)),
+					eventFromEventPusher(notification.NewDeadLetteredEvent(ctx, agg, "smtp unavailable")),
+				),
+				expectPush(
+					notification.NewRequeuedEvent(ctx, agg),
+				),
+			),
+			want: &domain.ObjectDetails{
+				ResourceOwner: "instance",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &Commands{
+				eventstore: tt.eventstore(t),
+			}
+			got, err := c.RequeueNotification(ctx, tt.id)
+			assert.ErrorIs(t, err, tt.wantErr)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
@@ -12,6 +12,7 @@ import (
 
 	"github.com/zitadel/zitadel/internal/activity"
 	"github.com/zitadel/zitadel/internal/api/authz"
+	http_util "github.com/zitadel/zitadel/internal/api/http"
 	"github.com/zitadel/zitadel/internal/crypto"
 	"github.com/zitadel/zitadel/internal/domain"
 	"github.com/zitadel/zitadel/internal/eventstore"
@@ -79,8 +80,11 @@ func (c *Commands) CreateOIDCSessionFromAuthRequest(ctx context.Context, authReq
 	if err = sessionModel.CheckIsActive(); err != nil {
 		return nil, "", err
 	}
+	if err = c.checkNetworkAccessFromCtx(ctx, domain.NetworkAccessPhaseToken, sessionModel.UserID, sessionModel.UserResourceOwner, authReqModel.ClientID); err != nil {
+		return nil, "", err
+	}
 
-	cmd, err := c.newOIDCSessionAddEvents(ctx, sessionModel.UserResourceOwner)
+	cmd, err := c.newOIDCSessionAddEvents(ctx, sessionModel.UserResourceOwner, authReqModel.ClientID)
 	if err != nil {
 		return nil, "", err
 	}
@@ -140,7 +144,10 @@ func (c *Commands) CreateOIDCSession(ctx context.Context,
 	ctx, span := tracing.NewSpan(ctx)
 	defer func() { span.EndWithError(err) }()
 
-	cmd, err := c.newOIDCSessionAddEvents(ctx, resourceOwner)
+	if err = c.checkNetworkAccessFromCtx(ctx, domain.NetworkAccessPhaseToken, userID, resourceOwner, clientID); err != nil {
+		return nil, err
+	}
+	cmd, err := c.newOIDCSessionAddEvents(ctx, resourceOwner, clientID)
 	if err != nil {
 		return nil, err
 	}
@@ -175,6 +182,13 @@ func (c *Commands) ExchangeOIDCSessionRefreshAndAccessToken(ctx context.Context,
 	if err != nil {
 		return nil, err
 	}
+	if err = c.checkNetworkAccessFromCtx(ctx, domain.NetworkAccessPhaseToken,
+		cmd.oidcSessionWriteModel.UserID,
+		cmd.oidcSessionWriteModel.UserResourceOwner,
+		cmd.oidcSessionWriteModel.ClientID,
+	); err != nil {
+		return nil, err
+	}
 	scope, err = complianceCheck(ctx, cmd.oidcSessionWriteModel, scope)
 	if err != nil {
 		return nil, err
@@ -264,8 +278,8 @@ func (c *Commands) RevokeOIDCSessionToken(ctx context.Context, token, clientID s
 	return c.pushAppendAndReduce(ctx, writeModel, oidcsession.NewAccessTokenRevokedEvent(ctx, writeModel.aggregate))
 }
 
-func (c *Commands) newOIDCSessionAddEvents(ctx context.Context, resourceOwner string, pending ...eventstore.Command) (*OIDCSessionEvents, error) {
-	accessTokenLifetime, refreshTokenLifeTime, refreshTokenIdleLifetime, err := c.tokenTokenLifetimes(ctx)
+func (c *Commands) newOIDCSessionAddEvents(ctx context.Context, resourceOwner, clientID string, pending ...eventstore.Command) (*OIDCSessionEvents, error) {
+	accessTokenLifetime, refreshTokenLifeTime, refreshTokenIdleLifetime, err := c.tokenTokenLifetimes(ctx, clientID)
 	if err != nil {
 		return nil, err
 	}
@@ -320,7 +334,7 @@ func (c *Commands) newOIDCSessionUpdateEvents(ctx context.Context, refreshToken
 	if err = sessionWriteModel.CheckRefreshToken(refreshTokenID); err != nil {
 		return nil, err
 	}
-	accessTokenLifetime, refreshTokenLifeTime, refreshTokenIdleLifetime, err := c.tokenTokenLifetimes(ctx)
+	accessTokenLifetime, refreshTokenLifeTime, refreshTokenIdleLifetime, err := c.tokenTokenLifetimes(ctx, sessionWriteModel.ClientID)
 	if err != nil {
 		return nil, err
 	}
@@ -406,8 +420,13 @@ func (c *OIDCSessionEvents) AddAccessToken(ctx context.Context, scope []string,
 		return err
 	}
 	c.accessTokenID = AccessTokenPrefix + accessTokenID
+	// bind the token to the client certificate of the request (RFC 8705)
+	var certificateThumbprint string
+	if cert := http_util.ClientCertificateFromCtx(ctx); cert != nil {
+		certificateThumbprint = http_util.CertificateThumbprint(cert)
+	}
 	c.events = append(c.events,
-		oidcsession.NewAccessTokenAddedEvent(ctx, c.oidcSessionWriteModel.aggregate, c.accessTokenID, scope, c.accessTokenLifetime, reason, actor),
+		oidcsession.NewAccessTokenAddedEvent(ctx, c.oidcSessionWriteModel.aggregate, c.accessTokenID, scope, c.accessTokenLifetime, reason, actor, certificateThumbprint),
 		user.NewUserTokenV2AddedEvent(ctx, &user.NewAggregate(userID, resourceOwner).Aggregate, c.accessTokenID), // for user audit log
 	)
 	return nil
@@ -483,8 +502,10 @@ func (c *OIDCSessionEvents) PushEvents(ctx context.Context) (*OIDCSession, error
 	return session, nil
 }
 
-func (c *Commands) tokenTokenLifetimes(ctx context.Context) (accessTokenLifetime time.Duration, refreshTokenLifetime time.Duration, refreshTokenIdleLifetime time.Duration, err error) {
-	oidcSettings := NewInstanceOIDCSettingsWriteModel(ctx)
+// tokenTokenLifetimes returns the lifetimes of the tokens issued to the client.
+// The OIDC settings of the instance are used if set, the application can override them with shorter lifetimes.
+func (c *Commands) tokenTokenLifetimes(ctx context.Context, clientID string) (accessTokenLifetime time.Duration, refreshTokenLifetime time.Duration, refreshTokenIdleLifetime time.Duration, err error) {
+	oidcSettings := newOIDCTokenLifetimesReadModel(ctx, clientID)
 	err = c.eventstore.FilterToQueryReducer(ctx, oidcSettings)
 	if err != nil {
 		return 0, 0, 0, err
@@ -501,7 +522,10 @@ func (c *Commands) tokenTokenLifetimes(ctx context.Context) (accessTokenLifetime
 	if oidcSettings.RefreshTokenIdleExpiration > 0 {
 		refreshTokenIdleLifetime = oidcSettings.RefreshTokenIdleExpiration
 	}
-	return accessTokenLifetime, refreshTokenLifetime, refreshTokenIdleLifetime, nil
+	return oidcSettings.app.AccessToken(accessTokenLifetime),
+		oidcSettings.app.RefreshToken(refreshTokenLifetime),
+		oidcSettings.app.RefreshTokenIdle(refreshTokenIdleLifetime),
+		nil
 }
 
 func tokenReasonToActivityMethodType(r domain.TokenReason) activity.TriggerMethod {
@@ -1,13 +1,16 @@
 package command
 
 import (
+	"context"
 	"time"
 
 	"golang.org/x/text/language"
 
 	"github.com/zitadel/zitadel/internal/domain"
 	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/repository/instance"
 	"github.com/zitadel/zitadel/internal/repository/oidcsession"
+	"github.com/zitadel/zitadel/internal/repository/project"
 	"github.com/zitadel/zitadel/internal/zerrors"
 )
 
@@ -179,3 +182,48 @@ func (wm *OIDCSessionWriteModel) CheckClient(clientID string) error {
 func (wm *OIDCSessionWriteModel) OIDCRefreshTokenID(refreshTokenID string) string {
 	return wm.AggregateID + TokenDelimiter + refreshTokenID
 }
+
+// oidcTokenLifetimesReadModel contains the token lifetimes of the OIDC settings of the instance
+// and the ones overridden by the application of the client
+type oidcTokenLifetimesReadModel struct {
+	*InstanceOIDCSettingsWriteModel
+
+	clientID string
+	app      *domain.AppTokenLifetimes
+}
+
+func newOIDCTokenLifetimesReadModel(ctx context.Context, clientID string) *oidcTokenLifetimesReadModel {
+	return &oidcTokenLifetimesReadModel{
+		InstanceOIDCSettingsWriteModel: NewInstanceOIDCSettingsWriteModel(ctx),
+		clientID:                       clientID,
+	}
+}
+
+func (rm *oidcTokenLifetimesReadModel) Reduce() error {
+	for _, event := range rm.Events {
+		if e, ok := event.(*project.ApplicationTokenLifetimesSetEvent); ok && e.ClientID == rm.clientID {
+			rm.app = e.TokenLifetimes
+		}
+	}
+	return rm.InstanceOIDCSettingsWriteModel.Reduce()
+}
+
+func (rm *oidcTokenLifetimesReadModel) Query() *eventstore.SearchQueryBuilder {
+	query := eventstore.NewSearchQueryBuilder(eventstore.ColumnsEvent).
+		AddQuery().
+		AggregateTypes(instance.AggregateType).
+		AggregateIDs(rm.AggregateID).
+		EventTypes(
+			instance.OIDCSettingsAddedEventType,
+			instance.OIDCSettingsChangedEventType).
+		Builder()
+	if rm.clientID == "" {
+		return query
+	}
+	return query.
+		AddQuery().
+		AggregateTypes(project.AggregateType).
+		EventTypes(project.ApplicationTokenLifetimesSetType).
+		EventData(map[string]interface{}{"clientId": rm.clientID}).
+		Builder()
+}
@@ -273,6 +273,9 @@ func (c *Commands) checkOrgExists(ctx context.Context, orgID string) error {
 	if !isOrgStateExists(orgWriteModel.State) {
 		return zerrors.ThrowPreconditionFailed(nil, "COMMAND-QXPGs", "Errors.Org.NotFound")
 	}
+	if orgWriteModel.State == domain.OrgStateArchived {
+		return zerrors.ThrowPreconditionFailed(nil, "COMMAND-Ar5hv", "Errors.Org.Archived")
+	}
 	return nil
 }
 
@@ -346,6 +349,9 @@ func (c *Commands) ChangeOrg(ctx context.Context, orgID, name string) (*domain.O
 	if !isOrgStateExists(orgWriteModel.State) {
 		return nil, zerrors.ThrowNotFound(nil, "ORG-1MRds", "Errors.Org.NotFound")
 	}
+	if orgWriteModel.State == domain.OrgStateArchived {
+		return nil, zerrors.ThrowPreconditionFailed(nil, "ORG-Ar2cd", "Errors.Org.Archived")
+	}
 	if orgWriteModel.Name == name {
 		return nil, zerrors.ThrowPreconditionFailed(nil, "ORG-4VSdf", "Errors.Org.NotChanged")
 	}
@@ -378,6 +384,9 @@ func (c *Commands) DeactivateOrg(ctx context.Context, orgID string) (*domain.Obj
 	if !isOrgStateExists(orgWriteModel.State) {
 		return nil, zerrors.ThrowNotFound(nil, "ORG-oL9nT", "Errors.Org.NotFound")
 	}
+	if orgWriteModel.State == domain.OrgStateArchived {
+		return nil, zerrors.ThrowPreconditionFailed(nil, "ORG-Ar7dt", "Errors.Org.Archived")
+	}
 	if orgWriteModel.State == domain.OrgStateInactive {
 		return nil, zerrors.ThrowPreconditionFailed(nil, "EVENT-Dbs2g", "Errors.Org.AlreadyDeactivated")
 	}
@@ -401,6 +410,9 @@ func (c *Commands) ReactivateOrg(ctx context.Context, orgID string) (*domain.Obj
 	if !isOrgStateExists(orgWriteModel.State) {
 		return nil, zerrors.ThrowNotFound(nil, "ORG-Dgf3g", "Errors.Org.NotFound")
 	}
+	if orgWriteModel.State == domain.OrgStateArchived {
+		return nil, zerrors.ThrowPreconditionFailed(nil, "ORG-Ar9kq", "Errors.Org.Archived")
+	}
 	if orgWriteModel.State == domain.OrgStateActive {
 		return nil, zerrors.ThrowPreconditionFailed(nil, "EVENT-bfnrh", "Errors.Org.AlreadyActive")
 	}
@@ -588,6 +600,7 @@ func OrgDomains(ctx context.Context, filter preparation.FilterToQueryReducer, or
 		EventTypes(
 			org.OrgDomainVerifiedEventType,
 			org.OrgDomainRemovedEventType,
+			org.OrgDomainUnverifiedEventType,
 		).Builder())
 	if err != nil {
 		return nil, err
@@ -598,18 +611,24 @@ func OrgDomains(ctx context.Context, filter preparation.FilterToQueryReducer, or
 		case *org.DomainVerifiedEvent:
 			names = append(names, eventTyped.Domain)
 		case *org.DomainRemovedEvent:
-			for i := range names {
-				if names[i] == eventTyped.Domain {
-					names[i] = names[len(names)-1]
-					names = names[:len(names)-1]
-					break
-				}
-			}
+			names = removeOrgDomainName(names, eventTyped.Domain)
+		case *org.DomainUnverifiedEvent:
+			names = removeOrgDomainName(names, eventTyped.Domain)
 		}
 	}
 	return names, nil
 }
 
+func removeOrgDomainName(names []string, domain string) []string {
+	for i := range names {
+		if names[i] == domain {
+			names[i] = names[len(names)-1]
+			return names[:len(names)-1]
+		}
+	}
+	return names
+}
+
 type userIDName struct {
 	name string
 	id   string
@@ -685,6 +704,8 @@ func ExistsOrg(ctx context.Context, filter preparation.FilterToQueryReducer, id
 			org.OrgDeactivatedEventType,
 			org.OrgReactivatedEventType,
 			org.OrgRemovedEventType,
+			org.OrgArchivedEventType,
+			org.OrgUnarchivedEventType,
 		).Builder())
 	if err != nil {
 		return false, err
@@ -692,9 +713,9 @@ func ExistsOrg(ctx context.Context, filter preparation.FilterToQueryReducer, id
 
 	for _, event := range events {
 		switch event.(type) {
-		case *org.OrgAddedEvent, *org.OrgReactivatedEvent:
+		case *org.OrgAddedEvent, *org.OrgReactivatedEvent, *org.OrgUnarchivedEvent:
 			exists = true
-		case *org.OrgDeactivatedEvent, *org.OrgRemovedEvent:
+		case *org.OrgDeactivatedEvent, *org.OrgRemovedEvent, *org.OrgArchivedEvent:
 			exists = false
 		}
 	}
@@ -0,0 +1,134 @@
+package command
+
+import (
+	"context"
+	"slices"
+	"strings"
+
+	"github.com/zitadel/zitadel/internal/command/preparation"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/repository/org"
+	"github.com/zitadel/zitadel/internal/telemetry/tracing"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+// SetOrgAutoJoin enables users with a verified email of a verified domain of the organization
+// to automatically become members of the organization with the roles.
+func (c *Commands) SetOrgAutoJoin(ctx context.Context, orgID string, roles []string) (_ *domain.ObjectDetails, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	if orgID == "" {
+		return nil, zerrors.ThrowInvalidArgument(nil, "ORG-Aj1i", "Errors.ResourceOwnerMissing")
+	}
+	if len(roles) == 0 {
+		return nil, zerrors.ThrowInvalidArgument(nil, "ORG-Aj2e", "Errors.Org.AutoJoin.Invalid")
+	}
+	for i, role := range roles {
+		if role == "" || slices.Contains(roles[:i], role) {
+			return nil, zerrors.ThrowInvalidArgument(nil, "ORG-Aj3i", "Errors.Org.AutoJoin.Invalid")
+		}
+	}
+	configuredRoles, customRoles := domain.SplitOrgCustomRoles(roles)
+	if len(configuredRoles) > 0 && len(domain.CheckForInvalidRoles(configuredRoles, domain.OrgRolePrefix, c.zitadelRoles)) > 0 && len(domain.CheckForInvalidRoles(configuredRoles, domain.RoleSelfManagementGlobal, c.zitadelRoles)) > 0 {
+		return nil, zerrors.ThrowInvalidArgument(nil, "ORG-Aj4i", "Errors.Org.MemberInvalid")
+	}
+	if err = c.checkOrgExists(ctx, orgID); err != nil {
+		return nil, err
+	}
+	if err = checkOrgCustomRoles(ctx, c.eventstore.Filter, orgID, customRoles); err != nil {
+		return nil, err
+	}
+	writeModel := NewOrgAutoJoinWriteModel(orgID)
+	if err = c.eventstore.FilterToQueryReducer(ctx, writeModel); err != nil {
+		return nil, err
+	}
+	if writeModel.State.Exists() && slices.Equal(writeModel.Roles, roles) {
+		return nil, zerrors.ThrowPreconditionFailed(nil, "ORG-Aj5c", "Errors.Org.AutoJoin.NotChanged")
+	}
+	if err = c.pushAppendAndReduce(ctx, writeModel,
+		org.NewAutoJoinSetEvent(ctx, &org.NewAggregate(orgID).Aggregate, roles),
+	); err != nil {
+		return nil, err
+	}
+	return writeModelToObjectDetails(&writeModel.WriteModel), nil
+}
+
+// RemoveOrgAutoJoin disables the automatic membership of the organization.
+// Users who already joined stay members.
+func (c *Commands) RemoveOrgAutoJoin(ctx context.Context, orgID string) (_ *domain.ObjectDetails, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	if orgID == "" {
+		return nil, zerrors.ThrowInvalidArgument(nil, "ORG-Aj6i", "Errors.ResourceOwnerMissing")
+	}
+	writeModel := NewOrgAutoJoinWriteModel(orgID)
+	if err = c.eventstore.FilterToQueryReducer(ctx, writeModel); err != nil {
+		return nil, err
+	}
+	if !writeModel.State.Exists() {
+		return nil, zerrors.ThrowNotFound(nil, "ORG-Aj7n", "Errors.Org.AutoJoin.NotFound")
+	}
+	if err = c.pushAppendAndReduce(ctx, writeModel,
+		org.NewAutoJoinRemovedEvent(ctx, &org.NewAggregate(orgID).Aggregate),
+	); err != nil {
+		return nil, err
+	}
+	return writeModelToObjectDetails(&writeModel.WriteModel), nil
+}
+
+// AutoJoinOrg adds the human user as member to the organization owning the domain of its verified email,
+// if the organization enabled the automatic membership.
+// If the user doesn't qualify or is already a member, no member is returned.
+func (c *Commands) AutoJoinOrg(ctx context.Context, userID, resourceOwner string) (_ *domain.Member, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	if userID == "" {
+		return nil, zerrors.ThrowInvalidArgument(nil, "ORG-Aj8i", "Errors.User.UserIDMissing")
+	}
+	email, err := c.emailWriteModel(ctx, userID, resourceOwner)
+	if err != nil {
+		return nil, err
+	}
+	// only the verification of the email proves that the user belongs to the domain
+	if !email.UserState.Exists() || !email.IsEmailVerified {
+		return nil, nil
+	}
+	index := strings.LastIndex(string(email.Email), "@")
+	if index < 0 {
+		return nil, nil
+	}
+	orgDomain, err := c.searchOrgDomainVerifiedByDomain(ctx, strings.ToLower(string(email.Email[index+1:])))
+	if err != nil {
+		return nil, err
+	}
+	if !orgDomain.Verified || orgDomain.OrgID == "" {
+		return nil, nil
+	}
+	autoJoin := NewOrgAutoJoinWriteModel(orgDomain.OrgID)
+	if err = c.eventstore.FilterToQueryReducer(ctx, autoJoin); err != nil {
+		return nil, err
+	}
+	if !autoJoin.State.Exists() {
+		return nil, nil
+	}
+	isMember, err := IsOrgMember(ctx, c.eventstore.Filter, orgDomain.OrgID, userID)
+	if err != nil || isMember {
+		return nil, err
+	}
+	cmds, err := preparation.PrepareCommands(ctx, c.eventstore.Filter, c.AddOrgMemberCommand(org.NewAggregate(orgDomain.OrgID), userID, autoJoin.Roles...))
+	if err != nil {
+		return nil, err
+	}
+	events, err := c.eventstore.Push(ctx, cmds...)
+	if err != nil {
+		return nil, err
+	}
+	addedMember := NewOrgMemberWriteModel(orgDomain.OrgID, userID)
+	if err = AppendAndReduce(addedMember, events...); err != nil {
+		return nil, err
+	}
+	return memberWriteModelToMember(&addedMember.MemberWriteModel), nil
+}
@@ -0,0 +1,51 @@
+package command
+
+import (
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/repository/org"
+)
+
+type OrgAutoJoinWriteModel struct {
+	eventstore.WriteModel
+
+	State domain.PolicyState
+	Roles []string
+}
+
+func NewOrgAutoJoinWriteModel(orgID string) *OrgAutoJoinWriteModel {
+	return &OrgAutoJoinWriteModel{
+		WriteModel: eventstore.WriteModel{
+			AggregateID:   orgID,
+			ResourceOwner: orgID,
+		},
+	}
+}
+
+func (wm *OrgAutoJoinWriteModel) Reduce() error {
+	for _, event := range wm.Events {
+		switch e := event.(type) {
+		case *org.AutoJoinSetEvent:
+			wm.State = domain.PolicyStateActive
+			wm.Roles = e.Roles
+		case *org.AutoJoinRemovedEvent, *org.OrgRemovedEvent:
+			wm.State = domain.PolicyStateRemoved
+			wm.Roles = nil
+		}
+	}
+	return wm.WriteModel.Reduce()
+}
+
+func (wm *OrgAutoJoinWriteModel) Query() *eventstore.SearchQueryBuilder {
+	return eventstore.NewSearchQueryBuilder(eventstore.ColumnsEvent).
+		ResourceOwner(wm.ResourceOwner).
+		AddQuery().
+		AggregateTypes(org.AggregateType).
+		AggregateIDs(wm.AggregateID).
+		EventTypes(
+			org.AutoJoinSetEventType,
+			org.AutoJoinRemovedEventType,
+			org.OrgRemovedEventType,
+		).
+		Builder()
+}
@@ -0,0 +1,389 @@
+package command
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/text/language"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/eventstore/v1/models"
+	"github.com/zitadel/zitadel/internal/repository/org"
+	"github.com/zitadel/zitadel/internal/repository/user"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+func TestCommandSide_SetOrgAutoJoin(t *testing.T) {
+	type fields struct {
+		eventstore func(t *testing.T) *eventstore.Eventstore
+	}
+	type args struct {
+		ctx   context.Context
+		orgID string
+		roles []string
+	}
+	type res struct {
+		want *domain.ObjectDetails
+		err  error
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		args   args
+		res    res
+	}{
+		{
+			name: "no roles, invalid argument error",
+			fields: fields{
+				eventstore: expectEventstore(),
+			},
+			args: args{
+				ctx:   context.Background(),
+				orgID: "org1",
+			},
+			res: res{
+				err: zerrors.ThrowInvalidArgument(nil, "ORG-Aj2e", "Errors.Org.AutoJoin.Invalid"),
+			},
+		},
+		{
+			name: "duplicate role, invalid argument error",
+			fields: fields{
+				eventstore: expectEventstore(),
+			},
+			args: args{
+				ctx:   context.Background(),
+				orgID: "org1",
+				roles: []string{"ORG_USER_SELF_MANAGER", "ORG_USER_SELF_MANAGER"},
+			},
+			res: res{
+				err: zerrors.ThrowInvalidArgument(nil, "ORG-Aj3i", "Errors.Org.AutoJoin.Invalid"),
+			},
+		},
+		{
+			name: "unknown role, invalid argument error",
+			fields: fields{
+				eventstore: expectEventstore(),
+			},
+			args: args{
+				ctx:   context.Background(),
+				orgID: "org1",
+				roles: []string{"IAM_OWNER"},
+			},
+			res: res{
+				err: zerrors.ThrowInvalidArgument(nil, "ORG-Aj4i", "Errors.Org.MemberInvalid"),
+			},
+		},
+		{
+			name: "org not found, precondition error",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(),
+				),
+			},
+			args: args{
+				ctx:   context.Background(),
+				orgID: "org1",
+				roles: []string{"ORG_USER_SELF_MANAGER"},
+			},
+			res: res{
+				err: zerrors.ThrowPreconditionFailed(nil, "COMMAND-QXPGs", "Errors.Org.NotFound"),
+			},
+		},
+		{
+			name: "not changed, precondition error",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(
+							org.NewOrgAddedEvent(context.Background(), &org.NewAggregate("org1").Aggregate, "org"),
+						),
+					),
+					expectFilter(
+						eventFromEventPusher(
+							org.NewAutoJoinSetEvent(context.Background(), &org.NewAggregate("org1").Aggregate, []string{"ORG_USER_SELF_MANAGER"}),
+						),
+					),
+				),
+			},
+			args: args{
+				ctx:   context.Background(),
+				orgID: "org1",
+				roles: []string{"ORG_USER_SELF_MANAGER"},
+			},
+			res: res{
+				err: zerrors.ThrowPreconditionFailed(nil, "ORG-Aj5c", "Errors.Org.AutoJoin.NotChanged"),
+			},
+		},
+		{
+			name: "set auto join, ok",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(
+							org.NewOrgAddedEvent(context.Background(), &org.NewAggregate("org1").Aggregate, "org"),
+						),
+					),
+					expectFilter(),
+					expectPush(
+						org.NewAutoJoinSetEvent(context.Background(), &org.NewAggregate("org1").Aggregate, []string{"ORG_USER_SELF_MANAGER"}),
+					),
+				),
+			},
+			args: args{
+				ctx:   context.Background(),
+				orgID: "org1",
+				roles: []string{"ORG_USER_SELF_MANAGER"},
+			},
+			res: res{
+				want: &domain.ObjectDetails{
+					ResourceOwner: "org1",
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := &Commands{
+				eventstore: tt.fields.eventstore(t),
+				zitadelRoles: []authz.RoleMapping{
+					{Role: "ORG_USER_SELF_MANAGER"},
+					{Role: "IAM_OWNER"},
+				},
+			}
+			got, err := r.SetOrgAutoJoin(tt.args.ctx, tt.args.orgID, tt.args.roles)
+			assert.ErrorIs(t, err, tt.res.err)
+			assert.Equal(t, tt.res.want, got)
+		})
+	}
+}
+
+func TestCommandSide_RemoveOrgAutoJoin(t *testing.T) {
+	type fields struct {
+		eventstore func(t *testing.T) *eventstore.Eventstore
+	}
+	type args struct {
+		ctx   context.Context
+		orgID string
+	}
+	type res struct {
+		want *domain.ObjectDetails
+		err  error
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		args   args
+		res    res
+	}{
+		{
+			name: "not found, not found error",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(),
+				),
+			},
+			args: args{
+				ctx:   context.Background(),
+				orgID: "org1",
+			},
+			res: res{
+				err: zerrors.ThrowNotFound(nil, "ORG-Aj7n", "Errors.Org.AutoJoin.NotFound"),
+			},
+		},
+		{
+			name: "remove auto join, ok",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(
+							org.NewAutoJoinSetEvent(context.Background(), &org.NewAggregate("org1").Aggregate, []string{"ORG_USER_SELF_MANAGER"}),
+						),
+					),
+					expectPush(
+						org.NewAutoJoinRemovedEvent(context.Background(), &org.NewAggregate("org1").Aggregate),
+					),
+				),
+			},
+			args: args{
+				ctx:   context.Background(),
+				orgID: "org1",
+			},
+			res: res{
+				want: &domain.ObjectDetails{
+					ResourceOwner: "org1",
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := &Commands{
+				eventstore: tt.fields.eventstore(t),
+			}
+			got, err := r.RemoveOrgAutoJoin(tt.args.ctx, tt.args.orgID)
+			assert.ErrorIs(t, err, tt.res.err)
+			assert.Equal(t, tt.res.want, got)
+		})
+	}
+}
+
+func TestCommandSide_AutoJoinOrg(t *testing.T) {
+	userAgg := &user.NewAggregate("user1", "org1").Aggregate
+	type fields struct {
+		eventstore func(t *testing.T) *eventstore.Eventstore
+	}
+	type res struct {
+		want *domain.Member
+		err  error
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		res    res
+	}{
+		{
+			name: "email not verified, no member",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(
+							newAddHumanEvent("", false, true, "", language.English),
+						),
+					),
+				),
+			},
+			res: res{},
+		},
+		{
+			name: "domain not verified, no member",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(
+							newAddHumanEvent("", false, true, "", language.English),
+						),
+						eventFromEventPusher(
+							user.NewHumanEmailVerifiedEvent(context.Background(), userAgg),
+						),
+					),
+					expectFilter(),
+				),
+			},
+			res: res{},
+		},
+		{
+			name: "auto join not enabled, no member",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(
+							newAddHumanEvent("", false, true, "", language.English),
+						),
+						eventFromEventPusher(
+							user.NewHumanEmailVerifiedEvent(context.Background(), userAgg),
+						),
+					),
+					expectFilter(
+						eventFromEventPusher(
+							org.NewDomainVerifiedEvent(context.Background(), &org.NewAggregate("org2").Aggregate, "test.ch"),
+						),
+					),
+					expectFilter(),
+				),
+			},
+			res: res{},
+		},
+		{
+			name: "already member, no member",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(
+							newAddHumanEvent("", false, true, "", language.English),
+						),
+						eventFromEventPusher(
+							user.NewHumanEmailVerifiedEvent(context.Background(), userAgg),
+						),
+					),
+					expectFilter(
+						eventFromEventPusher(
+							org.NewDomainVerifiedEvent(context.Background(), &org.NewAggregate("org2").Aggregate, "test.ch"),
+						),
+					),
+					expectFilter(
+						eventFromEventPusher(
+							org.NewAutoJoinSetEvent(context.Background(), &org.NewAggregate("org2").Aggregate, []string{"ORG_USER_SELF_MANAGER"}),
+						),
+					),
+					expectFilter(
+						eventFromEventPusher(
+							org.NewMemberAddedEvent(context.Background(), &org.NewAggregate("org2").Aggregate, "user1", "ORG_OWNER"),
+						),
+					),
+				),
+			},
+			res: res{},
+		},
+		{
+			name: "join org, ok",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(
+							newAddHumanEvent("", false, true, "", language.English),
+						),
+						eventFromEventPusher(
+							user.NewHumanEmailVerifiedEvent(context.Background(), userAgg),
+						),
+					),
+					expectFilter(
+						eventFromEventPusher(
+							org.NewDomainVerifiedEvent(context.Background(), &org.NewAggregate("org2").Aggregate, "test.ch"),
+						),
+					),
+					expectFilter(
+						eventFromEventPusher(
+							org.NewAutoJoinSetEvent(context.Background(), &org.NewAggregate("org2").Aggregate, []string{"ORG_USER_SELF_MANAGER"}),
+						),
+					),
+					expectFilter(),
+					expectFilter(
+						eventFromEventPusher(
+							newAddHumanEvent("", false, true, "", language.English),
+						),
+					),
+					expectFilter(),
+					expectPush(
+						org.NewMemberAddedEvent(context.Background(), &org.NewAggregate("org2").Aggregate, "user1", "ORG_USER_SELF_MANAGER"),
+					),
+				),
+			},
+			res: res{
+				want: &domain.Member{
+					ObjectRoot: models.ObjectRoot{
+						AggregateID:   "org2",
+						ResourceOwner: "org2",
+					},
+					UserID: "user1",
+					Roles:  []string{"ORG_USER_SELF_MANAGER"},
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := &Commands{
+				eventstore: tt.fields.eventstore(t),
+				zitadelRoles: []authz.RoleMapping{
+					{Role: "ORG_USER_SELF_MANAGER"},
+				},
+			}
+			got, err := r.AutoJoinOrg(context.Background(), "user1", "org1")
+			assert.ErrorIs(t, err, tt.res.err)
+			assert.Equal(t, tt.res.want, got)
+		})
+	}
+}
@@ -63,6 +63,10 @@ func (wm *OrgCustomLoginTextReadModel) Query() *eventstore.SearchQueryBuilder {
 		Builder()
 }
 
+func (wm *OrgCustomLoginTextReadModel) SnapshotType() string {
+	return "org_custom_login_text.v1." + wm.Language.String()
+}
+
 type OrgCustomLoginTextsReadModel struct {
 	CustomLoginTextsReadModel
 }
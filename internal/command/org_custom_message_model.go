@@ -55,6 +55,10 @@ func (wm *OrgCustomMessageTextReadModel) Query() *eventstore.SearchQueryBuilder
 		Builder()
 }
 
+func (wm *OrgCustomMessageTextReadModel) SnapshotType() string {
+	return "org_custom_message_text.v1." + wm.MessageTextType + "." + wm.Language.String()
+}
+
 type OrgCustomMessageTemplatesReadModel struct {
 	CustomMessageTemplatesReadModel
 }
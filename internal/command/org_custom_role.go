@@ -0,0 +1,179 @@
+package command
+
+import (
+	"context"
+	"regexp"
+	"slices"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/command/preparation"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/repository/org"
+	"github.com/zitadel/zitadel/internal/telemetry/tracing"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+var customRoleKeyRegex = regexp.MustCompile(`^` + domain.OrgCustomRolePrefix + `[A-Z0-9_]{1,100}$`)
+
+// CustomRole is a role defined by an organization, which grants a set of permissions to its members.
+type CustomRole struct {
+	Key         string
+	DisplayName string
+	Permissions []string
+}
+
+// validate checks the key of the role and that the permissions are a subset of the permissions of an organization owner,
+// so the role can't grant more than the organization owner is allowed to.
+func (r *CustomRole) validate(roleMappings []authz.RoleMapping) error {
+	if !customRoleKeyRegex.MatchString(r.Key) {
+		return zerrors.ThrowInvalidArgument(nil, "COMMAND-Cr2kv", "Errors.Org.CustomRole.KeyInvalid")
+	}
+	if len(r.Permissions) == 0 {
+		return zerrors.ThrowInvalidArgument(nil, "COMMAND-Cr4pm", "Errors.Org.CustomRole.PermissionsMissing")
+	}
+	allowed := domain.RolePermissions(domain.RoleOrgOwner, roleMappings)
+	for _, permission := range r.Permissions {
+		if !slices.Contains(allowed, permission) {
+			return zerrors.ThrowInvalidArgument(nil, "COMMAND-Cr6pi", "Errors.Org.CustomRole.PermissionInvalid")
+		}
+	}
+	return nil
+}
+
+// normalizePermissions sorts the permissions and removes duplicates, so changes can be detected.
+func normalizePermissions(permissions []string) []string {
+	permissions = slices.Clone(permissions)
+	slices.Sort(permissions)
+	return slices.Compact(permissions)
+}
+
+func (c *Commands) AddOrgCustomRole(ctx context.Context, orgID string, role *CustomRole) (_ *domain.ObjectDetails, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	if orgID == "" {
+		return nil, zerrors.ThrowInvalidArgument(nil, "COMMAND-Cr1oi", "Errors.Org.Empty")
+	}
+	role.Permissions = normalizePermissions(role.Permissions)
+	if err = role.validate(c.zitadelRoles); err != nil {
+		return nil, err
+	}
+	writeModel, err := c.orgCustomRolesWriteModel(ctx, orgID)
+	if err != nil {
+		return nil, err
+	}
+	if _, ok := writeModel.Roles[role.Key]; ok {
+		return nil, zerrors.ThrowAlreadyExists(nil, "COMMAND-Cr3ae", "Errors.Org.CustomRole.AlreadyExists")
+	}
+	if err = c.pushAppendAndReduce(ctx, writeModel, org.NewCustomRoleAddedEvent(
+		ctx,
+		&org.NewAggregate(orgID).Aggregate,
+		role.Key,
+		role.DisplayName,
+		role.Permissions,
+	)); err != nil {
+		return nil, err
+	}
+	return writeModelToObjectDetails(&writeModel.WriteModel), nil
+}
+
+func (c *Commands) ChangeOrgCustomRole(ctx context.Context, orgID string, role *CustomRole) (_ *domain.ObjectDetails, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	if orgID == "" {
+		return nil, zerrors.ThrowInvalidArgument(nil, "COMMAND-Cr5oi", "Errors.Org.Empty")
+	}
+	role.Permissions = normalizePermissions(role.Permissions)
+	if err = role.validate(c.zitadelRoles); err != nil {
+		return nil, err
+	}
+	writeModel, err := c.orgCustomRolesWriteModel(ctx, orgID)
+	if err != nil {
+		return nil, err
+	}
+	existing, ok := writeModel.Roles[role.Key]
+	if !ok {
+		return nil, zerrors.ThrowNotFound(nil, "COMMAND-Cr7nf", "Errors.Org.CustomRole.NotFound")
+	}
+	changes := make([]org.CustomRoleChanges, 0, 2)
+	if existing.DisplayName != role.DisplayName {
+		changes = append(changes, org.ChangeCustomRoleDisplayName(role.DisplayName))
+	}
+	if !slices.Equal(existing.Permissions, role.Permissions) {
+		changes = append(changes, org.ChangeCustomRolePermissions(role.Permissions))
+	}
+	changedEvent, err := org.NewCustomRoleChangedEvent(ctx, &org.NewAggregate(orgID).Aggregate, role.Key, changes)
+	if err != nil {
+		return nil, err
+	}
+	if err = c.pushAppendAndReduce(ctx, writeModel, changedEvent); err != nil {
+		return nil, err
+	}
+	return writeModelToObjectDetails(&writeModel.WriteModel), nil
+}
+
+// RemoveOrgCustomRole removes the custom role and takes it away from the members of the organization.
+// Members without any other role are removed.
+func (c *Commands) RemoveOrgCustomRole(ctx context.Context, orgID, key string) (_ *domain.ObjectDetails, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	if orgID == "" || key == "" {
+		return nil, zerrors.ThrowInvalidArgument(nil, "COMMAND-Cr8ia", "Errors.IDMissing")
+	}
+	writeModel, err := c.orgCustomRolesWriteModel(ctx, orgID)
+	if err != nil {
+		return nil, err
+	}
+	if _, ok := writeModel.Roles[key]; !ok {
+		return nil, zerrors.ThrowNotFound(nil, "COMMAND-Cr9nf", "Errors.Org.CustomRole.NotFound")
+	}
+	orgAgg := &org.NewAggregate(orgID).Aggregate
+	events := []eventstore.Command{org.NewCustomRoleRemovedEvent(ctx, orgAgg, key)}
+	members := writeModel.membersWithRole(key)
+	userIDs := make([]string, 0, len(members))
+	for userID := range members {
+		userIDs = append(userIDs, userID)
+	}
+	// sorted for a deterministic order of the events
+	slices.Sort(userIDs)
+	for _, userID := range userIDs {
+		if len(members[userID]) == 0 {
+			events = append(events, org.NewMemberRemovedEvent(ctx, orgAgg, userID))
+			continue
+		}
+		events = append(events, org.NewMemberChangedEvent(ctx, orgAgg, userID, members[userID]...))
+	}
+	if err = c.pushAppendAndReduce(ctx, writeModel, events...); err != nil {
+		return nil, err
+	}
+	return writeModelToObjectDetails(&writeModel.WriteModel), nil
+}
+
+func (c *Commands) orgCustomRolesWriteModel(ctx context.Context, orgID string) (_ *OrgCustomRolesWriteModel, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	writeModel := NewOrgCustomRolesWriteModel(orgID)
+	if err = c.eventstore.FilterToQueryReducer(ctx, writeModel); err != nil {
+		return nil, err
+	}
+	return writeModel, nil
+}
+
+// checkOrgCustomRoles checks that the custom roles are defined in the organization.
+func checkOrgCustomRoles(ctx context.Context, filter preparation.FilterToQueryReducer, orgID string, roles []string) error {
+	if len(roles) == 0 {
+		return nil
+	}
+	writeModel := NewOrgCustomRolesWriteModel(orgID)
+	if err := queryAndReduce(ctx, filter, writeModel); err != nil {
+		return err
+	}
+	if len(writeModel.missingRoles(roles)) > 0 {
+		return zerrors.ThrowPreconditionFailed(nil, "COMMAND-Cr0nf", "Errors.Org.CustomRole.NotFound")
+	}
+	return nil
+}
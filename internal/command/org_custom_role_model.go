@@ -0,0 +1,106 @@
+package command
+
+import (
+	"slices"
+
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/repository/org"
+)
+
+// OrgCustomRolesWriteModel contains the custom roles of an organization
+// and the roles of its members, so members can be updated when a custom role is removed.
+type OrgCustomRolesWriteModel struct {
+	eventstore.WriteModel
+
+	Roles       map[string]*CustomRole
+	MemberRoles map[string][]string
+}
+
+func NewOrgCustomRolesWriteModel(orgID string) *OrgCustomRolesWriteModel {
+	return &OrgCustomRolesWriteModel{
+		WriteModel: eventstore.WriteModel{
+			AggregateID:   orgID,
+			ResourceOwner: orgID,
+		},
+		Roles:       make(map[string]*CustomRole),
+		MemberRoles: make(map[string][]string),
+	}
+}
+
+func (wm *OrgCustomRolesWriteModel) Reduce() error {
+	for _, event := range wm.Events {
+		switch e := event.(type) {
+		case *org.CustomRoleAddedEvent:
+			wm.Roles[e.Key] = &CustomRole{
+				Key:         e.Key,
+				DisplayName: e.DisplayName,
+				Permissions: e.Permissions,
+			}
+		case *org.CustomRoleChangedEvent:
+			role, ok := wm.Roles[e.Key]
+			if !ok {
+				continue
+			}
+			if e.DisplayName != nil {
+				role.DisplayName = *e.DisplayName
+			}
+			if e.Permissions != nil {
+				role.Permissions = e.Permissions
+			}
+		case *org.CustomRoleRemovedEvent:
+			delete(wm.Roles, e.Key)
+		case *org.MemberAddedEvent:
+			wm.MemberRoles[e.UserID] = e.Roles
+		case *org.MemberChangedEvent:
+			wm.MemberRoles[e.UserID] = e.Roles
+		case *org.MemberRemovedEvent:
+			delete(wm.MemberRoles, e.UserID)
+		case *org.MemberCascadeRemovedEvent:
+			delete(wm.MemberRoles, e.UserID)
+		}
+	}
+	return wm.WriteModel.Reduce()
+}
+
+func (wm *OrgCustomRolesWriteModel) Query() *eventstore.SearchQueryBuilder {
+	return eventstore.NewSearchQueryBuilder(eventstore.ColumnsEvent).
+		ResourceOwner(wm.ResourceOwner).
+		AddQuery().
+		AggregateTypes(org.AggregateType).
+		AggregateIDs(wm.AggregateID).
+		EventTypes(
+			org.CustomRoleAddedEventType,
+			org.CustomRoleChangedEventType,
+			org.CustomRoleRemovedEventType,
+			org.MemberAddedEventType,
+			org.MemberChangedEventType,
+			org.MemberRemovedEventType,
+			org.MemberCascadeRemovedEventType,
+		).
+		Builder()
+}
+
+// missingRoles returns the passed roles, which are not defined in the organization.
+func (wm *OrgCustomRolesWriteModel) missingRoles(roles []string) []string {
+	missing := make([]string, 0)
+	for _, role := range roles {
+		if _, ok := wm.Roles[role]; !ok {
+			missing = append(missing, role)
+		}
+	}
+	return missing
+}
+
+// membersWithRole returns the remaining roles of the members holding the role, without the role.
+func (wm *OrgCustomRolesWriteModel) membersWithRole(role string) map[string][]string {
+	members := make(map[string][]string)
+	for userID, roles := range wm.MemberRoles {
+		if !slices.Contains(roles, role) {
+			continue
+		}
+		members[userID] = slices.DeleteFunc(slices.Clone(roles), func(r string) bool {
+			return r == role
+		})
+	}
+	return members
+}
@@ -0,0 +1,377 @@
+package command
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/repository/org"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+var customRoleTestRoles = []authz.RoleMapping{
+	{
+		Role:        domain.RoleOrgOwner,
+		Permissions: []string{"org.read", "user.read", "user.write", "user.credential.write"},
+	},
+}
+
+func TestCommandSide_AddOrgCustomRole(t *testing.T) {
+	type fields struct {
+		eventstore func(t *testing.T) *eventstore.Eventstore
+	}
+	type args struct {
+		orgID string
+		role  *CustomRole
+	}
+	type res struct {
+		want *domain.ObjectDetails
+		err  func(error) bool
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		args   args
+		res    res
+	}{
+		{
+			name: "invalid key, invalid argument error",
+			fields: fields{
+				eventstore: expectEventstore(),
+			},
+			args: args{
+				orgID: "org1",
+				role: &CustomRole{
+					Key:         "ORG_OWNER",
+					Permissions: []string{"user.read"},
+				},
+			},
+			res: res{
+				err: zerrors.IsErrorInvalidArgument,
+			},
+		},
+		{
+			name: "permissions missing, invalid argument error",
+			fields: fields{
+				eventstore: expectEventstore(),
+			},
+			args: args{
+				orgID: "org1",
+				role: &CustomRole{
+					Key: "ORG_CUSTOM_HELPDESK",
+				},
+			},
+			res: res{
+				err: zerrors.IsErrorInvalidArgument,
+			},
+		},
+		{
+			name: "permission not granted to org owners, invalid argument error",
+			fields: fields{
+				eventstore: expectEventstore(),
+			},
+			args: args{
+				orgID: "org1",
+				role: &CustomRole{
+					Key:         "ORG_CUSTOM_HELPDESK",
+					Permissions: []string{"user.read", "iam.write"},
+				},
+			},
+			res: res{
+				err: zerrors.IsErrorInvalidArgument,
+			},
+		},
+		{
+			name: "role already exists, already exists error",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(
+							org.NewCustomRoleAddedEvent(context.Background(), &org.NewAggregate("org1").Aggregate,
+								"ORG_CUSTOM_HELPDESK", "Helpdesk", []string{"user.read"},
+							),
+						),
+					),
+				),
+			},
+			args: args{
+				orgID: "org1",
+				role: &CustomRole{
+					Key:         "ORG_CUSTOM_HELPDESK",
+					Permissions: []string{"user.read"},
+				},
+			},
+			res: res{
+				err: zerrors.IsErrorAlreadyExists,
+			},
+		},
+		{
+			name: "add role, ok",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(),
+					expectPush(
+						org.NewCustomRoleAddedEvent(context.Background(), &org.NewAggregate("org1").Aggregate,
+							"ORG_CUSTOM_HELPDESK", "Helpdesk", []string{"user.credential.write", "user.read"},
+						),
+					),
+				),
+			},
+			args: args{
+				orgID: "org1",
+				role: &CustomRole{
+					Key:         "ORG_CUSTOM_HELPDESK",
+					DisplayName: "Helpdesk",
+					Permissions: []string{"user.read", "user.credential.write", "user.read"},
+				},
+			},
+			res: res{
+				want: &domain.ObjectDetails{
+					ResourceOwner: "org1",
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := &Commands{
+				eventstore:   tt.fields.eventstore(t),
+				zitadelRoles: customRoleTestRoles,
+			}
+			got, err := r.AddOrgCustomRole(context.Background(), tt.args.orgID, tt.args.role)
+			if tt.res.err == nil {
+				assert.NoError(t, err)
+			}
+			if tt.res.err != nil && !tt.res.err(err) {
+				t.Errorf("got wrong err: %v ", err)
+			}
+			if tt.res.err == nil {
+				assert.Equal(t, tt.res.want, got)
+			}
+		})
+	}
+}
+
+func TestCommandSide_ChangeOrgCustomRole(t *testing.T) {
+	type fields struct {
+		eventstore func(t *testing.T) *eventstore.Eventstore
+	}
+	type args struct {
+		orgID string
+		role  *CustomRole
+	}
+	type res struct {
+		want *domain.ObjectDetails
+		err  func(error) bool
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		args   args
+		res    res
+	}{
+		{
+			name: "role not existing, not found error",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(),
+				),
+			},
+			args: args{
+				orgID: "org1",
+				role: &CustomRole{
+					Key:         "ORG_CUSTOM_HELPDESK",
+					Permissions: []string{"user.read"},
+				},
+			},
+			res: res{
+				err: zerrors.IsNotFound,
+			},
+		},
+		{
+			name: "no changes, precondition error",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(
+							org.NewCustomRoleAddedEvent(context.Background(), &org.NewAggregate("org1").Aggregate,
+								"ORG_CUSTOM_HELPDESK", "Helpdesk", []string{"user.read"},
+							),
+						),
+					),
+				),
+			},
+			args: args{
+				orgID: "org1",
+				role: &CustomRole{
+					Key:         "ORG_CUSTOM_HELPDESK",
+					DisplayName: "Helpdesk",
+					Permissions: []string{"user.read"},
+				},
+			},
+			res: res{
+				err: zerrors.IsPreconditionFailed,
+			},
+		},
+		{
+			name: "change permissions, ok",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(
+							org.NewCustomRoleAddedEvent(context.Background(), &org.NewAggregate("org1").Aggregate,
+								"ORG_CUSTOM_HELPDESK", "Helpdesk", []string{"user.read"},
+							),
+						),
+					),
+					expectPush(
+						func() eventstore.Command {
+							event, _ := org.NewCustomRoleChangedEvent(context.Background(), &org.NewAggregate("org1").Aggregate,
+								"ORG_CUSTOM_HELPDESK",
+								[]org.CustomRoleChanges{org.ChangeCustomRolePermissions([]string{"user.credential.write", "user.read"})},
+							)
+							return event
+						}(),
+					),
+				),
+			},
+			args: args{
+				orgID: "org1",
+				role: &CustomRole{
+					Key:         "ORG_CUSTOM_HELPDESK",
+					DisplayName: "Helpdesk",
+					Permissions: []string{"user.read", "user.credential.write"},
+				},
+			},
+			res: res{
+				want: &domain.ObjectDetails{
+					ResourceOwner: "org1",
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := &Commands{
+				eventstore:   tt.fields.eventstore(t),
+				zitadelRoles: customRoleTestRoles,
+			}
+			got, err := r.ChangeOrgCustomRole(context.Background(), tt.args.orgID, tt.args.role)
+			if tt.res.err == nil {
+				assert.NoError(t, err)
+			}
+			if tt.res.err != nil && !tt.res.err(err) {
+				t.Errorf("got wrong err: %v ", err)
+			}
+			if tt.res.err == nil {
+				assert.Equal(t, tt.res.want, got)
+			}
+		})
+	}
+}
+
+func TestCommandSide_RemoveOrgCustomRole(t *testing.T) {
+	type fields struct {
+		eventstore func(t *testing.T) *eventstore.Eventstore
+	}
+	type args struct {
+		orgID string
+		key   string
+	}
+	type res struct {
+		want *domain.ObjectDetails
+		err  func(error) bool
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		args   args
+		res    res
+	}{
+		{
+			name: "role not existing, not found error",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(),
+				),
+			},
+			args: args{
+				orgID: "org1",
+				key:   "ORG_CUSTOM_HELPDESK",
+			},
+			res: res{
+				err: zerrors.IsNotFound,
+			},
+		},
+		{
+			name: "remove role and take it away from members, ok",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(
+							org.NewCustomRoleAddedEvent(context.Background(), &org.NewAggregate("org1").Aggregate,
+								"ORG_CUSTOM_HELPDESK", "Helpdesk", []string{"user.read"},
+							),
+						),
+						eventFromEventPusher(
+							org.NewMemberAddedEvent(context.Background(), &org.NewAggregate("org1").Aggregate,
+								"user1", "ORG_CUSTOM_HELPDESK",
+							),
+						),
+						eventFromEventPusher(
+							org.NewMemberAddedEvent(context.Background(), &org.NewAggregate("org1").Aggregate,
+								"user2", "ORG_OWNER_VIEWER", "ORG_CUSTOM_HELPDESK",
+							),
+						),
+						eventFromEventPusher(
+							org.NewMemberAddedEvent(context.Background(), &org.NewAggregate("org1").Aggregate,
+								"user3", "ORG_OWNER",
+							),
+						),
+					),
+					expectPush(
+						org.NewCustomRoleRemovedEvent(context.Background(), &org.NewAggregate("org1").Aggregate,
+							"ORG_CUSTOM_HELPDESK",
+						),
+						org.NewMemberRemovedEvent(context.Background(), &org.NewAggregate("org1").Aggregate,
+							"user1",
+						),
+						org.NewMemberChangedEvent(context.Background(), &org.NewAggregate("org1").Aggregate,
+							"user2", "ORG_OWNER_VIEWER",
+						),
+					),
+				),
+			},
+			args: args{
+				orgID: "org1",
+				key:   "ORG_CUSTOM_HELPDESK",
+			},
+			res: res{
+				want: &domain.ObjectDetails{
+					ResourceOwner: "org1",
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := &Commands{
+				eventstore: tt.fields.eventstore(t),
+			}
+			got, err := r.RemoveOrgCustomRole(context.Background(), tt.args.orgID, tt.args.key)
+			if tt.res.err == nil {
+				assert.NoError(t, err)
+			}
+			if tt.res.err != nil && !tt.res.err(err) {
+				t.Errorf("got wrong err: %v ", err)
+			}
+			if tt.res.err == nil {
+				assert.Equal(t, tt.res.want, got)
+			}
+		})
+	}
+}
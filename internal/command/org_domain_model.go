@@ -52,6 +52,11 @@ func (wm *OrgDomainWriteModel) AppendEvents(events ...eventstore.Event) {
 				continue
 			}
 			wm.WriteModel.AppendEvents(e)
+		case *org.DomainUnverifiedEvent:
+			if e.Domain != wm.Domain {
+				continue
+			}
+			wm.WriteModel.AppendEvents(e)
 		case *org.DomainPrimarySetEvent:
 			wm.WriteModel.AppendEvents(e)
 		case *org.DomainRemovedEvent:
@@ -74,6 +79,8 @@ func (wm *OrgDomainWriteModel) Reduce() error {
 			wm.ValidationCode = e.ValidationCode
 		case *org.DomainVerifiedEvent:
 			wm.Verified = true
+		case *org.DomainUnverifiedEvent:
+			wm.Verified = false
 		case *org.DomainPrimarySetEvent:
 			wm.Primary = e.Domain == wm.Domain
 		case *org.DomainRemovedEvent:
@@ -99,7 +106,8 @@ func (wm *OrgDomainWriteModel) Query() *eventstore.SearchQueryBuilder {
 			org.OrgDomainVerificationAddedEventType,
 			org.OrgDomainVerifiedEventType,
 			org.OrgDomainPrimarySetEventType,
-			org.OrgDomainRemovedEventType).
+			org.OrgDomainRemovedEventType,
+			org.OrgDomainUnverifiedEventType).
 		Builder()
 }
 
@@ -143,6 +151,13 @@ func (wm *OrgDomainsWriteModel) Reduce() error {
 					continue
 				}
 			}
+		case *org.DomainUnverifiedEvent:
+			for _, d := range wm.Domains {
+				if d.Domain == e.Domain {
+					d.Verified = false
+					continue
+				}
+			}
 		case *org.DomainPrimarySetEvent:
 			wm.PrimaryDomain = e.Domain
 		case *org.DomainRemovedEvent:
@@ -171,7 +186,8 @@ func (wm *OrgDomainsWriteModel) Query() *eventstore.SearchQueryBuilder {
 			org.OrgDomainVerificationAddedEventType,
 			org.OrgDomainVerifiedEventType,
 			org.OrgDomainPrimarySetEventType,
-			org.OrgDomainRemovedEventType).
+			org.OrgDomainRemovedEventType,
+			org.OrgDomainUnverifiedEventType).
 		Builder()
 }
 
@@ -202,6 +218,11 @@ func (wm *OrgDomainVerifiedWriteModel) AppendEvents(events ...eventstore.Event)
 				continue
 			}
 			wm.WriteModel.AppendEvents(e)
+		case *org.DomainUnverifiedEvent:
+			if e.Domain != wm.Domain {
+				continue
+			}
+			wm.WriteModel.AppendEvents(e)
 		}
 	}
 }
@@ -212,7 +233,7 @@ func (wm *OrgDomainVerifiedWriteModel) Reduce() error {
 		case *org.DomainVerifiedEvent:
 			wm.Verified = true
 			wm.ResourceOwner = e.Aggregate().ResourceOwner
-		case *org.DomainRemovedEvent:
+		case *org.DomainRemovedEvent, *org.DomainUnverifiedEvent:
 			wm.Verified = false
 		}
 	}
@@ -225,6 +246,7 @@ func (wm *OrgDomainVerifiedWriteModel) Query() *eventstore.SearchQueryBuilder {
 		AggregateTypes(org.AggregateType).
 		EventTypes(
 			org.OrgDomainVerifiedEventType,
-			org.OrgDomainRemovedEventType).
+			org.OrgDomainRemovedEventType,
+			org.OrgDomainUnverifiedEventType).
 		Builder()
 }
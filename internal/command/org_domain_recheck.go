@@ -0,0 +1,87 @@
+package command
+
+import (
+	"context"
+	"time"
+
+	"github.com/zitadel/logging"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/crypto"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/repository/org"
+	"github.com/zitadel/zitadel/internal/telemetry/tracing"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+// RecheckOrgDomains checks the verification tokens of all org domains of the instance again:
+// verified domains whose token is gone are unverified, pending domains whose token is found are verified
+// and pending verifications older than the verification expiry are marked as expired.
+// A failing domain doesn't prevent the recheck of the others.
+func (c *Commands) RecheckOrgDomains(ctx context.Context, claimedUserIDs func(ctx context.Context, orgDomain, orgID string) ([]string, error)) (err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	writeModel := newOrgDomainVerificationsWriteModel(authz.GetInstance(ctx).InstanceID())
+	if err = c.eventstore.FilterToQueryReducer(ctx, writeModel); err != nil {
+		return err
+	}
+	for _, orgDomain := range writeModel.domains {
+		err := c.recheckOrgDomain(ctx, orgDomain, claimedUserIDs)
+		logging.WithFields("org", orgDomain.aggregate.ID, "domain", orgDomain.domain).OnError(err).Warn("recheck of org domain failed")
+	}
+	return nil
+}
+
+func (c *Commands) recheckOrgDomain(ctx context.Context, orgDomain *orgDomainVerification, claimedUserIDs func(ctx context.Context, orgDomain, orgID string) ([]string, error)) error {
+	// domains without a verification were verified on creation and can't be checked
+	if orgDomain.validationCode == nil || orgDomain.validationType == domain.OrgDomainValidationTypeUnspecified {
+		return nil
+	}
+	// expired verifications have to be restarted by the org
+	if orgDomain.expired {
+		return nil
+	}
+	validationCode, err := crypto.DecryptString(orgDomain.validationCode, c.domainVerificationAlg)
+	if err != nil {
+		return err
+	}
+	checkType, _ := orgDomain.validationType.CheckType()
+	validationErr := c.domainVerificationValidator(orgDomain.domain, validationCode, validationCode, checkType)
+
+	switch {
+	case orgDomain.verified && validationErr != nil:
+		_, err = c.eventstore.Push(ctx, org.NewDomainUnverifiedEvent(ctx, orgDomain.aggregate, orgDomain.domain))
+		return err
+	case !orgDomain.verified && validationErr == nil:
+		userIDs, err := claimedUserIDs(ctx, orgDomain.domain, orgDomain.aggregate.ID)
+		if err != nil {
+			return err
+		}
+		events := []eventstore.Command{org.NewDomainVerifiedEvent(ctx, orgDomain.aggregate, orgDomain.domain)}
+		for _, userID := range userIDs {
+			userEvents, _, err := c.userDomainClaimed(ctx, userID)
+			if err != nil {
+				logging.WithFields("userid", userID).WithError(err).Warn("could not claim user")
+				continue
+			}
+			events = append(events, userEvents...)
+		}
+		_, err = c.eventstore.Push(ctx, events...)
+		return err
+	case !orgDomain.verified && c.domainVerificationExpiry > 0 && orgDomain.pendingSince.Add(c.domainVerificationExpiry).Before(time.Now()):
+		_, err = c.eventstore.Push(ctx, org.NewDomainVerificationExpiredEvent(ctx, orgDomain.aggregate, orgDomain.domain))
+		return err
+	}
+	return nil
+}
+
+// OrgDomainVerificationExpiredSent marks the org owners as notified about the expired domain verification.
+func (c *Commands) OrgDomainVerificationExpiredSent(ctx context.Context, orgID, orgDomain string) error {
+	if orgID == "" || orgDomain == "" {
+		return zerrors.ThrowInvalidArgument(nil, "ORG-Ex4sm", "Errors.IDMissing")
+	}
+	_, err := c.eventstore.Push(ctx, org.NewDomainVerificationExpiredSentEvent(ctx, &org.NewAggregate(orgID).Aggregate, orgDomain))
+	return err
+}
@@ -0,0 +1,115 @@
+package command
+
+import (
+	"time"
+
+	"github.com/zitadel/zitadel/internal/crypto"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/repository/org"
+)
+
+type orgDomainVerification struct {
+	aggregate      *eventstore.Aggregate
+	domain         string
+	validationType domain.OrgDomainValidationType
+	validationCode *crypto.CryptoValue
+	verified       bool
+	// pendingSince is the date since the domain waits for its verification
+	pendingSince time.Time
+	expired      bool
+}
+
+// orgDomainVerificationsWriteModel contains the domains of all organizations of an instance
+type orgDomainVerificationsWriteModel struct {
+	eventstore.WriteModel
+
+	domains []*orgDomainVerification
+}
+
+func newOrgDomainVerificationsWriteModel(instanceID string) *orgDomainVerificationsWriteModel {
+	return &orgDomainVerificationsWriteModel{
+		WriteModel: eventstore.WriteModel{
+			InstanceID: instanceID,
+		},
+	}
+}
+
+func (wm *orgDomainVerificationsWriteModel) Reduce() error {
+	for _, event := range wm.Events {
+		switch e := event.(type) {
+		case *org.DomainAddedEvent:
+			wm.domains = append(wm.domains, &orgDomainVerification{
+				aggregate: e.Aggregate(),
+				domain:    e.Domain,
+			})
+		case *org.DomainVerificationAddedEvent:
+			if d := wm.find(e.Aggregate().ID, e.Domain); d != nil {
+				d.validationType = e.ValidationType
+				d.validationCode = e.ValidationCode
+				d.pendingSince = e.CreationDate()
+				d.expired = false
+			}
+		case *org.DomainVerifiedEvent:
+			if d := wm.find(e.Aggregate().ID, e.Domain); d != nil {
+				d.verified = true
+				d.pendingSince = time.Time{}
+				d.expired = false
+			}
+		case *org.DomainUnverifiedEvent:
+			if d := wm.find(e.Aggregate().ID, e.Domain); d != nil {
+				d.verified = false
+				d.pendingSince = e.CreationDate()
+			}
+		case *org.DomainVerificationExpiredEvent:
+			if d := wm.find(e.Aggregate().ID, e.Domain); d != nil {
+				d.expired = true
+			}
+		case *org.DomainRemovedEvent:
+			wm.remove(func(d *orgDomainVerification) bool {
+				return d.aggregate.ID == e.Aggregate().ID && d.domain == e.Domain
+			})
+		case *org.OrgRemovedEvent:
+			wm.remove(func(d *orgDomainVerification) bool {
+				return d.aggregate.ID == e.Aggregate().ID
+			})
+		}
+	}
+	return wm.WriteModel.Reduce()
+}
+
+func (wm *orgDomainVerificationsWriteModel) Query() *eventstore.SearchQueryBuilder {
+	return eventstore.NewSearchQueryBuilder(eventstore.ColumnsEvent).
+		InstanceID(wm.InstanceID).
+		AddQuery().
+		AggregateTypes(org.AggregateType).
+		EventTypes(
+			org.OrgDomainAddedEventType,
+			org.OrgDomainVerificationAddedEventType,
+			org.OrgDomainVerifiedEventType,
+			org.OrgDomainUnverifiedEventType,
+			org.OrgDomainVerificationExpiredEventType,
+			org.OrgDomainRemovedEventType,
+			org.OrgRemovedEventType,
+		).
+		Builder()
+}
+
+func (wm *orgDomainVerificationsWriteModel) find(orgID, orgDomain string) *orgDomainVerification {
+	for _, d := range wm.domains {
+		if d.aggregate.ID == orgID && d.domain == orgDomain {
+			return d
+		}
+	}
+	return nil
+}
+
+func (wm *orgDomainVerificationsWriteModel) remove(matches func(d *orgDomainVerification) bool) {
+	domains := wm.domains[:0]
+	for _, d := range wm.domains {
+		if !matches(d) {
+			domains = append(domains, d)
+		}
+	}
+	wm.domains = domains
+}
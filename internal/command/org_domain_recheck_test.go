@@ -0,0 +1,403 @@
+package command
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	"github.com/zitadel/zitadel/internal/api/http"
+	"github.com/zitadel/zitadel/internal/crypto"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/repository/org"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+func TestCommandSide_RecheckOrgDomains(t *testing.T) {
+	type fields struct {
+		eventstore           func(t *testing.T) *eventstore.Eventstore
+		domainValidationFunc func(domain, token, verifier string, checkType http.CheckType) error
+		expiry               time.Duration
+	}
+	type args struct {
+		claimedUserIDs func(ctx context.Context, orgDomain, orgID string) ([]string, error)
+	}
+	type res struct {
+		err func(error) bool
+	}
+	noClaimedUsers := func(context.Context, string, string) ([]string, error) {
+		return nil, nil
+	}
+	verificationAdded := func() eventstore.Command {
+		return org.NewDomainVerificationAddedEvent(context.Background(),
+			&org.NewAggregate("org1").Aggregate,
+			"domain.ch",
+			domain.OrgDomainValidationTypeDNS,
+			&crypto.CryptoValue{
+				CryptoType: crypto.TypeEncryption,
+				Algorithm:  "enc",
+				KeyID:      "id",
+				Crypted:    []byte("a"),
+			},
+		)
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		args   args
+		res    res
+	}{
+		{
+			name: "filter error, error",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilterError(zerrors.ThrowInternal(nil, "id", "filter failed")),
+				),
+			},
+			args: args{
+				claimedUserIDs: noClaimedUsers,
+			},
+			res: res{
+				err: zerrors.IsInternal,
+			},
+		},
+		{
+			name: "domain without verification, not checked",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(
+							org.NewDomainAddedEvent(context.Background(),
+								&org.NewAggregate("org1").Aggregate,
+								"domain.ch",
+							),
+						),
+						eventFromEventPusher(
+							org.NewDomainVerifiedEvent(context.Background(),
+								&org.NewAggregate("org1").Aggregate,
+								"domain.ch",
+							),
+						),
+					),
+				),
+				domainValidationFunc: invalidDomainVerification,
+			},
+			args: args{
+				claimedUserIDs: noClaimedUsers,
+			},
+		},
+		{
+			name: "verified domain, token removed, unverified",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(
+							org.NewDomainAddedEvent(context.Background(),
+								&org.NewAggregate("org1").Aggregate,
+								"domain.ch",
+							),
+						),
+						eventFromEventPusher(verificationAdded()),
+						eventFromEventPusher(
+							org.NewDomainVerifiedEvent(context.Background(),
+								&org.NewAggregate("org1").Aggregate,
+								"domain.ch",
+							),
+						),
+					),
+					expectPush(
+						org.NewDomainUnverifiedEvent(context.Background(),
+							&org.NewAggregate("org1").Aggregate,
+							"domain.ch",
+						),
+					),
+				),
+				domainValidationFunc: invalidDomainVerification,
+			},
+			args: args{
+				claimedUserIDs: noClaimedUsers,
+			},
+		},
+		{
+			name: "verified domain, token found, nothing changed",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(
+							org.NewDomainAddedEvent(context.Background(),
+								&org.NewAggregate("org1").Aggregate,
+								"domain.ch",
+							),
+						),
+						eventFromEventPusher(verificationAdded()),
+						eventFromEventPusher(
+							org.NewDomainVerifiedEvent(context.Background(),
+								&org.NewAggregate("org1").Aggregate,
+								"domain.ch",
+							),
+						),
+					),
+				),
+				domainValidationFunc: validDomainVerification,
+			},
+			args: args{
+				claimedUserIDs: noClaimedUsers,
+			},
+		},
+		{
+			name: "pending domain, token found, verified",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(
+							org.NewDomainAddedEvent(context.Background(),
+								&org.NewAggregate("org1").Aggregate,
+								"domain.ch",
+							),
+						),
+						eventFromEventPusherWithCreationDateNow(verificationAdded()),
+					),
+					expectPush(
+						org.NewDomainVerifiedEvent(context.Background(),
+							&org.NewAggregate("org1").Aggregate,
+							"domain.ch",
+						),
+					),
+				),
+				domainValidationFunc: validDomainVerification,
+				expiry:               time.Hour,
+			},
+			args: args{
+				claimedUserIDs: noClaimedUsers,
+			},
+		},
+		{
+			name: "pending domain, claimed users failed, recheck continues",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(
+							org.NewDomainAddedEvent(context.Background(),
+								&org.NewAggregate("org1").Aggregate,
+								"domain.ch",
+							),
+						),
+						eventFromEventPusher(verificationAdded()),
+					),
+				),
+				domainValidationFunc: validDomainVerification,
+			},
+			args: args{
+				claimedUserIDs: func(context.Context, string, string) ([]string, error) {
+					return nil, errors.New("search failed")
+				},
+			},
+		},
+		{
+			name: "pending domain, not yet expired, nothing changed",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(
+							org.NewDomainAddedEvent(context.Background(),
+								&org.NewAggregate("org1").Aggregate,
+								"domain.ch",
+							),
+						),
+						eventFromEventPusherWithCreationDateNow(verificationAdded()),
+					),
+				),
+				domainValidationFunc: invalidDomainVerification,
+				expiry:               time.Hour,
+			},
+			args: args{
+				claimedUserIDs: noClaimedUsers,
+			},
+		},
+		{
+			name: "pending domain, expired",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(
+							org.NewDomainAddedEvent(context.Background(),
+								&org.NewAggregate("org1").Aggregate,
+								"domain.ch",
+							),
+						),
+						eventFromEventPusher(verificationAdded()),
+					),
+					expectPush(
+						org.NewDomainVerificationExpiredEvent(context.Background(),
+							&org.NewAggregate("org1").Aggregate,
+							"domain.ch",
+						),
+					),
+				),
+				domainValidationFunc: invalidDomainVerification,
+				expiry:               time.Hour,
+			},
+			args: args{
+				claimedUserIDs: noClaimedUsers,
+			},
+		},
+		{
+			name: "pending domain, expiry disabled, nothing changed",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(
+							org.NewDomainAddedEvent(context.Background(),
+								&org.NewAggregate("org1").Aggregate,
+								"domain.ch",
+							),
+						),
+						eventFromEventPusher(verificationAdded()),
+					),
+				),
+				domainValidationFunc: invalidDomainVerification,
+			},
+			args: args{
+				claimedUserIDs: noClaimedUsers,
+			},
+		},
+		{
+			name: "verification already expired, not checked",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(
+							org.NewDomainAddedEvent(context.Background(),
+								&org.NewAggregate("org1").Aggregate,
+								"domain.ch",
+							),
+						),
+						eventFromEventPusher(verificationAdded()),
+						eventFromEventPusher(
+							org.NewDomainVerificationExpiredEvent(context.Background(),
+								&org.NewAggregate("org1").Aggregate,
+								"domain.ch",
+							),
+						),
+					),
+				),
+				domainValidationFunc: validDomainVerification,
+				expiry:               time.Hour,
+			},
+			args: args{
+				claimedUserIDs: noClaimedUsers,
+			},
+		},
+		{
+			name: "removed domain, not checked",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(
+							org.NewDomainAddedEvent(context.Background(),
+								&org.NewAggregate("org1").Aggregate,
+								"domain.ch",
+							),
+						),
+						eventFromEventPusher(verificationAdded()),
+						eventFromEventPusher(
+							org.NewDomainRemovedEvent(context.Background(),
+								&org.NewAggregate("org1").Aggregate,
+								"domain.ch",
+								false,
+							),
+						),
+					),
+				),
+				domainValidationFunc: validDomainVerification,
+			},
+			args: args{
+				claimedUserIDs: noClaimedUsers,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &Commands{
+				eventstore:                  tt.fields.eventstore(t),
+				domainVerificationAlg:       crypto.CreateMockEncryptionAlg(gomock.NewController(t)),
+				domainVerificationValidator: tt.fields.domainValidationFunc,
+				domainVerificationExpiry:    tt.fields.expiry,
+			}
+			err := c.RecheckOrgDomains(context.Background(), tt.args.claimedUserIDs)
+			if tt.res.err == nil {
+				assert.NoError(t, err)
+			}
+			if tt.res.err != nil && !tt.res.err(err) {
+				t.Errorf("got wrong err: %v ", err)
+			}
+		})
+	}
+}
+
+func TestCommandSide_OrgDomainVerificationExpiredSent(t *testing.T) {
+	type fields struct {
+		eventstore func(t *testing.T) *eventstore.Eventstore
+	}
+	type args struct {
+		orgID  string
+		domain string
+	}
+	type res struct {
+		err func(error) bool
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		args   args
+		res    res
+	}{
+		{
+			name: "missing domain, error",
+			fields: fields{
+				eventstore: expectEventstore(),
+			},
+			args: args{
+				orgID: "org1",
+			},
+			res: res{
+				err: zerrors.IsErrorInvalidArgument,
+			},
+		},
+		{
+			name: "sent, ok",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectPush(
+						org.NewDomainVerificationExpiredSentEvent(context.Background(),
+							&org.NewAggregate("org1").Aggregate,
+							"domain.ch",
+						),
+					),
+				),
+			},
+			args: args{
+				orgID:  "org1",
+				domain: "domain.ch",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &Commands{
+				eventstore: tt.fields.eventstore(t),
+			}
+			err := c.OrgDomainVerificationExpiredSent(context.Background(), tt.args.orgID, tt.args.domain)
+			if tt.res.err == nil {
+				assert.NoError(t, err)
+			}
+			if tt.res.err != nil && !tt.res.err(err) {
+				t.Errorf("got wrong err: %v ", err)
+			}
+		})
+	}
+}
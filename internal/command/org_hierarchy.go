@@ -0,0 +1,81 @@
+package command
+
+import (
+	"context"
+	"slices"
+
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/repository/org"
+	"github.com/zitadel/zitadel/internal/telemetry/tracing"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+// SetOrgParent nests the organization below the parent organization.
+// The organization inherits the policies of its ancestors it does not define itself.
+func (c *Commands) SetOrgParent(ctx context.Context, orgID, parentOrgID string) (_ *domain.ObjectDetails, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	if orgID == "" || parentOrgID == "" {
+		return nil, zerrors.ThrowInvalidArgument(nil, "ORG-h3Kq9", "Errors.IDMissing")
+	}
+	if orgID == parentOrgID {
+		return nil, zerrors.ThrowInvalidArgument(nil, "ORG-Wp2xe", "Errors.Org.Hierarchy.Cycle")
+	}
+	wm, err := c.orgHierarchyWriteModel(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if !wm.Orgs[orgID] || !wm.Orgs[parentOrgID] {
+		return nil, zerrors.ThrowNotFound(nil, "ORG-7fNcs", "Errors.Org.NotFound")
+	}
+	if wm.Hierarchy.Parent(orgID) == parentOrgID {
+		return nil, zerrors.ThrowPreconditionFailed(nil, "ORG-q9Lmd", "Errors.Org.Hierarchy.ParentAlreadySet")
+	}
+	ancestors := wm.Hierarchy.Ancestors(parentOrgID)
+	if slices.Contains(ancestors, orgID) {
+		return nil, zerrors.ThrowInvalidArgument(nil, "ORG-Ue4tb", "Errors.Org.Hierarchy.Cycle")
+	}
+	// levels above the parent, the parent itself, the organization and the levels below it
+	if len(ancestors)+2+wm.Hierarchy.Height(orgID) > domain.MaxOrgHierarchyDepth {
+		return nil, zerrors.ThrowPreconditionFailed(nil, "ORG-Zk8pr", "Errors.Org.Hierarchy.TooDeep")
+	}
+	pushedEvents, err := c.eventstore.Push(ctx, org.NewOrgParentSetEvent(ctx, &org.NewAggregate(orgID).Aggregate, parentOrgID))
+	if err != nil {
+		return nil, err
+	}
+	return pushedEventsToObjectDetails(pushedEvents), nil
+}
+
+// RemoveOrgParent makes the organization a root of the organization hierarchy again.
+func (c *Commands) RemoveOrgParent(ctx context.Context, orgID string) (_ *domain.ObjectDetails, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	if orgID == "" {
+		return nil, zerrors.ThrowInvalidArgument(nil, "ORG-m2Hvx", "Errors.IDMissing")
+	}
+	wm, err := c.orgHierarchyWriteModel(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if !wm.Orgs[orgID] {
+		return nil, zerrors.ThrowNotFound(nil, "ORG-R6wdn", "Errors.Org.NotFound")
+	}
+	if wm.Hierarchy.Parent(orgID) == "" {
+		return nil, zerrors.ThrowPreconditionFailed(nil, "ORG-c5Tja", "Errors.Org.Hierarchy.ParentNotSet")
+	}
+	pushedEvents, err := c.eventstore.Push(ctx, org.NewOrgParentRemovedEvent(ctx, &org.NewAggregate(orgID).Aggregate))
+	if err != nil {
+		return nil, err
+	}
+	return pushedEventsToObjectDetails(pushedEvents), nil
+}
+
+func (c *Commands) orgHierarchyWriteModel(ctx context.Context) (*OrgHierarchyWriteModel, error) {
+	wm := NewOrgHierarchyWriteModel()
+	if err := c.eventstore.FilterToQueryReducer(ctx, wm); err != nil {
+		return nil, err
+	}
+	return wm, nil
+}
@@ -0,0 +1,77 @@
+package command
+
+import (
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/repository/org"
+)
+
+// OrgHierarchyWriteModel contains the existing organizations of the instance and their parents.
+type OrgHierarchyWriteModel struct {
+	eventstore.WriteModel
+
+	Orgs      map[string]bool
+	Hierarchy domain.OrgHierarchy
+}
+
+func NewOrgHierarchyWriteModel() *OrgHierarchyWriteModel {
+	return &OrgHierarchyWriteModel{
+		Orgs:      make(map[string]bool),
+		Hierarchy: make(domain.OrgHierarchy),
+	}
+}
+
+func (wm *OrgHierarchyWriteModel) Reduce() error {
+	for _, event := range wm.Events {
+		switch e := event.(type) {
+		case *org.OrgAddedEvent:
+			wm.Orgs[e.Aggregate().ID] = true
+		case *org.OrgRemovedEvent:
+			delete(wm.Orgs, e.Aggregate().ID)
+			wm.Hierarchy.Remove(e.Aggregate().ID)
+		case *org.OrgParentSetEvent:
+			wm.Hierarchy.SetParent(e.Aggregate().ID, e.ParentOrgID)
+		case *org.OrgParentRemovedEvent:
+			delete(wm.Hierarchy, e.Aggregate().ID)
+		}
+	}
+	return wm.WriteModel.Reduce()
+}
+
+func (wm *OrgHierarchyWriteModel) Query() *eventstore.SearchQueryBuilder {
+	return eventstore.NewSearchQueryBuilder(eventstore.ColumnsEvent).
+		AddQuery().
+		AggregateTypes(org.AggregateType).
+		EventTypes(
+			org.OrgAddedEventType,
+			org.OrgRemovedEventType,
+			org.OrgParentSetEventType,
+			org.OrgParentRemovedEventType,
+		).
+		Builder()
+}
+
+// orgParentWriteModel tracks the parent of an organization.
+// It is part of the organization policy write models, so the policy of the nearest ancestor applies
+// if the organization has no policy of its own.
+type orgParentWriteModel struct {
+	ParentOrgID string
+	OrgRemoved  bool
+}
+
+func (wm *orgParentWriteModel) appendEvent(event eventstore.Event) {
+	switch e := event.(type) {
+	case *org.OrgParentSetEvent:
+		wm.ParentOrgID = e.ParentOrgID
+	case *org.OrgParentRemovedEvent:
+		wm.ParentOrgID = ""
+	case *org.OrgRemovedEvent:
+		wm.OrgRemoved = true
+	}
+}
+
+var orgParentEventTypes = []eventstore.EventType{
+	org.OrgParentSetEventType,
+	org.OrgParentRemovedEventType,
+	org.OrgRemovedEventType,
+}
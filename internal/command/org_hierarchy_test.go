@@ -0,0 +1,284 @@
+package command
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/repository/org"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+func orgHierarchyTestEvents(parents map[string]string, orgIDs ...string) []eventstore.Event {
+	events := make([]eventstore.Event, 0, len(orgIDs)+len(parents))
+	for _, orgID := range orgIDs {
+		events = append(events, eventFromEventPusher(
+			org.NewOrgAddedEvent(context.Background(), &org.NewAggregate(orgID).Aggregate, orgID),
+		))
+	}
+	for _, orgID := range orgIDs {
+		if parent, ok := parents[orgID]; ok {
+			events = append(events, eventFromEventPusher(
+				org.NewOrgParentSetEvent(context.Background(), &org.NewAggregate(orgID).Aggregate, parent),
+			))
+		}
+	}
+	return events
+}
+
+func TestCommandSide_SetOrgParent(t *testing.T) {
+	type fields struct {
+		eventstore func(t *testing.T) *eventstore.Eventstore
+	}
+	type args struct {
+		orgID       string
+		parentOrgID string
+	}
+	type res struct {
+		want *domain.ObjectDetails
+		err  func(error) bool
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		args   args
+		res    res
+	}{
+		{
+			name: "parent missing, invalid argument error",
+			fields: fields{
+				eventstore: expectEventstore(),
+			},
+			args: args{
+				orgID: "org1",
+			},
+			res: res{
+				err: zerrors.IsErrorInvalidArgument,
+			},
+		},
+		{
+			name: "parent is org itself, invalid argument error",
+			fields: fields{
+				eventstore: expectEventstore(),
+			},
+			args: args{
+				orgID:       "org1",
+				parentOrgID: "org1",
+			},
+			res: res{
+				err: zerrors.IsErrorInvalidArgument,
+			},
+		},
+		{
+			name: "parent not existing, not found error",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(orgHierarchyTestEvents(nil, "org1")...),
+				),
+			},
+			args: args{
+				orgID:       "org1",
+				parentOrgID: "org2",
+			},
+			res: res{
+				err: zerrors.IsNotFound,
+			},
+		},
+		{
+			name: "parent already set, precondition error",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(orgHierarchyTestEvents(map[string]string{"org1": "org2"}, "org1", "org2")...),
+				),
+			},
+			args: args{
+				orgID:       "org1",
+				parentOrgID: "org2",
+			},
+			res: res{
+				err: zerrors.IsPreconditionFailed,
+			},
+		},
+		{
+			name: "parent is descendant, invalid argument error",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(orgHierarchyTestEvents(map[string]string{"org2": "org1", "org3": "org2"}, "org1", "org2", "org3")...),
+				),
+			},
+			args: args{
+				orgID:       "org1",
+				parentOrgID: "org3",
+			},
+			res: res{
+				err: zerrors.IsErrorInvalidArgument,
+			},
+		},
+		{
+			name: "hierarchy too deep, precondition error",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(orgHierarchyTestEvents(
+						map[string]string{"org1": "org0", "org2": "org1", "org3": "org2", "org4": "org3", "org5": "org4", "sub2": "sub1", "sub3": "sub2", "sub4": "sub3", "sub5": "sub4"},
+						"org0", "org1", "org2", "org3", "org4", "org5", "sub1", "sub2", "sub3", "sub4", "sub5",
+					)...),
+				),
+			},
+			args: args{
+				orgID:       "sub1",
+				parentOrgID: "org5",
+			},
+			res: res{
+				err: zerrors.IsPreconditionFailed,
+			},
+		},
+		{
+			name: "set parent, ok",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(orgHierarchyTestEvents(map[string]string{"org2": "org1"}, "org1", "org2", "org3")...),
+					expectPush(
+						org.NewOrgParentSetEvent(context.Background(), &org.NewAggregate("org3").Aggregate, "org2"),
+					),
+				),
+			},
+			args: args{
+				orgID:       "org3",
+				parentOrgID: "org2",
+			},
+			res: res{
+				want: &domain.ObjectDetails{
+					ResourceOwner: "org3",
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := &Commands{
+				eventstore: tt.fields.eventstore(t),
+			}
+			got, err := r.SetOrgParent(context.Background(), tt.args.orgID, tt.args.parentOrgID)
+			if tt.res.err == nil {
+				assert.NoError(t, err)
+			}
+			if tt.res.err != nil && !tt.res.err(err) {
+				t.Errorf("got wrong err: %v ", err)
+			}
+			if tt.res.err == nil {
+				assert.Equal(t, tt.res.want, got)
+			}
+		})
+	}
+}
+
+func TestCommandSide_RemoveOrgParent(t *testing.T) {
+	type fields struct {
+		eventstore func(t *testing.T) *eventstore.Eventstore
+	}
+	type args struct {
+		orgID string
+	}
+	type res struct {
+		want *domain.ObjectDetails
+		err  func(error) bool
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		args   args
+		res    res
+	}{
+		{
+			name: "org not existing, not found error",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(),
+				),
+			},
+			args: args{
+				orgID: "org1",
+			},
+			res: res{
+				err: zerrors.IsNotFound,
+			},
+		},
+		{
+			name: "no parent, precondition error",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(orgHierarchyTestEvents(nil, "org1")...),
+				),
+			},
+			args: args{
+				orgID: "org1",
+			},
+			res: res{
+				err: zerrors.IsPreconditionFailed,
+			},
+		},
+		{
+			name: "remove parent, ok",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(orgHierarchyTestEvents(map[string]string{"org2": "org1"}, "org1", "org2")...),
+					expectPush(
+						org.NewOrgParentRemovedEvent(context.Background(), &org.NewAggregate("org2").Aggregate),
+					),
+				),
+			},
+			args: args{
+				orgID: "org2",
+			},
+			res: res{
+				want: &domain.ObjectDetails{
+					ResourceOwner: "org2",
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := &Commands{
+				eventstore: tt.fields.eventstore(t),
+			}
+			got, err := r.RemoveOrgParent(context.Background(), tt.args.orgID)
+			if tt.res.err == nil {
+				assert.NoError(t, err)
+			}
+			if tt.res.err != nil && !tt.res.err(err) {
+				t.Errorf("got wrong err: %v ", err)
+			}
+			if tt.res.err == nil {
+				assert.Equal(t, tt.res.want, got)
+			}
+		})
+	}
+}
+
+func TestCommandSide_getOrgPasswordComplexityPolicy_inherited(t *testing.T) {
+	r := &Commands{
+		eventstore: expectEventstore(
+			expectFilter(
+				eventFromEventPusher(
+					org.NewOrgParentSetEvent(context.Background(), &org.NewAggregate("org2").Aggregate, "org1"),
+				),
+			),
+			expectFilter(
+				eventFromEventPusher(
+					org.NewPasswordComplexityPolicyAddedEvent(context.Background(), &org.NewAggregate("org1").Aggregate,
+						12, true, true, true, true, false,
+					),
+				),
+			),
+		)(t),
+	}
+	got, err := r.getOrgPasswordComplexityPolicy(context.Background(), "org2")
+	require.NoError(t, err)
+	assert.Equal(t, "org1", got.AggregateID)
+	assert.Equal(t, uint64(12), got.MinLength)
+}
@@ -2,6 +2,7 @@ package command
 
 import (
 	"context"
+	"slices"
 	"strings"
 
 	"github.com/zitadel/saml/pkg/provider/xml"
@@ -10,6 +11,9 @@ import (
 	"github.com/zitadel/zitadel/internal/crypto"
 	"github.com/zitadel/zitadel/internal/domain"
 	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/idp/providers/apple"
+	"github.com/zitadel/zitadel/internal/idp/providers/ldap"
+	"github.com/zitadel/zitadel/internal/idp/providers/oauth"
 	"github.com/zitadel/zitadel/internal/repository/org"
 	"github.com/zitadel/zitadel/internal/zerrors"
 )
@@ -601,6 +605,9 @@ func (c *Commands) prepareAddOrgOAuthProvider(a *org.Aggregate, writeModel *OrgO
 		if provider.IDAttribute = strings.TrimSpace(provider.IDAttribute); provider.IDAttribute == "" {
 			return nil, zerrors.ThrowInvalidArgument(nil, "ORG-sadf3d", "Errors.Invalid.Argument")
 		}
+		if _, err := oauth.NewAttributeMapping(provider.AttributeMapping); err != nil {
+			return nil, zerrors.ThrowInvalidArgument(err, "ORG-Oam1f", "Errors.IDPConfig.AttributeMappingInvalid")
+		}
 		return func(ctx context.Context, filter preparation.FilterToQueryReducer) ([]eventstore.Command, error) {
 			events, err := filter(ctx, writeModel.Query())
 			if err != nil {
@@ -610,7 +617,7 @@ func (c *Commands) prepareAddOrgOAuthProvider(a *org.Aggregate, writeModel *OrgO
 			if err = writeModel.Reduce(); err != nil {
 				return nil, err
 			}
-			secret, err := crypto.Encrypt([]byte(provider.ClientSecret), c.idpConfigEncryption)
+			secret, err := crypto.Encrypt([]byte(provider.ClientSecret), crypto.ForContext(ctx, c.idpConfigEncryption))
 			if err != nil {
 				return nil, err
 			}
@@ -627,6 +634,7 @@ func (c *Commands) prepareAddOrgOAuthProvider(a *org.Aggregate, writeModel *OrgO
 					provider.UserEndpoint,
 					provider.IDAttribute,
 					provider.Scopes,
+					provider.AttributeMapping,
 					provider.IDPOptions,
 				),
 			}, nil
@@ -657,6 +665,9 @@ func (c *Commands) prepareUpdateOrgOAuthProvider(a *org.Aggregate, writeModel *O
 		if provider.IDAttribute = strings.TrimSpace(provider.IDAttribute); provider.IDAttribute == "" {
 			return nil, zerrors.ThrowInvalidArgument(nil, "ORG-SAe4gh", "Errors.Invalid.Argument")
 		}
+		if _, err := oauth.NewAttributeMapping(provider.AttributeMapping); err != nil {
+			return nil, zerrors.ThrowInvalidArgument(err, "ORG-Oam2f", "Errors.IDPConfig.AttributeMappingInvalid")
+		}
 		return func(ctx context.Context, filter preparation.FilterToQueryReducer) ([]eventstore.Command, error) {
 			events, err := filter(ctx, writeModel.Query())
 			if err != nil {
@@ -676,12 +687,13 @@ func (c *Commands) prepareUpdateOrgOAuthProvider(a *org.Aggregate, writeModel *O
 				provider.Name,
 				provider.ClientID,
 				provider.ClientSecret,
-				c.idpConfigEncryption,
+				crypto.ForContext(ctx, c.idpConfigEncryption),
 				provider.AuthorizationEndpoint,
 				provider.TokenEndpoint,
 				provider.UserEndpoint,
 				provider.IDAttribute,
 				provider.Scopes,
+				provider.AttributeMapping,
 				provider.IDPOptions,
 			)
 			if err != nil || event == nil {
@@ -715,7 +727,7 @@ func (c *Commands) prepareAddOrgOIDCProvider(a *org.Aggregate, writeModel *OrgOI
 			if err = writeModel.Reduce(); err != nil {
 				return nil, err
 			}
-			secret, err := crypto.Encrypt([]byte(provider.ClientSecret), c.idpConfigEncryption)
+			secret, err := crypto.Encrypt([]byte(provider.ClientSecret), crypto.ForContext(ctx, c.idpConfigEncryption))
 			if err != nil {
 				return nil, err
 			}
@@ -771,7 +783,7 @@ func (c *Commands) prepareUpdateOrgOIDCProvider(a *org.Aggregate, writeModel *Or
 				provider.Issuer,
 				provider.ClientID,
 				provider.ClientSecret,
-				c.idpConfigEncryption,
+				crypto.ForContext(ctx, c.idpConfigEncryption),
 				provider.Scopes,
 				provider.IsIDTokenMapping,
 				provider.IDPOptions,
@@ -807,7 +819,7 @@ func (c *Commands) prepareMigrateOrgOIDCToAzureADProvider(a *org.Aggregate, writ
 			if !writeModel.State.Exists() {
 				return nil, zerrors.ThrowNotFound(nil, "INST-Dg239201", "Errors.Instance.IDPConfig.NotExisting")
 			}
-			secret, err := crypto.Encrypt([]byte(provider.ClientSecret), c.idpConfigEncryption)
+			secret, err := crypto.Encrypt([]byte(provider.ClientSecret), crypto.ForContext(ctx, c.idpConfigEncryption))
 			if err != nil {
 				return nil, err
 			}
@@ -822,6 +834,8 @@ func (c *Commands) prepareMigrateOrgOIDCToAzureADProvider(a *org.Aggregate, writ
 					provider.Scopes,
 					provider.Tenant,
 					provider.EmailVerified,
+					provider.AllowedTenants,
+					provider.FetchGroups,
 					provider.IDPOptions,
 				),
 			}, nil
@@ -849,7 +863,7 @@ func (c *Commands) prepareMigrateOrgOIDCToGoogleProvider(a *org.Aggregate, write
 			if !writeModel.State.Exists() {
 				return nil, zerrors.ThrowNotFound(nil, "INST-x09981", "Errors.Instance.IDPConfig.NotExisting")
 			}
-			secret, err := crypto.Encrypt([]byte(provider.ClientSecret), c.idpConfigEncryption)
+			secret, err := crypto.Encrypt([]byte(provider.ClientSecret), crypto.ForContext(ctx, c.idpConfigEncryption))
 			if err != nil {
 				return nil, err
 			}
@@ -974,6 +988,9 @@ func (c *Commands) prepareAddOrgAzureADProvider(a *org.Aggregate, writeModel *Or
 		if provider.ClientSecret = strings.TrimSpace(provider.ClientSecret); provider.ClientSecret == "" {
 			return nil, zerrors.ThrowInvalidArgument(nil, "ORG-Dzh3g", "Errors.Invalid.Argument")
 		}
+		if slices.Contains(trimStringSliceWhiteSpaces(provider.AllowedTenants), "") {
+			return nil, zerrors.ThrowInvalidArgument(nil, "ORG-Azt1q", "Errors.Invalid.Argument")
+		}
 		return func(ctx context.Context, filter preparation.FilterToQueryReducer) ([]eventstore.Command, error) {
 			events, err := filter(ctx, writeModel.Query())
 			if err != nil {
@@ -983,7 +1000,7 @@ func (c *Commands) prepareAddOrgAzureADProvider(a *org.Aggregate, writeModel *Or
 			if err = writeModel.Reduce(); err != nil {
 				return nil, err
 			}
-			secret, err := crypto.Encrypt([]byte(provider.ClientSecret), c.idpConfigEncryption)
+			secret, err := crypto.Encrypt([]byte(provider.ClientSecret), crypto.ForContext(ctx, c.idpConfigEncryption))
 			if err != nil {
 				return nil, err
 			}
@@ -998,6 +1015,8 @@ func (c *Commands) prepareAddOrgAzureADProvider(a *org.Aggregate, writeModel *Or
 					provider.Scopes,
 					provider.Tenant,
 					provider.EmailVerified,
+					provider.AllowedTenants,
+					provider.FetchGroups,
 					provider.IDPOptions,
 				),
 			}, nil
@@ -1016,6 +1035,9 @@ func (c *Commands) prepareUpdateOrgAzureADProvider(a *org.Aggregate, writeModel
 		if provider.ClientID = strings.TrimSpace(provider.ClientID); provider.ClientID == "" {
 			return nil, zerrors.ThrowInvalidArgument(nil, "ORG-dmitg", "Errors.Invalid.Argument")
 		}
+		if slices.Contains(trimStringSliceWhiteSpaces(provider.AllowedTenants), "") {
+			return nil, zerrors.ThrowInvalidArgument(nil, "ORG-Azt2q", "Errors.Invalid.Argument")
+		}
 		return func(ctx context.Context, filter preparation.FilterToQueryReducer) ([]eventstore.Command, error) {
 			events, err := filter(ctx, writeModel.Query())
 			if err != nil {
@@ -1035,10 +1057,12 @@ func (c *Commands) prepareUpdateOrgAzureADProvider(a *org.Aggregate, writeModel
 				provider.Name,
 				provider.ClientID,
 				provider.ClientSecret,
-				c.idpConfigEncryption,
+				crypto.ForContext(ctx, c.idpConfigEncryption),
 				provider.Scopes,
 				provider.Tenant,
 				provider.EmailVerified,
+				provider.AllowedTenants,
+				provider.FetchGroups,
 				provider.IDPOptions,
 			)
 			if err != nil || event == nil {
@@ -1066,7 +1090,7 @@ func (c *Commands) prepareAddOrgGitHubProvider(a *org.Aggregate, writeModel *Org
 			if err = writeModel.Reduce(); err != nil {
 				return nil, err
 			}
-			secret, err := crypto.Encrypt([]byte(provider.ClientSecret), c.idpConfigEncryption)
+			secret, err := crypto.Encrypt([]byte(provider.ClientSecret), crypto.ForContext(ctx, c.idpConfigEncryption))
 			if err != nil {
 				return nil, err
 			}
@@ -1113,7 +1137,7 @@ func (c *Commands) prepareUpdateOrgGitHubProvider(a *org.Aggregate, writeModel *
 				provider.Name,
 				provider.ClientID,
 				provider.ClientSecret,
-				c.idpConfigEncryption,
+				crypto.ForContext(ctx, c.idpConfigEncryption),
 				provider.Scopes,
 				provider.IDPOptions,
 			)
@@ -1157,7 +1181,7 @@ func (c *Commands) prepareAddOrgGitHubEnterpriseProvider(a *org.Aggregate, write
 			if err = writeModel.Reduce(); err != nil {
 				return nil, err
 			}
-			secret, err := crypto.Encrypt([]byte(provider.ClientSecret), c.idpConfigEncryption)
+			secret, err := crypto.Encrypt([]byte(provider.ClientSecret), crypto.ForContext(ctx, c.idpConfigEncryption))
 			if err != nil {
 				return nil, err
 			}
@@ -1219,7 +1243,7 @@ func (c *Commands) prepareUpdateOrgGitHubEnterpriseProvider(a *org.Aggregate, wr
 				provider.Name,
 				provider.ClientID,
 				provider.ClientSecret,
-				c.idpConfigEncryption,
+				crypto.ForContext(ctx, c.idpConfigEncryption),
 				provider.AuthorizationEndpoint,
 				provider.TokenEndpoint,
 				provider.UserEndpoint,
@@ -1254,7 +1278,7 @@ func (c *Commands) prepareAddOrgGitLabProvider(a *org.Aggregate, writeModel *Org
 			if err = writeModel.Reduce(); err != nil {
 				return nil, err
 			}
-			secret, err := crypto.Encrypt([]byte(provider.ClientSecret), c.idpConfigEncryption)
+			secret, err := crypto.Encrypt([]byte(provider.ClientSecret), crypto.ForContext(ctx, c.idpConfigEncryption))
 			if err != nil {
 				return nil, err
 			}
@@ -1301,7 +1325,7 @@ func (c *Commands) prepareUpdateOrgGitLabProvider(a *org.Aggregate, writeModel *
 				provider.Name,
 				provider.ClientID,
 				provider.ClientSecret,
-				c.idpConfigEncryption,
+				crypto.ForContext(ctx, c.idpConfigEncryption),
 				provider.Scopes,
 				provider.IDPOptions,
 			)
@@ -1339,7 +1363,7 @@ func (c *Commands) prepareAddOrgGitLabSelfHostedProvider(a *org.Aggregate, write
 			if err = writeModel.Reduce(); err != nil {
 				return nil, err
 			}
-			secret, err := crypto.Encrypt([]byte(provider.ClientSecret), c.idpConfigEncryption)
+			secret, err := crypto.Encrypt([]byte(provider.ClientSecret), crypto.ForContext(ctx, c.idpConfigEncryption))
 			if err != nil {
 				return nil, err
 			}
@@ -1394,7 +1418,7 @@ func (c *Commands) prepareUpdateOrgGitLabSelfHostedProvider(a *org.Aggregate, wr
 				provider.Issuer,
 				provider.ClientID,
 				provider.ClientSecret,
-				c.idpConfigEncryption,
+				crypto.ForContext(ctx, c.idpConfigEncryption),
 				provider.Scopes,
 				provider.IDPOptions,
 			)
@@ -1426,7 +1450,7 @@ func (c *Commands) prepareAddOrgGoogleProvider(a *org.Aggregate, writeModel *Org
 			if err = writeModel.Reduce(); err != nil {
 				return nil, err
 			}
-			secret, err := crypto.Encrypt([]byte(provider.ClientSecret), c.idpConfigEncryption)
+			secret, err := crypto.Encrypt([]byte(provider.ClientSecret), crypto.ForContext(ctx, c.idpConfigEncryption))
 			if err != nil {
 				return nil, err
 			}
@@ -1473,7 +1497,7 @@ func (c *Commands) prepareUpdateOrgGoogleProvider(a *org.Aggregate, writeModel *
 				provider.Name,
 				provider.ClientID,
 				provider.ClientSecret,
-				c.idpConfigEncryption,
+				crypto.ForContext(ctx, c.idpConfigEncryption),
 				provider.Scopes,
 				provider.IDPOptions,
 			)
@@ -1511,6 +1535,17 @@ func (c *Commands) prepareAddOrgLDAPProvider(a *org.Aggregate, writeModel *OrgLD
 		if len(provider.UserFilters) == 0 {
 			return nil, zerrors.ThrowInvalidArgument(nil, "ORG-aAx9x1n", "Errors.Invalid.Argument")
 		}
+		if err := ldap.ValidateRootCA(provider.RootCA); err != nil {
+			return nil, zerrors.ThrowInvalidArgument(err, "ORG-Rc7x1", "Errors.IDP.RootCAInvalid")
+		}
+		if !provider.GroupResolution.Valid() {
+			return nil, zerrors.ThrowInvalidArgument(nil, "ORG-Gr7x1", "Errors.Invalid.Argument")
+		}
+		if !validLDAPMetadataAttributes(provider.LDAPAttributes.MetadataAttributes) {
+			return nil, zerrors.ThrowInvalidArgument(nil, "ORG-Md7x1", "Errors.Invalid.Argument")
+		}
+		provider.GroupBase = strings.TrimSpace(provider.GroupBase)
+		provider.GroupNameAttribute = strings.TrimSpace(provider.GroupNameAttribute)
 		return func(ctx context.Context, filter preparation.FilterToQueryReducer) ([]eventstore.Command, error) {
 			events, err := filter(ctx, writeModel.Query())
 			if err != nil {
@@ -1520,7 +1555,7 @@ func (c *Commands) prepareAddOrgLDAPProvider(a *org.Aggregate, writeModel *OrgLD
 			if err = writeModel.Reduce(); err != nil {
 				return nil, err
 			}
-			secret, err := crypto.Encrypt([]byte(provider.BindPassword), c.idpConfigEncryption)
+			secret, err := crypto.Encrypt([]byte(provider.BindPassword), crypto.ForContext(ctx, c.idpConfigEncryption))
 			if err != nil {
 				return nil, err
 			}
@@ -1539,6 +1574,10 @@ func (c *Commands) prepareAddOrgLDAPProvider(a *org.Aggregate, writeModel *OrgLD
 					provider.UserObjectClasses,
 					provider.UserFilters,
 					provider.Timeout,
+					provider.RootCA,
+					provider.GroupResolution,
+					provider.GroupBase,
+					provider.GroupNameAttribute,
 					provider.LDAPAttributes,
 					provider.IDPOptions,
 				),
@@ -1573,6 +1612,17 @@ func (c *Commands) prepareUpdateOrgLDAPProvider(a *org.Aggregate, writeModel *Or
 		if len(provider.UserFilters) == 0 {
 			return nil, zerrors.ThrowInvalidArgument(nil, "ORG-aBx901n", "Errors.Invalid.Argument")
 		}
+		if err := ldap.ValidateRootCA(provider.RootCA); err != nil {
+			return nil, zerrors.ThrowInvalidArgument(err, "ORG-Rc7x2", "Errors.IDP.RootCAInvalid")
+		}
+		if !provider.GroupResolution.Valid() {
+			return nil, zerrors.ThrowInvalidArgument(nil, "ORG-Gr7x2", "Errors.Invalid.Argument")
+		}
+		if !validLDAPMetadataAttributes(provider.LDAPAttributes.MetadataAttributes) {
+			return nil, zerrors.ThrowInvalidArgument(nil, "ORG-Md7x2", "Errors.Invalid.Argument")
+		}
+		provider.GroupBase = strings.TrimSpace(provider.GroupBase)
+		provider.GroupNameAttribute = strings.TrimSpace(provider.GroupNameAttribute)
 		return func(ctx context.Context, filter preparation.FilterToQueryReducer) ([]eventstore.Command, error) {
 			events, err := filter(ctx, writeModel.Query())
 			if err != nil {
@@ -1599,7 +1649,11 @@ func (c *Commands) prepareUpdateOrgLDAPProvider(a *org.Aggregate, writeModel *Or
 				provider.UserObjectClasses,
 				provider.UserFilters,
 				provider.Timeout,
-				c.idpConfigEncryption,
+				provider.RootCA,
+				provider.GroupResolution,
+				provider.GroupBase,
+				provider.GroupNameAttribute,
+				crypto.ForContext(ctx, c.idpConfigEncryption),
 				provider.LDAPAttributes,
 				provider.IDPOptions,
 			)
@@ -1625,6 +1679,9 @@ func (c *Commands) prepareAddOrgAppleProvider(a *org.Aggregate, writeModel *OrgA
 		if len(provider.PrivateKey) == 0 {
 			return nil, zerrors.ThrowInvalidArgument(nil, "ORG-GVD4n", "Errors.IDP.PrivateKeyMissing")
 		}
+		if err := apple.ValidatePrivateKey(provider.PrivateKey); err != nil {
+			return nil, zerrors.ThrowInvalidArgument(err, "ORG-Vk3hx", "Errors.IDP.PrivateKeyInvalid")
+		}
 		return func(ctx context.Context, filter preparation.FilterToQueryReducer) ([]eventstore.Command, error) {
 			events, err := filter(ctx, writeModel.Query())
 			if err != nil {
@@ -1634,7 +1691,7 @@ func (c *Commands) prepareAddOrgAppleProvider(a *org.Aggregate, writeModel *OrgA
 			if err = writeModel.Reduce(); err != nil {
 				return nil, err
 			}
-			privateKey, err := crypto.Encrypt(provider.PrivateKey, c.idpConfigEncryption)
+			privateKey, err := crypto.Encrypt(provider.PrivateKey, crypto.ForContext(ctx, c.idpConfigEncryption))
 			if err != nil {
 				return nil, err
 			}
@@ -1670,6 +1727,11 @@ func (c *Commands) prepareUpdateOrgAppleProvider(a *org.Aggregate, writeModel *O
 		if provider.KeyID = strings.TrimSpace(provider.KeyID); provider.KeyID == "" {
 			return nil, zerrors.ThrowInvalidArgument(nil, "ORG-Gh4z2", "Errors.IDP.KeyIDMissing")
 		}
+		if len(provider.PrivateKey) > 0 {
+			if err := apple.ValidatePrivateKey(provider.PrivateKey); err != nil {
+				return nil, zerrors.ThrowInvalidArgument(err, "ORG-Vk4hx", "Errors.IDP.PrivateKeyInvalid")
+			}
+		}
 		return func(ctx context.Context, filter preparation.FilterToQueryReducer) ([]eventstore.Command, error) {
 			events, err := filter(ctx, writeModel.Query())
 			if err != nil {
@@ -1691,7 +1753,7 @@ func (c *Commands) prepareUpdateOrgAppleProvider(a *org.Aggregate, writeModel *O
 				provider.TeamID,
 				provider.KeyID,
 				provider.PrivateKey,
-				c.idpConfigEncryption,
+				crypto.ForContext(ctx, c.idpConfigEncryption),
 				provider.Scopes,
 				provider.IDPOptions,
 			)
@@ -1731,7 +1793,7 @@ func (c *Commands) prepareAddOrgSAMLProvider(a *org.Aggregate, writeModel *OrgSA
 			if err != nil {
 				return nil, err
 			}
-			keyEnc, err := crypto.Encrypt(key, c.idpConfigEncryption)
+			keyEnc, err := crypto.Encrypt(key, crypto.ForContext(ctx, c.idpConfigEncryption))
 			if err != nil {
 				return nil, err
 			}
@@ -1742,6 +1804,7 @@ func (c *Commands) prepareAddOrgSAMLProvider(a *org.Aggregate, writeModel *OrgSA
 					writeModel.ID,
 					provider.Name,
 					provider.Metadata,
+					provider.MetadataURL,
 					keyEnc,
 					cert,
 					provider.Binding,
@@ -1791,9 +1854,10 @@ func (c *Commands) prepareUpdateOrgSAMLProvider(a *org.Aggregate, writeModel *Or
 				writeModel.ID,
 				provider.Name,
 				provider.Metadata,
+				provider.MetadataURL,
 				nil,
 				nil,
-				c.idpConfigEncryption,
+				crypto.ForContext(ctx, c.idpConfigEncryption),
 				provider.Binding,
 				provider.WithSignedRequest,
 				provider.NameIDFormat,
@@ -1836,9 +1900,10 @@ func (c *Commands) prepareRegenerateOrgSAMLProviderCertificate(a *org.Aggregate,
 				writeModel.ID,
 				writeModel.Name,
 				writeModel.Metadata,
+				writeModel.MetadataURL,
 				key,
 				cert,
-				c.idpConfigEncryption,
+				crypto.ForContext(ctx, c.idpConfigEncryption),
 				writeModel.Binding,
 				writeModel.WithSignedRequest,
 				writeModel.NameIDFormat,
@@ -57,7 +57,7 @@ func (c *Commands) addIDPConfig(ctx context.Context, config *domain.IDPConfig, i
 		),
 	}
 	if config.OIDCConfig != nil {
-		clientSecret, err := crypto.Crypt([]byte(config.OIDCConfig.ClientSecretString), c.idpConfigEncryption)
+		clientSecret, err := crypto.Crypt([]byte(config.OIDCConfig.ClientSecretString), crypto.ForContext(ctx, c.idpConfigEncryption))
 		if err != nil {
 			return nil, err
 		}
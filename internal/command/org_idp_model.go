@@ -70,6 +70,7 @@ func (wm *OrgOAuthIDPWriteModel) NewChangedEvent(
 	userEndpoint,
 	idAttribute string,
 	scopes []string,
+	attributeMapping *domain.OAuthAttributeMapping,
 	options idp.Options,
 ) (*org.OAuthIDPChangedEvent, error) {
 
@@ -83,6 +84,7 @@ func (wm *OrgOAuthIDPWriteModel) NewChangedEvent(
 		userEndpoint,
 		idAttribute,
 		scopes,
+		attributeMapping,
 		options,
 	)
 	if err != nil || len(changes) == 0 {
@@ -355,6 +357,8 @@ func (wm *OrgAzureADIDPWriteModel) NewChangedEvent(
 	scopes []string,
 	tenant string,
 	isEmailVerified bool,
+	allowedTenants []string,
+	fetchGroups bool,
 	options idp.Options,
 ) (*org.AzureADIDPChangedEvent, error) {
 
@@ -366,6 +370,8 @@ func (wm *OrgAzureADIDPWriteModel) NewChangedEvent(
 		scopes,
 		tenant,
 		isEmailVerified,
+		allowedTenants,
+		fetchGroups,
 		options,
 	)
 	if err != nil || len(changes) == 0 {
@@ -778,6 +784,10 @@ func (wm *OrgLDAPIDPWriteModel) NewChangedEvent(
 	userObjectClasses []string,
 	userFilters []string,
 	timeout time.Duration,
+	rootCA []byte,
+	groupResolution domain.LDAPGroupResolution,
+	groupBase string,
+	groupNameAttribute string,
 	secretCrypto crypto.EncryptionAlgorithm,
 	attributes idp.LDAPAttributes,
 	options idp.Options,
@@ -794,6 +804,10 @@ func (wm *OrgLDAPIDPWriteModel) NewChangedEvent(
 		userObjectClasses,
 		userFilters,
 		timeout,
+		rootCA,
+		groupResolution,
+		groupBase,
+		groupNameAttribute,
 		secretCrypto,
 		attributes,
 		options,
@@ -922,7 +936,8 @@ func (wm *OrgSAMLIDPWriteModel) NewChangedEvent(
 	aggregate *eventstore.Aggregate,
 	id,
 	name string,
-	metadata,
+	metadata []byte,
+	metadataURL string,
 	key,
 	certificate []byte,
 	secretCrypto crypto.EncryptionAlgorithm,
@@ -935,6 +950,7 @@ func (wm *OrgSAMLIDPWriteModel) NewChangedEvent(
 	changes, err := wm.SAMLIDPWriteModel.NewChanges(
 		name,
 		metadata,
+		metadataURL,
 		key,
 		certificate,
 		secretCrypto,
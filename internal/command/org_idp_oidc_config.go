@@ -3,6 +3,7 @@ package command
 import (
 	"context"
 
+	"github.com/zitadel/zitadel/internal/crypto"
 	"github.com/zitadel/zitadel/internal/domain"
 	"github.com/zitadel/zitadel/internal/zerrors"
 )
@@ -34,7 +35,7 @@ func (c *Commands) ChangeIDPOIDCConfig(ctx context.Context, config *domain.OIDCI
 		config.AuthorizationEndpoint,
 		config.TokenEndpoint,
 		config.ClientSecretString,
-		c.idpConfigEncryption,
+		crypto.ForContext(ctx, c.idpConfigEncryption),
 		config.IDPDisplayNameMapping,
 		config.UsernameMapping,
 		config.Scopes...)
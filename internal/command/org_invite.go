@@ -0,0 +1,241 @@
+package command
+
+import (
+	"context"
+	"slices"
+	"strings"
+
+	"github.com/zitadel/zitadel/internal/crypto"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/repository/org"
+	"github.com/zitadel/zitadel/internal/repository/usergrant"
+	"github.com/zitadel/zitadel/internal/telemetry/tracing"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+type AddOrgInvite struct {
+	// Email is required, unless the code is returned
+	Email    domain.EmailAddress
+	Grants   []*domain.OrgInviteGrant
+	Metadata []*domain.OrgInviteMetadata
+	// ReturnCode returns the code instead of sending the invite to the email
+	ReturnCode bool
+
+	// ID is set by the command
+	ID string
+	// Code is set by the command if ReturnCode is set
+	Code *string
+	// Details are set after a successful execution of the command
+	Details *domain.ObjectDetails
+}
+
+func (i *AddOrgInvite) Validate() (err error) {
+	if i.Email != "" {
+		i.Email = i.Email.Normalize()
+		if err = i.Email.Validate(); err != nil {
+			return err
+		}
+	}
+	if i.Email == "" && !i.ReturnCode {
+		return zerrors.ThrowInvalidArgument(nil, "ORG-Inv1e", "Errors.Org.Invite.EmailMissing")
+	}
+	projects := make([]string, 0, len(i.Grants))
+	for _, grant := range i.Grants {
+		if grant == nil || !grant.IsValid() {
+			return zerrors.ThrowInvalidArgument(nil, "ORG-Inv1g", "Errors.Org.Invite.Invalid")
+		}
+		// a user can only have one grant per project
+		project := grant.ProjectID + grant.ProjectGrantID
+		if slices.Contains(projects, project) {
+			return zerrors.ThrowInvalidArgument(nil, "ORG-Inv2g", "Errors.Org.Invite.Invalid")
+		}
+		projects = append(projects, project)
+	}
+	keys := make([]string, 0, len(i.Metadata))
+	for _, metadata := range i.Metadata {
+		if metadata == nil || len(metadata.Value) == 0 {
+			return zerrors.ThrowInvalidArgument(nil, "ORG-Inv1m", "Errors.Org.Invite.Invalid")
+		}
+		if metadata.Key = strings.TrimSpace(metadata.Key); metadata.Key == "" || slices.Contains(keys, metadata.Key) {
+			return zerrors.ThrowInvalidArgument(nil, "ORG-Inv2m", "Errors.Org.Invite.Invalid")
+		}
+		keys = append(keys, metadata.Key)
+	}
+	return nil
+}
+
+// AddOrgInvite creates a single use invite to register a human user in the organization.
+// The invite is sent to the email, unless the code is returned to pass it on another way.
+func (c *Commands) AddOrgInvite(ctx context.Context, orgID string, invite *AddOrgInvite) (err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	if orgID == "" {
+		return zerrors.ThrowInvalidArgument(nil, "ORG-Inv1i", "Errors.ResourceOwnerMissing")
+	}
+	if err = invite.Validate(); err != nil {
+		return err
+	}
+	if err = c.checkOrgExists(ctx, orgID); err != nil {
+		return err
+	}
+	for _, grant := range invite.Grants {
+		if err = c.checkGroupGrantPreCondition(ctx, grant.ProjectID, grant.ProjectGrantID, grant.RoleKeys, orgID); err != nil {
+			return err
+		}
+	}
+	invite.ID, err = c.idGenerator.Next()
+	if err != nil {
+		return err
+	}
+	code, err := c.newEncryptedCode(ctx, c.eventstore.Filter, domain.SecretGeneratorTypeInitCode, c.userEncryption) //nolint:staticcheck
+	if err != nil {
+		return err
+	}
+	writeModel := NewOrgInviteWriteModel(orgID, invite.ID)
+	if err = c.pushAppendAndReduce(ctx, writeModel,
+		org.NewInviteAddedEvent(ctx,
+			&org.NewAggregate(orgID).Aggregate,
+			invite.ID,
+			invite.Email,
+			code.Crypted,
+			code.Expiry,
+			invite.Grants,
+			invite.Metadata,
+			invite.ReturnCode,
+		),
+	); err != nil {
+		return err
+	}
+	if invite.ReturnCode {
+		invite.Code = &code.Plain
+	}
+	invite.Details = writeModelToObjectDetails(&writeModel.WriteModel)
+	return nil
+}
+
+// RevokeOrgInvite prevents the registration with an unused invite.
+func (c *Commands) RevokeOrgInvite(ctx context.Context, orgID, inviteID string) (_ *domain.ObjectDetails, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	if orgID == "" || inviteID == "" {
+		return nil, zerrors.ThrowInvalidArgument(nil, "ORG-Inv2i", "Errors.IDMissing")
+	}
+	writeModel, err := c.orgInviteWriteModel(ctx, orgID, inviteID)
+	if err != nil {
+		return nil, err
+	}
+	if writeModel.State == domain.OrgInviteStateUnspecified {
+		return nil, zerrors.ThrowNotFound(nil, "ORG-Inv1n", "Errors.Org.Invite.NotFound")
+	}
+	if writeModel.State != domain.OrgInviteStateActive {
+		return nil, zerrors.ThrowPreconditionFailed(nil, "ORG-Inv1p", "Errors.Org.Invite.NotUsable")
+	}
+	if err = c.pushAppendAndReduce(ctx, writeModel,
+		org.NewInviteRevokedEvent(ctx, &org.NewAggregate(orgID).Aggregate, inviteID),
+	); err != nil {
+		return nil, err
+	}
+	return writeModelToObjectDetails(&writeModel.WriteModel), nil
+}
+
+func (c *Commands) OrgInviteSent(ctx context.Context, orgID, inviteID string) error {
+	if orgID == "" || inviteID == "" {
+		return zerrors.ThrowInvalidArgument(nil, "ORG-Inv3i", "Errors.IDMissing")
+	}
+	_, err := c.eventstore.Push(ctx, org.NewInviteSentEvent(ctx, &org.NewAggregate(orgID).Aggregate, inviteID))
+	return err
+}
+
+// RegisterHumanWithInvite registers the human in the organization of the invite.
+// The user, its grants and the usage of the invite are pushed at once,
+// so the invite is either used for exactly one user or not at all.
+func (c *Commands) RegisterHumanWithInvite(ctx context.Context, orgID, inviteID, code string, human *AddHuman, alg crypto.EncryptionAlgorithm) (err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	if orgID == "" || inviteID == "" {
+		return zerrors.ThrowInvalidArgument(nil, "ORG-Inv4i", "Errors.IDMissing")
+	}
+	if code == "" {
+		return zerrors.ThrowInvalidArgument(nil, "ORG-Inv5i", "Errors.User.Code.Empty")
+	}
+	invite, err := c.orgInviteWriteModel(ctx, orgID, inviteID)
+	if err != nil {
+		return err
+	}
+	if invite.State != domain.OrgInviteStateActive {
+		return zerrors.ThrowPreconditionFailed(nil, "ORG-Inv2p", "Errors.Org.Invite.NotUsable")
+	}
+	if err = crypto.VerifyCode(invite.CodeCreationAt, invite.Expiry, invite.Code, code, alg); err != nil {
+		return zerrors.ThrowInvalidArgument(err, "ORG-Inv1c", "Errors.Org.Invite.CodeInvalid")
+	}
+	if invite.Email != "" {
+		if !strings.EqualFold(string(human.Email.Address.Normalize()), string(invite.Email)) {
+			return zerrors.ThrowInvalidArgument(nil, "ORG-Inv3e", "Errors.Org.Invite.EmailMismatch")
+		}
+		// the invite was received with this email
+		human.Email.Verified = true
+	}
+	human.Register = true
+	human.Metadata = inviteMetadata(human.Metadata, invite.Metadata)
+
+	existingHuman, cmds, err := c.addUserHumanCommands(ctx, orgID, human, true, alg)
+	if err != nil {
+		return err
+	}
+	userCmds := len(cmds)
+	for _, grant := range invite.Grants {
+		if err = c.checkGroupGrantPreCondition(ctx, grant.ProjectID, grant.ProjectGrantID, grant.RoleKeys, orgID); err != nil {
+			return err
+		}
+		grantID, err := c.idGenerator.Next()
+		if err != nil {
+			return err
+		}
+		cmds = append(cmds, usergrant.NewUserGrantAddedEvent(ctx,
+			&usergrant.NewAggregate(grantID, orgID).Aggregate,
+			human.ID,
+			grant.ProjectID,
+			grant.ProjectGrantID,
+			grant.RoleKeys,
+		))
+	}
+	cmds = append(cmds, org.NewInviteUsedEvent(ctx, &org.NewAggregate(orgID).Aggregate, inviteID, human.ID))
+
+	events, err := c.eventstore.Push(ctx, cmds...)
+	if err != nil {
+		return err
+	}
+	// the pushed events are in the order of the commands
+	if err = AppendAndReduce(existingHuman, events[:userCmds]...); err != nil {
+		return err
+	}
+	human.Details = writeModelToObjectDetails(&existingHuman.WriteModel)
+	return nil
+}
+
+// inviteMetadata adds the metadata of the invite to the entered metadata of the user,
+// the values of the invite take precedence.
+func inviteMetadata(entries []*AddMetadataEntry, metadata []*domain.OrgInviteMetadata) []*AddMetadataEntry {
+	for _, m := range metadata {
+		index := slices.IndexFunc(entries, func(entry *AddMetadataEntry) bool {
+			return entry.Key == m.Key
+		})
+		if index < 0 {
+			entries = append(entries, &AddMetadataEntry{Key: m.Key, Value: m.Value})
+			continue
+		}
+		entries[index].Value = m.Value
+	}
+	return entries
+}
+
+func (c *Commands) orgInviteWriteModel(ctx context.Context, orgID, inviteID string) (*OrgInviteWriteModel, error) {
+	writeModel := NewOrgInviteWriteModel(orgID, inviteID)
+	if err := c.eventstore.FilterToQueryReducer(ctx, writeModel); err != nil {
+		return nil, err
+	}
+	return writeModel, nil
+}
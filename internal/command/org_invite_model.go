@@ -0,0 +1,97 @@
+package command
+
+import (
+	"time"
+
+	"github.com/zitadel/zitadel/internal/crypto"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/repository/org"
+)
+
+type OrgInviteWriteModel struct {
+	eventstore.WriteModel
+
+	InviteID       string
+	Email          domain.EmailAddress
+	Code           *crypto.CryptoValue
+	CodeCreationAt time.Time
+	Expiry         time.Duration
+	Grants         []*domain.OrgInviteGrant
+	Metadata       []*domain.OrgInviteMetadata
+	UserID         string
+
+	State domain.OrgInviteState
+}
+
+func NewOrgInviteWriteModel(orgID, inviteID string) *OrgInviteWriteModel {
+	return &OrgInviteWriteModel{
+		WriteModel: eventstore.WriteModel{
+			AggregateID:   orgID,
+			ResourceOwner: orgID,
+		},
+		InviteID: inviteID,
+	}
+}
+
+func (wm *OrgInviteWriteModel) AppendEvents(events ...eventstore.Event) {
+	for _, event := range events {
+		switch e := event.(type) {
+		case *org.InviteAddedEvent:
+			if e.InviteID != wm.InviteID {
+				continue
+			}
+			wm.WriteModel.AppendEvents(e)
+		case *org.InviteUsedEvent:
+			if e.InviteID != wm.InviteID {
+				continue
+			}
+			wm.WriteModel.AppendEvents(e)
+		case *org.InviteRevokedEvent:
+			if e.InviteID != wm.InviteID {
+				continue
+			}
+			wm.WriteModel.AppendEvents(e)
+		case *org.OrgRemovedEvent:
+			wm.WriteModel.AppendEvents(e)
+		}
+	}
+}
+
+func (wm *OrgInviteWriteModel) Reduce() error {
+	for _, event := range wm.Events {
+		switch e := event.(type) {
+		case *org.InviteAddedEvent:
+			wm.Email = e.Email
+			wm.Code = e.Code
+			wm.CodeCreationAt = e.CreatedAt()
+			wm.Expiry = e.Expiry
+			wm.Grants = e.Grants
+			wm.Metadata = e.Metadata
+			wm.State = domain.OrgInviteStateActive
+		case *org.InviteUsedEvent:
+			wm.UserID = e.UserID
+			wm.State = domain.OrgInviteStateUsed
+		case *org.InviteRevokedEvent, *org.OrgRemovedEvent:
+			if wm.State == domain.OrgInviteStateActive {
+				wm.State = domain.OrgInviteStateRevoked
+			}
+		}
+	}
+	return wm.WriteModel.Reduce()
+}
+
+func (wm *OrgInviteWriteModel) Query() *eventstore.SearchQueryBuilder {
+	return eventstore.NewSearchQueryBuilder(eventstore.ColumnsEvent).
+		ResourceOwner(wm.ResourceOwner).
+		AddQuery().
+		AggregateTypes(org.AggregateType).
+		AggregateIDs(wm.AggregateID).
+		EventTypes(
+			org.InviteAddedEventType,
+			org.InviteUsedEventType,
+			org.InviteRevokedEventType,
+			org.OrgRemovedEventType,
+		).
+		Builder()
+}
@@ -0,0 +1,523 @@
+package command
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/muhlemmer/gu"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+	"golang.org/x/text/language"
+
+	"github.com/zitadel/zitadel/internal/crypto"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/id"
+	id_mock "github.com/zitadel/zitadel/internal/id/mock"
+	"github.com/zitadel/zitadel/internal/repository/org"
+	"github.com/zitadel/zitadel/internal/repository/project"
+	"github.com/zitadel/zitadel/internal/repository/user"
+	"github.com/zitadel/zitadel/internal/repository/usergrant"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+func TestCommandSide_AddOrgInvite(t *testing.T) {
+	grants := []*domain.OrgInviteGrant{
+		{ProjectID: "project1", RoleKeys: []string{"role1"}},
+	}
+	metadata := []*domain.OrgInviteMetadata{
+		{Key: "department", Value: []byte("sales")},
+	}
+	code := &crypto.CryptoValue{
+		CryptoType: crypto.TypeEncryption,
+		Algorithm:  "enc",
+		KeyID:      "id",
+		Crypted:    []byte("invite"),
+	}
+	type fields struct {
+		eventstore  func(t *testing.T) *eventstore.Eventstore
+		idGenerator id.Generator
+	}
+	type args struct {
+		ctx    context.Context
+		orgID  string
+		invite *AddOrgInvite
+	}
+	type res struct {
+		wantID   string
+		wantCode *string
+		err      error
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		args   args
+		res    res
+	}{
+		{
+			name: "no email, invalid argument error",
+			fields: fields{
+				eventstore: expectEventstore(),
+			},
+			args: args{
+				ctx:    context.Background(),
+				orgID:  "org1",
+				invite: &AddOrgInvite{},
+			},
+			res: res{
+				err: zerrors.ThrowInvalidArgument(nil, "ORG-Inv1e", "Errors.Org.Invite.EmailMissing"),
+			},
+		},
+		{
+			name: "duplicate project, invalid argument error",
+			fields: fields{
+				eventstore: expectEventstore(),
+			},
+			args: args{
+				ctx:   context.Background(),
+				orgID: "org1",
+				invite: &AddOrgInvite{
+					Email: "email@test.ch",
+					Grants: []*domain.OrgInviteGrant{
+						{ProjectID: "project1", RoleKeys: []string{"role1"}},
+						{ProjectID: "project1", RoleKeys: []string{"role2"}},
+					},
+				},
+			},
+			res: res{
+				err: zerrors.ThrowInvalidArgument(nil, "ORG-Inv2g", "Errors.Org.Invite.Invalid"),
+			},
+		},
+		{
+			name: "duplicate metadata key, invalid argument error",
+			fields: fields{
+				eventstore: expectEventstore(),
+			},
+			args: args{
+				ctx:   context.Background(),
+				orgID: "org1",
+				invite: &AddOrgInvite{
+					Email: "email@test.ch",
+					Metadata: []*domain.OrgInviteMetadata{
+						{Key: "department", Value: []byte("sales")},
+						{Key: "department", Value: []byte("marketing")},
+					},
+				},
+			},
+			res: res{
+				err: zerrors.ThrowInvalidArgument(nil, "ORG-Inv2m", "Errors.Org.Invite.Invalid"),
+			},
+		},
+		{
+			name: "role not found, precondition error",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(
+							org.NewOrgAddedEvent(context.Background(), &org.NewAggregate("org1").Aggregate, "org"),
+						),
+					),
+					expectFilter(
+						eventFromEventPusher(
+							project.NewProjectAddedEvent(context.Background(), &project.NewAggregate("project1", "org1").Aggregate, "project", false, false, false, domain.PrivateLabelingSettingUnspecified),
+						),
+					),
+				),
+			},
+			args: args{
+				ctx:   context.Background(),
+				orgID: "org1",
+				invite: &AddOrgInvite{
+					Email:  "email@test.ch",
+					Grants: grants,
+				},
+			},
+			res: res{
+				err: zerrors.ThrowPreconditionFailed(nil, "COMMAND-Grg5r", "Errors.Project.Role.NotFound"),
+			},
+		},
+		{
+			name: "add invite, ok",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(
+							org.NewOrgAddedEvent(context.Background(), &org.NewAggregate("org1").Aggregate, "org"),
+						),
+					),
+					expectFilter(
+						eventFromEventPusher(
+							project.NewProjectAddedEvent(context.Background(), &project.NewAggregate("project1", "org1").Aggregate, "project", false, false, false, domain.PrivateLabelingSettingUnspecified),
+						),
+						eventFromEventPusher(
+							project.NewRoleAddedEvent(context.Background(), &project.NewAggregate("project1", "org1").Aggregate, "role1", "Role 1", ""),
+						),
+					),
+					expectPush(
+						org.NewInviteAddedEvent(context.Background(), &org.NewAggregate("org1").Aggregate,
+							"invite1", "email@test.ch", code, time.Hour, grants, metadata, false,
+						),
+					),
+				),
+				idGenerator: id_mock.NewIDGeneratorExpectIDs(t, "invite1"),
+			},
+			args: args{
+				ctx:   context.Background(),
+				orgID: "org1",
+				invite: &AddOrgInvite{
+					Email:    " email@test.ch ",
+					Grants:   grants,
+					Metadata: metadata,
+				},
+			},
+			res: res{
+				wantID: "invite1",
+			},
+		},
+		{
+			name: "add invite, return code, ok",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(
+							org.NewOrgAddedEvent(context.Background(), &org.NewAggregate("org1").Aggregate, "org"),
+						),
+					),
+					expectPush(
+						org.NewInviteAddedEvent(context.Background(), &org.NewAggregate("org1").Aggregate,
+							"invite1", "", code, time.Hour, nil, nil, true,
+						),
+					),
+				),
+				idGenerator: id_mock.NewIDGeneratorExpectIDs(t, "invite1"),
+			},
+			args: args{
+				ctx:   context.Background(),
+				orgID: "org1",
+				invite: &AddOrgInvite{
+					ReturnCode: true,
+				},
+			},
+			res: res{
+				wantID:   "invite1",
+				wantCode: gu.Ptr("invite"),
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := &Commands{
+				eventstore:       tt.fields.eventstore(t),
+				idGenerator:      tt.fields.idGenerator,
+				newEncryptedCode: mockEncryptedCode("invite", time.Hour),
+			}
+			err := r.AddOrgInvite(tt.args.ctx, tt.args.orgID, tt.args.invite)
+			assert.ErrorIs(t, err, tt.res.err)
+			if tt.res.err == nil {
+				assert.Equal(t, tt.res.wantID, tt.args.invite.ID)
+				assert.Equal(t, tt.res.wantCode, tt.args.invite.Code)
+				assert.Equal(t, "org1", tt.args.invite.Details.ResourceOwner)
+			}
+		})
+	}
+}
+
+func TestCommandSide_RevokeOrgInvite(t *testing.T) {
+	type fields struct {
+		eventstore func(t *testing.T) *eventstore.Eventstore
+	}
+	type args struct {
+		ctx      context.Context
+		orgID    string
+		inviteID string
+	}
+	type res struct {
+		want *domain.ObjectDetails
+		err  error
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		args   args
+		res    res
+	}{
+		{
+			name: "invite not found, not found error",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(),
+				),
+			},
+			args: args{
+				ctx:      context.Background(),
+				orgID:    "org1",
+				inviteID: "invite1",
+			},
+			res: res{
+				err: zerrors.ThrowNotFound(nil, "ORG-Inv1n", "Errors.Org.Invite.NotFound"),
+			},
+		},
+		{
+			name: "invite used, precondition error",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(
+							org.NewInviteAddedEvent(context.Background(), &org.NewAggregate("org1").Aggregate,
+								"invite1", "email@test.ch", nil, time.Hour, nil, nil, false,
+							),
+						),
+						eventFromEventPusher(
+							org.NewInviteUsedEvent(context.Background(), &org.NewAggregate("org1").Aggregate, "invite1", "user1"),
+						),
+					),
+				),
+			},
+			args: args{
+				ctx:      context.Background(),
+				orgID:    "org1",
+				inviteID: "invite1",
+			},
+			res: res{
+				err: zerrors.ThrowPreconditionFailed(nil, "ORG-Inv1p", "Errors.Org.Invite.NotUsable"),
+			},
+		},
+		{
+			name: "revoke invite, ok",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(
+							org.NewInviteAddedEvent(context.Background(), &org.NewAggregate("org1").Aggregate,
+								"invite1", "email@test.ch", nil, time.Hour, nil, nil, false,
+							),
+						),
+						eventFromEventPusher(
+							org.NewInviteRevokedEvent(context.Background(), &org.NewAggregate("org1").Aggregate, "invite2"),
+						),
+					),
+					expectPush(
+						org.NewInviteRevokedEvent(context.Background(), &org.NewAggregate("org1").Aggregate, "invite1"),
+					),
+				),
+			},
+			args: args{
+				ctx:      context.Background(),
+				orgID:    "org1",
+				inviteID: "invite1",
+			},
+			res: res{
+				want: &domain.ObjectDetails{
+					ResourceOwner: "org1",
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := &Commands{
+				eventstore: tt.fields.eventstore(t),
+			}
+			got, err := r.RevokeOrgInvite(tt.args.ctx, tt.args.orgID, tt.args.inviteID)
+			assert.ErrorIs(t, err, tt.res.err)
+			assert.Equal(t, tt.res.want, got)
+		})
+	}
+}
+
+func TestCommandSide_RegisterHumanWithInvite(t *testing.T) {
+	userAgg := user.NewAggregate("user1", "org1")
+	code := &crypto.CryptoValue{
+		CryptoType: crypto.TypeEncryption,
+		Algorithm:  "enc",
+		KeyID:      "id",
+		Crypted:    []byte("invite"),
+	}
+	grants := []*domain.OrgInviteGrant{
+		{ProjectID: "project1", RoleKeys: []string{"role1"}},
+	}
+	metadata := []*domain.OrgInviteMetadata{
+		{Key: "department", Value: []byte("sales")},
+	}
+	inviteAdded := func(email domain.EmailAddress) *org.InviteAddedEvent {
+		return org.NewInviteAddedEvent(context.Background(), &org.NewAggregate("org1").Aggregate,
+			"invite1", email, code, time.Hour, grants, metadata, false,
+		)
+	}
+	human := func() *AddHuman {
+		return &AddHuman{
+			Username:  "username",
+			FirstName: "firstname",
+			LastName:  "lastname",
+			Email: Email{
+				Address: "email@test.ch",
+			},
+			PreferredLanguage: language.English,
+		}
+	}
+	type fields struct {
+		eventstore  func(t *testing.T) *eventstore.Eventstore
+		idGenerator id.Generator
+	}
+	type args struct {
+		ctx   context.Context
+		code  string
+		human *AddHuman
+	}
+	type res struct {
+		wantID string
+		err    error
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		args   args
+		res    res
+	}{
+		{
+			name: "invite revoked, precondition error",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusherWithCreationDateNow(inviteAdded("email@test.ch")),
+						eventFromEventPusher(
+							org.NewInviteRevokedEvent(context.Background(), &org.NewAggregate("org1").Aggregate, "invite1"),
+						),
+					),
+				),
+			},
+			args: args{
+				ctx:   context.Background(),
+				code:  "invite",
+				human: human(),
+			},
+			res: res{
+				err: zerrors.ThrowPreconditionFailed(nil, "ORG-Inv2p", "Errors.Org.Invite.NotUsable"),
+			},
+		},
+		{
+			name: "invite expired, invalid argument error",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(inviteAdded("email@test.ch")),
+					),
+				),
+			},
+			args: args{
+				ctx:   context.Background(),
+				code:  "invite",
+				human: human(),
+			},
+			res: res{
+				err: zerrors.ThrowInvalidArgument(nil, "ORG-Inv1c", "Errors.Org.Invite.CodeInvalid"),
+			},
+		},
+		{
+			name: "wrong code, invalid argument error",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusherWithCreationDateNow(inviteAdded("email@test.ch")),
+					),
+				),
+			},
+			args: args{
+				ctx:   context.Background(),
+				code:  "wrong",
+				human: human(),
+			},
+			res: res{
+				err: zerrors.ThrowInvalidArgument(nil, "ORG-Inv1c", "Errors.Org.Invite.CodeInvalid"),
+			},
+		},
+		{
+			name: "other email, invalid argument error",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusherWithCreationDateNow(inviteAdded("other@test.ch")),
+					),
+				),
+			},
+			args: args{
+				ctx:   context.Background(),
+				code:  "invite",
+				human: human(),
+			},
+			res: res{
+				err: zerrors.ThrowInvalidArgument(nil, "ORG-Inv3e", "Errors.Org.Invite.EmailMismatch"),
+			},
+		},
+		{
+			name: "register with invite, ok",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusherWithCreationDateNow(inviteAdded("email@test.ch")),
+					),
+					expectFilter(),
+					expectFilter(
+						eventFromEventPusher(
+							org.NewDomainPolicyAddedEvent(context.Background(),
+								&userAgg.Aggregate,
+								true,
+								true,
+								true,
+							),
+						),
+					),
+					expectFilter(),
+					expectFilter(
+						eventFromEventPusher(
+							project.NewProjectAddedEvent(context.Background(), &project.NewAggregate("project1", "org1").Aggregate, "project", false, false, false, domain.PrivateLabelingSettingUnspecified),
+						),
+						eventFromEventPusher(
+							project.NewRoleAddedEvent(context.Background(), &project.NewAggregate("project1", "org1").Aggregate, "role1", "Role 1", ""),
+						),
+					),
+					expectPush(
+						newRegisterHumanEvent("username", "", false, true, "", language.English),
+						user.NewHumanEmailVerifiedEvent(context.Background(), &userAgg.Aggregate),
+						user.NewHumanInitialCodeAddedEvent(context.Background(), &userAgg.Aggregate, &crypto.CryptoValue{
+							CryptoType: crypto.TypeEncryption,
+							Algorithm:  "enc",
+							KeyID:      "id",
+							Crypted:    []byte("userinit"),
+						}, time.Hour, ""),
+						user.NewMetadataSetEvent(context.Background(), &userAgg.Aggregate, "department", []byte("sales")),
+						usergrant.NewUserGrantAddedEvent(context.Background(), &usergrant.NewAggregate("grant1", "org1").Aggregate,
+							"user1", "project1", "", []string{"role1"},
+						),
+						org.NewInviteUsedEvent(context.Background(), &org.NewAggregate("org1").Aggregate, "invite1", "user1"),
+					),
+				),
+				idGenerator: id_mock.NewIDGeneratorExpectIDs(t, "user1", "grant1"),
+			},
+			args: args{
+				ctx:   context.Background(),
+				code:  "invite",
+				human: human(),
+			},
+			res: res{
+				wantID: "user1",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := &Commands{
+				eventstore:       tt.fields.eventstore(t),
+				idGenerator:      tt.fields.idGenerator,
+				newEncryptedCode: mockEncryptedCode("userinit", time.Hour),
+			}
+			err := r.RegisterHumanWithInvite(tt.args.ctx, "org1", "invite1", tt.args.code, tt.args.human, crypto.CreateMockEncryptionAlg(gomock.NewController(t)))
+			assert.ErrorIs(t, err, tt.res.err)
+			if tt.res.err == nil {
+				assert.Equal(t, tt.res.wantID, tt.args.human.ID)
+				assert.Equal(t, "org1", tt.args.human.Details.ResourceOwner)
+			}
+		})
+	}
+}
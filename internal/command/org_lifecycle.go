@@ -0,0 +1,138 @@
+package command
+
+import (
+	"context"
+	"time"
+
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/repository/org"
+	"github.com/zitadel/zitadel/internal/telemetry/tracing"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+// ArchiveOrg makes the organization read-only and blocks the logins of its users.
+// The data of the organization is retained until it's removed.
+func (c *Commands) ArchiveOrg(ctx context.Context, orgID string) (_ *domain.ObjectDetails, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	orgWriteModel, err := c.getExistingOrgWriteModel(ctx, orgID)
+	if err != nil {
+		return nil, err
+	}
+	if orgWriteModel.State == domain.OrgStateArchived {
+		return nil, zerrors.ThrowPreconditionFailed(nil, "ORG-Ac4hv", "Errors.Org.AlreadyArchived")
+	}
+	if err = c.pushAppendAndReduce(ctx, orgWriteModel,
+		org.NewOrgArchivedEvent(ctx, OrgAggregateFromWriteModel(&orgWriteModel.WriteModel)),
+	); err != nil {
+		return nil, err
+	}
+	return writeModelToObjectDetails(&orgWriteModel.WriteModel), nil
+}
+
+// UnarchiveOrg makes the archived organization active again,
+// a scheduled deletion is canceled.
+func (c *Commands) UnarchiveOrg(ctx context.Context, orgID string) (_ *domain.ObjectDetails, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	orgWriteModel, err := c.getExistingOrgWriteModel(ctx, orgID)
+	if err != nil {
+		return nil, err
+	}
+	if orgWriteModel.State != domain.OrgStateArchived {
+		return nil, zerrors.ThrowPreconditionFailed(nil, "ORG-Un3rc", "Errors.Org.NotArchived")
+	}
+	orgAgg := OrgAggregateFromWriteModel(&orgWriteModel.WriteModel)
+	events := make([]eventstore.Command, 0, 2)
+	if !orgWriteModel.DeletionDate.IsZero() {
+		events = append(events, org.NewOrgDeletionCanceledEvent(ctx, orgAgg))
+	}
+	events = append(events, org.NewOrgUnarchivedEvent(ctx, orgAgg))
+	if err = c.pushAppendAndReduce(ctx, orgWriteModel, events...); err != nil {
+		return nil, err
+	}
+	return writeModelToObjectDetails(&orgWriteModel.WriteModel), nil
+}
+
+// ScheduleOrgDeletion schedules the permanent deletion of the archived organization.
+// The organization can be purged as soon as the deletionDate has passed,
+// until then the deletion can be canceled.
+func (c *Commands) ScheduleOrgDeletion(ctx context.Context, orgID string, deletionDate time.Time) (_ *domain.ObjectDetails, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	if !deletionDate.After(time.Now()) {
+		return nil, zerrors.ThrowInvalidArgument(nil, "ORG-Sd8fe", "Errors.Org.Deletion.DateInvalid")
+	}
+	orgWriteModel, err := c.getExistingOrgWriteModel(ctx, orgID)
+	if err != nil {
+		return nil, err
+	}
+	if orgWriteModel.State != domain.OrgStateArchived {
+		return nil, zerrors.ThrowPreconditionFailed(nil, "ORG-Sd2na", "Errors.Org.NotArchived")
+	}
+	if !orgWriteModel.DeletionDate.IsZero() {
+		return nil, zerrors.ThrowPreconditionFailed(nil, "ORG-Sd5as", "Errors.Org.Deletion.AlreadyScheduled")
+	}
+	if err = c.pushAppendAndReduce(ctx, orgWriteModel,
+		org.NewOrgDeletionScheduledEvent(ctx, OrgAggregateFromWriteModel(&orgWriteModel.WriteModel), deletionDate),
+	); err != nil {
+		return nil, err
+	}
+	return writeModelToObjectDetails(&orgWriteModel.WriteModel), nil
+}
+
+// CancelOrgDeletion cancels the scheduled deletion, the organization stays archived.
+func (c *Commands) CancelOrgDeletion(ctx context.Context, orgID string) (_ *domain.ObjectDetails, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	orgWriteModel, err := c.getExistingOrgWriteModel(ctx, orgID)
+	if err != nil {
+		return nil, err
+	}
+	if orgWriteModel.DeletionDate.IsZero() {
+		return nil, zerrors.ThrowPreconditionFailed(nil, "ORG-Cd7ns", "Errors.Org.Deletion.NotScheduled")
+	}
+	if err = c.pushAppendAndReduce(ctx, orgWriteModel,
+		org.NewOrgDeletionCanceledEvent(ctx, OrgAggregateFromWriteModel(&orgWriteModel.WriteModel)),
+	); err != nil {
+		return nil, err
+	}
+	return writeModelToObjectDetails(&orgWriteModel.WriteModel), nil
+}
+
+// PurgeOrg permanently removes the organization after its scheduled deletion date has passed.
+func (c *Commands) PurgeOrg(ctx context.Context, orgID string) (_ *domain.ObjectDetails, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	orgWriteModel, err := c.getExistingOrgWriteModel(ctx, orgID)
+	if err != nil {
+		return nil, err
+	}
+	if orgWriteModel.DeletionDate.IsZero() {
+		return nil, zerrors.ThrowPreconditionFailed(nil, "ORG-Pg3ns", "Errors.Org.Deletion.NotScheduled")
+	}
+	if orgWriteModel.DeletionDate.After(time.Now()) {
+		return nil, zerrors.ThrowPreconditionFailed(nil, "ORG-Pg8dd", "Errors.Org.Deletion.NotDue")
+	}
+	return c.RemoveOrg(ctx, orgID)
+}
+
+func (c *Commands) getExistingOrgWriteModel(ctx context.Context, orgID string) (*OrgWriteModel, error) {
+	if orgID == "" {
+		return nil, zerrors.ThrowInvalidArgument(nil, "ORG-Lf2id", "Errors.IDMissing")
+	}
+	orgWriteModel, err := c.getOrgWriteModelByID(ctx, orgID)
+	if err != nil {
+		return nil, err
+	}
+	if !isOrgStateExists(orgWriteModel.State) {
+		return nil, zerrors.ThrowNotFound(nil, "ORG-Lf9nf", "Errors.Org.NotFound")
+	}
+	return orgWriteModel, nil
+}
@@ -0,0 +1,467 @@
+package command
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/repository/org"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+func TestCommandSide_ArchiveOrg(t *testing.T) {
+	type fields struct {
+		eventstore func(t *testing.T) *eventstore.Eventstore
+	}
+	type args struct {
+		ctx   context.Context
+		orgID string
+	}
+	type res struct {
+		want *domain.ObjectDetails
+		err  error
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		args   args
+		res    res
+	}{
+		{
+			name: "org not found, not found error",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(),
+				),
+			},
+			args: args{
+				ctx:   context.Background(),
+				orgID: "org1",
+			},
+			res: res{
+				err: zerrors.ThrowNotFound(nil, "ORG-Lf9nf", "Errors.Org.NotFound"),
+			},
+		},
+		{
+			name: "org already archived, precondition error",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(
+							org.NewOrgAddedEvent(context.Background(), &org.NewAggregate("org1").Aggregate, "org"),
+						),
+						eventFromEventPusher(
+							org.NewOrgArchivedEvent(context.Background(), &org.NewAggregate("org1").Aggregate),
+						),
+					),
+				),
+			},
+			args: args{
+				ctx:   context.Background(),
+				orgID: "org1",
+			},
+			res: res{
+				err: zerrors.ThrowPreconditionFailed(nil, "ORG-Ac4hv", "Errors.Org.AlreadyArchived"),
+			},
+		},
+		{
+			name: "archive org, ok",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(
+							org.NewOrgAddedEvent(context.Background(), &org.NewAggregate("org1").Aggregate, "org"),
+						),
+					),
+					expectPush(
+						org.NewOrgArchivedEvent(context.Background(), &org.NewAggregate("org1").Aggregate),
+					),
+				),
+			},
+			args: args{
+				ctx:   context.Background(),
+				orgID: "org1",
+			},
+			res: res{
+				want: &domain.ObjectDetails{
+					ResourceOwner: "org1",
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := &Commands{
+				eventstore: tt.fields.eventstore(t),
+			}
+			got, err := r.ArchiveOrg(tt.args.ctx, tt.args.orgID)
+			assert.ErrorIs(t, err, tt.res.err)
+			assert.Equal(t, tt.res.want, got)
+		})
+	}
+}
+
+func TestCommandSide_UnarchiveOrg(t *testing.T) {
+	type fields struct {
+		eventstore func(t *testing.T) *eventstore.Eventstore
+	}
+	type args struct {
+		ctx   context.Context
+		orgID string
+	}
+	type res struct {
+		want *domain.ObjectDetails
+		err  error
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		args   args
+		res    res
+	}{
+		{
+			name: "org not archived, precondition error",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(
+							org.NewOrgAddedEvent(context.Background(), &org.NewAggregate("org1").Aggregate, "org"),
+						),
+					),
+				),
+			},
+			args: args{
+				ctx:   context.Background(),
+				orgID: "org1",
+			},
+			res: res{
+				err: zerrors.ThrowPreconditionFailed(nil, "ORG-Un3rc", "Errors.Org.NotArchived"),
+			},
+		},
+		{
+			name: "unarchive org with scheduled deletion, deletion canceled",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(
+							org.NewOrgAddedEvent(context.Background(), &org.NewAggregate("org1").Aggregate, "org"),
+						),
+						eventFromEventPusher(
+							org.NewOrgArchivedEvent(context.Background(), &org.NewAggregate("org1").Aggregate),
+						),
+						eventFromEventPusher(
+							org.NewOrgDeletionScheduledEvent(context.Background(), &org.NewAggregate("org1").Aggregate, time.Now().Add(time.Hour)),
+						),
+					),
+					expectPush(
+						org.NewOrgDeletionCanceledEvent(context.Background(), &org.NewAggregate("org1").Aggregate),
+						org.NewOrgUnarchivedEvent(context.Background(), &org.NewAggregate("org1").Aggregate),
+					),
+				),
+			},
+			args: args{
+				ctx:   context.Background(),
+				orgID: "org1",
+			},
+			res: res{
+				want: &domain.ObjectDetails{
+					ResourceOwner: "org1",
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := &Commands{
+				eventstore: tt.fields.eventstore(t),
+			}
+			got, err := r.UnarchiveOrg(tt.args.ctx, tt.args.orgID)
+			assert.ErrorIs(t, err, tt.res.err)
+			assert.Equal(t, tt.res.want, got)
+		})
+	}
+}
+
+func TestCommandSide_ScheduleOrgDeletion(t *testing.T) {
+	deletionDate := time.Now().Add(30 * 24 * time.Hour)
+	type fields struct {
+		eventstore func(t *testing.T) *eventstore.Eventstore
+	}
+	type args struct {
+		ctx          context.Context
+		orgID        string
+		deletionDate time.Time
+	}
+	type res struct {
+		want *domain.ObjectDetails
+		err  error
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		args   args
+		res    res
+	}{
+		{
+			name: "deletion date in the past, invalid argument error",
+			fields: fields{
+				eventstore: expectEventstore(),
+			},
+			args: args{
+				ctx:          context.Background(),
+				orgID:        "org1",
+				deletionDate: time.Now().Add(-time.Hour),
+			},
+			res: res{
+				err: zerrors.ThrowInvalidArgument(nil, "ORG-Sd8fe", "Errors.Org.Deletion.DateInvalid"),
+			},
+		},
+		{
+			name: "org not archived, precondition error",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(
+							org.NewOrgAddedEvent(context.Background(), &org.NewAggregate("org1").Aggregate, "org"),
+						),
+					),
+				),
+			},
+			args: args{
+				ctx:          context.Background(),
+				orgID:        "org1",
+				deletionDate: deletionDate,
+			},
+			res: res{
+				err: zerrors.ThrowPreconditionFailed(nil, "ORG-Sd2na", "Errors.Org.NotArchived"),
+			},
+		},
+		{
+			name: "deletion already scheduled, precondition error",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(
+							org.NewOrgAddedEvent(context.Background(), &org.NewAggregate("org1").Aggregate, "org"),
+						),
+						eventFromEventPusher(
+							org.NewOrgArchivedEvent(context.Background(), &org.NewAggregate("org1").Aggregate),
+						),
+						eventFromEventPusher(
+							org.NewOrgDeletionScheduledEvent(context.Background(), &org.NewAggregate("org1").Aggregate, deletionDate),
+						),
+					),
+				),
+			},
+			args: args{
+				ctx:          context.Background(),
+				orgID:        "org1",
+				deletionDate: deletionDate,
+			},
+			res: res{
+				err: zerrors.ThrowPreconditionFailed(nil, "ORG-Sd5as", "Errors.Org.Deletion.AlreadyScheduled"),
+			},
+		},
+		{
+			name: "schedule deletion, ok",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(
+							org.NewOrgAddedEvent(context.Background(), &org.NewAggregate("org1").Aggregate, "org"),
+						),
+						eventFromEventPusher(
+							org.NewOrgArchivedEvent(context.Background(), &org.NewAggregate("org1").Aggregate),
+						),
+					),
+					expectPush(
+						org.NewOrgDeletionScheduledEvent(context.Background(), &org.NewAggregate("org1").Aggregate, deletionDate),
+					),
+				),
+			},
+			args: args{
+				ctx:          context.Background(),
+				orgID:        "org1",
+				deletionDate: deletionDate,
+			},
+			res: res{
+				want: &domain.ObjectDetails{
+					ResourceOwner: "org1",
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := &Commands{
+				eventstore: tt.fields.eventstore(t),
+			}
+			got, err := r.ScheduleOrgDeletion(tt.args.ctx, tt.args.orgID, tt.args.deletionDate)
+			assert.ErrorIs(t, err, tt.res.err)
+			assert.Equal(t, tt.res.want, got)
+		})
+	}
+}
+
+func TestCommandSide_CancelOrgDeletion(t *testing.T) {
+	type fields struct {
+		eventstore func(t *testing.T) *eventstore.Eventstore
+	}
+	type args struct {
+		ctx   context.Context
+		orgID string
+	}
+	type res struct {
+		want *domain.ObjectDetails
+		err  error
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		args   args
+		res    res
+	}{
+		{
+			name: "deletion not scheduled, precondition error",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(
+							org.NewOrgAddedEvent(context.Background(), &org.NewAggregate("org1").Aggregate, "org"),
+						),
+						eventFromEventPusher(
+							org.NewOrgArchivedEvent(context.Background(), &org.NewAggregate("org1").Aggregate),
+						),
+					),
+				),
+			},
+			args: args{
+				ctx:   context.Background(),
+				orgID: "org1",
+			},
+			res: res{
+				err: zerrors.ThrowPreconditionFailed(nil, "ORG-Cd7ns", "Errors.Org.Deletion.NotScheduled"),
+			},
+		},
+		{
+			name: "cancel deletion, ok",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(
+							org.NewOrgAddedEvent(context.Background(), &org.NewAggregate("org1").Aggregate, "org"),
+						),
+						eventFromEventPusher(
+							org.NewOrgArchivedEvent(context.Background(), &org.NewAggregate("org1").Aggregate),
+						),
+						eventFromEventPusher(
+							org.NewOrgDeletionScheduledEvent(context.Background(), &org.NewAggregate("org1").Aggregate, time.Now().Add(time.Hour)),
+						),
+					),
+					expectPush(
+						org.NewOrgDeletionCanceledEvent(context.Background(), &org.NewAggregate("org1").Aggregate),
+					),
+				),
+			},
+			args: args{
+				ctx:   context.Background(),
+				orgID: "org1",
+			},
+			res: res{
+				want: &domain.ObjectDetails{
+					ResourceOwner: "org1",
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := &Commands{
+				eventstore: tt.fields.eventstore(t),
+			}
+			got, err := r.CancelOrgDeletion(tt.args.ctx, tt.args.orgID)
+			assert.ErrorIs(t, err, tt.res.err)
+			assert.Equal(t, tt.res.want, got)
+		})
+	}
+}
+
+func TestCommandSide_PurgeOrg(t *testing.T) {
+	type fields struct {
+		eventstore func(t *testing.T) *eventstore.Eventstore
+	}
+	type args struct {
+		ctx   context.Context
+		orgID string
+	}
+	type res struct {
+		err error
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		args   args
+		res    res
+	}{
+		{
+			name: "deletion not scheduled, precondition error",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(
+							org.NewOrgAddedEvent(context.Background(), &org.NewAggregate("org1").Aggregate, "org"),
+						),
+						eventFromEventPusher(
+							org.NewOrgArchivedEvent(context.Background(), &org.NewAggregate("org1").Aggregate),
+						),
+					),
+				),
+			},
+			args: args{
+				ctx:   context.Background(),
+				orgID: "org1",
+			},
+			res: res{
+				err: zerrors.ThrowPreconditionFailed(nil, "ORG-Pg3ns", "Errors.Org.Deletion.NotScheduled"),
+			},
+		},
+		{
+			name: "deletion not due, precondition error",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(
+							org.NewOrgAddedEvent(context.Background(), &org.NewAggregate("org1").Aggregate, "org"),
+						),
+						eventFromEventPusher(
+							org.NewOrgArchivedEvent(context.Background(), &org.NewAggregate("org1").Aggregate),
+						),
+						eventFromEventPusher(
+							org.NewOrgDeletionScheduledEvent(context.Background(), &org.NewAggregate("org1").Aggregate, time.Now().Add(time.Hour)),
+						),
+					),
+				),
+			},
+			args: args{
+				ctx:   context.Background(),
+				orgID: "org1",
+			},
+			res: res{
+				err: zerrors.ThrowPreconditionFailed(nil, "ORG-Pg8dd", "Errors.Org.Deletion.NotDue"),
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := &Commands{
+				eventstore: tt.fields.eventstore(t),
+			}
+			_, err := r.PurgeOrg(tt.args.ctx, tt.args.orgID)
+			assert.ErrorIs(t, err, tt.res.err)
+		})
+	}
+}
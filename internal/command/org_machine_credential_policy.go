@@ -0,0 +1,119 @@
+package command
+
+import (
+	"context"
+	"time"
+
+	"github.com/zitadel/zitadel/internal/command/preparation"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/repository/org"
+	"github.com/zitadel/zitadel/internal/telemetry/tracing"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+// MachineCredentialPolicy limits the lifetime of the keys and personal access tokens of the machine users of an organization.
+type MachineCredentialPolicy struct {
+	// MaxLifetime is optional, 0 doesn't limit the lifetime of new credentials
+	MaxLifetime time.Duration
+	// NotifyBefore is optional, the org owners are notified this duration before a credential expires
+	NotifyBefore time.Duration
+}
+
+func (p *MachineCredentialPolicy) Validate() error {
+	if p.MaxLifetime < 0 || p.NotifyBefore < 0 ||
+		(p.MaxLifetime == 0 && p.NotifyBefore == 0) {
+		return zerrors.ThrowInvalidArgument(nil, "ORG-Mcp3i", "Errors.Org.MachineCredentialPolicy.Invalid")
+	}
+	if p.MaxLifetime > 0 && p.NotifyBefore >= p.MaxLifetime {
+		return zerrors.ThrowInvalidArgument(nil, "ORG-Mcp4n", "Errors.Org.MachineCredentialPolicy.Invalid")
+	}
+	return nil
+}
+
+// SetOrgMachineCredentialPolicy sets the policy which limits the lifetime of new machine keys and personal access tokens
+// and notifies the owners of the organization about credentials which expire soon.
+func (c *Commands) SetOrgMachineCredentialPolicy(ctx context.Context, orgID string, policy *MachineCredentialPolicy) (_ *domain.ObjectDetails, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	if orgID == "" {
+		return nil, zerrors.ThrowInvalidArgument(nil, "ORG-Mcp1i", "Errors.ResourceOwnerMissing")
+	}
+	if err = policy.Validate(); err != nil {
+		return nil, err
+	}
+	if err = c.checkOrgExists(ctx, orgID); err != nil {
+		return nil, err
+	}
+	writeModel, err := c.orgMachineCredentialPolicyWriteModel(ctx, orgID)
+	if err != nil {
+		return nil, err
+	}
+	if writeModel.State.Exists() &&
+		writeModel.MaxLifetime == policy.MaxLifetime &&
+		writeModel.NotifyBefore == policy.NotifyBefore {
+		return nil, zerrors.ThrowPreconditionFailed(nil, "ORG-Mcp5c", "Errors.Org.MachineCredentialPolicy.NotChanged")
+	}
+	if err = c.pushAppendAndReduce(ctx, writeModel,
+		org.NewMachineCredentialPolicySetEvent(ctx, &org.NewAggregate(orgID).Aggregate, policy.MaxLifetime, policy.NotifyBefore),
+	); err != nil {
+		return nil, err
+	}
+	return writeModelToObjectDetails(&writeModel.WriteModel), nil
+}
+
+// RemoveOrgMachineCredentialPolicy removes the limits of the machine credentials of the organization.
+// The expiration of existing credentials isn't changed.
+func (c *Commands) RemoveOrgMachineCredentialPolicy(ctx context.Context, orgID string) (_ *domain.ObjectDetails, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	if orgID == "" {
+		return nil, zerrors.ThrowInvalidArgument(nil, "ORG-Mcp2i", "Errors.ResourceOwnerMissing")
+	}
+	writeModel, err := c.orgMachineCredentialPolicyWriteModel(ctx, orgID)
+	if err != nil {
+		return nil, err
+	}
+	if !writeModel.State.Exists() {
+		return nil, zerrors.ThrowNotFound(nil, "ORG-Mcp6n", "Errors.Org.MachineCredentialPolicy.NotFound")
+	}
+	if err = c.pushAppendAndReduce(ctx, writeModel,
+		org.NewMachineCredentialPolicyRemovedEvent(ctx, &org.NewAggregate(orgID).Aggregate),
+	); err != nil {
+		return nil, err
+	}
+	return writeModelToObjectDetails(&writeModel.WriteModel), nil
+}
+
+func (c *Commands) orgMachineCredentialPolicyWriteModel(ctx context.Context, orgID string) (*OrgMachineCredentialPolicyWriteModel, error) {
+	writeModel := NewOrgMachineCredentialPolicyWriteModel(orgID)
+	if err := c.eventstore.FilterToQueryReducer(ctx, writeModel); err != nil {
+		return nil, err
+	}
+	return writeModel, nil
+}
+
+func orgMachineCredentialPolicy(ctx context.Context, filter preparation.FilterToQueryReducer, orgID string) (*OrgMachineCredentialPolicyWriteModel, error) {
+	policy := NewOrgMachineCredentialPolicyWriteModel(orgID)
+	events, err := filter(ctx, policy.Query())
+	if err != nil {
+		return nil, err
+	}
+	if len(events) == 0 {
+		return policy, nil
+	}
+	policy.AppendEvents(events...)
+	err = policy.Reduce()
+	return policy, err
+}
+
+// limitMachineCredentialExpiration applies the max lifetime of the machine credential policy of the organization
+// to the expiration date of a new credential
+func limitMachineCredentialExpiration(ctx context.Context, filter preparation.FilterToQueryReducer, orgID string, expirationDate time.Time) (time.Time, error) {
+	policy, err := orgMachineCredentialPolicy(ctx, filter, orgID)
+	if err != nil {
+		return time.Time{}, err
+	}
+	return domain.LimitExpirationDate(expirationDate, policy.MaxLifetime)
+}
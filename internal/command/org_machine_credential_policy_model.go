@@ -0,0 +1,56 @@
+package command
+
+import (
+	"time"
+
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/repository/org"
+)
+
+type OrgMachineCredentialPolicyWriteModel struct {
+	eventstore.WriteModel
+
+	State        domain.PolicyState
+	MaxLifetime  time.Duration
+	NotifyBefore time.Duration
+}
+
+func NewOrgMachineCredentialPolicyWriteModel(orgID string) *OrgMachineCredentialPolicyWriteModel {
+	return &OrgMachineCredentialPolicyWriteModel{
+		WriteModel: eventstore.WriteModel{
+			AggregateID:   orgID,
+			ResourceOwner: orgID,
+		},
+	}
+}
+
+func (wm *OrgMachineCredentialPolicyWriteModel) Reduce() error {
+	for _, event := range wm.Events {
+		switch e := event.(type) {
+		case *org.MachineCredentialPolicySetEvent:
+			wm.State = domain.PolicyStateActive
+			wm.MaxLifetime = e.MaxLifetime
+			wm.NotifyBefore = e.NotifyBefore
+		case *org.MachineCredentialPolicyRemovedEvent, *org.OrgRemovedEvent:
+			wm.State = domain.PolicyStateRemoved
+			wm.MaxLifetime = 0
+			wm.NotifyBefore = 0
+		}
+	}
+	return wm.WriteModel.Reduce()
+}
+
+func (wm *OrgMachineCredentialPolicyWriteModel) Query() *eventstore.SearchQueryBuilder {
+	return eventstore.NewSearchQueryBuilder(eventstore.ColumnsEvent).
+		ResourceOwner(wm.ResourceOwner).
+		AddQuery().
+		AggregateTypes(org.AggregateType).
+		AggregateIDs(wm.AggregateID).
+		EventTypes(
+			org.MachineCredentialPolicySetEventType,
+			org.MachineCredentialPolicyRemovedEventType,
+			org.OrgRemovedEventType,
+		).
+		Builder()
+}
@@ -0,0 +1,214 @@
+package command
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/repository/org"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+func TestCommandSide_SetOrgMachineCredentialPolicy(t *testing.T) {
+	type fields struct {
+		eventstore func(t *testing.T) *eventstore.Eventstore
+	}
+	type args struct {
+		ctx    context.Context
+		orgID  string
+		policy *MachineCredentialPolicy
+	}
+	type res struct {
+		want *domain.ObjectDetails
+		err  error
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		args   args
+		res    res
+	}{
+		{
+			name: "no limit, invalid argument error",
+			fields: fields{
+				eventstore: expectEventstore(),
+			},
+			args: args{
+				ctx:    context.Background(),
+				orgID:  "org1",
+				policy: &MachineCredentialPolicy{},
+			},
+			res: res{
+				err: zerrors.ThrowInvalidArgument(nil, "ORG-Mcp3i", "Errors.Org.MachineCredentialPolicy.Invalid"),
+			},
+		},
+		{
+			name: "notification before max lifetime, invalid argument error",
+			fields: fields{
+				eventstore: expectEventstore(),
+			},
+			args: args{
+				ctx:    context.Background(),
+				orgID:  "org1",
+				policy: &MachineCredentialPolicy{MaxLifetime: time.Hour, NotifyBefore: time.Hour},
+			},
+			res: res{
+				err: zerrors.ThrowInvalidArgument(nil, "ORG-Mcp4n", "Errors.Org.MachineCredentialPolicy.Invalid"),
+			},
+		},
+		{
+			name: "org not found, precondition error",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(),
+				),
+			},
+			args: args{
+				ctx:    context.Background(),
+				orgID:  "org1",
+				policy: &MachineCredentialPolicy{MaxLifetime: time.Hour},
+			},
+			res: res{
+				err: zerrors.ThrowPreconditionFailed(nil, "COMMAND-QXPGs", "Errors.Org.NotFound"),
+			},
+		},
+		{
+			name: "policy not changed, precondition error",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(
+							org.NewOrgAddedEvent(context.Background(), &org.NewAggregate("org1").Aggregate, "org"),
+						),
+					),
+					expectFilter(
+						eventFromEventPusher(
+							org.NewMachineCredentialPolicySetEvent(context.Background(), &org.NewAggregate("org1").Aggregate, time.Hour, 0),
+						),
+					),
+				),
+			},
+			args: args{
+				ctx:    context.Background(),
+				orgID:  "org1",
+				policy: &MachineCredentialPolicy{MaxLifetime: time.Hour},
+			},
+			res: res{
+				err: zerrors.ThrowPreconditionFailed(nil, "ORG-Mcp5c", "Errors.Org.MachineCredentialPolicy.NotChanged"),
+			},
+		},
+		{
+			name: "set policy, ok",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(
+							org.NewOrgAddedEvent(context.Background(), &org.NewAggregate("org1").Aggregate, "org"),
+						),
+					),
+					expectFilter(),
+					expectPush(
+						org.NewMachineCredentialPolicySetEvent(context.Background(), &org.NewAggregate("org1").Aggregate, 365*24*time.Hour, 7*24*time.Hour),
+					),
+				),
+			},
+			args: args{
+				ctx:   context.Background(),
+				orgID: "org1",
+				policy: &MachineCredentialPolicy{
+					MaxLifetime:  365 * 24 * time.Hour,
+					NotifyBefore: 7 * 24 * time.Hour,
+				},
+			},
+			res: res{
+				want: &domain.ObjectDetails{
+					ResourceOwner: "org1",
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := &Commands{
+				eventstore: tt.fields.eventstore(t),
+			}
+			got, err := r.SetOrgMachineCredentialPolicy(tt.args.ctx, tt.args.orgID, tt.args.policy)
+			assert.ErrorIs(t, err, tt.res.err)
+			assert.Equal(t, tt.res.want, got)
+		})
+	}
+}
+
+func TestCommandSide_RemoveOrgMachineCredentialPolicy(t *testing.T) {
+	type fields struct {
+		eventstore func(t *testing.T) *eventstore.Eventstore
+	}
+	type args struct {
+		ctx   context.Context
+		orgID string
+	}
+	type res struct {
+		want *domain.ObjectDetails
+		err  error
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		args   args
+		res    res
+	}{
+		{
+			name: "policy not found, not found error",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(),
+				),
+			},
+			args: args{
+				ctx:   context.Background(),
+				orgID: "org1",
+			},
+			res: res{
+				err: zerrors.ThrowNotFound(nil, "ORG-Mcp6n", "Errors.Org.MachineCredentialPolicy.NotFound"),
+			},
+		},
+		{
+			name: "remove policy, ok",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(
+							org.NewMachineCredentialPolicySetEvent(context.Background(), &org.NewAggregate("org1").Aggregate, time.Hour, 0),
+						),
+					),
+					expectPush(
+						org.NewMachineCredentialPolicyRemovedEvent(context.Background(), &org.NewAggregate("org1").Aggregate),
+					),
+				),
+			},
+			args: args{
+				ctx:   context.Background(),
+				orgID: "org1",
+			},
+			res: res{
+				want: &domain.ObjectDetails{
+					ResourceOwner: "org1",
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := &Commands{
+				eventstore: tt.fields.eventstore(t),
+			}
+			got, err := r.RemoveOrgMachineCredentialPolicy(tt.args.ctx, tt.args.orgID)
+			assert.ErrorIs(t, err, tt.res.err)
+			assert.Equal(t, tt.res.want, got)
+		})
+	}
+}
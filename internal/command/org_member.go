@@ -21,13 +21,17 @@ func (c *Commands) AddOrgMemberCommand(a *org.Aggregate, userID string, roles ..
 			return nil, zerrors.ThrowInvalidArgument(nil, "V2-PfYhb", "Errors.Invalid.Argument")
 		}
 
-		if len(domain.CheckForInvalidRoles(roles, domain.OrgRolePrefix, c.zitadelRoles)) > 0 && len(domain.CheckForInvalidRoles(roles, domain.RoleSelfManagementGlobal, c.zitadelRoles)) > 0 {
+		configuredRoles, customRoles := domain.SplitOrgCustomRoles(roles)
+		if len(configuredRoles) > 0 && len(domain.CheckForInvalidRoles(configuredRoles, domain.OrgRolePrefix, c.zitadelRoles)) > 0 && len(domain.CheckForInvalidRoles(configuredRoles, domain.RoleSelfManagementGlobal, c.zitadelRoles)) > 0 {
 			return nil, zerrors.ThrowInvalidArgument(nil, "Org-4N8es", "Errors.Org.MemberInvalid")
 		}
 		return func(ctx context.Context, filter preparation.FilterToQueryReducer) (_ []eventstore.Command, err error) {
 				ctx, span := tracing.NewSpan(ctx)
 				defer func() { span.EndWithError(err) }()
 
+				if err = checkOrgCustomRoles(ctx, filter, a.ID, customRoles); err != nil {
+					return nil, err
+				}
 				if exists, err := ExistsUser(ctx, filter, userID, ""); err != nil || !exists {
 					return nil, zerrors.ThrowPreconditionFailed(err, "ORG-GoXOn", "Errors.User.NotFound")
 				}
@@ -120,9 +124,13 @@ func (c *Commands) ChangeOrgMember(ctx context.Context, member *domain.Member) (
 	if !member.IsValid() {
 		return nil, zerrors.ThrowInvalidArgument(nil, "Org-LiaZi", "Errors.Org.MemberInvalid")
 	}
-	if len(domain.CheckForInvalidRoles(member.Roles, domain.OrgRolePrefix, c.zitadelRoles)) > 0 {
+	configuredRoles, customRoles := domain.SplitOrgCustomRoles(member.Roles)
+	if len(domain.CheckForInvalidRoles(configuredRoles, domain.OrgRolePrefix, c.zitadelRoles)) > 0 {
 		return nil, zerrors.ThrowInvalidArgument(nil, "IAM-m9fG8", "Errors.Org.MemberInvalid")
 	}
+	if err := checkOrgCustomRoles(ctx, c.eventstore.Filter, member.AggregateID, customRoles); err != nil {
+		return nil, err
+	}
 
 	existingMember, err := c.orgMemberWriteModelByID(ctx, member.AggregateID, member.UserID)
 	if err != nil {
@@ -613,6 +613,33 @@ func TestCommandSide_ChangeOrgMember(t *testing.T) {
 				err: zerrors.IsNotFound,
 			},
 		},
+		{
+			name: "custom role not existing, precondition error",
+			fields: fields{
+				eventstore: eventstoreExpect(
+					t,
+					expectFilter(),
+				),
+				zitadelRoles: []authz.RoleMapping{
+					{
+						Role: domain.RoleOrgOwner,
+					},
+				},
+			},
+			args: args{
+				ctx: context.Background(),
+				member: &domain.Member{
+					ObjectRoot: models.ObjectRoot{
+						AggregateID: "org1",
+					},
+					UserID: "user1",
+					Roles:  []string{"ORG_OWNER", "ORG_CUSTOM_HELPDESK"},
+				},
+			},
+			res: res{
+				err: zerrors.IsPreconditionFailed,
+			},
+		},
 		{
 			name: "member not changed, precondition error",
 			fields: fields{
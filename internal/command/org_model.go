@@ -1,6 +1,8 @@
 package command
 
 import (
+	"time"
+
 	"github.com/zitadel/zitadel/internal/domain"
 	"github.com/zitadel/zitadel/internal/eventstore"
 	"github.com/zitadel/zitadel/internal/repository/org"
@@ -12,6 +14,8 @@ type OrgWriteModel struct {
 	Name          string
 	State         domain.OrgState
 	PrimaryDomain string
+	// DeletionDate is set while the permanent deletion of the organization is scheduled
+	DeletionDate time.Time
 }
 
 func NewOrgWriteModel(orgID string) *OrgWriteModel {
@@ -35,6 +39,14 @@ func (wm *OrgWriteModel) Reduce() error {
 			wm.State = domain.OrgStateActive
 		case *org.OrgRemovedEvent:
 			wm.State = domain.OrgStateRemoved
+		case *org.OrgArchivedEvent:
+			wm.State = domain.OrgStateArchived
+		case *org.OrgUnarchivedEvent:
+			wm.State = domain.OrgStateActive
+		case *org.OrgDeletionScheduledEvent:
+			wm.DeletionDate = e.DeletionDate
+		case *org.OrgDeletionCanceledEvent:
+			wm.DeletionDate = time.Time{}
 		case *org.OrgChangedEvent:
 			wm.Name = e.Name
 		case *org.DomainPrimarySetEvent:
@@ -56,6 +68,10 @@ func (wm *OrgWriteModel) Query() *eventstore.SearchQueryBuilder {
 			org.OrgDeactivatedEventType,
 			org.OrgReactivatedEventType,
 			org.OrgRemovedEventType,
+			org.OrgArchivedEventType,
+			org.OrgUnarchivedEventType,
+			org.OrgDeletionScheduledEventType,
+			org.OrgDeletionCanceledEventType,
 			org.OrgDomainPrimarySetEventType).
 		Builder()
 }
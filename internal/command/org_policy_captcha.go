@@ -0,0 +1,181 @@
+package command
+
+import (
+	"context"
+
+	"github.com/zitadel/zitadel/internal/command/preparation"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/repository/org"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+func (c *Commands) AddCaptchaPolicy(ctx context.Context, resourceOwner string, policy *CaptchaPolicy) (*domain.ObjectDetails, error) {
+	if resourceOwner == "" {
+		return nil, zerrors.ThrowInvalidArgument(nil, "Org-Ck2ns", "Errors.ResourceOwnerMissing")
+	}
+	orgAgg := org.NewAggregate(resourceOwner)
+	cmds, err := preparation.PrepareCommands(ctx, c.eventstore.Filter, c.prepareAddCaptchaPolicy(orgAgg, policy))
+	if err != nil {
+		return nil, err
+	}
+	pushedEvents, err := c.eventstore.Push(ctx, cmds...)
+	if err != nil {
+		return nil, err
+	}
+	return pushedEventsToObjectDetails(pushedEvents), nil
+}
+
+func (c *Commands) prepareAddCaptchaPolicy(
+	a *org.Aggregate,
+	policy *CaptchaPolicy,
+) preparation.Validation {
+	return func() (preparation.CreateCommands, error) {
+		if err := policy.IsValid(true); err != nil {
+			return nil, err
+		}
+		return func(ctx context.Context, filter preparation.FilterToQueryReducer) ([]eventstore.Command, error) {
+			writeModel := NewOrgCaptchaPolicyWriteModel(a.Aggregate.ID)
+			events, err := filter(ctx, writeModel.Query())
+			if err != nil {
+				return nil, err
+			}
+			writeModel.AppendEvents(events...)
+			if err = writeModel.Reduce(); err != nil {
+				return nil, err
+			}
+			if writeModel.State == domain.PolicyStateActive {
+				return nil, zerrors.ThrowAlreadyExists(nil, "Org-Ue8fp", "Errors.Org.CaptchaPolicy.AlreadyExists")
+			}
+			secret, err := c.encryptCaptchaSecret(policy.Secret)
+			if err != nil {
+				return nil, err
+			}
+			return []eventstore.Command{
+				org.NewCaptchaPolicyAddedEvent(ctx, &a.Aggregate,
+					policy.Type,
+					policy.SiteKey,
+					secret,
+					policy.EnabledOnRegister,
+					policy.EnabledOnPasswordReset,
+					policy.EnabledOnPasswordless,
+					policy.EnabledOnSession,
+				),
+			}, nil
+		}, nil
+	}
+}
+
+func (c *Commands) ChangeCaptchaPolicy(ctx context.Context, resourceOwner string, policy *CaptchaPolicy) (*domain.ObjectDetails, error) {
+	if resourceOwner == "" {
+		return nil, zerrors.ThrowInvalidArgument(nil, "Org-Jw3bd", "Errors.ResourceOwnerMissing")
+	}
+	orgAgg := org.NewAggregate(resourceOwner)
+	cmds, err := preparation.PrepareCommands(ctx, c.eventstore.Filter, c.prepareChangeCaptchaPolicy(orgAgg, policy))
+	if err != nil {
+		return nil, err
+	}
+	pushedEvents, err := c.eventstore.Push(ctx, cmds...)
+	if err != nil {
+		return nil, err
+	}
+	return pushedEventsToObjectDetails(pushedEvents), nil
+}
+
+func (c *Commands) prepareChangeCaptchaPolicy(
+	a *org.Aggregate,
+	policy *CaptchaPolicy,
+) preparation.Validation {
+	return func() (preparation.CreateCommands, error) {
+		if err := policy.IsValid(false); err != nil {
+			return nil, err
+		}
+		return func(ctx context.Context, filter preparation.FilterToQueryReducer) ([]eventstore.Command, error) {
+			writeModel := NewOrgCaptchaPolicyWriteModel(a.Aggregate.ID)
+			events, err := filter(ctx, writeModel.Query())
+			if err != nil {
+				return nil, err
+			}
+			writeModel.AppendEvents(events...)
+			if err = writeModel.Reduce(); err != nil {
+				return nil, err
+			}
+			if writeModel.State == domain.PolicyStateUnspecified || writeModel.State == domain.PolicyStateRemoved {
+				return nil, zerrors.ThrowNotFound(nil, "ORG-Zo3ma", "Errors.Org.CaptchaPolicy.NotFound")
+			}
+			secret, err := c.encryptCaptchaSecret(policy.Secret)
+			if err != nil {
+				return nil, err
+			}
+			change, hasChanged := writeModel.NewChangedEvent(ctx, &a.Aggregate, policy, secret)
+			if !hasChanged {
+				return nil, zerrors.ThrowPreconditionFailed(nil, "Org-Bn4ws", "Errors.Org.CaptchaPolicy.NotChanged")
+			}
+			return []eventstore.Command{
+				change,
+			}, nil
+		}, nil
+	}
+}
+
+func (c *Commands) RemoveCaptchaPolicy(ctx context.Context, resourceOwner string) (*domain.ObjectDetails, error) {
+	if resourceOwner == "" {
+		return nil, zerrors.ThrowInvalidArgument(nil, "Org-Pq8vn", "Errors.ResourceOwnerMissing")
+	}
+	orgAgg := org.NewAggregate(resourceOwner)
+	cmds, err := preparation.PrepareCommands(ctx, c.eventstore.Filter, prepareRemoveCaptchaPolicy(orgAgg))
+	if err != nil {
+		return nil, err
+	}
+	pushedEvents, err := c.eventstore.Push(ctx, cmds...)
+	if err != nil {
+		return nil, err
+	}
+	return pushedEventsToObjectDetails(pushedEvents), nil
+}
+
+func prepareRemoveCaptchaPolicy(
+	a *org.Aggregate,
+) preparation.Validation {
+	return func() (preparation.CreateCommands, error) {
+		return func(ctx context.Context, filter preparation.FilterToQueryReducer) ([]eventstore.Command, error) {
+			writeModel := NewOrgCaptchaPolicyWriteModel(a.Aggregate.ID)
+			events, err := filter(ctx, writeModel.Query())
+			if err != nil {
+				return nil, err
+			}
+			writeModel.AppendEvents(events...)
+			if err = writeModel.Reduce(); err != nil {
+				return nil, err
+			}
+			if writeModel.State == domain.PolicyStateUnspecified || writeModel.State == domain.PolicyStateRemoved {
+				return nil, zerrors.ThrowNotFound(nil, "ORG-Wd7ca", "Errors.Org.CaptchaPolicy.NotFound")
+			}
+			return []eventstore.Command{
+				org.NewCaptchaPolicyRemovedEvent(ctx, &a.Aggregate),
+			}, nil
+		}, nil
+	}
+}
+
+// getCaptchaPolicy returns the captcha policy of the organization or the default policy of the instance if the organization has none.
+// If neither is configured, nil is returned.
+func getCaptchaPolicy(ctx context.Context, orgID string, queryReducer func(ctx context.Context, r eventstore.QueryReducer) error) (*CaptchaPolicyWriteModel, error) {
+	if orgID != "" {
+		orgWm := NewOrgCaptchaPolicyWriteModel(orgID)
+		if err := queryReducer(ctx, orgWm); err != nil {
+			return nil, err
+		}
+		if orgWm.State == domain.PolicyStateActive {
+			return &orgWm.CaptchaPolicyWriteModel, nil
+		}
+	}
+	instanceWm := NewInstanceCaptchaPolicyWriteModel(ctx)
+	if err := queryReducer(ctx, instanceWm); err != nil {
+		return nil, err
+	}
+	if instanceWm.State == domain.PolicyStateActive {
+		return &instanceWm.CaptchaPolicyWriteModel, nil
+	}
+	return nil, nil
+}
@@ -0,0 +1,71 @@
+package command
+
+import (
+	"context"
+
+	"github.com/zitadel/zitadel/internal/crypto"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/repository/org"
+)
+
+type OrgCaptchaPolicyWriteModel struct {
+	CaptchaPolicyWriteModel
+}
+
+func NewOrgCaptchaPolicyWriteModel(orgID string) *OrgCaptchaPolicyWriteModel {
+	return &OrgCaptchaPolicyWriteModel{
+		CaptchaPolicyWriteModel{
+			WriteModel: eventstore.WriteModel{
+				AggregateID:   orgID,
+				ResourceOwner: orgID,
+			},
+		},
+	}
+}
+
+func (wm *OrgCaptchaPolicyWriteModel) AppendEvents(events ...eventstore.Event) {
+	for _, event := range events {
+		switch e := event.(type) {
+		case *org.CaptchaPolicyAddedEvent:
+			wm.CaptchaPolicyWriteModel.AppendEvents(&e.CaptchaPolicyAddedEvent)
+		case *org.CaptchaPolicyChangedEvent:
+			wm.CaptchaPolicyWriteModel.AppendEvents(&e.CaptchaPolicyChangedEvent)
+		case *org.CaptchaPolicyRemovedEvent:
+			wm.CaptchaPolicyWriteModel.AppendEvents(&e.CaptchaPolicyRemovedEvent)
+		}
+	}
+}
+
+func (wm *OrgCaptchaPolicyWriteModel) Reduce() error {
+	return wm.CaptchaPolicyWriteModel.Reduce()
+}
+
+func (wm *OrgCaptchaPolicyWriteModel) Query() *eventstore.SearchQueryBuilder {
+	return eventstore.NewSearchQueryBuilder(eventstore.ColumnsEvent).
+		ResourceOwner(wm.ResourceOwner).
+		AddQuery().
+		AggregateIDs(wm.CaptchaPolicyWriteModel.AggregateID).
+		AggregateTypes(org.AggregateType).
+		EventTypes(
+			org.CaptchaPolicyAddedEventType,
+			org.CaptchaPolicyChangedEventType,
+			org.CaptchaPolicyRemovedEventType).
+		Builder()
+}
+
+func (wm *OrgCaptchaPolicyWriteModel) NewChangedEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	policy *CaptchaPolicy,
+	secret *crypto.CryptoValue,
+) (*org.CaptchaPolicyChangedEvent, bool) {
+	changes := wm.changes(policy, secret)
+	if len(changes) == 0 {
+		return nil, false
+	}
+	changedEvent, err := org.NewCaptchaPolicyChangedEvent(ctx, aggregate, changes)
+	if err != nil {
+		return nil, false
+	}
+	return changedEvent, true
+}
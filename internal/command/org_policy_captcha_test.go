@@ -0,0 +1,493 @@
+package command
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	"github.com/zitadel/zitadel/internal/crypto"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/repository/org"
+	"github.com/zitadel/zitadel/internal/repository/policy"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+func TestCommandSide_AddCaptchaPolicy(t *testing.T) {
+	type fields struct {
+		eventstore   func(*testing.T) *eventstore.Eventstore
+		secretCrypto crypto.EncryptionAlgorithm
+	}
+	type args struct {
+		ctx    context.Context
+		orgID  string
+		policy *CaptchaPolicy
+	}
+	type res struct {
+		want *domain.ObjectDetails
+		err  func(error) bool
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		args   args
+		res    res
+	}{
+		{
+			name: "org id missing, invalid argument error",
+			fields: fields{
+				eventstore: expectEventstore(),
+			},
+			args: args{
+				ctx:   context.Background(),
+				orgID: "",
+				policy: &CaptchaPolicy{
+					Type:    domain.CaptchaTypeHCaptcha,
+					SiteKey: "siteKey",
+					Secret:  "secret",
+				},
+			},
+			res: res{
+				err: zerrors.IsErrorInvalidArgument,
+			},
+		},
+		{
+			name: "invalid type, invalid argument error",
+			fields: fields{
+				eventstore: expectEventstore(),
+			},
+			args: args{
+				ctx:   context.Background(),
+				orgID: "org1",
+				policy: &CaptchaPolicy{
+					SiteKey: "siteKey",
+					Secret:  "secret",
+				},
+			},
+			res: res{
+				err: zerrors.IsErrorInvalidArgument,
+			},
+		},
+		{
+			name: "secret missing, invalid argument error",
+			fields: fields{
+				eventstore: expectEventstore(),
+			},
+			args: args{
+				ctx:   context.Background(),
+				orgID: "org1",
+				policy: &CaptchaPolicy{
+					Type:    domain.CaptchaTypeHCaptcha,
+					SiteKey: "siteKey",
+				},
+			},
+			res: res{
+				err: zerrors.IsErrorInvalidArgument,
+			},
+		},
+		{
+			name: "policy already existing, already exists error",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(
+							org.NewCaptchaPolicyAddedEvent(context.Background(),
+								&org.NewAggregate("org1").Aggregate,
+								domain.CaptchaTypeHCaptcha,
+								"siteKey",
+								nil,
+								true,
+								false,
+								false,
+								false,
+							),
+						),
+					),
+				),
+			},
+			args: args{
+				ctx:   context.Background(),
+				orgID: "org1",
+				policy: &CaptchaPolicy{
+					Type:    domain.CaptchaTypeHCaptcha,
+					SiteKey: "siteKey",
+					Secret:  "secret",
+				},
+			},
+			res: res{
+				err: zerrors.IsErrorAlreadyExists,
+			},
+		},
+		{
+			name: "add policy, ok",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(),
+					expectPush(
+						org.NewCaptchaPolicyAddedEvent(context.Background(),
+							&org.NewAggregate("org1").Aggregate,
+							domain.CaptchaTypeTurnstile,
+							"siteKey",
+							&crypto.CryptoValue{
+								CryptoType: crypto.TypeEncryption,
+								Algorithm:  "enc",
+								KeyID:      "id",
+								Crypted:    []byte("secret"),
+							},
+							true,
+							true,
+							false,
+							false,
+						),
+					),
+				),
+				secretCrypto: crypto.CreateMockEncryptionAlg(gomock.NewController(t)),
+			},
+			args: args{
+				ctx:   context.Background(),
+				orgID: "org1",
+				policy: &CaptchaPolicy{
+					Type:                   domain.CaptchaTypeTurnstile,
+					SiteKey:                "siteKey",
+					Secret:                 "secret",
+					EnabledOnRegister:      true,
+					EnabledOnPasswordReset: true,
+				},
+			},
+			res: res{
+				want: &domain.ObjectDetails{
+					ResourceOwner: "org1",
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := &Commands{
+				eventstore:        tt.fields.eventstore(t),
+				captchaEncryption: tt.fields.secretCrypto,
+			}
+			got, err := r.AddCaptchaPolicy(tt.args.ctx, tt.args.orgID, tt.args.policy)
+			if tt.res.err == nil {
+				assert.NoError(t, err)
+			}
+			if tt.res.err != nil && !tt.res.err(err) {
+				t.Errorf("got wrong err: %v ", err)
+			}
+			if tt.res.err == nil {
+				assert.Equal(t, tt.res.want, got)
+			}
+		})
+	}
+}
+
+func TestCommandSide_ChangeCaptchaPolicy(t *testing.T) {
+	type fields struct {
+		eventstore   func(*testing.T) *eventstore.Eventstore
+		secretCrypto crypto.EncryptionAlgorithm
+	}
+	type args struct {
+		ctx    context.Context
+		orgID  string
+		policy *CaptchaPolicy
+	}
+	type res struct {
+		want *domain.ObjectDetails
+		err  func(error) bool
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		args   args
+		res    res
+	}{
+		{
+			name: "org id missing, invalid argument error",
+			fields: fields{
+				eventstore: expectEventstore(),
+			},
+			args: args{
+				ctx:   context.Background(),
+				orgID: "",
+				policy: &CaptchaPolicy{
+					Type:    domain.CaptchaTypeHCaptcha,
+					SiteKey: "siteKey",
+				},
+			},
+			res: res{
+				err: zerrors.IsErrorInvalidArgument,
+			},
+		},
+		{
+			name: "policy not existing, not found error",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(),
+				),
+			},
+			args: args{
+				ctx:   context.Background(),
+				orgID: "org1",
+				policy: &CaptchaPolicy{
+					Type:    domain.CaptchaTypeHCaptcha,
+					SiteKey: "siteKey",
+				},
+			},
+			res: res{
+				err: zerrors.IsNotFound,
+			},
+		},
+		{
+			name: "no changes, precondition error",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(
+							org.NewCaptchaPolicyAddedEvent(context.Background(),
+								&org.NewAggregate("org1").Aggregate,
+								domain.CaptchaTypeHCaptcha,
+								"siteKey",
+								nil,
+								true,
+								false,
+								false,
+								false,
+							),
+						),
+					),
+				),
+			},
+			args: args{
+				ctx:   context.Background(),
+				orgID: "org1",
+				policy: &CaptchaPolicy{
+					Type:              domain.CaptchaTypeHCaptcha,
+					SiteKey:           "siteKey",
+					EnabledOnRegister: true,
+				},
+			},
+			res: res{
+				err: zerrors.IsPreconditionFailed,
+			},
+		},
+		{
+			name: "change, ok",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(
+							org.NewCaptchaPolicyAddedEvent(context.Background(),
+								&org.NewAggregate("org1").Aggregate,
+								domain.CaptchaTypeHCaptcha,
+								"siteKey",
+								nil,
+								true,
+								false,
+								false,
+								false,
+							),
+						),
+					),
+					expectPush(
+						newCaptchaPolicyChangedEvent(context.Background(), "org1",
+							policy.ChangeCaptchaType(domain.CaptchaTypeReCaptcha),
+							policy.ChangeCaptchaSiteKey("siteKey2"),
+							policy.ChangeCaptchaSecret(&crypto.CryptoValue{
+								CryptoType: crypto.TypeEncryption,
+								Algorithm:  "enc",
+								KeyID:      "id",
+								Crypted:    []byte("secret2"),
+							}),
+							policy.ChangeCaptchaEnabledOnPasswordless(true),
+						),
+					),
+				),
+				secretCrypto: crypto.CreateMockEncryptionAlg(gomock.NewController(t)),
+			},
+			args: args{
+				ctx:   context.Background(),
+				orgID: "org1",
+				policy: &CaptchaPolicy{
+					Type:                  domain.CaptchaTypeReCaptcha,
+					SiteKey:               "siteKey2",
+					Secret:                "secret2",
+					EnabledOnRegister:     true,
+					EnabledOnPasswordless: true,
+				},
+			},
+			res: res{
+				want: &domain.ObjectDetails{
+					ResourceOwner: "org1",
+				},
+			},
+		},
+		{
+			name: "enable on session, ok",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(
+							org.NewCaptchaPolicyAddedEvent(context.Background(),
+								&org.NewAggregate("org1").Aggregate,
+								domain.CaptchaTypeHCaptcha,
+								"siteKey",
+								nil,
+								true,
+								false,
+								false,
+								false,
+							),
+						),
+					),
+					expectPush(
+						newCaptchaPolicyChangedEvent(context.Background(), "org1",
+							policy.ChangeCaptchaEnabledOnSession(true),
+						),
+					),
+				),
+			},
+			args: args{
+				ctx:   context.Background(),
+				orgID: "org1",
+				policy: &CaptchaPolicy{
+					Type:              domain.CaptchaTypeHCaptcha,
+					SiteKey:           "siteKey",
+					EnabledOnRegister: true,
+					EnabledOnSession:  true,
+				},
+			},
+			res: res{
+				want: &domain.ObjectDetails{
+					ResourceOwner: "org1",
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := &Commands{
+				eventstore:        tt.fields.eventstore(t),
+				captchaEncryption: tt.fields.secretCrypto,
+			}
+			got, err := r.ChangeCaptchaPolicy(tt.args.ctx, tt.args.orgID, tt.args.policy)
+			if tt.res.err == nil {
+				assert.NoError(t, err)
+			}
+			if tt.res.err != nil && !tt.res.err(err) {
+				t.Errorf("got wrong err: %v ", err)
+			}
+			if tt.res.err == nil {
+				assert.Equal(t, tt.res.want, got)
+			}
+		})
+	}
+}
+
+func TestCommandSide_RemoveCaptchaPolicy(t *testing.T) {
+	type fields struct {
+		eventstore func(*testing.T) *eventstore.Eventstore
+	}
+	type args struct {
+		ctx   context.Context
+		orgID string
+	}
+	type res struct {
+		want *domain.ObjectDetails
+		err  func(error) bool
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		args   args
+		res    res
+	}{
+		{
+			name: "org id missing, invalid argument error",
+			fields: fields{
+				eventstore: expectEventstore(),
+			},
+			args: args{
+				ctx: context.Background(),
+			},
+			res: res{
+				err: zerrors.IsErrorInvalidArgument,
+			},
+		},
+		{
+			name: "policy not existing, not found error",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(),
+				),
+			},
+			args: args{
+				ctx:   context.Background(),
+				orgID: "org1",
+			},
+			res: res{
+				err: zerrors.IsNotFound,
+			},
+		},
+		{
+			name: "remove, ok",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(
+							org.NewCaptchaPolicyAddedEvent(context.Background(),
+								&org.NewAggregate("org1").Aggregate,
+								domain.CaptchaTypeHCaptcha,
+								"siteKey",
+								nil,
+								true,
+								false,
+								false,
+								false,
+							),
+						),
+					),
+					expectPush(
+						org.NewCaptchaPolicyRemovedEvent(context.Background(),
+							&org.NewAggregate("org1").Aggregate),
+					),
+				),
+			},
+			args: args{
+				ctx:   context.Background(),
+				orgID: "org1",
+			},
+			res: res{
+				want: &domain.ObjectDetails{
+					ResourceOwner: "org1",
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := &Commands{
+				eventstore: tt.fields.eventstore(t),
+			}
+			got, err := r.RemoveCaptchaPolicy(tt.args.ctx, tt.args.orgID)
+			if tt.res.err == nil {
+				assert.NoError(t, err)
+			}
+			if tt.res.err != nil && !tt.res.err(err) {
+				t.Errorf("got wrong err: %v ", err)
+			}
+			if tt.res.err == nil {
+				assert.Equal(t, tt.res.want, got)
+			}
+		})
+	}
+}
+
+func newCaptchaPolicyChangedEvent(ctx context.Context, orgID string, changes ...policy.CaptchaPolicyChanges) *org.CaptchaPolicyChangedEvent {
+	event, _ := org.NewCaptchaPolicyChangedEvent(ctx,
+		&org.NewAggregate(orgID).Aggregate,
+		changes,
+	)
+	return event
+}
@@ -44,7 +44,8 @@ func (c *Commands) AddLabelPolicy(ctx context.Context, resourceOwner string, pol
 		policy.HideLoginNameSuffix,
 		policy.ErrorMsgPopup,
 		policy.DisableWatermark,
-		policy.ThemeMode))
+		policy.ThemeMode,
+		domain.SanitizeLabelPolicyCSS(policy.CustomCSS)))
 	if err != nil {
 		return nil, err
 	}
@@ -86,7 +87,8 @@ func (c *Commands) ChangeLabelPolicy(ctx context.Context, resourceOwner string,
 		policy.HideLoginNameSuffix,
 		policy.ErrorMsgPopup,
 		policy.DisableWatermark,
-		policy.ThemeMode)
+		policy.ThemeMode,
+		domain.SanitizeLabelPolicyCSS(policy.CustomCSS))
 	if !hasChanged {
 		return nil, zerrors.ThrowPreconditionFailed(nil, "Org-8nfSr", "Errors.Org.LabelPolicy.NotChanged")
 	}
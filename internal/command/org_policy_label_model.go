@@ -99,6 +99,7 @@ func (wm *OrgLabelPolicyWriteModel) NewChangedEvent(
 	errorMsgPopup,
 	disableWatermark bool,
 	themeMode domain.LabelPolicyThemeMode,
+	customCSS string,
 ) (*org.LabelPolicyChangedEvent, bool) {
 	changes := make([]policy.LabelPolicyChanges, 0)
 	if wm.PrimaryColor != primaryColor {
@@ -137,6 +138,9 @@ func (wm *OrgLabelPolicyWriteModel) NewChangedEvent(
 	if wm.ThemeMode != themeMode {
 		changes = append(changes, policy.ChangeThemeMode(themeMode))
 	}
+	if wm.CustomCSS != customCSS {
+		changes = append(changes, policy.ChangeCustomCSS(customCSS))
+	}
 	if len(changes) == 0 {
 		return nil, false
 	}
@@ -76,6 +76,7 @@ func TestCommandSide_AddLabelPolicy(t *testing.T) {
 								true,
 								true,
 								domain.LabelPolicyThemeAuto,
+								"",
 							),
 						),
 					),
@@ -123,6 +124,7 @@ func TestCommandSide_AddLabelPolicy(t *testing.T) {
 							true,
 							true,
 							domain.LabelPolicyThemeDark,
+							"",
 						),
 					),
 				),
@@ -265,6 +267,7 @@ func TestCommandSide_ChangeLabelPolicy(t *testing.T) {
 								true,
 								true,
 								domain.LabelPolicyThemeAuto,
+								"",
 							),
 						),
 					),
@@ -313,6 +316,7 @@ func TestCommandSide_ChangeLabelPolicy(t *testing.T) {
 								true,
 								true,
 								domain.LabelPolicyThemeAuto,
+								"",
 							),
 						),
 					),
@@ -374,6 +378,103 @@ func TestCommandSide_ChangeLabelPolicy(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "invalid custom css, invalid argument error",
+			fields: fields{
+				eventstore: eventstoreExpect(
+					t,
+				),
+			},
+			args: args{
+				ctx:   context.Background(),
+				orgID: "org1",
+				policy: &domain.LabelPolicy{
+					CustomCSS: "@import 'https://example.com/style.css';",
+				},
+			},
+			res: res{
+				err: zerrors.IsErrorInvalidArgument,
+			},
+		},
+		{
+			name: "change custom css, sanitized, ok",
+			fields: fields{
+				eventstore: eventstoreExpect(
+					t,
+					expectFilter(
+						eventFromEventPusher(
+							org.NewLabelPolicyAddedEvent(context.Background(),
+								&org.NewAggregate("org1").Aggregate,
+								"#ffffff",
+								"#ffffff",
+								"#ffffff",
+								"#ffffff",
+								"#ffffff",
+								"#ffffff",
+								"#ffffff",
+								"#ffffff",
+								true,
+								true,
+								true,
+								domain.LabelPolicyThemeAuto,
+								"",
+							),
+						),
+					),
+					expectPush(
+						func() eventstore.Command {
+							event, _ := org.NewLabelPolicyChangedEvent(context.Background(),
+								&org.NewAggregate("org1").Aggregate,
+								[]policy.LabelPolicyChanges{
+									policy.ChangeCustomCSS(".lgn-button { border-radius: 0; }"),
+								},
+							)
+							return event
+						}(),
+					),
+				),
+			},
+			args: args{
+				ctx:   context.Background(),
+				orgID: "org1",
+				policy: &domain.LabelPolicy{
+					PrimaryColor:        "#ffffff",
+					BackgroundColor:     "#ffffff",
+					WarnColor:           "#ffffff",
+					FontColor:           "#ffffff",
+					PrimaryColorDark:    "#ffffff",
+					BackgroundColorDark: "#ffffff",
+					WarnColorDark:       "#ffffff",
+					FontColorDark:       "#ffffff",
+					HideLoginNameSuffix: true,
+					ErrorMsgPopup:       true,
+					DisableWatermark:    true,
+					ThemeMode:           domain.LabelPolicyThemeAuto,
+					CustomCSS:           "/* square buttons */\n.lgn-button { border-radius: 0; }\n",
+				},
+			},
+			res: res{
+				want: &domain.LabelPolicy{
+					ObjectRoot: models.ObjectRoot{
+						AggregateID:   "org1",
+						ResourceOwner: "org1",
+					},
+					PrimaryColor:        "#ffffff",
+					BackgroundColor:     "#ffffff",
+					WarnColor:           "#ffffff",
+					FontColor:           "#ffffff",
+					PrimaryColorDark:    "#ffffff",
+					BackgroundColorDark: "#ffffff",
+					WarnColorDark:       "#ffffff",
+					FontColorDark:       "#ffffff",
+					HideLoginNameSuffix: true,
+					ErrorMsgPopup:       true,
+					DisableWatermark:    true,
+					ThemeMode:           domain.LabelPolicyThemeAuto,
+					CustomCSS:           ".lgn-button { border-radius: 0; }",
+				},
+			},
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -462,6 +563,7 @@ func TestCommandSide_ActivateLabelPolicy(t *testing.T) {
 								true,
 								true,
 								domain.LabelPolicyThemeAuto,
+								"",
 							),
 						),
 					),
@@ -563,6 +665,7 @@ func TestCommandSide_RemoveLabelPolicy(t *testing.T) {
 								true,
 								true,
 								domain.LabelPolicyThemeAuto,
+								"",
 							),
 						),
 					),
@@ -685,6 +788,7 @@ func TestCommandSide_AddLogoLabelPolicy(t *testing.T) {
 								true,
 								true,
 								domain.LabelPolicyThemeAuto,
+								"",
 							),
 						),
 					),
@@ -728,6 +832,7 @@ func TestCommandSide_AddLogoLabelPolicy(t *testing.T) {
 								true,
 								true,
 								domain.LabelPolicyThemeAuto,
+								"",
 							),
 						),
 					),
@@ -853,6 +958,7 @@ func TestCommandSide_RemoveLogoLabelPolicy(t *testing.T) {
 								true,
 								true,
 								domain.LabelPolicyThemeAuto,
+								"",
 							),
 						),
 						eventFromEventPusher(
@@ -990,6 +1096,7 @@ func TestCommandSide_AddIconLabelPolicy(t *testing.T) {
 								true,
 								true,
 								domain.LabelPolicyThemeAuto,
+								"",
 							),
 						),
 					),
@@ -1033,6 +1140,7 @@ func TestCommandSide_AddIconLabelPolicy(t *testing.T) {
 								true,
 								true,
 								domain.LabelPolicyThemeAuto,
+								"",
 							),
 						),
 					),
@@ -1156,6 +1264,7 @@ func TestCommandSide_RemoveIconLabelPolicy(t *testing.T) {
 								true,
 								true,
 								domain.LabelPolicyThemeAuto,
+								"",
 							),
 						),
 						eventFromEventPusher(
@@ -1292,6 +1401,7 @@ func TestCommandSide_AddLogoDarkLabelPolicy(t *testing.T) {
 								true,
 								true,
 								domain.LabelPolicyThemeAuto,
+								"",
 							),
 						),
 					),
@@ -1335,6 +1445,7 @@ func TestCommandSide_AddLogoDarkLabelPolicy(t *testing.T) {
 								true,
 								true,
 								domain.LabelPolicyThemeAuto,
+								"",
 							),
 						),
 					),
@@ -1460,6 +1571,7 @@ func TestCommandSide_RemoveLogoDarkLabelPolicy(t *testing.T) {
 								true,
 								true,
 								domain.LabelPolicyThemeAuto,
+								"",
 							),
 						),
 						eventFromEventPusher(
@@ -1597,6 +1709,7 @@ func TestCommandSide_AddIconDarkLabelPolicy(t *testing.T) {
 								true,
 								true,
 								domain.LabelPolicyThemeAuto,
+								"",
 							),
 						),
 					),
@@ -1640,6 +1753,7 @@ func TestCommandSide_AddIconDarkLabelPolicy(t *testing.T) {
 								true,
 								true,
 								domain.LabelPolicyThemeAuto,
+								"",
 							),
 						),
 					),
@@ -1761,6 +1875,7 @@ func TestCommandSide_RemoveIconDarkLabelPolicy(t *testing.T) {
 								true,
 								true,
 								domain.LabelPolicyThemeAuto,
+								"",
 							),
 						),
 						eventFromEventPusher(
@@ -1889,6 +2004,7 @@ func TestCommandSide_AddFontLabelPolicy(t *testing.T) {
 								true,
 								true,
 								domain.LabelPolicyThemeAuto,
+								"",
 							),
 						),
 					),
@@ -1932,6 +2048,7 @@ func TestCommandSide_AddFontLabelPolicy(t *testing.T) {
 								true,
 								true,
 								domain.LabelPolicyThemeAuto,
+								"",
 							),
 						),
 					),
@@ -2053,6 +2170,7 @@ func TestCommandSide_RemoveFontLabelPolicy(t *testing.T) {
 								true,
 								true,
 								domain.LabelPolicyThemeAuto,
+								"",
 							),
 						),
 						eventFromEventPusher(
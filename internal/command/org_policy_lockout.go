@@ -122,12 +122,22 @@ func orgLockoutPolicyWriteModelByID(ctx context.Context, orgID string, queryRedu
 }
 
 func getLockoutPolicy(ctx context.Context, orgID string, queryReducer func(ctx context.Context, r eventstore.QueryReducer) error) (*domain.LockoutPolicy, error) {
-	orgWm, err := orgLockoutPolicyWriteModelByID(ctx, orgID, queryReducer)
-	if err != nil {
-		return nil, err
-	}
-	if orgWm.State == domain.PolicyStateActive {
-		return writeModelToLockoutPolicy(&orgWm.LockoutPolicyWriteModel), nil
+	// the policy of the nearest ancestor applies if the organization has none of its own
+	for depth := 0; depth < domain.MaxOrgHierarchyDepth; depth++ {
+		orgWm, err := orgLockoutPolicyWriteModelByID(ctx, orgID, queryReducer)
+		if err != nil {
+			return nil, err
+		}
+		if depth > 0 && orgWm.OrgRemoved {
+			break
+		}
+		if orgWm.State == domain.PolicyStateActive {
+			return writeModelToLockoutPolicy(&orgWm.LockoutPolicyWriteModel), nil
+		}
+		if orgWm.ParentOrgID == "" {
+			break
+		}
+		orgID = orgWm.ParentOrgID
 	}
 	instanceWm, err := defaultLockoutPolicyWriteModelByID(ctx, queryReducer)
 	if err != nil {
@@ -10,11 +10,12 @@ import (
 
 type OrgLockoutPolicyWriteModel struct {
 	LockoutPolicyWriteModel
+	orgParentWriteModel
 }
 
 func NewOrgLockoutPolicyWriteModel(orgID string) *OrgLockoutPolicyWriteModel {
 	return &OrgLockoutPolicyWriteModel{
-		LockoutPolicyWriteModel{
+		LockoutPolicyWriteModel: LockoutPolicyWriteModel{
 			WriteModel: eventstore.WriteModel{
 				AggregateID:   orgID,
 				ResourceOwner: orgID,
@@ -32,6 +33,8 @@ func (wm *OrgLockoutPolicyWriteModel) AppendEvents(events ...eventstore.Event) {
 			wm.LockoutPolicyWriteModel.AppendEvents(&e.LockoutPolicyChangedEvent)
 		case *org.LockoutPolicyRemovedEvent:
 			wm.LockoutPolicyWriteModel.AppendEvents(&e.LockoutPolicyRemovedEvent)
+		default:
+			wm.orgParentWriteModel.appendEvent(event)
 		}
 	}
 }
@@ -49,6 +52,10 @@ func (wm *OrgLockoutPolicyWriteModel) Query() *eventstore.SearchQueryBuilder {
 		EventTypes(org.LockoutPolicyAddedEventType,
 			org.LockoutPolicyChangedEventType,
 			org.LockoutPolicyRemovedEventType).
+		Or().
+		AggregateTypes(org.AggregateType).
+		AggregateIDs(wm.LockoutPolicyWriteModel.AggregateID).
+		EventTypes(orgParentEventTypes...).
 		Builder()
 }
 
@@ -16,26 +16,32 @@ import (
 )
 
 type AddLoginPolicy struct {
-	AllowUsernamePassword      bool
-	AllowRegister              bool
-	AllowExternalIDP           bool
-	IDPProviders               []*AddLoginPolicyIDP
-	ForceMFA                   bool
-	ForceMFALocalOnly          bool
-	SecondFactors              []domain.SecondFactorType
-	MultiFactors               []domain.MultiFactorType
-	PasswordlessType           domain.PasswordlessType
-	HidePasswordReset          bool
-	IgnoreUnknownUsernames     bool
-	AllowDomainDiscovery       bool
-	DefaultRedirectURI         string
-	PasswordCheckLifetime      time.Duration
-	ExternalLoginCheckLifetime time.Duration
-	MFAInitSkipLifetime        time.Duration
-	SecondFactorCheckLifetime  time.Duration
-	MultiFactorCheckLifetime   time.Duration
-	DisableLoginWithEmail      bool
-	DisableLoginWithPhone      bool
+	AllowUsernamePassword       bool
+	AllowRegister               bool
+	AllowExternalIDP            bool
+	IDPProviders                []*AddLoginPolicyIDP
+	ForceMFA                    bool
+	ForceMFALocalOnly           bool
+	SecondFactors               []domain.SecondFactorType
+	MultiFactors                []domain.MultiFactorType
+	PasswordlessType            domain.PasswordlessType
+	HidePasswordReset           bool
+	IgnoreUnknownUsernames      bool
+	AllowDomainDiscovery        bool
+	DefaultRedirectURI          string
+	PasswordCheckLifetime       time.Duration
+	ExternalLoginCheckLifetime  time.Duration
+	MFAInitSkipLifetime         time.Duration
+	SecondFactorCheckLifetime   time.Duration
+	MultiFactorCheckLifetime    time.Duration
+	TrustedDeviceLifetime       time.Duration
+	SharedDevice                bool
+	SharedDeviceLifetime        time.Duration
+	SharedDeviceIdleTimeout     time.Duration
+	PasskeyPrompt               bool
+	PasskeyPromptSnoozeLifetime time.Duration
+	DisableLoginWithEmail       bool
+	DisableLoginWithPhone       bool
 }
 
 type AddLoginPolicyIDP struct {
@@ -44,23 +50,29 @@ type AddLoginPolicyIDP struct {
 }
 
 type ChangeLoginPolicy struct {
-	AllowUsernamePassword      bool
-	AllowRegister              bool
-	AllowExternalIDP           bool
-	ForceMFA                   bool
-	ForceMFALocalOnly          bool
-	PasswordlessType           domain.PasswordlessType
-	HidePasswordReset          bool
-	IgnoreUnknownUsernames     bool
-	AllowDomainDiscovery       bool
-	DefaultRedirectURI         string
-	PasswordCheckLifetime      time.Duration
-	ExternalLoginCheckLifetime time.Duration
-	MFAInitSkipLifetime        time.Duration
-	SecondFactorCheckLifetime  time.Duration
-	MultiFactorCheckLifetime   time.Duration
-	DisableLoginWithEmail      bool
-	DisableLoginWithPhone      bool
+	AllowUsernamePassword       bool
+	AllowRegister               bool
+	AllowExternalIDP            bool
+	ForceMFA                    bool
+	ForceMFALocalOnly           bool
+	PasswordlessType            domain.PasswordlessType
+	HidePasswordReset           bool
+	IgnoreUnknownUsernames      bool
+	AllowDomainDiscovery        bool
+	DefaultRedirectURI          string
+	PasswordCheckLifetime       time.Duration
+	ExternalLoginCheckLifetime  time.Duration
+	MFAInitSkipLifetime         time.Duration
+	SecondFactorCheckLifetime   time.Duration
+	MultiFactorCheckLifetime    time.Duration
+	TrustedDeviceLifetime       time.Duration
+	SharedDevice                bool
+	SharedDeviceLifetime        time.Duration
+	SharedDeviceIdleTimeout     time.Duration
+	PasskeyPrompt               bool
+	PasskeyPromptSnoozeLifetime time.Duration
+	DisableLoginWithEmail       bool
+	DisableLoginWithPhone       bool
 }
 
 func (c *Commands) AddLoginPolicy(ctx context.Context, resourceOwner string, policy *AddLoginPolicy) (_ *domain.ObjectDetails, err error) {
@@ -89,12 +101,22 @@ func (c *Commands) orgLoginPolicyWriteModelByID(ctx context.Context, orgID strin
 }
 
 func (c *Commands) getOrgLoginPolicy(ctx context.Context, orgID string) (*domain.LoginPolicy, error) {
-	policy, err := c.orgLoginPolicyWriteModelByID(ctx, orgID)
-	if err != nil {
-		return nil, err
-	}
-	if policy.State == domain.PolicyStateActive {
-		return writeModelToLoginPolicy(&policy.LoginPolicyWriteModel), nil
+	// the policy of the nearest ancestor applies if the organization has none of its own
+	for depth := 0; depth < domain.MaxOrgHierarchyDepth; depth++ {
+		policy, err := c.orgLoginPolicyWriteModelByID(ctx, orgID)
+		if err != nil {
+			return nil, err
+		}
+		if depth > 0 && policy.OrgRemoved {
+			break
+		}
+		if policy.State == domain.PolicyStateActive {
+			return writeModelToLoginPolicy(&policy.LoginPolicyWriteModel), nil
+		}
+		if policy.ParentOrgID == "" {
+			break
+		}
+		orgID = policy.ParentOrgID
 	}
 	return c.getDefaultLoginPolicy(ctx)
 }
@@ -446,6 +468,12 @@ func prepareAddLoginPolicy(a *org.Aggregate, policy *AddLoginPolicy) preparation
 				policy.MFAInitSkipLifetime,
 				policy.SecondFactorCheckLifetime,
 				policy.MultiFactorCheckLifetime,
+				policy.TrustedDeviceLifetime,
+				policy.SharedDevice,
+				policy.SharedDeviceLifetime,
+				policy.SharedDeviceIdleTimeout,
+				policy.PasskeyPrompt,
+				policy.PasskeyPromptSnoozeLifetime,
 			))
 			for _, factor := range policy.SecondFactors {
 				cmds = append(cmds, org.NewLoginPolicySecondFactorAddedEvent(ctx, &a.Aggregate, factor))
@@ -491,7 +519,13 @@ func prepareChangeLoginPolicy(a *org.Aggregate, policy *ChangeLoginPolicy) prepa
 				policy.ExternalLoginCheckLifetime,
 				policy.MFAInitSkipLifetime,
 				policy.SecondFactorCheckLifetime,
-				policy.MultiFactorCheckLifetime)
+				policy.MultiFactorCheckLifetime,
+				policy.TrustedDeviceLifetime,
+				policy.SharedDevice,
+				policy.SharedDeviceLifetime,
+				policy.SharedDeviceIdleTimeout,
+				policy.PasskeyPrompt,
+				policy.PasskeyPromptSnoozeLifetime)
 			if !hasChanged {
 				return nil, zerrors.ThrowPreconditionFailed(nil, "Org-5M9vdd", "Errors.Org.LoginPolicy.NotChanged")
 			}
@@ -0,0 +1,105 @@
+package command
+
+import (
+	"context"
+
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/repository/org"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+// SetOrgLoginPolicyIDPButtons sets the order and the visibility rules of the identity provider buttons of the login policy of the organization
+func (c *Commands) SetOrgLoginPolicyIDPButtons(ctx context.Context, orgID string, buttons []*domain.IDPButton) (*domain.ObjectDetails, error) {
+	if orgID == "" {
+		return nil, zerrors.ThrowInvalidArgument(nil, "Org-Ib7rm", "Errors.ResourceOwnerMissing")
+	}
+	existingPolicy, err := c.orgLoginPolicyIDPButtonsWriteModel(ctx, orgID)
+	if err != nil {
+		return nil, err
+	}
+	if err = existingPolicy.validateButtons(buttons); err != nil {
+		return nil, err
+	}
+	orgAgg := OrgAggregateFromWriteModel(&existingPolicy.WriteModel)
+	pushedEvents, err := c.eventstore.Push(ctx, org.NewIdentityProviderButtonsSetEvent(ctx, orgAgg, buttons))
+	if err != nil {
+		return nil, err
+	}
+	err = AppendAndReduce(existingPolicy, pushedEvents...)
+	if err != nil {
+		return nil, err
+	}
+	return writeModelToObjectDetails(&existingPolicy.WriteModel), nil
+}
+
+// AddOrgLoginPolicyIDPIcon uploads a custom icon for the button of the identity provider on the login screen
+func (c *Commands) AddOrgLoginPolicyIDPIcon(ctx context.Context, orgID, idpID string, upload *AssetUpload) (*domain.ObjectDetails, error) {
+	if orgID == "" {
+		return nil, zerrors.ThrowInvalidArgument(nil, "Org-Ib8rm", "Errors.ResourceOwnerMissing")
+	}
+	if idpID == "" {
+		return nil, zerrors.ThrowInvalidArgument(nil, "Org-Ib9id", "Errors.IDMissing")
+	}
+	existingPolicy, err := c.orgLoginPolicyIDPButtonsWriteModel(ctx, orgID)
+	if err != nil {
+		return nil, err
+	}
+	if !existingPolicy.isLinked(idpID) {
+		return nil, zerrors.ThrowPreconditionFailed(nil, "Org-Ic0nl", "Errors.Policy.Login.IDPButton.IDPNotLinked")
+	}
+	asset, err := c.uploadAsset(ctx, upload)
+	if err != nil {
+		return nil, zerrors.ThrowInternal(err, "Org-Ic1pf", "Errors.Assets.Object.PutFailed")
+	}
+	orgAgg := OrgAggregateFromWriteModel(&existingPolicy.WriteModel)
+	pushedEvents, err := c.eventstore.Push(ctx, org.NewIdentityProviderIconAddedEvent(ctx, orgAgg, idpID, asset.Name))
+	if err != nil {
+		return nil, err
+	}
+	err = AppendAndReduce(existingPolicy, pushedEvents...)
+	if err != nil {
+		return nil, err
+	}
+	return writeModelToObjectDetails(&existingPolicy.WriteModel), nil
+}
+
+// RemoveOrgLoginPolicyIDPIcon removes the custom icon of the button of the identity provider, the default icon will be shown again
+func (c *Commands) RemoveOrgLoginPolicyIDPIcon(ctx context.Context, orgID, idpID string) (*domain.ObjectDetails, error) {
+	if orgID == "" {
+		return nil, zerrors.ThrowInvalidArgument(nil, "Org-Ic2rm", "Errors.ResourceOwnerMissing")
+	}
+	existingPolicy, err := c.orgLoginPolicyIDPButtonsWriteModel(ctx, orgID)
+	if err != nil {
+		return nil, err
+	}
+	storeKey := existingPolicy.Icons[idpID]
+	if storeKey == "" {
+		return nil, zerrors.ThrowNotFound(nil, "Org-Ic3nf", "Errors.Policy.Login.IDPButton.IconNotExisting")
+	}
+	err = c.removeAsset(ctx, orgID, storeKey)
+	if err != nil {
+		return nil, err
+	}
+	orgAgg := OrgAggregateFromWriteModel(&existingPolicy.WriteModel)
+	pushedEvents, err := c.eventstore.Push(ctx, org.NewIdentityProviderIconRemovedEvent(ctx, orgAgg, idpID, storeKey))
+	if err != nil {
+		return nil, err
+	}
+	err = AppendAndReduce(existingPolicy, pushedEvents...)
+	if err != nil {
+		return nil, err
+	}
+	return writeModelToObjectDetails(&existingPolicy.WriteModel), nil
+}
+
+func (c *Commands) orgLoginPolicyIDPButtonsWriteModel(ctx context.Context, orgID string) (*OrgLoginPolicyIDPButtonsWriteModel, error) {
+	writeModel := NewOrgLoginPolicyIDPButtonsWriteModel(orgID)
+	err := c.eventstore.FilterToQueryReducer(ctx, writeModel)
+	if err != nil {
+		return nil, err
+	}
+	if writeModel.State != domain.PolicyStateActive {
+		return nil, zerrors.ThrowNotFound(nil, "Org-Ic4nf", "Errors.Org.LoginPolicy.NotFound")
+	}
+	return writeModel, nil
+}
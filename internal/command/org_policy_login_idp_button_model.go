@@ -0,0 +1,66 @@
+package command
+
+import (
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/repository/org"
+)
+
+type OrgLoginPolicyIDPButtonsWriteModel struct {
+	LoginPolicyIDPButtonsWriteModel
+}
+
+func NewOrgLoginPolicyIDPButtonsWriteModel(orgID string) *OrgLoginPolicyIDPButtonsWriteModel {
+	return &OrgLoginPolicyIDPButtonsWriteModel{
+		LoginPolicyIDPButtonsWriteModel: LoginPolicyIDPButtonsWriteModel{
+			WriteModel: eventstore.WriteModel{
+				AggregateID:   orgID,
+				ResourceOwner: orgID,
+			},
+		},
+	}
+}
+
+func (wm *OrgLoginPolicyIDPButtonsWriteModel) AppendEvents(events ...eventstore.Event) {
+	for _, event := range events {
+		switch e := event.(type) {
+		case *org.LoginPolicyAddedEvent:
+			wm.LoginPolicyIDPButtonsWriteModel.AppendEvents(&e.LoginPolicyAddedEvent)
+		case *org.LoginPolicyRemovedEvent:
+			wm.LoginPolicyIDPButtonsWriteModel.AppendEvents(&e.LoginPolicyRemovedEvent)
+		case *org.IdentityProviderAddedEvent:
+			wm.LoginPolicyIDPButtonsWriteModel.AppendEvents(&e.IdentityProviderAddedEvent)
+		case *org.IdentityProviderRemovedEvent:
+			wm.LoginPolicyIDPButtonsWriteModel.AppendEvents(&e.IdentityProviderRemovedEvent)
+		case *org.IdentityProviderCascadeRemovedEvent:
+			wm.LoginPolicyIDPButtonsWriteModel.AppendEvents(&e.IdentityProviderCascadeRemovedEvent)
+		case *org.IdentityProviderButtonsSetEvent:
+			wm.LoginPolicyIDPButtonsWriteModel.AppendEvents(&e.IdentityProviderButtonsSetEvent)
+		case *org.IdentityProviderIconAddedEvent:
+			wm.LoginPolicyIDPButtonsWriteModel.AppendEvents(&e.IdentityProviderIconAddedEvent)
+		case *org.IdentityProviderIconRemovedEvent:
+			wm.LoginPolicyIDPButtonsWriteModel.AppendEvents(&e.IdentityProviderIconRemovedEvent)
+		}
+	}
+}
+
+func (wm *OrgLoginPolicyIDPButtonsWriteModel) Reduce() error {
+	return wm.LoginPolicyIDPButtonsWriteModel.Reduce()
+}
+
+func (wm *OrgLoginPolicyIDPButtonsWriteModel) Query() *eventstore.SearchQueryBuilder {
+	return eventstore.NewSearchQueryBuilder(eventstore.ColumnsEvent).
+		ResourceOwner(wm.ResourceOwner).
+		AddQuery().
+		AggregateTypes(org.AggregateType).
+		AggregateIDs(wm.AggregateID).
+		EventTypes(
+			org.LoginPolicyAddedEventType,
+			org.LoginPolicyRemovedEventType,
+			org.LoginPolicyIDPProviderAddedEventType,
+			org.LoginPolicyIDPProviderRemovedEventType,
+			org.LoginPolicyIDPProviderCascadeRemovedEventType,
+			org.LoginPolicyIDPProviderButtonsSetEventType,
+			org.LoginPolicyIDPProviderIconAddedEventType,
+			org.LoginPolicyIDPProviderIconRemovedEventType).
+		Builder()
+}
@@ -0,0 +1,374 @@
+package command
+
+import (
+	"bytes"
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/repository/org"
+	"github.com/zitadel/zitadel/internal/static"
+	"github.com/zitadel/zitadel/internal/static/mock"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+func orgLoginPolicyWithIDPEvents(idpIDs ...string) []eventstore.Event {
+	events := []eventstore.Event{
+		eventFromEventPusher(
+			org.NewLoginPolicyAddedEvent(context.Background(),
+				&org.NewAggregate("org1").Aggregate,
+				true,
+				true,
+				true,
+				false,
+				false,
+				false,
+				false,
+				false,
+				false,
+				false,
+				domain.PasswordlessTypeAllowed,
+				"",
+				time.Hour*1,
+				time.Hour*2,
+				time.Hour*3,
+				time.Hour*4,
+				time.Hour*5,
+				time.Hour*6,
+				false,
+				0,
+				0,
+				false,
+				0,
+			),
+		),
+	}
+	for _, idpID := range idpIDs {
+		events = append(events, eventFromEventPusher(
+			org.NewIdentityProviderAddedEvent(context.Background(),
+				&org.NewAggregate("org1").Aggregate,
+				idpID,
+				domain.IdentityProviderTypeOrg,
+			),
+		))
+	}
+	return events
+}
+
+func TestCommandSide_SetOrgLoginPolicyIDPButtons(t *testing.T) {
+	type fields struct {
+		eventstore func(t *testing.T) *eventstore.Eventstore
+	}
+	type args struct {
+		orgID   string
+		buttons []*domain.IDPButton
+	}
+	type res struct {
+		want *domain.ObjectDetails
+		err  func(error) bool
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		args   args
+		res    res
+	}{
+		{
+			name: "orgID empty, invalid argument error",
+			fields: fields{
+				eventstore: expectEventstore(),
+			},
+			args: args{},
+			res: res{
+				err: zerrors.IsErrorInvalidArgument,
+			},
+		},
+		{
+			name: "login policy not existing, not found error",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(),
+				),
+			},
+			args: args{
+				orgID: "org1",
+			},
+			res: res{
+				err: zerrors.IsNotFound,
+			},
+		},
+		{
+			name: "invalid domain, invalid argument error",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(orgLoginPolicyWithIDPEvents("idp1")...),
+				),
+			},
+			args: args{
+				orgID: "org1",
+				buttons: []*domain.IDPButton{
+					{IDPID: "idp1", Domains: []string{"user@example.com"}},
+				},
+			},
+			res: res{
+				err: zerrors.IsErrorInvalidArgument,
+			},
+		},
+		{
+			name: "duplicate button, invalid argument error",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(orgLoginPolicyWithIDPEvents("idp1")...),
+				),
+			},
+			args: args{
+				orgID: "org1",
+				buttons: []*domain.IDPButton{
+					{IDPID: "idp1"},
+					{IDPID: "idp1"},
+				},
+			},
+			res: res{
+				err: zerrors.IsErrorInvalidArgument,
+			},
+		},
+		{
+			name: "idp not linked, precondition error",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(orgLoginPolicyWithIDPEvents("idp1")...),
+				),
+			},
+			args: args{
+				orgID: "org1",
+				buttons: []*domain.IDPButton{
+					{IDPID: "idp2"},
+				},
+			},
+			res: res{
+				err: zerrors.IsPreconditionFailed,
+			},
+		},
+		{
+			name: "buttons set, ok",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(orgLoginPolicyWithIDPEvents("idp1", "idp2")...),
+					expectPush(
+						org.NewIdentityProviderButtonsSetEvent(context.Background(),
+							&org.NewAggregate("org1").Aggregate,
+							[]*domain.IDPButton{
+								{IDPID: "idp2", Domains: []string{"example.com"}},
+								{IDPID: "idp1"},
+							},
+						),
+					),
+				),
+			},
+			args: args{
+				orgID: "org1",
+				buttons: []*domain.IDPButton{
+					{IDPID: "idp2", Domains: []string{" Example.com"}},
+					{IDPID: "idp1"},
+				},
+			},
+			res: res{
+				want: &domain.ObjectDetails{
+					ResourceOwner: "org1",
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &Commands{
+				eventstore: tt.fields.eventstore(t),
+			}
+			got, err := c.SetOrgLoginPolicyIDPButtons(context.Background(), tt.args.orgID, tt.args.buttons)
+			if tt.res.err != nil {
+				require.True(t, tt.res.err(err), err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.res.want, got)
+		})
+	}
+}
+
+func TestCommandSide_AddOrgLoginPolicyIDPIcon(t *testing.T) {
+	upload := func() *AssetUpload {
+		return &AssetUpload{
+			ResourceOwner: "org1",
+			ObjectName:    "icon",
+			ContentType:   "image",
+			ObjectType:    static.ObjectTypeStyling,
+			File:          bytes.NewReader([]byte("test")),
+			Size:          4,
+		}
+	}
+	type fields struct {
+		eventstore func(t *testing.T) *eventstore.Eventstore
+		storage    static.Storage
+	}
+	type res struct {
+		want *domain.ObjectDetails
+		err  func(error) bool
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		idpID  string
+		res    res
+	}{
+		{
+			name: "idp missing, invalid argument error",
+			fields: fields{
+				eventstore: expectEventstore(),
+			},
+			res: res{
+				err: zerrors.IsErrorInvalidArgument,
+			},
+		},
+		{
+			name: "idp not linked, precondition error",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(orgLoginPolicyWithIDPEvents("idp1")...),
+				),
+			},
+			idpID: "idp2",
+			res: res{
+				err: zerrors.IsPreconditionFailed,
+			},
+		},
+		{
+			name: "upload failed, error",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(orgLoginPolicyWithIDPEvents("idp1")...),
+				),
+				storage: mock.NewStorage(t).ExpectPutObjectError(),
+			},
+			idpID: "idp1",
+			res: res{
+				err: zerrors.IsInternal,
+			},
+		},
+		{
+			name: "icon added, ok",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(orgLoginPolicyWithIDPEvents("idp1")...),
+					expectPush(
+						org.NewIdentityProviderIconAddedEvent(context.Background(),
+							&org.NewAggregate("org1").Aggregate,
+							"idp1",
+							"icon",
+						),
+					),
+				),
+				storage: mock.NewStorage(t).ExpectPutObject(),
+			},
+			idpID: "idp1",
+			res: res{
+				want: &domain.ObjectDetails{
+					ResourceOwner: "org1",
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &Commands{
+				eventstore: tt.fields.eventstore(t),
+				static:     tt.fields.storage,
+			}
+			got, err := c.AddOrgLoginPolicyIDPIcon(context.Background(), "org1", tt.idpID, upload())
+			if tt.res.err != nil {
+				require.True(t, tt.res.err(err), err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.res.want, got)
+		})
+	}
+}
+
+func TestCommandSide_RemoveOrgLoginPolicyIDPIcon(t *testing.T) {
+	type fields struct {
+		eventstore func(t *testing.T) *eventstore.Eventstore
+		storage    static.Storage
+	}
+	type res struct {
+		want *domain.ObjectDetails
+		err  func(error) bool
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		res    res
+	}{
+		{
+			name: "icon not existing, not found error",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(orgLoginPolicyWithIDPEvents("idp1")...),
+				),
+			},
+			res: res{
+				err: zerrors.IsNotFound,
+			},
+		},
+		{
+			name: "icon removed, ok",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						append(orgLoginPolicyWithIDPEvents("idp1"),
+							eventFromEventPusher(
+								org.NewIdentityProviderIconAddedEvent(context.Background(),
+									&org.NewAggregate("org1").Aggregate,
+									"idp1",
+									"icon",
+								),
+							),
+						)...,
+					),
+					expectPush(
+						org.NewIdentityProviderIconRemovedEvent(context.Background(),
+							&org.NewAggregate("org1").Aggregate,
+							"idp1",
+							"icon",
+						),
+					),
+				),
+				storage: mock.NewStorage(t).ExpectRemoveObjectNoError(),
+			},
+			res: res{
+				want: &domain.ObjectDetails{
+					ResourceOwner: "org1",
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &Commands{
+				eventstore: tt.fields.eventstore(t),
+				static:     tt.fields.storage,
+			}
+			got, err := c.RemoveOrgLoginPolicyIDPIcon(context.Background(), "org1", "idp1")
+			if tt.res.err != nil {
+				require.True(t, tt.res.err(err), err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.res.want, got)
+		})
+	}
+}
@@ -12,11 +12,12 @@ import (
 
 type OrgLoginPolicyWriteModel struct {
 	LoginPolicyWriteModel
+	orgParentWriteModel
 }
 
 func NewOrgLoginPolicyWriteModel(orgID string) *OrgLoginPolicyWriteModel {
 	return &OrgLoginPolicyWriteModel{
-		LoginPolicyWriteModel{
+		LoginPolicyWriteModel: LoginPolicyWriteModel{
 			WriteModel: eventstore.WriteModel{
 				AggregateID:   orgID,
 				ResourceOwner: orgID,
@@ -34,6 +35,8 @@ func (wm *OrgLoginPolicyWriteModel) AppendEvents(events ...eventstore.Event) {
 			wm.LoginPolicyWriteModel.AppendEvents(&e.LoginPolicyChangedEvent)
 		case *org.LoginPolicyRemovedEvent:
 			wm.LoginPolicyWriteModel.AppendEvents(&e.LoginPolicyRemovedEvent)
+		default:
+			wm.orgParentWriteModel.appendEvent(event)
 		}
 	}
 }
@@ -56,6 +59,10 @@ func (wm *OrgLoginPolicyWriteModel) Query() *eventstore.SearchQueryBuilder {
 			org.LoginPolicyAddedEventType,
 			org.LoginPolicyChangedEventType,
 			org.LoginPolicyRemovedEventType).
+		Or().
+		AggregateTypes(org.AggregateType).
+		AggregateIDs(wm.LoginPolicyWriteModel.AggregateID).
+		EventTypes(orgParentEventTypes...).
 		Builder()
 }
 
@@ -78,7 +85,13 @@ func (wm *OrgLoginPolicyWriteModel) NewChangedEvent(
 	externalLoginCheckLifetime,
 	mfaInitSkipLifetime,
 	secondFactorCheckLifetime,
-	multiFactorCheckLifetime time.Duration,
+	multiFactorCheckLifetime,
+	trustedDeviceLifetime time.Duration,
+	sharedDevice bool,
+	sharedDeviceLifetime,
+	sharedDeviceIdleTimeout time.Duration,
+	passkeyPrompt bool,
+	passkeyPromptSnoozeLifetime time.Duration,
 ) (*org.LoginPolicyChangedEvent, bool) {
 
 	changes := make([]policy.LoginPolicyChanges, 0)
@@ -121,6 +134,24 @@ func (wm *OrgLoginPolicyWriteModel) NewChangedEvent(
 	if wm.MultiFactorCheckLifetime != multiFactorCheckLifetime {
 		changes = append(changes, policy.ChangeMultiFactorCheckLifetime(multiFactorCheckLifetime))
 	}
+	if wm.TrustedDeviceLifetime != trustedDeviceLifetime {
+		changes = append(changes, policy.ChangeTrustedDeviceLifetime(trustedDeviceLifetime))
+	}
+	if wm.SharedDevice != sharedDevice {
+		changes = append(changes, policy.ChangeSharedDevice(sharedDevice))
+	}
+	if wm.SharedDeviceLifetime != sharedDeviceLifetime {
+		changes = append(changes, policy.ChangeSharedDeviceLifetime(sharedDeviceLifetime))
+	}
+	if wm.SharedDeviceIdleTimeout != sharedDeviceIdleTimeout {
+		changes = append(changes, policy.ChangeSharedDeviceIdleTimeout(sharedDeviceIdleTimeout))
+	}
+	if wm.PasskeyPrompt != passkeyPrompt {
+		changes = append(changes, policy.ChangePasskeyPrompt(passkeyPrompt))
+	}
+	if wm.PasskeyPromptSnoozeLifetime != passkeyPromptSnoozeLifetime {
+		changes = append(changes, policy.ChangePasskeyPromptSnoozeLifetime(passkeyPromptSnoozeLifetime))
+	}
 	if passwordlessType.Valid() && wm.PasswordlessType != passwordlessType {
 		changes = append(changes, policy.ChangePasswordlessType(passwordlessType))
 	}
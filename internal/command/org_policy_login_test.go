@@ -22,6 +22,7 @@ var (
 	duration30 = time.Hour * 30
 	duration40 = time.Hour * 40
 	duration50 = time.Hour * 50
+	duration60 = time.Hour * 60
 )
 
 func TestCommandSide_AddLoginPolicy(t *testing.T) {
@@ -69,6 +70,12 @@ func TestCommandSide_AddLoginPolicy(t *testing.T) {
 								time.Hour*3,
 								time.Hour*4,
 								time.Hour*5,
+								time.Hour*6,
+								false,
+								0,
+								0,
+								false,
+								0,
 							),
 						),
 					),
@@ -92,6 +99,7 @@ func TestCommandSide_AddLoginPolicy(t *testing.T) {
 					MFAInitSkipLifetime:        time.Hour * 3,
 					SecondFactorCheckLifetime:  time.Hour * 4,
 					MultiFactorCheckLifetime:   time.Hour * 5,
+					TrustedDeviceLifetime:      time.Hour * 6,
 				},
 			},
 			res: res{
@@ -124,6 +132,12 @@ func TestCommandSide_AddLoginPolicy(t *testing.T) {
 							time.Hour*3,
 							time.Hour*4,
 							time.Hour*5,
+							time.Hour*6,
+							false,
+							0,
+							0,
+							false,
+							0,
 						),
 					),
 				),
@@ -149,6 +163,7 @@ func TestCommandSide_AddLoginPolicy(t *testing.T) {
 					MFAInitSkipLifetime:        time.Hour * 3,
 					SecondFactorCheckLifetime:  time.Hour * 4,
 					MultiFactorCheckLifetime:   time.Hour * 5,
+					TrustedDeviceLifetime:      time.Hour * 6,
 				},
 			},
 			res: res{
@@ -185,6 +200,7 @@ func TestCommandSide_AddLoginPolicy(t *testing.T) {
 					MFAInitSkipLifetime:        time.Hour * 3,
 					SecondFactorCheckLifetime:  time.Hour * 4,
 					MultiFactorCheckLifetime:   time.Hour * 5,
+					TrustedDeviceLifetime:      time.Hour * 6,
 					SecondFactors:              []domain.SecondFactorType{domain.SecondFactorTypeUnspecified},
 				},
 			},
@@ -218,6 +234,12 @@ func TestCommandSide_AddLoginPolicy(t *testing.T) {
 							time.Hour*3,
 							time.Hour*4,
 							time.Hour*5,
+							time.Hour*6,
+							false,
+							0,
+							0,
+							false,
+							0,
 						),
 						org.NewLoginPolicySecondFactorAddedEvent(context.Background(),
 							&org.NewAggregate("org1").Aggregate,
@@ -251,6 +273,7 @@ func TestCommandSide_AddLoginPolicy(t *testing.T) {
 					MFAInitSkipLifetime:        time.Hour * 3,
 					SecondFactorCheckLifetime:  time.Hour * 4,
 					MultiFactorCheckLifetime:   time.Hour * 5,
+					TrustedDeviceLifetime:      time.Hour * 6,
 					SecondFactors:              []domain.SecondFactorType{domain.SecondFactorTypeTOTP},
 					MultiFactors:               []domain.MultiFactorType{domain.MultiFactorTypeU2FWithPIN},
 				},
@@ -292,6 +315,7 @@ func TestCommandSide_AddLoginPolicy(t *testing.T) {
 					MFAInitSkipLifetime:        time.Hour * 3,
 					SecondFactorCheckLifetime:  time.Hour * 4,
 					MultiFactorCheckLifetime:   time.Hour * 5,
+					TrustedDeviceLifetime:      time.Hour * 6,
 					IDPProviders: []*AddLoginPolicyIDP{
 						{
 							Type:     domain.IdentityProviderTypeSystem,
@@ -343,6 +367,12 @@ func TestCommandSide_AddLoginPolicy(t *testing.T) {
 							time.Hour*3,
 							time.Hour*4,
 							time.Hour*5,
+							time.Hour*6,
+							false,
+							0,
+							0,
+							false,
+							0,
 						),
 						org.NewIdentityProviderAddedEvent(context.Background(),
 							&org.NewAggregate("org1").Aggregate,
@@ -373,6 +403,7 @@ func TestCommandSide_AddLoginPolicy(t *testing.T) {
 					MFAInitSkipLifetime:        time.Hour * 3,
 					SecondFactorCheckLifetime:  time.Hour * 4,
 					MultiFactorCheckLifetime:   time.Hour * 5,
+					TrustedDeviceLifetime:      time.Hour * 6,
 					IDPProviders: []*AddLoginPolicyIDP{
 						{
 							Type:     domain.IdentityProviderTypeSystem,
@@ -425,6 +456,12 @@ func TestCommandSide_AddLoginPolicy(t *testing.T) {
 							time.Hour*3,
 							time.Hour*4,
 							time.Hour*5,
+							time.Hour*6,
+							false,
+							0,
+							0,
+							false,
+							0,
 						),
 						org.NewIdentityProviderAddedEvent(context.Background(),
 							&org.NewAggregate("org1").Aggregate,
@@ -455,6 +492,7 @@ func TestCommandSide_AddLoginPolicy(t *testing.T) {
 					MFAInitSkipLifetime:        time.Hour * 3,
 					SecondFactorCheckLifetime:  time.Hour * 4,
 					MultiFactorCheckLifetime:   time.Hour * 5,
+					TrustedDeviceLifetime:      time.Hour * 6,
 					IDPProviders: []*AddLoginPolicyIDP{
 						{
 							Type:     domain.IdentityProviderTypeOrg,
@@ -563,6 +601,12 @@ func TestCommandSide_ChangeLoginPolicy(t *testing.T) {
 								time.Hour*3,
 								time.Hour*4,
 								time.Hour*5,
+								time.Hour*6,
+								false,
+								0,
+								0,
+								false,
+								0,
 							),
 						),
 					),
@@ -589,6 +633,7 @@ func TestCommandSide_ChangeLoginPolicy(t *testing.T) {
 					MFAInitSkipLifetime:        time.Hour * 3,
 					SecondFactorCheckLifetime:  time.Hour * 4,
 					MultiFactorCheckLifetime:   time.Hour * 5,
+					TrustedDeviceLifetime:      time.Hour * 6,
 				},
 			},
 			res: res{
@@ -621,6 +666,12 @@ func TestCommandSide_ChangeLoginPolicy(t *testing.T) {
 								time.Hour*3,
 								time.Hour*4,
 								time.Hour*5,
+								time.Hour*6,
+								false,
+								0,
+								0,
+								false,
+								0,
 							),
 						),
 					),
@@ -644,6 +695,7 @@ func TestCommandSide_ChangeLoginPolicy(t *testing.T) {
 							&duration30,
 							&duration40,
 							&duration50,
+							&duration60,
 						),
 					),
 				),
@@ -668,6 +720,7 @@ func TestCommandSide_ChangeLoginPolicy(t *testing.T) {
 					MFAInitSkipLifetime:        time.Hour * 30,
 					SecondFactorCheckLifetime:  time.Hour * 40,
 					MultiFactorCheckLifetime:   time.Hour * 50,
+					TrustedDeviceLifetime:      time.Hour * 60,
 				},
 			},
 			res: res{
@@ -770,6 +823,12 @@ func TestCommandSide_RemoveLoginPolicy(t *testing.T) {
 								time.Hour*3,
 								time.Hour*4,
 								time.Hour*5,
+								time.Hour*6,
+								false,
+								0,
+								0,
+								false,
+								0,
 							),
 						),
 					),
@@ -910,6 +969,12 @@ func TestCommandSide_AddIDPProviderLoginPolicy(t *testing.T) {
 								time.Hour*3,
 								time.Hour*4,
 								time.Hour*5,
+								time.Hour*6,
+								false,
+								0,
+								0,
+								false,
+								0,
 							),
 						),
 					),
@@ -955,6 +1020,12 @@ func TestCommandSide_AddIDPProviderLoginPolicy(t *testing.T) {
 								time.Hour*3,
 								time.Hour*4,
 								time.Hour*5,
+								time.Hour*6,
+								false,
+								0,
+								0,
+								false,
+								0,
 							),
 						),
 					),
@@ -1020,6 +1091,12 @@ func TestCommandSide_AddIDPProviderLoginPolicy(t *testing.T) {
 								time.Hour*3,
 								time.Hour*4,
 								time.Hour*5,
+								time.Hour*6,
+								false,
+								0,
+								0,
+								false,
+								0,
 							),
 						),
 					),
@@ -1183,6 +1260,12 @@ func TestCommandSide_RemoveIDPProviderLoginPolicy(t *testing.T) {
 								time.Hour*3,
 								time.Hour*4,
 								time.Hour*5,
+								time.Hour*6,
+								false,
+								0,
+								0,
+								false,
+								0,
 							),
 						),
 					),
@@ -1228,6 +1311,12 @@ func TestCommandSide_RemoveIDPProviderLoginPolicy(t *testing.T) {
 								time.Hour*3,
 								time.Hour*4,
 								time.Hour*5,
+								time.Hour*6,
+								false,
+								0,
+								0,
+								false,
+								0,
 							),
 						),
 					),
@@ -1285,6 +1374,12 @@ func TestCommandSide_RemoveIDPProviderLoginPolicy(t *testing.T) {
 								time.Hour*3,
 								time.Hour*4,
 								time.Hour*5,
+								time.Hour*6,
+								false,
+								0,
+								0,
+								false,
+								0,
 							),
 						),
 					),
@@ -1345,6 +1440,12 @@ func TestCommandSide_RemoveIDPProviderLoginPolicy(t *testing.T) {
 								time.Hour*3,
 								time.Hour*4,
 								time.Hour*5,
+								time.Hour*6,
+								false,
+								0,
+								0,
+								false,
+								0,
 							),
 						),
 					),
@@ -2116,7 +2217,7 @@ func newLoginPolicyChangedEvent(ctx context.Context, orgID string,
 	usernamePassword, register, externalIDP, mfa, mfaLocalOnly, passwordReset, ignoreUnknownUsernames, allowDomainDiscovery, disableLoginWithEmail, disableLoginWithPhone bool,
 	passwordlessType domain.PasswordlessType,
 	redirectURI string,
-	passwordLifetime, externalLoginLifetime, mfaInitSkipLifetime, secondFactorLifetime, multiFactorLifetime *time.Duration) *org.LoginPolicyChangedEvent {
+	passwordLifetime, externalLoginLifetime, mfaInitSkipLifetime, secondFactorLifetime, multiFactorLifetime, trustedDeviceLifetime *time.Duration) *org.LoginPolicyChangedEvent {
 	changes := []policy.LoginPolicyChanges{
 		policy.ChangeAllowUserNamePassword(usernamePassword),
 		policy.ChangeAllowRegister(register),
@@ -2146,6 +2247,9 @@ func newLoginPolicyChangedEvent(ctx context.Context, orgID string,
 	if multiFactorLifetime != nil {
 		changes = append(changes, policy.ChangeMultiFactorCheckLifetime(*multiFactorLifetime))
 	}
+	if trustedDeviceLifetime != nil {
+		changes = append(changes, policy.ChangeTrustedDeviceLifetime(*trustedDeviceLifetime))
+	}
 	event, _ := org.NewLoginPolicyChangedEvent(ctx,
 		&org.NewAggregate(orgID).Aggregate,
 		changes,
@@ -13,12 +13,22 @@ func (c *Commands) getOrgPasswordComplexityPolicy(ctx context.Context, orgID str
 	ctx, span := tracing.NewSpan(ctx)
 	defer func() { span.EndWithError(err) }()
 
-	policy, err := c.orgPasswordComplexityPolicyWriteModelByID(ctx, orgID)
-	if err != nil {
-		return nil, err
-	}
-	if policy.State == domain.PolicyStateActive {
-		return orgWriteModelToPasswordComplexityPolicy(policy), nil
+	// the policy of the nearest ancestor applies if the organization has none of its own
+	for depth := 0; depth < domain.MaxOrgHierarchyDepth; depth++ {
+		policy, err := c.orgPasswordComplexityPolicyWriteModelByID(ctx, orgID)
+		if err != nil {
+			return nil, err
+		}
+		if depth > 0 && policy.OrgRemoved {
+			break
+		}
+		if policy.State == domain.PolicyStateActive {
+			return orgWriteModelToPasswordComplexityPolicy(policy), nil
+		}
+		if policy.ParentOrgID == "" {
+			break
+		}
+		orgID = policy.ParentOrgID
 	}
 	return c.getDefaultPasswordComplexityPolicy(ctx)
 }
@@ -61,7 +71,8 @@ func (c *Commands) AddPasswordComplexityPolicy(ctx context.Context, resourceOwne
 			policy.HasLowercase,
 			policy.HasUppercase,
 			policy.HasNumber,
-			policy.HasSymbol))
+			policy.HasSymbol,
+			policy.CheckBreached))
 	if err != nil {
 		return nil, err
 	}
@@ -90,7 +101,7 @@ func (c *Commands) ChangePasswordComplexityPolicy(ctx context.Context, resourceO
 	}
 
 	orgAgg := OrgAggregateFromWriteModel(&existingPolicy.PasswordComplexityPolicyWriteModel.WriteModel)
-	changedEvent, hasChanged := existingPolicy.NewChangedEvent(ctx, orgAgg, policy.MinLength, policy.HasLowercase, policy.HasUppercase, policy.HasNumber, policy.HasSymbol)
+	changedEvent, hasChanged := existingPolicy.NewChangedEvent(ctx, orgAgg, policy.MinLength, policy.HasLowercase, policy.HasUppercase, policy.HasNumber, policy.HasSymbol, policy.CheckBreached)
 	if !hasChanged {
 		return nil, zerrors.ThrowPreconditionFailed(nil, "Org-DAs21", "Errors.Org.PasswordComplexityPolicy.NotChanged")
 	}
@@ -10,11 +10,12 @@ import (
 
 type OrgPasswordComplexityPolicyWriteModel struct {
 	PasswordComplexityPolicyWriteModel
+	orgParentWriteModel
 }
 
 func NewOrgPasswordComplexityPolicyWriteModel(orgID string) *OrgPasswordComplexityPolicyWriteModel {
 	return &OrgPasswordComplexityPolicyWriteModel{
-		PasswordComplexityPolicyWriteModel{
+		PasswordComplexityPolicyWriteModel: PasswordComplexityPolicyWriteModel{
 			WriteModel: eventstore.WriteModel{
 				AggregateID:   orgID,
 				ResourceOwner: orgID,
@@ -32,6 +33,8 @@ func (wm *OrgPasswordComplexityPolicyWriteModel) AppendEvents(events ...eventsto
 			wm.PasswordComplexityPolicyWriteModel.AppendEvents(&e.PasswordComplexityPolicyChangedEvent)
 		case *org.PasswordComplexityPolicyRemovedEvent:
 			wm.PasswordComplexityPolicyWriteModel.AppendEvents(&e.PasswordComplexityPolicyRemovedEvent)
+		default:
+			wm.orgParentWriteModel.appendEvent(event)
 		}
 	}
 }
@@ -49,6 +52,10 @@ func (wm *OrgPasswordComplexityPolicyWriteModel) Query() *eventstore.SearchQuery
 		EventTypes(org.PasswordComplexityPolicyAddedEventType,
 			org.PasswordComplexityPolicyChangedEventType,
 			org.PasswordComplexityPolicyRemovedEventType).
+		Or().
+		AggregateTypes(org.AggregateType).
+		AggregateIDs(wm.PasswordComplexityPolicyWriteModel.AggregateID).
+		EventTypes(orgParentEventTypes...).
 		Builder()
 }
 
@@ -59,7 +66,8 @@ func (wm *OrgPasswordComplexityPolicyWriteModel) NewChangedEvent(
 	hasLowercase,
 	hasUppercase,
 	hasNumber,
-	hasSymbol bool,
+	hasSymbol,
+	checkBreached bool,
 ) (*org.PasswordComplexityPolicyChangedEvent, bool) {
 
 	changes := make([]policy.PasswordComplexityPolicyChanges, 0)
@@ -78,6 +86,9 @@ func (wm *OrgPasswordComplexityPolicyWriteModel) NewChangedEvent(
 	if wm.HasSymbol != hasSymbol {
 		changes = append(changes, policy.ChangeHasSymbol(hasSymbol))
 	}
+	if wm.CheckBreached != checkBreached {
+		changes = append(changes, policy.ChangeCheckBreached(checkBreached))
+	}
 	if len(changes) == 0 {
 		return nil, false
 	}
@@ -0,0 +1,69 @@
+package command
+
+import (
+	"context"
+	"slices"
+
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/repository/org"
+	"github.com/zitadel/zitadel/internal/telemetry/tracing"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+// SetOrgProfileRequirements replaces the profile fields the human users of the organization are required to have.
+// Users missing any of them, or a required custom attribute, are asked to complete their profile on the next login.
+func (c *Commands) SetOrgProfileRequirements(ctx context.Context, orgID string, fields []domain.ProfileField) (_ *domain.ObjectDetails, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	if orgID == "" {
+		return nil, zerrors.ThrowInvalidArgument(nil, "ORG-Prq1i", "Errors.ResourceOwnerMissing")
+	}
+	if len(fields) == 0 {
+		return nil, zerrors.ThrowInvalidArgument(nil, "ORG-Prq2e", "Errors.Org.ProfileRequirements.Invalid")
+	}
+	for i, field := range fields {
+		if !field.Valid() || slices.Contains(fields[:i], field) {
+			return nil, zerrors.ThrowInvalidArgument(nil, "ORG-Prq3i", "Errors.Org.ProfileRequirements.Invalid")
+		}
+	}
+	if err = c.checkOrgExists(ctx, orgID); err != nil {
+		return nil, err
+	}
+	writeModel := NewOrgProfileRequirementsWriteModel(orgID)
+	if err = c.eventstore.FilterToQueryReducer(ctx, writeModel); err != nil {
+		return nil, err
+	}
+	if writeModel.State.Exists() && slices.Equal(writeModel.Fields, fields) {
+		return nil, zerrors.ThrowPreconditionFailed(nil, "ORG-Prq4c", "Errors.Org.ProfileRequirements.NotChanged")
+	}
+	if err = c.pushAppendAndReduce(ctx, writeModel,
+		org.NewProfileRequirementsSetEvent(ctx, &org.NewAggregate(orgID).Aggregate, fields),
+	); err != nil {
+		return nil, err
+	}
+	return writeModelToObjectDetails(&writeModel.WriteModel), nil
+}
+
+// RemoveOrgProfileRequirements removes the required profile fields of the human users of the organization.
+func (c *Commands) RemoveOrgProfileRequirements(ctx context.Context, orgID string) (_ *domain.ObjectDetails, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	if orgID == "" {
+		return nil, zerrors.ThrowInvalidArgument(nil, "ORG-Prq5i", "Errors.ResourceOwnerMissing")
+	}
+	writeModel := NewOrgProfileRequirementsWriteModel(orgID)
+	if err = c.eventstore.FilterToQueryReducer(ctx, writeModel); err != nil {
+		return nil, err
+	}
+	if !writeModel.State.Exists() {
+		return nil, zerrors.ThrowNotFound(nil, "ORG-Prq6n", "Errors.Org.ProfileRequirements.NotFound")
+	}
+	if err = c.pushAppendAndReduce(ctx, writeModel,
+		org.NewProfileRequirementsRemovedEvent(ctx, &org.NewAggregate(orgID).Aggregate),
+	); err != nil {
+		return nil, err
+	}
+	return writeModelToObjectDetails(&writeModel.WriteModel), nil
+}
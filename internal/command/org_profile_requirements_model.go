@@ -0,0 +1,51 @@
+package command
+
+import (
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/repository/org"
+)
+
+type OrgProfileRequirementsWriteModel struct {
+	eventstore.WriteModel
+
+	State  domain.PolicyState
+	Fields []domain.ProfileField
+}
+
+func NewOrgProfileRequirementsWriteModel(orgID string) *OrgProfileRequirementsWriteModel {
+	return &OrgProfileRequirementsWriteModel{
+		WriteModel: eventstore.WriteModel{
+			AggregateID:   orgID,
+			ResourceOwner: orgID,
+		},
+	}
+}
+
+func (wm *OrgProfileRequirementsWriteModel) Reduce() error {
+	for _, event := range wm.Events {
+		switch e := event.(type) {
+		case *org.ProfileRequirementsSetEvent:
+			wm.State = domain.PolicyStateActive
+			wm.Fields = e.ProfileFields
+		case *org.ProfileRequirementsRemovedEvent, *org.OrgRemovedEvent:
+			wm.State = domain.PolicyStateRemoved
+			wm.Fields = nil
+		}
+	}
+	return wm.WriteModel.Reduce()
+}
+
+func (wm *OrgProfileRequirementsWriteModel) Query() *eventstore.SearchQueryBuilder {
+	return eventstore.NewSearchQueryBuilder(eventstore.ColumnsEvent).
+		ResourceOwner(wm.ResourceOwner).
+		AddQuery().
+		AggregateTypes(org.AggregateType).
+		AggregateIDs(wm.AggregateID).
+		EventTypes(
+			org.ProfileRequirementsSetEventType,
+			org.ProfileRequirementsRemovedEventType,
+			org.OrgRemovedEventType,
+		).
+		Builder()
+}
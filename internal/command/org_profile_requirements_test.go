@@ -0,0 +1,229 @@
+package command
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/repository/org"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+func TestCommandSide_SetOrgProfileRequirements(t *testing.T) {
+	type fields struct {
+		eventstore func(t *testing.T) *eventstore.Eventstore
+	}
+	type args struct {
+		ctx    context.Context
+		orgID  string
+		fields []domain.ProfileField
+	}
+	type res struct {
+		want *domain.ObjectDetails
+		err  error
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		args   args
+		res    res
+	}{
+		{
+			name: "no fields, invalid argument error",
+			fields: fields{
+				eventstore: expectEventstore(),
+			},
+			args: args{
+				ctx:   context.Background(),
+				orgID: "org1",
+			},
+			res: res{
+				err: zerrors.ThrowInvalidArgument(nil, "ORG-Prq2e", "Errors.Org.ProfileRequirements.Invalid"),
+			},
+		},
+		{
+			name: "unspecified field, invalid argument error",
+			fields: fields{
+				eventstore: expectEventstore(),
+			},
+			args: args{
+				ctx:    context.Background(),
+				orgID:  "org1",
+				fields: []domain.ProfileField{domain.ProfileFieldUnspecified},
+			},
+			res: res{
+				err: zerrors.ThrowInvalidArgument(nil, "ORG-Prq3i", "Errors.Org.ProfileRequirements.Invalid"),
+			},
+		},
+		{
+			name: "duplicate field, invalid argument error",
+			fields: fields{
+				eventstore: expectEventstore(),
+			},
+			args: args{
+				ctx:    context.Background(),
+				orgID:  "org1",
+				fields: []domain.ProfileField{domain.ProfileFieldPhone, domain.ProfileFieldPhone},
+			},
+			res: res{
+				err: zerrors.ThrowInvalidArgument(nil, "ORG-Prq3i", "Errors.Org.ProfileRequirements.Invalid"),
+			},
+		},
+		{
+			name: "org not found, precondition error",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(),
+				),
+			},
+			args: args{
+				ctx:    context.Background(),
+				orgID:  "org1",
+				fields: []domain.ProfileField{domain.ProfileFieldPhone},
+			},
+			res: res{
+				err: zerrors.ThrowPreconditionFailed(nil, "COMMAND-QXPGs", "Errors.Org.NotFound"),
+			},
+		},
+		{
+			name: "requirements not changed, precondition error",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(
+							org.NewOrgAddedEvent(context.Background(), &org.NewAggregate("org1").Aggregate, "org"),
+						),
+					),
+					expectFilter(
+						eventFromEventPusher(
+							org.NewProfileRequirementsSetEvent(context.Background(), &org.NewAggregate("org1").Aggregate,
+								[]domain.ProfileField{domain.ProfileFieldPhone},
+							),
+						),
+					),
+				),
+			},
+			args: args{
+				ctx:    context.Background(),
+				orgID:  "org1",
+				fields: []domain.ProfileField{domain.ProfileFieldPhone},
+			},
+			res: res{
+				err: zerrors.ThrowPreconditionFailed(nil, "ORG-Prq4c", "Errors.Org.ProfileRequirements.NotChanged"),
+			},
+		},
+		{
+			name: "set requirements, ok",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(
+							org.NewOrgAddedEvent(context.Background(), &org.NewAggregate("org1").Aggregate, "org"),
+						),
+					),
+					expectFilter(),
+					expectPush(
+						org.NewProfileRequirementsSetEvent(context.Background(), &org.NewAggregate("org1").Aggregate,
+							[]domain.ProfileField{domain.ProfileFieldPhone, domain.ProfileFieldNickName},
+						),
+					),
+				),
+			},
+			args: args{
+				ctx:    context.Background(),
+				orgID:  "org1",
+				fields: []domain.ProfileField{domain.ProfileFieldPhone, domain.ProfileFieldNickName},
+			},
+			res: res{
+				want: &domain.ObjectDetails{
+					ResourceOwner: "org1",
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := &Commands{
+				eventstore: tt.fields.eventstore(t),
+			}
+			got, err := r.SetOrgProfileRequirements(tt.args.ctx, tt.args.orgID, tt.args.fields)
+			assert.ErrorIs(t, err, tt.res.err)
+			assert.Equal(t, tt.res.want, got)
+		})
+	}
+}
+
+func TestCommandSide_RemoveOrgProfileRequirements(t *testing.T) {
+	type fields struct {
+		eventstore func(t *testing.T) *eventstore.Eventstore
+	}
+	type args struct {
+		ctx   context.Context
+		orgID string
+	}
+	type res struct {
+		want *domain.ObjectDetails
+		err  error
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		args   args
+		res    res
+	}{
+		{
+			name: "requirements not found, not found error",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(),
+				),
+			},
+			args: args{
+				ctx:   context.Background(),
+				orgID: "org1",
+			},
+			res: res{
+				err: zerrors.ThrowNotFound(nil, "ORG-Prq6n", "Errors.Org.ProfileRequirements.NotFound"),
+			},
+		},
+		{
+			name: "remove requirements, ok",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(
+							org.NewProfileRequirementsSetEvent(context.Background(), &org.NewAggregate("org1").Aggregate,
+								[]domain.ProfileField{domain.ProfileFieldPhone},
+							),
+						),
+					),
+					expectPush(
+						org.NewProfileRequirementsRemovedEvent(context.Background(), &org.NewAggregate("org1").Aggregate),
+					),
+				),
+			},
+			args: args{
+				ctx:   context.Background(),
+				orgID: "org1",
+			},
+			res: res{
+				want: &domain.ObjectDetails{
+					ResourceOwner: "org1",
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := &Commands{
+				eventstore: tt.fields.eventstore(t),
+			}
+			got, err := r.RemoveOrgProfileRequirements(tt.args.ctx, tt.args.orgID)
+			assert.ErrorIs(t, err, tt.res.err)
+			assert.Equal(t, tt.res.want, got)
+		})
+	}
+}
@@ -0,0 +1,158 @@
+package command
+
+import (
+	"context"
+
+	"github.com/zitadel/zitadel/internal/command/preparation"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/repository/org"
+	"github.com/zitadel/zitadel/internal/telemetry/tracing"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+// OrgProvisioning describes an organization which is set up together with its admins,
+// policies, branding and identity provider links in a single step.
+type OrgProvisioning struct {
+	OrgSetup
+	// ID of the organization, it makes the provisioning idempotent:
+	// an organization which already exists is not provisioned again.
+	// If empty, a new ID is generated.
+	ID                       string
+	LoginPolicy              *AddLoginPolicy
+	PasswordComplexityPolicy *domain.PasswordComplexityPolicy
+	LabelPolicy              *domain.LabelPolicy
+	// ClaimedUserIDs are the users of other organizations whose login names are claimed by the domain of the organization
+	ClaimedUserIDs []string
+}
+
+type ProvisionedOrg struct {
+	*CreatedOrg
+	// AlreadyExisted is true if the organization was provisioned before, nothing was changed in that case
+	AlreadyExisted bool
+}
+
+// OrgProvisioningResult is the outcome of the provisioning of a single organization,
+// either the provisioned organization or the error which prevented it.
+type OrgProvisioningResult struct {
+	Org *ProvisionedOrg
+	Err error
+}
+
+// ProvisionOrgs provisions each organization on its own,
+// an organization which fails doesn't prevent the provisioning of the others.
+func (c *Commands) ProvisionOrgs(ctx context.Context, orgs []*OrgProvisioning, allowInitialMail bool) []*OrgProvisioningResult {
+	results := make([]*OrgProvisioningResult, len(orgs))
+	for i, o := range orgs {
+		provisioned, err := c.ProvisionOrg(ctx, o, allowInitialMail)
+		results[i] = &OrgProvisioningResult{
+			Org: provisioned,
+			Err: err,
+		}
+	}
+	return results
+}
+
+// ProvisionOrg sets up the organization with its admins, policies, branding and identity provider links.
+// All events are pushed at once, so the organization is either provisioned completely or not at all.
+func (c *Commands) ProvisionOrg(ctx context.Context, o *OrgProvisioning, allowInitialMail bool) (_ *ProvisionedOrg, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	orgID := o.ID
+	if orgID == "" {
+		if orgID, err = c.idGenerator.Next(); err != nil {
+			return nil, err
+		}
+	} else {
+		existing, err := c.getOrgWriteModelByID(ctx, orgID)
+		if err != nil {
+			return nil, err
+		}
+		if existing.State == domain.OrgStateRemoved {
+			return nil, zerrors.ThrowPreconditionFailed(nil, "ORG-Pv7rd", "Errors.Org.Provisioning.Removed")
+		}
+		if isOrgStateExists(existing.State) {
+			return &ProvisionedOrg{
+				CreatedOrg: &CreatedOrg{
+					ObjectDetails: writeModelToObjectDetails(&existing.WriteModel),
+				},
+				AlreadyExisted: true,
+			}, nil
+		}
+	}
+
+	cmds := c.newOrgSetupCommands(ctx, orgID, &o.OrgSetup)
+	for _, admin := range o.Admins {
+		if err = cmds.setupOrgAdmin(admin, allowInitialMail); err != nil {
+			return nil, err
+		}
+	}
+	if err = cmds.addCustomDomain(o.CustomDomain, o.ClaimedUserIDs); err != nil {
+		return nil, err
+	}
+	if o.LoginPolicy != nil {
+		cmds.validations = append(cmds.validations, prepareAddLoginPolicy(cmds.aggregate, o.LoginPolicy))
+	}
+	if o.PasswordComplexityPolicy != nil {
+		cmds.validations = append(cmds.validations, prepareAddOrgPasswordComplexityPolicy(cmds.aggregate, o.PasswordComplexityPolicy))
+	}
+	if o.LabelPolicy != nil {
+		cmds.validations = append(cmds.validations, prepareAddOrgLabelPolicy(cmds.aggregate, o.LabelPolicy))
+	}
+	created, err := cmds.push(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &ProvisionedOrg{CreatedOrg: created}, nil
+}
+
+func prepareAddOrgPasswordComplexityPolicy(a *org.Aggregate, policy *domain.PasswordComplexityPolicy) preparation.Validation {
+	return func() (preparation.CreateCommands, error) {
+		if err := policy.IsValid(); err != nil {
+			return nil, err
+		}
+		return func(ctx context.Context, _ preparation.FilterToQueryReducer) ([]eventstore.Command, error) {
+			return []eventstore.Command{
+				org.NewPasswordComplexityPolicyAddedEvent(ctx, &a.Aggregate,
+					policy.MinLength,
+					policy.HasLowercase,
+					policy.HasUppercase,
+					policy.HasNumber,
+					policy.HasSymbol,
+					policy.CheckBreached,
+				),
+			}, nil
+		}, nil
+	}
+}
+
+// prepareAddOrgLabelPolicy adds the label policy and activates it directly,
+// it's only used when the organization is provisioned, so there is no preview to review.
+func prepareAddOrgLabelPolicy(a *org.Aggregate, policy *domain.LabelPolicy) preparation.Validation {
+	return func() (preparation.CreateCommands, error) {
+		if err := policy.IsValid(); err != nil {
+			return nil, err
+		}
+		return func(ctx context.Context, _ preparation.FilterToQueryReducer) ([]eventstore.Command, error) {
+			return []eventstore.Command{
+				org.NewLabelPolicyAddedEvent(ctx, &a.Aggregate,
+					policy.PrimaryColor,
+					policy.BackgroundColor,
+					policy.WarnColor,
+					policy.FontColor,
+					policy.PrimaryColorDark,
+					policy.BackgroundColorDark,
+					policy.WarnColorDark,
+					policy.FontColorDark,
+					policy.HideLoginNameSuffix,
+					policy.ErrorMsgPopup,
+					policy.DisableWatermark,
+					policy.ThemeMode,
+					domain.SanitizeLabelPolicyCSS(policy.CustomCSS),
+				),
+				org.NewLabelPolicyActivatedEvent(ctx, &a.Aggregate),
+			}, nil
+		}, nil
+	}
+}
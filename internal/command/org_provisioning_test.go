@@ -0,0 +1,208 @@
+package command
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/id"
+	id_mock "github.com/zitadel/zitadel/internal/id/mock"
+	"github.com/zitadel/zitadel/internal/repository/org"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+func TestCommandSide_ProvisionOrg(t *testing.T) {
+	type fields struct {
+		eventstore  func(t *testing.T) *eventstore.Eventstore
+		idGenerator id.Generator
+	}
+	type args struct {
+		ctx context.Context
+		org *OrgProvisioning
+	}
+	type res struct {
+		want *ProvisionedOrg
+		err  error
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		args   args
+		res    res
+	}{
+		{
+			name: "org already provisioned, unchanged",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(
+							org.NewOrgAddedEvent(context.Background(), &org.NewAggregate("orgID").Aggregate, "Org"),
+						),
+					),
+				),
+			},
+			args: args{
+				ctx: authz.WithRequestedDomain(context.Background(), "iam-domain"),
+				org: &OrgProvisioning{
+					ID:       "orgID",
+					OrgSetup: OrgSetup{Name: "Org"},
+				},
+			},
+			res: res{
+				want: &ProvisionedOrg{
+					CreatedOrg: &CreatedOrg{
+						ObjectDetails: &domain.ObjectDetails{
+							ResourceOwner: "orgID",
+						},
+					},
+					AlreadyExisted: true,
+				},
+			},
+		},
+		{
+			name: "org removed, precondition error",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(
+							org.NewOrgAddedEvent(context.Background(), &org.NewAggregate("orgID").Aggregate, "Org"),
+						),
+						eventFromEventPusher(
+							org.NewOrgRemovedEvent(context.Background(), &org.NewAggregate("orgID").Aggregate, "Org", nil, false, nil, nil, nil),
+						),
+					),
+				),
+			},
+			args: args{
+				ctx: authz.WithRequestedDomain(context.Background(), "iam-domain"),
+				org: &OrgProvisioning{
+					ID:       "orgID",
+					OrgSetup: OrgSetup{Name: "Org"},
+				},
+			},
+			res: res{
+				err: zerrors.ThrowPreconditionFailed(nil, "ORG-Pv7rd", "Errors.Org.Provisioning.Removed"),
+			},
+		},
+		{
+			name: "password complexity policy invalid, error",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(),
+				),
+			},
+			args: args{
+				ctx: authz.WithRequestedDomain(context.Background(), "iam-domain"),
+				org: &OrgProvisioning{
+					ID:                       "orgID",
+					OrgSetup:                 OrgSetup{Name: "Org"},
+					PasswordComplexityPolicy: &domain.PasswordComplexityPolicy{},
+				},
+			},
+			res: res{
+				err: zerrors.ThrowInvalidArgument(nil, "MODEL-Lsp0e", "Errors.User.PasswordComplexityPolicy.MinLengthNotAllowed"),
+			},
+		},
+		{
+			name: "org provisioned with policies and branding",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectPush(
+						org.NewOrgAddedEvent(context.Background(), &org.NewAggregate("orgID").Aggregate, "Org"),
+						org.NewDomainAddedEvent(context.Background(), &org.NewAggregate("orgID").Aggregate, "org.iam-domain"),
+						org.NewDomainVerifiedEvent(context.Background(), &org.NewAggregate("orgID").Aggregate, "org.iam-domain"),
+						org.NewDomainPrimarySetEvent(context.Background(), &org.NewAggregate("orgID").Aggregate, "org.iam-domain"),
+						org.NewPasswordComplexityPolicyAddedEvent(context.Background(), &org.NewAggregate("orgID").Aggregate,
+							12, true, true, true, true, false,
+						),
+						org.NewLabelPolicyAddedEvent(context.Background(), &org.NewAggregate("orgID").Aggregate,
+							"#5469d4", "#fafafa", "#cd3d56", "#000000", "#a5b4fc", "#111827", "#ff3b5b", "#ffffff",
+							false, false, false, domain.LabelPolicyThemeAuto, "",
+						),
+						org.NewLabelPolicyActivatedEvent(context.Background(), &org.NewAggregate("orgID").Aggregate),
+					),
+				),
+				idGenerator: id_mock.NewIDGeneratorExpectIDs(t, "orgID"),
+			},
+			args: args{
+				ctx: authz.WithRequestedDomain(context.Background(), "iam-domain"),
+				org: &OrgProvisioning{
+					OrgSetup: OrgSetup{Name: "Org"},
+					PasswordComplexityPolicy: &domain.PasswordComplexityPolicy{
+						MinLength:    12,
+						HasLowercase: true,
+						HasUppercase: true,
+						HasNumber:    true,
+						HasSymbol:    true,
+					},
+					LabelPolicy: &domain.LabelPolicy{
+						PrimaryColor:        "#5469d4",
+						BackgroundColor:     "#fafafa",
+						WarnColor:           "#cd3d56",
+						FontColor:           "#000000",
+						PrimaryColorDark:    "#a5b4fc",
+						BackgroundColorDark: "#111827",
+						WarnColorDark:       "#ff3b5b",
+						FontColorDark:       "#ffffff",
+						ThemeMode:           domain.LabelPolicyThemeAuto,
+					},
+				},
+			},
+			res: res{
+				want: &ProvisionedOrg{
+					CreatedOrg: &CreatedOrg{
+						ObjectDetails: &domain.ObjectDetails{
+							ResourceOwner: "orgID",
+						},
+						CreatedAdmins: []*CreatedOrgAdmin{},
+					},
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := &Commands{
+				eventstore:  tt.fields.eventstore(t),
+				idGenerator: tt.fields.idGenerator,
+			}
+			got, err := r.ProvisionOrg(tt.args.ctx, tt.args.org, false)
+			assert.ErrorIs(t, err, tt.res.err)
+			assert.Equal(t, tt.res.want, got)
+		})
+	}
+}
+
+func TestCommandSide_ProvisionOrgs(t *testing.T) {
+	r := &Commands{
+		eventstore: expectEventstore(
+			expectFilter(
+				eventFromEventPusher(
+					org.NewOrgAddedEvent(context.Background(), &org.NewAggregate("org1").Aggregate, "Org 1"),
+				),
+				eventFromEventPusher(
+					org.NewOrgRemovedEvent(context.Background(), &org.NewAggregate("org1").Aggregate, "Org 1", nil, false, nil, nil, nil),
+				),
+			),
+			expectFilter(
+				eventFromEventPusher(
+					org.NewOrgAddedEvent(context.Background(), &org.NewAggregate("org2").Aggregate, "Org 2"),
+				),
+			),
+		)(t),
+	}
+	results := r.ProvisionOrgs(authz.WithRequestedDomain(context.Background(), "iam-domain"), []*OrgProvisioning{
+		{ID: "org1", OrgSetup: OrgSetup{Name: "Org 1"}},
+		{ID: "org2", OrgSetup: OrgSetup{Name: "Org 2"}},
+	}, false)
+	if assert.Len(t, results, 2) {
+		assert.True(t, zerrors.IsPreconditionFailed(results[0].Err))
+		assert.Nil(t, results[0].Org)
+		assert.NoError(t, results[1].Err)
+		assert.True(t, results[1].Org.AlreadyExisted)
+	}
+}
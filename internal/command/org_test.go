@@ -1364,6 +1364,7 @@ func TestCommandSide_SetUpOrg(t *testing.T) {
 							),
 						),
 					),
+					expectFilter(), // user attribute schema
 					expectFilter(), // org member check
 					expectFilter(
 						eventFromEventPusher(
@@ -1558,6 +1559,7 @@ func TestCommandSide_SetUpOrg(t *testing.T) {
 					),
 					expectFilter(),
 					expectFilter(),
+					expectFilter(), // machine credential policy
 					expectFilter(), // org member check
 					expectFilter(
 						eventFromEventPusher(
@@ -1607,6 +1609,9 @@ func TestCommandSide_SetUpOrg(t *testing.T) {
 								"tokenID",
 								testNow.Add(time.Hour),
 								[]string{openid.ScopeOpenID},
+								nil,
+								nil,
+								nil,
 							),
 						),
 						eventFromEventPusher(org.NewMemberAddedEvent(context.Background(),
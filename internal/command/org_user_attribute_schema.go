@@ -0,0 +1,110 @@
+package command
+
+import (
+	"context"
+	"slices"
+
+	"github.com/zitadel/zitadel/internal/command/preparation"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/repository/org"
+	"github.com/zitadel/zitadel/internal/telemetry/tracing"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+// SetOrgUserAttributeSchema replaces the custom attributes of the human users of the organization.
+// The values of the attributes are validated when users are created and their metadata is changed.
+func (c *Commands) SetOrgUserAttributeSchema(ctx context.Context, orgID string, attributes []*domain.UserAttribute) (_ *domain.ObjectDetails, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	if orgID == "" {
+		return nil, zerrors.ThrowInvalidArgument(nil, "ORG-Uas1i", "Errors.ResourceOwnerMissing")
+	}
+	if len(attributes) == 0 {
+		return nil, zerrors.ThrowInvalidArgument(nil, "ORG-Uas2e", "Errors.Org.UserAttributeSchema.Invalid")
+	}
+	keys := make(map[string]struct{}, len(attributes))
+	for _, attribute := range attributes {
+		if !attribute.IsValid() {
+			return nil, zerrors.ThrowInvalidArgument(nil, "ORG-Uas3i", "Errors.Org.UserAttributeSchema.Invalid")
+		}
+		if _, ok := keys[attribute.Key]; ok {
+			return nil, zerrors.ThrowInvalidArgument(nil, "ORG-Uas4d", "Errors.Org.UserAttributeSchema.DuplicateKey")
+		}
+		keys[attribute.Key] = struct{}{}
+	}
+	if err = c.checkOrgExists(ctx, orgID); err != nil {
+		return nil, err
+	}
+	writeModel, err := c.orgUserAttributeSchemaWriteModel(ctx, orgID)
+	if err != nil {
+		return nil, err
+	}
+	if writeModel.State.Exists() && slices.EqualFunc(writeModel.Attributes, attributes, func(a, b *domain.UserAttribute) bool {
+		return *a == *b
+	}) {
+		return nil, zerrors.ThrowPreconditionFailed(nil, "ORG-Uas5c", "Errors.Org.UserAttributeSchema.NotChanged")
+	}
+	schema := make([]*org.UserAttribute, len(attributes))
+	for i, attribute := range attributes {
+		schema[i] = &org.UserAttribute{
+			Key:         attribute.Key,
+			DisplayName: attribute.DisplayName,
+			Type:        attribute.Type,
+			Required:    attribute.Required,
+			Pattern:     attribute.Pattern,
+		}
+	}
+	if err = c.pushAppendAndReduce(ctx, writeModel,
+		org.NewUserAttributeSchemaSetEvent(ctx, &org.NewAggregate(orgID).Aggregate, schema),
+	); err != nil {
+		return nil, err
+	}
+	return writeModelToObjectDetails(&writeModel.WriteModel), nil
+}
+
+// RemoveOrgUserAttributeSchema removes the custom attributes of the human users of the organization.
+// The values stay in the metadata of the users.
+func (c *Commands) RemoveOrgUserAttributeSchema(ctx context.Context, orgID string) (_ *domain.ObjectDetails, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	if orgID == "" {
+		return nil, zerrors.ThrowInvalidArgument(nil, "ORG-Uas6i", "Errors.ResourceOwnerMissing")
+	}
+	writeModel, err := c.orgUserAttributeSchemaWriteModel(ctx, orgID)
+	if err != nil {
+		return nil, err
+	}
+	if !writeModel.State.Exists() {
+		return nil, zerrors.ThrowNotFound(nil, "ORG-Uas7n", "Errors.Org.UserAttributeSchema.NotFound")
+	}
+	if err = c.pushAppendAndReduce(ctx, writeModel,
+		org.NewUserAttributeSchemaRemovedEvent(ctx, &org.NewAggregate(orgID).Aggregate),
+	); err != nil {
+		return nil, err
+	}
+	return writeModelToObjectDetails(&writeModel.WriteModel), nil
+}
+
+func (c *Commands) orgUserAttributeSchemaWriteModel(ctx context.Context, orgID string) (*OrgUserAttributeSchemaWriteModel, error) {
+	writeModel := NewOrgUserAttributeSchemaWriteModel(orgID)
+	if err := c.eventstore.FilterToQueryReducer(ctx, writeModel); err != nil {
+		return nil, err
+	}
+	return writeModel, nil
+}
+
+func orgUserAttributeSchema(ctx context.Context, filter preparation.FilterToQueryReducer, orgID string) (*OrgUserAttributeSchemaWriteModel, error) {
+	schema := NewOrgUserAttributeSchemaWriteModel(orgID)
+	events, err := filter(ctx, schema.Query())
+	if err != nil {
+		return nil, err
+	}
+	if len(events) == 0 {
+		return schema, nil
+	}
+	schema.AppendEvents(events...)
+	err = schema.Reduce()
+	return schema, err
+}
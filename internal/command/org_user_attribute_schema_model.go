@@ -0,0 +1,99 @@
+package command
+
+import (
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/repository/org"
+)
+
+type OrgUserAttributeSchemaWriteModel struct {
+	eventstore.WriteModel
+
+	State      domain.PolicyState
+	Attributes []*domain.UserAttribute
+}
+
+func NewOrgUserAttributeSchemaWriteModel(orgID string) *OrgUserAttributeSchemaWriteModel {
+	return &OrgUserAttributeSchemaWriteModel{
+		WriteModel: eventstore.WriteModel{
+			AggregateID:   orgID,
+			ResourceOwner: orgID,
+		},
+	}
+}
+
+func (wm *OrgUserAttributeSchemaWriteModel) Reduce() error {
+	for _, event := range wm.Events {
+		switch e := event.(type) {
+		case *org.UserAttributeSchemaSetEvent:
+			wm.State = domain.PolicyStateActive
+			wm.Attributes = make([]*domain.UserAttribute, len(e.Attributes))
+			for i, attribute := range e.Attributes {
+				wm.Attributes[i] = &domain.UserAttribute{
+					Key:         attribute.Key,
+					DisplayName: attribute.DisplayName,
+					Type:        attribute.Type,
+					Required:    attribute.Required,
+					Pattern:     attribute.Pattern,
+				}
+			}
+		case *org.UserAttributeSchemaRemovedEvent, *org.OrgRemovedEvent:
+			wm.State = domain.PolicyStateRemoved
+			wm.Attributes = nil
+		}
+	}
+	return wm.WriteModel.Reduce()
+}
+
+func (wm *OrgUserAttributeSchemaWriteModel) Query() *eventstore.SearchQueryBuilder {
+	return eventstore.NewSearchQueryBuilder(eventstore.ColumnsEvent).
+		ResourceOwner(wm.ResourceOwner).
+		AddQuery().
+		AggregateTypes(org.AggregateType).
+		AggregateIDs(wm.AggregateID).
+		EventTypes(
+			org.UserAttributeSchemaSetEventType,
+			org.UserAttributeSchemaRemovedEventType,
+			org.OrgRemovedEventType,
+		).
+		Builder()
+}
+
+func (wm *OrgUserAttributeSchemaWriteModel) attribute(key string) *domain.UserAttribute {
+	for _, attribute := range wm.Attributes {
+		if attribute.Key == key {
+			return attribute
+		}
+	}
+	return nil
+}
+
+// validateUserMetadata checks the metadata of a new user against all attributes of the schema
+func (wm *OrgUserAttributeSchemaWriteModel) validateUserMetadata(metadata []*AddMetadataEntry) error {
+	values := make(map[string][]byte, len(metadata))
+	for _, entry := range metadata {
+		values[entry.Key] = entry.Value
+	}
+	for _, attribute := range wm.Attributes {
+		if err := attribute.ValidateValue(values[attribute.Key]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// validateSetMetadata checks the value of the metadata, if it's an attribute of the schema
+func (wm *OrgUserAttributeSchemaWriteModel) validateSetMetadata(key string, value []byte) error {
+	if attribute := wm.attribute(key); attribute != nil {
+		return attribute.ValidateValue(value)
+	}
+	return nil
+}
+
+// validateRemoveMetadata prevents the removal of the metadata of required attributes
+func (wm *OrgUserAttributeSchemaWriteModel) validateRemoveMetadata(key string) error {
+	if attribute := wm.attribute(key); attribute != nil {
+		return attribute.ValidateValue(nil)
+	}
+	return nil
+}
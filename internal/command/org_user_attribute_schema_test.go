@@ -0,0 +1,295 @@
+package command
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/text/language"
+
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/repository/org"
+	"github.com/zitadel/zitadel/internal/repository/user"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+func TestCommandSide_SetOrgUserAttributeSchema(t *testing.T) {
+	type fields struct {
+		eventstore func(t *testing.T) *eventstore.Eventstore
+	}
+	type args struct {
+		ctx        context.Context
+		orgID      string
+		attributes []*domain.UserAttribute
+	}
+	type res struct {
+		want *domain.ObjectDetails
+		err  error
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		args   args
+		res    res
+	}{
+		{
+			name: "no attributes, invalid argument error",
+			fields: fields{
+				eventstore: expectEventstore(),
+			},
+			args: args{
+				ctx:   context.Background(),
+				orgID: "org1",
+			},
+			res: res{
+				err: zerrors.ThrowInvalidArgument(nil, "ORG-Uas2e", "Errors.Org.UserAttributeSchema.Invalid"),
+			},
+		},
+		{
+			name: "invalid pattern, invalid argument error",
+			fields: fields{
+				eventstore: expectEventstore(),
+			},
+			args: args{
+				ctx:   context.Background(),
+				orgID: "org1",
+				attributes: []*domain.UserAttribute{
+					{Key: "department", Type: domain.UserAttributeTypeString, Pattern: "[a-z"},
+				},
+			},
+			res: res{
+				err: zerrors.ThrowInvalidArgument(nil, "ORG-Uas3i", "Errors.Org.UserAttributeSchema.Invalid"),
+			},
+		},
+		{
+			name: "duplicate key, invalid argument error",
+			fields: fields{
+				eventstore: expectEventstore(),
+			},
+			args: args{
+				ctx:   context.Background(),
+				orgID: "org1",
+				attributes: []*domain.UserAttribute{
+					{Key: "department", Type: domain.UserAttributeTypeString},
+					{Key: "department", Type: domain.UserAttributeTypeNumber},
+				},
+			},
+			res: res{
+				err: zerrors.ThrowInvalidArgument(nil, "ORG-Uas4d", "Errors.Org.UserAttributeSchema.DuplicateKey"),
+			},
+		},
+		{
+			name: "org not found, precondition error",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(),
+				),
+			},
+			args: args{
+				ctx:   context.Background(),
+				orgID: "org1",
+				attributes: []*domain.UserAttribute{
+					{Key: "department", Type: domain.UserAttributeTypeString},
+				},
+			},
+			res: res{
+				err: zerrors.ThrowPreconditionFailed(nil, "COMMAND-QXPGs", "Errors.Org.NotFound"),
+			},
+		},
+		{
+			name: "schema not changed, precondition error",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(
+							org.NewOrgAddedEvent(context.Background(), &org.NewAggregate("org1").Aggregate, "org"),
+						),
+					),
+					expectFilter(
+						eventFromEventPusher(
+							org.NewUserAttributeSchemaSetEvent(context.Background(), &org.NewAggregate("org1").Aggregate, []*org.UserAttribute{
+								{Key: "department", Type: domain.UserAttributeTypeString},
+							}),
+						),
+					),
+				),
+			},
+			args: args{
+				ctx:   context.Background(),
+				orgID: "org1",
+				attributes: []*domain.UserAttribute{
+					{Key: "department", Type: domain.UserAttributeTypeString},
+				},
+			},
+			res: res{
+				err: zerrors.ThrowPreconditionFailed(nil, "ORG-Uas5c", "Errors.Org.UserAttributeSchema.NotChanged"),
+			},
+		},
+		{
+			name: "set schema, ok",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(
+							org.NewOrgAddedEvent(context.Background(), &org.NewAggregate("org1").Aggregate, "org"),
+						),
+					),
+					expectFilter(),
+					expectPush(
+						org.NewUserAttributeSchemaSetEvent(context.Background(), &org.NewAggregate("org1").Aggregate, []*org.UserAttribute{
+							{Key: "department", DisplayName: "Department", Type: domain.UserAttributeTypeString, Required: true, Pattern: "[A-Z]{3}"},
+							{Key: "birthday", Type: domain.UserAttributeTypeDate},
+						}),
+					),
+				),
+			},
+			args: args{
+				ctx:   context.Background(),
+				orgID: "org1",
+				attributes: []*domain.UserAttribute{
+					{Key: "department", DisplayName: "Department", Type: domain.UserAttributeTypeString, Required: true, Pattern: "[A-Z]{3}"},
+					{Key: "birthday", Type: domain.UserAttributeTypeDate},
+				},
+			},
+			res: res{
+				want: &domain.ObjectDetails{
+					ResourceOwner: "org1",
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := &Commands{
+				eventstore: tt.fields.eventstore(t),
+			}
+			got, err := r.SetOrgUserAttributeSchema(tt.args.ctx, tt.args.orgID, tt.args.attributes)
+			assert.ErrorIs(t, err, tt.res.err)
+			assert.Equal(t, tt.res.want, got)
+		})
+	}
+}
+
+func TestCommandSide_RemoveOrgUserAttributeSchema(t *testing.T) {
+	type fields struct {
+		eventstore func(t *testing.T) *eventstore.Eventstore
+	}
+	type args struct {
+		ctx   context.Context
+		orgID string
+	}
+	type res struct {
+		want *domain.ObjectDetails
+		err  error
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		args   args
+		res    res
+	}{
+		{
+			name: "schema not found, not found error",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(),
+				),
+			},
+			args: args{
+				ctx:   context.Background(),
+				orgID: "org1",
+			},
+			res: res{
+				err: zerrors.ThrowNotFound(nil, "ORG-Uas7n", "Errors.Org.UserAttributeSchema.NotFound"),
+			},
+		},
+		{
+			name: "remove schema, ok",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(
+							org.NewUserAttributeSchemaSetEvent(context.Background(), &org.NewAggregate("org1").Aggregate, []*org.UserAttribute{
+								{Key: "department", Type: domain.UserAttributeTypeString},
+							}),
+						),
+					),
+					expectPush(
+						org.NewUserAttributeSchemaRemovedEvent(context.Background(), &org.NewAggregate("org1").Aggregate),
+					),
+				),
+			},
+			args: args{
+				ctx:   context.Background(),
+				orgID: "org1",
+			},
+			res: res{
+				want: &domain.ObjectDetails{
+					ResourceOwner: "org1",
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := &Commands{
+				eventstore: tt.fields.eventstore(t),
+			}
+			got, err := r.RemoveOrgUserAttributeSchema(tt.args.ctx, tt.args.orgID)
+			assert.ErrorIs(t, err, tt.res.err)
+			assert.Equal(t, tt.res.want, got)
+		})
+	}
+}
+
+func TestCommandSide_SetUserMetadata_userAttributeSchema(t *testing.T) {
+	userAddedEvent := func() eventstore.Event {
+		return eventFromEventPusher(
+			user.NewHumanAddedEvent(context.Background(),
+				&user.NewAggregate("user1", "org1").Aggregate,
+				"username",
+				"firstname",
+				"lastname",
+				"",
+				"firstname lastname",
+				language.Und,
+				domain.GenderUnspecified,
+				"email@test.ch",
+				true,
+			),
+		)
+	}
+	schemaSetEvent := func() eventstore.Event {
+		return eventFromEventPusher(
+			org.NewUserAttributeSchemaSetEvent(context.Background(), &org.NewAggregate("org1").Aggregate, []*org.UserAttribute{
+				{Key: "employee_number", Type: domain.UserAttributeTypeNumber, Required: true},
+			}),
+		)
+	}
+	t.Run("invalid value, invalid argument error", func(t *testing.T) {
+		r := &Commands{
+			eventstore: expectEventstore(
+				expectFilter(userAddedEvent()),
+				expectFilter(schemaSetEvent()),
+			)(t),
+		}
+		_, err := r.SetUserMetadata(context.Background(), &domain.Metadata{Key: "employee_number", Value: []byte("abc")}, "user1", "org1")
+		assert.ErrorIs(t, err, zerrors.ThrowInvalidArgument(nil, "DOMAIN-Uat2t", "Errors.User.Attribute.Invalid"))
+	})
+	t.Run("remove required attribute, invalid argument error", func(t *testing.T) {
+		r := &Commands{
+			eventstore: expectEventstore(
+				expectFilter(userAddedEvent()),
+				expectFilter(
+					eventFromEventPusher(
+						user.NewMetadataSetEvent(context.Background(), &user.NewAggregate("user1", "org1").Aggregate, "employee_number", []byte("42")),
+					),
+				),
+				expectFilter(schemaSetEvent()),
+			)(t),
+		}
+		_, err := r.RemoveUserMetadata(context.Background(), "employee_number", "user1", "org1")
+		assert.ErrorIs(t, err, zerrors.ThrowInvalidArgument(nil, "DOMAIN-Uat1r", "Errors.User.Attribute.Required"))
+	})
+}
@@ -0,0 +1,107 @@
+package command
+
+import (
+	"context"
+	"time"
+
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/repository/org"
+	"github.com/zitadel/zitadel/internal/telemetry/tracing"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+// UserLifecyclePolicy defines after which duration of inactivity the human users of an organization are deactivated and deleted.
+type UserLifecyclePolicy struct {
+	// DeactivateAfter is optional, 0 disables the deactivation
+	DeactivateAfter time.Duration
+	// DeleteAfter is optional, 0 disables the deletion
+	DeleteAfter time.Duration
+	// NotifyBefore is optional, the org owners are notified this duration before a user is deactivated or deleted
+	NotifyBefore time.Duration
+}
+
+func (p *UserLifecyclePolicy) Validate() error {
+	if p.DeactivateAfter < 0 || p.DeleteAfter < 0 || p.NotifyBefore < 0 ||
+		(p.DeactivateAfter == 0 && p.DeleteAfter == 0) {
+		return zerrors.ThrowInvalidArgument(nil, "ORG-Ulp3i", "Errors.Org.UserLifecyclePolicy.Invalid")
+	}
+	// inactive users are deactivated before they are deleted
+	if p.DeactivateAfter > 0 && p.DeleteAfter > 0 && p.DeleteAfter <= p.DeactivateAfter {
+		return zerrors.ThrowInvalidArgument(nil, "ORG-Ulp4d", "Errors.Org.UserLifecyclePolicy.Invalid")
+	}
+	if p.NotifyBefore >= p.firstAction() {
+		return zerrors.ThrowInvalidArgument(nil, "ORG-Ulp5n", "Errors.Org.UserLifecyclePolicy.Invalid")
+	}
+	return nil
+}
+
+// firstAction returns the duration of inactivity after which the first action is executed
+func (p *UserLifecyclePolicy) firstAction() time.Duration {
+	if p.DeactivateAfter > 0 {
+		return p.DeactivateAfter
+	}
+	return p.DeleteAfter
+}
+
+// SetOrgUserLifecyclePolicy sets the policy which deactivates and deletes the inactive users of the organization.
+func (c *Commands) SetOrgUserLifecyclePolicy(ctx context.Context, orgID string, policy *UserLifecyclePolicy) (_ *domain.ObjectDetails, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	if orgID == "" {
+		return nil, zerrors.ThrowInvalidArgument(nil, "ORG-Ulp1i", "Errors.ResourceOwnerMissing")
+	}
+	if err = policy.Validate(); err != nil {
+		return nil, err
+	}
+	if err = c.checkOrgExists(ctx, orgID); err != nil {
+		return nil, err
+	}
+	writeModel, err := c.orgUserLifecyclePolicyWriteModel(ctx, orgID)
+	if err != nil {
+		return nil, err
+	}
+	if writeModel.State.Exists() &&
+		writeModel.DeactivateAfter == policy.DeactivateAfter &&
+		writeModel.DeleteAfter == policy.DeleteAfter &&
+		writeModel.NotifyBefore == policy.NotifyBefore {
+		return nil, zerrors.ThrowPreconditionFailed(nil, "ORG-Ulp6c", "Errors.Org.UserLifecyclePolicy.NotChanged")
+	}
+	if err = c.pushAppendAndReduce(ctx, writeModel,
+		org.NewUserLifecyclePolicySetEvent(ctx, &org.NewAggregate(orgID).Aggregate, policy.DeactivateAfter, policy.DeleteAfter, policy.NotifyBefore),
+	); err != nil {
+		return nil, err
+	}
+	return writeModelToObjectDetails(&writeModel.WriteModel), nil
+}
+
+// RemoveOrgUserLifecyclePolicy stops the deactivation and deletion of inactive users of the organization.
+func (c *Commands) RemoveOrgUserLifecyclePolicy(ctx context.Context, orgID string) (_ *domain.ObjectDetails, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	if orgID == "" {
+		return nil, zerrors.ThrowInvalidArgument(nil, "ORG-Ulp2i", "Errors.ResourceOwnerMissing")
+	}
+	writeModel, err := c.orgUserLifecyclePolicyWriteModel(ctx, orgID)
+	if err != nil {
+		return nil, err
+	}
+	if !writeModel.State.Exists() {
+		return nil, zerrors.ThrowNotFound(nil, "ORG-Ulp7n", "Errors.Org.UserLifecyclePolicy.NotFound")
+	}
+	if err = c.pushAppendAndReduce(ctx, writeModel,
+		org.NewUserLifecyclePolicyRemovedEvent(ctx, &org.NewAggregate(orgID).Aggregate),
+	); err != nil {
+		return nil, err
+	}
+	return writeModelToObjectDetails(&writeModel.WriteModel), nil
+}
+
+func (c *Commands) orgUserLifecyclePolicyWriteModel(ctx context.Context, orgID string) (*OrgUserLifecyclePolicyWriteModel, error) {
+	writeModel := NewOrgUserLifecyclePolicyWriteModel(orgID)
+	if err := c.eventstore.FilterToQueryReducer(ctx, writeModel); err != nil {
+		return nil, err
+	}
+	return writeModel, nil
+}
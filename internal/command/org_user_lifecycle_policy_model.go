@@ -0,0 +1,59 @@
+package command
+
+import (
+	"time"
+
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/repository/org"
+)
+
+type OrgUserLifecyclePolicyWriteModel struct {
+	eventstore.WriteModel
+
+	State           domain.PolicyState
+	DeactivateAfter time.Duration
+	DeleteAfter     time.Duration
+	NotifyBefore    time.Duration
+}
+
+func NewOrgUserLifecyclePolicyWriteModel(orgID string) *OrgUserLifecyclePolicyWriteModel {
+	return &OrgUserLifecyclePolicyWriteModel{
+		WriteModel: eventstore.WriteModel{
+			AggregateID:   orgID,
+			ResourceOwner: orgID,
+		},
+	}
+}
+
+func (wm *OrgUserLifecyclePolicyWriteModel) Reduce() error {
+	for _, event := range wm.Events {
+		switch e := event.(type) {
+		case *org.UserLifecyclePolicySetEvent:
+			wm.State = domain.PolicyStateActive
+			wm.DeactivateAfter = e.DeactivateAfter
+			wm.DeleteAfter = e.DeleteAfter
+			wm.NotifyBefore = e.NotifyBefore
+		case *org.UserLifecyclePolicyRemovedEvent, *org.OrgRemovedEvent:
+			wm.State = domain.PolicyStateRemoved
+			wm.DeactivateAfter = 0
+			wm.DeleteAfter = 0
+			wm.NotifyBefore = 0
+		}
+	}
+	return wm.WriteModel.Reduce()
+}
+
+func (wm *OrgUserLifecyclePolicyWriteModel) Query() *eventstore.SearchQueryBuilder {
+	return eventstore.NewSearchQueryBuilder(eventstore.ColumnsEvent).
+		ResourceOwner(wm.ResourceOwner).
+		AddQuery().
+		AggregateTypes(org.AggregateType).
+		AggregateIDs(wm.AggregateID).
+		EventTypes(
+			org.UserLifecyclePolicySetEventType,
+			org.UserLifecyclePolicyRemovedEventType,
+			org.OrgRemovedEventType,
+		).
+		Builder()
+}
@@ -0,0 +1,229 @@
+package command
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/repository/org"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+func TestCommandSide_SetOrgUserLifecyclePolicy(t *testing.T) {
+	type fields struct {
+		eventstore func(t *testing.T) *eventstore.Eventstore
+	}
+	type args struct {
+		ctx    context.Context
+		orgID  string
+		policy *UserLifecyclePolicy
+	}
+	type res struct {
+		want *domain.ObjectDetails
+		err  error
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		args   args
+		res    res
+	}{
+		{
+			name: "no action, invalid argument error",
+			fields: fields{
+				eventstore: expectEventstore(),
+			},
+			args: args{
+				ctx:    context.Background(),
+				orgID:  "org1",
+				policy: &UserLifecyclePolicy{NotifyBefore: time.Hour},
+			},
+			res: res{
+				err: zerrors.ThrowInvalidArgument(nil, "ORG-Ulp3i", "Errors.Org.UserLifecyclePolicy.Invalid"),
+			},
+		},
+		{
+			name: "deletion before deactivation, invalid argument error",
+			fields: fields{
+				eventstore: expectEventstore(),
+			},
+			args: args{
+				ctx:    context.Background(),
+				orgID:  "org1",
+				policy: &UserLifecyclePolicy{DeactivateAfter: 2 * time.Hour, DeleteAfter: time.Hour},
+			},
+			res: res{
+				err: zerrors.ThrowInvalidArgument(nil, "ORG-Ulp4d", "Errors.Org.UserLifecyclePolicy.Invalid"),
+			},
+		},
+		{
+			name: "notification before first action, invalid argument error",
+			fields: fields{
+				eventstore: expectEventstore(),
+			},
+			args: args{
+				ctx:    context.Background(),
+				orgID:  "org1",
+				policy: &UserLifecyclePolicy{DeleteAfter: time.Hour, NotifyBefore: time.Hour},
+			},
+			res: res{
+				err: zerrors.ThrowInvalidArgument(nil, "ORG-Ulp5n", "Errors.Org.UserLifecyclePolicy.Invalid"),
+			},
+		},
+		{
+			name: "org not found, precondition error",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(),
+				),
+			},
+			args: args{
+				ctx:    context.Background(),
+				orgID:  "org1",
+				policy: &UserLifecyclePolicy{DeactivateAfter: time.Hour},
+			},
+			res: res{
+				err: zerrors.ThrowPreconditionFailed(nil, "COMMAND-QXPGs", "Errors.Org.NotFound"),
+			},
+		},
+		{
+			name: "policy not changed, precondition error",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(
+							org.NewOrgAddedEvent(context.Background(), &org.NewAggregate("org1").Aggregate, "org"),
+						),
+					),
+					expectFilter(
+						eventFromEventPusher(
+							org.NewUserLifecyclePolicySetEvent(context.Background(), &org.NewAggregate("org1").Aggregate, time.Hour, 0, 0),
+						),
+					),
+				),
+			},
+			args: args{
+				ctx:    context.Background(),
+				orgID:  "org1",
+				policy: &UserLifecyclePolicy{DeactivateAfter: time.Hour},
+			},
+			res: res{
+				err: zerrors.ThrowPreconditionFailed(nil, "ORG-Ulp6c", "Errors.Org.UserLifecyclePolicy.NotChanged"),
+			},
+		},
+		{
+			name: "set policy, ok",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(
+							org.NewOrgAddedEvent(context.Background(), &org.NewAggregate("org1").Aggregate, "org"),
+						),
+					),
+					expectFilter(),
+					expectPush(
+						org.NewUserLifecyclePolicySetEvent(context.Background(), &org.NewAggregate("org1").Aggregate, 90*24*time.Hour, 365*24*time.Hour, 7*24*time.Hour),
+					),
+				),
+			},
+			args: args{
+				ctx:   context.Background(),
+				orgID: "org1",
+				policy: &UserLifecyclePolicy{
+					DeactivateAfter: 90 * 24 * time.Hour,
+					DeleteAfter:     365 * 24 * time.Hour,
+					NotifyBefore:    7 * 24 * time.Hour,
+				},
+			},
+			res: res{
+				want: &domain.ObjectDetails{
+					ResourceOwner: "org1",
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := &Commands{
+				eventstore: tt.fields.eventstore(t),
+			}
+			got, err := r.SetOrgUserLifecyclePolicy(tt.args.ctx, tt.args.orgID, tt.args.policy)
+			assert.ErrorIs(t, err, tt.res.err)
+			assert.Equal(t, tt.res.want, got)
+		})
+	}
+}
+
+func TestCommandSide_RemoveOrgUserLifecyclePolicy(t *testing.T) {
+	type fields struct {
+		eventstore func(t *testing.T) *eventstore.Eventstore
+	}
+	type args struct {
+		ctx   context.Context
+		orgID string
+	}
+	type res struct {
+		want *domain.ObjectDetails
+		err  error
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		args   args
+		res    res
+	}{
+		{
+			name: "policy not found, not found error",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(),
+				),
+			},
+			args: args{
+				ctx:   context.Background(),
+				orgID: "org1",
+			},
+			res: res{
+				err: zerrors.ThrowNotFound(nil, "ORG-Ulp7n", "Errors.Org.UserLifecyclePolicy.NotFound"),
+			},
+		},
+		{
+			name: "remove policy, ok",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(
+							org.NewUserLifecyclePolicySetEvent(context.Background(), &org.NewAggregate("org1").Aggregate, time.Hour, 0, 0),
+						),
+					),
+					expectPush(
+						org.NewUserLifecyclePolicyRemovedEvent(context.Background(), &org.NewAggregate("org1").Aggregate),
+					),
+				),
+			},
+			args: args{
+				ctx:   context.Background(),
+				orgID: "org1",
+			},
+			res: res{
+				want: &domain.ObjectDetails{
+					ResourceOwner: "org1",
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := &Commands{
+				eventstore: tt.fields.eventstore(t),
+			}
+			got, err := r.RemoveOrgUserLifecyclePolicy(tt.args.ctx, tt.args.orgID)
+			assert.ErrorIs(t, err, tt.res.err)
+			assert.Equal(t, tt.res.want, got)
+		})
+	}
+}
@@ -0,0 +1,107 @@
+package command
+
+import (
+	"github.com/zitadel/zitadel/internal/crypto"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/repository/policy"
+)
+
+type CaptchaPolicyWriteModel struct {
+	eventstore.WriteModel
+
+	Type                   domain.CaptchaType
+	SiteKey                string
+	Secret                 *crypto.CryptoValue
+	EnabledOnRegister      bool
+	EnabledOnPasswordReset bool
+	EnabledOnPasswordless  bool
+	EnabledOnSession       bool
+	State                  domain.PolicyState
+}
+
+func (wm *CaptchaPolicyWriteModel) Reduce() error {
+	for _, event := range wm.Events {
+		switch e := event.(type) {
+		case *policy.CaptchaPolicyAddedEvent:
+			wm.Type = e.CaptchaType
+			wm.SiteKey = e.SiteKey
+			wm.Secret = e.Secret
+			wm.EnabledOnRegister = e.EnabledOnRegister
+			wm.EnabledOnPasswordReset = e.EnabledOnPasswordReset
+			wm.EnabledOnPasswordless = e.EnabledOnPasswordless
+			wm.EnabledOnSession = e.EnabledOnSession
+			wm.State = domain.PolicyStateActive
+		case *policy.CaptchaPolicyChangedEvent:
+			if e.CaptchaType != nil {
+				wm.Type = *e.CaptchaType
+			}
+			if e.SiteKey != nil {
+				wm.SiteKey = *e.SiteKey
+			}
+			if e.Secret != nil {
+				wm.Secret = e.Secret
+			}
+			if e.EnabledOnRegister != nil {
+				wm.EnabledOnRegister = *e.EnabledOnRegister
+			}
+			if e.EnabledOnPasswordReset != nil {
+				wm.EnabledOnPasswordReset = *e.EnabledOnPasswordReset
+			}
+			if e.EnabledOnPasswordless != nil {
+				wm.EnabledOnPasswordless = *e.EnabledOnPasswordless
+			}
+			if e.EnabledOnSession != nil {
+				wm.EnabledOnSession = *e.EnabledOnSession
+			}
+		case *policy.CaptchaPolicyRemovedEvent:
+			wm.Type = domain.CaptchaTypeUnspecified
+			wm.SiteKey = ""
+			wm.Secret = nil
+			wm.EnabledOnRegister = false
+			wm.EnabledOnPasswordReset = false
+			wm.EnabledOnPasswordless = false
+			wm.EnabledOnSession = false
+			wm.State = domain.PolicyStateRemoved
+		}
+	}
+	return wm.WriteModel.Reduce()
+}
+
+func (wm *CaptchaPolicyWriteModel) toDomain() *domain.CaptchaPolicy {
+	return &domain.CaptchaPolicy{
+		Type:                   wm.Type,
+		SiteKey:                wm.SiteKey,
+		Secret:                 wm.Secret,
+		EnabledOnRegister:      wm.EnabledOnRegister,
+		EnabledOnPasswordReset: wm.EnabledOnPasswordReset,
+		EnabledOnPasswordless:  wm.EnabledOnPasswordless,
+		EnabledOnSession:       wm.EnabledOnSession,
+	}
+}
+
+func (wm *CaptchaPolicyWriteModel) changes(p *CaptchaPolicy, secret *crypto.CryptoValue) []policy.CaptchaPolicyChanges {
+	changes := make([]policy.CaptchaPolicyChanges, 0, 7)
+	if wm.Type != p.Type {
+		changes = append(changes, policy.ChangeCaptchaType(p.Type))
+	}
+	if wm.SiteKey != p.SiteKey {
+		changes = append(changes, policy.ChangeCaptchaSiteKey(p.SiteKey))
+	}
+	if secret != nil {
+		changes = append(changes, policy.ChangeCaptchaSecret(secret))
+	}
+	if wm.EnabledOnRegister != p.EnabledOnRegister {
+		changes = append(changes, policy.ChangeCaptchaEnabledOnRegister(p.EnabledOnRegister))
+	}
+	if wm.EnabledOnPasswordReset != p.EnabledOnPasswordReset {
+		changes = append(changes, policy.ChangeCaptchaEnabledOnPasswordReset(p.EnabledOnPasswordReset))
+	}
+	if wm.EnabledOnPasswordless != p.EnabledOnPasswordless {
+		changes = append(changes, policy.ChangeCaptchaEnabledOnPasswordless(p.EnabledOnPasswordless))
+	}
+	if wm.EnabledOnSession != p.EnabledOnSession {
+		changes = append(changes, policy.ChangeCaptchaEnabledOnSession(p.EnabledOnSession))
+	}
+	return changes
+}
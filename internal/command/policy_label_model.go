@@ -29,6 +29,7 @@ type LabelPolicyWriteModel struct {
 	ErrorMsgPopup       bool
 	DisableWatermark    bool
 	ThemeMode           domain.LabelPolicyThemeMode
+	CustomCSS           string
 
 	State domain.PolicyState
 }
@@ -49,6 +50,7 @@ func (wm *LabelPolicyWriteModel) Reduce() error {
 			wm.ErrorMsgPopup = e.ErrorMsgPopup
 			wm.DisableWatermark = e.DisableWatermark
 			wm.ThemeMode = e.ThemeMode
+			wm.CustomCSS = e.CustomCSS
 			wm.State = domain.PolicyStateActive
 		case *policy.LabelPolicyChangedEvent:
 			if e.PrimaryColor != nil {
@@ -87,6 +89,9 @@ func (wm *LabelPolicyWriteModel) Reduce() error {
 			if e.ThemeMode != nil {
 				wm.ThemeMode = *e.ThemeMode
 			}
+			if e.CustomCSS != nil {
+				wm.CustomCSS = *e.CustomCSS
+			}
 		case *policy.LabelPolicyLogoAddedEvent:
 			wm.LogoKey = e.StoreKey
 		case *policy.LabelPolicyLogoRemovedEvent:
@@ -0,0 +1,79 @@
+package command
+
+import (
+	"slices"
+
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/repository/policy"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+type LoginPolicyIDPButtonsWriteModel struct {
+	eventstore.WriteModel
+
+	IDPConfigIDs []string
+	Buttons      []*domain.IDPButton
+	Icons        map[string]string
+	State        domain.PolicyState
+}
+
+func (wm *LoginPolicyIDPButtonsWriteModel) Reduce() error {
+	for _, event := range wm.Events {
+		switch e := event.(type) {
+		case *policy.LoginPolicyAddedEvent:
+			wm.State = domain.PolicyStateActive
+		case *policy.LoginPolicyRemovedEvent:
+			wm.IDPConfigIDs = nil
+			wm.Buttons = nil
+			wm.Icons = nil
+			wm.State = domain.PolicyStateRemoved
+		case *policy.IdentityProviderAddedEvent:
+			wm.IDPConfigIDs = append(wm.IDPConfigIDs, e.IDPConfigID)
+		case *policy.IdentityProviderRemovedEvent:
+			wm.removeIDP(e.IDPConfigID)
+		case *policy.IdentityProviderCascadeRemovedEvent:
+			wm.removeIDP(e.IDPConfigID)
+		case *policy.IdentityProviderButtonsSetEvent:
+			wm.Buttons = e.Buttons
+		case *policy.IdentityProviderIconAddedEvent:
+			if wm.Icons == nil {
+				wm.Icons = make(map[string]string)
+			}
+			wm.Icons[e.IDPConfigID] = e.StoreKey
+		case *policy.IdentityProviderIconRemovedEvent:
+			delete(wm.Icons, e.IDPConfigID)
+		}
+	}
+	return wm.WriteModel.Reduce()
+}
+
+func (wm *LoginPolicyIDPButtonsWriteModel) removeIDP(idpConfigID string) {
+	wm.IDPConfigIDs = slices.DeleteFunc(wm.IDPConfigIDs, func(id string) bool {
+		return id == idpConfigID
+	})
+}
+
+// isLinked returns true if the identity provider is part of the login policy
+func (wm *LoginPolicyIDPButtonsWriteModel) isLinked(idpConfigID string) bool {
+	return slices.Contains(wm.IDPConfigIDs, idpConfigID)
+}
+
+// validateButtons normalizes the buttons and checks that every identity provider is linked to the policy only once
+func (wm *LoginPolicyIDPButtonsWriteModel) validateButtons(buttons []*domain.IDPButton) error {
+	seen := make(map[string]struct{}, len(buttons))
+	for _, button := range buttons {
+		button.Normalize()
+		if !button.IsValid() {
+			return zerrors.ThrowInvalidArgument(nil, "COMMAND-Ib4nv", "Errors.Policy.Login.IDPButton.Invalid")
+		}
+		if _, ok := seen[button.IDPID]; ok {
+			return zerrors.ThrowInvalidArgument(nil, "COMMAND-Ib5dp", "Errors.Policy.Login.IDPButton.Duplicate")
+		}
+		seen[button.IDPID] = struct{}{}
+		if !wm.isLinked(button.IDPID) {
+			return zerrors.ThrowPreconditionFailed(nil, "COMMAND-Ib6nl", "Errors.Policy.Login.IDPButton.IDPNotLinked")
+		}
+	}
+	return nil
+}
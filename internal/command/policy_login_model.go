@@ -11,24 +11,30 @@ import (
 type LoginPolicyWriteModel struct {
 	eventstore.WriteModel
 
-	AllowUserNamePassword      bool
-	AllowRegister              bool
-	AllowExternalIDP           bool
-	ForceMFA                   bool
-	ForceMFALocalOnly          bool
-	HidePasswordReset          bool
-	IgnoreUnknownUsernames     bool
-	AllowDomainDiscovery       bool
-	DisableLoginWithEmail      bool
-	DisableLoginWithPhone      bool
-	PasswordlessType           domain.PasswordlessType
-	DefaultRedirectURI         string
-	PasswordCheckLifetime      time.Duration
-	ExternalLoginCheckLifetime time.Duration
-	MFAInitSkipLifetime        time.Duration
-	SecondFactorCheckLifetime  time.Duration
-	MultiFactorCheckLifetime   time.Duration
-	State                      domain.PolicyState
+	AllowUserNamePassword       bool
+	AllowRegister               bool
+	AllowExternalIDP            bool
+	ForceMFA                    bool
+	ForceMFALocalOnly           bool
+	HidePasswordReset           bool
+	IgnoreUnknownUsernames      bool
+	AllowDomainDiscovery        bool
+	DisableLoginWithEmail       bool
+	DisableLoginWithPhone       bool
+	PasswordlessType            domain.PasswordlessType
+	DefaultRedirectURI          string
+	PasswordCheckLifetime       time.Duration
+	ExternalLoginCheckLifetime  time.Duration
+	MFAInitSkipLifetime         time.Duration
+	SecondFactorCheckLifetime   time.Duration
+	MultiFactorCheckLifetime    time.Duration
+	TrustedDeviceLifetime       time.Duration
+	SharedDevice                bool
+	SharedDeviceLifetime        time.Duration
+	SharedDeviceIdleTimeout     time.Duration
+	PasskeyPrompt               bool
+	PasskeyPromptSnoozeLifetime time.Duration
+	State                       domain.PolicyState
 }
 
 func (wm *LoginPolicyWriteModel) Reduce() error {
@@ -52,6 +58,12 @@ func (wm *LoginPolicyWriteModel) Reduce() error {
 			wm.MFAInitSkipLifetime = e.MFAInitSkipLifetime
 			wm.SecondFactorCheckLifetime = e.SecondFactorCheckLifetime
 			wm.MultiFactorCheckLifetime = e.MultiFactorCheckLifetime
+			wm.TrustedDeviceLifetime = e.TrustedDeviceLifetime
+			wm.SharedDevice = e.SharedDevice
+			wm.SharedDeviceLifetime = e.SharedDeviceLifetime
+			wm.SharedDeviceIdleTimeout = e.SharedDeviceIdleTimeout
+			wm.PasskeyPrompt = e.PasskeyPrompt
+			wm.PasskeyPromptSnoozeLifetime = e.PasskeyPromptSnoozeLifetime
 			wm.State = domain.PolicyStateActive
 		case *policy.LoginPolicyChangedEvent:
 			if e.AllowRegister != nil {
@@ -99,6 +111,24 @@ func (wm *LoginPolicyWriteModel) Reduce() error {
 			if e.MultiFactorCheckLifetime != nil {
 				wm.MultiFactorCheckLifetime = *e.MultiFactorCheckLifetime
 			}
+			if e.TrustedDeviceLifetime != nil {
+				wm.TrustedDeviceLifetime = *e.TrustedDeviceLifetime
+			}
+			if e.SharedDevice != nil {
+				wm.SharedDevice = *e.SharedDevice
+			}
+			if e.SharedDeviceLifetime != nil {
+				wm.SharedDeviceLifetime = *e.SharedDeviceLifetime
+			}
+			if e.SharedDeviceIdleTimeout != nil {
+				wm.SharedDeviceIdleTimeout = *e.SharedDeviceIdleTimeout
+			}
+			if e.PasskeyPrompt != nil {
+				wm.PasskeyPrompt = *e.PasskeyPrompt
+			}
+			if e.PasskeyPromptSnoozeLifetime != nil {
+				wm.PasskeyPromptSnoozeLifetime = *e.PasskeyPromptSnoozeLifetime
+			}
 			if e.DisableLoginWithEmail != nil {
 				wm.DisableLoginWithEmail = *e.DisableLoginWithEmail
 			}
@@ -78,6 +78,8 @@ func (wm *DomainPolicyUsernamesWriteModel) Reduce() error {
 			wm.VerifiedDomains = append(wm.VerifiedDomains, e.Domain)
 		case *org.DomainRemovedEvent:
 			wm.removeDomain(e.Domain)
+		case *org.DomainUnverifiedEvent:
+			wm.removeDomain(e.Domain)
 		case *org.DomainPrimarySetEvent:
 			wm.PrimaryDomain = e.Domain
 		case *user.HumanAddedEvent:
@@ -137,6 +139,7 @@ func (wm *DomainPolicyUsernamesWriteModel) Query() *eventstore.SearchQueryBuilde
 		EventTypes(
 			org.OrgDomainVerifiedEventType,
 			org.OrgDomainRemovedEventType,
+			org.OrgDomainUnverifiedEventType,
 			org.OrgDomainPrimarySetEventType,
 			user.HumanAddedType,
 			user.HumanRegisteredType,
@@ -0,0 +1,89 @@
+package command
+
+import (
+	"context"
+	"strings"
+
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/repository/instance"
+	"github.com/zitadel/zitadel/internal/repository/org"
+	"github.com/zitadel/zitadel/internal/telemetry/tracing"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+// PublishTermsVersion publishes a new version of the terms of service and privacy policy of the organization.
+// The links of the active privacy policy are recorded with the version.
+// Users of the organization have to accept the new version on their next login.
+func (c *Commands) PublishTermsVersion(ctx context.Context, orgID, version string) (_ *domain.ObjectDetails, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	if orgID == "" {
+		return nil, zerrors.ThrowInvalidArgument(nil, "COMMAND-Tv1ro", "Errors.ResourceOwnerMissing")
+	}
+	version = strings.TrimSpace(version)
+	if version == "" {
+		return nil, zerrors.ThrowInvalidArgument(nil, "COMMAND-Tv1vi", "Errors.Policy.TermsVersion.Invalid")
+	}
+	writeModel := NewOrgTermsVersionWriteModel(orgID)
+	if err = c.eventstore.FilterToQueryReducer(ctx, writeModel); err != nil {
+		return nil, err
+	}
+	if writeModel.Version == version {
+		return nil, zerrors.ThrowPreconditionFailed(nil, "COMMAND-Tv1pu", "Errors.Policy.TermsVersion.AlreadyPublished")
+	}
+	privacyPolicy, err := c.getOrgPrivacyPolicy(ctx, orgID)
+	if err != nil {
+		return nil, err
+	}
+	orgAgg := OrgAggregateFromWriteModel(&writeModel.WriteModel)
+	if err = c.pushAppendAndReduce(ctx, writeModel, org.NewTermsVersionPublishedEvent(ctx, orgAgg, version, privacyPolicy.TOSLink, privacyPolicy.PrivacyLink)); err != nil {
+		return nil, err
+	}
+	return writeModelToObjectDetails(&writeModel.WriteModel), nil
+}
+
+// PublishDefaultTermsVersion publishes a new version of the terms of service and privacy policy of the instance.
+// It applies to all organizations which didn't publish a version of their own.
+func (c *Commands) PublishDefaultTermsVersion(ctx context.Context, version string) (_ *domain.ObjectDetails, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	version = strings.TrimSpace(version)
+	if version == "" {
+		return nil, zerrors.ThrowInvalidArgument(nil, "COMMAND-Tv2vi", "Errors.Policy.TermsVersion.Invalid")
+	}
+	writeModel := NewInstanceTermsVersionWriteModel(ctx)
+	if err = c.eventstore.FilterToQueryReducer(ctx, writeModel); err != nil {
+		return nil, err
+	}
+	if writeModel.Version == version {
+		return nil, zerrors.ThrowPreconditionFailed(nil, "COMMAND-Tv2pu", "Errors.Policy.TermsVersion.AlreadyPublished")
+	}
+	privacyPolicy, err := c.getDefaultPrivacyPolicy(ctx)
+	if err != nil {
+		return nil, err
+	}
+	instanceAgg := instance.NewAggregate(writeModel.AggregateID)
+	if err = c.pushAppendAndReduce(ctx, writeModel, instance.NewTermsVersionPublishedEvent(ctx, &instanceAgg.Aggregate, version, privacyPolicy.TOSLink, privacyPolicy.PrivacyLink)); err != nil {
+		return nil, err
+	}
+	return writeModelToObjectDetails(&writeModel.WriteModel), nil
+}
+
+// activeTermsVersion returns the terms version the users of the organization have to accept.
+// The version of the organization takes precedence over the one of the instance.
+func (c *Commands) activeTermsVersion(ctx context.Context, orgID string) (*TermsVersionWriteModel, error) {
+	orgWriteModel := NewOrgTermsVersionWriteModel(orgID)
+	if err := c.eventstore.FilterToQueryReducer(ctx, orgWriteModel); err != nil {
+		return nil, err
+	}
+	if orgWriteModel.Version != "" {
+		return &orgWriteModel.TermsVersionWriteModel, nil
+	}
+	instanceWriteModel := NewInstanceTermsVersionWriteModel(ctx)
+	if err := c.eventstore.FilterToQueryReducer(ctx, instanceWriteModel); err != nil {
+		return nil, err
+	}
+	return &instanceWriteModel.TermsVersionWriteModel, nil
+}
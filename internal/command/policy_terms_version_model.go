@@ -0,0 +1,104 @@
+package command
+
+import (
+	"context"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/repository/instance"
+	"github.com/zitadel/zitadel/internal/repository/org"
+	"github.com/zitadel/zitadel/internal/repository/policy"
+)
+
+type TermsVersionWriteModel struct {
+	eventstore.WriteModel
+
+	Version     string
+	TOSLink     string
+	PrivacyLink string
+}
+
+func (wm *TermsVersionWriteModel) Reduce() error {
+	for _, event := range wm.Events {
+		if e, ok := event.(*policy.TermsVersionPublishedEvent); ok {
+			wm.Version = e.Version
+			wm.TOSLink = e.TOSLink
+			wm.PrivacyLink = e.PrivacyLink
+		}
+	}
+	return wm.WriteModel.Reduce()
+}
+
+type OrgTermsVersionWriteModel struct {
+	TermsVersionWriteModel
+}
+
+func NewOrgTermsVersionWriteModel(orgID string) *OrgTermsVersionWriteModel {
+	return &OrgTermsVersionWriteModel{
+		TermsVersionWriteModel{
+			WriteModel: eventstore.WriteModel{
+				AggregateID:   orgID,
+				ResourceOwner: orgID,
+			},
+		},
+	}
+}
+
+func (wm *OrgTermsVersionWriteModel) AppendEvents(events ...eventstore.Event) {
+	for _, event := range events {
+		if e, ok := event.(*org.TermsVersionPublishedEvent); ok {
+			wm.TermsVersionWriteModel.AppendEvents(&e.TermsVersionPublishedEvent)
+		}
+	}
+}
+
+func (wm *OrgTermsVersionWriteModel) Reduce() error {
+	return wm.TermsVersionWriteModel.Reduce()
+}
+
+func (wm *OrgTermsVersionWriteModel) Query() *eventstore.SearchQueryBuilder {
+	return eventstore.NewSearchQueryBuilder(eventstore.ColumnsEvent).
+		ResourceOwner(wm.ResourceOwner).
+		AddQuery().
+		AggregateTypes(org.AggregateType).
+		AggregateIDs(wm.TermsVersionWriteModel.AggregateID).
+		EventTypes(org.TermsVersionPublishedEventType).
+		Builder()
+}
+
+type InstanceTermsVersionWriteModel struct {
+	TermsVersionWriteModel
+}
+
+func NewInstanceTermsVersionWriteModel(ctx context.Context) *InstanceTermsVersionWriteModel {
+	return &InstanceTermsVersionWriteModel{
+		TermsVersionWriteModel{
+			WriteModel: eventstore.WriteModel{
+				AggregateID:   authz.GetInstance(ctx).InstanceID(),
+				ResourceOwner: authz.GetInstance(ctx).InstanceID(),
+			},
+		},
+	}
+}
+
+func (wm *InstanceTermsVersionWriteModel) AppendEvents(events ...eventstore.Event) {
+	for _, event := range events {
+		if e, ok := event.(*instance.TermsVersionPublishedEvent); ok {
+			wm.TermsVersionWriteModel.AppendEvents(&e.TermsVersionPublishedEvent)
+		}
+	}
+}
+
+func (wm *InstanceTermsVersionWriteModel) Reduce() error {
+	return wm.TermsVersionWriteModel.Reduce()
+}
+
+func (wm *InstanceTermsVersionWriteModel) Query() *eventstore.SearchQueryBuilder {
+	return eventstore.NewSearchQueryBuilder(eventstore.ColumnsEvent).
+		ResourceOwner(wm.ResourceOwner).
+		AddQuery().
+		AggregateTypes(instance.AggregateType).
+		AggregateIDs(wm.TermsVersionWriteModel.AggregateID).
+		EventTypes(instance.TermsVersionPublishedEventType).
+		Builder()
+}
@@ -0,0 +1,301 @@
+package command
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/repository/instance"
+	"github.com/zitadel/zitadel/internal/repository/org"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+func TestCommandSide_PublishTermsVersion(t *testing.T) {
+	type fields struct {
+		eventstore func(*testing.T) *eventstore.Eventstore
+	}
+	type args struct {
+		ctx     context.Context
+		orgID   string
+		version string
+	}
+	type res struct {
+		want *domain.ObjectDetails
+		err  func(error) bool
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		args   args
+		res    res
+	}{
+		{
+			name: "org id missing, invalid argument error",
+			fields: fields{
+				eventstore: expectEventstore(),
+			},
+			args: args{
+				ctx:     context.Background(),
+				version: "v1",
+			},
+			res: res{
+				err: zerrors.IsErrorInvalidArgument,
+			},
+		},
+		{
+			name: "version missing, invalid argument error",
+			fields: fields{
+				eventstore: expectEventstore(),
+			},
+			args: args{
+				ctx:     context.Background(),
+				orgID:   "org1",
+				version: " ",
+			},
+			res: res{
+				err: zerrors.IsErrorInvalidArgument,
+			},
+		},
+		{
+			name: "version already published, precondition error",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(
+							org.NewTermsVersionPublishedEvent(context.Background(),
+								&org.NewAggregate("org1").Aggregate,
+								"v1",
+								"TOSLink",
+								"PrivacyLink",
+							),
+						),
+					),
+				),
+			},
+			args: args{
+				ctx:     context.Background(),
+				orgID:   "org1",
+				version: "v1",
+			},
+			res: res{
+				err: zerrors.IsPreconditionFailed,
+			},
+		},
+		{
+			name: "publish with org privacy policy, ok",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(
+							org.NewTermsVersionPublishedEvent(context.Background(),
+								&org.NewAggregate("org1").Aggregate,
+								"v1",
+								"TOSLink",
+								"PrivacyLink",
+							),
+						),
+					),
+					expectFilter(
+						eventFromEventPusher(
+							org.NewPrivacyPolicyAddedEvent(context.Background(),
+								&org.NewAggregate("org1").Aggregate,
+								"TOSLink2",
+								"PrivacyLink2",
+								"HelpLink",
+								"support@example.com",
+								"DocsLink",
+								"CustomLink",
+								"CustomLinkText",
+							),
+						),
+					),
+					expectPush(
+						org.NewTermsVersionPublishedEvent(context.Background(),
+							&org.NewAggregate("org1").Aggregate,
+							"v2",
+							"TOSLink2",
+							"PrivacyLink2",
+						),
+					),
+				),
+			},
+			args: args{
+				ctx:     context.Background(),
+				orgID:   "org1",
+				version: "v2",
+			},
+			res: res{
+				want: &domain.ObjectDetails{
+					ResourceOwner: "org1",
+				},
+			},
+		},
+		{
+			name: "publish with default privacy policy, ok",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(),
+					expectFilter(),
+					expectFilter(
+						eventFromEventPusher(
+							instance.NewPrivacyPolicyAddedEvent(context.Background(),
+								&instance.NewAggregate("INSTANCE").Aggregate,
+								"TOSLink",
+								"PrivacyLink",
+								"HelpLink",
+								"support@example.com",
+								"DocsLink",
+								"CustomLink",
+								"CustomLinkText",
+							),
+						),
+					),
+					expectPush(
+						org.NewTermsVersionPublishedEvent(context.Background(),
+							&org.NewAggregate("org1").Aggregate,
+							"v1",
+							"TOSLink",
+							"PrivacyLink",
+						),
+					),
+				),
+			},
+			args: args{
+				ctx:     authz.WithInstanceID(context.Background(), "INSTANCE"),
+				orgID:   "org1",
+				version: "v1",
+			},
+			res: res{
+				want: &domain.ObjectDetails{
+					ResourceOwner: "org1",
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := &Commands{
+				eventstore: tt.fields.eventstore(t),
+			}
+			got, err := r.PublishTermsVersion(tt.args.ctx, tt.args.orgID, tt.args.version)
+			if tt.res.err == nil {
+				assert.NoError(t, err)
+			}
+			if tt.res.err != nil && !tt.res.err(err) {
+				t.Errorf("got wrong err: %v ", err)
+			}
+			if tt.res.err == nil {
+				assert.Equal(t, tt.res.want, got)
+			}
+		})
+	}
+}
+
+func TestCommandSide_PublishDefaultTermsVersion(t *testing.T) {
+	type fields struct {
+		eventstore func(*testing.T) *eventstore.Eventstore
+	}
+	type args struct {
+		ctx     context.Context
+		version string
+	}
+	type res struct {
+		want *domain.ObjectDetails
+		err  func(error) bool
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		args   args
+		res    res
+	}{
+		{
+			name: "version missing, invalid argument error",
+			fields: fields{
+				eventstore: expectEventstore(),
+			},
+			args: args{
+				ctx: authz.WithInstanceID(context.Background(), "INSTANCE"),
+			},
+			res: res{
+				err: zerrors.IsErrorInvalidArgument,
+			},
+		},
+		{
+			name: "privacy policy not existing, invalid argument error",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(),
+					expectFilter(),
+				),
+			},
+			args: args{
+				ctx:     authz.WithInstanceID(context.Background(), "INSTANCE"),
+				version: "v1",
+			},
+			res: res{
+				err: zerrors.IsErrorInvalidArgument,
+			},
+		},
+		{
+			name: "publish, ok",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(),
+					expectFilter(
+						eventFromEventPusher(
+							instance.NewPrivacyPolicyAddedEvent(context.Background(),
+								&instance.NewAggregate("INSTANCE").Aggregate,
+								"TOSLink",
+								"PrivacyLink",
+								"HelpLink",
+								"support@example.com",
+								"DocsLink",
+								"CustomLink",
+								"CustomLinkText",
+							),
+						),
+					),
+					expectPush(
+						instance.NewTermsVersionPublishedEvent(context.Background(),
+							&instance.NewAggregate("INSTANCE").Aggregate,
+							"v1",
+							"TOSLink",
+							"PrivacyLink",
+						),
+					),
+				),
+			},
+			args: args{
+				ctx:     authz.WithInstanceID(context.Background(), "INSTANCE"),
+				version: "v1",
+			},
+			res: res{
+				want: &domain.ObjectDetails{
+					ResourceOwner: "INSTANCE",
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := &Commands{
+				eventstore: tt.fields.eventstore(t),
+			}
+			got, err := r.PublishDefaultTermsVersion(tt.args.ctx, tt.args.version)
+			if tt.res.err == nil {
+				assert.NoError(t, err)
+			}
+			if tt.res.err != nil && !tt.res.err(err) {
+				t.Errorf("got wrong err: %v ", err)
+			}
+			if tt.res.err == nil {
+				assert.Equal(t, tt.res.want, got)
+			}
+		})
+	}
+}
@@ -233,6 +233,9 @@ func (c *Commands) ChangeProject(ctx context.Context, projectChange *domain.Proj
 	if existingProject.State == domain.ProjectStateUnspecified || existingProject.State == domain.ProjectStateRemoved {
 		return nil, zerrors.ThrowNotFound(nil, "COMMAND-3M9sd", "Errors.Project.NotFound")
 	}
+	if err = checkExpectedSequence(&existingProject.WriteModel, projectChange.ExpectedSequence); err != nil {
+		return nil, err
+	}
 
 	projectAgg := ProjectAggregateFromWriteModel(&existingProject.WriteModel)
 	changedEvent, hasChanged, err := existingProject.NewChangedEvent(
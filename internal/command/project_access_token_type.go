@@ -0,0 +1,57 @@
+package command
+
+import (
+	"context"
+
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/repository/project"
+	"github.com/zitadel/zitadel/internal/telemetry/tracing"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+// SetProjectAccessTokenType sets the access token type of the project
+// and changes it on all existing OIDC applications of the project, which use another type.
+func (c *Commands) SetProjectAccessTokenType(ctx context.Context, projectID string, accessTokenType domain.OIDCTokenType, resourceOwner string) (_ *domain.ObjectDetails, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	if projectID == "" {
+		return nil, zerrors.ThrowInvalidArgument(nil, "COMMAND-Jq3md", "Errors.IDMissing")
+	}
+	if accessTokenType != domain.OIDCTokenTypeBearer && accessTokenType != domain.OIDCTokenTypeJWT {
+		return nil, zerrors.ThrowInvalidArgument(nil, "COMMAND-Wm2xa", "Errors.Project.Invalid")
+	}
+	writeModel := NewProjectAccessTokenTypeWriteModel(projectID, resourceOwner)
+	if err = c.eventstore.FilterToQueryReducer(ctx, writeModel); err != nil {
+		return nil, err
+	}
+	if writeModel.State == domain.ProjectStateUnspecified || writeModel.State == domain.ProjectStateRemoved {
+		return nil, zerrors.ThrowNotFound(nil, "COMMAND-Pz8ve", "Errors.Project.NotFound")
+	}
+
+	projectAgg := ProjectAggregateFromWriteModel(&writeModel.WriteModel)
+	events := make([]eventstore.Command, 0, len(writeModel.Apps)+1)
+	if writeModel.AccessTokenType != accessTokenType {
+		events = append(events, project.NewProjectAccessTokenTypeSetEvent(ctx, projectAgg, accessTokenType))
+	}
+	for _, app := range writeModel.Apps {
+		if app.AccessTokenType == accessTokenType {
+			continue
+		}
+		changedEvent, err := project.NewOIDCConfigChangedEvent(ctx, projectAgg, app.AppID, []project.OIDCConfigChanges{
+			project.ChangeAccessTokenType(accessTokenType),
+		})
+		if err != nil {
+			return nil, err
+		}
+		events = append(events, changedEvent)
+	}
+	if len(events) == 0 {
+		return nil, zerrors.ThrowPreconditionFailed(nil, "COMMAND-Hs7ka", "Errors.NoChangesFound")
+	}
+	if err = c.pushAppendAndReduce(ctx, writeModel, events...); err != nil {
+		return nil, err
+	}
+	return writeModelToObjectDetails(&writeModel.WriteModel), nil
+}
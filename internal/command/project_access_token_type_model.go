@@ -0,0 +1,79 @@
+package command
+
+import (
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/repository/project"
+)
+
+// ProjectAccessTokenTypeWriteModel contains the access token type of the project
+// and the access token types of all its OIDC applications.
+type ProjectAccessTokenTypeWriteModel struct {
+	eventstore.WriteModel
+
+	State           domain.ProjectState
+	AccessTokenType domain.OIDCTokenType
+	Apps            []*AppIDToAccessTokenType
+}
+
+type AppIDToAccessTokenType struct {
+	AppID           string
+	AccessTokenType domain.OIDCTokenType
+}
+
+func NewProjectAccessTokenTypeWriteModel(projectID, resourceOwner string) *ProjectAccessTokenTypeWriteModel {
+	return &ProjectAccessTokenTypeWriteModel{
+		WriteModel: eventstore.WriteModel{
+			AggregateID:   projectID,
+			ResourceOwner: resourceOwner,
+		},
+	}
+}
+
+func (wm *ProjectAccessTokenTypeWriteModel) Reduce() error {
+	for _, event := range wm.Events {
+		switch e := event.(type) {
+		case *project.ProjectAddedEvent:
+			wm.State = domain.ProjectStateActive
+		case *project.ProjectRemovedEvent:
+			wm.State = domain.ProjectStateRemoved
+		case *project.ProjectAccessTokenTypeSetEvent:
+			wm.AccessTokenType = e.AccessTokenType
+		case *project.OIDCConfigAddedEvent:
+			wm.Apps = append(wm.Apps, &AppIDToAccessTokenType{AppID: e.AppID, AccessTokenType: e.AccessTokenType})
+		case *project.OIDCConfigChangedEvent:
+			if e.AccessTokenType == nil {
+				continue
+			}
+			for _, app := range wm.Apps {
+				if app.AppID == e.AppID {
+					app.AccessTokenType = *e.AccessTokenType
+				}
+			}
+		case *project.ApplicationRemovedEvent:
+			for i, app := range wm.Apps {
+				if app.AppID == e.AppID {
+					wm.Apps = append(wm.Apps[:i], wm.Apps[i+1:]...)
+					break
+				}
+			}
+		}
+	}
+	return wm.WriteModel.Reduce()
+}
+
+func (wm *ProjectAccessTokenTypeWriteModel) Query() *eventstore.SearchQueryBuilder {
+	return eventstore.NewSearchQueryBuilder(eventstore.ColumnsEvent).
+		ResourceOwner(wm.ResourceOwner).
+		AddQuery().
+		AggregateTypes(project.AggregateType).
+		AggregateIDs(wm.AggregateID).
+		EventTypes(
+			project.ProjectAddedType,
+			project.ProjectRemovedType,
+			project.ProjectAccessTokenTypeSetType,
+			project.OIDCConfigAddedType,
+			project.OIDCConfigChangedType,
+			project.ApplicationRemovedType).
+		Builder()
+}
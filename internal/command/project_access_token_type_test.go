@@ -0,0 +1,243 @@
+package command
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/repository/project"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+func TestCommandSide_SetProjectAccessTokenType(t *testing.T) {
+	type fields struct {
+		eventstore *eventstore.Eventstore
+	}
+	type args struct {
+		ctx             context.Context
+		projectID       string
+		accessTokenType domain.OIDCTokenType
+		resourceOwner   string
+	}
+	type res struct {
+		want *domain.ObjectDetails
+		err  func(error) bool
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		args   args
+		res    res
+	}{
+		{
+			name: "missing project id, invalid argument error",
+			fields: fields{
+				eventstore: eventstoreExpect(
+					t,
+				),
+			},
+			args: args{
+				ctx:             context.Background(),
+				projectID:       "",
+				accessTokenType: domain.OIDCTokenTypeJWT,
+				resourceOwner:   "org1",
+			},
+			res: res{
+				err: zerrors.IsErrorInvalidArgument,
+			},
+		},
+		{
+			name: "invalid access token type, invalid argument error",
+			fields: fields{
+				eventstore: eventstoreExpect(
+					t,
+				),
+			},
+			args: args{
+				ctx:             context.Background(),
+				projectID:       "project1",
+				accessTokenType: domain.OIDCTokenType(5),
+				resourceOwner:   "org1",
+			},
+			res: res{
+				err: zerrors.IsErrorInvalidArgument,
+			},
+		},
+		{
+			name: "project not existing, not found error",
+			fields: fields{
+				eventstore: eventstoreExpect(
+					t,
+					expectFilter(),
+				),
+			},
+			args: args{
+				ctx:             context.Background(),
+				projectID:       "project1",
+				accessTokenType: domain.OIDCTokenTypeJWT,
+				resourceOwner:   "org1",
+			},
+			res: res{
+				err: zerrors.IsNotFound,
+			},
+		},
+		{
+			name: "no changes, precondition error",
+			fields: fields{
+				eventstore: eventstoreExpect(
+					t,
+					expectFilter(
+						eventFromEventPusher(project.NewProjectAddedEvent(context.Background(),
+							&project.NewAggregate("project1", "org1").Aggregate,
+							"project", true, true, true,
+							domain.PrivateLabelingSettingUnspecified,
+						)),
+						eventFromEventPusher(oidcConfigAddedEventWithAccessTokenType("app1", domain.OIDCTokenTypeBearer)),
+					),
+				),
+			},
+			args: args{
+				ctx:             context.Background(),
+				projectID:       "project1",
+				accessTokenType: domain.OIDCTokenTypeBearer,
+				resourceOwner:   "org1",
+			},
+			res: res{
+				err: zerrors.IsPreconditionFailed,
+			},
+		},
+		{
+			name: "set access token type, ok",
+			fields: fields{
+				eventstore: eventstoreExpect(
+					t,
+					expectFilter(
+						eventFromEventPusher(project.NewProjectAddedEvent(context.Background(),
+							&project.NewAggregate("project1", "org1").Aggregate,
+							"project", true, true, true,
+							domain.PrivateLabelingSettingUnspecified,
+						)),
+						eventFromEventPusher(oidcConfigAddedEventWithAccessTokenType("app1", domain.OIDCTokenTypeBearer)),
+						eventFromEventPusher(oidcConfigAddedEventWithAccessTokenType("app2", domain.OIDCTokenTypeJWT)),
+						eventFromEventPusher(oidcConfigAddedEventWithAccessTokenType("app3", domain.OIDCTokenTypeBearer)),
+						eventFromEventPusher(project.NewApplicationRemovedEvent(context.Background(),
+							&project.NewAggregate("project1", "org1").Aggregate,
+							"app3",
+							"app",
+							"",
+						)),
+					),
+					expectPush(
+						project.NewProjectAccessTokenTypeSetEvent(context.Background(),
+							&project.NewAggregate("project1", "org1").Aggregate,
+							domain.OIDCTokenTypeJWT,
+						),
+						newOIDCConfigAccessTokenTypeChangedEvent(context.Background(), "app1", "project1", "org1", domain.OIDCTokenTypeJWT),
+					),
+				),
+			},
+			args: args{
+				ctx:             context.Background(),
+				projectID:       "project1",
+				accessTokenType: domain.OIDCTokenTypeJWT,
+				resourceOwner:   "org1",
+			},
+			res: res{
+				want: &domain.ObjectDetails{
+					ResourceOwner: "org1",
+				},
+			},
+		},
+		{
+			name: "project already set, apps changed, ok",
+			fields: fields{
+				eventstore: eventstoreExpect(
+					t,
+					expectFilter(
+						eventFromEventPusher(project.NewProjectAddedEvent(context.Background(),
+							&project.NewAggregate("project1", "org1").Aggregate,
+							"project", true, true, true,
+							domain.PrivateLabelingSettingUnspecified,
+						)),
+						eventFromEventPusher(project.NewProjectAccessTokenTypeSetEvent(context.Background(),
+							&project.NewAggregate("project1", "org1").Aggregate,
+							domain.OIDCTokenTypeJWT,
+						)),
+						eventFromEventPusher(oidcConfigAddedEventWithAccessTokenType("app1", domain.OIDCTokenTypeBearer)),
+					),
+					expectPush(
+						newOIDCConfigAccessTokenTypeChangedEvent(context.Background(), "app1", "project1", "org1", domain.OIDCTokenTypeJWT),
+					),
+				),
+			},
+			args: args{
+				ctx:             context.Background(),
+				projectID:       "project1",
+				accessTokenType: domain.OIDCTokenTypeJWT,
+				resourceOwner:   "org1",
+			},
+			res: res{
+				want: &domain.ObjectDetails{
+					ResourceOwner: "org1",
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := &Commands{
+				eventstore: tt.fields.eventstore,
+			}
+			got, err := r.SetProjectAccessTokenType(tt.args.ctx, tt.args.projectID, tt.args.accessTokenType, tt.args.resourceOwner)
+			if tt.res.err == nil {
+				assert.NoError(t, err)
+			}
+			if tt.res.err != nil && !tt.res.err(err) {
+				t.Errorf("got wrong err: %v ", err)
+			}
+			if tt.res.err == nil {
+				assert.Equal(t, tt.res.want, got)
+			}
+		})
+	}
+}
+
+func oidcConfigAddedEventWithAccessTokenType(appID string, accessTokenType domain.OIDCTokenType) *project.OIDCConfigAddedEvent {
+	return project.NewOIDCConfigAddedEvent(context.Background(),
+		&project.NewAggregate("project1", "org1").Aggregate,
+		domain.OIDCVersionV1,
+		appID,
+		"client-"+appID,
+		"",
+		[]string{"https://test.ch"},
+		[]domain.OIDCResponseType{domain.OIDCResponseTypeCode},
+		[]domain.OIDCGrantType{domain.OIDCGrantTypeAuthorizationCode},
+		domain.OIDCApplicationTypeWeb,
+		domain.OIDCAuthMethodTypeNone,
+		[]string{"https://test.ch/logout"},
+		false,
+		accessTokenType,
+		false,
+		false,
+		false,
+		0,
+		nil,
+		false,
+		"",
+		"",
+	)
+}
+
+func newOIDCConfigAccessTokenTypeChangedEvent(ctx context.Context, appID, projectID, resourceOwner string, accessTokenType domain.OIDCTokenType) *project.OIDCConfigChangedEvent {
+	event, _ := project.NewOIDCConfigChangedEvent(ctx,
+		&project.NewAggregate(projectID, resourceOwner).Aggregate,
+		appID,
+		[]project.OIDCConfigChanges{
+			project.ChangeAccessTokenType(accessTokenType),
+		},
+	)
+	return event
+}
@@ -2,7 +2,10 @@ package command
 
 import (
 	"context"
+	"net/url"
+	"slices"
 
+	http_util "github.com/zitadel/zitadel/internal/api/http"
 	"github.com/zitadel/zitadel/internal/domain"
 	"github.com/zitadel/zitadel/internal/repository/project"
 	"github.com/zitadel/zitadel/internal/telemetry/tracing"
@@ -44,6 +47,142 @@ func (c *Commands) ChangeApplication(ctx context.Context, projectID string, appC
 	return writeModelToObjectDetails(&existingApp.WriteModel), nil
 }
 
+// SetApplicationClaimsMapping replaces the claims mapping of the application,
+// which customizes the claims of its ID tokens and introspection responses.
+// An empty mapping removes the customization.
+func (c *Commands) SetApplicationClaimsMapping(ctx context.Context, projectID, appID string, claimsMapping *domain.ClaimsMapping, resourceOwner string) (*domain.ObjectDetails, error) {
+	if projectID == "" || appID == "" {
+		return nil, zerrors.ThrowInvalidArgument(nil, "COMMAND-Xv4nd", "Errors.IDMissing")
+	}
+	if !claimsMapping.IsValid() {
+		return nil, zerrors.ThrowInvalidArgument(nil, "COMMAND-Rk2pa", "Errors.Project.App.ClaimsMappingInvalid")
+	}
+
+	existingApp, err := c.getApplicationWriteModel(ctx, projectID, appID, resourceOwner)
+	if err != nil {
+		return nil, err
+	}
+	if !existingApp.State.Exists() {
+		return nil, zerrors.ThrowNotFound(nil, "COMMAND-Fn2qe", "Errors.Project.App.NotExisting")
+	}
+	if existingApp.ClaimsMapping.Equal(claimsMapping) {
+		return nil, zerrors.ThrowPreconditionFailed(nil, "COMMAND-Uw8cs", "Errors.NoChangesFound")
+	}
+	if claimsMapping.IsZero() {
+		claimsMapping = nil
+	}
+	projectAgg := ProjectAggregateFromWriteModel(&existingApp.WriteModel)
+	if err = c.pushAppendAndReduce(ctx, existingApp, project.NewApplicationClaimsMappingSetEvent(ctx, projectAgg, appID, claimsMapping)); err != nil {
+		return nil, err
+	}
+	return writeModelToObjectDetails(&existingApp.WriteModel), nil
+}
+
+// SetApplicationLogoutConfig replaces the endpoints the relying party is notified on,
+// when the session of a user is terminated.
+// The back-channel endpoint is called by ZITADEL with a logout token,
+// the front-channel endpoint is loaded by the browser of the user.
+// Empty endpoints disable the respective notification.
+func (c *Commands) SetApplicationLogoutConfig(ctx context.Context, projectID, appID, backChannelLogoutURI, frontChannelLogoutURI, resourceOwner string) (*domain.ObjectDetails, error) {
+	if projectID == "" || appID == "" {
+		return nil, zerrors.ThrowInvalidArgument(nil, "COMMAND-Lc2nw", "Errors.IDMissing")
+	}
+	if !isValidLogoutURI(backChannelLogoutURI) || !isValidLogoutURI(frontChannelLogoutURI) {
+		return nil, zerrors.ThrowInvalidArgument(nil, "COMMAND-Lc8qd", "Errors.Project.App.LogoutURIInvalid")
+	}
+
+	existingApp, err := c.getApplicationWriteModel(ctx, projectID, appID, resourceOwner)
+	if err != nil {
+		return nil, err
+	}
+	if !existingApp.State.Exists() {
+		return nil, zerrors.ThrowNotFound(nil, "COMMAND-Lc4vs", "Errors.Project.App.NotExisting")
+	}
+	if existingApp.BackChannelLogoutURI == backChannelLogoutURI && existingApp.FrontChannelLogoutURI == frontChannelLogoutURI {
+		return nil, zerrors.ThrowPreconditionFailed(nil, "COMMAND-Lc6ke", "Errors.NoChangesFound")
+	}
+	projectAgg := ProjectAggregateFromWriteModel(&existingApp.WriteModel)
+	if err = c.pushAppendAndReduce(ctx, existingApp, project.NewApplicationLogoutConfigSetEvent(ctx, projectAgg, appID, backChannelLogoutURI, frontChannelLogoutURI)); err != nil {
+		return nil, err
+	}
+	return writeModelToObjectDetails(&existingApp.WriteModel), nil
+}
+
+// SetApplicationAuthRequirements replaces the conditions the authentication of a user has to fulfil
+// before an authorization request of the application is completed.
+// Empty requirements remove them.
+func (c *Commands) SetApplicationAuthRequirements(ctx context.Context, projectID, appID string, requirements *domain.AppAuthRequirements, resourceOwner string) (*domain.ObjectDetails, error) {
+	if projectID == "" || appID == "" {
+		return nil, zerrors.ThrowInvalidArgument(nil, "COMMAND-Ar2nd", "Errors.IDMissing")
+	}
+	if !requirements.IsValid() {
+		return nil, zerrors.ThrowInvalidArgument(nil, "COMMAND-Ar4pq", "Errors.Project.App.AuthRequirementsInvalid")
+	}
+
+	existingApp, err := c.getApplicationWriteModel(ctx, projectID, appID, resourceOwner)
+	if err != nil {
+		return nil, err
+	}
+	if !existingApp.State.Exists() {
+		return nil, zerrors.ThrowNotFound(nil, "COMMAND-Ar6ws", "Errors.Project.App.NotExisting")
+	}
+	if existingApp.AuthRequirements.Equal(requirements) {
+		return nil, zerrors.ThrowPreconditionFailed(nil, "COMMAND-Ar8ke", "Errors.NoChangesFound")
+	}
+	if requirements.IsZero() {
+		requirements = nil
+	}
+	projectAgg := ProjectAggregateFromWriteModel(&existingApp.WriteModel)
+	if err = c.pushAppendAndReduce(ctx, existingApp, project.NewApplicationAuthRequirementsSetEvent(ctx, projectAgg, appID, requirements)); err != nil {
+		return nil, err
+	}
+	return writeModelToObjectDetails(&existingApp.WriteModel), nil
+}
+
+// SetApplicationFrameAncestors replaces the origins which are allowed to embed
+// the login of the application in a frame or to open it as popup and receive the
+// result by postMessage (response_mode=web_message).
+// An empty list prevents the embedding.
+func (c *Commands) SetApplicationFrameAncestors(ctx context.Context, projectID, appID string, frameAncestors []string, resourceOwner string) (*domain.ObjectDetails, error) {
+	if projectID == "" || appID == "" {
+		return nil, zerrors.ThrowInvalidArgument(nil, "COMMAND-Fa2md", "Errors.IDMissing")
+	}
+	frameAncestors = trimStringSliceWhiteSpaces(frameAncestors)
+	for _, origin := range frameAncestors {
+		if !http_util.IsOrigin(origin) {
+			return nil, zerrors.ThrowInvalidArgument(nil, "COMMAND-Fa5nq", "Errors.Project.App.FrameAncestorInvalid")
+		}
+	}
+
+	existingApp, err := c.getApplicationWriteModel(ctx, projectID, appID, resourceOwner)
+	if err != nil {
+		return nil, err
+	}
+	if !existingApp.State.Exists() {
+		return nil, zerrors.ThrowNotFound(nil, "COMMAND-Fa7ws", "Errors.Project.App.NotExisting")
+	}
+	if slices.Equal(existingApp.FrameAncestors, frameAncestors) {
+		return nil, zerrors.ThrowPreconditionFailed(nil, "COMMAND-Fa9ke", "Errors.NoChangesFound")
+	}
+	projectAgg := ProjectAggregateFromWriteModel(&existingApp.WriteModel)
+	if err = c.pushAppendAndReduce(ctx, existingApp, project.NewApplicationFrameAncestorsSetEvent(ctx, projectAgg, appID, frameAncestors)); err != nil {
+		return nil, err
+	}
+	return writeModelToObjectDetails(&existingApp.WriteModel), nil
+}
+
+// isValidLogoutURI returns true if the uri is empty or an absolute http(s) url
+func isValidLogoutURI(uri string) bool {
+	if uri == "" {
+		return true
+	}
+	parsed, err := url.Parse(uri)
+	if err != nil {
+		return false
+	}
+	return (parsed.Scheme == "https" || parsed.Scheme == "http") && parsed.Host != "" && parsed.Fragment == ""
+}
+
 func (c *Commands) DeactivateApplication(ctx context.Context, projectID, appID, resourceOwner string) (*domain.ObjectDetails, error) {
 	if projectID == "" || appID == "" {
 		return nil, zerrors.ThrowInvalidArgument(nil, "COMMAND-88fi0", "Errors.IDMissing")
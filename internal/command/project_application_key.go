@@ -2,7 +2,9 @@ package command
 
 import (
 	"context"
+	"time"
 
+	"github.com/zitadel/zitadel/internal/crypto"
 	"github.com/zitadel/zitadel/internal/domain"
 	"github.com/zitadel/zitadel/internal/repository/project"
 	"github.com/zitadel/zitadel/internal/telemetry/tracing"
@@ -67,13 +69,18 @@ func (c *Commands) addApplicationKey(ctx context.Context, key *domain.Applicatio
 		return nil, err
 	}
 
-	if len(key.PublicKey) == 0 {
+	// If a key is supplied, it should be a valid public key
+	if len(key.PublicKey) > 0 {
+		if _, err := crypto.BytesToPublicKey(key.PublicKey); err != nil {
+			return nil, zerrors.ThrowInvalidArgument(nil, "COMMAND-Ek3ma", "Errors.Project.App.Key.Invalid")
+		}
+	} else {
 		err = domain.SetNewAuthNKeyPair(key, c.applicationKeySize)
 		if err != nil {
 			return nil, err
 		}
-		key.ClientID = keyWriteModel.ClientID
 	}
+	key.ClientID = keyWriteModel.ClientID
 
 	pushedEvents, err := c.eventstore.Push(ctx,
 		project.NewApplicationKeyAddedEvent(
@@ -100,6 +107,49 @@ func (c *Commands) addApplicationKey(ctx context.Context, key *domain.Applicatio
 	return result, nil
 }
 
+// ChangeApplicationKeyExpiration sets a new expiration date of the key.
+// Client assertions signed by the key are no longer accepted after that date.
+func (c *Commands) ChangeApplicationKeyExpiration(ctx context.Context, projectID, applicationID, keyID string, expirationDate time.Time, resourceOwner string) (*domain.ObjectDetails, error) {
+	expirationDate, err := domain.ValidateExpirationDate(expirationDate)
+	if err != nil {
+		return nil, err
+	}
+	return c.setApplicationKeyExpiration(ctx, projectID, applicationID, keyID, expirationDate, resourceOwner)
+}
+
+// RevokeApplicationKey expires the key immediately.
+// In contrast to [Commands.RemoveApplicationKey] the key is kept and can still be listed.
+func (c *Commands) RevokeApplicationKey(ctx context.Context, projectID, applicationID, keyID, resourceOwner string) (*domain.ObjectDetails, error) {
+	return c.setApplicationKeyExpiration(ctx, projectID, applicationID, keyID, time.Now(), resourceOwner)
+}
+
+func (c *Commands) setApplicationKeyExpiration(ctx context.Context, projectID, applicationID, keyID string, expirationDate time.Time, resourceOwner string) (*domain.ObjectDetails, error) {
+	if projectID == "" || keyID == "" {
+		return nil, zerrors.ThrowInvalidArgument(nil, "COMMAND-Rn4sd", "Errors.IDMissing")
+	}
+	keyWriteModel, err := c.applicationKeyWriteModelByID(ctx, projectID, applicationID, keyID, resourceOwner)
+	if err != nil {
+		return nil, err
+	}
+	if !keyWriteModel.State.Exists() {
+		return nil, zerrors.ThrowNotFound(nil, "COMMAND-Pd9wq", "Errors.Project.App.Key.NotFound")
+	}
+	if keyWriteModel.ExpirationDate.Before(time.Now()) {
+		return nil, zerrors.ThrowPreconditionFailed(nil, "COMMAND-Hs3lf", "Errors.Project.App.Key.Expired")
+	}
+	err = c.pushAppendAndReduce(ctx, keyWriteModel, project.NewApplicationKeyExpirationChangedEvent(
+		ctx,
+		ProjectAggregateFromWriteModel(&keyWriteModel.WriteModel),
+		applicationID,
+		keyID,
+		expirationDate,
+	))
+	if err != nil {
+		return nil, err
+	}
+	return writeModelToObjectDetails(&keyWriteModel.WriteModel), nil
+}
+
 func (c *Commands) RemoveApplicationKey(ctx context.Context, projectID, applicationID, keyID, resourceOwner string) (*domain.ObjectDetails, error) {
 	keyWriteModel := NewApplicationKeyWriteModel(projectID, applicationID, keyID, resourceOwner)
 	err := c.eventstore.FilterToQueryReducer(ctx, keyWriteModel)
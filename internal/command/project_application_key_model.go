@@ -65,6 +65,11 @@ func (wm *ApplicationKeyWriteModel) AppendEvents(events ...eventstore.Event) {
 				continue
 			}
 			wm.WriteModel.AppendEvents(e)
+		case *project.ApplicationKeyExpirationChangedEvent:
+			if e.AppID != wm.AppID || e.KeyID != wm.KeyID {
+				continue
+			}
+			wm.WriteModel.AppendEvents(e)
 		case *project.ApplicationKeyRemovedEvent:
 			if e.KeyID != wm.KeyID {
 				continue
@@ -94,6 +99,8 @@ func (wm *ApplicationKeyWriteModel) Reduce() error {
 			wm.ExpirationDate = e.ExpirationDate
 			wm.KeyType = e.KeyType
 			wm.State = domain.AppStateActive
+		case *project.ApplicationKeyExpirationChangedEvent:
+			wm.ExpirationDate = e.ExpirationDate
 		case *project.ApplicationKeyRemovedEvent:
 			wm.State = domain.AppStateRemoved
 		case *project.ProjectRemovedEvent:
@@ -138,6 +145,7 @@ func (wm *ApplicationKeyWriteModel) Query() *eventstore.SearchQueryBuilder {
 			project.APIConfigAddedType,
 			project.APIConfigChangedType,
 			project.ApplicationKeyAddedEventType,
+			project.ApplicationKeyExpirationChangedEventType,
 			project.ApplicationKeyRemovedEventType,
 			project.ProjectRemovedType).
 		Builder()
@@ -3,6 +3,7 @@ package command
 import (
 	"context"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 
@@ -27,7 +28,7 @@ func TestCommandSide_AddAPIApplicationKey(t *testing.T) {
 		resourceOwner string
 	}
 	type res struct {
-		want *domain.APIApp
+		want *domain.ApplicationKey
 		err  func(error) bool
 	}
 	tests := []struct {
@@ -179,6 +180,114 @@ func TestCommandSide_AddAPIApplicationKey(t *testing.T) {
 				err: zerrors.IsPreconditionFailed,
 			},
 		},
+		{
+			name: "invalid public key, invalid argument error",
+			fields: fields{
+				eventstore: eventstoreExpect(
+					t,
+					expectFilter(
+						eventFromEventPusher(
+							project.NewApplicationAddedEvent(context.Background(),
+								&project.NewAggregate("project1", "org1").Aggregate,
+								"app1",
+								"app",
+							),
+						),
+					),
+					expectFilter(
+						eventFromEventPusher(
+							project.NewAPIConfigAddedEvent(context.Background(),
+								&project.NewAggregate("project1", "org1").Aggregate,
+								"app1",
+								"client1@project",
+								"",
+								domain.APIAuthMethodTypePrivateKeyJWT),
+						),
+					),
+				),
+				idGenerator: id_mock.NewIDGeneratorExpectIDs(t, "key1"),
+			},
+			args: args{
+				ctx: context.Background(),
+				key: &domain.ApplicationKey{
+					ObjectRoot: models.ObjectRoot{
+						AggregateID: "project1",
+					},
+					ApplicationID: "app1",
+					Type:          domain.AuthNKeyTypeJSON,
+					PublicKey:     []byte("incorrect"),
+				},
+				resourceOwner: "org1",
+			},
+			res: res{
+				err: zerrors.IsErrorInvalidArgument,
+			},
+		},
+		{
+			name: "add public key, ok",
+			fields: fields{
+				eventstore: eventstoreExpect(
+					t,
+					expectFilter(
+						eventFromEventPusher(
+							project.NewApplicationAddedEvent(context.Background(),
+								&project.NewAggregate("project1", "org1").Aggregate,
+								"app1",
+								"app",
+							),
+						),
+					),
+					expectFilter(
+						eventFromEventPusher(
+							project.NewAPIConfigAddedEvent(context.Background(),
+								&project.NewAggregate("project1", "org1").Aggregate,
+								"app1",
+								"client1@project",
+								"",
+								domain.APIAuthMethodTypePrivateKeyJWT),
+						),
+					),
+					expectPush(
+						project.NewApplicationKeyAddedEvent(context.Background(),
+							&project.NewAggregate("project1", "org1").Aggregate,
+							"app1",
+							"client1@project",
+							"key1",
+							domain.AuthNKeyTypeJSON,
+							time.Date(9999, time.December, 31, 23, 59, 59, 0, time.UTC),
+							[]byte(fakePubkey),
+						),
+					),
+				),
+				idGenerator: id_mock.NewIDGeneratorExpectIDs(t, "key1"),
+			},
+			args: args{
+				ctx: context.Background(),
+				key: &domain.ApplicationKey{
+					ObjectRoot: models.ObjectRoot{
+						AggregateID: "project1",
+					},
+					ApplicationID:  "app1",
+					Type:           domain.AuthNKeyTypeJSON,
+					ExpirationDate: time.Date(9999, time.December, 31, 23, 59, 59, 0, time.UTC),
+					PublicKey:      []byte(fakePubkey),
+				},
+				resourceOwner: "org1",
+			},
+			res: res{
+				want: &domain.ApplicationKey{
+					ObjectRoot: models.ObjectRoot{
+						AggregateID:   "project1",
+						ResourceOwner: "org1",
+					},
+					ApplicationID:  "app1",
+					ClientID:       "client1@project",
+					KeyID:          "key1",
+					Type:           domain.AuthNKeyTypeJSON,
+					ExpirationDate: time.Date(9999, time.December, 31, 23, 59, 59, 0, time.UTC),
+				},
+			},
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -200,3 +309,168 @@ func TestCommandSide_AddAPIApplicationKey(t *testing.T) {
 		})
 	}
 }
+
+func TestCommandSide_ChangeApplicationKeyExpiration(t *testing.T) {
+	type fields struct {
+		eventstore *eventstore.Eventstore
+	}
+	type args struct {
+		ctx            context.Context
+		projectID      string
+		appID          string
+		keyID          string
+		expirationDate time.Time
+		resourceOwner  string
+	}
+	type res struct {
+		want *domain.ObjectDetails
+		err  func(error) bool
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		args   args
+		res    res
+	}{
+		{
+			name: "no key id, invalid argument error",
+			fields: fields{
+				eventstore: eventstoreExpect(t),
+			},
+			args: args{
+				ctx:           context.Background(),
+				projectID:     "project1",
+				appID:         "app1",
+				resourceOwner: "org1",
+			},
+			res: res{
+				err: zerrors.IsErrorInvalidArgument,
+			},
+		},
+		{
+			name: "expiration in the past, invalid argument error",
+			fields: fields{
+				eventstore: eventstoreExpect(t),
+			},
+			args: args{
+				ctx:            context.Background(),
+				projectID:      "project1",
+				appID:          "app1",
+				keyID:          "key1",
+				expirationDate: time.Now().Add(-time.Hour),
+				resourceOwner:  "org1",
+			},
+			res: res{
+				err: zerrors.IsErrorInvalidArgument,
+			},
+		},
+		{
+			name: "key not existing, not found error",
+			fields: fields{
+				eventstore: eventstoreExpect(t,
+					expectFilter(),
+				),
+			},
+			args: args{
+				ctx:            context.Background(),
+				projectID:      "project1",
+				appID:          "app1",
+				keyID:          "key1",
+				expirationDate: time.Now().Add(time.Hour),
+				resourceOwner:  "org1",
+			},
+			res: res{
+				err: zerrors.IsNotFound,
+			},
+		},
+		{
+			name: "key expired, precondition error",
+			fields: fields{
+				eventstore: eventstoreExpect(t,
+					expectFilter(
+						eventFromEventPusher(
+							project.NewApplicationKeyAddedEvent(context.Background(),
+								&project.NewAggregate("project1", "org1").Aggregate,
+								"app1",
+								"client1@project",
+								"key1",
+								domain.AuthNKeyTypeJSON,
+								time.Now().Add(-time.Hour),
+								[]byte(fakePubkey),
+							),
+						),
+					),
+				),
+			},
+			args: args{
+				ctx:            context.Background(),
+				projectID:      "project1",
+				appID:          "app1",
+				keyID:          "key1",
+				expirationDate: time.Now().Add(time.Hour),
+				resourceOwner:  "org1",
+			},
+			res: res{
+				err: zerrors.IsPreconditionFailed,
+			},
+		},
+		{
+			name: "change expiration, ok",
+			fields: fields{
+				eventstore: eventstoreExpect(t,
+					expectFilter(
+						eventFromEventPusher(
+							project.NewApplicationKeyAddedEvent(context.Background(),
+								&project.NewAggregate("project1", "org1").Aggregate,
+								"app1",
+								"client1@project",
+								"key1",
+								domain.AuthNKeyTypeJSON,
+								time.Date(9999, time.December, 31, 23, 59, 59, 0, time.UTC),
+								[]byte(fakePubkey),
+							),
+						),
+					),
+					expectPush(
+						project.NewApplicationKeyExpirationChangedEvent(context.Background(),
+							&project.NewAggregate("project1", "org1").Aggregate,
+							"app1",
+							"key1",
+							time.Date(2500, time.January, 1, 0, 0, 0, 0, time.UTC),
+						),
+					),
+				),
+			},
+			args: args{
+				ctx:            context.Background(),
+				projectID:      "project1",
+				appID:          "app1",
+				keyID:          "key1",
+				expirationDate: time.Date(2500, time.January, 1, 0, 0, 0, 0, time.UTC),
+				resourceOwner:  "org1",
+			},
+			res: res{
+				want: &domain.ObjectDetails{
+					ResourceOwner: "org1",
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := &Commands{
+				eventstore: tt.fields.eventstore,
+			}
+			got, err := r.ChangeApplicationKeyExpiration(tt.args.ctx, tt.args.projectID, tt.args.appID, tt.args.keyID, tt.args.expirationDate, tt.args.resourceOwner)
+			if tt.res.err == nil {
+				assert.NoError(t, err)
+			}
+			if tt.res.err != nil && !tt.res.err(err) {
+				t.Errorf("got wrong err: %v ", err)
+			}
+			if tt.res.err == nil {
+				assert.Equal(t, tt.res.want, got)
+			}
+		})
+	}
+}
@@ -9,9 +9,14 @@ import (
 type ApplicationWriteModel struct {
 	eventstore.WriteModel
 
-	AppID string
-	State domain.AppState
-	Name  string
+	AppID                 string
+	State                 domain.AppState
+	Name                  string
+	ClaimsMapping         *domain.ClaimsMapping
+	BackChannelLogoutURI  string
+	FrontChannelLogoutURI string
+	AuthRequirements      *domain.AppAuthRequirements
+	FrameAncestors        []string
 }
 
 func NewApplicationWriteModelWithAppIDC(projectID, appID, resourceOwner string) *ApplicationWriteModel {
@@ -60,6 +65,26 @@ func (wm *ApplicationWriteModel) AppendEvents(events ...eventstore.Event) {
 				continue
 			}
 			wm.WriteModel.AppendEvents(e)
+		case *project.ApplicationClaimsMappingSetEvent:
+			if e.AppID != wm.AppID {
+				continue
+			}
+			wm.WriteModel.AppendEvents(e)
+		case *project.ApplicationLogoutConfigSetEvent:
+			if e.AppID != wm.AppID {
+				continue
+			}
+			wm.WriteModel.AppendEvents(e)
+		case *project.ApplicationAuthRequirementsSetEvent:
+			if e.AppID != wm.AppID {
+				continue
+			}
+			wm.WriteModel.AppendEvents(e)
+		case *project.ApplicationFrameAncestorsSetEvent:
+			if e.AppID != wm.AppID {
+				continue
+			}
+			wm.WriteModel.AppendEvents(e)
 		case *project.ProjectRemovedEvent:
 			wm.WriteModel.AppendEvents(e)
 		}
@@ -86,6 +111,15 @@ func (wm *ApplicationWriteModel) Reduce() error {
 			wm.State = domain.AppStateActive
 		case *project.ApplicationRemovedEvent:
 			wm.State = domain.AppStateRemoved
+		case *project.ApplicationClaimsMappingSetEvent:
+			wm.ClaimsMapping = e.ClaimsMapping
+		case *project.ApplicationLogoutConfigSetEvent:
+			wm.BackChannelLogoutURI = e.BackChannelLogoutURI
+			wm.FrontChannelLogoutURI = e.FrontChannelLogoutURI
+		case *project.ApplicationAuthRequirementsSetEvent:
+			wm.AuthRequirements = e.AuthRequirements
+		case *project.ApplicationFrameAncestorsSetEvent:
+			wm.FrameAncestors = e.FrameAncestors
 		case *project.ProjectRemovedEvent:
 			wm.State = domain.AppStateRemoved
 		}
@@ -105,6 +139,10 @@ func (wm *ApplicationWriteModel) Query() *eventstore.SearchQueryBuilder {
 			project.ApplicationDeactivatedType,
 			project.ApplicationReactivatedType,
 			project.ApplicationRemovedType,
+			project.ApplicationClaimsMappingSetType,
+			project.ApplicationLogoutConfigSetType,
+			project.ApplicationAuthRequirementsSetType,
+			project.ApplicationFrameAncestorsSetType,
 			project.ProjectRemovedType).
 		Builder()
 }
@@ -2,6 +2,8 @@ package command
 
 import (
 	"context"
+	"net/url"
+	"slices"
 	"strings"
 	"time"
 
@@ -31,6 +33,8 @@ type addOIDCApp struct {
 	ClockSkew                   time.Duration
 	AdditionalOrigins           []string
 	SkipSuccessPageForNativeApp bool
+	TLSClientAuthSubjectDN      string
+	TLSClientAuthThumbprint     string
 
 	ClientID          string
 	ClientSecret      string
@@ -62,6 +66,10 @@ func (c *Commands) AddOIDCAppCommand(app *addOIDCApp) preparation.Validation {
 			return nil, zerrors.ThrowInvalidArgument(nil, "V2-sLpW1", "Errors.Invalid.Argument")
 		}
 
+		if !domain.TLSClientAuthValid(app.AuthMethodType, app.TLSClientAuthSubjectDN, app.TLSClientAuthThumbprint) {
+			return nil, zerrors.ThrowInvalidArgument(nil, "V2-Tq8cw", "Errors.Project.App.TLSClientAuthInvalid")
+		}
+
 		return func(ctx context.Context, filter preparation.FilterToQueryReducer) (_ []eventstore.Command, err error) {
 			project, err := projectWriteModel(ctx, filter, app.Aggregate.ID, app.Aggregate.ResourceOwner)
 			if err != nil || !project.State.Valid() {
@@ -108,6 +116,8 @@ func (c *Commands) AddOIDCAppCommand(app *addOIDCApp) preparation.Validation {
 					app.ClockSkew,
 					trimStringSliceWhiteSpaces(app.AdditionalOrigins),
 					app.SkipSuccessPageForNativeApp,
+					strings.TrimSpace(app.TLSClientAuthSubjectDN),
+					strings.TrimSpace(app.TLSClientAuthThumbprint),
 				),
 			}, nil
 		}, nil
@@ -199,6 +209,8 @@ func (c *Commands) addOIDCApplicationWithID(ctx context.Context, oidcApp *domain
 		oidcApp.ClockSkew,
 		trimStringSliceWhiteSpaces(oidcApp.AdditionalOrigins),
 		oidcApp.SkipNativeAppSuccessPage,
+		strings.TrimSpace(oidcApp.TLSClientAuthSubjectDN),
+		strings.TrimSpace(oidcApp.TLSClientAuthThumbprint),
 	))
 
 	addedApplication.AppID = oidcApp.AppID
@@ -251,6 +263,8 @@ func (c *Commands) ChangeOIDCApplication(ctx context.Context, oidc *domain.OIDCA
 		oidc.ClockSkew,
 		trimStringSliceWhiteSpaces(oidc.AdditionalOrigins),
 		oidc.SkipNativeAppSuccessPage,
+		strings.TrimSpace(oidc.TLSClientAuthSubjectDN),
+		strings.TrimSpace(oidc.TLSClientAuthThumbprint),
 	)
 	if err != nil {
 		return nil, err
@@ -309,6 +323,171 @@ func (c *Commands) ChangeOIDCApplicationSecret(ctx context.Context, projectID, a
 	return result, err
 }
 
+// SetOIDCApplicationTokenLifetimes replaces the token lifetimes the OIDC application overrides of the instance settings.
+// The lifetimes must not exceed the ones of the instance. Empty lifetimes remove the overrides.
+func (c *Commands) SetOIDCApplicationTokenLifetimes(ctx context.Context, projectID, appID string, lifetimes *domain.AppTokenLifetimes, resourceOwner string) (*domain.ObjectDetails, error) {
+	if projectID == "" || appID == "" {
+		return nil, zerrors.ThrowInvalidArgument(nil, "COMMAND-Tl1md", "Errors.IDMissing")
+	}
+	if !lifetimes.IsValid() {
+		return nil, zerrors.ThrowInvalidArgument(nil, "COMMAND-Tl2pq", "Errors.Project.App.TokenLifetimesInvalid")
+	}
+
+	existingOIDC, err := c.getOIDCAppWriteModel(ctx, projectID, appID, resourceOwner)
+	if err != nil {
+		return nil, err
+	}
+	if !existingOIDC.State.Exists() {
+		return nil, zerrors.ThrowNotFound(nil, "COMMAND-Tl3ws", "Errors.Project.App.NotExisting")
+	}
+	if !existingOIDC.IsOIDC() {
+		return nil, zerrors.ThrowInvalidArgument(nil, "COMMAND-Tl4ok", "Errors.Project.App.IsNotOIDC")
+	}
+	if existingOIDC.TokenLifetimes.Equal(lifetimes) {
+		return nil, zerrors.ThrowPreconditionFailed(nil, "COMMAND-Tl5ke", "Errors.NoChangesFound")
+	}
+	if lifetimes.IsZero() {
+		lifetimes = nil
+	}
+	if lifetimes != nil {
+		bounds, err := c.instanceTokenLifetimes(ctx)
+		if err != nil {
+			return nil, err
+		}
+		if !lifetimes.IsWithin(bounds) {
+			return nil, zerrors.ThrowInvalidArgument(nil, "COMMAND-Tl6bx", "Errors.Project.App.TokenLifetimesExceedInstance")
+		}
+	}
+	projectAgg := ProjectAggregateFromWriteModel(&existingOIDC.WriteModel)
+	if err = c.pushAppendAndReduce(ctx, existingOIDC, project_repo.NewApplicationTokenLifetimesSetEvent(ctx, projectAgg, appID, existingOIDC.ClientID, lifetimes)); err != nil {
+		return nil, err
+	}
+	return writeModelToObjectDetails(&existingOIDC.WriteModel), nil
+}
+
+// instanceTokenLifetimes returns the lifetimes of the OIDC settings of the instance,
+// which are the upper bounds of the lifetimes of the applications.
+func (c *Commands) instanceTokenLifetimes(ctx context.Context) (domain.AppTokenLifetimes, error) {
+	settings := NewInstanceOIDCSettingsWriteModel(ctx)
+	if err := c.eventstore.FilterToQueryReducer(ctx, settings); err != nil {
+		return domain.AppTokenLifetimes{}, err
+	}
+	return domain.AppTokenLifetimes{
+		AccessTokenLifetime:        settings.AccessTokenLifetime,
+		IDTokenLifetime:            settings.IdTokenLifetime,
+		RefreshTokenIdleExpiration: settings.RefreshTokenIdleExpiration,
+		RefreshTokenExpiration:     settings.RefreshTokenExpiration,
+	}, nil
+}
+
+// SetOIDCApplicationOfflineTokens marks the OIDC application as first-party, which allows it to issue
+// offline tokens to its users with a lifetime of up to maxLifetime. A zero maxLifetime disallows them.
+func (c *Commands) SetOIDCApplicationOfflineTokens(ctx context.Context, projectID, appID string, maxLifetime time.Duration, resourceOwner string) (*domain.ObjectDetails, error) {
+	if projectID == "" || appID == "" {
+		return nil, zerrors.ThrowInvalidArgument(nil, "COMMAND-Ot1md", "Errors.IDMissing")
+	}
+	if maxLifetime < 0 {
+		return nil, zerrors.ThrowInvalidArgument(nil, "COMMAND-Ot2lf", "Errors.Project.App.OfflineTokenLifetimeInvalid")
+	}
+
+	existingOIDC, err := c.getOIDCAppWriteModel(ctx, projectID, appID, resourceOwner)
+	if err != nil {
+		return nil, err
+	}
+	if !existingOIDC.State.Exists() {
+		return nil, zerrors.ThrowNotFound(nil, "COMMAND-Ot3ws", "Errors.Project.App.NotExisting")
+	}
+	if !existingOIDC.IsOIDC() {
+		return nil, zerrors.ThrowInvalidArgument(nil, "COMMAND-Ot4ok", "Errors.Project.App.IsNotOIDC")
+	}
+	if existingOIDC.OfflineTokenMaxLifetime == maxLifetime {
+		return nil, zerrors.ThrowPreconditionFailed(nil, "COMMAND-Ot5ke", "Errors.NoChangesFound")
+	}
+	projectAgg := ProjectAggregateFromWriteModel(&existingOIDC.WriteModel)
+	if err = c.pushAppendAndReduce(ctx, existingOIDC, project_repo.NewApplicationOfflineTokensSetEvent(ctx, projectAgg, appID, existingOIDC.ClientID, maxLifetime)); err != nil {
+		return nil, err
+	}
+	return writeModelToObjectDetails(&existingOIDC.WriteModel), nil
+}
+
+// AddOIDCApplicationPostLogoutRedirectURI adds the URI to the allowlist of the OIDC application,
+// the post_logout_redirect_uri of an end_session request is checked against.
+func (c *Commands) AddOIDCApplicationPostLogoutRedirectURI(ctx context.Context, projectID, appID, uri, resourceOwner string) (*domain.ObjectDetails, error) {
+	uri = strings.TrimSpace(uri)
+	if !isValidPostLogoutRedirectURI(uri) {
+		return nil, zerrors.ThrowInvalidArgument(nil, "COMMAND-Pl1va", "Errors.Project.App.PostLogoutRedirectURIInvalid")
+	}
+	existingOIDC, err := c.postLogoutRedirectURIsWriteModel(ctx, projectID, appID, resourceOwner)
+	if err != nil {
+		return nil, err
+	}
+	if slices.Contains(existingOIDC.PostLogoutRedirectUris, uri) {
+		return nil, zerrors.ThrowAlreadyExists(nil, "COMMAND-Pl2ex", "Errors.Project.App.PostLogoutRedirectURIAlreadyExists")
+	}
+	uris := append(slices.Clone(existingOIDC.PostLogoutRedirectUris), uri)
+	return c.changeOIDCApplicationPostLogoutRedirectURIs(ctx, existingOIDC, uris)
+}
+
+// RemoveOIDCApplicationPostLogoutRedirectURI removes the URI from the allowlist of the OIDC application.
+func (c *Commands) RemoveOIDCApplicationPostLogoutRedirectURI(ctx context.Context, projectID, appID, uri, resourceOwner string) (*domain.ObjectDetails, error) {
+	uri = strings.TrimSpace(uri)
+	if uri == "" {
+		return nil, zerrors.ThrowInvalidArgument(nil, "COMMAND-Pl3mi", "Errors.Project.App.PostLogoutRedirectURIInvalid")
+	}
+	existingOIDC, err := c.postLogoutRedirectURIsWriteModel(ctx, projectID, appID, resourceOwner)
+	if err != nil {
+		return nil, err
+	}
+	if !slices.Contains(existingOIDC.PostLogoutRedirectUris, uri) {
+		return nil, zerrors.ThrowNotFound(nil, "COMMAND-Pl4nf", "Errors.Project.App.PostLogoutRedirectURINotFound")
+	}
+	uris := slices.DeleteFunc(slices.Clone(existingOIDC.PostLogoutRedirectUris), func(existing string) bool {
+		return existing == uri
+	})
+	return c.changeOIDCApplicationPostLogoutRedirectURIs(ctx, existingOIDC, uris)
+}
+
+func (c *Commands) postLogoutRedirectURIsWriteModel(ctx context.Context, projectID, appID, resourceOwner string) (*OIDCApplicationWriteModel, error) {
+	if projectID == "" || appID == "" {
+		return nil, zerrors.ThrowInvalidArgument(nil, "COMMAND-Pl5id", "Errors.IDMissing")
+	}
+	existingOIDC, err := c.getOIDCAppWriteModel(ctx, projectID, appID, resourceOwner)
+	if err != nil {
+		return nil, err
+	}
+	if !existingOIDC.State.Exists() {
+		return nil, zerrors.ThrowNotFound(nil, "COMMAND-Pl6ne", "Errors.Project.App.NotExisting")
+	}
+	if !existingOIDC.IsOIDC() {
+		return nil, zerrors.ThrowInvalidArgument(nil, "COMMAND-Pl7io", "Errors.Project.App.IsNotOIDC")
+	}
+	return existingOIDC, nil
+}
+
+func (c *Commands) changeOIDCApplicationPostLogoutRedirectURIs(ctx context.Context, existingOIDC *OIDCApplicationWriteModel, uris []string) (*domain.ObjectDetails, error) {
+	projectAgg := ProjectAggregateFromWriteModel(&existingOIDC.WriteModel)
+	changedEvent, err := project_repo.NewOIDCConfigChangedEvent(ctx, projectAgg, existingOIDC.AppID, []project_repo.OIDCConfigChanges{
+		project_repo.ChangePostLogoutRedirectURIs(uris),
+	})
+	if err != nil {
+		return nil, err
+	}
+	if err = c.pushAppendAndReduce(ctx, existingOIDC, changedEvent); err != nil {
+		return nil, err
+	}
+	return writeModelToObjectDetails(&existingOIDC.WriteModel), nil
+}
+
+// isValidPostLogoutRedirectURI checks the URI is absolute and has no fragment,
+// see https://openid.net/specs/openid-connect-rpinitiated-1_0.html#ClientMetadata
+func isValidPostLogoutRedirectURI(uri string) bool {
+	parsed, err := url.Parse(uri)
+	if err != nil {
+		return false
+	}
+	return parsed.Scheme != "" && parsed.Fragment == ""
+}
+
 func (c *Commands) VerifyOIDCClientSecret(ctx context.Context, projectID, appID, secret string) (err error) {
 	ctx, span := tracing.NewSpan(ctx)
 	defer func() { span.EndWithError(err) }()
@@ -36,6 +36,10 @@ type OIDCApplicationWriteModel struct {
 	State                    domain.AppState
 	AdditionalOrigins        []string
 	SkipNativeAppSuccessPage bool
+	TLSClientAuthSubjectDN   string
+	TLSClientAuthThumbprint  string
+	TokenLifetimes           *domain.AppTokenLifetimes
+	OfflineTokenMaxLifetime  time.Duration
 	oidc                     bool
 }
 
@@ -105,6 +109,16 @@ func (wm *OIDCApplicationWriteModel) AppendEvents(events ...eventstore.Event) {
 				continue
 			}
 			wm.WriteModel.AppendEvents(e)
+		case *project.ApplicationTokenLifetimesSetEvent:
+			if e.AppID != wm.AppID {
+				continue
+			}
+			wm.WriteModel.AppendEvents(e)
+		case *project.ApplicationOfflineTokensSetEvent:
+			if e.AppID != wm.AppID {
+				continue
+			}
+			wm.WriteModel.AppendEvents(e)
 		case *project.ProjectRemovedEvent:
 			wm.WriteModel.AppendEvents(e)
 		}
@@ -139,6 +153,10 @@ func (wm *OIDCApplicationWriteModel) Reduce() error {
 			wm.HashedSecret = crypto.SecretOrEncodedHash(e.ClientSecret, e.HashedSecret)
 		case *project.OIDCConfigSecretHashUpdatedEvent:
 			wm.HashedSecret = e.HashedSecret
+		case *project.ApplicationTokenLifetimesSetEvent:
+			wm.TokenLifetimes = e.TokenLifetimes
+		case *project.ApplicationOfflineTokensSetEvent:
+			wm.OfflineTokenMaxLifetime = e.MaxLifetime
 		case *project.ProjectRemovedEvent:
 			wm.State = domain.AppStateRemoved
 		}
@@ -165,6 +183,8 @@ func (wm *OIDCApplicationWriteModel) appendAddOIDCEvent(e *project.OIDCConfigAdd
 	wm.ClockSkew = e.ClockSkew
 	wm.AdditionalOrigins = e.AdditionalOrigins
 	wm.SkipNativeAppSuccessPage = e.SkipNativeAppSuccessPage
+	wm.TLSClientAuthSubjectDN = e.TLSClientAuthSubjectDN
+	wm.TLSClientAuthThumbprint = e.TLSClientAuthThumbprint
 }
 
 func (wm *OIDCApplicationWriteModel) appendChangeOIDCEvent(e *project.OIDCConfigChangedEvent) {
@@ -213,6 +233,12 @@ func (wm *OIDCApplicationWriteModel) appendChangeOIDCEvent(e *project.OIDCConfig
 	if e.SkipNativeAppSuccessPage != nil {
 		wm.SkipNativeAppSuccessPage = *e.SkipNativeAppSuccessPage
 	}
+	if e.TLSClientAuthSubjectDN != nil {
+		wm.TLSClientAuthSubjectDN = *e.TLSClientAuthSubjectDN
+	}
+	if e.TLSClientAuthThumbprint != nil {
+		wm.TLSClientAuthThumbprint = *e.TLSClientAuthThumbprint
+	}
 }
 
 func (wm *OIDCApplicationWriteModel) Query() *eventstore.SearchQueryBuilder {
@@ -231,6 +257,8 @@ func (wm *OIDCApplicationWriteModel) Query() *eventstore.SearchQueryBuilder {
 			project.OIDCConfigChangedType,
 			project.OIDCConfigSecretChangedType,
 			project.OIDCConfigSecretHashUpdatedType,
+			project.ApplicationTokenLifetimesSetType,
+			project.ApplicationOfflineTokensSetType,
 			project.ProjectRemovedType,
 		).Builder()
 }
@@ -254,6 +282,8 @@ func (wm *OIDCApplicationWriteModel) NewChangedEvent(
 	clockSkew time.Duration,
 	additionalOrigins []string,
 	skipNativeAppSuccessPage bool,
+	tlsClientAuthSubjectDN,
+	tlsClientAuthThumbprint string,
 ) (*project.OIDCConfigChangedEvent, bool, error) {
 	changes := make([]project.OIDCConfigChanges, 0)
 	var err error
@@ -303,6 +333,12 @@ func (wm *OIDCApplicationWriteModel) NewChangedEvent(
 	if wm.SkipNativeAppSuccessPage != skipNativeAppSuccessPage {
 		changes = append(changes, project.ChangeSkipNativeAppSuccessPage(skipNativeAppSuccessPage))
 	}
+	if wm.TLSClientAuthSubjectDN != tlsClientAuthSubjectDN {
+		changes = append(changes, project.ChangeTLSClientAuthSubjectDN(tlsClientAuthSubjectDN))
+	}
+	if wm.TLSClientAuthThumbprint != tlsClientAuthThumbprint {
+		changes = append(changes, project.ChangeTLSClientAuthThumbprint(tlsClientAuthThumbprint))
+	}
 
 	if len(changes) == 0 {
 		return nil, false, nil
@@ -11,6 +11,7 @@ import (
 	"github.com/zitadel/passwap"
 	"github.com/zitadel/passwap/bcrypt"
 
+	"github.com/zitadel/zitadel/internal/api/authz"
 	"github.com/zitadel/zitadel/internal/command/preparation"
 	"github.com/zitadel/zitadel/internal/crypto"
 	"github.com/zitadel/zitadel/internal/domain"
@@ -18,6 +19,7 @@ import (
 	"github.com/zitadel/zitadel/internal/eventstore/v1/models"
 	"github.com/zitadel/zitadel/internal/id"
 	id_mock "github.com/zitadel/zitadel/internal/id/mock"
+	"github.com/zitadel/zitadel/internal/repository/instance"
 	"github.com/zitadel/zitadel/internal/repository/project"
 	"github.com/zitadel/zitadel/internal/zerrors"
 )
@@ -84,6 +86,28 @@ func TestAddOIDCApp(t *testing.T) {
 				ValidationErr: zerrors.ThrowInvalidArgument(nil, "PROJE-Fef31", "Errors.Invalid.Argument"),
 			},
 		},
+		{
+			name:   "tls client auth without subject",
+			fields: fields{},
+			args: args{
+				app: &addOIDCApp{
+					AddApp: AddApp{
+						Aggregate: *agg,
+						ID:        "id",
+						Name:      "name",
+					},
+					GrantTypes:      []domain.OIDCGrantType{domain.OIDCGrantTypeAuthorizationCode},
+					ResponseTypes:   []domain.OIDCResponseType{domain.OIDCResponseTypeCode},
+					Version:         domain.OIDCVersionV1,
+					ApplicationType: domain.OIDCApplicationTypeWeb,
+					AuthMethodType:  domain.OIDCAuthMethodTypeTLSClientAuth,
+					AccessTokenType: domain.OIDCTokenTypeBearer,
+				},
+			},
+			want: Want{
+				ValidationErr: zerrors.ThrowInvalidArgument(nil, "V2-Tq8cw", "Errors.Project.App.TLSClientAuthInvalid"),
+			},
+		},
 		{
 			name:   "project doesn't exist",
 			fields: fields{},
@@ -174,6 +198,8 @@ func TestAddOIDCApp(t *testing.T) {
 						0,
 						[]string{"https://sub.test.ch"},
 						false,
+						"",
+						"",
 					),
 				},
 			},
@@ -239,6 +265,75 @@ func TestAddOIDCApp(t *testing.T) {
 						0,
 						nil,
 						false,
+						"",
+						"",
+					),
+				},
+			},
+		},
+		{
+			name: "correct, self signed tls client auth",
+			fields: fields{
+				idGenerator: id_mock.NewIDGeneratorExpectIDs(t, "clientID"),
+			},
+			args: args{
+				app: &addOIDCApp{
+					AddApp: AddApp{
+						Aggregate: *agg,
+						ID:        "id",
+						Name:      "name",
+					},
+					GrantTypes:              []domain.OIDCGrantType{domain.OIDCGrantTypeAuthorizationCode},
+					ResponseTypes:           []domain.OIDCResponseType{domain.OIDCResponseTypeCode},
+					Version:                 domain.OIDCVersionV1,
+					ApplicationType:         domain.OIDCApplicationTypeWeb,
+					AuthMethodType:          domain.OIDCAuthMethodTypeSelfSignedTLSClientAuth,
+					AccessTokenType:         domain.OIDCTokenTypeJWT,
+					TLSClientAuthThumbprint: " thumbprint ",
+				},
+				filter: NewMultiFilter().
+					Append(func(ctx context.Context, queryFactory *eventstore.SearchQueryBuilder) ([]eventstore.Event, error) {
+						return []eventstore.Event{
+							project.NewProjectAddedEvent(
+								ctx,
+								&agg.Aggregate,
+								"project",
+								false,
+								false,
+								false,
+								domain.PrivateLabelingSettingUnspecified,
+							),
+						}, nil
+					}).
+					Filter(),
+			},
+			want: Want{
+				Commands: []eventstore.Command{
+					project.NewApplicationAddedEvent(ctx, &agg.Aggregate,
+						"id",
+						"name",
+					),
+					project.NewOIDCConfigAddedEvent(ctx, &agg.Aggregate,
+						domain.OIDCVersionV1,
+						"id",
+						"clientID",
+						"",
+						nil,
+						[]domain.OIDCResponseType{domain.OIDCResponseTypeCode},
+						[]domain.OIDCGrantType{domain.OIDCGrantTypeAuthorizationCode},
+						domain.OIDCApplicationTypeWeb,
+						domain.OIDCAuthMethodTypeSelfSignedTLSClientAuth,
+						nil,
+						false,
+						domain.OIDCTokenTypeJWT,
+						false,
+						false,
+						false,
+						0,
+						nil,
+						false,
+						"",
+						"thumbprint",
 					),
 				},
 			},
@@ -304,6 +399,8 @@ func TestAddOIDCApp(t *testing.T) {
 						0,
 						nil,
 						false,
+						"",
+						"",
 					),
 				},
 			},
@@ -369,6 +466,8 @@ func TestAddOIDCApp(t *testing.T) {
 						0,
 						nil,
 						false,
+						"",
+						"",
 					),
 				},
 			},
@@ -515,6 +614,8 @@ func TestCommandSide_AddOIDCApplication(t *testing.T) {
 							time.Second*1,
 							[]string{"https://sub.test.ch"},
 							true,
+							"",
+							"",
 						),
 					),
 				),
@@ -613,6 +714,8 @@ func TestCommandSide_AddOIDCApplication(t *testing.T) {
 							time.Second*1,
 							[]string{"https://sub.test.ch"},
 							true,
+							"",
+							"",
 						),
 					),
 				),
@@ -845,6 +948,8 @@ func TestCommandSide_ChangeOIDCApplication(t *testing.T) {
 								time.Second*1,
 								[]string{"https://sub.test.ch"},
 								true,
+								"",
+								"",
 							),
 						),
 					),
@@ -914,6 +1019,8 @@ func TestCommandSide_ChangeOIDCApplication(t *testing.T) {
 								time.Second*1,
 								[]string{"https://sub.test.ch"},
 								true,
+								"",
+								"",
 							),
 						),
 					),
@@ -983,6 +1090,8 @@ func TestCommandSide_ChangeOIDCApplication(t *testing.T) {
 								time.Second*1,
 								[]string{"https://sub.test.ch"},
 								true,
+								"",
+								"",
 							),
 						),
 					),
@@ -1049,6 +1158,101 @@ func TestCommandSide_ChangeOIDCApplication(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "change to tls client auth, ok",
+			fields: fields{
+				eventstore: eventstoreExpect(
+					t,
+					expectFilter(
+						eventFromEventPusher(
+							project.NewApplicationAddedEvent(context.Background(),
+								&project.NewAggregate("project1", "org1").Aggregate,
+								"app1",
+								"app",
+							),
+						),
+						eventFromEventPusher(
+							project.NewOIDCConfigAddedEvent(context.Background(),
+								&project.NewAggregate("project1", "org1").Aggregate,
+								domain.OIDCVersionV1,
+								"app1",
+								"client1@project",
+								"secret",
+								[]string{"https://test.ch"},
+								[]domain.OIDCResponseType{domain.OIDCResponseTypeCode},
+								[]domain.OIDCGrantType{domain.OIDCGrantTypeAuthorizationCode},
+								domain.OIDCApplicationTypeWeb,
+								domain.OIDCAuthMethodTypePost,
+								nil,
+								false,
+								domain.OIDCTokenTypeBearer,
+								false,
+								false,
+								false,
+								0,
+								nil,
+								false,
+								"",
+								"",
+							),
+						),
+					),
+					expectPush(
+						func() eventstore.Command {
+							event, _ := project.NewOIDCConfigChangedEvent(context.Background(),
+								&project.NewAggregate("project1", "org1").Aggregate,
+								"app1",
+								[]project.OIDCConfigChanges{
+									project.ChangeAuthMethodType(domain.OIDCAuthMethodTypeTLSClientAuth),
+									project.ChangeTLSClientAuthSubjectDN("CN=client,O=ZITADEL"),
+								},
+							)
+							return event
+						}(),
+					),
+				),
+			},
+			args: args{
+				ctx: context.Background(),
+				oidcApp: &domain.OIDCApp{
+					ObjectRoot: models.ObjectRoot{
+						AggregateID: "project1",
+					},
+					AppID:                  "app1",
+					AppName:                "app",
+					AuthMethodType:         domain.OIDCAuthMethodTypeTLSClientAuth,
+					OIDCVersion:            domain.OIDCVersionV1,
+					RedirectUris:           []string{"https://test.ch"},
+					ResponseTypes:          []domain.OIDCResponseType{domain.OIDCResponseTypeCode},
+					GrantTypes:             []domain.OIDCGrantType{domain.OIDCGrantTypeAuthorizationCode},
+					ApplicationType:        domain.OIDCApplicationTypeWeb,
+					AccessTokenType:        domain.OIDCTokenTypeBearer,
+					TLSClientAuthSubjectDN: " CN=client,O=ZITADEL ",
+				},
+				resourceOwner: "org1",
+			},
+			res: res{
+				want: &domain.OIDCApp{
+					ObjectRoot: models.ObjectRoot{
+						AggregateID:   "project1",
+						ResourceOwner: "org1",
+					},
+					AppID:                  "app1",
+					ClientID:               "client1@project",
+					AppName:                "app",
+					AuthMethodType:         domain.OIDCAuthMethodTypeTLSClientAuth,
+					OIDCVersion:            domain.OIDCVersionV1,
+					RedirectUris:           []string{"https://test.ch"},
+					ResponseTypes:          []domain.OIDCResponseType{domain.OIDCResponseTypeCode},
+					GrantTypes:             []domain.OIDCGrantType{domain.OIDCGrantTypeAuthorizationCode},
+					ApplicationType:        domain.OIDCApplicationTypeWeb,
+					AccessTokenType:        domain.OIDCTokenTypeBearer,
+					TLSClientAuthSubjectDN: "CN=client,O=ZITADEL",
+					Compliance:             &domain.Compliance{},
+					State:                  domain.AppStateActive,
+				},
+			},
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -1168,6 +1372,8 @@ func TestCommandSide_ChangeOIDCApplicationSecret(t *testing.T) {
 								time.Second*1,
 								[]string{"https://sub.test.ch"},
 								false,
+								"",
+								"",
 							),
 						),
 					),
@@ -1239,66 +1445,763 @@ func TestCommandSide_ChangeOIDCApplicationSecret(t *testing.T) {
 	}
 }
 
-func newOIDCAppChangedEvent(ctx context.Context, appID, projectID, resourceOwner string) *project.OIDCConfigChangedEvent {
-	changes := []project.OIDCConfigChanges{
-		project.ChangeRedirectURIs([]string{"https://test-change.ch"}),
-		project.ChangePostLogoutRedirectURIs([]string{"https://test-change.ch/logout"}),
-		project.ChangeDevMode(true),
-		project.ChangeAccessTokenType(domain.OIDCTokenTypeJWT),
-		project.ChangeAccessTokenRoleAssertion(false),
-		project.ChangeIDTokenRoleAssertion(false),
-		project.ChangeIDTokenUserinfoAssertion(false),
-		project.ChangeClockSkew(time.Second * 2),
+func TestCommandSide_SetOIDCApplicationTokenLifetimes(t *testing.T) {
+	type fields struct {
+		eventstore func(*testing.T) *eventstore.Eventstore
 	}
-	event, _ := project.NewOIDCConfigChangedEvent(ctx,
-		&project.NewAggregate(projectID, resourceOwner).Aggregate,
-		appID,
-		changes,
-	)
-	return event
-}
-
-func TestCommands_VerifyOIDCClientSecret(t *testing.T) {
-	hasher := &crypto.Hasher{
-		Swapper: passwap.NewSwapper(bcrypt.New(bcrypt.MinCost)),
+	type args struct {
+		ctx           context.Context
+		projectID     string
+		appID         string
+		lifetimes     *domain.AppTokenLifetimes
+		resourceOwner string
+	}
+	type res struct {
+		want *domain.ObjectDetails
+		err  func(error) bool
+	}
+	ctx := authz.WithInstanceID(context.Background(), "instance1")
+	oidcAppAdded := func() []eventstore.Event {
+		return []eventstore.Event{
+			eventFromEventPusher(
+				project.NewApplicationAddedEvent(ctx,
+					&project.NewAggregate("project1", "org1").Aggregate,
+					"app1",
+					"app",
+				),
+			),
+			eventFromEventPusher(
+				project.NewOIDCConfigAddedEvent(ctx,
+					&project.NewAggregate("project1", "org1").Aggregate,
+					domain.OIDCVersionV1,
+					"app1",
+					"client1@project",
+					"secret",
+					[]string{"https://test.ch"},
+					[]domain.OIDCResponseType{domain.OIDCResponseTypeCode},
+					[]domain.OIDCGrantType{domain.OIDCGrantTypeAuthorizationCode},
+					domain.OIDCApplicationTypeWeb,
+					domain.OIDCAuthMethodTypePost,
+					[]string{"https://test.ch/logout"},
+					true,
+					domain.OIDCTokenTypeBearer,
+					true,
+					true,
+					true,
+					time.Second*1,
+					[]string{"https://sub.test.ch"},
+					false,
+					"",
+					"",
+				),
+			),
+		}
+	}
+	oidcSettingsAdded := func() eventstore.Event {
+		return eventFromEventPusher(
+			instance.NewOIDCSettingsAddedEvent(ctx,
+				&instance.NewAggregate("instance1").Aggregate,
+				12*time.Hour,
+				12*time.Hour,
+				30*24*time.Hour,
+				90*24*time.Hour,
+			),
+		)
 	}
-	hashedSecret, err := hasher.Hash("secret")
-	require.NoError(t, err)
-	agg := project.NewAggregate("projectID", "orgID")
-
 	tests := []struct {
-		name       string
-		secret     string
-		eventstore func(*testing.T) *eventstore.Eventstore
-		wantErr    error
+		name   string
+		fields fields
+		args   args
+		res    res
 	}{
 		{
-			name: "filter error",
-			eventstore: expectEventstore(
-				expectFilterError(io.ErrClosedPipe),
-			),
-			wantErr: io.ErrClosedPipe,
+			name: "no appid, invalid argument error",
+			fields: fields{
+				eventstore: expectEventstore(),
+			},
+			args: args{
+				ctx:           ctx,
+				projectID:     "project1",
+				resourceOwner: "org1",
+			},
+			res: res{
+				err: zerrors.IsErrorInvalidArgument,
+			},
 		},
 		{
-			name: "app not exists",
-			eventstore: expectEventstore(
-				expectFilter(),
-			),
-			wantErr: zerrors.ThrowPreconditionFailed(nil, "COMMAND-D8hba", "Errors.Project.App.NotExisting"),
+			name: "invalid lifetimes, invalid argument error",
+			fields: fields{
+				eventstore: expectEventstore(),
+			},
+			args: args{
+				ctx:           ctx,
+				projectID:     "project1",
+				appID:         "app1",
+				lifetimes:     &domain.AppTokenLifetimes{AccessTokenLifetime: -time.Minute},
+				resourceOwner: "org1",
+			},
+			res: res{
+				err: zerrors.IsErrorInvalidArgument,
+			},
 		},
 		{
-			name: "wrong app type",
-			eventstore: expectEventstore(
-				expectFilter(
-					eventFromEventPusher(
-						project.NewApplicationAddedEvent(context.Background(), &agg.Aggregate, "appID", "appName"),
-					),
+			name: "app not existing, not found error",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(),
 				),
-			),
-			wantErr: zerrors.ThrowInvalidArgument(nil, "COMMAND-BHgn2", "Errors.Project.App.IsNotOIDC"),
+			},
+			args: args{
+				ctx:           ctx,
+				projectID:     "project1",
+				appID:         "app1",
+				lifetimes:     &domain.AppTokenLifetimes{AccessTokenLifetime: time.Hour},
+				resourceOwner: "org1",
+			},
+			res: res{
+				err: zerrors.IsNotFound,
+			},
 		},
 		{
-			name: "no secret set",
+			name: "no changes, precondition error",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						append(oidcAppAdded(),
+							eventFromEventPusher(
+								project.NewApplicationTokenLifetimesSetEvent(ctx,
+									&project.NewAggregate("project1", "org1").Aggregate,
+									"app1",
+									"client1@project",
+									&domain.AppTokenLifetimes{AccessTokenLifetime: time.Hour},
+								),
+							),
+						)...,
+					),
+				),
+			},
+			args: args{
+				ctx:           ctx,
+				projectID:     "project1",
+				appID:         "app1",
+				lifetimes:     &domain.AppTokenLifetimes{AccessTokenLifetime: time.Hour},
+				resourceOwner: "org1",
+			},
+			res: res{
+				err: zerrors.IsPreconditionFailed,
+			},
+		},
+		{
+			name: "exceeds instance, invalid argument error",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(oidcAppAdded()...),
+					expectFilter(oidcSettingsAdded()),
+				),
+			},
+			args: args{
+				ctx:           ctx,
+				projectID:     "project1",
+				appID:         "app1",
+				lifetimes:     &domain.AppTokenLifetimes{RefreshTokenExpiration: 180 * 24 * time.Hour},
+				resourceOwner: "org1",
+			},
+			res: res{
+				err: zerrors.IsErrorInvalidArgument,
+			},
+		},
+		{
+			name: "set lifetimes, ok",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(oidcAppAdded()...),
+					expectFilter(oidcSettingsAdded()),
+					expectPush(
+						project.NewApplicationTokenLifetimesSetEvent(ctx,
+							&project.NewAggregate("project1", "org1").Aggregate,
+							"app1",
+							"client1@project",
+							&domain.AppTokenLifetimes{AccessTokenLifetime: time.Hour, RefreshTokenIdleExpiration: 24 * time.Hour},
+						),
+					),
+				),
+			},
+			args: args{
+				ctx:           ctx,
+				projectID:     "project1",
+				appID:         "app1",
+				lifetimes:     &domain.AppTokenLifetimes{AccessTokenLifetime: time.Hour, RefreshTokenIdleExpiration: 24 * time.Hour},
+				resourceOwner: "org1",
+			},
+			res: res{
+				want: &domain.ObjectDetails{
+					ResourceOwner: "org1",
+				},
+			},
+		},
+		{
+			name: "remove lifetimes, ok",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						append(oidcAppAdded(),
+							eventFromEventPusher(
+								project.NewApplicationTokenLifetimesSetEvent(ctx,
+									&project.NewAggregate("project1", "org1").Aggregate,
+									"app1",
+									"client1@project",
+									&domain.AppTokenLifetimes{AccessTokenLifetime: time.Hour},
+								),
+							),
+						)...,
+					),
+					expectPush(
+						project.NewApplicationTokenLifetimesSetEvent(ctx,
+							&project.NewAggregate("project1", "org1").Aggregate,
+							"app1",
+							"client1@project",
+							nil,
+						),
+					),
+				),
+			},
+			args: args{
+				ctx:           ctx,
+				projectID:     "project1",
+				appID:         "app1",
+				lifetimes:     &domain.AppTokenLifetimes{},
+				resourceOwner: "org1",
+			},
+			res: res{
+				want: &domain.ObjectDetails{
+					ResourceOwner: "org1",
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := &Commands{
+				eventstore: tt.fields.eventstore(t),
+			}
+			got, err := r.SetOIDCApplicationTokenLifetimes(tt.args.ctx, tt.args.projectID, tt.args.appID, tt.args.lifetimes, tt.args.resourceOwner)
+			if tt.res.err == nil {
+				assert.NoError(t, err)
+			}
+			if tt.res.err != nil && !tt.res.err(err) {
+				t.Errorf("got wrong err: %v ", err)
+			}
+			if tt.res.err == nil {
+				assert.Equal(t, tt.res.want, got)
+			}
+		})
+	}
+}
+
+func TestCommandSide_SetOIDCApplicationOfflineTokens(t *testing.T) {
+	type fields struct {
+		eventstore func(*testing.T) *eventstore.Eventstore
+	}
+	type args struct {
+		ctx           context.Context
+		projectID     string
+		appID         string
+		maxLifetime   time.Duration
+		resourceOwner string
+	}
+	type res struct {
+		want *domain.ObjectDetails
+		err  func(error) bool
+	}
+	oidcAppAdded := func() []eventstore.Event {
+		return []eventstore.Event{
+			eventFromEventPusher(
+				project.NewApplicationAddedEvent(context.Background(),
+					&project.NewAggregate("project1", "org1").Aggregate,
+					"app1",
+					"app",
+				),
+			),
+			eventFromEventPusher(
+				project.NewOIDCConfigAddedEvent(context.Background(),
+					&project.NewAggregate("project1", "org1").Aggregate,
+					domain.OIDCVersionV1,
+					"app1",
+					"client1@project",
+					"secret",
+					[]string{"https://test.ch"},
+					[]domain.OIDCResponseType{domain.OIDCResponseTypeCode},
+					[]domain.OIDCGrantType{domain.OIDCGrantTypeAuthorizationCode},
+					domain.OIDCApplicationTypeWeb,
+					domain.OIDCAuthMethodTypePost,
+					[]string{"https://test.ch/logout"},
+					true,
+					domain.OIDCTokenTypeBearer,
+					true,
+					true,
+					true,
+					time.Second*1,
+					[]string{"https://sub.test.ch"},
+					false,
+					"",
+					"",
+				),
+			),
+		}
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		args   args
+		res    res
+	}{
+		{
+			name: "negative max lifetime, invalid argument error",
+			fields: fields{
+				eventstore: expectEventstore(),
+			},
+			args: args{
+				ctx:           context.Background(),
+				projectID:     "project1",
+				appID:         "app1",
+				maxLifetime:   -time.Hour,
+				resourceOwner: "org1",
+			},
+			res: res{
+				err: zerrors.IsErrorInvalidArgument,
+			},
+		},
+		{
+			name: "no changes, precondition error",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(oidcAppAdded()...),
+				),
+			},
+			args: args{
+				ctx:           context.Background(),
+				projectID:     "project1",
+				appID:         "app1",
+				resourceOwner: "org1",
+			},
+			res: res{
+				err: zerrors.IsPreconditionFailed,
+			},
+		},
+		{
+			name: "allow offline tokens, ok",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(oidcAppAdded()...),
+					expectPush(
+						project.NewApplicationOfflineTokensSetEvent(context.Background(),
+							&project.NewAggregate("project1", "org1").Aggregate,
+							"app1",
+							"client1@project",
+							90*24*time.Hour,
+						),
+					),
+				),
+			},
+			args: args{
+				ctx:           context.Background(),
+				projectID:     "project1",
+				appID:         "app1",
+				maxLifetime:   90 * 24 * time.Hour,
+				resourceOwner: "org1",
+			},
+			res: res{
+				want: &domain.ObjectDetails{
+					ResourceOwner: "org1",
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := &Commands{
+				eventstore: tt.fields.eventstore(t),
+			}
+			got, err := r.SetOIDCApplicationOfflineTokens(tt.args.ctx, tt.args.projectID, tt.args.appID, tt.args.maxLifetime, tt.args.resourceOwner)
+			if tt.res.err == nil {
+				assert.NoError(t, err)
+			}
+			if tt.res.err != nil && !tt.res.err(err) {
+				t.Errorf("got wrong err: %v ", err)
+			}
+			if tt.res.err == nil {
+				assert.Equal(t, tt.res.want, got)
+			}
+		})
+	}
+}
+
+func TestCommandSide_AddOIDCApplicationPostLogoutRedirectURI(t *testing.T) {
+	type fields struct {
+		eventstore func(*testing.T) *eventstore.Eventstore
+	}
+	type args struct {
+		ctx           context.Context
+		projectID     string
+		appID         string
+		uri           string
+		resourceOwner string
+	}
+	type res struct {
+		want *domain.ObjectDetails
+		err  func(error) bool
+	}
+	oidcAppAdded := func() []eventstore.Event {
+		return []eventstore.Event{
+			eventFromEventPusher(
+				project.NewApplicationAddedEvent(context.Background(),
+					&project.NewAggregate("project1", "org1").Aggregate,
+					"app1",
+					"app",
+				),
+			),
+			eventFromEventPusher(
+				project.NewOIDCConfigAddedEvent(context.Background(),
+					&project.NewAggregate("project1", "org1").Aggregate,
+					domain.OIDCVersionV1,
+					"app1",
+					"client1@project",
+					"secret",
+					[]string{"https://test.ch"},
+					[]domain.OIDCResponseType{domain.OIDCResponseTypeCode},
+					[]domain.OIDCGrantType{domain.OIDCGrantTypeAuthorizationCode},
+					domain.OIDCApplicationTypeWeb,
+					domain.OIDCAuthMethodTypePost,
+					[]string{"https://test.ch/logout"},
+					true,
+					domain.OIDCTokenTypeBearer,
+					true,
+					true,
+					true,
+					time.Second*1,
+					[]string{"https://sub.test.ch"},
+					false,
+					"",
+					"",
+				),
+			),
+		}
+	}
+	postLogoutRedirectURIsChanged := func(uris ...string) *project.OIDCConfigChangedEvent {
+		event, _ := project.NewOIDCConfigChangedEvent(context.Background(),
+			&project.NewAggregate("project1", "org1").Aggregate,
+			"app1",
+			[]project.OIDCConfigChanges{
+				project.ChangePostLogoutRedirectURIs(uris),
+			},
+		)
+		return event
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		args   args
+		res    res
+	}{
+		{
+			name: "invalid uri, invalid argument error",
+			fields: fields{
+				eventstore: expectEventstore(),
+			},
+			args: args{
+				ctx:           context.Background(),
+				projectID:     "project1",
+				appID:         "app1",
+				uri:           "/logout",
+				resourceOwner: "org1",
+			},
+			res: res{
+				err: zerrors.IsErrorInvalidArgument,
+			},
+		},
+		{
+			name: "app not existing, not found error",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(),
+				),
+			},
+			args: args{
+				ctx:           context.Background(),
+				projectID:     "project1",
+				appID:         "app1",
+				uri:           "https://test.ch/signedout",
+				resourceOwner: "org1",
+			},
+			res: res{
+				err: zerrors.IsNotFound,
+			},
+		},
+		{
+			name: "uri already allowed, already exists error",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(oidcAppAdded()...),
+				),
+			},
+			args: args{
+				ctx:           context.Background(),
+				projectID:     "project1",
+				appID:         "app1",
+				uri:           "https://test.ch/logout",
+				resourceOwner: "org1",
+			},
+			res: res{
+				err: zerrors.IsErrorAlreadyExists,
+			},
+		},
+		{
+			name: "add uri, ok",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(oidcAppAdded()...),
+					expectPush(
+						postLogoutRedirectURIsChanged("https://test.ch/logout", "https://test.ch/signedout"),
+					),
+				),
+			},
+			args: args{
+				ctx:           context.Background(),
+				projectID:     "project1",
+				appID:         "app1",
+				uri:           "https://test.ch/signedout",
+				resourceOwner: "org1",
+			},
+			res: res{
+				want: &domain.ObjectDetails{
+					ResourceOwner: "org1",
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := &Commands{
+				eventstore: tt.fields.eventstore(t),
+			}
+			got, err := r.AddOIDCApplicationPostLogoutRedirectURI(tt.args.ctx, tt.args.projectID, tt.args.appID, tt.args.uri, tt.args.resourceOwner)
+			if tt.res.err == nil {
+				assert.NoError(t, err)
+			}
+			if tt.res.err != nil && !tt.res.err(err) {
+				t.Errorf("got wrong err: %v ", err)
+			}
+			if tt.res.err == nil {
+				assert.Equal(t, tt.res.want, got)
+			}
+		})
+	}
+}
+
+func TestCommandSide_RemoveOIDCApplicationPostLogoutRedirectURI(t *testing.T) {
+	type fields struct {
+		eventstore func(*testing.T) *eventstore.Eventstore
+	}
+	type args struct {
+		ctx           context.Context
+		projectID     string
+		appID         string
+		uri           string
+		resourceOwner string
+	}
+	type res struct {
+		want *domain.ObjectDetails
+		err  func(error) bool
+	}
+	oidcAppAdded := func() []eventstore.Event {
+		return []eventstore.Event{
+			eventFromEventPusher(
+				project.NewApplicationAddedEvent(context.Background(),
+					&project.NewAggregate("project1", "org1").Aggregate,
+					"app1",
+					"app",
+				),
+			),
+			eventFromEventPusher(
+				project.NewOIDCConfigAddedEvent(context.Background(),
+					&project.NewAggregate("project1", "org1").Aggregate,
+					domain.OIDCVersionV1,
+					"app1",
+					"client1@project",
+					"secret",
+					[]string{"https://test.ch"},
+					[]domain.OIDCResponseType{domain.OIDCResponseTypeCode},
+					[]domain.OIDCGrantType{domain.OIDCGrantTypeAuthorizationCode},
+					domain.OIDCApplicationTypeWeb,
+					domain.OIDCAuthMethodTypePost,
+					[]string{"https://test.ch/logout"},
+					true,
+					domain.OIDCTokenTypeBearer,
+					true,
+					true,
+					true,
+					time.Second*1,
+					[]string{"https://sub.test.ch"},
+					false,
+					"",
+					"",
+				),
+			),
+		}
+	}
+	postLogoutRedirectURIsChanged := func(uris ...string) *project.OIDCConfigChangedEvent {
+		event, _ := project.NewOIDCConfigChangedEvent(context.Background(),
+			&project.NewAggregate("project1", "org1").Aggregate,
+			"app1",
+			[]project.OIDCConfigChanges{
+				project.ChangePostLogoutRedirectURIs(uris),
+			},
+		)
+		return event
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		args   args
+		res    res
+	}{
+		{
+			name: "empty uri, invalid argument error",
+			fields: fields{
+				eventstore: expectEventstore(),
+			},
+			args: args{
+				ctx:           context.Background(),
+				projectID:     "project1",
+				appID:         "app1",
+				uri:           "",
+				resourceOwner: "org1",
+			},
+			res: res{
+				err: zerrors.IsErrorInvalidArgument,
+			},
+		},
+		{
+			name: "uri not allowed, not found error",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(oidcAppAdded()...),
+				),
+			},
+			args: args{
+				ctx:           context.Background(),
+				projectID:     "project1",
+				appID:         "app1",
+				uri:           "https://test.ch/signedout",
+				resourceOwner: "org1",
+			},
+			res: res{
+				err: zerrors.IsNotFound,
+			},
+		},
+		{
+			name: "remove uri, ok",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(oidcAppAdded()...),
+					expectPush(
+						postLogoutRedirectURIsChanged([]string{}...),
+					),
+				),
+			},
+			args: args{
+				ctx:           context.Background(),
+				projectID:     "project1",
+				appID:         "app1",
+				uri:           "https://test.ch/logout",
+				resourceOwner: "org1",
+			},
+			res: res{
+				want: &domain.ObjectDetails{
+					ResourceOwner: "org1",
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := &Commands{
+				eventstore: tt.fields.eventstore(t),
+			}
+			got, err := r.RemoveOIDCApplicationPostLogoutRedirectURI(tt.args.ctx, tt.args.projectID, tt.args.appID, tt.args.uri, tt.args.resourceOwner)
+			if tt.res.err == nil {
+				assert.NoError(t, err)
+			}
+			if tt.res.err != nil && !tt.res.err(err) {
+				t.Errorf("got wrong err: %v ", err)
+			}
+			if tt.res.err == nil {
+				assert.Equal(t, tt.res.want, got)
+			}
+		})
+	}
+}
+
+func newOIDCAppChangedEvent(ctx context.Context, appID, projectID, resourceOwner string) *project.OIDCConfigChangedEvent {
+	changes := []project.OIDCConfigChanges{
+		project.ChangeRedirectURIs([]string{"https://test-change.ch"}),
+		project.ChangePostLogoutRedirectURIs([]string{"https://test-change.ch/logout"}),
+		project.ChangeDevMode(true),
+		project.ChangeAccessTokenType(domain.OIDCTokenTypeJWT),
+		project.ChangeAccessTokenRoleAssertion(false),
+		project.ChangeIDTokenRoleAssertion(false),
+		project.ChangeIDTokenUserinfoAssertion(false),
+		project.ChangeClockSkew(time.Second * 2),
+	}
+	event, _ := project.NewOIDCConfigChangedEvent(ctx,
+		&project.NewAggregate(projectID, resourceOwner).Aggregate,
+		appID,
+		changes,
+	)
+	return event
+}
+
+func TestCommands_VerifyOIDCClientSecret(t *testing.T) {
+	hasher := &crypto.Hasher{
+		Swapper: passwap.NewSwapper(bcrypt.New(bcrypt.MinCost)),
+	}
+	hashedSecret, err := hasher.Hash("secret")
+	require.NoError(t, err)
+	agg := project.NewAggregate("projectID", "orgID")
+
+	tests := []struct {
+		name       string
+		secret     string
+		eventstore func(*testing.T) *eventstore.Eventstore
+		wantErr    error
+	}{
+		{
+			name: "filter error",
+			eventstore: expectEventstore(
+				expectFilterError(io.ErrClosedPipe),
+			),
+			wantErr: io.ErrClosedPipe,
+		},
+		{
+			name: "app not exists",
+			eventstore: expectEventstore(
+				expectFilter(),
+			),
+			wantErr: zerrors.ThrowPreconditionFailed(nil, "COMMAND-D8hba", "Errors.Project.App.NotExisting"),
+		},
+		{
+			name: "wrong app type",
+			eventstore: expectEventstore(
+				expectFilter(
+					eventFromEventPusher(
+						project.NewApplicationAddedEvent(context.Background(), &agg.Aggregate, "appID", "appName"),
+					),
+				),
+			),
+			wantErr: zerrors.ThrowInvalidArgument(nil, "COMMAND-BHgn2", "Errors.Project.App.IsNotOIDC"),
+		},
+		{
+			name: "no secret set",
 			eventstore: expectEventstore(
 				expectFilter(
 					eventFromEventPusher(
@@ -1325,6 +2228,8 @@ func TestCommands_VerifyOIDCClientSecret(t *testing.T) {
 							time.Second*1,
 							[]string{"https://sub.test.ch"},
 							false,
+							"",
+							"",
 						),
 					),
 				),
@@ -1360,6 +2265,8 @@ func TestCommands_VerifyOIDCClientSecret(t *testing.T) {
 							time.Second*1,
 							[]string{"https://sub.test.ch"},
 							false,
+							"",
+							"",
 						),
 					),
 				),
@@ -1397,6 +2304,8 @@ func TestCommands_VerifyOIDCClientSecret(t *testing.T) {
 							time.Second*1,
 							[]string{"https://sub.test.ch"},
 							false,
+							"",
+							"",
 						),
 					),
 				),
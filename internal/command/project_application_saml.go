@@ -136,6 +136,37 @@ func (c *Commands) ChangeSAMLApplication(ctx context.Context, samlApp *domain.SA
 	return samlWriteModelToSAMLConfig(existingSAML), nil
 }
 
+// SetSAMLApplicationAttributes replaces the attribute statements which are added
+// to the SAML responses of the application in addition to the default attributes.
+// An empty list removes them.
+func (c *Commands) SetSAMLApplicationAttributes(ctx context.Context, projectID, appID string, attributes []*domain.SAMLAttribute, resourceOwner string) (*domain.ObjectDetails, error) {
+	if projectID == "" || appID == "" {
+		return nil, zerrors.ThrowInvalidArgument(nil, "COMMAND-Sa1mi", "Errors.IDMissing")
+	}
+	if !domain.SAMLAttributesValid(attributes) {
+		return nil, zerrors.ThrowInvalidArgument(nil, "COMMAND-Sa2nv", "Errors.Project.App.SAMLAttributesInvalid")
+	}
+
+	existingSAML, err := c.getSAMLAppWriteModel(ctx, projectID, appID, resourceOwner)
+	if err != nil {
+		return nil, err
+	}
+	if existingSAML.State == domain.AppStateUnspecified || existingSAML.State == domain.AppStateRemoved {
+		return nil, zerrors.ThrowNotFound(nil, "COMMAND-Sa3ne", "Errors.Project.App.NotExisting")
+	}
+	if !existingSAML.IsSAML() {
+		return nil, zerrors.ThrowInvalidArgument(nil, "COMMAND-Sa4is", "Errors.Project.App.IsNotSAML")
+	}
+	if domain.SAMLAttributesEqual(existingSAML.Attributes, attributes) {
+		return nil, zerrors.ThrowPreconditionFailed(nil, "COMMAND-Sa5nc", "Errors.NoChangesFound")
+	}
+	projectAgg := ProjectAggregateFromWriteModel(&existingSAML.WriteModel)
+	if err = c.pushAppendAndReduce(ctx, existingSAML, project.NewSAMLAttributesSetEvent(ctx, projectAgg, appID, attributes)); err != nil {
+		return nil, err
+	}
+	return writeModelToObjectDetails(&existingSAML.WriteModel), nil
+}
+
 func (c *Commands) getSAMLAppWriteModel(ctx context.Context, projectID, appID, resourceOwner string) (*SAMLApplicationWriteModel, error) {
 	appWriteModel := NewSAMLApplicationWriteModelWithAppID(projectID, appID, resourceOwner)
 	err := c.eventstore.FilterToQueryReducer(ctx, appWriteModel)
@@ -17,6 +17,7 @@ type SAMLApplicationWriteModel struct {
 	EntityID    string
 	Metadata    []byte
 	MetadataURL string
+	Attributes  []*domain.SAMLAttribute
 
 	State domain.AppState
 	saml  bool
@@ -79,6 +80,11 @@ func (wm *SAMLApplicationWriteModel) AppendEvents(events ...eventstore.Event) {
 				continue
 			}
 			wm.WriteModel.AppendEvents(e)
+		case *project.SAMLAttributesSetEvent:
+			if e.AppID != wm.AppID {
+				continue
+			}
+			wm.WriteModel.AppendEvents(e)
 		case *project.ProjectRemovedEvent:
 			wm.WriteModel.AppendEvents(e)
 		}
@@ -109,6 +115,8 @@ func (wm *SAMLApplicationWriteModel) Reduce() error {
 			wm.appendAddSAMLEvent(e)
 		case *project.SAMLConfigChangedEvent:
 			wm.appendChangeSAMLEvent(e)
+		case *project.SAMLAttributesSetEvent:
+			wm.Attributes = e.Attributes
 		case *project.ProjectRemovedEvent:
 			wm.State = domain.AppStateRemoved
 		}
@@ -150,6 +158,7 @@ func (wm *SAMLApplicationWriteModel) Query() *eventstore.SearchQueryBuilder {
 			project.ApplicationRemovedType,
 			project.SAMLConfigAddedType,
 			project.SAMLConfigChangedType,
+			project.SAMLAttributesSetType,
 			project.ProjectRemovedType).
 		Builder()
 }
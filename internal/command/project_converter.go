@@ -47,6 +47,8 @@ func oidcWriteModelToOIDCConfig(writeModel *OIDCApplicationWriteModel) *domain.O
 		ClockSkew:                writeModel.ClockSkew,
 		AdditionalOrigins:        writeModel.AdditionalOrigins,
 		SkipNativeAppSuccessPage: writeModel.SkipNativeAppSuccessPage,
+		TLSClientAuthSubjectDN:   writeModel.TLSClientAuthSubjectDN,
+		TLSClientAuthThumbprint:  writeModel.TLSClientAuthThumbprint,
 	}
 }
 
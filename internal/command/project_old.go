@@ -38,6 +38,9 @@ func (c *Commands) changeProjectOld(ctx context.Context, projectChange *domain.P
 	if existingProject.State == domain.ProjectStateUnspecified || existingProject.State == domain.ProjectStateRemoved {
 		return nil, zerrors.ThrowNotFound(nil, "COMMAND-3M9sd", "Errors.Project.NotFound")
 	}
+	if err = checkExpectedSequence(&existingProject.WriteModel, projectChange.ExpectedSequence); err != nil {
+		return nil, err
+	}
 
 	//nolint: contextcheck
 	projectAgg := ProjectAggregateFromWriteModel(&existingProject.WriteModel)
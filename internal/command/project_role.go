@@ -97,6 +97,9 @@ func (c *Commands) ChangeProjectRole(ctx context.Context, projectRole *domain.Pr
 	if existingRole.State == domain.ProjectRoleStateUnspecified || existingRole.State == domain.ProjectRoleStateRemoved {
 		return nil, zerrors.ThrowNotFound(nil, "COMMAND-vv8M9", "Errors.Project.Role.NotExisting")
 	}
+	if err = checkExpectedSequence(&existingRole.WriteModel, projectRole.ExpectedSequence); err != nil {
+		return nil, err
+	}
 
 	projectAgg := ProjectAggregateFromWriteModel(&existingRole.WriteModel)
 
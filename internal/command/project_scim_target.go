@@ -0,0 +1,167 @@
+package command
+
+import (
+	"context"
+	"net/url"
+	"reflect"
+
+	"github.com/zitadel/zitadel/internal/crypto"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/repository/project"
+	"github.com/zitadel/zitadel/internal/telemetry/tracing"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+// SCIMTarget is a downstream application the users granted on the project are provisioned to with SCIM 2.0
+type SCIMTarget struct {
+	Name string
+	// Endpoint is the base URL of the SCIM API of the application, e.g. https://api.slack.com/scim/v2
+	Endpoint string
+	// Token is the bearer token used to authenticate at the SCIM API.
+	// On changes, an empty token keeps the existing one.
+	Token   string
+	Mapping domain.SCIMProvisioningMapping
+}
+
+func (t *SCIMTarget) IsValid(requireToken bool) error {
+	if t.Name == "" {
+		return zerrors.ThrowInvalidArgument(nil, "COMMAND-Sc1nm", "Errors.Project.SCIMTarget.NameMissing")
+	}
+	parsed, err := url.Parse(t.Endpoint)
+	if err != nil || parsed.Scheme != "https" || parsed.Host == "" {
+		return zerrors.ThrowInvalidArgument(err, "COMMAND-Sc2ep", "Errors.Project.SCIMTarget.EndpointInvalid")
+	}
+	if requireToken && t.Token == "" {
+		return zerrors.ThrowInvalidArgument(nil, "COMMAND-Sc3tk", "Errors.Project.SCIMTarget.TokenMissing")
+	}
+	if !t.Mapping.Valid() {
+		return zerrors.ThrowInvalidArgument(nil, "COMMAND-Sc4mp", "Errors.Project.SCIMTarget.MappingInvalid")
+	}
+	return nil
+}
+
+// AddProjectSCIMTarget adds a downstream application, the users granted on the project are provisioned to.
+// All users already granted are provisioned after the target was added.
+func (c *Commands) AddProjectSCIMTarget(ctx context.Context, projectID, resourceOwner string, target *SCIMTarget) (id string, _ *domain.ObjectDetails, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	if projectID == "" || resourceOwner == "" {
+		return "", nil, zerrors.ThrowInvalidArgument(nil, "COMMAND-Sc5pi", "Errors.IDMissing")
+	}
+	if err = target.IsValid(true); err != nil {
+		return "", nil, err
+	}
+	writeModel, err := c.getProjectSCIMTargetsWriteModel(ctx, projectID, resourceOwner)
+	if err != nil {
+		return "", nil, err
+	}
+	token, err := crypto.Encrypt([]byte(target.Token), c.targetEncryption)
+	if err != nil {
+		return "", nil, err
+	}
+	id, err = c.idGenerator.Next()
+	if err != nil {
+		return "", nil, err
+	}
+	err = c.pushAppendAndReduce(ctx, writeModel, project.NewSCIMTargetAddedEvent(
+		ctx,
+		ProjectAggregateFromWriteModel(&writeModel.WriteModel),
+		id,
+		target.Name,
+		target.Endpoint,
+		token,
+		target.Mapping,
+	))
+	if err != nil {
+		return "", nil, err
+	}
+	return id, writeModelToObjectDetails(&writeModel.WriteModel), nil
+}
+
+// ChangeProjectSCIMTarget changes the SCIM target, the token is only replaced if a new one is passed
+func (c *Commands) ChangeProjectSCIMTarget(ctx context.Context, projectID, resourceOwner, id string, target *SCIMTarget) (_ *domain.ObjectDetails, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	if projectID == "" || resourceOwner == "" || id == "" {
+		return nil, zerrors.ThrowInvalidArgument(nil, "COMMAND-Sc6pi", "Errors.IDMissing")
+	}
+	if err = target.IsValid(false); err != nil {
+		return nil, err
+	}
+	writeModel, err := c.getProjectSCIMTargetsWriteModel(ctx, projectID, resourceOwner)
+	if err != nil {
+		return nil, err
+	}
+	existing := writeModel.ActiveTarget(id)
+	if existing == nil {
+		return nil, zerrors.ThrowNotFound(nil, "COMMAND-Sc7nf", "Errors.Project.SCIMTarget.NotFound")
+	}
+	changes := make([]project.SCIMTargetChanges, 0, 4)
+	if existing.Name != target.Name {
+		changes = append(changes, project.ChangeSCIMTargetName(target.Name))
+	}
+	if existing.Endpoint != target.Endpoint {
+		changes = append(changes, project.ChangeSCIMTargetEndpoint(target.Endpoint))
+	}
+	if target.Token != "" {
+		token, err := crypto.Encrypt([]byte(target.Token), c.targetEncryption)
+		if err != nil {
+			return nil, err
+		}
+		changes = append(changes, project.ChangeSCIMTargetToken(token))
+	}
+	if !reflect.DeepEqual(existing.Mapping, target.Mapping) {
+		changes = append(changes, project.ChangeSCIMTargetMapping(target.Mapping))
+	}
+	if len(changes) == 0 {
+		return nil, zerrors.ThrowPreconditionFailed(nil, "COMMAND-Sc8nc", "Errors.NoChangesFound")
+	}
+	changedEvent, err := project.NewSCIMTargetChangedEvent(ctx, ProjectAggregateFromWriteModel(&writeModel.WriteModel), id, changes)
+	if err != nil {
+		return nil, err
+	}
+	if err = c.pushAppendAndReduce(ctx, writeModel, changedEvent); err != nil {
+		return nil, err
+	}
+	return writeModelToObjectDetails(&writeModel.WriteModel), nil
+}
+
+// RemoveProjectSCIMTarget removes the SCIM target, the users are no longer provisioned to it.
+// Users already provisioned are not removed from the application.
+func (c *Commands) RemoveProjectSCIMTarget(ctx context.Context, projectID, resourceOwner, id string) (_ *domain.ObjectDetails, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	if projectID == "" || resourceOwner == "" || id == "" {
+		return nil, zerrors.ThrowInvalidArgument(nil, "COMMAND-Sc9pi", "Errors.IDMissing")
+	}
+	writeModel, err := c.getProjectSCIMTargetsWriteModel(ctx, projectID, resourceOwner)
+	if err != nil {
+		return nil, err
+	}
+	if writeModel.ActiveTarget(id) == nil {
+		return nil, zerrors.ThrowNotFound(nil, "COMMAND-Sc0nf", "Errors.Project.SCIMTarget.NotFound")
+	}
+	err = c.pushAppendAndReduce(ctx, writeModel, project.NewSCIMTargetRemovedEvent(
+		ctx,
+		ProjectAggregateFromWriteModel(&writeModel.WriteModel),
+		id,
+	))
+	if err != nil {
+		return nil, err
+	}
+	return writeModelToObjectDetails(&writeModel.WriteModel), nil
+}
+
+func (c *Commands) getProjectSCIMTargetsWriteModel(ctx context.Context, projectID, resourceOwner string) (*ProjectSCIMTargetsWriteModel, error) {
+	writeModel := NewProjectSCIMTargetsWriteModel(projectID, resourceOwner)
+	if err := c.eventstore.FilterToQueryReducer(ctx, writeModel); err != nil {
+		return nil, err
+	}
+	if writeModel.ProjectState != domain.ProjectStateActive {
+		return nil, zerrors.ThrowPreconditionFailed(nil, "COMMAND-Sc1pf", "Errors.Project.NotFound")
+	}
+	return writeModel, nil
+}
@@ -0,0 +1,102 @@
+package command
+
+import (
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/repository/project"
+)
+
+type ProjectSCIMTargetsWriteModel struct {
+	eventstore.WriteModel
+
+	ProjectState domain.ProjectState
+	Targets      []*SCIMTargetWriteModel
+}
+
+type SCIMTargetWriteModel struct {
+	ID       string
+	Name     string
+	Endpoint string
+	Mapping  domain.SCIMProvisioningMapping
+	State    domain.SCIMTargetState
+}
+
+func NewProjectSCIMTargetsWriteModel(projectID, resourceOwner string) *ProjectSCIMTargetsWriteModel {
+	return &ProjectSCIMTargetsWriteModel{
+		WriteModel: eventstore.WriteModel{
+			AggregateID:   projectID,
+			ResourceOwner: resourceOwner,
+		},
+	}
+}
+
+func (wm *ProjectSCIMTargetsWriteModel) Reduce() error {
+	for _, event := range wm.Events {
+		switch e := event.(type) {
+		case *project.ProjectAddedEvent:
+			wm.ProjectState = domain.ProjectStateActive
+		case *project.ProjectRemovedEvent:
+			wm.ProjectState = domain.ProjectStateRemoved
+		case *project.SCIMTargetAddedEvent:
+			wm.Targets = append(wm.Targets, &SCIMTargetWriteModel{
+				ID:       e.ID,
+				Name:     e.Name,
+				Endpoint: e.Endpoint,
+				Mapping:  e.Mapping,
+				State:    domain.SCIMTargetStateActive,
+			})
+		case *project.SCIMTargetChangedEvent:
+			target := wm.target(e.ID)
+			if target == nil {
+				continue
+			}
+			if e.Name != nil {
+				target.Name = *e.Name
+			}
+			if e.Endpoint != nil {
+				target.Endpoint = *e.Endpoint
+			}
+			if e.Mapping != nil {
+				target.Mapping = *e.Mapping
+			}
+		case *project.SCIMTargetRemovedEvent:
+			if target := wm.target(e.ID); target != nil {
+				target.State = domain.SCIMTargetStateRemoved
+			}
+		}
+	}
+	return wm.WriteModel.Reduce()
+}
+
+func (wm *ProjectSCIMTargetsWriteModel) Query() *eventstore.SearchQueryBuilder {
+	return eventstore.NewSearchQueryBuilder(eventstore.ColumnsEvent).
+		ResourceOwner(wm.ResourceOwner).
+		AddQuery().
+		AggregateTypes(project.AggregateType).
+		AggregateIDs(wm.AggregateID).
+		EventTypes(
+			project.ProjectAddedType,
+			project.ProjectRemovedType,
+			project.SCIMTargetAddedType,
+			project.SCIMTargetChangedType,
+			project.SCIMTargetRemovedType).
+		Builder()
+}
+
+func (wm *ProjectSCIMTargetsWriteModel) target(id string) *SCIMTargetWriteModel {
+	for _, target := range wm.Targets {
+		if target.ID == id {
+			return target
+		}
+	}
+	return nil
+}
+
+// ActiveTarget returns the active SCIM target with the passed id, nil if there is none
+func (wm *ProjectSCIMTargetsWriteModel) ActiveTarget(id string) *SCIMTargetWriteModel {
+	target := wm.target(id)
+	if target == nil || target.State != domain.SCIMTargetStateActive {
+		return nil
+	}
+	return target
+}
@@ -0,0 +1,460 @@
+package command
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/zitadel/zitadel/internal/crypto"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/id"
+	id_mock "github.com/zitadel/zitadel/internal/id/mock"
+	"github.com/zitadel/zitadel/internal/repository/project"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+const testSCIMEndpoint = "https://api.slack.com/scim/v2"
+
+func testSCIMProjectAddedEvent() *project.ProjectAddedEvent {
+	return project.NewProjectAddedEvent(context.Background(),
+		&project.NewAggregate("project1", "org1").Aggregate,
+		"project", true, true, true,
+		domain.PrivateLabelingSettingUnspecified,
+	)
+}
+
+func testSCIMTargetAddedEvent() *project.SCIMTargetAddedEvent {
+	return project.NewSCIMTargetAddedEvent(context.Background(),
+		&project.NewAggregate("project1", "org1").Aggregate,
+		"target1",
+		"slack",
+		testSCIMEndpoint,
+		&crypto.CryptoValue{
+			CryptoType: crypto.TypeEncryption,
+			Algorithm:  "enc",
+			KeyID:      "id",
+			Crypted:    []byte("token"),
+		},
+		domain.SCIMProvisioningMapping{Groups: true},
+	)
+}
+
+func testSCIMTargetChangedEvent(t *testing.T, changes ...project.SCIMTargetChanges) *project.SCIMTargetChangedEvent {
+	event, err := project.NewSCIMTargetChangedEvent(context.Background(),
+		&project.NewAggregate("project1", "org1").Aggregate,
+		"target1",
+		changes,
+	)
+	require.NoError(t, err)
+	return event
+}
+
+func TestCommands_AddProjectSCIMTarget(t *testing.T) {
+	type fields struct {
+		eventstore  *eventstore.Eventstore
+		idGenerator id.Generator
+	}
+	type args struct {
+		target *SCIMTarget
+	}
+	type res struct {
+		id   string
+		want *domain.ObjectDetails
+		err  func(error) bool
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		args   args
+		res    res
+	}{
+		{
+			name: "missing name, error",
+			fields: fields{
+				eventstore: eventstoreExpect(t),
+			},
+			args: args{
+				target: &SCIMTarget{
+					Endpoint: testSCIMEndpoint,
+					Token:    "token",
+				},
+			},
+			res: res{
+				err: zerrors.IsErrorInvalidArgument,
+			},
+		},
+		{
+			name: "insecure endpoint, error",
+			fields: fields{
+				eventstore: eventstoreExpect(t),
+			},
+			args: args{
+				target: &SCIMTarget{
+					Name:     "slack",
+					Endpoint: "http://api.slack.com/scim/v2",
+					Token:    "token",
+				},
+			},
+			res: res{
+				err: zerrors.IsErrorInvalidArgument,
+			},
+		},
+		{
+			name: "missing token, error",
+			fields: fields{
+				eventstore: eventstoreExpect(t),
+			},
+			args: args{
+				target: &SCIMTarget{
+					Name:     "slack",
+					Endpoint: testSCIMEndpoint,
+				},
+			},
+			res: res{
+				err: zerrors.IsErrorInvalidArgument,
+			},
+		},
+		{
+			name: "invalid mapping, error",
+			fields: fields{
+				eventstore: eventstoreExpect(t),
+			},
+			args: args{
+				target: &SCIMTarget{
+					Name:     "slack",
+					Endpoint: testSCIMEndpoint,
+					Token:    "token",
+					Mapping: domain.SCIMProvisioningMapping{
+						Attributes: []domain.SCIMAttributeMapping{{MetadataKey: "id", Attribute: "id"}},
+					},
+				},
+			},
+			res: res{
+				err: zerrors.IsErrorInvalidArgument,
+			},
+		},
+		{
+			name: "project not found, error",
+			fields: fields{
+				eventstore: eventstoreExpect(t,
+					expectFilter(),
+				),
+			},
+			args: args{
+				target: &SCIMTarget{
+					Name:     "slack",
+					Endpoint: testSCIMEndpoint,
+					Token:    "token",
+				},
+			},
+			res: res{
+				err: zerrors.IsPreconditionFailed,
+			},
+		},
+		{
+			name: "add scim target, ok",
+			fields: fields{
+				eventstore: eventstoreExpect(t,
+					expectFilter(
+						eventFromEventPusher(testSCIMProjectAddedEvent()),
+					),
+					expectPush(
+						testSCIMTargetAddedEvent(),
+					),
+				),
+				idGenerator: id_mock.NewIDGeneratorExpectIDs(t, "target1"),
+			},
+			args: args{
+				target: &SCIMTarget{
+					Name:     "slack",
+					Endpoint: testSCIMEndpoint,
+					Token:    "token",
+					Mapping:  domain.SCIMProvisioningMapping{Groups: true},
+				},
+			},
+			res: res{
+				id: "target1",
+				want: &domain.ObjectDetails{
+					ResourceOwner: "org1",
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &Commands{
+				eventstore:       tt.fields.eventstore,
+				idGenerator:      tt.fields.idGenerator,
+				targetEncryption: crypto.CreateMockEncryptionAlg(gomock.NewController(t)),
+			}
+			id, got, err := c.AddProjectSCIMTarget(context.Background(), "project1", "org1", tt.args.target)
+			if tt.res.err == nil {
+				assert.NoError(t, err)
+			}
+			if tt.res.err != nil && !tt.res.err(err) {
+				t.Errorf("got wrong err: %v ", err)
+			}
+			if tt.res.err == nil {
+				assert.Equal(t, tt.res.id, id)
+				assert.Equal(t, tt.res.want, got)
+			}
+		})
+	}
+}
+
+func TestCommands_ChangeProjectSCIMTarget(t *testing.T) {
+	type fields struct {
+		eventstore *eventstore.Eventstore
+	}
+	type args struct {
+		id     string
+		target *SCIMTarget
+	}
+	type res struct {
+		want *domain.ObjectDetails
+		err  func(error) bool
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		args   args
+		res    res
+	}{
+		{
+			name: "missing id, error",
+			fields: fields{
+				eventstore: eventstoreExpect(t),
+			},
+			args: args{
+				target: &SCIMTarget{
+					Name:     "slack",
+					Endpoint: testSCIMEndpoint,
+				},
+			},
+			res: res{
+				err: zerrors.IsErrorInvalidArgument,
+			},
+		},
+		{
+			name: "not found, error",
+			fields: fields{
+				eventstore: eventstoreExpect(t,
+					expectFilter(
+						eventFromEventPusher(testSCIMProjectAddedEvent()),
+					),
+				),
+			},
+			args: args{
+				id: "target1",
+				target: &SCIMTarget{
+					Name:     "slack",
+					Endpoint: testSCIMEndpoint,
+				},
+			},
+			res: res{
+				err: zerrors.IsNotFound,
+			},
+		},
+		{
+			name: "no changes, precondition error",
+			fields: fields{
+				eventstore: eventstoreExpect(t,
+					expectFilter(
+						eventFromEventPusher(testSCIMProjectAddedEvent()),
+						eventFromEventPusher(testSCIMTargetAddedEvent()),
+					),
+				),
+			},
+			args: args{
+				id: "target1",
+				target: &SCIMTarget{
+					Name:     "slack",
+					Endpoint: testSCIMEndpoint,
+					Mapping:  domain.SCIMProvisioningMapping{Groups: true},
+				},
+			},
+			res: res{
+				err: zerrors.IsPreconditionFailed,
+			},
+		},
+		{
+			name: "change name and mapping, ok",
+			fields: fields{
+				eventstore: eventstoreExpect(t,
+					expectFilter(
+						eventFromEventPusher(testSCIMProjectAddedEvent()),
+						eventFromEventPusher(testSCIMTargetAddedEvent()),
+					),
+					expectPush(
+						testSCIMTargetChangedEvent(t,
+							project.ChangeSCIMTargetName("github"),
+							project.ChangeSCIMTargetMapping(domain.SCIMProvisioningMapping{UserName: domain.SCIMUserNameSourceEmail}),
+						),
+					),
+				),
+			},
+			args: args{
+				id: "target1",
+				target: &SCIMTarget{
+					Name:     "github",
+					Endpoint: testSCIMEndpoint,
+					Mapping:  domain.SCIMProvisioningMapping{UserName: domain.SCIMUserNameSourceEmail},
+				},
+			},
+			res: res{
+				want: &domain.ObjectDetails{
+					ResourceOwner: "org1",
+				},
+			},
+		},
+		{
+			name: "change token, ok",
+			fields: fields{
+				eventstore: eventstoreExpect(t,
+					expectFilter(
+						eventFromEventPusher(testSCIMProjectAddedEvent()),
+						eventFromEventPusher(testSCIMTargetAddedEvent()),
+					),
+					expectPush(
+						testSCIMTargetChangedEvent(t,
+							project.ChangeSCIMTargetToken(&crypto.CryptoValue{
+								CryptoType: crypto.TypeEncryption,
+								Algorithm:  "enc",
+								KeyID:      "id",
+								Crypted:    []byte("rotated"),
+							}),
+						),
+					),
+				),
+			},
+			args: args{
+				id: "target1",
+				target: &SCIMTarget{
+					Name:     "slack",
+					Endpoint: testSCIMEndpoint,
+					Token:    "rotated",
+					Mapping:  domain.SCIMProvisioningMapping{Groups: true},
+				},
+			},
+			res: res{
+				want: &domain.ObjectDetails{
+					ResourceOwner: "org1",
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &Commands{
+				eventstore:       tt.fields.eventstore,
+				targetEncryption: crypto.CreateMockEncryptionAlg(gomock.NewController(t)),
+			}
+			got, err := c.ChangeProjectSCIMTarget(context.Background(), "project1", "org1", tt.args.id, tt.args.target)
+			if tt.res.err == nil {
+				assert.NoError(t, err)
+			}
+			if tt.res.err != nil && !tt.res.err(err) {
+				t.Errorf("got wrong err: %v ", err)
+			}
+			if tt.res.err == nil {
+				assert.Equal(t, tt.res.want, got)
+			}
+		})
+	}
+}
+
+func TestCommands_RemoveProjectSCIMTarget(t *testing.T) {
+	type fields struct {
+		eventstore *eventstore.Eventstore
+	}
+	type args struct {
+		id string
+	}
+	type res struct {
+		want *domain.ObjectDetails
+		err  func(error) bool
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		args   args
+		res    res
+	}{
+		{
+			name: "missing id, error",
+			fields: fields{
+				eventstore: eventstoreExpect(t),
+			},
+			res: res{
+				err: zerrors.IsErrorInvalidArgument,
+			},
+		},
+		{
+			name: "already removed, not found error",
+			fields: fields{
+				eventstore: eventstoreExpect(t,
+					expectFilter(
+						eventFromEventPusher(testSCIMProjectAddedEvent()),
+						eventFromEventPusher(testSCIMTargetAddedEvent()),
+						eventFromEventPusher(project.NewSCIMTargetRemovedEvent(context.Background(),
+							&project.NewAggregate("project1", "org1").Aggregate,
+							"target1",
+						)),
+					),
+				),
+			},
+			args: args{
+				id: "target1",
+			},
+			res: res{
+				err: zerrors.IsNotFound,
+			},
+		},
+		{
+			name: "remove scim target, ok",
+			fields: fields{
+				eventstore: eventstoreExpect(t,
+					expectFilter(
+						eventFromEventPusher(testSCIMProjectAddedEvent()),
+						eventFromEventPusher(testSCIMTargetAddedEvent()),
+					),
+					expectPush(
+						project.NewSCIMTargetRemovedEvent(context.Background(),
+							&project.NewAggregate("project1", "org1").Aggregate,
+							"target1",
+						),
+					),
+				),
+			},
+			args: args{
+				id: "target1",
+			},
+			res: res{
+				want: &domain.ObjectDetails{
+					ResourceOwner: "org1",
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &Commands{
+				eventstore: tt.fields.eventstore,
+			}
+			got, err := c.RemoveProjectSCIMTarget(context.Background(), "project1", "org1", tt.args.id)
+			if tt.res.err == nil {
+				assert.NoError(t, err)
+			}
+			if tt.res.err != nil && !tt.res.err(err) {
+				t.Errorf("got wrong err: %v ", err)
+			}
+			if tt.res.err == nil {
+				assert.Equal(t, tt.res.want, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,103 @@
+package command
+
+import (
+	"context"
+	"time"
+
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/repository/provisioning"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+// RequestSCIMSync requests the sync of the user to the SCIM target of the project.
+// The sync provisions the current state of the user, so nothing is requested as long as a sync is pending.
+func (c *Commands) RequestSCIMSync(ctx context.Context, projectID, resourceOwner, targetID, userID, reason string) error {
+	existing, err := c.getSCIMSyncWriteModel(ctx, projectID, resourceOwner, targetID, userID)
+	if err != nil {
+		return err
+	}
+	if existing.State == domain.SCIMSyncStatePending {
+		return nil
+	}
+	return c.pushAppendAndReduce(ctx, existing, provisioning.NewSyncRequestedEvent(ctx, ProvisioningAggregateFromWriteModel(&existing.WriteModel), userID, projectID, reason))
+}
+
+// RetrySCIMSync syncs the user to the SCIM target of the project again, e.g. after all attempts of the last sync failed.
+func (c *Commands) RetrySCIMSync(ctx context.Context, projectID, resourceOwner, targetID, userID string) (*domain.ObjectDetails, error) {
+	existing, err := c.getSCIMSyncWriteModel(ctx, projectID, resourceOwner, targetID, userID)
+	if err != nil {
+		return nil, err
+	}
+	if existing.State == domain.SCIMSyncStatePending {
+		return nil, zerrors.ThrowPreconditionFailed(nil, "COMMAND-Sy1pd", "Errors.Project.SCIMTarget.SyncPending")
+	}
+	if err = c.pushAppendAndReduce(ctx, existing, provisioning.NewSyncRequestedEvent(ctx, ProvisioningAggregateFromWriteModel(&existing.WriteModel), userID, projectID, "manual")); err != nil {
+		return nil, err
+	}
+	return writeModelToObjectDetails(&existing.WriteModel), nil
+}
+
+// SCIMSyncSucceeded marks the pending sync of the user as done,
+// the remote id is empty if the user was deprovisioned.
+func (c *Commands) SCIMSyncSucceeded(ctx context.Context, targetID, resourceOwner, userID, remoteID string) error {
+	existing, err := c.getPendingSCIMSyncWriteModel(ctx, targetID, resourceOwner, userID)
+	if err != nil {
+		return err
+	}
+	return c.pushAppendAndReduce(ctx, existing, provisioning.NewSyncSucceededEvent(ctx, ProvisioningAggregateFromWriteModel(&existing.WriteModel), userID, remoteID))
+}
+
+// SCIMSyncRetryScheduled schedules the next attempt of the pending sync of the user after a failed one.
+func (c *Commands) SCIMSyncRetryScheduled(ctx context.Context, targetID, resourceOwner, userID, reason string, nextAttempt time.Time) error {
+	existing, err := c.getPendingSCIMSyncWriteModel(ctx, targetID, resourceOwner, userID)
+	if err != nil {
+		return err
+	}
+	return c.pushAppendAndReduce(ctx, existing, provisioning.NewSyncRetryScheduledEvent(ctx, ProvisioningAggregateFromWriteModel(&existing.WriteModel), userID, reason, nextAttempt))
+}
+
+// SCIMSyncFailed stops the retries of the pending sync of the user after the last attempt failed.
+func (c *Commands) SCIMSyncFailed(ctx context.Context, targetID, resourceOwner, userID, reason string) error {
+	existing, err := c.getPendingSCIMSyncWriteModel(ctx, targetID, resourceOwner, userID)
+	if err != nil {
+		return err
+	}
+	return c.pushAppendAndReduce(ctx, existing, provisioning.NewSyncFailedEvent(ctx, ProvisioningAggregateFromWriteModel(&existing.WriteModel), userID, reason))
+}
+
+// getSCIMSyncWriteModel returns the sync of the user to the active SCIM target of the project
+func (c *Commands) getSCIMSyncWriteModel(ctx context.Context, projectID, resourceOwner, targetID, userID string) (*SCIMSyncWriteModel, error) {
+	if projectID == "" || resourceOwner == "" || targetID == "" || userID == "" {
+		return nil, zerrors.ThrowInvalidArgument(nil, "COMMAND-Sy2pi", "Errors.IDMissing")
+	}
+	targets, err := c.getProjectSCIMTargetsWriteModel(ctx, projectID, resourceOwner)
+	if err != nil {
+		return nil, err
+	}
+	if targets.ActiveTarget(targetID) == nil {
+		return nil, zerrors.ThrowNotFound(nil, "COMMAND-Sy3nf", "Errors.Project.SCIMTarget.NotFound")
+	}
+	return c.filterSCIMSyncWriteModel(ctx, targetID, resourceOwner, userID)
+}
+
+func (c *Commands) getPendingSCIMSyncWriteModel(ctx context.Context, targetID, resourceOwner, userID string) (*SCIMSyncWriteModel, error) {
+	if targetID == "" || resourceOwner == "" || userID == "" {
+		return nil, zerrors.ThrowInvalidArgument(nil, "COMMAND-Sy4pi", "Errors.IDMissing")
+	}
+	existing, err := c.filterSCIMSyncWriteModel(ctx, targetID, resourceOwner, userID)
+	if err != nil {
+		return nil, err
+	}
+	if existing.State != domain.SCIMSyncStatePending {
+		return nil, zerrors.ThrowPreconditionFailed(nil, "COMMAND-Sy5np", "Errors.Project.SCIMTarget.SyncNotPending")
+	}
+	return existing, nil
+}
+
+func (c *Commands) filterSCIMSyncWriteModel(ctx context.Context, targetID, resourceOwner, userID string) (*SCIMSyncWriteModel, error) {
+	wm := NewSCIMSyncWriteModel(targetID, resourceOwner, userID)
+	if err := c.eventstore.FilterToQueryReducer(ctx, wm); err != nil {
+		return nil, err
+	}
+	return wm, nil
+}
@@ -0,0 +1,62 @@
+package command
+
+import (
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/repository/provisioning"
+)
+
+// SCIMSyncWriteModel is the state of the sync of a user to a SCIM target
+type SCIMSyncWriteModel struct {
+	eventstore.WriteModel
+
+	UserID string
+	State  domain.SCIMSyncState
+}
+
+func NewSCIMSyncWriteModel(targetID, resourceOwner, userID string) *SCIMSyncWriteModel {
+	return &SCIMSyncWriteModel{
+		WriteModel: eventstore.WriteModel{
+			AggregateID:   targetID,
+			ResourceOwner: resourceOwner,
+		},
+		UserID: userID,
+	}
+}
+
+func (wm *SCIMSyncWriteModel) Reduce() error {
+	for _, event := range wm.Events {
+		switch event.(type) {
+		case *provisioning.SyncRequestedEvent,
+			*provisioning.SyncRetryScheduledEvent:
+			wm.State = domain.SCIMSyncStatePending
+		case *provisioning.SyncSucceededEvent:
+			wm.State = domain.SCIMSyncStateSynced
+		case *provisioning.SyncFailedEvent:
+			wm.State = domain.SCIMSyncStateFailed
+		}
+	}
+	return wm.WriteModel.Reduce()
+}
+
+func (wm *SCIMSyncWriteModel) Query() *eventstore.SearchQueryBuilder {
+	return eventstore.NewSearchQueryBuilder(eventstore.ColumnsEvent).
+		ResourceOwner(wm.ResourceOwner).
+		AddQuery().
+		AggregateTypes(provisioning.AggregateType).
+		AggregateIDs(wm.AggregateID).
+		EventTypes(
+			provisioning.SyncRequestedEventType,
+			provisioning.SyncSucceededEventType,
+			provisioning.SyncRetryScheduledEventType,
+			provisioning.SyncFailedEventType,
+		).
+		EventData(map[string]interface{}{
+			"userId": wm.UserID,
+		}).
+		Builder()
+}
+
+func ProvisioningAggregateFromWriteModel(wm *eventstore.WriteModel) *eventstore.Aggregate {
+	return eventstore.AggregateFromWriteModel(wm, provisioning.AggregateType, provisioning.AggregateVersion)
+}
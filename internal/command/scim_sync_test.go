@@ -0,0 +1,229 @@
+package command
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/repository/provisioning"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+func TestCommands_RequestSCIMSync(t *testing.T) {
+	ctx := context.Background()
+	agg := &provisioning.NewAggregate("target1", "org1", "").Aggregate
+	tests := []struct {
+		name       string
+		eventstore func(t *testing.T) *eventstore.Eventstore
+		wantErr    error
+	}{
+		{
+			name: "target not found, not found error",
+			eventstore: expectEventstore(
+				expectFilter(
+					eventFromEventPusher(testSCIMProjectAddedEvent()),
+				),
+			),
+			wantErr: zerrors.ThrowNotFound(nil, "COMMAND-Sy3nf", "Errors.Project.SCIMTarget.NotFound"),
+		},
+		{
+			name: "already pending, ok",
+			eventstore: expectEventstore(
+				expectFilter(
+					eventFromEventPusher(testSCIMProjectAddedEvent()),
+					eventFromEventPusher(testSCIMTargetAddedEvent()),
+				),
+				expectFilter(
+					eventFromEventPusher(provisioning.NewSyncRequestedEvent(ctx, agg, "user1", "project1", "user.grant.added")),
+				),
+			),
+		},
+		{
+			name: "requested, ok",
+			eventstore: expectEventstore(
+				expectFilter(
+					eventFromEventPusher(testSCIMProjectAddedEvent()),
+					eventFromEventPusher(testSCIMTargetAddedEvent()),
+				),
+				expectFilter(
+					eventFromEventPusher(provisioning.NewSyncRequestedEvent(ctx, agg, "user1", "project1", "user.grant.added")),
+					eventFromEventPusher(provisioning.NewSyncSucceededEvent(ctx, agg, "user1", "remote1")),
+				),
+				expectPush(
+					provisioning.NewSyncRequestedEvent(ctx, agg, "user1", "project1", "user.human.profile.changed"),
+				),
+			),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &Commands{
+				eventstore: tt.eventstore(t),
+			}
+			err := c.RequestSCIMSync(ctx, "project1", "org1", "target1", "user1", "user.human.profile.changed")
+			assert.ErrorIs(t, err, tt.wantErr)
+		})
+	}
+}
+
+func TestCommands_RetrySCIMSync(t *testing.T) {
+	ctx := context.Background()
+	agg := &provisioning.NewAggregate("target1", "org1", "").Aggregate
+	tests := []struct {
+		name       string
+		userID     string
+		eventstore func(t *testing.T) *eventstore.Eventstore
+		want       *domain.ObjectDetails
+		wantErr    error
+	}{
+		{
+			name:       "missing user id, invalid argument error",
+			eventstore: expectEventstore(),
+			wantErr:    zerrors.ThrowInvalidArgument(nil, "COMMAND-Sy2pi", "Errors.IDMissing"),
+		},
+		{
+			name:   "pending, precondition error",
+			userID: "user1",
+			eventstore: expectEventstore(
+				expectFilter(
+					eventFromEventPusher(testSCIMProjectAddedEvent()),
+					eventFromEventPusher(testSCIMTargetAddedEvent()),
+				),
+				expectFilter(
+					eventFromEventPusher(provisioning.NewSyncRequestedEvent(ctx, agg, "user1", "project1", "user.grant.added")),
+				),
+			),
+			wantErr: zerrors.ThrowPreconditionFailed(nil, "COMMAND-Sy1pd", "Errors.Project.SCIMTarget.SyncPending"),
+		},
+		{
+			name:   "failed, ok",
+			userID: "user1",
+			eventstore: expectEventstore(
+				expectFilter(
+					eventFromEventPusher(testSCIMProjectAddedEvent()),
+					eventFromEventPusher(testSCIMTargetAddedEvent()),
+				),
+				expectFilter(
+					eventFromEventPusher(provisioning.NewSyncRequestedEvent(ctx, agg, "user1", "project1", "user.grant.added")),
+					eventFromEventPusher(provisioning.NewSyncFailedEvent(ctx, agg, "user1", "unauthorized")),
+				),
+				expectPush(
+					provisioning.NewSyncRequestedEvent(ctx, agg, "user1", "project1", "manual"),
+				),
+			),
+			want: &domain.ObjectDetails{
+				ResourceOwner: "org1",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &Commands{
+				eventstore: tt.eventstore(t),
+			}
+			got, err := c.RetrySCIMSync(ctx, "project1", "org1", "target1", tt.userID)
+			assert.ErrorIs(t, err, tt.wantErr)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestCommands_SCIMSyncSucceeded(t *testing.T) {
+	ctx := context.Background()
+	agg := &provisioning.NewAggregate("target1", "org1", "").Aggregate
+	tests := []struct {
+		name       string
+		eventstore func(t *testing.T) *eventstore.Eventstore
+		wantErr    error
+	}{
+		{
+			name:       "not requested, precondition error",
+			eventstore: expectEventstore(expectFilter()),
+			wantErr:    zerrors.ThrowPreconditionFailed(nil, "COMMAND-Sy5np", "Errors.Project.SCIMTarget.SyncNotPending"),
+		},
+		{
+			name: "succeeded, ok",
+			eventstore: expectEventstore(
+				expectFilter(
+					eventFromEventPusher(provisioning.NewSyncRequestedEvent(ctx, agg, "user1", "project1", "user.grant.added")),
+					eventFromEventPusher(provisioning.NewSyncRetryScheduledEvent(ctx, agg, "user1", "unavailable", time.Now())),
+				),
+				expectPush(
+					provisioning.NewSyncSucceededEvent(ctx, agg, "user1", "remote1"),
+				),
+			),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &Commands{
+				eventstore: tt.eventstore(t),
+			}
+			err := c.SCIMSyncSucceeded(ctx, "target1", "org1", "user1", "remote1")
+			assert.ErrorIs(t, err, tt.wantErr)
+		})
+	}
+}
+
+func TestCommands_SCIMSyncRetryScheduled(t *testing.T) {
+	ctx := context.Background()
+	nextAttempt := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	agg := &provisioning.NewAggregate("target1", "org1", "").Aggregate
+	tests := []struct {
+		name       string
+		eventstore func(t *testing.T) *eventstore.Eventstore
+		wantErr    error
+	}{
+		{
+			name: "synced, precondition error",
+			eventstore: expectEventstore(
+				expectFilter(
+					eventFromEventPusher(provisioning.NewSyncRequestedEvent(ctx, agg, "user1", "project1", "user.grant.added")),
+					eventFromEventPusher(provisioning.NewSyncSucceededEvent(ctx, agg, "user1", "remote1")),
+				),
+			),
+			wantErr: zerrors.ThrowPreconditionFailed(nil, "COMMAND-Sy5np", "Errors.Project.SCIMTarget.SyncNotPending"),
+		},
+		{
+			name: "retry scheduled, ok",
+			eventstore: expectEventstore(
+				expectFilter(
+					eventFromEventPusher(provisioning.NewSyncRequestedEvent(ctx, agg, "user1", "project1", "user.grant.added")),
+				),
+				expectPush(
+					provisioning.NewSyncRetryScheduledEvent(ctx, agg, "user1", "unavailable", nextAttempt),
+				),
+			),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &Commands{
+				eventstore: tt.eventstore(t),
+			}
+			err := c.SCIMSyncRetryScheduled(ctx, "target1", "org1", "user1", "unavailable", nextAttempt)
+			assert.ErrorIs(t, err, tt.wantErr)
+		})
+	}
+}
+
+func TestCommands_SCIMSyncFailed(t *testing.T) {
+	ctx := context.Background()
+	agg := &provisioning.NewAggregate("target1", "org1", "").Aggregate
+	c := &Commands{
+		eventstore: expectEventstore(
+			expectFilter(
+				eventFromEventPusher(provisioning.NewSyncRequestedEvent(ctx, agg, "user1", "project1", "user.grant.added")),
+			),
+			expectPush(
+				provisioning.NewSyncFailedEvent(ctx, agg, "user1", "unauthorized"),
+			),
+		)(t),
+	}
+	err := c.SCIMSyncFailed(ctx, "target1", "org1", "user1", "unauthorized")
+	assert.NoError(t, err)
+}
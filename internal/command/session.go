@@ -5,6 +5,7 @@ import (
 	"context"
 	"encoding/base64"
 	"fmt"
+	"slices"
 	"time"
 
 	"github.com/zitadel/logging"
@@ -12,6 +13,7 @@ import (
 
 	"github.com/zitadel/zitadel/internal/activity"
 	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/captcha"
 	"github.com/zitadel/zitadel/internal/crypto"
 	"github.com/zitadel/zitadel/internal/domain"
 	"github.com/zitadel/zitadel/internal/eventstore"
@@ -38,6 +40,12 @@ type SessionCommands struct {
 	createCode  encryptedCodeWithDefaultFunc
 	createToken func(sessionID string) (id string, token string, err error)
 	now         func() time.Time
+
+	captchaAlg      crypto.EncryptionAlgorithm
+	captchaVerifier func(captchaType domain.CaptchaType, secret string) (captcha.Verifier, error)
+	captchaResponse string
+	captchaRemoteIP string
+	captchaVerified bool
 }
 
 func (c *Commands) NewSessionCommands(cmds []SessionCommand, session *SessionWriteModel) *SessionCommands {
@@ -52,6 +60,8 @@ func (c *Commands) NewSessionCommands(cmds []SessionCommand, session *SessionWri
 		createCode:        c.newEncryptedCodeWithDefault,
 		createToken:       c.sessionTokenCreator,
 		now:               time.Now,
+		captchaAlg:        c.captchaEncryption,
+		captchaVerifier:   newCaptchaVerifier,
 	}
 }
 
@@ -65,9 +75,22 @@ func CheckUser(id string, resourceOwner string, preferredLanguage *language.Tag)
 	}
 }
 
+// CheckCaptcha provides the response of a solved CAPTCHA challenge to the session update.
+// The response is verified by the checks and challenges which require it, if the captcha policy is enabled on them.
+func CheckCaptcha(response, remoteIP string) SessionCommand {
+	return func(ctx context.Context, cmd *SessionCommands) ([]eventstore.Command, error) {
+		cmd.captchaResponse = response
+		cmd.captchaRemoteIP = remoteIP
+		return nil, nil
+	}
+}
+
 // CheckPassword defines a password check to be executed for a session update
 func CheckPassword(password string) SessionCommand {
 	return func(ctx context.Context, cmd *SessionCommands) ([]eventstore.Command, error) {
+		if err := cmd.verifyCaptcha(ctx, domain.CaptchaEndpointSession); err != nil {
+			return nil, err
+		}
 		commands, err := checkPassword(ctx, cmd.sessionWriteModel.UserID, password, cmd.eventstore, cmd.hasher, nil)
 		if err != nil {
 			return commands, err
@@ -134,6 +157,42 @@ func CheckTOTP(code string) SessionCommand {
 	}
 }
 
+func newCaptchaVerifier(captchaType domain.CaptchaType, secret string) (captcha.Verifier, error) {
+	return captcha.New(captchaType, secret)
+}
+
+// verifyCaptcha verifies the provided CAPTCHA response, if the captcha policy of the user's organization
+// (or the instance) requires a challenge on the endpoint.
+// A response is verified only once per session update.
+func (s *SessionCommands) verifyCaptcha(ctx context.Context, endpoint domain.CaptchaEndpoint) error {
+	if s.captchaVerified {
+		return nil
+	}
+	policy, err := getCaptchaPolicy(ctx, s.sessionWriteModel.UserResourceOwner, s.eventstore.FilterToQueryReducer)
+	if err != nil {
+		return err
+	}
+	if policy == nil || !policy.toDomain().IsEnabledOn(endpoint) {
+		return nil
+	}
+	if s.captchaResponse == "" {
+		return zerrors.ThrowPreconditionFailed(nil, "COMMAND-Hq3mTz", "Errors.Captcha.Missing")
+	}
+	secret, err := crypto.DecryptString(policy.Secret, s.captchaAlg)
+	if err != nil {
+		return err
+	}
+	verifier, err := s.captchaVerifier(policy.Type, secret)
+	if err != nil {
+		return err
+	}
+	if err = verifier.Verify(ctx, s.captchaResponse, s.captchaRemoteIP); err != nil {
+		return err
+	}
+	s.captchaVerified = true
+	return nil
+}
+
 // Exec will execute the commands specified and returns an error on the first occurrence.
 // In case of an error there might be specific commands returned, e.g. a failed pw check will have to be stored.
 func (s *SessionCommands) Exec(ctx context.Context) ([]eventstore.Command, error) {
@@ -331,6 +390,98 @@ func (c *Commands) terminateSession(ctx context.Context, sessionID, sessionToken
 	return writeModelToObjectDetails(&sessionWriteModel.WriteModel), nil
 }
 
+// TerminateAllOtherSessions terminates all active sessions of the user checked on the passed session,
+// except the passed session itself.
+// The session token is required, unless the authenticated user terminates the own sessions
+// or is granted the "session.delete" permission.
+func (c *Commands) TerminateAllOtherSessions(ctx context.Context, sessionID, sessionToken string) (*domain.ObjectDetails, error) {
+	sessionWriteModel := NewSessionWriteModel(sessionID, authz.GetInstance(ctx).InstanceID())
+	if err := c.eventstore.FilterToQueryReducer(ctx, sessionWriteModel); err != nil {
+		return nil, err
+	}
+	if err := c.checkSessionTerminationPermission(ctx, sessionWriteModel, sessionToken); err != nil {
+		return nil, err
+	}
+	if err := sessionWriteModel.CheckIsActive(); err != nil {
+		return nil, err
+	}
+	if sessionWriteModel.UserID == "" {
+		return nil, zerrors.ThrowPreconditionFailed(nil, "COMMAND-Kd9wq", "Errors.Session.UserMissing")
+	}
+
+	sessionIDsWriteModel := NewUserSessionIDsWriteModel(sessionWriteModel.UserID, authz.GetInstance(ctx).InstanceID())
+	if err := c.eventstore.FilterToQueryReducer(ctx, sessionIDsWriteModel); err != nil {
+		return nil, err
+	}
+	otherSessionIDs := slices.DeleteFunc(sessionIDsWriteModel.SessionIDs, func(id string) bool {
+		return id == sessionID
+	})
+	if len(otherSessionIDs) == 0 {
+		return writeModelToObjectDetails(&sessionWriteModel.WriteModel), nil
+	}
+	sessionsWriteModel := NewUserSessionsWriteModel(authz.GetInstance(ctx).InstanceID(), otherSessionIDs...)
+	if err := c.eventstore.FilterToQueryReducer(ctx, sessionsWriteModel); err != nil {
+		return nil, err
+	}
+	aggregates := sessionsWriteModel.ActiveSessions(time.Now())
+	if len(aggregates) == 0 {
+		return writeModelToObjectDetails(&sessionWriteModel.WriteModel), nil
+	}
+	cmds := make([]eventstore.Command, len(aggregates))
+	for i, aggregate := range aggregates {
+		cmds[i] = session.NewTerminateEvent(ctx, aggregate)
+	}
+	pushedEvents, err := c.eventstore.Push(ctx, cmds...)
+	if err != nil {
+		return nil, err
+	}
+	return pushedEventsToObjectDetails(pushedEvents), nil
+}
+
+// TerminateLinkedSessions terminates all active sessions created on the same user agent as the passed session,
+// so the user is logged out of the browser completely.
+// The passed session itself has to be terminated by the caller.
+func (c *Commands) TerminateLinkedSessions(ctx context.Context, sessionID string) (*domain.ObjectDetails, error) {
+	sessionWriteModel := NewSessionWriteModel(sessionID, authz.GetInstance(ctx).InstanceID())
+	if err := c.eventstore.FilterToQueryReducer(ctx, sessionWriteModel); err != nil {
+		return nil, err
+	}
+	if sessionWriteModel.State == domain.SessionStateUnspecified {
+		return nil, zerrors.ThrowNotFound(nil, "COMMAND-Ls4nd", "Errors.Session.NotExisting")
+	}
+	fingerprintID := sessionWriteModel.UserAgent.GetFingerprintID()
+	if fingerprintID == "" {
+		return writeModelToObjectDetails(&sessionWriteModel.WriteModel), nil
+	}
+	sessionIDsWriteModel := NewUserAgentSessionIDsWriteModel(fingerprintID, authz.GetInstance(ctx).InstanceID())
+	if err := c.eventstore.FilterToQueryReducer(ctx, sessionIDsWriteModel); err != nil {
+		return nil, err
+	}
+	linkedSessionIDs := slices.DeleteFunc(sessionIDsWriteModel.SessionIDs, func(id string) bool {
+		return id == sessionID
+	})
+	if len(linkedSessionIDs) == 0 {
+		return writeModelToObjectDetails(&sessionWriteModel.WriteModel), nil
+	}
+	sessionsWriteModel := NewUserSessionsWriteModel(authz.GetInstance(ctx).InstanceID(), linkedSessionIDs...)
+	if err := c.eventstore.FilterToQueryReducer(ctx, sessionsWriteModel); err != nil {
+		return nil, err
+	}
+	aggregates := sessionsWriteModel.ActiveSessions(time.Now())
+	if len(aggregates) == 0 {
+		return writeModelToObjectDetails(&sessionWriteModel.WriteModel), nil
+	}
+	cmds := make([]eventstore.Command, len(aggregates))
+	for i, aggregate := range aggregates {
+		cmds[i] = session.NewTerminateEvent(ctx, aggregate)
+	}
+	pushedEvents, err := c.eventstore.Push(ctx, cmds...)
+	if err != nil {
+		return nil, err
+	}
+	return pushedEventsToObjectDetails(pushedEvents), nil
+}
+
 // updateSession execute the [SessionCommands] where new events will be created and as well as for metadata (changes)
 func (c *Commands) updateSession(ctx context.Context, checks *SessionCommands, metadata map[string][]byte, lifetime time.Duration) (set *SessionChanged, err error) {
 	if err = checks.sessionWriteModel.CheckNotInvalidated(); err != nil {
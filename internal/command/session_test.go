@@ -16,6 +16,7 @@ import (
 	"golang.org/x/text/language"
 
 	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/captcha"
 	"github.com/zitadel/zitadel/internal/crypto"
 	"github.com/zitadel/zitadel/internal/domain"
 	"github.com/zitadel/zitadel/internal/eventstore"
@@ -530,6 +531,8 @@ func TestCommands_updateSession(t *testing.T) {
 			"set user, invalid password",
 			fields{
 				eventstore: expectEventstore(
+					expectFilter(), // org captcha policy
+					expectFilter(), // instance captcha policy
 					expectFilter(
 						eventFromEventPusher(
 							user.NewHumanAddedEvent(context.Background(), &user.NewAggregate("userID", "org1").Aggregate,
@@ -579,6 +582,8 @@ func TestCommands_updateSession(t *testing.T) {
 			"set user, password, metadata and token",
 			fields{
 				eventstore: expectEventstore(
+					expectFilter(), // org captcha policy
+					expectFilter(), // instance captcha policy
 					expectFilter(
 						eventFromEventPusher(
 							user.NewHumanAddedEvent(context.Background(), &user.NewAggregate("userID", "org1").Aggregate,
@@ -639,6 +644,160 @@ func TestCommands_updateSession(t *testing.T) {
 				},
 			},
 		},
+		{
+			"set user, password, captcha required but missing",
+			fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(
+							org.NewCaptchaPolicyAddedEvent(context.Background(), &org.NewAggregate("org1").Aggregate,
+								domain.CaptchaTypeHCaptcha, "siteKey",
+								&crypto.CryptoValue{
+									CryptoType: crypto.TypeEncryption,
+									Algorithm:  "enc",
+									KeyID:      "id",
+									Crypted:    []byte("secret"),
+								},
+								false, false, false, true,
+							),
+						),
+					),
+				),
+			},
+			args{
+				ctx: authz.NewMockContext("instance1", "", ""),
+				checks: &SessionCommands{
+					sessionWriteModel: NewSessionWriteModel("sessionID", "instance1"),
+					sessionCommands: []SessionCommand{
+						CheckUser("userID", "org1", &language.Afrikaans),
+						CheckPassword("password"),
+					},
+					hasher: mockPasswordHasher("x"),
+					now: func() time.Time {
+						return testNow
+					},
+				},
+			},
+			res{
+				err: zerrors.ThrowPreconditionFailed(nil, "COMMAND-Hq3mTz", "Errors.Captcha.Missing"),
+			},
+		},
+		{
+			"set user, passkey challenge, captcha required but missing",
+			fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(
+							org.NewCaptchaPolicyAddedEvent(context.Background(), &org.NewAggregate("org1").Aggregate,
+								domain.CaptchaTypeHCaptcha, "siteKey",
+								&crypto.CryptoValue{
+									CryptoType: crypto.TypeEncryption,
+									Algorithm:  "enc",
+									KeyID:      "id",
+									Crypted:    []byte("secret"),
+								},
+								false, false, true, false,
+							),
+						),
+					),
+				),
+			},
+			args{
+				ctx: authz.NewMockContext("instance1", "", ""),
+				checks: &SessionCommands{
+					sessionWriteModel: NewSessionWriteModel("sessionID", "instance1"),
+					sessionCommands: []SessionCommand{
+						CheckUser("userID", "org1", &language.Afrikaans),
+						new(Commands).CreateWebAuthNChallenge(domain.UserVerificationRequirementRequired, "", nil),
+					},
+					now: func() time.Time {
+						return testNow
+					},
+				},
+			},
+			res{
+				err: zerrors.ThrowPreconditionFailed(nil, "COMMAND-Hq3mTz", "Errors.Captcha.Missing"),
+			},
+		},
+		{
+			"set user, captcha and password, ok",
+			fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(
+							org.NewCaptchaPolicyAddedEvent(context.Background(), &org.NewAggregate("org1").Aggregate,
+								domain.CaptchaTypeHCaptcha, "siteKey",
+								&crypto.CryptoValue{
+									CryptoType: crypto.TypeEncryption,
+									Algorithm:  "enc",
+									KeyID:      "id",
+									Crypted:    []byte("secret"),
+								},
+								false, false, false, true,
+							),
+						),
+					),
+					expectFilter(
+						eventFromEventPusher(
+							user.NewHumanAddedEvent(context.Background(), &user.NewAggregate("userID", "org1").Aggregate,
+								"username", "", "", "", "", language.English, domain.GenderUnspecified, "", false),
+						),
+						eventFromEventPusher(
+							user.NewHumanPasswordChangedEvent(context.Background(), &user.NewAggregate("userID", "org1").Aggregate,
+								"$plain$x$password", false, ""),
+						),
+					),
+					expectFilter(), // recheck
+					expectPush(
+						session.NewUserCheckedEvent(context.Background(), &session.NewAggregate("sessionID", "instance1").Aggregate,
+							"userID", "org1", testNow, &language.Afrikaans,
+						),
+						user.NewHumanPasswordCheckSucceededEvent(context.Background(), &user.NewAggregate("userID", "org1").Aggregate, nil),
+						session.NewPasswordCheckedEvent(context.Background(), &session.NewAggregate("sessionID", "instance1").Aggregate,
+							testNow,
+						),
+						session.NewTokenSetEvent(context.Background(), &session.NewAggregate("sessionID", "instance1").Aggregate,
+							"tokenID",
+						),
+					),
+				),
+			},
+			args{
+				ctx: authz.NewMockContext("instance1", "", ""),
+				checks: &SessionCommands{
+					sessionWriteModel: NewSessionWriteModel("sessionID", "instance1"),
+					sessionCommands: []SessionCommand{
+						CheckUser("userID", "org1", &language.Afrikaans),
+						CheckCaptcha("captchaResponse", "127.0.0.1"),
+						CheckPassword("password"),
+					},
+					createToken: func(sessionID string) (string, string, error) {
+						return "tokenID",
+							"token",
+							nil
+					},
+					hasher:     mockPasswordHasher("x"),
+					captchaAlg: crypto.CreateMockEncryptionAlg(gomock.NewController(t)),
+					captchaVerifier: func(captchaType domain.CaptchaType, secret string) (captcha.Verifier, error) {
+						assert.Equal(t, domain.CaptchaTypeHCaptcha, captchaType)
+						assert.Equal(t, "secret", secret)
+						return &mockCaptchaVerifier{response: "captchaResponse", remoteIP: "127.0.0.1"}, nil
+					},
+					now: func() time.Time {
+						return testNow
+					},
+				},
+			},
+			res{
+				want: &SessionChanged{
+					ObjectDetails: &domain.ObjectDetails{
+						ResourceOwner: "instance1",
+					},
+					ID:       "sessionID",
+					NewToken: "token",
+				},
+			},
+		},
 		{
 			"set user, intent not successful",
 			fields{
@@ -1468,3 +1627,384 @@ func TestCommands_TerminateSession(t *testing.T) {
 		})
 	}
 }
+
+func TestCommands_TerminateAllOtherSessions(t *testing.T) {
+	type fields struct {
+		eventstore      func(t *testing.T) *eventstore.Eventstore
+		tokenVerifier   func(ctx context.Context, sessionToken, sessionID, tokenID string) (err error)
+		checkPermission domain.PermissionCheck
+	}
+	type args struct {
+		ctx          context.Context
+		sessionID    string
+		sessionToken string
+	}
+	type res struct {
+		want *domain.ObjectDetails
+		err  error
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		args   args
+		res    res
+	}{
+		{
+			"eventstore failed",
+			fields{
+				eventstore: expectEventstore(
+					expectFilterError(zerrors.ThrowInternal(nil, "id", "filter failed")),
+				),
+			},
+			args{
+				ctx: context.Background(),
+			},
+			res{
+				err: zerrors.ThrowInternal(nil, "id", "filter failed"),
+			},
+		},
+		{
+			"invalid session token",
+			fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(
+							session.NewAddedEvent(context.Background(),
+								&session.NewAggregate("sessionID", "instance1").Aggregate,
+								&domain.UserAgent{FingerprintID: gu.Ptr("fp1")},
+							)),
+						eventFromEventPusher(
+							session.NewUserCheckedEvent(context.Background(), &session.NewAggregate("sessionID", "instance1").Aggregate,
+								"user1", "org1", testNow, &language.Afrikaans),
+						),
+						eventFromEventPusher(
+							session.NewTokenSetEvent(context.Background(), &session.NewAggregate("sessionID", "instance1").Aggregate,
+								"tokenID")),
+					),
+				),
+				tokenVerifier: newMockTokenVerifierInvalid(),
+			},
+			args{
+				ctx:          context.Background(),
+				sessionID:    "sessionID",
+				sessionToken: "invalid",
+			},
+			res{
+				err: zerrors.ThrowPermissionDenied(nil, "COMMAND-sGr42", "Errors.Session.Token.Invalid"),
+			},
+		},
+		{
+			"not active",
+			fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(
+							session.NewAddedEvent(context.Background(),
+								&session.NewAggregate("sessionID", "instance1").Aggregate,
+								&domain.UserAgent{FingerprintID: gu.Ptr("fp1")},
+							)),
+						eventFromEventPusher(
+							session.NewUserCheckedEvent(context.Background(), &session.NewAggregate("sessionID", "instance1").Aggregate,
+								"user1", "org1", testNow, &language.Afrikaans),
+						),
+						eventFromEventPusher(
+							session.NewTerminateEvent(context.Background(), &session.NewAggregate("sessionID", "instance1").Aggregate)),
+					),
+				),
+			},
+			args{
+				ctx:       authz.NewMockContext("instance1", "org1", "user1"),
+				sessionID: "sessionID",
+			},
+			res{
+				err: zerrors.ThrowPreconditionFailed(nil, "COMMAND-Hewfq", "Errors.Session.Terminated"),
+			},
+		},
+		{
+			"user missing",
+			fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(
+							session.NewAddedEvent(context.Background(),
+								&session.NewAggregate("sessionID", "instance1").Aggregate,
+								&domain.UserAgent{FingerprintID: gu.Ptr("fp1")},
+							)),
+						eventFromEventPusher(
+							session.NewTokenSetEvent(context.Background(), &session.NewAggregate("sessionID", "instance1").Aggregate,
+								"tokenID")),
+					),
+				),
+				tokenVerifier: func(ctx context.Context, sessionToken, sessionID, tokenID string) (err error) {
+					return nil
+				},
+			},
+			args{
+				ctx:          context.Background(),
+				sessionID:    "sessionID",
+				sessionToken: "token",
+			},
+			res{
+				err: zerrors.ThrowPreconditionFailed(nil, "COMMAND-Kd9wq", "Errors.Session.UserMissing"),
+			},
+		},
+		{
+			"no other sessions",
+			fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(
+							session.NewAddedEvent(context.Background(),
+								&session.NewAggregate("sessionID", "instance1").Aggregate,
+								&domain.UserAgent{FingerprintID: gu.Ptr("fp1")},
+							)),
+						eventFromEventPusher(
+							session.NewUserCheckedEvent(context.Background(), &session.NewAggregate("sessionID", "instance1").Aggregate,
+								"user1", "org1", testNow, &language.Afrikaans),
+						),
+					),
+					expectFilter(
+						eventFromEventPusher(
+							session.NewUserCheckedEvent(context.Background(), &session.NewAggregate("sessionID", "instance1").Aggregate,
+								"user1", "org1", testNow, &language.Afrikaans),
+						),
+					),
+				),
+			},
+			args{
+				ctx:       authz.NewMockContext("instance1", "org1", "user1"),
+				sessionID: "sessionID",
+			},
+			res{
+				want: &domain.ObjectDetails{
+					ResourceOwner: "instance1",
+				},
+			},
+		},
+		{
+			"terminate other active sessions",
+			fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(
+							session.NewAddedEvent(context.Background(),
+								&session.NewAggregate("sessionID", "instance1").Aggregate,
+								&domain.UserAgent{FingerprintID: gu.Ptr("fp1")},
+							)),
+						eventFromEventPusher(
+							session.NewUserCheckedEvent(context.Background(), &session.NewAggregate("sessionID", "instance1").Aggregate,
+								"user1", "org1", testNow, &language.Afrikaans),
+						),
+					),
+					expectFilter(
+						eventFromEventPusher(
+							session.NewUserCheckedEvent(context.Background(), &session.NewAggregate("sessionID", "instance1").Aggregate,
+								"user1", "org1", testNow, &language.Afrikaans),
+						),
+						eventFromEventPusher(
+							session.NewUserCheckedEvent(context.Background(), &session.NewAggregate("session2", "instance1").Aggregate,
+								"user1", "org1", testNow, &language.Afrikaans),
+						),
+						eventFromEventPusher(
+							session.NewUserCheckedEvent(context.Background(), &session.NewAggregate("session3", "instance1").Aggregate,
+								"user1", "org1", testNow, &language.Afrikaans),
+						),
+						eventFromEventPusher(
+							session.NewUserCheckedEvent(context.Background(), &session.NewAggregate("session4", "instance1").Aggregate,
+								"user1", "org1", testNow, &language.Afrikaans),
+						),
+					),
+					expectFilter(
+						eventFromEventPusher(
+							session.NewAddedEvent(context.Background(),
+								&session.NewAggregate("session2", "instance1").Aggregate,
+								&domain.UserAgent{FingerprintID: gu.Ptr("fp2")},
+							)),
+						eventFromEventPusher(
+							session.NewAddedEvent(context.Background(),
+								&session.NewAggregate("session3", "instance1").Aggregate,
+								&domain.UserAgent{FingerprintID: gu.Ptr("fp3")},
+							)),
+						eventFromEventPusher(
+							session.NewTerminateEvent(context.Background(), &session.NewAggregate("session3", "instance1").Aggregate),
+						),
+						eventFromEventPusher(
+							session.NewAddedEvent(context.Background(),
+								&session.NewAggregate("session4", "instance1").Aggregate,
+								&domain.UserAgent{FingerprintID: gu.Ptr("fp4")},
+							)),
+						eventFromEventPusher(
+							session.NewLifetimeSetEvent(context.Background(), &session.NewAggregate("session4", "instance1").Aggregate, time.Hour),
+						),
+					),
+					expectPush(
+						session.NewTerminateEvent(authz.NewMockContext("instance1", "org1", "user1"), &session.NewAggregate("session2", "instance1").Aggregate),
+					),
+				),
+			},
+			args{
+				ctx:       authz.NewMockContext("instance1", "org1", "user1"),
+				sessionID: "sessionID",
+			},
+			res{
+				want: &domain.ObjectDetails{
+					ResourceOwner: "instance1",
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &Commands{
+				eventstore:           tt.fields.eventstore(t),
+				sessionTokenVerifier: tt.fields.tokenVerifier,
+				checkPermission:      tt.fields.checkPermission,
+			}
+			got, err := c.TerminateAllOtherSessions(tt.args.ctx, tt.args.sessionID, tt.args.sessionToken)
+			require.ErrorIs(t, err, tt.res.err)
+			assert.Equal(t, tt.res.want, got)
+		})
+	}
+}
+
+func TestCommands_TerminateLinkedSessions(t *testing.T) {
+	type fields struct {
+		eventstore func(t *testing.T) *eventstore.Eventstore
+	}
+	type args struct {
+		ctx       context.Context
+		sessionID string
+	}
+	type res struct {
+		want *domain.ObjectDetails
+		err  error
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		args   args
+		res    res
+	}{
+		{
+			"session not existing",
+			fields{
+				eventstore: expectEventstore(
+					expectFilter(),
+				),
+			},
+			args{
+				ctx:       authz.NewMockContext("instance1", "org1", "user1"),
+				sessionID: "sessionID",
+			},
+			res{
+				err: zerrors.ThrowNotFound(nil, "COMMAND-Ls4nd", "Errors.Session.NotExisting"),
+			},
+		},
+		{
+			"no fingerprint",
+			fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(
+							session.NewAddedEvent(context.Background(),
+								&session.NewAggregate("sessionID", "instance1").Aggregate,
+								nil,
+							)),
+					),
+				),
+			},
+			args{
+				ctx:       authz.NewMockContext("instance1", "org1", "user1"),
+				sessionID: "sessionID",
+			},
+			res{
+				want: &domain.ObjectDetails{
+					ResourceOwner: "instance1",
+				},
+			},
+		},
+		{
+			"terminate linked active sessions",
+			fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(
+							session.NewAddedEvent(context.Background(),
+								&session.NewAggregate("sessionID", "instance1").Aggregate,
+								&domain.UserAgent{FingerprintID: gu.Ptr("fp1")},
+							)),
+						eventFromEventPusher(
+							session.NewTerminateEvent(context.Background(), &session.NewAggregate("sessionID", "instance1").Aggregate),
+						),
+					),
+					expectFilter(
+						eventFromEventPusher(
+							session.NewAddedEvent(context.Background(),
+								&session.NewAggregate("sessionID", "instance1").Aggregate,
+								&domain.UserAgent{FingerprintID: gu.Ptr("fp1")},
+							)),
+						eventFromEventPusher(
+							session.NewAddedEvent(context.Background(),
+								&session.NewAggregate("session2", "instance1").Aggregate,
+								&domain.UserAgent{FingerprintID: gu.Ptr("fp1")},
+							)),
+						eventFromEventPusher(
+							session.NewAddedEvent(context.Background(),
+								&session.NewAggregate("session3", "instance1").Aggregate,
+								&domain.UserAgent{FingerprintID: gu.Ptr("fp1")},
+							)),
+					),
+					expectFilter(
+						eventFromEventPusher(
+							session.NewAddedEvent(context.Background(),
+								&session.NewAggregate("session2", "instance1").Aggregate,
+								&domain.UserAgent{FingerprintID: gu.Ptr("fp1")},
+							)),
+						eventFromEventPusher(
+							session.NewAddedEvent(context.Background(),
+								&session.NewAggregate("session3", "instance1").Aggregate,
+								&domain.UserAgent{FingerprintID: gu.Ptr("fp1")},
+							)),
+						eventFromEventPusher(
+							session.NewTerminateEvent(context.Background(), &session.NewAggregate("session3", "instance1").Aggregate),
+						),
+					),
+					expectPush(
+						session.NewTerminateEvent(authz.NewMockContext("instance1", "org1", "user1"), &session.NewAggregate("session2", "instance1").Aggregate),
+					),
+				),
+			},
+			args{
+				ctx:       authz.NewMockContext("instance1", "org1", "user1"),
+				sessionID: "sessionID",
+			},
+			res{
+				want: &domain.ObjectDetails{
+					ResourceOwner: "instance1",
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &Commands{
+				eventstore: tt.fields.eventstore(t),
+			}
+			got, err := c.TerminateLinkedSessions(tt.args.ctx, tt.args.sessionID)
+			require.ErrorIs(t, err, tt.res.err)
+			assert.Equal(t, tt.res.want, got)
+		})
+	}
+}
+
+type mockCaptchaVerifier struct {
+	response string
+	remoteIP string
+}
+
+func (m *mockCaptchaVerifier) Verify(_ context.Context, response, remoteIP string) error {
+	if response != m.response || remoteIP != m.remoteIP {
+		return zerrors.ThrowPreconditionFailed(nil, "CAPTC-Ke4rt", "Errors.Captcha.Invalid")
+	}
+	return nil
+}
@@ -0,0 +1,163 @@
+package command
+
+import (
+	"slices"
+	"time"
+
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/repository/session"
+)
+
+// UserSessionIDsWriteModel collects the IDs of all sessions the user was checked on.
+// The user check is the only session event containing the user ID,
+// the state of the sessions is therefore reduced by the [UserSessionsWriteModel].
+type UserSessionIDsWriteModel struct {
+	eventstore.WriteModel
+
+	UserID     string
+	SessionIDs []string
+}
+
+func NewUserSessionIDsWriteModel(userID, instanceID string) *UserSessionIDsWriteModel {
+	return &UserSessionIDsWriteModel{
+		WriteModel: eventstore.WriteModel{
+			InstanceID: instanceID,
+		},
+		UserID: userID,
+	}
+}
+
+func (wm *UserSessionIDsWriteModel) Reduce() error {
+	for _, event := range wm.Events {
+		e, ok := event.(*session.UserCheckedEvent)
+		if !ok || e.UserID != wm.UserID || slices.Contains(wm.SessionIDs, e.Aggregate().ID) {
+			continue
+		}
+		wm.SessionIDs = append(wm.SessionIDs, e.Aggregate().ID)
+	}
+	return wm.WriteModel.Reduce()
+}
+
+func (wm *UserSessionIDsWriteModel) Query() *eventstore.SearchQueryBuilder {
+	return eventstore.NewSearchQueryBuilder(eventstore.ColumnsEvent).
+		AddQuery().
+		AggregateTypes(session.AggregateType).
+		EventTypes(session.UserCheckedType).
+		EventData(map[string]interface{}{"userID": wm.UserID}).
+		Builder()
+}
+
+// UserAgentSessionIDsWriteModel collects the IDs of all sessions created on the user agent with the fingerprint.
+type UserAgentSessionIDsWriteModel struct {
+	eventstore.WriteModel
+
+	FingerprintID string
+	SessionIDs    []string
+}
+
+func NewUserAgentSessionIDsWriteModel(fingerprintID, instanceID string) *UserAgentSessionIDsWriteModel {
+	return &UserAgentSessionIDsWriteModel{
+		WriteModel: eventstore.WriteModel{
+			InstanceID: instanceID,
+		},
+		FingerprintID: fingerprintID,
+	}
+}
+
+func (wm *UserAgentSessionIDsWriteModel) Reduce() error {
+	for _, event := range wm.Events {
+		e, ok := event.(*session.AddedEvent)
+		if !ok || e.UserAgent.GetFingerprintID() != wm.FingerprintID || slices.Contains(wm.SessionIDs, e.Aggregate().ID) {
+			continue
+		}
+		wm.SessionIDs = append(wm.SessionIDs, e.Aggregate().ID)
+	}
+	return wm.WriteModel.Reduce()
+}
+
+func (wm *UserAgentSessionIDsWriteModel) Query() *eventstore.SearchQueryBuilder {
+	return eventstore.NewSearchQueryBuilder(eventstore.ColumnsEvent).
+		AddQuery().
+		AggregateTypes(session.AggregateType).
+		EventTypes(session.AddedType).
+		EventData(map[string]interface{}{
+			"user_agent": map[string]interface{}{"fingerprint_id": wm.FingerprintID},
+		}).
+		Builder()
+}
+
+type userSession struct {
+	resourceOwner string
+	state         domain.SessionState
+	expiration    time.Time
+}
+
+func (s *userSession) isActive(now time.Time) bool {
+	return s.state == domain.SessionStateActive && (s.expiration.IsZero() || s.expiration.After(now))
+}
+
+// UserSessionsWriteModel reduces the state of the passed sessions.
+type UserSessionsWriteModel struct {
+	eventstore.WriteModel
+
+	sessionIDs []string
+	sessions   map[string]*userSession
+}
+
+func NewUserSessionsWriteModel(instanceID string, sessionIDs ...string) *UserSessionsWriteModel {
+	return &UserSessionsWriteModel{
+		WriteModel: eventstore.WriteModel{
+			InstanceID: instanceID,
+		},
+		sessionIDs: sessionIDs,
+		sessions:   make(map[string]*userSession, len(sessionIDs)),
+	}
+}
+
+func (wm *UserSessionsWriteModel) Reduce() error {
+	for _, event := range wm.Events {
+		switch e := event.(type) {
+		case *session.AddedEvent:
+			wm.sessions[e.Aggregate().ID] = &userSession{
+				resourceOwner: e.Aggregate().ResourceOwner,
+				state:         domain.SessionStateActive,
+			}
+		case *session.LifetimeSetEvent:
+			if s, ok := wm.sessions[e.Aggregate().ID]; ok {
+				s.expiration = e.CreationDate().Add(e.Lifetime)
+			}
+		case *session.TerminateEvent:
+			if s, ok := wm.sessions[e.Aggregate().ID]; ok {
+				s.state = domain.SessionStateTerminated
+			}
+		}
+	}
+	return wm.WriteModel.Reduce()
+}
+
+func (wm *UserSessionsWriteModel) Query() *eventstore.SearchQueryBuilder {
+	return eventstore.NewSearchQueryBuilder(eventstore.ColumnsEvent).
+		AddQuery().
+		AggregateTypes(session.AggregateType).
+		AggregateIDs(wm.sessionIDs...).
+		EventTypes(
+			session.AddedType,
+			session.LifetimeSetType,
+			session.TerminateType,
+		).
+		Builder()
+}
+
+// ActiveSessions returns the aggregates of the sessions which are neither terminated nor expired.
+func (wm *UserSessionsWriteModel) ActiveSessions(now time.Time) []*eventstore.Aggregate {
+	aggregates := make([]*eventstore.Aggregate, 0, len(wm.sessions))
+	for _, id := range wm.sessionIDs {
+		s, ok := wm.sessions[id]
+		if !ok || !s.isActive(now) {
+			continue
+		}
+		aggregates = append(aggregates, &session.NewAggregate(id, s.resourceOwner).Aggregate)
+	}
+	return aggregates
+}
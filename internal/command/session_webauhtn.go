@@ -43,6 +43,12 @@ func (s *SessionCommands) getHumanWebAuthNTokenReadModel(ctx context.Context, us
 
 func (c *Commands) CreateWebAuthNChallenge(userVerification domain.UserVerificationRequirement, rpid string, dst json.Unmarshaler) SessionCommand {
 	return func(ctx context.Context, cmd *SessionCommands) ([]eventstore.Command, error) {
+		// a passkey challenge starts a passwordless login, a U2F challenge follows another factor
+		if userVerification == domain.UserVerificationRequirementRequired {
+			if err := cmd.verifyCaptcha(ctx, domain.CaptchaEndpointPasswordlessBegin); err != nil {
+				return nil, err
+			}
+		}
 		humanPasskeys, err := cmd.getHumanWebAuthNTokens(ctx, userVerification)
 		if err != nil {
 			return nil, err
@@ -2,6 +2,10 @@ package command
 
 import (
 	"context"
+	"net/url"
+	"regexp"
+	"slices"
+	"strings"
 
 	"github.com/zitadel/zitadel/internal/crypto"
 	"github.com/zitadel/zitadel/internal/domain"
@@ -110,6 +114,183 @@ func (c *Commands) ChangeSMSConfigTwilioToken(ctx context.Context, instanceID, i
 	return writeModelToObjectDetails(&smsConfigWriteModel.WriteModel), nil
 }
 
+type AddSMSHTTP struct {
+	Description string
+	Endpoint    string
+
+	// ID and SigningKey are set by the command,
+	// the signing key is only returned once and used to sign the messages sent to the endpoint.
+	ID         string
+	SigningKey string
+}
+
+func (c *Commands) AddSMSConfigHTTP(ctx context.Context, instanceID string, config *AddSMSHTTP) (_ *domain.ObjectDetails, err error) {
+	config.Endpoint = strings.TrimSpace(config.Endpoint)
+	if err := validateNotificationEndpoint(config.Endpoint); err != nil {
+		return nil, err
+	}
+	config.ID, err = c.idGenerator.Next()
+	if err != nil {
+		return nil, err
+	}
+	smsConfigWriteModel, err := c.getSMSConfig(ctx, instanceID, config.ID)
+	if err != nil {
+		return nil, err
+	}
+	code, err := c.newSigningKey(ctx, c.smsEncryption)
+	if err != nil {
+		return nil, err
+	}
+	config.SigningKey = code.Plain
+
+	iamAgg := InstanceAggregateFromWriteModel(&smsConfigWriteModel.WriteModel)
+	pushedEvents, err := c.eventstore.Push(ctx, instance.NewSMSConfigHTTPAddedEvent(
+		ctx,
+		iamAgg,
+		config.ID,
+		strings.TrimSpace(config.Description),
+		config.Endpoint,
+		code.Crypted,
+	))
+	if err != nil {
+		return nil, err
+	}
+	err = AppendAndReduce(smsConfigWriteModel, pushedEvents...)
+	if err != nil {
+		return nil, err
+	}
+	return writeModelToObjectDetails(&smsConfigWriteModel.WriteModel), nil
+}
+
+type ChangeSMSHTTP struct {
+	Description *string
+	Endpoint    *string
+
+	// ExpirationSigningKey generates a new signing key, which is then set in SigningKey
+	ExpirationSigningKey bool
+	SigningKey           *string
+}
+
+func (c *Commands) ChangeSMSConfigHTTP(ctx context.Context, instanceID, id string, config *ChangeSMSHTTP) (*domain.ObjectDetails, error) {
+	if id == "" {
+		return nil, zerrors.ThrowInvalidArgument(nil, "SMS-0ze6nkh3qy", "Errors.IDMissing")
+	}
+	if config.Endpoint != nil {
+		*config.Endpoint = strings.TrimSpace(*config.Endpoint)
+		if err := validateNotificationEndpoint(*config.Endpoint); err != nil {
+			return nil, err
+		}
+	}
+	if config.Description != nil {
+		*config.Description = strings.TrimSpace(*config.Description)
+	}
+	smsConfigWriteModel, err := c.getSMSConfig(ctx, instanceID, id)
+	if err != nil {
+		return nil, err
+	}
+	if !smsConfigWriteModel.State.Exists() || smsConfigWriteModel.HTTP == nil {
+		return nil, zerrors.ThrowNotFound(nil, "COMMAND-8lmz4v3sxe", "Errors.SMSConfig.NotFound")
+	}
+	var signingKey *crypto.CryptoValue
+	if config.ExpirationSigningKey {
+		code, err := c.newSigningKey(ctx, c.smsEncryption)
+		if err != nil {
+			return nil, err
+		}
+		signingKey = code.Crypted
+		config.SigningKey = &code.Plain
+	}
+	iamAgg := InstanceAggregateFromWriteModel(&smsConfigWriteModel.WriteModel)
+
+	changedEvent, hasChanged, err := smsConfigWriteModel.NewHTTPChangedEvent(
+		ctx,
+		iamAgg,
+		id,
+		config.Description,
+		config.Endpoint,
+		signingKey,
+	)
+	if err != nil {
+		return nil, err
+	}
+	if !hasChanged {
+		return nil, zerrors.ThrowPreconditionFailed(nil, "COMMAND-q3xw9ka5ne", "Errors.NoChangesFound")
+	}
+	pushedEvents, err := c.eventstore.Push(ctx, changedEvent)
+	if err != nil {
+		return nil, err
+	}
+	err = AppendAndReduce(smsConfigWriteModel, pushedEvents...)
+	if err != nil {
+		return nil, err
+	}
+	return writeModelToObjectDetails(&smsConfigWriteModel.WriteModel), nil
+}
+
+// validateNotificationEndpoint checks that the endpoint of an HTTP notification provider is an absolute http(s) URL
+func validateNotificationEndpoint(endpoint string) error {
+	parsed, err := url.Parse(endpoint)
+	if err != nil || parsed.Host == "" || (parsed.Scheme != "http" && parsed.Scheme != "https") {
+		return zerrors.ThrowInvalidArgument(err, "COMMAND-9fzu2c4lpo", "Errors.Notification.InvalidEndpoint")
+	}
+	return nil
+}
+
+var smsCountryCodeRegex = regexp.MustCompile(`^\+[0-9]{1,4}$`)
+
+// SetSMSConfigRouting sets the priority and the country prefixes (e.g. +41) of an sms provider.
+// Providers restricted to country prefixes are only used for matching recipients and are tried before the unrestricted ones.
+func (c *Commands) SetSMSConfigRouting(ctx context.Context, instanceID, id string, priority uint32, countryCodes []string) (*domain.ObjectDetails, error) {
+	if id == "" {
+		return nil, zerrors.ThrowInvalidArgument(nil, "SMS-6xv2ckrj9d", "Errors.IDMissing")
+	}
+	countryCodes, err := normalizeSMSCountryCodes(countryCodes)
+	if err != nil {
+		return nil, err
+	}
+	smsConfigWriteModel, err := c.getSMSConfig(ctx, instanceID, id)
+	if err != nil {
+		return nil, err
+	}
+	if !smsConfigWriteModel.State.Exists() {
+		return nil, zerrors.ThrowNotFound(nil, "COMMAND-d3kq0wzm6r", "Errors.SMSConfig.NotFound")
+	}
+	if smsConfigWriteModel.Priority == priority && slices.Equal(smsConfigWriteModel.CountryCodes, countryCodes) {
+		return nil, zerrors.ThrowPreconditionFailed(nil, "COMMAND-n5u8s1bhle", "Errors.NoChangesFound")
+	}
+	iamAgg := InstanceAggregateFromWriteModel(&smsConfigWriteModel.WriteModel)
+	pushedEvents, err := c.eventstore.Push(ctx, instance.NewSMSConfigRoutingSetEvent(
+		ctx,
+		iamAgg,
+		id,
+		priority,
+		countryCodes))
+	if err != nil {
+		return nil, err
+	}
+	err = AppendAndReduce(smsConfigWriteModel, pushedEvents...)
+	if err != nil {
+		return nil, err
+	}
+	return writeModelToObjectDetails(&smsConfigWriteModel.WriteModel), nil
+}
+
+// normalizeSMSCountryCodes trims, deduplicates and validates the country calling code prefixes (+ followed by 1-4 digits)
+func normalizeSMSCountryCodes(countryCodes []string) ([]string, error) {
+	normalized := make([]string, 0, len(countryCodes))
+	for _, code := range countryCodes {
+		code = strings.TrimSpace(code)
+		if !smsCountryCodeRegex.MatchString(code) {
+			return nil, zerrors.ThrowInvalidArgument(nil, "COMMAND-4hy7ta2qsc", "Errors.SMSConfig.InvalidCountryCode")
+		}
+		if !slices.Contains(normalized, code) {
+			normalized = append(normalized, code)
+		}
+	}
+	slices.Sort(normalized)
+	return normalized, nil
+}
+
 func (c *Commands) ActivateSMSConfig(ctx context.Context, instanceID, id string) (*domain.ObjectDetails, error) {
 	if id == "" {
 		return nil, zerrors.ThrowInvalidArgument(nil, "SMS-dn93n", "Errors.IDMissing")
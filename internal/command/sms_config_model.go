@@ -14,7 +14,11 @@ type IAMSMSConfigWriteModel struct {
 
 	ID     string
 	Twilio *TwilioConfig
+	HTTP   *HTTPConfig
 	State  domain.SMSConfigState
+
+	Priority     uint32
+	CountryCodes []string
 }
 
 type TwilioConfig struct {
@@ -23,6 +27,12 @@ type TwilioConfig struct {
 	SenderNumber string
 }
 
+type HTTPConfig struct {
+	Description string
+	Endpoint    string
+	SigningKey  *crypto.CryptoValue
+}
+
 func NewIAMSMSConfigWriteModel(instanceID, id string) *IAMSMSConfigWriteModel {
 	return &IAMSMSConfigWriteModel{
 		WriteModel: eventstore.WriteModel{
@@ -62,6 +72,35 @@ func (wm *IAMSMSConfigWriteModel) Reduce() error {
 				continue
 			}
 			wm.Twilio.Token = e.Token
+		case *instance.SMSConfigHTTPAddedEvent:
+			if wm.ID != e.ID {
+				continue
+			}
+			wm.HTTP = &HTTPConfig{
+				Description: e.Description,
+				Endpoint:    e.Endpoint,
+				SigningKey:  e.SigningKey,
+			}
+			wm.State = domain.SMSConfigStateInactive
+		case *instance.SMSConfigHTTPChangedEvent:
+			if wm.ID != e.ID {
+				continue
+			}
+			if e.Description != nil {
+				wm.HTTP.Description = *e.Description
+			}
+			if e.Endpoint != nil {
+				wm.HTTP.Endpoint = *e.Endpoint
+			}
+			if e.SigningKey != nil {
+				wm.HTTP.SigningKey = e.SigningKey
+			}
+		case *instance.SMSConfigRoutingSetEvent:
+			if wm.ID != e.ID {
+				continue
+			}
+			wm.Priority = e.Priority
+			wm.CountryCodes = e.CountryCodes
 		case *instance.SMSConfigActivatedEvent:
 			if wm.ID != e.ID {
 				continue
@@ -77,6 +116,9 @@ func (wm *IAMSMSConfigWriteModel) Reduce() error {
 				continue
 			}
 			wm.Twilio = nil
+			wm.HTTP = nil
+			wm.Priority = 0
+			wm.CountryCodes = nil
 			wm.State = domain.SMSConfigStateRemoved
 		}
 	}
@@ -92,6 +134,9 @@ func (wm *IAMSMSConfigWriteModel) Query() *eventstore.SearchQueryBuilder {
 			instance.SMSConfigTwilioAddedEventType,
 			instance.SMSConfigTwilioChangedEventType,
 			instance.SMSConfigTwilioTokenChangedEventType,
+			instance.SMSConfigHTTPAddedEventType,
+			instance.SMSConfigHTTPChangedEventType,
+			instance.SMSConfigRoutingSetEventType,
 			instance.SMSConfigActivatedEventType,
 			instance.SMSConfigDeactivatedEventType,
 			instance.SMSConfigRemovedEventType).
@@ -118,3 +163,26 @@ func (wm *IAMSMSConfigWriteModel) NewChangedEvent(ctx context.Context, aggregate
 	}
 	return changeEvent, true, nil
 }
+
+func (wm *IAMSMSConfigWriteModel) NewHTTPChangedEvent(ctx context.Context, aggregate *eventstore.Aggregate, id string, description, endpoint *string, signingKey *crypto.CryptoValue) (*instance.SMSConfigHTTPChangedEvent, bool, error) {
+	changes := make([]instance.SMSConfigHTTPChanges, 0)
+
+	if description != nil && wm.HTTP.Description != *description {
+		changes = append(changes, instance.ChangeSMSConfigHTTPDescription(*description))
+	}
+	if endpoint != nil && wm.HTTP.Endpoint != *endpoint {
+		changes = append(changes, instance.ChangeSMSConfigHTTPEndpoint(*endpoint))
+	}
+	if signingKey != nil {
+		changes = append(changes, instance.ChangeSMSConfigHTTPSigningKey(signingKey))
+	}
+
+	if len(changes) == 0 {
+		return nil, false, nil
+	}
+	changeEvent, err := instance.NewSMSConfigHTTPChangedEvent(ctx, aggregate, id, changes)
+	if err != nil {
+		return nil, false, err
+	}
+	return changeEvent, true, nil
+}
@@ -110,7 +110,7 @@ func (c *Commands) ChangeSMTPConfig(ctx context.Context, instanceID string, id s
 		return nil, err
 	}
 
-	if !smtpConfigWriteModel.State.Exists() {
+	if !smtpConfigWriteModel.State.Exists() || smtpConfigWriteModel.HTTP != nil {
 		return nil, zerrors.ThrowNotFound(nil, "COMMAND-7j8gv", "Errors.SMTPConfig.NotFound")
 	}
 
@@ -152,6 +152,119 @@ func (c *Commands) ChangeSMTPConfig(ctx context.Context, instanceID string, id s
 	return writeModelToObjectDetails(&smtpConfigWriteModel.WriteModel), nil
 }
 
+type AddSMTPHTTP struct {
+	Description string
+	Endpoint    string
+
+	// ID and SigningKey are set by the command,
+	// the signing key is only returned once and used to sign the messages sent to the endpoint.
+	ID         string
+	SigningKey string
+}
+
+func (c *Commands) AddSMTPConfigHTTP(ctx context.Context, instanceID string, config *AddSMTPHTTP) (_ *domain.ObjectDetails, err error) {
+	config.Endpoint = strings.TrimSpace(config.Endpoint)
+	if err := validateNotificationEndpoint(config.Endpoint); err != nil {
+		return nil, err
+	}
+	config.ID, err = c.idGenerator.Next()
+	if err != nil {
+		return nil, err
+	}
+	smtpConfigWriteModel, err := c.getSMTPConfig(ctx, instanceID, config.ID, "")
+	if err != nil {
+		return nil, err
+	}
+	code, err := c.newSigningKey(ctx, c.smtpEncryption)
+	if err != nil {
+		return nil, err
+	}
+	config.SigningKey = code.Plain
+
+	iamAgg := InstanceAggregateFromWriteModel(&smtpConfigWriteModel.WriteModel)
+	pushedEvents, err := c.eventstore.Push(ctx, instance.NewSMTPConfigHTTPAddedEvent(
+		ctx,
+		iamAgg,
+		config.ID,
+		strings.TrimSpace(config.Description),
+		config.Endpoint,
+		code.Crypted,
+	))
+	if err != nil {
+		return nil, err
+	}
+	err = AppendAndReduce(smtpConfigWriteModel, pushedEvents...)
+	if err != nil {
+		return nil, err
+	}
+	return writeModelToObjectDetails(&smtpConfigWriteModel.WriteModel), nil
+}
+
+type ChangeSMTPHTTP struct {
+	Description *string
+	Endpoint    *string
+
+	// ExpirationSigningKey generates a new signing key, which is then set in SigningKey
+	ExpirationSigningKey bool
+	SigningKey           *string
+}
+
+func (c *Commands) ChangeSMTPConfigHTTP(ctx context.Context, instanceID, id string, config *ChangeSMTPHTTP) (*domain.ObjectDetails, error) {
+	if id == "" {
+		return nil, zerrors.ThrowInvalidArgument(nil, "SMTP-l4s0ezq7nu", "Errors.IDMissing")
+	}
+	if config.Endpoint != nil {
+		*config.Endpoint = strings.TrimSpace(*config.Endpoint)
+		if err := validateNotificationEndpoint(*config.Endpoint); err != nil {
+			return nil, err
+		}
+	}
+	if config.Description != nil {
+		*config.Description = strings.TrimSpace(*config.Description)
+	}
+	smtpConfigWriteModel, err := c.getSMTPConfig(ctx, instanceID, id, "")
+	if err != nil {
+		return nil, err
+	}
+	if !smtpConfigWriteModel.State.Exists() || smtpConfigWriteModel.HTTP == nil {
+		return nil, zerrors.ThrowNotFound(nil, "COMMAND-w7h2xq0kcv", "Errors.SMTPConfig.NotFound")
+	}
+	var signingKey *crypto.CryptoValue
+	if config.ExpirationSigningKey {
+		code, err := c.newSigningKey(ctx, c.smtpEncryption)
+		if err != nil {
+			return nil, err
+		}
+		signingKey = code.Crypted
+		config.SigningKey = &code.Plain
+	}
+	iamAgg := InstanceAggregateFromWriteModel(&smtpConfigWriteModel.WriteModel)
+
+	changedEvent, hasChanged, err := smtpConfigWriteModel.NewHTTPChangedEvent(
+		ctx,
+		iamAgg,
+		id,
+		config.Description,
+		config.Endpoint,
+		signingKey,
+	)
+	if err != nil {
+		return nil, err
+	}
+	if !hasChanged {
+		return nil, zerrors.ThrowPreconditionFailed(nil, "COMMAND-3bne9tsx1o", "Errors.NoChangesFound")
+	}
+	pushedEvents, err := c.eventstore.Push(ctx, changedEvent)
+	if err != nil {
+		return nil, err
+	}
+	err = AppendAndReduce(smtpConfigWriteModel, pushedEvents...)
+	if err != nil {
+		return nil, err
+	}
+	return writeModelToObjectDetails(&smtpConfigWriteModel.WriteModel), nil
+}
+
 func (c *Commands) ChangeSMTPConfigPassword(ctx context.Context, instanceID, id string, password string) (*domain.ObjectDetails, error) {
 	instanceAgg := instance.NewAggregate(authz.GetInstance(ctx).InstanceID())
 	smtpConfigWriteModel, err := c.getSMTPConfig(ctx, instanceID, id, "")
@@ -341,6 +454,9 @@ func (c *Commands) TestSMTPConfigById(ctx context.Context, instanceID, id, email
 	if !smtpConfigWriteModel.State.Exists() {
 		return zerrors.ThrowNotFound(nil, "SMTP-99klw", "Errors.SMTPConfig.NotFound")
 	}
+	if smtpConfigWriteModel.HTTP != nil {
+		return zerrors.ThrowPreconditionFailed(nil, "SMTP-4vd0ulb7qe", "Errors.SMTPConfig.TestHTTPNotSupported")
+	}
 
 	password, err := crypto.DecryptString(smtpConfigWriteModel.Password, c.smtpEncryption)
 	if err != nil {
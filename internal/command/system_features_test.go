@@ -135,6 +135,24 @@ func TestCommands_SetSystemFeatures(t *testing.T) {
 				ResourceOwner: "SYSTEM",
 			},
 		},
+		{
+			name: "set LoginV2",
+			eventstore: expectEventstore(
+				expectFilter(),
+				expectPush(
+					feature_v2.NewSetEvent[bool](
+						context.Background(), aggregate,
+						feature_v2.SystemLoginV2EventType, true,
+					),
+				),
+			),
+			args: args{context.Background(), &SystemFeatures{
+				LoginV2: gu.Ptr(true),
+			}},
+			want: &domain.ObjectDetails{
+				ResourceOwner: "SYSTEM",
+			},
+		},
 		{
 			name: "push error",
 			eventstore: expectEventstore(
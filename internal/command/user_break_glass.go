@@ -0,0 +1,107 @@
+package command
+
+import (
+	"context"
+
+	"github.com/zitadel/logging"
+
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/repository/user"
+	"github.com/zitadel/zitadel/internal/telemetry/tracing"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+// SetUserBreakGlass flags the user as emergency account.
+// Break-glass users can always log in locally with their password and a security key (humans)
+// or their client secret (machines), every use is recorded and alerted,
+// and they are excluded from the user lifecycle policies.
+func (c *Commands) SetUserBreakGlass(ctx context.Context, userID, resourceOwner, description string) (_ *domain.ObjectDetails, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	if userID == "" {
+		return nil, zerrors.ThrowInvalidArgument(nil, "COMMAND-Bg1mq", "Errors.User.UserIDMissing")
+	}
+	writeModel, err := c.userBreakGlassWriteModelByID(ctx, userID, resourceOwner)
+	if err != nil {
+		return nil, err
+	}
+	if !isUserStateExists(writeModel.UserState) {
+		return nil, zerrors.ThrowNotFound(nil, "COMMAND-Bg2nf", "Errors.User.NotFound")
+	}
+	if writeModel.BreakGlass {
+		return nil, zerrors.ThrowPreconditionFailed(nil, "COMMAND-Bg3as", "Errors.User.BreakGlass.AlreadySet")
+	}
+	userAgg := UserAggregateFromWriteModel(&writeModel.WriteModel)
+	pushedEvents, err := c.eventstore.Push(ctx, user.NewUserBreakGlassSetEvent(ctx, userAgg, description))
+	if err != nil {
+		return nil, err
+	}
+	err = AppendAndReduce(writeModel, pushedEvents...)
+	if err != nil {
+		return nil, err
+	}
+	return writeModelToObjectDetails(&writeModel.WriteModel), nil
+}
+
+// RemoveUserBreakGlass turns the break-glass user into a regular user again
+func (c *Commands) RemoveUserBreakGlass(ctx context.Context, userID, resourceOwner string) (_ *domain.ObjectDetails, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	if userID == "" {
+		return nil, zerrors.ThrowInvalidArgument(nil, "COMMAND-Bg4mq", "Errors.User.UserIDMissing")
+	}
+	writeModel, err := c.userBreakGlassWriteModelByID(ctx, userID, resourceOwner)
+	if err != nil {
+		return nil, err
+	}
+	if !isUserStateExists(writeModel.UserState) {
+		return nil, zerrors.ThrowNotFound(nil, "COMMAND-Bg5nf", "Errors.User.NotFound")
+	}
+	if !writeModel.BreakGlass {
+		return nil, zerrors.ThrowPreconditionFailed(nil, "COMMAND-Bg6ns", "Errors.User.BreakGlass.NotSet")
+	}
+	userAgg := UserAggregateFromWriteModel(&writeModel.WriteModel)
+	pushedEvents, err := c.eventstore.Push(ctx, user.NewUserBreakGlassRemovedEvent(ctx, userAgg))
+	if err != nil {
+		return nil, err
+	}
+	err = AppendAndReduce(writeModel, pushedEvents...)
+	if err != nil {
+		return nil, err
+	}
+	return writeModelToObjectDetails(&writeModel.WriteModel), nil
+}
+
+// breakGlassUsedEvent returns the event recording the use of the break-glass user
+// or nil if the user is no break-glass user
+func (c *Commands) breakGlassUsedEvent(ctx context.Context, userAgg *eventstore.Aggregate, method domain.BreakGlassAuthMethod, info *user.AuthRequestInfo) (eventstore.Command, error) {
+	writeModel, err := c.userBreakGlassWriteModelByID(ctx, userAgg.ID, userAgg.ResourceOwner)
+	if err != nil {
+		return nil, err
+	}
+	if !writeModel.BreakGlass {
+		return nil, nil
+	}
+	return user.NewUserBreakGlassUsedEvent(ctx, userAgg, method, info), nil
+}
+
+func (c *Commands) isBreakGlassUser(ctx context.Context, userID, resourceOwner string) bool {
+	writeModel, err := c.userBreakGlassWriteModelByID(ctx, userID, resourceOwner)
+	logging.WithFields("userID", userID).OnError(err).Warn("unable to check break-glass state of user")
+	return err == nil && writeModel.BreakGlass
+}
+
+func (c *Commands) userBreakGlassWriteModelByID(ctx context.Context, userID, resourceOwner string) (writeModel *UserBreakGlassWriteModel, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	writeModel = NewUserBreakGlassWriteModel(userID, resourceOwner)
+	err = c.eventstore.FilterToQueryReducer(ctx, writeModel)
+	if err != nil {
+		return nil, err
+	}
+	return writeModel, nil
+}
@@ -0,0 +1,67 @@
+package command
+
+import (
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/repository/user"
+)
+
+type UserBreakGlassWriteModel struct {
+	eventstore.WriteModel
+
+	UserState  domain.UserState
+	UserType   domain.UserType
+	BreakGlass bool
+}
+
+func NewUserBreakGlassWriteModel(userID, resourceOwner string) *UserBreakGlassWriteModel {
+	return &UserBreakGlassWriteModel{
+		WriteModel: eventstore.WriteModel{
+			AggregateID:   userID,
+			ResourceOwner: resourceOwner,
+		},
+	}
+}
+
+func (wm *UserBreakGlassWriteModel) Reduce() error {
+	for _, event := range wm.Events {
+		switch event.(type) {
+		case *user.HumanAddedEvent, *user.HumanRegisteredEvent:
+			wm.UserState = domain.UserStateActive
+			wm.UserType = domain.UserTypeHuman
+		case *user.MachineAddedEvent:
+			wm.UserState = domain.UserStateActive
+			wm.UserType = domain.UserTypeMachine
+		case *user.UserBreakGlassSetEvent:
+			wm.BreakGlass = true
+		case *user.UserBreakGlassRemovedEvent:
+			wm.BreakGlass = false
+		case *user.UserRemovedEvent:
+			wm.UserState = domain.UserStateDeleted
+			wm.BreakGlass = false
+		}
+	}
+	return wm.WriteModel.Reduce()
+}
+
+func (wm *UserBreakGlassWriteModel) Query() *eventstore.SearchQueryBuilder {
+	query := eventstore.NewSearchQueryBuilder(eventstore.ColumnsEvent).
+		AddQuery().
+		AggregateTypes(user.AggregateType).
+		AggregateIDs(wm.AggregateID).
+		EventTypes(
+			user.UserV1AddedType,
+			user.HumanAddedType,
+			user.UserV1RegisteredType,
+			user.HumanRegisteredType,
+			user.MachineAddedEventType,
+			user.UserBreakGlassSetType,
+			user.UserBreakGlassRemovedType,
+			user.UserRemovedType).
+		Builder()
+
+	if wm.ResourceOwner != "" {
+		query.ResourceOwner(wm.ResourceOwner)
+	}
+	return query
+}
@@ -92,6 +92,9 @@ func (c *Commands) changeUserGrant(ctx context.Context, userGrant *domain.UserGr
 	if existingUserGrant.State == domain.UserGrantStateUnspecified || existingUserGrant.State == domain.UserGrantStateRemoved {
 		return nil, nil, zerrors.ThrowNotFound(nil, "COMMAND-3M9sd", "Errors.UserGrant.NotFound")
 	}
+	if err = checkExpectedSequence(&existingUserGrant.WriteModel, userGrant.ExpectedSequence); err != nil {
+		return nil, nil, err
+	}
 	if reflect.DeepEqual(existingUserGrant.RoleKeys, userGrant.RoleKeys) {
 		return nil, nil, zerrors.ThrowPreconditionFailed(nil, "COMMAND-Rs8fy", "Errors.UserGrant.NotChanged")
 	}
@@ -685,6 +685,39 @@ func TestCommandSide_ChangeUserGrant(t *testing.T) {
 				err: zerrors.IsNotFound,
 			},
 		},
+		{
+			name: "usergrant changed in the meantime, precondition error",
+			fields: fields{
+				eventstore: eventstoreExpect(
+					t,
+					expectFilter(
+						eventFromEventPusher(
+							usergrant.NewUserGrantAddedEvent(context.Background(),
+								&usergrant.NewAggregate("usergrant1", "org").Aggregate,
+								"user1",
+								"project1",
+								"", []string{"rolekey1"}),
+						),
+					),
+				),
+			},
+			args: args{
+				ctx: authz.NewMockContextWithPermissions("", "", "", []string{domain.RoleProjectOwner}),
+				userGrant: &domain.UserGrant{
+					ObjectRoot: models.ObjectRoot{
+						AggregateID: "usergrant1",
+					},
+					UserID:           "user1",
+					ProjectID:        "project1",
+					RoleKeys:         []string{"rolekey2"},
+					ExpectedSequence: 5,
+				},
+				resourceOwner: "org1",
+			},
+			res: res{
+				err: zerrors.IsPreconditionFailed,
+			},
+		},
 		{
 			name: "usergrant roles not changed, precondition error",
 			fields: fields{
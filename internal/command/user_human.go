@@ -184,6 +184,9 @@ func (c *Commands) AddHumanCommand(human *AddHuman, orgID string, hasher *crypto
 			if err := c.addHumanCommandCheckID(ctx, filter, human, orgID); err != nil {
 				return nil, err
 			}
+			if err := c.validateAddHuman(ctx, orgID, human); err != nil {
+				return nil, err
+			}
 			a := user.NewAggregate(human.ID, orgID)
 
 			domainPolicy, err := domainPolicyWriteModel(ctx, filter, a.ResourceOwner)
@@ -231,7 +234,7 @@ func (c *Commands) AddHumanCommand(human *AddHuman, orgID string, hasher *crypto
 				createCmd.AddPhoneData(human.Phone.Number)
 			}
 
-			if err := addHumanCommandPassword(ctx, filter, createCmd, human, hasher); err != nil {
+			if err := c.addHumanCommandPassword(ctx, filter, createCmd, human, hasher); err != nil {
 				return nil, err
 			}
 
@@ -264,6 +267,14 @@ func (c *Commands) AddHumanCommand(human *AddHuman, orgID string, hasher *crypto
 				cmds = append(cmds, cmd)
 			}
 
+			attributeSchema, err := orgUserAttributeSchema(ctx, filter, a.ResourceOwner)
+			if err != nil {
+				return nil, err
+			}
+			if err = attributeSchema.validateUserMetadata(human.Metadata); err != nil {
+				return nil, err
+			}
+
 			return cmds, nil
 		}, nil
 	}
@@ -340,9 +351,9 @@ func (c *Commands) addHumanCommandCheckID(ctx context.Context, filter preparatio
 	return nil
 }
 
-func addHumanCommandPassword(ctx context.Context, filter preparation.FilterToQueryReducer, createCmd humanCreationCommand, human *AddHuman, hasher *crypto.Hasher) (err error) {
+func (c *Commands) addHumanCommandPassword(ctx context.Context, filter preparation.FilterToQueryReducer, createCmd humanCreationCommand, human *AddHuman, hasher *crypto.Hasher) (err error) {
 	if human.Password != "" {
-		if err = humanValidatePassword(ctx, filter, human.Password); err != nil {
+		if err = c.humanValidatePassword(ctx, filter, human.Password); err != nil {
 			return err
 		}
 
@@ -384,13 +395,16 @@ func (c *Commands) userValidateDomain(ctx context.Context, resourceOwner string,
 	return nil
 }
 
-func humanValidatePassword(ctx context.Context, filter preparation.FilterToQueryReducer, password string) error {
+func (c *Commands) humanValidatePassword(ctx context.Context, filter preparation.FilterToQueryReducer, password string) error {
 	passwordComplexity, err := passwordComplexityPolicyWriteModel(ctx, filter)
 	if err != nil {
 		return err
 	}
 
-	return passwordComplexity.Validate(password)
+	if err = passwordComplexity.Validate(password); err != nil {
+		return err
+	}
+	return c.checkPasswordBreached(ctx, passwordComplexity.CheckBreached, password)
 }
 
 func (h *AddHuman) ensureDisplayName() {
@@ -520,6 +534,11 @@ func (c *Commands) createHuman(ctx context.Context, orgID string, human *domain.
 
 	human.EnsureDisplayName()
 	if human.Password != nil {
+		if pwPolicy != nil {
+			if err := c.checkPasswordBreached(ctx, pwPolicy.CheckBreached, human.Password.SecretString); err != nil {
+				return nil, nil, err
+			}
+		}
 		if err := human.HashPasswordIfExisting(ctx, pwPolicy, c.userPasswordHasher, human.Password.ChangeRequired); err != nil {
 			return nil, nil, err
 		}
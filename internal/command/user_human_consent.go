@@ -0,0 +1,68 @@
+package command
+
+import (
+	"context"
+	"slices"
+
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/repository/user"
+	"github.com/zitadel/zitadel/internal/telemetry/tracing"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+// GrantHumanConsent records the consent of the user to release the scopes to the application.
+// Scopes consented to earlier remain granted, so the user is only asked for new scopes on subsequent logins.
+// A repeated consent is recorded as well, as it's requested explicitly by the application (prompt=consent).
+func (c *Commands) GrantHumanConsent(ctx context.Context, userID, resourceOwner, appID string, scopes []string) (_ *domain.ObjectDetails, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	if userID == "" || appID == "" {
+		return nil, zerrors.ThrowInvalidArgument(nil, "COMMAND-Cn2id", "Errors.IDMissing")
+	}
+	existingHuman, err := c.getHumanWriteModelByID(ctx, userID, resourceOwner)
+	if err != nil {
+		return nil, err
+	}
+	if !isUserStateExists(existingHuman.UserState) {
+		return nil, zerrors.ThrowNotFound(nil, "COMMAND-Cn4nf", "Errors.User.NotFound")
+	}
+	writeModel := NewHumanConsentWriteModel(userID, appID, existingHuman.ResourceOwner)
+	if err = c.eventstore.FilterToQueryReducer(ctx, writeModel); err != nil {
+		return nil, err
+	}
+	granted := slices.Clone(writeModel.Scopes)
+	for _, scope := range scopes {
+		if scope != "" && !slices.Contains(granted, scope) {
+			granted = append(granted, scope)
+		}
+	}
+	userAgg := UserAggregateFromWriteModel(&existingHuman.WriteModel)
+	if err = c.pushAppendAndReduce(ctx, writeModel, user.NewHumanConsentGrantedEvent(ctx, userAgg, appID, granted)); err != nil {
+		return nil, err
+	}
+	return writeModelToObjectDetails(&writeModel.WriteModel), nil
+}
+
+// RevokeHumanConsent revokes the consent of the user for the application,
+// the user has to consent again on the next login to the application.
+func (c *Commands) RevokeHumanConsent(ctx context.Context, userID, appID, resourceOwner string) (_ *domain.ObjectDetails, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	if userID == "" || appID == "" {
+		return nil, zerrors.ThrowInvalidArgument(nil, "COMMAND-Cn6id", "Errors.IDMissing")
+	}
+	writeModel := NewHumanConsentWriteModel(userID, appID, resourceOwner)
+	if err = c.eventstore.FilterToQueryReducer(ctx, writeModel); err != nil {
+		return nil, err
+	}
+	if !writeModel.Exists() {
+		return nil, zerrors.ThrowNotFound(nil, "COMMAND-Cn8nf", "Errors.User.Consent.NotFound")
+	}
+	userAgg := UserAggregateFromWriteModel(&writeModel.WriteModel)
+	if err = c.pushAppendAndReduce(ctx, writeModel, user.NewHumanConsentRevokedEvent(ctx, userAgg, appID)); err != nil {
+		return nil, err
+	}
+	return writeModelToObjectDetails(&writeModel.WriteModel), nil
+}
@@ -0,0 +1,83 @@
+package command
+
+import (
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/repository/user"
+)
+
+type HumanConsentWriteModel struct {
+	eventstore.WriteModel
+
+	AppID  string
+	Scopes []string
+
+	State domain.UserConsentState
+}
+
+func NewHumanConsentWriteModel(userID, appID, resourceOwner string) *HumanConsentWriteModel {
+	return &HumanConsentWriteModel{
+		WriteModel: eventstore.WriteModel{
+			AggregateID:   userID,
+			ResourceOwner: resourceOwner,
+		},
+		AppID: appID,
+	}
+}
+
+func (wm *HumanConsentWriteModel) AppendEvents(events ...eventstore.Event) {
+	for _, event := range events {
+		switch e := event.(type) {
+		case *user.HumanConsentGrantedEvent:
+			if wm.AppID != e.AppID {
+				continue
+			}
+			wm.WriteModel.AppendEvents(e)
+		case *user.HumanConsentRevokedEvent:
+			if wm.AppID != e.AppID {
+				continue
+			}
+			wm.WriteModel.AppendEvents(e)
+		case *user.UserRemovedEvent:
+			wm.WriteModel.AppendEvents(e)
+		}
+	}
+}
+
+func (wm *HumanConsentWriteModel) Reduce() error {
+	for _, event := range wm.Events {
+		switch e := event.(type) {
+		case *user.HumanConsentGrantedEvent:
+			wm.Scopes = e.Scopes
+			wm.State = domain.UserConsentStateActive
+		case *user.HumanConsentRevokedEvent:
+			wm.Scopes = nil
+			wm.State = domain.UserConsentStateRevoked
+		case *user.UserRemovedEvent:
+			wm.Scopes = nil
+			wm.State = domain.UserConsentStateRevoked
+		}
+	}
+	return wm.WriteModel.Reduce()
+}
+
+func (wm *HumanConsentWriteModel) Query() *eventstore.SearchQueryBuilder {
+	query := eventstore.NewSearchQueryBuilder(eventstore.ColumnsEvent).
+		AddQuery().
+		AggregateTypes(user.AggregateType).
+		AggregateIDs(wm.AggregateID).
+		EventTypes(
+			user.HumanConsentGrantedType,
+			user.HumanConsentRevokedType,
+			user.UserRemovedType).
+		Builder()
+
+	if wm.ResourceOwner != "" {
+		query.ResourceOwner(wm.ResourceOwner)
+	}
+	return query
+}
+
+func (wm *HumanConsentWriteModel) Exists() bool {
+	return wm.State == domain.UserConsentStateActive
+}
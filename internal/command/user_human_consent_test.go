@@ -0,0 +1,345 @@
+package command
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/text/language"
+
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/repository/user"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+func TestCommands_GrantHumanConsent(t *testing.T) {
+	type fields struct {
+		eventstore func(*testing.T) *eventstore.Eventstore
+	}
+	type args struct {
+		ctx           context.Context
+		userID        string
+		resourceOwner string
+		appID         string
+		scopes        []string
+	}
+	type res struct {
+		want *domain.ObjectDetails
+		err  func(error) bool
+	}
+	humanAddedEvent := func() eventstore.Event {
+		return eventFromEventPusher(
+			user.NewHumanAddedEvent(context.Background(),
+				&user.NewAggregate("user1", "org1").Aggregate,
+				"username",
+				"firstname",
+				"lastname",
+				"nickname",
+				"displayname",
+				language.German,
+				domain.GenderUnspecified,
+				"email@test.ch",
+				true,
+			),
+		)
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		args   args
+		res    res
+	}{
+		{
+			name: "missing app id, error",
+			fields: fields{
+				eventstore: expectEventstore(),
+			},
+			args: args{
+				ctx:           context.Background(),
+				userID:        "user1",
+				resourceOwner: "org1",
+				scopes:        []string{"openid"},
+			},
+			res: res{
+				err: zerrors.IsErrorInvalidArgument,
+			},
+		},
+		{
+			name: "user not existing, not found error",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(),
+				),
+			},
+			args: args{
+				ctx:           context.Background(),
+				userID:        "user1",
+				resourceOwner: "org1",
+				appID:         "app1",
+				scopes:        []string{"openid"},
+			},
+			res: res{
+				err: zerrors.IsNotFound,
+			},
+		},
+		{
+			name: "first consent, ok",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						humanAddedEvent(),
+					),
+					expectFilter(),
+					expectPush(
+						user.NewHumanConsentGrantedEvent(context.Background(),
+							&user.NewAggregate("user1", "org1").Aggregate,
+							"app1",
+							[]string{"openid", "email"},
+						),
+					),
+				),
+			},
+			args: args{
+				ctx:           context.Background(),
+				userID:        "user1",
+				resourceOwner: "org1",
+				appID:         "app1",
+				scopes:        []string{"openid", "email"},
+			},
+			res: res{
+				want: &domain.ObjectDetails{
+					ResourceOwner: "org1",
+				},
+			},
+		},
+		{
+			name: "additional scopes, merged",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						humanAddedEvent(),
+					),
+					expectFilter(
+						eventFromEventPusher(
+							user.NewHumanConsentGrantedEvent(context.Background(),
+								&user.NewAggregate("user1", "org1").Aggregate,
+								"app1",
+								[]string{"openid", "email"},
+							),
+						),
+					),
+					expectPush(
+						user.NewHumanConsentGrantedEvent(context.Background(),
+							&user.NewAggregate("user1", "org1").Aggregate,
+							"app1",
+							[]string{"openid", "email", "profile"},
+						),
+					),
+				),
+			},
+			args: args{
+				ctx:           context.Background(),
+				userID:        "user1",
+				resourceOwner: "org1",
+				appID:         "app1",
+				scopes:        []string{"openid", "profile"},
+			},
+			res: res{
+				want: &domain.ObjectDetails{
+					ResourceOwner: "org1",
+				},
+			},
+		},
+		{
+			name: "scopes already granted, consent recorded again",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						humanAddedEvent(),
+					),
+					expectFilter(
+						eventFromEventPusher(
+							user.NewHumanConsentGrantedEvent(context.Background(),
+								&user.NewAggregate("user1", "org1").Aggregate,
+								"app1",
+								[]string{"openid", "email"},
+							),
+						),
+					),
+					expectPush(
+						user.NewHumanConsentGrantedEvent(context.Background(),
+							&user.NewAggregate("user1", "org1").Aggregate,
+							"app1",
+							[]string{"openid", "email"},
+						),
+					),
+				),
+			},
+			args: args{
+				ctx:           context.Background(),
+				userID:        "user1",
+				resourceOwner: "org1",
+				appID:         "app1",
+				scopes:        []string{"email"},
+			},
+			res: res{
+				want: &domain.ObjectDetails{
+					ResourceOwner: "org1",
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := &Commands{
+				eventstore: tt.fields.eventstore(t),
+			}
+			got, err := r.GrantHumanConsent(tt.args.ctx, tt.args.userID, tt.args.resourceOwner, tt.args.appID, tt.args.scopes)
+			if tt.res.err == nil {
+				assert.NoError(t, err)
+			}
+			if tt.res.err != nil && !tt.res.err(err) {
+				t.Errorf("got wrong err: %v ", err)
+			}
+			if tt.res.err == nil {
+				assert.Equal(t, tt.res.want, got)
+			}
+		})
+	}
+}
+
+func TestCommands_RevokeHumanConsent(t *testing.T) {
+	type fields struct {
+		eventstore func(*testing.T) *eventstore.Eventstore
+	}
+	type args struct {
+		ctx           context.Context
+		userID        string
+		appID         string
+		resourceOwner string
+	}
+	type res struct {
+		want *domain.ObjectDetails
+		err  func(error) bool
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		args   args
+		res    res
+	}{
+		{
+			name: "missing app id, error",
+			fields: fields{
+				eventstore: expectEventstore(),
+			},
+			args: args{
+				ctx:           context.Background(),
+				userID:        "user1",
+				resourceOwner: "org1",
+			},
+			res: res{
+				err: zerrors.IsErrorInvalidArgument,
+			},
+		},
+		{
+			name: "consent not existing, not found error",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(),
+				),
+			},
+			args: args{
+				ctx:           context.Background(),
+				userID:        "user1",
+				appID:         "app1",
+				resourceOwner: "org1",
+			},
+			res: res{
+				err: zerrors.IsNotFound,
+			},
+		},
+		{
+			name: "consent already revoked, not found error",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(
+							user.NewHumanConsentGrantedEvent(context.Background(),
+								&user.NewAggregate("user1", "org1").Aggregate,
+								"app1",
+								[]string{"openid"},
+							),
+						),
+						eventFromEventPusher(
+							user.NewHumanConsentRevokedEvent(context.Background(),
+								&user.NewAggregate("user1", "org1").Aggregate,
+								"app1",
+							),
+						),
+					),
+				),
+			},
+			args: args{
+				ctx:           context.Background(),
+				userID:        "user1",
+				appID:         "app1",
+				resourceOwner: "org1",
+			},
+			res: res{
+				err: zerrors.IsNotFound,
+			},
+		},
+		{
+			name: "revoke consent, ok",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(
+							user.NewHumanConsentGrantedEvent(context.Background(),
+								&user.NewAggregate("user1", "org1").Aggregate,
+								"app1",
+								[]string{"openid"},
+							),
+						),
+					),
+					expectPush(
+						user.NewHumanConsentRevokedEvent(context.Background(),
+							&user.NewAggregate("user1", "org1").Aggregate,
+							"app1",
+						),
+					),
+				),
+			},
+			args: args{
+				ctx:           context.Background(),
+				userID:        "user1",
+				appID:         "app1",
+				resourceOwner: "org1",
+			},
+			res: res{
+				want: &domain.ObjectDetails{
+					ResourceOwner: "org1",
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := &Commands{
+				eventstore: tt.fields.eventstore(t),
+			}
+			got, err := r.RevokeHumanConsent(tt.args.ctx, tt.args.userID, tt.args.appID, tt.args.resourceOwner)
+			if tt.res.err == nil {
+				assert.NoError(t, err)
+			}
+			if tt.res.err != nil && !tt.res.err(err) {
+				t.Errorf("got wrong err: %v ", err)
+			}
+			if tt.res.err == nil {
+				assert.Equal(t, tt.res.want, got)
+			}
+		})
+	}
+}
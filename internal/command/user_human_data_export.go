@@ -0,0 +1,129 @@
+package command
+
+import (
+	"context"
+	"crypto/rand"
+	"io"
+	"time"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/crypto"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/repository/user"
+	"github.com/zitadel/zitadel/internal/static"
+	"github.com/zitadel/zitadel/internal/telemetry/tracing"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+const (
+	dataExportContentType   = "application/zip"
+	dataExportSigningKeyLen = 32
+)
+
+// RequestHumanDataExport requests an archive of the personal data of the user.
+// The archive is generated asynchronously, the returned ID is used to query the state of the export.
+func (c *Commands) RequestHumanDataExport(ctx context.Context, userID, resourceOwner string) (exportID string, _ *domain.ObjectDetails, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	if userID == "" {
+		return "", nil, zerrors.ThrowInvalidArgument(nil, "COMMAND-Dx4kv9qm2w", "Errors.User.UserIDMissing")
+	}
+	existingHuman, err := c.getHumanWriteModelByID(ctx, userID, resourceOwner)
+	if err != nil {
+		return "", nil, err
+	}
+	if !isUserStateExists(existingHuman.UserState) {
+		return "", nil, zerrors.ThrowNotFound(nil, "COMMAND-Dx8nw3kq7v", "Errors.User.NotFound")
+	}
+	exportID, err = c.idGenerator.Next()
+	if err != nil {
+		return "", nil, err
+	}
+	userAgg := UserAggregateFromWriteModel(&existingHuman.WriteModel)
+	pushedEvents, err := c.eventstore.Push(ctx, user.NewHumanDataExportRequestedEvent(ctx, userAgg, exportID))
+	if err != nil {
+		return "", nil, err
+	}
+	return exportID, pushedEventsToObjectDetails(pushedEvents), nil
+}
+
+// CompleteHumanDataExport stores the generated archive of the export.
+// It can be downloaded through a link signed with a key of the export until the expiration.
+func (c *Commands) CompleteHumanDataExport(ctx context.Context, userID, resourceOwner, exportID string, archive io.Reader, size int64, expiration time.Time) (_ *domain.ObjectDetails, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	writeModel, err := c.pendingHumanDataExport(ctx, userID, resourceOwner, exportID)
+	if err != nil {
+		return nil, err
+	}
+	objectName := domain.GetHumanDataExportAssetPath(userID, exportID)
+	asset, err := c.static.PutObject(ctx,
+		authz.GetInstance(ctx).InstanceID(),
+		"",
+		writeModel.ResourceOwner,
+		objectName,
+		dataExportContentType,
+		static.ObjectTypeUserDataExport,
+		archive,
+		size,
+	)
+	if err != nil {
+		return nil, zerrors.ThrowInternal(err, "COMMAND-Dx3mq7vk2n", "Errors.Assets.Object.PutFailed")
+	}
+	signingKey := make([]byte, dataExportSigningKeyLen)
+	if _, err = rand.Read(signingKey); err != nil {
+		return nil, err
+	}
+	encryptedKey, err := crypto.Encrypt(signingKey, c.userEncryption)
+	if err != nil {
+		return nil, err
+	}
+	userAgg := UserAggregateFromWriteModel(&writeModel.WriteModel)
+	pushedEvents, err := c.eventstore.Push(ctx, user.NewHumanDataExportGeneratedEvent(ctx, userAgg, exportID, asset.Name, asset.Size, encryptedKey, expiration))
+	if err != nil {
+		return nil, err
+	}
+	err = AppendAndReduce(writeModel, pushedEvents...)
+	if err != nil {
+		return nil, err
+	}
+	return writeModelToObjectDetails(&writeModel.WriteModel), nil
+}
+
+// FailHumanDataExport marks the export as failed, the user has to request a new export.
+func (c *Commands) FailHumanDataExport(ctx context.Context, userID, resourceOwner, exportID string) (_ *domain.ObjectDetails, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	writeModel, err := c.pendingHumanDataExport(ctx, userID, resourceOwner, exportID)
+	if err != nil {
+		return nil, err
+	}
+	userAgg := UserAggregateFromWriteModel(&writeModel.WriteModel)
+	pushedEvents, err := c.eventstore.Push(ctx, user.NewHumanDataExportFailedEvent(ctx, userAgg, exportID))
+	if err != nil {
+		return nil, err
+	}
+	err = AppendAndReduce(writeModel, pushedEvents...)
+	if err != nil {
+		return nil, err
+	}
+	return writeModelToObjectDetails(&writeModel.WriteModel), nil
+}
+
+func (c *Commands) pendingHumanDataExport(ctx context.Context, userID, resourceOwner, exportID string) (*HumanDataExportWriteModel, error) {
+	if userID == "" || exportID == "" {
+		return nil, zerrors.ThrowInvalidArgument(nil, "COMMAND-Dx9kw2vn4q", "Errors.IDMissing")
+	}
+	writeModel := NewHumanDataExportWriteModel(userID, exportID, resourceOwner)
+	err := c.eventstore.FilterToQueryReducer(ctx, writeModel)
+	if err != nil {
+		return nil, err
+	}
+	if writeModel.State != domain.DataExportStateRequested {
+		return nil, zerrors.ThrowPreconditionFailed(nil, "COMMAND-Dx6vq3mk8w", "Errors.User.DataExport.NotPending")
+	}
+	return writeModel, nil
+}
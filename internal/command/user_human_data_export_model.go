@@ -0,0 +1,89 @@
+package command
+
+import (
+	"time"
+
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/repository/user"
+)
+
+type HumanDataExportWriteModel struct {
+	eventstore.WriteModel
+
+	ExportID   string
+	ObjectName string
+	Expiration time.Time
+
+	State domain.DataExportState
+}
+
+func NewHumanDataExportWriteModel(userID, exportID, resourceOwner string) *HumanDataExportWriteModel {
+	return &HumanDataExportWriteModel{
+		WriteModel: eventstore.WriteModel{
+			AggregateID:   userID,
+			ResourceOwner: resourceOwner,
+		},
+		ExportID: exportID,
+	}
+}
+
+func (wm *HumanDataExportWriteModel) AppendEvents(events ...eventstore.Event) {
+	for _, event := range events {
+		switch e := event.(type) {
+		case *user.HumanDataExportRequestedEvent:
+			if wm.ExportID != e.ExportID {
+				continue
+			}
+			wm.WriteModel.AppendEvents(e)
+		case *user.HumanDataExportGeneratedEvent:
+			if wm.ExportID != e.ExportID {
+				continue
+			}
+			wm.WriteModel.AppendEvents(e)
+		case *user.HumanDataExportFailedEvent:
+			if wm.ExportID != e.ExportID {
+				continue
+			}
+			wm.WriteModel.AppendEvents(e)
+		case *user.UserRemovedEvent:
+			wm.WriteModel.AppendEvents(e)
+		}
+	}
+}
+
+func (wm *HumanDataExportWriteModel) Reduce() error {
+	for _, event := range wm.Events {
+		switch e := event.(type) {
+		case *user.HumanDataExportRequestedEvent:
+			wm.State = domain.DataExportStateRequested
+		case *user.HumanDataExportGeneratedEvent:
+			wm.ObjectName = e.ObjectName
+			wm.Expiration = e.Expiration
+			wm.State = domain.DataExportStateGenerated
+		case *user.HumanDataExportFailedEvent:
+			wm.State = domain.DataExportStateFailed
+		case *user.UserRemovedEvent:
+			wm.State = domain.DataExportStateUnspecified
+		}
+	}
+	return wm.WriteModel.Reduce()
+}
+
+func (wm *HumanDataExportWriteModel) Query() *eventstore.SearchQueryBuilder {
+	query := eventstore.NewSearchQueryBuilder(eventstore.ColumnsEvent).
+		AddQuery().
+		AggregateTypes(user.AggregateType).
+		AggregateIDs(wm.AggregateID).
+		EventTypes(
+			user.HumanDataExportRequestedType,
+			user.HumanDataExportGeneratedType,
+			user.HumanDataExportFailedType,
+			user.UserRemovedType).
+		Builder()
+
+	if wm.ResourceOwner != "" {
+		query.ResourceOwner(wm.ResourceOwner)
+	}
+	return query
+}
@@ -20,7 +20,7 @@ func (c *Commands) ImportHumanTOTP(ctx context.Context, userID, userAgentID, res
 	ctx, span := tracing.NewSpan(ctx)
 	defer func() { span.EndWithError(err) }()
 
-	encryptedSecret, err := crypto.Encrypt([]byte(key), c.multifactors.OTP.CryptoMFA)
+	encryptedSecret, err := crypto.Encrypt([]byte(key), crypto.ForContext(ctx, c.multifactors.OTP.CryptoMFA))
 	if err != nil {
 		return err
 	}
@@ -113,7 +113,7 @@ func (c *Commands) createHumanTOTP(ctx context.Context, userID, resourceOwner st
 	if err != nil {
 		return nil, err
 	}
-	encryptedSecret, err := crypto.Encrypt([]byte(key.Secret()), c.multifactors.OTP.CryptoMFA)
+	encryptedSecret, err := crypto.Encrypt([]byte(key.Secret()), crypto.ForContext(ctx, c.multifactors.OTP.CryptoMFA))
 	if err != nil {
 		return nil, err
 	}
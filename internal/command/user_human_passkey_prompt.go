@@ -0,0 +1,54 @@
+package command
+
+import (
+	"context"
+
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/repository/user"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+// HumanPasskeyPromptShown records that the user was asked to register a passkey after the password login
+func (c *Commands) HumanPasskeyPromptShown(ctx context.Context, userID, resourceOwner string) error {
+	return c.pushHumanPasskeyPromptEvent(ctx, userID, resourceOwner, func(ctx context.Context, agg *eventstore.Aggregate) eventstore.Command {
+		return user.NewHumanPasskeyPromptShownEvent(ctx, agg)
+	})
+}
+
+// HumanPasskeyPromptAccepted records that the user started the passkey registration from the prompt
+func (c *Commands) HumanPasskeyPromptAccepted(ctx context.Context, userID, resourceOwner string) error {
+	return c.pushHumanPasskeyPromptEvent(ctx, userID, resourceOwner, func(ctx context.Context, agg *eventstore.Aggregate) eventstore.Command {
+		return user.NewHumanPasskeyPromptAcceptedEvent(ctx, agg)
+	})
+}
+
+// HumanSnoozePasskeyPrompt hides the passkey prompt for the snooze lifetime of the login policy
+func (c *Commands) HumanSnoozePasskeyPrompt(ctx context.Context, userID, resourceOwner string) error {
+	return c.pushHumanPasskeyPromptEvent(ctx, userID, resourceOwner, func(ctx context.Context, agg *eventstore.Aggregate) eventstore.Command {
+		return user.NewHumanPasskeyPromptSnoozedEvent(ctx, agg)
+	})
+}
+
+// HumanOptOutPasskeyPrompt hides the passkey prompt for the user permanently
+func (c *Commands) HumanOptOutPasskeyPrompt(ctx context.Context, userID, resourceOwner string) error {
+	return c.pushHumanPasskeyPromptEvent(ctx, userID, resourceOwner, func(ctx context.Context, agg *eventstore.Aggregate) eventstore.Command {
+		return user.NewHumanPasskeyPromptOptedOutEvent(ctx, agg)
+	})
+}
+
+func (c *Commands) pushHumanPasskeyPromptEvent(ctx context.Context, userID, resourceOwner string, event func(context.Context, *eventstore.Aggregate) eventstore.Command) error {
+	if userID == "" {
+		return zerrors.ThrowInvalidArgument(nil, "COMMAND-Pkp1s", "Errors.User.UserIDMissing")
+	}
+
+	existingHuman, err := c.getHumanWriteModelByID(ctx, userID, resourceOwner)
+	if err != nil {
+		return err
+	}
+	if !isUserStateExists(existingHuman.UserState) {
+		return zerrors.ThrowNotFound(nil, "COMMAND-Pkp2s", "Errors.User.NotFound")
+	}
+
+	_, err = c.eventstore.Push(ctx, event(ctx, UserAggregateFromWriteModel(&existingHuman.WriteModel)))
+	return err
+}
@@ -0,0 +1,181 @@
+package command
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/text/language"
+
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/repository/user"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+func TestCommandSide_HumanPasskeyPrompt(t *testing.T) {
+	humanAddedEvent := func() eventstore.Event {
+		return eventFromEventPusher(
+			user.NewHumanAddedEvent(context.Background(),
+				&user.NewAggregate("user1", "org1").Aggregate,
+				"username",
+				"firstname",
+				"lastname",
+				"nickname",
+				"displayname",
+				language.German,
+				domain.GenderUnspecified,
+				"email@test.ch",
+				true,
+			),
+		)
+	}
+	type fields struct {
+		eventstore func(*testing.T) *eventstore.Eventstore
+	}
+	type args struct {
+		ctx           context.Context
+		command       func(*Commands) func(context.Context, string, string) error
+		userID        string
+		resourceOwner string
+	}
+	type res struct {
+		err func(error) bool
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		args   args
+		res    res
+	}{
+		{
+			name: "userid missing, invalid argument error",
+			fields: fields{
+				eventstore: expectEventstore(),
+			},
+			args: args{
+				ctx:           context.Background(),
+				command:       func(c *Commands) func(context.Context, string, string) error { return c.HumanSnoozePasskeyPrompt },
+				resourceOwner: "org1",
+			},
+			res: res{
+				err: zerrors.IsErrorInvalidArgument,
+			},
+		},
+		{
+			name: "user not existing, not found error",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(),
+				),
+			},
+			args: args{
+				ctx:           context.Background(),
+				command:       func(c *Commands) func(context.Context, string, string) error { return c.HumanSnoozePasskeyPrompt },
+				userID:        "user1",
+				resourceOwner: "org1",
+			},
+			res: res{
+				err: zerrors.IsNotFound,
+			},
+		},
+		{
+			name: "prompt shown, ok",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						humanAddedEvent(),
+					),
+					expectPush(
+						user.NewHumanPasskeyPromptShownEvent(context.Background(),
+							&user.NewAggregate("user1", "org1").Aggregate,
+						),
+					),
+				),
+			},
+			args: args{
+				ctx:           context.Background(),
+				command:       func(c *Commands) func(context.Context, string, string) error { return c.HumanPasskeyPromptShown },
+				userID:        "user1",
+				resourceOwner: "org1",
+			},
+		},
+		{
+			name: "prompt accepted, ok",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						humanAddedEvent(),
+					),
+					expectPush(
+						user.NewHumanPasskeyPromptAcceptedEvent(context.Background(),
+							&user.NewAggregate("user1", "org1").Aggregate,
+						),
+					),
+				),
+			},
+			args: args{
+				ctx:           context.Background(),
+				command:       func(c *Commands) func(context.Context, string, string) error { return c.HumanPasskeyPromptAccepted },
+				userID:        "user1",
+				resourceOwner: "org1",
+			},
+		},
+		{
+			name: "prompt snoozed, ok",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						humanAddedEvent(),
+					),
+					expectPush(
+						user.NewHumanPasskeyPromptSnoozedEvent(context.Background(),
+							&user.NewAggregate("user1", "org1").Aggregate,
+						),
+					),
+				),
+			},
+			args: args{
+				ctx:           context.Background(),
+				command:       func(c *Commands) func(context.Context, string, string) error { return c.HumanSnoozePasskeyPrompt },
+				userID:        "user1",
+				resourceOwner: "org1",
+			},
+		},
+		{
+			name: "prompt opted out, ok",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						humanAddedEvent(),
+					),
+					expectPush(
+						user.NewHumanPasskeyPromptOptedOutEvent(context.Background(),
+							&user.NewAggregate("user1", "org1").Aggregate,
+						),
+					),
+				),
+			},
+			args: args{
+				ctx:           context.Background(),
+				command:       func(c *Commands) func(context.Context, string, string) error { return c.HumanOptOutPasskeyPrompt },
+				userID:        "user1",
+				resourceOwner: "org1",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := &Commands{
+				eventstore: tt.fields.eventstore(t),
+			}
+			err := tt.args.command(r)(tt.args.ctx, tt.args.userID, tt.args.resourceOwner)
+			if tt.res.err == nil {
+				assert.NoError(t, err)
+			}
+			if tt.res.err != nil && !tt.res.err(err) {
+				t.Errorf("got wrong err: %v ", err)
+			}
+		})
+	}
+}
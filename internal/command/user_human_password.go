@@ -224,6 +224,25 @@ func (c *Commands) checkPasswordComplexity(ctx context.Context, newPassword stri
 	if err := policy.Check(newPassword); err != nil {
 		return err
 	}
+	return c.checkPasswordBreached(ctx, policy.CheckBreached, newPassword)
+}
+
+// checkPasswordBreached rejects the password if it is known to be part of a data breach
+// and the check is enabled on the password complexity policy
+func (c *Commands) checkPasswordBreached(ctx context.Context, checkBreached bool, password string) (err error) {
+	if !checkBreached || password == "" || c.passwordBreachChecker == nil {
+		return nil
+	}
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	breached, err := c.passwordBreachChecker.IsBreached(ctx, password)
+	if err != nil {
+		return err
+	}
+	if breached {
+		return zerrors.ThrowInvalidArgument(nil, "COMMAND-Hb3ks", "Errors.User.PasswordComplexityPolicy.Breached")
+	}
 	return nil
 }
 
@@ -311,7 +330,8 @@ func (c *Commands) HumanCheckPassword(ctx context.Context, orgID, userID, passwo
 	if err != nil {
 		return zerrors.ThrowPreconditionFailed(err, "COMMAND-Edf3g", "Errors.Org.LoginPolicy.NotFound")
 	}
-	if !loginPolicy.AllowUsernamePassword {
+	// break-glass users must be able to log in locally even if only external identity providers are allowed
+	if !loginPolicy.AllowUsernamePassword && !c.isBreakGlassUser(ctx, userID, "") {
 		return zerrors.ThrowPreconditionFailed(err, "COMMAND-Dft32", "Errors.Org.LoginPolicy.UsernamePasswordNotAllowed")
 	}
 	commands, err := checkPassword(ctx, userID, password, c.eventstore, c.userPasswordHasher, authRequestDomainToAuthRequestInfo(authRequest))
@@ -15,6 +15,7 @@ import (
 	"github.com/zitadel/zitadel/internal/crypto"
 	"github.com/zitadel/zitadel/internal/domain"
 	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/passwordbreach"
 	"github.com/zitadel/zitadel/internal/repository/org"
 	"github.com/zitadel/zitadel/internal/repository/user"
 	"github.com/zitadel/zitadel/internal/zerrors"
@@ -148,6 +149,7 @@ func TestCommandSide_SetOneTimePassword(t *testing.T) {
 								false,
 								false,
 								false,
+								false,
 							),
 						),
 					),
@@ -210,6 +212,7 @@ func TestCommandSide_SetOneTimePassword(t *testing.T) {
 								false,
 								false,
 								false,
+								false,
 							),
 						),
 					),
@@ -458,6 +461,7 @@ func TestCommandSide_SetPasswordWithVerifyCode(t *testing.T) {
 								false,
 								false,
 								false,
+								false,
 							),
 						),
 					),
@@ -534,6 +538,7 @@ func TestCommandSide_SetPasswordWithVerifyCode(t *testing.T) {
 								false,
 								false,
 								false,
+								false,
 							),
 						),
 					),
@@ -611,6 +616,7 @@ func TestCommandSide_SetPasswordWithVerifyCode(t *testing.T) {
 								false,
 								false,
 								false,
+								false,
 							),
 						),
 					),
@@ -830,6 +836,7 @@ func TestCommandSide_ChangePassword(t *testing.T) {
 							true,
 							true,
 							true,
+							false,
 						),
 					),
 				),
@@ -932,6 +939,7 @@ func TestCommandSide_ChangePassword(t *testing.T) {
 							false,
 							false,
 							false,
+							false,
 						),
 					),
 				),
@@ -1000,6 +1008,7 @@ func TestCommandSide_ChangePassword(t *testing.T) {
 							false,
 							false,
 							false,
+							false,
 						),
 					),
 				),
@@ -1069,6 +1078,7 @@ func TestCommandSide_ChangePassword(t *testing.T) {
 							false,
 							false,
 							false,
+							false,
 						),
 					),
 				),
@@ -1500,6 +1510,7 @@ func TestCommandSide_CheckPassword(t *testing.T) {
 				eventstore: expectEventstore(
 					expectFilter(),
 					expectFilter(),
+					expectFilter(),
 				),
 			},
 			args: args{
@@ -1537,9 +1548,16 @@ func TestCommandSide_CheckPassword(t *testing.T) {
 								time.Hour*3,
 								time.Hour*4,
 								time.Hour*5,
+								time.Hour*6,
+								false,
+								0,
+								0,
+								false,
+								0,
 							),
 						),
 					),
+					expectFilter(),
 				),
 			},
 			args: args{
@@ -1577,6 +1595,12 @@ func TestCommandSide_CheckPassword(t *testing.T) {
 								time.Hour*3,
 								time.Hour*4,
 								time.Hour*5,
+								time.Hour*6,
+								false,
+								0,
+								0,
+								false,
+								0,
 							),
 						),
 					),
@@ -1618,6 +1642,12 @@ func TestCommandSide_CheckPassword(t *testing.T) {
 								time.Hour*3,
 								time.Hour*4,
 								time.Hour*5,
+								time.Hour*6,
+								false,
+								0,
+								0,
+								false,
+								0,
 							),
 						),
 					),
@@ -1679,6 +1709,12 @@ func TestCommandSide_CheckPassword(t *testing.T) {
 								time.Hour*3,
 								time.Hour*4,
 								time.Hour*5,
+								time.Hour*6,
+								false,
+								0,
+								0,
+								false,
+								0,
 							),
 						),
 					),
@@ -1736,6 +1772,12 @@ func TestCommandSide_CheckPassword(t *testing.T) {
 								time.Hour*3,
 								time.Hour*4,
 								time.Hour*5,
+								time.Hour*6,
+								false,
+								0,
+								0,
+								false,
+								0,
 							),
 						),
 					),
@@ -1825,6 +1867,12 @@ func TestCommandSide_CheckPassword(t *testing.T) {
 								time.Hour*3,
 								time.Hour*4,
 								time.Hour*5,
+								time.Hour*6,
+								false,
+								0,
+								0,
+								false,
+								0,
 							),
 						),
 					),
@@ -1918,6 +1966,12 @@ func TestCommandSide_CheckPassword(t *testing.T) {
 								time.Hour*3,
 								time.Hour*4,
 								time.Hour*5,
+								time.Hour*6,
+								false,
+								0,
+								0,
+								false,
+								0,
 							),
 						),
 					),
@@ -1998,6 +2052,12 @@ func TestCommandSide_CheckPassword(t *testing.T) {
 								time.Hour*3,
 								time.Hour*4,
 								time.Hour*5,
+								time.Hour*6,
+								false,
+								0,
+								0,
+								false,
+								0,
 							),
 						),
 					),
@@ -2083,6 +2143,12 @@ func TestCommandSide_CheckPassword(t *testing.T) {
 								time.Hour*3,
 								time.Hour*4,
 								time.Hour*5,
+								time.Hour*6,
+								false,
+								0,
+								0,
+								false,
+								0,
 							),
 						),
 					),
@@ -2162,6 +2228,12 @@ func TestCommandSide_CheckPassword(t *testing.T) {
 								time.Hour*3,
 								time.Hour*4,
 								time.Hour*5,
+								time.Hour*6,
+								false,
+								0,
+								0,
+								false,
+								0,
 							),
 						),
 					),
@@ -2286,3 +2358,61 @@ func Test_convertPasswapErr(t *testing.T) {
 		})
 	}
 }
+
+type mockPasswordBreachChecker struct {
+	breached bool
+	err      error
+}
+
+func (m *mockPasswordBreachChecker) IsBreached(context.Context, string) (bool, error) {
+	return m.breached, m.err
+}
+
+func TestCommands_checkPasswordBreached(t *testing.T) {
+	tests := []struct {
+		name          string
+		checker       passwordbreach.Checker
+		checkBreached bool
+		wantErr       func(error) bool
+	}{
+		{
+			name:          "check disabled",
+			checker:       &mockPasswordBreachChecker{breached: true},
+			checkBreached: false,
+		},
+		{
+			name:          "no checker",
+			checkBreached: true,
+		},
+		{
+			name:          "checker error",
+			checker:       &mockPasswordBreachChecker{err: zerrors.ThrowUnavailable(nil, "id", "unavailable")},
+			checkBreached: true,
+			wantErr:       zerrors.IsUnavailable,
+		},
+		{
+			name:          "breached",
+			checker:       &mockPasswordBreachChecker{breached: true},
+			checkBreached: true,
+			wantErr:       zerrors.IsErrorInvalidArgument,
+		},
+		{
+			name:          "not breached",
+			checker:       &mockPasswordBreachChecker{breached: false},
+			checkBreached: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &Commands{
+				passwordBreachChecker: tt.checker,
+			}
+			err := c.checkPasswordBreached(context.Background(), tt.checkBreached, "password")
+			if tt.wantErr != nil {
+				assert.True(t, tt.wantErr(err), "unexpected error: %v", err)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
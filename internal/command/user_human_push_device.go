@@ -0,0 +1,89 @@
+package command
+
+import (
+	"context"
+
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/repository/user"
+	"github.com/zitadel/zitadel/internal/telemetry/tracing"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+// PushDevice is a mobile device of a user able to receive push notifications
+type PushDevice struct {
+	Platform domain.PushPlatform
+	// Token is the registration token (FCM) or device token (APNs) issued to the app on the device
+	Token string
+	Name  string
+}
+
+func (d *PushDevice) IsValid() error {
+	if !d.Platform.Valid() {
+		return zerrors.ThrowInvalidArgument(nil, "COMMAND-Pd3nw", "Errors.Push.PlatformInvalid")
+	}
+	if d.Token == "" {
+		return zerrors.ThrowInvalidArgument(nil, "COMMAND-Pd6qk", "Errors.User.PushDevice.TokenMissing")
+	}
+	return nil
+}
+
+// AddHumanPushDevice registers the device of the user for push notifications.
+// If the token is already registered, the existing device is returned.
+func (c *Commands) AddHumanPushDevice(ctx context.Context, userID, resourceOwner string, device *PushDevice) (deviceID string, _ *domain.ObjectDetails, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	if userID == "" {
+		return "", nil, zerrors.ThrowInvalidArgument(nil, "COMMAND-Pd2xm", "Errors.User.UserIDMissing")
+	}
+	if err = device.IsValid(); err != nil {
+		return "", nil, err
+	}
+	existingHuman, err := c.getHumanWriteModelByID(ctx, userID, resourceOwner)
+	if err != nil {
+		return "", nil, err
+	}
+	if !isUserStateExists(existingHuman.UserState) {
+		return "", nil, zerrors.ThrowNotFound(nil, "COMMAND-Pd8vb", "Errors.User.NotFound")
+	}
+	writeModel := NewHumanPushDevicesWriteModel(userID, existingHuman.ResourceOwner)
+	if err = c.eventstore.FilterToQueryReducer(ctx, writeModel); err != nil {
+		return "", nil, err
+	}
+	if existing := writeModel.ActiveDeviceByToken(device.Platform, device.Token); existing != nil {
+		return existing.DeviceID, writeModelToObjectDetails(&writeModel.WriteModel), nil
+	}
+	deviceID, err = c.idGenerator.Next()
+	if err != nil {
+		return "", nil, err
+	}
+	userAgg := UserAggregateFromWriteModel(&existingHuman.WriteModel)
+	err = c.pushAppendAndReduce(ctx, writeModel, user.NewHumanPushDeviceAddedEvent(ctx, userAgg, deviceID, device.Platform, device.Token, device.Name))
+	if err != nil {
+		return "", nil, err
+	}
+	return deviceID, writeModelToObjectDetails(&writeModel.WriteModel), nil
+}
+
+// RemoveHumanPushDevice unregisters the device, so it no longer receives push notifications.
+func (c *Commands) RemoveHumanPushDevice(ctx context.Context, userID, deviceID, resourceOwner string) (_ *domain.ObjectDetails, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	if userID == "" || deviceID == "" {
+		return nil, zerrors.ThrowInvalidArgument(nil, "COMMAND-Pe4mq", "Errors.IDMissing")
+	}
+	writeModel := NewHumanPushDevicesWriteModel(userID, resourceOwner)
+	if err = c.eventstore.FilterToQueryReducer(ctx, writeModel); err != nil {
+		return nil, err
+	}
+	if writeModel.ActiveDevice(deviceID) == nil {
+		return nil, zerrors.ThrowNotFound(nil, "COMMAND-Pe9sk", "Errors.User.PushDevice.NotFound")
+	}
+	userAgg := UserAggregateFromWriteModel(&writeModel.WriteModel)
+	err = c.pushAppendAndReduce(ctx, writeModel, user.NewHumanPushDeviceRemovedEvent(ctx, userAgg, deviceID))
+	if err != nil {
+		return nil, err
+	}
+	return writeModelToObjectDetails(&writeModel.WriteModel), nil
+}
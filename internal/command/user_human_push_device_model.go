@@ -0,0 +1,97 @@
+package command
+
+import (
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/repository/user"
+)
+
+type HumanPushDevicesWriteModel struct {
+	eventstore.WriteModel
+
+	Devices []*HumanPushDeviceWriteModel
+}
+
+type HumanPushDeviceWriteModel struct {
+	DeviceID string
+	Platform domain.PushPlatform
+	Token    string
+	State    domain.PushDeviceState
+}
+
+func NewHumanPushDevicesWriteModel(userID, resourceOwner string) *HumanPushDevicesWriteModel {
+	return &HumanPushDevicesWriteModel{
+		WriteModel: eventstore.WriteModel{
+			AggregateID:   userID,
+			ResourceOwner: resourceOwner,
+		},
+	}
+}
+
+func (wm *HumanPushDevicesWriteModel) Reduce() error {
+	for _, event := range wm.Events {
+		switch e := event.(type) {
+		case *user.HumanPushDeviceAddedEvent:
+			wm.Devices = append(wm.Devices, &HumanPushDeviceWriteModel{
+				DeviceID: e.DeviceID,
+				Platform: e.Platform,
+				Token:    e.Token,
+				State:    domain.PushDeviceStateActive,
+			})
+		case *user.HumanPushDeviceRemovedEvent:
+			if device := wm.device(e.DeviceID); device != nil {
+				device.State = domain.PushDeviceStateRemoved
+			}
+		case *user.UserRemovedEvent:
+			for _, device := range wm.Devices {
+				device.State = domain.PushDeviceStateRemoved
+			}
+		}
+	}
+	return wm.WriteModel.Reduce()
+}
+
+func (wm *HumanPushDevicesWriteModel) Query() *eventstore.SearchQueryBuilder {
+	query := eventstore.NewSearchQueryBuilder(eventstore.ColumnsEvent).
+		AddQuery().
+		AggregateTypes(user.AggregateType).
+		AggregateIDs(wm.AggregateID).
+		EventTypes(
+			user.HumanPushDeviceAddedType,
+			user.HumanPushDeviceRemovedType,
+			user.UserRemovedType).
+		Builder()
+
+	if wm.ResourceOwner != "" {
+		query.ResourceOwner(wm.ResourceOwner)
+	}
+	return query
+}
+
+func (wm *HumanPushDevicesWriteModel) device(deviceID string) *HumanPushDeviceWriteModel {
+	for _, device := range wm.Devices {
+		if device.DeviceID == deviceID {
+			return device
+		}
+	}
+	return nil
+}
+
+// ActiveDevice returns the active device with the passed id, nil if there is none
+func (wm *HumanPushDevicesWriteModel) ActiveDevice(deviceID string) *HumanPushDeviceWriteModel {
+	device := wm.device(deviceID)
+	if device == nil || device.State != domain.PushDeviceStateActive {
+		return nil
+	}
+	return device
+}
+
+// ActiveDeviceByToken returns the active device registered with the token on the platform, nil if there is none
+func (wm *HumanPushDevicesWriteModel) ActiveDeviceByToken(platform domain.PushPlatform, token string) *HumanPushDeviceWriteModel {
+	for _, device := range wm.Devices {
+		if device.State == domain.PushDeviceStateActive && device.Platform == platform && device.Token == token {
+			return device
+		}
+	}
+	return nil
+}
@@ -0,0 +1,55 @@
+package command
+
+import (
+	"context"
+
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/repository/user"
+	"github.com/zitadel/zitadel/internal/telemetry/tracing"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+// AcceptTerms records the acceptance of the terms of service and privacy policy by the user.
+// Only the version currently active for the organization of the user can be accepted.
+func (c *Commands) AcceptTerms(ctx context.Context, userID, resourceOwner, version string) (_ *domain.ObjectDetails, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	if userID == "" {
+		return nil, zerrors.ThrowInvalidArgument(nil, "COMMAND-Ta1ui", "Errors.User.UserIDMissing")
+	}
+	existingHuman, err := c.getHumanWriteModelByID(ctx, userID, resourceOwner)
+	if err != nil {
+		return nil, err
+	}
+	if !isUserStateExists(existingHuman.UserState) {
+		return nil, zerrors.ThrowNotFound(nil, "COMMAND-Ta1nf", "Errors.User.NotFound")
+	}
+	active, err := c.activeTermsVersion(ctx, existingHuman.ResourceOwner)
+	if err != nil {
+		return nil, err
+	}
+	if active.Version == "" || active.Version != version {
+		return nil, zerrors.ThrowPreconditionFailed(nil, "COMMAND-Ta1na", "Errors.Policy.TermsVersion.NotActive")
+	}
+	userAgg := UserAggregateFromWriteModel(&existingHuman.WriteModel)
+	pushedEvents, err := c.eventstore.Push(ctx, user.NewHumanTermsAcceptedEvent(ctx, userAgg, version, active.ResourceOwner))
+	if err != nil {
+		return nil, err
+	}
+	return pushedEventsToObjectDetails(pushedEvents), nil
+}
+
+// AcceptActiveTerms records the acceptance of the version active for the organization of the user,
+// e.g. if the terms were confirmed as part of the registration.
+// Nothing is recorded if no version was published.
+func (c *Commands) AcceptActiveTerms(ctx context.Context, userID, resourceOwner string) (_ *domain.ObjectDetails, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	active, err := c.activeTermsVersion(ctx, resourceOwner)
+	if err != nil || active.Version == "" {
+		return nil, err
+	}
+	return c.AcceptTerms(ctx, userID, resourceOwner, active.Version)
+}
@@ -0,0 +1,345 @@
+package command
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/text/language"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/repository/instance"
+	"github.com/zitadel/zitadel/internal/repository/org"
+	"github.com/zitadel/zitadel/internal/repository/user"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+func TestCommandSide_AcceptTerms(t *testing.T) {
+	type fields struct {
+		eventstore func(*testing.T) *eventstore.Eventstore
+	}
+	type args struct {
+		ctx           context.Context
+		userID        string
+		resourceOwner string
+		version       string
+	}
+	type res struct {
+		want *domain.ObjectDetails
+		err  func(error) bool
+	}
+	humanAddedEvent := func() eventstore.Event {
+		return eventFromEventPusher(
+			user.NewHumanAddedEvent(context.Background(),
+				&user.NewAggregate("user1", "org1").Aggregate,
+				"username",
+				"firstname",
+				"lastname",
+				"nickname",
+				"displayname",
+				language.German,
+				domain.GenderUnspecified,
+				"email@test.ch",
+				true,
+			),
+		)
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		args   args
+		res    res
+	}{
+		{
+			name: "user id missing, invalid argument error",
+			fields: fields{
+				eventstore: expectEventstore(),
+			},
+			args: args{
+				ctx:           context.Background(),
+				resourceOwner: "org1",
+				version:       "v1",
+			},
+			res: res{
+				err: zerrors.IsErrorInvalidArgument,
+			},
+		},
+		{
+			name: "user not existing, not found error",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(),
+				),
+			},
+			args: args{
+				ctx:           context.Background(),
+				userID:        "user1",
+				resourceOwner: "org1",
+				version:       "v1",
+			},
+			res: res{
+				err: zerrors.IsNotFound,
+			},
+		},
+		{
+			name: "no version published, precondition error",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						humanAddedEvent(),
+					),
+					expectFilter(),
+					expectFilter(),
+				),
+			},
+			args: args{
+				ctx:           authz.WithInstanceID(context.Background(), "INSTANCE"),
+				userID:        "user1",
+				resourceOwner: "org1",
+				version:       "v1",
+			},
+			res: res{
+				err: zerrors.IsPreconditionFailed,
+			},
+		},
+		{
+			name: "version not active, precondition error",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						humanAddedEvent(),
+					),
+					expectFilter(
+						eventFromEventPusher(
+							org.NewTermsVersionPublishedEvent(context.Background(),
+								&org.NewAggregate("org1").Aggregate,
+								"v2",
+								"TOSLink",
+								"PrivacyLink",
+							),
+						),
+					),
+				),
+			},
+			args: args{
+				ctx:           context.Background(),
+				userID:        "user1",
+				resourceOwner: "org1",
+				version:       "v1",
+			},
+			res: res{
+				err: zerrors.IsPreconditionFailed,
+			},
+		},
+		{
+			name: "accept org version, ok",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						humanAddedEvent(),
+					),
+					expectFilter(
+						eventFromEventPusher(
+							org.NewTermsVersionPublishedEvent(context.Background(),
+								&org.NewAggregate("org1").Aggregate,
+								"v1",
+								"TOSLink",
+								"PrivacyLink",
+							),
+						),
+					),
+					expectPush(
+						user.NewHumanTermsAcceptedEvent(context.Background(),
+							&user.NewAggregate("user1", "org1").Aggregate,
+							"v1",
+							"org1",
+						),
+					),
+				),
+			},
+			args: args{
+				ctx:           context.Background(),
+				userID:        "user1",
+				resourceOwner: "org1",
+				version:       "v1",
+			},
+			res: res{
+				want: &domain.ObjectDetails{
+					ResourceOwner: "org1",
+				},
+			},
+		},
+		{
+			name: "accept default version, ok",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						humanAddedEvent(),
+					),
+					expectFilter(),
+					expectFilter(
+						eventFromEventPusher(
+							instance.NewTermsVersionPublishedEvent(context.Background(),
+								&instance.NewAggregate("INSTANCE").Aggregate,
+								"v1",
+								"TOSLink",
+								"PrivacyLink",
+							),
+						),
+					),
+					expectPush(
+						user.NewHumanTermsAcceptedEvent(context.Background(),
+							&user.NewAggregate("user1", "org1").Aggregate,
+							"v1",
+							"INSTANCE",
+						),
+					),
+				),
+			},
+			args: args{
+				ctx:           authz.WithInstanceID(context.Background(), "INSTANCE"),
+				userID:        "user1",
+				resourceOwner: "org1",
+				version:       "v1",
+			},
+			res: res{
+				want: &domain.ObjectDetails{
+					ResourceOwner: "org1",
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := &Commands{
+				eventstore: tt.fields.eventstore(t),
+			}
+			got, err := r.AcceptTerms(tt.args.ctx, tt.args.userID, tt.args.resourceOwner, tt.args.version)
+			if tt.res.err == nil {
+				assert.NoError(t, err)
+			}
+			if tt.res.err != nil && !tt.res.err(err) {
+				t.Errorf("got wrong err: %v ", err)
+			}
+			if tt.res.err == nil {
+				assert.Equal(t, tt.res.want, got)
+			}
+		})
+	}
+}
+
+func TestCommandSide_AcceptActiveTerms(t *testing.T) {
+	type fields struct {
+		eventstore func(*testing.T) *eventstore.Eventstore
+	}
+	type args struct {
+		ctx           context.Context
+		userID        string
+		resourceOwner string
+	}
+	type res struct {
+		want *domain.ObjectDetails
+		err  func(error) bool
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		args   args
+		res    res
+	}{
+		{
+			name: "no version published, nothing recorded",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(),
+					expectFilter(),
+				),
+			},
+			args: args{
+				ctx:           authz.WithInstanceID(context.Background(), "INSTANCE"),
+				userID:        "user1",
+				resourceOwner: "org1",
+			},
+			res: res{},
+		},
+		{
+			name: "accept active version, ok",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(
+							org.NewTermsVersionPublishedEvent(context.Background(),
+								&org.NewAggregate("org1").Aggregate,
+								"v1",
+								"TOSLink",
+								"PrivacyLink",
+							),
+						),
+					),
+					expectFilter(
+						eventFromEventPusher(
+							user.NewHumanAddedEvent(context.Background(),
+								&user.NewAggregate("user1", "org1").Aggregate,
+								"username",
+								"firstname",
+								"lastname",
+								"nickname",
+								"displayname",
+								language.German,
+								domain.GenderUnspecified,
+								"email@test.ch",
+								true,
+							),
+						),
+					),
+					expectFilter(
+						eventFromEventPusher(
+							org.NewTermsVersionPublishedEvent(context.Background(),
+								&org.NewAggregate("org1").Aggregate,
+								"v1",
+								"TOSLink",
+								"PrivacyLink",
+							),
+						),
+					),
+					expectPush(
+						user.NewHumanTermsAcceptedEvent(context.Background(),
+							&user.NewAggregate("user1", "org1").Aggregate,
+							"v1",
+							"org1",
+						),
+					),
+				),
+			},
+			args: args{
+				ctx:           context.Background(),
+				userID:        "user1",
+				resourceOwner: "org1",
+			},
+			res: res{
+				want: &domain.ObjectDetails{
+					ResourceOwner: "org1",
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := &Commands{
+				eventstore: tt.fields.eventstore(t),
+			}
+			got, err := r.AcceptActiveTerms(tt.args.ctx, tt.args.userID, tt.args.resourceOwner)
+			if tt.res.err == nil {
+				assert.NoError(t, err)
+			}
+			if tt.res.err != nil && !tt.res.err(err) {
+				t.Errorf("got wrong err: %v ", err)
+			}
+			if tt.res.err == nil {
+				assert.Equal(t, tt.res.want, got)
+			}
+		})
+	}
+}
@@ -218,6 +218,7 @@ func TestCommandSide_AddHuman(t *testing.T) {
 							),
 						),
 					),
+					expectFilter(),
 					expectPush(
 						user.NewHumanAddedEvent(context.Background(),
 							&userAgg.Aggregate,
@@ -286,6 +287,7 @@ func TestCommandSide_AddHuman(t *testing.T) {
 							),
 						),
 					),
+					expectFilter(),
 					expectPush(
 						user.NewHumanAddedEvent(context.Background(),
 							&userAgg.Aggregate,
@@ -355,6 +357,7 @@ func TestCommandSide_AddHuman(t *testing.T) {
 							),
 						),
 					),
+					expectFilter(),
 					expectPush(
 						user.NewHumanAddedEvent(context.Background(),
 							&userAgg.Aggregate,
@@ -433,9 +436,11 @@ func TestCommandSide_AddHuman(t *testing.T) {
 								false,
 								false,
 								false,
+								false,
 							),
 						),
 					),
+					expectFilter(),
 					expectPush(
 						newAddHumanEvent("$plain$x$password", false, true, "", AllowedLanguage),
 						user.NewHumanInitialCodeAddedEvent(context.Background(),
@@ -503,9 +508,11 @@ func TestCommandSide_AddHuman(t *testing.T) {
 								false,
 								false,
 								false,
+								false,
 							),
 						),
 					),
+					expectFilter(),
 					expectPush(
 						newAddHumanEvent("$plain$x$password", false, true, "", AllowedLanguage),
 						user.NewHumanEmailCodeAddedEventV2(context.Background(),
@@ -576,9 +583,11 @@ func TestCommandSide_AddHuman(t *testing.T) {
 								false,
 								false,
 								false,
+								false,
 							),
 						),
 					),
+					expectFilter(),
 					expectPush(
 						newAddHumanEvent("$plain$x$password", false, true, "", AllowedLanguage),
 						user.NewHumanEmailCodeAddedEventV2(context.Background(),
@@ -650,9 +659,11 @@ func TestCommandSide_AddHuman(t *testing.T) {
 								false,
 								false,
 								false,
+								false,
 							),
 						),
 					),
+					expectFilter(),
 					expectPush(
 						newAddHumanEvent("$plain$x$password", true, true, "", AllowedLanguage),
 						user.NewHumanEmailVerifiedEvent(context.Background(),
@@ -713,9 +724,11 @@ func TestCommandSide_AddHuman(t *testing.T) {
 								false,
 								false,
 								false,
+								false,
 							),
 						),
 					),
+					expectFilter(),
 					expectPush(
 						newAddHumanEvent("$plain$x$password", true, true, "", AllowedLanguage),
 						user.NewHumanEmailVerifiedEvent(context.Background(),
@@ -776,9 +789,11 @@ func TestCommandSide_AddHuman(t *testing.T) {
 								false,
 								false,
 								false,
+								false,
 							),
 						),
 					),
+					expectFilter(),
 					expectPush(
 						newAddHumanEvent("$plain$x$password", true, false, "", AllowedLanguage),
 						user.NewHumanEmailVerifiedEvent(context.Background(),
@@ -899,9 +914,11 @@ func TestCommandSide_AddHuman(t *testing.T) {
 								false,
 								false,
 								false,
+								false,
 							),
 						),
 					),
+					expectFilter(),
 					expectPush(
 						func() eventstore.Command {
 							event := user.NewHumanAddedEvent(context.Background(),
@@ -978,9 +995,11 @@ func TestCommandSide_AddHuman(t *testing.T) {
 								false,
 								false,
 								false,
+								false,
 							),
 						),
 					),
+					expectFilter(),
 					expectPush(
 						newAddHumanEvent("$plain$x$password", false, true, "+41711234567", AllowedLanguage),
 						user.NewHumanEmailVerifiedEvent(
@@ -1046,6 +1065,7 @@ func TestCommandSide_AddHuman(t *testing.T) {
 							),
 						),
 					),
+					expectFilter(),
 					expectPush(
 						newAddHumanEvent("", false, true, "+41711234567", AllowedLanguage),
 						user.NewHumanInitialCodeAddedEvent(
@@ -1119,9 +1139,11 @@ func TestCommandSide_AddHuman(t *testing.T) {
 								false,
 								false,
 								false,
+								false,
 							),
 						),
 					),
+					expectFilter(),
 					expectPush(
 						newAddHumanEvent("$plain$x$password", false, true, "+41711234567", AllowedLanguage),
 						user.NewHumanEmailVerifiedEvent(context.Background(),
@@ -1188,6 +1210,7 @@ func TestCommandSide_AddHuman(t *testing.T) {
 							),
 						),
 					),
+					expectFilter(),
 					expectPush(
 						newAddHumanEvent("", false, true, "", AllowedLanguage),
 						user.NewHumanInitialCodeAddedEvent(
@@ -1418,6 +1441,7 @@ func TestCommandSide_ImportHuman(t *testing.T) {
 										false,
 										false,
 										false,
+										false,
 									),
 								),
 							),
@@ -1463,6 +1487,7 @@ func TestCommandSide_ImportHuman(t *testing.T) {
 										false,
 										false,
 										false,
+										false,
 									),
 								),
 							),
@@ -1550,6 +1575,7 @@ func TestCommandSide_ImportHuman(t *testing.T) {
 										false,
 										false,
 										false,
+										false,
 									),
 								),
 							),
@@ -1631,6 +1657,7 @@ func TestCommandSide_ImportHuman(t *testing.T) {
 										false,
 										false,
 										false,
+										false,
 									),
 								),
 							),
@@ -1731,6 +1758,7 @@ func TestCommandSide_ImportHuman(t *testing.T) {
 										false,
 										false,
 										false,
+										false,
 									),
 								),
 							),
@@ -1835,6 +1863,7 @@ func TestCommandSide_ImportHuman(t *testing.T) {
 										false,
 										false,
 										false,
+										false,
 									),
 								),
 							),
@@ -1937,6 +1966,7 @@ func TestCommandSide_ImportHuman(t *testing.T) {
 										false,
 										false,
 										false,
+										false,
 									),
 								),
 							),
@@ -2033,6 +2063,7 @@ func TestCommandSide_ImportHuman(t *testing.T) {
 										false,
 										false,
 										false,
+										false,
 									),
 								),
 							),
@@ -2119,6 +2150,7 @@ func TestCommandSide_ImportHuman(t *testing.T) {
 										false,
 										false,
 										false,
+										false,
 									),
 								),
 							),
@@ -2206,6 +2238,7 @@ func TestCommandSide_ImportHuman(t *testing.T) {
 										false,
 										false,
 										false,
+										false,
 									),
 								),
 							),
@@ -2352,6 +2385,7 @@ func TestCommandSide_ImportHuman(t *testing.T) {
 										false,
 										false,
 										false,
+										false,
 									),
 								),
 							),
@@ -2968,6 +3002,7 @@ func TestAddHumanCommand(t *testing.T) {
 									true,
 									true,
 									true,
+									false,
 								),
 							}, nil
 						}).
@@ -3021,9 +3056,14 @@ func TestAddHumanCommand(t *testing.T) {
 									false,
 									false,
 									false,
+									false,
 								),
 							}, nil
 						}).
+					Append(
+						func(ctx context.Context, queryFactory *eventstore.SearchQueryBuilder) ([]eventstore.Event, error) {
+							return []eventstore.Event{}, nil
+						}).
 					Filter(),
 			},
 			want: Want{
@@ -3092,9 +3132,14 @@ func TestAddHumanCommand(t *testing.T) {
 									false,
 									false,
 									false,
+									false,
 								),
 							}, nil
 						}).
+					Append(
+						func(ctx context.Context, queryFactory *eventstore.SearchQueryBuilder) ([]eventstore.Event, error) {
+							return []eventstore.Event{}, nil
+						}).
 					Filter(),
 			},
 			want: Want{
@@ -3164,9 +3209,14 @@ func TestAddHumanCommand(t *testing.T) {
 									false,
 									false,
 									false,
+									false,
 								),
 							}, nil
 						}).
+					Append(
+						func(ctx context.Context, queryFactory *eventstore.SearchQueryBuilder) ([]eventstore.Event, error) {
+							return []eventstore.Event{}, nil
+						}).
 					Filter(),
 			},
 			want: Want{
@@ -3236,6 +3286,7 @@ func TestAddHumanCommand(t *testing.T) {
 									false,
 									false,
 									false,
+									false,
 								),
 							}, nil
 						}).
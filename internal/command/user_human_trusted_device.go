@@ -0,0 +1,74 @@
+package command
+
+import (
+	"context"
+	"time"
+
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/repository/user"
+	"github.com/zitadel/zitadel/internal/telemetry/tracing"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+// AddHumanTrustedDevice marks the user agent as trusted by the user,
+// so the multi-factor check can be skipped for it until the expiration.
+func (c *Commands) AddHumanTrustedDevice(ctx context.Context, userID, resourceOwner, userAgentID, description string, expiration time.Time) (deviceID string, _ *domain.ObjectDetails, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	if userID == "" {
+		return "", nil, zerrors.ThrowInvalidArgument(nil, "COMMAND-Tq2kd", "Errors.User.UserIDMissing")
+	}
+	if userAgentID == "" {
+		return "", nil, zerrors.ThrowInvalidArgument(nil, "COMMAND-Tq9sm", "Errors.User.TrustedDevice.UserAgentMissing")
+	}
+	if !expiration.After(time.Now()) {
+		return "", nil, zerrors.ThrowInvalidArgument(nil, "COMMAND-Tq4nw", "Errors.User.TrustedDevice.ExpirationInvalid")
+	}
+	existingHuman, err := c.getHumanWriteModelByID(ctx, userID, resourceOwner)
+	if err != nil {
+		return "", nil, err
+	}
+	if !isUserStateExists(existingHuman.UserState) {
+		return "", nil, zerrors.ThrowNotFound(nil, "COMMAND-Tq7vb", "Errors.User.NotFound")
+	}
+	deviceID, err = c.idGenerator.Next()
+	if err != nil {
+		return "", nil, err
+	}
+	userAgg := UserAggregateFromWriteModel(&existingHuman.WriteModel)
+	pushedEvents, err := c.eventstore.Push(ctx, user.NewHumanTrustedDeviceAddedEvent(ctx, userAgg, deviceID, userAgentID, description, expiration))
+	if err != nil {
+		return "", nil, err
+	}
+	return deviceID, pushedEventsToObjectDetails(pushedEvents), nil
+}
+
+// RemoveHumanTrustedDevice revokes the trust of the device,
+// so the multi-factor check is required again on the next login.
+func (c *Commands) RemoveHumanTrustedDevice(ctx context.Context, userID, deviceID, resourceOwner string) (_ *domain.ObjectDetails, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	if userID == "" || deviceID == "" {
+		return nil, zerrors.ThrowInvalidArgument(nil, "COMMAND-Tr3mq", "Errors.IDMissing")
+	}
+	writeModel := NewHumanTrustedDeviceWriteModel(userID, deviceID, resourceOwner)
+	err = c.eventstore.FilterToQueryReducer(ctx, writeModel)
+	if err != nil {
+		return nil, err
+	}
+	if !writeModel.Exists() {
+		return nil, zerrors.ThrowNotFound(nil, "COMMAND-Tr8sk", "Errors.User.TrustedDevice.NotFound")
+	}
+	userAgg := UserAggregateFromWriteModel(&writeModel.WriteModel)
+	pushedEvents, err := c.eventstore.Push(ctx, user.NewHumanTrustedDeviceRemovedEvent(ctx, userAgg, deviceID))
+	if err != nil {
+		return nil, err
+	}
+	err = AppendAndReduce(writeModel, pushedEvents...)
+	if err != nil {
+		return nil, err
+	}
+	return writeModelToObjectDetails(&writeModel.WriteModel), nil
+}
@@ -0,0 +1,58 @@
+package command
+
+import (
+	"context"
+
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/repository/user"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+// RequestUsernameRecovery requests a notification containing the login names of a specific user
+func (c *Commands) RequestUsernameRecovery(ctx context.Context, userID, resourceOwner string, notifyType domain.NotificationType, authRequestID string) (objectDetails *domain.ObjectDetails, err error) {
+	if userID == "" {
+		return nil, zerrors.ThrowInvalidArgument(nil, "COMMAND-Urq1s", "Errors.User.UserIDMissing")
+	}
+
+	existingHuman, err := c.userWriteModelByID(ctx, userID, resourceOwner)
+	if err != nil {
+		return nil, err
+	}
+	if !isUserStateExists(existingHuman.UserState) {
+		return nil, zerrors.ThrowPreconditionFailed(nil, "COMMAND-Urq2s", "Errors.User.NotFound")
+	}
+	if existingHuman.UserType != domain.UserTypeHuman {
+		return nil, zerrors.ThrowPreconditionFailed(nil, "COMMAND-Urq3s", "Errors.User.NotHuman")
+	}
+	if existingHuman.UserState != domain.UserStateActive && existingHuman.UserState != domain.UserStateInitial {
+		return nil, zerrors.ThrowPreconditionFailed(nil, "COMMAND-Urq4s", "Errors.User.ShouldBeActiveOrInitial")
+	}
+	userAgg := UserAggregateFromWriteModel(&existingHuman.WriteModel)
+	pushedEvents, err := c.eventstore.Push(ctx, user.NewHumanUsernameRecoveryRequestedEvent(ctx, userAgg, notifyType, authRequestID))
+	if err != nil {
+		return nil, err
+	}
+	err = AppendAndReduce(existingHuman, pushedEvents...)
+	if err != nil {
+		return nil, err
+	}
+	return writeModelToObjectDetails(&existingHuman.WriteModel), nil
+}
+
+// UsernameRecoverySent notification with the login names of the user was sent
+func (c *Commands) UsernameRecoverySent(ctx context.Context, orgID, userID string) (err error) {
+	if userID == "" {
+		return zerrors.ThrowInvalidArgument(nil, "COMMAND-Urs1s", "Errors.User.UserIDMissing")
+	}
+
+	existingHuman, err := c.userWriteModelByID(ctx, userID, orgID)
+	if err != nil {
+		return err
+	}
+	if !isUserStateExists(existingHuman.UserState) {
+		return zerrors.ThrowPreconditionFailed(nil, "COMMAND-Urs2s", "Errors.User.NotFound")
+	}
+	userAgg := UserAggregateFromWriteModel(&existingHuman.WriteModel)
+	_, err = c.eventstore.Push(ctx, user.NewHumanUsernameRecoverySentEvent(ctx, userAgg))
+	return err
+}
@@ -0,0 +1,173 @@
+package command
+
+import (
+	"context"
+	"encoding/json"
+
+	"golang.org/x/text/language"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/domain"
+	exec "github.com/zitadel/zitadel/internal/execution"
+	exec_repo "github.com/zitadel/zitadel/internal/repository/execution"
+	"github.com/zitadel/zitadel/internal/telemetry/tracing"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+// HumanValidation contains the values of a human user before they are persisted.
+// The targets of [domain.FunctionPreHumanUserCreation] and [domain.FunctionPreHumanProfileChange]
+// can normalize the values by responding with the changed object
+// or reject the command by responding with a 4xx status code and an [exec.Rejection].
+type HumanValidation struct {
+	Username          string              `json:"username,omitempty"`
+	FirstName         string              `json:"firstName,omitempty"`
+	LastName          string              `json:"lastName,omitempty"`
+	NickName          string              `json:"nickName,omitempty"`
+	DisplayName       string              `json:"displayName,omitempty"`
+	PreferredLanguage language.Tag        `json:"preferredLanguage,omitempty"`
+	Gender            domain.Gender       `json:"gender,omitempty"`
+	Email             domain.EmailAddress `json:"email,omitempty"`
+	Phone             domain.PhoneNumber  `json:"phone,omitempty"`
+}
+
+var _ exec.ContextInfo = &ContextInfoHumanValidation{}
+
+// ContextInfoHumanValidation is the payload sent to the targets of the human validation functions
+type ContextInfoHumanValidation struct {
+	Function   string           `json:"function,omitempty"`
+	InstanceID string           `json:"instanceID,omitempty"`
+	OrgID      string           `json:"orgID,omitempty"`
+	UserID     string           `json:"userID,omitempty"`
+	Human      *HumanValidation `json:"human,omitempty"`
+}
+
+func (c *ContextInfoHumanValidation) GetHTTPRequestBody() []byte {
+	data, err := json.Marshal(c)
+	if err != nil {
+		return nil
+	}
+	return data
+}
+
+func (c *ContextInfoHumanValidation) SetHTTPResponseBody(resp []byte) error {
+	return json.Unmarshal(resp, c.Human)
+}
+
+func (c *ContextInfoHumanValidation) GetContent() interface{} {
+	return c.Human
+}
+
+// validateHuman calls the targets of the function execution,
+// the values of the human are changed to the normalized values returned by the targets.
+func (c *Commands) validateHuman(ctx context.Context, function, orgID, userID string, human *HumanValidation) (err error) {
+	if c.ExecutionTargets == nil {
+		return nil
+	}
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	targets, err := c.ExecutionTargets(ctx, []string{exec_repo.ID(domain.ExecutionTypeFunction, function)})
+	if err != nil || len(targets) == 0 {
+		return err
+	}
+	_, err = exec.CallTargets(ctx, targets, &ContextInfoHumanValidation{
+		Function:   function,
+		InstanceID: authz.GetInstance(ctx).InstanceID(),
+		OrgID:      orgID,
+		UserID:     userID,
+		Human:      human,
+	})
+	return err
+}
+
+// validateAddHuman calls the targets of [domain.FunctionPreHumanUserCreation]
+// and validates the human again if the values were normalized.
+func (c *Commands) validateAddHuman(ctx context.Context, orgID string, human *AddHuman) error {
+	validation := &HumanValidation{
+		Username:          human.Username,
+		FirstName:         human.FirstName,
+		LastName:          human.LastName,
+		NickName:          human.NickName,
+		DisplayName:       human.DisplayName,
+		PreferredLanguage: human.PreferredLanguage,
+		Gender:            human.Gender,
+		Email:             human.Email.Address,
+		Phone:             human.Phone.Number,
+	}
+	original := *validation
+	if err := c.validateHuman(ctx, domain.FunctionPreHumanUserCreation, orgID, human.ID, validation); err != nil || *validation == original {
+		return err
+	}
+	human.Username = validation.Username
+	human.FirstName = validation.FirstName
+	human.LastName = validation.LastName
+	human.NickName = validation.NickName
+	human.DisplayName = validation.DisplayName
+	human.PreferredLanguage = validation.PreferredLanguage
+	human.Gender = validation.Gender
+	human.Email.Address = validation.Email
+	human.Phone.Number = validation.Phone
+	return human.Validate(c.userPasswordHasher)
+}
+
+// validateChangeProfile calls the targets of [domain.FunctionPreHumanProfileChange]
+// with the profile as it will be after the change.
+func (c *Commands) validateChangeProfile(ctx context.Context, wm *UserV2WriteModel, profile *Profile) error {
+	validation := &HumanValidation{
+		Username:          wm.UserName,
+		FirstName:         valueOrDefault(profile.FirstName, wm.FirstName),
+		LastName:          valueOrDefault(profile.LastName, wm.LastName),
+		NickName:          valueOrDefault(profile.NickName, wm.NickName),
+		DisplayName:       valueOrDefault(profile.DisplayName, wm.DisplayName),
+		PreferredLanguage: valueOrDefault(profile.PreferredLanguage, wm.PreferredLanguage),
+		Gender:            valueOrDefault(profile.Gender, wm.Gender),
+	}
+	original := *validation
+	if err := c.validateHuman(ctx, domain.FunctionPreHumanProfileChange, wm.ResourceOwner, wm.AggregateID, validation); err != nil || *validation == original {
+		return err
+	}
+	if validation.FirstName == "" {
+		return zerrors.ThrowInvalidArgument(nil, "COMMAND-w3ezk9lq2m", "Errors.User.Profile.FirstNameEmpty")
+	}
+	if validation.LastName == "" {
+		return zerrors.ThrowInvalidArgument(nil, "COMMAND-0xr6ht1dpa", "Errors.User.Profile.LastNameEmpty")
+	}
+	profile.FirstName = &validation.FirstName
+	profile.LastName = &validation.LastName
+	profile.NickName = &validation.NickName
+	profile.DisplayName = &validation.DisplayName
+	profile.PreferredLanguage = &validation.PreferredLanguage
+	profile.Gender = &validation.Gender
+	return nil
+}
+
+// validateChangeHumanProfile calls the targets of [domain.FunctionPreHumanProfileChange]
+// for the deprecated profile change.
+func (c *Commands) validateChangeHumanProfile(ctx context.Context, wm *HumanProfileWriteModel, profile *domain.Profile) error {
+	validation := &HumanValidation{
+		FirstName:         profile.FirstName,
+		LastName:          profile.LastName,
+		NickName:          profile.NickName,
+		DisplayName:       profile.DisplayName,
+		PreferredLanguage: profile.PreferredLanguage,
+		Gender:            profile.Gender,
+	}
+	original := *validation
+	if err := c.validateHuman(ctx, domain.FunctionPreHumanProfileChange, wm.ResourceOwner, wm.AggregateID, validation); err != nil || *validation == original {
+		return err
+	}
+	profile.FirstName = validation.FirstName
+	profile.LastName = validation.LastName
+	profile.NickName = validation.NickName
+	profile.DisplayName = validation.DisplayName
+	profile.PreferredLanguage = validation.PreferredLanguage
+	profile.Gender = validation.Gender
+	return profile.Validate()
+}
+
+func valueOrDefault[T any](value *T, defaultValue T) T {
+	if value == nil {
+		return defaultValue
+	}
+	return *value
+}
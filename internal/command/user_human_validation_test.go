@@ -0,0 +1,146 @@
+package command
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/text/language"
+
+	"github.com/zitadel/zitadel/internal/domain"
+	exec "github.com/zitadel/zitadel/internal/execution"
+	"github.com/zitadel/zitadel/internal/query"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+func humanValidationTargets(t *testing.T, handler func(w http.ResponseWriter, info *ContextInfoHumanValidation)) func(ctx context.Context, ids []string) ([]exec.Target, error) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, err := io.ReadAll(r.Body)
+		require.NoError(t, err)
+		info := new(ContextInfoHumanValidation)
+		require.NoError(t, json.Unmarshal(body, info))
+		handler(w, info)
+	}))
+	t.Cleanup(server.Close)
+	return func(ctx context.Context, ids []string) ([]exec.Target, error) {
+		assert.Equal(t, []string{"function/" + domain.FunctionPreHumanUserCreation}, ids)
+		return []exec.Target{
+			&query.ExecutionTarget{
+				TargetID:         "target",
+				TargetType:       domain.TargetTypeCall,
+				Endpoint:         server.URL,
+				Timeout:          time.Minute,
+				InterruptOnError: true,
+			},
+		}, nil
+	}
+}
+
+func TestCommands_validateAddHuman(t *testing.T) {
+	tests := []struct {
+		name             string
+		executionTargets func(t *testing.T) func(ctx context.Context, ids []string) ([]exec.Target, error)
+		want             *AddHuman
+		wantErr          func(error) bool
+	}{
+		{
+			name: "no execution targets, unchanged",
+			want: &AddHuman{
+				ID:                "user1",
+				Username:          "username",
+				FirstName:         "firstname",
+				LastName:          "lastname",
+				DisplayName:       "firstname lastname",
+				PreferredLanguage: language.English,
+				Email:             Email{Address: "email@test.ch"},
+			},
+		},
+		{
+			name: "no targets, unchanged",
+			executionTargets: func(t *testing.T) func(ctx context.Context, ids []string) ([]exec.Target, error) {
+				return func(ctx context.Context, ids []string) ([]exec.Target, error) {
+					return nil, nil
+				}
+			},
+			want: &AddHuman{
+				ID:                "user1",
+				Username:          "username",
+				FirstName:         "firstname",
+				LastName:          "lastname",
+				DisplayName:       "firstname lastname",
+				PreferredLanguage: language.English,
+				Email:             Email{Address: "email@test.ch"},
+			},
+		},
+		{
+			name: "rejected, invalid argument",
+			executionTargets: func(t *testing.T) func(ctx context.Context, ids []string) ([]exec.Target, error) {
+				return humanValidationTargets(t, func(w http.ResponseWriter, info *ContextInfoHumanValidation) {
+					w.WriteHeader(http.StatusBadRequest)
+					_, _ = io.WriteString(w, `{"message": "email domain not allowed"}`)
+				})
+			},
+			wantErr: zerrors.IsErrorInvalidArgument,
+		},
+		{
+			name: "normalized, changed",
+			executionTargets: func(t *testing.T) func(ctx context.Context, ids []string) ([]exec.Target, error) {
+				return humanValidationTargets(t, func(w http.ResponseWriter, info *ContextInfoHumanValidation) {
+					assert.Equal(t, domain.FunctionPreHumanUserCreation, info.Function)
+					assert.Equal(t, "org1", info.OrgID)
+					assert.Equal(t, "user1", info.UserID)
+					_, _ = io.WriteString(w, `{"username": "user@corp.ch", "email": "user@corp.ch", "phone": "+41 71 123 45 67"}`)
+				})
+			},
+			want: &AddHuman{
+				ID:                "user1",
+				Username:          "user@corp.ch",
+				FirstName:         "firstname",
+				LastName:          "lastname",
+				DisplayName:       "firstname lastname",
+				PreferredLanguage: language.English,
+				Email:             Email{Address: "user@corp.ch"},
+				Phone:             Phone{Number: "+41711234567"},
+			},
+		},
+		{
+			name: "normalized invalid, error",
+			executionTargets: func(t *testing.T) func(ctx context.Context, ids []string) ([]exec.Target, error) {
+				return humanValidationTargets(t, func(w http.ResponseWriter, info *ContextInfoHumanValidation) {
+					_, _ = io.WriteString(w, `{"firstName": " "}`)
+				})
+			},
+			wantErr: zerrors.IsErrorInvalidArgument,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &Commands{}
+			if tt.executionTargets != nil {
+				c.ExecutionTargets = tt.executionTargets(t)
+			}
+			human := &AddHuman{
+				ID:                "user1",
+				Username:          "username",
+				FirstName:         "firstname",
+				LastName:          "lastname",
+				DisplayName:       "firstname lastname",
+				PreferredLanguage: language.English,
+				Email:             Email{Address: "email@test.ch"},
+			}
+			err := c.validateAddHuman(context.Background(), "org1", human)
+			if tt.wantErr != nil {
+				assert.True(t, tt.wantErr(err), "unexpected error: %v", err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, human)
+		})
+	}
+}
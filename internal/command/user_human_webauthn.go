@@ -390,7 +390,7 @@ func (c *Commands) HumanFinishU2FLogin(ctx context.Context, userID, resourceOwne
 		return err
 	}
 
-	_, err = c.eventstore.Push(ctx,
+	cmds := []eventstore.Command{
 		usr_repo.NewHumanU2FCheckSucceededEvent(
 			ctx,
 			userAgg,
@@ -402,7 +402,15 @@ func (c *Commands) HumanFinishU2FLogin(ctx context.Context, userID, resourceOwne
 			token.WebAuthNTokenID,
 			signCount,
 		),
-	)
+	}
+	breakGlassUsed, err := c.breakGlassUsedEvent(ctx, userAgg, domain.BreakGlassAuthMethodSecurityKey, authRequestDomainToAuthRequestInfo(authRequest))
+	if err != nil {
+		return err
+	}
+	if breakGlassUsed != nil {
+		cmds = append(cmds, breakGlassUsed)
+	}
+	_, err = c.eventstore.Push(ctx, cmds...)
 
 	return err
 }
@@ -75,6 +75,35 @@ func (c *Commands) BulkAddedUserIDPLinks(ctx context.Context, userID, resourceOw
 	return err
 }
 
+// AutoLinkUserIDP links the external user to the existing user with the same verified email.
+// Besides the link itself, the automatic linking is recorded with the matched email for auditing.
+func (c *Commands) AutoLinkUserIDP(ctx context.Context, userID, resourceOwner string, link *domain.UserIDPLink, email domain.EmailAddress) (err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	if userID == "" {
+		return zerrors.ThrowInvalidArgument(nil, "COMMAND-Alk1f", "Errors.IDMissing")
+	}
+	if email == "" {
+		return zerrors.ThrowInvalidArgument(nil, "COMMAND-Alk2f", "Errors.User.Email.Empty")
+	}
+	if err := c.checkUserExists(ctx, userID, resourceOwner); err != nil {
+		return err
+	}
+	linkWriteModel := NewUserIDPLinkWriteModel(userID, link.IDPConfigID, link.ExternalUserID, resourceOwner)
+	userAgg := UserAggregateFromWriteModel(&linkWriteModel.WriteModel)
+
+	linkEvent, err := c.addUserIDPLink(ctx, userAgg, link, true)
+	if err != nil {
+		return err
+	}
+	_, err = c.eventstore.Push(ctx,
+		linkEvent,
+		user.NewUserIDPLinkAutoLinkedEvent(ctx, userAgg, link.IDPConfigID, link.ExternalUserID, email),
+	)
+	return err
+}
+
 func (c *Commands) addUserIDPLink(ctx context.Context, human *eventstore.Aggregate, link *domain.UserIDPLink, linkToExistingUser bool) (eventstore.Command, error) {
 	if link.AggregateID != "" && human.ID != link.AggregateID {
 		return nil, zerrors.ThrowInvalidArgument(nil, "COMMAND-33M0g", "Errors.IDMissing")
@@ -114,6 +143,53 @@ func (c *Commands) RemoveUserIDPLink(ctx context.Context, link *domain.UserIDPLi
 	return writeModelToObjectDetails(&linkWriteModel.WriteModel), nil
 }
 
+// RemoveMyUserIDPLink removes a link of the user to an external identity provider on their own request.
+// To prevent users from locking themselves out, the link can only be removed
+// if they are still able to authenticate with a password, a passkey or another linked identity provider.
+func (c *Commands) RemoveMyUserIDPLink(ctx context.Context, link *domain.UserIDPLink) (*domain.ObjectDetails, error) {
+	if !link.IsValid() || link.AggregateID == "" {
+		return nil, zerrors.ThrowInvalidArgument(nil, "COMMAND-Rml1f", "Errors.IDMissing")
+	}
+	remaining, err := c.hasRemainingLoginMethod(ctx, link)
+	if err != nil {
+		return nil, err
+	}
+	if !remaining {
+		return nil, zerrors.ThrowPreconditionFailed(nil, "COMMAND-Rml2f", "Errors.User.ExternalIDP.LastLoginMethod")
+	}
+	return c.RemoveUserIDPLink(ctx, link)
+}
+
+// hasRemainingLoginMethod checks if the user can still authenticate without the provided link
+func (c *Commands) hasRemainingLoginMethod(ctx context.Context, link *domain.UserIDPLink) (bool, error) {
+	linksWriteModel := NewUserIDPLinksWriteModel(link.AggregateID, link.ResourceOwner)
+	if err := c.eventstore.FilterToQueryReducer(ctx, linksWriteModel); err != nil {
+		return false, err
+	}
+	for _, existing := range linksWriteModel.Links {
+		if existing.IDPConfigID != link.IDPConfigID || existing.ExternalUserID != link.ExternalUserID {
+			return true, nil
+		}
+	}
+	passwordWriteModel, err := c.passwordWriteModel(ctx, link.AggregateID, link.ResourceOwner)
+	if err != nil {
+		return false, err
+	}
+	if passwordWriteModel.EncodedHash != "" {
+		return true, nil
+	}
+	tokens, err := c.getHumanPasswordlessTokens(ctx, link.AggregateID, link.ResourceOwner)
+	if err != nil {
+		return false, err
+	}
+	for _, token := range tokens {
+		if token.State == domain.MFAStateReady {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
 func (c *Commands) removeUserIDPLink(ctx context.Context, link *domain.UserIDPLink, cascade bool) (eventstore.Command, *UserIDPLinkWriteModel, error) {
 	if !link.IsValid() || link.AggregateID == "" {
 		return nil, nil, zerrors.ThrowInvalidArgument(nil, "COMMAND-3M9ds", "Errors.IDMissing")
@@ -90,3 +90,75 @@ func (wm *UserIDPLinkWriteModel) Query() *eventstore.SearchQueryBuilder {
 			user.UserRemovedType).
 		Builder()
 }
+
+// UserIDPLinksWriteModel collects all active links of a user to external identity providers
+type UserIDPLinksWriteModel struct {
+	eventstore.WriteModel
+
+	Links []*domain.UserIDPLink
+}
+
+func NewUserIDPLinksWriteModel(userID, resourceOwner string) *UserIDPLinksWriteModel {
+	return &UserIDPLinksWriteModel{
+		WriteModel: eventstore.WriteModel{
+			AggregateID:   userID,
+			ResourceOwner: resourceOwner,
+		},
+	}
+}
+
+func (wm *UserIDPLinksWriteModel) Reduce() error {
+	for _, event := range wm.Events {
+		switch e := event.(type) {
+		case *user.UserIDPLinkAddedEvent:
+			wm.Links = append(wm.Links, &domain.UserIDPLink{
+				IDPConfigID:    e.IDPConfigID,
+				ExternalUserID: e.ExternalUserID,
+				DisplayName:    e.DisplayName,
+			})
+		case *user.UserIDPExternalIDMigratedEvent:
+			if link := wm.link(e.IDPConfigID, e.PreviousID); link != nil {
+				link.ExternalUserID = e.NewID
+			}
+		case *user.UserIDPLinkRemovedEvent:
+			wm.removeLink(e.IDPConfigID, e.ExternalUserID)
+		case *user.UserIDPLinkCascadeRemovedEvent:
+			wm.removeLink(e.IDPConfigID, e.ExternalUserID)
+		case *user.UserRemovedEvent:
+			wm.Links = nil
+		}
+	}
+	return wm.WriteModel.Reduce()
+}
+
+func (wm *UserIDPLinksWriteModel) Query() *eventstore.SearchQueryBuilder {
+	return eventstore.NewSearchQueryBuilder(eventstore.ColumnsEvent).
+		ResourceOwner(wm.ResourceOwner).
+		AddQuery().
+		AggregateTypes(user.AggregateType).
+		AggregateIDs(wm.AggregateID).
+		EventTypes(user.UserIDPLinkAddedType,
+			user.UserIDPExternalIDMigratedType,
+			user.UserIDPLinkRemovedType,
+			user.UserIDPLinkCascadeRemovedType,
+			user.UserRemovedType).
+		Builder()
+}
+
+func (wm *UserIDPLinksWriteModel) link(idpConfigID, externalUserID string) *domain.UserIDPLink {
+	for _, link := range wm.Links {
+		if link.IDPConfigID == idpConfigID && link.ExternalUserID == externalUserID {
+			return link
+		}
+	}
+	return nil
+}
+
+func (wm *UserIDPLinksWriteModel) removeLink(idpConfigID, externalUserID string) {
+	for i, link := range wm.Links {
+		if link.IDPConfigID == idpConfigID && link.ExternalUserID == externalUserID {
+			wm.Links = append(wm.Links[:i], wm.Links[i+1:]...)
+			return
+		}
+	}
+}
@@ -517,6 +517,205 @@ func TestCommandSide_BulkAddUserIDPLinks(t *testing.T) {
 	}
 }
 
+func TestCommandSide_AutoLinkUserIDP(t *testing.T) {
+	humanAddedEvent := func() eventstore.Event {
+		return eventFromEventPusher(
+			user.NewHumanAddedEvent(
+				context.Background(),
+				&user.NewAggregate("user1", "org1").Aggregate,
+				"userName",
+				"firstName",
+				"lastName",
+				"nickName",
+				"displayName",
+				language.German,
+				domain.GenderFemale,
+				"email@Address.ch",
+				false,
+			),
+		)
+	}
+	idpConfigEvents := func(changes ...idp.OIDCIDPChanges) []eventstore.Event {
+		events := []eventstore.Event{
+			eventFromEventPusher(
+				org.NewIDPConfigAddedEvent(context.Background(),
+					&org.NewAggregate("org1").Aggregate,
+					"config1",
+					"name",
+					domain.IDPConfigTypeOIDC,
+					domain.IDPConfigStylingTypeUnspecified,
+					true,
+				),
+			),
+			eventFromEventPusher(
+				org.NewIDPOIDCConfigAddedEvent(context.Background(),
+					&org.NewAggregate("org1").Aggregate,
+					"clientID",
+					"config1",
+					"issuer",
+					"authEndpoint",
+					"tokenEndpoint",
+					nil,
+					domain.OIDCMappingFieldUnspecified,
+					domain.OIDCMappingFieldUnspecified,
+				),
+			),
+		}
+		if len(changes) > 0 {
+			e, _ := org.NewOIDCIDPChangedEvent(context.Background(),
+				&org.NewAggregate("org1").Aggregate,
+				"config1",
+				changes,
+			)
+			events = append(events, eventFromEventPusher(e))
+		}
+		return events
+	}
+	type fields struct {
+		eventstore *eventstore.Eventstore
+	}
+	type args struct {
+		ctx           context.Context
+		userID        string
+		resourceOwner string
+		link          *domain.UserIDPLink
+		email         domain.EmailAddress
+	}
+	type res struct {
+		err error
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		args   args
+		res    res
+	}{
+		{
+			name: "missing userid, invalid argument error",
+			fields: fields{
+				eventstore: eventstoreExpect(
+					t,
+				),
+			},
+			args: args{
+				ctx:           context.Background(),
+				resourceOwner: "org1",
+				link: &domain.UserIDPLink{
+					IDPConfigID:    "config1",
+					ExternalUserID: "externaluser1",
+				},
+				email: "email@Address.ch",
+			},
+			res: res{
+				err: zerrors.ThrowInvalidArgument(nil, "COMMAND-Alk1f", "Errors.IDMissing"),
+			},
+		},
+		{
+			name: "missing email, invalid argument error",
+			fields: fields{
+				eventstore: eventstoreExpect(
+					t,
+				),
+			},
+			args: args{
+				ctx:           context.Background(),
+				userID:        "user1",
+				resourceOwner: "org1",
+				link: &domain.UserIDPLink{
+					IDPConfigID:    "config1",
+					ExternalUserID: "externaluser1",
+				},
+			},
+			res: res{
+				err: zerrors.ThrowInvalidArgument(nil, "COMMAND-Alk2f", "Errors.User.Email.Empty"),
+			},
+		},
+		{
+			name: "linking not allowed, precondition error",
+			fields: fields{
+				eventstore: eventstoreExpect(
+					t,
+					expectFilter(
+						humanAddedEvent(),
+					),
+					expectFilter(
+						idpConfigEvents()...,
+					),
+					expectFilter(
+						idpConfigEvents(idp.ChangeOIDCOptions(idp.OptionChanges{IsLinkingAllowed: gu.Ptr(false)}))...,
+					),
+				),
+			},
+			args: args{
+				ctx:           context.Background(),
+				userID:        "user1",
+				resourceOwner: "org1",
+				link: &domain.UserIDPLink{
+					IDPConfigID:    "config1",
+					DisplayName:    "name",
+					ExternalUserID: "externaluser1",
+				},
+				email: "email@Address.ch",
+			},
+			res: res{
+				err: zerrors.ThrowPreconditionFailed(nil, "COMMAND-Sfee2", "Errors.ExternalIDP.LinkingNotAllowed"),
+			},
+		},
+		{
+			name: "auto link, ok",
+			fields: fields{
+				eventstore: eventstoreExpect(
+					t,
+					expectFilter(
+						humanAddedEvent(),
+					),
+					expectFilter(
+						idpConfigEvents()...,
+					),
+					expectFilter(
+						idpConfigEvents()...,
+					),
+					expectPush(
+						user.NewUserIDPLinkAddedEvent(context.Background(),
+							&user.NewAggregate("user1", "org1").Aggregate,
+							"config1",
+							"name",
+							"externaluser1",
+						),
+						user.NewUserIDPLinkAutoLinkedEvent(context.Background(),
+							&user.NewAggregate("user1", "org1").Aggregate,
+							"config1",
+							"externaluser1",
+							"email@Address.ch",
+						),
+					),
+				),
+			},
+			args: args{
+				ctx:           context.Background(),
+				userID:        "user1",
+				resourceOwner: "org1",
+				link: &domain.UserIDPLink{
+					IDPConfigID:    "config1",
+					DisplayName:    "name",
+					ExternalUserID: "externaluser1",
+				},
+				email: "email@Address.ch",
+			},
+			res: res{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := &Commands{
+				eventstore: tt.fields.eventstore,
+			}
+			err := r.AutoLinkUserIDP(tt.args.ctx, tt.args.userID, tt.args.resourceOwner, tt.args.link, tt.args.email)
+			assert.ErrorIs(t, err, tt.res.err)
+		})
+	}
+}
+
 func TestCommandSide_RemoveUserIDPLink(t *testing.T) {
 	type fields struct {
 		eventstore *eventstore.Eventstore
@@ -695,6 +894,201 @@ func TestCommandSide_RemoveUserIDPLink(t *testing.T) {
 	}
 }
 
+func TestCommandSide_RemoveMyUserIDPLink(t *testing.T) {
+	linkAddedEvent := func(idpConfigID, externalUserID string) eventstore.Event {
+		return eventFromEventPusher(
+			user.NewUserIDPLinkAddedEvent(context.Background(),
+				&user.NewAggregate("user1", "org1").Aggregate,
+				idpConfigID,
+				"name",
+				externalUserID,
+			),
+		)
+	}
+	type fields struct {
+		eventstore *eventstore.Eventstore
+	}
+	type args struct {
+		ctx  context.Context
+		link *domain.UserIDPLink
+	}
+	type res struct {
+		want *domain.ObjectDetails
+		err  func(error) bool
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		args   args
+		res    res
+	}{
+		{
+			name: "invalid idp, invalid argument error",
+			fields: fields{
+				eventstore: eventstoreExpect(
+					t,
+				),
+			},
+			args: args{
+				ctx: context.Background(),
+				link: &domain.UserIDPLink{
+					ObjectRoot: models.ObjectRoot{
+						AggregateID: "user1",
+					},
+				},
+			},
+			res: res{
+				err: zerrors.IsErrorInvalidArgument,
+			},
+		},
+		{
+			name: "last login method, precondition error",
+			fields: fields{
+				eventstore: eventstoreExpect(
+					t,
+					expectFilter(
+						linkAddedEvent("config1", "externaluser1"),
+					),
+					expectFilter(
+						eventFromEventPusher(
+							user.NewHumanAddedEvent(
+								context.Background(),
+								&user.NewAggregate("user1", "org1").Aggregate,
+								"userName",
+								"firstName",
+								"lastName",
+								"nickName",
+								"displayName",
+								language.German,
+								domain.GenderFemale,
+								"email@Address.ch",
+								false,
+							),
+						),
+					),
+					expectFilter(),
+				),
+			},
+			args: args{
+				ctx: context.Background(),
+				link: &domain.UserIDPLink{
+					ObjectRoot: models.ObjectRoot{
+						AggregateID:   "user1",
+						ResourceOwner: "org1",
+					},
+					IDPConfigID:    "config1",
+					ExternalUserID: "externaluser1",
+				},
+			},
+			res: res{
+				err: zerrors.IsPreconditionFailed,
+			},
+		},
+		{
+			name: "other idp linked, ok",
+			fields: fields{
+				eventstore: eventstoreExpect(
+					t,
+					expectFilter(
+						linkAddedEvent("config1", "externaluser1"),
+						linkAddedEvent("config2", "externaluser2"),
+					),
+					expectFilter(
+						linkAddedEvent("config1", "externaluser1"),
+					),
+					expectPush(
+						user.NewUserIDPLinkRemovedEvent(context.Background(),
+							&user.NewAggregate("user1", "org1").Aggregate,
+							"config1",
+							"externaluser1",
+						),
+					),
+				),
+			},
+			args: args{
+				ctx: context.Background(),
+				link: &domain.UserIDPLink{
+					ObjectRoot: models.ObjectRoot{
+						AggregateID:   "user1",
+						ResourceOwner: "org1",
+					},
+					IDPConfigID:    "config1",
+					ExternalUserID: "externaluser1",
+				},
+			},
+			res: res{
+				want: &domain.ObjectDetails{
+					ResourceOwner: "org1",
+				},
+			},
+		},
+		{
+			name: "password set, ok",
+			fields: fields{
+				eventstore: eventstoreExpect(
+					t,
+					expectFilter(
+						linkAddedEvent("config1", "externaluser1"),
+					),
+					expectFilter(
+						eventFromEventPusher(
+							user.NewHumanPasswordChangedEvent(context.Background(),
+								&user.NewAggregate("user1", "org1").Aggregate,
+								"$plain$x$password",
+								false,
+								"",
+							),
+						),
+					),
+					expectFilter(
+						linkAddedEvent("config1", "externaluser1"),
+					),
+					expectPush(
+						user.NewUserIDPLinkRemovedEvent(context.Background(),
+							&user.NewAggregate("user1", "org1").Aggregate,
+							"config1",
+							"externaluser1",
+						),
+					),
+				),
+			},
+			args: args{
+				ctx: context.Background(),
+				link: &domain.UserIDPLink{
+					ObjectRoot: models.ObjectRoot{
+						AggregateID:   "user1",
+						ResourceOwner: "org1",
+					},
+					IDPConfigID:    "config1",
+					ExternalUserID: "externaluser1",
+				},
+			},
+			res: res{
+				want: &domain.ObjectDetails{
+					ResourceOwner: "org1",
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := &Commands{
+				eventstore: tt.fields.eventstore,
+			}
+			got, err := r.RemoveMyUserIDPLink(tt.args.ctx, tt.args.link)
+			if tt.res.err == nil {
+				assert.NoError(t, err)
+			}
+			if tt.res.err != nil && !tt.res.err(err) {
+				t.Errorf("got wrong err: %v ", err)
+			}
+			if tt.res.err == nil {
+				assert.Equal(t, tt.res.want, got)
+			}
+		})
+	}
+}
+
 func TestCommandSide_ExternalLoginCheck(t *testing.T) {
 	type fields struct {
 		eventstore *eventstore.Eventstore
@@ -0,0 +1,116 @@
+package command
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	http_util "github.com/zitadel/zitadel/internal/api/http"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/telemetry/tracing"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+const (
+	defaultImpersonationLifetime = 15 * time.Minute
+	maxImpersonationLifetime     = time.Hour
+)
+
+// Impersonation is the access token issued to a support engineer to act as another user.
+type Impersonation struct {
+	// ID is the ID of the OIDC session of the impersonation.
+	ID          string
+	AccessToken string
+	Expiration  time.Time
+}
+
+// ImpersonateUser issues a short-lived access token for the user to the authenticated support engineer.
+// The token can only be used for the audience of the passed client and its project and not for the ZITADEL APIs.
+// The project must be owned by or granted to the organization of the user.
+// The support engineer is recorded as actor of the token together with the stated reason
+// and the impersonation is recorded on the user for audit.
+// If no lifetime is passed, the token is valid for 15 minutes, the maximum lifetime is one hour.
+func (c *Commands) ImpersonateUser(ctx context.Context, userID, resourceOwner, projectID, clientID, reason string, lifetime time.Duration) (_ *Impersonation, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	if userID == "" {
+		return nil, zerrors.ThrowInvalidArgument(nil, "COMMAND-Ip2sx", "Errors.User.UserIDMissing")
+	}
+	reason = strings.TrimSpace(reason)
+	if reason == "" {
+		return nil, zerrors.ThrowInvalidArgument(nil, "COMMAND-Ip4rq", "Errors.Impersonation.ReasonMissing")
+	}
+	if lifetime < 0 || lifetime > maxImpersonationLifetime {
+		return nil, zerrors.ThrowInvalidArgument(nil, "COMMAND-Ip6lk", "Errors.Impersonation.LifetimeInvalid")
+	}
+	if lifetime == 0 {
+		lifetime = defaultImpersonationLifetime
+	}
+	if !authz.GetInstance(ctx).EnableImpersonation() {
+		return nil, zerrors.ThrowPermissionDenied(nil, "COMMAND-Ip8pd", "Errors.TokenExchange.Impersonation.PolicyDisabled")
+	}
+	impersonatorID := authz.GetCtxData(ctx).UserID
+	if impersonatorID == userID {
+		return nil, zerrors.ThrowPermissionDenied(nil, "COMMAND-Ip3sf", "Errors.Impersonation.SelfNotAllowed")
+	}
+	if err = c.checkPermission(ctx, domain.PermissionUserImpersonate, resourceOwner, userID); err != nil {
+		return nil, err
+	}
+	if err = c.checkImpersonationProject(ctx, projectID, resourceOwner); err != nil {
+		return nil, err
+	}
+	existingUser, err := c.userWriteModelByID(ctx, userID, resourceOwner)
+	if err != nil {
+		return nil, err
+	}
+	if !isUserStateExists(existingUser.UserState) {
+		return nil, zerrors.ThrowNotFound(nil, "COMMAND-Ip5nf", "Errors.User.NotFound")
+	}
+	if existingUser.UserState != domain.UserStateActive {
+		return nil, zerrors.ThrowPreconditionFailed(nil, "COMMAND-Ip7ua", "Errors.Impersonation.UserNotActive")
+	}
+
+	actor := &domain.TokenActor{
+		UserID: impersonatorID,
+		Issuer: http_util.BuildOrigin(authz.GetInstance(ctx).RequestedHost(), c.externalSecure),
+		Reason: reason,
+	}
+	cmd, err := c.newOIDCSessionAddEvents(ctx, existingUser.ResourceOwner, clientID)
+	if err != nil {
+		return nil, err
+	}
+	cmd.accessTokenLifetime = min(cmd.accessTokenLifetime, lifetime)
+	cmd.UserImpersonated(ctx, userID, existingUser.ResourceOwner, clientID, actor)
+	// the impersonated user did not authenticate, so there are no auth methods and no auth time
+	cmd.AddSession(ctx, userID, existingUser.ResourceOwner, "", clientID, []string{projectID, clientID}, nil, nil, time.Time{}, "", nil, nil)
+	if err = cmd.AddAccessToken(ctx, nil, userID, existingUser.ResourceOwner, domain.TokenReasonImpersonation, actor); err != nil {
+		return nil, err
+	}
+	session, err := cmd.PushEvents(ctx)
+	if err != nil {
+		return nil, err
+	}
+	accessToken, err := createToken(c.keyAlgorithm, session.TokenID, userID)
+	if err != nil {
+		return nil, err
+	}
+	return &Impersonation{
+		ID:          cmd.oidcSessionWriteModel.AggregateID,
+		AccessToken: accessToken,
+		Expiration:  session.Expiration,
+	}, nil
+}
+
+// checkImpersonationProject prevents issuing tokens for applications of projects, the organization has no access to
+func (c *Commands) checkImpersonationProject(ctx context.Context, projectID, resourceOwner string) error {
+	projectAccess := newImpersonationProjectReadModel(projectID, resourceOwner)
+	if err := c.eventstore.FilterToQueryReducer(ctx, projectAccess); err != nil {
+		return err
+	}
+	if !projectAccess.accessible() {
+		return zerrors.ThrowPreconditionFailed(nil, "COMMAND-Ip1pn", "Errors.Project.NotFound")
+	}
+	return nil
+}
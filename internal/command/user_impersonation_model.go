@@ -0,0 +1,86 @@
+package command
+
+import (
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/repository/project"
+)
+
+// impersonationProjectReadModel checks if the project is owned by or actively granted to the organization
+type impersonationProjectReadModel struct {
+	eventstore.WriteModel
+
+	orgID         string
+	ownedByOrg    bool
+	grantedToOrg  map[string]domain.ProjectGrantState
+	projectExists bool
+}
+
+func newImpersonationProjectReadModel(projectID, orgID string) *impersonationProjectReadModel {
+	return &impersonationProjectReadModel{
+		WriteModel: eventstore.WriteModel{
+			AggregateID: projectID,
+		},
+		orgID:        orgID,
+		grantedToOrg: make(map[string]domain.ProjectGrantState),
+	}
+}
+
+func (wm *impersonationProjectReadModel) Reduce() error {
+	for _, event := range wm.Events {
+		switch e := event.(type) {
+		case *project.ProjectAddedEvent:
+			wm.projectExists = true
+			wm.ownedByOrg = e.Aggregate().ResourceOwner == wm.orgID
+		case *project.ProjectRemovedEvent:
+			wm.projectExists = false
+		case *project.GrantAddedEvent:
+			if e.GrantedOrgID == wm.orgID {
+				wm.grantedToOrg[e.GrantID] = domain.ProjectGrantStateActive
+			}
+		case *project.GrantDeactivateEvent:
+			if _, ok := wm.grantedToOrg[e.GrantID]; ok {
+				wm.grantedToOrg[e.GrantID] = domain.ProjectGrantStateInactive
+			}
+		case *project.GrantReactivatedEvent:
+			if _, ok := wm.grantedToOrg[e.GrantID]; ok {
+				wm.grantedToOrg[e.GrantID] = domain.ProjectGrantStateActive
+			}
+		case *project.GrantRemovedEvent:
+			delete(wm.grantedToOrg, e.GrantID)
+		}
+	}
+	return wm.WriteModel.Reduce()
+}
+
+func (wm *impersonationProjectReadModel) Query() *eventstore.SearchQueryBuilder {
+	return eventstore.NewSearchQueryBuilder(eventstore.ColumnsEvent).
+		AddQuery().
+		AggregateTypes(project.AggregateType).
+		AggregateIDs(wm.AggregateID).
+		EventTypes(
+			project.ProjectAddedType,
+			project.ProjectRemovedType,
+			project.GrantAddedType,
+			project.GrantDeactivatedType,
+			project.GrantReactivatedType,
+			project.GrantRemovedType,
+		).
+		Builder()
+}
+
+// accessible returns true if the project exists and is owned by or actively granted to the organization
+func (wm *impersonationProjectReadModel) accessible() bool {
+	if !wm.projectExists {
+		return false
+	}
+	if wm.ownedByOrg {
+		return true
+	}
+	for _, state := range wm.grantedToOrg {
+		if state == domain.ProjectGrantStateActive {
+			return true
+		}
+	}
+	return false
+}
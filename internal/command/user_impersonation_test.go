@@ -0,0 +1,301 @@
+package command
+
+import (
+	"context"
+	"encoding/base64"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"golang.org/x/text/language"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/crypto"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/id"
+	"github.com/zitadel/zitadel/internal/id/mock"
+	"github.com/zitadel/zitadel/internal/repository/oidcsession"
+	"github.com/zitadel/zitadel/internal/repository/project"
+	"github.com/zitadel/zitadel/internal/repository/user"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+type impersonationInstance struct {
+	mockInstance
+}
+
+func (*impersonationInstance) EnableImpersonation() bool {
+	return true
+}
+
+func TestCommands_ImpersonateUser(t *testing.T) {
+	impersonationCtx := authz.SetCtxData(authz.WithInstance(context.Background(), new(impersonationInstance)), authz.CtxData{UserID: "support1"})
+	actor := &domain.TokenActor{
+		UserID: "support1",
+		Issuer: "https://zitadel.cloud:443",
+		Reason: "ticket 123",
+	}
+	userAddedEvent := func() eventstore.Event {
+		return eventFromEventPusher(
+			user.NewHumanAddedEvent(context.Background(),
+				&user.NewAggregate("user1", "org1").Aggregate,
+				"username1",
+				"firstname1",
+				"lastname1",
+				"nickname1",
+				"displayname1",
+				language.German,
+				domain.GenderMale,
+				"email1",
+				true,
+			),
+		)
+	}
+	projectAddedEvent := func(resourceOwner string) eventstore.Event {
+		return eventFromEventPusher(
+			project.NewProjectAddedEvent(context.Background(),
+				&project.NewAggregate("projectID", resourceOwner).Aggregate,
+				"project", false, false, false, domain.PrivateLabelingSettingUnspecified,
+			),
+		)
+	}
+	type fields struct {
+		eventstore      func(*testing.T) *eventstore.Eventstore
+		idGenerator     id.Generator
+		checkPermission domain.PermissionCheck
+	}
+	type args struct {
+		ctx      context.Context
+		userID   string
+		reason   string
+		lifetime time.Duration
+	}
+	tests := []struct {
+		name    string
+		fields  fields
+		args    args
+		want    *Impersonation
+		wantErr error
+	}{
+		{
+			name: "missing reason, error",
+			fields: fields{
+				eventstore: expectEventstore(),
+			},
+			args: args{
+				ctx:    impersonationCtx,
+				userID: "user1",
+				reason: " ",
+			},
+			wantErr: zerrors.ThrowInvalidArgument(nil, "COMMAND-Ip4rq", "Errors.Impersonation.ReasonMissing"),
+		},
+		{
+			name: "lifetime too long, error",
+			fields: fields{
+				eventstore: expectEventstore(),
+			},
+			args: args{
+				ctx:      impersonationCtx,
+				userID:   "user1",
+				reason:   "ticket 123",
+				lifetime: 2 * time.Hour,
+			},
+			wantErr: zerrors.ThrowInvalidArgument(nil, "COMMAND-Ip6lk", "Errors.Impersonation.LifetimeInvalid"),
+		},
+		{
+			name: "impersonation disabled, error",
+			fields: fields{
+				eventstore: expectEventstore(),
+			},
+			args: args{
+				ctx:    authz.WithInstance(context.Background(), new(mockInstance)),
+				userID: "user1",
+				reason: "ticket 123",
+			},
+			wantErr: zerrors.ThrowPermissionDenied(nil, "COMMAND-Ip8pd", "Errors.TokenExchange.Impersonation.PolicyDisabled"),
+		},
+		{
+			name: "impersonate oneself, error",
+			fields: fields{
+				eventstore: expectEventstore(),
+			},
+			args: args{
+				ctx:    impersonationCtx,
+				userID: "support1",
+				reason: "ticket 123",
+			},
+			wantErr: zerrors.ThrowPermissionDenied(nil, "COMMAND-Ip3sf", "Errors.Impersonation.SelfNotAllowed"),
+		},
+		{
+			name: "missing permission, error",
+			fields: fields{
+				eventstore:      expectEventstore(),
+				checkPermission: newMockPermissionCheckNotAllowed(),
+			},
+			args: args{
+				ctx:    impersonationCtx,
+				userID: "user1",
+				reason: "ticket 123",
+			},
+			wantErr: zerrors.ThrowPermissionDenied(nil, "AUTHZ-HKJD33", "Errors.PermissionDenied"),
+		},
+		{
+			name: "project of other organization, error",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						projectAddedEvent("org2"),
+					),
+				),
+				checkPermission: newMockPermissionCheckAllowed(),
+			},
+			args: args{
+				ctx:    impersonationCtx,
+				userID: "user1",
+				reason: "ticket 123",
+			},
+			wantErr: zerrors.ThrowPreconditionFailed(nil, "COMMAND-Ip1pn", "Errors.Project.NotFound"),
+		},
+		{
+			name: "project grant to organization deactivated, error",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						projectAddedEvent("org2"),
+						eventFromEventPusher(
+							project.NewGrantAddedEvent(context.Background(), &project.NewAggregate("projectID", "org2").Aggregate, "grant1", "org1", nil),
+						),
+						eventFromEventPusher(
+							project.NewGrantDeactivateEvent(context.Background(), &project.NewAggregate("projectID", "org2").Aggregate, "grant1"),
+						),
+					),
+				),
+				checkPermission: newMockPermissionCheckAllowed(),
+			},
+			args: args{
+				ctx:    impersonationCtx,
+				userID: "user1",
+				reason: "ticket 123",
+			},
+			wantErr: zerrors.ThrowPreconditionFailed(nil, "COMMAND-Ip1pn", "Errors.Project.NotFound"),
+		},
+		{
+			name: "user not active, error",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						projectAddedEvent("org1"),
+					),
+					expectFilter(
+						userAddedEvent(),
+						eventFromEventPusher(
+							user.NewUserLockedEvent(context.Background(), &user.NewAggregate("user1", "org1").Aggregate),
+						),
+					),
+				),
+				checkPermission: newMockPermissionCheckAllowed(),
+			},
+			args: args{
+				ctx:    impersonationCtx,
+				userID: "user1",
+				reason: "ticket 123",
+			},
+			wantErr: zerrors.ThrowPreconditionFailed(nil, "COMMAND-Ip7ua", "Errors.Impersonation.UserNotActive"),
+		},
+		{
+			name: "impersonate, ok",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						projectAddedEvent("org1"),
+					),
+					expectFilter(
+						userAddedEvent(),
+					),
+					expectFilter(), // token lifetime
+					expectPush(
+						user.NewUserImpersonatedEvent(impersonationCtx, &user.NewAggregate("user1", "org1").Aggregate, "clientID", actor),
+						oidcsession.NewAddedEvent(impersonationCtx, &oidcsession.NewAggregate("V2_oidcSessionID", "org1").Aggregate,
+							"user1", "org1", "", "clientID", []string{"projectID", "clientID"}, nil, nil, time.Time{}, "", nil, nil,
+						),
+						oidcsession.NewAccessTokenAddedEvent(impersonationCtx,
+							&oidcsession.NewAggregate("V2_oidcSessionID", "org1").Aggregate,
+							"at_accessTokenID", nil, 15*time.Minute, domain.TokenReasonImpersonation, actor, "",
+						),
+						user.NewUserTokenV2AddedEvent(impersonationCtx, &user.NewAggregate("user1", "org1").Aggregate, "at_accessTokenID"),
+					),
+				),
+				idGenerator:     mock.NewIDGeneratorExpectIDs(t, "oidcSessionID", "accessTokenID"),
+				checkPermission: newMockPermissionCheckAllowed(),
+			},
+			args: args{
+				ctx:    impersonationCtx,
+				userID: "user1",
+				reason: "ticket 123",
+			},
+			want: &Impersonation{
+				ID:          "V2_oidcSessionID",
+				AccessToken: base64.RawURLEncoding.EncodeToString([]byte("V2_oidcSessionID-at_accessTokenID:user1")),
+				Expiration:  time.Time{}.Add(15 * time.Minute),
+			},
+		},
+		{
+			name: "impersonate with granted project, ok",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						projectAddedEvent("org2"),
+						eventFromEventPusher(
+							project.NewGrantAddedEvent(context.Background(), &project.NewAggregate("projectID", "org2").Aggregate, "grant1", "org1", nil),
+						),
+					),
+					expectFilter(
+						userAddedEvent(),
+					),
+					expectFilter(), // token lifetime
+					expectPush(
+						user.NewUserImpersonatedEvent(impersonationCtx, &user.NewAggregate("user1", "org1").Aggregate, "clientID", actor),
+						oidcsession.NewAddedEvent(impersonationCtx, &oidcsession.NewAggregate("V2_oidcSessionID", "org1").Aggregate,
+							"user1", "org1", "", "clientID", []string{"projectID", "clientID"}, nil, nil, time.Time{}, "", nil, nil,
+						),
+						oidcsession.NewAccessTokenAddedEvent(impersonationCtx,
+							&oidcsession.NewAggregate("V2_oidcSessionID", "org1").Aggregate,
+							"at_accessTokenID", nil, 15*time.Minute, domain.TokenReasonImpersonation, actor, "",
+						),
+						user.NewUserTokenV2AddedEvent(impersonationCtx, &user.NewAggregate("user1", "org1").Aggregate, "at_accessTokenID"),
+					),
+				),
+				idGenerator:     mock.NewIDGeneratorExpectIDs(t, "oidcSessionID", "accessTokenID"),
+				checkPermission: newMockPermissionCheckAllowed(),
+			},
+			args: args{
+				ctx:    impersonationCtx,
+				userID: "user1",
+				reason: "ticket 123",
+			},
+			want: &Impersonation{
+				ID:          "V2_oidcSessionID",
+				AccessToken: base64.RawURLEncoding.EncodeToString([]byte("V2_oidcSessionID-at_accessTokenID:user1")),
+				Expiration:  time.Time{}.Add(15 * time.Minute),
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &Commands{
+				eventstore:                 tt.fields.eventstore(t),
+				idGenerator:                tt.fields.idGenerator,
+				checkPermission:            tt.fields.checkPermission,
+				keyAlgorithm:               crypto.CreateMockEncryptionAlg(gomock.NewController(t)),
+				defaultAccessTokenLifetime: time.Hour,
+				externalSecure:             true,
+			}
+			got, err := c.ImpersonateUser(tt.args.ctx, tt.args.userID, "org1", "projectID", "clientID", tt.args.reason, tt.args.lifetime)
+			require.ErrorIs(t, err, tt.wantErr)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
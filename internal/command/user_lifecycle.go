@@ -0,0 +1,81 @@
+package command
+
+import (
+	"context"
+	"time"
+
+	"github.com/zitadel/logging"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/repository/user"
+	"github.com/zitadel/zitadel/internal/telemetry/tracing"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+// ApplyUserLifecyclePolicies deactivates and deletes the inactive human users of all organizations of the instance
+// according to the user lifecycle policy of their organization.
+// Before an action is due, the org owners are notified if the policy requires it.
+// A failing user doesn't prevent the others.
+func (c *Commands) ApplyUserLifecyclePolicies(ctx context.Context, removeUserDependencies func(ctx context.Context, userID string) ([]*CascadingMembership, []string, error)) (err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	writeModel := newUserLifecyclesWriteModel(authz.GetInstance(ctx).InstanceID())
+	if err = c.eventstore.FilterToQueryReducer(ctx, writeModel); err != nil {
+		return err
+	}
+	now := time.Now()
+	for _, u := range writeModel.users {
+		policy, ok := writeModel.policies[u.aggregate.ResourceOwner]
+		if !ok || u.state == domain.UserStateDeleted || u.breakGlass {
+			continue
+		}
+		err := c.applyUserLifecyclePolicy(ctx, u, policy, now, removeUserDependencies)
+		logging.WithFields("user", u.aggregate.ID, "org", u.aggregate.ResourceOwner).OnError(err).Warn("user lifecycle action failed")
+	}
+	return nil
+}
+
+func (c *Commands) applyUserLifecyclePolicy(ctx context.Context, u *userLifecycle, policy *UserLifecyclePolicy, now time.Time, removeUserDependencies func(ctx context.Context, userID string) ([]*CascadingMembership, []string, error)) error {
+	action, dueDate := policy.nextAction(u)
+	switch {
+	case action == domain.UserLifecycleActionUnspecified:
+		return nil
+	case !dueDate.After(now) && action == domain.UserLifecycleActionDeactivate:
+		_, err := c.DeactivateUser(ctx, u.aggregate.ID, u.aggregate.ResourceOwner)
+		return err
+	case !dueDate.After(now) && action == domain.UserLifecycleActionDelete:
+		memberships, grants, err := removeUserDependencies(ctx, u.aggregate.ID)
+		if err != nil {
+			return err
+		}
+		_, err = c.RemoveUser(ctx, u.aggregate.ID, u.aggregate.ResourceOwner, memberships, grants...)
+		return err
+	case policy.NotifyBefore > 0 && u.notifiedAction != action && !dueDate.Add(-policy.NotifyBefore).After(now):
+		_, err := c.eventstore.Push(ctx, user.NewLifecycleActionScheduledEvent(ctx, u.aggregate, action, dueDate))
+		return err
+	}
+	return nil
+}
+
+// nextAction returns the next action of the policy for the user and the date it's due
+func (p *UserLifecyclePolicy) nextAction(u *userLifecycle) (domain.UserLifecycleAction, time.Time) {
+	// initial users can't be deactivated
+	if p.DeactivateAfter > 0 && (u.state == domain.UserStateActive || u.state == domain.UserStateLocked) {
+		return domain.UserLifecycleActionDeactivate, u.lastActivity.Add(p.DeactivateAfter)
+	}
+	if p.DeleteAfter > 0 {
+		return domain.UserLifecycleActionDelete, u.lastActivity.Add(p.DeleteAfter)
+	}
+	return domain.UserLifecycleActionUnspecified, time.Time{}
+}
+
+// UserLifecycleActionNotificationSent marks the org owners as notified about the scheduled action on the user.
+func (c *Commands) UserLifecycleActionNotificationSent(ctx context.Context, orgID, userID string, action domain.UserLifecycleAction) error {
+	if orgID == "" || userID == "" {
+		return zerrors.ThrowInvalidArgument(nil, "COMMAND-Lc2ns", "Errors.IDMissing")
+	}
+	_, err := c.eventstore.Push(ctx, user.NewLifecycleActionNotificationSentEvent(ctx, &user.NewAggregate(userID, orgID).Aggregate, action))
+	return err
+}
@@ -0,0 +1,152 @@
+package command
+
+import (
+	"time"
+
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/repository/org"
+	"github.com/zitadel/zitadel/internal/repository/user"
+)
+
+type userLifecycle struct {
+	aggregate *eventstore.Aggregate
+	state     domain.UserState
+	// lastActivity is the date of the last successful authentication of the user,
+	// the creation or reactivation if the user never authenticated since.
+	lastActivity time.Time
+	// notifiedAction is the action the org owners were notified about since the last activity
+	notifiedAction domain.UserLifecycleAction
+	// breakGlass users are never deactivated or deleted by the lifecycle policies
+	breakGlass bool
+}
+
+// userLifecyclesWriteModel contains the user lifecycle policies of all organizations of an instance
+// and the activity of their human users
+type userLifecyclesWriteModel struct {
+	eventstore.WriteModel
+
+	policies map[string]*UserLifecyclePolicy
+	users    []*userLifecycle
+	userByID map[string]*userLifecycle
+}
+
+func newUserLifecyclesWriteModel(instanceID string) *userLifecyclesWriteModel {
+	return &userLifecyclesWriteModel{
+		WriteModel: eventstore.WriteModel{
+			InstanceID: instanceID,
+		},
+		policies: make(map[string]*UserLifecyclePolicy),
+		userByID: make(map[string]*userLifecycle),
+	}
+}
+
+func (wm *userLifecyclesWriteModel) Reduce() error {
+	for _, event := range wm.Events {
+		switch e := event.(type) {
+		case *org.UserLifecyclePolicySetEvent:
+			wm.policies[e.Aggregate().ID] = &UserLifecyclePolicy{
+				DeactivateAfter: e.DeactivateAfter,
+				DeleteAfter:     e.DeleteAfter,
+				NotifyBefore:    e.NotifyBefore,
+			}
+		case *org.UserLifecyclePolicyRemovedEvent, *org.OrgRemovedEvent:
+			delete(wm.policies, e.Aggregate().ID)
+		case *user.HumanAddedEvent, *user.HumanRegisteredEvent:
+			u := &userLifecycle{
+				aggregate:    e.Aggregate(),
+				state:        domain.UserStateActive,
+				lastActivity: e.CreatedAt(),
+			}
+			wm.users = append(wm.users, u)
+			wm.userByID[u.aggregate.ID] = u
+		case *user.HumanInitialCodeAddedEvent:
+			wm.setState(e, domain.UserStateInitial)
+		case *user.UserLockedEvent:
+			wm.setState(e, domain.UserStateLocked)
+		case *user.UserDeactivatedEvent:
+			wm.setState(e, domain.UserStateInactive)
+		case *user.UserUnlockedEvent, *user.UserReactivatedEvent, *user.HumanInitializedCheckSucceededEvent:
+			wm.setState(e, domain.UserStateActive)
+			wm.active(e)
+		case *user.UserRemovedEvent:
+			if u := wm.userByID[e.Aggregate().ID]; u != nil {
+				u.state = domain.UserStateDeleted
+				delete(wm.userByID, e.Aggregate().ID)
+			}
+		case *user.UserBreakGlassSetEvent:
+			if u := wm.userByID[e.Aggregate().ID]; u != nil {
+				u.breakGlass = true
+			}
+		case *user.UserBreakGlassRemovedEvent:
+			if u := wm.userByID[e.Aggregate().ID]; u != nil {
+				u.breakGlass = false
+			}
+		case *user.LifecycleActionScheduledEvent:
+			if u := wm.userByID[e.Aggregate().ID]; u != nil {
+				u.notifiedAction = e.Action
+			}
+		default:
+			// all other events are successful authentications
+			wm.active(event)
+		}
+	}
+	return wm.WriteModel.Reduce()
+}
+
+func (wm *userLifecyclesWriteModel) setState(event eventstore.Event, state domain.UserState) {
+	if u := wm.userByID[event.Aggregate().ID]; u != nil {
+		u.state = state
+	}
+}
+
+func (wm *userLifecyclesWriteModel) active(event eventstore.Event) {
+	if u := wm.userByID[event.Aggregate().ID]; u != nil {
+		u.lastActivity = event.CreatedAt()
+		u.notifiedAction = domain.UserLifecycleActionUnspecified
+	}
+}
+
+func (wm *userLifecyclesWriteModel) Query() *eventstore.SearchQueryBuilder {
+	return eventstore.NewSearchQueryBuilder(eventstore.ColumnsEvent).
+		InstanceID(wm.InstanceID).
+		AddQuery().
+		AggregateTypes(org.AggregateType).
+		EventTypes(
+			org.UserLifecyclePolicySetEventType,
+			org.UserLifecyclePolicyRemovedEventType,
+			org.OrgRemovedEventType,
+		).
+		Or().
+		AggregateTypes(user.AggregateType).
+		EventTypes(
+			user.UserV1AddedType,
+			user.HumanAddedType,
+			user.UserV1RegisteredType,
+			user.HumanRegisteredType,
+			user.UserV1InitialCodeAddedType,
+			user.HumanInitialCodeAddedType,
+			user.UserV1InitializedCheckSucceededType,
+			user.HumanInitializedCheckSucceededType,
+			user.UserLockedType,
+			user.UserUnlockedType,
+			user.UserDeactivatedType,
+			user.UserReactivatedType,
+			user.UserRemovedType,
+			user.UserLifecycleActionScheduledType,
+			user.UserBreakGlassSetType,
+			user.UserBreakGlassRemovedType,
+			// successful authentications
+			user.UserV1PasswordCheckSucceededType,
+			user.HumanPasswordCheckSucceededType,
+			user.UserIDPLoginCheckSucceededType,
+			user.UserV1MFAOTPCheckSucceededType,
+			user.HumanMFAOTPCheckSucceededType,
+			user.HumanOTPSMSCheckSucceededType,
+			user.HumanOTPEmailCheckSucceededType,
+			user.HumanU2FTokenCheckSucceededType,
+			user.HumanPasswordlessTokenCheckSucceededType,
+			user.UserTokenAddedType,
+		).
+		Builder()
+}
@@ -0,0 +1,276 @@
+package command
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/text/language"
+
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/eventstore/repository"
+	"github.com/zitadel/zitadel/internal/repository/instance"
+	"github.com/zitadel/zitadel/internal/repository/org"
+	"github.com/zitadel/zitadel/internal/repository/user"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+func TestCommandSide_ApplyUserLifecyclePolicies(t *testing.T) {
+	type fields struct {
+		eventstore func(t *testing.T) *eventstore.Eventstore
+	}
+	type args struct {
+		removeUserDependencies func(ctx context.Context, userID string) ([]*CascadingMembership, []string, error)
+	}
+	type res struct {
+		err func(error) bool
+	}
+	noDependencies := func(context.Context, string) ([]*CascadingMembership, []string, error) {
+		return nil, nil, nil
+	}
+	policySet := func(deactivateAfter, deleteAfter, notifyBefore time.Duration) *repository.Event {
+		return eventFromEventPusher(
+			org.NewUserLifecyclePolicySetEvent(context.Background(),
+				&org.NewAggregate("org1").Aggregate,
+				deactivateAfter,
+				deleteAfter,
+				notifyBefore,
+			),
+		)
+	}
+	humanAdded := func() eventstore.Command {
+		return user.NewHumanAddedEvent(context.Background(),
+			&user.NewAggregate("user1", "org1").Aggregate,
+			"username",
+			"firstname",
+			"lastname",
+			"nickname",
+			"displayname",
+			language.German,
+			domain.GenderUnspecified,
+			"email@test.ch",
+			true,
+		)
+	}
+	withCreationDate := func(event *repository.Event, creationDate time.Time) *repository.Event {
+		event.CreationDate = creationDate
+		return event
+	}
+	fiftyMinutesAgo := time.Now().Add(-50 * time.Minute)
+	tests := []struct {
+		name   string
+		fields fields
+		args   args
+		res    res
+	}{
+		{
+			name: "filter error, error",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilterError(zerrors.ThrowInternal(nil, "id", "filter failed")),
+				),
+			},
+			args: args{
+				removeUserDependencies: noDependencies,
+			},
+			res: res{
+				err: zerrors.IsInternal,
+			},
+		},
+		{
+			name: "org without policy, nothing done",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(humanAdded()),
+					),
+				),
+			},
+			args: args{
+				removeUserDependencies: noDependencies,
+			},
+		},
+		{
+			name: "active user, nothing done",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						policySet(time.Hour, 0, 0),
+						eventFromEventPusher(humanAdded()),
+						eventFromEventPusherWithCreationDateNow(
+							user.NewHumanPasswordCheckSucceededEvent(context.Background(),
+								&user.NewAggregate("user1", "org1").Aggregate,
+								nil,
+							),
+						),
+					),
+				),
+			},
+			args: args{
+				removeUserDependencies: noDependencies,
+			},
+		},
+		{
+			name: "inactive user, deactivated",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						policySet(time.Hour, 0, 0),
+						eventFromEventPusher(humanAdded()),
+					),
+					expectFilter(
+						eventFromEventPusher(humanAdded()),
+					),
+					expectPush(
+						user.NewUserDeactivatedEvent(context.Background(),
+							&user.NewAggregate("user1", "org1").Aggregate,
+						),
+					),
+				),
+			},
+			args: args{
+				removeUserDependencies: noDependencies,
+			},
+		},
+		{
+			name: "inactive break-glass user, nothing done",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						policySet(time.Hour, 0, 0),
+						eventFromEventPusher(humanAdded()),
+						eventFromEventPusher(
+							user.NewUserBreakGlassSetEvent(context.Background(),
+								&user.NewAggregate("user1", "org1").Aggregate,
+								"",
+							),
+						),
+					),
+				),
+			},
+			args: args{
+				removeUserDependencies: noDependencies,
+			},
+		},
+		{
+			name: "deactivation within notification period, scheduled",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						policySet(time.Hour, 0, 30*time.Minute),
+						withCreationDate(eventFromEventPusher(humanAdded()), fiftyMinutesAgo),
+					),
+					expectPush(
+						user.NewLifecycleActionScheduledEvent(context.Background(),
+							&user.NewAggregate("user1", "org1").Aggregate,
+							domain.UserLifecycleActionDeactivate,
+							fiftyMinutesAgo.Add(time.Hour),
+						),
+					),
+				),
+			},
+			args: args{
+				removeUserDependencies: noDependencies,
+			},
+		},
+		{
+			name: "deactivation within notification period, already notified",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						policySet(time.Hour, 0, 30*time.Minute),
+						withCreationDate(eventFromEventPusher(humanAdded()), fiftyMinutesAgo),
+						eventFromEventPusher(
+							user.NewLifecycleActionScheduledEvent(context.Background(),
+								&user.NewAggregate("user1", "org1").Aggregate,
+								domain.UserLifecycleActionDeactivate,
+								time.Now().Add(10*time.Minute),
+							),
+						),
+					),
+				),
+			},
+			args: args{
+				removeUserDependencies: noDependencies,
+			},
+		},
+		{
+			name: "deactivated user, deleted",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						policySet(time.Hour, 2*time.Hour, 0),
+						eventFromEventPusher(humanAdded()),
+						eventFromEventPusher(
+							user.NewUserDeactivatedEvent(context.Background(),
+								&user.NewAggregate("user1", "org1").Aggregate,
+							),
+						),
+					),
+					expectFilter(
+						eventFromEventPusher(humanAdded()),
+						eventFromEventPusher(
+							user.NewUserDeactivatedEvent(context.Background(),
+								&user.NewAggregate("user1", "org1").Aggregate,
+							),
+						),
+					),
+					expectFilter(),
+					expectFilter(
+						eventFromEventPusher(
+							instance.NewDomainPolicyAddedEvent(context.Background(),
+								&instance.NewAggregate("instance1").Aggregate,
+								true,
+								true,
+								true,
+							),
+						),
+					),
+					expectPush(
+						user.NewUserRemovedEvent(context.Background(),
+							&user.NewAggregate("user1", "org1").Aggregate,
+							"username",
+							nil,
+							true,
+						),
+					),
+				),
+			},
+			args: args{
+				removeUserDependencies: noDependencies,
+			},
+		},
+		{
+			name: "deletion dependencies fail, user not deleted",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						policySet(0, time.Hour, 0),
+						eventFromEventPusher(humanAdded()),
+					),
+				),
+			},
+			args: args{
+				removeUserDependencies: func(context.Context, string) ([]*CascadingMembership, []string, error) {
+					return nil, nil, zerrors.ThrowInternal(nil, "id", "query failed")
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := &Commands{
+				eventstore: tt.fields.eventstore(t),
+			}
+			err := r.ApplyUserLifecyclePolicies(context.Background(), tt.args.removeUserDependencies)
+			if tt.res.err == nil {
+				assert.NoError(t, err)
+			}
+			if tt.res.err != nil && !tt.res.err(err) {
+				t.Errorf("got wrong err: %v ", err)
+			}
+		})
+	}
+}
@@ -149,6 +149,10 @@ func prepareAddUserMachineKey(machineKey *MachineKey, keySize int) preparation.V
 			if writeModel.Exists() {
 				return nil, zerrors.ThrowAlreadyExists(nil, "COMMAND-091mops", "Errors.User.Machine.Key.AlreadyExists")
 			}
+			machineKey.ExpirationDate, err = limitMachineCredentialExpiration(ctx, filter, machineKey.ResourceOwner, machineKey.ExpirationDate)
+			if err != nil {
+				return nil, err
+			}
 			return []eventstore.Command{
 				user.NewMachineKeyAddedEvent(
 					ctx,
@@ -204,6 +208,78 @@ func prepareRemoveUserMachineKey(machineKey *MachineKey) preparation.Validation
 	}
 }
 
+// RotateUserMachineKey adds a new key to the machine user and schedules the revocation of the old key in the same transaction.
+// The old key stays valid until the revocation date, so clients can switch to the new key in the meantime.
+// Without a revocation date the old key is revoked immediately.
+func (c *Commands) RotateUserMachineKey(ctx context.Context, oldKeyID string, newKey *MachineKey, revocationDate time.Time) (_ *domain.ObjectDetails, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	if newKey.KeyID == "" {
+		keyID, err := c.idGenerator.Next()
+		if err != nil {
+			return nil, err
+		}
+		newKey.KeyID = keyID
+	}
+
+	cmds, err := preparation.PrepareCommands(ctx, c.eventstore.Filter,
+		prepareScheduleUserMachineKeyRevocation(newKey.AggregateID, newKey.ResourceOwner, oldKeyID, revocationDate),
+		prepareAddUserMachineKey(newKey, c.machineKeySize),
+	)
+	if err != nil {
+		return nil, err
+	}
+	events, err := c.eventstore.Push(ctx, cmds...)
+	if err != nil {
+		return nil, err
+	}
+	return &domain.ObjectDetails{
+		Sequence:      events[len(events)-1].Sequence(),
+		EventDate:     events[len(events)-1].CreatedAt(),
+		ResourceOwner: events[len(events)-1].Aggregate().ResourceOwner,
+	}, nil
+}
+
+func prepareScheduleUserMachineKeyRevocation(userID, resourceOwner, keyID string, revocationDate time.Time) preparation.Validation {
+	return func() (_ preparation.CreateCommands, err error) {
+		if keyID == "" {
+			return nil, zerrors.ThrowInvalidArgument(nil, "COMMAND-Mkr1i", "Errors.IDMissing")
+		}
+		now := time.Now()
+		if revocationDate.IsZero() {
+			revocationDate = now
+		}
+		if revocationDate.Before(now) {
+			return nil, zerrors.ThrowInvalidArgument(nil, "COMMAND-Mkr2d", "Errors.User.Machine.Key.RevocationDateInvalid")
+		}
+		return func(ctx context.Context, filter preparation.FilterToQueryReducer) ([]eventstore.Command, error) {
+			writeModel, err := getMachineKeyWriteModelByID(ctx, filter, userID, keyID, resourceOwner)
+			if err != nil {
+				return nil, err
+			}
+			if !writeModel.Exists() {
+				return nil, zerrors.ThrowNotFound(nil, "COMMAND-Mkr3n", "Errors.User.Machine.Key.NotFound")
+			}
+			if !writeModel.RevocationDate.IsZero() {
+				return nil, zerrors.ThrowPreconditionFailed(nil, "COMMAND-Mkr4r", "Errors.User.Machine.Key.AlreadyRotated")
+			}
+			// the key can't be valid longer than before
+			if writeModel.ExpirationDate.Before(revocationDate) {
+				revocationDate = writeModel.ExpirationDate
+			}
+			return []eventstore.Command{
+				user.NewMachineKeyRevocationScheduledEvent(
+					ctx,
+					UserAggregateFromWriteModel(&writeModel.WriteModel),
+					keyID,
+					revocationDate,
+				),
+			}, nil
+		}, nil
+	}
+}
+
 func getMachineKeyWriteModelByID(ctx context.Context, filter preparation.FilterToQueryReducer, userID, keyID, resourceOwner string) (_ *MachineKeyWriteModel, err error) {
 	writeModel := NewMachineKeyWriteModel(userID, keyID, resourceOwner)
 	events, err := filter(ctx, writeModel.Query())
@@ -14,6 +14,8 @@ type MachineKeyWriteModel struct {
 	KeyID          string
 	KeyType        domain.AuthNKeyType
 	ExpirationDate time.Time
+	// RevocationDate is set if the key was rotated, the key can't be used after it
+	RevocationDate time.Time
 
 	State domain.MachineKeyState
 }
@@ -41,6 +43,11 @@ func (wm *MachineKeyWriteModel) AppendEvents(events ...eventstore.Event) {
 				continue
 			}
 			wm.WriteModel.AppendEvents(e)
+		case *user.MachineKeyRevocationScheduledEvent:
+			if wm.KeyID != e.KeyID {
+				continue
+			}
+			wm.WriteModel.AppendEvents(e)
 		case *user.UserRemovedEvent:
 			wm.WriteModel.AppendEvents(e)
 		}
@@ -55,6 +62,8 @@ func (wm *MachineKeyWriteModel) Reduce() error {
 			wm.KeyType = e.KeyType
 			wm.ExpirationDate = e.ExpirationDate
 			wm.State = domain.MachineKeyStateActive
+		case *user.MachineKeyRevocationScheduledEvent:
+			wm.RevocationDate = e.RevocationDate
 		case *user.MachineKeyRemovedEvent:
 			wm.State = domain.MachineKeyStateRemoved
 		case *user.UserRemovedEvent:
@@ -73,6 +82,7 @@ func (wm *MachineKeyWriteModel) Query() *eventstore.SearchQueryBuilder {
 		EventTypes(
 			user.MachineKeyAddedEventType,
 			user.MachineKeyRemovedEventType,
+			user.MachineKeyRevocationScheduledEventType,
 			user.UserRemovedType).
 		Builder()
 }
@@ -14,6 +14,7 @@ import (
 	"github.com/zitadel/zitadel/internal/eventstore/v1/models"
 	"github.com/zitadel/zitadel/internal/id"
 	id_mock "github.com/zitadel/zitadel/internal/id/mock"
+	"github.com/zitadel/zitadel/internal/repository/org"
 	"github.com/zitadel/zitadel/internal/repository/user"
 	"github.com/zitadel/zitadel/internal/zerrors"
 )
@@ -149,6 +150,7 @@ func TestCommands_AddMachineKey(t *testing.T) {
 						),
 					),
 					expectFilter(),
+					expectFilter(),
 					expectPush(
 						user.NewMachineKeyAddedEvent(context.Background(),
 							&user.NewAggregate("user1", "org1").Aggregate,
@@ -198,6 +200,7 @@ func TestCommands_AddMachineKey(t *testing.T) {
 						),
 					),
 					expectFilter(),
+					expectFilter(),
 					expectPush(
 						user.NewMachineKeyAddedEvent(context.Background(),
 							&user.NewAggregate("user1", "org1").Aggregate,
@@ -251,6 +254,51 @@ func TestCommands_AddMachineKey(t *testing.T) {
 				err: zerrors.IsErrorInvalidArgument,
 			},
 		},
+		{
+			"expiration exceeds max lifetime of policy, error",
+			fields{
+				eventstore: eventstoreExpect(t,
+					expectFilter(
+						eventFromEventPusher(
+							user.NewMachineAddedEvent(context.Background(),
+								&user.NewAggregate("user1", "org1").Aggregate,
+								"machine",
+								"Machine",
+								"",
+								true,
+								domain.OIDCTokenTypeBearer,
+							),
+						),
+					),
+					expectFilter(),
+					expectFilter(
+						eventFromEventPusher(
+							org.NewMachineCredentialPolicySetEvent(context.Background(),
+								&org.NewAggregate("org1").Aggregate,
+								90*24*time.Hour,
+								0,
+							),
+						),
+					),
+				),
+				idGenerator: id_mock.NewIDGeneratorExpectIDs(t, "key1"),
+			},
+			args{
+				ctx: context.Background(),
+				key: &MachineKey{
+					ObjectRoot: models.ObjectRoot{
+						AggregateID:   "user1",
+						ResourceOwner: "org1",
+					},
+					Type:           domain.AuthNKeyTypeJSON,
+					ExpirationDate: time.Now().Add(365 * 24 * time.Hour),
+					PublicKey:      []byte(fakePubkey),
+				},
+			},
+			res{
+				err: zerrors.IsErrorInvalidArgument,
+			},
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -274,3 +322,198 @@ func TestCommands_AddMachineKey(t *testing.T) {
 		})
 	}
 }
+
+func TestCommands_RotateUserMachineKey(t *testing.T) {
+	type fields struct {
+		eventstore  func(t *testing.T) *eventstore.Eventstore
+		idGenerator id.Generator
+	}
+	type args struct {
+		ctx            context.Context
+		oldKeyID       string
+		key            *MachineKey
+		revocationDate time.Time
+	}
+	type res struct {
+		want *domain.ObjectDetails
+		err  func(error) bool
+	}
+	revocationDate := time.Now().Add(24 * time.Hour).UTC()
+	oldKeyAddedEvent := func() eventstore.Event {
+		return eventFromEventPusher(
+			user.NewMachineKeyAddedEvent(context.Background(),
+				&user.NewAggregate("user1", "org1").Aggregate,
+				"key1",
+				domain.AuthNKeyTypeJSON,
+				time.Date(9999, 12, 31, 23, 59, 59, 0, time.UTC),
+				[]byte(fakePubkey),
+			),
+		)
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		args   args
+		res    res
+	}{
+		{
+			name: "revocation date in the past, error",
+			fields: fields{
+				eventstore:  expectEventstore(),
+				idGenerator: id_mock.NewIDGeneratorExpectIDs(t, "key2"),
+			},
+			args: args{
+				ctx:      context.Background(),
+				oldKeyID: "key1",
+				key: &MachineKey{
+					ObjectRoot: models.ObjectRoot{
+						AggregateID:   "user1",
+						ResourceOwner: "org1",
+					},
+					Type:      domain.AuthNKeyTypeJSON,
+					PublicKey: []byte(fakePubkey),
+				},
+				revocationDate: time.Now().Add(-time.Hour),
+			},
+			res: res{
+				err: zerrors.IsErrorInvalidArgument,
+			},
+		},
+		{
+			name: "old key not found, error",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(),
+				),
+				idGenerator: id_mock.NewIDGeneratorExpectIDs(t, "key2"),
+			},
+			args: args{
+				ctx:      context.Background(),
+				oldKeyID: "key1",
+				key: &MachineKey{
+					ObjectRoot: models.ObjectRoot{
+						AggregateID:   "user1",
+						ResourceOwner: "org1",
+					},
+					Type:      domain.AuthNKeyTypeJSON,
+					PublicKey: []byte(fakePubkey),
+				},
+				revocationDate: revocationDate,
+			},
+			res: res{
+				err: zerrors.IsNotFound,
+			},
+		},
+		{
+			name: "old key already rotated, error",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						oldKeyAddedEvent(),
+						eventFromEventPusher(
+							user.NewMachineKeyRevocationScheduledEvent(context.Background(),
+								&user.NewAggregate("user1", "org1").Aggregate,
+								"key1",
+								revocationDate,
+							),
+						),
+					),
+				),
+				idGenerator: id_mock.NewIDGeneratorExpectIDs(t, "key2"),
+			},
+			args: args{
+				ctx:      context.Background(),
+				oldKeyID: "key1",
+				key: &MachineKey{
+					ObjectRoot: models.ObjectRoot{
+						AggregateID:   "user1",
+						ResourceOwner: "org1",
+					},
+					Type:      domain.AuthNKeyTypeJSON,
+					PublicKey: []byte(fakePubkey),
+				},
+				revocationDate: revocationDate,
+			},
+			res: res{
+				err: zerrors.IsPreconditionFailed,
+			},
+		},
+		{
+			name: "key rotated, ok",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						oldKeyAddedEvent(),
+					),
+					expectFilter(
+						eventFromEventPusher(
+							user.NewMachineAddedEvent(context.Background(),
+								&user.NewAggregate("user1", "org1").Aggregate,
+								"machine",
+								"Machine",
+								"",
+								true,
+								domain.OIDCTokenTypeBearer,
+							),
+						),
+					),
+					expectFilter(),
+					expectFilter(),
+					expectPush(
+						user.NewMachineKeyRevocationScheduledEvent(context.Background(),
+							&user.NewAggregate("user1", "org1").Aggregate,
+							"key1",
+							revocationDate,
+						),
+						user.NewMachineKeyAddedEvent(context.Background(),
+							&user.NewAggregate("user1", "org1").Aggregate,
+							"key2",
+							domain.AuthNKeyTypeJSON,
+							time.Date(9999, 12, 31, 23, 59, 59, 0, time.UTC),
+							[]byte(fakePubkey),
+						),
+					),
+				),
+				idGenerator: id_mock.NewIDGeneratorExpectIDs(t, "key2"),
+			},
+			args: args{
+				ctx:      context.Background(),
+				oldKeyID: "key1",
+				key: &MachineKey{
+					ObjectRoot: models.ObjectRoot{
+						AggregateID:   "user1",
+						ResourceOwner: "org1",
+					},
+					Type:           domain.AuthNKeyTypeJSON,
+					ExpirationDate: time.Date(9999, 12, 31, 23, 59, 59, 0, time.UTC),
+					PublicKey:      []byte(fakePubkey),
+				},
+				revocationDate: revocationDate,
+			},
+			res: res{
+				want: &domain.ObjectDetails{
+					ResourceOwner: "org1",
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &Commands{
+				eventstore:  tt.fields.eventstore(t),
+				idGenerator: tt.fields.idGenerator,
+			}
+			got, err := c.RotateUserMachineKey(tt.args.ctx, tt.args.oldKeyID, tt.args.key, tt.args.revocationDate)
+			if tt.res.err == nil {
+				assert.NoError(t, err)
+			}
+			if tt.res.err != nil && !tt.res.err(err) {
+				t.Errorf("got wrong err: %v ", err)
+			}
+			if tt.res.err == nil {
+				assert.Equal(t, tt.res.want, got)
+				assert.Equal(t, "key2", tt.args.key.KeyID)
+			}
+		})
+	}
+}
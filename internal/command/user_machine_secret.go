@@ -3,6 +3,8 @@ package command
 import (
 	"context"
 
+	"github.com/zitadel/logging"
+
 	"github.com/zitadel/zitadel/internal/command/preparation"
 	"github.com/zitadel/zitadel/internal/domain"
 	"github.com/zitadel/zitadel/internal/eventstore"
@@ -116,6 +118,11 @@ func (c *Commands) MachineSecretCheckSucceeded(ctx context.Context, userID, reso
 	if updated != "" {
 		cmds = append(cmds, user.NewMachineSecretHashUpdatedEvent(ctx, &agg.Aggregate, updated))
 	}
+	breakGlassUsed, err := c.breakGlassUsedEvent(ctx, &agg.Aggregate, domain.BreakGlassAuthMethodClientSecret, nil)
+	logging.WithFields("userID", userID).OnError(err).Warn("unable to check break-glass state of machine user")
+	if breakGlassUsed != nil {
+		cmds = append(cmds, breakGlassUsed)
+	}
 	c.asyncPush(ctx, cmds...)
 }
 
@@ -312,6 +312,7 @@ func TestCommands_MachineSecretCheckSucceeded(t *testing.T) {
 
 	c := &Commands{
 		eventstore: eventstoreExpect(t,
+			expectFilter(),
 			expectPushSlow(time.Second/100, cmd),
 		),
 	}
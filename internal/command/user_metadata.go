@@ -24,6 +24,13 @@ func (c *Commands) SetUserMetadata(ctx context.Context, metadata *domain.Metadat
 	if err != nil {
 		return nil, err
 	}
+	schema, err := c.orgUserAttributeSchemaWriteModel(ctx, resourceOwner)
+	if err != nil {
+		return nil, err
+	}
+	if err = schema.validateSetMetadata(metadata.Key, metadata.Value); err != nil {
+		return nil, err
+	}
 	pushedEvents, err := c.eventstore.Push(ctx, event)
 	if err != nil {
 		return nil, err
@@ -48,11 +55,18 @@ func (c *Commands) BulkSetUserMetadata(ctx context.Context, userID, resourceOwne
 	events := make([]eventstore.Command, len(metadatas))
 	setMetadata := NewUserMetadataListWriteModel(userID, resourceOwner)
 	userAgg := UserAggregateFromWriteModel(&setMetadata.WriteModel)
+	schema, err := c.orgUserAttributeSchemaWriteModel(ctx, resourceOwner)
+	if err != nil {
+		return nil, err
+	}
 	for i, data := range metadatas {
 		event, err := c.setUserMetadata(ctx, userAgg, data)
 		if err != nil {
 			return nil, err
 		}
+		if err = schema.validateSetMetadata(data.Key, data.Value); err != nil {
+			return nil, err
+		}
 		events[i] = event
 	}
 
@@ -95,6 +109,13 @@ func (c *Commands) RemoveUserMetadata(ctx context.Context, metadataKey, userID,
 	if !removeMetadata.State.Exists() {
 		return nil, zerrors.ThrowNotFound(nil, "META-ncnw3", "Errors.Metadata.NotFound")
 	}
+	schema, err := c.orgUserAttributeSchemaWriteModel(ctx, resourceOwner)
+	if err != nil {
+		return nil, err
+	}
+	if err = schema.validateRemoveMetadata(metadataKey); err != nil {
+		return nil, err
+	}
 	userAgg := UserAggregateFromWriteModel(&removeMetadata.WriteModel)
 	event, err := c.removeUserMetadata(ctx, userAgg, metadataKey)
 	if err != nil {
@@ -126,6 +147,10 @@ func (c *Commands) BulkRemoveUserMetadata(ctx context.Context, userID, resourceO
 	if err != nil {
 		return nil, err
 	}
+	schema, err := c.orgUserAttributeSchemaWriteModel(ctx, resourceOwner)
+	if err != nil {
+		return nil, err
+	}
 	userAgg := UserAggregateFromWriteModel(&removeMetadata.WriteModel)
 	for i, key := range metadataKeys {
 		if key == "" {
@@ -134,6 +159,9 @@ func (c *Commands) BulkRemoveUserMetadata(ctx context.Context, userID, resourceO
 		if _, found := removeMetadata.metadataList[key]; !found {
 			return nil, zerrors.ThrowNotFound(nil, "META-2nnds", "Errors.Metadata.KeyNotExisting")
 		}
+		if err = schema.validateRemoveMetadata(key); err != nil {
+			return nil, err
+		}
 		event, err := c.removeUserMetadata(ctx, userAgg, key)
 		if err != nil {
 			return nil, err
@@ -0,0 +1,157 @@
+package command
+
+import (
+	"context"
+	"time"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore/v1/models"
+	"github.com/zitadel/zitadel/internal/repository/user"
+	"github.com/zitadel/zitadel/internal/telemetry/tracing"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+// OfflineToken is a long-lived token of a user issued to a first-party application.
+// Other than a personal access token, it's bound to the application and can be listed and revoked by the user.
+type OfflineToken struct {
+	models.ObjectRoot
+
+	// ClientID is the client id of the application the token is issued to
+	ClientID       string
+	ExpirationDate time.Time
+	Description    string
+
+	TokenID string
+	Token   string
+}
+
+// AddOfflineToken issues an offline token of the user to the application of the client.
+// The application must allow offline tokens and the expiration must not exceed its max lifetime.
+// Tokens without an expiration get the max lifetime.
+func (c *Commands) AddOfflineToken(ctx context.Context, token *OfflineToken) (_ *domain.ObjectDetails, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	if token.AggregateID == "" {
+		return nil, zerrors.ThrowInvalidArgument(nil, "COMMAND-Ot6um", "Errors.User.UserIDMissing")
+	}
+	if token.ClientID == "" {
+		return nil, zerrors.ThrowPermissionDenied(nil, "COMMAND-Ot7cm", "Errors.User.OfflineToken.ApplicationNotAllowed")
+	}
+	if !token.ExpirationDate.IsZero() && token.ExpirationDate.Before(time.Now()) {
+		return nil, zerrors.ThrowInvalidArgument(nil, "COMMAND-Ot8eb", "Errors.User.OfflineToken.ExpirationInvalid")
+	}
+	if err = c.checkCallerNotOfflineToken(ctx, token.AggregateID, token.ResourceOwner); err != nil {
+		return nil, err
+	}
+	projectID, maxLifetime, err := c.offlineTokenMaxLifetime(ctx, token.ClientID)
+	if err != nil {
+		return nil, err
+	}
+	maxDate := time.Now().Add(maxLifetime)
+	if token.ExpirationDate.IsZero() {
+		token.ExpirationDate = maxDate
+	}
+	if token.ExpirationDate.After(maxDate) {
+		return nil, zerrors.ThrowInvalidArgument(nil, "COMMAND-Ot9ex", "Errors.User.OfflineToken.ExpirationExceedsMaxLifetime")
+	}
+
+	existingUser, err := c.userWriteModelByID(ctx, token.AggregateID, token.ResourceOwner)
+	if err != nil {
+		return nil, err
+	}
+	if !isUserStateExists(existingUser.UserState) {
+		return nil, zerrors.ThrowNotFound(nil, "COMMAND-Ot1nf", "Errors.User.NotFound")
+	}
+	if existingUser.UserState != domain.UserStateActive {
+		return nil, zerrors.ThrowPreconditionFailed(nil, "COMMAND-Ot2na", "Errors.User.OfflineToken.UserNotActive")
+	}
+
+	token.TokenID, err = c.idGenerator.Next()
+	if err != nil {
+		return nil, err
+	}
+	token.Token, err = createToken(c.keyAlgorithm, token.TokenID, token.AggregateID)
+	if err != nil {
+		return nil, err
+	}
+	writeModel := NewOfflineTokenWriteModel(token.AggregateID, token.TokenID, existingUser.ResourceOwner)
+	err = c.pushAppendAndReduce(ctx, writeModel,
+		user.NewOfflineTokenAddedEvent(
+			ctx,
+			UserAggregateFromWriteModel(&existingUser.WriteModel),
+			token.TokenID,
+			token.ClientID,
+			[]string{projectID, token.ClientID},
+			token.ExpirationDate,
+			token.Description,
+		),
+	)
+	if err != nil {
+		return nil, err
+	}
+	return writeModelToObjectDetails(&writeModel.WriteModel), nil
+}
+
+// RevokeOfflineToken revokes an offline token of the user, the token can't be used anymore.
+func (c *Commands) RevokeOfflineToken(ctx context.Context, userID, tokenID, resourceOwner string) (_ *domain.ObjectDetails, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	if userID == "" || tokenID == "" {
+		return nil, zerrors.ThrowInvalidArgument(nil, "COMMAND-Ot3im", "Errors.IDMissing")
+	}
+	writeModel := NewOfflineTokenWriteModel(userID, tokenID, resourceOwner)
+	if err = c.eventstore.FilterToQueryReducer(ctx, writeModel); err != nil {
+		return nil, err
+	}
+	if !writeModel.Active {
+		return nil, zerrors.ThrowNotFound(nil, "COMMAND-Ot4nf", "Errors.User.OfflineToken.NotFound")
+	}
+	err = c.pushAppendAndReduce(ctx, writeModel,
+		user.NewOfflineTokenRevokedEvent(ctx, UserAggregateFromWriteModel(&writeModel.WriteModel), tokenID),
+	)
+	if err != nil {
+		return nil, err
+	}
+	return writeModelToObjectDetails(&writeModel.WriteModel), nil
+}
+
+// checkCallerNotOfflineToken prevents extending the lifetime of an offline token
+// by issuing a new offline token with it.
+func (c *Commands) checkCallerNotOfflineToken(ctx context.Context, userID, resourceOwner string) error {
+	tokenID := authz.GetCtxData(ctx).TokenID
+	if tokenID == "" {
+		return nil
+	}
+	caller := NewOfflineTokenWriteModel(userID, tokenID, resourceOwner)
+	if err := c.eventstore.FilterToQueryReducer(ctx, caller); err != nil {
+		return err
+	}
+	// a revoked token might still be cached by the token verifier, so every issued offline token is rejected
+	if caller.ClientID != "" {
+		return zerrors.ThrowPermissionDenied(nil, "COMMAND-Ot0ic", "Errors.User.OfflineToken.IssuedByOfflineToken")
+	}
+	return nil
+}
+
+// offlineTokenMaxLifetime returns the project and the max lifetime of the offline tokens of the application of the client.
+// Applications which don't allow offline tokens (anymore) or aren't active return an error.
+func (c *Commands) offlineTokenMaxLifetime(ctx context.Context, clientID string) (projectID string, maxLifetime time.Duration, err error) {
+	client := newOfflineTokenClientReadModel(clientID)
+	if err = c.eventstore.FilterToQueryReducer(ctx, client); err != nil {
+		return "", 0, err
+	}
+	if client.appID == "" {
+		return "", 0, zerrors.ThrowPermissionDenied(nil, "COMMAND-Ot5pd", "Errors.User.OfflineToken.ApplicationNotAllowed")
+	}
+	app, err := c.getOIDCAppWriteModel(ctx, client.projectID, client.appID, "")
+	if err != nil {
+		return "", 0, err
+	}
+	if app.State != domain.AppStateActive || app.OfflineTokenMaxLifetime <= 0 {
+		return "", 0, zerrors.ThrowPermissionDenied(nil, "COMMAND-Ot6pd", "Errors.User.OfflineToken.ApplicationNotAllowed")
+	}
+	return client.projectID, app.OfflineTokenMaxLifetime, nil
+}
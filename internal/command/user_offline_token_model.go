@@ -0,0 +1,109 @@
+package command
+
+import (
+	"time"
+
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/repository/project"
+	"github.com/zitadel/zitadel/internal/repository/user"
+)
+
+type OfflineTokenWriteModel struct {
+	eventstore.WriteModel
+
+	TokenID        string
+	ClientID       string
+	ExpirationDate time.Time
+	Active         bool
+}
+
+func NewOfflineTokenWriteModel(userID, tokenID, resourceOwner string) *OfflineTokenWriteModel {
+	return &OfflineTokenWriteModel{
+		WriteModel: eventstore.WriteModel{
+			AggregateID:   userID,
+			ResourceOwner: resourceOwner,
+		},
+		TokenID: tokenID,
+	}
+}
+
+func (wm *OfflineTokenWriteModel) AppendEvents(events ...eventstore.Event) {
+	for _, event := range events {
+		switch e := event.(type) {
+		case *user.OfflineTokenAddedEvent:
+			if wm.TokenID != e.TokenID {
+				continue
+			}
+			wm.WriteModel.AppendEvents(e)
+		case *user.OfflineTokenRevokedEvent:
+			if wm.TokenID != e.TokenID {
+				continue
+			}
+			wm.WriteModel.AppendEvents(e)
+		case *user.UserRemovedEvent:
+			wm.WriteModel.AppendEvents(e)
+		}
+	}
+}
+
+func (wm *OfflineTokenWriteModel) Reduce() error {
+	for _, event := range wm.Events {
+		switch e := event.(type) {
+		case *user.OfflineTokenAddedEvent:
+			wm.ClientID = e.ApplicationID
+			wm.ExpirationDate = e.Expiration
+			wm.Active = true
+		case *user.OfflineTokenRevokedEvent, *user.UserRemovedEvent:
+			wm.Active = false
+		}
+	}
+	return wm.WriteModel.Reduce()
+}
+
+func (wm *OfflineTokenWriteModel) Query() *eventstore.SearchQueryBuilder {
+	return eventstore.NewSearchQueryBuilder(eventstore.ColumnsEvent).
+		ResourceOwner(wm.ResourceOwner).
+		AddQuery().
+		AggregateTypes(user.AggregateType).
+		AggregateIDs(wm.AggregateID).
+		EventTypes(
+			user.OfflineTokenAddedType,
+			user.OfflineTokenRevokedType,
+			user.UserRemovedType).
+		Builder()
+}
+
+// offlineTokenClientReadModel finds the application of the client, which allowed offline tokens.
+// The current setting and state of the application are read from the [OIDCApplicationWriteModel].
+type offlineTokenClientReadModel struct {
+	eventstore.WriteModel
+
+	clientID  string
+	projectID string
+	appID     string
+}
+
+func newOfflineTokenClientReadModel(clientID string) *offlineTokenClientReadModel {
+	return &offlineTokenClientReadModel{
+		clientID: clientID,
+	}
+}
+
+func (rm *offlineTokenClientReadModel) Reduce() error {
+	for _, event := range rm.Events {
+		if e, ok := event.(*project.ApplicationOfflineTokensSetEvent); ok && e.ClientID == rm.clientID {
+			rm.projectID = e.Aggregate().ID
+			rm.appID = e.AppID
+		}
+	}
+	return rm.WriteModel.Reduce()
+}
+
+func (rm *offlineTokenClientReadModel) Query() *eventstore.SearchQueryBuilder {
+	return eventstore.NewSearchQueryBuilder(eventstore.ColumnsEvent).
+		AddQuery().
+		AggregateTypes(project.AggregateType).
+		EventTypes(project.ApplicationOfflineTokensSetType).
+		EventData(map[string]interface{}{"clientId": rm.clientID}).
+		Builder()
+}
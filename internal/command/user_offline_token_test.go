@@ -0,0 +1,448 @@
+package command
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+	"golang.org/x/text/language"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/crypto"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/eventstore/v1/models"
+	"github.com/zitadel/zitadel/internal/id"
+	id_mock "github.com/zitadel/zitadel/internal/id/mock"
+	"github.com/zitadel/zitadel/internal/repository/project"
+	"github.com/zitadel/zitadel/internal/repository/user"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+func TestCommands_AddOfflineToken(t *testing.T) {
+	expiration := time.Now().Add(24 * time.Hour).UTC()
+	offlineTokensSet := func(maxLifetime time.Duration) eventstore.Event {
+		return eventFromEventPusher(
+			project.NewApplicationOfflineTokensSetEvent(context.Background(),
+				&project.NewAggregate("project1", "org1").Aggregate,
+				"app1",
+				"client1",
+				maxLifetime,
+			),
+		)
+	}
+	oidcApp := func(maxLifetime time.Duration) []eventstore.Event {
+		return []eventstore.Event{
+			eventFromEventPusher(
+				project.NewApplicationAddedEvent(context.Background(),
+					&project.NewAggregate("project1", "org1").Aggregate,
+					"app1",
+					"app",
+				),
+			),
+			eventFromEventPusher(
+				project.NewOIDCConfigAddedEvent(context.Background(),
+					&project.NewAggregate("project1", "org1").Aggregate,
+					domain.OIDCVersionV1,
+					"app1",
+					"client1",
+					"secret",
+					[]string{"https://test.ch"},
+					[]domain.OIDCResponseType{domain.OIDCResponseTypeCode},
+					[]domain.OIDCGrantType{domain.OIDCGrantTypeAuthorizationCode},
+					domain.OIDCApplicationTypeNative,
+					domain.OIDCAuthMethodTypeNone,
+					[]string{"https://test.ch/logout"},
+					false,
+					domain.OIDCTokenTypeBearer,
+					false,
+					false,
+					false,
+					0,
+					nil,
+					false,
+					"",
+					"",
+				),
+			),
+			offlineTokensSet(maxLifetime),
+		}
+	}
+	humanAdded := func() eventstore.Event {
+		return eventFromEventPusher(
+			user.NewHumanAddedEvent(context.Background(),
+				&user.NewAggregate("user1", "org1").Aggregate,
+				"username",
+				"firstname",
+				"lastname",
+				"nickname",
+				"displayname",
+				language.German,
+				domain.GenderUnspecified,
+				"email@test.ch",
+				true,
+			),
+		)
+	}
+	type fields struct {
+		eventstore  func(*testing.T) *eventstore.Eventstore
+		idGenerator id.Generator
+	}
+	type args struct {
+		ctx   context.Context
+		token *OfflineToken
+	}
+	type res struct {
+		want    *domain.ObjectDetails
+		tokenID string
+		err     func(error) bool
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		args   args
+		res    res
+	}{
+		{
+			name: "missing client, permission denied error",
+			fields: fields{
+				eventstore: expectEventstore(),
+			},
+			args: args{
+				ctx: context.Background(),
+				token: &OfflineToken{
+					ObjectRoot: models.ObjectRoot{AggregateID: "user1", ResourceOwner: "org1"},
+				},
+			},
+			res: res{
+				err: zerrors.IsPermissionDenied,
+			},
+		},
+		{
+			name: "expiration in the past, invalid argument error",
+			fields: fields{
+				eventstore: expectEventstore(),
+			},
+			args: args{
+				ctx: context.Background(),
+				token: &OfflineToken{
+					ObjectRoot:     models.ObjectRoot{AggregateID: "user1", ResourceOwner: "org1"},
+					ClientID:       "client1",
+					ExpirationDate: time.Now().Add(-time.Hour),
+				},
+			},
+			res: res{
+				err: zerrors.IsErrorInvalidArgument,
+			},
+		},
+		{
+			name: "called with offline token, permission denied error",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(
+							user.NewOfflineTokenAddedEvent(context.Background(),
+								&user.NewAggregate("user1", "org1").Aggregate,
+								"caller1",
+								"client1",
+								[]string{"project1", "client1"},
+								expiration,
+								"laptop",
+							),
+						),
+					),
+				),
+			},
+			args: args{
+				ctx: authz.SetCtxData(context.Background(), authz.CtxData{UserID: "user1", TokenID: "caller1"}),
+				token: &OfflineToken{
+					ObjectRoot: models.ObjectRoot{AggregateID: "user1", ResourceOwner: "org1"},
+					ClientID:   "client1",
+				},
+			},
+			res: res{
+				err: zerrors.IsPermissionDenied,
+			},
+		},
+		{
+			name: "called with other token, application without offline tokens, permission denied error",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(),
+					expectFilter(),
+				),
+			},
+			args: args{
+				ctx: authz.SetCtxData(context.Background(), authz.CtxData{UserID: "user1", TokenID: "caller1"}),
+				token: &OfflineToken{
+					ObjectRoot: models.ObjectRoot{AggregateID: "user1", ResourceOwner: "org1"},
+					ClientID:   "client1",
+				},
+			},
+			res: res{
+				err: zerrors.IsPermissionDenied,
+			},
+		},
+		{
+			name: "application without offline tokens, permission denied error",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(),
+				),
+			},
+			args: args{
+				ctx: context.Background(),
+				token: &OfflineToken{
+					ObjectRoot: models.ObjectRoot{AggregateID: "user1", ResourceOwner: "org1"},
+					ClientID:   "client1",
+				},
+			},
+			res: res{
+				err: zerrors.IsPermissionDenied,
+			},
+		},
+		{
+			name: "offline tokens disallowed again, permission denied error",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(offlineTokensSet(0)),
+					expectFilter(oidcApp(0)...),
+				),
+			},
+			args: args{
+				ctx: context.Background(),
+				token: &OfflineToken{
+					ObjectRoot: models.ObjectRoot{AggregateID: "user1", ResourceOwner: "org1"},
+					ClientID:   "client1",
+				},
+			},
+			res: res{
+				err: zerrors.IsPermissionDenied,
+			},
+		},
+		{
+			name: "expiration exceeds max lifetime, invalid argument error",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(offlineTokensSet(time.Hour)),
+					expectFilter(oidcApp(time.Hour)...),
+				),
+			},
+			args: args{
+				ctx: context.Background(),
+				token: &OfflineToken{
+					ObjectRoot:     models.ObjectRoot{AggregateID: "user1", ResourceOwner: "org1"},
+					ClientID:       "client1",
+					ExpirationDate: expiration,
+				},
+			},
+			res: res{
+				err: zerrors.IsErrorInvalidArgument,
+			},
+		},
+		{
+			name: "user not existing, not found error",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(offlineTokensSet(30*24*time.Hour)),
+					expectFilter(oidcApp(30*24*time.Hour)...),
+					expectFilter(),
+				),
+			},
+			args: args{
+				ctx: context.Background(),
+				token: &OfflineToken{
+					ObjectRoot:     models.ObjectRoot{AggregateID: "user1", ResourceOwner: "org1"},
+					ClientID:       "client1",
+					ExpirationDate: expiration,
+				},
+			},
+			res: res{
+				err: zerrors.IsNotFound,
+			},
+		},
+		{
+			name: "add offline token, ok",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(offlineTokensSet(30*24*time.Hour)),
+					expectFilter(oidcApp(30*24*time.Hour)...),
+					expectFilter(humanAdded()),
+					expectPush(
+						user.NewOfflineTokenAddedEvent(context.Background(),
+							&user.NewAggregate("user1", "org1").Aggregate,
+							"token1",
+							"client1",
+							[]string{"project1", "client1"},
+							expiration,
+							"laptop",
+						),
+					),
+				),
+				idGenerator: id_mock.NewIDGeneratorExpectIDs(t, "token1"),
+			},
+			args: args{
+				ctx: context.Background(),
+				token: &OfflineToken{
+					ObjectRoot:     models.ObjectRoot{AggregateID: "user1", ResourceOwner: "org1"},
+					ClientID:       "client1",
+					ExpirationDate: expiration,
+					Description:    "laptop",
+				},
+			},
+			res: res{
+				want: &domain.ObjectDetails{
+					ResourceOwner: "org1",
+				},
+				tokenID: "token1",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &Commands{
+				eventstore:   tt.fields.eventstore(t),
+				idGenerator:  tt.fields.idGenerator,
+				keyAlgorithm: crypto.CreateMockEncryptionAlg(gomock.NewController(t)),
+			}
+			got, err := c.AddOfflineToken(tt.args.ctx, tt.args.token)
+			if tt.res.err == nil {
+				assert.NoError(t, err)
+			}
+			if tt.res.err != nil && !tt.res.err(err) {
+				t.Errorf("got wrong err: %v ", err)
+			}
+			if tt.res.err == nil {
+				assert.Equal(t, tt.res.want, got)
+				assert.Equal(t, tt.res.tokenID, tt.args.token.TokenID)
+				assert.NotEmpty(t, tt.args.token.Token)
+			}
+		})
+	}
+}
+
+func TestCommands_RevokeOfflineToken(t *testing.T) {
+	type fields struct {
+		eventstore func(*testing.T) *eventstore.Eventstore
+	}
+	type args struct {
+		ctx           context.Context
+		userID        string
+		tokenID       string
+		resourceOwner string
+	}
+	type res struct {
+		want *domain.ObjectDetails
+		err  func(error) bool
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		args   args
+		res    res
+	}{
+		{
+			name: "missing token id, invalid argument error",
+			fields: fields{
+				eventstore: expectEventstore(),
+			},
+			args: args{
+				ctx:           context.Background(),
+				userID:        "user1",
+				resourceOwner: "org1",
+			},
+			res: res{
+				err: zerrors.IsErrorInvalidArgument,
+			},
+		},
+		{
+			name: "token revoked, not found error",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(
+							user.NewOfflineTokenAddedEvent(context.Background(),
+								&user.NewAggregate("user1", "org1").Aggregate,
+								"token1",
+								"client1",
+								[]string{"project1", "client1"},
+								time.Now().Add(time.Hour),
+								"",
+							),
+						),
+						eventFromEventPusher(
+							user.NewOfflineTokenRevokedEvent(context.Background(),
+								&user.NewAggregate("user1", "org1").Aggregate,
+								"token1",
+							),
+						),
+					),
+				),
+			},
+			args: args{
+				ctx:           context.Background(),
+				userID:        "user1",
+				tokenID:       "token1",
+				resourceOwner: "org1",
+			},
+			res: res{
+				err: zerrors.IsNotFound,
+			},
+		},
+		{
+			name: "revoke token, ok",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(
+							user.NewOfflineTokenAddedEvent(context.Background(),
+								&user.NewAggregate("user1", "org1").Aggregate,
+								"token1",
+								"client1",
+								[]string{"project1", "client1"},
+								time.Now().Add(time.Hour),
+								"",
+							),
+						),
+					),
+					expectPush(
+						user.NewOfflineTokenRevokedEvent(context.Background(),
+							&user.NewAggregate("user1", "org1").Aggregate,
+							"token1",
+						),
+					),
+				),
+			},
+			args: args{
+				ctx:           context.Background(),
+				userID:        "user1",
+				tokenID:       "token1",
+				resourceOwner: "org1",
+			},
+			res: res{
+				want: &domain.ObjectDetails{
+					ResourceOwner: "org1",
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &Commands{
+				eventstore: tt.fields.eventstore(t),
+			}
+			got, err := c.RevokeOfflineToken(tt.args.ctx, tt.args.userID, tt.args.tokenID, tt.args.resourceOwner)
+			if tt.res.err == nil {
+				assert.NoError(t, err)
+			}
+			if tt.res.err != nil && !tt.res.err(err) {
+				t.Errorf("got wrong err: %v ", err)
+			}
+			if tt.res.err == nil {
+				assert.Equal(t, tt.res.want, got)
+			}
+		})
+	}
+}
@@ -5,6 +5,7 @@ import (
 
 	"github.com/zitadel/zitadel/internal/api/authz"
 	"github.com/zitadel/zitadel/internal/command/preparation"
+	"github.com/zitadel/zitadel/internal/domain"
 	"github.com/zitadel/zitadel/internal/zerrors"
 )
 
@@ -21,17 +22,30 @@ func passwordComplexityPolicyWriteModel(ctx context.Context, filter preparation.
 }
 
 func customPasswordComplexityPolicy(ctx context.Context, filter preparation.FilterToQueryReducer) (*PasswordComplexityPolicyWriteModel, error) {
-	policy := NewOrgPasswordComplexityPolicyWriteModel(authz.GetCtxData(ctx).OrgID)
-	events, err := filter(ctx, policy.Query())
-	if err != nil {
-		return nil, err
+	orgID := authz.GetCtxData(ctx).OrgID
+	// the policy of the nearest ancestor applies if the organization has none of its own
+	for depth := 0; depth < domain.MaxOrgHierarchyDepth; depth++ {
+		policy := NewOrgPasswordComplexityPolicyWriteModel(orgID)
+		events, err := filter(ctx, policy.Query())
+		if err != nil {
+			return nil, err
+		}
+		if len(events) == 0 {
+			return nil, nil
+		}
+		policy.AppendEvents(events...)
+		if err = policy.Reduce(); err != nil {
+			return nil, err
+		}
+		if depth > 0 && policy.OrgRemoved {
+			return nil, nil
+		}
+		if policy.State.Exists() || policy.ParentOrgID == "" {
+			return &policy.PasswordComplexityPolicyWriteModel, nil
+		}
+		orgID = policy.ParentOrgID
 	}
-	if len(events) == 0 {
-		return nil, nil
-	}
-	policy.AppendEvents(events...)
-	err = policy.Reduce()
-	return &policy.PasswordComplexityPolicyWriteModel, err
+	return nil, nil
 }
 
 func defaultPasswordComplexityPolicy(ctx context.Context, filter preparation.FilterToQueryReducer) (*PasswordComplexityPolicyWriteModel, error) {
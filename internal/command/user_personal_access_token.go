@@ -3,8 +3,10 @@ package command
 import (
 	"context"
 	"encoding/base64"
+	"slices"
 	"time"
 
+	http_util "github.com/zitadel/zitadel/internal/api/http"
 	"github.com/zitadel/zitadel/internal/command/preparation"
 	"github.com/zitadel/zitadel/internal/crypto"
 	"github.com/zitadel/zitadel/internal/domain"
@@ -25,6 +27,12 @@ type PersonalAccessToken struct {
 	ExpirationDate  time.Time
 	Scopes          []string
 	AllowedUserType domain.UserType
+	// Audience optionally restricts the token to the listed projects and applications
+	Audience []string
+	// Permissions optionally restricts the token to the listed permissions of the user
+	Permissions []string
+	// AllowedIPs optionally restricts the token to requests from the listed IP addresses and networks
+	AllowedIPs []string
 
 	TokenID string
 	Token   string
@@ -59,6 +67,12 @@ func (pat *PersonalAccessToken) valid() (err error) {
 	if err := pat.content(); err != nil {
 		return err
 	}
+	if slices.Contains(pat.Audience, "") || slices.Contains(pat.Permissions, "") {
+		return zerrors.ThrowInvalidArgument(nil, "COMMAND-Pat1s", "Errors.User.PAT.ScopeInvalid")
+	}
+	if !http_util.IsIPAllowList(pat.AllowedIPs) {
+		return zerrors.ThrowInvalidArgument(nil, "COMMAND-Pat2i", "Errors.User.PAT.AllowedIPInvalid")
+	}
 	pat.ExpirationDate, err = domain.ValidateExpirationDate(pat.ExpirationDate)
 	return err
 }
@@ -118,6 +132,10 @@ func prepareAddPersonalAccessToken(pat *PersonalAccessToken, algorithm crypto.En
 			if err != nil {
 				return nil, err
 			}
+			pat.ExpirationDate, err = limitMachineCredentialExpiration(ctx, filter, pat.ResourceOwner, pat.ExpirationDate)
+			if err != nil {
+				return nil, err
+			}
 
 			return []eventstore.Command{
 				user.NewPersonalAccessTokenAddedEvent(
@@ -126,6 +144,9 @@ func prepareAddPersonalAccessToken(pat *PersonalAccessToken, algorithm crypto.En
 					pat.TokenID,
 					pat.ExpirationDate,
 					pat.Scopes,
+					pat.Audience,
+					pat.Permissions,
+					pat.AllowedIPs,
 				),
 			}, nil
 		}, nil
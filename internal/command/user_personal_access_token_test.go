@@ -175,12 +175,16 @@ func TestCommands_AddPersonalAccessToken(t *testing.T) {
 						),
 					),
 					expectFilter(),
+					expectFilter(),
 					expectPush(
 						user.NewPersonalAccessTokenAddedEvent(context.Background(),
 							&user.NewAggregate("user1", "org1").Aggregate,
 							"token1",
 							time.Date(9999, 12, 31, 23, 59, 59, 0, time.UTC),
 							[]string{"openid"},
+							nil,
+							nil,
+							nil,
 						),
 					),
 				),
@@ -223,12 +227,16 @@ func TestCommands_AddPersonalAccessToken(t *testing.T) {
 						),
 					),
 					expectFilter(),
+					expectFilter(),
 					expectPush(
 						user.NewPersonalAccessTokenAddedEvent(context.Background(),
 							&user.NewAggregate("user1", "org1").Aggregate,
 							"token1",
 							time.Date(9999, 12, 31, 23, 59, 59, 0, time.UTC),
 							[]string{"openid"},
+							nil,
+							nil,
+							nil,
 						),
 					),
 				),
@@ -254,6 +262,82 @@ func TestCommands_AddPersonalAccessToken(t *testing.T) {
 				token: base64.RawURLEncoding.EncodeToString([]byte("token1:user1")),
 			},
 		},
+		{
+			"invalid allowed ip, error",
+			fields{
+				eventstore: eventstoreExpect(t),
+			},
+			args{
+				ctx: context.Background(),
+				pat: &PersonalAccessToken{
+					ObjectRoot: models.ObjectRoot{
+						AggregateID:   "user1",
+						ResourceOwner: "org1",
+					},
+					TokenID:         "token1",
+					Scopes:          []string{"openid"},
+					AllowedUserType: domain.UserTypeMachine,
+					AllowedIPs:      []string{"zitadel.com"},
+				},
+			},
+			res{
+				err: zerrors.IsErrorInvalidArgument,
+			},
+		},
+		{
+			"token added with restrictions",
+			fields{
+				eventstore: eventstoreExpect(t,
+					expectFilter(
+						eventFromEventPusher(
+							user.NewMachineAddedEvent(context.Background(),
+								&user.NewAggregate("user1", "org1").Aggregate,
+								"machine",
+								"Machine",
+								"",
+								true,
+								domain.OIDCTokenTypeBearer,
+							),
+						),
+					),
+					expectFilter(),
+					expectFilter(),
+					expectPush(
+						user.NewPersonalAccessTokenAddedEvent(context.Background(),
+							&user.NewAggregate("user1", "org1").Aggregate,
+							"token1",
+							time.Date(9999, 12, 31, 23, 59, 59, 0, time.UTC),
+							[]string{"openid"},
+							[]string{"project1"},
+							[]string{"user.read"},
+							[]string{"10.0.0.0/8", "192.168.0.1"},
+						),
+					),
+				),
+				keyAlgorithm: crypto.CreateMockEncryptionAlg(gomock.NewController(t)),
+			},
+			args{
+				ctx: context.Background(),
+				pat: &PersonalAccessToken{
+					ObjectRoot: models.ObjectRoot{
+						AggregateID:   "user1",
+						ResourceOwner: "org1",
+					},
+					TokenID:         "token1",
+					Scopes:          []string{"openid"},
+					AllowedUserType: domain.UserTypeMachine,
+					Audience:        []string{"project1"},
+					Permissions:     []string{"user.read"},
+					AllowedIPs:      []string{"10.0.0.0/8", "192.168.0.1"},
+				},
+			},
+			res{
+				want: &domain.ObjectDetails{
+					ResourceOwner: "org1",
+				},
+				token: base64.RawURLEncoding.EncodeToString([]byte("token1:user1")),
+			},
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -327,6 +411,9 @@ func TestCommands_RemovePersonalAccessToken(t *testing.T) {
 								"token1",
 								time.Date(9999, 12, 31, 23, 59, 59, 0, time.UTC),
 								[]string{"openid"},
+								nil,
+								nil,
+								nil,
 							),
 						),
 					),
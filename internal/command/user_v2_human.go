@@ -104,18 +104,37 @@ func (h *ChangeHuman) Changed() bool {
 }
 
 func (c *Commands) AddUserHuman(ctx context.Context, resourceOwner string, human *AddHuman, allowInitMail bool, alg crypto.EncryptionAlgorithm) (err error) {
+	existingHuman, cmds, err := c.addUserHumanCommands(ctx, resourceOwner, human, allowInitMail, alg)
+	if err != nil {
+		return err
+	}
+	if len(cmds) == 0 {
+		human.Details = writeModelToObjectDetails(&existingHuman.WriteModel)
+		return nil
+	}
+
+	err = c.pushAppendAndReduce(ctx, existingHuman, cmds...)
+	if err != nil {
+		return err
+	}
+	human.Details = writeModelToObjectDetails(&existingHuman.WriteModel)
+	return nil
+}
+
+// addUserHumanCommands validates the human and returns the commands to create it without pushing them.
+func (c *Commands) addUserHumanCommands(ctx context.Context, resourceOwner string, human *AddHuman, allowInitMail bool, alg crypto.EncryptionAlgorithm) (_ *UserV2WriteModel, _ []eventstore.Command, err error) {
 	if resourceOwner == "" {
-		return zerrors.ThrowInvalidArgument(nil, "COMMA-095xh8fll1", "Errors.Internal")
+		return nil, nil, zerrors.ThrowInvalidArgument(nil, "COMMA-095xh8fll1", "Errors.Internal")
 	}
 
 	if err := human.Validate(c.userPasswordHasher); err != nil {
-		return err
+		return nil, nil, err
 	}
 
 	if human.ID == "" {
 		human.ID, err = c.idGenerator.Next()
 		if err != nil {
-			return err
+			return nil, nil, err
 		}
 	}
 
@@ -125,27 +144,31 @@ func (c *Commands) AddUserHuman(ctx context.Context, resourceOwner string, human
 		human.ID,
 	)
 	if err != nil {
-		return err
+		return nil, nil, err
 	}
 	if isUserStateExists(existingHuman.UserState) {
-		return zerrors.ThrowPreconditionFailed(nil, "COMMAND-7yiox1isql", "Errors.User.AlreadyExisting")
+		return nil, nil, zerrors.ThrowPreconditionFailed(nil, "COMMAND-7yiox1isql", "Errors.User.AlreadyExisting")
 	}
 	// check for permission to create user on resourceOwner
 	if !human.Register {
 		if err := c.checkPermission(ctx, domain.PermissionUserWrite, resourceOwner, human.ID); err != nil {
-			return err
+			return nil, nil, err
 		}
 	}
 	// add resourceowner for the events with the aggregate
 	existingHuman.ResourceOwner = resourceOwner
 
+	if err := c.validateAddHuman(ctx, resourceOwner, human); err != nil {
+		return nil, nil, err
+	}
+
 	domainPolicy, err := c.domainPolicyWriteModel(ctx, resourceOwner)
 	if err != nil {
-		return err
+		return nil, nil, err
 	}
 
 	if err = c.userValidateDomain(ctx, resourceOwner, human.Username, domainPolicy.UserLoginMustBeDomain); err != nil {
-		return err
+		return nil, nil, err
 	}
 	var createCmd humanCreationCommand
 	if human.Register {
@@ -185,8 +208,8 @@ func (c *Commands) AddUserHuman(ctx context.Context, resourceOwner string, human
 
 	// separated to change when old user logic is not used anymore
 	filter := c.eventstore.Filter //nolint:staticcheck
-	if err := addHumanCommandPassword(ctx, filter, createCmd, human, c.userPasswordHasher); err != nil {
-		return err
+	if err := c.addHumanCommandPassword(ctx, filter, createCmd, human, c.userPasswordHasher); err != nil {
+		return nil, nil, err
 	}
 
 	cmds := make([]eventstore.Command, 0, 3)
@@ -194,12 +217,12 @@ func (c *Commands) AddUserHuman(ctx context.Context, resourceOwner string, human
 
 	cmds, err = c.addHumanCommandEmail(ctx, filter, cmds, existingHuman.Aggregate(), human, alg, allowInitMail)
 	if err != nil {
-		return err
+		return nil, nil, err
 	}
 
 	cmds, err = c.addHumanCommandPhone(ctx, filter, cmds, existingHuman.Aggregate(), human, alg)
 	if err != nil {
-		return err
+		return nil, nil, err
 	}
 
 	for _, metadataEntry := range human.Metadata {
@@ -213,15 +236,23 @@ func (c *Commands) AddUserHuman(ctx context.Context, resourceOwner string, human
 	for _, link := range human.Links {
 		cmd, err := addLink(ctx, filter, existingHuman.Aggregate(), link)
 		if err != nil {
-			return err
+			return nil, nil, err
 		}
 		cmds = append(cmds, cmd)
 	}
 
+	attributeSchema, err := orgUserAttributeSchema(ctx, filter, resourceOwner)
+	if err != nil {
+		return nil, nil, err
+	}
+	if err = attributeSchema.validateUserMetadata(human.Metadata); err != nil {
+		return nil, nil, err
+	}
+
 	if human.TOTPSecret != "" {
-		encryptedSecret, err := crypto.Encrypt([]byte(human.TOTPSecret), c.multifactors.OTP.CryptoMFA)
+		encryptedSecret, err := crypto.Encrypt([]byte(human.TOTPSecret), crypto.ForContext(ctx, c.multifactors.OTP.CryptoMFA))
 		if err != nil {
-			return err
+			return nil, nil, err
 		}
 		cmds = append(cmds,
 			user.NewHumanOTPAddedEvent(ctx, &existingHuman.Aggregate().Aggregate, encryptedSecret),
@@ -229,17 +260,7 @@ func (c *Commands) AddUserHuman(ctx context.Context, resourceOwner string, human
 		)
 	}
 
-	if len(cmds) == 0 {
-		human.Details = writeModelToObjectDetails(&existingHuman.WriteModel)
-		return nil
-	}
-
-	err = c.pushAppendAndReduce(ctx, existingHuman, cmds...)
-	if err != nil {
-		return err
-	}
-	human.Details = writeModelToObjectDetails(&existingHuman.WriteModel)
-	return nil
+	return existingHuman, cmds, nil
 }
 
 func (c *Commands) ChangeUserHuman(ctx context.Context, human *ChangeHuman, alg crypto.EncryptionAlgorithm) (err error) {
@@ -278,6 +299,9 @@ func (c *Commands) ChangeUserHuman(ctx context.Context, human *ChangeHuman, alg
 		}
 	}
 	if human.Profile != nil {
+		if err := c.validateChangeProfile(ctx, existingHuman, human.Profile); err != nil {
+			return err
+		}
 		cmds, err = changeUserProfile(ctx, cmds, existingHuman, human.Profile)
 		if err != nil {
 			return err
@@ -0,0 +1,97 @@
+package command
+
+import (
+	"context"
+	"strings"
+
+	"github.com/zitadel/zitadel/internal/crypto"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/telemetry/tracing"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+// importHumansBatchSize is the maximum number of users whose events are pushed together
+const importHumansBatchSize = 100
+
+// ImportHumanResult is the outcome of the import of a single user,
+// either the details of the created user or the error which prevented it.
+type ImportHumanResult struct {
+	// ID of the user, on a dry run it's only set if it was passed
+	ID      string
+	Details *domain.ObjectDetails
+	Err     error
+}
+
+type importedHuman struct {
+	index int
+	wm    *UserV2WriteModel
+	cmds  []eventstore.Command
+}
+
+// ImportHumans validates each user on its own, a user which fails doesn't prevent the import of the others.
+// The events of the valid users are pushed in batches, if a batch fails its users are pushed one by one.
+// On a dry run the users are only validated and nothing is pushed.
+func (c *Commands) ImportHumans(ctx context.Context, resourceOwner string, humans []*AddHuman, dryRun, allowInitMail bool, alg crypto.EncryptionAlgorithm) (results []*ImportHumanResult) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.End() }()
+
+	results = make([]*ImportHumanResult, len(humans))
+	usernames := make(map[string]struct{}, len(humans))
+	batch := make([]*importedHuman, 0, importHumansBatchSize)
+	for i, human := range humans {
+		results[i] = &ImportHumanResult{ID: human.ID}
+		// unique usernames are only checked on push, duplicates within the import must be found before
+		username := strings.ToLower(strings.TrimSpace(human.Username))
+		if _, ok := usernames[username]; ok {
+			results[i].Err = zerrors.ThrowAlreadyExists(nil, "COMMAND-Im2du", "Errors.User.AlreadyExisting")
+			continue
+		}
+		usernames[username] = struct{}{}
+
+		wm, cmds, err := c.addUserHumanCommands(ctx, resourceOwner, human, allowInitMail, alg)
+		if err != nil {
+			results[i].Err = err
+			continue
+		}
+		if dryRun {
+			continue
+		}
+		batch = append(batch, &importedHuman{index: i, wm: wm, cmds: cmds})
+		if len(batch) == importHumansBatchSize {
+			c.pushImportedHumans(ctx, batch, results)
+			batch = batch[:0]
+		}
+	}
+	if len(batch) > 0 {
+		c.pushImportedHumans(ctx, batch, results)
+	}
+	return results
+}
+
+func (c *Commands) pushImportedHumans(ctx context.Context, batch []*importedHuman, results []*ImportHumanResult) {
+	cmds := make([]eventstore.Command, 0, len(batch)*3)
+	for _, imported := range batch {
+		cmds = append(cmds, imported.cmds...)
+	}
+	events, err := c.eventstore.Push(ctx, cmds...)
+	if err != nil {
+		if len(batch) == 1 {
+			results[batch[0].index].Err = err
+			return
+		}
+		// find the failing users by pushing them one by one
+		for _, imported := range batch {
+			c.pushImportedHumans(ctx, []*importedHuman{imported}, results)
+		}
+		return
+	}
+	// the pushed events are in the order of the commands
+	for _, imported := range batch {
+		result := results[imported.index]
+		result.Err = AppendAndReduce(imported.wm, events[:len(imported.cmds)]...)
+		events = events[len(imported.cmds):]
+		result.ID = imported.wm.AggregateID
+		result.Details = writeModelToObjectDetails(&imported.wm.WriteModel)
+	}
+}
@@ -0,0 +1,192 @@
+package command
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/text/language"
+
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/id"
+	id_mock "github.com/zitadel/zitadel/internal/id/mock"
+	"github.com/zitadel/zitadel/internal/repository/org"
+	"github.com/zitadel/zitadel/internal/repository/user"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+func TestCommandSide_ImportHumans(t *testing.T) {
+	type fields struct {
+		eventstore      func(t *testing.T) *eventstore.Eventstore
+		idGenerator     id.Generator
+		checkPermission domain.PermissionCheck
+	}
+	type args struct {
+		ctx    context.Context
+		orgID  string
+		humans []*AddHuman
+		dryRun bool
+	}
+	type result struct {
+		id      string
+		details *domain.ObjectDetails
+		err     func(error) bool
+	}
+
+	importHuman := func(username string) *AddHuman {
+		return &AddHuman{
+			Username:  username,
+			FirstName: "firstname",
+			LastName:  "lastname",
+			Email: Email{
+				Address:  "email@test.ch",
+				Verified: true,
+			},
+			PreferredLanguage:   language.English,
+			EncodedPasswordHash: "$plain$x$password",
+		}
+	}
+	importedEvents := func(userID, username string) []eventstore.Command {
+		agg := &user.NewAggregate(userID, "org1").Aggregate
+		added := user.NewHumanAddedEvent(context.Background(),
+			agg,
+			username,
+			"firstname",
+			"lastname",
+			"",
+			"firstname lastname",
+			language.English,
+			domain.GenderUnspecified,
+			"email@test.ch",
+			true,
+		)
+		added.AddPasswordData("$plain$x$password", false)
+		return []eventstore.Command{
+			added,
+			user.NewHumanEmailVerifiedEvent(context.Background(), agg),
+		}
+	}
+	domainPolicy := func() expect {
+		return expectFilter(
+			eventFromEventPusher(
+				org.NewDomainPolicyAddedEvent(context.Background(),
+					&org.NewAggregate("org1").Aggregate,
+					true,
+					true,
+					true,
+				),
+			),
+		)
+	}
+
+	tests := []struct {
+		name   string
+		fields fields
+		args   args
+		res    []result
+	}{
+		{
+			name: "dry run, duplicate username, nothing pushed",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(),
+					domainPolicy(),
+					expectFilter(),
+				),
+				checkPermission: newMockPermissionCheckAllowed(),
+				idGenerator:     id_mock.NewIDGeneratorExpectIDs(t, "user1"),
+			},
+			args: args{
+				ctx:    context.Background(),
+				orgID:  "org1",
+				humans: []*AddHuman{importHuman("username1"), importHuman(" USERNAME1 ")},
+				dryRun: true,
+			},
+			res: []result{
+				{},
+				{err: zerrors.IsErrorAlreadyExists},
+			},
+		},
+		{
+			name: "invalid user, others imported in one batch",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(),
+					domainPolicy(),
+					expectFilter(),
+					expectFilter(),
+					domainPolicy(),
+					expectFilter(),
+					expectPush(append(importedEvents("user1", "username1"), importedEvents("user2", "username2")...)...),
+				),
+				checkPermission: newMockPermissionCheckAllowed(),
+				idGenerator:     id_mock.NewIDGeneratorExpectIDs(t, "user1", "user2"),
+			},
+			args: args{
+				ctx:    context.Background(),
+				orgID:  "org1",
+				humans: []*AddHuman{importHuman("username1"), importHuman(""), importHuman("username2")},
+			},
+			res: []result{
+				{id: "user1", details: &domain.ObjectDetails{ResourceOwner: "org1"}},
+				{err: zerrors.IsErrorInvalidArgument},
+				{id: "user2", details: &domain.ObjectDetails{ResourceOwner: "org1"}},
+			},
+		},
+		{
+			name: "batch fails, users pushed one by one",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(),
+					domainPolicy(),
+					expectFilter(),
+					expectFilter(),
+					domainPolicy(),
+					expectFilter(),
+					expectPushFailed(zerrors.ThrowAlreadyExists(nil, "ERROR", "unique constraint"),
+						append(importedEvents("user1", "username1"), importedEvents("user2", "username2")...)...,
+					),
+					expectPush(importedEvents("user1", "username1")...),
+					expectPushFailed(zerrors.ThrowAlreadyExists(nil, "ERROR", "unique constraint"),
+						importedEvents("user2", "username2")...,
+					),
+				),
+				checkPermission: newMockPermissionCheckAllowed(),
+				idGenerator:     id_mock.NewIDGeneratorExpectIDs(t, "user1", "user2"),
+			},
+			args: args{
+				ctx:    context.Background(),
+				orgID:  "org1",
+				humans: []*AddHuman{importHuman("username1"), importHuman("username2")},
+			},
+			res: []result{
+				{id: "user1", details: &domain.ObjectDetails{ResourceOwner: "org1"}},
+				{err: zerrors.IsErrorAlreadyExists},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := &Commands{
+				eventstore:         tt.fields.eventstore(t),
+				userPasswordHasher: mockPasswordHasher("x"),
+				idGenerator:        tt.fields.idGenerator,
+				checkPermission:    tt.fields.checkPermission,
+			}
+			results := r.ImportHumans(tt.args.ctx, tt.args.orgID, tt.args.humans, tt.args.dryRun, false, nil)
+			if !assert.Len(t, results, len(tt.res)) {
+				return
+			}
+			for i, want := range tt.res {
+				if want.err == nil {
+					assert.NoError(t, results[i].Err)
+				} else if !want.err(results[i].Err) {
+					t.Errorf("got wrong err for record %d: %v ", i, results[i].Err)
+				}
+				assert.Equal(t, want.id, results[i].ID)
+				assert.Equal(t, want.details, results[i].Details)
+			}
+		})
+	}
+}
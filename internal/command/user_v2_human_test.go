@@ -227,6 +227,7 @@ func TestCommandSide_AddUserHuman(t *testing.T) {
 							),
 						),
 					),
+					expectFilter(),
 					expectPush(
 						user.NewHumanRegisteredEvent(context.Background(),
 							&userAgg.Aggregate,
@@ -333,6 +334,7 @@ func TestCommandSide_AddUserHuman(t *testing.T) {
 							),
 						),
 					),
+					expectFilter(),
 					expectPush(
 						user.NewHumanAddedEvent(context.Background(),
 							&userAgg.Aggregate,
@@ -412,9 +414,11 @@ func TestCommandSide_AddUserHuman(t *testing.T) {
 								false,
 								false,
 								false,
+								false,
 							),
 						),
 					),
+					expectFilter(),
 					expectPush(
 						newAddHumanEvent("$plain$x$password", false, true, "", language.English),
 						user.NewHumanInitialCodeAddedEvent(context.Background(),
@@ -483,9 +487,11 @@ func TestCommandSide_AddUserHuman(t *testing.T) {
 								false,
 								false,
 								false,
+								false,
 							),
 						),
 					),
+					expectFilter(),
 					expectPush(
 						newAddHumanEvent("$plain$x$password", false, true, "", language.English),
 						user.NewHumanEmailCodeAddedEventV2(context.Background(),
@@ -557,9 +563,11 @@ func TestCommandSide_AddUserHuman(t *testing.T) {
 								false,
 								false,
 								false,
+								false,
 							),
 						),
 					),
+					expectFilter(),
 					expectPush(
 						newAddHumanEvent("$plain$x$password", false, true, "", language.English),
 						user.NewHumanEmailCodeAddedEventV2(context.Background(),
@@ -632,9 +640,11 @@ func TestCommandSide_AddUserHuman(t *testing.T) {
 								false,
 								false,
 								false,
+								false,
 							),
 						),
 					),
+					expectFilter(),
 					expectPush(
 						newAddHumanEvent("$plain$x$password", true, true, "", language.English),
 						user.NewHumanEmailVerifiedEvent(context.Background(),
@@ -696,9 +706,11 @@ func TestCommandSide_AddUserHuman(t *testing.T) {
 								false,
 								false,
 								false,
+								false,
 							),
 						),
 					),
+					expectFilter(),
 					expectPush(
 						newAddHumanEvent("$plain$x$password", true, true, "", language.English),
 						user.NewHumanEmailVerifiedEvent(context.Background(),
@@ -760,9 +772,11 @@ func TestCommandSide_AddUserHuman(t *testing.T) {
 								false,
 								false,
 								false,
+								false,
 							),
 						),
 					),
+					expectFilter(),
 					expectPush(
 						newAddHumanEvent("$plain$x$password", true, false, "", language.English),
 						user.NewHumanEmailVerifiedEvent(context.Background(),
@@ -885,9 +899,11 @@ func TestCommandSide_AddUserHuman(t *testing.T) {
 								false,
 								false,
 								false,
+								false,
 							),
 						),
 					),
+					expectFilter(),
 					expectPush(
 						func() eventstore.Command {
 							event := user.NewHumanAddedEvent(context.Background(),
@@ -965,9 +981,11 @@ func TestCommandSide_AddUserHuman(t *testing.T) {
 								false,
 								false,
 								false,
+								false,
 							),
 						),
 					),
+					expectFilter(),
 					expectPush(
 						newAddHumanEvent("$plain$x$password", false, true, "+41711234567", language.English),
 						user.NewHumanEmailVerifiedEvent(
@@ -1034,6 +1052,7 @@ func TestCommandSide_AddUserHuman(t *testing.T) {
 							),
 						),
 					),
+					expectFilter(),
 					expectPush(
 						newAddHumanEvent("", false, true, "+41711234567", language.English),
 						user.NewHumanInitialCodeAddedEvent(
@@ -1108,9 +1127,11 @@ func TestCommandSide_AddUserHuman(t *testing.T) {
 								false,
 								false,
 								false,
+								false,
 							),
 						),
 					),
+					expectFilter(),
 					expectPush(
 						newAddHumanEvent("$plain$x$password", false, true, "+41711234567", language.English),
 						user.NewHumanEmailVerifiedEvent(context.Background(),
@@ -1178,6 +1199,7 @@ func TestCommandSide_AddUserHuman(t *testing.T) {
 							),
 						),
 					),
+					expectFilter(),
 					expectPush(
 						newAddHumanEvent("", false, true, "", language.English),
 						user.NewHumanInitialCodeAddedEvent(
@@ -1261,6 +1283,7 @@ func TestCommandSide_AddUserHuman(t *testing.T) {
 							),
 						),
 					),
+					expectFilter(),
 					expectPush(
 						newRegisterHumanEvent("email@test.ch", "", false, true, "", language.English),
 						user.NewHumanEmailCodeAddedEvent(
@@ -1348,6 +1371,7 @@ func TestCommandSide_AddUserHuman(t *testing.T) {
 							),
 						),
 					),
+					expectFilter(),
 					expectPush(
 						newRegisterHumanEvent("email@test.ch", "", false, true, "", language.English),
 						user.NewHumanEmailVerifiedEvent(
@@ -1415,6 +1439,7 @@ func TestCommandSide_AddUserHuman(t *testing.T) {
 							),
 						),
 					),
+					expectFilter(),
 					expectPush(
 						user.NewHumanRegisteredEvent(context.Background(),
 							&userAgg.Aggregate,
@@ -2316,6 +2341,7 @@ func TestCommandSide_ChangeUserHuman(t *testing.T) {
 								true,
 								false,
 								false,
+								false,
 							),
 						),
 					),
@@ -2420,6 +2446,7 @@ func TestCommandSide_ChangeUserHuman(t *testing.T) {
 								false,
 								false,
 								false,
+								false,
 							),
 						),
 					),
@@ -2476,6 +2503,7 @@ func TestCommandSide_ChangeUserHuman(t *testing.T) {
 								false,
 								false,
 								false,
+								false,
 							),
 						),
 					),
@@ -2583,6 +2611,7 @@ func TestCommandSide_ChangeUserHuman(t *testing.T) {
 								false,
 								false,
 								false,
+								false,
 							),
 						),
 					),
@@ -2707,6 +2736,7 @@ func TestCommandSide_ChangeUserHuman(t *testing.T) {
 								true,
 								true,
 								true,
+								false,
 							),
 						),
 					),
@@ -2829,6 +2859,7 @@ func TestCommandSide_ChangeUserHuman(t *testing.T) {
 								false,
 								false,
 								false,
+								false,
 							),
 						),
 					),
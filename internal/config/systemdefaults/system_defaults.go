@@ -7,13 +7,23 @@ import (
 )
 
 type SystemDefaults struct {
-	SecretGenerators   SecretGenerators
-	PasswordHasher     crypto.HashConfig
-	SecretHasher       crypto.HashConfig
-	Multifactors       MultifactorConfig
-	DomainVerification DomainVerification
-	Notifications      Notifications
-	KeyConfig          KeyConfig
+	SecretGenerators        SecretGenerators
+	PasswordHasher          crypto.HashConfig
+	SecretHasher            crypto.HashConfig
+	PasswordBreachCheck     PasswordBreachCheck
+	GeoIP                   GeoIP
+	Multifactors            MultifactorConfig
+	DomainVerification      DomainVerification
+	UserLifecycle           UserLifecycle
+	MachineCredentialExpiry MachineCredentialExpiry
+	IDPHealthCheck          IDPHealthCheck
+	SAMLMetadataRefresh     SAMLMetadataRefresh
+	AccessReview            AccessReview
+	Retention               Retention
+	UserDataExport          UserDataExport
+	Notifications           Notifications
+	KeyConfig               KeyConfig
+	InstanceHostCache       InstanceHostCache
 }
 
 type SecretGenerators struct {
@@ -21,6 +31,19 @@ type SecretGenerators struct {
 	ApplicationKeySize uint32
 }
 
+type PasswordBreachCheck struct {
+	RangeURL        string
+	BloomFilterPath string
+}
+
+// GeoIP resolves the country and the autonomous system of clients for the network access policies
+type GeoIP struct {
+	// FilePath of a CSV file with the rows network (CIDR), country code and ASN
+	FilePath string
+	// LookupURL of a service responding with the JSON location, the {ip} placeholder is replaced by the client IP
+	LookupURL string
+}
+
 type MultifactorConfig struct {
 	OTP OTPConfig
 }
@@ -31,6 +54,55 @@ type OTPConfig struct {
 
 type DomainVerification struct {
 	VerificationGenerator crypto.GeneratorConfig
+	// RecheckInterval defines how often the verification tokens of the org domains are checked again, 0 disables the recheck.
+	RecheckInterval time.Duration
+	// VerificationExpiry after which a pending domain verification expires and the org owners are notified, 0 disables the expiry.
+	VerificationExpiry time.Duration
+}
+
+type UserLifecycle struct {
+	// Interval defines how often the user lifecycle policies of the organizations are applied, 0 disables the policies.
+	Interval time.Duration
+}
+
+type MachineCredentialExpiry struct {
+	// Interval defines how often the machine credentials are checked for their expiration, 0 disables the check.
+	Interval time.Duration
+}
+
+type IDPHealthCheck struct {
+	// Interval defines how often the identity providers are checked for their reachability, 0 disables the check.
+	Interval time.Duration
+	// CertificateExpiryWarning is the duration before the expiration of a certificate, from which on the check fails.
+	CertificateExpiryWarning time.Duration
+}
+
+type SAMLMetadataRefresh struct {
+	// Interval defines how often the metadata of SAML identity providers is fetched from their metadata URL, 0 disables the refresh.
+	Interval time.Duration
+}
+
+type AccessReview struct {
+	// Interval defines how often due access review campaigns are started and closed, 0 disables the access reviews.
+	Interval time.Duration
+}
+
+type Retention struct {
+	// Interval defines how often the retention of events and notifications is applied, 0 disables the retention.
+	Interval time.Duration
+	// RemovedUserPayloads is the duration after the removal of a user until the payloads of its events are erased, 0 keeps the payloads.
+	RemovedUserPayloads time.Duration
+	// Notifications is the duration after which sent notifications and their delivery statuses are deleted, 0 keeps them.
+	Notifications time.Duration
+	// LoginFailures is the duration after which failed login checks are deleted, 0 keeps them.
+	LoginFailures time.Duration
+	// BatchSize is the maximum amount of rows changed per statement.
+	BatchSize uint32
+}
+
+type UserDataExport struct {
+	// LinkLifetime defines how long the archive of a data export can be downloaded through its signed link.
+	LinkLifetime time.Duration
 }
 
 type Notifications struct {
@@ -44,3 +116,9 @@ type KeyConfig struct {
 	CertificateSize     int
 	CertificateLifetime time.Duration
 }
+
+type InstanceHostCache struct {
+	// MaxAge defines how long an instance resolved by the requested host is cached, 0 disables the cache.
+	// Changes of other running processes are applied after this duration.
+	MaxAge time.Duration
+}
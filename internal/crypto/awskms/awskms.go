@@ -0,0 +1,324 @@
+// Package awskms creates and uses asymmetric signing keys in AWS Key Management Service.
+// The private keys never leave AWS KMS, every signature is created by its JSON API.
+package awskms
+
+import (
+	"bytes"
+	"context"
+	"crypto"
+	"crypto/hmac"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"sort"
+	"strings"
+	"time"
+)
+
+const (
+	kmsService     = "kms"
+	targetPrefix   = "TrentService."
+	contentType    = "application/x-amz-json-1.1"
+	signingAlg     = "AWS4-HMAC-SHA256"
+	amzDateFormat  = "20060102T150405Z"
+	dateFormat     = "20060102"
+	defaultTimeout = 30 * time.Second
+)
+
+type Config struct {
+	// Region of the keys, e.g. eu-central-1
+	Region string
+	// Endpoint overwrites the AWS KMS endpoint of the region.
+	Endpoint string
+	// KeyDescription is set on every created key.
+	KeyDescription string
+	// AccessKeyID, SecretAccessKey and SessionToken authenticate the requests.
+	// If empty, the AWS_ACCESS_KEY_ID, AWS_SECRET_ACCESS_KEY and AWS_SESSION_TOKEN environment variables are used.
+	AccessKeyID     string
+	SecretAccessKey string
+	SessionToken    string
+}
+
+type credentials struct {
+	accessKeyID     string
+	secretAccessKey string
+	sessionToken    string
+}
+
+type KeyManager struct {
+	region         string
+	endpoint       string
+	keyDescription string
+	credentials    credentials
+	httpClient     *http.Client
+	now            func() time.Time
+}
+
+// New returns a KeyManager authenticated with the configured or the environment credentials.
+func New(config Config) (*KeyManager, error) {
+	return NewWithClient(config, &http.Client{Timeout: defaultTimeout})
+}
+
+// NewWithClient returns a KeyManager which sends its requests with the passed client.
+func NewWithClient(config Config, client *http.Client) (*KeyManager, error) {
+	if config.Region == "" {
+		return nil, errors.New("region missing")
+	}
+	creds := credentials{
+		accessKeyID:     config.AccessKeyID,
+		secretAccessKey: config.SecretAccessKey,
+		sessionToken:    config.SessionToken,
+	}
+	if creds.accessKeyID == "" {
+		creds = credentials{
+			accessKeyID:     os.Getenv("AWS_ACCESS_KEY_ID"),
+			secretAccessKey: os.Getenv("AWS_SECRET_ACCESS_KEY"),
+			sessionToken:    os.Getenv("AWS_SESSION_TOKEN"),
+		}
+	}
+	if creds.accessKeyID == "" || creds.secretAccessKey == "" {
+		return nil, errors.New("credentials missing")
+	}
+	endpoint := config.Endpoint
+	if endpoint == "" {
+		endpoint = "https://kms." + config.Region + ".amazonaws.com/"
+	}
+	return &KeyManager{
+		region:         config.Region,
+		endpoint:       strings.TrimSuffix(endpoint, "/") + "/",
+		keyDescription: config.KeyDescription,
+		credentials:    creds,
+		httpClient:     client,
+		now:            time.Now,
+	}, nil
+}
+
+type createKeyRequest struct {
+	KeySpec     string `json:"KeySpec"`
+	KeyUsage    string `json:"KeyUsage"`
+	Description string `json:"Description,omitempty"`
+}
+
+type createKeyResponse struct {
+	KeyMetadata struct {
+		Arn string `json:"Arn"`
+	} `json:"KeyMetadata"`
+}
+
+// CreateKey creates a new RSA signing key with the passed size (2048, 3072 or 4096 bits)
+// and returns its ARN as reference.
+func (m *KeyManager) CreateKey(ctx context.Context, bits int) (string, *rsa.PublicKey, error) {
+	switch bits {
+	case 2048, 3072, 4096:
+	default:
+		return "", nil, fmt.Errorf("unsupported key size %d", bits)
+	}
+	resp := new(createKeyResponse)
+	err := m.do(ctx, "CreateKey", &createKeyRequest{
+		KeySpec:     fmt.Sprintf("RSA_%d", bits),
+		KeyUsage:    "SIGN_VERIFY",
+		Description: m.keyDescription,
+	}, resp)
+	if err != nil {
+		return "", nil, err
+	}
+	publicKey, err := m.publicKey(ctx, resp.KeyMetadata.Arn)
+	if err != nil {
+		return "", nil, err
+	}
+	return resp.KeyMetadata.Arn, publicKey, nil
+}
+
+// Signer returns a signer for the key referenced by keyRef.
+func (m *KeyManager) Signer(ctx context.Context, keyRef string) (crypto.Signer, error) {
+	publicKey, err := m.publicKey(ctx, keyRef)
+	if err != nil {
+		return nil, err
+	}
+	return &signer{
+		manager:   m,
+		keyRef:    keyRef,
+		publicKey: publicKey,
+	}, nil
+}
+
+type keyRequest struct {
+	KeyID string `json:"KeyId"`
+}
+
+type publicKeyResponse struct {
+	PublicKey string `json:"PublicKey"`
+}
+
+func (m *KeyManager) publicKey(ctx context.Context, keyRef string) (*rsa.PublicKey, error) {
+	resp := new(publicKeyResponse)
+	if err := m.do(ctx, "GetPublicKey", &keyRequest{KeyID: keyRef}, resp); err != nil {
+		return nil, err
+	}
+	der, err := base64.StdEncoding.DecodeString(resp.PublicKey)
+	if err != nil {
+		return nil, err
+	}
+	key, err := x509.ParsePKIXPublicKey(der)
+	if err != nil {
+		return nil, err
+	}
+	rsaKey, ok := key.(*rsa.PublicKey)
+	if !ok {
+		return nil, errors.New("public key is not an RSA key")
+	}
+	return rsaKey, nil
+}
+
+func (m *KeyManager) do(ctx context.Context, operation string, body, result any) error {
+	data, err := json.Marshal(body)
+	if err != nil {
+		return err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, m.endpoint, bytes.NewReader(data))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", contentType)
+	req.Header.Set("X-Amz-Target", targetPrefix+operation)
+	signRequestV4(req, data, m.credentials, m.region, kmsService, m.now())
+	resp, err := m.httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("aws kms responded with status %d", resp.StatusCode)
+	}
+	return json.NewDecoder(resp.Body).Decode(result)
+}
+
+// signRequestV4 adds the authorization of the request using AWS Signature Version 4.
+func signRequestV4(req *http.Request, payload []byte, creds credentials, region, service string, now time.Time) {
+	now = now.UTC()
+	amzDate := now.Format(amzDateFormat)
+	req.Header.Set("X-Amz-Date", amzDate)
+	if creds.sessionToken != "" {
+		req.Header.Set("X-Amz-Security-Token", creds.sessionToken)
+	}
+	signedHeaders, canonicalHeaders := canonicalHeaders(req)
+	canonicalRequest := strings.Join([]string{
+		req.Method,
+		canonicalPath(req.URL),
+		req.URL.RawQuery,
+		canonicalHeaders,
+		signedHeaders,
+		hashHex(payload),
+	}, "\n")
+	scope := strings.Join([]string{now.Format(dateFormat), region, service, "aws4_request"}, "/")
+	stringToSign := strings.Join([]string{signingAlg, amzDate, scope, hashHex([]byte(canonicalRequest))}, "\n")
+
+	key := hmacSHA256([]byte("AWS4"+creds.secretAccessKey), now.Format(dateFormat))
+	key = hmacSHA256(key, region)
+	key = hmacSHA256(key, service)
+	key = hmacSHA256(key, "aws4_request")
+	signature := hex.EncodeToString(hmacSHA256(key, stringToSign))
+
+	req.Header.Set("Authorization", fmt.Sprintf("%s Credential=%s/%s, SignedHeaders=%s, Signature=%s",
+		signingAlg, creds.accessKeyID, scope, signedHeaders, signature))
+}
+
+func canonicalPath(u *url.URL) string {
+	if path := u.EscapedPath(); path != "" {
+		return path
+	}
+	return "/"
+}
+
+// canonicalHeaders returns the signed header names and their canonical form.
+// The host and all content and x-amz headers are signed.
+func canonicalHeaders(req *http.Request) (signed, canonical string) {
+	headers := map[string]string{"host": req.URL.Host}
+	names := []string{"host"}
+	for name, values := range req.Header {
+		name = strings.ToLower(name)
+		if name != "content-type" && !strings.HasPrefix(name, "x-amz-") {
+			continue
+		}
+		headers[name] = strings.TrimSpace(strings.Join(values, ","))
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	var builder strings.Builder
+	for _, name := range names {
+		builder.WriteString(name + ":" + headers[name] + "\n")
+	}
+	return strings.Join(names, ";"), builder.String()
+}
+
+func hashHex(data []byte) string {
+	hash := sha256.Sum256(data)
+	return hex.EncodeToString(hash[:])
+}
+
+func hmacSHA256(key []byte, data string) []byte {
+	mac := hmac.New(sha256.New, key)
+	mac.Write([]byte(data))
+	return mac.Sum(nil)
+}
+
+type signer struct {
+	manager   *KeyManager
+	keyRef    string
+	publicKey *rsa.PublicKey
+}
+
+func (s *signer) Public() crypto.PublicKey {
+	return s.publicKey
+}
+
+type signRequest struct {
+	KeyID            string `json:"KeyId"`
+	Message          string `json:"Message"`
+	MessageType      string `json:"MessageType"`
+	SigningAlgorithm string `json:"SigningAlgorithm"`
+}
+
+type signResponse struct {
+	Signature string `json:"Signature"`
+}
+
+// Sign creates the signature of the digest in AWS KMS.
+// PKCS #1 v1.5 is used, unless [rsa.PSSOptions] are passed.
+func (s *signer) Sign(_ io.Reader, digest []byte, opts crypto.SignerOpts) ([]byte, error) {
+	var hash string
+	switch opts.HashFunc() {
+	case crypto.SHA256:
+		hash = "SHA_256"
+	case crypto.SHA384:
+		hash = "SHA_384"
+	case crypto.SHA512:
+		hash = "SHA_512"
+	default:
+		return nil, fmt.Errorf("unsupported hash function %v", opts.HashFunc())
+	}
+	padding := "RSASSA_PKCS1_V1_5_"
+	if _, ok := opts.(*rsa.PSSOptions); ok {
+		padding = "RSASSA_PSS_"
+	}
+	resp := new(signResponse)
+	err := s.manager.do(context.Background(), "Sign", &signRequest{
+		KeyID:            s.keyRef,
+		Message:          base64.StdEncoding.EncodeToString(digest),
+		MessageType:      "DIGEST",
+		SigningAlgorithm: padding + hash,
+	}, resp)
+	if err != nil {
+		return nil, err
+	}
+	return base64.StdEncoding.DecodeString(resp.Signature)
+}
@@ -0,0 +1,116 @@
+package awskms
+
+import (
+	"context"
+	"crypto"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testKeyArn = "arn:aws:kms:eu-central-1:111122223333:key/1234abcd-12ab-34cd-56ef-1234567890ab"
+
+func testServer(t *testing.T, key *rsa.PrivateKey) *httptest.Server {
+	publicKey, err := x509.MarshalPKIXPublicKey(&key.PublicKey)
+	require.NoError(t, err)
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if !strings.HasPrefix(r.Header.Get("Authorization"), signingAlg+" Credential=AKID/") {
+			w.WriteHeader(http.StatusForbidden)
+			return
+		}
+		switch r.Header.Get("X-Amz-Target") {
+		case targetPrefix + "CreateKey":
+			req := new(createKeyRequest)
+			require.NoError(t, json.NewDecoder(r.Body).Decode(req))
+			assert.Equal(t, "RSA_2048", req.KeySpec)
+			resp := new(createKeyResponse)
+			resp.KeyMetadata.Arn = testKeyArn
+			json.NewEncoder(w).Encode(resp)
+		case targetPrefix + "GetPublicKey":
+			req := new(keyRequest)
+			require.NoError(t, json.NewDecoder(r.Body).Decode(req))
+			if req.KeyID != testKeyArn {
+				w.WriteHeader(http.StatusBadRequest)
+				return
+			}
+			json.NewEncoder(w).Encode(&publicKeyResponse{PublicKey: base64.StdEncoding.EncodeToString(publicKey)})
+		case targetPrefix + "Sign":
+			req := new(signRequest)
+			require.NoError(t, json.NewDecoder(r.Body).Decode(req))
+			assert.Equal(t, "RSASSA_PKCS1_V1_5_SHA_256", req.SigningAlgorithm)
+			digest, err := base64.StdEncoding.DecodeString(req.Message)
+			require.NoError(t, err)
+			signature, err := rsa.SignPKCS1v15(rand.Reader, key, crypto.SHA256, digest)
+			require.NoError(t, err)
+			json.NewEncoder(w).Encode(&signResponse{Signature: base64.StdEncoding.EncodeToString(signature)})
+		default:
+			w.WriteHeader(http.StatusBadRequest)
+		}
+	}))
+}
+
+func TestKeyManager(t *testing.T) {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	server := testServer(t, key)
+	defer server.Close()
+	manager, err := NewWithClient(Config{Region: "eu-central-1", Endpoint: server.URL, AccessKeyID: "AKID", SecretAccessKey: "secret"}, server.Client())
+	require.NoError(t, err)
+
+	_, _, err = manager.CreateKey(context.Background(), 1024)
+	assert.Error(t, err)
+
+	keyRef, publicKey, err := manager.CreateKey(context.Background(), 2048)
+	require.NoError(t, err)
+	assert.Equal(t, testKeyArn, keyRef)
+	assert.Equal(t, &key.PublicKey, publicKey)
+
+	signer, err := manager.Signer(context.Background(), keyRef)
+	require.NoError(t, err)
+	digest := sha256.Sum256([]byte("payload"))
+	signature, err := signer.Sign(rand.Reader, digest[:], crypto.SHA256)
+	require.NoError(t, err)
+	require.NoError(t, rsa.VerifyPKCS1v15(publicKey, crypto.SHA256, digest[:], signature))
+
+	_, err = signer.Sign(rand.Reader, digest[:], crypto.MD5)
+	assert.Error(t, err)
+
+	_, err = manager.Signer(context.Background(), "unknown")
+	assert.Error(t, err)
+}
+
+func TestNewWithClient_credentials(t *testing.T) {
+	t.Setenv("AWS_ACCESS_KEY_ID", "")
+	t.Setenv("AWS_SECRET_ACCESS_KEY", "")
+	_, err := NewWithClient(Config{Region: "eu-central-1"}, http.DefaultClient)
+	assert.Error(t, err)
+
+	t.Setenv("AWS_ACCESS_KEY_ID", "AKID")
+	t.Setenv("AWS_SECRET_ACCESS_KEY", "secret")
+	manager, err := NewWithClient(Config{Region: "eu-central-1"}, http.DefaultClient)
+	require.NoError(t, err)
+	assert.Equal(t, "AKID", manager.credentials.accessKeyID)
+	assert.Equal(t, "https://kms.eu-central-1.amazonaws.com/", manager.endpoint)
+}
+
+// Test_signRequestV4 uses the get-vanilla case of the AWS Signature Version 4 test suite.
+func Test_signRequestV4(t *testing.T) {
+	req, err := http.NewRequest(http.MethodGet, "https://example.amazonaws.com/", nil)
+	require.NoError(t, err)
+	signRequestV4(req, nil,
+		credentials{accessKeyID: "AKIDEXAMPLE", secretAccessKey: "wJalrXUtnFEMI/K7MDENG+bPxRfiCYEXAMPLEKEY"},
+		"us-east-1", "service", time.Date(2015, 8, 30, 12, 36, 0, 0, time.UTC),
+	)
+	assert.Equal(t, "AWS4-HMAC-SHA256 Credential=AKIDEXAMPLE/20150830/us-east-1/service/aws4_request, SignedHeaders=host;x-amz-date, Signature=5fa00fa31553b73ebf1942676e86291e8372ff2a2260956d9b8aae1d763fbf31", req.Header.Get("Authorization"))
+}
@@ -0,0 +1,222 @@
+// Package azurekeyvault creates and uses RSA signing keys in Azure Key Vault.
+// The private keys never leave the vault, every signature is created by its REST API.
+package azurekeyvault
+
+import (
+	"bytes"
+	"context"
+	"crypto"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"math/big"
+	"net/http"
+	"strings"
+	"time"
+
+	"golang.org/x/oauth2/clientcredentials"
+)
+
+const (
+	apiVersion     = "7.4"
+	scope          = "https://vault.azure.net/.default"
+	tokenURLFormat = "https://login.microsoftonline.com/%s/oauth2/v2.0/token"
+	defaultTimeout = 30 * time.Second
+)
+
+type Config struct {
+	// VaultURL is the URL of the key vault, e.g. https://{vault}.vault.azure.net
+	VaultURL string
+	// KeyName of the key in the vault. Every created key is a new version of it.
+	KeyName string
+	// TenantID, ClientID and ClientSecret of the app registration (service principal) used for authentication.
+	TenantID     string
+	ClientID     string
+	ClientSecret string
+}
+
+type KeyManager struct {
+	vaultURL   string
+	keyName    string
+	httpClient *http.Client
+}
+
+// New returns a KeyManager authenticated with the client credentials of the service principal.
+func New(ctx context.Context, config Config) (*KeyManager, error) {
+	if config.TenantID == "" || config.ClientID == "" || config.ClientSecret == "" {
+		return nil, errors.New("client credentials missing")
+	}
+	credentials := &clientcredentials.Config{
+		ClientID:     config.ClientID,
+		ClientSecret: config.ClientSecret,
+		TokenURL:     fmt.Sprintf(tokenURLFormat, config.TenantID),
+		Scopes:       []string{scope},
+	}
+	client := credentials.Client(ctx)
+	client.Timeout = defaultTimeout
+	return NewWithClient(config, client)
+}
+
+// NewWithClient returns a KeyManager which uses the passed, already authenticated client.
+func NewWithClient(config Config, client *http.Client) (*KeyManager, error) {
+	if config.VaultURL == "" || config.KeyName == "" {
+		return nil, errors.New("vault url or key name missing")
+	}
+	return &KeyManager{
+		vaultURL:   strings.TrimSuffix(config.VaultURL, "/"),
+		keyName:    config.KeyName,
+		httpClient: client,
+	}, nil
+}
+
+type createKeyRequest struct {
+	KeyType string   `json:"kty"`
+	KeySize int      `json:"key_size"`
+	KeyOps  []string `json:"key_ops"`
+}
+
+type keyBundle struct {
+	Key jsonWebKey `json:"key"`
+}
+
+type jsonWebKey struct {
+	KeyID string `json:"kid"`
+	N     string `json:"n"`
+	E     string `json:"e"`
+}
+
+// CreateKey creates a new version of the key and returns its key identifier as reference.
+func (m *KeyManager) CreateKey(ctx context.Context, bits int) (string, *rsa.PublicKey, error) {
+	bundle := new(keyBundle)
+	err := m.do(ctx, m.vaultURL+"/keys/"+m.keyName+"/create", &createKeyRequest{
+		KeyType: "RSA",
+		KeySize: bits,
+		KeyOps:  []string{"sign", "verify"},
+	}, bundle)
+	if err != nil {
+		return "", nil, err
+	}
+	publicKey, err := bundle.Key.publicKey()
+	if err != nil {
+		return "", nil, err
+	}
+	return bundle.Key.KeyID, publicKey, nil
+}
+
+// Signer returns a signer for the key version referenced by keyRef.
+func (m *KeyManager) Signer(ctx context.Context, keyRef string) (crypto.Signer, error) {
+	if !strings.HasPrefix(keyRef, m.vaultURL+"/keys/") {
+		return nil, errors.New("key is not part of the vault")
+	}
+	bundle := new(keyBundle)
+	if err := m.do(ctx, keyRef, nil, bundle); err != nil {
+		return nil, err
+	}
+	publicKey, err := bundle.Key.publicKey()
+	if err != nil {
+		return nil, err
+	}
+	return &signer{
+		manager:   m,
+		keyRef:    keyRef,
+		publicKey: publicKey,
+	}, nil
+}
+
+func (k *jsonWebKey) publicKey() (*rsa.PublicKey, error) {
+	n, err := base64.RawURLEncoding.DecodeString(k.N)
+	if err != nil {
+		return nil, err
+	}
+	e, err := base64.RawURLEncoding.DecodeString(k.E)
+	if err != nil {
+		return nil, err
+	}
+	exponent := new(big.Int).SetBytes(e)
+	if len(n) == 0 || !exponent.IsInt64() {
+		return nil, errors.New("invalid public key")
+	}
+	return &rsa.PublicKey{
+		N: new(big.Int).SetBytes(n),
+		E: int(exponent.Int64()),
+	}, nil
+}
+
+// do sends a POST request with the body or a GET request if the body is nil.
+func (m *KeyManager) do(ctx context.Context, url string, body, result any) error {
+	method := http.MethodGet
+	var reader io.Reader
+	if body != nil {
+		method = http.MethodPost
+		data, err := json.Marshal(body)
+		if err != nil {
+			return err
+		}
+		reader = bytes.NewReader(data)
+	}
+	req, err := http.NewRequestWithContext(ctx, method, url+"?api-version="+apiVersion, reader)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	resp, err := m.httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("azure key vault responded with status %d", resp.StatusCode)
+	}
+	return json.NewDecoder(resp.Body).Decode(result)
+}
+
+type signer struct {
+	manager   *KeyManager
+	keyRef    string
+	publicKey *rsa.PublicKey
+}
+
+func (s *signer) Public() crypto.PublicKey {
+	return s.publicKey
+}
+
+type signRequest struct {
+	Algorithm string `json:"alg"`
+	Value     string `json:"value"`
+}
+
+type signResponse struct {
+	Value string `json:"value"`
+}
+
+// Sign creates the signature of the digest in the key vault.
+// PKCS #1 v1.5 is used, unless [rsa.PSSOptions] are passed.
+func (s *signer) Sign(_ io.Reader, digest []byte, opts crypto.SignerOpts) ([]byte, error) {
+	var size string
+	switch opts.HashFunc() {
+	case crypto.SHA256:
+		size = "256"
+	case crypto.SHA384:
+		size = "384"
+	case crypto.SHA512:
+		size = "512"
+	default:
+		return nil, fmt.Errorf("unsupported hash function %v", opts.HashFunc())
+	}
+	alg := "RS" + size
+	if _, ok := opts.(*rsa.PSSOptions); ok {
+		alg = "PS" + size
+	}
+	resp := new(signResponse)
+	err := s.manager.do(context.Background(), s.keyRef+"/sign", &signRequest{
+		Algorithm: alg,
+		Value:     base64.RawURLEncoding.EncodeToString(digest),
+	}, resp)
+	if err != nil {
+		return nil, err
+	}
+	return base64.RawURLEncoding.DecodeString(resp.Value)
+}
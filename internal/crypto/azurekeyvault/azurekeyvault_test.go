@@ -0,0 +1,92 @@
+package azurekeyvault
+
+import (
+	"context"
+	"crypto"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	testKeyName = "zitadel"
+	testVersion = "/keys/" + testKeyName + "/1"
+)
+
+func testServer(t *testing.T, key *rsa.PrivateKey) *httptest.Server {
+	var server *httptest.Server
+	bundle := func() *keyBundle {
+		return &keyBundle{Key: jsonWebKey{
+			KeyID: server.URL + testVersion,
+			N:     base64.RawURLEncoding.EncodeToString(key.N.Bytes()),
+			E:     base64.RawURLEncoding.EncodeToString(big.NewInt(int64(key.E)).Bytes()),
+		}}
+	}
+	server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("api-version") != apiVersion {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		switch {
+		case r.Method == http.MethodPost && r.URL.Path == "/keys/"+testKeyName+"/create":
+			req := new(createKeyRequest)
+			require.NoError(t, json.NewDecoder(r.Body).Decode(req))
+			assert.Equal(t, "RSA", req.KeyType)
+			assert.Equal(t, 2048, req.KeySize)
+			json.NewEncoder(w).Encode(bundle())
+		case r.Method == http.MethodGet && r.URL.Path == testVersion:
+			json.NewEncoder(w).Encode(bundle())
+		case r.Method == http.MethodPost && r.URL.Path == testVersion+"/sign":
+			req := new(signRequest)
+			require.NoError(t, json.NewDecoder(r.Body).Decode(req))
+			assert.Equal(t, "RS256", req.Algorithm)
+			digest, err := base64.RawURLEncoding.DecodeString(req.Value)
+			require.NoError(t, err)
+			signature, err := rsa.SignPKCS1v15(rand.Reader, key, crypto.SHA256, digest)
+			require.NoError(t, err)
+			json.NewEncoder(w).Encode(&signResponse{Value: base64.RawURLEncoding.EncodeToString(signature)})
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	return server
+}
+
+func TestKeyManager(t *testing.T) {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	server := testServer(t, key)
+	defer server.Close()
+	manager, err := NewWithClient(Config{VaultURL: server.URL, KeyName: testKeyName}, server.Client())
+	require.NoError(t, err)
+
+	keyRef, publicKey, err := manager.CreateKey(context.Background(), 2048)
+	require.NoError(t, err)
+	assert.Equal(t, server.URL+testVersion, keyRef)
+	assert.Equal(t, &key.PublicKey, publicKey)
+
+	signer, err := manager.Signer(context.Background(), keyRef)
+	require.NoError(t, err)
+	digest := sha256.Sum256([]byte("payload"))
+	signature, err := signer.Sign(rand.Reader, digest[:], crypto.SHA256)
+	require.NoError(t, err)
+	require.NoError(t, rsa.VerifyPKCS1v15(publicKey, crypto.SHA256, digest[:], signature))
+
+	_, err = signer.Sign(rand.Reader, digest[:], crypto.MD5)
+	assert.Error(t, err)
+
+	_, err = manager.Signer(context.Background(), server.URL+"/keys/"+testKeyName+"/2")
+	assert.Error(t, err)
+
+	_, err = manager.Signer(context.Background(), "https://other.vault.azure.net"+testVersion)
+	assert.Error(t, err)
+}
@@ -11,6 +11,7 @@ import (
 const (
 	TypeEncryption CryptoType = iota
 	TypeHash                  // Depcrecated: use [passwap.Swapper] instead
+	TypeReference             // the value references a key held by a [KeyManager]
 )
 
 type EncryptionAlgorithm interface {
@@ -83,6 +84,12 @@ func checkEncryptionAlgorithm(value *CryptoValue, alg EncryptionAlgorithm) error
 	if value.Algorithm != alg.Algorithm() {
 		return zerrors.ThrowInvalidArgument(nil, "CRYPT-Nx7XlT", "value was encrypted with a different key")
 	}
+	if checker, ok := alg.(decryptionKeyChecker); ok {
+		if checker.HasDecryptionKey(value.KeyID) {
+			return nil
+		}
+		return zerrors.ThrowInvalidArgument(nil, "CRYPT-Wq3ma", "value was encrypted with a different key")
+	}
 	for _, id := range alg.DecryptionKeyIDs() {
 		if id == value.KeyID {
 			return nil
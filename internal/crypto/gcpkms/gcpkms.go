@@ -0,0 +1,212 @@
+// Package gcpkms creates and uses asymmetric signing keys in Google Cloud KMS.
+// The private keys never leave Cloud KMS, every signature is created by its REST API.
+package gcpkms
+
+import (
+	"bytes"
+	"context"
+	"crypto"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	"golang.org/x/oauth2/google"
+)
+
+const (
+	DefaultEndpoint = "https://cloudkms.googleapis.com/v1/"
+	scope           = "https://www.googleapis.com/auth/cloudkms"
+
+	stateEnabled      = "ENABLED"
+	pollInterval      = time.Second
+	defaultMaxPolling = 30
+)
+
+type Config struct {
+	// CryptoKey is the resource name of an asymmetric signing key with an RSA algorithm:
+	// projects/{project}/locations/{location}/keyRings/{keyRing}/cryptoKeys/{cryptoKey}
+	// Every created key is a new version of the crypto key.
+	CryptoKey string
+	// Endpoint overwrites the Cloud KMS API endpoint.
+	Endpoint string
+}
+
+type KeyManager struct {
+	cryptoKey  string
+	endpoint   string
+	httpClient *http.Client
+	maxPolling int
+}
+
+// New returns a KeyManager authenticated with the application default credentials.
+func New(ctx context.Context, config Config) (*KeyManager, error) {
+	if config.CryptoKey == "" {
+		return nil, errors.New("crypto key missing")
+	}
+	client, err := google.DefaultClient(ctx, scope)
+	if err != nil {
+		return nil, err
+	}
+	return NewWithClient(config, client)
+}
+
+// NewWithClient returns a KeyManager which uses the passed, already authenticated client.
+func NewWithClient(config Config, client *http.Client) (*KeyManager, error) {
+	if config.CryptoKey == "" {
+		return nil, errors.New("crypto key missing")
+	}
+	endpoint := config.Endpoint
+	if endpoint == "" {
+		endpoint = DefaultEndpoint
+	}
+	return &KeyManager{
+		cryptoKey:  strings.Trim(config.CryptoKey, "/"),
+		endpoint:   strings.TrimSuffix(endpoint, "/") + "/",
+		httpClient: client,
+		maxPolling: defaultMaxPolling,
+	}, nil
+}
+
+type cryptoKeyVersion struct {
+	Name  string `json:"name"`
+	State string `json:"state"`
+}
+
+// CreateKey creates a new version of the crypto key and waits until it is enabled.
+// The key size is defined by the algorithm of the crypto key, bits is ignored.
+func (m *KeyManager) CreateKey(ctx context.Context, _ int) (string, *rsa.PublicKey, error) {
+	version := new(cryptoKeyVersion)
+	if err := m.do(ctx, http.MethodPost, m.cryptoKey+"/cryptoKeyVersions", struct{}{}, version); err != nil {
+		return "", nil, err
+	}
+	for i := 0; version.State != stateEnabled; i++ {
+		if i >= m.maxPolling {
+			return "", nil, fmt.Errorf("key version %s not enabled, state: %s", version.Name, version.State)
+		}
+		select {
+		case <-ctx.Done():
+			return "", nil, ctx.Err()
+		case <-time.After(pollInterval):
+		}
+		if err := m.do(ctx, http.MethodGet, version.Name, nil, version); err != nil {
+			return "", nil, err
+		}
+	}
+	publicKey, err := m.publicKey(ctx, version.Name)
+	if err != nil {
+		return "", nil, err
+	}
+	return version.Name, publicKey, nil
+}
+
+// Signer returns a signer for the crypto key version referenced by keyRef.
+func (m *KeyManager) Signer(ctx context.Context, keyRef string) (crypto.Signer, error) {
+	publicKey, err := m.publicKey(ctx, keyRef)
+	if err != nil {
+		return nil, err
+	}
+	return &signer{
+		manager:   m,
+		keyRef:    keyRef,
+		publicKey: publicKey,
+	}, nil
+}
+
+type publicKeyResponse struct {
+	PEM string `json:"pem"`
+}
+
+func (m *KeyManager) publicKey(ctx context.Context, keyRef string) (*rsa.PublicKey, error) {
+	resp := new(publicKeyResponse)
+	if err := m.do(ctx, http.MethodGet, keyRef+"/publicKey", nil, resp); err != nil {
+		return nil, err
+	}
+	block, _ := pem.Decode([]byte(resp.PEM))
+	if block == nil {
+		return nil, errors.New("invalid public key")
+	}
+	key, err := x509.ParsePKIXPublicKey(block.Bytes)
+	if err != nil {
+		return nil, err
+	}
+	rsaKey, ok := key.(*rsa.PublicKey)
+	if !ok {
+		return nil, errors.New("public key is not an RSA key")
+	}
+	return rsaKey, nil
+}
+
+func (m *KeyManager) do(ctx context.Context, method, path string, body, result any) error {
+	var reader io.Reader
+	if body != nil {
+		data, err := json.Marshal(body)
+		if err != nil {
+			return err
+		}
+		reader = bytes.NewReader(data)
+	}
+	req, err := http.NewRequestWithContext(ctx, method, m.endpoint+path, reader)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	resp, err := m.httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("cloud kms responded with status %d", resp.StatusCode)
+	}
+	return json.NewDecoder(resp.Body).Decode(result)
+}
+
+type signer struct {
+	manager   *KeyManager
+	keyRef    string
+	publicKey *rsa.PublicKey
+}
+
+func (s *signer) Public() crypto.PublicKey {
+	return s.publicKey
+}
+
+type signRequest struct {
+	Digest map[string]string `json:"digest"`
+}
+
+type signResponse struct {
+	Signature string `json:"signature"`
+}
+
+// Sign creates the signature of the digest in Cloud KMS.
+// The padding is defined by the algorithm of the crypto key.
+func (s *signer) Sign(_ io.Reader, digest []byte, opts crypto.SignerOpts) ([]byte, error) {
+	var digestName string
+	switch opts.HashFunc() {
+	case crypto.SHA256:
+		digestName = "sha256"
+	case crypto.SHA384:
+		digestName = "sha384"
+	case crypto.SHA512:
+		digestName = "sha512"
+	default:
+		return nil, fmt.Errorf("unsupported hash function %v", opts.HashFunc())
+	}
+	resp := new(signResponse)
+	err := s.manager.do(context.Background(), http.MethodPost, s.keyRef+":asymmetricSign", &signRequest{
+		Digest: map[string]string{digestName: base64.StdEncoding.EncodeToString(digest)},
+	}, resp)
+	if err != nil {
+		return nil, err
+	}
+	return base64.StdEncoding.DecodeString(resp.Signature)
+}
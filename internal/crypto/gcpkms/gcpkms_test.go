@@ -0,0 +1,117 @@
+package gcpkms
+
+import (
+	"context"
+	"crypto"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	testCryptoKey = "projects/p/locations/l/keyRings/r/cryptoKeys/k"
+	testVersion   = testCryptoKey + "/cryptoKeyVersions/1"
+)
+
+func testServer(t *testing.T, key *rsa.PrivateKey, state string) *httptest.Server {
+	publicKey, err := x509.MarshalPKIXPublicKey(&key.PublicKey)
+	require.NoError(t, err)
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodPost && r.URL.Path == "/"+testCryptoKey+"/cryptoKeyVersions":
+			json.NewEncoder(w).Encode(&cryptoKeyVersion{Name: testVersion, State: state})
+		case r.Method == http.MethodGet && r.URL.Path == "/"+testVersion:
+			json.NewEncoder(w).Encode(&cryptoKeyVersion{Name: testVersion, State: stateEnabled})
+		case r.Method == http.MethodGet && r.URL.Path == "/"+testVersion+"/publicKey":
+			json.NewEncoder(w).Encode(&publicKeyResponse{
+				PEM: string(pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: publicKey})),
+			})
+		case r.Method == http.MethodPost && r.URL.Path == "/"+testVersion+":asymmetricSign":
+			req := new(signRequest)
+			require.NoError(t, json.NewDecoder(r.Body).Decode(req))
+			digest, err := base64.StdEncoding.DecodeString(req.Digest["sha256"])
+			require.NoError(t, err)
+			signature, err := rsa.SignPKCS1v15(rand.Reader, key, crypto.SHA256, digest)
+			require.NoError(t, err)
+			json.NewEncoder(w).Encode(&signResponse{Signature: base64.StdEncoding.EncodeToString(signature)})
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+}
+
+func TestKeyManager(t *testing.T) {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	tests := []struct {
+		name  string
+		state string
+	}{
+		{
+			name:  "enabled",
+			state: stateEnabled,
+		},
+		{
+			name:  "pending generation",
+			state: "PENDING_GENERATION",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := testServer(t, key, tt.state)
+			defer server.Close()
+			manager, err := NewWithClient(Config{CryptoKey: testCryptoKey, Endpoint: server.URL}, server.Client())
+			require.NoError(t, err)
+
+			keyRef, publicKey, err := manager.CreateKey(context.Background(), 2048)
+			require.NoError(t, err)
+			assert.Equal(t, testVersion, keyRef)
+			assert.Equal(t, &key.PublicKey, publicKey)
+
+			signer, err := manager.Signer(context.Background(), keyRef)
+			require.NoError(t, err)
+			digest := sha256.Sum256([]byte("payload"))
+			signature, err := signer.Sign(rand.Reader, digest[:], crypto.SHA256)
+			require.NoError(t, err)
+			require.NoError(t, rsa.VerifyPKCS1v15(publicKey, crypto.SHA256, digest[:], signature))
+
+			_, err = signer.Sign(rand.Reader, digest[:], crypto.MD5)
+			assert.Error(t, err)
+		})
+	}
+}
+
+func TestKeyManager_notEnabled(t *testing.T) {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	server := testServer(t, key, "PENDING_GENERATION")
+	defer server.Close()
+	manager, err := NewWithClient(Config{CryptoKey: testCryptoKey, Endpoint: server.URL}, server.Client())
+	require.NoError(t, err)
+	manager.maxPolling = 0
+
+	_, _, err = manager.CreateKey(context.Background(), 2048)
+	assert.Error(t, err)
+}
+
+func TestKeyManager_notFound(t *testing.T) {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	server := testServer(t, key, stateEnabled)
+	defer server.Close()
+	manager, err := NewWithClient(Config{CryptoKey: testCryptoKey, Endpoint: server.URL}, server.Client())
+	require.NoError(t, err)
+
+	_, err = manager.Signer(context.Background(), testCryptoKey+"/cryptoKeyVersions/2")
+	assert.Error(t, err)
+}
@@ -0,0 +1,126 @@
+package crypto
+
+import (
+	"context"
+	"slices"
+	"strings"
+
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+const instanceKeyIDPrefix = "instance:"
+
+// InstanceKeyID returns the id of a customer managed key of an instance.
+func InstanceKeyID(instanceID, id string) string {
+	return instanceKeyIDPrefix + instanceID + ":" + id
+}
+
+// InstanceIDFromKeyID returns the id of the instance the customer managed key belongs to.
+func InstanceIDFromKeyID(keyID string) (string, bool) {
+	instanceID, _, found := strings.Cut(strings.TrimPrefix(keyID, instanceKeyIDPrefix), ":")
+	if !found || !strings.HasPrefix(keyID, instanceKeyIDPrefix) || instanceID == "" {
+		return "", false
+	}
+	return instanceID, true
+}
+
+// InstanceKeyProvider resolves the customer managed keys of instances.
+type InstanceKeyProvider interface {
+	// ActiveKey returns the id and value of the active key of the instance in the context.
+	// An empty id is returned if the instance uses the system keys.
+	ActiveKey(ctx context.Context) (id, key string, err error)
+	// Key returns the value of the key with the passed id.
+	Key(ctx context.Context, id string) (string, error)
+}
+
+type contextAlgorithm interface {
+	WithContext(ctx context.Context) EncryptionAlgorithm
+}
+
+// ForContext returns the algorithm to encrypt values of the instance in the context.
+// Algorithms without support for customer managed keys are returned unchanged.
+func ForContext(ctx context.Context, alg EncryptionAlgorithm) EncryptionAlgorithm {
+	if a, ok := alg.(contextAlgorithm); ok {
+		return a.WithContext(ctx)
+	}
+	return alg
+}
+
+type decryptionKeyChecker interface {
+	HasDecryptionKey(keyID string) bool
+}
+
+var _ EncryptionAlgorithm = (*InstanceEncryption)(nil)
+
+// InstanceEncryption encrypts values with the customer managed key of an instance if one is set
+// and falls back to the system key otherwise.
+// Values encrypted with either key can be decrypted.
+type InstanceEncryption struct {
+	EncryptionAlgorithm
+	keys InstanceKeyProvider
+}
+
+func NewInstanceEncryption(alg EncryptionAlgorithm, keys InstanceKeyProvider) *InstanceEncryption {
+	return &InstanceEncryption{
+		EncryptionAlgorithm: alg,
+		keys:                keys,
+	}
+}
+
+// WithContext returns the algorithm which encrypts with the active key of the instance in the context.
+func (e *InstanceEncryption) WithContext(ctx context.Context) EncryptionAlgorithm {
+	keyID, key, err := e.keys.ActiveKey(ctx)
+	if err != nil {
+		return &instanceKeyEncryption{InstanceEncryption: e, err: err}
+	}
+	if keyID == "" {
+		return e
+	}
+	return &instanceKeyEncryption{InstanceEncryption: e, keyID: keyID, key: key}
+}
+
+func (e *InstanceEncryption) HasDecryptionKey(keyID string) bool {
+	if slices.Contains(e.EncryptionAlgorithm.DecryptionKeyIDs(), keyID) {
+		return true
+	}
+	_, ok := InstanceIDFromKeyID(keyID)
+	return ok
+}
+
+func (e *InstanceEncryption) Decrypt(value []byte, keyID string) ([]byte, error) {
+	if _, ok := InstanceIDFromKeyID(keyID); !ok {
+		return e.EncryptionAlgorithm.Decrypt(value, keyID)
+	}
+	key, err := e.keys.Key(context.Background(), keyID)
+	if err != nil {
+		return nil, err
+	}
+	return DecryptAES(value, key)
+}
+
+func (e *InstanceEncryption) DecryptString(value []byte, keyID string) (string, error) {
+	decrypted, err := e.Decrypt(value, keyID)
+	if err != nil {
+		return "", err
+	}
+	return string(decrypted), nil
+}
+
+// instanceKeyEncryption encrypts with the active customer managed key of an instance.
+type instanceKeyEncryption struct {
+	*InstanceEncryption
+	keyID string
+	key   string
+	err   error
+}
+
+func (e *instanceKeyEncryption) EncryptionKeyID() string {
+	return e.keyID
+}
+
+func (e *instanceKeyEncryption) Encrypt(value []byte) ([]byte, error) {
+	if e.err != nil {
+		return nil, zerrors.ThrowInternal(e.err, "CRYPT-Oa3nd", "Errors.Instance.EncryptionKey.Unavailable")
+	}
+	return EncryptAES(value, e.key)
+}
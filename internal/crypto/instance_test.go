@@ -0,0 +1,146 @@
+package crypto
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	testSystemKey   = "systemkeywhichneedstobe32bytes!!"
+	testInstanceKey = "instancekeywhichneedstobe32byte!"
+)
+
+type testInstanceKeys struct {
+	activeKeyID string
+	keys        map[string]string
+	err         error
+}
+
+func (k *testInstanceKeys) ActiveKey(context.Context) (string, string, error) {
+	if k.err != nil {
+		return "", "", k.err
+	}
+	return k.activeKeyID, k.keys[k.activeKeyID], nil
+}
+
+func (k *testInstanceKeys) Key(_ context.Context, id string) (string, error) {
+	key, ok := k.keys[id]
+	if !ok {
+		return "", errors.New("not found")
+	}
+	return key, nil
+}
+
+func testSystemAlg() *AESCrypto {
+	return &AESCrypto{
+		keys:            map[string]string{"system": testSystemKey},
+		encryptionKeyID: "system",
+		keyIDs:          []string{"system"},
+	}
+}
+
+func TestInstanceIDFromKeyID(t *testing.T) {
+	tests := []struct {
+		keyID      string
+		instanceID string
+		ok         bool
+	}{
+		{keyID: InstanceKeyID("instance1", "key1"), instanceID: "instance1", ok: true},
+		{keyID: "idpConfigKey"},
+		{keyID: "instance:"},
+		{keyID: "instance::key1"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.keyID, func(t *testing.T) {
+			instanceID, ok := InstanceIDFromKeyID(tt.keyID)
+			assert.Equal(t, tt.ok, ok)
+			assert.Equal(t, tt.instanceID, instanceID)
+		})
+	}
+}
+
+func TestInstanceEncryption(t *testing.T) {
+	instanceKeyID := InstanceKeyID("instance1", "key1")
+	tests := []struct {
+		name      string
+		keys      *testInstanceKeys
+		wantKeyID string
+		wantErr   bool
+	}{
+		{
+			name:      "system key",
+			keys:      &testInstanceKeys{},
+			wantKeyID: "system",
+		},
+		{
+			name: "instance key",
+			keys: &testInstanceKeys{
+				activeKeyID: instanceKeyID,
+				keys:        map[string]string{instanceKeyID: testInstanceKey},
+			},
+			wantKeyID: instanceKeyID,
+		},
+		{
+			name:    "key unavailable",
+			keys:    &testInstanceKeys{err: errors.New("kms unavailable")},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			alg := NewInstanceEncryption(testSystemAlg(), tt.keys)
+			encrypted, err := Encrypt([]byte("secret"), ForContext(context.Background(), alg))
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantKeyID, encrypted.KeyID)
+
+			decrypted, err := DecryptString(encrypted, alg)
+			require.NoError(t, err)
+			assert.Equal(t, "secret", decrypted)
+		})
+	}
+}
+
+func TestForContext(t *testing.T) {
+	alg := &mockEncCrypto{}
+	assert.Same(t, alg, ForContext(context.Background(), alg))
+}
+
+func TestLocalKMS(t *testing.T) {
+	kms := NewLocalKMS(&testKeyStorage{keys: Keys{"kek": testSystemKey}})
+	wrapped, err := kms.Encrypt(context.Background(), "kek", []byte(testInstanceKey))
+	require.NoError(t, err)
+	unwrapped, err := kms.Decrypt(context.Background(), "kek", wrapped)
+	require.NoError(t, err)
+	assert.Equal(t, testInstanceKey, string(unwrapped))
+
+	_, err = kms.Encrypt(context.Background(), "unknown", []byte(testInstanceKey))
+	assert.Error(t, err)
+}
+
+type testKeyStorage struct {
+	keys Keys
+}
+
+func (s *testKeyStorage) ReadKeys() (Keys, error) {
+	return s.keys, nil
+}
+
+func (s *testKeyStorage) ReadKey(id string) (*Key, error) {
+	key, ok := s.keys[id]
+	if !ok {
+		return nil, errors.New("not found")
+	}
+	return &Key{ID: id, Value: key}, nil
+}
+
+func (s *testKeyStorage) CreateKeys(context.Context, ...*Key) error {
+	return nil
+}
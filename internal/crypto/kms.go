@@ -0,0 +1,56 @@
+package crypto
+
+import (
+	"context"
+
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+const KMSProviderLocal = "local"
+
+// KeyManagementService wraps and unwraps data encryption keys
+// with a key encryption key which never leaves the external key management service.
+// The keyURI identifies the key encryption key inside the service.
+type KeyManagementService interface {
+	Encrypt(ctx context.Context, keyURI string, plaintext []byte) ([]byte, error)
+	Decrypt(ctx context.Context, keyURI string, ciphertext []byte) ([]byte, error)
+}
+
+// KMSProviders maps the name of a provider to its [KeyManagementService].
+type KMSProviders map[string]KeyManagementService
+
+func (p KMSProviders) Get(provider string) (KeyManagementService, error) {
+	kms, ok := p[provider]
+	if !ok {
+		return nil, zerrors.ThrowInvalidArgument(nil, "CRYPT-Mk3la", "Errors.Instance.EncryptionKey.ProviderNotFound")
+	}
+	return kms, nil
+}
+
+var _ KeyManagementService = (*LocalKMS)(nil)
+
+// LocalKMS uses a key of the [KeyStorage] as key encryption key.
+// The keyURI is the id of the key.
+type LocalKMS struct {
+	keyStorage KeyStorage
+}
+
+func NewLocalKMS(keyStorage KeyStorage) *LocalKMS {
+	return &LocalKMS{keyStorage: keyStorage}
+}
+
+func (l *LocalKMS) Encrypt(_ context.Context, keyURI string, plaintext []byte) ([]byte, error) {
+	key, err := LoadKey(keyURI, l.keyStorage)
+	if err != nil {
+		return nil, err
+	}
+	return EncryptAES(plaintext, key)
+}
+
+func (l *LocalKMS) Decrypt(_ context.Context, keyURI string, ciphertext []byte) ([]byte, error) {
+	key, err := LoadKey(keyURI, l.keyStorage)
+	if err != nil {
+		return nil, err
+	}
+	return DecryptAES(ciphertext, key)
+}
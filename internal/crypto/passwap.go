@@ -51,6 +51,7 @@ const (
 	HashNameMd5Plain HashName = "md5plain" // verify only, as hashing with md5 is insecure and deprecated
 	HashNameScrypt   HashName = "scrypt"   // hash and verify
 	HashNamePBKDF2   HashName = "pbkdf2"   // hash and verify
+	HashNameSSHA     HashName = "ssha"     // verify only, as hashing with sha1 is insecure
 )
 
 type HashMode string
@@ -70,6 +71,12 @@ type HashConfig struct {
 	Hasher    HasherConfig
 }
 
+// defaultHashName is the algorithm ZITADEL hashes with, if not configured otherwise.
+// Hashes of the default algorithm can always be verified,
+// so they are transparently re-hashed on the next successful verification
+// after the Hasher is changed to another algorithm like argon2id or scrypt.
+const defaultHashName = HashNameBcrypt
+
 func (c *HashConfig) NewHasher() (*Hasher, error) {
 	verifiers, vPrefixes, err := c.buildVerifiers()
 	if err != nil {
@@ -119,12 +126,36 @@ var knowVerifiers = map[HashName]prefixVerifier{
 		prefixes: []string{pbkdf2.Prefix},
 		verifier: pbkdf2.Verifier,
 	},
+	HashNameSSHA: {
+		prefixes: []string{sshaPrefix},
+		verifier: sshaVerifier,
+	},
+}
+
+// EncodedHashName returns the name of the algorithm an encoded hash was created with.
+// The parameters of the algorithm are part of the encoded hash.
+func EncodedHashName(encodedHash string) (HashName, bool) {
+	for _, name := range []HashName{HashNameArgon2, HashNameBcrypt, HashNameMd5, HashNameScrypt, HashNamePBKDF2, HashNameSSHA} {
+		for _, prefix := range knowVerifiers[name].prefixes {
+			if strings.HasPrefix(encodedHash, prefix) {
+				return name, true
+			}
+		}
+	}
+	if _, err := hex.DecodeString(encodedHash); encodedHash != "" && err == nil {
+		return HashNameMd5Plain, true
+	}
+	return "", false
 }
 
 func (c *HashConfig) buildVerifiers() (verifiers []verifier.Verifier, prefixes []string, err error) {
-	verifiers = make([]verifier.Verifier, len(c.Verifiers))
-	prefixes = make([]string, 0, len(c.Verifiers)+1)
-	for i, name := range c.Verifiers {
+	names := c.Verifiers
+	if c.Hasher.Algorithm != defaultHashName && !slices.Contains(names, defaultHashName) {
+		names = append(slices.Clone(names), defaultHashName)
+	}
+	verifiers = make([]verifier.Verifier, len(names))
+	prefixes = make([]string, 0, len(names)+1)
+	for i, name := range names {
 		v, ok := knowVerifiers[name]
 		if !ok {
 			return nil, nil, fmt.Errorf("invalid verifier %q", name)
@@ -154,7 +185,7 @@ func (c *HasherConfig) buildHasher() (hasher passwap.Hasher, prefixes []string,
 		return c.pbkdf2()
 	case "":
 		return nil, nil, fmt.Errorf("missing hasher algorithm")
-	case HashNameArgon2, HashNameMd5:
+	case HashNameArgon2, HashNameMd5, HashNameSSHA:
 		fallthrough
 	default:
 		return nil, nil, fmt.Errorf("invalid algorithm %q", c.Algorithm)
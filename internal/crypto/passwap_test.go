@@ -4,6 +4,7 @@ import (
 	"crypto/sha1"
 	"crypto/sha256"
 	"crypto/sha512"
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -192,6 +193,20 @@ func TestPasswordHashConfig_PasswordHasher(t *testing.T) {
 			},
 			wantPrefixes: []string{argon2.Prefix, bcrypt.Prefix, md5.Prefix, scrypt.Prefix, scrypt.Prefix_Linux},
 		},
+		{
+			name: "argon2id, default verifier",
+			fields: fields{
+				Hasher: HasherConfig{
+					Algorithm: HashNameArgon2id,
+					Params: map[string]any{
+						"time":    3,
+						"memory":  32768,
+						"threads": 4,
+					},
+				},
+			},
+			wantPrefixes: []string{argon2.Prefix, bcrypt.Prefix},
+		},
 		{
 			name: "bcrypt, error",
 			fields: fields{
@@ -229,6 +244,18 @@ func TestPasswordHashConfig_PasswordHasher(t *testing.T) {
 			},
 			wantErr: true,
 		},
+		{
+			name: "scrypt, default verifier",
+			fields: fields{
+				Hasher: HasherConfig{
+					Algorithm: HashNameScrypt,
+					Params: map[string]any{
+						"cost": 3,
+					},
+				},
+			},
+			wantPrefixes: []string{scrypt.Prefix, scrypt.Prefix_Linux, bcrypt.Prefix},
+		},
 		{
 			name: "scrypt, ok",
 			fields: fields{
@@ -361,6 +388,58 @@ func TestPasswordHashConfig_PasswordHasher(t *testing.T) {
 	}
 }
 
+func TestHasher_VerifyDefaultMigration(t *testing.T) {
+	legacy, err := bcrypt.New(bcrypt.MinCost).Hash("password")
+	require.NoError(t, err)
+
+	tests := []struct {
+		name       string
+		hasher     HasherConfig
+		wantPrefix string
+	}{
+		{
+			name: "argon2id",
+			hasher: HasherConfig{
+				Algorithm: HashNameArgon2id,
+				Params: map[string]any{
+					"time":    1,
+					"memory":  1024,
+					"threads": 1,
+				},
+			},
+			wantPrefix: "$argon2id$",
+		},
+		{
+			name: "scrypt",
+			hasher: HasherConfig{
+				Algorithm: HashNameScrypt,
+				Params: map[string]any{
+					"cost": 4,
+				},
+			},
+			wantPrefix: scrypt.Prefix,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &HashConfig{
+				Hasher: tt.hasher,
+			}
+			hasher, err := c.NewHasher()
+			require.NoError(t, err)
+
+			updated, err := hasher.Verify(legacy, "password")
+			require.NoError(t, err)
+			assert.True(t, strings.HasPrefix(updated, tt.wantPrefix), "hash not migrated: %s", updated)
+
+			// the migrated hash must not be updated again
+			updated, err = hasher.Verify(updated, "password")
+			require.NoError(t, err)
+			assert.Empty(t, updated)
+		})
+	}
+}
+
 func TestHasherConfig_decodeParams(t *testing.T) {
 	type dst struct {
 		A int
@@ -703,3 +782,64 @@ func TestHasherConfig_pbkdf2Params(t *testing.T) {
 		})
 	}
 }
+
+func TestEncodedHashName(t *testing.T) {
+	tests := []struct {
+		name        string
+		encodedHash string
+		want        HashName
+		wantOK      bool
+	}{
+		{
+			name:        "empty",
+			encodedHash: "",
+		},
+		{
+			name:        "unknown",
+			encodedHash: "$unknown$hash",
+		},
+		{
+			name:        "bcrypt",
+			encodedHash: "$2y$12$hXUrnqdq1RIIYZ2HPytIIe5lXdIvbhqrTvdPsSF7o.jFh817Z6lwm",
+			want:        HashNameBcrypt,
+			wantOK:      true,
+		},
+		{
+			name:        "argon2id",
+			encodedHash: "$argon2id$v=19$m=4096,t=3,p=1$cmFuZG9tc2FsdGlzaGFyZA$YMvo8AUoNtnKYGqeODruCjHdiEbl1pKL2MsYy9VgU/E",
+			want:        HashNameArgon2,
+			wantOK:      true,
+		},
+		{
+			name:        "scrypt",
+			encodedHash: "$scrypt$ln=16,r=8,p=1$cmFuZG9tc2FsdGlzaGFyZA$Rh+NnJNo1I6nRwaNqbDm6kmADswD1+7FTKZ7Ln9D8nQ",
+			want:        HashNameScrypt,
+			wantOK:      true,
+		},
+		{
+			name:        "pbkdf2",
+			encodedHash: "$pbkdf2-sha256$12$cmFuZG9tc2FsdGlzaGFyZA$9xUqBDkmRHrcdHk6SjYpNFQkQp1KyE2HJyQCd4xyzG8",
+			want:        HashNamePBKDF2,
+			wantOK:      true,
+		},
+		{
+			name:        "ssha",
+			encodedHash: "{SSHA}yrht1iYXEIkejLVu42JWkadd80RzYWx0c2FsdA==",
+			want:        HashNameSSHA,
+			wantOK:      true,
+		},
+		{
+			name:        "md5plain",
+			encodedHash: "5f4dcc3b5aa765d61d8327deb882cf99",
+			want:        HashNameMd5Plain,
+			wantOK:      true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := EncodedHashName(tt.encodedHash)
+			assert.Equal(t, tt.want, got)
+			assert.Equal(t, tt.wantOK, ok)
+		})
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"crypto/rand"
 	"crypto/rsa"
+	"crypto/sha256"
 	"crypto/x509"
 	"crypto/x509/pkix"
 	"encoding/pem"
@@ -189,6 +190,12 @@ func BytesToPublicKey(pub []byte) (*rsa.PublicKey, error) {
 	return key, nil
 }
 
+// EncryptWithPublicKey encrypts data with RSA-OAEP and SHA-256,
+// so it can only be decrypted by the holder of the private key.
+func EncryptWithPublicKey(publicKey *rsa.PublicKey, data []byte) ([]byte, error) {
+	return rsa.EncryptOAEP(sha256.New(), rand.Reader, publicKey, data, nil)
+}
+
 func EncryptKeys(privateKey *rsa.PrivateKey, publicKey *rsa.PublicKey, alg EncryptionAlgorithm) (*CryptoValue, *CryptoValue, error) {
 	encryptedPrivateKey, err := Encrypt(PrivateKeyToBytes(privateKey), alg)
 	if err != nil {
@@ -0,0 +1,172 @@
+package crypto
+
+import (
+	"context"
+	stdcrypto "crypto"
+	"crypto/rsa"
+	"fmt"
+
+	"github.com/mitchellh/mapstructure"
+
+	"github.com/zitadel/zitadel/internal/crypto/awskms"
+	"github.com/zitadel/zitadel/internal/crypto/azurekeyvault"
+	"github.com/zitadel/zitadel/internal/crypto/gcpkms"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+const (
+	SigningKeyProviderDatabase      = "database"
+	SigningKeyProviderGCPKMS        = "gcpkms"
+	SigningKeyProviderAWSKMS        = "awskms"
+	SigningKeyProviderAzureKeyVault = "azurekeyvault"
+)
+
+// SigningKeyProvider generates the key pairs used to sign tokens
+// and provides the signers for their private keys.
+type SigningKeyProvider interface {
+	// GenerateKeyPair returns the private key, either encrypted or as reference to an external key, and its public key.
+	GenerateKeyPair(ctx context.Context, bits int) (privateKey *CryptoValue, publicKey *rsa.PublicKey, err error)
+	// Signer returns the signer for a private key returned by GenerateKeyPair.
+	Signer(ctx context.Context, privateKey *CryptoValue) (stdcrypto.Signer, error)
+}
+
+// KeyManager creates and uses asymmetric keys which never leave an HSM or cloud KMS.
+type KeyManager interface {
+	// CreateKey creates a new key and returns its reference in the key manager.
+	CreateKey(ctx context.Context, bits int) (keyRef string, publicKey *rsa.PublicKey, err error)
+	Signer(ctx context.Context, keyRef string) (stdcrypto.Signer, error)
+}
+
+// KeyManagerFactory creates a [KeyManager] from the params of the [SigningKeyProviderConfig].
+type KeyManagerFactory func(ctx context.Context, params map[string]any) (KeyManager, error)
+
+var keyManagers = map[string]KeyManagerFactory{
+	SigningKeyProviderGCPKMS:        newGCPKeyManager,
+	SigningKeyProviderAWSKMS:        newAWSKeyManager,
+	SigningKeyProviderAzureKeyVault: newAzureKeyManager,
+}
+
+// RegisterKeyManager makes a [KeyManager] selectable by its name in the [SigningKeyProviderConfig].
+func RegisterKeyManager(name string, factory KeyManagerFactory) {
+	keyManagers[name] = factory
+}
+
+type SigningKeyProviderConfig struct {
+	Type   string
+	Params map[string]any `mapstructure:",remain"`
+}
+
+// NewProvider returns the configured provider.
+// Private keys stored encrypted in the database are always supported,
+// so keys generated before switching to an external key manager can still be used.
+func (c *SigningKeyProviderConfig) NewProvider(ctx context.Context, alg EncryptionAlgorithm) (SigningKeyProvider, error) {
+	database := NewDatabaseSigningKeyProvider(alg)
+	if c == nil || c.Type == "" || c.Type == SigningKeyProviderDatabase {
+		return database, nil
+	}
+	factory, ok := keyManagers[c.Type]
+	if !ok {
+		return nil, fmt.Errorf("invalid signing key provider %q", c.Type)
+	}
+	manager, err := factory(ctx, c.Params)
+	if err != nil {
+		return nil, fmt.Errorf("signing key provider %q: %w", c.Type, err)
+	}
+	return &externalSigningKeyProvider{
+		DatabaseSigningKeyProvider: database,
+		name:                       c.Type,
+		manager:                    manager,
+	}, nil
+}
+
+var _ SigningKeyProvider = (*DatabaseSigningKeyProvider)(nil)
+
+// DatabaseSigningKeyProvider generates private keys in memory and stores them encrypted.
+type DatabaseSigningKeyProvider struct {
+	alg EncryptionAlgorithm
+}
+
+func NewDatabaseSigningKeyProvider(alg EncryptionAlgorithm) *DatabaseSigningKeyProvider {
+	return &DatabaseSigningKeyProvider{alg: alg}
+}
+
+func (p *DatabaseSigningKeyProvider) GenerateKeyPair(_ context.Context, bits int) (*CryptoValue, *rsa.PublicKey, error) {
+	privateKey, publicKey, err := GenerateKeyPair(bits)
+	if err != nil {
+		return nil, nil, err
+	}
+	encryptedPrivateKey, err := Encrypt(PrivateKeyToBytes(privateKey), p.alg)
+	if err != nil {
+		return nil, nil, err
+	}
+	return encryptedPrivateKey, publicKey, nil
+}
+
+func (p *DatabaseSigningKeyProvider) Signer(_ context.Context, privateKey *CryptoValue) (stdcrypto.Signer, error) {
+	if privateKey == nil || privateKey.CryptoType != TypeEncryption {
+		return nil, zerrors.ThrowInternal(nil, "CRYPT-Jd2sk", "Errors.Key.ProviderNotSupported")
+	}
+	keyData, err := Decrypt(privateKey, p.alg)
+	if err != nil {
+		return nil, err
+	}
+	return BytesToPrivateKey(keyData)
+}
+
+// externalSigningKeyProvider generates private keys in a [KeyManager].
+// Only a reference to the key is stored.
+type externalSigningKeyProvider struct {
+	*DatabaseSigningKeyProvider
+	name    string
+	manager KeyManager
+}
+
+func (p *externalSigningKeyProvider) GenerateKeyPair(ctx context.Context, bits int) (*CryptoValue, *rsa.PublicKey, error) {
+	keyRef, publicKey, err := p.manager.CreateKey(ctx, bits)
+	if err != nil {
+		return nil, nil, zerrors.ThrowInternal(err, "CRYPT-Bv2ql", "Errors.Key.ProviderUnavailable")
+	}
+	return &CryptoValue{
+		CryptoType: TypeReference,
+		Algorithm:  p.name,
+		KeyID:      keyRef,
+	}, publicKey, nil
+}
+
+func (p *externalSigningKeyProvider) Signer(ctx context.Context, privateKey *CryptoValue) (stdcrypto.Signer, error) {
+	if privateKey == nil || privateKey.CryptoType != TypeReference {
+		return p.DatabaseSigningKeyProvider.Signer(ctx, privateKey)
+	}
+	if privateKey.Algorithm != p.name {
+		return nil, zerrors.ThrowInternal(nil, "CRYPT-Wm3ps", "Errors.Key.ProviderNotSupported")
+	}
+	signer, err := p.manager.Signer(ctx, privateKey.KeyID)
+	if err != nil {
+		return nil, zerrors.ThrowInternal(err, "CRYPT-Ue8sw", "Errors.Key.ProviderUnavailable")
+	}
+	return signer, nil
+}
+
+func newGCPKeyManager(ctx context.Context, params map[string]any) (KeyManager, error) {
+	var config gcpkms.Config
+	if err := mapstructure.Decode(params, &config); err != nil {
+		return nil, err
+	}
+	return gcpkms.New(ctx, config)
+}
+
+func newAWSKeyManager(_ context.Context, params map[string]any) (KeyManager, error) {
+	var config awskms.Config
+	if err := mapstructure.Decode(params, &config); err != nil {
+		return nil, err
+	}
+	return awskms.New(config)
+}
+
+func newAzureKeyManager(ctx context.Context, params map[string]any) (KeyManager, error) {
+	var config azurekeyvault.Config
+	if err := mapstructure.Decode(params, &config); err != nil {
+		return nil, err
+	}
+	return azurekeyvault.New(ctx, config)
+}
@@ -0,0 +1,168 @@
+package crypto
+
+import (
+	"context"
+	stdcrypto "crypto"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+type testKeyManager struct {
+	keys map[string]*rsa.PrivateKey
+	err  error
+}
+
+func (m *testKeyManager) CreateKey(_ context.Context, bits int) (string, *rsa.PublicKey, error) {
+	if m.err != nil {
+		return "", nil, m.err
+	}
+	key, err := rsa.GenerateKey(rand.Reader, bits)
+	if err != nil {
+		return "", nil, err
+	}
+	m.keys["key1"] = key
+	return "key1", &key.PublicKey, nil
+}
+
+func (m *testKeyManager) Signer(_ context.Context, keyRef string) (stdcrypto.Signer, error) {
+	key, ok := m.keys[keyRef]
+	if !ok {
+		return nil, errors.New("not found")
+	}
+	return key, nil
+}
+
+func TestSigningKeyProviderConfig_NewProvider(t *testing.T) {
+	RegisterKeyManager("test", func(context.Context, map[string]any) (KeyManager, error) {
+		return &testKeyManager{}, nil
+	})
+	tests := []struct {
+		name         string
+		config       *SigningKeyProviderConfig
+		wantExternal bool
+		wantErr      bool
+	}{
+		{
+			name: "nil config",
+		},
+		{
+			name:   "database",
+			config: &SigningKeyProviderConfig{Type: SigningKeyProviderDatabase},
+		},
+		{
+			name:         "key manager",
+			config:       &SigningKeyProviderConfig{Type: "test"},
+			wantExternal: true,
+		},
+		{
+			name:    "unknown type",
+			config:  &SigningKeyProviderConfig{Type: "unknown"},
+			wantErr: true,
+		},
+		{
+			name:    "gcpkms without crypto key",
+			config:  &SigningKeyProviderConfig{Type: SigningKeyProviderGCPKMS},
+			wantErr: true,
+		},
+		{
+			name: "awskms",
+			config: &SigningKeyProviderConfig{Type: SigningKeyProviderAWSKMS, Params: map[string]any{
+				"Region":          "eu-central-1",
+				"AccessKeyID":     "AKID",
+				"SecretAccessKey": "secret",
+			}},
+			wantExternal: true,
+		},
+		{
+			name:    "awskms without region",
+			config:  &SigningKeyProviderConfig{Type: SigningKeyProviderAWSKMS},
+			wantErr: true,
+		},
+		{
+			name: "azurekeyvault",
+			config: &SigningKeyProviderConfig{Type: SigningKeyProviderAzureKeyVault, Params: map[string]any{
+				"VaultURL":     "https://vault.vault.azure.net",
+				"KeyName":      "zitadel",
+				"TenantID":     "tenant",
+				"ClientID":     "client",
+				"ClientSecret": "secret",
+			}},
+			wantExternal: true,
+		},
+		{
+			name:    "azurekeyvault without credentials",
+			config:  &SigningKeyProviderConfig{Type: SigningKeyProviderAzureKeyVault},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			provider, err := tt.config.NewProvider(context.Background(), testSystemAlg())
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			_, external := provider.(*externalSigningKeyProvider)
+			assert.Equal(t, tt.wantExternal, external)
+		})
+	}
+}
+
+func TestDatabaseSigningKeyProvider(t *testing.T) {
+	provider := NewDatabaseSigningKeyProvider(testSystemAlg())
+	privateKey, publicKey, err := provider.GenerateKeyPair(context.Background(), 2048)
+	require.NoError(t, err)
+	assert.Equal(t, TypeEncryption, privateKey.CryptoType)
+	assert.Equal(t, "system", privateKey.KeyID)
+
+	signer, err := provider.Signer(context.Background(), privateKey)
+	require.NoError(t, err)
+	assert.Equal(t, publicKey, signer.Public())
+
+	_, err = provider.Signer(context.Background(), &CryptoValue{CryptoType: TypeReference, Algorithm: "test", KeyID: "key1"})
+	assert.True(t, zerrors.IsInternal(err))
+}
+
+func TestExternalSigningKeyProvider(t *testing.T) {
+	database := NewDatabaseSigningKeyProvider(testSystemAlg())
+	provider := &externalSigningKeyProvider{
+		DatabaseSigningKeyProvider: database,
+		name:                       "test",
+		manager:                    &testKeyManager{keys: make(map[string]*rsa.PrivateKey)},
+	}
+	privateKey, publicKey, err := provider.GenerateKeyPair(context.Background(), 2048)
+	require.NoError(t, err)
+	assert.Equal(t, &CryptoValue{CryptoType: TypeReference, Algorithm: "test", KeyID: "key1"}, privateKey)
+
+	signer, err := provider.Signer(context.Background(), privateKey)
+	require.NoError(t, err)
+	digest := sha256.Sum256([]byte("payload"))
+	signature, err := signer.Sign(rand.Reader, digest[:], stdcrypto.SHA256)
+	require.NoError(t, err)
+	require.NoError(t, rsa.VerifyPKCS1v15(publicKey, stdcrypto.SHA256, digest[:], signature))
+
+	// keys stored in the database before switching the provider are still usable
+	databaseKey, databasePublicKey, err := database.GenerateKeyPair(context.Background(), 2048)
+	require.NoError(t, err)
+	signer, err = provider.Signer(context.Background(), databaseKey)
+	require.NoError(t, err)
+	assert.Equal(t, databasePublicKey, signer.Public())
+
+	_, err = provider.Signer(context.Background(), &CryptoValue{CryptoType: TypeReference, Algorithm: "other", KeyID: "key1"})
+	assert.True(t, zerrors.IsInternal(err))
+	_, err = provider.Signer(context.Background(), &CryptoValue{CryptoType: TypeReference, Algorithm: "test", KeyID: "unknown"})
+	assert.True(t, zerrors.IsInternal(err))
+
+	provider.manager = &testKeyManager{err: errors.New("unavailable")}
+	_, _, err = provider.GenerateKeyPair(context.Background(), 2048)
+	assert.True(t, zerrors.IsInternal(err))
+}
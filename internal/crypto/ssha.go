@@ -0,0 +1,34 @@
+package crypto
+
+import (
+	"crypto/sha1"
+	"crypto/subtle"
+	"encoding/base64"
+	"strings"
+
+	"github.com/zitadel/passwap/verifier"
+)
+
+// sshaPrefix identifies salted SHA-1 hashes as exported from LDAP directories (RFC 2307).
+const sshaPrefix = "{SSHA}"
+
+// sshaVerifier verifies LDAP {SSHA} hashes.
+// The encoded value is the base64 encoded SHA-1 digest of password and salt, followed by the salt.
+// It is verify only, as hashing with sha1 is insecure.
+var sshaVerifier = verifier.VerifyFunc(func(encoded, password string) (verifier.Result, error) {
+	if !strings.HasPrefix(encoded, sshaPrefix) {
+		return verifier.Skip, nil
+	}
+	decoded, err := base64.StdEncoding.DecodeString(strings.TrimPrefix(encoded, sshaPrefix))
+	if err != nil || len(decoded) <= sha1.Size {
+		return verifier.Skip, nil
+	}
+	digest, salt := decoded[:sha1.Size], decoded[sha1.Size:]
+	hash := sha1.New()
+	hash.Write([]byte(password))
+	hash.Write(salt)
+	if subtle.ConstantTimeCompare(digest, hash.Sum(nil)) != 1 {
+		return verifier.Fail, nil
+	}
+	return verifier.OK, nil
+})
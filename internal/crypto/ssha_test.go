@@ -0,0 +1,78 @@
+package crypto
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/zitadel/passwap/verifier"
+)
+
+func Test_sshaVerifier(t *testing.T) {
+	// salted sha1 of "password" with the salt "saltsalt"
+	const encoded = "{SSHA}yrht1iYXEIkejLVu42JWkadd80RzYWx0c2FsdA=="
+
+	tests := []struct {
+		name     string
+		encoded  string
+		password string
+		want     verifier.Result
+	}{
+		{
+			name:     "other prefix, skip",
+			encoded:  "$2y$12$hXUrnqdq1RIIYZ2HPytIIe5lXdIvbhqrTvdPsSF7o.jFh817Z6lwm",
+			password: "password",
+			want:     verifier.Skip,
+		},
+		{
+			name:     "invalid encoding, skip",
+			encoded:  "{SSHA}!!!",
+			password: "password",
+			want:     verifier.Skip,
+		},
+		{
+			name:     "missing salt, skip",
+			encoded:  "{SSHA}W6ph5Mm5Pz8GgiULbPgzG37mj9g=",
+			password: "password",
+			want:     verifier.Skip,
+		},
+		{
+			name:     "wrong password, fail",
+			encoded:  encoded,
+			password: "wrong",
+			want:     verifier.Fail,
+		},
+		{
+			name:     "ok",
+			encoded:  encoded,
+			password: "password",
+			want:     verifier.OK,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := sshaVerifier.Verify(tt.encoded, tt.password)
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestHasher_VerifySSHA(t *testing.T) {
+	c := &HashConfig{
+		Verifiers: []HashName{HashNameSSHA},
+		Hasher: HasherConfig{
+			Algorithm: HashNameBcrypt,
+			Params: map[string]any{
+				"cost": 4,
+			},
+		},
+	}
+	hasher, err := c.NewHasher()
+	require.NoError(t, err)
+	assert.True(t, hasher.EncodingSupported("{SSHA}yrht1iYXEIkejLVu42JWkadd80RzYWx0c2FsdA=="))
+
+	updated, err := hasher.Verify("{SSHA}yrht1iYXEIkejLVu42JWkadd80RzYWx0c2FsdA==", "password")
+	require.NoError(t, err)
+	assert.NotEmpty(t, updated, "ssha hash must be re-hashed")
+}
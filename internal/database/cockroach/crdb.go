@@ -108,6 +108,12 @@ func (c *Config) Timetravel(d time.Duration) string {
 	return ""
 }
 
+// ReplicationLagQuery selects the staleness of follower reads,
+// writes are replicated synchronously across the regions of a cluster
+func (c *Config) ReplicationLagQuery() string {
+	return "SELECT EXTRACT(EPOCH FROM statement_timestamp() - follower_read_timestamp())"
+}
+
 type User struct {
 	Username string
 	Password string
@@ -7,6 +7,7 @@ import (
 	"errors"
 	"reflect"
 	"strings"
+	"time"
 
 	"github.com/mitchellh/mapstructure"
 	"github.com/zitadel/logging"
@@ -22,7 +23,10 @@ type Config struct {
 	Dialects                   map[string]interface{} `mapstructure:",remain"`
 	EventPushConnRatio         float64
 	ProjectionSpoolerConnRatio float64
-	connector                  dialect.Connector
+	// MaxReplicationLag is the maximum replication lag of the connected database node until ZITADEL reports not ready.
+	// 0 disables the check.
+	MaxReplicationLag time.Duration
+	connector         dialect.Connector
 }
 
 func (c *Config) SetConnector(connector dialect.Connector) {
@@ -32,6 +36,7 @@ func (c *Config) SetConnector(connector dialect.Connector) {
 type DB struct {
 	*sql.DB
 	dialect.Database
+	maxReplicationLag time.Duration
 }
 
 func (db *DB) Query(scan func(*sql.Rows) error, query string, args ...any) error {
@@ -128,18 +133,53 @@ func Connect(config Config, useAdmin bool, purpose dialect.DBPurpose) (*DB, erro
 	}
 
 	return &DB{
-		DB:       client,
-		Database: config.connector,
+		DB:                client,
+		Database:          config.connector,
+		maxReplicationLag: config.MaxReplicationLag,
 	}, nil
 }
 
+// ReplicationLag returns the time the connected database node is behind the writes of the other regions
+func (db *DB) ReplicationLag(ctx context.Context) (lag time.Duration, err error) {
+	var seconds float64
+	err = db.QueryRowContext(ctx, func(row *sql.Row) error {
+		return row.Scan(&seconds)
+	}, db.ReplicationLagQuery())
+	if err != nil {
+		return 0, zerrors.ThrowInternal(err, "DATAB-Rk4vq8Ln2x", "Errors.Internal")
+	}
+	return time.Duration(seconds * float64(time.Second)), nil
+}
+
+// CheckReplicationLag returns an error if the replication lag exceeds the configured MaxReplicationLag
+func (db *DB) CheckReplicationLag(ctx context.Context) error {
+	if db.maxReplicationLag == 0 {
+		return nil
+	}
+	lag, err := db.ReplicationLag(ctx)
+	if err != nil {
+		return err
+	}
+	if lag > db.maxReplicationLag {
+		return zerrors.ThrowUnavailablef(nil, "DATAB-Wm7xt3Qv5n", "replication lag of %s exceeds %s", lag, db.maxReplicationLag)
+	}
+	return nil
+}
+
 func DecodeHook(from, to reflect.Value) (_ interface{}, err error) {
 	if to.Type() != reflect.TypeOf(Config{}) {
 		return from.Interface(), nil
 	}
 
 	config := new(Config)
-	if err = mapstructure.Decode(from.Interface(), config); err != nil {
+	decoder, err := mapstructure.NewDecoder(&mapstructure.DecoderConfig{
+		DecodeHook: mapstructure.StringToTimeDurationHookFunc(),
+		Result:     config,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if err = decoder.Decode(from.Interface()); err != nil {
 		return nil, err
 	}
 
@@ -5,11 +5,13 @@ import (
 	"database/sql"
 	"database/sql/driver"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
 	"github.com/zitadel/zitadel/internal/database/mock"
+	"github.com/zitadel/zitadel/internal/database/postgres"
 	"github.com/zitadel/zitadel/internal/zerrors"
 )
 
@@ -90,3 +92,72 @@ func TestQueryJSONObject(t *testing.T) {
 		})
 	}
 }
+
+func TestDB_CheckReplicationLag(t *testing.T) {
+	const query = "SELECT CASE WHEN pg_is_in_recovery() THEN COALESCE(EXTRACT(EPOCH FROM now() - pg_last_xact_replay_timestamp()), 0) ELSE 0 END"
+
+	tests := []struct {
+		name              string
+		mock              func(*testing.T) *mock.SQLMock
+		maxReplicationLag time.Duration
+		wantErr           func(error) bool
+	}{
+		{
+			name: "disabled",
+			mock: func(t *testing.T) *mock.SQLMock {
+				return mock.NewSQLMock(t)
+			},
+		},
+		{
+			name: "query error",
+			mock: func(t *testing.T) *mock.SQLMock {
+				return mock.NewSQLMock(t,
+					mock.ExpectBegin(nil),
+					mock.ExpectQuery(query, mock.WithQueryErr(sql.ErrConnDone)),
+				)
+			},
+			maxReplicationLag: time.Second,
+			wantErr:           zerrors.IsInternal,
+		},
+		{
+			name: "lag exceeded",
+			mock: func(t *testing.T) *mock.SQLMock {
+				return mock.NewSQLMock(t,
+					mock.ExpectBegin(nil),
+					mock.ExpectQuery(query, mock.WithQueryResult([]string{"lag"}, [][]driver.Value{{2.5}})),
+					mock.ExpectCommit(nil),
+				)
+			},
+			maxReplicationLag: time.Second,
+			wantErr:           zerrors.IsUnavailable,
+		},
+		{
+			name: "lag within limit",
+			mock: func(t *testing.T) *mock.SQLMock {
+				return mock.NewSQLMock(t,
+					mock.ExpectBegin(nil),
+					mock.ExpectQuery(query, mock.WithQueryResult([]string{"lag"}, [][]driver.Value{{0.5}})),
+					mock.ExpectCommit(nil),
+				)
+			},
+			maxReplicationLag: time.Second,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mock := tt.mock(t)
+			defer mock.Assert(t)
+			db := &DB{
+				DB:                mock.DB,
+				Database:          new(postgres.Config),
+				maxReplicationLag: tt.maxReplicationLag,
+			}
+			err := db.CheckReplicationLag(context.Background())
+			if tt.wantErr != nil {
+				assert.True(t, tt.wantErr(err), "unexpected error: %v", err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
@@ -63,6 +63,8 @@ type Database interface {
 	Username() string
 	Type() string
 	Timetravel(time.Duration) string
+	// ReplicationLagQuery returns a statement which selects the replication lag of the connected node in seconds
+	ReplicationLagQuery() string
 }
 
 func Register(matcher Matcher, config Connector, isDefault bool) {
@@ -109,6 +109,12 @@ func (c *Config) Timetravel(time.Duration) string {
 	return ""
 }
 
+// ReplicationLagQuery selects the delay of the last replayed transaction on a standby,
+// the lag of a primary is always 0
+func (c *Config) ReplicationLagQuery() string {
+	return "SELECT CASE WHEN pg_is_in_recovery() THEN COALESCE(EXTRACT(EPOCH FROM now() - pg_last_xact_replay_timestamp()), 0) ELSE 0 END"
+}
+
 type User struct {
 	Username string
 	Password string
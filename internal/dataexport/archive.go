@@ -0,0 +1,167 @@
+package dataexport
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/query"
+	"github.com/zitadel/zitadel/internal/repository/user"
+)
+
+// Queries are the queries used to collect the personal data of a user
+type Queries interface {
+	GetUserByID(ctx context.Context, shouldTriggerBulk bool, userID string) (*query.User, error)
+	SearchUserMetadata(ctx context.Context, shouldTriggerBulk bool, userID string, queries *query.UserMetadataSearchQueries, withOwnerRemoved bool) (*query.UserMetadataList, error)
+	UserGrants(ctx context.Context, queries *query.UserGrantsQueries, shouldTriggerBulk bool) (*query.UserGrants, error)
+	SearchSessions(ctx context.Context, queries *query.SessionsSearchQueries) (*query.Sessions, error)
+	UserConsents(ctx context.Context, userID, resourceOwner string) ([]*query.UserConsent, error)
+	SearchEvents(ctx context.Context, query *eventstore.SearchQueryBuilder) ([]*query.Event, error)
+}
+
+const (
+	profileFile  = "profile.json"
+	metadataFile = "metadata.json"
+	grantsFile   = "grants.json"
+	sessionsFile = "sessions.json"
+	consentsFile = "consents.json"
+	eventsFile   = "events.json"
+)
+
+// Event is the representation of an audit event in the archive
+type Event struct {
+	CreationDate  time.Time       `json:"creationDate"`
+	EventType     string          `json:"eventType"`
+	AggregateType string          `json:"aggregateType"`
+	AggregateID   string          `json:"aggregateId"`
+	ResourceOwner string          `json:"resourceOwner"`
+	Sequence      uint64          `json:"sequence"`
+	EditorUserID  string          `json:"editorUserId,omitempty"`
+	Payload       json.RawMessage `json:"payload,omitempty"`
+}
+
+// BuildArchive collects the profile, metadata, grants, sessions, consents
+// and the audit events which reference the user into a zip archive of JSON files.
+func BuildArchive(ctx context.Context, queries Queries, userID, resourceOwner string) ([]byte, error) {
+	profile, err := queries.GetUserByID(ctx, false, userID)
+	if err != nil {
+		return nil, err
+	}
+	metadata, err := queries.SearchUserMetadata(ctx, false, userID, &query.UserMetadataSearchQueries{}, false)
+	if err != nil {
+		return nil, err
+	}
+	userIDQuery, err := query.NewUserGrantUserIDSearchQuery(userID)
+	if err != nil {
+		return nil, err
+	}
+	grants, err := queries.UserGrants(ctx, &query.UserGrantsQueries{Queries: []query.SearchQuery{userIDQuery}}, false)
+	if err != nil {
+		return nil, err
+	}
+	sessionUserQuery, err := query.NewUserIDSearchQuery(userID)
+	if err != nil {
+		return nil, err
+	}
+	sessions, err := queries.SearchSessions(ctx, &query.SessionsSearchQueries{Queries: []query.SearchQuery{sessionUserQuery}})
+	if err != nil {
+		return nil, err
+	}
+	consents, err := queries.UserConsents(ctx, userID, resourceOwner)
+	if err != nil {
+		return nil, err
+	}
+	events, err := userEvents(ctx, queries, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	var buf bytes.Buffer
+	w := zip.NewWriter(&buf)
+	files := []struct {
+		name    string
+		content any
+	}{
+		{profileFile, profile},
+		{metadataFile, metadata.Metadata},
+		{grantsFile, grants.UserGrants},
+		{sessionsFile, sessions.Sessions},
+		{consentsFile, consents},
+		{eventsFile, events},
+	}
+	for _, file := range files {
+		if err = writeJSON(w, file.name, file.content); err != nil {
+			return nil, err
+		}
+	}
+	if err = w.Close(); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// userEvents returns the events of the user aggregate and the events the user created on other aggregates
+func userEvents(ctx context.Context, queries Queries, userID string) ([]*Event, error) {
+	instanceID := authz.GetInstance(ctx).InstanceID()
+	ofUser, err := queries.SearchEvents(ctx, eventstore.NewSearchQueryBuilder(eventstore.ColumnsEvent).
+		InstanceID(instanceID).
+		OrderAsc().
+		AddQuery().
+		AggregateTypes(user.AggregateType).
+		AggregateIDs(userID).
+		Builder())
+	if err != nil {
+		return nil, err
+	}
+	byUser, err := queries.SearchEvents(ctx, eventstore.NewSearchQueryBuilder(eventstore.ColumnsEvent).
+		InstanceID(instanceID).
+		OrderAsc().
+		EditorUser(userID))
+	if err != nil {
+		return nil, err
+	}
+	events := make([]*Event, 0, len(ofUser)+len(byUser))
+	for _, event := range ofUser {
+		events = append(events, eventFromQuery(event))
+	}
+	for _, event := range byUser {
+		// the events of the user aggregate are already contained
+		if event.Aggregate.Type == user.AggregateType && event.Aggregate.ID == userID {
+			continue
+		}
+		events = append(events, eventFromQuery(event))
+	}
+	return events, nil
+}
+
+func eventFromQuery(event *query.Event) *Event {
+	exported := &Event{
+		CreationDate:  event.CreationDate,
+		EventType:     event.Type,
+		AggregateType: string(event.Aggregate.Type),
+		AggregateID:   event.Aggregate.ID,
+		ResourceOwner: event.Aggregate.ResourceOwner,
+		Sequence:      event.Sequence,
+	}
+	if event.Editor != nil {
+		exported.EditorUserID = event.Editor.ID
+	}
+	if len(event.Payload) > 0 && json.Valid(event.Payload) {
+		exported.Payload = event.Payload
+	}
+	return exported
+}
+
+func writeJSON(w *zip.Writer, name string, content any) error {
+	file, err := w.Create(name)
+	if err != nil {
+		return err
+	}
+	encoder := json.NewEncoder(file)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(content)
+}
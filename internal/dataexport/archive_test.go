@@ -0,0 +1,108 @@
+package dataexport
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/query"
+)
+
+type testQueries struct {
+	events map[string][]*query.Event
+}
+
+func (q *testQueries) GetUserByID(_ context.Context, _ bool, userID string) (*query.User, error) {
+	return &query.User{ID: userID, Username: "jane"}, nil
+}
+
+func (q *testQueries) SearchUserMetadata(context.Context, bool, string, *query.UserMetadataSearchQueries, bool) (*query.UserMetadataList, error) {
+	return &query.UserMetadataList{Metadata: []*query.UserMetadata{{Key: "key", Value: []byte("value")}}}, nil
+}
+
+func (q *testQueries) UserGrants(context.Context, *query.UserGrantsQueries, bool) (*query.UserGrants, error) {
+	return &query.UserGrants{UserGrants: []*query.UserGrant{{ID: "grant1", Roles: []string{"role"}}}}, nil
+}
+
+func (q *testQueries) SearchSessions(context.Context, *query.SessionsSearchQueries) (*query.Sessions, error) {
+	return &query.Sessions{Sessions: []*query.Session{{ID: "session1"}}}, nil
+}
+
+func (q *testQueries) UserConsents(context.Context, string, string) ([]*query.UserConsent, error) {
+	return []*query.UserConsent{{AppID: "app1", Scopes: []string{"openid"}}}, nil
+}
+
+func (q *testQueries) SearchEvents(_ context.Context, builder *eventstore.SearchQueryBuilder) ([]*query.Event, error) {
+	if builder.GetEditorUser() != "" {
+		return q.events["editor"], nil
+	}
+	return q.events["aggregate"], nil
+}
+
+func TestBuildArchive(t *testing.T) {
+	created := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	queries := &testQueries{
+		events: map[string][]*query.Event{
+			"aggregate": {
+				{
+					Aggregate:    &eventstore.Aggregate{ID: "user1", Type: "user", ResourceOwner: "org1"},
+					Sequence:     1,
+					CreationDate: created,
+					Type:         "user.human.added",
+					Payload:      []byte(`{"userName":"jane"}`),
+				},
+			},
+			"editor": {
+				{
+					Aggregate:    &eventstore.Aggregate{ID: "user1", Type: "user", ResourceOwner: "org1"},
+					Sequence:     1,
+					CreationDate: created,
+					Type:         "user.human.added",
+					Editor:       &query.EventEditor{ID: "user1"},
+				},
+				{
+					Aggregate:    &eventstore.Aggregate{ID: "org1", Type: "org", ResourceOwner: "org1"},
+					Sequence:     5,
+					CreationDate: created,
+					Type:         "org.member.added",
+					Editor:       &query.EventEditor{ID: "user1"},
+				},
+			},
+		},
+	}
+	archive, err := BuildArchive(context.Background(), queries, "user1", "org1")
+	require.NoError(t, err)
+
+	reader, err := zip.NewReader(bytes.NewReader(archive), int64(len(archive)))
+	require.NoError(t, err)
+	files := make(map[string]string, len(reader.File))
+	for _, file := range reader.File {
+		f, err := file.Open()
+		require.NoError(t, err)
+		content, err := io.ReadAll(f)
+		require.NoError(t, err)
+		files[file.Name] = string(content)
+	}
+	assert.ElementsMatch(t, []string{profileFile, metadataFile, grantsFile, sessionsFile, consentsFile, eventsFile}, mapKeys(files))
+	assert.Contains(t, files[profileFile], `"username": "jane"`)
+	assert.Contains(t, files[consentsFile], `"AppID": "app1"`)
+	assert.JSONEq(t, `[
+		{"creationDate":"2024-01-01T00:00:00Z","eventType":"user.human.added","aggregateType":"user","aggregateId":"user1","resourceOwner":"org1","sequence":1,"payload":{"userName":"jane"}},
+		{"creationDate":"2024-01-01T00:00:00Z","eventType":"org.member.added","aggregateType":"org","aggregateId":"org1","resourceOwner":"org1","sequence":5,"editorUserId":"user1"}
+	]`, files[eventsFile])
+}
+
+func mapKeys(m map[string]string) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	return keys
+}
@@ -0,0 +1,136 @@
+package dataexport
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"time"
+
+	"github.com/zitadel/logging"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/command"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/eventstore/handler/v2"
+	"github.com/zitadel/zitadel/internal/query"
+	"github.com/zitadel/zitadel/internal/query/projection"
+	"github.com/zitadel/zitadel/internal/repository/user"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+const (
+	HandlerTable = "projections.user_data_exports"
+	// GeneratorUserID is the editor of the events pushed by the generator
+	GeneratorUserID = "DATA_EXPORT"
+)
+
+// Commands are the commands used to store the result of an export
+type Commands interface {
+	CompleteHumanDataExport(ctx context.Context, userID, resourceOwner, exportID string, archive io.Reader, size int64, expiration time.Time) (*domain.ObjectDetails, error)
+	FailHumanDataExport(ctx context.Context, userID, resourceOwner, exportID string) (*domain.ObjectDetails, error)
+}
+
+type HandlerQueries interface {
+	Queries
+	HumanDataExportByID(ctx context.Context, userID, resourceOwner, exportID string) (*query.DataExport, error)
+}
+
+type eventHandler struct {
+	commands     Commands
+	queries      HandlerQueries
+	linkLifetime time.Duration
+}
+
+// NewEventHandler returns the handler which generates the archives of the requested data exports.
+// If the archive can't be generated, the export is marked as failed and the user has to request a new one.
+func NewEventHandler(
+	ctx context.Context,
+	config handler.Config,
+	commands Commands,
+	queries HandlerQueries,
+	linkLifetime time.Duration,
+) *handler.Handler {
+	return handler.NewHandler(ctx, &config, &eventHandler{
+		commands:     commands,
+		queries:      queries,
+		linkLifetime: linkLifetime,
+	})
+}
+
+func (*eventHandler) Name() string {
+	return HandlerTable
+}
+
+func (h *eventHandler) Reducers() []handler.AggregateReducer {
+	return []handler.AggregateReducer{
+		{
+			Aggregate: user.AggregateType,
+			EventReducers: []handler.EventReducer{
+				{
+					Event:  user.HumanDataExportRequestedType,
+					Reduce: h.reduceDataExportRequested,
+				},
+			},
+		},
+	}
+}
+
+func (h *eventHandler) reduceDataExportRequested(event eventstore.Event) (*handler.Statement, error) {
+	e, ok := event.(*user.HumanDataExportRequestedEvent)
+	if !ok {
+		return nil, zerrors.ThrowInvalidArgumentf(nil, "EXPORT-Dx4nq8kw2v", "reduce.wrong.event.type %s", user.HumanDataExportRequestedType)
+	}
+	return handler.NewStatement(event, func(ex handler.Executer, projectionName string) error {
+		ctx := handlerContext(e.Aggregate())
+		export, err := h.queries.HumanDataExportByID(ctx, e.Aggregate().ID, e.Aggregate().ResourceOwner, e.ExportID)
+		if zerrors.IsNotFound(err) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		// the export was already handled, e.g. on a previous run
+		if export.State != domain.DataExportStateRequested {
+			return nil
+		}
+		archive, err := BuildArchive(ctx, h.queries, e.Aggregate().ID, e.Aggregate().ResourceOwner)
+		if err != nil {
+			logging.WithFields("instance", e.Aggregate().InstanceID, "user", e.Aggregate().ID, "export", e.ExportID).WithError(err).Warn("generating data export failed")
+			_, err = h.commands.FailHumanDataExport(ctx, e.Aggregate().ID, e.Aggregate().ResourceOwner, e.ExportID)
+			return err
+		}
+		_, err = h.commands.CompleteHumanDataExport(ctx, e.Aggregate().ID, e.Aggregate().ResourceOwner, e.ExportID, bytes.NewReader(archive), int64(len(archive)), time.Now().Add(h.linkLifetime))
+		return err
+	}), nil
+}
+
+func handlerContext(aggregate *eventstore.Aggregate) context.Context {
+	ctx := authz.WithInstanceID(context.Background(), aggregate.InstanceID)
+	return authz.SetCtxData(ctx, authz.CtxData{UserID: GeneratorUserID, OrgID: aggregate.ResourceOwner})
+}
+
+var projections []*handler.Handler
+
+// Register creates the handler which generates the data exports. It's started with [Start].
+func Register(
+	ctx context.Context,
+	customConfig projection.CustomConfig,
+	commands *command.Commands,
+	queries *query.Queries,
+	linkLifetime time.Duration,
+) {
+	projections = append(projections, NewEventHandler(
+		ctx,
+		projection.ApplyCustomConfig(customConfig),
+		commands,
+		queries,
+		linkLifetime,
+	))
+}
+
+func Start(ctx context.Context) {
+	for _, projection := range projections {
+		projection.Start(ctx)
+	}
+}
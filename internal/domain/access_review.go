@@ -0,0 +1,54 @@
+package domain
+
+type AccessReviewState int32
+
+const (
+	AccessReviewStateUnspecified AccessReviewState = iota
+	AccessReviewStateActive
+	AccessReviewStateRemoved
+
+	accessReviewStateCount
+)
+
+func (s AccessReviewState) Valid() bool {
+	return s >= 0 && s < accessReviewStateCount
+}
+
+func (s AccessReviewState) Exists() bool {
+	return s != AccessReviewStateUnspecified && s != AccessReviewStateRemoved
+}
+
+// AccessReviewCampaignState is the state of a single run of an access review
+type AccessReviewCampaignState int32
+
+const (
+	AccessReviewCampaignStateUnspecified AccessReviewCampaignState = iota
+	// AccessReviewCampaignStateOpen is set while the reviewers decide about the grants
+	AccessReviewCampaignStateOpen
+	// AccessReviewCampaignStateClosed is set after the revocations of the campaign were executed
+	AccessReviewCampaignStateClosed
+
+	accessReviewCampaignStateCount
+)
+
+func (s AccessReviewCampaignState) Valid() bool {
+	return s >= 0 && s < accessReviewCampaignStateCount
+}
+
+// AccessReviewDecision is the decision of a reviewer about a grant in a campaign
+type AccessReviewDecision int32
+
+const (
+	// AccessReviewDecisionUnspecified is set as long as no reviewer decided about the grant
+	AccessReviewDecisionUnspecified AccessReviewDecision = iota
+	// AccessReviewDecisionApprove keeps the grant
+	AccessReviewDecisionApprove
+	// AccessReviewDecisionRevoke removes the grant when the campaign is closed
+	AccessReviewDecisionRevoke
+
+	accessReviewDecisionCount
+)
+
+func (d AccessReviewDecision) Valid() bool {
+	return d > AccessReviewDecisionUnspecified && d < accessReviewDecisionCount
+}
@@ -0,0 +1,53 @@
+package domain
+
+import "strings"
+
+// AlertTargetType is the chat service an alert target posts to
+type AlertTargetType int32
+
+const (
+	AlertTargetTypeUnspecified AlertTargetType = iota
+	AlertTargetTypeSlack
+	AlertTargetTypeTeams
+
+	alertTargetTypeCount
+)
+
+func (t AlertTargetType) Valid() bool {
+	return t > AlertTargetTypeUnspecified && t < alertTargetTypeCount
+}
+
+type AlertTargetState int32
+
+const (
+	AlertTargetStateUnspecified AlertTargetState = iota
+	AlertTargetStateActive
+	AlertTargetStateRemoved
+
+	alertTargetStateCount
+)
+
+func (s AlertTargetState) Valid() bool {
+	return s >= 0 && s < alertTargetStateCount
+}
+
+// AlertEventTypeMatches checks if an alert of the event type is posted to a target with the filters.
+// A filter matches the event type exactly or, if it ends with a *, by prefix.
+// Targets without filters receive all alerts.
+func AlertEventTypeMatches(filters []string, eventType string) bool {
+	if len(filters) == 0 {
+		return true
+	}
+	for _, filter := range filters {
+		if prefix, ok := strings.CutSuffix(filter, "*"); ok {
+			if strings.HasPrefix(eventType, prefix) {
+				return true
+			}
+			continue
+		}
+		if filter == eventType {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,51 @@
+package domain
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAlertEventTypeMatches(t *testing.T) {
+	tests := []struct {
+		name      string
+		filters   []string
+		eventType string
+		want      bool
+	}{
+		{
+			name:      "no filters",
+			eventType: "org.member.added",
+			want:      true,
+		},
+		{
+			name:      "exact",
+			filters:   []string{"org.member.added"},
+			eventType: "org.member.added",
+			want:      true,
+		},
+		{
+			name:      "prefix",
+			filters:   []string{"projection.failed", "instance.idp.*"},
+			eventType: "instance.idp.oidc.changed",
+			want:      true,
+		},
+		{
+			name:      "no match",
+			filters:   []string{"org.member.added", "org.idp.*"},
+			eventType: "instance.idp.oidc.changed",
+			want:      false,
+		},
+		{
+			name:      "exact requires full type",
+			filters:   []string{"org.member"},
+			eventType: "org.member.added",
+			want:      false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, AlertEventTypeMatches(tt.filters, tt.eventType))
+		})
+	}
+}
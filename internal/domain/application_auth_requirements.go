@@ -0,0 +1,55 @@
+package domain
+
+import (
+	"slices"
+	"time"
+)
+
+// AppAuthRequirements are the conditions the authentication of a user has to fulfil
+// before an authorization request of the application is completed.
+// They are evaluated in addition to the login policy of the organization.
+type AppAuthRequirements struct {
+	// ForceMFA requires a multi-factor authentication, even if the login policy doesn't.
+	ForceMFA bool `json:"forceMfa,omitempty"`
+	// MaxAuthAge is the maximum time since the last authentication of the user.
+	// If exceeded, the user has to authenticate again. Zero disables the check.
+	MaxAuthAge time.Duration `json:"maxAuthAge,omitempty"`
+	// ConsentRequired requires the user to consent to the requested scopes before the application receives them,
+	// e.g. for applications of third parties. The consent is remembered for subsequent logins.
+	ConsentRequired bool `json:"consentRequired,omitempty"`
+}
+
+func (r *AppAuthRequirements) IsValid() bool {
+	return r == nil || r.MaxAuthAge >= 0
+}
+
+// IsZero returns true if the requirements don't add any condition.
+func (r *AppAuthRequirements) IsZero() bool {
+	return r == nil || (!r.ForceMFA && r.MaxAuthAge == 0 && !r.ConsentRequired)
+}
+
+// Equal returns true if both requirements add the same conditions.
+func (r *AppAuthRequirements) Equal(other *AppAuthRequirements) bool {
+	if r.IsZero() || other.IsZero() {
+		return r.IsZero() == other.IsZero()
+	}
+	return *r == *other
+}
+
+// ApplyTo adds the requirements of the application to the auth request.
+// The stricter one of the requested max_age and the max auth age of the application is used.
+func (r *AppAuthRequirements) ApplyTo(request *AuthRequest, appID string) {
+	if r.IsZero() {
+		return
+	}
+	if r.ConsentRequired {
+		request.ConsentAppID = appID
+	}
+	if r.ForceMFA && !slices.Contains(request.PossibleLOAs, LevelOfAssuranceMultiFactor) {
+		request.PossibleLOAs = append(request.PossibleLOAs, LevelOfAssuranceMultiFactor)
+	}
+	if r.MaxAuthAge > 0 && (request.MaxAuthAge == nil || *request.MaxAuthAge > r.MaxAuthAge) {
+		maxAuthAge := r.MaxAuthAge
+		request.MaxAuthAge = &maxAuthAge
+	}
+}
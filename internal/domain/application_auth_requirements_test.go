@@ -0,0 +1,106 @@
+package domain
+
+import (
+	"testing"
+	"time"
+
+	"github.com/muhlemmer/gu"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAppAuthRequirements_Equal(t *testing.T) {
+	tests := []struct {
+		name  string
+		r     *AppAuthRequirements
+		other *AppAuthRequirements
+		want  bool
+	}{
+		{
+			name:  "nil and empty",
+			r:     nil,
+			other: &AppAuthRequirements{},
+			want:  true,
+		},
+		{
+			name:  "nil and set",
+			r:     nil,
+			other: &AppAuthRequirements{ForceMFA: true},
+			want:  false,
+		},
+		{
+			name:  "same",
+			r:     &AppAuthRequirements{ForceMFA: true, MaxAuthAge: time.Minute},
+			other: &AppAuthRequirements{ForceMFA: true, MaxAuthAge: time.Minute},
+			want:  true,
+		},
+		{
+			name:  "different max auth age",
+			r:     &AppAuthRequirements{ForceMFA: true, MaxAuthAge: time.Minute},
+			other: &AppAuthRequirements{ForceMFA: true, MaxAuthAge: time.Hour},
+			want:  false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, tt.r.Equal(tt.other))
+		})
+	}
+}
+
+func TestAppAuthRequirements_ApplyTo(t *testing.T) {
+	tests := []struct {
+		name         string
+		requirements *AppAuthRequirements
+		request      *AuthRequest
+		want         *AuthRequest
+	}{
+		{
+			name:         "nil, unchanged",
+			requirements: nil,
+			request:      &AuthRequest{MaxAuthAge: gu.Ptr(time.Hour)},
+			want:         &AuthRequest{MaxAuthAge: gu.Ptr(time.Hour)},
+		},
+		{
+			name:         "force mfa",
+			requirements: &AppAuthRequirements{ForceMFA: true},
+			request:      &AuthRequest{},
+			want:         &AuthRequest{PossibleLOAs: []LevelOfAssurance{LevelOfAssuranceMultiFactor}},
+		},
+		{
+			name:         "force mfa, already requested",
+			requirements: &AppAuthRequirements{ForceMFA: true},
+			request:      &AuthRequest{PossibleLOAs: []LevelOfAssurance{LevelOfAssuranceMultiFactor}},
+			want:         &AuthRequest{PossibleLOAs: []LevelOfAssurance{LevelOfAssuranceMultiFactor}},
+		},
+		{
+			name:         "max auth age, none requested",
+			requirements: &AppAuthRequirements{MaxAuthAge: 15 * time.Minute},
+			request:      &AuthRequest{},
+			want:         &AuthRequest{MaxAuthAge: gu.Ptr(15 * time.Minute)},
+		},
+		{
+			name:         "max auth age, longer requested",
+			requirements: &AppAuthRequirements{MaxAuthAge: 15 * time.Minute},
+			request:      &AuthRequest{MaxAuthAge: gu.Ptr(time.Hour)},
+			want:         &AuthRequest{MaxAuthAge: gu.Ptr(15 * time.Minute)},
+		},
+		{
+			name:         "max auth age, shorter requested",
+			requirements: &AppAuthRequirements{MaxAuthAge: 15 * time.Minute},
+			request:      &AuthRequest{MaxAuthAge: gu.Ptr(time.Minute)},
+			want:         &AuthRequest{MaxAuthAge: gu.Ptr(time.Minute)},
+		},
+		{
+			name:         "consent required",
+			requirements: &AppAuthRequirements{ConsentRequired: true},
+			request:      &AuthRequest{},
+			want:         &AuthRequest{ConsentAppID: "app1"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.requirements.ApplyTo(tt.request, "app1")
+			assert.Equal(t, tt.want, tt.request)
+		})
+	}
+}
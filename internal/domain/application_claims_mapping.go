@@ -0,0 +1,98 @@
+package domain
+
+import (
+	"maps"
+	"slices"
+	"strings"
+)
+
+// ClaimsMapping customizes the claims of the ID tokens and introspection responses of an application.
+type ClaimsMapping struct {
+	// IncludeRoles asserts the roles of the project, even if they are not requested by scope.
+	IncludeRoles bool `json:"includeRoles,omitempty"`
+	// MetadataKeys are the keys of the user metadata which are added as claims.
+	// The claim is named like the key and contains the value as string.
+	MetadataKeys []string `json:"metadataKeys,omitempty"`
+	// FlattenAudience returns the audience as string instead of an array, if it contains a single value.
+	FlattenAudience bool `json:"flattenAudience,omitempty"`
+	// RenamedClaims maps the name of a claim to the name it is returned with.
+	// Only claims which are not registered by the specifications can be renamed.
+	RenamedClaims map[string]string `json:"renamedClaims,omitempty"`
+	// MetadataNamespaces are prefixes of user metadata keys, which are added as a single claim per namespace.
+	// The claim is named like the namespace and contains the metadata with the key "namespace.name" as "name": "value".
+	MetadataNamespaces []string `json:"metadataNamespaces,omitempty"`
+	// RolesFormat defines the format of the asserted role claims.
+	RolesFormat ClaimsRolesFormat `json:"rolesFormat,omitempty"`
+}
+
+type ClaimsRolesFormat int32
+
+const (
+	// ClaimsRolesFormatMap returns the roles as object with the role key and the granting organizations.
+	ClaimsRolesFormatMap ClaimsRolesFormat = iota
+	// ClaimsRolesFormatList returns the roles as list of the role keys.
+	ClaimsRolesFormatList
+
+	claimsRolesFormatCount
+)
+
+func (f ClaimsRolesFormat) Valid() bool {
+	return f >= 0 && f < claimsRolesFormatCount
+}
+
+// registeredClaims are set by the OIDC and OAuth specifications and can't be overwritten by a mapping.
+var registeredClaims = []string{
+	"iss", "sub", "aud", "exp", "iat", "nbf", "jti", "azp", "nonce", "auth_time", "amr", "acr",
+	"at_hash", "c_hash", "sid", "act", "cnf", "client_id", "scope", "active", "token_type", "username",
+	"name", "given_name", "family_name", "middle_name", "nickname", "preferred_username", "profile",
+	"picture", "website", "gender", "birthdate", "zoneinfo", "locale", "updated_at",
+	"email", "email_verified", "phone_number", "phone_number_verified", "address",
+}
+
+func isRegisteredClaim(claim string) bool {
+	return slices.Contains(registeredClaims, claim)
+}
+
+func (m *ClaimsMapping) IsValid() bool {
+	if m == nil {
+		return true
+	}
+	for _, key := range m.MetadataKeys {
+		if strings.TrimSpace(key) == "" || isRegisteredClaim(key) {
+			return false
+		}
+	}
+	for _, namespace := range m.MetadataNamespaces {
+		if strings.TrimSpace(namespace) == "" || strings.Contains(namespace, ".") || isRegisteredClaim(namespace) {
+			return false
+		}
+	}
+	for claim, name := range m.RenamedClaims {
+		if strings.TrimSpace(claim) == "" || strings.TrimSpace(name) == "" {
+			return false
+		}
+		if isRegisteredClaim(claim) || isRegisteredClaim(name) {
+			return false
+		}
+	}
+	return m.RolesFormat.Valid()
+}
+
+// IsZero returns true if the mapping doesn't change any claim.
+func (m *ClaimsMapping) IsZero() bool {
+	return m == nil || (!m.IncludeRoles && !m.FlattenAudience && len(m.MetadataKeys) == 0 && len(m.RenamedClaims) == 0 &&
+		len(m.MetadataNamespaces) == 0 && m.RolesFormat == ClaimsRolesFormatMap)
+}
+
+// Equal returns true if both mappings change the claims the same way.
+func (m *ClaimsMapping) Equal(other *ClaimsMapping) bool {
+	if m.IsZero() || other.IsZero() {
+		return m.IsZero() == other.IsZero()
+	}
+	if m.IncludeRoles != other.IncludeRoles || m.FlattenAudience != other.FlattenAudience || m.RolesFormat != other.RolesFormat {
+		return false
+	}
+	return slices.Equal(m.MetadataKeys, other.MetadataKeys) &&
+		slices.Equal(m.MetadataNamespaces, other.MetadataNamespaces) &&
+		maps.Equal(m.RenamedClaims, other.RenamedClaims)
+}
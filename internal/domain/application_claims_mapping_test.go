@@ -0,0 +1,152 @@
+package domain
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestClaimsMapping_IsValid(t *testing.T) {
+	tests := []struct {
+		name    string
+		mapping *ClaimsMapping
+		want    bool
+	}{
+		{
+			name:    "nil",
+			mapping: nil,
+			want:    true,
+		},
+		{
+			name: "valid",
+			mapping: &ClaimsMapping{
+				IncludeRoles:       true,
+				MetadataKeys:       []string{"department"},
+				FlattenAudience:    true,
+				RenamedClaims:      map[string]string{"urn:zitadel:iam:org:project:roles": "roles"},
+				MetadataNamespaces: []string{"billing"},
+				RolesFormat:        ClaimsRolesFormatList,
+			},
+			want: true,
+		},
+		{
+			name: "empty metadata namespace",
+			mapping: &ClaimsMapping{
+				MetadataNamespaces: []string{""},
+			},
+			want: false,
+		},
+		{
+			name: "metadata namespace with separator",
+			mapping: &ClaimsMapping{
+				MetadataNamespaces: []string{"billing.plan"},
+			},
+			want: false,
+		},
+		{
+			name: "registered metadata namespace",
+			mapping: &ClaimsMapping{
+				MetadataNamespaces: []string{"address"},
+			},
+			want: false,
+		},
+		{
+			name: "unknown roles format",
+			mapping: &ClaimsMapping{
+				RolesFormat: 99,
+			},
+			want: false,
+		},
+		{
+			name: "empty metadata key",
+			mapping: &ClaimsMapping{
+				MetadataKeys: []string{" "},
+			},
+			want: false,
+		},
+		{
+			name: "registered metadata key",
+			mapping: &ClaimsMapping{
+				MetadataKeys: []string{"email"},
+			},
+			want: false,
+		},
+		{
+			name: "empty name",
+			mapping: &ClaimsMapping{
+				RenamedClaims: map[string]string{"urn:zitadel:iam:org:project:roles": ""},
+			},
+			want: false,
+		},
+		{
+			name: "rename registered claim",
+			mapping: &ClaimsMapping{
+				RenamedClaims: map[string]string{"aud": "audience"},
+			},
+			want: false,
+		},
+		{
+			name: "rename to registered claim",
+			mapping: &ClaimsMapping{
+				RenamedClaims: map[string]string{"urn:zitadel:iam:user:metadata": "sub"},
+			},
+			want: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, tt.mapping.IsValid())
+		})
+	}
+}
+
+func TestClaimsMapping_Equal(t *testing.T) {
+	tests := []struct {
+		name  string
+		m     *ClaimsMapping
+		other *ClaimsMapping
+		want  bool
+	}{
+		{
+			name:  "nil and empty",
+			m:     nil,
+			other: &ClaimsMapping{},
+			want:  true,
+		},
+		{
+			name:  "nil and set",
+			m:     nil,
+			other: &ClaimsMapping{IncludeRoles: true},
+			want:  false,
+		},
+		{
+			name:  "same",
+			m:     &ClaimsMapping{MetadataKeys: []string{"a"}, RenamedClaims: map[string]string{"a": "b"}},
+			other: &ClaimsMapping{MetadataKeys: []string{"a"}, RenamedClaims: map[string]string{"a": "b"}},
+			want:  true,
+		},
+		{
+			name:  "only roles format",
+			m:     nil,
+			other: &ClaimsMapping{RolesFormat: ClaimsRolesFormatList},
+			want:  false,
+		},
+		{
+			name:  "different namespaces",
+			m:     &ClaimsMapping{MetadataNamespaces: []string{"a"}},
+			other: &ClaimsMapping{MetadataNamespaces: []string{"b"}},
+			want:  false,
+		},
+		{
+			name:  "different rename",
+			m:     &ClaimsMapping{RenamedClaims: map[string]string{"a": "b"}},
+			other: &ClaimsMapping{RenamedClaims: map[string]string{"a": "c"}},
+			want:  false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, tt.m.Equal(tt.other))
+		})
+	}
+}
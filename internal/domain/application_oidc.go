@@ -45,6 +45,10 @@ type OIDCApp struct {
 	ClockSkew                time.Duration
 	AdditionalOrigins        []string
 	SkipNativeAppSuccessPage bool
+	// TLSClientAuthSubjectDN is the expected subject of the client certificate for [OIDCAuthMethodTypeTLSClientAuth]
+	TLSClientAuthSubjectDN string
+	// TLSClientAuthThumbprint is the base64url encoded SHA-256 thumbprint of the client certificate for [OIDCAuthMethodTypeSelfSignedTLSClientAuth]
+	TLSClientAuthThumbprint string
 
 	State AppState
 }
@@ -91,6 +95,7 @@ const (
 	OIDCResponseModeQuery
 	OIDCResponseModeFragment
 	OIDCResponseModeFormPost
+	OIDCResponseModeWebMessage
 )
 
 type OIDCGrantType int32
@@ -118,8 +123,22 @@ const (
 	OIDCAuthMethodTypePost
 	OIDCAuthMethodTypeNone
 	OIDCAuthMethodTypePrivateKeyJWT
+	OIDCAuthMethodTypeTLSClientAuth
+	OIDCAuthMethodTypeSelfSignedTLSClientAuth
 )
 
+// TLSClientAuthValid checks that the certificate information required by the auth method is set
+func TLSClientAuthValid(authMethod OIDCAuthMethodType, subjectDN, thumbprint string) bool {
+	switch authMethod {
+	case OIDCAuthMethodTypeTLSClientAuth:
+		return strings.TrimSpace(subjectDN) != ""
+	case OIDCAuthMethodTypeSelfSignedTLSClientAuth:
+		return strings.TrimSpace(thumbprint) != ""
+	default:
+		return true
+	}
+}
+
 type Compliance struct {
 	NoneCompliant bool
 	Problems      []string
@@ -136,6 +155,9 @@ func (a *OIDCApp) IsValid() bool {
 	if a.ClockSkew > time.Second*5 || a.ClockSkew < time.Second*0 || !a.OriginsValid() {
 		return false
 	}
+	if !TLSClientAuthValid(a.AuthMethodType, a.TLSClientAuthSubjectDN, a.TLSClientAuthThumbprint) {
+		return false
+	}
 	grantTypes := a.getRequiredGrantTypes()
 	if len(grantTypes) == 0 {
 		return false
@@ -177,6 +177,50 @@ func TestApplicationValid(t *testing.T) {
 			},
 			result: false,
 		},
+		{
+			name: "invalid oidc application: tls_client_auth without subject",
+			args: args{
+				app: &OIDCApp{
+					ObjectRoot:     models.ObjectRoot{AggregateID: "AggregateID"},
+					AppID:          "AppID",
+					AppName:        "Name",
+					ResponseTypes:  []OIDCResponseType{OIDCResponseTypeCode},
+					GrantTypes:     []OIDCGrantType{OIDCGrantTypeAuthorizationCode},
+					AuthMethodType: OIDCAuthMethodTypeTLSClientAuth,
+				},
+			},
+			result: false,
+		},
+		{
+			name: "valid oidc application: tls_client_auth",
+			args: args{
+				app: &OIDCApp{
+					ObjectRoot:             models.ObjectRoot{AggregateID: "AggregateID"},
+					AppID:                  "AppID",
+					AppName:                "Name",
+					ResponseTypes:          []OIDCResponseType{OIDCResponseTypeCode},
+					GrantTypes:             []OIDCGrantType{OIDCGrantTypeAuthorizationCode},
+					AuthMethodType:         OIDCAuthMethodTypeTLSClientAuth,
+					TLSClientAuthSubjectDN: "CN=client,O=ZITADEL",
+				},
+			},
+			result: true,
+		},
+		{
+			name: "invalid oidc application: self_signed_tls_client_auth without thumbprint",
+			args: args{
+				app: &OIDCApp{
+					ObjectRoot:             models.ObjectRoot{AggregateID: "AggregateID"},
+					AppID:                  "AppID",
+					AppName:                "Name",
+					ResponseTypes:          []OIDCResponseType{OIDCResponseTypeCode},
+					GrantTypes:             []OIDCGrantType{OIDCGrantTypeAuthorizationCode},
+					AuthMethodType:         OIDCAuthMethodTypeSelfSignedTLSClientAuth,
+					TLSClientAuthSubjectDN: "CN=client,O=ZITADEL",
+				},
+			},
+			result: false,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -0,0 +1,68 @@
+package domain
+
+import (
+	"slices"
+	"strings"
+)
+
+// SAMLAttributeValue defines the value of a SAML attribute statement.
+type SAMLAttributeValue int32
+
+const (
+	SAMLAttributeValueUnspecified SAMLAttributeValue = iota
+	SAMLAttributeValueEmail
+	SAMLAttributeValueGivenName
+	SAMLAttributeValueSurname
+	SAMLAttributeValueFullName
+	SAMLAttributeValueUsername
+	SAMLAttributeValueUserID
+	// SAMLAttributeValueRoles contains the keys of the roles the user is granted in the project of the application.
+	SAMLAttributeValueRoles
+	// SAMLAttributeValueMetadata contains the value of the user metadata with the key of the attribute.
+	SAMLAttributeValueMetadata
+	samlAttributeValueCount
+)
+
+func (v SAMLAttributeValue) Valid() bool {
+	return v > SAMLAttributeValueUnspecified && v < samlAttributeValueCount
+}
+
+// SAMLAttribute is an attribute statement which is added to the SAML responses of an application
+// in addition to the default attributes.
+type SAMLAttribute struct {
+	Name         string             `json:"name"`
+	NameFormat   string             `json:"nameFormat,omitempty"`
+	FriendlyName string             `json:"friendlyName,omitempty"`
+	Value        SAMLAttributeValue `json:"value"`
+	// MetadataKey is the key of the user metadata, if the value is [SAMLAttributeValueMetadata].
+	MetadataKey string `json:"metadataKey,omitempty"`
+}
+
+// defaultSAMLAttributes are always part of the SAML responses and can't be overwritten by an attribute statement.
+var defaultSAMLAttributes = []string{"Email", "SurName", "FirstName", "FullName", "UserName", "UserID"}
+
+// SAMLAttributesValid returns false if an attribute is incomplete, overwrites a default attribute
+// or if the names are not unique.
+func SAMLAttributesValid(attributes []*SAMLAttribute) bool {
+	names := make([]string, 0, len(attributes))
+	for _, attribute := range attributes {
+		if attribute == nil || strings.TrimSpace(attribute.Name) == "" || !attribute.Value.Valid() {
+			return false
+		}
+		if (attribute.Value == SAMLAttributeValueMetadata) == (strings.TrimSpace(attribute.MetadataKey) == "") {
+			return false
+		}
+		if slices.Contains(defaultSAMLAttributes, attribute.Name) || slices.Contains(names, attribute.Name) {
+			return false
+		}
+		names = append(names, attribute.Name)
+	}
+	return true
+}
+
+// SAMLAttributesEqual returns true if both lists contain the same attribute statements in the same order.
+func SAMLAttributesEqual(a, b []*SAMLAttribute) bool {
+	return slices.EqualFunc(a, b, func(x, y *SAMLAttribute) bool {
+		return *x == *y
+	})
+}
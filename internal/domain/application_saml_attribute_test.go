@@ -0,0 +1,78 @@
+package domain
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSAMLAttributesValid(t *testing.T) {
+	tests := []struct {
+		name       string
+		attributes []*SAMLAttribute
+		want       bool
+	}{
+		{
+			name:       "empty",
+			attributes: nil,
+			want:       true,
+		},
+		{
+			name: "valid",
+			attributes: []*SAMLAttribute{
+				{Name: "urn:oid:0.9.2342.19200300.100.1.3", NameFormat: "urn:oasis:names:tc:SAML:2.0:attrname-format:uri", FriendlyName: "mail", Value: SAMLAttributeValueEmail},
+				{Name: "department", Value: SAMLAttributeValueMetadata, MetadataKey: "department"},
+				{Name: "roles", Value: SAMLAttributeValueRoles},
+			},
+			want: true,
+		},
+		{
+			name: "empty name",
+			attributes: []*SAMLAttribute{
+				{Name: " ", Value: SAMLAttributeValueEmail},
+			},
+			want: false,
+		},
+		{
+			name: "unspecified value",
+			attributes: []*SAMLAttribute{
+				{Name: "mail"},
+			},
+			want: false,
+		},
+		{
+			name: "metadata without key",
+			attributes: []*SAMLAttribute{
+				{Name: "department", Value: SAMLAttributeValueMetadata},
+			},
+			want: false,
+		},
+		{
+			name: "metadata key without metadata value",
+			attributes: []*SAMLAttribute{
+				{Name: "mail", Value: SAMLAttributeValueEmail, MetadataKey: "mail"},
+			},
+			want: false,
+		},
+		{
+			name: "default attribute",
+			attributes: []*SAMLAttribute{
+				{Name: "Email", Value: SAMLAttributeValueEmail},
+			},
+			want: false,
+		},
+		{
+			name: "duplicate name",
+			attributes: []*SAMLAttribute{
+				{Name: "mail", Value: SAMLAttributeValueEmail},
+				{Name: "mail", Value: SAMLAttributeValueUsername},
+			},
+			want: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, SAMLAttributesValid(tt.attributes))
+		})
+	}
+}
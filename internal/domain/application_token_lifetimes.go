@@ -0,0 +1,96 @@
+package domain
+
+import (
+	"time"
+)
+
+// AppTokenLifetimes override the token lifetimes of the OIDC settings of the instance for an application.
+// A zero lifetime uses the one of the instance.
+// The lifetimes of the instance are the upper bounds, an application can only shorten them.
+type AppTokenLifetimes struct {
+	AccessTokenLifetime        time.Duration `json:"accessTokenLifetime,omitempty"`
+	IDTokenLifetime            time.Duration `json:"idTokenLifetime,omitempty"`
+	RefreshTokenIdleExpiration time.Duration `json:"refreshTokenIdleExpiration,omitempty"`
+	RefreshTokenExpiration     time.Duration `json:"refreshTokenExpiration,omitempty"`
+}
+
+func (l *AppTokenLifetimes) IsValid() bool {
+	if l == nil {
+		return true
+	}
+	if l.AccessTokenLifetime < 0 || l.IDTokenLifetime < 0 || l.RefreshTokenIdleExpiration < 0 || l.RefreshTokenExpiration < 0 {
+		return false
+	}
+	return l.RefreshTokenIdleExpiration == 0 || l.RefreshTokenExpiration == 0 ||
+		l.RefreshTokenIdleExpiration <= l.RefreshTokenExpiration
+}
+
+// IsZero returns true if no lifetime of the instance is overridden.
+func (l *AppTokenLifetimes) IsZero() bool {
+	return l == nil || *l == AppTokenLifetimes{}
+}
+
+// Equal returns true if both override the same lifetimes.
+func (l *AppTokenLifetimes) Equal(other *AppTokenLifetimes) bool {
+	if l.IsZero() || other.IsZero() {
+		return l.IsZero() == other.IsZero()
+	}
+	return *l == *other
+}
+
+// IsWithin returns true if no lifetime exceeds the corresponding bound.
+// A zero bound doesn't restrict the lifetime.
+func (l *AppTokenLifetimes) IsWithin(bounds AppTokenLifetimes) bool {
+	if l == nil {
+		return true
+	}
+	return isWithin(l.AccessTokenLifetime, bounds.AccessTokenLifetime) &&
+		isWithin(l.IDTokenLifetime, bounds.IDTokenLifetime) &&
+		isWithin(l.RefreshTokenIdleExpiration, bounds.RefreshTokenIdleExpiration) &&
+		isWithin(l.RefreshTokenExpiration, bounds.RefreshTokenExpiration)
+}
+
+// AccessToken returns the access token lifetime of the application, or the one of the instance if not overridden.
+func (l *AppTokenLifetimes) AccessToken(instance time.Duration) time.Duration {
+	if l == nil {
+		return instance
+	}
+	return shorterLifetime(instance, l.AccessTokenLifetime)
+}
+
+// IDToken returns the id token lifetime of the application, or the one of the instance if not overridden.
+func (l *AppTokenLifetimes) IDToken(instance time.Duration) time.Duration {
+	if l == nil {
+		return instance
+	}
+	return shorterLifetime(instance, l.IDTokenLifetime)
+}
+
+// RefreshTokenIdle returns the refresh token idle expiration of the application, or the one of the instance if not overridden.
+func (l *AppTokenLifetimes) RefreshTokenIdle(instance time.Duration) time.Duration {
+	if l == nil {
+		return instance
+	}
+	return shorterLifetime(instance, l.RefreshTokenIdleExpiration)
+}
+
+// RefreshToken returns the refresh token expiration of the application, or the one of the instance if not overridden.
+func (l *AppTokenLifetimes) RefreshToken(instance time.Duration) time.Duration {
+	if l == nil {
+		return instance
+	}
+	return shorterLifetime(instance, l.RefreshTokenExpiration)
+}
+
+func isWithin(lifetime, bound time.Duration) bool {
+	return bound == 0 || lifetime <= bound
+}
+
+// shorterLifetime ignores overrides exceeding the lifetime of the instance,
+// e.g. if the settings of the instance were shortened after the application was configured.
+func shorterLifetime(instance, override time.Duration) time.Duration {
+	if override > 0 && (instance == 0 || override < instance) {
+		return override
+	}
+	return instance
+}
@@ -0,0 +1,117 @@
+package domain
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAppTokenLifetimes_IsValid(t *testing.T) {
+	tests := []struct {
+		name      string
+		lifetimes *AppTokenLifetimes
+		want      bool
+	}{
+		{
+			name: "nil",
+			want: true,
+		},
+		{
+			name:      "negative",
+			lifetimes: &AppTokenLifetimes{AccessTokenLifetime: -time.Minute},
+			want:      false,
+		},
+		{
+			name:      "idle expiration exceeds expiration",
+			lifetimes: &AppTokenLifetimes{RefreshTokenIdleExpiration: 48 * time.Hour, RefreshTokenExpiration: 24 * time.Hour},
+			want:      false,
+		},
+		{
+			name:      "only idle expiration",
+			lifetimes: &AppTokenLifetimes{RefreshTokenIdleExpiration: 48 * time.Hour},
+			want:      true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, tt.lifetimes.IsValid())
+		})
+	}
+}
+
+func TestAppTokenLifetimes_IsWithin(t *testing.T) {
+	bounds := AppTokenLifetimes{
+		AccessTokenLifetime:    12 * time.Hour,
+		IDTokenLifetime:        12 * time.Hour,
+		RefreshTokenExpiration: 30 * 24 * time.Hour,
+	}
+	tests := []struct {
+		name      string
+		lifetimes *AppTokenLifetimes
+		want      bool
+	}{
+		{
+			name: "nil",
+			want: true,
+		},
+		{
+			name:      "shorter",
+			lifetimes: &AppTokenLifetimes{AccessTokenLifetime: 5 * time.Minute, IDTokenLifetime: 5 * time.Minute},
+			want:      true,
+		},
+		{
+			name:      "longer",
+			lifetimes: &AppTokenLifetimes{RefreshTokenExpiration: 90 * 24 * time.Hour},
+			want:      false,
+		},
+		{
+			name:      "no bound",
+			lifetimes: &AppTokenLifetimes{RefreshTokenIdleExpiration: 90 * 24 * time.Hour},
+			want:      true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, tt.lifetimes.IsWithin(bounds))
+		})
+	}
+}
+
+func TestAppTokenLifetimes_AccessToken(t *testing.T) {
+	tests := []struct {
+		name      string
+		lifetimes *AppTokenLifetimes
+		instance  time.Duration
+		want      time.Duration
+	}{
+		{
+			name:     "nil, instance",
+			instance: time.Hour,
+			want:     time.Hour,
+		},
+		{
+			name:      "not overridden, instance",
+			lifetimes: &AppTokenLifetimes{IDTokenLifetime: time.Minute},
+			instance:  time.Hour,
+			want:      time.Hour,
+		},
+		{
+			name:      "shorter, application",
+			lifetimes: &AppTokenLifetimes{AccessTokenLifetime: 5 * time.Minute},
+			instance:  time.Hour,
+			want:      5 * time.Minute,
+		},
+		{
+			name:      "longer, instance",
+			lifetimes: &AppTokenLifetimes{AccessTokenLifetime: 2 * time.Hour},
+			instance:  time.Hour,
+			want:      time.Hour,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, tt.lifetimes.AccessToken(tt.instance))
+		})
+	}
+}
@@ -1,10 +1,17 @@
 package domain
 
-import "time"
+import (
+	"strings"
+	"time"
+)
 
 const (
 	UsersAssetPath  = "users"
 	AvatarAssetPath = "/avatar"
+	// DataExportAssetPath is the folder of the data exports of a user, they are only served through signed links
+	DataExportAssetPath = "/data_exports/"
+	// EventPayloadAssetPath is the folder of the offloaded event payloads, they are never served
+	EventPayloadAssetPath = "event_payloads/"
 
 	policyPrefix          = "policy"
 	LabelPolicyPrefix     = policyPrefix + "/label"
@@ -13,6 +20,9 @@ const (
 	labelPolicyFontPrefix = LabelPolicyPrefix + "/font"
 	Dark                  = "dark"
 
+	// LoginPolicyIDPIconPath is the prefix of the custom icons of the identity provider buttons on the login screen
+	LoginPolicyIDPIconPath = policyPrefix + "/login/idp/icon"
+
 	CssPath              = LabelPolicyPrefix + "/css"
 	CssVariablesFileName = "variables.css"
 
@@ -38,6 +48,22 @@ func GetHumanAvatarAssetPath(userID string) string {
 	return UsersAssetPath + "/" + userID + AvatarAssetPath
 }
 
+func GetHumanDataExportAssetPath(userID, exportID string) string {
+	return UsersAssetPath + "/" + userID + DataExportAssetPath + exportID + ".zip"
+}
+
+func IsHumanDataExportAssetPath(name string) bool {
+	return strings.HasPrefix(name, UsersAssetPath+"/") && strings.Contains(name, DataExportAssetPath)
+}
+
+func GetEventPayloadAssetPath(hash string) string {
+	return EventPayloadAssetPath + hash + ".json"
+}
+
+func IsEventPayloadAssetPath(name string) bool {
+	return strings.HasPrefix(name, EventPayloadAssetPath)
+}
+
 func AssetURL(prefix, resourceOwner, key string) string {
 	if prefix == "" || resourceOwner == "" || key == "" {
 		return ""
@@ -60,6 +60,12 @@ type AuthRequest struct {
 	DefaultTranslations      []*CustomText
 	OrgTranslations          []*CustomText
 	SAMLRequestID            string
+	// ConsentAppID is set if the application requires the user to consent to the requested scopes
+	ConsentAppID string
+	// FrameAncestors are the origins of the application allowed to embed the login
+	FrameAncestors []string
+	// NetworkAccessMonitored is set once an attempt blocked by a monitor only network access policy was recorded
+	NetworkAccessMonitored bool
 	// orgID the policies were last loaded with
 	policyOrgID string
 }
@@ -100,6 +106,8 @@ type ExternalUser struct {
 	Phone             PhoneNumber
 	IsPhoneVerified   bool
 	Metadatas         []*Metadata
+	// Claims are the values of the claims provided by the identity provider which are used by the role mapping of the provider
+	Claims map[string][]string
 }
 
 type Prompt int32
@@ -126,6 +134,8 @@ type LevelOfAssurance int
 
 const (
 	LevelOfAssuranceNone LevelOfAssurance = iota
+	LevelOfAssuranceSingleFactor
+	LevelOfAssuranceMultiFactor
 )
 
 type MFAType int
@@ -208,9 +218,14 @@ func (a *AuthRequest) SetOrgInformation(id, name, primaryDomain string, requeste
 	a.RequestedOrgDomain = requestedByDomain
 }
 
+// MFALevel returns the level of the multi-factor authentication required by the request.
+// A multi-factor authentication is required, if it was requested through the acr_values
+// or the application forces it. Otherwise the login policy decides (-1).
 func (a *AuthRequest) MFALevel() MFALevel {
+	if slices.Contains(a.PossibleLOAs, LevelOfAssuranceMultiFactor) {
+		return MFALevelSecondFactor
+	}
 	return -1
-	//PLANNED: check a.PossibleLOAs (and Prompt Login?)
 }
 
 func (a *AuthRequest) AppendAudIfNotExisting(aud string) {
@@ -246,6 +261,24 @@ func (a *AuthRequest) GetScopeOrgID() string {
 	return ""
 }
 
+// ConsentScopes returns the scopes the user has to consent to if the application requires a consent.
+func (a *AuthRequest) ConsentScopes() []string {
+	if oidcRequest, ok := a.Request.(*AuthRequestOIDC); ok {
+		return oidcRequest.Scopes
+	}
+	return nil
+}
+
+// ConsentPending returns true if the user didn't consent to the requested scopes yet.
+func (a *AuthRequest) ConsentPending() bool {
+	for _, step := range a.PossibleSteps {
+		if step.Type() == NextStepConsent {
+			return true
+		}
+	}
+	return false
+}
+
 func (a *AuthRequest) Done() bool {
 	for _, step := range a.PossibleSteps {
 		if step.Type() == NextStepRedirectToCallback {
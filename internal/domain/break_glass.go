@@ -0,0 +1,12 @@
+package domain
+
+// BreakGlassAuthMethod is how a break-glass user authenticated
+type BreakGlassAuthMethod int32
+
+const (
+	BreakGlassAuthMethodUnspecified BreakGlassAuthMethod = iota
+	// BreakGlassAuthMethodSecurityKey is the password and security key login of human users
+	BreakGlassAuthMethodSecurityKey
+	// BreakGlassAuthMethodClientSecret is the client credentials grant of machine users
+	BreakGlassAuthMethodClientSecret
+)
@@ -21,6 +21,7 @@ const (
 	LoginKeyLoginNextButtonText            = LoginKeyLogin + "NextButtonText"
 	LoginKeyLoginExternalUserDescription   = LoginKeyLogin + "ExternalUserDescription"
 	LoginKeyLoginUserMustBeMemberOfOrg     = LoginKeyLogin + "MustBeMemberOfOrg"
+	LoginKeyLoginForgotUsernameLinkText    = LoginKeyLogin + "ForgotUsernameLinkText"
 
 	LoginKeySelectAccount                          = "SelectAccount."
 	LoginKeySelectAccountTitle                     = LoginKeySelectAccount + "Title"
@@ -43,6 +44,7 @@ const (
 	LoginKeyPasswordHasSymbol      = LoginKeyPassword + "HasSymbol"
 	LoginKeyPasswordConfirmation   = LoginKeyPassword + "Confirmation"
 	LoginKeyPasswordResetLinkText  = LoginKeyPassword + "ResetLinkText"
+	LoginKeyPasswordCaptchaLabel   = LoginKeyPassword + "CaptchaLabel"
 	LoginKeyPasswordBackButtonText = LoginKeyPassword + "BackButtonText"
 	LoginKeyPasswordNextButtonText = LoginKeyPassword + "NextButtonText"
 
@@ -158,6 +160,7 @@ const (
 	LoginKeyPasswordlessValidateTokenButtonText = LoginKeyPasswordless + "ValidateTokenButtonText"
 	LoginKeyPasswordlessNotSupported            = LoginKeyPasswordless + "NotSupported"
 	LoginKeyPasswordlessErrorRetry              = LoginKeyPasswordless + "ErrorRetry"
+	LoginKeyPasswordlessCaptchaLabel            = LoginKeyPasswordless + "CaptchaLabel"
 
 	LoginKeyPasswordlessPrompt                       = "PasswordlessPrompt."
 	LoginKeyPasswordlessPromptTitle                  = LoginKeyPasswordlessPrompt + "Title"
@@ -202,6 +205,33 @@ const (
 	LoginKeyPasswordResetDoneDescription    = LoginKeyPasswordResetDone + "Description"
 	LoginKeyPasswordResetDoneNextButtonText = LoginKeyPasswordResetDone + "NextButtonText"
 
+	LoginKeyUsernameRecovery                  = "UsernameRecovery."
+	LoginKeyUsernameRecoveryTitle             = LoginKeyUsernameRecovery + "Title"
+	LoginKeyUsernameRecoveryDescription       = LoginKeyUsernameRecovery + "Description"
+	LoginKeyUsernameRecoveryEmailOrPhoneLabel = LoginKeyUsernameRecovery + "EmailOrPhoneLabel"
+	LoginKeyUsernameRecoveryCaptchaLabel      = LoginKeyUsernameRecovery + "CaptchaLabel"
+	LoginKeyUsernameRecoveryBackButtonText    = LoginKeyUsernameRecovery + "BackButtonText"
+	LoginKeyUsernameRecoveryNextButtonText    = LoginKeyUsernameRecovery + "NextButtonText"
+
+	LoginKeyUsernameRecoveryDone               = "UsernameRecoveryDone."
+	LoginKeyUsernameRecoveryDoneTitle          = LoginKeyUsernameRecoveryDone + "Title"
+	LoginKeyUsernameRecoveryDoneDescription    = LoginKeyUsernameRecoveryDone + "Description"
+	LoginKeyUsernameRecoveryDoneNextButtonText = LoginKeyUsernameRecoveryDone + "NextButtonText"
+
+	LoginKeyPasskeyPrompt                   = "PasskeyPrompt."
+	LoginKeyPasskeyPromptTitle              = LoginKeyPasskeyPrompt + "Title"
+	LoginKeyPasskeyPromptDescription        = LoginKeyPasskeyPrompt + "Description"
+	LoginKeyPasskeyPromptRegisterButtonText = LoginKeyPasskeyPrompt + "RegisterButtonText"
+	LoginKeyPasskeyPromptSnoozeButtonText   = LoginKeyPasskeyPrompt + "SnoozeButtonText"
+	LoginKeyPasskeyPromptOptOutButtonText   = LoginKeyPasskeyPrompt + "OptOutButtonText"
+
+	LoginKeyLinkedIDPs                 = "LinkedIDPs."
+	LoginKeyLinkedIDPsTitle            = LoginKeyLinkedIDPs + "Title"
+	LoginKeyLinkedIDPsDescription      = LoginKeyLinkedIDPs + "Description"
+	LoginKeyLinkedIDPsNoLinksText      = LoginKeyLinkedIDPs + "NoLinksText"
+	LoginKeyLinkedIDPsUnlinkButtonText = LoginKeyLinkedIDPs + "UnlinkButtonText"
+	LoginKeyLinkedIDPsNextButtonText   = LoginKeyLinkedIDPs + "NextButtonText"
+
 	LoginKeyRegistrationOption                         = "RegisterOption."
 	LoginKeyRegistrationOptionTitle                    = LoginKeyRegistrationOption + "Title"
 	LoginKeyRegistrationOptionDescription              = LoginKeyRegistrationOption + "Description"
@@ -228,6 +258,7 @@ const (
 	LoginKeyRegistrationUserPrivacyLinkText        = LoginKeyRegistrationUser + "PrivacyLinkText"
 	LoginKeyRegistrationUserNextButtonText         = LoginKeyRegistrationUser + "NextButtonText"
 	LoginKeyRegistrationUserBackButtonText         = LoginKeyRegistrationUser + "BackButtonText"
+	LoginKeyRegistrationUserCaptchaLabel           = LoginKeyRegistrationUser + "CaptchaLabel"
 
 	LoginKeyExternalRegistrationUserOverview                   = "ExternalRegistrationUserOverview."
 	LoginKeyExternalRegistrationUserOverviewTitle              = LoginKeyExternalRegistrationUserOverview + "Title"
@@ -299,6 +330,12 @@ const (
 	LoginKeyLogoutDoneDescription     = LoginKeyLogoutDone + "Description"
 	LoginKeyLogoutDoneLoginButtonText = LoginKeyLogoutDone + "LoginButtonText"
 
+	LoginKeyLogoutConfirmation                  = "LogoutConfirmation."
+	LoginKeyLogoutConfirmationTitle             = LoginKeyLogoutConfirmation + "Title"
+	LoginKeyLogoutConfirmationDescription       = LoginKeyLogoutConfirmation + "Description"
+	LoginKeyLogoutConfirmationConfirmButtonText = LoginKeyLogoutConfirmation + "ConfirmButtonText"
+	LoginKeyLogoutConfirmationCancelButtonText  = LoginKeyLogoutConfirmation + "CancelButtonText"
+
 	LoginKeyFooter              = "Footer."
 	LoginKeyFooterTOS           = LoginKeyFooter + "Tos"
 	LoginKeyFooterPrivacyPolicy = LoginKeyFooter + "PrivacyPolicy"
@@ -339,6 +376,10 @@ type CustomLoginText struct {
 	PasswordChange                   PasswordChangeScreenText
 	PasswordChangeDone               PasswordChangeDoneScreenText
 	PasswordResetDone                PasswordResetDoneScreenText
+	UsernameRecovery                 UsernameRecoveryScreenText
+	UsernameRecoveryDone             UsernameRecoveryDoneScreenText
+	PasskeyPrompt                    PasskeyPromptScreenText
+	LinkedIDPs                       LinkedIDPsScreenText
 	RegisterOption                   RegistrationOptionScreenText
 	RegistrationUser                 RegistrationUserScreenText
 	ExternalRegistrationUserOverview ExternalRegistrationUserOverviewScreenText
@@ -348,6 +389,7 @@ type CustomLoginText struct {
 	ExternalNotFound                 ExternalUserNotFoundScreenText
 	LoginSuccess                     SuccessLoginScreenText
 	LogoutDone                       LogoutDoneScreenText
+	LogoutConfirmation               LogoutConfirmationScreenText
 	Footer                           FooterText
 }
 
@@ -381,6 +423,7 @@ type LoginScreenText struct {
 	NextButtonText          string
 	ExternalUserDescription string
 	MustBeMemberOfOrg       string
+	ForgotUsernameLinkText  string
 }
 
 type PasswordScreenText struct {
@@ -388,6 +431,7 @@ type PasswordScreenText struct {
 	Description    string
 	PasswordLabel  string
 	ResetLinkText  string
+	CaptchaLabel   string
 	BackButtonText string
 	NextButtonText string
 	MinLength      string
@@ -525,6 +569,7 @@ type PasswordlessScreenText struct {
 	ValidateTokenButtonText string
 	NotSupported            string
 	ErrorRetry              string
+	CaptchaLabel            string
 }
 
 type PasswordChangeScreenText struct {
@@ -550,6 +595,37 @@ type PasswordResetDoneScreenText struct {
 	NextButtonText string
 }
 
+type UsernameRecoveryScreenText struct {
+	Title             string
+	Description       string
+	EmailOrPhoneLabel string
+	CaptchaLabel      string
+	BackButtonText    string
+	NextButtonText    string
+}
+
+type UsernameRecoveryDoneScreenText struct {
+	Title          string
+	Description    string
+	NextButtonText string
+}
+
+type PasskeyPromptScreenText struct {
+	Title              string
+	Description        string
+	RegisterButtonText string
+	SnoozeButtonText   string
+	OptOutButtonText   string
+}
+
+type LinkedIDPsScreenText struct {
+	Title            string
+	Description      string
+	NoLinksText      string
+	UnlinkButtonText string
+	NextButtonText   string
+}
+
 type RegistrationOptionScreenText struct {
 	Title                              string
 	Description                        string
@@ -577,6 +653,7 @@ type RegistrationUserScreenText struct {
 	PrivacyLinkText        string
 	NextButtonText         string
 	BackButtonText         string
+	CaptchaLabel           string
 }
 
 type ExternalRegistrationUserOverviewScreenText struct {
@@ -655,6 +732,13 @@ type LogoutDoneScreenText struct {
 	LoginButtonText string
 }
 
+type LogoutConfirmationScreenText struct {
+	Title             string
+	Description       string
+	ConfirmButtonText string
+	CancelButtonText  string
+}
+
 type FooterText struct {
 	TOS           string
 	PrivacyPolicy string
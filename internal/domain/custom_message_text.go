@@ -8,22 +8,29 @@ import (
 )
 
 const (
-	InitCodeMessageType                 = "InitCode"
-	PasswordResetMessageType            = "PasswordReset"
-	VerifyEmailMessageType              = "VerifyEmail"
-	VerifyPhoneMessageType              = "VerifyPhone"
-	VerifySMSOTPMessageType             = "VerifySMSOTP"
-	VerifyEmailOTPMessageType           = "VerifyEmailOTP"
-	DomainClaimedMessageType            = "DomainClaimed"
-	PasswordlessRegistrationMessageType = "PasswordlessRegistration"
-	PasswordChangeMessageType           = "PasswordChange"
-	MessageTitle                        = "Title"
-	MessagePreHeader                    = "PreHeader"
-	MessageSubject                      = "Subject"
-	MessageGreeting                     = "Greeting"
-	MessageText                         = "Text"
-	MessageButtonText                   = "ButtonText"
-	MessageFooterText                   = "Footer"
+	InitCodeMessageType                  = "InitCode"
+	PasswordResetMessageType             = "PasswordReset"
+	VerifyEmailMessageType               = "VerifyEmail"
+	VerifyPhoneMessageType               = "VerifyPhone"
+	VerifySMSOTPMessageType              = "VerifySMSOTP"
+	VerifyEmailOTPMessageType            = "VerifyEmailOTP"
+	DomainClaimedMessageType             = "DomainClaimed"
+	PasswordlessRegistrationMessageType  = "PasswordlessRegistration"
+	PasswordChangeMessageType            = "PasswordChange"
+	DomainVerificationExpiredMessageType = "DomainVerificationExpired"
+	UserDeactivationScheduledMessageType = "UserDeactivationScheduled"
+	UserDeletionScheduledMessageType     = "UserDeletionScheduled"
+	MachineCredentialExpiringMessageType = "MachineCredentialExpiring"
+	IDPHealthCheckFailedMessageType      = "IDPHealthCheckFailed"
+	InviteUserMessageType                = "InviteUser"
+	UsernameRecoveryMessageType          = "UsernameRecovery"
+	MessageTitle                         = "Title"
+	MessagePreHeader                     = "PreHeader"
+	MessageSubject                       = "Subject"
+	MessageGreeting                      = "Greeting"
+	MessageText                          = "Text"
+	MessageButtonText                    = "ButtonText"
+	MessageFooterText                    = "Footer"
 )
 
 type MessageTexts struct {
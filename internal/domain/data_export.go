@@ -0,0 +1,23 @@
+package domain
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"strconv"
+	"time"
+)
+
+// DataExportSignature returns the signature of the download link of a data export.
+// The expiration is signed as well, so a link can't be used after the export expired.
+func DataExportSignature(signingKey []byte, userID, exportID string, expiration time.Time) string {
+	mac := hmac.New(sha256.New, signingKey)
+	mac.Write([]byte(userID + "." + exportID + "." + strconv.FormatInt(expiration.Unix(), 10)))
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// VerifyDataExportSignature checks the signature of the download link in constant time.
+func VerifyDataExportSignature(signingKey []byte, userID, exportID string, expiration time.Time, signature string) bool {
+	expected := DataExportSignature(signingKey, userID, exportID, expiration)
+	return hmac.Equal([]byte(expected), []byte(signature))
+}
@@ -0,0 +1,27 @@
+package domain
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestVerifyDataExportSignature(t *testing.T) {
+	key := []byte("signing-key")
+	expiration := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	signature := DataExportSignature(key, "user1", "export1", expiration)
+
+	assert.True(t, VerifyDataExportSignature(key, "user1", "export1", expiration, signature))
+	assert.False(t, VerifyDataExportSignature([]byte("other-key"), "user1", "export1", expiration, signature))
+	assert.False(t, VerifyDataExportSignature(key, "user2", "export1", expiration, signature))
+	assert.False(t, VerifyDataExportSignature(key, "user1", "export2", expiration, signature))
+	assert.False(t, VerifyDataExportSignature(key, "user1", "export1", expiration.Add(time.Hour), signature))
+	assert.False(t, VerifyDataExportSignature(key, "user1", "export1", expiration, ""))
+}
+
+func TestIsHumanDataExportAssetPath(t *testing.T) {
+	assert.True(t, IsHumanDataExportAssetPath(GetHumanDataExportAssetPath("user1", "export1")))
+	assert.False(t, IsHumanDataExportAssetPath(GetHumanAvatarAssetPath("user1")))
+	assert.False(t, IsHumanDataExportAssetPath(LabelPolicyLogoPath))
+}
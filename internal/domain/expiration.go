@@ -34,3 +34,20 @@ func ValidateExpirationDate(date time.Time) (time.Time, error) {
 	}
 	return date, nil
 }
+
+// LimitExpirationDate ensures the expiration date doesn't exceed the max lifetime starting now.
+// Dates without an expiration get the max lifetime, later dates are rejected.
+// A max lifetime of 0 doesn't limit the date.
+func LimitExpirationDate(date time.Time, maxLifetime time.Duration) (time.Time, error) {
+	if maxLifetime <= 0 {
+		return date, nil
+	}
+	maxDate := time.Now().Add(maxLifetime)
+	if date.IsZero() || date.Equal(defaultExpDate) {
+		return maxDate, nil
+	}
+	if date.After(maxDate) {
+		return time.Time{}, zerrors.ThrowInvalidArgument(nil, "DOMAIN-Mcl1x", "Errors.Org.MachineCredentialPolicy.ExpirationExceedsMaxLifetime")
+	}
+	return date, nil
+}
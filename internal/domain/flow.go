@@ -151,6 +151,16 @@ func (s TriggerType) LocalizationKey() string {
 	}
 }
 
+// Functions which are only available for executions and not part of a flow.
+const (
+	// FunctionPreHumanUserCreation is called before a human user is created,
+	// the targets can reject or normalize the user.
+	FunctionPreHumanUserCreation = "Action.Function.PreHumanUserCreation"
+	// FunctionPreHumanProfileChange is called before the profile of a human user is changed,
+	// the targets can reject or normalize the profile.
+	FunctionPreHumanProfileChange = "Action.Function.PreHumanProfileChange"
+)
+
 func AllFunctions() []string {
 	functions := make([]string, 0)
 	for _, flowType := range AllFlowTypes() {
@@ -158,7 +168,7 @@ func AllFunctions() []string {
 			functions = append(functions, flowType.LocalizationKey()+"."+triggerType.LocalizationKey())
 		}
 	}
-	return functions
+	return append(functions, FunctionPreHumanUserCreation, FunctionPreHumanProfileChange)
 }
 
 func FunctionExists() func(string) bool {
@@ -133,6 +133,7 @@ const (
 	AutoLinkingOptionUnspecified AutoLinkingOption = iota
 	AutoLinkingOptionUsername
 	AutoLinkingOptionEmail
+	AutoLinkingOptionVerifiedEmail
 )
 
 type SAMLNameIDFormat uint8
@@ -143,3 +144,21 @@ const (
 	SAMLNameIDFormatPersistent
 	SAMLNameIDFormatTransient
 )
+
+// LDAPGroupResolution defines how the (nested) groups of a user are resolved on the LDAP server.
+type LDAPGroupResolution uint8
+
+const (
+	// LDAPGroupResolutionUnspecified does not resolve any groups.
+	LDAPGroupResolutionUnspecified LDAPGroupResolution = iota
+	// LDAPGroupResolutionMemberOf follows the memberOf attribute of the user and of each found group.
+	LDAPGroupResolutionMemberOf
+	// LDAPGroupResolutionMatchingRuleInChain searches the groups using the LDAP_MATCHING_RULE_IN_CHAIN (Active Directory).
+	LDAPGroupResolutionMatchingRuleInChain
+
+	ldapGroupResolutionCount
+)
+
+func (r LDAPGroupResolution) Valid() bool {
+	return r < ldapGroupResolutionCount
+}
@@ -0,0 +1,43 @@
+package domain
+
+import (
+	"slices"
+)
+
+// IDPAutoGrant grants the roles of a project to users registered with the identity provider,
+// so they are able to use the project right after their first login.
+type IDPAutoGrant struct {
+	ProjectID string `json:"projectId"`
+	// ProjectGrantID is required if the project is granted to the organization of the user
+	ProjectGrantID string   `json:"projectGrantId,omitempty"`
+	RoleKeys       []string `json:"roleKeys"`
+}
+
+func (g *IDPAutoGrant) IsValid() bool {
+	return g.ProjectID != "" && len(g.RoleKeys) > 0 && !slices.Contains(g.RoleKeys, "")
+}
+
+// MergeIDPAutoGrants adds the auto grants of the identity provider to the user grants of the user,
+// roles of projects the user is already granted are merged into the existing user grant.
+func MergeIDPAutoGrants(userGrants []*UserGrant, autoGrants []*IDPAutoGrant, userID string) []*UserGrant {
+	for _, autoGrant := range autoGrants {
+		index := slices.IndexFunc(userGrants, func(userGrant *UserGrant) bool {
+			return userGrant.ProjectID == autoGrant.ProjectID && userGrant.ProjectGrantID == autoGrant.ProjectGrantID
+		})
+		if index < 0 {
+			userGrants = append(userGrants, &UserGrant{
+				UserID:         userID,
+				ProjectID:      autoGrant.ProjectID,
+				ProjectGrantID: autoGrant.ProjectGrantID,
+				RoleKeys:       slices.Clone(autoGrant.RoleKeys),
+			})
+			continue
+		}
+		for _, roleKey := range autoGrant.RoleKeys {
+			if !slices.Contains(userGrants[index].RoleKeys, roleKey) {
+				userGrants[index].RoleKeys = append(userGrants[index].RoleKeys, roleKey)
+			}
+		}
+	}
+	return userGrants
+}
@@ -0,0 +1,63 @@
+package domain
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIDPAutoGrant_IsValid(t *testing.T) {
+	tests := []struct {
+		name  string
+		grant *IDPAutoGrant
+		want  bool
+	}{
+		{
+			name:  "project roles",
+			grant: &IDPAutoGrant{ProjectID: "project1", RoleKeys: []string{"developer"}},
+			want:  true,
+		},
+		{
+			name:  "project grant roles",
+			grant: &IDPAutoGrant{ProjectID: "project1", ProjectGrantID: "grant1", RoleKeys: []string{"developer"}},
+			want:  true,
+		},
+		{
+			name:  "missing project",
+			grant: &IDPAutoGrant{RoleKeys: []string{"developer"}},
+			want:  false,
+		},
+		{
+			name:  "missing roles",
+			grant: &IDPAutoGrant{ProjectID: "project1"},
+			want:  false,
+		},
+		{
+			name:  "empty role",
+			grant: &IDPAutoGrant{ProjectID: "project1", RoleKeys: []string{""}},
+			want:  false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, tt.grant.IsValid())
+		})
+	}
+}
+
+func TestMergeIDPAutoGrants(t *testing.T) {
+	userGrants := []*UserGrant{
+		{UserID: "user1", ProjectID: "project1", RoleKeys: []string{"viewer"}},
+	}
+	autoGrants := []*IDPAutoGrant{
+		{ProjectID: "project1", RoleKeys: []string{"viewer", "developer"}},
+		{ProjectID: "project2", ProjectGrantID: "grant2", RoleKeys: []string{"developer"}},
+	}
+	assert.Equal(t,
+		[]*UserGrant{
+			{UserID: "user1", ProjectID: "project1", RoleKeys: []string{"viewer", "developer"}},
+			{UserID: "user1", ProjectID: "project2", ProjectGrantID: "grant2", RoleKeys: []string{"developer"}},
+		},
+		MergeIDPAutoGrants(userGrants, autoGrants, "user1"),
+	)
+}
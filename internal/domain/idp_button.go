@@ -0,0 +1,97 @@
+package domain
+
+import (
+	"slices"
+	"strings"
+)
+
+// IDPButton contains the settings of the button of an identity provider on the login screen,
+// the buttons are shown in the order of the list.
+type IDPButton struct {
+	IDPID string `json:"idpId"`
+	// Domains restricts the visibility of the button to login names (e.g. emails) with one of the domains,
+	// the button is always visible if empty
+	Domains []string `json:"domains,omitempty"`
+}
+
+func (b *IDPButton) IsValid() bool {
+	if b.IDPID == "" {
+		return false
+	}
+	for _, domain := range b.Domains {
+		if domain == "" || strings.ContainsAny(domain, "@/ ") {
+			return false
+		}
+	}
+	return true
+}
+
+// Normalize trims the domains and converts them to lower case
+func (b *IDPButton) Normalize() {
+	for i, domain := range b.Domains {
+		b.Domains[i] = strings.ToLower(strings.TrimSpace(domain))
+	}
+}
+
+// ApplyIDPButtons sorts the providers by the order of the buttons and sets their visibility rules and custom icons.
+// Providers without button are shown after the others in their original order.
+func ApplyIDPButtons(providers []*IDPProvider, buttons []*IDPButton, icons map[string]string) {
+	for _, provider := range providers {
+		provider.IconKey = icons[provider.IDPConfigID]
+		index := slices.IndexFunc(buttons, func(button *IDPButton) bool {
+			return button.IDPID == provider.IDPConfigID
+		})
+		if index >= 0 {
+			provider.Domains = buttons[index].Domains
+		}
+	}
+	slices.SortStableFunc(providers, func(a, b *IDPProvider) int {
+		return idpButtonIndex(buttons, a.IDPConfigID) - idpButtonIndex(buttons, b.IDPConfigID)
+	})
+}
+
+func idpButtonIndex(buttons []*IDPButton, idpID string) int {
+	index := slices.IndexFunc(buttons, func(button *IDPButton) bool {
+		return button.IDPID == idpID
+	})
+	if index < 0 {
+		return len(buttons)
+	}
+	return index
+}
+
+// VisibleIDPProviders returns the providers whose button is visible for the login name entered by the user
+func VisibleIDPProviders(providers []*IDPProvider, loginName string) []*IDPProvider {
+	visible := make([]*IDPProvider, 0, len(providers))
+	for _, provider := range providers {
+		if len(provider.Domains) == 0 || provider.DiscoveredBy(loginName) {
+			visible = append(visible, provider)
+		}
+	}
+	return visible
+}
+
+// DiscoveredIDPProviders returns the providers whose button is restricted to the domain of the login name (home realm discovery)
+func DiscoveredIDPProviders(providers []*IDPProvider, loginName string) []*IDPProvider {
+	discovered := make([]*IDPProvider, 0, len(providers))
+	for _, provider := range providers {
+		if provider.DiscoveredBy(loginName) {
+			discovered = append(discovered, provider)
+		}
+	}
+	return discovered
+}
+
+// DiscoveredBy returns true if the button of the provider is restricted to the domain of the login name
+func (p IDPProvider) DiscoveredBy(loginName string) bool {
+	domain := loginNameDomain(loginName)
+	return domain != "" && slices.Contains(p.Domains, domain)
+}
+
+func loginNameDomain(loginName string) string {
+	index := strings.LastIndex(loginName, "@")
+	if index < 0 {
+		return ""
+	}
+	return strings.ToLower(strings.TrimSpace(loginName[index+1:]))
+}
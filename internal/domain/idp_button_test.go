@@ -0,0 +1,110 @@
+package domain
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIDPButton_IsValid(t *testing.T) {
+	tests := []struct {
+		name   string
+		button *IDPButton
+		want   bool
+	}{
+		{
+			name:   "without domains",
+			button: &IDPButton{IDPID: "idp1"},
+			want:   true,
+		},
+		{
+			name:   "with domains",
+			button: &IDPButton{IDPID: "idp1", Domains: []string{"zitadel.com", "zitadel.ch"}},
+			want:   true,
+		},
+		{
+			name:   "missing id",
+			button: &IDPButton{Domains: []string{"zitadel.com"}},
+			want:   false,
+		},
+		{
+			name:   "empty domain",
+			button: &IDPButton{IDPID: "idp1", Domains: []string{""}},
+			want:   false,
+		},
+		{
+			name:   "email as domain",
+			button: &IDPButton{IDPID: "idp1", Domains: []string{"user@zitadel.com"}},
+			want:   false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, tt.button.IsValid())
+		})
+	}
+}
+
+func TestApplyIDPButtons(t *testing.T) {
+	providers := []*IDPProvider{
+		{IDPConfigID: "idp1"},
+		{IDPConfigID: "idp2"},
+		{IDPConfigID: "idp3"},
+		{IDPConfigID: "idp4"},
+	}
+	ApplyIDPButtons(providers,
+		[]*IDPButton{
+			{IDPID: "idp3", Domains: []string{"zitadel.com"}},
+			{IDPID: "idp2"},
+		},
+		map[string]string{"idp2": "icon"},
+	)
+	assert.Equal(t, []*IDPProvider{
+		{IDPConfigID: "idp3", Domains: []string{"zitadel.com"}},
+		{IDPConfigID: "idp2", IconKey: "icon"},
+		{IDPConfigID: "idp1"},
+		{IDPConfigID: "idp4"},
+	}, providers)
+}
+
+func TestVisibleIDPProviders(t *testing.T) {
+	always := &IDPProvider{IDPConfigID: "idp1"}
+	restricted := &IDPProvider{IDPConfigID: "idp2", Domains: []string{"zitadel.com"}}
+	providers := []*IDPProvider{always, restricted}
+	tests := []struct {
+		name           string
+		loginName      string
+		wantVisible    []*IDPProvider
+		wantDiscovered []*IDPProvider
+	}{
+		{
+			name:           "no login name",
+			wantVisible:    []*IDPProvider{always},
+			wantDiscovered: []*IDPProvider{},
+		},
+		{
+			name:           "username",
+			loginName:      "user",
+			wantVisible:    []*IDPProvider{always},
+			wantDiscovered: []*IDPProvider{},
+		},
+		{
+			name:           "other domain",
+			loginName:      "user@zitadel.ch",
+			wantVisible:    []*IDPProvider{always},
+			wantDiscovered: []*IDPProvider{},
+		},
+		{
+			name:           "matching domain",
+			loginName:      "User@Zitadel.com",
+			wantVisible:    []*IDPProvider{always, restricted},
+			wantDiscovered: []*IDPProvider{restricted},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.wantVisible, VisibleIDPProviders(providers, tt.loginName))
+			assert.Equal(t, tt.wantDiscovered, DiscoveredIDPProviders(providers, tt.loginName))
+		})
+	}
+}
@@ -0,0 +1,59 @@
+package domain
+
+import (
+	"time"
+)
+
+type IDPHealthCheckType int32
+
+const (
+	IDPHealthCheckTypeUnspecified IDPHealthCheckType = iota
+	// IDPHealthCheckTypeConfiguration checks that the provider can be created from its configuration,
+	// which includes the discovery of OIDC based providers
+	IDPHealthCheckTypeConfiguration
+	// IDPHealthCheckTypeMetadata checks that the metadata (e.g. discovery document or keys) can be fetched
+	IDPHealthCheckTypeMetadata
+	// IDPHealthCheckTypeTokenEndpoint checks that the token endpoint is reachable
+	IDPHealthCheckTypeTokenEndpoint
+	// IDPHealthCheckTypeCertificate checks the expiration of a certificate
+	IDPHealthCheckTypeCertificate
+	// IDPHealthCheckTypeConnection checks that a connection (incl. bind) to a (LDAP) server is possible
+	IDPHealthCheckTypeConnection
+
+	idpHealthCheckTypeCount
+)
+
+func (t IDPHealthCheckType) Valid() bool {
+	return t > IDPHealthCheckTypeUnspecified && t < idpHealthCheckTypeCount
+}
+
+// IDPHealthCheck is the result of a single check of an identity provider
+type IDPHealthCheck struct {
+	Type IDPHealthCheckType `json:"type"`
+	// Target is the checked endpoint, server or certificate subject
+	Target string `json:"target,omitempty"`
+	// Error is empty if the check succeeded
+	Error string `json:"error,omitempty"`
+	// ExpirationDate is set for certificates
+	ExpirationDate time.Time `json:"expirationDate,omitempty"`
+}
+
+// Failed returns true if the check returned an error
+// or the certificate expires within the expiry warning duration
+func (c *IDPHealthCheck) Failed(expiryWarning time.Duration) bool {
+	if c.Error != "" {
+		return true
+	}
+	return !c.ExpirationDate.IsZero() && time.Until(c.ExpirationDate) < expiryWarning
+}
+
+// FailedIDPHealthChecks returns the failed checks, an empty list means the identity provider is healthy
+func FailedIDPHealthChecks(checks []*IDPHealthCheck, expiryWarning time.Duration) []*IDPHealthCheck {
+	failed := make([]*IDPHealthCheck, 0, len(checks))
+	for _, check := range checks {
+		if check.Failed(expiryWarning) {
+			failed = append(failed, check)
+		}
+	}
+	return failed
+}
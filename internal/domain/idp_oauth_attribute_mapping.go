@@ -0,0 +1,64 @@
+package domain
+
+// OAuthAttributeMapping contains the expressions to map the user information returned by the user endpoint
+// of a generic OAuth 2.0 provider into the attributes of the user, e.g. `.data.attributes.email`
+// or `.given_name + " " + .family_name`.
+// Attributes without an expression are not mapped.
+type OAuthAttributeMapping struct {
+	FirstName         string `json:"firstName,omitempty"`
+	LastName          string `json:"lastName,omitempty"`
+	DisplayName       string `json:"displayName,omitempty"`
+	Nickname          string `json:"nickname,omitempty"`
+	PreferredUsername string `json:"preferredUsername,omitempty"`
+	Email             string `json:"email,omitempty"`
+	EmailVerified     string `json:"emailVerified,omitempty"`
+	Phone             string `json:"phone,omitempty"`
+	PreferredLanguage string `json:"preferredLanguage,omitempty"`
+	AvatarURL         string `json:"avatarUrl,omitempty"`
+	// Metadata maps the key of the user metadata to the expression of its value
+	Metadata map[string]string `json:"metadata,omitempty"`
+}
+
+// IsZero checks if the mapping does not contain any expression.
+func (m *OAuthAttributeMapping) IsZero() bool {
+	if m == nil {
+		return true
+	}
+	return m.FirstName == "" &&
+		m.LastName == "" &&
+		m.DisplayName == "" &&
+		m.Nickname == "" &&
+		m.PreferredUsername == "" &&
+		m.Email == "" &&
+		m.EmailVerified == "" &&
+		m.Phone == "" &&
+		m.PreferredLanguage == "" &&
+		m.AvatarURL == "" &&
+		len(m.Metadata) == 0
+}
+
+// Equal checks if both mappings contain the same expressions.
+func (m *OAuthAttributeMapping) Equal(other *OAuthAttributeMapping) bool {
+	if m.IsZero() || other.IsZero() {
+		return m.IsZero() == other.IsZero()
+	}
+	if m.FirstName != other.FirstName ||
+		m.LastName != other.LastName ||
+		m.DisplayName != other.DisplayName ||
+		m.Nickname != other.Nickname ||
+		m.PreferredUsername != other.PreferredUsername ||
+		m.Email != other.Email ||
+		m.EmailVerified != other.EmailVerified ||
+		m.Phone != other.Phone ||
+		m.PreferredLanguage != other.PreferredLanguage ||
+		m.AvatarURL != other.AvatarURL ||
+		len(m.Metadata) != len(other.Metadata) {
+		return false
+	}
+	for key, expression := range m.Metadata {
+		if otherExpression, ok := other.Metadata[key]; !ok || otherExpression != expression {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,118 @@
+package domain
+
+import (
+	"slices"
+)
+
+// IDPRoleMapping grants roles to federated users if the claim provided by the identity provider contains the value,
+// e.g. all users with the value `admins` in their `groups` claim become owners of the organization.
+// Roles which are part of any mapping are managed by the identity provider:
+// they are added and removed on every federated login according to the claims of the user.
+type IDPRoleMapping struct {
+	// Claim is the name of the claim or attribute provided by the identity provider
+	Claim string `json:"claim"`
+	Value string `json:"value"`
+	// OrgRoles are the roles of the membership of the user in its organization, e.g. ORG_OWNER
+	OrgRoles []string `json:"orgRoles,omitempty"`
+	// ProjectID and ProjectRoleKeys are the roles of the user grant for the project
+	ProjectID string `json:"projectId,omitempty"`
+	// ProjectGrantID is required if the project is granted to the organization of the user
+	ProjectGrantID  string   `json:"projectGrantId,omitempty"`
+	ProjectRoleKeys []string `json:"projectRoleKeys,omitempty"`
+}
+
+func (m *IDPRoleMapping) IsValid() bool {
+	if m.Claim == "" || m.Value == "" {
+		return false
+	}
+	if slices.Contains(m.OrgRoles, "") || slices.Contains(m.ProjectRoleKeys, "") {
+		return false
+	}
+	if (m.ProjectID == "") != (len(m.ProjectRoleKeys) == 0) {
+		return false
+	}
+	if m.ProjectID == "" && m.ProjectGrantID != "" {
+		return false
+	}
+	return len(m.OrgRoles) > 0 || m.ProjectID != ""
+}
+
+// Matches checks if the claims of the user contain the value of the mapping.
+func (m *IDPRoleMapping) Matches(claims map[string][]string) bool {
+	return slices.Contains(claims[m.Claim], m.Value)
+}
+
+// IDPRoleMappingProject identifies the user grant managed by the mappings.
+type IDPRoleMappingProject struct {
+	ProjectID      string
+	ProjectGrantID string
+}
+
+// IDPRoleMappingClaims returns the distinct names of the claims used by the mappings.
+func IDPRoleMappingClaims(mappings []*IDPRoleMapping) []string {
+	claims := make([]string, 0, len(mappings))
+	for _, mapping := range mappings {
+		if !slices.Contains(claims, mapping.Claim) {
+			claims = append(claims, mapping.Claim)
+		}
+	}
+	return claims
+}
+
+// IDPRoleMappingProjects returns the distinct projects the mappings grant roles for.
+func IDPRoleMappingProjects(mappings []*IDPRoleMapping) []IDPRoleMappingProject {
+	projects := make([]IDPRoleMappingProject, 0, len(mappings))
+	for _, mapping := range mappings {
+		if mapping.ProjectID == "" {
+			continue
+		}
+		project := IDPRoleMappingProject{ProjectID: mapping.ProjectID, ProjectGrantID: mapping.ProjectGrantID}
+		if !slices.Contains(projects, project) {
+			projects = append(projects, project)
+		}
+	}
+	return projects
+}
+
+// IDPRoleMappingOrgRoles returns the roles of the org membership after applying the mappings to the current roles.
+func IDPRoleMappingOrgRoles(mappings []*IDPRoleMapping, claims map[string][]string, current []string) []string {
+	var managed, granted []string
+	for _, mapping := range mappings {
+		managed = append(managed, mapping.OrgRoles...)
+		if mapping.Matches(claims) {
+			granted = append(granted, mapping.OrgRoles...)
+		}
+	}
+	return applyIDPRoleMapping(current, managed, granted)
+}
+
+// IDPRoleMappingProjectRoles returns the roles of the user grant of the project after applying the mappings to the current roles.
+func IDPRoleMappingProjectRoles(mappings []*IDPRoleMapping, claims map[string][]string, project IDPRoleMappingProject, current []string) []string {
+	var managed, granted []string
+	for _, mapping := range mappings {
+		if mapping.ProjectID != project.ProjectID || mapping.ProjectGrantID != project.ProjectGrantID {
+			continue
+		}
+		managed = append(managed, mapping.ProjectRoleKeys...)
+		if mapping.Matches(claims) {
+			granted = append(granted, mapping.ProjectRoleKeys...)
+		}
+	}
+	return applyIDPRoleMapping(current, managed, granted)
+}
+
+// applyIDPRoleMapping keeps all current roles which are not managed by the mappings and adds the granted roles.
+func applyIDPRoleMapping(current, managed, granted []string) []string {
+	roles := make([]string, 0, len(current)+len(granted))
+	for _, role := range current {
+		if !slices.Contains(managed, role) && !slices.Contains(roles, role) {
+			roles = append(roles, role)
+		}
+	}
+	for _, role := range granted {
+		if !slices.Contains(roles, role) {
+			roles = append(roles, role)
+		}
+	}
+	return roles
+}
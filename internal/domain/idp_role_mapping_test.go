@@ -0,0 +1,198 @@
+package domain
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIDPRoleMapping_IsValid(t *testing.T) {
+	tests := []struct {
+		name    string
+		mapping *IDPRoleMapping
+		want    bool
+	}{
+		{
+			name: "org roles",
+			mapping: &IDPRoleMapping{
+				Claim:    "groups",
+				Value:    "admins",
+				OrgRoles: []string{"ORG_OWNER"},
+			},
+			want: true,
+		},
+		{
+			name: "project roles",
+			mapping: &IDPRoleMapping{
+				Claim:           "groups",
+				Value:           "developers",
+				ProjectID:       "project1",
+				ProjectGrantID:  "grant1",
+				ProjectRoleKeys: []string{"developer"},
+			},
+			want: true,
+		},
+		{
+			name: "missing claim",
+			mapping: &IDPRoleMapping{
+				Value:    "admins",
+				OrgRoles: []string{"ORG_OWNER"},
+			},
+			want: false,
+		},
+		{
+			name: "missing value",
+			mapping: &IDPRoleMapping{
+				Claim:    "groups",
+				OrgRoles: []string{"ORG_OWNER"},
+			},
+			want: false,
+		},
+		{
+			name: "no roles",
+			mapping: &IDPRoleMapping{
+				Claim: "groups",
+				Value: "admins",
+			},
+			want: false,
+		},
+		{
+			name: "empty org role",
+			mapping: &IDPRoleMapping{
+				Claim:    "groups",
+				Value:    "admins",
+				OrgRoles: []string{""},
+			},
+			want: false,
+		},
+		{
+			name: "project without roles",
+			mapping: &IDPRoleMapping{
+				Claim:     "groups",
+				Value:     "developers",
+				ProjectID: "project1",
+			},
+			want: false,
+		},
+		{
+			name: "roles without project",
+			mapping: &IDPRoleMapping{
+				Claim:           "groups",
+				Value:           "developers",
+				ProjectRoleKeys: []string{"developer"},
+			},
+			want: false,
+		},
+		{
+			name: "project grant without project",
+			mapping: &IDPRoleMapping{
+				Claim:          "groups",
+				Value:          "developers",
+				OrgRoles:       []string{"ORG_OWNER"},
+				ProjectGrantID: "grant1",
+			},
+			want: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, tt.mapping.IsValid())
+		})
+	}
+}
+
+func TestIDPRoleMappingOrgRoles(t *testing.T) {
+	mappings := []*IDPRoleMapping{
+		{Claim: "groups", Value: "admins", OrgRoles: []string{"ORG_OWNER"}},
+		{Claim: "groups", Value: "support", OrgRoles: []string{"ORG_USER_MANAGER"}},
+		{Claim: "groups", Value: "developers", ProjectID: "project1", ProjectRoleKeys: []string{"developer"}},
+	}
+	tests := []struct {
+		name    string
+		claims  map[string][]string
+		current []string
+		want    []string
+	}{
+		{
+			name:    "no claims, no roles",
+			claims:  nil,
+			current: nil,
+			want:    []string{},
+		},
+		{
+			name:    "matching claim added",
+			claims:  map[string][]string{"groups": {"admins", "developers"}},
+			current: nil,
+			want:    []string{"ORG_OWNER"},
+		},
+		{
+			name:    "unmanaged roles kept",
+			claims:  map[string][]string{"groups": {"support"}},
+			current: []string{"ORG_OWNER_VIEWER"},
+			want:    []string{"ORG_OWNER_VIEWER", "ORG_USER_MANAGER"},
+		},
+		{
+			name:    "managed role removed",
+			claims:  map[string][]string{"groups": {"support"}},
+			current: []string{"ORG_OWNER", "ORG_USER_MANAGER"},
+			want:    []string{"ORG_USER_MANAGER"},
+		},
+		{
+			name:    "other claim ignored",
+			claims:  map[string][]string{"roles": {"admins"}},
+			current: []string{"ORG_OWNER"},
+			want:    []string{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, IDPRoleMappingOrgRoles(mappings, tt.claims, tt.current))
+		})
+	}
+}
+
+func TestIDPRoleMappingProjectRoles(t *testing.T) {
+	mappings := []*IDPRoleMapping{
+		{Claim: "groups", Value: "admins", OrgRoles: []string{"ORG_OWNER"}, ProjectID: "project1", ProjectRoleKeys: []string{"admin"}},
+		{Claim: "groups", Value: "developers", ProjectID: "project1", ProjectRoleKeys: []string{"developer"}},
+		{Claim: "groups", Value: "developers", ProjectID: "project2", ProjectGrantID: "grant2", ProjectRoleKeys: []string{"developer"}},
+	}
+	assert.Equal(t,
+		[]IDPRoleMappingProject{{ProjectID: "project1"}, {ProjectID: "project2", ProjectGrantID: "grant2"}},
+		IDPRoleMappingProjects(mappings),
+	)
+	tests := []struct {
+		name    string
+		project IDPRoleMappingProject
+		claims  map[string][]string
+		current []string
+		want    []string
+	}{
+		{
+			name:    "matching claims added",
+			project: IDPRoleMappingProject{ProjectID: "project1"},
+			claims:  map[string][]string{"groups": {"admins", "developers"}},
+			current: nil,
+			want:    []string{"admin", "developer"},
+		},
+		{
+			name:    "managed role removed, unmanaged kept",
+			project: IDPRoleMappingProject{ProjectID: "project1"},
+			claims:  map[string][]string{"groups": {"developers"}},
+			current: []string{"admin", "viewer"},
+			want:    []string{"viewer", "developer"},
+		},
+		{
+			name:    "project grant",
+			project: IDPRoleMappingProject{ProjectID: "project2", ProjectGrantID: "grant2"},
+			claims:  map[string][]string{"groups": {"admins"}},
+			current: []string{"developer"},
+			want:    []string{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, IDPRoleMappingProjectRoles(mappings, tt.claims, tt.project, tt.current))
+		})
+	}
+}
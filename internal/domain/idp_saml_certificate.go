@@ -0,0 +1,35 @@
+package domain
+
+import (
+	"slices"
+	"time"
+)
+
+// SAMLSigningCertificate is a certificate of a SAML identity provider used to sign its responses,
+// as published in the metadata of the identity provider
+type SAMLSigningCertificate struct {
+	// Fingerprint is the hex encoded SHA-256 hash of the DER encoded certificate
+	Fingerprint string    `json:"fingerprint"`
+	Subject     string    `json:"subject,omitempty"`
+	NotAfter    time.Time `json:"notAfter"`
+}
+
+// ExpiresWithin returns true if the certificate is expired or expires within the duration
+func (c *SAMLSigningCertificate) ExpiresWithin(d time.Duration) bool {
+	return time.Now().Add(d).After(c.NotAfter)
+}
+
+// SAMLSigningCertificatesChanged returns true if a certificate was added or removed
+func SAMLSigningCertificatesChanged(previous, current []*SAMLSigningCertificate) bool {
+	if len(previous) != len(current) {
+		return true
+	}
+	for _, certificate := range current {
+		if !slices.ContainsFunc(previous, func(c *SAMLSigningCertificate) bool {
+			return c.Fingerprint == certificate.Fingerprint
+		}) {
+			return true
+		}
+	}
+	return false
+}
@@ -36,3 +36,21 @@ func NewGeneratedInstanceDomain(instanceName, iamDomain string) (string, error)
 	instanceName = strings.TrimSpace(instanceName)
 	return strings.ToLower(strings.ReplaceAll(instanceName, " ", "-") + "-" + randomString + "." + iamDomain), nil
 }
+
+// wildcardInstanceDomainPrefix marks an instance domain which matches all direct subdomains, e.g. *.example.com
+const wildcardInstanceDomainPrefix = "*."
+
+// IsWildcardInstanceDomain returns true if the instance domain matches all direct subdomains
+func IsWildcardInstanceDomain(instanceDomain string) bool {
+	return strings.HasPrefix(instanceDomain, wildcardInstanceDomainPrefix)
+}
+
+// WildcardInstanceDomain returns the wildcard domain which matches the requested domain,
+// e.g. *.example.com for login.example.com, or an empty string if there is none.
+func WildcardInstanceDomain(requestedDomain string) string {
+	_, parent, found := strings.Cut(requestedDomain, ".")
+	if !found || !strings.Contains(parent, ".") {
+		return ""
+	}
+	return wildcardInstanceDomainPrefix + parent
+}
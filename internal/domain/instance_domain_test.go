@@ -0,0 +1,41 @@
+package domain
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWildcardInstanceDomain(t *testing.T) {
+	tests := []struct {
+		name            string
+		requestedDomain string
+		want            string
+	}{
+		{
+			name:            "subdomain",
+			requestedDomain: "login.example.com",
+			want:            "*.example.com",
+		},
+		{
+			name:            "nested subdomain, only direct parent",
+			requestedDomain: "a.login.example.com",
+			want:            "*.login.example.com",
+		},
+		{
+			name:            "second level domain, no wildcard",
+			requestedDomain: "example.com",
+			want:            "",
+		},
+		{
+			name:            "localhost, no wildcard",
+			requestedDomain: "localhost",
+			want:            "",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, WildcardInstanceDomain(tt.requestedDomain))
+		})
+	}
+}
@@ -0,0 +1,24 @@
+package domain
+
+import "time"
+
+// UsageUnit is a metered unit of the usage of an instance
+type UsageUnit int32
+
+const (
+	UsageUnitUnspecified UsageUnit = iota
+	// UsageUnitAuthentications counts the successful authentications of users
+	UsageUnitAuthentications
+	// UsageUnitActiveUsers counts the distinct users which were active in a period
+	UsageUnitActiveUsers
+	// UsageUnitRequests counts the authenticated API requests
+	UsageUnitRequests
+	// UsageUnitActionRunSeconds sums the run durations of actions in seconds
+	UsageUnitActionRunSeconds
+)
+
+// UsagePeriodStart returns the start of the monthly metering period of t
+func UsagePeriodStart(t time.Time) time.Time {
+	t = t.UTC()
+	return time.Date(t.Year(), t.Month(), 1, 0, 0, 0, 0, time.UTC)
+}
@@ -0,0 +1,58 @@
+package domain
+
+type LogoutTargetType int32
+
+const (
+	LogoutTargetTypeUnspecified LogoutTargetType = iota
+	// LogoutTargetTypeOIDCBackChannel is notified by ZITADEL directly with a logout token.
+	LogoutTargetTypeOIDCBackChannel
+	// LogoutTargetTypeOIDCFrontChannel is notified by the browser of the user.
+	LogoutTargetTypeOIDCFrontChannel
+	// LogoutTargetTypeSAMLIDP is a federated SAML identity provider
+	// the user is logged out from by a single logout request through the browser.
+	LogoutTargetTypeSAMLIDP
+)
+
+func (t LogoutTargetType) Valid() bool {
+	return t > LogoutTargetTypeUnspecified && t <= LogoutTargetTypeSAMLIDP
+}
+
+// IsBrowserDelegated returns true if the logout of the target is delegated to the browser of the user.
+func (t LogoutTargetType) IsBrowserDelegated() bool {
+	return t == LogoutTargetTypeOIDCFrontChannel || t == LogoutTargetTypeSAMLIDP
+}
+
+type LogoutTargetState int32
+
+const (
+	LogoutTargetStateUnspecified LogoutTargetState = iota
+	LogoutTargetStatePending
+	LogoutTargetStateSucceeded
+	LogoutTargetStateFailed
+	// LogoutTargetStateDelegated is reported if the logout was handed to the browser,
+	// the result is not known to ZITADEL.
+	LogoutTargetStateDelegated
+)
+
+// IsReported returns true if the state is a result of the logout of the target.
+func (s LogoutTargetState) IsReported() bool {
+	return s == LogoutTargetStateSucceeded || s == LogoutTargetStateFailed || s == LogoutTargetStateDelegated
+}
+
+// IsFinal returns true if the result of the logout of the target is known.
+// Delegated targets can still be reported with their result by the browser of the user.
+func (s LogoutTargetState) IsFinal() bool {
+	return s == LogoutTargetStateSucceeded || s == LogoutTargetStateFailed
+}
+
+// LogoutTarget is a relying party or identity provider which is logged out
+// when the session of the user is terminated.
+type LogoutTarget struct {
+	// ID is the client id of the relying party or the id of the identity provider
+	ID   string           `json:"id"`
+	Type LogoutTargetType `json:"type"`
+	// URI is the logout endpoint of relying parties
+	URI string `json:"uri,omitempty"`
+	// Subject is the id of the user at the identity provider
+	Subject string `json:"subject,omitempty"`
+}
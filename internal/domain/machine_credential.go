@@ -0,0 +1,10 @@
+package domain
+
+// MachineCredentialType is the kind of credential a machine user authenticates with
+type MachineCredentialType int32
+
+const (
+	MachineCredentialTypeUnspecified MachineCredentialType = iota
+	MachineCredentialTypeKey
+	MachineCredentialTypePersonalAccessToken
+)
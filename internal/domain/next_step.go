@@ -29,6 +29,10 @@ const (
 	NextStepProjectRequired
 	NextStepRedirectToExternalIDP
 	NextStepLoginSucceeded
+	NextStepAcceptTerms
+	NextStepConsent
+	NextStepCompleteProfile
+	NextStepPasskeyPrompt
 )
 
 type LoginStep struct{}
@@ -189,3 +193,41 @@ type LoginSucceededStep struct{}
 func (s *LoginSucceededStep) Type() NextStepType {
 	return NextStepLoginSucceeded
 }
+
+type AcceptTermsStep struct {
+	Version     string
+	TOSLink     string
+	PrivacyLink string
+}
+
+func (s *AcceptTermsStep) Type() NextStepType {
+	return NextStepAcceptTerms
+}
+
+type ConsentStep struct {
+	AppID  string
+	Scopes []string
+}
+
+func (s *ConsentStep) Type() NextStepType {
+	return NextStepConsent
+}
+
+// CompleteProfileStep asks the user for the profile fields and custom attributes
+// the organization requires, but which are missing for the user.
+type CompleteProfileStep struct {
+	Fields     []ProfileField
+	Attributes []*UserAttribute
+}
+
+func (s *CompleteProfileStep) Type() NextStepType {
+	return NextStepCompleteProfile
+}
+
+// PasskeyPromptStep asks a user, who logged in with the password, to register a passkey.
+// The user can accept, be reminded later or opt out.
+type PasskeyPromptStep struct{}
+
+func (s *PasskeyPromptStep) Type() NextStepType {
+	return NextStepPasskeyPrompt
+}
@@ -31,3 +31,29 @@ const (
 
 	notificationProviderTypeCount
 )
+
+type NotificationDeliveryStatus int32
+
+const (
+	NotificationDeliveryStatusUnspecified NotificationDeliveryStatus = iota
+	// NotificationDeliveryStatusSent is set as soon as the provider accepted the message
+	NotificationDeliveryStatusSent
+	// NotificationDeliveryStatusFailed is set if the provider did not accept the message
+	NotificationDeliveryStatusFailed
+	// NotificationDeliveryStatusDelivered is set if the provider reported a successful delivery receipt
+	NotificationDeliveryStatusDelivered
+	// NotificationDeliveryStatusUndelivered is set if the provider reported a failed delivery receipt
+	NotificationDeliveryStatusUndelivered
+)
+
+type NotificationQueueState int32
+
+const (
+	NotificationQueueStateUnspecified NotificationQueueState = iota
+	// NotificationQueueStatePending is set as long as the notification is retried
+	NotificationQueueStatePending
+	// NotificationQueueStateDeadLettered is set if all attempts failed
+	NotificationQueueStateDeadLettered
+	// NotificationQueueStateSent is set if a retry was successful
+	NotificationQueueStateSent
+)
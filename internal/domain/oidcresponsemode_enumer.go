@@ -7,11 +7,11 @@ import (
 	"strings"
 )
 
-const _OIDCResponseModeName = "unspecifiedqueryfragmentform_post"
+const _OIDCResponseModeName = "unspecifiedqueryfragmentform_postweb_message"
 
-var _OIDCResponseModeIndex = [...]uint8{0, 11, 16, 24, 33}
+var _OIDCResponseModeIndex = [...]uint8{0, 11, 16, 24, 33, 44}
 
-const _OIDCResponseModeLowerName = "unspecifiedqueryfragmentform_post"
+const _OIDCResponseModeLowerName = "unspecifiedqueryfragmentform_postweb_message"
 
 func (i OIDCResponseMode) String() string {
 	if i < 0 || i >= OIDCResponseMode(len(_OIDCResponseModeIndex)-1) {
@@ -28,9 +28,10 @@ func _OIDCResponseModeNoOp() {
 	_ = x[OIDCResponseModeQuery-(1)]
 	_ = x[OIDCResponseModeFragment-(2)]
 	_ = x[OIDCResponseModeFormPost-(3)]
+	_ = x[OIDCResponseModeWebMessage-(4)]
 }
 
-var _OIDCResponseModeValues = []OIDCResponseMode{OIDCResponseModeUnspecified, OIDCResponseModeQuery, OIDCResponseModeFragment, OIDCResponseModeFormPost}
+var _OIDCResponseModeValues = []OIDCResponseMode{OIDCResponseModeUnspecified, OIDCResponseModeQuery, OIDCResponseModeFragment, OIDCResponseModeFormPost, OIDCResponseModeWebMessage}
 
 var _OIDCResponseModeNameToValueMap = map[string]OIDCResponseMode{
 	_OIDCResponseModeName[0:11]:       OIDCResponseModeUnspecified,
@@ -41,6 +42,8 @@ var _OIDCResponseModeNameToValueMap = map[string]OIDCResponseMode{
 	_OIDCResponseModeLowerName[16:24]: OIDCResponseModeFragment,
 	_OIDCResponseModeName[24:33]:      OIDCResponseModeFormPost,
 	_OIDCResponseModeLowerName[24:33]: OIDCResponseModeFormPost,
+	_OIDCResponseModeName[33:44]:      OIDCResponseModeWebMessage,
+	_OIDCResponseModeLowerName[33:44]: OIDCResponseModeWebMessage,
 }
 
 var _OIDCResponseModeNames = []string{
@@ -48,6 +51,7 @@ var _OIDCResponseModeNames = []string{
 	_OIDCResponseModeName[11:16],
 	_OIDCResponseModeName[16:24],
 	_OIDCResponseModeName[24:33],
+	_OIDCResponseModeName[33:44],
 }
 
 // OIDCResponseModeString retrieves an enum value from the enum constants string name.
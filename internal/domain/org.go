@@ -36,6 +36,7 @@ const (
 	OrgStateActive
 	OrgStateInactive
 	OrgStateRemoved
+	OrgStateArchived
 
 	orgStateMax
 )
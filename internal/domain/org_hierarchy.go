@@ -0,0 +1,83 @@
+package domain
+
+import (
+	"slices"
+)
+
+// MaxOrgHierarchyDepth is the maximum number of levels of the organization hierarchy, including the root organization.
+const MaxOrgHierarchyDepth = 10
+
+// OrgHierarchy maps the organizations of an instance to their parent organization.
+// Organizations without parent are the roots of the hierarchy.
+type OrgHierarchy map[string]string
+
+// SetParent links the organization to the parent organization.
+func (h OrgHierarchy) SetParent(orgID, parentOrgID string) {
+	h[orgID] = parentOrgID
+}
+
+// Remove removes the organization from the hierarchy, its children become roots.
+func (h OrgHierarchy) Remove(orgID string) {
+	delete(h, orgID)
+	for child, parent := range h {
+		if parent == orgID {
+			delete(h, child)
+		}
+	}
+}
+
+// Parent returns the parent of the organization, empty for roots.
+func (h OrgHierarchy) Parent(orgID string) string {
+	return h[orgID]
+}
+
+// Ancestors returns the parent, grandparent and so forth of the organization, nearest first.
+func (h OrgHierarchy) Ancestors(orgID string) []string {
+	ancestors := make([]string, 0)
+	for parent := h[orgID]; parent != ""; parent = h[parent] {
+		// guard against cycles, which are prevented when setting the parent
+		if parent == orgID || slices.Contains(ancestors, parent) {
+			break
+		}
+		ancestors = append(ancestors, parent)
+	}
+	return ancestors
+}
+
+// Children returns the direct children of the organization, sorted by their ID.
+func (h OrgHierarchy) Children(orgID string) []string {
+	children := make([]string, 0)
+	for child, parent := range h {
+		if parent == orgID {
+			children = append(children, child)
+		}
+	}
+	slices.Sort(children)
+	return children
+}
+
+// Descendants returns the children, grandchildren and so forth of the organization.
+func (h OrgHierarchy) Descendants(orgID string) []string {
+	descendants := make([]string, 0)
+	for queue := h.Children(orgID); len(queue) > 0; queue = queue[1:] {
+		if queue[0] == orgID || slices.Contains(descendants, queue[0]) {
+			continue
+		}
+		descendants = append(descendants, queue[0])
+		queue = append(queue, h.Children(queue[0])...)
+	}
+	return descendants
+}
+
+// Height returns the number of levels below the organization, 0 if it has no children.
+func (h OrgHierarchy) Height(orgID string) int {
+	height := 0
+	for _, descendant := range h.Descendants(orgID) {
+		depth := 0
+		for parent := descendant; parent != orgID && parent != ""; parent = h[parent] {
+			depth++
+		}
+		height = max(height, depth)
+	}
+	return height
+}
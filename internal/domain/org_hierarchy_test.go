@@ -0,0 +1,35 @@
+package domain
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestOrgHierarchy(t *testing.T) {
+	//   root
+	//   ├── unit1
+	//   │   └── team1
+	//   │       └── squad1
+	//   └── unit2
+	hierarchy := OrgHierarchy{}
+	hierarchy.SetParent("unit1", "root")
+	hierarchy.SetParent("unit2", "root")
+	hierarchy.SetParent("team1", "unit1")
+	hierarchy.SetParent("squad1", "team1")
+
+	assert.Equal(t, "unit1", hierarchy.Parent("team1"))
+	assert.Empty(t, hierarchy.Parent("root"))
+	assert.Equal(t, []string{"team1", "unit1", "root"}, hierarchy.Ancestors("squad1"))
+	assert.Empty(t, hierarchy.Ancestors("root"))
+	assert.Equal(t, []string{"unit1", "unit2"}, hierarchy.Children("root"))
+	assert.Equal(t, []string{"unit1", "unit2", "team1", "squad1"}, hierarchy.Descendants("root"))
+	assert.Equal(t, 3, hierarchy.Height("root"))
+	assert.Equal(t, 1, hierarchy.Height("team1"))
+	assert.Equal(t, 0, hierarchy.Height("squad1"))
+
+	hierarchy.Remove("unit1")
+	assert.Empty(t, hierarchy.Parent("team1"))
+	assert.Equal(t, []string{"team1"}, hierarchy.Ancestors("squad1"))
+	assert.Equal(t, []string{"unit2"}, hierarchy.Descendants("root"))
+}
@@ -0,0 +1,32 @@
+package domain
+
+import (
+	"slices"
+)
+
+type OrgInviteState int32
+
+const (
+	OrgInviteStateUnspecified OrgInviteState = iota
+	OrgInviteStateActive
+	OrgInviteStateUsed
+	OrgInviteStateRevoked
+)
+
+// OrgInviteGrant grants the roles of a project to the user registered with the invite.
+type OrgInviteGrant struct {
+	ProjectID string `json:"projectId"`
+	// ProjectGrantID is required if the project is granted to the organization of the invite
+	ProjectGrantID string   `json:"projectGrantId,omitempty"`
+	RoleKeys       []string `json:"roleKeys"`
+}
+
+func (g *OrgInviteGrant) IsValid() bool {
+	return g.ProjectID != "" && len(g.RoleKeys) > 0 && !slices.Contains(g.RoleKeys, "")
+}
+
+// OrgInviteMetadata is set as metadata of the user registered with the invite.
+type OrgInviteMetadata struct {
+	Key   string `json:"key"`
+	Value []byte `json:"value"`
+}
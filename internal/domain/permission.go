@@ -31,6 +31,8 @@ const (
 	PermissionUserRead            = "user.read"
 	PermissionUserDelete          = "user.delete"
 	PermissionUserCredentialWrite = "user.credential.write"
+	PermissionUserImpersonate     = "user.impersonate"
+	PermissionImpersonationRead   = "user.impersonation.read"
 	PermissionSessionWrite        = "session.write"
 	PermissionSessionDelete       = "session.delete"
 )
@@ -0,0 +1,65 @@
+package domain
+
+import (
+	"github.com/zitadel/zitadel/internal/crypto"
+	"github.com/zitadel/zitadel/internal/eventstore/v1/models"
+)
+
+type CaptchaType int32
+
+const (
+	CaptchaTypeUnspecified CaptchaType = iota
+	CaptchaTypeHCaptcha
+	CaptchaTypeReCaptcha
+	CaptchaTypeTurnstile
+
+	captchaTypeCount
+)
+
+func (t CaptchaType) Valid() bool {
+	return t > CaptchaTypeUnspecified && t < captchaTypeCount
+}
+
+// CaptchaEndpoint describes the flows which can be protected by a CAPTCHA challenge.
+type CaptchaEndpoint int32
+
+const (
+	CaptchaEndpointUnspecified CaptchaEndpoint = iota
+	CaptchaEndpointRegister
+	CaptchaEndpointPasswordReset
+	CaptchaEndpointPasswordlessBegin
+	CaptchaEndpointSession
+)
+
+type CaptchaPolicy struct {
+	models.ObjectRoot
+
+	Type                   CaptchaType
+	SiteKey                string
+	Secret                 *crypto.CryptoValue
+	EnabledOnRegister      bool
+	EnabledOnPasswordReset bool
+	EnabledOnPasswordless  bool
+	EnabledOnSession       bool
+	Default                bool
+}
+
+// IsEnabledOn returns whether a CAPTCHA challenge must be solved for the given endpoint.
+func (p *CaptchaPolicy) IsEnabledOn(endpoint CaptchaEndpoint) bool {
+	if p == nil || !p.Type.Valid() {
+		return false
+	}
+	switch endpoint {
+	case CaptchaEndpointRegister:
+		return p.EnabledOnRegister
+	case CaptchaEndpointPasswordReset:
+		return p.EnabledOnPasswordReset
+	case CaptchaEndpointPasswordlessBegin:
+		return p.EnabledOnPasswordless
+	case CaptchaEndpointSession:
+		return p.EnabledOnSession
+	case CaptchaEndpointUnspecified:
+		return false
+	}
+	return false
+}
@@ -2,12 +2,34 @@ package domain
 
 import (
 	"regexp"
+	"strings"
 
 	"github.com/zitadel/zitadel/internal/eventstore/v1/models"
 	"github.com/zitadel/zitadel/internal/zerrors"
 )
 
-var colorRegex = regexp.MustCompile("^$|^#([A-Fa-f0-9]{6}|[A-Fa-f0-9]{3})$")
+var (
+	colorRegex      = regexp.MustCompile("^$|^#([A-Fa-f0-9]{6}|[A-Fa-f0-9]{3})$")
+	cssCommentRegex = regexp.MustCompile(`(?s)/\*.*?\*/`)
+)
+
+// LabelPolicyCustomCSSMaxLength is the maximal length of the custom css of a label policy in bytes
+const LabelPolicyCustomCSSMaxLength = 1 << 15
+
+// forbiddenCSS are the constructs which are not allowed in the custom css of a label policy,
+// as they load external resources, execute scripts or break out of the style sheet
+var forbiddenCSS = []string{
+	"<",
+	"\\",
+	"@import",
+	"@charset",
+	"@namespace",
+	"url(",
+	"image-set(",
+	"expression(",
+	"javascript:",
+	"-moz-binding",
+}
 
 type LabelPolicy struct {
 	models.ObjectRoot
@@ -35,6 +57,10 @@ type LabelPolicy struct {
 	ErrorMsgPopup       bool
 	DisableWatermark    bool
 	ThemeMode           LabelPolicyThemeMode
+
+	// CustomCSS is appended to the generated css variables of the login,
+	// so it can override the variables of the light and dark theme
+	CustomCSS string
 }
 
 type LabelPolicyState int32
@@ -81,5 +107,26 @@ func (f LabelPolicy) IsValid() error {
 	if !colorRegex.MatchString(f.FontColorDark) {
 		return zerrors.ThrowInvalidArgument(nil, "POLICY-3M0fs", "Errors.Policy.Label.Invalid.FontColorDark")
 	}
+	if !isValidLabelPolicyCSS(SanitizeLabelPolicyCSS(f.CustomCSS)) {
+		return zerrors.ThrowInvalidArgument(nil, "POLICY-Xk2pCv8sWn", "Errors.Policy.Label.Invalid.CustomCSS")
+	}
 	return nil
 }
+
+// SanitizeLabelPolicyCSS removes the comments and the surrounding whitespaces of the custom css
+func SanitizeLabelPolicyCSS(css string) string {
+	return strings.TrimSpace(cssCommentRegex.ReplaceAllString(css, ""))
+}
+
+func isValidLabelPolicyCSS(css string) bool {
+	if len(css) > LabelPolicyCustomCSSMaxLength {
+		return false
+	}
+	lower := strings.ToLower(css)
+	for _, forbidden := range forbiddenCSS {
+		if strings.Contains(lower, forbidden) {
+			return false
+		}
+	}
+	return strings.Count(css, "{") == strings.Count(css, "}")
+}
@@ -503,3 +503,86 @@ func TestLabelPolicyFontColorDarkValid(t *testing.T) {
 		})
 	}
 }
+
+func TestLabelPolicyCustomCSSValid(t *testing.T) {
+	type args struct {
+		policy *LabelPolicy
+	}
+	tests := []struct {
+		name string
+		args args
+		err  func(error) bool
+	}{
+		{
+			name: "empty css, valid",
+			args: args{
+				policy: &LabelPolicy{CustomCSS: ""},
+			},
+		},
+		{
+			name: "variable overrides, valid",
+			args: args{
+				policy: &LabelPolicy{CustomCSS: ":root { --zitadel-color-primary-500: rgb(84, 105, 212); } .lgn-dark-theme { --zitadel-color-primary-500: rgb(165, 180, 252); }"},
+			},
+		},
+		{
+			name: "forbidden construct in comment, valid",
+			args: args{
+				policy: &LabelPolicy{CustomCSS: "/* @import is not allowed */ .lgn-button { border-radius: 0; }"},
+			},
+		},
+		{
+			name: "import, invalid",
+			args: args{
+				policy: &LabelPolicy{CustomCSS: "@IMPORT 'https://example.com/style.css';"},
+			},
+			err: zerrors.IsErrorInvalidArgument,
+		},
+		{
+			name: "url, invalid",
+			args: args{
+				policy: &LabelPolicy{CustomCSS: "body { background: url(https://example.com/track.png); }"},
+			},
+			err: zerrors.IsErrorInvalidArgument,
+		},
+		{
+			name: "escaped url, invalid",
+			args: args{
+				policy: &LabelPolicy{CustomCSS: "body { background: \\75rl(https://example.com/track.png); }"},
+			},
+			err: zerrors.IsErrorInvalidArgument,
+		},
+		{
+			name: "closing style tag, invalid",
+			args: args{
+				policy: &LabelPolicy{CustomCSS: "</style><script>alert(1)</script>"},
+			},
+			err: zerrors.IsErrorInvalidArgument,
+		},
+		{
+			name: "unbalanced braces, invalid",
+			args: args{
+				policy: &LabelPolicy{CustomCSS: ".lgn-button { color: red;"},
+			},
+			err: zerrors.IsErrorInvalidArgument,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.args.policy.IsValid()
+			if tt.err == nil {
+				assert.NoError(t, err)
+			}
+			if tt.err != nil && !tt.err(err) {
+				t.Errorf("got wrong err: %v ", err)
+			}
+		})
+	}
+}
+
+func TestSanitizeLabelPolicyCSS(t *testing.T) {
+	assert.Equal(t,
+		".lgn-button { border-radius: 0; }",
+		SanitizeLabelPolicyCSS("\n/* square buttons\n */ .lgn-button { border-radius: 0; }/**/\n"),
+	)
+}
@@ -10,27 +10,49 @@ import (
 type LoginPolicy struct {
 	models.ObjectRoot
 
-	Default                    bool
-	AllowUsernamePassword      bool
-	AllowRegister              bool
-	AllowExternalIDP           bool
-	IDPProviders               []*IDPProvider
-	ForceMFA                   bool
-	ForceMFALocalOnly          bool
-	SecondFactors              []SecondFactorType
-	MultiFactors               []MultiFactorType
-	PasswordlessType           PasswordlessType
-	HidePasswordReset          bool
-	IgnoreUnknownUsernames     bool
-	AllowDomainDiscovery       bool
-	DefaultRedirectURI         string
-	PasswordCheckLifetime      time.Duration
-	ExternalLoginCheckLifetime time.Duration
-	MFAInitSkipLifetime        time.Duration
-	SecondFactorCheckLifetime  time.Duration
-	MultiFactorCheckLifetime   time.Duration
-	DisableLoginWithEmail      bool
-	DisableLoginWithPhone      bool
+	Default                     bool
+	AllowUsernamePassword       bool
+	AllowRegister               bool
+	AllowExternalIDP            bool
+	IDPProviders                []*IDPProvider
+	ForceMFA                    bool
+	ForceMFALocalOnly           bool
+	SecondFactors               []SecondFactorType
+	MultiFactors                []MultiFactorType
+	PasswordlessType            PasswordlessType
+	HidePasswordReset           bool
+	IgnoreUnknownUsernames      bool
+	AllowDomainDiscovery        bool
+	DefaultRedirectURI          string
+	PasswordCheckLifetime       time.Duration
+	ExternalLoginCheckLifetime  time.Duration
+	MFAInitSkipLifetime         time.Duration
+	SecondFactorCheckLifetime   time.Duration
+	MultiFactorCheckLifetime    time.Duration
+	TrustedDeviceLifetime       time.Duration
+	SharedDevice                bool
+	SharedDeviceLifetime        time.Duration
+	SharedDeviceIdleTimeout     time.Duration
+	PasskeyPrompt               bool
+	PasskeyPromptSnoozeLifetime time.Duration
+	DisableLoginWithEmail       bool
+	DisableLoginWithPhone       bool
+}
+
+// ApplySharedDevice restricts the policy for logins on devices shared by multiple persons (e.g. kiosks):
+// devices can't be trusted and the checks are only valid for the fixed lifetime of the session.
+func (p *LoginPolicy) ApplySharedDevice() {
+	if !p.SharedDevice {
+		return
+	}
+	p.TrustedDeviceLifetime = 0
+	if p.SharedDeviceLifetime <= 0 {
+		return
+	}
+	p.PasswordCheckLifetime = min(p.PasswordCheckLifetime, p.SharedDeviceLifetime)
+	p.ExternalLoginCheckLifetime = min(p.ExternalLoginCheckLifetime, p.SharedDeviceLifetime)
+	p.SecondFactorCheckLifetime = min(p.SecondFactorCheckLifetime, p.SharedDeviceLifetime)
+	p.MultiFactorCheckLifetime = min(p.MultiFactorCheckLifetime, p.SharedDeviceLifetime)
 }
 
 func ValidateDefaultRedirectURI(rawURL string) bool {
@@ -60,6 +82,11 @@ type IDPProvider struct {
 	StylingType IDPConfigStylingType // deprecated
 	IDPType     IDPType
 	IDPState    IDPConfigState
+
+	// Domains restricts the visibility of the button on the login screen, see IDPButton
+	Domains []string
+	// IconKey is the store key of the custom icon of the button
+	IconKey string
 }
 
 func (p IDPProvider) IsValid() bool {
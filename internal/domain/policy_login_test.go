@@ -2,6 +2,7 @@ package domain
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 )
@@ -71,3 +72,65 @@ func TestValidateDefaultRedirectURI(t *testing.T) {
 		})
 	}
 }
+
+func TestLoginPolicy_ApplySharedDevice(t *testing.T) {
+	tests := []struct {
+		name   string
+		policy *LoginPolicy
+		want   *LoginPolicy
+	}{
+		{
+			"not shared, unchanged",
+			&LoginPolicy{
+				PasswordCheckLifetime: 240 * time.Hour,
+				TrustedDeviceLifetime: 720 * time.Hour,
+				SharedDeviceLifetime:  time.Hour,
+			},
+			&LoginPolicy{
+				PasswordCheckLifetime: 240 * time.Hour,
+				TrustedDeviceLifetime: 720 * time.Hour,
+				SharedDeviceLifetime:  time.Hour,
+			},
+		},
+		{
+			"shared without lifetime, no trusted devices",
+			&LoginPolicy{
+				SharedDevice:          true,
+				PasswordCheckLifetime: 240 * time.Hour,
+				TrustedDeviceLifetime: 720 * time.Hour,
+			},
+			&LoginPolicy{
+				SharedDevice:          true,
+				PasswordCheckLifetime: 240 * time.Hour,
+			},
+		},
+		{
+			"shared, checks limited",
+			&LoginPolicy{
+				SharedDevice:               true,
+				SharedDeviceLifetime:       8 * time.Hour,
+				PasswordCheckLifetime:      240 * time.Hour,
+				ExternalLoginCheckLifetime: 240 * time.Hour,
+				MFAInitSkipLifetime:        720 * time.Hour,
+				SecondFactorCheckLifetime:  18 * time.Hour,
+				MultiFactorCheckLifetime:   time.Hour,
+				TrustedDeviceLifetime:      720 * time.Hour,
+			},
+			&LoginPolicy{
+				SharedDevice:               true,
+				SharedDeviceLifetime:       8 * time.Hour,
+				PasswordCheckLifetime:      8 * time.Hour,
+				ExternalLoginCheckLifetime: 8 * time.Hour,
+				MFAInitSkipLifetime:        720 * time.Hour,
+				SecondFactorCheckLifetime:  8 * time.Hour,
+				MultiFactorCheckLifetime:   time.Hour,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.policy.ApplySharedDevice()
+			assert.Equal(t, tt.want, tt.policy)
+		})
+	}
+}
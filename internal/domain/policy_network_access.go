@@ -0,0 +1,142 @@
+package domain
+
+import (
+	"net"
+	"slices"
+
+	http_util "github.com/zitadel/zitadel/internal/api/http"
+	"github.com/zitadel/zitadel/internal/geoip"
+)
+
+// NetworkAccessRules restrict the client addresses which are allowed to log in or to receive tokens.
+// The networks are IP addresses or networks in CIDR notation,
+// the countries ISO 3166-1 alpha-2 codes and the ASNs numbers of autonomous systems.
+// Every configured dimension must allow the client, denied entries take precedence over allowed ones.
+type NetworkAccessRules struct {
+	// AllowedCIDRs restrict the access to the listed networks, if empty every network not denied is allowed
+	AllowedCIDRs []string `json:"allowedCidrs,omitempty"`
+	// DeniedCIDRs block the listed networks, they take precedence over the allowed networks
+	DeniedCIDRs      []string `json:"deniedCidrs,omitempty"`
+	AllowedCountries []string `json:"allowedCountries,omitempty"`
+	DeniedCountries  []string `json:"deniedCountries,omitempty"`
+	AllowedASNs      []uint32 `json:"allowedAsns,omitempty"`
+	DeniedASNs       []uint32 `json:"deniedAsns,omitempty"`
+	// MonitorOnly records the attempts which would be blocked without rejecting them
+	MonitorOnly bool `json:"monitorOnly,omitempty"`
+}
+
+func (r *NetworkAccessRules) IsValid() bool {
+	if r == nil ||
+		(len(r.AllowedCIDRs) == 0 && len(r.DeniedCIDRs) == 0 && !r.UsesLocation()) {
+		return false
+	}
+	return http_util.IsIPAllowList(r.AllowedCIDRs) && http_util.IsIPAllowList(r.DeniedCIDRs) &&
+		isCountryList(r.AllowedCountries) && isCountryList(r.DeniedCountries) &&
+		!slices.Contains(r.AllowedASNs, 0) && !slices.Contains(r.DeniedASNs, 0)
+}
+
+// UsesLocation is true if the rules restrict countries or autonomous systems,
+// which requires the location of the client
+func (r *NetworkAccessRules) UsesLocation() bool {
+	return r != nil &&
+		(len(r.AllowedCountries) > 0 || len(r.DeniedCountries) > 0 ||
+			len(r.AllowedASNs) > 0 || len(r.DeniedASNs) > 0)
+}
+
+// IsAllowed checks the client IP and its location against the rules.
+// An unknown IP, country or ASN is only allowed if no allowed entries of the dimension are configured.
+func (r *NetworkAccessRules) IsAllowed(ip net.IP, location *geoip.Location) bool {
+	if r == nil {
+		return true
+	}
+	if location == nil {
+		location = new(geoip.Location)
+	}
+	return isIPAllowed(r.AllowedCIDRs, r.DeniedCIDRs, ip) &&
+		isAllowed(r.AllowedCountries, r.DeniedCountries, location.Country, "") &&
+		isAllowed(r.AllowedASNs, r.DeniedASNs, location.ASN, 0)
+}
+
+// isIPAllowed denies an unknown (nil) IP, if allowed networks are configured
+func isIPAllowed(allowed, denied []string, ip net.IP) bool {
+	var address string
+	if ip != nil {
+		address = ip.String()
+	}
+	if http_util.IsIPListed(denied, address) {
+		return false
+	}
+	return http_util.IsIPAllowed(allowed, address)
+}
+
+func isAllowed[T comparable](allowed, denied []T, value, unknown T) bool {
+	if value == unknown {
+		return len(allowed) == 0
+	}
+	if slices.Contains(denied, value) {
+		return false
+	}
+	return len(allowed) == 0 || slices.Contains(allowed, value)
+}
+
+// NetworkAccessPolicies are the policies of the organization of a user
+// and of the application the user authenticates for
+type NetworkAccessPolicies struct {
+	Org *NetworkAccessRules
+	App *NetworkAccessRules
+}
+
+func (p *NetworkAccessPolicies) UsesLocation() bool {
+	return p.Org.UsesLocation() || p.App.UsesLocation()
+}
+
+// BlockedBy returns the level of the policy which denies the client and if the policy only monitors the attempts.
+// An enforced policy takes precedence over a monitoring one, the policy of the organization is checked first.
+func (p *NetworkAccessPolicies) BlockedBy(ip net.IP, location *geoip.Location) (level NetworkAccessPolicyLevel, monitorOnly bool) {
+	policies := []struct {
+		level NetworkAccessPolicyLevel
+		rules *NetworkAccessRules
+	}{
+		{level: NetworkAccessPolicyLevelOrg, rules: p.Org},
+		{level: NetworkAccessPolicyLevelApp, rules: p.App},
+	}
+	for _, policy := range policies {
+		if policy.rules.IsAllowed(ip, location) {
+			continue
+		}
+		if !policy.rules.MonitorOnly {
+			return policy.level, false
+		}
+		if level == NetworkAccessPolicyLevelUnspecified {
+			level, monitorOnly = policy.level, true
+		}
+	}
+	return level, monitorOnly
+}
+
+func isCountryList(list []string) bool {
+	for _, country := range list {
+		if len(country) != 2 || country[0] < 'A' || country[0] > 'Z' || country[1] < 'A' || country[1] > 'Z' {
+			return false
+		}
+	}
+	return true
+}
+
+// NetworkAccessPhase is the step in which the network access policies were evaluated
+type NetworkAccessPhase int32
+
+const (
+	NetworkAccessPhaseUnspecified NetworkAccessPhase = iota
+	NetworkAccessPhaseLogin
+	NetworkAccessPhaseToken
+)
+
+// NetworkAccessPolicyLevel is the level of the policy which blocked an attempt
+type NetworkAccessPolicyLevel int32
+
+const (
+	NetworkAccessPolicyLevelUnspecified NetworkAccessPolicyLevel = iota
+	NetworkAccessPolicyLevelOrg
+	NetworkAccessPolicyLevelApp
+)
@@ -0,0 +1,219 @@
+package domain
+
+import (
+	"net"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/zitadel/zitadel/internal/geoip"
+)
+
+func TestNetworkAccessRules_IsValid(t *testing.T) {
+	tests := []struct {
+		name  string
+		rules *NetworkAccessRules
+		want  bool
+	}{
+		{
+			name: "nil",
+			want: false,
+		},
+		{
+			name:  "empty",
+			rules: &NetworkAccessRules{},
+			want:  false,
+		},
+		{
+			name: "addresses and networks",
+			rules: &NetworkAccessRules{
+				AllowedCIDRs: []string{"10.0.0.0/8", "2001:db8::/32"},
+				DeniedCIDRs:  []string{"10.0.0.1"},
+			},
+			want: true,
+		},
+		{
+			name: "invalid network",
+			rules: &NetworkAccessRules{
+				DeniedCIDRs: []string{"10.0.0.0/33"},
+			},
+			want: false,
+		},
+		{
+			name: "countries and asns",
+			rules: &NetworkAccessRules{
+				AllowedCountries: []string{"CH", "DE"},
+				DeniedASNs:       []uint32{64496},
+			},
+			want: true,
+		},
+		{
+			name: "lower case country",
+			rules: &NetworkAccessRules{
+				DeniedCountries: []string{"ch"},
+			},
+			want: false,
+		},
+		{
+			name: "asn 0",
+			rules: &NetworkAccessRules{
+				AllowedASNs: []uint32{0},
+			},
+			want: false,
+		},
+		{
+			name: "monitor only without rules",
+			rules: &NetworkAccessRules{
+				MonitorOnly: true,
+			},
+			want: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, tt.rules.IsValid())
+		})
+	}
+}
+
+func TestNetworkAccessRules_IsAllowed(t *testing.T) {
+	tests := []struct {
+		name     string
+		rules    *NetworkAccessRules
+		ip       net.IP
+		location *geoip.Location
+		want     bool
+	}{
+		{
+			name: "no rules",
+			ip:   net.ParseIP("1.2.3.4"),
+			want: true,
+		},
+		{
+			name:  "allowed network",
+			rules: &NetworkAccessRules{AllowedCIDRs: []string{"10.0.0.0/8"}},
+			ip:    net.ParseIP("10.1.2.3"),
+			want:  true,
+		},
+		{
+			name:  "not in allowed network",
+			rules: &NetworkAccessRules{AllowedCIDRs: []string{"10.0.0.0/8"}},
+			ip:    net.ParseIP("1.2.3.4"),
+			want:  false,
+		},
+		{
+			name: "denied wins",
+			rules: &NetworkAccessRules{
+				AllowedCIDRs: []string{"10.0.0.0/8"},
+				DeniedCIDRs:  []string{"10.0.0.0/16"},
+			},
+			ip:   net.ParseIP("10.0.1.2"),
+			want: false,
+		},
+		{
+			name:  "denied address",
+			rules: &NetworkAccessRules{DeniedCIDRs: []string{"1.2.3.4"}},
+			ip:    net.ParseIP("1.2.3.4"),
+			want:  false,
+		},
+		{
+			name:  "unknown ip, deny list only",
+			rules: &NetworkAccessRules{DeniedCIDRs: []string{"1.2.3.4"}},
+			want:  true,
+		},
+		{
+			name:  "unknown ip, allow list",
+			rules: &NetworkAccessRules{AllowedCIDRs: []string{"10.0.0.0/8"}},
+			want:  false,
+		},
+		{
+			name:     "allowed country",
+			rules:    &NetworkAccessRules{AllowedCountries: []string{"CH"}},
+			ip:       net.ParseIP("1.2.3.4"),
+			location: &geoip.Location{Country: "CH", ASN: 13030},
+			want:     true,
+		},
+		{
+			name:     "unknown country, allow list",
+			rules:    &NetworkAccessRules{AllowedCountries: []string{"CH"}},
+			ip:       net.ParseIP("1.2.3.4"),
+			location: &geoip.Location{ASN: 13030},
+			want:     false,
+		},
+		{
+			name: "allowed network, denied asn",
+			rules: &NetworkAccessRules{
+				AllowedCIDRs: []string{"1.0.0.0/8"},
+				DeniedASNs:   []uint32{13030},
+			},
+			ip:       net.ParseIP("1.2.3.4"),
+			location: &geoip.Location{Country: "CH", ASN: 13030},
+			want:     false,
+		},
+		{
+			name:  "no location, deny list only",
+			rules: &NetworkAccessRules{DeniedCountries: []string{"CH"}},
+			ip:    net.ParseIP("1.2.3.4"),
+			want:  true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, tt.rules.IsAllowed(tt.ip, tt.location))
+		})
+	}
+}
+
+func TestNetworkAccessPolicies_BlockedBy(t *testing.T) {
+	tests := []struct {
+		name            string
+		policies        *NetworkAccessPolicies
+		wantLevel       NetworkAccessPolicyLevel
+		wantMonitorOnly bool
+	}{
+		{
+			name:      "no policies",
+			policies:  &NetworkAccessPolicies{},
+			wantLevel: NetworkAccessPolicyLevelUnspecified,
+		},
+		{
+			name: "allowed by both",
+			policies: &NetworkAccessPolicies{
+				Org: &NetworkAccessRules{AllowedCIDRs: []string{"1.0.0.0/8"}},
+				App: &NetworkAccessRules{DeniedCIDRs: []string{"10.0.0.0/8"}},
+			},
+			wantLevel: NetworkAccessPolicyLevelUnspecified,
+		},
+		{
+			name: "blocked by app",
+			policies: &NetworkAccessPolicies{
+				App: &NetworkAccessRules{DeniedCIDRs: []string{"1.2.3.4"}},
+			},
+			wantLevel: NetworkAccessPolicyLevelApp,
+		},
+		{
+			name: "monitored by org, enforced by app",
+			policies: &NetworkAccessPolicies{
+				Org: &NetworkAccessRules{DeniedCIDRs: []string{"1.2.3.4"}, MonitorOnly: true},
+				App: &NetworkAccessRules{DeniedCIDRs: []string{"1.2.3.4"}},
+			},
+			wantLevel: NetworkAccessPolicyLevelApp,
+		},
+		{
+			name: "monitored by org and app",
+			policies: &NetworkAccessPolicies{
+				Org: &NetworkAccessRules{DeniedCIDRs: []string{"1.2.3.4"}, MonitorOnly: true},
+				App: &NetworkAccessRules{DeniedCIDRs: []string{"1.2.3.4"}, MonitorOnly: true},
+			},
+			wantLevel:       NetworkAccessPolicyLevelOrg,
+			wantMonitorOnly: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			level, monitorOnly := tt.policies.BlockedBy(net.ParseIP("1.2.3.4"), nil)
+			assert.Equal(t, tt.wantLevel, level)
+			assert.Equal(t, tt.wantMonitorOnly, monitorOnly)
+		})
+	}
+}
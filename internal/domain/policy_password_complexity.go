@@ -22,6 +22,8 @@ type PasswordComplexityPolicy struct {
 	HasUppercase bool
 	HasNumber    bool
 	HasSymbol    bool
+	// CheckBreached rejects passwords which are known to be part of a data breach
+	CheckBreached bool
 
 	Default bool
 }
@@ -0,0 +1,18 @@
+package domain
+
+// ProfileField is a standard field of the profile of a human user, which an organization can require.
+type ProfileField int32
+
+const (
+	ProfileFieldUnspecified ProfileField = iota
+	ProfileFieldPhone
+	ProfileFieldNickName
+	ProfileFieldGender
+	ProfileFieldPreferredLanguage
+
+	profileFieldCount
+)
+
+func (f ProfileField) Valid() bool {
+	return f > ProfileFieldUnspecified && f < profileFieldCount
+}
@@ -13,6 +13,8 @@ type Project struct {
 	ProjectRoleCheck       bool
 	HasProjectCheck        bool
 	PrivateLabelingSetting PrivateLabelingSetting
+	// ExpectedSequence prevents changes of a project which was changed in the meantime if set
+	ExpectedSequence uint64
 }
 
 type ProjectState int32
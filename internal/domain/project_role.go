@@ -10,6 +10,8 @@ type ProjectRole struct {
 	Key         string
 	DisplayName string
 	Group       string
+	// ExpectedSequence prevents changes of a role which was changed in the meantime if set
+	ExpectedSequence uint64
 }
 
 type ProjectRoleState int32
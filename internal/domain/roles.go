@@ -17,6 +17,8 @@ const (
 	RoleProjectOwner         = "PROJECT_OWNER"
 	RoleProjectOwnerGlobal   = "PROJECT_OWNER_GLOBAL"
 	RoleSelfManagementGlobal = "SELF_MANAGEMENT_GLOBAL"
+	// OrgCustomRolePrefix is the prefix of the roles defined by the organizations themselves
+	OrgCustomRolePrefix = "ORG_CUSTOM_"
 )
 
 func CheckForInvalidRoles(roles []string, rolePrefix string, validRoles []authz.RoleMapping) []string {
@@ -37,3 +39,30 @@ func containsRole(role, rolePrefix string, validRoles []authz.RoleMapping) bool
 	}
 	return false
 }
+
+// SplitOrgCustomRoles separates the custom roles defined by the organization from the roles of the configuration.
+func SplitOrgCustomRoles(roles []string) (configuredRoles, customRoles []string) {
+	for _, role := range roles {
+		if strings.HasPrefix(role, OrgCustomRolePrefix) {
+			customRoles = append(customRoles, role)
+			continue
+		}
+		configuredRoles = append(configuredRoles, role)
+	}
+	return configuredRoles, customRoles
+}
+
+// RolePermissions returns the permissions of the role in the mappings without their context.
+func RolePermissions(role string, roleMappings []authz.RoleMapping) []string {
+	for _, mapping := range roleMappings {
+		if mapping.Role != role {
+			continue
+		}
+		permissions := make([]string, len(mapping.Permissions))
+		for i, permission := range mapping.Permissions {
+			permissions[i], _ = authz.SplitPermission(permission)
+		}
+		return permissions
+	}
+	return nil
+}
@@ -0,0 +1,104 @@
+package domain
+
+import "strings"
+
+// SCIMUserNameSource defines which value of the user is provisioned as userName of the SCIM user
+type SCIMUserNameSource int32
+
+const (
+	SCIMUserNameSourceUnspecified SCIMUserNameSource = iota
+	SCIMUserNameSourceUsername
+	SCIMUserNameSourceEmail
+
+	scimUserNameSourceCount
+)
+
+func (s SCIMUserNameSource) Valid() bool {
+	return s >= 0 && s < scimUserNameSourceCount
+}
+
+// SCIMProvisioningMapping defines how users and their roles are provisioned to a SCIM target
+type SCIMProvisioningMapping struct {
+	// UserName is the source of the userName, the username of the user if unspecified
+	UserName SCIMUserNameSource `json:"userName,omitempty"`
+	// Groups provisions the roles of the project as groups and the users granted a role as their members
+	Groups bool `json:"groups,omitempty"`
+	// Attributes maps metadata of the user to additional attributes of the SCIM user
+	Attributes []SCIMAttributeMapping `json:"attributes,omitempty"`
+}
+
+// SCIMAttributeMapping maps the value of a metadata key of the user to an attribute of the SCIM user.
+// Attributes of schema extensions are prefixed with the schema URN, e.g.
+// urn:ietf:params:scim:schemas:extension:enterprise:2.0:User:department
+type SCIMAttributeMapping struct {
+	MetadataKey string `json:"metadataKey,omitempty"`
+	Attribute   string `json:"attribute,omitempty"`
+}
+
+// scimReservedAttributes are set from the user itself and can't be mapped from metadata
+var scimReservedAttributes = []string{"schemas", "id", "externalid", "username", "name", "displayname", "emails", "active", "meta"}
+
+// Valid checks that every attribute is mapped once from a metadata key and no attribute set from the user itself is overwritten
+func (m *SCIMProvisioningMapping) Valid() bool {
+	if !m.UserName.Valid() {
+		return false
+	}
+	attributes := make(map[string]struct{}, len(m.Attributes))
+	for _, attribute := range m.Attributes {
+		if attribute.MetadataKey == "" || attribute.Attribute == "" {
+			return false
+		}
+		_, name := SplitSCIMAttribute(attribute.Attribute)
+		lowerName := strings.ToLower(name)
+		if name == "" || strings.Contains(lowerName, ".") {
+			return false
+		}
+		for _, reserved := range scimReservedAttributes {
+			if lowerName == reserved && !strings.Contains(attribute.Attribute, ":") {
+				return false
+			}
+		}
+		key := strings.ToLower(attribute.Attribute)
+		if _, ok := attributes[key]; ok {
+			return false
+		}
+		attributes[key] = struct{}{}
+	}
+	return true
+}
+
+// SplitSCIMAttribute splits an attribute into the URN of its schema extension and its name.
+// The schema is empty for attributes of the core user schema.
+func SplitSCIMAttribute(attribute string) (schema, name string) {
+	index := strings.LastIndex(attribute, ":")
+	if index < 0 {
+		return "", attribute
+	}
+	return attribute[:index], attribute[index+1:]
+}
+
+type SCIMTargetState int32
+
+const (
+	SCIMTargetStateUnspecified SCIMTargetState = iota
+	SCIMTargetStateActive
+	SCIMTargetStateRemoved
+
+	scimTargetStateCount
+)
+
+func (s SCIMTargetState) Valid() bool {
+	return s >= 0 && s < scimTargetStateCount
+}
+
+type SCIMSyncState int32
+
+const (
+	SCIMSyncStateUnspecified SCIMSyncState = iota
+	// SCIMSyncStatePending is set as long as the user is synced or retried
+	SCIMSyncStatePending
+	// SCIMSyncStateSynced is set if the last sync was successful
+	SCIMSyncStateSynced
+	// SCIMSyncStateFailed is set if all attempts of the last sync failed
+	SCIMSyncStateFailed
+)
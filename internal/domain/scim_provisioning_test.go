@@ -0,0 +1,86 @@
+package domain
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSCIMProvisioningMapping_Valid(t *testing.T) {
+	tests := []struct {
+		name    string
+		mapping *SCIMProvisioningMapping
+		want    bool
+	}{
+		{
+			name:    "empty",
+			mapping: &SCIMProvisioningMapping{},
+			want:    true,
+		},
+		{
+			name: "invalid user name source",
+			mapping: &SCIMProvisioningMapping{
+				UserName: scimUserNameSourceCount,
+			},
+			want: false,
+		},
+		{
+			name: "core and extension attributes",
+			mapping: &SCIMProvisioningMapping{
+				UserName: SCIMUserNameSourceEmail,
+				Groups:   true,
+				Attributes: []SCIMAttributeMapping{
+					{MetadataKey: "title", Attribute: "title"},
+					{MetadataKey: "department", Attribute: "urn:ietf:params:scim:schemas:extension:enterprise:2.0:User:department"},
+				},
+			},
+			want: true,
+		},
+		{
+			name: "missing metadata key",
+			mapping: &SCIMProvisioningMapping{
+				Attributes: []SCIMAttributeMapping{{Attribute: "title"}},
+			},
+			want: false,
+		},
+		{
+			name: "sub attribute",
+			mapping: &SCIMProvisioningMapping{
+				Attributes: []SCIMAttributeMapping{{MetadataKey: "nick", Attribute: "name.nickName"}},
+			},
+			want: false,
+		},
+		{
+			name: "reserved attribute",
+			mapping: &SCIMProvisioningMapping{
+				Attributes: []SCIMAttributeMapping{{MetadataKey: "mail", Attribute: "Emails"}},
+			},
+			want: false,
+		},
+		{
+			name: "duplicate attribute",
+			mapping: &SCIMProvisioningMapping{
+				Attributes: []SCIMAttributeMapping{
+					{MetadataKey: "title", Attribute: "title"},
+					{MetadataKey: "position", Attribute: "Title"},
+				},
+			},
+			want: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, tt.mapping.Valid())
+		})
+	}
+}
+
+func TestSplitSCIMAttribute(t *testing.T) {
+	schema, name := SplitSCIMAttribute("urn:ietf:params:scim:schemas:extension:enterprise:2.0:User:department")
+	assert.Equal(t, "urn:ietf:params:scim:schemas:extension:enterprise:2.0:User", schema)
+	assert.Equal(t, "department", name)
+
+	schema, name = SplitSCIMAttribute("title")
+	assert.Empty(t, schema)
+	assert.Equal(t, "title", name)
+}
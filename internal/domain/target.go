@@ -24,3 +24,12 @@ func (s TargetState) Valid() bool {
 func (s TargetState) Exists() bool {
 	return s != TargetUnspecified && s != TargetRemoved
 }
+
+type TargetDeliveryState int32
+
+const (
+	TargetDeliveryStateUnspecified TargetDeliveryState = iota
+	TargetDeliveryStateFailed
+	TargetDeliveryStateReplaying
+	TargetDeliveryStateSucceeded
+)
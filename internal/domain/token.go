@@ -58,4 +58,6 @@ type TokenActor struct {
 	Actor  *TokenActor `json:"actor,omitempty"`
 	UserID string      `json:"user_id,omitempty"`
 	Issuer string      `json:"issuer,omitempty"`
+	// Reason is stated by support engineers when they impersonate a user.
+	Reason string `json:"reason,omitempty"`
 }
@@ -120,3 +120,75 @@ const (
 func (f PersonalAccessTokenState) Valid() bool {
 	return f >= 0 && f < personalAccessTokenStateCount
 }
+
+type TrustedDeviceState int32
+
+const (
+	TrustedDeviceStateUnspecified TrustedDeviceState = iota
+	TrustedDeviceStateActive
+	TrustedDeviceStateRemoved
+
+	trustedDeviceStateCount
+)
+
+func (f TrustedDeviceState) Valid() bool {
+	return f >= 0 && f < trustedDeviceStateCount
+}
+
+type PushDeviceState int32
+
+const (
+	PushDeviceStateUnspecified PushDeviceState = iota
+	PushDeviceStateActive
+	PushDeviceStateRemoved
+
+	pushDeviceStateCount
+)
+
+func (f PushDeviceState) Valid() bool {
+	return f >= 0 && f < pushDeviceStateCount
+}
+
+// PushPlatform is the push notification service a device is registered with
+type PushPlatform int32
+
+const (
+	PushPlatformUnspecified PushPlatform = iota
+	PushPlatformFCM
+	PushPlatformAPNs
+
+	pushPlatformCount
+)
+
+func (p PushPlatform) Valid() bool {
+	return p > PushPlatformUnspecified && p < pushPlatformCount
+}
+
+type DataExportState int32
+
+const (
+	DataExportStateUnspecified DataExportState = iota
+	DataExportStateRequested
+	DataExportStateGenerated
+	DataExportStateFailed
+
+	dataExportStateCount
+)
+
+func (f DataExportState) Valid() bool {
+	return f >= 0 && f < dataExportStateCount
+}
+
+type UserConsentState int32
+
+const (
+	UserConsentStateUnspecified UserConsentState = iota
+	UserConsentStateActive
+	UserConsentStateRevoked
+
+	userConsentStateCount
+)
+
+func (f UserConsentState) Valid() bool {
+	return f >= 0 && f < userConsentStateCount
+}
@@ -3,6 +3,7 @@ package domain
 import (
 	"net"
 	httplib "net/http"
+	"strings"
 )
 
 type UserAgent struct {
@@ -22,3 +23,44 @@ func (ua *UserAgent) GetFingerprintID() string {
 	}
 	return *ua.FingerprintID
 }
+
+// countryHeaders and cityHeaders are set by CDNs and load balancers
+// with the location of the client IP.
+var (
+	countryHeaders = []string{"Cf-Ipcountry", "Cloudfront-Viewer-Country", "X-Client-Geo-Country", "X-Country-Code"}
+	cityHeaders    = []string{"Cf-Ipcity", "Cloudfront-Viewer-City", "X-Client-Geo-City", "X-City"}
+)
+
+type GeoLocation struct {
+	CountryCode string
+	City        string
+}
+
+// GeoLocation returns the location of the client, as provided by a CDN or load balancer in the headers.
+// It returns nil if none of the known headers is set.
+func (ua *UserAgent) GeoLocation() *GeoLocation {
+	if ua == nil || len(ua.Header) == 0 {
+		return nil
+	}
+	location := &GeoLocation{
+		CountryCode: ua.headerValue(countryHeaders),
+		City:        ua.headerValue(cityHeaders),
+	}
+	if location.CountryCode == "" && location.City == "" {
+		return nil
+	}
+	return location
+}
+
+// headerValue returns the first value of the first set header.
+// The keys are compared case-insensitive, as they might not be canonicalized by the client.
+func (ua *UserAgent) headerValue(keys []string) string {
+	for _, key := range keys {
+		for header, values := range ua.Header {
+			if strings.EqualFold(header, key) && len(values) > 0 && values[0] != "" {
+				return values[0]
+			}
+		}
+	}
+	return ""
+}
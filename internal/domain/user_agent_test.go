@@ -1,6 +1,7 @@
 package domain
 
 import (
+	httplib "net/http"
 	"testing"
 
 	"github.com/muhlemmer/gu"
@@ -40,3 +41,47 @@ func TestUserAgent_GetFingerprintID(t *testing.T) {
 		})
 	}
 }
+
+func TestUserAgent_GeoLocation(t *testing.T) {
+	tests := []struct {
+		name   string
+		fields *UserAgent
+		want   *GeoLocation
+	}{
+		{
+			name:   "nil useragent",
+			fields: nil,
+			want:   nil,
+		},
+		{
+			name: "no location headers",
+			fields: &UserAgent{
+				Header: httplib.Header{"User-Agent": {"agent"}},
+			},
+			want: nil,
+		},
+		{
+			name: "country",
+			fields: &UserAgent{
+				Header: httplib.Header{"Cf-Ipcountry": {"CH"}},
+			},
+			want: &GeoLocation{CountryCode: "CH"},
+		},
+		{
+			name: "country and city, not canonicalized",
+			fields: &UserAgent{
+				Header: httplib.Header{
+					"cloudfront-viewer-country": {"CH"},
+					"cloudfront-viewer-city":    {"Zurich"},
+				},
+			},
+			want: &GeoLocation{CountryCode: "CH", City: "Zurich"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := tt.fields.GeoLocation()
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
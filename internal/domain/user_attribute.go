@@ -0,0 +1,85 @@
+package domain
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+// UserAttributeType defines how the value of a custom user attribute is interpreted
+type UserAttributeType int32
+
+const (
+	UserAttributeTypeUnspecified UserAttributeType = iota
+	UserAttributeTypeString
+	UserAttributeTypeNumber
+	UserAttributeTypeBoolean
+	// UserAttributeTypeDate values are formatted as YYYY-MM-DD
+	UserAttributeTypeDate
+
+	userAttributeTypeCount
+)
+
+func (t UserAttributeType) Valid() bool {
+	return t > UserAttributeTypeUnspecified && t < userAttributeTypeCount
+}
+
+// UserAttribute is a custom field of the human users of an organization.
+// Its value is stored as the metadata of the user with the key of the attribute.
+type UserAttribute struct {
+	Key         string
+	DisplayName string
+	Type        UserAttributeType
+	Required    bool
+	// Pattern is an optional regular expression the whole value must match
+	Pattern string
+}
+
+func (a *UserAttribute) IsValid() bool {
+	if strings.TrimSpace(a.Key) == "" || !a.Type.Valid() {
+		return false
+	}
+	if a.Pattern == "" {
+		return true
+	}
+	_, err := regexp.Compile(a.Pattern)
+	return err == nil
+}
+
+// ValidateValue checks the value of the attribute against its type and pattern.
+// An empty value is only valid for attributes which are not required.
+func (a *UserAttribute) ValidateValue(value []byte) error {
+	if len(value) == 0 {
+		if a.Required {
+			return zerrors.ThrowInvalidArgument(nil, "DOMAIN-Uat1r", "Errors.User.Attribute.Required")
+		}
+		return nil
+	}
+	var err error
+	switch a.Type {
+	case UserAttributeTypeNumber:
+		_, err = strconv.ParseFloat(string(value), 64)
+	case UserAttributeTypeBoolean:
+		_, err = strconv.ParseBool(string(value))
+	case UserAttributeTypeDate:
+		_, err = time.Parse(time.DateOnly, string(value))
+	case UserAttributeTypeString,
+		UserAttributeTypeUnspecified,
+		userAttributeTypeCount:
+	}
+	if err != nil {
+		return zerrors.ThrowInvalidArgument(err, "DOMAIN-Uat2t", "Errors.User.Attribute.Invalid")
+	}
+	if a.Pattern == "" {
+		return nil
+	}
+	// the whole value has to match the pattern
+	matches, err := regexp.MatchString("^(?:"+a.Pattern+")$", string(value))
+	if err != nil || !matches {
+		return zerrors.ThrowInvalidArgument(err, "DOMAIN-Uat3p", "Errors.User.Attribute.Invalid")
+	}
+	return nil
+}
@@ -10,6 +10,8 @@ type UserGrant struct {
 	ProjectID      string
 	ProjectGrantID string
 	RoleKeys       []string
+	// ExpectedSequence prevents changes of a user grant which was changed in the meantime if set
+	ExpectedSequence uint64
 }
 
 type UserGrantState int32
@@ -0,0 +1,24 @@
+package domain
+
+// UserLifecycleAction is executed on users which were inactive for the duration defined in the user lifecycle policy of their organization
+type UserLifecycleAction int32
+
+const (
+	UserLifecycleActionUnspecified UserLifecycleAction = iota
+	UserLifecycleActionDeactivate
+	UserLifecycleActionDelete
+)
+
+// MessageType returns the type of the message the org owners are notified with before the action is executed
+func (a UserLifecycleAction) MessageType() string {
+	switch a {
+	case UserLifecycleActionDeactivate:
+		return UserDeactivationScheduledMessageType
+	case UserLifecycleActionDelete:
+		return UserDeletionScheduledMessageType
+	case UserLifecycleActionUnspecified:
+		fallthrough
+	default:
+		return ""
+	}
+}
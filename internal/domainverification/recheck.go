@@ -0,0 +1,54 @@
+package domainverification
+
+import (
+	"context"
+	"time"
+
+	"github.com/zitadel/zitadel/internal/command"
+	"github.com/zitadel/zitadel/internal/database"
+	"github.com/zitadel/zitadel/internal/query"
+	"github.com/zitadel/zitadel/internal/scheduledjob"
+)
+
+const lockName = "org_domain_recheck"
+
+type rechecker struct {
+	commands *command.Commands
+	queries  *query.Queries
+}
+
+// Start rechecks the verification tokens of the org domains of all instances on every interval
+// until the context is done. An interval of 0 disables the recheck.
+func Start(ctx context.Context, interval time.Duration, commands *command.Commands, queries *query.Queries, client *database.DB) {
+	r := &rechecker{
+		commands: commands,
+		queries:  queries,
+	}
+	scheduledjob.Start(ctx, lockName, interval, queries, client, r.recheck)
+}
+
+// recheck rechecks the verification tokens of the org domains of the instance.
+func (r *rechecker) recheck(ctx context.Context) error {
+	return r.commands.RecheckOrgDomains(ctx, r.claimedUserIDs)
+}
+
+// claimedUserIDs returns the users of other organizations whose login names end with the domain
+func (r *rechecker) claimedUserIDs(ctx context.Context, orgDomain, orgID string) ([]string, error) {
+	loginName, err := query.NewUserPreferredLoginNameSearchQuery("@"+orgDomain, query.TextEndsWithIgnoreCase)
+	if err != nil {
+		return nil, err
+	}
+	owner, err := query.NewUserResourceOwnerSearchQuery(orgID, query.TextNotEquals)
+	if err != nil {
+		return nil, err
+	}
+	users, err := r.queries.SearchUsers(ctx, &query.UserSearchQueries{Queries: []query.SearchQuery{loginName, owner}})
+	if err != nil {
+		return nil, err
+	}
+	userIDs := make([]string, len(users.Users))
+	for i, user := range users.Users {
+		userIDs[i] = user.ID
+	}
+	return userIDs, nil
+}
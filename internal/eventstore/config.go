@@ -8,7 +8,32 @@ type Config struct {
 	PushTimeout time.Duration
 	MaxRetries  uint32
 
-	Pusher   Pusher
-	Querier  Querier
-	Searcher Searcher
+	// CryptoShredding encrypts the payloads of the user events with a key per user
+	CryptoShredding CryptoShreddingConfig
+	// SnapshotInterval is the amount of events after which a new snapshot of a [SnapshotReducer] is stored, 0 disables snapshots
+	SnapshotInterval uint32
+	// Payloads defines how large payloads are stored
+	Payloads PayloadConfig
+	// ChangeDataCapture announces pushed events to the other processes
+	ChangeDataCapture ChangeDataCaptureConfig
+
+	Pusher          Pusher
+	Querier         Querier
+	Searcher        Searcher
+	SnapshotStorage SnapshotStorage
+}
+
+type CryptoShreddingConfig struct {
+	Enabled bool
+}
+
+type PayloadConfig struct {
+	// CompressionThreshold is the size in bytes above which payloads are stored compressed, 0 disables compression
+	CompressionThreshold uint32
+	// OffloadThreshold is the size in bytes above which payloads are stored in the asset storage, 0 disables offloading
+	OffloadThreshold uint32
+}
+
+type ChangeDataCaptureConfig struct {
+	Enabled bool
 }
@@ -5,6 +5,8 @@ import (
 	"encoding/json"
 	"time"
 
+	"go.opentelemetry.io/otel/trace"
+
 	"github.com/zitadel/zitadel/internal/api/authz"
 	"github.com/zitadel/zitadel/internal/api/service"
 )
@@ -31,6 +33,19 @@ type BaseEvent struct {
 	//Service which created the event
 	Service string `json:"-"`
 	Data    []byte `json:"-"`
+
+	// spanContext is the span which pushed the event, it's only set if the event was pushed by this process
+	spanContext trace.SpanContext
+}
+
+// SpanContext returns the span which pushed the event,
+// the span context is invalid if the event was not pushed by this process
+func (e *BaseEvent) SpanContext() trace.SpanContext {
+	return e.spanContext
+}
+
+func (e *BaseEvent) setSpanContext(spanContext trace.SpanContext) {
+	e.spanContext = spanContext
 }
 
 // Position implements Event.
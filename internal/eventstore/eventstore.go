@@ -9,11 +9,22 @@ import (
 
 	"github.com/jackc/pgx/v5/pgconn"
 	"github.com/zitadel/logging"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
 
 	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/telemetry/metrics"
 	"github.com/zitadel/zitadel/internal/zerrors"
 )
 
+const (
+	pushDuration              = "zitadel.eventstore.push_duration"
+	pushDurationDescription   = "Duration of pushing commands to the eventstore in seconds"
+	filterDuration            = "zitadel.eventstore.filter_duration"
+	filterDurationDescription = "Duration of filtering events from the eventstore in seconds"
+	succeeded                 = "succeeded"
+)
+
 // Eventstore abstracts all functions needed to store valid events
 // and filters the stored events
 type Eventstore struct {
@@ -24,6 +35,9 @@ type Eventstore struct {
 	querier  Querier
 	searcher Searcher
 
+	snapshots        SnapshotStorage
+	snapshotInterval uint32
+
 	instances         []string
 	lastInstanceQuery time.Time
 	instancesMu       sync.Mutex
@@ -68,6 +82,9 @@ func NewEventstore(config *Config) *Eventstore {
 		querier:  config.Querier,
 		searcher: config.Searcher,
 
+		snapshots:        config.SnapshotStorage,
+		snapshotInterval: config.SnapshotInterval,
+
 		instancesMu: sync.Mutex{},
 	}
 }
@@ -93,6 +110,7 @@ func (es *Eventstore) Push(ctx context.Context, cmds ...Command) ([]Event, error
 		events []Event
 		err    error
 	)
+	defer recordDuration(ctx, pushDuration, pushDurationDescription, time.Now(), &err)
 
 	// Retry when there is a collision of the sequence as part of the primary key.
 	// "duplicate key value violates unique constraint \"events2_pkey\" (SQLSTATE 23505)"
@@ -114,6 +132,7 @@ retry:
 	if err != nil {
 		return mappedEvents, err
 	}
+	setSpanContext(ctx, mappedEvents)
 	es.notify(mappedEvents)
 	return mappedEvents, nil
 }
@@ -148,10 +167,12 @@ func (es *Eventstore) Search(ctx context.Context, conditions ...map[FieldType]an
 // and maps the events to the defined event structs
 //
 // Deprecated: Use [FilterToQueryReducer] instead to avoid allocations.
-func (es *Eventstore) Filter(ctx context.Context, searchQuery *SearchQueryBuilder) ([]Event, error) {
+func (es *Eventstore) Filter(ctx context.Context, searchQuery *SearchQueryBuilder) (_ []Event, err error) {
+	defer recordDuration(ctx, filterDuration, filterDurationDescription, time.Now(), &err)
+
 	events := make([]Event, 0, searchQuery.GetLimit())
 	searchQuery.ensureInstanceID(ctx)
-	err := es.querier.FilterToReducer(ctx, searchQuery, func(event Event) error {
+	err = es.querier.FilterToReducer(ctx, searchQuery, func(event Event) error {
 		event, err := es.mapEvent(event)
 		if err != nil {
 			return err
@@ -205,7 +226,9 @@ type reducer interface {
 }
 
 // FilterToReducer filters the events based on the search query, appends all events to the reducer and calls it's reduce function
-func (es *Eventstore) FilterToReducer(ctx context.Context, searchQuery *SearchQueryBuilder, r reducer) error {
+func (es *Eventstore) FilterToReducer(ctx context.Context, searchQuery *SearchQueryBuilder, r reducer) (err error) {
+	defer recordDuration(ctx, filterDuration, filterDurationDescription, time.Now(), &err)
+
 	searchQuery.ensureInstanceID(ctx)
 	return es.querier.FilterToReducer(ctx, searchQuery, func(event Event) error {
 		event, err := es.mapEvent(event)
@@ -255,7 +278,11 @@ type QueryReducer interface {
 
 // FilterToQueryReducer filters the events based on the search query of the query function,
 // appends all events to the reducer and calls it's reduce function
+// Reducers implementing [SnapshotReducer] are hydrated from their latest snapshot if snapshots are enabled.
 func (es *Eventstore) FilterToQueryReducer(ctx context.Context, r QueryReducer) error {
+	if snapshotReducer, ok := r.(SnapshotReducer); ok && es.snapshots != nil && es.snapshotInterval > 0 {
+		return es.filterWithSnapshot(ctx, snapshotReducer)
+	}
 	return es.FilterToReducer(ctx, r.Query(), r)
 }
 
@@ -310,3 +337,35 @@ func appendAggregateType(typ AggregateType) {
 	}
 	aggregateTypes = append(aggregateTypes[:i], append([]string{string(typ)}, aggregateTypes[i:]...)...)
 }
+
+// setSpanContext passes the span of the push to the events,
+// so the subscribed handlers can link their spans to it
+func setSpanContext(ctx context.Context, events []Event) {
+	spanContext := trace.SpanContextFromContext(ctx)
+	if !spanContext.IsValid() {
+		return
+	}
+	for _, event := range events {
+		SetSpanContext(event, spanContext)
+	}
+}
+
+// SetSpanContext sets the span which pushed the event,
+// it's used by the handlers to pass the span of the push to the events they filtered
+func SetSpanContext(event Event, spanContext trace.SpanContext) {
+	if traced, ok := event.(interface{ setSpanContext(trace.SpanContext) }); ok {
+		traced.setSpanContext(spanContext)
+	}
+}
+
+// recordDuration records the time since start in the histogram
+func recordDuration(ctx context.Context, histogram, description string, start time.Time, err *error) {
+	if regErr := metrics.RegisterHistogram(histogram, description, "s", metrics.DurationBuckets); regErr != nil {
+		logging.WithError(regErr).WithField("metric", histogram).Debug("unable to register histogram")
+		return
+	}
+	labels := map[string]attribute.Value{
+		succeeded: attribute.BoolValue(*err == nil),
+	}
+	metrics.AddHistogramMeasurement(ctx, histogram, time.Since(start).Seconds(), labels)
+}
@@ -12,6 +12,7 @@ import (
 
 	"github.com/jackc/pgx/v5/pgconn"
 	"github.com/zitadel/logging"
+	"go.opentelemetry.io/otel/attribute"
 
 	"github.com/zitadel/zitadel/internal/api/authz"
 	"github.com/zitadel/zitadel/internal/api/call"
@@ -20,9 +21,19 @@ import (
 	"github.com/zitadel/zitadel/internal/migration"
 	"github.com/zitadel/zitadel/internal/repository/instance"
 	"github.com/zitadel/zitadel/internal/repository/pseudo"
+	"github.com/zitadel/zitadel/internal/telemetry/metrics"
 	"github.com/zitadel/zitadel/internal/telemetry/tracing"
 )
 
+const (
+	projectionLag            = "zitadel.projection.lag"
+	projectionLagDescription = "Time between the creation of the last processed event and its projection in seconds"
+	projectionLabel          = "projection"
+)
+
+// projectionLagBuckets are the histogram buckets of the projection lag in seconds
+var projectionLagBuckets = []float64{0.1, 0.5, 1, 2.5, 5, 10, 30, 60, 300, 900, 3600}
+
 type EventStore interface {
 	InstanceIDs(ctx context.Context, maxAge time.Duration, forceLoad bool, query *eventstore.SearchQueryBuilder) ([]string, error)
 	FilterToQueryReducer(ctx context.Context, reducer eventstore.QueryReducer) error
@@ -41,6 +52,9 @@ type Config struct {
 	HandleActiveInstances time.Duration
 	TransactionDuration   time.Duration
 	MaxFailureCount       uint8
+	// CatchUpPartitions is the amount of partitions reducing the events concurrently during the prefill of the projection,
+	// values of 0 and 1 disable partitioning
+	CatchUpPartitions uint16
 
 	TriggerWithoutEvents Reduce
 }
@@ -58,11 +72,14 @@ type Handler struct {
 	requeueEvery          time.Duration
 	handleActiveInstances time.Duration
 	txDuration            time.Duration
+	catchUpPartitions     uint16
 	now                   nowFunc
 
 	triggeredInstancesSync sync.Map
 
 	triggerWithoutEvents Reduce
+
+	pushedSpans pushedSpans
 }
 
 var _ migration.Migration = (*Handler)(nil)
@@ -119,6 +136,10 @@ func (h *Handler) Execute(ctx context.Context, startedEvent eventstore.Event) er
 
 func (h *Handler) executeInstances(ctx context.Context, instances <-chan string, startedEvent eventstore.Event, wg *sync.WaitGroup) {
 	for instance := range instances {
+		if h.catchUpPartitions > 1 {
+			h.executePartitionedInstance(ctx, instance, startedEvent.Position())
+			continue
+		}
 		h.triggerInstances(ctx, []string{instance}, WithMaxPosition(startedEvent.Position()))
 	}
 	wg.Done()
@@ -169,6 +190,7 @@ func NewHandler(
 		triggeredInstancesSync: sync.Map{},
 		triggerWithoutEvents:   config.TriggerWithoutEvents,
 		txDuration:             config.TransactionDuration,
+		catchUpPartitions:      config.CatchUpPartitions,
 	}
 
 	return handler
@@ -287,7 +309,7 @@ func (h *Handler) subscribe(ctx context.Context) {
 					continue
 				}
 				queueCtx = authz.WithInstanceID(queueCtx, e.Aggregate().InstanceID)
-				_, err := h.Trigger(queueCtx)
+				err := h.triggerQueued(queueCtx, e.Aggregate().InstanceID, events)
 				h.log().OnError(err).Debug("trigger of queued event failed")
 				if err == nil {
 					solvedInstances = append(solvedInstances, e.Aggregate().InstanceID)
@@ -381,6 +403,7 @@ func (h *Handler) existingInstances(ctx context.Context) ([]string, error) {
 type triggerConfig struct {
 	awaitRunning bool
 	maxPosition  float64
+	partition    *partition
 }
 
 type TriggerOpt func(conf *triggerConfig)
@@ -397,6 +420,23 @@ func WithMaxPosition(position float64) TriggerOpt {
 	}
 }
 
+// triggerQueued triggers the handler for the instance of the pushed events,
+// the span of the trigger is linked to the spans which pushed the events
+func (h *Handler) triggerQueued(ctx context.Context, instanceID string, events []eventstore.Event) (err error) {
+	instanceEvents := make([]eventstore.Event, 0, len(events))
+	for _, event := range events {
+		if event.Aggregate().InstanceID == instanceID {
+			instanceEvents = append(instanceEvents, event)
+		}
+	}
+	ctx, span := tracing.NewLinkedSpan(ctx, h.ProjectionName()+".trigger", h.pushedSpans.remember(instanceEvents...)...)
+	span.SetAttributes(tracing.HashedAttribute(tracing.InstanceIDAttribute, instanceID))
+	defer func() { span.EndWithError(err) }()
+
+	_, err = h.Trigger(ctx)
+	return err
+}
+
 func (h *Handler) Trigger(ctx context.Context, opts ...TriggerOpt) (_ context.Context, err error) {
 	config := new(triggerConfig)
 	for _, opt := range opts {
@@ -424,10 +464,13 @@ func (h *Handler) Trigger(ctx context.Context, opts ...TriggerOpt) (_ context.Co
 // the instance can be skipped then
 // If the instance is locked, an unlock deferrable function is returned
 func (h *Handler) lockInstance(ctx context.Context, config *triggerConfig) func() {
-	instanceID := authz.GetInstance(ctx).InstanceID()
+	lockKey := authz.GetInstance(ctx).InstanceID()
+	if config.partition != nil {
+		lockKey += "/" + h.stateName(config.partition)
+	}
 
 	// Check that the instance has a lock
-	instanceLock, _ := h.triggeredInstancesSync.LoadOrStore(instanceID, make(chan bool, 1))
+	instanceLock, _ := h.triggeredInstancesSync.LoadOrStore(lockKey, make(chan bool, 1))
 
 	// in case we don't want to wait for a running trigger / lock (e.g. spooler),
 	// we can directly return if we cannot lock
@@ -508,7 +551,7 @@ func (h *Handler) processEvents(ctx context.Context, config *triggerConfig) (add
 	}
 
 	var statements []*Statement
-	statements, additionalIteration, err = h.generateStatements(ctx, tx, currentState)
+	statements, additionalIteration, err = h.generateStatements(ctx, tx, currentState, config)
 	if err != nil {
 		return additionalIteration, err
 	}
@@ -530,11 +573,25 @@ func (h *Handler) processEvents(ctx context.Context, config *triggerConfig) (add
 	currentState.sequence = statements[lastProcessedIndex].Sequence
 	currentState.eventTimestamp = statements[lastProcessedIndex].CreationDate
 	err = h.setState(tx, currentState)
+	if err == nil {
+		h.recordLag(ctx, currentState.eventTimestamp)
+	}
 
 	return additionalIteration, err
 }
 
-func (h *Handler) generateStatements(ctx context.Context, tx *sql.Tx, currentState *state) (_ []*Statement, additionalIteration bool, err error) {
+// recordLag records the time since the creation of the last processed event
+func (h *Handler) recordLag(ctx context.Context, eventTimestamp time.Time) {
+	if err := metrics.RegisterHistogram(projectionLag, projectionLagDescription, "s", projectionLagBuckets); err != nil {
+		h.log().WithError(err).Debug("unable to register projection lag histogram")
+		return
+	}
+	metrics.AddHistogramMeasurement(ctx, projectionLag, time.Since(eventTimestamp).Seconds(), map[string]attribute.Value{
+		projectionLabel: attribute.StringValue(h.ProjectionName()),
+	})
+}
+
+func (h *Handler) generateStatements(ctx context.Context, tx *sql.Tx, currentState *state, config *triggerConfig) (_ []*Statement, additionalIteration bool, err error) {
 	if h.triggerWithoutEvents != nil {
 		stmt, err := h.triggerWithoutEvents(pseudo.NewScheduledEvent(ctx, time.Now(), currentState.instanceID))
 		if err != nil {
@@ -548,6 +605,11 @@ func (h *Handler) generateStatements(ctx context.Context, tx *sql.Tx, currentSta
 		h.log().WithError(err).Debug("filter eventstore failed")
 		return nil, false, err
 	}
+	h.pushedSpans.apply(events)
+	if config.partition != nil && config.maxPosition != 0 {
+		// all partitions must stop at the same event, so their states can be merged
+		events = eventsUntilPosition(events, config.maxPosition)
+	}
 	eventAmount := len(events)
 
 	statements, err := h.eventsToStatements(tx, events, currentState)
@@ -577,6 +639,15 @@ func (h *Handler) generateStatements(ctx context.Context, tx *sql.Tx, currentSta
 	return statements, additionalIteration, nil
 }
 
+func eventsUntilPosition(events []eventstore.Event, maxPosition float64) []eventstore.Event {
+	for i, event := range events {
+		if event.Position() > maxPosition {
+			return events[:i]
+		}
+	}
+	return events
+}
+
 func skipPreviouslyReducedStatements(statements []*Statement, currentState *state) int {
 	for i, statement := range statements {
 		if statement.Position == currentState.position &&
@@ -0,0 +1,208 @@
+package handler
+
+import (
+	"context"
+	"database/sql"
+	_ "embed"
+	"errors"
+	"fmt"
+	"hash/fnv"
+	"sync"
+	"time"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/database"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+var (
+	//go:embed state_partition_init.sql
+	initPartitionStateStmt string
+	//go:embed state_partitions_get.sql
+	partitionStatesStmt string
+	//go:embed state_partitions_delete.sql
+	deletePartitionStatesStmt string
+)
+
+// partition of the events of an instance.
+// The events are partitioned by their aggregate id,
+// so the events of an aggregate are always reduced in order by the same partition.
+type partition struct {
+	index uint16
+	count uint16
+}
+
+func withPartition(p *partition) TriggerOpt {
+	return func(conf *triggerConfig) {
+		conf.partition = p
+	}
+}
+
+func (p *partition) contains(event eventstore.Event) bool {
+	hash := fnv.New32a()
+	// writing to a hash never returns an error
+	_, _ = hash.Write([]byte(event.Aggregate().ID))
+	return hash.Sum32()%uint32(p.count) == uint32(p.index)
+}
+
+func (h *Handler) stateName(p *partition) string {
+	if p == nil {
+		return h.projection.Name()
+	}
+	return fmt.Sprintf("%s_partition_%d_of_%d", h.projection.Name(), p.index+1, p.count)
+}
+
+func (h *Handler) partitions() []*partition {
+	partitions := make([]*partition, h.catchUpPartitions)
+	for i := range partitions {
+		partitions[i] = &partition{index: uint16(i), count: h.catchUpPartitions}
+	}
+	return partitions
+}
+
+// catchUpPartitioned reduces the events of the instance up to maxPosition concurrently in [Handler.catchUpPartitions].
+// The state of the projection is locked while the partitions catch up.
+// Each partition keeps its own state, so an interrupted catch up resumes where each partition stopped.
+// As soon as all partitions reached maxPosition, their states are merged into the state of the projection.
+//
+// Projections reducing events into rows of other aggregates must not be partitioned,
+// as the order of events of different aggregates isn't preserved.
+func (h *Handler) catchUpPartitioned(ctx context.Context, maxPosition float64) (err error) {
+	tx, err := h.client.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err != nil {
+			rollbackErr := tx.Rollback()
+			h.log().OnError(rollbackErr).Debug("unable to rollback tx")
+			return
+		}
+		err = tx.Commit()
+	}()
+
+	currentState, err := h.currentState(ctx, tx, new(triggerConfig))
+	if err != nil {
+		return err
+	}
+	if currentState.position >= maxPosition {
+		return nil
+	}
+
+	partitions := h.partitions()
+	names := make([]string, len(partitions))
+	for i, p := range partitions {
+		names[i] = h.stateName(p)
+		// the partition states are initialized outside of tx, otherwise the partitions would wait for tx
+		if err = h.initPartitionState(ctx, names[i], currentState); err != nil {
+			return err
+		}
+	}
+
+	var (
+		wg   sync.WaitGroup
+		errs = make([]error, len(partitions))
+	)
+	wg.Add(len(partitions))
+	for i, p := range partitions {
+		go func(i int, p *partition) {
+			defer wg.Done()
+			errs[i] = h.triggerPartition(ctx, p, maxPosition)
+		}(i, p)
+	}
+	wg.Wait()
+	if err = errors.Join(errs...); err != nil {
+		return err
+	}
+
+	mergedState, err := h.partitionState(tx, currentState.instanceID, names)
+	if err != nil {
+		return err
+	}
+	if err = h.setState(tx, mergedState); err != nil {
+		return err
+	}
+	_, err = tx.Exec(deletePartitionStatesStmt, currentState.instanceID, database.TextArray[string](names))
+	return err
+}
+
+// triggerPartition reduces the events of the partition until it reached maxPosition
+func (h *Handler) triggerPartition(ctx context.Context, p *partition, maxPosition float64) error {
+	for {
+		_, err := h.Trigger(ctx, WithMaxPosition(maxPosition), withPartition(p))
+		if err == nil {
+			return nil
+		}
+		h.log().WithField("partition", h.stateName(p)).WithError(err).Debug("trigger of partition failed")
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(h.retryFailedAfter):
+		}
+	}
+}
+
+func (h *Handler) initPartitionState(ctx context.Context, name string, currentState *state) error {
+	_, err := h.client.ExecContext(ctx, initPartitionStateStmt,
+		name,
+		currentState.instanceID,
+		currentState.aggregateID,
+		currentState.aggregateType,
+		currentState.sequence,
+		currentState.eventTimestamp,
+		currentState.position,
+		currentState.offset,
+	)
+	if err != nil {
+		return zerrors.ThrowInternal(err, "V2-4xPqa", "unable to initialize partition state")
+	}
+	return nil
+}
+
+// partitionState returns the state of the partition which reduced the furthest.
+// After a completed catch up all partitions reduced the same events.
+func (h *Handler) partitionState(tx *sql.Tx, instanceID string, names []string) (*state, error) {
+	var (
+		aggregateID   = new(sql.NullString)
+		aggregateType = new(sql.NullString)
+		sequence      = new(sql.NullInt64)
+		timestamp     = new(sql.NullTime)
+		position      = new(sql.NullFloat64)
+		offset        = new(sql.NullInt64)
+	)
+	err := tx.QueryRow(partitionStatesStmt, instanceID, database.TextArray[string](names)).Scan(
+		aggregateID,
+		aggregateType,
+		sequence,
+		timestamp,
+		position,
+		offset,
+	)
+	if err != nil {
+		return nil, zerrors.ThrowInternal(err, "V2-Rk9sW", "unable to query partition states")
+	}
+	return &state{
+		instanceID:     instanceID,
+		aggregateID:    aggregateID.String,
+		aggregateType:  eventstore.AggregateType(aggregateType.String),
+		sequence:       uint64(sequence.Int64),
+		eventTimestamp: timestamp.Time,
+		position:       position.Float64,
+		offset:         uint32(offset.Int64),
+	}, nil
+}
+
+// executePartitionedInstance catches up the instance using partitions,
+// it retries until the catch up succeeded
+func (h *Handler) executePartitionedInstance(ctx context.Context, instanceID string, maxPosition float64) {
+	instanceCtx := authz.WithInstanceID(ctx, instanceID)
+	for {
+		err := h.catchUpPartitioned(instanceCtx, maxPosition)
+		if err == nil || ctx.Err() != nil {
+			return
+		}
+		h.log().WithField("instance", instanceID).WithError(err).Debug("partitioned catch up failed")
+		time.Sleep(h.retryFailedAfter)
+	}
+}
@@ -0,0 +1,88 @@
+package handler
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/zitadel/zitadel/internal/eventstore"
+)
+
+func partitionEvent(aggregateID string, position float64) eventstore.Event {
+	return &eventstore.BaseEvent{
+		Agg: &eventstore.Aggregate{
+			ID:   aggregateID,
+			Type: "aggregate",
+		},
+		Pos: position,
+	}
+}
+
+func TestHandler_partitions(t *testing.T) {
+	h := &Handler{
+		projection:        &projection{name: "projection"},
+		catchUpPartitions: 4,
+	}
+	partitions := h.partitions()
+	assert.Len(t, partitions, 4)
+	assert.Equal(t, "projection", h.stateName(nil))
+	assert.Equal(t, "projection_partition_1_of_4", h.stateName(partitions[0]))
+	assert.Equal(t, "projection_partition_4_of_4", h.stateName(partitions[3]))
+
+	for i := 0; i < 100; i++ {
+		event := partitionEvent(fmt.Sprintf("aggregate%d", i), 1)
+		var containing int
+		for _, p := range partitions {
+			if p.contains(event) {
+				containing++
+			}
+		}
+		assert.Equal(t, 1, containing, "event of aggregate%d must be in exactly one partition", i)
+	}
+}
+
+func TestHandler_reduceInPartition(t *testing.T) {
+	h := &Handler{
+		projection: &projection{
+			name: "projection",
+			reducers: []AggregateReducer{
+				{
+					Aggregate: "aggregate",
+					EventReducers: []EventReducer{
+						{
+							Event: "event",
+							Reduce: func(event eventstore.Event) (*Statement, error) {
+								return NewStatement(event, func(Executer, string) error { return nil }), nil
+							},
+						},
+					},
+				},
+			},
+		},
+		catchUpPartitions: 2,
+	}
+	event := partitionEvent("aggregate", 1)
+	event.(*eventstore.BaseEvent).EventType = "event"
+
+	for _, p := range h.partitions() {
+		statement, err := h.reduceInPartition(event, p)
+		assert.NoError(t, err)
+		assert.Equal(t, p.contains(event), statement.Execute != nil)
+	}
+	statement, err := h.reduceInPartition(event, nil)
+	assert.NoError(t, err)
+	assert.NotNil(t, statement.Execute)
+}
+
+func Test_eventsUntilPosition(t *testing.T) {
+	events := []eventstore.Event{
+		partitionEvent("1", 1),
+		partitionEvent("2", 2),
+		partitionEvent("3", 2),
+		partitionEvent("4", 3),
+	}
+	assert.Equal(t, events[:3], eventsUntilPosition(events, 2))
+	assert.Equal(t, events, eventsUntilPosition(events, 3))
+	assert.Empty(t, eventsUntilPosition(events, 0.5))
+}
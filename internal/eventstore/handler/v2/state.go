@@ -20,6 +20,7 @@ type state struct {
 	aggregateID    string
 	sequence       uint64
 	offset         uint32
+	partition      *partition
 }
 
 var (
@@ -38,6 +39,7 @@ var (
 func (h *Handler) currentState(ctx context.Context, tx *sql.Tx, config *triggerConfig) (currentState *state, err error) {
 	currentState = &state{
 		instanceID: authz.GetInstance(ctx).InstanceID(),
+		partition:  config.partition,
 	}
 
 	var (
@@ -54,7 +56,7 @@ func (h *Handler) currentState(ctx context.Context, tx *sql.Tx, config *triggerC
 		stateQuery = currentStateAwaitStmt
 	}
 
-	row := tx.QueryRow(stateQuery, currentState.instanceID, h.projection.Name())
+	row := tx.QueryRow(stateQuery, currentState.instanceID, h.stateName(currentState.partition))
 	err = row.Scan(
 		aggregateID,
 		aggregateType,
@@ -64,7 +66,7 @@ func (h *Handler) currentState(ctx context.Context, tx *sql.Tx, config *triggerC
 		offset,
 	)
 	if errors.Is(err, sql.ErrNoRows) {
-		err = h.lockState(tx, currentState.instanceID)
+		err = h.lockState(tx, currentState.instanceID, currentState.partition)
 	}
 	if err != nil {
 		h.log().WithError(err).Debug("unable to query current state")
@@ -83,7 +85,7 @@ func (h *Handler) currentState(ctx context.Context, tx *sql.Tx, config *triggerC
 
 func (h *Handler) setState(tx *sql.Tx, updatedState *state) error {
 	res, err := tx.Exec(updateStateStmt,
-		h.projection.Name(),
+		h.stateName(updatedState.partition),
 		updatedState.instanceID,
 		updatedState.aggregateID,
 		updatedState.aggregateType,
@@ -103,9 +105,9 @@ func (h *Handler) setState(tx *sql.Tx, updatedState *state) error {
 	return nil
 }
 
-func (h *Handler) lockState(tx *sql.Tx, instanceID string) error {
+func (h *Handler) lockState(tx *sql.Tx, instanceID string, p *partition) error {
 	res, err := tx.Exec(lockStateStmt,
-		h.projection.Name(),
+		h.stateName(p),
 		instanceID,
 	)
 	if err != nil {
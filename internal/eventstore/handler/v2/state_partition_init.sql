@@ -0,0 +1,21 @@
+INSERT INTO projections.current_states (
+    projection_name
+    , instance_id
+    , aggregate_id
+    , aggregate_type
+    , "sequence"
+    , event_date
+    , "position"
+    , last_updated
+    , filter_offset
+) VALUES (
+    $1
+    , $2
+    , $3
+    , $4
+    , $5
+    , $6
+    , $7
+    , now()
+    , $8
+) ON CONFLICT DO NOTHING;
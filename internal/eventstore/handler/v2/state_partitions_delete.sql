@@ -0,0 +1,5 @@
+DELETE FROM
+    projections.current_states
+WHERE
+    instance_id = $1
+    AND projection_name = ANY($2);
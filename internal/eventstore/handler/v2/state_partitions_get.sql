@@ -0,0 +1,16 @@
+SELECT
+    aggregate_id
+    , aggregate_type
+    , "sequence"
+    , event_date
+    , "position"
+    , filter_offset
+FROM 
+    projections.current_states
+WHERE
+    instance_id = $1
+    AND projection_name = ANY($2)
+ORDER BY
+    "position" DESC
+    , filter_offset DESC
+LIMIT 1;
@@ -127,7 +127,7 @@ func TestHandler_lockState(t *testing.T) {
 				t.Fatalf("unable to begin transaction: %v", err)
 			}
 
-			err = h.lockState(tx, tt.args.instanceID)
+			err = h.lockState(tx, tt.args.instanceID, nil)
 			tt.isErr(t, err)
 
 			tt.fields.mock.Assert(t)
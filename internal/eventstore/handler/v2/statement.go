@@ -43,7 +43,7 @@ func (h *Handler) eventsToStatements(tx *sql.Tx, events []eventstore.Event, curr
 	previousPosition := currentState.position
 	offset := currentState.offset
 	for _, event := range events {
-		statement, err := h.reduce(event)
+		statement, err := h.reduceInPartition(event, currentState.partition)
 		if err != nil {
 			h.logEvent(event).WithError(err).Error("reduce failed")
 			if shouldContinue := h.handleFailedStmt(tx, failureFromEvent(event, err)); shouldContinue {
@@ -64,6 +64,15 @@ func (h *Handler) eventsToStatements(tx *sql.Tx, events []eventstore.Event, curr
 	return statements, nil
 }
 
+// reduceInPartition only reduces the events of the partition,
+// the events of other partitions result in no op statements to keep track of the position
+func (h *Handler) reduceInPartition(event eventstore.Event, p *partition) (*Statement, error) {
+	if p != nil && !p.contains(event) {
+		return NewNoOpStatement(event), nil
+	}
+	return h.reduce(event)
+}
+
 func (h *Handler) reduce(event eventstore.Event) (*Statement, error) {
 	for _, reducer := range h.projection.Reducers() {
 		if reducer.Aggregate != event.Aggregate().Type {
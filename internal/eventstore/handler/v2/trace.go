@@ -0,0 +1,78 @@
+package handler
+
+import (
+	"sync"
+
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/zitadel/zitadel/internal/eventstore"
+)
+
+// maxPushedSpans limits the spans remembered by a handler,
+// the remembered spans are dropped if the limit is reached,
+// e.g. because the events were processed by another process
+const maxPushedSpans = 1000
+
+type pushedEventKey struct {
+	instanceID    string
+	aggregateType eventstore.AggregateType
+	aggregateID   string
+	sequence      uint64
+}
+
+func newPushedEventKey(event eventstore.Event) pushedEventKey {
+	return pushedEventKey{
+		instanceID:    event.Aggregate().InstanceID,
+		aggregateType: event.Aggregate().Type,
+		aggregateID:   event.Aggregate().ID,
+		sequence:      event.Sequence(),
+	}
+}
+
+// pushedSpans remembers the spans which pushed the events received by the subscription of the handler.
+// The events are filtered again before they are reduced, so the spans are passed to the filtered events,
+// which allows the statements (e.g. sending a notification) to be traced back to the request which pushed the event.
+type pushedSpans struct {
+	mu    sync.Mutex
+	spans map[pushedEventKey]trace.SpanContext
+}
+
+// remember stores the spans of the pushed events and returns them
+func (p *pushedSpans) remember(events ...eventstore.Event) []trace.SpanContext {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if p.spans == nil {
+		p.spans = make(map[pushedEventKey]trace.SpanContext)
+	}
+	spanContexts := make([]trace.SpanContext, 0, len(events))
+	for _, event := range events {
+		traced, ok := event.(interface{ SpanContext() trace.SpanContext })
+		if !ok || !traced.SpanContext().IsValid() {
+			continue
+		}
+		spanContexts = append(spanContexts, traced.SpanContext())
+		if len(p.spans) >= maxPushedSpans {
+			clear(p.spans)
+		}
+		p.spans[newPushedEventKey(event)] = traced.SpanContext()
+	}
+	return spanContexts
+}
+
+// apply passes the remembered spans to the filtered events and forgets them
+func (p *pushedSpans) apply(events []eventstore.Event) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if len(p.spans) == 0 {
+		return
+	}
+	for _, event := range events {
+		key := newPushedEventKey(event)
+		spanContext, ok := p.spans[key]
+		if !ok {
+			continue
+		}
+		eventstore.SetSpanContext(event, spanContext)
+		delete(p.spans, key)
+	}
+}
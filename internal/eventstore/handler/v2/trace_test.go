@@ -0,0 +1,46 @@
+package handler
+
+import (
+	"testing"
+
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/zitadel/zitadel/internal/eventstore"
+)
+
+func Test_pushedSpans(t *testing.T) {
+	spanContext := trace.NewSpanContext(trace.SpanContextConfig{
+		TraceID:    trace.TraceID{1},
+		SpanID:     trace.SpanID{1},
+		TraceFlags: trace.FlagsSampled,
+	})
+	newEvent := func(sequence uint64) *eventstore.BaseEvent {
+		return &eventstore.BaseEvent{
+			Agg: &eventstore.Aggregate{
+				InstanceID: "instance",
+				Type:       "aggregate",
+				ID:         "id",
+			},
+			Seq: sequence,
+		}
+	}
+	pushed := newEvent(1)
+	eventstore.SetSpanContext(pushed, spanContext)
+
+	spans := new(pushedSpans)
+	if got := spans.remember(pushed, newEvent(2)); len(got) != 1 || !got[0].Equal(spanContext) {
+		t.Fatalf("remember() = %v, want %v", got, spanContext)
+	}
+
+	filtered := []eventstore.Event{newEvent(1), newEvent(2)}
+	spans.apply(filtered)
+	if got := filtered[0].(*eventstore.BaseEvent).SpanContext(); !got.Equal(spanContext) {
+		t.Errorf("span of pushed event = %v, want %v", got, spanContext)
+	}
+	if got := filtered[1].(*eventstore.BaseEvent).SpanContext(); got.IsValid() {
+		t.Errorf("span of other event = %v, want invalid", got)
+	}
+	if len(spans.spans) != 0 {
+		t.Errorf("applied spans must be forgotten, got %d", len(spans.spans))
+	}
+}
@@ -121,6 +121,8 @@ type testDB struct{}
 
 func (_ *testDB) Timetravel(time.Duration) string { return " AS OF SYSTEM TIME '-1 ms' " }
 
+func (*testDB) ReplicationLagQuery() string { return "SELECT 0" }
+
 func (*testDB) DatabaseName() string { return "db" }
 
 func (*testDB) Username() string { return "user" }
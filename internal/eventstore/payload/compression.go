@@ -0,0 +1,71 @@
+package payload
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"encoding/json"
+	"io"
+
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+const compressedField = "$compressed"
+
+type compressedEnvelope struct {
+	Data []byte `json:"$compressed"`
+}
+
+// WrapCompression stores payloads larger than threshold bytes gzip compressed
+func WrapCompression(config *eventstore.Config, threshold uint32) {
+	wrap(config, &compression{threshold: int(threshold)})
+}
+
+type compression struct {
+	threshold int
+}
+
+func (c *compression) encode(_ context.Context, _ *eventstore.Aggregate, payload []byte) ([]byte, error) {
+	if len(payload) <= c.threshold {
+		return nil, nil
+	}
+	var compressed bytes.Buffer
+	writer := gzip.NewWriter(&compressed)
+	if _, err := writer.Write(payload); err != nil {
+		return nil, zerrors.ThrowInternal(err, "PAYLO-Cw8nq3xv2m", "Errors.Internal")
+	}
+	if err := writer.Close(); err != nil {
+		return nil, zerrors.ThrowInternal(err, "PAYLO-Cx4vn9qw7m", "Errors.Internal")
+	}
+	encoded, err := json.Marshal(&compressedEnvelope{Data: compressed.Bytes()})
+	if err != nil {
+		return nil, zerrors.ThrowInternal(err, "PAYLO-Cq2wx8nv4m", "Errors.Internal")
+	}
+	// the compressed payload is base64 encoded, so small or random payloads might grow
+	if len(encoded) >= len(payload) {
+		return nil, nil
+	}
+	return encoded, nil
+}
+
+func (c *compression) isEncoded(payload []byte) bool {
+	return hasField(payload, compressedField)
+}
+
+func (c *compression) decode(_ context.Context, _ *eventstore.Aggregate, payload []byte) ([]byte, error) {
+	var envelope compressedEnvelope
+	if err := json.Unmarshal(payload, &envelope); err != nil {
+		return nil, zerrors.ThrowInternal(err, "PAYLO-Cn7xw3qv2m", "Errors.Internal")
+	}
+	reader, err := gzip.NewReader(bytes.NewReader(envelope.Data))
+	if err != nil {
+		return nil, zerrors.ThrowInternal(err, "PAYLO-Cv9qn4wx8m", "Errors.Internal")
+	}
+	defer reader.Close()
+	decompressed, err := io.ReadAll(reader)
+	if err != nil {
+		return nil, zerrors.ThrowInternal(err, "PAYLO-Cw3xq8nv7m", "Errors.Internal")
+	}
+	return decompressed, nil
+}
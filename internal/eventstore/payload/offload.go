@@ -0,0 +1,70 @@
+package payload
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/static"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+const offloadedField = "$offloaded"
+
+type offloadedEnvelope struct {
+	Object offloadedObject `json:"$offloaded"`
+}
+
+type offloadedObject struct {
+	Name string `json:"name"`
+	Size int    `json:"size"`
+}
+
+// WrapOffloading stores payloads larger than threshold bytes in the asset storage,
+// the event only contains the name of the object
+func WrapOffloading(config *eventstore.Config, storage static.Storage, threshold uint32) {
+	wrap(config, &offloading{storage: storage, threshold: int(threshold)})
+}
+
+type offloading struct {
+	storage   static.Storage
+	threshold int
+}
+
+func (o *offloading) encode(ctx context.Context, aggregate *eventstore.Aggregate, payload []byte) ([]byte, error) {
+	if len(payload) <= o.threshold {
+		return nil, nil
+	}
+	// the name is derived from the content, so the same payload is only stored once
+	hash := sha256.Sum256(payload)
+	name := domain.GetEventPayloadAssetPath(hex.EncodeToString(hash[:]))
+	_, err := o.storage.PutObject(ctx, aggregate.InstanceID, "", aggregate.ResourceOwner, name, "application/json", static.ObjectTypeEventPayload, bytes.NewReader(payload), int64(len(payload)))
+	if err != nil {
+		return nil, err
+	}
+	encoded, err := json.Marshal(&offloadedEnvelope{Object: offloadedObject{Name: name, Size: len(payload)}})
+	if err != nil {
+		return nil, zerrors.ThrowInternal(err, "PAYLO-Ox8wn3qv2m", "Errors.Internal")
+	}
+	return encoded, nil
+}
+
+func (o *offloading) isEncoded(payload []byte) bool {
+	return hasField(payload, offloadedField)
+}
+
+func (o *offloading) decode(ctx context.Context, aggregate *eventstore.Aggregate, payload []byte) ([]byte, error) {
+	var envelope offloadedEnvelope
+	if err := json.Unmarshal(payload, &envelope); err != nil {
+		return nil, zerrors.ThrowInternal(err, "PAYLO-Oq4nv8wx2m", "Errors.Internal")
+	}
+	object, _, err := o.storage.GetObject(ctx, aggregate.InstanceID, aggregate.ResourceOwner, envelope.Object.Name)
+	if err != nil {
+		return nil, err
+	}
+	return object, nil
+}
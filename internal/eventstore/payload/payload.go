@@ -0,0 +1,125 @@
+// Package payload reduces the size of the event payloads stored in the eventstore.
+// Large payloads are compressed or offloaded to the asset storage, the event only contains a pointer to the offloaded payload.
+// The payloads are restored transparently when the events are read through the eventstore.
+package payload
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+// codec encodes the payloads on push and decodes them on read
+type codec interface {
+	// encode returns nil if the payload is stored unchanged
+	encode(ctx context.Context, aggregate *eventstore.Aggregate, payload []byte) ([]byte, error)
+	// isEncoded checks if the stored payload was encoded by the codec
+	isEncoded(payload []byte) bool
+	decode(ctx context.Context, aggregate *eventstore.Aggregate, payload []byte) ([]byte, error)
+}
+
+func wrap(config *eventstore.Config, c codec) {
+	config.Pusher = &pusher{Pusher: config.Pusher, codec: c}
+	config.Querier = &querier{Querier: config.Querier, codec: c}
+}
+
+type pusher struct {
+	eventstore.Pusher
+	codec codec
+}
+
+func (p *pusher) Push(ctx context.Context, commands ...eventstore.Command) (_ []eventstore.Event, err error) {
+	encoded := make([]eventstore.Command, len(commands))
+	for i, cmd := range commands {
+		encoded[i], err = p.encode(ctx, cmd)
+		if err != nil {
+			return nil, err
+		}
+	}
+	events, err := p.Pusher.Push(ctx, encoded...)
+	if err != nil {
+		return nil, err
+	}
+	for i, event := range events {
+		events[i], err = decode(ctx, p.codec, event)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return events, nil
+}
+
+func (p *pusher) encode(ctx context.Context, cmd eventstore.Command) (eventstore.Command, error) {
+	payload, err := eventstore.EventData(cmd)
+	if err != nil || len(payload) == 0 {
+		return cmd, err
+	}
+	encoded, err := p.codec.encode(ctx, cmd.Aggregate(), payload)
+	if err != nil || encoded == nil {
+		return cmd, err
+	}
+	return &encodedCommand{Command: cmd, payload: encoded}, nil
+}
+
+type querier struct {
+	eventstore.Querier
+	codec codec
+}
+
+func (q *querier) FilterToReducer(ctx context.Context, searchQuery *eventstore.SearchQueryBuilder, reduce eventstore.Reducer) error {
+	return q.Querier.FilterToReducer(ctx, searchQuery, func(event eventstore.Event) error {
+		decoded, err := decode(ctx, q.codec, event)
+		if err != nil {
+			return err
+		}
+		return reduce(decoded)
+	})
+}
+
+func decode(ctx context.Context, c codec, event eventstore.Event) (eventstore.Event, error) {
+	if !c.isEncoded(event.DataAsBytes()) {
+		return event, nil
+	}
+	payload, err := c.decode(ctx, event.Aggregate(), event.DataAsBytes())
+	if err != nil {
+		return nil, err
+	}
+	return &decodedEvent{Event: event, payload: payload}, nil
+}
+
+// hasField checks if the payload is an envelope of a codec,
+// the envelopes only contain their field, so it's always the first key
+func hasField(payload []byte, field string) bool {
+	return bytes.HasPrefix(bytes.TrimSpace(payload), []byte(`{"`+field+`"`))
+}
+
+type encodedCommand struct {
+	eventstore.Command
+	payload []byte
+}
+
+func (c *encodedCommand) Payload() any {
+	return c.payload
+}
+
+type decodedEvent struct {
+	eventstore.Event
+	payload []byte
+}
+
+func (e *decodedEvent) Unmarshal(ptr any) error {
+	if len(e.payload) == 0 {
+		return nil
+	}
+	if err := json.Unmarshal(e.payload, ptr); err != nil {
+		return zerrors.ThrowInternal(err, "PAYLO-Dn4wq8xv2m", "Errors.Internal")
+	}
+	return nil
+}
+
+func (e *decodedEvent) DataAsBytes() []byte {
+	return e.payload
+}
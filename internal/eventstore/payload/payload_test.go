@@ -0,0 +1,129 @@
+package payload
+
+import (
+	"context"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/repository/org"
+	"github.com/zitadel/zitadel/internal/static"
+	"github.com/zitadel/zitadel/internal/static/mock"
+)
+
+// memoryStore stores the pushed events with their raw payload
+type memoryStore struct {
+	events []eventstore.Event
+}
+
+func (s *memoryStore) Health(context.Context) error { return nil }
+
+func (s *memoryStore) Push(_ context.Context, commands ...eventstore.Command) ([]eventstore.Event, error) {
+	events := make([]eventstore.Event, len(commands))
+	for i, cmd := range commands {
+		payload, err := eventstore.EventData(cmd)
+		if err != nil {
+			return nil, err
+		}
+		events[i] = &eventstore.BaseEvent{
+			Agg:       cmd.Aggregate(),
+			EventType: cmd.Type(),
+			Data:      payload,
+		}
+	}
+	s.events = append(s.events, events...)
+	return events, nil
+}
+
+func (s *memoryStore) FilterToReducer(_ context.Context, _ *eventstore.SearchQueryBuilder, reduce eventstore.Reducer) error {
+	for _, event := range s.events {
+		if err := reduce(event); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s *memoryStore) LatestSequence(context.Context, *eventstore.SearchQueryBuilder) (float64, error) {
+	return 0, nil
+}
+
+func (s *memoryStore) InstanceIDs(context.Context, *eventstore.SearchQueryBuilder) ([]string, error) {
+	return nil, nil
+}
+
+func filterNames(t *testing.T, config *eventstore.Config) []string {
+	var names []string
+	err := config.Querier.FilterToReducer(context.Background(), nil, func(event eventstore.Event) error {
+		changed := new(org.OrgChangedEvent)
+		if err := event.Unmarshal(changed); err != nil {
+			return err
+		}
+		names = append(names, changed.Name)
+		return nil
+	})
+	require.NoError(t, err)
+	return names
+}
+
+func pushNames(t *testing.T, config *eventstore.Config, names ...string) {
+	ctx := context.Background()
+	agg := &org.NewAggregate("org1").Aggregate
+	agg.InstanceID = "instance1"
+	commands := make([]eventstore.Command, len(names))
+	for i, name := range names {
+		commands[i] = org.NewOrgChangedEvent(ctx, agg, "", name)
+	}
+	events, err := config.Pusher.Push(ctx, commands...)
+	require.NoError(t, err)
+	for i, event := range events {
+		assert.Contains(t, string(event.DataAsBytes()), names[i])
+	}
+}
+
+func TestWrapCompression(t *testing.T) {
+	store := new(memoryStore)
+	config := &eventstore.Config{Pusher: store, Querier: store}
+	WrapCompression(config, 100)
+
+	large := strings.Repeat("large", 100)
+	pushNames(t, config, "small", large)
+
+	assert.False(t, hasField(store.events[0].DataAsBytes(), compressedField))
+	assert.True(t, hasField(store.events[1].DataAsBytes(), compressedField))
+	assert.Less(t, len(store.events[1].DataAsBytes()), len(large))
+	assert.Equal(t, []string{"small", large}, filterNames(t, config))
+}
+
+func TestWrapOffloading(t *testing.T) {
+	store := new(memoryStore)
+	storage := mock.NewStorage(t)
+	objects := make(map[string][]byte)
+	storage.EXPECT().
+		PutObject(gomock.Any(), "instance1", "", "org1", gomock.Any(), "application/json", static.ObjectTypeEventPayload, gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, _, _, _, name, _ string, _ static.ObjectType, object io.Reader, _ int64) (*static.Asset, error) {
+			objects[name], _ = io.ReadAll(object)
+			return &static.Asset{Name: name}, nil
+		})
+	storage.EXPECT().
+		GetObject(gomock.Any(), "instance1", "org1", gomock.Any()).
+		DoAndReturn(func(_ context.Context, _, _, name string) ([]byte, func() (*static.Asset, error), error) {
+			return objects[name], nil, nil
+		}).
+		Times(2)
+	config := &eventstore.Config{Pusher: store, Querier: store}
+	WrapOffloading(config, storage, 100)
+
+	large := strings.Repeat("large", 100)
+	pushNames(t, config, "small", large)
+
+	assert.False(t, hasField(store.events[0].DataAsBytes(), offloadedField))
+	assert.True(t, hasField(store.events[1].DataAsBytes(), offloadedField))
+	assert.NotContains(t, string(store.events[1].DataAsBytes()), large)
+	assert.Equal(t, []string{"small", large}, filterNames(t, config))
+}
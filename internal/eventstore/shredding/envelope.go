@@ -0,0 +1,75 @@
+package shredding
+
+import (
+	"bytes"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/json"
+
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+// envelopeField identifies encrypted payloads, it can't collide with the payload of an event
+const envelopeField = "$shredded"
+
+// envelope is stored as payload of the encrypted events
+type envelope struct {
+	Data []byte `json:"$shredded"`
+}
+
+// isEnvelope checks if the payload was encrypted
+func isEnvelope(payload []byte) bool {
+	return bytes.Contains(payload, []byte(`"`+envelopeField+`"`))
+}
+
+// seal encrypts the payload with AES-GCM, the subject is used as additional data
+// so the payload can't be moved to another subject
+func seal(key []byte, subjectID string, payload []byte) ([]byte, error) {
+	aead, err := newAEAD(key)
+	if err != nil {
+		return nil, err
+	}
+	nonce := make([]byte, aead.NonceSize())
+	if _, err = rand.Read(nonce); err != nil {
+		return nil, zerrors.ThrowInternal(err, "SHRED-Ew4nq8xv2m", "Errors.Internal")
+	}
+	sealed, err := json.Marshal(&envelope{Data: aead.Seal(nonce, nonce, payload, []byte(subjectID))})
+	if err != nil {
+		return nil, zerrors.ThrowInternal(err, "SHRED-Ex8wv3nq7m", "Errors.Internal")
+	}
+	return sealed, nil
+}
+
+// open decrypts the payload sealed by [seal]
+func open(key []byte, subjectID string, payload []byte) ([]byte, error) {
+	var e envelope
+	if err := json.Unmarshal(payload, &e); err != nil {
+		return nil, zerrors.ThrowInternal(err, "SHRED-En2xq9wv4m", "Errors.Internal")
+	}
+	aead, err := newAEAD(key)
+	if err != nil {
+		return nil, err
+	}
+	if len(e.Data) < aead.NonceSize() {
+		return nil, zerrors.ThrowInternal(nil, "SHRED-Eq7nv3wx8m", "Errors.Internal")
+	}
+	nonce, ciphertext := e.Data[:aead.NonceSize()], e.Data[aead.NonceSize():]
+	plaintext, err := aead.Open(nil, nonce, ciphertext, []byte(subjectID))
+	if err != nil {
+		return nil, zerrors.ThrowInternal(err, "SHRED-Ew9xn2qv4m", "Errors.Internal")
+	}
+	return plaintext, nil
+}
+
+func newAEAD(key []byte) (cipher.AEAD, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, zerrors.ThrowInternal(err, "SHRED-Ev3qw8nx2m", "Errors.Internal")
+	}
+	aead, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, zerrors.ThrowInternal(err, "SHRED-Eq4xn9wv7m", "Errors.Internal")
+	}
+	return aead, nil
+}
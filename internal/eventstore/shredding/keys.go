@@ -0,0 +1,89 @@
+package shredding
+
+import (
+	"context"
+	"crypto/rand"
+	"database/sql"
+	"encoding/json"
+	"errors"
+
+	"github.com/zitadel/zitadel/internal/crypto"
+	"github.com/zitadel/zitadel/internal/database"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+const keySize = 32
+
+// KeyStorage stores the data encryption keys of the subjects
+type KeyStorage interface {
+	// EnsureKey returns the key of the subject, the key is created if it does not exist
+	EnsureKey(ctx context.Context, instanceID, subjectID string) ([]byte, error)
+	// Key returns the key of the subject, if the key does not exist (anymore) nil is returned
+	Key(ctx context.Context, instanceID, subjectID string) ([]byte, error)
+	// DestroyKey deletes the key of the subject, the payloads encrypted with the key become unreadable
+	DestroyKey(ctx context.Context, instanceID, subjectID string) error
+}
+
+const (
+	insertKeyStmt = "INSERT INTO eventstore.subject_keys (instance_id, subject_id, key) VALUES ($1, $2, $3) ON CONFLICT (instance_id, subject_id) DO NOTHING"
+	selectKeyStmt = "SELECT key FROM eventstore.subject_keys WHERE instance_id = $1 AND subject_id = $2"
+	deleteKeyStmt = "DELETE FROM eventstore.subject_keys WHERE instance_id = $1 AND subject_id = $2"
+)
+
+type dbKeyStorage struct {
+	client *database.DB
+	alg    crypto.EncryptionAlgorithm
+}
+
+// NewKeyStorage stores the keys in the database, encrypted with alg
+func NewKeyStorage(client *database.DB, alg crypto.EncryptionAlgorithm) KeyStorage {
+	return &dbKeyStorage{
+		client: client,
+		alg:    alg,
+	}
+}
+
+func (s *dbKeyStorage) EnsureKey(ctx context.Context, instanceID, subjectID string) ([]byte, error) {
+	key := make([]byte, keySize)
+	if _, err := rand.Read(key); err != nil {
+		return nil, zerrors.ThrowInternal(err, "SHRED-Kq8wn3vx2m", "Errors.Internal")
+	}
+	encrypted, err := crypto.Encrypt(key, s.alg)
+	if err != nil {
+		return nil, err
+	}
+	value, err := json.Marshal(encrypted)
+	if err != nil {
+		return nil, zerrors.ThrowInternal(err, "SHRED-Kw2mv9qx4n", "Errors.Internal")
+	}
+	if _, err = s.client.ExecContext(ctx, insertKeyStmt, instanceID, subjectID, value); err != nil {
+		return nil, zerrors.ThrowInternal(err, "SHRED-Kx7nq3wv8m", "Errors.Internal")
+	}
+	// another push might have created the key concurrently
+	return s.Key(ctx, instanceID, subjectID)
+}
+
+func (s *dbKeyStorage) Key(ctx context.Context, instanceID, subjectID string) (key []byte, err error) {
+	var value []byte
+	err = s.client.QueryRowContext(ctx, func(row *sql.Row) error {
+		return row.Scan(&value)
+	}, selectKeyStmt, instanceID, subjectID)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, zerrors.ThrowInternal(err, "SHRED-Kv4wq8nx2m", "Errors.Internal")
+	}
+	encrypted := new(crypto.CryptoValue)
+	if err = json.Unmarshal(value, encrypted); err != nil {
+		return nil, zerrors.ThrowInternal(err, "SHRED-Kn9xw2qv7m", "Errors.Internal")
+	}
+	return crypto.Decrypt(encrypted, s.alg)
+}
+
+func (s *dbKeyStorage) DestroyKey(ctx context.Context, instanceID, subjectID string) error {
+	if _, err := s.client.ExecContext(ctx, deleteKeyStmt, instanceID, subjectID); err != nil {
+		return zerrors.ThrowInternal(err, "SHRED-Kq3vn8wx4m", "Errors.Internal")
+	}
+	return nil
+}
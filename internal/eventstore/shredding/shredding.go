@@ -0,0 +1,184 @@
+// Package shredding encrypts the payloads of the events of a subject with a key per subject.
+// Destroying the key makes the payloads of the subject unreadable while the events stay in the eventstore,
+// which allows to forget the personal data of removed users without rewriting the event stream.
+package shredding
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/zitadel/logging"
+
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/repository/user"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+// Wrap encrypts the payloads pushed by the pusher of the config and decrypts the payloads read by the querier
+func Wrap(config *eventstore.Config, keys KeyStorage) {
+	config.Pusher = &pusher{Pusher: config.Pusher, keys: keys}
+	config.Querier = &querier{Querier: config.Querier, keys: keys}
+}
+
+// subjectOf returns the subject whose key encrypts the payload of the command,
+// an empty subject means the payload is stored unencrypted
+func subjectOf(cmd eventstore.Command) string {
+	if cmd.Aggregate().Type != user.AggregateType {
+		return ""
+	}
+	return cmd.Aggregate().ID
+}
+
+// isSubjectRemoved returns true if the command removes the subject, its key is destroyed after the push
+func isSubjectRemoved(cmd eventstore.Command) bool {
+	return cmd.Aggregate().Type == user.AggregateType && cmd.Type() == user.UserRemovedType
+}
+
+type pusher struct {
+	eventstore.Pusher
+	keys KeyStorage
+}
+
+func (p *pusher) Push(ctx context.Context, commands ...eventstore.Command) (_ []eventstore.Event, err error) {
+	keys := make(keyCache)
+	encrypted := make([]eventstore.Command, len(commands))
+	for i, cmd := range commands {
+		encrypted[i], err = p.encrypt(ctx, keys, cmd)
+		if err != nil {
+			return nil, err
+		}
+	}
+	events, err := p.Pusher.Push(ctx, encrypted...)
+	if err != nil {
+		return nil, err
+	}
+	for i, event := range events {
+		events[i], err = decrypt(event, keys.get(event.Aggregate().InstanceID, event.Aggregate().ID))
+		if err != nil {
+			return nil, err
+		}
+	}
+	for _, cmd := range commands {
+		if !isSubjectRemoved(cmd) {
+			continue
+		}
+		// the events are already stored, so the removal must not fail
+		err = p.keys.DestroyKey(ctx, cmd.Aggregate().InstanceID, cmd.Aggregate().ID)
+		logging.WithFields("instance", cmd.Aggregate().InstanceID, "subject", cmd.Aggregate().ID).OnError(err).Error("unable to destroy key of removed subject")
+	}
+	return events, nil
+}
+
+func (p *pusher) encrypt(ctx context.Context, keys keyCache, cmd eventstore.Command) (eventstore.Command, error) {
+	subject := subjectOf(cmd)
+	if subject == "" {
+		return cmd, nil
+	}
+	payload, err := eventstore.EventData(cmd)
+	if err != nil || len(payload) == 0 {
+		return cmd, err
+	}
+	key, ok := keys.lookup(cmd.Aggregate().InstanceID, subject)
+	if !ok {
+		key, err = p.keys.EnsureKey(ctx, cmd.Aggregate().InstanceID, subject)
+		if err != nil {
+			return nil, err
+		}
+		keys.set(cmd.Aggregate().InstanceID, subject, key)
+	}
+	sealed, err := seal(key, subject, payload)
+	if err != nil {
+		return nil, err
+	}
+	return &encryptedCommand{Command: cmd, payload: sealed}, nil
+}
+
+type querier struct {
+	eventstore.Querier
+	keys KeyStorage
+}
+
+func (q *querier) FilterToReducer(ctx context.Context, searchQuery *eventstore.SearchQueryBuilder, reduce eventstore.Reducer) error {
+	keys := make(keyCache)
+	return q.Querier.FilterToReducer(ctx, searchQuery, func(event eventstore.Event) error {
+		if !isEnvelope(event.DataAsBytes()) {
+			return reduce(event)
+		}
+		key, ok := keys.lookup(event.Aggregate().InstanceID, event.Aggregate().ID)
+		if !ok {
+			var err error
+			key, err = q.keys.Key(ctx, event.Aggregate().InstanceID, event.Aggregate().ID)
+			if err != nil {
+				return err
+			}
+			keys.set(event.Aggregate().InstanceID, event.Aggregate().ID, key)
+		}
+		decrypted, err := decrypt(event, key)
+		if err != nil {
+			return err
+		}
+		return reduce(decrypted)
+	})
+}
+
+// decrypt returns the event with the decrypted payload.
+// If the key was destroyed the payload of the event is empty.
+func decrypt(event eventstore.Event, key []byte) (eventstore.Event, error) {
+	if !isEnvelope(event.DataAsBytes()) {
+		return event, nil
+	}
+	if key == nil {
+		return &decryptedEvent{Event: event}, nil
+	}
+	payload, err := open(key, event.Aggregate().ID, event.DataAsBytes())
+	if err != nil {
+		return nil, err
+	}
+	return &decryptedEvent{Event: event, payload: payload}, nil
+}
+
+type encryptedCommand struct {
+	eventstore.Command
+	payload []byte
+}
+
+func (c *encryptedCommand) Payload() any {
+	return c.payload
+}
+
+type decryptedEvent struct {
+	eventstore.Event
+	payload []byte
+}
+
+func (e *decryptedEvent) Unmarshal(ptr any) error {
+	if len(e.payload) == 0 {
+		return nil
+	}
+	if err := json.Unmarshal(e.payload, ptr); err != nil {
+		return zerrors.ThrowInternal(err, "SHRED-Dq8xn3wv2m", "Errors.Internal")
+	}
+	return nil
+}
+
+func (e *decryptedEvent) DataAsBytes() []byte {
+	return e.payload
+}
+
+// keyCache avoids loading the key of a subject for every event.
+// Destroyed keys are cached as nil.
+type keyCache map[string][]byte
+
+func (c keyCache) lookup(instanceID, subjectID string) ([]byte, bool) {
+	key, ok := c[instanceID+"/"+subjectID]
+	return key, ok
+}
+
+func (c keyCache) get(instanceID, subjectID string) []byte {
+	key, _ := c.lookup(instanceID, subjectID)
+	return key
+}
+
+func (c keyCache) set(instanceID, subjectID string, key []byte) {
+	c[instanceID+"/"+subjectID] = key
+}
@@ -0,0 +1,150 @@
+package shredding
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/repository/org"
+	"github.com/zitadel/zitadel/internal/repository/user"
+)
+
+type memoryKeys struct {
+	keys map[string][]byte
+}
+
+func (m *memoryKeys) EnsureKey(ctx context.Context, instanceID, subjectID string) ([]byte, error) {
+	if key, _ := m.Key(ctx, instanceID, subjectID); key != nil {
+		return key, nil
+	}
+	key := make([]byte, keySize)
+	key[0] = byte(len(m.keys) + 1)
+	m.keys[instanceID+"/"+subjectID] = key
+	return key, nil
+}
+
+func (m *memoryKeys) Key(_ context.Context, instanceID, subjectID string) ([]byte, error) {
+	return m.keys[instanceID+"/"+subjectID], nil
+}
+
+func (m *memoryKeys) DestroyKey(_ context.Context, instanceID, subjectID string) error {
+	delete(m.keys, instanceID+"/"+subjectID)
+	return nil
+}
+
+// memoryStore stores the pushed events with their raw payload
+type memoryStore struct {
+	events []eventstore.Event
+}
+
+func (s *memoryStore) Health(context.Context) error { return nil }
+
+func (s *memoryStore) Push(_ context.Context, commands ...eventstore.Command) ([]eventstore.Event, error) {
+	events := make([]eventstore.Event, len(commands))
+	for i, cmd := range commands {
+		payload, err := eventstore.EventData(cmd)
+		if err != nil {
+			return nil, err
+		}
+		events[i] = &eventstore.BaseEvent{
+			Agg:       cmd.Aggregate(),
+			EventType: cmd.Type(),
+			Data:      payload,
+			Seq:       uint64(len(s.events) + i + 1),
+		}
+	}
+	s.events = append(s.events, events...)
+	return events, nil
+}
+
+func (s *memoryStore) FilterToReducer(_ context.Context, _ *eventstore.SearchQueryBuilder, reduce eventstore.Reducer) error {
+	for _, event := range s.events {
+		if err := reduce(event); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s *memoryStore) LatestSequence(context.Context, *eventstore.SearchQueryBuilder) (float64, error) {
+	return 0, nil
+}
+
+func (s *memoryStore) InstanceIDs(context.Context, *eventstore.SearchQueryBuilder) ([]string, error) {
+	return nil, nil
+}
+
+func newTestConfig() (*eventstore.Config, *memoryStore, *memoryKeys) {
+	store := new(memoryStore)
+	keys := &memoryKeys{keys: make(map[string][]byte)}
+	config := &eventstore.Config{Pusher: store, Querier: store}
+	Wrap(config, keys)
+	return config, store, keys
+}
+
+func filterPayloads(t *testing.T, config *eventstore.Config) []string {
+	var payloads []string
+	err := config.Querier.FilterToReducer(context.Background(), nil, func(event eventstore.Event) error {
+		payloads = append(payloads, string(event.DataAsBytes()))
+		return nil
+	})
+	require.NoError(t, err)
+	return payloads
+}
+
+func TestShredding(t *testing.T) {
+	ctx := context.Background()
+	userAgg := &user.NewAggregate("user1", "org1").Aggregate
+	userAgg.InstanceID = "instance1"
+	orgAgg := &org.NewAggregate("org1").Aggregate
+	orgAgg.InstanceID = "instance1"
+
+	config, store, keys := newTestConfig()
+	events, err := config.Pusher.Push(ctx,
+		user.NewUsernameChangedEvent(ctx, userAgg, "jane", "jane.doe", false),
+		org.NewOrgChangedEvent(ctx, orgAgg, "old", "new"),
+	)
+	require.NoError(t, err)
+
+	// the pushed events are returned in plaintext
+	var changed user.UsernameChangedEvent
+	require.NoError(t, events[0].Unmarshal(&changed))
+	assert.Equal(t, "jane.doe", changed.UserName)
+
+	// the payload of the user is stored encrypted, other aggregates are not encrypted
+	assert.True(t, isEnvelope(store.events[0].DataAsBytes()))
+	assert.NotContains(t, string(store.events[0].DataAsBytes()), "jane.doe")
+	assert.False(t, isEnvelope(store.events[1].DataAsBytes()))
+
+	payloads := filterPayloads(t, config)
+	assert.Contains(t, payloads[0], "jane.doe")
+	assert.Contains(t, payloads[1], "new")
+
+	_, err = config.Pusher.Push(ctx, user.NewUserRemovedEvent(ctx, userAgg, "jane.doe", nil, false))
+	require.NoError(t, err)
+	assert.Empty(t, keys.keys)
+
+	// the events of the removed user stay, but their payload can't be read anymore
+	payloads = filterPayloads(t, config)
+	require.Len(t, payloads, 3)
+	assert.Empty(t, payloads[0])
+	assert.Contains(t, payloads[1], "new")
+}
+
+func Test_sealOpen(t *testing.T) {
+	key := make([]byte, keySize)
+	sealed, err := seal(key, "user1", []byte(`{"userName":"jane"}`))
+	require.NoError(t, err)
+	assert.True(t, isEnvelope(sealed))
+
+	opened, err := open(key, "user1", sealed)
+	require.NoError(t, err)
+	assert.Equal(t, `{"userName":"jane"}`, string(opened))
+
+	// the payload is bound to the subject
+	_, err = open(key, "user2", sealed)
+	assert.Error(t, err)
+}
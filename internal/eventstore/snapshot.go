@@ -0,0 +1,115 @@
+package eventstore
+
+import (
+	"context"
+	"encoding/json"
+	"reflect"
+	"time"
+
+	"github.com/zitadel/logging"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+)
+
+// SnapshotReducer is a query reducer which can be hydrated from a snapshot of its state.
+// The state is marshalled as json, the fields of the [WriteModel] are stored beside it.
+// The query of the reducer must only return events of the aggregate of the write model,
+// the events after the snapshot are queried by their sequence.
+type SnapshotReducer interface {
+	QueryReducer
+	// SnapshotType identifies the snapshots of the reducer together with the aggregate id,
+	// e.g. it must contain the language if the reducer only reduces the texts of a language.
+	// It must be changed if the state changes incompatibly.
+	SnapshotType() string
+	writeModel() *WriteModel
+}
+
+// Snapshot is the state of a [SnapshotReducer] after reducing the events up to the sequence
+type Snapshot struct {
+	InstanceID    string
+	AggregateID   string
+	Type          string
+	ResourceOwner string
+	Sequence      uint64
+	ChangeDate    time.Time
+	Payload       []byte
+}
+
+// SnapshotStorage stores the latest snapshot of every reducer
+type SnapshotStorage interface {
+	// Snapshot returns the latest snapshot, nil is returned if no snapshot exists
+	Snapshot(ctx context.Context, instanceID, aggregateID, typ string) (*Snapshot, error)
+	// StoreSnapshot replaces the snapshot of the reducer
+	StoreSnapshot(ctx context.Context, snapshot *Snapshot) error
+}
+
+// filterWithSnapshot hydrates the reducer from its snapshot and reduces the events after the snapshot.
+// A new snapshot is stored if at least [Config.SnapshotInterval] events were reduced.
+func (es *Eventstore) filterWithSnapshot(ctx context.Context, r SnapshotReducer) error {
+	wm := r.writeModel()
+	instanceID := authz.GetInstance(ctx).InstanceID()
+	if wm.AggregateID == "" || instanceID == "" {
+		return es.FilterToReducer(ctx, r.Query(), r)
+	}
+	query := r.Query()
+	snapshot, err := es.snapshots.Snapshot(ctx, instanceID, wm.AggregateID, r.SnapshotType())
+	logging.WithFields("aggregate", wm.AggregateID, "type", r.SnapshotType()).OnError(err).Warn("unable to load snapshot, all events are reduced")
+	if snapshot != nil && restoreSnapshot(r, snapshot) {
+		query = query.SequenceGreater(snapshot.Sequence)
+	}
+
+	counter := &countingReducer{reducer: r}
+	if err = es.FilterToReducer(ctx, query, counter); err != nil {
+		return err
+	}
+	if counter.count < es.snapshotInterval {
+		return nil
+	}
+	payload, err := json.Marshal(r)
+	if err != nil {
+		logging.WithFields("aggregate", wm.AggregateID, "type", r.SnapshotType()).WithError(err).Warn("unable to marshal snapshot")
+		return nil
+	}
+	err = es.snapshots.StoreSnapshot(ctx, &Snapshot{
+		InstanceID:    instanceID,
+		AggregateID:   wm.AggregateID,
+		Type:          r.SnapshotType(),
+		ResourceOwner: wm.ResourceOwner,
+		Sequence:      wm.ProcessedSequence,
+		ChangeDate:    wm.ChangeDate,
+		Payload:       payload,
+	})
+	// the reducer is up to date, the snapshot is created again on the next filter
+	logging.WithFields("aggregate", wm.AggregateID, "type", r.SnapshotType()).OnError(err).Warn("unable to store snapshot")
+	return nil
+}
+
+// restoreSnapshot sets the state of the snapshot to the reducer.
+// The snapshot is ignored if it can't be unmarshalled, e.g. after the state changed.
+func restoreSnapshot(r SnapshotReducer, snapshot *Snapshot) bool {
+	// unmarshal into a new reducer first, so an invalid snapshot doesn't leave a partial state
+	if err := json.Unmarshal(snapshot.Payload, reflect.New(reflect.TypeOf(r).Elem()).Interface()); err != nil {
+		logging.WithFields("aggregate", snapshot.AggregateID, "type", snapshot.Type).WithError(err).Warn("unable to unmarshal snapshot, all events are reduced")
+		return false
+	}
+	if err := json.Unmarshal(snapshot.Payload, r); err != nil {
+		return false
+	}
+	wm := r.writeModel()
+	wm.ResourceOwner = snapshot.ResourceOwner
+	wm.InstanceID = snapshot.InstanceID
+	wm.ProcessedSequence = snapshot.Sequence
+	wm.ChangeDate = snapshot.ChangeDate
+	return true
+}
+
+// countingReducer counts the events reduced after the snapshot
+type countingReducer struct {
+	reducer
+	count uint32
+}
+
+func (r *countingReducer) AppendEvents(events ...Event) {
+	r.count += uint32(len(events))
+	r.reducer.AppendEvents(events...)
+}
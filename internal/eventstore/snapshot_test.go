@@ -0,0 +1,110 @@
+package eventstore
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+)
+
+type testSnapshotReducer struct {
+	WriteModel
+	Count int
+}
+
+func (r *testSnapshotReducer) Reduce() error {
+	r.Count += len(r.Events)
+	return r.WriteModel.Reduce()
+}
+
+func (r *testSnapshotReducer) Query() *SearchQueryBuilder {
+	return NewSearchQueryBuilder(ColumnsEvent).AddQuery().AggregateIDs(r.AggregateID).Builder()
+}
+
+func (r *testSnapshotReducer) SnapshotType() string {
+	return "test"
+}
+
+type testSnapshotStorage struct {
+	snapshot *Snapshot
+}
+
+func (s *testSnapshotStorage) Snapshot(context.Context, string, string, string) (*Snapshot, error) {
+	return s.snapshot, nil
+}
+
+func (s *testSnapshotStorage) StoreSnapshot(_ context.Context, snapshot *Snapshot) error {
+	s.snapshot = snapshot
+	return nil
+}
+
+// sequenceQuerier only returns the events after the sequence of the query
+type sequenceQuerier struct {
+	testQuerier
+	filtered int
+}
+
+func (repo *sequenceQuerier) FilterToReducer(_ context.Context, searchQuery *SearchQueryBuilder, reduce Reducer) error {
+	for _, event := range repo.events {
+		if event.Sequence() <= searchQuery.eventSequenceGreater {
+			continue
+		}
+		repo.filtered++
+		if err := reduce(event); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func testSnapshotEvents(from, to uint64) []Event {
+	events := make([]Event, 0, to-from+1)
+	for seq := from; seq <= to; seq++ {
+		events = append(events, &BaseEvent{
+			Agg:       &Aggregate{ID: "agg", Type: "test.aggregate", ResourceOwner: "ro", InstanceID: "instance"},
+			EventType: "test.event",
+			Seq:       seq,
+		})
+	}
+	return events
+}
+
+func TestEventstore_FilterToQueryReducer_snapshot(t *testing.T) {
+	ctx := authz.WithInstanceID(context.Background(), "instance")
+	querier := &sequenceQuerier{testQuerier: testQuerier{events: testSnapshotEvents(1, 5)}}
+	storage := new(testSnapshotStorage)
+	es := NewEventstore(&Config{Querier: querier, SnapshotStorage: storage, SnapshotInterval: 3})
+
+	reducer := &testSnapshotReducer{WriteModel: WriteModel{AggregateID: "agg"}}
+	require.NoError(t, es.FilterToQueryReducer(ctx, reducer))
+	assert.Equal(t, 5, reducer.Count)
+	require.NotNil(t, storage.snapshot)
+	assert.Equal(t, uint64(5), storage.snapshot.Sequence)
+	assert.Equal(t, "ro", storage.snapshot.ResourceOwner)
+
+	// only the events after the snapshot are reduced, no new snapshot is stored for less than the interval
+	querier.events = testSnapshotEvents(1, 6)
+	querier.filtered = 0
+	reducer = &testSnapshotReducer{WriteModel: WriteModel{AggregateID: "agg"}}
+	require.NoError(t, es.FilterToQueryReducer(ctx, reducer))
+	assert.Equal(t, 1, querier.filtered)
+	assert.Equal(t, 6, reducer.Count)
+	assert.Equal(t, uint64(6), reducer.ProcessedSequence)
+	assert.Equal(t, "ro", reducer.ResourceOwner)
+	assert.Equal(t, uint64(5), storage.snapshot.Sequence)
+}
+
+func TestEventstore_FilterToQueryReducer_invalidSnapshot(t *testing.T) {
+	ctx := authz.WithInstanceID(context.Background(), "instance")
+	querier := &sequenceQuerier{testQuerier: testQuerier{events: testSnapshotEvents(1, 2)}}
+	storage := &testSnapshotStorage{snapshot: &Snapshot{Sequence: 1, Payload: []byte(`{"Count":"invalid"}`)}}
+	es := NewEventstore(&Config{Querier: querier, SnapshotStorage: storage, SnapshotInterval: 10})
+
+	reducer := &testSnapshotReducer{WriteModel: WriteModel{AggregateID: "agg"}}
+	require.NoError(t, es.FilterToQueryReducer(ctx, reducer))
+	assert.Equal(t, 2, querier.filtered)
+	assert.Equal(t, 2, reducer.Count)
+}
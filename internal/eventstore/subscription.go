@@ -55,6 +55,12 @@ func SubscribeEventTypes(eventQueue chan Event, types map[AggregateType][]EventT
 	return sub
 }
 
+// NotifyChanges passes the events pushed by other processes to the subscriptions.
+// The events might only contain the aggregate and the event type.
+func (es *Eventstore) NotifyChanges(events ...Event) {
+	es.notify(events)
+}
+
 func (es *Eventstore) notify(events []Event) {
 	subsMutext.Lock()
 	defer subsMutext.Unlock()
@@ -14,7 +14,8 @@ var (
 )
 
 type Eventstore struct {
-	client *database.DB
+	client        *database.DB
+	notifyChanges bool
 }
 
 func NewEventstore(client *database.DB) *Eventstore {
@@ -0,0 +1,168 @@
+package eventstore
+
+import (
+	"context"
+	"crypto/rand"
+	"database/sql"
+	"database/sql/driver"
+	"encoding/hex"
+	"encoding/json"
+	"time"
+
+	"github.com/jackc/pgx/v5/stdlib"
+	"github.com/zitadel/logging"
+
+	"github.com/zitadel/zitadel/internal/database"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+// ChangeNotificationChannel is the channel the pushed events are announced on
+const ChangeNotificationChannel = "eventstore_events"
+
+const (
+	listenMinBackoff = 100 * time.Millisecond
+	listenMaxBackoff = 30 * time.Second
+)
+
+// origin identifies the notifications of this process,
+// the events pushed by this process are already announced to the subscribers in-process.
+var origin = newOrigin()
+
+func newOrigin() string {
+	id := make([]byte, 8)
+	_, err := rand.Read(id)
+	logging.OnError(err).Fatal("unable to generate change notification origin")
+	return hex.EncodeToString(id)
+}
+
+// changeNotification announces the type of pushed events of an instance.
+// The payload of the event is not part of the notification,
+// as postgres limits notifications to 8000 bytes.
+type changeNotification struct {
+	Origin        string                   `json:"origin"`
+	InstanceID    string                   `json:"instanceID"`
+	AggregateType eventstore.AggregateType `json:"aggregateType"`
+	EventType     eventstore.EventType     `json:"eventType"`
+}
+
+// EnableChangeNotifications announces the pushed events on [ChangeNotificationChannel]
+// so other processes can consume them without polling.
+// It's only supported on postgres and ignored on cockroach.
+func (es *Eventstore) EnableChangeNotifications() *Eventstore {
+	if es.client.Type() != "postgres" {
+		logging.WithFields("database", es.client.Type()).Warn("change notifications are only supported on postgres")
+		return es
+	}
+	es.notifyChanges = true
+	return es
+}
+
+func changeNotifications(commands []eventstore.Command) ([]string, error) {
+	payloads := make([]string, 0, len(commands))
+	seen := make(map[changeNotification]struct{}, len(commands))
+	for _, command := range commands {
+		notification := changeNotification{
+			Origin:        origin,
+			InstanceID:    command.Aggregate().InstanceID,
+			AggregateType: command.Aggregate().Type,
+			EventType:     command.Type(),
+		}
+		if _, ok := seen[notification]; ok {
+			continue
+		}
+		seen[notification] = struct{}{}
+		payload, err := json.Marshal(notification)
+		if err != nil {
+			return nil, zerrors.ThrowInternal(err, "V3-Ch4nG", "Errors.Internal")
+		}
+		payloads = append(payloads, string(payload))
+	}
+	return payloads, nil
+}
+
+// notifyChangesStmt is executed in the push transaction, postgres only delivers the notifications on commit
+const notifyChangesStmt = "SELECT pg_notify($1, n) FROM unnest($2::TEXT[]) AS n"
+
+func notifyChanges(ctx context.Context, tx *sql.Tx, commands []eventstore.Command) error {
+	payloads, err := changeNotifications(commands)
+	if err != nil {
+		return err
+	}
+	_, err = tx.ExecContext(ctx, notifyChangesStmt, ChangeNotificationChannel, database.TextArray[string](payloads))
+	return err
+}
+
+// Listen consumes the change notifications of other processes and passes them to notify
+// until ctx is done. The events only contain the instance, aggregate type and event type.
+// Lost connections are reestablished with an exponential backoff,
+// notifications sent in the meantime are lost and must be caught up by polling.
+func Listen(ctx context.Context, client *database.DB, notify func(...eventstore.Event)) {
+	backoff := listenMinBackoff
+	for {
+		started := time.Now()
+		err := listen(ctx, client, notify)
+		if ctx.Err() != nil {
+			return
+		}
+		if time.Since(started) > listenMaxBackoff {
+			backoff = listenMinBackoff
+		}
+		logging.WithError(err).WithField("retry_in", backoff).Warn("listening for change notifications failed")
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(backoff):
+		}
+		backoff = min(backoff*2, listenMaxBackoff)
+	}
+}
+
+func listen(ctx context.Context, client *database.DB, notify func(...eventstore.Event)) error {
+	conn, err := client.Conn(ctx)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	listenErr := conn.Raw(func(driverConn any) error {
+		pgxConn := driverConn.(*stdlib.Conn).Conn()
+		if _, err := pgxConn.Exec(ctx, "LISTEN "+ChangeNotificationChannel); err != nil {
+			return err
+		}
+		for {
+			notification, err := pgxConn.WaitForNotification(ctx)
+			if err != nil {
+				return err
+			}
+			event, ok := eventFromNotification(notification.Payload)
+			if !ok {
+				continue
+			}
+			notify(event)
+		}
+	})
+	// the connection is still listening, it must not be returned to the pool
+	_ = conn.Raw(func(any) error { return driver.ErrBadConn })
+	return listenErr
+}
+
+// eventFromNotification maps the notification to an event,
+// ok is false if the notification is invalid or was sent by this process
+func eventFromNotification(payload string) (_ eventstore.Event, ok bool) {
+	var notification changeNotification
+	if err := json.Unmarshal([]byte(payload), &notification); err != nil {
+		logging.WithError(err).Debug("unable to unmarshal change notification")
+		return nil, false
+	}
+	if notification.Origin == origin {
+		return nil, false
+	}
+	return &eventstore.BaseEvent{
+		EventType: notification.EventType,
+		Agg: &eventstore.Aggregate{
+			InstanceID: notification.InstanceID,
+			Type:       notification.AggregateType,
+		},
+	}, true
+}
@@ -0,0 +1,63 @@
+package eventstore
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/zitadel/zitadel/internal/eventstore"
+)
+
+func Test_changeNotifications(t *testing.T) {
+	otherInstance := mockAggregate("id2")
+	otherInstance.InstanceID = "instance2"
+
+	payloads, err := changeNotifications([]eventstore.Command{
+		&mockCommand{aggregate: mockAggregate("id1")},
+		&mockCommand{aggregate: mockAggregate("id2")},
+		&mockCommand{aggregate: otherInstance},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []string{
+		`{"origin":"` + origin + `","instanceID":"instance","aggregateType":"type","eventType":"event.type"}`,
+		`{"origin":"` + origin + `","instanceID":"instance2","aggregateType":"type","eventType":"event.type"}`,
+	}, payloads)
+}
+
+func Test_eventFromNotification(t *testing.T) {
+	tests := []struct {
+		name    string
+		payload string
+		want    eventstore.Event
+		wantOk  bool
+	}{
+		{
+			name:    "invalid payload",
+			payload: "invalid",
+		},
+		{
+			name:    "own origin",
+			payload: `{"origin":"` + origin + `","instanceID":"instance","aggregateType":"type","eventType":"event.type"}`,
+		},
+		{
+			name:    "other origin",
+			payload: `{"origin":"other","instanceID":"instance","aggregateType":"type","eventType":"event.type"}`,
+			want: &eventstore.BaseEvent{
+				EventType: "event.type",
+				Agg: &eventstore.Aggregate{
+					InstanceID: "instance",
+					Type:       "type",
+				},
+			},
+			wantOk: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := eventFromNotification(tt.payload)
+			assert.Equal(t, tt.wantOk, ok)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
@@ -54,7 +54,14 @@ func (es *Eventstore) Push(ctx context.Context, commands ...eventstore.Command)
 			}
 		}
 
-		return handleFieldCommands(ctx, tx, commands)
+		if err = handleFieldCommands(ctx, tx, commands); err != nil {
+			return err
+		}
+
+		if es.notifyChanges {
+			return notifyChanges(ctx, tx, commands)
+		}
+		return nil
 	})
 
 	if err != nil {
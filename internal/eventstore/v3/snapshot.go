@@ -0,0 +1,54 @@
+package eventstore
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+const (
+	snapshotQuery = "SELECT resource_owner, sequence, change_date, payload FROM eventstore.snapshots WHERE instance_id = $1 AND aggregate_id = $2 AND snapshot_type = $3"
+	// an older snapshot must not replace a newer one stored concurrently
+	storeSnapshotStmt = "INSERT INTO eventstore.snapshots (instance_id, aggregate_id, snapshot_type, resource_owner, sequence, change_date, payload) VALUES ($1, $2, $3, $4, $5, $6, $7)" +
+		" ON CONFLICT (instance_id, aggregate_id, snapshot_type) DO UPDATE SET resource_owner = EXCLUDED.resource_owner, sequence = EXCLUDED.sequence, change_date = EXCLUDED.change_date, payload = EXCLUDED.payload, created_at = NOW()" +
+		" WHERE eventstore.snapshots.sequence < EXCLUDED.sequence"
+)
+
+// Snapshot implements [eventstore.SnapshotStorage]
+func (es *Eventstore) Snapshot(ctx context.Context, instanceID, aggregateID, typ string) (*eventstore.Snapshot, error) {
+	snapshot := &eventstore.Snapshot{
+		InstanceID:  instanceID,
+		AggregateID: aggregateID,
+		Type:        typ,
+	}
+	err := es.client.QueryRowContext(ctx, func(row *sql.Row) error {
+		return row.Scan(&snapshot.ResourceOwner, &snapshot.Sequence, &snapshot.ChangeDate, &snapshot.Payload)
+	}, snapshotQuery, instanceID, aggregateID, typ)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, zerrors.ThrowInternal(err, "V3-Sn4wq8xv2m", "Errors.Internal")
+	}
+	return snapshot, nil
+}
+
+// StoreSnapshot implements [eventstore.SnapshotStorage]
+func (es *Eventstore) StoreSnapshot(ctx context.Context, snapshot *eventstore.Snapshot) error {
+	_, err := es.client.ExecContext(ctx, storeSnapshotStmt,
+		snapshot.InstanceID,
+		snapshot.AggregateID,
+		snapshot.Type,
+		snapshot.ResourceOwner,
+		snapshot.Sequence,
+		snapshot.ChangeDate,
+		snapshot.Payload,
+	)
+	if err != nil {
+		return zerrors.ThrowInternal(err, "V3-Sx8nv3qw4m", "Errors.Internal")
+	}
+	return nil
+}
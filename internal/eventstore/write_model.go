@@ -45,3 +45,8 @@ func (wm *WriteModel) Reduce() error {
 	wm.Events = []Event{}
 	return nil
 }
+
+// writeModel allows the eventstore to restore the fields of the write model from a snapshot
+func (wm *WriteModel) writeModel() *WriteModel {
+	return wm
+}
@@ -3,6 +3,7 @@ package execution
 import (
 	"bytes"
 	"context"
+	"encoding/json"
 	"io"
 	"net/http"
 	"time"
@@ -94,6 +95,12 @@ func webhook(ctx context.Context, url string, timeout time.Duration, body []byte
 
 // call function to do a post HTTP request to a desired url with timeout
 func call(ctx context.Context, url string, timeout time.Duration, body []byte) (_ []byte, err error) {
+	return signedCall(ctx, url, timeout, body, "")
+}
+
+// signedCall function to do a post HTTP request to a desired url with timeout,
+// the body is signed with the signing key if one is provided
+func signedCall(ctx context.Context, url string, timeout time.Duration, body []byte, signingKey string) (_ []byte, err error) {
 	ctx, cancel := context.WithTimeout(ctx, timeout)
 	ctx, span := tracing.NewSpan(ctx)
 	defer func() {
@@ -106,8 +113,10 @@ func call(ctx context.Context, url string, timeout time.Duration, body []byte) (
 		return nil, err
 	}
 	req.Header.Set("Content-Type", "application/json")
+	if signingKey != "" {
+		req.Header.Set(SigningHeader, ComputeSignatureHeader(time.Now(), body, signingKey))
+	}
 
-	client := http.DefaultClient
 	resp, err := client.Do(req)
 	if err != nil {
 		return nil, err
@@ -118,5 +127,51 @@ func call(ctx context.Context, url string, timeout time.Duration, body []byte) (
 	if resp.StatusCode >= 200 && resp.StatusCode <= 299 {
 		return io.ReadAll(resp.Body)
 	}
+	if resp.StatusCode >= 400 && resp.StatusCode <= 499 {
+		if err := rejection(resp.Body); err != nil {
+			return nil, err
+		}
+	}
 	return nil, zerrors.ThrowUnknown(nil, "EXEC-dra6yamk98", "Errors.Execution.Failed")
 }
+
+// client calls the targets, the trace context is propagated to the targets
+var client = &http.Client{Transport: tracing.HTTPTransport(nil)}
+
+// maxRejectionSize limits the body read from a rejecting target
+const maxRejectionSize = 4 * 1024
+
+// Rejection is the body a target can respond with a 4xx status code,
+// the message is returned to the caller as invalid argument.
+type Rejection struct {
+	Message string `json:"message"`
+}
+
+// rejection returns the error of the target if the body contains a [Rejection] with a message
+func rejection(body io.Reader) error {
+	data, err := io.ReadAll(io.LimitReader(body, maxRejectionSize))
+	if err != nil {
+		return nil
+	}
+	rejection := new(Rejection)
+	if err := json.Unmarshal(data, rejection); err != nil || rejection.Message == "" {
+		return nil
+	}
+	return zerrors.ThrowInvalidArgument(nil, "EXEC-q8v2lk0xhn", rejection.Message)
+}
+
+// TargetsByExecutionID returns a function to query the targets of the executions with the given IDs,
+// which can be used to call the targets of function executions.
+func TargetsByExecutionID(queries Queries) func(ctx context.Context, ids []string) ([]Target, error) {
+	return func(ctx context.Context, ids []string) ([]Target, error) {
+		executionTargets, err := queries.TargetsByExecutionID(ctx, ids)
+		if err != nil {
+			return nil, err
+		}
+		targets := make([]Target, len(executionTargets))
+		for i, target := range executionTargets {
+			targets[i] = target
+		}
+		return targets, nil
+	}
+}
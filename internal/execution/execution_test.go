@@ -6,6 +6,7 @@ import (
 	"io"
 	"net/http"
 	"net/http/httptest"
+	"strings"
 	"testing"
 	"time"
 
@@ -13,6 +14,7 @@ import (
 	"github.com/stretchr/testify/require"
 
 	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/zerrors"
 )
 
 var _ Target = &mockTarget{}
@@ -345,3 +347,36 @@ func Test_CallTarget(t *testing.T) {
 		})
 	}
 }
+
+func Test_rejection(t *testing.T) {
+	tests := []struct {
+		name    string
+		body    string
+		wantErr func(error) bool
+	}{
+		{
+			name: "no json, no rejection",
+			body: "error",
+		},
+		{
+			name: "no message, no rejection",
+			body: `{"code": 1}`,
+		},
+		{
+			name:    "message, invalid argument",
+			body:    `{"message": "email domain not allowed"}`,
+			wantErr: zerrors.IsErrorInvalidArgument,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := rejection(strings.NewReader(tt.body))
+			if tt.wantErr == nil {
+				assert.NoError(t, err)
+				return
+			}
+			assert.True(t, tt.wantErr(err))
+			assert.ErrorContains(t, err, "email domain not allowed")
+		})
+	}
+}
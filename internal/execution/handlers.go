@@ -0,0 +1,277 @@
+package execution
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+	"time"
+
+	"github.com/zitadel/logging"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/crypto"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/eventstore/handler/v2"
+	"github.com/zitadel/zitadel/internal/query"
+	"github.com/zitadel/zitadel/internal/query/projection"
+	exec_repo "github.com/zitadel/zitadel/internal/repository/execution"
+	"github.com/zitadel/zitadel/internal/repository/target"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+const (
+	HandlerTable  = "projections.execution_handler"
+	HandlerUserID = "EXECUTION"
+)
+
+type Config struct {
+	Retry RetryConfig
+}
+
+type Queries interface {
+	TargetsByExecutionID(ctx context.Context, ids []string) (execution []*query.ExecutionTarget, err error)
+	GetTargetByID(ctx context.Context, id string) (target *query.Target, err error)
+}
+
+type Commands interface {
+	TargetDeliveryFailed(ctx context.Context, targetID, deliveryID, resourceOwner string, delivery target.Delivery, attempts uint16, reason string) (string, error)
+	TargetDeliverySucceeded(ctx context.Context, targetID, deliveryID, resourceOwner string) error
+}
+
+type eventHandler struct {
+	eventTypes    map[eventstore.AggregateType][]eventstore.EventType
+	queries       Queries
+	commands      Commands
+	signingKeyAlg crypto.EncryptionAlgorithm
+	retry         RetryConfig
+}
+
+// NewEventHandler returns the handler which delivers the events to the targets of the event executions.
+// Failed deliveries are retried with an exponential backoff and dead-lettered on the target afterward.
+func NewEventHandler(
+	ctx context.Context,
+	config handler.Config,
+	executionConfig *Config,
+	eventTypes []string,
+	aggregateTypeFromEventType func(typ eventstore.EventType) eventstore.AggregateType,
+	queries Queries,
+	commands Commands,
+	signingKeyAlg crypto.EncryptionAlgorithm,
+) *handler.Handler {
+	return handler.NewHandler(ctx, &config, &eventHandler{
+		eventTypes:    groupEventTypes(eventTypes, aggregateTypeFromEventType),
+		queries:       queries,
+		commands:      commands,
+		signingKeyAlg: signingKeyAlg,
+		retry:         executionConfig.Retry,
+	})
+}
+
+// groupEventTypes groups the event types by their aggregate types,
+// the events of targets are excluded so deliveries never trigger further deliveries.
+func groupEventTypes(eventTypes []string, aggregateTypeFromEventType func(typ eventstore.EventType) eventstore.AggregateType) map[eventstore.AggregateType][]eventstore.EventType {
+	grouped := make(map[eventstore.AggregateType][]eventstore.EventType)
+	for _, eventType := range eventTypes {
+		aggregateType := aggregateTypeFromEventType(eventstore.EventType(eventType))
+		if aggregateType == "" || aggregateType == target.AggregateType {
+			continue
+		}
+		grouped[aggregateType] = append(grouped[aggregateType], eventstore.EventType(eventType))
+	}
+	return grouped
+}
+
+func (*eventHandler) Name() string {
+	return HandlerTable
+}
+
+func (h *eventHandler) Reducers() []handler.AggregateReducer {
+	reducers := make([]handler.AggregateReducer, 0, len(h.eventTypes)+1)
+	for aggregateType, eventTypes := range h.eventTypes {
+		eventReducers := make([]handler.EventReducer, len(eventTypes))
+		for i, eventType := range eventTypes {
+			eventReducers[i] = handler.EventReducer{
+				Event:  eventType,
+				Reduce: h.reduce,
+			}
+		}
+		reducers = append(reducers, handler.AggregateReducer{
+			Aggregate:     aggregateType,
+			EventReducers: eventReducers,
+		})
+	}
+	return append(reducers, handler.AggregateReducer{
+		Aggregate: target.AggregateType,
+		EventReducers: []handler.EventReducer{
+			{
+				Event:  target.DeliveryReplayedEventType,
+				Reduce: h.reduceDeliveryReplayed,
+			},
+		},
+	})
+}
+
+func (h *eventHandler) reduce(event eventstore.Event) (*handler.Statement, error) {
+	return handler.NewStatement(event, func(ex handler.Executer, projectionName string) error {
+		ctx := handlerContext(event.Aggregate())
+		targets, err := h.queries.TargetsByExecutionID(ctx, idsForEventType(string(event.Type())))
+		if err != nil {
+			return err
+		}
+		if len(targets) == 0 {
+			return nil
+		}
+		body, err := json.Marshal(ContextInfoFromEvent(event))
+		if err != nil {
+			return err
+		}
+		for _, target := range targets {
+			if err := h.deliver(ctx, target, event, body); err != nil {
+				return err
+			}
+		}
+		return nil
+	}), nil
+}
+
+// deliver sends the event to the target and dead-letters the delivery if all attempts failed.
+func (h *eventHandler) deliver(ctx context.Context, executionTarget *query.ExecutionTarget, event eventstore.Event, body []byte) error {
+	attempts, err := h.send(ctx, executionTarget.GetEndpoint(), executionTarget.GetTimeout(), executionTarget.GetSigningKey(), body)
+	if err == nil {
+		return nil
+	}
+	logging.WithFields("target", executionTarget.GetTargetID(), "event", event.Type(), "attempts", attempts).WithError(err).Info("delivery to target failed")
+	_, err = h.commands.TargetDeliveryFailed(ctx, executionTarget.GetTargetID(), "", event.Aggregate().InstanceID,
+		target.Delivery{
+			ExecutionID:   executionTarget.GetExecutionID(),
+			AggregateType: event.Aggregate().Type,
+			AggregateID:   event.Aggregate().ID,
+			EventType:     event.Type(),
+			EventSequence: event.Sequence(),
+			Body:          body,
+		},
+		attempts,
+		err.Error(),
+	)
+	return err
+}
+
+func (h *eventHandler) reduceDeliveryReplayed(event eventstore.Event) (*handler.Statement, error) {
+	e, ok := event.(*target.DeliveryReplayedEvent)
+	if !ok {
+		return nil, zerrors.ThrowInvalidArgumentf(nil, "EXEC-4m8xv0d2kq", "reduce.wrong.event.type %s", target.DeliveryReplayedEventType)
+	}
+	return handler.NewStatement(event, func(ex handler.Executer, projectionName string) error {
+		ctx := handlerContext(e.Aggregate())
+		replayedTarget, err := h.queries.GetTargetByID(ctx, e.Aggregate().ID)
+		if zerrors.IsNotFound(err) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		attempts, err := h.send(ctx, replayedTarget.Endpoint, replayedTarget.Timeout, replayedTarget.SigningKey, e.Body)
+		if err != nil {
+			_, err = h.commands.TargetDeliveryFailed(ctx, e.Aggregate().ID, e.DeliveryID, e.Aggregate().InstanceID, e.Delivery, attempts, err.Error())
+			return err
+		}
+		return h.commands.TargetDeliverySucceeded(ctx, e.Aggregate().ID, e.DeliveryID, e.Aggregate().InstanceID)
+	}), nil
+}
+
+func (h *eventHandler) send(ctx context.Context, endpoint string, timeout time.Duration, signingKey *crypto.CryptoValue, body []byte) (uint16, error) {
+	var key string
+	if signingKey != nil {
+		var err error
+		key, err = crypto.DecryptString(signingKey, h.signingKeyAlg)
+		if err != nil {
+			return 0, err
+		}
+	}
+	return h.retry.Retry(ctx, h.retry.MaxAttempts, func(ctx context.Context) error {
+		_, err := signedCall(ctx, endpoint, timeout, body, key)
+		return err
+	})
+}
+
+func handlerContext(aggregate *eventstore.Aggregate) context.Context {
+	ctx := authz.WithInstanceID(context.Background(), aggregate.InstanceID)
+	return authz.SetCtxData(ctx, authz.CtxData{UserID: HandlerUserID, OrgID: aggregate.ResourceOwner})
+}
+
+// idsForEventType returns the possible execution IDs for the event type ordered by precedence, for example:
+// [ "event/user.human.added",
+// "event/user.human.*",
+// "event/user.*",
+// "event" ]
+func idsForEventType(eventType string) []string {
+	ids := []string{exec_repo.ID(domain.ExecutionTypeEvent, eventType)}
+	parts := strings.Split(eventType, ".")
+	for i := len(parts) - 1; i > 0; i-- {
+		ids = append(ids, exec_repo.ID(domain.ExecutionTypeEvent, strings.Join(parts[:i], ".")+".*"))
+	}
+	return append(ids, exec_repo.IDAll(domain.ExecutionTypeEvent))
+}
+
+// ContextInfoEvent is the payload sent to the targets of event executions
+type ContextInfoEvent struct {
+	AggregateID   string          `json:"aggregateID,omitempty"`
+	AggregateType string          `json:"aggregateType,omitempty"`
+	ResourceOwner string          `json:"resourceOwner,omitempty"`
+	InstanceID    string          `json:"instanceID,omitempty"`
+	Version       string          `json:"version,omitempty"`
+	Sequence      uint64          `json:"sequence,omitempty"`
+	EventType     string          `json:"event_type,omitempty"`
+	CreatedAt     time.Time       `json:"created_at,omitempty"`
+	UserID        string          `json:"userID,omitempty"`
+	EventPayload  json.RawMessage `json:"event_payload,omitempty"`
+}
+
+func ContextInfoFromEvent(event eventstore.Event) *ContextInfoEvent {
+	info := &ContextInfoEvent{
+		AggregateID:   event.Aggregate().ID,
+		AggregateType: string(event.Aggregate().Type),
+		ResourceOwner: event.Aggregate().ResourceOwner,
+		InstanceID:    event.Aggregate().InstanceID,
+		Version:       string(event.Aggregate().Version),
+		Sequence:      event.Sequence(),
+		EventType:     string(event.Type()),
+		CreatedAt:     event.CreatedAt(),
+		UserID:        event.Creator(),
+	}
+	if payload := event.DataAsBytes(); len(payload) > 0 && json.Valid(payload) {
+		info.EventPayload = payload
+	}
+	return info
+}
+
+var projections []*handler.Handler
+
+// Register creates the handler for event executions, which is started with [Start].
+func Register(
+	ctx context.Context,
+	executionsCustomConfig projection.CustomConfig,
+	executionConfig *Config,
+	queries Queries,
+	commands Commands,
+	es *eventstore.Eventstore,
+	signingKeyAlg crypto.EncryptionAlgorithm,
+) {
+	projections = append(projections, NewEventHandler(
+		ctx,
+		projection.ApplyCustomConfig(executionsCustomConfig),
+		executionConfig,
+		es.EventTypes(),
+		eventstore.AggregateTypeFromEventType,
+		queries,
+		commands,
+		signingKeyAlg,
+	))
+}
+
+func Start(ctx context.Context) {
+	for _, projection := range projections {
+		projection.Start(ctx)
+	}
+}
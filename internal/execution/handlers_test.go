@@ -0,0 +1,31 @@
+package execution
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_idsForEventType(t *testing.T) {
+	tests := []struct {
+		name      string
+		eventType string
+		want      []string
+	}{
+		{
+			"single part",
+			"added",
+			[]string{"event/added", "event"},
+		},
+		{
+			"multiple parts",
+			"user.human.added",
+			[]string{"event/user.human.added", "event/user.human.*", "event/user.*", "event"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, idsForEventType(tt.eventType))
+		})
+	}
+}
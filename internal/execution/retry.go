@@ -0,0 +1,12 @@
+package execution
+
+import (
+	"github.com/zitadel/zitadel/internal/backoff"
+)
+
+// RetryConfig defines the exponential backoff used for the delivery of events to targets
+type RetryConfig struct {
+	// MaxAttempts is the maximum number of calls to the target, including the first one
+	MaxAttempts    uint16
+	backoff.Config `mapstructure:",squash"`
+}
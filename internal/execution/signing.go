@@ -0,0 +1,80 @@
+package execution
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+const (
+	// SigningHeader is the HTTP header containing the signature of the payload sent to a target
+	SigningHeader = "ZITADEL-Signature"
+
+	signingTimestamp = "t"
+	signingVersion   = "v1"
+)
+
+// ComputeSignatureHeader returns the value of the [SigningHeader] for the payload,
+// in the form of `t=<unix timestamp>,v1=<hex encoded HMAC-SHA256 of "<timestamp>.<payload>">`
+func ComputeSignatureHeader(t time.Time, payload []byte, signingKeys ...string) string {
+	parts := []string{signingTimestamp + "=" + strconv.FormatInt(t.Unix(), 10)}
+	for _, signingKey := range signingKeys {
+		parts = append(parts, signingVersion+"="+hex.EncodeToString(computeSignature(t, payload, signingKey)))
+	}
+	return strings.Join(parts, ",")
+}
+
+func computeSignature(t time.Time, payload []byte, signingKey string) []byte {
+	mac := hmac.New(sha256.New, []byte(signingKey))
+	mac.Write([]byte(fmt.Sprintf("%d.", t.Unix())))
+	mac.Write(payload)
+	return mac.Sum(nil)
+}
+
+// ValidatePayload checks the value of the [SigningHeader] against the payload with the signing key,
+// signatures older than the tolerance are rejected to prevent replay attacks.
+func ValidatePayload(payload []byte, header string, signingKey string, tolerance time.Duration) error {
+	var (
+		timestamp  time.Time
+		signatures [][]byte
+	)
+	for _, part := range strings.Split(header, ",") {
+		key, value, ok := strings.Cut(part, "=")
+		if !ok {
+			return zerrors.ThrowInvalidArgument(nil, "EXEC-2v7kwyb0ur", "Errors.Execution.Signature.Invalid")
+		}
+		switch key {
+		case signingTimestamp:
+			unix, err := strconv.ParseInt(value, 10, 64)
+			if err != nil {
+				return zerrors.ThrowInvalidArgument(err, "EXEC-6y2hzldk9m", "Errors.Execution.Signature.Invalid")
+			}
+			timestamp = time.Unix(unix, 0)
+		case signingVersion:
+			signature, err := hex.DecodeString(value)
+			if err != nil {
+				continue
+			}
+			signatures = append(signatures, signature)
+		}
+	}
+	if timestamp.IsZero() || len(signatures) == 0 {
+		return zerrors.ThrowInvalidArgument(nil, "EXEC-f0q8apmw1j", "Errors.Execution.Signature.Invalid")
+	}
+	if tolerance > 0 && time.Since(timestamp) > tolerance {
+		return zerrors.ThrowInvalidArgument(nil, "EXEC-rk5dx1bn3t", "Errors.Execution.Signature.Expired")
+	}
+	expected := computeSignature(timestamp, payload, signingKey)
+	for _, signature := range signatures {
+		if hmac.Equal(expected, signature) {
+			return nil
+		}
+	}
+	return zerrors.ThrowInvalidArgument(nil, "EXEC-tz3o9qg5wc", "Errors.Execution.Signature.Invalid")
+}
@@ -0,0 +1,97 @@
+package execution
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+func TestValidatePayload(t *testing.T) {
+	payload := []byte(`{"event_type":"user.human.added"}`)
+	now := time.Now()
+	type args struct {
+		payload    []byte
+		header     string
+		signingKey string
+		tolerance  time.Duration
+	}
+	tests := []struct {
+		name string
+		args args
+		err  func(error) bool
+	}{
+		{
+			"valid signature",
+			args{
+				payload:    payload,
+				header:     ComputeSignatureHeader(now, payload, "key"),
+				signingKey: "key",
+				tolerance:  time.Minute,
+			},
+			nil,
+		},
+		{
+			"valid signature of multiple keys",
+			args{
+				payload:    payload,
+				header:     ComputeSignatureHeader(now, payload, "old", "key"),
+				signingKey: "key",
+				tolerance:  time.Minute,
+			},
+			nil,
+		},
+		{
+			"wrong key",
+			args{
+				payload:    payload,
+				header:     ComputeSignatureHeader(now, payload, "other"),
+				signingKey: "key",
+				tolerance:  time.Minute,
+			},
+			zerrors.IsErrorInvalidArgument,
+		},
+		{
+			"changed payload",
+			args{
+				payload:    []byte(`{"event_type":"user.human.removed"}`),
+				header:     ComputeSignatureHeader(now, payload, "key"),
+				signingKey: "key",
+				tolerance:  time.Minute,
+			},
+			zerrors.IsErrorInvalidArgument,
+		},
+		{
+			"expired signature",
+			args{
+				payload:    payload,
+				header:     ComputeSignatureHeader(now.Add(-time.Hour), payload, "key"),
+				signingKey: "key",
+				tolerance:  time.Minute,
+			},
+			zerrors.IsErrorInvalidArgument,
+		},
+		{
+			"invalid header",
+			args{
+				payload:    payload,
+				header:     "signature",
+				signingKey: "key",
+				tolerance:  time.Minute,
+			},
+			zerrors.IsErrorInvalidArgument,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidatePayload(tt.args.payload, tt.args.header, tt.args.signingKey, tt.args.tolerance)
+			if tt.err == nil {
+				assert.NoError(t, err)
+				return
+			}
+			assert.True(t, tt.err(err))
+		})
+	}
+}
@@ -0,0 +1,113 @@
+package geoip
+
+import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"errors"
+	"io"
+	"net"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+// FileProvider resolves the locations from a CSV file loaded into memory.
+// Each row contains a network in CIDR notation, the country code and the ASN, e.g. 192.0.2.0/24,CH,13030.
+// Empty lines and lines starting with # are ignored, the networks must not overlap.
+type FileProvider struct {
+	networks []fileNetwork
+}
+
+type fileNetwork struct {
+	first, last net.IP
+	location    Location
+}
+
+func LoadFile(path string) (*FileProvider, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, zerrors.ThrowInternal(err, "GEOIP-Fi1op", "unable to open geoip file")
+	}
+	defer file.Close()
+	return ReadFile(file)
+}
+
+func ReadFile(r io.Reader) (*FileProvider, error) {
+	reader := csv.NewReader(r)
+	reader.Comment = '#'
+	reader.FieldsPerRecord = 3
+	reader.TrimLeadingSpace = true
+
+	provider := new(FileProvider)
+	for {
+		record, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, zerrors.ThrowInvalidArgument(err, "GEOIP-Fi2rd", "invalid geoip file")
+		}
+		_, network, err := net.ParseCIDR(record[0])
+		if err != nil {
+			return nil, zerrors.ThrowInvalidArgument(err, "GEOIP-Fi3nw", "invalid network in geoip file")
+		}
+		var asn uint64
+		if record[2] != "" {
+			asn, err = strconv.ParseUint(record[2], 10, 32)
+			if err != nil {
+				return nil, zerrors.ThrowInvalidArgument(err, "GEOIP-Fi4as", "invalid asn in geoip file")
+			}
+		}
+		first, last := networkRange(network)
+		provider.networks = append(provider.networks, fileNetwork{
+			first: first,
+			last:  last,
+			location: Location{
+				Country: strings.ToUpper(record[1]),
+				ASN:     uint32(asn),
+			},
+		})
+	}
+	sort.Slice(provider.networks, func(i, j int) bool {
+		return bytes.Compare(provider.networks[i].first, provider.networks[j].first) < 0
+	})
+	return provider, nil
+}
+
+// Lookup returns an empty location if the IP is not part of any network of the file.
+func (p *FileProvider) Lookup(_ context.Context, ip net.IP) (*Location, error) {
+	ip = ip.To16()
+	if ip == nil {
+		return &Location{}, nil
+	}
+	// index of the first network starting after the ip
+	i := sort.Search(len(p.networks), func(i int) bool {
+		return bytes.Compare(p.networks[i].first, ip) > 0
+	})
+	if i == 0 {
+		return &Location{}, nil
+	}
+	network := p.networks[i-1]
+	if bytes.Compare(ip, network.last) > 0 {
+		return &Location{}, nil
+	}
+	location := network.location
+	return &location, nil
+}
+
+// networkRange returns the first and the last address of the network in the 16-byte form
+func networkRange(network *net.IPNet) (first, last net.IP) {
+	first = network.IP.To16()
+	last = make(net.IP, net.IPv6len)
+	copy(last, first)
+	mask := network.Mask
+	offset := net.IPv6len - len(mask)
+	for i := range mask {
+		last[offset+i] |= ^mask[i]
+	}
+	return first, last
+}
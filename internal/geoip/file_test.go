@@ -0,0 +1,89 @@
+package geoip
+
+import (
+	"context"
+	"net"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+const testFile = `# network,country,asn
+192.0.2.0/24,ch,13030
+198.51.100.0/25,DE,3320
+2001:db8::/32,US,
+`
+
+func TestFileProvider_Lookup(t *testing.T) {
+	provider, err := ReadFile(strings.NewReader(testFile))
+	require.NoError(t, err)
+
+	tests := []struct {
+		name string
+		ip   string
+		want *Location
+	}{
+		{
+			name: "ipv4 network",
+			ip:   "192.0.2.42",
+			want: &Location{Country: "CH", ASN: 13030},
+		},
+		{
+			name: "last address of network",
+			ip:   "198.51.100.127",
+			want: &Location{Country: "DE", ASN: 3320},
+		},
+		{
+			name: "after network",
+			ip:   "198.51.100.128",
+			want: &Location{},
+		},
+		{
+			name: "before first network",
+			ip:   "10.0.0.1",
+			want: &Location{},
+		},
+		{
+			name: "ipv6 network without asn",
+			ip:   "2001:db8::1",
+			want: &Location{Country: "US"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := provider.Lookup(context.Background(), net.ParseIP(tt.ip))
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestReadFile_invalid(t *testing.T) {
+	tests := []struct {
+		name string
+		file string
+	}{
+		{
+			name: "missing column",
+			file: "192.0.2.0/24,CH\n",
+		},
+		{
+			name: "invalid network",
+			file: "192.0.2.0,CH,13030\n",
+		},
+		{
+			name: "invalid asn",
+			file: "192.0.2.0/24,CH,AS13030\n",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := ReadFile(strings.NewReader(tt.file))
+			assert.True(t, zerrors.IsErrorInvalidArgument(err))
+		})
+	}
+}
@@ -0,0 +1,20 @@
+package geoip
+
+import (
+	"context"
+	"net"
+)
+
+// Location is the country and the autonomous system an IP address belongs to.
+// Unknown values are empty.
+type Location struct {
+	// Country is the ISO 3166-1 alpha-2 code, e.g. CH
+	Country string `json:"country"`
+	// ASN is the number of the autonomous system, e.g. 13030
+	ASN uint32 `json:"asn"`
+}
+
+// Provider resolves the location of IP addresses.
+type Provider interface {
+	Lookup(ctx context.Context, ip net.IP) (*Location, error)
+}
@@ -0,0 +1,56 @@
+package geoip
+
+import (
+	"context"
+	"encoding/json"
+	"net"
+	"net/http"
+	"strings"
+
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+const ipPlaceholder = "{ip}"
+
+// HTTPProvider resolves the locations with a lookup service.
+// The {ip} placeholder of the URL is replaced by the address,
+// the service responds with the JSON representation of the Location, e.g. {"country":"CH","asn":13030}.
+type HTTPProvider struct {
+	lookupURL  string
+	httpClient *http.Client
+}
+
+func NewHTTPProvider(httpClient *http.Client, lookupURL string) *HTTPProvider {
+	if httpClient == nil {
+		httpClient = http.DefaultClient
+	}
+	return &HTTPProvider{
+		lookupURL:  lookupURL,
+		httpClient: httpClient,
+	}
+}
+
+func (p *HTTPProvider) Lookup(ctx context.Context, ip net.IP) (*Location, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, strings.ReplaceAll(p.lookupURL, ipPlaceholder, ip.String()), nil)
+	if err != nil {
+		return nil, zerrors.ThrowInternal(err, "GEOIP-Ht1rq", "unable to create geoip lookup")
+	}
+	req.Header.Set("Accept", "application/json")
+	resp, err := p.httpClient.Do(req)
+	if err != nil {
+		return nil, zerrors.ThrowUnavailable(err, "GEOIP-Ht2do", "geoip lookup failed")
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode == http.StatusNotFound {
+		return &Location{}, nil
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, zerrors.ThrowUnavailable(nil, "GEOIP-Ht3st", "geoip lookup failed")
+	}
+	location := new(Location)
+	if err = json.NewDecoder(resp.Body).Decode(location); err != nil {
+		return nil, zerrors.ThrowInternal(err, "GEOIP-Ht4js", "invalid geoip lookup response")
+	}
+	location.Country = strings.ToUpper(location.Country)
+	return location, nil
+}
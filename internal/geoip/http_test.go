@@ -0,0 +1,64 @@
+package geoip
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+func TestHTTPProvider_Lookup(t *testing.T) {
+	tests := []struct {
+		name    string
+		status  int
+		body    string
+		want    *Location
+		wantErr func(error) bool
+	}{
+		{
+			name:    "unavailable",
+			status:  http.StatusServiceUnavailable,
+			wantErr: zerrors.IsUnavailable,
+		},
+		{
+			name:   "not found",
+			status: http.StatusNotFound,
+			want:   &Location{},
+		},
+		{
+			name:   "found",
+			status: http.StatusOK,
+			body:   `{"country":"ch","asn":13030}`,
+			want:   &Location{Country: "CH", ASN: 13030},
+		},
+		{
+			name:    "invalid response",
+			status:  http.StatusOK,
+			body:    `country=CH`,
+			wantErr: zerrors.IsInternal,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, "/lookup/192.0.2.1", r.URL.Path)
+				w.WriteHeader(tt.status)
+				_, _ = w.Write([]byte(tt.body))
+			}))
+			defer server.Close()
+
+			got, err := NewHTTPProvider(server.Client(), server.URL+"/lookup/{ip}").Lookup(context.Background(), net.ParseIP("192.0.2.1"))
+			if tt.wantErr != nil {
+				assert.True(t, tt.wantErr(err))
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
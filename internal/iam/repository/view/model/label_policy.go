@@ -42,6 +42,7 @@ type LabelPolicyView struct {
 	HideLoginNameSuffix bool   `json:"hideLoginNameSuffix" gorm:"column:hide_login_name_suffix"`
 	ErrorMsgPopup       bool   `json:"errorMsgPopup" gorm:"column:err_msg_popup"`
 	DisableWatermark    bool   `json:"disableWatermark" gorm:"column:disable_watermark"`
+	CustomCSS           string `json:"customCss" gorm:"column:custom_css"`
 	Default             bool   `json:"-" gorm:"-"`
 
 	Sequence   uint64 `json:"-" gorm:"column:sequence"`
@@ -79,6 +80,7 @@ func (p *LabelPolicyView) ToDomain() *domain.LabelPolicy {
 		HideLoginNameSuffix: p.HideLoginNameSuffix,
 		ErrorMsgPopup:       p.ErrorMsgPopup,
 		DisableWatermark:    p.DisableWatermark,
+		CustomCSS:           p.CustomCSS,
 	}
 }
 
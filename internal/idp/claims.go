@@ -0,0 +1,48 @@
+package idp
+
+import (
+	"fmt"
+)
+
+// UserClaims is implemented by federated users which provide the raw claims or attributes of the identity provider,
+// e.g. the groups the user is member of.
+type UserClaims interface {
+	GetClaim(name string) []string
+}
+
+// Claims returns the values of the requested claims of the federated user.
+// Claims which are not provided by the identity provider are omitted.
+func Claims(user User, names []string) map[string][]string {
+	claimsUser, ok := user.(UserClaims)
+	if !ok || len(names) == 0 {
+		return nil
+	}
+	claims := make(map[string][]string, len(names))
+	for _, name := range names {
+		if values := claimsUser.GetClaim(name); len(values) > 0 {
+			claims[name] = values
+		}
+	}
+	return claims
+}
+
+// ClaimValues converts the value of a claim into a list of strings,
+// a single value results in a list with one entry.
+func ClaimValues(value interface{}) []string {
+	switch v := value.(type) {
+	case nil:
+		return nil
+	case string:
+		return []string{v}
+	case []string:
+		return v
+	case []interface{}:
+		values := make([]string, 0, len(v))
+		for _, entry := range v {
+			values = append(values, ClaimValues(entry)...)
+		}
+		return values
+	default:
+		return []string{fmt.Sprint(v)}
+	}
+}
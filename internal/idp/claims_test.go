@@ -0,0 +1,41 @@
+package idp
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestClaimValues(t *testing.T) {
+	tests := []struct {
+		name  string
+		value interface{}
+		want  []string
+	}{
+		{
+			name:  "nil",
+			value: nil,
+			want:  nil,
+		},
+		{
+			name:  "string",
+			value: "admins",
+			want:  []string{"admins"},
+		},
+		{
+			name:  "list",
+			value: []interface{}{"admins", "developers"},
+			want:  []string{"admins", "developers"},
+		},
+		{
+			name:  "number",
+			value: float64(42),
+			want:  []string{"42"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, ClaimValues(tt.value))
+		})
+	}
+}
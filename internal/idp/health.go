@@ -0,0 +1,78 @@
+package idp
+
+import (
+	"context"
+	"crypto/x509"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/zitadel/zitadel/internal/domain"
+)
+
+// HealthChecker is implemented by providers, which are able to check the reachability of the identity provider
+// and the expiration of its certificates without the interaction of a user.
+type HealthChecker interface {
+	CheckHealth(ctx context.Context) []*domain.IDPHealthCheck
+}
+
+// CheckMetadata fetches the metadata (e.g. discovery document or keys) from the endpoint,
+// which is expected to respond successfully.
+// The expiration of the server certificate is reported for TLS connections.
+func CheckMetadata(ctx context.Context, client *http.Client, endpoint string) []*domain.IDPHealthCheck {
+	return checkEndpoint(ctx, client, http.MethodGet, endpoint, domain.IDPHealthCheckTypeMetadata, func(status int) bool {
+		return status >= 200 && status < 300
+	})
+}
+
+// CheckTokenEndpoint sends a request without any credentials to the token endpoint.
+// It is reachable, if it responds without a server error, since client errors are expected for such a request.
+// The expiration of the server certificate is reported for TLS connections.
+func CheckTokenEndpoint(ctx context.Context, client *http.Client, endpoint string) []*domain.IDPHealthCheck {
+	return checkEndpoint(ctx, client, http.MethodPost, endpoint, domain.IDPHealthCheckTypeTokenEndpoint, func(status int) bool {
+		return status < 500
+	})
+}
+
+// CheckCertificate reports the expiration of the certificate
+func CheckCertificate(certificate *x509.Certificate) *domain.IDPHealthCheck {
+	return &domain.IDPHealthCheck{
+		Type:           domain.IDPHealthCheckTypeCertificate,
+		Target:         certificate.Subject.String(),
+		ExpirationDate: certificate.NotAfter,
+	}
+}
+
+func checkEndpoint(ctx context.Context, client *http.Client, method, endpoint string, checkType domain.IDPHealthCheckType, isHealthy func(status int) bool) []*domain.IDPHealthCheck {
+	check := &domain.IDPHealthCheck{
+		Type:   checkType,
+		Target: endpoint,
+	}
+	var body io.Reader
+	if method == http.MethodPost {
+		body = strings.NewReader(url.Values{}.Encode())
+	}
+	req, err := http.NewRequestWithContext(ctx, method, endpoint, body)
+	if err != nil {
+		check.Error = err.Error()
+		return []*domain.IDPHealthCheck{check}
+	}
+	if body != nil {
+		req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		check.Error = err.Error()
+		return []*domain.IDPHealthCheck{check}
+	}
+	defer resp.Body.Close()
+	if !isHealthy(resp.StatusCode) {
+		check.Error = fmt.Sprintf("unexpected status %s", resp.Status)
+	}
+	if resp.TLS == nil || len(resp.TLS.PeerCertificates) == 0 {
+		return []*domain.IDPHealthCheck{check}
+	}
+	return []*domain.IDPHealthCheck{check, CheckCertificate(resp.TLS.PeerCertificates[0])}
+}
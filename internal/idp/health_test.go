@@ -0,0 +1,106 @@
+package idp
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/zitadel/zitadel/internal/domain"
+)
+
+func TestCheckMetadata(t *testing.T) {
+	tests := []struct {
+		name       string
+		status     int
+		tls        bool
+		wantError  string
+		wantChecks int
+	}{
+		{
+			name:       "ok",
+			status:     http.StatusOK,
+			wantChecks: 1,
+		},
+		{
+			name:       "ok with certificate",
+			status:     http.StatusOK,
+			tls:        true,
+			wantChecks: 2,
+		},
+		{
+			name:       "not found",
+			status:     http.StatusNotFound,
+			wantError:  "unexpected status 404 Not Found",
+			wantChecks: 1,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, http.MethodGet, r.Method)
+				w.WriteHeader(tt.status)
+			})
+			server := httptest.NewServer(handler)
+			if tt.tls {
+				server.Close()
+				server = httptest.NewTLSServer(handler)
+			}
+			defer server.Close()
+
+			checks := CheckMetadata(context.Background(), server.Client(), server.URL)
+			require.Len(t, checks, tt.wantChecks)
+			assert.Equal(t, domain.IDPHealthCheckTypeMetadata, checks[0].Type)
+			assert.Equal(t, server.URL, checks[0].Target)
+			assert.Equal(t, tt.wantError, checks[0].Error)
+			if tt.tls {
+				assert.Equal(t, domain.IDPHealthCheckTypeCertificate, checks[1].Type)
+				assert.Equal(t, server.Certificate().NotAfter, checks[1].ExpirationDate)
+			}
+		})
+	}
+}
+
+func TestCheckTokenEndpoint(t *testing.T) {
+	tests := []struct {
+		name      string
+		status    int
+		wantError string
+	}{
+		{
+			name:   "client error, reachable",
+			status: http.StatusBadRequest,
+		},
+		{
+			name:      "server error",
+			status:    http.StatusBadGateway,
+			wantError: "unexpected status 502 Bad Gateway",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, http.MethodPost, r.Method)
+				w.WriteHeader(tt.status)
+			}))
+			defer server.Close()
+
+			checks := CheckTokenEndpoint(context.Background(), server.Client(), server.URL)
+			require.Len(t, checks, 1)
+			assert.Equal(t, domain.IDPHealthCheckTypeTokenEndpoint, checks[0].Type)
+			assert.Equal(t, tt.wantError, checks[0].Error)
+		})
+	}
+}
+
+func TestCheckTokenEndpoint_unreachable(t *testing.T) {
+	server := httptest.NewServer(http.NotFoundHandler())
+	server.Close()
+
+	checks := CheckTokenEndpoint(context.Background(), http.DefaultClient, server.URL)
+	require.Len(t, checks, 1)
+	assert.NotEmpty(t, checks[0].Error)
+}
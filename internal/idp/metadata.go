@@ -0,0 +1,33 @@
+package idp
+
+import (
+	"sort"
+
+	"github.com/zitadel/zitadel/internal/domain"
+)
+
+// UserMetadata is implemented by federated users which provide values for the metadata of the user,
+// e.g. mapped from the attributes of the identity provider.
+type UserMetadata interface {
+	GetMetadata() map[string]string
+}
+
+// Metadata returns the metadata provided by the federated user sorted by key.
+func Metadata(user User) []*domain.Metadata {
+	metadataUser, ok := user.(UserMetadata)
+	if !ok {
+		return nil
+	}
+	values := metadataUser.GetMetadata()
+	if len(values) == 0 {
+		return nil
+	}
+	metadata := make([]*domain.Metadata, 0, len(values))
+	for key, value := range values {
+		metadata = append(metadata, &domain.Metadata{Key: key, Value: []byte(value)})
+	}
+	sort.Slice(metadata, func(i, j int) bool {
+		return metadata[i].Key < metadata[j].Key
+	})
+	return metadata
+}
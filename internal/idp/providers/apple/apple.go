@@ -1,8 +1,11 @@
 package apple
 
 import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
 	"crypto/x509"
 	"encoding/pem"
+	"errors"
 	"time"
 
 	"github.com/go-jose/go-jose/v4"
@@ -18,7 +21,11 @@ const (
 	issuer = "https://appleid.apple.com"
 )
 
-var _ idp.Provider = (*Provider)(nil)
+var (
+	_ idp.Provider = (*Provider)(nil)
+
+	ErrInvalidPrivateKey = errors.New("invalid private key: expected a PEM encoded PKCS #8 ECDSA P-256 key (.p8 file)")
+)
 
 // Provider is the [idp.Provider] implementation for Apple
 type Provider struct {
@@ -40,11 +47,32 @@ func New(clientID, teamID, keyID, callbackURL string, key []byte, scopes []strin
 	}, nil
 }
 
+// ValidatePrivateKey checks if the key is a private key as downloaded from Apple (.p8 file),
+// which can be used to sign the client_secret with ES256.
+func ValidatePrivateKey(key []byte) error {
+	_, err := parsePrivateKey(key)
+	return err
+}
+
+func parsePrivateKey(key []byte) (*ecdsa.PrivateKey, error) {
+	block, _ := pem.Decode(key)
+	if block == nil {
+		return nil, ErrInvalidPrivateKey
+	}
+	pk, err := x509.ParsePKCS8PrivateKey(block.Bytes)
+	if err != nil {
+		return nil, errors.Join(ErrInvalidPrivateKey, err)
+	}
+	ecKey, ok := pk.(*ecdsa.PrivateKey)
+	if !ok || ecKey.Curve != elliptic.P256() {
+		return nil, ErrInvalidPrivateKey
+	}
+	return ecKey, nil
+}
+
 // clientSecretFromPrivateKey uses the private key to create and sign a JWT, which has to be used as client_secret at Apple.
 func clientSecretFromPrivateKey(key []byte, teamID, clientID, keyID string) (string, error) {
-	block, _ := pem.Decode(key)
-	b := block.Bytes
-	pk, err := x509.ParsePKCS8PrivateKey(b)
+	pk, err := parsePrivateKey(key)
 	if err != nil {
 		return "", err
 	}
@@ -2,6 +2,12 @@ package apple
 
 import (
 	"context"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"encoding/pem"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -69,3 +75,56 @@ func TestProvider_BeginAuth(t *testing.T) {
 		})
 	}
 }
+
+func TestValidatePrivateKey(t *testing.T) {
+	pemEncode := func(key interface{}) []byte {
+		der, err := x509.MarshalPKCS8PrivateKey(key)
+		require.NoError(t, err)
+		return pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: der})
+	}
+	p384Key, err := ecdsa.GenerateKey(elliptic.P384(), rand.Reader)
+	require.NoError(t, err)
+	_, ed25519Key, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+
+	tests := []struct {
+		name    string
+		key     []byte
+		wantErr bool
+	}{
+		{
+			name:    "no pem",
+			key:     []byte("privateKey"),
+			wantErr: true,
+		},
+		{
+			name:    "no pkcs8",
+			key:     pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: []byte("key")}),
+			wantErr: true,
+		},
+		{
+			name:    "wrong curve",
+			key:     pemEncode(p384Key),
+			wantErr: true,
+		},
+		{
+			name:    "no ecdsa key",
+			key:     pemEncode(ed25519Key),
+			wantErr: true,
+		},
+		{
+			name: "valid",
+			key:  []byte(privateKey),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidatePrivateKey(tt.key)
+			if tt.wantErr {
+				assert.ErrorIs(t, err, ErrInvalidPrivateKey)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
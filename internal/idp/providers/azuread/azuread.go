@@ -2,6 +2,7 @@ package azuread
 
 import (
 	"fmt"
+	"slices"
 
 	"github.com/zitadel/oidc/v3/pkg/oidc"
 	"golang.org/x/oauth2"
@@ -19,8 +20,15 @@ const (
 	keysURLTemplate  string = "https://login.microsoftonline.com/%s/discovery/v2.0/keys"
 	userURL          string = "https://graph.microsoft.com/v1.0/me"
 	userinfoEndpoint string = "https://graph.microsoft.com/oidc/userinfo"
+	groupsURL        string = "https://graph.microsoft.com/v1.0/me/transitiveMemberOf/microsoft.graph.group?$select=id&$top=999"
 
-	ScopeUserRead string = "User.Read"
+	ScopeUserRead        string = "User.Read"
+	ScopeGroupMemberRead string = "GroupMember.Read.All"
+
+	// ClaimTenantID is the claim of the id_token containing the ID of the tenant the user belongs to.
+	ClaimTenantID string = "tid"
+	// ClaimGroups contains the IDs of the groups the user is a (transitive) member of, see [WithGroups].
+	ClaimGroups string = "groups"
 )
 
 // TenantType are the well known tenant types to scope the users that can authenticate. TenantType is not an
@@ -42,14 +50,19 @@ const (
 	ConsumersTenant TenantType = "consumers"
 )
 
-var _ idp.Provider = (*Provider)(nil)
+var (
+	_ idp.Provider   = (*Provider)(nil)
+	_ idp.UserClaims = (*User)(nil)
+)
 
 // Provider is the [idp.Provider] implementation for AzureAD (V2 Endpoints)
 type Provider struct {
 	*oauth.Provider
-	tenant        TenantType
-	emailVerified bool
-	options       []oauth.ProviderOpts
+	tenant         TenantType
+	emailVerified  bool
+	allowedTenants []string
+	fetchGroups    bool
+	options        []oauth.ProviderOpts
 }
 
 // issuer returns the OIDC issuer based on the [TenantType]
@@ -62,6 +75,19 @@ func (p *Provider) keysEndpoint() string {
 	return fmt.Sprintf(keysURLTemplate, p.tenant)
 }
 
+// isMultiTenant returns true if the [TenantType] allows users of multiple tenants to sign in
+func (p *Provider) isMultiTenant() bool {
+	return p.tenant == CommonTenant ||
+		p.tenant == OrganizationsTenant ||
+		p.tenant == ConsumersTenant
+}
+
+// isTenantAllowed checks the tenant ID (`tid` claim) against the list set by [WithAllowedTenants].
+// Every tenant is allowed if the list is empty.
+func (p *Provider) isTenantAllowed(tenantID string) bool {
+	return len(p.allowedTenants) == 0 || slices.Contains(p.allowedTenants, tenantID)
+}
+
 type ProviderOptions func(*Provider)
 
 // WithTenant allows to set a [TenantType] (can also be a Tenant ID)
@@ -79,6 +105,24 @@ func WithEmailVerified() ProviderOptions {
 	}
 }
 
+// WithAllowedTenants restricts the sign-in to users of the provided tenant IDs (`tid` claim),
+// which is mainly useful in combination with the [CommonTenant] or [OrganizationsTenant].
+// The id_token is always verified if the list is not empty.
+func WithAllowedTenants(tenantIDs ...string) ProviderOptions {
+	return func(p *Provider) {
+		p.allowedTenants = tenantIDs
+	}
+}
+
+// WithGroups allows to retrieve the IDs of the groups the user is a (transitive) member of from Microsoft Graph.
+// They are provided as [ClaimGroups] claim (e.g. for the role mapping) and require the [ScopeGroupMemberRead] scope,
+// which is requested automatically.
+func WithGroups() ProviderOptions {
+	return func(p *Provider) {
+		p.fetchGroups = true
+	}
+}
+
 // WithOAuthOptions allows to specify [oauth.ProviderOpts] like [oauth.WithLinkingAllowed]
 func WithOAuthOptions(opts ...oauth.ProviderOpts) ProviderOptions {
 	return func(p *Provider) {
@@ -96,6 +140,12 @@ func New(name, clientID, clientSecret, redirectURI string, scopes []string, opts
 	for _, opt := range opts {
 		opt(provider)
 	}
+	if provider.fetchGroups {
+		scopes = ensureMinimalScope(scopes)
+		if !slices.Contains(scopes, ScopeGroupMemberRead) {
+			scopes = append(scopes, ScopeGroupMemberRead)
+		}
+	}
 	config := newConfig(provider.tenant, clientID, clientSecret, redirectURI, scopes)
 	rp, err := oauth.New(
 		config,
@@ -168,7 +218,11 @@ type User struct {
 	PreferredLanguage string               `json:"preferredLanguage"`
 	LastName          string               `json:"surname"`
 	UserPrincipalName string               `json:"userPrincipalName"`
-	isEmailVerified   bool
+	// TenantID is only set if the id_token was verified, see [WithAllowedTenants]
+	TenantID string `json:"tid,omitempty"`
+	// Groups are only set if they're retrieved from Microsoft Graph, see [WithGroups]
+	Groups          []string `json:"groups,omitempty"`
+	isEmailVerified bool
 }
 
 // GetID is an implementation of the [idp.User] interface.
@@ -247,3 +301,27 @@ func (u *User) GetProfile() string {
 func (u *User) GetAvatarURL() string {
 	return ""
 }
+
+// GetClaim is an implementation of the [idp.UserClaims] interface.
+// It provides the [ClaimTenantID] and [ClaimGroups] as well as the `jobTitle` and `officeLocation` of the user.
+func (u *User) GetClaim(name string) []string {
+	switch name {
+	case ClaimTenantID:
+		return claimValue(u.TenantID)
+	case ClaimGroups:
+		return u.Groups
+	case "jobTitle":
+		return claimValue(u.JobTitle)
+	case "officeLocation":
+		return claimValue(u.OfficeLocation)
+	default:
+		return nil
+	}
+}
+
+func claimValue(value string) []string {
+	if value == "" {
+		return nil
+	}
+	return []string{value}
+}
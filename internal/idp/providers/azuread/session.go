@@ -2,6 +2,8 @@ package azuread
 
 import (
 	"context"
+	"errors"
+	"fmt"
 	"net/http"
 
 	"github.com/zitadel/oidc/v3/pkg/client/rp"
@@ -12,6 +14,11 @@ import (
 	"github.com/zitadel/zitadel/internal/idp/providers/oauth"
 )
 
+var (
+	ErrIDTokenMissing   = errors.New("id_token missing, but required to check the tenant")
+	ErrTenantNotAllowed = errors.New("tenant of the user is not allowed")
+)
+
 // Session extends the [oauth.Session] to be able to handle the id_token and to implement the [idp.SessionSupportsMigration] functionality
 type Session struct {
 	*Provider
@@ -42,32 +49,99 @@ func (s *Session) RetrievePreviousID() (string, error) {
 // FetchUser implements the [idp.Session] interface.
 // It will execute an OAuth 2.0 code exchange if needed to retrieve the access token,
 // call the specified userEndpoint and map the received information into an [idp.User].
-// In case of a specific TenantID as [TenantType] it will additionally extract the id_token and validate it.
-func (s *Session) FetchUser(ctx context.Context) (user idp.User, err error) {
-	user, err = s.oauth().FetchUser(ctx)
+// In case of a specific TenantID as [TenantType] or a list of allowed tenants ([WithAllowedTenants])
+// it will additionally extract the id_token and validate it.
+// If enabled ([WithGroups]), the groups of the user are retrieved from Microsoft Graph.
+func (s *Session) FetchUser(ctx context.Context) (_ idp.User, err error) {
+	user, err := s.oauth().FetchUser(ctx)
 	if err != nil {
 		return nil, err
 	}
-	// since azure will sign the id_token always with the issuer of the application it might differ from
-	// the issuer the auth and token were based on, e.g. when allowing all account types to login,
-	// then the auth endpoint must be `https://login.microsoftonline.com/common/oauth2/v2.0/authorize`
-	// even though the issuer would be like `https://login.microsoftonline.com/d8cdd43f-fd94-4576-8deb-f3bfea72dc2e/v2.0`
-	if s.Provider.tenant == CommonTenant ||
-		s.Provider.tenant == OrganizationsTenant ||
-		s.Provider.tenant == ConsumersTenant {
+	azureUser, ok := user.(*User)
+	if !ok {
 		return user, nil
 	}
+	if err = s.verifyIDToken(ctx); err != nil {
+		return nil, err
+	}
+	if claims := s.oauth().Tokens.IDTokenClaims; s.oauth().Tokens.IDToken != "" && claims != nil {
+		azureUser.TenantID, _ = claims.Claims[ClaimTenantID].(string)
+	}
+	if !s.Provider.isTenantAllowed(azureUser.TenantID) {
+		return nil, ErrTenantNotAllowed
+	}
+	if s.Provider.fetchGroups {
+		if azureUser.Groups, err = s.groups(ctx); err != nil {
+			return nil, err
+		}
+	}
+	return azureUser, nil
+}
+
+// verifyIDToken verifies the id_token and sets it on the [oidc.Tokens]
+func (s *Session) verifyIDToken(ctx context.Context) (err error) {
 	idToken, ok := s.oauth().Tokens.Extra("id_token").(string)
 	if !ok {
-		return user, nil
+		if len(s.Provider.allowedTenants) > 0 {
+			return ErrIDTokenMissing
+		}
+		return nil
+	}
+	issuer := s.Provider.issuer()
+	// since azure will sign the id_token always with the issuer of the application it might differ from
+	// the issuer the auth and token were based on, e.g. when allowing all account types to login,
+	// then the auth endpoint must be `https://login.microsoftonline.com/common/oauth2/v2.0/authorize`
+	// even though the issuer would be like `https://login.microsoftonline.com/d8cdd43f-fd94-4576-8deb-f3bfea72dc2e/v2.0`
+	// therefore the token is only verified if the tenants need to be checked, using the issuer of the tenant of the user
+	if s.Provider.isMultiTenant() {
+		if len(s.Provider.allowedTenants) == 0 {
+			return nil
+		}
+		claims := new(oidc.IDTokenClaims)
+		if _, err = oidc.ParseToken(idToken, claims); err != nil {
+			return err
+		}
+		tenantID, _ := claims.Claims[ClaimTenantID].(string)
+		if !s.Provider.isTenantAllowed(tenantID) {
+			return ErrTenantNotAllowed
+		}
+		issuer = fmt.Sprintf(issuerTemplate, tenantID)
 	}
-	idTokenVerifier := rp.NewIDTokenVerifier(s.Provider.issuer(), s.Provider.OAuthConfig().ClientID, rp.NewRemoteKeySet(s.Provider.HttpClient(), s.Provider.keysEndpoint()))
+	idTokenVerifier := rp.NewIDTokenVerifier(issuer, s.Provider.OAuthConfig().ClientID, rp.NewRemoteKeySet(s.Provider.HttpClient(), s.Provider.keysEndpoint()))
 	s.oauth().Tokens.IDTokenClaims, err = rp.VerifyTokens[*oidc.IDTokenClaims](ctx, s.oauth().Tokens.AccessToken, idToken, idTokenVerifier)
 	if err != nil {
-		return nil, err
+		return err
 	}
 	s.oauth().Tokens.IDToken = idToken
-	return user, nil
+	return nil
+}
+
+type groupsResponse struct {
+	Value []struct {
+		ID string `json:"id"`
+	} `json:"value"`
+	NextLink string `json:"@odata.nextLink"`
+}
+
+// groups retrieves the IDs of all groups the user is a (transitive) member of, following the pagination of Microsoft Graph
+func (s *Session) groups(ctx context.Context) ([]string, error) {
+	groups := make([]string, 0)
+	for url := groupsURL; url != ""; {
+		req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+		if err != nil {
+			return nil, err
+		}
+		req.Header.Set("authorization", s.oauth().Tokens.TokenType+" "+s.oauth().Tokens.AccessToken)
+		resp := new(groupsResponse)
+		if err = httphelper.HttpRequest(s.Provider.HttpClient(), req, resp); err != nil {
+			return nil, err
+		}
+		for _, group := range resp.Value {
+			groups = append(groups, group.ID)
+		}
+		url = resp.NextLink
+	}
+	return groups, nil
 }
 
 // Tokens returns the [oidc.Tokens] of the underlying [oauth.Session].
@@ -2,14 +2,19 @@ package azuread
 
 import (
 	"context"
+	"crypto/rand"
+	"crypto/rsa"
 	"errors"
+	"fmt"
 	"net/http"
 	"testing"
 	"time"
 
+	"github.com/go-jose/go-jose/v4"
 	"github.com/h2non/gock"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"github.com/zitadel/oidc/v3/pkg/crypto"
 	"github.com/zitadel/oidc/v3/pkg/oidc"
 	"golang.org/x/oauth2"
 	"golang.org/x/text/language"
@@ -20,6 +25,9 @@ import (
 )
 
 func TestSession_FetchUser(t *testing.T) {
+	signingKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+
 	type fields struct {
 		name         string
 		clientID     string
@@ -237,6 +245,180 @@ func TestSession_FetchUser(t *testing.T) {
 				profile:           "",
 			},
 		},
+		{
+			name: "allowed tenant",
+			fields: fields{
+				clientID:     "clientID",
+				clientSecret: "clientSecret",
+				redirectURI:  "redirectURI",
+				options: []ProviderOptions{
+					WithAllowedTenants("tenant1", "tenant2"),
+				},
+				httpMock: func() {
+					gock.New("https://graph.microsoft.com").
+						Get("/v1.0/me").
+						Reply(200).
+						JSON(userinfo())
+					gock.New("https://login.microsoftonline.com").
+						Get("/common/discovery/v2.0/keys").
+						Reply(200).
+						JSON(publicKeySet(signingKey))
+				},
+				authURL: "https://login.microsoftonline.com/common/oauth2/v2.0/authorize?client_id=clientID&redirect_uri=redirectURI&response_type=code&scope=openid+profile+email&state=testState",
+				tokens:  tokensWithIDToken(t, signingKey, "tenant2"),
+			},
+			want: want{
+				user: &User{
+					ID:                "id",
+					BusinessPhones:    []domain.PhoneNumber{"phone1", "phone2"},
+					DisplayName:       "firstname lastname",
+					FirstName:         "firstname",
+					JobTitle:          "title",
+					Email:             "email",
+					MobilePhone:       "mobile",
+					OfficeLocation:    "office",
+					PreferredLanguage: "en",
+					LastName:          "lastname",
+					UserPrincipalName: "username",
+					TenantID:          "tenant2",
+				},
+				id:                "id",
+				firstName:         "firstname",
+				lastName:          "lastname",
+				displayName:       "firstname lastname",
+				nickName:          "",
+				preferredUsername: "username",
+				email:             "email",
+				isEmailVerified:   false,
+				phone:             "",
+				isPhoneVerified:   false,
+				preferredLanguage: language.English,
+				profile:           "",
+			},
+		},
+		{
+			name: "tenant not allowed, error",
+			fields: fields{
+				clientID:     "clientID",
+				clientSecret: "clientSecret",
+				redirectURI:  "redirectURI",
+				options: []ProviderOptions{
+					WithAllowedTenants("tenant1"),
+				},
+				httpMock: func() {
+					gock.New("https://graph.microsoft.com").
+						Get("/v1.0/me").
+						Reply(200).
+						JSON(userinfo())
+				},
+				authURL: "https://login.microsoftonline.com/common/oauth2/v2.0/authorize?client_id=clientID&redirect_uri=redirectURI&response_type=code&scope=openid+profile+email&state=testState",
+				tokens:  tokensWithIDToken(t, signingKey, "tenant2"),
+			},
+			want: want{
+				err: func(err error) bool {
+					return errors.Is(err, ErrTenantNotAllowed)
+				},
+			},
+		},
+		{
+			name: "allowed tenants without id_token, error",
+			fields: fields{
+				clientID:     "clientID",
+				clientSecret: "clientSecret",
+				redirectURI:  "redirectURI",
+				options: []ProviderOptions{
+					WithAllowedTenants("tenant1"),
+				},
+				httpMock: func() {
+					gock.New("https://graph.microsoft.com").
+						Get("/v1.0/me").
+						Reply(200).
+						JSON(userinfo())
+				},
+				authURL: "https://login.microsoftonline.com/common/oauth2/v2.0/authorize?client_id=clientID&redirect_uri=redirectURI&response_type=code&scope=openid+profile+email&state=testState",
+				tokens: &oidc.Tokens[*oidc.IDTokenClaims]{
+					Token: &oauth2.Token{
+						AccessToken: "accessToken",
+						TokenType:   oidc.BearerToken,
+					},
+				},
+			},
+			want: want{
+				err: func(err error) bool {
+					return errors.Is(err, ErrIDTokenMissing)
+				},
+			},
+		},
+		{
+			name: "successful fetch with groups",
+			fields: fields{
+				clientID:     "clientID",
+				clientSecret: "clientSecret",
+				redirectURI:  "redirectURI",
+				options: []ProviderOptions{
+					WithGroups(),
+				},
+				httpMock: func() {
+					gock.New("https://graph.microsoft.com").
+						Get("/v1.0/me/transitiveMemberOf/microsoft.graph.group").
+						Reply(200).
+						JSON(map[string]interface{}{
+							"value": []map[string]string{
+								{"id": "group1"},
+								{"id": "group2"},
+							},
+							"@odata.nextLink": "https://graph.microsoft.com/v1.0/me/transitiveMemberOf/microsoft.graph.group?$skiptoken=next",
+						})
+					gock.New("https://graph.microsoft.com").
+						Get("/v1.0/me/transitiveMemberOf/microsoft.graph.group").
+						Reply(200).
+						JSON(map[string]interface{}{
+							"value": []map[string]string{
+								{"id": "group3"},
+							},
+						})
+					gock.New("https://graph.microsoft.com").
+						Get("/v1.0/me").
+						Reply(200).
+						JSON(userinfo())
+				},
+				authURL: "https://login.microsoftonline.com/common/oauth2/v2.0/authorize?client_id=clientID&redirect_uri=redirectURI&response_type=code&scope=openid+profile+email&state=testState",
+				tokens: &oidc.Tokens[*oidc.IDTokenClaims]{
+					Token: &oauth2.Token{
+						AccessToken: "accessToken",
+						TokenType:   oidc.BearerToken,
+					},
+				},
+			},
+			want: want{
+				user: &User{
+					ID:                "id",
+					BusinessPhones:    []domain.PhoneNumber{"phone1", "phone2"},
+					DisplayName:       "firstname lastname",
+					FirstName:         "firstname",
+					JobTitle:          "title",
+					Email:             "email",
+					MobilePhone:       "mobile",
+					OfficeLocation:    "office",
+					PreferredLanguage: "en",
+					LastName:          "lastname",
+					UserPrincipalName: "username",
+					Groups:            []string{"group1", "group2", "group3"},
+				},
+				id:                "id",
+				firstName:         "firstname",
+				lastName:          "lastname",
+				displayName:       "firstname lastname",
+				nickName:          "",
+				preferredUsername: "username",
+				email:             "email",
+				isEmailVerified:   false,
+				phone:             "",
+				isPhoneVerified:   false,
+				preferredLanguage: language.English,
+				profile:           "",
+			},
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -284,6 +466,43 @@ func TestSession_FetchUser(t *testing.T) {
 	}
 }
 
+func tokensWithIDToken(t *testing.T, key *rsa.PrivateKey, tenantID string) *oidc.Tokens[*oidc.IDTokenClaims] {
+	signer, err := jose.NewSigner(jose.SigningKey{
+		Algorithm: jose.RS256,
+		Key:       &jose.JSONWebKey{Key: key, KeyID: "keyID"},
+	}, &jose.SignerOptions{})
+	require.NoError(t, err)
+	claims := oidc.NewIDTokenClaims(
+		fmt.Sprintf(issuerTemplate, tenantID),
+		"sub",
+		[]string{"clientID"},
+		time.Now().Add(1*time.Hour),
+		time.Now().Add(-1*time.Second),
+		"",
+		"",
+		nil,
+		"clientID",
+		0,
+	)
+	claims.Claims = map[string]any{ClaimTenantID: tenantID}
+	idToken, err := crypto.Sign(claims, signer)
+	require.NoError(t, err)
+	return &oidc.Tokens[*oidc.IDTokenClaims]{
+		Token: (&oauth2.Token{
+			AccessToken: "accessToken",
+			TokenType:   oidc.BearerToken,
+		}).WithExtra(map[string]interface{}{"id_token": idToken}),
+	}
+}
+
+func publicKeySet(key *rsa.PrivateKey) *jose.JSONWebKeySet {
+	return &jose.JSONWebKeySet{
+		Keys: []jose.JSONWebKey{
+			{Key: &key.PublicKey, KeyID: "keyID", Algorithm: string(jose.RS256), Use: "sig"},
+		},
+	}
+}
+
 func userinfo() *User {
 	return &User{
 		ID:                "id",
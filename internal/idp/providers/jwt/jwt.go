@@ -4,9 +4,11 @@ import (
 	"context"
 	"encoding/base64"
 	"errors"
+	"net/http"
 	"net/url"
 
 	"github.com/zitadel/zitadel/internal/crypto"
+	"github.com/zitadel/zitadel/internal/domain"
 	"github.com/zitadel/zitadel/internal/idp"
 )
 
@@ -15,7 +17,10 @@ const (
 	queryUserAgentID   = "userAgentID"
 )
 
-var _ idp.Provider = (*Provider)(nil)
+var (
+	_ idp.Provider      = (*Provider)(nil)
+	_ idp.HealthChecker = (*Provider)(nil)
+)
 
 var (
 	ErrMissingUserAgentID = errors.New("userAgentID missing")
@@ -139,3 +144,9 @@ func (p *Provider) IsAutoCreation() bool {
 func (p *Provider) IsAutoUpdate() bool {
 	return p.isAutoUpdate
 }
+
+// CheckHealth implements the [idp.HealthChecker] interface.
+// It checks that the keys to verify the JWT can be fetched.
+func (p *Provider) CheckHealth(ctx context.Context) []*domain.IDPHealthCheck {
+	return idp.CheckMetadata(ctx, http.DefaultClient, p.keysEndpoint)
+}
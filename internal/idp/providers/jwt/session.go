@@ -138,3 +138,8 @@ func (u *User) GetAvatarURL() string {
 func (u *User) GetProfile() string {
 	return u.Profile
 }
+
+// GetClaim is an implementation of the [idp.UserClaims] interface.
+func (u *User) GetClaim(name string) []string {
+	return idp.ClaimValues(u.Claims[name])
+}
@@ -0,0 +1,138 @@
+package ldap
+
+import (
+	"strings"
+	"time"
+
+	"github.com/go-ldap/ldap/v3"
+
+	"github.com/zitadel/zitadel/internal/domain"
+)
+
+const (
+	memberOfAttribute = "memberOf"
+	// matchingRuleInChain is the OID of the LDAP_MATCHING_RULE_IN_CHAIN (Active Directory),
+	// which walks the chain of ancestry of the objects
+	matchingRuleInChain = "1.2.840.113556.1.4.1941"
+	// noAttributes requests no attributes of the entries, see RFC 4511
+	noAttributes = "1.1"
+	// maxGroups limits the amount of groups resolved to prevent endless or too expensive resolutions
+	maxGroups = 1000
+)
+
+// searcher is implemented by [ldap.Conn]
+type searcher interface {
+	Search(searchRequest *ldap.SearchRequest) (*ldap.SearchResult, error)
+}
+
+// resolveGroups returns the direct and nested groups of the user based on the requested resolution
+func resolveGroups(
+	conn searcher,
+	user *ldap.Entry,
+	resolution domain.LDAPGroupResolution,
+	groupBase string,
+	groupNameAttribute string,
+	timeout time.Duration,
+) ([]string, error) {
+	switch resolution {
+	case domain.LDAPGroupResolutionMemberOf:
+		return resolveMemberOfGroups(conn, user, groupNameAttribute, timeout)
+	case domain.LDAPGroupResolutionMatchingRuleInChain:
+		return resolveMatchingRuleInChainGroups(conn, user, groupBase, groupNameAttribute, timeout)
+	case domain.LDAPGroupResolutionUnspecified:
+		fallthrough
+	default:
+		return nil, nil
+	}
+}
+
+// resolveMemberOfGroups chases the memberOf attribute of the user and of each of its groups (breadth-first)
+func resolveMemberOfGroups(
+	conn searcher,
+	user *ldap.Entry,
+	groupNameAttribute string,
+	timeout time.Duration,
+) ([]string, error) {
+	attributes := []string{memberOfAttribute}
+	if groupNameAttribute != "" {
+		attributes = append(attributes, groupNameAttribute)
+	}
+	visited := make(map[string]struct{})
+	queue := user.GetAttributeValues(memberOfAttribute)
+	groups := make([]string, 0, len(queue))
+	for len(queue) > 0 && len(groups) < maxGroups {
+		groupDN := queue[0]
+		queue = queue[1:]
+		// DNs are case-insensitive
+		key := strings.ToLower(groupDN)
+		if _, ok := visited[key]; ok {
+			continue
+		}
+		visited[key] = struct{}{}
+
+		sr, err := conn.Search(ldap.NewSearchRequest(
+			groupDN,
+			ldap.ScopeBaseObject, ldap.NeverDerefAliases, 1, int(timeout.Seconds()), false,
+			"(objectClass=*)",
+			attributes,
+			nil,
+		))
+		if err != nil {
+			// the group might not be readable or not exist (anymore), e.g. a dangling reference
+			if ldap.IsErrorAnyOf(err, ldap.LDAPResultNoSuchObject, ldap.LDAPResultInsufficientAccessRights) {
+				groups = append(groups, groupDN)
+				continue
+			}
+			return nil, err
+		}
+		if len(sr.Entries) != 1 {
+			groups = append(groups, groupDN)
+			continue
+		}
+		groups = append(groups, groupName(sr.Entries[0], groupNameAttribute))
+		queue = append(queue, sr.Entries[0].GetAttributeValues(memberOfAttribute)...)
+	}
+	return groups, nil
+}
+
+// resolveMatchingRuleInChainGroups searches all groups the user is a direct or nested member of
+// using the LDAP_MATCHING_RULE_IN_CHAIN, which is supported by Active Directory
+func resolveMatchingRuleInChainGroups(
+	conn searcher,
+	user *ldap.Entry,
+	groupBase string,
+	groupNameAttribute string,
+	timeout time.Duration,
+) ([]string, error) {
+	attributes := []string{noAttributes}
+	if groupNameAttribute != "" {
+		attributes = []string{groupNameAttribute}
+	}
+	sr, err := conn.Search(ldap.NewSearchRequest(
+		groupBase,
+		ldap.ScopeWholeSubtree, ldap.NeverDerefAliases, maxGroups, int(timeout.Seconds()), false,
+		"(member:"+matchingRuleInChain+":="+ldap.EscapeFilter(user.DN)+")",
+		attributes,
+		nil,
+	))
+	// a reached size limit still returns the entries found so far
+	if err != nil && !ldap.IsErrorWithCode(err, ldap.LDAPResultSizeLimitExceeded) {
+		return nil, err
+	}
+	if sr == nil {
+		return nil, nil
+	}
+	groups := make([]string, 0, len(sr.Entries))
+	for _, entry := range sr.Entries {
+		groups = append(groups, groupName(entry, groupNameAttribute))
+	}
+	return groups, nil
+}
+
+// groupName returns the value of the groupNameAttribute of the group or its DN as fallback
+func groupName(group *ldap.Entry, groupNameAttribute string) string {
+	if name := getAttributeValue(group, groupNameAttribute); name != "" {
+		return name
+	}
+	return group.DN
+}
@@ -0,0 +1,180 @@
+package ldap
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/go-ldap/ldap/v3"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/zitadel/zitadel/internal/domain"
+)
+
+// mockSearcher returns the entry with the base DN (case-insensitive) of the search request
+// or the result of the subtree search by its filter
+type mockSearcher struct {
+	entries  map[string]*ldap.Entry
+	filters  map[string][]*ldap.Entry
+	err      error
+	requests []*ldap.SearchRequest
+}
+
+func (m *mockSearcher) Search(searchRequest *ldap.SearchRequest) (*ldap.SearchResult, error) {
+	m.requests = append(m.requests, searchRequest)
+	if m.err != nil {
+		return nil, m.err
+	}
+	if searchRequest.Scope == ldap.ScopeWholeSubtree {
+		return &ldap.SearchResult{Entries: m.filters[searchRequest.Filter]}, nil
+	}
+	entry, ok := m.entries[strings.ToLower(searchRequest.BaseDN)]
+	if !ok {
+		return nil, ldap.NewError(ldap.LDAPResultNoSuchObject, errors.New("no such object"))
+	}
+	return &ldap.SearchResult{Entries: []*ldap.Entry{entry}}, nil
+}
+
+func TestProvider_resolveGroups(t *testing.T) {
+	user := ldap.NewEntry("cn=user,ou=users,dc=example,dc=com", map[string][]string{
+		memberOfAttribute: {"cn=developers,ou=groups,dc=example,dc=com", "cn=support,ou=groups,dc=example,dc=com"},
+	})
+	type fields struct {
+		searcher           *mockSearcher
+		resolution         domain.LDAPGroupResolution
+		groupBase          string
+		groupNameAttribute string
+	}
+	type want struct {
+		groups  []string
+		err     error
+		filters []string
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		want   want
+	}{
+		{
+			name: "unspecified, no groups",
+			fields: fields{
+				searcher:   &mockSearcher{},
+				resolution: domain.LDAPGroupResolutionUnspecified,
+			},
+			want: want{},
+		},
+		{
+			name: "memberOf, nested groups",
+			fields: fields{
+				searcher: &mockSearcher{
+					entries: map[string]*ldap.Entry{
+						"cn=developers,ou=groups,dc=example,dc=com": ldap.NewEntry("cn=developers,ou=groups,dc=example,dc=com", map[string][]string{
+							memberOfAttribute: {"cn=engineering,ou=groups,dc=example,dc=com"},
+						}),
+						"cn=support,ou=groups,dc=example,dc=com": ldap.NewEntry("cn=support,ou=groups,dc=example,dc=com", nil),
+						"cn=engineering,ou=groups,dc=example,dc=com": ldap.NewEntry("cn=engineering,ou=groups,dc=example,dc=com", map[string][]string{
+							memberOfAttribute: {"CN=Developers,OU=Groups,DC=example,DC=com"},
+						}),
+					},
+				},
+				resolution: domain.LDAPGroupResolutionMemberOf,
+			},
+			want: want{
+				groups: []string{
+					"cn=developers,ou=groups,dc=example,dc=com",
+					"cn=support,ou=groups,dc=example,dc=com",
+					"cn=engineering,ou=groups,dc=example,dc=com",
+				},
+			},
+		},
+		{
+			name: "memberOf, group name attribute and dangling reference",
+			fields: fields{
+				searcher: &mockSearcher{
+					entries: map[string]*ldap.Entry{
+						"cn=developers,ou=groups,dc=example,dc=com": ldap.NewEntry("cn=developers,ou=groups,dc=example,dc=com", map[string][]string{
+							"cn":              {"developers"},
+							memberOfAttribute: {"cn=engineering,ou=groups,dc=example,dc=com"},
+						}),
+						"cn=engineering,ou=groups,dc=example,dc=com": ldap.NewEntry("cn=engineering,ou=groups,dc=example,dc=com", map[string][]string{
+							"cn": {"engineering"},
+						}),
+					},
+				},
+				resolution:         domain.LDAPGroupResolutionMemberOf,
+				groupNameAttribute: "cn",
+			},
+			want: want{
+				groups: []string{
+					"developers",
+					"cn=support,ou=groups,dc=example,dc=com",
+					"engineering",
+				},
+			},
+		},
+		{
+			name: "memberOf, error",
+			fields: fields{
+				searcher: &mockSearcher{
+					err: ldap.NewError(ldap.LDAPResultUnavailable, errors.New("unavailable")),
+				},
+				resolution: domain.LDAPGroupResolutionMemberOf,
+			},
+			want: want{
+				err: ldap.NewError(ldap.LDAPResultUnavailable, errors.New("unavailable")),
+			},
+		},
+		{
+			name: "matching rule in chain",
+			fields: fields{
+				searcher: &mockSearcher{
+					filters: map[string][]*ldap.Entry{
+						"(member:1.2.840.113556.1.4.1941:=cn=user,ou=users,dc=example,dc=com)": {
+							ldap.NewEntry("cn=developers,ou=groups,dc=example,dc=com", map[string][]string{"cn": {"developers"}}),
+							ldap.NewEntry("cn=engineering,ou=groups,dc=example,dc=com", nil),
+						},
+					},
+				},
+				resolution:         domain.LDAPGroupResolutionMatchingRuleInChain,
+				groupBase:          "ou=groups,dc=example,dc=com",
+				groupNameAttribute: "cn",
+			},
+			want: want{
+				groups: []string{
+					"developers",
+					"cn=engineering,ou=groups,dc=example,dc=com",
+				},
+				filters: []string{"(member:1.2.840.113556.1.4.1941:=cn=user,ou=users,dc=example,dc=com)"},
+			},
+		},
+		{
+			name: "matching rule in chain, error",
+			fields: fields{
+				searcher: &mockSearcher{
+					err: ldap.NewError(ldap.LDAPResultUnavailable, errors.New("unavailable")),
+				},
+				resolution: domain.LDAPGroupResolutionMatchingRuleInChain,
+				groupBase:  "ou=groups,dc=example,dc=com",
+			},
+			want: want{
+				err: ldap.NewError(ldap.LDAPResultUnavailable, errors.New("unavailable")),
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := resolveGroups(tt.fields.searcher, user, tt.fields.resolution, tt.fields.groupBase, tt.fields.groupNameAttribute, time.Second)
+			if tt.want.err != nil {
+				assert.EqualError(t, err, tt.want.err.Error())
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want.groups, got)
+			for i, filter := range tt.want.filters {
+				assert.Equal(t, filter, tt.fields.searcher.requests[i].Filter)
+				assert.Equal(t, tt.fields.groupBase, tt.fields.searcher.requests[i].BaseDN)
+			}
+		})
+	}
+}
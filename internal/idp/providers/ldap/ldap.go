@@ -4,18 +4,23 @@ import (
 	"context"
 	"time"
 
+	"github.com/zitadel/zitadel/internal/domain"
 	"github.com/zitadel/zitadel/internal/idp"
 )
 
 const DefaultPort = "389"
 
-var _ idp.Provider = (*Provider)(nil)
+var (
+	_ idp.Provider      = (*Provider)(nil)
+	_ idp.HealthChecker = (*Provider)(nil)
+)
 
 // Provider is the [idp.Provider] implementation for a generic LDAP provider
 type Provider struct {
 	name              string
 	servers           []string
 	startTLS          bool
+	rootCA            []byte
 	baseDN            string
 	bindDN            string
 	bindPassword      string
@@ -44,6 +49,11 @@ type Provider struct {
 	preferredLanguageAttribute string
 	avatarURLAttribute         string
 	profileAttribute           string
+	metadataAttributes         map[string]string
+
+	groupResolution    domain.LDAPGroupResolution
+	groupBase          string
+	groupNameAttribute string
 }
 
 type ProviderOpts func(provider *Provider)
@@ -84,6 +94,32 @@ func WithoutStartTLS() ProviderOpts {
 	}
 }
 
+// WithRootCA configures the PEM encoded certificates (bundle) used to verify the certificate of the LDAP server
+// for ldaps and startTLS connections, instead of the system roots
+func WithRootCA(rootCA []byte) ProviderOpts {
+	return func(p *Provider) {
+		p.rootCA = rootCA
+	}
+}
+
+// WithGroups configures to resolve the (nested) groups of the user, which are provided as `groups` claim.
+// The groups are searched in the groupBase (default baseDN) and represented by the value of the groupNameAttribute
+// or their DN if no attribute is configured
+func WithGroups(resolution domain.LDAPGroupResolution, groupBase, groupNameAttribute string) ProviderOpts {
+	return func(p *Provider) {
+		p.groupResolution = resolution
+		p.groupBase = groupBase
+		p.groupNameAttribute = groupNameAttribute
+	}
+}
+
+// WithMetadataAttributes configures to map the LDAP attributes (value) to the metadata of the user (key)
+func WithMetadataAttributes(attributes map[string]string) ProviderOpts {
+	return func(p *Provider) {
+		p.metadataAttributes = attributes
+	}
+}
+
 // WithCustomIDAttribute configures to map the LDAP attribute to the user, default is the uniqueUserAttribute
 func WithCustomIDAttribute(name string) ProviderOpts {
 	return func(p *Provider) {
@@ -242,6 +278,17 @@ func (p *Provider) IsAutoUpdate() bool {
 	return p.isAutoUpdate
 }
 
+// CheckHealth implements the [idp.HealthChecker] interface.
+// It checks the connection and bind (with the BindDN) to every server
+// and the expiration of the server certificate for encrypted connections.
+func (p *Provider) CheckHealth(_ context.Context) []*domain.IDPHealthCheck {
+	checks := make([]*domain.IDPHealthCheck, 0, len(p.servers))
+	for _, server := range p.servers {
+		checks = append(checks, checkServer(server, p.startTLS, p.rootCA, p.bindDN, p.bindPassword, p.timeout)...)
+	}
+	return checks
+}
+
 func (p *Provider) getNecessaryAttributes() []string {
 	attributes := []string{p.userBase}
 	if p.idAttribute != "" {
@@ -283,5 +330,13 @@ func (p *Provider) getNecessaryAttributes() []string {
 	if p.profileAttribute != "" {
 		attributes = append(attributes, p.profileAttribute)
 	}
+	for _, attribute := range p.metadataAttributes {
+		if attribute != "" {
+			attributes = append(attributes, attribute)
+		}
+	}
+	if p.groupResolution == domain.LDAPGroupResolutionMemberOf {
+		attributes = append(attributes, memberOfAttribute)
+	}
 	return attributes
 }
@@ -5,6 +5,8 @@ import (
 	"time"
 
 	"github.com/stretchr/testify/assert"
+
+	"github.com/zitadel/zitadel/internal/domain"
 )
 
 func TestProvider_Options(t *testing.T) {
@@ -41,6 +43,11 @@ func TestProvider_Options(t *testing.T) {
 		preferredLanguageAttribute string
 		avatarURLAttribute         string
 		profileAttribute           string
+		metadataAttributes         map[string]string
+		rootCA                     []byte
+		groupResolution            domain.LDAPGroupResolution
+		groupBase                  string
+		groupNameAttribute         string
 	}
 	tests := []struct {
 		name   string
@@ -134,6 +141,9 @@ func TestProvider_Options(t *testing.T) {
 					WithPreferredLanguageAttribute("prefLang"),
 					WithAvatarURLAttribute("avatar"),
 					WithProfileAttribute("profile"),
+					WithMetadataAttributes(map[string]string{"department": "departmentNumber"}),
+					WithRootCA([]byte("rootCA")),
+					WithGroups(domain.LDAPGroupResolutionMatchingRuleInChain, "groups", "cn"),
 				},
 			},
 			want: want{
@@ -156,6 +166,11 @@ func TestProvider_Options(t *testing.T) {
 				preferredLanguageAttribute: "prefLang",
 				avatarURLAttribute:         "avatar",
 				profileAttribute:           "profile",
+				metadataAttributes:         map[string]string{"department": "departmentNumber"},
+				rootCA:                     []byte("rootCA"),
+				groupResolution:            domain.LDAPGroupResolutionMatchingRuleInChain,
+				groupBase:                  "groups",
+				groupNameAttribute:         "cn",
 			},
 		},
 	}
@@ -196,6 +211,11 @@ func TestProvider_Options(t *testing.T) {
 			a.Equal(tt.want.preferredLanguageAttribute, provider.preferredLanguageAttribute)
 			a.Equal(tt.want.avatarURLAttribute, provider.avatarURLAttribute)
 			a.Equal(tt.want.profileAttribute, provider.profileAttribute)
+			a.Equal(tt.want.metadataAttributes, provider.metadataAttributes)
+			a.Equal(tt.want.rootCA, provider.rootCA)
+			a.Equal(tt.want.groupResolution, provider.groupResolution)
+			a.Equal(tt.want.groupBase, provider.groupBase)
+			a.Equal(tt.want.groupNameAttribute, provider.groupNameAttribute)
 		})
 	}
 }
@@ -3,6 +3,7 @@ package ldap
 import (
 	"context"
 	"crypto/tls"
+	"crypto/x509"
 	"encoding/base64"
 	"errors"
 	"net"
@@ -21,6 +22,7 @@ import (
 
 var ErrNoSingleUser = errors.New("user does not exist or too many entries returned")
 var ErrFailedLogin = errors.New("user failed to login")
+var ErrInvalidRootCA = errors.New("no valid root CA certificate found")
 
 var _ idp.Session = (*Session)(nil)
 
@@ -39,9 +41,11 @@ func (s *Session) GetAuth(ctx context.Context) (string, bool) {
 
 func (s *Session) FetchUser(_ context.Context) (_ idp.User, err error) {
 	var user *ldap.Entry
+	var groups []string
 	for _, server := range s.Provider.servers {
-		user, err = tryBind(server,
+		user, groups, err = tryBind(server,
 			s.Provider.startTLS,
+			s.Provider.rootCA,
 			s.Provider.bindDN,
 			s.Provider.bindPassword,
 			s.Provider.baseDN,
@@ -49,7 +53,12 @@ func (s *Session) FetchUser(_ context.Context) (_ idp.User, err error) {
 			s.Provider.userObjectClasses,
 			s.Provider.userFilters,
 			s.User,
-			s.Password, s.Provider.timeout)
+			s.Password,
+			s.Provider.timeout,
+			s.Provider.groupResolution,
+			s.Provider.groupBase,
+			s.Provider.groupNameAttribute,
+		)
 		// If there were invalid credentials or multiple users with the credentials cancel process
 		if err != nil && (errors.Is(err, ErrFailedLogin) || errors.Is(err, ErrNoSingleUser)) {
 			return nil, err
@@ -64,7 +73,7 @@ func (s *Session) FetchUser(_ context.Context) (_ idp.User, err error) {
 	}
 	s.Entry = user
 
-	return mapLDAPEntryToUser(
+	mappedUser, err := mapLDAPEntryToUser(
 		user,
 		s.Provider.idAttribute,
 		s.Provider.firstNameAttribute,
@@ -80,11 +89,18 @@ func (s *Session) FetchUser(_ context.Context) (_ idp.User, err error) {
 		s.Provider.avatarURLAttribute,
 		s.Provider.profileAttribute,
 	)
+	if err != nil {
+		return nil, err
+	}
+	mappedUser.Groups = groups
+	mappedUser.Metadata = mapLDAPEntryToMetadata(user, s.Provider.metadataAttributes)
+	return mappedUser, nil
 }
 
 func tryBind(
 	server string,
 	startTLS bool,
+	rootCA []byte,
 	bindDN string,
 	bindPassword string,
 	baseDN string,
@@ -94,18 +110,21 @@ func tryBind(
 	username string,
 	password string,
 	timeout time.Duration,
-) (*ldap.Entry, error) {
-	conn, err := getConnection(server, startTLS, timeout)
+	groupResolution domain.LDAPGroupResolution,
+	groupBase string,
+	groupNameAttribute string,
+) (*ldap.Entry, []string, error) {
+	conn, err := getConnection(server, startTLS, rootCA, timeout)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 	defer conn.Close()
 
 	if err := conn.Bind(bindDN, bindPassword); err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
-	return trySearchAndUserBind(
+	user, err := trySearchAndUserBind(
 		conn,
 		baseDN,
 		attributes,
@@ -115,35 +134,110 @@ func tryBind(
 		password,
 		timeout,
 	)
+	if err != nil || groupResolution == domain.LDAPGroupResolutionUnspecified {
+		return user, nil, err
+	}
+
+	// the groups are searched with the bind user, since the user might not be allowed to read them
+	if err := conn.Bind(bindDN, bindPassword); err != nil {
+		return nil, nil, err
+	}
+	if groupBase == "" {
+		groupBase = baseDN
+	}
+	groups, err := resolveGroups(conn, user, groupResolution, groupBase, groupNameAttribute, timeout)
+	if err != nil {
+		return nil, nil, err
+	}
+	return user, groups, nil
 }
 
 func getConnection(
 	server string,
 	startTLS bool,
+	rootCA []byte,
 	timeout time.Duration,
 ) (*ldap.Conn, error) {
 	if timeout == 0 {
 		timeout = ldap.DefaultTimeout
 	}
 
-	conn, err := ldap.DialURL(server, ldap.DialWithDialer(&net.Dialer{Timeout: timeout}))
+	u, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+	tlsConfig, err := getTLSConfig(u.Hostname(), rootCA)
 	if err != nil {
 		return nil, err
 	}
 
-	u, err := url.Parse(server)
+	conn, err := ldap.DialURL(server, ldap.DialWithDialer(&net.Dialer{Timeout: timeout}), ldap.DialWithTLSConfig(tlsConfig))
 	if err != nil {
 		return nil, err
 	}
-	if u.Scheme == "ldaps" && startTLS {
-		err = conn.StartTLS(&tls.Config{ServerName: u.Host})
+
+	// ldaps connections are already encrypted, so StartTLS only upgrades plain ldap connections
+	if u.Scheme == "ldap" && startTLS {
+		err = conn.StartTLS(tlsConfig)
 		if err != nil {
+			conn.Close()
 			return nil, err
 		}
 	}
 	return conn, nil
 }
 
+// checkServer connects and binds to the server and reports the expiration of the server certificate
+func checkServer(
+	server string,
+	startTLS bool,
+	rootCA []byte,
+	bindDN string,
+	bindPassword string,
+	timeout time.Duration,
+) []*domain.IDPHealthCheck {
+	check := &domain.IDPHealthCheck{
+		Type:   domain.IDPHealthCheckTypeConnection,
+		Target: server,
+	}
+	conn, err := getConnection(server, startTLS, rootCA, timeout)
+	if err != nil {
+		check.Error = err.Error()
+		return []*domain.IDPHealthCheck{check}
+	}
+	defer conn.Close()
+
+	if err := conn.Bind(bindDN, bindPassword); err != nil {
+		check.Error = err.Error()
+	}
+	state, ok := conn.TLSConnectionState()
+	if !ok || len(state.PeerCertificates) == 0 {
+		return []*domain.IDPHealthCheck{check}
+	}
+	return []*domain.IDPHealthCheck{check, idp.CheckCertificate(state.PeerCertificates[0])}
+}
+
+// getTLSConfig returns the TLS config for the server,
+// which verifies the certificate against the provided root CAs or the system roots if none are provided
+func getTLSConfig(serverName string, rootCA []byte) (*tls.Config, error) {
+	tlsConfig := &tls.Config{ServerName: serverName}
+	if len(rootCA) == 0 {
+		return tlsConfig, nil
+	}
+	rootCAs := x509.NewCertPool()
+	if !rootCAs.AppendCertsFromPEM(rootCA) {
+		return nil, ErrInvalidRootCA
+	}
+	tlsConfig.RootCAs = rootCAs
+	return tlsConfig, nil
+}
+
+// ValidateRootCA checks that the PEM encoded root CA (bundle) contains at least one valid certificate
+func ValidateRootCA(rootCA []byte) error {
+	_, err := getTLSConfig("", rootCA)
+	return err
+}
+
 func trySearchAndUserBind(
 	conn *ldap.Conn,
 	baseDN string,
@@ -280,6 +374,21 @@ func mapLDAPEntryToUser(
 	), nil
 }
 
+// mapLDAPEntryToMetadata maps the values of the LDAP attributes (value) to the metadata of the user (key),
+// attributes without value are omitted
+func mapLDAPEntryToMetadata(user *ldap.Entry, metadataAttributes map[string]string) map[string]string {
+	if len(metadataAttributes) == 0 {
+		return nil
+	}
+	metadata := make(map[string]string, len(metadataAttributes))
+	for key, attribute := range metadataAttributes {
+		if value := getAttributeValue(user, attribute); value != "" {
+			metadata[key] = value
+		}
+	}
+	return metadata
+}
+
 func getAttributeValue(user *ldap.Entry, attribute string) string {
 	// return an empty string if no attribute is needed
 	if attribute == "" {
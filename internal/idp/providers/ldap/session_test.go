@@ -1,11 +1,24 @@
 package ldap
 
 import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"net"
 	"testing"
+	"time"
 
 	"github.com/go-ldap/ldap/v3"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	"golang.org/x/text/language"
+
+	"github.com/zitadel/zitadel/internal/domain"
 )
 
 func TestProvider_objectClassesToSearchQuery(t *testing.T) {
@@ -398,3 +411,96 @@ func TestProvider_mapLDAPEntryToUser(t *testing.T) {
 		})
 	}
 }
+
+func TestProvider_mapLDAPEntryToMetadata(t *testing.T) {
+	user := &ldap.Entry{
+		Attributes: []*ldap.EntryAttribute{
+			{Name: "department", Values: []string{"engineering"}},
+			{Name: "employeeNumber", Values: []string{"42"}},
+			{Name: "empty", Values: []string{""}},
+		},
+	}
+	tests := []struct {
+		name       string
+		attributes map[string]string
+		want       map[string]string
+	}{
+		{
+			name:       "no attributes",
+			attributes: nil,
+			want:       nil,
+		},
+		{
+			name: "attributes",
+			attributes: map[string]string{
+				"department": "department",
+				"employee":   "employeeNumber",
+				"empty":      "empty",
+				"missing":    "missing",
+			},
+			want: map[string]string{
+				"department": "engineering",
+				"employee":   "42",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, mapLDAPEntryToMetadata(user, tt.attributes))
+		})
+	}
+}
+
+func TestValidateRootCA(t *testing.T) {
+	tests := []struct {
+		name    string
+		rootCA  []byte
+		wantErr error
+	}{
+		{
+			name:    "no certificate",
+			rootCA:  []byte("certificate"),
+			wantErr: ErrInvalidRootCA,
+		},
+		{
+			name:   "certificate",
+			rootCA: testRootCA(t),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.ErrorIs(t, ValidateRootCA(tt.rootCA), tt.wantErr)
+		})
+	}
+}
+
+func TestProvider_CheckHealth(t *testing.T) {
+	// get a free port, which is not listening anymore
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	server := "ldap://" + listener.Addr().String()
+	require.NoError(t, listener.Close())
+
+	provider := New("ldap", []string{server}, "dc=example,dc=com", "cn=admin", "password", "dn", nil, nil, time.Second, "")
+	checks := provider.CheckHealth(context.Background())
+	require.Len(t, checks, 1)
+	assert.Equal(t, domain.IDPHealthCheckTypeConnection, checks[0].Type)
+	assert.Equal(t, server, checks[0].Target)
+	assert.NotEmpty(t, checks[0].Error)
+}
+
+func testRootCA(t *testing.T) []byte {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "ldap"},
+		NotBefore:             time.Now(),
+		NotAfter:              time.Now().Add(time.Hour),
+		IsCA:                  true,
+		BasicConstraintsValid: true,
+	}
+	certificate, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	require.NoError(t, err)
+	return pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: certificate})
+}
@@ -4,8 +4,16 @@ import (
 	"golang.org/x/text/language"
 
 	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/idp"
 )
 
+var (
+	_ idp.UserClaims   = (*User)(nil)
+	_ idp.UserMetadata = (*User)(nil)
+)
+
+const groupsClaim = "groups"
+
 type User struct {
 	ID                string              `json:"id,omitempty"`
 	FirstName         string              `json:"firstName,omitempty"`
@@ -20,6 +28,8 @@ type User struct {
 	PreferredLanguage language.Tag        `json:"preferredLanguage,omitempty"`
 	AvatarURL         string              `json:"avatarURL,omitempty"`
 	Profile           string              `json:"profile,omitempty"`
+	Groups            []string            `json:"groups,omitempty"`
+	Metadata          map[string]string   `json:"metadata,omitempty"`
 }
 
 func NewUser(
@@ -38,19 +48,19 @@ func NewUser(
 	profile string,
 ) *User {
 	return &User{
-		id,
-		firstName,
-		lastName,
-		displayName,
-		nickName,
-		preferredUsername,
-		email,
-		emailVerified,
-		phone,
-		phoneVerified,
-		preferredLanguage,
-		avatarURL,
-		profile,
+		ID:                id,
+		FirstName:         firstName,
+		LastName:          lastName,
+		DisplayName:       displayName,
+		NickName:          nickName,
+		PreferredUsername: preferredUsername,
+		Email:             email,
+		EmailVerified:     emailVerified,
+		Phone:             phone,
+		PhoneVerified:     phoneVerified,
+		PreferredLanguage: preferredLanguage,
+		AvatarURL:         avatarURL,
+		Profile:           profile,
 	}
 }
 
@@ -93,3 +103,17 @@ func (u *User) GetAvatarURL() string {
 func (u *User) GetProfile() string {
 	return u.Profile
 }
+
+// GetClaim is an implementation of the [idp.UserClaims] interface.
+// The LDAP user provides the resolved (nested) groups as `groups` claim.
+func (u *User) GetClaim(name string) []string {
+	if name == groupsClaim {
+		return u.Groups
+	}
+	return nil
+}
+
+// GetMetadata is an implementation of the [idp.UserMetadata] interface.
+func (u *User) GetMetadata() map[string]string {
+	return u.Metadata
+}
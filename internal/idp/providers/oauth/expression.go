@@ -0,0 +1,326 @@
+package oauth
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+var ErrInvalidExpression = errors.New("invalid expression")
+
+// Expression is a jq like expression to select a value of the user information returned by the user endpoint.
+// It supports:
+//   - paths to select a value, e.g. `.data.attributes.email`, `.emails[0].value`, `.["x-user-id"]` or `.` for the whole document
+//   - string literals, e.g. `"unknown"`
+//   - concatenation of values as strings, e.g. `.given_name + " " + .family_name`, missing values are ignored
+//   - alternatives, which return the first value which is neither missing, null nor false, e.g. `.email // .upn`
+//   - parentheses, e.g. `(.first // .given_name) + " " + .last`
+type Expression struct {
+	raw  string
+	root expressionNode
+}
+
+// ParseExpression parses the expression and returns an [ErrInvalidExpression] if the syntax is not valid.
+func ParseExpression(expression string) (*Expression, error) {
+	p := &expressionParser{input: expression}
+	root, err := p.parseAlternative()
+	if err != nil {
+		return nil, err
+	}
+	p.skipWhitespace()
+	if !p.done() {
+		return nil, p.errorf("unexpected %q", p.input[p.pos])
+	}
+	return &Expression{raw: expression, root: root}, nil
+}
+
+// Evaluate returns the value selected by the expression or nil if it does not exist.
+func (e *Expression) Evaluate(data interface{}) interface{} {
+	if e == nil {
+		return nil
+	}
+	return e.root.evaluate(data)
+}
+
+func (e *Expression) String() string {
+	return e.raw
+}
+
+type expressionNode interface {
+	evaluate(data interface{}) interface{}
+}
+
+type pathNode struct {
+	// segments are either the key (string) of an object or the index (int) of an array
+	segments []interface{}
+}
+
+func (n *pathNode) evaluate(data interface{}) interface{} {
+	current := data
+	for _, segment := range n.segments {
+		switch s := segment.(type) {
+		case string:
+			object, ok := current.(map[string]interface{})
+			if !ok {
+				return nil
+			}
+			current = object[s]
+		case int:
+			array, ok := current.([]interface{})
+			if !ok {
+				return nil
+			}
+			index := s
+			if index < 0 {
+				index += len(array)
+			}
+			if index < 0 || index >= len(array) {
+				return nil
+			}
+			current = array[index]
+		}
+	}
+	return current
+}
+
+type literalNode struct {
+	value string
+}
+
+func (n *literalNode) evaluate(interface{}) interface{} {
+	return n.value
+}
+
+type concatNode struct {
+	terms []expressionNode
+}
+
+func (n *concatNode) evaluate(data interface{}) interface{} {
+	var (
+		builder strings.Builder
+		found   bool
+	)
+	for _, term := range n.terms {
+		value := term.evaluate(data)
+		if value == nil {
+			continue
+		}
+		found = true
+		builder.WriteString(valueToString(value))
+	}
+	if !found {
+		return nil
+	}
+	return builder.String()
+}
+
+type alternativeNode struct {
+	options []expressionNode
+}
+
+func (n *alternativeNode) evaluate(data interface{}) interface{} {
+	for _, option := range n.options {
+		value := option.evaluate(data)
+		if value == nil || value == false {
+			continue
+		}
+		return value
+	}
+	return nil
+}
+
+type expressionParser struct {
+	input string
+	pos   int
+}
+
+func (p *expressionParser) parseAlternative() (expressionNode, error) {
+	first, err := p.parseConcat()
+	if err != nil {
+		return nil, err
+	}
+	options := []expressionNode{first}
+	for p.consume("//") {
+		option, err := p.parseConcat()
+		if err != nil {
+			return nil, err
+		}
+		options = append(options, option)
+	}
+	if len(options) == 1 {
+		return first, nil
+	}
+	return &alternativeNode{options: options}, nil
+}
+
+func (p *expressionParser) parseConcat() (expressionNode, error) {
+	first, err := p.parseTerm()
+	if err != nil {
+		return nil, err
+	}
+	terms := []expressionNode{first}
+	for p.consume("+") {
+		term, err := p.parseTerm()
+		if err != nil {
+			return nil, err
+		}
+		terms = append(terms, term)
+	}
+	if len(terms) == 1 {
+		return first, nil
+	}
+	return &concatNode{terms: terms}, nil
+}
+
+func (p *expressionParser) parseTerm() (expressionNode, error) {
+	p.skipWhitespace()
+	if p.done() {
+		return nil, p.errorf("unexpected end")
+	}
+	switch p.input[p.pos] {
+	case '.':
+		return p.parsePath()
+	case '"':
+		value, err := p.parseString()
+		if err != nil {
+			return nil, err
+		}
+		return &literalNode{value: value}, nil
+	case '(':
+		p.pos++
+		node, err := p.parseAlternative()
+		if err != nil {
+			return nil, err
+		}
+		if !p.consume(")") {
+			return nil, p.errorf("missing \")\"")
+		}
+		return node, nil
+	default:
+		return nil, p.errorf("unexpected %q", p.input[p.pos])
+	}
+}
+
+func (p *expressionParser) parsePath() (expressionNode, error) {
+	node := new(pathNode)
+	// the path starts with a dot, which is either followed by a key, a bracket or nothing (identity)
+	p.pos++
+	if !p.done() && isIdentifierStart(p.input[p.pos]) {
+		node.segments = append(node.segments, p.parseIdentifier())
+	}
+	for !p.done() {
+		switch p.input[p.pos] {
+		case '.':
+			p.pos++
+			if p.done() || !isIdentifierStart(p.input[p.pos]) {
+				if !p.done() && p.input[p.pos] == '[' {
+					continue
+				}
+				return nil, p.errorf("missing key")
+			}
+			node.segments = append(node.segments, p.parseIdentifier())
+		case '[':
+			p.pos++
+			segment, err := p.parseIndex()
+			if err != nil {
+				return nil, err
+			}
+			node.segments = append(node.segments, segment)
+		default:
+			return node, nil
+		}
+	}
+	return node, nil
+}
+
+func (p *expressionParser) parseIdentifier() string {
+	start := p.pos
+	for !p.done() && isIdentifierPart(p.input[p.pos]) {
+		p.pos++
+	}
+	return p.input[start:p.pos]
+}
+
+// parseIndex parses the content of the brackets, which is either the index of an array or the quoted key of an object
+func (p *expressionParser) parseIndex() (segment interface{}, err error) {
+	p.skipWhitespace()
+	if p.done() {
+		return nil, p.errorf("unexpected end")
+	}
+	if p.input[p.pos] == '"' {
+		segment, err = p.parseString()
+	} else {
+		start := p.pos
+		if p.input[p.pos] == '-' {
+			p.pos++
+		}
+		for !p.done() && p.input[p.pos] >= '0' && p.input[p.pos] <= '9' {
+			p.pos++
+		}
+		segment, err = strconv.Atoi(p.input[start:p.pos])
+		if err != nil {
+			return nil, p.errorf("invalid index %q", p.input[start:p.pos])
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+	if !p.consume("]") {
+		return nil, p.errorf("missing \"]\"")
+	}
+	return segment, nil
+}
+
+func (p *expressionParser) parseString() (string, error) {
+	start := p.pos
+	p.pos++
+	for !p.done() {
+		switch p.input[p.pos] {
+		case '\\':
+			p.pos += 2
+		case '"':
+			p.pos++
+			value, err := strconv.Unquote(p.input[start:p.pos])
+			if err != nil {
+				return "", p.errorf("invalid string %s", p.input[start:p.pos])
+			}
+			return value, nil
+		default:
+			p.pos++
+		}
+	}
+	return "", p.errorf("unterminated string")
+}
+
+// consume skips the whitespaces and the token if it is next
+func (p *expressionParser) consume(token string) bool {
+	p.skipWhitespace()
+	if !strings.HasPrefix(p.input[p.pos:], token) {
+		return false
+	}
+	p.pos += len(token)
+	return true
+}
+
+func (p *expressionParser) skipWhitespace() {
+	for !p.done() && (p.input[p.pos] == ' ' || p.input[p.pos] == '\t' || p.input[p.pos] == '\n' || p.input[p.pos] == '\r') {
+		p.pos++
+	}
+}
+
+func (p *expressionParser) done() bool {
+	return p.pos >= len(p.input)
+}
+
+func (p *expressionParser) errorf(format string, args ...interface{}) error {
+	return fmt.Errorf("%w %q: %s at position %d", ErrInvalidExpression, p.input, fmt.Sprintf(format, args...), p.pos)
+}
+
+func isIdentifierStart(c byte) bool {
+	return c == '_' || (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z')
+}
+
+func isIdentifierPart(c byte) bool {
+	return isIdentifierStart(c) || (c >= '0' && c <= '9')
+}
@@ -0,0 +1,262 @@
+package oauth
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/zitadel/zitadel/internal/domain"
+)
+
+const expressionTestUserInfo = `{
+	"id": 42,
+	"data": {
+		"attributes": {
+			"email": "user@example.com",
+			"email_verified": true,
+			"given_name": "Jane",
+			"family_name": "Doe",
+			"x-tenant": "acme"
+		}
+	},
+	"emails": [{"value": "first@example.com"}, {"value": "second@example.com"}],
+	"disabled": false,
+	"nothing": null
+}`
+
+func TestParseExpression(t *testing.T) {
+	var data map[string]interface{}
+	require.NoError(t, json.Unmarshal([]byte(expressionTestUserInfo), &data))
+
+	tests := []struct {
+		name       string
+		expression string
+		want       interface{}
+		wantErr    bool
+	}{
+		{
+			name:       "key",
+			expression: ".id",
+			want:       float64(42),
+		},
+		{
+			name:       "nested key",
+			expression: ".data.attributes.email",
+			want:       "user@example.com",
+		},
+		{
+			name:       "quoted key",
+			expression: `.data.attributes["x-tenant"]`,
+			want:       "acme",
+		},
+		{
+			name:       "quoted key after dot",
+			expression: `.data.attributes.["x-tenant"]`,
+			want:       "acme",
+		},
+		{
+			name:       "index",
+			expression: ".emails[1].value",
+			want:       "second@example.com",
+		},
+		{
+			name:       "negative index",
+			expression: ".emails[-2].value",
+			want:       "first@example.com",
+		},
+		{
+			name:       "index out of range",
+			expression: ".emails[2].value",
+			want:       nil,
+		},
+		{
+			name:       "missing key",
+			expression: ".data.missing.email",
+			want:       nil,
+		},
+		{
+			name:       "identity",
+			expression: ".",
+			want:       data,
+		},
+		{
+			name:       "literal",
+			expression: `"unknown"`,
+			want:       "unknown",
+		},
+		{
+			name:       "concat",
+			expression: `.data.attributes.given_name + " " + .data.attributes.family_name`,
+			want:       "Jane Doe",
+		},
+		{
+			name:       "concat ignores missing values",
+			expression: `.missing + .data.attributes.given_name`,
+			want:       "Jane",
+		},
+		{
+			name:       "concat of missing values",
+			expression: `.missing + .nothing`,
+			want:       nil,
+		},
+		{
+			name:       "concat converts values",
+			expression: `"id-" + .id`,
+			want:       "id-42",
+		},
+		{
+			name:       "alternative",
+			expression: `.email // .nothing // .disabled // .emails[0].value`,
+			want:       "first@example.com",
+		},
+		{
+			name:       "alternative with default",
+			expression: `.email // "none"`,
+			want:       "none",
+		},
+		{
+			name:       "parentheses",
+			expression: `(.first // .data.attributes.given_name) + "!"`,
+			want:       "Jane!",
+		},
+		{
+			name:       "empty",
+			expression: "",
+			wantErr:    true,
+		},
+		{
+			name:       "missing dot",
+			expression: "email",
+			wantErr:    true,
+		},
+		{
+			name:       "trailing dot",
+			expression: ".data.",
+			wantErr:    true,
+		},
+		{
+			name:       "missing bracket",
+			expression: ".emails[0",
+			wantErr:    true,
+		},
+		{
+			name:       "invalid index",
+			expression: ".emails[first]",
+			wantErr:    true,
+		},
+		{
+			name:       "unterminated string",
+			expression: `.email // "none`,
+			wantErr:    true,
+		},
+		{
+			name:       "missing operand",
+			expression: ".email //",
+			wantErr:    true,
+		},
+		{
+			name:       "missing parenthesis",
+			expression: "(.email",
+			wantErr:    true,
+		},
+		{
+			name:       "unsupported operator",
+			expression: ".email | .upn",
+			wantErr:    true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			expression, err := ParseExpression(tt.expression)
+			if tt.wantErr {
+				assert.ErrorIs(t, err, ErrInvalidExpression)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.expression, expression.String())
+			assert.Equal(t, tt.want, expression.Evaluate(data))
+		})
+	}
+}
+
+func TestUserMapper_AttributeMapping(t *testing.T) {
+	mapping, err := NewAttributeMapping(&domain.OAuthAttributeMapping{
+		FirstName:         ".data.attributes.given_name",
+		LastName:          ".data.attributes.family_name",
+		DisplayName:       `.data.attributes.given_name + " " + .data.attributes.family_name`,
+		PreferredUsername: ".data.attributes.email",
+		Email:             ".data.attributes.email",
+		EmailVerified:     ".data.attributes.email_verified",
+		PreferredLanguage: `.locale // "de"`,
+		Metadata: map[string]string{
+			"tenant":  `.data.attributes["x-tenant"]`,
+			"missing": ".missing",
+		},
+	})
+	require.NoError(t, err)
+	mapper := NewUserMapperWithAttributeMapping("id", mapping)
+	require.NoError(t, json.Unmarshal([]byte(expressionTestUserInfo), mapper))
+
+	assert.Equal(t, "42", mapper.GetID())
+	assert.Equal(t, "Jane", mapper.GetFirstName())
+	assert.Equal(t, "Doe", mapper.GetLastName())
+	assert.Equal(t, "Jane Doe", mapper.GetDisplayName())
+	assert.Equal(t, "", mapper.GetNickname())
+	assert.Equal(t, "user@example.com", mapper.GetPreferredUsername())
+	assert.Equal(t, domain.EmailAddress("user@example.com"), mapper.GetEmail())
+	assert.True(t, mapper.IsEmailVerified())
+	assert.Equal(t, "de", mapper.GetPreferredLanguage().String())
+	assert.Equal(t, map[string]string{"tenant": "acme"}, mapper.GetMetadata())
+}
+
+func TestNewAttributeMapping(t *testing.T) {
+	tests := []struct {
+		name    string
+		mapping *domain.OAuthAttributeMapping
+		wantNil bool
+		wantErr bool
+	}{
+		{
+			name:    "nil",
+			mapping: nil,
+			wantNil: true,
+		},
+		{
+			name:    "empty",
+			mapping: &domain.OAuthAttributeMapping{},
+			wantNil: true,
+		},
+		{
+			name:    "invalid attribute expression",
+			mapping: &domain.OAuthAttributeMapping{Email: "email"},
+			wantErr: true,
+		},
+		{
+			name:    "invalid metadata expression",
+			mapping: &domain.OAuthAttributeMapping{Metadata: map[string]string{"key": ".a."}},
+			wantErr: true,
+		},
+		{
+			name:    "missing metadata key",
+			mapping: &domain.OAuthAttributeMapping{Metadata: map[string]string{"": ".a"}},
+			wantErr: true,
+		},
+		{
+			name:    "valid",
+			mapping: &domain.OAuthAttributeMapping{Email: ".email", Metadata: map[string]string{"key": ".a"}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := NewAttributeMapping(tt.mapping)
+			if tt.wantErr {
+				assert.ErrorIs(t, err, ErrInvalidExpression)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantNil, got == nil)
+		})
+	}
+}
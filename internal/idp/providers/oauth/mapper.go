@@ -11,13 +11,90 @@ import (
 	"github.com/zitadel/zitadel/internal/idp"
 )
 
-var _ idp.User = (*UserMapper)(nil)
+var (
+	_ idp.User         = (*UserMapper)(nil)
+	_ idp.UserClaims   = (*UserMapper)(nil)
+	_ idp.UserMetadata = (*UserMapper)(nil)
+)
+
+const (
+	attributeFirstName         = "firstName"
+	attributeLastName          = "lastName"
+	attributeDisplayName       = "displayName"
+	attributeNickname          = "nickname"
+	attributePreferredUsername = "preferredUsername"
+	attributeEmail             = "email"
+	attributeEmailVerified     = "emailVerified"
+	attributePhone             = "phone"
+	attributePreferredLanguage = "preferredLanguage"
+	attributeAvatarURL         = "avatarUrl"
+)
+
+// AttributeMapping contains the parsed expressions of a [domain.OAuthAttributeMapping].
+type AttributeMapping struct {
+	attributes map[string]*Expression
+	metadata   map[string]*Expression
+}
+
+// NewAttributeMapping parses the expressions of the mapping.
+// It returns an error wrapping [ErrInvalidExpression] for the first invalid expression
+// and nil if the mapping does not contain any expression.
+func NewAttributeMapping(mapping *domain.OAuthAttributeMapping) (*AttributeMapping, error) {
+	if mapping.IsZero() {
+		return nil, nil
+	}
+	attributeMapping := &AttributeMapping{
+		attributes: make(map[string]*Expression),
+		metadata:   make(map[string]*Expression, len(mapping.Metadata)),
+	}
+	for attribute, expression := range map[string]string{
+		attributeFirstName:         mapping.FirstName,
+		attributeLastName:          mapping.LastName,
+		attributeDisplayName:       mapping.DisplayName,
+		attributeNickname:          mapping.Nickname,
+		attributePreferredUsername: mapping.PreferredUsername,
+		attributeEmail:             mapping.Email,
+		attributeEmailVerified:     mapping.EmailVerified,
+		attributePhone:             mapping.Phone,
+		attributePreferredLanguage: mapping.PreferredLanguage,
+		attributeAvatarURL:         mapping.AvatarURL,
+	} {
+		if expression == "" {
+			continue
+		}
+		parsed, err := ParseExpression(expression)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", attribute, err)
+		}
+		attributeMapping.attributes[attribute] = parsed
+	}
+	for key, expression := range mapping.Metadata {
+		if key == "" {
+			return nil, fmt.Errorf("metadata: %w: missing key", ErrInvalidExpression)
+		}
+		parsed, err := ParseExpression(expression)
+		if err != nil {
+			return nil, fmt.Errorf("metadata %s: %w", key, err)
+		}
+		attributeMapping.metadata[key] = parsed
+	}
+	return attributeMapping, nil
+}
+
+func (m *AttributeMapping) evaluate(attribute string, data interface{}) interface{} {
+	if m == nil {
+		return nil
+	}
+	return m.attributes[attribute].Evaluate(data)
+}
 
 // UserMapper is an implementation of [idp.User].
-// It can be used in ZITADEL actions to map the `RawInfo`
+// It can be used in ZITADEL actions to map the `RawInfo`.
+// The attributes of the user are only set, if an [AttributeMapping] is provided.
 type UserMapper struct {
-	idAttribute string
-	RawInfo     map[string]interface{}
+	idAttribute      string
+	attributeMapping *AttributeMapping
+	RawInfo          map[string]interface{}
 }
 
 func NewUserMapper(idAttribute string) *UserMapper {
@@ -27,6 +104,14 @@ func NewUserMapper(idAttribute string) *UserMapper {
 	}
 }
 
+// NewUserMapperWithAttributeMapping creates a [UserMapper], which maps the `RawInfo` into the attributes
+// of the user using the expressions of the mapping.
+func NewUserMapperWithAttributeMapping(idAttribute string, attributeMapping *AttributeMapping) *UserMapper {
+	mapper := NewUserMapper(idAttribute)
+	mapper.attributeMapping = attributeMapping
+	return mapper
+}
+
 func (u *UserMapper) UnmarshalJSON(data []byte) error {
 	return json.Unmarshal(data, &u.RawInfo)
 }
@@ -51,42 +136,43 @@ func (u *UserMapper) GetID() string {
 
 // GetFirstName is an implementation of the [idp.User] interface.
 func (u *UserMapper) GetFirstName() string {
-	return ""
+	return u.attribute(attributeFirstName)
 }
 
 // GetLastName is an implementation of the [idp.User] interface.
 func (u *UserMapper) GetLastName() string {
-	return ""
+	return u.attribute(attributeLastName)
 }
 
 // GetDisplayName is an implementation of the [idp.User] interface.
 func (u *UserMapper) GetDisplayName() string {
-	return ""
+	return u.attribute(attributeDisplayName)
 }
 
 // GetNickname is an implementation of the [idp.User] interface.
 func (u *UserMapper) GetNickname() string {
-	return ""
+	return u.attribute(attributeNickname)
 }
 
 // GetPreferredUsername is an implementation of the [idp.User] interface.
 func (u *UserMapper) GetPreferredUsername() string {
-	return ""
+	return u.attribute(attributePreferredUsername)
 }
 
 // GetEmail is an implementation of the [idp.User] interface.
 func (u *UserMapper) GetEmail() domain.EmailAddress {
-	return ""
+	return domain.EmailAddress(u.attribute(attributeEmail))
 }
 
 // IsEmailVerified is an implementation of the [idp.User] interface.
 func (u *UserMapper) IsEmailVerified() bool {
-	return false
+	verified, _ := strconv.ParseBool(u.attribute(attributeEmailVerified))
+	return verified
 }
 
 // GetPhone is an implementation of the [idp.User] interface.
 func (u *UserMapper) GetPhone() domain.PhoneNumber {
-	return ""
+	return domain.PhoneNumber(u.attribute(attributePhone))
 }
 
 // IsPhoneVerified is an implementation of the [idp.User] interface.
@@ -96,15 +182,66 @@ func (u *UserMapper) IsPhoneVerified() bool {
 
 // GetPreferredLanguage is an implementation of the [idp.User] interface.
 func (u *UserMapper) GetPreferredLanguage() language.Tag {
-	return language.Und
+	lang := u.attribute(attributePreferredLanguage)
+	if lang == "" {
+		return language.Und
+	}
+	return language.Make(lang)
 }
 
 // GetAvatarURL is an implementation of the [idp.User] interface.
 func (u *UserMapper) GetAvatarURL() string {
-	return ""
+	return u.attribute(attributeAvatarURL)
 }
 
 // GetProfile is an implementation of the [idp.User] interface.
 func (u *UserMapper) GetProfile() string {
 	return ""
 }
+
+// GetClaim is an implementation of the [idp.UserClaims] interface.
+func (u *UserMapper) GetClaim(name string) []string {
+	return idp.ClaimValues(u.RawInfo[name])
+}
+
+// GetMetadata is an implementation of the [idp.UserMetadata] interface.
+// It returns the values of the metadata expressions of the [AttributeMapping], missing values are omitted.
+func (u *UserMapper) GetMetadata() map[string]string {
+	if u.attributeMapping == nil || len(u.attributeMapping.metadata) == 0 {
+		return nil
+	}
+	metadata := make(map[string]string, len(u.attributeMapping.metadata))
+	for key, expression := range u.attributeMapping.metadata {
+		if value := valueToString(expression.Evaluate(u.RawInfo)); value != "" {
+			metadata[key] = value
+		}
+	}
+	return metadata
+}
+
+func (u *UserMapper) attribute(attribute string) string {
+	return valueToString(u.attributeMapping.evaluate(attribute, u.RawInfo))
+}
+
+// valueToString converts a value of the user information into a string,
+// objects and arrays are returned as JSON.
+func valueToString(value interface{}) string {
+	switch v := value.(type) {
+	case nil:
+		return ""
+	case string:
+		return v
+	case bool:
+		return strconv.FormatBool(v)
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64)
+	case int:
+		return strconv.Itoa(v)
+	default:
+		data, err := json.Marshal(v)
+		if err != nil {
+			return fmt.Sprint(v)
+		}
+		return string(data)
+	}
+}
@@ -7,10 +7,14 @@ import (
 	"github.com/zitadel/oidc/v3/pkg/oidc"
 	"golang.org/x/oauth2"
 
+	"github.com/zitadel/zitadel/internal/domain"
 	"github.com/zitadel/zitadel/internal/idp"
 )
 
-var _ idp.Provider = (*Provider)(nil)
+var (
+	_ idp.Provider      = (*Provider)(nil)
+	_ idp.HealthChecker = (*Provider)(nil)
+)
 
 // Provider is the [idp.Provider] implementation for a generic OAuth 2.0 provider
 type Provider struct {
@@ -128,3 +132,9 @@ func (p *Provider) IsAutoCreation() bool {
 func (p *Provider) IsAutoUpdate() bool {
 	return p.isAutoUpdate
 }
+
+// CheckHealth implements the [idp.HealthChecker] interface.
+// It checks the reachability of the token endpoint.
+func (p *Provider) CheckHealth(ctx context.Context) []*domain.IDPHealthCheck {
+	return idp.CheckTokenEndpoint(ctx, p.HttpClient(), p.OAuthConfig().Endpoint.TokenURL)
+}
@@ -39,18 +39,24 @@ func (s *Session) FetchUser(ctx context.Context) (user idp.User, err error) {
 			return nil, err
 		}
 	}
-	req, err := http.NewRequest("GET", s.Provider.userEndpoint, nil)
-	if err != nil {
-		return nil, err
-	}
-	req.Header.Set("authorization", s.Tokens.TokenType+" "+s.Tokens.AccessToken)
 	mapper := s.Provider.userMapper()
-	if err := httphelper.HttpRequest(s.Provider.RelyingParty.HttpClient(), req, &mapper); err != nil {
+	if err := FetchUserInfo(ctx, s.Provider.RelyingParty.HttpClient(), s.Provider.userEndpoint, s.Tokens.TokenType, s.Tokens.AccessToken, &mapper); err != nil {
 		return nil, err
 	}
 	return mapper, nil
 }
 
+// FetchUserInfo calls the userEndpoint with the access token and unmarshals the received information into the user,
+// e.g. a [UserMapper].
+func FetchUserInfo(ctx context.Context, client *http.Client, userEndpoint, tokenType, accessToken string, user interface{}) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, userEndpoint, nil)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("authorization", tokenType+" "+accessToken)
+	return httphelper.HttpRequest(client, req, user)
+}
+
 func (s *Session) authorize(ctx context.Context) (err error) {
 	if s.Code == "" {
 		return ErrCodeMissing
@@ -2,15 +2,20 @@ package oidc
 
 import (
 	"context"
+	"strings"
 
 	"github.com/zitadel/oidc/v3/pkg/client/rp"
 	"github.com/zitadel/oidc/v3/pkg/oidc"
 	"golang.org/x/oauth2"
 
+	"github.com/zitadel/zitadel/internal/domain"
 	"github.com/zitadel/zitadel/internal/idp"
 )
 
-var _ idp.Provider = (*Provider)(nil)
+var (
+	_ idp.Provider      = (*Provider)(nil)
+	_ idp.HealthChecker = (*Provider)(nil)
+)
 
 // Provider is the [idp.Provider] implementation for a generic OIDC provider
 type Provider struct {
@@ -179,3 +184,11 @@ func (p *Provider) IsAutoCreation() bool {
 func (p *Provider) IsAutoUpdate() bool {
 	return p.isAutoUpdate
 }
+
+// CheckHealth implements the [idp.HealthChecker] interface.
+// It checks the discovery endpoint of the issuer and the reachability of the token endpoint.
+func (p *Provider) CheckHealth(ctx context.Context) []*domain.IDPHealthCheck {
+	discoveryEndpoint := strings.TrimSuffix(p.Issuer(), "/") + oidc.DiscoveryEndpoint
+	checks := idp.CheckMetadata(ctx, p.HttpClient(), discoveryEndpoint)
+	return append(checks, idp.CheckTokenEndpoint(ctx, p.HttpClient(), p.OAuthConfig().Endpoint.TokenURL)...)
+}
@@ -87,3 +87,9 @@ func (u *UserMapper) GetAvatarURL() string {
 func (u *UserMapper) GetProfile() string {
 	return ""
 }
+
+// GetClaim is an implementation of the [idp.UserClaims] interface.
+// It returns the values of the attribute.
+func (u *UserMapper) GetClaim(name string) []string {
+	return u.Attributes[name]
+}
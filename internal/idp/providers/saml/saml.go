@@ -3,10 +3,15 @@ package saml
 import (
 	"context"
 	"crypto/rsa"
+	"crypto/sha256"
 	"crypto/tls"
 	"crypto/x509"
+	"encoding/base64"
+	"encoding/hex"
 	"encoding/xml"
 	"net/url"
+	"slices"
+	"strings"
 
 	"github.com/crewjam/saml"
 	"github.com/crewjam/saml/samlsp"
@@ -16,7 +21,10 @@ import (
 	"github.com/zitadel/zitadel/internal/zerrors"
 )
 
-var _ idp.Provider = (*Provider)(nil)
+var (
+	_ idp.Provider      = (*Provider)(nil)
+	_ idp.HealthChecker = (*Provider)(nil)
+)
 
 // Provider is the [idp.Provider] implementation for a generic SAML provider
 type Provider struct {
@@ -195,10 +203,61 @@ func (p *Provider) BeginAuth(ctx context.Context, state string, _ ...idp.Paramet
 	}, nil
 }
 
+// LogoutURL returns the URL of the single logout service of the IdP for the user with the passed nameID.
+// An error is returned if the IdP does not provide a single logout service with redirect binding.
+func (p *Provider) LogoutURL(nameID string) (*url.URL, error) {
+	m, err := p.GetSP()
+	if err != nil {
+		return nil, err
+	}
+	if m.ServiceProvider.GetSLOBindingLocation(saml.HTTPRedirectBinding) == "" {
+		return nil, zerrors.ThrowPreconditionFailed(nil, "SAML-Lo4xq", "Errors.Intent.IDPInvalid")
+	}
+	logoutURL, err := m.ServiceProvider.MakeRedirectLogoutRequest(nameID, "")
+	if err != nil {
+		return nil, zerrors.ThrowInternal(err, "SAML-Lo7vd", "Errors.Intent.IDPInvalid")
+	}
+	return logoutURL, nil
+}
+
 func (p *Provider) TransientMappingAttributeName() string {
 	return p.transientMappingAttributeName
 }
 
+// CheckHealth implements the [idp.HealthChecker] interface.
+// It checks the expiration of the certificates of the identity provider (from the metadata)
+// and of the service provider certificate used to sign the requests.
+func (p *Provider) CheckHealth(_ context.Context) []*domain.IDPHealthCheck {
+	checks := make([]*domain.IDPHealthCheck, 0)
+	// the same certificate is often used for signing and encryption
+	checked := make(map[string]struct{})
+	for _, descriptor := range p.spOptions.IDPMetadata.IDPSSODescriptors {
+		for _, keyDescriptor := range descriptor.KeyDescriptors {
+			for _, certificate := range keyDescriptor.KeyInfo.X509Data.X509Certificates {
+				data := strings.Join(strings.Fields(certificate.Data), "")
+				if _, ok := checked[data]; ok {
+					continue
+				}
+				checked[data] = struct{}{}
+				checks = append(checks, checkMetadataCertificate(data))
+			}
+		}
+	}
+	return append(checks, idp.CheckCertificate(p.spOptions.Certificate))
+}
+
+func checkMetadataCertificate(data string) *domain.IDPHealthCheck {
+	der, err := base64.StdEncoding.DecodeString(data)
+	if err != nil {
+		return &domain.IDPHealthCheck{Type: domain.IDPHealthCheckTypeCertificate, Error: err.Error()}
+	}
+	certificate, err := x509.ParseCertificate(der)
+	if err != nil {
+		return &domain.IDPHealthCheck{Type: domain.IDPHealthCheckTypeCertificate, Error: err.Error()}
+	}
+	return idp.CheckCertificate(certificate)
+}
+
 func nameIDFormatFromDomain(format domain.SAMLNameIDFormat) saml.NameIDFormat {
 	switch format {
 	case domain.SAMLNameIDFormatUnspecified:
@@ -213,3 +272,43 @@ func nameIDFormatFromDomain(format domain.SAMLNameIDFormat) saml.NameIDFormat {
 		return saml.UnspecifiedNameIDFormat
 	}
 }
+
+// SigningCertificates returns the certificates the identity provider uses to sign its responses
+// according to the metadata. Certificates of key descriptors without use are used for signing and encryption.
+func SigningCertificates(metadata []byte) ([]*domain.SAMLSigningCertificate, error) {
+	entityDescriptor := new(saml.EntityDescriptor)
+	if err := xml.Unmarshal(metadata, entityDescriptor); err != nil {
+		return nil, err
+	}
+	certificates := make([]*domain.SAMLSigningCertificate, 0)
+	for _, descriptor := range entityDescriptor.IDPSSODescriptors {
+		for _, keyDescriptor := range descriptor.KeyDescriptors {
+			if keyDescriptor.Use != "" && keyDescriptor.Use != "signing" {
+				continue
+			}
+			for _, data := range keyDescriptor.KeyInfo.X509Data.X509Certificates {
+				der, err := base64.StdEncoding.DecodeString(strings.Join(strings.Fields(data.Data), ""))
+				if err != nil {
+					return nil, err
+				}
+				certificate, err := x509.ParseCertificate(der)
+				if err != nil {
+					return nil, err
+				}
+				fingerprint := sha256.Sum256(certificate.Raw)
+				signingCertificate := &domain.SAMLSigningCertificate{
+					Fingerprint: hex.EncodeToString(fingerprint[:]),
+					Subject:     certificate.Subject.String(),
+					NotAfter:    certificate.NotAfter,
+				}
+				if slices.ContainsFunc(certificates, func(c *domain.SAMLSigningCertificate) bool {
+					return c.Fingerprint == signingCertificate.Fingerprint
+				}) {
+					continue
+				}
+				certificates = append(certificates, signingCertificate)
+			}
+		}
+	}
+	return certificates, nil
+}
@@ -1,7 +1,16 @@
 package saml
 
 import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/base64"
+	"math/big"
 	"testing"
+	"time"
 
 	"github.com/crewjam/saml"
 	"github.com/crewjam/saml/samlsp"
@@ -170,3 +179,69 @@ func TestProvider_Options(t *testing.T) {
 		})
 	}
 }
+
+func TestProvider_CheckHealth(t *testing.T) {
+	idpCertificate := testCertificate(t, "idp", time.Date(2030, 1, 1, 0, 0, 0, 0, time.UTC))
+	spCertificate := testCertificate(t, "sp", time.Date(2031, 1, 1, 0, 0, 0, 0, time.UTC))
+	keyDescriptor := func(use, data string) saml.KeyDescriptor {
+		return saml.KeyDescriptor{
+			Use: use,
+			KeyInfo: saml.KeyInfo{
+				X509Data: saml.X509Data{
+					X509Certificates: []saml.X509Certificate{{Data: data}},
+				},
+			},
+		}
+	}
+	encoded := base64.StdEncoding.EncodeToString(idpCertificate.Raw)
+	provider := &Provider{
+		spOptions: &samlsp.Options{
+			Certificate: spCertificate,
+			IDPMetadata: &saml.EntityDescriptor{
+				IDPSSODescriptors: []saml.IDPSSODescriptor{{
+					SSODescriptor: saml.SSODescriptor{
+						RoleDescriptor: saml.RoleDescriptor{
+							KeyDescriptors: []saml.KeyDescriptor{
+								keyDescriptor("signing", encoded),
+								// same certificate with line breaks
+								keyDescriptor("encryption", encoded[:10]+"\n  "+encoded[10:]),
+								keyDescriptor("signing", "invalid"),
+							},
+						},
+					},
+				}},
+			},
+		},
+	}
+
+	checks := provider.CheckHealth(context.Background())
+	require.Len(t, checks, 3)
+	assert.Equal(t, &domain.IDPHealthCheck{
+		Type:           domain.IDPHealthCheckTypeCertificate,
+		Target:         "CN=idp",
+		ExpirationDate: idpCertificate.NotAfter,
+	}, checks[0])
+	assert.Equal(t, domain.IDPHealthCheckTypeCertificate, checks[1].Type)
+	assert.NotEmpty(t, checks[1].Error)
+	assert.Equal(t, &domain.IDPHealthCheck{
+		Type:           domain.IDPHealthCheckTypeCertificate,
+		Target:         "CN=sp",
+		ExpirationDate: spCertificate.NotAfter,
+	}, checks[2])
+}
+
+func testCertificate(t *testing.T, commonName string, notAfter time.Time) *x509.Certificate {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: commonName},
+		NotBefore:    time.Now(),
+		NotAfter:     notAfter,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	require.NoError(t, err)
+	certificate, err := x509.ParseCertificate(der)
+	require.NoError(t, err)
+	return certificate
+}
@@ -0,0 +1,46 @@
+package idphealth
+
+import (
+	"context"
+	"time"
+
+	"github.com/zitadel/zitadel/internal/command"
+	"github.com/zitadel/zitadel/internal/database"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/query"
+	"github.com/zitadel/zitadel/internal/scheduledjob"
+)
+
+const lockName = "idp_health_check"
+
+type job struct {
+	commands *command.Commands
+	queries  *query.Queries
+}
+
+// Start checks the active identity providers of all instances on every interval until the context is done.
+// Failing identity providers are flagged and the owners of the organization or instance are notified.
+// An interval of 0 disables the check.
+func Start(ctx context.Context, interval time.Duration, commands *command.Commands, queries *query.Queries, client *database.DB) {
+	j := &job{
+		commands: commands,
+		queries:  queries,
+	}
+	scheduledjob.Start(ctx, lockName, interval, queries, client, j.check)
+}
+
+// check runs the health check of the active identity providers of the instance.
+func (j *job) check(ctx context.Context) error {
+	idps, err := j.queries.IDPTemplates(ctx, &query.IDPTemplateSearchQueries{}, false)
+	if err != nil {
+		return err
+	}
+	ids := make([]string, 0, len(idps.Templates))
+	for _, idp := range idps.Templates {
+		if idp.State != domain.IDPStateActive {
+			continue
+		}
+		ids = append(ids, idp.ID)
+	}
+	return j.commands.CheckIDPsHealth(ctx, ids)
+}
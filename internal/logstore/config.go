@@ -1,8 +1,11 @@
 package logstore
 
+import "time"
+
 type Configs struct {
 	Access    *Config
 	Execution *Config
+	AuthZ     *AuthZConfig
 }
 
 type Config struct {
@@ -12,3 +15,14 @@ type Config struct {
 type StdConfig struct {
 	Enabled bool
 }
+
+type AuthZConfig struct {
+	Stdout   *StdConfig
+	Database *DatabaseConfig
+}
+
+type DatabaseConfig struct {
+	EmitterConfig
+	// Keep is the duration the records are stored, 0 stores them forever
+	Keep time.Duration
+}
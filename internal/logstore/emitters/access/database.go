@@ -9,6 +9,7 @@ import (
 	"github.com/zitadel/zitadel/internal/api/authz"
 	"github.com/zitadel/zitadel/internal/command"
 	"github.com/zitadel/zitadel/internal/database"
+	"github.com/zitadel/zitadel/internal/domain"
 	"github.com/zitadel/zitadel/internal/logstore"
 	"github.com/zitadel/zitadel/internal/logstore/record"
 	"github.com/zitadel/zitadel/internal/query"
@@ -51,6 +52,7 @@ func (l *databaseLogStorage) incrementUsage(ctx context.Context, bulk []*record.
 		}
 	}
 	for instanceID, instanceBulk := range byInstance {
+		err = errors.Join(err, projection.InstanceUsageProjection.IncrementUsage(ctx, domain.UsageUnitRequests, instanceID, domain.UsagePeriodStart(time.Now()), countAuthenticated(instanceBulk)))
 		q, getQuotaErr := l.queries.GetQuota(ctx, instanceID, quota.RequestsAllAuthenticated)
 		if errors.Is(getQuotaErr, sql.ErrNoRows) {
 			continue
@@ -83,11 +85,14 @@ func (l *databaseLogStorage) incrementUsageFromAccessLogs(ctx context.Context, i
 	ctx, span := tracing.NewSpan(ctx)
 	defer func() { span.EndWithError(err) }()
 
-	var count uint64
+	return projection.QuotaProjection.IncrementUsage(ctx, quota.RequestsAllAuthenticated, instanceID, periodStart, countAuthenticated(records))
+}
+
+func countAuthenticated(records []*record.AccessLog) (count uint64) {
 	for _, r := range records {
 		if r.IsAuthenticated() {
 			count++
 		}
 	}
-	return projection.QuotaProjection.IncrementUsage(ctx, quota.RequestsAllAuthenticated, instanceID, periodStart, count)
+	return count
 }
@@ -0,0 +1,146 @@
+package decision
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/zitadel/logging"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/database"
+	"github.com/zitadel/zitadel/internal/id"
+	"github.com/zitadel/zitadel/internal/logstore"
+	"github.com/zitadel/zitadel/internal/logstore/record"
+	"github.com/zitadel/zitadel/internal/query"
+	"github.com/zitadel/zitadel/internal/scheduledjob"
+	"github.com/zitadel/zitadel/internal/telemetry/tracing"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+const (
+	cleanupLockName = "authz_decisions_cleanup"
+	// cleanupInterval is the duration between two removals of expired decisions
+	cleanupInterval = time.Hour
+	// cleanupBatchSize limits the decisions removed by a single statement
+	cleanupBatchSize = 1000
+)
+
+// deleteExpiredStmt deletes a batch of decisions of an instance checked before the given date
+var deleteExpiredStmt = fmt.Sprintf(`DELETE FROM %[1]s WHERE (%[2]s, %[3]s) IN (
+	SELECT %[2]s, %[3]s FROM %[1]s WHERE %[2]s = $1 AND %[4]s < $2 LIMIT $3
+)`,
+	record.AuthZDecisionTable,
+	record.AuthZDecisionColumnInstanceID,
+	record.AuthZDecisionColumnID,
+	record.AuthZDecisionColumnCheckedAt,
+)
+
+var _ logstore.LogCleanupper[*record.AuthZDecision] = (*databaseLogStorage)(nil)
+
+type databaseLogStorage struct {
+	dbClient *database.DB
+	keep     time.Duration
+}
+
+// NewDatabaseLogStorage stores the authorization decisions in the logstore.authz_decisions table.
+// Decisions older than keep are removed by StartCleanup, a keep of 0 stores them forever.
+func NewDatabaseLogStorage(dbClient *database.DB, keep time.Duration) *databaseLogStorage {
+	return &databaseLogStorage{dbClient: dbClient, keep: keep}
+}
+
+func (l *databaseLogStorage) Emit(ctx context.Context, bulk []*record.AuthZDecision) (err error) {
+	if len(bulk) == 0 {
+		return nil
+	}
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	stmt := sq.Insert(record.AuthZDecisionTable).
+		Columns(
+			record.AuthZDecisionColumnInstanceID,
+			record.AuthZDecisionColumnID,
+			record.AuthZDecisionColumnCheckedAt,
+			record.AuthZDecisionColumnCallerID,
+			record.AuthZDecisionColumnCallerOrgID,
+			record.AuthZDecisionColumnOrgID,
+			record.AuthZDecisionColumnMethod,
+			record.AuthZDecisionColumnPermission,
+			record.AuthZDecisionColumnResourceID,
+			record.AuthZDecisionColumnAllowed,
+			record.AuthZDecisionColumnMatchedPermission,
+			record.AuthZDecisionColumnErrorID,
+		).
+		PlaceholderFormat(sq.Dollar)
+	for _, d := range bulk {
+		decisionID, err := id.SonyFlakeGenerator().Next()
+		if err != nil {
+			return err
+		}
+		stmt = stmt.Values(
+			d.InstanceID,
+			decisionID,
+			d.LogDate,
+			d.CallerID,
+			d.CallerOrgID,
+			d.OrgID,
+			d.Method,
+			d.Permission,
+			d.ResourceID,
+			d.Allowed,
+			d.MatchedPermission,
+			d.ErrorID,
+		)
+	}
+	query, args, err := stmt.ToSql()
+	if err != nil {
+		return zerrors.ThrowInternal(err, "DECIS-k2Rw8", "Errors.Internal")
+	}
+	if _, err = l.dbClient.ExecContext(ctx, query, args...); err != nil {
+		return zerrors.ThrowInternal(err, "DECIS-Hs7vK", "Errors.Internal")
+	}
+	return nil
+}
+
+// StartCleanup removes the expired decisions of all instances on every cleanup interval
+// until the context is done. A keep of 0 disables the cleanup.
+func (l *databaseLogStorage) StartCleanup(ctx context.Context, queries *query.Queries) {
+	if l.keep <= 0 {
+		return
+	}
+	scheduledjob.Start(ctx, cleanupLockName, cleanupInterval, queries, l.dbClient, func(ctx context.Context) error {
+		return l.Cleanup(ctx, l.keep)
+	})
+}
+
+// Cleanup removes the decisions of the instance of the context older than keep.
+// The decisions are deleted in batches, so a large backlog doesn't hold locks on the whole table.
+func (l *databaseLogStorage) Cleanup(ctx context.Context, keep time.Duration) error {
+	if keep <= 0 {
+		return nil
+	}
+	instanceID := authz.GetInstance(ctx).InstanceID()
+	expiredBefore := time.Now().Add(-keep)
+	var total int64
+	for {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		result, err := l.dbClient.ExecContext(ctx, deleteExpiredStmt, instanceID, expiredBefore, cleanupBatchSize)
+		if err != nil {
+			return zerrors.ThrowInternal(err, "DECIS-Zr5mB", "Errors.Internal")
+		}
+		affected, err := result.RowsAffected()
+		if err != nil {
+			return zerrors.ThrowInternal(err, "DECIS-Pq3nW", "Errors.Internal")
+		}
+		total += affected
+		if affected < cleanupBatchSize {
+			if total > 0 {
+				logging.WithFields("instance", instanceID, "removed", total).Info("expired authorization decisions removed")
+			}
+			return nil
+		}
+	}
+}
@@ -10,6 +10,7 @@ import (
 	"github.com/zitadel/zitadel/internal/api/authz"
 	"github.com/zitadel/zitadel/internal/command"
 	"github.com/zitadel/zitadel/internal/database"
+	"github.com/zitadel/zitadel/internal/domain"
 	"github.com/zitadel/zitadel/internal/logstore"
 	"github.com/zitadel/zitadel/internal/logstore/record"
 	"github.com/zitadel/zitadel/internal/query"
@@ -48,6 +49,7 @@ func (l *databaseLogStorage) incrementUsage(ctx context.Context, bulk []*record.
 		}
 	}
 	for instanceID, instanceBulk := range byInstance {
+		err = errors.Join(err, projection.InstanceUsageProjection.IncrementUsage(ctx, domain.UsageUnitActionRunSeconds, instanceID, domain.UsagePeriodStart(time.Now()), runSeconds(instanceBulk)))
 		q, getQuotaErr := l.queries.GetQuota(ctx, instanceID, quota.ActionsAllRunsSeconds)
 		if errors.Is(getQuotaErr, sql.ErrNoRows) {
 			continue
@@ -78,9 +80,13 @@ func (l *databaseLogStorage) incrementUsage(ctx context.Context, bulk []*record.
 }
 
 func (l *databaseLogStorage) incrementUsageFromExecutionLogs(ctx context.Context, instanceID string, periodStart time.Time, records []*record.ExecutionLog) (sum uint64, err error) {
+	return projection.QuotaProjection.IncrementUsage(ctx, quota.ActionsAllRunsSeconds, instanceID, periodStart, runSeconds(records))
+}
+
+func runSeconds(records []*record.ExecutionLog) uint64 {
 	var total time.Duration
 	for _, r := range records {
 		total += r.Took
 	}
-	return projection.QuotaProjection.IncrementUsage(ctx, quota.ActionsAllRunsSeconds, instanceID, periodStart, uint64(math.Floor(total.Seconds())))
+	return uint64(math.Floor(total.Seconds()))
 }
@@ -0,0 +1,61 @@
+package record
+
+import (
+	"time"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+)
+
+const (
+	AuthZDecisionTable = "logstore.authz_decisions"
+
+	AuthZDecisionColumnInstanceID        = "instance_id"
+	AuthZDecisionColumnID                = "id"
+	AuthZDecisionColumnCheckedAt         = "checked_at"
+	AuthZDecisionColumnCallerID          = "caller_id"
+	AuthZDecisionColumnCallerOrgID       = "caller_org_id"
+	AuthZDecisionColumnOrgID             = "org_id"
+	AuthZDecisionColumnMethod            = "method"
+	AuthZDecisionColumnPermission        = "permission"
+	AuthZDecisionColumnResourceID        = "resource_id"
+	AuthZDecisionColumnAllowed           = "allowed"
+	AuthZDecisionColumnMatchedPermission = "matched_permission"
+	AuthZDecisionColumnErrorID           = "error_id"
+)
+
+// AuthZDecision is the outcome of a permission check
+type AuthZDecision struct {
+	LogDate           time.Time `json:"logDate"`
+	InstanceID        string    `json:"instanceId"`
+	CallerID          string    `json:"callerId"`
+	CallerOrgID       string    `json:"callerOrgId,omitempty"`
+	OrgID             string    `json:"orgId,omitempty"`
+	Method            string    `json:"method,omitempty"`
+	Permission        string    `json:"permission"`
+	ResourceID        string    `json:"resourceId,omitempty"`
+	Allowed           bool      `json:"allowed"`
+	MatchedPermission string    `json:"matchedPermission,omitempty"`
+	ErrorID           string    `json:"errorId,omitempty"`
+}
+
+func NewAuthZDecision(decision *authz.Decision) *AuthZDecision {
+	return &AuthZDecision{
+		LogDate:           decision.CheckedAt,
+		InstanceID:        decision.InstanceID,
+		CallerID:          decision.CallerID,
+		CallerOrgID:       decision.CallerOrgID,
+		OrgID:             decision.OrgID,
+		Method:            decision.Method,
+		Permission:        decision.Permission,
+		ResourceID:        decision.ResourceID,
+		Allowed:           decision.Allowed,
+		MatchedPermission: decision.MatchedPermission,
+		ErrorID:           decision.ErrorID,
+	}
+}
+
+func (d AuthZDecision) Normalize() *AuthZDecision {
+	d.Method = cutString(d.Method, 200)
+	d.ResourceID = cutString(d.ResourceID, 200)
+	return &d
+}
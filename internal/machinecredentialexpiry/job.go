@@ -0,0 +1,34 @@
+package machinecredentialexpiry
+
+import (
+	"context"
+	"time"
+
+	"github.com/zitadel/zitadel/internal/command"
+	"github.com/zitadel/zitadel/internal/database"
+	"github.com/zitadel/zitadel/internal/query"
+	"github.com/zitadel/zitadel/internal/scheduledjob"
+)
+
+const lockName = "machine_credential_expiry"
+
+type job struct {
+	commands *command.Commands
+	queries  *query.Queries
+}
+
+// Start flags the machine keys and personal access tokens of all instances which expire soon on every interval
+// until the context is done. The owners of the organization are notified about flagged credentials.
+// An interval of 0 disables the check.
+func Start(ctx context.Context, interval time.Duration, commands *command.Commands, queries *query.Queries, client *database.DB) {
+	j := &job{
+		commands: commands,
+		queries:  queries,
+	}
+	scheduledjob.Start(ctx, lockName, interval, queries, client, j.check)
+}
+
+// check flags the expiring machine keys and personal access tokens of the instance.
+func (j *job) check(ctx context.Context) error {
+	return j.commands.FlagExpiringMachineCredentials(ctx)
+}
@@ -2,6 +2,7 @@ package migration
 
 import (
 	"context"
+	"encoding/json"
 
 	"github.com/zitadel/zitadel/internal/api/authz"
 	"github.com/zitadel/zitadel/internal/api/service"
@@ -11,6 +12,7 @@ import (
 
 func init() {
 	eventstore.RegisterFilterEventMapper(SystemAggregate, StartedType, SetupMapper)
+	eventstore.RegisterFilterEventMapper(SystemAggregate, ProgressedType, SetupMapper)
 	eventstore.RegisterFilterEventMapper(SystemAggregate, DoneType, SetupMapper)
 	eventstore.RegisterFilterEventMapper(SystemAggregate, failedType, SetupMapper)
 	eventstore.RegisterFilterEventMapper(SystemAggregate, repeatableDoneType, SetupMapper)
@@ -23,9 +25,11 @@ type SetupStep struct {
 	Name                 string `json:"name"`
 	Error                any    `json:"error,omitempty"`
 	LastRun              any    `json:"lastRun,omitempty"`
+	// Progress is the last stored progress of the migration
+	Progress json.RawMessage `json:"progress,omitempty"`
 }
 
-func setupStartedCmd(ctx context.Context, migration Migration) eventstore.Command {
+func setupStartedCmd(ctx context.Context, migration Migration, progress json.RawMessage) eventstore.Command {
 	ctx = authz.SetCtxData(service.WithService(ctx, "system"), authz.CtxData{UserID: "system", OrgID: "SYSTEM", ResourceOwner: "SYSTEM"})
 	return &SetupStep{
 		BaseEvent: *eventstore.NewBaseEventForPush(
@@ -34,9 +38,27 @@ func setupStartedCmd(ctx context.Context, migration Migration) eventstore.Comman
 			StartedType),
 		migration: migration,
 		Name:      migration.String(),
+		Progress:  progress,
 	}
 }
 
+func setupProgressedCmd(ctx context.Context, migration Migration, progress any) (eventstore.Command, error) {
+	data, err := json.Marshal(progress)
+	if err != nil {
+		return nil, zerrors.ThrowInternal(err, "MIGRA-Zr5vb8Hm3q", "unable to marshal progress")
+	}
+	ctx = authz.SetCtxData(service.WithService(ctx, "system"), authz.CtxData{UserID: "system", OrgID: "SYSTEM", ResourceOwner: "SYSTEM"})
+	return &SetupStep{
+		BaseEvent: *eventstore.NewBaseEventForPush(
+			ctx,
+			eventstore.NewAggregate(ctx, SystemAggregateID, SystemAggregate, "v1"),
+			ProgressedType),
+		migration: migration,
+		Name:      migration.String(),
+		Progress:  data,
+	}, nil
+}
+
 func setupDoneCmd(ctx context.Context, migration Migration, err error) eventstore.Command {
 	ctx = authz.SetCtxData(service.WithService(ctx, "system"), authz.CtxData{UserID: "system", OrgID: "SYSTEM", ResourceOwner: "SYSTEM"})
 	typ := DoneType
@@ -70,6 +92,8 @@ func (s *SetupStep) Payload() interface{} {
 
 func (s *SetupStep) UniqueConstraints() []*eventstore.UniqueConstraint {
 	switch s.Type() {
+	case ProgressedType:
+		return nil
 	case StartedType:
 		return []*eventstore.UniqueConstraint{
 			eventstore.NewAddGlobalUniqueConstraint("migration_started", s.migration.String(), "Errors.Step.Started.AlreadyExists"),
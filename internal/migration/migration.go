@@ -2,6 +2,7 @@ package migration
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"time"
 
@@ -13,6 +14,7 @@ import (
 
 const (
 	StartedType        = eventstore.EventType("system.migration.started")
+	ProgressedType     = eventstore.EventType("system.migration.progressed")
 	DoneType           = eventstore.EventType("system.migration.done")
 	failedType         = eventstore.EventType("system.migration.failed")
 	repeatableDoneType = eventstore.EventType("system.migration.repeatable.done")
@@ -58,13 +60,18 @@ func Migrate(ctx context.Context, es *eventstore.Eventstore, migration Migration
 		return nil
 	}
 
-	startedEvent, err := es.Push(ctx, setupStartedCmd(ctx, migration))
+	progress, err := lastProgress(ctx, es, migration)
+	if err != nil && !continueOnErr(err) {
+		return err
+	}
+
+	startedEvent, err := es.Push(ctx, setupStartedCmd(ctx, migration, progress))
 	if err != nil && !continueOnErr(err) {
 		return err
 	}
 
 	logging.WithFields("name", migration.String()).Info("starting migration")
-	err = migration.Execute(ctx, startedEvent[0])
+	err = migration.Execute(context.WithValue(ctx, progressStorerKey{}, &progressStorer{es: es, migration: migration}), startedEvent[0])
 	logging.WithFields("name", migration.String()).OnError(err).Error("migration failed")
 
 	_, pushErr := es.Push(ctx, setupDoneCmd(ctx, migration, err))
@@ -89,6 +96,85 @@ func LastStuckStep(ctx context.Context, es *eventstore.Eventstore) (*SetupStep,
 	return step.SetupStep, nil
 }
 
+// Steps returns the state of all executed setup steps
+func Steps(ctx context.Context, es *eventstore.Eventstore) ([]*Step, error) {
+	var states StepStates
+	if err := es.FilterToQueryReducer(ctx, &states); err != nil {
+		return nil, err
+	}
+	return states.Steps, nil
+}
+
+// RetryStep marks a stuck step as failed, so the next setup run retries it.
+// Failed steps are retried by the next setup run anyway.
+func RetryStep(ctx context.Context, es *eventstore.Eventstore, name string) error {
+	var states StepStates
+	if err := es.FilterToQueryReducer(ctx, &states); err != nil {
+		return err
+	}
+	step := states.byName(name)
+	if step == nil {
+		return zerrors.ThrowNotFound(nil, "MIGRA-Kd4wz7Yq2n", "Errors.Step.NotFound")
+	}
+	switch step.state {
+	case StepFailed:
+		return nil
+	case StepStarted:
+		return CancelStep(ctx, es, step.SetupStep)
+	case StepDone:
+		return zerrors.ThrowPreconditionFailed(nil, "MIGRA-Ph8vn3Xc6t", "Errors.Step.NotRetryable")
+	}
+	return zerrors.ThrowPreconditionFailed(nil, "MIGRA-Tb2mq9Wr5k", "Errors.Step.NotRetryable")
+}
+
+type progressStorerKey struct{}
+
+type progressStorer struct {
+	es        *eventstore.Eventstore
+	migration Migration
+}
+
+// StoreProgress persists the progress of the migration executed in ctx.
+// If the execution fails, the next execution of the migration receives the last progress in the started event,
+// which can be read using [LastProgress].
+func StoreProgress(ctx context.Context, progress any) error {
+	storer, ok := ctx.Value(progressStorerKey{}).(*progressStorer)
+	if !ok {
+		return zerrors.ThrowInternal(nil, "MIGRA-Vq6ys2Mj8d", "no migration in context")
+	}
+	cmd, err := setupProgressedCmd(ctx, storer.migration, progress)
+	if err != nil {
+		return err
+	}
+	_, err = storer.es.Push(ctx, cmd)
+	return err
+}
+
+// LastProgress unmarshals the progress stored by the previous execution into progress.
+// It returns false if the migration starts from the beginning.
+func LastProgress(startedEvent eventstore.Event, progress any) (bool, error) {
+	step, ok := startedEvent.(*SetupStep)
+	if !ok || len(step.Progress) == 0 {
+		return false, nil
+	}
+	if err := json.Unmarshal(step.Progress, progress); err != nil {
+		return false, zerrors.ThrowInternal(err, "MIGRA-Jw3kt8Nx4p", "unable to unmarshal progress")
+	}
+	return true, nil
+}
+
+func lastProgress(ctx context.Context, es *eventstore.Eventstore, migration Migration) (json.RawMessage, error) {
+	var states StepStates
+	if err := es.FilterToQueryReducer(ctx, &states); err != nil {
+		return nil, err
+	}
+	step := states.byName(migration.String())
+	if step == nil {
+		return nil, nil
+	}
+	return step.Progress, nil
+}
+
 var _ Migration = (*cancelMigration)(nil)
 
 type cancelMigration struct {
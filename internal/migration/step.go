@@ -1,6 +1,10 @@
 package migration
 
-import "github.com/zitadel/zitadel/internal/eventstore"
+import (
+	"encoding/json"
+
+	"github.com/zitadel/zitadel/internal/eventstore"
+)
 
 var _ eventstore.QueryReducer = (*StepStates)(nil)
 
@@ -8,6 +12,12 @@ type Step struct {
 	*SetupStep
 
 	state StepState
+	// Progress is the last stored progress of an unfinished step
+	Progress json.RawMessage
+}
+
+func (s *Step) State() StepState {
+	return s.state
 }
 
 type StepStates struct {
@@ -21,7 +31,7 @@ func (*StepStates) Query() *eventstore.SearchQueryBuilder {
 		AddQuery().
 		AggregateTypes(SystemAggregate).
 		AggregateIDs(SystemAggregateID).
-		EventTypes(StartedType, DoneType, repeatableDoneType, failedType).
+		EventTypes(StartedType, ProgressedType, DoneType, repeatableDoneType, failedType).
 		Builder()
 }
 
@@ -38,10 +48,14 @@ func (s *StepStates) Reduce() error {
 		switch step.EventType {
 		case StartedType:
 			state.state = StepStarted
+		case ProgressedType:
+			state.Progress = step.Progress
 		case DoneType:
 			state.state = StepDone
+			state.Progress = nil
 		case repeatableDoneType:
 			state.state = StepDone
+			state.Progress = nil
 		case failedType:
 			state.state = StepFailed
 		}
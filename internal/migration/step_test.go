@@ -1,6 +1,7 @@
 package migration
 
 import (
+	"encoding/json"
 	"reflect"
 	"testing"
 	"time"
@@ -92,3 +93,74 @@ func TestStepStates_lastByState(t *testing.T) {
 		})
 	}
 }
+
+func TestStepStates_Reduce(t *testing.T) {
+	event := func(typ eventstore.EventType, progress string) eventstore.Event {
+		step := &SetupStep{
+			BaseEvent: eventstore.BaseEvent{
+				EventType: typ,
+				Agg:       &eventstore.Aggregate{ID: SystemAggregateID, Type: SystemAggregate},
+			},
+			Name: "step",
+		}
+		if progress != "" {
+			step.Progress = json.RawMessage(progress)
+		}
+		return step
+	}
+	tests := []struct {
+		name         string
+		events       []eventstore.Event
+		wantState    StepState
+		wantProgress json.RawMessage
+	}{
+		{
+			name:         "progressed",
+			events:       []eventstore.Event{event(StartedType, ""), event(ProgressedType, `{"offset":10}`)},
+			wantState:    StepStarted,
+			wantProgress: json.RawMessage(`{"offset":10}`),
+		},
+		{
+			name:         "failed keeps progress",
+			events:       []eventstore.Event{event(StartedType, ""), event(ProgressedType, `{"offset":10}`), event(failedType, "")},
+			wantState:    StepFailed,
+			wantProgress: json.RawMessage(`{"offset":10}`),
+		},
+		{
+			name:      "done clears progress",
+			events:    []eventstore.Event{event(StartedType, ""), event(ProgressedType, `{"offset":10}`), event(DoneType, "")},
+			wantState: StepDone,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := new(StepStates)
+			s.AppendEvents(tt.events...)
+			if err := s.Reduce(); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			step := s.byName("step")
+			if step.State() != tt.wantState {
+				t.Errorf("state = %v, want %v", step.State(), tt.wantState)
+			}
+			if !reflect.DeepEqual(step.Progress, tt.wantProgress) {
+				t.Errorf("progress = %s, want %s", step.Progress, tt.wantProgress)
+			}
+		})
+	}
+}
+
+func TestLastProgress(t *testing.T) {
+	type progress struct {
+		Offset int `json:"offset"`
+	}
+	got := new(progress)
+	ok, err := LastProgress(&SetupStep{Progress: json.RawMessage(`{"offset":10}`)}, got)
+	if err != nil || !ok || got.Offset != 10 {
+		t.Errorf("LastProgress() = %v, %v, %v", ok, err, got)
+	}
+	ok, err = LastProgress(&SetupStep{}, got)
+	if err != nil || ok {
+		t.Errorf("LastProgress() without progress = %v, %v", ok, err)
+	}
+}
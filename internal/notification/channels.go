@@ -2,16 +2,22 @@ package notification
 
 import (
 	"context"
+	"encoding/json"
+	"time"
 
 	"github.com/zitadel/logging"
 
-	"github.com/zitadel/zitadel/internal/notification/channels/smtp"
-	"github.com/zitadel/zitadel/internal/notification/channels/twilio"
+	"github.com/zitadel/zitadel/internal/command"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/notification/channels/email"
+	"github.com/zitadel/zitadel/internal/notification/channels/sms"
 	"github.com/zitadel/zitadel/internal/notification/channels/webhook"
 	"github.com/zitadel/zitadel/internal/notification/handlers"
 	"github.com/zitadel/zitadel/internal/notification/senders"
 	"github.com/zitadel/zitadel/internal/notification/types"
+	"github.com/zitadel/zitadel/internal/repository/notification"
 	"github.com/zitadel/zitadel/internal/telemetry/metrics"
+	"github.com/zitadel/zitadel/internal/zerrors"
 )
 
 var _ types.ChannelChains = (*channels)(nil)
@@ -25,26 +31,33 @@ type deliveryMetrics struct {
 	email string
 	sms   string
 	json  string
+	push  string
 }
 
 type channels struct {
 	q        *handlers.NotificationQueries
+	commands *command.Commands
+	queue    handlers.NotificationQueueConfig
 	counters counters
 }
 
-func newChannels(q *handlers.NotificationQueries) *channels {
+func newChannels(q *handlers.NotificationQueries, commands *command.Commands, queue handlers.NotificationQueueConfig) *channels {
 	c := &channels{
-		q: q,
+		q:        q,
+		commands: commands,
+		queue:    queue,
 		counters: counters{
 			success: deliveryMetrics{
 				email: "successful_deliveries_email",
 				sms:   "successful_deliveries_sms",
 				json:  "successful_deliveries_json",
+				push:  "successful_deliveries_push",
 			},
 			failed: deliveryMetrics{
 				email: "failed_deliveries_email",
 				sms:   "failed_deliveries_sms",
 				json:  "failed_deliveries_json",
+				push:  "failed_deliveries_push",
 			},
 		},
 	}
@@ -54,6 +67,8 @@ func newChannels(q *handlers.NotificationQueries) *channels {
 	registerCounter(c.counters.failed.sms, "Failed SMS deliveries")
 	registerCounter(c.counters.success.json, "Successfully delivered JSON messages")
 	registerCounter(c.counters.failed.json, "Failed JSON message deliveries")
+	registerCounter(c.counters.success.push, "Successfully delivered push notifications")
+	registerCounter(c.counters.failed.push, "Failed push notification deliveries")
 	return c
 }
 
@@ -62,36 +77,48 @@ func registerCounter(counter, desc string) {
 	logging.WithFields("metric", counter).OnError(err).Panic("unable to register counter")
 }
 
-func (c *channels) Email(ctx context.Context) (*senders.Chain, *smtp.Config, error) {
-	smtpCfg, err := c.q.GetSMTPConfig(ctx)
+func (c *channels) Email(ctx context.Context) (*senders.Chain, *email.Config, error) {
+	emailCfg, err := c.q.GetActiveEmailConfig(ctx)
 	if err != nil {
 		return nil, nil, err
 	}
 	chain, err := senders.EmailChannels(
 		ctx,
-		smtpCfg,
+		emailCfg,
 		c.q.GetFileSystemProvider,
 		c.q.GetLogProvider,
 		c.counters.success.email,
 		c.counters.failed.email,
+		c.deliveryStatus,
 	)
-	return chain, smtpCfg, err
+	return chain, emailCfg, err
 }
 
-func (c *channels) SMS(ctx context.Context) (*senders.Chain, *twilio.Config, error) {
-	twilioCfg, err := c.q.GetTwilioConfig(ctx)
+// SMS returns a chain for every active SMS provider able to deliver to the recipient,
+// in the order they have to be tried
+func (c *channels) SMS(ctx context.Context, recipient string) ([]*senders.SMSChain, error) {
+	smsCfgs, err := c.q.GetActiveSMSConfigs(ctx)
 	if err != nil {
-		return nil, nil, err
+		return nil, err
 	}
-	chain, err := senders.SMSChannels(
-		ctx,
-		twilioCfg,
-		c.q.GetFileSystemProvider,
-		c.q.GetLogProvider,
-		c.counters.success.sms,
-		c.counters.failed.sms,
-	)
-	return chain, twilioCfg, err
+	routed := sms.Route(smsCfgs, recipient)
+	chains := make([]*senders.SMSChain, 0, len(routed))
+	for _, smsCfg := range routed {
+		chain, err := senders.SMSChannels(
+			ctx,
+			smsCfg,
+			c.q.GetFileSystemProvider,
+			c.q.GetLogProvider,
+			c.counters.success.sms,
+			c.counters.failed.sms,
+			c.deliveryStatus,
+		)
+		if err != nil {
+			return nil, err
+		}
+		chains = append(chains, &senders.SMSChain{Chain: chain, Config: smsCfg})
+	}
+	return chains, nil
 }
 
 func (c *channels) Webhook(ctx context.Context, cfg webhook.Config) (*senders.Chain, error) {
@@ -104,3 +131,65 @@ func (c *channels) Webhook(ctx context.Context, cfg webhook.Config) (*senders.Ch
 		c.counters.failed.json,
 	)
 }
+
+// Push returns the chain delivering push notifications to devices of the platform,
+// a precondition error is returned if the platform is not configured
+func (c *channels) Push(ctx context.Context, platform domain.PushPlatform) (*senders.Chain, error) {
+	pushCfg, err := c.q.GetPushConfig(ctx)
+	if err != nil && !zerrors.IsNotFound(err) {
+		return nil, err
+	}
+	if pushCfg == nil || !pushCfg.IsConfigured(platform) {
+		return nil, zerrors.ThrowPreconditionFailed(err, "NOTIF-Pu4dk", "Errors.Push.NotFound")
+	}
+	return senders.PushChannels(
+		ctx,
+		pushCfg,
+		platform,
+		c.q.GetFileSystemProvider,
+		c.q.GetLogProvider,
+		c.counters.success.push,
+		c.counters.failed.push,
+	)
+}
+
+// Enqueue queues the message for the retries of the notification queue worker.
+// If the queue is disabled or the message cannot be queued, the delivery error is returned,
+// so the notification projection retries the delivery.
+func (c *channels) Enqueue(ctx context.Context, message *types.QueuedMessage, deliveryErr error) error {
+	if !c.queue.Enabled {
+		return deliveryErr
+	}
+	data, err := json.Marshal(message)
+	if err != nil {
+		return deliveryErr
+	}
+	id, err := c.commands.QueueNotification(ctx,
+		notification.Queued{
+			Channel:               message.Channel,
+			Recipient:             message.Recipient,
+			TriggeringAggregateID: message.TriggeringAggregateID,
+			TriggeringEventType:   message.TriggeringEventType,
+		},
+		data,
+		deliveryErr.Error(),
+		time.Now().Add(c.queue.Delay(1)),
+	)
+	if err != nil {
+		logging.WithFields("channel", message.Channel).WithError(err).Warn("could not queue notification")
+		return deliveryErr
+	}
+	logging.WithFields("channel", message.Channel, "queued", id).WithError(deliveryErr).Info("notification delivery failed, queued for retry")
+	return nil
+}
+
+// deliveryStatus writes the delivery status of a message sent to a notification provider
+func (c *channels) deliveryStatus(ctx context.Context, messageID string, delivery notification.Delivery, deliveryErr error) {
+	var err error
+	if deliveryErr != nil {
+		err = c.commands.NotificationDeliveryFailed(ctx, messageID, delivery, deliveryErr.Error())
+	} else {
+		err = c.commands.NotificationDelivered(ctx, messageID, delivery)
+	}
+	logging.WithFields("message", messageID, "provider", delivery.ProviderID).OnError(err).Warn("could not write notification delivery status")
+}
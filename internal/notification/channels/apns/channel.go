@@ -0,0 +1,144 @@
+package apns
+
+import (
+	"bytes"
+	"context"
+	"crypto/ecdsa"
+	"crypto/x509"
+	"encoding/json"
+	"encoding/pem"
+	"fmt"
+	"net/http"
+	"net/url"
+	"sync"
+	"time"
+
+	"github.com/go-jose/go-jose/v4"
+	"github.com/go-jose/go-jose/v4/jwt"
+	"github.com/zitadel/logging"
+
+	"github.com/zitadel/zitadel/internal/notification/channels"
+	"github.com/zitadel/zitadel/internal/notification/messages"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+const (
+	productionHost = "https://api.push.apple.com"
+	sandboxHost    = "https://api.sandbox.push.apple.com"
+
+	// Apple rejects provider tokens older than an hour and requests sent with tokens refreshed more than every 20 minutes
+	tokenLifetime = 40 * time.Minute
+)
+
+func InitChannel(ctx context.Context, config Config) (channels.NotificationChannel, error) {
+	signer, err := newSigner(config.KeyID, config.PrivateKey)
+	if err != nil {
+		return nil, err
+	}
+	logging.Debug("successfully initialized apns push channel")
+	// the Go http client negotiates HTTP/2 required by APNs
+	return newChannel(ctx, http.DefaultClient, config.host(), config.BundleID, &providerToken{signer: signer, teamID: config.TeamID}), nil
+}
+
+func newChannel(ctx context.Context, client *http.Client, host, topic string, token *providerToken) channels.NotificationChannel {
+	return channels.HandleMessageFunc(func(message channels.Message) error {
+		pushMsg, ok := message.(*messages.Push)
+		if !ok {
+			return zerrors.ThrowInternal(nil, "APNS-d8Ks2", "message is not Push")
+		}
+		bearer, err := token.get(time.Now())
+		if err != nil {
+			return err
+		}
+		payload, err := json.Marshal(newPayload(pushMsg))
+		if err != nil {
+			return err
+		}
+		requestCtx, cancel := context.WithTimeout(ctx, 5*time.Second)
+		defer cancel()
+		req, err := http.NewRequestWithContext(requestCtx, http.MethodPost, host+"/3/device/"+url.PathEscape(pushMsg.DeviceToken), bytes.NewReader(payload))
+		if err != nil {
+			return err
+		}
+		req.Header.Set("Authorization", "bearer "+bearer)
+		req.Header.Set("Content-Type", "application/json")
+		req.Header.Set("apns-topic", topic)
+		req.Header.Set("apns-push-type", "alert")
+		resp, err := client.Do(req)
+		if err != nil {
+			return zerrors.ThrowInternal(err, "APNS-n4Wq7", "could not send push notification")
+		}
+		defer resp.Body.Close()
+		if resp.StatusCode != http.StatusOK {
+			failure := new(struct {
+				Reason string `json:"reason"`
+			})
+			_ = json.NewDecoder(resp.Body).Decode(failure)
+			return zerrors.ThrowInternal(fmt.Errorf("apns returned %s: %s", resp.Status, failure.Reason), "APNS-x1Bv5", "could not send push notification")
+		}
+		logging.WithFields("apns_id", resp.Header.Get("apns-id")).Debug("push notification sent to apns")
+		return nil
+	})
+}
+
+// newPayload places the custom data next to the aps dictionary, as expected by the app
+func newPayload(msg *messages.Push) map[string]any {
+	payload := make(map[string]any, len(msg.Data)+1)
+	for key, value := range msg.Data {
+		payload[key] = value
+	}
+	payload["aps"] = map[string]any{
+		"alert": map[string]string{
+			"title": msg.Title,
+			"body":  msg.Body,
+		},
+	}
+	return payload
+}
+
+func newSigner(keyID string, privateKey []byte) (jose.Signer, error) {
+	block, _ := pem.Decode(privateKey)
+	if block == nil {
+		return nil, zerrors.ThrowInvalidArgument(nil, "APNS-t6Mz3", "Errors.Push.APNs.PrivateKeyInvalid")
+	}
+	key, err := x509.ParsePKCS8PrivateKey(block.Bytes)
+	if err != nil {
+		return nil, zerrors.ThrowInvalidArgument(err, "APNS-h2Rc9", "Errors.Push.APNs.PrivateKeyInvalid")
+	}
+	ecKey, ok := key.(*ecdsa.PrivateKey)
+	if !ok {
+		return nil, zerrors.ThrowInvalidArgument(nil, "APNS-w5Jy4", "Errors.Push.APNs.PrivateKeyInvalid")
+	}
+	return jose.NewSigner(
+		jose.SigningKey{Algorithm: jose.ES256, Key: ecKey},
+		(&jose.SignerOptions{}).WithHeader("kid", keyID),
+	)
+}
+
+// providerToken is the JWT authenticating the requests to APNs,
+// it's reused until it reaches the tokenLifetime
+type providerToken struct {
+	signer jose.Signer
+	teamID string
+
+	mu       sync.Mutex
+	token    string
+	issuedAt time.Time
+}
+
+func (t *providerToken) get(now time.Time) (string, error) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if t.token != "" && now.Sub(t.issuedAt) < tokenLifetime {
+		return t.token, nil
+	}
+	token, err := jwt.Signed(t.signer).Claims(jwt.Claims{
+		Issuer:   t.teamID,
+		IssuedAt: jwt.NewNumericDate(now),
+	}).Serialize()
+	if err != nil {
+		return "", err
+	}
+	t.token, t.issuedAt = token, now
+	return token, nil
+}
@@ -0,0 +1,118 @@
+package apns
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"encoding/json"
+	"encoding/pem"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/go-jose/go-jose/v4"
+	"github.com/go-jose/go-jose/v4/jwt"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/zitadel/zitadel/internal/notification/messages"
+)
+
+func Test_channel_HandleMessage(t *testing.T) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	der, err := x509.MarshalPKCS8PrivateKey(key)
+	require.NoError(t, err)
+	signer, err := newSigner("key1", pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: der}))
+	require.NoError(t, err)
+
+	tests := []struct {
+		name    string
+		status  int
+		reason  string
+		wantErr string
+	}{
+		{
+			name:   "delivered",
+			status: http.StatusOK,
+		},
+		{
+			name:    "rejected",
+			status:  http.StatusBadRequest,
+			reason:  "BadDeviceToken",
+			wantErr: "BadDeviceToken",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, "/3/device/device-token", r.URL.Path)
+				assert.Equal(t, "com.example.app", r.Header.Get("apns-topic"))
+				assert.Equal(t, "alert", r.Header.Get("apns-push-type"))
+
+				token, err := jwt.ParseSigned(strings.TrimPrefix(r.Header.Get("Authorization"), "bearer "), []jose.SignatureAlgorithm{jose.ES256})
+				require.NoError(t, err)
+				assert.Equal(t, "key1", token.Headers[0].KeyID)
+				claims := new(jwt.Claims)
+				require.NoError(t, token.Claims(&key.PublicKey, claims))
+				assert.Equal(t, "team1", claims.Issuer)
+
+				payload := make(map[string]any)
+				require.NoError(t, json.NewDecoder(r.Body).Decode(&payload))
+				assert.Equal(t, map[string]any{
+					"aps": map[string]any{
+						"alert": map[string]any{
+							"title": "title",
+							"body":  "body",
+						},
+					},
+					"sessionId": "session1",
+				}, payload)
+
+				w.WriteHeader(tt.status)
+				if tt.reason != "" {
+					_ = json.NewEncoder(w).Encode(map[string]string{"reason": tt.reason})
+				}
+			}))
+			defer server.Close()
+
+			channel := newChannel(context.Background(), server.Client(), server.URL, "com.example.app", &providerToken{signer: signer, teamID: "team1"})
+			err := channel.HandleMessage(&messages.Push{
+				DeviceToken: "device-token",
+				Title:       "title",
+				Body:        "body",
+				Data:        map[string]string{"sessionId": "session1"},
+			})
+			if tt.wantErr != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tt.wantErr)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func Test_providerToken_get(t *testing.T) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	der, err := x509.MarshalPKCS8PrivateKey(key)
+	require.NoError(t, err)
+	signer, err := newSigner("key1", pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: der}))
+	require.NoError(t, err)
+
+	now := time.Now()
+	token := &providerToken{signer: signer, teamID: "team1"}
+	first, err := token.get(now)
+	require.NoError(t, err)
+	reused, err := token.get(now.Add(tokenLifetime - time.Minute))
+	require.NoError(t, err)
+	assert.Equal(t, first, reused)
+	renewed, err := token.get(now.Add(tokenLifetime))
+	require.NoError(t, err)
+	assert.NotEqual(t, first, renewed)
+}
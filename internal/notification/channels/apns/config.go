@@ -0,0 +1,22 @@
+package apns
+
+type Config struct {
+	KeyID    string
+	TeamID   string
+	BundleID string
+	// PrivateKey is the PEM encoded (.p8) token signing key
+	PrivateKey []byte
+	// Production sends the notifications through the production environment instead of the sandbox
+	Production bool
+}
+
+func (c *Config) IsValid() bool {
+	return c.KeyID != "" && c.TeamID != "" && c.BundleID != "" && len(c.PrivateKey) > 0
+}
+
+func (c *Config) host() string {
+	if c.Production {
+		return productionHost
+	}
+	return sandboxHost
+}
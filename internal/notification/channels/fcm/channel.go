@@ -0,0 +1,89 @@
+package fcm
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/zitadel/logging"
+	"golang.org/x/oauth2"
+	"golang.org/x/oauth2/google"
+
+	"github.com/zitadel/zitadel/internal/notification/channels"
+	"github.com/zitadel/zitadel/internal/notification/messages"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+const (
+	messagingScope = "https://www.googleapis.com/auth/firebase.messaging"
+	baseURL        = "https://fcm.googleapis.com/v1/projects/"
+)
+
+func InitChannel(ctx context.Context, config Config) (channels.NotificationChannel, error) {
+	credentials, err := google.CredentialsFromJSON(ctx, config.ServiceAccountKey, messagingScope)
+	if err != nil {
+		return nil, zerrors.ThrowInvalidArgument(err, "FCM-k3Jd8", "Errors.Push.FCM.ServiceAccountKeyInvalid")
+	}
+	logging.Debug("successfully initialized fcm push channel")
+	return newChannel(ctx, oauth2.NewClient(ctx, credentials.TokenSource), baseURL+url.PathEscape(config.ProjectID)+"/messages:send"), nil
+}
+
+func newChannel(ctx context.Context, client *http.Client, sendURL string) channels.NotificationChannel {
+	return channels.HandleMessageFunc(func(message channels.Message) error {
+		pushMsg, ok := message.(*messages.Push)
+		if !ok {
+			return zerrors.ThrowInternal(nil, "FCM-s9Lq2", "message is not Push")
+		}
+		payload, err := json.Marshal(&sendRequest{
+			Message: &fcmMessage{
+				Token: pushMsg.DeviceToken,
+				Notification: &notification{
+					Title: pushMsg.Title,
+					Body:  pushMsg.Body,
+				},
+				Data: pushMsg.Data,
+			},
+		})
+		if err != nil {
+			return err
+		}
+		requestCtx, cancel := context.WithTimeout(ctx, 5*time.Second)
+		defer cancel()
+		req, err := http.NewRequestWithContext(requestCtx, http.MethodPost, sendURL, bytes.NewReader(payload))
+		if err != nil {
+			return err
+		}
+		req.Header.Set("Content-Type", "application/json")
+		resp, err := client.Do(req)
+		if err != nil {
+			return zerrors.ThrowInternal(err, "FCM-p2Kd0", "could not send push notification")
+		}
+		defer resp.Body.Close()
+		if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+			body, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+			return zerrors.ThrowInternal(fmt.Errorf("fcm returned %s: %s", resp.Status, body), "FCM-r8Nw1", "could not send push notification")
+		}
+		logging.Debug("push notification sent to fcm")
+		return nil
+	})
+}
+
+type sendRequest struct {
+	Message *fcmMessage `json:"message"`
+}
+
+type fcmMessage struct {
+	Token        string            `json:"token"`
+	Notification *notification     `json:"notification,omitempty"`
+	Data         map[string]string `json:"data,omitempty"`
+}
+
+type notification struct {
+	Title string `json:"title,omitempty"`
+	Body  string `json:"body,omitempty"`
+}
@@ -0,0 +1,11 @@
+package fcm
+
+type Config struct {
+	ProjectID string
+	// ServiceAccountKey is the JSON key of a Google service account allowed to send messages
+	ServiceAccountKey []byte
+}
+
+func (c *Config) IsValid() bool {
+	return c.ProjectID != "" && len(c.ServiceAccountKey) > 0
+}
@@ -0,0 +1,26 @@
+package push
+
+import (
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/notification/channels/apns"
+	"github.com/zitadel/zitadel/internal/notification/channels/fcm"
+)
+
+// Config holds the push notification services of an instance,
+// a service without configuration is nil
+type Config struct {
+	FCMConfig  *fcm.Config
+	APNsConfig *apns.Config
+}
+
+// IsConfigured returns if notifications can be pushed to devices of the platform
+func (c *Config) IsConfigured(platform domain.PushPlatform) bool {
+	switch platform {
+	case domain.PushPlatformFCM:
+		return c.FCMConfig != nil
+	case domain.PushPlatformAPNs:
+		return c.APNsConfig != nil
+	case domain.PushPlatformUnspecified:
+	}
+	return false
+}
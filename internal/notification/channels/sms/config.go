@@ -0,0 +1,54 @@
+package sms
+
+import (
+	"cmp"
+	"slices"
+	"strings"
+
+	"github.com/zitadel/zitadel/internal/notification/channels/twilio"
+	"github.com/zitadel/zitadel/internal/notification/channels/webhook"
+)
+
+// Config is an active SMS provider of an instance,
+// either TwilioConfig or WebhookConfig is set
+type Config struct {
+	ProviderConfig *Provider
+	TwilioConfig   *twilio.Config
+	WebhookConfig  *webhook.Config
+
+	// Priority orders the providers for failover, lower values are tried first
+	Priority uint32
+	// CountryCodes restricts the provider to recipients with one of the prefixes (e.g. +41)
+	CountryCodes []string
+}
+
+type Provider struct {
+	ID          string `json:"id,omitempty"`
+	Description string `json:"description,omitempty"`
+}
+
+// Route returns the configs which are able to deliver to the recipient in the order they have to be tried.
+// Providers restricted to a matching country code come first, followed by the unrestricted providers,
+// each group ordered by priority.
+func Route(configs []*Config, recipient string) []*Config {
+	recipient = strings.ReplaceAll(recipient, " ", "")
+	matching := make([]*Config, 0, len(configs))
+	unrestricted := make([]*Config, 0, len(configs))
+	for _, config := range configs {
+		if len(config.CountryCodes) == 0 {
+			unrestricted = append(unrestricted, config)
+			continue
+		}
+		if slices.ContainsFunc(config.CountryCodes, func(code string) bool {
+			return strings.HasPrefix(recipient, code)
+		}) {
+			matching = append(matching, config)
+		}
+	}
+	byPriority := func(a, b *Config) int {
+		return cmp.Compare(a.Priority, b.Priority)
+	}
+	slices.SortStableFunc(matching, byPriority)
+	slices.SortStableFunc(unrestricted, byPriority)
+	return append(matching, unrestricted...)
+}
@@ -0,0 +1,51 @@
+package sms
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRoute(t *testing.T) {
+	var (
+		fallback = &Config{ProviderConfig: &Provider{ID: "fallback"}, Priority: 2}
+		primary  = &Config{ProviderConfig: &Provider{ID: "primary"}, Priority: 1}
+		swiss    = &Config{ProviderConfig: &Provider{ID: "swiss"}, Priority: 5, CountryCodes: []string{"+41"}}
+		german   = &Config{ProviderConfig: &Provider{ID: "german"}, CountryCodes: []string{"+49"}}
+	)
+	tests := []struct {
+		name      string
+		configs   []*Config
+		recipient string
+		want      []*Config
+	}{
+		{
+			name:      "no configs",
+			recipient: "+41791234567",
+			want:      []*Config{},
+		},
+		{
+			name:      "unrestricted by priority",
+			configs:   []*Config{fallback, primary},
+			recipient: "+41791234567",
+			want:      []*Config{primary, fallback},
+		},
+		{
+			name:      "country code first",
+			configs:   []*Config{fallback, primary, swiss, german},
+			recipient: "+41 79 123 45 67",
+			want:      []*Config{swiss, primary, fallback},
+		},
+		{
+			name:      "no matching country code",
+			configs:   []*Config{swiss, german},
+			recipient: "+12025550123",
+			want:      []*Config{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, Route(tt.configs, tt.recipient))
+		})
+	}
+}
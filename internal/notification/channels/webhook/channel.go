@@ -9,8 +9,10 @@ import (
 
 	"github.com/zitadel/logging"
 
+	"github.com/zitadel/zitadel/internal/execution"
 	"github.com/zitadel/zitadel/internal/notification/channels"
 	"github.com/zitadel/zitadel/internal/notification/messages"
+	"github.com/zitadel/zitadel/internal/telemetry/tracing"
 	"github.com/zitadel/zitadel/internal/zerrors"
 )
 
@@ -19,6 +21,8 @@ func InitChannel(ctx context.Context, cfg Config) (channels.NotificationChannel,
 		return nil, err
 	}
 
+	// the trace context is propagated to the webhook
+	client := &http.Client{Transport: tracing.HTTPTransport(nil)}
 	logging.Debug("successfully initialized webhook json channel")
 	return channels.HandleMessageFunc(func(message channels.Message) error {
 		requestCtx, cancel := context.WithTimeout(ctx, 5*time.Second)
@@ -36,10 +40,13 @@ func InitChannel(ctx context.Context, cfg Config) (channels.NotificationChannel,
 			return err
 		}
 		if cfg.Headers != nil {
-			req.Header = cfg.Headers
+			req.Header = cfg.Headers.Clone()
 		}
 		req.Header.Set("Content-Type", "application/json")
-		resp, err := http.DefaultClient.Do(req)
+		if cfg.SigningKey != "" {
+			req.Header.Set(execution.SigningHeader, execution.ComputeSignatureHeader(time.Now(), []byte(payload), cfg.SigningKey))
+		}
+		resp, err := client.Do(req)
 		if err != nil {
 			return err
 		}
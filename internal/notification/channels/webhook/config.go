@@ -9,6 +9,8 @@ type Config struct {
 	CallURL string
 	Method  string
 	Headers http.Header
+	// SigningKey is used to sign the payload in the ZITADEL-Signature header if set
+	SigningKey string
 }
 
 func (w *Config) Validate() error {
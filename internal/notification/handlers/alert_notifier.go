@@ -0,0 +1,354 @@
+package handlers
+
+import (
+	"context"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/zitadel/logging"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/api/call"
+	"github.com/zitadel/zitadel/internal/crypto"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/eventstore/handler/v2"
+	"github.com/zitadel/zitadel/internal/notification/types"
+	"github.com/zitadel/zitadel/internal/query"
+	"github.com/zitadel/zitadel/internal/repository/instance"
+	"github.com/zitadel/zitadel/internal/repository/org"
+	"github.com/zitadel/zitadel/internal/repository/pseudo"
+	"github.com/zitadel/zitadel/internal/repository/user"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+const (
+	AlertNotifierTable           = "projections.notifications_alerts"
+	ProjectionFailureAlertsTable = "projections.notifications_alerts_projection_failures"
+
+	// ProjectionFailedAlertEventType is the event type of alerts about events a projection failed to handle,
+	// it's not stored in the eventstore but can be used in the event type filters of alert targets
+	ProjectionFailedAlertEventType = "projection.failed"
+)
+
+type alertNotifier struct {
+	queries  *NotificationQueries
+	channels types.ChannelChains
+}
+
+// NewAlertNotifier posts security-relevant events, like added administrators and changed identity providers,
+// to the chat channels configured as alert targets of the instance
+func NewAlertNotifier(
+	ctx context.Context,
+	config handler.Config,
+	queries *NotificationQueries,
+	channels types.ChannelChains,
+) *handler.Handler {
+	return handler.NewHandler(ctx, &config, &alertNotifier{
+		queries:  queries,
+		channels: channels,
+	})
+}
+
+func (*alertNotifier) Name() string {
+	return AlertNotifierTable
+}
+
+func (n *alertNotifier) Reducers() []handler.AggregateReducer {
+	return []handler.AggregateReducer{
+		{
+			Aggregate:     instance.AggregateType,
+			EventReducers: n.eventReducers(instance.MemberAddedEventType, instance.MemberChangedEventType, instanceIDPEventTypes),
+		},
+		{
+			Aggregate:     org.AggregateType,
+			EventReducers: n.eventReducers(org.MemberAddedEventType, org.MemberChangedEventType, orgIDPEventTypes),
+		},
+		{
+			Aggregate: user.AggregateType,
+			EventReducers: []handler.EventReducer{
+				{Event: user.UserBreakGlassUsedType, Reduce: n.reduceAlert},
+			},
+		},
+	}
+}
+
+var (
+	instanceIDPEventTypes = []eventstore.EventType{
+		instance.IDPConfigAddedEventType,
+		instance.IDPConfigChangedEventType,
+		instance.IDPConfigRemovedEventType,
+		instance.IDPConfigDeactivatedEventType,
+		instance.IDPConfigReactivatedEventType,
+		instance.OAuthIDPAddedEventType,
+		instance.OAuthIDPChangedEventType,
+		instance.OIDCIDPAddedEventType,
+		instance.OIDCIDPChangedEventType,
+		instance.JWTIDPAddedEventType,
+		instance.JWTIDPChangedEventType,
+		instance.AzureADIDPAddedEventType,
+		instance.AzureADIDPChangedEventType,
+		instance.GitHubIDPAddedEventType,
+		instance.GitHubIDPChangedEventType,
+		instance.GitHubEnterpriseIDPAddedEventType,
+		instance.GitHubEnterpriseIDPChangedEventType,
+		instance.GitLabIDPAddedEventType,
+		instance.GitLabIDPChangedEventType,
+		instance.GitLabSelfHostedIDPAddedEventType,
+		instance.GitLabSelfHostedIDPChangedEventType,
+		instance.GoogleIDPAddedEventType,
+		instance.GoogleIDPChangedEventType,
+		instance.LDAPIDPAddedEventType,
+		instance.LDAPIDPChangedEventType,
+		instance.AppleIDPAddedEventType,
+		instance.AppleIDPChangedEventType,
+		instance.SAMLIDPAddedEventType,
+		instance.SAMLIDPChangedEventType,
+		instance.IDPRemovedEventType,
+	}
+	orgIDPEventTypes = []eventstore.EventType{
+		org.IDPConfigAddedEventType,
+		org.IDPConfigChangedEventType,
+		org.IDPConfigRemovedEventType,
+		org.IDPConfigDeactivatedEventType,
+		org.IDPConfigReactivatedEventType,
+		org.OAuthIDPAddedEventType,
+		org.OAuthIDPChangedEventType,
+		org.OIDCIDPAddedEventType,
+		org.OIDCIDPChangedEventType,
+		org.JWTIDPAddedEventType,
+		org.JWTIDPChangedEventType,
+		org.AzureADIDPAddedEventType,
+		org.AzureADIDPChangedEventType,
+		org.GitHubIDPAddedEventType,
+		org.GitHubIDPChangedEventType,
+		org.GitHubEnterpriseIDPAddedEventType,
+		org.GitHubEnterpriseIDPChangedEventType,
+		org.GitLabIDPAddedEventType,
+		org.GitLabIDPChangedEventType,
+		org.GitLabSelfHostedIDPAddedEventType,
+		org.GitLabSelfHostedIDPChangedEventType,
+		org.GoogleIDPAddedEventType,
+		org.GoogleIDPChangedEventType,
+		org.LDAPIDPAddedEventType,
+		org.LDAPIDPChangedEventType,
+		org.AppleIDPAddedEventType,
+		org.AppleIDPChangedEventType,
+		org.SAMLIDPAddedEventType,
+		org.SAMLIDPChangedEventType,
+		org.IDPRemovedEventType,
+	}
+)
+
+func (n *alertNotifier) eventReducers(memberAdded, memberChanged eventstore.EventType, idpEventTypes []eventstore.EventType) []handler.EventReducer {
+	reducers := make([]handler.EventReducer, 0, len(idpEventTypes)+2)
+	reducers = append(reducers,
+		handler.EventReducer{Event: memberAdded, Reduce: n.reduceAlert},
+		handler.EventReducer{Event: memberChanged, Reduce: n.reduceAlert},
+	)
+	for _, eventType := range idpEventTypes {
+		reducers = append(reducers, handler.EventReducer{Event: eventType, Reduce: n.reduceAlert})
+	}
+	return reducers
+}
+
+func (n *alertNotifier) reduceAlert(event eventstore.Event) (*handler.Statement, error) {
+	alert := alertFromEvent(event)
+	if alert == nil {
+		return handler.NewNoOpStatement(event), nil
+	}
+	return handler.NewStatement(event, func(ex handler.Executer, projectionName string) error {
+		return sendAlert(HandlerContext(event), n.queries, n.channels, alert, event)
+	}), nil
+}
+
+// alertFromEvent describes the event for the administrators, nil is returned if the event is not alerted
+func alertFromEvent(event eventstore.Event) *types.Alert {
+	facts := []types.AlertFact{
+		{Name: "Instance", Value: event.Aggregate().InstanceID},
+		{Name: "Resource Owner", Value: event.Aggregate().ResourceOwner},
+		{Name: "Editor", Value: event.Creator()},
+	}
+	switch e := event.(type) {
+	case *instance.MemberAddedEvent:
+		return &types.Alert{
+			EventType: string(e.Type()),
+			Title:     "Instance administrator added",
+			Text:      "User " + e.UserID + " was granted the roles " + strings.Join(e.Roles, ", ") + " on the instance.",
+			Facts:     facts,
+		}
+	case *instance.MemberChangedEvent:
+		return &types.Alert{
+			EventType: string(e.Type()),
+			Title:     "Instance administrator changed",
+			Text:      "The roles of user " + e.UserID + " on the instance were changed to " + strings.Join(e.Roles, ", ") + ".",
+			Facts:     facts,
+		}
+	case *org.MemberAddedEvent:
+		return &types.Alert{
+			EventType: string(e.Type()),
+			Title:     "Organization administrator added",
+			Text:      "User " + e.UserID + " was granted the roles " + strings.Join(e.Roles, ", ") + " on the organization.",
+			Facts:     facts,
+		}
+	case *org.MemberChangedEvent:
+		return &types.Alert{
+			EventType: string(e.Type()),
+			Title:     "Organization administrator changed",
+			Text:      "The roles of user " + e.UserID + " on the organization were changed to " + strings.Join(e.Roles, ", ") + ".",
+			Facts:     facts,
+		}
+	case *user.UserBreakGlassUsedEvent:
+		return breakGlassAlert(e)
+	}
+	if strings.Contains(string(event.Type()), ".idp.") {
+		return &types.Alert{
+			EventType: string(event.Type()),
+			Title:     "Identity provider configuration changed",
+			Text:      "The identity provider configuration of " + string(event.Aggregate().Type) + " " + event.Aggregate().ID + " was changed (" + string(event.Type()) + ").",
+			Facts:     facts,
+		}
+	}
+	return nil
+}
+
+// breakGlassAlert describes the authentication of a break-glass user,
+// which is alerted with high priority as it's only expected in emergencies
+func breakGlassAlert(e *user.UserBreakGlassUsedEvent) *types.Alert {
+	facts := []types.AlertFact{
+		{Name: "Instance", Value: e.Aggregate().InstanceID},
+		{Name: "Resource Owner", Value: e.Aggregate().ResourceOwner},
+		{Name: "User", Value: e.Aggregate().ID},
+	}
+	method := "a client secret"
+	if e.Method == domain.BreakGlassAuthMethodSecurityKey {
+		method = "password and security key"
+	}
+	if e.AuthRequestInfo != nil && e.AuthRequestInfo.BrowserInfo != nil {
+		if e.AuthRequestInfo.RemoteIP != nil {
+			facts = append(facts, types.AlertFact{Name: "IP", Value: e.AuthRequestInfo.RemoteIP.String()})
+		}
+		if e.AuthRequestInfo.UserAgent != "" {
+			facts = append(facts, types.AlertFact{Name: "User Agent", Value: e.AuthRequestInfo.UserAgent})
+		}
+	}
+	return &types.Alert{
+		EventType:    string(e.Type()),
+		Title:        "Break-glass account used",
+		Text:         "The break-glass user " + e.Aggregate().ID + " authenticated with " + method + ".",
+		Facts:        facts,
+		HighPriority: true,
+	}
+}
+
+// sendAlert posts the alert to all active alert targets of the instance, whose filters match the alert.
+// Failed deliveries are logged and not retried, so targets which received the alert don't get it twice.
+func sendAlert(ctx context.Context, queries *NotificationQueries, channels types.ChannelChains, alert *types.Alert, event eventstore.Event) error {
+	targets, err := queries.AlertTargets(ctx)
+	if err != nil {
+		return err
+	}
+	for _, target := range targets {
+		if !target.Matches(alert.EventType) {
+			continue
+		}
+		webhookURL, err := crypto.DecryptString(target.WebhookURL, queries.SMSTokenCrypto)
+		if err != nil {
+			logging.WithFields("instance", authz.GetInstance(ctx).InstanceID(), "target", target.ID).WithError(err).Warn("unable to decrypt alert webhook url")
+			continue
+		}
+		err = types.SendAlert(ctx, channels, target.TargetType, webhookURL, alert, event).WithoutTemplate()
+		logging.WithFields("instance", authz.GetInstance(ctx).InstanceID(), "target", target.ID).OnError(err).Warn("posting alert failed")
+	}
+	return nil
+}
+
+type projectionFailureAlerter struct {
+	queries      *NotificationQueries
+	channels     types.ChannelChains
+	requeueEvery time.Duration
+}
+
+// NewProjectionFailureAlerter periodically looks up the events projections of the instance failed to handle
+// and alerts the administrators about each failure since the last lookup
+func NewProjectionFailureAlerter(
+	ctx context.Context,
+	config handler.Config,
+	queries *NotificationQueries,
+	channels types.ChannelChains,
+) *handler.Handler {
+	alerter := &projectionFailureAlerter{
+		queries:      queries,
+		channels:     channels,
+		requeueEvery: config.RequeueEvery,
+	}
+	config.TriggerWithoutEvents = alerter.alertFailures
+	return handler.NewHandler(ctx, &config, alerter)
+}
+
+func (*projectionFailureAlerter) Name() string {
+	return ProjectionFailureAlertsTable
+}
+
+func (a *projectionFailureAlerter) Reducers() []handler.AggregateReducer {
+	return []handler.AggregateReducer{{
+		Aggregate: pseudo.AggregateType,
+		EventReducers: []handler.EventReducer{{
+			Event:  pseudo.ScheduledEventType,
+			Reduce: a.alertFailures,
+		}},
+	}}
+}
+
+func (a *projectionFailureAlerter) alertFailures(event eventstore.Event) (*handler.Statement, error) {
+	scheduledEvent, ok := event.(*pseudo.ScheduledEvent)
+	if !ok {
+		return nil, zerrors.ThrowInvalidArgumentf(nil, "HANDL-q7rw2zlk8d", "reduce.wrong.event.type %s", event.Type())
+	}
+
+	return handler.NewStatement(event, func(ex handler.Executer, projectionName string) error {
+		ctx := call.WithTimestamp(context.Background())
+		for _, instanceID := range scheduledEvent.InstanceIDs {
+			if err := a.alertInstanceFailures(authz.WithInstanceID(ctx, instanceID), instanceID, scheduledEvent.Timestamp.Add(-a.requeueEvery)); err != nil {
+				return err
+			}
+		}
+		return nil
+	}), nil
+}
+
+func (a *projectionFailureAlerter) alertInstanceFailures(ctx context.Context, instanceID string, since time.Time) error {
+	instanceQuery, err := query.NewFailedEventInstanceIDSearchQuery(instanceID)
+	if err != nil {
+		return err
+	}
+	sinceQuery, err := query.NewTimestampQuery(query.FailedEventsColumnLastFailed, since, query.TimestampGreater)
+	if err != nil {
+		return err
+	}
+	failed, err := a.queries.SearchFailedEvents(ctx, &query.FailedEventSearchQueries{
+		Queries: []query.SearchQuery{instanceQuery, sinceQuery},
+	})
+	if err != nil {
+		return err
+	}
+	for _, failedEvent := range failed.FailedEvents {
+		alert := &types.Alert{
+			EventType: ProjectionFailedAlertEventType,
+			Title:     "Projection failed",
+			Text:      "Projection " + failedEvent.ProjectionName + " failed to handle an event: " + failedEvent.Error,
+			Facts: []types.AlertFact{
+				{Name: "Instance", Value: instanceID},
+				{Name: "Aggregate", Value: failedEvent.AggregateType + " " + failedEvent.AggregateID},
+				{Name: "Sequence", Value: strconv.FormatUint(failedEvent.FailedSequence, 10)},
+				{Name: "Failures", Value: strconv.FormatUint(failedEvent.FailureCount, 10)},
+			},
+		}
+		triggeringEvent := eventstore.BaseEventFromRepo(&eventstore.BaseEvent{Agg: &instance.NewAggregate(instanceID).Aggregate})
+		if err = sendAlert(ctx, a.queries, a.channels, alert, triggeringEvent); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,129 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	"github.com/zitadel/zitadel/internal/crypto"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	channel_mock "github.com/zitadel/zitadel/internal/notification/channels/mock"
+	"github.com/zitadel/zitadel/internal/notification/handlers/mock"
+	"github.com/zitadel/zitadel/internal/notification/senders"
+	"github.com/zitadel/zitadel/internal/notification/types"
+	"github.com/zitadel/zitadel/internal/query"
+	"github.com/zitadel/zitadel/internal/repository/instance"
+	"github.com/zitadel/zitadel/internal/repository/org"
+	"github.com/zitadel/zitadel/internal/repository/user"
+)
+
+func Test_alertFromEvent(t *testing.T) {
+	orgAgg := &org.NewAggregate("org1").Aggregate
+	tests := []struct {
+		name          string
+		event         eventstore.Event
+		wantEventType string
+		wantTitle     string
+	}{
+		{
+			name:          "org member added",
+			event:         org.NewMemberAddedEvent(context.Background(), orgAgg, "user1", "ORG_OWNER"),
+			wantEventType: string(org.MemberAddedEventType),
+			wantTitle:     "Organization administrator added",
+		},
+		{
+			name:          "instance member added",
+			event:         instance.NewMemberAddedEvent(context.Background(), &instance.NewAggregate("instance1").Aggregate, "user1", "IAM_OWNER"),
+			wantEventType: string(instance.MemberAddedEventType),
+			wantTitle:     "Instance administrator added",
+		},
+		{
+			name:          "idp removed",
+			event:         org.NewIDPRemovedEvent(context.Background(), orgAgg, "idp1"),
+			wantEventType: string(org.IDPRemovedEventType),
+			wantTitle:     "Identity provider configuration changed",
+		},
+		{
+			name:          "break-glass user used",
+			event:         user.NewUserBreakGlassUsedEvent(context.Background(), &user.NewAggregate("user1", "org1").Aggregate, domain.BreakGlassAuthMethodSecurityKey, nil),
+			wantEventType: string(user.UserBreakGlassUsedType),
+			wantTitle:     "Break-glass account used",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			alert := alertFromEvent(tt.event)
+			if assert.NotNil(t, alert) {
+				assert.Equal(t, tt.wantEventType, alert.EventType)
+				assert.Equal(t, tt.wantTitle, alert.Title)
+			}
+		})
+	}
+}
+
+func Test_sendAlert(t *testing.T) {
+	webhookURL := &crypto.CryptoValue{
+		CryptoType: crypto.TypeEncryption,
+		Algorithm:  "enc",
+		KeyID:      "id",
+		Crypted:    []byte("https://hooks.example.com/alerts"),
+	}
+	alert := &types.Alert{
+		EventType: string(org.MemberAddedEventType),
+		Title:     "Organization administrator added",
+	}
+	tests := []struct {
+		name        string
+		targets     []*query.AlertTarget
+		deliveryErr error
+		sent        int
+	}{
+		{
+			name: "filter doesn't match, not sent",
+			targets: []*query.AlertTarget{
+				{ID: "target1", TargetType: domain.AlertTargetTypeSlack, WebhookURL: webhookURL, EventTypes: []string{"instance.idp.*"}},
+			},
+		},
+		{
+			name: "matching targets, sent",
+			targets: []*query.AlertTarget{
+				{ID: "target1", TargetType: domain.AlertTargetTypeSlack, WebhookURL: webhookURL},
+				{ID: "target2", TargetType: domain.AlertTargetTypeTeams, WebhookURL: webhookURL, EventTypes: []string{"org.member.*"}},
+			},
+			sent: 2,
+		},
+		{
+			name: "delivery failed, next target sent",
+			targets: []*query.AlertTarget{
+				{ID: "target1", TargetType: domain.AlertTargetTypeSlack, WebhookURL: webhookURL},
+				{ID: "target2", TargetType: domain.AlertTargetTypeTeams, WebhookURL: webhookURL},
+			},
+			deliveryErr: errors.New("webhook unavailable"),
+			sent:        2,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			queries := mock.NewMockQueries(ctrl)
+			queries.EXPECT().AlertTargets(gomock.Any()).Return(tt.targets, nil)
+			channel := channel_mock.NewMockNotificationChannel(ctrl)
+			channel.EXPECT().HandleMessage(gomock.Any()).Return(tt.deliveryErr).Times(tt.sent)
+			err := sendAlert(
+				context.Background(),
+				&NotificationQueries{
+					Queries:        queries,
+					SMSTokenCrypto: crypto.CreateMockEncryptionAlg(ctrl),
+				},
+				&channels{Chain: *senders.ChainChannels(channel)},
+				alert,
+				org.NewMemberAddedEvent(context.Background(), &org.NewAggregate("org1").Aggregate, "user1", "ORG_OWNER"),
+			)
+			assert.NoError(t, err)
+		})
+	}
+}
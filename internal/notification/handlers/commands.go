@@ -2,7 +2,9 @@ package handlers
 
 import (
 	"context"
+	"time"
 
+	"github.com/zitadel/zitadel/internal/domain"
 	"github.com/zitadel/zitadel/internal/repository/milestone"
 	"github.com/zitadel/zitadel/internal/repository/quota"
 )
@@ -16,9 +18,18 @@ type Commands interface {
 	OTPSMSSent(ctx context.Context, sessionID, resourceOwner string) error
 	OTPEmailSent(ctx context.Context, sessionID, resourceOwner string) error
 	UserDomainClaimedSent(ctx context.Context, orgID, userID string) error
+	OrgDomainVerificationExpiredSent(ctx context.Context, orgID, orgDomain string) error
+	UserLifecycleActionNotificationSent(ctx context.Context, orgID, userID string, action domain.UserLifecycleAction) error
+	MachineCredentialExpiringNotificationSent(ctx context.Context, orgID, userID, credentialID string) error
+	IDPHealthCheckFailedNotificationSent(ctx context.Context, resourceOwner, idpID string) error
+	OrgInviteSent(ctx context.Context, orgID, inviteID string) error
 	HumanPasswordlessInitCodeSent(ctx context.Context, userID, resourceOwner, codeID string) error
 	PasswordChangeSent(ctx context.Context, orgID, userID string) error
+	UsernameRecoverySent(ctx context.Context, orgID, userID string) error
 	HumanPhoneVerificationCodeSent(ctx context.Context, orgID, userID string) error
 	UsageNotificationSent(ctx context.Context, dueEvent *quota.NotificationDueEvent) error
 	MilestonePushed(ctx context.Context, msType milestone.Type, endpoints []string, primaryDomain string) error
+	NotificationRetryScheduled(ctx context.Context, id, reason string, nextAttempt time.Time) error
+	NotificationDeadLettered(ctx context.Context, id, reason string) error
+	NotificationResent(ctx context.Context, id string) error
 }
@@ -0,0 +1,47 @@
+package handlers
+
+import (
+	"context"
+
+	"github.com/zitadel/zitadel/internal/crypto"
+	"github.com/zitadel/zitadel/internal/notification/channels/apns"
+	"github.com/zitadel/zitadel/internal/notification/channels/fcm"
+	"github.com/zitadel/zitadel/internal/notification/channels/push"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+// GetPushConfig reads the push notification services of the instance
+func (n *NotificationQueries) GetPushConfig(ctx context.Context) (*push.Config, error) {
+	config, err := n.PushConfig(ctx)
+	if err != nil {
+		return nil, err
+	}
+	pushConfig := new(push.Config)
+	if config.FCM != nil {
+		serviceAccountKey, err := crypto.Decrypt(config.FCM.ServiceAccountKey, n.SMSTokenCrypto)
+		if err != nil {
+			return nil, err
+		}
+		pushConfig.FCMConfig = &fcm.Config{
+			ProjectID:         config.FCM.ProjectID,
+			ServiceAccountKey: serviceAccountKey,
+		}
+	}
+	if config.APNs != nil {
+		privateKey, err := crypto.Decrypt(config.APNs.PrivateKey, n.SMSTokenCrypto)
+		if err != nil {
+			return nil, err
+		}
+		pushConfig.APNsConfig = &apns.Config{
+			KeyID:      config.APNs.KeyID,
+			TeamID:     config.APNs.TeamID,
+			BundleID:   config.APNs.BundleID,
+			PrivateKey: privateKey,
+			Production: config.APNs.Production,
+		}
+	}
+	if pushConfig.FCMConfig == nil && pushConfig.APNsConfig == nil {
+		return nil, zerrors.ThrowNotFound(nil, "HANDLER-Pc8xm", "Errors.Push.NotFound")
+	}
+	return pushConfig, nil
+}
@@ -2,32 +2,56 @@ package handlers
 
 import (
 	"context"
+	"net/http"
 
 	"github.com/zitadel/zitadel/internal/api/authz"
 	"github.com/zitadel/zitadel/internal/crypto"
+	"github.com/zitadel/zitadel/internal/notification/channels/email"
 	"github.com/zitadel/zitadel/internal/notification/channels/smtp"
+	"github.com/zitadel/zitadel/internal/notification/channels/webhook"
 )
 
-// GetSMTPConfig reads the iam SMTP provider config
-func (n *NotificationQueries) GetSMTPConfig(ctx context.Context) (*smtp.Config, error) {
+// GetActiveEmailConfig reads the active iam email provider config
+func (n *NotificationQueries) GetActiveEmailConfig(ctx context.Context) (*email.Config, error) {
 	config, err := n.SMTPConfigActive(ctx, authz.GetInstance(ctx).InstanceID())
 	if err != nil {
 		return nil, err
 	}
+	provider := &email.Provider{
+		ID:          config.ID,
+		Description: config.Description,
+	}
+	if config.HTTPConfig != nil {
+		signingKey, err := crypto.DecryptString(config.HTTPConfig.SigningKey, n.SMTPPasswordCrypto)
+		if err != nil {
+			return nil, err
+		}
+		return &email.Config{
+			ProviderConfig: provider,
+			WebhookConfig: &webhook.Config{
+				CallURL:    config.HTTPConfig.Endpoint,
+				Method:     http.MethodPost,
+				SigningKey: signingKey,
+			},
+		}, nil
+	}
 	password, err := crypto.DecryptString(config.Password, n.SMTPPasswordCrypto)
 	if err != nil {
 		return nil, err
 	}
-	return &smtp.Config{
-		Description:    config.Description,
-		From:           config.SenderAddress,
-		FromName:       config.SenderName,
-		ReplyToAddress: config.ReplyToAddress,
-		Tls:            config.TLS,
-		SMTP: smtp.SMTP{
-			Host:     config.Host,
-			User:     config.User,
-			Password: password,
+	return &email.Config{
+		ProviderConfig: provider,
+		SMTPConfig: &smtp.Config{
+			Description:    config.Description,
+			From:           config.SenderAddress,
+			FromName:       config.SenderName,
+			ReplyToAddress: config.ReplyToAddress,
+			Tls:            config.TLS,
+			SMTP: smtp.SMTP{
+				Host:     config.Host,
+				User:     config.User,
+				Password: password,
+			},
 		},
 	}, nil
 }
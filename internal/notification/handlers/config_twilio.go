@@ -2,34 +2,76 @@ package handlers
 
 import (
 	"context"
+	"net/http"
+
+	"github.com/zitadel/logging"
 
 	"github.com/zitadel/zitadel/internal/crypto"
 	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/notification/channels/sms"
 	"github.com/zitadel/zitadel/internal/notification/channels/twilio"
+	"github.com/zitadel/zitadel/internal/notification/channels/webhook"
 	"github.com/zitadel/zitadel/internal/query"
 	"github.com/zitadel/zitadel/internal/zerrors"
 )
 
-// GetTwilioConfig reads the iam Twilio provider config
-func (n *NotificationQueries) GetTwilioConfig(ctx context.Context) (*twilio.Config, error) {
+// GetActiveSMSConfigs reads all active iam SMS provider configs
+func (n *NotificationQueries) GetActiveSMSConfigs(ctx context.Context) ([]*sms.Config, error) {
 	active, err := query.NewSMSProviderStateQuery(domain.SMSConfigStateActive)
 	if err != nil {
 		return nil, err
 	}
-	config, err := n.SMSProviderConfig(ctx, active)
+	configs, err := n.SearchSMSConfigs(ctx, &query.SMSConfigsSearchQueries{Queries: []query.SearchQuery{active}})
 	if err != nil {
 		return nil, err
 	}
-	if config.TwilioConfig == nil {
+	smsConfigs := make([]*sms.Config, 0, len(configs.Configs))
+	for _, config := range configs.Configs {
+		smsConfig, err := n.smsConfig(config)
+		// a single misconfigured provider must not prevent the failover to the others
+		logging.WithFields("provider", config.ID).OnError(err).Warn("unable to read sms provider config")
+		if err == nil {
+			smsConfigs = append(smsConfigs, smsConfig)
+		}
+	}
+	if len(smsConfigs) == 0 {
 		return nil, zerrors.ThrowNotFound(nil, "HANDLER-8nfow", "Errors.SMS.Twilio.NotFound")
 	}
-	token, err := crypto.DecryptString(config.TwilioConfig.Token, n.SMSTokenCrypto)
-	if err != nil {
-		return nil, err
+	return smsConfigs, nil
+}
+
+func (n *NotificationQueries) smsConfig(config *query.SMSConfig) (*sms.Config, error) {
+	smsConfig := &sms.Config{
+		ProviderConfig: &sms.Provider{
+			ID:          config.ID,
+			Description: config.Description,
+		},
+		Priority:     config.Priority,
+		CountryCodes: config.CountryCodes,
+	}
+	if config.TwilioConfig != nil {
+		token, err := crypto.DecryptString(config.TwilioConfig.Token, n.SMSTokenCrypto)
+		if err != nil {
+			return nil, err
+		}
+		smsConfig.TwilioConfig = &twilio.Config{
+			SID:          config.TwilioConfig.SID,
+			Token:        token,
+			SenderNumber: config.TwilioConfig.SenderNumber,
+		}
+		return smsConfig, nil
+	}
+	if config.HTTPConfig != nil {
+		signingKey, err := crypto.DecryptString(config.HTTPConfig.SigningKey, n.SMSTokenCrypto)
+		if err != nil {
+			return nil, err
+		}
+		smsConfig.WebhookConfig = &webhook.Config{
+			CallURL:    config.HTTPConfig.Endpoint,
+			Method:     http.MethodPost,
+			SigningKey: signingKey,
+		}
+		return smsConfig, nil
 	}
-	return &twilio.Config{
-		SID:          config.TwilioConfig.SID,
-		Token:        token,
-		SenderNumber: config.TwilioConfig.SenderNumber,
-	}, nil
+	return nil, zerrors.ThrowNotFound(nil, "HANDLER-2xk0bqhn7e", "Errors.SMS.Twilio.NotFound")
 }
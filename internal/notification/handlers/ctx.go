@@ -3,13 +3,21 @@ package handlers
 import (
 	"context"
 
+	"go.opentelemetry.io/otel/trace"
+
 	"github.com/zitadel/zitadel/internal/api/authz"
 	"github.com/zitadel/zitadel/internal/eventstore"
 )
 
 const NotifyUserID = "NOTIFICATION" //TODO: system?
 
-func HandlerContext(event *eventstore.Aggregate) context.Context {
-	ctx := authz.WithInstanceID(context.Background(), event.InstanceID)
-	return authz.SetCtxData(ctx, authz.CtxData{UserID: NotifyUserID, OrgID: event.ResourceOwner})
+// HandlerContext returns the context of the notification triggered by the event.
+// If the event was pushed by this process, the notification is traced as part of the push.
+func HandlerContext(event eventstore.Event) context.Context {
+	ctx := context.Background()
+	if traced, ok := event.(interface{ SpanContext() trace.SpanContext }); ok && traced.SpanContext().IsValid() {
+		ctx = trace.ContextWithSpanContext(ctx, traced.SpanContext())
+	}
+	ctx = authz.WithInstanceID(ctx, event.Aggregate().InstanceID)
+	return authz.SetCtxData(ctx, authz.CtxData{UserID: NotifyUserID, OrgID: event.Aggregate().ResourceOwner})
 }
@@ -0,0 +1,37 @@
+package handlers
+
+import (
+	"context"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/notification/templates"
+)
+
+// GetMailTemplateSet combines the mail template of the organization with the layouts and partials of the instance
+func (n *NotificationQueries) GetMailTemplateSet(ctx context.Context, orgID string) (*templates.TemplateSet, error) {
+	mailTemplate, err := n.MailTemplateByOrg(ctx, orgID, false)
+	if err != nil {
+		return nil, err
+	}
+	notificationTemplates, err := n.NotificationTemplates(ctx)
+	if err != nil {
+		return nil, err
+	}
+	set := notificationTemplates.TemplateSet(string(mailTemplate.Template))
+	if n.assets != nil {
+		set.Assets = n.loadAsset
+	}
+	return set, nil
+}
+
+func (n *NotificationQueries) loadAsset(ctx context.Context, resourceOwner, name string) ([]byte, string, error) {
+	data, getInfo, err := n.assets.GetObject(ctx, authz.GetInstance(ctx).InstanceID(), resourceOwner, name)
+	if err != nil {
+		return nil, "", err
+	}
+	info, err := getInfo()
+	if err != nil {
+		return nil, "", err
+	}
+	return data, info.ContentType, nil
+}
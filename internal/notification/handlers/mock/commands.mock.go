@@ -12,7 +12,9 @@ package mock
 import (
 	context "context"
 	reflect "reflect"
+	time "time"
 
+	domain "github.com/zitadel/zitadel/internal/domain"
 	milestone "github.com/zitadel/zitadel/internal/repository/milestone"
 	quota "github.com/zitadel/zitadel/internal/repository/quota"
 	gomock "go.uber.org/mock/gomock"
@@ -125,6 +127,34 @@ func (mr *MockCommandsMockRecorder) HumanPhoneVerificationCodeSent(arg0, arg1, a
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HumanPhoneVerificationCodeSent", reflect.TypeOf((*MockCommands)(nil).HumanPhoneVerificationCodeSent), arg0, arg1, arg2)
 }
 
+// IDPHealthCheckFailedNotificationSent mocks base method.
+func (m *MockCommands) IDPHealthCheckFailedNotificationSent(arg0 context.Context, arg1, arg2 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IDPHealthCheckFailedNotificationSent", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// IDPHealthCheckFailedNotificationSent indicates an expected call of IDPHealthCheckFailedNotificationSent.
+func (mr *MockCommandsMockRecorder) IDPHealthCheckFailedNotificationSent(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IDPHealthCheckFailedNotificationSent", reflect.TypeOf((*MockCommands)(nil).IDPHealthCheckFailedNotificationSent), arg0, arg1, arg2)
+}
+
+// MachineCredentialExpiringNotificationSent mocks base method.
+func (m *MockCommands) MachineCredentialExpiringNotificationSent(arg0 context.Context, arg1, arg2, arg3 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MachineCredentialExpiringNotificationSent", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// MachineCredentialExpiringNotificationSent indicates an expected call of MachineCredentialExpiringNotificationSent.
+func (mr *MockCommandsMockRecorder) MachineCredentialExpiringNotificationSent(arg0, arg1, arg2, arg3 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MachineCredentialExpiringNotificationSent", reflect.TypeOf((*MockCommands)(nil).MachineCredentialExpiringNotificationSent), arg0, arg1, arg2, arg3)
+}
+
 // MilestonePushed mocks base method.
 func (m *MockCommands) MilestonePushed(arg0 context.Context, arg1 milestone.Type, arg2 []string, arg3 string) error {
 	m.ctrl.T.Helper()
@@ -139,6 +169,48 @@ func (mr *MockCommandsMockRecorder) MilestonePushed(arg0, arg1, arg2, arg3 any)
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MilestonePushed", reflect.TypeOf((*MockCommands)(nil).MilestonePushed), arg0, arg1, arg2, arg3)
 }
 
+// NotificationDeadLettered mocks base method.
+func (m *MockCommands) NotificationDeadLettered(arg0 context.Context, arg1, arg2 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NotificationDeadLettered", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// NotificationDeadLettered indicates an expected call of NotificationDeadLettered.
+func (mr *MockCommandsMockRecorder) NotificationDeadLettered(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NotificationDeadLettered", reflect.TypeOf((*MockCommands)(nil).NotificationDeadLettered), arg0, arg1, arg2)
+}
+
+// NotificationResent mocks base method.
+func (m *MockCommands) NotificationResent(arg0 context.Context, arg1 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NotificationResent", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// NotificationResent indicates an expected call of NotificationResent.
+func (mr *MockCommandsMockRecorder) NotificationResent(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NotificationResent", reflect.TypeOf((*MockCommands)(nil).NotificationResent), arg0, arg1)
+}
+
+// NotificationRetryScheduled mocks base method.
+func (m *MockCommands) NotificationRetryScheduled(arg0 context.Context, arg1, arg2 string, arg3 time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NotificationRetryScheduled", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// NotificationRetryScheduled indicates an expected call of NotificationRetryScheduled.
+func (mr *MockCommandsMockRecorder) NotificationRetryScheduled(arg0, arg1, arg2, arg3 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NotificationRetryScheduled", reflect.TypeOf((*MockCommands)(nil).NotificationRetryScheduled), arg0, arg1, arg2, arg3)
+}
+
 // OTPEmailSent mocks base method.
 func (m *MockCommands) OTPEmailSent(arg0 context.Context, arg1, arg2 string) error {
 	m.ctrl.T.Helper()
@@ -167,6 +239,34 @@ func (mr *MockCommandsMockRecorder) OTPSMSSent(arg0, arg1, arg2 any) *gomock.Cal
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "OTPSMSSent", reflect.TypeOf((*MockCommands)(nil).OTPSMSSent), arg0, arg1, arg2)
 }
 
+// OrgDomainVerificationExpiredSent mocks base method.
+func (m *MockCommands) OrgDomainVerificationExpiredSent(arg0 context.Context, arg1, arg2 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "OrgDomainVerificationExpiredSent", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// OrgDomainVerificationExpiredSent indicates an expected call of OrgDomainVerificationExpiredSent.
+func (mr *MockCommandsMockRecorder) OrgDomainVerificationExpiredSent(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "OrgDomainVerificationExpiredSent", reflect.TypeOf((*MockCommands)(nil).OrgDomainVerificationExpiredSent), arg0, arg1, arg2)
+}
+
+// OrgInviteSent mocks base method.
+func (m *MockCommands) OrgInviteSent(arg0 context.Context, arg1, arg2 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "OrgInviteSent", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// OrgInviteSent indicates an expected call of OrgInviteSent.
+func (mr *MockCommandsMockRecorder) OrgInviteSent(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "OrgInviteSent", reflect.TypeOf((*MockCommands)(nil).OrgInviteSent), arg0, arg1, arg2)
+}
+
 // PasswordChangeSent mocks base method.
 func (m *MockCommands) PasswordChangeSent(arg0 context.Context, arg1, arg2 string) error {
 	m.ctrl.T.Helper()
@@ -222,3 +322,31 @@ func (mr *MockCommandsMockRecorder) UserDomainClaimedSent(arg0, arg1, arg2 any)
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UserDomainClaimedSent", reflect.TypeOf((*MockCommands)(nil).UserDomainClaimedSent), arg0, arg1, arg2)
 }
+
+// UserLifecycleActionNotificationSent mocks base method.
+func (m *MockCommands) UserLifecycleActionNotificationSent(arg0 context.Context, arg1, arg2 string, arg3 domain.UserLifecycleAction) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UserLifecycleActionNotificationSent", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UserLifecycleActionNotificationSent indicates an expected call of UserLifecycleActionNotificationSent.
+func (mr *MockCommandsMockRecorder) UserLifecycleActionNotificationSent(arg0, arg1, arg2, arg3 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UserLifecycleActionNotificationSent", reflect.TypeOf((*MockCommands)(nil).UserLifecycleActionNotificationSent), arg0, arg1, arg2, arg3)
+}
+
+// UsernameRecoverySent mocks base method.
+func (m *MockCommands) UsernameRecoverySent(arg0 context.Context, arg1, arg2 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UsernameRecoverySent", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UsernameRecoverySent indicates an expected call of UsernameRecoverySent.
+func (mr *MockCommandsMockRecorder) UsernameRecoverySent(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UsernameRecoverySent", reflect.TypeOf((*MockCommands)(nil).UsernameRecoverySent), arg0, arg1, arg2)
+}
@@ -57,6 +57,21 @@ func (mr *MockQueriesMockRecorder) ActiveLabelPolicyByOrg(arg0, arg1, arg2 any)
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ActiveLabelPolicyByOrg", reflect.TypeOf((*MockQueries)(nil).ActiveLabelPolicyByOrg), arg0, arg1, arg2)
 }
 
+// AlertTargets mocks base method.
+func (m *MockQueries) AlertTargets(arg0 context.Context) ([]*query.AlertTarget, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AlertTargets", arg0)
+	ret0, _ := ret[0].([]*query.AlertTarget)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AlertTargets indicates an expected call of AlertTargets.
+func (mr *MockQueriesMockRecorder) AlertTargets(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AlertTargets", reflect.TypeOf((*MockQueries)(nil).AlertTargets), arg0)
+}
+
 // CustomTextListByTemplate mocks base method.
 func (m *MockQueries) CustomTextListByTemplate(arg0 context.Context, arg1, arg2 string, arg3 bool) (*query.CustomTexts, error) {
 	m.ctrl.T.Helper()
@@ -116,6 +131,21 @@ func (mr *MockQueriesMockRecorder) GetNotifyUserByID(arg0, arg1, arg2 any) *gomo
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetNotifyUserByID", reflect.TypeOf((*MockQueries)(nil).GetNotifyUserByID), arg0, arg1, arg2)
 }
 
+// IAMMembers mocks base method.
+func (m *MockQueries) IAMMembers(arg0 context.Context, arg1 *query.IAMMembersQuery) (*query.Members, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IAMMembers", arg0, arg1)
+	ret0, _ := ret[0].(*query.Members)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// IAMMembers indicates an expected call of IAMMembers.
+func (mr *MockQueriesMockRecorder) IAMMembers(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IAMMembers", reflect.TypeOf((*MockQueries)(nil).IAMMembers), arg0, arg1)
+}
+
 // MailTemplateByOrg mocks base method.
 func (m *MockQueries) MailTemplateByOrg(arg0 context.Context, arg1 string, arg2 bool) (*query.MailTemplate, error) {
 	m.ctrl.T.Helper()
@@ -161,24 +191,64 @@ func (mr *MockQueriesMockRecorder) NotificationProviderByIDAndType(arg0, arg1, a
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NotificationProviderByIDAndType", reflect.TypeOf((*MockQueries)(nil).NotificationProviderByIDAndType), arg0, arg1, arg2)
 }
 
-// SMSProviderConfig mocks base method.
-func (m *MockQueries) SMSProviderConfig(arg0 context.Context, arg1 ...query.SearchQuery) (*query.SMSConfig, error) {
+// NotificationTemplates mocks base method.
+func (m *MockQueries) NotificationTemplates(arg0 context.Context) (*query.NotificationTemplates, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NotificationTemplates", arg0)
+	ret0, _ := ret[0].(*query.NotificationTemplates)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// NotificationTemplates indicates an expected call of NotificationTemplates.
+func (mr *MockQueriesMockRecorder) NotificationTemplates(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NotificationTemplates", reflect.TypeOf((*MockQueries)(nil).NotificationTemplates), arg0)
+}
+
+// OrgMembers mocks base method.
+func (m *MockQueries) OrgMembers(arg0 context.Context, arg1 *query.OrgMembersQuery) (*query.Members, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "OrgMembers", arg0, arg1)
+	ret0, _ := ret[0].(*query.Members)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// OrgMembers indicates an expected call of OrgMembers.
+func (mr *MockQueriesMockRecorder) OrgMembers(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "OrgMembers", reflect.TypeOf((*MockQueries)(nil).OrgMembers), arg0, arg1)
+}
+
+// PushConfig mocks base method.
+func (m *MockQueries) PushConfig(arg0 context.Context) (*query.PushConfig, error) {
 	m.ctrl.T.Helper()
-	varargs := []any{arg0}
-	for _, a := range arg1 {
-		varargs = append(varargs, a)
-	}
-	ret := m.ctrl.Call(m, "SMSProviderConfig", varargs...)
-	ret0, _ := ret[0].(*query.SMSConfig)
+	ret := m.ctrl.Call(m, "PushConfig", arg0)
+	ret0, _ := ret[0].(*query.PushConfig)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// SMSProviderConfig indicates an expected call of SMSProviderConfig.
-func (mr *MockQueriesMockRecorder) SMSProviderConfig(arg0 any, arg1 ...any) *gomock.Call {
+// PushConfig indicates an expected call of PushConfig.
+func (mr *MockQueriesMockRecorder) PushConfig(arg0 any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	varargs := append([]any{arg0}, arg1...)
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SMSProviderConfig", reflect.TypeOf((*MockQueries)(nil).SMSProviderConfig), varargs...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PushConfig", reflect.TypeOf((*MockQueries)(nil).PushConfig), arg0)
+}
+
+// PushDevices mocks base method.
+func (m *MockQueries) PushDevices(arg0 context.Context, arg1, arg2 string) ([]*query.PushDevice, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PushDevices", arg0, arg1, arg2)
+	ret0, _ := ret[0].([]*query.PushDevice)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PushDevices indicates an expected call of PushDevices.
+func (mr *MockQueriesMockRecorder) PushDevices(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PushDevices", reflect.TypeOf((*MockQueries)(nil).PushDevices), arg0, arg1, arg2)
 }
 
 // SMTPConfigActive mocks base method.
@@ -196,6 +266,21 @@ func (mr *MockQueriesMockRecorder) SMTPConfigActive(arg0, arg1 any) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SMTPConfigActive", reflect.TypeOf((*MockQueries)(nil).SMTPConfigActive), arg0, arg1)
 }
 
+// SearchFailedEvents mocks base method.
+func (m *MockQueries) SearchFailedEvents(arg0 context.Context, arg1 *query.FailedEventSearchQueries) (*query.FailedEvents, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SearchFailedEvents", arg0, arg1)
+	ret0, _ := ret[0].(*query.FailedEvents)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SearchFailedEvents indicates an expected call of SearchFailedEvents.
+func (mr *MockQueriesMockRecorder) SearchFailedEvents(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchFailedEvents", reflect.TypeOf((*MockQueries)(nil).SearchFailedEvents), arg0, arg1)
+}
+
 // SearchInstanceDomains mocks base method.
 func (m *MockQueries) SearchInstanceDomains(arg0 context.Context, arg1 *query.InstanceDomainSearchQueries) (*query.InstanceDomains, error) {
 	m.ctrl.T.Helper()
@@ -226,6 +311,36 @@ func (mr *MockQueriesMockRecorder) SearchMilestones(arg0, arg1, arg2 any) *gomoc
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchMilestones", reflect.TypeOf((*MockQueries)(nil).SearchMilestones), arg0, arg1, arg2)
 }
 
+// SearchQueuedNotifications mocks base method.
+func (m *MockQueries) SearchQueuedNotifications(arg0 context.Context, arg1 []string, arg2 *query.QueuedNotificationSearchQueries) (*query.QueuedNotifications, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SearchQueuedNotifications", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*query.QueuedNotifications)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SearchQueuedNotifications indicates an expected call of SearchQueuedNotifications.
+func (mr *MockQueriesMockRecorder) SearchQueuedNotifications(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchQueuedNotifications", reflect.TypeOf((*MockQueries)(nil).SearchQueuedNotifications), arg0, arg1, arg2)
+}
+
+// SearchSMSConfigs mocks base method.
+func (m *MockQueries) SearchSMSConfigs(arg0 context.Context, arg1 *query.SMSConfigsSearchQueries) (*query.SMSConfigs, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SearchSMSConfigs", arg0, arg1)
+	ret0, _ := ret[0].(*query.SMSConfigs)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SearchSMSConfigs indicates an expected call of SearchSMSConfigs.
+func (mr *MockQueriesMockRecorder) SearchSMSConfigs(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchSMSConfigs", reflect.TypeOf((*MockQueries)(nil).SearchSMSConfigs), arg0, arg1)
+}
+
 // SessionByID mocks base method.
 func (m *MockQueries) SessionByID(arg0 context.Context, arg1 bool, arg2, arg3 string) (*query.Session, error) {
 	m.ctrl.T.Helper()
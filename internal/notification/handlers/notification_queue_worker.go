@@ -0,0 +1,173 @@
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sync/atomic"
+	"time"
+
+	"github.com/zitadel/logging"
+	"go.opentelemetry.io/otel/metric"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/api/call"
+	"github.com/zitadel/zitadel/internal/backoff"
+	"github.com/zitadel/zitadel/internal/crypto"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/eventstore/handler/v2"
+	"github.com/zitadel/zitadel/internal/notification/types"
+	"github.com/zitadel/zitadel/internal/query"
+	"github.com/zitadel/zitadel/internal/repository/pseudo"
+	"github.com/zitadel/zitadel/internal/telemetry/metrics"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+const (
+	NotificationQueueWorkerTable = "projections.notification_queue_worker"
+
+	notificationQueueDepth            = "zitadel.notification.queue_depth"
+	notificationQueueDepthDescription = "Pending queued notifications which are due for a retry"
+)
+
+// NotificationQueueConfig defines the exponential backoff used for the retries of queued notifications
+type NotificationQueueConfig struct {
+	// Enabled queues notifications, whose delivery failed, for asynchronous retries
+	Enabled bool
+	// MaxAttempts is the maximum number of deliveries of a notification, including the first one,
+	// before it's dead-lettered
+	MaxAttempts    uint16
+	backoff.Config `mapstructure:",squash"`
+	// Limit is the maximum number of notifications retried at once
+	Limit uint64
+}
+
+type notificationQueueWorker struct {
+	cfg      NotificationQueueConfig
+	commands Commands
+	queries  *NotificationQueries
+	channels types.ChannelChains
+	// depth is the number of pending notifications, which were due at the last retry
+	depth atomic.Int64
+}
+
+func NewNotificationQueueWorker(
+	ctx context.Context,
+	queueCfg NotificationQueueConfig,
+	handlerCfg handler.Config,
+	commands Commands,
+	queries *NotificationQueries,
+	channels types.ChannelChains,
+) *handler.Handler {
+	worker := &notificationQueueWorker{
+		cfg:      queueCfg,
+		commands: commands,
+		queries:  queries,
+		channels: channels,
+	}
+	handlerCfg.TriggerWithoutEvents = worker.retryDue
+	err := metrics.RegisterValueObserver(notificationQueueDepth, notificationQueueDepthDescription, func(_ context.Context, observer metric.Int64Observer) error {
+		observer.Observe(worker.depth.Load())
+		return nil
+	})
+	logging.WithFields("metric", notificationQueueDepth).OnError(err).Panic("unable to register observer")
+	return handler.NewHandler(
+		ctx,
+		&handlerCfg,
+		worker,
+	)
+}
+
+func (*notificationQueueWorker) Name() string {
+	return NotificationQueueWorkerTable
+}
+
+func (w *notificationQueueWorker) Reducers() []handler.AggregateReducer {
+	return []handler.AggregateReducer{{
+		Aggregate: pseudo.AggregateType,
+		EventReducers: []handler.EventReducer{{
+			Event:  pseudo.ScheduledEventType,
+			Reduce: w.retryDue,
+		}},
+	}}
+}
+
+func (w *notificationQueueWorker) retryDue(event eventstore.Event) (*handler.Statement, error) {
+	scheduledEvent, ok := event.(*pseudo.ScheduledEvent)
+	if !ok {
+		return nil, zerrors.ThrowInvalidArgumentf(nil, "HANDL-n3vt8kqc5s", "reduce.wrong.event.type %s", event.Type())
+	}
+
+	return handler.NewStatement(event, func(ex handler.Executer, projectionName string) error {
+		return w.retry(call.WithTimestamp(context.Background()), scheduledEvent.InstanceIDs, time.Now())
+	}), nil
+}
+
+// retry retries all pending notifications, which are due at the given time
+func (w *notificationQueueWorker) retry(ctx context.Context, instanceIDs []string, now time.Time) error {
+	isPending, err := query.NewQueuedNotificationStateSearchQuery(domain.NotificationQueueStatePending)
+	if err != nil {
+		return err
+	}
+	isDue, err := query.NewQueuedNotificationDueSearchQuery(now)
+	if err != nil {
+		return err
+	}
+	due, err := w.queries.SearchQueuedNotifications(ctx, instanceIDs, &query.QueuedNotificationSearchQueries{
+		SearchRequest: query.SearchRequest{
+			Limit:         w.cfg.Limit,
+			SortingColumn: query.QueuedNotificationColumnNextAttempt,
+			Asc:           true,
+		},
+		Queries: []query.SearchQuery{isPending, isDue},
+	})
+	if err != nil {
+		return err
+	}
+	w.depth.Store(int64(due.Count))
+	var errs int
+	for _, queued := range due.QueuedNotifications {
+		if err = w.retryNotification(ctx, queued, now); err != nil {
+			errs++
+			logging.WithFields("instance", queued.InstanceID, "queued", queued.ID).WithError(err).Warn("updating queued notification failed")
+		}
+	}
+	if errs > 0 {
+		return fmt.Errorf("updating %d of %d queued notifications failed", errs, len(due.QueuedNotifications))
+	}
+	return nil
+}
+
+func (w *notificationQueueWorker) retryNotification(ctx context.Context, queued *query.QueuedNotification, now time.Time) error {
+	ctx = authz.WithInstanceID(ctx, queued.InstanceID)
+	message, err := decryptQueuedMessage(queued.Message, w.queries.UserDataCrypto)
+	if err != nil {
+		// the message will never be readable again (e.g. the key was removed), so it's not retried
+		return w.commands.NotificationDeadLettered(ctx, queued.ID, err.Error())
+	}
+	deliveryErr := message.Send(ctx, w.channels)
+	if deliveryErr == nil {
+		return w.commands.NotificationResent(ctx, queued.ID)
+	}
+	attempts := queued.Attempts + 1
+	if attempts >= w.cfg.MaxAttempts {
+		return w.commands.NotificationDeadLettered(ctx, queued.ID, deliveryErr.Error())
+	}
+	return w.commands.NotificationRetryScheduled(ctx, queued.ID, deliveryErr.Error(), now.Add(w.cfg.Delay(attempts)))
+}
+
+func decryptQueuedMessage(value *crypto.CryptoValue, alg crypto.EncryptionAlgorithm) (*types.QueuedMessage, error) {
+	if value == nil {
+		return nil, zerrors.ThrowInternal(nil, "HANDL-w8jx2cfb4r", "Errors.Notification.Queue.Invalid")
+	}
+	data, err := crypto.Decrypt(value, alg)
+	if err != nil {
+		return nil, err
+	}
+	message := new(types.QueuedMessage)
+	if err = json.Unmarshal(data, message); err != nil {
+		return nil, zerrors.ThrowInternal(err, "HANDL-y5dk9pqm1t", "Errors.Notification.Queue.Invalid")
+	}
+	return message, nil
+}
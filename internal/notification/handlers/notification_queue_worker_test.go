@@ -0,0 +1,135 @@
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	"github.com/zitadel/zitadel/internal/backoff"
+	"github.com/zitadel/zitadel/internal/crypto"
+	channel_mock "github.com/zitadel/zitadel/internal/notification/channels/mock"
+	"github.com/zitadel/zitadel/internal/notification/handlers/mock"
+	"github.com/zitadel/zitadel/internal/notification/senders"
+	"github.com/zitadel/zitadel/internal/notification/types"
+	"github.com/zitadel/zitadel/internal/query"
+)
+
+func Test_notificationQueueWorker_retryNotification(t *testing.T) {
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	errSMTP := errors.New("smtp unavailable")
+	message, err := json.Marshal(&types.QueuedMessage{
+		Channel:               "email",
+		Recipient:             "user@example.com",
+		Subject:               "subject",
+		Content:               "content",
+		TriggeringAggregateID: userID,
+		TriggeringEventType:   "user.human.initialization.code.added",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	encrypted := &crypto.CryptoValue{
+		CryptoType: crypto.TypeEncryption,
+		Algorithm:  "enc",
+		KeyID:      "id",
+		Crypted:    message,
+	}
+	tests := []struct {
+		name        string
+		queued      *query.QueuedNotification
+		deliveryErr error
+		expect      func(commands *mock.MockCommandsMockRecorder)
+		sent        bool
+	}{
+		{
+			name: "sent, resent",
+			queued: &query.QueuedNotification{
+				InstanceID: "instance",
+				ID:         "queued1",
+				Message:    encrypted,
+				Attempts:   1,
+			},
+			expect: func(commands *mock.MockCommandsMockRecorder) {
+				commands.NotificationResent(gomock.Any(), "queued1").Return(nil)
+			},
+			sent: true,
+		},
+		{
+			name: "failed, retry scheduled",
+			queued: &query.QueuedNotification{
+				InstanceID: "instance",
+				ID:         "queued1",
+				Message:    encrypted,
+				Attempts:   2,
+			},
+			deliveryErr: errSMTP,
+			expect: func(commands *mock.MockCommandsMockRecorder) {
+				commands.NotificationRetryScheduled(gomock.Any(), "queued1", errSMTP.Error(), now.Add(4*time.Second)).Return(nil)
+			},
+			sent: true,
+		},
+		{
+			name: "failed last attempt, dead lettered",
+			queued: &query.QueuedNotification{
+				InstanceID: "instance",
+				ID:         "queued1",
+				Message:    encrypted,
+				Attempts:   4,
+			},
+			deliveryErr: errSMTP,
+			expect: func(commands *mock.MockCommandsMockRecorder) {
+				commands.NotificationDeadLettered(gomock.Any(), "queued1", errSMTP.Error()).Return(nil)
+			},
+			sent: true,
+		},
+		{
+			name: "unreadable message, dead lettered",
+			queued: &query.QueuedNotification{
+				InstanceID: "instance",
+				ID:         "queued1",
+				Message: &crypto.CryptoValue{
+					CryptoType: crypto.TypeEncryption,
+					Algorithm:  "enc",
+					KeyID:      "removed",
+					Crypted:    message,
+				},
+				Attempts: 1,
+			},
+			expect: func(commands *mock.MockCommandsMockRecorder) {
+				commands.NotificationDeadLettered(gomock.Any(), "queued1", gomock.Any()).Return(nil)
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			commands := mock.NewMockCommands(ctrl)
+			tt.expect(commands.EXPECT())
+			channel := channel_mock.NewMockNotificationChannel(ctrl)
+			if tt.sent {
+				channel.EXPECT().HandleMessage(gomock.Any()).Return(tt.deliveryErr)
+			}
+			w := &notificationQueueWorker{
+				cfg: NotificationQueueConfig{
+					MaxAttempts: 5,
+					Config: backoff.Config{
+						InitialInterval: time.Second,
+						Multiplier:      2,
+					},
+				},
+				commands: commands,
+				queries: &NotificationQueries{
+					UserDataCrypto: crypto.CreateMockEncryptionAlg(ctrl),
+				},
+				channels: &channels{Chain: *senders.ChainChannels(channel)},
+			}
+			err := w.retryNotification(context.Background(), tt.queued, now)
+			assert.NoError(t, err)
+		})
+	}
+}
@@ -9,19 +9,28 @@ import (
 	"github.com/zitadel/zitadel/internal/domain"
 	"github.com/zitadel/zitadel/internal/eventstore"
 	"github.com/zitadel/zitadel/internal/query"
+	"github.com/zitadel/zitadel/internal/static"
 )
 
 type Queries interface {
 	ActiveLabelPolicyByOrg(ctx context.Context, orgID string, withOwnerRemoved bool) (*query.LabelPolicy, error)
+	AlertTargets(ctx context.Context) ([]*query.AlertTarget, error)
 	MailTemplateByOrg(ctx context.Context, orgID string, withOwnerRemoved bool) (*query.MailTemplate, error)
+	NotificationTemplates(ctx context.Context) (*query.NotificationTemplates, error)
 	GetNotifyUserByID(ctx context.Context, shouldTriggered bool, userID string) (*query.NotifyUser, error)
+	OrgMembers(ctx context.Context, queries *query.OrgMembersQuery) (*query.Members, error)
+	IAMMembers(ctx context.Context, queries *query.IAMMembersQuery) (*query.Members, error)
 	CustomTextListByTemplate(ctx context.Context, aggregateID, template string, withOwnerRemoved bool) (*query.CustomTexts, error)
 	SearchInstanceDomains(ctx context.Context, queries *query.InstanceDomainSearchQueries) (*query.InstanceDomains, error)
 	SessionByID(ctx context.Context, shouldTriggerBulk bool, id, sessionToken string) (*query.Session, error)
 	NotificationPolicyByOrg(ctx context.Context, shouldTriggerBulk bool, orgID string, withOwnerRemoved bool) (*query.NotificationPolicy, error)
 	SearchMilestones(ctx context.Context, instanceIDs []string, queries *query.MilestonesSearchQueries) (*query.Milestones, error)
+	SearchFailedEvents(ctx context.Context, queries *query.FailedEventSearchQueries) (*query.FailedEvents, error)
+	SearchQueuedNotifications(ctx context.Context, instanceIDs []string, queries *query.QueuedNotificationSearchQueries) (*query.QueuedNotifications, error)
 	NotificationProviderByIDAndType(ctx context.Context, aggID string, providerType domain.NotificationProviderType) (*query.DebugNotificationProvider, error)
-	SMSProviderConfig(ctx context.Context, queries ...query.SearchQuery) (*query.SMSConfig, error)
+	PushConfig(ctx context.Context) (*query.PushConfig, error)
+	PushDevices(ctx context.Context, userID, resourceOwner string) ([]*query.PushDevice, error)
+	SearchSMSConfigs(ctx context.Context, queries *query.SMSConfigsSearchQueries) (*query.SMSConfigs, error)
 	SMTPConfigActive(ctx context.Context, resourceOwner string) (*query.SMTPConfig, error)
 	GetDefaultLanguage(ctx context.Context) language.Tag
 	GetInstanceRestrictions(ctx context.Context) (restrictions query.Restrictions, err error)
@@ -34,6 +43,7 @@ type NotificationQueries struct {
 	externalPort       uint16
 	externalSecure     bool
 	fileSystemPath     string
+	assets             static.Storage
 	UserDataCrypto     crypto.EncryptionAlgorithm
 	SMTPPasswordCrypto crypto.EncryptionAlgorithm
 	SMSTokenCrypto     crypto.EncryptionAlgorithm
@@ -46,6 +56,7 @@ func NewNotificationQueries(
 	externalPort uint16,
 	externalSecure bool,
 	fileSystemPath string,
+	assets static.Storage,
 	userDataCrypto crypto.EncryptionAlgorithm,
 	smtpPasswordCrypto crypto.EncryptionAlgorithm,
 	smsTokenCrypto crypto.EncryptionAlgorithm,
@@ -57,6 +68,7 @@ func NewNotificationQueries(
 		externalPort:       externalPort,
 		externalSecure:     externalSecure,
 		fileSystemPath:     fileSystemPath,
+		assets:             assets,
 		UserDataCrypto:     userDataCrypto,
 		SMTPPasswordCrypto: smtpPasswordCrypto,
 		SMSTokenCrypto:     smsTokenCrypto,
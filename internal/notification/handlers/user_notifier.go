@@ -2,17 +2,24 @@ package handlers
 
 import (
 	"context"
+	"slices"
 	"strings"
 	"time"
 
+	"github.com/zitadel/logging"
+
 	http_util "github.com/zitadel/zitadel/internal/api/http"
 	"github.com/zitadel/zitadel/internal/api/ui/login"
 	"github.com/zitadel/zitadel/internal/crypto"
 	"github.com/zitadel/zitadel/internal/domain"
 	"github.com/zitadel/zitadel/internal/eventstore"
 	"github.com/zitadel/zitadel/internal/eventstore/handler/v2"
+	"github.com/zitadel/zitadel/internal/i18n"
 	"github.com/zitadel/zitadel/internal/notification/types"
 	"github.com/zitadel/zitadel/internal/query"
+	"github.com/zitadel/zitadel/internal/repository/idp"
+	"github.com/zitadel/zitadel/internal/repository/instance"
+	"github.com/zitadel/zitadel/internal/repository/org"
 	"github.com/zitadel/zitadel/internal/repository/session"
 	"github.com/zitadel/zitadel/internal/repository/user"
 	"github.com/zitadel/zitadel/internal/zerrors"
@@ -98,6 +105,10 @@ func (u *userNotifier) Reducers() []handler.AggregateReducer {
 					Event:  user.HumanPasswordChangedType,
 					Reduce: u.reducePasswordChanged,
 				},
+				{
+					Event:  user.HumanUsernameRecoveryRequestedType,
+					Reduce: u.reduceUsernameRecoveryRequested,
+				},
 				{
 					Event:  user.HumanOTPSMSCodeAddedType,
 					Reduce: u.reduceOTPSMSCodeAdded,
@@ -106,6 +117,14 @@ func (u *userNotifier) Reducers() []handler.AggregateReducer {
 					Event:  user.HumanOTPEmailCodeAddedType,
 					Reduce: u.reduceOTPEmailCodeAdded,
 				},
+				{
+					Event:  user.UserLifecycleActionScheduledType,
+					Reduce: u.reduceUserLifecycleActionScheduled,
+				},
+				{
+					Event:  user.MachineCredentialExpiringEventType,
+					Reduce: u.reduceMachineCredentialExpiring,
+				},
 			},
 		},
 		{
@@ -121,6 +140,32 @@ func (u *userNotifier) Reducers() []handler.AggregateReducer {
 				},
 			},
 		},
+		{
+			Aggregate: org.AggregateType,
+			EventReducers: []handler.EventReducer{
+				{
+					Event:  org.OrgDomainVerificationExpiredEventType,
+					Reduce: u.reduceDomainVerificationExpired,
+				},
+				{
+					Event:  org.InviteAddedEventType,
+					Reduce: u.reduceInviteAdded,
+				},
+				{
+					Event:  org.IDPHealthCheckFailedEventType,
+					Reduce: u.reduceIDPHealthCheckFailed,
+				},
+			},
+		},
+		{
+			Aggregate: instance.AggregateType,
+			EventReducers: []handler.EventReducer{
+				{
+					Event:  instance.IDPHealthCheckFailedEventType,
+					Reduce: u.reduceIDPHealthCheckFailed,
+				},
+			},
+		},
 	}
 }
 
@@ -131,7 +176,7 @@ func (u *userNotifier) reduceInitCodeAdded(event eventstore.Event) (*handler.Sta
 	}
 
 	return handler.NewStatement(event, func(ex handler.Executer, projectionName string) error {
-		ctx := HandlerContext(event.Aggregate())
+		ctx := HandlerContext(event)
 		alreadyHandled, err := u.checkIfCodeAlreadyHandledOrExpired(ctx, event, e.Expiry, nil,
 			user.UserV1InitialCodeAddedType, user.UserV1InitialCodeSentType,
 			user.HumanInitialCodeAddedType, user.HumanInitialCodeSentType)
@@ -150,7 +195,7 @@ func (u *userNotifier) reduceInitCodeAdded(event eventstore.Event) (*handler.Sta
 			return err
 		}
 
-		template, err := u.queries.MailTemplateByOrg(ctx, e.Aggregate().ResourceOwner, false)
+		template, err := u.queries.GetMailTemplateSet(ctx, e.Aggregate().ResourceOwner)
 		if err != nil {
 			return err
 		}
@@ -168,7 +213,7 @@ func (u *userNotifier) reduceInitCodeAdded(event eventstore.Event) (*handler.Sta
 		if err != nil {
 			return err
 		}
-		err = types.SendEmail(ctx, u.channels, string(template.Template), translator, notifyUser, colors, e).
+		err = types.SendEmail(ctx, u.channels, template, translator, notifyUser, colors, e).
 			SendUserInitCode(ctx, notifyUser, code, e.AuthRequestID)
 		if err != nil {
 			return err
@@ -188,7 +233,7 @@ func (u *userNotifier) reduceEmailCodeAdded(event eventstore.Event) (*handler.St
 	}
 
 	return handler.NewStatement(event, func(ex handler.Executer, projectionName string) error {
-		ctx := HandlerContext(event.Aggregate())
+		ctx := HandlerContext(event)
 		alreadyHandled, err := u.checkIfCodeAlreadyHandledOrExpired(ctx, event, e.Expiry, nil,
 			user.UserV1EmailCodeAddedType, user.UserV1EmailCodeSentType,
 			user.HumanEmailCodeAddedType, user.HumanEmailCodeSentType)
@@ -207,7 +252,7 @@ func (u *userNotifier) reduceEmailCodeAdded(event eventstore.Event) (*handler.St
 			return err
 		}
 
-		template, err := u.queries.MailTemplateByOrg(ctx, e.Aggregate().ResourceOwner, false)
+		template, err := u.queries.GetMailTemplateSet(ctx, e.Aggregate().ResourceOwner)
 		if err != nil {
 			return err
 		}
@@ -225,7 +270,7 @@ func (u *userNotifier) reduceEmailCodeAdded(event eventstore.Event) (*handler.St
 		if err != nil {
 			return err
 		}
-		err = types.SendEmail(ctx, u.channels, string(template.Template), translator, notifyUser, colors, e).
+		err = types.SendEmail(ctx, u.channels, template, translator, notifyUser, colors, e).
 			SendEmailVerificationCode(ctx, notifyUser, code, e.URLTemplate, e.AuthRequestID)
 		if err != nil {
 			return err
@@ -244,7 +289,7 @@ func (u *userNotifier) reducePasswordCodeAdded(event eventstore.Event) (*handler
 	}
 
 	return handler.NewStatement(event, func(ex handler.Executer, projectionName string) error {
-		ctx := HandlerContext(event.Aggregate())
+		ctx := HandlerContext(event)
 		alreadyHandled, err := u.checkIfCodeAlreadyHandledOrExpired(ctx, event, e.Expiry, nil,
 			user.UserV1PasswordCodeAddedType, user.UserV1PasswordCodeSentType,
 			user.HumanPasswordCodeAddedType, user.HumanPasswordCodeSentType)
@@ -263,7 +308,7 @@ func (u *userNotifier) reducePasswordCodeAdded(event eventstore.Event) (*handler
 			return err
 		}
 
-		template, err := u.queries.MailTemplateByOrg(ctx, e.Aggregate().ResourceOwner, false)
+		template, err := u.queries.GetMailTemplateSet(ctx, e.Aggregate().ResourceOwner)
 		if err != nil {
 			return err
 		}
@@ -281,7 +326,7 @@ func (u *userNotifier) reducePasswordCodeAdded(event eventstore.Event) (*handler
 		if err != nil {
 			return err
 		}
-		notify := types.SendEmail(ctx, u.channels, string(template.Template), translator, notifyUser, colors, e)
+		notify := types.SendEmail(ctx, u.channels, template, translator, notifyUser, colors, e)
 		if e.NotificationType == domain.NotificationTypeSms {
 			notify = types.SendSMSTwilio(ctx, u.channels, translator, notifyUser, colors, e)
 		}
@@ -318,7 +363,7 @@ func (u *userNotifier) reduceSessionOTPSMSChallenged(event eventstore.Event) (*h
 	if e.CodeReturned {
 		return handler.NewNoOpStatement(e), nil
 	}
-	ctx := HandlerContext(event.Aggregate())
+	ctx := HandlerContext(event)
 	s, err := u.queries.SessionByID(ctx, true, e.Aggregate().ID, "")
 	if err != nil {
 		return nil, err
@@ -344,7 +389,7 @@ func (u *userNotifier) reduceOTPSMS(
 	sentCommand func(ctx context.Context, userID string, resourceOwner string) (err error),
 	eventTypes ...eventstore.EventType,
 ) (*handler.Statement, error) {
-	ctx := HandlerContext(event.Aggregate())
+	ctx := HandlerContext(event)
 	alreadyHandled, err := u.checkIfCodeAlreadyHandledOrExpired(ctx, event, expiry, nil, eventTypes...)
 	if err != nil {
 		return nil, err
@@ -373,6 +418,13 @@ func (u *userNotifier) reduceOTPSMS(
 	if err != nil {
 		return nil, err
 	}
+	if u.sendOTPPush(ctx, translator, notifyUser, colors, plainCode, expiry, event) {
+		err = sentCommand(ctx, event.Aggregate().ID, event.Aggregate().ResourceOwner)
+		if err != nil {
+			return nil, err
+		}
+		return handler.NewNoOpStatement(event), nil
+	}
 	notify := types.SendSMSTwilio(ctx, u.channels, translator, notifyUser, colors, event)
 	err = notify.SendOTPSMSCode(ctx, plainCode, expiry)
 	if err != nil {
@@ -385,6 +437,28 @@ func (u *userNotifier) reduceOTPSMS(
 	return handler.NewNoOpStatement(event), nil
 }
 
+// sendOTPPush pushes the one-time password to the devices the user registered for push notifications.
+// It returns false if the user has no devices or none of them received the code, so it's sent by SMS instead.
+func (u *userNotifier) sendOTPPush(
+	ctx context.Context,
+	translator *i18n.Translator,
+	notifyUser *query.NotifyUser,
+	colors *query.LabelPolicy,
+	code string,
+	expiry time.Duration,
+	event eventstore.Event,
+) bool {
+	devices, err := u.queries.PushDevices(ctx, notifyUser.ID, notifyUser.ResourceOwner)
+	if err != nil || len(devices) == 0 {
+		logging.WithFields("user", notifyUser.ID).OnError(err).Warn("could not read push devices")
+		return false
+	}
+	notify := types.SendPush(ctx, u.channels, translator, notifyUser, devices, colors, event)
+	err = notify.SendOTPSMSCode(ctx, code, expiry)
+	logging.WithFields("user", notifyUser.ID).OnError(err).Info("otp push notification failed, falling back to sms")
+	return err == nil
+}
+
 func (u *userNotifier) reduceOTPEmailCodeAdded(event eventstore.Event) (*handler.Statement, error) {
 	e, ok := event.(*user.HumanOTPEmailCodeAddedEvent)
 	if !ok {
@@ -418,7 +492,7 @@ func (u *userNotifier) reduceSessionOTPEmailChallenged(event eventstore.Event) (
 	if e.ReturnCode {
 		return handler.NewNoOpStatement(e), nil
 	}
-	ctx := HandlerContext(event.Aggregate())
+	ctx := HandlerContext(event)
 	s, err := u.queries.SessionByID(ctx, true, e.Aggregate().ID, "")
 	if err != nil {
 		return nil, err
@@ -457,7 +531,7 @@ func (u *userNotifier) reduceOTPEmail(
 	sentCommand func(ctx context.Context, userID string, resourceOwner string) (err error),
 	eventTypes ...eventstore.EventType,
 ) (*handler.Statement, error) {
-	ctx := HandlerContext(event.Aggregate())
+	ctx := HandlerContext(event)
 	alreadyHandled, err := u.checkIfCodeAlreadyHandledOrExpired(ctx, event, expiry, nil, eventTypes...)
 	if err != nil {
 		return nil, err
@@ -474,7 +548,7 @@ func (u *userNotifier) reduceOTPEmail(
 		return nil, err
 	}
 
-	template, err := u.queries.MailTemplateByOrg(ctx, resourceOwner, false)
+	template, err := u.queries.GetMailTemplateSet(ctx, resourceOwner)
 	if err != nil {
 		return nil, err
 	}
@@ -495,7 +569,7 @@ func (u *userNotifier) reduceOTPEmail(
 	if err != nil {
 		return nil, err
 	}
-	notify := types.SendEmail(ctx, u.channels, string(template.Template), translator, notifyUser, colors, event)
+	notify := types.SendEmail(ctx, u.channels, template, translator, notifyUser, colors, event)
 	err = notify.SendOTPEmailCode(ctx, url, plainCode, expiry)
 	if err != nil {
 		return nil, err
@@ -513,7 +587,7 @@ func (u *userNotifier) reduceDomainClaimed(event eventstore.Event) (*handler.Sta
 		return nil, zerrors.ThrowInvalidArgumentf(nil, "HANDL-Drh5w", "reduce.wrong.event.type %s", user.UserDomainClaimedType)
 	}
 	return handler.NewStatement(event, func(ex handler.Executer, projectionName string) error {
-		ctx := HandlerContext(event.Aggregate())
+		ctx := HandlerContext(event)
 		alreadyHandled, err := u.queries.IsAlreadyHandled(ctx, event, nil,
 			user.UserDomainClaimedType, user.UserDomainClaimedSentType)
 		if err != nil {
@@ -527,7 +601,7 @@ func (u *userNotifier) reduceDomainClaimed(event eventstore.Event) (*handler.Sta
 			return err
 		}
 
-		template, err := u.queries.MailTemplateByOrg(ctx, e.Aggregate().ResourceOwner, false)
+		template, err := u.queries.GetMailTemplateSet(ctx, e.Aggregate().ResourceOwner)
 		if err != nil {
 			return err
 		}
@@ -545,7 +619,7 @@ func (u *userNotifier) reduceDomainClaimed(event eventstore.Event) (*handler.Sta
 		if err != nil {
 			return err
 		}
-		err = types.SendEmail(ctx, u.channels, string(template.Template), translator, notifyUser, colors, e).
+		err = types.SendEmail(ctx, u.channels, template, translator, notifyUser, colors, e).
 			SendDomainClaimed(ctx, notifyUser, e.UserName)
 		if err != nil {
 			return err
@@ -554,6 +628,332 @@ func (u *userNotifier) reduceDomainClaimed(event eventstore.Event) (*handler.Sta
 	}), nil
 }
 
+func (u *userNotifier) reduceDomainVerificationExpired(event eventstore.Event) (*handler.Statement, error) {
+	e, ok := event.(*org.DomainVerificationExpiredEvent)
+	if !ok {
+		return nil, zerrors.ThrowInvalidArgumentf(nil, "HANDL-Ex2vq", "reduce.wrong.event.type %s", org.OrgDomainVerificationExpiredEventType)
+	}
+	return handler.NewStatement(event, func(ex handler.Executer, projectionName string) error {
+		ctx := HandlerContext(event)
+		alreadyHandled, err := u.queries.IsAlreadyHandled(ctx, event, map[string]interface{}{"domain": e.Domain},
+			org.OrgDomainVerificationExpiredEventType, org.OrgDomainVerificationExpiredSentEventType)
+		if err != nil {
+			return err
+		}
+		if alreadyHandled {
+			return nil
+		}
+		members, err := u.queries.OrgMembers(ctx, &query.OrgMembersQuery{OrgID: e.Aggregate().ID})
+		if err != nil {
+			return err
+		}
+		colors, err := u.queries.ActiveLabelPolicyByOrg(ctx, e.Aggregate().ResourceOwner, false)
+		if err != nil {
+			return err
+		}
+
+		template, err := u.queries.GetMailTemplateSet(ctx, e.Aggregate().ResourceOwner)
+		if err != nil {
+			return err
+		}
+
+		translator, err := u.queries.GetTranslatorWithOrgTexts(ctx, e.Aggregate().ResourceOwner, domain.DomainVerificationExpiredMessageType)
+		if err != nil {
+			return err
+		}
+
+		ctx, err = u.queries.Origin(ctx, e)
+		if err != nil {
+			return err
+		}
+		for _, member := range members.Members {
+			if !slices.Contains(member.Roles, domain.RoleOrgOwner) {
+				continue
+			}
+			notifyUser, err := u.queries.GetNotifyUserByID(ctx, true, member.UserID)
+			if err != nil {
+				return err
+			}
+			// only owners with a verified email are notified
+			if notifyUser.VerifiedEmail == "" {
+				continue
+			}
+			err = types.SendEmail(ctx, u.channels, template, translator, notifyUser, colors, e).
+				SendDomainVerificationExpired(ctx, notifyUser, e.Domain)
+			if err != nil {
+				return err
+			}
+		}
+		return u.commands.OrgDomainVerificationExpiredSent(ctx, e.Aggregate().ID, e.Domain)
+	}), nil
+}
+
+func (u *userNotifier) reduceInviteAdded(event eventstore.Event) (*handler.Statement, error) {
+	e, ok := event.(*org.InviteAddedEvent)
+	if !ok {
+		return nil, zerrors.ThrowInvalidArgumentf(nil, "HANDL-Inv2q", "reduce.wrong.event.type %s", org.InviteAddedEventType)
+	}
+
+	if e.CodeReturned {
+		return handler.NewNoOpStatement(e), nil
+	}
+
+	return handler.NewStatement(event, func(ex handler.Executer, projectionName string) error {
+		ctx := HandlerContext(event)
+		// invites already used or revoked don't have to be sent anymore
+		alreadyHandled, err := u.checkIfCodeAlreadyHandledOrExpired(ctx, event, e.Expiry, map[string]interface{}{"inviteId": e.InviteID},
+			org.InviteSentEventType, org.InviteUsedEventType, org.InviteRevokedEventType)
+		if err != nil {
+			return err
+		}
+		if alreadyHandled {
+			return nil
+		}
+		code, err := crypto.DecryptString(e.Code, u.queries.UserDataCrypto)
+		if err != nil {
+			return err
+		}
+		colors, err := u.queries.ActiveLabelPolicyByOrg(ctx, e.Aggregate().ResourceOwner, false)
+		if err != nil {
+			return err
+		}
+
+		template, err := u.queries.GetMailTemplateSet(ctx, e.Aggregate().ResourceOwner)
+		if err != nil {
+			return err
+		}
+
+		translator, err := u.queries.GetTranslatorWithOrgTexts(ctx, e.Aggregate().ResourceOwner, domain.InviteUserMessageType)
+		if err != nil {
+			return err
+		}
+
+		ctx, err = u.queries.Origin(ctx, e)
+		if err != nil {
+			return err
+		}
+		// the invited person doesn't have a user yet
+		notifyUser := &query.NotifyUser{
+			ResourceOwner: e.Aggregate().ResourceOwner,
+			LastEmail:     string(e.Email),
+		}
+		err = types.SendEmail(ctx, u.channels, template, translator, notifyUser, colors, e).
+			SendInviteUser(ctx, e.Aggregate().ID, e.InviteID, code)
+		if err != nil {
+			return err
+		}
+		return u.commands.OrgInviteSent(ctx, e.Aggregate().ID, e.InviteID)
+	}), nil
+}
+
+func (u *userNotifier) reduceUserLifecycleActionScheduled(event eventstore.Event) (*handler.Statement, error) {
+	e, ok := event.(*user.LifecycleActionScheduledEvent)
+	if !ok {
+		return nil, zerrors.ThrowInvalidArgumentf(nil, "HANDL-Lc3sr", "reduce.wrong.event.type %s", user.UserLifecycleActionScheduledType)
+	}
+	return handler.NewStatement(event, func(ex handler.Executer, projectionName string) error {
+		ctx := HandlerContext(event)
+		alreadyHandled, err := u.queries.IsAlreadyHandled(ctx, event, map[string]interface{}{"action": e.Action},
+			user.UserLifecycleActionScheduledType, user.UserLifecycleActionNotificationSentType)
+		if err != nil {
+			return err
+		}
+		if alreadyHandled {
+			return nil
+		}
+		inactiveUser, err := u.queries.GetNotifyUserByID(ctx, false, e.Aggregate().ID)
+		if err != nil {
+			return err
+		}
+		members, err := u.queries.OrgMembers(ctx, &query.OrgMembersQuery{OrgID: e.Aggregate().ResourceOwner})
+		if err != nil {
+			return err
+		}
+		colors, err := u.queries.ActiveLabelPolicyByOrg(ctx, e.Aggregate().ResourceOwner, false)
+		if err != nil {
+			return err
+		}
+
+		template, err := u.queries.GetMailTemplateSet(ctx, e.Aggregate().ResourceOwner)
+		if err != nil {
+			return err
+		}
+
+		translator, err := u.queries.GetTranslatorWithOrgTexts(ctx, e.Aggregate().ResourceOwner, e.Action.MessageType())
+		if err != nil {
+			return err
+		}
+
+		ctx, err = u.queries.Origin(ctx, e)
+		if err != nil {
+			return err
+		}
+		for _, member := range members.Members {
+			if !slices.Contains(member.Roles, domain.RoleOrgOwner) {
+				continue
+			}
+			notifyUser, err := u.queries.GetNotifyUserByID(ctx, true, member.UserID)
+			if err != nil {
+				return err
+			}
+			// only owners with a verified email are notified
+			if notifyUser.VerifiedEmail == "" {
+				continue
+			}
+			err = types.SendEmail(ctx, u.channels, template, translator, notifyUser, colors, e).
+				SendUserLifecycleActionScheduled(ctx, notifyUser, e.Action, inactiveUser.PreferredLoginName, e.DueDate)
+			if err != nil {
+				return err
+			}
+		}
+		return u.commands.UserLifecycleActionNotificationSent(ctx, e.Aggregate().ResourceOwner, e.Aggregate().ID, e.Action)
+	}), nil
+}
+
+func (u *userNotifier) reduceMachineCredentialExpiring(event eventstore.Event) (*handler.Statement, error) {
+	e, ok := event.(*user.MachineCredentialExpiringEvent)
+	if !ok {
+		return nil, zerrors.ThrowInvalidArgumentf(nil, "HANDL-Mc5ex", "reduce.wrong.event.type %s", user.MachineCredentialExpiringEventType)
+	}
+	return handler.NewStatement(event, func(ex handler.Executer, projectionName string) error {
+		ctx := HandlerContext(event)
+		alreadyHandled, err := u.queries.IsAlreadyHandled(ctx, event, map[string]interface{}{"credentialId": e.CredentialID},
+			user.MachineCredentialExpiringEventType, user.MachineCredentialExpiringNotificationSentEventType)
+		if err != nil {
+			return err
+		}
+		if alreadyHandled {
+			return nil
+		}
+		machineUser, err := u.queries.GetNotifyUserByID(ctx, false, e.Aggregate().ID)
+		if err != nil {
+			return err
+		}
+		members, err := u.queries.OrgMembers(ctx, &query.OrgMembersQuery{OrgID: e.Aggregate().ResourceOwner})
+		if err != nil {
+			return err
+		}
+		colors, err := u.queries.ActiveLabelPolicyByOrg(ctx, e.Aggregate().ResourceOwner, false)
+		if err != nil {
+			return err
+		}
+
+		template, err := u.queries.GetMailTemplateSet(ctx, e.Aggregate().ResourceOwner)
+		if err != nil {
+			return err
+		}
+
+		translator, err := u.queries.GetTranslatorWithOrgTexts(ctx, e.Aggregate().ResourceOwner, domain.MachineCredentialExpiringMessageType)
+		if err != nil {
+			return err
+		}
+
+		ctx, err = u.queries.Origin(ctx, e)
+		if err != nil {
+			return err
+		}
+		for _, member := range members.Members {
+			if !slices.Contains(member.Roles, domain.RoleOrgOwner) {
+				continue
+			}
+			notifyUser, err := u.queries.GetNotifyUserByID(ctx, true, member.UserID)
+			if err != nil {
+				return err
+			}
+			// only owners with a verified email are notified
+			if notifyUser.VerifiedEmail == "" {
+				continue
+			}
+			err = types.SendEmail(ctx, u.channels, template, translator, notifyUser, colors, e).
+				SendMachineCredentialExpiring(ctx, notifyUser, machineUser.PreferredLoginName, e.CredentialID, e.ExpirationDate)
+			if err != nil {
+				return err
+			}
+		}
+		return u.commands.MachineCredentialExpiringNotificationSent(ctx, e.Aggregate().ResourceOwner, e.Aggregate().ID, e.CredentialID)
+	}), nil
+}
+
+func (u *userNotifier) reduceIDPHealthCheckFailed(event eventstore.Event) (*handler.Statement, error) {
+	var (
+		e                    *idp.HealthCheckFailedEvent
+		failedType, sentType eventstore.EventType
+		ownerRole            string
+	)
+	switch event := event.(type) {
+	case *org.IDPHealthCheckFailedEvent:
+		e = &event.HealthCheckFailedEvent
+		failedType, sentType = org.IDPHealthCheckFailedEventType, org.IDPHealthCheckFailedNotificationSentEventType
+		ownerRole = domain.RoleOrgOwner
+	case *instance.IDPHealthCheckFailedEvent:
+		e = &event.HealthCheckFailedEvent
+		failedType, sentType = instance.IDPHealthCheckFailedEventType, instance.IDPHealthCheckFailedNotificationSentEventType
+		ownerRole = domain.RoleIAMOwner
+	default:
+		return nil, zerrors.ThrowInvalidArgumentf(nil, "HANDL-Ih4fE", "reduce.wrong.event.type %v", []eventstore.EventType{org.IDPHealthCheckFailedEventType, instance.IDPHealthCheckFailedEventType})
+	}
+	return handler.NewStatement(event, func(ex handler.Executer, projectionName string) error {
+		ctx := HandlerContext(event)
+		alreadyHandled, err := u.queries.IsAlreadyHandled(ctx, event, map[string]interface{}{"id": e.ID}, failedType, sentType)
+		if err != nil {
+			return err
+		}
+		if alreadyHandled {
+			return nil
+		}
+		members, err := u.idpOwners(ctx, event)
+		if err != nil {
+			return err
+		}
+		colors, err := u.queries.ActiveLabelPolicyByOrg(ctx, e.Aggregate().ResourceOwner, false)
+		if err != nil {
+			return err
+		}
+
+		template, err := u.queries.GetMailTemplateSet(ctx, e.Aggregate().ResourceOwner)
+		if err != nil {
+			return err
+		}
+
+		translator, err := u.queries.GetTranslatorWithOrgTexts(ctx, e.Aggregate().ResourceOwner, domain.IDPHealthCheckFailedMessageType)
+		if err != nil {
+			return err
+		}
+
+		ctx, err = u.queries.Origin(ctx, event)
+		if err != nil {
+			return err
+		}
+		for _, member := range members.Members {
+			if !slices.Contains(member.Roles, ownerRole) {
+				continue
+			}
+			notifyUser, err := u.queries.GetNotifyUserByID(ctx, true, member.UserID)
+			if err != nil {
+				return err
+			}
+			// only owners with a verified email are notified
+			if notifyUser.VerifiedEmail == "" {
+				continue
+			}
+			err = types.SendEmail(ctx, u.channels, template, translator, notifyUser, colors, event).
+				SendIDPHealthCheckFailed(ctx, notifyUser, e.ID, e.Name, e.Checks)
+			if err != nil {
+				return err
+			}
+		}
+		return u.commands.IDPHealthCheckFailedNotificationSent(ctx, e.Aggregate().ResourceOwner, e.ID)
+	}), nil
+}
+
+// idpOwners returns the members of the organization or instance the identity provider belongs to
+func (u *userNotifier) idpOwners(ctx context.Context, event eventstore.Event) (*query.Members, error) {
+	if event.Aggregate().Type == instance.AggregateType {
+		return u.queries.IAMMembers(ctx, &query.IAMMembersQuery{})
+	}
+	return u.queries.OrgMembers(ctx, &query.OrgMembersQuery{OrgID: event.Aggregate().ResourceOwner})
+}
+
 func (u *userNotifier) reducePasswordlessCodeRequested(event eventstore.Event) (*handler.Statement, error) {
 	e, ok := event.(*user.HumanPasswordlessInitCodeRequestedEvent)
 	if !ok {
@@ -564,7 +964,7 @@ func (u *userNotifier) reducePasswordlessCodeRequested(event eventstore.Event) (
 	}
 
 	return handler.NewStatement(event, func(ex handler.Executer, projectionName string) error {
-		ctx := HandlerContext(event.Aggregate())
+		ctx := HandlerContext(event)
 		alreadyHandled, err := u.checkIfCodeAlreadyHandledOrExpired(ctx, event, e.Expiry, map[string]interface{}{"id": e.ID}, user.HumanPasswordlessInitCodeSentType)
 		if err != nil {
 			return err
@@ -581,7 +981,7 @@ func (u *userNotifier) reducePasswordlessCodeRequested(event eventstore.Event) (
 			return err
 		}
 
-		template, err := u.queries.MailTemplateByOrg(ctx, e.Aggregate().ResourceOwner, false)
+		template, err := u.queries.GetMailTemplateSet(ctx, e.Aggregate().ResourceOwner)
 		if err != nil {
 			return err
 		}
@@ -599,7 +999,7 @@ func (u *userNotifier) reducePasswordlessCodeRequested(event eventstore.Event) (
 		if err != nil {
 			return err
 		}
-		err = types.SendEmail(ctx, u.channels, string(template.Template), translator, notifyUser, colors, e).
+		err = types.SendEmail(ctx, u.channels, template, translator, notifyUser, colors, e).
 			SendPasswordlessRegistrationLink(ctx, notifyUser, code, e.ID, e.URLTemplate)
 		if err != nil {
 			return err
@@ -615,7 +1015,7 @@ func (u *userNotifier) reducePasswordChanged(event eventstore.Event) (*handler.S
 	}
 
 	return handler.NewStatement(event, func(ex handler.Executer, projectionName string) error {
-		ctx := HandlerContext(event.Aggregate())
+		ctx := HandlerContext(event)
 		alreadyHandled, err := u.queries.IsAlreadyHandled(ctx, event, nil, user.HumanPasswordChangeSentType)
 		if err != nil {
 			return err
@@ -641,7 +1041,7 @@ func (u *userNotifier) reducePasswordChanged(event eventstore.Event) (*handler.S
 			return err
 		}
 
-		template, err := u.queries.MailTemplateByOrg(ctx, e.Aggregate().ResourceOwner, false)
+		template, err := u.queries.GetMailTemplateSet(ctx, e.Aggregate().ResourceOwner)
 		if err != nil {
 			return err
 		}
@@ -658,7 +1058,7 @@ func (u *userNotifier) reducePasswordChanged(event eventstore.Event) (*handler.S
 		if err != nil {
 			return err
 		}
-		err = types.SendEmail(ctx, u.channels, string(template.Template), translator, notifyUser, colors, e).
+		err = types.SendEmail(ctx, u.channels, template, translator, notifyUser, colors, e).
 			SendPasswordChange(ctx, notifyUser)
 		if err != nil {
 			return err
@@ -667,6 +1067,55 @@ func (u *userNotifier) reducePasswordChanged(event eventstore.Event) (*handler.S
 	}), nil
 }
 
+func (u *userNotifier) reduceUsernameRecoveryRequested(event eventstore.Event) (*handler.Statement, error) {
+	e, ok := event.(*user.HumanUsernameRecoveryRequestedEvent)
+	if !ok {
+		return nil, zerrors.ThrowInvalidArgumentf(nil, "HANDL-Urc8d", "reduce.wrong.event.type %s", user.HumanUsernameRecoveryRequestedType)
+	}
+
+	return handler.NewStatement(event, func(ex handler.Executer, projectionName string) error {
+		ctx := HandlerContext(event)
+		alreadyHandled, err := u.queries.IsAlreadyHandled(ctx, event, nil, user.HumanUsernameRecoverySentType)
+		if err != nil {
+			return err
+		}
+		if alreadyHandled {
+			return nil
+		}
+		colors, err := u.queries.ActiveLabelPolicyByOrg(ctx, e.Aggregate().ResourceOwner, false)
+		if err != nil {
+			return err
+		}
+
+		template, err := u.queries.GetMailTemplateSet(ctx, e.Aggregate().ResourceOwner)
+		if err != nil {
+			return err
+		}
+
+		notifyUser, err := u.queries.GetNotifyUserByID(ctx, true, e.Aggregate().ID)
+		if err != nil {
+			return err
+		}
+		translator, err := u.queries.GetTranslatorWithOrgTexts(ctx, notifyUser.ResourceOwner, domain.UsernameRecoveryMessageType)
+		if err != nil {
+			return err
+		}
+		ctx, err = u.queries.Origin(ctx, e)
+		if err != nil {
+			return err
+		}
+		notify := types.SendEmail(ctx, u.channels, template, translator, notifyUser, colors, e)
+		if e.NotificationType == domain.NotificationTypeSms {
+			notify = types.SendSMSTwilio(ctx, u.channels, translator, notifyUser, colors, e)
+		}
+		err = notify.SendUsernameRecovery(ctx, notifyUser)
+		if err != nil {
+			return err
+		}
+		return u.commands.UsernameRecoverySent(ctx, e.Aggregate().ResourceOwner, e.Aggregate().ID)
+	}), nil
+}
+
 func (u *userNotifier) reducePhoneCodeAdded(event eventstore.Event) (*handler.Statement, error) {
 	e, ok := event.(*user.HumanPhoneCodeAddedEvent)
 	if !ok {
@@ -677,7 +1126,7 @@ func (u *userNotifier) reducePhoneCodeAdded(event eventstore.Event) (*handler.St
 	}
 
 	return handler.NewStatement(event, func(ex handler.Executer, projectionName string) error {
-		ctx := HandlerContext(event.Aggregate())
+		ctx := HandlerContext(event)
 		alreadyHandled, err := u.checkIfCodeAlreadyHandledOrExpired(ctx, event, e.Expiry, nil,
 			user.UserV1PhoneCodeAddedType, user.UserV1PhoneCodeSentType,
 			user.HumanPhoneCodeAddedType, user.HumanPhoneCodeSentType)
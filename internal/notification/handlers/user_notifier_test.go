@@ -12,18 +12,22 @@ import (
 	"golang.org/x/text/language"
 
 	"github.com/zitadel/zitadel/internal/crypto"
+	"github.com/zitadel/zitadel/internal/database"
+	"github.com/zitadel/zitadel/internal/domain"
 	"github.com/zitadel/zitadel/internal/eventstore"
 	"github.com/zitadel/zitadel/internal/eventstore/repository"
 	es_repo_mock "github.com/zitadel/zitadel/internal/eventstore/repository/mock"
+	"github.com/zitadel/zitadel/internal/notification/channels/email"
 	channel_mock "github.com/zitadel/zitadel/internal/notification/channels/mock"
-	"github.com/zitadel/zitadel/internal/notification/channels/smtp"
-	"github.com/zitadel/zitadel/internal/notification/channels/twilio"
 	"github.com/zitadel/zitadel/internal/notification/channels/webhook"
 	"github.com/zitadel/zitadel/internal/notification/handlers/mock"
 	"github.com/zitadel/zitadel/internal/notification/messages"
 	"github.com/zitadel/zitadel/internal/notification/senders"
 	"github.com/zitadel/zitadel/internal/notification/types"
 	"github.com/zitadel/zitadel/internal/query"
+	"github.com/zitadel/zitadel/internal/repository/idp"
+	"github.com/zitadel/zitadel/internal/repository/instance"
+	"github.com/zitadel/zitadel/internal/repository/org"
 	"github.com/zitadel/zitadel/internal/repository/session"
 	"github.com/zitadel/zitadel/internal/repository/user"
 )
@@ -67,24 +71,24 @@ func Test_userNotifier_reduceInitCodeAdded(t *testing.T) {
 			expectTemplateQueries(queries, givenTemplate)
 			commands.EXPECT().HumanInitCodeSent(gomock.Any(), orgID, userID).Return(nil)
 			return fields{
-					queries:  queries,
-					commands: commands,
-					es: eventstore.NewEventstore(&eventstore.Config{
-						Querier: es_repo_mock.NewRepo(t).ExpectFilterEvents().MockQuerier,
+				queries:  queries,
+				commands: commands,
+				es: eventstore.NewEventstore(&eventstore.Config{
+					Querier: es_repo_mock.NewRepo(t).ExpectFilterEvents().MockQuerier,
+				}),
+				userDataCrypto: codeAlg,
+			}, args{
+				event: &user.HumanInitialCodeAddedEvent{
+					BaseEvent: *eventstore.BaseEventFromRepo(&repository.Event{
+						AggregateID:   userID,
+						ResourceOwner: sql.NullString{String: orgID},
+						CreationDate:  time.Now().UTC(),
 					}),
-					userDataCrypto: codeAlg,
-				}, args{
-					event: &user.HumanInitialCodeAddedEvent{
-						BaseEvent: *eventstore.BaseEventFromRepo(&repository.Event{
-							AggregateID:   userID,
-							ResourceOwner: sql.NullString{String: orgID},
-							CreationDate:  time.Now().UTC(),
-						}),
-						Code:              code,
-						Expiry:            time.Hour,
-						TriggeredAtOrigin: eventOrigin,
-					},
-				}, w
+					Code:              code,
+					Expiry:            time.Hour,
+					TriggeredAtOrigin: eventOrigin,
+				},
+			}, w
 		},
 	}, {
 		name: "asset url without event trigger url",
@@ -106,23 +110,23 @@ func Test_userNotifier_reduceInitCodeAdded(t *testing.T) {
 			expectTemplateQueries(queries, givenTemplate)
 			commands.EXPECT().HumanInitCodeSent(gomock.Any(), orgID, userID).Return(nil)
 			return fields{
-					queries:  queries,
-					commands: commands,
-					es: eventstore.NewEventstore(&eventstore.Config{
-						Querier: es_repo_mock.NewRepo(t).ExpectFilterEvents().MockQuerier,
+				queries:  queries,
+				commands: commands,
+				es: eventstore.NewEventstore(&eventstore.Config{
+					Querier: es_repo_mock.NewRepo(t).ExpectFilterEvents().MockQuerier,
+				}),
+				userDataCrypto: codeAlg,
+			}, args{
+				event: &user.HumanInitialCodeAddedEvent{
+					BaseEvent: *eventstore.BaseEventFromRepo(&repository.Event{
+						AggregateID:   userID,
+						ResourceOwner: sql.NullString{String: orgID},
+						CreationDate:  time.Now().UTC(),
 					}),
-					userDataCrypto: codeAlg,
-				}, args{
-					event: &user.HumanInitialCodeAddedEvent{
-						BaseEvent: *eventstore.BaseEventFromRepo(&repository.Event{
-							AggregateID:   userID,
-							ResourceOwner: sql.NullString{String: orgID},
-							CreationDate:  time.Now().UTC(),
-						}),
-						Code:   code,
-						Expiry: time.Hour,
-					},
-				}, w
+					Code:   code,
+					Expiry: time.Hour,
+				},
+			}, w
 		},
 	}, {
 		name: "button url with event trigger url",
@@ -139,24 +143,24 @@ func Test_userNotifier_reduceInitCodeAdded(t *testing.T) {
 			expectTemplateQueries(queries, givenTemplate)
 			commands.EXPECT().HumanInitCodeSent(gomock.Any(), orgID, userID).Return(nil)
 			return fields{
-					queries:  queries,
-					commands: commands,
-					es: eventstore.NewEventstore(&eventstore.Config{
-						Querier: es_repo_mock.NewRepo(t).ExpectFilterEvents().MockQuerier,
+				queries:  queries,
+				commands: commands,
+				es: eventstore.NewEventstore(&eventstore.Config{
+					Querier: es_repo_mock.NewRepo(t).ExpectFilterEvents().MockQuerier,
+				}),
+				userDataCrypto: codeAlg,
+			}, args{
+				event: &user.HumanInitialCodeAddedEvent{
+					BaseEvent: *eventstore.BaseEventFromRepo(&repository.Event{
+						AggregateID:   userID,
+						ResourceOwner: sql.NullString{String: orgID},
+						CreationDate:  time.Now().UTC(),
 					}),
-					userDataCrypto: codeAlg,
-				}, args{
-					event: &user.HumanInitialCodeAddedEvent{
-						BaseEvent: *eventstore.BaseEventFromRepo(&repository.Event{
-							AggregateID:   userID,
-							ResourceOwner: sql.NullString{String: orgID},
-							CreationDate:  time.Now().UTC(),
-						}),
-						Code:              code,
-						Expiry:            time.Hour,
-						TriggeredAtOrigin: eventOrigin,
-					},
-				}, w
+					Code:              code,
+					Expiry:            time.Hour,
+					TriggeredAtOrigin: eventOrigin,
+				},
+			}, w
 		},
 	}, {
 		name: "button url without event trigger url",
@@ -179,23 +183,23 @@ func Test_userNotifier_reduceInitCodeAdded(t *testing.T) {
 			expectTemplateQueries(queries, givenTemplate)
 			commands.EXPECT().HumanInitCodeSent(gomock.Any(), orgID, userID).Return(nil)
 			return fields{
-					queries:  queries,
-					commands: commands,
-					es: eventstore.NewEventstore(&eventstore.Config{
-						Querier: es_repo_mock.NewRepo(t).ExpectFilterEvents().MockQuerier,
+				queries:  queries,
+				commands: commands,
+				es: eventstore.NewEventstore(&eventstore.Config{
+					Querier: es_repo_mock.NewRepo(t).ExpectFilterEvents().MockQuerier,
+				}),
+				userDataCrypto: codeAlg,
+			}, args{
+				event: &user.HumanInitialCodeAddedEvent{
+					BaseEvent: *eventstore.BaseEventFromRepo(&repository.Event{
+						AggregateID:   userID,
+						ResourceOwner: sql.NullString{String: orgID},
+						CreationDate:  time.Now().UTC(),
 					}),
-					userDataCrypto: codeAlg,
-				}, args{
-					event: &user.HumanInitialCodeAddedEvent{
-						BaseEvent: *eventstore.BaseEventFromRepo(&repository.Event{
-							AggregateID:   userID,
-							ResourceOwner: sql.NullString{String: orgID},
-							CreationDate:  time.Now().UTC(),
-						}),
-						Code:   code,
-						Expiry: time.Hour,
-					},
-				}, w
+					Code:   code,
+					Expiry: time.Hour,
+				},
+			}, w
 		},
 	}, {
 		name: "button url without event trigger url with authRequestID",
@@ -218,24 +222,24 @@ func Test_userNotifier_reduceInitCodeAdded(t *testing.T) {
 			expectTemplateQueries(queries, givenTemplate)
 			commands.EXPECT().HumanInitCodeSent(gomock.Any(), orgID, userID).Return(nil)
 			return fields{
-					queries:  queries,
-					commands: commands,
-					es: eventstore.NewEventstore(&eventstore.Config{
-						Querier: es_repo_mock.NewRepo(t).ExpectFilterEvents().MockQuerier,
+				queries:  queries,
+				commands: commands,
+				es: eventstore.NewEventstore(&eventstore.Config{
+					Querier: es_repo_mock.NewRepo(t).ExpectFilterEvents().MockQuerier,
+				}),
+				userDataCrypto: codeAlg,
+			}, args{
+				event: &user.HumanInitialCodeAddedEvent{
+					BaseEvent: *eventstore.BaseEventFromRepo(&repository.Event{
+						AggregateID:   userID,
+						ResourceOwner: sql.NullString{String: orgID},
+						CreationDate:  time.Now().UTC(),
 					}),
-					userDataCrypto: codeAlg,
-				}, args{
-					event: &user.HumanInitialCodeAddedEvent{
-						BaseEvent: *eventstore.BaseEventFromRepo(&repository.Event{
-							AggregateID:   userID,
-							ResourceOwner: sql.NullString{String: orgID},
-							CreationDate:  time.Now().UTC(),
-						}),
-						Code:          code,
-						Expiry:        time.Hour,
-						AuthRequestID: authRequestID,
-					},
-				}, w
+					Code:          code,
+					Expiry:        time.Hour,
+					AuthRequestID: authRequestID,
+				},
+			}, w
 		},
 	}}
 	// TODO: Why don't we have an url template on user.HumanInitialCodeAddedEvent?
@@ -280,26 +284,26 @@ func Test_userNotifier_reduceEmailCodeAdded(t *testing.T) {
 			expectTemplateQueries(queries, givenTemplate)
 			commands.EXPECT().HumanEmailVerificationCodeSent(gomock.Any(), orgID, userID).Return(nil)
 			return fields{
-					queries:  queries,
-					commands: commands,
-					es: eventstore.NewEventstore(&eventstore.Config{
-						Querier: es_repo_mock.NewRepo(t).ExpectFilterEvents().MockQuerier,
+				queries:  queries,
+				commands: commands,
+				es: eventstore.NewEventstore(&eventstore.Config{
+					Querier: es_repo_mock.NewRepo(t).ExpectFilterEvents().MockQuerier,
+				}),
+				userDataCrypto: codeAlg,
+			}, args{
+				event: &user.HumanEmailCodeAddedEvent{
+					BaseEvent: *eventstore.BaseEventFromRepo(&repository.Event{
+						AggregateID:   userID,
+						ResourceOwner: sql.NullString{String: orgID},
+						CreationDate:  time.Now().UTC(),
 					}),
-					userDataCrypto: codeAlg,
-				}, args{
-					event: &user.HumanEmailCodeAddedEvent{
-						BaseEvent: *eventstore.BaseEventFromRepo(&repository.Event{
-							AggregateID:   userID,
-							ResourceOwner: sql.NullString{String: orgID},
-							CreationDate:  time.Now().UTC(),
-						}),
-						Code:              code,
-						Expiry:            time.Hour,
-						URLTemplate:       "",
-						CodeReturned:      false,
-						TriggeredAtOrigin: eventOrigin,
-					},
-				}, w
+					Code:              code,
+					Expiry:            time.Hour,
+					URLTemplate:       "",
+					CodeReturned:      false,
+					TriggeredAtOrigin: eventOrigin,
+				},
+			}, w
 		},
 	}, {
 		name: "asset url without event trigger url",
@@ -321,25 +325,25 @@ func Test_userNotifier_reduceEmailCodeAdded(t *testing.T) {
 			expectTemplateQueries(queries, givenTemplate)
 			commands.EXPECT().HumanEmailVerificationCodeSent(gomock.Any(), orgID, userID).Return(nil)
 			return fields{
-					queries:  queries,
-					commands: commands,
-					es: eventstore.NewEventstore(&eventstore.Config{
-						Querier: es_repo_mock.NewRepo(t).ExpectFilterEvents().MockQuerier,
+				queries:  queries,
+				commands: commands,
+				es: eventstore.NewEventstore(&eventstore.Config{
+					Querier: es_repo_mock.NewRepo(t).ExpectFilterEvents().MockQuerier,
+				}),
+				userDataCrypto: codeAlg,
+			}, args{
+				event: &user.HumanEmailCodeAddedEvent{
+					BaseEvent: *eventstore.BaseEventFromRepo(&repository.Event{
+						AggregateID:   userID,
+						ResourceOwner: sql.NullString{String: orgID},
+						CreationDate:  time.Now().UTC(),
 					}),
-					userDataCrypto: codeAlg,
-				}, args{
-					event: &user.HumanEmailCodeAddedEvent{
-						BaseEvent: *eventstore.BaseEventFromRepo(&repository.Event{
-							AggregateID:   userID,
-							ResourceOwner: sql.NullString{String: orgID},
-							CreationDate:  time.Now().UTC(),
-						}),
-						Code:         code,
-						Expiry:       time.Hour,
-						URLTemplate:  "",
-						CodeReturned: false,
-					},
-				}, w
+					Code:         code,
+					Expiry:       time.Hour,
+					URLTemplate:  "",
+					CodeReturned: false,
+				},
+			}, w
 		},
 	}, {
 		name: "button url with event trigger url",
@@ -356,27 +360,27 @@ func Test_userNotifier_reduceEmailCodeAdded(t *testing.T) {
 			expectTemplateQueries(queries, givenTemplate)
 			commands.EXPECT().HumanEmailVerificationCodeSent(gomock.Any(), orgID, userID).Return(nil)
 			return fields{
-					queries:  queries,
-					commands: commands,
-					es: eventstore.NewEventstore(&eventstore.Config{
-						Querier: es_repo_mock.NewRepo(t).ExpectFilterEvents().MockQuerier,
+				queries:  queries,
+				commands: commands,
+				es: eventstore.NewEventstore(&eventstore.Config{
+					Querier: es_repo_mock.NewRepo(t).ExpectFilterEvents().MockQuerier,
+				}),
+				userDataCrypto: codeAlg,
+				SMSTokenCrypto: nil,
+			}, args{
+				event: &user.HumanEmailCodeAddedEvent{
+					BaseEvent: *eventstore.BaseEventFromRepo(&repository.Event{
+						AggregateID:   userID,
+						ResourceOwner: sql.NullString{String: orgID},
+						CreationDate:  time.Now().UTC(),
 					}),
-					userDataCrypto: codeAlg,
-					SMSTokenCrypto: nil,
-				}, args{
-					event: &user.HumanEmailCodeAddedEvent{
-						BaseEvent: *eventstore.BaseEventFromRepo(&repository.Event{
-							AggregateID:   userID,
-							ResourceOwner: sql.NullString{String: orgID},
-							CreationDate:  time.Now().UTC(),
-						}),
-						Code:              code,
-						Expiry:            time.Hour,
-						URLTemplate:       "",
-						CodeReturned:      false,
-						TriggeredAtOrigin: eventOrigin,
-					},
-				}, w
+					Code:              code,
+					Expiry:            time.Hour,
+					URLTemplate:       "",
+					CodeReturned:      false,
+					TriggeredAtOrigin: eventOrigin,
+				},
+			}, w
 		},
 	}, {
 		name: "button url without event trigger url",
@@ -399,25 +403,25 @@ func Test_userNotifier_reduceEmailCodeAdded(t *testing.T) {
 			expectTemplateQueries(queries, givenTemplate)
 			commands.EXPECT().HumanEmailVerificationCodeSent(gomock.Any(), orgID, userID).Return(nil)
 			return fields{
-					queries:  queries,
-					commands: commands,
-					es: eventstore.NewEventstore(&eventstore.Config{
-						Querier: es_repo_mock.NewRepo(t).ExpectFilterEvents().MockQuerier,
+				queries:  queries,
+				commands: commands,
+				es: eventstore.NewEventstore(&eventstore.Config{
+					Querier: es_repo_mock.NewRepo(t).ExpectFilterEvents().MockQuerier,
+				}),
+				userDataCrypto: codeAlg,
+			}, args{
+				event: &user.HumanEmailCodeAddedEvent{
+					BaseEvent: *eventstore.BaseEventFromRepo(&repository.Event{
+						AggregateID:   userID,
+						ResourceOwner: sql.NullString{String: orgID},
+						CreationDate:  time.Now().UTC(),
 					}),
-					userDataCrypto: codeAlg,
-				}, args{
-					event: &user.HumanEmailCodeAddedEvent{
-						BaseEvent: *eventstore.BaseEventFromRepo(&repository.Event{
-							AggregateID:   userID,
-							ResourceOwner: sql.NullString{String: orgID},
-							CreationDate:  time.Now().UTC(),
-						}),
-						Code:         code,
-						Expiry:       time.Hour,
-						URLTemplate:  "",
-						CodeReturned: false,
-					},
-				}, w
+					Code:         code,
+					Expiry:       time.Hour,
+					URLTemplate:  "",
+					CodeReturned: false,
+				},
+			}, w
 		},
 	}, {
 		name: "button url without event trigger url with authRequestID",
@@ -440,26 +444,26 @@ func Test_userNotifier_reduceEmailCodeAdded(t *testing.T) {
 			expectTemplateQueries(queries, givenTemplate)
 			commands.EXPECT().HumanEmailVerificationCodeSent(gomock.Any(), orgID, userID).Return(nil)
 			return fields{
-					queries:  queries,
-					commands: commands,
-					es: eventstore.NewEventstore(&eventstore.Config{
-						Querier: es_repo_mock.NewRepo(t).ExpectFilterEvents().MockQuerier,
+				queries:  queries,
+				commands: commands,
+				es: eventstore.NewEventstore(&eventstore.Config{
+					Querier: es_repo_mock.NewRepo(t).ExpectFilterEvents().MockQuerier,
+				}),
+				userDataCrypto: codeAlg,
+			}, args{
+				event: &user.HumanEmailCodeAddedEvent{
+					BaseEvent: *eventstore.BaseEventFromRepo(&repository.Event{
+						AggregateID:   userID,
+						ResourceOwner: sql.NullString{String: orgID},
+						CreationDate:  time.Now().UTC(),
 					}),
-					userDataCrypto: codeAlg,
-				}, args{
-					event: &user.HumanEmailCodeAddedEvent{
-						BaseEvent: *eventstore.BaseEventFromRepo(&repository.Event{
-							AggregateID:   userID,
-							ResourceOwner: sql.NullString{String: orgID},
-							CreationDate:  time.Now().UTC(),
-						}),
-						Code:          code,
-						Expiry:        time.Hour,
-						URLTemplate:   "",
-						CodeReturned:  false,
-						AuthRequestID: authRequestID,
-					},
-				}, w
+					Code:          code,
+					Expiry:        time.Hour,
+					URLTemplate:   "",
+					CodeReturned:  false,
+					AuthRequestID: authRequestID,
+				},
+			}, w
 		},
 	}, {
 		name: "button url with url template and event trigger url",
@@ -477,27 +481,27 @@ func Test_userNotifier_reduceEmailCodeAdded(t *testing.T) {
 			expectTemplateQueries(queries, givenTemplate)
 			commands.EXPECT().HumanEmailVerificationCodeSent(gomock.Any(), orgID, userID).Return(nil)
 			return fields{
-					queries:  queries,
-					commands: commands,
-					es: eventstore.NewEventstore(&eventstore.Config{
-						Querier: es_repo_mock.NewRepo(t).ExpectFilterEvents().MockQuerier,
+				queries:  queries,
+				commands: commands,
+				es: eventstore.NewEventstore(&eventstore.Config{
+					Querier: es_repo_mock.NewRepo(t).ExpectFilterEvents().MockQuerier,
+				}),
+				userDataCrypto: codeAlg,
+				SMSTokenCrypto: nil,
+			}, args{
+				event: &user.HumanEmailCodeAddedEvent{
+					BaseEvent: *eventstore.BaseEventFromRepo(&repository.Event{
+						AggregateID:   userID,
+						ResourceOwner: sql.NullString{String: orgID},
+						CreationDate:  time.Now().UTC(),
 					}),
-					userDataCrypto: codeAlg,
-					SMSTokenCrypto: nil,
-				}, args{
-					event: &user.HumanEmailCodeAddedEvent{
-						BaseEvent: *eventstore.BaseEventFromRepo(&repository.Event{
-							AggregateID:   userID,
-							ResourceOwner: sql.NullString{String: orgID},
-							CreationDate:  time.Now().UTC(),
-						}),
-						Code:              code,
-						Expiry:            time.Hour,
-						URLTemplate:       urlTemplate,
-						CodeReturned:      false,
-						TriggeredAtOrigin: eventOrigin,
-					},
-				}, w
+					Code:              code,
+					Expiry:            time.Hour,
+					URLTemplate:       urlTemplate,
+					CodeReturned:      false,
+					TriggeredAtOrigin: eventOrigin,
+				},
+			}, w
 		},
 	}}
 	for _, tt := range tests {
@@ -541,26 +545,26 @@ func Test_userNotifier_reducePasswordCodeAdded(t *testing.T) {
 			expectTemplateQueries(queries, givenTemplate)
 			commands.EXPECT().PasswordCodeSent(gomock.Any(), orgID, userID).Return(nil)
 			return fields{
-					queries:  queries,
-					commands: commands,
-					es: eventstore.NewEventstore(&eventstore.Config{
-						Querier: es_repo_mock.NewRepo(t).ExpectFilterEvents().MockQuerier,
+				queries:  queries,
+				commands: commands,
+				es: eventstore.NewEventstore(&eventstore.Config{
+					Querier: es_repo_mock.NewRepo(t).ExpectFilterEvents().MockQuerier,
+				}),
+				userDataCrypto: codeAlg,
+			}, args{
+				event: &user.HumanPasswordCodeAddedEvent{
+					BaseEvent: *eventstore.BaseEventFromRepo(&repository.Event{
+						AggregateID:   userID,
+						ResourceOwner: sql.NullString{String: orgID},
+						CreationDate:  time.Now().UTC(),
 					}),
-					userDataCrypto: codeAlg,
-				}, args{
-					event: &user.HumanPasswordCodeAddedEvent{
-						BaseEvent: *eventstore.BaseEventFromRepo(&repository.Event{
-							AggregateID:   userID,
-							ResourceOwner: sql.NullString{String: orgID},
-							CreationDate:  time.Now().UTC(),
-						}),
-						Code:              code,
-						Expiry:            time.Hour,
-						URLTemplate:       "",
-						CodeReturned:      false,
-						TriggeredAtOrigin: eventOrigin,
-					},
-				}, w
+					Code:              code,
+					Expiry:            time.Hour,
+					URLTemplate:       "",
+					CodeReturned:      false,
+					TriggeredAtOrigin: eventOrigin,
+				},
+			}, w
 		},
 	}, {
 		name: "asset url without event trigger url",
@@ -582,25 +586,25 @@ func Test_userNotifier_reducePasswordCodeAdded(t *testing.T) {
 			expectTemplateQueries(queries, givenTemplate)
 			commands.EXPECT().PasswordCodeSent(gomock.Any(), orgID, userID).Return(nil)
 			return fields{
-					queries:  queries,
-					commands: commands,
-					es: eventstore.NewEventstore(&eventstore.Config{
-						Querier: es_repo_mock.NewRepo(t).ExpectFilterEvents().MockQuerier,
+				queries:  queries,
+				commands: commands,
+				es: eventstore.NewEventstore(&eventstore.Config{
+					Querier: es_repo_mock.NewRepo(t).ExpectFilterEvents().MockQuerier,
+				}),
+				userDataCrypto: codeAlg,
+			}, args{
+				event: &user.HumanPasswordCodeAddedEvent{
+					BaseEvent: *eventstore.BaseEventFromRepo(&repository.Event{
+						AggregateID:   userID,
+						ResourceOwner: sql.NullString{String: orgID},
+						CreationDate:  time.Now().UTC(),
 					}),
-					userDataCrypto: codeAlg,
-				}, args{
-					event: &user.HumanPasswordCodeAddedEvent{
-						BaseEvent: *eventstore.BaseEventFromRepo(&repository.Event{
-							AggregateID:   userID,
-							ResourceOwner: sql.NullString{String: orgID},
-							CreationDate:  time.Now().UTC(),
-						}),
-						Code:         code,
-						Expiry:       time.Hour,
-						URLTemplate:  "",
-						CodeReturned: false,
-					},
-				}, w
+					Code:         code,
+					Expiry:       time.Hour,
+					URLTemplate:  "",
+					CodeReturned: false,
+				},
+			}, w
 		},
 	}, {
 		name: "button url with event trigger url",
@@ -617,27 +621,27 @@ func Test_userNotifier_reducePasswordCodeAdded(t *testing.T) {
 			expectTemplateQueries(queries, givenTemplate)
 			commands.EXPECT().PasswordCodeSent(gomock.Any(), orgID, userID).Return(nil)
 			return fields{
-					queries:  queries,
-					commands: commands,
-					es: eventstore.NewEventstore(&eventstore.Config{
-						Querier: es_repo_mock.NewRepo(t).ExpectFilterEvents().MockQuerier,
+				queries:  queries,
+				commands: commands,
+				es: eventstore.NewEventstore(&eventstore.Config{
+					Querier: es_repo_mock.NewRepo(t).ExpectFilterEvents().MockQuerier,
+				}),
+				userDataCrypto: codeAlg,
+				SMSTokenCrypto: nil,
+			}, args{
+				event: &user.HumanPasswordCodeAddedEvent{
+					BaseEvent: *eventstore.BaseEventFromRepo(&repository.Event{
+						AggregateID:   userID,
+						ResourceOwner: sql.NullString{String: orgID},
+						CreationDate:  time.Now().UTC(),
 					}),
-					userDataCrypto: codeAlg,
-					SMSTokenCrypto: nil,
-				}, args{
-					event: &user.HumanPasswordCodeAddedEvent{
-						BaseEvent: *eventstore.BaseEventFromRepo(&repository.Event{
-							AggregateID:   userID,
-							ResourceOwner: sql.NullString{String: orgID},
-							CreationDate:  time.Now().UTC(),
-						}),
-						Code:              code,
-						Expiry:            time.Hour,
-						URLTemplate:       "",
-						CodeReturned:      false,
-						TriggeredAtOrigin: eventOrigin,
-					},
-				}, w
+					Code:              code,
+					Expiry:            time.Hour,
+					URLTemplate:       "",
+					CodeReturned:      false,
+					TriggeredAtOrigin: eventOrigin,
+				},
+			}, w
 		},
 	}, {
 		name: "button url without event trigger url",
@@ -660,25 +664,25 @@ func Test_userNotifier_reducePasswordCodeAdded(t *testing.T) {
 			expectTemplateQueries(queries, givenTemplate)
 			commands.EXPECT().PasswordCodeSent(gomock.Any(), orgID, userID).Return(nil)
 			return fields{
-					queries:  queries,
-					commands: commands,
-					es: eventstore.NewEventstore(&eventstore.Config{
-						Querier: es_repo_mock.NewRepo(t).ExpectFilterEvents().MockQuerier,
+				queries:  queries,
+				commands: commands,
+				es: eventstore.NewEventstore(&eventstore.Config{
+					Querier: es_repo_mock.NewRepo(t).ExpectFilterEvents().MockQuerier,
+				}),
+				userDataCrypto: codeAlg,
+			}, args{
+				event: &user.HumanPasswordCodeAddedEvent{
+					BaseEvent: *eventstore.BaseEventFromRepo(&repository.Event{
+						AggregateID:   userID,
+						ResourceOwner: sql.NullString{String: orgID},
+						CreationDate:  time.Now().UTC(),
 					}),
-					userDataCrypto: codeAlg,
-				}, args{
-					event: &user.HumanPasswordCodeAddedEvent{
-						BaseEvent: *eventstore.BaseEventFromRepo(&repository.Event{
-							AggregateID:   userID,
-							ResourceOwner: sql.NullString{String: orgID},
-							CreationDate:  time.Now().UTC(),
-						}),
-						Code:         code,
-						Expiry:       time.Hour,
-						URLTemplate:  "",
-						CodeReturned: false,
-					},
-				}, w
+					Code:         code,
+					Expiry:       time.Hour,
+					URLTemplate:  "",
+					CodeReturned: false,
+				},
+			}, w
 		},
 	}, {
 		name: "button url without event trigger url with authRequestID",
@@ -701,26 +705,26 @@ func Test_userNotifier_reducePasswordCodeAdded(t *testing.T) {
 			expectTemplateQueries(queries, givenTemplate)
 			commands.EXPECT().PasswordCodeSent(gomock.Any(), orgID, userID).Return(nil)
 			return fields{
-					queries:  queries,
-					commands: commands,
-					es: eventstore.NewEventstore(&eventstore.Config{
-						Querier: es_repo_mock.NewRepo(t).ExpectFilterEvents().MockQuerier,
+				queries:  queries,
+				commands: commands,
+				es: eventstore.NewEventstore(&eventstore.Config{
+					Querier: es_repo_mock.NewRepo(t).ExpectFilterEvents().MockQuerier,
+				}),
+				userDataCrypto: codeAlg,
+			}, args{
+				event: &user.HumanPasswordCodeAddedEvent{
+					BaseEvent: *eventstore.BaseEventFromRepo(&repository.Event{
+						AggregateID:   userID,
+						ResourceOwner: sql.NullString{String: orgID},
+						CreationDate:  time.Now().UTC(),
 					}),
-					userDataCrypto: codeAlg,
-				}, args{
-					event: &user.HumanPasswordCodeAddedEvent{
-						BaseEvent: *eventstore.BaseEventFromRepo(&repository.Event{
-							AggregateID:   userID,
-							ResourceOwner: sql.NullString{String: orgID},
-							CreationDate:  time.Now().UTC(),
-						}),
-						Code:          code,
-						Expiry:        time.Hour,
-						URLTemplate:   "",
-						CodeReturned:  false,
-						AuthRequestID: authRequestID,
-					},
-				}, w
+					Code:          code,
+					Expiry:        time.Hour,
+					URLTemplate:   "",
+					CodeReturned:  false,
+					AuthRequestID: authRequestID,
+				},
+			}, w
 		},
 	}, {
 		name: "button url with url template and event trigger url",
@@ -738,27 +742,27 @@ func Test_userNotifier_reducePasswordCodeAdded(t *testing.T) {
 			expectTemplateQueries(queries, givenTemplate)
 			commands.EXPECT().PasswordCodeSent(gomock.Any(), orgID, userID).Return(nil)
 			return fields{
-					queries:  queries,
-					commands: commands,
-					es: eventstore.NewEventstore(&eventstore.Config{
-						Querier: es_repo_mock.NewRepo(t).ExpectFilterEvents().MockQuerier,
+				queries:  queries,
+				commands: commands,
+				es: eventstore.NewEventstore(&eventstore.Config{
+					Querier: es_repo_mock.NewRepo(t).ExpectFilterEvents().MockQuerier,
+				}),
+				userDataCrypto: codeAlg,
+				SMSTokenCrypto: nil,
+			}, args{
+				event: &user.HumanPasswordCodeAddedEvent{
+					BaseEvent: *eventstore.BaseEventFromRepo(&repository.Event{
+						AggregateID:   userID,
+						ResourceOwner: sql.NullString{String: orgID},
+						CreationDate:  time.Now().UTC(),
 					}),
-					userDataCrypto: codeAlg,
-					SMSTokenCrypto: nil,
-				}, args{
-					event: &user.HumanPasswordCodeAddedEvent{
-						BaseEvent: *eventstore.BaseEventFromRepo(&repository.Event{
-							AggregateID:   userID,
-							ResourceOwner: sql.NullString{String: orgID},
-							CreationDate:  time.Now().UTC(),
-						}),
-						Code:              code,
-						Expiry:            time.Hour,
-						URLTemplate:       urlTemplate,
-						CodeReturned:      false,
-						TriggeredAtOrigin: eventOrigin,
-					},
-				}, w
+					Code:              code,
+					Expiry:            time.Hour,
+					URLTemplate:       urlTemplate,
+					CodeReturned:      false,
+					TriggeredAtOrigin: eventOrigin,
+				},
+			}, w
 		},
 	}}
 	for _, tt := range tests {
@@ -801,21 +805,21 @@ func Test_userNotifier_reduceDomainClaimed(t *testing.T) {
 			expectTemplateQueries(queries, givenTemplate)
 			commands.EXPECT().UserDomainClaimedSent(gomock.Any(), orgID, userID).Return(nil)
 			return fields{
-					queries:  queries,
-					commands: commands,
-					es: eventstore.NewEventstore(&eventstore.Config{
-						Querier: es_repo_mock.NewRepo(t).ExpectFilterEvents().MockQuerier,
+				queries:  queries,
+				commands: commands,
+				es: eventstore.NewEventstore(&eventstore.Config{
+					Querier: es_repo_mock.NewRepo(t).ExpectFilterEvents().MockQuerier,
+				}),
+			}, args{
+				event: &user.DomainClaimedEvent{
+					BaseEvent: *eventstore.BaseEventFromRepo(&repository.Event{
+						AggregateID:   userID,
+						ResourceOwner: sql.NullString{String: orgID},
+						CreationDate:  time.Now().UTC(),
 					}),
-				}, args{
-					event: &user.DomainClaimedEvent{
-						BaseEvent: *eventstore.BaseEventFromRepo(&repository.Event{
-							AggregateID:   userID,
-							ResourceOwner: sql.NullString{String: orgID},
-							CreationDate:  time.Now().UTC(),
-						}),
-						TriggeredAtOrigin: eventOrigin,
-					},
-				}, w
+					TriggeredAtOrigin: eventOrigin,
+				},
+			}, w
 		},
 	}, {
 		name: "asset url without event trigger url",
@@ -836,20 +840,365 @@ func Test_userNotifier_reduceDomainClaimed(t *testing.T) {
 			expectTemplateQueries(queries, givenTemplate)
 			commands.EXPECT().UserDomainClaimedSent(gomock.Any(), orgID, userID).Return(nil)
 			return fields{
-					queries:  queries,
-					commands: commands,
-					es: eventstore.NewEventstore(&eventstore.Config{
-						Querier: es_repo_mock.NewRepo(t).ExpectFilterEvents().MockQuerier,
+				queries:  queries,
+				commands: commands,
+				es: eventstore.NewEventstore(&eventstore.Config{
+					Querier: es_repo_mock.NewRepo(t).ExpectFilterEvents().MockQuerier,
+				}),
+			}, args{
+				event: &user.DomainClaimedEvent{
+					BaseEvent: *eventstore.BaseEventFromRepo(&repository.Event{
+						AggregateID:   userID,
+						ResourceOwner: sql.NullString{String: orgID},
+						CreationDate:  time.Now().UTC(),
+					}),
+				},
+			}, w
+		},
+	}}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			queries := mock.NewMockQueries(ctrl)
+			commands := mock.NewMockCommands(ctrl)
+			f, a, w := tt.test(ctrl, queries, commands)
+			stmt, err := newUserNotifier(t, ctrl, queries, f, a, w).reduceDomainClaimed(a.event)
+			if w.err != nil {
+				w.err(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+			err = stmt.Execute(nil, "")
+			if w.err != nil {
+				w.err(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func Test_userNotifier_reduceDomainVerificationExpired(t *testing.T) {
+	expectMailSubject := "Domain verification expired"
+	tests := []struct {
+		name string
+		test func(*gomock.Controller, *mock.MockQueries, *mock.MockCommands) (fields, args, want)
+	}{{
+		name: "org owner notified",
+		test: func(ctrl *gomock.Controller, queries *mock.MockQueries, commands *mock.MockCommands) (f fields, a args, w want) {
+			givenTemplate := "{{.LogoURL}}"
+			expectContent := fmt.Sprintf("%s://%s:%d%s/%s/%s", externalProtocol, instancePrimaryDomain, externalPort, assetsPath, policyID, logoURL)
+			w.message = messages.Email{
+				Recipients: []string{verifiedEmail},
+				Subject:    expectMailSubject,
+				Content:    expectContent,
+			}
+			queries.EXPECT().OrgMembers(gomock.Any(), &query.OrgMembersQuery{OrgID: orgID}).Return(&query.Members{
+				Members: []*query.Member{
+					{UserID: userID, Roles: database.TextArray[string]{domain.RoleOrgOwner}},
+					{UserID: "user2", Roles: database.TextArray[string]{"ORG_USER_MANAGER"}},
+				},
+			}, nil)
+			queries.EXPECT().SearchInstanceDomains(gomock.Any(), gomock.Any()).Return(&query.InstanceDomains{
+				Domains: []*query.InstanceDomain{{
+					Domain:    instancePrimaryDomain,
+					IsPrimary: true,
+				}},
+			}, nil)
+			expectTemplateQueries(queries, givenTemplate)
+			commands.EXPECT().OrgDomainVerificationExpiredSent(gomock.Any(), orgID, "domain.ch").Return(nil)
+			return fields{
+				queries:  queries,
+				commands: commands,
+				es: eventstore.NewEventstore(&eventstore.Config{
+					Querier: es_repo_mock.NewRepo(t).ExpectFilterEvents().MockQuerier,
+				}),
+			}, args{
+				event: &org.DomainVerificationExpiredEvent{
+					BaseEvent: *eventstore.BaseEventFromRepo(&repository.Event{
+						AggregateID:   orgID,
+						ResourceOwner: sql.NullString{String: orgID},
+						CreationDate:  time.Now().UTC(),
 					}),
-				}, args{
-					event: &user.DomainClaimedEvent{
+					Domain: "domain.ch",
+				},
+			}, w
+		},
+	}}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			queries := mock.NewMockQueries(ctrl)
+			commands := mock.NewMockCommands(ctrl)
+			f, a, w := tt.test(ctrl, queries, commands)
+			stmt, err := newUserNotifier(t, ctrl, queries, f, a, w).reduceDomainVerificationExpired(a.event)
+			if w.err != nil {
+				w.err(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+			err = stmt.Execute(nil, "")
+			if w.err != nil {
+				w.err(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func Test_userNotifier_reduceUserLifecycleActionScheduled(t *testing.T) {
+	expectMailSubject := "User will be deleted"
+	tests := []struct {
+		name string
+		test func(*gomock.Controller, *mock.MockQueries, *mock.MockCommands) (fields, args, want)
+	}{{
+		name: "org owner notified",
+		test: func(ctrl *gomock.Controller, queries *mock.MockQueries, commands *mock.MockCommands) (f fields, a args, w want) {
+			givenTemplate := "{{.LogoURL}}"
+			expectContent := fmt.Sprintf("%s://%s:%d%s/%s/%s", externalProtocol, instancePrimaryDomain, externalPort, assetsPath, policyID, logoURL)
+			w.message = messages.Email{
+				Recipients: []string{verifiedEmail},
+				Subject:    expectMailSubject,
+				Content:    expectContent,
+			}
+			queries.EXPECT().GetNotifyUserByID(gomock.Any(), false, "inactive").Return(&query.NotifyUser{
+				ID:                 "inactive",
+				ResourceOwner:      orgID,
+				PreferredLoginName: "inactive@domain.ch",
+			}, nil)
+			queries.EXPECT().OrgMembers(gomock.Any(), &query.OrgMembersQuery{OrgID: orgID}).Return(&query.Members{
+				Members: []*query.Member{
+					{UserID: userID, Roles: database.TextArray[string]{domain.RoleOrgOwner}},
+					{UserID: "user2", Roles: database.TextArray[string]{"ORG_USER_MANAGER"}},
+				},
+			}, nil)
+			queries.EXPECT().SearchInstanceDomains(gomock.Any(), gomock.Any()).Return(&query.InstanceDomains{
+				Domains: []*query.InstanceDomain{{
+					Domain:    instancePrimaryDomain,
+					IsPrimary: true,
+				}},
+			}, nil)
+			expectTemplateQueries(queries, givenTemplate)
+			commands.EXPECT().UserLifecycleActionNotificationSent(gomock.Any(), orgID, "inactive", domain.UserLifecycleActionDelete).Return(nil)
+			return fields{
+				queries:  queries,
+				commands: commands,
+				es: eventstore.NewEventstore(&eventstore.Config{
+					Querier: es_repo_mock.NewRepo(t).ExpectFilterEvents().MockQuerier,
+				}),
+			}, args{
+				event: &user.LifecycleActionScheduledEvent{
+					BaseEvent: *eventstore.BaseEventFromRepo(&repository.Event{
+						AggregateID:   "inactive",
+						ResourceOwner: sql.NullString{String: orgID},
+						CreationDate:  time.Now().UTC(),
+					}),
+					Action:  domain.UserLifecycleActionDelete,
+					DueDate: time.Now().Add(24 * time.Hour),
+				},
+			}, w
+		},
+	}}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			queries := mock.NewMockQueries(ctrl)
+			commands := mock.NewMockCommands(ctrl)
+			f, a, w := tt.test(ctrl, queries, commands)
+			stmt, err := newUserNotifier(t, ctrl, queries, f, a, w).reduceUserLifecycleActionScheduled(a.event)
+			if w.err != nil {
+				w.err(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+			err = stmt.Execute(nil, "")
+			if w.err != nil {
+				w.err(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func Test_userNotifier_reduceMachineCredentialExpiring(t *testing.T) {
+	expectMailSubject := "Machine credential expires soon"
+	tests := []struct {
+		name string
+		test func(*gomock.Controller, *mock.MockQueries, *mock.MockCommands) (fields, args, want)
+	}{{
+		name: "org owner notified",
+		test: func(ctrl *gomock.Controller, queries *mock.MockQueries, commands *mock.MockCommands) (f fields, a args, w want) {
+			givenTemplate := "{{.LogoURL}}"
+			expectContent := fmt.Sprintf("%s://%s:%d%s/%s/%s", externalProtocol, instancePrimaryDomain, externalPort, assetsPath, policyID, logoURL)
+			w.message = messages.Email{
+				Recipients: []string{verifiedEmail},
+				Subject:    expectMailSubject,
+				Content:    expectContent,
+			}
+			queries.EXPECT().GetNotifyUserByID(gomock.Any(), false, "machine").Return(&query.NotifyUser{
+				ID:                 "machine",
+				ResourceOwner:      orgID,
+				PreferredLoginName: "machine@domain.ch",
+			}, nil)
+			queries.EXPECT().OrgMembers(gomock.Any(), &query.OrgMembersQuery{OrgID: orgID}).Return(&query.Members{
+				Members: []*query.Member{
+					{UserID: userID, Roles: database.TextArray[string]{domain.RoleOrgOwner}},
+					{UserID: "user2", Roles: database.TextArray[string]{"ORG_USER_MANAGER"}},
+				},
+			}, nil)
+			queries.EXPECT().SearchInstanceDomains(gomock.Any(), gomock.Any()).Return(&query.InstanceDomains{
+				Domains: []*query.InstanceDomain{{
+					Domain:    instancePrimaryDomain,
+					IsPrimary: true,
+				}},
+			}, nil)
+			expectTemplateQueries(queries, givenTemplate)
+			commands.EXPECT().MachineCredentialExpiringNotificationSent(gomock.Any(), orgID, "machine", "key1").Return(nil)
+			return fields{
+				queries:  queries,
+				commands: commands,
+				es: eventstore.NewEventstore(&eventstore.Config{
+					Querier: es_repo_mock.NewRepo(t).ExpectFilterEvents().MockQuerier,
+				}),
+			}, args{
+				event: &user.MachineCredentialExpiringEvent{
+					BaseEvent: *eventstore.BaseEventFromRepo(&repository.Event{
+						AggregateID:   "machine",
+						ResourceOwner: sql.NullString{String: orgID},
+						CreationDate:  time.Now().UTC(),
+					}),
+					CredentialType: domain.MachineCredentialTypeKey,
+					CredentialID:   "key1",
+					ExpirationDate: time.Now().Add(24 * time.Hour),
+				},
+			}, w
+		},
+	}}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			queries := mock.NewMockQueries(ctrl)
+			commands := mock.NewMockCommands(ctrl)
+			f, a, w := tt.test(ctrl, queries, commands)
+			stmt, err := newUserNotifier(t, ctrl, queries, f, a, w).reduceMachineCredentialExpiring(a.event)
+			if w.err != nil {
+				w.err(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+			err = stmt.Execute(nil, "")
+			if w.err != nil {
+				w.err(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func Test_userNotifier_reduceIDPHealthCheckFailed(t *testing.T) {
+	expectMailSubject := "Identity provider check failed"
+	checks := []*domain.IDPHealthCheck{{
+		Type:   domain.IDPHealthCheckTypeMetadata,
+		Target: "https://idp.domain.ch/.well-known/openid-configuration",
+		Error:  "unexpected status 404 Not Found",
+	}}
+	tests := []struct {
+		name string
+		test func(*gomock.Controller, *mock.MockQueries, *mock.MockCommands) (fields, args, want)
+	}{{
+		name: "org owner notified",
+		test: func(ctrl *gomock.Controller, queries *mock.MockQueries, commands *mock.MockCommands) (f fields, a args, w want) {
+			givenTemplate := "{{.LogoURL}}"
+			expectContent := fmt.Sprintf("%s://%s:%d%s/%s/%s", externalProtocol, instancePrimaryDomain, externalPort, assetsPath, policyID, logoURL)
+			w.message = messages.Email{
+				Recipients: []string{verifiedEmail},
+				Subject:    expectMailSubject,
+				Content:    expectContent,
+			}
+			queries.EXPECT().OrgMembers(gomock.Any(), &query.OrgMembersQuery{OrgID: orgID}).Return(&query.Members{
+				Members: []*query.Member{
+					{UserID: userID, Roles: database.TextArray[string]{domain.RoleOrgOwner}},
+					{UserID: "user2", Roles: database.TextArray[string]{"ORG_USER_MANAGER"}},
+				},
+			}, nil)
+			queries.EXPECT().SearchInstanceDomains(gomock.Any(), gomock.Any()).Return(&query.InstanceDomains{
+				Domains: []*query.InstanceDomain{{
+					Domain:    instancePrimaryDomain,
+					IsPrimary: true,
+				}},
+			}, nil)
+			expectTemplateQueries(queries, givenTemplate)
+			commands.EXPECT().IDPHealthCheckFailedNotificationSent(gomock.Any(), orgID, "idp1").Return(nil)
+			return fields{
+				queries:  queries,
+				commands: commands,
+				es: eventstore.NewEventstore(&eventstore.Config{
+					Querier: es_repo_mock.NewRepo(t).ExpectFilterEvents().MockQuerier,
+				}),
+			}, args{
+				event: &org.IDPHealthCheckFailedEvent{
+					HealthCheckFailedEvent: idp.HealthCheckFailedEvent{
 						BaseEvent: *eventstore.BaseEventFromRepo(&repository.Event{
-							AggregateID:   userID,
+							AggregateID:   orgID,
+							AggregateType: org.AggregateType,
 							ResourceOwner: sql.NullString{String: orgID},
 							CreationDate:  time.Now().UTC(),
 						}),
+						ID:     "idp1",
+						Name:   "idp",
+						Checks: checks,
 					},
-				}, w
+				},
+			}, w
+		},
+	}, {
+		name: "instance owner notified",
+		test: func(ctrl *gomock.Controller, queries *mock.MockQueries, commands *mock.MockCommands) (f fields, a args, w want) {
+			givenTemplate := "{{.LogoURL}}"
+			expectContent := fmt.Sprintf("%s://%s:%d%s/%s/%s", externalProtocol, instancePrimaryDomain, externalPort, assetsPath, policyID, logoURL)
+			w.message = messages.Email{
+				Recipients: []string{verifiedEmail},
+				Subject:    expectMailSubject,
+				Content:    expectContent,
+			}
+			queries.EXPECT().IAMMembers(gomock.Any(), &query.IAMMembersQuery{}).Return(&query.Members{
+				Members: []*query.Member{
+					{UserID: userID, Roles: database.TextArray[string]{domain.RoleIAMOwner}},
+					{UserID: "user2", Roles: database.TextArray[string]{domain.RoleOrgOwner}},
+				},
+			}, nil)
+			queries.EXPECT().SearchInstanceDomains(gomock.Any(), gomock.Any()).Return(&query.InstanceDomains{
+				Domains: []*query.InstanceDomain{{
+					Domain:    instancePrimaryDomain,
+					IsPrimary: true,
+				}},
+			}, nil)
+			expectTemplateQueries(queries, givenTemplate)
+			commands.EXPECT().IDPHealthCheckFailedNotificationSent(gomock.Any(), "instance1", "idp1").Return(nil)
+			return fields{
+				queries:  queries,
+				commands: commands,
+				es: eventstore.NewEventstore(&eventstore.Config{
+					Querier: es_repo_mock.NewRepo(t).ExpectFilterEvents().MockQuerier,
+				}),
+			}, args{
+				event: &instance.IDPHealthCheckFailedEvent{
+					HealthCheckFailedEvent: idp.HealthCheckFailedEvent{
+						BaseEvent: *eventstore.BaseEventFromRepo(&repository.Event{
+							AggregateID:   "instance1",
+							AggregateType: instance.AggregateType,
+							ResourceOwner: sql.NullString{String: "instance1"},
+							CreationDate:  time.Now().UTC(),
+						}),
+						ID:     "idp1",
+						Name:   "idp",
+						Checks: checks,
+					},
+				},
+			}, w
 		},
 	}}
 	for _, tt := range tests {
@@ -858,7 +1207,87 @@ func Test_userNotifier_reduceDomainClaimed(t *testing.T) {
 			queries := mock.NewMockQueries(ctrl)
 			commands := mock.NewMockCommands(ctrl)
 			f, a, w := tt.test(ctrl, queries, commands)
-			stmt, err := newUserNotifier(t, ctrl, queries, f, a, w).reduceDomainClaimed(a.event)
+			stmt, err := newUserNotifier(t, ctrl, queries, f, a, w).reduceIDPHealthCheckFailed(a.event)
+			if w.err != nil {
+				w.err(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+			err = stmt.Execute(nil, "")
+			if w.err != nil {
+				w.err(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func Test_userNotifier_reduceInviteAdded(t *testing.T) {
+	expectMailSubject := "You have been invited"
+	tests := []struct {
+		name string
+		test func(*gomock.Controller, *mock.MockQueries, *mock.MockCommands) (fields, args, want)
+	}{{
+		name: "invite sent to email",
+		test: func(ctrl *gomock.Controller, queries *mock.MockQueries, commands *mock.MockCommands) (f fields, a args, w want) {
+			givenTemplate := "{{.LogoURL}}"
+			expectContent := fmt.Sprintf("%s://%s:%d%s/%s/%s", externalProtocol, instancePrimaryDomain, externalPort, assetsPath, policyID, logoURL)
+			w.message = messages.Email{
+				Recipients: []string{"invited@email.com"},
+				Subject:    expectMailSubject,
+				Content:    expectContent,
+			}
+			codeAlg, code := cryptoValue(t, ctrl, "testcode")
+			queries.EXPECT().SearchInstanceDomains(gomock.Any(), gomock.Any()).Return(&query.InstanceDomains{
+				Domains: []*query.InstanceDomain{{
+					Domain:    instancePrimaryDomain,
+					IsPrimary: true,
+				}},
+			}, nil)
+			queries.EXPECT().GetInstanceRestrictions(gomock.Any()).Return(query.Restrictions{
+				AllowedLanguages: []language.Tag{language.English},
+			}, nil)
+			queries.EXPECT().ActiveLabelPolicyByOrg(gomock.Any(), gomock.Any(), gomock.Any()).Return(&query.LabelPolicy{
+				ID: policyID,
+				Light: query.Theme{
+					LogoURL: logoURL,
+				},
+			}, nil)
+			queries.EXPECT().MailTemplateByOrg(gomock.Any(), gomock.Any(), gomock.Any()).Return(&query.MailTemplate{Template: []byte(givenTemplate)}, nil)
+			queries.EXPECT().NotificationTemplates(gomock.Any()).Return(&query.NotificationTemplates{}, nil)
+			queries.EXPECT().GetDefaultLanguage(gomock.Any()).Return(language.English)
+			queries.EXPECT().CustomTextListByTemplate(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Times(2).Return(&query.CustomTexts{}, nil)
+			commands.EXPECT().OrgInviteSent(gomock.Any(), orgID, "invite1").Return(nil)
+			return fields{
+				queries:  queries,
+				commands: commands,
+				es: eventstore.NewEventstore(&eventstore.Config{
+					Querier: es_repo_mock.NewRepo(t).ExpectFilterEvents().MockQuerier,
+				}),
+				userDataCrypto: codeAlg,
+			}, args{
+				event: &org.InviteAddedEvent{
+					BaseEvent: *eventstore.BaseEventFromRepo(&repository.Event{
+						AggregateID:   orgID,
+						ResourceOwner: sql.NullString{String: orgID},
+						CreationDate:  time.Now().UTC(),
+					}),
+					InviteID: "invite1",
+					Email:    "invited@email.com",
+					Code:     code,
+					Expiry:   time.Hour,
+				},
+			}, w
+		},
+	}}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			queries := mock.NewMockQueries(ctrl)
+			commands := mock.NewMockCommands(ctrl)
+			f, a, w := tt.test(ctrl, queries, commands)
+			stmt, err := newUserNotifier(t, ctrl, queries, f, a, w).reduceInviteAdded(a.event)
 			if w.err != nil {
 				w.err(t, err)
 			} else {
@@ -893,27 +1322,27 @@ func Test_userNotifier_reducePasswordlessCodeRequested(t *testing.T) {
 			expectTemplateQueries(queries, givenTemplate)
 			commands.EXPECT().HumanPasswordlessInitCodeSent(gomock.Any(), userID, orgID, codeID).Return(nil)
 			return fields{
-					queries:  queries,
-					commands: commands,
-					es: eventstore.NewEventstore(&eventstore.Config{
-						Querier: es_repo_mock.NewRepo(t).ExpectFilterEvents().MockQuerier,
+				queries:  queries,
+				commands: commands,
+				es: eventstore.NewEventstore(&eventstore.Config{
+					Querier: es_repo_mock.NewRepo(t).ExpectFilterEvents().MockQuerier,
+				}),
+				userDataCrypto: codeAlg,
+			}, args{
+				event: &user.HumanPasswordlessInitCodeRequestedEvent{
+					BaseEvent: *eventstore.BaseEventFromRepo(&repository.Event{
+						AggregateID:   userID,
+						ResourceOwner: sql.NullString{String: orgID},
+						CreationDate:  time.Now().UTC(),
 					}),
-					userDataCrypto: codeAlg,
-				}, args{
-					event: &user.HumanPasswordlessInitCodeRequestedEvent{
-						BaseEvent: *eventstore.BaseEventFromRepo(&repository.Event{
-							AggregateID:   userID,
-							ResourceOwner: sql.NullString{String: orgID},
-							CreationDate:  time.Now().UTC(),
-						}),
-						ID:                codeID,
-						Code:              code,
-						Expiry:            time.Hour,
-						URLTemplate:       "",
-						CodeReturned:      false,
-						TriggeredAtOrigin: eventOrigin,
-					},
-				}, w
+					ID:                codeID,
+					Code:              code,
+					Expiry:            time.Hour,
+					URLTemplate:       "",
+					CodeReturned:      false,
+					TriggeredAtOrigin: eventOrigin,
+				},
+			}, w
 		},
 	}, {
 		name: "asset url without event trigger url",
@@ -935,26 +1364,26 @@ func Test_userNotifier_reducePasswordlessCodeRequested(t *testing.T) {
 			expectTemplateQueries(queries, givenTemplate)
 			commands.EXPECT().HumanPasswordlessInitCodeSent(gomock.Any(), userID, orgID, codeID).Return(nil)
 			return fields{
-					queries:  queries,
-					commands: commands,
-					es: eventstore.NewEventstore(&eventstore.Config{
-						Querier: es_repo_mock.NewRepo(t).ExpectFilterEvents().MockQuerier,
+				queries:  queries,
+				commands: commands,
+				es: eventstore.NewEventstore(&eventstore.Config{
+					Querier: es_repo_mock.NewRepo(t).ExpectFilterEvents().MockQuerier,
+				}),
+				userDataCrypto: codeAlg,
+			}, args{
+				event: &user.HumanPasswordlessInitCodeRequestedEvent{
+					BaseEvent: *eventstore.BaseEventFromRepo(&repository.Event{
+						AggregateID:   userID,
+						ResourceOwner: sql.NullString{String: orgID},
+						CreationDate:  time.Now().UTC(),
 					}),
-					userDataCrypto: codeAlg,
-				}, args{
-					event: &user.HumanPasswordlessInitCodeRequestedEvent{
-						BaseEvent: *eventstore.BaseEventFromRepo(&repository.Event{
-							AggregateID:   userID,
-							ResourceOwner: sql.NullString{String: orgID},
-							CreationDate:  time.Now().UTC(),
-						}),
-						ID:           codeID,
-						Code:         code,
-						Expiry:       time.Hour,
-						URLTemplate:  "",
-						CodeReturned: false,
-					},
-				}, w
+					ID:           codeID,
+					Code:         code,
+					Expiry:       time.Hour,
+					URLTemplate:  "",
+					CodeReturned: false,
+				},
+			}, w
 		},
 	}, {
 		name: "button url with event trigger url",
@@ -971,28 +1400,28 @@ func Test_userNotifier_reducePasswordlessCodeRequested(t *testing.T) {
 			expectTemplateQueries(queries, givenTemplate)
 			commands.EXPECT().HumanPasswordlessInitCodeSent(gomock.Any(), userID, orgID, codeID).Return(nil)
 			return fields{
-					queries:  queries,
-					commands: commands,
-					es: eventstore.NewEventstore(&eventstore.Config{
-						Querier: es_repo_mock.NewRepo(t).ExpectFilterEvents().MockQuerier,
+				queries:  queries,
+				commands: commands,
+				es: eventstore.NewEventstore(&eventstore.Config{
+					Querier: es_repo_mock.NewRepo(t).ExpectFilterEvents().MockQuerier,
+				}),
+				userDataCrypto: codeAlg,
+				SMSTokenCrypto: nil,
+			}, args{
+				event: &user.HumanPasswordlessInitCodeRequestedEvent{
+					BaseEvent: *eventstore.BaseEventFromRepo(&repository.Event{
+						AggregateID:   userID,
+						ResourceOwner: sql.NullString{String: orgID},
+						CreationDate:  time.Now().UTC(),
 					}),
-					userDataCrypto: codeAlg,
-					SMSTokenCrypto: nil,
-				}, args{
-					event: &user.HumanPasswordlessInitCodeRequestedEvent{
-						BaseEvent: *eventstore.BaseEventFromRepo(&repository.Event{
-							AggregateID:   userID,
-							ResourceOwner: sql.NullString{String: orgID},
-							CreationDate:  time.Now().UTC(),
-						}),
-						ID:                codeID,
-						Code:              code,
-						Expiry:            time.Hour,
-						URLTemplate:       "",
-						CodeReturned:      false,
-						TriggeredAtOrigin: eventOrigin,
-					},
-				}, w
+					ID:                codeID,
+					Code:              code,
+					Expiry:            time.Hour,
+					URLTemplate:       "",
+					CodeReturned:      false,
+					TriggeredAtOrigin: eventOrigin,
+				},
+			}, w
 		},
 	}, {
 		name: "button url without event trigger url",
@@ -1015,26 +1444,26 @@ func Test_userNotifier_reducePasswordlessCodeRequested(t *testing.T) {
 			expectTemplateQueries(queries, givenTemplate)
 			commands.EXPECT().HumanPasswordlessInitCodeSent(gomock.Any(), userID, orgID, codeID).Return(nil)
 			return fields{
-					queries:  queries,
-					commands: commands,
-					es: eventstore.NewEventstore(&eventstore.Config{
-						Querier: es_repo_mock.NewRepo(t).ExpectFilterEvents().MockQuerier,
+				queries:  queries,
+				commands: commands,
+				es: eventstore.NewEventstore(&eventstore.Config{
+					Querier: es_repo_mock.NewRepo(t).ExpectFilterEvents().MockQuerier,
+				}),
+				userDataCrypto: codeAlg,
+			}, args{
+				event: &user.HumanPasswordlessInitCodeRequestedEvent{
+					BaseEvent: *eventstore.BaseEventFromRepo(&repository.Event{
+						AggregateID:   userID,
+						ResourceOwner: sql.NullString{String: orgID},
+						CreationDate:  time.Now().UTC(),
 					}),
-					userDataCrypto: codeAlg,
-				}, args{
-					event: &user.HumanPasswordlessInitCodeRequestedEvent{
-						BaseEvent: *eventstore.BaseEventFromRepo(&repository.Event{
-							AggregateID:   userID,
-							ResourceOwner: sql.NullString{String: orgID},
-							CreationDate:  time.Now().UTC(),
-						}),
-						ID:           codeID,
-						Code:         code,
-						Expiry:       time.Hour,
-						URLTemplate:  "",
-						CodeReturned: false,
-					},
-				}, w
+					ID:           codeID,
+					Code:         code,
+					Expiry:       time.Hour,
+					URLTemplate:  "",
+					CodeReturned: false,
+				},
+			}, w
 		},
 	}, {
 		name: "button url with url template and event trigger url",
@@ -1052,28 +1481,28 @@ func Test_userNotifier_reducePasswordlessCodeRequested(t *testing.T) {
 			expectTemplateQueries(queries, givenTemplate)
 			commands.EXPECT().HumanPasswordlessInitCodeSent(gomock.Any(), userID, orgID, codeID).Return(nil)
 			return fields{
-					queries:  queries,
-					commands: commands,
-					es: eventstore.NewEventstore(&eventstore.Config{
-						Querier: es_repo_mock.NewRepo(t).ExpectFilterEvents().MockQuerier,
+				queries:  queries,
+				commands: commands,
+				es: eventstore.NewEventstore(&eventstore.Config{
+					Querier: es_repo_mock.NewRepo(t).ExpectFilterEvents().MockQuerier,
+				}),
+				userDataCrypto: codeAlg,
+				SMSTokenCrypto: nil,
+			}, args{
+				event: &user.HumanPasswordlessInitCodeRequestedEvent{
+					BaseEvent: *eventstore.BaseEventFromRepo(&repository.Event{
+						AggregateID:   userID,
+						ResourceOwner: sql.NullString{String: orgID},
+						CreationDate:  time.Now().UTC(),
 					}),
-					userDataCrypto: codeAlg,
-					SMSTokenCrypto: nil,
-				}, args{
-					event: &user.HumanPasswordlessInitCodeRequestedEvent{
-						BaseEvent: *eventstore.BaseEventFromRepo(&repository.Event{
-							AggregateID:   userID,
-							ResourceOwner: sql.NullString{String: orgID},
-							CreationDate:  time.Now().UTC(),
-						}),
-						ID:                codeID,
-						Code:              code,
-						Expiry:            time.Hour,
-						URLTemplate:       urlTemplate,
-						CodeReturned:      false,
-						TriggeredAtOrigin: eventOrigin,
-					},
-				}, w
+					ID:                codeID,
+					Code:              code,
+					Expiry:            time.Hour,
+					URLTemplate:       urlTemplate,
+					CodeReturned:      false,
+					TriggeredAtOrigin: eventOrigin,
+				},
+			}, w
 		},
 	}}
 	for _, tt := range tests {
@@ -1119,21 +1548,21 @@ func Test_userNotifier_reducePasswordChanged(t *testing.T) {
 			expectTemplateQueries(queries, givenTemplate)
 			commands.EXPECT().PasswordChangeSent(gomock.Any(), orgID, userID).Return(nil)
 			return fields{
-					queries:  queries,
-					commands: commands,
-					es: eventstore.NewEventstore(&eventstore.Config{
-						Querier: es_repo_mock.NewRepo(t).ExpectFilterEvents().MockQuerier,
+				queries:  queries,
+				commands: commands,
+				es: eventstore.NewEventstore(&eventstore.Config{
+					Querier: es_repo_mock.NewRepo(t).ExpectFilterEvents().MockQuerier,
+				}),
+			}, args{
+				event: &user.HumanPasswordChangedEvent{
+					BaseEvent: *eventstore.BaseEventFromRepo(&repository.Event{
+						AggregateID:   userID,
+						ResourceOwner: sql.NullString{String: orgID},
+						CreationDate:  time.Now().UTC(),
 					}),
-				}, args{
-					event: &user.HumanPasswordChangedEvent{
-						BaseEvent: *eventstore.BaseEventFromRepo(&repository.Event{
-							AggregateID:   userID,
-							ResourceOwner: sql.NullString{String: orgID},
-							CreationDate:  time.Now().UTC(),
-						}),
-						TriggeredAtOrigin: eventOrigin,
-					},
-				}, w
+					TriggeredAtOrigin: eventOrigin,
+				},
+			}, w
 		},
 	}, {
 		name: "asset url without event trigger url",
@@ -1157,20 +1586,20 @@ func Test_userNotifier_reducePasswordChanged(t *testing.T) {
 			expectTemplateQueries(queries, givenTemplate)
 			commands.EXPECT().PasswordChangeSent(gomock.Any(), orgID, userID).Return(nil)
 			return fields{
-					queries:  queries,
-					commands: commands,
-					es: eventstore.NewEventstore(&eventstore.Config{
-						Querier: es_repo_mock.NewRepo(t).ExpectFilterEvents().MockQuerier,
+				queries:  queries,
+				commands: commands,
+				es: eventstore.NewEventstore(&eventstore.Config{
+					Querier: es_repo_mock.NewRepo(t).ExpectFilterEvents().MockQuerier,
+				}),
+			}, args{
+				event: &user.HumanPasswordChangedEvent{
+					BaseEvent: *eventstore.BaseEventFromRepo(&repository.Event{
+						AggregateID:   userID,
+						ResourceOwner: sql.NullString{String: orgID},
+						CreationDate:  time.Now().UTC(),
 					}),
-				}, args{
-					event: &user.HumanPasswordChangedEvent{
-						BaseEvent: *eventstore.BaseEventFromRepo(&repository.Event{
-							AggregateID:   userID,
-							ResourceOwner: sql.NullString{String: orgID},
-							CreationDate:  time.Now().UTC(),
-						}),
-					},
-				}, w
+				},
+			}, w
 		},
 	}}
 	for _, tt := range tests {
@@ -1195,6 +1624,94 @@ func Test_userNotifier_reducePasswordChanged(t *testing.T) {
 	}
 }
 
+func Test_userNotifier_reduceUsernameRecoveryRequested(t *testing.T) {
+	expectMailSubject := "Your username"
+	tests := []struct {
+		name string
+		test func(*gomock.Controller, *mock.MockQueries, *mock.MockCommands) (fields, args, want)
+	}{{
+		name: "asset url with event trigger url",
+		test: func(ctrl *gomock.Controller, queries *mock.MockQueries, commands *mock.MockCommands) (f fields, a args, w want) {
+			givenTemplate := "{{.LogoURL}}"
+			expectContent := fmt.Sprintf("%s%s/%s/%s", eventOrigin, assetsPath, policyID, logoURL)
+			w.message = messages.Email{
+				Recipients: []string{verifiedEmail},
+				Subject:    expectMailSubject,
+				Content:    expectContent,
+			}
+			expectTemplateQueries(queries, givenTemplate)
+			commands.EXPECT().UsernameRecoverySent(gomock.Any(), orgID, userID).Return(nil)
+			return fields{
+				queries:  queries,
+				commands: commands,
+				es: eventstore.NewEventstore(&eventstore.Config{
+					Querier: es_repo_mock.NewRepo(t).ExpectFilterEvents().MockQuerier,
+				}),
+			}, args{
+				event: &user.HumanUsernameRecoveryRequestedEvent{
+					BaseEvent: *eventstore.BaseEventFromRepo(&repository.Event{
+						AggregateID:   userID,
+						ResourceOwner: sql.NullString{String: orgID},
+						CreationDate:  time.Now().UTC(),
+					}),
+					NotificationType:  domain.NotificationTypeEmail,
+					TriggeredAtOrigin: eventOrigin,
+				},
+			}, w
+		},
+	}, {
+		name: "button url with event trigger url",
+		test: func(ctrl *gomock.Controller, queries *mock.MockQueries, commands *mock.MockCommands) (f fields, a args, w want) {
+			givenTemplate := "{{.URL}}"
+			expectContent := fmt.Sprintf("%s/ui/login/login?orgID=%s", eventOrigin, orgID)
+			w.message = messages.Email{
+				Recipients: []string{verifiedEmail},
+				Subject:    expectMailSubject,
+				Content:    expectContent,
+			}
+			expectTemplateQueries(queries, givenTemplate)
+			commands.EXPECT().UsernameRecoverySent(gomock.Any(), orgID, userID).Return(nil)
+			return fields{
+				queries:  queries,
+				commands: commands,
+				es: eventstore.NewEventstore(&eventstore.Config{
+					Querier: es_repo_mock.NewRepo(t).ExpectFilterEvents().MockQuerier,
+				}),
+			}, args{
+				event: &user.HumanUsernameRecoveryRequestedEvent{
+					BaseEvent: *eventstore.BaseEventFromRepo(&repository.Event{
+						AggregateID:   userID,
+						ResourceOwner: sql.NullString{String: orgID},
+						CreationDate:  time.Now().UTC(),
+					}),
+					NotificationType:  domain.NotificationTypeEmail,
+					TriggeredAtOrigin: eventOrigin,
+				},
+			}, w
+		},
+	}}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			queries := mock.NewMockQueries(ctrl)
+			commands := mock.NewMockCommands(ctrl)
+			f, a, w := tt.test(ctrl, queries, commands)
+			stmt, err := newUserNotifier(t, ctrl, queries, f, a, w).reduceUsernameRecoveryRequested(a.event)
+			if w.err != nil {
+				w.err(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+			err = stmt.Execute(nil, "")
+			if w.err != nil {
+				w.err(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
 func Test_userNotifier_reduceOTPEmailChallenged(t *testing.T) {
 	expectMailSubject := "Verify One-Time Password"
 	tests := []struct {
@@ -1215,26 +1732,26 @@ func Test_userNotifier_reduceOTPEmailChallenged(t *testing.T) {
 			queries.EXPECT().SessionByID(gomock.Any(), gomock.Any(), userID, gomock.Any()).Return(&query.Session{}, nil)
 			commands.EXPECT().OTPEmailSent(gomock.Any(), userID, orgID).Return(nil)
 			return fields{
-					queries:  queries,
-					commands: commands,
-					es: eventstore.NewEventstore(&eventstore.Config{
-						Querier: es_repo_mock.NewRepo(t).ExpectFilterEvents().MockQuerier,
+				queries:  queries,
+				commands: commands,
+				es: eventstore.NewEventstore(&eventstore.Config{
+					Querier: es_repo_mock.NewRepo(t).ExpectFilterEvents().MockQuerier,
+				}),
+				userDataCrypto: codeAlg,
+			}, args{
+				event: &session.OTPEmailChallengedEvent{
+					BaseEvent: *eventstore.BaseEventFromRepo(&repository.Event{
+						AggregateID:   userID,
+						ResourceOwner: sql.NullString{String: orgID},
+						CreationDate:  time.Now().UTC(),
 					}),
-					userDataCrypto: codeAlg,
-				}, args{
-					event: &session.OTPEmailChallengedEvent{
-						BaseEvent: *eventstore.BaseEventFromRepo(&repository.Event{
-							AggregateID:   userID,
-							ResourceOwner: sql.NullString{String: orgID},
-							CreationDate:  time.Now().UTC(),
-						}),
-						Code:              code,
-						Expiry:            time.Hour,
-						URLTmpl:           "",
-						ReturnCode:        false,
-						TriggeredAtOrigin: eventOrigin,
-					},
-				}, w
+					Code:              code,
+					Expiry:            time.Hour,
+					URLTmpl:           "",
+					ReturnCode:        false,
+					TriggeredAtOrigin: eventOrigin,
+				},
+			}, w
 		},
 	}, {
 		name: "asset url without event trigger url",
@@ -1257,25 +1774,25 @@ func Test_userNotifier_reduceOTPEmailChallenged(t *testing.T) {
 			}, nil)
 			commands.EXPECT().OTPEmailSent(gomock.Any(), userID, orgID).Return(nil)
 			return fields{
-					queries:  queries,
-					commands: commands,
-					es: eventstore.NewEventstore(&eventstore.Config{
-						Querier: es_repo_mock.NewRepo(t).ExpectFilterEvents().MockQuerier,
+				queries:  queries,
+				commands: commands,
+				es: eventstore.NewEventstore(&eventstore.Config{
+					Querier: es_repo_mock.NewRepo(t).ExpectFilterEvents().MockQuerier,
+				}),
+				userDataCrypto: codeAlg,
+			}, args{
+				event: &session.OTPEmailChallengedEvent{
+					BaseEvent: *eventstore.BaseEventFromRepo(&repository.Event{
+						AggregateID:   userID,
+						ResourceOwner: sql.NullString{String: orgID},
+						CreationDate:  time.Now().UTC(),
 					}),
-					userDataCrypto: codeAlg,
-				}, args{
-					event: &session.OTPEmailChallengedEvent{
-						BaseEvent: *eventstore.BaseEventFromRepo(&repository.Event{
-							AggregateID:   userID,
-							ResourceOwner: sql.NullString{String: orgID},
-							CreationDate:  time.Now().UTC(),
-						}),
-						Code:       code,
-						Expiry:     time.Hour,
-						URLTmpl:    "",
-						ReturnCode: false,
-					},
-				}, w
+					Code:       code,
+					Expiry:     time.Hour,
+					URLTmpl:    "",
+					ReturnCode: false,
+				},
+			}, w
 		},
 	}, {
 		name: "button url with event trigger url",
@@ -1293,27 +1810,27 @@ func Test_userNotifier_reduceOTPEmailChallenged(t *testing.T) {
 			queries.EXPECT().SessionByID(gomock.Any(), gomock.Any(), userID, gomock.Any()).Return(&query.Session{}, nil)
 			commands.EXPECT().OTPEmailSent(gomock.Any(), userID, orgID).Return(nil)
 			return fields{
-					queries:  queries,
-					commands: commands,
-					es: eventstore.NewEventstore(&eventstore.Config{
-						Querier: es_repo_mock.NewRepo(t).ExpectFilterEvents().MockQuerier,
+				queries:  queries,
+				commands: commands,
+				es: eventstore.NewEventstore(&eventstore.Config{
+					Querier: es_repo_mock.NewRepo(t).ExpectFilterEvents().MockQuerier,
+				}),
+				userDataCrypto: codeAlg,
+				SMSTokenCrypto: nil,
+			}, args{
+				event: &session.OTPEmailChallengedEvent{
+					BaseEvent: *eventstore.BaseEventFromRepo(&repository.Event{
+						AggregateID:   userID,
+						ResourceOwner: sql.NullString{String: orgID},
+						CreationDate:  time.Now().UTC(),
 					}),
-					userDataCrypto: codeAlg,
-					SMSTokenCrypto: nil,
-				}, args{
-					event: &session.OTPEmailChallengedEvent{
-						BaseEvent: *eventstore.BaseEventFromRepo(&repository.Event{
-							AggregateID:   userID,
-							ResourceOwner: sql.NullString{String: orgID},
-							CreationDate:  time.Now().UTC(),
-						}),
-						Code:              code,
-						Expiry:            time.Hour,
-						URLTmpl:           "",
-						ReturnCode:        false,
-						TriggeredAtOrigin: eventOrigin,
-					},
-				}, w
+					Code:              code,
+					Expiry:            time.Hour,
+					URLTmpl:           "",
+					ReturnCode:        false,
+					TriggeredAtOrigin: eventOrigin,
+				},
+			}, w
 		},
 	}, {
 		name: "button url without event trigger url",
@@ -1337,24 +1854,24 @@ func Test_userNotifier_reduceOTPEmailChallenged(t *testing.T) {
 			queries.EXPECT().SessionByID(gomock.Any(), gomock.Any(), userID, gomock.Any()).Return(&query.Session{}, nil)
 			commands.EXPECT().OTPEmailSent(gomock.Any(), userID, orgID).Return(nil)
 			return fields{
-					queries:  queries,
-					commands: commands,
-					es: eventstore.NewEventstore(&eventstore.Config{
-						Querier: es_repo_mock.NewRepo(t).ExpectFilterEvents().MockQuerier,
+				queries:  queries,
+				commands: commands,
+				es: eventstore.NewEventstore(&eventstore.Config{
+					Querier: es_repo_mock.NewRepo(t).ExpectFilterEvents().MockQuerier,
+				}),
+				userDataCrypto: codeAlg,
+			}, args{
+				event: &session.OTPEmailChallengedEvent{
+					BaseEvent: *eventstore.BaseEventFromRepo(&repository.Event{
+						AggregateID:   userID,
+						ResourceOwner: sql.NullString{String: orgID},
+						CreationDate:  time.Now().UTC(),
 					}),
-					userDataCrypto: codeAlg,
-				}, args{
-					event: &session.OTPEmailChallengedEvent{
-						BaseEvent: *eventstore.BaseEventFromRepo(&repository.Event{
-							AggregateID:   userID,
-							ResourceOwner: sql.NullString{String: orgID},
-							CreationDate:  time.Now().UTC(),
-						}),
-						Code:       code,
-						Expiry:     time.Hour,
-						ReturnCode: false,
-					},
-				}, w
+					Code:       code,
+					Expiry:     time.Hour,
+					ReturnCode: false,
+				},
+			}, w
 		},
 	}, {
 		name: "button url with url template and event trigger url",
@@ -1373,27 +1890,27 @@ func Test_userNotifier_reduceOTPEmailChallenged(t *testing.T) {
 			queries.EXPECT().SessionByID(gomock.Any(), gomock.Any(), userID, gomock.Any()).Return(&query.Session{}, nil)
 			commands.EXPECT().OTPEmailSent(gomock.Any(), userID, orgID).Return(nil)
 			return fields{
-					queries:  queries,
-					commands: commands,
-					es: eventstore.NewEventstore(&eventstore.Config{
-						Querier: es_repo_mock.NewRepo(t).ExpectFilterEvents().MockQuerier,
+				queries:  queries,
+				commands: commands,
+				es: eventstore.NewEventstore(&eventstore.Config{
+					Querier: es_repo_mock.NewRepo(t).ExpectFilterEvents().MockQuerier,
+				}),
+				userDataCrypto: codeAlg,
+				SMSTokenCrypto: nil,
+			}, args{
+				event: &session.OTPEmailChallengedEvent{
+					BaseEvent: *eventstore.BaseEventFromRepo(&repository.Event{
+						AggregateID:   userID,
+						ResourceOwner: sql.NullString{String: orgID},
+						CreationDate:  time.Now().UTC(),
 					}),
-					userDataCrypto: codeAlg,
-					SMSTokenCrypto: nil,
-				}, args{
-					event: &session.OTPEmailChallengedEvent{
-						BaseEvent: *eventstore.BaseEventFromRepo(&repository.Event{
-							AggregateID:   userID,
-							ResourceOwner: sql.NullString{String: orgID},
-							CreationDate:  time.Now().UTC(),
-						}),
-						Code:              code,
-						Expiry:            time.Hour,
-						ReturnCode:        false,
-						URLTmpl:           urlTemplate,
-						TriggeredAtOrigin: eventOrigin,
-					},
-				}, w
+					Code:              code,
+					Expiry:            time.Hour,
+					ReturnCode:        false,
+					URLTmpl:           urlTemplate,
+					TriggeredAtOrigin: eventOrigin,
+				},
+			}, w
 		},
 	}}
 	for _, tt := range tests {
@@ -1444,6 +1961,7 @@ func newUserNotifier(t *testing.T, ctrl *gomock.Controller, queries *mock.MockQu
 			externalPort,
 			externalSecure,
 			"",
+			nil,
 			f.userDataCrypto,
 			smtpAlg,
 			f.SMSTokenCrypto,
@@ -1459,18 +1977,26 @@ type channels struct {
 	senders.Chain
 }
 
-func (c *channels) Email(context.Context) (*senders.Chain, *smtp.Config, error) {
+func (c *channels) Email(context.Context) (*senders.Chain, *email.Config, error) {
 	return &c.Chain, nil, nil
 }
 
-func (c *channels) SMS(context.Context) (*senders.Chain, *twilio.Config, error) {
-	return &c.Chain, nil, nil
+func (c *channels) SMS(context.Context, string) ([]*senders.SMSChain, error) {
+	return []*senders.SMSChain{{Chain: &c.Chain}}, nil
 }
 
 func (c *channels) Webhook(context.Context, webhook.Config) (*senders.Chain, error) {
 	return &c.Chain, nil
 }
 
+func (c *channels) Push(context.Context, domain.PushPlatform) (*senders.Chain, error) {
+	return &c.Chain, nil
+}
+
+func (c *channels) Enqueue(_ context.Context, _ *types.QueuedMessage, deliveryErr error) error {
+	return deliveryErr
+}
+
 func expectTemplateQueries(queries *mock.MockQueries, template string) {
 	queries.EXPECT().GetInstanceRestrictions(gomock.Any()).Return(query.Restrictions{
 		AllowedLanguages: []language.Tag{language.English},
@@ -1482,6 +2008,7 @@ func expectTemplateQueries(queries *mock.MockQueries, template string) {
 		},
 	}, nil)
 	queries.EXPECT().MailTemplateByOrg(gomock.Any(), gomock.Any(), gomock.Any()).Return(&query.MailTemplate{Template: []byte(template)}, nil)
+	queries.EXPECT().NotificationTemplates(gomock.Any()).Return(&query.NotificationTemplates{}, nil)
 	queries.EXPECT().GetNotifyUserByID(gomock.Any(), gomock.Any(), gomock.Any()).Return(&query.NotifyUser{
 		ID:                 userID,
 		ResourceOwner:      orgID,
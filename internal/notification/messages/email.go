@@ -1,14 +1,20 @@
 package messages
 
 import (
+	"bytes"
+	"encoding/base64"
 	"fmt"
 	"mime"
+	"mime/multipart"
+	"mime/quotedprintable"
+	"net/textproto"
 	"regexp"
 	"strings"
 	"time"
 
 	"github.com/zitadel/zitadel/internal/eventstore"
 	"github.com/zitadel/zitadel/internal/notification/channels"
+	"github.com/zitadel/zitadel/internal/notification/templates"
 )
 
 var (
@@ -19,14 +25,18 @@ var (
 var _ channels.Message = (*Email)(nil)
 
 type Email struct {
-	Recipients      []string
-	BCC             []string
-	CC              []string
-	SenderEmail     string
-	SenderName      string
-	ReplyToAddress  string
-	Subject         string
-	Content         string
+	Recipients     []string
+	BCC            []string
+	CC             []string
+	SenderEmail    string
+	SenderName     string
+	ReplyToAddress string
+	Subject        string
+	Content        string
+	// TextContent is the optional plain text alternative of the (html) Content
+	TextContent string
+	// Attachments are inline attachments referenced by their content id (e.g. `cid:logo`)
+	Attachments     []*templates.Attachment
 	TriggeringEvent eventstore.Event
 }
 
@@ -50,17 +60,103 @@ func (msg *Email) GetContent() (string, error) {
 		message += fmt.Sprintf("%s: %s"+lineBreak, k, v)
 	}
 
+	subject := "Subject: " + bEncodeWord(msg.Subject) + lineBreak
+	if msg.TextContent != "" || len(msg.Attachments) > 0 {
+		body, contentType, err := msg.multipartBody()
+		if err != nil {
+			return "", err
+		}
+		return message + subject + "MIME-Version: 1.0" + lineBreak + "Content-Type: " + contentType + lineBreak + lineBreak + body, nil
+	}
+
 	//default mime-type is html
 	mime := "MIME-Version: 1.0" + lineBreak + "Content-Type: text/html; charset=\"UTF-8\"" + lineBreak + lineBreak
 	if !isHTML(msg.Content) {
 		mime = "MIME-Version: 1.0" + lineBreak + "Content-Type: text/plain; charset=\"UTF-8\"" + lineBreak + lineBreak
 	}
-	subject := "Subject: " + bEncodeWord(msg.Subject) + lineBreak
 	message += subject + mime + lineBreak + msg.Content
 
 	return message, nil
 }
 
+// multipartBody returns a multipart/alternative body of the plain text and html content.
+// If the email has attachments, the alternative part is wrapped into a multipart/related body.
+func (msg *Email) multipartBody() (body string, contentType string, err error) {
+	alternative := new(bytes.Buffer)
+	alternativeWriter := multipart.NewWriter(alternative)
+	if msg.TextContent != "" {
+		if err = writeQuotedPrintablePart(alternativeWriter, "text/plain", msg.TextContent); err != nil {
+			return "", "", err
+		}
+	}
+	if err = writeQuotedPrintablePart(alternativeWriter, "text/html", msg.Content); err != nil {
+		return "", "", err
+	}
+	if err = alternativeWriter.Close(); err != nil {
+		return "", "", err
+	}
+	alternativeContentType := mime.FormatMediaType("multipart/alternative", map[string]string{"boundary": alternativeWriter.Boundary()})
+	if len(msg.Attachments) == 0 {
+		return alternative.String(), alternativeContentType, nil
+	}
+
+	related := new(bytes.Buffer)
+	relatedWriter := multipart.NewWriter(related)
+	part, err := relatedWriter.CreatePart(textproto.MIMEHeader{"Content-Type": {alternativeContentType}})
+	if err != nil {
+		return "", "", err
+	}
+	if _, err = part.Write(alternative.Bytes()); err != nil {
+		return "", "", err
+	}
+	for _, attachment := range msg.Attachments {
+		if err = writeAttachmentPart(relatedWriter, attachment); err != nil {
+			return "", "", err
+		}
+	}
+	if err = relatedWriter.Close(); err != nil {
+		return "", "", err
+	}
+	return related.String(), mime.FormatMediaType("multipart/related", map[string]string{"boundary": relatedWriter.Boundary(), "type": "multipart/alternative"}), nil
+}
+
+func writeQuotedPrintablePart(writer *multipart.Writer, contentType, content string) error {
+	part, err := writer.CreatePart(textproto.MIMEHeader{
+		"Content-Type":              {contentType + "; charset=\"UTF-8\""},
+		"Content-Transfer-Encoding": {"quoted-printable"},
+	})
+	if err != nil {
+		return err
+	}
+	encoder := quotedprintable.NewWriter(part)
+	if _, err = encoder.Write([]byte(content)); err != nil {
+		return err
+	}
+	return encoder.Close()
+}
+
+func writeAttachmentPart(writer *multipart.Writer, attachment *templates.Attachment) error {
+	part, err := writer.CreatePart(textproto.MIMEHeader{
+		"Content-Type":              {attachment.ContentType},
+		"Content-Transfer-Encoding": {"base64"},
+		"Content-ID":                {"<" + attachment.ContentID + ">"},
+		"Content-Disposition":       {mime.FormatMediaType("inline", map[string]string{"filename": attachment.Filename})},
+	})
+	if err != nil {
+		return err
+	}
+	encoded := base64.StdEncoding.EncodeToString(attachment.Data)
+	// lines of base64 encoded content must not exceed 76 characters (RFC 2045)
+	for len(encoded) > 76 {
+		if _, err = part.Write([]byte(encoded[:76] + lineBreak)); err != nil {
+			return err
+		}
+		encoded = encoded[76:]
+	}
+	_, err = part.Write([]byte(encoded))
+	return err
+}
+
 func (msg *Email) GetTriggeringEvent() eventstore.Event {
 	return msg.TriggeringEvent
 }
@@ -0,0 +1,67 @@
+package messages
+
+import (
+	"io"
+	"mime"
+	"mime/multipart"
+	"net/mail"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/zitadel/zitadel/internal/notification/templates"
+)
+
+func TestEmail_GetContent_Multipart(t *testing.T) {
+	msg := &Email{
+		Recipients:  []string{"user@example.com"},
+		SenderEmail: "noreply@example.com",
+		Subject:     "Subject",
+		Content:     `<html><img src="cid:logo"></html>`,
+		TextContent: "plain text",
+		Attachments: []*templates.Attachment{
+			{
+				Filename:    "logo.png",
+				ContentType: "image/png",
+				ContentID:   templates.LogoContentID,
+				Data:        []byte("png"),
+			},
+		},
+	}
+	content, err := msg.GetContent()
+	require.NoError(t, err)
+
+	parsed, err := mail.ReadMessage(strings.NewReader(content))
+	require.NoError(t, err)
+	mediaType, params, err := mime.ParseMediaType(parsed.Header.Get("Content-Type"))
+	require.NoError(t, err)
+	assert.Equal(t, "multipart/related", mediaType)
+
+	related := multipart.NewReader(parsed.Body, params["boundary"])
+	alternativePart, err := related.NextPart()
+	require.NoError(t, err)
+	mediaType, params, err = mime.ParseMediaType(alternativePart.Header.Get("Content-Type"))
+	require.NoError(t, err)
+	assert.Equal(t, "multipart/alternative", mediaType)
+
+	alternative := multipart.NewReader(alternativePart, params["boundary"])
+	textPart, err := alternative.NextPart()
+	require.NoError(t, err)
+	assert.Equal(t, `text/plain; charset="UTF-8"`, textPart.Header.Get("Content-Type"))
+	text, err := io.ReadAll(textPart)
+	require.NoError(t, err)
+	assert.Equal(t, "plain text", string(text))
+	htmlPart, err := alternative.NextPart()
+	require.NoError(t, err)
+	assert.Equal(t, `text/html; charset="UTF-8"`, htmlPart.Header.Get("Content-Type"))
+	html, err := io.ReadAll(htmlPart)
+	require.NoError(t, err)
+	assert.Equal(t, msg.Content, string(html))
+
+	logoPart, err := related.NextPart()
+	require.NoError(t, err)
+	assert.Equal(t, "<logo>", logoPart.Header.Get("Content-Id"))
+	assert.Equal(t, "image/png", logoPart.Header.Get("Content-Type"))
+}
@@ -10,6 +10,9 @@ import (
 var _ channels.Message = (*JSON)(nil)
 
 type JSON struct {
+	// MessageID identifies the message sent to a notification provider
+	// and is used to track its delivery status, it's empty for other webhooks
+	MessageID       string
 	Serializable    interface{}
 	TriggeringEvent eventstore.Event
 }
@@ -22,3 +25,7 @@ func (msg *JSON) GetContent() (string, error) {
 func (msg *JSON) GetTriggeringEvent() eventstore.Event {
 	return msg.TriggeringEvent
 }
+
+func (msg *JSON) GetMessageID() string {
+	return msg.MessageID
+}
@@ -0,0 +1,26 @@
+package messages
+
+import (
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/notification/channels"
+)
+
+var _ channels.Message = (*Push)(nil)
+
+type Push struct {
+	// DeviceToken is the token the push notification service issued to the app on the device
+	DeviceToken string
+	Title       string
+	Body        string
+	// Data is passed to the app, e.g. to open the login the notification belongs to
+	Data            map[string]string
+	TriggeringEvent eventstore.Event
+}
+
+func (msg *Push) GetContent() (string, error) {
+	return msg.Body, nil
+}
+
+func (msg *Push) GetTriggeringEvent() eventstore.Event {
+	return msg.TriggeringEvent
+}
@@ -11,14 +11,16 @@ import (
 	_ "github.com/zitadel/zitadel/internal/notification/statik"
 	"github.com/zitadel/zitadel/internal/query"
 	"github.com/zitadel/zitadel/internal/query/projection"
+	"github.com/zitadel/zitadel/internal/static"
 )
 
 var projections []*handler.Handler
 
 func Register(
 	ctx context.Context,
-	userHandlerCustomConfig, quotaHandlerCustomConfig, telemetryHandlerCustomConfig projection.CustomConfig,
+	userHandlerCustomConfig, quotaHandlerCustomConfig, telemetryHandlerCustomConfig, queueHandlerCustomConfig, alertHandlerCustomConfig, projectionFailureAlertHandlerCustomConfig projection.CustomConfig,
 	telemetryCfg handlers.TelemetryPusherConfig,
+	queueCfg handlers.NotificationQueueConfig,
 	externalDomain string,
 	externalPort uint16,
 	externalSecure bool,
@@ -27,12 +29,18 @@ func Register(
 	es *eventstore.Eventstore,
 	otpEmailTmpl string,
 	fileSystemPath string,
+	assets static.Storage,
 	userEncryption, smtpEncryption, smsEncryption crypto.EncryptionAlgorithm,
 ) {
-	q := handlers.NewNotificationQueries(queries, es, externalDomain, externalPort, externalSecure, fileSystemPath, userEncryption, smtpEncryption, smsEncryption)
-	c := newChannels(q)
+	q := handlers.NewNotificationQueries(queries, es, externalDomain, externalPort, externalSecure, fileSystemPath, assets, userEncryption, smtpEncryption, smsEncryption)
+	c := newChannels(q, commands, queueCfg)
 	projections = append(projections, handlers.NewUserNotifier(ctx, projection.ApplyCustomConfig(userHandlerCustomConfig), commands, q, c, otpEmailTmpl))
 	projections = append(projections, handlers.NewQuotaNotifier(ctx, projection.ApplyCustomConfig(quotaHandlerCustomConfig), commands, q, c))
+	projections = append(projections, handlers.NewAlertNotifier(ctx, projection.ApplyCustomConfig(alertHandlerCustomConfig), q, c))
+	projections = append(projections, handlers.NewProjectionFailureAlerter(ctx, projection.ApplyCustomConfig(projectionFailureAlertHandlerCustomConfig), q, c))
+	if queueCfg.Enabled {
+		projections = append(projections, handlers.NewNotificationQueueWorker(ctx, queueCfg, projection.ApplyCustomConfig(queueHandlerCustomConfig), commands, q, c))
+	}
 	if telemetryCfg.Enabled {
 		projections = append(projections, handlers.NewTelemetryPusher(ctx, telemetryCfg, projection.ApplyCustomConfig(telemetryHandlerCustomConfig), commands, q, c))
 	}
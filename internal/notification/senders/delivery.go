@@ -0,0 +1,45 @@
+package senders
+
+import (
+	"context"
+
+	"github.com/zitadel/zitadel/internal/notification/channels"
+	"github.com/zitadel/zitadel/internal/repository/notification"
+)
+
+// DeliveryStatus reports the result of a message handed over to a notification provider
+type DeliveryStatus func(ctx context.Context, messageID string, delivery notification.Delivery, err error)
+
+type identifiedMessage interface {
+	GetMessageID() string
+}
+
+// trackDelivery reports the delivery status of all messages with a message id
+func trackDelivery(
+	ctx context.Context,
+	channel channels.NotificationChannel,
+	deliveryChannel,
+	providerID string,
+	deliveryStatus DeliveryStatus,
+) channels.NotificationChannel {
+	if deliveryStatus == nil {
+		return channel
+	}
+	return channels.HandleMessageFunc(func(message channels.Message) error {
+		err := channel.HandleMessage(message)
+		msg, ok := message.(identifiedMessage)
+		if !ok || msg.GetMessageID() == "" {
+			return err
+		}
+		delivery := notification.Delivery{
+			Channel:    deliveryChannel,
+			ProviderID: providerID,
+		}
+		if event := message.GetTriggeringEvent(); event != nil {
+			delivery.TriggeringAggregateID = event.Aggregate().ID
+			delivery.TriggeringEventType = event.Type()
+		}
+		deliveryStatus(ctx, msg.GetMessageID(), delivery, err)
+		return err
+	})
+}
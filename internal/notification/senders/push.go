@@ -0,0 +1,71 @@
+package senders
+
+import (
+	"context"
+
+	"github.com/zitadel/logging"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/notification/channels"
+	"github.com/zitadel/zitadel/internal/notification/channels/apns"
+	"github.com/zitadel/zitadel/internal/notification/channels/fcm"
+	"github.com/zitadel/zitadel/internal/notification/channels/fs"
+	"github.com/zitadel/zitadel/internal/notification/channels/instrumenting"
+	"github.com/zitadel/zitadel/internal/notification/channels/log"
+	"github.com/zitadel/zitadel/internal/notification/channels/push"
+)
+
+const (
+	fcmSpanName  = "fcm.NotificationChannel"
+	apnsSpanName = "apns.NotificationChannel"
+)
+
+// PushChannels returns the chain delivering push notifications to devices of the platform
+func PushChannels(
+	ctx context.Context,
+	pushConfig *push.Config,
+	platform domain.PushPlatform,
+	getFileSystemProvider func(ctx context.Context) (*fs.Config, error),
+	getLogProvider func(ctx context.Context) (*log.Config, error),
+	successMetricName,
+	failureMetricName string,
+) (*Chain, error) {
+	var (
+		channel  channels.NotificationChannel
+		spanName string
+		err      error
+	)
+	switch platform {
+	case domain.PushPlatformFCM:
+		if pushConfig.FCMConfig != nil {
+			channel, err = fcm.InitChannel(ctx, *pushConfig.FCMConfig)
+			spanName = fcmSpanName
+		}
+	case domain.PushPlatformAPNs:
+		if pushConfig.APNsConfig != nil {
+			channel, err = apns.InitChannel(ctx, *pushConfig.APNsConfig)
+			spanName = apnsSpanName
+		}
+	case domain.PushPlatformUnspecified:
+	}
+	logging.WithFields(
+		"instance", authz.GetInstance(ctx).InstanceID(),
+		"platform", platform,
+	).OnError(err).Debug("initializing push channel failed")
+	channels := make([]channels.NotificationChannel, 0, 3)
+	if channel != nil && err == nil {
+		channels = append(
+			channels,
+			instrumenting.Wrap(
+				ctx,
+				channel,
+				spanName,
+				successMetricName,
+				failureMetricName,
+			),
+		)
+	}
+	channels = append(channels, debugChannels(ctx, getFileSystemProvider, getLogProvider)...)
+	return ChainChannels(channels...), nil
+}
@@ -3,36 +3,68 @@ package senders
 import (
 	"context"
 
+	"github.com/zitadel/logging"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
 	"github.com/zitadel/zitadel/internal/notification/channels"
 	"github.com/zitadel/zitadel/internal/notification/channels/fs"
 	"github.com/zitadel/zitadel/internal/notification/channels/instrumenting"
 	"github.com/zitadel/zitadel/internal/notification/channels/log"
+	"github.com/zitadel/zitadel/internal/notification/channels/sms"
 	"github.com/zitadel/zitadel/internal/notification/channels/twilio"
+	"github.com/zitadel/zitadel/internal/notification/channels/webhook"
+	"github.com/zitadel/zitadel/internal/repository/notification"
 )
 
 const twilioSpanName = "twilio.NotificationChannel"
 
+// SMSChain is the channel chain of a single SMS provider
+type SMSChain struct {
+	*Chain
+	Config *sms.Config
+}
+
 func SMSChannels(
 	ctx context.Context,
-	twilioConfig *twilio.Config,
+	smsConfig *sms.Config,
 	getFileSystemProvider func(ctx context.Context) (*fs.Config, error),
 	getLogProvider func(ctx context.Context) (*log.Config, error),
 	successMetricName,
 	failureMetricName string,
+	deliveryStatus DeliveryStatus,
 ) (chain *Chain, err error) {
 	channels := make([]channels.NotificationChannel, 0, 3)
-	if twilioConfig != nil {
+	if smsConfig.TwilioConfig != nil {
 		channels = append(
 			channels,
 			instrumenting.Wrap(
 				ctx,
-				twilio.InitChannel(*twilioConfig),
+				twilio.InitChannel(*smsConfig.TwilioConfig),
 				twilioSpanName,
 				successMetricName,
 				failureMetricName,
 			),
 		)
 	}
+	if smsConfig.WebhookConfig != nil {
+		webhookChannel, err := webhook.InitChannel(ctx, *smsConfig.WebhookConfig)
+		logging.WithFields(
+			"instance", authz.GetInstance(ctx).InstanceID(),
+			"callurl", smsConfig.WebhookConfig.CallURL,
+		).OnError(err).Debug("initializing SMS webhook channel failed")
+		if err == nil {
+			channels = append(
+				channels,
+				instrumenting.Wrap(
+					ctx,
+					trackDelivery(ctx, webhookChannel, notification.DeliveryChannelSMS, smsConfig.ProviderConfig.ID, deliveryStatus),
+					webhookSpanName,
+					successMetricName,
+					failureMetricName,
+				),
+			)
+		}
+	}
 	channels = append(channels, debugChannels(ctx, getFileSystemProvider, getLogProvider)...)
 	return ChainChannels(channels...), nil
 }
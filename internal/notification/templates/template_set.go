@@ -0,0 +1,153 @@
+package templates
+
+import (
+	"bytes"
+	"context"
+	html_template "html/template"
+	text_template "text/template"
+
+	"golang.org/x/text/language"
+)
+
+const (
+	// LogoContentID is the content id of the inline logo attachment.
+	// Layouts with an inline logo reference it as `cid:logo` through {{.LogoURL}}.
+	LogoContentID = "logo"
+
+	layoutTemplateName = "layout"
+)
+
+// Layout is a language specific email layout consisting of an html and an optional plain text part.
+type Layout struct {
+	HTML       string
+	Text       string
+	InlineLogo bool
+}
+
+// AssetLoader loads the content and content type of a stored asset (e.g. the logo of the label policy).
+type AssetLoader func(ctx context.Context, resourceOwner, name string) (data []byte, contentType string, err error)
+
+// TemplateSet combines the legacy mail template with the layouts per language and the shared partials.
+// If no layout matches the language of the recipient, the legacy template is used.
+type TemplateSet struct {
+	Legacy   string
+	Layouts  map[string]*Layout
+	Partials map[string]string
+	Assets   AssetLoader
+}
+
+// Layout returns the layout for the requested language.
+// It falls back to the base language (e.g. `de` for `de-CH`) and the default layout (empty language).
+// If none is found, a layout consisting of the legacy template is returned.
+func (s *TemplateSet) Layout(lang string) *Layout {
+	if layout, ok := s.Layouts[lang]; ok {
+		return layout
+	}
+	if tag, err := language.Parse(lang); err == nil {
+		base, _ := tag.Base()
+		if layout, ok := s.Layouts[base.String()]; ok {
+			return layout
+		}
+	}
+	if layout, ok := s.Layouts[""]; ok {
+		return layout
+	}
+	return &Layout{HTML: s.Legacy}
+}
+
+// Attachment is an inline attachment of an email referenced by its content id.
+type Attachment struct {
+	Filename    string
+	ContentType string
+	ContentID   string
+	Data        []byte
+}
+
+// Message is the rendered content of an email.
+type Message struct {
+	HTML        string
+	Text        string
+	Attachments []*Attachment
+}
+
+// Render renders the html and plain text part of the layout with the shared partials.
+func (l *Layout) Render(partials map[string]string, data interface{}) (*Message, error) {
+	htmlContent, err := renderHTML(l.HTML, partials, data)
+	if err != nil {
+		return nil, err
+	}
+	message := &Message{HTML: htmlContent}
+	if l.Text == "" {
+		return message, nil
+	}
+	message.Text, err = renderText(l.Text, partials, data)
+	if err != nil {
+		return nil, err
+	}
+	return message, nil
+}
+
+// ValidateHTML checks that the html layout (or partial) and the partials can be parsed.
+func ValidateHTML(content string, partials map[string]string) error {
+	_, err := parseHTML(content, partials)
+	return err
+}
+
+// ValidateText checks that the plain text layout (or partial) and the partials can be parsed.
+func ValidateText(content string, partials map[string]string) error {
+	_, err := parseText(content, partials)
+	return err
+}
+
+func renderHTML(content string, partials map[string]string, data interface{}) (string, error) {
+	tmpl, err := parseHTML(content, partials)
+	if err != nil {
+		return "", err
+	}
+	buf := new(bytes.Buffer)
+	if err := tmpl.Execute(buf, data); err != nil {
+		return "", err
+	}
+	// the translated texts might contain template actions themselves (same as GetParsedTemplate)
+	return ParseTemplateText(buf.String(), data)
+}
+
+func renderText(content string, partials map[string]string, data interface{}) (string, error) {
+	tmpl, err := parseText(content, partials)
+	if err != nil {
+		return "", err
+	}
+	buf := new(bytes.Buffer)
+	if err := tmpl.Execute(buf, data); err != nil {
+		return "", err
+	}
+	second, err := text_template.New("template").Parse(buf.String())
+	if err != nil {
+		return "", err
+	}
+	buf.Reset()
+	if err := second.Execute(buf, data); err != nil {
+		return "", err
+	}
+	return buf.String(), nil
+}
+
+func parseHTML(content string, partials map[string]string) (*html_template.Template, error) {
+	tmpl := html_template.New(layoutTemplateName)
+	for name, partial := range partials {
+		if _, err := tmpl.New(name).Parse(partial); err != nil {
+			return nil, err
+		}
+	}
+	return tmpl.Parse(content)
+}
+
+func parseText(content string, partials map[string]string) (*text_template.Template, error) {
+	tmpl := text_template.New(layoutTemplateName)
+	for name, partial := range partials {
+		if _, err := tmpl.New(name).Parse(partial); err != nil {
+			return nil, err
+		}
+	}
+	return tmpl.Parse(content)
+}
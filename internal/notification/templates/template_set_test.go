@@ -0,0 +1,109 @@
+package templates
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTemplateSet_Layout(t *testing.T) {
+	set := &TemplateSet{
+		Legacy: "legacy",
+		Layouts: map[string]*Layout{
+			"":      {HTML: "default"},
+			"de":    {HTML: "de"},
+			"de-CH": {HTML: "de-CH"},
+		},
+	}
+	tests := []struct {
+		name string
+		set  *TemplateSet
+		lang string
+		want string
+	}{
+		{
+			name: "exact language",
+			set:  set,
+			lang: "de-CH",
+			want: "de-CH",
+		},
+		{
+			name: "base language",
+			set:  set,
+			lang: "de-AT",
+			want: "de",
+		},
+		{
+			name: "default layout",
+			set:  set,
+			lang: "fr",
+			want: "default",
+		},
+		{
+			name: "legacy template",
+			set:  &TemplateSet{Legacy: "legacy"},
+			lang: "fr",
+			want: "legacy",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, tt.set.Layout(tt.lang).HTML)
+		})
+	}
+}
+
+func TestLayout_Render(t *testing.T) {
+	partials := map[string]string{
+		"header": `<h1>{{.Title}}</h1>`,
+		"footer": `{{.FooterText}}`,
+	}
+	data := &TemplateData{
+		Title:      "Verify <email>",
+		Text:       "Your code is {{.URL}}",
+		URL:        "123",
+		FooterText: "ACME",
+	}
+	tests := []struct {
+		name    string
+		layout  *Layout
+		want    *Message
+		wantErr bool
+	}{
+		{
+			name:   "html only",
+			layout: &Layout{HTML: `<html>{{template "header" .}}<p>{{.Text}}</p></html>`},
+			want: &Message{
+				HTML: "<html><h1>Verify &lt;email&gt;</h1><p>Your code is 123</p></html>",
+			},
+		},
+		{
+			name: "html and text",
+			layout: &Layout{
+				HTML: `<html>{{.Text}}</html>`,
+				Text: `{{.Title}}: {{.Text}} - {{template "footer" .}}`,
+			},
+			want: &Message{
+				HTML: "<html>Your code is 123</html>",
+				Text: "Verify <email>: Your code is 123 - ACME",
+			},
+		},
+		{
+			name:    "missing partial",
+			layout:  &Layout{HTML: `<html>{{template "unknown" .}}</html>`},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.layout.Render(partials, data)
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
@@ -0,0 +1,109 @@
+package types
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/notification/channels/webhook"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+// Alert is a security-relevant event posted to the chat channels of the instance administrators
+type Alert struct {
+	// EventType is matched against the event type filters of the alert targets
+	EventType string
+	Title     string
+	Text      string
+	Facts     []AlertFact
+	// HighPriority alerts mention the whole channel on Slack
+	HighPriority bool
+}
+
+type AlertFact struct {
+	Name  string
+	Value string
+}
+
+// SendAlert posts the alert to the incoming webhook of a Slack or Teams channel
+func SendAlert(
+	ctx context.Context,
+	channels ChannelChains,
+	targetType domain.AlertTargetType,
+	webhookURL string,
+	alert *Alert,
+	triggeringEvent eventstore.Event,
+) Notify {
+	return func(_ string, _ map[string]interface{}, _ string, _ bool) error {
+		var payload interface{}
+		switch targetType {
+		case domain.AlertTargetTypeSlack:
+			payload = slackAlertPayload(alert)
+		case domain.AlertTargetTypeTeams:
+			payload = teamsAlertPayload(alert)
+		case domain.AlertTargetTypeUnspecified:
+		}
+		if payload == nil {
+			return zerrors.ThrowInvalidArgument(nil, "NOTIF-Al4pq", "Errors.Alert.Target.TypeInvalid")
+		}
+		return handleWebhook(
+			ctx,
+			webhook.Config{CallURL: webhookURL, Method: http.MethodPost},
+			channels,
+			payload,
+			triggeringEvent,
+		)
+	}
+}
+
+// slackAlertPayload formats the alert as message with blocks, the text is shown in notifications
+func slackAlertPayload(alert *Alert) map[string]interface{} {
+	blocks := []map[string]interface{}{
+		{
+			"type": "header",
+			"text": map[string]interface{}{"type": "plain_text", "text": alert.Title},
+		},
+		{
+			"type": "section",
+			"text": map[string]interface{}{"type": "mrkdwn", "text": alert.Text},
+		},
+	}
+	if len(alert.Facts) > 0 {
+		fields := make([]map[string]interface{}, len(alert.Facts))
+		for i, fact := range alert.Facts {
+			fields[i] = map[string]interface{}{"type": "mrkdwn", "text": "*" + fact.Name + "*\n" + fact.Value}
+		}
+		blocks = append(blocks, map[string]interface{}{
+			"type":   "section",
+			"fields": fields,
+		})
+	}
+	text := alert.Title + ": " + alert.Text
+	if alert.HighPriority {
+		text = "<!channel> " + text
+	}
+	return map[string]interface{}{
+		"text":   text,
+		"blocks": blocks,
+	}
+}
+
+// teamsAlertPayload formats the alert as message card, which is supported by the incoming webhooks of Teams
+func teamsAlertPayload(alert *Alert) map[string]interface{} {
+	facts := make([]map[string]interface{}, len(alert.Facts))
+	for i, fact := range alert.Facts {
+		facts[i] = map[string]interface{}{"name": fact.Name, "value": fact.Value}
+	}
+	return map[string]interface{}{
+		"@type":      "MessageCard",
+		"@context":   "https://schema.org/extensions",
+		"summary":    alert.Title,
+		"themeColor": "D13438",
+		"title":      alert.Title,
+		"text":       alert.Text,
+		"sections": []map[string]interface{}{
+			{"facts": facts},
+		},
+	}
+}
@@ -0,0 +1,85 @@
+package types
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/notification/channels"
+	"github.com/zitadel/zitadel/internal/notification/channels/webhook"
+	"github.com/zitadel/zitadel/internal/notification/senders"
+	"github.com/zitadel/zitadel/internal/repository/instance"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+type webhookChannels struct {
+	smsChannels
+	cfg     webhook.Config
+	content string
+}
+
+func (c *webhookChannels) Webhook(_ context.Context, cfg webhook.Config) (*senders.Chain, error) {
+	c.cfg = cfg
+	return senders.ChainChannels(channels.HandleMessageFunc(func(message channels.Message) (err error) {
+		c.content, err = message.GetContent()
+		return err
+	})), nil
+}
+
+func TestSendAlert(t *testing.T) {
+	alert := &Alert{
+		EventType: "org.member.added",
+		Title:     "Organization owner added",
+		Text:      "A user was added as owner.",
+		Facts:     []AlertFact{{Name: "User", Value: "user1"}},
+	}
+	triggeringEvent := eventstore.BaseEventFromRepo(&eventstore.BaseEvent{Agg: &instance.NewAggregate("instance1").Aggregate})
+	tests := []struct {
+		name        string
+		targetType  domain.AlertTargetType
+		wantContent string
+		wantErr     func(error) bool
+	}{
+		{
+			name:       "invalid type, error",
+			targetType: domain.AlertTargetTypeUnspecified,
+			wantErr:    zerrors.IsErrorInvalidArgument,
+		},
+		{
+			name:        "slack",
+			targetType:  domain.AlertTargetTypeSlack,
+			wantContent: `{"blocks":[{"text":{"text":"Organization owner added","type":"plain_text"},"type":"header"},{"text":{"text":"A user was added as owner.","type":"mrkdwn"},"type":"section"},{"fields":[{"text":"*User*\nuser1","type":"mrkdwn"}],"type":"section"}],"text":"Organization owner added: A user was added as owner."}`,
+		},
+		{
+			name:        "teams",
+			targetType:  domain.AlertTargetTypeTeams,
+			wantContent: `{"@context":"https://schema.org/extensions","@type":"MessageCard","sections":[{"facts":[{"name":"User","value":"user1"}]}],"summary":"Organization owner added","text":"A user was added as owner.","themeColor":"D13438","title":"Organization owner added"}`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := new(webhookChannels)
+			err := SendAlert(context.Background(), c, tt.targetType, "https://hooks.example.com/alerts", alert, triggeringEvent).WithoutTemplate()
+			if tt.wantErr != nil {
+				assert.True(t, tt.wantErr(err))
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, "https://hooks.example.com/alerts", c.cfg.CallURL)
+			assert.JSONEq(t, tt.wantContent, c.content)
+		})
+	}
+}
+
+func Test_slackAlertPayload_highPriority(t *testing.T) {
+	payload := slackAlertPayload(&Alert{
+		Title:        "Break-glass account used",
+		Text:         "User user1 authenticated.",
+		HighPriority: true,
+	})
+	assert.Equal(t, "<!channel> Break-glass account used: User user1 authenticated.", payload["text"])
+}
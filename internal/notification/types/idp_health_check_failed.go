@@ -0,0 +1,37 @@
+package types
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	http_utils "github.com/zitadel/zitadel/internal/api/http"
+	"github.com/zitadel/zitadel/internal/api/ui/console"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/query"
+)
+
+func (notify Notify) SendIDPHealthCheckFailed(ctx context.Context, user *query.NotifyUser, idpID, idpName string, checks []*domain.IDPHealthCheck) error {
+	url := console.LoginHintLink(http_utils.ComposedOrigin(ctx), user.PreferredLoginName)
+	args := make(map[string]interface{})
+	args["IDPID"] = idpID
+	args["IDPName"] = idpName
+	args["FailedChecks"] = failedIDPHealthChecks(checks)
+	return notify(url, args, domain.IDPHealthCheckFailedMessageType, false)
+}
+
+func failedIDPHealthChecks(checks []*domain.IDPHealthCheck) string {
+	failed := make([]string, len(checks))
+	for i, check := range checks {
+		reason := check.Error
+		if reason == "" {
+			reason = "certificate expires on " + check.ExpirationDate.Format(time.DateOnly)
+		}
+		if check.Target == "" {
+			failed[i] = reason
+			continue
+		}
+		failed[i] = check.Target + ": " + reason
+	}
+	return strings.Join(failed, "; ")
+}
@@ -0,0 +1,16 @@
+package types
+
+import (
+	"context"
+
+	http_utils "github.com/zitadel/zitadel/internal/api/http"
+	"github.com/zitadel/zitadel/internal/api/ui/login"
+	"github.com/zitadel/zitadel/internal/domain"
+)
+
+func (notify Notify) SendInviteUser(ctx context.Context, orgID, inviteID, code string) error {
+	url := login.InviteUserLink(http_utils.ComposedOrigin(ctx), orgID, inviteID, code)
+	args := make(map[string]interface{})
+	args["Code"] = code
+	return notify(url, args, domain.InviteUserMessageType, true)
+}
@@ -0,0 +1,20 @@
+package types
+
+import (
+	"context"
+	"time"
+
+	http_utils "github.com/zitadel/zitadel/internal/api/http"
+	"github.com/zitadel/zitadel/internal/api/ui/console"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/query"
+)
+
+func (notify Notify) SendMachineCredentialExpiring(ctx context.Context, user *query.NotifyUser, userLoginName, credentialID string, expirationDate time.Time) error {
+	url := console.LoginHintLink(http_utils.ComposedOrigin(ctx), user.PreferredLoginName)
+	args := make(map[string]interface{})
+	args["UserLoginName"] = userLoginName
+	args["CredentialID"] = credentialID
+	args["ExpirationDate"] = expirationDate.Format(time.DateOnly)
+	return notify(url, args, domain.MachineCredentialExpiringMessageType, false)
+}
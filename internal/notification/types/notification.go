@@ -3,14 +3,17 @@ package types
 import (
 	"context"
 
+	"go.opentelemetry.io/otel/attribute"
+
+	"github.com/zitadel/zitadel/internal/domain"
 	"github.com/zitadel/zitadel/internal/eventstore"
 	"github.com/zitadel/zitadel/internal/i18n"
-	"github.com/zitadel/zitadel/internal/notification/channels/smtp"
-	"github.com/zitadel/zitadel/internal/notification/channels/twilio"
+	"github.com/zitadel/zitadel/internal/notification/channels/email"
 	"github.com/zitadel/zitadel/internal/notification/channels/webhook"
 	"github.com/zitadel/zitadel/internal/notification/senders"
 	"github.com/zitadel/zitadel/internal/notification/templates"
 	"github.com/zitadel/zitadel/internal/query"
+	"github.com/zitadel/zitadel/internal/telemetry/tracing"
 )
 
 type Notify func(
@@ -21,15 +24,20 @@ type Notify func(
 ) error
 
 type ChannelChains interface {
-	Email(context.Context) (*senders.Chain, *smtp.Config, error)
-	SMS(context.Context) (*senders.Chain, *twilio.Config, error)
+	Email(context.Context) (*senders.Chain, *email.Config, error)
+	SMS(ctx context.Context, recipient string) ([]*senders.SMSChain, error)
 	Webhook(context.Context, webhook.Config) (*senders.Chain, error)
+	// Push returns the chain delivering push notifications to devices of the platform
+	Push(ctx context.Context, platform domain.PushPlatform) (*senders.Chain, error)
+	// Enqueue queues a message, whose delivery failed, for asynchronous retries.
+	// It returns the delivery error if the message cannot be queued.
+	Enqueue(ctx context.Context, message *QueuedMessage, deliveryErr error) error
 }
 
 func SendEmail(
 	ctx context.Context,
 	channels ChannelChains,
-	mailhtml string,
+	mailTemplate *templates.TemplateSet,
 	translator *i18n.Translator,
 	user *query.NotifyUser,
 	colors *query.LabelPolicy,
@@ -43,7 +51,7 @@ func SendEmail(
 	) error {
 		args = mapNotifyUserToArgs(user, args)
 		data := GetTemplateData(ctx, translator, args, url, messageType, user.PreferredLanguage.String(), colors)
-		template, err := templates.GetParsedTemplate(mailhtml, data)
+		message, err := renderEmail(ctx, mailTemplate, user.PreferredLanguage.String(), &data, colors)
 		if err != nil {
 			return err
 		}
@@ -52,7 +60,7 @@ func SendEmail(
 			channels,
 			user,
 			data.Subject,
-			template,
+			message,
 			allowUnverifiedNotificationChannel,
 			triggeringEvent,
 		)
@@ -86,6 +94,36 @@ func SendSMSTwilio(
 	}
 }
 
+// SendPush delivers the notification to the devices the user registered for push notifications
+func SendPush(
+	ctx context.Context,
+	channels ChannelChains,
+	translator *i18n.Translator,
+	user *query.NotifyUser,
+	devices []*query.PushDevice,
+	colors *query.LabelPolicy,
+	triggeringEvent eventstore.Event,
+) Notify {
+	return func(
+		url string,
+		args map[string]interface{},
+		messageType string,
+		_ bool,
+	) error {
+		args = mapNotifyUserToArgs(user, args)
+		data := GetTemplateData(ctx, translator, args, url, messageType, user.PreferredLanguage.String(), colors)
+		return generatePush(
+			ctx,
+			channels,
+			devices,
+			data.Title,
+			data.Text,
+			url,
+			triggeringEvent,
+		)
+	}
+}
+
 func SendJSON(
 	ctx context.Context,
 	webhookConfig webhook.Config,
@@ -103,3 +141,11 @@ func SendJSON(
 		)
 	}
 }
+
+// setNotificationAttributes adds the triggering event to the span of the notification
+func setNotificationAttributes(span *tracing.Span, triggeringEvent eventstore.Event) {
+	span.SetAttributes(
+		tracing.HashedAttribute(tracing.InstanceIDAttribute, triggeringEvent.Aggregate().InstanceID),
+		attribute.String("zitadel.event_type", string(triggeringEvent.Type())),
+	)
+}
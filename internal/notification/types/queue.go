@@ -0,0 +1,65 @@
+package types
+
+import (
+	"context"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/notification/templates"
+	"github.com/zitadel/zitadel/internal/repository/notification"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+// QueuedMessage is a rendered notification, which is queued for asynchronous retries if its first delivery failed
+type QueuedMessage struct {
+	Channel               string                  `json:"channel"`
+	Recipient             string                  `json:"recipient"`
+	Subject               string                  `json:"subject,omitempty"`
+	Content               string                  `json:"content"`
+	TextContent           string                  `json:"textContent,omitempty"`
+	Attachments           []*templates.Attachment `json:"attachments,omitempty"`
+	TriggeringAggregateID string                  `json:"triggeringAggregateId"`
+	TriggeringEventType   eventstore.EventType    `json:"triggeringEventType"`
+}
+
+func newQueuedMessage(channel, recipient string, triggeringEvent eventstore.Event) *QueuedMessage {
+	return &QueuedMessage{
+		Channel:               channel,
+		Recipient:             recipient,
+		TriggeringAggregateID: triggeringEvent.Aggregate().ID,
+		TriggeringEventType:   triggeringEvent.Type(),
+	}
+}
+
+// Send retries the delivery of the queued message.
+// The message is not queued again, if it fails.
+func (m *QueuedMessage) Send(ctx context.Context, channels ChannelChains) error {
+	triggeringEvent := &eventstore.BaseEvent{
+		EventType: m.TriggeringEventType,
+		Agg: &eventstore.Aggregate{
+			ID:         m.TriggeringAggregateID,
+			InstanceID: authz.GetInstance(ctx).InstanceID(),
+		},
+	}
+	switch m.Channel {
+	case notification.DeliveryChannelEmail:
+		return sendEmail(ctx, channels, m.Recipient, m.Subject, &templates.Message{
+			HTML:        m.Content,
+			Text:        m.TextContent,
+			Attachments: m.Attachments,
+		}, triggeringEvent)
+	case notification.DeliveryChannelSMS:
+		return sendSms(ctx, channels, m.Recipient, m.Content, triggeringEvent)
+	default:
+		return zerrors.ThrowInvalidArgument(nil, "NOTIF-c8qj3vxe1w", "Errors.Notification.Queue.Invalid")
+	}
+}
+
+// enqueue queues the message if it could not be delivered because of an error of the providers.
+// Missing providers are not retried.
+func enqueue(ctx context.Context, channels ChannelChains, message *QueuedMessage, deliveryErr error) error {
+	if deliveryErr == nil || zerrors.IsPreconditionFailed(deliveryErr) {
+		return deliveryErr
+	}
+	return channels.Enqueue(ctx, message, deliveryErr)
+}
@@ -3,10 +3,18 @@ package types
 import (
 	"context"
 	"html"
+	"path"
+
+	"github.com/zitadel/logging"
 
 	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/id"
+	"github.com/zitadel/zitadel/internal/notification/channels/email"
 	"github.com/zitadel/zitadel/internal/notification/messages"
+	"github.com/zitadel/zitadel/internal/notification/templates"
 	"github.com/zitadel/zitadel/internal/query"
+	"github.com/zitadel/zitadel/internal/repository/notification"
+	"github.com/zitadel/zitadel/internal/telemetry/tracing"
 	"github.com/zitadel/zitadel/internal/zerrors"
 )
 
@@ -14,29 +22,112 @@ func generateEmail(
 	ctx context.Context,
 	channels ChannelChains,
 	user *query.NotifyUser,
-	subject,
-	content string,
+	subject string,
+	message *templates.Message,
 	lastEmail bool,
 	triggeringEvent eventstore.Event,
-) error {
-	content = html.UnescapeString(content)
-	message := &messages.Email{
-		Recipients:      []string{user.VerifiedEmail},
-		Subject:         subject,
-		Content:         content,
-		TriggeringEvent: triggeringEvent,
-	}
+) (err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+	setNotificationAttributes(span, triggeringEvent)
+
+	recipient := user.VerifiedEmail
 	if lastEmail {
-		message.Recipients = []string{user.LastEmail}
+		recipient = user.LastEmail
 	}
-	emailChannels, _, err := channels.Email(ctx)
+	err = sendEmail(ctx, channels, recipient, subject, message, triggeringEvent)
+	queued := newQueuedMessage(notification.DeliveryChannelEmail, recipient, triggeringEvent)
+	queued.Subject = subject
+	queued.Content = message.HTML
+	queued.TextContent = message.Text
+	queued.Attachments = message.Attachments
+	return enqueue(ctx, channels, queued, err)
+}
+
+func sendEmail(
+	ctx context.Context,
+	channels ChannelChains,
+	recipient,
+	subject string,
+	message *templates.Message,
+	triggeringEvent eventstore.Event,
+) error {
+	content := html.UnescapeString(message.HTML)
+	emailChannels, config, err := channels.Email(ctx)
 	if err != nil {
 		return err
 	}
 	if emailChannels == nil || emailChannels.Len() == 0 {
 		return zerrors.ThrowPreconditionFailed(nil, "MAIL-83nof", "Errors.Notification.Channels.NotPresent")
 	}
-	return emailChannels.HandleMessage(message)
+	if config != nil && config.WebhookConfig != nil {
+		messageID, err := id.SonyFlakeGenerator().Next()
+		if err != nil {
+			return err
+		}
+		return emailChannels.HandleMessage(&messages.JSON{
+			MessageID: messageID,
+			Serializable: &EmailNotification{
+				MessageID:   messageID,
+				Provider:    config.ProviderConfig,
+				EventType:   triggeringEvent.Type(),
+				Recipients:  []string{recipient},
+				Subject:     subject,
+				Content:     content,
+				TextContent: message.Text,
+			},
+			TriggeringEvent: triggeringEvent,
+		})
+	}
+	return emailChannels.HandleMessage(&messages.Email{
+		Recipients:      []string{recipient},
+		Subject:         subject,
+		Content:         content,
+		TextContent:     message.Text,
+		Attachments:     message.Attachments,
+		TriggeringEvent: triggeringEvent,
+	})
+}
+
+// renderEmail renders the layout of the template set matching the language of the user.
+// If the layout requires the logo to be inline, it's attached to the message and referenced by its content id.
+func renderEmail(ctx context.Context, mailTemplate *templates.TemplateSet, lang string, data *templates.TemplateData, policy *query.LabelPolicy) (*templates.Message, error) {
+	layout := mailTemplate.Layout(lang)
+	var logo *templates.Attachment
+	if layout.InlineLogo && mailTemplate.Assets != nil && policy.Light.LogoURL != "" {
+		content, contentType, err := mailTemplate.Assets(ctx, policy.ID, policy.Light.LogoURL)
+		// the logo is still referenced by its url if it cannot be loaded
+		logging.OnError(err).WithField("policy", policy.ID).Warn("unable to load logo for inline attachment")
+		if err == nil {
+			logo = &templates.Attachment{
+				Filename:    path.Base(policy.Light.LogoURL),
+				ContentType: contentType,
+				ContentID:   templates.LogoContentID,
+				Data:        content,
+			}
+			data.LogoURL = "cid:" + templates.LogoContentID
+		}
+	}
+	message, err := layout.Render(mailTemplate.Partials, data)
+	if err != nil {
+		return nil, err
+	}
+	if logo != nil {
+		message.Attachments = append(message.Attachments, logo)
+	}
+	return message, nil
+}
+
+// EmailNotification is the payload sent to email providers of type HTTP
+type EmailNotification struct {
+	MessageID  string               `json:"messageId"`
+	Provider   *email.Provider      `json:"provider,omitempty"`
+	EventType  eventstore.EventType `json:"eventType,omitempty"`
+	Recipients []string             `json:"recipients"`
+	Subject    string               `json:"subject"`
+	Content    string               `json:"content"`
+	// TextContent is the plain text alternative of the content, if the layout defines one
+	TextContent string `json:"textContent,omitempty"`
 }
 
 func mapNotifyUserToArgs(user *query.NotifyUser, args map[string]interface{}) map[string]interface{} {
@@ -0,0 +1,19 @@
+package types
+
+import (
+	"context"
+	"time"
+
+	http_utils "github.com/zitadel/zitadel/internal/api/http"
+	"github.com/zitadel/zitadel/internal/api/ui/console"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/query"
+)
+
+func (notify Notify) SendUserLifecycleActionScheduled(ctx context.Context, user *query.NotifyUser, action domain.UserLifecycleAction, userLoginName string, dueDate time.Time) error {
+	url := console.LoginHintLink(http_utils.ComposedOrigin(ctx), user.PreferredLoginName)
+	args := make(map[string]interface{})
+	args["UserLoginName"] = userLoginName
+	args["DueDate"] = dueDate.Format(time.DateOnly)
+	return notify(url, args, action.MessageType(), false)
+}
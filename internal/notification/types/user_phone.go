@@ -2,12 +2,18 @@ package types
 
 import (
 	"context"
+	"slices"
 
 	"github.com/zitadel/logging"
 
 	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/id"
+	"github.com/zitadel/zitadel/internal/notification/channels/sms"
 	"github.com/zitadel/zitadel/internal/notification/messages"
+	"github.com/zitadel/zitadel/internal/notification/senders"
 	"github.com/zitadel/zitadel/internal/query"
+	"github.com/zitadel/zitadel/internal/repository/notification"
+	"github.com/zitadel/zitadel/internal/telemetry/tracing"
 	"github.com/zitadel/zitadel/internal/zerrors"
 )
 
@@ -18,24 +24,99 @@ func generateSms(
 	content string,
 	lastPhone bool,
 	triggeringEvent eventstore.Event,
+) (err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+	setNotificationAttributes(span, triggeringEvent)
+
+	recipient := user.VerifiedPhone
+	if lastPhone {
+		recipient = user.LastPhone
+	}
+	err = sendSms(ctx, channels, recipient, content, triggeringEvent)
+	queued := newQueuedMessage(notification.DeliveryChannelSMS, recipient, triggeringEvent)
+	queued.Content = content
+	return enqueue(ctx, channels, queued, err)
+}
+
+// sendSms tries all providers able to deliver to the recipient until one accepts the message
+func sendSms(
+	ctx context.Context,
+	channels ChannelChains,
+	recipient,
+	content string,
+	triggeringEvent eventstore.Event,
 ) error {
-	number := ""
-	smsChannels, twilioConfig, err := channels.SMS(ctx)
+	smsChains, err := channels.SMS(ctx, recipient)
 	logging.OnError(err).Error("could not create sms channel")
-	if smsChannels == nil || smsChannels.Len() == 0 {
+	if len(smsChains) == 0 {
 		return zerrors.ThrowPreconditionFailed(nil, "PHONE-w8nfow", "Errors.Notification.Channels.NotPresent")
 	}
-	if err == nil {
-		number = twilioConfig.SenderNumber
+	// the message id is shared by all providers, so the delivery status of a message reflects the failover
+	var messageID string
+	if slices.ContainsFunc(smsChains, func(smsChain *senders.SMSChain) bool {
+		return smsChain.Config != nil && smsChain.Config.WebhookConfig != nil
+	}) {
+		messageID, err = id.SonyFlakeGenerator().Next()
+		if err != nil {
+			return err
+		}
 	}
-	message := &messages.SMS{
+	for _, smsChain := range smsChains {
+		err = sendSmsChain(smsChain, messageID, recipient, content, triggeringEvent)
+		if err == nil {
+			return nil
+		}
+		logging.WithFields("provider", smsProviderID(smsChain.Config)).WithError(err).Warn("sms delivery failed, trying next provider")
+	}
+	return err
+}
+
+func sendSmsChain(
+	smsChain *senders.SMSChain,
+	messageID,
+	recipient,
+	content string,
+	triggeringEvent eventstore.Event,
+) error {
+	config := smsChain.Config
+	if config != nil && config.WebhookConfig != nil {
+		return smsChain.HandleMessage(&messages.JSON{
+			MessageID: messageID,
+			Serializable: &SMSNotification{
+				MessageID:            messageID,
+				Provider:             config.ProviderConfig,
+				EventType:            triggeringEvent.Type(),
+				RecipientPhoneNumber: recipient,
+				Content:              content,
+			},
+			TriggeringEvent: triggeringEvent,
+		})
+	}
+	number := ""
+	if config != nil && config.TwilioConfig != nil {
+		number = config.TwilioConfig.SenderNumber
+	}
+	return smsChain.HandleMessage(&messages.SMS{
 		SenderPhoneNumber:    number,
-		RecipientPhoneNumber: user.VerifiedPhone,
+		RecipientPhoneNumber: recipient,
 		Content:              content,
 		TriggeringEvent:      triggeringEvent,
+	})
+}
+
+func smsProviderID(config *sms.Config) string {
+	if config == nil || config.ProviderConfig == nil {
+		return ""
 	}
-	if lastPhone {
-		message.RecipientPhoneNumber = user.LastPhone
-	}
-	return smsChannels.HandleMessage(message)
+	return config.ProviderConfig.ID
+}
+
+// SMSNotification is the payload sent to SMS providers of type HTTP
+type SMSNotification struct {
+	MessageID            string               `json:"messageId"`
+	Provider             *sms.Provider        `json:"provider,omitempty"`
+	EventType            eventstore.EventType `json:"eventType,omitempty"`
+	RecipientPhoneNumber string               `json:"recipientPhoneNumber"`
+	Content              string               `json:"content"`
 }
@@ -0,0 +1,152 @@
+package types
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/notification/channels"
+	"github.com/zitadel/zitadel/internal/notification/channels/email"
+	"github.com/zitadel/zitadel/internal/notification/channels/sms"
+	"github.com/zitadel/zitadel/internal/notification/channels/twilio"
+	"github.com/zitadel/zitadel/internal/notification/channels/webhook"
+	"github.com/zitadel/zitadel/internal/notification/messages"
+	"github.com/zitadel/zitadel/internal/notification/senders"
+	"github.com/zitadel/zitadel/internal/query"
+	"github.com/zitadel/zitadel/internal/repository/user"
+)
+
+type smsChannels struct {
+	chains []*senders.SMSChain
+	err    error
+	queue  bool
+	queued *QueuedMessage
+}
+
+func (c *smsChannels) Email(context.Context) (*senders.Chain, *email.Config, error) {
+	return nil, nil, nil
+}
+
+func (c *smsChannels) SMS(context.Context, string) ([]*senders.SMSChain, error) {
+	return c.chains, c.err
+}
+
+func (c *smsChannels) Webhook(context.Context, webhook.Config) (*senders.Chain, error) {
+	return nil, nil
+}
+
+func (c *smsChannels) Push(context.Context, domain.PushPlatform) (*senders.Chain, error) {
+	return nil, nil
+}
+
+func (c *smsChannels) Enqueue(_ context.Context, message *QueuedMessage, deliveryErr error) error {
+	if !c.queue {
+		return deliveryErr
+	}
+	c.queued = message
+	return nil
+}
+
+// smsProvider returns a provider chain which records the sent messages and fails with err
+func smsProvider(id string, err error, sent *[]string) *senders.SMSChain {
+	return &senders.SMSChain{
+		Chain: senders.ChainChannels(channels.HandleMessageFunc(func(message channels.Message) error {
+			*sent = append(*sent, id+":"+message.(*messages.SMS).SenderPhoneNumber)
+			return err
+		})),
+		Config: &sms.Config{
+			ProviderConfig: &sms.Provider{ID: id},
+			TwilioConfig:   &twilio.Config{SenderNumber: id},
+		},
+	}
+}
+
+func Test_generateSms(t *testing.T) {
+	errProvider := errors.New("provider unavailable")
+	tests := []struct {
+		name       string
+		channels   func(sent *[]string) *smsChannels
+		wantSent   []string
+		wantQueued *QueuedMessage
+		wantErr    error
+	}{
+		{
+			name: "no provider, precondition error",
+			channels: func(*[]string) *smsChannels {
+				return &smsChannels{err: errProvider}
+			},
+			wantSent: nil,
+			wantErr:  errors.New("Errors.Notification.Channels.NotPresent"),
+		},
+		{
+			name: "first provider succeeds",
+			channels: func(sent *[]string) *smsChannels {
+				return &smsChannels{chains: []*senders.SMSChain{
+					smsProvider("primary", nil, sent),
+					smsProvider("fallback", nil, sent),
+				}}
+			},
+			wantSent: []string{"primary:primary"},
+		},
+		{
+			name: "failover to next provider",
+			channels: func(sent *[]string) *smsChannels {
+				return &smsChannels{chains: []*senders.SMSChain{
+					smsProvider("primary", errProvider, sent),
+					smsProvider("fallback", nil, sent),
+				}}
+			},
+			wantSent: []string{"primary:primary", "fallback:fallback"},
+		},
+		{
+			name: "all providers fail, last error",
+			channels: func(sent *[]string) *smsChannels {
+				return &smsChannels{chains: []*senders.SMSChain{
+					smsProvider("primary", errors.New("primary failed"), sent),
+					smsProvider("fallback", errProvider, sent),
+				}}
+			},
+			wantSent: []string{"primary:primary", "fallback:fallback"},
+			wantErr:  errProvider,
+		},
+		{
+			name: "all providers fail, queued",
+			channels: func(sent *[]string) *smsChannels {
+				return &smsChannels{
+					chains: []*senders.SMSChain{
+						smsProvider("primary", errProvider, sent),
+					},
+					queue: true,
+				}
+			},
+			wantSent: []string{"primary:primary"},
+			wantQueued: &QueuedMessage{
+				Channel:               "sms",
+				Recipient:             "+41791234567",
+				Content:               "content",
+				TriggeringAggregateID: "user",
+				TriggeringEventType:   user.HumanPhoneCodeAddedType,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var sent []string
+			triggeringEvent := user.NewHumanPhoneCodeAddedEvent(context.Background(), &user.NewAggregate("user", "org").Aggregate, nil, time.Hour)
+			smsChannels := tt.channels(&sent)
+			err := generateSms(context.Background(), smsChannels, &query.NotifyUser{VerifiedPhone: "+41791234567"}, "content", false, triggeringEvent)
+			if tt.wantErr != nil {
+				require.ErrorContains(t, err, tt.wantErr.Error())
+			} else {
+				require.NoError(t, err)
+			}
+			assert.Equal(t, tt.wantSent, sent)
+			assert.Equal(t, tt.wantQueued, smsChannels.queued)
+		})
+	}
+}
@@ -0,0 +1,68 @@
+package types
+
+import (
+	"context"
+
+	"github.com/zitadel/logging"
+
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/notification/messages"
+	"github.com/zitadel/zitadel/internal/notification/senders"
+	"github.com/zitadel/zitadel/internal/query"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+// generatePush pushes the notification to every device of a configured platform.
+// It succeeds if at least one device received it.
+// Push notifications are not queued for retries, the caller can fall back to another channel instead.
+func generatePush(
+	ctx context.Context,
+	channels ChannelChains,
+	devices []*query.PushDevice,
+	title,
+	body,
+	url string,
+	triggeringEvent eventstore.Event,
+) (err error) {
+	data := map[string]string{
+		"eventType":   string(triggeringEvent.Type()),
+		"aggregateId": triggeringEvent.Aggregate().ID,
+	}
+	if url != "" {
+		data["url"] = url
+	}
+	chains := make(map[domain.PushPlatform]*senders.Chain, 2)
+	var delivered bool
+	for _, device := range devices {
+		chain, ok := chains[device.Platform]
+		if !ok {
+			var chainErr error
+			chain, chainErr = channels.Push(ctx, device.Platform)
+			logging.WithFields("platform", device.Platform).OnError(chainErr).Debug("could not create push channel")
+			chains[device.Platform] = chain
+		}
+		if chain == nil || chain.Len() == 0 {
+			continue
+		}
+		err = chain.HandleMessage(&messages.Push{
+			DeviceToken:     device.Token,
+			Title:           title,
+			Body:            body,
+			Data:            data,
+			TriggeringEvent: triggeringEvent,
+		})
+		if err != nil {
+			logging.WithFields("device", device.ID).WithError(err).Warn("push notification delivery failed")
+			continue
+		}
+		delivered = true
+	}
+	if delivered {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	return zerrors.ThrowPreconditionFailed(nil, "PUSH-Rk3mq", "Errors.Notification.Channels.NotPresent")
+}
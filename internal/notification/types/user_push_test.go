@@ -0,0 +1,114 @@
+package types
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/notification/channels"
+	"github.com/zitadel/zitadel/internal/notification/messages"
+	"github.com/zitadel/zitadel/internal/notification/senders"
+	"github.com/zitadel/zitadel/internal/query"
+	"github.com/zitadel/zitadel/internal/repository/session"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+type pushChannels struct {
+	smsChannels
+	chains map[domain.PushPlatform]*senders.Chain
+}
+
+func (c *pushChannels) Push(_ context.Context, platform domain.PushPlatform) (*senders.Chain, error) {
+	chain, ok := c.chains[platform]
+	if !ok {
+		return nil, zerrors.ThrowPreconditionFailed(nil, "TEST-Pu4dk", "Errors.Push.NotFound")
+	}
+	return chain, nil
+}
+
+// pushService returns a chain which records the devices it pushed to and fails with err
+func pushService(err error, sent *[]string) *senders.Chain {
+	return senders.ChainChannels(channels.HandleMessageFunc(func(message channels.Message) error {
+		*sent = append(*sent, message.(*messages.Push).DeviceToken)
+		return err
+	}))
+}
+
+func Test_generatePush(t *testing.T) {
+	errService := errors.New("service unavailable")
+	devices := []*query.PushDevice{
+		{ID: "device1", Platform: domain.PushPlatformFCM, Token: "android"},
+		{ID: "device2", Platform: domain.PushPlatformAPNs, Token: "ios"},
+	}
+	tests := []struct {
+		name     string
+		chains   func(sent *[]string) map[domain.PushPlatform]*senders.Chain
+		wantSent []string
+		wantErr  error
+	}{
+		{
+			name: "no platform configured, precondition error",
+			chains: func(*[]string) map[domain.PushPlatform]*senders.Chain {
+				return nil
+			},
+			wantErr: errors.New("Errors.Notification.Channels.NotPresent"),
+		},
+		{
+			name: "all devices",
+			chains: func(sent *[]string) map[domain.PushPlatform]*senders.Chain {
+				return map[domain.PushPlatform]*senders.Chain{
+					domain.PushPlatformFCM:  pushService(nil, sent),
+					domain.PushPlatformAPNs: pushService(nil, sent),
+				}
+			},
+			wantSent: []string{"android", "ios"},
+		},
+		{
+			name: "one platform configured",
+			chains: func(sent *[]string) map[domain.PushPlatform]*senders.Chain {
+				return map[domain.PushPlatform]*senders.Chain{
+					domain.PushPlatformAPNs: pushService(nil, sent),
+				}
+			},
+			wantSent: []string{"ios"},
+		},
+		{
+			name: "one device fails, ok",
+			chains: func(sent *[]string) map[domain.PushPlatform]*senders.Chain {
+				return map[domain.PushPlatform]*senders.Chain{
+					domain.PushPlatformFCM:  pushService(errService, sent),
+					domain.PushPlatformAPNs: pushService(nil, sent),
+				}
+			},
+			wantSent: []string{"android", "ios"},
+		},
+		{
+			name: "all devices fail, error",
+			chains: func(sent *[]string) map[domain.PushPlatform]*senders.Chain {
+				return map[domain.PushPlatform]*senders.Chain{
+					domain.PushPlatformFCM: pushService(errService, sent),
+				}
+			},
+			wantSent: []string{"android"},
+			wantErr:  errService,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var sent []string
+			triggeringEvent := session.NewOTPSMSChallengedEvent(context.Background(), &session.NewAggregate("session", "instance").Aggregate, nil, time.Minute, false)
+			err := generatePush(context.Background(), &pushChannels{chains: tt.chains(&sent)}, devices, "title", "body", "", triggeringEvent)
+			if tt.wantErr != nil {
+				require.ErrorContains(t, err, tt.wantErr.Error())
+			} else {
+				require.NoError(t, err)
+			}
+			assert.Equal(t, tt.wantSent, sent)
+		})
+	}
+}
@@ -0,0 +1,18 @@
+package types
+
+import (
+	"context"
+	"strings"
+
+	http_utils "github.com/zitadel/zitadel/internal/api/http"
+	"github.com/zitadel/zitadel/internal/api/ui/login"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/query"
+)
+
+func (notify Notify) SendUsernameRecovery(ctx context.Context, user *query.NotifyUser) error {
+	url := login.LoginLink(http_utils.ComposedOrigin(ctx), user.ResourceOwner)
+	args := make(map[string]interface{})
+	args["LoginNameList"] = strings.Join(user.LoginNames, ", ")
+	return notify(url, args, domain.UsernameRecoveryMessageType, false)
+}
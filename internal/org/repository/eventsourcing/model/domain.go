@@ -65,6 +65,19 @@ func (o *Org) appendVerifyDomainEvent(event eventstore.Event) error {
 	return nil
 }
 
+func (o *Org) appendUnverifyDomainEvent(event eventstore.Event) error {
+	domain := new(OrgDomain)
+	err := domain.SetData(event)
+	if err != nil {
+		return err
+	}
+	if i, d := GetDomain(o.Domains, domain.Domain); d != nil {
+		d.Verified = false
+		o.Domains[i] = d
+	}
+	return nil
+}
+
 func (o *Org) appendPrimaryDomainEvent(event eventstore.Event) error {
 	domain := new(OrgDomain)
 	err := domain.SetData(event)
@@ -62,9 +62,11 @@ func (o *Org) AppendEvent(event eventstore.Event) (err error) {
 		if err != nil {
 			return err
 		}
-	case org.OrgDeactivatedEventType:
+	case org.OrgDeactivatedEventType,
+		org.OrgArchivedEventType:
 		o.State = int32(org_model.OrgStateInactive)
-	case org.OrgReactivatedEventType:
+	case org.OrgReactivatedEventType,
+		org.OrgUnarchivedEventType:
 		o.State = int32(org_model.OrgStateActive)
 	case org.OrgDomainAddedEventType:
 		err = o.appendAddDomainEvent(event)
@@ -76,6 +78,8 @@ func (o *Org) AppendEvent(event eventstore.Event) (err error) {
 		err = o.appendPrimaryDomainEvent(event)
 	case org.OrgDomainRemovedEventType:
 		err = o.appendRemoveDomainEvent(event)
+	case org.OrgDomainUnverifiedEventType:
+		err = o.appendUnverifyDomainEvent(event)
 	case org.DomainPolicyAddedEventType:
 		err = o.appendAddDomainPolicyEvent(event)
 	case org.DomainPolicyChangedEventType:
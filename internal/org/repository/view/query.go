@@ -22,11 +22,14 @@ func OrgByIDQuery(id, instanceID string, latestSequence uint64) (*eventstore.Sea
 			org.OrgChangedEventType,
 			org.OrgDeactivatedEventType,
 			org.OrgReactivatedEventType,
+			org.OrgArchivedEventType,
+			org.OrgUnarchivedEventType,
 			org.OrgDomainAddedEventType,
 			org.OrgDomainVerificationAddedEventType,
 			org.OrgDomainVerifiedEventType,
 			org.OrgDomainPrimarySetEventType,
 			org.OrgDomainRemovedEventType,
+			org.OrgDomainUnverifiedEventType,
 			org.DomainPolicyAddedEventType,
 			org.DomainPolicyChangedEventType,
 			org.DomainPolicyRemovedEventType,
@@ -0,0 +1,110 @@
+package passwordbreach
+
+import (
+	"context"
+	"crypto/sha1"
+	"encoding/binary"
+	"io"
+	"os"
+
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+// BloomFilter is a local set of breached password hashes.
+// It never reports a breached password as safe,
+// but might reject a small amount of passwords which were never breached.
+//
+// The file format consists of the number of bits (uint64, big endian),
+// the number of hash functions (uint32, big endian) followed by the bits.
+// The positions are derived from the SHA-1 digest of the password,
+// so a filter can be created from the hash lists of Have I Been Pwned.
+type BloomFilter struct {
+	bits   []byte
+	size   uint64
+	hashes uint32
+}
+
+// NewBloomFilter creates an empty filter with size bits and the given amount of hash functions.
+func NewBloomFilter(size uint64, hashes uint32) (*BloomFilter, error) {
+	if size == 0 || hashes == 0 {
+		return nil, zerrors.ThrowInvalidArgument(nil, "BREACH-Qm3xr", "bloom filter size and hashes must be greater than 0")
+	}
+	return &BloomFilter{
+		bits:   make([]byte, (size+7)/8),
+		size:   size,
+		hashes: hashes,
+	}, nil
+}
+
+// LoadBloomFilter reads a filter from the file at path.
+func LoadBloomFilter(path string) (*BloomFilter, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, zerrors.ThrowInternal(err, "BREACH-Ew1pa", "unable to open bloom filter")
+	}
+	defer file.Close()
+	return ReadBloomFilter(file)
+}
+
+// ReadBloomFilter reads a filter written by [BloomFilter.WriteTo].
+func ReadBloomFilter(r io.Reader) (*BloomFilter, error) {
+	var size uint64
+	var hashes uint32
+	if err := binary.Read(r, binary.BigEndian, &size); err != nil {
+		return nil, zerrors.ThrowInternal(err, "BREACH-Ak9vd", "unable to read bloom filter header")
+	}
+	if err := binary.Read(r, binary.BigEndian, &hashes); err != nil {
+		return nil, zerrors.ThrowInternal(err, "BREACH-Bn4cw", "unable to read bloom filter header")
+	}
+	filter, err := NewBloomFilter(size, hashes)
+	if err != nil {
+		return nil, err
+	}
+	if _, err = io.ReadFull(r, filter.bits); err != nil {
+		return nil, zerrors.ThrowInternal(err, "BREACH-Vo2ls", "unable to read bloom filter")
+	}
+	return filter, nil
+}
+
+// WriteTo writes the filter in the format expected by [ReadBloomFilter].
+func (f *BloomFilter) WriteTo(w io.Writer) (int64, error) {
+	if err := binary.Write(w, binary.BigEndian, f.size); err != nil {
+		return 0, err
+	}
+	if err := binary.Write(w, binary.BigEndian, f.hashes); err != nil {
+		return 8, err
+	}
+	n, err := w.Write(f.bits)
+	return int64(12 + n), err
+}
+
+// Add adds the SHA-1 digest of a breached password to the filter.
+func (f *BloomFilter) Add(digest [sha1.Size]byte) {
+	for _, position := range f.positions(digest) {
+		f.bits[position/8] |= 1 << (position % 8)
+	}
+}
+
+func (f *BloomFilter) contains(digest [sha1.Size]byte) bool {
+	for _, position := range f.positions(digest) {
+		if f.bits[position/8]&(1<<(position%8)) == 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// positions uses double hashing to derive the bit positions from the digest
+func (f *BloomFilter) positions(digest [sha1.Size]byte) []uint64 {
+	h1 := binary.BigEndian.Uint64(digest[0:8])
+	h2 := binary.BigEndian.Uint64(digest[8:16])
+	positions := make([]uint64, f.hashes)
+	for i := range positions {
+		positions[i] = (h1 + uint64(i)*h2) % f.size
+	}
+	return positions
+}
+
+func (f *BloomFilter) IsBreached(_ context.Context, password string) (bool, error) {
+	return f.contains(hash(password)), nil
+}
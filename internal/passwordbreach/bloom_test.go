@@ -0,0 +1,44 @@
+package passwordbreach
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBloomFilter_IsBreached(t *testing.T) {
+	filter, err := NewBloomFilter(1<<16, 7)
+	require.NoError(t, err)
+	filter.Add(hash("password"))
+	filter.Add(hash("123456"))
+
+	buf := new(bytes.Buffer)
+	_, err = filter.WriteTo(buf)
+	require.NoError(t, err)
+	loaded, err := ReadBloomFilter(buf)
+	require.NoError(t, err)
+
+	tests := []struct {
+		password string
+		want     bool
+	}{
+		{password: "password", want: true},
+		{password: "123456", want: true},
+		{password: "correct horse battery staple", want: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.password, func(t *testing.T) {
+			got, err := loaded.IsBreached(context.Background(), tt.password)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestReadBloomFilter_invalid(t *testing.T) {
+	_, err := ReadBloomFilter(bytes.NewReader([]byte{0, 0, 0}))
+	assert.Error(t, err)
+}
@@ -0,0 +1,18 @@
+package passwordbreach
+
+import (
+	"context"
+	"crypto/sha1"
+)
+
+// Checker checks if a password is known to be part of a data breach.
+type Checker interface {
+	IsBreached(ctx context.Context, password string) (bool, error)
+}
+
+// hash returns the SHA-1 digest of the password,
+// which is the format breached password lists like Have I Been Pwned are distributed in.
+func hash(password string) [sha1.Size]byte {
+	//nolint:gosec // SHA-1 is required by the format of the breached password lists
+	return sha1.Sum([]byte(password))
+}
@@ -0,0 +1,74 @@
+package passwordbreach
+
+import (
+	"bufio"
+	"context"
+	"encoding/hex"
+	"net/http"
+	"strings"
+
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+const (
+	DefaultRangeURL = "https://api.pwnedpasswords.com/range/"
+
+	prefixLength = 5
+)
+
+// RangeChecker uses the range API of Have I Been Pwned.
+// Only the first 5 characters of the hex encoded SHA-1 hash of the password are sent (k-anonymity),
+// the remaining suffix is compared against the returned list locally.
+type RangeChecker struct {
+	rangeURL   string
+	httpClient *http.Client
+}
+
+func NewRangeChecker(httpClient *http.Client, rangeURL string) *RangeChecker {
+	if httpClient == nil {
+		httpClient = http.DefaultClient
+	}
+	if rangeURL == "" {
+		rangeURL = DefaultRangeURL
+	}
+	return &RangeChecker{
+		rangeURL:   rangeURL,
+		httpClient: httpClient,
+	}
+}
+
+func (c *RangeChecker) IsBreached(ctx context.Context, password string) (bool, error) {
+	digest := hash(password)
+	hashed := strings.ToUpper(hex.EncodeToString(digest[:]))
+	prefix, suffix := hashed[:prefixLength], hashed[prefixLength:]
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, c.rangeURL+prefix, nil)
+	if err != nil {
+		return false, zerrors.ThrowInternal(err, "BREACH-Sd3kq", "Errors.User.Password.BreachCheckFailed")
+	}
+	// padding prevents conclusions about the prefix by the size of the response
+	req.Header.Set("Add-Padding", "true")
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return false, zerrors.ThrowUnavailable(err, "BREACH-Ml2xc", "Errors.User.Password.BreachCheckFailed")
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return false, zerrors.ThrowUnavailable(nil, "BREACH-Pe8wb", "Errors.User.Password.BreachCheckFailed")
+	}
+	scanner := bufio.NewScanner(resp.Body)
+	for scanner.Scan() {
+		entry, count, found := strings.Cut(strings.TrimSpace(scanner.Text()), ":")
+		// padded entries have a count of 0
+		if !found || count == "0" {
+			continue
+		}
+		if strings.EqualFold(entry, suffix) {
+			return true, nil
+		}
+	}
+	if err = scanner.Err(); err != nil {
+		return false, zerrors.ThrowUnavailable(err, "BREACH-Xk4mv", "Errors.User.Password.BreachCheckFailed")
+	}
+	return false, nil
+}
@@ -0,0 +1,71 @@
+package passwordbreach
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+func TestRangeChecker_IsBreached(t *testing.T) {
+	tests := []struct {
+		name     string
+		password string
+		status   int
+		body     string
+		want     bool
+		wantErr  func(error) bool
+	}{
+		{
+			name:     "unavailable",
+			password: "password",
+			status:   http.StatusServiceUnavailable,
+			wantErr:  zerrors.IsUnavailable,
+		},
+		{
+			name:     "breached",
+			password: "password",
+			status:   http.StatusOK,
+			body:     "0018A45C4D1DEF81644B54AB7F969B88D65:1\r\n1E4C9B93F3F0682250B6CF8331B7EE68FD8:9659365\r\n",
+			want:     true,
+		},
+		{
+			name:     "padded entry",
+			password: "password",
+			status:   http.StatusOK,
+			body:     "1E4C9B93F3F0682250B6CF8331B7EE68FD8:0\r\n",
+			want:     false,
+		},
+		{
+			name:     "not breached",
+			password: "password",
+			status:   http.StatusOK,
+			body:     "0018A45C4D1DEF81644B54AB7F969B88D65:1\r\n",
+			want:     false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				// only the prefix of the hash must be sent
+				assert.Equal(t, "/range/5BAA6", r.URL.Path)
+				assert.Equal(t, "true", r.Header.Get("Add-Padding"))
+				w.WriteHeader(tt.status)
+				_, _ = w.Write([]byte(tt.body))
+			}))
+			defer server.Close()
+
+			got, err := NewRangeChecker(server.Client(), server.URL+"/range/").IsBreached(context.Background(), tt.password)
+			if tt.wantErr != nil {
+				assert.True(t, tt.wantErr(err), "unexpected error: %v", err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
@@ -0,0 +1,207 @@
+package provisioning
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+const (
+	scimContentType = "application/scim+json"
+
+	userSchema       = "urn:ietf:params:scim:schemas:core:2.0:User"
+	groupSchema      = "urn:ietf:params:scim:schemas:core:2.0:Group"
+	patchOpSchema    = "urn:ietf:params:scim:api:messages:2.0:PatchOp"
+	scimTypeNoTarget = "noTarget"
+)
+
+var errResourceNotFound = errors.New("scim resource not found")
+
+// client calls the SCIM 2.0 API (RFC 7644) of a target
+type client struct {
+	endpoint string
+	token    string
+	http     *http.Client
+}
+
+func newClient(httpClient *http.Client, endpoint, token string) *client {
+	return &client{
+		endpoint: strings.TrimSuffix(endpoint, "/"),
+		token:    token,
+		http:     httpClient,
+	}
+}
+
+type listResponse struct {
+	TotalResults int `json:"totalResults"`
+	Resources    []struct {
+		ID string `json:"id"`
+	} `json:"Resources"`
+}
+
+type resourceResponse struct {
+	ID string `json:"id"`
+}
+
+type errorResponse struct {
+	Detail   string `json:"detail"`
+	ScimType string `json:"scimType"`
+}
+
+type patchRequest struct {
+	Schemas    []string         `json:"schemas"`
+	Operations []patchOperation `json:"Operations"`
+}
+
+type patchOperation struct {
+	Op    string `json:"op"`
+	Path  string `json:"path"`
+	Value any    `json:"value,omitempty"`
+}
+
+type memberValue struct {
+	Value string `json:"value"`
+}
+
+// findUser returns the id of the user with the userName, empty if there is none
+func (c *client) findUser(ctx context.Context, userName string) (string, error) {
+	return c.find(ctx, "Users", "userName", userName)
+}
+
+// createUser creates the user and returns its id
+func (c *client) createUser(ctx context.Context, resource map[string]any) (string, error) {
+	created := new(resourceResponse)
+	if err := c.do(ctx, http.MethodPost, "Users", resource, created); err != nil {
+		return "", err
+	}
+	return created.ID, nil
+}
+
+// replaceUser replaces all attributes of the user, [errResourceNotFound] is returned if it doesn't exist
+func (c *client) replaceUser(ctx context.Context, id string, resource map[string]any) error {
+	return c.do(ctx, http.MethodPut, "Users/"+url.PathEscape(id), resource, nil)
+}
+
+// deleteUser deletes the user, it's ignored if it doesn't exist anymore
+func (c *client) deleteUser(ctx context.Context, id string) error {
+	err := c.do(ctx, http.MethodDelete, "Users/"+url.PathEscape(id), nil, nil)
+	if errors.Is(err, errResourceNotFound) {
+		return nil
+	}
+	return err
+}
+
+// findGroup returns the id of the group with the displayName, empty if there is none
+func (c *client) findGroup(ctx context.Context, displayName string) (string, error) {
+	return c.find(ctx, "Groups", "displayName", displayName)
+}
+
+// createGroup creates a group without members and returns its id
+func (c *client) createGroup(ctx context.Context, displayName string) (string, error) {
+	created := new(resourceResponse)
+	err := c.do(ctx, http.MethodPost, "Groups", map[string]any{
+		"schemas":     []string{groupSchema},
+		"displayName": displayName,
+	}, created)
+	if err != nil {
+		return "", err
+	}
+	return created.ID, nil
+}
+
+// addGroupMember adds the user to the members of the group
+func (c *client) addGroupMember(ctx context.Context, groupID, userID string) error {
+	return c.patchGroup(ctx, groupID, patchOperation{
+		Op:    "add",
+		Path:  "members",
+		Value: []memberValue{{Value: userID}},
+	})
+}
+
+// removeGroupMember removes the user from the members of the group, it's ignored if it isn't a member
+func (c *client) removeGroupMember(ctx context.Context, groupID, userID string) error {
+	return c.patchGroup(ctx, groupID, patchOperation{
+		Op:   "remove",
+		Path: "members[value eq " + filterValue(userID) + "]",
+	})
+}
+
+func (c *client) patchGroup(ctx context.Context, groupID string, operation patchOperation) error {
+	return c.do(ctx, http.MethodPatch, "Groups/"+url.PathEscape(groupID), &patchRequest{
+		Schemas:    []string{patchOpSchema},
+		Operations: []patchOperation{operation},
+	}, nil)
+}
+
+func (c *client) find(ctx context.Context, resourceType, attribute, value string) (string, error) {
+	query := url.Values{"filter": {attribute + " eq " + filterValue(value)}}
+	list := new(listResponse)
+	if err := c.do(ctx, http.MethodGet, resourceType+"?"+query.Encode(), nil, list); err != nil {
+		return "", err
+	}
+	if len(list.Resources) == 0 {
+		return "", nil
+	}
+	return list.Resources[0].ID, nil
+}
+
+// filterValue quotes the value as JSON string to be used in a filter
+func filterValue(value string) string {
+	quoted, _ := json.Marshal(value)
+	return string(quoted)
+}
+
+func (c *client) do(ctx context.Context, method, path string, body, result any) error {
+	var reader io.Reader
+	if body != nil {
+		data, err := json.Marshal(body)
+		if err != nil {
+			return err
+		}
+		reader = bytes.NewReader(data)
+	}
+	req, err := http.NewRequestWithContext(ctx, method, c.endpoint+"/"+path, reader)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Accept", scimContentType)
+	if body != nil {
+		req.Header.Set("Content-Type", scimContentType)
+	}
+	req.Header.Set("Authorization", "Bearer "+c.token)
+	resp, err := c.http.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	data, err := io.ReadAll(io.LimitReader(resp.Body, 1<<20))
+	if err != nil {
+		return err
+	}
+	if resp.StatusCode >= 200 && resp.StatusCode < 300 {
+		if result == nil || len(data) == 0 {
+			return nil
+		}
+		return json.Unmarshal(data, result)
+	}
+	if resp.StatusCode == http.StatusNotFound {
+		return errResourceNotFound
+	}
+	scimErr := new(errorResponse)
+	_ = json.Unmarshal(data, scimErr)
+	// removing a member, which isn't part of the group anymore, is not an error
+	if resp.StatusCode == http.StatusBadRequest && scimErr.ScimType == scimTypeNoTarget && method == http.MethodPatch {
+		return nil
+	}
+	if scimErr.Detail != "" {
+		return zerrors.ThrowUnavailablef(nil, "PROVI-Sc1rs", "scim target responded with status %d: %s", resp.StatusCode, scimErr.Detail)
+	}
+	return zerrors.ThrowUnavailablef(nil, "PROVI-Sc2rs", "scim target responded with status %d", resp.StatusCode)
+}
@@ -0,0 +1,21 @@
+package provisioning
+
+import (
+	"time"
+
+	"github.com/zitadel/zitadel/internal/backoff"
+)
+
+// Config defines the provisioning of users to the SCIM targets of projects
+// and the exponential backoff used for the retries of failed syncs
+type Config struct {
+	// Enabled syncs the users granted on projects to their SCIM targets
+	Enabled bool
+	// MaxAttempts is the maximum number of attempts of a sync, before it's marked as failed
+	MaxAttempts    uint16
+	backoff.Config `mapstructure:",squash"`
+	// Limit is the maximum number of users synced at once
+	Limit uint64
+	// Timeout of a single request to a SCIM target
+	Timeout time.Duration
+}
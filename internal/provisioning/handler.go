@@ -0,0 +1,284 @@
+package provisioning
+
+import (
+	"context"
+	"net/http"
+	"slices"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/command"
+	"github.com/zitadel/zitadel/internal/crypto"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/eventstore/handler/v2"
+	"github.com/zitadel/zitadel/internal/query"
+	"github.com/zitadel/zitadel/internal/query/projection"
+	"github.com/zitadel/zitadel/internal/repository/project"
+	"github.com/zitadel/zitadel/internal/repository/user"
+	"github.com/zitadel/zitadel/internal/repository/usergrant"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+const (
+	HandlerTable = "projections.scim_provisioning"
+	// ProvisionerUserID is the editor of the events pushed by the provisioning
+	ProvisionerUserID = "SCIM_PROVISIONING"
+)
+
+// UserGrantReader reads the user and project of a user grant,
+// because the events of removed or changed grants don't contain them
+type UserGrantReader interface {
+	FilterToQueryReducer(ctx context.Context, reducer eventstore.QueryReducer) error
+}
+
+type eventHandler struct {
+	commands Commands
+	queries  Queries
+	grants   UserGrantReader
+}
+
+// NewEventHandler returns the handler which requests the sync of the users to the SCIM targets of their projects,
+// whenever a user, its metadata, its grants or a target changes.
+func NewEventHandler(
+	ctx context.Context,
+	config handler.Config,
+	commands Commands,
+	queries Queries,
+	grants UserGrantReader,
+) *handler.Handler {
+	return handler.NewHandler(ctx, &config, &eventHandler{
+		commands: commands,
+		queries:  queries,
+		grants:   grants,
+	})
+}
+
+func (*eventHandler) Name() string {
+	return HandlerTable
+}
+
+func (h *eventHandler) Reducers() []handler.AggregateReducer {
+	return []handler.AggregateReducer{
+		{
+			Aggregate: user.AggregateType,
+			EventReducers: []handler.EventReducer{
+				{Event: user.UserUserNameChangedType, Reduce: h.reduceUserChanged},
+				{Event: user.HumanProfileChangedType, Reduce: h.reduceUserChanged},
+				{Event: user.HumanEmailChangedType, Reduce: h.reduceUserChanged},
+				{Event: user.UserLockedType, Reduce: h.reduceUserChanged},
+				{Event: user.UserUnlockedType, Reduce: h.reduceUserChanged},
+				{Event: user.UserDeactivatedType, Reduce: h.reduceUserChanged},
+				{Event: user.UserReactivatedType, Reduce: h.reduceUserChanged},
+				{Event: user.MetadataSetType, Reduce: h.reduceUserChanged},
+				{Event: user.MetadataRemovedType, Reduce: h.reduceUserChanged},
+				{Event: user.MetadataRemovedAllType, Reduce: h.reduceUserChanged},
+			},
+		},
+		{
+			Aggregate: usergrant.AggregateType,
+			EventReducers: []handler.EventReducer{
+				{Event: usergrant.UserGrantAddedType, Reduce: h.reduceUserGrantChanged},
+				{Event: usergrant.UserGrantChangedType, Reduce: h.reduceUserGrantChanged},
+				{Event: usergrant.UserGrantCascadeChangedType, Reduce: h.reduceUserGrantChanged},
+				{Event: usergrant.UserGrantRemovedType, Reduce: h.reduceUserGrantChanged},
+				{Event: usergrant.UserGrantCascadeRemovedType, Reduce: h.reduceUserGrantChanged},
+				{Event: usergrant.UserGrantDeactivatedType, Reduce: h.reduceUserGrantChanged},
+				{Event: usergrant.UserGrantReactivatedType, Reduce: h.reduceUserGrantChanged},
+			},
+		},
+		{
+			Aggregate: project.AggregateType,
+			EventReducers: []handler.EventReducer{
+				{Event: project.SCIMTargetAddedType, Reduce: h.reduceTargetChanged},
+				{Event: project.SCIMTargetChangedType, Reduce: h.reduceTargetChanged},
+			},
+		},
+	}
+}
+
+// reduceUserChanged requests the sync of the user to the targets of all projects it's granted on
+func (h *eventHandler) reduceUserChanged(event eventstore.Event) (*handler.Statement, error) {
+	return handler.NewStatement(event, func(ex handler.Executer, projectionName string) error {
+		ctx := handlerContext(context.Background(), event.Aggregate().InstanceID, event.Aggregate().ResourceOwner)
+		userIDQuery, err := query.NewUserGrantUserIDSearchQuery(event.Aggregate().ID)
+		if err != nil {
+			return err
+		}
+		grants, err := h.queries.UserGrants(ctx, &query.UserGrantsQueries{Queries: []query.SearchQuery{userIDQuery}}, false)
+		if err != nil {
+			return err
+		}
+		projectIDs := make([]string, 0, len(grants.UserGrants))
+		for _, grant := range grants.UserGrants {
+			if !slices.Contains(projectIDs, grant.ProjectID) {
+				projectIDs = append(projectIDs, grant.ProjectID)
+			}
+		}
+		for _, projectID := range projectIDs {
+			if err = h.requestSyncs(ctx, projectID, event.Type(), event.Aggregate().ID); err != nil {
+				return err
+			}
+		}
+		return nil
+	}), nil
+}
+
+// reduceUserGrantChanged requests the sync of the user of the grant to the targets of the project
+func (h *eventHandler) reduceUserGrantChanged(event eventstore.Event) (*handler.Statement, error) {
+	return handler.NewStatement(event, func(ex handler.Executer, projectionName string) error {
+		ctx := handlerContext(context.Background(), event.Aggregate().InstanceID, event.Aggregate().ResourceOwner)
+		grant := newUserGrantReadModel(event.Aggregate().ID, event.Aggregate().ResourceOwner)
+		if err := h.grants.FilterToQueryReducer(ctx, grant); err != nil {
+			return err
+		}
+		if grant.UserID == "" {
+			return nil
+		}
+		return h.requestSyncs(ctx, grant.ProjectID, event.Type(), grant.UserID)
+	}), nil
+}
+
+// reduceTargetChanged requests the sync of all users granted on the project to the added or changed target
+func (h *eventHandler) reduceTargetChanged(event eventstore.Event) (*handler.Statement, error) {
+	var targetID string
+	switch e := event.(type) {
+	case *project.SCIMTargetAddedEvent:
+		targetID = e.ID
+	case *project.SCIMTargetChangedEvent:
+		targetID = e.ID
+	default:
+		return nil, zerrors.ThrowInvalidArgumentf(nil, "PROVI-Hd1tc", "reduce.wrong.event.type %v", []eventstore.EventType{project.SCIMTargetAddedType, project.SCIMTargetChangedType})
+	}
+	return handler.NewStatement(event, func(ex handler.Executer, projectionName string) error {
+		ctx := handlerContext(context.Background(), event.Aggregate().InstanceID, event.Aggregate().ResourceOwner)
+		projectIDQuery, err := query.NewUserGrantProjectIDSearchQuery(event.Aggregate().ID)
+		if err != nil {
+			return err
+		}
+		grants, err := h.queries.UserGrants(ctx, &query.UserGrantsQueries{Queries: []query.SearchQuery{projectIDQuery}}, false)
+		if err != nil {
+			return err
+		}
+		userIDs := make([]string, 0, len(grants.UserGrants))
+		for _, grant := range grants.UserGrants {
+			if slices.Contains(userIDs, grant.UserID) {
+				continue
+			}
+			userIDs = append(userIDs, grant.UserID)
+			err = h.commands.RequestSCIMSync(ctx, event.Aggregate().ID, event.Aggregate().ResourceOwner, targetID, grant.UserID, string(event.Type()))
+			if err != nil && !zerrors.IsNotFound(err) {
+				return err
+			}
+		}
+		return nil
+	}), nil
+}
+
+// requestSyncs requests the sync of the user to all targets of the project
+func (h *eventHandler) requestSyncs(ctx context.Context, projectID string, reason eventstore.EventType, userID string) error {
+	p, err := h.queries.ProjectByID(ctx, false, projectID)
+	if zerrors.IsNotFound(err) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	targets, err := h.queries.ProjectSCIMTargets(ctx, p.ID, p.ResourceOwner)
+	if err != nil {
+		return err
+	}
+	for _, target := range targets {
+		// the target or the project could have been removed in the meantime
+		err = h.commands.RequestSCIMSync(ctx, p.ID, p.ResourceOwner, target.ID, userID, string(reason))
+		if err != nil && !zerrors.IsNotFound(err) && !zerrors.IsPreconditionFailed(err) {
+			return err
+		}
+	}
+	return nil
+}
+
+func handlerContext(ctx context.Context, instanceID, resourceOwner string) context.Context {
+	ctx = authz.WithInstanceID(ctx, instanceID)
+	return authz.SetCtxData(ctx, authz.CtxData{UserID: ProvisionerUserID, OrgID: resourceOwner})
+}
+
+// userGrantReadModel is the user and the project of a user grant
+type userGrantReadModel struct {
+	eventstore.ReadModel
+
+	UserID    string
+	ProjectID string
+}
+
+func newUserGrantReadModel(grantID, resourceOwner string) *userGrantReadModel {
+	return &userGrantReadModel{
+		ReadModel: eventstore.ReadModel{
+			AggregateID:   grantID,
+			ResourceOwner: resourceOwner,
+		},
+	}
+}
+
+func (rm *userGrantReadModel) Reduce() error {
+	for _, event := range rm.Events {
+		if e, ok := event.(*usergrant.UserGrantAddedEvent); ok {
+			rm.UserID = e.UserID
+			rm.ProjectID = e.ProjectID
+		}
+	}
+	return rm.ReadModel.Reduce()
+}
+
+func (rm *userGrantReadModel) Query() *eventstore.SearchQueryBuilder {
+	return eventstore.NewSearchQueryBuilder(eventstore.ColumnsEvent).
+		ResourceOwner(rm.ResourceOwner).
+		AddQuery().
+		AggregateTypes(usergrant.AggregateType).
+		AggregateIDs(rm.AggregateID).
+		EventTypes(usergrant.UserGrantAddedType).
+		Builder()
+}
+
+var projections []*handler.Handler
+
+// Register creates the handlers which detect changes and sync the users to the SCIM targets of the projects.
+// They're started with [Start].
+func Register(
+	ctx context.Context,
+	handlerCustomConfig, workerCustomConfig projection.CustomConfig,
+	config *Config,
+	commands *command.Commands,
+	queries *query.Queries,
+	es *eventstore.Eventstore,
+	targetEncryption crypto.EncryptionAlgorithm,
+) {
+	if !config.Enabled {
+		return
+	}
+	projections = append(projections,
+		NewEventHandler(
+			ctx,
+			projection.ApplyCustomConfig(handlerCustomConfig),
+			commands,
+			queries,
+			es,
+		),
+		NewWorker(
+			ctx,
+			config,
+			projection.ApplyCustomConfig(workerCustomConfig),
+			commands,
+			queries,
+			&syncer{
+				queries:    queries,
+				encryption: targetEncryption,
+				http:       &http.Client{Timeout: config.Timeout},
+			},
+		),
+	)
+}
+
+func Start(ctx context.Context) {
+	for _, projection := range projections {
+		projection.Start(ctx)
+	}
+}
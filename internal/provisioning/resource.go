@@ -0,0 +1,58 @@
+package provisioning
+
+import (
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/query"
+)
+
+// userName returns the userName of the user at the target
+func userName(user *query.User, mapping *domain.SCIMProvisioningMapping) string {
+	if mapping.UserName == domain.SCIMUserNameSourceEmail && user.Human != nil && user.Human.Email != "" {
+		return string(user.Human.Email)
+	}
+	return user.Username
+}
+
+// userResource returns the SCIM user of a human user.
+// The metadata is set to the attributes of the mapping, metadata without mapping is not provisioned.
+func userResource(user *query.User, metadata map[string]string, mapping *domain.SCIMProvisioningMapping, active bool) map[string]any {
+	schemas := []string{userSchema}
+	resource := map[string]any{
+		"externalId":  user.ID,
+		"userName":    userName(user, mapping),
+		"active":      active,
+		"displayName": user.Human.DisplayName,
+		"name": map[string]any{
+			"givenName":  user.Human.FirstName,
+			"familyName": user.Human.LastName,
+			"formatted":  user.Human.FirstName + " " + user.Human.LastName,
+		},
+	}
+	if user.Human.Email != "" {
+		resource["emails"] = []map[string]any{{
+			"value":   string(user.Human.Email),
+			"type":    "work",
+			"primary": true,
+		}}
+	}
+	for _, attribute := range mapping.Attributes {
+		value, ok := metadata[attribute.MetadataKey]
+		if !ok {
+			continue
+		}
+		schema, name := domain.SplitSCIMAttribute(attribute.Attribute)
+		if schema == "" || schema == userSchema {
+			resource[name] = value
+			continue
+		}
+		extension, ok := resource[schema].(map[string]any)
+		if !ok {
+			extension = make(map[string]any)
+			resource[schema] = extension
+			schemas = append(schemas, schema)
+		}
+		extension[name] = value
+	}
+	resource["schemas"] = schemas
+	return resource
+}
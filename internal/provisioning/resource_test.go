@@ -0,0 +1,96 @@
+package provisioning
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/query"
+)
+
+func Test_userResource(t *testing.T) {
+	user := &query.User{
+		ID:       "user1",
+		Username: "gigi",
+		Human: &query.Human{
+			FirstName:   "Gigi",
+			LastName:    "Giraffe",
+			DisplayName: "Gigi Giraffe",
+			Email:       "gigi@zitadel.com",
+		},
+	}
+	tests := []struct {
+		name     string
+		metadata map[string]string
+		mapping  *domain.SCIMProvisioningMapping
+		active   bool
+		want     map[string]any
+	}{
+		{
+			name:    "username",
+			mapping: &domain.SCIMProvisioningMapping{},
+			active:  true,
+			want: map[string]any{
+				"schemas":     []string{userSchema},
+				"externalId":  "user1",
+				"userName":    "gigi",
+				"active":      true,
+				"displayName": "Gigi Giraffe",
+				"name": map[string]any{
+					"givenName":  "Gigi",
+					"familyName": "Giraffe",
+					"formatted":  "Gigi Giraffe",
+				},
+				"emails": []map[string]any{{
+					"value":   "gigi@zitadel.com",
+					"type":    "work",
+					"primary": true,
+				}},
+			},
+		},
+		{
+			name: "email as username and mapped metadata",
+			metadata: map[string]string{
+				"title":      "Engineer",
+				"department": "Security",
+				"unmapped":   "value",
+			},
+			mapping: &domain.SCIMProvisioningMapping{
+				UserName: domain.SCIMUserNameSourceEmail,
+				Attributes: []domain.SCIMAttributeMapping{
+					{MetadataKey: "title", Attribute: "title"},
+					{MetadataKey: "department", Attribute: "urn:ietf:params:scim:schemas:extension:enterprise:2.0:User:department"},
+					{MetadataKey: "missing", Attribute: "nickName"},
+				},
+			},
+			active: false,
+			want: map[string]any{
+				"schemas":     []string{userSchema, "urn:ietf:params:scim:schemas:extension:enterprise:2.0:User"},
+				"externalId":  "user1",
+				"userName":    "gigi@zitadel.com",
+				"active":      false,
+				"displayName": "Gigi Giraffe",
+				"name": map[string]any{
+					"givenName":  "Gigi",
+					"familyName": "Giraffe",
+					"formatted":  "Gigi Giraffe",
+				},
+				"emails": []map[string]any{{
+					"value":   "gigi@zitadel.com",
+					"type":    "work",
+					"primary": true,
+				}},
+				"title": "Engineer",
+				"urn:ietf:params:scim:schemas:extension:enterprise:2.0:User": map[string]any{
+					"department": "Security",
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, userResource(user, tt.metadata, tt.mapping, tt.active))
+		})
+	}
+}
@@ -0,0 +1,192 @@
+package provisioning
+
+import (
+	"context"
+	"net/http"
+	"slices"
+
+	"github.com/zitadel/zitadel/internal/crypto"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/query"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+// Queries are the queries used to find and sync the users granted on projects with SCIM targets
+type Queries interface {
+	ProjectByID(ctx context.Context, shouldTriggerBulk bool, id string) (*query.Project, error)
+	ProjectSCIMTargets(ctx context.Context, projectID, resourceOwner string) ([]*query.SCIMTarget, error)
+	ProjectSCIMTargetByID(ctx context.Context, projectID, resourceOwner, id string) (*query.SCIMTarget, error)
+	SearchSCIMSyncs(ctx context.Context, instanceIDs []string, queries *query.SCIMSyncSearchQueries) (*query.SCIMSyncs, error)
+	GetUserByID(ctx context.Context, shouldTriggerBulk bool, userID string) (*query.User, error)
+	SearchUserMetadata(ctx context.Context, shouldTriggerBulk bool, userID string, queries *query.UserMetadataSearchQueries, withOwnerRemoved bool) (*query.UserMetadataList, error)
+	UserGrants(ctx context.Context, queries *query.UserGrantsQueries, shouldTriggerBulk bool) (*query.UserGrants, error)
+	SearchProjectRoles(ctx context.Context, shouldTriggerBulk bool, queries *query.ProjectRoleSearchQueries) (*query.ProjectRoles, error)
+}
+
+// syncer provisions the current state of a user to a SCIM target
+type syncer struct {
+	queries    Queries
+	encryption crypto.EncryptionAlgorithm
+	http       *http.Client
+}
+
+// grantState is the access of a user to a project, combined from all its grants
+type grantState struct {
+	granted bool
+	active  bool
+	roles   []string
+}
+
+// sync provisions or deprovisions the user and returns its id at the target.
+// Active human users with a grant on the project are provisioned, inactive or locked ones are deactivated.
+// Users without a grant, removed users and machine users are deleted from the target.
+func (s *syncer) sync(ctx context.Context, target *query.SCIMTarget, userID, remoteID string) (string, error) {
+	token, err := crypto.DecryptString(target.Token, s.encryption)
+	if err != nil {
+		return "", err
+	}
+	scim := newClient(s.http, target.Endpoint, token)
+
+	user, err := s.queries.GetUserByID(ctx, false, userID)
+	if err != nil && !zerrors.IsNotFound(err) {
+		return "", err
+	}
+	var grants *grantState
+	if user != nil {
+		if grants, err = s.grantState(ctx, target.ProjectID, userID); err != nil {
+			return "", err
+		}
+	}
+	if user == nil || user.Human == nil || !grants.granted || user.State == domain.UserStateDeleted {
+		return "", s.deprovision(ctx, scim, target, user, remoteID)
+	}
+	metadata, err := s.metadata(ctx, userID, &target.Mapping)
+	if err != nil {
+		return "", err
+	}
+	active := grants.active && (user.State == domain.UserStateActive || user.State == domain.UserStateInitial)
+	remoteID, err = s.provisionUser(ctx, scim, userResource(user, metadata, &target.Mapping, active), userName(user, &target.Mapping), remoteID)
+	if err != nil {
+		return "", err
+	}
+	if target.Mapping.Groups {
+		if err = s.syncGroups(ctx, scim, target, remoteID, grants.roles); err != nil {
+			return "", err
+		}
+	}
+	return remoteID, nil
+}
+
+// provisionUser replaces the user at the target, it's created if it doesn't exist yet
+func (s *syncer) provisionUser(ctx context.Context, scim *client, resource map[string]any, name, remoteID string) (_ string, err error) {
+	if remoteID == "" {
+		if remoteID, err = scim.findUser(ctx, name); err != nil {
+			return "", err
+		}
+	}
+	if remoteID != "" {
+		err = scim.replaceUser(ctx, remoteID, resource)
+		if err == nil {
+			return remoteID, nil
+		}
+		if err != errResourceNotFound {
+			return "", err
+		}
+	}
+	return scim.createUser(ctx, resource)
+}
+
+// deprovision deletes the user from the target, the user is looked up by its userName if it's unknown which one it is
+func (s *syncer) deprovision(ctx context.Context, scim *client, target *query.SCIMTarget, user *query.User, remoteID string) (err error) {
+	if remoteID == "" && user != nil {
+		if remoteID, err = scim.findUser(ctx, userName(user, &target.Mapping)); err != nil {
+			return err
+		}
+	}
+	if remoteID == "" {
+		return nil
+	}
+	return scim.deleteUser(ctx, remoteID)
+}
+
+// syncGroups adds the user to the groups of its roles and removes it from the groups of all other roles of the project.
+// Groups are created, when the first member is added.
+func (s *syncer) syncGroups(ctx context.Context, scim *client, target *query.SCIMTarget, remoteID string, userRoles []string) error {
+	projectIDQuery, err := query.NewProjectRoleProjectIDSearchQuery(target.ProjectID)
+	if err != nil {
+		return err
+	}
+	roles, err := s.queries.SearchProjectRoles(ctx, false, &query.ProjectRoleSearchQueries{Queries: []query.SearchQuery{projectIDQuery}})
+	if err != nil {
+		return err
+	}
+	for _, role := range roles.ProjectRoles {
+		isMember := slices.Contains(userRoles, role.Key)
+		groupID, err := scim.findGroup(ctx, role.Key)
+		if err != nil {
+			return err
+		}
+		if !isMember {
+			if groupID != "" {
+				if err = scim.removeGroupMember(ctx, groupID, remoteID); err != nil {
+					return err
+				}
+			}
+			continue
+		}
+		if groupID == "" {
+			if groupID, err = scim.createGroup(ctx, role.Key); err != nil {
+				return err
+			}
+		}
+		if err = scim.addGroupMember(ctx, groupID, remoteID); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s *syncer) grantState(ctx context.Context, projectID, userID string) (*grantState, error) {
+	userIDQuery, err := query.NewUserGrantUserIDSearchQuery(userID)
+	if err != nil {
+		return nil, err
+	}
+	projectIDQuery, err := query.NewUserGrantProjectIDSearchQuery(projectID)
+	if err != nil {
+		return nil, err
+	}
+	grants, err := s.queries.UserGrants(ctx, &query.UserGrantsQueries{Queries: []query.SearchQuery{userIDQuery, projectIDQuery}}, false)
+	if err != nil {
+		return nil, err
+	}
+	state := new(grantState)
+	for _, grant := range grants.UserGrants {
+		state.granted = true
+		if grant.State != domain.UserGrantStateActive {
+			continue
+		}
+		state.active = true
+		for _, role := range grant.Roles {
+			if !slices.Contains(state.roles, role) {
+				state.roles = append(state.roles, role)
+			}
+		}
+	}
+	return state, nil
+}
+
+// metadata returns the values of the metadata keys used by the mapping
+func (s *syncer) metadata(ctx context.Context, userID string, mapping *domain.SCIMProvisioningMapping) (map[string]string, error) {
+	if len(mapping.Attributes) == 0 {
+		return nil, nil
+	}
+	list, err := s.queries.SearchUserMetadata(ctx, false, userID, &query.UserMetadataSearchQueries{}, false)
+	if err != nil {
+		return nil, err
+	}
+	metadata := make(map[string]string, len(list.Metadata))
+	for _, entry := range list.Metadata {
+		metadata[entry.Key] = string(entry.Value)
+	}
+	return metadata, nil
+}
@@ -0,0 +1,133 @@
+package provisioning
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/zitadel/logging"
+
+	"github.com/zitadel/zitadel/internal/api/call"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/eventstore/handler/v2"
+	"github.com/zitadel/zitadel/internal/query"
+	"github.com/zitadel/zitadel/internal/repository/pseudo"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+const WorkerTable = "projections.scim_sync_worker"
+
+// Commands are the commands used to request syncs and to store their results
+type Commands interface {
+	RequestSCIMSync(ctx context.Context, projectID, resourceOwner, targetID, userID, reason string) error
+	SCIMSyncSucceeded(ctx context.Context, targetID, resourceOwner, userID, remoteID string) error
+	SCIMSyncRetryScheduled(ctx context.Context, targetID, resourceOwner, userID, reason string, nextAttempt time.Time) error
+	SCIMSyncFailed(ctx context.Context, targetID, resourceOwner, userID, reason string) error
+}
+
+type worker struct {
+	cfg      *Config
+	commands Commands
+	queries  Queries
+	syncer   *syncer
+}
+
+// NewWorker returns the handler which syncs the users with a pending sync, which is due.
+// Failed syncs are retried with an exponential backoff until [Config.MaxAttempts] is reached.
+func NewWorker(
+	ctx context.Context,
+	cfg *Config,
+	handlerCfg handler.Config,
+	commands Commands,
+	queries Queries,
+	syncer *syncer,
+) *handler.Handler {
+	w := &worker{
+		cfg:      cfg,
+		commands: commands,
+		queries:  queries,
+		syncer:   syncer,
+	}
+	handlerCfg.TriggerWithoutEvents = w.syncDue
+	return handler.NewHandler(ctx, &handlerCfg, w)
+}
+
+func (*worker) Name() string {
+	return WorkerTable
+}
+
+func (w *worker) Reducers() []handler.AggregateReducer {
+	return []handler.AggregateReducer{{
+		Aggregate: pseudo.AggregateType,
+		EventReducers: []handler.EventReducer{{
+			Event:  pseudo.ScheduledEventType,
+			Reduce: w.syncDue,
+		}},
+	}}
+}
+
+func (w *worker) syncDue(event eventstore.Event) (*handler.Statement, error) {
+	scheduledEvent, ok := event.(*pseudo.ScheduledEvent)
+	if !ok {
+		return nil, zerrors.ThrowInvalidArgumentf(nil, "PROVI-Wk1rd", "reduce.wrong.event.type %s", event.Type())
+	}
+	return handler.NewStatement(event, func(ex handler.Executer, projectionName string) error {
+		return w.syncAll(call.WithTimestamp(context.Background()), scheduledEvent.InstanceIDs, time.Now())
+	}), nil
+}
+
+// syncAll syncs all pending users, whose sync is due at the given time
+func (w *worker) syncAll(ctx context.Context, instanceIDs []string, now time.Time) error {
+	isPending, err := query.NewSCIMSyncStateSearchQuery(domain.SCIMSyncStatePending)
+	if err != nil {
+		return err
+	}
+	isDue, err := query.NewSCIMSyncDueSearchQuery(now)
+	if err != nil {
+		return err
+	}
+	due, err := w.queries.SearchSCIMSyncs(ctx, instanceIDs, &query.SCIMSyncSearchQueries{
+		SearchRequest: query.SearchRequest{
+			Limit:         w.cfg.Limit,
+			SortingColumn: query.SCIMSyncColumnNextAttempt,
+			Asc:           true,
+		},
+		Queries: []query.SearchQuery{isPending, isDue},
+	})
+	if err != nil {
+		return err
+	}
+	var errs int
+	for _, sync := range due.SCIMSyncs {
+		if err = w.syncUser(ctx, sync, now); err != nil {
+			errs++
+			logging.WithFields("instance", sync.InstanceID, "target", sync.TargetID, "user", sync.UserID).WithError(err).Warn("updating scim sync failed")
+		}
+	}
+	if errs > 0 {
+		return fmt.Errorf("updating %d of %d scim syncs failed", errs, len(due.SCIMSyncs))
+	}
+	return nil
+}
+
+func (w *worker) syncUser(ctx context.Context, sync *query.SCIMSync, now time.Time) error {
+	ctx = handlerContext(ctx, sync.InstanceID, sync.ResourceOwner)
+	target, err := w.queries.ProjectSCIMTargetByID(ctx, sync.ProjectID, sync.ResourceOwner, sync.TargetID)
+	// the syncs of removed targets are deleted by the projection
+	if zerrors.IsNotFound(err) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	remoteID, syncErr := w.syncer.sync(ctx, target, sync.UserID, sync.RemoteID)
+	if syncErr == nil {
+		return w.commands.SCIMSyncSucceeded(ctx, sync.TargetID, sync.ResourceOwner, sync.UserID, remoteID)
+	}
+	attempts := sync.Attempts + 1
+	if attempts >= w.cfg.MaxAttempts {
+		return w.commands.SCIMSyncFailed(ctx, sync.TargetID, sync.ResourceOwner, sync.UserID, syncErr.Error())
+	}
+	return w.commands.SCIMSyncRetryScheduled(ctx, sync.TargetID, sync.ResourceOwner, sync.UserID, syncErr.Error(), now.Add(w.cfg.Delay(attempts)))
+}
@@ -0,0 +1,288 @@
+package provisioning
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/muhlemmer/gu"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/zitadel/zitadel/internal/backoff"
+	"github.com/zitadel/zitadel/internal/crypto"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/query"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+type fakeQueries struct {
+	Queries
+	target *query.SCIMTarget
+	user   *query.User
+	grants []*query.UserGrant
+	roles  []*query.ProjectRole
+}
+
+func (q *fakeQueries) ProjectSCIMTargetByID(_ context.Context, _, _, id string) (*query.SCIMTarget, error) {
+	if q.target == nil || q.target.ID != id {
+		return nil, zerrors.ThrowNotFound(nil, "TEST-Sc1nf", "not found")
+	}
+	return q.target, nil
+}
+
+func (q *fakeQueries) GetUserByID(context.Context, bool, string) (*query.User, error) {
+	if q.user == nil {
+		return nil, zerrors.ThrowNotFound(nil, "TEST-Us1nf", "not found")
+	}
+	return q.user, nil
+}
+
+func (q *fakeQueries) UserGrants(context.Context, *query.UserGrantsQueries, bool) (*query.UserGrants, error) {
+	return &query.UserGrants{UserGrants: q.grants}, nil
+}
+
+func (q *fakeQueries) SearchProjectRoles(context.Context, bool, *query.ProjectRoleSearchQueries) (*query.ProjectRoles, error) {
+	return &query.ProjectRoles{ProjectRoles: q.roles}, nil
+}
+
+type fakeCommands struct {
+	Commands
+	succeeded   *string
+	retryReason string
+	nextAttempt time.Time
+	failed      string
+}
+
+func (c *fakeCommands) SCIMSyncSucceeded(_ context.Context, _, _, _, remoteID string) error {
+	c.succeeded = &remoteID
+	return nil
+}
+
+func (c *fakeCommands) SCIMSyncRetryScheduled(_ context.Context, _, _, _, reason string, nextAttempt time.Time) error {
+	c.retryReason = reason
+	c.nextAttempt = nextAttempt
+	return nil
+}
+
+func (c *fakeCommands) SCIMSyncFailed(_ context.Context, _, _, _, reason string) error {
+	c.failed = reason
+	return nil
+}
+
+type scimRequest struct {
+	method string
+	path   string
+	body   map[string]any
+}
+
+// scimServer records the requests and responds like a SCIM target without any users or groups
+func scimServer(t *testing.T, status int) (*httptest.Server, *[]scimRequest) {
+	requests := make([]scimRequest, 0)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "Bearer token", r.Header.Get("Authorization"))
+		req := scimRequest{method: r.Method, path: r.URL.RequestURI()}
+		data, err := io.ReadAll(r.Body)
+		require.NoError(t, err)
+		if len(data) > 0 {
+			require.NoError(t, json.Unmarshal(data, &req.body))
+		}
+		requests = append(requests, req)
+		if status != http.StatusOK {
+			w.WriteHeader(status)
+			return
+		}
+		switch r.Method {
+		case http.MethodGet:
+			_, _ = w.Write([]byte(`{"totalResults": 0, "Resources": []}`))
+		case http.MethodPost:
+			w.WriteHeader(http.StatusCreated)
+			_, _ = w.Write([]byte(`{"id": "remote-` + r.URL.Path[1:] + `"}`))
+		default:
+			w.WriteHeader(http.StatusNoContent)
+		}
+	}))
+	t.Cleanup(server.Close)
+	return server, &requests
+}
+
+func Test_worker_syncUser(t *testing.T) {
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	cfg := &Config{
+		MaxAttempts: 3,
+		Config: backoff.Config{
+			InitialInterval: time.Minute,
+			MaxInterval:     time.Hour,
+			Multiplier:      2,
+		},
+	}
+	human := &query.User{
+		ID:       "user1",
+		State:    domain.UserStateActive,
+		Username: "gigi",
+		Human: &query.Human{
+			FirstName:   "Gigi",
+			LastName:    "Giraffe",
+			DisplayName: "Gigi Giraffe",
+		},
+	}
+	activeGrant := []*query.UserGrant{{UserID: "user1", ProjectID: "project1", State: domain.UserGrantStateActive, Roles: []string{"admin"}}}
+	type fields struct {
+		targetMissing bool
+		mapping       domain.SCIMProvisioningMapping
+		user          *query.User
+		grants        []*query.UserGrant
+		roles         []*query.ProjectRole
+		status        int
+	}
+	type want struct {
+		requests    []scimRequest
+		succeeded   *string
+		retryReason bool
+		nextAttempt time.Time
+		failed      bool
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		sync   *query.SCIMSync
+		want   want
+	}{
+		{
+			name:   "target removed, nothing synced",
+			fields: fields{targetMissing: true, user: human, grants: activeGrant, status: http.StatusOK},
+			sync:   &query.SCIMSync{TargetID: "target1", UserID: "user1"},
+			want:   want{requests: []scimRequest{}},
+		},
+		{
+			name: "granted user created with groups",
+			fields: fields{
+				mapping: domain.SCIMProvisioningMapping{Groups: true},
+				user:    human,
+				grants:  activeGrant,
+				roles:   []*query.ProjectRole{{Key: "admin"}, {Key: "viewer"}},
+				status:  http.StatusOK,
+			},
+			sync: &query.SCIMSync{TargetID: "target1", UserID: "user1"},
+			want: want{
+				requests: []scimRequest{
+					{method: http.MethodGet, path: "/Users?filter=userName+eq+%22gigi%22"},
+					{method: http.MethodPost, path: "/Users"},
+					{method: http.MethodGet, path: "/Groups?filter=displayName+eq+%22admin%22"},
+					{method: http.MethodPost, path: "/Groups"},
+					{method: http.MethodPatch, path: "/Groups/remote-Groups"},
+					{method: http.MethodGet, path: "/Groups?filter=displayName+eq+%22viewer%22"},
+				},
+				succeeded: gu.Ptr("remote-Users"),
+			},
+		},
+		{
+			name:   "provisioned user replaced",
+			fields: fields{user: human, grants: activeGrant, status: http.StatusOK},
+			sync:   &query.SCIMSync{TargetID: "target1", UserID: "user1", RemoteID: "remote1"},
+			want: want{
+				requests: []scimRequest{
+					{method: http.MethodPut, path: "/Users/remote1"},
+				},
+				succeeded: gu.Ptr("remote1"),
+			},
+		},
+		{
+			name:   "user without grant deleted",
+			fields: fields{user: human, status: http.StatusOK},
+			sync:   &query.SCIMSync{TargetID: "target1", UserID: "user1", RemoteID: "remote1"},
+			want: want{
+				requests: []scimRequest{
+					{method: http.MethodDelete, path: "/Users/remote1"},
+				},
+				succeeded: gu.Ptr(""),
+			},
+		},
+		{
+			name:   "target unavailable, retry scheduled",
+			fields: fields{user: human, grants: activeGrant, status: http.StatusServiceUnavailable},
+			sync:   &query.SCIMSync{TargetID: "target1", UserID: "user1", RemoteID: "remote1", Attempts: 1},
+			want: want{
+				requests: []scimRequest{
+					{method: http.MethodPut, path: "/Users/remote1"},
+				},
+				retryReason: true,
+				nextAttempt: now.Add(2 * time.Minute),
+			},
+		},
+		{
+			name:   "target unavailable, last attempt failed",
+			fields: fields{user: human, grants: activeGrant, status: http.StatusServiceUnavailable},
+			sync:   &query.SCIMSync{TargetID: "target1", UserID: "user1", RemoteID: "remote1", Attempts: 2},
+			want: want{
+				requests: []scimRequest{
+					{method: http.MethodPut, path: "/Users/remote1"},
+				},
+				failed: true,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server, requests := scimServer(t, tt.fields.status)
+			queries := &fakeQueries{
+				user:   tt.fields.user,
+				grants: tt.fields.grants,
+				roles:  tt.fields.roles,
+			}
+			if !tt.fields.targetMissing {
+				queries.target = &query.SCIMTarget{
+					ID:        "target1",
+					ProjectID: "project1",
+					Endpoint:  server.URL,
+					Token:     &crypto.CryptoValue{CryptoType: crypto.TypeEncryption, Algorithm: "enc", KeyID: "id", Crypted: []byte("token")},
+					Mapping:   tt.fields.mapping,
+				}
+			}
+			commands := new(fakeCommands)
+			w := &worker{
+				cfg:      cfg,
+				commands: commands,
+				queries:  queries,
+				syncer: &syncer{
+					queries:    queries,
+					encryption: crypto.CreateMockEncryptionAlg(gomock.NewController(t)),
+					http:       server.Client(),
+				},
+			}
+			err := w.syncUser(context.Background(), tt.sync, now)
+			require.NoError(t, err)
+
+			got := make([]scimRequest, len(*requests))
+			for i, req := range *requests {
+				got[i] = scimRequest{method: req.method, path: req.path}
+			}
+			assert.Equal(t, tt.want.requests, got)
+			assert.Equal(t, tt.want.succeeded, commands.succeeded)
+			assert.Equal(t, tt.want.retryReason, commands.retryReason != "")
+			assert.Equal(t, tt.want.nextAttempt, commands.nextAttempt)
+			assert.Equal(t, tt.want.failed, commands.failed != "")
+		})
+	}
+}
+
+func Test_client_removeGroupMember(t *testing.T) {
+	server, requests := scimServer(t, http.StatusOK)
+	scim := newClient(server.Client(), server.URL+"/", "token")
+	require.NoError(t, scim.removeGroupMember(context.Background(), "group1", "remote1"))
+	require.Len(t, *requests, 1)
+	assert.Equal(t, http.MethodPatch, (*requests)[0].method)
+	assert.Equal(t, "/Groups/group1", (*requests)[0].path)
+	assert.Equal(t, map[string]any{
+		"schemas": []any{patchOpSchema},
+		"Operations": []any{map[string]any{
+			"op":   "remove",
+			"path": `members[value eq "remote1"]`,
+		}},
+	}, (*requests)[0].body)
+}
@@ -0,0 +1,260 @@
+package query
+
+import (
+	"context"
+	"slices"
+	"time"
+
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/repository/accessreview"
+	"github.com/zitadel/zitadel/internal/repository/org"
+	"github.com/zitadel/zitadel/internal/telemetry/tracing"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+// AccessReview periodically reviews the user grants of an organization
+type AccessReview struct {
+	ID            string
+	ResourceOwner string
+	CreationDate  time.Time
+	ChangeDate    time.Time
+	Sequence      uint64
+
+	Name            string
+	ProjectIDs      []string
+	Reviewers       []string
+	StartDate       time.Time
+	Duration        time.Duration
+	Interval        time.Duration
+	RevokeUndecided bool
+
+	// Campaigns are the started campaigns in the order they were started
+	Campaigns []*AccessReviewCampaign
+}
+
+// AccessReviewCampaign is a single run of an access review
+type AccessReviewCampaign struct {
+	ID        string
+	StartDate time.Time
+	DueDate   time.Time
+	// CloseDate is empty as long as the campaign is open
+	CloseDate time.Time
+	Reviewers []string
+	State     domain.AccessReviewCampaignState
+	Items     []*AccessReviewItem
+}
+
+// AccessReviewItem is a user grant reviewed in a campaign and the last decision about it
+type AccessReviewItem struct {
+	GrantID        string
+	ResourceOwner  string
+	UserID         string
+	ProjectID      string
+	ProjectGrantID string
+	RoleKeys       []string
+
+	Decision     domain.AccessReviewDecision
+	DecidedBy    string
+	DecisionDate time.Time
+	Comment      string
+	// Revoked is set if the grant was removed when the campaign was closed
+	Revoked bool
+}
+
+// AccessReviews returns the access reviews of the organization in the order they were added
+func (q *Queries) AccessReviews(ctx context.Context, resourceOwner string) (_ []*AccessReview, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	readModel := NewAccessReviewsReadModel(resourceOwner)
+	if err = q.eventstore.FilterToQueryReducer(ctx, readModel); err != nil {
+		return nil, err
+	}
+	return readModel.Reviews, nil
+}
+
+// AccessReviewByID returns the access review of the organization including its campaigns
+func (q *Queries) AccessReviewByID(ctx context.Context, resourceOwner, id string) (_ *AccessReview, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	reviews, err := q.AccessReviews(ctx, resourceOwner)
+	if err != nil {
+		return nil, err
+	}
+	for _, review := range reviews {
+		if review.ID == id {
+			return review, nil
+		}
+	}
+	return nil, zerrors.ThrowNotFound(nil, "QUERY-Ar1nf", "Errors.AccessReview.NotFound")
+}
+
+type AccessReviewsReadModel struct {
+	*eventstore.ReadModel
+
+	Reviews []*AccessReview
+}
+
+func NewAccessReviewsReadModel(resourceOwner string) *AccessReviewsReadModel {
+	return &AccessReviewsReadModel{
+		ReadModel: &eventstore.ReadModel{
+			ResourceOwner: resourceOwner,
+		},
+	}
+}
+
+func (rm *AccessReviewsReadModel) Reduce() error {
+	for _, event := range rm.Events {
+		switch e := event.(type) {
+		case *accessreview.AddedEvent:
+			rm.Reviews = append(rm.Reviews, &AccessReview{
+				ID:              e.Aggregate().ID,
+				ResourceOwner:   e.Aggregate().ResourceOwner,
+				CreationDate:    e.CreationDate(),
+				ChangeDate:      e.CreationDate(),
+				Sequence:        e.Sequence(),
+				Name:            e.Name,
+				ProjectIDs:      e.ProjectIDs,
+				Reviewers:       e.Reviewers,
+				StartDate:       e.StartDate,
+				Duration:        e.Duration,
+				Interval:        e.Interval,
+				RevokeUndecided: e.RevokeUndecided,
+			})
+		case *accessreview.ChangedEvent:
+			review := rm.review(e.Aggregate().ID)
+			if review == nil {
+				continue
+			}
+			review.ChangeDate = e.CreationDate()
+			review.Sequence = e.Sequence()
+			if e.Name != nil {
+				review.Name = *e.Name
+			}
+			if e.ProjectIDs != nil {
+				review.ProjectIDs = *e.ProjectIDs
+			}
+			if e.Reviewers != nil {
+				review.Reviewers = *e.Reviewers
+			}
+			if e.Duration != nil {
+				review.Duration = *e.Duration
+			}
+			if e.Interval != nil {
+				review.Interval = *e.Interval
+			}
+			if e.RevokeUndecided != nil {
+				review.RevokeUndecided = *e.RevokeUndecided
+			}
+		case *accessreview.RemovedEvent:
+			rm.Reviews = slices.DeleteFunc(rm.Reviews, func(review *AccessReview) bool {
+				return review.ID == e.Aggregate().ID
+			})
+		case *accessreview.CampaignStartedEvent:
+			review := rm.review(e.Aggregate().ID)
+			if review == nil {
+				continue
+			}
+			review.ChangeDate = e.CreationDate()
+			review.Sequence = e.Sequence()
+			items := make([]*AccessReviewItem, len(e.Items))
+			for i, item := range e.Items {
+				items[i] = &AccessReviewItem{
+					GrantID:        item.GrantID,
+					ResourceOwner:  item.ResourceOwner,
+					UserID:         item.UserID,
+					ProjectID:      item.ProjectID,
+					ProjectGrantID: item.ProjectGrantID,
+					RoleKeys:       item.RoleKeys,
+				}
+			}
+			review.Campaigns = append(review.Campaigns, &AccessReviewCampaign{
+				ID:        e.CampaignID,
+				StartDate: e.CreationDate(),
+				DueDate:   e.DueDate,
+				Reviewers: e.Reviewers,
+				State:     domain.AccessReviewCampaignStateOpen,
+				Items:     items,
+			})
+		case *accessreview.ItemDecidedEvent:
+			item := rm.item(e.Aggregate().ID, e.CampaignID, e.GrantID)
+			if item == nil {
+				continue
+			}
+			item.Decision = e.Decision
+			item.DecidedBy = e.Creator()
+			item.DecisionDate = e.CreationDate()
+			item.Comment = e.Comment
+		case *accessreview.CampaignClosedEvent:
+			campaign := rm.campaign(e.Aggregate().ID, e.CampaignID)
+			if campaign == nil {
+				continue
+			}
+			campaign.State = domain.AccessReviewCampaignStateClosed
+			campaign.CloseDate = e.CreationDate()
+			for _, item := range campaign.Items {
+				item.Revoked = slices.Contains(e.RevokedIDs, item.GrantID)
+			}
+		case *org.OrgRemovedEvent:
+			rm.Reviews = nil
+		}
+	}
+	return rm.ReadModel.Reduce()
+}
+
+func (rm *AccessReviewsReadModel) Query() *eventstore.SearchQueryBuilder {
+	return eventstore.NewSearchQueryBuilder(eventstore.ColumnsEvent).
+		AwaitOpenTransactions().
+		ResourceOwner(rm.ResourceOwner).
+		AddQuery().
+		AggregateTypes(accessreview.AggregateType).
+		EventTypes(
+			accessreview.AddedEventType,
+			accessreview.ChangedEventType,
+			accessreview.RemovedEventType,
+			accessreview.CampaignStartedEventType,
+			accessreview.ItemDecidedEventType,
+			accessreview.CampaignClosedEventType).
+		Or().
+		AggregateTypes(org.AggregateType).
+		AggregateIDs(rm.ResourceOwner).
+		EventTypes(org.OrgRemovedEventType).
+		Builder()
+}
+
+func (rm *AccessReviewsReadModel) review(id string) *AccessReview {
+	for _, review := range rm.Reviews {
+		if review.ID == id {
+			return review
+		}
+	}
+	return nil
+}
+
+func (rm *AccessReviewsReadModel) campaign(reviewID, campaignID string) *AccessReviewCampaign {
+	review := rm.review(reviewID)
+	if review == nil {
+		return nil
+	}
+	for _, campaign := range review.Campaigns {
+		if campaign.ID == campaignID {
+			return campaign
+		}
+	}
+	return nil
+}
+
+func (rm *AccessReviewsReadModel) item(reviewID, campaignID, grantID string) *AccessReviewItem {
+	campaign := rm.campaign(reviewID, campaignID)
+	if campaign == nil {
+		return nil
+	}
+	for _, item := range campaign.Items {
+		if item.GrantID == grantID {
+			return item
+		}
+	}
+	return nil
+}
@@ -0,0 +1,104 @@
+package query
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/repository/accessreview"
+)
+
+func TestAccessReviewsReadModel_Reduce(t *testing.T) {
+	agg := &accessreview.NewAggregate("review1", "org1").Aggregate
+	startDate := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	dueDate := startDate.Add(24 * time.Hour)
+	added := accessreview.NewAddedEvent(context.Background(), agg, "review", []string{"project1"}, []string{"reviewer1"}, startDate, 24*time.Hour, 0, true)
+	started := accessreview.NewCampaignStartedEvent(context.Background(), agg, "campaign1", dueDate, []string{"reviewer1"}, []*accessreview.Item{
+		{GrantID: "grant1", ResourceOwner: "org1", UserID: "user1", ProjectID: "project1", RoleKeys: []string{"admin"}},
+		{GrantID: "grant2", ResourceOwner: "org1", UserID: "user2", ProjectID: "project1", RoleKeys: []string{"viewer"}},
+	})
+	tests := []struct {
+		name   string
+		events []eventstore.Event
+		want   []*AccessReview
+	}{
+		{
+			name: "no reviews",
+		},
+		{
+			name: "review changed and campaign closed",
+			events: []eventstore.Event{
+				added,
+				accessreview.NewChangedEvent(context.Background(), agg, []accessreview.Changes{
+					accessreview.ChangeName("quarterly review"),
+					accessreview.ChangeRevokeUndecided(false),
+				}),
+				started,
+				accessreview.NewItemDecidedEvent(context.Background(), agg, "campaign1", "grant1", domain.AccessReviewDecisionApprove, "still needed"),
+				accessreview.NewItemDecidedEvent(context.Background(), agg, "campaign1", "grant2", domain.AccessReviewDecisionRevoke, ""),
+				accessreview.NewCampaignClosedEvent(context.Background(), agg, "campaign1", []string{"grant2"}),
+			},
+			want: []*AccessReview{
+				{
+					ID:            "review1",
+					ResourceOwner: "org1",
+					Name:          "quarterly review",
+					ProjectIDs:    []string{"project1"},
+					Reviewers:     []string{"reviewer1"},
+					StartDate:     startDate,
+					Duration:      24 * time.Hour,
+					Campaigns: []*AccessReviewCampaign{
+						{
+							ID:        "campaign1",
+							DueDate:   dueDate,
+							Reviewers: []string{"reviewer1"},
+							State:     domain.AccessReviewCampaignStateClosed,
+							Items: []*AccessReviewItem{
+								{
+									GrantID:       "grant1",
+									ResourceOwner: "org1",
+									UserID:        "user1",
+									ProjectID:     "project1",
+									RoleKeys:      []string{"admin"},
+									Decision:      domain.AccessReviewDecisionApprove,
+									Comment:       "still needed",
+								},
+								{
+									GrantID:       "grant2",
+									ResourceOwner: "org1",
+									UserID:        "user2",
+									ProjectID:     "project1",
+									RoleKeys:      []string{"viewer"},
+									Decision:      domain.AccessReviewDecisionRevoke,
+									Revoked:       true,
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "review removed",
+			events: []eventstore.Event{
+				added,
+				started,
+				accessreview.NewRemovedEvent(context.Background(), agg),
+			},
+			want: []*AccessReview{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rm := NewAccessReviewsReadModel("org1")
+			rm.AppendEvents(tt.events...)
+			require.NoError(t, rm.Reduce())
+			assert.Equal(t, tt.want, rm.Reviews)
+		})
+	}
+}
@@ -0,0 +1,143 @@
+package query
+
+import (
+	"context"
+	"slices"
+	"time"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/crypto"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/repository/instance"
+	"github.com/zitadel/zitadel/internal/telemetry/tracing"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+// AlertTarget is a Slack or Teams channel security-relevant events of the instance are posted to
+type AlertTarget struct {
+	ID           string
+	CreationDate time.Time
+	ChangeDate   time.Time
+	Sequence     uint64
+
+	Name       string
+	TargetType domain.AlertTargetType
+	WebhookURL *crypto.CryptoValue
+	EventTypes []string
+}
+
+// Matches returns if alerts of the event type are posted to the target
+func (t *AlertTarget) Matches(eventType string) bool {
+	return domain.AlertEventTypeMatches(t.EventTypes, eventType)
+}
+
+// AlertTargets returns the active alert targets of the instance in the order they were added
+func (q *Queries) AlertTargets(ctx context.Context) (_ []*AlertTarget, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	readModel := NewInstanceAlertTargetsReadModel(authz.GetInstance(ctx).InstanceID())
+	if err = q.eventstore.FilterToQueryReducer(ctx, readModel); err != nil {
+		return nil, err
+	}
+	return readModel.Targets, nil
+}
+
+// AlertTargetByID returns the alert target of the instance
+func (q *Queries) AlertTargetByID(ctx context.Context, id string) (_ *AlertTarget, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	targets, err := q.AlertTargets(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, target := range targets {
+		if target.ID == id {
+			return target, nil
+		}
+	}
+	return nil, zerrors.ThrowNotFound(nil, "QUERY-Al4nf", "Errors.Alert.Target.NotFound")
+}
+
+type InstanceAlertTargetsReadModel struct {
+	*eventstore.ReadModel
+
+	Targets []*AlertTarget
+}
+
+func NewInstanceAlertTargetsReadModel(instanceID string) *InstanceAlertTargetsReadModel {
+	return &InstanceAlertTargetsReadModel{
+		ReadModel: &eventstore.ReadModel{
+			AggregateID:   instanceID,
+			ResourceOwner: instanceID,
+			InstanceID:    instanceID,
+		},
+	}
+}
+
+func (rm *InstanceAlertTargetsReadModel) Reduce() error {
+	for _, event := range rm.Events {
+		switch e := event.(type) {
+		case *instance.AlertTargetAddedEvent:
+			rm.Targets = append(rm.Targets, &AlertTarget{
+				ID:           e.ID,
+				CreationDate: e.CreationDate(),
+				ChangeDate:   e.CreationDate(),
+				Sequence:     e.Sequence(),
+				Name:         e.Name,
+				TargetType:   e.TargetType,
+				WebhookURL:   e.WebhookURL,
+				EventTypes:   e.EventTypes,
+			})
+		case *instance.AlertTargetChangedEvent:
+			target := rm.target(e.ID)
+			if target == nil {
+				continue
+			}
+			target.ChangeDate = e.CreationDate()
+			target.Sequence = e.Sequence()
+			if e.Name != nil {
+				target.Name = *e.Name
+			}
+			if e.TargetType != nil {
+				target.TargetType = *e.TargetType
+			}
+			if e.WebhookURL != nil {
+				target.WebhookURL = e.WebhookURL
+			}
+			if e.EventTypes != nil {
+				target.EventTypes = *e.EventTypes
+			}
+		case *instance.AlertTargetRemovedEvent:
+			rm.Targets = slices.DeleteFunc(rm.Targets, func(target *AlertTarget) bool {
+				return target.ID == e.ID
+			})
+		}
+	}
+	return rm.ReadModel.Reduce()
+}
+
+func (rm *InstanceAlertTargetsReadModel) Query() *eventstore.SearchQueryBuilder {
+	return eventstore.NewSearchQueryBuilder(eventstore.ColumnsEvent).
+		AwaitOpenTransactions().
+		ResourceOwner(rm.ResourceOwner).
+		AddQuery().
+		AggregateTypes(instance.AggregateType).
+		AggregateIDs(rm.AggregateID).
+		EventTypes(
+			instance.AlertTargetAddedEventType,
+			instance.AlertTargetChangedEventType,
+			instance.AlertTargetRemovedEventType).
+		Builder()
+}
+
+func (rm *InstanceAlertTargetsReadModel) target(id string) *AlertTarget {
+	for _, target := range rm.Targets {
+		if target.ID == id {
+			return target
+		}
+	}
+	return nil
+}
@@ -0,0 +1,75 @@
+package query
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/zitadel/zitadel/internal/crypto"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/repository/instance"
+)
+
+func TestInstanceAlertTargetsReadModel_Reduce(t *testing.T) {
+	agg := &instance.NewAggregate("instance1").Aggregate
+	url1 := &crypto.CryptoValue{CryptoType: crypto.TypeEncryption, Algorithm: "enc", KeyID: "id", Crypted: []byte("url1")}
+	url2 := &crypto.CryptoValue{CryptoType: crypto.TypeEncryption, Algorithm: "enc", KeyID: "id", Crypted: []byte("url2")}
+	changed, err := instance.NewAlertTargetChangedEvent(context.Background(), agg, "target1", []instance.AlertTargetChanges{
+		instance.ChangeAlertTargetName("ops"),
+		instance.ChangeAlertTargetWebhookURL(url2),
+		instance.ChangeAlertTargetEventTypes([]string{"projection.failed"}),
+	})
+	require.NoError(t, err)
+	tests := []struct {
+		name   string
+		events []eventstore.Event
+		want   []*AlertTarget
+	}{
+		{
+			name: "no targets",
+		},
+		{
+			name: "targets added and changed",
+			events: []eventstore.Event{
+				instance.NewAlertTargetAddedEvent(context.Background(), agg, "target1", "security", domain.AlertTargetTypeSlack, url1, nil),
+				instance.NewAlertTargetAddedEvent(context.Background(), agg, "target2", "teams", domain.AlertTargetTypeTeams, url1, []string{"org.*"}),
+				changed,
+			},
+			want: []*AlertTarget{
+				{
+					ID:         "target1",
+					Name:       "ops",
+					TargetType: domain.AlertTargetTypeSlack,
+					WebhookURL: url2,
+					EventTypes: []string{"projection.failed"},
+				},
+				{
+					ID:         "target2",
+					Name:       "teams",
+					TargetType: domain.AlertTargetTypeTeams,
+					WebhookURL: url1,
+					EventTypes: []string{"org.*"},
+				},
+			},
+		},
+		{
+			name: "target removed",
+			events: []eventstore.Event{
+				instance.NewAlertTargetAddedEvent(context.Background(), agg, "target1", "security", domain.AlertTargetTypeSlack, url1, nil),
+				instance.NewAlertTargetRemovedEvent(context.Background(), agg, "target1"),
+			},
+			want: []*AlertTarget{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rm := NewInstanceAlertTargetsReadModel("instance1")
+			rm.AppendEvents(tt.events...)
+			require.NoError(t, rm.Reduce())
+			assert.Equal(t, tt.want, rm.Targets)
+		})
+	}
+}
@@ -3,6 +3,7 @@ package query
 import (
 	"context"
 	"database/sql"
+	"encoding/json"
 	"errors"
 	"time"
 
@@ -32,8 +33,9 @@ type App struct {
 	State         domain.AppState
 	Sequence      uint64
 
-	ProjectID string
-	Name      string
+	ProjectID     string
+	Name          string
+	ClaimsMapping *domain.ClaimsMapping
 
 	OIDCConfig *OIDCApp
 	SAMLConfig *SAMLApp
@@ -59,12 +61,18 @@ type OIDCApp struct {
 	AdditionalOrigins        database.TextArray[string]
 	AllowedOrigins           database.TextArray[string]
 	SkipNativeAppSuccessPage bool
+	TLSClientAuthSubjectDN   string
+	TLSClientAuthThumbprint  string
+	BackChannelLogoutURI     string
+	FrontChannelLogoutURI    string
+	FrameAncestors           database.TextArray[string]
 }
 
 type SAMLApp struct {
 	Metadata    []byte
 	MetadataURL string
 	EntityID    string
+	Attributes  []*domain.SAMLAttribute
 }
 
 type APIApp struct {
@@ -126,6 +134,10 @@ var (
 		name:  projection.AppColumnSequence,
 		table: appsTable,
 	}
+	AppColumnClaimsMapping = Column{
+		name:  projection.AppColumnClaimsMapping,
+		table: appsTable,
+	}
 )
 
 var (
@@ -149,6 +161,10 @@ var (
 		name:  projection.AppSAMLConfigColumnMetadataURL,
 		table: appSAMLConfigsTable,
 	}
+	AppSAMLConfigColumnAttributes = Column{
+		name:  projection.AppSAMLConfigColumnAttributes,
+		table: appSAMLConfigsTable,
+	}
 )
 
 var (
@@ -243,6 +259,26 @@ var (
 		name:  projection.AppOIDCConfigColumnSkipNativeAppSuccessPage,
 		table: appOIDCConfigsTable,
 	}
+	AppOIDCConfigColumnTLSClientAuthSubjectDN = Column{
+		name:  projection.AppOIDCConfigColumnTLSClientAuthSubjectDN,
+		table: appOIDCConfigsTable,
+	}
+	AppOIDCConfigColumnTLSClientAuthThumbprint = Column{
+		name:  projection.AppOIDCConfigColumnTLSClientAuthThumbprint,
+		table: appOIDCConfigsTable,
+	}
+	AppOIDCConfigColumnBackChannelLogoutURI = Column{
+		name:  projection.AppOIDCConfigColumnBackChannelLogoutURI,
+		table: appOIDCConfigsTable,
+	}
+	AppOIDCConfigColumnFrontChannelLogoutURI = Column{
+		name:  projection.AppOIDCConfigColumnFrontChannelLogoutURI,
+		table: appOIDCConfigsTable,
+	}
+	AppOIDCConfigColumnFrameAncestors = Column{
+		name:  projection.AppOIDCConfigColumnFrameAncestors,
+		table: appOIDCConfigsTable,
+	}
 )
 
 func (q *Queries) AppByProjectAndAppID(ctx context.Context, shouldTriggerBulk bool, projectID, appID string) (app *App, err error) {
@@ -501,6 +537,7 @@ func prepareAppQuery(ctx context.Context, db prepareDatabase) (sq.SelectBuilder,
 			AppColumnResourceOwner.identifier(),
 			AppColumnState.identifier(),
 			AppColumnSequence.identifier(),
+			AppColumnClaimsMapping.identifier(),
 
 			AppAPIConfigColumnAppID.identifier(),
 			AppAPIConfigColumnClientID.identifier(),
@@ -523,11 +560,17 @@ func prepareAppQuery(ctx context.Context, db prepareDatabase) (sq.SelectBuilder,
 			AppOIDCConfigColumnClockSkew.identifier(),
 			AppOIDCConfigColumnAdditionalOrigins.identifier(),
 			AppOIDCConfigColumnSkipNativeAppSuccessPage.identifier(),
+			AppOIDCConfigColumnTLSClientAuthSubjectDN.identifier(),
+			AppOIDCConfigColumnTLSClientAuthThumbprint.identifier(),
+			AppOIDCConfigColumnBackChannelLogoutURI.identifier(),
+			AppOIDCConfigColumnFrontChannelLogoutURI.identifier(),
+			AppOIDCConfigColumnFrameAncestors.identifier(),
 
 			AppSAMLConfigColumnAppID.identifier(),
 			AppSAMLConfigColumnEntityID.identifier(),
 			AppSAMLConfigColumnMetadata.identifier(),
 			AppSAMLConfigColumnMetadataURL.identifier(),
+			AppSAMLConfigColumnAttributes.identifier(),
 		).From(appsTable.identifier()).
 			LeftJoin(join(AppAPIConfigColumnAppID, AppColumnID)).
 			LeftJoin(join(AppOIDCConfigColumnAppID, AppColumnID)).
@@ -536,9 +579,10 @@ func prepareAppQuery(ctx context.Context, db prepareDatabase) (sq.SelectBuilder,
 			app := new(App)
 
 			var (
-				apiConfig  = sqlAPIConfig{}
-				oidcConfig = sqlOIDCConfig{}
-				samlConfig = sqlSAMLConfig{}
+				claimsMapping []byte
+				apiConfig     = sqlAPIConfig{}
+				oidcConfig    = sqlOIDCConfig{}
+				samlConfig    = sqlSAMLConfig{}
 			)
 
 			err := row.Scan(
@@ -550,6 +594,7 @@ func prepareAppQuery(ctx context.Context, db prepareDatabase) (sq.SelectBuilder,
 				&app.ResourceOwner,
 				&app.State,
 				&app.Sequence,
+				&claimsMapping,
 
 				&apiConfig.appID,
 				&apiConfig.clientID,
@@ -572,11 +617,17 @@ func prepareAppQuery(ctx context.Context, db prepareDatabase) (sq.SelectBuilder,
 				&oidcConfig.clockSkew,
 				&oidcConfig.additionalOrigins,
 				&oidcConfig.skipNativeAppSuccessPage,
+				&oidcConfig.tlsClientAuthSubjectDN,
+				&oidcConfig.tlsClientAuthThumbprint,
+				&oidcConfig.backChannelLogoutURI,
+				&oidcConfig.frontChannelLogoutURI,
+				&oidcConfig.frameAncestors,
 
 				&samlConfig.appID,
 				&samlConfig.entityID,
 				&samlConfig.metadata,
 				&samlConfig.metadataURL,
+				&samlConfig.attributes,
 			)
 
 			if err != nil {
@@ -585,10 +636,15 @@ func prepareAppQuery(ctx context.Context, db prepareDatabase) (sq.SelectBuilder,
 				}
 				return nil, zerrors.ThrowInternal(err, "QUERY-4SJlx", "Errors.Internal")
 			}
+			if app.ClaimsMapping, err = claimsMappingFromJSON(claimsMapping); err != nil {
+				return nil, zerrors.ThrowInternal(err, "QUERY-Wq3mc", "Errors.Internal")
+			}
 
 			apiConfig.set(app)
 			oidcConfig.set(app)
-			samlConfig.set(app)
+			if err = samlConfig.set(app); err != nil {
+				return nil, zerrors.ThrowInternal(err, "QUERY-Sa1jm", "Errors.Internal")
+			}
 
 			return app, nil
 		}
@@ -622,6 +678,11 @@ func prepareOIDCAppQuery() (sq.SelectBuilder, func(*sql.Row) (*App, error)) {
 			AppOIDCConfigColumnClockSkew.identifier(),
 			AppOIDCConfigColumnAdditionalOrigins.identifier(),
 			AppOIDCConfigColumnSkipNativeAppSuccessPage.identifier(),
+			AppOIDCConfigColumnTLSClientAuthSubjectDN.identifier(),
+			AppOIDCConfigColumnTLSClientAuthThumbprint.identifier(),
+			AppOIDCConfigColumnBackChannelLogoutURI.identifier(),
+			AppOIDCConfigColumnFrontChannelLogoutURI.identifier(),
+			AppOIDCConfigColumnFrameAncestors.identifier(),
 		).From(appsTable.identifier()).
 			Join(join(AppOIDCConfigColumnAppID, AppColumnID)).
 			PlaceholderFormat(sq.Dollar), func(row *sql.Row) (*App, error) {
@@ -658,6 +719,11 @@ func prepareOIDCAppQuery() (sq.SelectBuilder, func(*sql.Row) (*App, error)) {
 				&oidcConfig.clockSkew,
 				&oidcConfig.additionalOrigins,
 				&oidcConfig.skipNativeAppSuccessPage,
+				&oidcConfig.tlsClientAuthSubjectDN,
+				&oidcConfig.tlsClientAuthThumbprint,
+				&oidcConfig.backChannelLogoutURI,
+				&oidcConfig.frontChannelLogoutURI,
+				&oidcConfig.frameAncestors,
 			)
 
 			if err != nil {
@@ -688,6 +754,7 @@ func prepareSAMLAppQuery(ctx context.Context, db prepareDatabase) (sq.SelectBuil
 			AppSAMLConfigColumnEntityID.identifier(),
 			AppSAMLConfigColumnMetadata.identifier(),
 			AppSAMLConfigColumnMetadataURL.identifier(),
+			AppSAMLConfigColumnAttributes.identifier(),
 		).From(appsTable.identifier()).
 			Join(join(AppSAMLConfigColumnAppID, AppColumnID)).
 			PlaceholderFormat(sq.Dollar), func(row *sql.Row) (*App, error) {
@@ -711,6 +778,7 @@ func prepareSAMLAppQuery(ctx context.Context, db prepareDatabase) (sq.SelectBuil
 				&samlConfig.entityID,
 				&samlConfig.metadata,
 				&samlConfig.metadataURL,
+				&samlConfig.attributes,
 			)
 
 			if err != nil {
@@ -720,7 +788,9 @@ func prepareSAMLAppQuery(ctx context.Context, db prepareDatabase) (sq.SelectBuil
 				return nil, zerrors.ThrowInternal(err, "QUERY-NAtPg", "Errors.Internal")
 			}
 
-			samlConfig.set(app)
+			if err = samlConfig.set(app); err != nil {
+				return nil, zerrors.ThrowInternal(err, "QUERY-Sa2jm", "Errors.Internal")
+			}
 
 			return app, nil
 		}
@@ -762,6 +832,7 @@ func prepareProjectByOIDCAppQuery(ctx context.Context, db prepareDatabase) (sq.S
 			ProjectColumnProjectRoleCheck.identifier(),
 			ProjectColumnHasProjectCheck.identifier(),
 			ProjectColumnPrivateLabelingSetting.identifier(),
+			ProjectColumnAccessTokenType.identifier(),
 		).From(projectsTable.identifier()).
 			Join(join(AppColumnProjectID, ProjectColumnID)).
 			Join(join(AppOIDCConfigColumnAppID, AppColumnID)).
@@ -780,6 +851,7 @@ func prepareProjectByOIDCAppQuery(ctx context.Context, db prepareDatabase) (sq.S
 				&p.ProjectRoleCheck,
 				&p.HasProjectCheck,
 				&p.PrivateLabelingSetting,
+				&p.AccessTokenType,
 			)
 			if err != nil {
 				if errors.Is(err, sql.ErrNoRows) {
@@ -804,6 +876,7 @@ func prepareProjectByAppQuery(ctx context.Context, db prepareDatabase) (sq.Selec
 			ProjectColumnProjectRoleCheck.identifier(),
 			ProjectColumnHasProjectCheck.identifier(),
 			ProjectColumnPrivateLabelingSetting.identifier(),
+			ProjectColumnAccessTokenType.identifier(),
 		).From(projectsTable.identifier()).
 			Join(join(AppColumnProjectID, ProjectColumnID)).
 			LeftJoin(join(AppAPIConfigColumnAppID, AppColumnID)).
@@ -824,6 +897,7 @@ func prepareProjectByAppQuery(ctx context.Context, db prepareDatabase) (sq.Selec
 				&p.ProjectRoleCheck,
 				&p.HasProjectCheck,
 				&p.PrivateLabelingSetting,
+				&p.AccessTokenType,
 			)
 			if err != nil {
 				if errors.Is(err, sql.ErrNoRows) {
@@ -845,6 +919,7 @@ func prepareAppsQuery(ctx context.Context, db prepareDatabase) (sq.SelectBuilder
 			AppColumnResourceOwner.identifier(),
 			AppColumnState.identifier(),
 			AppColumnSequence.identifier(),
+			AppColumnClaimsMapping.identifier(),
 
 			AppAPIConfigColumnAppID.identifier(),
 			AppAPIConfigColumnClientID.identifier(),
@@ -867,11 +942,17 @@ func prepareAppsQuery(ctx context.Context, db prepareDatabase) (sq.SelectBuilder
 			AppOIDCConfigColumnClockSkew.identifier(),
 			AppOIDCConfigColumnAdditionalOrigins.identifier(),
 			AppOIDCConfigColumnSkipNativeAppSuccessPage.identifier(),
+			AppOIDCConfigColumnTLSClientAuthSubjectDN.identifier(),
+			AppOIDCConfigColumnTLSClientAuthThumbprint.identifier(),
+			AppOIDCConfigColumnBackChannelLogoutURI.identifier(),
+			AppOIDCConfigColumnFrontChannelLogoutURI.identifier(),
+			AppOIDCConfigColumnFrameAncestors.identifier(),
 
 			AppSAMLConfigColumnAppID.identifier(),
 			AppSAMLConfigColumnEntityID.identifier(),
 			AppSAMLConfigColumnMetadata.identifier(),
 			AppSAMLConfigColumnMetadataURL.identifier(),
+			AppSAMLConfigColumnAttributes.identifier(),
 			countColumn.identifier(),
 		).From(appsTable.identifier()).
 			LeftJoin(join(AppAPIConfigColumnAppID, AppColumnID)).
@@ -883,9 +964,10 @@ func prepareAppsQuery(ctx context.Context, db prepareDatabase) (sq.SelectBuilder
 			for row.Next() {
 				app := new(App)
 				var (
-					apiConfig  = sqlAPIConfig{}
-					oidcConfig = sqlOIDCConfig{}
-					samlConfig = sqlSAMLConfig{}
+					claimsMapping []byte
+					apiConfig     = sqlAPIConfig{}
+					oidcConfig    = sqlOIDCConfig{}
+					samlConfig    = sqlSAMLConfig{}
 				)
 
 				err := row.Scan(
@@ -897,6 +979,7 @@ func prepareAppsQuery(ctx context.Context, db prepareDatabase) (sq.SelectBuilder
 					&app.ResourceOwner,
 					&app.State,
 					&app.Sequence,
+					&claimsMapping,
 
 					&apiConfig.appID,
 					&apiConfig.clientID,
@@ -919,11 +1002,17 @@ func prepareAppsQuery(ctx context.Context, db prepareDatabase) (sq.SelectBuilder
 					&oidcConfig.clockSkew,
 					&oidcConfig.additionalOrigins,
 					&oidcConfig.skipNativeAppSuccessPage,
+					&oidcConfig.tlsClientAuthSubjectDN,
+					&oidcConfig.tlsClientAuthThumbprint,
+					&oidcConfig.backChannelLogoutURI,
+					&oidcConfig.frontChannelLogoutURI,
+					&oidcConfig.frameAncestors,
 
 					&samlConfig.appID,
 					&samlConfig.entityID,
 					&samlConfig.metadata,
 					&samlConfig.metadataURL,
+					&samlConfig.attributes,
 
 					&apps.Count,
 				)
@@ -931,10 +1020,15 @@ func prepareAppsQuery(ctx context.Context, db prepareDatabase) (sq.SelectBuilder
 				if err != nil {
 					return nil, zerrors.ThrowInternal(err, "QUERY-XGWAX", "Errors.Internal")
 				}
+				if app.ClaimsMapping, err = claimsMappingFromJSON(claimsMapping); err != nil {
+					return nil, zerrors.ThrowInternal(err, "QUERY-Nd8sk", "Errors.Internal")
+				}
 
 				apiConfig.set(app)
 				oidcConfig.set(app)
-				samlConfig.set(app)
+				if err = samlConfig.set(app); err != nil {
+					return nil, zerrors.ThrowInternal(err, "QUERY-Sa3jm", "Errors.Internal")
+				}
 
 				apps.Apps = append(apps.Apps, app)
 			}
@@ -973,6 +1067,15 @@ func prepareClientIDsQuery(ctx context.Context, db prepareDatabase) (sq.SelectBu
 		}
 }
 
+func claimsMappingFromJSON(data []byte) (mapping *domain.ClaimsMapping, err error) {
+	if len(data) == 0 {
+		return nil, nil
+	}
+	// a removed mapping is stored as JSON null, which leaves the mapping nil
+	err = json.Unmarshal(data, &mapping)
+	return mapping, err
+}
+
 type sqlOIDCConfig struct {
 	appID                    sql.NullString
 	version                  sql.NullInt32
@@ -991,6 +1094,11 @@ type sqlOIDCConfig struct {
 	responseTypes            database.NumberArray[domain.OIDCResponseType]
 	grantTypes               database.NumberArray[domain.OIDCGrantType]
 	skipNativeAppSuccessPage sql.NullBool
+	tlsClientAuthSubjectDN   sql.NullString
+	tlsClientAuthThumbprint  sql.NullString
+	backChannelLogoutURI     sql.NullString
+	frontChannelLogoutURI    sql.NullString
+	frameAncestors           database.TextArray[string]
 }
 
 func (c sqlOIDCConfig) set(app *App) {
@@ -1014,6 +1122,11 @@ func (c sqlOIDCConfig) set(app *App) {
 		ResponseTypes:            c.responseTypes,
 		GrantTypes:               c.grantTypes,
 		SkipNativeAppSuccessPage: c.skipNativeAppSuccessPage.Bool,
+		TLSClientAuthSubjectDN:   c.tlsClientAuthSubjectDN.String,
+		TLSClientAuthThumbprint:  c.tlsClientAuthThumbprint.String,
+		BackChannelLogoutURI:     c.backChannelLogoutURI.String,
+		FrontChannelLogoutURI:    c.frontChannelLogoutURI.String,
+		FrameAncestors:           c.frameAncestors,
 	}
 	compliance := domain.GetOIDCCompliance(app.OIDCConfig.Version, app.OIDCConfig.AppType, app.OIDCConfig.GrantTypes, app.OIDCConfig.ResponseTypes, app.OIDCConfig.AuthMethodType, app.OIDCConfig.RedirectURIs)
 	app.OIDCConfig.ComplianceProblems = compliance.Problems
@@ -1028,17 +1141,23 @@ type sqlSAMLConfig struct {
 	entityID    sql.NullString
 	metadataURL sql.NullString
 	metadata    []byte
+	attributes  []byte
 }
 
-func (c sqlSAMLConfig) set(app *App) {
+func (c sqlSAMLConfig) set(app *App) error {
 	if !c.appID.Valid {
-		return
+		return nil
 	}
 	app.SAMLConfig = &SAMLApp{
 		MetadataURL: c.metadataURL.String,
 		Metadata:    c.metadata,
 		EntityID:    c.entityID.String,
 	}
+	if len(c.attributes) == 0 {
+		return nil
+	}
+	// removed attributes are stored as JSON null, which leaves them nil
+	return json.Unmarshal(c.attributes, &app.SAMLConfig.Attributes)
 }
 
 type sqlAPIConfig struct {
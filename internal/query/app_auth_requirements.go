@@ -0,0 +1,72 @@
+package query
+
+import (
+	"context"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/repository/project"
+	"github.com/zitadel/zitadel/internal/telemetry/tracing"
+)
+
+// AppAuthRequirementsByID returns the conditions the authentication of a user has to fulfil for the application.
+// Applications without requirements return nil.
+func (q *Queries) AppAuthRequirementsByID(ctx context.Context, projectID, appID string) (_ *domain.AppAuthRequirements, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	model := newAppAuthRequirementsReadModel(authz.GetInstance(ctx).InstanceID(), projectID, appID)
+	if err = q.eventstore.FilterToQueryReducer(ctx, model); err != nil {
+		return nil, err
+	}
+	return model.requirements, nil
+}
+
+type appAuthRequirementsReadModel struct {
+	eventstore.ReadModel
+
+	appID        string
+	requirements *domain.AppAuthRequirements
+}
+
+func newAppAuthRequirementsReadModel(instanceID, projectID, appID string) *appAuthRequirementsReadModel {
+	return &appAuthRequirementsReadModel{
+		ReadModel: eventstore.ReadModel{
+			AggregateID: projectID,
+			InstanceID:  instanceID,
+		},
+		appID: appID,
+	}
+}
+
+func (rm *appAuthRequirementsReadModel) Reduce() error {
+	for _, event := range rm.Events {
+		switch e := event.(type) {
+		case *project.ApplicationAuthRequirementsSetEvent:
+			rm.requirements = e.AuthRequirements
+		case *project.ApplicationRemovedEvent, *project.ProjectRemovedEvent:
+			rm.requirements = nil
+		}
+	}
+	return rm.ReadModel.Reduce()
+}
+
+func (rm *appAuthRequirementsReadModel) Query() *eventstore.SearchQueryBuilder {
+	return eventstore.NewSearchQueryBuilder(eventstore.ColumnsEvent).
+		AwaitOpenTransactions().
+		InstanceID(rm.InstanceID).
+		AddQuery().
+		AggregateTypes(project.AggregateType).
+		AggregateIDs(rm.AggregateID).
+		EventTypes(
+			project.ApplicationAuthRequirementsSetType,
+			project.ApplicationRemovedType,
+		).
+		EventData(map[string]interface{}{"appId": rm.appID}).
+		Or().
+		AggregateTypes(project.AggregateType).
+		AggregateIDs(rm.AggregateID).
+		EventTypes(project.ProjectRemovedType).
+		Builder()
+}
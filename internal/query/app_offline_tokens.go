@@ -0,0 +1,72 @@
+package query
+
+import (
+	"context"
+	"time"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/repository/project"
+	"github.com/zitadel/zitadel/internal/telemetry/tracing"
+)
+
+// AppOfflineTokenMaxLifetimeByID returns the max lifetime of the offline tokens the first-party application can issue.
+// Applications which don't allow offline tokens return 0.
+func (q *Queries) AppOfflineTokenMaxLifetimeByID(ctx context.Context, projectID, appID string) (_ time.Duration, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	model := newAppOfflineTokensReadModel(authz.GetInstance(ctx).InstanceID(), projectID, appID)
+	if err = q.eventstore.FilterToQueryReducer(ctx, model); err != nil {
+		return 0, err
+	}
+	return model.maxLifetime, nil
+}
+
+type appOfflineTokensReadModel struct {
+	eventstore.ReadModel
+
+	appID       string
+	maxLifetime time.Duration
+}
+
+func newAppOfflineTokensReadModel(instanceID, projectID, appID string) *appOfflineTokensReadModel {
+	return &appOfflineTokensReadModel{
+		ReadModel: eventstore.ReadModel{
+			AggregateID: projectID,
+			InstanceID:  instanceID,
+		},
+		appID: appID,
+	}
+}
+
+func (rm *appOfflineTokensReadModel) Reduce() error {
+	for _, event := range rm.Events {
+		switch e := event.(type) {
+		case *project.ApplicationOfflineTokensSetEvent:
+			rm.maxLifetime = e.MaxLifetime
+		case *project.ApplicationRemovedEvent, *project.ProjectRemovedEvent:
+			rm.maxLifetime = 0
+		}
+	}
+	return rm.ReadModel.Reduce()
+}
+
+func (rm *appOfflineTokensReadModel) Query() *eventstore.SearchQueryBuilder {
+	return eventstore.NewSearchQueryBuilder(eventstore.ColumnsEvent).
+		AwaitOpenTransactions().
+		InstanceID(rm.InstanceID).
+		AddQuery().
+		AggregateTypes(project.AggregateType).
+		AggregateIDs(rm.AggregateID).
+		EventTypes(
+			project.ApplicationOfflineTokensSetType,
+			project.ApplicationRemovedType,
+		).
+		EventData(map[string]interface{}{"appId": rm.appID}).
+		Or().
+		AggregateTypes(project.AggregateType).
+		AggregateIDs(rm.AggregateID).
+		EventTypes(project.ProjectRemovedType).
+		Builder()
+}
@@ -15,115 +15,130 @@ import (
 )
 
 var (
-	expectedAppQuery = regexp.QuoteMeta(`SELECT projections.apps7.id,` +
-		` projections.apps7.name,` +
-		` projections.apps7.project_id,` +
-		` projections.apps7.creation_date,` +
-		` projections.apps7.change_date,` +
-		` projections.apps7.resource_owner,` +
-		` projections.apps7.state,` +
-		` projections.apps7.sequence,` +
+	expectedAppQuery = regexp.QuoteMeta(`SELECT projections.apps12.id,` +
+		` projections.apps12.name,` +
+		` projections.apps12.project_id,` +
+		` projections.apps12.creation_date,` +
+		` projections.apps12.change_date,` +
+		` projections.apps12.resource_owner,` +
+		` projections.apps12.state,` +
+		` projections.apps12.sequence,` +
+		` projections.apps12.claims_mapping,` +
 		// api config
-		` projections.apps7_api_configs.app_id,` +
-		` projections.apps7_api_configs.client_id,` +
-		` projections.apps7_api_configs.auth_method,` +
+		` projections.apps12_api_configs.app_id,` +
+		` projections.apps12_api_configs.client_id,` +
+		` projections.apps12_api_configs.auth_method,` +
 		// oidc config
-		` projections.apps7_oidc_configs.app_id,` +
-		` projections.apps7_oidc_configs.version,` +
-		` projections.apps7_oidc_configs.client_id,` +
-		` projections.apps7_oidc_configs.redirect_uris,` +
-		` projections.apps7_oidc_configs.response_types,` +
-		` projections.apps7_oidc_configs.grant_types,` +
-		` projections.apps7_oidc_configs.application_type,` +
-		` projections.apps7_oidc_configs.auth_method_type,` +
-		` projections.apps7_oidc_configs.post_logout_redirect_uris,` +
-		` projections.apps7_oidc_configs.is_dev_mode,` +
-		` projections.apps7_oidc_configs.access_token_type,` +
-		` projections.apps7_oidc_configs.access_token_role_assertion,` +
-		` projections.apps7_oidc_configs.id_token_role_assertion,` +
-		` projections.apps7_oidc_configs.id_token_userinfo_assertion,` +
-		` projections.apps7_oidc_configs.clock_skew,` +
-		` projections.apps7_oidc_configs.additional_origins,` +
-		` projections.apps7_oidc_configs.skip_native_app_success_page,` +
+		` projections.apps12_oidc_configs.app_id,` +
+		` projections.apps12_oidc_configs.version,` +
+		` projections.apps12_oidc_configs.client_id,` +
+		` projections.apps12_oidc_configs.redirect_uris,` +
+		` projections.apps12_oidc_configs.response_types,` +
+		` projections.apps12_oidc_configs.grant_types,` +
+		` projections.apps12_oidc_configs.application_type,` +
+		` projections.apps12_oidc_configs.auth_method_type,` +
+		` projections.apps12_oidc_configs.post_logout_redirect_uris,` +
+		` projections.apps12_oidc_configs.is_dev_mode,` +
+		` projections.apps12_oidc_configs.access_token_type,` +
+		` projections.apps12_oidc_configs.access_token_role_assertion,` +
+		` projections.apps12_oidc_configs.id_token_role_assertion,` +
+		` projections.apps12_oidc_configs.id_token_userinfo_assertion,` +
+		` projections.apps12_oidc_configs.clock_skew,` +
+		` projections.apps12_oidc_configs.additional_origins,` +
+		` projections.apps12_oidc_configs.skip_native_app_success_page,` +
+		` projections.apps12_oidc_configs.tls_client_auth_subject_dn,` +
+		` projections.apps12_oidc_configs.tls_client_auth_thumbprint,` +
+		` projections.apps12_oidc_configs.back_channel_logout_uri,` +
+		` projections.apps12_oidc_configs.front_channel_logout_uri,` +
+		` projections.apps12_oidc_configs.frame_ancestors,` +
 		//saml config
-		` projections.apps7_saml_configs.app_id,` +
-		` projections.apps7_saml_configs.entity_id,` +
-		` projections.apps7_saml_configs.metadata,` +
-		` projections.apps7_saml_configs.metadata_url` +
-		` FROM projections.apps7` +
-		` LEFT JOIN projections.apps7_api_configs ON projections.apps7.id = projections.apps7_api_configs.app_id AND projections.apps7.instance_id = projections.apps7_api_configs.instance_id` +
-		` LEFT JOIN projections.apps7_oidc_configs ON projections.apps7.id = projections.apps7_oidc_configs.app_id AND projections.apps7.instance_id = projections.apps7_oidc_configs.instance_id` +
-		` LEFT JOIN projections.apps7_saml_configs ON projections.apps7.id = projections.apps7_saml_configs.app_id AND projections.apps7.instance_id = projections.apps7_saml_configs.instance_id` +
+		` projections.apps12_saml_configs.app_id,` +
+		` projections.apps12_saml_configs.entity_id,` +
+		` projections.apps12_saml_configs.metadata,` +
+		` projections.apps12_saml_configs.metadata_url,` +
+		` projections.apps12_saml_configs.attributes` +
+		` FROM projections.apps12` +
+		` LEFT JOIN projections.apps12_api_configs ON projections.apps12.id = projections.apps12_api_configs.app_id AND projections.apps12.instance_id = projections.apps12_api_configs.instance_id` +
+		` LEFT JOIN projections.apps12_oidc_configs ON projections.apps12.id = projections.apps12_oidc_configs.app_id AND projections.apps12.instance_id = projections.apps12_oidc_configs.instance_id` +
+		` LEFT JOIN projections.apps12_saml_configs ON projections.apps12.id = projections.apps12_saml_configs.app_id AND projections.apps12.instance_id = projections.apps12_saml_configs.instance_id` +
 		` AS OF SYSTEM TIME '-1 ms'`)
-	expectedAppsQuery = regexp.QuoteMeta(`SELECT projections.apps7.id,` +
-		` projections.apps7.name,` +
-		` projections.apps7.project_id,` +
-		` projections.apps7.creation_date,` +
-		` projections.apps7.change_date,` +
-		` projections.apps7.resource_owner,` +
-		` projections.apps7.state,` +
-		` projections.apps7.sequence,` +
+	expectedAppsQuery = regexp.QuoteMeta(`SELECT projections.apps12.id,` +
+		` projections.apps12.name,` +
+		` projections.apps12.project_id,` +
+		` projections.apps12.creation_date,` +
+		` projections.apps12.change_date,` +
+		` projections.apps12.resource_owner,` +
+		` projections.apps12.state,` +
+		` projections.apps12.sequence,` +
+		` projections.apps12.claims_mapping,` +
 		// api config
-		` projections.apps7_api_configs.app_id,` +
-		` projections.apps7_api_configs.client_id,` +
-		` projections.apps7_api_configs.auth_method,` +
+		` projections.apps12_api_configs.app_id,` +
+		` projections.apps12_api_configs.client_id,` +
+		` projections.apps12_api_configs.auth_method,` +
 		// oidc config
-		` projections.apps7_oidc_configs.app_id,` +
-		` projections.apps7_oidc_configs.version,` +
-		` projections.apps7_oidc_configs.client_id,` +
-		` projections.apps7_oidc_configs.redirect_uris,` +
-		` projections.apps7_oidc_configs.response_types,` +
-		` projections.apps7_oidc_configs.grant_types,` +
-		` projections.apps7_oidc_configs.application_type,` +
-		` projections.apps7_oidc_configs.auth_method_type,` +
-		` projections.apps7_oidc_configs.post_logout_redirect_uris,` +
-		` projections.apps7_oidc_configs.is_dev_mode,` +
-		` projections.apps7_oidc_configs.access_token_type,` +
-		` projections.apps7_oidc_configs.access_token_role_assertion,` +
-		` projections.apps7_oidc_configs.id_token_role_assertion,` +
-		` projections.apps7_oidc_configs.id_token_userinfo_assertion,` +
-		` projections.apps7_oidc_configs.clock_skew,` +
-		` projections.apps7_oidc_configs.additional_origins,` +
-		` projections.apps7_oidc_configs.skip_native_app_success_page,` +
+		` projections.apps12_oidc_configs.app_id,` +
+		` projections.apps12_oidc_configs.version,` +
+		` projections.apps12_oidc_configs.client_id,` +
+		` projections.apps12_oidc_configs.redirect_uris,` +
+		` projections.apps12_oidc_configs.response_types,` +
+		` projections.apps12_oidc_configs.grant_types,` +
+		` projections.apps12_oidc_configs.application_type,` +
+		` projections.apps12_oidc_configs.auth_method_type,` +
+		` projections.apps12_oidc_configs.post_logout_redirect_uris,` +
+		` projections.apps12_oidc_configs.is_dev_mode,` +
+		` projections.apps12_oidc_configs.access_token_type,` +
+		` projections.apps12_oidc_configs.access_token_role_assertion,` +
+		` projections.apps12_oidc_configs.id_token_role_assertion,` +
+		` projections.apps12_oidc_configs.id_token_userinfo_assertion,` +
+		` projections.apps12_oidc_configs.clock_skew,` +
+		` projections.apps12_oidc_configs.additional_origins,` +
+		` projections.apps12_oidc_configs.skip_native_app_success_page,` +
+		` projections.apps12_oidc_configs.tls_client_auth_subject_dn,` +
+		` projections.apps12_oidc_configs.tls_client_auth_thumbprint,` +
+		` projections.apps12_oidc_configs.back_channel_logout_uri,` +
+		` projections.apps12_oidc_configs.front_channel_logout_uri,` +
+		` projections.apps12_oidc_configs.frame_ancestors,` +
 		//saml config
-		` projections.apps7_saml_configs.app_id,` +
-		` projections.apps7_saml_configs.entity_id,` +
-		` projections.apps7_saml_configs.metadata,` +
-		` projections.apps7_saml_configs.metadata_url,` +
+		` projections.apps12_saml_configs.app_id,` +
+		` projections.apps12_saml_configs.entity_id,` +
+		` projections.apps12_saml_configs.metadata,` +
+		` projections.apps12_saml_configs.metadata_url,` +
+		` projections.apps12_saml_configs.attributes,` +
 		` COUNT(*) OVER ()` +
-		` FROM projections.apps7` +
-		` LEFT JOIN projections.apps7_api_configs ON projections.apps7.id = projections.apps7_api_configs.app_id AND projections.apps7.instance_id = projections.apps7_api_configs.instance_id` +
-		` LEFT JOIN projections.apps7_oidc_configs ON projections.apps7.id = projections.apps7_oidc_configs.app_id AND projections.apps7.instance_id = projections.apps7_oidc_configs.instance_id` +
-		` LEFT JOIN projections.apps7_saml_configs ON projections.apps7.id = projections.apps7_saml_configs.app_id AND projections.apps7.instance_id = projections.apps7_saml_configs.instance_id` +
+		` FROM projections.apps12` +
+		` LEFT JOIN projections.apps12_api_configs ON projections.apps12.id = projections.apps12_api_configs.app_id AND projections.apps12.instance_id = projections.apps12_api_configs.instance_id` +
+		` LEFT JOIN projections.apps12_oidc_configs ON projections.apps12.id = projections.apps12_oidc_configs.app_id AND projections.apps12.instance_id = projections.apps12_oidc_configs.instance_id` +
+		` LEFT JOIN projections.apps12_saml_configs ON projections.apps12.id = projections.apps12_saml_configs.app_id AND projections.apps12.instance_id = projections.apps12_saml_configs.instance_id` +
 		` AS OF SYSTEM TIME '-1 ms'`)
-	expectedAppIDsQuery = regexp.QuoteMeta(`SELECT projections.apps7_api_configs.client_id,` +
-		` projections.apps7_oidc_configs.client_id` +
-		` FROM projections.apps7` +
-		` LEFT JOIN projections.apps7_api_configs ON projections.apps7.id = projections.apps7_api_configs.app_id AND projections.apps7.instance_id = projections.apps7_api_configs.instance_id` +
-		` LEFT JOIN projections.apps7_oidc_configs ON projections.apps7.id = projections.apps7_oidc_configs.app_id AND projections.apps7.instance_id = projections.apps7_oidc_configs.instance_id` +
+	expectedAppIDsQuery = regexp.QuoteMeta(`SELECT projections.apps12_api_configs.client_id,` +
+		` projections.apps12_oidc_configs.client_id` +
+		` FROM projections.apps12` +
+		` LEFT JOIN projections.apps12_api_configs ON projections.apps12.id = projections.apps12_api_configs.app_id AND projections.apps12.instance_id = projections.apps12_api_configs.instance_id` +
+		` LEFT JOIN projections.apps12_oidc_configs ON projections.apps12.id = projections.apps12_oidc_configs.app_id AND projections.apps12.instance_id = projections.apps12_oidc_configs.instance_id` +
 		` AS OF SYSTEM TIME '-1 ms'`)
-	expectedProjectIDByAppQuery = regexp.QuoteMeta(`SELECT projections.apps7.project_id` +
-		` FROM projections.apps7` +
-		` LEFT JOIN projections.apps7_api_configs ON projections.apps7.id = projections.apps7_api_configs.app_id AND projections.apps7.instance_id = projections.apps7_api_configs.instance_id` +
-		` LEFT JOIN projections.apps7_oidc_configs ON projections.apps7.id = projections.apps7_oidc_configs.app_id AND projections.apps7.instance_id = projections.apps7_oidc_configs.instance_id` +
-		` LEFT JOIN projections.apps7_saml_configs ON projections.apps7.id = projections.apps7_saml_configs.app_id AND projections.apps7.instance_id = projections.apps7_saml_configs.instance_id` +
+	expectedProjectIDByAppQuery = regexp.QuoteMeta(`SELECT projections.apps12.project_id` +
+		` FROM projections.apps12` +
+		` LEFT JOIN projections.apps12_api_configs ON projections.apps12.id = projections.apps12_api_configs.app_id AND projections.apps12.instance_id = projections.apps12_api_configs.instance_id` +
+		` LEFT JOIN projections.apps12_oidc_configs ON projections.apps12.id = projections.apps12_oidc_configs.app_id AND projections.apps12.instance_id = projections.apps12_oidc_configs.instance_id` +
+		` LEFT JOIN projections.apps12_saml_configs ON projections.apps12.id = projections.apps12_saml_configs.app_id AND projections.apps12.instance_id = projections.apps12_saml_configs.instance_id` +
 		` AS OF SYSTEM TIME '-1 ms'`)
-	expectedProjectByAppQuery = regexp.QuoteMeta(`SELECT projections.projects4.id,` +
-		` projections.projects4.creation_date,` +
-		` projections.projects4.change_date,` +
-		` projections.projects4.resource_owner,` +
-		` projections.projects4.state,` +
-		` projections.projects4.sequence,` +
-		` projections.projects4.name,` +
-		` projections.projects4.project_role_assertion,` +
-		` projections.projects4.project_role_check,` +
-		` projections.projects4.has_project_check,` +
-		` projections.projects4.private_labeling_setting` +
-		` FROM projections.projects4` +
-		` JOIN projections.apps7 ON projections.projects4.id = projections.apps7.project_id AND projections.projects4.instance_id = projections.apps7.instance_id` +
-		` LEFT JOIN projections.apps7_api_configs ON projections.apps7.id = projections.apps7_api_configs.app_id AND projections.apps7.instance_id = projections.apps7_api_configs.instance_id` +
-		` LEFT JOIN projections.apps7_oidc_configs ON projections.apps7.id = projections.apps7_oidc_configs.app_id AND projections.apps7.instance_id = projections.apps7_oidc_configs.instance_id` +
-		` LEFT JOIN projections.apps7_saml_configs ON projections.apps7.id = projections.apps7_saml_configs.app_id AND projections.apps7.instance_id = projections.apps7_saml_configs.instance_id` +
+	expectedProjectByAppQuery = regexp.QuoteMeta(`SELECT projections.projects5.id,` +
+		` projections.projects5.creation_date,` +
+		` projections.projects5.change_date,` +
+		` projections.projects5.resource_owner,` +
+		` projections.projects5.state,` +
+		` projections.projects5.sequence,` +
+		` projections.projects5.name,` +
+		` projections.projects5.project_role_assertion,` +
+		` projections.projects5.project_role_check,` +
+		` projections.projects5.has_project_check,` +
+		` projections.projects5.private_labeling_setting,` +
+		` projections.projects5.access_token_type` +
+		` FROM projections.projects5` +
+		` JOIN projections.apps12 ON projections.projects5.id = projections.apps12.project_id AND projections.projects5.instance_id = projections.apps12.instance_id` +
+		` LEFT JOIN projections.apps12_api_configs ON projections.apps12.id = projections.apps12_api_configs.app_id AND projections.apps12.instance_id = projections.apps12_api_configs.instance_id` +
+		` LEFT JOIN projections.apps12_oidc_configs ON projections.apps12.id = projections.apps12_oidc_configs.app_id AND projections.apps12.instance_id = projections.apps12_oidc_configs.instance_id` +
+		` LEFT JOIN projections.apps12_saml_configs ON projections.apps12.id = projections.apps12_saml_configs.app_id AND projections.apps12.instance_id = projections.apps12_saml_configs.instance_id` +
 		` AS OF SYSTEM TIME '-1 ms'`)
 
 	appCols = database.TextArray[string]{
@@ -135,6 +150,7 @@ var (
 		"resource_owner",
 		"state",
 		"sequence",
+		"claims_mapping",
 		// api config
 		"app_id",
 		"client_id",
@@ -157,11 +173,17 @@ var (
 		"clock_skew",
 		"additional_origins",
 		"skip_native_app_success_page",
+		"tls_client_auth_subject_dn",
+		"tls_client_auth_thumbprint",
+		"back_channel_logout_uri",
+		"front_channel_logout_uri",
+		"frame_ancestors",
 		//saml config
 		"app_id",
 		"entity_id",
 		"metadata",
 		"metadata_url",
+		"attributes",
 	}
 	appsCols = append(appCols, "count")
 )
@@ -206,6 +228,7 @@ func Test_AppsPrepare(t *testing.T) {
 							"ro",
 							domain.AppStateActive,
 							uint64(20211109),
+							nil,
 							// api config
 							nil,
 							nil,
@@ -228,11 +251,17 @@ func Test_AppsPrepare(t *testing.T) {
 							nil,
 							nil,
 							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
 							// saml config
 							nil,
 							nil,
 							nil,
 							nil,
+							nil,
 						},
 					},
 				),
@@ -272,6 +301,7 @@ func Test_AppsPrepare(t *testing.T) {
 							"ro",
 							domain.AppStateActive,
 							uint64(20211109),
+							nil,
 							// api config
 							"app-id",
 							"api-client-id",
@@ -294,11 +324,17 @@ func Test_AppsPrepare(t *testing.T) {
 							nil,
 							nil,
 							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
 							// saml config
 							nil,
 							nil,
 							nil,
 							nil,
+							nil,
 						},
 					},
 				),
@@ -341,6 +377,7 @@ func Test_AppsPrepare(t *testing.T) {
 							"ro",
 							domain.AppStateActive,
 							uint64(20211109),
+							nil,
 							// api config
 							nil,
 							nil,
@@ -363,11 +400,17 @@ func Test_AppsPrepare(t *testing.T) {
 							nil,
 							nil,
 							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
 							// saml config
 							"app-id",
 							"https://test.com/saml/metadata",
 							[]byte("<?xml version=\"1.0\"?>\n<md:EntityDescriptor xmlns:md=\"urn:oasis:names:tc:SAML:2.0:metadata\"\n                     validUntil=\"2022-08-26T14:08:16Z\"\n                     cacheDuration=\"PT604800S\"\n                     entityID=\"https://test.com/saml/metadata\">\n    <md:SPSSODescriptor AuthnRequestsSigned=\"false\" WantAssertionsSigned=\"false\" protocolSupportEnumeration=\"urn:oasis:names:tc:SAML:2.0:protocol\">\n        <md:NameIDFormat>urn:oasis:names:tc:SAML:1.1:nameid-format:unspecified</md:NameIDFormat>\n        <md:AssertionConsumerService Binding=\"urn:oasis:names:tc:SAML:2.0:bindings:HTTP-POST\"\n                                     Location=\"https://test.com/saml/acs\"\n                                     index=\"1\" />\n        \n    </md:SPSSODescriptor>\n</md:EntityDescriptor>"),
 							"https://test.com/saml/metadata",
+							nil,
 						},
 					},
 				),
@@ -412,6 +455,7 @@ func Test_AppsPrepare(t *testing.T) {
 							"ro",
 							domain.AppStateActive,
 							uint64(20211109),
+							nil,
 							// api config
 							nil,
 							nil,
@@ -434,11 +478,17 @@ func Test_AppsPrepare(t *testing.T) {
 							1 * time.Second,
 							database.TextArray[string]{"additional.origin"},
 							false,
+							"",
+							"",
+							nil,
+							nil,
+							database.TextArray[string]{"https://frame.ancestor"},
 							// saml config
 							nil,
 							nil,
 							nil,
 							nil,
+							nil,
 						},
 					},
 				),
@@ -475,6 +525,7 @@ func Test_AppsPrepare(t *testing.T) {
 							AdditionalOrigins:        database.TextArray[string]{"additional.origin"},
 							ComplianceProblems:       nil,
 							AllowedOrigins:           database.TextArray[string]{"https://redirect.to", "additional.origin"},
+							FrameAncestors:           database.TextArray[string]{"https://frame.ancestor"},
 							SkipNativeAppSuccessPage: false,
 						},
 					},
@@ -498,6 +549,7 @@ func Test_AppsPrepare(t *testing.T) {
 							"ro",
 							domain.AppStateActive,
 							uint64(20211109),
+							nil,
 							// api config
 							nil,
 							nil,
@@ -520,11 +572,17 @@ func Test_AppsPrepare(t *testing.T) {
 							1 * time.Second,
 							database.TextArray[string]{"additional.origin"},
 							false,
+							"",
+							"",
+							nil,
+							nil,
+							database.TextArray[string]{"https://frame.ancestor"},
 							// saml config
 							nil,
 							nil,
 							nil,
 							nil,
+							nil,
 						},
 					},
 				),
@@ -561,6 +619,7 @@ func Test_AppsPrepare(t *testing.T) {
 							AdditionalOrigins:        database.TextArray[string]{"additional.origin"},
 							ComplianceProblems:       nil,
 							AllowedOrigins:           database.TextArray[string]{"https://redirect.to", "additional.origin"},
+							FrameAncestors:           database.TextArray[string]{"https://frame.ancestor"},
 							SkipNativeAppSuccessPage: false,
 						},
 					},
@@ -584,6 +643,7 @@ func Test_AppsPrepare(t *testing.T) {
 							"ro",
 							domain.AppStateActive,
 							uint64(20211109),
+							nil,
 							// api config
 							nil,
 							nil,
@@ -606,11 +666,17 @@ func Test_AppsPrepare(t *testing.T) {
 							1 * time.Second,
 							database.TextArray[string]{"additional.origin"},
 							false,
+							"",
+							"",
+							nil,
+							nil,
+							database.TextArray[string]{"https://frame.ancestor"},
 							// saml config
 							nil,
 							nil,
 							nil,
 							nil,
+							nil,
 						},
 					},
 				),
@@ -647,6 +713,7 @@ func Test_AppsPrepare(t *testing.T) {
 							AdditionalOrigins:        database.TextArray[string]{"additional.origin"},
 							ComplianceProblems:       nil,
 							AllowedOrigins:           database.TextArray[string]{"https://redirect.to", "additional.origin"},
+							FrameAncestors:           database.TextArray[string]{"https://frame.ancestor"},
 							SkipNativeAppSuccessPage: false,
 						},
 					},
@@ -670,6 +737,7 @@ func Test_AppsPrepare(t *testing.T) {
 							"ro",
 							domain.AppStateActive,
 							uint64(20211109),
+							nil,
 							// api config
 							nil,
 							nil,
@@ -692,11 +760,17 @@ func Test_AppsPrepare(t *testing.T) {
 							1 * time.Second,
 							database.TextArray[string]{"additional.origin"},
 							false,
+							"",
+							"",
+							nil,
+							nil,
+							database.TextArray[string]{"https://frame.ancestor"},
 							// saml config
 							nil,
 							nil,
 							nil,
 							nil,
+							nil,
 						},
 					},
 				),
@@ -733,6 +807,7 @@ func Test_AppsPrepare(t *testing.T) {
 							AdditionalOrigins:        database.TextArray[string]{"additional.origin"},
 							ComplianceProblems:       nil,
 							AllowedOrigins:           database.TextArray[string]{"https://redirect.to", "additional.origin"},
+							FrameAncestors:           database.TextArray[string]{"https://frame.ancestor"},
 							SkipNativeAppSuccessPage: false,
 						},
 					},
@@ -756,6 +831,7 @@ func Test_AppsPrepare(t *testing.T) {
 							"ro",
 							domain.AppStateActive,
 							uint64(20211109),
+							nil,
 							// api config
 							nil,
 							nil,
@@ -778,11 +854,17 @@ func Test_AppsPrepare(t *testing.T) {
 							1 * time.Second,
 							database.TextArray[string]{"additional.origin"},
 							false,
+							"",
+							"",
+							nil,
+							nil,
+							database.TextArray[string]{"https://frame.ancestor"},
 							// saml config
 							nil,
 							nil,
 							nil,
 							nil,
+							nil,
 						},
 					},
 				),
@@ -819,6 +901,7 @@ func Test_AppsPrepare(t *testing.T) {
 							AdditionalOrigins:        database.TextArray[string]{"additional.origin"},
 							ComplianceProblems:       nil,
 							AllowedOrigins:           database.TextArray[string]{"https://redirect.to", "additional.origin"},
+							FrameAncestors:           database.TextArray[string]{"https://frame.ancestor"},
 							SkipNativeAppSuccessPage: false,
 						},
 					},
@@ -842,6 +925,7 @@ func Test_AppsPrepare(t *testing.T) {
 							"ro",
 							domain.AppStateActive,
 							uint64(20211109),
+							nil,
 							// api config
 							nil,
 							nil,
@@ -864,11 +948,17 @@ func Test_AppsPrepare(t *testing.T) {
 							1 * time.Second,
 							database.TextArray[string]{"additional.origin"},
 							true,
+							"",
+							"",
+							nil,
+							nil,
+							database.TextArray[string]{"https://frame.ancestor"},
 							// saml config
 							nil,
 							nil,
 							nil,
 							nil,
+							nil,
 						},
 					},
 				),
@@ -905,6 +995,7 @@ func Test_AppsPrepare(t *testing.T) {
 							AdditionalOrigins:        database.TextArray[string]{"additional.origin"},
 							ComplianceProblems:       nil,
 							AllowedOrigins:           database.TextArray[string]{"https://redirect.to", "additional.origin"},
+							FrameAncestors:           database.TextArray[string]{"https://frame.ancestor"},
 							SkipNativeAppSuccessPage: true,
 						},
 					},
@@ -928,6 +1019,7 @@ func Test_AppsPrepare(t *testing.T) {
 							"ro",
 							domain.AppStateActive,
 							uint64(20211109),
+							nil,
 							// api config
 							nil,
 							nil,
@@ -950,11 +1042,17 @@ func Test_AppsPrepare(t *testing.T) {
 							1 * time.Second,
 							database.TextArray[string]{"additional.origin"},
 							false,
+							"",
+							"",
+							nil,
+							nil,
+							database.TextArray[string]{"https://frame.ancestor"},
 							// saml config
 							nil,
 							nil,
 							nil,
 							nil,
+							nil,
 						},
 						{
 							"api-app-id",
@@ -965,6 +1063,7 @@ func Test_AppsPrepare(t *testing.T) {
 							"ro",
 							domain.AppStateActive,
 							uint64(20211109),
+							nil,
 							// api config
 							"api-app-id",
 							"api-client-id",
@@ -987,11 +1086,17 @@ func Test_AppsPrepare(t *testing.T) {
 							nil,
 							nil,
 							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
 							// saml config
 							nil,
 							nil,
 							nil,
 							nil,
+							nil,
 						},
 						{
 							"saml-app-id",
@@ -1002,6 +1107,7 @@ func Test_AppsPrepare(t *testing.T) {
 							"ro",
 							domain.AppStateActive,
 							uint64(20211109),
+							nil,
 							// api config
 							nil,
 							nil,
@@ -1024,11 +1130,17 @@ func Test_AppsPrepare(t *testing.T) {
 							nil,
 							nil,
 							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
 							// saml config
 							"saml-app-id",
 							"https://test.com/saml/metadata",
 							[]byte("<?xml version=\"1.0\"?>\n<md:EntityDescriptor xmlns:md=\"urn:oasis:names:tc:SAML:2.0:metadata\"\n                     validUntil=\"2022-08-26T14:08:16Z\"\n                     cacheDuration=\"PT604800S\"\n                     entityID=\"https://test.com/saml/metadata\">\n    <md:SPSSODescriptor AuthnRequestsSigned=\"false\" WantAssertionsSigned=\"false\" protocolSupportEnumeration=\"urn:oasis:names:tc:SAML:2.0:protocol\">\n        <md:NameIDFormat>urn:oasis:names:tc:SAML:1.1:nameid-format:unspecified</md:NameIDFormat>\n        <md:AssertionConsumerService Binding=\"urn:oasis:names:tc:SAML:2.0:bindings:HTTP-POST\"\n                                     Location=\"https://test.com/saml/acs\"\n                                     index=\"1\" />\n        \n    </md:SPSSODescriptor>\n</md:EntityDescriptor>"),
 							"https://test.com/saml/metadata",
+							nil,
 						},
 					},
 				),
@@ -1065,6 +1177,7 @@ func Test_AppsPrepare(t *testing.T) {
 							AdditionalOrigins:        database.TextArray[string]{"additional.origin"},
 							ComplianceProblems:       nil,
 							AllowedOrigins:           database.TextArray[string]{"https://redirect.to", "additional.origin"},
+							FrameAncestors:           database.TextArray[string]{"https://frame.ancestor"},
 							SkipNativeAppSuccessPage: false,
 						},
 					},
@@ -1173,6 +1286,71 @@ func Test_AppPrepare(t *testing.T) {
 						"ro",
 						domain.AppStateActive,
 						uint64(20211109),
+						nil,
+						// api config
+						nil,
+						nil,
+						nil,
+						// oidc config
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						// saml config
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+					},
+				),
+			},
+			object: &App{
+				ID:            "app-id",
+				CreationDate:  testNow,
+				ChangeDate:    testNow,
+				ResourceOwner: "ro",
+				State:         domain.AppStateActive,
+				Sequence:      20211109,
+				Name:          "app-name",
+				ProjectID:     "project-id",
+			},
+		},
+		{
+			name:    "prepareAppQuery found with claims mapping",
+			prepare: prepareAppQuery,
+			want: want{
+				sqlExpectations: mockQuery(
+					expectedAppQuery,
+					appCols,
+					[]driver.Value{
+						"app-id",
+						"app-name",
+						"project-id",
+						testNow,
+						testNow,
+						"ro",
+						domain.AppStateActive,
+						uint64(20211109),
+						[]byte(`{"includeRoles":true,"metadataKeys":["department"]}`),
 						// api config
 						nil,
 						nil,
@@ -1195,11 +1373,17 @@ func Test_AppPrepare(t *testing.T) {
 						nil,
 						nil,
 						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
 						// saml config
 						nil,
 						nil,
 						nil,
 						nil,
+						nil,
 					},
 				),
 			},
@@ -1212,6 +1396,10 @@ func Test_AppPrepare(t *testing.T) {
 				Sequence:      20211109,
 				Name:          "app-name",
 				ProjectID:     "project-id",
+				ClaimsMapping: &domain.ClaimsMapping{
+					IncludeRoles: true,
+					MetadataKeys: []string{"department"},
+				},
 			},
 		},
 		{
@@ -1231,6 +1419,7 @@ func Test_AppPrepare(t *testing.T) {
 							"ro",
 							domain.AppStateActive,
 							uint64(20211109),
+							nil,
 							// api config
 							"app-id",
 							"api-client-id",
@@ -1253,11 +1442,17 @@ func Test_AppPrepare(t *testing.T) {
 							nil,
 							nil,
 							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
 							// saml config
 							nil,
 							nil,
 							nil,
 							nil,
+							nil,
 						},
 					},
 				),
@@ -1294,6 +1489,7 @@ func Test_AppPrepare(t *testing.T) {
 							"ro",
 							domain.AppStateActive,
 							uint64(20211109),
+							nil,
 							// api config
 							nil,
 							nil,
@@ -1316,11 +1512,17 @@ func Test_AppPrepare(t *testing.T) {
 							1 * time.Second,
 							database.TextArray[string]{"additional.origin"},
 							false,
+							"",
+							"",
+							nil,
+							nil,
+							database.TextArray[string]{"https://frame.ancestor"},
 							// saml config
 							nil,
 							nil,
 							nil,
 							nil,
+							nil,
 						},
 					},
 				),
@@ -1352,6 +1554,7 @@ func Test_AppPrepare(t *testing.T) {
 					AdditionalOrigins:        database.TextArray[string]{"additional.origin"},
 					ComplianceProblems:       nil,
 					AllowedOrigins:           database.TextArray[string]{"https://redirect.to", "additional.origin"},
+					FrameAncestors:           database.TextArray[string]{"https://frame.ancestor"},
 					SkipNativeAppSuccessPage: false,
 				},
 			},
@@ -1372,6 +1575,7 @@ func Test_AppPrepare(t *testing.T) {
 							"ro",
 							domain.AppStateActive,
 							uint64(20211109),
+							nil,
 							// api config
 							nil,
 							nil,
@@ -1394,11 +1598,17 @@ func Test_AppPrepare(t *testing.T) {
 							nil,
 							nil,
 							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
 							// saml config
 							"app-id",
 							"https://test.com/saml/metadata",
 							[]byte("<?xml version=\"1.0\"?>\n<md:EntityDescriptor xmlns:md=\"urn:oasis:names:tc:SAML:2.0:metadata\"\n                     validUntil=\"2022-08-26T14:08:16Z\"\n                     cacheDuration=\"PT604800S\"\n                     entityID=\"https://test.com/saml/metadata\">\n    <md:SPSSODescriptor AuthnRequestsSigned=\"false\" WantAssertionsSigned=\"false\" protocolSupportEnumeration=\"urn:oasis:names:tc:SAML:2.0:protocol\">\n        <md:NameIDFormat>urn:oasis:names:tc:SAML:1.1:nameid-format:unspecified</md:NameIDFormat>\n        <md:AssertionConsumerService Binding=\"urn:oasis:names:tc:SAML:2.0:bindings:HTTP-POST\"\n                                     Location=\"https://test.com/saml/acs\"\n                                     index=\"1\" />\n        \n    </md:SPSSODescriptor>\n</md:EntityDescriptor>"),
 							"https://test.com/saml/metadata",
+							[]byte(`[{"name":"department","value":8,"metadataKey":"department"}]`),
 						},
 					},
 				),
@@ -1416,6 +1626,11 @@ func Test_AppPrepare(t *testing.T) {
 					Metadata:    []byte("<?xml version=\"1.0\"?>\n<md:EntityDescriptor xmlns:md=\"urn:oasis:names:tc:SAML:2.0:metadata\"\n                     validUntil=\"2022-08-26T14:08:16Z\"\n                     cacheDuration=\"PT604800S\"\n                     entityID=\"https://test.com/saml/metadata\">\n    <md:SPSSODescriptor AuthnRequestsSigned=\"false\" WantAssertionsSigned=\"false\" protocolSupportEnumeration=\"urn:oasis:names:tc:SAML:2.0:protocol\">\n        <md:NameIDFormat>urn:oasis:names:tc:SAML:1.1:nameid-format:unspecified</md:NameIDFormat>\n        <md:AssertionConsumerService Binding=\"urn:oasis:names:tc:SAML:2.0:bindings:HTTP-POST\"\n                                     Location=\"https://test.com/saml/acs\"\n                                     index=\"1\" />\n        \n    </md:SPSSODescriptor>\n</md:EntityDescriptor>"),
 					MetadataURL: "https://test.com/saml/metadata",
 					EntityID:    "https://test.com/saml/metadata",
+					Attributes: []*domain.SAMLAttribute{{
+						Name:        "department",
+						Value:       domain.SAMLAttributeValueMetadata,
+						MetadataKey: "department",
+					}},
 				},
 			},
 		},
@@ -1436,6 +1651,7 @@ func Test_AppPrepare(t *testing.T) {
 							"ro",
 							domain.AppStateActive,
 							uint64(20211109),
+							nil,
 							// api config
 							nil,
 							nil,
@@ -1458,11 +1674,17 @@ func Test_AppPrepare(t *testing.T) {
 							1 * time.Second,
 							database.TextArray[string]{"additional.origin"},
 							false,
+							"",
+							"",
+							nil,
+							nil,
+							database.TextArray[string]{"https://frame.ancestor"},
 							// saml config
 							nil,
 							nil,
 							nil,
 							nil,
+							nil,
 						},
 					},
 				),
@@ -1494,6 +1716,7 @@ func Test_AppPrepare(t *testing.T) {
 					AdditionalOrigins:        database.TextArray[string]{"additional.origin"},
 					ComplianceProblems:       nil,
 					AllowedOrigins:           database.TextArray[string]{"https://redirect.to", "additional.origin"},
+					FrameAncestors:           database.TextArray[string]{"https://frame.ancestor"},
 					SkipNativeAppSuccessPage: false,
 				},
 			},
@@ -1515,6 +1738,7 @@ func Test_AppPrepare(t *testing.T) {
 							"ro",
 							domain.AppStateActive,
 							uint64(20211109),
+							nil,
 							// api config
 							nil,
 							nil,
@@ -1537,11 +1761,17 @@ func Test_AppPrepare(t *testing.T) {
 							1 * time.Second,
 							database.TextArray[string]{"additional.origin"},
 							false,
+							"",
+							"",
+							nil,
+							nil,
+							database.TextArray[string]{"https://frame.ancestor"},
 							// saml config
 							nil,
 							nil,
 							nil,
 							nil,
+							nil,
 						},
 					},
 				),
@@ -1573,6 +1803,7 @@ func Test_AppPrepare(t *testing.T) {
 					AdditionalOrigins:        database.TextArray[string]{"additional.origin"},
 					ComplianceProblems:       nil,
 					AllowedOrigins:           database.TextArray[string]{"https://redirect.to", "additional.origin"},
+					FrameAncestors:           database.TextArray[string]{"https://frame.ancestor"},
 					SkipNativeAppSuccessPage: false,
 				},
 			},
@@ -1594,6 +1825,7 @@ func Test_AppPrepare(t *testing.T) {
 							"ro",
 							domain.AppStateActive,
 							uint64(20211109),
+							nil,
 							// api config
 							nil,
 							nil,
@@ -1616,11 +1848,17 @@ func Test_AppPrepare(t *testing.T) {
 							1 * time.Second,
 							database.TextArray[string]{"additional.origin"},
 							false,
+							"",
+							"",
+							nil,
+							nil,
+							database.TextArray[string]{"https://frame.ancestor"},
 							// saml config
 							nil,
 							nil,
 							nil,
 							nil,
+							nil,
 						},
 					},
 				),
@@ -1652,6 +1890,7 @@ func Test_AppPrepare(t *testing.T) {
 					AdditionalOrigins:        database.TextArray[string]{"additional.origin"},
 					ComplianceProblems:       nil,
 					AllowedOrigins:           database.TextArray[string]{"https://redirect.to", "additional.origin"},
+					FrameAncestors:           database.TextArray[string]{"https://frame.ancestor"},
 					SkipNativeAppSuccessPage: false,
 				},
 			},
@@ -1673,6 +1912,7 @@ func Test_AppPrepare(t *testing.T) {
 							"ro",
 							domain.AppStateActive,
 							uint64(20211109),
+							nil,
 							// api config
 							nil,
 							nil,
@@ -1695,11 +1935,17 @@ func Test_AppPrepare(t *testing.T) {
 							1 * time.Second,
 							database.TextArray[string]{"additional.origin"},
 							false,
+							"",
+							"",
+							nil,
+							nil,
+							database.TextArray[string]{"https://frame.ancestor"},
 							// saml config
 							nil,
 							nil,
 							nil,
 							nil,
+							nil,
 						},
 					},
 				),
@@ -1731,6 +1977,7 @@ func Test_AppPrepare(t *testing.T) {
 					AdditionalOrigins:        database.TextArray[string]{"additional.origin"},
 					ComplianceProblems:       nil,
 					AllowedOrigins:           database.TextArray[string]{"https://redirect.to", "additional.origin"},
+					FrameAncestors:           database.TextArray[string]{"https://frame.ancestor"},
 					SkipNativeAppSuccessPage: false,
 				},
 			},
@@ -1960,6 +2207,7 @@ func Test_ProjectByAppPrepare(t *testing.T) {
 						true,
 						true,
 						domain.PrivateLabelingSettingAllowLoginUserResourceOwnerPolicy,
+						domain.OIDCTokenTypeBearer,
 					},
 				),
 			},
@@ -1996,6 +2244,7 @@ func Test_ProjectByAppPrepare(t *testing.T) {
 						true,
 						true,
 						domain.PrivateLabelingSettingAllowLoginUserResourceOwnerPolicy,
+						domain.OIDCTokenTypeBearer,
 					},
 				),
 			},
@@ -2032,6 +2281,7 @@ func Test_ProjectByAppPrepare(t *testing.T) {
 						false,
 						true,
 						domain.PrivateLabelingSettingAllowLoginUserResourceOwnerPolicy,
+						domain.OIDCTokenTypeBearer,
 					},
 				),
 			},
@@ -2068,6 +2318,7 @@ func Test_ProjectByAppPrepare(t *testing.T) {
 						true,
 						false,
 						domain.PrivateLabelingSettingAllowLoginUserResourceOwnerPolicy,
+						domain.OIDCTokenTypeBearer,
 					},
 				),
 			},
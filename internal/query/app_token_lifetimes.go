@@ -0,0 +1,121 @@
+package query
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	sq "github.com/Masterminds/squirrel"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/api/call"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/query/projection"
+	"github.com/zitadel/zitadel/internal/telemetry/tracing"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+var (
+	appTokenLifetimesTable = table{
+		name:          projection.AppTokenLifetimesTable,
+		instanceIDCol: projection.AppTokenLifetimesColumnInstanceID,
+	}
+	AppTokenLifetimesColumnAppID = Column{
+		name:  projection.AppTokenLifetimesColumnAppID,
+		table: appTokenLifetimesTable,
+	}
+	AppTokenLifetimesColumnInstanceID = Column{
+		name:  projection.AppTokenLifetimesColumnInstanceID,
+		table: appTokenLifetimesTable,
+	}
+	AppTokenLifetimesColumnProjectID = Column{
+		name:  projection.AppTokenLifetimesColumnProjectID,
+		table: appTokenLifetimesTable,
+	}
+	AppTokenLifetimesColumnClientID = Column{
+		name:  projection.AppTokenLifetimesColumnClientID,
+		table: appTokenLifetimesTable,
+	}
+	AppTokenLifetimesColumnAccessTokenLifetime = Column{
+		name:  projection.AppTokenLifetimesColumnAccessTokenLifetime,
+		table: appTokenLifetimesTable,
+	}
+	AppTokenLifetimesColumnIDTokenLifetime = Column{
+		name:  projection.AppTokenLifetimesColumnIDTokenLifetime,
+		table: appTokenLifetimesTable,
+	}
+	AppTokenLifetimesColumnRefreshTokenIdleExpiration = Column{
+		name:  projection.AppTokenLifetimesColumnRefreshTokenIdleExpiration,
+		table: appTokenLifetimesTable,
+	}
+	AppTokenLifetimesColumnRefreshTokenExpiration = Column{
+		name:  projection.AppTokenLifetimesColumnRefreshTokenExpiration,
+		table: appTokenLifetimesTable,
+	}
+)
+
+// AppTokenLifetimesByID returns the token lifetimes overridden by the application.
+// Applications using the lifetimes of the instance return nil.
+func (q *Queries) AppTokenLifetimesByID(ctx context.Context, projectID, appID string) (_ *domain.AppTokenLifetimes, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	return q.appTokenLifetimes(ctx, sq.Eq{
+		AppTokenLifetimesColumnInstanceID.identifier(): authz.GetInstance(ctx).InstanceID(),
+		AppTokenLifetimesColumnProjectID.identifier():  projectID,
+		AppTokenLifetimesColumnAppID.identifier():      appID,
+	})
+}
+
+// AppTokenLifetimesByClientID returns the token lifetimes overridden by the application of the OIDC client.
+// Applications using the lifetimes of the instance return nil.
+func (q *Queries) AppTokenLifetimesByClientID(ctx context.Context, clientID string) (_ *domain.AppTokenLifetimes, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	return q.appTokenLifetimes(ctx, sq.Eq{
+		AppTokenLifetimesColumnInstanceID.identifier(): authz.GetInstance(ctx).InstanceID(),
+		AppTokenLifetimesColumnClientID.identifier():   clientID,
+	})
+}
+
+func (q *Queries) appTokenLifetimes(ctx context.Context, eq sq.Eq) (lifetimes *domain.AppTokenLifetimes, err error) {
+	stmt, scan := prepareAppTokenLifetimesQuery(ctx, q.client)
+	query, args, err := stmt.Where(eq).ToSql()
+	if err != nil {
+		return nil, zerrors.ThrowInternal(err, "QUERY-Tl4sq", "Errors.Query.SQLStatement")
+	}
+
+	err = q.client.QueryRowContext(ctx, func(row *sql.Row) error {
+		lifetimes, err = scan(row)
+		return err
+	}, query, args...)
+	return lifetimes, err
+}
+
+func prepareAppTokenLifetimesQuery(ctx context.Context, db prepareDatabase) (sq.SelectBuilder, func(*sql.Row) (*domain.AppTokenLifetimes, error)) {
+	return sq.Select(
+			AppTokenLifetimesColumnAccessTokenLifetime.identifier(),
+			AppTokenLifetimesColumnIDTokenLifetime.identifier(),
+			AppTokenLifetimesColumnRefreshTokenIdleExpiration.identifier(),
+			AppTokenLifetimesColumnRefreshTokenExpiration.identifier(),
+		).
+			From(appTokenLifetimesTable.identifier() + db.Timetravel(call.Took(ctx))).
+			PlaceholderFormat(sq.Dollar),
+		func(row *sql.Row) (*domain.AppTokenLifetimes, error) {
+			lifetimes := new(domain.AppTokenLifetimes)
+			err := row.Scan(
+				&lifetimes.AccessTokenLifetime,
+				&lifetimes.IDTokenLifetime,
+				&lifetimes.RefreshTokenIdleExpiration,
+				&lifetimes.RefreshTokenExpiration,
+			)
+			if errors.Is(err, sql.ErrNoRows) {
+				return nil, nil
+			}
+			if err != nil {
+				return nil, zerrors.ThrowInternal(err, "QUERY-Tl5sc", "Errors.Internal")
+			}
+			return lifetimes, nil
+		}
+}
@@ -0,0 +1,97 @@
+package query
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/zitadel/zitadel/internal/domain"
+)
+
+var (
+	prepareAppTokenLifetimesStmt = `SELECT projections.app_token_lifetimes.access_token_lifetime,` +
+		` projections.app_token_lifetimes.id_token_lifetime,` +
+		` projections.app_token_lifetimes.refresh_token_idle_expiration,` +
+		` projections.app_token_lifetimes.refresh_token_expiration` +
+		` FROM projections.app_token_lifetimes` +
+		` AS OF SYSTEM TIME '-1 ms'`
+	prepareAppTokenLifetimesCols = []string{
+		"access_token_lifetime",
+		"id_token_lifetime",
+		"refresh_token_idle_expiration",
+		"refresh_token_expiration",
+	}
+)
+
+func Test_AppTokenLifetimesPrepares(t *testing.T) {
+	type want struct {
+		sqlExpectations sqlExpectation
+		err             checkErr
+	}
+	tests := []struct {
+		name    string
+		prepare interface{}
+		want    want
+		object  interface{}
+	}{
+		{
+			name:    "prepareAppTokenLifetimesQuery no result",
+			prepare: prepareAppTokenLifetimesQuery,
+			want: want{
+				sqlExpectations: mockQuery(
+					regexp.QuoteMeta(prepareAppTokenLifetimesStmt),
+					prepareAppTokenLifetimesCols,
+					nil,
+				),
+			},
+			object: (*domain.AppTokenLifetimes)(nil),
+		},
+		{
+			name:    "prepareAppTokenLifetimesQuery found",
+			prepare: prepareAppTokenLifetimesQuery,
+			want: want{
+				sqlExpectations: mockQuery(
+					regexp.QuoteMeta(prepareAppTokenLifetimesStmt),
+					prepareAppTokenLifetimesCols,
+					[]driver.Value{
+						int64(time.Minute),
+						int64(0),
+						int64(time.Hour),
+						int64(24 * time.Hour),
+					},
+				),
+			},
+			object: &domain.AppTokenLifetimes{
+				AccessTokenLifetime:        time.Minute,
+				RefreshTokenIdleExpiration: time.Hour,
+				RefreshTokenExpiration:     24 * time.Hour,
+			},
+		},
+		{
+			name:    "prepareAppTokenLifetimesQuery sql err",
+			prepare: prepareAppTokenLifetimesQuery,
+			want: want{
+				sqlExpectations: mockQueryErr(
+					regexp.QuoteMeta(prepareAppTokenLifetimesStmt),
+					sql.ErrConnDone,
+				),
+				err: func(err error) (error, bool) {
+					if !errors.Is(err, sql.ErrConnDone) {
+						return fmt.Errorf("err should be sql.ErrConnDone got: %w", err), false
+					}
+					return nil, true
+				},
+			},
+			object: (*domain.AppTokenLifetimes)(nil),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assertPrepare(t, tt.prepare, tt.object, tt.want.sqlExpectations, tt.want.err, defaultPrepareArgs...)
+		})
+	}
+}
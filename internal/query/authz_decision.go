@@ -0,0 +1,194 @@
+package query
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/logstore/record"
+	"github.com/zitadel/zitadel/internal/telemetry/tracing"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+var (
+	authZDecisionTable = table{
+		name:          record.AuthZDecisionTable,
+		instanceIDCol: record.AuthZDecisionColumnInstanceID,
+	}
+	AuthZDecisionColumnInstanceID = Column{
+		name:  record.AuthZDecisionColumnInstanceID,
+		table: authZDecisionTable,
+	}
+	AuthZDecisionColumnID = Column{
+		name:  record.AuthZDecisionColumnID,
+		table: authZDecisionTable,
+	}
+	AuthZDecisionColumnCheckedAt = Column{
+		name:  record.AuthZDecisionColumnCheckedAt,
+		table: authZDecisionTable,
+	}
+	AuthZDecisionColumnCallerID = Column{
+		name:  record.AuthZDecisionColumnCallerID,
+		table: authZDecisionTable,
+	}
+	AuthZDecisionColumnCallerOrgID = Column{
+		name:  record.AuthZDecisionColumnCallerOrgID,
+		table: authZDecisionTable,
+	}
+	AuthZDecisionColumnOrgID = Column{
+		name:  record.AuthZDecisionColumnOrgID,
+		table: authZDecisionTable,
+	}
+	AuthZDecisionColumnMethod = Column{
+		name:  record.AuthZDecisionColumnMethod,
+		table: authZDecisionTable,
+	}
+	AuthZDecisionColumnPermission = Column{
+		name:  record.AuthZDecisionColumnPermission,
+		table: authZDecisionTable,
+	}
+	AuthZDecisionColumnResourceID = Column{
+		name:  record.AuthZDecisionColumnResourceID,
+		table: authZDecisionTable,
+	}
+	AuthZDecisionColumnAllowed = Column{
+		name:  record.AuthZDecisionColumnAllowed,
+		table: authZDecisionTable,
+	}
+	AuthZDecisionColumnMatchedPermission = Column{
+		name:  record.AuthZDecisionColumnMatchedPermission,
+		table: authZDecisionTable,
+	}
+	AuthZDecisionColumnErrorID = Column{
+		name:  record.AuthZDecisionColumnErrorID,
+		table: authZDecisionTable,
+	}
+)
+
+type AuthZDecisions struct {
+	SearchResponse
+	Decisions []*AuthZDecision
+}
+
+type AuthZDecision struct {
+	ID                string
+	CheckedAt         time.Time
+	CallerID          string
+	CallerOrgID       string
+	OrgID             string
+	Method            string
+	Permission        string
+	ResourceID        string
+	Allowed           bool
+	MatchedPermission string
+	ErrorID           string
+}
+
+type AuthZDecisionSearchQueries struct {
+	SearchRequest
+	Queries []SearchQuery
+}
+
+func (q *AuthZDecisionSearchQueries) toQuery(query sq.SelectBuilder) sq.SelectBuilder {
+	query = q.SearchRequest.toQuery(query)
+	if q.SortingColumn.isZero() {
+		query = query.OrderBy(AuthZDecisionColumnCheckedAt.identifier() + " DESC")
+	}
+	for _, q := range q.Queries {
+		query = q.toQuery(query)
+	}
+	return query
+}
+
+// SearchAuthZDecisions returns the recorded authorization decisions of the instance, the newest first by default
+func (q *Queries) SearchAuthZDecisions(ctx context.Context, queries *AuthZDecisionSearchQueries) (decisions *AuthZDecisions, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	eq := sq.Eq{
+		AuthZDecisionColumnInstanceID.identifier(): authz.GetInstance(ctx).InstanceID(),
+	}
+	query, scan := prepareAuthZDecisionsQuery(ctx, q.client)
+	return genericRowsQuery[*AuthZDecisions](ctx, q.client, combineToWhereStmt(query, queries.toQuery, eq), scan)
+}
+
+func NewAuthZDecisionCallerIDSearchQuery(callerID string) (SearchQuery, error) {
+	return NewTextQuery(AuthZDecisionColumnCallerID, callerID, TextEquals)
+}
+
+func NewAuthZDecisionOrgIDSearchQuery(orgID string) (SearchQuery, error) {
+	return NewTextQuery(AuthZDecisionColumnOrgID, orgID, TextEquals)
+}
+
+func NewAuthZDecisionPermissionSearchQuery(method TextComparison, permission string) (SearchQuery, error) {
+	return NewTextQuery(AuthZDecisionColumnPermission, permission, method)
+}
+
+func NewAuthZDecisionResourceIDSearchQuery(resourceID string) (SearchQuery, error) {
+	return NewTextQuery(AuthZDecisionColumnResourceID, resourceID, TextEquals)
+}
+
+func NewAuthZDecisionAllowedSearchQuery(allowed bool) (SearchQuery, error) {
+	return NewBoolQuery(AuthZDecisionColumnAllowed, allowed)
+}
+
+func NewAuthZDecisionCheckedAtSearchQuery(checkedAt time.Time, compare TimestampComparison) (SearchQuery, error) {
+	return NewTimestampQuery(AuthZDecisionColumnCheckedAt, checkedAt, compare)
+}
+
+func prepareAuthZDecisionsQuery(ctx context.Context, db prepareDatabase) (sq.SelectBuilder, func(rows *sql.Rows) (*AuthZDecisions, error)) {
+	return sq.Select(
+			AuthZDecisionColumnID.identifier(),
+			AuthZDecisionColumnCheckedAt.identifier(),
+			AuthZDecisionColumnCallerID.identifier(),
+			AuthZDecisionColumnCallerOrgID.identifier(),
+			AuthZDecisionColumnOrgID.identifier(),
+			AuthZDecisionColumnMethod.identifier(),
+			AuthZDecisionColumnPermission.identifier(),
+			AuthZDecisionColumnResourceID.identifier(),
+			AuthZDecisionColumnAllowed.identifier(),
+			AuthZDecisionColumnMatchedPermission.identifier(),
+			AuthZDecisionColumnErrorID.identifier(),
+			countColumn.identifier(),
+		).From(authZDecisionTable.identifier()).
+			PlaceholderFormat(sq.Dollar),
+		func(rows *sql.Rows) (*AuthZDecisions, error) {
+			decisions := make([]*AuthZDecision, 0)
+			var count uint64
+			for rows.Next() {
+				decision := new(AuthZDecision)
+				err := rows.Scan(
+					&decision.ID,
+					&decision.CheckedAt,
+					&decision.CallerID,
+					&decision.CallerOrgID,
+					&decision.OrgID,
+					&decision.Method,
+					&decision.Permission,
+					&decision.ResourceID,
+					&decision.Allowed,
+					&decision.MatchedPermission,
+					&decision.ErrorID,
+					&count,
+				)
+				if err != nil {
+					return nil, err
+				}
+				decisions = append(decisions, decision)
+			}
+
+			if err := rows.Close(); err != nil {
+				return nil, zerrors.ThrowInternal(err, "QUERY-Vq8kTz3nWd", "Errors.Query.CloseRows")
+			}
+
+			return &AuthZDecisions{
+				Decisions: decisions,
+				SearchResponse: SearchResponse{
+					Count: count,
+				},
+			}, nil
+		}
+}
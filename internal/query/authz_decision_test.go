@@ -0,0 +1,157 @@
+package query
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"regexp"
+	"testing"
+)
+
+var (
+	prepareAuthZDecisionsStmt = `SELECT logstore.authz_decisions.id,` +
+		` logstore.authz_decisions.checked_at,` +
+		` logstore.authz_decisions.caller_id,` +
+		` logstore.authz_decisions.caller_org_id,` +
+		` logstore.authz_decisions.org_id,` +
+		` logstore.authz_decisions.method,` +
+		` logstore.authz_decisions.permission,` +
+		` logstore.authz_decisions.resource_id,` +
+		` logstore.authz_decisions.allowed,` +
+		` logstore.authz_decisions.matched_permission,` +
+		` logstore.authz_decisions.error_id,` +
+		` COUNT(*) OVER ()` +
+		` FROM logstore.authz_decisions`
+	prepareAuthZDecisionsCols = []string{
+		"id",
+		"checked_at",
+		"caller_id",
+		"caller_org_id",
+		"org_id",
+		"method",
+		"permission",
+		"resource_id",
+		"allowed",
+		"matched_permission",
+		"error_id",
+		"count",
+	}
+)
+
+func Test_AuthZDecisionPrepares(t *testing.T) {
+	type want struct {
+		sqlExpectations sqlExpectation
+		err             checkErr
+	}
+	tests := []struct {
+		name    string
+		prepare interface{}
+		want    want
+		object  interface{}
+	}{
+		{
+			name:    "prepareAuthZDecisionsQuery no result",
+			prepare: prepareAuthZDecisionsQuery,
+			want: want{
+				sqlExpectations: mockQueries(
+					regexp.QuoteMeta(prepareAuthZDecisionsStmt),
+					nil,
+					nil,
+				),
+			},
+			object: &AuthZDecisions{Decisions: []*AuthZDecision{}},
+		},
+		{
+			name:    "prepareAuthZDecisionsQuery multiple result",
+			prepare: prepareAuthZDecisionsQuery,
+			want: want{
+				sqlExpectations: mockQueries(
+					regexp.QuoteMeta(prepareAuthZDecisionsStmt),
+					prepareAuthZDecisionsCols,
+					[][]driver.Value{
+						{
+							"id-1",
+							testNow,
+							"caller-id",
+							"caller-org-id",
+							"org-id",
+							"/zitadel.management.v1.ManagementService/GetUserByID",
+							"user.read",
+							"user-id",
+							true,
+							"user.read",
+							"",
+						},
+						{
+							"id-2",
+							testNow,
+							"caller-id",
+							"caller-org-id",
+							"org-id",
+							"/zitadel.management.v1.ManagementService/RemoveUser",
+							"user.delete",
+							"user-id",
+							false,
+							"",
+							"AUTH-5mWD2",
+						},
+					},
+				),
+			},
+			object: &AuthZDecisions{
+				SearchResponse: SearchResponse{
+					Count: 2,
+				},
+				Decisions: []*AuthZDecision{
+					{
+						ID:                "id-1",
+						CheckedAt:         testNow,
+						CallerID:          "caller-id",
+						CallerOrgID:       "caller-org-id",
+						OrgID:             "org-id",
+						Method:            "/zitadel.management.v1.ManagementService/GetUserByID",
+						Permission:        "user.read",
+						ResourceID:        "user-id",
+						Allowed:           true,
+						MatchedPermission: "user.read",
+					},
+					{
+						ID:          "id-2",
+						CheckedAt:   testNow,
+						CallerID:    "caller-id",
+						CallerOrgID: "caller-org-id",
+						OrgID:       "org-id",
+						Method:      "/zitadel.management.v1.ManagementService/RemoveUser",
+						Permission:  "user.delete",
+						ResourceID:  "user-id",
+						Allowed:     false,
+						ErrorID:     "AUTH-5mWD2",
+					},
+				},
+			},
+		},
+		{
+			name:    "prepareAuthZDecisionsQuery sql err",
+			prepare: prepareAuthZDecisionsQuery,
+			want: want{
+				sqlExpectations: mockQueryErr(
+					regexp.QuoteMeta(prepareAuthZDecisionsStmt),
+					sql.ErrConnDone,
+				),
+				err: func(err error) (error, bool) {
+					if !errors.Is(err, sql.ErrConnDone) {
+						return fmt.Errorf("err should be sql.ErrConnDone got: %w", err), false
+					}
+					return nil, true
+				},
+			},
+			object: (*AuthZDecisions)(nil),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assertPrepare(t, tt.prepare, tt.object, tt.want.sqlExpectations, tt.want.err, defaultPrepareArgs...)
+		})
+	}
+}
@@ -0,0 +1,163 @@
+package query
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	sq "github.com/Masterminds/squirrel"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/api/call"
+	"github.com/zitadel/zitadel/internal/crypto"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/query/projection"
+	"github.com/zitadel/zitadel/internal/telemetry/tracing"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+var (
+	captchaPolicyTable = table{
+		name:          projection.CaptchaPolicyTable,
+		instanceIDCol: projection.CaptchaPolicyInstanceIDCol,
+	}
+	CaptchaPolicyColID = Column{
+		name:  projection.CaptchaPolicyIDCol,
+		table: captchaPolicyTable,
+	}
+	CaptchaPolicyColInstanceID = Column{
+		name:  projection.CaptchaPolicyInstanceIDCol,
+		table: captchaPolicyTable,
+	}
+	CaptchaPolicyColSequence = Column{
+		name:  projection.CaptchaPolicySequenceCol,
+		table: captchaPolicyTable,
+	}
+	CaptchaPolicyColCreationDate = Column{
+		name:  projection.CaptchaPolicyCreationDateCol,
+		table: captchaPolicyTable,
+	}
+	CaptchaPolicyColChangeDate = Column{
+		name:  projection.CaptchaPolicyChangeDateCol,
+		table: captchaPolicyTable,
+	}
+	CaptchaPolicyColResourceOwner = Column{
+		name:  projection.CaptchaPolicyResourceOwnerCol,
+		table: captchaPolicyTable,
+	}
+	CaptchaPolicyColIsDefault = Column{
+		name:  projection.CaptchaPolicyIsDefaultCol,
+		table: captchaPolicyTable,
+	}
+	CaptchaPolicyColType = Column{
+		name:  projection.CaptchaPolicyTypeCol,
+		table: captchaPolicyTable,
+	}
+	CaptchaPolicyColSiteKey = Column{
+		name:  projection.CaptchaPolicySiteKeyCol,
+		table: captchaPolicyTable,
+	}
+	CaptchaPolicyColSecret = Column{
+		name:  projection.CaptchaPolicySecretCol,
+		table: captchaPolicyTable,
+	}
+	CaptchaPolicyColEnabledOnRegister = Column{
+		name:  projection.CaptchaPolicyEnabledOnRegisterCol,
+		table: captchaPolicyTable,
+	}
+	CaptchaPolicyColEnabledOnPasswordReset = Column{
+		name:  projection.CaptchaPolicyEnabledOnPasswordResetCol,
+		table: captchaPolicyTable,
+	}
+	CaptchaPolicyColEnabledOnPasswordless = Column{
+		name:  projection.CaptchaPolicyEnabledOnPasswordlessCol,
+		table: captchaPolicyTable,
+	}
+	CaptchaPolicyColEnabledOnSession = Column{
+		name:  projection.CaptchaPolicyEnabledOnSessionCol,
+		table: captchaPolicyTable,
+	}
+)
+
+// CaptchaPolicyByOrg returns the captcha policy of the organization, of its closest ancestor
+// or the default policy of the instance if none of them has one.
+// If neither is configured, nil is returned.
+func (q *Queries) CaptchaPolicyByOrg(ctx context.Context, orgID string) (policy *domain.CaptchaPolicy, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	owners, err := q.orgPolicyOwners(ctx, orgID)
+	if err != nil {
+		return nil, err
+	}
+
+	stmt, scan := prepareCaptchaPolicyQuery(ctx, q.client)
+	query, args, err := stmt.Where(
+		sq.And{
+			sq.Eq{CaptchaPolicyColInstanceID.identifier(): authz.GetInstance(ctx).InstanceID()},
+			sq.Eq{CaptchaPolicyColID.identifier(): owners},
+		}).
+		OrderByClause(orgPolicyOwnersOrder(CaptchaPolicyColID, owners)).
+		Limit(1).ToSql()
+	if err != nil {
+		return nil, zerrors.ThrowInternal(err, "QUERY-Hq4ce", "Errors.Query.SQLStatement")
+	}
+
+	err = q.client.QueryRowContext(ctx, func(row *sql.Row) error {
+		policy, err = scan(row)
+		return err
+	}, query, args...)
+	if zerrors.IsNotFound(err) {
+		return nil, nil
+	}
+	return policy, err
+}
+
+func prepareCaptchaPolicyQuery(ctx context.Context, db prepareDatabase) (sq.SelectBuilder, func(*sql.Row) (*domain.CaptchaPolicy, error)) {
+	return sq.Select(
+			CaptchaPolicyColID.identifier(),
+			CaptchaPolicyColSequence.identifier(),
+			CaptchaPolicyColCreationDate.identifier(),
+			CaptchaPolicyColChangeDate.identifier(),
+			CaptchaPolicyColResourceOwner.identifier(),
+			CaptchaPolicyColType.identifier(),
+			CaptchaPolicyColSiteKey.identifier(),
+			CaptchaPolicyColSecret.identifier(),
+			CaptchaPolicyColEnabledOnRegister.identifier(),
+			CaptchaPolicyColEnabledOnPasswordReset.identifier(),
+			CaptchaPolicyColEnabledOnPasswordless.identifier(),
+			CaptchaPolicyColEnabledOnSession.identifier(),
+			CaptchaPolicyColIsDefault.identifier(),
+		).
+			From(captchaPolicyTable.identifier() + db.Timetravel(call.Took(ctx))).
+			PlaceholderFormat(sq.Dollar),
+		func(row *sql.Row) (*domain.CaptchaPolicy, error) {
+			policy := new(domain.CaptchaPolicy)
+			secret := new(crypto.CryptoValue)
+			err := row.Scan(
+				&policy.AggregateID,
+				&policy.Sequence,
+				&policy.CreationDate,
+				&policy.ChangeDate,
+				&policy.ResourceOwner,
+				&policy.Type,
+				&policy.SiteKey,
+				secret,
+				&policy.EnabledOnRegister,
+				&policy.EnabledOnPasswordReset,
+				&policy.EnabledOnPasswordless,
+				&policy.EnabledOnSession,
+				&policy.Default,
+			)
+			if err != nil {
+				if errors.Is(err, sql.ErrNoRows) {
+					return nil, zerrors.ThrowNotFound(err, "QUERY-Nx6fe", "Errors.Org.CaptchaPolicy.NotFound")
+				}
+				return nil, zerrors.ThrowInternal(err, "QUERY-Vd2ow", "Errors.Internal")
+			}
+			if len(secret.Crypted) > 0 {
+				policy.Secret = secret
+			}
+			return policy, nil
+		}
+}
@@ -0,0 +1,186 @@
+package query
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/zitadel/zitadel/internal/crypto"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore/v1/models"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+var (
+	prepareCaptchaPolicyStmt = `SELECT projections.captcha_policies.id,` +
+		` projections.captcha_policies.sequence,` +
+		` projections.captcha_policies.creation_date,` +
+		` projections.captcha_policies.change_date,` +
+		` projections.captcha_policies.resource_owner,` +
+		` projections.captcha_policies.captcha_type,` +
+		` projections.captcha_policies.site_key,` +
+		` projections.captcha_policies.secret,` +
+		` projections.captcha_policies.enabled_on_register,` +
+		` projections.captcha_policies.enabled_on_password_reset,` +
+		` projections.captcha_policies.enabled_on_passwordless,` +
+		` projections.captcha_policies.enabled_on_session,` +
+		` projections.captcha_policies.is_default` +
+		` FROM projections.captcha_policies` +
+		` AS OF SYSTEM TIME '-1 ms'`
+
+	prepareCaptchaPolicyCols = []string{
+		"id",
+		"sequence",
+		"creation_date",
+		"change_date",
+		"resource_owner",
+		"captcha_type",
+		"site_key",
+		"secret",
+		"enabled_on_register",
+		"enabled_on_password_reset",
+		"enabled_on_passwordless",
+		"enabled_on_session",
+		"is_default",
+	}
+)
+
+func Test_CaptchaPolicyPrepares(t *testing.T) {
+	type want struct {
+		sqlExpectations sqlExpectation
+		err             checkErr
+	}
+	tests := []struct {
+		name    string
+		prepare interface{}
+		want    want
+		object  interface{}
+	}{
+		{
+			name:    "prepareCaptchaPolicyQuery no result",
+			prepare: prepareCaptchaPolicyQuery,
+			want: want{
+				sqlExpectations: mockQueriesScanErr(
+					regexp.QuoteMeta(prepareCaptchaPolicyStmt),
+					nil,
+					nil,
+				),
+				err: func(err error) (error, bool) {
+					if !zerrors.IsNotFound(err) {
+						return fmt.Errorf("err should be zitadel.NotFoundError got: %w", err), false
+					}
+					return nil, true
+				},
+			},
+			object: (*domain.CaptchaPolicy)(nil),
+		},
+		{
+			name:    "prepareCaptchaPolicyQuery found",
+			prepare: prepareCaptchaPolicyQuery,
+			want: want{
+				sqlExpectations: mockQuery(
+					regexp.QuoteMeta(prepareCaptchaPolicyStmt),
+					prepareCaptchaPolicyCols,
+					[]driver.Value{
+						"pol-id",
+						uint64(20211109),
+						testNow,
+						testNow,
+						"ro",
+						domain.CaptchaTypeReCaptcha,
+						"site-key",
+						[]byte(`{"CryptoType":0,"Algorithm":"aes","KeyID":"key-id","Crypted":"c2VjcmV0"}`),
+						true,
+						false,
+						false,
+						true,
+						false,
+					},
+				),
+			},
+			object: &domain.CaptchaPolicy{
+				ObjectRoot: models.ObjectRoot{
+					AggregateID:   "pol-id",
+					Sequence:      20211109,
+					CreationDate:  testNow,
+					ChangeDate:    testNow,
+					ResourceOwner: "ro",
+				},
+				Type:    domain.CaptchaTypeReCaptcha,
+				SiteKey: "site-key",
+				Secret: &crypto.CryptoValue{
+					CryptoType: crypto.TypeEncryption,
+					Algorithm:  "aes",
+					KeyID:      "key-id",
+					Crypted:    []byte("secret"),
+				},
+				EnabledOnRegister: true,
+				EnabledOnSession:  true,
+			},
+		},
+		{
+			name:    "prepareCaptchaPolicyQuery without secret",
+			prepare: prepareCaptchaPolicyQuery,
+			want: want{
+				sqlExpectations: mockQuery(
+					regexp.QuoteMeta(prepareCaptchaPolicyStmt),
+					prepareCaptchaPolicyCols,
+					[]driver.Value{
+						"pol-id",
+						uint64(20211109),
+						testNow,
+						testNow,
+						"ro",
+						domain.CaptchaTypeTurnstile,
+						"site-key",
+						nil,
+						false,
+						true,
+						true,
+						false,
+						true,
+					},
+				),
+			},
+			object: &domain.CaptchaPolicy{
+				ObjectRoot: models.ObjectRoot{
+					AggregateID:   "pol-id",
+					Sequence:      20211109,
+					CreationDate:  testNow,
+					ChangeDate:    testNow,
+					ResourceOwner: "ro",
+				},
+				Type:                   domain.CaptchaTypeTurnstile,
+				SiteKey:                "site-key",
+				EnabledOnPasswordReset: true,
+				EnabledOnPasswordless:  true,
+				Default:                true,
+			},
+		},
+		{
+			name:    "prepareCaptchaPolicyQuery sql err",
+			prepare: prepareCaptchaPolicyQuery,
+			want: want{
+				sqlExpectations: mockQueryErr(
+					regexp.QuoteMeta(prepareCaptchaPolicyStmt),
+					sql.ErrConnDone,
+				),
+				err: func(err error) (error, bool) {
+					if !errors.Is(err, sql.ErrConnDone) {
+						return fmt.Errorf("err should be sql.ErrConnDone got: %w", err), false
+					}
+					return nil, true
+				},
+			},
+			object: (*domain.CaptchaPolicy)(nil),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assertPrepare(t, tt.prepare, tt.object, tt.want.sqlExpectations, tt.want.err, defaultPrepareArgs...)
+		})
+	}
+}
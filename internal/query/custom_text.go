@@ -397,6 +397,18 @@ func CustomTextsToLoginDomain(instanceID, aggregateID, lang string, texts *Custo
 		if strings.HasPrefix(text.Key, domain.LoginKeyPasswordResetDone) {
 			passwordResetDoneKeyToDomain(text, result)
 		}
+		if strings.HasPrefix(text.Key, domain.LoginKeyUsernameRecovery) {
+			usernameRecoveryKeyToDomain(text, result)
+		}
+		if strings.HasPrefix(text.Key, domain.LoginKeyUsernameRecoveryDone) {
+			usernameRecoveryDoneKeyToDomain(text, result)
+		}
+		if strings.HasPrefix(text.Key, domain.LoginKeyPasskeyPrompt) {
+			passkeyPromptKeyToDomain(text, result)
+		}
+		if strings.HasPrefix(text.Key, domain.LoginKeyLinkedIDPs) {
+			linkedIDPsKeyToDomain(text, result)
+		}
 		if strings.HasPrefix(text.Key, domain.LoginKeyRegistrationOption) {
 			registrationOptionKeyToDomain(text, result)
 		}
@@ -424,6 +436,9 @@ func CustomTextsToLoginDomain(instanceID, aggregateID, lang string, texts *Custo
 		if strings.HasPrefix(text.Key, domain.LoginKeyLogoutDone) {
 			logoutDoneKeyToDomain(text, result)
 		}
+		if strings.HasPrefix(text.Key, domain.LoginKeyLogoutConfirmation) {
+			logoutConfirmationKeyToDomain(text, result)
+		}
 		if strings.HasPrefix(text.Key, domain.LoginKeyFooter) {
 			footerKeyToDomain(text, result)
 		}
@@ -486,6 +501,9 @@ func loginKeyToDomain(text *CustomText, result *domain.CustomLoginText) {
 	if text.Key == domain.LoginKeyLoginUserMustBeMemberOfOrg {
 		result.Login.MustBeMemberOfOrg = text.Text
 	}
+	if text.Key == domain.LoginKeyLoginForgotUsernameLinkText {
+		result.Login.ForgotUsernameLinkText = text.Text
+	}
 	if text.Key == domain.LoginKeyLoginRegisterButtonText {
 		result.Login.RegisterButtonText = text.Text
 	}
@@ -507,6 +525,9 @@ func passwordKeyToDomain(text *CustomText, result *domain.CustomLoginText) {
 	if text.Key == domain.LoginKeyPasswordResetLinkText {
 		result.Password.ResetLinkText = text.Text
 	}
+	if text.Key == domain.LoginKeyPasswordCaptchaLabel {
+		result.Password.CaptchaLabel = text.Text
+	}
 	if text.Key == domain.LoginKeyPasswordBackButtonText {
 		result.Password.BackButtonText = text.Text
 	}
@@ -822,6 +843,9 @@ func passwordlessKeyToDomain(text *CustomText, result *domain.CustomLoginText) {
 	if text.Key == domain.LoginKeyPasswordlessErrorRetry {
 		result.Passwordless.ErrorRetry = text.Text
 	}
+	if text.Key == domain.LoginKeyPasswordlessCaptchaLabel {
+		result.Passwordless.CaptchaLabel = text.Text
+	}
 }
 
 func passwordlessPromptKeyToDomain(text *CustomText, result *domain.CustomLoginText) {
@@ -935,6 +959,75 @@ func passwordResetDoneKeyToDomain(text *CustomText, result *domain.CustomLoginTe
 	}
 }
 
+func usernameRecoveryKeyToDomain(text *CustomText, result *domain.CustomLoginText) {
+	if text.Key == domain.LoginKeyUsernameRecoveryTitle {
+		result.UsernameRecovery.Title = text.Text
+	}
+	if text.Key == domain.LoginKeyUsernameRecoveryDescription {
+		result.UsernameRecovery.Description = text.Text
+	}
+	if text.Key == domain.LoginKeyUsernameRecoveryEmailOrPhoneLabel {
+		result.UsernameRecovery.EmailOrPhoneLabel = text.Text
+	}
+	if text.Key == domain.LoginKeyUsernameRecoveryCaptchaLabel {
+		result.UsernameRecovery.CaptchaLabel = text.Text
+	}
+	if text.Key == domain.LoginKeyUsernameRecoveryBackButtonText {
+		result.UsernameRecovery.BackButtonText = text.Text
+	}
+	if text.Key == domain.LoginKeyUsernameRecoveryNextButtonText {
+		result.UsernameRecovery.NextButtonText = text.Text
+	}
+}
+
+func usernameRecoveryDoneKeyToDomain(text *CustomText, result *domain.CustomLoginText) {
+	if text.Key == domain.LoginKeyUsernameRecoveryDoneTitle {
+		result.UsernameRecoveryDone.Title = text.Text
+	}
+	if text.Key == domain.LoginKeyUsernameRecoveryDoneDescription {
+		result.UsernameRecoveryDone.Description = text.Text
+	}
+	if text.Key == domain.LoginKeyUsernameRecoveryDoneNextButtonText {
+		result.UsernameRecoveryDone.NextButtonText = text.Text
+	}
+}
+
+func passkeyPromptKeyToDomain(text *CustomText, result *domain.CustomLoginText) {
+	if text.Key == domain.LoginKeyPasskeyPromptTitle {
+		result.PasskeyPrompt.Title = text.Text
+	}
+	if text.Key == domain.LoginKeyPasskeyPromptDescription {
+		result.PasskeyPrompt.Description = text.Text
+	}
+	if text.Key == domain.LoginKeyPasskeyPromptRegisterButtonText {
+		result.PasskeyPrompt.RegisterButtonText = text.Text
+	}
+	if text.Key == domain.LoginKeyPasskeyPromptSnoozeButtonText {
+		result.PasskeyPrompt.SnoozeButtonText = text.Text
+	}
+	if text.Key == domain.LoginKeyPasskeyPromptOptOutButtonText {
+		result.PasskeyPrompt.OptOutButtonText = text.Text
+	}
+}
+
+func linkedIDPsKeyToDomain(text *CustomText, result *domain.CustomLoginText) {
+	if text.Key == domain.LoginKeyLinkedIDPsTitle {
+		result.LinkedIDPs.Title = text.Text
+	}
+	if text.Key == domain.LoginKeyLinkedIDPsDescription {
+		result.LinkedIDPs.Description = text.Text
+	}
+	if text.Key == domain.LoginKeyLinkedIDPsNoLinksText {
+		result.LinkedIDPs.NoLinksText = text.Text
+	}
+	if text.Key == domain.LoginKeyLinkedIDPsUnlinkButtonText {
+		result.LinkedIDPs.UnlinkButtonText = text.Text
+	}
+	if text.Key == domain.LoginKeyLinkedIDPsNextButtonText {
+		result.LinkedIDPs.NextButtonText = text.Text
+	}
+}
+
 func registrationOptionKeyToDomain(text *CustomText, result *domain.CustomLoginText) {
 	if text.Key == domain.LoginKeyRegistrationOptionTitle {
 		result.RegisterOption.Title = text.Text
@@ -1056,6 +1149,9 @@ func registrationUserKeyToDomain(text *CustomText, result *domain.CustomLoginTex
 	if text.Key == domain.LoginKeyRegistrationUserBackButtonText {
 		result.RegistrationUser.BackButtonText = text.Text
 	}
+	if text.Key == domain.LoginKeyRegistrationUserCaptchaLabel {
+		result.RegistrationUser.CaptchaLabel = text.Text
+	}
 }
 
 func registrationOrgKeyToDomain(text *CustomText, result *domain.CustomLoginText) {
@@ -1193,6 +1289,21 @@ func logoutDoneKeyToDomain(text *CustomText, result *domain.CustomLoginText) {
 	}
 }
 
+func logoutConfirmationKeyToDomain(text *CustomText, result *domain.CustomLoginText) {
+	if text.Key == domain.LoginKeyLogoutConfirmationTitle {
+		result.LogoutConfirmation.Title = text.Text
+	}
+	if text.Key == domain.LoginKeyLogoutConfirmationDescription {
+		result.LogoutConfirmation.Description = text.Text
+	}
+	if text.Key == domain.LoginKeyLogoutConfirmationConfirmButtonText {
+		result.LogoutConfirmation.ConfirmButtonText = text.Text
+	}
+	if text.Key == domain.LoginKeyLogoutConfirmationCancelButtonText {
+		result.LogoutConfirmation.CancelButtonText = text.Text
+	}
+}
+
 func footerKeyToDomain(text *CustomText, result *domain.CustomLoginText) {
 	if text.Key == domain.LoginKeyFooterTOS {
 		result.Footer.TOS = text.Text
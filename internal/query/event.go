@@ -17,6 +17,7 @@ type Event struct {
 	CreationDate time.Time
 	Type         string
 	Payload      []byte
+	Position     float64
 }
 
 type EventEditor struct {
@@ -107,6 +108,7 @@ func (er *eventsReducer) convertEvent(ctx context.Context, event eventstore.Even
 		CreationDate: event.CreatedAt(),
 		Type:         string(event.Type()),
 		Payload:      event.DataAsBytes(),
+		Position:     event.Position(),
 	}
 }
 
@@ -0,0 +1,110 @@
+package query
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+const eventSubscriptionBatchSize = 100
+
+// EventCursor is the position from which a subscription resumes.
+// Events of the same transaction share their position, so the cursor of an event is the position
+// up to which all events were delivered. Resuming from a cursor might deliver events of the last transaction again.
+type EventCursor float64
+
+func (c EventCursor) String() string {
+	return strconv.FormatFloat(float64(c), 'f', -1, 64)
+}
+
+// ParseEventCursor parses the cursor returned with an event, an empty cursor subscribes from the beginning
+func ParseEventCursor(cursor string) (EventCursor, error) {
+	if cursor == "" {
+		return 0, nil
+	}
+	position, err := strconv.ParseFloat(cursor, 64)
+	if err != nil || position < 0 {
+		return 0, zerrors.ThrowInvalidArgument(err, "QUERY-Ev8nq3wx2m", "Errors.Query.InvalidRequest")
+	}
+	return EventCursor(position), nil
+}
+
+// SubscribeEvents calls send for every event matching the query after the cursor ordered by their position.
+// New events are polled every interval until the context is done or send returns an error.
+func (q *Queries) SubscribeEvents(ctx context.Context, query func() *eventstore.SearchQueryBuilder, cursor EventCursor, interval time.Duration, send func(event *Event, cursor EventCursor) error) error {
+	subscription := &eventSubscription{cursor: cursor, limit: eventSubscriptionBatchSize}
+	for {
+		events, err := q.SearchEvents(ctx, query().
+			OrderAsc().
+			AwaitOpenTransactions().
+			PositionAfter(float64(subscription.cursor)).
+			Limit(subscription.limit),
+		)
+		if err != nil {
+			return err
+		}
+		more, err := subscription.deliver(events, send)
+		if err != nil {
+			return err
+		}
+		if more {
+			continue
+		}
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(interval):
+		}
+	}
+}
+
+type eventSubscription struct {
+	cursor EventCursor
+	limit  uint64
+	// sentPosition and sentAtPosition skip the events of a transaction which were sent before the batch
+	sentPosition   float64
+	sentAtPosition int
+}
+
+// deliver sends the events of the batch which were not sent before
+// and returns if the next batch must be queried without waiting for new events
+func (s *eventSubscription) deliver(events []*Event, send func(event *Event, cursor EventCursor) error) (more bool, err error) {
+	next := s.cursor
+	skipPosition, skip := s.sentPosition, s.sentAtPosition
+	for i, event := range events {
+		if i > 0 && event.Position > events[i-1].Position {
+			next = EventCursor(events[i-1].Position)
+		}
+		if event.Position == skipPosition && skip > 0 {
+			skip--
+			continue
+		}
+		if err = send(event, next); err != nil {
+			return false, err
+		}
+		if event.Position != s.sentPosition {
+			s.sentPosition, s.sentAtPosition = event.Position, 0
+		}
+		s.sentAtPosition++
+	}
+	if uint64(len(events)) < s.limit {
+		// all transactions of the batch are complete
+		if len(events) > 0 {
+			s.cursor = EventCursor(events[len(events)-1].Position)
+		}
+		s.limit = eventSubscriptionBatchSize
+		return false, nil
+	}
+	// the batch might end within a transaction, so the last transaction is queried again.
+	// If the batch only contains one transaction, the limit is increased to get all of its events
+	if next == s.cursor {
+		s.limit *= 2
+	} else {
+		s.limit = eventSubscriptionBatchSize
+	}
+	s.cursor = next
+	return true, nil
+}
@@ -0,0 +1,69 @@
+package query
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type sentEvent struct {
+	sequence uint64
+	cursor   EventCursor
+}
+
+func subscriptionEvents(positions ...float64) []*Event {
+	events := make([]*Event, len(positions))
+	for i, position := range positions {
+		events[i] = &Event{Sequence: uint64(i + 1), Position: position}
+	}
+	return events
+}
+
+func Test_eventSubscription_deliver(t *testing.T) {
+	var sent []sentEvent
+	send := func(event *Event, cursor EventCursor) error {
+		sent = append(sent, sentEvent{sequence: event.Sequence, cursor: cursor})
+		return nil
+	}
+	subscription := &eventSubscription{cursor: 1, limit: 3}
+
+	// the batch is full, so the last transaction (position 3) might be incomplete
+	more, err := subscription.deliver(subscriptionEvents(2, 3, 3), send)
+	require.NoError(t, err)
+	assert.True(t, more)
+	assert.Equal(t, []sentEvent{{1, 1}, {2, 2}, {3, 2}}, sent)
+	assert.Equal(t, EventCursor(2), subscription.cursor)
+
+	// the events of position 3 which were already sent are skipped
+	sent = nil
+	subscription.limit = 3
+	more, err = subscription.deliver(subscriptionEvents(3, 3, 3), send)
+	require.NoError(t, err)
+	assert.True(t, more)
+	assert.Equal(t, []sentEvent{{3, 2}}, sent)
+	// the batch only contained one transaction
+	assert.Equal(t, uint64(6), subscription.limit)
+	assert.Equal(t, EventCursor(2), subscription.cursor)
+
+	sent = nil
+	more, err = subscription.deliver(subscriptionEvents(3, 3, 3, 3, 4), send)
+	require.NoError(t, err)
+	assert.False(t, more)
+	assert.Equal(t, []sentEvent{{4, 2}, {5, 3}}, sent)
+	assert.Equal(t, EventCursor(4), subscription.cursor)
+	assert.Equal(t, uint64(eventSubscriptionBatchSize), subscription.limit)
+}
+
+func TestParseEventCursor(t *testing.T) {
+	cursor, err := ParseEventCursor("")
+	require.NoError(t, err)
+	assert.Equal(t, EventCursor(0), cursor)
+
+	cursor, err = ParseEventCursor(EventCursor(1712345678.123456).String())
+	require.NoError(t, err)
+	assert.Equal(t, EventCursor(1712345678.123456), cursor)
+
+	_, err = ParseEventCursor("invalid")
+	assert.Error(t, err)
+}
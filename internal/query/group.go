@@ -0,0 +1,581 @@
+package query
+
+import (
+	"context"
+	"database/sql"
+	_ "embed"
+	"errors"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/zitadel/logging"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/api/call"
+	"github.com/zitadel/zitadel/internal/database"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore/handler/v2"
+	"github.com/zitadel/zitadel/internal/query/projection"
+	"github.com/zitadel/zitadel/internal/telemetry/tracing"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+var (
+	groupsTable = table{
+		name:          projection.GroupTable,
+		instanceIDCol: projection.GroupInstanceIDCol,
+	}
+	GroupColumnID = Column{
+		name:  projection.GroupIDCol,
+		table: groupsTable,
+	}
+	GroupColumnCreationDate = Column{
+		name:  projection.GroupCreationDateCol,
+		table: groupsTable,
+	}
+	GroupColumnChangeDate = Column{
+		name:  projection.GroupChangeDateCol,
+		table: groupsTable,
+	}
+	GroupColumnSequence = Column{
+		name:  projection.GroupSequenceCol,
+		table: groupsTable,
+	}
+	GroupColumnResourceOwner = Column{
+		name:  projection.GroupResourceOwnerCol,
+		table: groupsTable,
+	}
+	GroupColumnInstanceID = Column{
+		name:  projection.GroupInstanceIDCol,
+		table: groupsTable,
+	}
+	GroupColumnName = Column{
+		name:  projection.GroupNameCol,
+		table: groupsTable,
+	}
+	GroupColumnDescription = Column{
+		name:  projection.GroupDescriptionCol,
+		table: groupsTable,
+	}
+	GroupColumnParentID = Column{
+		name:  projection.GroupParentIDCol,
+		table: groupsTable,
+	}
+)
+
+var (
+	groupMembersTable = table{
+		name:          projection.GroupMemberTable,
+		instanceIDCol: projection.GroupMemberInstanceIDCol,
+	}
+	GroupMemberColumnGroupID = Column{
+		name:  projection.GroupMemberGroupIDCol,
+		table: groupMembersTable,
+	}
+	GroupMemberColumnUserID = Column{
+		name:  projection.GroupMemberUserIDCol,
+		table: groupMembersTable,
+	}
+	GroupMemberColumnCreationDate = Column{
+		name:  projection.GroupMemberCreationDateCol,
+		table: groupMembersTable,
+	}
+	GroupMemberColumnSequence = Column{
+		name:  projection.GroupMemberSequenceCol,
+		table: groupMembersTable,
+	}
+	GroupMemberColumnResourceOwner = Column{
+		name:  projection.GroupMemberResourceOwnerCol,
+		table: groupMembersTable,
+	}
+	GroupMemberColumnInstanceID = Column{
+		name:  projection.GroupMemberInstanceIDCol,
+		table: groupMembersTable,
+	}
+)
+
+var (
+	groupGrantsTable = table{
+		name:          projection.GroupGrantTable,
+		instanceIDCol: projection.GroupGrantInstanceIDCol,
+	}
+	GroupGrantColumnGroupID = Column{
+		name:  projection.GroupGrantGroupIDCol,
+		table: groupGrantsTable,
+	}
+	GroupGrantColumnProjectID = Column{
+		name:  projection.GroupGrantProjectIDCol,
+		table: groupGrantsTable,
+	}
+	GroupGrantColumnProjectGrantID = Column{
+		name:  projection.GroupGrantProjectGrantIDCol,
+		table: groupGrantsTable,
+	}
+	GroupGrantColumnRoles = Column{
+		name:  projection.GroupGrantRolesCol,
+		table: groupGrantsTable,
+	}
+	GroupGrantColumnCreationDate = Column{
+		name:  projection.GroupGrantCreationDateCol,
+		table: groupGrantsTable,
+	}
+	GroupGrantColumnChangeDate = Column{
+		name:  projection.GroupGrantChangeDateCol,
+		table: groupGrantsTable,
+	}
+	GroupGrantColumnSequence = Column{
+		name:  projection.GroupGrantSequenceCol,
+		table: groupGrantsTable,
+	}
+	GroupGrantColumnResourceOwner = Column{
+		name:  projection.GroupGrantResourceOwnerCol,
+		table: groupGrantsTable,
+	}
+	GroupGrantColumnInstanceID = Column{
+		name:  projection.GroupGrantInstanceIDCol,
+		table: groupGrantsTable,
+	}
+)
+
+type Groups struct {
+	SearchResponse
+	Groups []*Group
+}
+
+type Group struct {
+	ID            string
+	CreationDate  time.Time
+	ChangeDate    time.Time
+	Sequence      uint64
+	ResourceOwner string
+
+	Name        string
+	Description string
+	ParentID    string
+}
+
+type GroupSearchQueries struct {
+	SearchRequest
+	Queries []SearchQuery
+}
+
+type GroupMembers struct {
+	SearchResponse
+	Members []*GroupMember
+}
+
+type GroupMember struct {
+	GroupID       string
+	UserID        string
+	CreationDate  time.Time
+	Sequence      uint64
+	ResourceOwner string
+}
+
+type GroupMemberSearchQueries struct {
+	SearchRequest
+	Queries []SearchQuery
+}
+
+type GroupGrants struct {
+	SearchResponse
+	Grants []*GroupGrant
+}
+
+type GroupGrant struct {
+	GroupID        string
+	ProjectID      string
+	ProjectGrantID string
+	RoleKeys       database.TextArray[string]
+	CreationDate   time.Time
+	ChangeDate     time.Time
+	Sequence       uint64
+	ResourceOwner  string
+}
+
+type GroupGrantSearchQueries struct {
+	SearchRequest
+	Queries []SearchQuery
+}
+
+func (q *Queries) GroupByID(ctx context.Context, shouldTriggerBulk bool, id, resourceOwner string) (group *Group, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	if shouldTriggerBulk {
+		_, traceSpan := tracing.NewNamedSpan(ctx, "TriggerGroupProjection")
+		ctx, err = projection.GroupProjection.Trigger(ctx, handler.WithAwaitRunning())
+		logging.OnError(err).Debug("trigger failed")
+		traceSpan.EndWithError(err)
+	}
+
+	stmt, scan := prepareGroupQuery(ctx, q.client)
+	eq := sq.Eq{
+		GroupColumnID.identifier():            id,
+		GroupColumnResourceOwner.identifier(): resourceOwner,
+		GroupColumnInstanceID.identifier():    authz.GetInstance(ctx).InstanceID(),
+	}
+	query, args, err := stmt.Where(eq).ToSql()
+	if err != nil {
+		return nil, zerrors.ThrowInternal(err, "QUERY-Gq1sq", "Errors.Query.SQLStatment")
+	}
+
+	err = q.client.QueryRowContext(ctx, func(row *sql.Row) error {
+		group, err = scan(row)
+		return err
+	}, query, args...)
+	return group, err
+}
+
+func (q *Queries) SearchGroups(ctx context.Context, queries *GroupSearchQueries) (groups *Groups, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	query, scan := prepareGroupsQuery(ctx, q.client)
+	eq := sq.Eq{GroupColumnInstanceID.identifier(): authz.GetInstance(ctx).InstanceID()}
+	stmt, args, err := queries.toQuery(query).Where(eq).ToSql()
+	if err != nil {
+		return nil, zerrors.ThrowInvalidArgument(err, "QUERY-Gq2sq", "Errors.Query.InvalidRequest")
+	}
+
+	err = q.client.QueryContext(ctx, func(rows *sql.Rows) error {
+		groups, err = scan(rows)
+		return err
+	}, stmt, args...)
+	if err != nil {
+		return nil, zerrors.ThrowInternal(err, "QUERY-Gq2in", "Errors.Internal")
+	}
+	groups.State, err = q.latestState(ctx, groupsTable)
+	return groups, err
+}
+
+func (q *Queries) SearchGroupMembers(ctx context.Context, queries *GroupMemberSearchQueries) (members *GroupMembers, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	query, scan := prepareGroupMembersQuery(ctx, q.client)
+	eq := sq.Eq{GroupMemberColumnInstanceID.identifier(): authz.GetInstance(ctx).InstanceID()}
+	stmt, args, err := queries.toQuery(query).Where(eq).ToSql()
+	if err != nil {
+		return nil, zerrors.ThrowInvalidArgument(err, "QUERY-Gq3sq", "Errors.Query.InvalidRequest")
+	}
+
+	err = q.client.QueryContext(ctx, func(rows *sql.Rows) error {
+		members, err = scan(rows)
+		return err
+	}, stmt, args...)
+	if err != nil {
+		return nil, zerrors.ThrowInternal(err, "QUERY-Gq3in", "Errors.Internal")
+	}
+	members.State, err = q.latestState(ctx, groupMembersTable)
+	return members, err
+}
+
+func (q *Queries) SearchGroupGrants(ctx context.Context, queries *GroupGrantSearchQueries) (grants *GroupGrants, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	query, scan := prepareGroupGrantsQuery(ctx, q.client)
+	eq := sq.Eq{GroupGrantColumnInstanceID.identifier(): authz.GetInstance(ctx).InstanceID()}
+	stmt, args, err := queries.toQuery(query).Where(eq).ToSql()
+	if err != nil {
+		return nil, zerrors.ThrowInvalidArgument(err, "QUERY-Gq4sq", "Errors.Query.InvalidRequest")
+	}
+
+	err = q.client.QueryContext(ctx, func(rows *sql.Rows) error {
+		grants, err = scan(rows)
+		return err
+	}, stmt, args...)
+	if err != nil {
+		return nil, zerrors.ThrowInternal(err, "QUERY-Gq4in", "Errors.Internal")
+	}
+	grants.State, err = q.latestState(ctx, groupGrantsTable)
+	return grants, err
+}
+
+//go:embed group_grants_by_user_id.sql
+var groupGrantsByUserIDQuery string
+
+// GroupGrantsByUserAndProjectID returns the grants of the project the user receives
+// through the membership in a group or any of its parent groups.
+// The grants are returned as user grants so they can be handled like direct grants of the user.
+func (q *Queries) GroupGrantsByUserAndProjectID(ctx context.Context, userID, projectID string) (grants []*UserGrant, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	_, traceSpan := tracing.NewNamedSpan(ctx, "TriggerGroupProjection")
+	ctx, err = projection.GroupProjection.Trigger(ctx, handler.WithAwaitRunning())
+	logging.OnError(err).Debug("trigger failed")
+	traceSpan.EndWithError(err)
+
+	err = q.client.QueryContext(ctx, func(rows *sql.Rows) error {
+		grants, err = scanGroupGrantsByUserID(rows)
+		return err
+	}, groupGrantsByUserIDQuery, userID, authz.GetInstance(ctx).InstanceID(), projectID)
+	if err != nil {
+		return nil, zerrors.ThrowInternal(err, "QUERY-Gq5in", "Errors.Internal")
+	}
+	return grants, nil
+}
+
+func scanGroupGrantsByUserID(rows *sql.Rows) ([]*UserGrant, error) {
+	grants := make([]*UserGrant, 0)
+	for rows.Next() {
+		grant := &UserGrant{State: domain.UserGrantStateActive}
+		err := rows.Scan(
+			&grant.ID,
+			&grant.GrantID,
+			&grant.CreationDate,
+			&grant.ChangeDate,
+			&grant.Sequence,
+			&grant.UserID,
+			&grant.Roles,
+			&grant.ResourceOwner,
+			&grant.ProjectID,
+		)
+		if err != nil {
+			return nil, err
+		}
+		grants = append(grants, grant)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, zerrors.ThrowInternal(err, "QUERY-Gq5cl", "Errors.Query.CloseRows")
+	}
+	return grants, nil
+}
+
+func NewGroupResourceOwnerSearchQuery(value string) (SearchQuery, error) {
+	return NewTextQuery(GroupColumnResourceOwner, value, TextEquals)
+}
+
+func NewGroupNameSearchQuery(method TextComparison, value string) (SearchQuery, error) {
+	return NewTextQuery(GroupColumnName, value, method)
+}
+
+func NewGroupParentIDSearchQuery(value string) (SearchQuery, error) {
+	return NewTextQuery(GroupColumnParentID, value, TextEquals)
+}
+
+func NewGroupMemberGroupIDSearchQuery(value string) (SearchQuery, error) {
+	return NewTextQuery(GroupMemberColumnGroupID, value, TextEquals)
+}
+
+func NewGroupMemberUserIDSearchQuery(value string) (SearchQuery, error) {
+	return NewTextQuery(GroupMemberColumnUserID, value, TextEquals)
+}
+
+func NewGroupMemberResourceOwnerSearchQuery(value string) (SearchQuery, error) {
+	return NewTextQuery(GroupMemberColumnResourceOwner, value, TextEquals)
+}
+
+func NewGroupGrantGroupIDSearchQuery(value string) (SearchQuery, error) {
+	return NewTextQuery(GroupGrantColumnGroupID, value, TextEquals)
+}
+
+func NewGroupGrantProjectIDSearchQuery(value string) (SearchQuery, error) {
+	return NewTextQuery(GroupGrantColumnProjectID, value, TextEquals)
+}
+
+func NewGroupGrantResourceOwnerSearchQuery(value string) (SearchQuery, error) {
+	return NewTextQuery(GroupGrantColumnResourceOwner, value, TextEquals)
+}
+
+func (q *GroupSearchQueries) toQuery(query sq.SelectBuilder) sq.SelectBuilder {
+	query = q.SearchRequest.toQuery(query)
+	for _, q := range q.Queries {
+		query = q.toQuery(query)
+	}
+	return query
+}
+
+func (q *GroupMemberSearchQueries) toQuery(query sq.SelectBuilder) sq.SelectBuilder {
+	query = q.SearchRequest.toQuery(query)
+	for _, q := range q.Queries {
+		query = q.toQuery(query)
+	}
+	return query
+}
+
+func (q *GroupGrantSearchQueries) toQuery(query sq.SelectBuilder) sq.SelectBuilder {
+	query = q.SearchRequest.toQuery(query)
+	for _, q := range q.Queries {
+		query = q.toQuery(query)
+	}
+	return query
+}
+
+func prepareGroupQuery(ctx context.Context, db prepareDatabase) (sq.SelectBuilder, func(*sql.Row) (*Group, error)) {
+	return sq.Select(
+			GroupColumnID.identifier(),
+			GroupColumnCreationDate.identifier(),
+			GroupColumnChangeDate.identifier(),
+			GroupColumnSequence.identifier(),
+			GroupColumnResourceOwner.identifier(),
+			GroupColumnName.identifier(),
+			GroupColumnDescription.identifier(),
+			GroupColumnParentID.identifier(),
+		).
+			From(groupsTable.identifier() + db.Timetravel(call.Took(ctx))).
+			PlaceholderFormat(sq.Dollar),
+		func(row *sql.Row) (*Group, error) {
+			g := new(Group)
+			err := row.Scan(
+				&g.ID,
+				&g.CreationDate,
+				&g.ChangeDate,
+				&g.Sequence,
+				&g.ResourceOwner,
+				&g.Name,
+				&g.Description,
+				&g.ParentID,
+			)
+			if err != nil {
+				if errors.Is(err, sql.ErrNoRows) {
+					return nil, zerrors.ThrowNotFound(err, "QUERY-Gq1nf", "Errors.Group.NotFound")
+				}
+				return nil, zerrors.ThrowInternal(err, "QUERY-Gq1in", "Errors.Internal")
+			}
+			return g, nil
+		}
+}
+
+func prepareGroupsQuery(ctx context.Context, db prepareDatabase) (sq.SelectBuilder, func(*sql.Rows) (*Groups, error)) {
+	return sq.Select(
+			GroupColumnID.identifier(),
+			GroupColumnCreationDate.identifier(),
+			GroupColumnChangeDate.identifier(),
+			GroupColumnSequence.identifier(),
+			GroupColumnResourceOwner.identifier(),
+			GroupColumnName.identifier(),
+			GroupColumnDescription.identifier(),
+			GroupColumnParentID.identifier(),
+			countColumn.identifier(),
+		).
+			From(groupsTable.identifier() + db.Timetravel(call.Took(ctx))).
+			PlaceholderFormat(sq.Dollar),
+		func(rows *sql.Rows) (*Groups, error) {
+			groups := make([]*Group, 0)
+			var count uint64
+			for rows.Next() {
+				g := new(Group)
+				err := rows.Scan(
+					&g.ID,
+					&g.CreationDate,
+					&g.ChangeDate,
+					&g.Sequence,
+					&g.ResourceOwner,
+					&g.Name,
+					&g.Description,
+					&g.ParentID,
+					&count,
+				)
+				if err != nil {
+					return nil, err
+				}
+				groups = append(groups, g)
+			}
+
+			if err := rows.Close(); err != nil {
+				return nil, zerrors.ThrowInternal(err, "QUERY-Gq2cl", "Errors.Query.CloseRows")
+			}
+
+			return &Groups{
+				Groups: groups,
+				SearchResponse: SearchResponse{
+					Count: count,
+				},
+			}, nil
+		}
+}
+
+func prepareGroupMembersQuery(ctx context.Context, db prepareDatabase) (sq.SelectBuilder, func(*sql.Rows) (*GroupMembers, error)) {
+	return sq.Select(
+			GroupMemberColumnGroupID.identifier(),
+			GroupMemberColumnUserID.identifier(),
+			GroupMemberColumnCreationDate.identifier(),
+			GroupMemberColumnSequence.identifier(),
+			GroupMemberColumnResourceOwner.identifier(),
+			countColumn.identifier(),
+		).
+			From(groupMembersTable.identifier() + db.Timetravel(call.Took(ctx))).
+			PlaceholderFormat(sq.Dollar),
+		func(rows *sql.Rows) (*GroupMembers, error) {
+			members := make([]*GroupMember, 0)
+			var count uint64
+			for rows.Next() {
+				m := new(GroupMember)
+				err := rows.Scan(
+					&m.GroupID,
+					&m.UserID,
+					&m.CreationDate,
+					&m.Sequence,
+					&m.ResourceOwner,
+					&count,
+				)
+				if err != nil {
+					return nil, err
+				}
+				members = append(members, m)
+			}
+
+			if err := rows.Close(); err != nil {
+				return nil, zerrors.ThrowInternal(err, "QUERY-Gq3cl", "Errors.Query.CloseRows")
+			}
+
+			return &GroupMembers{
+				Members: members,
+				SearchResponse: SearchResponse{
+					Count: count,
+				},
+			}, nil
+		}
+}
+
+func prepareGroupGrantsQuery(ctx context.Context, db prepareDatabase) (sq.SelectBuilder, func(*sql.Rows) (*GroupGrants, error)) {
+	return sq.Select(
+			GroupGrantColumnGroupID.identifier(),
+			GroupGrantColumnProjectID.identifier(),
+			GroupGrantColumnProjectGrantID.identifier(),
+			GroupGrantColumnRoles.identifier(),
+			GroupGrantColumnCreationDate.identifier(),
+			GroupGrantColumnChangeDate.identifier(),
+			GroupGrantColumnSequence.identifier(),
+			GroupGrantColumnResourceOwner.identifier(),
+			countColumn.identifier(),
+		).
+			From(groupGrantsTable.identifier() + db.Timetravel(call.Took(ctx))).
+			PlaceholderFormat(sq.Dollar),
+		func(rows *sql.Rows) (*GroupGrants, error) {
+			grants := make([]*GroupGrant, 0)
+			var count uint64
+			for rows.Next() {
+				g := new(GroupGrant)
+				err := rows.Scan(
+					&g.GroupID,
+					&g.ProjectID,
+					&g.ProjectGrantID,
+					&g.RoleKeys,
+					&g.CreationDate,
+					&g.ChangeDate,
+					&g.Sequence,
+					&g.ResourceOwner,
+					&count,
+				)
+				if err != nil {
+					return nil, err
+				}
+				grants = append(grants, g)
+			}
+
+			if err := rows.Close(); err != nil {
+				return nil, zerrors.ThrowInternal(err, "QUERY-Gq4cl", "Errors.Query.CloseRows")
+			}
+
+			return &GroupGrants{
+				Grants: grants,
+				SearchResponse: SearchResponse{
+					Count: count,
+				},
+			}, nil
+		}
+}
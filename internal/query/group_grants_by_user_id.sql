@@ -0,0 +1,18 @@
+-- find the groups of the user including all parent groups, members inherit the grants of the parents
+with recursive user_groups as (
+	select g.id, g.parent_id
+	from projections.groups1_members m
+	join projections.groups1 g on g.id = m.group_id and g.instance_id = m.instance_id
+	where m.user_id = $1
+	and m.instance_id = $2
+	union
+	select g.id, g.parent_id
+	from projections.groups1 g
+	join user_groups ug on g.id = ug.parent_id
+	where g.instance_id = $2
+)
+select group_id, project_grant_id, creation_date, change_date, sequence, $1, roles, resource_owner, project_id
+from projections.groups1_grants
+where group_id in (select id from user_groups)
+and instance_id = $2
+and project_id = $3;
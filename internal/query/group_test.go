@@ -0,0 +1,339 @@
+package query
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/zitadel/zitadel/internal/database"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+var (
+	prepareGroupStmt = `SELECT projections.groups1.id,` +
+		` projections.groups1.creation_date,` +
+		` projections.groups1.change_date,` +
+		` projections.groups1.sequence,` +
+		` projections.groups1.resource_owner,` +
+		` projections.groups1.name,` +
+		` projections.groups1.description,` +
+		` projections.groups1.parent_id` +
+		` FROM projections.groups1` +
+		` AS OF SYSTEM TIME '-1 ms'`
+	prepareGroupCols = []string{
+		"id",
+		"creation_date",
+		"change_date",
+		"sequence",
+		"resource_owner",
+		"name",
+		"description",
+		"parent_id",
+	}
+	prepareGroupsStmt = `SELECT projections.groups1.id,` +
+		` projections.groups1.creation_date,` +
+		` projections.groups1.change_date,` +
+		` projections.groups1.sequence,` +
+		` projections.groups1.resource_owner,` +
+		` projections.groups1.name,` +
+		` projections.groups1.description,` +
+		` projections.groups1.parent_id,` +
+		` COUNT(*) OVER ()` +
+		` FROM projections.groups1` +
+		` AS OF SYSTEM TIME '-1 ms'`
+	prepareGroupsCols = []string{
+		"id",
+		"creation_date",
+		"change_date",
+		"sequence",
+		"resource_owner",
+		"name",
+		"description",
+		"parent_id",
+		"count",
+	}
+
+	prepareGroupMembersStmt = `SELECT projections.groups1_members.group_id,` +
+		` projections.groups1_members.user_id,` +
+		` projections.groups1_members.creation_date,` +
+		` projections.groups1_members.sequence,` +
+		` projections.groups1_members.resource_owner,` +
+		` COUNT(*) OVER ()` +
+		` FROM projections.groups1_members` +
+		` AS OF SYSTEM TIME '-1 ms'`
+	prepareGroupMembersCols = []string{
+		"group_id",
+		"user_id",
+		"creation_date",
+		"sequence",
+		"resource_owner",
+		"count",
+	}
+
+	prepareGroupGrantsStmt = `SELECT projections.groups1_grants.group_id,` +
+		` projections.groups1_grants.project_id,` +
+		` projections.groups1_grants.project_grant_id,` +
+		` projections.groups1_grants.roles,` +
+		` projections.groups1_grants.creation_date,` +
+		` projections.groups1_grants.change_date,` +
+		` projections.groups1_grants.sequence,` +
+		` projections.groups1_grants.resource_owner,` +
+		` COUNT(*) OVER ()` +
+		` FROM projections.groups1_grants` +
+		` AS OF SYSTEM TIME '-1 ms'`
+	prepareGroupGrantsCols = []string{
+		"group_id",
+		"project_id",
+		"project_grant_id",
+		"roles",
+		"creation_date",
+		"change_date",
+		"sequence",
+		"resource_owner",
+		"count",
+	}
+)
+
+func Test_GroupPrepares(t *testing.T) {
+	type want struct {
+		sqlExpectations sqlExpectation
+		err             checkErr
+	}
+	tests := []struct {
+		name    string
+		prepare interface{}
+		want    want
+		object  interface{}
+	}{
+		{
+			name:    "prepareGroupQuery no result",
+			prepare: prepareGroupQuery,
+			want: want{
+				sqlExpectations: mockQueriesScanErr(
+					regexp.QuoteMeta(prepareGroupStmt),
+					nil,
+					nil,
+				),
+				err: func(err error) (error, bool) {
+					if !zerrors.IsNotFound(err) {
+						return fmt.Errorf("err should be zitadel.NotFoundError got: %w", err), false
+					}
+					return nil, true
+				},
+			},
+			object: (*Group)(nil),
+		},
+		{
+			name:    "prepareGroupQuery found",
+			prepare: prepareGroupQuery,
+			want: want{
+				sqlExpectations: mockQuery(
+					regexp.QuoteMeta(prepareGroupStmt),
+					prepareGroupCols,
+					[]driver.Value{
+						"group-id",
+						testNow,
+						testNow,
+						uint64(20211111),
+						"ro",
+						"name",
+						"description",
+						"parent-id",
+					},
+				),
+			},
+			object: &Group{
+				ID:            "group-id",
+				CreationDate:  testNow,
+				ChangeDate:    testNow,
+				Sequence:      20211111,
+				ResourceOwner: "ro",
+				Name:          "name",
+				Description:   "description",
+				ParentID:      "parent-id",
+			},
+		},
+		{
+			name:    "prepareGroupQuery sql err",
+			prepare: prepareGroupQuery,
+			want: want{
+				sqlExpectations: mockQueryErr(
+					regexp.QuoteMeta(prepareGroupStmt),
+					sql.ErrConnDone,
+				),
+				err: func(err error) (error, bool) {
+					if !errors.Is(err, sql.ErrConnDone) {
+						return fmt.Errorf("err should be sql.ErrConnDone got: %w", err), false
+					}
+					return nil, true
+				},
+			},
+			object: (*Group)(nil),
+		},
+		{
+			name:    "prepareGroupsQuery no result",
+			prepare: prepareGroupsQuery,
+			want: want{
+				sqlExpectations: mockQueries(
+					regexp.QuoteMeta(prepareGroupsStmt),
+					nil,
+					nil,
+				),
+			},
+			object: &Groups{Groups: []*Group{}},
+		},
+		{
+			name:    "prepareGroupsQuery multiple result",
+			prepare: prepareGroupsQuery,
+			want: want{
+				sqlExpectations: mockQueries(
+					regexp.QuoteMeta(prepareGroupsStmt),
+					prepareGroupsCols,
+					[][]driver.Value{
+						{
+							"group-id-1",
+							testNow,
+							testNow,
+							uint64(20211111),
+							"ro",
+							"name-1",
+							"",
+							"",
+						},
+						{
+							"group-id-2",
+							testNow,
+							testNow,
+							uint64(20211111),
+							"ro",
+							"name-2",
+							"description",
+							"group-id-1",
+						},
+					},
+				),
+			},
+			object: &Groups{
+				SearchResponse: SearchResponse{
+					Count: 2,
+				},
+				Groups: []*Group{
+					{
+						ID:            "group-id-1",
+						CreationDate:  testNow,
+						ChangeDate:    testNow,
+						Sequence:      20211111,
+						ResourceOwner: "ro",
+						Name:          "name-1",
+					},
+					{
+						ID:            "group-id-2",
+						CreationDate:  testNow,
+						ChangeDate:    testNow,
+						Sequence:      20211111,
+						ResourceOwner: "ro",
+						Name:          "name-2",
+						Description:   "description",
+						ParentID:      "group-id-1",
+					},
+				},
+			},
+		},
+		{
+			name:    "prepareGroupsQuery sql err",
+			prepare: prepareGroupsQuery,
+			want: want{
+				sqlExpectations: mockQueryErr(
+					regexp.QuoteMeta(prepareGroupsStmt),
+					sql.ErrConnDone,
+				),
+				err: func(err error) (error, bool) {
+					if !errors.Is(err, sql.ErrConnDone) {
+						return fmt.Errorf("err should be sql.ErrConnDone got: %w", err), false
+					}
+					return nil, true
+				},
+			},
+			object: (*Groups)(nil),
+		},
+		{
+			name:    "prepareGroupMembersQuery one result",
+			prepare: prepareGroupMembersQuery,
+			want: want{
+				sqlExpectations: mockQueries(
+					regexp.QuoteMeta(prepareGroupMembersStmt),
+					prepareGroupMembersCols,
+					[][]driver.Value{
+						{
+							"group-id",
+							"user-id",
+							testNow,
+							uint64(20211111),
+							"ro",
+						},
+					},
+				),
+			},
+			object: &GroupMembers{
+				SearchResponse: SearchResponse{
+					Count: 1,
+				},
+				Members: []*GroupMember{
+					{
+						GroupID:       "group-id",
+						UserID:        "user-id",
+						CreationDate:  testNow,
+						Sequence:      20211111,
+						ResourceOwner: "ro",
+					},
+				},
+			},
+		},
+		{
+			name:    "prepareGroupGrantsQuery one result",
+			prepare: prepareGroupGrantsQuery,
+			want: want{
+				sqlExpectations: mockQueries(
+					regexp.QuoteMeta(prepareGroupGrantsStmt),
+					prepareGroupGrantsCols,
+					[][]driver.Value{
+						{
+							"group-id",
+							"project-id",
+							"",
+							database.TextArray[string]{"role-key"},
+							testNow,
+							testNow,
+							uint64(20211111),
+							"ro",
+						},
+					},
+				),
+			},
+			object: &GroupGrants{
+				SearchResponse: SearchResponse{
+					Count: 1,
+				},
+				Grants: []*GroupGrant{
+					{
+						GroupID:       "group-id",
+						ProjectID:     "project-id",
+						RoleKeys:      database.TextArray[string]{"role-key"},
+						CreationDate:  testNow,
+						ChangeDate:    testNow,
+						Sequence:      20211111,
+						ResourceOwner: "ro",
+					},
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assertPrepare(t, tt.prepare, tt.object, tt.want.sqlExpectations, tt.want.err, defaultPrepareArgs...)
+		})
+	}
+}
@@ -0,0 +1,93 @@
+package query
+
+import (
+	"context"
+	"time"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/repository/instance"
+	"github.com/zitadel/zitadel/internal/repository/org"
+	"github.com/zitadel/zitadel/internal/telemetry/tracing"
+)
+
+type IDPAutoGrants struct {
+	IDPID         string
+	ResourceOwner string
+	ChangeDate    time.Time
+	Sequence      uint64
+
+	Grants []*domain.IDPAutoGrant
+}
+
+// IDPAutoGrantsByID returns the project roles granted to users registered with the identity provider.
+// resourceOwner is the organization of the identity provider or the instance for identity providers of the instance.
+// Identity providers without auto grants return an empty list of grants.
+func (q *Queries) IDPAutoGrantsByID(ctx context.Context, idpID, resourceOwner string) (_ *IDPAutoGrants, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	model := newIDPAutoGrantsReadModel(authz.GetInstance(ctx).InstanceID(), idpID, resourceOwner)
+	if err = q.eventstore.FilterToQueryReducer(ctx, model); err != nil {
+		return nil, err
+	}
+	return model.grants, nil
+}
+
+type idpAutoGrantsReadModel struct {
+	eventstore.ReadModel
+
+	grants *IDPAutoGrants
+}
+
+func newIDPAutoGrantsReadModel(instanceID, idpID, resourceOwner string) *idpAutoGrantsReadModel {
+	return &idpAutoGrantsReadModel{
+		ReadModel: eventstore.ReadModel{
+			AggregateID:   resourceOwner,
+			ResourceOwner: resourceOwner,
+			InstanceID:    instanceID,
+		},
+		grants: &IDPAutoGrants{
+			IDPID:         idpID,
+			ResourceOwner: resourceOwner,
+		},
+	}
+}
+
+func (rm *idpAutoGrantsReadModel) Reduce() error {
+	for _, event := range rm.Events {
+		switch e := event.(type) {
+		case *org.IDPAutoGrantsSetEvent:
+			rm.reduceSet(e, e.Grants)
+		case *instance.IDPAutoGrantsSetEvent:
+			rm.reduceSet(e, e.Grants)
+		case *org.IDPRemovedEvent, *instance.IDPRemovedEvent:
+			rm.reduceSet(e, nil)
+		}
+	}
+	return rm.ReadModel.Reduce()
+}
+
+func (rm *idpAutoGrantsReadModel) reduceSet(event eventstore.Event, grants []*domain.IDPAutoGrant) {
+	rm.grants.ChangeDate = event.CreatedAt()
+	rm.grants.Sequence = event.Sequence()
+	rm.grants.Grants = grants
+}
+
+func (rm *idpAutoGrantsReadModel) Query() *eventstore.SearchQueryBuilder {
+	return eventstore.NewSearchQueryBuilder(eventstore.ColumnsEvent).
+		AwaitOpenTransactions().
+		InstanceID(rm.InstanceID).
+		AddQuery().
+		AggregateTypes(org.AggregateType, instance.AggregateType).
+		AggregateIDs(rm.AggregateID).
+		EventTypes(
+			org.IDPAutoGrantsSetEventType,
+			org.IDPRemovedEventType,
+			instance.IDPAutoGrantsSetEventType,
+			instance.IDPRemovedEventType,
+		).
+		EventData(map[string]interface{}{"id": rm.grants.IDPID}).
+		Builder()
+}
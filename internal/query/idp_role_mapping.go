@@ -0,0 +1,93 @@
+package query
+
+import (
+	"context"
+	"time"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/repository/instance"
+	"github.com/zitadel/zitadel/internal/repository/org"
+	"github.com/zitadel/zitadel/internal/telemetry/tracing"
+)
+
+type IDPRoleMapping struct {
+	IDPID         string
+	ResourceOwner string
+	ChangeDate    time.Time
+	Sequence      uint64
+
+	Mappings []*domain.IDPRoleMapping
+}
+
+// IDPRoleMappingByID returns the role mapping of the identity provider.
+// resourceOwner is the organization of the identity provider or the instance for identity providers of the instance.
+// Identity providers without role mapping return an empty list of mappings.
+func (q *Queries) IDPRoleMappingByID(ctx context.Context, idpID, resourceOwner string) (_ *IDPRoleMapping, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	model := newIDPRoleMappingReadModel(authz.GetInstance(ctx).InstanceID(), idpID, resourceOwner)
+	if err = q.eventstore.FilterToQueryReducer(ctx, model); err != nil {
+		return nil, err
+	}
+	return model.mapping, nil
+}
+
+type idpRoleMappingReadModel struct {
+	eventstore.ReadModel
+
+	mapping *IDPRoleMapping
+}
+
+func newIDPRoleMappingReadModel(instanceID, idpID, resourceOwner string) *idpRoleMappingReadModel {
+	return &idpRoleMappingReadModel{
+		ReadModel: eventstore.ReadModel{
+			AggregateID:   resourceOwner,
+			ResourceOwner: resourceOwner,
+			InstanceID:    instanceID,
+		},
+		mapping: &IDPRoleMapping{
+			IDPID:         idpID,
+			ResourceOwner: resourceOwner,
+		},
+	}
+}
+
+func (rm *idpRoleMappingReadModel) Reduce() error {
+	for _, event := range rm.Events {
+		switch e := event.(type) {
+		case *org.IDPRoleMappingSetEvent:
+			rm.reduceSet(e, e.Mappings)
+		case *instance.IDPRoleMappingSetEvent:
+			rm.reduceSet(e, e.Mappings)
+		case *org.IDPRemovedEvent, *instance.IDPRemovedEvent:
+			rm.reduceSet(e, nil)
+		}
+	}
+	return rm.ReadModel.Reduce()
+}
+
+func (rm *idpRoleMappingReadModel) reduceSet(event eventstore.Event, mappings []*domain.IDPRoleMapping) {
+	rm.mapping.ChangeDate = event.CreatedAt()
+	rm.mapping.Sequence = event.Sequence()
+	rm.mapping.Mappings = mappings
+}
+
+func (rm *idpRoleMappingReadModel) Query() *eventstore.SearchQueryBuilder {
+	return eventstore.NewSearchQueryBuilder(eventstore.ColumnsEvent).
+		AwaitOpenTransactions().
+		InstanceID(rm.InstanceID).
+		AddQuery().
+		AggregateTypes(org.AggregateType, instance.AggregateType).
+		AggregateIDs(rm.AggregateID).
+		EventTypes(
+			org.IDPRoleMappingSetEventType,
+			org.IDPRemovedEventType,
+			instance.IDPRoleMappingSetEventType,
+			instance.IDPRemovedEventType,
+		).
+		EventData(map[string]interface{}{"id": rm.mapping.IDPID}).
+		Builder()
+}
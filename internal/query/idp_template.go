@@ -3,6 +3,7 @@ package query
 import (
 	"context"
 	"database/sql"
+	"encoding/json"
 	"errors"
 	"time"
 
@@ -64,6 +65,7 @@ type OAuthIDPTemplate struct {
 	UserEndpoint          string
 	Scopes                database.TextArray[string]
 	IDAttribute           string
+	AttributeMapping      *domain.OAuthAttributeMapping
 }
 
 type OIDCIDPTemplate struct {
@@ -90,6 +92,8 @@ type AzureADIDPTemplate struct {
 	Scopes          database.TextArray[string]
 	Tenant          string
 	IsEmailVerified bool
+	AllowedTenants  database.TextArray[string]
+	FetchGroups     bool
 }
 
 type GitHubIDPTemplate struct {
@@ -142,6 +146,11 @@ type LDAPIDPTemplate struct {
 	UserObjectClasses []string
 	UserFilters       []string
 	Timeout           time.Duration
+	RootCA            []byte
+
+	GroupResolution    domain.LDAPGroupResolution
+	GroupBase          string
+	GroupNameAttribute string
 	idp.LDAPAttributes
 }
 
@@ -277,6 +286,10 @@ var (
 		name:  projection.OAuthIDAttributeCol,
 		table: oauthIdpTemplateTable,
 	}
+	OAuthAttributeMappingCol = Column{
+		name:  projection.OAuthAttributeMappingCol,
+		table: oauthIdpTemplateTable,
+	}
 )
 
 var (
@@ -378,6 +391,14 @@ var (
 		name:  projection.AzureADIsEmailVerified,
 		table: azureadIdpTemplateTable,
 	}
+	AzureADAllowedTenants = Column{
+		name:  projection.AzureADAllowedTenants,
+		table: azureadIdpTemplateTable,
+	}
+	AzureADFetchGroups = Column{
+		name:  projection.AzureADFetchGroups,
+		table: azureadIdpTemplateTable,
+	}
 )
 
 var (
@@ -632,6 +653,26 @@ var (
 		name:  projection.LDAPProfileAttributeCol,
 		table: ldapIdpTemplateTable,
 	}
+	LDAPMetadataAttributesCol = Column{
+		name:  projection.LDAPMetadataAttributesCol,
+		table: ldapIdpTemplateTable,
+	}
+	LDAPRootCACol = Column{
+		name:  projection.LDAPRootCACol,
+		table: ldapIdpTemplateTable,
+	}
+	LDAPGroupResolutionCol = Column{
+		name:  projection.LDAPGroupResolutionCol,
+		table: ldapIdpTemplateTable,
+	}
+	LDAPGroupBaseCol = Column{
+		name:  projection.LDAPGroupBaseCol,
+		table: ldapIdpTemplateTable,
+	}
+	LDAPGroupNameAttributeCol = Column{
+		name:  projection.LDAPGroupNameAttributeCol,
+		table: ldapIdpTemplateTable,
+	}
 )
 
 var (
@@ -837,6 +878,7 @@ func prepareIDPTemplateByIDQuery(ctx context.Context, db prepareDatabase) (sq.Se
 			OAuthUserEndpointCol.identifier(),
 			OAuthScopesCol.identifier(),
 			OAuthIDAttributeCol.identifier(),
+			OAuthAttributeMappingCol.identifier(),
 			// oidc
 			OIDCIDCol.identifier(),
 			OIDCIssuerCol.identifier(),
@@ -857,6 +899,8 @@ func prepareIDPTemplateByIDQuery(ctx context.Context, db prepareDatabase) (sq.Se
 			AzureADScopesCol.identifier(),
 			AzureADTenantCol.identifier(),
 			AzureADIsEmailVerified.identifier(),
+			AzureADAllowedTenants.identifier(),
+			AzureADFetchGroups.identifier(),
 			// github
 			GitHubIDCol.identifier(),
 			GitHubClientIDCol.identifier(),
@@ -919,6 +963,11 @@ func prepareIDPTemplateByIDQuery(ctx context.Context, db prepareDatabase) (sq.Se
 			LDAPPreferredLanguageAttributeCol.identifier(),
 			LDAPAvatarURLAttributeCol.identifier(),
 			LDAPProfileAttributeCol.identifier(),
+			LDAPMetadataAttributesCol.identifier(),
+			LDAPRootCACol.identifier(),
+			LDAPGroupResolutionCol.identifier(),
+			LDAPGroupBaseCol.identifier(),
+			LDAPGroupNameAttributeCol.identifier(),
 			// apple
 			AppleIDCol.identifier(),
 			AppleClientIDCol.identifier(),
@@ -953,6 +1002,7 @@ func prepareIDPTemplateByIDQuery(ctx context.Context, db prepareDatabase) (sq.Se
 			oauthUserEndpoint := sql.NullString{}
 			oauthScopes := database.TextArray[string]{}
 			oauthIDAttribute := sql.NullString{}
+			var oauthAttributeMapping []byte
 
 			oidcID := sql.NullString{}
 			oidcIssuer := sql.NullString{}
@@ -973,6 +1023,8 @@ func prepareIDPTemplateByIDQuery(ctx context.Context, db prepareDatabase) (sq.Se
 			azureadScopes := database.TextArray[string]{}
 			azureadTenant := sql.NullString{}
 			azureadIsEmailVerified := sql.NullBool{}
+			azureadAllowedTenants := database.TextArray[string]{}
+			azureadFetchGroups := sql.NullBool{}
 
 			githubID := sql.NullString{}
 			githubClientID := sql.NullString{}
@@ -1035,6 +1087,11 @@ func prepareIDPTemplateByIDQuery(ctx context.Context, db prepareDatabase) (sq.Se
 			ldapPreferredLanguageAttribute := sql.NullString{}
 			ldapAvatarURLAttribute := sql.NullString{}
 			ldapProfileAttribute := sql.NullString{}
+			var ldapMetadataAttributes []byte
+			var ldapRootCA []byte
+			ldapGroupResolution := sql.NullInt16{}
+			ldapGroupBase := sql.NullString{}
+			ldapGroupNameAttribute := sql.NullString{}
 
 			appleID := sql.NullString{}
 			appleClientID := sql.NullString{}
@@ -1067,6 +1124,7 @@ func prepareIDPTemplateByIDQuery(ctx context.Context, db prepareDatabase) (sq.Se
 				&oauthUserEndpoint,
 				&oauthScopes,
 				&oauthIDAttribute,
+				&oauthAttributeMapping,
 				// oidc
 				&oidcID,
 				&oidcIssuer,
@@ -1087,6 +1145,8 @@ func prepareIDPTemplateByIDQuery(ctx context.Context, db prepareDatabase) (sq.Se
 				&azureadScopes,
 				&azureadTenant,
 				&azureadIsEmailVerified,
+				&azureadAllowedTenants,
+				&azureadFetchGroups,
 				// github
 				&githubID,
 				&githubClientID,
@@ -1149,6 +1209,11 @@ func prepareIDPTemplateByIDQuery(ctx context.Context, db prepareDatabase) (sq.Se
 				&ldapPreferredLanguageAttribute,
 				&ldapAvatarURLAttribute,
 				&ldapProfileAttribute,
+				&ldapMetadataAttributes,
+				&ldapRootCA,
+				&ldapGroupResolution,
+				&ldapGroupBase,
+				&ldapGroupNameAttribute,
 				// apple
 				&appleID,
 				&appleClientID,
@@ -1167,6 +1232,10 @@ func prepareIDPTemplateByIDQuery(ctx context.Context, db prepareDatabase) (sq.Se
 			idpTemplate.Name = name.String
 
 			if oauthID.Valid {
+				attributeMapping, err := oauthAttributeMappingFromJSON(oauthAttributeMapping)
+				if err != nil {
+					return nil, err
+				}
 				idpTemplate.OAuthIDPTemplate = &OAuthIDPTemplate{
 					IDPID:                 oauthID.String,
 					ClientID:              oauthClientID.String,
@@ -1176,6 +1245,7 @@ func prepareIDPTemplateByIDQuery(ctx context.Context, db prepareDatabase) (sq.Se
 					UserEndpoint:          oauthUserEndpoint.String,
 					Scopes:                oauthScopes,
 					IDAttribute:           oauthIDAttribute.String,
+					AttributeMapping:      attributeMapping,
 				}
 			}
 			if oidcID.Valid {
@@ -1205,6 +1275,8 @@ func prepareIDPTemplateByIDQuery(ctx context.Context, db prepareDatabase) (sq.Se
 					Scopes:          azureadScopes,
 					Tenant:          azureadTenant.String,
 					IsEmailVerified: azureadIsEmailVerified.Bool,
+					AllowedTenants:  azureadAllowedTenants,
+					FetchGroups:     azureadFetchGroups.Bool,
 				}
 			}
 			if githubID.Valid {
@@ -1264,17 +1336,25 @@ func prepareIDPTemplateByIDQuery(ctx context.Context, db prepareDatabase) (sq.Se
 				}
 			}
 			if ldapID.Valid {
+				metadataAttributes, err := ldapMetadataAttributesFromJSON(ldapMetadataAttributes)
+				if err != nil {
+					return nil, err
+				}
 				idpTemplate.LDAPIDPTemplate = &LDAPIDPTemplate{
-					IDPID:             ldapID.String,
-					Servers:           ldapServers,
-					StartTLS:          ldapStartTls.Bool,
-					BaseDN:            ldapBaseDN.String,
-					BindDN:            ldapBindDN.String,
-					BindPassword:      ldapBindPassword,
-					UserBase:          ldapUserBase.String,
-					UserObjectClasses: ldapUserObjectClasses,
-					UserFilters:       ldapUserFilters,
-					Timeout:           time.Duration(ldapTimeout.Int64),
+					IDPID:              ldapID.String,
+					Servers:            ldapServers,
+					StartTLS:           ldapStartTls.Bool,
+					BaseDN:             ldapBaseDN.String,
+					BindDN:             ldapBindDN.String,
+					BindPassword:       ldapBindPassword,
+					UserBase:           ldapUserBase.String,
+					UserObjectClasses:  ldapUserObjectClasses,
+					UserFilters:        ldapUserFilters,
+					Timeout:            time.Duration(ldapTimeout.Int64),
+					RootCA:             ldapRootCA,
+					GroupResolution:    domain.LDAPGroupResolution(ldapGroupResolution.Int16),
+					GroupBase:          ldapGroupBase.String,
+					GroupNameAttribute: ldapGroupNameAttribute.String,
 					LDAPAttributes: idp.LDAPAttributes{
 						IDAttribute:                ldapIDAttribute.String,
 						FirstNameAttribute:         ldapFirstNameAttribute.String,
@@ -1289,6 +1369,7 @@ func prepareIDPTemplateByIDQuery(ctx context.Context, db prepareDatabase) (sq.Se
 						PreferredLanguageAttribute: ldapPreferredLanguageAttribute.String,
 						AvatarURLAttribute:         ldapAvatarURLAttribute.String,
 						ProfileAttribute:           ldapProfileAttribute.String,
+						MetadataAttributes:         metadataAttributes,
 					},
 				}
 			}
@@ -1332,6 +1413,7 @@ func prepareIDPTemplatesQuery(ctx context.Context, db prepareDatabase) (sq.Selec
 			OAuthUserEndpointCol.identifier(),
 			OAuthScopesCol.identifier(),
 			OAuthIDAttributeCol.identifier(),
+			OAuthAttributeMappingCol.identifier(),
 			// oidc
 			OIDCIDCol.identifier(),
 			OIDCIssuerCol.identifier(),
@@ -1352,6 +1434,8 @@ func prepareIDPTemplatesQuery(ctx context.Context, db prepareDatabase) (sq.Selec
 			AzureADScopesCol.identifier(),
 			AzureADTenantCol.identifier(),
 			AzureADIsEmailVerified.identifier(),
+			AzureADAllowedTenants.identifier(),
+			AzureADFetchGroups.identifier(),
 			// github
 			GitHubIDCol.identifier(),
 			GitHubClientIDCol.identifier(),
@@ -1414,6 +1498,11 @@ func prepareIDPTemplatesQuery(ctx context.Context, db prepareDatabase) (sq.Selec
 			LDAPPreferredLanguageAttributeCol.identifier(),
 			LDAPAvatarURLAttributeCol.identifier(),
 			LDAPProfileAttributeCol.identifier(),
+			LDAPMetadataAttributesCol.identifier(),
+			LDAPRootCACol.identifier(),
+			LDAPGroupResolutionCol.identifier(),
+			LDAPGroupBaseCol.identifier(),
+			LDAPGroupNameAttributeCol.identifier(),
 			// apple
 			AppleIDCol.identifier(),
 			AppleClientIDCol.identifier(),
@@ -1453,6 +1542,7 @@ func prepareIDPTemplatesQuery(ctx context.Context, db prepareDatabase) (sq.Selec
 				oauthUserEndpoint := sql.NullString{}
 				oauthScopes := database.TextArray[string]{}
 				oauthIDAttribute := sql.NullString{}
+				var oauthAttributeMapping []byte
 
 				oidcID := sql.NullString{}
 				oidcIssuer := sql.NullString{}
@@ -1473,6 +1563,8 @@ func prepareIDPTemplatesQuery(ctx context.Context, db prepareDatabase) (sq.Selec
 				azureadScopes := database.TextArray[string]{}
 				azureadTenant := sql.NullString{}
 				azureadIsEmailVerified := sql.NullBool{}
+				azureadAllowedTenants := database.TextArray[string]{}
+				azureadFetchGroups := sql.NullBool{}
 
 				githubID := sql.NullString{}
 				githubClientID := sql.NullString{}
@@ -1535,6 +1627,11 @@ func prepareIDPTemplatesQuery(ctx context.Context, db prepareDatabase) (sq.Selec
 				ldapPreferredLanguageAttribute := sql.NullString{}
 				ldapAvatarURLAttribute := sql.NullString{}
 				ldapProfileAttribute := sql.NullString{}
+				var ldapMetadataAttributes []byte
+				var ldapRootCA []byte
+				ldapGroupResolution := sql.NullInt16{}
+				ldapGroupBase := sql.NullString{}
+				ldapGroupNameAttribute := sql.NullString{}
 
 				appleID := sql.NullString{}
 				appleClientID := sql.NullString{}
@@ -1567,6 +1664,7 @@ func prepareIDPTemplatesQuery(ctx context.Context, db prepareDatabase) (sq.Selec
 					&oauthUserEndpoint,
 					&oauthScopes,
 					&oauthIDAttribute,
+					&oauthAttributeMapping,
 					// oidc
 					&oidcID,
 					&oidcIssuer,
@@ -1587,6 +1685,8 @@ func prepareIDPTemplatesQuery(ctx context.Context, db prepareDatabase) (sq.Selec
 					&azureadScopes,
 					&azureadTenant,
 					&azureadIsEmailVerified,
+					&azureadAllowedTenants,
+					&azureadFetchGroups,
 					// github
 					&githubID,
 					&githubClientID,
@@ -1649,6 +1749,11 @@ func prepareIDPTemplatesQuery(ctx context.Context, db prepareDatabase) (sq.Selec
 					&ldapPreferredLanguageAttribute,
 					&ldapAvatarURLAttribute,
 					&ldapProfileAttribute,
+					&ldapMetadataAttributes,
+					&ldapRootCA,
+					&ldapGroupResolution,
+					&ldapGroupBase,
+					&ldapGroupNameAttribute,
 					// apple
 					&appleID,
 					&appleClientID,
@@ -1666,6 +1771,10 @@ func prepareIDPTemplatesQuery(ctx context.Context, db prepareDatabase) (sq.Selec
 				idpTemplate.Name = name.String
 
 				if oauthID.Valid {
+					attributeMapping, err := oauthAttributeMappingFromJSON(oauthAttributeMapping)
+					if err != nil {
+						return nil, err
+					}
 					idpTemplate.OAuthIDPTemplate = &OAuthIDPTemplate{
 						IDPID:                 oauthID.String,
 						ClientID:              oauthClientID.String,
@@ -1675,6 +1784,7 @@ func prepareIDPTemplatesQuery(ctx context.Context, db prepareDatabase) (sq.Selec
 						UserEndpoint:          oauthUserEndpoint.String,
 						Scopes:                oauthScopes,
 						IDAttribute:           oauthIDAttribute.String,
+						AttributeMapping:      attributeMapping,
 					}
 				}
 				if oidcID.Valid {
@@ -1704,6 +1814,8 @@ func prepareIDPTemplatesQuery(ctx context.Context, db prepareDatabase) (sq.Selec
 						Scopes:          azureadScopes,
 						Tenant:          azureadTenant.String,
 						IsEmailVerified: azureadIsEmailVerified.Bool,
+						AllowedTenants:  azureadAllowedTenants,
+						FetchGroups:     azureadFetchGroups.Bool,
 					}
 				}
 				if githubID.Valid {
@@ -1763,17 +1875,25 @@ func prepareIDPTemplatesQuery(ctx context.Context, db prepareDatabase) (sq.Selec
 					}
 				}
 				if ldapID.Valid {
+					metadataAttributes, err := ldapMetadataAttributesFromJSON(ldapMetadataAttributes)
+					if err != nil {
+						return nil, err
+					}
 					idpTemplate.LDAPIDPTemplate = &LDAPIDPTemplate{
-						IDPID:             ldapID.String,
-						Servers:           ldapServers,
-						StartTLS:          ldapStartTls.Bool,
-						BaseDN:            ldapBaseDN.String,
-						BindDN:            ldapBindDN.String,
-						BindPassword:      ldapBindPassword,
-						UserBase:          ldapUserBase.String,
-						UserObjectClasses: ldapUserObjectClasses,
-						UserFilters:       ldapUserFilters,
-						Timeout:           time.Duration(ldapTimeout.Int64),
+						IDPID:              ldapID.String,
+						Servers:            ldapServers,
+						StartTLS:           ldapStartTls.Bool,
+						BaseDN:             ldapBaseDN.String,
+						BindDN:             ldapBindDN.String,
+						BindPassword:       ldapBindPassword,
+						UserBase:           ldapUserBase.String,
+						UserObjectClasses:  ldapUserObjectClasses,
+						UserFilters:        ldapUserFilters,
+						Timeout:            time.Duration(ldapTimeout.Int64),
+						RootCA:             ldapRootCA,
+						GroupResolution:    domain.LDAPGroupResolution(ldapGroupResolution.Int16),
+						GroupBase:          ldapGroupBase.String,
+						GroupNameAttribute: ldapGroupNameAttribute.String,
 						LDAPAttributes: idp.LDAPAttributes{
 							IDAttribute:                ldapIDAttribute.String,
 							FirstNameAttribute:         ldapFirstNameAttribute.String,
@@ -1788,6 +1908,7 @@ func prepareIDPTemplatesQuery(ctx context.Context, db prepareDatabase) (sq.Selec
 							PreferredLanguageAttribute: ldapPreferredLanguageAttribute.String,
 							AvatarURLAttribute:         ldapAvatarURLAttribute.String,
 							ProfileAttribute:           ldapProfileAttribute.String,
+							MetadataAttributes:         metadataAttributes,
 						},
 					}
 				}
@@ -1816,3 +1937,21 @@ func prepareIDPTemplatesQuery(ctx context.Context, db prepareDatabase) (sq.Selec
 			}, nil
 		}
 }
+
+func oauthAttributeMappingFromJSON(data []byte) (mapping *domain.OAuthAttributeMapping, err error) {
+	if len(data) == 0 {
+		return nil, nil
+	}
+	// a removed mapping is stored as JSON null, which leaves the mapping nil
+	err = json.Unmarshal(data, &mapping)
+	return mapping, err
+}
+
+func ldapMetadataAttributesFromJSON(data []byte) (attributes map[string]string, err error) {
+	if len(data) == 0 {
+		return nil, nil
+	}
+	// removed attributes are stored as JSON null, which leaves the map nil
+	err = json.Unmarshal(data, &attributes)
+	return attributes, err
+}
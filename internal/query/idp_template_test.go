@@ -39,6 +39,7 @@ var (
 		` projections.idp_templates6_oauth2.user_endpoint,` +
 		` projections.idp_templates6_oauth2.scopes,` +
 		` projections.idp_templates6_oauth2.id_attribute,` +
+		` projections.idp_templates6_oauth2.attribute_mapping,` +
 		// oidc
 		` projections.idp_templates6_oidc.idp_id,` +
 		` projections.idp_templates6_oidc.issuer,` +
@@ -59,6 +60,8 @@ var (
 		` projections.idp_templates6_azure.scopes,` +
 		` projections.idp_templates6_azure.tenant,` +
 		` projections.idp_templates6_azure.is_email_verified,` +
+		` projections.idp_templates6_azure.allowed_tenants,` +
+		` projections.idp_templates6_azure.fetch_groups,` +
 		// github
 		` projections.idp_templates6_github.idp_id,` +
 		` projections.idp_templates6_github.client_id,` +
@@ -121,6 +124,11 @@ var (
 		` projections.idp_templates6_ldap2.preferred_language_attribute,` +
 		` projections.idp_templates6_ldap2.avatar_url_attribute,` +
 		` projections.idp_templates6_ldap2.profile_attribute,` +
+		` projections.idp_templates6_ldap2.metadata_attributes,` +
+		` projections.idp_templates6_ldap2.root_ca,` +
+		` projections.idp_templates6_ldap2.group_resolution,` +
+		` projections.idp_templates6_ldap2.group_base,` +
+		` projections.idp_templates6_ldap2.group_name_attribute,` +
 		// apple
 		` projections.idp_templates6_apple.idp_id,` +
 		` projections.idp_templates6_apple.client_id,` +
@@ -166,6 +174,7 @@ var (
 		"user_endpoint",
 		"scopes",
 		"id_attribute",
+		"attribute_mapping",
 		// oidc config
 		"id_id",
 		"issuer",
@@ -186,6 +195,8 @@ var (
 		"scopes",
 		"tenant",
 		"is_email_verified",
+		"allowed_tenants",
+		"fetch_groups",
 		// github config
 		"idp_id",
 		"client_id",
@@ -248,6 +259,11 @@ var (
 		"preferred_language_attribute",
 		"avatar_url_attribute",
 		"profile_attribute",
+		"metadata_attributes",
+		"root_ca",
+		"group_resolution",
+		"group_base",
+		"group_name_attribute",
 		// apple config
 		"idp_id",
 		"client_id",
@@ -279,6 +295,7 @@ var (
 		` projections.idp_templates6_oauth2.user_endpoint,` +
 		` projections.idp_templates6_oauth2.scopes,` +
 		` projections.idp_templates6_oauth2.id_attribute,` +
+		` projections.idp_templates6_oauth2.attribute_mapping,` +
 		// oidc
 		` projections.idp_templates6_oidc.idp_id,` +
 		` projections.idp_templates6_oidc.issuer,` +
@@ -299,6 +316,8 @@ var (
 		` projections.idp_templates6_azure.scopes,` +
 		` projections.idp_templates6_azure.tenant,` +
 		` projections.idp_templates6_azure.is_email_verified,` +
+		` projections.idp_templates6_azure.allowed_tenants,` +
+		` projections.idp_templates6_azure.fetch_groups,` +
 		// github
 		` projections.idp_templates6_github.idp_id,` +
 		` projections.idp_templates6_github.client_id,` +
@@ -361,6 +380,11 @@ var (
 		` projections.idp_templates6_ldap2.preferred_language_attribute,` +
 		` projections.idp_templates6_ldap2.avatar_url_attribute,` +
 		` projections.idp_templates6_ldap2.profile_attribute,` +
+		` projections.idp_templates6_ldap2.metadata_attributes,` +
+		` projections.idp_templates6_ldap2.root_ca,` +
+		` projections.idp_templates6_ldap2.group_resolution,` +
+		` projections.idp_templates6_ldap2.group_base,` +
+		` projections.idp_templates6_ldap2.group_name_attribute,` +
 		// apple
 		` projections.idp_templates6_apple.idp_id,` +
 		` projections.idp_templates6_apple.client_id,` +
@@ -407,6 +431,7 @@ var (
 		"user_endpoint",
 		"scopes",
 		"id_attribute",
+		"attribute_mapping",
 		// oidc config
 		"id_id",
 		"issuer",
@@ -427,6 +452,8 @@ var (
 		"scopes",
 		"tenant",
 		"is_email_verified",
+		"allowed_tenants",
+		"fetch_groups",
 		// github config
 		"idp_id",
 		"client_id",
@@ -489,6 +516,11 @@ var (
 		"preferred_language_attribute",
 		"avatar_url_attribute",
 		"profile_attribute",
+		"metadata_attributes",
+		"root_ca",
+		"group_resolution",
+		"group_base",
+		"group_name_attribute",
 		// apple config
 		"idp_id",
 		"client_id",
@@ -560,6 +592,7 @@ func Test_IDPTemplateTemplatesPrepares(t *testing.T) {
 						"user",
 						database.TextArray[string]{"profile"},
 						"id-attribute",
+						[]byte(`{"email":".data.email"}`),
 						// oidc
 						nil,
 						nil,
@@ -580,6 +613,8 @@ func Test_IDPTemplateTemplatesPrepares(t *testing.T) {
 						nil,
 						nil,
 						nil,
+						nil,
+						nil,
 						// github
 						nil,
 						nil,
@@ -642,6 +677,11 @@ func Test_IDPTemplateTemplatesPrepares(t *testing.T) {
 						nil,
 						nil,
 						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
 						// apple
 						nil,
 						nil,
@@ -676,6 +716,7 @@ func Test_IDPTemplateTemplatesPrepares(t *testing.T) {
 					UserEndpoint:          "user",
 					Scopes:                []string{"profile"},
 					IDAttribute:           "id-attribute",
+					AttributeMapping:      &domain.OAuthAttributeMapping{Email: ".data.email"},
 				},
 			},
 		},
@@ -710,6 +751,7 @@ func Test_IDPTemplateTemplatesPrepares(t *testing.T) {
 						nil,
 						nil,
 						nil,
+						nil,
 						// oidc
 						"idp-id",
 						"issuer",
@@ -730,6 +772,8 @@ func Test_IDPTemplateTemplatesPrepares(t *testing.T) {
 						nil,
 						nil,
 						nil,
+						nil,
+						nil,
 						// github
 						nil,
 						nil,
@@ -792,6 +836,11 @@ func Test_IDPTemplateTemplatesPrepares(t *testing.T) {
 						nil,
 						nil,
 						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
 						// apple
 						nil,
 						nil,
@@ -858,6 +907,7 @@ func Test_IDPTemplateTemplatesPrepares(t *testing.T) {
 						nil,
 						nil,
 						nil,
+						nil,
 						// oidc
 						nil,
 						nil,
@@ -878,6 +928,8 @@ func Test_IDPTemplateTemplatesPrepares(t *testing.T) {
 						nil,
 						nil,
 						nil,
+						nil,
+						nil,
 						// github
 						nil,
 						nil,
@@ -940,6 +992,11 @@ func Test_IDPTemplateTemplatesPrepares(t *testing.T) {
 						nil,
 						nil,
 						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
 						// apple
 						nil,
 						nil,
@@ -1005,6 +1062,7 @@ func Test_IDPTemplateTemplatesPrepares(t *testing.T) {
 						nil,
 						nil,
 						nil,
+						nil,
 						// oidc
 						nil,
 						nil,
@@ -1025,6 +1083,8 @@ func Test_IDPTemplateTemplatesPrepares(t *testing.T) {
 						nil,
 						nil,
 						nil,
+						nil,
+						nil,
 						// github
 						"idp-id",
 						"client_id",
@@ -1087,6 +1147,11 @@ func Test_IDPTemplateTemplatesPrepares(t *testing.T) {
 						nil,
 						nil,
 						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
 						// apple
 						nil,
 						nil,
@@ -1151,6 +1216,7 @@ func Test_IDPTemplateTemplatesPrepares(t *testing.T) {
 						nil,
 						nil,
 						nil,
+						nil,
 						// oidc
 						nil,
 						nil,
@@ -1171,6 +1237,8 @@ func Test_IDPTemplateTemplatesPrepares(t *testing.T) {
 						nil,
 						nil,
 						nil,
+						nil,
+						nil,
 						// github
 						nil,
 						nil,
@@ -1233,6 +1301,11 @@ func Test_IDPTemplateTemplatesPrepares(t *testing.T) {
 						nil,
 						nil,
 						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
 						// apple
 						nil,
 						nil,
@@ -1297,6 +1370,7 @@ func Test_IDPTemplateTemplatesPrepares(t *testing.T) {
 						nil,
 						nil,
 						nil,
+						nil,
 						// oidc
 						nil,
 						nil,
@@ -1317,6 +1391,8 @@ func Test_IDPTemplateTemplatesPrepares(t *testing.T) {
 						nil,
 						nil,
 						nil,
+						nil,
+						nil,
 						// github
 						nil,
 						nil,
@@ -1379,6 +1455,11 @@ func Test_IDPTemplateTemplatesPrepares(t *testing.T) {
 						nil,
 						nil,
 						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
 						// apple
 						nil,
 						nil,
@@ -1444,6 +1525,7 @@ func Test_IDPTemplateTemplatesPrepares(t *testing.T) {
 						nil,
 						nil,
 						nil,
+						nil,
 						// oidc
 						nil,
 						nil,
@@ -1464,6 +1546,8 @@ func Test_IDPTemplateTemplatesPrepares(t *testing.T) {
 						nil,
 						nil,
 						nil,
+						nil,
+						nil,
 						// github
 						nil,
 						nil,
@@ -1526,6 +1610,11 @@ func Test_IDPTemplateTemplatesPrepares(t *testing.T) {
 						nil,
 						nil,
 						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
 						// apple
 						nil,
 						nil,
@@ -1590,6 +1679,7 @@ func Test_IDPTemplateTemplatesPrepares(t *testing.T) {
 						nil,
 						nil,
 						nil,
+						nil,
 						// oidc
 						nil,
 						nil,
@@ -1610,6 +1700,8 @@ func Test_IDPTemplateTemplatesPrepares(t *testing.T) {
 						nil,
 						nil,
 						nil,
+						nil,
+						nil,
 						// github
 						nil,
 						nil,
@@ -1672,6 +1764,11 @@ func Test_IDPTemplateTemplatesPrepares(t *testing.T) {
 						nil,
 						nil,
 						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
 						// apple
 						nil,
 						nil,
@@ -1740,6 +1837,7 @@ func Test_IDPTemplateTemplatesPrepares(t *testing.T) {
 						nil,
 						nil,
 						nil,
+						nil,
 						// oidc
 						nil,
 						nil,
@@ -1760,6 +1858,8 @@ func Test_IDPTemplateTemplatesPrepares(t *testing.T) {
 						nil,
 						nil,
 						nil,
+						nil,
+						nil,
 						// github
 						nil,
 						nil,
@@ -1822,6 +1922,11 @@ func Test_IDPTemplateTemplatesPrepares(t *testing.T) {
 						"lang",
 						"avatar",
 						"profile",
+						[]byte(`{"department":"departmentNumber"}`),
+						[]byte("rootCA"),
+						int16(domain.LDAPGroupResolutionMemberOf),
+						"groups",
+						"cn",
 						// apple
 						nil,
 						nil,
@@ -1848,15 +1953,19 @@ func Test_IDPTemplateTemplatesPrepares(t *testing.T) {
 				IsAutoUpdate:      true,
 				AutoLinking:       domain.AutoLinkingOptionUsername,
 				LDAPIDPTemplate: &LDAPIDPTemplate{
-					IDPID:             "idp-id",
-					Servers:           []string{"server"},
-					StartTLS:          true,
-					BaseDN:            "base",
-					BindDN:            "dn",
-					UserBase:          "user",
-					UserObjectClasses: []string{"object"},
-					UserFilters:       []string{"filter"},
-					Timeout:           time.Duration(30000000000),
+					IDPID:              "idp-id",
+					Servers:            []string{"server"},
+					StartTLS:           true,
+					BaseDN:             "base",
+					BindDN:             "dn",
+					UserBase:           "user",
+					UserObjectClasses:  []string{"object"},
+					UserFilters:        []string{"filter"},
+					Timeout:            time.Duration(30000000000),
+					RootCA:             []byte("rootCA"),
+					GroupResolution:    domain.LDAPGroupResolutionMemberOf,
+					GroupBase:          "groups",
+					GroupNameAttribute: "cn",
 					LDAPAttributes: idp.LDAPAttributes{
 						IDAttribute:                "id",
 						FirstNameAttribute:         "first",
@@ -1871,6 +1980,7 @@ func Test_IDPTemplateTemplatesPrepares(t *testing.T) {
 						PreferredLanguageAttribute: "lang",
 						AvatarURLAttribute:         "avatar",
 						ProfileAttribute:           "profile",
+						MetadataAttributes:         map[string]string{"department": "departmentNumber"},
 					},
 				},
 			},
@@ -1906,6 +2016,7 @@ func Test_IDPTemplateTemplatesPrepares(t *testing.T) {
 						nil,
 						nil,
 						nil,
+						nil,
 						// oidc
 						nil,
 						nil,
@@ -1926,6 +2037,8 @@ func Test_IDPTemplateTemplatesPrepares(t *testing.T) {
 						nil,
 						nil,
 						nil,
+						nil,
+						nil,
 						// github
 						nil,
 						nil,
@@ -1988,6 +2101,11 @@ func Test_IDPTemplateTemplatesPrepares(t *testing.T) {
 						nil,
 						nil,
 						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
 						// apple
 						"idp-id",
 						"client_id",
@@ -2054,6 +2172,7 @@ func Test_IDPTemplateTemplatesPrepares(t *testing.T) {
 						nil,
 						nil,
 						nil,
+						nil,
 						// oidc
 						nil,
 						nil,
@@ -2074,6 +2193,8 @@ func Test_IDPTemplateTemplatesPrepares(t *testing.T) {
 						nil,
 						nil,
 						nil,
+						nil,
+						nil,
 						// github
 						nil,
 						nil,
@@ -2136,6 +2257,11 @@ func Test_IDPTemplateTemplatesPrepares(t *testing.T) {
 						nil,
 						nil,
 						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
 						// apple
 						nil,
 						nil,
@@ -2230,6 +2356,7 @@ func Test_IDPTemplateTemplatesPrepares(t *testing.T) {
 							nil,
 							nil,
 							nil,
+							nil,
 							// oidc
 							nil,
 							nil,
@@ -2250,6 +2377,8 @@ func Test_IDPTemplateTemplatesPrepares(t *testing.T) {
 							nil,
 							nil,
 							nil,
+							nil,
+							nil,
 							// github
 							nil,
 							nil,
@@ -2312,6 +2441,11 @@ func Test_IDPTemplateTemplatesPrepares(t *testing.T) {
 							"lang",
 							"avatar",
 							"profile",
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
 							// apple
 							nil,
 							nil,
@@ -2405,6 +2539,7 @@ func Test_IDPTemplateTemplatesPrepares(t *testing.T) {
 							nil,
 							nil,
 							nil,
+							nil,
 							// oidc
 							nil,
 							nil,
@@ -2425,6 +2560,8 @@ func Test_IDPTemplateTemplatesPrepares(t *testing.T) {
 							nil,
 							nil,
 							nil,
+							nil,
+							nil,
 							// github
 							nil,
 							nil,
@@ -2487,6 +2624,11 @@ func Test_IDPTemplateTemplatesPrepares(t *testing.T) {
 							nil,
 							nil,
 							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
 							// apple
 							nil,
 							nil,
@@ -2554,6 +2696,7 @@ func Test_IDPTemplateTemplatesPrepares(t *testing.T) {
 							nil,
 							nil,
 							nil,
+							nil,
 							// oidc
 							nil,
 							nil,
@@ -2574,6 +2717,8 @@ func Test_IDPTemplateTemplatesPrepares(t *testing.T) {
 							nil,
 							nil,
 							nil,
+							nil,
+							nil,
 							// github
 							nil,
 							nil,
@@ -2636,6 +2781,11 @@ func Test_IDPTemplateTemplatesPrepares(t *testing.T) {
 							"lang",
 							"avatar",
 							"profile",
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
 							// apple
 							nil,
 							nil,
@@ -2668,6 +2818,7 @@ func Test_IDPTemplateTemplatesPrepares(t *testing.T) {
 							nil,
 							nil,
 							nil,
+							nil,
 							// oidc
 							nil,
 							nil,
@@ -2688,6 +2839,8 @@ func Test_IDPTemplateTemplatesPrepares(t *testing.T) {
 							nil,
 							nil,
 							nil,
+							nil,
+							nil,
 							// github
 							nil,
 							nil,
@@ -2750,6 +2903,11 @@ func Test_IDPTemplateTemplatesPrepares(t *testing.T) {
 							nil,
 							nil,
 							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
 							// apple
 							nil,
 							nil,
@@ -2782,6 +2940,7 @@ func Test_IDPTemplateTemplatesPrepares(t *testing.T) {
 							nil,
 							nil,
 							nil,
+							nil,
 							// oidc
 							nil,
 							nil,
@@ -2802,6 +2961,8 @@ func Test_IDPTemplateTemplatesPrepares(t *testing.T) {
 							nil,
 							nil,
 							nil,
+							nil,
+							nil,
 							// github
 							nil,
 							nil,
@@ -2864,6 +3025,11 @@ func Test_IDPTemplateTemplatesPrepares(t *testing.T) {
 							nil,
 							nil,
 							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
 							// apple
 							nil,
 							nil,
@@ -2896,6 +3062,7 @@ func Test_IDPTemplateTemplatesPrepares(t *testing.T) {
 							"user",
 							database.TextArray[string]{"profile"},
 							"id-attribute",
+							nil,
 							// oidc
 							nil,
 							nil,
@@ -2916,6 +3083,8 @@ func Test_IDPTemplateTemplatesPrepares(t *testing.T) {
 							nil,
 							nil,
 							nil,
+							nil,
+							nil,
 							// github
 							nil,
 							nil,
@@ -2978,6 +3147,11 @@ func Test_IDPTemplateTemplatesPrepares(t *testing.T) {
 							nil,
 							nil,
 							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
 							// apple
 							nil,
 							nil,
@@ -3010,6 +3184,7 @@ func Test_IDPTemplateTemplatesPrepares(t *testing.T) {
 							nil,
 							nil,
 							nil,
+							nil,
 							// oidc
 							"idp-id-oidc",
 							"issuer",
@@ -3030,6 +3205,8 @@ func Test_IDPTemplateTemplatesPrepares(t *testing.T) {
 							nil,
 							nil,
 							nil,
+							nil,
+							nil,
 							// github
 							nil,
 							nil,
@@ -3092,6 +3269,11 @@ func Test_IDPTemplateTemplatesPrepares(t *testing.T) {
 							nil,
 							nil,
 							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
 							// apple
 							nil,
 							nil,
@@ -3124,6 +3306,7 @@ func Test_IDPTemplateTemplatesPrepares(t *testing.T) {
 							nil,
 							nil,
 							nil,
+							nil,
 							// oidc
 							nil,
 							nil,
@@ -3144,6 +3327,8 @@ func Test_IDPTemplateTemplatesPrepares(t *testing.T) {
 							nil,
 							nil,
 							nil,
+							nil,
+							nil,
 							// github
 							nil,
 							nil,
@@ -3206,6 +3391,11 @@ func Test_IDPTemplateTemplatesPrepares(t *testing.T) {
 							nil,
 							nil,
 							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
 							// apple
 							nil,
 							nil,
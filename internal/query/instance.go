@@ -17,6 +17,7 @@ import (
 	"github.com/zitadel/zitadel/internal/api/authz"
 	"github.com/zitadel/zitadel/internal/api/call"
 	"github.com/zitadel/zitadel/internal/database"
+	"github.com/zitadel/zitadel/internal/domain"
 	"github.com/zitadel/zitadel/internal/eventstore/handler/v2"
 	"github.com/zitadel/zitadel/internal/feature"
 	"github.com/zitadel/zitadel/internal/query/projection"
@@ -204,10 +205,19 @@ func (q *Queries) InstanceByHost(ctx context.Context, host string) (_ authz.Inst
 		span.EndWithError(err)
 	}()
 
-	domain := strings.Split(host, ":")[0] // remove possible port
-	instance, scan := scanAuthzInstance(host, domain)
-	err = q.client.QueryRowContext(ctx, scan, instanceByDomainQuery, domain)
-	logging.OnError(err).WithField("host", host).WithField("domain", domain).Warn("instance by host")
+	if q.instanceHostCache != nil {
+		if instance, ok := q.instanceHostCache.get(host); ok {
+			return instance, nil
+		}
+	}
+	instanceDomain := strings.Split(host, ":")[0] // remove possible port
+	instance, scan := scanAuthzInstance(host, instanceDomain)
+	// an exact match of the domain has precedence over a matching wildcard domain
+	err = q.client.QueryRowContext(ctx, scan, instanceByDomainQuery, instanceDomain, domain.WildcardInstanceDomain(instanceDomain))
+	logging.OnError(err).WithField("host", host).WithField("domain", instanceDomain).Warn("instance by host")
+	if err == nil && q.instanceHostCache != nil {
+		q.instanceHostCache.set(host, instance)
+	}
 	return instance, err
 }
 
@@ -417,19 +427,21 @@ func prepareInstanceDomainQuery(ctx context.Context, db prepareDatabase) (sq.Sel
 }
 
 type authzInstance struct {
-	id                  string
-	iamProjectID        string
-	consoleID           string
-	consoleAppID        string
-	host                string
-	domain              string
-	defaultLang         language.Tag
-	defaultOrgID        string
-	csp                 csp
-	enableImpersonation bool
-	block               *bool
-	auditLogRetention   *time.Duration
-	features            feature.Features
+	id                   string
+	iamProjectID         string
+	consoleID            string
+	consoleAppID         string
+	host                 string
+	domain               string
+	defaultLang          language.Tag
+	defaultOrgID         string
+	brandingOrgID        string
+	csp                  csp
+	enableImpersonation  bool
+	actionsHTTPAllowList database.TextArray[string]
+	block                *bool
+	auditLogRetention    *time.Duration
+	features             feature.Features
 }
 
 type csp struct {
@@ -469,6 +481,10 @@ func (i *authzInstance) DefaultOrganisationID() string {
 	return i.defaultOrgID
 }
 
+func (i *authzInstance) BrandingOrganisationID() string {
+	return i.brandingOrgID
+}
+
 func (i *authzInstance) SecurityPolicyAllowedOrigins() []string {
 	if !i.csp.enableIframeEmbedding {
 		return nil
@@ -480,6 +496,10 @@ func (i *authzInstance) EnableImpersonation() bool {
 	return i.enableImpersonation
 }
 
+func (i *authzInstance) ActionsHTTPAllowList() []string {
+	return i.actionsHTTPAllowList
+}
+
 func (i *authzInstance) Block() *bool {
 	return i.block
 }
@@ -505,6 +525,7 @@ func scanAuthzInstance(host, domain string) (*authzInstance, func(row *sql.Row)
 			auditLogRetention     database.NullDuration
 			block                 sql.NullBool
 			features              []byte
+			brandingOrgID         sql.NullString
 		)
 		err := row.Scan(
 			&instance.id,
@@ -516,9 +537,11 @@ func scanAuthzInstance(host, domain string) (*authzInstance, func(row *sql.Row)
 			&enableIframeEmbedding,
 			&instance.csp.allowedOrigins,
 			&enableImpersonation,
+			&instance.actionsHTTPAllowList,
 			&auditLogRetention,
 			&block,
 			&features,
+			&brandingOrgID,
 		)
 		if errors.Is(err, sql.ErrNoRows) {
 			return zerrors.ThrowNotFound(nil, "QUERY-1kIjX", "Errors.IAM.NotFound")
@@ -527,6 +550,7 @@ func scanAuthzInstance(host, domain string) (*authzInstance, func(row *sql.Row)
 			return zerrors.ThrowInternal(err, "QUERY-d3fas", "Errors.Internal")
 		}
 		instance.defaultLang = language.Make(lang)
+		instance.brandingOrgID = brandingOrgID.String
 		if auditLogRetention.Valid {
 			instance.auditLogRetention = &auditLogRetention.Duration
 		}
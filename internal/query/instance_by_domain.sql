@@ -1,6 +1,9 @@
 with domain as (
-	select instance_id from projections.instance_domains
-	where domain = $1
+	select d.instance_id, ds.branding_org_id, ds.default_language from projections.instance_domains d
+	left join projections.instance_domain_settings ds on d.instance_id = ds.instance_id and d.domain = ds.domain
+	where d.domain = $1 or d.domain = $2
+	order by d.domain = $1 desc
+	limit 1
 ), instance_features as (
 	select i.*
 	from domain d
@@ -21,15 +24,17 @@ select
     i.iam_project_id,
     i.console_client_id,
     i.console_app_id,
-    i.default_language,
+    coalesce(nullif(d.default_language, ''), i.default_language),
     s.enable_iframe_embedding,
     s.origins,
 	s.enable_impersonation,
+	s.actions_http_allow_list,
     l.audit_log_retention,
     l.block,
-	f.features
+	f.features,
+	d.branding_org_id
 from domain d
 join projections.instances i on i.id = d.instance_id
-left join projections.security_policies2 s on i.id = s.instance_id
+left join projections.security_policies3 s on i.id = s.instance_id
 left join projections.limits l on i.id = l.instance_id
 left join features f on i.id = f.instance_id;
@@ -18,11 +18,13 @@ select
     s.enable_iframe_embedding,
     s.origins,
 	s.enable_impersonation,
+	s.actions_http_allow_list,
     l.audit_log_retention,
     l.block,
-	f.features
+	f.features,
+	null::text branding_org_id
 from projections.instances i
-left join projections.security_policies2 s on i.id = s.instance_id
+left join projections.security_policies3 s on i.id = s.instance_id
 left join projections.limits l on i.id = l.instance_id
 left join features f on i.id = f.instance_id
 where i.id = $1;
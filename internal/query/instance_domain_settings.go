@@ -0,0 +1,117 @@
+package query
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+	"golang.org/x/text/language"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/api/call"
+	"github.com/zitadel/zitadel/internal/query/projection"
+	"github.com/zitadel/zitadel/internal/telemetry/tracing"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+var (
+	instanceDomainSettingsTable = table{
+		name:          projection.InstanceDomainSettingsTable,
+		instanceIDCol: projection.InstanceDomainSettingsInstanceIDCol,
+	}
+	InstanceDomainSettingsColumnInstanceID = Column{
+		name:  projection.InstanceDomainSettingsInstanceIDCol,
+		table: instanceDomainSettingsTable,
+	}
+	InstanceDomainSettingsColumnDomain = Column{
+		name:  projection.InstanceDomainSettingsDomainCol,
+		table: instanceDomainSettingsTable,
+	}
+	InstanceDomainSettingsColumnCreationDate = Column{
+		name:  projection.InstanceDomainSettingsCreationDateCol,
+		table: instanceDomainSettingsTable,
+	}
+	InstanceDomainSettingsColumnChangeDate = Column{
+		name:  projection.InstanceDomainSettingsChangeDateCol,
+		table: instanceDomainSettingsTable,
+	}
+	InstanceDomainSettingsColumnSequence = Column{
+		name:  projection.InstanceDomainSettingsSequenceCol,
+		table: instanceDomainSettingsTable,
+	}
+	InstanceDomainSettingsColumnBrandingOrgID = Column{
+		name:  projection.InstanceDomainSettingsBrandingOrgIDCol,
+		table: instanceDomainSettingsTable,
+	}
+	InstanceDomainSettingsColumnDefaultLanguage = Column{
+		name:  projection.InstanceDomainSettingsDefaultLanguageCol,
+		table: instanceDomainSettingsTable,
+	}
+)
+
+type InstanceDomainSettings struct {
+	InstanceID   string
+	Domain       string
+	CreationDate time.Time
+	ChangeDate   time.Time
+	Sequence     uint64
+
+	BrandingOrgID   string
+	DefaultLanguage language.Tag
+}
+
+func (q *Queries) InstanceDomainSettingsByDomain(ctx context.Context, instanceDomain string) (settings *InstanceDomainSettings, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	stmt, scan := prepareInstanceDomainSettingsQuery(ctx, q.client)
+	query, args, err := stmt.Where(sq.Eq{
+		InstanceDomainSettingsColumnInstanceID.identifier(): authz.GetInstance(ctx).InstanceID(),
+		InstanceDomainSettingsColumnDomain.identifier():     instanceDomain,
+	}).ToSql()
+	if err != nil {
+		return nil, zerrors.ThrowInternal(err, "QUERY-Ds8nw", "Errors.Query.SQLStatment")
+	}
+	err = q.client.QueryRowContext(ctx, func(row *sql.Row) error {
+		settings, err = scan(row)
+		return err
+	}, query, args...)
+	return settings, err
+}
+
+func prepareInstanceDomainSettingsQuery(ctx context.Context, db prepareDatabase) (sq.SelectBuilder, func(*sql.Row) (*InstanceDomainSettings, error)) {
+	return sq.Select(
+			InstanceDomainSettingsColumnInstanceID.identifier(),
+			InstanceDomainSettingsColumnDomain.identifier(),
+			InstanceDomainSettingsColumnCreationDate.identifier(),
+			InstanceDomainSettingsColumnChangeDate.identifier(),
+			InstanceDomainSettingsColumnSequence.identifier(),
+			InstanceDomainSettingsColumnBrandingOrgID.identifier(),
+			InstanceDomainSettingsColumnDefaultLanguage.identifier(),
+		).
+			From(instanceDomainSettingsTable.identifier() + db.Timetravel(call.Took(ctx))).
+			PlaceholderFormat(sq.Dollar),
+		func(row *sql.Row) (*InstanceDomainSettings, error) {
+			settings := new(InstanceDomainSettings)
+			var defaultLanguage string
+			err := row.Scan(
+				&settings.InstanceID,
+				&settings.Domain,
+				&settings.CreationDate,
+				&settings.ChangeDate,
+				&settings.Sequence,
+				&settings.BrandingOrgID,
+				&defaultLanguage,
+			)
+			if err != nil {
+				if errors.Is(err, sql.ErrNoRows) {
+					return nil, zerrors.ThrowNotFound(err, "QUERY-Ds3nf", "Errors.Instance.Domain.Settings.NotFound")
+				}
+				return nil, zerrors.ThrowInternal(err, "QUERY-Ds4ie", "Errors.Internal")
+			}
+			settings.DefaultLanguage = language.Make(defaultLanguage)
+			return settings, nil
+		}
+}
@@ -0,0 +1,118 @@
+package query
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"golang.org/x/text/language"
+
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+var (
+	prepareInstanceDomainSettingsStmt = `SELECT projections.instance_domain_settings.instance_id,` +
+		` projections.instance_domain_settings.domain,` +
+		` projections.instance_domain_settings.creation_date,` +
+		` projections.instance_domain_settings.change_date,` +
+		` projections.instance_domain_settings.sequence,` +
+		` projections.instance_domain_settings.branding_org_id,` +
+		` projections.instance_domain_settings.default_language` +
+		` FROM projections.instance_domain_settings` +
+		` AS OF SYSTEM TIME '-1 ms'`
+
+	prepareInstanceDomainSettingsCols = []string{
+		"instance_id",
+		"domain",
+		"creation_date",
+		"change_date",
+		"sequence",
+		"branding_org_id",
+		"default_language",
+	}
+)
+
+func Test_InstanceDomainSettingsPrepares(t *testing.T) {
+	type want struct {
+		sqlExpectations sqlExpectation
+		err             checkErr
+	}
+	tests := []struct {
+		name    string
+		prepare interface{}
+		want    want
+		object  interface{}
+	}{
+		{
+			name:    "prepareInstanceDomainSettingsQuery no result",
+			prepare: prepareInstanceDomainSettingsQuery,
+			want: want{
+				sqlExpectations: mockQueriesScanErr(
+					regexp.QuoteMeta(prepareInstanceDomainSettingsStmt),
+					nil,
+					nil,
+				),
+				err: func(err error) (error, bool) {
+					if !zerrors.IsNotFound(err) {
+						return fmt.Errorf("err should be zitadel.NotFoundError got: %w", err), false
+					}
+					return nil, true
+				},
+			},
+			object: (*InstanceDomainSettings)(nil),
+		},
+		{
+			name:    "prepareInstanceDomainSettingsQuery found",
+			prepare: prepareInstanceDomainSettingsQuery,
+			want: want{
+				sqlExpectations: mockQuery(
+					regexp.QuoteMeta(prepareInstanceDomainSettingsStmt),
+					prepareInstanceDomainSettingsCols,
+					[]driver.Value{
+						"instance-id",
+						"*.zitadel.ch",
+						testNow,
+						testNow,
+						uint64(20211109),
+						"org-id",
+						"de",
+					},
+				),
+			},
+			object: &InstanceDomainSettings{
+				InstanceID:      "instance-id",
+				Domain:          "*.zitadel.ch",
+				CreationDate:    testNow,
+				ChangeDate:      testNow,
+				Sequence:        20211109,
+				BrandingOrgID:   "org-id",
+				DefaultLanguage: language.German,
+			},
+		},
+		{
+			name:    "prepareInstanceDomainSettingsQuery sql err",
+			prepare: prepareInstanceDomainSettingsQuery,
+			want: want{
+				sqlExpectations: mockQueryErr(
+					regexp.QuoteMeta(prepareInstanceDomainSettingsStmt),
+					sql.ErrConnDone,
+				),
+				err: func(err error) (error, bool) {
+					if !errors.Is(err, sql.ErrConnDone) {
+						return fmt.Errorf("err should be sql.ErrConnDone got: %w", err), false
+					}
+					return nil, true
+				},
+			},
+			object: (*InstanceDomainSettings)(nil),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assertPrepare(t, tt.prepare, tt.object, tt.want.sqlExpectations, tt.want.err, defaultPrepareArgs...)
+		})
+	}
+}
@@ -0,0 +1,114 @@
+package query
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/jonboulle/clockwork"
+
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/repository/feature/feature_v2"
+	"github.com/zitadel/zitadel/internal/repository/instance"
+	"github.com/zitadel/zitadel/internal/repository/limits"
+)
+
+// instanceHostCache keeps the instances resolved by the requested host,
+// so the instance interceptors don't query the database on every request.
+// Entries of an instance are dropped as soon as this process pushes an event of the instance, its limits or features.
+// Changes pushed by other processes are applied after maxAge.
+type instanceHostCache struct {
+	mtx    sync.RWMutex
+	hosts  map[string]*cachedInstance
+	maxAge time.Duration
+	clock  clockwork.Clock
+}
+
+type cachedInstance struct {
+	instance *authzInstance
+	cachedAt time.Time
+}
+
+// newInstanceHostCache initializes an instanceHostCache and starts the Go routines which drop changed and expired instances.
+// When the passed context is done, the Go routines will terminate.
+func newInstanceHostCache(background context.Context, maxAge time.Duration) *instanceHostCache {
+	c := &instanceHostCache{
+		hosts:  make(map[string]*cachedInstance),
+		maxAge: maxAge,
+		clock:  clockwork.FromContext(background), // defaults to real clock
+	}
+	go c.subscribe(background)
+	go c.purgeOnInterval(background, c.clock.NewTicker(maxAge))
+	return c
+}
+
+func (c *instanceHostCache) get(host string) (*authzInstance, bool) {
+	c.mtx.RLock()
+	defer c.mtx.RUnlock()
+	cached, ok := c.hosts[host]
+	if !ok || cached.cachedAt.Add(c.maxAge).Before(c.clock.Now()) {
+		return nil, false
+	}
+	return cached.instance, true
+}
+
+func (c *instanceHostCache) set(host string, instance *authzInstance) {
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+	c.hosts[host] = &cachedInstance{
+		instance: instance,
+		cachedAt: c.clock.Now(),
+	}
+}
+
+// invalidate drops all hosts of the instance, an empty instanceID drops all hosts.
+func (c *instanceHostCache) invalidate(instanceID string) {
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+	for host, cached := range c.hosts {
+		if instanceID == "" || cached.instance.id == instanceID {
+			delete(c.hosts, host)
+		}
+	}
+}
+
+// subscribe drops the instances changed by this process.
+// Events of the system features don't belong to an instance and drop all hosts.
+func (c *instanceHostCache) subscribe(background context.Context) {
+	queue := make(chan eventstore.Event, 100)
+	subscription := eventstore.SubscribeAggregates(queue,
+		instance.AggregateType,
+		limits.AggregateType,
+		feature_v2.AggregateType,
+	)
+	for {
+		select {
+		case <-background.Done():
+			subscription.Unsubscribe()
+			return
+		case event := <-queue:
+			c.invalidate(event.Aggregate().InstanceID)
+		}
+	}
+}
+
+// purgeOnInterval drops the expired hosts,
+// as hosts matching a wildcard domain would otherwise grow the cache unbounded.
+func (c *instanceHostCache) purgeOnInterval(background context.Context, ticker clockwork.Ticker) {
+	defer ticker.Stop()
+	for {
+		select {
+		case <-background.Done():
+			return
+		case <-ticker.Chan():
+		}
+
+		c.mtx.Lock()
+		for host, cached := range c.hosts {
+			if cached.cachedAt.Add(c.maxAge).Before(c.clock.Now()) {
+				delete(c.hosts, host)
+			}
+		}
+		c.mtx.Unlock()
+	}
+}
@@ -0,0 +1,48 @@
+package query
+
+import (
+	"testing"
+	"time"
+
+	"github.com/jonboulle/clockwork"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_instanceHostCache(t *testing.T) {
+	clock := clockwork.NewFakeClock()
+	c := &instanceHostCache{
+		hosts:  make(map[string]*cachedInstance),
+		maxAge: time.Minute,
+		clock:  clock,
+	}
+	instance1 := &authzInstance{id: "instance1"}
+	instance2 := &authzInstance{id: "instance2"}
+
+	c.set("login.example.com", instance1)
+	c.set("login.example.com:8080", instance1)
+	c.set("auth.example.org", instance2)
+
+	got, ok := c.get("login.example.com")
+	assert.True(t, ok)
+	assert.Equal(t, instance1, got)
+
+	_, ok = c.get("unknown.example.com")
+	assert.False(t, ok)
+
+	c.invalidate("instance1")
+	_, ok = c.get("login.example.com")
+	assert.False(t, ok)
+	_, ok = c.get("login.example.com:8080")
+	assert.False(t, ok)
+	got, ok = c.get("auth.example.org")
+	assert.True(t, ok)
+	assert.Equal(t, instance2, got)
+
+	clock.Advance(2 * time.Minute)
+	_, ok = c.get("auth.example.org")
+	assert.False(t, ok, "expired")
+
+	c.set("auth.example.org", instance2)
+	c.invalidate("")
+	assert.Empty(t, c.hosts)
+}
@@ -0,0 +1,156 @@
+package query
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/query/projection"
+	"github.com/zitadel/zitadel/internal/telemetry/tracing"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+var (
+	instanceUsageTable = table{
+		name:          projection.InstanceUsageProjectionTable,
+		instanceIDCol: projection.InstanceUsageColumnInstanceID,
+	}
+	InstanceUsageColumnInstanceID = Column{
+		name:  projection.InstanceUsageColumnInstanceID,
+		table: instanceUsageTable,
+	}
+	InstanceUsageColumnUnit = Column{
+		name:  projection.InstanceUsageColumnUnit,
+		table: instanceUsageTable,
+	}
+	InstanceUsageColumnPeriodStart = Column{
+		name:  projection.InstanceUsageColumnPeriodStart,
+		table: instanceUsageTable,
+	}
+	InstanceUsageColumnUsage = Column{
+		name:  projection.InstanceUsageColumnUsage,
+		table: instanceUsageTable,
+	}
+)
+
+var (
+	instanceUsageActiveUserTable = table{
+		name:          projection.InstanceUsageActiveUserTable,
+		instanceIDCol: projection.InstanceUsageActiveUserColumnInstanceID,
+	}
+	InstanceUsageActiveUserColumnInstanceID = Column{
+		name:  projection.InstanceUsageActiveUserColumnInstanceID,
+		table: instanceUsageActiveUserTable,
+	}
+	InstanceUsageActiveUserColumnPeriod = Column{
+		name:  projection.InstanceUsageActiveUserColumnPeriod,
+		table: instanceUsageActiveUserTable,
+	}
+	InstanceUsageActiveUserColumnUserID = Column{
+		name:  projection.InstanceUsageActiveUserColumnUserID,
+		table: instanceUsageActiveUserTable,
+	}
+)
+
+// InstanceUsage is the metered usage of an instance in a monthly period
+type InstanceUsage struct {
+	PeriodStart      time.Time
+	Authentications  uint64
+	ActiveUsers      uint64
+	Requests         uint64
+	ActionRunSeconds uint64
+}
+
+// InstanceUsage returns the usage of the instance in the month of periodStart
+func (q *Queries) InstanceUsage(ctx context.Context, instanceID string, periodStart time.Time) (usage *InstanceUsage, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	periodStart = domain.UsagePeriodStart(periodStart)
+	query, scan := prepareInstanceUsageQuery(ctx, q.client)
+	stmt, args, err := query.Where(
+		sq.Eq{
+			InstanceUsageColumnInstanceID.identifier():  instanceID,
+			InstanceUsageColumnPeriodStart.identifier(): periodStart,
+		},
+	).ToSql()
+	if err != nil {
+		return nil, zerrors.ThrowInternal(err, "QUERY-Tn4wq7Xv2k", "Errors.Query.SQLStatement")
+	}
+	err = q.client.QueryContext(ctx, func(rows *sql.Rows) error {
+		usage, err = scan(rows)
+		return err
+	}, stmt, args...)
+	if err != nil {
+		return nil, err
+	}
+	usage.PeriodStart = periodStart
+
+	query, scanCount := prepareInstanceUsageActiveUsersQuery(ctx, q.client)
+	stmt, args, err = query.Where(
+		sq.Eq{
+			InstanceUsageActiveUserColumnInstanceID.identifier(): instanceID,
+			InstanceUsageActiveUserColumnPeriod.identifier():     periodStart,
+		},
+	).ToSql()
+	if err != nil {
+		return nil, zerrors.ThrowInternal(err, "QUERY-Wc8nb3Rm5q", "Errors.Query.SQLStatement")
+	}
+	err = q.client.QueryRowContext(ctx, func(row *sql.Row) error {
+		usage.ActiveUsers, err = scanCount(row)
+		return err
+	}, stmt, args...)
+	if err != nil {
+		return nil, err
+	}
+	return usage, nil
+}
+
+func prepareInstanceUsageQuery(ctx context.Context, db prepareDatabase) (sq.SelectBuilder, func(*sql.Rows) (*InstanceUsage, error)) {
+	return sq.Select(
+			InstanceUsageColumnUnit.identifier(),
+			InstanceUsageColumnUsage.identifier(),
+		).
+			From(instanceUsageTable.identifier()).
+			PlaceholderFormat(sq.Dollar),
+		func(rows *sql.Rows) (*InstanceUsage, error) {
+			usage := new(InstanceUsage)
+			for rows.Next() {
+				var (
+					unit  domain.UsageUnit
+					count uint64
+				)
+				if err := rows.Scan(&unit, &count); err != nil {
+					return nil, zerrors.ThrowInternal(err, "QUERY-Gp5vk9Ly3s", "Errors.Internal")
+				}
+				switch unit {
+				case domain.UsageUnitAuthentications:
+					usage.Authentications = count
+				case domain.UsageUnitRequests:
+					usage.Requests = count
+				case domain.UsageUnitActionRunSeconds:
+					usage.ActionRunSeconds = count
+				case domain.UsageUnitUnspecified, domain.UsageUnitActiveUsers:
+				}
+			}
+			if err := rows.Close(); err != nil {
+				return nil, zerrors.ThrowInternal(err, "QUERY-Hd2rx6Nw8m", "Errors.Query.CloseRows")
+			}
+			return usage, nil
+		}
+}
+
+func prepareInstanceUsageActiveUsersQuery(ctx context.Context, db prepareDatabase) (sq.SelectBuilder, func(*sql.Row) (uint64, error)) {
+	return sq.Select("COUNT(*)").
+			From(instanceUsageActiveUserTable.identifier()).
+			PlaceholderFormat(sq.Dollar),
+		func(row *sql.Row) (count uint64, err error) {
+			if err := row.Scan(&count); err != nil {
+				return 0, zerrors.ThrowInternal(err, "QUERY-Mf7ts4Kb9v", "Errors.Internal")
+			}
+			return count, nil
+		}
+}
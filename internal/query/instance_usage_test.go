@@ -0,0 +1,105 @@
+package query
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/zitadel/zitadel/internal/domain"
+)
+
+var (
+	expectedInstanceUsageQuery = regexp.QuoteMeta(`SELECT projections.instance_usage.unit,` +
+		` projections.instance_usage.usage` +
+		` FROM projections.instance_usage`)
+	instanceUsageCols = []string{
+		"unit",
+		"usage",
+	}
+
+	expectedInstanceUsageActiveUsersQuery = regexp.QuoteMeta(`SELECT COUNT(*)` +
+		` FROM projections.instance_usage_active_users`)
+)
+
+func Test_InstanceUsagePrepares(t *testing.T) {
+	type want struct {
+		sqlExpectations sqlExpectation
+		err             checkErr
+	}
+	tests := []struct {
+		name    string
+		prepare interface{}
+		want    want
+		object  interface{}
+	}{
+		{
+			name:    "prepareInstanceUsageQuery no result",
+			prepare: prepareInstanceUsageQuery,
+			want: want{
+				sqlExpectations: mockQueries(
+					expectedInstanceUsageQuery,
+					nil,
+					nil,
+				),
+			},
+			object: &InstanceUsage{},
+		},
+		{
+			name:    "prepareInstanceUsageQuery",
+			prepare: prepareInstanceUsageQuery,
+			want: want{
+				sqlExpectations: mockQueries(
+					expectedInstanceUsageQuery,
+					instanceUsageCols,
+					[][]driver.Value{
+						{domain.UsageUnitAuthentications, uint64(12)},
+						{domain.UsageUnitRequests, uint64(1000)},
+						{domain.UsageUnitActionRunSeconds, uint64(30)},
+					},
+				),
+			},
+			object: &InstanceUsage{
+				Authentications:  12,
+				Requests:         1000,
+				ActionRunSeconds: 30,
+			},
+		},
+		{
+			name:    "prepareInstanceUsageQuery sql err",
+			prepare: prepareInstanceUsageQuery,
+			want: want{
+				sqlExpectations: mockQueryErr(
+					expectedInstanceUsageQuery,
+					sql.ErrConnDone,
+				),
+				err: func(err error) (error, bool) {
+					if !errors.Is(err, sql.ErrConnDone) {
+						return fmt.Errorf("err should be sql.ErrConnDone got: %w", err), false
+					}
+					return nil, true
+				},
+			},
+			object: (*InstanceUsage)(nil),
+		},
+		{
+			name:    "prepareInstanceUsageActiveUsersQuery",
+			prepare: prepareInstanceUsageActiveUsersQuery,
+			want: want{
+				sqlExpectations: mockQuery(
+					expectedInstanceUsageActiveUsersQuery,
+					[]string{"count"},
+					[]driver.Value{uint64(5)},
+				),
+			},
+			object: uint64(5),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assertPrepare(t, tt.prepare, tt.object, tt.want.sqlExpectations, tt.want.err, defaultPrepareArgs...)
+		})
+	}
+}
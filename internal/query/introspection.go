@@ -8,9 +8,11 @@ import (
 
 	"github.com/zitadel/zitadel/internal/api/authz"
 	"github.com/zitadel/zitadel/internal/database"
+	"github.com/zitadel/zitadel/internal/domain"
 	"github.com/zitadel/zitadel/internal/eventstore/handler/v2"
 	"github.com/zitadel/zitadel/internal/query/projection"
 	"github.com/zitadel/zitadel/internal/telemetry/tracing"
+	"github.com/zitadel/zitadel/internal/zerrors"
 )
 
 // introspectionTriggerHandlers slice can only be created after zitadel
@@ -46,6 +48,8 @@ type IntrospectionClient struct {
 	ProjectID            string
 	ResourceOwner        string
 	ProjectRoleAssertion bool
+	ClaimsMapping        *domain.ClaimsMapping
+	AccessTokenType      domain.OIDCTokenType // of the OIDC application, or of the project for API applications
 	PublicKeys           database.Map[[]byte]
 }
 
@@ -57,8 +61,9 @@ func (q *Queries) GetIntrospectionClientByID(ctx context.Context, clientID strin
 	defer func() { span.EndWithError(err) }()
 
 	var (
-		instanceID = authz.GetInstance(ctx).InstanceID()
-		client     = new(IntrospectionClient)
+		instanceID    = authz.GetInstance(ctx).InstanceID()
+		client        = new(IntrospectionClient)
+		claimsMapping []byte
 	)
 
 	err = q.client.QueryRowContext(ctx, func(row *sql.Row) error {
@@ -69,7 +74,9 @@ func (q *Queries) GetIntrospectionClientByID(ctx context.Context, clientID strin
 			&client.AppType,
 			&client.ProjectID,
 			&client.ResourceOwner,
+			&claimsMapping,
 			&client.ProjectRoleAssertion,
+			&client.AccessTokenType,
 			&client.PublicKeys,
 		)
 	},
@@ -79,6 +86,10 @@ func (q *Queries) GetIntrospectionClientByID(ctx context.Context, clientID strin
 	if err != nil {
 		return nil, err
 	}
+	client.ClaimsMapping, err = claimsMappingFromJSON(claimsMapping)
+	if err != nil {
+		return nil, zerrors.ThrowInternal(err, "QUERY-Hd9ak", "Errors.Internal")
+	}
 
 	return client, nil
 }
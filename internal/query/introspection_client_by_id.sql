@@ -1,11 +1,11 @@
 with config as (
-		select instance_id, app_id, client_id, client_secret, 'api' as app_type
-		from projections.apps7_api_configs
+		select instance_id, app_id, client_id, client_secret, 'api' as app_type, null as access_token_type
+		from projections.apps12_api_configs
 		where instance_id = $1
 			and client_id = $2
 	union
-		select instance_id, app_id, client_id, client_secret, 'oidc' as app_type
-		from projections.apps7_oidc_configs
+		select instance_id, app_id, client_id, client_secret, 'oidc' as app_type, access_token_type
+		from projections.apps12_oidc_configs
 		where instance_id = $1
 			and client_id = $2
 ),
@@ -18,8 +18,8 @@ keys as (
 		and expiration > current_timestamp
 	group by identifier
 )
-select config.app_id, config.client_id, config.client_secret, config.app_type, apps.project_id, apps.resource_owner, p.project_role_assertion, keys.public_keys
+select config.app_id, config.client_id, config.client_secret, config.app_type, apps.project_id, apps.resource_owner, apps.claims_mapping, p.project_role_assertion, coalesce(config.access_token_type, p.access_token_type) as access_token_type, keys.public_keys
 from config
-join projections.apps7 apps on apps.id = config.app_id and apps.instance_id = config.instance_id
-join projections.projects4 p on p.id = apps.project_id and p.instance_id = $1
+join projections.apps12 apps on apps.id = config.app_id and apps.instance_id = config.instance_id
+join projections.projects5 p on p.id = apps.project_id and p.instance_id = $1
 left join keys on keys.client_id = config.client_id;
@@ -12,6 +12,7 @@ import (
 
 	"github.com/zitadel/zitadel/internal/api/authz"
 	"github.com/zitadel/zitadel/internal/database"
+	"github.com/zitadel/zitadel/internal/domain"
 )
 
 func TestQueries_GetIntrospectionClientByID(t *testing.T) {
@@ -50,8 +51,8 @@ func TestQueries_GetIntrospectionClientByID(t *testing.T) {
 				getKeys:  false,
 			},
 			mock: mockQuery(expQuery,
-				[]string{"app_id", "client_id", "client_secret", "app_type", "project_id", "resource_owner", "project_role_assertion", "public_keys"},
-				[]driver.Value{"appID", "clientID", "secret", "oidc", "projectID", "orgID", true, nil},
+				[]string{"app_id", "client_id", "client_secret", "app_type", "project_id", "resource_owner", "claims_mapping", "project_role_assertion", "access_token_type", "public_keys"},
+				[]driver.Value{"appID", "clientID", "secret", "oidc", "projectID", "orgID", nil, true, domain.OIDCTokenTypeBearer, nil},
 				"instanceID", "clientID", false),
 			want: &IntrospectionClient{
 				AppID:                "appID",
@@ -71,8 +72,8 @@ func TestQueries_GetIntrospectionClientByID(t *testing.T) {
 				getKeys:  true,
 			},
 			mock: mockQuery(expQuery,
-				[]string{"app_id", "client_id", "client_secret", "app_type", "project_id", "resource_owner", "project_role_assertion", "public_keys"},
-				[]driver.Value{"appID", "clientID", "", "oidc", "projectID", "orgID", true, encPubkeys},
+				[]string{"app_id", "client_id", "client_secret", "app_type", "project_id", "resource_owner", "claims_mapping", "project_role_assertion", "access_token_type", "public_keys"},
+				[]driver.Value{"appID", "clientID", "", "oidc", "projectID", "orgID", []byte(`{"flattenAudience":true}`), true, domain.OIDCTokenTypeJWT, encPubkeys},
 				"instanceID", "clientID", true),
 			want: &IntrospectionClient{
 				AppID:                "appID",
@@ -82,6 +83,8 @@ func TestQueries_GetIntrospectionClientByID(t *testing.T) {
 				ProjectID:            "projectID",
 				ResourceOwner:        "orgID",
 				ProjectRoleAssertion: true,
+				ClaimsMapping:        &domain.ClaimsMapping{FlattenAudience: true},
+				AccessTokenType:      domain.OIDCTokenTypeJWT,
 				PublicKeys:           pubkeys,
 			},
 		},
@@ -29,6 +29,7 @@ type LabelPolicy struct {
 	WatermarkDisabled   bool
 	ShouldErrorPopup    bool
 	ThemeMode           domain.LabelPolicyThemeMode
+	CustomCSS           string
 
 	Dark  Theme
 	Light Theme
@@ -47,6 +48,11 @@ func (q *Queries) ActiveLabelPolicyByOrg(ctx context.Context, orgID string, with
 	ctx, span := tracing.NewSpan(ctx)
 	defer func() { span.EndWithError(err) }()
 
+	owners, err := q.orgPolicyOwners(ctx, orgID)
+	if err != nil {
+		return nil, err
+	}
+
 	stmt, scan := prepareLabelPolicyQuery(ctx, q.client)
 	eq := sq.Eq{
 		LabelPolicyColState.identifier():      domain.LabelPolicyStateActive,
@@ -57,13 +63,10 @@ func (q *Queries) ActiveLabelPolicyByOrg(ctx context.Context, orgID string, with
 	}
 	query, args, err := stmt.Where(
 		sq.And{
-			sq.Or{
-				sq.Eq{LabelPolicyColID.identifier(): orgID},
-				sq.Eq{LabelPolicyColID.identifier(): authz.GetInstance(ctx).InstanceID()},
-			},
+			sq.Eq{LabelPolicyColID.identifier(): owners},
 			eq,
 		}).
-		OrderBy(LabelPolicyColIsDefault.identifier()).
+		OrderByClause(orgPolicyOwnersOrder(LabelPolicyColID, owners)).
 		Limit(1).ToSql()
 	if err != nil {
 		return nil, zerrors.ThrowInternal(err, "QUERY-V22un", "unable to create sql stmt")
@@ -238,6 +241,9 @@ var (
 	LabelPolicyThemeMode = Column{
 		name: projection.LabelPolicyThemeModeCol,
 	}
+	LabelPolicyCustomCSS = Column{
+		name: projection.LabelPolicyCustomCSSCol,
+	}
 )
 
 func prepareLabelPolicyQuery(ctx context.Context, db prepareDatabase) (sq.SelectBuilder, func(*sql.Row) (*LabelPolicy, error)) {
@@ -255,6 +261,7 @@ func prepareLabelPolicyQuery(ctx context.Context, db prepareDatabase) (sq.Select
 			LabelPolicyColWatermarkDisabled.identifier(),
 			LabelPolicyColShouldErrorPopup.identifier(),
 			LabelPolicyThemeMode.identifier(),
+			LabelPolicyCustomCSS.identifier(),
 
 			LabelPolicyColLightPrimaryColor.identifier(),
 			LabelPolicyColLightWarnColor.identifier(),
@@ -277,6 +284,7 @@ func prepareLabelPolicyQuery(ctx context.Context, db prepareDatabase) (sq.Select
 
 			var (
 				fontURL              = sql.NullString{}
+				customCSS            = sql.NullString{}
 				lightPrimaryColor    = sql.NullString{}
 				lightWarnColor       = sql.NullString{}
 				lightBackgroundColor = sql.NullString{}
@@ -305,6 +313,7 @@ func prepareLabelPolicyQuery(ctx context.Context, db prepareDatabase) (sq.Select
 				&policy.WatermarkDisabled,
 				&policy.ShouldErrorPopup,
 				&policy.ThemeMode,
+				&customCSS,
 
 				&lightPrimaryColor,
 				&lightWarnColor,
@@ -328,6 +337,7 @@ func prepareLabelPolicyQuery(ctx context.Context, db prepareDatabase) (sq.Select
 			}
 
 			policy.FontURL = fontURL.String
+			policy.CustomCSS = customCSS.String
 			policy.Light.PrimaryColor = lightPrimaryColor.String
 			policy.Light.WarnColor = lightWarnColor.String
 			policy.Light.BackgroundColor = lightBackgroundColor.String
@@ -365,5 +375,6 @@ func (p *LabelPolicy) ToDomain() *domain.LabelPolicy {
 		ErrorMsgPopup:       p.ShouldErrorPopup,
 		DisableWatermark:    p.WatermarkDisabled,
 		ThemeMode:           p.ThemeMode,
+		CustomCSS:           p.CustomCSS,
 	}
 }
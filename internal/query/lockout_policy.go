@@ -88,6 +88,11 @@ func (q *Queries) LockoutPolicyByOrg(ctx context.Context, shouldTriggerBulk bool
 	ctx, span := tracing.NewSpan(ctx)
 	defer func() { span.EndWithError(err) }()
 
+	owners, err := q.orgPolicyOwners(ctx, orgID)
+	if err != nil {
+		return nil, err
+	}
+
 	if shouldTriggerBulk {
 		_, traceSpan := tracing.NewNamedSpan(ctx, "TriggerLockoutPolicyProjection")
 		ctx, err = projection.LockoutPolicyProjection.Trigger(ctx, handler.WithAwaitRunning())
@@ -102,12 +107,9 @@ func (q *Queries) LockoutPolicyByOrg(ctx context.Context, shouldTriggerBulk bool
 	query, args, err := stmt.Where(
 		sq.And{
 			eq,
-			sq.Or{
-				sq.Eq{LockoutColID.identifier(): orgID},
-				sq.Eq{LockoutColID.identifier(): authz.GetInstance(ctx).InstanceID()},
-			},
+			sq.Eq{LockoutColID.identifier(): owners},
 		}).
-		OrderBy(LockoutColIsDefault.identifier()).
+		OrderByClause(orgPolicyOwnersOrder(LockoutColID, owners)).
 		Limit(1).ToSql()
 	if err != nil {
 		return nil, zerrors.ThrowInternal(err, "QUERY-SKR6X", "Errors.Query.SQLStatement")
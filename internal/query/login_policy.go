@@ -20,31 +20,37 @@ import (
 )
 
 type LoginPolicy struct {
-	OrgID                      string
-	CreationDate               time.Time
-	ChangeDate                 time.Time
-	Sequence                   uint64
-	AllowRegister              bool
-	AllowUsernamePassword      bool
-	AllowExternalIDPs          bool
-	ForceMFA                   bool
-	ForceMFALocalOnly          bool
-	SecondFactors              database.NumberArray[domain.SecondFactorType]
-	MultiFactors               database.NumberArray[domain.MultiFactorType]
-	PasswordlessType           domain.PasswordlessType
-	IsDefault                  bool
-	HidePasswordReset          bool
-	IgnoreUnknownUsernames     bool
-	AllowDomainDiscovery       bool
-	DisableLoginWithEmail      bool
-	DisableLoginWithPhone      bool
-	DefaultRedirectURI         string
-	PasswordCheckLifetime      database.Duration
-	ExternalLoginCheckLifetime database.Duration
-	MFAInitSkipLifetime        database.Duration
-	SecondFactorCheckLifetime  database.Duration
-	MultiFactorCheckLifetime   database.Duration
-	IDPLinks                   []*IDPLoginPolicyLink
+	OrgID                       string
+	CreationDate                time.Time
+	ChangeDate                  time.Time
+	Sequence                    uint64
+	AllowRegister               bool
+	AllowUsernamePassword       bool
+	AllowExternalIDPs           bool
+	ForceMFA                    bool
+	ForceMFALocalOnly           bool
+	SecondFactors               database.NumberArray[domain.SecondFactorType]
+	MultiFactors                database.NumberArray[domain.MultiFactorType]
+	PasswordlessType            domain.PasswordlessType
+	IsDefault                   bool
+	HidePasswordReset           bool
+	IgnoreUnknownUsernames      bool
+	AllowDomainDiscovery        bool
+	DisableLoginWithEmail       bool
+	DisableLoginWithPhone       bool
+	DefaultRedirectURI          string
+	PasswordCheckLifetime       database.Duration
+	ExternalLoginCheckLifetime  database.Duration
+	MFAInitSkipLifetime         database.Duration
+	SecondFactorCheckLifetime   database.Duration
+	MultiFactorCheckLifetime    database.Duration
+	TrustedDeviceLifetime       database.Duration
+	SharedDevice                bool
+	SharedDeviceLifetime        database.Duration
+	SharedDeviceIdleTimeout     database.Duration
+	PasskeyPrompt               bool
+	PasskeyPromptSnoozeLifetime database.Duration
+	IDPLinks                    []*IDPLoginPolicyLink
 }
 
 type SecondFactors struct {
@@ -162,6 +168,30 @@ var (
 		name:  projection.MultiFactorCheckLifetimeCol,
 		table: loginPolicyTable,
 	}
+	LoginPolicyColumnTrustedDeviceLifetime = Column{
+		name:  projection.TrustedDeviceLifetimeCol,
+		table: loginPolicyTable,
+	}
+	LoginPolicyColumnSharedDevice = Column{
+		name:  projection.SharedDeviceCol,
+		table: loginPolicyTable,
+	}
+	LoginPolicyColumnSharedDeviceLifetime = Column{
+		name:  projection.SharedDeviceLifetimeCol,
+		table: loginPolicyTable,
+	}
+	LoginPolicyColumnSharedDeviceIdleTimeout = Column{
+		name:  projection.SharedDeviceIdleTimeoutCol,
+		table: loginPolicyTable,
+	}
+	LoginPolicyColumnPasskeyPrompt = Column{
+		name:  projection.PasskeyPromptCol,
+		table: loginPolicyTable,
+	}
+	LoginPolicyColumnPasskeyPromptSnoozeLifetime = Column{
+		name:  projection.PasskeyPromptSnoozeLifetimeCol,
+		table: loginPolicyTable,
+	}
 	LoginPolicyColumnOwnerRemoved = Column{
 		name:  projection.LoginPolicyOwnerRemovedCol,
 		table: loginPolicyTable,
@@ -172,6 +202,11 @@ func (q *Queries) LoginPolicyByID(ctx context.Context, shouldTriggerBulk bool, o
 	ctx, span := tracing.NewSpan(ctx)
 	defer func() { span.EndWithError(err) }()
 
+	owners, err := q.orgPolicyOwners(ctx, orgID)
+	if err != nil {
+		return nil, err
+	}
+
 	if shouldTriggerBulk {
 		_, traceSpan := tracing.NewNamedSpan(ctx, "TriggerLoginPolicyProjection")
 		ctx, err = projection.LoginPolicyProjection.Trigger(ctx, handler.WithAwaitRunning())
@@ -187,11 +222,8 @@ func (q *Queries) LoginPolicyByID(ctx context.Context, shouldTriggerBulk bool, o
 	stmt, args, err := query.Where(
 		sq.And{
 			eq,
-			sq.Or{
-				sq.Eq{LoginPolicyColumnOrgID.identifier(): orgID},
-				sq.Eq{LoginPolicyColumnOrgID.identifier(): authz.GetInstance(ctx).InstanceID()},
-			},
-		}).Limit(1).OrderBy(LoginPolicyColumnIsDefault.identifier()).ToSql()
+			sq.Eq{LoginPolicyColumnOrgID.identifier(): owners},
+		}).Limit(1).OrderByClause(orgPolicyOwnersOrder(LoginPolicyColumnOrgID, owners)).ToSql()
 	if err != nil {
 		return nil, zerrors.ThrowInternal(err, "QUERY-scVHo", "Errors.Query.SQLStatement")
 	}
@@ -384,6 +416,12 @@ func prepareLoginPolicyQuery(ctx context.Context, db prepareDatabase) (sq.Select
 			LoginPolicyColumnMFAInitSkipLifetime.identifier(),
 			LoginPolicyColumnSecondFactorCheckLifetime.identifier(),
 			LoginPolicyColumnMultiFactorCheckLifetime.identifier(),
+			LoginPolicyColumnTrustedDeviceLifetime.identifier(),
+			LoginPolicyColumnSharedDevice.identifier(),
+			LoginPolicyColumnSharedDeviceLifetime.identifier(),
+			LoginPolicyColumnSharedDeviceIdleTimeout.identifier(),
+			LoginPolicyColumnPasskeyPrompt.identifier(),
+			LoginPolicyColumnPasskeyPromptSnoozeLifetime.identifier(),
 		).From(loginPolicyTable.identifier() + db.Timetravel(call.Took(ctx))).
 			PlaceholderFormat(sq.Dollar),
 		func(rows *sql.Rows) (*LoginPolicy, error) {
@@ -415,6 +453,12 @@ func prepareLoginPolicyQuery(ctx context.Context, db prepareDatabase) (sq.Select
 					&p.MFAInitSkipLifetime,
 					&p.SecondFactorCheckLifetime,
 					&p.MultiFactorCheckLifetime,
+					&p.TrustedDeviceLifetime,
+					&p.SharedDevice,
+					&p.SharedDeviceLifetime,
+					&p.SharedDeviceIdleTimeout,
+					&p.PasskeyPrompt,
+					&p.PasskeyPromptSnoozeLifetime,
 				)
 				if err != nil {
 					return nil, zerrors.ThrowInternal(err, "QUERY-YcC53", "Errors.Internal")
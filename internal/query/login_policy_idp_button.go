@@ -0,0 +1,178 @@
+package query
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/api/call"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/query/projection"
+	"github.com/zitadel/zitadel/internal/telemetry/tracing"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+type LoginPolicyIDPButtons struct {
+	ResourceOwner string
+	ChangeDate    time.Time
+	Sequence      uint64
+
+	Buttons []*domain.IDPButton
+	// Icons maps the id of the identity provider to the store key of its custom icon
+	Icons map[string]string
+}
+
+var (
+	loginPolicyIDPButtonsTable = table{
+		name:          projection.LoginPolicyIDPButtonsTable,
+		instanceIDCol: projection.LoginPolicyIDPButtonsInstanceIDCol,
+	}
+	LoginPolicyIDPButtonsColInstanceID = Column{
+		name:  projection.LoginPolicyIDPButtonsInstanceIDCol,
+		table: loginPolicyIDPButtonsTable,
+	}
+	LoginPolicyIDPButtonsColResourceOwner = Column{
+		name:  projection.LoginPolicyIDPButtonsResourceOwnerCol,
+		table: loginPolicyIDPButtonsTable,
+	}
+	LoginPolicyIDPButtonsColChangeDate = Column{
+		name:  projection.LoginPolicyIDPButtonsChangeDateCol,
+		table: loginPolicyIDPButtonsTable,
+	}
+	LoginPolicyIDPButtonsColSequence = Column{
+		name:  projection.LoginPolicyIDPButtonsSequenceCol,
+		table: loginPolicyIDPButtonsTable,
+	}
+	LoginPolicyIDPButtonsColButtons = Column{
+		name:  projection.LoginPolicyIDPButtonsButtonsCol,
+		table: loginPolicyIDPButtonsTable,
+	}
+
+	loginPolicyIDPIconsTable = table{
+		name:          projection.LoginPolicyIDPButtonsTable + "_" + projection.LoginPolicyIDPIconSuffix,
+		instanceIDCol: projection.LoginPolicyIDPIconInstanceIDCol,
+	}
+	LoginPolicyIDPIconColInstanceID = Column{
+		name:  projection.LoginPolicyIDPIconInstanceIDCol,
+		table: loginPolicyIDPIconsTable,
+	}
+	LoginPolicyIDPIconColResourceOwner = Column{
+		name:  projection.LoginPolicyIDPIconResourceOwnerCol,
+		table: loginPolicyIDPIconsTable,
+	}
+	LoginPolicyIDPIconColIDPID = Column{
+		name:  projection.LoginPolicyIDPIconIDPIDCol,
+		table: loginPolicyIDPIconsTable,
+	}
+	LoginPolicyIDPIconColStoreKey = Column{
+		name:  projection.LoginPolicyIDPIconStoreKeyCol,
+		table: loginPolicyIDPIconsTable,
+	}
+)
+
+// LoginPolicyIDPButtonsByOwner returns the order, the visibility rules and the custom icons of the identity provider buttons.
+// resourceOwner is the organization of the login policy or the instance for the default login policy.
+func (q *Queries) LoginPolicyIDPButtonsByOwner(ctx context.Context, resourceOwner string) (buttons *LoginPolicyIDPButtons, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	instanceID := authz.GetInstance(ctx).InstanceID()
+	stmt, scan := prepareLoginPolicyIDPButtonsQuery(ctx, q.client)
+	query, args, err := stmt.Where(sq.Eq{
+		LoginPolicyIDPButtonsColInstanceID.identifier():    instanceID,
+		LoginPolicyIDPButtonsColResourceOwner.identifier(): resourceOwner,
+	}).ToSql()
+	if err != nil {
+		return nil, zerrors.ThrowInternal(err, "QUERY-Ib2sq", "Errors.Query.SQLStatement")
+	}
+	err = q.client.QueryRowContext(ctx, func(row *sql.Row) error {
+		buttons, err = scan(row)
+		return err
+	}, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	buttons.ResourceOwner = resourceOwner
+	if buttons.Sequence == 0 {
+		return buttons, nil
+	}
+
+	iconsStmt, iconsScan := prepareLoginPolicyIDPIconsQuery(ctx, q.client)
+	query, args, err = iconsStmt.Where(sq.Eq{
+		LoginPolicyIDPIconColInstanceID.identifier():    instanceID,
+		LoginPolicyIDPIconColResourceOwner.identifier(): resourceOwner,
+	}).ToSql()
+	if err != nil {
+		return nil, zerrors.ThrowInternal(err, "QUERY-Ib3sq", "Errors.Query.SQLStatement")
+	}
+	err = q.client.QueryContext(ctx, func(rows *sql.Rows) error {
+		buttons.Icons, err = iconsScan(rows)
+		return err
+	}, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	return buttons, nil
+}
+
+// prepareLoginPolicyIDPButtonsQuery returns empty buttons if the owner never changed them.
+func prepareLoginPolicyIDPButtonsQuery(ctx context.Context, db prepareDatabase) (sq.SelectBuilder, func(*sql.Row) (*LoginPolicyIDPButtons, error)) {
+	return sq.Select(
+			LoginPolicyIDPButtonsColChangeDate.identifier(),
+			LoginPolicyIDPButtonsColSequence.identifier(),
+			LoginPolicyIDPButtonsColButtons.identifier(),
+		).
+			From(loginPolicyIDPButtonsTable.identifier() + db.Timetravel(call.Took(ctx))).
+			PlaceholderFormat(sq.Dollar),
+		func(row *sql.Row) (*LoginPolicyIDPButtons, error) {
+			buttons := &LoginPolicyIDPButtons{
+				Icons: make(map[string]string),
+			}
+			var data []byte
+			err := row.Scan(
+				&buttons.ChangeDate,
+				&buttons.Sequence,
+				&data,
+			)
+			if errors.Is(err, sql.ErrNoRows) {
+				return buttons, nil
+			}
+			if err != nil {
+				return nil, zerrors.ThrowInternal(err, "QUERY-Ib4sc", "Errors.Internal")
+			}
+			if len(data) > 0 {
+				if err = json.Unmarshal(data, &buttons.Buttons); err != nil {
+					return nil, zerrors.ThrowInternal(err, "QUERY-Ib5js", "Errors.Internal")
+				}
+			}
+			return buttons, nil
+		}
+}
+
+func prepareLoginPolicyIDPIconsQuery(ctx context.Context, db prepareDatabase) (sq.SelectBuilder, func(*sql.Rows) (map[string]string, error)) {
+	return sq.Select(
+			LoginPolicyIDPIconColIDPID.identifier(),
+			LoginPolicyIDPIconColStoreKey.identifier(),
+		).
+			From(loginPolicyIDPIconsTable.identifier() + db.Timetravel(call.Took(ctx))).
+			PlaceholderFormat(sq.Dollar),
+		func(rows *sql.Rows) (map[string]string, error) {
+			icons := make(map[string]string)
+			for rows.Next() {
+				var idpID, storeKey string
+				if err := rows.Scan(&idpID, &storeKey); err != nil {
+					return nil, zerrors.ThrowInternal(err, "QUERY-Ib6sc", "Errors.Internal")
+				}
+				icons[idpID] = storeKey
+			}
+			if err := rows.Close(); err != nil {
+				return nil, zerrors.ThrowInternal(err, "QUERY-Ib7cr", "Errors.Query.CloseRows")
+			}
+			return icons, nil
+		}
+}
@@ -0,0 +1,141 @@
+package query
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/zitadel/zitadel/internal/domain"
+)
+
+var (
+	prepareLoginPolicyIDPButtonsStmt = `SELECT projections.login_policy_idp_buttons.change_date,` +
+		` projections.login_policy_idp_buttons.sequence,` +
+		` projections.login_policy_idp_buttons.buttons` +
+		` FROM projections.login_policy_idp_buttons` +
+		` AS OF SYSTEM TIME '-1 ms'`
+	prepareLoginPolicyIDPButtonsCols = []string{
+		"change_date",
+		"sequence",
+		"buttons",
+	}
+	prepareLoginPolicyIDPIconsStmt = `SELECT projections.login_policy_idp_buttons_icons.idp_id,` +
+		` projections.login_policy_idp_buttons_icons.store_key` +
+		` FROM projections.login_policy_idp_buttons_icons` +
+		` AS OF SYSTEM TIME '-1 ms'`
+	prepareLoginPolicyIDPIconsCols = []string{
+		"idp_id",
+		"store_key",
+	}
+)
+
+func Test_LoginPolicyIDPButtonsPrepares(t *testing.T) {
+	type want struct {
+		sqlExpectations sqlExpectation
+		err             checkErr
+	}
+	tests := []struct {
+		name    string
+		prepare interface{}
+		want    want
+		object  interface{}
+	}{
+		{
+			name:    "prepareLoginPolicyIDPButtonsQuery no result",
+			prepare: prepareLoginPolicyIDPButtonsQuery,
+			want: want{
+				sqlExpectations: mockQuery(
+					regexp.QuoteMeta(prepareLoginPolicyIDPButtonsStmt),
+					nil,
+					nil,
+				),
+			},
+			object: &LoginPolicyIDPButtons{
+				Icons: map[string]string{},
+			},
+		},
+		{
+			name:    "prepareLoginPolicyIDPButtonsQuery found",
+			prepare: prepareLoginPolicyIDPButtonsQuery,
+			want: want{
+				sqlExpectations: mockQuery(
+					regexp.QuoteMeta(prepareLoginPolicyIDPButtonsStmt),
+					prepareLoginPolicyIDPButtonsCols,
+					[]driver.Value{
+						testNow,
+						uint64(20211109),
+						[]byte(`[{"idpId":"idp-id","domains":["example.com"]}]`),
+					},
+				),
+			},
+			object: &LoginPolicyIDPButtons{
+				ChangeDate: testNow,
+				Sequence:   20211109,
+				Buttons: []*domain.IDPButton{
+					{IDPID: "idp-id", Domains: []string{"example.com"}},
+				},
+				Icons: map[string]string{},
+			},
+		},
+		{
+			name:    "prepareLoginPolicyIDPButtonsQuery sql err",
+			prepare: prepareLoginPolicyIDPButtonsQuery,
+			want: want{
+				sqlExpectations: mockQueryErr(
+					regexp.QuoteMeta(prepareLoginPolicyIDPButtonsStmt),
+					sql.ErrConnDone,
+				),
+				err: func(err error) (error, bool) {
+					if !errors.Is(err, sql.ErrConnDone) {
+						return fmt.Errorf("err should be sql.ErrConnDone got: %w", err), false
+					}
+					return nil, true
+				},
+			},
+			object: (*LoginPolicyIDPButtons)(nil),
+		},
+		{
+			name:    "prepareLoginPolicyIDPIconsQuery found",
+			prepare: prepareLoginPolicyIDPIconsQuery,
+			want: want{
+				sqlExpectations: mockQueries(
+					regexp.QuoteMeta(prepareLoginPolicyIDPIconsStmt),
+					prepareLoginPolicyIDPIconsCols,
+					[][]driver.Value{
+						{"idp-id", "store-key"},
+						{"idp-id2", "store-key2"},
+					},
+				),
+			},
+			object: map[string]string{
+				"idp-id":  "store-key",
+				"idp-id2": "store-key2",
+			},
+		},
+		{
+			name:    "prepareLoginPolicyIDPIconsQuery sql err",
+			prepare: prepareLoginPolicyIDPIconsQuery,
+			want: want{
+				sqlExpectations: mockQueryErr(
+					regexp.QuoteMeta(prepareLoginPolicyIDPIconsStmt),
+					sql.ErrConnDone,
+				),
+				err: func(err error) (error, bool) {
+					if !errors.Is(err, sql.ErrConnDone) {
+						return fmt.Errorf("err should be sql.ErrConnDone got: %w", err), false
+					}
+					return nil, true
+				},
+			},
+			object: (map[string]string)(nil),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assertPrepare(t, tt.prepare, tt.object, tt.want.sqlExpectations, tt.want.err, defaultPrepareArgs...)
+		})
+	}
+}
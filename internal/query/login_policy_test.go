@@ -15,31 +15,37 @@ import (
 )
 
 var (
-	loginPolicyQuery = `SELECT projections.login_policies5.aggregate_id,` +
-		` projections.login_policies5.creation_date,` +
-		` projections.login_policies5.change_date,` +
-		` projections.login_policies5.sequence,` +
-		` projections.login_policies5.allow_register,` +
-		` projections.login_policies5.allow_username_password,` +
-		` projections.login_policies5.allow_external_idps,` +
-		` projections.login_policies5.force_mfa,` +
-		` projections.login_policies5.force_mfa_local_only,` +
-		` projections.login_policies5.second_factors,` +
-		` projections.login_policies5.multi_factors,` +
-		` projections.login_policies5.passwordless_type,` +
-		` projections.login_policies5.is_default,` +
-		` projections.login_policies5.hide_password_reset,` +
-		` projections.login_policies5.ignore_unknown_usernames,` +
-		` projections.login_policies5.allow_domain_discovery,` +
-		` projections.login_policies5.disable_login_with_email,` +
-		` projections.login_policies5.disable_login_with_phone,` +
-		` projections.login_policies5.default_redirect_uri,` +
-		` projections.login_policies5.password_check_lifetime,` +
-		` projections.login_policies5.external_login_check_lifetime,` +
-		` projections.login_policies5.mfa_init_skip_lifetime,` +
-		` projections.login_policies5.second_factor_check_lifetime,` +
-		` projections.login_policies5.multi_factor_check_lifetime` +
-		` FROM projections.login_policies5` +
+	loginPolicyQuery = `SELECT projections.login_policies8.aggregate_id,` +
+		` projections.login_policies8.creation_date,` +
+		` projections.login_policies8.change_date,` +
+		` projections.login_policies8.sequence,` +
+		` projections.login_policies8.allow_register,` +
+		` projections.login_policies8.allow_username_password,` +
+		` projections.login_policies8.allow_external_idps,` +
+		` projections.login_policies8.force_mfa,` +
+		` projections.login_policies8.force_mfa_local_only,` +
+		` projections.login_policies8.second_factors,` +
+		` projections.login_policies8.multi_factors,` +
+		` projections.login_policies8.passwordless_type,` +
+		` projections.login_policies8.is_default,` +
+		` projections.login_policies8.hide_password_reset,` +
+		` projections.login_policies8.ignore_unknown_usernames,` +
+		` projections.login_policies8.allow_domain_discovery,` +
+		` projections.login_policies8.disable_login_with_email,` +
+		` projections.login_policies8.disable_login_with_phone,` +
+		` projections.login_policies8.default_redirect_uri,` +
+		` projections.login_policies8.password_check_lifetime,` +
+		` projections.login_policies8.external_login_check_lifetime,` +
+		` projections.login_policies8.mfa_init_skip_lifetime,` +
+		` projections.login_policies8.second_factor_check_lifetime,` +
+		` projections.login_policies8.multi_factor_check_lifetime,` +
+		` projections.login_policies8.trusted_device_lifetime,` +
+		` projections.login_policies8.shared_device,` +
+		` projections.login_policies8.shared_device_lifetime,` +
+		` projections.login_policies8.shared_device_idle_timeout,` +
+		` projections.login_policies8.passkey_prompt,` +
+		` projections.login_policies8.passkey_prompt_snooze_lifetime` +
+		` FROM projections.login_policies8` +
 		` AS OF SYSTEM TIME '-1 ms'`
 	loginPolicyCols = []string{
 		"aggregate_id",
@@ -66,17 +72,23 @@ var (
 		"mfa_init_skip_lifetime",
 		"second_factor_check_lifetime",
 		"multi_factor_check_lifetime",
+		"trusted_device_lifetime",
+		"shared_device",
+		"shared_device_lifetime",
+		"shared_device_idle_timeout",
+		"passkey_prompt",
+		"passkey_prompt_snooze_lifetime",
 	}
 
-	prepareLoginPolicy2FAsStmt = `SELECT projections.login_policies5.second_factors` +
-		` FROM projections.login_policies5` +
+	prepareLoginPolicy2FAsStmt = `SELECT projections.login_policies8.second_factors` +
+		` FROM projections.login_policies8` +
 		` AS OF SYSTEM TIME '-1 ms'`
 	prepareLoginPolicy2FAsCols = []string{
 		"second_factors",
 	}
 
-	prepareLoginPolicyMFAsStmt = `SELECT projections.login_policies5.multi_factors` +
-		` FROM projections.login_policies5` +
+	prepareLoginPolicyMFAsStmt = `SELECT projections.login_policies8.multi_factors` +
+		` FROM projections.login_policies8` +
 		` AS OF SYSTEM TIME '-1 ms'`
 	prepareLoginPolicyMFAsCols = []string{
 		"multi_factors",
@@ -145,34 +157,46 @@ func Test_LoginPolicyPrepares(t *testing.T) {
 						&duration,
 						&duration,
 						&duration,
+						&duration,
+						true,
+						&duration,
+						&duration,
+						true,
+						&duration,
 					},
 				),
 			},
 			object: &LoginPolicy{
-				OrgID:                      "ro",
-				CreationDate:               testNow,
-				ChangeDate:                 testNow,
-				Sequence:                   20211109,
-				AllowRegister:              true,
-				AllowUsernamePassword:      true,
-				AllowExternalIDPs:          true,
-				ForceMFA:                   true,
-				ForceMFALocalOnly:          true,
-				SecondFactors:              database.NumberArray[domain.SecondFactorType]{domain.SecondFactorTypeTOTP},
-				MultiFactors:               database.NumberArray[domain.MultiFactorType]{domain.MultiFactorTypeU2FWithPIN},
-				PasswordlessType:           domain.PasswordlessTypeAllowed,
-				IsDefault:                  true,
-				HidePasswordReset:          true,
-				IgnoreUnknownUsernames:     true,
-				AllowDomainDiscovery:       true,
-				DisableLoginWithEmail:      true,
-				DisableLoginWithPhone:      true,
-				DefaultRedirectURI:         "https://example.com/redirect",
-				PasswordCheckLifetime:      database.Duration(duration),
-				ExternalLoginCheckLifetime: database.Duration(duration),
-				MFAInitSkipLifetime:        database.Duration(duration),
-				SecondFactorCheckLifetime:  database.Duration(duration),
-				MultiFactorCheckLifetime:   database.Duration(duration),
+				OrgID:                       "ro",
+				CreationDate:                testNow,
+				ChangeDate:                  testNow,
+				Sequence:                    20211109,
+				AllowRegister:               true,
+				AllowUsernamePassword:       true,
+				AllowExternalIDPs:           true,
+				ForceMFA:                    true,
+				ForceMFALocalOnly:           true,
+				SecondFactors:               database.NumberArray[domain.SecondFactorType]{domain.SecondFactorTypeTOTP},
+				MultiFactors:                database.NumberArray[domain.MultiFactorType]{domain.MultiFactorTypeU2FWithPIN},
+				PasswordlessType:            domain.PasswordlessTypeAllowed,
+				IsDefault:                   true,
+				HidePasswordReset:           true,
+				IgnoreUnknownUsernames:      true,
+				AllowDomainDiscovery:        true,
+				DisableLoginWithEmail:       true,
+				DisableLoginWithPhone:       true,
+				DefaultRedirectURI:          "https://example.com/redirect",
+				PasswordCheckLifetime:       database.Duration(duration),
+				ExternalLoginCheckLifetime:  database.Duration(duration),
+				MFAInitSkipLifetime:         database.Duration(duration),
+				SecondFactorCheckLifetime:   database.Duration(duration),
+				MultiFactorCheckLifetime:    database.Duration(duration),
+				TrustedDeviceLifetime:       database.Duration(duration),
+				SharedDevice:                true,
+				SharedDeviceLifetime:        database.Duration(duration),
+				SharedDeviceIdleTimeout:     database.Duration(duration),
+				PasskeyPrompt:               true,
+				PasskeyPromptSnoozeLifetime: database.Duration(duration),
 			},
 		},
 		{
@@ -0,0 +1,235 @@
+package query
+
+import (
+	"context"
+	"database/sql"
+	"slices"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/api/call"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/query/projection"
+	"github.com/zitadel/zitadel/internal/repository/logout"
+	"github.com/zitadel/zitadel/internal/telemetry/tracing"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+var (
+	oidcSessionClientTable = table{
+		name:          projection.OIDCSessionClientProjectionTable,
+		instanceIDCol: projection.OIDCSessionClientColumnInstanceID,
+	}
+	OIDCSessionClientColumnInstanceID = Column{
+		name:  projection.OIDCSessionClientColumnInstanceID,
+		table: oidcSessionClientTable,
+	}
+	OIDCSessionClientColumnSessionID = Column{
+		name:  projection.OIDCSessionClientColumnSessionID,
+		table: oidcSessionClientTable,
+	}
+	OIDCSessionClientColumnUserAgentID = Column{
+		name:  projection.OIDCSessionClientColumnUserAgentID,
+		table: oidcSessionClientTable,
+	}
+	OIDCSessionClientColumnUserID = Column{
+		name:  projection.OIDCSessionClientColumnUserID,
+		table: oidcSessionClientTable,
+	}
+	OIDCSessionClientColumnClientID = Column{
+		name:  projection.OIDCSessionClientColumnClientID,
+		table: oidcSessionClientTable,
+	}
+)
+
+type Logout struct {
+	ID                    string
+	CreationDate          time.Time
+	ChangeDate            time.Time
+	ResourceOwner         string
+	Sequence              uint64
+	UserID                string
+	SessionID             string
+	UserAgentID           string
+	PostLogoutRedirectURI string
+	Targets               []*LogoutTarget
+}
+
+// LogoutTarget is the status of the logout of a relying party or identity provider.
+type LogoutTarget struct {
+	domain.LogoutTarget
+	State      domain.LogoutTargetState
+	Reason     string
+	ChangeDate time.Time
+}
+
+// PendingTargets returns the targets of the passed types which were not reported yet.
+func (l *Logout) PendingTargets(types ...domain.LogoutTargetType) []*LogoutTarget {
+	targets := make([]*LogoutTarget, 0, len(l.Targets))
+	for _, target := range l.Targets {
+		if !target.State.IsReported() && slices.Contains(types, target.Type) {
+			targets = append(targets, target)
+		}
+	}
+	return targets
+}
+
+// LogoutByID returns the status of the logout and all of its targets.
+func (q *Queries) LogoutByID(ctx context.Context, logoutID, resourceOwner string) (_ *Logout, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	if logoutID == "" {
+		return nil, zerrors.ThrowInvalidArgument(nil, "QUERY-Lo2kw", "Errors.IDMissing")
+	}
+	readModel := NewLogoutReadModel(logoutID, resourceOwner)
+	if err = q.eventstore.FilterToQueryReducer(ctx, readModel); err != nil {
+		return nil, err
+	}
+	if readModel.Logout.UserID == "" {
+		return nil, zerrors.ThrowNotFound(nil, "QUERY-Lo3nd", "Errors.Logout.NotFound")
+	}
+	return readModel.Logout, nil
+}
+
+// SessionClientIDs returns the client IDs of all relying parties the session was used for.
+func (q *Queries) SessionClientIDs(ctx context.Context, sessionID string) (_ []string, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	if sessionID == "" {
+		return nil, zerrors.ThrowInvalidArgument(nil, "QUERY-Lo8vm", "Errors.IDMissing")
+	}
+	return q.oidcSessionClientIDs(ctx, sq.Eq{
+		OIDCSessionClientColumnInstanceID.identifier(): authz.GetInstance(ctx).InstanceID(),
+		OIDCSessionClientColumnSessionID.identifier():  sessionID,
+	})
+}
+
+// UserAgentClientIDs returns the client IDs of all relying parties the user agent was used for by the passed users.
+// It is used for the logout of sessions created through the login UI (v1), which are bound to the user agent.
+func (q *Queries) UserAgentClientIDs(ctx context.Context, userAgentID string, userIDs []string) (_ []string, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	if userAgentID == "" {
+		return nil, zerrors.ThrowInvalidArgument(nil, "QUERY-Lo5tq", "Errors.IDMissing")
+	}
+	eq := sq.Eq{
+		OIDCSessionClientColumnInstanceID.identifier():  authz.GetInstance(ctx).InstanceID(),
+		OIDCSessionClientColumnUserAgentID.identifier(): userAgentID,
+	}
+	if len(userIDs) > 0 {
+		eq[OIDCSessionClientColumnUserID.identifier()] = userIDs
+	}
+	return q.oidcSessionClientIDs(ctx, eq)
+}
+
+func (q *Queries) oidcSessionClientIDs(ctx context.Context, eq sq.Eq) (clientIDs []string, err error) {
+	stmt, scan := prepareOIDCSessionClientIDsQuery(ctx, q.client)
+	query, args, err := stmt.Where(eq).ToSql()
+	if err != nil {
+		return nil, zerrors.ThrowInternal(err, "QUERY-Lo6sq", "Errors.Query.SQLStatement")
+	}
+
+	err = q.client.QueryContext(ctx, func(rows *sql.Rows) error {
+		clientIDs, err = scan(rows)
+		return err
+	}, query, args...)
+	return clientIDs, err
+}
+
+func prepareOIDCSessionClientIDsQuery(ctx context.Context, db prepareDatabase) (sq.SelectBuilder, func(*sql.Rows) ([]string, error)) {
+	return sq.Select(
+			OIDCSessionClientColumnClientID.identifier(),
+		).
+			Distinct().
+			From(oidcSessionClientTable.identifier() + db.Timetravel(call.Took(ctx))).
+			OrderBy(OIDCSessionClientColumnClientID.identifier()).
+			PlaceholderFormat(sq.Dollar),
+		func(rows *sql.Rows) ([]string, error) {
+			clientIDs := make([]string, 0)
+			for rows.Next() {
+				var clientID string
+				if err := rows.Scan(&clientID); err != nil {
+					return nil, zerrors.ThrowInternal(err, "QUERY-Lo7sc", "Errors.Internal")
+				}
+				clientIDs = append(clientIDs, clientID)
+			}
+			if err := rows.Close(); err != nil {
+				return nil, zerrors.ThrowInternal(err, "QUERY-Lo9cr", "Errors.Query.CloseRows")
+			}
+			return clientIDs, nil
+		}
+}
+
+type LogoutReadModel struct {
+	*eventstore.ReadModel
+
+	Logout *Logout
+}
+
+func NewLogoutReadModel(logoutID, resourceOwner string) *LogoutReadModel {
+	return &LogoutReadModel{
+		ReadModel: &eventstore.ReadModel{
+			AggregateID:   logoutID,
+			ResourceOwner: resourceOwner,
+		},
+		Logout: &Logout{ID: logoutID},
+	}
+}
+
+func (rm *LogoutReadModel) Reduce() error {
+	for _, event := range rm.Events {
+		switch e := event.(type) {
+		case *logout.StartedEvent:
+			rm.Logout.CreationDate = e.CreationDate()
+			rm.Logout.ResourceOwner = e.Aggregate().ResourceOwner
+			rm.Logout.UserID = e.UserID
+			rm.Logout.SessionID = e.SessionID
+			rm.Logout.UserAgentID = e.UserAgentID
+			rm.Logout.PostLogoutRedirectURI = e.PostLogoutRedirectURI
+			rm.Logout.Targets = make([]*LogoutTarget, len(e.Targets))
+			for i, target := range e.Targets {
+				rm.Logout.Targets[i] = &LogoutTarget{
+					LogoutTarget: *target,
+					State:        domain.LogoutTargetStatePending,
+					ChangeDate:   e.CreationDate(),
+				}
+			}
+		case *logout.TargetReportedEvent:
+			for _, target := range rm.Logout.Targets {
+				if target.ID != e.TargetID || target.Type != e.TargetType {
+					continue
+				}
+				target.State = e.State
+				target.Reason = e.Reason
+				target.ChangeDate = e.CreationDate()
+			}
+		}
+		rm.Logout.ChangeDate = event.CreatedAt()
+		rm.Logout.Sequence = event.Sequence()
+	}
+	return rm.ReadModel.Reduce()
+}
+
+func (rm *LogoutReadModel) Query() *eventstore.SearchQueryBuilder {
+	query := eventstore.NewSearchQueryBuilder(eventstore.ColumnsEvent).
+		AwaitOpenTransactions().
+		AddQuery().
+		AggregateTypes(logout.AggregateType).
+		AggregateIDs(rm.AggregateID).
+		EventTypes(
+			logout.StartedType,
+			logout.TargetReportedType,
+		).
+		Builder()
+
+	if rm.ResourceOwner != "" {
+		query.ResourceOwner(rm.ResourceOwner)
+	}
+	return query
+}
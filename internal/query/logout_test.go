@@ -0,0 +1,121 @@
+package query
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/repository/logout"
+)
+
+func TestLogoutReadModel_Reduce(t *testing.T) {
+	agg := &logout.NewAggregate("logout1", "org1").Aggregate
+	targets := []*domain.LogoutTarget{
+		{ID: "client1", Type: domain.LogoutTargetTypeOIDCBackChannel, URI: "https://rp1.com/logout"},
+		{ID: "client1", Type: domain.LogoutTargetTypeOIDCFrontChannel, URI: "https://rp1.com/frontchannel"},
+		{ID: "idp1", Type: domain.LogoutTargetTypeSAMLIDP, Subject: "externalUser1"},
+	}
+	rm := NewLogoutReadModel("logout1", "")
+	rm.AppendEvents(
+		logout.NewStartedEvent(context.Background(), agg, "user1", "session1", "agent1", "https://rp1.com/logged-out", targets),
+		logout.NewTargetReportedEvent(context.Background(), agg, "client1", domain.LogoutTargetTypeOIDCBackChannel, domain.LogoutTargetStateFailed, "status 500"),
+		logout.NewTargetReportedEvent(context.Background(), agg, "idp1", domain.LogoutTargetTypeSAMLIDP, domain.LogoutTargetStateDelegated, ""),
+	)
+	require.NoError(t, rm.Reduce())
+
+	assert.Equal(t, "org1", rm.Logout.ResourceOwner)
+	assert.Equal(t, "user1", rm.Logout.UserID)
+	assert.Equal(t, "session1", rm.Logout.SessionID)
+	assert.Equal(t, "agent1", rm.Logout.UserAgentID)
+	assert.Equal(t, "https://rp1.com/logged-out", rm.Logout.PostLogoutRedirectURI)
+	require.Len(t, rm.Logout.Targets, 3)
+	assert.Equal(t, domain.LogoutTargetStateFailed, rm.Logout.Targets[0].State)
+	assert.Equal(t, "status 500", rm.Logout.Targets[0].Reason)
+	assert.Equal(t, domain.LogoutTargetStatePending, rm.Logout.Targets[1].State)
+	assert.Equal(t, domain.LogoutTargetStateDelegated, rm.Logout.Targets[2].State)
+
+	pending := rm.Logout.PendingTargets(domain.LogoutTargetTypeOIDCFrontChannel, domain.LogoutTargetTypeSAMLIDP)
+	require.Len(t, pending, 1)
+	assert.Equal(t, domain.LogoutTargetTypeOIDCFrontChannel, pending[0].Type)
+}
+
+var (
+	prepareOIDCSessionClientIDsStmt = `SELECT DISTINCT projections.oidc_session_clients.client_id` +
+		` FROM projections.oidc_session_clients` +
+		` AS OF SYSTEM TIME '-1 ms'` +
+		` ORDER BY projections.oidc_session_clients.client_id`
+	prepareOIDCSessionClientIDsCols = []string{
+		"client_id",
+	}
+)
+
+func Test_OIDCSessionClientIDsPrepares(t *testing.T) {
+	type want struct {
+		sqlExpectations sqlExpectation
+		err             checkErr
+	}
+	tests := []struct {
+		name    string
+		prepare interface{}
+		want    want
+		object  interface{}
+	}{
+		{
+			name:    "prepareOIDCSessionClientIDsQuery no result",
+			prepare: prepareOIDCSessionClientIDsQuery,
+			want: want{
+				sqlExpectations: mockQueries(
+					regexp.QuoteMeta(prepareOIDCSessionClientIDsStmt),
+					nil,
+					nil,
+				),
+			},
+			object: []string{},
+		},
+		{
+			name:    "prepareOIDCSessionClientIDsQuery found",
+			prepare: prepareOIDCSessionClientIDsQuery,
+			want: want{
+				sqlExpectations: mockQueries(
+					regexp.QuoteMeta(prepareOIDCSessionClientIDsStmt),
+					prepareOIDCSessionClientIDsCols,
+					[][]driver.Value{
+						{"client1"},
+						{"client3"},
+					},
+				),
+			},
+			object: []string{"client1", "client3"},
+		},
+		{
+			name:    "prepareOIDCSessionClientIDsQuery sql err",
+			prepare: prepareOIDCSessionClientIDsQuery,
+			want: want{
+				sqlExpectations: mockQueryErr(
+					regexp.QuoteMeta(prepareOIDCSessionClientIDsStmt),
+					sql.ErrConnDone,
+				),
+				err: func(err error) (error, bool) {
+					if !errors.Is(err, sql.ErrConnDone) {
+						return fmt.Errorf("err should be sql.ErrConnDone got: %w", err), false
+					}
+					return nil, true
+				},
+			},
+			object: ([]string)(nil),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assertPrepare(t, tt.prepare, tt.object, tt.want.sqlExpectations, tt.want.err, defaultPrepareArgs...)
+		})
+	}
+}
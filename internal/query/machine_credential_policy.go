@@ -0,0 +1,86 @@
+package query
+
+import (
+	"context"
+	"time"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/repository/org"
+	"github.com/zitadel/zitadel/internal/telemetry/tracing"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+type MachineCredentialPolicy struct {
+	OrgID      string
+	ChangeDate time.Time
+	Sequence   uint64
+
+	MaxLifetime  time.Duration
+	NotifyBefore time.Duration
+}
+
+// MachineCredentialPolicyByOrg returns the machine credential policy of the organization.
+// Organizations without a policy don't limit the lifetime of machine keys and personal access tokens, so no default policy exists.
+func (q *Queries) MachineCredentialPolicyByOrg(ctx context.Context, orgID string) (_ *MachineCredentialPolicy, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	model := newMachineCredentialPolicyReadModel(authz.GetInstance(ctx).InstanceID(), orgID)
+	if err = q.eventstore.FilterToQueryReducer(ctx, model); err != nil {
+		return nil, err
+	}
+	if model.policy == nil {
+		return nil, zerrors.ThrowNotFound(nil, "QUERY-Mcp1n", "Errors.Org.MachineCredentialPolicy.NotFound")
+	}
+	return model.policy, nil
+}
+
+type machineCredentialPolicyReadModel struct {
+	eventstore.ReadModel
+
+	policy *MachineCredentialPolicy
+}
+
+func newMachineCredentialPolicyReadModel(instanceID, orgID string) *machineCredentialPolicyReadModel {
+	return &machineCredentialPolicyReadModel{
+		ReadModel: eventstore.ReadModel{
+			AggregateID:   orgID,
+			ResourceOwner: orgID,
+			InstanceID:    instanceID,
+		},
+	}
+}
+
+func (rm *machineCredentialPolicyReadModel) Reduce() error {
+	for _, event := range rm.Events {
+		switch e := event.(type) {
+		case *org.MachineCredentialPolicySetEvent:
+			rm.policy = &MachineCredentialPolicy{
+				OrgID:        e.Aggregate().ID,
+				ChangeDate:   e.CreatedAt(),
+				Sequence:     e.Sequence(),
+				MaxLifetime:  e.MaxLifetime,
+				NotifyBefore: e.NotifyBefore,
+			}
+		case *org.MachineCredentialPolicyRemovedEvent, *org.OrgRemovedEvent:
+			rm.policy = nil
+		}
+	}
+	return rm.ReadModel.Reduce()
+}
+
+func (rm *machineCredentialPolicyReadModel) Query() *eventstore.SearchQueryBuilder {
+	return eventstore.NewSearchQueryBuilder(eventstore.ColumnsEvent).
+		AwaitOpenTransactions().
+		InstanceID(rm.InstanceID).
+		AddQuery().
+		AggregateTypes(org.AggregateType).
+		AggregateIDs(rm.AggregateID).
+		EventTypes(
+			org.MachineCredentialPolicySetEventType,
+			org.MachineCredentialPolicyRemovedEventType,
+			org.OrgRemovedEventType,
+		).
+		Builder()
+}
@@ -0,0 +1,16 @@
+package query
+
+import (
+	"context"
+
+	"github.com/zitadel/zitadel/internal/migration"
+	"github.com/zitadel/zitadel/internal/telemetry/tracing"
+)
+
+// MigrationSteps returns the state of the setup steps executed on the database
+func (q *Queries) MigrationSteps(ctx context.Context) (steps []*migration.Step, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	return migration.Steps(ctx, q.eventstore)
+}
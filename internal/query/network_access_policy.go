@@ -0,0 +1,205 @@
+package query
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/api/call"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/query/projection"
+	"github.com/zitadel/zitadel/internal/telemetry/tracing"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+type NetworkAccessPolicy struct {
+	ResourceOwner string
+	ChangeDate    time.Time
+	Sequence      uint64
+
+	domain.NetworkAccessRules
+}
+
+var (
+	networkAccessPolicyTable = table{
+		name:          projection.NetworkAccessPolicyTable,
+		instanceIDCol: projection.NetworkAccessPolicyInstanceIDCol,
+	}
+	NetworkAccessPolicyColID = Column{
+		name:  projection.NetworkAccessPolicyIDCol,
+		table: networkAccessPolicyTable,
+	}
+	NetworkAccessPolicyColInstanceID = Column{
+		name:  projection.NetworkAccessPolicyInstanceIDCol,
+		table: networkAccessPolicyTable,
+	}
+	NetworkAccessPolicyColResourceOwner = Column{
+		name:  projection.NetworkAccessPolicyResourceOwnerCol,
+		table: networkAccessPolicyTable,
+	}
+	NetworkAccessPolicyColProjectID = Column{
+		name:  projection.NetworkAccessPolicyProjectIDCol,
+		table: networkAccessPolicyTable,
+	}
+	NetworkAccessPolicyColClientID = Column{
+		name:  projection.NetworkAccessPolicyClientIDCol,
+		table: networkAccessPolicyTable,
+	}
+	NetworkAccessPolicyColChangeDate = Column{
+		name:  projection.NetworkAccessPolicyChangeDateCol,
+		table: networkAccessPolicyTable,
+	}
+	NetworkAccessPolicyColSequence = Column{
+		name:  projection.NetworkAccessPolicySequenceCol,
+		table: networkAccessPolicyTable,
+	}
+	NetworkAccessPolicyColRules = Column{
+		name:  projection.NetworkAccessPolicyRulesCol,
+		table: networkAccessPolicyTable,
+	}
+)
+
+// NetworkAccessPolicyByOrg returns the network access policy of the organization.
+// Organizations without a policy don't restrict the client networks, so no default policy exists.
+func (q *Queries) NetworkAccessPolicyByOrg(ctx context.Context, orgID string) (_ *NetworkAccessPolicy, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	return q.networkAccessPolicy(ctx, sq.Eq{
+		NetworkAccessPolicyColInstanceID.identifier(): authz.GetInstance(ctx).InstanceID(),
+		NetworkAccessPolicyColID.identifier():         orgID,
+		NetworkAccessPolicyColProjectID.identifier():  "",
+	})
+}
+
+// NetworkAccessPolicyByApp returns the network access policy of the OIDC application.
+func (q *Queries) NetworkAccessPolicyByApp(ctx context.Context, projectID, appID, resourceOwner string) (_ *NetworkAccessPolicy, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	eq := sq.Eq{
+		NetworkAccessPolicyColInstanceID.identifier(): authz.GetInstance(ctx).InstanceID(),
+		NetworkAccessPolicyColID.identifier():         appID,
+		NetworkAccessPolicyColProjectID.identifier():  projectID,
+	}
+	if resourceOwner != "" {
+		eq[NetworkAccessPolicyColResourceOwner.identifier()] = resourceOwner
+	}
+	return q.networkAccessPolicy(ctx, eq)
+}
+
+func (q *Queries) networkAccessPolicy(ctx context.Context, eq sq.Eq) (policy *NetworkAccessPolicy, err error) {
+	stmt, scan := prepareNetworkAccessPolicyQuery(ctx, q.client)
+	query, args, err := stmt.Where(eq).ToSql()
+	if err != nil {
+		return nil, zerrors.ThrowInternal(err, "QUERY-Nap3q", "Errors.Query.SQLStatement")
+	}
+
+	err = q.client.QueryRowContext(ctx, func(row *sql.Row) error {
+		policy, err = scan(row)
+		return err
+	}, query, args...)
+	return policy, err
+}
+
+// NetworkAccessPolicies returns the network access policies of the organization and of the application (identified by its client id),
+// which apply to a user of the organization authenticating for the application.
+// Missing policies are nil and don't restrict the client networks.
+func (q *Queries) NetworkAccessPolicies(ctx context.Context, orgID, clientID string) (policies *domain.NetworkAccessPolicies, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	owners := sq.Or{
+		sq.Eq{
+			NetworkAccessPolicyColID.identifier():        orgID,
+			NetworkAccessPolicyColProjectID.identifier(): "",
+		},
+	}
+	if clientID != "" {
+		owners = append(owners, sq.Eq{NetworkAccessPolicyColClientID.identifier(): clientID})
+	}
+	stmt, scan := prepareNetworkAccessPoliciesQuery(ctx, q.client)
+	query, args, err := stmt.Where(sq.And{
+		sq.Eq{NetworkAccessPolicyColInstanceID.identifier(): authz.GetInstance(ctx).InstanceID()},
+		owners,
+	}).ToSql()
+	if err != nil {
+		return nil, zerrors.ThrowInternal(err, "QUERY-Nap4q", "Errors.Query.SQLStatement")
+	}
+
+	err = q.client.QueryContext(ctx, func(rows *sql.Rows) error {
+		policies, err = scan(rows)
+		return err
+	}, query, args...)
+	return policies, err
+}
+
+func prepareNetworkAccessPolicyQuery(ctx context.Context, db prepareDatabase) (sq.SelectBuilder, func(*sql.Row) (*NetworkAccessPolicy, error)) {
+	return sq.Select(
+			NetworkAccessPolicyColResourceOwner.identifier(),
+			NetworkAccessPolicyColChangeDate.identifier(),
+			NetworkAccessPolicyColSequence.identifier(),
+			NetworkAccessPolicyColRules.identifier(),
+		).
+			From(networkAccessPolicyTable.identifier() + db.Timetravel(call.Took(ctx))).
+			PlaceholderFormat(sq.Dollar),
+		func(row *sql.Row) (*NetworkAccessPolicy, error) {
+			policy := new(NetworkAccessPolicy)
+			var rules []byte
+			err := row.Scan(
+				&policy.ResourceOwner,
+				&policy.ChangeDate,
+				&policy.Sequence,
+				&rules,
+			)
+			if err != nil {
+				if errors.Is(err, sql.ErrNoRows) {
+					return nil, zerrors.ThrowNotFound(err, "QUERY-Nap1n", "Errors.NetworkAccessPolicy.NotFound")
+				}
+				return nil, zerrors.ThrowInternal(err, "QUERY-Nap5s", "Errors.Internal")
+			}
+			if err = json.Unmarshal(rules, &policy.NetworkAccessRules); err != nil {
+				return nil, zerrors.ThrowInternal(err, "QUERY-Nap6j", "Errors.Internal")
+			}
+			return policy, nil
+		}
+}
+
+func prepareNetworkAccessPoliciesQuery(ctx context.Context, db prepareDatabase) (sq.SelectBuilder, func(*sql.Rows) (*domain.NetworkAccessPolicies, error)) {
+	return sq.Select(
+			NetworkAccessPolicyColProjectID.identifier(),
+			NetworkAccessPolicyColRules.identifier(),
+		).
+			From(networkAccessPolicyTable.identifier() + db.Timetravel(call.Took(ctx))).
+			PlaceholderFormat(sq.Dollar),
+		func(rows *sql.Rows) (*domain.NetworkAccessPolicies, error) {
+			policies := new(domain.NetworkAccessPolicies)
+			for rows.Next() {
+				var (
+					projectID string
+					data      []byte
+				)
+				if err := rows.Scan(&projectID, &data); err != nil {
+					return nil, err
+				}
+				rules := new(domain.NetworkAccessRules)
+				if err := json.Unmarshal(data, rules); err != nil {
+					return nil, zerrors.ThrowInternal(err, "QUERY-Nap7j", "Errors.Internal")
+				}
+				if projectID == "" {
+					policies.Org = rules
+					continue
+				}
+				policies.App = rules
+			}
+			if err := rows.Close(); err != nil {
+				return nil, zerrors.ThrowInternal(err, "QUERY-Nap8c", "Errors.Query.CloseRows")
+			}
+			return policies, nil
+		}
+}
@@ -0,0 +1,162 @@
+package query
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+var (
+	prepareNetworkAccessPolicyStmt = `SELECT projections.network_access_policies.resource_owner,` +
+		` projections.network_access_policies.change_date,` +
+		` projections.network_access_policies.sequence,` +
+		` projections.network_access_policies.rules` +
+		` FROM projections.network_access_policies` +
+		` AS OF SYSTEM TIME '-1 ms'`
+	prepareNetworkAccessPolicyCols = []string{
+		"resource_owner",
+		"change_date",
+		"sequence",
+		"rules",
+	}
+	prepareNetworkAccessPoliciesStmt = `SELECT projections.network_access_policies.project_id,` +
+		` projections.network_access_policies.rules` +
+		` FROM projections.network_access_policies` +
+		` AS OF SYSTEM TIME '-1 ms'`
+	prepareNetworkAccessPoliciesCols = []string{
+		"project_id",
+		"rules",
+	}
+)
+
+func Test_NetworkAccessPolicyPrepares(t *testing.T) {
+	type want struct {
+		sqlExpectations sqlExpectation
+		err             checkErr
+	}
+	tests := []struct {
+		name    string
+		prepare interface{}
+		want    want
+		object  interface{}
+	}{
+		{
+			name:    "prepareNetworkAccessPolicyQuery no result",
+			prepare: prepareNetworkAccessPolicyQuery,
+			want: want{
+				sqlExpectations: mockQueriesScanErr(
+					regexp.QuoteMeta(prepareNetworkAccessPolicyStmt),
+					nil,
+					nil,
+				),
+				err: func(err error) (error, bool) {
+					if !zerrors.IsNotFound(err) {
+						return fmt.Errorf("err should be zitadel.NotFoundError got: %w", err), false
+					}
+					return nil, true
+				},
+			},
+			object: (*NetworkAccessPolicy)(nil),
+		},
+		{
+			name:    "prepareNetworkAccessPolicyQuery found",
+			prepare: prepareNetworkAccessPolicyQuery,
+			want: want{
+				sqlExpectations: mockQuery(
+					regexp.QuoteMeta(prepareNetworkAccessPolicyStmt),
+					prepareNetworkAccessPolicyCols,
+					[]driver.Value{
+						"ro",
+						testNow,
+						uint64(20211109),
+						[]byte(`{"allowedCidrs":["10.0.0.0/8"],"monitorOnly":true}`),
+					},
+				),
+			},
+			object: &NetworkAccessPolicy{
+				ResourceOwner: "ro",
+				ChangeDate:    testNow,
+				Sequence:      20211109,
+				NetworkAccessRules: domain.NetworkAccessRules{
+					AllowedCIDRs: []string{"10.0.0.0/8"},
+					MonitorOnly:  true,
+				},
+			},
+		},
+		{
+			name:    "prepareNetworkAccessPolicyQuery sql err",
+			prepare: prepareNetworkAccessPolicyQuery,
+			want: want{
+				sqlExpectations: mockQueryErr(
+					regexp.QuoteMeta(prepareNetworkAccessPolicyStmt),
+					sql.ErrConnDone,
+				),
+				err: func(err error) (error, bool) {
+					if !errors.Is(err, sql.ErrConnDone) {
+						return fmt.Errorf("err should be sql.ErrConnDone got: %w", err), false
+					}
+					return nil, true
+				},
+			},
+			object: (*NetworkAccessPolicy)(nil),
+		},
+		{
+			name:    "prepareNetworkAccessPoliciesQuery no result",
+			prepare: prepareNetworkAccessPoliciesQuery,
+			want: want{
+				sqlExpectations: mockQueries(
+					regexp.QuoteMeta(prepareNetworkAccessPoliciesStmt),
+					nil,
+					nil,
+				),
+			},
+			object: &domain.NetworkAccessPolicies{},
+		},
+		{
+			name:    "prepareNetworkAccessPoliciesQuery org and app",
+			prepare: prepareNetworkAccessPoliciesQuery,
+			want: want{
+				sqlExpectations: mockQueries(
+					regexp.QuoteMeta(prepareNetworkAccessPoliciesStmt),
+					prepareNetworkAccessPoliciesCols,
+					[][]driver.Value{
+						{"", []byte(`{"deniedCidrs":["10.0.0.0/8"]}`)},
+						{"project-id", []byte(`{"allowedCountries":["CH"]}`)},
+					},
+				),
+			},
+			object: &domain.NetworkAccessPolicies{
+				Org: &domain.NetworkAccessRules{DeniedCIDRs: []string{"10.0.0.0/8"}},
+				App: &domain.NetworkAccessRules{AllowedCountries: []string{"CH"}},
+			},
+		},
+		{
+			name:    "prepareNetworkAccessPoliciesQuery sql err",
+			prepare: prepareNetworkAccessPoliciesQuery,
+			want: want{
+				sqlExpectations: mockQueryErr(
+					regexp.QuoteMeta(prepareNetworkAccessPoliciesStmt),
+					sql.ErrConnDone,
+				),
+				err: func(err error) (error, bool) {
+					if !errors.Is(err, sql.ErrConnDone) {
+						return fmt.Errorf("err should be sql.ErrConnDone got: %w", err), false
+					}
+					return nil, true
+				},
+			},
+			object: (*domain.NetworkAccessPolicies)(nil),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assertPrepare(t, tt.prepare, tt.object, tt.want.sqlExpectations, tt.want.err, defaultPrepareArgs...)
+		})
+	}
+}
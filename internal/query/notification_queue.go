@@ -0,0 +1,220 @@
+package query
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/api/call"
+	"github.com/zitadel/zitadel/internal/crypto"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/query/projection"
+	"github.com/zitadel/zitadel/internal/telemetry/tracing"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+type QueuedNotifications struct {
+	SearchResponse
+	QueuedNotifications []*QueuedNotification
+}
+
+// QueuedNotification is a notification, whose first delivery failed and which is retried asynchronously
+type QueuedNotification struct {
+	InstanceID            string
+	ID                    string
+	CreationDate          time.Time
+	ChangeDate            time.Time
+	Sequence              uint64
+	Channel               string
+	Recipient             string
+	TriggeringAggregateID string
+	TriggeringEventType   eventstore.EventType
+	// Message is the encrypted rendered message
+	Message     *crypto.CryptoValue
+	State       domain.NotificationQueueState
+	Attempts    uint16
+	NextAttempt time.Time
+	LastError   string
+}
+
+type QueuedNotificationSearchQueries struct {
+	SearchRequest
+	Queries []SearchQuery
+}
+
+func (q *QueuedNotificationSearchQueries) toQuery(query sq.SelectBuilder) sq.SelectBuilder {
+	query = q.SearchRequest.toQuery(query)
+	for _, q := range q.Queries {
+		query = q.toQuery(query)
+	}
+	return query
+}
+
+func NewQueuedNotificationStateSearchQuery(state domain.NotificationQueueState) (SearchQuery, error) {
+	return NewNumberQuery(QueuedNotificationColumnState, state, NumberEquals)
+}
+
+// NewQueuedNotificationDueSearchQuery returns the notifications, which are due for their next attempt
+func NewQueuedNotificationDueSearchQuery(now time.Time) (SearchQuery, error) {
+	return NewTimestampQuery(QueuedNotificationColumnNextAttempt, now, TimestampLessOrEquals)
+}
+
+var (
+	queuedNotificationTable = table{
+		name:          projection.NotificationQueueTable,
+		instanceIDCol: projection.NotificationQueueInstanceIDCol,
+	}
+	QueuedNotificationColumnInstanceID = Column{
+		name:  projection.NotificationQueueInstanceIDCol,
+		table: queuedNotificationTable,
+	}
+	QueuedNotificationColumnID = Column{
+		name:  projection.NotificationQueueIDCol,
+		table: queuedNotificationTable,
+	}
+	QueuedNotificationColumnCreationDate = Column{
+		name:  projection.NotificationQueueCreationDateCol,
+		table: queuedNotificationTable,
+	}
+	QueuedNotificationColumnChangeDate = Column{
+		name:  projection.NotificationQueueChangeDateCol,
+		table: queuedNotificationTable,
+	}
+	QueuedNotificationColumnSequence = Column{
+		name:  projection.NotificationQueueSequenceCol,
+		table: queuedNotificationTable,
+	}
+	QueuedNotificationColumnChannel = Column{
+		name:  projection.NotificationQueueChannelCol,
+		table: queuedNotificationTable,
+	}
+	QueuedNotificationColumnRecipient = Column{
+		name:  projection.NotificationQueueRecipientCol,
+		table: queuedNotificationTable,
+	}
+	QueuedNotificationColumnTriggeringAggregateID = Column{
+		name:  projection.NotificationQueueTriggeringAggregateIDCol,
+		table: queuedNotificationTable,
+	}
+	QueuedNotificationColumnTriggeringEventType = Column{
+		name:  projection.NotificationQueueTriggeringEventTypeCol,
+		table: queuedNotificationTable,
+	}
+	QueuedNotificationColumnMessage = Column{
+		name:  projection.NotificationQueueMessageCol,
+		table: queuedNotificationTable,
+	}
+	QueuedNotificationColumnState = Column{
+		name:  projection.NotificationQueueStateCol,
+		table: queuedNotificationTable,
+	}
+	QueuedNotificationColumnAttempts = Column{
+		name:  projection.NotificationQueueAttemptsCol,
+		table: queuedNotificationTable,
+	}
+	QueuedNotificationColumnNextAttempt = Column{
+		name:  projection.NotificationQueueNextAttemptCol,
+		table: queuedNotificationTable,
+	}
+	QueuedNotificationColumnLastError = Column{
+		name:  projection.NotificationQueueLastErrorCol,
+		table: queuedNotificationTable,
+	}
+)
+
+// SearchQueuedNotifications tries to defer the instanceID from the passed context if no instanceIDs are passed
+func (q *Queries) SearchQueuedNotifications(ctx context.Context, instanceIDs []string, queries *QueuedNotificationSearchQueries) (notifications *QueuedNotifications, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	query, scan := prepareQueuedNotificationsQuery(ctx, q.client)
+	if len(instanceIDs) == 0 {
+		instanceIDs = []string{authz.GetInstance(ctx).InstanceID()}
+	}
+	stmt, args, err := queries.toQuery(query).Where(sq.Eq{QueuedNotificationColumnInstanceID.identifier(): instanceIDs}).ToSql()
+	if err != nil {
+		return nil, zerrors.ThrowInternal(err, "QUERY-t5mw8qzn2c", "Errors.Query.SQLStatement")
+	}
+	err = q.client.QueryContext(ctx, func(rows *sql.Rows) error {
+		notifications, err = scan(rows)
+		return err
+	}, stmt, args...)
+	if err != nil {
+		return nil, err
+	}
+
+	notifications.State, err = q.latestState(ctx, queuedNotificationTable)
+	return notifications, err
+}
+
+func prepareQueuedNotificationsQuery(ctx context.Context, db prepareDatabase) (sq.SelectBuilder, func(*sql.Rows) (*QueuedNotifications, error)) {
+	return sq.Select(
+			QueuedNotificationColumnInstanceID.identifier(),
+			QueuedNotificationColumnID.identifier(),
+			QueuedNotificationColumnCreationDate.identifier(),
+			QueuedNotificationColumnChangeDate.identifier(),
+			QueuedNotificationColumnSequence.identifier(),
+			QueuedNotificationColumnChannel.identifier(),
+			QueuedNotificationColumnRecipient.identifier(),
+			QueuedNotificationColumnTriggeringAggregateID.identifier(),
+			QueuedNotificationColumnTriggeringEventType.identifier(),
+			QueuedNotificationColumnMessage.identifier(),
+			QueuedNotificationColumnState.identifier(),
+			QueuedNotificationColumnAttempts.identifier(),
+			QueuedNotificationColumnNextAttempt.identifier(),
+			QueuedNotificationColumnLastError.identifier(),
+			countColumn.identifier(),
+		).
+			From(queuedNotificationTable.identifier() + db.Timetravel(call.Took(ctx))).
+			PlaceholderFormat(sq.Dollar),
+		func(rows *sql.Rows) (*QueuedNotifications, error) {
+			notifications := make([]*QueuedNotification, 0)
+			var count uint64
+			for rows.Next() {
+				n := new(QueuedNotification)
+				var message []byte
+				lastError := sql.NullString{}
+				err := rows.Scan(
+					&n.InstanceID,
+					&n.ID,
+					&n.CreationDate,
+					&n.ChangeDate,
+					&n.Sequence,
+					&n.Channel,
+					&n.Recipient,
+					&n.TriggeringAggregateID,
+					&n.TriggeringEventType,
+					&message,
+					&n.State,
+					&n.Attempts,
+					&n.NextAttempt,
+					&lastError,
+					&count,
+				)
+				if err != nil {
+					return nil, err
+				}
+				if len(message) > 0 {
+					if err := json.Unmarshal(message, &n.Message); err != nil {
+						return nil, zerrors.ThrowInternal(err, "QUERY-w2ks7bxe9n", "Errors.Internal")
+					}
+				}
+				n.LastError = lastError.String
+				notifications = append(notifications, n)
+			}
+			if err := rows.Close(); err != nil {
+				return nil, zerrors.ThrowInternal(err, "QUERY-j6rq4zvm3y", "Errors.Query.CloseRows")
+			}
+			return &QueuedNotifications{
+				QueuedNotifications: notifications,
+				SearchResponse: SearchResponse{
+					Count: count,
+				},
+			}, nil
+		}
+}
@@ -0,0 +1,157 @@
+package query
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/zitadel/zitadel/internal/crypto"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+)
+
+var (
+	expectedQueuedNotificationsQuery = regexp.QuoteMeta(`
+		SELECT projections.notification_queue.instance_id,
+		   projections.notification_queue.id,
+		   projections.notification_queue.creation_date,
+		   projections.notification_queue.change_date,
+		   projections.notification_queue.sequence,
+		   projections.notification_queue.channel,
+		   projections.notification_queue.recipient,
+		   projections.notification_queue.triggering_aggregate_id,
+		   projections.notification_queue.triggering_event_type,
+		   projections.notification_queue.message,
+		   projections.notification_queue.state,
+		   projections.notification_queue.attempts,
+		   projections.notification_queue.next_attempt,
+		   projections.notification_queue.last_error,
+		   COUNT(*) OVER ()
+		FROM projections.notification_queue AS OF SYSTEM TIME '-1 ms'
+		`)
+
+	queuedNotificationCols = []string{
+		"instance_id",
+		"id",
+		"creation_date",
+		"change_date",
+		"sequence",
+		"channel",
+		"recipient",
+		"triggering_aggregate_id",
+		"triggering_event_type",
+		"message",
+		"state",
+		"attempts",
+		"next_attempt",
+		"last_error",
+		"count",
+	}
+)
+
+func Test_QueuedNotificationsPrepare(t *testing.T) {
+	type want struct {
+		sqlExpectations sqlExpectation
+		err             checkErr
+	}
+	tests := []struct {
+		name    string
+		prepare interface{}
+		want    want
+		object  interface{}
+	}{
+		{
+			name:    "prepareQueuedNotificationsQuery no result",
+			prepare: prepareQueuedNotificationsQuery,
+			want: want{
+				sqlExpectations: mockQueries(
+					expectedQueuedNotificationsQuery,
+					nil,
+					nil,
+				),
+			},
+			object: &QueuedNotifications{QueuedNotifications: []*QueuedNotification{}},
+		},
+		{
+			name:    "prepareQueuedNotificationsQuery",
+			prepare: prepareQueuedNotificationsQuery,
+			want: want{
+				sqlExpectations: mockQueries(
+					expectedQueuedNotificationsQuery,
+					queuedNotificationCols,
+					[][]driver.Value{
+						{
+							"instance-id",
+							"queued1",
+							testNow,
+							testNow,
+							uint64(20211108),
+							"email",
+							"user@example.com",
+							"user1",
+							"user.human.initialization.code.added",
+							[]byte(`{"cryptoType": 0, "algorithm": "enc", "keyID": "id", "crypted": "bWVzc2FnZQ=="}`),
+							domain.NotificationQueueStateDeadLettered,
+							5,
+							testNow,
+							"smtp unavailable",
+						},
+					},
+				),
+			},
+			object: &QueuedNotifications{
+				SearchResponse: SearchResponse{
+					Count: 1,
+				},
+				QueuedNotifications: []*QueuedNotification{
+					{
+						InstanceID:            "instance-id",
+						ID:                    "queued1",
+						CreationDate:          testNow,
+						ChangeDate:            testNow,
+						Sequence:              20211108,
+						Channel:               "email",
+						Recipient:             "user@example.com",
+						TriggeringAggregateID: "user1",
+						TriggeringEventType:   eventstore.EventType("user.human.initialization.code.added"),
+						Message: &crypto.CryptoValue{
+							CryptoType: crypto.TypeEncryption,
+							Algorithm:  "enc",
+							KeyID:      "id",
+							Crypted:    []byte("message"),
+						},
+						State:       domain.NotificationQueueStateDeadLettered,
+						Attempts:    5,
+						NextAttempt: testNow,
+						LastError:   "smtp unavailable",
+					},
+				},
+			},
+		},
+		{
+			name:    "prepareQueuedNotificationsQuery sql err",
+			prepare: prepareQueuedNotificationsQuery,
+			want: want{
+				sqlExpectations: mockQueryErr(
+					expectedQueuedNotificationsQuery,
+					sql.ErrConnDone,
+				),
+				err: func(err error) (error, bool) {
+					if !errors.Is(err, sql.ErrConnDone) {
+						return fmt.Errorf("err should be sql.ErrConnDone got: %w", err), false
+					}
+					return nil, true
+				},
+			},
+			object: (*QueuedNotifications)(nil),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assertPrepare(t, tt.prepare, tt.object, tt.want.sqlExpectations, tt.want.err, defaultPrepareArgs...)
+		})
+	}
+}
@@ -0,0 +1,136 @@
+package query
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/query/projection"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+var (
+	notificationStatusTable = table{
+		name:          projection.NotificationStatusTable,
+		instanceIDCol: projection.NotificationStatusInstanceIDCol,
+	}
+	NotificationStatusColumnInstanceID = Column{
+		name:  projection.NotificationStatusInstanceIDCol,
+		table: notificationStatusTable,
+	}
+	NotificationStatusColumnID = Column{
+		name:  projection.NotificationStatusIDCol,
+		table: notificationStatusTable,
+	}
+	NotificationStatusColumnCreationDate = Column{
+		name:  projection.NotificationStatusCreationDateCol,
+		table: notificationStatusTable,
+	}
+	NotificationStatusColumnChangeDate = Column{
+		name:  projection.NotificationStatusChangeDateCol,
+		table: notificationStatusTable,
+	}
+	NotificationStatusColumnSequence = Column{
+		name:  projection.NotificationStatusSequenceCol,
+		table: notificationStatusTable,
+	}
+	NotificationStatusColumnChannel = Column{
+		name:  projection.NotificationStatusChannelCol,
+		table: notificationStatusTable,
+	}
+	NotificationStatusColumnProviderID = Column{
+		name:  projection.NotificationStatusProviderIDCol,
+		table: notificationStatusTable,
+	}
+	NotificationStatusColumnTriggeringAggregateID = Column{
+		name:  projection.NotificationStatusTriggeringAggregateIDCol,
+		table: notificationStatusTable,
+	}
+	NotificationStatusColumnTriggeringEventType = Column{
+		name:  projection.NotificationStatusTriggeringEventTypeCol,
+		table: notificationStatusTable,
+	}
+	NotificationStatusColumnStatus = Column{
+		name:  projection.NotificationStatusStatusCol,
+		table: notificationStatusTable,
+	}
+	NotificationStatusColumnReason = Column{
+		name:  projection.NotificationStatusReasonCol,
+		table: notificationStatusTable,
+	}
+)
+
+// NotificationStatus is the latest delivery status of a message sent to a notification provider
+type NotificationStatus struct {
+	ID                    string
+	CreationDate          time.Time
+	ChangeDate            time.Time
+	Sequence              uint64
+	Channel               string
+	ProviderID            string
+	TriggeringAggregateID string
+	TriggeringEventType   eventstore.EventType
+	Status                domain.NotificationDeliveryStatus
+	Reason                string
+}
+
+func (q *Queries) NotificationStatusByID(ctx context.Context, id string) (*NotificationStatus, error) {
+	eq := sq.Eq{
+		NotificationStatusColumnID.identifier():         id,
+		NotificationStatusColumnInstanceID.identifier(): authz.GetInstance(ctx).InstanceID(),
+	}
+	query, scan := prepareNotificationStatusQuery(ctx, q.client)
+	return genericRowQuery[*NotificationStatus](ctx, q.client, query.Where(eq), scan)
+}
+
+func prepareNotificationStatusQuery(ctx context.Context, db prepareDatabase) (sq.SelectBuilder, func(row *sql.Row) (*NotificationStatus, error)) {
+	return sq.Select(
+			NotificationStatusColumnID.identifier(),
+			NotificationStatusColumnCreationDate.identifier(),
+			NotificationStatusColumnChangeDate.identifier(),
+			NotificationStatusColumnSequence.identifier(),
+			NotificationStatusColumnChannel.identifier(),
+			NotificationStatusColumnProviderID.identifier(),
+			NotificationStatusColumnTriggeringAggregateID.identifier(),
+			NotificationStatusColumnTriggeringEventType.identifier(),
+			NotificationStatusColumnStatus.identifier(),
+			NotificationStatusColumnReason.identifier(),
+		).From(notificationStatusTable.identifier()).
+			PlaceholderFormat(sq.Dollar),
+		func(row *sql.Row) (*NotificationStatus, error) {
+			status := new(NotificationStatus)
+			var (
+				triggeringAggregateID sql.NullString
+				triggeringEventType   sql.NullString
+				reason                sql.NullString
+			)
+			err := row.Scan(
+				&status.ID,
+				&status.CreationDate,
+				&status.ChangeDate,
+				&status.Sequence,
+				&status.Channel,
+				&status.ProviderID,
+				&triggeringAggregateID,
+				&triggeringEventType,
+				&status.Status,
+				&reason,
+			)
+			if err != nil {
+				if errors.Is(err, sql.ErrNoRows) {
+					return nil, zerrors.ThrowNotFound(err, "QUERY-6cp0lxw2ma", "Errors.Notification.NotFound")
+				}
+				return nil, zerrors.ThrowInternal(err, "QUERY-s8r1dqf4zn", "Errors.Internal")
+			}
+			status.TriggeringAggregateID = triggeringAggregateID.String
+			status.TriggeringEventType = eventstore.EventType(triggeringEventType.String)
+			status.Reason = reason.String
+			return status, nil
+		}
+}
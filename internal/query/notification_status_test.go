@@ -0,0 +1,127 @@
+package query
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+var (
+	prepareNotificationStatusStmt = `SELECT projections.notification_statuses.id,` +
+		` projections.notification_statuses.creation_date,` +
+		` projections.notification_statuses.change_date,` +
+		` projections.notification_statuses.sequence,` +
+		` projections.notification_statuses.channel,` +
+		` projections.notification_statuses.provider_id,` +
+		` projections.notification_statuses.triggering_aggregate_id,` +
+		` projections.notification_statuses.triggering_event_type,` +
+		` projections.notification_statuses.status,` +
+		` projections.notification_statuses.reason` +
+		` FROM projections.notification_statuses`
+	prepareNotificationStatusCols = []string{
+		"id",
+		"creation_date",
+		"change_date",
+		"sequence",
+		"channel",
+		"provider_id",
+		"triggering_aggregate_id",
+		"triggering_event_type",
+		"status",
+		"reason",
+	}
+)
+
+func Test_NotificationStatusPrepares(t *testing.T) {
+	type want struct {
+		sqlExpectations sqlExpectation
+		err             checkErr
+	}
+	tests := []struct {
+		name    string
+		prepare interface{}
+		want    want
+		object  interface{}
+	}{
+		{
+			name:    "prepareNotificationStatusQuery no result",
+			prepare: prepareNotificationStatusQuery,
+			want: want{
+				sqlExpectations: mockQueriesScanErr(
+					regexp.QuoteMeta(prepareNotificationStatusStmt),
+					nil,
+					nil,
+				),
+				err: func(err error) (error, bool) {
+					if !zerrors.IsNotFound(err) {
+						return fmt.Errorf("err should be zitadel.NotFoundError got: %w", err), false
+					}
+					return nil, true
+				},
+			},
+			object: (*NotificationStatus)(nil),
+		},
+		{
+			name:    "prepareNotificationStatusQuery found",
+			prepare: prepareNotificationStatusQuery,
+			want: want{
+				sqlExpectations: mockQuery(
+					regexp.QuoteMeta(prepareNotificationStatusStmt),
+					prepareNotificationStatusCols,
+					[]driver.Value{
+						"message",
+						testNow,
+						testNow,
+						uint64(20211109),
+						"sms",
+						"provider",
+						"user",
+						"user.human.phone.code.added",
+						domain.NotificationDeliveryStatusUndelivered,
+						"unreachable",
+					},
+				),
+			},
+			object: &NotificationStatus{
+				ID:                    "message",
+				CreationDate:          testNow,
+				ChangeDate:            testNow,
+				Sequence:              20211109,
+				Channel:               "sms",
+				ProviderID:            "provider",
+				TriggeringAggregateID: "user",
+				TriggeringEventType:   "user.human.phone.code.added",
+				Status:                domain.NotificationDeliveryStatusUndelivered,
+				Reason:                "unreachable",
+			},
+		},
+		{
+			name:    "prepareNotificationStatusQuery sql err",
+			prepare: prepareNotificationStatusQuery,
+			want: want{
+				sqlExpectations: mockQueryErr(
+					regexp.QuoteMeta(prepareNotificationStatusStmt),
+					sql.ErrConnDone,
+				),
+				err: func(err error) (error, bool) {
+					if !errors.Is(err, sql.ErrConnDone) {
+						return fmt.Errorf("err should be sql.ErrConnDone got: %w", err), false
+					}
+					return nil, true
+				},
+			},
+			object: (*NotificationStatus)(nil),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assertPrepare(t, tt.prepare, tt.object, tt.want.sqlExpectations, tt.want.err, defaultPrepareArgs...)
+		})
+	}
+}
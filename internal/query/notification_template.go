@@ -0,0 +1,246 @@
+package query
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/api/call"
+	"github.com/zitadel/zitadel/internal/notification/templates"
+	"github.com/zitadel/zitadel/internal/query/projection"
+	"github.com/zitadel/zitadel/internal/telemetry/tracing"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+var (
+	notificationLayoutTable = table{
+		name:          projection.NotificationLayoutTable,
+		instanceIDCol: projection.NotificationLayoutInstanceIDCol,
+	}
+	NotificationLayoutColumnInstanceID = Column{
+		name:  projection.NotificationLayoutInstanceIDCol,
+		table: notificationLayoutTable,
+	}
+	NotificationLayoutColumnLanguage = Column{
+		name:  projection.NotificationLayoutLanguageCol,
+		table: notificationLayoutTable,
+	}
+	NotificationLayoutColumnCreationDate = Column{
+		name:  projection.NotificationLayoutCreationDateCol,
+		table: notificationLayoutTable,
+	}
+	NotificationLayoutColumnChangeDate = Column{
+		name:  projection.NotificationLayoutChangeDateCol,
+		table: notificationLayoutTable,
+	}
+	NotificationLayoutColumnSequence = Column{
+		name:  projection.NotificationLayoutSequenceCol,
+		table: notificationLayoutTable,
+	}
+	NotificationLayoutColumnHTML = Column{
+		name:  projection.NotificationLayoutHTMLCol,
+		table: notificationLayoutTable,
+	}
+	NotificationLayoutColumnText = Column{
+		name:  projection.NotificationLayoutTextCol,
+		table: notificationLayoutTable,
+	}
+	NotificationLayoutColumnInlineLogo = Column{
+		name:  projection.NotificationLayoutInlineLogoCol,
+		table: notificationLayoutTable,
+	}
+
+	notificationTemplatePartialTable = table{
+		name:          projection.NotificationTemplatePartialTable,
+		instanceIDCol: projection.NotificationPartialInstanceIDCol,
+	}
+	NotificationPartialColumnInstanceID = Column{
+		name:  projection.NotificationPartialInstanceIDCol,
+		table: notificationTemplatePartialTable,
+	}
+	NotificationPartialColumnName = Column{
+		name:  projection.NotificationPartialNameCol,
+		table: notificationTemplatePartialTable,
+	}
+	NotificationPartialColumnCreationDate = Column{
+		name:  projection.NotificationPartialCreationDateCol,
+		table: notificationTemplatePartialTable,
+	}
+	NotificationPartialColumnChangeDate = Column{
+		name:  projection.NotificationPartialChangeDateCol,
+		table: notificationTemplatePartialTable,
+	}
+	NotificationPartialColumnSequence = Column{
+		name:  projection.NotificationPartialSequenceCol,
+		table: notificationTemplatePartialTable,
+	}
+	NotificationPartialColumnContent = Column{
+		name:  projection.NotificationPartialContentCol,
+		table: notificationTemplatePartialTable,
+	}
+)
+
+// NotificationTemplates are the email layouts per language and the partials shared by them
+type NotificationTemplates struct {
+	Layouts  []*NotificationLayout
+	Partials []*NotificationTemplatePartial
+}
+
+type NotificationLayout struct {
+	Language     string
+	CreationDate time.Time
+	ChangeDate   time.Time
+	Sequence     uint64
+	HTML         string
+	Text         string
+	InlineLogo   bool
+}
+
+type NotificationTemplatePartial struct {
+	Name         string
+	CreationDate time.Time
+	ChangeDate   time.Time
+	Sequence     uint64
+	Content      string
+}
+
+// TemplateSet returns the layouts and partials as template set,
+// which falls back to the passed (legacy) mail template if no layout matches the language
+func (t *NotificationTemplates) TemplateSet(legacy string) *templates.TemplateSet {
+	set := &templates.TemplateSet{
+		Legacy:   legacy,
+		Layouts:  make(map[string]*templates.Layout, len(t.Layouts)),
+		Partials: make(map[string]string, len(t.Partials)),
+	}
+	for _, layout := range t.Layouts {
+		set.Layouts[layout.Language] = &templates.Layout{
+			HTML:       layout.HTML,
+			Text:       layout.Text,
+			InlineLogo: layout.InlineLogo,
+		}
+	}
+	for _, partial := range t.Partials {
+		set.Partials[partial.Name] = partial.Content
+	}
+	return set
+}
+
+// Layout returns the layout of the language, an empty language returns the default layout
+func (t *NotificationTemplates) Layout(language string) (*NotificationLayout, error) {
+	for _, layout := range t.Layouts {
+		if layout.Language == language {
+			return layout, nil
+		}
+	}
+	return nil, zerrors.ThrowNotFound(nil, "QUERY-Nk5vq2Lw8x", "Errors.NotificationTemplate.NotFound")
+}
+
+// Partial returns the partial with the name
+func (t *NotificationTemplates) Partial(name string) (*NotificationTemplatePartial, error) {
+	for _, partial := range t.Partials {
+		if partial.Name == name {
+			return partial, nil
+		}
+	}
+	return nil, zerrors.ThrowNotFound(nil, "QUERY-Fz7wm3Rc9p", "Errors.NotificationTemplate.PartialNotFound")
+}
+
+func (q *Queries) NotificationTemplates(ctx context.Context) (_ *NotificationTemplates, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	instanceID := authz.GetInstance(ctx).InstanceID()
+	layoutQuery, layoutScan := prepareNotificationLayoutsQuery(ctx, q.client)
+	layouts, err := genericRowsQuery[[]*NotificationLayout](ctx, q.client, layoutQuery.Where(sq.Eq{
+		NotificationLayoutColumnInstanceID.identifier(): instanceID,
+	}), layoutScan)
+	if err != nil {
+		return nil, err
+	}
+	partialQuery, partialScan := prepareNotificationTemplatePartialsQuery(ctx, q.client)
+	partials, err := genericRowsQuery[[]*NotificationTemplatePartial](ctx, q.client, partialQuery.Where(sq.Eq{
+		NotificationPartialColumnInstanceID.identifier(): instanceID,
+	}), partialScan)
+	if err != nil {
+		return nil, err
+	}
+	return &NotificationTemplates{
+		Layouts:  layouts,
+		Partials: partials,
+	}, nil
+}
+
+func prepareNotificationLayoutsQuery(ctx context.Context, db prepareDatabase) (sq.SelectBuilder, func(rows *sql.Rows) ([]*NotificationLayout, error)) {
+	return sq.Select(
+			NotificationLayoutColumnLanguage.identifier(),
+			NotificationLayoutColumnCreationDate.identifier(),
+			NotificationLayoutColumnChangeDate.identifier(),
+			NotificationLayoutColumnSequence.identifier(),
+			NotificationLayoutColumnHTML.identifier(),
+			NotificationLayoutColumnText.identifier(),
+			NotificationLayoutColumnInlineLogo.identifier(),
+		).From(notificationLayoutTable.identifier() + db.Timetravel(call.Took(ctx))).
+			OrderBy(NotificationLayoutColumnLanguage.identifier()).
+			PlaceholderFormat(sq.Dollar),
+		func(rows *sql.Rows) ([]*NotificationLayout, error) {
+			layouts := make([]*NotificationLayout, 0)
+			for rows.Next() {
+				layout := new(NotificationLayout)
+				var text sql.NullString
+				err := rows.Scan(
+					&layout.Language,
+					&layout.CreationDate,
+					&layout.ChangeDate,
+					&layout.Sequence,
+					&layout.HTML,
+					&text,
+					&layout.InlineLogo,
+				)
+				if err != nil {
+					return nil, err
+				}
+				layout.Text = text.String
+				layouts = append(layouts, layout)
+			}
+			if err := rows.Close(); err != nil {
+				return nil, zerrors.ThrowInternal(err, "QUERY-r4xm8wdq1c", "Errors.Query.CloseRows")
+			}
+			return layouts, nil
+		}
+}
+
+func prepareNotificationTemplatePartialsQuery(ctx context.Context, db prepareDatabase) (sq.SelectBuilder, func(rows *sql.Rows) ([]*NotificationTemplatePartial, error)) {
+	return sq.Select(
+			NotificationPartialColumnName.identifier(),
+			NotificationPartialColumnCreationDate.identifier(),
+			NotificationPartialColumnChangeDate.identifier(),
+			NotificationPartialColumnSequence.identifier(),
+			NotificationPartialColumnContent.identifier(),
+		).From(notificationTemplatePartialTable.identifier() + db.Timetravel(call.Took(ctx))).
+			OrderBy(NotificationPartialColumnName.identifier()).
+			PlaceholderFormat(sq.Dollar),
+		func(rows *sql.Rows) ([]*NotificationTemplatePartial, error) {
+			partials := make([]*NotificationTemplatePartial, 0)
+			for rows.Next() {
+				partial := new(NotificationTemplatePartial)
+				err := rows.Scan(
+					&partial.Name,
+					&partial.CreationDate,
+					&partial.ChangeDate,
+					&partial.Sequence,
+					&partial.Content,
+				)
+				if err != nil {
+					return nil, err
+				}
+				partials = append(partials, partial)
+			}
+			if err := rows.Close(); err != nil {
+				return nil, zerrors.ThrowInternal(err, "QUERY-h7vu2bnk5o", "Errors.Query.CloseRows")
+			}
+			return partials, nil
+		}
+}
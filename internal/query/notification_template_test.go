@@ -0,0 +1,219 @@
+package query
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+var (
+	prepareNotificationLayoutsStmt = `SELECT projections.notification_layouts.language,` +
+		` projections.notification_layouts.creation_date,` +
+		` projections.notification_layouts.change_date,` +
+		` projections.notification_layouts.sequence,` +
+		` projections.notification_layouts.html,` +
+		` projections.notification_layouts.text,` +
+		` projections.notification_layouts.inline_logo` +
+		` FROM projections.notification_layouts`
+	prepareNotificationLayoutsCols = []string{
+		"language",
+		"creation_date",
+		"change_date",
+		"sequence",
+		"html",
+		"text",
+		"inline_logo",
+	}
+	prepareNotificationTemplatePartialsStmt = `SELECT projections.notification_layouts_partials.name,` +
+		` projections.notification_layouts_partials.creation_date,` +
+		` projections.notification_layouts_partials.change_date,` +
+		` projections.notification_layouts_partials.sequence,` +
+		` projections.notification_layouts_partials.content` +
+		` FROM projections.notification_layouts_partials`
+	prepareNotificationTemplatePartialsCols = []string{
+		"name",
+		"creation_date",
+		"change_date",
+		"sequence",
+		"content",
+	}
+)
+
+func Test_NotificationTemplatePrepares(t *testing.T) {
+	type want struct {
+		sqlExpectations sqlExpectation
+		err             checkErr
+	}
+	tests := []struct {
+		name    string
+		prepare interface{}
+		want    want
+		object  interface{}
+	}{
+		{
+			name:    "prepareNotificationLayoutsQuery no result",
+			prepare: prepareNotificationLayoutsQuery,
+			want: want{
+				sqlExpectations: mockQueries(
+					regexp.QuoteMeta(prepareNotificationLayoutsStmt),
+					nil,
+					nil,
+				),
+			},
+			object: []*NotificationLayout{},
+		},
+		{
+			name:    "prepareNotificationLayoutsQuery multiple results",
+			prepare: prepareNotificationLayoutsQuery,
+			want: want{
+				sqlExpectations: mockQueries(
+					regexp.QuoteMeta(prepareNotificationLayoutsStmt),
+					prepareNotificationLayoutsCols,
+					[][]driver.Value{
+						{
+							"",
+							testNow,
+							testNow,
+							uint64(20211109),
+							"<html>{{.Text}}</html>",
+							nil,
+							false,
+						},
+						{
+							"de",
+							testNow,
+							testNow,
+							uint64(20211110),
+							"<html>{{.Text}}</html>",
+							"{{.Text}}",
+							true,
+						},
+					},
+				),
+			},
+			object: []*NotificationLayout{
+				{
+					Language:     "",
+					CreationDate: testNow,
+					ChangeDate:   testNow,
+					Sequence:     20211109,
+					HTML:         "<html>{{.Text}}</html>",
+				},
+				{
+					Language:     "de",
+					CreationDate: testNow,
+					ChangeDate:   testNow,
+					Sequence:     20211110,
+					HTML:         "<html>{{.Text}}</html>",
+					Text:         "{{.Text}}",
+					InlineLogo:   true,
+				},
+			},
+		},
+		{
+			name:    "prepareNotificationLayoutsQuery sql err",
+			prepare: prepareNotificationLayoutsQuery,
+			want: want{
+				sqlExpectations: mockQueryErr(
+					regexp.QuoteMeta(prepareNotificationLayoutsStmt),
+					sql.ErrConnDone,
+				),
+				err: func(err error) (error, bool) {
+					if !errors.Is(err, sql.ErrConnDone) {
+						return fmt.Errorf("err should be sql.ErrConnDone got: %w", err), false
+					}
+					return nil, true
+				},
+			},
+			object: ([]*NotificationLayout)(nil),
+		},
+		{
+			name:    "prepareNotificationTemplatePartialsQuery one result",
+			prepare: prepareNotificationTemplatePartialsQuery,
+			want: want{
+				sqlExpectations: mockQueries(
+					regexp.QuoteMeta(prepareNotificationTemplatePartialsStmt),
+					prepareNotificationTemplatePartialsCols,
+					[][]driver.Value{
+						{
+							"footer",
+							testNow,
+							testNow,
+							uint64(20211109),
+							"<p>{{.FooterText}}</p>",
+						},
+					},
+				),
+			},
+			object: []*NotificationTemplatePartial{
+				{
+					Name:         "footer",
+					CreationDate: testNow,
+					ChangeDate:   testNow,
+					Sequence:     20211109,
+					Content:      "<p>{{.FooterText}}</p>",
+				},
+			},
+		},
+		{
+			name:    "prepareNotificationTemplatePartialsQuery sql err",
+			prepare: prepareNotificationTemplatePartialsQuery,
+			want: want{
+				sqlExpectations: mockQueryErr(
+					regexp.QuoteMeta(prepareNotificationTemplatePartialsStmt),
+					sql.ErrConnDone,
+				),
+				err: func(err error) (error, bool) {
+					if !errors.Is(err, sql.ErrConnDone) {
+						return fmt.Errorf("err should be sql.ErrConnDone got: %w", err), false
+					}
+					return nil, true
+				},
+			},
+			object: ([]*NotificationTemplatePartial)(nil),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assertPrepare(t, tt.prepare, tt.object, tt.want.sqlExpectations, tt.want.err, defaultPrepareArgs...)
+		})
+	}
+}
+
+func TestNotificationTemplates_Layout(t *testing.T) {
+	templates := &NotificationTemplates{
+		Layouts: []*NotificationLayout{
+			{Language: "", HTML: "default"},
+			{Language: "de", HTML: "de"},
+		},
+		Partials: []*NotificationTemplatePartial{
+			{Name: "footer", Content: "footer"},
+		},
+	}
+
+	layout, err := templates.Layout("de")
+	require.NoError(t, err)
+	assert.Equal(t, "de", layout.HTML)
+
+	layout, err = templates.Layout("")
+	require.NoError(t, err)
+	assert.Equal(t, "default", layout.HTML)
+
+	_, err = templates.Layout("fr")
+	assert.True(t, zerrors.IsNotFound(err))
+
+	partial, err := templates.Partial("footer")
+	require.NoError(t, err)
+	assert.Equal(t, "footer", partial.Content)
+
+	_, err = templates.Partial("header")
+	assert.True(t, zerrors.IsNotFound(err))
+}
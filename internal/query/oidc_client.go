@@ -33,11 +33,18 @@ type OIDCClient struct {
 	IDTokenUserinfoAssertion bool                       `json:"id_token_userinfo_assertion,omitempty"`
 	ClockSkew                time.Duration              `json:"clock_skew,omitempty"`
 	AdditionalOrigins        []string                   `json:"additional_origins,omitempty"`
+	TLSClientAuthSubjectDN   string                     `json:"tls_client_auth_subject_dn,omitempty"`
+	TLSClientAuthThumbprint  string                     `json:"tls_client_auth_thumbprint,omitempty"`
+	BackChannelLogoutURI     string                     `json:"back_channel_logout_uri,omitempty"`
+	FrontChannelLogoutURI    string                     `json:"front_channel_logout_uri,omitempty"`
+	ClaimsMapping            *domain.ClaimsMapping      `json:"claims_mapping,omitempty"`
 	PublicKeys               map[string][]byte          `json:"public_keys,omitempty"`
 	ProjectID                string                     `json:"project_id,omitempty"`
 	ProjectRoleAssertion     bool                       `json:"project_role_assertion,omitempty"`
 	ProjectRoleKeys          []string                   `json:"project_role_keys,omitempty"`
 	Settings                 *OIDCSettings              `json:"settings,omitempty"`
+	// TokenLifetimes are not part of the projection and are set by the OP when needed.
+	TokenLifetimes *domain.AppTokenLifetimes `json:"-"`
 }
 
 //go:embed oidc_client_by_id.sql
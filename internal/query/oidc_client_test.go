@@ -85,6 +85,10 @@ low2kyJov38V4Uk2I8kuXpLcnrpw5Tio2ooiUE27b0vHZqBKOei9Uo88qCrn3EKx
 				ProjectRoleAssertion:     true,
 				PublicKeys:               map[string][]byte{"236647201860747266": []byte(pubkey)},
 				ProjectRoleKeys:          []string{"role1", "role2"},
+				ClaimsMapping: &domain.ClaimsMapping{
+					FlattenAudience: true,
+					RenamedClaims:   map[string]string{"urn:zitadel:iam:org:project:roles": "roles"},
+				},
 				Settings: &OIDCSettings{
 					AccessTokenLifetime: 43200000000000,
 					IdTokenLifetime:     43200000000000,
@@ -258,6 +258,9 @@ func (q *Queries) SearchOrgs(ctx context.Context, queries *OrgSearchQueries) (or
 	ctx, span := tracing.NewSpan(ctx)
 	defer func() { span.EndWithError(err) }()
 
+	if err = q.resolveOrgSubtreeQueries(ctx, queries.Queries); err != nil {
+		return nil, err
+	}
 	query, scan := prepareOrgsQuery(ctx, q.client)
 	stmt, args, err := queries.toQuery(query).
 		Where(sq.And{
@@ -293,6 +296,44 @@ func NewOrgStateSearchQuery(value domain_pkg.OrgState) (SearchQuery, error) {
 	return NewNumberQuery(OrgColumnState, value, NumberEquals)
 }
 
+// NewOrgMetadataSearchQuery matches the organizations having metadata with the key.
+// If the value is not empty, the value of the metadata must match as well.
+func NewOrgMetadataSearchQuery(key string, value []byte) (SearchQuery, error) {
+	//linking queries for the subselect
+	instanceQuery, err := NewColumnComparisonQuery(OrgMetadataInstanceIDCol, OrgColumnInstanceID, ColumnEquals)
+	if err != nil {
+		return nil, err
+	}
+	orgIDQuery, err := NewColumnComparisonQuery(OrgMetadataOrgIDCol, OrgColumnID, ColumnEquals)
+	if err != nil {
+		return nil, err
+	}
+	//queries to select data from the linked sub select
+	keyQuery, err := NewTextQuery(OrgMetadataKeyCol, key, TextEquals)
+	if err != nil {
+		return nil, err
+	}
+	queries := []SearchQuery{instanceQuery, orgIDQuery, keyQuery}
+	if len(value) > 0 {
+		valueQuery, err := NewBytesQuery(OrgMetadataValueCol, value)
+		if err != nil {
+			return nil, err
+		}
+		queries = append(queries, valueQuery)
+	}
+	//full definition of the sub select
+	subSelect, err := NewSubSelect(OrgMetadataOrgIDCol, queries)
+	if err != nil {
+		return nil, err
+	}
+	// "WHERE * IN (*)" query with subquery as list-data provider
+	return NewListQuery(
+		OrgColumnID,
+		subSelect,
+		ListIn,
+	)
+}
+
 func NewOrgIDsSearchQuery(ids ...string) (SearchQuery, error) {
 	list := make([]interface{}, len(ids))
 	for i, value := range ids {
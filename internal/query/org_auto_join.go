@@ -0,0 +1,85 @@
+package query
+
+import (
+	"context"
+	"time"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/repository/org"
+	"github.com/zitadel/zitadel/internal/telemetry/tracing"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+type OrgAutoJoin struct {
+	OrgID      string
+	ChangeDate time.Time
+	Sequence   uint64
+
+	// Roles are granted to the users joining the organization
+	Roles []string
+}
+
+// OrgAutoJoinByOrg returns the roles users with a verified email of a verified domain of the organization
+// automatically become members with.
+func (q *Queries) OrgAutoJoinByOrg(ctx context.Context, orgID string) (_ *OrgAutoJoin, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	model := newOrgAutoJoinReadModel(authz.GetInstance(ctx).InstanceID(), orgID)
+	if err = q.eventstore.FilterToQueryReducer(ctx, model); err != nil {
+		return nil, err
+	}
+	if model.autoJoin == nil {
+		return nil, zerrors.ThrowNotFound(nil, "QUERY-Aj1n", "Errors.Org.AutoJoin.NotFound")
+	}
+	return model.autoJoin, nil
+}
+
+type orgAutoJoinReadModel struct {
+	eventstore.ReadModel
+
+	autoJoin *OrgAutoJoin
+}
+
+func newOrgAutoJoinReadModel(instanceID, orgID string) *orgAutoJoinReadModel {
+	return &orgAutoJoinReadModel{
+		ReadModel: eventstore.ReadModel{
+			AggregateID:   orgID,
+			ResourceOwner: orgID,
+			InstanceID:    instanceID,
+		},
+	}
+}
+
+func (rm *orgAutoJoinReadModel) Reduce() error {
+	for _, event := range rm.Events {
+		switch e := event.(type) {
+		case *org.AutoJoinSetEvent:
+			rm.autoJoin = &OrgAutoJoin{
+				OrgID:      e.Aggregate().ID,
+				ChangeDate: e.CreatedAt(),
+				Sequence:   e.Sequence(),
+				Roles:      e.Roles,
+			}
+		case *org.AutoJoinRemovedEvent, *org.OrgRemovedEvent:
+			rm.autoJoin = nil
+		}
+	}
+	return rm.ReadModel.Reduce()
+}
+
+func (rm *orgAutoJoinReadModel) Query() *eventstore.SearchQueryBuilder {
+	return eventstore.NewSearchQueryBuilder(eventstore.ColumnsEvent).
+		AwaitOpenTransactions().
+		InstanceID(rm.InstanceID).
+		AddQuery().
+		AggregateTypes(org.AggregateType).
+		AggregateIDs(rm.AggregateID).
+		EventTypes(
+			org.AutoJoinSetEventType,
+			org.AutoJoinRemovedEventType,
+			org.OrgRemovedEventType,
+		).
+		Builder()
+}
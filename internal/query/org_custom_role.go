@@ -0,0 +1,194 @@
+package query
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/api/call"
+	"github.com/zitadel/zitadel/internal/database"
+	"github.com/zitadel/zitadel/internal/query/projection"
+	"github.com/zitadel/zitadel/internal/telemetry/tracing"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+// CustomRole is a role defined by an organization, which grants a set of permissions to its members.
+type CustomRole struct {
+	Key           string
+	DisplayName   string
+	Permissions   []string
+	CreationDate  time.Time
+	ChangeDate    time.Time
+	Sequence      uint64
+	ResourceOwner string
+}
+
+type CustomRoles struct {
+	Roles []*CustomRole
+}
+
+var (
+	orgCustomRoleTable = table{
+		name:          projection.OrgCustomRoleProjectionTable,
+		instanceIDCol: projection.OrgCustomRoleColumnInstanceID,
+	}
+	OrgCustomRoleColumnOrgID = Column{
+		name:  projection.OrgCustomRoleColumnOrgID,
+		table: orgCustomRoleTable,
+	}
+	OrgCustomRoleColumnKey = Column{
+		name:  projection.OrgCustomRoleColumnKey,
+		table: orgCustomRoleTable,
+	}
+	OrgCustomRoleColumnInstanceID = Column{
+		name:  projection.OrgCustomRoleColumnInstanceID,
+		table: orgCustomRoleTable,
+	}
+	OrgCustomRoleColumnResourceOwner = Column{
+		name:  projection.OrgCustomRoleColumnResourceOwner,
+		table: orgCustomRoleTable,
+	}
+	OrgCustomRoleColumnDisplayName = Column{
+		name:  projection.OrgCustomRoleColumnDisplayName,
+		table: orgCustomRoleTable,
+	}
+	OrgCustomRoleColumnPermissions = Column{
+		name:  projection.OrgCustomRoleColumnPermissions,
+		table: orgCustomRoleTable,
+	}
+	OrgCustomRoleColumnCreationDate = Column{
+		name:  projection.OrgCustomRoleColumnCreationDate,
+		table: orgCustomRoleTable,
+	}
+	OrgCustomRoleColumnChangeDate = Column{
+		name:  projection.OrgCustomRoleColumnChangeDate,
+		table: orgCustomRoleTable,
+	}
+	OrgCustomRoleColumnSequence = Column{
+		name:  projection.OrgCustomRoleColumnSequence,
+		table: orgCustomRoleTable,
+	}
+)
+
+// OrgCustomRoles returns the custom roles of the organization ordered by their key.
+func (q *Queries) OrgCustomRoles(ctx context.Context, orgID string) (roles *CustomRoles, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	stmt, scan := prepareOrgCustomRolesQuery(ctx, q.client)
+	query, args, err := stmt.Where(sq.Eq{
+		OrgCustomRoleColumnInstanceID.identifier(): authz.GetInstance(ctx).InstanceID(),
+		OrgCustomRoleColumnOrgID.identifier():      orgID,
+	}).
+		OrderBy(OrgCustomRoleColumnKey.identifier()).
+		ToSql()
+	if err != nil {
+		return nil, zerrors.ThrowInternal(err, "QUERY-Cr5sq", "Errors.Query.SQLStatement")
+	}
+
+	err = q.client.QueryContext(ctx, func(rows *sql.Rows) error {
+		roles, err = scan(rows)
+		return err
+	}, query, args...)
+	return roles, err
+}
+
+// OrgCustomRoleByKey returns the custom role of the organization with the key.
+func (q *Queries) OrgCustomRoleByKey(ctx context.Context, orgID, key string) (role *CustomRole, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	stmt, scan := prepareOrgCustomRoleQuery(ctx, q.client)
+	query, args, err := stmt.Where(sq.Eq{
+		OrgCustomRoleColumnInstanceID.identifier(): authz.GetInstance(ctx).InstanceID(),
+		OrgCustomRoleColumnOrgID.identifier():      orgID,
+		OrgCustomRoleColumnKey.identifier():        key,
+	}).ToSql()
+	if err != nil {
+		return nil, zerrors.ThrowInternal(err, "QUERY-Cr6sq", "Errors.Query.SQLStatement")
+	}
+
+	err = q.client.QueryRowContext(ctx, func(row *sql.Row) error {
+		role, err = scan(row)
+		return err
+	}, query, args...)
+	return role, err
+}
+
+func prepareOrgCustomRoleQuery(ctx context.Context, db prepareDatabase) (sq.SelectBuilder, func(*sql.Row) (*CustomRole, error)) {
+	return sq.Select(
+			OrgCustomRoleColumnKey.identifier(),
+			OrgCustomRoleColumnDisplayName.identifier(),
+			OrgCustomRoleColumnPermissions.identifier(),
+			OrgCustomRoleColumnCreationDate.identifier(),
+			OrgCustomRoleColumnChangeDate.identifier(),
+			OrgCustomRoleColumnSequence.identifier(),
+			OrgCustomRoleColumnResourceOwner.identifier(),
+		).
+			From(orgCustomRoleTable.identifier() + db.Timetravel(call.Took(ctx))).
+			PlaceholderFormat(sq.Dollar),
+		func(row *sql.Row) (*CustomRole, error) {
+			role := new(CustomRole)
+			var permissions database.TextArray[string]
+			err := row.Scan(
+				&role.Key,
+				&role.DisplayName,
+				&permissions,
+				&role.CreationDate,
+				&role.ChangeDate,
+				&role.Sequence,
+				&role.ResourceOwner,
+			)
+			if err != nil {
+				if errors.Is(err, sql.ErrNoRows) {
+					return nil, zerrors.ThrowNotFound(err, "QUERY-Cr4nf", "Errors.Org.CustomRole.NotFound")
+				}
+				return nil, zerrors.ThrowInternal(err, "QUERY-Cr7sc", "Errors.Internal")
+			}
+			role.Permissions = permissions
+			return role, nil
+		}
+}
+
+func prepareOrgCustomRolesQuery(ctx context.Context, db prepareDatabase) (sq.SelectBuilder, func(*sql.Rows) (*CustomRoles, error)) {
+	return sq.Select(
+			OrgCustomRoleColumnKey.identifier(),
+			OrgCustomRoleColumnDisplayName.identifier(),
+			OrgCustomRoleColumnPermissions.identifier(),
+			OrgCustomRoleColumnCreationDate.identifier(),
+			OrgCustomRoleColumnChangeDate.identifier(),
+			OrgCustomRoleColumnSequence.identifier(),
+			OrgCustomRoleColumnResourceOwner.identifier(),
+		).
+			From(orgCustomRoleTable.identifier() + db.Timetravel(call.Took(ctx))).
+			PlaceholderFormat(sq.Dollar),
+		func(rows *sql.Rows) (*CustomRoles, error) {
+			roles := make([]*CustomRole, 0)
+			for rows.Next() {
+				role := new(CustomRole)
+				var permissions database.TextArray[string]
+				err := rows.Scan(
+					&role.Key,
+					&role.DisplayName,
+					&permissions,
+					&role.CreationDate,
+					&role.ChangeDate,
+					&role.Sequence,
+					&role.ResourceOwner,
+				)
+				if err != nil {
+					return nil, err
+				}
+				role.Permissions = permissions
+				roles = append(roles, role)
+			}
+			if err := rows.Close(); err != nil {
+				return nil, zerrors.ThrowInternal(err, "QUERY-Cr8cr", "Errors.Query.CloseRows")
+			}
+			return &CustomRoles{Roles: roles}, nil
+		}
+}
@@ -0,0 +1,196 @@
+package query
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/zitadel/zitadel/internal/database"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+var (
+	prepareOrgCustomRoleStmt = `SELECT projections.org_custom_roles.key,` +
+		` projections.org_custom_roles.display_name,` +
+		` projections.org_custom_roles.permissions,` +
+		` projections.org_custom_roles.creation_date,` +
+		` projections.org_custom_roles.change_date,` +
+		` projections.org_custom_roles.sequence,` +
+		` projections.org_custom_roles.resource_owner` +
+		` FROM projections.org_custom_roles` +
+		` AS OF SYSTEM TIME '-1 ms'`
+	prepareOrgCustomRoleCols = []string{
+		"key",
+		"display_name",
+		"permissions",
+		"creation_date",
+		"change_date",
+		"sequence",
+		"resource_owner",
+	}
+)
+
+func Test_OrgCustomRolePrepares(t *testing.T) {
+	type want struct {
+		sqlExpectations sqlExpectation
+		err             checkErr
+	}
+	tests := []struct {
+		name    string
+		prepare interface{}
+		want    want
+		object  interface{}
+	}{
+		{
+			name:    "prepareOrgCustomRoleQuery no result",
+			prepare: prepareOrgCustomRoleQuery,
+			want: want{
+				sqlExpectations: mockQueriesScanErr(
+					regexp.QuoteMeta(prepareOrgCustomRoleStmt),
+					nil,
+					nil,
+				),
+				err: func(err error) (error, bool) {
+					if !zerrors.IsNotFound(err) {
+						return fmt.Errorf("err should be zitadel.NotFoundError got: %w", err), false
+					}
+					return nil, true
+				},
+			},
+			object: (*CustomRole)(nil),
+		},
+		{
+			name:    "prepareOrgCustomRoleQuery found",
+			prepare: prepareOrgCustomRoleQuery,
+			want: want{
+				sqlExpectations: mockQuery(
+					regexp.QuoteMeta(prepareOrgCustomRoleStmt),
+					prepareOrgCustomRoleCols,
+					[]driver.Value{
+						"ORG_CUSTOM_HELPDESK",
+						"Helpdesk",
+						database.TextArray[string]{"user.read", "user.credential.write"},
+						testNow,
+						testNow,
+						uint64(20211109),
+						"ro",
+					},
+				),
+			},
+			object: &CustomRole{
+				Key:           "ORG_CUSTOM_HELPDESK",
+				DisplayName:   "Helpdesk",
+				Permissions:   []string{"user.read", "user.credential.write"},
+				CreationDate:  testNow,
+				ChangeDate:    testNow,
+				Sequence:      20211109,
+				ResourceOwner: "ro",
+			},
+		},
+		{
+			name:    "prepareOrgCustomRoleQuery sql err",
+			prepare: prepareOrgCustomRoleQuery,
+			want: want{
+				sqlExpectations: mockQueryErr(
+					regexp.QuoteMeta(prepareOrgCustomRoleStmt),
+					sql.ErrConnDone,
+				),
+				err: func(err error) (error, bool) {
+					if !errors.Is(err, sql.ErrConnDone) {
+						return fmt.Errorf("err should be sql.ErrConnDone got: %w", err), false
+					}
+					return nil, true
+				},
+			},
+			object: (*CustomRole)(nil),
+		},
+		{
+			name:    "prepareOrgCustomRolesQuery no result",
+			prepare: prepareOrgCustomRolesQuery,
+			want: want{
+				sqlExpectations: mockQueries(
+					regexp.QuoteMeta(prepareOrgCustomRoleStmt),
+					nil,
+					nil,
+				),
+			},
+			object: &CustomRoles{Roles: []*CustomRole{}},
+		},
+		{
+			name:    "prepareOrgCustomRolesQuery found",
+			prepare: prepareOrgCustomRolesQuery,
+			want: want{
+				sqlExpectations: mockQueries(
+					regexp.QuoteMeta(prepareOrgCustomRoleStmt),
+					prepareOrgCustomRoleCols,
+					[][]driver.Value{
+						{
+							"ORG_CUSTOM_AUDITOR",
+							"Auditor",
+							database.TextArray[string]{"events.read"},
+							testNow,
+							testNow,
+							uint64(20211109),
+							"ro",
+						},
+						{
+							"ORG_CUSTOM_HELPDESK",
+							"",
+							database.TextArray[string]{"user.read"},
+							testNow,
+							testNow,
+							uint64(20211110),
+							"ro",
+						},
+					},
+				),
+			},
+			object: &CustomRoles{
+				Roles: []*CustomRole{
+					{
+						Key:           "ORG_CUSTOM_AUDITOR",
+						DisplayName:   "Auditor",
+						Permissions:   []string{"events.read"},
+						CreationDate:  testNow,
+						ChangeDate:    testNow,
+						Sequence:      20211109,
+						ResourceOwner: "ro",
+					},
+					{
+						Key:           "ORG_CUSTOM_HELPDESK",
+						Permissions:   []string{"user.read"},
+						CreationDate:  testNow,
+						ChangeDate:    testNow,
+						Sequence:      20211110,
+						ResourceOwner: "ro",
+					},
+				},
+			},
+		},
+		{
+			name:    "prepareOrgCustomRolesQuery sql err",
+			prepare: prepareOrgCustomRolesQuery,
+			want: want{
+				sqlExpectations: mockQueryErr(
+					regexp.QuoteMeta(prepareOrgCustomRoleStmt),
+					sql.ErrConnDone,
+				),
+				err: func(err error) (error, bool) {
+					if !errors.Is(err, sql.ErrConnDone) {
+						return fmt.Errorf("err should be sql.ErrConnDone got: %w", err), false
+					}
+					return nil, true
+				},
+			},
+			object: (*CustomRoles)(nil),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assertPrepare(t, tt.prepare, tt.object, tt.want.sqlExpectations, tt.want.err, defaultPrepareArgs...)
+		})
+	}
+}
@@ -0,0 +1,221 @@
+package query
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"slices"
+
+	sq "github.com/Masterminds/squirrel"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/api/call"
+	"github.com/zitadel/zitadel/internal/database"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/query/projection"
+	"github.com/zitadel/zitadel/internal/telemetry/tracing"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+// OrgHierarchy is the position of an organization in the organization hierarchy of the instance.
+type OrgHierarchy struct {
+	OrgID          string
+	ParentOrgID    string
+	AncestorOrgIDs []string
+	ChildOrgIDs    []string
+}
+
+var (
+	orgParentTable = table{
+		name:          projection.OrgParentProjectionTable,
+		instanceIDCol: projection.OrgParentColumnInstanceID,
+	}
+	OrgParentColumnOrgID = Column{
+		name:  projection.OrgParentColumnOrgID,
+		table: orgParentTable,
+	}
+	OrgParentColumnInstanceID = Column{
+		name:  projection.OrgParentColumnInstanceID,
+		table: orgParentTable,
+	}
+	OrgParentColumnParentOrgID = Column{
+		name:  projection.OrgParentColumnParentOrgID,
+		table: orgParentTable,
+	}
+)
+
+// OrgHierarchy returns the ancestors, nearest first, and the direct children of the organization.
+func (q *Queries) OrgHierarchy(ctx context.Context, orgID string) (_ *OrgHierarchy, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	hierarchy, err := q.orgHierarchy(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &OrgHierarchy{
+		OrgID:          orgID,
+		ParentOrgID:    hierarchy.Parent(orgID),
+		AncestorOrgIDs: hierarchy.Ancestors(orgID),
+		ChildOrgIDs:    hierarchy.Children(orgID),
+	}, nil
+}
+
+// orgHierarchy returns the whole organization hierarchy of the instance.
+func (q *Queries) orgHierarchy(ctx context.Context) (hierarchy domain.OrgHierarchy, err error) {
+	stmt, scan := prepareOrgHierarchyQuery(ctx, q.client)
+	query, args, err := stmt.Where(sq.Eq{
+		OrgParentColumnInstanceID.identifier(): authz.GetInstance(ctx).InstanceID(),
+	}).ToSql()
+	if err != nil {
+		return nil, zerrors.ThrowInternal(err, "QUERY-Ua8pe", "Errors.Query.SQLStatement")
+	}
+
+	err = q.client.QueryContext(ctx, func(rows *sql.Rows) error {
+		hierarchy, err = scan(rows)
+		return err
+	}, query, args...)
+	return hierarchy, err
+}
+
+// orgAncestors walks up the organization hierarchy, it returns the parent, grandparent and so forth of the organization.
+// Every step is a lookup by primary key, so it's cheap for organizations without parent.
+func (q *Queries) orgAncestors(ctx context.Context, orgID string) ([]string, error) {
+	ancestors := make([]string, 0)
+	for len(ancestors) < domain.MaxOrgHierarchyDepth {
+		parentOrgID, err := q.orgParent(ctx, orgID)
+		if err != nil {
+			return nil, err
+		}
+		// guard against cycles, which are prevented when setting the parent
+		if parentOrgID == "" || slices.Contains(ancestors, parentOrgID) {
+			break
+		}
+		orgID = parentOrgID
+		ancestors = append(ancestors, orgID)
+	}
+	return ancestors, nil
+}
+
+// orgParent returns the parent of the organization, empty for roots.
+func (q *Queries) orgParent(ctx context.Context, orgID string) (parentOrgID string, err error) {
+	stmt, scan := prepareOrgParentQuery(ctx, q.client)
+	query, args, err := stmt.Where(sq.Eq{
+		OrgParentColumnInstanceID.identifier(): authz.GetInstance(ctx).InstanceID(),
+		OrgParentColumnOrgID.identifier():      orgID,
+	}).ToSql()
+	if err != nil {
+		return "", zerrors.ThrowInternal(err, "QUERY-Jk3wd", "Errors.Query.SQLStatement")
+	}
+
+	err = q.client.QueryRowContext(ctx, func(row *sql.Row) error {
+		parentOrgID, err = scan(row)
+		return err
+	}, query, args...)
+	return parentOrgID, err
+}
+
+func prepareOrgParentQuery(ctx context.Context, db prepareDatabase) (sq.SelectBuilder, func(*sql.Row) (string, error)) {
+	return sq.Select(
+			OrgParentColumnParentOrgID.identifier(),
+		).
+			From(orgParentTable.identifier() + db.Timetravel(call.Took(ctx))).
+			PlaceholderFormat(sq.Dollar),
+		func(row *sql.Row) (string, error) {
+			var parentOrgID string
+			err := row.Scan(&parentOrgID)
+			if errors.Is(err, sql.ErrNoRows) {
+				return "", nil
+			}
+			if err != nil {
+				return "", zerrors.ThrowInternal(err, "QUERY-Sd4ny", "Errors.Internal")
+			}
+			return parentOrgID, nil
+		}
+}
+
+func prepareOrgHierarchyQuery(ctx context.Context, db prepareDatabase) (sq.SelectBuilder, func(*sql.Rows) (domain.OrgHierarchy, error)) {
+	return sq.Select(
+			OrgParentColumnOrgID.identifier(),
+			OrgParentColumnParentOrgID.identifier(),
+		).
+			From(orgParentTable.identifier() + db.Timetravel(call.Took(ctx))).
+			PlaceholderFormat(sq.Dollar),
+		func(rows *sql.Rows) (domain.OrgHierarchy, error) {
+			hierarchy := make(domain.OrgHierarchy)
+			for rows.Next() {
+				var orgID, parentOrgID string
+				if err := rows.Scan(&orgID, &parentOrgID); err != nil {
+					return nil, err
+				}
+				hierarchy.SetParent(orgID, parentOrgID)
+			}
+			if err := rows.Close(); err != nil {
+				return nil, zerrors.ThrowInternal(err, "QUERY-Pv6gk", "Errors.Query.CloseRows")
+			}
+			return hierarchy, nil
+		}
+}
+
+// orgPolicyOwners returns the owners whose policies apply to the organization, ordered by precedence:
+// the organization itself, its ancestors and the instance.
+func (q *Queries) orgPolicyOwners(ctx context.Context, orgID string) ([]string, error) {
+	ancestors, err := q.orgAncestors(ctx, orgID)
+	if err != nil {
+		return nil, err
+	}
+	owners := make([]string, 0, len(ancestors)+2)
+	owners = append(owners, orgID)
+	owners = append(owners, ancestors...)
+	return append(owners, authz.GetInstance(ctx).InstanceID()), nil
+}
+
+// orgPolicyOwnersOrder orders the policies by the precedence of their owners returned by [Queries.orgPolicyOwners].
+func orgPolicyOwnersOrder(column Column, owners []string) sq.Sqlizer {
+	return sq.Expr("array_position(?::TEXT[], "+column.identifier()+")", database.TextArray[string](owners))
+}
+
+// OrgSubtreeQuery restricts the search to the organization and its descendants in the organization hierarchy.
+// The descendants are resolved by [Queries.SearchOrgs].
+type OrgSubtreeQuery struct {
+	OrgID string
+
+	descendants []string
+}
+
+func NewOrgSubtreeSearchQuery(orgID string) (SearchQuery, error) {
+	if orgID == "" {
+		return nil, zerrors.ThrowInvalidArgument(nil, "QUERY-u8Hxq", "Errors.Query.InvalidRequest")
+	}
+	return &OrgSubtreeQuery{OrgID: orgID}, nil
+}
+
+func (q *OrgSubtreeQuery) toQuery(query sq.SelectBuilder) sq.SelectBuilder {
+	return query.Where(q.comp())
+}
+
+func (q *OrgSubtreeQuery) comp() sq.Sqlizer {
+	return sq.Eq{OrgColumnID.identifier(): append([]string{q.OrgID}, q.descendants...)}
+}
+
+func (q *OrgSubtreeQuery) Col() Column {
+	return OrgColumnID
+}
+
+func (q *Queries) resolveOrgSubtreeQueries(ctx context.Context, queries []SearchQuery) error {
+	var hierarchy domain.OrgHierarchy
+	for _, query := range queries {
+		subtree, ok := query.(*OrgSubtreeQuery)
+		if !ok {
+			continue
+		}
+		if hierarchy == nil {
+			var err error
+			if hierarchy, err = q.orgHierarchy(ctx); err != nil {
+				return err
+			}
+		}
+		subtree.descendants = hierarchy.Descendants(subtree.OrgID)
+	}
+	return nil
+}
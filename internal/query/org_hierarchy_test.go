@@ -0,0 +1,139 @@
+package query
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"regexp"
+	"testing"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/zitadel/zitadel/internal/domain"
+)
+
+var (
+	prepareOrgParentStmt = `SELECT projections.org_parents.parent_org_id` +
+		` FROM projections.org_parents` +
+		` AS OF SYSTEM TIME '-1 ms'`
+	prepareOrgHierarchyStmt = `SELECT projections.org_parents.org_id,` +
+		` projections.org_parents.parent_org_id` +
+		` FROM projections.org_parents` +
+		` AS OF SYSTEM TIME '-1 ms'`
+)
+
+func Test_OrgHierarchyPrepares(t *testing.T) {
+	type want struct {
+		sqlExpectations sqlExpectation
+		err             checkErr
+	}
+	tests := []struct {
+		name    string
+		prepare interface{}
+		want    want
+		object  interface{}
+	}{
+		{
+			name:    "prepareOrgParentQuery no result",
+			prepare: prepareOrgParentQuery,
+			want: want{
+				sqlExpectations: mockQuery(
+					regexp.QuoteMeta(prepareOrgParentStmt),
+					[]string{"parent_org_id"},
+					nil,
+				),
+			},
+			object: "",
+		},
+		{
+			name:    "prepareOrgParentQuery found",
+			prepare: prepareOrgParentQuery,
+			want: want{
+				sqlExpectations: mockQuery(
+					regexp.QuoteMeta(prepareOrgParentStmt),
+					[]string{"parent_org_id"},
+					[]driver.Value{"root"},
+				),
+			},
+			object: "root",
+		},
+		{
+			name:    "prepareOrgHierarchyQuery no result",
+			prepare: prepareOrgHierarchyQuery,
+			want: want{
+				sqlExpectations: mockQueries(
+					regexp.QuoteMeta(prepareOrgHierarchyStmt),
+					nil,
+					nil,
+				),
+			},
+			object: domain.OrgHierarchy{},
+		},
+		{
+			name:    "prepareOrgHierarchyQuery found",
+			prepare: prepareOrgHierarchyQuery,
+			want: want{
+				sqlExpectations: mockQueries(
+					regexp.QuoteMeta(prepareOrgHierarchyStmt),
+					[]string{"org_id", "parent_org_id"},
+					[][]driver.Value{
+						{"unit1", "root"},
+						{"team1", "unit1"},
+					},
+				),
+			},
+			object: domain.OrgHierarchy{
+				"unit1": "root",
+				"team1": "unit1",
+			},
+		},
+		{
+			name:    "prepareOrgHierarchyQuery sql err",
+			prepare: prepareOrgHierarchyQuery,
+			want: want{
+				sqlExpectations: mockQueryErr(
+					regexp.QuoteMeta(prepareOrgHierarchyStmt),
+					sql.ErrConnDone,
+				),
+				err: func(err error) (error, bool) {
+					if !errors.Is(err, sql.ErrConnDone) {
+						return fmt.Errorf("err should be sql.ErrConnDone got: %w", err), false
+					}
+					return nil, true
+				},
+			},
+			object: (domain.OrgHierarchy)(nil),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assertPrepare(t, tt.prepare, tt.object, tt.want.sqlExpectations, tt.want.err, defaultPrepareArgs...)
+		})
+	}
+}
+
+func TestOrgSubtreeQuery_comp(t *testing.T) {
+	query, err := NewOrgSubtreeSearchQuery("root")
+	require.NoError(t, err)
+	query.(*OrgSubtreeQuery).descendants = []string{"unit1", "team1"}
+
+	stmt, args, err := query.toQuery(sq.Select("id").From("orgs")).ToSql()
+	require.NoError(t, err)
+	assert.Equal(t, "SELECT id FROM orgs WHERE projections.orgs1.id IN (?,?,?)", stmt)
+	assert.Equal(t, []interface{}{"root", "unit1", "team1"}, args)
+
+	_, err = NewOrgSubtreeSearchQuery("")
+	assert.Error(t, err)
+}
+
+func Test_orgPolicyOwnersOrder(t *testing.T) {
+	stmt, args, err := sq.Select("id").From("policies").
+		OrderByClause(orgPolicyOwnersOrder(OrgColumnID, []string{"org", "parent", "instance"})).
+		ToSql()
+	require.NoError(t, err)
+	assert.Equal(t, "SELECT id FROM policies ORDER BY array_position(?::TEXT[], projections.orgs1.id)", stmt)
+	assert.Len(t, args, 1)
+}
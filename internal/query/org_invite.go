@@ -0,0 +1,140 @@
+package query
+
+import (
+	"context"
+	"slices"
+	"time"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/repository/org"
+	"github.com/zitadel/zitadel/internal/telemetry/tracing"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+type OrgInvite struct {
+	ID           string
+	OrgID        string
+	CreationDate time.Time
+	ChangeDate   time.Time
+	Sequence     uint64
+
+	Email    domain.EmailAddress
+	Grants   []*domain.OrgInviteGrant
+	Metadata []*domain.OrgInviteMetadata
+	State    domain.OrgInviteState
+	// ExpirationDate is the latest date the invite can be used
+	ExpirationDate time.Time
+	// UserID is the user registered with the invite
+	UserID string
+}
+
+// IsUsable returns true if the invite wasn't used or revoked and isn't expired.
+func (i *OrgInvite) IsUsable() bool {
+	return i.State == domain.OrgInviteStateActive && time.Now().Before(i.ExpirationDate)
+}
+
+// OrgInvites returns the invites of the organization, the newest first.
+func (q *Queries) OrgInvites(ctx context.Context, orgID string) (_ []*OrgInvite, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	model := newOrgInvitesReadModel(authz.GetInstance(ctx).InstanceID(), orgID)
+	if err = q.eventstore.FilterToQueryReducer(ctx, model); err != nil {
+		return nil, err
+	}
+	slices.Reverse(model.invites)
+	return model.invites, nil
+}
+
+func (q *Queries) OrgInviteByID(ctx context.Context, orgID, inviteID string) (_ *OrgInvite, err error) {
+	invites, err := q.OrgInvites(ctx, orgID)
+	if err != nil {
+		return nil, err
+	}
+	index := slices.IndexFunc(invites, func(invite *OrgInvite) bool {
+		return invite.ID == inviteID
+	})
+	if index < 0 {
+		return nil, zerrors.ThrowNotFound(nil, "QUERY-Inv1n", "Errors.Org.Invite.NotFound")
+	}
+	return invites[index], nil
+}
+
+type orgInvitesReadModel struct {
+	eventstore.ReadModel
+
+	invites []*OrgInvite
+}
+
+func newOrgInvitesReadModel(instanceID, orgID string) *orgInvitesReadModel {
+	return &orgInvitesReadModel{
+		ReadModel: eventstore.ReadModel{
+			AggregateID:   orgID,
+			ResourceOwner: orgID,
+			InstanceID:    instanceID,
+		},
+	}
+}
+
+func (rm *orgInvitesReadModel) Reduce() error {
+	for _, event := range rm.Events {
+		switch e := event.(type) {
+		case *org.InviteAddedEvent:
+			rm.invites = append(rm.invites, &OrgInvite{
+				ID:             e.InviteID,
+				OrgID:          e.Aggregate().ID,
+				CreationDate:   e.CreatedAt(),
+				ChangeDate:     e.CreatedAt(),
+				Sequence:       e.Sequence(),
+				Email:          e.Email,
+				Grants:         e.Grants,
+				Metadata:       e.Metadata,
+				State:          domain.OrgInviteStateActive,
+				ExpirationDate: e.CreatedAt().Add(e.Expiry),
+			})
+		case *org.InviteUsedEvent:
+			if invite := rm.invite(e.InviteID); invite != nil {
+				invite.State = domain.OrgInviteStateUsed
+				invite.UserID = e.UserID
+				invite.ChangeDate = e.CreatedAt()
+				invite.Sequence = e.Sequence()
+			}
+		case *org.InviteRevokedEvent:
+			if invite := rm.invite(e.InviteID); invite != nil {
+				invite.State = domain.OrgInviteStateRevoked
+				invite.ChangeDate = e.CreatedAt()
+				invite.Sequence = e.Sequence()
+			}
+		case *org.OrgRemovedEvent:
+			rm.invites = nil
+		}
+	}
+	return rm.ReadModel.Reduce()
+}
+
+func (rm *orgInvitesReadModel) invite(inviteID string) *OrgInvite {
+	for _, invite := range rm.invites {
+		if invite.ID == inviteID {
+			return invite
+		}
+	}
+	return nil
+}
+
+func (rm *orgInvitesReadModel) Query() *eventstore.SearchQueryBuilder {
+	return eventstore.NewSearchQueryBuilder(eventstore.ColumnsEvent).
+		AwaitOpenTransactions().
+		InstanceID(rm.InstanceID).
+		AddQuery().
+		AggregateTypes(org.AggregateType).
+		AggregateIDs(rm.AggregateID).
+		EventTypes(
+			org.InviteAddedEventType,
+			org.InviteUsedEventType,
+			org.InviteRevokedEventType,
+			org.OrgRemovedEventType,
+		).
+		Builder()
+}
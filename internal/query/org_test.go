@@ -6,10 +6,12 @@ import (
 	"database/sql/driver"
 	"errors"
 	"fmt"
+	"reflect"
 	"regexp"
 	"testing"
 
 	"github.com/DATA-DOG/go-sqlmock"
+	sq "github.com/Masterminds/squirrel"
 
 	"github.com/zitadel/zitadel/internal/database"
 	db_mock "github.com/zitadel/zitadel/internal/database/mock"
@@ -441,3 +443,45 @@ func TestQueries_IsOrgUnique(t *testing.T) {
 
 	}
 }
+
+func TestNewOrgMetadataSearchQuery(t *testing.T) {
+	tests := []struct {
+		name     string
+		key      string
+		value    []byte
+		wantStmt string
+		wantArgs []interface{}
+	}{
+		{
+			name:     "key",
+			key:      "tenant",
+			wantStmt: "SELECT projections.orgs1.id FROM projections.orgs1 WHERE projections.orgs1.id IN ( SELECT projections.org_metadata2.org_id FROM projections.org_metadata2 WHERE projections.org_metadata2.instance_id = projections.orgs1.instance_id AND projections.org_metadata2.org_id = projections.orgs1.id AND projections.org_metadata2.key = ? )",
+			wantArgs: []interface{}{"tenant"},
+		},
+		{
+			name:     "key and value",
+			key:      "tenant",
+			value:    []byte("eu-1"),
+			wantStmt: "SELECT projections.orgs1.id FROM projections.orgs1 WHERE projections.orgs1.id IN ( SELECT projections.org_metadata2.org_id FROM projections.org_metadata2 WHERE projections.org_metadata2.instance_id = projections.orgs1.instance_id AND projections.org_metadata2.org_id = projections.orgs1.id AND projections.org_metadata2.key = ? AND projections.org_metadata2.value = ? )",
+			wantArgs: []interface{}{"tenant", []byte("eu-1")},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			query, err := NewOrgMetadataSearchQuery(tt.key, tt.value)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			stmt, args, err := query.toQuery(sq.Select(OrgColumnID.identifier()).From(orgsTable.identifier())).ToSql()
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if stmt != tt.wantStmt {
+				t.Errorf("wrong stmt:\nwant: %s\ngot:  %s", tt.wantStmt, stmt)
+			}
+			if !reflect.DeepEqual(args, tt.wantArgs) {
+				t.Errorf("wrong args: want: %v, got: %v", tt.wantArgs, args)
+			}
+		})
+	}
+}
@@ -396,6 +396,8 @@ const asOfSystemTime = " AS OF SYSTEM TIME '-1 ms' "
 
 func (*prepareDB) Timetravel(time.Duration) string { return asOfSystemTime }
 
+func (*prepareDB) ReplicationLagQuery() string { return "SELECT 0" }
+
 var defaultPrepareArgs = []reflect.Value{reflect.ValueOf(context.Background()), reflect.ValueOf(new(prepareDB))}
 
 func (*prepareDB) DatabaseName() string { return "db" }
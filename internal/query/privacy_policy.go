@@ -112,6 +112,11 @@ func (q *Queries) PrivacyPolicyByOrg(ctx context.Context, shouldTriggerBulk bool
 	ctx, span := tracing.NewSpan(ctx)
 	defer func() { span.EndWithError(err) }()
 
+	owners, err := q.orgPolicyOwners(ctx, orgID)
+	if err != nil {
+		return nil, err
+	}
+
 	if shouldTriggerBulk {
 		_, traceSpan := tracing.NewNamedSpan(ctx, "TriggerPrivacyPolicyProjection")
 		ctx, err = projection.PrivacyPolicyProjection.Trigger(ctx, handler.WithAwaitRunning())
@@ -126,12 +131,9 @@ func (q *Queries) PrivacyPolicyByOrg(ctx context.Context, shouldTriggerBulk bool
 	query, args, err := stmt.Where(
 		sq.And{
 			eq,
-			sq.Or{
-				sq.Eq{PrivacyColID.identifier(): orgID},
-				sq.Eq{PrivacyColID.identifier(): authz.GetInstance(ctx).InstanceID()},
-			},
+			sq.Eq{PrivacyColID.identifier(): owners},
 		}).
-		OrderBy(PrivacyColIsDefault.identifier()).Limit(1).ToSql()
+		OrderByClause(orgPolicyOwnersOrder(PrivacyColID, owners)).Limit(1).ToSql()
 	if err != nil {
 		return nil, zerrors.ThrowInternal(err, "QUERY-UXuPI", "Errors.Query.SQLStatement")
 	}
@@ -0,0 +1,104 @@
+package query
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/api/call"
+	"github.com/zitadel/zitadel/internal/database"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/query/projection"
+	"github.com/zitadel/zitadel/internal/telemetry/tracing"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+type ProfileRequirements struct {
+	OrgID      string
+	ChangeDate time.Time
+	Sequence   uint64
+
+	Fields []domain.ProfileField
+}
+
+var (
+	profileRequirementsTable = table{
+		name:          projection.ProfileRequirementsProjectionTable,
+		instanceIDCol: projection.ProfileRequirementsColumnInstanceID,
+	}
+	ProfileRequirementsColumnOrgID = Column{
+		name:  projection.ProfileRequirementsColumnOrgID,
+		table: profileRequirementsTable,
+	}
+	ProfileRequirementsColumnInstanceID = Column{
+		name:  projection.ProfileRequirementsColumnInstanceID,
+		table: profileRequirementsTable,
+	}
+	ProfileRequirementsColumnChangeDate = Column{
+		name:  projection.ProfileRequirementsColumnChangeDate,
+		table: profileRequirementsTable,
+	}
+	ProfileRequirementsColumnSequence = Column{
+		name:  projection.ProfileRequirementsColumnSequence,
+		table: profileRequirementsTable,
+	}
+	ProfileRequirementsColumnFields = Column{
+		name:  projection.ProfileRequirementsColumnFields,
+		table: profileRequirementsTable,
+	}
+)
+
+// ProfileRequirementsByOrg returns the profile fields the human users of the organization are required to have.
+// Organizations without requirements don't require any field, so no default requirements exist.
+func (q *Queries) ProfileRequirementsByOrg(ctx context.Context, orgID string) (requirements *ProfileRequirements, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	stmt, scan := prepareProfileRequirementsQuery(ctx, q.client)
+	query, args, err := stmt.Where(sq.Eq{
+		ProfileRequirementsColumnInstanceID.identifier(): authz.GetInstance(ctx).InstanceID(),
+		ProfileRequirementsColumnOrgID.identifier():      orgID,
+	}).ToSql()
+	if err != nil {
+		return nil, zerrors.ThrowInternal(err, "QUERY-Prq2s", "Errors.Query.SQLStatement")
+	}
+
+	err = q.client.QueryRowContext(ctx, func(row *sql.Row) error {
+		requirements, err = scan(row)
+		return err
+	}, query, args...)
+	return requirements, err
+}
+
+func prepareProfileRequirementsQuery(ctx context.Context, db prepareDatabase) (sq.SelectBuilder, func(*sql.Row) (*ProfileRequirements, error)) {
+	return sq.Select(
+			ProfileRequirementsColumnOrgID.identifier(),
+			ProfileRequirementsColumnChangeDate.identifier(),
+			ProfileRequirementsColumnSequence.identifier(),
+			ProfileRequirementsColumnFields.identifier(),
+		).
+			From(profileRequirementsTable.identifier() + db.Timetravel(call.Took(ctx))).
+			PlaceholderFormat(sq.Dollar),
+		func(row *sql.Row) (*ProfileRequirements, error) {
+			requirements := new(ProfileRequirements)
+			var fields database.NumberArray[domain.ProfileField]
+			err := row.Scan(
+				&requirements.OrgID,
+				&requirements.ChangeDate,
+				&requirements.Sequence,
+				&fields,
+			)
+			if err != nil {
+				if errors.Is(err, sql.ErrNoRows) {
+					return nil, zerrors.ThrowNotFound(err, "QUERY-Prq1n", "Errors.Org.ProfileRequirements.NotFound")
+				}
+				return nil, zerrors.ThrowInternal(err, "QUERY-Prq5c", "Errors.Internal")
+			}
+			requirements.Fields = fields
+			return requirements, nil
+		}
+}
@@ -0,0 +1,105 @@
+package query
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/zitadel/zitadel/internal/database"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+var (
+	prepareProfileRequirementsStmt = `SELECT projections.profile_requirements.org_id,` +
+		` projections.profile_requirements.change_date,` +
+		` projections.profile_requirements.sequence,` +
+		` projections.profile_requirements.fields` +
+		` FROM projections.profile_requirements` +
+		` AS OF SYSTEM TIME '-1 ms'`
+	prepareProfileRequirementsCols = []string{
+		"org_id",
+		"change_date",
+		"sequence",
+		"fields",
+	}
+)
+
+func Test_ProfileRequirementsPrepares(t *testing.T) {
+	type want struct {
+		sqlExpectations sqlExpectation
+		err             checkErr
+	}
+	tests := []struct {
+		name    string
+		prepare interface{}
+		want    want
+		object  interface{}
+	}{
+		{
+			name:    "prepareProfileRequirementsQuery no result",
+			prepare: prepareProfileRequirementsQuery,
+			want: want{
+				sqlExpectations: mockQueriesScanErr(
+					regexp.QuoteMeta(prepareProfileRequirementsStmt),
+					nil,
+					nil,
+				),
+				err: func(err error) (error, bool) {
+					if !zerrors.IsNotFound(err) {
+						return fmt.Errorf("err should be zitadel.NotFoundError got: %w", err), false
+					}
+					return nil, true
+				},
+			},
+			object: (*ProfileRequirements)(nil),
+		},
+		{
+			name:    "prepareProfileRequirementsQuery found",
+			prepare: prepareProfileRequirementsQuery,
+			want: want{
+				sqlExpectations: mockQuery(
+					regexp.QuoteMeta(prepareProfileRequirementsStmt),
+					prepareProfileRequirementsCols,
+					[]driver.Value{
+						"org-id",
+						testNow,
+						uint64(20211109),
+						database.NumberArray[domain.ProfileField]{domain.ProfileFieldPhone, domain.ProfileFieldGender},
+					},
+				),
+			},
+			object: &ProfileRequirements{
+				OrgID:      "org-id",
+				ChangeDate: testNow,
+				Sequence:   20211109,
+				Fields:     []domain.ProfileField{domain.ProfileFieldPhone, domain.ProfileFieldGender},
+			},
+		},
+		{
+			name:    "prepareProfileRequirementsQuery sql err",
+			prepare: prepareProfileRequirementsQuery,
+			want: want{
+				sqlExpectations: mockQueryErr(
+					regexp.QuoteMeta(prepareProfileRequirementsStmt),
+					sql.ErrConnDone,
+				),
+				err: func(err error) (error, bool) {
+					if !errors.Is(err, sql.ErrConnDone) {
+						return fmt.Errorf("err should be sql.ErrConnDone got: %w", err), false
+					}
+					return nil, true
+				},
+			},
+			object: (*ProfileRequirements)(nil),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assertPrepare(t, tt.prepare, tt.object, tt.want.sqlExpectations, tt.want.err, defaultPrepareArgs...)
+		})
+	}
+}
@@ -47,6 +47,10 @@ var (
 		name:  projection.ProjectColumnPrivateLabelingSetting,
 		table: projectsTable,
 	}
+	ProjectColumnAccessTokenType = Column{
+		name:  projection.ProjectColumnAccessTokenType,
+		table: projectsTable,
+	}
 	ProjectColumnCreationDate = Column{
 		name:  projection.ProjectColumnCreationDate,
 		table: projectsTable,
@@ -91,6 +95,7 @@ type Project struct {
 	ProjectRoleCheck       bool
 	HasProjectCheck        bool
 	PrivateLabelingSetting domain.PrivateLabelingSetting
+	AccessTokenType        domain.OIDCTokenType
 }
 
 type ProjectSearchQueries struct {
@@ -205,7 +210,8 @@ func prepareProjectQuery(ctx context.Context, db prepareDatabase) (sq.SelectBuil
 			ProjectColumnProjectRoleAssertion.identifier(),
 			ProjectColumnProjectRoleCheck.identifier(),
 			ProjectColumnHasProjectCheck.identifier(),
-			ProjectColumnPrivateLabelingSetting.identifier()).
+			ProjectColumnPrivateLabelingSetting.identifier(),
+			ProjectColumnAccessTokenType.identifier()).
 			From(projectsTable.identifier() + db.Timetravel(call.Took(ctx))).
 			PlaceholderFormat(sq.Dollar),
 		func(row *sql.Row) (*Project, error) {
@@ -222,6 +228,7 @@ func prepareProjectQuery(ctx context.Context, db prepareDatabase) (sq.SelectBuil
 				&p.ProjectRoleCheck,
 				&p.HasProjectCheck,
 				&p.PrivateLabelingSetting,
+				&p.AccessTokenType,
 			)
 			if err != nil {
 				if errors.Is(err, sql.ErrNoRows) {
@@ -246,6 +253,7 @@ func prepareProjectsQuery(ctx context.Context, db prepareDatabase) (sq.SelectBui
 			ProjectColumnProjectRoleCheck.identifier(),
 			ProjectColumnHasProjectCheck.identifier(),
 			ProjectColumnPrivateLabelingSetting.identifier(),
+			ProjectColumnAccessTokenType.identifier(),
 			countColumn.identifier()).
 			From(projectsTable.identifier() + db.Timetravel(call.Took(ctx))).
 			PlaceholderFormat(sq.Dollar),
@@ -266,6 +274,7 @@ func prepareProjectsQuery(ctx context.Context, db prepareDatabase) (sq.SelectBui
 					&project.ProjectRoleCheck,
 					&project.HasProjectCheck,
 					&project.PrivateLabelingSetting,
+					&project.AccessTokenType,
 					&count,
 				)
 				if err != nil {
@@ -21,14 +21,14 @@ var (
 		` projections.project_grants4.resource_owner,` +
 		` projections.project_grants4.state,` +
 		` projections.project_grants4.sequence,` +
-		` projections.projects4.name,` +
+		` projections.projects5.name,` +
 		` projections.project_grants4.granted_org_id,` +
 		` o.name,` +
 		` projections.project_grants4.granted_role_keys,` +
 		` r.name,` +
 		` COUNT(*) OVER () ` +
 		` FROM projections.project_grants4 ` +
-		` LEFT JOIN projections.projects4 ON projections.project_grants4.project_id = projections.projects4.id AND projections.project_grants4.instance_id = projections.projects4.instance_id ` +
+		` LEFT JOIN projections.projects5 ON projections.project_grants4.project_id = projections.projects5.id AND projections.project_grants4.instance_id = projections.projects5.instance_id ` +
 		` LEFT JOIN projections.orgs1 AS r ON projections.project_grants4.resource_owner = r.id AND projections.project_grants4.instance_id = r.instance_id` +
 		` LEFT JOIN projections.orgs1 AS o ON projections.project_grants4.granted_org_id = o.id AND projections.project_grants4.instance_id = o.instance_id` +
 		` AS OF SYSTEM TIME '-1 ms'`
@@ -54,13 +54,13 @@ var (
 		` projections.project_grants4.resource_owner,` +
 		` projections.project_grants4.state,` +
 		` projections.project_grants4.sequence,` +
-		` projections.projects4.name,` +
+		` projections.projects5.name,` +
 		` projections.project_grants4.granted_org_id,` +
 		` o.name,` +
 		` projections.project_grants4.granted_role_keys,` +
 		` r.name` +
 		` FROM projections.project_grants4 ` +
-		` LEFT JOIN projections.projects4 ON projections.project_grants4.project_id = projections.projects4.id AND projections.project_grants4.instance_id = projections.projects4.instance_id ` +
+		` LEFT JOIN projections.projects5 ON projections.project_grants4.project_id = projections.projects5.id AND projections.project_grants4.instance_id = projections.projects5.instance_id ` +
 		` LEFT JOIN projections.orgs1 AS r ON projections.project_grants4.resource_owner = r.id AND projections.project_grants4.instance_id = r.instance_id` +
 		` LEFT JOIN projections.orgs1 AS o ON projections.project_grants4.granted_org_id = o.id AND projections.project_grants4.instance_id = o.instance_id` +
 		` AS OF SYSTEM TIME '-1 ms'`
@@ -25,21 +25,23 @@ var (
 		"project_role_check",
 		"has_project_check",
 		"private_labeling_setting",
+		"access_token_type",
 	}
 
-	prepareProjectsStmt = `SELECT projections.projects4.id,` +
-		` projections.projects4.creation_date,` +
-		` projections.projects4.change_date,` +
-		` projections.projects4.resource_owner,` +
-		` projections.projects4.state,` +
-		` projections.projects4.sequence,` +
-		` projections.projects4.name,` +
-		` projections.projects4.project_role_assertion,` +
-		` projections.projects4.project_role_check,` +
-		` projections.projects4.has_project_check,` +
-		` projections.projects4.private_labeling_setting,` +
+	prepareProjectsStmt = `SELECT projections.projects5.id,` +
+		` projections.projects5.creation_date,` +
+		` projections.projects5.change_date,` +
+		` projections.projects5.resource_owner,` +
+		` projections.projects5.state,` +
+		` projections.projects5.sequence,` +
+		` projections.projects5.name,` +
+		` projections.projects5.project_role_assertion,` +
+		` projections.projects5.project_role_check,` +
+		` projections.projects5.has_project_check,` +
+		` projections.projects5.private_labeling_setting,` +
+		` projections.projects5.access_token_type,` +
 		` COUNT(*) OVER ()` +
-		` FROM projections.projects4` +
+		` FROM projections.projects5` +
 		` AS OF SYSTEM TIME '-1 ms'`
 	prepareProjectsCols = []string{
 		"id",
@@ -53,21 +55,23 @@ var (
 		"project_role_check",
 		"has_project_check",
 		"private_labeling_setting",
+		"access_token_type",
 		"count",
 	}
 
-	prepareProjectStmt = `SELECT projections.projects4.id,` +
-		` projections.projects4.creation_date,` +
-		` projections.projects4.change_date,` +
-		` projections.projects4.resource_owner,` +
-		` projections.projects4.state,` +
-		` projections.projects4.sequence,` +
-		` projections.projects4.name,` +
-		` projections.projects4.project_role_assertion,` +
-		` projections.projects4.project_role_check,` +
-		` projections.projects4.has_project_check,` +
-		` projections.projects4.private_labeling_setting` +
-		` FROM projections.projects4` +
+	prepareProjectStmt = `SELECT projections.projects5.id,` +
+		` projections.projects5.creation_date,` +
+		` projections.projects5.change_date,` +
+		` projections.projects5.resource_owner,` +
+		` projections.projects5.state,` +
+		` projections.projects5.sequence,` +
+		` projections.projects5.name,` +
+		` projections.projects5.project_role_assertion,` +
+		` projections.projects5.project_role_check,` +
+		` projections.projects5.has_project_check,` +
+		` projections.projects5.private_labeling_setting,` +
+		` projections.projects5.access_token_type` +
+		` FROM projections.projects5` +
 		` AS OF SYSTEM TIME '-1 ms'`
 	prepareProjectCols = []string{
 		"id",
@@ -81,6 +85,7 @@ var (
 		"project_role_check",
 		"has_project_check",
 		"private_labeling_setting",
+		"access_token_type",
 	}
 )
 
@@ -127,6 +132,7 @@ func Test_ProjectPrepares(t *testing.T) {
 							true,
 							true,
 							domain.PrivateLabelingSettingEnforceProjectResourceOwnerPolicy,
+							domain.OIDCTokenTypeBearer,
 						},
 					},
 				),
@@ -172,6 +178,7 @@ func Test_ProjectPrepares(t *testing.T) {
 							true,
 							true,
 							domain.PrivateLabelingSettingEnforceProjectResourceOwnerPolicy,
+							domain.OIDCTokenTypeBearer,
 						},
 						{
 							"id-2",
@@ -185,6 +192,7 @@ func Test_ProjectPrepares(t *testing.T) {
 							false,
 							false,
 							domain.PrivateLabelingSettingAllowLoginUserResourceOwnerPolicy,
+							domain.OIDCTokenTypeBearer,
 						},
 					},
 				),
@@ -277,6 +285,7 @@ func Test_ProjectPrepares(t *testing.T) {
 						true,
 						true,
 						domain.PrivateLabelingSettingEnforceProjectResourceOwnerPolicy,
+						domain.OIDCTokenTypeJWT,
 					},
 				),
 			},
@@ -292,6 +301,7 @@ func Test_ProjectPrepares(t *testing.T) {
 				ProjectRoleCheck:       true,
 				HasProjectCheck:        true,
 				PrivateLabelingSetting: domain.PrivateLabelingSettingEnforceProjectResourceOwnerPolicy,
+				AccessTokenType:        domain.OIDCTokenTypeJWT,
 			},
 		},
 		{
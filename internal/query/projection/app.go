@@ -16,7 +16,7 @@ import (
 )
 
 const (
-	AppProjectionTable = "projections.apps7"
+	AppProjectionTable = "projections.apps12"
 	AppAPITable        = AppProjectionTable + "_" + appAPITableSuffix
 	AppOIDCTable       = AppProjectionTable + "_" + appOIDCTableSuffix
 	AppSAMLTable       = AppProjectionTable + "_" + appSAMLTableSuffix
@@ -30,6 +30,7 @@ const (
 	AppColumnInstanceID    = "instance_id"
 	AppColumnState         = "state"
 	AppColumnSequence      = "sequence"
+	AppColumnClaimsMapping = "claims_mapping"
 
 	appAPITableSuffix              = "api_configs"
 	AppAPIConfigColumnAppID        = "app_id"
@@ -58,6 +59,11 @@ const (
 	AppOIDCConfigColumnClockSkew                = "clock_skew"
 	AppOIDCConfigColumnAdditionalOrigins        = "additional_origins"
 	AppOIDCConfigColumnSkipNativeAppSuccessPage = "skip_native_app_success_page"
+	AppOIDCConfigColumnTLSClientAuthSubjectDN   = "tls_client_auth_subject_dn"
+	AppOIDCConfigColumnTLSClientAuthThumbprint  = "tls_client_auth_thumbprint"
+	AppOIDCConfigColumnBackChannelLogoutURI     = "back_channel_logout_uri"
+	AppOIDCConfigColumnFrontChannelLogoutURI    = "front_channel_logout_uri"
+	AppOIDCConfigColumnFrameAncestors           = "frame_ancestors"
 
 	appSAMLTableSuffix             = "saml_configs"
 	AppSAMLConfigColumnAppID       = "app_id"
@@ -65,6 +71,7 @@ const (
 	AppSAMLConfigColumnEntityID    = "entity_id"
 	AppSAMLConfigColumnMetadata    = "metadata"
 	AppSAMLConfigColumnMetadataURL = "metadata_url"
+	AppSAMLConfigColumnAttributes  = "attributes"
 )
 
 type appProjection struct{}
@@ -89,6 +96,7 @@ func (*appProjection) Init() *old_handler.Check {
 			handler.NewColumn(AppColumnInstanceID, handler.ColumnTypeText),
 			handler.NewColumn(AppColumnState, handler.ColumnTypeEnum),
 			handler.NewColumn(AppColumnSequence, handler.ColumnTypeInt64),
+			handler.NewColumn(AppColumnClaimsMapping, handler.ColumnTypeJSONB, handler.Nullable()),
 		},
 			handler.NewPrimaryKey(AppColumnInstanceID, AppColumnID),
 			handler.WithIndex(handler.NewIndex("project_id", []string{AppColumnProjectID})),
@@ -125,6 +133,11 @@ func (*appProjection) Init() *old_handler.Check {
 			handler.NewColumn(AppOIDCConfigColumnClockSkew, handler.ColumnTypeInt64, handler.Default(0)),
 			handler.NewColumn(AppOIDCConfigColumnAdditionalOrigins, handler.ColumnTypeTextArray, handler.Nullable()),
 			handler.NewColumn(AppOIDCConfigColumnSkipNativeAppSuccessPage, handler.ColumnTypeBool, handler.Default(false)),
+			handler.NewColumn(AppOIDCConfigColumnTLSClientAuthSubjectDN, handler.ColumnTypeText, handler.Default("")),
+			handler.NewColumn(AppOIDCConfigColumnTLSClientAuthThumbprint, handler.ColumnTypeText, handler.Default("")),
+			handler.NewColumn(AppOIDCConfigColumnBackChannelLogoutURI, handler.ColumnTypeText, handler.Default("")),
+			handler.NewColumn(AppOIDCConfigColumnFrontChannelLogoutURI, handler.ColumnTypeText, handler.Default("")),
+			handler.NewColumn(AppOIDCConfigColumnFrameAncestors, handler.ColumnTypeTextArray, handler.Nullable()),
 		},
 			handler.NewPrimaryKey(AppOIDCConfigColumnInstanceID, AppOIDCConfigColumnAppID),
 			appOIDCTableSuffix,
@@ -137,6 +150,7 @@ func (*appProjection) Init() *old_handler.Check {
 			handler.NewColumn(AppSAMLConfigColumnEntityID, handler.ColumnTypeText),
 			handler.NewColumn(AppSAMLConfigColumnMetadata, handler.ColumnTypeBytes),
 			handler.NewColumn(AppSAMLConfigColumnMetadataURL, handler.ColumnTypeText),
+			handler.NewColumn(AppSAMLConfigColumnAttributes, handler.ColumnTypeJSONB, handler.Nullable()),
 		},
 			handler.NewPrimaryKey(AppSAMLConfigColumnInstanceID, AppSAMLConfigColumnAppID),
 			appSAMLTableSuffix,
@@ -167,6 +181,18 @@ func (p *appProjection) Reducers() []handler.AggregateReducer {
 					Event:  project.ApplicationReactivatedType,
 					Reduce: p.reduceAppReactivated,
 				},
+				{
+					Event:  project.ApplicationClaimsMappingSetType,
+					Reduce: p.reduceAppClaimsMappingSet,
+				},
+				{
+					Event:  project.ApplicationLogoutConfigSetType,
+					Reduce: p.reduceAppLogoutConfigSet,
+				},
+				{
+					Event:  project.ApplicationFrameAncestorsSetType,
+					Reduce: p.reduceAppFrameAncestorsSet,
+				},
 				{
 					Event:  project.ApplicationRemovedType,
 					Reduce: p.reduceAppRemoved,
@@ -215,6 +241,10 @@ func (p *appProjection) Reducers() []handler.AggregateReducer {
 					Event:  project.SAMLConfigChangedType,
 					Reduce: p.reduceSAMLConfigChanged,
 				},
+				{
+					Event:  project.SAMLAttributesSetType,
+					Reduce: p.reduceSAMLAttributesSet,
+				},
 			},
 		},
 		{
@@ -319,6 +349,86 @@ func (p *appProjection) reduceAppReactivated(event eventstore.Event) (*handler.S
 	), nil
 }
 
+func (p *appProjection) reduceAppClaimsMappingSet(event eventstore.Event) (*handler.Statement, error) {
+	e, err := assertEvent[*project.ApplicationClaimsMappingSetEvent](event)
+	if err != nil {
+		return nil, err
+	}
+	return handler.NewUpdateStatement(
+		e,
+		[]handler.Column{
+			handler.NewJSONCol(AppColumnClaimsMapping, e.ClaimsMapping),
+			handler.NewCol(AppColumnChangeDate, e.CreationDate()),
+			handler.NewCol(AppColumnSequence, e.Sequence()),
+		},
+		[]handler.Condition{
+			handler.NewCond(AppColumnID, e.AppID),
+			handler.NewCond(AppColumnInstanceID, e.Aggregate().InstanceID),
+		},
+	), nil
+}
+
+func (p *appProjection) reduceAppLogoutConfigSet(event eventstore.Event) (*handler.Statement, error) {
+	e, err := assertEvent[*project.ApplicationLogoutConfigSetEvent](event)
+	if err != nil {
+		return nil, err
+	}
+	return handler.NewMultiStatement(
+		e,
+		handler.AddUpdateStatement(
+			[]handler.Column{
+				handler.NewCol(AppOIDCConfigColumnBackChannelLogoutURI, e.BackChannelLogoutURI),
+				handler.NewCol(AppOIDCConfigColumnFrontChannelLogoutURI, e.FrontChannelLogoutURI),
+			},
+			[]handler.Condition{
+				handler.NewCond(AppOIDCConfigColumnAppID, e.AppID),
+				handler.NewCond(AppOIDCConfigColumnInstanceID, e.Aggregate().InstanceID),
+			},
+			handler.WithTableSuffix(appOIDCTableSuffix),
+		),
+		handler.AddUpdateStatement(
+			[]handler.Column{
+				handler.NewCol(AppColumnChangeDate, e.CreationDate()),
+				handler.NewCol(AppColumnSequence, e.Sequence()),
+			},
+			[]handler.Condition{
+				handler.NewCond(AppColumnID, e.AppID),
+				handler.NewCond(AppColumnInstanceID, e.Aggregate().InstanceID),
+			},
+		),
+	), nil
+}
+
+func (p *appProjection) reduceAppFrameAncestorsSet(event eventstore.Event) (*handler.Statement, error) {
+	e, err := assertEvent[*project.ApplicationFrameAncestorsSetEvent](event)
+	if err != nil {
+		return nil, err
+	}
+	return handler.NewMultiStatement(
+		e,
+		handler.AddUpdateStatement(
+			[]handler.Column{
+				handler.NewCol(AppOIDCConfigColumnFrameAncestors, database.TextArray[string](e.FrameAncestors)),
+			},
+			[]handler.Condition{
+				handler.NewCond(AppOIDCConfigColumnAppID, e.AppID),
+				handler.NewCond(AppOIDCConfigColumnInstanceID, e.Aggregate().InstanceID),
+			},
+			handler.WithTableSuffix(appOIDCTableSuffix),
+		),
+		handler.AddUpdateStatement(
+			[]handler.Column{
+				handler.NewCol(AppColumnChangeDate, e.CreationDate()),
+				handler.NewCol(AppColumnSequence, e.Sequence()),
+			},
+			[]handler.Condition{
+				handler.NewCond(AppColumnID, e.AppID),
+				handler.NewCond(AppColumnInstanceID, e.Aggregate().InstanceID),
+			},
+		),
+	), nil
+}
+
 func (p *appProjection) reduceAppRemoved(event eventstore.Event) (*handler.Statement, error) {
 	e, ok := event.(*project.ApplicationRemovedEvent)
 	if !ok {
@@ -500,6 +610,8 @@ func (p *appProjection) reduceOIDCConfigAdded(event eventstore.Event) (*handler.
 				handler.NewCol(AppOIDCConfigColumnClockSkew, e.ClockSkew),
 				handler.NewCol(AppOIDCConfigColumnAdditionalOrigins, database.TextArray[string](e.AdditionalOrigins)),
 				handler.NewCol(AppOIDCConfigColumnSkipNativeAppSuccessPage, e.SkipNativeAppSuccessPage),
+				handler.NewCol(AppOIDCConfigColumnTLSClientAuthSubjectDN, e.TLSClientAuthSubjectDN),
+				handler.NewCol(AppOIDCConfigColumnTLSClientAuthThumbprint, e.TLSClientAuthThumbprint),
 			},
 			handler.WithTableSuffix(appOIDCTableSuffix),
 		),
@@ -568,6 +680,12 @@ func (p *appProjection) reduceOIDCConfigChanged(event eventstore.Event) (*handle
 	if e.SkipNativeAppSuccessPage != nil {
 		cols = append(cols, handler.NewCol(AppOIDCConfigColumnSkipNativeAppSuccessPage, *e.SkipNativeAppSuccessPage))
 	}
+	if e.TLSClientAuthSubjectDN != nil {
+		cols = append(cols, handler.NewCol(AppOIDCConfigColumnTLSClientAuthSubjectDN, *e.TLSClientAuthSubjectDN))
+	}
+	if e.TLSClientAuthThumbprint != nil {
+		cols = append(cols, handler.NewCol(AppOIDCConfigColumnTLSClientAuthThumbprint, *e.TLSClientAuthThumbprint))
+	}
 
 	if len(cols) == 0 {
 		return handler.NewNoOpStatement(e), nil
@@ -744,3 +862,33 @@ func (p *appProjection) reduceSAMLConfigChanged(event eventstore.Event) (*handle
 		),
 	), nil
 }
+
+func (p *appProjection) reduceSAMLAttributesSet(event eventstore.Event) (*handler.Statement, error) {
+	e, err := assertEvent[*project.SAMLAttributesSetEvent](event)
+	if err != nil {
+		return nil, err
+	}
+	return handler.NewMultiStatement(
+		e,
+		handler.AddUpdateStatement(
+			[]handler.Column{
+				handler.NewJSONCol(AppSAMLConfigColumnAttributes, e.Attributes),
+			},
+			[]handler.Condition{
+				handler.NewCond(AppSAMLConfigColumnAppID, e.AppID),
+				handler.NewCond(AppSAMLConfigColumnInstanceID, e.Aggregate().InstanceID),
+			},
+			handler.WithTableSuffix(appSAMLTableSuffix),
+		),
+		handler.AddUpdateStatement(
+			[]handler.Column{
+				handler.NewCol(AppColumnChangeDate, e.CreationDate()),
+				handler.NewCol(AppColumnSequence, e.Sequence()),
+			},
+			[]handler.Condition{
+				handler.NewCond(AppColumnID, e.AppID),
+				handler.NewCond(AppColumnInstanceID, e.Aggregate().InstanceID),
+			},
+		),
+	), nil
+}
@@ -0,0 +1,179 @@
+package projection
+
+import (
+	"context"
+
+	"github.com/zitadel/zitadel/internal/eventstore"
+	old_handler "github.com/zitadel/zitadel/internal/eventstore/handler"
+	"github.com/zitadel/zitadel/internal/eventstore/handler/v2"
+	"github.com/zitadel/zitadel/internal/repository/instance"
+	"github.com/zitadel/zitadel/internal/repository/org"
+	"github.com/zitadel/zitadel/internal/repository/project"
+)
+
+const (
+	AppTokenLifetimesTable = "projections.app_token_lifetimes"
+
+	AppTokenLifetimesColumnAppID                      = "app_id"
+	AppTokenLifetimesColumnInstanceID                 = "instance_id"
+	AppTokenLifetimesColumnProjectID                  = "project_id"
+	AppTokenLifetimesColumnClientID                   = "client_id"
+	AppTokenLifetimesColumnResourceOwner              = "resource_owner"
+	AppTokenLifetimesColumnChangeDate                 = "change_date"
+	AppTokenLifetimesColumnSequence                   = "sequence"
+	AppTokenLifetimesColumnAccessTokenLifetime        = "access_token_lifetime"
+	AppTokenLifetimesColumnIDTokenLifetime            = "id_token_lifetime"
+	AppTokenLifetimesColumnRefreshTokenIdleExpiration = "refresh_token_idle_expiration"
+	AppTokenLifetimesColumnRefreshTokenExpiration     = "refresh_token_expiration"
+)
+
+// appTokenLifetimesProjection contains the token lifetimes overridden by OIDC applications,
+// applications using the lifetimes of the instance have no row.
+type appTokenLifetimesProjection struct{}
+
+func newAppTokenLifetimesProjection(ctx context.Context, config handler.Config) *handler.Handler {
+	return handler.NewHandler(ctx, &config, new(appTokenLifetimesProjection))
+}
+
+func (*appTokenLifetimesProjection) Name() string {
+	return AppTokenLifetimesTable
+}
+
+func (*appTokenLifetimesProjection) Init() *old_handler.Check {
+	return handler.NewTableCheck(
+		handler.NewTable([]*handler.InitColumn{
+			handler.NewColumn(AppTokenLifetimesColumnAppID, handler.ColumnTypeText),
+			handler.NewColumn(AppTokenLifetimesColumnInstanceID, handler.ColumnTypeText),
+			handler.NewColumn(AppTokenLifetimesColumnProjectID, handler.ColumnTypeText),
+			handler.NewColumn(AppTokenLifetimesColumnClientID, handler.ColumnTypeText),
+			handler.NewColumn(AppTokenLifetimesColumnResourceOwner, handler.ColumnTypeText),
+			handler.NewColumn(AppTokenLifetimesColumnChangeDate, handler.ColumnTypeTimestamp),
+			handler.NewColumn(AppTokenLifetimesColumnSequence, handler.ColumnTypeInt64),
+			handler.NewColumn(AppTokenLifetimesColumnAccessTokenLifetime, handler.ColumnTypeInt64),
+			handler.NewColumn(AppTokenLifetimesColumnIDTokenLifetime, handler.ColumnTypeInt64),
+			handler.NewColumn(AppTokenLifetimesColumnRefreshTokenIdleExpiration, handler.ColumnTypeInt64),
+			handler.NewColumn(AppTokenLifetimesColumnRefreshTokenExpiration, handler.ColumnTypeInt64),
+		},
+			handler.NewPrimaryKey(AppTokenLifetimesColumnInstanceID, AppTokenLifetimesColumnAppID),
+			handler.WithIndex(handler.NewIndex("client_id", []string{AppTokenLifetimesColumnInstanceID, AppTokenLifetimesColumnClientID})),
+		),
+	)
+}
+
+func (p *appTokenLifetimesProjection) Reducers() []handler.AggregateReducer {
+	return []handler.AggregateReducer{
+		{
+			Aggregate: project.AggregateType,
+			EventReducers: []handler.EventReducer{
+				{
+					Event:  project.ApplicationTokenLifetimesSetType,
+					Reduce: p.reduceSet,
+				},
+				{
+					Event:  project.ApplicationRemovedType,
+					Reduce: p.reduceAppRemoved,
+				},
+				{
+					Event:  project.ProjectRemovedType,
+					Reduce: p.reduceProjectRemoved,
+				},
+			},
+		},
+		{
+			Aggregate: org.AggregateType,
+			EventReducers: []handler.EventReducer{
+				{
+					Event:  org.OrgRemovedEventType,
+					Reduce: p.reduceOwnerRemoved,
+				},
+			},
+		},
+		{
+			Aggregate: instance.AggregateType,
+			EventReducers: []handler.EventReducer{
+				{
+					Event:  instance.InstanceRemovedEventType,
+					Reduce: reduceInstanceRemovedHelper(AppTokenLifetimesColumnInstanceID),
+				},
+			},
+		},
+	}
+}
+
+func (p *appTokenLifetimesProjection) reduceSet(event eventstore.Event) (*handler.Statement, error) {
+	e, err := assertEvent[*project.ApplicationTokenLifetimesSetEvent](event)
+	if err != nil {
+		return nil, err
+	}
+	if e.TokenLifetimes == nil {
+		return handler.NewDeleteStatement(
+			e,
+			[]handler.Condition{
+				handler.NewCond(AppTokenLifetimesColumnInstanceID, e.Aggregate().InstanceID),
+				handler.NewCond(AppTokenLifetimesColumnAppID, e.AppID),
+			},
+		), nil
+	}
+	return handler.NewUpsertStatement(
+		e,
+		[]handler.Column{
+			handler.NewCol(AppTokenLifetimesColumnInstanceID, nil),
+			handler.NewCol(AppTokenLifetimesColumnAppID, nil),
+		},
+		[]handler.Column{
+			handler.NewCol(AppTokenLifetimesColumnInstanceID, e.Aggregate().InstanceID),
+			handler.NewCol(AppTokenLifetimesColumnAppID, e.AppID),
+			handler.NewCol(AppTokenLifetimesColumnProjectID, e.Aggregate().ID),
+			handler.NewCol(AppTokenLifetimesColumnClientID, e.ClientID),
+			handler.NewCol(AppTokenLifetimesColumnResourceOwner, e.Aggregate().ResourceOwner),
+			handler.NewCol(AppTokenLifetimesColumnChangeDate, e.CreationDate()),
+			handler.NewCol(AppTokenLifetimesColumnSequence, e.Sequence()),
+			handler.NewCol(AppTokenLifetimesColumnAccessTokenLifetime, e.TokenLifetimes.AccessTokenLifetime),
+			handler.NewCol(AppTokenLifetimesColumnIDTokenLifetime, e.TokenLifetimes.IDTokenLifetime),
+			handler.NewCol(AppTokenLifetimesColumnRefreshTokenIdleExpiration, e.TokenLifetimes.RefreshTokenIdleExpiration),
+			handler.NewCol(AppTokenLifetimesColumnRefreshTokenExpiration, e.TokenLifetimes.RefreshTokenExpiration),
+		},
+	), nil
+}
+
+func (p *appTokenLifetimesProjection) reduceAppRemoved(event eventstore.Event) (*handler.Statement, error) {
+	e, err := assertEvent[*project.ApplicationRemovedEvent](event)
+	if err != nil {
+		return nil, err
+	}
+	return handler.NewDeleteStatement(
+		e,
+		[]handler.Condition{
+			handler.NewCond(AppTokenLifetimesColumnInstanceID, e.Aggregate().InstanceID),
+			handler.NewCond(AppTokenLifetimesColumnAppID, e.AppID),
+		},
+	), nil
+}
+
+func (p *appTokenLifetimesProjection) reduceProjectRemoved(event eventstore.Event) (*handler.Statement, error) {
+	e, err := assertEvent[*project.ProjectRemovedEvent](event)
+	if err != nil {
+		return nil, err
+	}
+	return handler.NewDeleteStatement(
+		e,
+		[]handler.Condition{
+			handler.NewCond(AppTokenLifetimesColumnInstanceID, e.Aggregate().InstanceID),
+			handler.NewCond(AppTokenLifetimesColumnProjectID, e.Aggregate().ID),
+		},
+	), nil
+}
+
+func (p *appTokenLifetimesProjection) reduceOwnerRemoved(event eventstore.Event) (*handler.Statement, error) {
+	e, err := assertEvent[*org.OrgRemovedEvent](event)
+	if err != nil {
+		return nil, err
+	}
+	return handler.NewDeleteStatement(
+		e,
+		[]handler.Condition{
+			handler.NewCond(AppTokenLifetimesColumnInstanceID, e.Aggregate().InstanceID),
+			handler.NewCond(AppTokenLifetimesColumnResourceOwner, e.Aggregate().ID),
+		},
+	), nil
+}
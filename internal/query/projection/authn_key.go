@@ -75,6 +75,10 @@ func (p *authNKeyProjection) Reducers() []handler.AggregateReducer {
 					Event:  project.ApplicationKeyAddedEventType,
 					Reduce: p.reduceAuthNKeyAdded,
 				},
+				{
+					Event:  project.ApplicationKeyExpirationChangedEventType,
+					Reduce: p.reduceAuthNKeyExpirationChanged,
+				},
 				{
 					Event:  project.ApplicationKeyRemovedEventType,
 					Reduce: p.reduceAuthNKeyRemoved,
@@ -108,6 +112,10 @@ func (p *authNKeyProjection) Reducers() []handler.AggregateReducer {
 					Event:  user.MachineKeyRemovedEventType,
 					Reduce: p.reduceAuthNKeyRemoved,
 				},
+				{
+					Event:  user.MachineKeyRevocationScheduledEventType,
+					Reduce: p.reduceAuthNKeyExpirationChanged,
+				},
 				{
 					Event:  user.UserRemovedType,
 					Reduce: p.reduceAuthNKeyRemoved,
@@ -223,6 +231,32 @@ func (p *authNKeyProjection) reduceAuthNKeyEnabledChanged(event eventstore.Event
 	), nil
 }
 
+func (p *authNKeyProjection) reduceAuthNKeyExpirationChanged(event eventstore.Event) (*handler.Statement, error) {
+	var keyID string
+	var expirationDate time.Time
+	switch e := event.(type) {
+	case *project.ApplicationKeyExpirationChangedEvent:
+		keyID, expirationDate = e.KeyID, e.ExpirationDate
+	case *user.MachineKeyRevocationScheduledEvent:
+		// a rotated key expires at its revocation
+		keyID, expirationDate = e.KeyID, e.RevocationDate
+	default:
+		return nil, zerrors.ThrowInvalidArgumentf(nil, "PROJE-Mkr5e", "reduce.wrong.event.type %v", []eventstore.EventType{project.ApplicationKeyExpirationChangedEventType, user.MachineKeyRevocationScheduledEventType})
+	}
+	return handler.NewUpdateStatement(
+		event,
+		[]handler.Column{
+			handler.NewCol(AuthNKeyChangeDateCol, event.CreatedAt()),
+			handler.NewCol(AuthNKeySequenceCol, event.Sequence()),
+			handler.NewCol(AuthNKeyExpirationCol, expirationDate),
+		},
+		[]handler.Condition{
+			handler.NewCond(AuthNKeyIDCol, keyID),
+			handler.NewCond(AuthNKeyInstanceIDCol, event.Aggregate().InstanceID),
+		},
+	), nil
+}
+
 func (p *authNKeyProjection) reduceAuthNKeyRemoved(event eventstore.Event) (*handler.Statement, error) {
 	var condition handler.Condition
 	switch e := event.(type) {
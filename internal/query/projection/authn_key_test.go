@@ -2,6 +2,7 @@ package projection
 
 import (
 	"testing"
+	"time"
 
 	"github.com/zitadel/zitadel/internal/domain"
 	"github.com/zitadel/zitadel/internal/eventstore"
@@ -97,6 +98,66 @@ func TestAuthNKeyProjection_reduces(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "reduceAuthNKeyExpirationChanged app key",
+			args: args{
+				event: getEvent(
+					testEvent(
+						project.ApplicationKeyExpirationChangedEventType,
+						project.AggregateType,
+						[]byte(`{"applicationId": "appId", "keyId": "keyId", "expirationDate": "2021-11-30T15:00:00Z"}`),
+					), project.ApplicationKeyExpirationChangedEventMapper),
+			},
+			reduce: (&authNKeyProjection{}).reduceAuthNKeyExpirationChanged,
+			want: wantReduce{
+				aggregateType: eventstore.AggregateType("project"),
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "UPDATE projections.authn_keys2 SET (change_date, sequence, expiration) = ($1, $2, $3) WHERE (id = $4) AND (instance_id = $5)",
+							expectedArgs: []interface{}{
+								anyArg{},
+								uint64(15),
+								anyArg{},
+								"keyId",
+								"instance-id",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "reduceAuthNKeyExpirationChanged machine key revocation scheduled",
+			args: args{
+				event: getEvent(
+					testEvent(
+						user.MachineKeyRevocationScheduledEventType,
+						user.AggregateType,
+						[]byte(`{"keyId": "keyId", "revocationDate": "2021-11-30T15:00:00Z"}`),
+					), user.MachineKeyRevocationScheduledEventMapper),
+			},
+			reduce: (&authNKeyProjection{}).reduceAuthNKeyExpirationChanged,
+			want: wantReduce{
+				aggregateType: eventstore.AggregateType("user"),
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "UPDATE projections.authn_keys2 SET (change_date, sequence, expiration) = ($1, $2, $3) WHERE (id = $4) AND (instance_id = $5)",
+							expectedArgs: []interface{}{
+								anyArg{},
+								uint64(15),
+								time.Date(2021, 11, 30, 15, 0, 0, 0, time.UTC),
+								"keyId",
+								"instance-id",
+							},
+						},
+					},
+				},
+			},
+		},
 		{
 			name: "reduceAuthNKeyRemoved app key",
 			args: args{
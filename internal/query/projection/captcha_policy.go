@@ -0,0 +1,218 @@
+package projection
+
+import (
+	"context"
+
+	"github.com/zitadel/zitadel/internal/eventstore"
+	old_handler "github.com/zitadel/zitadel/internal/eventstore/handler"
+	"github.com/zitadel/zitadel/internal/eventstore/handler/v2"
+	"github.com/zitadel/zitadel/internal/repository/instance"
+	"github.com/zitadel/zitadel/internal/repository/org"
+	"github.com/zitadel/zitadel/internal/repository/policy"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+const (
+	CaptchaPolicyTable = "projections.captcha_policies"
+
+	CaptchaPolicyIDCol                     = "id"
+	CaptchaPolicyCreationDateCol           = "creation_date"
+	CaptchaPolicyChangeDateCol             = "change_date"
+	CaptchaPolicySequenceCol               = "sequence"
+	CaptchaPolicyIsDefaultCol              = "is_default"
+	CaptchaPolicyResourceOwnerCol          = "resource_owner"
+	CaptchaPolicyInstanceIDCol             = "instance_id"
+	CaptchaPolicyTypeCol                   = "captcha_type"
+	CaptchaPolicySiteKeyCol                = "site_key"
+	CaptchaPolicySecretCol                 = "secret"
+	CaptchaPolicyEnabledOnRegisterCol      = "enabled_on_register"
+	CaptchaPolicyEnabledOnPasswordResetCol = "enabled_on_password_reset"
+	CaptchaPolicyEnabledOnPasswordlessCol  = "enabled_on_passwordless"
+	CaptchaPolicyEnabledOnSessionCol       = "enabled_on_session"
+)
+
+type captchaPolicyProjection struct{}
+
+func newCaptchaPolicyProjection(ctx context.Context, config handler.Config) *handler.Handler {
+	return handler.NewHandler(ctx, &config, new(captchaPolicyProjection))
+}
+
+func (*captchaPolicyProjection) Name() string {
+	return CaptchaPolicyTable
+}
+
+func (*captchaPolicyProjection) Init() *old_handler.Check {
+	return handler.NewTableCheck(
+		handler.NewTable([]*handler.InitColumn{
+			handler.NewColumn(CaptchaPolicyIDCol, handler.ColumnTypeText),
+			handler.NewColumn(CaptchaPolicyCreationDateCol, handler.ColumnTypeTimestamp),
+			handler.NewColumn(CaptchaPolicyChangeDateCol, handler.ColumnTypeTimestamp),
+			handler.NewColumn(CaptchaPolicySequenceCol, handler.ColumnTypeInt64),
+			handler.NewColumn(CaptchaPolicyIsDefaultCol, handler.ColumnTypeBool, handler.Default(false)),
+			handler.NewColumn(CaptchaPolicyResourceOwnerCol, handler.ColumnTypeText),
+			handler.NewColumn(CaptchaPolicyInstanceIDCol, handler.ColumnTypeText),
+			handler.NewColumn(CaptchaPolicyTypeCol, handler.ColumnTypeEnum),
+			handler.NewColumn(CaptchaPolicySiteKeyCol, handler.ColumnTypeText),
+			handler.NewColumn(CaptchaPolicySecretCol, handler.ColumnTypeJSONB, handler.Nullable()),
+			handler.NewColumn(CaptchaPolicyEnabledOnRegisterCol, handler.ColumnTypeBool, handler.Default(false)),
+			handler.NewColumn(CaptchaPolicyEnabledOnPasswordResetCol, handler.ColumnTypeBool, handler.Default(false)),
+			handler.NewColumn(CaptchaPolicyEnabledOnPasswordlessCol, handler.ColumnTypeBool, handler.Default(false)),
+			handler.NewColumn(CaptchaPolicyEnabledOnSessionCol, handler.ColumnTypeBool, handler.Default(false)),
+		},
+			handler.NewPrimaryKey(CaptchaPolicyInstanceIDCol, CaptchaPolicyIDCol),
+		),
+	)
+}
+
+func (p *captchaPolicyProjection) Reducers() []handler.AggregateReducer {
+	return []handler.AggregateReducer{
+		{
+			Aggregate: org.AggregateType,
+			EventReducers: []handler.EventReducer{
+				{
+					Event:  org.CaptchaPolicyAddedEventType,
+					Reduce: p.reduceAdded,
+				},
+				{
+					Event:  org.CaptchaPolicyChangedEventType,
+					Reduce: p.reduceChanged,
+				},
+				{
+					Event:  org.CaptchaPolicyRemovedEventType,
+					Reduce: p.reduceRemoved,
+				},
+				{
+					Event:  org.OrgRemovedEventType,
+					Reduce: p.reduceOwnerRemoved,
+				},
+			},
+		},
+		{
+			Aggregate: instance.AggregateType,
+			EventReducers: []handler.EventReducer{
+				{
+					Event:  instance.CaptchaPolicyAddedEventType,
+					Reduce: p.reduceAdded,
+				},
+				{
+					Event:  instance.CaptchaPolicyChangedEventType,
+					Reduce: p.reduceChanged,
+				},
+				{
+					Event:  instance.CaptchaPolicyRemovedEventType,
+					Reduce: p.reduceRemoved,
+				},
+				{
+					Event:  instance.InstanceRemovedEventType,
+					Reduce: reduceInstanceRemovedHelper(CaptchaPolicyInstanceIDCol),
+				},
+			},
+		},
+	}
+}
+
+func (p *captchaPolicyProjection) reduceAdded(event eventstore.Event) (*handler.Statement, error) {
+	var policyEvent policy.CaptchaPolicyAddedEvent
+	var isDefault bool
+	switch e := event.(type) {
+	case *org.CaptchaPolicyAddedEvent:
+		policyEvent = e.CaptchaPolicyAddedEvent
+		isDefault = false
+	case *instance.CaptchaPolicyAddedEvent:
+		policyEvent = e.CaptchaPolicyAddedEvent
+		isDefault = true
+	default:
+		return nil, zerrors.ThrowInvalidArgumentf(nil, "PROJE-Kc7tw", "reduce.wrong.event.type, %v", []eventstore.EventType{org.CaptchaPolicyAddedEventType, instance.CaptchaPolicyAddedEventType})
+	}
+	return handler.NewCreateStatement(
+		&policyEvent,
+		[]handler.Column{
+			handler.NewCol(CaptchaPolicyCreationDateCol, policyEvent.CreationDate()),
+			handler.NewCol(CaptchaPolicyChangeDateCol, policyEvent.CreationDate()),
+			handler.NewCol(CaptchaPolicySequenceCol, policyEvent.Sequence()),
+			handler.NewCol(CaptchaPolicyIDCol, policyEvent.Aggregate().ID),
+			handler.NewCol(CaptchaPolicyIsDefaultCol, isDefault),
+			handler.NewCol(CaptchaPolicyResourceOwnerCol, policyEvent.Aggregate().ResourceOwner),
+			handler.NewCol(CaptchaPolicyInstanceIDCol, policyEvent.Aggregate().InstanceID),
+			handler.NewCol(CaptchaPolicyTypeCol, policyEvent.CaptchaType),
+			handler.NewCol(CaptchaPolicySiteKeyCol, policyEvent.SiteKey),
+			handler.NewCol(CaptchaPolicySecretCol, policyEvent.Secret),
+			handler.NewCol(CaptchaPolicyEnabledOnRegisterCol, policyEvent.EnabledOnRegister),
+			handler.NewCol(CaptchaPolicyEnabledOnPasswordResetCol, policyEvent.EnabledOnPasswordReset),
+			handler.NewCol(CaptchaPolicyEnabledOnPasswordlessCol, policyEvent.EnabledOnPasswordless),
+			handler.NewCol(CaptchaPolicyEnabledOnSessionCol, policyEvent.EnabledOnSession),
+		}), nil
+}
+
+func (p *captchaPolicyProjection) reduceChanged(event eventstore.Event) (*handler.Statement, error) {
+	var policyEvent policy.CaptchaPolicyChangedEvent
+	switch e := event.(type) {
+	case *org.CaptchaPolicyChangedEvent:
+		policyEvent = e.CaptchaPolicyChangedEvent
+	case *instance.CaptchaPolicyChangedEvent:
+		policyEvent = e.CaptchaPolicyChangedEvent
+	default:
+		return nil, zerrors.ThrowInvalidArgumentf(nil, "PROJE-Rb3xe", "reduce.wrong.event.type, %v", []eventstore.EventType{org.CaptchaPolicyChangedEventType, instance.CaptchaPolicyChangedEventType})
+	}
+	cols := []handler.Column{
+		handler.NewCol(CaptchaPolicyChangeDateCol, policyEvent.CreationDate()),
+		handler.NewCol(CaptchaPolicySequenceCol, policyEvent.Sequence()),
+	}
+	if policyEvent.CaptchaType != nil {
+		cols = append(cols, handler.NewCol(CaptchaPolicyTypeCol, *policyEvent.CaptchaType))
+	}
+	if policyEvent.SiteKey != nil {
+		cols = append(cols, handler.NewCol(CaptchaPolicySiteKeyCol, *policyEvent.SiteKey))
+	}
+	if policyEvent.Secret != nil {
+		cols = append(cols, handler.NewCol(CaptchaPolicySecretCol, policyEvent.Secret))
+	}
+	if policyEvent.EnabledOnRegister != nil {
+		cols = append(cols, handler.NewCol(CaptchaPolicyEnabledOnRegisterCol, *policyEvent.EnabledOnRegister))
+	}
+	if policyEvent.EnabledOnPasswordReset != nil {
+		cols = append(cols, handler.NewCol(CaptchaPolicyEnabledOnPasswordResetCol, *policyEvent.EnabledOnPasswordReset))
+	}
+	if policyEvent.EnabledOnPasswordless != nil {
+		cols = append(cols, handler.NewCol(CaptchaPolicyEnabledOnPasswordlessCol, *policyEvent.EnabledOnPasswordless))
+	}
+	if policyEvent.EnabledOnSession != nil {
+		cols = append(cols, handler.NewCol(CaptchaPolicyEnabledOnSessionCol, *policyEvent.EnabledOnSession))
+	}
+	return handler.NewUpdateStatement(
+		&policyEvent,
+		cols,
+		[]handler.Condition{
+			handler.NewCond(CaptchaPolicyIDCol, policyEvent.Aggregate().ID),
+			handler.NewCond(CaptchaPolicyInstanceIDCol, policyEvent.Aggregate().InstanceID),
+		}), nil
+}
+
+func (p *captchaPolicyProjection) reduceRemoved(event eventstore.Event) (*handler.Statement, error) {
+	switch event.(type) {
+	case *org.CaptchaPolicyRemovedEvent,
+		*instance.CaptchaPolicyRemovedEvent:
+	default:
+		return nil, zerrors.ThrowInvalidArgumentf(nil, "PROJE-Yq5nd", "reduce.wrong.event.type, %v", []eventstore.EventType{org.CaptchaPolicyRemovedEventType, instance.CaptchaPolicyRemovedEventType})
+	}
+	return handler.NewDeleteStatement(
+		event,
+		[]handler.Condition{
+			handler.NewCond(CaptchaPolicyIDCol, event.Aggregate().ID),
+			handler.NewCond(CaptchaPolicyInstanceIDCol, event.Aggregate().InstanceID),
+		}), nil
+}
+
+func (p *captchaPolicyProjection) reduceOwnerRemoved(event eventstore.Event) (*handler.Statement, error) {
+	e, ok := event.(*org.OrgRemovedEvent)
+	if !ok {
+		return nil, zerrors.ThrowInvalidArgumentf(nil, "PROJE-Ft8ha", "reduce.wrong.event.type %s", org.OrgRemovedEventType)
+	}
+	return handler.NewDeleteStatement(
+		e,
+		[]handler.Condition{
+			handler.NewCond(CaptchaPolicyInstanceIDCol, e.Aggregate().InstanceID),
+			handler.NewCond(CaptchaPolicyResourceOwnerCol, e.Aggregate().ID),
+		},
+	), nil
+}
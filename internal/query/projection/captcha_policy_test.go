@@ -0,0 +1,244 @@
+package projection
+
+import (
+	"testing"
+
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/eventstore/handler/v2"
+	"github.com/zitadel/zitadel/internal/repository/instance"
+	"github.com/zitadel/zitadel/internal/repository/org"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+func TestCaptchaPolicyProjection_reduces(t *testing.T) {
+	type args struct {
+		event func(t *testing.T) eventstore.Event
+	}
+	tests := []struct {
+		name   string
+		args   args
+		reduce func(event eventstore.Event) (*handler.Statement, error)
+		want   wantReduce
+	}{
+		{
+			name: "org reduceAdded",
+			args: args{
+				event: getEvent(
+					testEvent(
+						org.CaptchaPolicyAddedEventType,
+						org.AggregateType,
+						[]byte(`{
+						"captchaType": 1,
+						"siteKey": "site-key",
+						"secret": {
+							"cryptoType": 0,
+							"algorithm": "aes",
+							"keyId": "key-id"
+						},
+						"enabledOnRegister": true,
+						"enabledOnSession": true
+}`),
+					), org.CaptchaPolicyAddedEventMapper),
+			},
+			reduce: (&captchaPolicyProjection{}).reduceAdded,
+			want: wantReduce{
+				aggregateType: eventstore.AggregateType("org"),
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "INSERT INTO projections.captcha_policies (creation_date, change_date, sequence, id, is_default, resource_owner, instance_id, captcha_type, site_key, secret, enabled_on_register, enabled_on_password_reset, enabled_on_passwordless, enabled_on_session) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14)",
+							expectedArgs: []interface{}{
+								anyArg{},
+								anyArg{},
+								uint64(15),
+								"agg-id",
+								false,
+								"ro-id",
+								"instance-id",
+								domain.CaptchaTypeHCaptcha,
+								"site-key",
+								anyArg{},
+								true,
+								false,
+								false,
+								true,
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name:   "org reduceChanged",
+			reduce: (&captchaPolicyProjection{}).reduceChanged,
+			args: args{
+				event: getEvent(
+					testEvent(
+						org.CaptchaPolicyChangedEventType,
+						org.AggregateType,
+						[]byte(`{
+						"siteKey": "new-site-key",
+						"enabledOnPasswordReset": true
+		}`),
+					), org.CaptchaPolicyChangedEventMapper),
+			},
+			want: wantReduce{
+				aggregateType: eventstore.AggregateType("org"),
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "UPDATE projections.captcha_policies SET (change_date, sequence, site_key, enabled_on_password_reset) = ($1, $2, $3, $4) WHERE (id = $5) AND (instance_id = $6)",
+							expectedArgs: []interface{}{
+								anyArg{},
+								uint64(15),
+								"new-site-key",
+								true,
+								"agg-id",
+								"instance-id",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name:   "org reduceRemoved",
+			reduce: (&captchaPolicyProjection{}).reduceRemoved,
+			args: args{
+				event: getEvent(
+					testEvent(
+						org.CaptchaPolicyRemovedEventType,
+						org.AggregateType,
+						nil,
+					), org.CaptchaPolicyRemovedEventMapper),
+			},
+			want: wantReduce{
+				aggregateType: eventstore.AggregateType("org"),
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "DELETE FROM projections.captcha_policies WHERE (id = $1) AND (instance_id = $2)",
+							expectedArgs: []interface{}{
+								"agg-id",
+								"instance-id",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name:   "instance reduceAdded",
+			reduce: (&captchaPolicyProjection{}).reduceAdded,
+			args: args{
+				event: getEvent(
+					testEvent(
+						instance.CaptchaPolicyAddedEventType,
+						instance.AggregateType,
+						[]byte(`{
+						"captchaType": 3,
+						"siteKey": "site-key",
+						"enabledOnPasswordless": true
+					}`),
+					), instance.CaptchaPolicyAddedEventMapper),
+			},
+			want: wantReduce{
+				aggregateType: eventstore.AggregateType("instance"),
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "INSERT INTO projections.captcha_policies (creation_date, change_date, sequence, id, is_default, resource_owner, instance_id, captcha_type, site_key, secret, enabled_on_register, enabled_on_password_reset, enabled_on_passwordless, enabled_on_session) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14)",
+							expectedArgs: []interface{}{
+								anyArg{},
+								anyArg{},
+								uint64(15),
+								"agg-id",
+								true,
+								"ro-id",
+								"instance-id",
+								domain.CaptchaTypeTurnstile,
+								"site-key",
+								anyArg{},
+								false,
+								false,
+								true,
+								false,
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "instance reduceInstanceRemoved",
+			args: args{
+				event: getEvent(
+					testEvent(
+						instance.InstanceRemovedEventType,
+						instance.AggregateType,
+						nil,
+					), instance.InstanceRemovedEventMapper),
+			},
+			reduce: reduceInstanceRemovedHelper(CaptchaPolicyInstanceIDCol),
+			want: wantReduce{
+				aggregateType: eventstore.AggregateType("instance"),
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "DELETE FROM projections.captcha_policies WHERE (instance_id = $1)",
+							expectedArgs: []interface{}{
+								"agg-id",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name:   "org.reduceOwnerRemoved",
+			reduce: (&captchaPolicyProjection{}).reduceOwnerRemoved,
+			args: args{
+				event: getEvent(
+					testEvent(
+						org.OrgRemovedEventType,
+						org.AggregateType,
+						nil,
+					), org.OrgRemovedEventMapper),
+			},
+			want: wantReduce{
+				aggregateType: eventstore.AggregateType("org"),
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "DELETE FROM projections.captcha_policies WHERE (instance_id = $1) AND (resource_owner = $2)",
+							expectedArgs: []interface{}{
+								"instance-id",
+								"agg-id",
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			event := baseEvent(t)
+			got, err := tt.reduce(event)
+			if ok := zerrors.IsErrorInvalidArgument(err); !ok {
+				t.Errorf("no wrong event mapping: %v, got: %v", err, got)
+			}
+
+			event = tt.args.event(t)
+			got, err = tt.reduce(event)
+			assertReduce(t, got, err, CaptchaPolicyTable, tt.want)
+		})
+	}
+}
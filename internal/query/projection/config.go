@@ -13,6 +13,7 @@ type Config struct {
 	Customizations        map[string]CustomConfig
 	HandleActiveInstances time.Duration
 	TransactionDuration   time.Duration
+	CatchUpPartitions     uint16
 }
 
 type CustomConfig struct {
@@ -23,4 +24,5 @@ type CustomConfig struct {
 	BulkLimit             *uint16
 	HandleActiveInstances *time.Duration
 	TransactionDuration   *time.Duration
+	CatchUpPartitions     *uint16
 }
@@ -32,6 +32,7 @@ func newFillOrgDomainVerifiedFields(config handler.Config) *handler.FieldHandler
 				org.OrgDomainAddedEventType,
 				org.OrgDomainVerifiedEventType,
 				org.OrgDomainRemovedEventType,
+				org.OrgDomainUnverifiedEventType,
 			},
 		},
 	)
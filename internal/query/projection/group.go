@@ -0,0 +1,484 @@
+package projection
+
+import (
+	"context"
+
+	"github.com/zitadel/zitadel/internal/database"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	old_handler "github.com/zitadel/zitadel/internal/eventstore/handler"
+	"github.com/zitadel/zitadel/internal/eventstore/handler/v2"
+	"github.com/zitadel/zitadel/internal/repository/group"
+	"github.com/zitadel/zitadel/internal/repository/instance"
+	"github.com/zitadel/zitadel/internal/repository/org"
+	"github.com/zitadel/zitadel/internal/repository/project"
+	"github.com/zitadel/zitadel/internal/repository/user"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+const (
+	GroupTable            = "projections.groups1"
+	GroupIDCol            = "id"
+	GroupCreationDateCol  = "creation_date"
+	GroupChangeDateCol    = "change_date"
+	GroupSequenceCol      = "sequence"
+	GroupResourceOwnerCol = "resource_owner"
+	GroupInstanceIDCol    = "instance_id"
+	GroupNameCol          = "name"
+	GroupDescriptionCol   = "description"
+	GroupParentIDCol      = "parent_id"
+
+	GroupMemberSuffix           = "members"
+	GroupMemberTable            = GroupTable + "_" + GroupMemberSuffix
+	GroupMemberGroupIDCol       = "group_id"
+	GroupMemberUserIDCol        = "user_id"
+	GroupMemberCreationDateCol  = "creation_date"
+	GroupMemberSequenceCol      = "sequence"
+	GroupMemberResourceOwnerCol = "resource_owner"
+	GroupMemberInstanceIDCol    = "instance_id"
+
+	GroupGrantSuffix            = "grants"
+	GroupGrantTable             = GroupTable + "_" + GroupGrantSuffix
+	GroupGrantGroupIDCol        = "group_id"
+	GroupGrantProjectIDCol      = "project_id"
+	GroupGrantProjectGrantIDCol = "project_grant_id"
+	GroupGrantRolesCol          = "roles"
+	GroupGrantCreationDateCol   = "creation_date"
+	GroupGrantChangeDateCol     = "change_date"
+	GroupGrantSequenceCol       = "sequence"
+	GroupGrantResourceOwnerCol  = "resource_owner"
+	GroupGrantInstanceIDCol     = "instance_id"
+)
+
+type groupProjection struct{}
+
+func newGroupProjection(ctx context.Context, config handler.Config) *handler.Handler {
+	return handler.NewHandler(ctx, &config, new(groupProjection))
+}
+
+func (*groupProjection) Name() string {
+	return GroupTable
+}
+
+func (*groupProjection) Init() *old_handler.Check {
+	return handler.NewMultiTableCheck(
+		handler.NewTable([]*handler.InitColumn{
+			handler.NewColumn(GroupIDCol, handler.ColumnTypeText),
+			handler.NewColumn(GroupCreationDateCol, handler.ColumnTypeTimestamp),
+			handler.NewColumn(GroupChangeDateCol, handler.ColumnTypeTimestamp),
+			handler.NewColumn(GroupSequenceCol, handler.ColumnTypeInt64),
+			handler.NewColumn(GroupResourceOwnerCol, handler.ColumnTypeText),
+			handler.NewColumn(GroupInstanceIDCol, handler.ColumnTypeText),
+			handler.NewColumn(GroupNameCol, handler.ColumnTypeText),
+			handler.NewColumn(GroupDescriptionCol, handler.ColumnTypeText, handler.Default("")),
+			handler.NewColumn(GroupParentIDCol, handler.ColumnTypeText, handler.Default("")),
+		},
+			handler.NewPrimaryKey(GroupInstanceIDCol, GroupIDCol),
+			handler.WithIndex(handler.NewIndex("resource_owner", []string{GroupResourceOwnerCol})),
+		),
+		handler.NewSuffixedTable([]*handler.InitColumn{
+			handler.NewColumn(GroupMemberInstanceIDCol, handler.ColumnTypeText),
+			handler.NewColumn(GroupMemberGroupIDCol, handler.ColumnTypeText),
+			handler.NewColumn(GroupMemberUserIDCol, handler.ColumnTypeText),
+			handler.NewColumn(GroupMemberCreationDateCol, handler.ColumnTypeTimestamp),
+			handler.NewColumn(GroupMemberSequenceCol, handler.ColumnTypeInt64),
+			handler.NewColumn(GroupMemberResourceOwnerCol, handler.ColumnTypeText),
+		},
+			handler.NewPrimaryKey(GroupMemberInstanceIDCol, GroupMemberGroupIDCol, GroupMemberUserIDCol),
+			GroupMemberSuffix,
+			handler.WithForeignKey(handler.NewForeignKey("group", []string{GroupMemberInstanceIDCol, GroupMemberGroupIDCol}, []string{GroupInstanceIDCol, GroupIDCol})),
+			handler.WithIndex(handler.NewIndex("user_id", []string{GroupMemberUserIDCol})),
+		),
+		handler.NewSuffixedTable([]*handler.InitColumn{
+			handler.NewColumn(GroupGrantInstanceIDCol, handler.ColumnTypeText),
+			handler.NewColumn(GroupGrantGroupIDCol, handler.ColumnTypeText),
+			handler.NewColumn(GroupGrantProjectIDCol, handler.ColumnTypeText),
+			handler.NewColumn(GroupGrantProjectGrantIDCol, handler.ColumnTypeText, handler.Default("")),
+			handler.NewColumn(GroupGrantRolesCol, handler.ColumnTypeTextArray, handler.Nullable()),
+			handler.NewColumn(GroupGrantCreationDateCol, handler.ColumnTypeTimestamp),
+			handler.NewColumn(GroupGrantChangeDateCol, handler.ColumnTypeTimestamp),
+			handler.NewColumn(GroupGrantSequenceCol, handler.ColumnTypeInt64),
+			handler.NewColumn(GroupGrantResourceOwnerCol, handler.ColumnTypeText),
+		},
+			handler.NewPrimaryKey(GroupGrantInstanceIDCol, GroupGrantGroupIDCol, GroupGrantProjectIDCol),
+			GroupGrantSuffix,
+			handler.WithForeignKey(handler.NewForeignKey("group", []string{GroupGrantInstanceIDCol, GroupGrantGroupIDCol}, []string{GroupInstanceIDCol, GroupIDCol})),
+			handler.WithIndex(handler.NewIndex("project_id", []string{GroupGrantProjectIDCol})),
+		),
+	)
+}
+
+func (p *groupProjection) Reducers() []handler.AggregateReducer {
+	return []handler.AggregateReducer{
+		{
+			Aggregate: group.AggregateType,
+			EventReducers: []handler.EventReducer{
+				{
+					Event:  group.AddedEventType,
+					Reduce: p.reduceGroupAdded,
+				},
+				{
+					Event:  group.ChangedEventType,
+					Reduce: p.reduceGroupChanged,
+				},
+				{
+					Event:  group.ParentSetEventType,
+					Reduce: p.reduceGroupParentSet,
+				},
+				{
+					Event:  group.RemovedEventType,
+					Reduce: p.reduceGroupRemoved,
+				},
+				{
+					Event:  group.MemberAddedEventType,
+					Reduce: p.reduceMemberAdded,
+				},
+				{
+					Event:  group.MemberRemovedEventType,
+					Reduce: p.reduceMemberRemoved,
+				},
+				{
+					Event:  group.GrantAddedEventType,
+					Reduce: p.reduceGrantAdded,
+				},
+				{
+					Event:  group.GrantChangedEventType,
+					Reduce: p.reduceGrantChanged,
+				},
+				{
+					Event:  group.GrantRemovedEventType,
+					Reduce: p.reduceGrantRemoved,
+				},
+			},
+		},
+		{
+			Aggregate: user.AggregateType,
+			EventReducers: []handler.EventReducer{
+				{
+					Event:  user.UserRemovedType,
+					Reduce: p.reduceUserRemoved,
+				},
+			},
+		},
+		{
+			Aggregate: project.AggregateType,
+			EventReducers: []handler.EventReducer{
+				{
+					Event:  project.ProjectRemovedType,
+					Reduce: p.reduceProjectRemoved,
+				},
+				{
+					Event:  project.RoleRemovedType,
+					Reduce: p.reduceProjectRoleRemoved,
+				},
+				{
+					Event:  project.GrantChangedType,
+					Reduce: p.reduceProjectGrantChanged,
+				},
+				{
+					Event:  project.GrantCascadeChangedType,
+					Reduce: p.reduceProjectGrantChanged,
+				},
+				{
+					Event:  project.GrantRemovedType,
+					Reduce: p.reduceProjectGrantRemoved,
+				},
+			},
+		},
+		{
+			Aggregate: org.AggregateType,
+			EventReducers: []handler.EventReducer{
+				{
+					Event:  org.OrgRemovedEventType,
+					Reduce: p.reduceOwnerRemoved,
+				},
+			},
+		},
+		{
+			Aggregate: instance.AggregateType,
+			EventReducers: []handler.EventReducer{
+				{
+					Event:  instance.InstanceRemovedEventType,
+					Reduce: reduceInstanceRemovedHelper(GroupInstanceIDCol),
+				},
+			},
+		},
+	}
+}
+
+func (p *groupProjection) reduceGroupAdded(event eventstore.Event) (*handler.Statement, error) {
+	e, err := assertEvent[*group.AddedEvent](event)
+	if err != nil {
+		return nil, err
+	}
+	return handler.NewCreateStatement(
+		e,
+		[]handler.Column{
+			handler.NewCol(GroupIDCol, e.Aggregate().ID),
+			handler.NewCol(GroupCreationDateCol, e.CreationDate()),
+			handler.NewCol(GroupChangeDateCol, e.CreationDate()),
+			handler.NewCol(GroupSequenceCol, e.Sequence()),
+			handler.NewCol(GroupResourceOwnerCol, e.Aggregate().ResourceOwner),
+			handler.NewCol(GroupInstanceIDCol, e.Aggregate().InstanceID),
+			handler.NewCol(GroupNameCol, e.Name),
+			handler.NewCol(GroupDescriptionCol, e.Description),
+			handler.NewCol(GroupParentIDCol, e.ParentID),
+		},
+	), nil
+}
+
+func (p *groupProjection) reduceGroupChanged(event eventstore.Event) (*handler.Statement, error) {
+	e, err := assertEvent[*group.ChangedEvent](event)
+	if err != nil {
+		return nil, err
+	}
+	values := []handler.Column{
+		handler.NewCol(GroupChangeDateCol, e.CreationDate()),
+		handler.NewCol(GroupSequenceCol, e.Sequence()),
+	}
+	if e.Name != nil {
+		values = append(values, handler.NewCol(GroupNameCol, *e.Name))
+	}
+	if e.Description != nil {
+		values = append(values, handler.NewCol(GroupDescriptionCol, *e.Description))
+	}
+	return handler.NewUpdateStatement(
+		e,
+		values,
+		[]handler.Condition{
+			handler.NewCond(GroupInstanceIDCol, e.Aggregate().InstanceID),
+			handler.NewCond(GroupIDCol, e.Aggregate().ID),
+		},
+	), nil
+}
+
+func (p *groupProjection) reduceGroupParentSet(event eventstore.Event) (*handler.Statement, error) {
+	e, err := assertEvent[*group.ParentSetEvent](event)
+	if err != nil {
+		return nil, err
+	}
+	return handler.NewUpdateStatement(
+		e,
+		[]handler.Column{
+			handler.NewCol(GroupChangeDateCol, e.CreationDate()),
+			handler.NewCol(GroupSequenceCol, e.Sequence()),
+			handler.NewCol(GroupParentIDCol, e.ParentID),
+		},
+		[]handler.Condition{
+			handler.NewCond(GroupInstanceIDCol, e.Aggregate().InstanceID),
+			handler.NewCond(GroupIDCol, e.Aggregate().ID),
+		},
+	), nil
+}
+
+func (p *groupProjection) reduceGroupRemoved(event eventstore.Event) (*handler.Statement, error) {
+	e, err := assertEvent[*group.RemovedEvent](event)
+	if err != nil {
+		return nil, err
+	}
+	// members and grants are removed by the foreign key constraints
+	return handler.NewDeleteStatement(
+		e,
+		[]handler.Condition{
+			handler.NewCond(GroupInstanceIDCol, e.Aggregate().InstanceID),
+			handler.NewCond(GroupIDCol, e.Aggregate().ID),
+		},
+	), nil
+}
+
+func (p *groupProjection) reduceMemberAdded(event eventstore.Event) (*handler.Statement, error) {
+	e, err := assertEvent[*group.MemberAddedEvent](event)
+	if err != nil {
+		return nil, err
+	}
+	return handler.NewCreateStatement(
+		e,
+		[]handler.Column{
+			handler.NewCol(GroupMemberInstanceIDCol, e.Aggregate().InstanceID),
+			handler.NewCol(GroupMemberGroupIDCol, e.Aggregate().ID),
+			handler.NewCol(GroupMemberUserIDCol, e.UserID),
+			handler.NewCol(GroupMemberCreationDateCol, e.CreationDate()),
+			handler.NewCol(GroupMemberSequenceCol, e.Sequence()),
+			handler.NewCol(GroupMemberResourceOwnerCol, e.Aggregate().ResourceOwner),
+		},
+		handler.WithTableSuffix(GroupMemberSuffix),
+	), nil
+}
+
+func (p *groupProjection) reduceMemberRemoved(event eventstore.Event) (*handler.Statement, error) {
+	e, err := assertEvent[*group.MemberRemovedEvent](event)
+	if err != nil {
+		return nil, err
+	}
+	return handler.NewDeleteStatement(
+		e,
+		[]handler.Condition{
+			handler.NewCond(GroupMemberInstanceIDCol, e.Aggregate().InstanceID),
+			handler.NewCond(GroupMemberGroupIDCol, e.Aggregate().ID),
+			handler.NewCond(GroupMemberUserIDCol, e.UserID),
+		},
+		handler.WithTableSuffix(GroupMemberSuffix),
+	), nil
+}
+
+func (p *groupProjection) reduceGrantAdded(event eventstore.Event) (*handler.Statement, error) {
+	e, err := assertEvent[*group.GrantAddedEvent](event)
+	if err != nil {
+		return nil, err
+	}
+	return handler.NewCreateStatement(
+		e,
+		[]handler.Column{
+			handler.NewCol(GroupGrantInstanceIDCol, e.Aggregate().InstanceID),
+			handler.NewCol(GroupGrantGroupIDCol, e.Aggregate().ID),
+			handler.NewCol(GroupGrantProjectIDCol, e.ProjectID),
+			handler.NewCol(GroupGrantProjectGrantIDCol, e.ProjectGrantID),
+			handler.NewCol(GroupGrantRolesCol, database.TextArray[string](e.RoleKeys)),
+			handler.NewCol(GroupGrantCreationDateCol, e.CreationDate()),
+			handler.NewCol(GroupGrantChangeDateCol, e.CreationDate()),
+			handler.NewCol(GroupGrantSequenceCol, e.Sequence()),
+			handler.NewCol(GroupGrantResourceOwnerCol, e.Aggregate().ResourceOwner),
+		},
+		handler.WithTableSuffix(GroupGrantSuffix),
+	), nil
+}
+
+func (p *groupProjection) reduceGrantChanged(event eventstore.Event) (*handler.Statement, error) {
+	e, err := assertEvent[*group.GrantChangedEvent](event)
+	if err != nil {
+		return nil, err
+	}
+	return handler.NewUpdateStatement(
+		e,
+		[]handler.Column{
+			handler.NewCol(GroupGrantRolesCol, database.TextArray[string](e.RoleKeys)),
+			handler.NewCol(GroupGrantChangeDateCol, e.CreationDate()),
+			handler.NewCol(GroupGrantSequenceCol, e.Sequence()),
+		},
+		[]handler.Condition{
+			handler.NewCond(GroupGrantInstanceIDCol, e.Aggregate().InstanceID),
+			handler.NewCond(GroupGrantGroupIDCol, e.Aggregate().ID),
+			handler.NewCond(GroupGrantProjectIDCol, e.ProjectID),
+		},
+		handler.WithTableSuffix(GroupGrantSuffix),
+	), nil
+}
+
+func (p *groupProjection) reduceGrantRemoved(event eventstore.Event) (*handler.Statement, error) {
+	e, err := assertEvent[*group.GrantRemovedEvent](event)
+	if err != nil {
+		return nil, err
+	}
+	return handler.NewDeleteStatement(
+		e,
+		[]handler.Condition{
+			handler.NewCond(GroupGrantInstanceIDCol, e.Aggregate().InstanceID),
+			handler.NewCond(GroupGrantGroupIDCol, e.Aggregate().ID),
+			handler.NewCond(GroupGrantProjectIDCol, e.ProjectID),
+		},
+		handler.WithTableSuffix(GroupGrantSuffix),
+	), nil
+}
+
+func (p *groupProjection) reduceUserRemoved(event eventstore.Event) (*handler.Statement, error) {
+	e, err := assertEvent[*user.UserRemovedEvent](event)
+	if err != nil {
+		return nil, err
+	}
+	return handler.NewDeleteStatement(
+		e,
+		[]handler.Condition{
+			handler.NewCond(GroupMemberInstanceIDCol, e.Aggregate().InstanceID),
+			handler.NewCond(GroupMemberUserIDCol, e.Aggregate().ID),
+		},
+		handler.WithTableSuffix(GroupMemberSuffix),
+	), nil
+}
+
+func (p *groupProjection) reduceProjectRemoved(event eventstore.Event) (*handler.Statement, error) {
+	e, err := assertEvent[*project.ProjectRemovedEvent](event)
+	if err != nil {
+		return nil, err
+	}
+	return handler.NewDeleteStatement(
+		e,
+		[]handler.Condition{
+			handler.NewCond(GroupGrantInstanceIDCol, e.Aggregate().InstanceID),
+			handler.NewCond(GroupGrantProjectIDCol, e.Aggregate().ID),
+		},
+		handler.WithTableSuffix(GroupGrantSuffix),
+	), nil
+}
+
+func (p *groupProjection) reduceProjectRoleRemoved(event eventstore.Event) (*handler.Statement, error) {
+	e, err := assertEvent[*project.RoleRemovedEvent](event)
+	if err != nil {
+		return nil, err
+	}
+	return handler.NewUpdateStatement(
+		e,
+		[]handler.Column{
+			handler.NewArrayRemoveCol(GroupGrantRolesCol, e.Key),
+		},
+		[]handler.Condition{
+			handler.NewCond(GroupGrantInstanceIDCol, e.Aggregate().InstanceID),
+			handler.NewCond(GroupGrantProjectIDCol, e.Aggregate().ID),
+		},
+		handler.WithTableSuffix(GroupGrantSuffix),
+	), nil
+}
+
+func (p *groupProjection) reduceProjectGrantChanged(event eventstore.Event) (*handler.Statement, error) {
+	var grantID string
+	var keys database.TextArray[string]
+	switch e := event.(type) {
+	case *project.GrantChangedEvent:
+		grantID = e.GrantID
+		keys = e.RoleKeys
+	case *project.GrantCascadeChangedEvent:
+		grantID = e.GrantID
+		keys = e.RoleKeys
+	default:
+		return nil, zerrors.ThrowInvalidArgumentf(nil, "HANDL-Grp4c", "reduce.wrong.event.type %v", []eventstore.EventType{project.GrantChangedType, project.GrantCascadeChangedType})
+	}
+	return handler.NewUpdateStatement(
+		event,
+		[]handler.Column{
+			handler.NewArrayIntersectCol(GroupGrantRolesCol, keys),
+		},
+		[]handler.Condition{
+			handler.NewCond(GroupGrantInstanceIDCol, event.Aggregate().InstanceID),
+			handler.NewCond(GroupGrantProjectGrantIDCol, grantID),
+		},
+		handler.WithTableSuffix(GroupGrantSuffix),
+	), nil
+}
+
+func (p *groupProjection) reduceProjectGrantRemoved(event eventstore.Event) (*handler.Statement, error) {
+	e, err := assertEvent[*project.GrantRemovedEvent](event)
+	if err != nil {
+		return nil, err
+	}
+	return handler.NewDeleteStatement(
+		e,
+		[]handler.Condition{
+			handler.NewCond(GroupGrantInstanceIDCol, e.Aggregate().InstanceID),
+			handler.NewCond(GroupGrantProjectGrantIDCol, e.GrantID),
+		},
+		handler.WithTableSuffix(GroupGrantSuffix),
+	), nil
+}
+
+func (p *groupProjection) reduceOwnerRemoved(event eventstore.Event) (*handler.Statement, error) {
+	e, err := assertEvent[*org.OrgRemovedEvent](event)
+	if err != nil {
+		return nil, err
+	}
+	// members and grants are removed by the foreign key constraints
+	return handler.NewDeleteStatement(
+		e,
+		[]handler.Condition{
+			handler.NewCond(GroupInstanceIDCol, e.Aggregate().InstanceID),
+			handler.NewCond(GroupResourceOwnerCol, e.Aggregate().ID),
+		},
+	), nil
+}
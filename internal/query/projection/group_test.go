@@ -0,0 +1,536 @@
+package projection
+
+import (
+	"testing"
+
+	"github.com/zitadel/zitadel/internal/database"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/eventstore/handler/v2"
+	"github.com/zitadel/zitadel/internal/repository/group"
+	"github.com/zitadel/zitadel/internal/repository/instance"
+	"github.com/zitadel/zitadel/internal/repository/org"
+	"github.com/zitadel/zitadel/internal/repository/project"
+	"github.com/zitadel/zitadel/internal/repository/user"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+func TestGroupProjection_reduces(t *testing.T) {
+	type args struct {
+		event func(t *testing.T) eventstore.Event
+	}
+	tests := []struct {
+		name   string
+		args   args
+		reduce func(event eventstore.Event) (*handler.Statement, error)
+		want   wantReduce
+	}{
+		{
+			name: "reduceGroupAdded",
+			args: args{
+				event: getEvent(
+					testEvent(
+						group.AddedEventType,
+						group.AggregateType,
+						[]byte(`{"name": "name", "description": "description", "parentId": "parent-id"}`),
+					),
+					eventstore.GenericEventMapper[group.AddedEvent],
+				),
+			},
+			reduce: (&groupProjection{}).reduceGroupAdded,
+			want: wantReduce{
+				aggregateType: group.AggregateType,
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "INSERT INTO projections.groups1 (id, creation_date, change_date, sequence, resource_owner, instance_id, name, description, parent_id) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)",
+							expectedArgs: []interface{}{
+								"agg-id",
+								anyArg{},
+								anyArg{},
+								uint64(15),
+								"ro-id",
+								"instance-id",
+								"name",
+								"description",
+								"parent-id",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "reduceGroupChanged",
+			args: args{
+				event: getEvent(
+					testEvent(
+						group.ChangedEventType,
+						group.AggregateType,
+						[]byte(`{"name": "name2"}`),
+					),
+					eventstore.GenericEventMapper[group.ChangedEvent],
+				),
+			},
+			reduce: (&groupProjection{}).reduceGroupChanged,
+			want: wantReduce{
+				aggregateType: group.AggregateType,
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "UPDATE projections.groups1 SET (change_date, sequence, name) = ($1, $2, $3) WHERE (instance_id = $4) AND (id = $5)",
+							expectedArgs: []interface{}{
+								anyArg{},
+								uint64(15),
+								"name2",
+								"instance-id",
+								"agg-id",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "reduceGroupParentSet",
+			args: args{
+				event: getEvent(
+					testEvent(
+						group.ParentSetEventType,
+						group.AggregateType,
+						[]byte(`{"parentId": "parent-id"}`),
+					),
+					eventstore.GenericEventMapper[group.ParentSetEvent],
+				),
+			},
+			reduce: (&groupProjection{}).reduceGroupParentSet,
+			want: wantReduce{
+				aggregateType: group.AggregateType,
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "UPDATE projections.groups1 SET (change_date, sequence, parent_id) = ($1, $2, $3) WHERE (instance_id = $4) AND (id = $5)",
+							expectedArgs: []interface{}{
+								anyArg{},
+								uint64(15),
+								"parent-id",
+								"instance-id",
+								"agg-id",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "reduceGroupRemoved",
+			args: args{
+				event: getEvent(
+					testEvent(
+						group.RemovedEventType,
+						group.AggregateType,
+						[]byte(`{}`),
+					),
+					eventstore.GenericEventMapper[group.RemovedEvent],
+				),
+			},
+			reduce: (&groupProjection{}).reduceGroupRemoved,
+			want: wantReduce{
+				aggregateType: group.AggregateType,
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "DELETE FROM projections.groups1 WHERE (instance_id = $1) AND (id = $2)",
+							expectedArgs: []interface{}{
+								"instance-id",
+								"agg-id",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "reduceMemberAdded",
+			args: args{
+				event: getEvent(
+					testEvent(
+						group.MemberAddedEventType,
+						group.AggregateType,
+						[]byte(`{"userId": "user-id"}`),
+					),
+					eventstore.GenericEventMapper[group.MemberAddedEvent],
+				),
+			},
+			reduce: (&groupProjection{}).reduceMemberAdded,
+			want: wantReduce{
+				aggregateType: group.AggregateType,
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "INSERT INTO projections.groups1_members (instance_id, group_id, user_id, creation_date, sequence, resource_owner) VALUES ($1, $2, $3, $4, $5, $6)",
+							expectedArgs: []interface{}{
+								"instance-id",
+								"agg-id",
+								"user-id",
+								anyArg{},
+								uint64(15),
+								"ro-id",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "reduceMemberRemoved",
+			args: args{
+				event: getEvent(
+					testEvent(
+						group.MemberRemovedEventType,
+						group.AggregateType,
+						[]byte(`{"userId": "user-id"}`),
+					),
+					eventstore.GenericEventMapper[group.MemberRemovedEvent],
+				),
+			},
+			reduce: (&groupProjection{}).reduceMemberRemoved,
+			want: wantReduce{
+				aggregateType: group.AggregateType,
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "DELETE FROM projections.groups1_members WHERE (instance_id = $1) AND (group_id = $2) AND (user_id = $3)",
+							expectedArgs: []interface{}{
+								"instance-id",
+								"agg-id",
+								"user-id",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "reduceGrantAdded",
+			args: args{
+				event: getEvent(
+					testEvent(
+						group.GrantAddedEventType,
+						group.AggregateType,
+						[]byte(`{"projectId": "project-id", "grantId": "grant-id", "roleKeys": ["role"]}`),
+					),
+					eventstore.GenericEventMapper[group.GrantAddedEvent],
+				),
+			},
+			reduce: (&groupProjection{}).reduceGrantAdded,
+			want: wantReduce{
+				aggregateType: group.AggregateType,
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "INSERT INTO projections.groups1_grants (instance_id, group_id, project_id, project_grant_id, roles, creation_date, change_date, sequence, resource_owner) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)",
+							expectedArgs: []interface{}{
+								"instance-id",
+								"agg-id",
+								"project-id",
+								"grant-id",
+								database.TextArray[string]{"role"},
+								anyArg{},
+								anyArg{},
+								uint64(15),
+								"ro-id",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "reduceGrantChanged",
+			args: args{
+				event: getEvent(
+					testEvent(
+						group.GrantChangedEventType,
+						group.AggregateType,
+						[]byte(`{"projectId": "project-id", "roleKeys": ["role", "role2"]}`),
+					),
+					eventstore.GenericEventMapper[group.GrantChangedEvent],
+				),
+			},
+			reduce: (&groupProjection{}).reduceGrantChanged,
+			want: wantReduce{
+				aggregateType: group.AggregateType,
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "UPDATE projections.groups1_grants SET (roles, change_date, sequence) = ($1, $2, $3) WHERE (instance_id = $4) AND (group_id = $5) AND (project_id = $6)",
+							expectedArgs: []interface{}{
+								database.TextArray[string]{"role", "role2"},
+								anyArg{},
+								uint64(15),
+								"instance-id",
+								"agg-id",
+								"project-id",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "reduceGrantRemoved",
+			args: args{
+				event: getEvent(
+					testEvent(
+						group.GrantRemovedEventType,
+						group.AggregateType,
+						[]byte(`{"projectId": "project-id"}`),
+					),
+					eventstore.GenericEventMapper[group.GrantRemovedEvent],
+				),
+			},
+			reduce: (&groupProjection{}).reduceGrantRemoved,
+			want: wantReduce{
+				aggregateType: group.AggregateType,
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "DELETE FROM projections.groups1_grants WHERE (instance_id = $1) AND (group_id = $2) AND (project_id = $3)",
+							expectedArgs: []interface{}{
+								"instance-id",
+								"agg-id",
+								"project-id",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "user reduceUserRemoved",
+			args: args{
+				event: getEvent(
+					testEvent(
+						user.UserRemovedType,
+						user.AggregateType,
+						nil,
+					),
+					user.UserRemovedEventMapper,
+				),
+			},
+			reduce: (&groupProjection{}).reduceUserRemoved,
+			want: wantReduce{
+				aggregateType: user.AggregateType,
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "DELETE FROM projections.groups1_members WHERE (instance_id = $1) AND (user_id = $2)",
+							expectedArgs: []interface{}{
+								"instance-id",
+								"agg-id",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "project reduceProjectRemoved",
+			args: args{
+				event: getEvent(
+					testEvent(
+						project.ProjectRemovedType,
+						project.AggregateType,
+						[]byte(`{}`),
+					),
+					project.ProjectRemovedEventMapper,
+				),
+			},
+			reduce: (&groupProjection{}).reduceProjectRemoved,
+			want: wantReduce{
+				aggregateType: project.AggregateType,
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "DELETE FROM projections.groups1_grants WHERE (instance_id = $1) AND (project_id = $2)",
+							expectedArgs: []interface{}{
+								"instance-id",
+								"agg-id",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "project reduceProjectRoleRemoved",
+			args: args{
+				event: getEvent(
+					testEvent(
+						project.RoleRemovedType,
+						project.AggregateType,
+						[]byte(`{"key": "role"}`),
+					),
+					project.RoleRemovedEventMapper,
+				),
+			},
+			reduce: (&groupProjection{}).reduceProjectRoleRemoved,
+			want: wantReduce{
+				aggregateType: project.AggregateType,
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "UPDATE projections.groups1_grants SET roles = array_remove(roles, $1) WHERE (instance_id = $2) AND (project_id = $3)",
+							expectedArgs: []interface{}{
+								"role",
+								"instance-id",
+								"agg-id",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "project reduceProjectGrantChanged",
+			args: args{
+				event: getEvent(
+					testEvent(
+						project.GrantChangedType,
+						project.AggregateType,
+						[]byte(`{"grantId": "grant-id", "roleKeys": ["role"]}`),
+					),
+					project.GrantChangedEventMapper,
+				),
+			},
+			reduce: (&groupProjection{}).reduceProjectGrantChanged,
+			want: wantReduce{
+				aggregateType: project.AggregateType,
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "UPDATE projections.groups1_grants SET (roles) = (SELECT ARRAY( SELECT UNNEST(roles) INTERSECT SELECT UNNEST ($1::TEXT[]))) WHERE (instance_id = $2) AND (project_grant_id = $3)",
+							expectedArgs: []interface{}{
+								database.TextArray[string]{"role"},
+								"instance-id",
+								"grant-id",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "project reduceProjectGrantRemoved",
+			args: args{
+				event: getEvent(
+					testEvent(
+						project.GrantRemovedType,
+						project.AggregateType,
+						[]byte(`{"grantId": "grant-id"}`),
+					),
+					project.GrantRemovedEventMapper,
+				),
+			},
+			reduce: (&groupProjection{}).reduceProjectGrantRemoved,
+			want: wantReduce{
+				aggregateType: project.AggregateType,
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "DELETE FROM projections.groups1_grants WHERE (instance_id = $1) AND (project_grant_id = $2)",
+							expectedArgs: []interface{}{
+								"instance-id",
+								"grant-id",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "org reduceOwnerRemoved",
+			args: args{
+				event: getEvent(
+					testEvent(
+						org.OrgRemovedEventType,
+						org.AggregateType,
+						nil,
+					),
+					org.OrgRemovedEventMapper,
+				),
+			},
+			reduce: (&groupProjection{}).reduceOwnerRemoved,
+			want: wantReduce{
+				aggregateType: org.AggregateType,
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "DELETE FROM projections.groups1 WHERE (instance_id = $1) AND (resource_owner = $2)",
+							expectedArgs: []interface{}{
+								"instance-id",
+								"agg-id",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "instance reduceInstanceRemoved",
+			args: args{
+				event: getEvent(
+					testEvent(
+						instance.InstanceRemovedEventType,
+						instance.AggregateType,
+						nil,
+					),
+					instance.InstanceRemovedEventMapper,
+				),
+			},
+			reduce: reduceInstanceRemovedHelper(GroupInstanceIDCol),
+			want: wantReduce{
+				aggregateType: instance.AggregateType,
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "DELETE FROM projections.groups1 WHERE (instance_id = $1)",
+							expectedArgs: []interface{}{
+								"agg-id",
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			event := baseEvent(t)
+			got, err := tt.reduce(event)
+			if ok := zerrors.IsErrorInvalidArgument(err); !ok {
+				t.Errorf("no wrong event mapping: %v, got: %v", err, got)
+			}
+
+			event = tt.args.event(t)
+			got, err = tt.reduce(event)
+			assertReduce(t, got, err, GroupTable, tt.want)
+		})
+	}
+}
@@ -70,6 +70,7 @@ const (
 	OAuthUserEndpointCol          = "user_endpoint"
 	OAuthScopesCol                = "scopes"
 	OAuthIDAttributeCol           = "id_attribute"
+	OAuthAttributeMappingCol      = "attribute_mapping"
 
 	OIDCIDCol             = "idp_id"
 	OIDCInstanceIDCol     = "instance_id"
@@ -93,6 +94,8 @@ const (
 	AzureADScopesCol       = "scopes"
 	AzureADTenantCol       = "tenant"
 	AzureADIsEmailVerified = "is_email_verified"
+	AzureADAllowedTenants  = "allowed_tenants"
+	AzureADFetchGroups     = "fetch_groups"
 
 	GitHubIDCol           = "idp_id"
 	GitHubInstanceIDCol   = "instance_id"
@@ -152,6 +155,11 @@ const (
 	LDAPPreferredLanguageAttributeCol = "preferred_language_attribute"
 	LDAPAvatarURLAttributeCol         = "avatar_url_attribute"
 	LDAPProfileAttributeCol           = "profile_attribute"
+	LDAPMetadataAttributesCol         = "metadata_attributes"
+	LDAPRootCACol                     = "root_ca"
+	LDAPGroupResolutionCol            = "group_resolution"
+	LDAPGroupBaseCol                  = "group_base"
+	LDAPGroupNameAttributeCol         = "group_name_attribute"
 
 	AppleIDCol         = "idp_id"
 	AppleInstanceIDCol = "instance_id"
@@ -216,6 +224,7 @@ func (*idpTemplateProjection) Init() *old_handler.Check {
 			handler.NewColumn(OAuthUserEndpointCol, handler.ColumnTypeText),
 			handler.NewColumn(OAuthScopesCol, handler.ColumnTypeTextArray, handler.Nullable()),
 			handler.NewColumn(OAuthIDAttributeCol, handler.ColumnTypeText),
+			handler.NewColumn(OAuthAttributeMappingCol, handler.ColumnTypeJSONB, handler.Nullable()),
 		},
 			handler.NewPrimaryKey(OAuthInstanceIDCol, OAuthIDCol),
 			IDPTemplateOAuthSuffix,
@@ -254,6 +263,8 @@ func (*idpTemplateProjection) Init() *old_handler.Check {
 			handler.NewColumn(AzureADScopesCol, handler.ColumnTypeText, handler.Nullable()),
 			handler.NewColumn(AzureADTenantCol, handler.ColumnTypeText, handler.Nullable()),
 			handler.NewColumn(AzureADIsEmailVerified, handler.ColumnTypeBool, handler.Default(false)),
+			handler.NewColumn(AzureADAllowedTenants, handler.ColumnTypeTextArray, handler.Nullable()),
+			handler.NewColumn(AzureADFetchGroups, handler.ColumnTypeBool, handler.Default(false)),
 		},
 			handler.NewPrimaryKey(AzureADInstanceIDCol, AzureADIDCol),
 			IDPTemplateAzureADSuffix,
@@ -343,6 +354,11 @@ func (*idpTemplateProjection) Init() *old_handler.Check {
 			handler.NewColumn(LDAPPreferredLanguageAttributeCol, handler.ColumnTypeText, handler.Nullable()),
 			handler.NewColumn(LDAPAvatarURLAttributeCol, handler.ColumnTypeText, handler.Nullable()),
 			handler.NewColumn(LDAPProfileAttributeCol, handler.ColumnTypeText, handler.Nullable()),
+			handler.NewColumn(LDAPMetadataAttributesCol, handler.ColumnTypeJSONB, handler.Nullable()),
+			handler.NewColumn(LDAPRootCACol, handler.ColumnTypeBytes, handler.Nullable()),
+			handler.NewColumn(LDAPGroupResolutionCol, handler.ColumnTypeEnum, handler.Default(0)),
+			handler.NewColumn(LDAPGroupBaseCol, handler.ColumnTypeText, handler.Nullable()),
+			handler.NewColumn(LDAPGroupNameAttributeCol, handler.ColumnTypeText, handler.Nullable()),
 		},
 			handler.NewPrimaryKey(LDAPInstanceIDCol, LDAPIDCol),
 			IDPTemplateLDAPSuffix,
@@ -720,6 +736,7 @@ func (p *idpTemplateProjection) reduceOAuthIDPAdded(event eventstore.Event) (*ha
 				handler.NewCol(OAuthUserEndpointCol, idpEvent.UserEndpoint),
 				handler.NewCol(OAuthScopesCol, database.TextArray[string](idpEvent.Scopes)),
 				handler.NewCol(OAuthIDAttributeCol, idpEvent.IDAttribute),
+				handler.NewJSONCol(OAuthAttributeMappingCol, idpEvent.AttributeMapping),
 			},
 			handler.WithTableSuffix(IDPTemplateOAuthSuffix),
 		),
@@ -904,6 +921,8 @@ func (p *idpTemplateProjection) reduceOIDCIDPMigratedAzureAD(event eventstore.Ev
 				handler.NewCol(AzureADScopesCol, database.TextArray[string](idpEvent.Scopes)),
 				handler.NewCol(AzureADTenantCol, idpEvent.Tenant),
 				handler.NewCol(AzureADIsEmailVerified, idpEvent.IsEmailVerified),
+				handler.NewCol(AzureADAllowedTenants, database.TextArray[string](idpEvent.AllowedTenants)),
+				handler.NewCol(AzureADFetchGroups, idpEvent.FetchGroups),
 			},
 			handler.WithTableSuffix(IDPTemplateAzureADSuffix),
 		),
@@ -1352,6 +1371,8 @@ func (p *idpTemplateProjection) reduceAzureADIDPAdded(event eventstore.Event) (*
 				handler.NewCol(AzureADScopesCol, database.TextArray[string](idpEvent.Scopes)),
 				handler.NewCol(AzureADTenantCol, idpEvent.Tenant),
 				handler.NewCol(AzureADIsEmailVerified, idpEvent.IsEmailVerified),
+				handler.NewCol(AzureADAllowedTenants, database.TextArray[string](idpEvent.AllowedTenants)),
+				handler.NewCol(AzureADFetchGroups, idpEvent.FetchGroups),
 			},
 			handler.WithTableSuffix(IDPTemplateAzureADSuffix),
 		),
@@ -1909,6 +1930,11 @@ func (p *idpTemplateProjection) reduceLDAPIDPAdded(event eventstore.Event) (*han
 				handler.NewCol(LDAPPreferredLanguageAttributeCol, idpEvent.PreferredLanguageAttribute),
 				handler.NewCol(LDAPAvatarURLAttributeCol, idpEvent.AvatarURLAttribute),
 				handler.NewCol(LDAPProfileAttributeCol, idpEvent.ProfileAttribute),
+				handler.NewJSONCol(LDAPMetadataAttributesCol, idpEvent.MetadataAttributes),
+				handler.NewCol(LDAPRootCACol, idpEvent.RootCA),
+				handler.NewCol(LDAPGroupResolutionCol, idpEvent.GroupResolution),
+				handler.NewCol(LDAPGroupBaseCol, idpEvent.GroupBase),
+				handler.NewCol(LDAPGroupNameAttributeCol, idpEvent.GroupNameAttribute),
 			},
 			handler.WithTableSuffix(IDPTemplateLDAPSuffix),
 		),
@@ -2228,7 +2254,7 @@ func reduceIDPChangedTemplateColumns(name *string, creationDate time.Time, seque
 }
 
 func reduceOAuthIDPChangedColumns(idpEvent idp.OAuthIDPChangedEvent) []handler.Column {
-	oauthCols := make([]handler.Column, 0, 7)
+	oauthCols := make([]handler.Column, 0, 8)
 	if idpEvent.ClientID != nil {
 		oauthCols = append(oauthCols, handler.NewCol(OAuthClientIDCol, *idpEvent.ClientID))
 	}
@@ -2250,6 +2276,13 @@ func reduceOAuthIDPChangedColumns(idpEvent idp.OAuthIDPChangedEvent) []handler.C
 	if idpEvent.IDAttribute != nil {
 		oauthCols = append(oauthCols, handler.NewCol(OAuthIDAttributeCol, *idpEvent.IDAttribute))
 	}
+	if idpEvent.AttributeMapping != nil {
+		var attributeMapping *domain.OAuthAttributeMapping
+		if !idpEvent.AttributeMapping.IsZero() {
+			attributeMapping = idpEvent.AttributeMapping
+		}
+		oauthCols = append(oauthCols, handler.NewJSONCol(OAuthAttributeMappingCol, attributeMapping))
+	}
 	return oauthCols
 }
 
@@ -2291,7 +2324,7 @@ func reduceJWTIDPChangedColumns(idpEvent idp.JWTIDPChangedEvent) []handler.Colum
 }
 
 func reduceAzureADIDPChangedColumns(idpEvent idp.AzureADIDPChangedEvent) []handler.Column {
-	azureADCols := make([]handler.Column, 0, 5)
+	azureADCols := make([]handler.Column, 0, 7)
 	if idpEvent.ClientID != nil {
 		azureADCols = append(azureADCols, handler.NewCol(AzureADClientIDCol, *idpEvent.ClientID))
 	}
@@ -2307,6 +2340,12 @@ func reduceAzureADIDPChangedColumns(idpEvent idp.AzureADIDPChangedEvent) []handl
 	if idpEvent.IsEmailVerified != nil {
 		azureADCols = append(azureADCols, handler.NewCol(AzureADIsEmailVerified, *idpEvent.IsEmailVerified))
 	}
+	if idpEvent.AllowedTenants != nil {
+		azureADCols = append(azureADCols, handler.NewCol(AzureADAllowedTenants, database.TextArray[string](*idpEvent.AllowedTenants)))
+	}
+	if idpEvent.FetchGroups != nil {
+		azureADCols = append(azureADCols, handler.NewCol(AzureADFetchGroups, *idpEvent.FetchGroups))
+	}
 	return azureADCols
 }
 
@@ -2393,7 +2432,7 @@ func reduceGoogleIDPChangedColumns(idpEvent idp.GoogleIDPChangedEvent) []handler
 }
 
 func reduceLDAPIDPChangedColumns(idpEvent idp.LDAPIDPChangedEvent) []handler.Column {
-	ldapCols := make([]handler.Column, 0, 22)
+	ldapCols := make([]handler.Column, 0, 27)
 	if idpEvent.Servers != nil {
 		ldapCols = append(ldapCols, handler.NewCol(LDAPServersCol, database.TextArray[string](idpEvent.Servers)))
 	}
@@ -2460,6 +2499,21 @@ func reduceLDAPIDPChangedColumns(idpEvent idp.LDAPIDPChangedEvent) []handler.Col
 	if idpEvent.ProfileAttribute != nil {
 		ldapCols = append(ldapCols, handler.NewCol(LDAPProfileAttributeCol, *idpEvent.ProfileAttribute))
 	}
+	if idpEvent.MetadataAttributes != nil {
+		ldapCols = append(ldapCols, handler.NewJSONCol(LDAPMetadataAttributesCol, *idpEvent.MetadataAttributes))
+	}
+	if idpEvent.RootCA != nil {
+		ldapCols = append(ldapCols, handler.NewCol(LDAPRootCACol, *idpEvent.RootCA))
+	}
+	if idpEvent.GroupResolution != nil {
+		ldapCols = append(ldapCols, handler.NewCol(LDAPGroupResolutionCol, *idpEvent.GroupResolution))
+	}
+	if idpEvent.GroupBase != nil {
+		ldapCols = append(ldapCols, handler.NewCol(LDAPGroupBaseCol, *idpEvent.GroupBase))
+	}
+	if idpEvent.GroupNameAttribute != nil {
+		ldapCols = append(ldapCols, handler.NewCol(LDAPGroupNameAttributeCol, *idpEvent.GroupNameAttribute))
+	}
 	return ldapCols
 }
 
@@ -0,0 +1,130 @@
+package projection
+
+import (
+	"context"
+
+	"github.com/zitadel/zitadel/internal/eventstore"
+	old_handler "github.com/zitadel/zitadel/internal/eventstore/handler"
+	"github.com/zitadel/zitadel/internal/eventstore/handler/v2"
+	"github.com/zitadel/zitadel/internal/repository/instance"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+const (
+	InstanceDomainSettingsTable = "projections.instance_domain_settings"
+
+	InstanceDomainSettingsInstanceIDCol      = "instance_id"
+	InstanceDomainSettingsDomainCol          = "domain"
+	InstanceDomainSettingsCreationDateCol    = "creation_date"
+	InstanceDomainSettingsChangeDateCol      = "change_date"
+	InstanceDomainSettingsSequenceCol        = "sequence"
+	InstanceDomainSettingsBrandingOrgIDCol   = "branding_org_id"
+	InstanceDomainSettingsDefaultLanguageCol = "default_language"
+)
+
+type instanceDomainSettingsProjection struct{}
+
+func newInstanceDomainSettingsProjection(ctx context.Context, config handler.Config) *handler.Handler {
+	return handler.NewHandler(ctx, &config, new(instanceDomainSettingsProjection))
+}
+
+func (*instanceDomainSettingsProjection) Name() string {
+	return InstanceDomainSettingsTable
+}
+
+func (*instanceDomainSettingsProjection) Init() *old_handler.Check {
+	return handler.NewTableCheck(
+		handler.NewTable([]*handler.InitColumn{
+			handler.NewColumn(InstanceDomainSettingsInstanceIDCol, handler.ColumnTypeText),
+			handler.NewColumn(InstanceDomainSettingsDomainCol, handler.ColumnTypeText),
+			handler.NewColumn(InstanceDomainSettingsCreationDateCol, handler.ColumnTypeTimestamp),
+			handler.NewColumn(InstanceDomainSettingsChangeDateCol, handler.ColumnTypeTimestamp),
+			handler.NewColumn(InstanceDomainSettingsSequenceCol, handler.ColumnTypeInt64),
+			handler.NewColumn(InstanceDomainSettingsBrandingOrgIDCol, handler.ColumnTypeText, handler.Default("")),
+			handler.NewColumn(InstanceDomainSettingsDefaultLanguageCol, handler.ColumnTypeText, handler.Default("")),
+		},
+			handler.NewPrimaryKey(InstanceDomainSettingsInstanceIDCol, InstanceDomainSettingsDomainCol),
+		),
+	)
+}
+
+func (p *instanceDomainSettingsProjection) Reducers() []handler.AggregateReducer {
+	return []handler.AggregateReducer{
+		{
+			Aggregate: instance.AggregateType,
+			EventReducers: []handler.EventReducer{
+				{
+					Event:  instance.InstanceDomainSettingsSetEventType,
+					Reduce: p.reduceSettingsSet,
+				},
+				{
+					Event:  instance.InstanceDomainSettingsRemovedEventType,
+					Reduce: p.reduceSettingsRemoved,
+				},
+				{
+					Event:  instance.InstanceDomainRemovedEventType,
+					Reduce: p.reduceDomainRemoved,
+				},
+				{
+					Event:  instance.InstanceRemovedEventType,
+					Reduce: reduceInstanceRemovedHelper(InstanceDomainSettingsInstanceIDCol),
+				},
+			},
+		},
+	}
+}
+
+func (p *instanceDomainSettingsProjection) reduceSettingsSet(event eventstore.Event) (*handler.Statement, error) {
+	e, ok := event.(*instance.DomainSettingsSetEvent)
+	if !ok {
+		return nil, zerrors.ThrowInvalidArgumentf(nil, "PROJE-Ds7nq", "reduce.wrong.event.type %s", instance.InstanceDomainSettingsSetEventType)
+	}
+	defaultLanguage := ""
+	if !e.DefaultLanguage.IsRoot() {
+		defaultLanguage = e.DefaultLanguage.String()
+	}
+	return handler.NewUpsertStatement(
+		e,
+		[]handler.Column{
+			handler.NewCol(InstanceDomainSettingsInstanceIDCol, nil),
+			handler.NewCol(InstanceDomainSettingsDomainCol, nil),
+		},
+		[]handler.Column{
+			handler.NewCol(InstanceDomainSettingsInstanceIDCol, e.Aggregate().InstanceID),
+			handler.NewCol(InstanceDomainSettingsDomainCol, e.Domain),
+			handler.NewCol(InstanceDomainSettingsCreationDateCol, handler.OnlySetValueOnInsert(InstanceDomainSettingsTable, e.CreationDate())),
+			handler.NewCol(InstanceDomainSettingsChangeDateCol, e.CreationDate()),
+			handler.NewCol(InstanceDomainSettingsSequenceCol, e.Sequence()),
+			handler.NewCol(InstanceDomainSettingsBrandingOrgIDCol, e.BrandingOrgID),
+			handler.NewCol(InstanceDomainSettingsDefaultLanguageCol, defaultLanguage),
+		},
+	), nil
+}
+
+func (p *instanceDomainSettingsProjection) reduceSettingsRemoved(event eventstore.Event) (*handler.Statement, error) {
+	e, ok := event.(*instance.DomainSettingsRemovedEvent)
+	if !ok {
+		return nil, zerrors.ThrowInvalidArgumentf(nil, "PROJE-Dr2nw", "reduce.wrong.event.type %s", instance.InstanceDomainSettingsRemovedEventType)
+	}
+	return handler.NewDeleteStatement(
+		e,
+		[]handler.Condition{
+			handler.NewCond(InstanceDomainSettingsInstanceIDCol, e.Aggregate().InstanceID),
+			handler.NewCond(InstanceDomainSettingsDomainCol, e.Domain),
+		},
+	), nil
+}
+
+func (p *instanceDomainSettingsProjection) reduceDomainRemoved(event eventstore.Event) (*handler.Statement, error) {
+	e, ok := event.(*instance.DomainRemovedEvent)
+	if !ok {
+		return nil, zerrors.ThrowInvalidArgumentf(nil, "PROJE-Dr5mq", "reduce.wrong.event.type %s", instance.InstanceDomainRemovedEventType)
+	}
+	return handler.NewDeleteStatement(
+		e,
+		[]handler.Condition{
+			handler.NewCond(InstanceDomainSettingsInstanceIDCol, e.Aggregate().InstanceID),
+			handler.NewCond(InstanceDomainSettingsDomainCol, e.Domain),
+		},
+	), nil
+}
@@ -0,0 +1,180 @@
+package projection
+
+import (
+	"testing"
+
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/eventstore/handler/v2"
+	"github.com/zitadel/zitadel/internal/repository/instance"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+func TestInstanceDomainSettingsProjection_reduces(t *testing.T) {
+	type args struct {
+		event func(t *testing.T) eventstore.Event
+	}
+	tests := []struct {
+		name   string
+		args   args
+		reduce func(event eventstore.Event) (*handler.Statement, error)
+		want   wantReduce
+	}{
+		{
+			name: "reduceSettingsSet",
+			args: args{
+				event: getEvent(
+					testEvent(
+						instance.InstanceDomainSettingsSetEventType,
+						instance.AggregateType,
+						[]byte(`{"domain": "*.domain.new", "brandingOrgId": "org-id", "defaultLanguage": "de"}`),
+					), instance.DomainSettingsSetEventMapper),
+			},
+			reduce: (&instanceDomainSettingsProjection{}).reduceSettingsSet,
+			want: wantReduce{
+				aggregateType: eventstore.AggregateType("instance"),
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "INSERT INTO projections.instance_domain_settings (instance_id, domain, creation_date, change_date, sequence, branding_org_id, default_language) VALUES ($1, $2, $3, $4, $5, $6, $7) ON CONFLICT (instance_id, domain) DO UPDATE SET (creation_date, change_date, sequence, branding_org_id, default_language) = (projections.instance_domain_settings.creation_date, EXCLUDED.change_date, EXCLUDED.sequence, EXCLUDED.branding_org_id, EXCLUDED.default_language)",
+							expectedArgs: []interface{}{
+								"instance-id",
+								"*.domain.new",
+								anyArg{},
+								anyArg{},
+								uint64(15),
+								"org-id",
+								"de",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "reduceSettingsSet without language",
+			args: args{
+				event: getEvent(
+					testEvent(
+						instance.InstanceDomainSettingsSetEventType,
+						instance.AggregateType,
+						[]byte(`{"domain": "domain.new", "brandingOrgId": "org-id", "defaultLanguage": "und"}`),
+					), instance.DomainSettingsSetEventMapper),
+			},
+			reduce: (&instanceDomainSettingsProjection{}).reduceSettingsSet,
+			want: wantReduce{
+				aggregateType: eventstore.AggregateType("instance"),
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "INSERT INTO projections.instance_domain_settings (instance_id, domain, creation_date, change_date, sequence, branding_org_id, default_language) VALUES ($1, $2, $3, $4, $5, $6, $7) ON CONFLICT (instance_id, domain) DO UPDATE SET (creation_date, change_date, sequence, branding_org_id, default_language) = (projections.instance_domain_settings.creation_date, EXCLUDED.change_date, EXCLUDED.sequence, EXCLUDED.branding_org_id, EXCLUDED.default_language)",
+							expectedArgs: []interface{}{
+								"instance-id",
+								"domain.new",
+								anyArg{},
+								anyArg{},
+								uint64(15),
+								"org-id",
+								"",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "reduceSettingsRemoved",
+			args: args{
+				event: getEvent(
+					testEvent(
+						instance.InstanceDomainSettingsRemovedEventType,
+						instance.AggregateType,
+						[]byte(`{"domain": "domain.new"}`),
+					), instance.DomainSettingsRemovedEventMapper),
+			},
+			reduce: (&instanceDomainSettingsProjection{}).reduceSettingsRemoved,
+			want: wantReduce{
+				aggregateType: eventstore.AggregateType("instance"),
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "DELETE FROM projections.instance_domain_settings WHERE (instance_id = $1) AND (domain = $2)",
+							expectedArgs: []interface{}{
+								"instance-id",
+								"domain.new",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "reduceDomainRemoved",
+			args: args{
+				event: getEvent(
+					testEvent(
+						instance.InstanceDomainRemovedEventType,
+						instance.AggregateType,
+						[]byte(`{"domain": "domain.new"}`),
+					), instance.DomainRemovedEventMapper),
+			},
+			reduce: (&instanceDomainSettingsProjection{}).reduceDomainRemoved,
+			want: wantReduce{
+				aggregateType: eventstore.AggregateType("instance"),
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "DELETE FROM projections.instance_domain_settings WHERE (instance_id = $1) AND (domain = $2)",
+							expectedArgs: []interface{}{
+								"instance-id",
+								"domain.new",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "instance reduceInstanceRemoved",
+			args: args{
+				event: getEvent(
+					testEvent(
+						instance.InstanceRemovedEventType,
+						instance.AggregateType,
+						nil,
+					), instance.InstanceRemovedEventMapper),
+			},
+			reduce: reduceInstanceRemovedHelper(InstanceDomainSettingsInstanceIDCol),
+			want: wantReduce{
+				aggregateType: eventstore.AggregateType("instance"),
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "DELETE FROM projections.instance_domain_settings WHERE (instance_id = $1)",
+							expectedArgs: []interface{}{
+								"agg-id",
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			event := baseEvent(t)
+			got, err := tt.reduce(event)
+			if ok := zerrors.IsErrorInvalidArgument(err); !ok {
+				t.Errorf("no wrong event mapping: %v, got: %v", err, got)
+			}
+
+			event = tt.args.event(t)
+			got, err = tt.reduce(event)
+			assertReduce(t, got, err, InstanceDomainSettingsTable, tt.want)
+		})
+	}
+}
@@ -0,0 +1,237 @@
+package projection
+
+import (
+	"context"
+	"time"
+
+	"github.com/zitadel/zitadel/internal/database"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	old_handler "github.com/zitadel/zitadel/internal/eventstore/handler"
+	"github.com/zitadel/zitadel/internal/eventstore/handler/v2"
+	"github.com/zitadel/zitadel/internal/repository/instance"
+	"github.com/zitadel/zitadel/internal/repository/session"
+	"github.com/zitadel/zitadel/internal/repository/user"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+const (
+	InstanceUsageProjectionTable = "projections.instance_usage"
+	InstanceUsageActiveUserTable = InstanceUsageProjectionTable + "_" + instanceUsageActiveUserSuffix
+
+	InstanceUsageColumnInstanceID  = "instance_id"
+	InstanceUsageColumnUnit        = "unit"
+	InstanceUsageColumnPeriodStart = "period_start"
+	InstanceUsageColumnUsage       = "usage"
+
+	instanceUsageActiveUserSuffix           = "active_users"
+	InstanceUsageActiveUserColumnInstanceID = "instance_id"
+	InstanceUsageActiveUserColumnPeriod     = "period_start"
+	InstanceUsageActiveUserColumnUserID     = "user_id"
+)
+
+const (
+	incrementInstanceUsageStmt = "INSERT INTO " + InstanceUsageProjectionTable +
+		" (instance_id, unit, period_start, usage) VALUES ($1, $2, $3, $4)" +
+		" ON CONFLICT (instance_id, unit, period_start) DO UPDATE SET usage = " + InstanceUsageProjectionTable + ".usage + EXCLUDED.usage"
+	addInstanceUsageActiveUserStmt = "INSERT INTO " + InstanceUsageActiveUserTable +
+		" (instance_id, period_start, user_id) VALUES ($1, $2, $3) ON CONFLICT DO NOTHING"
+)
+
+// instanceUsageProjection meters the usage of the instances per month.
+// Authentications and active users are reduced from events,
+// requests and action run seconds are incremented by the log store emitters.
+type instanceUsageProjection struct {
+	handler *handler.Handler
+	client  *database.DB
+}
+
+func newInstanceUsageProjection(ctx context.Context, config handler.Config) *instanceUsageProjection {
+	p := &instanceUsageProjection{
+		client: config.Client,
+	}
+	p.handler = handler.NewHandler(ctx, &config, p)
+	return p
+}
+
+func (*instanceUsageProjection) Name() string {
+	return InstanceUsageProjectionTable
+}
+
+func (*instanceUsageProjection) Init() *old_handler.Check {
+	return handler.NewMultiTableCheck(
+		handler.NewTable(
+			[]*handler.InitColumn{
+				handler.NewColumn(InstanceUsageColumnInstanceID, handler.ColumnTypeText),
+				handler.NewColumn(InstanceUsageColumnUnit, handler.ColumnTypeEnum),
+				handler.NewColumn(InstanceUsageColumnPeriodStart, handler.ColumnTypeTimestamp),
+				handler.NewColumn(InstanceUsageColumnUsage, handler.ColumnTypeInt64),
+			},
+			handler.NewPrimaryKey(InstanceUsageColumnInstanceID, InstanceUsageColumnUnit, InstanceUsageColumnPeriodStart),
+		),
+		handler.NewSuffixedTable(
+			[]*handler.InitColumn{
+				handler.NewColumn(InstanceUsageActiveUserColumnInstanceID, handler.ColumnTypeText),
+				handler.NewColumn(InstanceUsageActiveUserColumnPeriod, handler.ColumnTypeTimestamp),
+				handler.NewColumn(InstanceUsageActiveUserColumnUserID, handler.ColumnTypeText),
+			},
+			handler.NewPrimaryKey(InstanceUsageActiveUserColumnInstanceID, InstanceUsageActiveUserColumnPeriod, InstanceUsageActiveUserColumnUserID),
+			instanceUsageActiveUserSuffix,
+		),
+	)
+}
+
+func (p *instanceUsageProjection) Reducers() []handler.AggregateReducer {
+	return []handler.AggregateReducer{
+		{
+			Aggregate: user.AggregateType,
+			EventReducers: []handler.EventReducer{
+				{
+					Event:  user.HumanPasswordCheckSucceededType,
+					Reduce: p.reduceUserAuthenticated,
+				},
+				{
+					Event:  user.UserV1PasswordCheckSucceededType,
+					Reduce: p.reduceUserAuthenticated,
+				},
+				{
+					Event:  user.HumanPasswordlessTokenCheckSucceededType,
+					Reduce: p.reduceUserAuthenticated,
+				},
+				{
+					Event:  user.UserIDPLoginCheckSucceededType,
+					Reduce: p.reduceUserAuthenticated,
+				},
+			},
+		},
+		{
+			Aggregate: session.AggregateType,
+			EventReducers: []handler.EventReducer{
+				{
+					Event:  session.UserCheckedType,
+					Reduce: p.reduceSessionUserChecked,
+				},
+				{
+					Event:  session.PasswordCheckedType,
+					Reduce: p.reduceSessionAuthenticated,
+				},
+				{
+					Event:  session.IntentCheckedType,
+					Reduce: p.reduceSessionAuthenticated,
+				},
+				{
+					Event:  session.WebAuthNCheckedType,
+					Reduce: p.reduceSessionAuthenticated,
+				},
+			},
+		},
+		{
+			Aggregate: instance.AggregateType,
+			EventReducers: []handler.EventReducer{
+				{
+					Event:  instance.InstanceRemovedEventType,
+					Reduce: p.reduceInstanceRemoved,
+				},
+			},
+		},
+	}
+}
+
+// reduceUserAuthenticated counts the authentication of the login and marks the user as active
+func (p *instanceUsageProjection) reduceUserAuthenticated(event eventstore.Event) (*handler.Statement, error) {
+	switch event.(type) {
+	case *user.HumanPasswordCheckSucceededEvent,
+		*user.HumanPasswordlessCheckSucceededEvent,
+		*user.UserIDPCheckSucceededEvent:
+	default:
+		return nil, zerrors.ThrowInvalidArgumentf(nil, "PROJE-Jq3wv8Ks2n", "reduce.wrong.event.type %v", []eventstore.EventType{user.HumanPasswordCheckSucceededType, user.HumanPasswordlessTokenCheckSucceededType, user.UserIDPLoginCheckSucceededType})
+	}
+	periodStart := domain.UsagePeriodStart(event.CreatedAt())
+	return handler.NewStatement(event, multiExec(
+		incrementInstanceUsage(event.Aggregate().InstanceID, domain.UsageUnitAuthentications, periodStart, 1),
+		addInstanceUsageActiveUser(event.Aggregate().InstanceID, periodStart, event.Aggregate().ID),
+	)), nil
+}
+
+// reduceSessionAuthenticated counts the authentication of a session,
+// the user is marked as active by the user check of the session
+func (p *instanceUsageProjection) reduceSessionAuthenticated(event eventstore.Event) (*handler.Statement, error) {
+	switch event.(type) {
+	case *session.PasswordCheckedEvent,
+		*session.IntentCheckedEvent,
+		*session.WebAuthNCheckedEvent:
+	default:
+		return nil, zerrors.ThrowInvalidArgumentf(nil, "PROJE-Bx6mt4Wq9r", "reduce.wrong.event.type %v", []eventstore.EventType{session.PasswordCheckedType, session.IntentCheckedType, session.WebAuthNCheckedType})
+	}
+	return handler.NewStatement(event,
+		incrementInstanceUsage(event.Aggregate().InstanceID, domain.UsageUnitAuthentications, domain.UsagePeriodStart(event.CreatedAt()), 1),
+	), nil
+}
+
+func (p *instanceUsageProjection) reduceSessionUserChecked(event eventstore.Event) (*handler.Statement, error) {
+	e, err := assertEvent[*session.UserCheckedEvent](event)
+	if err != nil {
+		return nil, err
+	}
+	return handler.NewStatement(e,
+		addInstanceUsageActiveUser(e.Aggregate().InstanceID, domain.UsagePeriodStart(e.CreatedAt()), e.UserID),
+	), nil
+}
+
+func (p *instanceUsageProjection) reduceInstanceRemoved(event eventstore.Event) (*handler.Statement, error) {
+	e, err := assertEvent[*instance.InstanceRemovedEvent](event)
+	if err != nil {
+		return nil, err
+	}
+	return handler.NewMultiStatement(
+		e,
+		handler.AddDeleteStatement(
+			[]handler.Condition{
+				handler.NewCond(InstanceUsageActiveUserColumnInstanceID, e.Aggregate().InstanceID),
+			},
+			handler.WithTableSuffix(instanceUsageActiveUserSuffix),
+		),
+		handler.AddDeleteStatement(
+			[]handler.Condition{
+				handler.NewCond(InstanceUsageColumnInstanceID, e.Aggregate().InstanceID),
+			},
+		),
+	), nil
+}
+
+// IncrementUsage adds count to the usage of the unit in the period
+func (p *instanceUsageProjection) IncrementUsage(ctx context.Context, unit domain.UsageUnit, instanceID string, periodStart time.Time, count uint64) error {
+	if count == 0 {
+		return nil
+	}
+	_, err := p.client.ExecContext(ctx, incrementInstanceUsageStmt, instanceID, unit, periodStart, count)
+	if err != nil {
+		return zerrors.ThrowInternal(err, "PROJ-Ur7xk2Nv4q", "Errors.Internal")
+	}
+	return nil
+}
+
+func incrementInstanceUsage(instanceID string, unit domain.UsageUnit, periodStart time.Time, count uint64) handler.Exec {
+	return func(ex handler.Executer, _ string) error {
+		_, err := ex.Exec(incrementInstanceUsageStmt, instanceID, unit, periodStart, count)
+		return err
+	}
+}
+
+func addInstanceUsageActiveUser(instanceID string, periodStart time.Time, userID string) handler.Exec {
+	return func(ex handler.Executer, _ string) error {
+		_, err := ex.Exec(addInstanceUsageActiveUserStmt, instanceID, periodStart, userID)
+		return err
+	}
+}
+
+func multiExec(execs ...handler.Exec) handler.Exec {
+	return func(ex handler.Executer, projectionName string) error {
+		for _, exec := range execs {
+			if err := exec(ex, projectionName); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+}
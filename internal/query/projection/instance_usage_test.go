@@ -0,0 +1,167 @@
+package projection
+
+import (
+	"testing"
+	"time"
+
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/eventstore/handler/v2"
+	"github.com/zitadel/zitadel/internal/repository/instance"
+	"github.com/zitadel/zitadel/internal/repository/session"
+	"github.com/zitadel/zitadel/internal/repository/user"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+func TestInstanceUsageProjection_reduces(t *testing.T) {
+	type args struct {
+		event func(t *testing.T) eventstore.Event
+	}
+	createdAt := time.Date(2024, 3, 17, 12, 30, 0, 0, time.UTC)
+	periodStart := time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC)
+	tests := []struct {
+		name   string
+		args   args
+		reduce func(event eventstore.Event) (*handler.Statement, error)
+		want   wantReduce
+	}{
+		{
+			name: "reduceUserAuthenticated",
+			args: args{
+				event: getEvent(timedTestEvent(
+					user.HumanPasswordCheckSucceededType,
+					user.AggregateType,
+					[]byte(`{}`),
+					createdAt,
+				), user.HumanPasswordCheckSucceededEventMapper),
+			},
+			reduce: (&instanceUsageProjection{}).reduceUserAuthenticated,
+			want: wantReduce{
+				aggregateType: user.AggregateType,
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "INSERT INTO projections.instance_usage (instance_id, unit, period_start, usage) VALUES ($1, $2, $3, $4) ON CONFLICT (instance_id, unit, period_start) DO UPDATE SET usage = projections.instance_usage.usage + EXCLUDED.usage",
+							expectedArgs: []interface{}{
+								"instance-id",
+								domain.UsageUnitAuthentications,
+								periodStart,
+								uint64(1),
+							},
+						},
+						{
+							expectedStmt: "INSERT INTO projections.instance_usage_active_users (instance_id, period_start, user_id) VALUES ($1, $2, $3) ON CONFLICT DO NOTHING",
+							expectedArgs: []interface{}{
+								"instance-id",
+								periodStart,
+								"agg-id",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "reduceSessionAuthenticated",
+			args: args{
+				event: getEvent(timedTestEvent(
+					session.PasswordCheckedType,
+					session.AggregateType,
+					[]byte(`{}`),
+					createdAt,
+				), session.PasswordCheckedEventMapper),
+			},
+			reduce: (&instanceUsageProjection{}).reduceSessionAuthenticated,
+			want: wantReduce{
+				aggregateType: session.AggregateType,
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "INSERT INTO projections.instance_usage (instance_id, unit, period_start, usage) VALUES ($1, $2, $3, $4) ON CONFLICT (instance_id, unit, period_start) DO UPDATE SET usage = projections.instance_usage.usage + EXCLUDED.usage",
+							expectedArgs: []interface{}{
+								"instance-id",
+								domain.UsageUnitAuthentications,
+								periodStart,
+								uint64(1),
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "reduceSessionUserChecked",
+			args: args{
+				event: getEvent(timedTestEvent(
+					session.UserCheckedType,
+					session.AggregateType,
+					[]byte(`{"userID": "user-id"}`),
+					createdAt,
+				), session.UserCheckedEventMapper),
+			},
+			reduce: (&instanceUsageProjection{}).reduceSessionUserChecked,
+			want: wantReduce{
+				aggregateType: session.AggregateType,
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "INSERT INTO projections.instance_usage_active_users (instance_id, period_start, user_id) VALUES ($1, $2, $3) ON CONFLICT DO NOTHING",
+							expectedArgs: []interface{}{
+								"instance-id",
+								periodStart,
+								"user-id",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "reduceInstanceRemoved",
+			args: args{
+				event: getEvent(testEvent(
+					instance.InstanceRemovedEventType,
+					instance.AggregateType,
+					[]byte(`{}`),
+				), instance.InstanceRemovedEventMapper),
+			},
+			reduce: (&instanceUsageProjection{}).reduceInstanceRemoved,
+			want: wantReduce{
+				aggregateType: instance.AggregateType,
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "DELETE FROM projections.instance_usage_active_users WHERE (instance_id = $1)",
+							expectedArgs: []interface{}{
+								"instance-id",
+							},
+						},
+						{
+							expectedStmt: "DELETE FROM projections.instance_usage WHERE (instance_id = $1)",
+							expectedArgs: []interface{}{
+								"instance-id",
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			event := baseEvent(t)
+			got, err := tt.reduce(event)
+			if !zerrors.IsErrorInvalidArgument(err) {
+				t.Errorf("no wrong event mapping: %v, got: %v", err, got)
+			}
+
+			event = tt.args.event(t)
+			got, err = tt.reduce(event)
+			assertReduce(t, got, err, InstanceUsageProjectionTable, tt.want)
+		})
+	}
+}
@@ -124,6 +124,10 @@ func (p *keyProjection) Reducers() []handler.AggregateReducer {
 					Event:  keypair.AddedCertificateEventType,
 					Reduce: p.reduceCertificateAdded,
 				},
+				{
+					Event:  keypair.RotatedEventType,
+					Reduce: p.reduceKeyPairRotated,
+				},
 			},
 		},
 		{
@@ -190,6 +194,46 @@ func (p *keyProjection) reduceKeyPairAdded(event eventstore.Event) (*handler.Sta
 	return handler.NewMultiStatement(e, creates...), nil
 }
 
+func (p *keyProjection) reduceKeyPairRotated(event eventstore.Event) (*handler.Statement, error) {
+	e, ok := event.(*keypair.RotatedEvent)
+	if !ok {
+		return nil, zerrors.ThrowInvalidArgumentf(nil, "HANDL-Nq3ew", "reduce.wrong.event.type %s", keypair.RotatedEventType)
+	}
+	return handler.NewMultiStatement(
+		e,
+		handler.AddUpdateStatement(
+			[]handler.Column{
+				handler.NewCol(KeyColumnChangeDate, e.CreationDate()),
+				handler.NewCol(KeyColumnSequence, e.Sequence()),
+			},
+			[]handler.Condition{
+				handler.NewCond(KeyColumnID, e.Aggregate().ID),
+				handler.NewCond(KeyColumnInstanceID, e.Aggregate().InstanceID),
+			},
+		),
+		handler.AddUpdateStatement(
+			[]handler.Column{
+				handler.NewCol(KeyPrivateColumnExpiry, e.PrivateKeyExpiry),
+			},
+			[]handler.Condition{
+				handler.NewCond(KeyPrivateColumnID, e.Aggregate().ID),
+				handler.NewCond(KeyPrivateColumnInstanceID, e.Aggregate().InstanceID),
+			},
+			handler.WithTableSuffix(privateKeyTableSuffix),
+		),
+		handler.AddUpdateStatement(
+			[]handler.Column{
+				handler.NewCol(KeyPublicColumnExpiry, e.PublicKeyExpiry),
+			},
+			[]handler.Condition{
+				handler.NewCond(KeyPublicColumnID, e.Aggregate().ID),
+				handler.NewCond(KeyPublicColumnInstanceID, e.Aggregate().InstanceID),
+			},
+			handler.WithTableSuffix(publicKeyTableSuffix),
+		),
+	), nil
+}
+
 func (p *keyProjection) reduceCertificateAdded(event eventstore.Event) (*handler.Statement, error) {
 	e, ok := event.(*keypair.AddedCertificateEvent)
 	if !ok {
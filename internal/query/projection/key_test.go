@@ -99,6 +99,51 @@ func TestKeyProjection_reduces(t *testing.T) {
 				executer:      &testExecuter{},
 			},
 		},
+		{
+			name: "reduceKeyPairRotated",
+			args: args{
+				event: getEvent(
+					testEvent(
+						keypair.RotatedEventType,
+						keypair.AggregateType,
+						[]byte(`{"nextKeyId": "key2", "privateKeyExpiry": "2024-01-01T00:00:00Z", "publicKeyExpiry": "2024-01-02T00:00:00Z"}`),
+					), keypair.RotatedEventMapper),
+			},
+			reduce: (&keyProjection{}).reduceKeyPairRotated,
+			want: wantReduce{
+				aggregateType: eventstore.AggregateType("key_pair"),
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "UPDATE projections.keys4 SET (change_date, sequence) = ($1, $2) WHERE (id = $3) AND (instance_id = $4)",
+							expectedArgs: []interface{}{
+								anyArg{},
+								uint64(15),
+								"agg-id",
+								"instance-id",
+							},
+						},
+						{
+							expectedStmt: "UPDATE projections.keys4_private SET expiry = $1 WHERE (id = $2) AND (instance_id = $3)",
+							expectedArgs: []interface{}{
+								time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+								"agg-id",
+								"instance-id",
+							},
+						},
+						{
+							expectedStmt: "UPDATE projections.keys4_public SET expiry = $1 WHERE (id = $2) AND (instance_id = $3)",
+							expectedArgs: []interface{}{
+								time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC),
+								"agg-id",
+								"instance-id",
+							},
+						},
+					},
+				},
+			},
+		},
 		{
 			name: "instance reduceInstanceRemoved",
 			args: args{
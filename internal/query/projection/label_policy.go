@@ -14,7 +14,7 @@ import (
 )
 
 const (
-	LabelPolicyTable = "projections.label_policies3"
+	LabelPolicyTable = "projections.label_policies4"
 
 	LabelPolicyIDCol                  = "id"
 	LabelPolicyCreationDateCol        = "creation_date"
@@ -30,6 +30,7 @@ const (
 	LabelPolicyFontURLCol             = "font_url"
 	LabelPolicyOwnerRemovedCol        = "owner_removed"
 	LabelPolicyThemeModeCol           = "theme_mode"
+	LabelPolicyCustomCSSCol           = "custom_css"
 
 	LabelPolicyLightPrimaryColorCol    = "light_primary_color"
 	LabelPolicyLightWarnColorCol       = "light_warn_color"
@@ -85,6 +86,7 @@ func (*labelPolicyProjection) Init() *old_handler.Check {
 			handler.NewColumn(LabelPolicyDarkIconURLCol, handler.ColumnTypeText, handler.Nullable()),
 			handler.NewColumn(LabelPolicyOwnerRemovedCol, handler.ColumnTypeBool, handler.Default(false)),
 			handler.NewColumn(LabelPolicyThemeModeCol, handler.ColumnTypeEnum, handler.Default(0)),
+			handler.NewColumn(LabelPolicyCustomCSSCol, handler.ColumnTypeText, handler.Nullable()),
 		},
 			handler.NewPrimaryKey(LabelPolicyInstanceIDCol, LabelPolicyIDCol, LabelPolicyStateCol),
 			handler.WithIndex(handler.NewIndex("owner_removed", []string{LabelPolicyOwnerRemovedCol})),
@@ -267,6 +269,7 @@ func (p *labelPolicyProjection) reduceAdded(event eventstore.Event) (*handler.St
 			handler.NewCol(LabelPolicyShouldErrorPopupCol, policyEvent.ErrorMsgPopup),
 			handler.NewCol(LabelPolicyWatermarkDisabledCol, policyEvent.DisableWatermark),
 			handler.NewCol(LabelPolicyThemeModeCol, policyEvent.ThemeMode),
+			handler.NewCol(LabelPolicyCustomCSSCol, policyEvent.CustomCSS),
 		}), nil
 }
 
@@ -320,6 +323,9 @@ func (p *labelPolicyProjection) reduceChanged(event eventstore.Event) (*handler.
 	if policyEvent.ThemeMode != nil {
 		cols = append(cols, handler.NewCol(LabelPolicyThemeModeCol, *policyEvent.ThemeMode))
 	}
+	if policyEvent.CustomCSS != nil {
+		cols = append(cols, handler.NewCol(LabelPolicyCustomCSSCol, *policyEvent.CustomCSS))
+	}
 	return handler.NewUpdateStatement(
 		&policyEvent,
 		cols,
@@ -383,6 +389,7 @@ func (p *labelPolicyProjection) reduceActivated(event eventstore.Event) (*handle
 			handler.NewCol(LabelPolicyDarkLogoURLCol, nil),
 			handler.NewCol(LabelPolicyDarkIconURLCol, nil),
 			handler.NewCol(LabelPolicyThemeModeCol, nil),
+			handler.NewCol(LabelPolicyCustomCSSCol, nil),
 		},
 		[]handler.Column{
 			handler.NewCol(LabelPolicyChangeDateCol, nil),
@@ -410,6 +417,7 @@ func (p *labelPolicyProjection) reduceActivated(event eventstore.Event) (*handle
 			handler.NewCol(LabelPolicyDarkLogoURLCol, nil),
 			handler.NewCol(LabelPolicyDarkIconURLCol, nil),
 			handler.NewCol(LabelPolicyThemeModeCol, nil),
+			handler.NewCol(LabelPolicyCustomCSSCol, nil),
 		},
 		[]handler.NamespacedCondition{
 			handler.NewNamespacedCondition(LabelPolicyIDCol, event.Aggregate().ID),
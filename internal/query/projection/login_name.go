@@ -303,6 +303,10 @@ func (p *loginNameProjection) Reducers() []handler.AggregateReducer {
 					Event:  org.OrgDomainVerifiedEventType,
 					Reduce: p.reduceDomainVerified,
 				},
+				{
+					Event:  org.OrgDomainUnverifiedEventType,
+					Reduce: p.reduceDomainUnverified,
+				},
 				{
 					Event:  org.OrgRemovedEventType,
 					Reduce: p.reduceOwnerRemoved,
@@ -550,6 +554,23 @@ func (p *loginNameProjection) reduceDomainRemoved(event eventstore.Event) (*hand
 	), nil
 }
 
+func (p *loginNameProjection) reduceDomainUnverified(event eventstore.Event) (*handler.Statement, error) {
+	e, ok := event.(*org.DomainUnverifiedEvent)
+	if !ok {
+		return nil, zerrors.ThrowInvalidArgumentf(nil, "HANDL-Uv2sq", "reduce.wrong.event.type %s", org.OrgDomainUnverifiedEventType)
+	}
+
+	return handler.NewDeleteStatement(
+		event,
+		[]handler.Condition{
+			handler.NewCond(LoginNameDomainNameCol, e.Domain),
+			handler.NewCond(LoginNameDomainResourceOwnerCol, e.Aggregate().ResourceOwner),
+			handler.NewCond(LoginNameDomainInstanceIDCol, e.Aggregate().InstanceID),
+		},
+		handler.WithTableSuffix(loginNameDomainSuffix),
+	), nil
+}
+
 func (p *loginNameProjection) reduceInstanceRemoved(event eventstore.Event) (*handler.Statement, error) {
 	e, ok := event.(*instance.InstanceRemovedEvent)
 	if !ok {
@@ -368,6 +368,36 @@ func TestLoginNameProjection_reduces(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "org OrgDomainUnverifiedEventType",
+			args: args{
+				event: getEvent(
+					testEvent(
+						org.OrgDomainUnverifiedEventType,
+						org.AggregateType,
+						[]byte(`{
+						"domain": "unverified"
+					}`),
+					), org.DomainUnverifiedEventMapper),
+			},
+			reduce: (&loginNameProjection{}).reduceDomainUnverified,
+			want: wantReduce{
+				aggregateType: org.AggregateType,
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "DELETE FROM projections.login_names3_domains WHERE (name = $1) AND (resource_owner = $2) AND (instance_id = $3)",
+							expectedArgs: []interface{}{
+								"unverified",
+								"ro-id",
+								"instance-id",
+							},
+						},
+					},
+				},
+			},
+		},
 		{
 			name: "org OrgDomainPrimarySetEventType",
 			args: args{
@@ -13,7 +13,7 @@ import (
 )
 
 const (
-	LoginPolicyTable = "projections.login_policies5"
+	LoginPolicyTable = "projections.login_policies8"
 
 	LoginPolicyIDCol                    = "aggregate_id"
 	LoginPolicyInstanceIDCol            = "instance_id"
@@ -40,6 +40,12 @@ const (
 	MFAInitSkipLifetimeCol              = "mfa_init_skip_lifetime"
 	SecondFactorCheckLifetimeCol        = "second_factor_check_lifetime"
 	MultiFactorCheckLifetimeCol         = "multi_factor_check_lifetime"
+	TrustedDeviceLifetimeCol            = "trusted_device_lifetime"
+	SharedDeviceCol                     = "shared_device"
+	SharedDeviceLifetimeCol             = "shared_device_lifetime"
+	SharedDeviceIdleTimeoutCol          = "shared_device_idle_timeout"
+	PasskeyPromptCol                    = "passkey_prompt"
+	PasskeyPromptSnoozeLifetimeCol      = "passkey_prompt_snooze_lifetime"
 	LoginPolicyOwnerRemovedCol          = "owner_removed"
 )
 
@@ -81,6 +87,12 @@ func (*loginPolicyProjection) Init() *old_handler.Check {
 			handler.NewColumn(MFAInitSkipLifetimeCol, handler.ColumnTypeInt64),
 			handler.NewColumn(SecondFactorCheckLifetimeCol, handler.ColumnTypeInt64),
 			handler.NewColumn(MultiFactorCheckLifetimeCol, handler.ColumnTypeInt64),
+			handler.NewColumn(TrustedDeviceLifetimeCol, handler.ColumnTypeInt64, handler.Default(0)),
+			handler.NewColumn(SharedDeviceCol, handler.ColumnTypeBool, handler.Default(false)),
+			handler.NewColumn(SharedDeviceLifetimeCol, handler.ColumnTypeInt64, handler.Default(0)),
+			handler.NewColumn(SharedDeviceIdleTimeoutCol, handler.ColumnTypeInt64, handler.Default(0)),
+			handler.NewColumn(PasskeyPromptCol, handler.ColumnTypeBool, handler.Default(false)),
+			handler.NewColumn(PasskeyPromptSnoozeLifetimeCol, handler.ColumnTypeInt64, handler.Default(0)),
 			handler.NewColumn(LoginPolicyOwnerRemovedCol, handler.ColumnTypeBool, handler.Default(false)),
 		},
 			handler.NewPrimaryKey(LoginPolicyInstanceIDCol, LoginPolicyIDCol),
@@ -202,6 +214,12 @@ func (p *loginPolicyProjection) reduceLoginPolicyAdded(event eventstore.Event) (
 		handler.NewCol(MFAInitSkipLifetimeCol, policyEvent.MFAInitSkipLifetime),
 		handler.NewCol(SecondFactorCheckLifetimeCol, policyEvent.SecondFactorCheckLifetime),
 		handler.NewCol(MultiFactorCheckLifetimeCol, policyEvent.MultiFactorCheckLifetime),
+		handler.NewCol(TrustedDeviceLifetimeCol, policyEvent.TrustedDeviceLifetime),
+		handler.NewCol(SharedDeviceCol, policyEvent.SharedDevice),
+		handler.NewCol(SharedDeviceLifetimeCol, policyEvent.SharedDeviceLifetime),
+		handler.NewCol(SharedDeviceIdleTimeoutCol, policyEvent.SharedDeviceIdleTimeout),
+		handler.NewCol(PasskeyPromptCol, policyEvent.PasskeyPrompt),
+		handler.NewCol(PasskeyPromptSnoozeLifetimeCol, policyEvent.PasskeyPromptSnoozeLifetime),
 	}), nil
 }
 
@@ -271,6 +289,24 @@ func (p *loginPolicyProjection) reduceLoginPolicyChanged(event eventstore.Event)
 	if policyEvent.MultiFactorCheckLifetime != nil {
 		cols = append(cols, handler.NewCol(MultiFactorCheckLifetimeCol, *policyEvent.MultiFactorCheckLifetime))
 	}
+	if policyEvent.TrustedDeviceLifetime != nil {
+		cols = append(cols, handler.NewCol(TrustedDeviceLifetimeCol, *policyEvent.TrustedDeviceLifetime))
+	}
+	if policyEvent.SharedDevice != nil {
+		cols = append(cols, handler.NewCol(SharedDeviceCol, *policyEvent.SharedDevice))
+	}
+	if policyEvent.SharedDeviceLifetime != nil {
+		cols = append(cols, handler.NewCol(SharedDeviceLifetimeCol, *policyEvent.SharedDeviceLifetime))
+	}
+	if policyEvent.SharedDeviceIdleTimeout != nil {
+		cols = append(cols, handler.NewCol(SharedDeviceIdleTimeoutCol, *policyEvent.SharedDeviceIdleTimeout))
+	}
+	if policyEvent.PasskeyPrompt != nil {
+		cols = append(cols, handler.NewCol(PasskeyPromptCol, *policyEvent.PasskeyPrompt))
+	}
+	if policyEvent.PasskeyPromptSnoozeLifetime != nil {
+		cols = append(cols, handler.NewCol(PasskeyPromptSnoozeLifetimeCol, *policyEvent.PasskeyPromptSnoozeLifetime))
+	}
 
 	return handler.NewUpdateStatement(
 		&policyEvent,
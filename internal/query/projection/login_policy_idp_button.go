@@ -0,0 +1,233 @@
+package projection
+
+import (
+	"context"
+
+	"github.com/zitadel/zitadel/internal/eventstore"
+	old_handler "github.com/zitadel/zitadel/internal/eventstore/handler"
+	"github.com/zitadel/zitadel/internal/eventstore/handler/v2"
+	"github.com/zitadel/zitadel/internal/repository/instance"
+	"github.com/zitadel/zitadel/internal/repository/org"
+	"github.com/zitadel/zitadel/internal/repository/policy"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+const (
+	LoginPolicyIDPButtonsTable = "projections.login_policy_idp_buttons"
+
+	LoginPolicyIDPButtonsInstanceIDCol    = "instance_id"
+	LoginPolicyIDPButtonsResourceOwnerCol = "resource_owner"
+	LoginPolicyIDPButtonsCreationDateCol  = "creation_date"
+	LoginPolicyIDPButtonsChangeDateCol    = "change_date"
+	LoginPolicyIDPButtonsSequenceCol      = "sequence"
+	LoginPolicyIDPButtonsButtonsCol       = "buttons"
+
+	LoginPolicyIDPIconSuffix           = "icons"
+	LoginPolicyIDPIconInstanceIDCol    = "instance_id"
+	LoginPolicyIDPIconResourceOwnerCol = "resource_owner"
+	LoginPolicyIDPIconIDPIDCol         = "idp_id"
+	LoginPolicyIDPIconStoreKeyCol      = "store_key"
+)
+
+// loginPolicyIDPButtonsProjection contains the order and visibility of the identity provider buttons
+// of the login policies and the custom icons of the buttons in a separate table.
+type loginPolicyIDPButtonsProjection struct{}
+
+func newLoginPolicyIDPButtonsProjection(ctx context.Context, config handler.Config) *handler.Handler {
+	return handler.NewHandler(ctx, &config, new(loginPolicyIDPButtonsProjection))
+}
+
+func (*loginPolicyIDPButtonsProjection) Name() string {
+	return LoginPolicyIDPButtonsTable
+}
+
+func (*loginPolicyIDPButtonsProjection) Init() *old_handler.Check {
+	return handler.NewMultiTableCheck(
+		handler.NewTable([]*handler.InitColumn{
+			handler.NewColumn(LoginPolicyIDPButtonsInstanceIDCol, handler.ColumnTypeText),
+			handler.NewColumn(LoginPolicyIDPButtonsResourceOwnerCol, handler.ColumnTypeText),
+			handler.NewColumn(LoginPolicyIDPButtonsCreationDateCol, handler.ColumnTypeTimestamp),
+			handler.NewColumn(LoginPolicyIDPButtonsChangeDateCol, handler.ColumnTypeTimestamp),
+			handler.NewColumn(LoginPolicyIDPButtonsSequenceCol, handler.ColumnTypeInt64),
+			handler.NewColumn(LoginPolicyIDPButtonsButtonsCol, handler.ColumnTypeJSONB, handler.Nullable()),
+		},
+			handler.NewPrimaryKey(LoginPolicyIDPButtonsInstanceIDCol, LoginPolicyIDPButtonsResourceOwnerCol),
+		),
+		handler.NewSuffixedTable([]*handler.InitColumn{
+			handler.NewColumn(LoginPolicyIDPIconInstanceIDCol, handler.ColumnTypeText),
+			handler.NewColumn(LoginPolicyIDPIconResourceOwnerCol, handler.ColumnTypeText),
+			handler.NewColumn(LoginPolicyIDPIconIDPIDCol, handler.ColumnTypeText),
+			handler.NewColumn(LoginPolicyIDPIconStoreKeyCol, handler.ColumnTypeText),
+		},
+			handler.NewPrimaryKey(LoginPolicyIDPIconInstanceIDCol, LoginPolicyIDPIconResourceOwnerCol, LoginPolicyIDPIconIDPIDCol),
+			LoginPolicyIDPIconSuffix,
+			handler.WithForeignKey(handler.NewForeignKey("buttons", []string{LoginPolicyIDPIconInstanceIDCol, LoginPolicyIDPIconResourceOwnerCol}, []string{LoginPolicyIDPButtonsInstanceIDCol, LoginPolicyIDPButtonsResourceOwnerCol})),
+		),
+	)
+}
+
+func (p *loginPolicyIDPButtonsProjection) Reducers() []handler.AggregateReducer {
+	return []handler.AggregateReducer{
+		{
+			Aggregate: org.AggregateType,
+			EventReducers: []handler.EventReducer{
+				{
+					Event:  org.LoginPolicyIDPProviderButtonsSetEventType,
+					Reduce: p.reduceButtonsSet,
+				},
+				{
+					Event:  org.LoginPolicyIDPProviderIconAddedEventType,
+					Reduce: p.reduceIconAdded,
+				},
+				{
+					Event:  org.LoginPolicyIDPProviderIconRemovedEventType,
+					Reduce: p.reduceIconRemoved,
+				},
+				{
+					Event:  org.LoginPolicyRemovedEventType,
+					Reduce: p.reduceRemoved,
+				},
+				{
+					Event:  org.OrgRemovedEventType,
+					Reduce: p.reduceRemoved,
+				},
+			},
+		},
+		{
+			Aggregate: instance.AggregateType,
+			EventReducers: []handler.EventReducer{
+				{
+					Event:  instance.LoginPolicyIDPProviderButtonsSetEventType,
+					Reduce: p.reduceButtonsSet,
+				},
+				{
+					Event:  instance.LoginPolicyIDPProviderIconAddedEventType,
+					Reduce: p.reduceIconAdded,
+				},
+				{
+					Event:  instance.LoginPolicyIDPProviderIconRemovedEventType,
+					Reduce: p.reduceIconRemoved,
+				},
+				{
+					Event:  instance.InstanceRemovedEventType,
+					Reduce: reduceInstanceRemovedHelper(LoginPolicyIDPButtonsInstanceIDCol),
+				},
+			},
+		},
+	}
+}
+
+func (p *loginPolicyIDPButtonsProjection) reduceButtonsSet(event eventstore.Event) (*handler.Statement, error) {
+	var buttonsEvent policy.IdentityProviderButtonsSetEvent
+	switch e := event.(type) {
+	case *org.IdentityProviderButtonsSetEvent:
+		buttonsEvent = e.IdentityProviderButtonsSetEvent
+	case *instance.IdentityProviderButtonsSetEvent:
+		buttonsEvent = e.IdentityProviderButtonsSetEvent
+	default:
+		return nil, zerrors.ThrowInvalidArgumentf(nil, "PROJE-Ib3st", "reduce.wrong.event.type %v", []eventstore.EventType{org.LoginPolicyIDPProviderButtonsSetEventType, instance.LoginPolicyIDPProviderButtonsSetEventType})
+	}
+	return handler.NewUpsertStatement(
+		&buttonsEvent,
+		[]handler.Column{
+			handler.NewCol(LoginPolicyIDPButtonsInstanceIDCol, nil),
+			handler.NewCol(LoginPolicyIDPButtonsResourceOwnerCol, nil),
+		},
+		append(p.ownerCols(&buttonsEvent),
+			handler.NewJSONCol(LoginPolicyIDPButtonsButtonsCol, buttonsEvent.Buttons),
+		),
+	), nil
+}
+
+func (p *loginPolicyIDPButtonsProjection) reduceIconAdded(event eventstore.Event) (*handler.Statement, error) {
+	var iconEvent policy.IdentityProviderIconAddedEvent
+	switch e := event.(type) {
+	case *org.IdentityProviderIconAddedEvent:
+		iconEvent = e.IdentityProviderIconAddedEvent
+	case *instance.IdentityProviderIconAddedEvent:
+		iconEvent = e.IdentityProviderIconAddedEvent
+	default:
+		return nil, zerrors.ThrowInvalidArgumentf(nil, "PROJE-Ib4ad", "reduce.wrong.event.type %v", []eventstore.EventType{org.LoginPolicyIDPProviderIconAddedEventType, instance.LoginPolicyIDPProviderIconAddedEventType})
+	}
+	return handler.NewMultiStatement(
+		&iconEvent,
+		p.addOwnerStatement(&iconEvent),
+		handler.AddUpsertStatement(
+			[]handler.Column{
+				handler.NewCol(LoginPolicyIDPIconInstanceIDCol, nil),
+				handler.NewCol(LoginPolicyIDPIconResourceOwnerCol, nil),
+				handler.NewCol(LoginPolicyIDPIconIDPIDCol, nil),
+			},
+			[]handler.Column{
+				handler.NewCol(LoginPolicyIDPIconInstanceIDCol, iconEvent.Aggregate().InstanceID),
+				handler.NewCol(LoginPolicyIDPIconResourceOwnerCol, iconEvent.Aggregate().ID),
+				handler.NewCol(LoginPolicyIDPIconIDPIDCol, iconEvent.IDPConfigID),
+				handler.NewCol(LoginPolicyIDPIconStoreKeyCol, iconEvent.StoreKey),
+			},
+			handler.WithTableSuffix(LoginPolicyIDPIconSuffix),
+		),
+	), nil
+}
+
+func (p *loginPolicyIDPButtonsProjection) reduceIconRemoved(event eventstore.Event) (*handler.Statement, error) {
+	var iconEvent policy.IdentityProviderIconRemovedEvent
+	switch e := event.(type) {
+	case *org.IdentityProviderIconRemovedEvent:
+		iconEvent = e.IdentityProviderIconRemovedEvent
+	case *instance.IdentityProviderIconRemovedEvent:
+		iconEvent = e.IdentityProviderIconRemovedEvent
+	default:
+		return nil, zerrors.ThrowInvalidArgumentf(nil, "PROJE-Ib5rm", "reduce.wrong.event.type %v", []eventstore.EventType{org.LoginPolicyIDPProviderIconRemovedEventType, instance.LoginPolicyIDPProviderIconRemovedEventType})
+	}
+	return handler.NewMultiStatement(
+		&iconEvent,
+		p.addOwnerStatement(&iconEvent),
+		handler.AddDeleteStatement(
+			[]handler.Condition{
+				handler.NewCond(LoginPolicyIDPIconInstanceIDCol, iconEvent.Aggregate().InstanceID),
+				handler.NewCond(LoginPolicyIDPIconResourceOwnerCol, iconEvent.Aggregate().ID),
+				handler.NewCond(LoginPolicyIDPIconIDPIDCol, iconEvent.IDPConfigID),
+			},
+			handler.WithTableSuffix(LoginPolicyIDPIconSuffix),
+		),
+	), nil
+}
+
+// reduceRemoved removes the buttons of the organization, the icons are removed by the foreign key.
+func (p *loginPolicyIDPButtonsProjection) reduceRemoved(event eventstore.Event) (*handler.Statement, error) {
+	switch event.(type) {
+	case *org.LoginPolicyRemovedEvent,
+		*org.OrgRemovedEvent:
+	default:
+		return nil, zerrors.ThrowInvalidArgumentf(nil, "PROJE-Ib6rm", "reduce.wrong.event.type %v", []eventstore.EventType{org.LoginPolicyRemovedEventType, org.OrgRemovedEventType})
+	}
+	return handler.NewDeleteStatement(
+		event,
+		[]handler.Condition{
+			handler.NewCond(LoginPolicyIDPButtonsInstanceIDCol, event.Aggregate().InstanceID),
+			handler.NewCond(LoginPolicyIDPButtonsResourceOwnerCol, event.Aggregate().ID),
+		},
+	), nil
+}
+
+// addOwnerStatement creates the row of the owner if the icon is changed before the buttons were set,
+// so the icons always have a parent row.
+func (p *loginPolicyIDPButtonsProjection) addOwnerStatement(event eventstore.Event) func(eventstore.Event) handler.Exec {
+	return handler.AddUpsertStatement(
+		[]handler.Column{
+			handler.NewCol(LoginPolicyIDPButtonsInstanceIDCol, nil),
+			handler.NewCol(LoginPolicyIDPButtonsResourceOwnerCol, nil),
+		},
+		p.ownerCols(event),
+	)
+}
+
+func (p *loginPolicyIDPButtonsProjection) ownerCols(event eventstore.Event) []handler.Column {
+	return []handler.Column{
+		handler.NewCol(LoginPolicyIDPButtonsInstanceIDCol, event.Aggregate().InstanceID),
+		handler.NewCol(LoginPolicyIDPButtonsResourceOwnerCol, event.Aggregate().ID),
+		handler.NewCol(LoginPolicyIDPButtonsCreationDateCol, handler.OnlySetValueOnInsert(LoginPolicyIDPButtonsTable, event.CreatedAt())),
+		handler.NewCol(LoginPolicyIDPButtonsChangeDateCol, event.CreatedAt()),
+		handler.NewCol(LoginPolicyIDPButtonsSequenceCol, event.Sequence()),
+	}
+}
@@ -0,0 +1,198 @@
+package projection
+
+import (
+	"testing"
+
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/eventstore/handler/v2"
+	"github.com/zitadel/zitadel/internal/repository/instance"
+	"github.com/zitadel/zitadel/internal/repository/org"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+func TestLoginPolicyIDPButtonsProjection_reduces(t *testing.T) {
+	type args struct {
+		event func(t *testing.T) eventstore.Event
+	}
+	tests := []struct {
+		name   string
+		args   args
+		reduce func(event eventstore.Event) (*handler.Statement, error)
+		want   wantReduce
+	}{
+		{
+			name: "org reduceButtonsSet",
+			args: args{
+				event: getEvent(
+					testEvent(
+						org.LoginPolicyIDPProviderButtonsSetEventType,
+						org.AggregateType,
+						[]byte(`{"buttons": [{"idpId": "idp-id", "domains": ["example.com"]}]}`),
+					), org.IdentityProviderButtonsSetEventMapper),
+			},
+			reduce: (&loginPolicyIDPButtonsProjection{}).reduceButtonsSet,
+			want: wantReduce{
+				aggregateType: eventstore.AggregateType("org"),
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "INSERT INTO projections.login_policy_idp_buttons (instance_id, resource_owner, creation_date, change_date, sequence, buttons) VALUES ($1, $2, $3, $4, $5, $6) ON CONFLICT (instance_id, resource_owner) DO UPDATE SET (creation_date, change_date, sequence, buttons) = (projections.login_policy_idp_buttons.creation_date, EXCLUDED.change_date, EXCLUDED.sequence, EXCLUDED.buttons)",
+							expectedArgs: []interface{}{
+								"instance-id",
+								"agg-id",
+								anyArg{},
+								anyArg{},
+								uint64(15),
+								[]byte(`[{"idpId":"idp-id","domains":["example.com"]}]`),
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "instance reduceIconAdded",
+			args: args{
+				event: getEvent(
+					testEvent(
+						instance.LoginPolicyIDPProviderIconAddedEventType,
+						instance.AggregateType,
+						[]byte(`{"idpConfigId": "idp-id", "storeKey": "store-key"}`),
+					), instance.IdentityProviderIconAddedEventMapper),
+			},
+			reduce: (&loginPolicyIDPButtonsProjection{}).reduceIconAdded,
+			want: wantReduce{
+				aggregateType: eventstore.AggregateType("instance"),
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "INSERT INTO projections.login_policy_idp_buttons (instance_id, resource_owner, creation_date, change_date, sequence) VALUES ($1, $2, $3, $4, $5) ON CONFLICT (instance_id, resource_owner) DO UPDATE SET (creation_date, change_date, sequence) = (projections.login_policy_idp_buttons.creation_date, EXCLUDED.change_date, EXCLUDED.sequence)",
+							expectedArgs: []interface{}{
+								"instance-id",
+								"agg-id",
+								anyArg{},
+								anyArg{},
+								uint64(15),
+							},
+						},
+						{
+							expectedStmt: "INSERT INTO projections.login_policy_idp_buttons_icons (instance_id, resource_owner, idp_id, store_key) VALUES ($1, $2, $3, $4) ON CONFLICT (instance_id, resource_owner, idp_id) DO UPDATE SET store_key = EXCLUDED.store_key",
+							expectedArgs: []interface{}{
+								"instance-id",
+								"agg-id",
+								"idp-id",
+								"store-key",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "org reduceIconRemoved",
+			args: args{
+				event: getEvent(
+					testEvent(
+						org.LoginPolicyIDPProviderIconRemovedEventType,
+						org.AggregateType,
+						[]byte(`{"idpConfigId": "idp-id", "storeKey": "store-key"}`),
+					), org.IdentityProviderIconRemovedEventMapper),
+			},
+			reduce: (&loginPolicyIDPButtonsProjection{}).reduceIconRemoved,
+			want: wantReduce{
+				aggregateType: eventstore.AggregateType("org"),
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "INSERT INTO projections.login_policy_idp_buttons (instance_id, resource_owner, creation_date, change_date, sequence) VALUES ($1, $2, $3, $4, $5) ON CONFLICT (instance_id, resource_owner) DO UPDATE SET (creation_date, change_date, sequence) = (projections.login_policy_idp_buttons.creation_date, EXCLUDED.change_date, EXCLUDED.sequence)",
+							expectedArgs: []interface{}{
+								"instance-id",
+								"agg-id",
+								anyArg{},
+								anyArg{},
+								uint64(15),
+							},
+						},
+						{
+							expectedStmt: "DELETE FROM projections.login_policy_idp_buttons_icons WHERE (instance_id = $1) AND (resource_owner = $2) AND (idp_id = $3)",
+							expectedArgs: []interface{}{
+								"instance-id",
+								"agg-id",
+								"idp-id",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "org reduceLoginPolicyRemoved",
+			args: args{
+				event: getEvent(
+					testEvent(
+						org.LoginPolicyRemovedEventType,
+						org.AggregateType,
+						nil,
+					), org.LoginPolicyRemovedEventMapper),
+			},
+			reduce: (&loginPolicyIDPButtonsProjection{}).reduceRemoved,
+			want: wantReduce{
+				aggregateType: eventstore.AggregateType("org"),
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "DELETE FROM projections.login_policy_idp_buttons WHERE (instance_id = $1) AND (resource_owner = $2)",
+							expectedArgs: []interface{}{
+								"instance-id",
+								"agg-id",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "instance reduceInstanceRemoved",
+			args: args{
+				event: getEvent(
+					testEvent(
+						instance.InstanceRemovedEventType,
+						instance.AggregateType,
+						nil,
+					), instance.InstanceRemovedEventMapper),
+			},
+			reduce: reduceInstanceRemovedHelper(LoginPolicyIDPButtonsInstanceIDCol),
+			want: wantReduce{
+				aggregateType: eventstore.AggregateType("instance"),
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "DELETE FROM projections.login_policy_idp_buttons WHERE (instance_id = $1)",
+							expectedArgs: []interface{}{
+								"agg-id",
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			event := baseEvent(t)
+			got, err := tt.reduce(event)
+			if ok := zerrors.IsErrorInvalidArgument(err); !ok {
+				t.Errorf("no wrong event mapping: %v, got: %v", err, got)
+			}
+
+			event = tt.args.event(t)
+			got, err = tt.reduce(event)
+			assertReduce(t, got, err, LoginPolicyIDPButtonsTable, tt.want)
+		})
+	}
+}
@@ -0,0 +1,224 @@
+package projection
+
+import (
+	"context"
+
+	"github.com/zitadel/zitadel/internal/eventstore"
+	old_handler "github.com/zitadel/zitadel/internal/eventstore/handler"
+	"github.com/zitadel/zitadel/internal/eventstore/handler/v2"
+	"github.com/zitadel/zitadel/internal/repository/instance"
+	"github.com/zitadel/zitadel/internal/repository/org"
+	"github.com/zitadel/zitadel/internal/repository/project"
+)
+
+const (
+	NetworkAccessPolicyTable = "projections.network_access_policies"
+
+	// NetworkAccessPolicyIDCol is the id of the organization or of the application the policy belongs to
+	NetworkAccessPolicyIDCol            = "id"
+	NetworkAccessPolicyInstanceIDCol    = "instance_id"
+	NetworkAccessPolicyResourceOwnerCol = "resource_owner"
+	// NetworkAccessPolicyProjectIDCol and NetworkAccessPolicyClientIDCol are empty for policies of organizations
+	NetworkAccessPolicyProjectIDCol  = "project_id"
+	NetworkAccessPolicyClientIDCol   = "client_id"
+	NetworkAccessPolicyChangeDateCol = "change_date"
+	NetworkAccessPolicySequenceCol   = "sequence"
+	NetworkAccessPolicyRulesCol      = "rules"
+)
+
+type networkAccessPolicyProjection struct{}
+
+func newNetworkAccessPolicyProjection(ctx context.Context, config handler.Config) *handler.Handler {
+	return handler.NewHandler(ctx, &config, new(networkAccessPolicyProjection))
+}
+
+func (*networkAccessPolicyProjection) Name() string {
+	return NetworkAccessPolicyTable
+}
+
+func (*networkAccessPolicyProjection) Init() *old_handler.Check {
+	return handler.NewTableCheck(
+		handler.NewTable([]*handler.InitColumn{
+			handler.NewColumn(NetworkAccessPolicyIDCol, handler.ColumnTypeText),
+			handler.NewColumn(NetworkAccessPolicyInstanceIDCol, handler.ColumnTypeText),
+			handler.NewColumn(NetworkAccessPolicyResourceOwnerCol, handler.ColumnTypeText),
+			handler.NewColumn(NetworkAccessPolicyProjectIDCol, handler.ColumnTypeText, handler.Default("")),
+			handler.NewColumn(NetworkAccessPolicyClientIDCol, handler.ColumnTypeText, handler.Default("")),
+			handler.NewColumn(NetworkAccessPolicyChangeDateCol, handler.ColumnTypeTimestamp),
+			handler.NewColumn(NetworkAccessPolicySequenceCol, handler.ColumnTypeInt64),
+			handler.NewColumn(NetworkAccessPolicyRulesCol, handler.ColumnTypeJSONB),
+		},
+			handler.NewPrimaryKey(NetworkAccessPolicyInstanceIDCol, NetworkAccessPolicyIDCol),
+			handler.WithIndex(handler.NewIndex("client_id", []string{NetworkAccessPolicyInstanceIDCol, NetworkAccessPolicyClientIDCol})),
+		),
+	)
+}
+
+func (p *networkAccessPolicyProjection) Reducers() []handler.AggregateReducer {
+	return []handler.AggregateReducer{
+		{
+			Aggregate: org.AggregateType,
+			EventReducers: []handler.EventReducer{
+				{
+					Event:  org.NetworkAccessPolicySetEventType,
+					Reduce: p.reduceOrgPolicySet,
+				},
+				{
+					Event:  org.NetworkAccessPolicyRemovedEventType,
+					Reduce: p.reduceOrgPolicyRemoved,
+				},
+				{
+					Event:  org.OrgRemovedEventType,
+					Reduce: p.reduceOwnerRemoved,
+				},
+			},
+		},
+		{
+			Aggregate: project.AggregateType,
+			EventReducers: []handler.EventReducer{
+				{
+					Event:  project.NetworkAccessPolicySetType,
+					Reduce: p.reduceAppPolicySet,
+				},
+				{
+					Event:  project.NetworkAccessPolicyRemovedType,
+					Reduce: p.reduceAppPolicyRemoved,
+				},
+				{
+					Event:  project.ApplicationRemovedType,
+					Reduce: p.reduceAppRemoved,
+				},
+				{
+					Event:  project.ProjectRemovedType,
+					Reduce: p.reduceProjectRemoved,
+				},
+			},
+		},
+		{
+			Aggregate: instance.AggregateType,
+			EventReducers: []handler.EventReducer{
+				{
+					Event:  instance.InstanceRemovedEventType,
+					Reduce: reduceInstanceRemovedHelper(NetworkAccessPolicyInstanceIDCol),
+				},
+			},
+		},
+	}
+}
+
+func (p *networkAccessPolicyProjection) reduceOrgPolicySet(event eventstore.Event) (*handler.Statement, error) {
+	e, err := assertEvent[*org.NetworkAccessPolicySetEvent](event)
+	if err != nil {
+		return nil, err
+	}
+	return handler.NewUpsertStatement(
+		e,
+		[]handler.Column{
+			handler.NewCol(NetworkAccessPolicyInstanceIDCol, nil),
+			handler.NewCol(NetworkAccessPolicyIDCol, nil),
+		},
+		[]handler.Column{
+			handler.NewCol(NetworkAccessPolicyInstanceIDCol, e.Aggregate().InstanceID),
+			handler.NewCol(NetworkAccessPolicyIDCol, e.Aggregate().ID),
+			handler.NewCol(NetworkAccessPolicyResourceOwnerCol, e.Aggregate().ID),
+			handler.NewCol(NetworkAccessPolicyChangeDateCol, e.CreationDate()),
+			handler.NewCol(NetworkAccessPolicySequenceCol, e.Sequence()),
+			handler.NewJSONCol(NetworkAccessPolicyRulesCol, e.NetworkAccessRules),
+		},
+	), nil
+}
+
+func (p *networkAccessPolicyProjection) reduceOrgPolicyRemoved(event eventstore.Event) (*handler.Statement, error) {
+	e, err := assertEvent[*org.NetworkAccessPolicyRemovedEvent](event)
+	if err != nil {
+		return nil, err
+	}
+	return handler.NewDeleteStatement(
+		e,
+		[]handler.Condition{
+			handler.NewCond(NetworkAccessPolicyInstanceIDCol, e.Aggregate().InstanceID),
+			handler.NewCond(NetworkAccessPolicyIDCol, e.Aggregate().ID),
+		},
+	), nil
+}
+
+// reduceOwnerRemoved removes the policies of the organization and of the applications it owns.
+func (p *networkAccessPolicyProjection) reduceOwnerRemoved(event eventstore.Event) (*handler.Statement, error) {
+	e, err := assertEvent[*org.OrgRemovedEvent](event)
+	if err != nil {
+		return nil, err
+	}
+	return handler.NewDeleteStatement(
+		e,
+		[]handler.Condition{
+			handler.NewCond(NetworkAccessPolicyInstanceIDCol, e.Aggregate().InstanceID),
+			handler.NewCond(NetworkAccessPolicyResourceOwnerCol, e.Aggregate().ID),
+		},
+	), nil
+}
+
+func (p *networkAccessPolicyProjection) reduceAppPolicySet(event eventstore.Event) (*handler.Statement, error) {
+	e, err := assertEvent[*project.NetworkAccessPolicySetEvent](event)
+	if err != nil {
+		return nil, err
+	}
+	return handler.NewUpsertStatement(
+		e,
+		[]handler.Column{
+			handler.NewCol(NetworkAccessPolicyInstanceIDCol, nil),
+			handler.NewCol(NetworkAccessPolicyIDCol, nil),
+		},
+		[]handler.Column{
+			handler.NewCol(NetworkAccessPolicyInstanceIDCol, e.Aggregate().InstanceID),
+			handler.NewCol(NetworkAccessPolicyIDCol, e.AppID),
+			handler.NewCol(NetworkAccessPolicyResourceOwnerCol, e.Aggregate().ResourceOwner),
+			handler.NewCol(NetworkAccessPolicyProjectIDCol, e.Aggregate().ID),
+			handler.NewCol(NetworkAccessPolicyClientIDCol, e.ClientID),
+			handler.NewCol(NetworkAccessPolicyChangeDateCol, e.CreationDate()),
+			handler.NewCol(NetworkAccessPolicySequenceCol, e.Sequence()),
+			handler.NewJSONCol(NetworkAccessPolicyRulesCol, e.NetworkAccessRules),
+		},
+	), nil
+}
+
+func (p *networkAccessPolicyProjection) reduceAppPolicyRemoved(event eventstore.Event) (*handler.Statement, error) {
+	e, err := assertEvent[*project.NetworkAccessPolicyRemovedEvent](event)
+	if err != nil {
+		return nil, err
+	}
+	return handler.NewDeleteStatement(
+		e,
+		[]handler.Condition{
+			handler.NewCond(NetworkAccessPolicyInstanceIDCol, e.Aggregate().InstanceID),
+			handler.NewCond(NetworkAccessPolicyIDCol, e.AppID),
+		},
+	), nil
+}
+
+func (p *networkAccessPolicyProjection) reduceAppRemoved(event eventstore.Event) (*handler.Statement, error) {
+	e, err := assertEvent[*project.ApplicationRemovedEvent](event)
+	if err != nil {
+		return nil, err
+	}
+	return handler.NewDeleteStatement(
+		e,
+		[]handler.Condition{
+			handler.NewCond(NetworkAccessPolicyInstanceIDCol, e.Aggregate().InstanceID),
+			handler.NewCond(NetworkAccessPolicyIDCol, e.AppID),
+		},
+	), nil
+}
+
+func (p *networkAccessPolicyProjection) reduceProjectRemoved(event eventstore.Event) (*handler.Statement, error) {
+	e, err := assertEvent[*project.ProjectRemovedEvent](event)
+	if err != nil {
+		return nil, err
+	}
+	return handler.NewDeleteStatement(
+		e,
+		[]handler.Condition{
+			handler.NewCond(NetworkAccessPolicyInstanceIDCol, e.Aggregate().InstanceID),
+			handler.NewCond(NetworkAccessPolicyProjectIDCol, e.Aggregate().ID),
+		},
+	), nil
+}
@@ -0,0 +1,263 @@
+package projection
+
+import (
+	"testing"
+
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/eventstore/handler/v2"
+	"github.com/zitadel/zitadel/internal/repository/instance"
+	"github.com/zitadel/zitadel/internal/repository/org"
+	"github.com/zitadel/zitadel/internal/repository/project"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+func TestNetworkAccessPolicyProjection_reduces(t *testing.T) {
+	type args struct {
+		event func(t *testing.T) eventstore.Event
+	}
+	tests := []struct {
+		name   string
+		args   args
+		reduce func(event eventstore.Event) (*handler.Statement, error)
+		want   wantReduce
+	}{
+		{
+			name: "org reduceOrgPolicySet",
+			args: args{
+				event: getEvent(
+					testEvent(
+						org.NetworkAccessPolicySetEventType,
+						org.AggregateType,
+						[]byte(`{"allowedCidrs": ["10.0.0.0/8"]}`),
+					), org.NetworkAccessPolicySetEventMapper),
+			},
+			reduce: (&networkAccessPolicyProjection{}).reduceOrgPolicySet,
+			want: wantReduce{
+				aggregateType: eventstore.AggregateType("org"),
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "INSERT INTO projections.network_access_policies (instance_id, id, resource_owner, change_date, sequence, rules) VALUES ($1, $2, $3, $4, $5, $6) ON CONFLICT (instance_id, id) DO UPDATE SET (resource_owner, change_date, sequence, rules) = (EXCLUDED.resource_owner, EXCLUDED.change_date, EXCLUDED.sequence, EXCLUDED.rules)",
+							expectedArgs: []interface{}{
+								"instance-id",
+								"agg-id",
+								"agg-id",
+								anyArg{},
+								uint64(15),
+								[]byte(`{"allowedCidrs":["10.0.0.0/8"]}`),
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "org reduceOrgPolicyRemoved",
+			args: args{
+				event: getEvent(
+					testEvent(
+						org.NetworkAccessPolicyRemovedEventType,
+						org.AggregateType,
+						nil,
+					), org.NetworkAccessPolicyRemovedEventMapper),
+			},
+			reduce: (&networkAccessPolicyProjection{}).reduceOrgPolicyRemoved,
+			want: wantReduce{
+				aggregateType: eventstore.AggregateType("org"),
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "DELETE FROM projections.network_access_policies WHERE (instance_id = $1) AND (id = $2)",
+							expectedArgs: []interface{}{
+								"instance-id",
+								"agg-id",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "org reduceOwnerRemoved",
+			args: args{
+				event: getEvent(
+					testEvent(
+						org.OrgRemovedEventType,
+						org.AggregateType,
+						nil,
+					), org.OrgRemovedEventMapper),
+			},
+			reduce: (&networkAccessPolicyProjection{}).reduceOwnerRemoved,
+			want: wantReduce{
+				aggregateType: eventstore.AggregateType("org"),
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "DELETE FROM projections.network_access_policies WHERE (instance_id = $1) AND (resource_owner = $2)",
+							expectedArgs: []interface{}{
+								"instance-id",
+								"agg-id",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "project reduceAppPolicySet",
+			args: args{
+				event: getEvent(
+					testEvent(
+						project.NetworkAccessPolicySetType,
+						project.AggregateType,
+						[]byte(`{"appId": "app-id", "clientId": "client-id", "deniedCountries": ["CH"]}`),
+					), project.NetworkAccessPolicySetEventMapper),
+			},
+			reduce: (&networkAccessPolicyProjection{}).reduceAppPolicySet,
+			want: wantReduce{
+				aggregateType: eventstore.AggregateType("project"),
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "INSERT INTO projections.network_access_policies (instance_id, id, resource_owner, project_id, client_id, change_date, sequence, rules) VALUES ($1, $2, $3, $4, $5, $6, $7, $8) ON CONFLICT (instance_id, id) DO UPDATE SET (resource_owner, project_id, client_id, change_date, sequence, rules) = (EXCLUDED.resource_owner, EXCLUDED.project_id, EXCLUDED.client_id, EXCLUDED.change_date, EXCLUDED.sequence, EXCLUDED.rules)",
+							expectedArgs: []interface{}{
+								"instance-id",
+								"app-id",
+								"ro-id",
+								"agg-id",
+								"client-id",
+								anyArg{},
+								uint64(15),
+								[]byte(`{"deniedCountries":["CH"]}`),
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "project reduceAppPolicyRemoved",
+			args: args{
+				event: getEvent(
+					testEvent(
+						project.NetworkAccessPolicyRemovedType,
+						project.AggregateType,
+						[]byte(`{"appId": "app-id", "clientId": "client-id"}`),
+					), project.NetworkAccessPolicyRemovedEventMapper),
+			},
+			reduce: (&networkAccessPolicyProjection{}).reduceAppPolicyRemoved,
+			want: wantReduce{
+				aggregateType: eventstore.AggregateType("project"),
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "DELETE FROM projections.network_access_policies WHERE (instance_id = $1) AND (id = $2)",
+							expectedArgs: []interface{}{
+								"instance-id",
+								"app-id",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "project reduceAppRemoved",
+			args: args{
+				event: getEvent(
+					testEvent(
+						project.ApplicationRemovedType,
+						project.AggregateType,
+						[]byte(`{"appId": "app-id"}`),
+					), project.ApplicationRemovedEventMapper),
+			},
+			reduce: (&networkAccessPolicyProjection{}).reduceAppRemoved,
+			want: wantReduce{
+				aggregateType: eventstore.AggregateType("project"),
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "DELETE FROM projections.network_access_policies WHERE (instance_id = $1) AND (id = $2)",
+							expectedArgs: []interface{}{
+								"instance-id",
+								"app-id",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "project reduceProjectRemoved",
+			args: args{
+				event: getEvent(
+					testEvent(
+						project.ProjectRemovedType,
+						project.AggregateType,
+						nil,
+					), project.ProjectRemovedEventMapper),
+			},
+			reduce: (&networkAccessPolicyProjection{}).reduceProjectRemoved,
+			want: wantReduce{
+				aggregateType: eventstore.AggregateType("project"),
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "DELETE FROM projections.network_access_policies WHERE (instance_id = $1) AND (project_id = $2)",
+							expectedArgs: []interface{}{
+								"instance-id",
+								"agg-id",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "instance reduceInstanceRemoved",
+			args: args{
+				event: getEvent(
+					testEvent(
+						instance.InstanceRemovedEventType,
+						instance.AggregateType,
+						nil,
+					), instance.InstanceRemovedEventMapper),
+			},
+			reduce: reduceInstanceRemovedHelper(NetworkAccessPolicyInstanceIDCol),
+			want: wantReduce{
+				aggregateType: eventstore.AggregateType("instance"),
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "DELETE FROM projections.network_access_policies WHERE (instance_id = $1)",
+							expectedArgs: []interface{}{
+								"agg-id",
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			event := baseEvent(t)
+			got, err := tt.reduce(event)
+			if !zerrors.IsErrorInvalidArgument(err) {
+				t.Errorf("no wrong event mapping: %v, got: %v", err, got)
+			}
+
+			event = tt.args.event(t)
+			got, err = tt.reduce(event)
+			assertReduce(t, got, err, NetworkAccessPolicyTable, tt.want)
+		})
+	}
+}
@@ -0,0 +1,203 @@
+package projection
+
+import (
+	"context"
+
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	old_handler "github.com/zitadel/zitadel/internal/eventstore/handler"
+	"github.com/zitadel/zitadel/internal/eventstore/handler/v2"
+	"github.com/zitadel/zitadel/internal/repository/instance"
+	"github.com/zitadel/zitadel/internal/repository/notification"
+)
+
+const (
+	NotificationQueueTable                    = "projections.notification_queue"
+	NotificationQueueInstanceIDCol            = "instance_id"
+	NotificationQueueIDCol                    = "id"
+	NotificationQueueCreationDateCol          = "creation_date"
+	NotificationQueueChangeDateCol            = "change_date"
+	NotificationQueueSequenceCol              = "sequence"
+	NotificationQueueChannelCol               = "channel"
+	NotificationQueueRecipientCol             = "recipient"
+	NotificationQueueTriggeringAggregateIDCol = "triggering_aggregate_id"
+	NotificationQueueTriggeringEventTypeCol   = "triggering_event_type"
+	NotificationQueueMessageCol               = "message"
+	NotificationQueueStateCol                 = "state"
+	NotificationQueueAttemptsCol              = "attempts"
+	NotificationQueueNextAttemptCol           = "next_attempt"
+	NotificationQueueLastErrorCol             = "last_error"
+)
+
+type notificationQueueProjection struct{}
+
+func newNotificationQueueProjection(ctx context.Context, config handler.Config) *handler.Handler {
+	return handler.NewHandler(ctx, &config, new(notificationQueueProjection))
+}
+
+func (*notificationQueueProjection) Name() string {
+	return NotificationQueueTable
+}
+
+func (*notificationQueueProjection) Init() *old_handler.Check {
+	return handler.NewTableCheck(
+		handler.NewTable([]*handler.InitColumn{
+			handler.NewColumn(NotificationQueueInstanceIDCol, handler.ColumnTypeText),
+			handler.NewColumn(NotificationQueueIDCol, handler.ColumnTypeText),
+			handler.NewColumn(NotificationQueueCreationDateCol, handler.ColumnTypeTimestamp),
+			handler.NewColumn(NotificationQueueChangeDateCol, handler.ColumnTypeTimestamp),
+			handler.NewColumn(NotificationQueueSequenceCol, handler.ColumnTypeInt64),
+			handler.NewColumn(NotificationQueueChannelCol, handler.ColumnTypeText),
+			handler.NewColumn(NotificationQueueRecipientCol, handler.ColumnTypeText),
+			handler.NewColumn(NotificationQueueTriggeringAggregateIDCol, handler.ColumnTypeText),
+			handler.NewColumn(NotificationQueueTriggeringEventTypeCol, handler.ColumnTypeText),
+			handler.NewColumn(NotificationQueueMessageCol, handler.ColumnTypeJSONB),
+			handler.NewColumn(NotificationQueueStateCol, handler.ColumnTypeEnum),
+			handler.NewColumn(NotificationQueueAttemptsCol, handler.ColumnTypeInt64),
+			handler.NewColumn(NotificationQueueNextAttemptCol, handler.ColumnTypeTimestamp),
+			handler.NewColumn(NotificationQueueLastErrorCol, handler.ColumnTypeText, handler.Nullable()),
+		},
+			handler.NewPrimaryKey(NotificationQueueInstanceIDCol, NotificationQueueIDCol),
+			handler.WithIndex(handler.NewIndex("next_attempt", []string{NotificationQueueStateCol, NotificationQueueNextAttemptCol})),
+		),
+	)
+}
+
+func (p *notificationQueueProjection) Reducers() []handler.AggregateReducer {
+	return []handler.AggregateReducer{
+		{
+			Aggregate: notification.AggregateType,
+			EventReducers: []handler.EventReducer{
+				{
+					Event:  notification.QueuedEventType,
+					Reduce: p.reduceQueued,
+				},
+				{
+					Event:  notification.RetryScheduledEventType,
+					Reduce: p.reduceRetryScheduled,
+				},
+				{
+					Event:  notification.DeadLetteredEventType,
+					Reduce: p.reduceDeadLettered,
+				},
+				{
+					Event:  notification.RequeuedEventType,
+					Reduce: p.reduceRequeued,
+				},
+				{
+					Event:  notification.ResentEventType,
+					Reduce: p.reduceResent,
+				},
+			},
+		},
+		{
+			Aggregate: instance.AggregateType,
+			EventReducers: []handler.EventReducer{
+				{
+					Event:  instance.InstanceRemovedEventType,
+					Reduce: reduceInstanceRemovedHelper(NotificationQueueInstanceIDCol),
+				},
+			},
+		},
+	}
+}
+
+func (p *notificationQueueProjection) reduceQueued(event eventstore.Event) (*handler.Statement, error) {
+	e, err := assertEvent[*notification.QueuedEvent](event)
+	if err != nil {
+		return nil, err
+	}
+	return handler.NewCreateStatement(
+		e,
+		[]handler.Column{
+			handler.NewCol(NotificationQueueInstanceIDCol, e.Aggregate().InstanceID),
+			handler.NewCol(NotificationQueueIDCol, e.Aggregate().ID),
+			handler.NewCol(NotificationQueueCreationDateCol, e.CreationDate()),
+			handler.NewCol(NotificationQueueChangeDateCol, e.CreationDate()),
+			handler.NewCol(NotificationQueueSequenceCol, e.Sequence()),
+			handler.NewCol(NotificationQueueChannelCol, e.Channel),
+			handler.NewCol(NotificationQueueRecipientCol, e.Recipient),
+			handler.NewCol(NotificationQueueTriggeringAggregateIDCol, e.TriggeringAggregateID),
+			handler.NewCol(NotificationQueueTriggeringEventTypeCol, e.TriggeringEventType),
+			handler.NewJSONCol(NotificationQueueMessageCol, e.Message),
+			handler.NewCol(NotificationQueueStateCol, domain.NotificationQueueStatePending),
+			// the first delivery already failed
+			handler.NewCol(NotificationQueueAttemptsCol, 1),
+			handler.NewCol(NotificationQueueNextAttemptCol, e.NextAttempt),
+			handler.NewCol(NotificationQueueLastErrorCol, e.Reason),
+		},
+	), nil
+}
+
+func (p *notificationQueueProjection) reduceRetryScheduled(event eventstore.Event) (*handler.Statement, error) {
+	e, err := assertEvent[*notification.RetryScheduledEvent](event)
+	if err != nil {
+		return nil, err
+	}
+	return handler.NewUpdateStatement(
+		e,
+		[]handler.Column{
+			handler.NewCol(NotificationQueueChangeDateCol, e.CreationDate()),
+			handler.NewCol(NotificationQueueSequenceCol, e.Sequence()),
+			handler.NewIncrementCol(NotificationQueueAttemptsCol, 1),
+			handler.NewCol(NotificationQueueNextAttemptCol, e.NextAttempt),
+			handler.NewCol(NotificationQueueLastErrorCol, e.Reason),
+		},
+		notificationQueueConditions(e),
+	), nil
+}
+
+func (p *notificationQueueProjection) reduceDeadLettered(event eventstore.Event) (*handler.Statement, error) {
+	e, err := assertEvent[*notification.DeadLetteredEvent](event)
+	if err != nil {
+		return nil, err
+	}
+	return handler.NewUpdateStatement(
+		e,
+		[]handler.Column{
+			handler.NewCol(NotificationQueueChangeDateCol, e.CreationDate()),
+			handler.NewCol(NotificationQueueSequenceCol, e.Sequence()),
+			handler.NewIncrementCol(NotificationQueueAttemptsCol, 1),
+			handler.NewCol(NotificationQueueStateCol, domain.NotificationQueueStateDeadLettered),
+			handler.NewCol(NotificationQueueLastErrorCol, e.Reason),
+		},
+		notificationQueueConditions(e),
+	), nil
+}
+
+func (p *notificationQueueProjection) reduceRequeued(event eventstore.Event) (*handler.Statement, error) {
+	e, err := assertEvent[*notification.RequeuedEvent](event)
+	if err != nil {
+		return nil, err
+	}
+	// a requeued notification is retried immediately with the full number of attempts
+	return handler.NewUpdateStatement(
+		e,
+		[]handler.Column{
+			handler.NewCol(NotificationQueueChangeDateCol, e.CreationDate()),
+			handler.NewCol(NotificationQueueSequenceCol, e.Sequence()),
+			handler.NewCol(NotificationQueueStateCol, domain.NotificationQueueStatePending),
+			handler.NewCol(NotificationQueueAttemptsCol, 0),
+			handler.NewCol(NotificationQueueNextAttemptCol, e.CreationDate()),
+		},
+		notificationQueueConditions(e),
+	), nil
+}
+
+func (p *notificationQueueProjection) reduceResent(event eventstore.Event) (*handler.Statement, error) {
+	e, err := assertEvent[*notification.ResentEvent](event)
+	if err != nil {
+		return nil, err
+	}
+	return handler.NewDeleteStatement(
+		e,
+		notificationQueueConditions(e),
+	), nil
+}
+
+func notificationQueueConditions(e eventstore.Event) []handler.Condition {
+	return []handler.Condition{
+		handler.NewCond(NotificationQueueInstanceIDCol, e.Aggregate().InstanceID),
+		handler.NewCond(NotificationQueueIDCol, e.Aggregate().ID),
+	}
+}
@@ -0,0 +1,238 @@
+package projection
+
+import (
+	"testing"
+
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/eventstore/handler/v2"
+	"github.com/zitadel/zitadel/internal/repository/instance"
+	"github.com/zitadel/zitadel/internal/repository/notification"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+func TestNotificationQueueProjection_reduces(t *testing.T) {
+	type args struct {
+		event func(t *testing.T) eventstore.Event
+	}
+	tests := []struct {
+		name   string
+		args   args
+		reduce func(event eventstore.Event) (*handler.Statement, error)
+		want   wantReduce
+	}{
+		{
+			name: "reduceQueued",
+			args: args{
+				event: getEvent(
+					testEvent(
+						notification.QueuedEventType,
+						notification.AggregateType,
+						[]byte(`{"channel": "email", "recipient": "user@example.com", "triggeringAggregateId": "user1", "triggeringEventType": "user.human.initialization.code.added", "message": {"cryptoType": 0, "algorithm": "enc", "keyID": "id", "crypted": "bWVzc2FnZQ=="}, "reason": "smtp unavailable", "nextAttempt": "2024-01-01T00:00:00Z"}`),
+					),
+					notification.QueuedEventMapper,
+				),
+			},
+			reduce: (&notificationQueueProjection{}).reduceQueued,
+			want: wantReduce{
+				aggregateType: eventstore.AggregateType("notification"),
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "INSERT INTO projections.notification_queue (instance_id, id, creation_date, change_date, sequence, channel, recipient, triggering_aggregate_id, triggering_event_type, message, state, attempts, next_attempt, last_error) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14)",
+							expectedArgs: []interface{}{
+								"instance-id",
+								"agg-id",
+								anyArg{},
+								anyArg{},
+								uint64(15),
+								"email",
+								"user@example.com",
+								"user1",
+								eventstore.EventType("user.human.initialization.code.added"),
+								anyArg{},
+								domain.NotificationQueueStatePending,
+								1,
+								anyArg{},
+								"smtp unavailable",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "reduceRetryScheduled",
+			args: args{
+				event: getEvent(
+					testEvent(
+						notification.RetryScheduledEventType,
+						notification.AggregateType,
+						[]byte(`{"reason": "smtp unavailable", "nextAttempt": "2024-01-01T00:00:00Z"}`),
+					),
+					notification.RetryScheduledEventMapper,
+				),
+			},
+			reduce: (&notificationQueueProjection{}).reduceRetryScheduled,
+			want: wantReduce{
+				aggregateType: eventstore.AggregateType("notification"),
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "UPDATE projections.notification_queue SET (change_date, sequence, attempts, next_attempt, last_error) = ($1, $2, attempts + $3, $4, $5) WHERE (instance_id = $6) AND (id = $7)",
+							expectedArgs: []interface{}{
+								anyArg{},
+								uint64(15),
+								1,
+								anyArg{},
+								"smtp unavailable",
+								"instance-id",
+								"agg-id",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "reduceDeadLettered",
+			args: args{
+				event: getEvent(
+					testEvent(
+						notification.DeadLetteredEventType,
+						notification.AggregateType,
+						[]byte(`{"reason": "smtp unavailable"}`),
+					),
+					notification.DeadLetteredEventMapper,
+				),
+			},
+			reduce: (&notificationQueueProjection{}).reduceDeadLettered,
+			want: wantReduce{
+				aggregateType: eventstore.AggregateType("notification"),
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "UPDATE projections.notification_queue SET (change_date, sequence, attempts, state, last_error) = ($1, $2, attempts + $3, $4, $5) WHERE (instance_id = $6) AND (id = $7)",
+							expectedArgs: []interface{}{
+								anyArg{},
+								uint64(15),
+								1,
+								domain.NotificationQueueStateDeadLettered,
+								"smtp unavailable",
+								"instance-id",
+								"agg-id",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "reduceRequeued",
+			args: args{
+				event: getEvent(
+					testEvent(
+						notification.RequeuedEventType,
+						notification.AggregateType,
+						nil,
+					),
+					notification.RequeuedEventMapper,
+				),
+			},
+			reduce: (&notificationQueueProjection{}).reduceRequeued,
+			want: wantReduce{
+				aggregateType: eventstore.AggregateType("notification"),
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "UPDATE projections.notification_queue SET (change_date, sequence, state, attempts, next_attempt) = ($1, $2, $3, $4, $5) WHERE (instance_id = $6) AND (id = $7)",
+							expectedArgs: []interface{}{
+								anyArg{},
+								uint64(15),
+								domain.NotificationQueueStatePending,
+								0,
+								anyArg{},
+								"instance-id",
+								"agg-id",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "reduceResent",
+			args: args{
+				event: getEvent(
+					testEvent(
+						notification.ResentEventType,
+						notification.AggregateType,
+						nil,
+					),
+					notification.ResentEventMapper,
+				),
+			},
+			reduce: (&notificationQueueProjection{}).reduceResent,
+			want: wantReduce{
+				aggregateType: eventstore.AggregateType("notification"),
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "DELETE FROM projections.notification_queue WHERE (instance_id = $1) AND (id = $2)",
+							expectedArgs: []interface{}{
+								"instance-id",
+								"agg-id",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "instance reduceInstanceRemoved",
+			args: args{
+				event: getEvent(
+					testEvent(
+						instance.InstanceRemovedEventType,
+						instance.AggregateType,
+						nil,
+					),
+					instance.InstanceRemovedEventMapper,
+				),
+			},
+			reduce: reduceInstanceRemovedHelper(NotificationQueueInstanceIDCol),
+			want: wantReduce{
+				aggregateType: eventstore.AggregateType("instance"),
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "DELETE FROM projections.notification_queue WHERE (instance_id = $1)",
+							expectedArgs: []interface{}{
+								"agg-id",
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			event := baseEvent(t)
+			got, err := tt.reduce(event)
+			if ok := zerrors.IsErrorInvalidArgument(err); !ok {
+				t.Errorf("no wrong event mapping: %v, got: %v", err, got)
+			}
+
+			event = tt.args.event(t)
+			got, err = tt.reduce(event)
+			assertReduce(t, got, err, NotificationQueueTable, tt.want)
+		})
+	}
+}
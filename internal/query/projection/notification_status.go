@@ -0,0 +1,154 @@
+package projection
+
+import (
+	"context"
+
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	old_handler "github.com/zitadel/zitadel/internal/eventstore/handler"
+	"github.com/zitadel/zitadel/internal/eventstore/handler/v2"
+	"github.com/zitadel/zitadel/internal/repository/instance"
+	"github.com/zitadel/zitadel/internal/repository/notification"
+)
+
+const (
+	NotificationStatusTable                    = "projections.notification_statuses"
+	NotificationStatusInstanceIDCol            = "instance_id"
+	NotificationStatusIDCol                    = "id"
+	NotificationStatusCreationDateCol          = "creation_date"
+	NotificationStatusChangeDateCol            = "change_date"
+	NotificationStatusSequenceCol              = "sequence"
+	NotificationStatusChannelCol               = "channel"
+	NotificationStatusProviderIDCol            = "provider_id"
+	NotificationStatusTriggeringAggregateIDCol = "triggering_aggregate_id"
+	NotificationStatusTriggeringEventTypeCol   = "triggering_event_type"
+	NotificationStatusStatusCol                = "status"
+	NotificationStatusReasonCol                = "reason"
+)
+
+type notificationStatusProjection struct{}
+
+func newNotificationStatusProjection(ctx context.Context, config handler.Config) *handler.Handler {
+	return handler.NewHandler(ctx, &config, new(notificationStatusProjection))
+}
+
+func (*notificationStatusProjection) Name() string {
+	return NotificationStatusTable
+}
+
+func (*notificationStatusProjection) Init() *old_handler.Check {
+	return handler.NewTableCheck(
+		handler.NewTable([]*handler.InitColumn{
+			handler.NewColumn(NotificationStatusInstanceIDCol, handler.ColumnTypeText),
+			handler.NewColumn(NotificationStatusIDCol, handler.ColumnTypeText),
+			handler.NewColumn(NotificationStatusCreationDateCol, handler.ColumnTypeTimestamp),
+			handler.NewColumn(NotificationStatusChangeDateCol, handler.ColumnTypeTimestamp),
+			handler.NewColumn(NotificationStatusSequenceCol, handler.ColumnTypeInt64),
+			handler.NewColumn(NotificationStatusChannelCol, handler.ColumnTypeText),
+			handler.NewColumn(NotificationStatusProviderIDCol, handler.ColumnTypeText),
+			handler.NewColumn(NotificationStatusTriggeringAggregateIDCol, handler.ColumnTypeText, handler.Nullable()),
+			handler.NewColumn(NotificationStatusTriggeringEventTypeCol, handler.ColumnTypeText, handler.Nullable()),
+			handler.NewColumn(NotificationStatusStatusCol, handler.ColumnTypeEnum),
+			handler.NewColumn(NotificationStatusReasonCol, handler.ColumnTypeText, handler.Nullable()),
+		},
+			handler.NewPrimaryKey(NotificationStatusInstanceIDCol, NotificationStatusIDCol),
+		),
+	)
+}
+
+func (p *notificationStatusProjection) Reducers() []handler.AggregateReducer {
+	return []handler.AggregateReducer{
+		{
+			Aggregate: notification.AggregateType,
+			EventReducers: []handler.EventReducer{
+				{
+					Event:  notification.DeliveredEventType,
+					Reduce: p.reduceDelivered,
+				},
+				{
+					Event:  notification.DeliveryFailedEventType,
+					Reduce: p.reduceDeliveryFailed,
+				},
+				{
+					Event:  notification.ReceiptReceivedEventType,
+					Reduce: p.reduceReceiptReceived,
+				},
+			},
+		},
+		{
+			Aggregate: instance.AggregateType,
+			EventReducers: []handler.EventReducer{
+				{
+					Event:  instance.InstanceRemovedEventType,
+					Reduce: reduceInstanceRemovedHelper(NotificationStatusInstanceIDCol),
+				},
+			},
+		},
+	}
+}
+
+func (p *notificationStatusProjection) reduceDelivered(event eventstore.Event) (*handler.Statement, error) {
+	e, err := assertEvent[*notification.DeliveredEvent](event)
+	if err != nil {
+		return nil, err
+	}
+	return p.upsertDelivery(e, e.Delivery, domain.NotificationDeliveryStatusSent, ""), nil
+}
+
+func (p *notificationStatusProjection) reduceDeliveryFailed(event eventstore.Event) (*handler.Statement, error) {
+	e, err := assertEvent[*notification.DeliveryFailedEvent](event)
+	if err != nil {
+		return nil, err
+	}
+	return p.upsertDelivery(e, e.Delivery, domain.NotificationDeliveryStatusFailed, e.Reason), nil
+}
+
+// upsertDelivery overwrites the status of a message on every delivery attempt,
+// so a failover to another provider is reflected in the status
+func (p *notificationStatusProjection) upsertDelivery(event eventstore.Event, delivery notification.Delivery, status domain.NotificationDeliveryStatus, reason string) *handler.Statement {
+	return handler.NewUpsertStatement(
+		event,
+		[]handler.Column{
+			handler.NewCol(NotificationStatusInstanceIDCol, nil),
+			handler.NewCol(NotificationStatusIDCol, nil),
+		},
+		[]handler.Column{
+			handler.NewCol(NotificationStatusInstanceIDCol, event.Aggregate().InstanceID),
+			handler.NewCol(NotificationStatusIDCol, event.Aggregate().ID),
+			handler.NewCol(NotificationStatusCreationDateCol, handler.OnlySetValueOnInsert(NotificationStatusTable, event.CreatedAt())),
+			handler.NewCol(NotificationStatusChangeDateCol, event.CreatedAt()),
+			handler.NewCol(NotificationStatusSequenceCol, event.Sequence()),
+			handler.NewCol(NotificationStatusChannelCol, delivery.Channel),
+			handler.NewCol(NotificationStatusProviderIDCol, delivery.ProviderID),
+			handler.NewCol(NotificationStatusTriggeringAggregateIDCol, delivery.TriggeringAggregateID),
+			handler.NewCol(NotificationStatusTriggeringEventTypeCol, delivery.TriggeringEventType),
+			handler.NewCol(NotificationStatusStatusCol, status),
+			handler.NewCol(NotificationStatusReasonCol, reason),
+		},
+	)
+}
+
+func (p *notificationStatusProjection) reduceReceiptReceived(event eventstore.Event) (*handler.Statement, error) {
+	e, err := assertEvent[*notification.ReceiptReceivedEvent](event)
+	if err != nil {
+		return nil, err
+	}
+	status := domain.NotificationDeliveryStatusDelivered
+	if e.Status == notification.ReceiptStatusFailed {
+		status = domain.NotificationDeliveryStatusUndelivered
+	}
+	return handler.NewUpdateStatement(
+		e,
+		[]handler.Column{
+			handler.NewCol(NotificationStatusChangeDateCol, e.CreatedAt()),
+			handler.NewCol(NotificationStatusSequenceCol, e.Sequence()),
+			handler.NewCol(NotificationStatusStatusCol, status),
+			handler.NewCol(NotificationStatusReasonCol, e.Reason),
+		},
+		[]handler.Condition{
+			handler.NewCond(NotificationStatusInstanceIDCol, e.Aggregate().InstanceID),
+			handler.NewCond(NotificationStatusIDCol, e.Aggregate().ID),
+			handler.NewCond(NotificationStatusProviderIDCol, e.ProviderID),
+		},
+	), nil
+}
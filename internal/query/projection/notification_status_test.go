@@ -0,0 +1,176 @@
+package projection
+
+import (
+	"testing"
+
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/eventstore/handler/v2"
+	"github.com/zitadel/zitadel/internal/repository/instance"
+	"github.com/zitadel/zitadel/internal/repository/notification"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+func TestNotificationStatusProjection_reduces(t *testing.T) {
+	type args struct {
+		event func(t *testing.T) eventstore.Event
+	}
+	tests := []struct {
+		name   string
+		args   args
+		reduce func(event eventstore.Event) (*handler.Statement, error)
+		want   wantReduce
+	}{
+		{
+			name: "reduceDelivered",
+			args: args{
+				event: getEvent(
+					testEvent(
+						notification.DeliveredEventType,
+						notification.AggregateType,
+						[]byte(`{"channel": "sms", "providerId": "provider", "triggeringAggregateId": "user", "triggeringEventType": "user.human.phone.code.added"}`),
+					),
+					notification.DeliveredEventMapper,
+				),
+			},
+			reduce: (&notificationStatusProjection{}).reduceDelivered,
+			want: wantReduce{
+				aggregateType: eventstore.AggregateType("notification"),
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "INSERT INTO projections.notification_statuses (instance_id, id, creation_date, change_date, sequence, channel, provider_id, triggering_aggregate_id, triggering_event_type, status, reason) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11) ON CONFLICT (instance_id, id) DO UPDATE SET (creation_date, change_date, sequence, channel, provider_id, triggering_aggregate_id, triggering_event_type, status, reason) = (projections.notification_statuses.creation_date, EXCLUDED.change_date, EXCLUDED.sequence, EXCLUDED.channel, EXCLUDED.provider_id, EXCLUDED.triggering_aggregate_id, EXCLUDED.triggering_event_type, EXCLUDED.status, EXCLUDED.reason)",
+							expectedArgs: []interface{}{
+								"instance-id",
+								"agg-id",
+								anyArg{},
+								anyArg{},
+								uint64(15),
+								"sms",
+								"provider",
+								"user",
+								eventstore.EventType("user.human.phone.code.added"),
+								domain.NotificationDeliveryStatusSent,
+								"",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "reduceDeliveryFailed",
+			args: args{
+				event: getEvent(
+					testEvent(
+						notification.DeliveryFailedEventType,
+						notification.AggregateType,
+						[]byte(`{"channel": "sms", "providerId": "provider", "reason": "timeout"}`),
+					),
+					notification.DeliveryFailedEventMapper,
+				),
+			},
+			reduce: (&notificationStatusProjection{}).reduceDeliveryFailed,
+			want: wantReduce{
+				aggregateType: eventstore.AggregateType("notification"),
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "INSERT INTO projections.notification_statuses (instance_id, id, creation_date, change_date, sequence, channel, provider_id, triggering_aggregate_id, triggering_event_type, status, reason) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11) ON CONFLICT (instance_id, id) DO UPDATE SET (creation_date, change_date, sequence, channel, provider_id, triggering_aggregate_id, triggering_event_type, status, reason) = (projections.notification_statuses.creation_date, EXCLUDED.change_date, EXCLUDED.sequence, EXCLUDED.channel, EXCLUDED.provider_id, EXCLUDED.triggering_aggregate_id, EXCLUDED.triggering_event_type, EXCLUDED.status, EXCLUDED.reason)",
+							expectedArgs: []interface{}{
+								"instance-id",
+								"agg-id",
+								anyArg{},
+								anyArg{},
+								uint64(15),
+								"sms",
+								"provider",
+								"",
+								eventstore.EventType(""),
+								domain.NotificationDeliveryStatusFailed,
+								"timeout",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "reduceReceiptReceived",
+			args: args{
+				event: getEvent(
+					testEvent(
+						notification.ReceiptReceivedEventType,
+						notification.AggregateType,
+						[]byte(`{"providerId": "provider", "status": "failed", "reason": "unreachable"}`),
+					),
+					notification.ReceiptReceivedEventMapper,
+				),
+			},
+			reduce: (&notificationStatusProjection{}).reduceReceiptReceived,
+			want: wantReduce{
+				aggregateType: eventstore.AggregateType("notification"),
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "UPDATE projections.notification_statuses SET (change_date, sequence, status, reason) = ($1, $2, $3, $4) WHERE (instance_id = $5) AND (id = $6) AND (provider_id = $7)",
+							expectedArgs: []interface{}{
+								anyArg{},
+								uint64(15),
+								domain.NotificationDeliveryStatusUndelivered,
+								"unreachable",
+								"instance-id",
+								"agg-id",
+								"provider",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "reduceInstanceRemoved",
+			args: args{
+				event: getEvent(
+					testEvent(
+						instance.InstanceRemovedEventType,
+						instance.AggregateType,
+						nil,
+					),
+					instance.InstanceRemovedEventMapper,
+				),
+			},
+			reduce: reduceInstanceRemovedHelper(NotificationStatusInstanceIDCol),
+			want: wantReduce{
+				aggregateType: eventstore.AggregateType("instance"),
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "DELETE FROM projections.notification_statuses WHERE (instance_id = $1)",
+							expectedArgs: []interface{}{
+								"agg-id",
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			event := baseEvent(t)
+			got, err := tt.reduce(event)
+			if ok := zerrors.IsErrorInvalidArgument(err); !ok {
+				t.Errorf("no wrong event mapping: %v, got: %v", err, got)
+			}
+
+			event = tt.args.event(t)
+			got, err = tt.reduce(event)
+			assertReduce(t, got, err, NotificationStatusTable, tt.want)
+		})
+	}
+}
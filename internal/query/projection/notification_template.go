@@ -0,0 +1,200 @@
+package projection
+
+import (
+	"context"
+
+	"github.com/zitadel/zitadel/internal/eventstore"
+	old_handler "github.com/zitadel/zitadel/internal/eventstore/handler"
+	"github.com/zitadel/zitadel/internal/eventstore/handler/v2"
+	"github.com/zitadel/zitadel/internal/repository/instance"
+)
+
+const (
+	NotificationLayoutTable            = "projections.notification_layouts"
+	NotificationLayoutInstanceIDCol    = "instance_id"
+	NotificationLayoutLanguageCol      = "language"
+	NotificationLayoutCreationDateCol  = "creation_date"
+	NotificationLayoutChangeDateCol    = "change_date"
+	NotificationLayoutSequenceCol      = "sequence"
+	NotificationLayoutHTMLCol          = "html"
+	NotificationLayoutTextCol          = "text"
+	NotificationLayoutInlineLogoCol    = "inline_logo"
+	NotificationTemplatePartialTable   = NotificationLayoutTable + "_" + notificationTemplatePartialSuffix
+	notificationTemplatePartialSuffix  = "partials"
+	NotificationPartialInstanceIDCol   = "instance_id"
+	NotificationPartialNameCol         = "name"
+	NotificationPartialCreationDateCol = "creation_date"
+	NotificationPartialChangeDateCol   = "change_date"
+	NotificationPartialSequenceCol     = "sequence"
+	NotificationPartialContentCol      = "content"
+)
+
+type notificationTemplateProjection struct{}
+
+func newNotificationTemplateProjection(ctx context.Context, config handler.Config) *handler.Handler {
+	return handler.NewHandler(ctx, &config, new(notificationTemplateProjection))
+}
+
+func (*notificationTemplateProjection) Name() string {
+	return NotificationLayoutTable
+}
+
+func (*notificationTemplateProjection) Init() *old_handler.Check {
+	return handler.NewMultiTableCheck(
+		handler.NewTable([]*handler.InitColumn{
+			handler.NewColumn(NotificationLayoutInstanceIDCol, handler.ColumnTypeText),
+			handler.NewColumn(NotificationLayoutLanguageCol, handler.ColumnTypeText),
+			handler.NewColumn(NotificationLayoutCreationDateCol, handler.ColumnTypeTimestamp),
+			handler.NewColumn(NotificationLayoutChangeDateCol, handler.ColumnTypeTimestamp),
+			handler.NewColumn(NotificationLayoutSequenceCol, handler.ColumnTypeInt64),
+			handler.NewColumn(NotificationLayoutHTMLCol, handler.ColumnTypeText),
+			handler.NewColumn(NotificationLayoutTextCol, handler.ColumnTypeText, handler.Nullable()),
+			handler.NewColumn(NotificationLayoutInlineLogoCol, handler.ColumnTypeBool, handler.Default(false)),
+		},
+			handler.NewPrimaryKey(NotificationLayoutInstanceIDCol, NotificationLayoutLanguageCol),
+		),
+		// partials are shared by all layouts, so they are not bound to a layout by a foreign key
+		handler.NewSuffixedTable([]*handler.InitColumn{
+			handler.NewColumn(NotificationPartialInstanceIDCol, handler.ColumnTypeText),
+			handler.NewColumn(NotificationPartialNameCol, handler.ColumnTypeText),
+			handler.NewColumn(NotificationPartialCreationDateCol, handler.ColumnTypeTimestamp),
+			handler.NewColumn(NotificationPartialChangeDateCol, handler.ColumnTypeTimestamp),
+			handler.NewColumn(NotificationPartialSequenceCol, handler.ColumnTypeInt64),
+			handler.NewColumn(NotificationPartialContentCol, handler.ColumnTypeText),
+		},
+			handler.NewPrimaryKey(NotificationPartialInstanceIDCol, NotificationPartialNameCol),
+			notificationTemplatePartialSuffix,
+		),
+	)
+}
+
+func (p *notificationTemplateProjection) Reducers() []handler.AggregateReducer {
+	return []handler.AggregateReducer{
+		{
+			Aggregate: instance.AggregateType,
+			EventReducers: []handler.EventReducer{
+				{
+					Event:  instance.NotificationLayoutSetEventType,
+					Reduce: p.reduceLayoutSet,
+				},
+				{
+					Event:  instance.NotificationLayoutRemovedEventType,
+					Reduce: p.reduceLayoutRemoved,
+				},
+				{
+					Event:  instance.NotificationTemplatePartialSetEventType,
+					Reduce: p.reducePartialSet,
+				},
+				{
+					Event:  instance.NotificationTemplatePartialRemovedEventType,
+					Reduce: p.reducePartialRemoved,
+				},
+				{
+					Event:  instance.InstanceRemovedEventType,
+					Reduce: p.reduceInstanceRemoved,
+				},
+			},
+		},
+	}
+}
+
+func (p *notificationTemplateProjection) reduceLayoutSet(event eventstore.Event) (*handler.Statement, error) {
+	e, err := assertEvent[*instance.NotificationLayoutSetEvent](event)
+	if err != nil {
+		return nil, err
+	}
+	return handler.NewUpsertStatement(
+		e,
+		[]handler.Column{
+			handler.NewCol(NotificationLayoutInstanceIDCol, nil),
+			handler.NewCol(NotificationLayoutLanguageCol, nil),
+		},
+		[]handler.Column{
+			handler.NewCol(NotificationLayoutInstanceIDCol, e.Aggregate().InstanceID),
+			handler.NewCol(NotificationLayoutLanguageCol, e.Language),
+			handler.NewCol(NotificationLayoutCreationDateCol, handler.OnlySetValueOnInsert(NotificationLayoutTable, e.CreationDate())),
+			handler.NewCol(NotificationLayoutChangeDateCol, e.CreationDate()),
+			handler.NewCol(NotificationLayoutSequenceCol, e.Sequence()),
+			handler.NewCol(NotificationLayoutHTMLCol, e.HTML),
+			handler.NewCol(NotificationLayoutTextCol, e.Text),
+			handler.NewCol(NotificationLayoutInlineLogoCol, e.InlineLogo),
+		},
+	), nil
+}
+
+func (p *notificationTemplateProjection) reduceLayoutRemoved(event eventstore.Event) (*handler.Statement, error) {
+	e, err := assertEvent[*instance.NotificationLayoutRemovedEvent](event)
+	if err != nil {
+		return nil, err
+	}
+	return handler.NewDeleteStatement(
+		e,
+		[]handler.Condition{
+			handler.NewCond(NotificationLayoutInstanceIDCol, e.Aggregate().InstanceID),
+			handler.NewCond(NotificationLayoutLanguageCol, e.Language),
+		},
+	), nil
+}
+
+func (p *notificationTemplateProjection) reducePartialSet(event eventstore.Event) (*handler.Statement, error) {
+	e, err := assertEvent[*instance.NotificationTemplatePartialSetEvent](event)
+	if err != nil {
+		return nil, err
+	}
+	return handler.NewMultiStatement(
+		e,
+		handler.AddUpsertStatement(
+			[]handler.Column{
+				handler.NewCol(NotificationPartialInstanceIDCol, nil),
+				handler.NewCol(NotificationPartialNameCol, nil),
+			},
+			[]handler.Column{
+				handler.NewCol(NotificationPartialInstanceIDCol, e.Aggregate().InstanceID),
+				handler.NewCol(NotificationPartialNameCol, e.Name),
+				handler.NewCol(NotificationPartialCreationDateCol, handler.OnlySetValueOnInsert(NotificationTemplatePartialTable, e.CreationDate())),
+				handler.NewCol(NotificationPartialChangeDateCol, e.CreationDate()),
+				handler.NewCol(NotificationPartialSequenceCol, e.Sequence()),
+				handler.NewCol(NotificationPartialContentCol, e.Content),
+			},
+			handler.WithTableSuffix(notificationTemplatePartialSuffix),
+		),
+	), nil
+}
+
+func (p *notificationTemplateProjection) reducePartialRemoved(event eventstore.Event) (*handler.Statement, error) {
+	e, err := assertEvent[*instance.NotificationTemplatePartialRemovedEvent](event)
+	if err != nil {
+		return nil, err
+	}
+	return handler.NewMultiStatement(
+		e,
+		handler.AddDeleteStatement(
+			[]handler.Condition{
+				handler.NewCond(NotificationPartialInstanceIDCol, e.Aggregate().InstanceID),
+				handler.NewCond(NotificationPartialNameCol, e.Name),
+			},
+			handler.WithTableSuffix(notificationTemplatePartialSuffix),
+		),
+	), nil
+}
+
+func (p *notificationTemplateProjection) reduceInstanceRemoved(event eventstore.Event) (*handler.Statement, error) {
+	e, err := assertEvent[*instance.InstanceRemovedEvent](event)
+	if err != nil {
+		return nil, err
+	}
+	return handler.NewMultiStatement(
+		e,
+		handler.AddDeleteStatement(
+			[]handler.Condition{
+				handler.NewCond(NotificationLayoutInstanceIDCol, e.Aggregate().ID),
+			},
+		),
+		handler.AddDeleteStatement(
+			[]handler.Condition{
+				handler.NewCond(NotificationPartialInstanceIDCol, e.Aggregate().ID),
+			},
+			handler.WithTableSuffix(notificationTemplatePartialSuffix),
+		),
+	), nil
+}
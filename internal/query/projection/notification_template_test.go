@@ -0,0 +1,196 @@
+package projection
+
+import (
+	"testing"
+
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/eventstore/handler/v2"
+	"github.com/zitadel/zitadel/internal/repository/instance"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+func TestNotificationTemplateProjection_reduces(t *testing.T) {
+	type args struct {
+		event func(t *testing.T) eventstore.Event
+	}
+	tests := []struct {
+		name   string
+		args   args
+		reduce func(event eventstore.Event) (*handler.Statement, error)
+		want   wantReduce
+	}{
+		{
+			name: "reduceLayoutSet",
+			args: args{
+				event: getEvent(
+					testEvent(
+						instance.NotificationLayoutSetEventType,
+						instance.AggregateType,
+						[]byte(`{"language": "de", "html": "<html>{{.Text}}</html>", "text": "{{.Text}}", "inlineLogo": true}`),
+					),
+					instance.NotificationLayoutSetEventMapper,
+				),
+			},
+			reduce: (&notificationTemplateProjection{}).reduceLayoutSet,
+			want: wantReduce{
+				aggregateType: eventstore.AggregateType("instance"),
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "INSERT INTO projections.notification_layouts (instance_id, language, creation_date, change_date, sequence, html, text, inline_logo) VALUES ($1, $2, $3, $4, $5, $6, $7, $8) ON CONFLICT (instance_id, language) DO UPDATE SET (creation_date, change_date, sequence, html, text, inline_logo) = (projections.notification_layouts.creation_date, EXCLUDED.change_date, EXCLUDED.sequence, EXCLUDED.html, EXCLUDED.text, EXCLUDED.inline_logo)",
+							expectedArgs: []interface{}{
+								"instance-id",
+								"de",
+								anyArg{},
+								anyArg{},
+								uint64(15),
+								"<html>{{.Text}}</html>",
+								"{{.Text}}",
+								true,
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "reduceLayoutRemoved",
+			args: args{
+				event: getEvent(
+					testEvent(
+						instance.NotificationLayoutRemovedEventType,
+						instance.AggregateType,
+						[]byte(`{"language": "de"}`),
+					),
+					instance.NotificationLayoutRemovedEventMapper,
+				),
+			},
+			reduce: (&notificationTemplateProjection{}).reduceLayoutRemoved,
+			want: wantReduce{
+				aggregateType: eventstore.AggregateType("instance"),
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "DELETE FROM projections.notification_layouts WHERE (instance_id = $1) AND (language = $2)",
+							expectedArgs: []interface{}{
+								"instance-id",
+								"de",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "reducePartialSet",
+			args: args{
+				event: getEvent(
+					testEvent(
+						instance.NotificationTemplatePartialSetEventType,
+						instance.AggregateType,
+						[]byte(`{"name": "footer", "content": "<p>{{.FooterText}}</p>"}`),
+					),
+					instance.NotificationTemplatePartialSetEventMapper,
+				),
+			},
+			reduce: (&notificationTemplateProjection{}).reducePartialSet,
+			want: wantReduce{
+				aggregateType: eventstore.AggregateType("instance"),
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "INSERT INTO projections.notification_layouts_partials (instance_id, name, creation_date, change_date, sequence, content) VALUES ($1, $2, $3, $4, $5, $6) ON CONFLICT (instance_id, name) DO UPDATE SET (creation_date, change_date, sequence, content) = (projections.notification_layouts_partials.creation_date, EXCLUDED.change_date, EXCLUDED.sequence, EXCLUDED.content)",
+							expectedArgs: []interface{}{
+								"instance-id",
+								"footer",
+								anyArg{},
+								anyArg{},
+								uint64(15),
+								"<p>{{.FooterText}}</p>",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "reducePartialRemoved",
+			args: args{
+				event: getEvent(
+					testEvent(
+						instance.NotificationTemplatePartialRemovedEventType,
+						instance.AggregateType,
+						[]byte(`{"name": "footer"}`),
+					),
+					instance.NotificationTemplatePartialRemovedEventMapper,
+				),
+			},
+			reduce: (&notificationTemplateProjection{}).reducePartialRemoved,
+			want: wantReduce{
+				aggregateType: eventstore.AggregateType("instance"),
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "DELETE FROM projections.notification_layouts_partials WHERE (instance_id = $1) AND (name = $2)",
+							expectedArgs: []interface{}{
+								"instance-id",
+								"footer",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "reduceInstanceRemoved",
+			args: args{
+				event: getEvent(
+					testEvent(
+						instance.InstanceRemovedEventType,
+						instance.AggregateType,
+						nil,
+					),
+					instance.InstanceRemovedEventMapper,
+				),
+			},
+			reduce: (&notificationTemplateProjection{}).reduceInstanceRemoved,
+			want: wantReduce{
+				aggregateType: eventstore.AggregateType("instance"),
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "DELETE FROM projections.notification_layouts WHERE (instance_id = $1)",
+							expectedArgs: []interface{}{
+								"agg-id",
+							},
+						},
+						{
+							expectedStmt: "DELETE FROM projections.notification_layouts_partials WHERE (instance_id = $1)",
+							expectedArgs: []interface{}{
+								"agg-id",
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			event := baseEvent(t)
+			got, err := tt.reduce(event)
+			if ok := zerrors.IsErrorInvalidArgument(err); !ok {
+				t.Errorf("no wrong event mapping: %v, got: %v", err, got)
+			}
+
+			event = tt.args.event(t)
+			got, err = tt.reduce(event)
+			assertReduce(t, got, err, NotificationLayoutTable, tt.want)
+		})
+	}
+}
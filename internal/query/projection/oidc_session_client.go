@@ -0,0 +1,147 @@
+package projection
+
+import (
+	"context"
+
+	"github.com/zitadel/zitadel/internal/eventstore"
+	old_handler "github.com/zitadel/zitadel/internal/eventstore/handler"
+	"github.com/zitadel/zitadel/internal/eventstore/handler/v2"
+	"github.com/zitadel/zitadel/internal/repository/instance"
+	"github.com/zitadel/zitadel/internal/repository/oidcsession"
+	"github.com/zitadel/zitadel/internal/repository/session"
+	"github.com/zitadel/zitadel/internal/repository/user"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+const (
+	OIDCSessionClientProjectionTable = "projections.oidc_session_clients"
+
+	OIDCSessionClientColumnID           = "id"
+	OIDCSessionClientColumnInstanceID   = "instance_id"
+	OIDCSessionClientColumnSessionID    = "session_id"
+	OIDCSessionClientColumnUserAgentID  = "user_agent_id"
+	OIDCSessionClientColumnUserID       = "user_id"
+	OIDCSessionClientColumnClientID     = "client_id"
+	OIDCSessionClientColumnCreationDate = "creation_date"
+	OIDCSessionClientColumnSequence     = "sequence"
+)
+
+// oidcSessionClientProjection contains the relying party of every OIDC session,
+// so the clients can be notified when the (user agent) session is logged out.
+type oidcSessionClientProjection struct{}
+
+func newOIDCSessionClientProjection(ctx context.Context, config handler.Config) *handler.Handler {
+	return handler.NewHandler(ctx, &config, new(oidcSessionClientProjection))
+}
+
+func (*oidcSessionClientProjection) Name() string {
+	return OIDCSessionClientProjectionTable
+}
+
+func (*oidcSessionClientProjection) Init() *old_handler.Check {
+	return handler.NewTableCheck(
+		handler.NewTable([]*handler.InitColumn{
+			handler.NewColumn(OIDCSessionClientColumnID, handler.ColumnTypeText),
+			handler.NewColumn(OIDCSessionClientColumnInstanceID, handler.ColumnTypeText),
+			handler.NewColumn(OIDCSessionClientColumnSessionID, handler.ColumnTypeText, handler.Default("")),
+			handler.NewColumn(OIDCSessionClientColumnUserAgentID, handler.ColumnTypeText, handler.Default("")),
+			handler.NewColumn(OIDCSessionClientColumnUserID, handler.ColumnTypeText),
+			handler.NewColumn(OIDCSessionClientColumnClientID, handler.ColumnTypeText),
+			handler.NewColumn(OIDCSessionClientColumnCreationDate, handler.ColumnTypeTimestamp),
+			handler.NewColumn(OIDCSessionClientColumnSequence, handler.ColumnTypeInt64),
+		},
+			handler.NewPrimaryKey(OIDCSessionClientColumnInstanceID, OIDCSessionClientColumnID),
+			handler.WithIndex(handler.NewIndex("session", []string{OIDCSessionClientColumnInstanceID, OIDCSessionClientColumnSessionID})),
+			handler.WithIndex(handler.NewIndex("user_agent", []string{OIDCSessionClientColumnInstanceID, OIDCSessionClientColumnUserAgentID})),
+		),
+	)
+}
+
+func (p *oidcSessionClientProjection) Reducers() []handler.AggregateReducer {
+	return []handler.AggregateReducer{
+		{
+			Aggregate: oidcsession.AggregateType,
+			EventReducers: []handler.EventReducer{
+				{
+					Event:  oidcsession.AddedType,
+					Reduce: p.reduceAdded,
+				},
+			},
+		},
+		{
+			Aggregate: session.AggregateType,
+			EventReducers: []handler.EventReducer{
+				{
+					Event:  session.TerminateType,
+					Reduce: p.reduceSessionTerminated,
+				},
+			},
+		},
+		{
+			Aggregate: user.AggregateType,
+			EventReducers: []handler.EventReducer{
+				{
+					Event:  user.UserRemovedType,
+					Reduce: p.reduceUserRemoved,
+				},
+			},
+		},
+		{
+			Aggregate: instance.AggregateType,
+			EventReducers: []handler.EventReducer{
+				{
+					Event:  instance.InstanceRemovedEventType,
+					Reduce: reduceInstanceRemovedHelper(OIDCSessionClientColumnInstanceID),
+				},
+			},
+		},
+	}
+}
+
+func (p *oidcSessionClientProjection) reduceAdded(event eventstore.Event) (*handler.Statement, error) {
+	e, err := assertEvent[*oidcsession.AddedEvent](event)
+	if err != nil {
+		return nil, err
+	}
+	return handler.NewCreateStatement(
+		e,
+		[]handler.Column{
+			handler.NewCol(OIDCSessionClientColumnID, e.Aggregate().ID),
+			handler.NewCol(OIDCSessionClientColumnInstanceID, e.Aggregate().InstanceID),
+			handler.NewCol(OIDCSessionClientColumnSessionID, e.SessionID),
+			handler.NewCol(OIDCSessionClientColumnUserAgentID, e.UserAgent.GetFingerprintID()),
+			handler.NewCol(OIDCSessionClientColumnUserID, e.UserID),
+			handler.NewCol(OIDCSessionClientColumnClientID, e.ClientID),
+			handler.NewCol(OIDCSessionClientColumnCreationDate, e.CreationDate()),
+			handler.NewCol(OIDCSessionClientColumnSequence, e.Sequence()),
+		},
+	), nil
+}
+
+func (p *oidcSessionClientProjection) reduceSessionTerminated(event eventstore.Event) (*handler.Statement, error) {
+	e, ok := event.(*session.TerminateEvent)
+	if !ok {
+		return nil, zerrors.ThrowInvalidArgumentf(nil, "PROJE-Os4tr", "reduce.wrong.event.type %s", session.TerminateType)
+	}
+	return handler.NewDeleteStatement(
+		e,
+		[]handler.Condition{
+			handler.NewCond(OIDCSessionClientColumnInstanceID, e.Aggregate().InstanceID),
+			handler.NewCond(OIDCSessionClientColumnSessionID, e.Aggregate().ID),
+		},
+	), nil
+}
+
+func (p *oidcSessionClientProjection) reduceUserRemoved(event eventstore.Event) (*handler.Statement, error) {
+	e, ok := event.(*user.UserRemovedEvent)
+	if !ok {
+		return nil, zerrors.ThrowInvalidArgumentf(nil, "PROJE-Os7ur", "reduce.wrong.event.type %s", user.UserRemovedType)
+	}
+	return handler.NewDeleteStatement(
+		e,
+		[]handler.Condition{
+			handler.NewCond(OIDCSessionClientColumnInstanceID, e.Aggregate().InstanceID),
+			handler.NewCond(OIDCSessionClientColumnUserID, e.Aggregate().ID),
+		},
+	), nil
+}
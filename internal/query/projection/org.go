@@ -78,6 +78,14 @@ func (p *orgProjection) Reducers() []handler.AggregateReducer {
 					Event:  org.OrgReactivatedEventType,
 					Reduce: p.reduceOrgReactivated,
 				},
+				{
+					Event:  org.OrgArchivedEventType,
+					Reduce: p.reduceOrgArchived,
+				},
+				{
+					Event:  org.OrgUnarchivedEventType,
+					Reduce: p.reduceOrgUnarchived,
+				},
 				{
 					Event:  org.OrgRemovedEventType,
 					Reduce: p.reduceOrgRemoved,
@@ -180,6 +188,44 @@ func (p *orgProjection) reduceOrgReactivated(event eventstore.Event) (*handler.S
 	), nil
 }
 
+func (p *orgProjection) reduceOrgArchived(event eventstore.Event) (*handler.Statement, error) {
+	e, ok := event.(*org.OrgArchivedEvent)
+	if !ok {
+		return nil, zerrors.ThrowInvalidArgumentf(nil, "HANDL-Ar4ch", "reduce.wrong.event.type %s", org.OrgArchivedEventType)
+	}
+	return handler.NewUpdateStatement(
+		e,
+		[]handler.Column{
+			handler.NewCol(OrgColumnChangeDate, e.CreationDate()),
+			handler.NewCol(OrgColumnSequence, e.Sequence()),
+			handler.NewCol(OrgColumnState, domain.OrgStateArchived),
+		},
+		[]handler.Condition{
+			handler.NewCond(OrgColumnID, e.Aggregate().ID),
+			handler.NewCond(OrgColumnInstanceID, e.Aggregate().InstanceID),
+		},
+	), nil
+}
+
+func (p *orgProjection) reduceOrgUnarchived(event eventstore.Event) (*handler.Statement, error) {
+	e, ok := event.(*org.OrgUnarchivedEvent)
+	if !ok {
+		return nil, zerrors.ThrowInvalidArgumentf(nil, "HANDL-Un4rc", "reduce.wrong.event.type %s", org.OrgUnarchivedEventType)
+	}
+	return handler.NewUpdateStatement(
+		e,
+		[]handler.Column{
+			handler.NewCol(OrgColumnChangeDate, e.CreationDate()),
+			handler.NewCol(OrgColumnSequence, e.Sequence()),
+			handler.NewCol(OrgColumnState, domain.OrgStateActive),
+		},
+		[]handler.Condition{
+			handler.NewCond(OrgColumnID, e.Aggregate().ID),
+			handler.NewCond(OrgColumnInstanceID, e.Aggregate().InstanceID),
+		},
+	), nil
+}
+
 func (p *orgProjection) reducePrimaryDomainSet(event eventstore.Event) (*handler.Statement, error) {
 	e, ok := event.(*org.DomainPrimarySetEvent)
 	if !ok {
@@ -0,0 +1,165 @@
+package projection
+
+import (
+	"context"
+
+	"github.com/zitadel/zitadel/internal/database"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	old_handler "github.com/zitadel/zitadel/internal/eventstore/handler"
+	"github.com/zitadel/zitadel/internal/eventstore/handler/v2"
+	"github.com/zitadel/zitadel/internal/repository/instance"
+	"github.com/zitadel/zitadel/internal/repository/org"
+)
+
+const (
+	OrgCustomRoleProjectionTable = "projections.org_custom_roles"
+
+	OrgCustomRoleColumnOrgID         = "org_id"
+	OrgCustomRoleColumnKey           = "key"
+	OrgCustomRoleColumnInstanceID    = "instance_id"
+	OrgCustomRoleColumnResourceOwner = "resource_owner"
+	OrgCustomRoleColumnDisplayName   = "display_name"
+	OrgCustomRoleColumnPermissions   = "permissions"
+	OrgCustomRoleColumnCreationDate  = "creation_date"
+	OrgCustomRoleColumnChangeDate    = "change_date"
+	OrgCustomRoleColumnSequence      = "sequence"
+)
+
+type orgCustomRoleProjection struct{}
+
+func newOrgCustomRoleProjection(ctx context.Context, config handler.Config) *handler.Handler {
+	return handler.NewHandler(ctx, &config, new(orgCustomRoleProjection))
+}
+
+func (*orgCustomRoleProjection) Name() string {
+	return OrgCustomRoleProjectionTable
+}
+
+func (*orgCustomRoleProjection) Init() *old_handler.Check {
+	return handler.NewTableCheck(
+		handler.NewTable([]*handler.InitColumn{
+			handler.NewColumn(OrgCustomRoleColumnOrgID, handler.ColumnTypeText),
+			handler.NewColumn(OrgCustomRoleColumnKey, handler.ColumnTypeText),
+			handler.NewColumn(OrgCustomRoleColumnInstanceID, handler.ColumnTypeText),
+			handler.NewColumn(OrgCustomRoleColumnResourceOwner, handler.ColumnTypeText),
+			handler.NewColumn(OrgCustomRoleColumnDisplayName, handler.ColumnTypeText, handler.Default("")),
+			handler.NewColumn(OrgCustomRoleColumnPermissions, handler.ColumnTypeTextArray),
+			handler.NewColumn(OrgCustomRoleColumnCreationDate, handler.ColumnTypeTimestamp),
+			handler.NewColumn(OrgCustomRoleColumnChangeDate, handler.ColumnTypeTimestamp),
+			handler.NewColumn(OrgCustomRoleColumnSequence, handler.ColumnTypeInt64),
+		},
+			handler.NewPrimaryKey(OrgCustomRoleColumnInstanceID, OrgCustomRoleColumnOrgID, OrgCustomRoleColumnKey),
+		),
+	)
+}
+
+func (p *orgCustomRoleProjection) Reducers() []handler.AggregateReducer {
+	return []handler.AggregateReducer{
+		{
+			Aggregate: org.AggregateType,
+			EventReducers: []handler.EventReducer{
+				{
+					Event:  org.CustomRoleAddedEventType,
+					Reduce: p.reduceAdded,
+				},
+				{
+					Event:  org.CustomRoleChangedEventType,
+					Reduce: p.reduceChanged,
+				},
+				{
+					Event:  org.CustomRoleRemovedEventType,
+					Reduce: p.reduceRemoved,
+				},
+				{
+					Event:  org.OrgRemovedEventType,
+					Reduce: p.reduceOwnerRemoved,
+				},
+			},
+		},
+		{
+			Aggregate: instance.AggregateType,
+			EventReducers: []handler.EventReducer{
+				{
+					Event:  instance.InstanceRemovedEventType,
+					Reduce: reduceInstanceRemovedHelper(OrgCustomRoleColumnInstanceID),
+				},
+			},
+		},
+	}
+}
+
+func (p *orgCustomRoleProjection) reduceAdded(event eventstore.Event) (*handler.Statement, error) {
+	e, err := assertEvent[*org.CustomRoleAddedEvent](event)
+	if err != nil {
+		return nil, err
+	}
+	return handler.NewCreateStatement(
+		e,
+		[]handler.Column{
+			handler.NewCol(OrgCustomRoleColumnOrgID, e.Aggregate().ID),
+			handler.NewCol(OrgCustomRoleColumnKey, e.Key),
+			handler.NewCol(OrgCustomRoleColumnInstanceID, e.Aggregate().InstanceID),
+			handler.NewCol(OrgCustomRoleColumnResourceOwner, e.Aggregate().ResourceOwner),
+			handler.NewCol(OrgCustomRoleColumnDisplayName, e.DisplayName),
+			handler.NewCol(OrgCustomRoleColumnPermissions, database.TextArray[string](e.Permissions)),
+			handler.NewCol(OrgCustomRoleColumnCreationDate, e.CreationDate()),
+			handler.NewCol(OrgCustomRoleColumnChangeDate, e.CreationDate()),
+			handler.NewCol(OrgCustomRoleColumnSequence, e.Sequence()),
+		},
+	), nil
+}
+
+func (p *orgCustomRoleProjection) reduceChanged(event eventstore.Event) (*handler.Statement, error) {
+	e, err := assertEvent[*org.CustomRoleChangedEvent](event)
+	if err != nil {
+		return nil, err
+	}
+	cols := []handler.Column{
+		handler.NewCol(OrgCustomRoleColumnChangeDate, e.CreationDate()),
+		handler.NewCol(OrgCustomRoleColumnSequence, e.Sequence()),
+	}
+	if e.DisplayName != nil {
+		cols = append(cols, handler.NewCol(OrgCustomRoleColumnDisplayName, *e.DisplayName))
+	}
+	if e.Permissions != nil {
+		cols = append(cols, handler.NewCol(OrgCustomRoleColumnPermissions, database.TextArray[string](e.Permissions)))
+	}
+	return handler.NewUpdateStatement(
+		e,
+		cols,
+		[]handler.Condition{
+			handler.NewCond(OrgCustomRoleColumnInstanceID, e.Aggregate().InstanceID),
+			handler.NewCond(OrgCustomRoleColumnOrgID, e.Aggregate().ID),
+			handler.NewCond(OrgCustomRoleColumnKey, e.Key),
+		},
+	), nil
+}
+
+func (p *orgCustomRoleProjection) reduceRemoved(event eventstore.Event) (*handler.Statement, error) {
+	e, err := assertEvent[*org.CustomRoleRemovedEvent](event)
+	if err != nil {
+		return nil, err
+	}
+	return handler.NewDeleteStatement(
+		e,
+		[]handler.Condition{
+			handler.NewCond(OrgCustomRoleColumnInstanceID, e.Aggregate().InstanceID),
+			handler.NewCond(OrgCustomRoleColumnOrgID, e.Aggregate().ID),
+			handler.NewCond(OrgCustomRoleColumnKey, e.Key),
+		},
+	), nil
+}
+
+func (p *orgCustomRoleProjection) reduceOwnerRemoved(event eventstore.Event) (*handler.Statement, error) {
+	e, err := assertEvent[*org.OrgRemovedEvent](event)
+	if err != nil {
+		return nil, err
+	}
+	return handler.NewDeleteStatement(
+		e,
+		[]handler.Condition{
+			handler.NewCond(OrgCustomRoleColumnInstanceID, e.Aggregate().InstanceID),
+			handler.NewCond(OrgCustomRoleColumnOrgID, e.Aggregate().ID),
+		},
+	), nil
+}
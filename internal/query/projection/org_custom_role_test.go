@@ -0,0 +1,184 @@
+package projection
+
+import (
+	"testing"
+
+	"github.com/zitadel/zitadel/internal/database"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/eventstore/handler/v2"
+	"github.com/zitadel/zitadel/internal/repository/instance"
+	"github.com/zitadel/zitadel/internal/repository/org"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+func TestOrgCustomRoleProjection_reduces(t *testing.T) {
+	type args struct {
+		event func(t *testing.T) eventstore.Event
+	}
+	tests := []struct {
+		name   string
+		args   args
+		reduce func(event eventstore.Event) (*handler.Statement, error)
+		want   wantReduce
+	}{
+		{
+			name: "reduceAdded",
+			args: args{
+				event: getEvent(
+					testEvent(
+						org.CustomRoleAddedEventType,
+						org.AggregateType,
+						[]byte(`{"key": "ORG_CUSTOM_HELPDESK", "displayName": "Helpdesk", "permissions": ["user.read"]}`),
+					), org.CustomRoleAddedEventMapper),
+			},
+			reduce: (&orgCustomRoleProjection{}).reduceAdded,
+			want: wantReduce{
+				aggregateType: eventstore.AggregateType("org"),
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "INSERT INTO projections.org_custom_roles (org_id, key, instance_id, resource_owner, display_name, permissions, creation_date, change_date, sequence) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)",
+							expectedArgs: []interface{}{
+								"agg-id",
+								"ORG_CUSTOM_HELPDESK",
+								"instance-id",
+								"ro-id",
+								"Helpdesk",
+								database.TextArray[string]{"user.read"},
+								anyArg{},
+								anyArg{},
+								uint64(15),
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "reduceChanged",
+			args: args{
+				event: getEvent(
+					testEvent(
+						org.CustomRoleChangedEventType,
+						org.AggregateType,
+						[]byte(`{"key": "ORG_CUSTOM_HELPDESK", "permissions": ["user.read", "user.write"]}`),
+					), org.CustomRoleChangedEventMapper),
+			},
+			reduce: (&orgCustomRoleProjection{}).reduceChanged,
+			want: wantReduce{
+				aggregateType: eventstore.AggregateType("org"),
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "UPDATE projections.org_custom_roles SET (change_date, sequence, permissions) = ($1, $2, $3) WHERE (instance_id = $4) AND (org_id = $5) AND (key = $6)",
+							expectedArgs: []interface{}{
+								anyArg{},
+								uint64(15),
+								database.TextArray[string]{"user.read", "user.write"},
+								"instance-id",
+								"agg-id",
+								"ORG_CUSTOM_HELPDESK",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "reduceRemoved",
+			args: args{
+				event: getEvent(
+					testEvent(
+						org.CustomRoleRemovedEventType,
+						org.AggregateType,
+						[]byte(`{"key": "ORG_CUSTOM_HELPDESK"}`),
+					), org.CustomRoleRemovedEventMapper),
+			},
+			reduce: (&orgCustomRoleProjection{}).reduceRemoved,
+			want: wantReduce{
+				aggregateType: eventstore.AggregateType("org"),
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "DELETE FROM projections.org_custom_roles WHERE (instance_id = $1) AND (org_id = $2) AND (key = $3)",
+							expectedArgs: []interface{}{
+								"instance-id",
+								"agg-id",
+								"ORG_CUSTOM_HELPDESK",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "reduceOwnerRemoved",
+			args: args{
+				event: getEvent(
+					testEvent(
+						org.OrgRemovedEventType,
+						org.AggregateType,
+						nil,
+					), org.OrgRemovedEventMapper),
+			},
+			reduce: (&orgCustomRoleProjection{}).reduceOwnerRemoved,
+			want: wantReduce{
+				aggregateType: eventstore.AggregateType("org"),
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "DELETE FROM projections.org_custom_roles WHERE (instance_id = $1) AND (org_id = $2)",
+							expectedArgs: []interface{}{
+								"instance-id",
+								"agg-id",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "instance reduceInstanceRemoved",
+			args: args{
+				event: getEvent(
+					testEvent(
+						instance.InstanceRemovedEventType,
+						instance.AggregateType,
+						nil,
+					), instance.InstanceRemovedEventMapper),
+			},
+			reduce: reduceInstanceRemovedHelper(OrgCustomRoleColumnInstanceID),
+			want: wantReduce{
+				aggregateType: eventstore.AggregateType("instance"),
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "DELETE FROM projections.org_custom_roles WHERE (instance_id = $1)",
+							expectedArgs: []interface{}{
+								"agg-id",
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			event := baseEvent(t)
+			got, err := tt.reduce(event)
+			if !zerrors.IsErrorInvalidArgument(err) {
+				t.Errorf("no wrong event mapping: %v, got: %v", err, got)
+			}
+
+			event = tt.args.event(t)
+			got, err = tt.reduce(event)
+			assertReduce(t, got, err, OrgCustomRoleProjectionTable, tt.want)
+		})
+	}
+}
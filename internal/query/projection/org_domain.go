@@ -74,6 +74,10 @@ func (p *orgDomainProjection) Reducers() []handler.AggregateReducer {
 					Event:  org.OrgDomainVerifiedEventType,
 					Reduce: p.reduceDomainVerified,
 				},
+				{
+					Event:  org.OrgDomainUnverifiedEventType,
+					Reduce: p.reduceDomainUnverified,
+				},
 				{
 					Event:  org.OrgDomainPrimarySetEventType,
 					Reduce: p.reducePrimaryDomainSet,
@@ -161,6 +165,26 @@ func (p *orgDomainProjection) reduceDomainVerified(event eventstore.Event) (*han
 	), nil
 }
 
+func (p *orgDomainProjection) reduceDomainUnverified(event eventstore.Event) (*handler.Statement, error) {
+	e, ok := event.(*org.DomainUnverifiedEvent)
+	if !ok {
+		return nil, zerrors.ThrowInvalidArgumentf(nil, "PROJE-Uv8dq", "reduce.wrong.event.type %s", org.OrgDomainUnverifiedEventType)
+	}
+	return handler.NewUpdateStatement(
+		e,
+		[]handler.Column{
+			handler.NewCol(OrgDomainChangeDateCol, e.CreationDate()),
+			handler.NewCol(OrgDomainSequenceCol, e.Sequence()),
+			handler.NewCol(OrgDomainIsVerifiedCol, false),
+		},
+		[]handler.Condition{
+			handler.NewCond(OrgDomainDomainCol, e.Domain),
+			handler.NewCond(OrgDomainOrgIDCol, e.Aggregate().ID),
+			handler.NewCond(OrgDomainInstanceIDCol, e.Aggregate().InstanceID),
+		},
+	), nil
+}
+
 func (p *orgDomainProjection) reducePrimaryDomainSet(event eventstore.Event) (*handler.Statement, error) {
 	e, ok := event.(*org.DomainPrimarySetEvent)
 	if !ok {
@@ -117,6 +117,37 @@ func TestOrgDomainProjection_reduces(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "reduceDomainUnverified",
+			args: args{
+				event: getEvent(
+					testEvent(
+						org.OrgDomainUnverifiedEventType,
+						org.AggregateType,
+						[]byte(`{"domain": "domain.new"}`),
+					), org.DomainUnverifiedEventMapper),
+			},
+			reduce: (&orgDomainProjection{}).reduceDomainUnverified,
+			want: wantReduce{
+				aggregateType: eventstore.AggregateType("org"),
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "UPDATE projections.org_domains2 SET (change_date, sequence, is_verified) = ($1, $2, $3) WHERE (domain = $4) AND (org_id = $5) AND (instance_id = $6)",
+							expectedArgs: []interface{}{
+								anyArg{},
+								uint64(15),
+								false,
+								"domain.new",
+								"agg-id",
+								"instance-id",
+							},
+						},
+					},
+				},
+			},
+		},
 		{
 			name: "reducePrimaryDomainSet",
 			args: args{
@@ -0,0 +1,141 @@
+package projection
+
+import (
+	"context"
+
+	"github.com/zitadel/zitadel/internal/eventstore"
+	old_handler "github.com/zitadel/zitadel/internal/eventstore/handler"
+	"github.com/zitadel/zitadel/internal/eventstore/handler/v2"
+	"github.com/zitadel/zitadel/internal/repository/instance"
+	"github.com/zitadel/zitadel/internal/repository/org"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+const (
+	OrgParentProjectionTable = "projections.org_parents"
+
+	OrgParentColumnOrgID        = "org_id"
+	OrgParentColumnInstanceID   = "instance_id"
+	OrgParentColumnParentOrgID  = "parent_org_id"
+	OrgParentColumnCreationDate = "creation_date"
+	OrgParentColumnChangeDate   = "change_date"
+	OrgParentColumnSequence     = "sequence"
+)
+
+// orgParentProjection contains the edges of the organization hierarchy,
+// organizations without parent have no row.
+type orgParentProjection struct{}
+
+func newOrgParentProjection(ctx context.Context, config handler.Config) *handler.Handler {
+	return handler.NewHandler(ctx, &config, new(orgParentProjection))
+}
+
+func (*orgParentProjection) Name() string {
+	return OrgParentProjectionTable
+}
+
+func (*orgParentProjection) Init() *old_handler.Check {
+	return handler.NewTableCheck(
+		handler.NewTable([]*handler.InitColumn{
+			handler.NewColumn(OrgParentColumnOrgID, handler.ColumnTypeText),
+			handler.NewColumn(OrgParentColumnInstanceID, handler.ColumnTypeText),
+			handler.NewColumn(OrgParentColumnParentOrgID, handler.ColumnTypeText),
+			handler.NewColumn(OrgParentColumnCreationDate, handler.ColumnTypeTimestamp),
+			handler.NewColumn(OrgParentColumnChangeDate, handler.ColumnTypeTimestamp),
+			handler.NewColumn(OrgParentColumnSequence, handler.ColumnTypeInt64),
+		},
+			handler.NewPrimaryKey(OrgParentColumnInstanceID, OrgParentColumnOrgID),
+			handler.WithIndex(handler.NewIndex("parent", []string{OrgParentColumnInstanceID, OrgParentColumnParentOrgID})),
+		),
+	)
+}
+
+func (p *orgParentProjection) Reducers() []handler.AggregateReducer {
+	return []handler.AggregateReducer{
+		{
+			Aggregate: org.AggregateType,
+			EventReducers: []handler.EventReducer{
+				{
+					Event:  org.OrgParentSetEventType,
+					Reduce: p.reduceParentSet,
+				},
+				{
+					Event:  org.OrgParentRemovedEventType,
+					Reduce: p.reduceParentRemoved,
+				},
+				{
+					Event:  org.OrgRemovedEventType,
+					Reduce: p.reduceOrgRemoved,
+				},
+			},
+		},
+		{
+			Aggregate: instance.AggregateType,
+			EventReducers: []handler.EventReducer{
+				{
+					Event:  instance.InstanceRemovedEventType,
+					Reduce: reduceInstanceRemovedHelper(OrgParentColumnInstanceID),
+				},
+			},
+		},
+	}
+}
+
+func (p *orgParentProjection) reduceParentSet(event eventstore.Event) (*handler.Statement, error) {
+	e, ok := event.(*org.OrgParentSetEvent)
+	if !ok {
+		return nil, zerrors.ThrowInvalidArgumentf(nil, "PROJE-Wn4pa", "reduce.wrong.event.type %s", org.OrgParentSetEventType)
+	}
+	return handler.NewUpsertStatement(
+		e,
+		[]handler.Column{
+			handler.NewCol(OrgParentColumnInstanceID, nil),
+			handler.NewCol(OrgParentColumnOrgID, nil),
+		},
+		[]handler.Column{
+			handler.NewCol(OrgParentColumnInstanceID, e.Aggregate().InstanceID),
+			handler.NewCol(OrgParentColumnOrgID, e.Aggregate().ID),
+			handler.NewCol(OrgParentColumnParentOrgID, e.ParentOrgID),
+			handler.NewCol(OrgParentColumnCreationDate, handler.OnlySetValueOnInsert(OrgParentProjectionTable, e.CreationDate())),
+			handler.NewCol(OrgParentColumnChangeDate, e.CreationDate()),
+			handler.NewCol(OrgParentColumnSequence, e.Sequence()),
+		},
+	), nil
+}
+
+func (p *orgParentProjection) reduceParentRemoved(event eventstore.Event) (*handler.Statement, error) {
+	e, ok := event.(*org.OrgParentRemovedEvent)
+	if !ok {
+		return nil, zerrors.ThrowInvalidArgumentf(nil, "PROJE-Gt7ks", "reduce.wrong.event.type %s", org.OrgParentRemovedEventType)
+	}
+	return handler.NewDeleteStatement(
+		e,
+		[]handler.Condition{
+			handler.NewCond(OrgParentColumnInstanceID, e.Aggregate().InstanceID),
+			handler.NewCond(OrgParentColumnOrgID, e.Aggregate().ID),
+		},
+	), nil
+}
+
+// reduceOrgRemoved removes the organization from the hierarchy, its children become roots.
+func (p *orgParentProjection) reduceOrgRemoved(event eventstore.Event) (*handler.Statement, error) {
+	e, ok := event.(*org.OrgRemovedEvent)
+	if !ok {
+		return nil, zerrors.ThrowInvalidArgumentf(nil, "PROJE-Lr2vd", "reduce.wrong.event.type %s", org.OrgRemovedEventType)
+	}
+	return handler.NewMultiStatement(
+		e,
+		handler.AddDeleteStatement(
+			[]handler.Condition{
+				handler.NewCond(OrgParentColumnInstanceID, e.Aggregate().InstanceID),
+				handler.NewCond(OrgParentColumnOrgID, e.Aggregate().ID),
+			},
+		),
+		handler.AddDeleteStatement(
+			[]handler.Condition{
+				handler.NewCond(OrgParentColumnInstanceID, e.Aggregate().InstanceID),
+				handler.NewCond(OrgParentColumnParentOrgID, e.Aggregate().ID),
+			},
+		),
+	), nil
+}
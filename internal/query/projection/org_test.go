@@ -81,6 +81,66 @@ func TestOrgProjection_reduces(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "reduceOrgArchived",
+			args: args{
+				event: getEvent(
+					testEvent(
+						org.OrgArchivedEventType,
+						org.AggregateType,
+						nil,
+					), org.OrgArchivedEventMapper),
+			},
+			reduce: (&orgProjection{}).reduceOrgArchived,
+			want: wantReduce{
+				aggregateType: eventstore.AggregateType("org"),
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "UPDATE projections.orgs1 SET (change_date, sequence, org_state) = ($1, $2, $3) WHERE (id = $4) AND (instance_id = $5)",
+							expectedArgs: []interface{}{
+								anyArg{},
+								uint64(15),
+								domain.OrgStateArchived,
+								"agg-id",
+								"instance-id",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "reduceOrgUnarchived",
+			args: args{
+				event: getEvent(
+					testEvent(
+						org.OrgUnarchivedEventType,
+						org.AggregateType,
+						nil,
+					), org.OrgUnarchivedEventMapper),
+			},
+			reduce: (&orgProjection{}).reduceOrgUnarchived,
+			want: wantReduce{
+				aggregateType: eventstore.AggregateType("org"),
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "UPDATE projections.orgs1 SET (change_date, sequence, org_state) = ($1, $2, $3) WHERE (id = $4) AND (instance_id = $5)",
+							expectedArgs: []interface{}{
+								anyArg{},
+								uint64(15),
+								domain.OrgStateActive,
+								"agg-id",
+								"instance-id",
+							},
+						},
+					},
+				},
+			},
+		},
 		{
 			name: "reduceOrgDeactivated",
 			args: args{
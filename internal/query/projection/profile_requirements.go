@@ -0,0 +1,119 @@
+package projection
+
+import (
+	"context"
+
+	"github.com/zitadel/zitadel/internal/database"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	old_handler "github.com/zitadel/zitadel/internal/eventstore/handler"
+	"github.com/zitadel/zitadel/internal/eventstore/handler/v2"
+	"github.com/zitadel/zitadel/internal/repository/instance"
+	"github.com/zitadel/zitadel/internal/repository/org"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+const (
+	ProfileRequirementsProjectionTable = "projections.profile_requirements"
+
+	ProfileRequirementsColumnOrgID        = "org_id"
+	ProfileRequirementsColumnInstanceID   = "instance_id"
+	ProfileRequirementsColumnCreationDate = "creation_date"
+	ProfileRequirementsColumnChangeDate   = "change_date"
+	ProfileRequirementsColumnSequence     = "sequence"
+	ProfileRequirementsColumnFields       = "fields"
+)
+
+type profileRequirementsProjection struct{}
+
+func newProfileRequirementsProjection(ctx context.Context, config handler.Config) *handler.Handler {
+	return handler.NewHandler(ctx, &config, new(profileRequirementsProjection))
+}
+
+func (*profileRequirementsProjection) Name() string {
+	return ProfileRequirementsProjectionTable
+}
+
+func (*profileRequirementsProjection) Init() *old_handler.Check {
+	return handler.NewTableCheck(
+		handler.NewTable([]*handler.InitColumn{
+			handler.NewColumn(ProfileRequirementsColumnOrgID, handler.ColumnTypeText),
+			handler.NewColumn(ProfileRequirementsColumnInstanceID, handler.ColumnTypeText),
+			handler.NewColumn(ProfileRequirementsColumnCreationDate, handler.ColumnTypeTimestamp),
+			handler.NewColumn(ProfileRequirementsColumnChangeDate, handler.ColumnTypeTimestamp),
+			handler.NewColumn(ProfileRequirementsColumnSequence, handler.ColumnTypeInt64),
+			handler.NewColumn(ProfileRequirementsColumnFields, handler.ColumnTypeEnumArray, handler.Nullable()),
+		},
+			handler.NewPrimaryKey(ProfileRequirementsColumnInstanceID, ProfileRequirementsColumnOrgID),
+		),
+	)
+}
+
+func (p *profileRequirementsProjection) Reducers() []handler.AggregateReducer {
+	return []handler.AggregateReducer{
+		{
+			Aggregate: org.AggregateType,
+			EventReducers: []handler.EventReducer{
+				{
+					Event:  org.ProfileRequirementsSetEventType,
+					Reduce: p.reduceSet,
+				},
+				{
+					Event:  org.ProfileRequirementsRemovedEventType,
+					Reduce: p.reduceRemoved,
+				},
+				{
+					Event:  org.OrgRemovedEventType,
+					Reduce: p.reduceRemoved,
+				},
+			},
+		},
+		{
+			Aggregate: instance.AggregateType,
+			EventReducers: []handler.EventReducer{
+				{
+					Event:  instance.InstanceRemovedEventType,
+					Reduce: reduceInstanceRemovedHelper(ProfileRequirementsColumnInstanceID),
+				},
+			},
+		},
+	}
+}
+
+func (p *profileRequirementsProjection) reduceSet(event eventstore.Event) (*handler.Statement, error) {
+	e, ok := event.(*org.ProfileRequirementsSetEvent)
+	if !ok {
+		return nil, zerrors.ThrowInvalidArgumentf(nil, "PROJE-Pr4st", "reduce.wrong.event.type %s", org.ProfileRequirementsSetEventType)
+	}
+	return handler.NewUpsertStatement(
+		e,
+		[]handler.Column{
+			handler.NewCol(ProfileRequirementsColumnInstanceID, nil),
+			handler.NewCol(ProfileRequirementsColumnOrgID, nil),
+		},
+		[]handler.Column{
+			handler.NewCol(ProfileRequirementsColumnInstanceID, e.Aggregate().InstanceID),
+			handler.NewCol(ProfileRequirementsColumnOrgID, e.Aggregate().ID),
+			handler.NewCol(ProfileRequirementsColumnCreationDate, handler.OnlySetValueOnInsert(ProfileRequirementsProjectionTable, e.CreationDate())),
+			handler.NewCol(ProfileRequirementsColumnChangeDate, e.CreationDate()),
+			handler.NewCol(ProfileRequirementsColumnSequence, e.Sequence()),
+			handler.NewCol(ProfileRequirementsColumnFields, database.NumberArray[domain.ProfileField](e.ProfileFields)),
+		},
+	), nil
+}
+
+func (p *profileRequirementsProjection) reduceRemoved(event eventstore.Event) (*handler.Statement, error) {
+	switch event.(type) {
+	case *org.ProfileRequirementsRemovedEvent,
+		*org.OrgRemovedEvent:
+	default:
+		return nil, zerrors.ThrowInvalidArgumentf(nil, "PROJE-Pr5rm", "reduce.wrong.event.type %v", []eventstore.EventType{org.ProfileRequirementsRemovedEventType, org.OrgRemovedEventType})
+	}
+	return handler.NewDeleteStatement(
+		event,
+		[]handler.Condition{
+			handler.NewCond(ProfileRequirementsColumnInstanceID, event.Aggregate().InstanceID),
+			handler.NewCond(ProfileRequirementsColumnOrgID, event.Aggregate().ID),
+		},
+	), nil
+}
@@ -14,7 +14,7 @@ import (
 )
 
 const (
-	ProjectProjectionTable = "projections.projects4"
+	ProjectProjectionTable = "projections.projects5"
 
 	ProjectColumnID                     = "id"
 	ProjectColumnCreationDate           = "creation_date"
@@ -28,6 +28,7 @@ const (
 	ProjectColumnProjectRoleCheck       = "project_role_check"
 	ProjectColumnHasProjectCheck        = "has_project_check"
 	ProjectColumnPrivateLabelingSetting = "private_labeling_setting"
+	ProjectColumnAccessTokenType        = "access_token_type"
 )
 
 type projectProjection struct{}
@@ -55,6 +56,7 @@ func (*projectProjection) Init() *old_handler.Check {
 			handler.NewColumn(ProjectColumnProjectRoleCheck, handler.ColumnTypeBool),
 			handler.NewColumn(ProjectColumnHasProjectCheck, handler.ColumnTypeBool),
 			handler.NewColumn(ProjectColumnPrivateLabelingSetting, handler.ColumnTypeEnum),
+			handler.NewColumn(ProjectColumnAccessTokenType, handler.ColumnTypeEnum, handler.Default(0)),
 		},
 			handler.NewPrimaryKey(ProjectColumnInstanceID, ProjectColumnID),
 			handler.WithIndex(handler.NewIndex("resource_owner", []string{ProjectColumnResourceOwner})),
@@ -87,6 +89,10 @@ func (p *projectProjection) Reducers() []handler.AggregateReducer {
 					Event:  project.ProjectRemovedType,
 					Reduce: p.reduceProjectRemoved,
 				},
+				{
+					Event:  project.ProjectAccessTokenTypeSetType,
+					Reduce: p.reduceProjectAccessTokenTypeSet,
+				},
 			},
 		},
 		{
@@ -171,6 +177,25 @@ func (p *projectProjection) reduceProjectChanged(event eventstore.Event) (*handl
 	), nil
 }
 
+func (p *projectProjection) reduceProjectAccessTokenTypeSet(event eventstore.Event) (*handler.Statement, error) {
+	e, err := assertEvent[*project.ProjectAccessTokenTypeSetEvent](event)
+	if err != nil {
+		return nil, err
+	}
+	return handler.NewUpdateStatement(
+		e,
+		[]handler.Column{
+			handler.NewCol(ProjectColumnChangeDate, e.CreationDate()),
+			handler.NewCol(ProjectColumnSequence, e.Sequence()),
+			handler.NewCol(ProjectColumnAccessTokenType, e.AccessTokenType),
+		},
+		[]handler.Condition{
+			handler.NewCond(ProjectColumnID, e.Aggregate().ID),
+			handler.NewCond(ProjectColumnInstanceID, e.Aggregate().InstanceID),
+		},
+	), nil
+}
+
 func (p *projectProjection) reduceProjectDeactivated(event eventstore.Event) (*handler.Statement, error) {
 	e, ok := event.(*project.ProjectDeactivatedEvent)
 	if !ok {
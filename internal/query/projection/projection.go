@@ -20,21 +20,30 @@ var (
 	projectionConfig                    handler.Config
 	OrgProjection                       *handler.Handler
 	OrgMetadataProjection               *handler.Handler
+	OrgParentProjection                 *handler.Handler
+	OrgCustomRoleProjection             *handler.Handler
+	ProfileRequirementsProjection       *handler.Handler
+	UserAttributeSchemaProjection       *handler.Handler
 	ActionProjection                    *handler.Handler
 	FlowProjection                      *handler.Handler
 	ProjectProjection                   *handler.Handler
 	PasswordComplexityProjection        *handler.Handler
 	PasswordAgeProjection               *handler.Handler
 	LockoutPolicyProjection             *handler.Handler
+	CaptchaPolicyProjection             *handler.Handler
 	PrivacyPolicyProjection             *handler.Handler
+	TermsVersionProjection              *handler.Handler
 	DomainPolicyProjection              *handler.Handler
 	LabelPolicyProjection               *handler.Handler
 	ProjectGrantProjection              *handler.Handler
 	ProjectRoleProjection               *handler.Handler
 	OrgDomainProjection                 *handler.Handler
 	LoginPolicyProjection               *handler.Handler
+	LoginPolicyIDPButtonsProjection     *handler.Handler
 	IDPProjection                       *handler.Handler
 	AppProjection                       *handler.Handler
+	NetworkAccessPolicyProjection       *handler.Handler
+	AppTokenLifetimesProjection         *handler.Handler
 	IDPUserLinkProjection               *handler.Handler
 	IDPLoginPolicyLinkProjection        *handler.Handler
 	IDPTemplateProjection               *handler.Handler
@@ -45,6 +54,7 @@ var (
 	LoginNameProjection                 *handler.Handler
 	OrgMemberProjection                 *handler.Handler
 	InstanceDomainProjection            *handler.Handler
+	InstanceDomainSettingsProjection    *handler.Handler
 	InstanceMemberProjection            *handler.Handler
 	ProjectMemberProjection             *handler.Handler
 	ProjectGrantMemberProjection        *handler.Handler
@@ -67,9 +77,11 @@ var (
 	TelemetryPusherProjection           interface{}
 	DeviceAuthProjection                *handler.Handler
 	SessionProjection                   *handler.Handler
+	OIDCSessionClientProjection         *handler.Handler
 	AuthRequestProjection               *handler.Handler
 	MilestoneProjection                 *handler.Handler
 	QuotaProjection                     *quotaProjection
+	InstanceUsageProjection             *instanceUsageProjection
 	LimitsProjection                    *handler.Handler
 	RestrictionsProjection              *handler.Handler
 	SystemFeatureProjection             *handler.Handler
@@ -77,6 +89,12 @@ var (
 	TargetProjection                    *handler.Handler
 	ExecutionProjection                 *handler.Handler
 	UserSchemaProjection                *handler.Handler
+	RevokedTokenProjection              *handler.Handler
+	GroupProjection                     *handler.Handler
+	NotificationStatusProjection        *handler.Handler
+	NotificationTemplateProjection      *handler.Handler
+	NotificationQueueProjection         *handler.Handler
+	SCIMSyncProjection                  *handler.Handler
 
 	ProjectGrantFields      *handler.FieldHandler
 	OrgDomainVerifiedFields *handler.FieldHandler
@@ -103,25 +121,35 @@ func Create(ctx context.Context, sqlClient *database.DB, es handler.EventStore,
 		MaxFailureCount:       config.MaxFailureCount,
 		RetryFailedAfter:      config.RetryFailedAfter,
 		TransactionDuration:   config.TransactionDuration,
+		CatchUpPartitions:     config.CatchUpPartitions,
 	}
 
 	OrgProjection = newOrgProjection(ctx, applyCustomConfig(projectionConfig, config.Customizations["orgs"]))
 	OrgMetadataProjection = newOrgMetadataProjection(ctx, applyCustomConfig(projectionConfig, config.Customizations["org_metadata"]))
+	OrgParentProjection = newOrgParentProjection(ctx, applyCustomConfig(projectionConfig, config.Customizations["org_parents"]))
+	OrgCustomRoleProjection = newOrgCustomRoleProjection(ctx, applyCustomConfig(projectionConfig, config.Customizations["org_custom_roles"]))
+	ProfileRequirementsProjection = newProfileRequirementsProjection(ctx, applyCustomConfig(projectionConfig, config.Customizations["profile_requirements"]))
+	UserAttributeSchemaProjection = newUserAttributeSchemaProjection(ctx, applyCustomConfig(projectionConfig, config.Customizations["user_attribute_schemas"]))
 	ActionProjection = newActionProjection(ctx, applyCustomConfig(projectionConfig, config.Customizations["actions"]))
 	FlowProjection = newFlowProjection(ctx, applyCustomConfig(projectionConfig, config.Customizations["flows"]))
 	ProjectProjection = newProjectProjection(ctx, applyCustomConfig(projectionConfig, config.Customizations["projects"]))
 	PasswordComplexityProjection = newPasswordComplexityProjection(ctx, applyCustomConfig(projectionConfig, config.Customizations["password_complexities"]))
 	PasswordAgeProjection = newPasswordAgeProjection(ctx, applyCustomConfig(projectionConfig, config.Customizations["password_age_policy"]))
 	LockoutPolicyProjection = newLockoutPolicyProjection(ctx, applyCustomConfig(projectionConfig, config.Customizations["lockout_policy"]))
+	CaptchaPolicyProjection = newCaptchaPolicyProjection(ctx, applyCustomConfig(projectionConfig, config.Customizations["captcha_policies"]))
 	PrivacyPolicyProjection = newPrivacyPolicyProjection(ctx, applyCustomConfig(projectionConfig, config.Customizations["privacy_policy"]))
+	TermsVersionProjection = newTermsVersionProjection(ctx, applyCustomConfig(projectionConfig, config.Customizations["terms_versions"]))
 	DomainPolicyProjection = newDomainPolicyProjection(ctx, applyCustomConfig(projectionConfig, config.Customizations["org_iam_policy"]))
 	LabelPolicyProjection = newLabelPolicyProjection(ctx, applyCustomConfig(projectionConfig, config.Customizations["label_policy"]))
 	ProjectGrantProjection = newProjectGrantProjection(ctx, applyCustomConfig(projectionConfig, config.Customizations["project_grants"]))
 	ProjectRoleProjection = newProjectRoleProjection(ctx, applyCustomConfig(projectionConfig, config.Customizations["project_roles"]))
 	OrgDomainProjection = newOrgDomainProjection(ctx, applyCustomConfig(projectionConfig, config.Customizations["org_domains"]))
 	LoginPolicyProjection = newLoginPolicyProjection(ctx, applyCustomConfig(projectionConfig, config.Customizations["login_policies"]))
+	LoginPolicyIDPButtonsProjection = newLoginPolicyIDPButtonsProjection(ctx, applyCustomConfig(projectionConfig, config.Customizations["login_policy_idp_buttons"]))
 	IDPProjection = newIDPProjection(ctx, applyCustomConfig(projectionConfig, config.Customizations["idps"]))
 	AppProjection = newAppProjection(ctx, applyCustomConfig(projectionConfig, config.Customizations["apps"]))
+	NetworkAccessPolicyProjection = newNetworkAccessPolicyProjection(ctx, applyCustomConfig(projectionConfig, config.Customizations["network_access_policies"]))
+	AppTokenLifetimesProjection = newAppTokenLifetimesProjection(ctx, applyCustomConfig(projectionConfig, config.Customizations["app_token_lifetimes"]))
 	IDPUserLinkProjection = newIDPUserLinkProjection(ctx, applyCustomConfig(projectionConfig, config.Customizations["idp_user_links"]))
 	IDPLoginPolicyLinkProjection = newIDPLoginPolicyLinkProjection(ctx, applyCustomConfig(projectionConfig, config.Customizations["idp_login_policy_links"]))
 	IDPTemplateProjection = newIDPTemplateProjection(ctx, applyCustomConfig(projectionConfig, config.Customizations["idp_templates"]))
@@ -132,6 +160,7 @@ func Create(ctx context.Context, sqlClient *database.DB, es handler.EventStore,
 	LoginNameProjection = newLoginNameProjection(ctx, applyCustomConfig(projectionConfig, config.Customizations["login_names"]))
 	OrgMemberProjection = newOrgMemberProjection(ctx, applyCustomConfig(projectionConfig, config.Customizations["org_members"]))
 	InstanceDomainProjection = newInstanceDomainProjection(ctx, applyCustomConfig(projectionConfig, config.Customizations["instance_domains"]))
+	InstanceDomainSettingsProjection = newInstanceDomainSettingsProjection(ctx, applyCustomConfig(projectionConfig, config.Customizations["instance_domain_settings"]))
 	InstanceMemberProjection = newInstanceMemberProjection(ctx, applyCustomConfig(projectionConfig, config.Customizations["iam_members"]))
 	ProjectMemberProjection = newProjectMemberProjection(ctx, applyCustomConfig(projectionConfig, config.Customizations["project_members"]))
 	ProjectGrantMemberProjection = newProjectGrantMemberProjection(ctx, applyCustomConfig(projectionConfig, config.Customizations["project_grant_members"]))
@@ -151,9 +180,11 @@ func Create(ctx context.Context, sqlClient *database.DB, es handler.EventStore,
 	NotificationPolicyProjection = newNotificationPolicyProjection(ctx, applyCustomConfig(projectionConfig, config.Customizations["notification_policies"]))
 	DeviceAuthProjection = newDeviceAuthProjection(ctx, applyCustomConfig(projectionConfig, config.Customizations["device_auth"]))
 	SessionProjection = newSessionProjection(ctx, applyCustomConfig(projectionConfig, config.Customizations["sessions"]))
+	OIDCSessionClientProjection = newOIDCSessionClientProjection(ctx, applyCustomConfig(projectionConfig, config.Customizations["oidc_session_clients"]))
 	AuthRequestProjection = newAuthRequestProjection(ctx, applyCustomConfig(projectionConfig, config.Customizations["auth_requests"]))
 	MilestoneProjection = newMilestoneProjection(ctx, applyCustomConfig(projectionConfig, config.Customizations["milestones"]), systemUsers)
 	QuotaProjection = newQuotaProjection(ctx, applyCustomConfig(projectionConfig, config.Customizations["quotas"]))
+	InstanceUsageProjection = newInstanceUsageProjection(ctx, applyCustomConfig(projectionConfig, config.Customizations["instance_usage"]))
 	LimitsProjection = newLimitsProjection(ctx, applyCustomConfig(projectionConfig, config.Customizations["limits"]))
 	RestrictionsProjection = newRestrictionsProjection(ctx, applyCustomConfig(projectionConfig, config.Customizations["restrictions"]))
 	SystemFeatureProjection = newSystemFeatureProjection(ctx, applyCustomConfig(projectionConfig, config.Customizations["system_features"]))
@@ -161,6 +192,12 @@ func Create(ctx context.Context, sqlClient *database.DB, es handler.EventStore,
 	TargetProjection = newTargetProjection(ctx, applyCustomConfig(projectionConfig, config.Customizations["targets"]))
 	ExecutionProjection = newExecutionProjection(ctx, applyCustomConfig(projectionConfig, config.Customizations["executions"]))
 	UserSchemaProjection = newUserSchemaProjection(ctx, applyCustomConfig(projectionConfig, config.Customizations["user_schemas"]))
+	RevokedTokenProjection = newRevokedTokenProjection(ctx, applyCustomConfig(projectionConfig, config.Customizations["revoked_tokens"]))
+	GroupProjection = newGroupProjection(ctx, applyCustomConfig(projectionConfig, config.Customizations["groups"]))
+	NotificationStatusProjection = newNotificationStatusProjection(ctx, applyCustomConfig(projectionConfig, config.Customizations["notification_statuses"]))
+	NotificationTemplateProjection = newNotificationTemplateProjection(ctx, applyCustomConfig(projectionConfig, config.Customizations["notification_layouts"]))
+	NotificationQueueProjection = newNotificationQueueProjection(ctx, applyCustomConfig(projectionConfig, config.Customizations["notification_queue"]))
+	SCIMSyncProjection = newSCIMSyncProjection(ctx, applyCustomConfig(projectionConfig, config.Customizations["scim_syncs"]))
 
 	ProjectGrantFields = newFillProjectGrantFields(applyCustomConfig(projectionConfig, config.Customizations[fieldsProjectGrant]))
 	OrgDomainVerifiedFields = newFillOrgDomainVerifiedFields(applyCustomConfig(projectionConfig, config.Customizations[fieldsOrgDomainVerified]))
@@ -221,6 +258,9 @@ func applyCustomConfig(config handler.Config, customConfig CustomConfig) handler
 	if customConfig.TransactionDuration != nil {
 		config.TransactionDuration = *customConfig.TransactionDuration
 	}
+	if customConfig.CatchUpPartitions != nil {
+		config.CatchUpPartitions = *customConfig.CatchUpPartitions
+	}
 
 	return config
 }
@@ -235,22 +275,31 @@ func newProjectionsList() {
 	projections = []projection{
 		OrgProjection,
 		OrgMetadataProjection,
+		OrgParentProjection,
+		OrgCustomRoleProjection,
+		ProfileRequirementsProjection,
+		UserAttributeSchemaProjection,
 		ActionProjection,
 		FlowProjection,
 		ProjectProjection,
 		PasswordComplexityProjection,
 		PasswordAgeProjection,
 		LockoutPolicyProjection,
+		CaptchaPolicyProjection,
 		PrivacyPolicyProjection,
+		TermsVersionProjection,
 		DomainPolicyProjection,
 		LabelPolicyProjection,
 		ProjectGrantProjection,
 		ProjectRoleProjection,
 		OrgDomainProjection,
 		LoginPolicyProjection,
+		LoginPolicyIDPButtonsProjection,
 		IDPProjection,
 		IDPTemplateProjection,
 		AppProjection,
+		NetworkAccessPolicyProjection,
+		AppTokenLifetimesProjection,
 		IDPUserLinkProjection,
 		IDPLoginPolicyLinkProjection,
 		MailTemplateProjection,
@@ -260,6 +309,7 @@ func newProjectionsList() {
 		LoginNameProjection,
 		OrgMemberProjection,
 		InstanceDomainProjection,
+		InstanceDomainSettingsProjection,
 		InstanceMemberProjection,
 		ProjectMemberProjection,
 		ProjectGrantMemberProjection,
@@ -279,9 +329,11 @@ func newProjectionsList() {
 		NotificationPolicyProjection,
 		DeviceAuthProjection,
 		SessionProjection,
+		OIDCSessionClientProjection,
 		AuthRequestProjection,
 		MilestoneProjection,
 		QuotaProjection.handler,
+		InstanceUsageProjection.handler,
 		LimitsProjection,
 		RestrictionsProjection,
 		SystemFeatureProjection,
@@ -289,5 +341,11 @@ func newProjectionsList() {
 		TargetProjection,
 		ExecutionProjection,
 		UserSchemaProjection,
+		RevokedTokenProjection,
+		GroupProjection,
+		NotificationStatusProjection,
+		NotificationTemplateProjection,
+		NotificationQueueProjection,
+		SCIMSyncProjection,
 	}
 }
@@ -0,0 +1,166 @@
+package projection
+
+import (
+	"context"
+
+	"github.com/zitadel/zitadel/internal/eventstore"
+	old_handler "github.com/zitadel/zitadel/internal/eventstore/handler"
+	"github.com/zitadel/zitadel/internal/eventstore/handler/v2"
+	"github.com/zitadel/zitadel/internal/repository/instance"
+	"github.com/zitadel/zitadel/internal/repository/org"
+	"github.com/zitadel/zitadel/internal/repository/user"
+)
+
+const (
+	RevokedTokenProjectionTable = "projections.revoked_tokens"
+
+	RevokedTokenColumnTokenID        = "token_id"
+	RevokedTokenColumnRevocationDate = "revocation_date"
+	RevokedTokenColumnSequence       = "sequence"
+	RevokedTokenColumnResourceOwner  = "resource_owner"
+	RevokedTokenColumnInstanceID     = "instance_id"
+	RevokedTokenColumnUserID         = "user_id"
+)
+
+// revokedTokenProjection lists the revoked access tokens, personal access tokens and offline tokens,
+// so they can be rejected without loading the events of the user.
+type revokedTokenProjection struct{}
+
+func newRevokedTokenProjection(ctx context.Context, config handler.Config) *handler.Handler {
+	return handler.NewHandler(ctx, &config, new(revokedTokenProjection))
+}
+
+func (*revokedTokenProjection) Name() string {
+	return RevokedTokenProjectionTable
+}
+
+func (*revokedTokenProjection) Init() *old_handler.Check {
+	return handler.NewTableCheck(
+		handler.NewTable([]*handler.InitColumn{
+			handler.NewColumn(RevokedTokenColumnTokenID, handler.ColumnTypeText),
+			handler.NewColumn(RevokedTokenColumnRevocationDate, handler.ColumnTypeTimestamp),
+			handler.NewColumn(RevokedTokenColumnSequence, handler.ColumnTypeInt64),
+			handler.NewColumn(RevokedTokenColumnResourceOwner, handler.ColumnTypeText),
+			handler.NewColumn(RevokedTokenColumnInstanceID, handler.ColumnTypeText),
+			handler.NewColumn(RevokedTokenColumnUserID, handler.ColumnTypeText),
+		},
+			handler.NewPrimaryKey(RevokedTokenColumnInstanceID, RevokedTokenColumnTokenID),
+			handler.WithIndex(handler.NewIndex("user_id", []string{RevokedTokenColumnUserID})),
+			handler.WithIndex(handler.NewIndex("revocation_date", []string{RevokedTokenColumnRevocationDate})),
+		),
+	)
+}
+
+func (p *revokedTokenProjection) Reducers() []handler.AggregateReducer {
+	return []handler.AggregateReducer{
+		{
+			Aggregate: user.AggregateType,
+			EventReducers: []handler.EventReducer{
+				{
+					Event:  user.UserTokenRemovedType,
+					Reduce: p.reduceTokenRemoved,
+				},
+				{
+					Event:  user.PersonalAccessTokenRemovedType,
+					Reduce: p.reducePersonalAccessTokenRemoved,
+				},
+				{
+					Event:  user.OfflineTokenRevokedType,
+					Reduce: p.reduceOfflineTokenRevoked,
+				},
+				{
+					Event:  user.UserRemovedType,
+					Reduce: p.reduceUserRemoved,
+				},
+			},
+		},
+		{
+			Aggregate: org.AggregateType,
+			EventReducers: []handler.EventReducer{
+				{
+					Event:  org.OrgRemovedEventType,
+					Reduce: p.reduceOwnerRemoved,
+				},
+			},
+		},
+		{
+			Aggregate: instance.AggregateType,
+			EventReducers: []handler.EventReducer{
+				{
+					Event:  instance.InstanceRemovedEventType,
+					Reduce: reduceInstanceRemovedHelper(RevokedTokenColumnInstanceID),
+				},
+			},
+		},
+	}
+}
+
+func (p *revokedTokenProjection) reduceTokenRemoved(event eventstore.Event) (*handler.Statement, error) {
+	e, err := assertEvent[*user.UserTokenRemovedEvent](event)
+	if err != nil {
+		return nil, err
+	}
+	return p.revokedStatement(e, e.TokenID), nil
+}
+
+func (p *revokedTokenProjection) reducePersonalAccessTokenRemoved(event eventstore.Event) (*handler.Statement, error) {
+	e, err := assertEvent[*user.PersonalAccessTokenRemovedEvent](event)
+	if err != nil {
+		return nil, err
+	}
+	return p.revokedStatement(e, e.TokenID), nil
+}
+
+func (p *revokedTokenProjection) reduceOfflineTokenRevoked(event eventstore.Event) (*handler.Statement, error) {
+	e, err := assertEvent[*user.OfflineTokenRevokedEvent](event)
+	if err != nil {
+		return nil, err
+	}
+	return p.revokedStatement(e, e.TokenID), nil
+}
+
+func (p *revokedTokenProjection) revokedStatement(event eventstore.Event, tokenID string) *handler.Statement {
+	return handler.NewUpsertStatement(
+		event,
+		[]handler.Column{
+			handler.NewCol(RevokedTokenColumnInstanceID, nil),
+			handler.NewCol(RevokedTokenColumnTokenID, nil),
+		},
+		[]handler.Column{
+			handler.NewCol(RevokedTokenColumnInstanceID, event.Aggregate().InstanceID),
+			handler.NewCol(RevokedTokenColumnTokenID, tokenID),
+			handler.NewCol(RevokedTokenColumnRevocationDate, event.CreatedAt()),
+			handler.NewCol(RevokedTokenColumnSequence, event.Sequence()),
+			handler.NewCol(RevokedTokenColumnResourceOwner, event.Aggregate().ResourceOwner),
+			handler.NewCol(RevokedTokenColumnUserID, event.Aggregate().ID),
+		},
+	)
+}
+
+func (p *revokedTokenProjection) reduceUserRemoved(event eventstore.Event) (*handler.Statement, error) {
+	e, err := assertEvent[*user.UserRemovedEvent](event)
+	if err != nil {
+		return nil, err
+	}
+	return handler.NewDeleteStatement(
+		e,
+		[]handler.Condition{
+			handler.NewCond(RevokedTokenColumnUserID, e.Aggregate().ID),
+			handler.NewCond(RevokedTokenColumnInstanceID, e.Aggregate().InstanceID),
+		},
+	), nil
+}
+
+func (p *revokedTokenProjection) reduceOwnerRemoved(event eventstore.Event) (*handler.Statement, error) {
+	e, err := assertEvent[*org.OrgRemovedEvent](event)
+	if err != nil {
+		return nil, err
+	}
+	return handler.NewDeleteStatement(
+		e,
+		[]handler.Condition{
+			handler.NewCond(RevokedTokenColumnInstanceID, e.Aggregate().InstanceID),
+			handler.NewCond(RevokedTokenColumnResourceOwner, e.Aggregate().ID),
+		},
+	), nil
+}
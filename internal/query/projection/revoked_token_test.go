@@ -0,0 +1,211 @@
+package projection
+
+import (
+	"testing"
+
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/eventstore/handler/v2"
+	"github.com/zitadel/zitadel/internal/repository/instance"
+	"github.com/zitadel/zitadel/internal/repository/org"
+	"github.com/zitadel/zitadel/internal/repository/user"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+func TestRevokedTokenProjection_reduces(t *testing.T) {
+	type args struct {
+		event func(t *testing.T) eventstore.Event
+	}
+	tests := []struct {
+		name   string
+		args   args
+		reduce func(event eventstore.Event) (*handler.Statement, error)
+		want   wantReduce
+	}{
+		{
+			name: "reduceTokenRemoved",
+			args: args{
+				event: getEvent(
+					testEvent(
+						user.UserTokenRemovedType,
+						user.AggregateType,
+						[]byte(`{"tokenId": "tokenID"}`),
+					), user.UserTokenRemovedEventMapper),
+			},
+			reduce: (&revokedTokenProjection{}).reduceTokenRemoved,
+			want: wantReduce{
+				aggregateType: eventstore.AggregateType("user"),
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "INSERT INTO projections.revoked_tokens (instance_id, token_id, revocation_date, sequence, resource_owner, user_id) VALUES ($1, $2, $3, $4, $5, $6) ON CONFLICT (instance_id, token_id) DO UPDATE SET (revocation_date, sequence, resource_owner, user_id) = (EXCLUDED.revocation_date, EXCLUDED.sequence, EXCLUDED.resource_owner, EXCLUDED.user_id)",
+							expectedArgs: []interface{}{
+								"instance-id",
+								"tokenID",
+								anyArg{},
+								uint64(15),
+								"ro-id",
+								"agg-id",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "reducePersonalAccessTokenRemoved",
+			args: args{
+				event: getEvent(
+					testEvent(
+						user.PersonalAccessTokenRemovedType,
+						user.AggregateType,
+						[]byte(`{"tokenId": "tokenID"}`),
+					), user.PersonalAccessTokenRemovedEventMapper),
+			},
+			reduce: (&revokedTokenProjection{}).reducePersonalAccessTokenRemoved,
+			want: wantReduce{
+				aggregateType: eventstore.AggregateType("user"),
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "INSERT INTO projections.revoked_tokens (instance_id, token_id, revocation_date, sequence, resource_owner, user_id) VALUES ($1, $2, $3, $4, $5, $6) ON CONFLICT (instance_id, token_id) DO UPDATE SET (revocation_date, sequence, resource_owner, user_id) = (EXCLUDED.revocation_date, EXCLUDED.sequence, EXCLUDED.resource_owner, EXCLUDED.user_id)",
+							expectedArgs: []interface{}{
+								"instance-id",
+								"tokenID",
+								anyArg{},
+								uint64(15),
+								"ro-id",
+								"agg-id",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "reduceOfflineTokenRevoked",
+			args: args{
+				event: getEvent(
+					testEvent(
+						user.OfflineTokenRevokedType,
+						user.AggregateType,
+						[]byte(`{"tokenId": "tokenID"}`),
+					), user.OfflineTokenRevokedEventMapper),
+			},
+			reduce: (&revokedTokenProjection{}).reduceOfflineTokenRevoked,
+			want: wantReduce{
+				aggregateType: eventstore.AggregateType("user"),
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "INSERT INTO projections.revoked_tokens (instance_id, token_id, revocation_date, sequence, resource_owner, user_id) VALUES ($1, $2, $3, $4, $5, $6) ON CONFLICT (instance_id, token_id) DO UPDATE SET (revocation_date, sequence, resource_owner, user_id) = (EXCLUDED.revocation_date, EXCLUDED.sequence, EXCLUDED.resource_owner, EXCLUDED.user_id)",
+							expectedArgs: []interface{}{
+								"instance-id",
+								"tokenID",
+								anyArg{},
+								uint64(15),
+								"ro-id",
+								"agg-id",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "reduceUserRemoved",
+			args: args{
+				event: getEvent(
+					testEvent(
+						user.UserRemovedType,
+						user.AggregateType,
+						nil,
+					), user.UserRemovedEventMapper),
+			},
+			reduce: (&revokedTokenProjection{}).reduceUserRemoved,
+			want: wantReduce{
+				aggregateType: eventstore.AggregateType("user"),
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "DELETE FROM projections.revoked_tokens WHERE (user_id = $1) AND (instance_id = $2)",
+							expectedArgs: []interface{}{
+								"agg-id",
+								"instance-id",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "org reduceOwnerRemoved",
+			args: args{
+				event: getEvent(
+					testEvent(
+						org.OrgRemovedEventType,
+						org.AggregateType,
+						nil,
+					), org.OrgRemovedEventMapper),
+			},
+			reduce: (&revokedTokenProjection{}).reduceOwnerRemoved,
+			want: wantReduce{
+				aggregateType: eventstore.AggregateType("org"),
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "DELETE FROM projections.revoked_tokens WHERE (instance_id = $1) AND (resource_owner = $2)",
+							expectedArgs: []interface{}{
+								"instance-id",
+								"agg-id",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "instance reduceInstanceRemoved",
+			args: args{
+				event: getEvent(
+					testEvent(
+						instance.InstanceRemovedEventType,
+						instance.AggregateType,
+						nil,
+					), instance.InstanceRemovedEventMapper),
+			},
+			reduce: reduceInstanceRemovedHelper(RevokedTokenColumnInstanceID),
+			want: wantReduce{
+				aggregateType: eventstore.AggregateType("instance"),
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "DELETE FROM projections.revoked_tokens WHERE (instance_id = $1)",
+							expectedArgs: []interface{}{
+								"agg-id",
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			event := baseEvent(t)
+			got, err := tt.reduce(event)
+			if ok := zerrors.IsErrorInvalidArgument(err); !ok {
+				t.Errorf("no wrong event mapping: %v, got: %v", err, got)
+			}
+
+			event = tt.args.event(t)
+			got, err = tt.reduce(event)
+			assertReduce(t, got, err, RevokedTokenProjectionTable, tt.want)
+		})
+	}
+}
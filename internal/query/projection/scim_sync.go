@@ -0,0 +1,262 @@
+package projection
+
+import (
+	"context"
+
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	old_handler "github.com/zitadel/zitadel/internal/eventstore/handler"
+	"github.com/zitadel/zitadel/internal/eventstore/handler/v2"
+	"github.com/zitadel/zitadel/internal/repository/instance"
+	"github.com/zitadel/zitadel/internal/repository/org"
+	"github.com/zitadel/zitadel/internal/repository/project"
+	"github.com/zitadel/zitadel/internal/repository/provisioning"
+)
+
+const (
+	SCIMSyncTable            = "projections.scim_syncs"
+	SCIMSyncInstanceIDCol    = "instance_id"
+	SCIMSyncTargetIDCol      = "target_id"
+	SCIMSyncUserIDCol        = "user_id"
+	SCIMSyncProjectIDCol     = "project_id"
+	SCIMSyncResourceOwnerCol = "resource_owner"
+	SCIMSyncCreationDateCol  = "creation_date"
+	SCIMSyncChangeDateCol    = "change_date"
+	SCIMSyncSequenceCol      = "sequence"
+	SCIMSyncStateCol         = "state"
+	SCIMSyncReasonCol        = "reason"
+	SCIMSyncAttemptsCol      = "attempts"
+	SCIMSyncNextAttemptCol   = "next_attempt"
+	SCIMSyncRemoteIDCol      = "remote_id"
+	SCIMSyncLastSyncDateCol  = "last_sync_date"
+	SCIMSyncLastErrorCol     = "last_error"
+)
+
+type scimSyncProjection struct{}
+
+func newSCIMSyncProjection(ctx context.Context, config handler.Config) *handler.Handler {
+	return handler.NewHandler(ctx, &config, new(scimSyncProjection))
+}
+
+func (*scimSyncProjection) Name() string {
+	return SCIMSyncTable
+}
+
+func (*scimSyncProjection) Init() *old_handler.Check {
+	return handler.NewTableCheck(
+		handler.NewTable([]*handler.InitColumn{
+			handler.NewColumn(SCIMSyncInstanceIDCol, handler.ColumnTypeText),
+			handler.NewColumn(SCIMSyncTargetIDCol, handler.ColumnTypeText),
+			handler.NewColumn(SCIMSyncUserIDCol, handler.ColumnTypeText),
+			handler.NewColumn(SCIMSyncProjectIDCol, handler.ColumnTypeText),
+			handler.NewColumn(SCIMSyncResourceOwnerCol, handler.ColumnTypeText),
+			handler.NewColumn(SCIMSyncCreationDateCol, handler.ColumnTypeTimestamp),
+			handler.NewColumn(SCIMSyncChangeDateCol, handler.ColumnTypeTimestamp),
+			handler.NewColumn(SCIMSyncSequenceCol, handler.ColumnTypeInt64),
+			handler.NewColumn(SCIMSyncStateCol, handler.ColumnTypeEnum),
+			handler.NewColumn(SCIMSyncReasonCol, handler.ColumnTypeText),
+			handler.NewColumn(SCIMSyncAttemptsCol, handler.ColumnTypeInt64),
+			handler.NewColumn(SCIMSyncNextAttemptCol, handler.ColumnTypeTimestamp),
+			handler.NewColumn(SCIMSyncRemoteIDCol, handler.ColumnTypeText, handler.Nullable()),
+			handler.NewColumn(SCIMSyncLastSyncDateCol, handler.ColumnTypeTimestamp, handler.Nullable()),
+			handler.NewColumn(SCIMSyncLastErrorCol, handler.ColumnTypeText, handler.Nullable()),
+		},
+			handler.NewPrimaryKey(SCIMSyncInstanceIDCol, SCIMSyncTargetIDCol, SCIMSyncUserIDCol),
+			handler.WithIndex(handler.NewIndex("next_attempt", []string{SCIMSyncStateCol, SCIMSyncNextAttemptCol})),
+			handler.WithIndex(handler.NewIndex("project_id", []string{SCIMSyncProjectIDCol})),
+		),
+	)
+}
+
+func (p *scimSyncProjection) Reducers() []handler.AggregateReducer {
+	return []handler.AggregateReducer{
+		{
+			Aggregate: provisioning.AggregateType,
+			EventReducers: []handler.EventReducer{
+				{
+					Event:  provisioning.SyncRequestedEventType,
+					Reduce: p.reduceSyncRequested,
+				},
+				{
+					Event:  provisioning.SyncSucceededEventType,
+					Reduce: p.reduceSyncSucceeded,
+				},
+				{
+					Event:  provisioning.SyncRetryScheduledEventType,
+					Reduce: p.reduceSyncRetryScheduled,
+				},
+				{
+					Event:  provisioning.SyncFailedEventType,
+					Reduce: p.reduceSyncFailed,
+				},
+			},
+		},
+		{
+			Aggregate: project.AggregateType,
+			EventReducers: []handler.EventReducer{
+				{
+					Event:  project.SCIMTargetRemovedType,
+					Reduce: p.reduceTargetRemoved,
+				},
+				{
+					Event:  project.ProjectRemovedType,
+					Reduce: p.reduceProjectRemoved,
+				},
+			},
+		},
+		{
+			Aggregate: org.AggregateType,
+			EventReducers: []handler.EventReducer{
+				{
+					Event:  org.OrgRemovedEventType,
+					Reduce: p.reduceOwnerRemoved,
+				},
+			},
+		},
+		{
+			Aggregate: instance.AggregateType,
+			EventReducers: []handler.EventReducer{
+				{
+					Event:  instance.InstanceRemovedEventType,
+					Reduce: reduceInstanceRemovedHelper(SCIMSyncInstanceIDCol),
+				},
+			},
+		},
+	}
+}
+
+func (p *scimSyncProjection) reduceSyncRequested(event eventstore.Event) (*handler.Statement, error) {
+	e, err := assertEvent[*provisioning.SyncRequestedEvent](event)
+	if err != nil {
+		return nil, err
+	}
+	// the sync is attempted immediately, the remote id and the date of the last sync are kept
+	return handler.NewUpsertStatement(
+		e,
+		[]handler.Column{
+			handler.NewCol(SCIMSyncInstanceIDCol, nil),
+			handler.NewCol(SCIMSyncTargetIDCol, nil),
+			handler.NewCol(SCIMSyncUserIDCol, nil),
+		},
+		[]handler.Column{
+			handler.NewCol(SCIMSyncInstanceIDCol, e.Aggregate().InstanceID),
+			handler.NewCol(SCIMSyncTargetIDCol, e.Aggregate().ID),
+			handler.NewCol(SCIMSyncUserIDCol, e.UserID),
+			handler.NewCol(SCIMSyncProjectIDCol, e.ProjectID),
+			handler.NewCol(SCIMSyncResourceOwnerCol, e.Aggregate().ResourceOwner),
+			handler.NewCol(SCIMSyncCreationDateCol, handler.OnlySetValueOnInsert(SCIMSyncTable, e.CreationDate())),
+			handler.NewCol(SCIMSyncChangeDateCol, e.CreationDate()),
+			handler.NewCol(SCIMSyncSequenceCol, e.Sequence()),
+			handler.NewCol(SCIMSyncStateCol, domain.SCIMSyncStatePending),
+			handler.NewCol(SCIMSyncReasonCol, e.Reason),
+			handler.NewCol(SCIMSyncAttemptsCol, 0),
+			handler.NewCol(SCIMSyncNextAttemptCol, e.CreationDate()),
+		},
+	), nil
+}
+
+func (p *scimSyncProjection) reduceSyncSucceeded(event eventstore.Event) (*handler.Statement, error) {
+	e, err := assertEvent[*provisioning.SyncSucceededEvent](event)
+	if err != nil {
+		return nil, err
+	}
+	return handler.NewUpdateStatement(
+		e,
+		[]handler.Column{
+			handler.NewCol(SCIMSyncChangeDateCol, e.CreationDate()),
+			handler.NewCol(SCIMSyncSequenceCol, e.Sequence()),
+			handler.NewCol(SCIMSyncStateCol, domain.SCIMSyncStateSynced),
+			handler.NewIncrementCol(SCIMSyncAttemptsCol, 1),
+			handler.NewCol(SCIMSyncRemoteIDCol, e.RemoteID),
+			handler.NewCol(SCIMSyncLastSyncDateCol, e.CreationDate()),
+			handler.NewCol(SCIMSyncLastErrorCol, nil),
+		},
+		scimSyncConditions(e, e.UserID),
+	), nil
+}
+
+func (p *scimSyncProjection) reduceSyncRetryScheduled(event eventstore.Event) (*handler.Statement, error) {
+	e, err := assertEvent[*provisioning.SyncRetryScheduledEvent](event)
+	if err != nil {
+		return nil, err
+	}
+	return handler.NewUpdateStatement(
+		e,
+		[]handler.Column{
+			handler.NewCol(SCIMSyncChangeDateCol, e.CreationDate()),
+			handler.NewCol(SCIMSyncSequenceCol, e.Sequence()),
+			handler.NewIncrementCol(SCIMSyncAttemptsCol, 1),
+			handler.NewCol(SCIMSyncNextAttemptCol, e.NextAttempt),
+			handler.NewCol(SCIMSyncLastErrorCol, e.Reason),
+		},
+		scimSyncConditions(e, e.UserID),
+	), nil
+}
+
+func (p *scimSyncProjection) reduceSyncFailed(event eventstore.Event) (*handler.Statement, error) {
+	e, err := assertEvent[*provisioning.SyncFailedEvent](event)
+	if err != nil {
+		return nil, err
+	}
+	return handler.NewUpdateStatement(
+		e,
+		[]handler.Column{
+			handler.NewCol(SCIMSyncChangeDateCol, e.CreationDate()),
+			handler.NewCol(SCIMSyncSequenceCol, e.Sequence()),
+			handler.NewIncrementCol(SCIMSyncAttemptsCol, 1),
+			handler.NewCol(SCIMSyncStateCol, domain.SCIMSyncStateFailed),
+			handler.NewCol(SCIMSyncLastErrorCol, e.Reason),
+		},
+		scimSyncConditions(e, e.UserID),
+	), nil
+}
+
+func (p *scimSyncProjection) reduceTargetRemoved(event eventstore.Event) (*handler.Statement, error) {
+	e, err := assertEvent[*project.SCIMTargetRemovedEvent](event)
+	if err != nil {
+		return nil, err
+	}
+	return handler.NewDeleteStatement(
+		e,
+		[]handler.Condition{
+			handler.NewCond(SCIMSyncInstanceIDCol, e.Aggregate().InstanceID),
+			handler.NewCond(SCIMSyncTargetIDCol, e.ID),
+		},
+	), nil
+}
+
+func (p *scimSyncProjection) reduceProjectRemoved(event eventstore.Event) (*handler.Statement, error) {
+	e, err := assertEvent[*project.ProjectRemovedEvent](event)
+	if err != nil {
+		return nil, err
+	}
+	return handler.NewDeleteStatement(
+		e,
+		[]handler.Condition{
+			handler.NewCond(SCIMSyncInstanceIDCol, e.Aggregate().InstanceID),
+			handler.NewCond(SCIMSyncProjectIDCol, e.Aggregate().ID),
+		},
+	), nil
+}
+
+func (p *scimSyncProjection) reduceOwnerRemoved(event eventstore.Event) (*handler.Statement, error) {
+	e, err := assertEvent[*org.OrgRemovedEvent](event)
+	if err != nil {
+		return nil, err
+	}
+	return handler.NewDeleteStatement(
+		e,
+		[]handler.Condition{
+			handler.NewCond(SCIMSyncInstanceIDCol, e.Aggregate().InstanceID),
+			handler.NewCond(SCIMSyncResourceOwnerCol, e.Aggregate().ID),
+		},
+	), nil
+}
+
+func scimSyncConditions(e eventstore.Event, userID string) []handler.Condition {
+	return []handler.Condition{
+		handler.NewCond(SCIMSyncInstanceIDCol, e.Aggregate().InstanceID),
+		handler.NewCond(SCIMSyncTargetIDCol, e.Aggregate().ID),
+		handler.NewCond(SCIMSyncUserIDCol, userID),
+	}
+}
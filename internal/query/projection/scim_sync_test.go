@@ -0,0 +1,301 @@
+package projection
+
+import (
+	"testing"
+
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/eventstore/handler/v2"
+	"github.com/zitadel/zitadel/internal/repository/instance"
+	"github.com/zitadel/zitadel/internal/repository/org"
+	"github.com/zitadel/zitadel/internal/repository/project"
+	"github.com/zitadel/zitadel/internal/repository/provisioning"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+func TestSCIMSyncProjection_reduces(t *testing.T) {
+	type args struct {
+		event func(t *testing.T) eventstore.Event
+	}
+	tests := []struct {
+		name   string
+		args   args
+		reduce func(event eventstore.Event) (*handler.Statement, error)
+		want   wantReduce
+	}{
+		{
+			name: "reduceSyncRequested",
+			args: args{
+				event: getEvent(
+					testEvent(
+						provisioning.SyncRequestedEventType,
+						provisioning.AggregateType,
+						[]byte(`{"userId": "user1", "projectId": "project1", "reason": "user.grant.added"}`),
+					),
+					provisioning.SyncRequestedEventMapper,
+				),
+			},
+			reduce: (&scimSyncProjection{}).reduceSyncRequested,
+			want: wantReduce{
+				aggregateType: eventstore.AggregateType("provisioning"),
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "INSERT INTO projections.scim_syncs (instance_id, target_id, user_id, project_id, resource_owner, creation_date, change_date, sequence, state, reason, attempts, next_attempt) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12) ON CONFLICT (instance_id, target_id, user_id) DO UPDATE SET (project_id, resource_owner, creation_date, change_date, sequence, state, reason, attempts, next_attempt) = (EXCLUDED.project_id, EXCLUDED.resource_owner, projections.scim_syncs.creation_date, EXCLUDED.change_date, EXCLUDED.sequence, EXCLUDED.state, EXCLUDED.reason, EXCLUDED.attempts, EXCLUDED.next_attempt)",
+							expectedArgs: []interface{}{
+								"instance-id",
+								"agg-id",
+								"user1",
+								"project1",
+								"ro-id",
+								anyArg{},
+								anyArg{},
+								uint64(15),
+								domain.SCIMSyncStatePending,
+								"user.grant.added",
+								0,
+								anyArg{},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "reduceSyncSucceeded",
+			args: args{
+				event: getEvent(
+					testEvent(
+						provisioning.SyncSucceededEventType,
+						provisioning.AggregateType,
+						[]byte(`{"userId": "user1", "remoteId": "remote1"}`),
+					),
+					provisioning.SyncSucceededEventMapper,
+				),
+			},
+			reduce: (&scimSyncProjection{}).reduceSyncSucceeded,
+			want: wantReduce{
+				aggregateType: eventstore.AggregateType("provisioning"),
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "UPDATE projections.scim_syncs SET (change_date, sequence, state, attempts, remote_id, last_sync_date, last_error) = ($1, $2, $3, attempts + $4, $5, $6, $7) WHERE (instance_id = $8) AND (target_id = $9) AND (user_id = $10)",
+							expectedArgs: []interface{}{
+								anyArg{},
+								uint64(15),
+								domain.SCIMSyncStateSynced,
+								1,
+								"remote1",
+								anyArg{},
+								nil,
+								"instance-id",
+								"agg-id",
+								"user1",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "reduceSyncRetryScheduled",
+			args: args{
+				event: getEvent(
+					testEvent(
+						provisioning.SyncRetryScheduledEventType,
+						provisioning.AggregateType,
+						[]byte(`{"userId": "user1", "reason": "unavailable", "nextAttempt": "2024-01-01T00:00:00Z"}`),
+					),
+					provisioning.SyncRetryScheduledEventMapper,
+				),
+			},
+			reduce: (&scimSyncProjection{}).reduceSyncRetryScheduled,
+			want: wantReduce{
+				aggregateType: eventstore.AggregateType("provisioning"),
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "UPDATE projections.scim_syncs SET (change_date, sequence, attempts, next_attempt, last_error) = ($1, $2, attempts + $3, $4, $5) WHERE (instance_id = $6) AND (target_id = $7) AND (user_id = $8)",
+							expectedArgs: []interface{}{
+								anyArg{},
+								uint64(15),
+								1,
+								anyArg{},
+								"unavailable",
+								"instance-id",
+								"agg-id",
+								"user1",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "reduceSyncFailed",
+			args: args{
+				event: getEvent(
+					testEvent(
+						provisioning.SyncFailedEventType,
+						provisioning.AggregateType,
+						[]byte(`{"userId": "user1", "reason": "unauthorized"}`),
+					),
+					provisioning.SyncFailedEventMapper,
+				),
+			},
+			reduce: (&scimSyncProjection{}).reduceSyncFailed,
+			want: wantReduce{
+				aggregateType: eventstore.AggregateType("provisioning"),
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "UPDATE projections.scim_syncs SET (change_date, sequence, attempts, state, last_error) = ($1, $2, attempts + $3, $4, $5) WHERE (instance_id = $6) AND (target_id = $7) AND (user_id = $8)",
+							expectedArgs: []interface{}{
+								anyArg{},
+								uint64(15),
+								1,
+								domain.SCIMSyncStateFailed,
+								"unauthorized",
+								"instance-id",
+								"agg-id",
+								"user1",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "project reduceTargetRemoved",
+			args: args{
+				event: getEvent(
+					testEvent(
+						project.SCIMTargetRemovedType,
+						project.AggregateType,
+						[]byte(`{"id": "target1"}`),
+					),
+					project.SCIMTargetRemovedEventMapper,
+				),
+			},
+			reduce: (&scimSyncProjection{}).reduceTargetRemoved,
+			want: wantReduce{
+				aggregateType: eventstore.AggregateType("project"),
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "DELETE FROM projections.scim_syncs WHERE (instance_id = $1) AND (target_id = $2)",
+							expectedArgs: []interface{}{
+								"instance-id",
+								"target1",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "project reduceProjectRemoved",
+			args: args{
+				event: getEvent(
+					testEvent(
+						project.ProjectRemovedType,
+						project.AggregateType,
+						nil,
+					),
+					project.ProjectRemovedEventMapper,
+				),
+			},
+			reduce: (&scimSyncProjection{}).reduceProjectRemoved,
+			want: wantReduce{
+				aggregateType: eventstore.AggregateType("project"),
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "DELETE FROM projections.scim_syncs WHERE (instance_id = $1) AND (project_id = $2)",
+							expectedArgs: []interface{}{
+								"instance-id",
+								"agg-id",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "org reduceOwnerRemoved",
+			args: args{
+				event: getEvent(
+					testEvent(
+						org.OrgRemovedEventType,
+						org.AggregateType,
+						nil,
+					),
+					org.OrgRemovedEventMapper,
+				),
+			},
+			reduce: (&scimSyncProjection{}).reduceOwnerRemoved,
+			want: wantReduce{
+				aggregateType: eventstore.AggregateType("org"),
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "DELETE FROM projections.scim_syncs WHERE (instance_id = $1) AND (resource_owner = $2)",
+							expectedArgs: []interface{}{
+								"instance-id",
+								"agg-id",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "instance reduceInstanceRemoved",
+			args: args{
+				event: getEvent(
+					testEvent(
+						instance.InstanceRemovedEventType,
+						instance.AggregateType,
+						nil,
+					),
+					instance.InstanceRemovedEventMapper,
+				),
+			},
+			reduce: reduceInstanceRemovedHelper(SCIMSyncInstanceIDCol),
+			want: wantReduce{
+				aggregateType: eventstore.AggregateType("instance"),
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "DELETE FROM projections.scim_syncs WHERE (instance_id = $1)",
+							expectedArgs: []interface{}{
+								"agg-id",
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			event := baseEvent(t)
+			got, err := tt.reduce(event)
+			if ok := zerrors.IsErrorInvalidArgument(err); !ok {
+				t.Errorf("no wrong event mapping: %v, got: %v", err, got)
+			}
+
+			event = tt.args.event(t)
+			got, err = tt.reduce(event)
+			assertReduce(t, got, err, SCIMSyncTable, tt.want)
+		})
+	}
+}
@@ -11,7 +11,7 @@ import (
 )
 
 const (
-	SecurityPolicyProjectionTable             = "projections.security_policies2"
+	SecurityPolicyProjectionTable             = "projections.security_policies3"
 	SecurityPolicyColumnInstanceID            = "instance_id"
 	SecurityPolicyColumnCreationDate          = "creation_date"
 	SecurityPolicyColumnChangeDate            = "change_date"
@@ -19,6 +19,7 @@ const (
 	SecurityPolicyColumnEnableIframeEmbedding = "enable_iframe_embedding"
 	SecurityPolicyColumnAllowedOrigins        = "origins"
 	SecurityPolicyColumnEnableImpersonation   = "enable_impersonation"
+	SecurityPolicyColumnActionsHTTPAllowList  = "actions_http_allow_list"
 )
 
 type securityPolicyProjection struct{}
@@ -41,6 +42,7 @@ func (*securityPolicyProjection) Init() *old_handler.Check {
 			handler.NewColumn(SecurityPolicyColumnEnableIframeEmbedding, handler.ColumnTypeBool, handler.Default(false)),
 			handler.NewColumn(SecurityPolicyColumnAllowedOrigins, handler.ColumnTypeTextArray, handler.Nullable()),
 			handler.NewColumn(SecurityPolicyColumnEnableImpersonation, handler.ColumnTypeBool, handler.Default(false)),
+			handler.NewColumn(SecurityPolicyColumnActionsHTTPAllowList, handler.ColumnTypeTextArray, handler.Nullable()),
 		},
 			handler.NewPrimaryKey(SecurityPolicyColumnInstanceID),
 		),
@@ -87,6 +89,9 @@ func (p *securityPolicyProjection) reduceSecurityPolicySet(event eventstore.Even
 	if e.EnableImpersonation != nil {
 		changes = append(changes, handler.NewCol(SecurityPolicyColumnEnableImpersonation, e.EnableImpersonation))
 	}
+	if e.ActionsHTTPAllowList != nil {
+		changes = append(changes, handler.NewCol(SecurityPolicyColumnActionsHTTPAllowList, e.ActionsHTTPAllowList))
+	}
 	return handler.NewUpsertStatement(
 		e,
 		[]handler.Column{
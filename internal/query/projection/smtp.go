@@ -28,6 +28,14 @@ const (
 	SMTPConfigColumnSMTPPassword   = "password"
 	SMTPConfigColumnState          = "state"
 	SMTPConfigColumnDescription    = "description"
+
+	SMTPConfigHTTPTable               = SMTPConfigProjectionTable + "_" + smtpConfigHTTPTableSuffix
+	smtpConfigHTTPTableSuffix         = "http"
+	SMTPConfigHTTPColumnInstanceID    = "instance_id"
+	SMTPConfigHTTPColumnResourceOwner = "resource_owner"
+	SMTPConfigHTTPColumnSMTPID        = "smtp_id"
+	SMTPConfigHTTPColumnEndpoint      = "endpoint"
+	SMTPConfigHTTPColumnSigningKey    = "signing_key"
 )
 
 type smtpConfigProjection struct{}
@@ -41,7 +49,7 @@ func (*smtpConfigProjection) Name() string {
 }
 
 func (*smtpConfigProjection) Init() *old_handler.Check {
-	return handler.NewTableCheck(
+	return handler.NewMultiTableCheck(
 		handler.NewTable([]*handler.InitColumn{
 			handler.NewColumn(SMTPConfigColumnID, handler.ColumnTypeText),
 			handler.NewColumn(SMTPConfigColumnCreationDate, handler.ColumnTypeTimestamp),
@@ -61,6 +69,17 @@ func (*smtpConfigProjection) Init() *old_handler.Check {
 		},
 			handler.NewPrimaryKey(SMTPConfigColumnInstanceID, SMTPConfigColumnResourceOwner, SMTPConfigColumnID),
 		),
+		handler.NewSuffixedTable([]*handler.InitColumn{
+			handler.NewColumn(SMTPConfigHTTPColumnInstanceID, handler.ColumnTypeText),
+			handler.NewColumn(SMTPConfigHTTPColumnResourceOwner, handler.ColumnTypeText),
+			handler.NewColumn(SMTPConfigHTTPColumnSMTPID, handler.ColumnTypeText),
+			handler.NewColumn(SMTPConfigHTTPColumnEndpoint, handler.ColumnTypeText),
+			handler.NewColumn(SMTPConfigHTTPColumnSigningKey, handler.ColumnTypeJSONB),
+		},
+			handler.NewPrimaryKey(SMTPConfigHTTPColumnInstanceID, SMTPConfigHTTPColumnResourceOwner, SMTPConfigHTTPColumnSMTPID),
+			smtpConfigHTTPTableSuffix,
+			handler.WithForeignKey(handler.NewForeignKeyOfPublicKeys()),
+		),
 	)
 }
 
@@ -81,6 +100,14 @@ func (p *smtpConfigProjection) Reducers() []handler.AggregateReducer {
 					Event:  instance.SMTPConfigPasswordChangedEventType,
 					Reduce: p.reduceSMTPConfigPasswordChanged,
 				},
+				{
+					Event:  instance.SMTPConfigHTTPAddedEventType,
+					Reduce: p.reduceSMTPConfigHTTPAdded,
+				},
+				{
+					Event:  instance.SMTPConfigHTTPChangedEventType,
+					Reduce: p.reduceSMTPConfigHTTPChanged,
+				},
 				{
 					Event:  instance.SMTPConfigActivatedEventType,
 					Reduce: p.reduceSMTPConfigActivated,
@@ -218,6 +245,90 @@ func (p *smtpConfigProjection) reduceSMTPConfigPasswordChanged(event eventstore.
 	), nil
 }
 
+func (p *smtpConfigProjection) reduceSMTPConfigHTTPAdded(event eventstore.Event) (*handler.Statement, error) {
+	e, err := assertEvent[*instance.SMTPConfigHTTPAddedEvent](event)
+	if err != nil {
+		return nil, err
+	}
+
+	return handler.NewMultiStatement(
+		e,
+		handler.AddCreateStatement(
+			[]handler.Column{
+				handler.NewCol(SMTPConfigColumnCreationDate, e.CreationDate()),
+				handler.NewCol(SMTPConfigColumnChangeDate, e.CreationDate()),
+				handler.NewCol(SMTPConfigColumnResourceOwner, e.Aggregate().ResourceOwner),
+				handler.NewCol(SMTPConfigColumnInstanceID, e.Aggregate().InstanceID),
+				handler.NewCol(SMTPConfigColumnSequence, e.Sequence()),
+				handler.NewCol(SMTPConfigColumnID, e.ID),
+				handler.NewCol(SMTPConfigColumnTLS, false),
+				handler.NewCol(SMTPConfigColumnSenderAddress, ""),
+				handler.NewCol(SMTPConfigColumnSenderName, ""),
+				handler.NewCol(SMTPConfigColumnReplyToAddress, ""),
+				handler.NewCol(SMTPConfigColumnSMTPHost, ""),
+				handler.NewCol(SMTPConfigColumnSMTPUser, ""),
+				handler.NewCol(SMTPConfigColumnState, domain.SMTPConfigStateInactive),
+				handler.NewCol(SMTPConfigColumnDescription, e.Description),
+			},
+		),
+		handler.AddCreateStatement(
+			[]handler.Column{
+				handler.NewCol(SMTPConfigHTTPColumnInstanceID, e.Aggregate().InstanceID),
+				handler.NewCol(SMTPConfigHTTPColumnResourceOwner, e.Aggregate().ResourceOwner),
+				handler.NewCol(SMTPConfigHTTPColumnSMTPID, e.ID),
+				handler.NewCol(SMTPConfigHTTPColumnEndpoint, e.Endpoint),
+				handler.NewCol(SMTPConfigHTTPColumnSigningKey, e.SigningKey),
+			},
+			handler.WithTableSuffix(smtpConfigHTTPTableSuffix),
+		),
+	), nil
+}
+
+func (p *smtpConfigProjection) reduceSMTPConfigHTTPChanged(event eventstore.Event) (*handler.Statement, error) {
+	e, err := assertEvent[*instance.SMTPConfigHTTPChangedEvent](event)
+	if err != nil {
+		return nil, err
+	}
+
+	columns := []handler.Column{
+		handler.NewCol(SMTPConfigColumnChangeDate, e.CreationDate()),
+		handler.NewCol(SMTPConfigColumnSequence, e.Sequence()),
+	}
+	if e.Description != nil {
+		columns = append(columns, handler.NewCol(SMTPConfigColumnDescription, *e.Description))
+	}
+	httpColumns := make([]handler.Column, 0, 2)
+	if e.Endpoint != nil {
+		httpColumns = append(httpColumns, handler.NewCol(SMTPConfigHTTPColumnEndpoint, *e.Endpoint))
+	}
+	if e.SigningKey != nil {
+		httpColumns = append(httpColumns, handler.NewCol(SMTPConfigHTTPColumnSigningKey, e.SigningKey))
+	}
+
+	stmts := []func(eventstore.Event) handler.Exec{
+		handler.AddUpdateStatement(
+			columns,
+			[]handler.Condition{
+				handler.NewCond(SMTPConfigColumnID, e.ID),
+				handler.NewCond(SMTPConfigColumnResourceOwner, e.Aggregate().ResourceOwner),
+				handler.NewCond(SMTPConfigColumnInstanceID, e.Aggregate().InstanceID),
+			},
+		),
+	}
+	if len(httpColumns) > 0 {
+		stmts = append(stmts, handler.AddUpdateStatement(
+			httpColumns,
+			[]handler.Condition{
+				handler.NewCond(SMTPConfigHTTPColumnSMTPID, e.ID),
+				handler.NewCond(SMTPConfigHTTPColumnResourceOwner, e.Aggregate().ResourceOwner),
+				handler.NewCond(SMTPConfigHTTPColumnInstanceID, e.Aggregate().InstanceID),
+			},
+			handler.WithTableSuffix(smtpConfigHTTPTableSuffix),
+		))
+	}
+	return handler.NewMultiStatement(e, stmts...), nil
+}
+
 func (p *smtpConfigProjection) reduceSMTPConfigActivated(event eventstore.Event) (*handler.Statement, error) {
 	e, ok := event.(*instance.SMTPConfigActivatedEvent)
 	if !ok {
@@ -80,6 +80,10 @@ func (*systemFeatureProjection) Reducers() []handler.AggregateReducer {
 				Event:  feature_v2.SystemImprovedPerformanceEventType,
 				Reduce: reduceSystemSetFeature[[]feature.ImprovedPerformanceType],
 			},
+			{
+				Event:  feature_v2.SystemLoginV2EventType,
+				Reduce: reduceSystemSetFeature[bool],
+			},
 		},
 	}}
 }
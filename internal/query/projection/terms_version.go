@@ -0,0 +1,132 @@
+package projection
+
+import (
+	"context"
+
+	"github.com/zitadel/zitadel/internal/eventstore"
+	old_handler "github.com/zitadel/zitadel/internal/eventstore/handler"
+	"github.com/zitadel/zitadel/internal/eventstore/handler/v2"
+	"github.com/zitadel/zitadel/internal/repository/instance"
+	"github.com/zitadel/zitadel/internal/repository/org"
+	"github.com/zitadel/zitadel/internal/repository/policy"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+const (
+	TermsVersionTable = "projections.terms_versions"
+
+	TermsVersionInstanceIDCol    = "instance_id"
+	TermsVersionResourceOwnerCol = "resource_owner"
+	TermsVersionIsDefaultCol     = "is_default"
+	TermsVersionPublishedAtCol   = "published_at"
+	TermsVersionSequenceCol      = "sequence"
+	TermsVersionVersionCol       = "version"
+	TermsVersionTOSLinkCol       = "tos_link"
+	TermsVersionPrivacyLinkCol   = "privacy_link"
+)
+
+// termsVersionProjection contains the latest published version of the terms of service
+// and privacy policy of every organization and instance.
+type termsVersionProjection struct{}
+
+func newTermsVersionProjection(ctx context.Context, config handler.Config) *handler.Handler {
+	return handler.NewHandler(ctx, &config, new(termsVersionProjection))
+}
+
+func (*termsVersionProjection) Name() string {
+	return TermsVersionTable
+}
+
+func (*termsVersionProjection) Init() *old_handler.Check {
+	return handler.NewTableCheck(
+		handler.NewTable([]*handler.InitColumn{
+			handler.NewColumn(TermsVersionInstanceIDCol, handler.ColumnTypeText),
+			handler.NewColumn(TermsVersionResourceOwnerCol, handler.ColumnTypeText),
+			handler.NewColumn(TermsVersionIsDefaultCol, handler.ColumnTypeBool, handler.Default(false)),
+			handler.NewColumn(TermsVersionPublishedAtCol, handler.ColumnTypeTimestamp),
+			handler.NewColumn(TermsVersionSequenceCol, handler.ColumnTypeInt64),
+			handler.NewColumn(TermsVersionVersionCol, handler.ColumnTypeText),
+			handler.NewColumn(TermsVersionTOSLinkCol, handler.ColumnTypeText, handler.Default("")),
+			handler.NewColumn(TermsVersionPrivacyLinkCol, handler.ColumnTypeText, handler.Default("")),
+		},
+			handler.NewPrimaryKey(TermsVersionInstanceIDCol, TermsVersionResourceOwnerCol),
+		),
+	)
+}
+
+func (p *termsVersionProjection) Reducers() []handler.AggregateReducer {
+	return []handler.AggregateReducer{
+		{
+			Aggregate: org.AggregateType,
+			EventReducers: []handler.EventReducer{
+				{
+					Event:  org.TermsVersionPublishedEventType,
+					Reduce: p.reducePublished,
+				},
+				{
+					Event:  org.OrgRemovedEventType,
+					Reduce: p.reduceOwnerRemoved,
+				},
+			},
+		},
+		{
+			Aggregate: instance.AggregateType,
+			EventReducers: []handler.EventReducer{
+				{
+					Event:  instance.TermsVersionPublishedEventType,
+					Reduce: p.reducePublished,
+				},
+				{
+					Event:  instance.InstanceRemovedEventType,
+					Reduce: reduceInstanceRemovedHelper(TermsVersionInstanceIDCol),
+				},
+			},
+		},
+	}
+}
+
+func (p *termsVersionProjection) reducePublished(event eventstore.Event) (*handler.Statement, error) {
+	var publishedEvent policy.TermsVersionPublishedEvent
+	var isDefault bool
+	switch e := event.(type) {
+	case *org.TermsVersionPublishedEvent:
+		publishedEvent = e.TermsVersionPublishedEvent
+		isDefault = false
+	case *instance.TermsVersionPublishedEvent:
+		publishedEvent = e.TermsVersionPublishedEvent
+		isDefault = true
+	default:
+		return nil, zerrors.ThrowInvalidArgumentf(nil, "PROJE-Tv3pb", "reduce.wrong.event.type %v", []eventstore.EventType{org.TermsVersionPublishedEventType, instance.TermsVersionPublishedEventType})
+	}
+	return handler.NewUpsertStatement(
+		&publishedEvent,
+		[]handler.Column{
+			handler.NewCol(TermsVersionInstanceIDCol, nil),
+			handler.NewCol(TermsVersionResourceOwnerCol, nil),
+		},
+		[]handler.Column{
+			handler.NewCol(TermsVersionInstanceIDCol, publishedEvent.Aggregate().InstanceID),
+			handler.NewCol(TermsVersionResourceOwnerCol, publishedEvent.Aggregate().ResourceOwner),
+			handler.NewCol(TermsVersionIsDefaultCol, isDefault),
+			handler.NewCol(TermsVersionPublishedAtCol, publishedEvent.CreatedAt()),
+			handler.NewCol(TermsVersionSequenceCol, publishedEvent.Sequence()),
+			handler.NewCol(TermsVersionVersionCol, publishedEvent.Version),
+			handler.NewCol(TermsVersionTOSLinkCol, publishedEvent.TOSLink),
+			handler.NewCol(TermsVersionPrivacyLinkCol, publishedEvent.PrivacyLink),
+		},
+	), nil
+}
+
+func (p *termsVersionProjection) reduceOwnerRemoved(event eventstore.Event) (*handler.Statement, error) {
+	e, err := assertEvent[*org.OrgRemovedEvent](event)
+	if err != nil {
+		return nil, err
+	}
+	return handler.NewDeleteStatement(
+		e,
+		[]handler.Condition{
+			handler.NewCond(TermsVersionInstanceIDCol, e.Aggregate().InstanceID),
+			handler.NewCond(TermsVersionResourceOwnerCol, e.Aggregate().ID),
+		},
+	), nil
+}
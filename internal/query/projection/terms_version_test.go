@@ -0,0 +1,156 @@
+package projection
+
+import (
+	"testing"
+
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/eventstore/handler/v2"
+	"github.com/zitadel/zitadel/internal/repository/instance"
+	"github.com/zitadel/zitadel/internal/repository/org"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+func TestTermsVersionProjection_reduces(t *testing.T) {
+	type args struct {
+		event func(t *testing.T) eventstore.Event
+	}
+	tests := []struct {
+		name   string
+		args   args
+		reduce func(event eventstore.Event) (*handler.Statement, error)
+		want   wantReduce
+	}{
+		{
+			name: "org reducePublished",
+			args: args{
+				event: getEvent(
+					testEvent(
+						org.TermsVersionPublishedEventType,
+						org.AggregateType,
+						[]byte(`{"version": "2024-01", "tosLink": "https://example.com/tos", "privacyLink": "https://example.com/privacy"}`),
+					), org.TermsVersionPublishedEventMapper),
+			},
+			reduce: (&termsVersionProjection{}).reducePublished,
+			want: wantReduce{
+				aggregateType: eventstore.AggregateType("org"),
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "INSERT INTO projections.terms_versions (instance_id, resource_owner, is_default, published_at, sequence, version, tos_link, privacy_link) VALUES ($1, $2, $3, $4, $5, $6, $7, $8) ON CONFLICT (instance_id, resource_owner) DO UPDATE SET (is_default, published_at, sequence, version, tos_link, privacy_link) = (EXCLUDED.is_default, EXCLUDED.published_at, EXCLUDED.sequence, EXCLUDED.version, EXCLUDED.tos_link, EXCLUDED.privacy_link)",
+							expectedArgs: []interface{}{
+								"instance-id",
+								"ro-id",
+								false,
+								anyArg{},
+								uint64(15),
+								"2024-01",
+								"https://example.com/tos",
+								"https://example.com/privacy",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "instance reducePublished",
+			args: args{
+				event: getEvent(
+					testEvent(
+						instance.TermsVersionPublishedEventType,
+						instance.AggregateType,
+						[]byte(`{"version": "2024-02"}`),
+					), instance.TermsVersionPublishedEventMapper),
+			},
+			reduce: (&termsVersionProjection{}).reducePublished,
+			want: wantReduce{
+				aggregateType: eventstore.AggregateType("instance"),
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "INSERT INTO projections.terms_versions (instance_id, resource_owner, is_default, published_at, sequence, version, tos_link, privacy_link) VALUES ($1, $2, $3, $4, $5, $6, $7, $8) ON CONFLICT (instance_id, resource_owner) DO UPDATE SET (is_default, published_at, sequence, version, tos_link, privacy_link) = (EXCLUDED.is_default, EXCLUDED.published_at, EXCLUDED.sequence, EXCLUDED.version, EXCLUDED.tos_link, EXCLUDED.privacy_link)",
+							expectedArgs: []interface{}{
+								"instance-id",
+								"ro-id",
+								true,
+								anyArg{},
+								uint64(15),
+								"2024-02",
+								"",
+								"",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "org reduceOwnerRemoved",
+			args: args{
+				event: getEvent(
+					testEvent(
+						org.OrgRemovedEventType,
+						org.AggregateType,
+						nil,
+					), org.OrgRemovedEventMapper),
+			},
+			reduce: (&termsVersionProjection{}).reduceOwnerRemoved,
+			want: wantReduce{
+				aggregateType: eventstore.AggregateType("org"),
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "DELETE FROM projections.terms_versions WHERE (instance_id = $1) AND (resource_owner = $2)",
+							expectedArgs: []interface{}{
+								"instance-id",
+								"agg-id",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "instance reduceInstanceRemoved",
+			args: args{
+				event: getEvent(
+					testEvent(
+						instance.InstanceRemovedEventType,
+						instance.AggregateType,
+						nil,
+					), instance.InstanceRemovedEventMapper),
+			},
+			reduce: reduceInstanceRemovedHelper(TermsVersionInstanceIDCol),
+			want: wantReduce{
+				aggregateType: eventstore.AggregateType("instance"),
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "DELETE FROM projections.terms_versions WHERE (instance_id = $1)",
+							expectedArgs: []interface{}{
+								"agg-id",
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			event := baseEvent(t)
+			got, err := tt.reduce(event)
+			if ok := zerrors.IsErrorInvalidArgument(err); !ok {
+				t.Errorf("no wrong event mapping: %v, got: %v", err, got)
+			}
+
+			event = tt.args.event(t)
+			got, err = tt.reduce(event)
+			assertReduce(t, got, err, TermsVersionTable, tt.want)
+		})
+	}
+}
@@ -0,0 +1,121 @@
+package projection
+
+import (
+	"context"
+
+	"github.com/zitadel/zitadel/internal/eventstore"
+	old_handler "github.com/zitadel/zitadel/internal/eventstore/handler"
+	"github.com/zitadel/zitadel/internal/eventstore/handler/v2"
+	"github.com/zitadel/zitadel/internal/repository/instance"
+	"github.com/zitadel/zitadel/internal/repository/org"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+const (
+	UserAttributeSchemaProjectionTable = "projections.user_attribute_schemas"
+
+	UserAttributeSchemaColumnOrgID        = "org_id"
+	UserAttributeSchemaColumnInstanceID   = "instance_id"
+	UserAttributeSchemaColumnCreationDate = "creation_date"
+	UserAttributeSchemaColumnChangeDate   = "change_date"
+	UserAttributeSchemaColumnSequence     = "sequence"
+	UserAttributeSchemaColumnAttributes   = "attributes"
+)
+
+type userAttributeSchemaProjection struct{}
+
+func newUserAttributeSchemaProjection(ctx context.Context, config handler.Config) *handler.Handler {
+	return handler.NewHandler(ctx, &config, new(userAttributeSchemaProjection))
+}
+
+func (*userAttributeSchemaProjection) Name() string {
+	return UserAttributeSchemaProjectionTable
+}
+
+func (*userAttributeSchemaProjection) Init() *old_handler.Check {
+	return handler.NewTableCheck(
+		handler.NewTable([]*handler.InitColumn{
+			handler.NewColumn(UserAttributeSchemaColumnOrgID, handler.ColumnTypeText),
+			handler.NewColumn(UserAttributeSchemaColumnInstanceID, handler.ColumnTypeText),
+			handler.NewColumn(UserAttributeSchemaColumnCreationDate, handler.ColumnTypeTimestamp),
+			handler.NewColumn(UserAttributeSchemaColumnChangeDate, handler.ColumnTypeTimestamp),
+			handler.NewColumn(UserAttributeSchemaColumnSequence, handler.ColumnTypeInt64),
+			handler.NewColumn(UserAttributeSchemaColumnAttributes, handler.ColumnTypeJSONB),
+		},
+			handler.NewPrimaryKey(UserAttributeSchemaColumnInstanceID, UserAttributeSchemaColumnOrgID),
+		),
+	)
+}
+
+func (p *userAttributeSchemaProjection) Reducers() []handler.AggregateReducer {
+	return []handler.AggregateReducer{
+		{
+			Aggregate: org.AggregateType,
+			EventReducers: []handler.EventReducer{
+				{
+					Event:  org.UserAttributeSchemaSetEventType,
+					Reduce: p.reduceSet,
+				},
+				{
+					Event:  org.UserAttributeSchemaRemovedEventType,
+					Reduce: p.reduceRemoved,
+				},
+				{
+					Event:  org.OrgRemovedEventType,
+					Reduce: p.reduceRemoved,
+				},
+			},
+		},
+		{
+			Aggregate: instance.AggregateType,
+			EventReducers: []handler.EventReducer{
+				{
+					Event:  instance.InstanceRemovedEventType,
+					Reduce: reduceInstanceRemovedHelper(UserAttributeSchemaColumnInstanceID),
+				},
+			},
+		},
+	}
+}
+
+func (p *userAttributeSchemaProjection) reduceSet(event eventstore.Event) (*handler.Statement, error) {
+	e, err := assertEvent[*org.UserAttributeSchemaSetEvent](event)
+	if err != nil {
+		return nil, err
+	}
+	attributes := e.Attributes
+	if attributes == nil {
+		attributes = []*org.UserAttribute{}
+	}
+	return handler.NewUpsertStatement(
+		e,
+		[]handler.Column{
+			handler.NewCol(UserAttributeSchemaColumnInstanceID, nil),
+			handler.NewCol(UserAttributeSchemaColumnOrgID, nil),
+		},
+		[]handler.Column{
+			handler.NewCol(UserAttributeSchemaColumnInstanceID, e.Aggregate().InstanceID),
+			handler.NewCol(UserAttributeSchemaColumnOrgID, e.Aggregate().ID),
+			handler.NewCol(UserAttributeSchemaColumnCreationDate, handler.OnlySetValueOnInsert(UserAttributeSchemaProjectionTable, e.CreationDate())),
+			handler.NewCol(UserAttributeSchemaColumnChangeDate, e.CreationDate()),
+			handler.NewCol(UserAttributeSchemaColumnSequence, e.Sequence()),
+			handler.NewJSONCol(UserAttributeSchemaColumnAttributes, attributes),
+		},
+	), nil
+}
+
+func (p *userAttributeSchemaProjection) reduceRemoved(event eventstore.Event) (*handler.Statement, error) {
+	switch event.(type) {
+	case *org.UserAttributeSchemaRemovedEvent,
+		*org.OrgRemovedEvent:
+	default:
+		return nil, zerrors.ThrowInvalidArgumentf(nil, "PROJE-Ua7rm", "reduce.wrong.event.type %v", []eventstore.EventType{org.UserAttributeSchemaRemovedEventType, org.OrgRemovedEventType})
+	}
+	return handler.NewDeleteStatement(
+		event,
+		[]handler.Condition{
+			handler.NewCond(UserAttributeSchemaColumnInstanceID, event.Aggregate().InstanceID),
+			handler.NewCond(UserAttributeSchemaColumnOrgID, event.Aggregate().ID),
+		},
+	), nil
+}
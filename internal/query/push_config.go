@@ -0,0 +1,125 @@
+package query
+
+import (
+	"context"
+	"time"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/crypto"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/repository/instance"
+	"github.com/zitadel/zitadel/internal/telemetry/tracing"
+)
+
+// PushConfig is the push notification configuration of an instance,
+// a platform without configuration is nil.
+type PushConfig struct {
+	ChangeDate time.Time
+	Sequence   uint64
+
+	FCM  *FCMPushConfig
+	APNs *APNsPushConfig
+}
+
+type FCMPushConfig struct {
+	ProjectID         string
+	ServiceAccountKey *crypto.CryptoValue
+}
+
+type APNsPushConfig struct {
+	KeyID      string
+	TeamID     string
+	BundleID   string
+	PrivateKey *crypto.CryptoValue
+	Production bool
+}
+
+// IsConfigured returns if push notifications can be delivered to devices of the platform
+func (c *PushConfig) IsConfigured(platform domain.PushPlatform) bool {
+	switch platform {
+	case domain.PushPlatformFCM:
+		return c.FCM != nil
+	case domain.PushPlatformAPNs:
+		return c.APNs != nil
+	case domain.PushPlatformUnspecified:
+	}
+	return false
+}
+
+// PushConfig returns the push notification configuration of the instance
+func (q *Queries) PushConfig(ctx context.Context) (_ *PushConfig, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	readModel := NewInstancePushConfigReadModel(authz.GetInstance(ctx).InstanceID())
+	if err = q.eventstore.FilterToQueryReducer(ctx, readModel); err != nil {
+		return nil, err
+	}
+	return &PushConfig{
+		ChangeDate: readModel.ChangeDate,
+		Sequence:   readModel.ProcessedSequence,
+		FCM:        readModel.FCM,
+		APNs:       readModel.APNs,
+	}, nil
+}
+
+type InstancePushConfigReadModel struct {
+	*eventstore.ReadModel
+
+	FCM  *FCMPushConfig
+	APNs *APNsPushConfig
+}
+
+func NewInstancePushConfigReadModel(instanceID string) *InstancePushConfigReadModel {
+	return &InstancePushConfigReadModel{
+		ReadModel: &eventstore.ReadModel{
+			AggregateID:   instanceID,
+			ResourceOwner: instanceID,
+			InstanceID:    instanceID,
+		},
+	}
+}
+
+func (rm *InstancePushConfigReadModel) Reduce() error {
+	for _, event := range rm.Events {
+		switch e := event.(type) {
+		case *instance.PushConfigFCMSetEvent:
+			rm.FCM = &FCMPushConfig{
+				ProjectID:         e.ProjectID,
+				ServiceAccountKey: e.ServiceAccountKey,
+			}
+		case *instance.PushConfigAPNsSetEvent:
+			rm.APNs = &APNsPushConfig{
+				KeyID:      e.KeyID,
+				TeamID:     e.TeamID,
+				BundleID:   e.BundleID,
+				PrivateKey: e.PrivateKey,
+				Production: e.Production,
+			}
+		case *instance.PushConfigRemovedEvent:
+			switch e.Platform {
+			case domain.PushPlatformFCM:
+				rm.FCM = nil
+			case domain.PushPlatformAPNs:
+				rm.APNs = nil
+			case domain.PushPlatformUnspecified:
+			}
+		}
+	}
+	return rm.ReadModel.Reduce()
+}
+
+func (rm *InstancePushConfigReadModel) Query() *eventstore.SearchQueryBuilder {
+	return eventstore.NewSearchQueryBuilder(eventstore.ColumnsEvent).
+		AwaitOpenTransactions().
+		ResourceOwner(rm.ResourceOwner).
+		AddQuery().
+		AggregateTypes(instance.AggregateType).
+		AggregateIDs(rm.AggregateID).
+		EventTypes(
+			instance.PushConfigFCMSetEventType,
+			instance.PushConfigAPNsSetEventType,
+			instance.PushConfigRemovedEventType).
+		Builder()
+}
@@ -0,0 +1,73 @@
+package query
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/zitadel/zitadel/internal/crypto"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/repository/instance"
+)
+
+func TestInstancePushConfigReadModel_Reduce(t *testing.T) {
+	agg := &instance.NewAggregate("instance1").Aggregate
+	key := &crypto.CryptoValue{
+		CryptoType: crypto.TypeEncryption,
+		Algorithm:  "enc",
+		KeyID:      "id",
+		Crypted:    []byte("key"),
+	}
+	tests := []struct {
+		name     string
+		events   []eventstore.Event
+		wantFCM  *FCMPushConfig
+		wantAPNs *APNsPushConfig
+	}{
+		{
+			name: "not configured",
+		},
+		{
+			name: "both platforms configured, latest config",
+			events: []eventstore.Event{
+				instance.NewPushConfigFCMSetEvent(context.Background(), agg, "project1", key),
+				instance.NewPushConfigAPNsSetEvent(context.Background(), agg, "key", "team", "com.example.app", key, false),
+				instance.NewPushConfigFCMSetEvent(context.Background(), agg, "project2", key),
+			},
+			wantFCM: &FCMPushConfig{
+				ProjectID:         "project2",
+				ServiceAccountKey: key,
+			},
+			wantAPNs: &APNsPushConfig{
+				KeyID:      "key",
+				TeamID:     "team",
+				BundleID:   "com.example.app",
+				PrivateKey: key,
+			},
+		},
+		{
+			name: "platform removed",
+			events: []eventstore.Event{
+				instance.NewPushConfigFCMSetEvent(context.Background(), agg, "project1", key),
+				instance.NewPushConfigAPNsSetEvent(context.Background(), agg, "key", "team", "com.example.app", key, true),
+				instance.NewPushConfigRemovedEvent(context.Background(), agg, domain.PushPlatformAPNs),
+			},
+			wantFCM: &FCMPushConfig{
+				ProjectID:         "project1",
+				ServiceAccountKey: key,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rm := NewInstancePushConfigReadModel("instance1")
+			rm.AppendEvents(tt.events...)
+			require.NoError(t, rm.Reduce())
+			assert.Equal(t, tt.wantFCM, rm.FCM)
+			assert.Equal(t, tt.wantAPNs, rm.APNs)
+		})
+	}
+}
@@ -40,6 +40,7 @@ type Queries struct {
 	zitadelRoles                        []authz.RoleMapping
 	multifactors                        domain.MultifactorConfigs
 	defaultAuditLogRetention            time.Duration
+	instanceHostCache                   *instanceHostCache
 }
 
 func StartQueries(
@@ -78,6 +79,9 @@ func StartQueries(
 	}
 
 	repo.checkPermission = permissionCheck(repo)
+	if defaults.InstanceHostCache.MaxAge > 0 {
+		repo.instanceHostCache = newInstanceHostCache(ctx, defaults.InstanceHostCache.MaxAge)
+	}
 
 	err = projection.Create(ctx, projectionSqlClient, es, projections, keyEncryptionAlgorithm, certEncryptionAlgorithm, systemAPIUsers)
 	if err != nil {
@@ -91,7 +95,14 @@ func StartQueries(
 }
 
 func (q *Queries) Health(ctx context.Context) error {
-	return q.client.Ping()
+	if err := q.client.Ping(); err != nil {
+		return err
+	}
+	return q.client.CheckReplicationLag(ctx)
+}
+
+func (q *Queries) ReplicationLag(ctx context.Context) (time.Duration, error) {
+	return q.client.ReplicationLag(ctx)
 }
 
 type prepareDatabase interface {
@@ -0,0 +1,165 @@
+package query
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+
+	"github.com/zitadel/zitadel/internal/api/call"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/query/projection"
+	"github.com/zitadel/zitadel/internal/repository/user"
+	"github.com/zitadel/zitadel/internal/telemetry/tracing"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+var (
+	revokedTokensTable = table{
+		name:          projection.RevokedTokenProjectionTable,
+		instanceIDCol: projection.RevokedTokenColumnInstanceID,
+	}
+	RevokedTokenColumnTokenID = Column{
+		name:  projection.RevokedTokenColumnTokenID,
+		table: revokedTokensTable,
+	}
+	RevokedTokenColumnRevocationDate = Column{
+		name:  projection.RevokedTokenColumnRevocationDate,
+		table: revokedTokensTable,
+	}
+	RevokedTokenColumnInstanceID = Column{
+		name:  projection.RevokedTokenColumnInstanceID,
+		table: revokedTokensTable,
+	}
+	RevokedTokenColumnUserID = Column{
+		name:  projection.RevokedTokenColumnUserID,
+		table: revokedTokensTable,
+	}
+)
+
+type RevokedToken struct {
+	InstanceID     string
+	TokenID        string
+	UserID         string
+	RevocationDate time.Time
+}
+
+// RevokedTokensSince returns the tokens of all instances which were revoked after the passed date.
+func (q *Queries) RevokedTokensSince(ctx context.Context, since time.Time) (tokens []*RevokedToken, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	query, scan := prepareRevokedTokensQuery(ctx, q.client)
+	stmt, args, err := query.Where(sq.Gt{
+		RevokedTokenColumnRevocationDate.identifier(): since,
+	}).ToSql()
+	if err != nil {
+		return nil, zerrors.ThrowInternal(err, "QUERY-Rk3vd", "Errors.Query.SQLStatment")
+	}
+
+	err = q.client.QueryContext(ctx, func(rows *sql.Rows) error {
+		tokens, err = scan(rows)
+		return err
+	}, stmt, args...)
+	if err != nil {
+		return nil, zerrors.ThrowInternal(err, "QUERY-Tq8sm", "Errors.Internal")
+	}
+	return tokens, nil
+}
+
+// RevokedTokensAfterPosition returns the tokens of all instances which were revoked after the passed position
+// directly from the eventstore, so revocations are available before the projection is updated.
+// If the position is not known yet, only revocations created after since are returned.
+// The returned position must be passed to the next call.
+func (q *Queries) RevokedTokensAfterPosition(ctx context.Context, position float64, since time.Time) (_ []*RevokedToken, lastPosition float64, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	model := &revokedTokensModel{
+		position: position,
+		since:    since,
+	}
+	if err = q.eventstore.FilterToQueryReducer(ctx, model); err != nil {
+		return nil, position, err
+	}
+	return model.tokens, model.position, nil
+}
+
+type revokedTokensModel struct {
+	position float64
+	since    time.Time
+
+	tokens []*RevokedToken
+}
+
+func (m *revokedTokensModel) Reduce() error {
+	return nil
+}
+
+func (m *revokedTokensModel) AppendEvents(events ...eventstore.Event) {
+	for _, event := range events {
+		switch e := event.(type) {
+		case *user.UserTokenRemovedEvent:
+			m.appendToken(e, e.TokenID)
+		case *user.PersonalAccessTokenRemovedEvent:
+			m.appendToken(e, e.TokenID)
+		}
+		if event.Position() > m.position {
+			m.position = event.Position()
+		}
+	}
+}
+
+func (m *revokedTokensModel) appendToken(event eventstore.Event, tokenID string) {
+	m.tokens = append(m.tokens, &RevokedToken{
+		InstanceID:     event.Aggregate().InstanceID,
+		TokenID:        tokenID,
+		UserID:         event.Aggregate().ID,
+		RevocationDate: event.CreatedAt(),
+	})
+}
+
+func (m *revokedTokensModel) Query() *eventstore.SearchQueryBuilder {
+	return eventstore.NewSearchQueryBuilder(eventstore.ColumnsEvent).
+		PositionAfter(m.position).
+		CreationDateAfter(m.since).
+		AwaitOpenTransactions().
+		AddQuery().
+		AggregateTypes(user.AggregateType).
+		EventTypes(
+			user.UserTokenRemovedType,
+			user.PersonalAccessTokenRemovedType,
+		).
+		Builder()
+}
+
+func prepareRevokedTokensQuery(ctx context.Context, db prepareDatabase) (sq.SelectBuilder, func(*sql.Rows) ([]*RevokedToken, error)) {
+	return sq.Select(
+			RevokedTokenColumnInstanceID.identifier(),
+			RevokedTokenColumnTokenID.identifier(),
+			RevokedTokenColumnUserID.identifier(),
+			RevokedTokenColumnRevocationDate.identifier()).
+			From(revokedTokensTable.identifier() + db.Timetravel(call.Took(ctx))).
+			PlaceholderFormat(sq.Dollar),
+		func(rows *sql.Rows) ([]*RevokedToken, error) {
+			tokens := make([]*RevokedToken, 0)
+			for rows.Next() {
+				token := new(RevokedToken)
+				err := rows.Scan(
+					&token.InstanceID,
+					&token.TokenID,
+					&token.UserID,
+					&token.RevocationDate,
+				)
+				if err != nil {
+					return nil, err
+				}
+				tokens = append(tokens, token)
+			}
+			if err := rows.Close(); err != nil {
+				return nil, zerrors.ThrowInternal(err, "QUERY-Wc4ka", "Errors.Query.CloseRows")
+			}
+			return tokens, nil
+		}
+}
@@ -0,0 +1,112 @@
+package query
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"regexp"
+	"testing"
+)
+
+var (
+	revokedTokensStmt = regexp.QuoteMeta(
+		"SELECT projections.revoked_tokens.instance_id," +
+			" projections.revoked_tokens.token_id," +
+			" projections.revoked_tokens.user_id," +
+			" projections.revoked_tokens.revocation_date" +
+			" FROM projections.revoked_tokens" +
+			" AS OF SYSTEM TIME '-1 ms'")
+	revokedTokensCols = []string{
+		"instance_id",
+		"token_id",
+		"user_id",
+		"revocation_date",
+	}
+)
+
+func Test_RevokedTokensPrepares(t *testing.T) {
+	type want struct {
+		sqlExpectations sqlExpectation
+		err             checkErr
+	}
+	tests := []struct {
+		name    string
+		prepare interface{}
+		want    want
+		object  interface{}
+	}{
+		{
+			name:    "prepareRevokedTokensQuery no result",
+			prepare: prepareRevokedTokensQuery,
+			want: want{
+				sqlExpectations: mockQueries(
+					revokedTokensStmt,
+					nil,
+					nil,
+				),
+			},
+			object: []*RevokedToken{},
+		},
+		{
+			name:    "prepareRevokedTokensQuery multiple tokens",
+			prepare: prepareRevokedTokensQuery,
+			want: want{
+				sqlExpectations: mockQueries(
+					revokedTokensStmt,
+					revokedTokensCols,
+					[][]driver.Value{
+						{
+							"instance-id",
+							"token-id",
+							"user-id",
+							testNow,
+						},
+						{
+							"instance-id2",
+							"token-id2",
+							"user-id2",
+							testNow,
+						},
+					},
+				),
+			},
+			object: []*RevokedToken{
+				{
+					InstanceID:     "instance-id",
+					TokenID:        "token-id",
+					UserID:         "user-id",
+					RevocationDate: testNow,
+				},
+				{
+					InstanceID:     "instance-id2",
+					TokenID:        "token-id2",
+					UserID:         "user-id2",
+					RevocationDate: testNow,
+				},
+			},
+		},
+		{
+			name:    "prepareRevokedTokensQuery sql err",
+			prepare: prepareRevokedTokensQuery,
+			want: want{
+				sqlExpectations: mockQueryErr(
+					revokedTokensStmt,
+					sql.ErrConnDone,
+				),
+				err: func(err error) (error, bool) {
+					if !errors.Is(err, sql.ErrConnDone) {
+						return fmt.Errorf("err should be sql.ErrConnDone got: %w", err), false
+					}
+					return nil, true
+				},
+			},
+			object: ([]*RevokedToken)(nil),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assertPrepare(t, tt.prepare, tt.object, tt.want.sqlExpectations, tt.want.err, defaultPrepareArgs...)
+		})
+	}
+}
@@ -0,0 +1,239 @@
+package query
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/api/call"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/query/projection"
+	"github.com/zitadel/zitadel/internal/telemetry/tracing"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+type SCIMSyncs struct {
+	SearchResponse
+	SCIMSyncs []*SCIMSync
+}
+
+// SCIMSync is the state of the provisioning of a user to a SCIM target of a project
+type SCIMSync struct {
+	InstanceID    string
+	TargetID      string
+	UserID        string
+	ProjectID     string
+	ResourceOwner string
+	CreationDate  time.Time
+	ChangeDate    time.Time
+	Sequence      uint64
+	State         domain.SCIMSyncState
+	// Reason is the type of the event which triggered the last sync
+	Reason      string
+	Attempts    uint16
+	NextAttempt time.Time
+	// RemoteID is the id of the user at the target, it's empty if the user isn't provisioned
+	RemoteID     string
+	LastSyncDate time.Time
+	LastError    string
+}
+
+type SCIMSyncSearchQueries struct {
+	SearchRequest
+	Queries []SearchQuery
+}
+
+func (q *SCIMSyncSearchQueries) toQuery(query sq.SelectBuilder) sq.SelectBuilder {
+	query = q.SearchRequest.toQuery(query)
+	for _, q := range q.Queries {
+		query = q.toQuery(query)
+	}
+	return query
+}
+
+func NewSCIMSyncProjectIDSearchQuery(projectID string) (SearchQuery, error) {
+	return NewTextQuery(SCIMSyncColumnProjectID, projectID, TextEquals)
+}
+
+func NewSCIMSyncResourceOwnerSearchQuery(resourceOwner string) (SearchQuery, error) {
+	return NewTextQuery(SCIMSyncColumnResourceOwner, resourceOwner, TextEquals)
+}
+
+func NewSCIMSyncTargetIDSearchQuery(targetID string) (SearchQuery, error) {
+	return NewTextQuery(SCIMSyncColumnTargetID, targetID, TextEquals)
+}
+
+func NewSCIMSyncUserIDSearchQuery(userID string) (SearchQuery, error) {
+	return NewTextQuery(SCIMSyncColumnUserID, userID, TextEquals)
+}
+
+func NewSCIMSyncStateSearchQuery(state domain.SCIMSyncState) (SearchQuery, error) {
+	return NewNumberQuery(SCIMSyncColumnState, state, NumberEquals)
+}
+
+// NewSCIMSyncDueSearchQuery returns the syncs, which are due for their next attempt
+func NewSCIMSyncDueSearchQuery(now time.Time) (SearchQuery, error) {
+	return NewTimestampQuery(SCIMSyncColumnNextAttempt, now, TimestampLessOrEquals)
+}
+
+var (
+	scimSyncTable = table{
+		name:          projection.SCIMSyncTable,
+		instanceIDCol: projection.SCIMSyncInstanceIDCol,
+	}
+	SCIMSyncColumnInstanceID = Column{
+		name:  projection.SCIMSyncInstanceIDCol,
+		table: scimSyncTable,
+	}
+	SCIMSyncColumnTargetID = Column{
+		name:  projection.SCIMSyncTargetIDCol,
+		table: scimSyncTable,
+	}
+	SCIMSyncColumnUserID = Column{
+		name:  projection.SCIMSyncUserIDCol,
+		table: scimSyncTable,
+	}
+	SCIMSyncColumnProjectID = Column{
+		name:  projection.SCIMSyncProjectIDCol,
+		table: scimSyncTable,
+	}
+	SCIMSyncColumnResourceOwner = Column{
+		name:  projection.SCIMSyncResourceOwnerCol,
+		table: scimSyncTable,
+	}
+	SCIMSyncColumnCreationDate = Column{
+		name:  projection.SCIMSyncCreationDateCol,
+		table: scimSyncTable,
+	}
+	SCIMSyncColumnChangeDate = Column{
+		name:  projection.SCIMSyncChangeDateCol,
+		table: scimSyncTable,
+	}
+	SCIMSyncColumnSequence = Column{
+		name:  projection.SCIMSyncSequenceCol,
+		table: scimSyncTable,
+	}
+	SCIMSyncColumnState = Column{
+		name:  projection.SCIMSyncStateCol,
+		table: scimSyncTable,
+	}
+	SCIMSyncColumnReason = Column{
+		name:  projection.SCIMSyncReasonCol,
+		table: scimSyncTable,
+	}
+	SCIMSyncColumnAttempts = Column{
+		name:  projection.SCIMSyncAttemptsCol,
+		table: scimSyncTable,
+	}
+	SCIMSyncColumnNextAttempt = Column{
+		name:  projection.SCIMSyncNextAttemptCol,
+		table: scimSyncTable,
+	}
+	SCIMSyncColumnRemoteID = Column{
+		name:  projection.SCIMSyncRemoteIDCol,
+		table: scimSyncTable,
+	}
+	SCIMSyncColumnLastSyncDate = Column{
+		name:  projection.SCIMSyncLastSyncDateCol,
+		table: scimSyncTable,
+	}
+	SCIMSyncColumnLastError = Column{
+		name:  projection.SCIMSyncLastErrorCol,
+		table: scimSyncTable,
+	}
+)
+
+// SearchSCIMSyncs tries to defer the instanceID from the passed context if no instanceIDs are passed
+func (q *Queries) SearchSCIMSyncs(ctx context.Context, instanceIDs []string, queries *SCIMSyncSearchQueries) (syncs *SCIMSyncs, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	query, scan := prepareSCIMSyncsQuery(ctx, q.client)
+	if len(instanceIDs) == 0 {
+		instanceIDs = []string{authz.GetInstance(ctx).InstanceID()}
+	}
+	stmt, args, err := queries.toQuery(query).Where(sq.Eq{SCIMSyncColumnInstanceID.identifier(): instanceIDs}).ToSql()
+	if err != nil {
+		return nil, zerrors.ThrowInternal(err, "QUERY-Sy1sq", "Errors.Query.SQLStatement")
+	}
+	err = q.client.QueryContext(ctx, func(rows *sql.Rows) error {
+		syncs, err = scan(rows)
+		return err
+	}, stmt, args...)
+	if err != nil {
+		return nil, err
+	}
+
+	syncs.State, err = q.latestState(ctx, scimSyncTable)
+	return syncs, err
+}
+
+func prepareSCIMSyncsQuery(ctx context.Context, db prepareDatabase) (sq.SelectBuilder, func(*sql.Rows) (*SCIMSyncs, error)) {
+	return sq.Select(
+			SCIMSyncColumnInstanceID.identifier(),
+			SCIMSyncColumnTargetID.identifier(),
+			SCIMSyncColumnUserID.identifier(),
+			SCIMSyncColumnProjectID.identifier(),
+			SCIMSyncColumnResourceOwner.identifier(),
+			SCIMSyncColumnCreationDate.identifier(),
+			SCIMSyncColumnChangeDate.identifier(),
+			SCIMSyncColumnSequence.identifier(),
+			SCIMSyncColumnState.identifier(),
+			SCIMSyncColumnReason.identifier(),
+			SCIMSyncColumnAttempts.identifier(),
+			SCIMSyncColumnNextAttempt.identifier(),
+			SCIMSyncColumnRemoteID.identifier(),
+			SCIMSyncColumnLastSyncDate.identifier(),
+			SCIMSyncColumnLastError.identifier(),
+			countColumn.identifier(),
+		).
+			From(scimSyncTable.identifier() + db.Timetravel(call.Took(ctx))).
+			PlaceholderFormat(sq.Dollar),
+		func(rows *sql.Rows) (*SCIMSyncs, error) {
+			syncs := make([]*SCIMSync, 0)
+			var count uint64
+			for rows.Next() {
+				s := new(SCIMSync)
+				remoteID := sql.NullString{}
+				lastSyncDate := sql.NullTime{}
+				lastError := sql.NullString{}
+				err := rows.Scan(
+					&s.InstanceID,
+					&s.TargetID,
+					&s.UserID,
+					&s.ProjectID,
+					&s.ResourceOwner,
+					&s.CreationDate,
+					&s.ChangeDate,
+					&s.Sequence,
+					&s.State,
+					&s.Reason,
+					&s.Attempts,
+					&s.NextAttempt,
+					&remoteID,
+					&lastSyncDate,
+					&lastError,
+					&count,
+				)
+				if err != nil {
+					return nil, err
+				}
+				s.RemoteID = remoteID.String
+				s.LastSyncDate = lastSyncDate.Time
+				s.LastError = lastError.String
+				syncs = append(syncs, s)
+			}
+			if err := rows.Close(); err != nil {
+				return nil, zerrors.ThrowInternal(err, "QUERY-Sy2cr", "Errors.Query.CloseRows")
+			}
+			return &SCIMSyncs{
+				SCIMSyncs: syncs,
+				SearchResponse: SearchResponse{
+					Count: count,
+				},
+			}, nil
+		}
+}
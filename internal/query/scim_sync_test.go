@@ -0,0 +1,185 @@
+package query
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/zitadel/zitadel/internal/domain"
+)
+
+var (
+	expectedSCIMSyncsQuery = regexp.QuoteMeta(`
+		SELECT projections.scim_syncs.instance_id,
+		   projections.scim_syncs.target_id,
+		   projections.scim_syncs.user_id,
+		   projections.scim_syncs.project_id,
+		   projections.scim_syncs.resource_owner,
+		   projections.scim_syncs.creation_date,
+		   projections.scim_syncs.change_date,
+		   projections.scim_syncs.sequence,
+		   projections.scim_syncs.state,
+		   projections.scim_syncs.reason,
+		   projections.scim_syncs.attempts,
+		   projections.scim_syncs.next_attempt,
+		   projections.scim_syncs.remote_id,
+		   projections.scim_syncs.last_sync_date,
+		   projections.scim_syncs.last_error,
+		   COUNT(*) OVER ()
+		FROM projections.scim_syncs AS OF SYSTEM TIME '-1 ms'
+		`)
+
+	scimSyncCols = []string{
+		"instance_id",
+		"target_id",
+		"user_id",
+		"project_id",
+		"resource_owner",
+		"creation_date",
+		"change_date",
+		"sequence",
+		"state",
+		"reason",
+		"attempts",
+		"next_attempt",
+		"remote_id",
+		"last_sync_date",
+		"last_error",
+		"count",
+	}
+)
+
+func Test_SCIMSyncsPrepare(t *testing.T) {
+	type want struct {
+		sqlExpectations sqlExpectation
+		err             checkErr
+	}
+	tests := []struct {
+		name    string
+		prepare interface{}
+		want    want
+		object  interface{}
+	}{
+		{
+			name:    "prepareSCIMSyncsQuery no result",
+			prepare: prepareSCIMSyncsQuery,
+			want: want{
+				sqlExpectations: mockQueries(
+					expectedSCIMSyncsQuery,
+					nil,
+					nil,
+				),
+			},
+			object: &SCIMSyncs{SCIMSyncs: []*SCIMSync{}},
+		},
+		{
+			name:    "prepareSCIMSyncsQuery",
+			prepare: prepareSCIMSyncsQuery,
+			want: want{
+				sqlExpectations: mockQueries(
+					expectedSCIMSyncsQuery,
+					scimSyncCols,
+					[][]driver.Value{
+						{
+							"instance-id",
+							"target1",
+							"user1",
+							"project1",
+							"org1",
+							testNow,
+							testNow,
+							uint64(20211108),
+							domain.SCIMSyncStateSynced,
+							"user.grant.added",
+							1,
+							testNow,
+							"remote1",
+							testNow,
+							nil,
+						},
+						{
+							"instance-id",
+							"target1",
+							"user2",
+							"project1",
+							"org1",
+							testNow,
+							testNow,
+							uint64(20211109),
+							domain.SCIMSyncStateFailed,
+							"user.human.profile.changed",
+							10,
+							testNow,
+							nil,
+							nil,
+							"unauthorized",
+						},
+					},
+				),
+			},
+			object: &SCIMSyncs{
+				SearchResponse: SearchResponse{
+					Count: 2,
+				},
+				SCIMSyncs: []*SCIMSync{
+					{
+						InstanceID:    "instance-id",
+						TargetID:      "target1",
+						UserID:        "user1",
+						ProjectID:     "project1",
+						ResourceOwner: "org1",
+						CreationDate:  testNow,
+						ChangeDate:    testNow,
+						Sequence:      20211108,
+						State:         domain.SCIMSyncStateSynced,
+						Reason:        "user.grant.added",
+						Attempts:      1,
+						NextAttempt:   testNow,
+						RemoteID:      "remote1",
+						LastSyncDate:  testNow,
+					},
+					{
+						InstanceID:    "instance-id",
+						TargetID:      "target1",
+						UserID:        "user2",
+						ProjectID:     "project1",
+						ResourceOwner: "org1",
+						CreationDate:  testNow,
+						ChangeDate:    testNow,
+						Sequence:      20211109,
+						State:         domain.SCIMSyncStateFailed,
+						Reason:        "user.human.profile.changed",
+						Attempts:      10,
+						NextAttempt:   testNow,
+						LastError:     "unauthorized",
+					},
+				},
+			},
+		},
+		{
+			name:    "prepareSCIMSyncsQuery sql err",
+			prepare: prepareSCIMSyncsQuery,
+			want: want{
+				sqlExpectations: mockQueryErr(
+					expectedSCIMSyncsQuery,
+					sql.ErrConnDone,
+				),
+				err: func(err error) (error, bool) {
+					if !errors.Is(err, sql.ErrConnDone) {
+						return fmt.Errorf("err should be sql.ErrConnDone got: %w", err), false
+					}
+					return nil, true
+				},
+			},
+			object: (*SCIMSyncs)(nil),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assertPrepare(t, tt.prepare, tt.object, tt.want.sqlExpectations, tt.want.err, defaultPrepareArgs...)
+		})
+	}
+}
@@ -0,0 +1,144 @@
+package query
+
+import (
+	"context"
+	"slices"
+	"time"
+
+	"github.com/zitadel/zitadel/internal/crypto"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/repository/project"
+	"github.com/zitadel/zitadel/internal/telemetry/tracing"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+// SCIMTarget is a downstream application the users granted on the project are provisioned to with SCIM 2.0
+type SCIMTarget struct {
+	ID            string
+	ProjectID     string
+	ResourceOwner string
+	CreationDate  time.Time
+	ChangeDate    time.Time
+	Sequence      uint64
+
+	Name     string
+	Endpoint string
+	// Token is the encrypted bearer token used to authenticate at the SCIM API
+	Token   *crypto.CryptoValue
+	Mapping domain.SCIMProvisioningMapping
+}
+
+// ProjectSCIMTargets returns the SCIM targets of the project in the order they were added
+func (q *Queries) ProjectSCIMTargets(ctx context.Context, projectID, resourceOwner string) (_ []*SCIMTarget, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	readModel := NewProjectSCIMTargetsReadModel(projectID, resourceOwner)
+	if err = q.eventstore.FilterToQueryReducer(ctx, readModel); err != nil {
+		return nil, err
+	}
+	return readModel.Targets, nil
+}
+
+// ProjectSCIMTargetByID returns the SCIM target of the project
+func (q *Queries) ProjectSCIMTargetByID(ctx context.Context, projectID, resourceOwner, id string) (_ *SCIMTarget, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	targets, err := q.ProjectSCIMTargets(ctx, projectID, resourceOwner)
+	if err != nil {
+		return nil, err
+	}
+	for _, target := range targets {
+		if target.ID == id {
+			return target, nil
+		}
+	}
+	return nil, zerrors.ThrowNotFound(nil, "QUERY-Sc1nf", "Errors.Project.SCIMTarget.NotFound")
+}
+
+type ProjectSCIMTargetsReadModel struct {
+	*eventstore.ReadModel
+
+	Targets []*SCIMTarget
+}
+
+func NewProjectSCIMTargetsReadModel(projectID, resourceOwner string) *ProjectSCIMTargetsReadModel {
+	return &ProjectSCIMTargetsReadModel{
+		ReadModel: &eventstore.ReadModel{
+			AggregateID:   projectID,
+			ResourceOwner: resourceOwner,
+		},
+	}
+}
+
+func (rm *ProjectSCIMTargetsReadModel) Reduce() error {
+	for _, event := range rm.Events {
+		switch e := event.(type) {
+		case *project.SCIMTargetAddedEvent:
+			rm.Targets = append(rm.Targets, &SCIMTarget{
+				ID:            e.ID,
+				ProjectID:     e.Aggregate().ID,
+				ResourceOwner: e.Aggregate().ResourceOwner,
+				CreationDate:  e.CreationDate(),
+				ChangeDate:    e.CreationDate(),
+				Sequence:      e.Sequence(),
+				Name:          e.Name,
+				Endpoint:      e.Endpoint,
+				Token:         e.Token,
+				Mapping:       e.Mapping,
+			})
+		case *project.SCIMTargetChangedEvent:
+			target := rm.target(e.ID)
+			if target == nil {
+				continue
+			}
+			target.ChangeDate = e.CreationDate()
+			target.Sequence = e.Sequence()
+			if e.Name != nil {
+				target.Name = *e.Name
+			}
+			if e.Endpoint != nil {
+				target.Endpoint = *e.Endpoint
+			}
+			if e.Token != nil {
+				target.Token = e.Token
+			}
+			if e.Mapping != nil {
+				target.Mapping = *e.Mapping
+			}
+		case *project.SCIMTargetRemovedEvent:
+			rm.Targets = slices.DeleteFunc(rm.Targets, func(target *SCIMTarget) bool {
+				return target.ID == e.ID
+			})
+		case *project.ProjectRemovedEvent:
+			rm.Targets = nil
+		}
+	}
+	return rm.ReadModel.Reduce()
+}
+
+func (rm *ProjectSCIMTargetsReadModel) Query() *eventstore.SearchQueryBuilder {
+	return eventstore.NewSearchQueryBuilder(eventstore.ColumnsEvent).
+		AwaitOpenTransactions().
+		ResourceOwner(rm.ResourceOwner).
+		AddQuery().
+		AggregateTypes(project.AggregateType).
+		AggregateIDs(rm.AggregateID).
+		EventTypes(
+			project.SCIMTargetAddedType,
+			project.SCIMTargetChangedType,
+			project.SCIMTargetRemovedType,
+			project.ProjectRemovedType).
+		Builder()
+}
+
+func (rm *ProjectSCIMTargetsReadModel) target(id string) *SCIMTarget {
+	for _, target := range rm.Targets {
+		if target.ID == id {
+			return target
+		}
+	}
+	return nil
+}
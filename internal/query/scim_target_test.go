@@ -0,0 +1,78 @@
+package query
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/zitadel/zitadel/internal/crypto"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/repository/project"
+)
+
+func TestProjectSCIMTargetsReadModel_Reduce(t *testing.T) {
+	agg := &project.NewAggregate("project1", "org1").Aggregate
+	token1 := &crypto.CryptoValue{CryptoType: crypto.TypeEncryption, Algorithm: "enc", KeyID: "id", Crypted: []byte("token1")}
+	token2 := &crypto.CryptoValue{CryptoType: crypto.TypeEncryption, Algorithm: "enc", KeyID: "id", Crypted: []byte("token2")}
+	changed, err := project.NewSCIMTargetChangedEvent(context.Background(), agg, "target1", []project.SCIMTargetChanges{
+		project.ChangeSCIMTargetName("github"),
+		project.ChangeSCIMTargetToken(token2),
+		project.ChangeSCIMTargetMapping(domain.SCIMProvisioningMapping{UserName: domain.SCIMUserNameSourceEmail}),
+	})
+	require.NoError(t, err)
+	tests := []struct {
+		name   string
+		events []eventstore.Event
+		want   []*SCIMTarget
+	}{
+		{
+			name: "no targets",
+		},
+		{
+			name: "targets added and changed",
+			events: []eventstore.Event{
+				project.NewSCIMTargetAddedEvent(context.Background(), agg, "target1", "slack", "https://api.slack.com/scim/v2", token1, domain.SCIMProvisioningMapping{Groups: true}),
+				project.NewSCIMTargetAddedEvent(context.Background(), agg, "target2", "aws", "https://scim.us-east-1.amazonaws.com/abc/scim/v2", token1, domain.SCIMProvisioningMapping{}),
+				changed,
+			},
+			want: []*SCIMTarget{
+				{
+					ID:            "target1",
+					ProjectID:     "project1",
+					ResourceOwner: "org1",
+					Name:          "github",
+					Endpoint:      "https://api.slack.com/scim/v2",
+					Token:         token2,
+					Mapping:       domain.SCIMProvisioningMapping{UserName: domain.SCIMUserNameSourceEmail},
+				},
+				{
+					ID:            "target2",
+					ProjectID:     "project1",
+					ResourceOwner: "org1",
+					Name:          "aws",
+					Endpoint:      "https://scim.us-east-1.amazonaws.com/abc/scim/v2",
+					Token:         token1,
+				},
+			},
+		},
+		{
+			name: "target removed",
+			events: []eventstore.Event{
+				project.NewSCIMTargetAddedEvent(context.Background(), agg, "target1", "slack", "https://api.slack.com/scim/v2", token1, domain.SCIMProvisioningMapping{}),
+				project.NewSCIMTargetRemovedEvent(context.Background(), agg, "target1"),
+			},
+			want: []*SCIMTarget{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rm := NewProjectSCIMTargetsReadModel("project1", "org1")
+			rm.AppendEvents(tt.events...)
+			require.NoError(t, rm.Reduce())
+			assert.Equal(t, tt.want, rm.Targets)
+		})
+	}
+}
@@ -588,6 +588,74 @@ func (q *BoolQuery) comp() sq.Sqlizer {
 	return sq.Eq{q.Column.identifier(): q.Value}
 }
 
+type BytesQuery struct {
+	Column Column
+	Value  []byte
+}
+
+func NewBytesQuery(c Column, value []byte) (*BytesQuery, error) {
+	if c.isZero() {
+		return nil, ErrMissingColumn
+	}
+	return &BytesQuery{
+		Column: c,
+		Value:  value,
+	}, nil
+}
+
+func (q *BytesQuery) Col() Column {
+	return q.Column
+}
+
+func (q *BytesQuery) toQuery(query sq.SelectBuilder) sq.SelectBuilder {
+	return query.Where(q.comp())
+}
+
+func (q *BytesQuery) comp() sq.Sqlizer {
+	return sq.Eq{q.Column.identifier(): q.Value}
+}
+
+// JSONQuery matches the JSON documents stored as bytes in the column.
+// If a path is set, the element at the path of the document is matched instead of the whole document.
+// If contains is set, the element must contain the JSON of contains, otherwise it only has to exist.
+type JSONQuery struct {
+	Column   Column
+	Path     []string
+	Contains []byte
+}
+
+func NewJSONQuery(c Column, path []string, contains []byte) (*JSONQuery, error) {
+	if c.isZero() {
+		return nil, ErrMissingColumn
+	}
+	return &JSONQuery{
+		Column:   c,
+		Path:     path,
+		Contains: contains,
+	}, nil
+}
+
+func (q *JSONQuery) Col() Column {
+	return q.Column
+}
+
+func (q *JSONQuery) toQuery(query sq.SelectBuilder) sq.SelectBuilder {
+	return query.Where(q.comp())
+}
+
+func (q *JSONQuery) comp() sq.Sqlizer {
+	element := "CONVERT_FROM(" + q.Column.identifier() + ", 'UTF8')::JSONB"
+	args := make([]interface{}, 0, 2)
+	if len(q.Path) > 0 {
+		element = "(" + element + " #> ?::TEXT[])"
+		args = append(args, q.Path)
+	}
+	if len(q.Contains) == 0 {
+		return sq.Expr(element+" IS NOT NULL", args...)
+	}
+	return sq.Expr(element+" @> ?::JSONB", append(args, string(q.Contains))...)
+}
+
 type TimestampComparison int
 
 const (
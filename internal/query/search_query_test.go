@@ -2193,3 +2193,18 @@ func TestInTextQuery_comp(t *testing.T) {
 		})
 	}
 }
+
+func TestNewBytesQuery(t *testing.T) {
+	_, err := NewBytesQuery(Column{}, []byte("value"))
+	if !errors.Is(err, ErrMissingColumn) {
+		t.Errorf("expected missing column error, got: %v", err)
+	}
+	query, err := NewBytesQuery(testCol, []byte("value"))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	want := sq.Eq{"test_table.test_col": []byte("value")}
+	if !reflect.DeepEqual(query.comp(), want) {
+		t.Errorf("wrong query: want: %v, got: %v", want, query.comp())
+	}
+}
@@ -48,6 +48,10 @@ var (
 		name:  projection.SecurityPolicyColumnEnableImpersonation,
 		table: securityPolicyTable,
 	}
+	SecurityPolicyColumnActionsHTTPAllowList = Column{
+		name:  projection.SecurityPolicyColumnActionsHTTPAllowList,
+		table: securityPolicyTable,
+	}
 )
 
 type SecurityPolicy struct {
@@ -60,6 +64,7 @@ type SecurityPolicy struct {
 	EnableIframeEmbedding bool
 	AllowedOrigins        database.TextArray[string]
 	EnableImpersonation   bool
+	ActionsHTTPAllowList  database.TextArray[string]
 }
 
 func (q *Queries) SecurityPolicy(ctx context.Context) (policy *SecurityPolicy, err error) {
@@ -87,7 +92,8 @@ func prepareSecurityPolicyQuery(ctx context.Context, db prepareDatabase) (sq.Sel
 			SecurityPolicyColumnSequence.identifier(),
 			SecurityPolicyColumnEnableIframeEmbedding.identifier(),
 			SecurityPolicyColumnAllowedOrigins.identifier(),
-			SecurityPolicyColumnEnableImpersonation.identifier()).
+			SecurityPolicyColumnEnableImpersonation.identifier(),
+			SecurityPolicyColumnActionsHTTPAllowList.identifier()).
 			From(securityPolicyTable.identifier() + db.Timetravel(call.Took(ctx))).
 			PlaceholderFormat(sq.Dollar),
 		func(row *sql.Row) (*SecurityPolicy, error) {
@@ -101,6 +107,7 @@ func prepareSecurityPolicyQuery(ctx context.Context, db prepareDatabase) (sq.Sel
 				&securityPolicy.EnableIframeEmbedding,
 				&securityPolicy.AllowedOrigins,
 				&securityPolicy.EnableImpersonation,
+				&securityPolicy.ActionsHTTPAllowList,
 			)
 			if err != nil && !errors.Is(err, sql.ErrNoRows) { // ignore not found errors
 				return nil, zerrors.ThrowInternal(err, "QUERY-Dfrt2", "Errors.Internal")
@@ -260,6 +260,27 @@ func (q *Queries) SearchSessions(ctx context.Context, queries *SessionsSearchQue
 	return sessions, err
 }
 
+// SearchActiveUserSessions returns the sessions of the user which are neither terminated nor expired.
+// Users can always list their own sessions, listing the sessions of other users requires the user.read permission.
+func (q *Queries) SearchActiveUserSessions(ctx context.Context, userID string, queries *SessionsSearchQueries) (sessions *Sessions, err error) {
+	ctxData := authz.GetCtxData(ctx)
+	if ctxData.UserID != userID {
+		if err := q.checkPermission(ctx, domain.PermissionUserRead, ctxData.OrgID, userID); err != nil {
+			return nil, err
+		}
+	}
+	userIDQuery, err := NewUserIDSearchQuery(userID)
+	if err != nil {
+		return nil, err
+	}
+	notExpiredQuery, err := NewSessionNotExpiredSearchQuery(time.Now())
+	if err != nil {
+		return nil, err
+	}
+	queries.Queries = append(queries.Queries, userIDQuery, notExpiredQuery)
+	return q.SearchSessions(ctx, queries)
+}
+
 func NewSessionIDsSearchQuery(ids []string) (SearchQuery, error) {
 	list := make([]interface{}, len(ids))
 	for i, value := range ids {
@@ -276,6 +297,18 @@ func NewUserIDSearchQuery(id string) (SearchQuery, error) {
 	return NewTextQuery(SessionColumnUserID, id, TextEquals)
 }
 
+func NewSessionNotExpiredSearchQuery(now time.Time) (SearchQuery, error) {
+	withoutExpiration, err := NewIsNullQuery(SessionColumnExpiration)
+	if err != nil {
+		return nil, err
+	}
+	notExpired, err := NewTimestampQuery(SessionColumnExpiration, now, TimestampGreater)
+	if err != nil {
+		return nil, err
+	}
+	return NewOrQuery(withoutExpiration, notExpired)
+}
+
 func NewCreationDateQuery(datetime time.Time, compare TimestampComparison) (SearchQuery, error) {
 	return NewTimestampQuery(SessionColumnCreationDate, datetime, compare)
 }
@@ -415,6 +448,10 @@ func prepareSessionsQuery(ctx context.Context, db prepareDatabase) (sq.SelectBui
 			SessionColumnOTPSMSCheckedAt.identifier(),
 			SessionColumnOTPEmailCheckedAt.identifier(),
 			SessionColumnMetadata.identifier(),
+			SessionColumnUserAgentFingerprintID.identifier(),
+			SessionColumnUserAgentIP.identifier(),
+			SessionColumnUserAgentDescription.identifier(),
+			SessionColumnUserAgentHeader.identifier(),
 			SessionColumnExpiration.identifier(),
 			countColumn.identifier(),
 		).From(sessionsTable.identifier()).
@@ -441,6 +478,8 @@ func prepareSessionsQuery(ctx context.Context, db prepareDatabase) (sq.SelectBui
 					otpSMSCheckedAt     sql.NullTime
 					otpEmailCheckedAt   sql.NullTime
 					metadata            database.Map[[]byte]
+					userAgentIP         sql.NullString
+					userAgentHeader     database.Map[[]string]
 					expiration          sql.NullTime
 				)
 
@@ -465,6 +504,10 @@ func prepareSessionsQuery(ctx context.Context, db prepareDatabase) (sq.SelectBui
 					&otpSMSCheckedAt,
 					&otpEmailCheckedAt,
 					&metadata,
+					&session.UserAgent.FingerprintID,
+					&userAgentIP,
+					&session.UserAgent.Description,
+					&userAgentHeader,
 					&expiration,
 					&sessions.Count,
 				)
@@ -485,6 +528,10 @@ func prepareSessionsQuery(ctx context.Context, db prepareDatabase) (sq.SelectBui
 				session.OTPSMSFactor.OTPCheckedAt = otpSMSCheckedAt.Time
 				session.OTPEmailFactor.OTPCheckedAt = otpEmailCheckedAt.Time
 				session.Metadata = metadata
+				session.UserAgent.Header = http.Header(userAgentHeader)
+				if userAgentIP.Valid {
+					session.UserAgent.IP = net.ParseIP(userAgentIP.String)
+				}
 				session.Expiration = expiration.Time
 
 				sessions.Sessions = append(sessions.Sessions, session)
@@ -11,6 +11,7 @@ import (
 	"github.com/zitadel/zitadel/internal/api/authz"
 	"github.com/zitadel/zitadel/internal/api/call"
 	"github.com/zitadel/zitadel/internal/crypto"
+	"github.com/zitadel/zitadel/internal/database"
 	"github.com/zitadel/zitadel/internal/domain"
 	"github.com/zitadel/zitadel/internal/query/projection"
 	"github.com/zitadel/zitadel/internal/telemetry/tracing"
@@ -30,8 +31,14 @@ type SMSConfig struct {
 	ResourceOwner string
 	State         domain.SMSConfigState
 	Sequence      uint64
+	Description   string
+	// Priority orders the active providers for failover, lower values are tried first
+	Priority uint32
+	// CountryCodes restricts the provider to recipients with one of the country calling code prefixes (e.g. +41)
+	CountryCodes []string
 
 	TwilioConfig *Twilio
+	HTTPConfig   *HTTP
 }
 
 type Twilio struct {
@@ -40,6 +47,11 @@ type Twilio struct {
 	SenderNumber string
 }
 
+type HTTP struct {
+	Endpoint   string
+	SigningKey *crypto.CryptoValue
+}
+
 type SMSConfigsSearchQueries struct {
 	SearchRequest
 	Queries []SearchQuery
@@ -115,6 +127,48 @@ var (
 	}
 )
 
+var (
+	smsHTTPConfigsTable = table{
+		name:          projection.SMSHTTPTable,
+		instanceIDCol: projection.SMSHTTPColumnInstanceID,
+	}
+	SMSHTTPConfigColumnSMSID = Column{
+		name:  projection.SMSHTTPConfigColumnSMSID,
+		table: smsHTTPConfigsTable,
+	}
+	SMSHTTPConfigColumnDescription = Column{
+		name:  projection.SMSHTTPConfigColumnDescription,
+		table: smsHTTPConfigsTable,
+	}
+	SMSHTTPConfigColumnEndpoint = Column{
+		name:  projection.SMSHTTPConfigColumnEndpoint,
+		table: smsHTTPConfigsTable,
+	}
+	SMSHTTPConfigColumnSigningKey = Column{
+		name:  projection.SMSHTTPConfigColumnSigningKey,
+		table: smsHTTPConfigsTable,
+	}
+)
+
+var (
+	smsRoutingConfigsTable = table{
+		name:          projection.SMSRoutingTable,
+		instanceIDCol: projection.SMSRoutingColumnInstanceID,
+	}
+	SMSRoutingConfigColumnSMSID = Column{
+		name:  projection.SMSRoutingColumnSMSID,
+		table: smsRoutingConfigsTable,
+	}
+	SMSRoutingConfigColumnPriority = Column{
+		name:  projection.SMSRoutingColumnPriority,
+		table: smsRoutingConfigsTable,
+	}
+	SMSRoutingConfigColumnCountryCodes = Column{
+		name:  projection.SMSRoutingColumnCountryCodes,
+		table: smsRoutingConfigsTable,
+	}
+)
+
 func (q *Queries) SMSProviderConfigByID(ctx context.Context, id string) (config *SMSConfig, err error) {
 	ctx, span := tracing.NewSpan(ctx)
 	defer func() { span.EndWithError(err) }()
@@ -203,13 +257,25 @@ func prepareSMSConfigQuery(ctx context.Context, db prepareDatabase) (sq.SelectBu
 			SMSTwilioConfigColumnSID.identifier(),
 			SMSTwilioConfigColumnToken.identifier(),
 			SMSTwilioConfigColumnSenderNumber.identifier(),
+
+			SMSHTTPConfigColumnSMSID.identifier(),
+			SMSHTTPConfigColumnDescription.identifier(),
+			SMSHTTPConfigColumnEndpoint.identifier(),
+			SMSHTTPConfigColumnSigningKey.identifier(),
+
+			SMSRoutingConfigColumnPriority.identifier(),
+			SMSRoutingConfigColumnCountryCodes.identifier(),
 		).From(smsConfigsTable.identifier()).
-			LeftJoin(join(SMSTwilioConfigColumnSMSID, SMSConfigColumnID) + db.Timetravel(call.Took(ctx))).
+			LeftJoin(join(SMSTwilioConfigColumnSMSID, SMSConfigColumnID)).
+			LeftJoin(join(SMSHTTPConfigColumnSMSID, SMSConfigColumnID)).
+			LeftJoin(join(SMSRoutingConfigColumnSMSID, SMSConfigColumnID) + db.Timetravel(call.Took(ctx))).
 			PlaceholderFormat(sq.Dollar), func(row *sql.Row) (*SMSConfig, error) {
 			config := new(SMSConfig)
 
 			var (
-				twilioConfig = sqlTwilioConfig{}
+				twilioConfig  = sqlTwilioConfig{}
+				httpConfig    = sqlHTTPConfig{}
+				routingConfig = sqlSMSRoutingConfig{}
 			)
 
 			err := row.Scan(
@@ -225,6 +291,14 @@ func prepareSMSConfigQuery(ctx context.Context, db prepareDatabase) (sq.SelectBu
 				&twilioConfig.sid,
 				&twilioConfig.token,
 				&twilioConfig.senderNumber,
+
+				&httpConfig.smsID,
+				&httpConfig.description,
+				&httpConfig.endpoint,
+				&httpConfig.signingKey,
+
+				&routingConfig.priority,
+				&routingConfig.countryCodes,
 			)
 
 			if err != nil {
@@ -235,6 +309,8 @@ func prepareSMSConfigQuery(ctx context.Context, db prepareDatabase) (sq.SelectBu
 			}
 
 			twilioConfig.set(config)
+			httpConfig.set(config)
+			routingConfig.set(config)
 
 			return config, nil
 		}
@@ -254,16 +330,28 @@ func prepareSMSConfigsQuery(ctx context.Context, db prepareDatabase) (sq.SelectB
 			SMSTwilioConfigColumnSID.identifier(),
 			SMSTwilioConfigColumnToken.identifier(),
 			SMSTwilioConfigColumnSenderNumber.identifier(),
+
+			SMSHTTPConfigColumnSMSID.identifier(),
+			SMSHTTPConfigColumnDescription.identifier(),
+			SMSHTTPConfigColumnEndpoint.identifier(),
+			SMSHTTPConfigColumnSigningKey.identifier(),
+
+			SMSRoutingConfigColumnPriority.identifier(),
+			SMSRoutingConfigColumnCountryCodes.identifier(),
 			countColumn.identifier(),
 		).From(smsConfigsTable.identifier()).
-			LeftJoin(join(SMSTwilioConfigColumnSMSID, SMSConfigColumnID) + db.Timetravel(call.Took(ctx))).
+			LeftJoin(join(SMSTwilioConfigColumnSMSID, SMSConfigColumnID)).
+			LeftJoin(join(SMSHTTPConfigColumnSMSID, SMSConfigColumnID)).
+			LeftJoin(join(SMSRoutingConfigColumnSMSID, SMSConfigColumnID) + db.Timetravel(call.Took(ctx))).
 			PlaceholderFormat(sq.Dollar), func(row *sql.Rows) (*SMSConfigs, error) {
 			configs := &SMSConfigs{Configs: []*SMSConfig{}}
 
 			for row.Next() {
 				config := new(SMSConfig)
 				var (
-					twilioConfig = sqlTwilioConfig{}
+					twilioConfig  = sqlTwilioConfig{}
+					httpConfig    = sqlHTTPConfig{}
+					routingConfig = sqlSMSRoutingConfig{}
 				)
 
 				err := row.Scan(
@@ -279,6 +367,14 @@ func prepareSMSConfigsQuery(ctx context.Context, db prepareDatabase) (sq.SelectB
 					&twilioConfig.sid,
 					&twilioConfig.token,
 					&twilioConfig.senderNumber,
+
+					&httpConfig.smsID,
+					&httpConfig.description,
+					&httpConfig.endpoint,
+					&httpConfig.signingKey,
+
+					&routingConfig.priority,
+					&routingConfig.countryCodes,
 					&configs.Count,
 				)
 
@@ -287,6 +383,8 @@ func prepareSMSConfigsQuery(ctx context.Context, db prepareDatabase) (sq.SelectB
 				}
 
 				twilioConfig.set(config)
+				httpConfig.set(config)
+				routingConfig.set(config)
 
 				configs.Configs = append(configs.Configs, config)
 			}
@@ -312,3 +410,33 @@ func (c sqlTwilioConfig) set(smsConfig *SMSConfig) {
 		SenderNumber: c.senderNumber.String,
 	}
 }
+
+type sqlHTTPConfig struct {
+	smsID       sql.NullString
+	description sql.NullString
+	endpoint    sql.NullString
+	signingKey  *crypto.CryptoValue
+}
+
+func (c sqlHTTPConfig) set(smsConfig *SMSConfig) {
+	if !c.smsID.Valid {
+		return
+	}
+	smsConfig.Description = c.description.String
+	smsConfig.HTTPConfig = &HTTP{
+		Endpoint:   c.endpoint.String,
+		SigningKey: c.signingKey,
+	}
+}
+
+type sqlSMSRoutingConfig struct {
+	priority     sql.NullInt64
+	countryCodes database.TextArray[string]
+}
+
+func (c sqlSMSRoutingConfig) set(smsConfig *SMSConfig) {
+	smsConfig.Priority = uint32(c.priority.Int64)
+	if len(c.countryCodes) > 0 {
+		smsConfig.CountryCodes = c.countryCodes
+	}
+}
@@ -9,6 +9,7 @@ import (
 	"testing"
 
 	"github.com/zitadel/zitadel/internal/crypto"
+	"github.com/zitadel/zitadel/internal/database"
 	"github.com/zitadel/zitadel/internal/domain"
 	"github.com/zitadel/zitadel/internal/zerrors"
 )
@@ -26,9 +27,21 @@ var (
 		` projections.sms_configs2_twilio.sms_id,` +
 		` projections.sms_configs2_twilio.sid,` +
 		` projections.sms_configs2_twilio.token,` +
-		` projections.sms_configs2_twilio.sender_number` +
+		` projections.sms_configs2_twilio.sender_number,` +
+
+		// http config
+		` projections.sms_configs2_http.sms_id,` +
+		` projections.sms_configs2_http.description,` +
+		` projections.sms_configs2_http.endpoint,` +
+		` projections.sms_configs2_http.signing_key,` +
+
+		// routing config
+		` projections.sms_configs2_routing.priority,` +
+		` projections.sms_configs2_routing.country_codes` +
 		` FROM projections.sms_configs2` +
 		` LEFT JOIN projections.sms_configs2_twilio ON projections.sms_configs2.id = projections.sms_configs2_twilio.sms_id AND projections.sms_configs2.instance_id = projections.sms_configs2_twilio.instance_id` +
+		` LEFT JOIN projections.sms_configs2_http ON projections.sms_configs2.id = projections.sms_configs2_http.sms_id AND projections.sms_configs2.instance_id = projections.sms_configs2_http.instance_id` +
+		` LEFT JOIN projections.sms_configs2_routing ON projections.sms_configs2.id = projections.sms_configs2_routing.sms_id AND projections.sms_configs2.instance_id = projections.sms_configs2_routing.instance_id` +
 		` AS OF SYSTEM TIME '-1 ms'`)
 	expectedSMSConfigsQuery = regexp.QuoteMeta(`SELECT projections.sms_configs2.id,` +
 		` projections.sms_configs2.aggregate_id,` +
@@ -43,9 +56,21 @@ var (
 		` projections.sms_configs2_twilio.sid,` +
 		` projections.sms_configs2_twilio.token,` +
 		` projections.sms_configs2_twilio.sender_number,` +
+
+		// http config
+		` projections.sms_configs2_http.sms_id,` +
+		` projections.sms_configs2_http.description,` +
+		` projections.sms_configs2_http.endpoint,` +
+		` projections.sms_configs2_http.signing_key,` +
+
+		// routing config
+		` projections.sms_configs2_routing.priority,` +
+		` projections.sms_configs2_routing.country_codes,` +
 		` COUNT(*) OVER ()` +
 		` FROM projections.sms_configs2` +
 		` LEFT JOIN projections.sms_configs2_twilio ON projections.sms_configs2.id = projections.sms_configs2_twilio.sms_id AND projections.sms_configs2.instance_id = projections.sms_configs2_twilio.instance_id` +
+		` LEFT JOIN projections.sms_configs2_http ON projections.sms_configs2.id = projections.sms_configs2_http.sms_id AND projections.sms_configs2.instance_id = projections.sms_configs2_http.instance_id` +
+		` LEFT JOIN projections.sms_configs2_routing ON projections.sms_configs2.id = projections.sms_configs2_routing.sms_id AND projections.sms_configs2.instance_id = projections.sms_configs2_routing.instance_id` +
 		` AS OF SYSTEM TIME '-1 ms'`)
 
 	smsConfigCols = []string{
@@ -61,6 +86,14 @@ var (
 		"sid",
 		"token",
 		"sender-number",
+		// http config
+		"sms_id",
+		"description",
+		"endpoint",
+		"signing_key",
+		// routing config
+		"priority",
+		"country_codes",
 	}
 	smsConfigsCols = append(smsConfigCols, "count")
 )
@@ -109,6 +142,14 @@ func Test_SMSConfigssPrepare(t *testing.T) {
 							"sid",
 							&crypto.CryptoValue{},
 							"sender-number",
+							// http config
+							nil,
+							nil,
+							nil,
+							nil,
+							// routing config
+							nil,
+							nil,
 						},
 					},
 				),
@@ -135,6 +176,63 @@ func Test_SMSConfigssPrepare(t *testing.T) {
 				},
 			},
 		},
+		{
+			name:    "prepareSMSQuery http config",
+			prepare: prepareSMSConfigsQuery,
+			want: want{
+				sqlExpectations: mockQueries(
+					expectedSMSConfigsQuery,
+					smsConfigsCols,
+					[][]driver.Value{
+						{
+							"sms-id",
+							"agg-id",
+							testNow,
+							testNow,
+							"ro",
+							domain.SMSConfigStateInactive,
+							uint64(20211109),
+							// twilio config
+							nil,
+							nil,
+							nil,
+							nil,
+							// http config
+							"sms-id",
+							"description",
+							"https://gateway.example.com",
+							&crypto.CryptoValue{},
+							// routing config
+							int64(1),
+							database.TextArray[string]{"+41", "+49"},
+						},
+					},
+				),
+			},
+			object: &SMSConfigs{
+				SearchResponse: SearchResponse{
+					Count: 1,
+				},
+				Configs: []*SMSConfig{
+					{
+						ID:            "sms-id",
+						AggregateID:   "agg-id",
+						CreationDate:  testNow,
+						ChangeDate:    testNow,
+						ResourceOwner: "ro",
+						State:         domain.SMSConfigStateInactive,
+						Sequence:      20211109,
+						Description:   "description",
+						Priority:      1,
+						CountryCodes:  []string{"+41", "+49"},
+						HTTPConfig: &HTTP{
+							Endpoint:   "https://gateway.example.com",
+							SigningKey: &crypto.CryptoValue{},
+						},
+					},
+				},
+			},
+		},
 		{
 			name:    "prepareSMSConfigsQuery multiple result",
 			prepare: prepareSMSConfigsQuery,
@@ -156,6 +254,14 @@ func Test_SMSConfigssPrepare(t *testing.T) {
 							"sid",
 							&crypto.CryptoValue{},
 							"sender-number",
+							// http config
+							nil,
+							nil,
+							nil,
+							nil,
+							// routing config
+							nil,
+							nil,
 						},
 						{
 							"sms-id2",
@@ -170,6 +276,14 @@ func Test_SMSConfigssPrepare(t *testing.T) {
 							"sid2",
 							&crypto.CryptoValue{},
 							"sender-number2",
+							// http config
+							nil,
+							nil,
+							nil,
+							nil,
+							// routing config
+							nil,
+							nil,
 						},
 					},
 				),
@@ -284,6 +398,14 @@ func Test_SMSConfigPrepare(t *testing.T) {
 						"sid",
 						&crypto.CryptoValue{},
 						"sender-number",
+						// http config
+						nil,
+						nil,
+						nil,
+						nil,
+						// routing config
+						nil,
+						nil,
 					},
 				),
 			},
@@ -27,8 +27,12 @@ var (
 		` projections.smtp_configs2.password,` +
 		` projections.smtp_configs2.id,` +
 		` projections.smtp_configs2.state,` +
-		` projections.smtp_configs2.description` +
+		` projections.smtp_configs2.description,` +
+		` projections.smtp_configs2_http.smtp_id,` +
+		` projections.smtp_configs2_http.endpoint,` +
+		` projections.smtp_configs2_http.signing_key` +
 		` FROM projections.smtp_configs2` +
+		` LEFT JOIN projections.smtp_configs2_http ON projections.smtp_configs2.id = projections.smtp_configs2_http.smtp_id AND projections.smtp_configs2.instance_id = projections.smtp_configs2_http.instance_id` +
 		` AS OF SYSTEM TIME '-1 ms'`
 	prepareSMTPConfigCols = []string{
 		"creation_date",
@@ -45,6 +49,9 @@ var (
 		"id",
 		"state",
 		"description",
+		"smtp_id",
+		"endpoint",
+		"signing_key",
 	}
 )
 
@@ -99,6 +106,9 @@ func Test_SMTPConfigsPrepares(t *testing.T) {
 						"2232323",
 						domain.SMTPConfigStateActive,
 						"test",
+						nil,
+						nil,
+						nil,
 					},
 				),
 			},
@@ -141,6 +151,9 @@ func Test_SMTPConfigsPrepares(t *testing.T) {
 						"44442323",
 						domain.SMTPConfigStateInactive,
 						"test2",
+						nil,
+						nil,
+						nil,
 					},
 				),
 			},
@@ -183,6 +196,9 @@ func Test_SMTPConfigsPrepares(t *testing.T) {
 						"23234444",
 						domain.SMTPConfigStateInactive,
 						"test3",
+						nil,
+						nil,
+						nil,
 					},
 				),
 			},
@@ -203,6 +219,48 @@ func Test_SMTPConfigsPrepares(t *testing.T) {
 				Description:    "test3",
 			},
 		},
+		{
+			name:    "prepareSMTPConfigQuery http config found",
+			prepare: prepareSMTPConfigQuery,
+			want: want{
+				sqlExpectations: mockQuery(
+					regexp.QuoteMeta(prepareSMTPConfigStmt),
+					prepareSMTPConfigCols,
+					[]driver.Value{
+						testNow,
+						testNow,
+						"ro",
+						uint64(20211109),
+						false,
+						"",
+						"",
+						"",
+						"",
+						"",
+						nil,
+						"23234445",
+						domain.SMTPConfigStateInactive,
+						"http",
+						"23234445",
+						"https://gateway.example.com",
+						&crypto.CryptoValue{},
+					},
+				),
+			},
+			object: &SMTPConfig{
+				CreationDate:  testNow,
+				ChangeDate:    testNow,
+				ResourceOwner: "ro",
+				Sequence:      20211109,
+				ID:            "23234445",
+				State:         domain.SMTPConfigStateInactive,
+				Description:   "http",
+				HTTPConfig: &HTTP{
+					Endpoint:   "https://gateway.example.com",
+					SigningKey: &crypto.CryptoValue{},
+				},
+			},
+		},
 		{
 			name:    "prepareSMTPConfigQuery sql err",
 			prepare: prepareSMTPConfigQuery,
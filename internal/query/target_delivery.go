@@ -0,0 +1,142 @@
+package query
+
+import (
+	"context"
+	"slices"
+	"time"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/repository/target"
+	"github.com/zitadel/zitadel/internal/telemetry/tracing"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+// TargetDelivery is a dead-lettered delivery of an event to a target.
+type TargetDelivery struct {
+	ID           string
+	TargetID     string
+	CreationDate time.Time
+	ChangeDate   time.Time
+	Sequence     uint64
+
+	target.Delivery
+	Attempts uint16
+	Reason   string
+	State    domain.TargetDeliveryState
+}
+
+// TargetDeliveries returns the dead-lettered deliveries of the target, the newest first.
+// Deliveries which were successfully replayed are only returned if includeSucceeded is set.
+func (q *Queries) TargetDeliveries(ctx context.Context, targetID string, includeSucceeded bool) (_ []*TargetDelivery, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	model := newTargetDeliveriesReadModel(authz.GetInstance(ctx).InstanceID(), targetID)
+	if err = q.eventstore.FilterToQueryReducer(ctx, model); err != nil {
+		return nil, err
+	}
+	deliveries := model.deliveries
+	if !includeSucceeded {
+		deliveries = slices.DeleteFunc(deliveries, func(delivery *TargetDelivery) bool {
+			return delivery.State == domain.TargetDeliveryStateSucceeded
+		})
+	}
+	slices.Reverse(deliveries)
+	return deliveries, nil
+}
+
+func (q *Queries) TargetDeliveryByID(ctx context.Context, targetID, deliveryID string) (_ *TargetDelivery, err error) {
+	deliveries, err := q.TargetDeliveries(ctx, targetID, true)
+	if err != nil {
+		return nil, err
+	}
+	index := slices.IndexFunc(deliveries, func(delivery *TargetDelivery) bool {
+		return delivery.ID == deliveryID
+	})
+	if index < 0 {
+		return nil, zerrors.ThrowNotFound(nil, "QUERY-9vjz1kt4qd", "Errors.Target.Delivery.NotFound")
+	}
+	return deliveries[index], nil
+}
+
+type targetDeliveriesReadModel struct {
+	eventstore.ReadModel
+
+	deliveries []*TargetDelivery
+}
+
+func newTargetDeliveriesReadModel(instanceID, targetID string) *targetDeliveriesReadModel {
+	return &targetDeliveriesReadModel{
+		ReadModel: eventstore.ReadModel{
+			AggregateID:   targetID,
+			ResourceOwner: instanceID,
+			InstanceID:    instanceID,
+		},
+	}
+}
+
+func (rm *targetDeliveriesReadModel) Reduce() error {
+	for _, event := range rm.Events {
+		switch e := event.(type) {
+		case *target.DeliveryFailedEvent:
+			delivery := rm.delivery(e.DeliveryID)
+			if delivery == nil {
+				delivery = &TargetDelivery{
+					ID:           e.DeliveryID,
+					TargetID:     e.Aggregate().ID,
+					CreationDate: e.CreatedAt(),
+				}
+				rm.deliveries = append(rm.deliveries, delivery)
+			}
+			delivery.Delivery = e.Delivery
+			delivery.Attempts += e.Attempts
+			delivery.Reason = e.Reason
+			delivery.State = domain.TargetDeliveryStateFailed
+			delivery.ChangeDate = e.CreatedAt()
+			delivery.Sequence = e.Sequence()
+		case *target.DeliveryReplayedEvent:
+			if delivery := rm.delivery(e.DeliveryID); delivery != nil {
+				delivery.State = domain.TargetDeliveryStateReplaying
+				delivery.ChangeDate = e.CreatedAt()
+				delivery.Sequence = e.Sequence()
+			}
+		case *target.DeliverySucceededEvent:
+			if delivery := rm.delivery(e.DeliveryID); delivery != nil {
+				delivery.State = domain.TargetDeliveryStateSucceeded
+				delivery.Reason = ""
+				delivery.ChangeDate = e.CreatedAt()
+				delivery.Sequence = e.Sequence()
+			}
+		case *target.RemovedEvent:
+			rm.deliveries = nil
+		}
+	}
+	return rm.ReadModel.Reduce()
+}
+
+func (rm *targetDeliveriesReadModel) delivery(deliveryID string) *TargetDelivery {
+	for _, delivery := range rm.deliveries {
+		if delivery.ID == deliveryID {
+			return delivery
+		}
+	}
+	return nil
+}
+
+func (rm *targetDeliveriesReadModel) Query() *eventstore.SearchQueryBuilder {
+	return eventstore.NewSearchQueryBuilder(eventstore.ColumnsEvent).
+		AwaitOpenTransactions().
+		InstanceID(rm.InstanceID).
+		AddQuery().
+		AggregateTypes(target.AggregateType).
+		AggregateIDs(rm.AggregateID).
+		EventTypes(
+			target.DeliveryFailedEventType,
+			target.DeliveryReplayedEventType,
+			target.DeliverySucceededEventType,
+			target.RemovedEventType,
+		).
+		Builder()
+}
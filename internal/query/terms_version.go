@@ -0,0 +1,246 @@
+package query
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"slices"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/api/call"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/query/projection"
+	"github.com/zitadel/zitadel/internal/repository/user"
+	"github.com/zitadel/zitadel/internal/telemetry/tracing"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+// TermsVersion is a published version of the terms of service and privacy policy.
+type TermsVersion struct {
+	// ResourceOwner is the organization or instance which published the version.
+	ResourceOwner string
+	PublishedAt   time.Time
+	Version       string
+	TOSLink       string
+	PrivacyLink   string
+	IsDefault     bool
+}
+
+// TermsAcceptance is the latest version of the terms of service and privacy policy a user accepted.
+type TermsAcceptance struct {
+	UserID     string
+	AcceptedAt time.Time
+	Version    string
+	// TermsOwner is the organization or instance which published the accepted version.
+	TermsOwner string
+}
+
+// IsAcceptanceOf returns true if the user accepted the passed version.
+func (a *TermsAcceptance) IsAcceptanceOf(version *TermsVersion) bool {
+	return a != nil && version != nil &&
+		a.Version == version.Version &&
+		a.TermsOwner == version.ResourceOwner
+}
+
+var (
+	termsVersionTable = table{
+		name:          projection.TermsVersionTable,
+		instanceIDCol: projection.TermsVersionInstanceIDCol,
+	}
+	TermsVersionColInstanceID = Column{
+		name:  projection.TermsVersionInstanceIDCol,
+		table: termsVersionTable,
+	}
+	TermsVersionColResourceOwner = Column{
+		name:  projection.TermsVersionResourceOwnerCol,
+		table: termsVersionTable,
+	}
+	TermsVersionColIsDefault = Column{
+		name:  projection.TermsVersionIsDefaultCol,
+		table: termsVersionTable,
+	}
+	TermsVersionColPublishedAt = Column{
+		name:  projection.TermsVersionPublishedAtCol,
+		table: termsVersionTable,
+	}
+	TermsVersionColVersion = Column{
+		name:  projection.TermsVersionVersionCol,
+		table: termsVersionTable,
+	}
+	TermsVersionColTOSLink = Column{
+		name:  projection.TermsVersionTOSLinkCol,
+		table: termsVersionTable,
+	}
+	TermsVersionColPrivacyLink = Column{
+		name:  projection.TermsVersionPrivacyLinkCol,
+		table: termsVersionTable,
+	}
+)
+
+// ActiveTermsVersion returns the version of the terms of service and privacy policy the users of the organization have to accept.
+// The version of the organization takes precedence over the one of the instance.
+// If no version was published, nil is returned.
+func (q *Queries) ActiveTermsVersion(ctx context.Context, orgID string) (_ *TermsVersion, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	instanceID := authz.GetInstance(ctx).InstanceID()
+	return q.termsVersion(ctx, sq.Eq{
+		TermsVersionColInstanceID.identifier():    instanceID,
+		TermsVersionColResourceOwner.identifier(): []string{orgID, instanceID},
+	})
+}
+
+// DefaultTermsVersion returns the version of the terms of service and privacy policy published by the instance.
+// If no version was published, nil is returned.
+func (q *Queries) DefaultTermsVersion(ctx context.Context) (_ *TermsVersion, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	return q.termsVersion(ctx, sq.Eq{
+		TermsVersionColInstanceID.identifier(): authz.GetInstance(ctx).InstanceID(),
+		TermsVersionColIsDefault.identifier():  true,
+	})
+}
+
+func (q *Queries) termsVersion(ctx context.Context, eq sq.Eq) (version *TermsVersion, err error) {
+	stmt, scan := prepareTermsVersionQuery(ctx, q.client)
+	query, args, err := stmt.Where(eq).
+		// the version of the organization is sorted before the default version
+		OrderBy(TermsVersionColIsDefault.identifier()).
+		Limit(1).ToSql()
+	if err != nil {
+		return nil, zerrors.ThrowInternal(err, "QUERY-Tv2sq", "Errors.Query.SQLStatement")
+	}
+
+	err = q.client.QueryRowContext(ctx, func(row *sql.Row) error {
+		version, err = scan(row)
+		return err
+	}, query, args...)
+	return version, err
+}
+
+func prepareTermsVersionQuery(ctx context.Context, db prepareDatabase) (sq.SelectBuilder, func(*sql.Row) (*TermsVersion, error)) {
+	return sq.Select(
+			TermsVersionColResourceOwner.identifier(),
+			TermsVersionColPublishedAt.identifier(),
+			TermsVersionColVersion.identifier(),
+			TermsVersionColTOSLink.identifier(),
+			TermsVersionColPrivacyLink.identifier(),
+			TermsVersionColIsDefault.identifier(),
+		).
+			From(termsVersionTable.identifier() + db.Timetravel(call.Took(ctx))).
+			PlaceholderFormat(sq.Dollar),
+		func(row *sql.Row) (*TermsVersion, error) {
+			version := new(TermsVersion)
+			err := row.Scan(
+				&version.ResourceOwner,
+				&version.PublishedAt,
+				&version.Version,
+				&version.TOSLink,
+				&version.PrivacyLink,
+				&version.IsDefault,
+			)
+			if errors.Is(err, sql.ErrNoRows) {
+				return nil, nil
+			}
+			if err != nil {
+				return nil, zerrors.ThrowInternal(err, "QUERY-Tv3sc", "Errors.Internal")
+			}
+			return version, nil
+		}
+}
+
+// UserTermsAcceptance returns the latest version of the terms of service and privacy policy the user accepted.
+// If the user never accepted any version, nil is returned.
+func (q *Queries) UserTermsAcceptance(ctx context.Context, userID string) (_ *TermsAcceptance, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	model := newTermsAcceptancesReadModel(authz.GetInstance(ctx).InstanceID(), "")
+	model.AggregateID = userID
+	if err = q.eventstore.FilterToQueryReducer(ctx, model); err != nil {
+		return nil, err
+	}
+	if len(model.acceptances) == 0 {
+		return nil, nil
+	}
+	return model.acceptances[0], nil
+}
+
+// SearchTermsAcceptances returns the latest acceptance of each user of the organization.
+// If version is set, only users whose latest acceptance is of this version are returned.
+func (q *Queries) SearchTermsAcceptances(ctx context.Context, orgID, version string) (_ []*TermsAcceptance, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	model := newTermsAcceptancesReadModel(authz.GetInstance(ctx).InstanceID(), orgID)
+	if err = q.eventstore.FilterToQueryReducer(ctx, model); err != nil {
+		return nil, err
+	}
+	if version == "" {
+		return model.acceptances, nil
+	}
+	return slices.DeleteFunc(model.acceptances, func(acceptance *TermsAcceptance) bool {
+		return acceptance.Version != version
+	}), nil
+}
+
+type termsAcceptancesReadModel struct {
+	eventstore.ReadModel
+
+	acceptances []*TermsAcceptance
+}
+
+func newTermsAcceptancesReadModel(instanceID, resourceOwner string) *termsAcceptancesReadModel {
+	return &termsAcceptancesReadModel{
+		ReadModel: eventstore.ReadModel{
+			ResourceOwner: resourceOwner,
+			InstanceID:    instanceID,
+		},
+	}
+}
+
+func (rm *termsAcceptancesReadModel) Reduce() error {
+	for _, event := range rm.Events {
+		switch e := event.(type) {
+		case *user.HumanTermsAcceptedEvent:
+			rm.acceptances = slices.DeleteFunc(rm.acceptances, func(acceptance *TermsAcceptance) bool {
+				return acceptance.UserID == e.Aggregate().ID
+			})
+			rm.acceptances = append(rm.acceptances, &TermsAcceptance{
+				UserID:     e.Aggregate().ID,
+				AcceptedAt: e.CreatedAt(),
+				Version:    e.Version,
+				TermsOwner: e.TermsOwner,
+			})
+		case *user.UserRemovedEvent:
+			rm.acceptances = slices.DeleteFunc(rm.acceptances, func(acceptance *TermsAcceptance) bool {
+				return acceptance.UserID == e.Aggregate().ID
+			})
+		}
+	}
+	return rm.ReadModel.Reduce()
+}
+
+func (rm *termsAcceptancesReadModel) Query() *eventstore.SearchQueryBuilder {
+	query := eventstore.NewSearchQueryBuilder(eventstore.ColumnsEvent).
+		AwaitOpenTransactions().
+		InstanceID(rm.InstanceID)
+	if rm.ResourceOwner != "" {
+		query.ResourceOwner(rm.ResourceOwner)
+	}
+	eventQuery := query.AddQuery().
+		AggregateTypes(user.AggregateType).
+		EventTypes(
+			user.HumanTermsAcceptedType,
+			user.UserRemovedType,
+		)
+	if rm.AggregateID != "" {
+		eventQuery.AggregateIDs(rm.AggregateID)
+	}
+	return eventQuery.Builder()
+}
@@ -0,0 +1,103 @@
+package query
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"regexp"
+	"testing"
+)
+
+var (
+	prepareTermsVersionStmt = `SELECT projections.terms_versions.resource_owner,` +
+		` projections.terms_versions.published_at,` +
+		` projections.terms_versions.version,` +
+		` projections.terms_versions.tos_link,` +
+		` projections.terms_versions.privacy_link,` +
+		` projections.terms_versions.is_default` +
+		` FROM projections.terms_versions` +
+		` AS OF SYSTEM TIME '-1 ms'`
+	prepareTermsVersionCols = []string{
+		"resource_owner",
+		"published_at",
+		"version",
+		"tos_link",
+		"privacy_link",
+		"is_default",
+	}
+)
+
+func Test_TermsVersionPrepares(t *testing.T) {
+	type want struct {
+		sqlExpectations sqlExpectation
+		err             checkErr
+	}
+	tests := []struct {
+		name    string
+		prepare interface{}
+		want    want
+		object  interface{}
+	}{
+		{
+			name:    "prepareTermsVersionQuery no result",
+			prepare: prepareTermsVersionQuery,
+			want: want{
+				sqlExpectations: mockQuery(
+					regexp.QuoteMeta(prepareTermsVersionStmt),
+					nil,
+					nil,
+				),
+			},
+			object: (*TermsVersion)(nil),
+		},
+		{
+			name:    "prepareTermsVersionQuery found",
+			prepare: prepareTermsVersionQuery,
+			want: want{
+				sqlExpectations: mockQuery(
+					regexp.QuoteMeta(prepareTermsVersionStmt),
+					prepareTermsVersionCols,
+					[]driver.Value{
+						"instance-id",
+						testNow,
+						"2024-01",
+						"https://example.com/tos",
+						"https://example.com/privacy",
+						true,
+					},
+				),
+			},
+			object: &TermsVersion{
+				ResourceOwner: "instance-id",
+				PublishedAt:   testNow,
+				Version:       "2024-01",
+				TOSLink:       "https://example.com/tos",
+				PrivacyLink:   "https://example.com/privacy",
+				IsDefault:     true,
+			},
+		},
+		{
+			name:    "prepareTermsVersionQuery sql err",
+			prepare: prepareTermsVersionQuery,
+			want: want{
+				sqlExpectations: mockQueryErr(
+					regexp.QuoteMeta(prepareTermsVersionStmt),
+					sql.ErrConnDone,
+				),
+				err: func(err error) (error, bool) {
+					if !errors.Is(err, sql.ErrConnDone) {
+						return fmt.Errorf("err should be sql.ErrConnDone got: %w", err), false
+					}
+					return nil, true
+				},
+			},
+			object: (*TermsVersion)(nil),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assertPrepare(t, tt.prepare, tt.object, tt.want.sqlExpectations, tt.want.err, defaultPrepareArgs...)
+		})
+	}
+}
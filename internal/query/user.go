@@ -4,7 +4,9 @@ import (
 	"context"
 	"database/sql"
 	_ "embed"
+	"encoding/json"
 	"errors"
+	"strconv"
 	"strings"
 	"time"
 
@@ -732,6 +734,10 @@ func NewUserVerifiedEmailSearchQuery(value string) (SearchQuery, error) {
 	return NewTextQuery(NotifyVerifiedEmailLowerCaseCol, strings.ToLower(value), TextEquals)
 }
 
+func NewUserIsEmailVerifiedSearchQuery(value bool) (SearchQuery, error) {
+	return NewBoolQuery(HumanIsEmailVerifiedCol, value)
+}
+
 func NewUserVerifiedPhoneSearchQuery(value string, comparison TextComparison) (SearchQuery, error) {
 	return NewTextQuery(NotifyVerifiedPhoneCol, value, comparison)
 }
@@ -780,6 +786,102 @@ func NewUserLoginNameExistsQuery(value string, comparison TextComparison) (Searc
 	)
 }
 
+// NewUserMetadataSearchQuery matches the users having metadata with the key.
+// If the value is not empty, the value of the metadata must match as well.
+func NewUserMetadataSearchQuery(key string, value []byte) (SearchQuery, error) {
+	var valueQuery SearchQuery
+	if len(value) > 0 {
+		var err error
+		valueQuery, err = NewBytesQuery(UserMetadataValueCol, value)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return newUserMetadataExistsQuery(key, valueQuery)
+}
+
+// NewUserMetadataJSONSearchQuery matches the users having metadata with the key and a JSON value.
+// The path (e.g. $.address.lines[0]) selects the element of the JSON value which is matched, an empty path matches the whole value.
+// If contains is empty, the element only has to exist, otherwise it must contain the JSON of contains:
+// objects must contain the given members and arrays the given elements.
+func NewUserMetadataJSONSearchQuery(key, path string, contains []byte) (SearchQuery, error) {
+	elements, err := jsonPathElements(path)
+	if err != nil {
+		return nil, err
+	}
+	if len(contains) > 0 && !json.Valid(contains) {
+		return nil, zerrors.ThrowInvalidArgument(nil, "QUERY-Js0nv", "Errors.Query.InvalidRequest")
+	}
+	valueQuery, err := NewJSONQuery(UserMetadataValueCol, elements, contains)
+	if err != nil {
+		return nil, err
+	}
+	return newUserMetadataExistsQuery(key, valueQuery)
+}
+
+func newUserMetadataExistsQuery(key string, valueQuery SearchQuery) (SearchQuery, error) {
+	//linking queries for the subselect
+	instanceQuery, err := NewColumnComparisonQuery(UserMetadataInstanceIDCol, UserInstanceIDCol, ColumnEquals)
+	if err != nil {
+		return nil, err
+	}
+	userIDQuery, err := NewColumnComparisonQuery(UserMetadataUserIDCol, UserIDCol, ColumnEquals)
+	if err != nil {
+		return nil, err
+	}
+	//queries to select data from the linked sub select
+	keyQuery, err := NewTextQuery(UserMetadataKeyCol, key, TextEquals)
+	if err != nil {
+		return nil, err
+	}
+	queries := []SearchQuery{instanceQuery, userIDQuery, keyQuery}
+	if valueQuery != nil {
+		queries = append(queries, valueQuery)
+	}
+	//full definition of the sub select
+	subSelect, err := NewSubSelect(UserMetadataUserIDCol, queries)
+	if err != nil {
+		return nil, err
+	}
+	// "WHERE * IN (*)" query with subquery as list-data provider
+	return NewListQuery(
+		UserIDCol,
+		subSelect,
+		ListIn,
+	)
+}
+
+// jsonPathElements splits a JSON path like $.address.lines[0] into its members and array indexes
+func jsonPathElements(path string) ([]string, error) {
+	path = strings.TrimPrefix(strings.TrimPrefix(path, "$"), ".")
+	if path == "" {
+		return nil, nil
+	}
+	var elements []string
+	for i, segment := range strings.Split(path, ".") {
+		member, indexes, hasIndex := strings.Cut(segment, "[")
+		// only the root can be indexed without a member, e.g. $[0]
+		if member == "" && (!hasIndex || i > 0) {
+			return nil, zerrors.ThrowInvalidArgument(nil, "QUERY-Js0np", "Errors.Query.InvalidRequest")
+		}
+		if member != "" {
+			elements = append(elements, member)
+		}
+		for hasIndex {
+			index, rest, closed := strings.Cut(indexes, "]")
+			if number, err := strconv.Atoi(index); !closed || err != nil || number < 0 {
+				return nil, zerrors.ThrowInvalidArgument(err, "QUERY-Js0ni", "Errors.Query.InvalidRequest")
+			}
+			elements = append(elements, index)
+			indexes, hasIndex = strings.CutPrefix(rest, "[")
+			if !hasIndex && rest != "" {
+				return nil, zerrors.ThrowInvalidArgument(nil, "QUERY-Js0ns", "Errors.Query.InvalidRequest")
+			}
+		}
+	}
+	return elements, nil
+}
+
 func triggerUserProjections(ctx context.Context) {
 	triggerBatch(ctx, projection.UserProjection, projection.LoginNameProjection)
 }
@@ -0,0 +1,118 @@
+package query
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/api/call"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/query/projection"
+	"github.com/zitadel/zitadel/internal/repository/org"
+	"github.com/zitadel/zitadel/internal/telemetry/tracing"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+type UserAttributeSchema struct {
+	OrgID      string
+	ChangeDate time.Time
+	Sequence   uint64
+
+	Attributes []*domain.UserAttribute
+}
+
+var (
+	userAttributeSchemaTable = table{
+		name:          projection.UserAttributeSchemaProjectionTable,
+		instanceIDCol: projection.UserAttributeSchemaColumnInstanceID,
+	}
+	UserAttributeSchemaColumnOrgID = Column{
+		name:  projection.UserAttributeSchemaColumnOrgID,
+		table: userAttributeSchemaTable,
+	}
+	UserAttributeSchemaColumnInstanceID = Column{
+		name:  projection.UserAttributeSchemaColumnInstanceID,
+		table: userAttributeSchemaTable,
+	}
+	UserAttributeSchemaColumnChangeDate = Column{
+		name:  projection.UserAttributeSchemaColumnChangeDate,
+		table: userAttributeSchemaTable,
+	}
+	UserAttributeSchemaColumnSequence = Column{
+		name:  projection.UserAttributeSchemaColumnSequence,
+		table: userAttributeSchemaTable,
+	}
+	UserAttributeSchemaColumnAttributes = Column{
+		name:  projection.UserAttributeSchemaColumnAttributes,
+		table: userAttributeSchemaTable,
+	}
+)
+
+// UserAttributeSchemaByOrg returns the custom user attributes of the organization.
+// Organizations without a schema don't have any custom attributes, so no default schema exists.
+func (q *Queries) UserAttributeSchemaByOrg(ctx context.Context, orgID string) (schema *UserAttributeSchema, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	stmt, scan := prepareUserAttributeSchemaQuery(ctx, q.client)
+	query, args, err := stmt.Where(sq.Eq{
+		UserAttributeSchemaColumnInstanceID.identifier(): authz.GetInstance(ctx).InstanceID(),
+		UserAttributeSchemaColumnOrgID.identifier():      orgID,
+	}).ToSql()
+	if err != nil {
+		return nil, zerrors.ThrowInternal(err, "QUERY-Uas2s", "Errors.Query.SQLStatement")
+	}
+
+	err = q.client.QueryRowContext(ctx, func(row *sql.Row) error {
+		schema, err = scan(row)
+		return err
+	}, query, args...)
+	return schema, err
+}
+
+func prepareUserAttributeSchemaQuery(ctx context.Context, db prepareDatabase) (sq.SelectBuilder, func(*sql.Row) (*UserAttributeSchema, error)) {
+	return sq.Select(
+			UserAttributeSchemaColumnOrgID.identifier(),
+			UserAttributeSchemaColumnChangeDate.identifier(),
+			UserAttributeSchemaColumnSequence.identifier(),
+			UserAttributeSchemaColumnAttributes.identifier(),
+		).
+			From(userAttributeSchemaTable.identifier() + db.Timetravel(call.Took(ctx))).
+			PlaceholderFormat(sq.Dollar),
+		func(row *sql.Row) (*UserAttributeSchema, error) {
+			schema := new(UserAttributeSchema)
+			var data []byte
+			err := row.Scan(
+				&schema.OrgID,
+				&schema.ChangeDate,
+				&schema.Sequence,
+				&data,
+			)
+			if err != nil {
+				if errors.Is(err, sql.ErrNoRows) {
+					return nil, zerrors.ThrowNotFound(err, "QUERY-Uas1n", "Errors.Org.UserAttributeSchema.NotFound")
+				}
+				return nil, zerrors.ThrowInternal(err, "QUERY-Uas3c", "Errors.Internal")
+			}
+			var attributes []*org.UserAttribute
+			if err = json.Unmarshal(data, &attributes); err != nil {
+				return nil, zerrors.ThrowInternal(err, "QUERY-Uas4j", "Errors.Internal")
+			}
+			schema.Attributes = make([]*domain.UserAttribute, len(attributes))
+			for i, attribute := range attributes {
+				schema.Attributes[i] = &domain.UserAttribute{
+					Key:         attribute.Key,
+					DisplayName: attribute.DisplayName,
+					Type:        attribute.Type,
+					Required:    attribute.Required,
+					Pattern:     attribute.Pattern,
+				}
+			}
+			return schema, nil
+		}
+}
@@ -0,0 +1,116 @@
+package query
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+var (
+	prepareUserAttributeSchemaStmt = `SELECT projections.user_attribute_schemas.org_id,` +
+		` projections.user_attribute_schemas.change_date,` +
+		` projections.user_attribute_schemas.sequence,` +
+		` projections.user_attribute_schemas.attributes` +
+		` FROM projections.user_attribute_schemas` +
+		` AS OF SYSTEM TIME '-1 ms'`
+	prepareUserAttributeSchemaCols = []string{
+		"org_id",
+		"change_date",
+		"sequence",
+		"attributes",
+	}
+)
+
+func Test_UserAttributeSchemaPrepares(t *testing.T) {
+	type want struct {
+		sqlExpectations sqlExpectation
+		err             checkErr
+	}
+	tests := []struct {
+		name    string
+		prepare interface{}
+		want    want
+		object  interface{}
+	}{
+		{
+			name:    "prepareUserAttributeSchemaQuery no result",
+			prepare: prepareUserAttributeSchemaQuery,
+			want: want{
+				sqlExpectations: mockQueriesScanErr(
+					regexp.QuoteMeta(prepareUserAttributeSchemaStmt),
+					nil,
+					nil,
+				),
+				err: func(err error) (error, bool) {
+					if !zerrors.IsNotFound(err) {
+						return fmt.Errorf("err should be zitadel.NotFoundError got: %w", err), false
+					}
+					return nil, true
+				},
+			},
+			object: (*UserAttributeSchema)(nil),
+		},
+		{
+			name:    "prepareUserAttributeSchemaQuery found",
+			prepare: prepareUserAttributeSchemaQuery,
+			want: want{
+				sqlExpectations: mockQuery(
+					regexp.QuoteMeta(prepareUserAttributeSchemaStmt),
+					prepareUserAttributeSchemaCols,
+					[]driver.Value{
+						"org-id",
+						testNow,
+						uint64(20211109),
+						[]byte(`[{"key":"department","displayName":"Department","type":1,"required":true},{"key":"birthday","type":4,"pattern":"^19"}]`),
+					},
+				),
+			},
+			object: &UserAttributeSchema{
+				OrgID:      "org-id",
+				ChangeDate: testNow,
+				Sequence:   20211109,
+				Attributes: []*domain.UserAttribute{
+					{
+						Key:         "department",
+						DisplayName: "Department",
+						Type:        domain.UserAttributeTypeString,
+						Required:    true,
+					},
+					{
+						Key:     "birthday",
+						Type:    domain.UserAttributeTypeDate,
+						Pattern: "^19",
+					},
+				},
+			},
+		},
+		{
+			name:    "prepareUserAttributeSchemaQuery sql err",
+			prepare: prepareUserAttributeSchemaQuery,
+			want: want{
+				sqlExpectations: mockQueryErr(
+					regexp.QuoteMeta(prepareUserAttributeSchemaStmt),
+					sql.ErrConnDone,
+				),
+				err: func(err error) (error, bool) {
+					if !errors.Is(err, sql.ErrConnDone) {
+						return fmt.Errorf("err should be sql.ErrConnDone got: %w", err), false
+					}
+					return nil, true
+				},
+			},
+			object: (*UserAttributeSchema)(nil),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assertPrepare(t, tt.prepare, tt.object, tt.want.sqlExpectations, tt.want.err, defaultPrepareArgs...)
+		})
+	}
+}
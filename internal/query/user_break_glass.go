@@ -0,0 +1,140 @@
+package query
+
+import (
+	"context"
+	"net"
+	"time"
+
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/repository/user"
+	"github.com/zitadel/zitadel/internal/telemetry/tracing"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+// UserBreakGlass is the break-glass state of a user and the audit trail of its uses
+type UserBreakGlass struct {
+	UserID        string
+	ResourceOwner string
+	BreakGlass    bool
+	Description   string
+	// Usages are all recorded uses of the user as break-glass account, newest first.
+	// They are kept after the flag is removed.
+	Usages []*BreakGlassUsage
+}
+
+type BreakGlassUsage struct {
+	CreationDate  time.Time
+	Sequence      uint64
+	Method        domain.BreakGlassAuthMethod
+	AuthRequestID string
+	UserAgentID   string
+	UserAgent     string
+	RemoteIP      net.IP
+}
+
+// UserBreakGlass returns whether the user is a break-glass user and the recorded uses of it
+func (q *Queries) UserBreakGlass(ctx context.Context, userID, resourceOwner string) (_ *UserBreakGlass, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	if userID == "" {
+		return nil, zerrors.ThrowInvalidArgument(nil, "QUERY-Bg1nq", "Errors.User.UserIDMissing")
+	}
+	readModel := NewUserBreakGlassReadModel(userID, resourceOwner)
+	if err = q.eventstore.FilterToQueryReducer(ctx, readModel); err != nil {
+		return nil, err
+	}
+	return readModel.UserBreakGlass(), nil
+}
+
+// IsBreakGlassUser returns whether the user is currently flagged as break-glass user
+func (q *Queries) IsBreakGlassUser(ctx context.Context, userID string) (_ bool, err error) {
+	breakGlass, err := q.UserBreakGlass(ctx, userID, "")
+	if err != nil {
+		return false, err
+	}
+	return breakGlass.BreakGlass, nil
+}
+
+type UserBreakGlassReadModel struct {
+	*eventstore.ReadModel
+
+	BreakGlass  bool
+	Description string
+	Usages      []*BreakGlassUsage
+}
+
+func NewUserBreakGlassReadModel(userID, resourceOwner string) *UserBreakGlassReadModel {
+	return &UserBreakGlassReadModel{
+		ReadModel: &eventstore.ReadModel{
+			AggregateID:   userID,
+			ResourceOwner: resourceOwner,
+		},
+	}
+}
+
+func (rm *UserBreakGlassReadModel) Reduce() error {
+	for _, event := range rm.Events {
+		switch e := event.(type) {
+		case *user.UserBreakGlassSetEvent:
+			rm.BreakGlass = true
+			rm.Description = e.Description
+		case *user.UserBreakGlassRemovedEvent:
+			rm.BreakGlass = false
+			rm.Description = ""
+		case *user.UserBreakGlassUsedEvent:
+			usage := &BreakGlassUsage{
+				CreationDate: e.CreationDate(),
+				Sequence:     e.Sequence(),
+				Method:       e.Method,
+			}
+			if e.AuthRequestInfo != nil {
+				usage.AuthRequestID = e.AuthRequestInfo.ID
+				usage.UserAgentID = e.AuthRequestInfo.UserAgentID
+				if e.AuthRequestInfo.BrowserInfo != nil {
+					usage.UserAgent = e.AuthRequestInfo.UserAgent
+					usage.RemoteIP = e.AuthRequestInfo.RemoteIP
+				}
+			}
+			rm.Usages = append(rm.Usages, usage)
+		case *user.UserRemovedEvent:
+			rm.BreakGlass = false
+			rm.Description = ""
+		}
+	}
+	return rm.ReadModel.Reduce()
+}
+
+func (rm *UserBreakGlassReadModel) UserBreakGlass() *UserBreakGlass {
+	usages := make([]*BreakGlassUsage, 0, len(rm.Usages))
+	for i := len(rm.Usages) - 1; i >= 0; i-- {
+		usages = append(usages, rm.Usages[i])
+	}
+	return &UserBreakGlass{
+		UserID:        rm.AggregateID,
+		ResourceOwner: rm.ResourceOwner,
+		BreakGlass:    rm.BreakGlass,
+		Description:   rm.Description,
+		Usages:        usages,
+	}
+}
+
+func (rm *UserBreakGlassReadModel) Query() *eventstore.SearchQueryBuilder {
+	query := eventstore.NewSearchQueryBuilder(eventstore.ColumnsEvent).
+		AwaitOpenTransactions().
+		AddQuery().
+		AggregateTypes(user.AggregateType).
+		AggregateIDs(rm.AggregateID).
+		EventTypes(
+			user.UserBreakGlassSetType,
+			user.UserBreakGlassRemovedType,
+			user.UserBreakGlassUsedType,
+			user.UserRemovedType).
+		Builder()
+
+	if rm.ResourceOwner != "" {
+		query.ResourceOwner(rm.ResourceOwner)
+	}
+	return query
+}
@@ -0,0 +1,84 @@
+package query
+
+import (
+	"context"
+	"net"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/repository/user"
+)
+
+func TestUserBreakGlassReadModel_UserBreakGlass(t *testing.T) {
+	agg := &user.NewAggregate("user1", "org1").Aggregate
+	info := &user.AuthRequestInfo{
+		ID:          "authRequest1",
+		UserAgentID: "agent1",
+		BrowserInfo: &user.BrowserInfo{
+			UserAgent: "Firefox",
+			RemoteIP:  net.IPv4(127, 0, 0, 1),
+		},
+	}
+	tests := []struct {
+		name   string
+		events []eventstore.Event
+		want   *UserBreakGlass
+	}{
+		{
+			name: "no break-glass user",
+			want: &UserBreakGlass{
+				UserID:        "user1",
+				ResourceOwner: "org1",
+				Usages:        []*BreakGlassUsage{},
+			},
+		},
+		{
+			name: "usages kept after removal, newest first",
+			events: []eventstore.Event{
+				user.NewUserBreakGlassSetEvent(context.Background(), agg, "outage"),
+				user.NewUserBreakGlassUsedEvent(context.Background(), agg, domain.BreakGlassAuthMethodSecurityKey, info),
+				user.NewUserBreakGlassUsedEvent(context.Background(), agg, domain.BreakGlassAuthMethodClientSecret, nil),
+				user.NewUserBreakGlassRemovedEvent(context.Background(), agg),
+			},
+			want: &UserBreakGlass{
+				UserID:        "user1",
+				ResourceOwner: "org1",
+				Usages: []*BreakGlassUsage{
+					{Method: domain.BreakGlassAuthMethodClientSecret},
+					{
+						Method:        domain.BreakGlassAuthMethodSecurityKey,
+						AuthRequestID: "authRequest1",
+						UserAgentID:   "agent1",
+						UserAgent:     "Firefox",
+						RemoteIP:      net.IPv4(127, 0, 0, 1),
+					},
+				},
+			},
+		},
+		{
+			name: "break-glass user",
+			events: []eventstore.Event{
+				user.NewUserBreakGlassSetEvent(context.Background(), agg, "outage"),
+			},
+			want: &UserBreakGlass{
+				UserID:        "user1",
+				ResourceOwner: "org1",
+				BreakGlass:    true,
+				Description:   "outage",
+				Usages:        []*BreakGlassUsage{},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rm := NewUserBreakGlassReadModel("user1", "org1")
+			rm.AppendEvents(tt.events...)
+			require.NoError(t, rm.Reduce())
+			assert.Equal(t, tt.want, rm.UserBreakGlass())
+		})
+	}
+}
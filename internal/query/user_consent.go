@@ -0,0 +1,132 @@
+package query
+
+import (
+	"context"
+	"slices"
+	"time"
+
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/repository/user"
+	"github.com/zitadel/zitadel/internal/telemetry/tracing"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+type UserConsent struct {
+	AppID         string
+	CreationDate  time.Time
+	ChangeDate    time.Time
+	ResourceOwner string
+	Sequence      uint64
+	Scopes        []string
+}
+
+// Covers returns true if the user consented to all passed scopes.
+func (c *UserConsent) Covers(scopes []string) bool {
+	for _, scope := range scopes {
+		if !slices.Contains(c.Scopes, scope) {
+			return false
+		}
+	}
+	return true
+}
+
+// UserConsents returns the applications the user currently consents to, revoked consents are omitted.
+func (q *Queries) UserConsents(ctx context.Context, userID, resourceOwner string) (_ []*UserConsent, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	if userID == "" {
+		return nil, zerrors.ThrowInvalidArgument(nil, "QUERY-Cn4uq", "Errors.User.UserIDMissing")
+	}
+	readModel := NewHumanConsentsReadModel(userID, resourceOwner)
+	if err = q.eventstore.FilterToQueryReducer(ctx, readModel); err != nil {
+		return nil, err
+	}
+	return readModel.Consents, nil
+}
+
+// UserConsentByApp returns the consent of the user for the application.
+func (q *Queries) UserConsentByApp(ctx context.Context, userID, appID string) (_ *UserConsent, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	consents, err := q.UserConsents(ctx, userID, "")
+	if err != nil {
+		return nil, err
+	}
+	for _, consent := range consents {
+		if consent.AppID == appID {
+			return consent, nil
+		}
+	}
+	return nil, zerrors.ThrowNotFound(nil, "QUERY-Cn9nf", "Errors.User.Consent.NotFound")
+}
+
+type HumanConsentsReadModel struct {
+	*eventstore.ReadModel
+
+	Consents []*UserConsent
+}
+
+func NewHumanConsentsReadModel(userID, resourceOwner string) *HumanConsentsReadModel {
+	return &HumanConsentsReadModel{
+		ReadModel: &eventstore.ReadModel{
+			AggregateID:   userID,
+			ResourceOwner: resourceOwner,
+		},
+	}
+}
+
+func (rm *HumanConsentsReadModel) Reduce() error {
+	for _, event := range rm.Events {
+		switch e := event.(type) {
+		case *user.HumanConsentGrantedEvent:
+			consent := rm.consent(e.AppID)
+			if consent == nil {
+				consent = &UserConsent{
+					AppID:        e.AppID,
+					CreationDate: e.CreationDate(),
+				}
+				rm.Consents = append(rm.Consents, consent)
+			}
+			consent.ChangeDate = e.CreationDate()
+			consent.ResourceOwner = e.Aggregate().ResourceOwner
+			consent.Sequence = e.Sequence()
+			consent.Scopes = e.Scopes
+		case *user.HumanConsentRevokedEvent:
+			rm.Consents = slices.DeleteFunc(rm.Consents, func(consent *UserConsent) bool {
+				return consent.AppID == e.AppID
+			})
+		case *user.UserRemovedEvent:
+			rm.Consents = nil
+		}
+	}
+	return rm.ReadModel.Reduce()
+}
+
+func (rm *HumanConsentsReadModel) consent(appID string) *UserConsent {
+	for _, consent := range rm.Consents {
+		if consent.AppID == appID {
+			return consent
+		}
+	}
+	return nil
+}
+
+func (rm *HumanConsentsReadModel) Query() *eventstore.SearchQueryBuilder {
+	query := eventstore.NewSearchQueryBuilder(eventstore.ColumnsEvent).
+		AwaitOpenTransactions().
+		AddQuery().
+		AggregateTypes(user.AggregateType).
+		AggregateIDs(rm.AggregateID).
+		EventTypes(
+			user.HumanConsentGrantedType,
+			user.HumanConsentRevokedType,
+			user.UserRemovedType).
+		Builder()
+
+	if rm.ResourceOwner != "" {
+		query.ResourceOwner(rm.ResourceOwner)
+	}
+	return query
+}
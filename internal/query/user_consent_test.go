@@ -0,0 +1,59 @@
+package query
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/repository/user"
+)
+
+func TestHumanConsentsReadModel_Reduce(t *testing.T) {
+	agg := &user.NewAggregate("user1", "org1").Aggregate
+	tests := []struct {
+		name   string
+		events []eventstore.Event
+		want   []*UserConsent
+	}{
+		{
+			name: "no consents",
+		},
+		{
+			name: "latest scopes, revoked consents omitted",
+			events: []eventstore.Event{
+				user.NewHumanConsentGrantedEvent(context.Background(), agg, "app1", []string{"openid"}),
+				user.NewHumanConsentGrantedEvent(context.Background(), agg, "app2", []string{"openid"}),
+				user.NewHumanConsentGrantedEvent(context.Background(), agg, "app1", []string{"openid", "email"}),
+				user.NewHumanConsentRevokedEvent(context.Background(), agg, "app2"),
+			},
+			want: []*UserConsent{
+				{AppID: "app1", ResourceOwner: "org1", Scopes: []string{"openid", "email"}},
+			},
+		},
+		{
+			name: "user removed",
+			events: []eventstore.Event{
+				user.NewHumanConsentGrantedEvent(context.Background(), agg, "app1", []string{"openid"}),
+				user.NewUserRemovedEvent(context.Background(), agg, "username", nil, false),
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rm := NewHumanConsentsReadModel("user1", "org1")
+			rm.AppendEvents(tt.events...)
+			require.NoError(t, rm.Reduce())
+			assert.Equal(t, tt.want, rm.Consents)
+		})
+	}
+}
+
+func TestUserConsent_Covers(t *testing.T) {
+	consent := &UserConsent{Scopes: []string{"openid", "email"}}
+	assert.True(t, consent.Covers([]string{"email"}))
+	assert.True(t, consent.Covers(nil))
+	assert.False(t, consent.Covers([]string{"openid", "profile"}))
+}
@@ -0,0 +1,151 @@
+package query
+
+import (
+	"context"
+	"time"
+
+	"github.com/zitadel/zitadel/internal/crypto"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/repository/user"
+	"github.com/zitadel/zitadel/internal/telemetry/tracing"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+type DataExport struct {
+	ID            string
+	UserID        string
+	CreationDate  time.Time
+	ChangeDate    time.Time
+	ResourceOwner string
+	Sequence      uint64
+	State         domain.DataExportState
+	ObjectName    string
+	Size          int64
+	SigningKey    *crypto.CryptoValue
+	Expiration    time.Time
+}
+
+// Expired returns true if the archive of the export can't be downloaded anymore.
+func (e *DataExport) Expired(now time.Time) bool {
+	return e.State == domain.DataExportStateGenerated && !e.Expiration.After(now)
+}
+
+// HumanDataExports returns the data exports requested by the user, newest first.
+func (q *Queries) HumanDataExports(ctx context.Context, userID, resourceOwner string) (_ []*DataExport, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	if userID == "" {
+		return nil, zerrors.ThrowInvalidArgument(nil, "QUERY-Dx5kq2nv8m", "Errors.User.UserIDMissing")
+	}
+	readModel := NewHumanDataExportsReadModel(userID, resourceOwner)
+	if err = q.eventstore.FilterToQueryReducer(ctx, readModel); err != nil {
+		return nil, err
+	}
+	exports := make([]*DataExport, len(readModel.Exports))
+	for i, export := range readModel.Exports {
+		exports[len(exports)-1-i] = export
+	}
+	return exports, nil
+}
+
+// HumanDataExportByID returns the data export of the user.
+func (q *Queries) HumanDataExportByID(ctx context.Context, userID, resourceOwner, exportID string) (_ *DataExport, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	exports, err := q.HumanDataExports(ctx, userID, resourceOwner)
+	if err != nil {
+		return nil, err
+	}
+	for _, export := range exports {
+		if export.ID == exportID {
+			return export, nil
+		}
+	}
+	return nil, zerrors.ThrowNotFound(nil, "QUERY-Dx2mw9kv4q", "Errors.User.DataExport.NotFound")
+}
+
+type HumanDataExportsReadModel struct {
+	*eventstore.ReadModel
+
+	Exports []*DataExport
+}
+
+func NewHumanDataExportsReadModel(userID, resourceOwner string) *HumanDataExportsReadModel {
+	return &HumanDataExportsReadModel{
+		ReadModel: &eventstore.ReadModel{
+			AggregateID:   userID,
+			ResourceOwner: resourceOwner,
+		},
+	}
+}
+
+func (rm *HumanDataExportsReadModel) Reduce() error {
+	for _, event := range rm.Events {
+		switch e := event.(type) {
+		case *user.HumanDataExportRequestedEvent:
+			rm.Exports = append(rm.Exports, &DataExport{
+				ID:            e.ExportID,
+				UserID:        e.Aggregate().ID,
+				CreationDate:  e.CreationDate(),
+				ChangeDate:    e.CreationDate(),
+				ResourceOwner: e.Aggregate().ResourceOwner,
+				Sequence:      e.Sequence(),
+				State:         domain.DataExportStateRequested,
+			})
+		case *user.HumanDataExportGeneratedEvent:
+			export := rm.export(e.ExportID)
+			if export == nil {
+				continue
+			}
+			export.ChangeDate = e.CreationDate()
+			export.Sequence = e.Sequence()
+			export.State = domain.DataExportStateGenerated
+			export.ObjectName = e.ObjectName
+			export.Size = e.Size
+			export.SigningKey = e.SigningKey
+			export.Expiration = e.Expiration
+		case *user.HumanDataExportFailedEvent:
+			export := rm.export(e.ExportID)
+			if export == nil {
+				continue
+			}
+			export.ChangeDate = e.CreationDate()
+			export.Sequence = e.Sequence()
+			export.State = domain.DataExportStateFailed
+		case *user.UserRemovedEvent:
+			rm.Exports = nil
+		}
+	}
+	return rm.ReadModel.Reduce()
+}
+
+func (rm *HumanDataExportsReadModel) export(exportID string) *DataExport {
+	for _, export := range rm.Exports {
+		if export.ID == exportID {
+			return export
+		}
+	}
+	return nil
+}
+
+func (rm *HumanDataExportsReadModel) Query() *eventstore.SearchQueryBuilder {
+	query := eventstore.NewSearchQueryBuilder(eventstore.ColumnsEvent).
+		AwaitOpenTransactions().
+		AddQuery().
+		AggregateTypes(user.AggregateType).
+		AggregateIDs(rm.AggregateID).
+		EventTypes(
+			user.HumanDataExportRequestedType,
+			user.HumanDataExportGeneratedType,
+			user.HumanDataExportFailedType,
+			user.UserRemovedType).
+		Builder()
+
+	if rm.ResourceOwner != "" {
+		query.ResourceOwner(rm.ResourceOwner)
+	}
+	return query
+}
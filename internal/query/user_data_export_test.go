@@ -0,0 +1,76 @@
+package query
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/zitadel/zitadel/internal/crypto"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/repository/user"
+)
+
+func TestHumanDataExportsReadModel_Reduce(t *testing.T) {
+	expiration := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	signingKey := &crypto.CryptoValue{CryptoType: crypto.TypeEncryption, Algorithm: "enc", KeyID: "id", Crypted: []byte("key")}
+	agg := &user.NewAggregate("user1", "org1").Aggregate
+	tests := []struct {
+		name   string
+		events []eventstore.Event
+		want   []*DataExport
+	}{
+		{
+			name: "no exports",
+		},
+		{
+			name: "requested, generated and failed exports",
+			events: []eventstore.Event{
+				user.NewHumanDataExportRequestedEvent(context.Background(), agg, "export1"),
+				user.NewHumanDataExportGeneratedEvent(context.Background(), agg, "export1", "users/user1/data_exports/export1.zip", 42, signingKey, expiration),
+				user.NewHumanDataExportRequestedEvent(context.Background(), agg, "export2"),
+				user.NewHumanDataExportFailedEvent(context.Background(), agg, "export2"),
+				user.NewHumanDataExportRequestedEvent(context.Background(), agg, "export3"),
+			},
+			want: []*DataExport{
+				{
+					ID:            "export1",
+					UserID:        "user1",
+					ResourceOwner: "org1",
+					State:         domain.DataExportStateGenerated,
+					ObjectName:    "users/user1/data_exports/export1.zip",
+					Size:          42,
+					SigningKey:    signingKey,
+					Expiration:    expiration,
+				},
+				{ID: "export2", UserID: "user1", ResourceOwner: "org1", State: domain.DataExportStateFailed},
+				{ID: "export3", UserID: "user1", ResourceOwner: "org1", State: domain.DataExportStateRequested},
+			},
+		},
+		{
+			name: "user removed",
+			events: []eventstore.Event{
+				user.NewHumanDataExportRequestedEvent(context.Background(), agg, "export1"),
+				user.NewUserRemovedEvent(context.Background(), agg, "username", nil, false),
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rm := NewHumanDataExportsReadModel("user1", "org1")
+			rm.AppendEvents(tt.events...)
+			require.NoError(t, rm.Reduce())
+			assert.Equal(t, tt.want, rm.Exports)
+		})
+	}
+}
+
+func TestDataExport_Expired(t *testing.T) {
+	now := time.Now()
+	assert.False(t, (&DataExport{State: domain.DataExportStateRequested}).Expired(now))
+	assert.False(t, (&DataExport{State: domain.DataExportStateGenerated, Expiration: now.Add(time.Hour)}).Expired(now))
+	assert.True(t, (&DataExport{State: domain.DataExportStateGenerated, Expiration: now}).Expired(now))
+}
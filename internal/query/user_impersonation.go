@@ -0,0 +1,112 @@
+package query
+
+import (
+	"context"
+	"time"
+
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/repository/user"
+	"github.com/zitadel/zitadel/internal/telemetry/tracing"
+)
+
+// Impersonation is the audit record of an impersonated user.
+type Impersonation struct {
+	CreationDate   time.Time
+	Sequence       uint64
+	UserID         string
+	ResourceOwner  string
+	ClientID       string
+	ImpersonatorID string
+	Issuer         string
+	Reason         string
+}
+
+type Impersonations struct {
+	Impersonations []*Impersonation
+}
+
+type ImpersonationSearchQueries struct {
+	UserID         string
+	ImpersonatorID string
+	CreationDate   time.Time
+	Limit          uint64
+}
+
+// SearchImpersonations returns the impersonations of the users of the organization, latest first.
+// Impersonations through token exchange and by support engineers are returned.
+func (q *Queries) SearchImpersonations(ctx context.Context, resourceOwner string, queries *ImpersonationSearchQueries) (_ *Impersonations, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	model := NewImpersonationsReadModel(resourceOwner, queries)
+	if err = q.eventstore.FilterToQueryReducer(ctx, model); err != nil {
+		return nil, err
+	}
+	return &Impersonations{
+		Impersonations: model.Impersonations,
+	}, nil
+}
+
+type ImpersonationsReadModel struct {
+	eventstore.ReadModel
+
+	queries        *ImpersonationSearchQueries
+	Impersonations []*Impersonation
+}
+
+func NewImpersonationsReadModel(resourceOwner string, queries *ImpersonationSearchQueries) *ImpersonationsReadModel {
+	if queries == nil {
+		queries = new(ImpersonationSearchQueries)
+	}
+	return &ImpersonationsReadModel{
+		ReadModel: eventstore.ReadModel{
+			ResourceOwner: resourceOwner,
+		},
+		queries: queries,
+	}
+}
+
+func (rm *ImpersonationsReadModel) Reduce() error {
+	for _, event := range rm.Events {
+		e, ok := event.(*user.UserImpersonatedEvent)
+		if !ok {
+			continue
+		}
+		impersonation := &Impersonation{
+			CreationDate:  e.CreatedAt(),
+			Sequence:      e.Sequence(),
+			UserID:        e.Aggregate().ID,
+			ResourceOwner: e.Aggregate().ResourceOwner,
+			ClientID:      e.ApplicationID,
+		}
+		if e.Actor != nil {
+			impersonation.ImpersonatorID = e.Actor.UserID
+			impersonation.Issuer = e.Actor.Issuer
+			impersonation.Reason = e.Actor.Reason
+		}
+		rm.Impersonations = append(rm.Impersonations, impersonation)
+	}
+	return rm.ReadModel.Reduce()
+}
+
+func (rm *ImpersonationsReadModel) Query() *eventstore.SearchQueryBuilder {
+	builder := eventstore.NewSearchQueryBuilder(eventstore.ColumnsEvent).
+		ResourceOwner(rm.ResourceOwner).
+		OrderDesc().
+		Limit(rm.queries.Limit)
+	if !rm.queries.CreationDate.IsZero() {
+		builder = builder.CreationDateAfter(rm.queries.CreationDate)
+	}
+	query := builder.AddQuery().
+		AggregateTypes(user.AggregateType).
+		EventTypes(user.UserImpersonatedType)
+	if rm.queries.UserID != "" {
+		query = query.AggregateIDs(rm.queries.UserID)
+	}
+	if rm.queries.ImpersonatorID != "" {
+		query = query.EventData(map[string]interface{}{
+			"actor": map[string]interface{}{"user_id": rm.queries.ImpersonatorID},
+		})
+	}
+	return query.Builder()
+}
@@ -0,0 +1,40 @@
+package query
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/repository/user"
+)
+
+func TestImpersonationsReadModel_Reduce(t *testing.T) {
+	rm := NewImpersonationsReadModel("org1", nil)
+	rm.AppendEvents(
+		user.NewUserImpersonatedEvent(context.Background(), &user.NewAggregate("user1", "org1").Aggregate, "client1", &domain.TokenActor{
+			UserID: "support1",
+			Issuer: "https://zitadel.cloud",
+			Reason: "ticket 123",
+		}),
+		user.NewUserImpersonatedEvent(context.Background(), &user.NewAggregate("user2", "org1").Aggregate, "client2", nil),
+	)
+	require.NoError(t, rm.Reduce())
+
+	require.Len(t, rm.Impersonations, 2)
+	assert.Equal(t, &Impersonation{
+		UserID:         "user1",
+		ResourceOwner:  "org1",
+		ClientID:       "client1",
+		ImpersonatorID: "support1",
+		Issuer:         "https://zitadel.cloud",
+		Reason:         "ticket 123",
+	}, rm.Impersonations[0])
+	assert.Equal(t, &Impersonation{
+		UserID:        "user2",
+		ResourceOwner: "org1",
+		ClientID:      "client2",
+	}, rm.Impersonations[1])
+}
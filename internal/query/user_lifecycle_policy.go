@@ -0,0 +1,88 @@
+package query
+
+import (
+	"context"
+	"time"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/repository/org"
+	"github.com/zitadel/zitadel/internal/telemetry/tracing"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+type UserLifecyclePolicy struct {
+	OrgID      string
+	ChangeDate time.Time
+	Sequence   uint64
+
+	DeactivateAfter time.Duration
+	DeleteAfter     time.Duration
+	NotifyBefore    time.Duration
+}
+
+// UserLifecyclePolicyByOrg returns the user lifecycle policy of the organization.
+// Organizations without a policy never deactivate or delete inactive users, so no default policy exists.
+func (q *Queries) UserLifecyclePolicyByOrg(ctx context.Context, orgID string) (_ *UserLifecyclePolicy, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	model := newUserLifecyclePolicyReadModel(authz.GetInstance(ctx).InstanceID(), orgID)
+	if err = q.eventstore.FilterToQueryReducer(ctx, model); err != nil {
+		return nil, err
+	}
+	if model.policy == nil {
+		return nil, zerrors.ThrowNotFound(nil, "QUERY-Ulp1n", "Errors.Org.UserLifecyclePolicy.NotFound")
+	}
+	return model.policy, nil
+}
+
+type userLifecyclePolicyReadModel struct {
+	eventstore.ReadModel
+
+	policy *UserLifecyclePolicy
+}
+
+func newUserLifecyclePolicyReadModel(instanceID, orgID string) *userLifecyclePolicyReadModel {
+	return &userLifecyclePolicyReadModel{
+		ReadModel: eventstore.ReadModel{
+			AggregateID:   orgID,
+			ResourceOwner: orgID,
+			InstanceID:    instanceID,
+		},
+	}
+}
+
+func (rm *userLifecyclePolicyReadModel) Reduce() error {
+	for _, event := range rm.Events {
+		switch e := event.(type) {
+		case *org.UserLifecyclePolicySetEvent:
+			rm.policy = &UserLifecyclePolicy{
+				OrgID:           e.Aggregate().ID,
+				ChangeDate:      e.CreatedAt(),
+				Sequence:        e.Sequence(),
+				DeactivateAfter: e.DeactivateAfter,
+				DeleteAfter:     e.DeleteAfter,
+				NotifyBefore:    e.NotifyBefore,
+			}
+		case *org.UserLifecyclePolicyRemovedEvent, *org.OrgRemovedEvent:
+			rm.policy = nil
+		}
+	}
+	return rm.ReadModel.Reduce()
+}
+
+func (rm *userLifecyclePolicyReadModel) Query() *eventstore.SearchQueryBuilder {
+	return eventstore.NewSearchQueryBuilder(eventstore.ColumnsEvent).
+		AwaitOpenTransactions().
+		InstanceID(rm.InstanceID).
+		AddQuery().
+		AggregateTypes(org.AggregateType).
+		AggregateIDs(rm.AggregateID).
+		EventTypes(
+			org.UserLifecyclePolicySetEventType,
+			org.UserLifecyclePolicyRemovedEventType,
+			org.OrgRemovedEventType,
+		).
+		Builder()
+}
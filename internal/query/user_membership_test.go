@@ -27,7 +27,7 @@ var (
 			", members.project_id" +
 			", members.grant_id" +
 			", projections.project_grants4.granted_org_id" +
-			", projections.projects4.name" +
+			", projections.projects5.name" +
 			", projections.orgs1.name" +
 			", projections.instances.name" +
 			", COUNT(*) OVER ()" +
@@ -84,7 +84,7 @@ var (
 			", members.grant_id" +
 			" FROM projections.project_grant_members4 AS members" +
 			") AS members" +
-			" LEFT JOIN projections.projects4 ON members.project_id = projections.projects4.id AND members.instance_id = projections.projects4.instance_id" +
+			" LEFT JOIN projections.projects5 ON members.project_id = projections.projects5.id AND members.instance_id = projections.projects5.instance_id" +
 			" LEFT JOIN projections.orgs1 ON members.org_id = projections.orgs1.id AND members.instance_id = projections.orgs1.instance_id" +
 			" LEFT JOIN projections.project_grants4 ON members.grant_id = projections.project_grants4.grant_id AND members.instance_id = projections.project_grants4.instance_id" +
 			" LEFT JOIN projections.instances ON members.instance_id = projections.instances.id" +
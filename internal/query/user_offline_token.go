@@ -0,0 +1,95 @@
+package query
+
+import (
+	"context"
+	"slices"
+	"time"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/repository/user"
+	"github.com/zitadel/zitadel/internal/telemetry/tracing"
+)
+
+type OfflineToken struct {
+	ID            string
+	CreationDate  time.Time
+	ResourceOwner string
+	Sequence      uint64
+	UserID        string
+	// ClientID is the client id of the application the token was issued to
+	ClientID    string
+	Expiration  time.Time
+	Description string
+}
+
+// OfflineTokensByUserID returns the active offline tokens of the user, ordered by their creation.
+// Revoked and expired tokens are omitted.
+func (q *Queries) OfflineTokensByUserID(ctx context.Context, userID string) (_ []*OfflineToken, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	model := newOfflineTokensReadModel(authz.GetInstance(ctx).InstanceID(), userID)
+	if err = q.eventstore.FilterToQueryReducer(ctx, model); err != nil {
+		return nil, err
+	}
+	now := time.Now()
+	return slices.DeleteFunc(model.tokens, func(token *OfflineToken) bool {
+		return !token.Expiration.After(now)
+	}), nil
+}
+
+type offlineTokensReadModel struct {
+	eventstore.ReadModel
+
+	tokens []*OfflineToken
+}
+
+func newOfflineTokensReadModel(instanceID, userID string) *offlineTokensReadModel {
+	return &offlineTokensReadModel{
+		ReadModel: eventstore.ReadModel{
+			AggregateID: userID,
+			InstanceID:  instanceID,
+		},
+	}
+}
+
+func (rm *offlineTokensReadModel) Reduce() error {
+	for _, event := range rm.Events {
+		switch e := event.(type) {
+		case *user.OfflineTokenAddedEvent:
+			rm.tokens = append(rm.tokens, &OfflineToken{
+				ID:            e.TokenID,
+				CreationDate:  e.CreatedAt(),
+				ResourceOwner: e.Aggregate().ResourceOwner,
+				Sequence:      e.Sequence(),
+				UserID:        e.Aggregate().ID,
+				ClientID:      e.ApplicationID,
+				Expiration:    e.Expiration,
+				Description:   e.Description,
+			})
+		case *user.OfflineTokenRevokedEvent:
+			rm.tokens = slices.DeleteFunc(rm.tokens, func(token *OfflineToken) bool {
+				return token.ID == e.TokenID
+			})
+		case *user.UserRemovedEvent:
+			rm.tokens = nil
+		}
+	}
+	return rm.ReadModel.Reduce()
+}
+
+func (rm *offlineTokensReadModel) Query() *eventstore.SearchQueryBuilder {
+	return eventstore.NewSearchQueryBuilder(eventstore.ColumnsEvent).
+		AwaitOpenTransactions().
+		InstanceID(rm.InstanceID).
+		AddQuery().
+		AggregateTypes(user.AggregateType).
+		AggregateIDs(rm.AggregateID).
+		EventTypes(
+			user.OfflineTokenAddedType,
+			user.OfflineTokenRevokedType,
+			user.UserRemovedType,
+		).
+		Builder()
+}
@@ -0,0 +1,105 @@
+package query
+
+import (
+	"context"
+	"time"
+
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/repository/user"
+	"github.com/zitadel/zitadel/internal/telemetry/tracing"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+type PushDevice struct {
+	ID            string
+	CreationDate  time.Time
+	ResourceOwner string
+	Sequence      uint64
+	Platform      domain.PushPlatform
+	Token         string
+	Name          string
+}
+
+// PushDevices returns the devices the user registered for push notifications, newest first.
+func (q *Queries) PushDevices(ctx context.Context, userID, resourceOwner string) (_ []*PushDevice, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	if userID == "" {
+		return nil, zerrors.ThrowInvalidArgument(nil, "QUERY-Pd5nq", "Errors.User.UserIDMissing")
+	}
+	readModel := NewHumanPushDevicesReadModel(userID, resourceOwner)
+	if err = q.eventstore.FilterToQueryReducer(ctx, readModel); err != nil {
+		return nil, err
+	}
+	devices := make([]*PushDevice, 0, len(readModel.Devices))
+	for i := len(readModel.Devices) - 1; i >= 0; i-- {
+		devices = append(devices, readModel.Devices[i])
+	}
+	return devices, nil
+}
+
+type HumanPushDevicesReadModel struct {
+	*eventstore.ReadModel
+
+	Devices []*PushDevice
+}
+
+func NewHumanPushDevicesReadModel(userID, resourceOwner string) *HumanPushDevicesReadModel {
+	return &HumanPushDevicesReadModel{
+		ReadModel: &eventstore.ReadModel{
+			AggregateID:   userID,
+			ResourceOwner: resourceOwner,
+		},
+	}
+}
+
+func (rm *HumanPushDevicesReadModel) Reduce() error {
+	for _, event := range rm.Events {
+		switch e := event.(type) {
+		case *user.HumanPushDeviceAddedEvent:
+			rm.Devices = append(rm.Devices, &PushDevice{
+				ID:            e.DeviceID,
+				CreationDate:  e.CreationDate(),
+				ResourceOwner: e.Aggregate().ResourceOwner,
+				Sequence:      e.Sequence(),
+				Platform:      e.Platform,
+				Token:         e.Token,
+				Name:          e.Name,
+			})
+		case *user.HumanPushDeviceRemovedEvent:
+			rm.removeDevice(e.DeviceID)
+		case *user.UserRemovedEvent:
+			rm.Devices = nil
+		}
+	}
+	return rm.ReadModel.Reduce()
+}
+
+func (rm *HumanPushDevicesReadModel) removeDevice(deviceID string) {
+	for i, device := range rm.Devices {
+		if device.ID == deviceID {
+			rm.Devices = append(rm.Devices[:i], rm.Devices[i+1:]...)
+			return
+		}
+	}
+}
+
+func (rm *HumanPushDevicesReadModel) Query() *eventstore.SearchQueryBuilder {
+	query := eventstore.NewSearchQueryBuilder(eventstore.ColumnsEvent).
+		AwaitOpenTransactions().
+		AddQuery().
+		AggregateTypes(user.AggregateType).
+		AggregateIDs(rm.AggregateID).
+		EventTypes(
+			user.HumanPushDeviceAddedType,
+			user.HumanPushDeviceRemovedType,
+			user.UserRemovedType).
+		Builder()
+
+	if rm.ResourceOwner != "" {
+		query.ResourceOwner(rm.ResourceOwner)
+	}
+	return query
+}
@@ -9,6 +9,7 @@ import (
 	"regexp"
 	"testing"
 
+	sq "github.com/Masterminds/squirrel"
 	"github.com/stretchr/testify/require"
 	"golang.org/x/text/language"
 
@@ -1434,3 +1435,81 @@ func Test_UserPrepares(t *testing.T) {
 		})
 	}
 }
+
+func TestNewUserMetadataJSONSearchQuery(t *testing.T) {
+	tests := []struct {
+		name     string
+		key      string
+		path     string
+		contains []byte
+		wantStmt string
+		wantArgs []interface{}
+		wantErr  error
+	}{
+		{
+			name:     "exists",
+			key:      "profile",
+			wantStmt: "SELECT projections.users13.id FROM projections.users13 WHERE projections.users13.id IN ( SELECT projections.user_metadata5.user_id FROM projections.user_metadata5 WHERE projections.user_metadata5.instance_id = projections.users13.instance_id AND projections.user_metadata5.user_id = projections.users13.id AND projections.user_metadata5.key = ? AND CONVERT_FROM(projections.user_metadata5.value, 'UTF8')::JSONB IS NOT NULL )",
+			wantArgs: []interface{}{"profile"},
+		},
+		{
+			name:     "path exists",
+			key:      "profile",
+			path:     "$.address.lines[0]",
+			wantStmt: "SELECT projections.users13.id FROM projections.users13 WHERE projections.users13.id IN ( SELECT projections.user_metadata5.user_id FROM projections.user_metadata5 WHERE projections.user_metadata5.instance_id = projections.users13.instance_id AND projections.user_metadata5.user_id = projections.users13.id AND projections.user_metadata5.key = ? AND (CONVERT_FROM(projections.user_metadata5.value, 'UTF8')::JSONB #> ?::TEXT[]) IS NOT NULL )",
+			wantArgs: []interface{}{"profile", []string{"address", "lines", "0"}},
+		},
+		{
+			name:     "path contains",
+			key:      "profile",
+			path:     "address.city",
+			contains: []byte(`"Zurich"`),
+			wantStmt: "SELECT projections.users13.id FROM projections.users13 WHERE projections.users13.id IN ( SELECT projections.user_metadata5.user_id FROM projections.user_metadata5 WHERE projections.user_metadata5.instance_id = projections.users13.instance_id AND projections.user_metadata5.user_id = projections.users13.id AND projections.user_metadata5.key = ? AND (CONVERT_FROM(projections.user_metadata5.value, 'UTF8')::JSONB #> ?::TEXT[]) @> ?::JSONB )",
+			wantArgs: []interface{}{"profile", []string{"address", "city"}, `"Zurich"`},
+		},
+		{
+			name:     "root array",
+			key:      "roles",
+			path:     "$[1]",
+			wantStmt: "SELECT projections.users13.id FROM projections.users13 WHERE projections.users13.id IN ( SELECT projections.user_metadata5.user_id FROM projections.user_metadata5 WHERE projections.user_metadata5.instance_id = projections.users13.instance_id AND projections.user_metadata5.user_id = projections.users13.id AND projections.user_metadata5.key = ? AND (CONVERT_FROM(projections.user_metadata5.value, 'UTF8')::JSONB #> ?::TEXT[]) IS NOT NULL )",
+			wantArgs: []interface{}{"roles", []string{"1"}},
+		},
+		{
+			name:     "invalid json, error",
+			key:      "profile",
+			contains: []byte(`{"city":`),
+			wantErr:  zerrors.ThrowInvalidArgument(nil, "QUERY-Js0nv", "Errors.Query.InvalidRequest"),
+		},
+		{
+			name:    "empty member, error",
+			key:     "profile",
+			path:    "$.address..city",
+			wantErr: zerrors.ThrowInvalidArgument(nil, "QUERY-Js0np", "Errors.Query.InvalidRequest"),
+		},
+		{
+			name:    "invalid index, error",
+			key:     "profile",
+			path:    "$.lines[first]",
+			wantErr: zerrors.ThrowInvalidArgument(nil, "QUERY-Js0ni", "Errors.Query.InvalidRequest"),
+		},
+		{
+			name:    "text after index, error",
+			key:     "profile",
+			path:    "$.lines[0]city",
+			wantErr: zerrors.ThrowInvalidArgument(nil, "QUERY-Js0ns", "Errors.Query.InvalidRequest"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			query, err := NewUserMetadataJSONSearchQuery(tt.key, tt.path, tt.contains)
+			require.ErrorIs(t, err, tt.wantErr)
+			if tt.wantErr != nil {
+				return
+			}
+			stmt, args, err := query.toQuery(sq.Select(UserIDCol.identifier()).From(userTable.identifier())).ToSql()
+			require.NoError(t, err)
+			require.Equal(t, tt.wantStmt, stmt)
+			require.Equal(t, tt.wantArgs, args)
+		})
+	}
+}
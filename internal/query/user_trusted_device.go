@@ -0,0 +1,128 @@
+package query
+
+import (
+	"context"
+	"time"
+
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/repository/user"
+	"github.com/zitadel/zitadel/internal/telemetry/tracing"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+type TrustedDevice struct {
+	ID            string
+	CreationDate  time.Time
+	ResourceOwner string
+	Sequence      uint64
+	UserAgentID   string
+	Description   string
+	Expiration    time.Time
+}
+
+// TrustedDevices returns the devices the user currently trusts, expired and removed devices are omitted.
+func (q *Queries) TrustedDevices(ctx context.Context, userID, resourceOwner string) (_ []*TrustedDevice, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	if userID == "" {
+		return nil, zerrors.ThrowInvalidArgument(nil, "QUERY-Td4nq", "Errors.User.UserIDMissing")
+	}
+	readModel := NewHumanTrustedDevicesReadModel(userID, resourceOwner)
+	if err = q.eventstore.FilterToQueryReducer(ctx, readModel); err != nil {
+		return nil, err
+	}
+	return readModel.ActiveDevices(time.Now()), nil
+}
+
+// TrustedDeviceByUserAgent returns the device of the user agent if the user currently trusts it.
+func (q *Queries) TrustedDeviceByUserAgent(ctx context.Context, userID, userAgentID string) (_ *TrustedDevice, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	devices, err := q.TrustedDevices(ctx, userID, "")
+	if err != nil {
+		return nil, err
+	}
+	for _, device := range devices {
+		if device.UserAgentID == userAgentID {
+			return device, nil
+		}
+	}
+	return nil, zerrors.ThrowNotFound(nil, "QUERY-Td9vk", "Errors.User.TrustedDevice.NotFound")
+}
+
+type HumanTrustedDevicesReadModel struct {
+	*eventstore.ReadModel
+
+	Devices []*TrustedDevice
+}
+
+func NewHumanTrustedDevicesReadModel(userID, resourceOwner string) *HumanTrustedDevicesReadModel {
+	return &HumanTrustedDevicesReadModel{
+		ReadModel: &eventstore.ReadModel{
+			AggregateID:   userID,
+			ResourceOwner: resourceOwner,
+		},
+	}
+}
+
+func (rm *HumanTrustedDevicesReadModel) Reduce() error {
+	for _, event := range rm.Events {
+		switch e := event.(type) {
+		case *user.HumanTrustedDeviceAddedEvent:
+			rm.Devices = append(rm.Devices, &TrustedDevice{
+				ID:            e.DeviceID,
+				CreationDate:  e.CreationDate(),
+				ResourceOwner: e.Aggregate().ResourceOwner,
+				Sequence:      e.Sequence(),
+				UserAgentID:   e.UserAgentID,
+				Description:   e.Description,
+				Expiration:    e.Expiration,
+			})
+		case *user.HumanTrustedDeviceRemovedEvent:
+			rm.removeDevice(e.DeviceID)
+		case *user.UserRemovedEvent:
+			rm.Devices = nil
+		}
+	}
+	return rm.ReadModel.Reduce()
+}
+
+func (rm *HumanTrustedDevicesReadModel) removeDevice(deviceID string) {
+	for i, device := range rm.Devices {
+		if device.ID == deviceID {
+			rm.Devices = append(rm.Devices[:i], rm.Devices[i+1:]...)
+			return
+		}
+	}
+}
+
+// ActiveDevices returns the devices which are not expired at the passed time, newest first.
+func (rm *HumanTrustedDevicesReadModel) ActiveDevices(now time.Time) []*TrustedDevice {
+	devices := make([]*TrustedDevice, 0, len(rm.Devices))
+	for i := len(rm.Devices) - 1; i >= 0; i-- {
+		if rm.Devices[i].Expiration.After(now) {
+			devices = append(devices, rm.Devices[i])
+		}
+	}
+	return devices
+}
+
+func (rm *HumanTrustedDevicesReadModel) Query() *eventstore.SearchQueryBuilder {
+	query := eventstore.NewSearchQueryBuilder(eventstore.ColumnsEvent).
+		AwaitOpenTransactions().
+		AddQuery().
+		AggregateTypes(user.AggregateType).
+		AggregateIDs(rm.AggregateID).
+		EventTypes(
+			user.HumanTrustedDeviceAddedType,
+			user.HumanTrustedDeviceRemovedType,
+			user.UserRemovedType).
+		Builder()
+
+	if rm.ResourceOwner != "" {
+		query.ResourceOwner(rm.ResourceOwner)
+	}
+	return query
+}
@@ -0,0 +1,58 @@
+package query
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/repository/user"
+)
+
+func TestHumanTrustedDevicesReadModel_ActiveDevices(t *testing.T) {
+	now := time.Now()
+	agg := &user.NewAggregate("user1", "org1").Aggregate
+	tests := []struct {
+		name   string
+		events []eventstore.Event
+		want   []*TrustedDevice
+	}{
+		{
+			name: "no devices",
+			want: []*TrustedDevice{},
+		},
+		{
+			name: "expired and removed devices omitted, newest first",
+			events: []eventstore.Event{
+				user.NewHumanTrustedDeviceAddedEvent(context.Background(), agg, "device1", "agent1", "first", now.Add(time.Hour)),
+				user.NewHumanTrustedDeviceAddedEvent(context.Background(), agg, "device2", "agent2", "expired", now.Add(-time.Hour)),
+				user.NewHumanTrustedDeviceAddedEvent(context.Background(), agg, "device3", "agent3", "removed", now.Add(time.Hour)),
+				user.NewHumanTrustedDeviceRemovedEvent(context.Background(), agg, "device3"),
+				user.NewHumanTrustedDeviceAddedEvent(context.Background(), agg, "device4", "agent1", "second", now.Add(2*time.Hour)),
+			},
+			want: []*TrustedDevice{
+				{ID: "device4", ResourceOwner: "org1", UserAgentID: "agent1", Description: "second", Expiration: now.Add(2 * time.Hour)},
+				{ID: "device1", ResourceOwner: "org1", UserAgentID: "agent1", Description: "first", Expiration: now.Add(time.Hour)},
+			},
+		},
+		{
+			name: "user removed",
+			events: []eventstore.Event{
+				user.NewHumanTrustedDeviceAddedEvent(context.Background(), agg, "device1", "agent1", "", now.Add(time.Hour)),
+				user.NewUserRemovedEvent(context.Background(), agg, "username", nil, false),
+			},
+			want: []*TrustedDevice{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rm := NewHumanTrustedDevicesReadModel("user1", "org1")
+			rm.AppendEvents(tt.events...)
+			require.NoError(t, rm.Reduce())
+			assert.Equal(t, tt.want, rm.ActiveDevices(now))
+		})
+	}
+}
@@ -11,6 +11,7 @@ import (
 
 	"github.com/zitadel/zitadel/internal/api/authz"
 	"github.com/zitadel/zitadel/internal/database"
+	"github.com/zitadel/zitadel/internal/domain"
 	"github.com/zitadel/zitadel/internal/eventstore/handler/v2"
 	"github.com/zitadel/zitadel/internal/query/projection"
 	"github.com/zitadel/zitadel/internal/telemetry/tracing"
@@ -26,6 +27,7 @@ var oidcUserInfoTriggerHandlers = sync.OnceValue(func() []*handler.Handler {
 		projection.UserProjection,
 		projection.UserMetadataProjection,
 		projection.UserGrantProjection,
+		projection.GroupProjection,
 		projection.OrgProjection,
 		projection.ProjectProjection,
 	}
@@ -103,21 +105,34 @@ type UserInfoOrg struct {
 //go:embed userinfo_client_by_id.sql
 var oidcUserinfoClientQuery string
 
-func (q *Queries) GetOIDCUserinfoClientByID(ctx context.Context, clientID string) (projectID string, projectRoleAssertion bool, err error) {
+// OIDCUserinfoClient is the configuration of the client which applies to the userinfo of its access tokens.
+type OIDCUserinfoClient struct {
+	ProjectID            string
+	ProjectRoleAssertion bool
+	ClaimsMapping        *domain.ClaimsMapping
+}
+
+func (q *Queries) GetOIDCUserinfoClientByID(ctx context.Context, clientID string) (client *OIDCUserinfoClient, err error) {
 	ctx, span := tracing.NewSpan(ctx)
 	defer func() { span.EndWithError(err) }()
 
+	var claimsMapping []byte
+	client = new(OIDCUserinfoClient)
 	scan := func(row *sql.Row) error {
-		err := row.Scan(&projectID, &projectRoleAssertion)
+		err := row.Scan(&client.ProjectID, &client.ProjectRoleAssertion, &claimsMapping)
 		return err
 	}
 
 	err = q.client.QueryRowContext(ctx, scan, oidcUserinfoClientQuery, authz.GetInstance(ctx).InstanceID(), clientID)
 	if errors.Is(err, sql.ErrNoRows) {
-		return "", false, zerrors.ThrowNotFound(err, "QUERY-beeW8", "Errors.App.NotFound")
+		return nil, zerrors.ThrowNotFound(err, "QUERY-beeW8", "Errors.App.NotFound")
+	}
+	if err != nil {
+		return nil, zerrors.ThrowInternal(err, "QUERY-Ais4r", "Errors.Internal")
 	}
+	client.ClaimsMapping, err = claimsMappingFromJSON(claimsMapping)
 	if err != nil {
-		return "", false, zerrors.ThrowInternal(err, "QUERY-Ais4r", "Errors.Internal")
+		return nil, zerrors.ThrowInternal(err, "QUERY-Uc3ma", "Errors.Internal")
 	}
-	return projectID, projectRoleAssertion, nil
+	return client, nil
 }
@@ -31,7 +31,20 @@ metadata as (
 		and instance_id = $2
 	) r
 ),
--- get all user grants, needed for the orgs query
+-- find the groups of the user including all parent groups, members inherit the grants of the parents
+user_groups as (
+	select g.id, g.parent_id
+	from projections.groups1_members m
+	join projections.groups1 g on g.id = m.group_id and g.instance_id = m.instance_id
+	where m.user_id = $1
+	and m.instance_id = $2
+	union
+	select g.id, g.parent_id
+	from projections.groups1 g
+	join user_groups ug on g.id = ug.parent_id
+	where g.instance_id = $2
+),
+-- get all user grants including the grants of the user's groups, needed for the orgs query
 user_grants as (
 	select id, grant_id, state, creation_date, change_date, sequence, user_id, roles, resource_owner, project_id
 	from projections.user_grants5
@@ -41,6 +54,15 @@ user_grants as (
 	{{ if . -}}
 	and resource_owner = any($4)
 	{{- end }}
+	union all
+	select group_id, project_grant_id, 1, creation_date, change_date, sequence, $1, roles, resource_owner, project_id
+	from projections.groups1_grants
+	where group_id in (select id from user_groups)
+	and instance_id = $2
+	and project_id = any($3)
+	{{ if . -}}
+	and resource_owner = any($4)
+	{{- end }}
 ),
 -- filter all orgs we are interested in.
 orgs as (
@@ -69,7 +91,7 @@ grants as (
 			p.name as project_name, u.resource_owner as user_resource_owner
 		from user_grants g
 		left join orgs o on o.id = g.resource_owner
-		left join projections.projects4 p on p.id = g.project_id
+		left join projections.projects5 p on p.id = g.project_id
 		left join usr u on u.id = g.user_id
 		where p.instance_id = $2
 	) r
@@ -445,14 +445,13 @@ func TestQueries_GetOIDCUserInfo(t *testing.T) {
 
 func TestQueries_GetOIDCUserinfoClientByID(t *testing.T) {
 	expQuery := regexp.QuoteMeta(oidcUserinfoClientQuery)
-	cols := []string{"project_id", "project_role_assertion"}
+	cols := []string{"project_id", "project_role_assertion", "claims_mapping"}
 
 	tests := []struct {
-		name                     string
-		mock                     sqlExpectation
-		wantProjectID            string
-		wantProjectRoleAssertion bool
-		wantErr                  error
+		name    string
+		mock    sqlExpectation
+		want    *OIDCUserinfoClient
+		wantErr error
 	}{
 		{
 			name:    "no rows",
@@ -465,10 +464,23 @@ func TestQueries_GetOIDCUserinfoClientByID(t *testing.T) {
 			wantErr: zerrors.ThrowInternal(sql.ErrConnDone, "QUERY-Ais4r", "Errors.Internal"),
 		},
 		{
-			name:                     "found",
-			mock:                     mockQuery(expQuery, cols, []driver.Value{"projectID", true}, "instanceID", "clientID"),
-			wantProjectID:            "projectID",
-			wantProjectRoleAssertion: true,
+			name: "found",
+			mock: mockQuery(expQuery, cols, []driver.Value{"projectID", true, nil}, "instanceID", "clientID"),
+			want: &OIDCUserinfoClient{
+				ProjectID:            "projectID",
+				ProjectRoleAssertion: true,
+			},
+		},
+		{
+			name: "found with claims mapping",
+			mock: mockQuery(expQuery, cols, []driver.Value{"projectID", false, []byte(`{"metadataNamespaces":["billing"],"rolesFormat":1}`)}, "instanceID", "clientID"),
+			want: &OIDCUserinfoClient{
+				ProjectID: "projectID",
+				ClaimsMapping: &domain.ClaimsMapping{
+					MetadataNamespaces: []string{"billing"},
+					RolesFormat:        domain.ClaimsRolesFormatList,
+				},
+			},
 		},
 	}
 	for _, tt := range tests {
@@ -481,10 +493,9 @@ func TestQueries_GetOIDCUserinfoClientByID(t *testing.T) {
 					},
 				}
 				ctx := authz.NewMockContext("instanceID", "orgID", "loginClient")
-				gotProjectID, gotProjectRoleAssertion, err := q.GetOIDCUserinfoClientByID(ctx, "clientID")
+				got, err := q.GetOIDCUserinfoClientByID(ctx, "clientID")
 				require.ErrorIs(t, err, tt.wantErr)
-				assert.Equal(t, tt.wantProjectID, gotProjectID)
-				assert.Equal(t, tt.wantProjectRoleAssertion, gotProjectRoleAssertion)
+				assert.Equal(t, tt.want, got)
 			})
 		})
 	}
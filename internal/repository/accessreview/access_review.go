@@ -0,0 +1,182 @@
+package accessreview
+
+import (
+	"context"
+	"time"
+
+	"github.com/zitadel/zitadel/internal/eventstore"
+)
+
+const (
+	eventTypePrefix  = eventstore.EventType("access_review.")
+	AddedEventType   = eventTypePrefix + "added"
+	ChangedEventType = eventTypePrefix + "changed"
+	RemovedEventType = eventTypePrefix + "removed"
+)
+
+// AddedEvent defines a periodic review of the user grants of an organization.
+// The first campaign starts at StartDate, the following ones every Interval.
+type AddedEvent struct {
+	eventstore.BaseEvent `json:"-"`
+
+	Name string `json:"name"`
+	// ProjectIDs restrict the reviewed grants to the projects, all grants of the organization are reviewed if empty
+	ProjectIDs []string `json:"projectIds,omitempty"`
+	// Reviewers are the ids of the users deciding about the grants
+	Reviewers []string  `json:"reviewers"`
+	StartDate time.Time `json:"startDate"`
+	// Duration is the time the reviewers have to decide, before the campaign is closed
+	Duration time.Duration `json:"duration"`
+	// Interval between the start of two campaigns, the review is only run once if it's 0
+	Interval time.Duration `json:"interval,omitempty"`
+	// RevokeUndecided revokes the grants, no reviewer decided about, when the campaign is closed
+	RevokeUndecided bool `json:"revokeUndecided,omitempty"`
+}
+
+func (e *AddedEvent) SetBaseEvent(b *eventstore.BaseEvent) {
+	e.BaseEvent = *b
+}
+
+func (e *AddedEvent) Payload() any {
+	return e
+}
+
+func (e *AddedEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+func NewAddedEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	name string,
+	projectIDs,
+	reviewers []string,
+	startDate time.Time,
+	duration,
+	interval time.Duration,
+	revokeUndecided bool,
+) *AddedEvent {
+	return &AddedEvent{
+		BaseEvent: *eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			AddedEventType,
+		),
+		Name:            name,
+		ProjectIDs:      projectIDs,
+		Reviewers:       reviewers,
+		StartDate:       startDate,
+		Duration:        duration,
+		Interval:        interval,
+		RevokeUndecided: revokeUndecided,
+	}
+}
+
+// ChangedEvent changes the review, the changes apply from the next campaign on
+type ChangedEvent struct {
+	eventstore.BaseEvent `json:"-"`
+
+	Name            *string        `json:"name,omitempty"`
+	ProjectIDs      *[]string      `json:"projectIds,omitempty"`
+	Reviewers       *[]string      `json:"reviewers,omitempty"`
+	Duration        *time.Duration `json:"duration,omitempty"`
+	Interval        *time.Duration `json:"interval,omitempty"`
+	RevokeUndecided *bool          `json:"revokeUndecided,omitempty"`
+}
+
+func (e *ChangedEvent) SetBaseEvent(b *eventstore.BaseEvent) {
+	e.BaseEvent = *b
+}
+
+func (e *ChangedEvent) Payload() any {
+	return e
+}
+
+func (e *ChangedEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+func NewChangedEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	changes []Changes,
+) *ChangedEvent {
+	changeEvent := &ChangedEvent{
+		BaseEvent: *eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			ChangedEventType,
+		),
+	}
+	for _, change := range changes {
+		change(changeEvent)
+	}
+	return changeEvent
+}
+
+type Changes func(event *ChangedEvent)
+
+func ChangeName(name string) func(event *ChangedEvent) {
+	return func(e *ChangedEvent) {
+		e.Name = &name
+	}
+}
+
+func ChangeProjectIDs(projectIDs []string) func(event *ChangedEvent) {
+	return func(e *ChangedEvent) {
+		e.ProjectIDs = &projectIDs
+	}
+}
+
+func ChangeReviewers(reviewers []string) func(event *ChangedEvent) {
+	return func(e *ChangedEvent) {
+		e.Reviewers = &reviewers
+	}
+}
+
+func ChangeDuration(duration time.Duration) func(event *ChangedEvent) {
+	return func(e *ChangedEvent) {
+		e.Duration = &duration
+	}
+}
+
+func ChangeInterval(interval time.Duration) func(event *ChangedEvent) {
+	return func(e *ChangedEvent) {
+		e.Interval = &interval
+	}
+}
+
+func ChangeRevokeUndecided(revokeUndecided bool) func(event *ChangedEvent) {
+	return func(e *ChangedEvent) {
+		e.RevokeUndecided = &revokeUndecided
+	}
+}
+
+type RemovedEvent struct {
+	eventstore.BaseEvent `json:"-"`
+}
+
+func (e *RemovedEvent) SetBaseEvent(b *eventstore.BaseEvent) {
+	e.BaseEvent = *b
+}
+
+func (e *RemovedEvent) Payload() any {
+	return nil
+}
+
+func (e *RemovedEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+func NewRemovedEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+) *RemovedEvent {
+	return &RemovedEvent{
+		BaseEvent: *eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			RemovedEventType,
+		),
+	}
+}
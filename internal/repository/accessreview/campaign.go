@@ -0,0 +1,151 @@
+package accessreview
+
+import (
+	"context"
+	"time"
+
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+)
+
+const (
+	campaignEventTypePrefix  = eventTypePrefix + "campaign."
+	CampaignStartedEventType = campaignEventTypePrefix + "started"
+	ItemDecidedEventType     = campaignEventTypePrefix + "item.decided"
+	CampaignClosedEventType  = campaignEventTypePrefix + "closed"
+)
+
+// Item is a user grant in scope of the review at the start of a campaign
+type Item struct {
+	GrantID string `json:"grantId"`
+	// ResourceOwner is the organization of the user grant
+	ResourceOwner  string   `json:"resourceOwner"`
+	UserID         string   `json:"userId"`
+	ProjectID      string   `json:"projectId"`
+	ProjectGrantID string   `json:"projectGrantId,omitempty"`
+	RoleKeys       []string `json:"roleKeys,omitempty"`
+}
+
+// CampaignStartedEvent snapshots the grants to be reviewed until the due date
+type CampaignStartedEvent struct {
+	eventstore.BaseEvent `json:"-"`
+
+	CampaignID string    `json:"campaignId"`
+	DueDate    time.Time `json:"dueDate"`
+	Reviewers  []string  `json:"reviewers"`
+	Items      []*Item   `json:"items"`
+}
+
+func (e *CampaignStartedEvent) SetBaseEvent(b *eventstore.BaseEvent) {
+	e.BaseEvent = *b
+}
+
+func (e *CampaignStartedEvent) Payload() any {
+	return e
+}
+
+func (e *CampaignStartedEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+func NewCampaignStartedEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	campaignID string,
+	dueDate time.Time,
+	reviewers []string,
+	items []*Item,
+) *CampaignStartedEvent {
+	return &CampaignStartedEvent{
+		BaseEvent: *eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			CampaignStartedEventType,
+		),
+		CampaignID: campaignID,
+		DueDate:    dueDate,
+		Reviewers:  reviewers,
+		Items:      items,
+	}
+}
+
+// ItemDecidedEvent records the decision of a reviewer about a grant, the editor of the event is the reviewer.
+// The decision can be changed until the campaign is closed.
+type ItemDecidedEvent struct {
+	eventstore.BaseEvent `json:"-"`
+
+	CampaignID string                      `json:"campaignId"`
+	GrantID    string                      `json:"grantId"`
+	Decision   domain.AccessReviewDecision `json:"decision"`
+	Comment    string                      `json:"comment,omitempty"`
+}
+
+func (e *ItemDecidedEvent) SetBaseEvent(b *eventstore.BaseEvent) {
+	e.BaseEvent = *b
+}
+
+func (e *ItemDecidedEvent) Payload() any {
+	return e
+}
+
+func (e *ItemDecidedEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+func NewItemDecidedEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	campaignID,
+	grantID string,
+	decision domain.AccessReviewDecision,
+	comment string,
+) *ItemDecidedEvent {
+	return &ItemDecidedEvent{
+		BaseEvent: *eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			ItemDecidedEventType,
+		),
+		CampaignID: campaignID,
+		GrantID:    grantID,
+		Decision:   decision,
+		Comment:    comment,
+	}
+}
+
+// CampaignClosedEvent is pushed together with the removal of the revoked grants
+type CampaignClosedEvent struct {
+	eventstore.BaseEvent `json:"-"`
+
+	CampaignID string   `json:"campaignId"`
+	RevokedIDs []string `json:"revokedIds,omitempty"`
+}
+
+func (e *CampaignClosedEvent) SetBaseEvent(b *eventstore.BaseEvent) {
+	e.BaseEvent = *b
+}
+
+func (e *CampaignClosedEvent) Payload() any {
+	return e
+}
+
+func (e *CampaignClosedEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+func NewCampaignClosedEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	campaignID string,
+	revokedIDs []string,
+) *CampaignClosedEvent {
+	return &CampaignClosedEvent{
+		BaseEvent: *eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			CampaignClosedEventType,
+		),
+		CampaignID: campaignID,
+		RevokedIDs: revokedIDs,
+	}
+}
@@ -0,0 +1,12 @@
+package accessreview
+
+import "github.com/zitadel/zitadel/internal/eventstore"
+
+func init() {
+	eventstore.RegisterFilterEventMapper(AggregateType, AddedEventType, eventstore.GenericEventMapper[AddedEvent])
+	eventstore.RegisterFilterEventMapper(AggregateType, ChangedEventType, eventstore.GenericEventMapper[ChangedEvent])
+	eventstore.RegisterFilterEventMapper(AggregateType, RemovedEventType, eventstore.GenericEventMapper[RemovedEvent])
+	eventstore.RegisterFilterEventMapper(AggregateType, CampaignStartedEventType, eventstore.GenericEventMapper[CampaignStartedEvent])
+	eventstore.RegisterFilterEventMapper(AggregateType, ItemDecidedEventType, eventstore.GenericEventMapper[ItemDecidedEvent])
+	eventstore.RegisterFilterEventMapper(AggregateType, CampaignClosedEventType, eventstore.GenericEventMapper[CampaignClosedEvent])
+}
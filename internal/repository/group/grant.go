@@ -0,0 +1,119 @@
+package group
+
+import (
+	"context"
+
+	"github.com/zitadel/zitadel/internal/eventstore"
+)
+
+const (
+	GrantAddedEventType   = eventTypePrefix + "grant.added"
+	GrantChangedEventType = eventTypePrefix + "grant.changed"
+	GrantRemovedEventType = eventTypePrefix + "grant.removed"
+)
+
+// GrantAddedEvent grants the roles of a project to all members of the group and its nested groups.
+// The ProjectGrantID is set if the project is granted to the organization of the group.
+type GrantAddedEvent struct {
+	eventstore.BaseEvent `json:"-"`
+
+	ProjectID      string   `json:"projectId"`
+	ProjectGrantID string   `json:"grantId,omitempty"`
+	RoleKeys       []string `json:"roleKeys"`
+}
+
+func (e *GrantAddedEvent) SetBaseEvent(b *eventstore.BaseEvent) {
+	e.BaseEvent = *b
+}
+
+func (e *GrantAddedEvent) Payload() any {
+	return e
+}
+
+func (e *GrantAddedEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+func NewGrantAddedEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	projectID,
+	projectGrantID string,
+	roleKeys []string,
+) *GrantAddedEvent {
+	return &GrantAddedEvent{
+		BaseEvent: *eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			GrantAddedEventType,
+		),
+		ProjectID:      projectID,
+		ProjectGrantID: projectGrantID,
+		RoleKeys:       roleKeys,
+	}
+}
+
+type GrantChangedEvent struct {
+	eventstore.BaseEvent `json:"-"`
+
+	ProjectID string   `json:"projectId"`
+	RoleKeys  []string `json:"roleKeys"`
+}
+
+func (e *GrantChangedEvent) SetBaseEvent(b *eventstore.BaseEvent) {
+	e.BaseEvent = *b
+}
+
+func (e *GrantChangedEvent) Payload() any {
+	return e
+}
+
+func (e *GrantChangedEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+func NewGrantChangedEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	projectID string,
+	roleKeys []string,
+) *GrantChangedEvent {
+	return &GrantChangedEvent{
+		BaseEvent: *eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			GrantChangedEventType,
+		),
+		ProjectID: projectID,
+		RoleKeys:  roleKeys,
+	}
+}
+
+type GrantRemovedEvent struct {
+	eventstore.BaseEvent `json:"-"`
+
+	ProjectID string `json:"projectId"`
+}
+
+func (e *GrantRemovedEvent) SetBaseEvent(b *eventstore.BaseEvent) {
+	e.BaseEvent = *b
+}
+
+func (e *GrantRemovedEvent) Payload() any {
+	return e
+}
+
+func (e *GrantRemovedEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+func NewGrantRemovedEvent(ctx context.Context, aggregate *eventstore.Aggregate, projectID string) *GrantRemovedEvent {
+	return &GrantRemovedEvent{
+		BaseEvent: *eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			GrantRemovedEventType,
+		),
+		ProjectID: projectID,
+	}
+}
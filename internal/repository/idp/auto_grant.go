@@ -0,0 +1,49 @@
+package idp
+
+import (
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+// AutoGrantsSetEvent replaces the project roles granted to users registered with the identity provider.
+// An empty list of grants removes the auto grants of the identity provider.
+type AutoGrantsSetEvent struct {
+	eventstore.BaseEvent `json:"-"`
+
+	ID     string                 `json:"id"`
+	Grants []*domain.IDPAutoGrant `json:"grants,omitempty"`
+}
+
+func NewAutoGrantsSetEvent(
+	base *eventstore.BaseEvent,
+	id string,
+	grants []*domain.IDPAutoGrant,
+) *AutoGrantsSetEvent {
+	return &AutoGrantsSetEvent{
+		BaseEvent: *base,
+		ID:        id,
+		Grants:    grants,
+	}
+}
+
+func (e *AutoGrantsSetEvent) Payload() interface{} {
+	return e
+}
+
+func (e *AutoGrantsSetEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+func AutoGrantsSetEventMapper(event eventstore.Event) (eventstore.Event, error) {
+	e := &AutoGrantsSetEvent{
+		BaseEvent: *eventstore.BaseEventFromRepo(event),
+	}
+
+	err := event.Unmarshal(e)
+	if err != nil {
+		return nil, zerrors.ThrowInternal(err, "IDP-Ag1sE", "unable to unmarshal event")
+	}
+
+	return e, nil
+}
@@ -16,6 +16,8 @@ type AzureADIDPAddedEvent struct {
 	Scopes          []string            `json:"scopes,omitempty"`
 	Tenant          string              `json:"tenant,omitempty"`
 	IsEmailVerified bool                `json:"isEmailVerified,omitempty"`
+	AllowedTenants  []string            `json:"allowedTenants,omitempty"`
+	FetchGroups     bool                `json:"fetchGroups,omitempty"`
 	Options
 }
 
@@ -28,6 +30,8 @@ func NewAzureADIDPAddedEvent(
 	scopes []string,
 	tenant string,
 	isEmailVerified bool,
+	allowedTenants []string,
+	fetchGroups bool,
 	options Options,
 ) *AzureADIDPAddedEvent {
 	return &AzureADIDPAddedEvent{
@@ -39,6 +43,8 @@ func NewAzureADIDPAddedEvent(
 		Scopes:          scopes,
 		Tenant:          tenant,
 		IsEmailVerified: isEmailVerified,
+		AllowedTenants:  allowedTenants,
+		FetchGroups:     fetchGroups,
 		Options:         options,
 	}
 }
@@ -74,6 +80,8 @@ type AzureADIDPChangedEvent struct {
 	Scopes          []string            `json:"scopes,omitempty"`
 	Tenant          *string             `json:"tenant,omitempty"`
 	IsEmailVerified *bool               `json:"isEmailVerified,omitempty"`
+	AllowedTenants  *[]string           `json:"allowedTenants,omitempty"`
+	FetchGroups     *bool               `json:"fetchGroups,omitempty"`
 	OptionChanges
 }
 
@@ -139,6 +147,18 @@ func ChangeAzureADIsEmailVerified(isEmailVerified bool) func(*AzureADIDPChangedE
 	}
 }
 
+func ChangeAzureADAllowedTenants(allowedTenants []string) func(*AzureADIDPChangedEvent) {
+	return func(e *AzureADIDPChangedEvent) {
+		e.AllowedTenants = &allowedTenants
+	}
+}
+
+func ChangeAzureADFetchGroups(fetchGroups bool) func(*AzureADIDPChangedEvent) {
+	return func(e *AzureADIDPChangedEvent) {
+		e.FetchGroups = &fetchGroups
+	}
+}
+
 func (e *AzureADIDPChangedEvent) Payload() interface{} {
 	return e
 }
@@ -0,0 +1,128 @@
+package idp
+
+import (
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+// HealthCheckFailedEvent flags an identity provider whose scheduled health check failed,
+// the owners are notified about it.
+// It's pushed once until the identity provider recovered.
+type HealthCheckFailedEvent struct {
+	eventstore.BaseEvent `json:"-"`
+
+	ID     string                   `json:"id"`
+	Name   string                   `json:"name,omitempty"`
+	Checks []*domain.IDPHealthCheck `json:"checks,omitempty"`
+}
+
+func NewHealthCheckFailedEvent(
+	base *eventstore.BaseEvent,
+	id,
+	name string,
+	checks []*domain.IDPHealthCheck,
+) *HealthCheckFailedEvent {
+	return &HealthCheckFailedEvent{
+		BaseEvent: *base,
+		ID:        id,
+		Name:      name,
+		Checks:    checks,
+	}
+}
+
+func (e *HealthCheckFailedEvent) Payload() interface{} {
+	return e
+}
+
+func (e *HealthCheckFailedEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+func HealthCheckFailedEventMapper(event eventstore.Event) (eventstore.Event, error) {
+	e := &HealthCheckFailedEvent{
+		BaseEvent: *eventstore.BaseEventFromRepo(event),
+	}
+
+	err := event.Unmarshal(e)
+	if err != nil {
+		return nil, zerrors.ThrowInternal(err, "IDP-Hc1fE", "unable to unmarshal event")
+	}
+
+	return e, nil
+}
+
+// HealthCheckRecoveredEvent removes the flag of a failed health check of the identity provider
+type HealthCheckRecoveredEvent struct {
+	eventstore.BaseEvent `json:"-"`
+
+	ID string `json:"id"`
+}
+
+func NewHealthCheckRecoveredEvent(
+	base *eventstore.BaseEvent,
+	id string,
+) *HealthCheckRecoveredEvent {
+	return &HealthCheckRecoveredEvent{
+		BaseEvent: *base,
+		ID:        id,
+	}
+}
+
+func (e *HealthCheckRecoveredEvent) Payload() interface{} {
+	return e
+}
+
+func (e *HealthCheckRecoveredEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+func HealthCheckRecoveredEventMapper(event eventstore.Event) (eventstore.Event, error) {
+	e := &HealthCheckRecoveredEvent{
+		BaseEvent: *eventstore.BaseEventFromRepo(event),
+	}
+
+	err := event.Unmarshal(e)
+	if err != nil {
+		return nil, zerrors.ThrowInternal(err, "IDP-Hc2rE", "unable to unmarshal event")
+	}
+
+	return e, nil
+}
+
+type HealthCheckFailedNotificationSentEvent struct {
+	eventstore.BaseEvent `json:"-"`
+
+	ID string `json:"id"`
+}
+
+func NewHealthCheckFailedNotificationSentEvent(
+	base *eventstore.BaseEvent,
+	id string,
+) *HealthCheckFailedNotificationSentEvent {
+	return &HealthCheckFailedNotificationSentEvent{
+		BaseEvent: *base,
+		ID:        id,
+	}
+}
+
+func (e *HealthCheckFailedNotificationSentEvent) Payload() interface{} {
+	return e
+}
+
+func (e *HealthCheckFailedNotificationSentEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+func HealthCheckFailedNotificationSentEventMapper(event eventstore.Event) (eventstore.Event, error) {
+	e := &HealthCheckFailedNotificationSentEvent{
+		BaseEvent: *eventstore.BaseEventFromRepo(event),
+	}
+
+	err := event.Unmarshal(e)
+	if err != nil {
+		return nil, zerrors.ThrowInternal(err, "IDP-Hc3nE", "unable to unmarshal event")
+	}
+
+	return e, nil
+}
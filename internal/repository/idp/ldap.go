@@ -1,9 +1,11 @@
 package idp
 
 import (
+	"maps"
 	"time"
 
 	"github.com/zitadel/zitadel/internal/crypto"
+	"github.com/zitadel/zitadel/internal/domain"
 	"github.com/zitadel/zitadel/internal/eventstore"
 	"github.com/zitadel/zitadel/internal/zerrors"
 )
@@ -22,6 +24,11 @@ type LDAPIDPAddedEvent struct {
 	UserObjectClasses []string            `json:"userObjectClasses"`
 	UserFilters       []string            `json:"userFilters"`
 	Timeout           time.Duration       `json:"timeout"`
+	RootCA            []byte              `json:"rootCA,omitempty"`
+
+	GroupResolution    domain.LDAPGroupResolution `json:"groupResolution,omitempty"`
+	GroupBase          string                     `json:"groupBase,omitempty"`
+	GroupNameAttribute string                     `json:"groupNameAttribute,omitempty"`
 
 	LDAPAttributes
 	Options
@@ -41,6 +48,8 @@ type LDAPAttributes struct {
 	PreferredLanguageAttribute string `json:"preferredLanguageAttribute,omitempty"`
 	AvatarURLAttribute         string `json:"avatarURLAttribute,omitempty"`
 	ProfileAttribute           string `json:"profileAttribute,omitempty"`
+	// MetadataAttributes maps the key of the user metadata to the LDAP attribute
+	MetadataAttributes map[string]string `json:"metadataAttributes,omitempty"`
 }
 
 func (o *LDAPAttributes) Changes(attributes LDAPAttributes) LDAPAttributeChanges {
@@ -84,6 +93,9 @@ func (o *LDAPAttributes) Changes(attributes LDAPAttributes) LDAPAttributeChanges
 	if o.ProfileAttribute != attributes.ProfileAttribute {
 		attrs.ProfileAttribute = &attributes.ProfileAttribute
 	}
+	if !maps.Equal(o.MetadataAttributes, attributes.MetadataAttributes) {
+		attrs.MetadataAttributes = &attributes.MetadataAttributes
+	}
 	return attrs
 }
 
@@ -127,6 +139,9 @@ func (o *LDAPAttributes) ReduceChanges(changes LDAPAttributeChanges) {
 	if changes.ProfileAttribute != nil {
 		o.ProfileAttribute = *changes.ProfileAttribute
 	}
+	if changes.MetadataAttributes != nil {
+		o.MetadataAttributes = *changes.MetadataAttributes
+	}
 }
 
 func NewLDAPIDPAddedEvent(
@@ -142,24 +157,32 @@ func NewLDAPIDPAddedEvent(
 	userObjectClasses []string,
 	userFilters []string,
 	timeout time.Duration,
+	rootCA []byte,
+	groupResolution domain.LDAPGroupResolution,
+	groupBase string,
+	groupNameAttribute string,
 	attributes LDAPAttributes,
 	options Options,
 ) *LDAPIDPAddedEvent {
 	return &LDAPIDPAddedEvent{
-		BaseEvent:         *base,
-		ID:                id,
-		Name:              name,
-		Servers:           servers,
-		StartTLS:          startTLS,
-		BaseDN:            baseDN,
-		BindDN:            bindDN,
-		BindPassword:      bindPassword,
-		UserBase:          userBase,
-		UserObjectClasses: userObjectClasses,
-		UserFilters:       userFilters,
-		Timeout:           timeout,
-		LDAPAttributes:    attributes,
-		Options:           options,
+		BaseEvent:          *base,
+		ID:                 id,
+		Name:               name,
+		Servers:            servers,
+		StartTLS:           startTLS,
+		BaseDN:             baseDN,
+		BindDN:             bindDN,
+		BindPassword:       bindPassword,
+		UserBase:           userBase,
+		UserObjectClasses:  userObjectClasses,
+		UserFilters:        userFilters,
+		Timeout:            timeout,
+		RootCA:             rootCA,
+		GroupResolution:    groupResolution,
+		GroupBase:          groupBase,
+		GroupNameAttribute: groupNameAttribute,
+		LDAPAttributes:     attributes,
+		Options:            options,
 	}
 }
 
@@ -198,6 +221,11 @@ type LDAPIDPChangedEvent struct {
 	UserObjectClasses []string            `json:"userObjectClasses,omitempty"`
 	UserFilters       []string            `json:"userFilters,omitempty"`
 	Timeout           *time.Duration      `json:"timeout,omitempty"`
+	RootCA            *[]byte             `json:"rootCA,omitempty"`
+
+	GroupResolution    *domain.LDAPGroupResolution `json:"groupResolution,omitempty"`
+	GroupBase          *string                     `json:"groupBase,omitempty"`
+	GroupNameAttribute *string                     `json:"groupNameAttribute,omitempty"`
 
 	LDAPAttributeChanges
 	OptionChanges
@@ -217,6 +245,8 @@ type LDAPAttributeChanges struct {
 	PreferredLanguageAttribute *string `json:"preferredLanguageAttribute,omitempty"`
 	AvatarURLAttribute         *string `json:"avatarURLAttribute,omitempty"`
 	ProfileAttribute           *string `json:"profileAttribute,omitempty"`
+	// MetadataAttributes maps the key of the user metadata to the LDAP attribute
+	MetadataAttributes *map[string]string `json:"metadataAttributes,omitempty"`
 }
 
 func (o LDAPAttributeChanges) IsZero() bool {
@@ -232,7 +262,8 @@ func (o LDAPAttributeChanges) IsZero() bool {
 		o.PhoneVerifiedAttribute == nil &&
 		o.PreferredLanguageAttribute == nil &&
 		o.AvatarURLAttribute == nil &&
-		o.ProfileAttribute == nil
+		o.ProfileAttribute == nil &&
+		o.MetadataAttributes == nil
 }
 
 func NewLDAPIDPChangedEvent(
@@ -315,6 +346,30 @@ func ChangeLDAPTimeout(timeout time.Duration) func(*LDAPIDPChangedEvent) {
 	}
 }
 
+func ChangeLDAPRootCA(rootCA []byte) func(*LDAPIDPChangedEvent) {
+	return func(e *LDAPIDPChangedEvent) {
+		e.RootCA = &rootCA
+	}
+}
+
+func ChangeLDAPGroupResolution(groupResolution domain.LDAPGroupResolution) func(*LDAPIDPChangedEvent) {
+	return func(e *LDAPIDPChangedEvent) {
+		e.GroupResolution = &groupResolution
+	}
+}
+
+func ChangeLDAPGroupBase(groupBase string) func(*LDAPIDPChangedEvent) {
+	return func(e *LDAPIDPChangedEvent) {
+		e.GroupBase = &groupBase
+	}
+}
+
+func ChangeLDAPGroupNameAttribute(groupNameAttribute string) func(*LDAPIDPChangedEvent) {
+	return func(e *LDAPIDPChangedEvent) {
+		e.GroupNameAttribute = &groupNameAttribute
+	}
+}
+
 func ChangeLDAPAttributes(attributes LDAPAttributeChanges) func(*LDAPIDPChangedEvent) {
 	return func(e *LDAPIDPChangedEvent) {
 		e.LDAPAttributeChanges = attributes
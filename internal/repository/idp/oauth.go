@@ -2,6 +2,7 @@ package idp
 
 import (
 	"github.com/zitadel/zitadel/internal/crypto"
+	"github.com/zitadel/zitadel/internal/domain"
 	"github.com/zitadel/zitadel/internal/eventstore"
 	"github.com/zitadel/zitadel/internal/zerrors"
 )
@@ -18,6 +19,8 @@ type OAuthIDPAddedEvent struct {
 	UserEndpoint          string              `json:"userEndpoint,omitempty"`
 	Scopes                []string            `json:"scopes,omitempty"`
 	IDAttribute           string              `json:"idAttribute,omitempty"`
+	// AttributeMapping maps the information of the user endpoint into the attributes of the user
+	AttributeMapping *domain.OAuthAttributeMapping `json:"attributeMapping,omitempty"`
 	Options
 }
 
@@ -32,6 +35,7 @@ func NewOAuthIDPAddedEvent(
 	userEndpoint,
 	idAttribute string,
 	scopes []string,
+	attributeMapping *domain.OAuthAttributeMapping,
 	options Options,
 ) *OAuthIDPAddedEvent {
 	return &OAuthIDPAddedEvent{
@@ -45,6 +49,7 @@ func NewOAuthIDPAddedEvent(
 		UserEndpoint:          userEndpoint,
 		Scopes:                scopes,
 		IDAttribute:           idAttribute,
+		AttributeMapping:      attributeMapping,
 		Options:               options,
 	}
 }
@@ -82,6 +87,8 @@ type OAuthIDPChangedEvent struct {
 	UserEndpoint          *string             `json:"userEndpoint,omitempty"`
 	Scopes                []string            `json:"scopes,omitempty"`
 	IDAttribute           *string             `json:"idAttribute,omitempty"`
+	// AttributeMapping replaces the whole mapping, an empty mapping removes it
+	AttributeMapping *domain.OAuthAttributeMapping `json:"attributeMapping,omitempty"`
 	OptionChanges
 }
 
@@ -158,6 +165,12 @@ func ChangeOAuthIDAttribute(idAttribute string) func(*OAuthIDPChangedEvent) {
 	}
 }
 
+func ChangeOAuthAttributeMapping(attributeMapping *domain.OAuthAttributeMapping) func(*OAuthIDPChangedEvent) {
+	return func(e *OAuthIDPChangedEvent) {
+		e.AttributeMapping = attributeMapping
+	}
+}
+
 func (e *OAuthIDPChangedEvent) Payload() interface{} {
 	return e
 }
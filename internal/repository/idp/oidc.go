@@ -173,6 +173,8 @@ func NewOIDCIDPMigratedAzureADEvent(
 	scopes []string,
 	tenant string,
 	isEmailVerified bool,
+	allowedTenants []string,
+	fetchGroups bool,
 	options Options,
 ) *OIDCIDPMigratedAzureADEvent {
 	return &OIDCIDPMigratedAzureADEvent{
@@ -185,6 +187,8 @@ func NewOIDCIDPMigratedAzureADEvent(
 			Scopes:          scopes,
 			Tenant:          tenant,
 			IsEmailVerified: isEmailVerified,
+			AllowedTenants:  allowedTenants,
+			FetchGroups:     fetchGroups,
 			Options:         options,
 		},
 	}
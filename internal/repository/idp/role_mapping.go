@@ -0,0 +1,49 @@
+package idp
+
+import (
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+// RoleMappingSetEvent replaces the mappings of the claims provided by the identity provider to ZITADEL roles.
+// An empty list of mappings removes the role mapping of the identity provider.
+type RoleMappingSetEvent struct {
+	eventstore.BaseEvent `json:"-"`
+
+	ID       string                   `json:"id"`
+	Mappings []*domain.IDPRoleMapping `json:"mappings,omitempty"`
+}
+
+func NewRoleMappingSetEvent(
+	base *eventstore.BaseEvent,
+	id string,
+	mappings []*domain.IDPRoleMapping,
+) *RoleMappingSetEvent {
+	return &RoleMappingSetEvent{
+		BaseEvent: *base,
+		ID:        id,
+		Mappings:  mappings,
+	}
+}
+
+func (e *RoleMappingSetEvent) Payload() interface{} {
+	return e
+}
+
+func (e *RoleMappingSetEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+func RoleMappingSetEventMapper(event eventstore.Event) (eventstore.Event, error) {
+	e := &RoleMappingSetEvent{
+		BaseEvent: *eventstore.BaseEventFromRepo(event),
+	}
+
+	err := event.Unmarshal(e)
+	if err != nil {
+		return nil, zerrors.ThrowInternal(err, "IDP-Rm1sE", "unable to unmarshal event")
+	}
+
+	return e, nil
+}
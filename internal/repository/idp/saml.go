@@ -13,6 +13,7 @@ type SAMLIDPAddedEvent struct {
 	ID                            string                   `json:"id"`
 	Name                          string                   `json:"name,omitempty"`
 	Metadata                      []byte                   `json:"metadata,omitempty"`
+	MetadataURL                   string                   `json:"metadataUrl,omitempty"`
 	Key                           *crypto.CryptoValue      `json:"key,omitempty"`
 	Certificate                   []byte                   `json:"certificate,omitempty"`
 	Binding                       string                   `json:"binding,omitempty"`
@@ -27,6 +28,7 @@ func NewSAMLIDPAddedEvent(
 	id,
 	name string,
 	metadata []byte,
+	metadataURL string,
 	key *crypto.CryptoValue,
 	certificate []byte,
 	binding string,
@@ -40,6 +42,7 @@ func NewSAMLIDPAddedEvent(
 		ID:                            id,
 		Name:                          name,
 		Metadata:                      metadata,
+		MetadataURL:                   metadataURL,
 		Key:                           key,
 		Certificate:                   certificate,
 		Binding:                       binding,
@@ -77,6 +80,7 @@ type SAMLIDPChangedEvent struct {
 	ID                            string                   `json:"id"`
 	Name                          *string                  `json:"name,omitempty"`
 	Metadata                      []byte                   `json:"metadata,omitempty"`
+	MetadataURL                   *string                  `json:"metadataUrl,omitempty"`
 	Key                           *crypto.CryptoValue      `json:"key,omitempty"`
 	Certificate                   []byte                   `json:"certificate,omitempty"`
 	Binding                       *string                  `json:"binding,omitempty"`
@@ -118,6 +122,12 @@ func ChangeSAMLMetadata(metadata []byte) func(*SAMLIDPChangedEvent) {
 	}
 }
 
+func ChangeSAMLMetadataURL(metadataURL string) func(*SAMLIDPChangedEvent) {
+	return func(e *SAMLIDPChangedEvent) {
+		e.MetadataURL = &metadataURL
+	}
+}
+
 func ChangeSAMLKey(key *crypto.CryptoValue) func(*SAMLIDPChangedEvent) {
 	return func(e *SAMLIDPChangedEvent) {
 		e.Key = key
@@ -180,3 +190,90 @@ func SAMLIDPChangedEventMapper(event eventstore.Event) (eventstore.Event, error)
 
 	return e, nil
 }
+
+// SAMLIDPCertificateChangedEvent is pushed if the signing certificates in the refreshed metadata
+// of the identity provider differ from the previous metadata (e.g. because of a certificate rotation)
+type SAMLIDPCertificateChangedEvent struct {
+	eventstore.BaseEvent `json:"-"`
+
+	ID       string                           `json:"id"`
+	Previous []*domain.SAMLSigningCertificate `json:"previous,omitempty"`
+	Current  []*domain.SAMLSigningCertificate `json:"current,omitempty"`
+}
+
+func NewSAMLIDPCertificateChangedEvent(
+	base *eventstore.BaseEvent,
+	id string,
+	previous,
+	current []*domain.SAMLSigningCertificate,
+) *SAMLIDPCertificateChangedEvent {
+	return &SAMLIDPCertificateChangedEvent{
+		BaseEvent: *base,
+		ID:        id,
+		Previous:  previous,
+		Current:   current,
+	}
+}
+
+func (e *SAMLIDPCertificateChangedEvent) Payload() interface{} {
+	return e
+}
+
+func (e *SAMLIDPCertificateChangedEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+func SAMLIDPCertificateChangedEventMapper(event eventstore.Event) (eventstore.Event, error) {
+	e := &SAMLIDPCertificateChangedEvent{
+		BaseEvent: *eventstore.BaseEventFromRepo(event),
+	}
+
+	err := event.Unmarshal(e)
+	if err != nil {
+		return nil, zerrors.ThrowInternal(err, "IDP-Sc1cE", "unable to unmarshal event")
+	}
+
+	return e, nil
+}
+
+// SAMLIDPCertificateExpiringEvent is pushed once per certificate, if a signing certificate
+// of the identity provider expires within the configured warning period
+type SAMLIDPCertificateExpiringEvent struct {
+	eventstore.BaseEvent `json:"-"`
+
+	ID          string                         `json:"id"`
+	Certificate *domain.SAMLSigningCertificate `json:"certificate"`
+}
+
+func NewSAMLIDPCertificateExpiringEvent(
+	base *eventstore.BaseEvent,
+	id string,
+	certificate *domain.SAMLSigningCertificate,
+) *SAMLIDPCertificateExpiringEvent {
+	return &SAMLIDPCertificateExpiringEvent{
+		BaseEvent:   *base,
+		ID:          id,
+		Certificate: certificate,
+	}
+}
+
+func (e *SAMLIDPCertificateExpiringEvent) Payload() interface{} {
+	return e
+}
+
+func (e *SAMLIDPCertificateExpiringEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+func SAMLIDPCertificateExpiringEventMapper(event eventstore.Event) (eventstore.Event, error) {
+	e := &SAMLIDPCertificateExpiringEvent{
+		BaseEvent: *eventstore.BaseEventFromRepo(event),
+	}
+
+	err := event.Unmarshal(e)
+	if err != nil {
+		return nil, zerrors.ThrowInternal(err, "IDP-Sc2eE", "unable to unmarshal event")
+	}
+
+	return e, nil
+}
@@ -0,0 +1,191 @@
+package instance
+
+import (
+	"context"
+
+	"github.com/zitadel/zitadel/internal/crypto"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+const (
+	alertTargetPrefix           = "alert.target."
+	AlertTargetAddedEventType   = instanceEventTypePrefix + alertTargetPrefix + "added"
+	AlertTargetChangedEventType = instanceEventTypePrefix + alertTargetPrefix + "changed"
+	AlertTargetRemovedEventType = instanceEventTypePrefix + alertTargetPrefix + "removed"
+)
+
+type AlertTargetAddedEvent struct {
+	eventstore.BaseEvent `json:"-"`
+
+	ID         string                 `json:"id,omitempty"`
+	Name       string                 `json:"name,omitempty"`
+	TargetType domain.AlertTargetType `json:"targetType,omitempty"`
+	// WebhookURL is encrypted, as the URL of incoming webhooks is the only credential needed to post to the channel
+	WebhookURL *crypto.CryptoValue `json:"webhookUrl,omitempty"`
+	EventTypes []string            `json:"eventTypes,omitempty"`
+}
+
+func NewAlertTargetAddedEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	id,
+	name string,
+	targetType domain.AlertTargetType,
+	webhookURL *crypto.CryptoValue,
+	eventTypes []string,
+) *AlertTargetAddedEvent {
+	return &AlertTargetAddedEvent{
+		BaseEvent: *eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			AlertTargetAddedEventType,
+		),
+		ID:         id,
+		Name:       name,
+		TargetType: targetType,
+		WebhookURL: webhookURL,
+		EventTypes: eventTypes,
+	}
+}
+
+func (e *AlertTargetAddedEvent) Payload() interface{} {
+	return e
+}
+
+func (e *AlertTargetAddedEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+func AlertTargetAddedEventMapper(event eventstore.Event) (eventstore.Event, error) {
+	targetAdded := &AlertTargetAddedEvent{
+		BaseEvent: *eventstore.BaseEventFromRepo(event),
+	}
+	err := event.Unmarshal(targetAdded)
+	if err != nil {
+		return nil, zerrors.ThrowInternal(err, "IAM-At3kd", "unable to unmarshal alert target added")
+	}
+
+	return targetAdded, nil
+}
+
+type AlertTargetChangedEvent struct {
+	eventstore.BaseEvent `json:"-"`
+
+	ID         string                  `json:"id,omitempty"`
+	Name       *string                 `json:"name,omitempty"`
+	TargetType *domain.AlertTargetType `json:"targetType,omitempty"`
+	WebhookURL *crypto.CryptoValue     `json:"webhookUrl,omitempty"`
+	EventTypes *[]string               `json:"eventTypes,omitempty"`
+}
+
+func NewAlertTargetChangedEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	id string,
+	changes []AlertTargetChanges,
+) (*AlertTargetChangedEvent, error) {
+	if len(changes) == 0 {
+		return nil, zerrors.ThrowPreconditionFailed(nil, "IAM-At7pw", "Errors.NoChangesFound")
+	}
+	changeEvent := &AlertTargetChangedEvent{
+		BaseEvent: *eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			AlertTargetChangedEventType,
+		),
+		ID: id,
+	}
+	for _, change := range changes {
+		change(changeEvent)
+	}
+	return changeEvent, nil
+}
+
+type AlertTargetChanges func(event *AlertTargetChangedEvent)
+
+func ChangeAlertTargetName(name string) func(event *AlertTargetChangedEvent) {
+	return func(e *AlertTargetChangedEvent) {
+		e.Name = &name
+	}
+}
+
+func ChangeAlertTargetType(targetType domain.AlertTargetType) func(event *AlertTargetChangedEvent) {
+	return func(e *AlertTargetChangedEvent) {
+		e.TargetType = &targetType
+	}
+}
+
+func ChangeAlertTargetWebhookURL(webhookURL *crypto.CryptoValue) func(event *AlertTargetChangedEvent) {
+	return func(e *AlertTargetChangedEvent) {
+		e.WebhookURL = webhookURL
+	}
+}
+
+func ChangeAlertTargetEventTypes(eventTypes []string) func(event *AlertTargetChangedEvent) {
+	return func(e *AlertTargetChangedEvent) {
+		e.EventTypes = &eventTypes
+	}
+}
+
+func (e *AlertTargetChangedEvent) Payload() interface{} {
+	return e
+}
+
+func (e *AlertTargetChangedEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+func AlertTargetChangedEventMapper(event eventstore.Event) (eventstore.Event, error) {
+	targetChanged := &AlertTargetChangedEvent{
+		BaseEvent: *eventstore.BaseEventFromRepo(event),
+	}
+	err := event.Unmarshal(targetChanged)
+	if err != nil {
+		return nil, zerrors.ThrowInternal(err, "IAM-At9qm", "unable to unmarshal alert target changed")
+	}
+
+	return targetChanged, nil
+}
+
+type AlertTargetRemovedEvent struct {
+	eventstore.BaseEvent `json:"-"`
+
+	ID string `json:"id,omitempty"`
+}
+
+func NewAlertTargetRemovedEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	id string,
+) *AlertTargetRemovedEvent {
+	return &AlertTargetRemovedEvent{
+		BaseEvent: *eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			AlertTargetRemovedEventType,
+		),
+		ID: id,
+	}
+}
+
+func (e *AlertTargetRemovedEvent) Payload() interface{} {
+	return e
+}
+
+func (e *AlertTargetRemovedEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+func AlertTargetRemovedEventMapper(event eventstore.Event) (eventstore.Event, error) {
+	targetRemoved := &AlertTargetRemovedEvent{
+		BaseEvent: *eventstore.BaseEventFromRepo(event),
+	}
+	err := event.Unmarshal(targetRemoved)
+	if err != nil {
+		return nil, zerrors.ThrowInternal(err, "IAM-At2xv", "unable to unmarshal alert target removed")
+	}
+
+	return targetRemoved, nil
+}
@@ -0,0 +1,104 @@
+package instance
+
+import (
+	"context"
+
+	"golang.org/x/text/language"
+
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+const (
+	domainSettingsEventPrefix              = domainEventPrefix + "settings."
+	InstanceDomainSettingsSetEventType     = domainSettingsEventPrefix + "set"
+	InstanceDomainSettingsRemovedEventType = domainSettingsEventPrefix + "removed"
+)
+
+// DomainSettingsSetEvent defines the settings which apply to requests on the domain instead of the ones of the instance.
+type DomainSettingsSetEvent struct {
+	eventstore.BaseEvent `json:"-"`
+
+	Domain string `json:"domain,omitempty"`
+	// BrandingOrgID is the organization whose branding is shown on the domain
+	BrandingOrgID string `json:"brandingOrgId,omitempty"`
+	// DefaultLanguage is used on the domain instead of the default language of the instance
+	DefaultLanguage language.Tag `json:"defaultLanguage"`
+}
+
+func (e *DomainSettingsSetEvent) Payload() interface{} {
+	return e
+}
+
+func (e *DomainSettingsSetEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+func NewDomainSettingsSetEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	domain,
+	brandingOrgID string,
+	defaultLanguage language.Tag,
+) *DomainSettingsSetEvent {
+	return &DomainSettingsSetEvent{
+		BaseEvent: *eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			InstanceDomainSettingsSetEventType,
+		),
+		Domain:          domain,
+		BrandingOrgID:   brandingOrgID,
+		DefaultLanguage: defaultLanguage,
+	}
+}
+
+func DomainSettingsSetEventMapper(event eventstore.Event) (eventstore.Event, error) {
+	settingsSet := &DomainSettingsSetEvent{
+		BaseEvent: *eventstore.BaseEventFromRepo(event),
+	}
+	err := event.Unmarshal(settingsSet)
+	if err != nil {
+		return nil, zerrors.ThrowInternal(err, "INSTANCE-Ds3tq", "unable to unmarshal instance domain settings set")
+	}
+
+	return settingsSet, nil
+}
+
+// DomainSettingsRemovedEvent resets the domain to the settings of the instance.
+type DomainSettingsRemovedEvent struct {
+	eventstore.BaseEvent `json:"-"`
+
+	Domain string `json:"domain,omitempty"`
+}
+
+func (e *DomainSettingsRemovedEvent) Payload() interface{} {
+	return e
+}
+
+func (e *DomainSettingsRemovedEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+func NewDomainSettingsRemovedEvent(ctx context.Context, aggregate *eventstore.Aggregate, domain string) *DomainSettingsRemovedEvent {
+	return &DomainSettingsRemovedEvent{
+		BaseEvent: *eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			InstanceDomainSettingsRemovedEventType,
+		),
+		Domain: domain,
+	}
+}
+
+func DomainSettingsRemovedEventMapper(event eventstore.Event) (eventstore.Event, error) {
+	settingsRemoved := &DomainSettingsRemovedEvent{
+		BaseEvent: *eventstore.BaseEventFromRepo(event),
+	}
+	err := event.Unmarshal(settingsRemoved)
+	if err != nil {
+		return nil, zerrors.ThrowInternal(err, "INSTANCE-Dr8mv", "unable to unmarshal instance domain settings removed")
+	}
+
+	return settingsRemoved, nil
+}
@@ -0,0 +1,99 @@
+package instance
+
+import (
+	"context"
+
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+const (
+	encryptionKeyPrefix           = "encryption.key."
+	EncryptionKeyAddedEventType   = instanceEventTypePrefix + encryptionKeyPrefix + "added"
+	EncryptionKeyRemovedEventType = instanceEventTypePrefix + encryptionKeyPrefix + "removed"
+)
+
+// EncryptionKeyAddedEvent sets a customer managed key as active key of the instance.
+// The key itself is only stored wrapped by the key encryption key of the external key management service.
+type EncryptionKeyAddedEvent struct {
+	eventstore.BaseEvent `json:"-"`
+
+	KeyID      string `json:"keyId,omitempty"`
+	Provider   string `json:"provider,omitempty"`
+	KeyURI     string `json:"keyUri,omitempty"`
+	WrappedKey []byte `json:"wrappedKey,omitempty"`
+}
+
+func NewEncryptionKeyAddedEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	keyID,
+	provider,
+	keyURI string,
+	wrappedKey []byte,
+) *EncryptionKeyAddedEvent {
+	return &EncryptionKeyAddedEvent{
+		BaseEvent: *eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			EncryptionKeyAddedEventType,
+		),
+		KeyID:      keyID,
+		Provider:   provider,
+		KeyURI:     keyURI,
+		WrappedKey: wrappedKey,
+	}
+}
+
+func (e *EncryptionKeyAddedEvent) Payload() interface{} {
+	return e
+}
+
+func (e *EncryptionKeyAddedEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+func EncryptionKeyAddedEventMapper(event eventstore.Event) (eventstore.Event, error) {
+	e := &EncryptionKeyAddedEvent{
+		BaseEvent: *eventstore.BaseEventFromRepo(event),
+	}
+	err := event.Unmarshal(e)
+	if err != nil {
+		return nil, zerrors.ThrowInternal(err, "INST-Ue3ns", "unable to unmarshal encryption key added")
+	}
+
+	return e, nil
+}
+
+// EncryptionKeyRemovedEvent switches the instance back to the system keys.
+// Previously added keys remain available for decryption.
+type EncryptionKeyRemovedEvent struct {
+	eventstore.BaseEvent `json:"-"`
+}
+
+func NewEncryptionKeyRemovedEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+) *EncryptionKeyRemovedEvent {
+	return &EncryptionKeyRemovedEvent{
+		BaseEvent: *eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			EncryptionKeyRemovedEventType,
+		),
+	}
+}
+
+func (e *EncryptionKeyRemovedEvent) Payload() interface{} {
+	return nil
+}
+
+func (e *EncryptionKeyRemovedEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+func EncryptionKeyRemovedEventMapper(event eventstore.Event) (eventstore.Event, error) {
+	return &EncryptionKeyRemovedEvent{
+		BaseEvent: *eventstore.BaseEventFromRepo(event),
+	}, nil
+}
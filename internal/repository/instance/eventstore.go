@@ -18,12 +18,27 @@ func init() {
 	eventstore.RegisterFilterEventMapper(AggregateType, SMTPConfigDeactivatedEventType, SMTPConfigDeactivatedEventMapper)
 	eventstore.RegisterFilterEventMapper(AggregateType, SMTPConfigPasswordChangedEventType, SMTPConfigPasswordChangedEventMapper)
 	eventstore.RegisterFilterEventMapper(AggregateType, SMTPConfigRemovedEventType, SMTPConfigRemovedEventMapper)
+	eventstore.RegisterFilterEventMapper(AggregateType, SMTPConfigHTTPAddedEventType, SMTPConfigHTTPAddedEventMapper)
+	eventstore.RegisterFilterEventMapper(AggregateType, SMTPConfigHTTPChangedEventType, SMTPConfigHTTPChangedEventMapper)
 	eventstore.RegisterFilterEventMapper(AggregateType, SMSConfigTwilioAddedEventType, SMSConfigTwilioAddedEventMapper)
 	eventstore.RegisterFilterEventMapper(AggregateType, SMSConfigTwilioChangedEventType, SMSConfigTwilioChangedEventMapper)
 	eventstore.RegisterFilterEventMapper(AggregateType, SMSConfigTwilioTokenChangedEventType, SMSConfigTwilioTokenChangedEventMapper)
 	eventstore.RegisterFilterEventMapper(AggregateType, SMSConfigActivatedEventType, SMSConfigActivatedEventMapper)
 	eventstore.RegisterFilterEventMapper(AggregateType, SMSConfigDeactivatedEventType, SMSConfigDeactivatedEventMapper)
 	eventstore.RegisterFilterEventMapper(AggregateType, SMSConfigRemovedEventType, SMSConfigRemovedEventMapper)
+	eventstore.RegisterFilterEventMapper(AggregateType, SMSConfigHTTPAddedEventType, SMSConfigHTTPAddedEventMapper)
+	eventstore.RegisterFilterEventMapper(AggregateType, SMSConfigHTTPChangedEventType, SMSConfigHTTPChangedEventMapper)
+	eventstore.RegisterFilterEventMapper(AggregateType, SMSConfigRoutingSetEventType, SMSConfigRoutingSetEventMapper)
+	eventstore.RegisterFilterEventMapper(AggregateType, PushConfigFCMSetEventType, PushConfigFCMSetEventMapper)
+	eventstore.RegisterFilterEventMapper(AggregateType, PushConfigAPNsSetEventType, PushConfigAPNsSetEventMapper)
+	eventstore.RegisterFilterEventMapper(AggregateType, PushConfigRemovedEventType, PushConfigRemovedEventMapper)
+	eventstore.RegisterFilterEventMapper(AggregateType, AlertTargetAddedEventType, AlertTargetAddedEventMapper)
+	eventstore.RegisterFilterEventMapper(AggregateType, AlertTargetChangedEventType, AlertTargetChangedEventMapper)
+	eventstore.RegisterFilterEventMapper(AggregateType, AlertTargetRemovedEventType, AlertTargetRemovedEventMapper)
+	eventstore.RegisterFilterEventMapper(AggregateType, NotificationLayoutSetEventType, NotificationLayoutSetEventMapper)
+	eventstore.RegisterFilterEventMapper(AggregateType, NotificationLayoutRemovedEventType, NotificationLayoutRemovedEventMapper)
+	eventstore.RegisterFilterEventMapper(AggregateType, NotificationTemplatePartialSetEventType, NotificationTemplatePartialSetEventMapper)
+	eventstore.RegisterFilterEventMapper(AggregateType, NotificationTemplatePartialRemovedEventType, NotificationTemplatePartialRemovedEventMapper)
 	eventstore.RegisterFilterEventMapper(AggregateType, DebugNotificationProviderFileAddedEventType, DebugNotificationProviderFileAddedEventMapper)
 	eventstore.RegisterFilterEventMapper(AggregateType, DebugNotificationProviderFileChangedEventType, DebugNotificationProviderFileChangedEventMapper)
 	eventstore.RegisterFilterEventMapper(AggregateType, DebugNotificationProviderFileRemovedEventType, DebugNotificationProviderFileRemovedEventMapper)
@@ -59,6 +74,7 @@ func init() {
 	eventstore.RegisterFilterEventMapper(AggregateType, LockoutPolicyChangedEventType, LockoutPolicyChangedEventMapper)
 	eventstore.RegisterFilterEventMapper(AggregateType, PrivacyPolicyAddedEventType, PrivacyPolicyAddedEventMapper)
 	eventstore.RegisterFilterEventMapper(AggregateType, PrivacyPolicyChangedEventType, PrivacyPolicyChangedEventMapper)
+	eventstore.RegisterFilterEventMapper(AggregateType, TermsVersionPublishedEventType, TermsVersionPublishedEventMapper)
 	eventstore.RegisterFilterEventMapper(AggregateType, MemberAddedEventType, MemberAddedEventMapper)
 	eventstore.RegisterFilterEventMapper(AggregateType, MemberChangedEventType, MemberChangedEventMapper)
 	eventstore.RegisterFilterEventMapper(AggregateType, MemberRemovedEventType, MemberRemovedEventMapper)
@@ -98,10 +114,20 @@ func init() {
 	eventstore.RegisterFilterEventMapper(AggregateType, AppleIDPChangedEventType, AppleIDPChangedEventMapper)
 	eventstore.RegisterFilterEventMapper(AggregateType, SAMLIDPAddedEventType, SAMLIDPAddedEventMapper)
 	eventstore.RegisterFilterEventMapper(AggregateType, SAMLIDPChangedEventType, SAMLIDPChangedEventMapper)
+	eventstore.RegisterFilterEventMapper(AggregateType, SAMLIDPCertificateChangedEventType, SAMLIDPCertificateChangedEventMapper)
+	eventstore.RegisterFilterEventMapper(AggregateType, SAMLIDPCertificateExpiringEventType, SAMLIDPCertificateExpiringEventMapper)
 	eventstore.RegisterFilterEventMapper(AggregateType, IDPRemovedEventType, IDPRemovedEventMapper)
+	eventstore.RegisterFilterEventMapper(AggregateType, IDPRoleMappingSetEventType, IDPRoleMappingSetEventMapper)
+	eventstore.RegisterFilterEventMapper(AggregateType, IDPAutoGrantsSetEventType, IDPAutoGrantsSetEventMapper)
+	eventstore.RegisterFilterEventMapper(AggregateType, IDPHealthCheckFailedEventType, IDPHealthCheckFailedEventMapper)
+	eventstore.RegisterFilterEventMapper(AggregateType, IDPHealthCheckRecoveredEventType, IDPHealthCheckRecoveredEventMapper)
+	eventstore.RegisterFilterEventMapper(AggregateType, IDPHealthCheckFailedNotificationSentEventType, IDPHealthCheckFailedNotificationSentEventMapper)
 	eventstore.RegisterFilterEventMapper(AggregateType, LoginPolicyIDPProviderAddedEventType, IdentityProviderAddedEventMapper)
 	eventstore.RegisterFilterEventMapper(AggregateType, LoginPolicyIDPProviderRemovedEventType, IdentityProviderRemovedEventMapper)
 	eventstore.RegisterFilterEventMapper(AggregateType, LoginPolicyIDPProviderCascadeRemovedEventType, IdentityProviderCascadeRemovedEventMapper)
+	eventstore.RegisterFilterEventMapper(AggregateType, LoginPolicyIDPProviderButtonsSetEventType, IdentityProviderButtonsSetEventMapper)
+	eventstore.RegisterFilterEventMapper(AggregateType, LoginPolicyIDPProviderIconAddedEventType, IdentityProviderIconAddedEventMapper)
+	eventstore.RegisterFilterEventMapper(AggregateType, LoginPolicyIDPProviderIconRemovedEventType, IdentityProviderIconRemovedEventMapper)
 	eventstore.RegisterFilterEventMapper(AggregateType, LoginPolicySecondFactorAddedEventType, SecondFactorAddedEventMapper)
 	eventstore.RegisterFilterEventMapper(AggregateType, LoginPolicySecondFactorRemovedEventType, SecondFactorRemovedEventMapper)
 	eventstore.RegisterFilterEventMapper(AggregateType, LoginPolicyMultiFactorAddedEventType, MultiFactorAddedEventMapper)
@@ -116,9 +142,16 @@ func init() {
 	eventstore.RegisterFilterEventMapper(AggregateType, InstanceDomainAddedEventType, DomainAddedEventMapper)
 	eventstore.RegisterFilterEventMapper(AggregateType, InstanceDomainPrimarySetEventType, DomainPrimarySetEventMapper)
 	eventstore.RegisterFilterEventMapper(AggregateType, InstanceDomainRemovedEventType, DomainRemovedEventMapper)
+	eventstore.RegisterFilterEventMapper(AggregateType, InstanceDomainSettingsSetEventType, DomainSettingsSetEventMapper)
+	eventstore.RegisterFilterEventMapper(AggregateType, InstanceDomainSettingsRemovedEventType, DomainSettingsRemovedEventMapper)
 	eventstore.RegisterFilterEventMapper(AggregateType, InstanceAddedEventType, InstanceAddedEventMapper)
 	eventstore.RegisterFilterEventMapper(AggregateType, InstanceChangedEventType, InstanceChangedEventMapper)
 	eventstore.RegisterFilterEventMapper(AggregateType, InstanceRemovedEventType, InstanceRemovedEventMapper)
 	eventstore.RegisterFilterEventMapper(AggregateType, NotificationPolicyAddedEventType, NotificationPolicyAddedEventMapper)
 	eventstore.RegisterFilterEventMapper(AggregateType, NotificationPolicyChangedEventType, NotificationPolicyChangedEventMapper)
+	eventstore.RegisterFilterEventMapper(AggregateType, CaptchaPolicyAddedEventType, CaptchaPolicyAddedEventMapper)
+	eventstore.RegisterFilterEventMapper(AggregateType, CaptchaPolicyChangedEventType, CaptchaPolicyChangedEventMapper)
+	eventstore.RegisterFilterEventMapper(AggregateType, CaptchaPolicyRemovedEventType, CaptchaPolicyRemovedEventMapper)
+	eventstore.RegisterFilterEventMapper(AggregateType, EncryptionKeyAddedEventType, EncryptionKeyAddedEventMapper)
+	eventstore.RegisterFilterEventMapper(AggregateType, EncryptionKeyRemovedEventType, EncryptionKeyRemovedEventMapper)
 }
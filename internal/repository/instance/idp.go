@@ -38,6 +38,19 @@ const (
 	SAMLIDPAddedEventType               eventstore.EventType = "instance.idp.saml.added"
 	SAMLIDPChangedEventType             eventstore.EventType = "instance.idp.saml.changed"
 	IDPRemovedEventType                 eventstore.EventType = "instance.idp.removed"
+	IDPRoleMappingSetEventType          eventstore.EventType = "instance.idp.role_mapping.set"
+	IDPAutoGrantsSetEventType           eventstore.EventType = "instance.idp.auto_grants.set"
+)
+
+const (
+	IDPHealthCheckFailedEventType                 eventstore.EventType = "instance.idp.health.failed"
+	IDPHealthCheckRecoveredEventType              eventstore.EventType = "instance.idp.health.recovered"
+	IDPHealthCheckFailedNotificationSentEventType eventstore.EventType = "instance.idp.health.failed.notification.sent"
+)
+
+const (
+	SAMLIDPCertificateChangedEventType  eventstore.EventType = "instance.idp.saml.certificate.changed"
+	SAMLIDPCertificateExpiringEventType eventstore.EventType = "instance.idp.saml.certificate.expiring"
 )
 
 type OAuthIDPAddedEvent struct {
@@ -56,6 +69,7 @@ func NewOAuthIDPAddedEvent(
 	userEndpoint,
 	idAttribute string,
 	scopes []string,
+	attributeMapping *domain.OAuthAttributeMapping,
 	options idp.Options,
 ) *OAuthIDPAddedEvent {
 
@@ -75,6 +89,7 @@ func NewOAuthIDPAddedEvent(
 			userEndpoint,
 			idAttribute,
 			scopes,
+			attributeMapping,
 			options,
 		),
 	}
@@ -218,6 +233,8 @@ func NewOIDCIDPMigratedAzureADEvent(
 	scopes []string,
 	tenant string,
 	isEmailVerified bool,
+	allowedTenants []string,
+	fetchGroups bool,
 	options idp.Options,
 ) *OIDCIDPMigratedAzureADEvent {
 	return &OIDCIDPMigratedAzureADEvent{
@@ -234,6 +251,8 @@ func NewOIDCIDPMigratedAzureADEvent(
 			scopes,
 			tenant,
 			isEmailVerified,
+			allowedTenants,
+			fetchGroups,
 			options,
 		),
 	}
@@ -380,6 +399,8 @@ func NewAzureADIDPAddedEvent(
 	scopes []string,
 	tenant string,
 	isEmailVerified bool,
+	allowedTenants []string,
+	fetchGroups bool,
 	options idp.Options,
 ) *AzureADIDPAddedEvent {
 
@@ -397,6 +418,8 @@ func NewAzureADIDPAddedEvent(
 			scopes,
 			tenant,
 			isEmailVerified,
+			allowedTenants,
+			fetchGroups,
 			options,
 		),
 	}
@@ -852,6 +875,10 @@ func NewLDAPIDPAddedEvent(
 	userObjectClasses []string,
 	userFilters []string,
 	timeout time.Duration,
+	rootCA []byte,
+	groupResolution domain.LDAPGroupResolution,
+	groupBase string,
+	groupNameAttribute string,
 	attributes idp.LDAPAttributes,
 	options idp.Options,
 ) *LDAPIDPAddedEvent {
@@ -874,6 +901,10 @@ func NewLDAPIDPAddedEvent(
 			userObjectClasses,
 			userFilters,
 			timeout,
+			rootCA,
+			groupResolution,
+			groupBase,
+			groupNameAttribute,
 			attributes,
 			options,
 		),
@@ -1013,6 +1044,7 @@ func NewSAMLIDPAddedEvent(
 	id,
 	name string,
 	metadata []byte,
+	metadataURL string,
 	key *crypto.CryptoValue,
 	certificate []byte,
 	binding string,
@@ -1031,6 +1063,7 @@ func NewSAMLIDPAddedEvent(
 			id,
 			name,
 			metadata,
+			metadataURL,
 			key,
 			certificate,
 			binding,
@@ -1119,3 +1152,247 @@ func IDPRemovedEventMapper(event eventstore.Event) (eventstore.Event, error) {
 
 	return &IDPRemovedEvent{RemovedEvent: *e.(*idp.RemovedEvent)}, nil
 }
+
+type IDPRoleMappingSetEvent struct {
+	idp.RoleMappingSetEvent
+}
+
+func NewIDPRoleMappingSetEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	id string,
+	mappings []*domain.IDPRoleMapping,
+) *IDPRoleMappingSetEvent {
+	return &IDPRoleMappingSetEvent{
+		RoleMappingSetEvent: *idp.NewRoleMappingSetEvent(
+			eventstore.NewBaseEventForPush(
+				ctx,
+				aggregate,
+				IDPRoleMappingSetEventType,
+			),
+			id,
+			mappings,
+		),
+	}
+}
+
+func (e *IDPRoleMappingSetEvent) Payload() interface{} {
+	return e
+}
+
+func IDPRoleMappingSetEventMapper(event eventstore.Event) (eventstore.Event, error) {
+	e, err := idp.RoleMappingSetEventMapper(event)
+	if err != nil {
+		return nil, err
+	}
+
+	return &IDPRoleMappingSetEvent{RoleMappingSetEvent: *e.(*idp.RoleMappingSetEvent)}, nil
+}
+
+type IDPAutoGrantsSetEvent struct {
+	idp.AutoGrantsSetEvent
+}
+
+func NewIDPAutoGrantsSetEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	id string,
+	grants []*domain.IDPAutoGrant,
+) *IDPAutoGrantsSetEvent {
+	return &IDPAutoGrantsSetEvent{
+		AutoGrantsSetEvent: *idp.NewAutoGrantsSetEvent(
+			eventstore.NewBaseEventForPush(
+				ctx,
+				aggregate,
+				IDPAutoGrantsSetEventType,
+			),
+			id,
+			grants,
+		),
+	}
+}
+
+func (e *IDPAutoGrantsSetEvent) Payload() interface{} {
+	return e
+}
+
+func IDPAutoGrantsSetEventMapper(event eventstore.Event) (eventstore.Event, error) {
+	e, err := idp.AutoGrantsSetEventMapper(event)
+	if err != nil {
+		return nil, err
+	}
+
+	return &IDPAutoGrantsSetEvent{AutoGrantsSetEvent: *e.(*idp.AutoGrantsSetEvent)}, nil
+}
+
+type IDPHealthCheckFailedEvent struct {
+	idp.HealthCheckFailedEvent
+}
+
+func NewIDPHealthCheckFailedEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	id,
+	name string,
+	checks []*domain.IDPHealthCheck,
+) *IDPHealthCheckFailedEvent {
+	return &IDPHealthCheckFailedEvent{
+		HealthCheckFailedEvent: *idp.NewHealthCheckFailedEvent(
+			eventstore.NewBaseEventForPush(
+				ctx,
+				aggregate,
+				IDPHealthCheckFailedEventType,
+			),
+			id,
+			name,
+			checks,
+		),
+	}
+}
+
+func (e *IDPHealthCheckFailedEvent) Payload() interface{} {
+	return e
+}
+
+func IDPHealthCheckFailedEventMapper(event eventstore.Event) (eventstore.Event, error) {
+	e, err := idp.HealthCheckFailedEventMapper(event)
+	if err != nil {
+		return nil, err
+	}
+
+	return &IDPHealthCheckFailedEvent{HealthCheckFailedEvent: *e.(*idp.HealthCheckFailedEvent)}, nil
+}
+
+type IDPHealthCheckRecoveredEvent struct {
+	idp.HealthCheckRecoveredEvent
+}
+
+func NewIDPHealthCheckRecoveredEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	id string,
+) *IDPHealthCheckRecoveredEvent {
+	return &IDPHealthCheckRecoveredEvent{
+		HealthCheckRecoveredEvent: *idp.NewHealthCheckRecoveredEvent(
+			eventstore.NewBaseEventForPush(
+				ctx,
+				aggregate,
+				IDPHealthCheckRecoveredEventType,
+			),
+			id,
+		),
+	}
+}
+
+func (e *IDPHealthCheckRecoveredEvent) Payload() interface{} {
+	return e
+}
+
+func IDPHealthCheckRecoveredEventMapper(event eventstore.Event) (eventstore.Event, error) {
+	e, err := idp.HealthCheckRecoveredEventMapper(event)
+	if err != nil {
+		return nil, err
+	}
+
+	return &IDPHealthCheckRecoveredEvent{HealthCheckRecoveredEvent: *e.(*idp.HealthCheckRecoveredEvent)}, nil
+}
+
+type IDPHealthCheckFailedNotificationSentEvent struct {
+	idp.HealthCheckFailedNotificationSentEvent
+}
+
+func NewIDPHealthCheckFailedNotificationSentEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	id string,
+) *IDPHealthCheckFailedNotificationSentEvent {
+	return &IDPHealthCheckFailedNotificationSentEvent{
+		HealthCheckFailedNotificationSentEvent: *idp.NewHealthCheckFailedNotificationSentEvent(
+			eventstore.NewBaseEventForPush(
+				ctx,
+				aggregate,
+				IDPHealthCheckFailedNotificationSentEventType,
+			),
+			id,
+		),
+	}
+}
+
+func (e *IDPHealthCheckFailedNotificationSentEvent) Payload() interface{} {
+	return e
+}
+
+func IDPHealthCheckFailedNotificationSentEventMapper(event eventstore.Event) (eventstore.Event, error) {
+	e, err := idp.HealthCheckFailedNotificationSentEventMapper(event)
+	if err != nil {
+		return nil, err
+	}
+
+	return &IDPHealthCheckFailedNotificationSentEvent{HealthCheckFailedNotificationSentEvent: *e.(*idp.HealthCheckFailedNotificationSentEvent)}, nil
+}
+
+type SAMLIDPCertificateChangedEvent struct {
+	idp.SAMLIDPCertificateChangedEvent
+}
+
+func NewSAMLIDPCertificateChangedEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	id string,
+	previous,
+	current []*domain.SAMLSigningCertificate,
+) *SAMLIDPCertificateChangedEvent {
+	return &SAMLIDPCertificateChangedEvent{
+		SAMLIDPCertificateChangedEvent: *idp.NewSAMLIDPCertificateChangedEvent(
+			eventstore.NewBaseEventForPush(
+				ctx,
+				aggregate,
+				SAMLIDPCertificateChangedEventType,
+			),
+			id,
+			previous,
+			current,
+		),
+	}
+}
+
+func SAMLIDPCertificateChangedEventMapper(event eventstore.Event) (eventstore.Event, error) {
+	e, err := idp.SAMLIDPCertificateChangedEventMapper(event)
+	if err != nil {
+		return nil, err
+	}
+
+	return &SAMLIDPCertificateChangedEvent{SAMLIDPCertificateChangedEvent: *e.(*idp.SAMLIDPCertificateChangedEvent)}, nil
+}
+
+type SAMLIDPCertificateExpiringEvent struct {
+	idp.SAMLIDPCertificateExpiringEvent
+}
+
+func NewSAMLIDPCertificateExpiringEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	id string,
+	certificate *domain.SAMLSigningCertificate,
+) *SAMLIDPCertificateExpiringEvent {
+	return &SAMLIDPCertificateExpiringEvent{
+		SAMLIDPCertificateExpiringEvent: *idp.NewSAMLIDPCertificateExpiringEvent(
+			eventstore.NewBaseEventForPush(
+				ctx,
+				aggregate,
+				SAMLIDPCertificateExpiringEventType,
+			),
+			id,
+			certificate,
+		),
+	}
+}
+
+func SAMLIDPCertificateExpiringEventMapper(event eventstore.Event) (eventstore.Event, error) {
+	e, err := idp.SAMLIDPCertificateExpiringEventMapper(event)
+	if err != nil {
+		return nil, err
+	}
+
+	return &SAMLIDPCertificateExpiringEvent{SAMLIDPCertificateExpiringEvent: *e.(*idp.SAMLIDPCertificateExpiringEvent)}, nil
+}
@@ -0,0 +1,196 @@
+package instance
+
+import (
+	"context"
+
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+const (
+	notificationTemplatePrefix                  = "notification."
+	NotificationLayoutSetEventType              = instanceEventTypePrefix + notificationTemplatePrefix + "layout.set"
+	NotificationLayoutRemovedEventType          = instanceEventTypePrefix + notificationTemplatePrefix + "layout.removed"
+	NotificationTemplatePartialSetEventType     = instanceEventTypePrefix + notificationTemplatePrefix + "partial.set"
+	NotificationTemplatePartialRemovedEventType = instanceEventTypePrefix + notificationTemplatePrefix + "partial.removed"
+)
+
+// NotificationLayoutSetEvent sets the email layout for a language.
+// An empty language sets the default layout used if no layout for the language of the recipient exists.
+type NotificationLayoutSetEvent struct {
+	eventstore.BaseEvent `json:"-"`
+
+	Language   string `json:"language,omitempty"`
+	HTML       string `json:"html,omitempty"`
+	Text       string `json:"text,omitempty"`
+	InlineLogo bool   `json:"inlineLogo,omitempty"`
+}
+
+func NewNotificationLayoutSetEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	language,
+	html,
+	text string,
+	inlineLogo bool,
+) *NotificationLayoutSetEvent {
+	return &NotificationLayoutSetEvent{
+		BaseEvent: *eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			NotificationLayoutSetEventType,
+		),
+		Language:   language,
+		HTML:       html,
+		Text:       text,
+		InlineLogo: inlineLogo,
+	}
+}
+
+func (e *NotificationLayoutSetEvent) Payload() interface{} {
+	return e
+}
+
+func (e *NotificationLayoutSetEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+func NotificationLayoutSetEventMapper(event eventstore.Event) (eventstore.Event, error) {
+	layoutSet := &NotificationLayoutSetEvent{
+		BaseEvent: *eventstore.BaseEventFromRepo(event),
+	}
+	err := event.Unmarshal(layoutSet)
+	if err != nil {
+		return nil, zerrors.ThrowInternal(err, "IAM-k3vq8wzt1d", "unable to unmarshal notification layout set")
+	}
+
+	return layoutSet, nil
+}
+
+type NotificationLayoutRemovedEvent struct {
+	eventstore.BaseEvent `json:"-"`
+
+	Language string `json:"language,omitempty"`
+}
+
+func NewNotificationLayoutRemovedEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	language string,
+) *NotificationLayoutRemovedEvent {
+	return &NotificationLayoutRemovedEvent{
+		BaseEvent: *eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			NotificationLayoutRemovedEventType,
+		),
+		Language: language,
+	}
+}
+
+func (e *NotificationLayoutRemovedEvent) Payload() interface{} {
+	return e
+}
+
+func (e *NotificationLayoutRemovedEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+func NotificationLayoutRemovedEventMapper(event eventstore.Event) (eventstore.Event, error) {
+	layoutRemoved := &NotificationLayoutRemovedEvent{
+		BaseEvent: *eventstore.BaseEventFromRepo(event),
+	}
+	err := event.Unmarshal(layoutRemoved)
+	if err != nil {
+		return nil, zerrors.ThrowInternal(err, "IAM-7hmx2crp9s", "unable to unmarshal notification layout removed")
+	}
+
+	return layoutRemoved, nil
+}
+
+// NotificationTemplatePartialSetEvent sets a named partial (e.g. header or footer),
+// which can be used in all layouts with {{template "name" .}}.
+type NotificationTemplatePartialSetEvent struct {
+	eventstore.BaseEvent `json:"-"`
+
+	Name    string `json:"name,omitempty"`
+	Content string `json:"content,omitempty"`
+}
+
+func NewNotificationTemplatePartialSetEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	name,
+	content string,
+) *NotificationTemplatePartialSetEvent {
+	return &NotificationTemplatePartialSetEvent{
+		BaseEvent: *eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			NotificationTemplatePartialSetEventType,
+		),
+		Name:    name,
+		Content: content,
+	}
+}
+
+func (e *NotificationTemplatePartialSetEvent) Payload() interface{} {
+	return e
+}
+
+func (e *NotificationTemplatePartialSetEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+func NotificationTemplatePartialSetEventMapper(event eventstore.Event) (eventstore.Event, error) {
+	partialSet := &NotificationTemplatePartialSetEvent{
+		BaseEvent: *eventstore.BaseEventFromRepo(event),
+	}
+	err := event.Unmarshal(partialSet)
+	if err != nil {
+		return nil, zerrors.ThrowInternal(err, "IAM-d5nw0gkx7y", "unable to unmarshal notification template partial set")
+	}
+
+	return partialSet, nil
+}
+
+type NotificationTemplatePartialRemovedEvent struct {
+	eventstore.BaseEvent `json:"-"`
+
+	Name string `json:"name,omitempty"`
+}
+
+func NewNotificationTemplatePartialRemovedEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	name string,
+) *NotificationTemplatePartialRemovedEvent {
+	return &NotificationTemplatePartialRemovedEvent{
+		BaseEvent: *eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			NotificationTemplatePartialRemovedEventType,
+		),
+		Name: name,
+	}
+}
+
+func (e *NotificationTemplatePartialRemovedEvent) Payload() interface{} {
+	return e
+}
+
+func (e *NotificationTemplatePartialRemovedEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+func NotificationTemplatePartialRemovedEventMapper(event eventstore.Event) (eventstore.Event, error) {
+	partialRemoved := &NotificationTemplatePartialRemovedEvent{
+		BaseEvent: *eventstore.BaseEventFromRepo(event),
+	}
+	err := event.Unmarshal(partialRemoved)
+	if err != nil {
+		return nil, zerrors.ThrowInternal(err, "IAM-p9fy4tbe2u", "unable to unmarshal notification template partial removed")
+	}
+
+	return partialRemoved, nil
+}
@@ -47,6 +47,7 @@ func NewLabelPolicyAddedEvent(
 	errorMsgPopup,
 	disableWatermark bool,
 	themeMode domain.LabelPolicyThemeMode,
+	customCSS string,
 ) *LabelPolicyAddedEvent {
 	return &LabelPolicyAddedEvent{
 		LabelPolicyAddedEvent: *policy.NewLabelPolicyAddedEvent(
@@ -65,7 +66,8 @@ func NewLabelPolicyAddedEvent(
 			hideLoginNameSuffix,
 			errorMsgPopup,
 			disableWatermark,
-			themeMode),
+			themeMode,
+			customCSS),
 	}
 }
 
@@ -37,7 +37,13 @@ func NewLoginPolicyAddedEvent(
 	externalLoginCheckLifetime,
 	mfaInitSkipLifetime,
 	secondFactorCheckLifetime,
-	multiFactorCheckLifetime time.Duration,
+	multiFactorCheckLifetime,
+	trustedDeviceLifetime time.Duration,
+	sharedDevice bool,
+	sharedDeviceLifetime,
+	sharedDeviceIdleTimeout time.Duration,
+	passkeyPrompt bool,
+	passkeyPromptSnoozeLifetime time.Duration,
 ) *LoginPolicyAddedEvent {
 	return &LoginPolicyAddedEvent{
 		LoginPolicyAddedEvent: *policy.NewLoginPolicyAddedEvent(
@@ -61,7 +67,13 @@ func NewLoginPolicyAddedEvent(
 			externalLoginCheckLifetime,
 			mfaInitSkipLifetime,
 			secondFactorCheckLifetime,
-			multiFactorCheckLifetime),
+			multiFactorCheckLifetime,
+			trustedDeviceLifetime,
+			sharedDevice,
+			sharedDeviceLifetime,
+			sharedDeviceIdleTimeout,
+			passkeyPrompt,
+			passkeyPromptSnoozeLifetime),
 	}
 }
 
@@ -21,6 +21,7 @@ type SecurityPolicySetEvent struct {
 	EnableIframeEmbedding *bool     `json:"enable_iframe_embedding,omitempty"`
 	AllowedOrigins        *[]string `json:"allowedOrigins,omitempty"`
 	EnableImpersonation   *bool     `json:"enable_impersonation,omitempty"`
+	ActionsHTTPAllowList  *[]string `json:"actionsHTTPAllowList,omitempty"`
 }
 
 func NewSecurityPolicySetEvent(
@@ -67,6 +68,15 @@ func ChangeSecurityPolicyEnableImpersonation(enabled bool) func(event *SecurityP
 	}
 }
 
+func ChangeSecurityPolicyActionsHTTPAllowList(allowList []string) func(event *SecurityPolicySetEvent) {
+	return func(e *SecurityPolicySetEvent) {
+		if len(allowList) == 0 {
+			allowList = []string{}
+		}
+		e.ActionsHTTPAllowList = &allowList
+	}
+}
+
 func (e *SecurityPolicySetEvent) Payload() interface{} {
 	return e
 }
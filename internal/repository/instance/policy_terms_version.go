@@ -0,0 +1,44 @@
+package instance
+
+import (
+	"context"
+
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/repository/policy"
+)
+
+var (
+	TermsVersionPublishedEventType = instanceEventTypePrefix + policy.TermsVersionPublishedEventType
+)
+
+type TermsVersionPublishedEvent struct {
+	policy.TermsVersionPublishedEvent
+}
+
+func NewTermsVersionPublishedEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	version,
+	tosLink,
+	privacyLink string,
+) *TermsVersionPublishedEvent {
+	return &TermsVersionPublishedEvent{
+		TermsVersionPublishedEvent: *policy.NewTermsVersionPublishedEvent(
+			eventstore.NewBaseEventForPush(
+				ctx,
+				aggregate,
+				TermsVersionPublishedEventType),
+			version,
+			tosLink,
+			privacyLink),
+	}
+}
+
+func TermsVersionPublishedEventMapper(event eventstore.Event) (eventstore.Event, error) {
+	e, err := policy.TermsVersionPublishedEventMapper(event)
+	if err != nil {
+		return nil, err
+	}
+
+	return &TermsVersionPublishedEvent{TermsVersionPublishedEvent: *e.(*policy.TermsVersionPublishedEvent)}, nil
+}
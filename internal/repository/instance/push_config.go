@@ -0,0 +1,155 @@
+package instance
+
+import (
+	"context"
+
+	"github.com/zitadel/zitadel/internal/crypto"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+const (
+	pushConfigPrefix           = "push.config."
+	PushConfigFCMSetEventType  = instanceEventTypePrefix + pushConfigPrefix + "fcm.set"
+	PushConfigAPNsSetEventType = instanceEventTypePrefix + pushConfigPrefix + "apns.set"
+	PushConfigRemovedEventType = instanceEventTypePrefix + pushConfigPrefix + "removed"
+)
+
+type PushConfigFCMSetEvent struct {
+	eventstore.BaseEvent `json:"-"`
+
+	ProjectID         string              `json:"projectId,omitempty"`
+	ServiceAccountKey *crypto.CryptoValue `json:"serviceAccountKey,omitempty"`
+}
+
+func NewPushConfigFCMSetEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	projectID string,
+	serviceAccountKey *crypto.CryptoValue,
+) *PushConfigFCMSetEvent {
+	return &PushConfigFCMSetEvent{
+		BaseEvent: *eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			PushConfigFCMSetEventType,
+		),
+		ProjectID:         projectID,
+		ServiceAccountKey: serviceAccountKey,
+	}
+}
+
+func (e *PushConfigFCMSetEvent) Payload() interface{} {
+	return e
+}
+
+func (e *PushConfigFCMSetEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+func PushConfigFCMSetEventMapper(event eventstore.Event) (eventstore.Event, error) {
+	configSet := &PushConfigFCMSetEvent{
+		BaseEvent: *eventstore.BaseEventFromRepo(event),
+	}
+	err := event.Unmarshal(configSet)
+	if err != nil {
+		return nil, zerrors.ThrowInternal(err, "IAM-Pf3nq", "unable to unmarshal push config fcm set")
+	}
+
+	return configSet, nil
+}
+
+type PushConfigAPNsSetEvent struct {
+	eventstore.BaseEvent `json:"-"`
+
+	KeyID      string              `json:"keyId,omitempty"`
+	TeamID     string              `json:"teamId,omitempty"`
+	BundleID   string              `json:"bundleId,omitempty"`
+	PrivateKey *crypto.CryptoValue `json:"privateKey,omitempty"`
+	Production bool                `json:"production,omitempty"`
+}
+
+func NewPushConfigAPNsSetEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	keyID,
+	teamID,
+	bundleID string,
+	privateKey *crypto.CryptoValue,
+	production bool,
+) *PushConfigAPNsSetEvent {
+	return &PushConfigAPNsSetEvent{
+		BaseEvent: *eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			PushConfigAPNsSetEventType,
+		),
+		KeyID:      keyID,
+		TeamID:     teamID,
+		BundleID:   bundleID,
+		PrivateKey: privateKey,
+		Production: production,
+	}
+}
+
+func (e *PushConfigAPNsSetEvent) Payload() interface{} {
+	return e
+}
+
+func (e *PushConfigAPNsSetEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+func PushConfigAPNsSetEventMapper(event eventstore.Event) (eventstore.Event, error) {
+	configSet := &PushConfigAPNsSetEvent{
+		BaseEvent: *eventstore.BaseEventFromRepo(event),
+	}
+	err := event.Unmarshal(configSet)
+	if err != nil {
+		return nil, zerrors.ThrowInternal(err, "IAM-Pa8vd", "unable to unmarshal push config apns set")
+	}
+
+	return configSet, nil
+}
+
+type PushConfigRemovedEvent struct {
+	eventstore.BaseEvent `json:"-"`
+
+	Platform domain.PushPlatform `json:"platform"`
+}
+
+func NewPushConfigRemovedEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	platform domain.PushPlatform,
+) *PushConfigRemovedEvent {
+	return &PushConfigRemovedEvent{
+		BaseEvent: *eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			PushConfigRemovedEventType,
+		),
+		Platform: platform,
+	}
+}
+
+func (e *PushConfigRemovedEvent) Payload() interface{} {
+	return e
+}
+
+func (e *PushConfigRemovedEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+func PushConfigRemovedEventMapper(event eventstore.Event) (eventstore.Event, error) {
+	configRemoved := &PushConfigRemovedEvent{
+		BaseEvent: *eventstore.BaseEventFromRepo(event),
+	}
+	err := event.Unmarshal(configRemoved)
+	if err != nil {
+		return nil, zerrors.ThrowInternal(err, "IAM-Pr2xk", "unable to unmarshal push config removed")
+	}
+
+	return configRemoved, nil
+}
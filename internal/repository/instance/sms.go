@@ -17,6 +17,12 @@ const (
 	SMSConfigActivatedEventType          = instanceEventTypePrefix + smsConfigPrefix + smsConfigTwilioPrefix + "activated"
 	SMSConfigDeactivatedEventType        = instanceEventTypePrefix + smsConfigPrefix + smsConfigTwilioPrefix + "deactivated"
 	SMSConfigRemovedEventType            = instanceEventTypePrefix + smsConfigPrefix + smsConfigTwilioPrefix + "removed"
+
+	smsConfigHTTPPrefix           = "http."
+	SMSConfigHTTPAddedEventType   = instanceEventTypePrefix + smsConfigPrefix + smsConfigHTTPPrefix + "added"
+	SMSConfigHTTPChangedEventType = instanceEventTypePrefix + smsConfigPrefix + smsConfigHTTPPrefix + "changed"
+
+	SMSConfigRoutingSetEventType = instanceEventTypePrefix + smsConfigPrefix + "routing.set"
 )
 
 type SMSConfigTwilioAddedEvent struct {
@@ -297,3 +303,173 @@ func SMSConfigRemovedEventMapper(event eventstore.Event) (eventstore.Event, erro
 
 	return smsConfigRemoved, nil
 }
+
+type SMSConfigHTTPAddedEvent struct {
+	eventstore.BaseEvent `json:"-"`
+
+	ID          string              `json:"id,omitempty"`
+	Description string              `json:"description,omitempty"`
+	Endpoint    string              `json:"endpoint,omitempty"`
+	SigningKey  *crypto.CryptoValue `json:"signingKey,omitempty"`
+}
+
+func NewSMSConfigHTTPAddedEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	id,
+	description,
+	endpoint string,
+	signingKey *crypto.CryptoValue,
+) *SMSConfigHTTPAddedEvent {
+	return &SMSConfigHTTPAddedEvent{
+		BaseEvent: *eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			SMSConfigHTTPAddedEventType,
+		),
+		ID:          id,
+		Description: description,
+		Endpoint:    endpoint,
+		SigningKey:  signingKey,
+	}
+}
+
+func (e *SMSConfigHTTPAddedEvent) Payload() interface{} {
+	return e
+}
+
+func (e *SMSConfigHTTPAddedEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+func SMSConfigHTTPAddedEventMapper(event eventstore.Event) (eventstore.Event, error) {
+	smsConfigAdded := &SMSConfigHTTPAddedEvent{
+		BaseEvent: *eventstore.BaseEventFromRepo(event),
+	}
+	err := event.Unmarshal(smsConfigAdded)
+	if err != nil {
+		return nil, zerrors.ThrowInternal(err, "IAM-7vg1nq0djb", "unable to unmarshal sms config http added")
+	}
+
+	return smsConfigAdded, nil
+}
+
+type SMSConfigHTTPChangedEvent struct {
+	eventstore.BaseEvent `json:"-"`
+
+	ID          string              `json:"id,omitempty"`
+	Description *string             `json:"description,omitempty"`
+	Endpoint    *string             `json:"endpoint,omitempty"`
+	SigningKey  *crypto.CryptoValue `json:"signingKey,omitempty"`
+}
+
+func NewSMSConfigHTTPChangedEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	id string,
+	changes []SMSConfigHTTPChanges,
+) (*SMSConfigHTTPChangedEvent, error) {
+	if len(changes) == 0 {
+		return nil, zerrors.ThrowPreconditionFailed(nil, "IAM-u3l8e0xk2s", "Errors.NoChangesFound")
+	}
+	changeEvent := &SMSConfigHTTPChangedEvent{
+		BaseEvent: *eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			SMSConfigHTTPChangedEventType,
+		),
+		ID: id,
+	}
+	for _, change := range changes {
+		change(changeEvent)
+	}
+	return changeEvent, nil
+}
+
+type SMSConfigHTTPChanges func(event *SMSConfigHTTPChangedEvent)
+
+func ChangeSMSConfigHTTPDescription(description string) func(event *SMSConfigHTTPChangedEvent) {
+	return func(e *SMSConfigHTTPChangedEvent) {
+		e.Description = &description
+	}
+}
+
+func ChangeSMSConfigHTTPEndpoint(endpoint string) func(event *SMSConfigHTTPChangedEvent) {
+	return func(e *SMSConfigHTTPChangedEvent) {
+		e.Endpoint = &endpoint
+	}
+}
+
+func ChangeSMSConfigHTTPSigningKey(signingKey *crypto.CryptoValue) func(event *SMSConfigHTTPChangedEvent) {
+	return func(e *SMSConfigHTTPChangedEvent) {
+		e.SigningKey = signingKey
+	}
+}
+
+func (e *SMSConfigHTTPChangedEvent) Payload() interface{} {
+	return e
+}
+
+func (e *SMSConfigHTTPChangedEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+func SMSConfigHTTPChangedEventMapper(event eventstore.Event) (eventstore.Event, error) {
+	smsConfigChanged := &SMSConfigHTTPChangedEvent{
+		BaseEvent: *eventstore.BaseEventFromRepo(event),
+	}
+	err := event.Unmarshal(smsConfigChanged)
+	if err != nil {
+		return nil, zerrors.ThrowInternal(err, "IAM-1ojz8pq5vd", "unable to unmarshal sms config http changed")
+	}
+
+	return smsConfigChanged, nil
+}
+
+// SMSConfigRoutingSetEvent sets the priority and country prefixes used to choose between multiple active sms providers.
+type SMSConfigRoutingSetEvent struct {
+	eventstore.BaseEvent `json:"-"`
+
+	ID           string   `json:"id,omitempty"`
+	Priority     uint32   `json:"priority,omitempty"`
+	CountryCodes []string `json:"countryCodes,omitempty"`
+}
+
+func NewSMSConfigRoutingSetEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	id string,
+	priority uint32,
+	countryCodes []string,
+) *SMSConfigRoutingSetEvent {
+	return &SMSConfigRoutingSetEvent{
+		BaseEvent: *eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			SMSConfigRoutingSetEventType,
+		),
+		ID:           id,
+		Priority:     priority,
+		CountryCodes: countryCodes,
+	}
+}
+
+func (e *SMSConfigRoutingSetEvent) Payload() interface{} {
+	return e
+}
+
+func (e *SMSConfigRoutingSetEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+func SMSConfigRoutingSetEventMapper(event eventstore.Event) (eventstore.Event, error) {
+	routingSet := &SMSConfigRoutingSetEvent{
+		BaseEvent: *eventstore.BaseEventFromRepo(event),
+	}
+	err := event.Unmarshal(routingSet)
+	if err != nil {
+		return nil, zerrors.ThrowInternal(err, "IAM-q0bvw5m2ty", "unable to unmarshal sms config routing set")
+	}
+
+	return routingSet, nil
+}
@@ -16,6 +16,8 @@ const (
 	SMTPConfigRemovedEventType         = instanceEventTypePrefix + smtpConfigPrefix + "removed"
 	SMTPConfigActivatedEventType       = instanceEventTypePrefix + smtpConfigPrefix + "activated"
 	SMTPConfigDeactivatedEventType     = instanceEventTypePrefix + smtpConfigPrefix + "deactivated"
+	SMTPConfigHTTPAddedEventType       = instanceEventTypePrefix + smtpConfigPrefix + "http.added"
+	SMTPConfigHTTPChangedEventType     = instanceEventTypePrefix + smtpConfigPrefix + "http.changed"
 )
 
 type SMTPConfigAddedEvent struct {
@@ -356,3 +358,123 @@ func SMTPConfigRemovedEventMapper(event eventstore.Event) (eventstore.Event, err
 
 	return smtpConfigRemoved, nil
 }
+
+type SMTPConfigHTTPAddedEvent struct {
+	eventstore.BaseEvent `json:"-"`
+
+	ID          string              `json:"id,omitempty"`
+	Description string              `json:"description,omitempty"`
+	Endpoint    string              `json:"endpoint,omitempty"`
+	SigningKey  *crypto.CryptoValue `json:"signingKey,omitempty"`
+}
+
+func NewSMTPConfigHTTPAddedEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	id, description string,
+	endpoint string,
+	signingKey *crypto.CryptoValue,
+) *SMTPConfigHTTPAddedEvent {
+	return &SMTPConfigHTTPAddedEvent{
+		BaseEvent: *eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			SMTPConfigHTTPAddedEventType,
+		),
+		ID:          id,
+		Description: description,
+		Endpoint:    endpoint,
+		SigningKey:  signingKey,
+	}
+}
+
+func (e *SMTPConfigHTTPAddedEvent) Payload() interface{} {
+	return e
+}
+
+func (e *SMTPConfigHTTPAddedEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+func SMTPConfigHTTPAddedEventMapper(event eventstore.Event) (eventstore.Event, error) {
+	smtpConfigAdded := &SMTPConfigHTTPAddedEvent{
+		BaseEvent: *eventstore.BaseEventFromRepo(event),
+	}
+	err := event.Unmarshal(smtpConfigAdded)
+	if err != nil {
+		return nil, zerrors.ThrowInternal(err, "IAM-2rcx6u9kwe", "unable to unmarshal smtp config http added")
+	}
+
+	return smtpConfigAdded, nil
+}
+
+type SMTPConfigHTTPChangedEvent struct {
+	eventstore.BaseEvent `json:"-"`
+	ID                   string              `json:"id,omitempty"`
+	Description          *string             `json:"description,omitempty"`
+	Endpoint             *string             `json:"endpoint,omitempty"`
+	SigningKey           *crypto.CryptoValue `json:"signingKey,omitempty"`
+}
+
+func (e *SMTPConfigHTTPChangedEvent) Payload() interface{} {
+	return e
+}
+
+func (e *SMTPConfigHTTPChangedEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+func NewSMTPConfigHTTPChangedEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	id string,
+	changes []SMTPConfigHTTPChanges,
+) (*SMTPConfigHTTPChangedEvent, error) {
+	if len(changes) == 0 {
+		return nil, zerrors.ThrowPreconditionFailed(nil, "IAM-9ts3lmk0fh", "Errors.NoChangesFound")
+	}
+	changeEvent := &SMTPConfigHTTPChangedEvent{
+		BaseEvent: *eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			SMTPConfigHTTPChangedEventType,
+		),
+		ID: id,
+	}
+	for _, change := range changes {
+		change(changeEvent)
+	}
+	return changeEvent, nil
+}
+
+type SMTPConfigHTTPChanges func(event *SMTPConfigHTTPChangedEvent)
+
+func ChangeSMTPConfigHTTPDescription(description string) func(event *SMTPConfigHTTPChangedEvent) {
+	return func(e *SMTPConfigHTTPChangedEvent) {
+		e.Description = &description
+	}
+}
+
+func ChangeSMTPConfigHTTPEndpoint(endpoint string) func(event *SMTPConfigHTTPChangedEvent) {
+	return func(e *SMTPConfigHTTPChangedEvent) {
+		e.Endpoint = &endpoint
+	}
+}
+
+func ChangeSMTPConfigHTTPSigningKey(signingKey *crypto.CryptoValue) func(event *SMTPConfigHTTPChangedEvent) {
+	return func(e *SMTPConfigHTTPChangedEvent) {
+		e.SigningKey = signingKey
+	}
+}
+
+func SMTPConfigHTTPChangedEventMapper(event eventstore.Event) (eventstore.Event, error) {
+	smtpConfigChanged := &SMTPConfigHTTPChangedEvent{
+		BaseEvent: *eventstore.BaseEventFromRepo(event),
+	}
+	err := event.Unmarshal(smtpConfigChanged)
+	if err != nil {
+		return nil, zerrors.ThrowInternal(err, "IAM-5fzb0yq8ni", "unable to unmarshal smtp config http changed")
+	}
+
+	return smtpConfigChanged, nil
+}
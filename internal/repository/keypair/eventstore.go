@@ -7,4 +7,5 @@ import (
 func init() {
 	eventstore.RegisterFilterEventMapper(AggregateType, AddedEventType, AddedEventMapper)
 	eventstore.RegisterFilterEventMapper(AggregateType, AddedCertificateEventType, AddedCertificateEventMapper)
+	eventstore.RegisterFilterEventMapper(AggregateType, RotatedEventType, RotatedEventMapper)
 }
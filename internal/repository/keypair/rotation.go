@@ -0,0 +1,62 @@
+package keypair
+
+import (
+	"context"
+	"time"
+
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+const (
+	RotatedEventType = eventTypePrefix + "rotated"
+)
+
+// RotatedEvent is pushed on the replaced key pair when a new signing key pair was generated by the scheduled rotation.
+// The private key is no longer used for signing, the public key stays published until PublicKeyExpiry.
+type RotatedEvent struct {
+	eventstore.BaseEvent `json:"-"`
+
+	NextKeyID        string    `json:"nextKeyId"`
+	PrivateKeyExpiry time.Time `json:"privateKeyExpiry"`
+	PublicKeyExpiry  time.Time `json:"publicKeyExpiry"`
+}
+
+func (e *RotatedEvent) Payload() interface{} {
+	return e
+}
+
+func (e *RotatedEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+func NewRotatedEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	nextKeyID string,
+	privateKeyExpiration,
+	publicKeyExpiration time.Time) *RotatedEvent {
+	return &RotatedEvent{
+		BaseEvent: *eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			RotatedEventType,
+		),
+		NextKeyID:        nextKeyID,
+		PrivateKeyExpiry: privateKeyExpiration,
+		PublicKeyExpiry:  publicKeyExpiration,
+	}
+}
+
+func RotatedEventMapper(event eventstore.Event) (eventstore.Event, error) {
+	e := &RotatedEvent{
+		BaseEvent: *eventstore.BaseEventFromRepo(event),
+	}
+
+	err := event.Unmarshal(e)
+	if err != nil {
+		return nil, zerrors.ThrowInternal(err, "KEY-Rw2pq", "unable to unmarshal key pair rotated")
+	}
+
+	return e, nil
+}
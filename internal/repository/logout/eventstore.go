@@ -0,0 +1,10 @@
+package logout
+
+import (
+	"github.com/zitadel/zitadel/internal/eventstore"
+)
+
+func init() {
+	eventstore.RegisterFilterEventMapper(AggregateType, StartedType, eventstore.GenericEventMapper[StartedEvent])
+	eventstore.RegisterFilterEventMapper(AggregateType, TargetReportedType, eventstore.GenericEventMapper[TargetReportedEvent])
+}
@@ -0,0 +1,104 @@
+package logout
+
+import (
+	"context"
+
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+)
+
+const (
+	logoutEventPrefix  = "logout."
+	StartedType        = logoutEventPrefix + "started"
+	TargetReportedType = logoutEventPrefix + "target.reported"
+)
+
+// StartedEvent is pushed when the session of the user was terminated
+// and the relying parties and identity providers of it have to be logged out.
+type StartedEvent struct {
+	eventstore.BaseEvent `json:"-"`
+
+	UserID                string                 `json:"userID"`
+	SessionID             string                 `json:"sessionID,omitempty"`
+	UserAgentID           string                 `json:"userAgentID,omitempty"`
+	PostLogoutRedirectURI string                 `json:"postLogoutRedirectURI,omitempty"`
+	Targets               []*domain.LogoutTarget `json:"targets"`
+}
+
+func (e *StartedEvent) Payload() interface{} {
+	return e
+}
+
+func (e *StartedEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+func (e *StartedEvent) SetBaseEvent(event *eventstore.BaseEvent) {
+	e.BaseEvent = *event
+}
+
+func NewStartedEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	userID,
+	sessionID,
+	userAgentID,
+	postLogoutRedirectURI string,
+	targets []*domain.LogoutTarget,
+) *StartedEvent {
+	return &StartedEvent{
+		BaseEvent: *eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			StartedType,
+		),
+		UserID:                userID,
+		SessionID:             sessionID,
+		UserAgentID:           userAgentID,
+		PostLogoutRedirectURI: postLogoutRedirectURI,
+		Targets:               targets,
+	}
+}
+
+// TargetReportedEvent reports the result of the logout of a single target.
+type TargetReportedEvent struct {
+	eventstore.BaseEvent `json:"-"`
+
+	TargetID   string                   `json:"targetID"`
+	TargetType domain.LogoutTargetType  `json:"targetType"`
+	State      domain.LogoutTargetState `json:"state"`
+	Reason     string                   `json:"reason,omitempty"`
+}
+
+func (e *TargetReportedEvent) Payload() interface{} {
+	return e
+}
+
+func (e *TargetReportedEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+func (e *TargetReportedEvent) SetBaseEvent(event *eventstore.BaseEvent) {
+	e.BaseEvent = *event
+}
+
+func NewTargetReportedEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	targetID string,
+	targetType domain.LogoutTargetType,
+	state domain.LogoutTargetState,
+	reason string,
+) *TargetReportedEvent {
+	return &TargetReportedEvent{
+		BaseEvent: *eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			TargetReportedType,
+		),
+		TargetID:   targetID,
+		TargetType: targetType,
+		State:      state,
+		Reason:     reason,
+	}
+}
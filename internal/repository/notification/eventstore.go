@@ -0,0 +1,16 @@
+package notification
+
+import (
+	"github.com/zitadel/zitadel/internal/eventstore"
+)
+
+func init() {
+	eventstore.RegisterFilterEventMapper(AggregateType, DeliveredEventType, DeliveredEventMapper)
+	eventstore.RegisterFilterEventMapper(AggregateType, DeliveryFailedEventType, DeliveryFailedEventMapper)
+	eventstore.RegisterFilterEventMapper(AggregateType, ReceiptReceivedEventType, ReceiptReceivedEventMapper)
+	eventstore.RegisterFilterEventMapper(AggregateType, QueuedEventType, QueuedEventMapper)
+	eventstore.RegisterFilterEventMapper(AggregateType, RetryScheduledEventType, RetryScheduledEventMapper)
+	eventstore.RegisterFilterEventMapper(AggregateType, DeadLetteredEventType, DeadLetteredEventMapper)
+	eventstore.RegisterFilterEventMapper(AggregateType, RequeuedEventType, RequeuedEventMapper)
+	eventstore.RegisterFilterEventMapper(AggregateType, ResentEventType, ResentEventMapper)
+}
@@ -0,0 +1,146 @@
+package notification
+
+import (
+	"context"
+
+	"github.com/zitadel/zitadel/internal/eventstore"
+)
+
+const (
+	eventTypePrefix          = eventstore.EventType("notification.")
+	DeliveredEventType       = eventTypePrefix + "delivered"
+	DeliveryFailedEventType  = eventTypePrefix + "delivery.failed"
+	ReceiptReceivedEventType = eventTypePrefix + "receipt.received"
+)
+
+const (
+	DeliveryChannelEmail = "email"
+	DeliveryChannelSMS   = "sms"
+)
+
+// Delivery describes which provider was used to deliver a notification
+// and which event triggered it
+type Delivery struct {
+	Channel               string               `json:"channel,omitempty"`
+	ProviderID            string               `json:"providerId,omitempty"`
+	TriggeringAggregateID string               `json:"triggeringAggregateId,omitempty"`
+	TriggeringEventType   eventstore.EventType `json:"triggeringEventType,omitempty"`
+}
+
+type DeliveredEvent struct {
+	*eventstore.BaseEvent `json:"-"`
+	Delivery
+}
+
+func (e *DeliveredEvent) Payload() any {
+	return e
+}
+
+func (e *DeliveredEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+func (e *DeliveredEvent) SetBaseEvent(b *eventstore.BaseEvent) {
+	e.BaseEvent = b
+}
+
+func NewDeliveredEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	delivery Delivery,
+) *DeliveredEvent {
+	return &DeliveredEvent{
+		BaseEvent: eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			DeliveredEventType,
+		),
+		Delivery: delivery,
+	}
+}
+
+var DeliveredEventMapper = eventstore.GenericEventMapper[DeliveredEvent]
+
+type DeliveryFailedEvent struct {
+	*eventstore.BaseEvent `json:"-"`
+	Delivery
+	Reason string `json:"reason,omitempty"`
+}
+
+func (e *DeliveryFailedEvent) Payload() any {
+	return e
+}
+
+func (e *DeliveryFailedEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+func (e *DeliveryFailedEvent) SetBaseEvent(b *eventstore.BaseEvent) {
+	e.BaseEvent = b
+}
+
+func NewDeliveryFailedEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	delivery Delivery,
+	reason string,
+) *DeliveryFailedEvent {
+	return &DeliveryFailedEvent{
+		BaseEvent: eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			DeliveryFailedEventType,
+		),
+		Delivery: delivery,
+		Reason:   reason,
+	}
+}
+
+var DeliveryFailedEventMapper = eventstore.GenericEventMapper[DeliveryFailedEvent]
+
+const (
+	ReceiptStatusDelivered = "delivered"
+	ReceiptStatusFailed    = "failed"
+)
+
+// ReceiptReceivedEvent is the delivery receipt a provider reported back
+// for a message it had accepted
+type ReceiptReceivedEvent struct {
+	*eventstore.BaseEvent `json:"-"`
+	ProviderID            string `json:"providerId,omitempty"`
+	Status                string `json:"status,omitempty"`
+	Reason                string `json:"reason,omitempty"`
+}
+
+func (e *ReceiptReceivedEvent) Payload() any {
+	return e
+}
+
+func (e *ReceiptReceivedEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+func (e *ReceiptReceivedEvent) SetBaseEvent(b *eventstore.BaseEvent) {
+	e.BaseEvent = b
+}
+
+func NewReceiptReceivedEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	providerID,
+	status,
+	reason string,
+) *ReceiptReceivedEvent {
+	return &ReceiptReceivedEvent{
+		BaseEvent: eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			ReceiptReceivedEventType,
+		),
+		ProviderID: providerID,
+		Status:     status,
+		Reason:     reason,
+	}
+}
+
+var ReceiptReceivedEventMapper = eventstore.GenericEventMapper[ReceiptReceivedEvent]
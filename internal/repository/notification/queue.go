@@ -0,0 +1,210 @@
+package notification
+
+import (
+	"context"
+	"time"
+
+	"github.com/zitadel/zitadel/internal/crypto"
+	"github.com/zitadel/zitadel/internal/eventstore"
+)
+
+const (
+	QueuedEventType         = eventTypePrefix + "queued"
+	RetryScheduledEventType = eventTypePrefix + "retry.scheduled"
+	DeadLetteredEventType   = eventTypePrefix + "dead.lettered"
+	RequeuedEventType       = eventTypePrefix + "requeued"
+	ResentEventType         = eventTypePrefix + "resent"
+)
+
+// Queued describes a notification, which could not be sent immediately
+// and is retried asynchronously
+type Queued struct {
+	Channel               string               `json:"channel,omitempty"`
+	Recipient             string               `json:"recipient,omitempty"`
+	TriggeringAggregateID string               `json:"triggeringAggregateId,omitempty"`
+	TriggeringEventType   eventstore.EventType `json:"triggeringEventType,omitempty"`
+}
+
+// QueuedEvent is written if the first delivery of a notification failed.
+// The rendered message is stored encrypted, as it might contain codes and links
+type QueuedEvent struct {
+	*eventstore.BaseEvent `json:"-"`
+	Queued
+	Message     *crypto.CryptoValue `json:"message,omitempty"`
+	Reason      string              `json:"reason,omitempty"`
+	NextAttempt time.Time           `json:"nextAttempt,omitempty"`
+}
+
+func (e *QueuedEvent) Payload() any {
+	return e
+}
+
+func (e *QueuedEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+func (e *QueuedEvent) SetBaseEvent(b *eventstore.BaseEvent) {
+	e.BaseEvent = b
+}
+
+func NewQueuedEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	queued Queued,
+	message *crypto.CryptoValue,
+	reason string,
+	nextAttempt time.Time,
+) *QueuedEvent {
+	return &QueuedEvent{
+		BaseEvent: eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			QueuedEventType,
+		),
+		Queued:      queued,
+		Message:     message,
+		Reason:      reason,
+		NextAttempt: nextAttempt,
+	}
+}
+
+var QueuedEventMapper = eventstore.GenericEventMapper[QueuedEvent]
+
+// RetryScheduledEvent is written if a retry of a queued notification failed
+// and the maximum number of attempts is not reached yet
+type RetryScheduledEvent struct {
+	*eventstore.BaseEvent `json:"-"`
+	Reason                string    `json:"reason,omitempty"`
+	NextAttempt           time.Time `json:"nextAttempt,omitempty"`
+}
+
+func (e *RetryScheduledEvent) Payload() any {
+	return e
+}
+
+func (e *RetryScheduledEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+func (e *RetryScheduledEvent) SetBaseEvent(b *eventstore.BaseEvent) {
+	e.BaseEvent = b
+}
+
+func NewRetryScheduledEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	reason string,
+	nextAttempt time.Time,
+) *RetryScheduledEvent {
+	return &RetryScheduledEvent{
+		BaseEvent: eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			RetryScheduledEventType,
+		),
+		Reason:      reason,
+		NextAttempt: nextAttempt,
+	}
+}
+
+var RetryScheduledEventMapper = eventstore.GenericEventMapper[RetryScheduledEvent]
+
+// DeadLetteredEvent is written if the last attempt of a queued notification failed.
+// The notification is not retried anymore until it's requeued
+type DeadLetteredEvent struct {
+	*eventstore.BaseEvent `json:"-"`
+	Reason                string `json:"reason,omitempty"`
+}
+
+func (e *DeadLetteredEvent) Payload() any {
+	return e
+}
+
+func (e *DeadLetteredEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+func (e *DeadLetteredEvent) SetBaseEvent(b *eventstore.BaseEvent) {
+	e.BaseEvent = b
+}
+
+func NewDeadLetteredEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	reason string,
+) *DeadLetteredEvent {
+	return &DeadLetteredEvent{
+		BaseEvent: eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			DeadLetteredEventType,
+		),
+		Reason: reason,
+	}
+}
+
+var DeadLetteredEventMapper = eventstore.GenericEventMapper[DeadLetteredEvent]
+
+// RequeuedEvent is written if a dead lettered notification is requeued manually
+type RequeuedEvent struct {
+	*eventstore.BaseEvent `json:"-"`
+}
+
+func (e *RequeuedEvent) Payload() any {
+	return e
+}
+
+func (e *RequeuedEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+func (e *RequeuedEvent) SetBaseEvent(b *eventstore.BaseEvent) {
+	e.BaseEvent = b
+}
+
+func NewRequeuedEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+) *RequeuedEvent {
+	return &RequeuedEvent{
+		BaseEvent: eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			RequeuedEventType,
+		),
+	}
+}
+
+var RequeuedEventMapper = eventstore.GenericEventMapper[RequeuedEvent]
+
+// ResentEvent is written if a queued notification was sent successfully
+type ResentEvent struct {
+	*eventstore.BaseEvent `json:"-"`
+}
+
+func (e *ResentEvent) Payload() any {
+	return e
+}
+
+func (e *ResentEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+func (e *ResentEvent) SetBaseEvent(b *eventstore.BaseEvent) {
+	e.BaseEvent = b
+}
+
+func NewResentEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+) *ResentEvent {
+	return &ResentEvent{
+		BaseEvent: eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			ResentEventType,
+		),
+	}
+}
+
+var ResentEventMapper = eventstore.GenericEventMapper[ResentEvent]
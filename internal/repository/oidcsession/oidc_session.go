@@ -85,11 +85,12 @@ func NewAddedEvent(ctx context.Context,
 type AccessTokenAddedEvent struct {
 	eventstore.BaseEvent `json:"-"`
 
-	ID       string             `json:"id,omitempty"`
-	Scope    []string           `json:"scope,omitempty"`
-	Lifetime time.Duration      `json:"lifetime,omitempty"`
-	Reason   domain.TokenReason `json:"reason,omitempty"`
-	Actor    *domain.TokenActor `json:"actor,omitempty"`
+	ID                    string             `json:"id,omitempty"`
+	Scope                 []string           `json:"scope,omitempty"`
+	Lifetime              time.Duration      `json:"lifetime,omitempty"`
+	Reason                domain.TokenReason `json:"reason,omitempty"`
+	Actor                 *domain.TokenActor `json:"actor,omitempty"`
+	CertificateThumbprint string             `json:"certificateThumbprint,omitempty"`
 }
 
 func (e *AccessTokenAddedEvent) Payload() interface{} {
@@ -112,6 +113,7 @@ func NewAccessTokenAddedEvent(
 	lifetime time.Duration,
 	reason domain.TokenReason,
 	actor *domain.TokenActor,
+	certificateThumbprint string,
 ) *AccessTokenAddedEvent {
 	return &AccessTokenAddedEvent{
 		BaseEvent: *eventstore.NewBaseEventForPush(
@@ -119,11 +121,12 @@ func NewAccessTokenAddedEvent(
 			aggregate,
 			AccessTokenAddedType,
 		),
-		ID:       id,
-		Scope:    scope,
-		Lifetime: lifetime,
-		Reason:   reason,
-		Actor:    actor,
+		ID:                    id,
+		Scope:                 scope,
+		Lifetime:              lifetime,
+		Reason:                reason,
+		Actor:                 actor,
+		CertificateThumbprint: certificateThumbprint,
 	}
 }
 
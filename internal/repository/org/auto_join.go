@@ -0,0 +1,86 @@
+package org
+
+import (
+	"context"
+
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+const (
+	autoJoinEventTypePrefix  = orgEventTypePrefix + "auto.join."
+	AutoJoinSetEventType     = autoJoinEventTypePrefix + "set"
+	AutoJoinRemovedEventType = autoJoinEventTypePrefix + "removed"
+)
+
+// AutoJoinSetEvent enables users with a verified email of a verified domain of the organization
+// to automatically become members of the organization with the roles.
+type AutoJoinSetEvent struct {
+	eventstore.BaseEvent `json:"-"`
+
+	Roles []string `json:"roles,omitempty"`
+}
+
+func (e *AutoJoinSetEvent) Payload() interface{} {
+	return e
+}
+
+func (e *AutoJoinSetEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+func NewAutoJoinSetEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	roles []string,
+) *AutoJoinSetEvent {
+	return &AutoJoinSetEvent{
+		BaseEvent: *eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			AutoJoinSetEventType,
+		),
+		Roles: roles,
+	}
+}
+
+func AutoJoinSetEventMapper(event eventstore.Event) (eventstore.Event, error) {
+	autoJoinSet := &AutoJoinSetEvent{
+		BaseEvent: *eventstore.BaseEventFromRepo(event),
+	}
+	err := event.Unmarshal(autoJoinSet)
+	if err != nil {
+		return nil, zerrors.ThrowInternal(err, "ORG-Aj1m", "unable to unmarshal auto join set")
+	}
+
+	return autoJoinSet, nil
+}
+
+// AutoJoinRemovedEvent disables the automatic membership, existing members are kept.
+type AutoJoinRemovedEvent struct {
+	eventstore.BaseEvent `json:"-"`
+}
+
+func (e *AutoJoinRemovedEvent) Payload() interface{} {
+	return nil
+}
+
+func (e *AutoJoinRemovedEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+func NewAutoJoinRemovedEvent(ctx context.Context, aggregate *eventstore.Aggregate) *AutoJoinRemovedEvent {
+	return &AutoJoinRemovedEvent{
+		BaseEvent: *eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			AutoJoinRemovedEventType,
+		),
+	}
+}
+
+func AutoJoinRemovedEventMapper(event eventstore.Event) (eventstore.Event, error) {
+	return &AutoJoinRemovedEvent{
+		BaseEvent: *eventstore.BaseEventFromRepo(event),
+	}, nil
+}
@@ -0,0 +1,171 @@
+package org
+
+import (
+	"context"
+
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+const (
+	customRoleEventTypePrefix  = orgEventTypePrefix + "custom.role."
+	CustomRoleAddedEventType   = customRoleEventTypePrefix + "added"
+	CustomRoleChangedEventType = customRoleEventTypePrefix + "changed"
+	CustomRoleRemovedEventType = customRoleEventTypePrefix + "removed"
+)
+
+type CustomRoleAddedEvent struct {
+	eventstore.BaseEvent `json:"-"`
+
+	Key         string   `json:"key"`
+	DisplayName string   `json:"displayName,omitempty"`
+	Permissions []string `json:"permissions"`
+}
+
+func (e *CustomRoleAddedEvent) Payload() interface{} {
+	return e
+}
+
+func (e *CustomRoleAddedEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+func NewCustomRoleAddedEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	key,
+	displayName string,
+	permissions []string,
+) *CustomRoleAddedEvent {
+	return &CustomRoleAddedEvent{
+		BaseEvent: *eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			CustomRoleAddedEventType,
+		),
+		Key:         key,
+		DisplayName: displayName,
+		Permissions: permissions,
+	}
+}
+
+func CustomRoleAddedEventMapper(event eventstore.Event) (eventstore.Event, error) {
+	e := &CustomRoleAddedEvent{
+		BaseEvent: *eventstore.BaseEventFromRepo(event),
+	}
+
+	err := event.Unmarshal(e)
+	if err != nil {
+		return nil, zerrors.ThrowInternal(err, "ORG-Cr3ad", "unable to unmarshal custom role")
+	}
+
+	return e, nil
+}
+
+type CustomRoleChangedEvent struct {
+	eventstore.BaseEvent `json:"-"`
+
+	Key         string   `json:"key"`
+	DisplayName *string  `json:"displayName,omitempty"`
+	Permissions []string `json:"permissions,omitempty"`
+}
+
+func (e *CustomRoleChangedEvent) Payload() interface{} {
+	return e
+}
+
+func (e *CustomRoleChangedEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+func NewCustomRoleChangedEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	key string,
+	changes []CustomRoleChanges,
+) (*CustomRoleChangedEvent, error) {
+	if len(changes) == 0 {
+		return nil, zerrors.ThrowPreconditionFailed(nil, "ORG-Cr5nc", "Errors.NoChangesFound")
+	}
+	changeEvent := &CustomRoleChangedEvent{
+		BaseEvent: *eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			CustomRoleChangedEventType,
+		),
+		Key: key,
+	}
+	for _, change := range changes {
+		change(changeEvent)
+	}
+	return changeEvent, nil
+}
+
+type CustomRoleChanges func(event *CustomRoleChangedEvent)
+
+func ChangeCustomRoleDisplayName(displayName string) func(event *CustomRoleChangedEvent) {
+	return func(e *CustomRoleChangedEvent) {
+		e.DisplayName = &displayName
+	}
+}
+
+func ChangeCustomRolePermissions(permissions []string) func(event *CustomRoleChangedEvent) {
+	return func(e *CustomRoleChangedEvent) {
+		e.Permissions = permissions
+	}
+}
+
+func CustomRoleChangedEventMapper(event eventstore.Event) (eventstore.Event, error) {
+	e := &CustomRoleChangedEvent{
+		BaseEvent: *eventstore.BaseEventFromRepo(event),
+	}
+
+	err := event.Unmarshal(e)
+	if err != nil {
+		return nil, zerrors.ThrowInternal(err, "ORG-Cr7ch", "unable to unmarshal custom role")
+	}
+
+	return e, nil
+}
+
+type CustomRoleRemovedEvent struct {
+	eventstore.BaseEvent `json:"-"`
+
+	Key string `json:"key"`
+}
+
+func (e *CustomRoleRemovedEvent) Payload() interface{} {
+	return e
+}
+
+func (e *CustomRoleRemovedEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+func NewCustomRoleRemovedEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	key string,
+) *CustomRoleRemovedEvent {
+	return &CustomRoleRemovedEvent{
+		BaseEvent: *eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			CustomRoleRemovedEventType,
+		),
+		Key: key,
+	}
+}
+
+func CustomRoleRemovedEventMapper(event eventstore.Event) (eventstore.Event, error) {
+	e := &CustomRoleRemovedEvent{
+		BaseEvent: *eventstore.BaseEventFromRepo(event),
+	}
+
+	err := event.Unmarshal(e)
+	if err != nil {
+		return nil, zerrors.ThrowInternal(err, "ORG-Cr9rm", "unable to unmarshal custom role")
+	}
+
+	return e, nil
+}
@@ -10,14 +10,17 @@ import (
 )
 
 const (
-	UniqueOrgDomain                      = "org_domain"
-	domainEventPrefix                    = orgEventTypePrefix + "domain."
-	OrgDomainAddedEventType              = domainEventPrefix + "added"
-	OrgDomainVerificationAddedEventType  = domainEventPrefix + "verification.added"
-	OrgDomainVerificationFailedEventType = domainEventPrefix + "verification.failed"
-	OrgDomainVerifiedEventType           = domainEventPrefix + "verified"
-	OrgDomainPrimarySetEventType         = domainEventPrefix + "primary.set"
-	OrgDomainRemovedEventType            = domainEventPrefix + "removed"
+	UniqueOrgDomain                           = "org_domain"
+	domainEventPrefix                         = orgEventTypePrefix + "domain."
+	OrgDomainAddedEventType                   = domainEventPrefix + "added"
+	OrgDomainVerificationAddedEventType       = domainEventPrefix + "verification.added"
+	OrgDomainVerificationFailedEventType      = domainEventPrefix + "verification.failed"
+	OrgDomainVerifiedEventType                = domainEventPrefix + "verified"
+	OrgDomainPrimarySetEventType              = domainEventPrefix + "primary.set"
+	OrgDomainRemovedEventType                 = domainEventPrefix + "removed"
+	OrgDomainUnverifiedEventType              = domainEventPrefix + "unverified"
+	OrgDomainVerificationExpiredEventType     = domainEventPrefix + "verification.expired"
+	OrgDomainVerificationExpiredSentEventType = domainEventPrefix + "verification.expired.sent"
 
 	OrgDomainSearchType          = "org_domain"
 	OrgDomainVerifiedSearchField = "verified"
@@ -339,6 +342,143 @@ func DomainRemovedEventMapper(event eventstore.Event) (eventstore.Event, error)
 	return orgDomainRemoved, nil
 }
 
+// DomainUnverifiedEvent is pushed if the verification token of a verified domain can't be found anymore,
+// the domain is no longer reserved for the organization.
+type DomainUnverifiedEvent struct {
+	eventstore.BaseEvent `json:"-"`
+
+	Domain string `json:"domain,omitempty"`
+}
+
+func (e *DomainUnverifiedEvent) Payload() interface{} {
+	return e
+}
+
+func (e *DomainUnverifiedEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return []*eventstore.UniqueConstraint{NewRemoveOrgDomainUniqueConstraint(e.Domain)}
+}
+
+func (e *DomainUnverifiedEvent) Fields() []*eventstore.FieldOperation {
+	return []*eventstore.FieldOperation{
+		eventstore.SetField(
+			e.Aggregate(),
+			domainSearchObject(e.Domain),
+			OrgDomainVerifiedSearchField,
+			&eventstore.Value{
+				Value:       false,
+				ShouldIndex: false,
+			},
+
+			eventstore.FieldTypeInstanceID,
+			eventstore.FieldTypeResourceOwner,
+			eventstore.FieldTypeAggregateType,
+			eventstore.FieldTypeAggregateID,
+			eventstore.FieldTypeObjectType,
+			eventstore.FieldTypeObjectID,
+			eventstore.FieldTypeFieldName,
+		),
+	}
+}
+
+func NewDomainUnverifiedEvent(ctx context.Context, aggregate *eventstore.Aggregate, domain string) *DomainUnverifiedEvent {
+	return &DomainUnverifiedEvent{
+		BaseEvent: *eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			OrgDomainUnverifiedEventType,
+		),
+		Domain: domain,
+	}
+}
+
+func DomainUnverifiedEventMapper(event eventstore.Event) (eventstore.Event, error) {
+	orgDomainUnverified := &DomainUnverifiedEvent{
+		BaseEvent: *eventstore.BaseEventFromRepo(event),
+	}
+	err := event.Unmarshal(orgDomainUnverified)
+	if err != nil {
+		return nil, zerrors.ThrowInternal(err, "ORG-Uv4rf", "unable to unmarshal org domain unverified")
+	}
+
+	return orgDomainUnverified, nil
+}
+
+// DomainVerificationExpiredEvent is pushed if the domain wasn't verified in time,
+// the owners of the organization are notified.
+type DomainVerificationExpiredEvent struct {
+	eventstore.BaseEvent `json:"-"`
+
+	Domain string `json:"domain,omitempty"`
+}
+
+func (e *DomainVerificationExpiredEvent) Payload() interface{} {
+	return e
+}
+
+func (e *DomainVerificationExpiredEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+func NewDomainVerificationExpiredEvent(ctx context.Context, aggregate *eventstore.Aggregate, domain string) *DomainVerificationExpiredEvent {
+	return &DomainVerificationExpiredEvent{
+		BaseEvent: *eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			OrgDomainVerificationExpiredEventType,
+		),
+		Domain: domain,
+	}
+}
+
+func DomainVerificationExpiredEventMapper(event eventstore.Event) (eventstore.Event, error) {
+	orgDomainVerificationExpired := &DomainVerificationExpiredEvent{
+		BaseEvent: *eventstore.BaseEventFromRepo(event),
+	}
+	err := event.Unmarshal(orgDomainVerificationExpired)
+	if err != nil {
+		return nil, zerrors.ThrowInternal(err, "ORG-Ex3pd", "unable to unmarshal org domain verification expired")
+	}
+
+	return orgDomainVerificationExpired, nil
+}
+
+type DomainVerificationExpiredSentEvent struct {
+	eventstore.BaseEvent `json:"-"`
+
+	Domain string `json:"domain,omitempty"`
+}
+
+func (e *DomainVerificationExpiredSentEvent) Payload() interface{} {
+	return e
+}
+
+func (e *DomainVerificationExpiredSentEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+func NewDomainVerificationExpiredSentEvent(ctx context.Context, aggregate *eventstore.Aggregate, domain string) *DomainVerificationExpiredSentEvent {
+	return &DomainVerificationExpiredSentEvent{
+		BaseEvent: *eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			OrgDomainVerificationExpiredSentEventType,
+		),
+		Domain: domain,
+	}
+}
+
+func DomainVerificationExpiredSentEventMapper(event eventstore.Event) (eventstore.Event, error) {
+	orgDomainVerificationExpiredSent := &DomainVerificationExpiredSentEvent{
+		BaseEvent: *eventstore.BaseEventFromRepo(event),
+	}
+	err := event.Unmarshal(orgDomainVerificationExpiredSent)
+	if err != nil {
+		return nil, zerrors.ThrowInternal(err, "ORG-Ex8sn", "unable to unmarshal org domain verification expired sent")
+	}
+
+	return orgDomainVerificationExpiredSent, nil
+}
+
 func domainSearchObject(domain string) eventstore.Object {
 	return eventstore.Object{
 		Type:     OrgDomainSearchType,
@@ -16,6 +16,25 @@ func init() {
 	eventstore.RegisterFilterEventMapper(AggregateType, OrgDomainVerifiedEventType, DomainVerifiedEventMapper)
 	eventstore.RegisterFilterEventMapper(AggregateType, OrgDomainPrimarySetEventType, DomainPrimarySetEventMapper)
 	eventstore.RegisterFilterEventMapper(AggregateType, OrgDomainRemovedEventType, DomainRemovedEventMapper)
+	eventstore.RegisterFilterEventMapper(AggregateType, OrgDomainUnverifiedEventType, DomainUnverifiedEventMapper)
+	eventstore.RegisterFilterEventMapper(AggregateType, OrgDomainVerificationExpiredEventType, DomainVerificationExpiredEventMapper)
+	eventstore.RegisterFilterEventMapper(AggregateType, OrgDomainVerificationExpiredSentEventType, DomainVerificationExpiredSentEventMapper)
+	eventstore.RegisterFilterEventMapper(AggregateType, UserLifecyclePolicySetEventType, UserLifecyclePolicySetEventMapper)
+	eventstore.RegisterFilterEventMapper(AggregateType, UserLifecyclePolicyRemovedEventType, UserLifecyclePolicyRemovedEventMapper)
+	eventstore.RegisterFilterEventMapper(AggregateType, MachineCredentialPolicySetEventType, MachineCredentialPolicySetEventMapper)
+	eventstore.RegisterFilterEventMapper(AggregateType, MachineCredentialPolicyRemovedEventType, MachineCredentialPolicyRemovedEventMapper)
+	eventstore.RegisterFilterEventMapper(AggregateType, NetworkAccessPolicySetEventType, NetworkAccessPolicySetEventMapper)
+	eventstore.RegisterFilterEventMapper(AggregateType, NetworkAccessPolicyRemovedEventType, NetworkAccessPolicyRemovedEventMapper)
+	eventstore.RegisterFilterEventMapper(AggregateType, UserAttributeSchemaSetEventType, UserAttributeSchemaSetEventMapper)
+	eventstore.RegisterFilterEventMapper(AggregateType, UserAttributeSchemaRemovedEventType, UserAttributeSchemaRemovedEventMapper)
+	eventstore.RegisterFilterEventMapper(AggregateType, ProfileRequirementsSetEventType, ProfileRequirementsSetEventMapper)
+	eventstore.RegisterFilterEventMapper(AggregateType, ProfileRequirementsRemovedEventType, ProfileRequirementsRemovedEventMapper)
+	eventstore.RegisterFilterEventMapper(AggregateType, InviteAddedEventType, InviteAddedEventMapper)
+	eventstore.RegisterFilterEventMapper(AggregateType, InviteSentEventType, InviteSentEventMapper)
+	eventstore.RegisterFilterEventMapper(AggregateType, InviteUsedEventType, InviteUsedEventMapper)
+	eventstore.RegisterFilterEventMapper(AggregateType, InviteRevokedEventType, InviteRevokedEventMapper)
+	eventstore.RegisterFilterEventMapper(AggregateType, AutoJoinSetEventType, AutoJoinSetEventMapper)
+	eventstore.RegisterFilterEventMapper(AggregateType, AutoJoinRemovedEventType, AutoJoinRemovedEventMapper)
 	eventstore.RegisterFilterEventMapper(AggregateType, MemberAddedEventType, MemberAddedEventMapper)
 	eventstore.RegisterFilterEventMapper(AggregateType, MemberChangedEventType, MemberChangedEventMapper)
 	eventstore.RegisterFilterEventMapper(AggregateType, MemberRemovedEventType, MemberRemovedEventMapper)
@@ -45,6 +64,9 @@ func init() {
 	eventstore.RegisterFilterEventMapper(AggregateType, LoginPolicyIDPProviderAddedEventType, IdentityProviderAddedEventMapper)
 	eventstore.RegisterFilterEventMapper(AggregateType, LoginPolicyIDPProviderRemovedEventType, IdentityProviderRemovedEventMapper)
 	eventstore.RegisterFilterEventMapper(AggregateType, LoginPolicyIDPProviderCascadeRemovedEventType, IdentityProviderCascadeRemovedEventMapper)
+	eventstore.RegisterFilterEventMapper(AggregateType, LoginPolicyIDPProviderButtonsSetEventType, IdentityProviderButtonsSetEventMapper)
+	eventstore.RegisterFilterEventMapper(AggregateType, LoginPolicyIDPProviderIconAddedEventType, IdentityProviderIconAddedEventMapper)
+	eventstore.RegisterFilterEventMapper(AggregateType, LoginPolicyIDPProviderIconRemovedEventType, IdentityProviderIconRemovedEventMapper)
 	eventstore.RegisterFilterEventMapper(AggregateType, DomainPolicyAddedEventType, DomainPolicyAddedEventMapper)
 	eventstore.RegisterFilterEventMapper(AggregateType, DomainPolicyChangedEventType, DomainPolicyChangedEventMapper)
 	eventstore.RegisterFilterEventMapper(AggregateType, DomainPolicyRemovedEventType, DomainPolicyRemovedEventMapper)
@@ -60,6 +82,7 @@ func init() {
 	eventstore.RegisterFilterEventMapper(AggregateType, PrivacyPolicyAddedEventType, PrivacyPolicyAddedEventMapper)
 	eventstore.RegisterFilterEventMapper(AggregateType, PrivacyPolicyChangedEventType, PrivacyPolicyChangedEventMapper)
 	eventstore.RegisterFilterEventMapper(AggregateType, PrivacyPolicyRemovedEventType, PrivacyPolicyRemovedEventMapper)
+	eventstore.RegisterFilterEventMapper(AggregateType, TermsVersionPublishedEventType, TermsVersionPublishedEventMapper)
 	eventstore.RegisterFilterEventMapper(AggregateType, MailTemplateAddedEventType, MailTemplateAddedEventMapper)
 	eventstore.RegisterFilterEventMapper(AggregateType, MailTemplateChangedEventType, MailTemplateChangedEventMapper)
 	eventstore.RegisterFilterEventMapper(AggregateType, MailTemplateRemovedEventType, MailTemplateRemovedEventMapper)
@@ -104,7 +127,14 @@ func init() {
 	eventstore.RegisterFilterEventMapper(AggregateType, AppleIDPChangedEventType, AppleIDPChangedEventMapper)
 	eventstore.RegisterFilterEventMapper(AggregateType, SAMLIDPAddedEventType, SAMLIDPAddedEventMapper)
 	eventstore.RegisterFilterEventMapper(AggregateType, SAMLIDPChangedEventType, SAMLIDPChangedEventMapper)
+	eventstore.RegisterFilterEventMapper(AggregateType, SAMLIDPCertificateChangedEventType, SAMLIDPCertificateChangedEventMapper)
+	eventstore.RegisterFilterEventMapper(AggregateType, SAMLIDPCertificateExpiringEventType, SAMLIDPCertificateExpiringEventMapper)
 	eventstore.RegisterFilterEventMapper(AggregateType, IDPRemovedEventType, IDPRemovedEventMapper)
+	eventstore.RegisterFilterEventMapper(AggregateType, IDPRoleMappingSetEventType, IDPRoleMappingSetEventMapper)
+	eventstore.RegisterFilterEventMapper(AggregateType, IDPAutoGrantsSetEventType, IDPAutoGrantsSetEventMapper)
+	eventstore.RegisterFilterEventMapper(AggregateType, IDPHealthCheckFailedEventType, IDPHealthCheckFailedEventMapper)
+	eventstore.RegisterFilterEventMapper(AggregateType, IDPHealthCheckRecoveredEventType, IDPHealthCheckRecoveredEventMapper)
+	eventstore.RegisterFilterEventMapper(AggregateType, IDPHealthCheckFailedNotificationSentEventType, IDPHealthCheckFailedNotificationSentEventMapper)
 	eventstore.RegisterFilterEventMapper(AggregateType, TriggerActionsSetEventType, TriggerActionsSetEventMapper)
 	eventstore.RegisterFilterEventMapper(AggregateType, TriggerActionsCascadeRemovedEventType, TriggerActionsCascadeRemovedEventMapper)
 	eventstore.RegisterFilterEventMapper(AggregateType, FlowClearedEventType, FlowClearedEventMapper)
@@ -114,4 +144,16 @@ func init() {
 	eventstore.RegisterFilterEventMapper(AggregateType, NotificationPolicyAddedEventType, NotificationPolicyAddedEventMapper)
 	eventstore.RegisterFilterEventMapper(AggregateType, NotificationPolicyChangedEventType, NotificationPolicyChangedEventMapper)
 	eventstore.RegisterFilterEventMapper(AggregateType, NotificationPolicyRemovedEventType, NotificationPolicyRemovedEventMapper)
+	eventstore.RegisterFilterEventMapper(AggregateType, CaptchaPolicyAddedEventType, CaptchaPolicyAddedEventMapper)
+	eventstore.RegisterFilterEventMapper(AggregateType, CaptchaPolicyChangedEventType, CaptchaPolicyChangedEventMapper)
+	eventstore.RegisterFilterEventMapper(AggregateType, CaptchaPolicyRemovedEventType, CaptchaPolicyRemovedEventMapper)
+	eventstore.RegisterFilterEventMapper(AggregateType, CustomRoleAddedEventType, CustomRoleAddedEventMapper)
+	eventstore.RegisterFilterEventMapper(AggregateType, CustomRoleChangedEventType, CustomRoleChangedEventMapper)
+	eventstore.RegisterFilterEventMapper(AggregateType, CustomRoleRemovedEventType, CustomRoleRemovedEventMapper)
+	eventstore.RegisterFilterEventMapper(AggregateType, OrgParentSetEventType, OrgParentSetEventMapper)
+	eventstore.RegisterFilterEventMapper(AggregateType, OrgParentRemovedEventType, OrgParentRemovedEventMapper)
+	eventstore.RegisterFilterEventMapper(AggregateType, OrgArchivedEventType, OrgArchivedEventMapper)
+	eventstore.RegisterFilterEventMapper(AggregateType, OrgUnarchivedEventType, OrgUnarchivedEventMapper)
+	eventstore.RegisterFilterEventMapper(AggregateType, OrgDeletionScheduledEventType, OrgDeletionScheduledEventMapper)
+	eventstore.RegisterFilterEventMapper(AggregateType, OrgDeletionCanceledEventType, OrgDeletionCanceledEventMapper)
 }
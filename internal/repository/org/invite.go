@@ -0,0 +1,212 @@
+package org
+
+import (
+	"context"
+	"time"
+
+	"github.com/zitadel/zitadel/internal/api/http"
+	"github.com/zitadel/zitadel/internal/crypto"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+const (
+	UniqueOrgInviteUsed = "org_invite_used"
+
+	inviteEventTypePrefix  = orgEventTypePrefix + "invite."
+	InviteAddedEventType   = inviteEventTypePrefix + "added"
+	InviteSentEventType    = inviteEventTypePrefix + "sent"
+	InviteUsedEventType    = inviteEventTypePrefix + "used"
+	InviteRevokedEventType = inviteEventTypePrefix + "revoked"
+)
+
+// InviteAddedEvent creates a single use invite to register a human user in the organization.
+// If the code isn't returned to the creator, the invite is sent to the email.
+type InviteAddedEvent struct {
+	eventstore.BaseEvent `json:"-"`
+
+	InviteID     string                      `json:"inviteId"`
+	Email        domain.EmailAddress         `json:"email,omitempty"`
+	Code         *crypto.CryptoValue         `json:"code,omitempty"`
+	Expiry       time.Duration               `json:"expiry,omitempty"`
+	Grants       []*domain.OrgInviteGrant    `json:"grants,omitempty"`
+	Metadata     []*domain.OrgInviteMetadata `json:"metadata,omitempty"`
+	CodeReturned bool                        `json:"codeReturned,omitempty"`
+
+	TriggeredAtOrigin string `json:"triggerOrigin,omitempty"`
+}
+
+func (e *InviteAddedEvent) Payload() interface{} {
+	return e
+}
+
+func (e *InviteAddedEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+func (e *InviteAddedEvent) TriggerOrigin() string {
+	return e.TriggeredAtOrigin
+}
+
+func NewInviteAddedEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	inviteID string,
+	email domain.EmailAddress,
+	code *crypto.CryptoValue,
+	expiry time.Duration,
+	grants []*domain.OrgInviteGrant,
+	metadata []*domain.OrgInviteMetadata,
+	codeReturned bool,
+) *InviteAddedEvent {
+	return &InviteAddedEvent{
+		BaseEvent: *eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			InviteAddedEventType,
+		),
+		InviteID:     inviteID,
+		Email:        email,
+		Code:         code,
+		Expiry:       expiry,
+		Grants:       grants,
+		Metadata:     metadata,
+		CodeReturned: codeReturned,
+
+		TriggeredAtOrigin: http.ComposedOrigin(ctx),
+	}
+}
+
+func InviteAddedEventMapper(event eventstore.Event) (eventstore.Event, error) {
+	inviteAdded := &InviteAddedEvent{
+		BaseEvent: *eventstore.BaseEventFromRepo(event),
+	}
+	err := event.Unmarshal(inviteAdded)
+	if err != nil {
+		return nil, zerrors.ThrowInternal(err, "ORG-Inv1m", "unable to unmarshal invite added")
+	}
+
+	return inviteAdded, nil
+}
+
+type InviteSentEvent struct {
+	eventstore.BaseEvent `json:"-"`
+
+	InviteID string `json:"inviteId"`
+}
+
+func (e *InviteSentEvent) Payload() interface{} {
+	return e
+}
+
+func (e *InviteSentEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+func NewInviteSentEvent(ctx context.Context, aggregate *eventstore.Aggregate, inviteID string) *InviteSentEvent {
+	return &InviteSentEvent{
+		BaseEvent: *eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			InviteSentEventType,
+		),
+		InviteID: inviteID,
+	}
+}
+
+func InviteSentEventMapper(event eventstore.Event) (eventstore.Event, error) {
+	inviteSent := &InviteSentEvent{
+		BaseEvent: *eventstore.BaseEventFromRepo(event),
+	}
+	err := event.Unmarshal(inviteSent)
+	if err != nil {
+		return nil, zerrors.ThrowInternal(err, "ORG-Inv2m", "unable to unmarshal invite sent")
+	}
+
+	return inviteSent, nil
+}
+
+// InviteUsedEvent is pushed together with the events of the registered user.
+// The unique constraint prevents that the invite is used by concurrent registrations.
+type InviteUsedEvent struct {
+	eventstore.BaseEvent `json:"-"`
+
+	InviteID string `json:"inviteId"`
+	UserID   string `json:"userId"`
+}
+
+func (e *InviteUsedEvent) Payload() interface{} {
+	return e
+}
+
+func (e *InviteUsedEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return []*eventstore.UniqueConstraint{
+		eventstore.NewAddEventUniqueConstraint(
+			UniqueOrgInviteUsed,
+			e.InviteID,
+			"Errors.Org.Invite.NotUsable",
+		),
+	}
+}
+
+func NewInviteUsedEvent(ctx context.Context, aggregate *eventstore.Aggregate, inviteID, userID string) *InviteUsedEvent {
+	return &InviteUsedEvent{
+		BaseEvent: *eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			InviteUsedEventType,
+		),
+		InviteID: inviteID,
+		UserID:   userID,
+	}
+}
+
+func InviteUsedEventMapper(event eventstore.Event) (eventstore.Event, error) {
+	inviteUsed := &InviteUsedEvent{
+		BaseEvent: *eventstore.BaseEventFromRepo(event),
+	}
+	err := event.Unmarshal(inviteUsed)
+	if err != nil {
+		return nil, zerrors.ThrowInternal(err, "ORG-Inv3m", "unable to unmarshal invite used")
+	}
+
+	return inviteUsed, nil
+}
+
+type InviteRevokedEvent struct {
+	eventstore.BaseEvent `json:"-"`
+
+	InviteID string `json:"inviteId"`
+}
+
+func (e *InviteRevokedEvent) Payload() interface{} {
+	return e
+}
+
+func (e *InviteRevokedEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+func NewInviteRevokedEvent(ctx context.Context, aggregate *eventstore.Aggregate, inviteID string) *InviteRevokedEvent {
+	return &InviteRevokedEvent{
+		BaseEvent: *eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			InviteRevokedEventType,
+		),
+		InviteID: inviteID,
+	}
+}
+
+func InviteRevokedEventMapper(event eventstore.Event) (eventstore.Event, error) {
+	inviteRevoked := &InviteRevokedEvent{
+		BaseEvent: *eventstore.BaseEventFromRepo(event),
+	}
+	err := event.Unmarshal(inviteRevoked)
+	if err != nil {
+		return nil, zerrors.ThrowInternal(err, "ORG-Inv4m", "unable to unmarshal invite revoked")
+	}
+
+	return inviteRevoked, nil
+}
@@ -0,0 +1,178 @@
+package org
+
+import (
+	"context"
+	"time"
+
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+const (
+	OrgArchivedEventType   = orgEventTypePrefix + "archived"
+	OrgUnarchivedEventType = orgEventTypePrefix + "unarchived"
+
+	orgDeletionEventTypePrefix    = orgEventTypePrefix + "deletion."
+	OrgDeletionScheduledEventType = orgDeletionEventTypePrefix + "scheduled"
+	OrgDeletionCanceledEventType  = orgDeletionEventTypePrefix + "canceled"
+)
+
+func orgStateField(aggregate *eventstore.Aggregate, state domain.OrgState) *eventstore.FieldOperation {
+	return eventstore.SetField(
+		aggregate,
+		orgSearchObject(aggregate.ID),
+		OrgStateSearchField,
+		&eventstore.Value{
+			Value:       state,
+			ShouldIndex: true,
+		},
+
+		eventstore.FieldTypeInstanceID,
+		eventstore.FieldTypeResourceOwner,
+		eventstore.FieldTypeAggregateType,
+		eventstore.FieldTypeAggregateID,
+		eventstore.FieldTypeObjectType,
+		eventstore.FieldTypeObjectID,
+		eventstore.FieldTypeFieldName,
+	)
+}
+
+// OrgArchivedEvent makes the organization read-only and blocks the logins of its users,
+// the data of the organization is retained.
+type OrgArchivedEvent struct {
+	eventstore.BaseEvent `json:"-"`
+}
+
+func (e *OrgArchivedEvent) Payload() interface{} {
+	return nil
+}
+
+func (e *OrgArchivedEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+func (e *OrgArchivedEvent) Fields() []*eventstore.FieldOperation {
+	return []*eventstore.FieldOperation{
+		orgStateField(e.Aggregate(), domain.OrgStateArchived),
+	}
+}
+
+func NewOrgArchivedEvent(ctx context.Context, aggregate *eventstore.Aggregate) *OrgArchivedEvent {
+	return &OrgArchivedEvent{
+		BaseEvent: *eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			OrgArchivedEventType,
+		),
+	}
+}
+
+func OrgArchivedEventMapper(event eventstore.Event) (eventstore.Event, error) {
+	return &OrgArchivedEvent{
+		BaseEvent: *eventstore.BaseEventFromRepo(event),
+	}, nil
+}
+
+// OrgUnarchivedEvent makes the archived organization active again.
+type OrgUnarchivedEvent struct {
+	eventstore.BaseEvent `json:"-"`
+}
+
+func (e *OrgUnarchivedEvent) Payload() interface{} {
+	return nil
+}
+
+func (e *OrgUnarchivedEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+func (e *OrgUnarchivedEvent) Fields() []*eventstore.FieldOperation {
+	return []*eventstore.FieldOperation{
+		orgStateField(e.Aggregate(), domain.OrgStateActive),
+	}
+}
+
+func NewOrgUnarchivedEvent(ctx context.Context, aggregate *eventstore.Aggregate) *OrgUnarchivedEvent {
+	return &OrgUnarchivedEvent{
+		BaseEvent: *eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			OrgUnarchivedEventType,
+		),
+	}
+}
+
+func OrgUnarchivedEventMapper(event eventstore.Event) (eventstore.Event, error) {
+	return &OrgUnarchivedEvent{
+		BaseEvent: *eventstore.BaseEventFromRepo(event),
+	}, nil
+}
+
+// OrgDeletionScheduledEvent announces the permanent deletion of the archived organization,
+// the organization can be purged as soon as the DeletionDate has passed.
+type OrgDeletionScheduledEvent struct {
+	eventstore.BaseEvent `json:"-"`
+
+	DeletionDate time.Time `json:"deletionDate"`
+}
+
+func (e *OrgDeletionScheduledEvent) Payload() interface{} {
+	return e
+}
+
+func (e *OrgDeletionScheduledEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+func NewOrgDeletionScheduledEvent(ctx context.Context, aggregate *eventstore.Aggregate, deletionDate time.Time) *OrgDeletionScheduledEvent {
+	return &OrgDeletionScheduledEvent{
+		BaseEvent: *eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			OrgDeletionScheduledEventType,
+		),
+		DeletionDate: deletionDate,
+	}
+}
+
+func OrgDeletionScheduledEventMapper(event eventstore.Event) (eventstore.Event, error) {
+	e := &OrgDeletionScheduledEvent{
+		BaseEvent: *eventstore.BaseEventFromRepo(event),
+	}
+	err := event.Unmarshal(e)
+	if err != nil {
+		return nil, zerrors.ThrowInternal(err, "ORG-Dl3sc", "unable to unmarshal org deletion scheduled")
+	}
+
+	return e, nil
+}
+
+// OrgDeletionCanceledEvent cancels the scheduled deletion of the organization.
+type OrgDeletionCanceledEvent struct {
+	eventstore.BaseEvent `json:"-"`
+}
+
+func (e *OrgDeletionCanceledEvent) Payload() interface{} {
+	return nil
+}
+
+func (e *OrgDeletionCanceledEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+func NewOrgDeletionCanceledEvent(ctx context.Context, aggregate *eventstore.Aggregate) *OrgDeletionCanceledEvent {
+	return &OrgDeletionCanceledEvent{
+		BaseEvent: *eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			OrgDeletionCanceledEventType,
+		),
+	}
+}
+
+func OrgDeletionCanceledEventMapper(event eventstore.Event) (eventstore.Event, error) {
+	return &OrgDeletionCanceledEvent{
+		BaseEvent: *eventstore.BaseEventFromRepo(event),
+	}, nil
+}
@@ -0,0 +1,81 @@
+package org
+
+import (
+	"context"
+
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+const (
+	orgParentEventTypePrefix  = orgEventTypePrefix + "parent."
+	OrgParentSetEventType     = orgParentEventTypePrefix + "set"
+	OrgParentRemovedEventType = orgParentEventTypePrefix + "removed"
+)
+
+// OrgParentSetEvent links the organization to its parent organization in the organization hierarchy.
+type OrgParentSetEvent struct {
+	eventstore.BaseEvent `json:"-"`
+
+	ParentOrgID string `json:"parentOrgId"`
+}
+
+func (e *OrgParentSetEvent) Payload() interface{} {
+	return e
+}
+
+func (e *OrgParentSetEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+func NewOrgParentSetEvent(ctx context.Context, aggregate *eventstore.Aggregate, parentOrgID string) *OrgParentSetEvent {
+	return &OrgParentSetEvent{
+		BaseEvent: *eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			OrgParentSetEventType,
+		),
+		ParentOrgID: parentOrgID,
+	}
+}
+
+func OrgParentSetEventMapper(event eventstore.Event) (eventstore.Event, error) {
+	e := &OrgParentSetEvent{
+		BaseEvent: *eventstore.BaseEventFromRepo(event),
+	}
+	err := event.Unmarshal(e)
+	if err != nil {
+		return nil, zerrors.ThrowInternal(err, "ORG-Pa3sm", "unable to unmarshal org parent set")
+	}
+
+	return e, nil
+}
+
+// OrgParentRemovedEvent makes the organization a root of the organization hierarchy.
+type OrgParentRemovedEvent struct {
+	eventstore.BaseEvent `json:"-"`
+}
+
+func (e *OrgParentRemovedEvent) Payload() interface{} {
+	return nil
+}
+
+func (e *OrgParentRemovedEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+func NewOrgParentRemovedEvent(ctx context.Context, aggregate *eventstore.Aggregate) *OrgParentRemovedEvent {
+	return &OrgParentRemovedEvent{
+		BaseEvent: *eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			OrgParentRemovedEventType,
+		),
+	}
+}
+
+func OrgParentRemovedEventMapper(event eventstore.Event) (eventstore.Event, error) {
+	return &OrgParentRemovedEvent{
+		BaseEvent: *eventstore.BaseEventFromRepo(event),
+	}, nil
+}
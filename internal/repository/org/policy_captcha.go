@@ -0,0 +1,115 @@
+package org
+
+import (
+	"context"
+
+	"github.com/zitadel/zitadel/internal/crypto"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/repository/policy"
+)
+
+var (
+	CaptchaPolicyAddedEventType   = orgEventTypePrefix + policy.CaptchaPolicyAddedEventType
+	CaptchaPolicyChangedEventType = orgEventTypePrefix + policy.CaptchaPolicyChangedEventType
+	CaptchaPolicyRemovedEventType = orgEventTypePrefix + policy.CaptchaPolicyRemovedEventType
+)
+
+type CaptchaPolicyAddedEvent struct {
+	policy.CaptchaPolicyAddedEvent
+}
+
+func NewCaptchaPolicyAddedEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	captchaType domain.CaptchaType,
+	siteKey string,
+	secret *crypto.CryptoValue,
+	enabledOnRegister,
+	enabledOnPasswordReset,
+	enabledOnPasswordless,
+	enabledOnSession bool,
+) *CaptchaPolicyAddedEvent {
+	return &CaptchaPolicyAddedEvent{
+		CaptchaPolicyAddedEvent: *policy.NewCaptchaPolicyAddedEvent(
+			eventstore.NewBaseEventForPush(
+				ctx,
+				aggregate,
+				CaptchaPolicyAddedEventType),
+			captchaType,
+			siteKey,
+			secret,
+			enabledOnRegister,
+			enabledOnPasswordReset,
+			enabledOnPasswordless,
+			enabledOnSession,
+		),
+	}
+}
+
+func CaptchaPolicyAddedEventMapper(event eventstore.Event) (eventstore.Event, error) {
+	e, err := policy.CaptchaPolicyAddedEventMapper(event)
+	if err != nil {
+		return nil, err
+	}
+
+	return &CaptchaPolicyAddedEvent{CaptchaPolicyAddedEvent: *e.(*policy.CaptchaPolicyAddedEvent)}, nil
+}
+
+type CaptchaPolicyChangedEvent struct {
+	policy.CaptchaPolicyChangedEvent
+}
+
+func NewCaptchaPolicyChangedEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	changes []policy.CaptchaPolicyChanges,
+) (*CaptchaPolicyChangedEvent, error) {
+	changedEvent, err := policy.NewCaptchaPolicyChangedEvent(
+		eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			CaptchaPolicyChangedEventType),
+		changes,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &CaptchaPolicyChangedEvent{CaptchaPolicyChangedEvent: *changedEvent}, nil
+}
+
+func CaptchaPolicyChangedEventMapper(event eventstore.Event) (eventstore.Event, error) {
+	e, err := policy.CaptchaPolicyChangedEventMapper(event)
+	if err != nil {
+		return nil, err
+	}
+
+	return &CaptchaPolicyChangedEvent{CaptchaPolicyChangedEvent: *e.(*policy.CaptchaPolicyChangedEvent)}, nil
+}
+
+type CaptchaPolicyRemovedEvent struct {
+	policy.CaptchaPolicyRemovedEvent
+}
+
+func NewCaptchaPolicyRemovedEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+) *CaptchaPolicyRemovedEvent {
+	return &CaptchaPolicyRemovedEvent{
+		CaptchaPolicyRemovedEvent: *policy.NewCaptchaPolicyRemovedEvent(
+			eventstore.NewBaseEventForPush(
+				ctx,
+				aggregate,
+				CaptchaPolicyRemovedEventType),
+		),
+	}
+}
+
+func CaptchaPolicyRemovedEventMapper(event eventstore.Event) (eventstore.Event, error) {
+	e, err := policy.CaptchaPolicyRemovedEventMapper(event)
+	if err != nil {
+		return nil, err
+	}
+
+	return &CaptchaPolicyRemovedEvent{CaptchaPolicyRemovedEvent: *e.(*policy.CaptchaPolicyRemovedEvent)}, nil
+}
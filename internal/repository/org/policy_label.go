@@ -48,6 +48,7 @@ func NewLabelPolicyAddedEvent(
 	errorMsgPopup,
 	disableWatermark bool,
 	themeMode domain.LabelPolicyThemeMode,
+	customCSS string,
 ) *LabelPolicyAddedEvent {
 	return &LabelPolicyAddedEvent{
 		LabelPolicyAddedEvent: *policy.NewLabelPolicyAddedEvent(
@@ -66,7 +67,8 @@ func NewLabelPolicyAddedEvent(
 			hideLoginNameSuffix,
 			errorMsgPopup,
 			disableWatermark,
-			themeMode),
+			themeMode,
+			customCSS),
 	}
 }
 
@@ -38,7 +38,13 @@ func NewLoginPolicyAddedEvent(
 	externalLoginCheckLifetime,
 	mfaInitSkipLifetime,
 	secondFactorCheckLifetime,
-	multiFactorCheckLifetime time.Duration,
+	multiFactorCheckLifetime,
+	trustedDeviceLifetime time.Duration,
+	sharedDevice bool,
+	sharedDeviceLifetime,
+	sharedDeviceIdleTimeout time.Duration,
+	passkeyPrompt bool,
+	passkeyPromptSnoozeLifetime time.Duration,
 ) *LoginPolicyAddedEvent {
 	return &LoginPolicyAddedEvent{
 		LoginPolicyAddedEvent: *policy.NewLoginPolicyAddedEvent(
@@ -63,6 +69,12 @@ func NewLoginPolicyAddedEvent(
 			mfaInitSkipLifetime,
 			secondFactorCheckLifetime,
 			multiFactorCheckLifetime,
+			trustedDeviceLifetime,
+			sharedDevice,
+			sharedDeviceLifetime,
+			sharedDeviceIdleTimeout,
+			passkeyPrompt,
+			passkeyPromptSnoozeLifetime,
 		),
 	}
 }
@@ -12,6 +12,9 @@ var (
 	LoginPolicyIDPProviderAddedEventType          = orgEventTypePrefix + policy.LoginPolicyIDPProviderAddedType
 	LoginPolicyIDPProviderRemovedEventType        = orgEventTypePrefix + policy.LoginPolicyIDPProviderRemovedType
 	LoginPolicyIDPProviderCascadeRemovedEventType = orgEventTypePrefix + policy.LoginPolicyIDPProviderCascadeRemovedType
+	LoginPolicyIDPProviderButtonsSetEventType     = orgEventTypePrefix + policy.LoginPolicyIDPProviderButtonsSetType
+	LoginPolicyIDPProviderIconAddedEventType      = orgEventTypePrefix + policy.LoginPolicyIDPProviderIconAddedType
+	LoginPolicyIDPProviderIconRemovedEventType    = orgEventTypePrefix + policy.LoginPolicyIDPProviderIconRemovedType
 )
 
 type IdentityProviderAddedEvent struct {
@@ -103,3 +106,88 @@ func IdentityProviderCascadeRemovedEventMapper(event eventstore.Event) (eventsto
 		IdentityProviderCascadeRemovedEvent: *e.(*policy.IdentityProviderCascadeRemovedEvent),
 	}, nil
 }
+
+type IdentityProviderButtonsSetEvent struct {
+	policy.IdentityProviderButtonsSetEvent
+}
+
+func NewIdentityProviderButtonsSetEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	buttons []*domain.IDPButton,
+) *IdentityProviderButtonsSetEvent {
+	return &IdentityProviderButtonsSetEvent{
+		IdentityProviderButtonsSetEvent: *policy.NewIdentityProviderButtonsSetEvent(
+			eventstore.NewBaseEventForPush(ctx, aggregate, LoginPolicyIDPProviderButtonsSetEventType),
+			buttons),
+	}
+}
+
+func IdentityProviderButtonsSetEventMapper(event eventstore.Event) (eventstore.Event, error) {
+	e, err := policy.IdentityProviderButtonsSetEventMapper(event)
+	if err != nil {
+		return nil, err
+	}
+
+	return &IdentityProviderButtonsSetEvent{
+		IdentityProviderButtonsSetEvent: *e.(*policy.IdentityProviderButtonsSetEvent),
+	}, nil
+}
+
+type IdentityProviderIconAddedEvent struct {
+	policy.IdentityProviderIconAddedEvent
+}
+
+func NewIdentityProviderIconAddedEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	idpConfigID,
+	storeKey string,
+) *IdentityProviderIconAddedEvent {
+	return &IdentityProviderIconAddedEvent{
+		IdentityProviderIconAddedEvent: *policy.NewIdentityProviderIconAddedEvent(
+			eventstore.NewBaseEventForPush(ctx, aggregate, LoginPolicyIDPProviderIconAddedEventType),
+			idpConfigID,
+			storeKey),
+	}
+}
+
+func IdentityProviderIconAddedEventMapper(event eventstore.Event) (eventstore.Event, error) {
+	e, err := policy.IdentityProviderIconAddedEventMapper(event)
+	if err != nil {
+		return nil, err
+	}
+
+	return &IdentityProviderIconAddedEvent{
+		IdentityProviderIconAddedEvent: *e.(*policy.IdentityProviderIconAddedEvent),
+	}, nil
+}
+
+type IdentityProviderIconRemovedEvent struct {
+	policy.IdentityProviderIconRemovedEvent
+}
+
+func NewIdentityProviderIconRemovedEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	idpConfigID,
+	storeKey string,
+) *IdentityProviderIconRemovedEvent {
+	return &IdentityProviderIconRemovedEvent{
+		IdentityProviderIconRemovedEvent: *policy.NewIdentityProviderIconRemovedEvent(
+			eventstore.NewBaseEventForPush(ctx, aggregate, LoginPolicyIDPProviderIconRemovedEventType),
+			idpConfigID,
+			storeKey),
+	}
+}
+
+func IdentityProviderIconRemovedEventMapper(event eventstore.Event) (eventstore.Event, error) {
+	e, err := policy.IdentityProviderIconRemovedEventMapper(event)
+	if err != nil {
+		return nil, err
+	}
+
+	return &IdentityProviderIconRemovedEvent{
+		IdentityProviderIconRemovedEvent: *e.(*policy.IdentityProviderIconRemovedEvent),
+	}, nil
+}
@@ -0,0 +1,92 @@
+package org
+
+import (
+	"context"
+	"time"
+
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+const (
+	machineCredentialPolicyEventTypePrefix  = orgEventTypePrefix + "policy.machine.credential."
+	MachineCredentialPolicySetEventType     = machineCredentialPolicyEventTypePrefix + "set"
+	MachineCredentialPolicyRemovedEventType = machineCredentialPolicyEventTypePrefix + "removed"
+)
+
+// MachineCredentialPolicySetEvent limits the lifetime of the keys and personal access tokens of the machine users of the organization.
+type MachineCredentialPolicySetEvent struct {
+	eventstore.BaseEvent `json:"-"`
+
+	// MaxLifetime is the longest duration a new credential can be valid for, 0 doesn't limit the lifetime
+	MaxLifetime time.Duration `json:"maxLifetime,omitempty"`
+	// NotifyBefore is the duration before a credential expires, when the owners of the organization are notified, 0 disables the notification
+	NotifyBefore time.Duration `json:"notifyBefore,omitempty"`
+}
+
+func (e *MachineCredentialPolicySetEvent) Payload() interface{} {
+	return e
+}
+
+func (e *MachineCredentialPolicySetEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+func NewMachineCredentialPolicySetEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	maxLifetime,
+	notifyBefore time.Duration,
+) *MachineCredentialPolicySetEvent {
+	return &MachineCredentialPolicySetEvent{
+		BaseEvent: *eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			MachineCredentialPolicySetEventType,
+		),
+		MaxLifetime:  maxLifetime,
+		NotifyBefore: notifyBefore,
+	}
+}
+
+func MachineCredentialPolicySetEventMapper(event eventstore.Event) (eventstore.Event, error) {
+	policySet := &MachineCredentialPolicySetEvent{
+		BaseEvent: *eventstore.BaseEventFromRepo(event),
+	}
+	err := event.Unmarshal(policySet)
+	if err != nil {
+		return nil, zerrors.ThrowInternal(err, "ORG-Mc3sm", "unable to unmarshal machine credential policy set")
+	}
+
+	return policySet, nil
+}
+
+// MachineCredentialPolicyRemovedEvent removes the limits of the machine credentials of the organization.
+// The expiration of existing credentials isn't changed.
+type MachineCredentialPolicyRemovedEvent struct {
+	eventstore.BaseEvent `json:"-"`
+}
+
+func (e *MachineCredentialPolicyRemovedEvent) Payload() interface{} {
+	return nil
+}
+
+func (e *MachineCredentialPolicyRemovedEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+func NewMachineCredentialPolicyRemovedEvent(ctx context.Context, aggregate *eventstore.Aggregate) *MachineCredentialPolicyRemovedEvent {
+	return &MachineCredentialPolicyRemovedEvent{
+		BaseEvent: *eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			MachineCredentialPolicyRemovedEventType,
+		),
+	}
+}
+
+func MachineCredentialPolicyRemovedEventMapper(event eventstore.Event) (eventstore.Event, error) {
+	return &MachineCredentialPolicyRemovedEvent{
+		BaseEvent: *eventstore.BaseEventFromRepo(event),
+	}, nil
+}
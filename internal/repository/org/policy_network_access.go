@@ -0,0 +1,87 @@
+package org
+
+import (
+	"context"
+
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+const (
+	networkAccessPolicyEventTypePrefix  = orgEventTypePrefix + "policy.network_access."
+	NetworkAccessPolicySetEventType     = networkAccessPolicyEventTypePrefix + "set"
+	NetworkAccessPolicyRemovedEventType = networkAccessPolicyEventTypePrefix + "removed"
+)
+
+// NetworkAccessPolicySetEvent restricts the client networks from which the users of the organization
+// can log in and receive tokens.
+type NetworkAccessPolicySetEvent struct {
+	eventstore.BaseEvent `json:"-"`
+
+	domain.NetworkAccessRules
+}
+
+func (e *NetworkAccessPolicySetEvent) Payload() interface{} {
+	return e
+}
+
+func (e *NetworkAccessPolicySetEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+func NewNetworkAccessPolicySetEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	rules domain.NetworkAccessRules,
+) *NetworkAccessPolicySetEvent {
+	return &NetworkAccessPolicySetEvent{
+		BaseEvent: *eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			NetworkAccessPolicySetEventType,
+		),
+		NetworkAccessRules: rules,
+	}
+}
+
+func NetworkAccessPolicySetEventMapper(event eventstore.Event) (eventstore.Event, error) {
+	policySet := &NetworkAccessPolicySetEvent{
+		BaseEvent: *eventstore.BaseEventFromRepo(event),
+	}
+	err := event.Unmarshal(policySet)
+	if err != nil {
+		return nil, zerrors.ThrowInternal(err, "ORG-Na3sm", "unable to unmarshal network access policy set")
+	}
+
+	return policySet, nil
+}
+
+// NetworkAccessPolicyRemovedEvent removes the network restrictions of the organization.
+type NetworkAccessPolicyRemovedEvent struct {
+	eventstore.BaseEvent `json:"-"`
+}
+
+func (e *NetworkAccessPolicyRemovedEvent) Payload() interface{} {
+	return nil
+}
+
+func (e *NetworkAccessPolicyRemovedEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+func NewNetworkAccessPolicyRemovedEvent(ctx context.Context, aggregate *eventstore.Aggregate) *NetworkAccessPolicyRemovedEvent {
+	return &NetworkAccessPolicyRemovedEvent{
+		BaseEvent: *eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			NetworkAccessPolicyRemovedEventType,
+		),
+	}
+}
+
+func NetworkAccessPolicyRemovedEventMapper(event eventstore.Event) (eventstore.Event, error) {
+	return &NetworkAccessPolicyRemovedEvent{
+		BaseEvent: *eventstore.BaseEventFromRepo(event),
+	}, nil
+}
@@ -0,0 +1,95 @@
+package org
+
+import (
+	"context"
+	"time"
+
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+const (
+	userLifecyclePolicyEventTypePrefix  = orgEventTypePrefix + "policy.user.lifecycle."
+	UserLifecyclePolicySetEventType     = userLifecyclePolicyEventTypePrefix + "set"
+	UserLifecyclePolicyRemovedEventType = userLifecyclePolicyEventTypePrefix + "removed"
+)
+
+// UserLifecyclePolicySetEvent defines after which duration of inactivity the human users of the organization are deactivated and deleted.
+type UserLifecyclePolicySetEvent struct {
+	eventstore.BaseEvent `json:"-"`
+
+	// DeactivateAfter is the duration of inactivity after which a user is deactivated, 0 disables the deactivation
+	DeactivateAfter time.Duration `json:"deactivateAfter,omitempty"`
+	// DeleteAfter is the duration of inactivity after which a user is deleted, 0 disables the deletion
+	DeleteAfter time.Duration `json:"deleteAfter,omitempty"`
+	// NotifyBefore is the duration before an action, when the owners of the organization are notified, 0 disables the notification
+	NotifyBefore time.Duration `json:"notifyBefore,omitempty"`
+}
+
+func (e *UserLifecyclePolicySetEvent) Payload() interface{} {
+	return e
+}
+
+func (e *UserLifecyclePolicySetEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+func NewUserLifecyclePolicySetEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	deactivateAfter,
+	deleteAfter,
+	notifyBefore time.Duration,
+) *UserLifecyclePolicySetEvent {
+	return &UserLifecyclePolicySetEvent{
+		BaseEvent: *eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			UserLifecyclePolicySetEventType,
+		),
+		DeactivateAfter: deactivateAfter,
+		DeleteAfter:     deleteAfter,
+		NotifyBefore:    notifyBefore,
+	}
+}
+
+func UserLifecyclePolicySetEventMapper(event eventstore.Event) (eventstore.Event, error) {
+	policySet := &UserLifecyclePolicySetEvent{
+		BaseEvent: *eventstore.BaseEventFromRepo(event),
+	}
+	err := event.Unmarshal(policySet)
+	if err != nil {
+		return nil, zerrors.ThrowInternal(err, "ORG-Ul3sm", "unable to unmarshal user lifecycle policy set")
+	}
+
+	return policySet, nil
+}
+
+// UserLifecyclePolicyRemovedEvent stops the deactivation and deletion of inactive users of the organization.
+type UserLifecyclePolicyRemovedEvent struct {
+	eventstore.BaseEvent `json:"-"`
+}
+
+func (e *UserLifecyclePolicyRemovedEvent) Payload() interface{} {
+	return nil
+}
+
+func (e *UserLifecyclePolicyRemovedEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+func NewUserLifecyclePolicyRemovedEvent(ctx context.Context, aggregate *eventstore.Aggregate) *UserLifecyclePolicyRemovedEvent {
+	return &UserLifecyclePolicyRemovedEvent{
+		BaseEvent: *eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			UserLifecyclePolicyRemovedEventType,
+		),
+	}
+}
+
+func UserLifecyclePolicyRemovedEventMapper(event eventstore.Event) (eventstore.Event, error) {
+	return &UserLifecyclePolicyRemovedEvent{
+		BaseEvent: *eventstore.BaseEventFromRepo(event),
+	}, nil
+}
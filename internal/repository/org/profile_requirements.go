@@ -0,0 +1,86 @@
+package org
+
+import (
+	"context"
+
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+const (
+	profileRequirementsEventTypePrefix  = orgEventTypePrefix + "profile.requirements."
+	ProfileRequirementsSetEventType     = profileRequirementsEventTypePrefix + "set"
+	ProfileRequirementsRemovedEventType = profileRequirementsEventTypePrefix + "removed"
+)
+
+// ProfileRequirementsSetEvent replaces the profile fields the human users of the organization are required to have.
+type ProfileRequirementsSetEvent struct {
+	eventstore.BaseEvent `json:"-"`
+
+	ProfileFields []domain.ProfileField `json:"fields,omitempty"`
+}
+
+func (e *ProfileRequirementsSetEvent) Payload() interface{} {
+	return e
+}
+
+func (e *ProfileRequirementsSetEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+func NewProfileRequirementsSetEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	fields []domain.ProfileField,
+) *ProfileRequirementsSetEvent {
+	return &ProfileRequirementsSetEvent{
+		BaseEvent: *eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			ProfileRequirementsSetEventType,
+		),
+		ProfileFields: fields,
+	}
+}
+
+func ProfileRequirementsSetEventMapper(event eventstore.Event) (eventstore.Event, error) {
+	requirementsSet := &ProfileRequirementsSetEvent{
+		BaseEvent: *eventstore.BaseEventFromRepo(event),
+	}
+	err := event.Unmarshal(requirementsSet)
+	if err != nil {
+		return nil, zerrors.ThrowInternal(err, "ORG-Prq3m", "unable to unmarshal profile requirements set")
+	}
+
+	return requirementsSet, nil
+}
+
+// ProfileRequirementsRemovedEvent removes the required profile fields of the human users of the organization.
+type ProfileRequirementsRemovedEvent struct {
+	eventstore.BaseEvent `json:"-"`
+}
+
+func (e *ProfileRequirementsRemovedEvent) Payload() interface{} {
+	return nil
+}
+
+func (e *ProfileRequirementsRemovedEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+func NewProfileRequirementsRemovedEvent(ctx context.Context, aggregate *eventstore.Aggregate) *ProfileRequirementsRemovedEvent {
+	return &ProfileRequirementsRemovedEvent{
+		BaseEvent: *eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			ProfileRequirementsRemovedEventType,
+		),
+	}
+}
+
+func ProfileRequirementsRemovedEventMapper(event eventstore.Event) (eventstore.Event, error) {
+	return &ProfileRequirementsRemovedEvent{
+		BaseEvent: *eventstore.BaseEventFromRepo(event),
+	}, nil
+}
@@ -0,0 +1,95 @@
+package org
+
+import (
+	"context"
+
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+const (
+	userAttributeSchemaEventTypePrefix  = orgEventTypePrefix + "user.attribute.schema."
+	UserAttributeSchemaSetEventType     = userAttributeSchemaEventTypePrefix + "set"
+	UserAttributeSchemaRemovedEventType = userAttributeSchemaEventTypePrefix + "removed"
+)
+
+type UserAttribute struct {
+	Key         string                   `json:"key"`
+	DisplayName string                   `json:"displayName,omitempty"`
+	Type        domain.UserAttributeType `json:"type"`
+	Required    bool                     `json:"required,omitempty"`
+	Pattern     string                   `json:"pattern,omitempty"`
+}
+
+// UserAttributeSchemaSetEvent replaces the custom attributes of the human users of the organization.
+type UserAttributeSchemaSetEvent struct {
+	eventstore.BaseEvent `json:"-"`
+
+	Attributes []*UserAttribute `json:"attributes,omitempty"`
+}
+
+func (e *UserAttributeSchemaSetEvent) Payload() interface{} {
+	return e
+}
+
+func (e *UserAttributeSchemaSetEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+func NewUserAttributeSchemaSetEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	attributes []*UserAttribute,
+) *UserAttributeSchemaSetEvent {
+	return &UserAttributeSchemaSetEvent{
+		BaseEvent: *eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			UserAttributeSchemaSetEventType,
+		),
+		Attributes: attributes,
+	}
+}
+
+func UserAttributeSchemaSetEventMapper(event eventstore.Event) (eventstore.Event, error) {
+	schemaSet := &UserAttributeSchemaSetEvent{
+		BaseEvent: *eventstore.BaseEventFromRepo(event),
+	}
+	err := event.Unmarshal(schemaSet)
+	if err != nil {
+		return nil, zerrors.ThrowInternal(err, "ORG-Uas3m", "unable to unmarshal user attribute schema set")
+	}
+
+	return schemaSet, nil
+}
+
+// UserAttributeSchemaRemovedEvent removes the custom attributes of the human users of the organization,
+// the values stay in the metadata of the users.
+type UserAttributeSchemaRemovedEvent struct {
+	eventstore.BaseEvent `json:"-"`
+}
+
+func (e *UserAttributeSchemaRemovedEvent) Payload() interface{} {
+	return nil
+}
+
+func (e *UserAttributeSchemaRemovedEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+func NewUserAttributeSchemaRemovedEvent(ctx context.Context, aggregate *eventstore.Aggregate) *UserAttributeSchemaRemovedEvent {
+	return &UserAttributeSchemaRemovedEvent{
+		BaseEvent: *eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			UserAttributeSchemaRemovedEventType,
+		),
+	}
+}
+
+func UserAttributeSchemaRemovedEventMapper(event eventstore.Event) (eventstore.Event, error) {
+	return &UserAttributeSchemaRemovedEvent{
+		BaseEvent: *eventstore.BaseEventFromRepo(event),
+	}, nil
+}
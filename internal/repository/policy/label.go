@@ -45,6 +45,7 @@ type LabelPolicyAddedEvent struct {
 	ErrorMsgPopup       bool                        `json:"errorMsgPopup,omitempty"`
 	DisableWatermark    bool                        `json:"disableMsgPopup,omitempty"`
 	ThemeMode           domain.LabelPolicyThemeMode `json:"themeMode,omitempty"`
+	CustomCSS           string                      `json:"customCss,omitempty"`
 }
 
 func (e *LabelPolicyAddedEvent) Payload() interface{} {
@@ -69,6 +70,7 @@ func NewLabelPolicyAddedEvent(
 	errorMsgPopup,
 	disableWatermark bool,
 	themeMode domain.LabelPolicyThemeMode,
+	customCSS string,
 ) *LabelPolicyAddedEvent {
 
 	return &LabelPolicyAddedEvent{
@@ -85,6 +87,7 @@ func NewLabelPolicyAddedEvent(
 		ErrorMsgPopup:       errorMsgPopup,
 		DisableWatermark:    disableWatermark,
 		ThemeMode:           themeMode,
+		CustomCSS:           customCSS,
 	}
 }
 
@@ -116,6 +119,7 @@ type LabelPolicyChangedEvent struct {
 	ErrorMsgPopup       *bool                        `json:"errorMsgPopup,omitempty"`
 	DisableWatermark    *bool                        `json:"disableWatermark,omitempty"`
 	ThemeMode           *domain.LabelPolicyThemeMode `json:"themeMode,omitempty"`
+	CustomCSS           *string                      `json:"customCss,omitempty"`
 }
 
 func (e *LabelPolicyChangedEvent) Payload() interface{} {
@@ -216,6 +220,12 @@ func ChangeThemeMode(themeMode domain.LabelPolicyThemeMode) func(*LabelPolicyCha
 	}
 }
 
+func ChangeCustomCSS(customCSS string) func(*LabelPolicyChangedEvent) {
+	return func(e *LabelPolicyChangedEvent) {
+		e.CustomCSS = &customCSS
+	}
+}
+
 func LabelPolicyChangedEventMapper(event eventstore.Event) (eventstore.Event, error) {
 	e := &LabelPolicyChangedEvent{
 		BaseEvent: *eventstore.BaseEventFromRepo(event),
@@ -18,23 +18,29 @@ const (
 type LoginPolicyAddedEvent struct {
 	eventstore.BaseEvent `json:"-"`
 
-	AllowUserNamePassword      bool                    `json:"allowUsernamePassword,omitempty"`
-	AllowRegister              bool                    `json:"allowRegister,omitempty"`
-	AllowExternalIDP           bool                    `json:"allowExternalIdp,omitempty"`
-	ForceMFA                   bool                    `json:"forceMFA,omitempty"`
-	ForceMFALocalOnly          bool                    `json:"forceMFALocalOnly,omitempty"`
-	HidePasswordReset          bool                    `json:"hidePasswordReset,omitempty"`
-	IgnoreUnknownUsernames     bool                    `json:"ignoreUnknownUsernames,omitempty"`
-	AllowDomainDiscovery       bool                    `json:"allowDomainDiscovery,omitempty"`
-	DisableLoginWithEmail      bool                    `json:"disableLoginWithEmail,omitempty"`
-	DisableLoginWithPhone      bool                    `json:"disableLoginWithPhone,omitempty"`
-	PasswordlessType           domain.PasswordlessType `json:"passwordlessType,omitempty"`
-	DefaultRedirectURI         string                  `json:"defaultRedirectURI,omitempty"`
-	PasswordCheckLifetime      time.Duration           `json:"passwordCheckLifetime,omitempty"`
-	ExternalLoginCheckLifetime time.Duration           `json:"externalLoginCheckLifetime,omitempty"`
-	MFAInitSkipLifetime        time.Duration           `json:"mfaInitSkipLifetime,omitempty"`
-	SecondFactorCheckLifetime  time.Duration           `json:"secondFactorCheckLifetime,omitempty"`
-	MultiFactorCheckLifetime   time.Duration           `json:"multiFactorCheckLifetime,omitempty"`
+	AllowUserNamePassword       bool                    `json:"allowUsernamePassword,omitempty"`
+	AllowRegister               bool                    `json:"allowRegister,omitempty"`
+	AllowExternalIDP            bool                    `json:"allowExternalIdp,omitempty"`
+	ForceMFA                    bool                    `json:"forceMFA,omitempty"`
+	ForceMFALocalOnly           bool                    `json:"forceMFALocalOnly,omitempty"`
+	HidePasswordReset           bool                    `json:"hidePasswordReset,omitempty"`
+	IgnoreUnknownUsernames      bool                    `json:"ignoreUnknownUsernames,omitempty"`
+	AllowDomainDiscovery        bool                    `json:"allowDomainDiscovery,omitempty"`
+	DisableLoginWithEmail       bool                    `json:"disableLoginWithEmail,omitempty"`
+	DisableLoginWithPhone       bool                    `json:"disableLoginWithPhone,omitempty"`
+	PasswordlessType            domain.PasswordlessType `json:"passwordlessType,omitempty"`
+	DefaultRedirectURI          string                  `json:"defaultRedirectURI,omitempty"`
+	PasswordCheckLifetime       time.Duration           `json:"passwordCheckLifetime,omitempty"`
+	ExternalLoginCheckLifetime  time.Duration           `json:"externalLoginCheckLifetime,omitempty"`
+	MFAInitSkipLifetime         time.Duration           `json:"mfaInitSkipLifetime,omitempty"`
+	SecondFactorCheckLifetime   time.Duration           `json:"secondFactorCheckLifetime,omitempty"`
+	MultiFactorCheckLifetime    time.Duration           `json:"multiFactorCheckLifetime,omitempty"`
+	TrustedDeviceLifetime       time.Duration           `json:"trustedDeviceLifetime,omitempty"`
+	SharedDevice                bool                    `json:"sharedDevice,omitempty"`
+	SharedDeviceLifetime        time.Duration           `json:"sharedDeviceLifetime,omitempty"`
+	SharedDeviceIdleTimeout     time.Duration           `json:"sharedDeviceIdleTimeout,omitempty"`
+	PasskeyPrompt               bool                    `json:"passkeyPrompt,omitempty"`
+	PasskeyPromptSnoozeLifetime time.Duration           `json:"passkeyPromptSnoozeLifetime,omitempty"`
 }
 
 func (e *LoginPolicyAddedEvent) Payload() interface{} {
@@ -63,27 +69,39 @@ func NewLoginPolicyAddedEvent(
 	externalLoginCheckLifetime,
 	mfaInitSkipLifetime,
 	secondFactorCheckLifetime,
-	multiFactorCheckLifetime time.Duration,
+	multiFactorCheckLifetime,
+	trustedDeviceLifetime time.Duration,
+	sharedDevice bool,
+	sharedDeviceLifetime,
+	sharedDeviceIdleTimeout time.Duration,
+	passkeyPrompt bool,
+	passkeyPromptSnoozeLifetime time.Duration,
 ) *LoginPolicyAddedEvent {
 	return &LoginPolicyAddedEvent{
-		BaseEvent:                  *base,
-		AllowExternalIDP:           allowExternalIDP,
-		AllowRegister:              allowRegister,
-		AllowUserNamePassword:      allowUserNamePassword,
-		ForceMFA:                   forceMFA,
-		ForceMFALocalOnly:          forceMFALocalOnly,
-		PasswordlessType:           passwordlessType,
-		HidePasswordReset:          hidePasswordReset,
-		IgnoreUnknownUsernames:     ignoreUnknownUsernames,
-		AllowDomainDiscovery:       allowDomainDiscovery,
-		DefaultRedirectURI:         defaultRedirectURI,
-		PasswordCheckLifetime:      passwordCheckLifetime,
-		ExternalLoginCheckLifetime: externalLoginCheckLifetime,
-		MFAInitSkipLifetime:        mfaInitSkipLifetime,
-		SecondFactorCheckLifetime:  secondFactorCheckLifetime,
-		MultiFactorCheckLifetime:   multiFactorCheckLifetime,
-		DisableLoginWithEmail:      disableLoginWithEmail,
-		DisableLoginWithPhone:      disableLoginWithPhone,
+		BaseEvent:                   *base,
+		AllowExternalIDP:            allowExternalIDP,
+		AllowRegister:               allowRegister,
+		AllowUserNamePassword:       allowUserNamePassword,
+		ForceMFA:                    forceMFA,
+		ForceMFALocalOnly:           forceMFALocalOnly,
+		PasswordlessType:            passwordlessType,
+		HidePasswordReset:           hidePasswordReset,
+		IgnoreUnknownUsernames:      ignoreUnknownUsernames,
+		AllowDomainDiscovery:        allowDomainDiscovery,
+		DefaultRedirectURI:          defaultRedirectURI,
+		PasswordCheckLifetime:       passwordCheckLifetime,
+		ExternalLoginCheckLifetime:  externalLoginCheckLifetime,
+		MFAInitSkipLifetime:         mfaInitSkipLifetime,
+		SecondFactorCheckLifetime:   secondFactorCheckLifetime,
+		MultiFactorCheckLifetime:    multiFactorCheckLifetime,
+		TrustedDeviceLifetime:       trustedDeviceLifetime,
+		SharedDevice:                sharedDevice,
+		SharedDeviceLifetime:        sharedDeviceLifetime,
+		SharedDeviceIdleTimeout:     sharedDeviceIdleTimeout,
+		PasskeyPrompt:               passkeyPrompt,
+		PasskeyPromptSnoozeLifetime: passkeyPromptSnoozeLifetime,
+		DisableLoginWithEmail:       disableLoginWithEmail,
+		DisableLoginWithPhone:       disableLoginWithPhone,
 	}
 }
 
@@ -103,23 +121,29 @@ func LoginPolicyAddedEventMapper(event eventstore.Event) (eventstore.Event, erro
 type LoginPolicyChangedEvent struct {
 	eventstore.BaseEvent `json:"-"`
 
-	AllowUserNamePassword      *bool                    `json:"allowUsernamePassword,omitempty"`
-	AllowRegister              *bool                    `json:"allowRegister,omitempty"`
-	AllowExternalIDP           *bool                    `json:"allowExternalIdp,omitempty"`
-	ForceMFA                   *bool                    `json:"forceMFA,omitempty"`
-	ForceMFALocalOnly          *bool                    `json:"forceMFALocalOnly,omitempty"`
-	HidePasswordReset          *bool                    `json:"hidePasswordReset,omitempty"`
-	IgnoreUnknownUsernames     *bool                    `json:"ignoreUnknownUsernames,omitempty"`
-	AllowDomainDiscovery       *bool                    `json:"allowDomainDiscovery,omitempty"`
-	DisableLoginWithEmail      *bool                    `json:"disableLoginWithEmail,omitempty"`
-	DisableLoginWithPhone      *bool                    `json:"disableLoginWithPhone,omitempty"`
-	PasswordlessType           *domain.PasswordlessType `json:"passwordlessType,omitempty"`
-	DefaultRedirectURI         *string                  `json:"defaultRedirectURI,omitempty"`
-	PasswordCheckLifetime      *time.Duration           `json:"passwordCheckLifetime,omitempty"`
-	ExternalLoginCheckLifetime *time.Duration           `json:"externalLoginCheckLifetime,omitempty"`
-	MFAInitSkipLifetime        *time.Duration           `json:"mfaInitSkipLifetime,omitempty"`
-	SecondFactorCheckLifetime  *time.Duration           `json:"secondFactorCheckLifetime,omitempty"`
-	MultiFactorCheckLifetime   *time.Duration           `json:"multiFactorCheckLifetime,omitempty"`
+	AllowUserNamePassword       *bool                    `json:"allowUsernamePassword,omitempty"`
+	AllowRegister               *bool                    `json:"allowRegister,omitempty"`
+	AllowExternalIDP            *bool                    `json:"allowExternalIdp,omitempty"`
+	ForceMFA                    *bool                    `json:"forceMFA,omitempty"`
+	ForceMFALocalOnly           *bool                    `json:"forceMFALocalOnly,omitempty"`
+	HidePasswordReset           *bool                    `json:"hidePasswordReset,omitempty"`
+	IgnoreUnknownUsernames      *bool                    `json:"ignoreUnknownUsernames,omitempty"`
+	AllowDomainDiscovery        *bool                    `json:"allowDomainDiscovery,omitempty"`
+	DisableLoginWithEmail       *bool                    `json:"disableLoginWithEmail,omitempty"`
+	DisableLoginWithPhone       *bool                    `json:"disableLoginWithPhone,omitempty"`
+	PasswordlessType            *domain.PasswordlessType `json:"passwordlessType,omitempty"`
+	DefaultRedirectURI          *string                  `json:"defaultRedirectURI,omitempty"`
+	PasswordCheckLifetime       *time.Duration           `json:"passwordCheckLifetime,omitempty"`
+	ExternalLoginCheckLifetime  *time.Duration           `json:"externalLoginCheckLifetime,omitempty"`
+	MFAInitSkipLifetime         *time.Duration           `json:"mfaInitSkipLifetime,omitempty"`
+	SecondFactorCheckLifetime   *time.Duration           `json:"secondFactorCheckLifetime,omitempty"`
+	MultiFactorCheckLifetime    *time.Duration           `json:"multiFactorCheckLifetime,omitempty"`
+	TrustedDeviceLifetime       *time.Duration           `json:"trustedDeviceLifetime,omitempty"`
+	SharedDevice                *bool                    `json:"sharedDevice,omitempty"`
+	SharedDeviceLifetime        *time.Duration           `json:"sharedDeviceLifetime,omitempty"`
+	SharedDeviceIdleTimeout     *time.Duration           `json:"sharedDeviceIdleTimeout,omitempty"`
+	PasskeyPrompt               *bool                    `json:"passkeyPrompt,omitempty"`
+	PasskeyPromptSnoozeLifetime *time.Duration           `json:"passkeyPromptSnoozeLifetime,omitempty"`
 }
 
 func (e *LoginPolicyChangedEvent) Payload() interface{} {
@@ -220,6 +244,42 @@ func ChangeMultiFactorCheckLifetime(multiFactorCheckLifetime time.Duration) func
 	}
 }
 
+func ChangeTrustedDeviceLifetime(trustedDeviceLifetime time.Duration) func(*LoginPolicyChangedEvent) {
+	return func(e *LoginPolicyChangedEvent) {
+		e.TrustedDeviceLifetime = &trustedDeviceLifetime
+	}
+}
+
+func ChangeSharedDevice(sharedDevice bool) func(*LoginPolicyChangedEvent) {
+	return func(e *LoginPolicyChangedEvent) {
+		e.SharedDevice = &sharedDevice
+	}
+}
+
+func ChangeSharedDeviceLifetime(sharedDeviceLifetime time.Duration) func(*LoginPolicyChangedEvent) {
+	return func(e *LoginPolicyChangedEvent) {
+		e.SharedDeviceLifetime = &sharedDeviceLifetime
+	}
+}
+
+func ChangeSharedDeviceIdleTimeout(sharedDeviceIdleTimeout time.Duration) func(*LoginPolicyChangedEvent) {
+	return func(e *LoginPolicyChangedEvent) {
+		e.SharedDeviceIdleTimeout = &sharedDeviceIdleTimeout
+	}
+}
+
+func ChangePasskeyPrompt(passkeyPrompt bool) func(*LoginPolicyChangedEvent) {
+	return func(e *LoginPolicyChangedEvent) {
+		e.PasskeyPrompt = &passkeyPrompt
+	}
+}
+
+func ChangePasskeyPromptSnoozeLifetime(passkeyPromptSnoozeLifetime time.Duration) func(*LoginPolicyChangedEvent) {
+	return func(e *LoginPolicyChangedEvent) {
+		e.PasskeyPromptSnoozeLifetime = &passkeyPromptSnoozeLifetime
+	}
+}
+
 func ChangeIgnoreUnknownUsernames(ignoreUnknownUsernames bool) func(*LoginPolicyChangedEvent) {
 	return func(e *LoginPolicyChangedEvent) {
 		e.IgnoreUnknownUsernames = &ignoreUnknownUsernames
@@ -0,0 +1,186 @@
+package policy
+
+import (
+	"github.com/zitadel/zitadel/internal/crypto"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+const (
+	CaptchaPolicyAddedEventType   = "policy.captcha.added"
+	CaptchaPolicyChangedEventType = "policy.captcha.changed"
+	CaptchaPolicyRemovedEventType = "policy.captcha.removed"
+)
+
+type CaptchaPolicyAddedEvent struct {
+	eventstore.BaseEvent `json:"-"`
+
+	CaptchaType            domain.CaptchaType  `json:"captchaType,omitempty"`
+	SiteKey                string              `json:"siteKey,omitempty"`
+	Secret                 *crypto.CryptoValue `json:"secret,omitempty"`
+	EnabledOnRegister      bool                `json:"enabledOnRegister,omitempty"`
+	EnabledOnPasswordReset bool                `json:"enabledOnPasswordReset,omitempty"`
+	EnabledOnPasswordless  bool                `json:"enabledOnPasswordless,omitempty"`
+	EnabledOnSession       bool                `json:"enabledOnSession,omitempty"`
+}
+
+func (e *CaptchaPolicyAddedEvent) Payload() interface{} {
+	return e
+}
+
+func (e *CaptchaPolicyAddedEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+func NewCaptchaPolicyAddedEvent(
+	base *eventstore.BaseEvent,
+	captchaType domain.CaptchaType,
+	siteKey string,
+	secret *crypto.CryptoValue,
+	enabledOnRegister,
+	enabledOnPasswordReset,
+	enabledOnPasswordless,
+	enabledOnSession bool,
+) *CaptchaPolicyAddedEvent {
+	return &CaptchaPolicyAddedEvent{
+		BaseEvent:              *base,
+		CaptchaType:            captchaType,
+		SiteKey:                siteKey,
+		Secret:                 secret,
+		EnabledOnRegister:      enabledOnRegister,
+		EnabledOnPasswordReset: enabledOnPasswordReset,
+		EnabledOnPasswordless:  enabledOnPasswordless,
+		EnabledOnSession:       enabledOnSession,
+	}
+}
+
+func CaptchaPolicyAddedEventMapper(event eventstore.Event) (eventstore.Event, error) {
+	e := &CaptchaPolicyAddedEvent{
+		BaseEvent: *eventstore.BaseEventFromRepo(event),
+	}
+
+	err := event.Unmarshal(e)
+	if err != nil {
+		return nil, zerrors.ThrowInternal(err, "POLIC-Cw8nr", "unable to unmarshal policy")
+	}
+
+	return e, nil
+}
+
+type CaptchaPolicyChangedEvent struct {
+	eventstore.BaseEvent `json:"-"`
+
+	CaptchaType            *domain.CaptchaType `json:"captchaType,omitempty"`
+	SiteKey                *string             `json:"siteKey,omitempty"`
+	Secret                 *crypto.CryptoValue `json:"secret,omitempty"`
+	EnabledOnRegister      *bool               `json:"enabledOnRegister,omitempty"`
+	EnabledOnPasswordReset *bool               `json:"enabledOnPasswordReset,omitempty"`
+	EnabledOnPasswordless  *bool               `json:"enabledOnPasswordless,omitempty"`
+	EnabledOnSession       *bool               `json:"enabledOnSession,omitempty"`
+}
+
+func (e *CaptchaPolicyChangedEvent) Payload() interface{} {
+	return e
+}
+
+func (e *CaptchaPolicyChangedEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+func NewCaptchaPolicyChangedEvent(
+	base *eventstore.BaseEvent,
+	changes []CaptchaPolicyChanges,
+) (*CaptchaPolicyChangedEvent, error) {
+	if len(changes) == 0 {
+		return nil, zerrors.ThrowPreconditionFailed(nil, "POLICY-Ak2ne", "Errors.NoChangesFound")
+	}
+	changeEvent := &CaptchaPolicyChangedEvent{
+		BaseEvent: *base,
+	}
+	for _, change := range changes {
+		change(changeEvent)
+	}
+	return changeEvent, nil
+}
+
+type CaptchaPolicyChanges func(*CaptchaPolicyChangedEvent)
+
+func ChangeCaptchaType(captchaType domain.CaptchaType) func(*CaptchaPolicyChangedEvent) {
+	return func(e *CaptchaPolicyChangedEvent) {
+		e.CaptchaType = &captchaType
+	}
+}
+
+func ChangeCaptchaSiteKey(siteKey string) func(*CaptchaPolicyChangedEvent) {
+	return func(e *CaptchaPolicyChangedEvent) {
+		e.SiteKey = &siteKey
+	}
+}
+
+func ChangeCaptchaSecret(secret *crypto.CryptoValue) func(*CaptchaPolicyChangedEvent) {
+	return func(e *CaptchaPolicyChangedEvent) {
+		e.Secret = secret
+	}
+}
+
+func ChangeCaptchaEnabledOnRegister(enabled bool) func(*CaptchaPolicyChangedEvent) {
+	return func(e *CaptchaPolicyChangedEvent) {
+		e.EnabledOnRegister = &enabled
+	}
+}
+
+func ChangeCaptchaEnabledOnPasswordReset(enabled bool) func(*CaptchaPolicyChangedEvent) {
+	return func(e *CaptchaPolicyChangedEvent) {
+		e.EnabledOnPasswordReset = &enabled
+	}
+}
+
+func ChangeCaptchaEnabledOnPasswordless(enabled bool) func(*CaptchaPolicyChangedEvent) {
+	return func(e *CaptchaPolicyChangedEvent) {
+		e.EnabledOnPasswordless = &enabled
+	}
+}
+
+func ChangeCaptchaEnabledOnSession(enabled bool) func(*CaptchaPolicyChangedEvent) {
+	return func(e *CaptchaPolicyChangedEvent) {
+		e.EnabledOnSession = &enabled
+	}
+}
+
+func CaptchaPolicyChangedEventMapper(event eventstore.Event) (eventstore.Event, error) {
+	e := &CaptchaPolicyChangedEvent{
+		BaseEvent: *eventstore.BaseEventFromRepo(event),
+	}
+
+	err := event.Unmarshal(e)
+	if err != nil {
+		return nil, zerrors.ThrowInternal(err, "POLIC-Oe4bz", "unable to unmarshal policy")
+	}
+
+	return e, nil
+}
+
+type CaptchaPolicyRemovedEvent struct {
+	eventstore.BaseEvent `json:"-"`
+}
+
+func (e *CaptchaPolicyRemovedEvent) Payload() interface{} {
+	return nil
+}
+
+func (e *CaptchaPolicyRemovedEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+func NewCaptchaPolicyRemovedEvent(base *eventstore.BaseEvent) *CaptchaPolicyRemovedEvent {
+	return &CaptchaPolicyRemovedEvent{
+		BaseEvent: *base,
+	}
+}
+
+func CaptchaPolicyRemovedEventMapper(event eventstore.Event) (eventstore.Event, error) {
+	return &CaptchaPolicyRemovedEvent{
+		BaseEvent: *eventstore.BaseEventFromRepo(event),
+	}, nil
+}
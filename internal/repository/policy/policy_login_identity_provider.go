@@ -11,6 +11,9 @@ const (
 	LoginPolicyIDPProviderAddedType          = loginPolicyIDPProviderPrevix + "added"
 	LoginPolicyIDPProviderRemovedType        = loginPolicyIDPProviderPrevix + "removed"
 	LoginPolicyIDPProviderCascadeRemovedType = loginPolicyIDPProviderPrevix + "cascade.removed"
+	LoginPolicyIDPProviderButtonsSetType     = loginPolicyIDPProviderPrevix + "buttons.set"
+	LoginPolicyIDPProviderIconAddedType      = loginPolicyIDPProviderPrevix + "icon.added"
+	LoginPolicyIDPProviderIconRemovedType    = loginPolicyIDPProviderPrevix + "icon.removed"
 )
 
 type IdentityProviderAddedEvent struct {
@@ -127,3 +130,122 @@ func IdentityProviderCascadeRemovedEventMapper(event eventstore.Event) (eventsto
 
 	return e, nil
 }
+
+// IdentityProviderButtonsSetEvent sets the order and the visibility of the buttons of the identity providers on the login screen
+type IdentityProviderButtonsSetEvent struct {
+	eventstore.BaseEvent `json:"-"`
+
+	Buttons []*domain.IDPButton `json:"buttons,omitempty"`
+}
+
+func (e *IdentityProviderButtonsSetEvent) Payload() interface{} {
+	return e
+}
+
+func (e *IdentityProviderButtonsSetEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+func NewIdentityProviderButtonsSetEvent(
+	base *eventstore.BaseEvent,
+	buttons []*domain.IDPButton,
+) *IdentityProviderButtonsSetEvent {
+	return &IdentityProviderButtonsSetEvent{
+		BaseEvent: *base,
+		Buttons:   buttons,
+	}
+}
+
+func IdentityProviderButtonsSetEventMapper(event eventstore.Event) (eventstore.Event, error) {
+	e := &IdentityProviderButtonsSetEvent{
+		BaseEvent: *eventstore.BaseEventFromRepo(event),
+	}
+
+	err := event.Unmarshal(e)
+	if err != nil {
+		return nil, zerrors.ThrowInternal(err, "PROVI-Ib1sE", "Errors.Internal")
+	}
+
+	return e, nil
+}
+
+// IdentityProviderIconAddedEvent sets the custom icon of the button of an identity provider on the login screen
+type IdentityProviderIconAddedEvent struct {
+	eventstore.BaseEvent `json:"-"`
+
+	IDPConfigID string `json:"idpConfigId"`
+	StoreKey    string `json:"storeKey"`
+}
+
+func (e *IdentityProviderIconAddedEvent) Payload() interface{} {
+	return e
+}
+
+func (e *IdentityProviderIconAddedEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+func NewIdentityProviderIconAddedEvent(
+	base *eventstore.BaseEvent,
+	idpConfigID,
+	storeKey string,
+) *IdentityProviderIconAddedEvent {
+	return &IdentityProviderIconAddedEvent{
+		BaseEvent:   *base,
+		IDPConfigID: idpConfigID,
+		StoreKey:    storeKey,
+	}
+}
+
+func IdentityProviderIconAddedEventMapper(event eventstore.Event) (eventstore.Event, error) {
+	e := &IdentityProviderIconAddedEvent{
+		BaseEvent: *eventstore.BaseEventFromRepo(event),
+	}
+
+	err := event.Unmarshal(e)
+	if err != nil {
+		return nil, zerrors.ThrowInternal(err, "PROVI-Ib2aE", "Errors.Internal")
+	}
+
+	return e, nil
+}
+
+type IdentityProviderIconRemovedEvent struct {
+	eventstore.BaseEvent `json:"-"`
+
+	IDPConfigID string `json:"idpConfigId"`
+	StoreKey    string `json:"storeKey"`
+}
+
+func (e *IdentityProviderIconRemovedEvent) Payload() interface{} {
+	return e
+}
+
+func (e *IdentityProviderIconRemovedEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+func NewIdentityProviderIconRemovedEvent(
+	base *eventstore.BaseEvent,
+	idpConfigID,
+	storeKey string,
+) *IdentityProviderIconRemovedEvent {
+	return &IdentityProviderIconRemovedEvent{
+		BaseEvent:   *base,
+		IDPConfigID: idpConfigID,
+		StoreKey:    storeKey,
+	}
+}
+
+func IdentityProviderIconRemovedEventMapper(event eventstore.Event) (eventstore.Event, error) {
+	e := &IdentityProviderIconRemovedEvent{
+		BaseEvent: *eventstore.BaseEventFromRepo(event),
+	}
+
+	err := event.Unmarshal(e)
+	if err != nil {
+		return nil, zerrors.ThrowInternal(err, "PROVI-Ib3rE", "Errors.Internal")
+	}
+
+	return e, nil
+}
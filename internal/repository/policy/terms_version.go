@@ -0,0 +1,55 @@
+package policy
+
+import (
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+const (
+	TermsVersionPublishedEventType = "policy.terms.version.published"
+)
+
+// TermsVersionPublishedEvent publishes a new version of the terms of service and privacy policy.
+// Users who accepted an older version have to accept the new one on their next login.
+// The links of the privacy policy at the time of publishing are recorded with the version.
+type TermsVersionPublishedEvent struct {
+	eventstore.BaseEvent `json:"-"`
+
+	Version     string `json:"version,omitempty"`
+	TOSLink     string `json:"tosLink,omitempty"`
+	PrivacyLink string `json:"privacyLink,omitempty"`
+}
+
+func (e *TermsVersionPublishedEvent) Payload() interface{} {
+	return e
+}
+
+func (e *TermsVersionPublishedEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+func NewTermsVersionPublishedEvent(
+	base *eventstore.BaseEvent,
+	version,
+	tosLink,
+	privacyLink string,
+) *TermsVersionPublishedEvent {
+	return &TermsVersionPublishedEvent{
+		BaseEvent:   *base,
+		Version:     version,
+		TOSLink:     tosLink,
+		PrivacyLink: privacyLink,
+	}
+}
+
+func TermsVersionPublishedEventMapper(event eventstore.Event) (eventstore.Event, error) {
+	e := &TermsVersionPublishedEvent{
+		BaseEvent: *eventstore.BaseEventFromRepo(event),
+	}
+	err := event.Unmarshal(e)
+	if err != nil {
+		return nil, zerrors.ThrowInternal(err, "POLIC-Tv4pu", "unable to unmarshal terms version")
+	}
+
+	return e, nil
+}
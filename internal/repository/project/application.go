@@ -3,7 +3,9 @@ package project
 import (
 	"context"
 	"fmt"
+	"time"
 
+	"github.com/zitadel/zitadel/internal/domain"
 	"github.com/zitadel/zitadel/internal/eventstore"
 	"github.com/zitadel/zitadel/internal/zerrors"
 )
@@ -16,6 +18,13 @@ const (
 	ApplicationDeactivatedType = applicationEventTypePrefix + "deactivated"
 	ApplicationReactivatedType = applicationEventTypePrefix + "reactivated"
 	ApplicationRemovedType     = applicationEventTypePrefix + "removed"
+
+	ApplicationClaimsMappingSetType    = applicationEventTypePrefix + "claims.mapping.set"
+	ApplicationLogoutConfigSetType     = applicationEventTypePrefix + "logout.config.set"
+	ApplicationAuthRequirementsSetType = applicationEventTypePrefix + "auth.requirements.set"
+	ApplicationFrameAncestorsSetType   = applicationEventTypePrefix + "frame.ancestors.set"
+	ApplicationTokenLifetimesSetType   = applicationEventTypePrefix + "token.lifetimes.set"
+	ApplicationOfflineTokensSetType    = applicationEventTypePrefix + "offline.tokens.set"
 )
 
 func NewAddApplicationUniqueConstraint(name, projectID string) *eventstore.UniqueConstraint {
@@ -169,6 +178,248 @@ func ApplicationDeactivatedEventMapper(event eventstore.Event) (eventstore.Event
 	return e, nil
 }
 
+// ApplicationClaimsMappingSetEvent replaces the claims mapping of the application.
+// An empty mapping removes it.
+type ApplicationClaimsMappingSetEvent struct {
+	eventstore.BaseEvent `json:"-"`
+
+	AppID         string                `json:"appId,omitempty"`
+	ClaimsMapping *domain.ClaimsMapping `json:"claimsMapping,omitempty"`
+}
+
+func (e *ApplicationClaimsMappingSetEvent) Payload() interface{} {
+	return e
+}
+
+func (e *ApplicationClaimsMappingSetEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+func NewApplicationClaimsMappingSetEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	appID string,
+	claimsMapping *domain.ClaimsMapping,
+) *ApplicationClaimsMappingSetEvent {
+	return &ApplicationClaimsMappingSetEvent{
+		BaseEvent: *eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			ApplicationClaimsMappingSetType,
+		),
+		AppID:         appID,
+		ClaimsMapping: claimsMapping,
+	}
+}
+
+func ApplicationClaimsMappingSetEventMapper(event eventstore.Event) (eventstore.Event, error) {
+	e := &ApplicationClaimsMappingSetEvent{
+		BaseEvent: *eventstore.BaseEventFromRepo(event),
+	}
+
+	err := event.Unmarshal(e)
+	if err != nil {
+		return nil, zerrors.ThrowInternal(err, "APPLICATION-Ck3sl", "unable to unmarshal application claims mapping")
+	}
+
+	return e, nil
+}
+
+// ApplicationLogoutConfigSetEvent replaces the endpoints the relying party is notified on
+// when the session of a user is terminated. Empty endpoints disable the notification.
+type ApplicationLogoutConfigSetEvent struct {
+	eventstore.BaseEvent `json:"-"`
+
+	AppID                 string `json:"appId,omitempty"`
+	BackChannelLogoutURI  string `json:"backChannelLogoutUri,omitempty"`
+	FrontChannelLogoutURI string `json:"frontChannelLogoutUri,omitempty"`
+}
+
+func (e *ApplicationLogoutConfigSetEvent) Payload() interface{} {
+	return e
+}
+
+func (e *ApplicationLogoutConfigSetEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+func NewApplicationLogoutConfigSetEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	appID,
+	backChannelLogoutURI,
+	frontChannelLogoutURI string,
+) *ApplicationLogoutConfigSetEvent {
+	return &ApplicationLogoutConfigSetEvent{
+		BaseEvent: *eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			ApplicationLogoutConfigSetType,
+		),
+		AppID:                 appID,
+		BackChannelLogoutURI:  backChannelLogoutURI,
+		FrontChannelLogoutURI: frontChannelLogoutURI,
+	}
+}
+
+func ApplicationLogoutConfigSetEventMapper(event eventstore.Event) (eventstore.Event, error) {
+	e := &ApplicationLogoutConfigSetEvent{
+		BaseEvent: *eventstore.BaseEventFromRepo(event),
+	}
+
+	err := event.Unmarshal(e)
+	if err != nil {
+		return nil, zerrors.ThrowInternal(err, "APPLICATION-Lq4ms", "unable to unmarshal application logout config")
+	}
+
+	return e, nil
+}
+
+// ApplicationAuthRequirementsSetEvent replaces the conditions the authentication of a user
+// has to fulfil for the application. Empty requirements remove them.
+type ApplicationAuthRequirementsSetEvent struct {
+	eventstore.BaseEvent `json:"-"`
+
+	AppID            string                      `json:"appId,omitempty"`
+	AuthRequirements *domain.AppAuthRequirements `json:"authRequirements,omitempty"`
+}
+
+func (e *ApplicationAuthRequirementsSetEvent) Payload() interface{} {
+	return e
+}
+
+func (e *ApplicationAuthRequirementsSetEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+func NewApplicationAuthRequirementsSetEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	appID string,
+	authRequirements *domain.AppAuthRequirements,
+) *ApplicationAuthRequirementsSetEvent {
+	return &ApplicationAuthRequirementsSetEvent{
+		BaseEvent: *eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			ApplicationAuthRequirementsSetType,
+		),
+		AppID:            appID,
+		AuthRequirements: authRequirements,
+	}
+}
+
+func ApplicationAuthRequirementsSetEventMapper(event eventstore.Event) (eventstore.Event, error) {
+	e := &ApplicationAuthRequirementsSetEvent{
+		BaseEvent: *eventstore.BaseEventFromRepo(event),
+	}
+
+	err := event.Unmarshal(e)
+	if err != nil {
+		return nil, zerrors.ThrowInternal(err, "APPLICATION-Ar5mq", "unable to unmarshal application auth requirements")
+	}
+
+	return e, nil
+}
+
+// ApplicationFrameAncestorsSetEvent replaces the origins which are allowed to embed
+// the login of the application in a frame. An empty list prevents the embedding.
+type ApplicationFrameAncestorsSetEvent struct {
+	eventstore.BaseEvent `json:"-"`
+
+	AppID          string   `json:"appId,omitempty"`
+	FrameAncestors []string `json:"frameAncestors,omitempty"`
+}
+
+func (e *ApplicationFrameAncestorsSetEvent) Payload() interface{} {
+	return e
+}
+
+func (e *ApplicationFrameAncestorsSetEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+func NewApplicationFrameAncestorsSetEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	appID string,
+	frameAncestors []string,
+) *ApplicationFrameAncestorsSetEvent {
+	return &ApplicationFrameAncestorsSetEvent{
+		BaseEvent: *eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			ApplicationFrameAncestorsSetType,
+		),
+		AppID:          appID,
+		FrameAncestors: frameAncestors,
+	}
+}
+
+func ApplicationFrameAncestorsSetEventMapper(event eventstore.Event) (eventstore.Event, error) {
+	e := &ApplicationFrameAncestorsSetEvent{
+		BaseEvent: *eventstore.BaseEventFromRepo(event),
+	}
+
+	err := event.Unmarshal(e)
+	if err != nil {
+		return nil, zerrors.ThrowInternal(err, "APPLICATION-Fa3kw", "unable to unmarshal application frame ancestors")
+	}
+
+	return e, nil
+}
+
+// ApplicationTokenLifetimesSetEvent replaces the token lifetimes the OIDC application
+// overrides of the instance settings. Empty lifetimes remove the overrides.
+// The client id is stored to find the lifetimes when tokens are issued.
+type ApplicationTokenLifetimesSetEvent struct {
+	eventstore.BaseEvent `json:"-"`
+
+	AppID          string                    `json:"appId,omitempty"`
+	ClientID       string                    `json:"clientId,omitempty"`
+	TokenLifetimes *domain.AppTokenLifetimes `json:"tokenLifetimes,omitempty"`
+}
+
+func (e *ApplicationTokenLifetimesSetEvent) Payload() interface{} {
+	return e
+}
+
+func (e *ApplicationTokenLifetimesSetEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+func NewApplicationTokenLifetimesSetEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	appID,
+	clientID string,
+	tokenLifetimes *domain.AppTokenLifetimes,
+) *ApplicationTokenLifetimesSetEvent {
+	return &ApplicationTokenLifetimesSetEvent{
+		BaseEvent: *eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			ApplicationTokenLifetimesSetType,
+		),
+		AppID:          appID,
+		ClientID:       clientID,
+		TokenLifetimes: tokenLifetimes,
+	}
+}
+
+func ApplicationTokenLifetimesSetEventMapper(event eventstore.Event) (eventstore.Event, error) {
+	e := &ApplicationTokenLifetimesSetEvent{
+		BaseEvent: *eventstore.BaseEventFromRepo(event),
+	}
+
+	err := event.Unmarshal(e)
+	if err != nil {
+		return nil, zerrors.ThrowInternal(err, "APPLICATION-Tl3mq", "unable to unmarshal application token lifetimes")
+	}
+
+	return e, nil
+}
+
 type ApplicationReactivatedEvent struct {
 	eventstore.BaseEvent `json:"-"`
 
@@ -262,3 +513,54 @@ func ApplicationRemovedEventMapper(event eventstore.Event) (eventstore.Event, er
 
 	return e, nil
 }
+
+// ApplicationOfflineTokensSetEvent allows a first-party OIDC application to issue
+// long-lived offline tokens to its users. A zero max lifetime disallows them.
+// The client id is stored to find the setting when tokens are issued.
+type ApplicationOfflineTokensSetEvent struct {
+	eventstore.BaseEvent `json:"-"`
+
+	AppID       string        `json:"appId,omitempty"`
+	ClientID    string        `json:"clientId,omitempty"`
+	MaxLifetime time.Duration `json:"maxLifetime,omitempty"`
+}
+
+func (e *ApplicationOfflineTokensSetEvent) Payload() interface{} {
+	return e
+}
+
+func (e *ApplicationOfflineTokensSetEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+func NewApplicationOfflineTokensSetEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	appID,
+	clientID string,
+	maxLifetime time.Duration,
+) *ApplicationOfflineTokensSetEvent {
+	return &ApplicationOfflineTokensSetEvent{
+		BaseEvent: *eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			ApplicationOfflineTokensSetType,
+		),
+		AppID:       appID,
+		ClientID:    clientID,
+		MaxLifetime: maxLifetime,
+	}
+}
+
+func ApplicationOfflineTokensSetEventMapper(event eventstore.Event) (eventstore.Event, error) {
+	e := &ApplicationOfflineTokensSetEvent{
+		BaseEvent: *eventstore.BaseEventFromRepo(event),
+	}
+
+	err := event.Unmarshal(e)
+	if err != nil {
+		return nil, zerrors.ThrowInternal(err, "APPLICATION-Ot3sm", "unable to unmarshal application offline tokens")
+	}
+
+	return e, nil
+}
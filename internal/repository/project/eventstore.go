@@ -10,6 +10,7 @@ func init() {
 	eventstore.RegisterFilterEventMapper(AggregateType, ProjectDeactivatedType, ProjectDeactivatedEventMapper)
 	eventstore.RegisterFilterEventMapper(AggregateType, ProjectReactivatedType, ProjectReactivatedEventMapper)
 	eventstore.RegisterFilterEventMapper(AggregateType, ProjectRemovedType, ProjectRemovedEventMapper)
+	eventstore.RegisterFilterEventMapper(AggregateType, ProjectAccessTokenTypeSetType, ProjectAccessTokenTypeSetEventMapper)
 	eventstore.RegisterFilterEventMapper(AggregateType, MemberAddedType, MemberAddedEventMapper)
 	eventstore.RegisterFilterEventMapper(AggregateType, MemberChangedType, MemberChangedEventMapper)
 	eventstore.RegisterFilterEventMapper(AggregateType, MemberRemovedType, MemberRemovedEventMapper)
@@ -32,6 +33,12 @@ func init() {
 	eventstore.RegisterFilterEventMapper(AggregateType, ApplicationRemovedType, ApplicationRemovedEventMapper)
 	eventstore.RegisterFilterEventMapper(AggregateType, ApplicationDeactivatedType, ApplicationDeactivatedEventMapper)
 	eventstore.RegisterFilterEventMapper(AggregateType, ApplicationReactivatedType, ApplicationReactivatedEventMapper)
+	eventstore.RegisterFilterEventMapper(AggregateType, ApplicationClaimsMappingSetType, ApplicationClaimsMappingSetEventMapper)
+	eventstore.RegisterFilterEventMapper(AggregateType, ApplicationLogoutConfigSetType, ApplicationLogoutConfigSetEventMapper)
+	eventstore.RegisterFilterEventMapper(AggregateType, ApplicationAuthRequirementsSetType, ApplicationAuthRequirementsSetEventMapper)
+	eventstore.RegisterFilterEventMapper(AggregateType, ApplicationFrameAncestorsSetType, ApplicationFrameAncestorsSetEventMapper)
+	eventstore.RegisterFilterEventMapper(AggregateType, ApplicationTokenLifetimesSetType, ApplicationTokenLifetimesSetEventMapper)
+	eventstore.RegisterFilterEventMapper(AggregateType, ApplicationOfflineTokensSetType, ApplicationOfflineTokensSetEventMapper)
 	eventstore.RegisterFilterEventMapper(AggregateType, OIDCConfigAddedType, OIDCConfigAddedEventMapper)
 	eventstore.RegisterFilterEventMapper(AggregateType, OIDCConfigChangedType, OIDCConfigChangedEventMapper)
 	eventstore.RegisterFilterEventMapper(AggregateType, OIDCConfigSecretChangedType, OIDCConfigSecretChangedEventMapper)
@@ -43,7 +50,14 @@ func init() {
 	eventstore.RegisterFilterEventMapper(AggregateType, APIConfigSecretChangedType, APIConfigSecretChangedEventMapper)
 	eventstore.RegisterFilterEventMapper(AggregateType, APIConfigSecretHashUpdatedType, eventstore.GenericEventMapper[APIConfigSecretHashUpdatedEvent])
 	eventstore.RegisterFilterEventMapper(AggregateType, ApplicationKeyAddedEventType, ApplicationKeyAddedEventMapper)
+	eventstore.RegisterFilterEventMapper(AggregateType, ApplicationKeyExpirationChangedEventType, ApplicationKeyExpirationChangedEventMapper)
 	eventstore.RegisterFilterEventMapper(AggregateType, ApplicationKeyRemovedEventType, ApplicationKeyRemovedEventMapper)
 	eventstore.RegisterFilterEventMapper(AggregateType, SAMLConfigAddedType, SAMLConfigAddedEventMapper)
 	eventstore.RegisterFilterEventMapper(AggregateType, SAMLConfigChangedType, SAMLConfigChangedEventMapper)
+	eventstore.RegisterFilterEventMapper(AggregateType, SAMLAttributesSetType, SAMLAttributesSetEventMapper)
+	eventstore.RegisterFilterEventMapper(AggregateType, NetworkAccessPolicySetType, NetworkAccessPolicySetEventMapper)
+	eventstore.RegisterFilterEventMapper(AggregateType, NetworkAccessPolicyRemovedType, NetworkAccessPolicyRemovedEventMapper)
+	eventstore.RegisterFilterEventMapper(AggregateType, SCIMTargetAddedType, SCIMTargetAddedEventMapper)
+	eventstore.RegisterFilterEventMapper(AggregateType, SCIMTargetChangedType, SCIMTargetChangedEventMapper)
+	eventstore.RegisterFilterEventMapper(AggregateType, SCIMTargetRemovedType, SCIMTargetRemovedEventMapper)
 }
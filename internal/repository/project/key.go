@@ -10,9 +10,10 @@ import (
 )
 
 const (
-	applicationKeyEventPrefix      = applicationEventTypePrefix + "oidc.key."
-	ApplicationKeyAddedEventType   = applicationKeyEventPrefix + "added"
-	ApplicationKeyRemovedEventType = applicationKeyEventPrefix + "removed"
+	applicationKeyEventPrefix                = applicationEventTypePrefix + "oidc.key."
+	ApplicationKeyAddedEventType             = applicationKeyEventPrefix + "added"
+	ApplicationKeyExpirationChangedEventType = applicationKeyEventPrefix + "expiration.changed"
+	ApplicationKeyRemovedEventType           = applicationKeyEventPrefix + "removed"
 )
 
 type ApplicationKeyAddedEvent struct {
@@ -72,6 +73,53 @@ func ApplicationKeyAddedEventMapper(event eventstore.Event) (eventstore.Event, e
 	return e, nil
 }
 
+type ApplicationKeyExpirationChangedEvent struct {
+	eventstore.BaseEvent `json:"-"`
+
+	AppID          string    `json:"applicationId"`
+	KeyID          string    `json:"keyId,omitempty"`
+	ExpirationDate time.Time `json:"expirationDate,omitempty"`
+}
+
+func (e *ApplicationKeyExpirationChangedEvent) Payload() interface{} {
+	return e
+}
+
+func (e *ApplicationKeyExpirationChangedEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+func NewApplicationKeyExpirationChangedEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	appID,
+	keyID string,
+	expirationDate time.Time,
+) *ApplicationKeyExpirationChangedEvent {
+	return &ApplicationKeyExpirationChangedEvent{
+		BaseEvent: *eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			ApplicationKeyExpirationChangedEventType,
+		),
+		AppID:          appID,
+		KeyID:          keyID,
+		ExpirationDate: expirationDate,
+	}
+}
+
+func ApplicationKeyExpirationChangedEventMapper(event eventstore.Event) (eventstore.Event, error) {
+	e := &ApplicationKeyExpirationChangedEvent{
+		BaseEvent: *eventstore.BaseEventFromRepo(event),
+	}
+	err := event.Unmarshal(e)
+	if err != nil {
+		return nil, zerrors.ThrowInternal(err, "PROJECT-Wd8ks", "unable to unmarshal application key expiration changed")
+	}
+
+	return e, nil
+}
+
 type ApplicationKeyRemovedEvent struct {
 	eventstore.BaseEvent `json:"-"`
 
@@ -0,0 +1,110 @@
+package project
+
+import (
+	"context"
+
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+const (
+	NetworkAccessPolicySetType     = applicationEventTypePrefix + "network_access.set"
+	NetworkAccessPolicyRemovedType = applicationEventTypePrefix + "network_access.removed"
+)
+
+// NetworkAccessPolicySetEvent restricts the client networks which can log in to the OIDC application
+// and receive tokens for it.
+// The client id is stored, so the policy can be evaluated when only the client is known.
+type NetworkAccessPolicySetEvent struct {
+	eventstore.BaseEvent `json:"-"`
+
+	AppID    string `json:"appId,omitempty"`
+	ClientID string `json:"clientId,omitempty"`
+	domain.NetworkAccessRules
+}
+
+func (e *NetworkAccessPolicySetEvent) Payload() interface{} {
+	return e
+}
+
+func (e *NetworkAccessPolicySetEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+func NewNetworkAccessPolicySetEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	appID,
+	clientID string,
+	rules domain.NetworkAccessRules,
+) *NetworkAccessPolicySetEvent {
+	return &NetworkAccessPolicySetEvent{
+		BaseEvent: *eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			NetworkAccessPolicySetType,
+		),
+		AppID:              appID,
+		ClientID:           clientID,
+		NetworkAccessRules: rules,
+	}
+}
+
+func NetworkAccessPolicySetEventMapper(event eventstore.Event) (eventstore.Event, error) {
+	e := &NetworkAccessPolicySetEvent{
+		BaseEvent: *eventstore.BaseEventFromRepo(event),
+	}
+
+	err := event.Unmarshal(e)
+	if err != nil {
+		return nil, zerrors.ThrowInternal(err, "PROJECT-Na3sm", "unable to unmarshal network access policy")
+	}
+
+	return e, nil
+}
+
+type NetworkAccessPolicyRemovedEvent struct {
+	eventstore.BaseEvent `json:"-"`
+
+	AppID    string `json:"appId,omitempty"`
+	ClientID string `json:"clientId,omitempty"`
+}
+
+func (e *NetworkAccessPolicyRemovedEvent) Payload() interface{} {
+	return e
+}
+
+func (e *NetworkAccessPolicyRemovedEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+func NewNetworkAccessPolicyRemovedEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	appID,
+	clientID string,
+) *NetworkAccessPolicyRemovedEvent {
+	return &NetworkAccessPolicyRemovedEvent{
+		BaseEvent: *eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			NetworkAccessPolicyRemovedType,
+		),
+		AppID:    appID,
+		ClientID: clientID,
+	}
+}
+
+func NetworkAccessPolicyRemovedEventMapper(event eventstore.Event) (eventstore.Event, error) {
+	e := &NetworkAccessPolicyRemovedEvent{
+		BaseEvent: *eventstore.BaseEventFromRepo(event),
+	}
+
+	err := event.Unmarshal(e)
+	if err != nil {
+		return nil, zerrors.ThrowInternal(err, "PROJECT-Na4rm", "unable to unmarshal network access policy")
+	}
+
+	return e, nil
+}
@@ -45,6 +45,8 @@ type OIDCConfigAddedEvent struct {
 	ClockSkew                time.Duration              `json:"clockSkew,omitempty"`
 	AdditionalOrigins        []string                   `json:"additionalOrigins,omitempty"`
 	SkipNativeAppSuccessPage bool                       `json:"skipNativeAppSuccessPage,omitempty"`
+	TLSClientAuthSubjectDN   string                     `json:"tlsClientAuthSubjectDn,omitempty"`
+	TLSClientAuthThumbprint  string                     `json:"tlsClientAuthThumbprint,omitempty"`
 }
 
 func (e *OIDCConfigAddedEvent) Payload() interface{} {
@@ -76,6 +78,8 @@ func NewOIDCConfigAddedEvent(
 	clockSkew time.Duration,
 	additionalOrigins []string,
 	skipNativeAppSuccessPage bool,
+	tlsClientAuthSubjectDN string,
+	tlsClientAuthThumbprint string,
 ) *OIDCConfigAddedEvent {
 	return &OIDCConfigAddedEvent{
 		BaseEvent: *eventstore.NewBaseEventForPush(
@@ -101,6 +105,8 @@ func NewOIDCConfigAddedEvent(
 		ClockSkew:                clockSkew,
 		AdditionalOrigins:        additionalOrigins,
 		SkipNativeAppSuccessPage: skipNativeAppSuccessPage,
+		TLSClientAuthSubjectDN:   tlsClientAuthSubjectDN,
+		TLSClientAuthThumbprint:  tlsClientAuthThumbprint,
 	}
 }
 
@@ -186,6 +192,12 @@ func (e *OIDCConfigAddedEvent) Validate(cmd eventstore.Command) bool {
 			return false
 		}
 	}
+	if e.TLSClientAuthSubjectDN != c.TLSClientAuthSubjectDN {
+		return false
+	}
+	if e.TLSClientAuthThumbprint != c.TLSClientAuthThumbprint {
+		return false
+	}
 	return e.SkipNativeAppSuccessPage == c.SkipNativeAppSuccessPage
 }
 
@@ -221,6 +233,8 @@ type OIDCConfigChangedEvent struct {
 	ClockSkew                *time.Duration              `json:"clockSkew,omitempty"`
 	AdditionalOrigins        *[]string                   `json:"additionalOrigins,omitempty"`
 	SkipNativeAppSuccessPage *bool                       `json:"skipNativeAppSuccessPage,omitempty"`
+	TLSClientAuthSubjectDN   *string                     `json:"tlsClientAuthSubjectDn,omitempty"`
+	TLSClientAuthThumbprint  *string                     `json:"tlsClientAuthThumbprint,omitempty"`
 }
 
 func (e *OIDCConfigChangedEvent) Payload() interface{} {
@@ -347,6 +361,18 @@ func ChangeSkipNativeAppSuccessPage(skipNativeAppSuccessPage bool) func(event *O
 	}
 }
 
+func ChangeTLSClientAuthSubjectDN(subjectDN string) func(event *OIDCConfigChangedEvent) {
+	return func(e *OIDCConfigChangedEvent) {
+		e.TLSClientAuthSubjectDN = &subjectDN
+	}
+}
+
+func ChangeTLSClientAuthThumbprint(thumbprint string) func(event *OIDCConfigChangedEvent) {
+	return func(e *OIDCConfigChangedEvent) {
+		e.TLSClientAuthThumbprint = &thumbprint
+	}
+}
+
 func OIDCConfigChangedEventMapper(event eventstore.Event) (eventstore.Event, error) {
 	e := &OIDCConfigChangedEvent{
 		BaseEvent: *eventstore.BaseEventFromRepo(event),
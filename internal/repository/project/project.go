@@ -17,6 +17,8 @@ const (
 	ProjectReactivatedType = projectEventTypePrefix + "reactivated"
 	ProjectRemovedType     = projectEventTypePrefix + "removed"
 
+	ProjectAccessTokenTypeSetType = projectEventTypePrefix + "access.token.type.set"
+
 	ProjectSearchType       = "project"
 	ProjectObjectRevision   = uint8(1)
 	ProjectNameSearchField  = "name"
@@ -346,6 +348,50 @@ func ProjectReactivatedEventMapper(event eventstore.Event) (eventstore.Event, er
 	}, nil
 }
 
+// ProjectAccessTokenTypeSetEvent sets the type of the access tokens of the project.
+// The OIDC applications of the project are changed by their own events.
+type ProjectAccessTokenTypeSetEvent struct {
+	eventstore.BaseEvent `json:"-"`
+
+	AccessTokenType domain.OIDCTokenType `json:"accessTokenType"`
+}
+
+func (e *ProjectAccessTokenTypeSetEvent) Payload() interface{} {
+	return e
+}
+
+func (e *ProjectAccessTokenTypeSetEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+func NewProjectAccessTokenTypeSetEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	accessTokenType domain.OIDCTokenType,
+) *ProjectAccessTokenTypeSetEvent {
+	return &ProjectAccessTokenTypeSetEvent{
+		BaseEvent: *eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			ProjectAccessTokenTypeSetType,
+		),
+		AccessTokenType: accessTokenType,
+	}
+}
+
+func ProjectAccessTokenTypeSetEventMapper(event eventstore.Event) (eventstore.Event, error) {
+	e := &ProjectAccessTokenTypeSetEvent{
+		BaseEvent: *eventstore.BaseEventFromRepo(event),
+	}
+
+	err := event.Unmarshal(e)
+	if err != nil {
+		return nil, zerrors.ThrowInternal(err, "PROJECT-Tk4ma", "unable to unmarshal project access token type")
+	}
+
+	return e, nil
+}
+
 type ProjectRemovedEvent struct {
 	eventstore.BaseEvent `json:"-"`
 
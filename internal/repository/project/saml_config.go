@@ -3,6 +3,7 @@ package project
 import (
 	"context"
 
+	"github.com/zitadel/zitadel/internal/domain"
 	"github.com/zitadel/zitadel/internal/eventstore"
 	"github.com/zitadel/zitadel/internal/zerrors"
 )
@@ -11,6 +12,7 @@ const (
 	UniqueEntityIDType    = "entity_ids"
 	SAMLConfigAddedType   = applicationEventTypePrefix + "config.saml.added"
 	SAMLConfigChangedType = applicationEventTypePrefix + "config.saml.changed"
+	SAMLAttributesSetType = applicationEventTypePrefix + "config.saml.attributes.set"
 )
 
 type SAMLConfigAddedEvent struct {
@@ -159,3 +161,50 @@ func SAMLConfigChangedEventMapper(event eventstore.Event) (eventstore.Event, err
 
 	return e, nil
 }
+
+// SAMLAttributesSetEvent replaces the attribute statements which are added to the SAML responses of the application.
+// An empty list removes them.
+type SAMLAttributesSetEvent struct {
+	eventstore.BaseEvent `json:"-"`
+
+	AppID      string                  `json:"appId,omitempty"`
+	Attributes []*domain.SAMLAttribute `json:"attributes,omitempty"`
+}
+
+func (e *SAMLAttributesSetEvent) Payload() interface{} {
+	return e
+}
+
+func (e *SAMLAttributesSetEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+func NewSAMLAttributesSetEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	appID string,
+	attributes []*domain.SAMLAttribute,
+) *SAMLAttributesSetEvent {
+	return &SAMLAttributesSetEvent{
+		BaseEvent: *eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			SAMLAttributesSetType,
+		),
+		AppID:      appID,
+		Attributes: attributes,
+	}
+}
+
+func SAMLAttributesSetEventMapper(event eventstore.Event) (eventstore.Event, error) {
+	e := &SAMLAttributesSetEvent{
+		BaseEvent: *eventstore.BaseEventFromRepo(event),
+	}
+
+	err := event.Unmarshal(e)
+	if err != nil {
+		return nil, zerrors.ThrowInternal(err, "SAML-Sa3ks", "unable to unmarshal saml attributes")
+	}
+
+	return e, nil
+}
@@ -0,0 +1,191 @@
+package project
+
+import (
+	"context"
+
+	"github.com/zitadel/zitadel/internal/crypto"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+const (
+	scimTargetEventTypePrefix = projectEventTypePrefix + "scim.target."
+	SCIMTargetAddedType       = scimTargetEventTypePrefix + "added"
+	SCIMTargetChangedType     = scimTargetEventTypePrefix + "changed"
+	SCIMTargetRemovedType     = scimTargetEventTypePrefix + "removed"
+)
+
+type SCIMTargetAddedEvent struct {
+	eventstore.BaseEvent `json:"-"`
+
+	ID       string `json:"id,omitempty"`
+	Name     string `json:"name,omitempty"`
+	Endpoint string `json:"endpoint,omitempty"`
+	// Token is the bearer token used to authenticate at the SCIM API of the target
+	Token   *crypto.CryptoValue            `json:"token,omitempty"`
+	Mapping domain.SCIMProvisioningMapping `json:"mapping"`
+}
+
+func NewSCIMTargetAddedEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	id,
+	name,
+	endpoint string,
+	token *crypto.CryptoValue,
+	mapping domain.SCIMProvisioningMapping,
+) *SCIMTargetAddedEvent {
+	return &SCIMTargetAddedEvent{
+		BaseEvent: *eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			SCIMTargetAddedType,
+		),
+		ID:       id,
+		Name:     name,
+		Endpoint: endpoint,
+		Token:    token,
+		Mapping:  mapping,
+	}
+}
+
+func (e *SCIMTargetAddedEvent) Payload() interface{} {
+	return e
+}
+
+func (e *SCIMTargetAddedEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+func SCIMTargetAddedEventMapper(event eventstore.Event) (eventstore.Event, error) {
+	e := &SCIMTargetAddedEvent{
+		BaseEvent: *eventstore.BaseEventFromRepo(event),
+	}
+	err := event.Unmarshal(e)
+	if err != nil {
+		return nil, zerrors.ThrowInternal(err, "SCIM-Tg1ad", "unable to unmarshal scim target added")
+	}
+
+	return e, nil
+}
+
+type SCIMTargetChangedEvent struct {
+	eventstore.BaseEvent `json:"-"`
+
+	ID       string                          `json:"id,omitempty"`
+	Name     *string                         `json:"name,omitempty"`
+	Endpoint *string                         `json:"endpoint,omitempty"`
+	Token    *crypto.CryptoValue             `json:"token,omitempty"`
+	Mapping  *domain.SCIMProvisioningMapping `json:"mapping,omitempty"`
+}
+
+func NewSCIMTargetChangedEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	id string,
+	changes []SCIMTargetChanges,
+) (*SCIMTargetChangedEvent, error) {
+	if len(changes) == 0 {
+		return nil, zerrors.ThrowPreconditionFailed(nil, "SCIM-Tg2ch", "Errors.NoChangesFound")
+	}
+	changeEvent := &SCIMTargetChangedEvent{
+		BaseEvent: *eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			SCIMTargetChangedType,
+		),
+		ID: id,
+	}
+	for _, change := range changes {
+		change(changeEvent)
+	}
+	return changeEvent, nil
+}
+
+type SCIMTargetChanges func(event *SCIMTargetChangedEvent)
+
+func ChangeSCIMTargetName(name string) func(event *SCIMTargetChangedEvent) {
+	return func(e *SCIMTargetChangedEvent) {
+		e.Name = &name
+	}
+}
+
+func ChangeSCIMTargetEndpoint(endpoint string) func(event *SCIMTargetChangedEvent) {
+	return func(e *SCIMTargetChangedEvent) {
+		e.Endpoint = &endpoint
+	}
+}
+
+func ChangeSCIMTargetToken(token *crypto.CryptoValue) func(event *SCIMTargetChangedEvent) {
+	return func(e *SCIMTargetChangedEvent) {
+		e.Token = token
+	}
+}
+
+func ChangeSCIMTargetMapping(mapping domain.SCIMProvisioningMapping) func(event *SCIMTargetChangedEvent) {
+	return func(e *SCIMTargetChangedEvent) {
+		e.Mapping = &mapping
+	}
+}
+
+func (e *SCIMTargetChangedEvent) Payload() interface{} {
+	return e
+}
+
+func (e *SCIMTargetChangedEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+func SCIMTargetChangedEventMapper(event eventstore.Event) (eventstore.Event, error) {
+	e := &SCIMTargetChangedEvent{
+		BaseEvent: *eventstore.BaseEventFromRepo(event),
+	}
+	err := event.Unmarshal(e)
+	if err != nil {
+		return nil, zerrors.ThrowInternal(err, "SCIM-Tg3ch", "unable to unmarshal scim target changed")
+	}
+
+	return e, nil
+}
+
+type SCIMTargetRemovedEvent struct {
+	eventstore.BaseEvent `json:"-"`
+
+	ID string `json:"id,omitempty"`
+}
+
+func NewSCIMTargetRemovedEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	id string,
+) *SCIMTargetRemovedEvent {
+	return &SCIMTargetRemovedEvent{
+		BaseEvent: *eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			SCIMTargetRemovedType,
+		),
+		ID: id,
+	}
+}
+
+func (e *SCIMTargetRemovedEvent) Payload() interface{} {
+	return e
+}
+
+func (e *SCIMTargetRemovedEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+func SCIMTargetRemovedEventMapper(event eventstore.Event) (eventstore.Event, error) {
+	e := &SCIMTargetRemovedEvent{
+		BaseEvent: *eventstore.BaseEventFromRepo(event),
+	}
+	err := event.Unmarshal(e)
+	if err != nil {
+		return nil, zerrors.ThrowInternal(err, "SCIM-Tg4rm", "unable to unmarshal scim target removed")
+	}
+
+	return e, nil
+}
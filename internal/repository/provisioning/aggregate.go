@@ -0,0 +1,28 @@
+package provisioning
+
+import (
+	"github.com/zitadel/zitadel/internal/eventstore"
+)
+
+const (
+	AggregateType    = "provisioning"
+	AggregateVersion = "v1"
+)
+
+type Aggregate struct {
+	eventstore.Aggregate
+}
+
+// NewAggregate returns the aggregate of the syncs of the users to a SCIM target,
+// the id is the id of the target, the resource owner the one of its project
+func NewAggregate(targetID, resourceOwner, instanceID string) *Aggregate {
+	return &Aggregate{
+		Aggregate: eventstore.Aggregate{
+			Type:          AggregateType,
+			Version:       AggregateVersion,
+			ID:            targetID,
+			InstanceID:    instanceID,
+			ResourceOwner: resourceOwner,
+		},
+	}
+}
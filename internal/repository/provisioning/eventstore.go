@@ -0,0 +1,12 @@
+package provisioning
+
+import (
+	"github.com/zitadel/zitadel/internal/eventstore"
+)
+
+func init() {
+	eventstore.RegisterFilterEventMapper(AggregateType, SyncRequestedEventType, SyncRequestedEventMapper)
+	eventstore.RegisterFilterEventMapper(AggregateType, SyncSucceededEventType, SyncSucceededEventMapper)
+	eventstore.RegisterFilterEventMapper(AggregateType, SyncRetryScheduledEventType, SyncRetryScheduledEventMapper)
+	eventstore.RegisterFilterEventMapper(AggregateType, SyncFailedEventType, SyncFailedEventMapper)
+}
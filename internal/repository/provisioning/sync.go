@@ -0,0 +1,180 @@
+package provisioning
+
+import (
+	"context"
+	"time"
+
+	"github.com/zitadel/zitadel/internal/eventstore"
+)
+
+const (
+	eventTypePrefix             = eventstore.EventType("provisioning.sync.")
+	SyncRequestedEventType      = eventTypePrefix + "requested"
+	SyncSucceededEventType      = eventTypePrefix + "succeeded"
+	SyncRetryScheduledEventType = eventTypePrefix + "retry.scheduled"
+	SyncFailedEventType         = eventTypePrefix + "failed"
+)
+
+// SyncRequestedEvent is written if the user has to be synced to the target,
+// e.g. because the user or its grants on the project of the target changed.
+// The sync provisions the current state of the user, so it's only requested once until it's done.
+type SyncRequestedEvent struct {
+	*eventstore.BaseEvent `json:"-"`
+	UserID                string `json:"userId,omitempty"`
+	ProjectID             string `json:"projectId,omitempty"`
+	// Reason is the type of the event which triggered the sync
+	Reason string `json:"reason,omitempty"`
+}
+
+func (e *SyncRequestedEvent) Payload() any {
+	return e
+}
+
+func (e *SyncRequestedEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+func (e *SyncRequestedEvent) SetBaseEvent(b *eventstore.BaseEvent) {
+	e.BaseEvent = b
+}
+
+func NewSyncRequestedEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	userID,
+	projectID,
+	reason string,
+) *SyncRequestedEvent {
+	return &SyncRequestedEvent{
+		BaseEvent: eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			SyncRequestedEventType,
+		),
+		UserID:    userID,
+		ProjectID: projectID,
+		Reason:    reason,
+	}
+}
+
+var SyncRequestedEventMapper = eventstore.GenericEventMapper[SyncRequestedEvent]
+
+// SyncSucceededEvent is written if the user was provisioned to or deprovisioned from the target
+type SyncSucceededEvent struct {
+	*eventstore.BaseEvent `json:"-"`
+	UserID                string `json:"userId,omitempty"`
+	// RemoteID is the id of the user at the target, it's empty if the user was deprovisioned
+	RemoteID string `json:"remoteId,omitempty"`
+}
+
+func (e *SyncSucceededEvent) Payload() any {
+	return e
+}
+
+func (e *SyncSucceededEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+func (e *SyncSucceededEvent) SetBaseEvent(b *eventstore.BaseEvent) {
+	e.BaseEvent = b
+}
+
+func NewSyncSucceededEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	userID,
+	remoteID string,
+) *SyncSucceededEvent {
+	return &SyncSucceededEvent{
+		BaseEvent: eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			SyncSucceededEventType,
+		),
+		UserID:   userID,
+		RemoteID: remoteID,
+	}
+}
+
+var SyncSucceededEventMapper = eventstore.GenericEventMapper[SyncSucceededEvent]
+
+// SyncRetryScheduledEvent is written if a sync failed
+// and the maximum number of attempts is not reached yet
+type SyncRetryScheduledEvent struct {
+	*eventstore.BaseEvent `json:"-"`
+	UserID                string    `json:"userId,omitempty"`
+	Reason                string    `json:"reason,omitempty"`
+	NextAttempt           time.Time `json:"nextAttempt,omitempty"`
+}
+
+func (e *SyncRetryScheduledEvent) Payload() any {
+	return e
+}
+
+func (e *SyncRetryScheduledEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+func (e *SyncRetryScheduledEvent) SetBaseEvent(b *eventstore.BaseEvent) {
+	e.BaseEvent = b
+}
+
+func NewSyncRetryScheduledEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	userID,
+	reason string,
+	nextAttempt time.Time,
+) *SyncRetryScheduledEvent {
+	return &SyncRetryScheduledEvent{
+		BaseEvent: eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			SyncRetryScheduledEventType,
+		),
+		UserID:      userID,
+		Reason:      reason,
+		NextAttempt: nextAttempt,
+	}
+}
+
+var SyncRetryScheduledEventMapper = eventstore.GenericEventMapper[SyncRetryScheduledEvent]
+
+// SyncFailedEvent is written if the last attempt of a sync failed.
+// The user is not synced anymore until a new sync is requested
+type SyncFailedEvent struct {
+	*eventstore.BaseEvent `json:"-"`
+	UserID                string `json:"userId,omitempty"`
+	Reason                string `json:"reason,omitempty"`
+}
+
+func (e *SyncFailedEvent) Payload() any {
+	return e
+}
+
+func (e *SyncFailedEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+func (e *SyncFailedEvent) SetBaseEvent(b *eventstore.BaseEvent) {
+	e.BaseEvent = b
+}
+
+func NewSyncFailedEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	userID,
+	reason string,
+) *SyncFailedEvent {
+	return &SyncFailedEvent{
+		BaseEvent: eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			SyncFailedEventType,
+		),
+		UserID: userID,
+		Reason: reason,
+	}
+}
+
+var SyncFailedEventMapper = eventstore.GenericEventMapper[SyncFailedEvent]
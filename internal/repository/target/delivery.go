@@ -0,0 +1,134 @@
+package target
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/zitadel/zitadel/internal/eventstore"
+)
+
+const (
+	deliveryEventTypePrefix    = eventTypePrefix + "delivery."
+	DeliveryFailedEventType    = deliveryEventTypePrefix + "failed"
+	DeliveryReplayedEventType  = deliveryEventTypePrefix + "replayed"
+	DeliverySucceededEventType = deliveryEventTypePrefix + "succeeded"
+)
+
+// Delivery describes a domain event which was sent to a target by an event execution.
+type Delivery struct {
+	ExecutionID   string                   `json:"executionId"`
+	AggregateType eventstore.AggregateType `json:"aggregateType"`
+	AggregateID   string                   `json:"aggregateId"`
+	EventType     eventstore.EventType     `json:"eventType"`
+	EventSequence uint64                   `json:"eventSequence"`
+	Body          json.RawMessage          `json:"body"`
+}
+
+// DeliveryFailedEvent is pushed after all retries of a delivery failed,
+// the delivery is dead-lettered and can be replayed afterwards.
+type DeliveryFailedEvent struct {
+	eventstore.BaseEvent `json:"-"`
+
+	DeliveryID string `json:"deliveryId"`
+	Delivery
+	Attempts uint16 `json:"attempts"`
+	Reason   string `json:"reason,omitempty"`
+}
+
+func (e *DeliveryFailedEvent) SetBaseEvent(b *eventstore.BaseEvent) {
+	e.BaseEvent = *b
+}
+
+func (e *DeliveryFailedEvent) Payload() any {
+	return e
+}
+
+func (e *DeliveryFailedEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+func NewDeliveryFailedEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	deliveryID string,
+	delivery Delivery,
+	attempts uint16,
+	reason string,
+) *DeliveryFailedEvent {
+	return &DeliveryFailedEvent{
+		BaseEvent: *eventstore.NewBaseEventForPush(
+			ctx, aggregate, DeliveryFailedEventType,
+		),
+		DeliveryID: deliveryID,
+		Delivery:   delivery,
+		Attempts:   attempts,
+		Reason:     reason,
+	}
+}
+
+// DeliveryReplayedEvent requests a new delivery of a dead-lettered event to the target.
+type DeliveryReplayedEvent struct {
+	eventstore.BaseEvent `json:"-"`
+
+	DeliveryID string `json:"deliveryId"`
+	Delivery
+}
+
+func (e *DeliveryReplayedEvent) SetBaseEvent(b *eventstore.BaseEvent) {
+	e.BaseEvent = *b
+}
+
+func (e *DeliveryReplayedEvent) Payload() any {
+	return e
+}
+
+func (e *DeliveryReplayedEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+func NewDeliveryReplayedEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	deliveryID string,
+	delivery Delivery,
+) *DeliveryReplayedEvent {
+	return &DeliveryReplayedEvent{
+		BaseEvent: *eventstore.NewBaseEventForPush(
+			ctx, aggregate, DeliveryReplayedEventType,
+		),
+		DeliveryID: deliveryID,
+		Delivery:   delivery,
+	}
+}
+
+// DeliverySucceededEvent is pushed after a replayed delivery was accepted by the target.
+type DeliverySucceededEvent struct {
+	eventstore.BaseEvent `json:"-"`
+
+	DeliveryID string `json:"deliveryId"`
+}
+
+func (e *DeliverySucceededEvent) SetBaseEvent(b *eventstore.BaseEvent) {
+	e.BaseEvent = *b
+}
+
+func (e *DeliverySucceededEvent) Payload() any {
+	return e
+}
+
+func (e *DeliverySucceededEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+func NewDeliverySucceededEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	deliveryID string,
+) *DeliverySucceededEvent {
+	return &DeliverySucceededEvent{
+		BaseEvent: *eventstore.NewBaseEventForPush(
+			ctx, aggregate, DeliverySucceededEventType,
+		),
+		DeliveryID: deliveryID,
+	}
+}
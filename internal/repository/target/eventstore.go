@@ -6,4 +6,7 @@ func init() {
 	eventstore.RegisterFilterEventMapper(AggregateType, AddedEventType, eventstore.GenericEventMapper[AddedEvent])
 	eventstore.RegisterFilterEventMapper(AggregateType, ChangedEventType, eventstore.GenericEventMapper[ChangedEvent])
 	eventstore.RegisterFilterEventMapper(AggregateType, RemovedEventType, eventstore.GenericEventMapper[RemovedEvent])
+	eventstore.RegisterFilterEventMapper(AggregateType, DeliveryFailedEventType, eventstore.GenericEventMapper[DeliveryFailedEvent])
+	eventstore.RegisterFilterEventMapper(AggregateType, DeliveryReplayedEventType, eventstore.GenericEventMapper[DeliveryReplayedEvent])
+	eventstore.RegisterFilterEventMapper(AggregateType, DeliverySucceededEventType, eventstore.GenericEventMapper[DeliverySucceededEvent])
 }
@@ -0,0 +1,110 @@
+package user
+
+import (
+	"context"
+
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+)
+
+const (
+	breakGlassEventPrefix     = userEventTypePrefix + "break_glass."
+	UserBreakGlassSetType     = breakGlassEventPrefix + "set"
+	UserBreakGlassRemovedType = breakGlassEventPrefix + "removed"
+	UserBreakGlassUsedType    = breakGlassEventPrefix + "used"
+)
+
+// UserBreakGlassSetEvent flags the user as emergency account,
+// which can log in locally when the external identity providers are unavailable
+type UserBreakGlassSetEvent struct {
+	eventstore.BaseEvent `json:"-"`
+
+	Description string `json:"description,omitempty"`
+}
+
+func (e *UserBreakGlassSetEvent) SetBaseEvent(b *eventstore.BaseEvent) {
+	e.BaseEvent = *b
+}
+
+func (e *UserBreakGlassSetEvent) Payload() interface{} {
+	return e
+}
+
+func (e *UserBreakGlassSetEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+func NewUserBreakGlassSetEvent(ctx context.Context, aggregate *eventstore.Aggregate, description string) *UserBreakGlassSetEvent {
+	return &UserBreakGlassSetEvent{
+		BaseEvent: *eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			UserBreakGlassSetType,
+		),
+		Description: description,
+	}
+}
+
+type UserBreakGlassRemovedEvent struct {
+	eventstore.BaseEvent `json:"-"`
+}
+
+func (e *UserBreakGlassRemovedEvent) SetBaseEvent(b *eventstore.BaseEvent) {
+	e.BaseEvent = *b
+}
+
+func (e *UserBreakGlassRemovedEvent) Payload() interface{} {
+	return nil
+}
+
+func (e *UserBreakGlassRemovedEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+func NewUserBreakGlassRemovedEvent(ctx context.Context, aggregate *eventstore.Aggregate) *UserBreakGlassRemovedEvent {
+	return &UserBreakGlassRemovedEvent{
+		BaseEvent: *eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			UserBreakGlassRemovedType,
+		),
+	}
+}
+
+// UserBreakGlassUsedEvent is pushed on every successful authentication of a break-glass user,
+// the events form the audit trail of the emergency accesses
+type UserBreakGlassUsedEvent struct {
+	eventstore.BaseEvent `json:"-"`
+
+	Method          domain.BreakGlassAuthMethod `json:"method"`
+	AuthRequestInfo *AuthRequestInfo            `json:"authRequestInfo,omitempty"`
+}
+
+func (e *UserBreakGlassUsedEvent) SetBaseEvent(b *eventstore.BaseEvent) {
+	e.BaseEvent = *b
+}
+
+func (e *UserBreakGlassUsedEvent) Payload() interface{} {
+	return e
+}
+
+func (e *UserBreakGlassUsedEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+func NewUserBreakGlassUsedEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	method domain.BreakGlassAuthMethod,
+	info *AuthRequestInfo,
+) *UserBreakGlassUsedEvent {
+	return &UserBreakGlassUsedEvent{
+		BaseEvent: *eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			UserBreakGlassUsedType,
+		),
+		Method:          method,
+		AuthRequestInfo: info,
+	}
+}
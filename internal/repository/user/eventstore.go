@@ -41,6 +41,8 @@ func init() {
 	eventstore.RegisterFilterEventMapper(AggregateType, UserDeactivatedType, UserDeactivatedEventMapper)
 	eventstore.RegisterFilterEventMapper(AggregateType, UserReactivatedType, UserReactivatedEventMapper)
 	eventstore.RegisterFilterEventMapper(AggregateType, UserRemovedType, UserRemovedEventMapper)
+	eventstore.RegisterFilterEventMapper(AggregateType, UserLifecycleActionScheduledType, LifecycleActionScheduledEventMapper)
+	eventstore.RegisterFilterEventMapper(AggregateType, UserLifecycleActionNotificationSentType, LifecycleActionNotificationSentEventMapper)
 	eventstore.RegisterFilterEventMapper(AggregateType, UserTokenAddedType, UserTokenAddedEventMapper)
 	eventstore.RegisterFilterEventMapper(AggregateType, UserTokenV2AddedType, eventstore.GenericEventMapper[UserTokenV2AddedEvent])
 	eventstore.RegisterFilterEventMapper(AggregateType, UserImpersonatedType, eventstore.GenericEventMapper[UserImpersonatedEvent])
@@ -65,12 +67,15 @@ func init() {
 	eventstore.RegisterFilterEventMapper(AggregateType, HumanPasswordCheckSucceededType, HumanPasswordCheckSucceededEventMapper)
 	eventstore.RegisterFilterEventMapper(AggregateType, HumanPasswordCheckFailedType, HumanPasswordCheckFailedEventMapper)
 	eventstore.RegisterFilterEventMapper(AggregateType, HumanPasswordHashUpdatedType, eventstore.GenericEventMapper[HumanPasswordHashUpdatedEvent])
+	eventstore.RegisterFilterEventMapper(AggregateType, HumanUsernameRecoveryRequestedType, HumanUsernameRecoveryRequestedEventMapper)
+	eventstore.RegisterFilterEventMapper(AggregateType, HumanUsernameRecoverySentType, HumanUsernameRecoverySentEventMapper)
 	eventstore.RegisterFilterEventMapper(AggregateType, UserIDPLinkAddedType, UserIDPLinkAddedEventMapper)
 	eventstore.RegisterFilterEventMapper(AggregateType, UserIDPLinkRemovedType, UserIDPLinkRemovedEventMapper)
 	eventstore.RegisterFilterEventMapper(AggregateType, UserIDPLinkCascadeRemovedType, UserIDPLinkCascadeRemovedEventMapper)
 	eventstore.RegisterFilterEventMapper(AggregateType, UserIDPLoginCheckSucceededType, UserIDPCheckSucceededEventMapper)
 	eventstore.RegisterFilterEventMapper(AggregateType, UserIDPExternalIDMigratedType, eventstore.GenericEventMapper[UserIDPExternalIDMigratedEvent])
 	eventstore.RegisterFilterEventMapper(AggregateType, UserIDPExternalUsernameChangedType, eventstore.GenericEventMapper[UserIDPExternalUsernameEvent])
+	eventstore.RegisterFilterEventMapper(AggregateType, UserIDPLinkAutoLinkedType, eventstore.GenericEventMapper[UserIDPLinkAutoLinkedEvent])
 	eventstore.RegisterFilterEventMapper(AggregateType, HumanEmailChangedType, HumanEmailChangedEventMapper)
 	eventstore.RegisterFilterEventMapper(AggregateType, HumanEmailVerifiedType, HumanEmailVerifiedEventMapper)
 	eventstore.RegisterFilterEventMapper(AggregateType, HumanEmailVerificationFailedType, HumanEmailVerificationFailedEventMapper)
@@ -87,6 +92,10 @@ func init() {
 	eventstore.RegisterFilterEventMapper(AggregateType, HumanAvatarRemovedType, HumanAvatarRemovedEventMapper)
 	eventstore.RegisterFilterEventMapper(AggregateType, HumanAddressChangedType, HumanAddressChangedEventMapper)
 	eventstore.RegisterFilterEventMapper(AggregateType, HumanMFAInitSkippedType, HumanMFAInitSkippedEventMapper)
+	eventstore.RegisterFilterEventMapper(AggregateType, HumanPasskeyPromptShownType, HumanPasskeyPromptShownEventMapper)
+	eventstore.RegisterFilterEventMapper(AggregateType, HumanPasskeyPromptAcceptedType, HumanPasskeyPromptAcceptedEventMapper)
+	eventstore.RegisterFilterEventMapper(AggregateType, HumanPasskeyPromptSnoozedType, HumanPasskeyPromptSnoozedEventMapper)
+	eventstore.RegisterFilterEventMapper(AggregateType, HumanPasskeyPromptOptedOutType, HumanPasskeyPromptOptedOutEventMapper)
 	eventstore.RegisterFilterEventMapper(AggregateType, HumanMFAOTPAddedType, HumanOTPAddedEventMapper)
 	eventstore.RegisterFilterEventMapper(AggregateType, HumanMFAOTPVerifiedType, HumanOTPVerifiedEventMapper)
 	eventstore.RegisterFilterEventMapper(AggregateType, HumanMFAOTPRemovedType, HumanOTPRemovedEventMapper)
@@ -126,12 +135,31 @@ func init() {
 	eventstore.RegisterFilterEventMapper(AggregateType, HumanRefreshTokenAddedType, HumanRefreshTokenAddedEventMapper)
 	eventstore.RegisterFilterEventMapper(AggregateType, HumanRefreshTokenRenewedType, HumanRefreshTokenRenewedEventEventMapper)
 	eventstore.RegisterFilterEventMapper(AggregateType, HumanRefreshTokenRemovedType, HumanRefreshTokenRemovedEventEventMapper)
+	eventstore.RegisterFilterEventMapper(AggregateType, HumanTrustedDeviceAddedType, HumanTrustedDeviceAddedEventMapper)
+	eventstore.RegisterFilterEventMapper(AggregateType, HumanTrustedDeviceRemovedType, HumanTrustedDeviceRemovedEventMapper)
+	eventstore.RegisterFilterEventMapper(AggregateType, UserBreakGlassSetType, eventstore.GenericEventMapper[UserBreakGlassSetEvent])
+	eventstore.RegisterFilterEventMapper(AggregateType, UserBreakGlassRemovedType, eventstore.GenericEventMapper[UserBreakGlassRemovedEvent])
+	eventstore.RegisterFilterEventMapper(AggregateType, UserBreakGlassUsedType, eventstore.GenericEventMapper[UserBreakGlassUsedEvent])
+	eventstore.RegisterFilterEventMapper(AggregateType, UserNetworkAccessBlockedType, eventstore.GenericEventMapper[UserNetworkAccessBlockedEvent])
+	eventstore.RegisterFilterEventMapper(AggregateType, HumanDataExportRequestedType, HumanDataExportRequestedEventMapper)
+	eventstore.RegisterFilterEventMapper(AggregateType, HumanDataExportGeneratedType, HumanDataExportGeneratedEventMapper)
+	eventstore.RegisterFilterEventMapper(AggregateType, HumanDataExportFailedType, HumanDataExportFailedEventMapper)
+	eventstore.RegisterFilterEventMapper(AggregateType, HumanPushDeviceAddedType, HumanPushDeviceAddedEventMapper)
+	eventstore.RegisterFilterEventMapper(AggregateType, HumanPushDeviceRemovedType, HumanPushDeviceRemovedEventMapper)
+	eventstore.RegisterFilterEventMapper(AggregateType, HumanTermsAcceptedType, HumanTermsAcceptedEventMapper)
+	eventstore.RegisterFilterEventMapper(AggregateType, HumanConsentGrantedType, HumanConsentGrantedEventMapper)
+	eventstore.RegisterFilterEventMapper(AggregateType, HumanConsentRevokedType, HumanConsentRevokedEventMapper)
 	eventstore.RegisterFilterEventMapper(AggregateType, MachineAddedEventType, MachineAddedEventMapper)
 	eventstore.RegisterFilterEventMapper(AggregateType, MachineChangedEventType, MachineChangedEventMapper)
 	eventstore.RegisterFilterEventMapper(AggregateType, MachineKeyAddedEventType, MachineKeyAddedEventMapper)
 	eventstore.RegisterFilterEventMapper(AggregateType, MachineKeyRemovedEventType, MachineKeyRemovedEventMapper)
+	eventstore.RegisterFilterEventMapper(AggregateType, MachineKeyRevocationScheduledEventType, MachineKeyRevocationScheduledEventMapper)
+	eventstore.RegisterFilterEventMapper(AggregateType, MachineCredentialExpiringEventType, MachineCredentialExpiringEventMapper)
+	eventstore.RegisterFilterEventMapper(AggregateType, MachineCredentialExpiringNotificationSentEventType, MachineCredentialExpiringNotificationSentEventMapper)
 	eventstore.RegisterFilterEventMapper(AggregateType, PersonalAccessTokenAddedType, PersonalAccessTokenAddedEventMapper)
 	eventstore.RegisterFilterEventMapper(AggregateType, PersonalAccessTokenRemovedType, PersonalAccessTokenRemovedEventMapper)
+	eventstore.RegisterFilterEventMapper(AggregateType, OfflineTokenAddedType, OfflineTokenAddedEventMapper)
+	eventstore.RegisterFilterEventMapper(AggregateType, OfflineTokenRevokedType, OfflineTokenRevokedEventMapper)
 	eventstore.RegisterFilterEventMapper(AggregateType, MachineSecretSetType, MachineSecretSetEventMapper)
 	eventstore.RegisterFilterEventMapper(AggregateType, MachineSecretRemovedType, MachineSecretRemovedEventMapper)
 	eventstore.RegisterFilterEventMapper(AggregateType, MachineSecretCheckSucceededType, MachineSecretCheckSucceededEventMapper)
@@ -0,0 +1,101 @@
+package user
+
+import (
+	"context"
+
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+const (
+	consentEventPrefix      = humanEventPrefix + "consent."
+	HumanConsentGrantedType = consentEventPrefix + "granted"
+	HumanConsentRevokedType = consentEventPrefix + "revoked"
+)
+
+// HumanConsentGrantedEvent records the scopes the user consented to be released to the application.
+// Scopes contains all scopes consented to so far, not only the ones of the latest consent.
+type HumanConsentGrantedEvent struct {
+	eventstore.BaseEvent `json:"-"`
+
+	AppID  string   `json:"appId"`
+	Scopes []string `json:"scopes,omitempty"`
+}
+
+func (e *HumanConsentGrantedEvent) Payload() interface{} {
+	return e
+}
+
+func (e *HumanConsentGrantedEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+func NewHumanConsentGrantedEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	appID string,
+	scopes []string,
+) *HumanConsentGrantedEvent {
+	return &HumanConsentGrantedEvent{
+		BaseEvent: *eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			HumanConsentGrantedType,
+		),
+		AppID:  appID,
+		Scopes: scopes,
+	}
+}
+
+func HumanConsentGrantedEventMapper(event eventstore.Event) (eventstore.Event, error) {
+	consentGranted := &HumanConsentGrantedEvent{
+		BaseEvent: *eventstore.BaseEventFromRepo(event),
+	}
+	err := event.Unmarshal(consentGranted)
+	if err != nil {
+		return nil, zerrors.ThrowInternal(err, "USER-Cn3gr", "unable to unmarshal consent granted")
+	}
+
+	return consentGranted, nil
+}
+
+type HumanConsentRevokedEvent struct {
+	eventstore.BaseEvent `json:"-"`
+
+	AppID string `json:"appId"`
+}
+
+func (e *HumanConsentRevokedEvent) Payload() interface{} {
+	return e
+}
+
+func (e *HumanConsentRevokedEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+func NewHumanConsentRevokedEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	appID string,
+) *HumanConsentRevokedEvent {
+	return &HumanConsentRevokedEvent{
+		BaseEvent: *eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			HumanConsentRevokedType,
+		),
+		AppID: appID,
+	}
+}
+
+func HumanConsentRevokedEventMapper(event eventstore.Event) (eventstore.Event, error) {
+	consentRevoked := &HumanConsentRevokedEvent{
+		BaseEvent: *eventstore.BaseEventFromRepo(event),
+	}
+	err := event.Unmarshal(consentRevoked)
+	if err != nil {
+		return nil, zerrors.ThrowInternal(err, "USER-Cn5rv", "unable to unmarshal consent revoked")
+	}
+
+	return consentRevoked, nil
+}
@@ -0,0 +1,152 @@
+package user
+
+import (
+	"context"
+	"time"
+
+	"github.com/zitadel/zitadel/internal/crypto"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+const (
+	dataExportEventPrefix        = humanEventPrefix + "data.export."
+	HumanDataExportRequestedType = dataExportEventPrefix + "requested"
+	HumanDataExportGeneratedType = dataExportEventPrefix + "generated"
+	HumanDataExportFailedType    = dataExportEventPrefix + "failed"
+)
+
+type HumanDataExportRequestedEvent struct {
+	eventstore.BaseEvent `json:"-"`
+
+	ExportID string `json:"exportId"`
+}
+
+func (e *HumanDataExportRequestedEvent) Payload() interface{} {
+	return e
+}
+
+func (e *HumanDataExportRequestedEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+func NewHumanDataExportRequestedEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	exportID string,
+) *HumanDataExportRequestedEvent {
+	return &HumanDataExportRequestedEvent{
+		BaseEvent: *eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			HumanDataExportRequestedType,
+		),
+		ExportID: exportID,
+	}
+}
+
+func HumanDataExportRequestedEventMapper(event eventstore.Event) (eventstore.Event, error) {
+	exportRequested := &HumanDataExportRequestedEvent{
+		BaseEvent: *eventstore.BaseEventFromRepo(event),
+	}
+	err := event.Unmarshal(exportRequested)
+	if err != nil {
+		return nil, zerrors.ThrowInternal(err, "USER-Dx3kq8vn2m", "unable to unmarshal data export requested")
+	}
+
+	return exportRequested, nil
+}
+
+type HumanDataExportGeneratedEvent struct {
+	eventstore.BaseEvent `json:"-"`
+
+	ExportID   string              `json:"exportId"`
+	ObjectName string              `json:"objectName"`
+	Size       int64               `json:"size"`
+	SigningKey *crypto.CryptoValue `json:"signingKey"`
+	Expiration time.Time           `json:"expiration"`
+}
+
+func (e *HumanDataExportGeneratedEvent) Payload() interface{} {
+	return e
+}
+
+func (e *HumanDataExportGeneratedEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+func NewHumanDataExportGeneratedEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	exportID,
+	objectName string,
+	size int64,
+	signingKey *crypto.CryptoValue,
+	expiration time.Time,
+) *HumanDataExportGeneratedEvent {
+	return &HumanDataExportGeneratedEvent{
+		BaseEvent: *eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			HumanDataExportGeneratedType,
+		),
+		ExportID:   exportID,
+		ObjectName: objectName,
+		Size:       size,
+		SigningKey: signingKey,
+		Expiration: expiration,
+	}
+}
+
+func HumanDataExportGeneratedEventMapper(event eventstore.Event) (eventstore.Event, error) {
+	exportGenerated := &HumanDataExportGeneratedEvent{
+		BaseEvent: *eventstore.BaseEventFromRepo(event),
+	}
+	err := event.Unmarshal(exportGenerated)
+	if err != nil {
+		return nil, zerrors.ThrowInternal(err, "USER-Dx7mw2kq9r", "unable to unmarshal data export generated")
+	}
+
+	return exportGenerated, nil
+}
+
+type HumanDataExportFailedEvent struct {
+	eventstore.BaseEvent `json:"-"`
+
+	ExportID string `json:"exportId"`
+}
+
+func (e *HumanDataExportFailedEvent) Payload() interface{} {
+	return e
+}
+
+func (e *HumanDataExportFailedEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+func NewHumanDataExportFailedEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	exportID string,
+) *HumanDataExportFailedEvent {
+	return &HumanDataExportFailedEvent{
+		BaseEvent: *eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			HumanDataExportFailedType,
+		),
+		ExportID: exportID,
+	}
+}
+
+func HumanDataExportFailedEventMapper(event eventstore.Event) (eventstore.Event, error) {
+	exportFailed := &HumanDataExportFailedEvent{
+		BaseEvent: *eventstore.BaseEventFromRepo(event),
+	}
+	err := event.Unmarshal(exportFailed)
+	if err != nil {
+		return nil, zerrors.ThrowInternal(err, "USER-Dx2vn8kw4p", "unable to unmarshal data export failed")
+	}
+
+	return exportFailed, nil
+}
@@ -3,6 +3,7 @@ package user
 import (
 	"context"
 
+	"github.com/zitadel/zitadel/internal/domain"
 	"github.com/zitadel/zitadel/internal/eventstore"
 	"github.com/zitadel/zitadel/internal/zerrors"
 )
@@ -17,6 +18,7 @@ const (
 	UserIDPLinkCascadeRemovedType      = UserIDPLinkEventPrefix + "cascade.removed"
 	UserIDPExternalIDMigratedType      = UserIDPLinkEventPrefix + "id.migrated"
 	UserIDPExternalUsernameChangedType = UserIDPLinkEventPrefix + "username.changed"
+	UserIDPLinkAutoLinkedType          = UserIDPLinkEventPrefix + "auto.linked"
 
 	UserIDPLoginCheckSucceededType = idpLoginEventPrefix + "check.succeeded"
 )
@@ -287,3 +289,44 @@ func NewUserIDPExternalUsernameEvent(
 		ExternalUsername: externalUsername,
 	}
 }
+
+// UserIDPLinkAutoLinkedEvent is pushed together with the [UserIDPLinkAddedEvent]
+// when the login linked the external user to the user with the same verified email without user interaction
+type UserIDPLinkAutoLinkedEvent struct {
+	eventstore.BaseEvent `json:"-"`
+
+	IDPConfigID    string              `json:"idpConfigId,omitempty"`
+	ExternalUserID string              `json:"userId,omitempty"`
+	Email          domain.EmailAddress `json:"email,omitempty"`
+}
+
+func (e *UserIDPLinkAutoLinkedEvent) Payload() interface{} {
+	return e
+}
+
+func (e *UserIDPLinkAutoLinkedEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+func (e *UserIDPLinkAutoLinkedEvent) SetBaseEvent(event *eventstore.BaseEvent) {
+	e.BaseEvent = *event
+}
+
+func NewUserIDPLinkAutoLinkedEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	idpConfigID,
+	externalUserID string,
+	email domain.EmailAddress,
+) *UserIDPLinkAutoLinkedEvent {
+	return &UserIDPLinkAutoLinkedEvent{
+		BaseEvent: *eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			UserIDPLinkAutoLinkedType,
+		),
+		IDPConfigID:    idpConfigID,
+		ExternalUserID: externalUserID,
+		Email:          email,
+	}
+}
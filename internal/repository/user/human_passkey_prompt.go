@@ -0,0 +1,132 @@
+package user
+
+import (
+	"context"
+
+	"github.com/zitadel/zitadel/internal/eventstore"
+)
+
+const (
+	passkeyPromptEventPrefix       = humanEventPrefix + "passkey.prompt."
+	HumanPasskeyPromptShownType    = passkeyPromptEventPrefix + "shown"
+	HumanPasskeyPromptAcceptedType = passkeyPromptEventPrefix + "accepted"
+	HumanPasskeyPromptSnoozedType  = passkeyPromptEventPrefix + "snoozed"
+	HumanPasskeyPromptOptedOutType = passkeyPromptEventPrefix + "opted.out"
+)
+
+// HumanPasskeyPromptShownEvent is pushed when the login asked the user to register a passkey after the password login
+type HumanPasskeyPromptShownEvent struct {
+	eventstore.BaseEvent `json:"-"`
+}
+
+func (e *HumanPasskeyPromptShownEvent) Payload() interface{} {
+	return nil
+}
+
+func (e *HumanPasskeyPromptShownEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+func NewHumanPasskeyPromptShownEvent(ctx context.Context, aggregate *eventstore.Aggregate) *HumanPasskeyPromptShownEvent {
+	return &HumanPasskeyPromptShownEvent{
+		BaseEvent: *eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			HumanPasskeyPromptShownType,
+		),
+	}
+}
+
+func HumanPasskeyPromptShownEventMapper(event eventstore.Event) (eventstore.Event, error) {
+	return &HumanPasskeyPromptShownEvent{
+		BaseEvent: *eventstore.BaseEventFromRepo(event),
+	}, nil
+}
+
+// HumanPasskeyPromptAcceptedEvent is pushed when the user started the passkey registration from the prompt
+type HumanPasskeyPromptAcceptedEvent struct {
+	eventstore.BaseEvent `json:"-"`
+}
+
+func (e *HumanPasskeyPromptAcceptedEvent) Payload() interface{} {
+	return nil
+}
+
+func (e *HumanPasskeyPromptAcceptedEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+func NewHumanPasskeyPromptAcceptedEvent(ctx context.Context, aggregate *eventstore.Aggregate) *HumanPasskeyPromptAcceptedEvent {
+	return &HumanPasskeyPromptAcceptedEvent{
+		BaseEvent: *eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			HumanPasskeyPromptAcceptedType,
+		),
+	}
+}
+
+func HumanPasskeyPromptAcceptedEventMapper(event eventstore.Event) (eventstore.Event, error) {
+	return &HumanPasskeyPromptAcceptedEvent{
+		BaseEvent: *eventstore.BaseEventFromRepo(event),
+	}, nil
+}
+
+// HumanPasskeyPromptSnoozedEvent is pushed when the user chose to be reminded later,
+// the prompt is not shown again for the snooze lifetime of the login policy
+type HumanPasskeyPromptSnoozedEvent struct {
+	eventstore.BaseEvent `json:"-"`
+}
+
+func (e *HumanPasskeyPromptSnoozedEvent) Payload() interface{} {
+	return nil
+}
+
+func (e *HumanPasskeyPromptSnoozedEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+func NewHumanPasskeyPromptSnoozedEvent(ctx context.Context, aggregate *eventstore.Aggregate) *HumanPasskeyPromptSnoozedEvent {
+	return &HumanPasskeyPromptSnoozedEvent{
+		BaseEvent: *eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			HumanPasskeyPromptSnoozedType,
+		),
+	}
+}
+
+func HumanPasskeyPromptSnoozedEventMapper(event eventstore.Event) (eventstore.Event, error) {
+	return &HumanPasskeyPromptSnoozedEvent{
+		BaseEvent: *eventstore.BaseEventFromRepo(event),
+	}, nil
+}
+
+// HumanPasskeyPromptOptedOutEvent is pushed when the user chose to never be asked again
+type HumanPasskeyPromptOptedOutEvent struct {
+	eventstore.BaseEvent `json:"-"`
+}
+
+func (e *HumanPasskeyPromptOptedOutEvent) Payload() interface{} {
+	return nil
+}
+
+func (e *HumanPasskeyPromptOptedOutEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+func NewHumanPasskeyPromptOptedOutEvent(ctx context.Context, aggregate *eventstore.Aggregate) *HumanPasskeyPromptOptedOutEvent {
+	return &HumanPasskeyPromptOptedOutEvent{
+		BaseEvent: *eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			HumanPasskeyPromptOptedOutType,
+		),
+	}
+}
+
+func HumanPasskeyPromptOptedOutEventMapper(event eventstore.Event) (eventstore.Event, error) {
+	return &HumanPasskeyPromptOptedOutEvent{
+		BaseEvent: *eventstore.BaseEventFromRepo(event),
+	}, nil
+}
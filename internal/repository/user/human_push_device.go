@@ -0,0 +1,106 @@
+package user
+
+import (
+	"context"
+
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+const (
+	pushDeviceEventPrefix      = humanEventPrefix + "push.device."
+	HumanPushDeviceAddedType   = pushDeviceEventPrefix + "added"
+	HumanPushDeviceRemovedType = pushDeviceEventPrefix + "removed"
+)
+
+type HumanPushDeviceAddedEvent struct {
+	eventstore.BaseEvent `json:"-"`
+
+	DeviceID string              `json:"deviceId"`
+	Platform domain.PushPlatform `json:"platform"`
+	Token    string              `json:"token"`
+	Name     string              `json:"name,omitempty"`
+}
+
+func (e *HumanPushDeviceAddedEvent) Payload() interface{} {
+	return e
+}
+
+func (e *HumanPushDeviceAddedEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+func NewHumanPushDeviceAddedEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	deviceID string,
+	platform domain.PushPlatform,
+	token,
+	name string,
+) *HumanPushDeviceAddedEvent {
+	return &HumanPushDeviceAddedEvent{
+		BaseEvent: *eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			HumanPushDeviceAddedType,
+		),
+		DeviceID: deviceID,
+		Platform: platform,
+		Token:    token,
+		Name:     name,
+	}
+}
+
+func HumanPushDeviceAddedEventMapper(event eventstore.Event) (eventstore.Event, error) {
+	deviceAdded := &HumanPushDeviceAddedEvent{
+		BaseEvent: *eventstore.BaseEventFromRepo(event),
+	}
+	err := event.Unmarshal(deviceAdded)
+	if err != nil {
+		return nil, zerrors.ThrowInternal(err, "USER-Pd4kr", "unable to unmarshal push device added")
+	}
+
+	return deviceAdded, nil
+}
+
+type HumanPushDeviceRemovedEvent struct {
+	eventstore.BaseEvent `json:"-"`
+
+	DeviceID string `json:"deviceId"`
+}
+
+func (e *HumanPushDeviceRemovedEvent) Payload() interface{} {
+	return e
+}
+
+func (e *HumanPushDeviceRemovedEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+func NewHumanPushDeviceRemovedEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	deviceID string,
+) *HumanPushDeviceRemovedEvent {
+	return &HumanPushDeviceRemovedEvent{
+		BaseEvent: *eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			HumanPushDeviceRemovedType,
+		),
+		DeviceID: deviceID,
+	}
+}
+
+func HumanPushDeviceRemovedEventMapper(event eventstore.Event) (eventstore.Event, error) {
+	deviceRemoved := &HumanPushDeviceRemovedEvent{
+		BaseEvent: *eventstore.BaseEventFromRepo(event),
+	}
+	err := event.Unmarshal(deviceRemoved)
+	if err != nil {
+		return nil, zerrors.ThrowInternal(err, "USER-Pd7wm", "unable to unmarshal push device removed")
+	}
+
+	return deviceRemoved, nil
+}
@@ -0,0 +1,58 @@
+package user
+
+import (
+	"context"
+
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+const (
+	HumanTermsAcceptedType = humanEventPrefix + "terms.accepted"
+)
+
+// HumanTermsAcceptedEvent records the acceptance of a version of the terms of service and privacy policy.
+// TermsOwner is the organization or instance which published the version.
+type HumanTermsAcceptedEvent struct {
+	eventstore.BaseEvent `json:"-"`
+
+	Version    string `json:"version"`
+	TermsOwner string `json:"termsOwner"`
+}
+
+func (e *HumanTermsAcceptedEvent) Payload() interface{} {
+	return e
+}
+
+func (e *HumanTermsAcceptedEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+func NewHumanTermsAcceptedEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	version,
+	termsOwner string,
+) *HumanTermsAcceptedEvent {
+	return &HumanTermsAcceptedEvent{
+		BaseEvent: *eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			HumanTermsAcceptedType,
+		),
+		Version:    version,
+		TermsOwner: termsOwner,
+	}
+}
+
+func HumanTermsAcceptedEventMapper(event eventstore.Event) (eventstore.Event, error) {
+	termsAccepted := &HumanTermsAcceptedEvent{
+		BaseEvent: *eventstore.BaseEventFromRepo(event),
+	}
+	err := event.Unmarshal(termsAccepted)
+	if err != nil {
+		return nil, zerrors.ThrowInternal(err, "USER-Ta5cq", "unable to unmarshal terms accepted")
+	}
+
+	return termsAccepted, nil
+}
@@ -0,0 +1,106 @@
+package user
+
+import (
+	"context"
+	"time"
+
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+const (
+	trustedDeviceEventPrefix      = humanEventPrefix + "trusted.device."
+	HumanTrustedDeviceAddedType   = trustedDeviceEventPrefix + "added"
+	HumanTrustedDeviceRemovedType = trustedDeviceEventPrefix + "removed"
+)
+
+type HumanTrustedDeviceAddedEvent struct {
+	eventstore.BaseEvent `json:"-"`
+
+	DeviceID    string    `json:"deviceId"`
+	UserAgentID string    `json:"userAgentId"`
+	Description string    `json:"description,omitempty"`
+	Expiration  time.Time `json:"expiration"`
+}
+
+func (e *HumanTrustedDeviceAddedEvent) Payload() interface{} {
+	return e
+}
+
+func (e *HumanTrustedDeviceAddedEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+func NewHumanTrustedDeviceAddedEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	deviceID,
+	userAgentID,
+	description string,
+	expiration time.Time,
+) *HumanTrustedDeviceAddedEvent {
+	return &HumanTrustedDeviceAddedEvent{
+		BaseEvent: *eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			HumanTrustedDeviceAddedType,
+		),
+		DeviceID:    deviceID,
+		UserAgentID: userAgentID,
+		Description: description,
+		Expiration:  expiration,
+	}
+}
+
+func HumanTrustedDeviceAddedEventMapper(event eventstore.Event) (eventstore.Event, error) {
+	deviceAdded := &HumanTrustedDeviceAddedEvent{
+		BaseEvent: *eventstore.BaseEventFromRepo(event),
+	}
+	err := event.Unmarshal(deviceAdded)
+	if err != nil {
+		return nil, zerrors.ThrowInternal(err, "USER-Td3kq", "unable to unmarshal trusted device added")
+	}
+
+	return deviceAdded, nil
+}
+
+type HumanTrustedDeviceRemovedEvent struct {
+	eventstore.BaseEvent `json:"-"`
+
+	DeviceID string `json:"deviceId"`
+}
+
+func (e *HumanTrustedDeviceRemovedEvent) Payload() interface{} {
+	return e
+}
+
+func (e *HumanTrustedDeviceRemovedEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+func NewHumanTrustedDeviceRemovedEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	deviceID string,
+) *HumanTrustedDeviceRemovedEvent {
+	return &HumanTrustedDeviceRemovedEvent{
+		BaseEvent: *eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			HumanTrustedDeviceRemovedType,
+		),
+		DeviceID: deviceID,
+	}
+}
+
+func HumanTrustedDeviceRemovedEventMapper(event eventstore.Event) (eventstore.Event, error) {
+	deviceRemoved := &HumanTrustedDeviceRemovedEvent{
+		BaseEvent: *eventstore.BaseEventFromRepo(event),
+	}
+	err := event.Unmarshal(deviceRemoved)
+	if err != nil {
+		return nil, zerrors.ThrowInternal(err, "USER-Td8mw", "unable to unmarshal trusted device removed")
+	}
+
+	return deviceRemoved, nil
+}
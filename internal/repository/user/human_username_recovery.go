@@ -0,0 +1,97 @@
+package user
+
+import (
+	"context"
+
+	"github.com/zitadel/zitadel/internal/api/http"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+const (
+	usernameRecoveryEventPrefix        = humanEventPrefix + "username.recovery."
+	HumanUsernameRecoveryRequestedType = usernameRecoveryEventPrefix + "requested"
+	HumanUsernameRecoverySentType      = usernameRecoveryEventPrefix + "sent"
+)
+
+// HumanUsernameRecoveryRequestedEvent is pushed when a user requested to receive their login names,
+// e.g. through the "forgot username" flow of the login.
+type HumanUsernameRecoveryRequestedEvent struct {
+	eventstore.BaseEvent `json:"-"`
+
+	NotificationType  domain.NotificationType `json:"notificationType,omitempty"`
+	TriggeredAtOrigin string                  `json:"triggerOrigin,omitempty"`
+	// AuthRequest is only used in V1 Login UI
+	AuthRequestID string `json:"authRequestID,omitempty"`
+}
+
+func (e *HumanUsernameRecoveryRequestedEvent) Payload() interface{} {
+	return e
+}
+
+func (e *HumanUsernameRecoveryRequestedEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+func (e *HumanUsernameRecoveryRequestedEvent) TriggerOrigin() string {
+	return e.TriggeredAtOrigin
+}
+
+func NewHumanUsernameRecoveryRequestedEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	notificationType domain.NotificationType,
+	authRequestID string,
+) *HumanUsernameRecoveryRequestedEvent {
+	return &HumanUsernameRecoveryRequestedEvent{
+		BaseEvent: *eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			HumanUsernameRecoveryRequestedType,
+		),
+		NotificationType:  notificationType,
+		TriggeredAtOrigin: http.ComposedOrigin(ctx),
+		AuthRequestID:     authRequestID,
+	}
+}
+
+func HumanUsernameRecoveryRequestedEventMapper(event eventstore.Event) (eventstore.Event, error) {
+	requested := &HumanUsernameRecoveryRequestedEvent{
+		BaseEvent: *eventstore.BaseEventFromRepo(event),
+	}
+	err := event.Unmarshal(requested)
+	if err != nil {
+		return nil, zerrors.ThrowInternal(err, "USER-Rec4u", "unable to unmarshal human username recovery requested")
+	}
+
+	return requested, nil
+}
+
+type HumanUsernameRecoverySentEvent struct {
+	eventstore.BaseEvent `json:"-"`
+}
+
+func (e *HumanUsernameRecoverySentEvent) Payload() interface{} {
+	return nil
+}
+
+func (e *HumanUsernameRecoverySentEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+func NewHumanUsernameRecoverySentEvent(ctx context.Context, aggregate *eventstore.Aggregate) *HumanUsernameRecoverySentEvent {
+	return &HumanUsernameRecoverySentEvent{
+		BaseEvent: *eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			HumanUsernameRecoverySentType,
+		),
+	}
+}
+
+func HumanUsernameRecoverySentEventMapper(event eventstore.Event) (eventstore.Event, error) {
+	return &HumanUsernameRecoverySentEvent{
+		BaseEvent: *eventstore.BaseEventFromRepo(event),
+	}, nil
+}
@@ -0,0 +1,103 @@
+package user
+
+import (
+	"context"
+	"time"
+
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+const (
+	lifecycleEventTypePrefix                = userEventTypePrefix + "lifecycle."
+	UserLifecycleActionScheduledType        = lifecycleEventTypePrefix + "action.scheduled"
+	UserLifecycleActionNotificationSentType = lifecycleEventTypePrefix + "action.notification.sent"
+)
+
+// LifecycleActionScheduledEvent announces the deactivation or deletion of the inactive user,
+// the owners of the organization are notified about it.
+type LifecycleActionScheduledEvent struct {
+	eventstore.BaseEvent `json:"-"`
+
+	Action  domain.UserLifecycleAction `json:"action,omitempty"`
+	DueDate time.Time                  `json:"dueDate,omitempty"`
+}
+
+func (e *LifecycleActionScheduledEvent) Payload() interface{} {
+	return e
+}
+
+func (e *LifecycleActionScheduledEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+func NewLifecycleActionScheduledEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	action domain.UserLifecycleAction,
+	dueDate time.Time,
+) *LifecycleActionScheduledEvent {
+	return &LifecycleActionScheduledEvent{
+		BaseEvent: *eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			UserLifecycleActionScheduledType,
+		),
+		Action:  action,
+		DueDate: dueDate,
+	}
+}
+
+func LifecycleActionScheduledEventMapper(event eventstore.Event) (eventstore.Event, error) {
+	actionScheduled := &LifecycleActionScheduledEvent{
+		BaseEvent: *eventstore.BaseEventFromRepo(event),
+	}
+	err := event.Unmarshal(actionScheduled)
+	if err != nil {
+		return nil, zerrors.ThrowInternal(err, "USER-Lc4sd", "unable to unmarshal user lifecycle action scheduled")
+	}
+
+	return actionScheduled, nil
+}
+
+type LifecycleActionNotificationSentEvent struct {
+	eventstore.BaseEvent `json:"-"`
+
+	Action domain.UserLifecycleAction `json:"action,omitempty"`
+}
+
+func (e *LifecycleActionNotificationSentEvent) Payload() interface{} {
+	return e
+}
+
+func (e *LifecycleActionNotificationSentEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+func NewLifecycleActionNotificationSentEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	action domain.UserLifecycleAction,
+) *LifecycleActionNotificationSentEvent {
+	return &LifecycleActionNotificationSentEvent{
+		BaseEvent: *eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			UserLifecycleActionNotificationSentType,
+		),
+		Action: action,
+	}
+}
+
+func LifecycleActionNotificationSentEventMapper(event eventstore.Event) (eventstore.Event, error) {
+	notificationSent := &LifecycleActionNotificationSentEvent{
+		BaseEvent: *eventstore.BaseEventFromRepo(event),
+	}
+	err := event.Unmarshal(notificationSent)
+	if err != nil {
+		return nil, zerrors.ThrowInternal(err, "USER-Lc9ns", "unable to unmarshal user lifecycle action notification sent")
+	}
+
+	return notificationSent, nil
+}
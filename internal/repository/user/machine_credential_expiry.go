@@ -0,0 +1,106 @@
+package user
+
+import (
+	"context"
+	"time"
+
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+const (
+	machineCredentialEventPrefix                       = machineEventPrefix + "credential."
+	MachineCredentialExpiringEventType                 = machineCredentialEventPrefix + "expiring"
+	MachineCredentialExpiringNotificationSentEventType = MachineCredentialExpiringEventType + ".notification.sent"
+)
+
+// MachineCredentialExpiringEvent flags a key or personal access token of the machine user which expires soon,
+// the owners of the organization are notified about it.
+type MachineCredentialExpiringEvent struct {
+	eventstore.BaseEvent `json:"-"`
+
+	CredentialType domain.MachineCredentialType `json:"credentialType,omitempty"`
+	CredentialID   string                       `json:"credentialId,omitempty"`
+	ExpirationDate time.Time                    `json:"expirationDate,omitempty"`
+}
+
+func (e *MachineCredentialExpiringEvent) Payload() interface{} {
+	return e
+}
+
+func (e *MachineCredentialExpiringEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+func NewMachineCredentialExpiringEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	credentialType domain.MachineCredentialType,
+	credentialID string,
+	expirationDate time.Time,
+) *MachineCredentialExpiringEvent {
+	return &MachineCredentialExpiringEvent{
+		BaseEvent: *eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			MachineCredentialExpiringEventType,
+		),
+		CredentialType: credentialType,
+		CredentialID:   credentialID,
+		ExpirationDate: expirationDate,
+	}
+}
+
+func MachineCredentialExpiringEventMapper(event eventstore.Event) (eventstore.Event, error) {
+	expiring := &MachineCredentialExpiringEvent{
+		BaseEvent: *eventstore.BaseEventFromRepo(event),
+	}
+	err := event.Unmarshal(expiring)
+	if err != nil {
+		return nil, zerrors.ThrowInternal(err, "USER-Mc4ex", "unable to unmarshal machine credential expiring")
+	}
+
+	return expiring, nil
+}
+
+type MachineCredentialExpiringNotificationSentEvent struct {
+	eventstore.BaseEvent `json:"-"`
+
+	CredentialID string `json:"credentialId,omitempty"`
+}
+
+func (e *MachineCredentialExpiringNotificationSentEvent) Payload() interface{} {
+	return e
+}
+
+func (e *MachineCredentialExpiringNotificationSentEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+func NewMachineCredentialExpiringNotificationSentEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	credentialID string,
+) *MachineCredentialExpiringNotificationSentEvent {
+	return &MachineCredentialExpiringNotificationSentEvent{
+		BaseEvent: *eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			MachineCredentialExpiringNotificationSentEventType,
+		),
+		CredentialID: credentialID,
+	}
+}
+
+func MachineCredentialExpiringNotificationSentEventMapper(event eventstore.Event) (eventstore.Event, error) {
+	notificationSent := &MachineCredentialExpiringNotificationSentEvent{
+		BaseEvent: *eventstore.BaseEventFromRepo(event),
+	}
+	err := event.Unmarshal(notificationSent)
+	if err != nil {
+		return nil, zerrors.ThrowInternal(err, "USER-Mc9ns", "unable to unmarshal machine credential expiring notification sent")
+	}
+
+	return notificationSent, nil
+}
@@ -14,6 +14,8 @@ const (
 	machineKeyEventPrefix      = machineEventPrefix + "key."
 	MachineKeyAddedEventType   = machineKeyEventPrefix + "added"
 	MachineKeyRemovedEventType = machineKeyEventPrefix + "removed"
+
+	MachineKeyRevocationScheduledEventType = machineKeyEventPrefix + "revocation.scheduled"
 )
 
 type MachineKeyAddedEvent struct {
@@ -111,3 +113,49 @@ func MachineKeyRemovedEventMapper(event eventstore.Event) (eventstore.Event, err
 
 	return machineRemoved, nil
 }
+
+// MachineKeyRevocationScheduledEvent shortens the validity of a rotated key,
+// the key can't be used to authenticate after the revocation date.
+type MachineKeyRevocationScheduledEvent struct {
+	eventstore.BaseEvent `json:"-"`
+
+	KeyID          string    `json:"keyId,omitempty"`
+	RevocationDate time.Time `json:"revocationDate,omitempty"`
+}
+
+func (e *MachineKeyRevocationScheduledEvent) Payload() interface{} {
+	return e
+}
+
+func (e *MachineKeyRevocationScheduledEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+func NewMachineKeyRevocationScheduledEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	keyID string,
+	revocationDate time.Time,
+) *MachineKeyRevocationScheduledEvent {
+	return &MachineKeyRevocationScheduledEvent{
+		BaseEvent: *eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			MachineKeyRevocationScheduledEventType,
+		),
+		KeyID:          keyID,
+		RevocationDate: revocationDate,
+	}
+}
+
+func MachineKeyRevocationScheduledEventMapper(event eventstore.Event) (eventstore.Event, error) {
+	revocationScheduled := &MachineKeyRevocationScheduledEvent{
+		BaseEvent: *eventstore.BaseEventFromRepo(event),
+	}
+	err := event.Unmarshal(revocationScheduled)
+	if err != nil {
+		return nil, zerrors.ThrowInternal(err, "USER-Mk3rs", "unable to unmarshal machine key revocation scheduled")
+	}
+
+	return revocationScheduled, nil
+}
@@ -0,0 +1,69 @@
+package user
+
+import (
+	"context"
+
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/geoip"
+)
+
+const (
+	UserNetworkAccessBlockedType = userEventTypePrefix + "network_access.blocked"
+)
+
+// UserNetworkAccessBlockedEvent is pushed when a login or a token request of the user
+// was rejected by a network access policy of the organization or the application.
+// If the policy is in monitor only mode, the request wasn't rejected and the event records the would-be block.
+type UserNetworkAccessBlockedEvent struct {
+	eventstore.BaseEvent `json:"-"`
+
+	Phase       domain.NetworkAccessPhase       `json:"phase"`
+	Level       domain.NetworkAccessPolicyLevel `json:"level"`
+	RemoteIP    string                          `json:"remoteIp,omitempty"`
+	ClientID    string                          `json:"clientId,omitempty"`
+	Country     string                          `json:"country,omitempty"`
+	ASN         uint32                          `json:"asn,omitempty"`
+	MonitorOnly bool                            `json:"monitorOnly,omitempty"`
+}
+
+func (e *UserNetworkAccessBlockedEvent) SetBaseEvent(b *eventstore.BaseEvent) {
+	e.BaseEvent = *b
+}
+
+func (e *UserNetworkAccessBlockedEvent) Payload() interface{} {
+	return e
+}
+
+func (e *UserNetworkAccessBlockedEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+func NewUserNetworkAccessBlockedEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	phase domain.NetworkAccessPhase,
+	level domain.NetworkAccessPolicyLevel,
+	remoteIP,
+	clientID string,
+	location *geoip.Location,
+	monitorOnly bool,
+) *UserNetworkAccessBlockedEvent {
+	event := &UserNetworkAccessBlockedEvent{
+		BaseEvent: *eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			UserNetworkAccessBlockedType,
+		),
+		Phase:       phase,
+		Level:       level,
+		RemoteIP:    remoteIP,
+		ClientID:    clientID,
+		MonitorOnly: monitorOnly,
+	}
+	if location != nil {
+		event.Country = location.Country
+		event.ASN = location.ASN
+	}
+	return event
+}
@@ -0,0 +1,112 @@
+package user
+
+import (
+	"context"
+	"time"
+
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+const (
+	offlineTokenEventPrefix = userEventTypePrefix + "offline.token."
+	OfflineTokenAddedType   = offlineTokenEventPrefix + "added"
+	OfflineTokenRevokedType = offlineTokenEventPrefix + "revoked"
+)
+
+// OfflineTokenAddedEvent is a long-lived token of the user issued to a first-party application.
+// The json keys match the ones of the [UserTokenAddedEvent], so the token is verified the same way.
+type OfflineTokenAddedEvent struct {
+	eventstore.BaseEvent `json:"-"`
+
+	TokenID string `json:"tokenId"`
+	// ApplicationID is the client id of the application the token was issued to
+	ApplicationID string    `json:"applicationId"`
+	Audience      []string  `json:"audience"`
+	Expiration    time.Time `json:"expiration"`
+	Description   string    `json:"description,omitempty"`
+}
+
+func (e *OfflineTokenAddedEvent) Payload() interface{} {
+	return e
+}
+
+func (e *OfflineTokenAddedEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+func NewOfflineTokenAddedEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	tokenID,
+	clientID string,
+	audience []string,
+	expiration time.Time,
+	description string,
+) *OfflineTokenAddedEvent {
+	return &OfflineTokenAddedEvent{
+		BaseEvent: *eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			OfflineTokenAddedType,
+		),
+		TokenID:       tokenID,
+		ApplicationID: clientID,
+		Audience:      audience,
+		Expiration:    expiration,
+		Description:   description,
+	}
+}
+
+func OfflineTokenAddedEventMapper(event eventstore.Event) (eventstore.Event, error) {
+	tokenAdded := &OfflineTokenAddedEvent{
+		BaseEvent: *eventstore.BaseEventFromRepo(event),
+	}
+	err := event.Unmarshal(tokenAdded)
+	if err != nil {
+		return nil, zerrors.ThrowInternal(err, "USER-Ot1ka", "unable to unmarshal offline token added")
+	}
+
+	return tokenAdded, nil
+}
+
+type OfflineTokenRevokedEvent struct {
+	eventstore.BaseEvent `json:"-"`
+
+	TokenID string `json:"tokenId"`
+}
+
+func (e *OfflineTokenRevokedEvent) Payload() interface{} {
+	return e
+}
+
+func (e *OfflineTokenRevokedEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+func NewOfflineTokenRevokedEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	tokenID string,
+) *OfflineTokenRevokedEvent {
+	return &OfflineTokenRevokedEvent{
+		BaseEvent: *eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			OfflineTokenRevokedType,
+		),
+		TokenID: tokenID,
+	}
+}
+
+func OfflineTokenRevokedEventMapper(event eventstore.Event) (eventstore.Event, error) {
+	tokenRevoked := &OfflineTokenRevokedEvent{
+		BaseEvent: *eventstore.BaseEventFromRepo(event),
+	}
+	err := event.Unmarshal(tokenRevoked)
+	if err != nil {
+		return nil, zerrors.ThrowInternal(err, "USER-Ot2rv", "unable to unmarshal offline token revoked")
+	}
+
+	return tokenRevoked, nil
+}
@@ -20,6 +20,12 @@ type PersonalAccessTokenAddedEvent struct {
 	TokenID    string    `json:"tokenId"`
 	Expiration time.Time `json:"expiration"`
 	Scopes     []string  `json:"scopes"`
+	// Audience restricts the token to the listed projects, all projects are allowed if empty
+	Audience []string `json:"audience,omitempty"`
+	// Permissions restricts the token to the listed permissions of the user, all permissions are granted if empty
+	Permissions []string `json:"permissions,omitempty"`
+	// AllowedIPs restricts the token to requests from the listed IP addresses and networks, all addresses are allowed if empty
+	AllowedIPs []string `json:"allowedIps,omitempty"`
 }
 
 func (e *PersonalAccessTokenAddedEvent) Payload() interface{} {
@@ -35,7 +41,10 @@ func NewPersonalAccessTokenAddedEvent(
 	aggregate *eventstore.Aggregate,
 	tokenID string,
 	expiration time.Time,
-	scopes []string,
+	scopes,
+	audience,
+	permissions,
+	allowedIPs []string,
 ) *PersonalAccessTokenAddedEvent {
 	return &PersonalAccessTokenAddedEvent{
 		BaseEvent: *eventstore.NewBaseEventForPush(
@@ -43,9 +52,12 @@ func NewPersonalAccessTokenAddedEvent(
 			aggregate,
 			PersonalAccessTokenAddedType,
 		),
-		TokenID:    tokenID,
-		Expiration: expiration,
-		Scopes:     scopes,
+		TokenID:     tokenID,
+		Expiration:  expiration,
+		Scopes:      scopes,
+		Audience:    audience,
+		Permissions: permissions,
+		AllowedIPs:  allowedIPs,
 	}
 }
 
@@ -0,0 +1,201 @@
+package retention
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/zitadel/logging"
+	"go.opentelemetry.io/otel/attribute"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/config/systemdefaults"
+	"github.com/zitadel/zitadel/internal/database"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/query"
+	"github.com/zitadel/zitadel/internal/query/projection"
+	"github.com/zitadel/zitadel/internal/repository/user"
+	"github.com/zitadel/zitadel/internal/scheduledjob"
+	"github.com/zitadel/zitadel/internal/telemetry/metrics"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+const (
+	lockName = "retention"
+
+	defaultBatchSize = 1000
+
+	anonymizedPayloadsCounter   = "retention_anonymized_event_payloads"
+	deletedNotificationsCounter = "retention_deleted_notifications"
+	compactedFailuresCounter    = "retention_compacted_login_failures"
+)
+
+// loginFailureEventTypes are the failed checks of users, which are compacted
+var loginFailureEventTypes = []string{
+	string(user.HumanPasswordCheckFailedType),
+	string(user.HumanMFAOTPCheckFailedType),
+	string(user.HumanOTPSMSCheckFailedType),
+	string(user.HumanOTPEmailCheckFailedType),
+	string(user.HumanU2FTokenCheckFailedType),
+	string(user.HumanPasswordlessTokenCheckFailedType),
+}
+
+// anonymizeRemovedUsersStmt erases the payloads of the events of users, which were removed before the given date.
+// The events are kept, so the sequences of the aggregates and the positions of the projections stay consistent.
+const anonymizeRemovedUsersStmt = `WITH batch AS (
+	SELECT e.instance_id, e.aggregate_type, e.aggregate_id, e."sequence"
+	FROM eventstore.events2 e
+	JOIN eventstore.events2 r
+		ON r.instance_id = e.instance_id
+		AND r.aggregate_type = e.aggregate_type
+		AND r.aggregate_id = e.aggregate_id
+	WHERE e.instance_id = $1
+		AND e.aggregate_type = $2
+		AND e.payload IS NOT NULL
+		AND r.event_type = $3
+		AND r.created_at < $4
+	LIMIT $5
+)
+UPDATE eventstore.events2 SET payload = NULL
+WHERE (instance_id, aggregate_type, aggregate_id, "sequence") IN (SELECT instance_id, aggregate_type, aggregate_id, "sequence" FROM batch)`
+
+// compactLoginFailuresStmt deletes failed checks created before the given date.
+// The last event of a user is never deleted, so the sequence of the aggregate is never reused.
+const compactLoginFailuresStmt = `WITH batch AS (
+	SELECT f.instance_id, f.aggregate_type, f.aggregate_id, f."sequence"
+	FROM eventstore.events2 f
+	WHERE f.instance_id = $1
+		AND f.aggregate_type = $2
+		AND f.event_type = ANY($3)
+		AND f.created_at < $4
+		AND EXISTS (
+			SELECT 1 FROM eventstore.events2 l
+			WHERE l.instance_id = f.instance_id
+				AND l.aggregate_type = f.aggregate_type
+				AND l.aggregate_id = f.aggregate_id
+				AND l."sequence" > f."sequence"
+		)
+	LIMIT $5
+)
+DELETE FROM eventstore.events2
+WHERE (instance_id, aggregate_type, aggregate_id, "sequence") IN (SELECT instance_id, aggregate_type, aggregate_id, "sequence" FROM batch)`
+
+var (
+	// deleteSentNotificationsStmt deletes notifications, which were sent by a retry, from the notification queue
+	deleteSentNotificationsStmt = fmt.Sprintf(`DELETE FROM %[1]s WHERE (%[2]s, %[3]s) IN (
+	SELECT %[2]s, %[3]s FROM %[1]s WHERE %[2]s = $1 AND %[4]s = $2 AND %[5]s < $3 LIMIT $4
+)`,
+		projection.NotificationQueueTable,
+		projection.NotificationQueueInstanceIDCol,
+		projection.NotificationQueueIDCol,
+		projection.NotificationQueueStateCol,
+		projection.NotificationQueueChangeDateCol,
+	)
+	// deleteNotificationStatusesStmt deletes the delivery statuses of notifications
+	deleteNotificationStatusesStmt = fmt.Sprintf(`DELETE FROM %[1]s WHERE (%[2]s, %[3]s) IN (
+	SELECT %[2]s, %[3]s FROM %[1]s WHERE %[2]s = $1 AND %[4]s < $2 LIMIT $3
+)`,
+		projection.NotificationStatusTable,
+		projection.NotificationStatusInstanceIDCol,
+		projection.NotificationStatusIDCol,
+		projection.NotificationStatusChangeDateCol,
+	)
+)
+
+type job struct {
+	config systemdefaults.Retention
+	client *database.DB
+}
+
+// Start applies the retention of events and notifications of all instances on every interval
+// until the context is done. An interval of 0 disables the retention.
+func Start(ctx context.Context, config systemdefaults.Retention, queries *query.Queries, client *database.DB) {
+	if config.Interval <= 0 {
+		return
+	}
+	if config.BatchSize == 0 {
+		config.BatchSize = defaultBatchSize
+	}
+	registerCounter(anonymizedPayloadsCounter, "Event payloads of removed users erased by the retention")
+	registerCounter(deletedNotificationsCounter, "Notification records deleted by the retention")
+	registerCounter(compactedFailuresCounter, "Failed login checks deleted by the retention")
+	j := &job{
+		config: config,
+		client: client,
+	}
+	scheduledjob.Start(ctx, lockName, config.Interval, queries, client, j.apply)
+}
+
+func registerCounter(counter, desc string) {
+	err := metrics.RegisterCounter(counter, desc)
+	logging.WithFields("metric", counter).OnError(err).Panic("unable to register counter")
+}
+
+// apply applies the retention to the events and notifications of the instance.
+func (j *job) apply(ctx context.Context) error {
+	return j.applyInstance(ctx, authz.GetInstance(ctx).InstanceID(), time.Now())
+}
+
+func (j *job) applyInstance(ctx context.Context, instanceID string, now time.Time) error {
+	if j.config.RemovedUserPayloads > 0 {
+		err := j.inBatches(ctx, instanceID, "anonymize removed users", anonymizedPayloadsCounter, func() (sql.Result, error) {
+			return j.client.ExecContext(ctx, anonymizeRemovedUsersStmt,
+				instanceID, user.AggregateType, user.UserRemovedType, now.Add(-j.config.RemovedUserPayloads), j.config.BatchSize)
+		})
+		if err != nil {
+			return err
+		}
+	}
+	if j.config.Notifications > 0 {
+		err := j.inBatches(ctx, instanceID, "delete sent notifications", deletedNotificationsCounter, func() (sql.Result, error) {
+			return j.client.ExecContext(ctx, deleteSentNotificationsStmt,
+				instanceID, domain.NotificationQueueStateSent, now.Add(-j.config.Notifications), j.config.BatchSize)
+		})
+		if err != nil {
+			return err
+		}
+		err = j.inBatches(ctx, instanceID, "delete notification statuses", deletedNotificationsCounter, func() (sql.Result, error) {
+			return j.client.ExecContext(ctx, deleteNotificationStatusesStmt,
+				instanceID, now.Add(-j.config.Notifications), j.config.BatchSize)
+		})
+		if err != nil {
+			return err
+		}
+	}
+	if j.config.LoginFailures > 0 {
+		return j.inBatches(ctx, instanceID, "compact login failures", compactedFailuresCounter, func() (sql.Result, error) {
+			return j.client.ExecContext(ctx, compactLoginFailuresStmt,
+				instanceID, user.AggregateType, database.TextArray[string](loginFailureEventTypes), now.Add(-j.config.LoginFailures), j.config.BatchSize)
+		})
+	}
+	return nil
+}
+
+// inBatches executes the statement until it affects less rows than the batch size
+// and reports the progress after every batch
+func (j *job) inBatches(ctx context.Context, instanceID, step, counter string, exec func() (sql.Result, error)) error {
+	var total int64
+	for {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		result, err := exec()
+		if err != nil {
+			return zerrors.ThrowInternalf(err, "RETEN-v3k9xq2m7d", "retention step %s failed", step)
+		}
+		affected, err := result.RowsAffected()
+		if err != nil {
+			return err
+		}
+		total += affected
+		if affected > 0 {
+			err = metrics.AddCount(ctx, counter, affected, map[string]attribute.Value{"instance": attribute.StringValue(instanceID)})
+			logging.OnError(err).WithField("metric", counter).Warn("unable to add count")
+			logging.WithFields("instance", instanceID, "step", step, "affected", total).Info("retention progress")
+		}
+		if affected < int64(j.config.BatchSize) {
+			return nil
+		}
+	}
+}
@@ -0,0 +1,46 @@
+package samlmetadata
+
+import (
+	"context"
+	"time"
+
+	"github.com/zitadel/zitadel/internal/command"
+	"github.com/zitadel/zitadel/internal/database"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/query"
+	"github.com/zitadel/zitadel/internal/scheduledjob"
+)
+
+const lockName = "saml_metadata_refresh"
+
+type job struct {
+	commands *command.Commands
+	queries  *query.Queries
+}
+
+// Start refreshes the metadata of the active SAML identity providers of all instances on every interval until the context is done.
+// Only identity providers with a metadata URL are refreshed, see [command.Commands.RefreshSAMLIDPsMetadata].
+// An interval of 0 disables the refresh.
+func Start(ctx context.Context, interval time.Duration, commands *command.Commands, queries *query.Queries, client *database.DB) {
+	j := &job{
+		commands: commands,
+		queries:  queries,
+	}
+	scheduledjob.Start(ctx, lockName, interval, queries, client, j.refresh)
+}
+
+// refresh refreshes the metadata of the active SAML identity providers of the instance.
+func (j *job) refresh(ctx context.Context) error {
+	idps, err := j.queries.IDPTemplates(ctx, &query.IDPTemplateSearchQueries{}, false)
+	if err != nil {
+		return err
+	}
+	ids := make([]string, 0, len(idps.Templates))
+	for _, idp := range idps.Templates {
+		if idp.State != domain.IDPStateActive || idp.Type != domain.IDPTypeSAML {
+			continue
+		}
+		ids = append(ids, idp.ID)
+	}
+	return j.commands.RefreshSAMLIDPsMetadata(ctx, ids)
+}
@@ -0,0 +1,96 @@
+// Package scheduledjob runs background jobs for every instance on an interval.
+// The job of an instance is locked, so only one ZITADEL process runs it at a time.
+package scheduledjob
+
+import (
+	"context"
+	"time"
+
+	"github.com/zitadel/logging"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/database"
+	"github.com/zitadel/zitadel/internal/eventstore/handler/crdb"
+	"github.com/zitadel/zitadel/internal/query"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+const (
+	locksTable   = "projections.locks"
+	lockDuration = time.Minute
+)
+
+// RunFunc runs the job for the instance of the context.
+type RunFunc func(ctx context.Context) error
+
+type instanceSearcher interface {
+	SearchInstances(ctx context.Context, queries *query.InstanceSearchQueries) (*query.Instances, error)
+}
+
+type job struct {
+	name      string
+	instances instanceSearcher
+	locker    crdb.Locker
+	run       RunFunc
+}
+
+// Start runs the job for all instances on every interval until the context is done.
+// The name identifies the lock of the job and is logged on failures.
+// An interval of 0 disables the job.
+func Start(ctx context.Context, name string, interval time.Duration, queries *query.Queries, client *database.DB, run RunFunc) {
+	if interval <= 0 {
+		return
+	}
+	j := &job{
+		name:      name,
+		instances: queries,
+		locker:    crdb.NewLocker(client.DB, locksTable, name),
+		run:       run,
+	}
+	go j.runOnInterval(ctx, interval)
+}
+
+func (j *job) runOnInterval(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			j.runInstances(ctx)
+		}
+	}
+}
+
+func (j *job) runInstances(ctx context.Context) {
+	instances, err := j.instances.SearchInstances(ctx, &query.InstanceSearchQueries{})
+	if err != nil {
+		logging.WithFields("job", j.name).WithError(err).Warn("unable to query instances")
+		return
+	}
+	for _, instance := range instances.Instances {
+		err = j.lockAndRun(authz.WithInstanceID(ctx, instance.ID))
+		logging.WithFields("job", j.name, "instance", instance.ID).OnError(err).Warn("job failed")
+	}
+}
+
+// lockAndRun runs the job for the instance of the context, unless another process holds its lock.
+func (j *job) lockAndRun(ctx context.Context) error {
+	ctx, cancel := context.WithCancel(ctx)
+	errs := j.locker.Lock(ctx, lockDuration, authz.GetInstance(ctx).InstanceID())
+	defer func() {
+		cancel()
+		// the locker renews the lock until it notices the canceled context
+		for range errs {
+		}
+	}()
+	err, ok := <-errs
+	if err != nil || !ok {
+		if zerrors.IsErrorAlreadyExists(err) {
+			return nil
+		}
+		return err
+	}
+	return j.run(ctx)
+}
@@ -0,0 +1,91 @@
+package scheduledjob
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/query"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+type testInstances []string
+
+func (ids testInstances) SearchInstances(context.Context, *query.InstanceSearchQueries) (*query.Instances, error) {
+	instances := make([]*query.Instance, len(ids))
+	for i, id := range ids {
+		instances[i] = &query.Instance{ID: id}
+	}
+	return &query.Instances{Instances: instances}, nil
+}
+
+// testLocker locks all instances except the locked ones, like another process holding their locks
+type testLocker struct {
+	locked map[string]bool
+}
+
+func (l *testLocker) Lock(ctx context.Context, _ time.Duration, instanceIDs ...string) <-chan error {
+	errs := make(chan error)
+	go func() {
+		defer close(errs)
+		var err error
+		if l.locked[instanceIDs[0]] {
+			err = zerrors.ThrowAlreadyExists(nil, "TEST-Lck1a", "already locked")
+		}
+		select {
+		case errs <- err:
+		case <-ctx.Done():
+			return
+		}
+		<-ctx.Done()
+	}()
+	return errs
+}
+
+func (l *testLocker) Unlock(...string) error {
+	return nil
+}
+
+func Test_job_runInstances(t *testing.T) {
+	tests := []struct {
+		name    string
+		locked  map[string]bool
+		runErr  error
+		wantRan []string
+	}{
+		{
+			name:    "all instances",
+			wantRan: []string{"instance1", "instance2"},
+		},
+		{
+			name:    "instance locked by other process, skipped",
+			locked:  map[string]bool{"instance1": true},
+			wantRan: []string{"instance2"},
+		},
+		{
+			name:    "failed run, next instance",
+			runErr:  errors.New("run failed"),
+			wantRan: []string{"instance1", "instance2"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var ran []string
+			j := &job{
+				name:      "test",
+				instances: testInstances{"instance1", "instance2"},
+				locker:    &testLocker{locked: tt.locked},
+				run: func(ctx context.Context) error {
+					ran = append(ran, authz.GetInstance(ctx).InstanceID())
+					return tt.runErr
+				},
+			}
+			j.runInstances(context.Background())
+			assert.Equal(t, tt.wantRan, ran)
+		})
+	}
+}
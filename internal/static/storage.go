@@ -24,6 +24,8 @@ type ObjectType int32
 const (
 	ObjectTypeUserAvatar ObjectType = iota
 	ObjectTypeStyling
+	ObjectTypeUserDataExport
+	ObjectTypeEventPayload
 )
 
 func (o ObjectType) String() string {
@@ -32,6 +34,10 @@ func (o ObjectType) String() string {
 		return "0"
 	case ObjectTypeStyling:
 		return "1"
+	case ObjectTypeUserDataExport:
+		return "2"
+	case ObjectTypeEventPayload:
+		return "3"
 	default:
 		return ""
 	}
@@ -31,6 +31,7 @@ const (
 	MetricTypeTotalCount MetricType = iota
 	MetricTypeStatusCode
 	MetricTypeRequestCount
+	MetricTypeRequestDuration
 )
 
 type StatusRecorder struct {
@@ -17,6 +17,9 @@ const (
 	ViewName                        = "view_name"
 )
 
+// DurationBuckets are the default histogram buckets in seconds for latencies
+var DurationBuckets = []float64{0.005, 0.01, 0.025, 0.05, 0.1, 0.25, 0.5, 1, 2.5, 5, 10}
+
 type Metrics interface {
 	GetExporter() http.Handler
 	GetMetricsProvider() metric.MeterProvider
@@ -24,6 +27,8 @@ type Metrics interface {
 	AddCount(ctx context.Context, name string, value int64, labels map[string]attribute.Value) error
 	RegisterUpDownSumObserver(name, description string, callbackFunc metric.Int64Callback) error
 	RegisterValueObserver(name, description string, callbackFunc metric.Int64Callback) error
+	RegisterHistogram(name, description, unit string, buckets []float64) error
+	AddHistogramMeasurement(ctx context.Context, name string, value float64, labels map[string]attribute.Value) error
 }
 
 var M Metrics
@@ -69,3 +74,19 @@ func RegisterValueObserver(name, description string, callbackFunc metric.Int64Ca
 	}
 	return M.RegisterValueObserver(name, description, callbackFunc)
 }
+
+func RegisterHistogram(name, description, unit string, buckets []float64) error {
+	if M == nil {
+		return nil
+	}
+	return M.RegisterHistogram(name, description, unit, buckets)
+}
+
+// AddHistogramMeasurement records the value in the histogram.
+// If the span in ctx is sampled and exemplars are enabled, the measurement is linked to the trace.
+func AddHistogramMeasurement(ctx context.Context, name string, value float64, labels map[string]attribute.Value) error {
+	if M == nil {
+		return nil
+	}
+	return M.AddHistogramMeasurement(ctx, name, value, labels)
+}
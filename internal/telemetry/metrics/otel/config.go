@@ -1,20 +1,39 @@
 package otel
 
 import (
+	"os"
+	"strconv"
+
 	"github.com/zitadel/zitadel/internal/telemetry/metrics"
 )
 
+// exemplarsEnv enables the experimental exemplars of the OpenTelemetry SDK
+const exemplarsEnv = "OTEL_GO_X_EXEMPLAR"
+
 type Config struct {
 	MeterName string
+	// Exemplars links histogram measurements to the sampled trace
+	Exemplars bool
 }
 
 func NewTracerFromConfig(rawConfig map[string]interface{}) (err error) {
 	c := new(Config)
 	c.MeterName, _ = rawConfig["metername"].(string)
+	switch exemplars := rawConfig["exemplars"].(type) {
+	case bool:
+		c.Exemplars = exemplars
+	case string:
+		c.Exemplars, _ = strconv.ParseBool(exemplars)
+	}
 	return c.NewMetrics()
 }
 
 func (c *Config) NewMetrics() (err error) {
+	if c.Exemplars {
+		if err = os.Setenv(exemplarsEnv, "true"); err != nil {
+			return err
+		}
+	}
 	metrics.M, err = NewMetrics(c.MeterName)
 	return err
 }
@@ -5,6 +5,7 @@ import (
 	"net/http"
 	"sync"
 
+	prometheus_client "github.com/prometheus/client_golang/prometheus"
 	"github.com/prometheus/client_golang/prometheus/promhttp"
 	"go.opentelemetry.io/otel/attribute"
 	"go.opentelemetry.io/otel/exporters/prometheus"
@@ -22,6 +23,7 @@ type Metrics struct {
 	Counters          sync.Map
 	UpDownSumObserver sync.Map
 	ValueObservers    sync.Map
+	Histograms        sync.Map
 }
 
 func NewMetrics(meterName string) (metrics.Metrics, error) {
@@ -44,7 +46,11 @@ func NewMetrics(meterName string) (metrics.Metrics, error) {
 }
 
 func (m *Metrics) GetExporter() http.Handler {
-	return promhttp.Handler()
+	// the OpenMetrics format is required to expose exemplars
+	return promhttp.InstrumentMetricHandler(
+		prometheus_client.DefaultRegisterer,
+		promhttp.HandlerFor(prometheus_client.DefaultGatherer, promhttp.HandlerOpts{EnableOpenMetrics: true}),
+	)
 }
 
 func (m *Metrics) GetMetricsProvider() metric.MeterProvider {
@@ -100,6 +106,31 @@ func (m *Metrics) RegisterValueObserver(name, description string, callbackFunc m
 	return nil
 }
 
+func (m *Metrics) RegisterHistogram(name, description, unit string, buckets []float64) error {
+	if _, exists := m.Histograms.Load(name); exists {
+		return nil
+	}
+	histogram, err := m.Meter.Float64Histogram(name,
+		metric.WithDescription(description),
+		metric.WithUnit(unit),
+		metric.WithExplicitBucketBoundaries(buckets...),
+	)
+	if err != nil {
+		return err
+	}
+	m.Histograms.Store(name, histogram)
+	return nil
+}
+
+func (m *Metrics) AddHistogramMeasurement(ctx context.Context, name string, value float64, labels map[string]attribute.Value) error {
+	histogram, exists := m.Histograms.Load(name)
+	if !exists {
+		return zerrors.ThrowNotFound(nil, "METER-Kx7vq2Lm9w", "Errors.Metrics.Histogram.NotFound")
+	}
+	histogram.(metric.Float64Histogram).Record(ctx, value, MapToRecordOption(labels)...)
+	return nil
+}
+
 func MapToAddOption(labels map[string]attribute.Value) []metric.AddOption {
 	if labels == nil {
 		return nil
@@ -113,3 +144,17 @@ func MapToAddOption(labels map[string]attribute.Value) []metric.AddOption {
 	}
 	return []metric.AddOption{metric.WithAttributes(keyValues...)}
 }
+
+func MapToRecordOption(labels map[string]attribute.Value) []metric.RecordOption {
+	if labels == nil {
+		return nil
+	}
+	keyValues := make([]attribute.KeyValue, 0, len(labels))
+	for key, value := range labels {
+		keyValues = append(keyValues, attribute.KeyValue{
+			Key:   attribute.Key(key),
+			Value: value,
+		})
+	}
+	return []metric.RecordOption{metric.WithAttributes(keyValues...)}
+}
@@ -0,0 +1,54 @@
+package tracing
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"net/http"
+
+	"go.opentelemetry.io/contrib/instrumentation/net/http/otelhttp"
+	"go.opentelemetry.io/otel/attribute"
+	api_trace "go.opentelemetry.io/otel/trace"
+)
+
+const (
+	InstanceIDAttribute = "zitadel.instance_id_hash"
+	OrgIDAttribute      = "zitadel.org_id_hash"
+)
+
+// HashedAttribute returns an attribute containing the truncated SHA-256 hash of the value,
+// so spans can be correlated without exporting the IDs to the tracing backend
+func HashedAttribute(key, value string) attribute.KeyValue {
+	hash := sha256.Sum256([]byte(value))
+	return attribute.String(key, hex.EncodeToString(hash[:8]))
+}
+
+// SetInstanceAttribute adds the hashed instance id to the active span of ctx
+func SetInstanceAttribute(ctx context.Context, instanceID string) {
+	setHashedAttribute(ctx, InstanceIDAttribute, instanceID)
+}
+
+// SetOrgAttribute adds the hashed organization id to the active span of ctx
+func SetOrgAttribute(ctx context.Context, orgID string) {
+	setHashedAttribute(ctx, OrgIDAttribute, orgID)
+}
+
+func setHashedAttribute(ctx context.Context, key, value string) {
+	if value == "" {
+		return
+	}
+	span := api_trace.SpanFromContext(ctx)
+	if !span.IsRecording() {
+		return
+	}
+	span.SetAttributes(HashedAttribute(key, value))
+}
+
+// HTTPTransport wraps the transport, so outgoing requests are traced
+// and the trace context is propagated to the called endpoint
+func HTTPTransport(transport http.RoundTripper) http.RoundTripper {
+	if transport == nil {
+		transport = http.DefaultTransport
+	}
+	return otelhttp.NewTransport(transport)
+}
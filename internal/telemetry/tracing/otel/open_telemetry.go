@@ -61,6 +61,17 @@ func (t *Tracer) NewSpan(ctx context.Context, caller string) (context.Context, *
 	return t.newSpan(ctx, caller)
 }
 
+func (t *Tracer) NewLinkedSpan(ctx context.Context, name string, links ...api_trace.SpanContext) (context.Context, *tracing.Span) {
+	spanLinks := make([]api_trace.Link, 0, len(links))
+	for _, link := range links {
+		if !link.IsValid() {
+			continue
+		}
+		spanLinks = append(spanLinks, api_trace.Link{SpanContext: link})
+	}
+	return t.newSpanFromName(ctx, name, api_trace.WithLinks(spanLinks...))
+}
+
 func (t *Tracer) newSpan(ctx context.Context, caller string, options ...api_trace.SpanStartOption) (context.Context, *tracing.Span) {
 	return t.newSpanFromName(ctx, caller, options...)
 }
@@ -29,6 +29,14 @@ func (s *Span) EndWithError(err error) {
 	s.End()
 }
 
+// SetAttributes adds the attributes to the span
+func (s *Span) SetAttributes(attributes ...attribute.KeyValue) {
+	if s.span == nil {
+		return
+	}
+	s.span.SetAttributes(attributes...)
+}
+
 func (s *Span) SetStatusByError(err error) {
 	if s.span == nil {
 		return
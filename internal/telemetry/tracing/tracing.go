@@ -15,6 +15,7 @@ type Tracer interface {
 	NewClientInterceptorSpan(ctx context.Context, name string) (context.Context, *Span)
 	NewServerInterceptorSpan(ctx context.Context, name string) (context.Context, *Span)
 	NewSpanHTTP(r *http.Request, caller string) (*http.Request, *Span)
+	NewLinkedSpan(ctx context.Context, name string, links ...api_trace.SpanContext) (context.Context, *Span)
 	Sampler() sdk_trace.Sampler
 }
 
@@ -76,6 +77,15 @@ func NewSpanHTTP(r *http.Request) (*http.Request, *Span) {
 	return T.NewSpanHTTP(r, GetCaller())
 }
 
+// NewLinkedSpan starts a span which is linked to the given spans,
+// it's used to trace asynchronous work like projections and notifications back to the request which triggered it
+func NewLinkedSpan(ctx context.Context, name string, links ...api_trace.SpanContext) (context.Context, *Span) {
+	if T == nil {
+		return ctx, CreateSpan(nil)
+	}
+	return T.NewLinkedSpan(ctx, name, links...)
+}
+
 func TraceIDFromCtx(ctx context.Context) string {
 	return api_trace.SpanFromContext(ctx).SpanContext().TraceID().String()
 }
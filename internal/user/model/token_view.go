@@ -22,6 +22,8 @@ type TokenView struct {
 	PreferredLanguage string
 	RefreshTokenID    string
 	IsPAT             bool
+	Permissions       []string
+	AllowedIPs        []string
 	Reason            domain.TokenReason
 	Actor             *domain.TokenActor
 }
@@ -56,6 +56,8 @@ type HumanView struct {
 	MFAInitSkipped           time.Time
 	InitRequired             bool
 	PasswordlessInitRequired bool
+	PasskeyPromptSnoozed     time.Time
+	PasskeyPromptOptedOut    bool
 }
 
 type WebAuthNView struct {
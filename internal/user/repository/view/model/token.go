@@ -33,6 +33,8 @@ const (
 	TokenKeyPreferredLanguage = "preferred_language"
 	TokenKeyScopes            = "scopes"
 	TokenKeyIsPat             = "is_pat"
+	TokenKeyPermissions       = "permissions"
+	TokenKeyAllowedIPs        = "allowed_ips"
 )
 
 type TokenView struct {
@@ -50,6 +52,8 @@ type TokenView struct {
 	PreferredLanguage string                     `json:"preferredLanguage" gorm:"column:preferred_language"`
 	RefreshTokenID    string                     `json:"refreshTokenID,omitempty" gorm:"refresh_token_id"`
 	IsPAT             bool                       `json:"-" gorm:"is_pat"`
+	Permissions       database.TextArray[string] `json:"permissions" gorm:"column:permissions"`
+	AllowedIPs        database.TextArray[string] `json:"allowedIps" gorm:"column:allowed_ips"`
 	Deactivated       bool                       `json:"-" gorm:"-"`
 	InstanceID        string                     `json:"instanceID" gorm:"column:instance_id;primary_key"`
 	Actor             TokenActor                 `json:"actor" gorm:"column:actor"`
@@ -104,6 +108,8 @@ func TokenViewToModel(token *TokenView) *usr_model.TokenView {
 		PreferredLanguage: token.PreferredLanguage,
 		RefreshTokenID:    token.RefreshTokenID,
 		IsPAT:             token.IsPAT,
+		Permissions:       token.Permissions,
+		AllowedIPs:        token.AllowedIPs,
 		Actor:             token.Actor.TokenActor,
 	}
 }
@@ -113,10 +119,12 @@ func (t *TokenView) AppendEventIfMyToken(event eventstore.Event) (err error) {
 	view := new(TokenView)
 	switch event.Type() {
 	case user_repo.UserTokenAddedType,
-		user_repo.PersonalAccessTokenAddedType:
+		user_repo.PersonalAccessTokenAddedType,
+		user_repo.OfflineTokenAddedType:
 		view.setRootData(event)
 		err = view.setData(event)
-	case user_repo.UserTokenRemovedType:
+	case user_repo.UserTokenRemovedType,
+		user_repo.OfflineTokenRevokedType:
 		return t.appendTokenRemoved(event)
 	case user_repo.HumanRefreshTokenRemovedType:
 		return t.appendRefreshTokenRemoved(event)
@@ -161,7 +169,8 @@ func (t *TokenView) AppendEvent(event eventstore.Event) error {
 	t.Sequence = event.Sequence()
 	switch event.Type() {
 	case user_repo.UserTokenAddedType,
-		user_repo.PersonalAccessTokenAddedType:
+		user_repo.PersonalAccessTokenAddedType,
+		user_repo.OfflineTokenAddedType:
 		t.setRootData(event)
 		err := t.setData(event)
 		if err != nil {
@@ -234,6 +243,8 @@ func (t *TokenView) GetRelevantEventTypes() []eventstore.EventType {
 		user_repo.UserLockedType,
 		user_repo.UserReactivatedType,
 		user_repo.PersonalAccessTokenRemovedType,
+		user_repo.OfflineTokenAddedType,
+		user_repo.OfflineTokenRevokedType,
 	}
 }
 
@@ -38,6 +38,8 @@ const (
 	UserKeyInitRequired             = "init_required"
 	UserKeyPasswordlessInitRequired = "passwordless_init_required"
 	UserKeyMFAInitSkipped           = "mfa_init_skipped"
+	UserKeyPasskeyPromptSnoozed     = "passkey_prompt_snoozed"
+	UserKeyPasskeyPromptOptedOut    = "passkey_prompt_opted_out"
 	UserKeyChangeDate               = "change_date"
 )
 
@@ -100,6 +102,8 @@ type HumanView struct {
 	UsernameChangeRequired   bool           `json:"-" gorm:"column:username_change_required"`
 	PasswordChanged          time.Time      `json:"-" gorm:"column:password_change"`
 	PasswordlessTokens       WebAuthNTokens `json:"-" gorm:"column:passwordless_tokens"`
+	PasskeyPromptSnoozed     time.Time      `json:"-" gorm:"column:passkey_prompt_snoozed"`
+	PasskeyPromptOptedOut    bool           `json:"-" gorm:"column:passkey_prompt_opted_out"`
 }
 
 type WebAuthNTokens []*WebAuthNView
@@ -184,6 +188,8 @@ func UserToModel(user *UserView) *model.UserView {
 			MFAInitSkipped:           user.MFAInitSkipped,
 			InitRequired:             user.InitRequired,
 			PasswordlessInitRequired: user.PasswordlessInitRequired,
+			PasskeyPromptSnoozed:     user.PasskeyPromptSnoozed,
+			PasskeyPromptOptedOut:    user.PasskeyPromptOptedOut,
 		}
 	}
 
@@ -352,6 +358,10 @@ func (u *UserView) AppendEvent(event eventstore.Event) (err error) {
 	case user.UserV1MFAInitSkippedType,
 		user.HumanMFAInitSkippedType:
 		u.MFAInitSkipped = event.CreatedAt()
+	case user.HumanPasskeyPromptSnoozedType:
+		u.PasskeyPromptSnoozed = event.CreatedAt()
+	case user.HumanPasskeyPromptOptedOutType:
+		u.PasskeyPromptOptedOut = true
 	case user.UserV1InitialCodeAddedType,
 		user.HumanInitialCodeAddedType:
 		u.InitRequired = true
@@ -610,5 +620,7 @@ func (u *UserView) EventTypes() []eventstore.EventType {
 		user.HumanAvatarRemovedType,
 		user.HumanPasswordlessInitCodeAddedType,
 		user.HumanPasswordlessInitCodeRequestedType,
+		user.HumanPasskeyPromptSnoozedType,
+		user.HumanPasskeyPromptOptedOutType,
 	}
 }
@@ -51,6 +51,8 @@ SELECT
         ELSE 0
       END AS mfa_max_set_up
     , au.mfa_init_skipped
+    , au.passkey_prompt_snoozed
+    , au.passkey_prompt_opted_out
     , u.sequence
     , au.init_required
     , au.username_change_required
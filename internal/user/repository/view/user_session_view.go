@@ -16,6 +16,9 @@ var userSessionByIDQuery string
 //go:embed user_sessions_by_user_agent.sql
 var userSessionsByUserAgentQuery string
 
+//go:embed user_sessions_by_user.sql
+var userSessionsByUserQuery string
+
 func UserSessionByIDs(db *database.DB, agentID, userID, instanceID string) (userSession *model.UserSessionView, err error) {
 	err = db.QueryRow(
 		func(row *sql.Row) error {
@@ -42,6 +45,19 @@ func UserSessionsByAgentID(db *database.DB, agentID, instanceID string) (userSes
 	return userSessions, err
 }
 
+func UserSessionsByUserID(db *database.DB, userID, instanceID string) (userSessions []*model.UserSessionView, err error) {
+	err = db.Query(
+		func(rows *sql.Rows) error {
+			userSessions, err = scanUserSessions(rows)
+			return err
+		},
+		userSessionsByUserQuery,
+		userID,
+		instanceID,
+	)
+	return userSessions, err
+}
+
 func scanUserSession(row *sql.Row) (*model.UserSessionView, error) {
 	session := new(model.UserSessionView)
 	err := row.Scan(
@@ -0,0 +1,85 @@
+package userlifecycle
+
+import (
+	"context"
+	"time"
+
+	"github.com/zitadel/zitadel/internal/command"
+	"github.com/zitadel/zitadel/internal/database"
+	"github.com/zitadel/zitadel/internal/query"
+	"github.com/zitadel/zitadel/internal/scheduledjob"
+)
+
+const lockName = "user_lifecycle"
+
+type job struct {
+	commands *command.Commands
+	queries  *query.Queries
+}
+
+// Start applies the user lifecycle policies of the organizations of all instances on every interval
+// until the context is done. An interval of 0 disables the lifecycle policies.
+func Start(ctx context.Context, interval time.Duration, commands *command.Commands, queries *query.Queries, client *database.DB) {
+	j := &job{
+		commands: commands,
+		queries:  queries,
+	}
+	scheduledjob.Start(ctx, lockName, interval, queries, client, j.apply)
+}
+
+// apply applies the user lifecycle policies of the organizations of the instance.
+func (j *job) apply(ctx context.Context) error {
+	return j.commands.ApplyUserLifecyclePolicies(ctx, j.removeUserDependencies)
+}
+
+// removeUserDependencies returns the memberships and user grants which are removed together with the user
+func (j *job) removeUserDependencies(ctx context.Context, userID string) ([]*command.CascadingMembership, []string, error) {
+	userGrantUserQuery, err := query.NewUserGrantUserIDSearchQuery(userID)
+	if err != nil {
+		return nil, nil, err
+	}
+	grants, err := j.queries.UserGrants(ctx, &query.UserGrantsQueries{
+		Queries: []query.SearchQuery{userGrantUserQuery},
+	}, true)
+	if err != nil {
+		return nil, nil, err
+	}
+	membershipsUserQuery, err := query.NewMembershipUserIDQuery(userID)
+	if err != nil {
+		return nil, nil, err
+	}
+	memberships, err := j.queries.Memberships(ctx, &query.MembershipSearchQuery{
+		Queries: []query.SearchQuery{membershipsUserQuery},
+	}, false)
+	if err != nil {
+		return nil, nil, err
+	}
+	grantIDs := make([]string, len(grants.UserGrants))
+	for i, grant := range grants.UserGrants {
+		grantIDs[i] = grant.ID
+	}
+	return cascadingMemberships(memberships.Memberships), grantIDs, nil
+}
+
+func cascadingMemberships(memberships []*query.Membership) []*command.CascadingMembership {
+	cascades := make([]*command.CascadingMembership, len(memberships))
+	for i, membership := range memberships {
+		cascades[i] = &command.CascadingMembership{
+			UserID:        membership.UserID,
+			ResourceOwner: membership.ResourceOwner,
+		}
+		if membership.IAM != nil {
+			cascades[i].IAM = &command.CascadingIAMMembership{IAMID: membership.IAM.IAMID}
+		}
+		if membership.Org != nil {
+			cascades[i].Org = &command.CascadingOrgMembership{OrgID: membership.Org.OrgID}
+		}
+		if membership.Project != nil {
+			cascades[i].Project = &command.CascadingProjectMembership{ProjectID: membership.Project.ProjectID}
+		}
+		if membership.ProjectGrant != nil {
+			cascades[i].ProjectGrant = &command.CascadingProjectGrantMembership{ProjectID: membership.ProjectGrant.ProjectID, GrantID: membership.ProjectGrant.GrantID}
+		}
+	}
+	return cascades
+}